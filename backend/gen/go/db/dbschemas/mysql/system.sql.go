@@ -278,7 +278,8 @@ WHERE
     AND tc.constraint_type = 'UNIQUE'
 ORDER BY
     tc.table_name,
-    kcu.column_name
+    tc.constraint_name,
+    kcu.ordinal_position
 `
 
 type GetUniqueConstraintsRow struct {
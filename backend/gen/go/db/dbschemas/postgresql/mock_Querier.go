@@ -200,6 +200,66 @@ func (_c *MockQuerier_GetDatabaseTableSchemasBySchemasAndTables_Call) RunAndRetu
 	return _c
 }
 
+// GetExclusionConstraintsBySchema provides a mock function with given fields: ctx, db, schema
+func (_m *MockQuerier) GetExclusionConstraintsBySchema(ctx context.Context, db DBTX, schema []string) ([]*GetExclusionConstraintsBySchemaRow, error) {
+	ret := _m.Called(ctx, db, schema)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExclusionConstraintsBySchema")
+	}
+
+	var r0 []*GetExclusionConstraintsBySchemaRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, []string) ([]*GetExclusionConstraintsBySchemaRow, error)); ok {
+		return rf(ctx, db, schema)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, []string) []*GetExclusionConstraintsBySchemaRow); ok {
+		r0 = rf(ctx, db, schema)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*GetExclusionConstraintsBySchemaRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, []string) error); ok {
+		r1 = rf(ctx, db, schema)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetExclusionConstraintsBySchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExclusionConstraintsBySchema'
+type MockQuerier_GetExclusionConstraintsBySchema_Call struct {
+	*mock.Call
+}
+
+// GetExclusionConstraintsBySchema is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - schema []string
+func (_e *MockQuerier_Expecter) GetExclusionConstraintsBySchema(ctx interface{}, db interface{}, schema interface{}) *MockQuerier_GetExclusionConstraintsBySchema_Call {
+	return &MockQuerier_GetExclusionConstraintsBySchema_Call{Call: _e.mock.On("GetExclusionConstraintsBySchema", ctx, db, schema)}
+}
+
+func (_c *MockQuerier_GetExclusionConstraintsBySchema_Call) Run(run func(ctx context.Context, db DBTX, schema []string)) *MockQuerier_GetExclusionConstraintsBySchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetExclusionConstraintsBySchema_Call) Return(_a0 []*GetExclusionConstraintsBySchemaRow, _a1 error) *MockQuerier_GetExclusionConstraintsBySchema_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetExclusionConstraintsBySchema_Call) RunAndReturn(run func(context.Context, DBTX, []string) ([]*GetExclusionConstraintsBySchemaRow, error)) *MockQuerier_GetExclusionConstraintsBySchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetIndicesBySchemasAndTables provides a mock function with given fields: ctx, db, schematables
 func (_m *MockQuerier) GetIndicesBySchemasAndTables(ctx context.Context, db DBTX, schematables []string) ([]*GetIndicesBySchemasAndTablesRow, error) {
 	ret := _m.Called(ctx, db, schematables)
@@ -440,6 +500,123 @@ func (_c *MockQuerier_GetTableConstraintsBySchema_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// GetUniqueIndexesBySchema provides a mock function with given fields: ctx, db, schema
+func (_m *MockQuerier) GetUniqueIndexesBySchema(ctx context.Context, db DBTX, schema []string) ([]*GetUniqueIndexesBySchemaRow, error) {
+	ret := _m.Called(ctx, db, schema)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUniqueIndexesBySchema")
+	}
+
+	var r0 []*GetUniqueIndexesBySchemaRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, []string) ([]*GetUniqueIndexesBySchemaRow, error)); ok {
+		return rf(ctx, db, schema)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, []string) []*GetUniqueIndexesBySchemaRow); ok {
+		r0 = rf(ctx, db, schema)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*GetUniqueIndexesBySchemaRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, []string) error); ok {
+		r1 = rf(ctx, db, schema)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUniqueIndexesBySchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUniqueIndexesBySchema'
+type MockQuerier_GetUniqueIndexesBySchema_Call struct {
+	*mock.Call
+}
+
+// GetUniqueIndexesBySchema is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - schema []string
+func (_e *MockQuerier_Expecter) GetUniqueIndexesBySchema(ctx interface{}, db interface{}, schema interface{}) *MockQuerier_GetUniqueIndexesBySchema_Call {
+	return &MockQuerier_GetUniqueIndexesBySchema_Call{Call: _e.mock.On("GetUniqueIndexesBySchema", ctx, db, schema)}
+}
+
+func (_c *MockQuerier_GetUniqueIndexesBySchema_Call) Run(run func(ctx context.Context, db DBTX, schema []string)) *MockQuerier_GetUniqueIndexesBySchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUniqueIndexesBySchema_Call) Return(_a0 []*GetUniqueIndexesBySchemaRow, _a1 error) *MockQuerier_GetUniqueIndexesBySchema_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUniqueIndexesBySchema_Call) RunAndReturn(run func(context.Context, DBTX, []string) ([]*GetUniqueIndexesBySchemaRow, error)) *MockQuerier_GetUniqueIndexesBySchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsCitusExtensionInstalled provides a mock function with given fields: ctx, db
+func (_m *MockQuerier) IsCitusExtensionInstalled(ctx context.Context, db DBTX) (bool, error) {
+	ret := _m.Called(ctx, db)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsCitusExtensionInstalled")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX) (bool, error)); ok {
+		return rf(ctx, db)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX) bool); ok {
+		r0 = rf(ctx, db)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX) error); ok {
+		r1 = rf(ctx, db)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_IsCitusExtensionInstalled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsCitusExtensionInstalled'
+type MockQuerier_IsCitusExtensionInstalled_Call struct {
+	*mock.Call
+}
+
+// IsCitusExtensionInstalled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+func (_e *MockQuerier_Expecter) IsCitusExtensionInstalled(ctx interface{}, db interface{}) *MockQuerier_IsCitusExtensionInstalled_Call {
+	return &MockQuerier_IsCitusExtensionInstalled_Call{Call: _e.mock.On("IsCitusExtensionInstalled", ctx, db)}
+}
+
+func (_c *MockQuerier_IsCitusExtensionInstalled_Call) Run(run func(ctx context.Context, db DBTX)) *MockQuerier_IsCitusExtensionInstalled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_IsCitusExtensionInstalled_Call) Return(_a0 bool, _a1 error) *MockQuerier_IsCitusExtensionInstalled_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_IsCitusExtensionInstalled_Call) RunAndReturn(run func(context.Context, DBTX) (bool, error)) *MockQuerier_IsCitusExtensionInstalled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockQuerier creates a new instance of MockQuerier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockQuerier(t interface {
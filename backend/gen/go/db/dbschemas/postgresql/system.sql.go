@@ -62,18 +62,39 @@ SELECT
             -1
     END AS numeric_scale,
     a.attnum AS ordinal_position,
-    a.attgenerated::text as generated_type
+    a.attgenerated::text as generated_type,
+    CASE
+        WHEN c.relkind = 'f' THEN 'FOREIGN'
+        ELSE 'BASE TABLE'
+    END AS table_type,
+    COALESCE(domain_base.data_type, '')::text AS base_data_type
 FROM
     pg_catalog.pg_attribute a
     INNER JOIN pg_catalog.pg_class c ON a.attrelid = c.oid
     INNER JOIN pg_catalog.pg_namespace n ON c.relnamespace = n.oid
     INNER JOIN pg_catalog.pg_type pgt ON pgt.oid = a.atttypid
     LEFT JOIN pg_catalog.pg_attrdef d ON d.adrelid = a.attrelid AND d.adnum = a.attnum
+    LEFT JOIN LATERAL (
+        -- Resolves domains, including domains of domains, to the data type they are based on
+        WITH RECURSIVE domain_types AS (
+            SELECT pgt.typbasetype AS type_oid, pgt.typtypmod AS type_mod, 1 AS depth
+            WHERE pgt.typtype = 'd'
+            UNION ALL
+            SELECT t.typbasetype, t.typtypmod, dt.depth + 1
+            FROM domain_types dt
+            JOIN pg_catalog.pg_type t ON t.oid = dt.type_oid
+            WHERE t.typtype = 'd'
+        )
+        SELECT pg_catalog.format_type(type_oid, type_mod) AS data_type
+        FROM domain_types
+        ORDER BY depth DESC
+        LIMIT 1
+    ) AS domain_base ON TRUE
 WHERE
     n.nspname NOT IN('pg_catalog', 'pg_toast', 'information_schema')
     AND a.attnum > 0
     AND NOT a.attisdropped
-    AND c.relkind = 'r' -- ensures only tables are present
+    AND c.relkind IN ('r', 'f') -- ensures only tables and foreign tables are present
 ORDER BY
     a.attnum
 `
@@ -90,6 +111,8 @@ type GetDatabaseSchemaRow struct {
 	NumericScale           int32
 	OrdinalPosition        int16
 	GeneratedType          string
+	TableType              string
+	BaseDataType           string
 }
 
 func (q *Queries) GetDatabaseSchema(ctx context.Context, db DBTX) ([]*GetDatabaseSchemaRow, error) {
@@ -113,6 +136,8 @@ func (q *Queries) GetDatabaseSchema(ctx context.Context, db DBTX) ([]*GetDatabas
 			&i.NumericScale,
 			&i.OrdinalPosition,
 			&i.GeneratedType,
+			&i.TableType,
+			&i.BaseDataType,
 		); err != nil {
 			return nil, err
 		}
@@ -177,13 +202,42 @@ SELECT
             -1
     END AS numeric_scale,
     a.attnum AS ordinal_position,
-    a.attgenerated::text as generated_type
+    a.attgenerated::text as generated_type,
+    COALESCE(domain_base.data_type, '')::text AS base_data_type,
+    EXISTS (
+        SELECT
+            1
+        FROM
+            pg_catalog.pg_depend dep
+            JOIN pg_catalog.pg_class seq ON seq.oid = dep.objid AND seq.relkind = 'S'
+        WHERE
+            dep.classid = 'pg_catalog.pg_class'::regclass
+            AND dep.refobjid = a.attrelid
+            AND dep.refobjsubid = a.attnum
+            AND dep.deptype = 'a'
+    ) AS is_serial -- Only columns that own the sequence of their nextval default were created as serial columns
 FROM
     pg_catalog.pg_attribute a
     INNER JOIN pg_catalog.pg_class c ON a.attrelid = c.oid
     INNER JOIN pg_catalog.pg_namespace n ON c.relnamespace = n.oid
     INNER JOIN pg_catalog.pg_type pgt ON pgt.oid = a.atttypid
     LEFT JOIN pg_catalog.pg_attrdef d ON d.adrelid = a.attrelid AND d.adnum = a.attnum
+    LEFT JOIN LATERAL (
+        -- Resolves domains, including domains of domains, to the data type they are based on
+        WITH RECURSIVE domain_types AS (
+            SELECT pgt.typbasetype AS type_oid, pgt.typtypmod AS type_mod, 1 AS depth
+            WHERE pgt.typtype = 'd'
+            UNION ALL
+            SELECT t.typbasetype, t.typtypmod, dt.depth + 1
+            FROM domain_types dt
+            JOIN pg_catalog.pg_type t ON t.oid = dt.type_oid
+            WHERE t.typtype = 'd'
+        )
+        SELECT pg_catalog.format_type(type_oid, type_mod) AS data_type
+        FROM domain_types
+        ORDER BY depth DESC
+        LIMIT 1
+    ) AS domain_base ON TRUE
 WHERE
     c.relname = $1
     AND n.nspname = $2
@@ -211,6 +265,8 @@ type GetDatabaseTableSchemaRow struct {
 	NumericScale           int32
 	OrdinalPosition        int16
 	GeneratedType          string
+	BaseDataType           string
+	IsSerial               bool
 }
 
 func (q *Queries) GetDatabaseTableSchema(ctx context.Context, db DBTX, arg *GetDatabaseTableSchemaParams) ([]*GetDatabaseTableSchemaRow, error) {
@@ -234,6 +290,8 @@ func (q *Queries) GetDatabaseTableSchema(ctx context.Context, db DBTX, arg *GetD
 			&i.NumericScale,
 			&i.OrdinalPosition,
 			&i.GeneratedType,
+			&i.BaseDataType,
+			&i.IsSerial,
 		); err != nil {
 			return nil, err
 		}
@@ -298,13 +356,42 @@ SELECT
             -1
     END AS numeric_scale,
     a.attnum AS ordinal_position,
-    a.attgenerated::text as generated_type
+    a.attgenerated::text as generated_type,
+    COALESCE(domain_base.data_type, '')::text AS base_data_type,
+    EXISTS (
+        SELECT
+            1
+        FROM
+            pg_catalog.pg_depend dep
+            JOIN pg_catalog.pg_class seq ON seq.oid = dep.objid AND seq.relkind = 'S'
+        WHERE
+            dep.classid = 'pg_catalog.pg_class'::regclass
+            AND dep.refobjid = a.attrelid
+            AND dep.refobjsubid = a.attnum
+            AND dep.deptype = 'a'
+    ) AS is_serial -- Only columns that own the sequence of their nextval default were created as serial columns
 FROM
     pg_catalog.pg_attribute a
     INNER JOIN pg_catalog.pg_class c ON a.attrelid = c.oid
     INNER JOIN pg_catalog.pg_namespace n ON c.relnamespace = n.oid
     INNER JOIN pg_catalog.pg_type pgt ON pgt.oid = a.atttypid
     LEFT JOIN pg_catalog.pg_attrdef d ON d.adrelid = a.attrelid AND d.adnum = a.attnum
+    LEFT JOIN LATERAL (
+        -- Resolves domains, including domains of domains, to the data type they are based on
+        WITH RECURSIVE domain_types AS (
+            SELECT pgt.typbasetype AS type_oid, pgt.typtypmod AS type_mod, 1 AS depth
+            WHERE pgt.typtype = 'd'
+            UNION ALL
+            SELECT t.typbasetype, t.typtypmod, dt.depth + 1
+            FROM domain_types dt
+            JOIN pg_catalog.pg_type t ON t.oid = dt.type_oid
+            WHERE t.typtype = 'd'
+        )
+        SELECT pg_catalog.format_type(type_oid, type_mod) AS data_type
+        FROM domain_types
+        ORDER BY depth DESC
+        LIMIT 1
+    ) AS domain_base ON TRUE
 WHERE
     (n.nspname || '.' || c.relname) = ANY($1::TEXT[])
     AND a.attnum > 0
@@ -326,6 +413,8 @@ type GetDatabaseTableSchemasBySchemasAndTablesRow struct {
 	NumericScale           int32
 	OrdinalPosition        int16
 	GeneratedType          string
+	BaseDataType           string
+	IsSerial               bool
 }
 
 func (q *Queries) GetDatabaseTableSchemasBySchemasAndTables(ctx context.Context, db DBTX, schematables []string) ([]*GetDatabaseTableSchemasBySchemasAndTablesRow, error) {
@@ -349,6 +438,78 @@ func (q *Queries) GetDatabaseTableSchemasBySchemasAndTables(ctx context.Context,
 			&i.NumericScale,
 			&i.OrdinalPosition,
 			&i.GeneratedType,
+			&i.BaseDataType,
+			&i.IsSerial,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getExclusionConstraintsBySchema = `-- name: GetExclusionConstraintsBySchema :many
+SELECT
+    ns.nspname AS schema_name,
+    cls.relname AS table_name,
+    con.conname AS constraint_name,
+    elements.column_names AS constraint_columns,
+    elements.operator_names AS operators,
+    pg_get_constraintdef(con.oid)::TEXT AS constraint_definition
+FROM
+    pg_catalog.pg_constraint con
+    JOIN pg_catalog.pg_class cls ON cls.oid = con.conrelid
+    JOIN pg_catalog.pg_namespace ns ON cls.relnamespace = ns.oid
+JOIN LATERAL (
+        SELECT
+            array_agg(att.attname ORDER BY k.idx)::TEXT[] AS column_names,
+            array_agg(op.oprname ORDER BY k.idx)::TEXT[] AS operator_names
+        FROM
+            generate_subscripts(con.conkey, 1) AS k(idx)
+        JOIN pg_catalog.pg_attribute att ON -- expression elements have an attnum of 0 and are not joined
+            att.attrelid = con.conrelid
+            AND att.attnum = con.conkey[k.idx]
+        JOIN pg_catalog.pg_operator op ON
+            op.oid = con.conexclop[k.idx]
+    ) AS elements ON
+    TRUE
+WHERE
+    con.contype = 'x'
+    AND ns.nspname = ANY($1::TEXT[])
+ORDER BY
+    schema_name,
+    table_name,
+    constraint_name
+`
+
+type GetExclusionConstraintsBySchemaRow struct {
+	SchemaName           string
+	TableName            string
+	ConstraintName       string
+	ConstraintColumns    []string
+	Operators            []string
+	ConstraintDefinition string
+}
+
+func (q *Queries) GetExclusionConstraintsBySchema(ctx context.Context, db DBTX, schema []string) ([]*GetExclusionConstraintsBySchemaRow, error) {
+	rows, err := db.Query(ctx, getExclusionConstraintsBySchema, schema)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetExclusionConstraintsBySchemaRow
+	for rows.Next() {
+		var i GetExclusionConstraintsBySchemaRow
+		if err := rows.Scan(
+			&i.SchemaName,
+			&i.TableName,
+			&i.ConstraintName,
+			&i.ConstraintColumns,
+			&i.Operators,
+			&i.ConstraintDefinition,
 		); err != nil {
 			return nil, err
 		}
@@ -669,3 +830,85 @@ func (q *Queries) GetTableConstraintsBySchema(ctx context.Context, db DBTX, sche
 	}
 	return items, nil
 }
+
+const getUniqueIndexesBySchema = `-- name: GetUniqueIndexesBySchema :many
+SELECT
+    ns.nspname AS schema_name,
+    t.relname AS table_name,
+    i.relname AS index_name,
+    index_columns.column_names AS index_columns,
+    COALESCE(pg_get_expr(ix.indpred, ix.indrelid), '')::TEXT AS index_predicate
+FROM
+    pg_catalog.pg_index ix
+    JOIN pg_catalog.pg_class t ON t.oid = ix.indrelid
+    JOIN pg_catalog.pg_class i ON i.oid = ix.indexrelid
+    JOIN pg_catalog.pg_namespace ns ON t.relnamespace = ns.oid
+LEFT JOIN pg_catalog.pg_constraint con ON con.conindid = ix.indexrelid
+JOIN LATERAL (
+        SELECT
+            array_agg(att.attname ORDER BY array_position(ix.indkey::SMALLINT[], att.attnum))::TEXT[] AS column_names
+        FROM
+            pg_catalog.pg_attribute att
+        WHERE
+            att.attrelid = t.oid
+            AND att.attnum = ANY(ix.indkey)
+    ) AS index_columns ON
+    TRUE
+WHERE
+    ix.indisunique
+    AND NOT ix.indisprimary
+    AND con.conindid IS NULL -- Excludes indexes created as part of constraints
+    AND ix.indexprs IS NULL -- Excludes expression indexes as they do not map to plain columns
+    AND ns.nspname = ANY($1::TEXT[])
+ORDER BY
+    schema_name,
+    table_name,
+    index_name
+`
+
+type GetUniqueIndexesBySchemaRow struct {
+	SchemaName     string
+	TableName      string
+	IndexName      string
+	IndexColumns   []string
+	IndexPredicate string
+}
+
+func (q *Queries) GetUniqueIndexesBySchema(ctx context.Context, db DBTX, schema []string) ([]*GetUniqueIndexesBySchemaRow, error) {
+	rows, err := db.Query(ctx, getUniqueIndexesBySchema, schema)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetUniqueIndexesBySchemaRow
+	for rows.Next() {
+		var i GetUniqueIndexesBySchemaRow
+		if err := rows.Scan(
+			&i.SchemaName,
+			&i.TableName,
+			&i.IndexName,
+			&i.IndexColumns,
+			&i.IndexPredicate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const isCitusExtensionInstalled = `-- name: IsCitusExtensionInstalled :one
+SELECT EXISTS (
+    SELECT 1 FROM pg_catalog.pg_extension WHERE extname = 'citus'
+) AS is_installed
+`
+
+func (q *Queries) IsCitusExtensionInstalled(ctx context.Context, db DBTX) (bool, error) {
+	row := db.QueryRow(ctx, isCitusExtensionInstalled)
+	var is_installed bool
+	err := row.Scan(&is_installed)
+	return is_installed, err
+}
@@ -12,14 +12,44 @@ import (
 	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
 )
 
+const approveJobChangeRequest = `-- name: ApproveJobChangeRequest :one
+UPDATE neosync_api.job_change_requests
+SET approved_by_id = $1,
+approved_at = CURRENT_TIMESTAMP
+WHERE id = $2 AND approved_by_id IS NULL
+RETURNING id, job_id, connection_options, mappings, created_by_id, created_at, approved_by_id, approved_at
+`
+
+type ApproveJobChangeRequestParams struct {
+	ApprovedByID pgtype.UUID
+	ID           pgtype.UUID
+}
+
+func (q *Queries) ApproveJobChangeRequest(ctx context.Context, db DBTX, arg ApproveJobChangeRequestParams) (NeosyncApiJobChangeRequest, error) {
+	row := db.QueryRow(ctx, approveJobChangeRequest, arg.ApprovedByID, arg.ID)
+	var i NeosyncApiJobChangeRequest
+	err := row.Scan(
+		&i.ID,
+		&i.JobID,
+		&i.ConnectionOptions,
+		&i.Mappings,
+		&i.CreatedByID,
+		&i.CreatedAt,
+		&i.ApprovedByID,
+		&i.ApprovedAt,
+	)
+	return i, err
+}
+
 const createJob = `-- name: CreateJob :one
 INSERT INTO neosync_api.jobs (
   name, account_id, status, connection_options, mappings,
-  cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options
+  cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options,
+  table_processors, parameters
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
 )
-RETURNING id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options
+RETURNING id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options, table_processors, parameters
 `
 
 type CreateJobParams struct {
@@ -33,6 +63,8 @@ type CreateJobParams struct {
 	UpdatedByID       pgtype.UUID
 	WorkflowOptions   *pg_models.WorkflowOptions
 	SyncOptions       *pg_models.ActivityOptions
+	TableProcessors   []*pg_models.JobTableProcessor
+	Parameters        []*pg_models.JobParameter
 }
 
 func (q *Queries) CreateJob(ctx context.Context, db DBTX, arg CreateJobParams) (NeosyncApiJob, error) {
@@ -47,6 +79,8 @@ func (q *Queries) CreateJob(ctx context.Context, db DBTX, arg CreateJobParams) (
 		arg.UpdatedByID,
 		arg.WorkflowOptions,
 		arg.SyncOptions,
+		arg.TableProcessors,
+		arg.Parameters,
 	)
 	var i NeosyncApiJob
 	err := row.Scan(
@@ -63,6 +97,45 @@ func (q *Queries) CreateJob(ctx context.Context, db DBTX, arg CreateJobParams) (
 		&i.UpdatedByID,
 		&i.WorkflowOptions,
 		&i.SyncOptions,
+		&i.TableProcessors,
+		&i.Parameters,
+	)
+	return i, err
+}
+
+const createJobChangeRequest = `-- name: CreateJobChangeRequest :one
+INSERT INTO neosync_api.job_change_requests (
+  job_id, connection_options, mappings, created_by_id
+) VALUES (
+  $1, $2, $3, $4
+)
+RETURNING id, job_id, connection_options, mappings, created_by_id, created_at, approved_by_id, approved_at
+`
+
+type CreateJobChangeRequestParams struct {
+	JobID             pgtype.UUID
+	ConnectionOptions *pg_models.JobSourceOptions
+	Mappings          []*pg_models.JobMapping
+	CreatedByID       pgtype.UUID
+}
+
+func (q *Queries) CreateJobChangeRequest(ctx context.Context, db DBTX, arg CreateJobChangeRequestParams) (NeosyncApiJobChangeRequest, error) {
+	row := db.QueryRow(ctx, createJobChangeRequest,
+		arg.JobID,
+		arg.ConnectionOptions,
+		arg.Mappings,
+		arg.CreatedByID,
+	)
+	var i NeosyncApiJobChangeRequest
+	err := row.Scan(
+		&i.ID,
+		&i.JobID,
+		&i.ConnectionOptions,
+		&i.Mappings,
+		&i.CreatedByID,
+		&i.CreatedAt,
+		&i.ApprovedByID,
+		&i.ApprovedAt,
 	)
 	return i, err
 }
@@ -113,8 +186,32 @@ func (q *Queries) DeleteJob(ctx context.Context, db DBTX, id pgtype.UUID) error
 	return err
 }
 
+const getJobAccountIds = `-- name: GetJobAccountIds :many
+SELECT DISTINCT j.account_id FROM neosync_api.jobs j
+`
+
+func (q *Queries) GetJobAccountIds(ctx context.Context, db DBTX) ([]pgtype.UUID, error) {
+	rows, err := db.Query(ctx, getJobAccountIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var account_id pgtype.UUID
+		if err := rows.Scan(&account_id); err != nil {
+			return nil, err
+		}
+		items = append(items, account_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getJobById = `-- name: GetJobById :one
-SELECT id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options from neosync_api.jobs WHERE id = $1
+SELECT id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options, table_processors, parameters from neosync_api.jobs WHERE id = $1
 `
 
 func (q *Queries) GetJobById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiJob, error) {
@@ -134,12 +231,41 @@ func (q *Queries) GetJobById(ctx context.Context, db DBTX, id pgtype.UUID) (Neos
 		&i.UpdatedByID,
 		&i.WorkflowOptions,
 		&i.SyncOptions,
+		&i.TableProcessors,
+		&i.Parameters,
+	)
+	return i, err
+}
+
+const getJobByIdForUpdate = `-- name: GetJobByIdForUpdate :one
+SELECT id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options, table_processors, parameters from neosync_api.jobs WHERE id = $1 FOR UPDATE
+`
+
+func (q *Queries) GetJobByIdForUpdate(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiJob, error) {
+	row := db.QueryRow(ctx, getJobByIdForUpdate, id)
+	var i NeosyncApiJob
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Name,
+		&i.AccountID,
+		&i.Status,
+		&i.ConnectionOptions,
+		&i.Mappings,
+		&i.CronSchedule,
+		&i.CreatedByID,
+		&i.UpdatedByID,
+		&i.WorkflowOptions,
+		&i.SyncOptions,
+		&i.TableProcessors,
+		&i.Parameters,
 	)
 	return i, err
 }
 
 const getJobByNameAndAccount = `-- name: GetJobByNameAndAccount :one
-SELECT j.id, j.created_at, j.updated_at, j.name, j.account_id, j.status, j.connection_options, j.mappings, j.cron_schedule, j.created_by_id, j.updated_by_id, j.workflow_options, j.sync_options from neosync_api.jobs j
+SELECT j.id, j.created_at, j.updated_at, j.name, j.account_id, j.status, j.connection_options, j.mappings, j.cron_schedule, j.created_by_id, j.updated_by_id, j.workflow_options, j.sync_options, j.table_processors, j.parameters from neosync_api.jobs j
 INNER JOIN neosync_api.accounts a ON a.id = j.account_id
 WHERE a.id = $1 AND j.name = $2
 `
@@ -166,10 +292,67 @@ func (q *Queries) GetJobByNameAndAccount(ctx context.Context, db DBTX, arg GetJo
 		&i.UpdatedByID,
 		&i.WorkflowOptions,
 		&i.SyncOptions,
+		&i.TableProcessors,
+		&i.Parameters,
+	)
+	return i, err
+}
+
+const getJobChangeRequestById = `-- name: GetJobChangeRequestById :one
+SELECT id, job_id, connection_options, mappings, created_by_id, created_at, approved_by_id, approved_at from neosync_api.job_change_requests WHERE id = $1
+`
+
+func (q *Queries) GetJobChangeRequestById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiJobChangeRequest, error) {
+	row := db.QueryRow(ctx, getJobChangeRequestById, id)
+	var i NeosyncApiJobChangeRequest
+	err := row.Scan(
+		&i.ID,
+		&i.JobID,
+		&i.ConnectionOptions,
+		&i.Mappings,
+		&i.CreatedByID,
+		&i.CreatedAt,
+		&i.ApprovedByID,
+		&i.ApprovedAt,
 	)
 	return i, err
 }
 
+const getJobChangeRequestsByJob = `-- name: GetJobChangeRequestsByJob :many
+SELECT id, job_id, connection_options, mappings, created_by_id, created_at, approved_by_id, approved_at from neosync_api.job_change_requests
+WHERE job_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) GetJobChangeRequestsByJob(ctx context.Context, db DBTX, jobID pgtype.UUID) ([]NeosyncApiJobChangeRequest, error) {
+	rows, err := db.Query(ctx, getJobChangeRequestsByJob, jobID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NeosyncApiJobChangeRequest
+	for rows.Next() {
+		var i NeosyncApiJobChangeRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.JobID,
+			&i.ConnectionOptions,
+			&i.Mappings,
+			&i.CreatedByID,
+			&i.CreatedAt,
+			&i.ApprovedByID,
+			&i.ApprovedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getJobConnectionDestination = `-- name: GetJobConnectionDestination :one
 SELECT jdca.id, jdca.created_at, jdca.updated_at, jdca.job_id, jdca.connection_id, jdca.options from neosync_api.job_destination_connection_associations jdca
 WHERE jdca.id = $1
@@ -258,7 +441,7 @@ func (q *Queries) GetJobConnectionDestinationsByJobIds(ctx context.Context, db D
 }
 
 const getJobsByAccount = `-- name: GetJobsByAccount :many
-SELECT j.id, j.created_at, j.updated_at, j.name, j.account_id, j.status, j.connection_options, j.mappings, j.cron_schedule, j.created_by_id, j.updated_by_id, j.workflow_options, j.sync_options from neosync_api.jobs j
+SELECT j.id, j.created_at, j.updated_at, j.name, j.account_id, j.status, j.connection_options, j.mappings, j.cron_schedule, j.created_by_id, j.updated_by_id, j.workflow_options, j.sync_options, j.table_processors, j.parameters from neosync_api.jobs j
 INNER JOIN neosync_api.accounts a ON a.id = j.account_id
 WHERE a.id = $1
 ORDER BY j.created_at DESC
@@ -287,6 +470,9 @@ func (q *Queries) GetJobsByAccount(ctx context.Context, db DBTX, accountid pgtyp
 			&i.UpdatedByID,
 			&i.WorkflowOptions,
 			&i.SyncOptions,
+			&i.TableProcessors,
+			&i.Parameters,
+			&i.Parameters,
 		); err != nil {
 			return nil, err
 		}
@@ -344,12 +530,59 @@ func (q *Queries) RemoveJobConnectionDestinations(ctx context.Context, db DBTX,
 	return err
 }
 
+const removePendingJobChangeRequests = `-- name: RemovePendingJobChangeRequests :exec
+DELETE FROM neosync_api.job_change_requests
+WHERE job_id = $1 AND approved_by_id IS NULL
+`
+
+func (q *Queries) RemovePendingJobChangeRequests(ctx context.Context, db DBTX, jobID pgtype.UUID) error {
+	_, err := db.Exec(ctx, removePendingJobChangeRequests, jobID)
+	return err
+}
+
+const setJobParameters = `-- name: SetJobParameters :one
+UPDATE neosync_api.jobs
+SET parameters = $1,
+updated_by_id = $2
+WHERE id = $3
+RETURNING id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options, table_processors, parameters
+`
+
+type SetJobParametersParams struct {
+	Parameters  []*pg_models.JobParameter
+	UpdatedByID pgtype.UUID
+	ID          pgtype.UUID
+}
+
+func (q *Queries) SetJobParameters(ctx context.Context, db DBTX, arg SetJobParametersParams) (NeosyncApiJob, error) {
+	row := db.QueryRow(ctx, setJobParameters, arg.Parameters, arg.UpdatedByID, arg.ID)
+	var i NeosyncApiJob
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Name,
+		&i.AccountID,
+		&i.Status,
+		&i.ConnectionOptions,
+		&i.Mappings,
+		&i.CronSchedule,
+		&i.CreatedByID,
+		&i.UpdatedByID,
+		&i.WorkflowOptions,
+		&i.SyncOptions,
+		&i.TableProcessors,
+		&i.Parameters,
+	)
+	return i, err
+}
+
 const setJobSyncOptions = `-- name: SetJobSyncOptions :one
 UPDATE neosync_api.jobs
 SET sync_options = $1,
 updated_by_id = $2
 WHERE id = $3
-RETURNING id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options
+RETURNING id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options, table_processors, parameters
 `
 
 type SetJobSyncOptionsParams struct {
@@ -375,6 +608,45 @@ func (q *Queries) SetJobSyncOptions(ctx context.Context, db DBTX, arg SetJobSync
 		&i.UpdatedByID,
 		&i.WorkflowOptions,
 		&i.SyncOptions,
+		&i.TableProcessors,
+		&i.Parameters,
+	)
+	return i, err
+}
+
+const setJobTableProcessors = `-- name: SetJobTableProcessors :one
+UPDATE neosync_api.jobs
+SET table_processors = $1,
+updated_by_id = $2
+WHERE id = $3
+RETURNING id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options, table_processors, parameters
+`
+
+type SetJobTableProcessorsParams struct {
+	TableProcessors []*pg_models.JobTableProcessor
+	UpdatedByID     pgtype.UUID
+	ID              pgtype.UUID
+}
+
+func (q *Queries) SetJobTableProcessors(ctx context.Context, db DBTX, arg SetJobTableProcessorsParams) (NeosyncApiJob, error) {
+	row := db.QueryRow(ctx, setJobTableProcessors, arg.TableProcessors, arg.UpdatedByID, arg.ID)
+	var i NeosyncApiJob
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Name,
+		&i.AccountID,
+		&i.Status,
+		&i.ConnectionOptions,
+		&i.Mappings,
+		&i.CronSchedule,
+		&i.CreatedByID,
+		&i.UpdatedByID,
+		&i.WorkflowOptions,
+		&i.SyncOptions,
+		&i.TableProcessors,
+		&i.Parameters,
 	)
 	return i, err
 }
@@ -384,7 +656,7 @@ UPDATE neosync_api.jobs
 SET workflow_options = $1,
 updated_by_id = $2
 WHERE id = $3
-RETURNING id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options
+RETURNING id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options, table_processors, parameters
 `
 
 type SetJobWorkflowOptionsParams struct {
@@ -410,6 +682,8 @@ func (q *Queries) SetJobWorkflowOptions(ctx context.Context, db DBTX, arg SetJob
 		&i.UpdatedByID,
 		&i.WorkflowOptions,
 		&i.SyncOptions,
+		&i.TableProcessors,
+		&i.Parameters,
 	)
 	return i, err
 }
@@ -447,7 +721,7 @@ UPDATE neosync_api.jobs
 SET mappings = $1,
 updated_by_id = $2
 WHERE id = $3
-RETURNING id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options
+RETURNING id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options, table_processors, parameters
 `
 
 type UpdateJobMappingsParams struct {
@@ -473,6 +747,8 @@ func (q *Queries) UpdateJobMappings(ctx context.Context, db DBTX, arg UpdateJobM
 		&i.UpdatedByID,
 		&i.WorkflowOptions,
 		&i.SyncOptions,
+		&i.TableProcessors,
+		&i.Parameters,
 	)
 	return i, err
 }
@@ -482,7 +758,7 @@ UPDATE neosync_api.jobs
 SET cron_schedule = $1,
 updated_by_id = $2
 WHERE id = $3
-RETURNING id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options
+RETURNING id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options, table_processors, parameters
 `
 
 type UpdateJobScheduleParams struct {
@@ -508,6 +784,8 @@ func (q *Queries) UpdateJobSchedule(ctx context.Context, db DBTX, arg UpdateJobS
 		&i.UpdatedByID,
 		&i.WorkflowOptions,
 		&i.SyncOptions,
+		&i.TableProcessors,
+		&i.Parameters,
 	)
 	return i, err
 }
@@ -517,7 +795,7 @@ UPDATE neosync_api.jobs
 SET connection_options = $1,
 updated_by_id = $2
 WHERE id = $3
-RETURNING id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options
+RETURNING id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options, table_processors, parameters
 `
 
 type UpdateJobSourceParams struct {
@@ -543,6 +821,8 @@ func (q *Queries) UpdateJobSource(ctx context.Context, db DBTX, arg UpdateJobSou
 		&i.UpdatedByID,
 		&i.WorkflowOptions,
 		&i.SyncOptions,
+		&i.TableProcessors,
+		&i.Parameters,
 	)
 	return i, err
 }
@@ -24,6 +24,64 @@ func (_m *MockQuerier) EXPECT() *MockQuerier_Expecter {
 	return &MockQuerier_Expecter{mock: &_m.Mock}
 }
 
+// ApproveJobChangeRequest provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) ApproveJobChangeRequest(ctx context.Context, db DBTX, arg ApproveJobChangeRequestParams) (NeosyncApiJobChangeRequest, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveJobChangeRequest")
+	}
+
+	var r0 NeosyncApiJobChangeRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, ApproveJobChangeRequestParams) (NeosyncApiJobChangeRequest, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, ApproveJobChangeRequestParams) NeosyncApiJobChangeRequest); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiJobChangeRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, ApproveJobChangeRequestParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_ApproveJobChangeRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveJobChangeRequest'
+type MockQuerier_ApproveJobChangeRequest_Call struct {
+	*mock.Call
+}
+
+// ApproveJobChangeRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg ApproveJobChangeRequestParams
+func (_e *MockQuerier_Expecter) ApproveJobChangeRequest(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_ApproveJobChangeRequest_Call {
+	return &MockQuerier_ApproveJobChangeRequest_Call{Call: _e.mock.On("ApproveJobChangeRequest", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_ApproveJobChangeRequest_Call) Run(run func(ctx context.Context, db DBTX, arg ApproveJobChangeRequestParams)) *MockQuerier_ApproveJobChangeRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(ApproveJobChangeRequestParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ApproveJobChangeRequest_Call) Return(_a0 NeosyncApiJobChangeRequest, _a1 error) *MockQuerier_ApproveJobChangeRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_ApproveJobChangeRequest_Call) RunAndReturn(run func(context.Context, DBTX, ApproveJobChangeRequestParams) (NeosyncApiJobChangeRequest, error)) *MockQuerier_ApproveJobChangeRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AreConnectionsInAccount provides a mock function with given fields: ctx, db, arg
 func (_m *MockQuerier) AreConnectionsInAccount(ctx context.Context, db DBTX, arg AreConnectionsInAccountParams) (int64, error) {
 	ret := _m.Called(ctx, db, arg)
@@ -82,6 +140,64 @@ func (_c *MockQuerier_AreConnectionsInAccount_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// ClaimJobRunRequest provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) ClaimJobRunRequest(ctx context.Context, db DBTX, arg ClaimJobRunRequestParams) (NeosyncApiJobRunRequest, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimJobRunRequest")
+	}
+
+	var r0 NeosyncApiJobRunRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, ClaimJobRunRequestParams) (NeosyncApiJobRunRequest, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, ClaimJobRunRequestParams) NeosyncApiJobRunRequest); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiJobRunRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, ClaimJobRunRequestParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_ClaimJobRunRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimJobRunRequest'
+type MockQuerier_ClaimJobRunRequest_Call struct {
+	*mock.Call
+}
+
+// ClaimJobRunRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg ClaimJobRunRequestParams
+func (_e *MockQuerier_Expecter) ClaimJobRunRequest(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_ClaimJobRunRequest_Call {
+	return &MockQuerier_ClaimJobRunRequest_Call{Call: _e.mock.On("ClaimJobRunRequest", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_ClaimJobRunRequest_Call) Run(run func(ctx context.Context, db DBTX, arg ClaimJobRunRequestParams)) *MockQuerier_ClaimJobRunRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(ClaimJobRunRequestParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ClaimJobRunRequest_Call) Return(_a0 NeosyncApiJobRunRequest, _a1 error) *MockQuerier_ClaimJobRunRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_ClaimJobRunRequest_Call) RunAndReturn(run func(context.Context, DBTX, ClaimJobRunRequestParams) (NeosyncApiJobRunRequest, error)) *MockQuerier_ClaimJobRunRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateAccountApiKey provides a mock function with given fields: ctx, db, arg
 func (_m *MockQuerier) CreateAccountApiKey(ctx context.Context, db DBTX, arg CreateAccountApiKeyParams) (NeosyncApiAccountApiKey, error) {
 	ret := _m.Called(ctx, db, arg)
@@ -314,6 +430,64 @@ func (_c *MockQuerier_CreateConnection_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// CreateConnectionSavedQuery provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) CreateConnectionSavedQuery(ctx context.Context, db DBTX, arg CreateConnectionSavedQueryParams) (NeosyncApiConnectionSavedQuery, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateConnectionSavedQuery")
+	}
+
+	var r0 NeosyncApiConnectionSavedQuery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateConnectionSavedQueryParams) (NeosyncApiConnectionSavedQuery, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateConnectionSavedQueryParams) NeosyncApiConnectionSavedQuery); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiConnectionSavedQuery)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, CreateConnectionSavedQueryParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateConnectionSavedQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateConnectionSavedQuery'
+type MockQuerier_CreateConnectionSavedQuery_Call struct {
+	*mock.Call
+}
+
+// CreateConnectionSavedQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg CreateConnectionSavedQueryParams
+func (_e *MockQuerier_Expecter) CreateConnectionSavedQuery(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_CreateConnectionSavedQuery_Call {
+	return &MockQuerier_CreateConnectionSavedQuery_Call{Call: _e.mock.On("CreateConnectionSavedQuery", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_CreateConnectionSavedQuery_Call) Run(run func(ctx context.Context, db DBTX, arg CreateConnectionSavedQueryParams)) *MockQuerier_CreateConnectionSavedQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(CreateConnectionSavedQueryParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateConnectionSavedQuery_Call) Return(_a0 NeosyncApiConnectionSavedQuery, _a1 error) *MockQuerier_CreateConnectionSavedQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateConnectionSavedQuery_Call) RunAndReturn(run func(context.Context, DBTX, CreateConnectionSavedQueryParams) (NeosyncApiConnectionSavedQuery, error)) *MockQuerier_CreateConnectionSavedQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateIdentityProviderAssociation provides a mock function with given fields: ctx, db, arg
 func (_m *MockQuerier) CreateIdentityProviderAssociation(ctx context.Context, db DBTX, arg CreateIdentityProviderAssociationParams) (NeosyncApiUserIdentityProviderAssociation, error) {
 	ret := _m.Called(ctx, db, arg)
@@ -430,6 +604,64 @@ func (_c *MockQuerier_CreateJob_Call) RunAndReturn(run func(context.Context, DBT
 	return _c
 }
 
+// CreateJobChangeRequest provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) CreateJobChangeRequest(ctx context.Context, db DBTX, arg CreateJobChangeRequestParams) (NeosyncApiJobChangeRequest, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJobChangeRequest")
+	}
+
+	var r0 NeosyncApiJobChangeRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateJobChangeRequestParams) (NeosyncApiJobChangeRequest, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateJobChangeRequestParams) NeosyncApiJobChangeRequest); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiJobChangeRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, CreateJobChangeRequestParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateJobChangeRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJobChangeRequest'
+type MockQuerier_CreateJobChangeRequest_Call struct {
+	*mock.Call
+}
+
+// CreateJobChangeRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg CreateJobChangeRequestParams
+func (_e *MockQuerier_Expecter) CreateJobChangeRequest(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_CreateJobChangeRequest_Call {
+	return &MockQuerier_CreateJobChangeRequest_Call{Call: _e.mock.On("CreateJobChangeRequest", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_CreateJobChangeRequest_Call) Run(run func(ctx context.Context, db DBTX, arg CreateJobChangeRequestParams)) *MockQuerier_CreateJobChangeRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(CreateJobChangeRequestParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateJobChangeRequest_Call) Return(_a0 NeosyncApiJobChangeRequest, _a1 error) *MockQuerier_CreateJobChangeRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateJobChangeRequest_Call) RunAndReturn(run func(context.Context, DBTX, CreateJobChangeRequestParams) (NeosyncApiJobChangeRequest, error)) *MockQuerier_CreateJobChangeRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateJobConnectionDestination provides a mock function with given fields: ctx, db, arg
 func (_m *MockQuerier) CreateJobConnectionDestination(ctx context.Context, db DBTX, arg CreateJobConnectionDestinationParams) (NeosyncApiJobDestinationConnectionAssociation, error) {
 	ret := _m.Called(ctx, db, arg)
@@ -546,27 +778,27 @@ func (_c *MockQuerier_CreateJobConnectionDestinations_Call) RunAndReturn(run fun
 	return _c
 }
 
-// CreateMachineUser provides a mock function with given fields: ctx, db
-func (_m *MockQuerier) CreateMachineUser(ctx context.Context, db DBTX) (NeosyncApiUser, error) {
-	ret := _m.Called(ctx, db)
+// CreateJobRunArtifact provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) CreateJobRunArtifact(ctx context.Context, db DBTX, arg CreateJobRunArtifactParams) (NeosyncApiJobRunArtifact, error) {
+	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateMachineUser")
+		panic("no return value specified for CreateJobRunArtifact")
 	}
 
-	var r0 NeosyncApiUser
+	var r0 NeosyncApiJobRunArtifact
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX) (NeosyncApiUser, error)); ok {
-		return rf(ctx, db)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateJobRunArtifactParams) (NeosyncApiJobRunArtifact, error)); ok {
+		return rf(ctx, db, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX) NeosyncApiUser); ok {
-		r0 = rf(ctx, db)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateJobRunArtifactParams) NeosyncApiJobRunArtifact); ok {
+		r0 = rf(ctx, db, arg)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiUser)
+		r0 = ret.Get(0).(NeosyncApiJobRunArtifact)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX) error); ok {
-		r1 = rf(ctx, db)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, CreateJobRunArtifactParams) error); ok {
+		r1 = rf(ctx, db, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -574,56 +806,57 @@ func (_m *MockQuerier) CreateMachineUser(ctx context.Context, db DBTX) (NeosyncA
 	return r0, r1
 }
 
-// MockQuerier_CreateMachineUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMachineUser'
-type MockQuerier_CreateMachineUser_Call struct {
+// MockQuerier_CreateJobRunArtifact_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJobRunArtifact'
+type MockQuerier_CreateJobRunArtifact_Call struct {
 	*mock.Call
 }
 
-// CreateMachineUser is a helper method to define mock.On call
+// CreateJobRunArtifact is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-func (_e *MockQuerier_Expecter) CreateMachineUser(ctx interface{}, db interface{}) *MockQuerier_CreateMachineUser_Call {
-	return &MockQuerier_CreateMachineUser_Call{Call: _e.mock.On("CreateMachineUser", ctx, db)}
+//   - arg CreateJobRunArtifactParams
+func (_e *MockQuerier_Expecter) CreateJobRunArtifact(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_CreateJobRunArtifact_Call {
+	return &MockQuerier_CreateJobRunArtifact_Call{Call: _e.mock.On("CreateJobRunArtifact", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_CreateMachineUser_Call) Run(run func(ctx context.Context, db DBTX)) *MockQuerier_CreateMachineUser_Call {
+func (_c *MockQuerier_CreateJobRunArtifact_Call) Run(run func(ctx context.Context, db DBTX, arg CreateJobRunArtifactParams)) *MockQuerier_CreateJobRunArtifact_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(CreateJobRunArtifactParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_CreateMachineUser_Call) Return(_a0 NeosyncApiUser, _a1 error) *MockQuerier_CreateMachineUser_Call {
+func (_c *MockQuerier_CreateJobRunArtifact_Call) Return(_a0 NeosyncApiJobRunArtifact, _a1 error) *MockQuerier_CreateJobRunArtifact_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_CreateMachineUser_Call) RunAndReturn(run func(context.Context, DBTX) (NeosyncApiUser, error)) *MockQuerier_CreateMachineUser_Call {
+func (_c *MockQuerier_CreateJobRunArtifact_Call) RunAndReturn(run func(context.Context, DBTX, CreateJobRunArtifactParams) (NeosyncApiJobRunArtifact, error)) *MockQuerier_CreateJobRunArtifact_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateNonMachineUser provides a mock function with given fields: ctx, db
-func (_m *MockQuerier) CreateNonMachineUser(ctx context.Context, db DBTX) (NeosyncApiUser, error) {
-	ret := _m.Called(ctx, db)
+// CreateJobRunRequest provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) CreateJobRunRequest(ctx context.Context, db DBTX, arg CreateJobRunRequestParams) (NeosyncApiJobRunRequest, error) {
+	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateNonMachineUser")
+		panic("no return value specified for CreateJobRunRequest")
 	}
 
-	var r0 NeosyncApiUser
+	var r0 NeosyncApiJobRunRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX) (NeosyncApiUser, error)); ok {
-		return rf(ctx, db)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateJobRunRequestParams) (NeosyncApiJobRunRequest, error)); ok {
+		return rf(ctx, db, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX) NeosyncApiUser); ok {
-		r0 = rf(ctx, db)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateJobRunRequestParams) NeosyncApiJobRunRequest); ok {
+		r0 = rf(ctx, db, arg)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiUser)
+		r0 = ret.Get(0).(NeosyncApiJobRunRequest)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX) error); ok {
-		r1 = rf(ctx, db)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, CreateJobRunRequestParams) error); ok {
+		r1 = rf(ctx, db, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -631,56 +864,57 @@ func (_m *MockQuerier) CreateNonMachineUser(ctx context.Context, db DBTX) (Neosy
 	return r0, r1
 }
 
-// MockQuerier_CreateNonMachineUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNonMachineUser'
-type MockQuerier_CreateNonMachineUser_Call struct {
+// MockQuerier_CreateJobRunRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJobRunRequest'
+type MockQuerier_CreateJobRunRequest_Call struct {
 	*mock.Call
 }
 
-// CreateNonMachineUser is a helper method to define mock.On call
+// CreateJobRunRequest is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-func (_e *MockQuerier_Expecter) CreateNonMachineUser(ctx interface{}, db interface{}) *MockQuerier_CreateNonMachineUser_Call {
-	return &MockQuerier_CreateNonMachineUser_Call{Call: _e.mock.On("CreateNonMachineUser", ctx, db)}
+//   - arg CreateJobRunRequestParams
+func (_e *MockQuerier_Expecter) CreateJobRunRequest(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_CreateJobRunRequest_Call {
+	return &MockQuerier_CreateJobRunRequest_Call{Call: _e.mock.On("CreateJobRunRequest", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_CreateNonMachineUser_Call) Run(run func(ctx context.Context, db DBTX)) *MockQuerier_CreateNonMachineUser_Call {
+func (_c *MockQuerier_CreateJobRunRequest_Call) Run(run func(ctx context.Context, db DBTX, arg CreateJobRunRequestParams)) *MockQuerier_CreateJobRunRequest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(CreateJobRunRequestParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_CreateNonMachineUser_Call) Return(_a0 NeosyncApiUser, _a1 error) *MockQuerier_CreateNonMachineUser_Call {
+func (_c *MockQuerier_CreateJobRunRequest_Call) Return(_a0 NeosyncApiJobRunRequest, _a1 error) *MockQuerier_CreateJobRunRequest_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_CreateNonMachineUser_Call) RunAndReturn(run func(context.Context, DBTX) (NeosyncApiUser, error)) *MockQuerier_CreateNonMachineUser_Call {
+func (_c *MockQuerier_CreateJobRunRequest_Call) RunAndReturn(run func(context.Context, DBTX, CreateJobRunRequestParams) (NeosyncApiJobRunRequest, error)) *MockQuerier_CreateJobRunRequest_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreatePersonalAccount provides a mock function with given fields: ctx, db, accountSlug
-func (_m *MockQuerier) CreatePersonalAccount(ctx context.Context, db DBTX, accountSlug string) (NeosyncApiAccount, error) {
-	ret := _m.Called(ctx, db, accountSlug)
+// CreateJobRunSnapshot provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) CreateJobRunSnapshot(ctx context.Context, db DBTX, arg CreateJobRunSnapshotParams) (NeosyncApiJobRunSnapshot, error) {
+	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreatePersonalAccount")
+		panic("no return value specified for CreateJobRunSnapshot")
 	}
 
-	var r0 NeosyncApiAccount
+	var r0 NeosyncApiJobRunSnapshot
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, string) (NeosyncApiAccount, error)); ok {
-		return rf(ctx, db, accountSlug)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateJobRunSnapshotParams) (NeosyncApiJobRunSnapshot, error)); ok {
+		return rf(ctx, db, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, string) NeosyncApiAccount); ok {
-		r0 = rf(ctx, db, accountSlug)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateJobRunSnapshotParams) NeosyncApiJobRunSnapshot); ok {
+		r0 = rf(ctx, db, arg)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiAccount)
+		r0 = ret.Get(0).(NeosyncApiJobRunSnapshot)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, string) error); ok {
-		r1 = rf(ctx, db, accountSlug)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, CreateJobRunSnapshotParams) error); ok {
+		r1 = rf(ctx, db, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -688,53 +922,273 @@ func (_m *MockQuerier) CreatePersonalAccount(ctx context.Context, db DBTX, accou
 	return r0, r1
 }
 
-// MockQuerier_CreatePersonalAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePersonalAccount'
-type MockQuerier_CreatePersonalAccount_Call struct {
+// MockQuerier_CreateJobRunSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJobRunSnapshot'
+type MockQuerier_CreateJobRunSnapshot_Call struct {
 	*mock.Call
 }
 
-// CreatePersonalAccount is a helper method to define mock.On call
+// CreateJobRunSnapshot is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - accountSlug string
-func (_e *MockQuerier_Expecter) CreatePersonalAccount(ctx interface{}, db interface{}, accountSlug interface{}) *MockQuerier_CreatePersonalAccount_Call {
-	return &MockQuerier_CreatePersonalAccount_Call{Call: _e.mock.On("CreatePersonalAccount", ctx, db, accountSlug)}
+//   - arg CreateJobRunSnapshotParams
+func (_e *MockQuerier_Expecter) CreateJobRunSnapshot(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_CreateJobRunSnapshot_Call {
+	return &MockQuerier_CreateJobRunSnapshot_Call{Call: _e.mock.On("CreateJobRunSnapshot", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_CreatePersonalAccount_Call) Run(run func(ctx context.Context, db DBTX, accountSlug string)) *MockQuerier_CreatePersonalAccount_Call {
+func (_c *MockQuerier_CreateJobRunSnapshot_Call) Run(run func(ctx context.Context, db DBTX, arg CreateJobRunSnapshotParams)) *MockQuerier_CreateJobRunSnapshot_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(string))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(CreateJobRunSnapshotParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_CreatePersonalAccount_Call) Return(_a0 NeosyncApiAccount, _a1 error) *MockQuerier_CreatePersonalAccount_Call {
+func (_c *MockQuerier_CreateJobRunSnapshot_Call) Return(_a0 NeosyncApiJobRunSnapshot, _a1 error) *MockQuerier_CreateJobRunSnapshot_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_CreatePersonalAccount_Call) RunAndReturn(run func(context.Context, DBTX, string) (NeosyncApiAccount, error)) *MockQuerier_CreatePersonalAccount_Call {
+func (_c *MockQuerier_CreateJobRunSnapshot_Call) RunAndReturn(run func(context.Context, DBTX, CreateJobRunSnapshotParams) (NeosyncApiJobRunSnapshot, error)) *MockQuerier_CreateJobRunSnapshot_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateTeamAccount provides a mock function with given fields: ctx, db, accountSlug
-func (_m *MockQuerier) CreateTeamAccount(ctx context.Context, db DBTX, accountSlug string) (NeosyncApiAccount, error) {
-	ret := _m.Called(ctx, db, accountSlug)
+// CreateMachineUser provides a mock function with given fields: ctx, db
+func (_m *MockQuerier) CreateMachineUser(ctx context.Context, db DBTX) (NeosyncApiUser, error) {
+	ret := _m.Called(ctx, db)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateTeamAccount")
+		panic("no return value specified for CreateMachineUser")
 	}
 
-	var r0 NeosyncApiAccount
+	var r0 NeosyncApiUser
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, string) (NeosyncApiAccount, error)); ok {
-		return rf(ctx, db, accountSlug)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX) (NeosyncApiUser, error)); ok {
+		return rf(ctx, db)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, string) NeosyncApiAccount); ok {
-		r0 = rf(ctx, db, accountSlug)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX) NeosyncApiUser); ok {
+		r0 = rf(ctx, db)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiAccount)
+		r0 = ret.Get(0).(NeosyncApiUser)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX) error); ok {
+		r1 = rf(ctx, db)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateMachineUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMachineUser'
+type MockQuerier_CreateMachineUser_Call struct {
+	*mock.Call
+}
+
+// CreateMachineUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+func (_e *MockQuerier_Expecter) CreateMachineUser(ctx interface{}, db interface{}) *MockQuerier_CreateMachineUser_Call {
+	return &MockQuerier_CreateMachineUser_Call{Call: _e.mock.On("CreateMachineUser", ctx, db)}
+}
+
+func (_c *MockQuerier_CreateMachineUser_Call) Run(run func(ctx context.Context, db DBTX)) *MockQuerier_CreateMachineUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateMachineUser_Call) Return(_a0 NeosyncApiUser, _a1 error) *MockQuerier_CreateMachineUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateMachineUser_Call) RunAndReturn(run func(context.Context, DBTX) (NeosyncApiUser, error)) *MockQuerier_CreateMachineUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateNonMachineUser provides a mock function with given fields: ctx, db
+func (_m *MockQuerier) CreateNonMachineUser(ctx context.Context, db DBTX) (NeosyncApiUser, error) {
+	ret := _m.Called(ctx, db)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNonMachineUser")
+	}
+
+	var r0 NeosyncApiUser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX) (NeosyncApiUser, error)); ok {
+		return rf(ctx, db)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX) NeosyncApiUser); ok {
+		r0 = rf(ctx, db)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiUser)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX) error); ok {
+		r1 = rf(ctx, db)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateNonMachineUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNonMachineUser'
+type MockQuerier_CreateNonMachineUser_Call struct {
+	*mock.Call
+}
+
+// CreateNonMachineUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+func (_e *MockQuerier_Expecter) CreateNonMachineUser(ctx interface{}, db interface{}) *MockQuerier_CreateNonMachineUser_Call {
+	return &MockQuerier_CreateNonMachineUser_Call{Call: _e.mock.On("CreateNonMachineUser", ctx, db)}
+}
+
+func (_c *MockQuerier_CreateNonMachineUser_Call) Run(run func(ctx context.Context, db DBTX)) *MockQuerier_CreateNonMachineUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateNonMachineUser_Call) Return(_a0 NeosyncApiUser, _a1 error) *MockQuerier_CreateNonMachineUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateNonMachineUser_Call) RunAndReturn(run func(context.Context, DBTX) (NeosyncApiUser, error)) *MockQuerier_CreateNonMachineUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePersonalAccount provides a mock function with given fields: ctx, db, accountSlug
+func (_m *MockQuerier) CreatePersonalAccount(ctx context.Context, db DBTX, accountSlug string) (NeosyncApiAccount, error) {
+	ret := _m.Called(ctx, db, accountSlug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePersonalAccount")
+	}
+
+	var r0 NeosyncApiAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, string) (NeosyncApiAccount, error)); ok {
+		return rf(ctx, db, accountSlug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, string) NeosyncApiAccount); ok {
+		r0 = rf(ctx, db, accountSlug)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiAccount)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, string) error); ok {
+		r1 = rf(ctx, db, accountSlug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreatePersonalAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePersonalAccount'
+type MockQuerier_CreatePersonalAccount_Call struct {
+	*mock.Call
+}
+
+// CreatePersonalAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - accountSlug string
+func (_e *MockQuerier_Expecter) CreatePersonalAccount(ctx interface{}, db interface{}, accountSlug interface{}) *MockQuerier_CreatePersonalAccount_Call {
+	return &MockQuerier_CreatePersonalAccount_Call{Call: _e.mock.On("CreatePersonalAccount", ctx, db, accountSlug)}
+}
+
+func (_c *MockQuerier_CreatePersonalAccount_Call) Run(run func(ctx context.Context, db DBTX, accountSlug string)) *MockQuerier_CreatePersonalAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreatePersonalAccount_Call) Return(_a0 NeosyncApiAccount, _a1 error) *MockQuerier_CreatePersonalAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreatePersonalAccount_Call) RunAndReturn(run func(context.Context, DBTX, string) (NeosyncApiAccount, error)) *MockQuerier_CreatePersonalAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateResourceLabels provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) CreateResourceLabels(ctx context.Context, db DBTX, arg CreateResourceLabelsParams) error {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateResourceLabels")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateResourceLabelsParams) error); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CreateResourceLabels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateResourceLabels'
+type MockQuerier_CreateResourceLabels_Call struct {
+	*mock.Call
+}
+
+// CreateResourceLabels is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg CreateResourceLabelsParams
+func (_e *MockQuerier_Expecter) CreateResourceLabels(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_CreateResourceLabels_Call {
+	return &MockQuerier_CreateResourceLabels_Call{Call: _e.mock.On("CreateResourceLabels", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_CreateResourceLabels_Call) Run(run func(ctx context.Context, db DBTX, arg CreateResourceLabelsParams)) *MockQuerier_CreateResourceLabels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(CreateResourceLabelsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateResourceLabels_Call) Return(_a0 error) *MockQuerier_CreateResourceLabels_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CreateResourceLabels_Call) RunAndReturn(run func(context.Context, DBTX, CreateResourceLabelsParams) error) *MockQuerier_CreateResourceLabels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTeamAccount provides a mock function with given fields: ctx, db, accountSlug
+func (_m *MockQuerier) CreateTeamAccount(ctx context.Context, db DBTX, accountSlug string) (NeosyncApiAccount, error) {
+	ret := _m.Called(ctx, db, accountSlug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTeamAccount")
+	}
+
+	var r0 NeosyncApiAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, string) (NeosyncApiAccount, error)); ok {
+		return rf(ctx, db, accountSlug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, string) NeosyncApiAccount); ok {
+		r0 = rf(ctx, db, accountSlug)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiAccount)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, DBTX, string) error); ok {
@@ -1280,24 +1734,24 @@ func (_c *MockQuerier_GetAccountInviteByToken_Call) RunAndReturn(run func(contex
 	return _c
 }
 
-// GetAccountOnboardingConfig provides a mock function with given fields: ctx, db, id
-func (_m *MockQuerier) GetAccountOnboardingConfig(ctx context.Context, db DBTX, id pgtype.UUID) (*pg_models.AccountOnboardingConfig, error) {
+// GetAccountJobDefaults provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) GetAccountJobDefaults(ctx context.Context, db DBTX, id pgtype.UUID) (*pg_models.AccountJobDefaults, error) {
 	ret := _m.Called(ctx, db, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAccountOnboardingConfig")
+		panic("no return value specified for GetAccountJobDefaults")
 	}
 
-	var r0 *pg_models.AccountOnboardingConfig
+	var r0 *pg_models.AccountJobDefaults
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (*pg_models.AccountOnboardingConfig, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (*pg_models.AccountJobDefaults, error)); ok {
 		return rf(ctx, db, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) *pg_models.AccountOnboardingConfig); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) *pg_models.AccountJobDefaults); ok {
 		r0 = rf(ctx, db, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pg_models.AccountOnboardingConfig)
+			r0 = ret.Get(0).(*pg_models.AccountJobDefaults)
 		}
 	}
 
@@ -1310,57 +1764,59 @@ func (_m *MockQuerier) GetAccountOnboardingConfig(ctx context.Context, db DBTX,
 	return r0, r1
 }
 
-// MockQuerier_GetAccountOnboardingConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountOnboardingConfig'
-type MockQuerier_GetAccountOnboardingConfig_Call struct {
+// MockQuerier_GetAccountJobDefaults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountJobDefaults'
+type MockQuerier_GetAccountJobDefaults_Call struct {
 	*mock.Call
 }
 
-// GetAccountOnboardingConfig is a helper method to define mock.On call
+// GetAccountJobDefaults is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
 //   - id pgtype.UUID
-func (_e *MockQuerier_Expecter) GetAccountOnboardingConfig(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetAccountOnboardingConfig_Call {
-	return &MockQuerier_GetAccountOnboardingConfig_Call{Call: _e.mock.On("GetAccountOnboardingConfig", ctx, db, id)}
+func (_e *MockQuerier_Expecter) GetAccountJobDefaults(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetAccountJobDefaults_Call {
+	return &MockQuerier_GetAccountJobDefaults_Call{Call: _e.mock.On("GetAccountJobDefaults", ctx, db, id)}
 }
 
-func (_c *MockQuerier_GetAccountOnboardingConfig_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetAccountOnboardingConfig_Call {
+func (_c *MockQuerier_GetAccountJobDefaults_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetAccountJobDefaults_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetAccountOnboardingConfig_Call) Return(_a0 *pg_models.AccountOnboardingConfig, _a1 error) *MockQuerier_GetAccountOnboardingConfig_Call {
+func (_c *MockQuerier_GetAccountJobDefaults_Call) Return(_a0 *pg_models.AccountJobDefaults, _a1 error) *MockQuerier_GetAccountJobDefaults_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetAccountOnboardingConfig_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (*pg_models.AccountOnboardingConfig, error)) *MockQuerier_GetAccountOnboardingConfig_Call {
+func (_c *MockQuerier_GetAccountJobDefaults_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (*pg_models.AccountJobDefaults, error)) *MockQuerier_GetAccountJobDefaults_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAccountUserAssociation provides a mock function with given fields: ctx, db, arg
-func (_m *MockQuerier) GetAccountUserAssociation(ctx context.Context, db DBTX, arg GetAccountUserAssociationParams) (NeosyncApiAccountUserAssociation, error) {
-	ret := _m.Called(ctx, db, arg)
+// GetAccountOnboardingConfig provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) GetAccountOnboardingConfig(ctx context.Context, db DBTX, id pgtype.UUID) (*pg_models.AccountOnboardingConfig, error) {
+	ret := _m.Called(ctx, db, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAccountUserAssociation")
+		panic("no return value specified for GetAccountOnboardingConfig")
 	}
 
-	var r0 NeosyncApiAccountUserAssociation
+	var r0 *pg_models.AccountOnboardingConfig
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetAccountUserAssociationParams) (NeosyncApiAccountUserAssociation, error)); ok {
-		return rf(ctx, db, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (*pg_models.AccountOnboardingConfig, error)); ok {
+		return rf(ctx, db, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetAccountUserAssociationParams) NeosyncApiAccountUserAssociation); ok {
-		r0 = rf(ctx, db, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) *pg_models.AccountOnboardingConfig); ok {
+		r0 = rf(ctx, db, id)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiAccountUserAssociation)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pg_models.AccountOnboardingConfig)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetAccountUserAssociationParams) error); ok {
-		r1 = rf(ctx, db, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1368,17 +1824,133 @@ func (_m *MockQuerier) GetAccountUserAssociation(ctx context.Context, db DBTX, a
 	return r0, r1
 }
 
-// MockQuerier_GetAccountUserAssociation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountUserAssociation'
-type MockQuerier_GetAccountUserAssociation_Call struct {
+// MockQuerier_GetAccountOnboardingConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountOnboardingConfig'
+type MockQuerier_GetAccountOnboardingConfig_Call struct {
 	*mock.Call
 }
 
-// GetAccountUserAssociation is a helper method to define mock.On call
+// GetAccountOnboardingConfig is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - arg GetAccountUserAssociationParams
-func (_e *MockQuerier_Expecter) GetAccountUserAssociation(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetAccountUserAssociation_Call {
-	return &MockQuerier_GetAccountUserAssociation_Call{Call: _e.mock.On("GetAccountUserAssociation", ctx, db, arg)}
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) GetAccountOnboardingConfig(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetAccountOnboardingConfig_Call {
+	return &MockQuerier_GetAccountOnboardingConfig_Call{Call: _e.mock.On("GetAccountOnboardingConfig", ctx, db, id)}
+}
+
+func (_c *MockQuerier_GetAccountOnboardingConfig_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetAccountOnboardingConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetAccountOnboardingConfig_Call) Return(_a0 *pg_models.AccountOnboardingConfig, _a1 error) *MockQuerier_GetAccountOnboardingConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetAccountOnboardingConfig_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (*pg_models.AccountOnboardingConfig, error)) *MockQuerier_GetAccountOnboardingConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAccountPermissionUserCount provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetAccountPermissionUserCount(ctx context.Context, db DBTX, arg GetAccountPermissionUserCountParams) (int64, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccountPermissionUserCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetAccountPermissionUserCountParams) (int64, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetAccountPermissionUserCountParams) int64); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetAccountPermissionUserCountParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetAccountPermissionUserCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountPermissionUserCount'
+type MockQuerier_GetAccountPermissionUserCount_Call struct {
+	*mock.Call
+}
+
+// GetAccountPermissionUserCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg GetAccountPermissionUserCountParams
+func (_e *MockQuerier_Expecter) GetAccountPermissionUserCount(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetAccountPermissionUserCount_Call {
+	return &MockQuerier_GetAccountPermissionUserCount_Call{Call: _e.mock.On("GetAccountPermissionUserCount", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_GetAccountPermissionUserCount_Call) Run(run func(ctx context.Context, db DBTX, arg GetAccountPermissionUserCountParams)) *MockQuerier_GetAccountPermissionUserCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetAccountPermissionUserCountParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetAccountPermissionUserCount_Call) Return(_a0 int64, _a1 error) *MockQuerier_GetAccountPermissionUserCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetAccountPermissionUserCount_Call) RunAndReturn(run func(context.Context, DBTX, GetAccountPermissionUserCountParams) (int64, error)) *MockQuerier_GetAccountPermissionUserCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAccountUserAssociation provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetAccountUserAssociation(ctx context.Context, db DBTX, arg GetAccountUserAssociationParams) (NeosyncApiAccountUserAssociation, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccountUserAssociation")
+	}
+
+	var r0 NeosyncApiAccountUserAssociation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetAccountUserAssociationParams) (NeosyncApiAccountUserAssociation, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetAccountUserAssociationParams) NeosyncApiAccountUserAssociation); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiAccountUserAssociation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetAccountUserAssociationParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetAccountUserAssociation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountUserAssociation'
+type MockQuerier_GetAccountUserAssociation_Call struct {
+	*mock.Call
+}
+
+// GetAccountUserAssociation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg GetAccountUserAssociationParams
+func (_e *MockQuerier_Expecter) GetAccountUserAssociation(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetAccountUserAssociation_Call {
+	return &MockQuerier_GetAccountUserAssociation_Call{Call: _e.mock.On("GetAccountUserAssociation", ctx, db, arg)}
 }
 
 func (_c *MockQuerier_GetAccountUserAssociation_Call) Run(run func(ctx context.Context, db DBTX, arg GetAccountUserAssociationParams)) *MockQuerier_GetAccountUserAssociation_Call {
@@ -1398,6 +1970,66 @@ func (_c *MockQuerier_GetAccountUserAssociation_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// GetAccountUserPermissions provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetAccountUserPermissions(ctx context.Context, db DBTX, arg GetAccountUserPermissionsParams) ([]string, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccountUserPermissions")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetAccountUserPermissionsParams) ([]string, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetAccountUserPermissionsParams) []string); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetAccountUserPermissionsParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetAccountUserPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountUserPermissions'
+type MockQuerier_GetAccountUserPermissions_Call struct {
+	*mock.Call
+}
+
+// GetAccountUserPermissions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg GetAccountUserPermissionsParams
+func (_e *MockQuerier_Expecter) GetAccountUserPermissions(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetAccountUserPermissions_Call {
+	return &MockQuerier_GetAccountUserPermissions_Call{Call: _e.mock.On("GetAccountUserPermissions", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_GetAccountUserPermissions_Call) Run(run func(ctx context.Context, db DBTX, arg GetAccountUserPermissionsParams)) *MockQuerier_GetAccountUserPermissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetAccountUserPermissionsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetAccountUserPermissions_Call) Return(_a0 []string, _a1 error) *MockQuerier_GetAccountUserPermissions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetAccountUserPermissions_Call) RunAndReturn(run func(context.Context, DBTX, GetAccountUserPermissionsParams) ([]string, error)) *MockQuerier_GetAccountUserPermissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAccountsByUser provides a mock function with given fields: ctx, db, id
 func (_m *MockQuerier) GetAccountsByUser(ctx context.Context, db DBTX, id pgtype.UUID) ([]NeosyncApiAccount, error) {
 	ret := _m.Called(ctx, db, id)
@@ -1575,23 +2207,23 @@ func (_c *MockQuerier_GetAnonymousUser_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
-// GetConnectionById provides a mock function with given fields: ctx, db, id
-func (_m *MockQuerier) GetConnectionById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiConnection, error) {
+// GetColumnTagById provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) GetColumnTagById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiConnectionColumnTag, error) {
 	ret := _m.Called(ctx, db, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetConnectionById")
+		panic("no return value specified for GetColumnTagById")
 	}
 
-	var r0 NeosyncApiConnection
+	var r0 NeosyncApiConnectionColumnTag
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiConnection, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiConnectionColumnTag, error)); ok {
 		return rf(ctx, db, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiConnection); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiConnectionColumnTag); ok {
 		r0 = rf(ctx, db, id)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiConnection)
+		r0 = ret.Get(0).(NeosyncApiConnectionColumnTag)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
@@ -1603,57 +2235,59 @@ func (_m *MockQuerier) GetConnectionById(ctx context.Context, db DBTX, id pgtype
 	return r0, r1
 }
 
-// MockQuerier_GetConnectionById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionById'
-type MockQuerier_GetConnectionById_Call struct {
+// MockQuerier_GetColumnTagById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetColumnTagById'
+type MockQuerier_GetColumnTagById_Call struct {
 	*mock.Call
 }
 
-// GetConnectionById is a helper method to define mock.On call
+// GetColumnTagById is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
 //   - id pgtype.UUID
-func (_e *MockQuerier_Expecter) GetConnectionById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetConnectionById_Call {
-	return &MockQuerier_GetConnectionById_Call{Call: _e.mock.On("GetConnectionById", ctx, db, id)}
+func (_e *MockQuerier_Expecter) GetColumnTagById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetColumnTagById_Call {
+	return &MockQuerier_GetColumnTagById_Call{Call: _e.mock.On("GetColumnTagById", ctx, db, id)}
 }
 
-func (_c *MockQuerier_GetConnectionById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetConnectionById_Call {
+func (_c *MockQuerier_GetColumnTagById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetColumnTagById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetConnectionById_Call) Return(_a0 NeosyncApiConnection, _a1 error) *MockQuerier_GetConnectionById_Call {
+func (_c *MockQuerier_GetColumnTagById_Call) Return(_a0 NeosyncApiConnectionColumnTag, _a1 error) *MockQuerier_GetColumnTagById_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetConnectionById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiConnection, error)) *MockQuerier_GetConnectionById_Call {
+func (_c *MockQuerier_GetColumnTagById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiConnectionColumnTag, error)) *MockQuerier_GetColumnTagById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetConnectionByNameAndAccount provides a mock function with given fields: ctx, db, arg
-func (_m *MockQuerier) GetConnectionByNameAndAccount(ctx context.Context, db DBTX, arg GetConnectionByNameAndAccountParams) (NeosyncApiConnection, error) {
-	ret := _m.Called(ctx, db, arg)
+// GetColumnTagsByAccount provides a mock function with given fields: ctx, db, accountID
+func (_m *MockQuerier) GetColumnTagsByAccount(ctx context.Context, db DBTX, accountID pgtype.UUID) ([]NeosyncApiConnectionColumnTag, error) {
+	ret := _m.Called(ctx, db, accountID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetConnectionByNameAndAccount")
+		panic("no return value specified for GetColumnTagsByAccount")
 	}
 
-	var r0 NeosyncApiConnection
+	var r0 []NeosyncApiConnectionColumnTag
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetConnectionByNameAndAccountParams) (NeosyncApiConnection, error)); ok {
-		return rf(ctx, db, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiConnectionColumnTag, error)); ok {
+		return rf(ctx, db, accountID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetConnectionByNameAndAccountParams) NeosyncApiConnection); ok {
-		r0 = rf(ctx, db, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiConnectionColumnTag); ok {
+		r0 = rf(ctx, db, accountID)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiConnection)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiConnectionColumnTag)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetConnectionByNameAndAccountParams) error); ok {
-		r1 = rf(ctx, db, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, accountID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1661,59 +2295,59 @@ func (_m *MockQuerier) GetConnectionByNameAndAccount(ctx context.Context, db DBT
 	return r0, r1
 }
 
-// MockQuerier_GetConnectionByNameAndAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionByNameAndAccount'
-type MockQuerier_GetConnectionByNameAndAccount_Call struct {
+// MockQuerier_GetColumnTagsByAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetColumnTagsByAccount'
+type MockQuerier_GetColumnTagsByAccount_Call struct {
 	*mock.Call
 }
 
-// GetConnectionByNameAndAccount is a helper method to define mock.On call
+// GetColumnTagsByAccount is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - arg GetConnectionByNameAndAccountParams
-func (_e *MockQuerier_Expecter) GetConnectionByNameAndAccount(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetConnectionByNameAndAccount_Call {
-	return &MockQuerier_GetConnectionByNameAndAccount_Call{Call: _e.mock.On("GetConnectionByNameAndAccount", ctx, db, arg)}
+//   - accountID pgtype.UUID
+func (_e *MockQuerier_Expecter) GetColumnTagsByAccount(ctx interface{}, db interface{}, accountID interface{}) *MockQuerier_GetColumnTagsByAccount_Call {
+	return &MockQuerier_GetColumnTagsByAccount_Call{Call: _e.mock.On("GetColumnTagsByAccount", ctx, db, accountID)}
 }
 
-func (_c *MockQuerier_GetConnectionByNameAndAccount_Call) Run(run func(ctx context.Context, db DBTX, arg GetConnectionByNameAndAccountParams)) *MockQuerier_GetConnectionByNameAndAccount_Call {
+func (_c *MockQuerier_GetColumnTagsByAccount_Call) Run(run func(ctx context.Context, db DBTX, accountID pgtype.UUID)) *MockQuerier_GetColumnTagsByAccount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetConnectionByNameAndAccountParams))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetConnectionByNameAndAccount_Call) Return(_a0 NeosyncApiConnection, _a1 error) *MockQuerier_GetConnectionByNameAndAccount_Call {
+func (_c *MockQuerier_GetColumnTagsByAccount_Call) Return(_a0 []NeosyncApiConnectionColumnTag, _a1 error) *MockQuerier_GetColumnTagsByAccount_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetConnectionByNameAndAccount_Call) RunAndReturn(run func(context.Context, DBTX, GetConnectionByNameAndAccountParams) (NeosyncApiConnection, error)) *MockQuerier_GetConnectionByNameAndAccount_Call {
+func (_c *MockQuerier_GetColumnTagsByAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiConnectionColumnTag, error)) *MockQuerier_GetColumnTagsByAccount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetConnectionsByAccount provides a mock function with given fields: ctx, db, accountid
-func (_m *MockQuerier) GetConnectionsByAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiConnection, error) {
-	ret := _m.Called(ctx, db, accountid)
+// GetColumnTagsByConnection provides a mock function with given fields: ctx, db, connectionID
+func (_m *MockQuerier) GetColumnTagsByConnection(ctx context.Context, db DBTX, connectionID pgtype.UUID) ([]NeosyncApiConnectionColumnTag, error) {
+	ret := _m.Called(ctx, db, connectionID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetConnectionsByAccount")
+		panic("no return value specified for GetColumnTagsByConnection")
 	}
 
-	var r0 []NeosyncApiConnection
+	var r0 []NeosyncApiConnectionColumnTag
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiConnection, error)); ok {
-		return rf(ctx, db, accountid)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiConnectionColumnTag, error)); ok {
+		return rf(ctx, db, connectionID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiConnection); ok {
-		r0 = rf(ctx, db, accountid)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiConnectionColumnTag); ok {
+		r0 = rf(ctx, db, connectionID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]NeosyncApiConnection)
+			r0 = ret.Get(0).([]NeosyncApiConnectionColumnTag)
 		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
-		r1 = rf(ctx, db, accountid)
+		r1 = rf(ctx, db, connectionID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1721,59 +2355,57 @@ func (_m *MockQuerier) GetConnectionsByAccount(ctx context.Context, db DBTX, acc
 	return r0, r1
 }
 
-// MockQuerier_GetConnectionsByAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionsByAccount'
-type MockQuerier_GetConnectionsByAccount_Call struct {
+// MockQuerier_GetColumnTagsByConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetColumnTagsByConnection'
+type MockQuerier_GetColumnTagsByConnection_Call struct {
 	*mock.Call
 }
 
-// GetConnectionsByAccount is a helper method to define mock.On call
+// GetColumnTagsByConnection is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - accountid pgtype.UUID
-func (_e *MockQuerier_Expecter) GetConnectionsByAccount(ctx interface{}, db interface{}, accountid interface{}) *MockQuerier_GetConnectionsByAccount_Call {
-	return &MockQuerier_GetConnectionsByAccount_Call{Call: _e.mock.On("GetConnectionsByAccount", ctx, db, accountid)}
+//   - connectionID pgtype.UUID
+func (_e *MockQuerier_Expecter) GetColumnTagsByConnection(ctx interface{}, db interface{}, connectionID interface{}) *MockQuerier_GetColumnTagsByConnection_Call {
+	return &MockQuerier_GetColumnTagsByConnection_Call{Call: _e.mock.On("GetColumnTagsByConnection", ctx, db, connectionID)}
 }
 
-func (_c *MockQuerier_GetConnectionsByAccount_Call) Run(run func(ctx context.Context, db DBTX, accountid pgtype.UUID)) *MockQuerier_GetConnectionsByAccount_Call {
+func (_c *MockQuerier_GetColumnTagsByConnection_Call) Run(run func(ctx context.Context, db DBTX, connectionID pgtype.UUID)) *MockQuerier_GetColumnTagsByConnection_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetConnectionsByAccount_Call) Return(_a0 []NeosyncApiConnection, _a1 error) *MockQuerier_GetConnectionsByAccount_Call {
+func (_c *MockQuerier_GetColumnTagsByConnection_Call) Return(_a0 []NeosyncApiConnectionColumnTag, _a1 error) *MockQuerier_GetColumnTagsByConnection_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetConnectionsByAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiConnection, error)) *MockQuerier_GetConnectionsByAccount_Call {
+func (_c *MockQuerier_GetColumnTagsByConnection_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiConnectionColumnTag, error)) *MockQuerier_GetColumnTagsByConnection_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetConnectionsByIds provides a mock function with given fields: ctx, db, dollar_1
-func (_m *MockQuerier) GetConnectionsByIds(ctx context.Context, db DBTX, dollar_1 []pgtype.UUID) ([]NeosyncApiConnection, error) {
-	ret := _m.Called(ctx, db, dollar_1)
+// GetConnectionById provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) GetConnectionById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiConnection, error) {
+	ret := _m.Called(ctx, db, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetConnectionsByIds")
+		panic("no return value specified for GetConnectionById")
 	}
 
-	var r0 []NeosyncApiConnection
+	var r0 NeosyncApiConnection
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, []pgtype.UUID) ([]NeosyncApiConnection, error)); ok {
-		return rf(ctx, db, dollar_1)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiConnection, error)); ok {
+		return rf(ctx, db, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, []pgtype.UUID) []NeosyncApiConnection); ok {
-		r0 = rf(ctx, db, dollar_1)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiConnection); ok {
+		r0 = rf(ctx, db, id)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]NeosyncApiConnection)
-		}
+		r0 = ret.Get(0).(NeosyncApiConnection)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, []pgtype.UUID) error); ok {
-		r1 = rf(ctx, db, dollar_1)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1781,57 +2413,57 @@ func (_m *MockQuerier) GetConnectionsByIds(ctx context.Context, db DBTX, dollar_
 	return r0, r1
 }
 
-// MockQuerier_GetConnectionsByIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionsByIds'
-type MockQuerier_GetConnectionsByIds_Call struct {
+// MockQuerier_GetConnectionById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionById'
+type MockQuerier_GetConnectionById_Call struct {
 	*mock.Call
 }
 
-// GetConnectionsByIds is a helper method to define mock.On call
+// GetConnectionById is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - dollar_1 []pgtype.UUID
-func (_e *MockQuerier_Expecter) GetConnectionsByIds(ctx interface{}, db interface{}, dollar_1 interface{}) *MockQuerier_GetConnectionsByIds_Call {
-	return &MockQuerier_GetConnectionsByIds_Call{Call: _e.mock.On("GetConnectionsByIds", ctx, db, dollar_1)}
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) GetConnectionById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetConnectionById_Call {
+	return &MockQuerier_GetConnectionById_Call{Call: _e.mock.On("GetConnectionById", ctx, db, id)}
 }
 
-func (_c *MockQuerier_GetConnectionsByIds_Call) Run(run func(ctx context.Context, db DBTX, dollar_1 []pgtype.UUID)) *MockQuerier_GetConnectionsByIds_Call {
+func (_c *MockQuerier_GetConnectionById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetConnectionById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].([]pgtype.UUID))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetConnectionsByIds_Call) Return(_a0 []NeosyncApiConnection, _a1 error) *MockQuerier_GetConnectionsByIds_Call {
+func (_c *MockQuerier_GetConnectionById_Call) Return(_a0 NeosyncApiConnection, _a1 error) *MockQuerier_GetConnectionById_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetConnectionsByIds_Call) RunAndReturn(run func(context.Context, DBTX, []pgtype.UUID) ([]NeosyncApiConnection, error)) *MockQuerier_GetConnectionsByIds_Call {
+func (_c *MockQuerier_GetConnectionById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiConnection, error)) *MockQuerier_GetConnectionById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetJobById provides a mock function with given fields: ctx, db, id
-func (_m *MockQuerier) GetJobById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiJob, error) {
-	ret := _m.Called(ctx, db, id)
-
+// GetConnectionByNameAndAccount provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetConnectionByNameAndAccount(ctx context.Context, db DBTX, arg GetConnectionByNameAndAccountParams) (NeosyncApiConnection, error) {
+	ret := _m.Called(ctx, db, arg)
+
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobById")
+		panic("no return value specified for GetConnectionByNameAndAccount")
 	}
 
-	var r0 NeosyncApiJob
+	var r0 NeosyncApiConnection
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiJob, error)); ok {
-		return rf(ctx, db, id)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetConnectionByNameAndAccountParams) (NeosyncApiConnection, error)); ok {
+		return rf(ctx, db, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiJob); ok {
-		r0 = rf(ctx, db, id)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetConnectionByNameAndAccountParams) NeosyncApiConnection); ok {
+		r0 = rf(ctx, db, arg)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiJob)
+		r0 = ret.Get(0).(NeosyncApiConnection)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
-		r1 = rf(ctx, db, id)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetConnectionByNameAndAccountParams) error); ok {
+		r1 = rf(ctx, db, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1839,57 +2471,59 @@ func (_m *MockQuerier) GetJobById(ctx context.Context, db DBTX, id pgtype.UUID)
 	return r0, r1
 }
 
-// MockQuerier_GetJobById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobById'
-type MockQuerier_GetJobById_Call struct {
+// MockQuerier_GetConnectionByNameAndAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionByNameAndAccount'
+type MockQuerier_GetConnectionByNameAndAccount_Call struct {
 	*mock.Call
 }
 
-// GetJobById is a helper method to define mock.On call
+// GetConnectionByNameAndAccount is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - id pgtype.UUID
-func (_e *MockQuerier_Expecter) GetJobById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetJobById_Call {
-	return &MockQuerier_GetJobById_Call{Call: _e.mock.On("GetJobById", ctx, db, id)}
+//   - arg GetConnectionByNameAndAccountParams
+func (_e *MockQuerier_Expecter) GetConnectionByNameAndAccount(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetConnectionByNameAndAccount_Call {
+	return &MockQuerier_GetConnectionByNameAndAccount_Call{Call: _e.mock.On("GetConnectionByNameAndAccount", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_GetJobById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetJobById_Call {
+func (_c *MockQuerier_GetConnectionByNameAndAccount_Call) Run(run func(ctx context.Context, db DBTX, arg GetConnectionByNameAndAccountParams)) *MockQuerier_GetConnectionByNameAndAccount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetConnectionByNameAndAccountParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetJobById_Call) Return(_a0 NeosyncApiJob, _a1 error) *MockQuerier_GetJobById_Call {
+func (_c *MockQuerier_GetConnectionByNameAndAccount_Call) Return(_a0 NeosyncApiConnection, _a1 error) *MockQuerier_GetConnectionByNameAndAccount_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetJobById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiJob, error)) *MockQuerier_GetJobById_Call {
+func (_c *MockQuerier_GetConnectionByNameAndAccount_Call) RunAndReturn(run func(context.Context, DBTX, GetConnectionByNameAndAccountParams) (NeosyncApiConnection, error)) *MockQuerier_GetConnectionByNameAndAccount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetJobByNameAndAccount provides a mock function with given fields: ctx, db, arg
-func (_m *MockQuerier) GetJobByNameAndAccount(ctx context.Context, db DBTX, arg GetJobByNameAndAccountParams) (NeosyncApiJob, error) {
-	ret := _m.Called(ctx, db, arg)
+// GetConnectionSavedQueriesByConnection provides a mock function with given fields: ctx, db, connectionID
+func (_m *MockQuerier) GetConnectionSavedQueriesByConnection(ctx context.Context, db DBTX, connectionID pgtype.UUID) ([]NeosyncApiConnectionSavedQuery, error) {
+	ret := _m.Called(ctx, db, connectionID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobByNameAndAccount")
+		panic("no return value specified for GetConnectionSavedQueriesByConnection")
 	}
 
-	var r0 NeosyncApiJob
+	var r0 []NeosyncApiConnectionSavedQuery
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobByNameAndAccountParams) (NeosyncApiJob, error)); ok {
-		return rf(ctx, db, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiConnectionSavedQuery, error)); ok {
+		return rf(ctx, db, connectionID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobByNameAndAccountParams) NeosyncApiJob); ok {
-		r0 = rf(ctx, db, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiConnectionSavedQuery); ok {
+		r0 = rf(ctx, db, connectionID)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiJob)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiConnectionSavedQuery)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetJobByNameAndAccountParams) error); ok {
-		r1 = rf(ctx, db, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, connectionID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1897,53 +2531,53 @@ func (_m *MockQuerier) GetJobByNameAndAccount(ctx context.Context, db DBTX, arg
 	return r0, r1
 }
 
-// MockQuerier_GetJobByNameAndAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobByNameAndAccount'
-type MockQuerier_GetJobByNameAndAccount_Call struct {
+// MockQuerier_GetConnectionSavedQueriesByConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionSavedQueriesByConnection'
+type MockQuerier_GetConnectionSavedQueriesByConnection_Call struct {
 	*mock.Call
 }
 
-// GetJobByNameAndAccount is a helper method to define mock.On call
+// GetConnectionSavedQueriesByConnection is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - arg GetJobByNameAndAccountParams
-func (_e *MockQuerier_Expecter) GetJobByNameAndAccount(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetJobByNameAndAccount_Call {
-	return &MockQuerier_GetJobByNameAndAccount_Call{Call: _e.mock.On("GetJobByNameAndAccount", ctx, db, arg)}
+//   - connectionID pgtype.UUID
+func (_e *MockQuerier_Expecter) GetConnectionSavedQueriesByConnection(ctx interface{}, db interface{}, connectionID interface{}) *MockQuerier_GetConnectionSavedQueriesByConnection_Call {
+	return &MockQuerier_GetConnectionSavedQueriesByConnection_Call{Call: _e.mock.On("GetConnectionSavedQueriesByConnection", ctx, db, connectionID)}
 }
 
-func (_c *MockQuerier_GetJobByNameAndAccount_Call) Run(run func(ctx context.Context, db DBTX, arg GetJobByNameAndAccountParams)) *MockQuerier_GetJobByNameAndAccount_Call {
+func (_c *MockQuerier_GetConnectionSavedQueriesByConnection_Call) Run(run func(ctx context.Context, db DBTX, connectionID pgtype.UUID)) *MockQuerier_GetConnectionSavedQueriesByConnection_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetJobByNameAndAccountParams))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetJobByNameAndAccount_Call) Return(_a0 NeosyncApiJob, _a1 error) *MockQuerier_GetJobByNameAndAccount_Call {
+func (_c *MockQuerier_GetConnectionSavedQueriesByConnection_Call) Return(_a0 []NeosyncApiConnectionSavedQuery, _a1 error) *MockQuerier_GetConnectionSavedQueriesByConnection_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetJobByNameAndAccount_Call) RunAndReturn(run func(context.Context, DBTX, GetJobByNameAndAccountParams) (NeosyncApiJob, error)) *MockQuerier_GetJobByNameAndAccount_Call {
+func (_c *MockQuerier_GetConnectionSavedQueriesByConnection_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiConnectionSavedQuery, error)) *MockQuerier_GetConnectionSavedQueriesByConnection_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetJobConnectionDestination provides a mock function with given fields: ctx, db, id
-func (_m *MockQuerier) GetJobConnectionDestination(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiJobDestinationConnectionAssociation, error) {
+// GetConnectionSavedQueryById provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) GetConnectionSavedQueryById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiConnectionSavedQuery, error) {
 	ret := _m.Called(ctx, db, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobConnectionDestination")
+		panic("no return value specified for GetConnectionSavedQueryById")
 	}
 
-	var r0 NeosyncApiJobDestinationConnectionAssociation
+	var r0 NeosyncApiConnectionSavedQuery
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiJobDestinationConnectionAssociation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiConnectionSavedQuery, error)); ok {
 		return rf(ctx, db, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiJobDestinationConnectionAssociation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiConnectionSavedQuery); ok {
 		r0 = rf(ctx, db, id)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiJobDestinationConnectionAssociation)
+		r0 = ret.Get(0).(NeosyncApiConnectionSavedQuery)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
@@ -1955,55 +2589,53 @@ func (_m *MockQuerier) GetJobConnectionDestination(ctx context.Context, db DBTX,
 	return r0, r1
 }
 
-// MockQuerier_GetJobConnectionDestination_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobConnectionDestination'
-type MockQuerier_GetJobConnectionDestination_Call struct {
+// MockQuerier_GetConnectionSavedQueryById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionSavedQueryById'
+type MockQuerier_GetConnectionSavedQueryById_Call struct {
 	*mock.Call
 }
 
-// GetJobConnectionDestination is a helper method to define mock.On call
+// GetConnectionSavedQueryById is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
 //   - id pgtype.UUID
-func (_e *MockQuerier_Expecter) GetJobConnectionDestination(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetJobConnectionDestination_Call {
-	return &MockQuerier_GetJobConnectionDestination_Call{Call: _e.mock.On("GetJobConnectionDestination", ctx, db, id)}
+func (_e *MockQuerier_Expecter) GetConnectionSavedQueryById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetConnectionSavedQueryById_Call {
+	return &MockQuerier_GetConnectionSavedQueryById_Call{Call: _e.mock.On("GetConnectionSavedQueryById", ctx, db, id)}
 }
 
-func (_c *MockQuerier_GetJobConnectionDestination_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetJobConnectionDestination_Call {
+func (_c *MockQuerier_GetConnectionSavedQueryById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetConnectionSavedQueryById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetJobConnectionDestination_Call) Return(_a0 NeosyncApiJobDestinationConnectionAssociation, _a1 error) *MockQuerier_GetJobConnectionDestination_Call {
+func (_c *MockQuerier_GetConnectionSavedQueryById_Call) Return(_a0 NeosyncApiConnectionSavedQuery, _a1 error) *MockQuerier_GetConnectionSavedQueryById_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetJobConnectionDestination_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiJobDestinationConnectionAssociation, error)) *MockQuerier_GetJobConnectionDestination_Call {
+func (_c *MockQuerier_GetConnectionSavedQueryById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiConnectionSavedQuery, error)) *MockQuerier_GetConnectionSavedQueryById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetJobConnectionDestinations provides a mock function with given fields: ctx, db, id
-func (_m *MockQuerier) GetJobConnectionDestinations(ctx context.Context, db DBTX, id pgtype.UUID) ([]NeosyncApiJobDestinationConnectionAssociation, error) {
+// GetConnectionTableBookmarkById provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) GetConnectionTableBookmarkById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiConnectionTableBookmark, error) {
 	ret := _m.Called(ctx, db, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobConnectionDestinations")
+		panic("no return value specified for GetConnectionTableBookmarkById")
 	}
 
-	var r0 []NeosyncApiJobDestinationConnectionAssociation
+	var r0 NeosyncApiConnectionTableBookmark
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJobDestinationConnectionAssociation, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiConnectionTableBookmark, error)); ok {
 		return rf(ctx, db, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiJobDestinationConnectionAssociation); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiConnectionTableBookmark); ok {
 		r0 = rf(ctx, db, id)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]NeosyncApiJobDestinationConnectionAssociation)
-		}
+		r0 = ret.Get(0).(NeosyncApiConnectionTableBookmark)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
@@ -2015,59 +2647,59 @@ func (_m *MockQuerier) GetJobConnectionDestinations(ctx context.Context, db DBTX
 	return r0, r1
 }
 
-// MockQuerier_GetJobConnectionDestinations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobConnectionDestinations'
-type MockQuerier_GetJobConnectionDestinations_Call struct {
+// MockQuerier_GetConnectionTableBookmarkById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionTableBookmarkById'
+type MockQuerier_GetConnectionTableBookmarkById_Call struct {
 	*mock.Call
 }
 
-// GetJobConnectionDestinations is a helper method to define mock.On call
+// GetConnectionTableBookmarkById is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
 //   - id pgtype.UUID
-func (_e *MockQuerier_Expecter) GetJobConnectionDestinations(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetJobConnectionDestinations_Call {
-	return &MockQuerier_GetJobConnectionDestinations_Call{Call: _e.mock.On("GetJobConnectionDestinations", ctx, db, id)}
+func (_e *MockQuerier_Expecter) GetConnectionTableBookmarkById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetConnectionTableBookmarkById_Call {
+	return &MockQuerier_GetConnectionTableBookmarkById_Call{Call: _e.mock.On("GetConnectionTableBookmarkById", ctx, db, id)}
 }
 
-func (_c *MockQuerier_GetJobConnectionDestinations_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetJobConnectionDestinations_Call {
+func (_c *MockQuerier_GetConnectionTableBookmarkById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetConnectionTableBookmarkById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetJobConnectionDestinations_Call) Return(_a0 []NeosyncApiJobDestinationConnectionAssociation, _a1 error) *MockQuerier_GetJobConnectionDestinations_Call {
+func (_c *MockQuerier_GetConnectionTableBookmarkById_Call) Return(_a0 NeosyncApiConnectionTableBookmark, _a1 error) *MockQuerier_GetConnectionTableBookmarkById_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetJobConnectionDestinations_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJobDestinationConnectionAssociation, error)) *MockQuerier_GetJobConnectionDestinations_Call {
+func (_c *MockQuerier_GetConnectionTableBookmarkById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiConnectionTableBookmark, error)) *MockQuerier_GetConnectionTableBookmarkById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetJobConnectionDestinationsByJobIds provides a mock function with given fields: ctx, db, jobids
-func (_m *MockQuerier) GetJobConnectionDestinationsByJobIds(ctx context.Context, db DBTX, jobids []pgtype.UUID) ([]NeosyncApiJobDestinationConnectionAssociation, error) {
-	ret := _m.Called(ctx, db, jobids)
+// GetConnectionTableBookmarksByConnection provides a mock function with given fields: ctx, db, connectionID
+func (_m *MockQuerier) GetConnectionTableBookmarksByConnection(ctx context.Context, db DBTX, connectionID pgtype.UUID) ([]NeosyncApiConnectionTableBookmark, error) {
+	ret := _m.Called(ctx, db, connectionID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobConnectionDestinationsByJobIds")
+		panic("no return value specified for GetConnectionTableBookmarksByConnection")
 	}
 
-	var r0 []NeosyncApiJobDestinationConnectionAssociation
+	var r0 []NeosyncApiConnectionTableBookmark
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, []pgtype.UUID) ([]NeosyncApiJobDestinationConnectionAssociation, error)); ok {
-		return rf(ctx, db, jobids)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiConnectionTableBookmark, error)); ok {
+		return rf(ctx, db, connectionID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, []pgtype.UUID) []NeosyncApiJobDestinationConnectionAssociation); ok {
-		r0 = rf(ctx, db, jobids)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiConnectionTableBookmark); ok {
+		r0 = rf(ctx, db, connectionID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]NeosyncApiJobDestinationConnectionAssociation)
+			r0 = ret.Get(0).([]NeosyncApiConnectionTableBookmark)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, []pgtype.UUID) error); ok {
-		r1 = rf(ctx, db, jobids)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, connectionID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2075,54 +2707,54 @@ func (_m *MockQuerier) GetJobConnectionDestinationsByJobIds(ctx context.Context,
 	return r0, r1
 }
 
-// MockQuerier_GetJobConnectionDestinationsByJobIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobConnectionDestinationsByJobIds'
-type MockQuerier_GetJobConnectionDestinationsByJobIds_Call struct {
+// MockQuerier_GetConnectionTableBookmarksByConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionTableBookmarksByConnection'
+type MockQuerier_GetConnectionTableBookmarksByConnection_Call struct {
 	*mock.Call
 }
 
-// GetJobConnectionDestinationsByJobIds is a helper method to define mock.On call
+// GetConnectionTableBookmarksByConnection is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - jobids []pgtype.UUID
-func (_e *MockQuerier_Expecter) GetJobConnectionDestinationsByJobIds(ctx interface{}, db interface{}, jobids interface{}) *MockQuerier_GetJobConnectionDestinationsByJobIds_Call {
-	return &MockQuerier_GetJobConnectionDestinationsByJobIds_Call{Call: _e.mock.On("GetJobConnectionDestinationsByJobIds", ctx, db, jobids)}
+//   - connectionID pgtype.UUID
+func (_e *MockQuerier_Expecter) GetConnectionTableBookmarksByConnection(ctx interface{}, db interface{}, connectionID interface{}) *MockQuerier_GetConnectionTableBookmarksByConnection_Call {
+	return &MockQuerier_GetConnectionTableBookmarksByConnection_Call{Call: _e.mock.On("GetConnectionTableBookmarksByConnection", ctx, db, connectionID)}
 }
 
-func (_c *MockQuerier_GetJobConnectionDestinationsByJobIds_Call) Run(run func(ctx context.Context, db DBTX, jobids []pgtype.UUID)) *MockQuerier_GetJobConnectionDestinationsByJobIds_Call {
+func (_c *MockQuerier_GetConnectionTableBookmarksByConnection_Call) Run(run func(ctx context.Context, db DBTX, connectionID pgtype.UUID)) *MockQuerier_GetConnectionTableBookmarksByConnection_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].([]pgtype.UUID))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetJobConnectionDestinationsByJobIds_Call) Return(_a0 []NeosyncApiJobDestinationConnectionAssociation, _a1 error) *MockQuerier_GetJobConnectionDestinationsByJobIds_Call {
+func (_c *MockQuerier_GetConnectionTableBookmarksByConnection_Call) Return(_a0 []NeosyncApiConnectionTableBookmark, _a1 error) *MockQuerier_GetConnectionTableBookmarksByConnection_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetJobConnectionDestinationsByJobIds_Call) RunAndReturn(run func(context.Context, DBTX, []pgtype.UUID) ([]NeosyncApiJobDestinationConnectionAssociation, error)) *MockQuerier_GetJobConnectionDestinationsByJobIds_Call {
+func (_c *MockQuerier_GetConnectionTableBookmarksByConnection_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiConnectionTableBookmark, error)) *MockQuerier_GetConnectionTableBookmarksByConnection_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetJobsByAccount provides a mock function with given fields: ctx, db, accountid
-func (_m *MockQuerier) GetJobsByAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiJob, error) {
+// GetConnectionsByAccount provides a mock function with given fields: ctx, db, accountid
+func (_m *MockQuerier) GetConnectionsByAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiConnection, error) {
 	ret := _m.Called(ctx, db, accountid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobsByAccount")
+		panic("no return value specified for GetConnectionsByAccount")
 	}
 
-	var r0 []NeosyncApiJob
+	var r0 []NeosyncApiConnection
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJob, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiConnection, error)); ok {
 		return rf(ctx, db, accountid)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiJob); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiConnection); ok {
 		r0 = rf(ctx, db, accountid)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]NeosyncApiJob)
+			r0 = ret.Get(0).([]NeosyncApiConnection)
 		}
 	}
 
@@ -2135,57 +2767,59 @@ func (_m *MockQuerier) GetJobsByAccount(ctx context.Context, db DBTX, accountid
 	return r0, r1
 }
 
-// MockQuerier_GetJobsByAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobsByAccount'
-type MockQuerier_GetJobsByAccount_Call struct {
+// MockQuerier_GetConnectionsByAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionsByAccount'
+type MockQuerier_GetConnectionsByAccount_Call struct {
 	*mock.Call
 }
 
-// GetJobsByAccount is a helper method to define mock.On call
+// GetConnectionsByAccount is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
 //   - accountid pgtype.UUID
-func (_e *MockQuerier_Expecter) GetJobsByAccount(ctx interface{}, db interface{}, accountid interface{}) *MockQuerier_GetJobsByAccount_Call {
-	return &MockQuerier_GetJobsByAccount_Call{Call: _e.mock.On("GetJobsByAccount", ctx, db, accountid)}
+func (_e *MockQuerier_Expecter) GetConnectionsByAccount(ctx interface{}, db interface{}, accountid interface{}) *MockQuerier_GetConnectionsByAccount_Call {
+	return &MockQuerier_GetConnectionsByAccount_Call{Call: _e.mock.On("GetConnectionsByAccount", ctx, db, accountid)}
 }
 
-func (_c *MockQuerier_GetJobsByAccount_Call) Run(run func(ctx context.Context, db DBTX, accountid pgtype.UUID)) *MockQuerier_GetJobsByAccount_Call {
+func (_c *MockQuerier_GetConnectionsByAccount_Call) Run(run func(ctx context.Context, db DBTX, accountid pgtype.UUID)) *MockQuerier_GetConnectionsByAccount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetJobsByAccount_Call) Return(_a0 []NeosyncApiJob, _a1 error) *MockQuerier_GetJobsByAccount_Call {
+func (_c *MockQuerier_GetConnectionsByAccount_Call) Return(_a0 []NeosyncApiConnection, _a1 error) *MockQuerier_GetConnectionsByAccount_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetJobsByAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJob, error)) *MockQuerier_GetJobsByAccount_Call {
+func (_c *MockQuerier_GetConnectionsByAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiConnection, error)) *MockQuerier_GetConnectionsByAccount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPersonalAccountByUserId provides a mock function with given fields: ctx, db, userid
-func (_m *MockQuerier) GetPersonalAccountByUserId(ctx context.Context, db DBTX, userid pgtype.UUID) (NeosyncApiAccount, error) {
-	ret := _m.Called(ctx, db, userid)
+// GetConnectionsByIds provides a mock function with given fields: ctx, db, dollar_1
+func (_m *MockQuerier) GetConnectionsByIds(ctx context.Context, db DBTX, dollar_1 []pgtype.UUID) ([]NeosyncApiConnection, error) {
+	ret := _m.Called(ctx, db, dollar_1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPersonalAccountByUserId")
+		panic("no return value specified for GetConnectionsByIds")
 	}
 
-	var r0 NeosyncApiAccount
+	var r0 []NeosyncApiConnection
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiAccount, error)); ok {
-		return rf(ctx, db, userid)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, []pgtype.UUID) ([]NeosyncApiConnection, error)); ok {
+		return rf(ctx, db, dollar_1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiAccount); ok {
-		r0 = rf(ctx, db, userid)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, []pgtype.UUID) []NeosyncApiConnection); ok {
+		r0 = rf(ctx, db, dollar_1)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiAccount)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiConnection)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
-		r1 = rf(ctx, db, userid)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, []pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, dollar_1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2193,59 +2827,57 @@ func (_m *MockQuerier) GetPersonalAccountByUserId(ctx context.Context, db DBTX,
 	return r0, r1
 }
 
-// MockQuerier_GetPersonalAccountByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPersonalAccountByUserId'
-type MockQuerier_GetPersonalAccountByUserId_Call struct {
+// MockQuerier_GetConnectionsByIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionsByIds'
+type MockQuerier_GetConnectionsByIds_Call struct {
 	*mock.Call
 }
 
-// GetPersonalAccountByUserId is a helper method to define mock.On call
+// GetConnectionsByIds is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - userid pgtype.UUID
-func (_e *MockQuerier_Expecter) GetPersonalAccountByUserId(ctx interface{}, db interface{}, userid interface{}) *MockQuerier_GetPersonalAccountByUserId_Call {
-	return &MockQuerier_GetPersonalAccountByUserId_Call{Call: _e.mock.On("GetPersonalAccountByUserId", ctx, db, userid)}
+//   - dollar_1 []pgtype.UUID
+func (_e *MockQuerier_Expecter) GetConnectionsByIds(ctx interface{}, db interface{}, dollar_1 interface{}) *MockQuerier_GetConnectionsByIds_Call {
+	return &MockQuerier_GetConnectionsByIds_Call{Call: _e.mock.On("GetConnectionsByIds", ctx, db, dollar_1)}
 }
 
-func (_c *MockQuerier_GetPersonalAccountByUserId_Call) Run(run func(ctx context.Context, db DBTX, userid pgtype.UUID)) *MockQuerier_GetPersonalAccountByUserId_Call {
+func (_c *MockQuerier_GetConnectionsByIds_Call) Run(run func(ctx context.Context, db DBTX, dollar_1 []pgtype.UUID)) *MockQuerier_GetConnectionsByIds_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].([]pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetPersonalAccountByUserId_Call) Return(_a0 NeosyncApiAccount, _a1 error) *MockQuerier_GetPersonalAccountByUserId_Call {
+func (_c *MockQuerier_GetConnectionsByIds_Call) Return(_a0 []NeosyncApiConnection, _a1 error) *MockQuerier_GetConnectionsByIds_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetPersonalAccountByUserId_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiAccount, error)) *MockQuerier_GetPersonalAccountByUserId_Call {
+func (_c *MockQuerier_GetConnectionsByIds_Call) RunAndReturn(run func(context.Context, DBTX, []pgtype.UUID) ([]NeosyncApiConnection, error)) *MockQuerier_GetConnectionsByIds_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTeamAccountsByUserId provides a mock function with given fields: ctx, db, userid
-func (_m *MockQuerier) GetTeamAccountsByUserId(ctx context.Context, db DBTX, userid pgtype.UUID) ([]NeosyncApiAccount, error) {
-	ret := _m.Called(ctx, db, userid)
+// GetIdempotencyKey provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetIdempotencyKey(ctx context.Context, db DBTX, arg GetIdempotencyKeyParams) (NeosyncApiIdempotencyKey, error) {
+	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTeamAccountsByUserId")
+		panic("no return value specified for GetIdempotencyKey")
 	}
 
-	var r0 []NeosyncApiAccount
+	var r0 NeosyncApiIdempotencyKey
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiAccount, error)); ok {
-		return rf(ctx, db, userid)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetIdempotencyKeyParams) (NeosyncApiIdempotencyKey, error)); ok {
+		return rf(ctx, db, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiAccount); ok {
-		r0 = rf(ctx, db, userid)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetIdempotencyKeyParams) NeosyncApiIdempotencyKey); ok {
+		r0 = rf(ctx, db, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]NeosyncApiAccount)
-		}
+		r0 = ret.Get(0).(NeosyncApiIdempotencyKey)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
-		r1 = rf(ctx, db, userid)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetIdempotencyKeyParams) error); ok {
+		r1 = rf(ctx, db, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2253,59 +2885,59 @@ func (_m *MockQuerier) GetTeamAccountsByUserId(ctx context.Context, db DBTX, use
 	return r0, r1
 }
 
-// MockQuerier_GetTeamAccountsByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamAccountsByUserId'
-type MockQuerier_GetTeamAccountsByUserId_Call struct {
+// MockQuerier_GetIdempotencyKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIdempotencyKey'
+type MockQuerier_GetIdempotencyKey_Call struct {
 	*mock.Call
 }
 
-// GetTeamAccountsByUserId is a helper method to define mock.On call
+// GetIdempotencyKey is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - userid pgtype.UUID
-func (_e *MockQuerier_Expecter) GetTeamAccountsByUserId(ctx interface{}, db interface{}, userid interface{}) *MockQuerier_GetTeamAccountsByUserId_Call {
-	return &MockQuerier_GetTeamAccountsByUserId_Call{Call: _e.mock.On("GetTeamAccountsByUserId", ctx, db, userid)}
+//   - arg GetIdempotencyKeyParams
+func (_e *MockQuerier_Expecter) GetIdempotencyKey(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetIdempotencyKey_Call {
+	return &MockQuerier_GetIdempotencyKey_Call{Call: _e.mock.On("GetIdempotencyKey", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_GetTeamAccountsByUserId_Call) Run(run func(ctx context.Context, db DBTX, userid pgtype.UUID)) *MockQuerier_GetTeamAccountsByUserId_Call {
+func (_c *MockQuerier_GetIdempotencyKey_Call) Run(run func(ctx context.Context, db DBTX, arg GetIdempotencyKeyParams)) *MockQuerier_GetIdempotencyKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetIdempotencyKeyParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetTeamAccountsByUserId_Call) Return(_a0 []NeosyncApiAccount, _a1 error) *MockQuerier_GetTeamAccountsByUserId_Call {
+func (_c *MockQuerier_GetIdempotencyKey_Call) Return(_a0 NeosyncApiIdempotencyKey, _a1 error) *MockQuerier_GetIdempotencyKey_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetTeamAccountsByUserId_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiAccount, error)) *MockQuerier_GetTeamAccountsByUserId_Call {
+func (_c *MockQuerier_GetIdempotencyKey_Call) RunAndReturn(run func(context.Context, DBTX, GetIdempotencyKeyParams) (NeosyncApiIdempotencyKey, error)) *MockQuerier_GetIdempotencyKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTemporalConfigByAccount provides a mock function with given fields: ctx, db, id
-func (_m *MockQuerier) GetTemporalConfigByAccount(ctx context.Context, db DBTX, id pgtype.UUID) (*pg_models.TemporalConfig, error) {
-	ret := _m.Called(ctx, db, id)
+// GetJavascriptLibrariesByAccount provides a mock function with given fields: ctx, db, accountid
+func (_m *MockQuerier) GetJavascriptLibrariesByAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiJavascriptLibrary, error) {
+	ret := _m.Called(ctx, db, accountid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTemporalConfigByAccount")
+		panic("no return value specified for GetJavascriptLibrariesByAccount")
 	}
 
-	var r0 *pg_models.TemporalConfig
+	var r0 []NeosyncApiJavascriptLibrary
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (*pg_models.TemporalConfig, error)); ok {
-		return rf(ctx, db, id)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJavascriptLibrary, error)); ok {
+		return rf(ctx, db, accountid)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) *pg_models.TemporalConfig); ok {
-		r0 = rf(ctx, db, id)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiJavascriptLibrary); ok {
+		r0 = rf(ctx, db, accountid)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pg_models.TemporalConfig)
+			r0 = ret.Get(0).([]NeosyncApiJavascriptLibrary)
 		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
-		r1 = rf(ctx, db, id)
+		r1 = rf(ctx, db, accountid)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2313,59 +2945,59 @@ func (_m *MockQuerier) GetTemporalConfigByAccount(ctx context.Context, db DBTX,
 	return r0, r1
 }
 
-// MockQuerier_GetTemporalConfigByAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTemporalConfigByAccount'
-type MockQuerier_GetTemporalConfigByAccount_Call struct {
+// MockQuerier_GetJavascriptLibrariesByAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJavascriptLibrariesByAccount'
+type MockQuerier_GetJavascriptLibrariesByAccount_Call struct {
 	*mock.Call
 }
 
-// GetTemporalConfigByAccount is a helper method to define mock.On call
+// GetJavascriptLibrariesByAccount is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - id pgtype.UUID
-func (_e *MockQuerier_Expecter) GetTemporalConfigByAccount(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetTemporalConfigByAccount_Call {
-	return &MockQuerier_GetTemporalConfigByAccount_Call{Call: _e.mock.On("GetTemporalConfigByAccount", ctx, db, id)}
+//   - accountid pgtype.UUID
+func (_e *MockQuerier_Expecter) GetJavascriptLibrariesByAccount(ctx interface{}, db interface{}, accountid interface{}) *MockQuerier_GetJavascriptLibrariesByAccount_Call {
+	return &MockQuerier_GetJavascriptLibrariesByAccount_Call{Call: _e.mock.On("GetJavascriptLibrariesByAccount", ctx, db, accountid)}
 }
 
-func (_c *MockQuerier_GetTemporalConfigByAccount_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetTemporalConfigByAccount_Call {
+func (_c *MockQuerier_GetJavascriptLibrariesByAccount_Call) Run(run func(ctx context.Context, db DBTX, accountid pgtype.UUID)) *MockQuerier_GetJavascriptLibrariesByAccount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetTemporalConfigByAccount_Call) Return(_a0 *pg_models.TemporalConfig, _a1 error) *MockQuerier_GetTemporalConfigByAccount_Call {
+func (_c *MockQuerier_GetJavascriptLibrariesByAccount_Call) Return(_a0 []NeosyncApiJavascriptLibrary, _a1 error) *MockQuerier_GetJavascriptLibrariesByAccount_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetTemporalConfigByAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (*pg_models.TemporalConfig, error)) *MockQuerier_GetTemporalConfigByAccount_Call {
+func (_c *MockQuerier_GetJavascriptLibrariesByAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJavascriptLibrary, error)) *MockQuerier_GetJavascriptLibrariesByAccount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTemporalConfigByUserAccount provides a mock function with given fields: ctx, db, arg
-func (_m *MockQuerier) GetTemporalConfigByUserAccount(ctx context.Context, db DBTX, arg GetTemporalConfigByUserAccountParams) (*pg_models.TemporalConfig, error) {
-	ret := _m.Called(ctx, db, arg)
+// GetJobAccountIds provides a mock function with given fields: ctx, db
+func (_m *MockQuerier) GetJobAccountIds(ctx context.Context, db DBTX) ([]pgtype.UUID, error) {
+	ret := _m.Called(ctx, db)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTemporalConfigByUserAccount")
+		panic("no return value specified for GetJobAccountIds")
 	}
 
-	var r0 *pg_models.TemporalConfig
+	var r0 []pgtype.UUID
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetTemporalConfigByUserAccountParams) (*pg_models.TemporalConfig, error)); ok {
-		return rf(ctx, db, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX) ([]pgtype.UUID, error)); ok {
+		return rf(ctx, db)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetTemporalConfigByUserAccountParams) *pg_models.TemporalConfig); ok {
-		r0 = rf(ctx, db, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX) []pgtype.UUID); ok {
+		r0 = rf(ctx, db)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pg_models.TemporalConfig)
+			r0 = ret.Get(0).([]pgtype.UUID)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetTemporalConfigByUserAccountParams) error); ok {
-		r1 = rf(ctx, db, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX) error); ok {
+		r1 = rf(ctx, db)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2373,53 +3005,52 @@ func (_m *MockQuerier) GetTemporalConfigByUserAccount(ctx context.Context, db DB
 	return r0, r1
 }
 
-// MockQuerier_GetTemporalConfigByUserAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTemporalConfigByUserAccount'
-type MockQuerier_GetTemporalConfigByUserAccount_Call struct {
+// MockQuerier_GetJobAccountIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobAccountIds'
+type MockQuerier_GetJobAccountIds_Call struct {
 	*mock.Call
 }
 
-// GetTemporalConfigByUserAccount is a helper method to define mock.On call
+// GetJobAccountIds is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - arg GetTemporalConfigByUserAccountParams
-func (_e *MockQuerier_Expecter) GetTemporalConfigByUserAccount(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetTemporalConfigByUserAccount_Call {
-	return &MockQuerier_GetTemporalConfigByUserAccount_Call{Call: _e.mock.On("GetTemporalConfigByUserAccount", ctx, db, arg)}
+func (_e *MockQuerier_Expecter) GetJobAccountIds(ctx interface{}, db interface{}) *MockQuerier_GetJobAccountIds_Call {
+	return &MockQuerier_GetJobAccountIds_Call{Call: _e.mock.On("GetJobAccountIds", ctx, db)}
 }
 
-func (_c *MockQuerier_GetTemporalConfigByUserAccount_Call) Run(run func(ctx context.Context, db DBTX, arg GetTemporalConfigByUserAccountParams)) *MockQuerier_GetTemporalConfigByUserAccount_Call {
+func (_c *MockQuerier_GetJobAccountIds_Call) Run(run func(ctx context.Context, db DBTX)) *MockQuerier_GetJobAccountIds_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetTemporalConfigByUserAccountParams))
+		run(args[0].(context.Context), args[1].(DBTX))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetTemporalConfigByUserAccount_Call) Return(_a0 *pg_models.TemporalConfig, _a1 error) *MockQuerier_GetTemporalConfigByUserAccount_Call {
+func (_c *MockQuerier_GetJobAccountIds_Call) Return(_a0 []pgtype.UUID, _a1 error) *MockQuerier_GetJobAccountIds_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetTemporalConfigByUserAccount_Call) RunAndReturn(run func(context.Context, DBTX, GetTemporalConfigByUserAccountParams) (*pg_models.TemporalConfig, error)) *MockQuerier_GetTemporalConfigByUserAccount_Call {
+func (_c *MockQuerier_GetJobAccountIds_Call) RunAndReturn(run func(context.Context, DBTX) ([]pgtype.UUID, error)) *MockQuerier_GetJobAccountIds_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUser provides a mock function with given fields: ctx, db, id
-func (_m *MockQuerier) GetUser(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiUser, error) {
+// GetJobById provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) GetJobById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiJob, error) {
 	ret := _m.Called(ctx, db, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUser")
+		panic("no return value specified for GetJobById")
 	}
 
-	var r0 NeosyncApiUser
+	var r0 NeosyncApiJob
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiUser, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiJob, error)); ok {
 		return rf(ctx, db, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiUser); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiJob); ok {
 		r0 = rf(ctx, db, id)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiUser)
+		r0 = ret.Get(0).(NeosyncApiJob)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
@@ -2431,57 +3062,57 @@ func (_m *MockQuerier) GetUser(ctx context.Context, db DBTX, id pgtype.UUID) (Ne
 	return r0, r1
 }
 
-// MockQuerier_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
-type MockQuerier_GetUser_Call struct {
+// MockQuerier_GetJobById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobById'
+type MockQuerier_GetJobById_Call struct {
 	*mock.Call
 }
 
-// GetUser is a helper method to define mock.On call
+// GetJobById is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
 //   - id pgtype.UUID
-func (_e *MockQuerier_Expecter) GetUser(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetUser_Call {
-	return &MockQuerier_GetUser_Call{Call: _e.mock.On("GetUser", ctx, db, id)}
+func (_e *MockQuerier_Expecter) GetJobById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetJobById_Call {
+	return &MockQuerier_GetJobById_Call{Call: _e.mock.On("GetJobById", ctx, db, id)}
 }
 
-func (_c *MockQuerier_GetUser_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetUser_Call {
+func (_c *MockQuerier_GetJobById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetJobById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetUser_Call) Return(_a0 NeosyncApiUser, _a1 error) *MockQuerier_GetUser_Call {
+func (_c *MockQuerier_GetJobById_Call) Return(_a0 NeosyncApiJob, _a1 error) *MockQuerier_GetJobById_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetUser_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiUser, error)) *MockQuerier_GetUser_Call {
+func (_c *MockQuerier_GetJobById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiJob, error)) *MockQuerier_GetJobById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserAssociationByProviderSub provides a mock function with given fields: ctx, db, providerSub
-func (_m *MockQuerier) GetUserAssociationByProviderSub(ctx context.Context, db DBTX, providerSub string) (NeosyncApiUserIdentityProviderAssociation, error) {
-	ret := _m.Called(ctx, db, providerSub)
+// GetJobByIdForUpdate provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) GetJobByIdForUpdate(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiJob, error) {
+	ret := _m.Called(ctx, db, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserAssociationByProviderSub")
+		panic("no return value specified for GetJobByIdForUpdate")
 	}
 
-	var r0 NeosyncApiUserIdentityProviderAssociation
+	var r0 NeosyncApiJob
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, string) (NeosyncApiUserIdentityProviderAssociation, error)); ok {
-		return rf(ctx, db, providerSub)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiJob, error)); ok {
+		return rf(ctx, db, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, string) NeosyncApiUserIdentityProviderAssociation); ok {
-		r0 = rf(ctx, db, providerSub)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiJob); ok {
+		r0 = rf(ctx, db, id)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiUserIdentityProviderAssociation)
+		r0 = ret.Get(0).(NeosyncApiJob)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, string) error); ok {
-		r1 = rf(ctx, db, providerSub)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2489,57 +3120,57 @@ func (_m *MockQuerier) GetUserAssociationByProviderSub(ctx context.Context, db D
 	return r0, r1
 }
 
-// MockQuerier_GetUserAssociationByProviderSub_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserAssociationByProviderSub'
-type MockQuerier_GetUserAssociationByProviderSub_Call struct {
+// MockQuerier_GetJobByIdForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobByIdForUpdate'
+type MockQuerier_GetJobByIdForUpdate_Call struct {
 	*mock.Call
 }
 
-// GetUserAssociationByProviderSub is a helper method to define mock.On call
+// GetJobByIdForUpdate is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - providerSub string
-func (_e *MockQuerier_Expecter) GetUserAssociationByProviderSub(ctx interface{}, db interface{}, providerSub interface{}) *MockQuerier_GetUserAssociationByProviderSub_Call {
-	return &MockQuerier_GetUserAssociationByProviderSub_Call{Call: _e.mock.On("GetUserAssociationByProviderSub", ctx, db, providerSub)}
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) GetJobByIdForUpdate(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetJobByIdForUpdate_Call {
+	return &MockQuerier_GetJobByIdForUpdate_Call{Call: _e.mock.On("GetJobByIdForUpdate", ctx, db, id)}
 }
 
-func (_c *MockQuerier_GetUserAssociationByProviderSub_Call) Run(run func(ctx context.Context, db DBTX, providerSub string)) *MockQuerier_GetUserAssociationByProviderSub_Call {
+func (_c *MockQuerier_GetJobByIdForUpdate_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetJobByIdForUpdate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(string))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetUserAssociationByProviderSub_Call) Return(_a0 NeosyncApiUserIdentityProviderAssociation, _a1 error) *MockQuerier_GetUserAssociationByProviderSub_Call {
+func (_c *MockQuerier_GetJobByIdForUpdate_Call) Return(_a0 NeosyncApiJob, _a1 error) *MockQuerier_GetJobByIdForUpdate_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetUserAssociationByProviderSub_Call) RunAndReturn(run func(context.Context, DBTX, string) (NeosyncApiUserIdentityProviderAssociation, error)) *MockQuerier_GetUserAssociationByProviderSub_Call {
+func (_c *MockQuerier_GetJobByIdForUpdate_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiJob, error)) *MockQuerier_GetJobByIdForUpdate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserByProviderSub provides a mock function with given fields: ctx, db, providerSub
-func (_m *MockQuerier) GetUserByProviderSub(ctx context.Context, db DBTX, providerSub string) (NeosyncApiUser, error) {
-	ret := _m.Called(ctx, db, providerSub)
+// GetJobByNameAndAccount provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetJobByNameAndAccount(ctx context.Context, db DBTX, arg GetJobByNameAndAccountParams) (NeosyncApiJob, error) {
+	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserByProviderSub")
+		panic("no return value specified for GetJobByNameAndAccount")
 	}
 
-	var r0 NeosyncApiUser
+	var r0 NeosyncApiJob
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, string) (NeosyncApiUser, error)); ok {
-		return rf(ctx, db, providerSub)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobByNameAndAccountParams) (NeosyncApiJob, error)); ok {
+		return rf(ctx, db, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, string) NeosyncApiUser); ok {
-		r0 = rf(ctx, db, providerSub)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobByNameAndAccountParams) NeosyncApiJob); ok {
+		r0 = rf(ctx, db, arg)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiUser)
+		r0 = ret.Get(0).(NeosyncApiJob)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, string) error); ok {
-		r1 = rf(ctx, db, providerSub)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetJobByNameAndAccountParams) error); ok {
+		r1 = rf(ctx, db, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2547,53 +3178,53 @@ func (_m *MockQuerier) GetUserByProviderSub(ctx context.Context, db DBTX, provid
 	return r0, r1
 }
 
-// MockQuerier_GetUserByProviderSub_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByProviderSub'
-type MockQuerier_GetUserByProviderSub_Call struct {
+// MockQuerier_GetJobByNameAndAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobByNameAndAccount'
+type MockQuerier_GetJobByNameAndAccount_Call struct {
 	*mock.Call
 }
 
-// GetUserByProviderSub is a helper method to define mock.On call
+// GetJobByNameAndAccount is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - providerSub string
-func (_e *MockQuerier_Expecter) GetUserByProviderSub(ctx interface{}, db interface{}, providerSub interface{}) *MockQuerier_GetUserByProviderSub_Call {
-	return &MockQuerier_GetUserByProviderSub_Call{Call: _e.mock.On("GetUserByProviderSub", ctx, db, providerSub)}
+//   - arg GetJobByNameAndAccountParams
+func (_e *MockQuerier_Expecter) GetJobByNameAndAccount(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetJobByNameAndAccount_Call {
+	return &MockQuerier_GetJobByNameAndAccount_Call{Call: _e.mock.On("GetJobByNameAndAccount", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_GetUserByProviderSub_Call) Run(run func(ctx context.Context, db DBTX, providerSub string)) *MockQuerier_GetUserByProviderSub_Call {
+func (_c *MockQuerier_GetJobByNameAndAccount_Call) Run(run func(ctx context.Context, db DBTX, arg GetJobByNameAndAccountParams)) *MockQuerier_GetJobByNameAndAccount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(string))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetJobByNameAndAccountParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetUserByProviderSub_Call) Return(_a0 NeosyncApiUser, _a1 error) *MockQuerier_GetUserByProviderSub_Call {
+func (_c *MockQuerier_GetJobByNameAndAccount_Call) Return(_a0 NeosyncApiJob, _a1 error) *MockQuerier_GetJobByNameAndAccount_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetUserByProviderSub_Call) RunAndReturn(run func(context.Context, DBTX, string) (NeosyncApiUser, error)) *MockQuerier_GetUserByProviderSub_Call {
+func (_c *MockQuerier_GetJobByNameAndAccount_Call) RunAndReturn(run func(context.Context, DBTX, GetJobByNameAndAccountParams) (NeosyncApiJob, error)) *MockQuerier_GetJobByNameAndAccount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserDefinedTransformerById provides a mock function with given fields: ctx, db, id
-func (_m *MockQuerier) GetUserDefinedTransformerById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiTransformer, error) {
+// GetJobChangeRequestById provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) GetJobChangeRequestById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiJobChangeRequest, error) {
 	ret := _m.Called(ctx, db, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserDefinedTransformerById")
+		panic("no return value specified for GetJobChangeRequestById")
 	}
 
-	var r0 NeosyncApiTransformer
+	var r0 NeosyncApiJobChangeRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiTransformer, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiJobChangeRequest, error)); ok {
 		return rf(ctx, db, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiTransformer); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiJobChangeRequest); ok {
 		r0 = rf(ctx, db, id)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiTransformer)
+		r0 = ret.Get(0).(NeosyncApiJobChangeRequest)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
@@ -2605,59 +3236,59 @@ func (_m *MockQuerier) GetUserDefinedTransformerById(ctx context.Context, db DBT
 	return r0, r1
 }
 
-// MockQuerier_GetUserDefinedTransformerById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserDefinedTransformerById'
-type MockQuerier_GetUserDefinedTransformerById_Call struct {
+// MockQuerier_GetJobChangeRequestById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobChangeRequestById'
+type MockQuerier_GetJobChangeRequestById_Call struct {
 	*mock.Call
 }
 
-// GetUserDefinedTransformerById is a helper method to define mock.On call
+// GetJobChangeRequestById is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
 //   - id pgtype.UUID
-func (_e *MockQuerier_Expecter) GetUserDefinedTransformerById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetUserDefinedTransformerById_Call {
-	return &MockQuerier_GetUserDefinedTransformerById_Call{Call: _e.mock.On("GetUserDefinedTransformerById", ctx, db, id)}
+func (_e *MockQuerier_Expecter) GetJobChangeRequestById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetJobChangeRequestById_Call {
+	return &MockQuerier_GetJobChangeRequestById_Call{Call: _e.mock.On("GetJobChangeRequestById", ctx, db, id)}
 }
 
-func (_c *MockQuerier_GetUserDefinedTransformerById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetUserDefinedTransformerById_Call {
+func (_c *MockQuerier_GetJobChangeRequestById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetJobChangeRequestById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetUserDefinedTransformerById_Call) Return(_a0 NeosyncApiTransformer, _a1 error) *MockQuerier_GetUserDefinedTransformerById_Call {
+func (_c *MockQuerier_GetJobChangeRequestById_Call) Return(_a0 NeosyncApiJobChangeRequest, _a1 error) *MockQuerier_GetJobChangeRequestById_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetUserDefinedTransformerById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiTransformer, error)) *MockQuerier_GetUserDefinedTransformerById_Call {
+func (_c *MockQuerier_GetJobChangeRequestById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiJobChangeRequest, error)) *MockQuerier_GetJobChangeRequestById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserDefinedTransformersByAccount provides a mock function with given fields: ctx, db, accountid
-func (_m *MockQuerier) GetUserDefinedTransformersByAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiTransformer, error) {
-	ret := _m.Called(ctx, db, accountid)
+// GetJobChangeRequestsByJob provides a mock function with given fields: ctx, db, jobID
+func (_m *MockQuerier) GetJobChangeRequestsByJob(ctx context.Context, db DBTX, jobID pgtype.UUID) ([]NeosyncApiJobChangeRequest, error) {
+	ret := _m.Called(ctx, db, jobID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserDefinedTransformersByAccount")
+		panic("no return value specified for GetJobChangeRequestsByJob")
 	}
 
-	var r0 []NeosyncApiTransformer
+	var r0 []NeosyncApiJobChangeRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiTransformer, error)); ok {
-		return rf(ctx, db, accountid)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJobChangeRequest, error)); ok {
+		return rf(ctx, db, jobID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiTransformer); ok {
-		r0 = rf(ctx, db, accountid)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiJobChangeRequest); ok {
+		r0 = rf(ctx, db, jobID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]NeosyncApiTransformer)
+			r0 = ret.Get(0).([]NeosyncApiJobChangeRequest)
 		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
-		r1 = rf(ctx, db, accountid)
+		r1 = rf(ctx, db, jobID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2665,59 +3296,57 @@ func (_m *MockQuerier) GetUserDefinedTransformersByAccount(ctx context.Context,
 	return r0, r1
 }
 
-// MockQuerier_GetUserDefinedTransformersByAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserDefinedTransformersByAccount'
-type MockQuerier_GetUserDefinedTransformersByAccount_Call struct {
+// MockQuerier_GetJobChangeRequestsByJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobChangeRequestsByJob'
+type MockQuerier_GetJobChangeRequestsByJob_Call struct {
 	*mock.Call
 }
 
-// GetUserDefinedTransformersByAccount is a helper method to define mock.On call
+// GetJobChangeRequestsByJob is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - accountid pgtype.UUID
-func (_e *MockQuerier_Expecter) GetUserDefinedTransformersByAccount(ctx interface{}, db interface{}, accountid interface{}) *MockQuerier_GetUserDefinedTransformersByAccount_Call {
-	return &MockQuerier_GetUserDefinedTransformersByAccount_Call{Call: _e.mock.On("GetUserDefinedTransformersByAccount", ctx, db, accountid)}
+//   - jobID pgtype.UUID
+func (_e *MockQuerier_Expecter) GetJobChangeRequestsByJob(ctx interface{}, db interface{}, jobID interface{}) *MockQuerier_GetJobChangeRequestsByJob_Call {
+	return &MockQuerier_GetJobChangeRequestsByJob_Call{Call: _e.mock.On("GetJobChangeRequestsByJob", ctx, db, jobID)}
 }
 
-func (_c *MockQuerier_GetUserDefinedTransformersByAccount_Call) Run(run func(ctx context.Context, db DBTX, accountid pgtype.UUID)) *MockQuerier_GetUserDefinedTransformersByAccount_Call {
+func (_c *MockQuerier_GetJobChangeRequestsByJob_Call) Run(run func(ctx context.Context, db DBTX, jobID pgtype.UUID)) *MockQuerier_GetJobChangeRequestsByJob_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetUserDefinedTransformersByAccount_Call) Return(_a0 []NeosyncApiTransformer, _a1 error) *MockQuerier_GetUserDefinedTransformersByAccount_Call {
+func (_c *MockQuerier_GetJobChangeRequestsByJob_Call) Return(_a0 []NeosyncApiJobChangeRequest, _a1 error) *MockQuerier_GetJobChangeRequestsByJob_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetUserDefinedTransformersByAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiTransformer, error)) *MockQuerier_GetUserDefinedTransformersByAccount_Call {
+func (_c *MockQuerier_GetJobChangeRequestsByJob_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJobChangeRequest, error)) *MockQuerier_GetJobChangeRequestsByJob_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserIdentitiesByTeamAccount provides a mock function with given fields: ctx, db, accountid
-func (_m *MockQuerier) GetUserIdentitiesByTeamAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error) {
-	ret := _m.Called(ctx, db, accountid)
+// GetJobConnectionDestination provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) GetJobConnectionDestination(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiJobDestinationConnectionAssociation, error) {
+	ret := _m.Called(ctx, db, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserIdentitiesByTeamAccount")
+		panic("no return value specified for GetJobConnectionDestination")
 	}
 
-	var r0 []NeosyncApiUserIdentityProviderAssociation
+	var r0 NeosyncApiJobDestinationConnectionAssociation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error)); ok {
-		return rf(ctx, db, accountid)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiJobDestinationConnectionAssociation, error)); ok {
+		return rf(ctx, db, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiUserIdentityProviderAssociation); ok {
-		r0 = rf(ctx, db, accountid)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiJobDestinationConnectionAssociation); ok {
+		r0 = rf(ctx, db, id)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]NeosyncApiUserIdentityProviderAssociation)
-		}
+		r0 = ret.Get(0).(NeosyncApiJobDestinationConnectionAssociation)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
-		r1 = rf(ctx, db, accountid)
+		r1 = rf(ctx, db, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2725,59 +3354,59 @@ func (_m *MockQuerier) GetUserIdentitiesByTeamAccount(ctx context.Context, db DB
 	return r0, r1
 }
 
-// MockQuerier_GetUserIdentitiesByTeamAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserIdentitiesByTeamAccount'
-type MockQuerier_GetUserIdentitiesByTeamAccount_Call struct {
+// MockQuerier_GetJobConnectionDestination_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobConnectionDestination'
+type MockQuerier_GetJobConnectionDestination_Call struct {
 	*mock.Call
 }
 
-// GetUserIdentitiesByTeamAccount is a helper method to define mock.On call
+// GetJobConnectionDestination is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - accountid pgtype.UUID
-func (_e *MockQuerier_Expecter) GetUserIdentitiesByTeamAccount(ctx interface{}, db interface{}, accountid interface{}) *MockQuerier_GetUserIdentitiesByTeamAccount_Call {
-	return &MockQuerier_GetUserIdentitiesByTeamAccount_Call{Call: _e.mock.On("GetUserIdentitiesByTeamAccount", ctx, db, accountid)}
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) GetJobConnectionDestination(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetJobConnectionDestination_Call {
+	return &MockQuerier_GetJobConnectionDestination_Call{Call: _e.mock.On("GetJobConnectionDestination", ctx, db, id)}
 }
 
-func (_c *MockQuerier_GetUserIdentitiesByTeamAccount_Call) Run(run func(ctx context.Context, db DBTX, accountid pgtype.UUID)) *MockQuerier_GetUserIdentitiesByTeamAccount_Call {
+func (_c *MockQuerier_GetJobConnectionDestination_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetJobConnectionDestination_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetUserIdentitiesByTeamAccount_Call) Return(_a0 []NeosyncApiUserIdentityProviderAssociation, _a1 error) *MockQuerier_GetUserIdentitiesByTeamAccount_Call {
+func (_c *MockQuerier_GetJobConnectionDestination_Call) Return(_a0 NeosyncApiJobDestinationConnectionAssociation, _a1 error) *MockQuerier_GetJobConnectionDestination_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetUserIdentitiesByTeamAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error)) *MockQuerier_GetUserIdentitiesByTeamAccount_Call {
+func (_c *MockQuerier_GetJobConnectionDestination_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiJobDestinationConnectionAssociation, error)) *MockQuerier_GetJobConnectionDestination_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserIdentityAssociationsByUserIds provides a mock function with given fields: ctx, db, dollar_1
-func (_m *MockQuerier) GetUserIdentityAssociationsByUserIds(ctx context.Context, db DBTX, dollar_1 []pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error) {
-	ret := _m.Called(ctx, db, dollar_1)
+// GetJobConnectionDestinations provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) GetJobConnectionDestinations(ctx context.Context, db DBTX, id pgtype.UUID) ([]NeosyncApiJobDestinationConnectionAssociation, error) {
+	ret := _m.Called(ctx, db, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserIdentityAssociationsByUserIds")
+		panic("no return value specified for GetJobConnectionDestinations")
 	}
 
-	var r0 []NeosyncApiUserIdentityProviderAssociation
+	var r0 []NeosyncApiJobDestinationConnectionAssociation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, []pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error)); ok {
-		return rf(ctx, db, dollar_1)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJobDestinationConnectionAssociation, error)); ok {
+		return rf(ctx, db, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, []pgtype.UUID) []NeosyncApiUserIdentityProviderAssociation); ok {
-		r0 = rf(ctx, db, dollar_1)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiJobDestinationConnectionAssociation); ok {
+		r0 = rf(ctx, db, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]NeosyncApiUserIdentityProviderAssociation)
+			r0 = ret.Get(0).([]NeosyncApiJobDestinationConnectionAssociation)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, []pgtype.UUID) error); ok {
-		r1 = rf(ctx, db, dollar_1)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2785,57 +3414,59 @@ func (_m *MockQuerier) GetUserIdentityAssociationsByUserIds(ctx context.Context,
 	return r0, r1
 }
 
-// MockQuerier_GetUserIdentityAssociationsByUserIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserIdentityAssociationsByUserIds'
-type MockQuerier_GetUserIdentityAssociationsByUserIds_Call struct {
+// MockQuerier_GetJobConnectionDestinations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobConnectionDestinations'
+type MockQuerier_GetJobConnectionDestinations_Call struct {
 	*mock.Call
 }
 
-// GetUserIdentityAssociationsByUserIds is a helper method to define mock.On call
+// GetJobConnectionDestinations is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - dollar_1 []pgtype.UUID
-func (_e *MockQuerier_Expecter) GetUserIdentityAssociationsByUserIds(ctx interface{}, db interface{}, dollar_1 interface{}) *MockQuerier_GetUserIdentityAssociationsByUserIds_Call {
-	return &MockQuerier_GetUserIdentityAssociationsByUserIds_Call{Call: _e.mock.On("GetUserIdentityAssociationsByUserIds", ctx, db, dollar_1)}
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) GetJobConnectionDestinations(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetJobConnectionDestinations_Call {
+	return &MockQuerier_GetJobConnectionDestinations_Call{Call: _e.mock.On("GetJobConnectionDestinations", ctx, db, id)}
 }
 
-func (_c *MockQuerier_GetUserIdentityAssociationsByUserIds_Call) Run(run func(ctx context.Context, db DBTX, dollar_1 []pgtype.UUID)) *MockQuerier_GetUserIdentityAssociationsByUserIds_Call {
+func (_c *MockQuerier_GetJobConnectionDestinations_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetJobConnectionDestinations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].([]pgtype.UUID))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetUserIdentityAssociationsByUserIds_Call) Return(_a0 []NeosyncApiUserIdentityProviderAssociation, _a1 error) *MockQuerier_GetUserIdentityAssociationsByUserIds_Call {
+func (_c *MockQuerier_GetJobConnectionDestinations_Call) Return(_a0 []NeosyncApiJobDestinationConnectionAssociation, _a1 error) *MockQuerier_GetJobConnectionDestinations_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetUserIdentityAssociationsByUserIds_Call) RunAndReturn(run func(context.Context, DBTX, []pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error)) *MockQuerier_GetUserIdentityAssociationsByUserIds_Call {
+func (_c *MockQuerier_GetJobConnectionDestinations_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJobDestinationConnectionAssociation, error)) *MockQuerier_GetJobConnectionDestinations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserIdentityByUserId provides a mock function with given fields: ctx, db, userID
-func (_m *MockQuerier) GetUserIdentityByUserId(ctx context.Context, db DBTX, userID pgtype.UUID) (NeosyncApiUserIdentityProviderAssociation, error) {
-	ret := _m.Called(ctx, db, userID)
+// GetJobConnectionDestinationsByJobIds provides a mock function with given fields: ctx, db, jobids
+func (_m *MockQuerier) GetJobConnectionDestinationsByJobIds(ctx context.Context, db DBTX, jobids []pgtype.UUID) ([]NeosyncApiJobDestinationConnectionAssociation, error) {
+	ret := _m.Called(ctx, db, jobids)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserIdentityByUserId")
+		panic("no return value specified for GetJobConnectionDestinationsByJobIds")
 	}
 
-	var r0 NeosyncApiUserIdentityProviderAssociation
+	var r0 []NeosyncApiJobDestinationConnectionAssociation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiUserIdentityProviderAssociation, error)); ok {
-		return rf(ctx, db, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, []pgtype.UUID) ([]NeosyncApiJobDestinationConnectionAssociation, error)); ok {
+		return rf(ctx, db, jobids)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiUserIdentityProviderAssociation); ok {
-		r0 = rf(ctx, db, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, []pgtype.UUID) []NeosyncApiJobDestinationConnectionAssociation); ok {
+		r0 = rf(ctx, db, jobids)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiUserIdentityProviderAssociation)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiJobDestinationConnectionAssociation)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
-		r1 = rf(ctx, db, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, []pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, jobids)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2843,56 +3474,58 @@ func (_m *MockQuerier) GetUserIdentityByUserId(ctx context.Context, db DBTX, use
 	return r0, r1
 }
 
-// MockQuerier_GetUserIdentityByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserIdentityByUserId'
-type MockQuerier_GetUserIdentityByUserId_Call struct {
+// MockQuerier_GetJobConnectionDestinationsByJobIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobConnectionDestinationsByJobIds'
+type MockQuerier_GetJobConnectionDestinationsByJobIds_Call struct {
 	*mock.Call
 }
 
-// GetUserIdentityByUserId is a helper method to define mock.On call
+// GetJobConnectionDestinationsByJobIds is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - userID pgtype.UUID
-func (_e *MockQuerier_Expecter) GetUserIdentityByUserId(ctx interface{}, db interface{}, userID interface{}) *MockQuerier_GetUserIdentityByUserId_Call {
-	return &MockQuerier_GetUserIdentityByUserId_Call{Call: _e.mock.On("GetUserIdentityByUserId", ctx, db, userID)}
+//   - jobids []pgtype.UUID
+func (_e *MockQuerier_Expecter) GetJobConnectionDestinationsByJobIds(ctx interface{}, db interface{}, jobids interface{}) *MockQuerier_GetJobConnectionDestinationsByJobIds_Call {
+	return &MockQuerier_GetJobConnectionDestinationsByJobIds_Call{Call: _e.mock.On("GetJobConnectionDestinationsByJobIds", ctx, db, jobids)}
 }
 
-func (_c *MockQuerier_GetUserIdentityByUserId_Call) Run(run func(ctx context.Context, db DBTX, userID pgtype.UUID)) *MockQuerier_GetUserIdentityByUserId_Call {
+func (_c *MockQuerier_GetJobConnectionDestinationsByJobIds_Call) Run(run func(ctx context.Context, db DBTX, jobids []pgtype.UUID)) *MockQuerier_GetJobConnectionDestinationsByJobIds_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].([]pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetUserIdentityByUserId_Call) Return(_a0 NeosyncApiUserIdentityProviderAssociation, _a1 error) *MockQuerier_GetUserIdentityByUserId_Call {
+func (_c *MockQuerier_GetJobConnectionDestinationsByJobIds_Call) Return(_a0 []NeosyncApiJobDestinationConnectionAssociation, _a1 error) *MockQuerier_GetJobConnectionDestinationsByJobIds_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetUserIdentityByUserId_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiUserIdentityProviderAssociation, error)) *MockQuerier_GetUserIdentityByUserId_Call {
+func (_c *MockQuerier_GetJobConnectionDestinationsByJobIds_Call) RunAndReturn(run func(context.Context, DBTX, []pgtype.UUID) ([]NeosyncApiJobDestinationConnectionAssociation, error)) *MockQuerier_GetJobConnectionDestinationsByJobIds_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsConnectionInAccount provides a mock function with given fields: ctx, db, arg
-func (_m *MockQuerier) IsConnectionInAccount(ctx context.Context, db DBTX, arg IsConnectionInAccountParams) (int64, error) {
+// GetJobRunArtifacts provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetJobRunArtifacts(ctx context.Context, db DBTX, arg GetJobRunArtifactsParams) ([]NeosyncApiJobRunArtifact, error) {
 	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsConnectionInAccount")
+		panic("no return value specified for GetJobRunArtifacts")
 	}
 
-	var r0 int64
+	var r0 []NeosyncApiJobRunArtifact
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsConnectionInAccountParams) (int64, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobRunArtifactsParams) ([]NeosyncApiJobRunArtifact, error)); ok {
 		return rf(ctx, db, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsConnectionInAccountParams) int64); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobRunArtifactsParams) []NeosyncApiJobRunArtifact); ok {
 		r0 = rf(ctx, db, arg)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiJobRunArtifact)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, IsConnectionInAccountParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetJobRunArtifactsParams) error); ok {
 		r1 = rf(ctx, db, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -2901,56 +3534,174 @@ func (_m *MockQuerier) IsConnectionInAccount(ctx context.Context, db DBTX, arg I
 	return r0, r1
 }
 
-// MockQuerier_IsConnectionInAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsConnectionInAccount'
-type MockQuerier_IsConnectionInAccount_Call struct {
+// MockQuerier_GetJobRunArtifacts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunArtifacts'
+type MockQuerier_GetJobRunArtifacts_Call struct {
 	*mock.Call
 }
 
-// IsConnectionInAccount is a helper method to define mock.On call
+// GetJobRunArtifacts is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - arg IsConnectionInAccountParams
-func (_e *MockQuerier_Expecter) IsConnectionInAccount(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_IsConnectionInAccount_Call {
-	return &MockQuerier_IsConnectionInAccount_Call{Call: _e.mock.On("IsConnectionInAccount", ctx, db, arg)}
+//   - arg GetJobRunArtifactsParams
+func (_e *MockQuerier_Expecter) GetJobRunArtifacts(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetJobRunArtifacts_Call {
+	return &MockQuerier_GetJobRunArtifacts_Call{Call: _e.mock.On("GetJobRunArtifacts", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_IsConnectionInAccount_Call) Run(run func(ctx context.Context, db DBTX, arg IsConnectionInAccountParams)) *MockQuerier_IsConnectionInAccount_Call {
+func (_c *MockQuerier_GetJobRunArtifacts_Call) Run(run func(ctx context.Context, db DBTX, arg GetJobRunArtifactsParams)) *MockQuerier_GetJobRunArtifacts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(IsConnectionInAccountParams))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetJobRunArtifactsParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_IsConnectionInAccount_Call) Return(_a0 int64, _a1 error) *MockQuerier_IsConnectionInAccount_Call {
+func (_c *MockQuerier_GetJobRunArtifacts_Call) Return(_a0 []NeosyncApiJobRunArtifact, _a1 error) *MockQuerier_GetJobRunArtifacts_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_IsConnectionInAccount_Call) RunAndReturn(run func(context.Context, DBTX, IsConnectionInAccountParams) (int64, error)) *MockQuerier_IsConnectionInAccount_Call {
+func (_c *MockQuerier_GetJobRunArtifacts_Call) RunAndReturn(run func(context.Context, DBTX, GetJobRunArtifactsParams) ([]NeosyncApiJobRunArtifact, error)) *MockQuerier_GetJobRunArtifacts_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsConnectionNameAvailable provides a mock function with given fields: ctx, db, arg
-func (_m *MockQuerier) IsConnectionNameAvailable(ctx context.Context, db DBTX, arg IsConnectionNameAvailableParams) (int64, error) {
+// GetJobRunRequestByJobRun provides a mock function with given fields: ctx, db, jobRunID
+func (_m *MockQuerier) GetJobRunRequestByJobRun(ctx context.Context, db DBTX, jobRunID pgtype.Text) (NeosyncApiJobRunRequest, error) {
+	ret := _m.Called(ctx, db, jobRunID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunRequestByJobRun")
+	}
+
+	var r0 NeosyncApiJobRunRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.Text) (NeosyncApiJobRunRequest, error)); ok {
+		return rf(ctx, db, jobRunID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.Text) NeosyncApiJobRunRequest); ok {
+		r0 = rf(ctx, db, jobRunID)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiJobRunRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.Text) error); ok {
+		r1 = rf(ctx, db, jobRunID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetJobRunRequestByJobRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunRequestByJobRun'
+type MockQuerier_GetJobRunRequestByJobRun_Call struct {
+	*mock.Call
+}
+
+// GetJobRunRequestByJobRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - jobRunID pgtype.Text
+func (_e *MockQuerier_Expecter) GetJobRunRequestByJobRun(ctx interface{}, db interface{}, jobRunID interface{}) *MockQuerier_GetJobRunRequestByJobRun_Call {
+	return &MockQuerier_GetJobRunRequestByJobRun_Call{Call: _e.mock.On("GetJobRunRequestByJobRun", ctx, db, jobRunID)}
+}
+
+func (_c *MockQuerier_GetJobRunRequestByJobRun_Call) Run(run func(ctx context.Context, db DBTX, jobRunID pgtype.Text)) *MockQuerier_GetJobRunRequestByJobRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.Text))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetJobRunRequestByJobRun_Call) Return(_a0 NeosyncApiJobRunRequest, _a1 error) *MockQuerier_GetJobRunRequestByJobRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetJobRunRequestByJobRun_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.Text) (NeosyncApiJobRunRequest, error)) *MockQuerier_GetJobRunRequestByJobRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRunRequestsByJobRuns provides a mock function with given fields: ctx, db, jobrunids
+func (_m *MockQuerier) GetJobRunRequestsByJobRuns(ctx context.Context, db DBTX, jobrunids []string) ([]NeosyncApiJobRunRequest, error) {
+	ret := _m.Called(ctx, db, jobrunids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunRequestsByJobRuns")
+	}
+
+	var r0 []NeosyncApiJobRunRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, []string) ([]NeosyncApiJobRunRequest, error)); ok {
+		return rf(ctx, db, jobrunids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, []string) []NeosyncApiJobRunRequest); ok {
+		r0 = rf(ctx, db, jobrunids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiJobRunRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, []string) error); ok {
+		r1 = rf(ctx, db, jobrunids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetJobRunRequestsByJobRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunRequestsByJobRuns'
+type MockQuerier_GetJobRunRequestsByJobRuns_Call struct {
+	*mock.Call
+}
+
+// GetJobRunRequestsByJobRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - jobrunids []string
+func (_e *MockQuerier_Expecter) GetJobRunRequestsByJobRuns(ctx interface{}, db interface{}, jobrunids interface{}) *MockQuerier_GetJobRunRequestsByJobRuns_Call {
+	return &MockQuerier_GetJobRunRequestsByJobRuns_Call{Call: _e.mock.On("GetJobRunRequestsByJobRuns", ctx, db, jobrunids)}
+}
+
+func (_c *MockQuerier_GetJobRunRequestsByJobRuns_Call) Run(run func(ctx context.Context, db DBTX, jobrunids []string)) *MockQuerier_GetJobRunRequestsByJobRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetJobRunRequestsByJobRuns_Call) Return(_a0 []NeosyncApiJobRunRequest, _a1 error) *MockQuerier_GetJobRunRequestsByJobRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetJobRunRequestsByJobRuns_Call) RunAndReturn(run func(context.Context, DBTX, []string) ([]NeosyncApiJobRunRequest, error)) *MockQuerier_GetJobRunRequestsByJobRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRunSnapshotByName provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetJobRunSnapshotByName(ctx context.Context, db DBTX, arg GetJobRunSnapshotByNameParams) (NeosyncApiJobRunSnapshot, error) {
 	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsConnectionNameAvailable")
+		panic("no return value specified for GetJobRunSnapshotByName")
 	}
 
-	var r0 int64
+	var r0 NeosyncApiJobRunSnapshot
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsConnectionNameAvailableParams) (int64, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobRunSnapshotByNameParams) (NeosyncApiJobRunSnapshot, error)); ok {
 		return rf(ctx, db, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsConnectionNameAvailableParams) int64); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobRunSnapshotByNameParams) NeosyncApiJobRunSnapshot); ok {
 		r0 = rf(ctx, db, arg)
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Get(0).(NeosyncApiJobRunSnapshot)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, IsConnectionNameAvailableParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetJobRunSnapshotByNameParams) error); ok {
 		r1 = rf(ctx, db, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -2959,56 +3710,118 @@ func (_m *MockQuerier) IsConnectionNameAvailable(ctx context.Context, db DBTX, a
 	return r0, r1
 }
 
-// MockQuerier_IsConnectionNameAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsConnectionNameAvailable'
-type MockQuerier_IsConnectionNameAvailable_Call struct {
+// MockQuerier_GetJobRunSnapshotByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunSnapshotByName'
+type MockQuerier_GetJobRunSnapshotByName_Call struct {
 	*mock.Call
 }
 
-// IsConnectionNameAvailable is a helper method to define mock.On call
+// GetJobRunSnapshotByName is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - arg IsConnectionNameAvailableParams
-func (_e *MockQuerier_Expecter) IsConnectionNameAvailable(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_IsConnectionNameAvailable_Call {
-	return &MockQuerier_IsConnectionNameAvailable_Call{Call: _e.mock.On("IsConnectionNameAvailable", ctx, db, arg)}
+//   - arg GetJobRunSnapshotByNameParams
+func (_e *MockQuerier_Expecter) GetJobRunSnapshotByName(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetJobRunSnapshotByName_Call {
+	return &MockQuerier_GetJobRunSnapshotByName_Call{Call: _e.mock.On("GetJobRunSnapshotByName", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_IsConnectionNameAvailable_Call) Run(run func(ctx context.Context, db DBTX, arg IsConnectionNameAvailableParams)) *MockQuerier_IsConnectionNameAvailable_Call {
+func (_c *MockQuerier_GetJobRunSnapshotByName_Call) Run(run func(ctx context.Context, db DBTX, arg GetJobRunSnapshotByNameParams)) *MockQuerier_GetJobRunSnapshotByName_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(IsConnectionNameAvailableParams))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetJobRunSnapshotByNameParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_IsConnectionNameAvailable_Call) Return(_a0 int64, _a1 error) *MockQuerier_IsConnectionNameAvailable_Call {
+func (_c *MockQuerier_GetJobRunSnapshotByName_Call) Return(_a0 NeosyncApiJobRunSnapshot, _a1 error) *MockQuerier_GetJobRunSnapshotByName_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_IsConnectionNameAvailable_Call) RunAndReturn(run func(context.Context, DBTX, IsConnectionNameAvailableParams) (int64, error)) *MockQuerier_IsConnectionNameAvailable_Call {
+func (_c *MockQuerier_GetJobRunSnapshotByName_Call) RunAndReturn(run func(context.Context, DBTX, GetJobRunSnapshotByNameParams) (NeosyncApiJobRunSnapshot, error)) *MockQuerier_GetJobRunSnapshotByName_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsJobNameAvailable provides a mock function with given fields: ctx, db, arg
-func (_m *MockQuerier) IsJobNameAvailable(ctx context.Context, db DBTX, arg IsJobNameAvailableParams) (int64, error) {
+// GetJobRunSnapshotsByAccount provides a mock function with given fields: ctx, db, accountID
+func (_m *MockQuerier) GetJobRunSnapshotsByAccount(ctx context.Context, db DBTX, accountID pgtype.UUID) ([]NeosyncApiJobRunSnapshot, error) {
+	ret := _m.Called(ctx, db, accountID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunSnapshotsByAccount")
+	}
+
+	var r0 []NeosyncApiJobRunSnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJobRunSnapshot, error)); ok {
+		return rf(ctx, db, accountID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiJobRunSnapshot); ok {
+		r0 = rf(ctx, db, accountID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiJobRunSnapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, accountID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetJobRunSnapshotsByAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunSnapshotsByAccount'
+type MockQuerier_GetJobRunSnapshotsByAccount_Call struct {
+	*mock.Call
+}
+
+// GetJobRunSnapshotsByAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - accountID pgtype.UUID
+func (_e *MockQuerier_Expecter) GetJobRunSnapshotsByAccount(ctx interface{}, db interface{}, accountID interface{}) *MockQuerier_GetJobRunSnapshotsByAccount_Call {
+	return &MockQuerier_GetJobRunSnapshotsByAccount_Call{Call: _e.mock.On("GetJobRunSnapshotsByAccount", ctx, db, accountID)}
+}
+
+func (_c *MockQuerier_GetJobRunSnapshotsByAccount_Call) Run(run func(ctx context.Context, db DBTX, accountID pgtype.UUID)) *MockQuerier_GetJobRunSnapshotsByAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetJobRunSnapshotsByAccount_Call) Return(_a0 []NeosyncApiJobRunSnapshot, _a1 error) *MockQuerier_GetJobRunSnapshotsByAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetJobRunSnapshotsByAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJobRunSnapshot, error)) *MockQuerier_GetJobRunSnapshotsByAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRunSnapshotsByJob provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetJobRunSnapshotsByJob(ctx context.Context, db DBTX, arg GetJobRunSnapshotsByJobParams) ([]NeosyncApiJobRunSnapshot, error) {
 	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsJobNameAvailable")
+		panic("no return value specified for GetJobRunSnapshotsByJob")
 	}
 
-	var r0 int64
+	var r0 []NeosyncApiJobRunSnapshot
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsJobNameAvailableParams) (int64, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobRunSnapshotsByJobParams) ([]NeosyncApiJobRunSnapshot, error)); ok {
 		return rf(ctx, db, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsJobNameAvailableParams) int64); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobRunSnapshotsByJobParams) []NeosyncApiJobRunSnapshot); ok {
 		r0 = rf(ctx, db, arg)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiJobRunSnapshot)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, IsJobNameAvailableParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetJobRunSnapshotsByJobParams) error); ok {
 		r1 = rf(ctx, db, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3017,56 +3830,58 @@ func (_m *MockQuerier) IsJobNameAvailable(ctx context.Context, db DBTX, arg IsJo
 	return r0, r1
 }
 
-// MockQuerier_IsJobNameAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsJobNameAvailable'
-type MockQuerier_IsJobNameAvailable_Call struct {
+// MockQuerier_GetJobRunSnapshotsByJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunSnapshotsByJob'
+type MockQuerier_GetJobRunSnapshotsByJob_Call struct {
 	*mock.Call
 }
 
-// IsJobNameAvailable is a helper method to define mock.On call
+// GetJobRunSnapshotsByJob is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - arg IsJobNameAvailableParams
-func (_e *MockQuerier_Expecter) IsJobNameAvailable(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_IsJobNameAvailable_Call {
-	return &MockQuerier_IsJobNameAvailable_Call{Call: _e.mock.On("IsJobNameAvailable", ctx, db, arg)}
+//   - arg GetJobRunSnapshotsByJobParams
+func (_e *MockQuerier_Expecter) GetJobRunSnapshotsByJob(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetJobRunSnapshotsByJob_Call {
+	return &MockQuerier_GetJobRunSnapshotsByJob_Call{Call: _e.mock.On("GetJobRunSnapshotsByJob", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_IsJobNameAvailable_Call) Run(run func(ctx context.Context, db DBTX, arg IsJobNameAvailableParams)) *MockQuerier_IsJobNameAvailable_Call {
+func (_c *MockQuerier_GetJobRunSnapshotsByJob_Call) Run(run func(ctx context.Context, db DBTX, arg GetJobRunSnapshotsByJobParams)) *MockQuerier_GetJobRunSnapshotsByJob_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(IsJobNameAvailableParams))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetJobRunSnapshotsByJobParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_IsJobNameAvailable_Call) Return(_a0 int64, _a1 error) *MockQuerier_IsJobNameAvailable_Call {
+func (_c *MockQuerier_GetJobRunSnapshotsByJob_Call) Return(_a0 []NeosyncApiJobRunSnapshot, _a1 error) *MockQuerier_GetJobRunSnapshotsByJob_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_IsJobNameAvailable_Call) RunAndReturn(run func(context.Context, DBTX, IsJobNameAvailableParams) (int64, error)) *MockQuerier_IsJobNameAvailable_Call {
+func (_c *MockQuerier_GetJobRunSnapshotsByJob_Call) RunAndReturn(run func(context.Context, DBTX, GetJobRunSnapshotsByJobParams) ([]NeosyncApiJobRunSnapshot, error)) *MockQuerier_GetJobRunSnapshotsByJob_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsTransformerNameAvailable provides a mock function with given fields: ctx, db, arg
-func (_m *MockQuerier) IsTransformerNameAvailable(ctx context.Context, db DBTX, arg IsTransformerNameAvailableParams) (int64, error) {
+// GetJobRunSnapshotsByJobRun provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetJobRunSnapshotsByJobRun(ctx context.Context, db DBTX, arg GetJobRunSnapshotsByJobRunParams) ([]NeosyncApiJobRunSnapshot, error) {
 	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsTransformerNameAvailable")
+		panic("no return value specified for GetJobRunSnapshotsByJobRun")
 	}
 
-	var r0 int64
+	var r0 []NeosyncApiJobRunSnapshot
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsTransformerNameAvailableParams) (int64, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobRunSnapshotsByJobRunParams) ([]NeosyncApiJobRunSnapshot, error)); ok {
 		return rf(ctx, db, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsTransformerNameAvailableParams) int64); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobRunSnapshotsByJobRunParams) []NeosyncApiJobRunSnapshot); ok {
 		r0 = rf(ctx, db, arg)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiJobRunSnapshot)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, IsTransformerNameAvailableParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetJobRunSnapshotsByJobRunParams) error); ok {
 		r1 = rf(ctx, db, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3075,158 +3890,1938 @@ func (_m *MockQuerier) IsTransformerNameAvailable(ctx context.Context, db DBTX,
 	return r0, r1
 }
 
-// MockQuerier_IsTransformerNameAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsTransformerNameAvailable'
-type MockQuerier_IsTransformerNameAvailable_Call struct {
+// MockQuerier_GetJobRunSnapshotsByJobRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunSnapshotsByJobRun'
+type MockQuerier_GetJobRunSnapshotsByJobRun_Call struct {
 	*mock.Call
 }
 
-// IsTransformerNameAvailable is a helper method to define mock.On call
+// GetJobRunSnapshotsByJobRun is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - arg IsTransformerNameAvailableParams
-func (_e *MockQuerier_Expecter) IsTransformerNameAvailable(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_IsTransformerNameAvailable_Call {
-	return &MockQuerier_IsTransformerNameAvailable_Call{Call: _e.mock.On("IsTransformerNameAvailable", ctx, db, arg)}
+//   - arg GetJobRunSnapshotsByJobRunParams
+func (_e *MockQuerier_Expecter) GetJobRunSnapshotsByJobRun(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetJobRunSnapshotsByJobRun_Call {
+	return &MockQuerier_GetJobRunSnapshotsByJobRun_Call{Call: _e.mock.On("GetJobRunSnapshotsByJobRun", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_IsTransformerNameAvailable_Call) Run(run func(ctx context.Context, db DBTX, arg IsTransformerNameAvailableParams)) *MockQuerier_IsTransformerNameAvailable_Call {
+func (_c *MockQuerier_GetJobRunSnapshotsByJobRun_Call) Run(run func(ctx context.Context, db DBTX, arg GetJobRunSnapshotsByJobRunParams)) *MockQuerier_GetJobRunSnapshotsByJobRun_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(IsTransformerNameAvailableParams))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetJobRunSnapshotsByJobRunParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_IsTransformerNameAvailable_Call) Return(_a0 int64, _a1 error) *MockQuerier_IsTransformerNameAvailable_Call {
+func (_c *MockQuerier_GetJobRunSnapshotsByJobRun_Call) Return(_a0 []NeosyncApiJobRunSnapshot, _a1 error) *MockQuerier_GetJobRunSnapshotsByJobRun_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_IsTransformerNameAvailable_Call) RunAndReturn(run func(context.Context, DBTX, IsTransformerNameAvailableParams) (int64, error)) *MockQuerier_IsTransformerNameAvailable_Call {
+func (_c *MockQuerier_GetJobRunSnapshotsByJobRun_Call) RunAndReturn(run func(context.Context, DBTX, GetJobRunSnapshotsByJobRunParams) ([]NeosyncApiJobRunSnapshot, error)) *MockQuerier_GetJobRunSnapshotsByJobRun_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsUserInAccount provides a mock function with given fields: ctx, db, arg
-func (_m *MockQuerier) IsUserInAccount(ctx context.Context, db DBTX, arg IsUserInAccountParams) (int64, error) {
-	ret := _m.Called(ctx, db, arg)
+// GetJobsByAccount provides a mock function with given fields: ctx, db, accountid
+func (_m *MockQuerier) GetJobsByAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiJob, error) {
+	ret := _m.Called(ctx, db, accountid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsUserInAccount")
+		panic("no return value specified for GetJobsByAccount")
 	}
 
-	var r0 int64
+	var r0 []NeosyncApiJob
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsUserInAccountParams) (int64, error)); ok {
-		return rf(ctx, db, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJob, error)); ok {
+		return rf(ctx, db, accountid)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsUserInAccountParams) int64); ok {
-		r0 = rf(ctx, db, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiJob); ok {
+		r0 = rf(ctx, db, accountid)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, accountid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetJobsByAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobsByAccount'
+type MockQuerier_GetJobsByAccount_Call struct {
+	*mock.Call
+}
+
+// GetJobsByAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - accountid pgtype.UUID
+func (_e *MockQuerier_Expecter) GetJobsByAccount(ctx interface{}, db interface{}, accountid interface{}) *MockQuerier_GetJobsByAccount_Call {
+	return &MockQuerier_GetJobsByAccount_Call{Call: _e.mock.On("GetJobsByAccount", ctx, db, accountid)}
+}
+
+func (_c *MockQuerier_GetJobsByAccount_Call) Run(run func(ctx context.Context, db DBTX, accountid pgtype.UUID)) *MockQuerier_GetJobsByAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetJobsByAccount_Call) Return(_a0 []NeosyncApiJob, _a1 error) *MockQuerier_GetJobsByAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetJobsByAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJob, error)) *MockQuerier_GetJobsByAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPersonalAccountByUserId provides a mock function with given fields: ctx, db, userid
+func (_m *MockQuerier) GetPersonalAccountByUserId(ctx context.Context, db DBTX, userid pgtype.UUID) (NeosyncApiAccount, error) {
+	ret := _m.Called(ctx, db, userid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPersonalAccountByUserId")
+	}
+
+	var r0 NeosyncApiAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiAccount, error)); ok {
+		return rf(ctx, db, userid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiAccount); ok {
+		r0 = rf(ctx, db, userid)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiAccount)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, userid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetPersonalAccountByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPersonalAccountByUserId'
+type MockQuerier_GetPersonalAccountByUserId_Call struct {
+	*mock.Call
+}
+
+// GetPersonalAccountByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - userid pgtype.UUID
+func (_e *MockQuerier_Expecter) GetPersonalAccountByUserId(ctx interface{}, db interface{}, userid interface{}) *MockQuerier_GetPersonalAccountByUserId_Call {
+	return &MockQuerier_GetPersonalAccountByUserId_Call{Call: _e.mock.On("GetPersonalAccountByUserId", ctx, db, userid)}
+}
+
+func (_c *MockQuerier_GetPersonalAccountByUserId_Call) Run(run func(ctx context.Context, db DBTX, userid pgtype.UUID)) *MockQuerier_GetPersonalAccountByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetPersonalAccountByUserId_Call) Return(_a0 NeosyncApiAccount, _a1 error) *MockQuerier_GetPersonalAccountByUserId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetPersonalAccountByUserId_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiAccount, error)) *MockQuerier_GetPersonalAccountByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetResourceLabels provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetResourceLabels(ctx context.Context, db DBTX, arg GetResourceLabelsParams) ([]NeosyncApiResourceLabel, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetResourceLabels")
+	}
+
+	var r0 []NeosyncApiResourceLabel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetResourceLabelsParams) ([]NeosyncApiResourceLabel, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetResourceLabelsParams) []NeosyncApiResourceLabel); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiResourceLabel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetResourceLabelsParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetResourceLabels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetResourceLabels'
+type MockQuerier_GetResourceLabels_Call struct {
+	*mock.Call
+}
+
+// GetResourceLabels is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg GetResourceLabelsParams
+func (_e *MockQuerier_Expecter) GetResourceLabels(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetResourceLabels_Call {
+	return &MockQuerier_GetResourceLabels_Call{Call: _e.mock.On("GetResourceLabels", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_GetResourceLabels_Call) Run(run func(ctx context.Context, db DBTX, arg GetResourceLabelsParams)) *MockQuerier_GetResourceLabels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetResourceLabelsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetResourceLabels_Call) Return(_a0 []NeosyncApiResourceLabel, _a1 error) *MockQuerier_GetResourceLabels_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetResourceLabels_Call) RunAndReturn(run func(context.Context, DBTX, GetResourceLabelsParams) ([]NeosyncApiResourceLabel, error)) *MockQuerier_GetResourceLabels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTeamAccountsByUserId provides a mock function with given fields: ctx, db, userid
+func (_m *MockQuerier) GetTeamAccountsByUserId(ctx context.Context, db DBTX, userid pgtype.UUID) ([]NeosyncApiAccount, error) {
+	ret := _m.Called(ctx, db, userid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamAccountsByUserId")
+	}
+
+	var r0 []NeosyncApiAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiAccount, error)); ok {
+		return rf(ctx, db, userid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiAccount); ok {
+		r0 = rf(ctx, db, userid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, userid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetTeamAccountsByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamAccountsByUserId'
+type MockQuerier_GetTeamAccountsByUserId_Call struct {
+	*mock.Call
+}
+
+// GetTeamAccountsByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - userid pgtype.UUID
+func (_e *MockQuerier_Expecter) GetTeamAccountsByUserId(ctx interface{}, db interface{}, userid interface{}) *MockQuerier_GetTeamAccountsByUserId_Call {
+	return &MockQuerier_GetTeamAccountsByUserId_Call{Call: _e.mock.On("GetTeamAccountsByUserId", ctx, db, userid)}
+}
+
+func (_c *MockQuerier_GetTeamAccountsByUserId_Call) Run(run func(ctx context.Context, db DBTX, userid pgtype.UUID)) *MockQuerier_GetTeamAccountsByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetTeamAccountsByUserId_Call) Return(_a0 []NeosyncApiAccount, _a1 error) *MockQuerier_GetTeamAccountsByUserId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetTeamAccountsByUserId_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiAccount, error)) *MockQuerier_GetTeamAccountsByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTemporalConfigByAccount provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) GetTemporalConfigByAccount(ctx context.Context, db DBTX, id pgtype.UUID) (*pg_models.TemporalConfig, error) {
+	ret := _m.Called(ctx, db, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemporalConfigByAccount")
+	}
+
+	var r0 *pg_models.TemporalConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (*pg_models.TemporalConfig, error)); ok {
+		return rf(ctx, db, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) *pg_models.TemporalConfig); ok {
+		r0 = rf(ctx, db, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pg_models.TemporalConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetTemporalConfigByAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTemporalConfigByAccount'
+type MockQuerier_GetTemporalConfigByAccount_Call struct {
+	*mock.Call
+}
+
+// GetTemporalConfigByAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) GetTemporalConfigByAccount(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetTemporalConfigByAccount_Call {
+	return &MockQuerier_GetTemporalConfigByAccount_Call{Call: _e.mock.On("GetTemporalConfigByAccount", ctx, db, id)}
+}
+
+func (_c *MockQuerier_GetTemporalConfigByAccount_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetTemporalConfigByAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetTemporalConfigByAccount_Call) Return(_a0 *pg_models.TemporalConfig, _a1 error) *MockQuerier_GetTemporalConfigByAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetTemporalConfigByAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (*pg_models.TemporalConfig, error)) *MockQuerier_GetTemporalConfigByAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTemporalConfigByUserAccount provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetTemporalConfigByUserAccount(ctx context.Context, db DBTX, arg GetTemporalConfigByUserAccountParams) (*pg_models.TemporalConfig, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemporalConfigByUserAccount")
+	}
+
+	var r0 *pg_models.TemporalConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetTemporalConfigByUserAccountParams) (*pg_models.TemporalConfig, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetTemporalConfigByUserAccountParams) *pg_models.TemporalConfig); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pg_models.TemporalConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetTemporalConfigByUserAccountParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetTemporalConfigByUserAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTemporalConfigByUserAccount'
+type MockQuerier_GetTemporalConfigByUserAccount_Call struct {
+	*mock.Call
+}
+
+// GetTemporalConfigByUserAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg GetTemporalConfigByUserAccountParams
+func (_e *MockQuerier_Expecter) GetTemporalConfigByUserAccount(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetTemporalConfigByUserAccount_Call {
+	return &MockQuerier_GetTemporalConfigByUserAccount_Call{Call: _e.mock.On("GetTemporalConfigByUserAccount", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_GetTemporalConfigByUserAccount_Call) Run(run func(ctx context.Context, db DBTX, arg GetTemporalConfigByUserAccountParams)) *MockQuerier_GetTemporalConfigByUserAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetTemporalConfigByUserAccountParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetTemporalConfigByUserAccount_Call) Return(_a0 *pg_models.TemporalConfig, _a1 error) *MockQuerier_GetTemporalConfigByUserAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetTemporalConfigByUserAccount_Call) RunAndReturn(run func(context.Context, DBTX, GetTemporalConfigByUserAccountParams) (*pg_models.TemporalConfig, error)) *MockQuerier_GetTemporalConfigByUserAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUser provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) GetUser(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiUser, error) {
+	ret := _m.Called(ctx, db, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUser")
+	}
+
+	var r0 NeosyncApiUser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiUser, error)); ok {
+		return rf(ctx, db, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiUser); ok {
+		r0 = rf(ctx, db, id)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiUser)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type MockQuerier_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) GetUser(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetUser_Call {
+	return &MockQuerier_GetUser_Call{Call: _e.mock.On("GetUser", ctx, db, id)}
+}
+
+func (_c *MockQuerier_GetUser_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUser_Call) Return(_a0 NeosyncApiUser, _a1 error) *MockQuerier_GetUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUser_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiUser, error)) *MockQuerier_GetUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserAssociationByProviderSub provides a mock function with given fields: ctx, db, providerSub
+func (_m *MockQuerier) GetUserAssociationByProviderSub(ctx context.Context, db DBTX, providerSub string) (NeosyncApiUserIdentityProviderAssociation, error) {
+	ret := _m.Called(ctx, db, providerSub)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserAssociationByProviderSub")
+	}
+
+	var r0 NeosyncApiUserIdentityProviderAssociation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, string) (NeosyncApiUserIdentityProviderAssociation, error)); ok {
+		return rf(ctx, db, providerSub)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, string) NeosyncApiUserIdentityProviderAssociation); ok {
+		r0 = rf(ctx, db, providerSub)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiUserIdentityProviderAssociation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, string) error); ok {
+		r1 = rf(ctx, db, providerSub)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUserAssociationByProviderSub_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserAssociationByProviderSub'
+type MockQuerier_GetUserAssociationByProviderSub_Call struct {
+	*mock.Call
+}
+
+// GetUserAssociationByProviderSub is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - providerSub string
+func (_e *MockQuerier_Expecter) GetUserAssociationByProviderSub(ctx interface{}, db interface{}, providerSub interface{}) *MockQuerier_GetUserAssociationByProviderSub_Call {
+	return &MockQuerier_GetUserAssociationByProviderSub_Call{Call: _e.mock.On("GetUserAssociationByProviderSub", ctx, db, providerSub)}
+}
+
+func (_c *MockQuerier_GetUserAssociationByProviderSub_Call) Run(run func(ctx context.Context, db DBTX, providerSub string)) *MockQuerier_GetUserAssociationByProviderSub_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUserAssociationByProviderSub_Call) Return(_a0 NeosyncApiUserIdentityProviderAssociation, _a1 error) *MockQuerier_GetUserAssociationByProviderSub_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUserAssociationByProviderSub_Call) RunAndReturn(run func(context.Context, DBTX, string) (NeosyncApiUserIdentityProviderAssociation, error)) *MockQuerier_GetUserAssociationByProviderSub_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByProviderSub provides a mock function with given fields: ctx, db, providerSub
+func (_m *MockQuerier) GetUserByProviderSub(ctx context.Context, db DBTX, providerSub string) (NeosyncApiUser, error) {
+	ret := _m.Called(ctx, db, providerSub)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByProviderSub")
+	}
+
+	var r0 NeosyncApiUser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, string) (NeosyncApiUser, error)); ok {
+		return rf(ctx, db, providerSub)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, string) NeosyncApiUser); ok {
+		r0 = rf(ctx, db, providerSub)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiUser)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, string) error); ok {
+		r1 = rf(ctx, db, providerSub)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUserByProviderSub_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByProviderSub'
+type MockQuerier_GetUserByProviderSub_Call struct {
+	*mock.Call
+}
+
+// GetUserByProviderSub is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - providerSub string
+func (_e *MockQuerier_Expecter) GetUserByProviderSub(ctx interface{}, db interface{}, providerSub interface{}) *MockQuerier_GetUserByProviderSub_Call {
+	return &MockQuerier_GetUserByProviderSub_Call{Call: _e.mock.On("GetUserByProviderSub", ctx, db, providerSub)}
+}
+
+func (_c *MockQuerier_GetUserByProviderSub_Call) Run(run func(ctx context.Context, db DBTX, providerSub string)) *MockQuerier_GetUserByProviderSub_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUserByProviderSub_Call) Return(_a0 NeosyncApiUser, _a1 error) *MockQuerier_GetUserByProviderSub_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUserByProviderSub_Call) RunAndReturn(run func(context.Context, DBTX, string) (NeosyncApiUser, error)) *MockQuerier_GetUserByProviderSub_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserDefinedTransformerById provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) GetUserDefinedTransformerById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiTransformer, error) {
+	ret := _m.Called(ctx, db, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserDefinedTransformerById")
+	}
+
+	var r0 NeosyncApiTransformer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiTransformer, error)); ok {
+		return rf(ctx, db, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiTransformer); ok {
+		r0 = rf(ctx, db, id)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiTransformer)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUserDefinedTransformerById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserDefinedTransformerById'
+type MockQuerier_GetUserDefinedTransformerById_Call struct {
+	*mock.Call
+}
+
+// GetUserDefinedTransformerById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) GetUserDefinedTransformerById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetUserDefinedTransformerById_Call {
+	return &MockQuerier_GetUserDefinedTransformerById_Call{Call: _e.mock.On("GetUserDefinedTransformerById", ctx, db, id)}
+}
+
+func (_c *MockQuerier_GetUserDefinedTransformerById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetUserDefinedTransformerById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUserDefinedTransformerById_Call) Return(_a0 NeosyncApiTransformer, _a1 error) *MockQuerier_GetUserDefinedTransformerById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUserDefinedTransformerById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiTransformer, error)) *MockQuerier_GetUserDefinedTransformerById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserDefinedTransformersByAccount provides a mock function with given fields: ctx, db, accountid
+func (_m *MockQuerier) GetUserDefinedTransformersByAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiTransformer, error) {
+	ret := _m.Called(ctx, db, accountid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserDefinedTransformersByAccount")
+	}
+
+	var r0 []NeosyncApiTransformer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiTransformer, error)); ok {
+		return rf(ctx, db, accountid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiTransformer); ok {
+		r0 = rf(ctx, db, accountid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiTransformer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, accountid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUserDefinedTransformersByAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserDefinedTransformersByAccount'
+type MockQuerier_GetUserDefinedTransformersByAccount_Call struct {
+	*mock.Call
+}
+
+// GetUserDefinedTransformersByAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - accountid pgtype.UUID
+func (_e *MockQuerier_Expecter) GetUserDefinedTransformersByAccount(ctx interface{}, db interface{}, accountid interface{}) *MockQuerier_GetUserDefinedTransformersByAccount_Call {
+	return &MockQuerier_GetUserDefinedTransformersByAccount_Call{Call: _e.mock.On("GetUserDefinedTransformersByAccount", ctx, db, accountid)}
+}
+
+func (_c *MockQuerier_GetUserDefinedTransformersByAccount_Call) Run(run func(ctx context.Context, db DBTX, accountid pgtype.UUID)) *MockQuerier_GetUserDefinedTransformersByAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUserDefinedTransformersByAccount_Call) Return(_a0 []NeosyncApiTransformer, _a1 error) *MockQuerier_GetUserDefinedTransformersByAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUserDefinedTransformersByAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiTransformer, error)) *MockQuerier_GetUserDefinedTransformersByAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserIdentitiesByTeamAccount provides a mock function with given fields: ctx, db, accountid
+func (_m *MockQuerier) GetUserIdentitiesByTeamAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error) {
+	ret := _m.Called(ctx, db, accountid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserIdentitiesByTeamAccount")
+	}
+
+	var r0 []NeosyncApiUserIdentityProviderAssociation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error)); ok {
+		return rf(ctx, db, accountid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiUserIdentityProviderAssociation); ok {
+		r0 = rf(ctx, db, accountid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiUserIdentityProviderAssociation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, accountid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUserIdentitiesByTeamAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserIdentitiesByTeamAccount'
+type MockQuerier_GetUserIdentitiesByTeamAccount_Call struct {
+	*mock.Call
+}
+
+// GetUserIdentitiesByTeamAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - accountid pgtype.UUID
+func (_e *MockQuerier_Expecter) GetUserIdentitiesByTeamAccount(ctx interface{}, db interface{}, accountid interface{}) *MockQuerier_GetUserIdentitiesByTeamAccount_Call {
+	return &MockQuerier_GetUserIdentitiesByTeamAccount_Call{Call: _e.mock.On("GetUserIdentitiesByTeamAccount", ctx, db, accountid)}
+}
+
+func (_c *MockQuerier_GetUserIdentitiesByTeamAccount_Call) Run(run func(ctx context.Context, db DBTX, accountid pgtype.UUID)) *MockQuerier_GetUserIdentitiesByTeamAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUserIdentitiesByTeamAccount_Call) Return(_a0 []NeosyncApiUserIdentityProviderAssociation, _a1 error) *MockQuerier_GetUserIdentitiesByTeamAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUserIdentitiesByTeamAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error)) *MockQuerier_GetUserIdentitiesByTeamAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserIdentityAssociationsByUserIds provides a mock function with given fields: ctx, db, dollar_1
+func (_m *MockQuerier) GetUserIdentityAssociationsByUserIds(ctx context.Context, db DBTX, dollar_1 []pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error) {
+	ret := _m.Called(ctx, db, dollar_1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserIdentityAssociationsByUserIds")
+	}
+
+	var r0 []NeosyncApiUserIdentityProviderAssociation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, []pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error)); ok {
+		return rf(ctx, db, dollar_1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, []pgtype.UUID) []NeosyncApiUserIdentityProviderAssociation); ok {
+		r0 = rf(ctx, db, dollar_1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiUserIdentityProviderAssociation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, []pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, dollar_1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUserIdentityAssociationsByUserIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserIdentityAssociationsByUserIds'
+type MockQuerier_GetUserIdentityAssociationsByUserIds_Call struct {
+	*mock.Call
+}
+
+// GetUserIdentityAssociationsByUserIds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - dollar_1 []pgtype.UUID
+func (_e *MockQuerier_Expecter) GetUserIdentityAssociationsByUserIds(ctx interface{}, db interface{}, dollar_1 interface{}) *MockQuerier_GetUserIdentityAssociationsByUserIds_Call {
+	return &MockQuerier_GetUserIdentityAssociationsByUserIds_Call{Call: _e.mock.On("GetUserIdentityAssociationsByUserIds", ctx, db, dollar_1)}
+}
+
+func (_c *MockQuerier_GetUserIdentityAssociationsByUserIds_Call) Run(run func(ctx context.Context, db DBTX, dollar_1 []pgtype.UUID)) *MockQuerier_GetUserIdentityAssociationsByUserIds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].([]pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUserIdentityAssociationsByUserIds_Call) Return(_a0 []NeosyncApiUserIdentityProviderAssociation, _a1 error) *MockQuerier_GetUserIdentityAssociationsByUserIds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUserIdentityAssociationsByUserIds_Call) RunAndReturn(run func(context.Context, DBTX, []pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error)) *MockQuerier_GetUserIdentityAssociationsByUserIds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserIdentityByUserId provides a mock function with given fields: ctx, db, userID
+func (_m *MockQuerier) GetUserIdentityByUserId(ctx context.Context, db DBTX, userID pgtype.UUID) (NeosyncApiUserIdentityProviderAssociation, error) {
+	ret := _m.Called(ctx, db, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserIdentityByUserId")
+	}
+
+	var r0 NeosyncApiUserIdentityProviderAssociation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiUserIdentityProviderAssociation, error)); ok {
+		return rf(ctx, db, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiUserIdentityProviderAssociation); ok {
+		r0 = rf(ctx, db, userID)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiUserIdentityProviderAssociation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUserIdentityByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserIdentityByUserId'
+type MockQuerier_GetUserIdentityByUserId_Call struct {
+	*mock.Call
+}
+
+// GetUserIdentityByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - userID pgtype.UUID
+func (_e *MockQuerier_Expecter) GetUserIdentityByUserId(ctx interface{}, db interface{}, userID interface{}) *MockQuerier_GetUserIdentityByUserId_Call {
+	return &MockQuerier_GetUserIdentityByUserId_Call{Call: _e.mock.On("GetUserIdentityByUserId", ctx, db, userID)}
+}
+
+func (_c *MockQuerier_GetUserIdentityByUserId_Call) Run(run func(ctx context.Context, db DBTX, userID pgtype.UUID)) *MockQuerier_GetUserIdentityByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUserIdentityByUserId_Call) Return(_a0 NeosyncApiUserIdentityProviderAssociation, _a1 error) *MockQuerier_GetUserIdentityByUserId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUserIdentityByUserId_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiUserIdentityProviderAssociation, error)) *MockQuerier_GetUserIdentityByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GrantAccountUserPermission provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GrantAccountUserPermission(ctx context.Context, db DBTX, arg GrantAccountUserPermissionParams) error {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GrantAccountUserPermission")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GrantAccountUserPermissionParams) error); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_GrantAccountUserPermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GrantAccountUserPermission'
+type MockQuerier_GrantAccountUserPermission_Call struct {
+	*mock.Call
+}
+
+// GrantAccountUserPermission is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg GrantAccountUserPermissionParams
+func (_e *MockQuerier_Expecter) GrantAccountUserPermission(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GrantAccountUserPermission_Call {
+	return &MockQuerier_GrantAccountUserPermission_Call{Call: _e.mock.On("GrantAccountUserPermission", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_GrantAccountUserPermission_Call) Run(run func(ctx context.Context, db DBTX, arg GrantAccountUserPermissionParams)) *MockQuerier_GrantAccountUserPermission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GrantAccountUserPermissionParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GrantAccountUserPermission_Call) Return(_a0 error) *MockQuerier_GrantAccountUserPermission_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_GrantAccountUserPermission_Call) RunAndReturn(run func(context.Context, DBTX, GrantAccountUserPermissionParams) error) *MockQuerier_GrantAccountUserPermission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsConnectionInAccount provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) IsConnectionInAccount(ctx context.Context, db DBTX, arg IsConnectionInAccountParams) (int64, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsConnectionInAccount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsConnectionInAccountParams) (int64, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsConnectionInAccountParams) int64); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, IsConnectionInAccountParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_IsConnectionInAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsConnectionInAccount'
+type MockQuerier_IsConnectionInAccount_Call struct {
+	*mock.Call
+}
+
+// IsConnectionInAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg IsConnectionInAccountParams
+func (_e *MockQuerier_Expecter) IsConnectionInAccount(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_IsConnectionInAccount_Call {
+	return &MockQuerier_IsConnectionInAccount_Call{Call: _e.mock.On("IsConnectionInAccount", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_IsConnectionInAccount_Call) Run(run func(ctx context.Context, db DBTX, arg IsConnectionInAccountParams)) *MockQuerier_IsConnectionInAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(IsConnectionInAccountParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_IsConnectionInAccount_Call) Return(_a0 int64, _a1 error) *MockQuerier_IsConnectionInAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_IsConnectionInAccount_Call) RunAndReturn(run func(context.Context, DBTX, IsConnectionInAccountParams) (int64, error)) *MockQuerier_IsConnectionInAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsConnectionNameAvailable provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) IsConnectionNameAvailable(ctx context.Context, db DBTX, arg IsConnectionNameAvailableParams) (int64, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsConnectionNameAvailable")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsConnectionNameAvailableParams) (int64, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsConnectionNameAvailableParams) int64); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, IsConnectionNameAvailableParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_IsConnectionNameAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsConnectionNameAvailable'
+type MockQuerier_IsConnectionNameAvailable_Call struct {
+	*mock.Call
+}
+
+// IsConnectionNameAvailable is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg IsConnectionNameAvailableParams
+func (_e *MockQuerier_Expecter) IsConnectionNameAvailable(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_IsConnectionNameAvailable_Call {
+	return &MockQuerier_IsConnectionNameAvailable_Call{Call: _e.mock.On("IsConnectionNameAvailable", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_IsConnectionNameAvailable_Call) Run(run func(ctx context.Context, db DBTX, arg IsConnectionNameAvailableParams)) *MockQuerier_IsConnectionNameAvailable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(IsConnectionNameAvailableParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_IsConnectionNameAvailable_Call) Return(_a0 int64, _a1 error) *MockQuerier_IsConnectionNameAvailable_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_IsConnectionNameAvailable_Call) RunAndReturn(run func(context.Context, DBTX, IsConnectionNameAvailableParams) (int64, error)) *MockQuerier_IsConnectionNameAvailable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsJobNameAvailable provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) IsJobNameAvailable(ctx context.Context, db DBTX, arg IsJobNameAvailableParams) (int64, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsJobNameAvailable")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsJobNameAvailableParams) (int64, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsJobNameAvailableParams) int64); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, IsJobNameAvailableParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_IsJobNameAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsJobNameAvailable'
+type MockQuerier_IsJobNameAvailable_Call struct {
+	*mock.Call
+}
+
+// IsJobNameAvailable is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg IsJobNameAvailableParams
+func (_e *MockQuerier_Expecter) IsJobNameAvailable(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_IsJobNameAvailable_Call {
+	return &MockQuerier_IsJobNameAvailable_Call{Call: _e.mock.On("IsJobNameAvailable", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_IsJobNameAvailable_Call) Run(run func(ctx context.Context, db DBTX, arg IsJobNameAvailableParams)) *MockQuerier_IsJobNameAvailable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(IsJobNameAvailableParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_IsJobNameAvailable_Call) Return(_a0 int64, _a1 error) *MockQuerier_IsJobNameAvailable_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_IsJobNameAvailable_Call) RunAndReturn(run func(context.Context, DBTX, IsJobNameAvailableParams) (int64, error)) *MockQuerier_IsJobNameAvailable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsTransformerNameAvailable provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) IsTransformerNameAvailable(ctx context.Context, db DBTX, arg IsTransformerNameAvailableParams) (int64, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsTransformerNameAvailable")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsTransformerNameAvailableParams) (int64, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsTransformerNameAvailableParams) int64); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, IsTransformerNameAvailableParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_IsTransformerNameAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsTransformerNameAvailable'
+type MockQuerier_IsTransformerNameAvailable_Call struct {
+	*mock.Call
+}
+
+// IsTransformerNameAvailable is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg IsTransformerNameAvailableParams
+func (_e *MockQuerier_Expecter) IsTransformerNameAvailable(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_IsTransformerNameAvailable_Call {
+	return &MockQuerier_IsTransformerNameAvailable_Call{Call: _e.mock.On("IsTransformerNameAvailable", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_IsTransformerNameAvailable_Call) Run(run func(ctx context.Context, db DBTX, arg IsTransformerNameAvailableParams)) *MockQuerier_IsTransformerNameAvailable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(IsTransformerNameAvailableParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_IsTransformerNameAvailable_Call) Return(_a0 int64, _a1 error) *MockQuerier_IsTransformerNameAvailable_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_IsTransformerNameAvailable_Call) RunAndReturn(run func(context.Context, DBTX, IsTransformerNameAvailableParams) (int64, error)) *MockQuerier_IsTransformerNameAvailable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsUserInAccount provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) IsUserInAccount(ctx context.Context, db DBTX, arg IsUserInAccountParams) (int64, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsUserInAccount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsUserInAccountParams) (int64, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsUserInAccountParams) int64); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, IsUserInAccountParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_IsUserInAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUserInAccount'
+type MockQuerier_IsUserInAccount_Call struct {
+	*mock.Call
+}
+
+// IsUserInAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg IsUserInAccountParams
+func (_e *MockQuerier_Expecter) IsUserInAccount(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_IsUserInAccount_Call {
+	return &MockQuerier_IsUserInAccount_Call{Call: _e.mock.On("IsUserInAccount", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_IsUserInAccount_Call) Run(run func(ctx context.Context, db DBTX, arg IsUserInAccountParams)) *MockQuerier_IsUserInAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(IsUserInAccountParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_IsUserInAccount_Call) Return(_a0 int64, _a1 error) *MockQuerier_IsUserInAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_IsUserInAccount_Call) RunAndReturn(run func(context.Context, DBTX, IsUserInAccountParams) (int64, error)) *MockQuerier_IsUserInAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsUserInAccountApiKey provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) IsUserInAccountApiKey(ctx context.Context, db DBTX, arg IsUserInAccountApiKeyParams) (int64, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsUserInAccountApiKey")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsUserInAccountApiKeyParams) (int64, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsUserInAccountApiKeyParams) int64); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, IsUserInAccountApiKeyParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_IsUserInAccountApiKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUserInAccountApiKey'
+type MockQuerier_IsUserInAccountApiKey_Call struct {
+	*mock.Call
+}
+
+// IsUserInAccountApiKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg IsUserInAccountApiKeyParams
+func (_e *MockQuerier_Expecter) IsUserInAccountApiKey(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_IsUserInAccountApiKey_Call {
+	return &MockQuerier_IsUserInAccountApiKey_Call{Call: _e.mock.On("IsUserInAccountApiKey", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_IsUserInAccountApiKey_Call) Run(run func(ctx context.Context, db DBTX, arg IsUserInAccountApiKeyParams)) *MockQuerier_IsUserInAccountApiKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(IsUserInAccountApiKeyParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_IsUserInAccountApiKey_Call) Return(_a0 int64, _a1 error) *MockQuerier_IsUserInAccountApiKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_IsUserInAccountApiKey_Call) RunAndReturn(run func(context.Context, DBTX, IsUserInAccountApiKeyParams) (int64, error)) *MockQuerier_IsUserInAccountApiKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveAccountApiKey provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) RemoveAccountApiKey(ctx context.Context, db DBTX, id pgtype.UUID) error {
+	ret := _m.Called(ctx, db, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveAccountApiKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r0 = rf(ctx, db, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_RemoveAccountApiKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAccountApiKey'
+type MockQuerier_RemoveAccountApiKey_Call struct {
+	*mock.Call
+}
+
+// RemoveAccountApiKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) RemoveAccountApiKey(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveAccountApiKey_Call {
+	return &MockQuerier_RemoveAccountApiKey_Call{Call: _e.mock.On("RemoveAccountApiKey", ctx, db, id)}
+}
+
+func (_c *MockQuerier_RemoveAccountApiKey_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveAccountApiKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RemoveAccountApiKey_Call) Return(_a0 error) *MockQuerier_RemoveAccountApiKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_RemoveAccountApiKey_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveAccountApiKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveAccountInvite provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) RemoveAccountInvite(ctx context.Context, db DBTX, id pgtype.UUID) error {
+	ret := _m.Called(ctx, db, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveAccountInvite")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r0 = rf(ctx, db, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_RemoveAccountInvite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAccountInvite'
+type MockQuerier_RemoveAccountInvite_Call struct {
+	*mock.Call
+}
+
+// RemoveAccountInvite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) RemoveAccountInvite(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveAccountInvite_Call {
+	return &MockQuerier_RemoveAccountInvite_Call{Call: _e.mock.On("RemoveAccountInvite", ctx, db, id)}
+}
+
+func (_c *MockQuerier_RemoveAccountInvite_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveAccountInvite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RemoveAccountInvite_Call) Return(_a0 error) *MockQuerier_RemoveAccountInvite_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_RemoveAccountInvite_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveAccountInvite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveAccountUser provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) RemoveAccountUser(ctx context.Context, db DBTX, arg RemoveAccountUserParams) error {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveAccountUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, RemoveAccountUserParams) error); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_RemoveAccountUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAccountUser'
+type MockQuerier_RemoveAccountUser_Call struct {
+	*mock.Call
+}
+
+// RemoveAccountUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg RemoveAccountUserParams
+func (_e *MockQuerier_Expecter) RemoveAccountUser(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_RemoveAccountUser_Call {
+	return &MockQuerier_RemoveAccountUser_Call{Call: _e.mock.On("RemoveAccountUser", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_RemoveAccountUser_Call) Run(run func(ctx context.Context, db DBTX, arg RemoveAccountUserParams)) *MockQuerier_RemoveAccountUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(RemoveAccountUserParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RemoveAccountUser_Call) Return(_a0 error) *MockQuerier_RemoveAccountUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_RemoveAccountUser_Call) RunAndReturn(run func(context.Context, DBTX, RemoveAccountUserParams) error) *MockQuerier_RemoveAccountUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveColumnTag provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) RemoveColumnTag(ctx context.Context, db DBTX, id pgtype.UUID) error {
+	ret := _m.Called(ctx, db, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveColumnTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r0 = rf(ctx, db, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_RemoveColumnTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveColumnTag'
+type MockQuerier_RemoveColumnTag_Call struct {
+	*mock.Call
+}
+
+// RemoveColumnTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) RemoveColumnTag(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveColumnTag_Call {
+	return &MockQuerier_RemoveColumnTag_Call{Call: _e.mock.On("RemoveColumnTag", ctx, db, id)}
+}
+
+func (_c *MockQuerier_RemoveColumnTag_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveColumnTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RemoveColumnTag_Call) Return(_a0 error) *MockQuerier_RemoveColumnTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_RemoveColumnTag_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveColumnTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveConnectionById provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) RemoveConnectionById(ctx context.Context, db DBTX, id pgtype.UUID) error {
+	ret := _m.Called(ctx, db, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveConnectionById")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r0 = rf(ctx, db, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_RemoveConnectionById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveConnectionById'
+type MockQuerier_RemoveConnectionById_Call struct {
+	*mock.Call
+}
+
+// RemoveConnectionById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) RemoveConnectionById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveConnectionById_Call {
+	return &MockQuerier_RemoveConnectionById_Call{Call: _e.mock.On("RemoveConnectionById", ctx, db, id)}
+}
+
+func (_c *MockQuerier_RemoveConnectionById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveConnectionById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RemoveConnectionById_Call) Return(_a0 error) *MockQuerier_RemoveConnectionById_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_RemoveConnectionById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveConnectionById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveConnectionByNameAndAccount provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) RemoveConnectionByNameAndAccount(ctx context.Context, db DBTX, arg RemoveConnectionByNameAndAccountParams) error {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveConnectionByNameAndAccount")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, RemoveConnectionByNameAndAccountParams) error); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_RemoveConnectionByNameAndAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveConnectionByNameAndAccount'
+type MockQuerier_RemoveConnectionByNameAndAccount_Call struct {
+	*mock.Call
+}
+
+// RemoveConnectionByNameAndAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg RemoveConnectionByNameAndAccountParams
+func (_e *MockQuerier_Expecter) RemoveConnectionByNameAndAccount(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_RemoveConnectionByNameAndAccount_Call {
+	return &MockQuerier_RemoveConnectionByNameAndAccount_Call{Call: _e.mock.On("RemoveConnectionByNameAndAccount", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_RemoveConnectionByNameAndAccount_Call) Run(run func(ctx context.Context, db DBTX, arg RemoveConnectionByNameAndAccountParams)) *MockQuerier_RemoveConnectionByNameAndAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(RemoveConnectionByNameAndAccountParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RemoveConnectionByNameAndAccount_Call) Return(_a0 error) *MockQuerier_RemoveConnectionByNameAndAccount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_RemoveConnectionByNameAndAccount_Call) RunAndReturn(run func(context.Context, DBTX, RemoveConnectionByNameAndAccountParams) error) *MockQuerier_RemoveConnectionByNameAndAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveConnectionSavedQuery provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) RemoveConnectionSavedQuery(ctx context.Context, db DBTX, id pgtype.UUID) error {
+	ret := _m.Called(ctx, db, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveConnectionSavedQuery")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r0 = rf(ctx, db, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_RemoveConnectionSavedQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveConnectionSavedQuery'
+type MockQuerier_RemoveConnectionSavedQuery_Call struct {
+	*mock.Call
+}
+
+// RemoveConnectionSavedQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) RemoveConnectionSavedQuery(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveConnectionSavedQuery_Call {
+	return &MockQuerier_RemoveConnectionSavedQuery_Call{Call: _e.mock.On("RemoveConnectionSavedQuery", ctx, db, id)}
+}
+
+func (_c *MockQuerier_RemoveConnectionSavedQuery_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveConnectionSavedQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RemoveConnectionSavedQuery_Call) Return(_a0 error) *MockQuerier_RemoveConnectionSavedQuery_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_RemoveConnectionSavedQuery_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveConnectionSavedQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveConnectionTableBookmark provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) RemoveConnectionTableBookmark(ctx context.Context, db DBTX, id pgtype.UUID) error {
+	ret := _m.Called(ctx, db, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveConnectionTableBookmark")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r0 = rf(ctx, db, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_RemoveConnectionTableBookmark_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveConnectionTableBookmark'
+type MockQuerier_RemoveConnectionTableBookmark_Call struct {
+	*mock.Call
+}
+
+// RemoveConnectionTableBookmark is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) RemoveConnectionTableBookmark(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveConnectionTableBookmark_Call {
+	return &MockQuerier_RemoveConnectionTableBookmark_Call{Call: _e.mock.On("RemoveConnectionTableBookmark", ctx, db, id)}
+}
+
+func (_c *MockQuerier_RemoveConnectionTableBookmark_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveConnectionTableBookmark_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RemoveConnectionTableBookmark_Call) Return(_a0 error) *MockQuerier_RemoveConnectionTableBookmark_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_RemoveConnectionTableBookmark_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveConnectionTableBookmark_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveIdempotencyKey provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) RemoveIdempotencyKey(ctx context.Context, db DBTX, id pgtype.UUID) error {
+	ret := _m.Called(ctx, db, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveIdempotencyKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r0 = rf(ctx, db, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_RemoveIdempotencyKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveIdempotencyKey'
+type MockQuerier_RemoveIdempotencyKey_Call struct {
+	*mock.Call
+}
+
+// RemoveIdempotencyKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) RemoveIdempotencyKey(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveIdempotencyKey_Call {
+	return &MockQuerier_RemoveIdempotencyKey_Call{Call: _e.mock.On("RemoveIdempotencyKey", ctx, db, id)}
+}
+
+func (_c *MockQuerier_RemoveIdempotencyKey_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveIdempotencyKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RemoveIdempotencyKey_Call) Return(_a0 error) *MockQuerier_RemoveIdempotencyKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_RemoveIdempotencyKey_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveIdempotencyKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveJavascriptLibrary provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) RemoveJavascriptLibrary(ctx context.Context, db DBTX, arg RemoveJavascriptLibraryParams) error {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveJavascriptLibrary")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, RemoveJavascriptLibraryParams) error); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_RemoveJavascriptLibrary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveJavascriptLibrary'
+type MockQuerier_RemoveJavascriptLibrary_Call struct {
+	*mock.Call
+}
+
+// RemoveJavascriptLibrary is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg RemoveJavascriptLibraryParams
+func (_e *MockQuerier_Expecter) RemoveJavascriptLibrary(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_RemoveJavascriptLibrary_Call {
+	return &MockQuerier_RemoveJavascriptLibrary_Call{Call: _e.mock.On("RemoveJavascriptLibrary", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_RemoveJavascriptLibrary_Call) Run(run func(ctx context.Context, db DBTX, arg RemoveJavascriptLibraryParams)) *MockQuerier_RemoveJavascriptLibrary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(RemoveJavascriptLibraryParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RemoveJavascriptLibrary_Call) Return(_a0 error) *MockQuerier_RemoveJavascriptLibrary_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_RemoveJavascriptLibrary_Call) RunAndReturn(run func(context.Context, DBTX, RemoveJavascriptLibraryParams) error) *MockQuerier_RemoveJavascriptLibrary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveJobById provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) RemoveJobById(ctx context.Context, db DBTX, id pgtype.UUID) error {
+	ret := _m.Called(ctx, db, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveJobById")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r0 = rf(ctx, db, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_RemoveJobById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveJobById'
+type MockQuerier_RemoveJobById_Call struct {
+	*mock.Call
+}
+
+// RemoveJobById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) RemoveJobById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveJobById_Call {
+	return &MockQuerier_RemoveJobById_Call{Call: _e.mock.On("RemoveJobById", ctx, db, id)}
+}
+
+func (_c *MockQuerier_RemoveJobById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveJobById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RemoveJobById_Call) Return(_a0 error) *MockQuerier_RemoveJobById_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_RemoveJobById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveJobById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveJobConnectionDestination provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) RemoveJobConnectionDestination(ctx context.Context, db DBTX, id pgtype.UUID) error {
+	ret := _m.Called(ctx, db, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveJobConnectionDestination")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r0 = rf(ctx, db, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_RemoveJobConnectionDestination_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveJobConnectionDestination'
+type MockQuerier_RemoveJobConnectionDestination_Call struct {
+	*mock.Call
+}
+
+// RemoveJobConnectionDestination is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) RemoveJobConnectionDestination(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveJobConnectionDestination_Call {
+	return &MockQuerier_RemoveJobConnectionDestination_Call{Call: _e.mock.On("RemoveJobConnectionDestination", ctx, db, id)}
+}
+
+func (_c *MockQuerier_RemoveJobConnectionDestination_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveJobConnectionDestination_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RemoveJobConnectionDestination_Call) Return(_a0 error) *MockQuerier_RemoveJobConnectionDestination_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_RemoveJobConnectionDestination_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveJobConnectionDestination_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveJobConnectionDestinations provides a mock function with given fields: ctx, db, jobids
+func (_m *MockQuerier) RemoveJobConnectionDestinations(ctx context.Context, db DBTX, jobids []pgtype.UUID) error {
+	ret := _m.Called(ctx, db, jobids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveJobConnectionDestinations")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, IsUserInAccountParams) error); ok {
-		r1 = rf(ctx, db, arg)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, []pgtype.UUID) error); ok {
+		r0 = rf(ctx, db, jobids)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockQuerier_IsUserInAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUserInAccount'
-type MockQuerier_IsUserInAccount_Call struct {
+// MockQuerier_RemoveJobConnectionDestinations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveJobConnectionDestinations'
+type MockQuerier_RemoveJobConnectionDestinations_Call struct {
 	*mock.Call
 }
 
-// IsUserInAccount is a helper method to define mock.On call
+// RemoveJobConnectionDestinations is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - arg IsUserInAccountParams
-func (_e *MockQuerier_Expecter) IsUserInAccount(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_IsUserInAccount_Call {
-	return &MockQuerier_IsUserInAccount_Call{Call: _e.mock.On("IsUserInAccount", ctx, db, arg)}
+//   - jobids []pgtype.UUID
+func (_e *MockQuerier_Expecter) RemoveJobConnectionDestinations(ctx interface{}, db interface{}, jobids interface{}) *MockQuerier_RemoveJobConnectionDestinations_Call {
+	return &MockQuerier_RemoveJobConnectionDestinations_Call{Call: _e.mock.On("RemoveJobConnectionDestinations", ctx, db, jobids)}
 }
 
-func (_c *MockQuerier_IsUserInAccount_Call) Run(run func(ctx context.Context, db DBTX, arg IsUserInAccountParams)) *MockQuerier_IsUserInAccount_Call {
+func (_c *MockQuerier_RemoveJobConnectionDestinations_Call) Run(run func(ctx context.Context, db DBTX, jobids []pgtype.UUID)) *MockQuerier_RemoveJobConnectionDestinations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(IsUserInAccountParams))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].([]pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_IsUserInAccount_Call) Return(_a0 int64, _a1 error) *MockQuerier_IsUserInAccount_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockQuerier_RemoveJobConnectionDestinations_Call) Return(_a0 error) *MockQuerier_RemoveJobConnectionDestinations_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockQuerier_IsUserInAccount_Call) RunAndReturn(run func(context.Context, DBTX, IsUserInAccountParams) (int64, error)) *MockQuerier_IsUserInAccount_Call {
+func (_c *MockQuerier_RemoveJobConnectionDestinations_Call) RunAndReturn(run func(context.Context, DBTX, []pgtype.UUID) error) *MockQuerier_RemoveJobConnectionDestinations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsUserInAccountApiKey provides a mock function with given fields: ctx, db, arg
-func (_m *MockQuerier) IsUserInAccountApiKey(ctx context.Context, db DBTX, arg IsUserInAccountApiKeyParams) (int64, error) {
+// RemoveJobRunArtifacts provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) RemoveJobRunArtifacts(ctx context.Context, db DBTX, arg RemoveJobRunArtifactsParams) error {
 	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsUserInAccountApiKey")
+		panic("no return value specified for RemoveJobRunArtifacts")
 	}
 
-	var r0 int64
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsUserInAccountApiKeyParams) (int64, error)); ok {
-		return rf(ctx, db, arg)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsUserInAccountApiKeyParams) int64); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, RemoveJobRunArtifactsParams) error); ok {
 		r0 = rf(ctx, db, arg)
 	} else {
-		r0 = ret.Get(0).(int64)
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, IsUserInAccountApiKeyParams) error); ok {
-		r1 = rf(ctx, db, arg)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockQuerier_IsUserInAccountApiKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUserInAccountApiKey'
-type MockQuerier_IsUserInAccountApiKey_Call struct {
+// MockQuerier_RemoveJobRunArtifacts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveJobRunArtifacts'
+type MockQuerier_RemoveJobRunArtifacts_Call struct {
 	*mock.Call
 }
 
-// IsUserInAccountApiKey is a helper method to define mock.On call
+// RemoveJobRunArtifacts is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - arg IsUserInAccountApiKeyParams
-func (_e *MockQuerier_Expecter) IsUserInAccountApiKey(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_IsUserInAccountApiKey_Call {
-	return &MockQuerier_IsUserInAccountApiKey_Call{Call: _e.mock.On("IsUserInAccountApiKey", ctx, db, arg)}
+//   - arg RemoveJobRunArtifactsParams
+func (_e *MockQuerier_Expecter) RemoveJobRunArtifacts(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_RemoveJobRunArtifacts_Call {
+	return &MockQuerier_RemoveJobRunArtifacts_Call{Call: _e.mock.On("RemoveJobRunArtifacts", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_IsUserInAccountApiKey_Call) Run(run func(ctx context.Context, db DBTX, arg IsUserInAccountApiKeyParams)) *MockQuerier_IsUserInAccountApiKey_Call {
+func (_c *MockQuerier_RemoveJobRunArtifacts_Call) Run(run func(ctx context.Context, db DBTX, arg RemoveJobRunArtifactsParams)) *MockQuerier_RemoveJobRunArtifacts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(IsUserInAccountApiKeyParams))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(RemoveJobRunArtifactsParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_IsUserInAccountApiKey_Call) Return(_a0 int64, _a1 error) *MockQuerier_IsUserInAccountApiKey_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockQuerier_RemoveJobRunArtifacts_Call) Return(_a0 error) *MockQuerier_RemoveJobRunArtifacts_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockQuerier_IsUserInAccountApiKey_Call) RunAndReturn(run func(context.Context, DBTX, IsUserInAccountApiKeyParams) (int64, error)) *MockQuerier_IsUserInAccountApiKey_Call {
+func (_c *MockQuerier_RemoveJobRunArtifacts_Call) RunAndReturn(run func(context.Context, DBTX, RemoveJobRunArtifactsParams) error) *MockQuerier_RemoveJobRunArtifacts_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveAccountApiKey provides a mock function with given fields: ctx, db, id
-func (_m *MockQuerier) RemoveAccountApiKey(ctx context.Context, db DBTX, id pgtype.UUID) error {
+// RemoveJobRunRequest provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) RemoveJobRunRequest(ctx context.Context, db DBTX, id pgtype.UUID) error {
 	ret := _m.Called(ctx, db, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveAccountApiKey")
+		panic("no return value specified for RemoveJobRunRequest")
 	}
 
 	var r0 error
@@ -3239,42 +5834,42 @@ func (_m *MockQuerier) RemoveAccountApiKey(ctx context.Context, db DBTX, id pgty
 	return r0
 }
 
-// MockQuerier_RemoveAccountApiKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAccountApiKey'
-type MockQuerier_RemoveAccountApiKey_Call struct {
+// MockQuerier_RemoveJobRunRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveJobRunRequest'
+type MockQuerier_RemoveJobRunRequest_Call struct {
 	*mock.Call
 }
 
-// RemoveAccountApiKey is a helper method to define mock.On call
+// RemoveJobRunRequest is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
 //   - id pgtype.UUID
-func (_e *MockQuerier_Expecter) RemoveAccountApiKey(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveAccountApiKey_Call {
-	return &MockQuerier_RemoveAccountApiKey_Call{Call: _e.mock.On("RemoveAccountApiKey", ctx, db, id)}
+func (_e *MockQuerier_Expecter) RemoveJobRunRequest(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveJobRunRequest_Call {
+	return &MockQuerier_RemoveJobRunRequest_Call{Call: _e.mock.On("RemoveJobRunRequest", ctx, db, id)}
 }
 
-func (_c *MockQuerier_RemoveAccountApiKey_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveAccountApiKey_Call {
+func (_c *MockQuerier_RemoveJobRunRequest_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveJobRunRequest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_RemoveAccountApiKey_Call) Return(_a0 error) *MockQuerier_RemoveAccountApiKey_Call {
+func (_c *MockQuerier_RemoveJobRunRequest_Call) Return(_a0 error) *MockQuerier_RemoveJobRunRequest_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockQuerier_RemoveAccountApiKey_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveAccountApiKey_Call {
+func (_c *MockQuerier_RemoveJobRunRequest_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveJobRunRequest_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveAccountInvite provides a mock function with given fields: ctx, db, id
-func (_m *MockQuerier) RemoveAccountInvite(ctx context.Context, db DBTX, id pgtype.UUID) error {
+// RemoveJobRunSnapshot provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) RemoveJobRunSnapshot(ctx context.Context, db DBTX, id pgtype.UUID) error {
 	ret := _m.Called(ctx, db, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveAccountInvite")
+		panic("no return value specified for RemoveJobRunSnapshot")
 	}
 
 	var r0 error
@@ -3287,47 +5882,47 @@ func (_m *MockQuerier) RemoveAccountInvite(ctx context.Context, db DBTX, id pgty
 	return r0
 }
 
-// MockQuerier_RemoveAccountInvite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAccountInvite'
-type MockQuerier_RemoveAccountInvite_Call struct {
+// MockQuerier_RemoveJobRunSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveJobRunSnapshot'
+type MockQuerier_RemoveJobRunSnapshot_Call struct {
 	*mock.Call
 }
 
-// RemoveAccountInvite is a helper method to define mock.On call
+// RemoveJobRunSnapshot is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
 //   - id pgtype.UUID
-func (_e *MockQuerier_Expecter) RemoveAccountInvite(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveAccountInvite_Call {
-	return &MockQuerier_RemoveAccountInvite_Call{Call: _e.mock.On("RemoveAccountInvite", ctx, db, id)}
+func (_e *MockQuerier_Expecter) RemoveJobRunSnapshot(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveJobRunSnapshot_Call {
+	return &MockQuerier_RemoveJobRunSnapshot_Call{Call: _e.mock.On("RemoveJobRunSnapshot", ctx, db, id)}
 }
 
-func (_c *MockQuerier_RemoveAccountInvite_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveAccountInvite_Call {
+func (_c *MockQuerier_RemoveJobRunSnapshot_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveJobRunSnapshot_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_RemoveAccountInvite_Call) Return(_a0 error) *MockQuerier_RemoveAccountInvite_Call {
+func (_c *MockQuerier_RemoveJobRunSnapshot_Call) Return(_a0 error) *MockQuerier_RemoveJobRunSnapshot_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockQuerier_RemoveAccountInvite_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveAccountInvite_Call {
+func (_c *MockQuerier_RemoveJobRunSnapshot_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveJobRunSnapshot_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveAccountUser provides a mock function with given fields: ctx, db, arg
-func (_m *MockQuerier) RemoveAccountUser(ctx context.Context, db DBTX, arg RemoveAccountUserParams) error {
-	ret := _m.Called(ctx, db, arg)
+// RemovePendingJobChangeRequests provides a mock function with given fields: ctx, db, jobID
+func (_m *MockQuerier) RemovePendingJobChangeRequests(ctx context.Context, db DBTX, jobID pgtype.UUID) error {
+	ret := _m.Called(ctx, db, jobID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveAccountUser")
+		panic("no return value specified for RemovePendingJobChangeRequests")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, RemoveAccountUserParams) error); ok {
-		r0 = rf(ctx, db, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r0 = rf(ctx, db, jobID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -3335,47 +5930,47 @@ func (_m *MockQuerier) RemoveAccountUser(ctx context.Context, db DBTX, arg Remov
 	return r0
 }
 
-// MockQuerier_RemoveAccountUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAccountUser'
-type MockQuerier_RemoveAccountUser_Call struct {
+// MockQuerier_RemovePendingJobChangeRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemovePendingJobChangeRequests'
+type MockQuerier_RemovePendingJobChangeRequests_Call struct {
 	*mock.Call
 }
 
-// RemoveAccountUser is a helper method to define mock.On call
+// RemovePendingJobChangeRequests is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - arg RemoveAccountUserParams
-func (_e *MockQuerier_Expecter) RemoveAccountUser(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_RemoveAccountUser_Call {
-	return &MockQuerier_RemoveAccountUser_Call{Call: _e.mock.On("RemoveAccountUser", ctx, db, arg)}
+//   - jobID pgtype.UUID
+func (_e *MockQuerier_Expecter) RemovePendingJobChangeRequests(ctx interface{}, db interface{}, jobID interface{}) *MockQuerier_RemovePendingJobChangeRequests_Call {
+	return &MockQuerier_RemovePendingJobChangeRequests_Call{Call: _e.mock.On("RemovePendingJobChangeRequests", ctx, db, jobID)}
 }
 
-func (_c *MockQuerier_RemoveAccountUser_Call) Run(run func(ctx context.Context, db DBTX, arg RemoveAccountUserParams)) *MockQuerier_RemoveAccountUser_Call {
+func (_c *MockQuerier_RemovePendingJobChangeRequests_Call) Run(run func(ctx context.Context, db DBTX, jobID pgtype.UUID)) *MockQuerier_RemovePendingJobChangeRequests_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(RemoveAccountUserParams))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_RemoveAccountUser_Call) Return(_a0 error) *MockQuerier_RemoveAccountUser_Call {
+func (_c *MockQuerier_RemovePendingJobChangeRequests_Call) Return(_a0 error) *MockQuerier_RemovePendingJobChangeRequests_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockQuerier_RemoveAccountUser_Call) RunAndReturn(run func(context.Context, DBTX, RemoveAccountUserParams) error) *MockQuerier_RemoveAccountUser_Call {
+func (_c *MockQuerier_RemovePendingJobChangeRequests_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemovePendingJobChangeRequests_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveConnectionById provides a mock function with given fields: ctx, db, id
-func (_m *MockQuerier) RemoveConnectionById(ctx context.Context, db DBTX, id pgtype.UUID) error {
-	ret := _m.Called(ctx, db, id)
+// RemoveResourceLabels provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) RemoveResourceLabels(ctx context.Context, db DBTX, arg RemoveResourceLabelsParams) error {
+	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveConnectionById")
+		panic("no return value specified for RemoveResourceLabels")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
-		r0 = rf(ctx, db, id)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, RemoveResourceLabelsParams) error); ok {
+		r0 = rf(ctx, db, arg)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -3383,95 +5978,105 @@ func (_m *MockQuerier) RemoveConnectionById(ctx context.Context, db DBTX, id pgt
 	return r0
 }
 
-// MockQuerier_RemoveConnectionById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveConnectionById'
-type MockQuerier_RemoveConnectionById_Call struct {
+// MockQuerier_RemoveResourceLabels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveResourceLabels'
+type MockQuerier_RemoveResourceLabels_Call struct {
 	*mock.Call
 }
 
-// RemoveConnectionById is a helper method to define mock.On call
+// RemoveResourceLabels is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - id pgtype.UUID
-func (_e *MockQuerier_Expecter) RemoveConnectionById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveConnectionById_Call {
-	return &MockQuerier_RemoveConnectionById_Call{Call: _e.mock.On("RemoveConnectionById", ctx, db, id)}
+//   - arg RemoveResourceLabelsParams
+func (_e *MockQuerier_Expecter) RemoveResourceLabels(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_RemoveResourceLabels_Call {
+	return &MockQuerier_RemoveResourceLabels_Call{Call: _e.mock.On("RemoveResourceLabels", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_RemoveConnectionById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveConnectionById_Call {
+func (_c *MockQuerier_RemoveResourceLabels_Call) Run(run func(ctx context.Context, db DBTX, arg RemoveResourceLabelsParams)) *MockQuerier_RemoveResourceLabels_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(RemoveResourceLabelsParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_RemoveConnectionById_Call) Return(_a0 error) *MockQuerier_RemoveConnectionById_Call {
+func (_c *MockQuerier_RemoveResourceLabels_Call) Return(_a0 error) *MockQuerier_RemoveResourceLabels_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockQuerier_RemoveConnectionById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveConnectionById_Call {
+func (_c *MockQuerier_RemoveResourceLabels_Call) RunAndReturn(run func(context.Context, DBTX, RemoveResourceLabelsParams) error) *MockQuerier_RemoveResourceLabels_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveConnectionByNameAndAccount provides a mock function with given fields: ctx, db, arg
-func (_m *MockQuerier) RemoveConnectionByNameAndAccount(ctx context.Context, db DBTX, arg RemoveConnectionByNameAndAccountParams) error {
+// ReserveIdempotencyKey provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) ReserveIdempotencyKey(ctx context.Context, db DBTX, arg ReserveIdempotencyKeyParams) (NeosyncApiIdempotencyKey, error) {
 	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveConnectionByNameAndAccount")
+		panic("no return value specified for ReserveIdempotencyKey")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, RemoveConnectionByNameAndAccountParams) error); ok {
+	var r0 NeosyncApiIdempotencyKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, ReserveIdempotencyKeyParams) (NeosyncApiIdempotencyKey, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, ReserveIdempotencyKeyParams) NeosyncApiIdempotencyKey); ok {
 		r0 = rf(ctx, db, arg)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(NeosyncApiIdempotencyKey)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, ReserveIdempotencyKeyParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockQuerier_RemoveConnectionByNameAndAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveConnectionByNameAndAccount'
-type MockQuerier_RemoveConnectionByNameAndAccount_Call struct {
+// MockQuerier_ReserveIdempotencyKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveIdempotencyKey'
+type MockQuerier_ReserveIdempotencyKey_Call struct {
 	*mock.Call
 }
 
-// RemoveConnectionByNameAndAccount is a helper method to define mock.On call
+// ReserveIdempotencyKey is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - arg RemoveConnectionByNameAndAccountParams
-func (_e *MockQuerier_Expecter) RemoveConnectionByNameAndAccount(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_RemoveConnectionByNameAndAccount_Call {
-	return &MockQuerier_RemoveConnectionByNameAndAccount_Call{Call: _e.mock.On("RemoveConnectionByNameAndAccount", ctx, db, arg)}
+//   - arg ReserveIdempotencyKeyParams
+func (_e *MockQuerier_Expecter) ReserveIdempotencyKey(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_ReserveIdempotencyKey_Call {
+	return &MockQuerier_ReserveIdempotencyKey_Call{Call: _e.mock.On("ReserveIdempotencyKey", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_RemoveConnectionByNameAndAccount_Call) Run(run func(ctx context.Context, db DBTX, arg RemoveConnectionByNameAndAccountParams)) *MockQuerier_RemoveConnectionByNameAndAccount_Call {
+func (_c *MockQuerier_ReserveIdempotencyKey_Call) Run(run func(ctx context.Context, db DBTX, arg ReserveIdempotencyKeyParams)) *MockQuerier_ReserveIdempotencyKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(RemoveConnectionByNameAndAccountParams))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(ReserveIdempotencyKeyParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_RemoveConnectionByNameAndAccount_Call) Return(_a0 error) *MockQuerier_RemoveConnectionByNameAndAccount_Call {
-	_c.Call.Return(_a0)
+func (_c *MockQuerier_ReserveIdempotencyKey_Call) Return(_a0 NeosyncApiIdempotencyKey, _a1 error) *MockQuerier_ReserveIdempotencyKey_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_RemoveConnectionByNameAndAccount_Call) RunAndReturn(run func(context.Context, DBTX, RemoveConnectionByNameAndAccountParams) error) *MockQuerier_RemoveConnectionByNameAndAccount_Call {
+func (_c *MockQuerier_ReserveIdempotencyKey_Call) RunAndReturn(run func(context.Context, DBTX, ReserveIdempotencyKeyParams) (NeosyncApiIdempotencyKey, error)) *MockQuerier_ReserveIdempotencyKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveJobById provides a mock function with given fields: ctx, db, id
-func (_m *MockQuerier) RemoveJobById(ctx context.Context, db DBTX, id pgtype.UUID) error {
-	ret := _m.Called(ctx, db, id)
+// RevokeAccountUserPermission provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) RevokeAccountUserPermission(ctx context.Context, db DBTX, arg RevokeAccountUserPermissionParams) error {
+	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveJobById")
+		panic("no return value specified for RevokeAccountUserPermission")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
-		r0 = rf(ctx, db, id)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, RevokeAccountUserPermissionParams) error); ok {
+		r0 = rf(ctx, db, arg)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -3479,95 +6084,104 @@ func (_m *MockQuerier) RemoveJobById(ctx context.Context, db DBTX, id pgtype.UUI
 	return r0
 }
 
-// MockQuerier_RemoveJobById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveJobById'
-type MockQuerier_RemoveJobById_Call struct {
+// MockQuerier_RevokeAccountUserPermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAccountUserPermission'
+type MockQuerier_RevokeAccountUserPermission_Call struct {
 	*mock.Call
 }
 
-// RemoveJobById is a helper method to define mock.On call
+// RevokeAccountUserPermission is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - id pgtype.UUID
-func (_e *MockQuerier_Expecter) RemoveJobById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveJobById_Call {
-	return &MockQuerier_RemoveJobById_Call{Call: _e.mock.On("RemoveJobById", ctx, db, id)}
+//   - arg RevokeAccountUserPermissionParams
+func (_e *MockQuerier_Expecter) RevokeAccountUserPermission(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_RevokeAccountUserPermission_Call {
+	return &MockQuerier_RevokeAccountUserPermission_Call{Call: _e.mock.On("RevokeAccountUserPermission", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_RemoveJobById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveJobById_Call {
+func (_c *MockQuerier_RevokeAccountUserPermission_Call) Run(run func(ctx context.Context, db DBTX, arg RevokeAccountUserPermissionParams)) *MockQuerier_RevokeAccountUserPermission_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(RevokeAccountUserPermissionParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_RemoveJobById_Call) Return(_a0 error) *MockQuerier_RemoveJobById_Call {
+func (_c *MockQuerier_RevokeAccountUserPermission_Call) Return(_a0 error) *MockQuerier_RevokeAccountUserPermission_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockQuerier_RemoveJobById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveJobById_Call {
+func (_c *MockQuerier_RevokeAccountUserPermission_Call) RunAndReturn(run func(context.Context, DBTX, RevokeAccountUserPermissionParams) error) *MockQuerier_RevokeAccountUserPermission_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveJobConnectionDestination provides a mock function with given fields: ctx, db, id
-func (_m *MockQuerier) RemoveJobConnectionDestination(ctx context.Context, db DBTX, id pgtype.UUID) error {
-	ret := _m.Called(ctx, db, id)
+// SetAnonymousUser provides a mock function with given fields: ctx, db
+func (_m *MockQuerier) SetAnonymousUser(ctx context.Context, db DBTX) (NeosyncApiUser, error) {
+	ret := _m.Called(ctx, db)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveJobConnectionDestination")
+		panic("no return value specified for SetAnonymousUser")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
-		r0 = rf(ctx, db, id)
+	var r0 NeosyncApiUser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX) (NeosyncApiUser, error)); ok {
+		return rf(ctx, db)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX) NeosyncApiUser); ok {
+		r0 = rf(ctx, db)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(NeosyncApiUser)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX) error); ok {
+		r1 = rf(ctx, db)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockQuerier_RemoveJobConnectionDestination_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveJobConnectionDestination'
-type MockQuerier_RemoveJobConnectionDestination_Call struct {
+// MockQuerier_SetAnonymousUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAnonymousUser'
+type MockQuerier_SetAnonymousUser_Call struct {
 	*mock.Call
 }
 
-// RemoveJobConnectionDestination is a helper method to define mock.On call
+// SetAnonymousUser is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - id pgtype.UUID
-func (_e *MockQuerier_Expecter) RemoveJobConnectionDestination(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveJobConnectionDestination_Call {
-	return &MockQuerier_RemoveJobConnectionDestination_Call{Call: _e.mock.On("RemoveJobConnectionDestination", ctx, db, id)}
+func (_e *MockQuerier_Expecter) SetAnonymousUser(ctx interface{}, db interface{}) *MockQuerier_SetAnonymousUser_Call {
+	return &MockQuerier_SetAnonymousUser_Call{Call: _e.mock.On("SetAnonymousUser", ctx, db)}
 }
 
-func (_c *MockQuerier_RemoveJobConnectionDestination_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveJobConnectionDestination_Call {
+func (_c *MockQuerier_SetAnonymousUser_Call) Run(run func(ctx context.Context, db DBTX)) *MockQuerier_SetAnonymousUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+		run(args[0].(context.Context), args[1].(DBTX))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_RemoveJobConnectionDestination_Call) Return(_a0 error) *MockQuerier_RemoveJobConnectionDestination_Call {
-	_c.Call.Return(_a0)
+func (_c *MockQuerier_SetAnonymousUser_Call) Return(_a0 NeosyncApiUser, _a1 error) *MockQuerier_SetAnonymousUser_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_RemoveJobConnectionDestination_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveJobConnectionDestination_Call {
+func (_c *MockQuerier_SetAnonymousUser_Call) RunAndReturn(run func(context.Context, DBTX) (NeosyncApiUser, error)) *MockQuerier_SetAnonymousUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveJobConnectionDestinations provides a mock function with given fields: ctx, db, jobids
-func (_m *MockQuerier) RemoveJobConnectionDestinations(ctx context.Context, db DBTX, jobids []pgtype.UUID) error {
-	ret := _m.Called(ctx, db, jobids)
+// SetIdempotencyKeyResponse provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) SetIdempotencyKeyResponse(ctx context.Context, db DBTX, arg SetIdempotencyKeyResponseParams) error {
+	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveJobConnectionDestinations")
+		panic("no return value specified for SetIdempotencyKeyResponse")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, []pgtype.UUID) error); ok {
-		r0 = rf(ctx, db, jobids)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, SetIdempotencyKeyResponseParams) error); ok {
+		r0 = rf(ctx, db, arg)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -3575,57 +6189,57 @@ func (_m *MockQuerier) RemoveJobConnectionDestinations(ctx context.Context, db D
 	return r0
 }
 
-// MockQuerier_RemoveJobConnectionDestinations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveJobConnectionDestinations'
-type MockQuerier_RemoveJobConnectionDestinations_Call struct {
+// MockQuerier_SetIdempotencyKeyResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetIdempotencyKeyResponse'
+type MockQuerier_SetIdempotencyKeyResponse_Call struct {
 	*mock.Call
 }
 
-// RemoveJobConnectionDestinations is a helper method to define mock.On call
+// SetIdempotencyKeyResponse is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - jobids []pgtype.UUID
-func (_e *MockQuerier_Expecter) RemoveJobConnectionDestinations(ctx interface{}, db interface{}, jobids interface{}) *MockQuerier_RemoveJobConnectionDestinations_Call {
-	return &MockQuerier_RemoveJobConnectionDestinations_Call{Call: _e.mock.On("RemoveJobConnectionDestinations", ctx, db, jobids)}
+//   - arg SetIdempotencyKeyResponseParams
+func (_e *MockQuerier_Expecter) SetIdempotencyKeyResponse(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_SetIdempotencyKeyResponse_Call {
+	return &MockQuerier_SetIdempotencyKeyResponse_Call{Call: _e.mock.On("SetIdempotencyKeyResponse", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_RemoveJobConnectionDestinations_Call) Run(run func(ctx context.Context, db DBTX, jobids []pgtype.UUID)) *MockQuerier_RemoveJobConnectionDestinations_Call {
+func (_c *MockQuerier_SetIdempotencyKeyResponse_Call) Run(run func(ctx context.Context, db DBTX, arg SetIdempotencyKeyResponseParams)) *MockQuerier_SetIdempotencyKeyResponse_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].([]pgtype.UUID))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(SetIdempotencyKeyResponseParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_RemoveJobConnectionDestinations_Call) Return(_a0 error) *MockQuerier_RemoveJobConnectionDestinations_Call {
+func (_c *MockQuerier_SetIdempotencyKeyResponse_Call) Return(_a0 error) *MockQuerier_SetIdempotencyKeyResponse_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockQuerier_RemoveJobConnectionDestinations_Call) RunAndReturn(run func(context.Context, DBTX, []pgtype.UUID) error) *MockQuerier_RemoveJobConnectionDestinations_Call {
+func (_c *MockQuerier_SetIdempotencyKeyResponse_Call) RunAndReturn(run func(context.Context, DBTX, SetIdempotencyKeyResponseParams) error) *MockQuerier_SetIdempotencyKeyResponse_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetAnonymousUser provides a mock function with given fields: ctx, db
-func (_m *MockQuerier) SetAnonymousUser(ctx context.Context, db DBTX) (NeosyncApiUser, error) {
-	ret := _m.Called(ctx, db)
+// SetJobParameters provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) SetJobParameters(ctx context.Context, db DBTX, arg SetJobParametersParams) (NeosyncApiJob, error) {
+	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetAnonymousUser")
+		panic("no return value specified for SetJobParameters")
 	}
 
-	var r0 NeosyncApiUser
+	var r0 NeosyncApiJob
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX) (NeosyncApiUser, error)); ok {
-		return rf(ctx, db)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, SetJobParametersParams) (NeosyncApiJob, error)); ok {
+		return rf(ctx, db, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX) NeosyncApiUser); ok {
-		r0 = rf(ctx, db)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, SetJobParametersParams) NeosyncApiJob); ok {
+		r0 = rf(ctx, db, arg)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiUser)
+		r0 = ret.Get(0).(NeosyncApiJob)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX) error); ok {
-		r1 = rf(ctx, db)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, SetJobParametersParams) error); ok {
+		r1 = rf(ctx, db, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3633,31 +6247,32 @@ func (_m *MockQuerier) SetAnonymousUser(ctx context.Context, db DBTX) (NeosyncAp
 	return r0, r1
 }
 
-// MockQuerier_SetAnonymousUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAnonymousUser'
-type MockQuerier_SetAnonymousUser_Call struct {
+// MockQuerier_SetJobParameters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobParameters'
+type MockQuerier_SetJobParameters_Call struct {
 	*mock.Call
 }
 
-// SetAnonymousUser is a helper method to define mock.On call
+// SetJobParameters is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-func (_e *MockQuerier_Expecter) SetAnonymousUser(ctx interface{}, db interface{}) *MockQuerier_SetAnonymousUser_Call {
-	return &MockQuerier_SetAnonymousUser_Call{Call: _e.mock.On("SetAnonymousUser", ctx, db)}
+//   - arg SetJobParametersParams
+func (_e *MockQuerier_Expecter) SetJobParameters(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_SetJobParameters_Call {
+	return &MockQuerier_SetJobParameters_Call{Call: _e.mock.On("SetJobParameters", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_SetAnonymousUser_Call) Run(run func(ctx context.Context, db DBTX)) *MockQuerier_SetAnonymousUser_Call {
+func (_c *MockQuerier_SetJobParameters_Call) Run(run func(ctx context.Context, db DBTX, arg SetJobParametersParams)) *MockQuerier_SetJobParameters_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(SetJobParametersParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_SetAnonymousUser_Call) Return(_a0 NeosyncApiUser, _a1 error) *MockQuerier_SetAnonymousUser_Call {
+func (_c *MockQuerier_SetJobParameters_Call) Return(_a0 NeosyncApiJob, _a1 error) *MockQuerier_SetJobParameters_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_SetAnonymousUser_Call) RunAndReturn(run func(context.Context, DBTX) (NeosyncApiUser, error)) *MockQuerier_SetAnonymousUser_Call {
+func (_c *MockQuerier_SetJobParameters_Call) RunAndReturn(run func(context.Context, DBTX, SetJobParametersParams) (NeosyncApiJob, error)) *MockQuerier_SetJobParameters_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3720,6 +6335,64 @@ func (_c *MockQuerier_SetJobSyncOptions_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// SetJobTableProcessors provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) SetJobTableProcessors(ctx context.Context, db DBTX, arg SetJobTableProcessorsParams) (NeosyncApiJob, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetJobTableProcessors")
+	}
+
+	var r0 NeosyncApiJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, SetJobTableProcessorsParams) (NeosyncApiJob, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, SetJobTableProcessorsParams) NeosyncApiJob); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiJob)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, SetJobTableProcessorsParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_SetJobTableProcessors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobTableProcessors'
+type MockQuerier_SetJobTableProcessors_Call struct {
+	*mock.Call
+}
+
+// SetJobTableProcessors is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg SetJobTableProcessorsParams
+func (_e *MockQuerier_Expecter) SetJobTableProcessors(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_SetJobTableProcessors_Call {
+	return &MockQuerier_SetJobTableProcessors_Call{Call: _e.mock.On("SetJobTableProcessors", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_SetJobTableProcessors_Call) Run(run func(ctx context.Context, db DBTX, arg SetJobTableProcessorsParams)) *MockQuerier_SetJobTableProcessors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(SetJobTableProcessorsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_SetJobTableProcessors_Call) Return(_a0 NeosyncApiJob, _a1 error) *MockQuerier_SetJobTableProcessors_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_SetJobTableProcessors_Call) RunAndReturn(run func(context.Context, DBTX, SetJobTableProcessorsParams) (NeosyncApiJob, error)) *MockQuerier_SetJobTableProcessors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetJobWorkflowOptions provides a mock function with given fields: ctx, db, arg
 func (_m *MockQuerier) SetJobWorkflowOptions(ctx context.Context, db DBTX, arg SetJobWorkflowOptionsParams) (NeosyncApiJob, error) {
 	ret := _m.Called(ctx, db, arg)
@@ -3894,6 +6567,64 @@ func (_c *MockQuerier_UpdateAccountInviteToAccepted_Call) RunAndReturn(run func(
 	return _c
 }
 
+// UpdateAccountJobDefaults provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) UpdateAccountJobDefaults(ctx context.Context, db DBTX, arg UpdateAccountJobDefaultsParams) (NeosyncApiAccount, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAccountJobDefaults")
+	}
+
+	var r0 NeosyncApiAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateAccountJobDefaultsParams) (NeosyncApiAccount, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateAccountJobDefaultsParams) NeosyncApiAccount); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiAccount)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, UpdateAccountJobDefaultsParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_UpdateAccountJobDefaults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAccountJobDefaults'
+type MockQuerier_UpdateAccountJobDefaults_Call struct {
+	*mock.Call
+}
+
+// UpdateAccountJobDefaults is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg UpdateAccountJobDefaultsParams
+func (_e *MockQuerier_Expecter) UpdateAccountJobDefaults(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_UpdateAccountJobDefaults_Call {
+	return &MockQuerier_UpdateAccountJobDefaults_Call{Call: _e.mock.On("UpdateAccountJobDefaults", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_UpdateAccountJobDefaults_Call) Run(run func(ctx context.Context, db DBTX, arg UpdateAccountJobDefaultsParams)) *MockQuerier_UpdateAccountJobDefaults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(UpdateAccountJobDefaultsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpdateAccountJobDefaults_Call) Return(_a0 NeosyncApiAccount, _a1 error) *MockQuerier_UpdateAccountJobDefaults_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_UpdateAccountJobDefaults_Call) RunAndReturn(run func(context.Context, DBTX, UpdateAccountJobDefaultsParams) (NeosyncApiAccount, error)) *MockQuerier_UpdateAccountJobDefaults_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateAccountOnboardingConfig provides a mock function with given fields: ctx, db, arg
 func (_m *MockQuerier) UpdateAccountOnboardingConfig(ctx context.Context, db DBTX, arg UpdateAccountOnboardingConfigParams) (NeosyncApiAccount, error) {
 	ret := _m.Called(ctx, db, arg)
@@ -4068,6 +6799,64 @@ func (_c *MockQuerier_UpdateConnection_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// UpdateConnectionSavedQuery provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) UpdateConnectionSavedQuery(ctx context.Context, db DBTX, arg UpdateConnectionSavedQueryParams) (NeosyncApiConnectionSavedQuery, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConnectionSavedQuery")
+	}
+
+	var r0 NeosyncApiConnectionSavedQuery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateConnectionSavedQueryParams) (NeosyncApiConnectionSavedQuery, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateConnectionSavedQueryParams) NeosyncApiConnectionSavedQuery); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiConnectionSavedQuery)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, UpdateConnectionSavedQueryParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_UpdateConnectionSavedQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConnectionSavedQuery'
+type MockQuerier_UpdateConnectionSavedQuery_Call struct {
+	*mock.Call
+}
+
+// UpdateConnectionSavedQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg UpdateConnectionSavedQueryParams
+func (_e *MockQuerier_Expecter) UpdateConnectionSavedQuery(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_UpdateConnectionSavedQuery_Call {
+	return &MockQuerier_UpdateConnectionSavedQuery_Call{Call: _e.mock.On("UpdateConnectionSavedQuery", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_UpdateConnectionSavedQuery_Call) Run(run func(ctx context.Context, db DBTX, arg UpdateConnectionSavedQueryParams)) *MockQuerier_UpdateConnectionSavedQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(UpdateConnectionSavedQueryParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpdateConnectionSavedQuery_Call) Return(_a0 NeosyncApiConnectionSavedQuery, _a1 error) *MockQuerier_UpdateConnectionSavedQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_UpdateConnectionSavedQuery_Call) RunAndReturn(run func(context.Context, DBTX, UpdateConnectionSavedQueryParams) (NeosyncApiConnectionSavedQuery, error)) *MockQuerier_UpdateConnectionSavedQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateJobConnectionDestination provides a mock function with given fields: ctx, db, arg
 func (_m *MockQuerier) UpdateJobConnectionDestination(ctx context.Context, db DBTX, arg UpdateJobConnectionDestinationParams) (NeosyncApiJobDestinationConnectionAssociation, error) {
 	ret := _m.Called(ctx, db, arg)
@@ -4416,6 +7205,180 @@ func (_c *MockQuerier_UpdateUserDefinedTransformer_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// UpsertColumnTag provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) UpsertColumnTag(ctx context.Context, db DBTX, arg UpsertColumnTagParams) (NeosyncApiConnectionColumnTag, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertColumnTag")
+	}
+
+	var r0 NeosyncApiConnectionColumnTag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpsertColumnTagParams) (NeosyncApiConnectionColumnTag, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpsertColumnTagParams) NeosyncApiConnectionColumnTag); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiConnectionColumnTag)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, UpsertColumnTagParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_UpsertColumnTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertColumnTag'
+type MockQuerier_UpsertColumnTag_Call struct {
+	*mock.Call
+}
+
+// UpsertColumnTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg UpsertColumnTagParams
+func (_e *MockQuerier_Expecter) UpsertColumnTag(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_UpsertColumnTag_Call {
+	return &MockQuerier_UpsertColumnTag_Call{Call: _e.mock.On("UpsertColumnTag", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_UpsertColumnTag_Call) Run(run func(ctx context.Context, db DBTX, arg UpsertColumnTagParams)) *MockQuerier_UpsertColumnTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(UpsertColumnTagParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpsertColumnTag_Call) Return(_a0 NeosyncApiConnectionColumnTag, _a1 error) *MockQuerier_UpsertColumnTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_UpsertColumnTag_Call) RunAndReturn(run func(context.Context, DBTX, UpsertColumnTagParams) (NeosyncApiConnectionColumnTag, error)) *MockQuerier_UpsertColumnTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertConnectionTableBookmark provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) UpsertConnectionTableBookmark(ctx context.Context, db DBTX, arg UpsertConnectionTableBookmarkParams) (NeosyncApiConnectionTableBookmark, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertConnectionTableBookmark")
+	}
+
+	var r0 NeosyncApiConnectionTableBookmark
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpsertConnectionTableBookmarkParams) (NeosyncApiConnectionTableBookmark, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpsertConnectionTableBookmarkParams) NeosyncApiConnectionTableBookmark); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiConnectionTableBookmark)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, UpsertConnectionTableBookmarkParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_UpsertConnectionTableBookmark_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertConnectionTableBookmark'
+type MockQuerier_UpsertConnectionTableBookmark_Call struct {
+	*mock.Call
+}
+
+// UpsertConnectionTableBookmark is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg UpsertConnectionTableBookmarkParams
+func (_e *MockQuerier_Expecter) UpsertConnectionTableBookmark(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_UpsertConnectionTableBookmark_Call {
+	return &MockQuerier_UpsertConnectionTableBookmark_Call{Call: _e.mock.On("UpsertConnectionTableBookmark", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_UpsertConnectionTableBookmark_Call) Run(run func(ctx context.Context, db DBTX, arg UpsertConnectionTableBookmarkParams)) *MockQuerier_UpsertConnectionTableBookmark_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(UpsertConnectionTableBookmarkParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpsertConnectionTableBookmark_Call) Return(_a0 NeosyncApiConnectionTableBookmark, _a1 error) *MockQuerier_UpsertConnectionTableBookmark_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_UpsertConnectionTableBookmark_Call) RunAndReturn(run func(context.Context, DBTX, UpsertConnectionTableBookmarkParams) (NeosyncApiConnectionTableBookmark, error)) *MockQuerier_UpsertConnectionTableBookmark_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertJavascriptLibrary provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) UpsertJavascriptLibrary(ctx context.Context, db DBTX, arg UpsertJavascriptLibraryParams) (NeosyncApiJavascriptLibrary, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertJavascriptLibrary")
+	}
+
+	var r0 NeosyncApiJavascriptLibrary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpsertJavascriptLibraryParams) (NeosyncApiJavascriptLibrary, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpsertJavascriptLibraryParams) NeosyncApiJavascriptLibrary); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiJavascriptLibrary)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, UpsertJavascriptLibraryParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_UpsertJavascriptLibrary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertJavascriptLibrary'
+type MockQuerier_UpsertJavascriptLibrary_Call struct {
+	*mock.Call
+}
+
+// UpsertJavascriptLibrary is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg UpsertJavascriptLibraryParams
+func (_e *MockQuerier_Expecter) UpsertJavascriptLibrary(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_UpsertJavascriptLibrary_Call {
+	return &MockQuerier_UpsertJavascriptLibrary_Call{Call: _e.mock.On("UpsertJavascriptLibrary", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_UpsertJavascriptLibrary_Call) Run(run func(ctx context.Context, db DBTX, arg UpsertJavascriptLibraryParams)) *MockQuerier_UpsertJavascriptLibrary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(UpsertJavascriptLibraryParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpsertJavascriptLibrary_Call) Return(_a0 NeosyncApiJavascriptLibrary, _a1 error) *MockQuerier_UpsertJavascriptLibrary_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_UpsertJavascriptLibrary_Call) RunAndReturn(run func(context.Context, DBTX, UpsertJavascriptLibraryParams) (NeosyncApiJavascriptLibrary, error)) *MockQuerier_UpsertJavascriptLibrary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockQuerier creates a new instance of MockQuerier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockQuerier(t interface {
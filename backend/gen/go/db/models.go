@@ -17,6 +17,7 @@ type NeosyncApiAccount struct {
 	AccountSlug      string
 	TemporalConfig   *pg_models.TemporalConfig
 	OnboardingConfig *pg_models.AccountOnboardingConfig
+	JobDefaults      *pg_models.AccountJobDefaults
 }
 
 type NeosyncApiAccountApiKey struct {
@@ -52,6 +53,15 @@ type NeosyncApiAccountUserAssociation struct {
 	UpdatedAt pgtype.Timestamp
 }
 
+type NeosyncApiAccountUserPermission struct {
+	ID         pgtype.UUID
+	AccountID  pgtype.UUID
+	UserID     pgtype.UUID
+	Permission string
+	CreatedAt  pgtype.Timestamp
+	UpdatedAt  pgtype.Timestamp
+}
+
 type NeosyncApiConnection struct {
 	ID               pgtype.UUID
 	CreatedAt        pgtype.Timestamp
@@ -63,6 +73,64 @@ type NeosyncApiConnection struct {
 	UpdatedByID      pgtype.UUID
 }
 
+type NeosyncApiConnectionColumnTag struct {
+	ID              pgtype.UUID
+	ConnectionID    pgtype.UUID
+	SchemaName      string
+	TableName       string
+	ColumnName      string
+	Classifications []int32
+	Source          int16
+	CreatedByID     pgtype.UUID
+	UpdatedByID     pgtype.UUID
+	CreatedAt       pgtype.Timestamp
+	UpdatedAt       pgtype.Timestamp
+}
+
+type NeosyncApiConnectionSavedQuery struct {
+	ID           pgtype.UUID
+	ConnectionID pgtype.UUID
+	Name         string
+	SchemaName   string
+	TableName    string
+	WhereClause  pgtype.Text
+	CreatedByID  pgtype.UUID
+	UpdatedByID  pgtype.UUID
+	CreatedAt    pgtype.Timestamp
+	UpdatedAt    pgtype.Timestamp
+}
+
+type NeosyncApiConnectionTableBookmark struct {
+	ID           pgtype.UUID
+	ConnectionID pgtype.UUID
+	SchemaName   string
+	TableName    string
+	CreatedByID  pgtype.UUID
+	CreatedAt    pgtype.Timestamp
+}
+
+type NeosyncApiIdempotencyKey struct {
+	ID             pgtype.UUID
+	AccountID      pgtype.UUID
+	Operation      string
+	IdempotencyKey string
+	RequestHash    []byte
+	Response       []byte
+	CreatedAt      pgtype.Timestamp
+	UpdatedAt      pgtype.Timestamp
+}
+
+type NeosyncApiJavascriptLibrary struct {
+	ID          pgtype.UUID
+	AccountID   pgtype.UUID
+	Name        string
+	Code        string
+	CreatedByID pgtype.UUID
+	UpdatedByID pgtype.UUID
+	CreatedAt   pgtype.Timestamp
+	UpdatedAt   pgtype.Timestamp
+}
+
 type NeosyncApiJob struct {
 	ID                pgtype.UUID
 	CreatedAt         pgtype.Timestamp
@@ -77,6 +145,19 @@ type NeosyncApiJob struct {
 	UpdatedByID       pgtype.UUID
 	WorkflowOptions   *pg_models.WorkflowOptions
 	SyncOptions       *pg_models.ActivityOptions
+	TableProcessors   []*pg_models.JobTableProcessor
+	Parameters        []*pg_models.JobParameter
+}
+
+type NeosyncApiJobChangeRequest struct {
+	ID                pgtype.UUID
+	JobID             pgtype.UUID
+	ConnectionOptions *pg_models.JobSourceOptions
+	Mappings          []*pg_models.JobMapping
+	CreatedByID       pgtype.UUID
+	CreatedAt         pgtype.Timestamp
+	ApprovedByID      pgtype.UUID
+	ApprovedAt        pgtype.Timestamp
 }
 
 type NeosyncApiJobDestinationConnectionAssociation struct {
@@ -88,6 +169,50 @@ type NeosyncApiJobDestinationConnectionAssociation struct {
 	Options      *pg_models.JobDestinationOptions
 }
 
+type NeosyncApiJobRunArtifact struct {
+	ID           pgtype.UUID
+	AccountID    pgtype.UUID
+	JobRunID     string
+	ArtifactType int16
+	Name         string
+	ContentType  string
+	SizeBytes    int64
+	ObjectKey    string
+	CreatedAt    pgtype.Timestamp
+}
+
+type NeosyncApiJobRunRequest struct {
+	ID          pgtype.UUID
+	JobID       pgtype.UUID
+	Priority    int16
+	JobRunID    pgtype.Text
+	CreatedByID pgtype.UUID
+	CreatedAt   pgtype.Timestamp
+	Parameters  []*pg_models.JobParameterValue
+}
+
+type NeosyncApiJobRunSnapshot struct {
+	ID           pgtype.UUID
+	AccountID    pgtype.UUID
+	JobID        pgtype.UUID
+	JobRunID     string
+	ConnectionID pgtype.UUID
+	Name         string
+	CreatedByID  pgtype.UUID
+	CreatedAt    pgtype.Timestamp
+}
+
+type NeosyncApiResourceLabel struct {
+	ID           pgtype.UUID
+	AccountID    pgtype.UUID
+	ResourceType string
+	ResourceID   pgtype.UUID
+	Key          string
+	Value        string
+	CreatedAt    pgtype.Timestamp
+	UpdatedAt    pgtype.Timestamp
+}
+
 type NeosyncApiTransformer struct {
 	ID                pgtype.UUID
 	CreatedAt         pgtype.Timestamp
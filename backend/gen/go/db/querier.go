@@ -12,18 +12,26 @@ import (
 )
 
 type Querier interface {
+	ApproveJobChangeRequest(ctx context.Context, db DBTX, arg ApproveJobChangeRequestParams) (NeosyncApiJobChangeRequest, error)
 	AreConnectionsInAccount(ctx context.Context, db DBTX, arg AreConnectionsInAccountParams) (int64, error)
+	ClaimJobRunRequest(ctx context.Context, db DBTX, arg ClaimJobRunRequestParams) (NeosyncApiJobRunRequest, error)
 	CreateAccountApiKey(ctx context.Context, db DBTX, arg CreateAccountApiKeyParams) (NeosyncApiAccountApiKey, error)
 	CreateAccountInvite(ctx context.Context, db DBTX, arg CreateAccountInviteParams) (NeosyncApiAccountInvite, error)
 	CreateAccountUserAssociation(ctx context.Context, db DBTX, arg CreateAccountUserAssociationParams) (NeosyncApiAccountUserAssociation, error)
 	CreateConnection(ctx context.Context, db DBTX, arg CreateConnectionParams) (NeosyncApiConnection, error)
+	CreateConnectionSavedQuery(ctx context.Context, db DBTX, arg CreateConnectionSavedQueryParams) (NeosyncApiConnectionSavedQuery, error)
 	CreateIdentityProviderAssociation(ctx context.Context, db DBTX, arg CreateIdentityProviderAssociationParams) (NeosyncApiUserIdentityProviderAssociation, error)
 	CreateJob(ctx context.Context, db DBTX, arg CreateJobParams) (NeosyncApiJob, error)
+	CreateJobChangeRequest(ctx context.Context, db DBTX, arg CreateJobChangeRequestParams) (NeosyncApiJobChangeRequest, error)
 	CreateJobConnectionDestination(ctx context.Context, db DBTX, arg CreateJobConnectionDestinationParams) (NeosyncApiJobDestinationConnectionAssociation, error)
 	CreateJobConnectionDestinations(ctx context.Context, db DBTX, arg []CreateJobConnectionDestinationsParams) (int64, error)
+	CreateJobRunArtifact(ctx context.Context, db DBTX, arg CreateJobRunArtifactParams) (NeosyncApiJobRunArtifact, error)
+	CreateJobRunRequest(ctx context.Context, db DBTX, arg CreateJobRunRequestParams) (NeosyncApiJobRunRequest, error)
+	CreateJobRunSnapshot(ctx context.Context, db DBTX, arg CreateJobRunSnapshotParams) (NeosyncApiJobRunSnapshot, error)
 	CreateMachineUser(ctx context.Context, db DBTX) (NeosyncApiUser, error)
 	CreateNonMachineUser(ctx context.Context, db DBTX) (NeosyncApiUser, error)
 	CreatePersonalAccount(ctx context.Context, db DBTX, accountSlug string) (NeosyncApiAccount, error)
+	CreateResourceLabels(ctx context.Context, db DBTX, arg CreateResourceLabelsParams) error
 	CreateTeamAccount(ctx context.Context, db DBTX, accountSlug string) (NeosyncApiAccount, error)
 	CreateUserDefinedTransformer(ctx context.Context, db DBTX, arg CreateUserDefinedTransformerParams) (NeosyncApiTransformer, error)
 	DeleteJob(ctx context.Context, db DBTX, id pgtype.UUID) error
@@ -34,22 +42,46 @@ type Querier interface {
 	GetAccountApiKeys(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiAccountApiKey, error)
 	GetAccountInvite(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiAccountInvite, error)
 	GetAccountInviteByToken(ctx context.Context, db DBTX, token string) (NeosyncApiAccountInvite, error)
+	GetAccountJobDefaults(ctx context.Context, db DBTX, id pgtype.UUID) (*pg_models.AccountJobDefaults, error)
 	GetAccountOnboardingConfig(ctx context.Context, db DBTX, id pgtype.UUID) (*pg_models.AccountOnboardingConfig, error)
+	GetAccountPermissionUserCount(ctx context.Context, db DBTX, arg GetAccountPermissionUserCountParams) (int64, error)
 	GetAccountUserAssociation(ctx context.Context, db DBTX, arg GetAccountUserAssociationParams) (NeosyncApiAccountUserAssociation, error)
+	GetAccountUserPermissions(ctx context.Context, db DBTX, arg GetAccountUserPermissionsParams) ([]string, error)
 	GetAccountsByUser(ctx context.Context, db DBTX, id pgtype.UUID) ([]NeosyncApiAccount, error)
 	GetActiveAccountInvites(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiAccountInvite, error)
 	GetAnonymousUser(ctx context.Context, db DBTX) (NeosyncApiUser, error)
+	GetColumnTagById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiConnectionColumnTag, error)
+	GetColumnTagsByAccount(ctx context.Context, db DBTX, accountID pgtype.UUID) ([]NeosyncApiConnectionColumnTag, error)
+	GetColumnTagsByConnection(ctx context.Context, db DBTX, connectionID pgtype.UUID) ([]NeosyncApiConnectionColumnTag, error)
 	GetConnectionById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiConnection, error)
 	GetConnectionByNameAndAccount(ctx context.Context, db DBTX, arg GetConnectionByNameAndAccountParams) (NeosyncApiConnection, error)
+	GetConnectionSavedQueriesByConnection(ctx context.Context, db DBTX, connectionID pgtype.UUID) ([]NeosyncApiConnectionSavedQuery, error)
+	GetConnectionSavedQueryById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiConnectionSavedQuery, error)
+	GetConnectionTableBookmarkById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiConnectionTableBookmark, error)
+	GetConnectionTableBookmarksByConnection(ctx context.Context, db DBTX, connectionID pgtype.UUID) ([]NeosyncApiConnectionTableBookmark, error)
 	GetConnectionsByAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiConnection, error)
 	GetConnectionsByIds(ctx context.Context, db DBTX, dollar_1 []pgtype.UUID) ([]NeosyncApiConnection, error)
+	GetIdempotencyKey(ctx context.Context, db DBTX, arg GetIdempotencyKeyParams) (NeosyncApiIdempotencyKey, error)
+	GetJavascriptLibrariesByAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiJavascriptLibrary, error)
+	GetJobAccountIds(ctx context.Context, db DBTX) ([]pgtype.UUID, error)
 	GetJobById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiJob, error)
+	GetJobByIdForUpdate(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiJob, error)
 	GetJobByNameAndAccount(ctx context.Context, db DBTX, arg GetJobByNameAndAccountParams) (NeosyncApiJob, error)
+	GetJobChangeRequestById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiJobChangeRequest, error)
+	GetJobChangeRequestsByJob(ctx context.Context, db DBTX, jobID pgtype.UUID) ([]NeosyncApiJobChangeRequest, error)
 	GetJobConnectionDestination(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiJobDestinationConnectionAssociation, error)
 	GetJobConnectionDestinations(ctx context.Context, db DBTX, id pgtype.UUID) ([]NeosyncApiJobDestinationConnectionAssociation, error)
 	GetJobConnectionDestinationsByJobIds(ctx context.Context, db DBTX, jobids []pgtype.UUID) ([]NeosyncApiJobDestinationConnectionAssociation, error)
+	GetJobRunArtifacts(ctx context.Context, db DBTX, arg GetJobRunArtifactsParams) ([]NeosyncApiJobRunArtifact, error)
+	GetJobRunRequestByJobRun(ctx context.Context, db DBTX, jobRunID pgtype.Text) (NeosyncApiJobRunRequest, error)
+	GetJobRunRequestsByJobRuns(ctx context.Context, db DBTX, jobrunids []string) ([]NeosyncApiJobRunRequest, error)
+	GetJobRunSnapshotByName(ctx context.Context, db DBTX, arg GetJobRunSnapshotByNameParams) (NeosyncApiJobRunSnapshot, error)
+	GetJobRunSnapshotsByAccount(ctx context.Context, db DBTX, accountID pgtype.UUID) ([]NeosyncApiJobRunSnapshot, error)
+	GetJobRunSnapshotsByJob(ctx context.Context, db DBTX, arg GetJobRunSnapshotsByJobParams) ([]NeosyncApiJobRunSnapshot, error)
+	GetJobRunSnapshotsByJobRun(ctx context.Context, db DBTX, arg GetJobRunSnapshotsByJobRunParams) ([]NeosyncApiJobRunSnapshot, error)
 	GetJobsByAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiJob, error)
 	GetPersonalAccountByUserId(ctx context.Context, db DBTX, userid pgtype.UUID) (NeosyncApiAccount, error)
+	GetResourceLabels(ctx context.Context, db DBTX, arg GetResourceLabelsParams) ([]NeosyncApiResourceLabel, error)
 	GetTeamAccountsByUserId(ctx context.Context, db DBTX, userid pgtype.UUID) ([]NeosyncApiAccount, error)
 	GetTemporalConfigByAccount(ctx context.Context, db DBTX, id pgtype.UUID) (*pg_models.TemporalConfig, error)
 	GetTemporalConfigByUserAccount(ctx context.Context, db DBTX, arg GetTemporalConfigByUserAccountParams) (*pg_models.TemporalConfig, error)
@@ -61,6 +93,7 @@ type Querier interface {
 	GetUserIdentitiesByTeamAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error)
 	GetUserIdentityAssociationsByUserIds(ctx context.Context, db DBTX, dollar_1 []pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error)
 	GetUserIdentityByUserId(ctx context.Context, db DBTX, userID pgtype.UUID) (NeosyncApiUserIdentityProviderAssociation, error)
+	GrantAccountUserPermission(ctx context.Context, db DBTX, arg GrantAccountUserPermissionParams) error
 	IsConnectionInAccount(ctx context.Context, db DBTX, arg IsConnectionInAccountParams) (int64, error)
 	IsConnectionNameAvailable(ctx context.Context, db DBTX, arg IsConnectionNameAvailableParams) (int64, error)
 	IsJobNameAvailable(ctx context.Context, db DBTX, arg IsJobNameAvailableParams) (int64, error)
@@ -70,25 +103,47 @@ type Querier interface {
 	RemoveAccountApiKey(ctx context.Context, db DBTX, id pgtype.UUID) error
 	RemoveAccountInvite(ctx context.Context, db DBTX, id pgtype.UUID) error
 	RemoveAccountUser(ctx context.Context, db DBTX, arg RemoveAccountUserParams) error
+	RemoveColumnTag(ctx context.Context, db DBTX, id pgtype.UUID) error
 	RemoveConnectionById(ctx context.Context, db DBTX, id pgtype.UUID) error
 	RemoveConnectionByNameAndAccount(ctx context.Context, db DBTX, arg RemoveConnectionByNameAndAccountParams) error
+	RemoveConnectionSavedQuery(ctx context.Context, db DBTX, id pgtype.UUID) error
+	RemoveConnectionTableBookmark(ctx context.Context, db DBTX, id pgtype.UUID) error
+	RemoveIdempotencyKey(ctx context.Context, db DBTX, id pgtype.UUID) error
+	RemoveJavascriptLibrary(ctx context.Context, db DBTX, arg RemoveJavascriptLibraryParams) error
 	RemoveJobById(ctx context.Context, db DBTX, id pgtype.UUID) error
 	RemoveJobConnectionDestination(ctx context.Context, db DBTX, id pgtype.UUID) error
 	RemoveJobConnectionDestinations(ctx context.Context, db DBTX, jobids []pgtype.UUID) error
+	RemoveJobRunArtifacts(ctx context.Context, db DBTX, arg RemoveJobRunArtifactsParams) error
+	RemoveJobRunRequest(ctx context.Context, db DBTX, id pgtype.UUID) error
+	RemoveJobRunSnapshot(ctx context.Context, db DBTX, id pgtype.UUID) error
+	RemovePendingJobChangeRequests(ctx context.Context, db DBTX, jobID pgtype.UUID) error
+	RemoveResourceLabels(ctx context.Context, db DBTX, arg RemoveResourceLabelsParams) error
+	// Claims the key for a new request. Keys that have expired, or whose request never finished, are reclaimed.
+	// Returns no rows if the key is currently held by another request.
+	ReserveIdempotencyKey(ctx context.Context, db DBTX, arg ReserveIdempotencyKeyParams) (NeosyncApiIdempotencyKey, error)
+	RevokeAccountUserPermission(ctx context.Context, db DBTX, arg RevokeAccountUserPermissionParams) error
 	SetAnonymousUser(ctx context.Context, db DBTX) (NeosyncApiUser, error)
+	SetIdempotencyKeyResponse(ctx context.Context, db DBTX, arg SetIdempotencyKeyResponseParams) error
+	SetJobParameters(ctx context.Context, db DBTX, arg SetJobParametersParams) (NeosyncApiJob, error)
 	SetJobSyncOptions(ctx context.Context, db DBTX, arg SetJobSyncOptionsParams) (NeosyncApiJob, error)
+	SetJobTableProcessors(ctx context.Context, db DBTX, arg SetJobTableProcessorsParams) (NeosyncApiJob, error)
 	SetJobWorkflowOptions(ctx context.Context, db DBTX, arg SetJobWorkflowOptionsParams) (NeosyncApiJob, error)
 	UpdateAccountApiKeyValue(ctx context.Context, db DBTX, arg UpdateAccountApiKeyValueParams) (NeosyncApiAccountApiKey, error)
 	UpdateAccountInviteToAccepted(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiAccountInvite, error)
+	UpdateAccountJobDefaults(ctx context.Context, db DBTX, arg UpdateAccountJobDefaultsParams) (NeosyncApiAccount, error)
 	UpdateAccountOnboardingConfig(ctx context.Context, db DBTX, arg UpdateAccountOnboardingConfigParams) (NeosyncApiAccount, error)
 	UpdateActiveAccountInvitesToExpired(ctx context.Context, db DBTX, arg UpdateActiveAccountInvitesToExpiredParams) (NeosyncApiAccountInvite, error)
 	UpdateConnection(ctx context.Context, db DBTX, arg UpdateConnectionParams) (NeosyncApiConnection, error)
+	UpdateConnectionSavedQuery(ctx context.Context, db DBTX, arg UpdateConnectionSavedQueryParams) (NeosyncApiConnectionSavedQuery, error)
 	UpdateJobConnectionDestination(ctx context.Context, db DBTX, arg UpdateJobConnectionDestinationParams) (NeosyncApiJobDestinationConnectionAssociation, error)
 	UpdateJobMappings(ctx context.Context, db DBTX, arg UpdateJobMappingsParams) (NeosyncApiJob, error)
 	UpdateJobSchedule(ctx context.Context, db DBTX, arg UpdateJobScheduleParams) (NeosyncApiJob, error)
 	UpdateJobSource(ctx context.Context, db DBTX, arg UpdateJobSourceParams) (NeosyncApiJob, error)
 	UpdateTemporalConfigByAccount(ctx context.Context, db DBTX, arg UpdateTemporalConfigByAccountParams) (NeosyncApiAccount, error)
 	UpdateUserDefinedTransformer(ctx context.Context, db DBTX, arg UpdateUserDefinedTransformerParams) (NeosyncApiTransformer, error)
+	UpsertColumnTag(ctx context.Context, db DBTX, arg UpsertColumnTagParams) (NeosyncApiConnectionColumnTag, error)
+	UpsertConnectionTableBookmark(ctx context.Context, db DBTX, arg UpsertConnectionTableBookmarkParams) (NeosyncApiConnectionTableBookmark, error)
+	UpsertJavascriptLibrary(ctx context.Context, db DBTX, arg UpsertJavascriptLibraryParams) (NeosyncApiJavascriptLibrary, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -152,7 +152,7 @@ INSERT INTO neosync_api.accounts (
 ) VALUES (
   0, $1
 )
-RETURNING id, created_at, updated_at, account_type, account_slug, temporal_config, onboarding_config
+RETURNING id, created_at, updated_at, account_type, account_slug, temporal_config, onboarding_config, job_defaults
 `
 
 func (q *Queries) CreatePersonalAccount(ctx context.Context, db DBTX, accountSlug string) (NeosyncApiAccount, error) {
@@ -166,6 +166,7 @@ func (q *Queries) CreatePersonalAccount(ctx context.Context, db DBTX, accountSlu
 		&i.AccountSlug,
 		&i.TemporalConfig,
 		&i.OnboardingConfig,
+		&i.JobDefaults,
 	)
 	return i, err
 }
@@ -176,7 +177,7 @@ INSERT INTO neosync_api.accounts (
 ) VALUES (
   1, $1
 )
-RETURNING id, created_at, updated_at, account_type, account_slug, temporal_config, onboarding_config
+RETURNING id, created_at, updated_at, account_type, account_slug, temporal_config, onboarding_config, job_defaults
 `
 
 func (q *Queries) CreateTeamAccount(ctx context.Context, db DBTX, accountSlug string) (NeosyncApiAccount, error) {
@@ -190,6 +191,7 @@ func (q *Queries) CreateTeamAccount(ctx context.Context, db DBTX, accountSlug st
 		&i.AccountSlug,
 		&i.TemporalConfig,
 		&i.OnboardingConfig,
+		&i.JobDefaults,
 	)
 	return i, err
 }
@@ -210,6 +212,7 @@ func (q *Queries) GetAccount(ctx context.Context, db DBTX, id pgtype.UUID) (Neos
 		&i.AccountSlug,
 		&i.TemporalConfig,
 		&i.OnboardingConfig,
+		&i.JobDefaults,
 	)
 	return i, err
 }
@@ -258,6 +261,19 @@ func (q *Queries) GetAccountInviteByToken(ctx context.Context, db DBTX, token st
 	return i, err
 }
 
+const getAccountJobDefaults = `-- name: GetAccountJobDefaults :one
+SELECT job_defaults
+FROM neosync_api.accounts
+WHERE id = $1
+`
+
+func (q *Queries) GetAccountJobDefaults(ctx context.Context, db DBTX, id pgtype.UUID) (*pg_models.AccountJobDefaults, error) {
+	row := db.QueryRow(ctx, getAccountJobDefaults, id)
+	var job_defaults *pg_models.AccountJobDefaults
+	err := row.Scan(&job_defaults)
+	return job_defaults, err
+}
+
 const getAccountOnboardingConfig = `-- name: GetAccountOnboardingConfig :one
 SELECT onboarding_config
 FROM neosync_api.accounts
@@ -271,6 +287,23 @@ func (q *Queries) GetAccountOnboardingConfig(ctx context.Context, db DBTX, id pg
 	return onboarding_config, err
 }
 
+const getAccountPermissionUserCount = `-- name: GetAccountPermissionUserCount :one
+SELECT count(aup.id) from neosync_api.account_user_permissions aup
+WHERE aup.account_id = $1 AND aup.permission = $2
+`
+
+type GetAccountPermissionUserCountParams struct {
+	AccountId  pgtype.UUID
+	Permission string
+}
+
+func (q *Queries) GetAccountPermissionUserCount(ctx context.Context, db DBTX, arg GetAccountPermissionUserCountParams) (int64, error) {
+	row := db.QueryRow(ctx, getAccountPermissionUserCount, arg.AccountId, arg.Permission)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const getAccountUserAssociation = `-- name: GetAccountUserAssociation :one
 SELECT aua.id, aua.account_id, aua.user_id, aua.created_at, aua.updated_at from neosync_api.account_user_associations aua
 INNER JOIN neosync_api.accounts a ON a.id = aua.account_id
@@ -296,8 +329,38 @@ func (q *Queries) GetAccountUserAssociation(ctx context.Context, db DBTX, arg Ge
 	return i, err
 }
 
+const getAccountUserPermissions = `-- name: GetAccountUserPermissions :many
+SELECT aup.permission from neosync_api.account_user_permissions aup
+WHERE aup.account_id = $1 AND aup.user_id = $2
+`
+
+type GetAccountUserPermissionsParams struct {
+	AccountId pgtype.UUID
+	UserId    pgtype.UUID
+}
+
+func (q *Queries) GetAccountUserPermissions(ctx context.Context, db DBTX, arg GetAccountUserPermissionsParams) ([]string, error) {
+	rows, err := db.Query(ctx, getAccountUserPermissions, arg.AccountId, arg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var permission string
+		if err := rows.Scan(&permission); err != nil {
+			return nil, err
+		}
+		items = append(items, permission)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getAccountsByUser = `-- name: GetAccountsByUser :many
-SELECT a.id, a.created_at, a.updated_at, a.account_type, a.account_slug, a.temporal_config, a.onboarding_config
+SELECT a.id, a.created_at, a.updated_at, a.account_type, a.account_slug, a.temporal_config, a.onboarding_config, a.job_defaults
 FROM neosync_api.accounts a
 INNER JOIN neosync_api.account_api_keys aak ON aak.account_id = a.id
 INNER JOIN neosync_api.users u ON u.id = aak.user_id
@@ -305,7 +368,7 @@ WHERE u.id = $1
 
 UNION
 
-SELECT a.id, a.created_at, a.updated_at, a.account_type, a.account_slug, a.temporal_config, a.onboarding_config
+SELECT a.id, a.created_at, a.updated_at, a.account_type, a.account_slug, a.temporal_config, a.onboarding_config, a.job_defaults
 FROM neosync_api.accounts a
 INNER JOIN neosync_api.account_user_associations aua ON aua.account_id = a.id
 INNER JOIN neosync_api.users u ON u.id = aua.user_id
@@ -329,6 +392,7 @@ func (q *Queries) GetAccountsByUser(ctx context.Context, db DBTX, id pgtype.UUID
 			&i.AccountSlug,
 			&i.TemporalConfig,
 			&i.OnboardingConfig,
+			&i.JobDefaults,
 		); err != nil {
 			return nil, err
 		}
@@ -393,7 +457,7 @@ func (q *Queries) GetAnonymousUser(ctx context.Context, db DBTX) (NeosyncApiUser
 }
 
 const getPersonalAccountByUserId = `-- name: GetPersonalAccountByUserId :one
-SELECT a.id, a.created_at, a.updated_at, a.account_type, a.account_slug, a.temporal_config, a.onboarding_config from neosync_api.accounts a
+SELECT a.id, a.created_at, a.updated_at, a.account_type, a.account_slug, a.temporal_config, a.onboarding_config, a.job_defaults from neosync_api.accounts a
 INNER JOIN neosync_api.account_user_associations aua ON aua.account_id = a.id
 INNER JOIN neosync_api.users u ON u.id = aua.user_id
 WHERE u.id = $1 AND a.account_type = 0
@@ -410,12 +474,13 @@ func (q *Queries) GetPersonalAccountByUserId(ctx context.Context, db DBTX, useri
 		&i.AccountSlug,
 		&i.TemporalConfig,
 		&i.OnboardingConfig,
+		&i.JobDefaults,
 	)
 	return i, err
 }
 
 const getTeamAccountsByUserId = `-- name: GetTeamAccountsByUserId :many
-SELECT a.id, a.created_at, a.updated_at, a.account_type, a.account_slug, a.temporal_config, a.onboarding_config from neosync_api.accounts a
+SELECT a.id, a.created_at, a.updated_at, a.account_type, a.account_slug, a.temporal_config, a.onboarding_config, a.job_defaults from neosync_api.accounts a
 INNER JOIN neosync_api.account_user_associations aua ON aua.account_id = a.id
 INNER JOIN neosync_api.users u ON u.id = aua.user_id
 WHERE u.id = $1 AND a.account_type = 1
@@ -438,6 +503,7 @@ func (q *Queries) GetTeamAccountsByUserId(ctx context.Context, db DBTX, userid p
 			&i.AccountSlug,
 			&i.TemporalConfig,
 			&i.OnboardingConfig,
+			&i.JobDefaults,
 		); err != nil {
 			return nil, err
 		}
@@ -617,6 +683,26 @@ func (q *Queries) GetUserIdentityByUserId(ctx context.Context, db DBTX, userID p
 	return i, err
 }
 
+const grantAccountUserPermission = `-- name: GrantAccountUserPermission :exec
+INSERT INTO neosync_api.account_user_permissions (
+  account_id, user_id, permission
+) VALUES (
+  $1, $2, $3
+)
+ON CONFLICT (account_id, user_id, permission) DO NOTHING
+`
+
+type GrantAccountUserPermissionParams struct {
+	AccountId  pgtype.UUID
+	UserId     pgtype.UUID
+	Permission string
+}
+
+func (q *Queries) GrantAccountUserPermission(ctx context.Context, db DBTX, arg GrantAccountUserPermissionParams) error {
+	_, err := db.Exec(ctx, grantAccountUserPermission, arg.AccountId, arg.UserId, arg.Permission)
+	return err
+}
+
 const isUserInAccount = `-- name: IsUserInAccount :one
 SELECT count(aua.id) from neosync_api.account_user_associations aua
 INNER JOIN neosync_api.accounts a ON a.id = aua.account_id
@@ -661,6 +747,22 @@ func (q *Queries) RemoveAccountUser(ctx context.Context, db DBTX, arg RemoveAcco
 	return err
 }
 
+const revokeAccountUserPermission = `-- name: RevokeAccountUserPermission :exec
+DELETE FROM neosync_api.account_user_permissions
+WHERE account_id = $1 AND user_id = $2 AND permission = $3
+`
+
+type RevokeAccountUserPermissionParams struct {
+	AccountId  pgtype.UUID
+	UserId     pgtype.UUID
+	Permission string
+}
+
+func (q *Queries) RevokeAccountUserPermission(ctx context.Context, db DBTX, arg RevokeAccountUserPermissionParams) error {
+	_, err := db.Exec(ctx, revokeAccountUserPermission, arg.AccountId, arg.UserId, arg.Permission)
+	return err
+}
+
 const setAnonymousUser = `-- name: SetAnonymousUser :one
 INSERT INTO neosync_api.users (
   id, created_at, updated_at
@@ -709,11 +811,39 @@ func (q *Queries) UpdateAccountInviteToAccepted(ctx context.Context, db DBTX, id
 	return i, err
 }
 
+const updateAccountJobDefaults = `-- name: UpdateAccountJobDefaults :one
+UPDATE neosync_api.accounts
+SET job_defaults = $1
+WHERE id = $2
+RETURNING id, created_at, updated_at, account_type, account_slug, temporal_config, onboarding_config, job_defaults
+`
+
+type UpdateAccountJobDefaultsParams struct {
+	JobDefaults *pg_models.AccountJobDefaults
+	AccountId   pgtype.UUID
+}
+
+func (q *Queries) UpdateAccountJobDefaults(ctx context.Context, db DBTX, arg UpdateAccountJobDefaultsParams) (NeosyncApiAccount, error) {
+	row := db.QueryRow(ctx, updateAccountJobDefaults, arg.JobDefaults, arg.AccountId)
+	var i NeosyncApiAccount
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.AccountType,
+		&i.AccountSlug,
+		&i.TemporalConfig,
+		&i.OnboardingConfig,
+		&i.JobDefaults,
+	)
+	return i, err
+}
+
 const updateAccountOnboardingConfig = `-- name: UpdateAccountOnboardingConfig :one
 UPDATE neosync_api.accounts
 SET onboarding_config = $1
 WHERE id = $2
-RETURNING id, created_at, updated_at, account_type, account_slug, temporal_config, onboarding_config
+RETURNING id, created_at, updated_at, account_type, account_slug, temporal_config, onboarding_config, job_defaults
 `
 
 type UpdateAccountOnboardingConfigParams struct {
@@ -732,6 +862,7 @@ func (q *Queries) UpdateAccountOnboardingConfig(ctx context.Context, db DBTX, ar
 		&i.AccountSlug,
 		&i.TemporalConfig,
 		&i.OnboardingConfig,
+		&i.JobDefaults,
 	)
 	return i, err
 }
@@ -769,7 +900,7 @@ const updateTemporalConfigByAccount = `-- name: UpdateTemporalConfigByAccount :o
 UPDATE neosync_api.accounts
 SET temporal_config = $1
 WHERE id = $2
-RETURNING id, created_at, updated_at, account_type, account_slug, temporal_config, onboarding_config
+RETURNING id, created_at, updated_at, account_type, account_slug, temporal_config, onboarding_config, job_defaults
 `
 
 type UpdateTemporalConfigByAccountParams struct {
@@ -788,6 +919,7 @@ func (q *Queries) UpdateTemporalConfigByAccount(ctx context.Context, db DBTX, ar
 		&i.AccountSlug,
 		&i.TemporalConfig,
 		&i.OnboardingConfig,
+		&i.JobDefaults,
 	)
 	return i, err
 }
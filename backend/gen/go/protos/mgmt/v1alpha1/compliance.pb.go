@@ -0,0 +1,2730 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        (unknown)
+// source: mgmt/v1alpha1/compliance.proto
+
+package mgmtv1alpha1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The sensitivity classes a column may be placed in
+type DataClassification int32
+
+const (
+	DataClassification_DATA_CLASSIFICATION_UNSPECIFIED DataClassification = 0
+	// Personally identifiable information
+	DataClassification_DATA_CLASSIFICATION_PII DataClassification = 1
+	// Protected health information
+	DataClassification_DATA_CLASSIFICATION_PHI DataClassification = 2
+	// Financial information such as card or account numbers
+	DataClassification_DATA_CLASSIFICATION_FINANCIAL DataClassification = 3
+	// Data that is safe to be shared as-is
+	DataClassification_DATA_CLASSIFICATION_PUBLIC DataClassification = 4
+)
+
+// Enum value maps for DataClassification.
+var (
+	DataClassification_name = map[int32]string{
+		0: "DATA_CLASSIFICATION_UNSPECIFIED",
+		1: "DATA_CLASSIFICATION_PII",
+		2: "DATA_CLASSIFICATION_PHI",
+		3: "DATA_CLASSIFICATION_FINANCIAL",
+		4: "DATA_CLASSIFICATION_PUBLIC",
+	}
+	DataClassification_value = map[string]int32{
+		"DATA_CLASSIFICATION_UNSPECIFIED": 0,
+		"DATA_CLASSIFICATION_PII":         1,
+		"DATA_CLASSIFICATION_PHI":         2,
+		"DATA_CLASSIFICATION_FINANCIAL":   3,
+		"DATA_CLASSIFICATION_PUBLIC":      4,
+	}
+)
+
+func (x DataClassification) Enum() *DataClassification {
+	p := new(DataClassification)
+	*p = x
+	return p
+}
+
+func (x DataClassification) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DataClassification) Descriptor() protoreflect.EnumDescriptor {
+	return file_mgmt_v1alpha1_compliance_proto_enumTypes[0].Descriptor()
+}
+
+func (DataClassification) Type() protoreflect.EnumType {
+	return &file_mgmt_v1alpha1_compliance_proto_enumTypes[0]
+}
+
+func (x DataClassification) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DataClassification.Descriptor instead.
+func (DataClassification) EnumDescriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_compliance_proto_rawDescGZIP(), []int{0}
+}
+
+// The regulatory framework that the report is framed against
+type ComplianceFramework int32
+
+const (
+	ComplianceFramework_COMPLIANCE_FRAMEWORK_UNSPECIFIED ComplianceFramework = 0
+	ComplianceFramework_COMPLIANCE_FRAMEWORK_GDPR        ComplianceFramework = 1
+	ComplianceFramework_COMPLIANCE_FRAMEWORK_HIPAA       ComplianceFramework = 2
+)
+
+// Enum value maps for ComplianceFramework.
+var (
+	ComplianceFramework_name = map[int32]string{
+		0: "COMPLIANCE_FRAMEWORK_UNSPECIFIED",
+		1: "COMPLIANCE_FRAMEWORK_GDPR",
+		2: "COMPLIANCE_FRAMEWORK_HIPAA",
+	}
+	ComplianceFramework_value = map[string]int32{
+		"COMPLIANCE_FRAMEWORK_UNSPECIFIED": 0,
+		"COMPLIANCE_FRAMEWORK_GDPR":        1,
+		"COMPLIANCE_FRAMEWORK_HIPAA":       2,
+	}
+)
+
+func (x ComplianceFramework) Enum() *ComplianceFramework {
+	p := new(ComplianceFramework)
+	*p = x
+	return p
+}
+
+func (x ComplianceFramework) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ComplianceFramework) Descriptor() protoreflect.EnumDescriptor {
+	return file_mgmt_v1alpha1_compliance_proto_enumTypes[1].Descriptor()
+}
+
+func (ComplianceFramework) Type() protoreflect.EnumType {
+	return &file_mgmt_v1alpha1_compliance_proto_enumTypes[1]
+}
+
+func (x ComplianceFramework) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ComplianceFramework.Descriptor instead.
+func (ComplianceFramework) EnumDescriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_compliance_proto_rawDescGZIP(), []int{1}
+}
+
+// Where a column tag originated from
+type ColumnTagSource int32
+
+const (
+	ColumnTagSource_COLUMN_TAG_SOURCE_UNSPECIFIED ColumnTagSource = 0
+	// The tag was generated by scanning the connection's schema
+	ColumnTagSource_COLUMN_TAG_SOURCE_SCANNER ColumnTagSource = 1
+	// The tag was set by a user. User tags are never overwritten by the scanner
+	ColumnTagSource_COLUMN_TAG_SOURCE_USER ColumnTagSource = 2
+	// The tag was imported from a schema file such as a dbt manifest. User tags are never overwritten by an import
+	ColumnTagSource_COLUMN_TAG_SOURCE_SCHEMA_HINTS ColumnTagSource = 3
+)
+
+// Enum value maps for ColumnTagSource.
+var (
+	ColumnTagSource_name = map[int32]string{
+		0: "COLUMN_TAG_SOURCE_UNSPECIFIED",
+		1: "COLUMN_TAG_SOURCE_SCANNER",
+		2: "COLUMN_TAG_SOURCE_USER",
+		3: "COLUMN_TAG_SOURCE_SCHEMA_HINTS",
+	}
+	ColumnTagSource_value = map[string]int32{
+		"COLUMN_TAG_SOURCE_UNSPECIFIED":  0,
+		"COLUMN_TAG_SOURCE_SCANNER":      1,
+		"COLUMN_TAG_SOURCE_USER":         2,
+		"COLUMN_TAG_SOURCE_SCHEMA_HINTS": 3,
+	}
+)
+
+func (x ColumnTagSource) Enum() *ColumnTagSource {
+	p := new(ColumnTagSource)
+	*p = x
+	return p
+}
+
+func (x ColumnTagSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ColumnTagSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_mgmt_v1alpha1_compliance_proto_enumTypes[2].Descriptor()
+}
+
+func (ColumnTagSource) Type() protoreflect.EnumType {
+	return &file_mgmt_v1alpha1_compliance_proto_enumTypes[2]
+}
+
+func (x ColumnTagSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ColumnTagSource.Descriptor instead.
+func (ColumnTagSource) EnumDescriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_compliance_proto_rawDescGZIP(), []int{2}
+}
+
+// The format of a file that describes a database schema from outside of the database
+type SchemaHintsFormat int32
+
+const (
+	SchemaHintsFormat_SCHEMA_HINTS_FORMAT_UNSPECIFIED SchemaHintsFormat = 0
+	// The manifest.json artifact that is produced by dbt
+	SchemaHintsFormat_SCHEMA_HINTS_FORMAT_DBT_MANIFEST SchemaHintsFormat = 1
+	// A Prisma schema file, usually schema.prisma
+	SchemaHintsFormat_SCHEMA_HINTS_FORMAT_PRISMA_SCHEMA SchemaHintsFormat = 2
+	// A Rails db/schema.rb file
+	SchemaHintsFormat_SCHEMA_HINTS_FORMAT_RAILS_SCHEMA SchemaHintsFormat = 3
+)
+
+// Enum value maps for SchemaHintsFormat.
+var (
+	SchemaHintsFormat_name = map[int32]string{
+		0: "SCHEMA_HINTS_FORMAT_UNSPECIFIED",
+		1: "SCHEMA_HINTS_FORMAT_DBT_MANIFEST",
+		2: "SCHEMA_HINTS_FORMAT_PRISMA_SCHEMA",
+		3: "SCHEMA_HINTS_FORMAT_RAILS_SCHEMA",
+	}
+	SchemaHintsFormat_value = map[string]int32{
+		"SCHEMA_HINTS_FORMAT_UNSPECIFIED":   0,
+		"SCHEMA_HINTS_FORMAT_DBT_MANIFEST":  1,
+		"SCHEMA_HINTS_FORMAT_PRISMA_SCHEMA": 2,
+		"SCHEMA_HINTS_FORMAT_RAILS_SCHEMA":  3,
+	}
+)
+
+func (x SchemaHintsFormat) Enum() *SchemaHintsFormat {
+	p := new(SchemaHintsFormat)
+	*p = x
+	return p
+}
+
+func (x SchemaHintsFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SchemaHintsFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_mgmt_v1alpha1_compliance_proto_enumTypes[3].Descriptor()
+}
+
+func (SchemaHintsFormat) Type() protoreflect.EnumType {
+	return &file_mgmt_v1alpha1_compliance_proto_enumTypes[3]
+}
+
+func (x SchemaHintsFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SchemaHintsFormat.Descriptor instead.
+func (SchemaHintsFormat) EnumDescriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_compliance_proto_rawDescGZIP(), []int{3}
+}
+
+type GenerateComplianceReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Target:
+	//
+	//	*GenerateComplianceReportRequest_JobId
+	//	*GenerateComplianceReportRequest_JobRunId
+	Target isGenerateComplianceReportRequest_Target `protobuf_oneof:"target"`
+	// Required when providing a job run id
+	AccountId string              `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Framework ComplianceFramework `protobuf:"varint,4,opt,name=framework,proto3,enum=mgmt.v1alpha1.ComplianceFramework" json:"framework,omitempty"`
+}
+
+func (x *GenerateComplianceReportRequest) Reset() {
+	*x = GenerateComplianceReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateComplianceReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateComplianceReportRequest) ProtoMessage() {}
+
+func (x *GenerateComplianceReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateComplianceReportRequest.ProtoReflect.Descriptor instead.
+func (*GenerateComplianceReportRequest) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_compliance_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *GenerateComplianceReportRequest) GetTarget() isGenerateComplianceReportRequest_Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (x *GenerateComplianceReportRequest) GetJobId() string {
+	if x, ok := x.GetTarget().(*GenerateComplianceReportRequest_JobId); ok {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *GenerateComplianceReportRequest) GetJobRunId() string {
+	if x, ok := x.GetTarget().(*GenerateComplianceReportRequest_JobRunId); ok {
+		return x.JobRunId
+	}
+	return ""
+}
+
+func (x *GenerateComplianceReportRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *GenerateComplianceReportRequest) GetFramework() ComplianceFramework {
+	if x != nil {
+		return x.Framework
+	}
+	return ComplianceFramework_COMPLIANCE_FRAMEWORK_UNSPECIFIED
+}
+
+type isGenerateComplianceReportRequest_Target interface {
+	isGenerateComplianceReportRequest_Target()
+}
+
+type GenerateComplianceReportRequest_JobId struct {
+	// Generates a report based on the current configuration of the job
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3,oneof"`
+}
+
+type GenerateComplianceReportRequest_JobRunId struct {
+	// Generates a report for a specific run of a job, based on the configuration that the run was started with.
+	// Requires job run artifacts to be enabled so that the configuration was recorded
+	JobRunId string `protobuf:"bytes,2,opt,name=job_run_id,json=jobRunId,proto3,oneof"`
+}
+
+func (*GenerateComplianceReportRequest_JobId) isGenerateComplianceReportRequest_Target() {}
+
+func (*GenerateComplianceReportRequest_JobRunId) isGenerateComplianceReportRequest_Target() {}
+
+type GenerateComplianceReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Report *ComplianceReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	// The JSON encoded report. This is the exact payload that was signed
+	Content []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// The hex encoded signature of the content.
+	// Empty if the server has not been configured with a signing key
+	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	// The algorithm that was used to compute the signature. Ex: HMAC-SHA256
+	SignatureAlgorithm string `protobuf:"bytes,4,opt,name=signature_algorithm,json=signatureAlgorithm,proto3" json:"signature_algorithm,omitempty"`
+}
+
+func (x *GenerateComplianceReportResponse) Reset() {
+	*x = GenerateComplianceReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateComplianceReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateComplianceReportResponse) ProtoMessage() {}
+
+func (x *GenerateComplianceReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateComplianceReportResponse.ProtoReflect.Descriptor instead.
+func (*GenerateComplianceReportResponse) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_compliance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GenerateComplianceReportResponse) GetReport() *ComplianceReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *GenerateComplianceReportResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *GenerateComplianceReportResponse) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *GenerateComplianceReportResponse) GetSignatureAlgorithm() string {
+	if x != nil {
+		return x.SignatureAlgorithm
+	}
+	return ""
+}
+
+type ComplianceReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	JobId     string `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	JobName   string `protobuf:"bytes,3,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	// Populated if the report was generated for a specific run
+	JobRunId    *string                `protobuf:"bytes,4,opt,name=job_run_id,json=jobRunId,proto3,oneof" json:"job_run_id,omitempty"`
+	GeneratedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	Framework   ComplianceFramework    `protobuf:"varint,6,opt,name=framework,proto3,enum=mgmt.v1alpha1.ComplianceFramework" json:"framework,omitempty"`
+	// The user that last modified the job configuration
+	ConfiguredByUserId string                    `protobuf:"bytes,7,opt,name=configured_by_user_id,json=configuredByUserId,proto3" json:"configured_by_user_id,omitempty"`
+	ConfiguredAt       *timestamppb.Timestamp    `protobuf:"bytes,8,opt,name=configured_at,json=configuredAt,proto3" json:"configured_at,omitempty"`
+	Columns            []*ComplianceColumnReport `protobuf:"bytes,9,rep,name=columns,proto3" json:"columns,omitempty"`
+	// The total amount of rows that were processed by the run.
+	// Only available for run reports when the metrics service is enabled
+	RowCount *uint64 `protobuf:"varint,10,opt,name=row_count,json=rowCount,proto3,oneof" json:"row_count,omitempty"`
+	// The user that approved the most recent change to the job configuration.
+	// Only available if job change approvals are enabled and a change has been approved
+	ApprovedByUserId *string                `protobuf:"bytes,11,opt,name=approved_by_user_id,json=approvedByUserId,proto3,oneof" json:"approved_by_user_id,omitempty"`
+	ApprovedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=approved_at,json=approvedAt,proto3,oneof" json:"approved_at,omitempty"`
+}
+
+func (x *ComplianceReport) Reset() {
+	*x = ComplianceReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComplianceReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComplianceReport) ProtoMessage() {}
+
+func (x *ComplianceReport) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComplianceReport.ProtoReflect.Descriptor instead.
+func (*ComplianceReport) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_compliance_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ComplianceReport) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ComplianceReport) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ComplianceReport) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *ComplianceReport) GetJobRunId() string {
+	if x != nil && x.JobRunId != nil {
+		return *x.JobRunId
+	}
+	return ""
+}
+
+func (x *ComplianceReport) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+func (x *ComplianceReport) GetFramework() ComplianceFramework {
+	if x != nil {
+		return x.Framework
+	}
+	return ComplianceFramework_COMPLIANCE_FRAMEWORK_UNSPECIFIED
+}
+
+func (x *ComplianceReport) GetConfiguredByUserId() string {
+	if x != nil {
+		return x.ConfiguredByUserId
+	}
+	return ""
+}
+
+func (x *ComplianceReport) GetConfiguredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConfiguredAt
+	}
+	return nil
+}
+
+func (x *ComplianceReport) GetColumns() []*ComplianceColumnReport {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ComplianceReport) GetRowCount() uint64 {
+	if x != nil && x.RowCount != nil {
+		return *x.RowCount
+	}
+	return 0
+}
+
+func (x *ComplianceReport) GetApprovedByUserId() string {
+	if x != nil && x.ApprovedByUserId != nil {
+		return *x.ApprovedByUserId
+	}
+	return ""
+}
+
+func (x *ComplianceReport) GetApprovedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ApprovedAt
+	}
+	return nil
+}
+
+type ComplianceColumnReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schema            string               `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	Table             string               `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	Column            string               `protobuf:"bytes,3,opt,name=column,proto3" json:"column,omitempty"`
+	Classifications   []DataClassification `protobuf:"varint,4,rep,packed,name=classifications,proto3,enum=mgmt.v1alpha1.DataClassification" json:"classifications,omitempty"`
+	TransformerSource TransformerSource    `protobuf:"varint,5,opt,name=transformer_source,json=transformerSource,proto3,enum=mgmt.v1alpha1.TransformerSource" json:"transformer_source,omitempty"`
+	// Friendly name of the transformer that was applied
+	TransformerName string `protobuf:"bytes,6,opt,name=transformer_name,json=transformerName,proto3" json:"transformer_name,omitempty"`
+	// True if the column is classified as sensitive and is passed through without modification
+	IsExposed bool `protobuf:"varint,7,opt,name=is_exposed,json=isExposed,proto3" json:"is_exposed,omitempty"`
+}
+
+func (x *ComplianceColumnReport) Reset() {
+	*x = ComplianceColumnReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComplianceColumnReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComplianceColumnReport) ProtoMessage() {}
+
+func (x *ComplianceColumnReport) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComplianceColumnReport.ProtoReflect.Descriptor instead.
+func (*ComplianceColumnReport) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_compliance_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ComplianceColumnReport) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *ComplianceColumnReport) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *ComplianceColumnReport) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *ComplianceColumnReport) GetClassifications() []DataClassification {
+	if x != nil {
+		return x.Classifications
+	}
+	return nil
+}
+
+func (x *ComplianceColumnReport) GetTransformerSource() TransformerSource {
+	if x != nil {
+		return x.TransformerSource
+	}
+	return TransformerSource_TRANSFORMER_SOURCE_UNSPECIFIED
+}
+
+func (x *ComplianceColumnReport) GetTransformerName() string {
+	if x != nil {
+		return x.TransformerName
+	}
+	return ""
+}
+
+func (x *ComplianceColumnReport) GetIsExposed() bool {
+	if x != nil {
+		return x.IsExposed
+	}
+	return false
+}
+
+// The data classifications of a column within a connection
+type ColumnTag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ConnectionId    string                 `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Schema          string                 `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
+	Table           string                 `protobuf:"bytes,4,opt,name=table,proto3" json:"table,omitempty"`
+	Column          string                 `protobuf:"bytes,5,opt,name=column,proto3" json:"column,omitempty"`
+	Classifications []DataClassification   `protobuf:"varint,6,rep,packed,name=classifications,proto3,enum=mgmt.v1alpha1.DataClassification" json:"classifications,omitempty"`
+	Source          ColumnTagSource        `protobuf:"varint,7,opt,name=source,proto3,enum=mgmt.v1alpha1.ColumnTagSource" json:"source,omitempty"`
+	CreatedByUserId string                 `protobuf:"bytes,8,opt,name=created_by_user_id,json=createdByUserId,proto3" json:"created_by_user_id,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedByUserId string                 `protobuf:"bytes,10,opt,name=updated_by_user_id,json=updatedByUserId,proto3" json:"updated_by_user_id,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *ColumnTag) Reset() {
+	*x = ColumnTag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColumnTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColumnTag) ProtoMessage() {}
+
+func (x *ColumnTag) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColumnTag.ProtoReflect.Descriptor instead.
+func (*ColumnTag) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_compliance_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ColumnTag) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ColumnTag) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *ColumnTag) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *ColumnTag) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *ColumnTag) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *ColumnTag) GetClassifications() []DataClassification {
+	if x != nil {
+		return x.Classifications
+	}
+	return nil
+}
+
+func (x *ColumnTag) GetSource() ColumnTagSource {
+	if x != nil {
+		return x.Source
+	}
+	return ColumnTagSource_COLUMN_TAG_SOURCE_UNSPECIFIED
+}
+
+func (x *ColumnTag) GetCreatedByUserId() string {
+	if x != nil {
+		return x.CreatedByUserId
+	}
+	return ""
+}
+
+func (x *ColumnTag) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ColumnTag) GetUpdatedByUserId() string {
+	if x != nil {
+		return x.UpdatedByUserId
+	}
+	return ""
+}
+
+func (x *ColumnTag) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetColumnTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+}
+
+func (x *GetColumnTagsRequest) Reset() {
+	*x = GetColumnTagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetColumnTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetColumnTagsRequest) ProtoMessage() {}
+
+func (x *GetColumnTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetColumnTagsRequest.ProtoReflect.Descriptor instead.
+func (*GetColumnTagsRequest) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_compliance_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetColumnTagsRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+type GetColumnTagsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags []*ColumnTag `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *GetColumnTagsResponse) Reset() {
+	*x = GetColumnTagsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetColumnTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetColumnTagsResponse) ProtoMessage() {}
+
+func (x *GetColumnTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetColumnTagsResponse.ProtoReflect.Descriptor instead.
+func (*GetColumnTagsResponse) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_compliance_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetColumnTagsResponse) GetTags() []*ColumnTag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type SetColumnTagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnectionId    string               `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Schema          string               `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	Table           string               `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
+	Column          string               `protobuf:"bytes,4,opt,name=column,proto3" json:"column,omitempty"`
+	Classifications []DataClassification `protobuf:"varint,5,rep,packed,name=classifications,proto3,enum=mgmt.v1alpha1.DataClassification" json:"classifications,omitempty"`
+}
+
+func (x *SetColumnTagRequest) Reset() {
+	*x = SetColumnTagRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetColumnTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetColumnTagRequest) ProtoMessage() {}
+
+func (x *SetColumnTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetColumnTagRequest.ProtoReflect.Descriptor instead.
+func (*SetColumnTagRequest) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_compliance_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetColumnTagRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *SetColumnTagRequest) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *SetColumnTagRequest) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *SetColumnTagRequest) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *SetColumnTagRequest) GetClassifications() []DataClassification {
+	if x != nil {
+		return x.Classifications
+	}
+	return nil
+}
+
+type SetColumnTagResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag *ColumnTag `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *SetColumnTagResponse) Reset() {
+	*x = SetColumnTagResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetColumnTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetColumnTagResponse) ProtoMessage() {}
+
+func (x *SetColumnTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetColumnTagResponse.ProtoReflect.Descriptor instead.
+func (*SetColumnTagResponse) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_compliance_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetColumnTagResponse) GetTag() *ColumnTag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+type DeleteColumnTagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteColumnTagRequest) Reset() {
+	*x = DeleteColumnTagRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteColumnTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteColumnTagRequest) ProtoMessage() {}
+
+func (x *DeleteColumnTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteColumnTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteColumnTagRequest) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_compliance_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteColumnTagRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteColumnTagResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteColumnTagResponse) Reset() {
+	*x = DeleteColumnTagResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteColumnTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteColumnTagResponse) ProtoMessage() {}
+
+func (x *DeleteColumnTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteColumnTagResponse.ProtoReflect.Descriptor instead.
+func (*DeleteColumnTagResponse) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_compliance_proto_rawDescGZIP(), []int{10}
+}
+
+type ScanColumnTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+}
+
+func (x *ScanColumnTagsRequest) Reset() {
+	*x = ScanColumnTagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanColumnTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanColumnTagsRequest) ProtoMessage() {}
+
+func (x *ScanColumnTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanColumnTagsRequest.ProtoReflect.Descriptor instead.
+func (*ScanColumnTagsRequest) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_compliance_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ScanColumnTagsRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+type ScanColumnTagsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The full set of tags for the connection after the scan has completed
+	Tags []*ColumnTag `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *ScanColumnTagsResponse) Reset() {
+	*x = ScanColumnTagsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanColumnTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanColumnTagsResponse) ProtoMessage() {}
+
+func (x *ScanColumnTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanColumnTagsResponse.ProtoReflect.Descriptor instead.
+func (*ScanColumnTagsResponse) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_compliance_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ScanColumnTagsResponse) GetTags() []*ColumnTag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type EvaluatePrivacyRiskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	JobRunId  string `protobuf:"bytes,2,opt,name=job_run_id,json=jobRunId,proto3" json:"job_run_id,omitempty"`
+	Schema    string `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
+	Table     string `protobuf:"bytes,4,opt,name=table,proto3" json:"table,omitempty"`
+	// The columns that in combination could be used to re-identify an individual. Ex: zip code, birth date, gender
+	QuasiIdentifiers []string `protobuf:"bytes,5,rep,name=quasi_identifiers,json=quasiIdentifiers,proto3" json:"quasi_identifiers,omitempty"`
+	// The sensitive attribute used to compute l-diversity. If not provided, only k-anonymity is evaluated
+	SensitiveColumn *string `protobuf:"bytes,6,opt,name=sensitive_column,json=sensitiveColumn,proto3,oneof" json:"sensitive_column,omitempty"`
+	// Equivalence classes with fewer rows than this are flagged. Defaults to 5
+	KThreshold *uint32 `protobuf:"varint,7,opt,name=k_threshold,json=kThreshold,proto3,oneof" json:"k_threshold,omitempty"`
+	// Equivalence classes with fewer distinct sensitive values than this are flagged. Defaults to 2
+	LThreshold *uint32 `protobuf:"varint,8,opt,name=l_threshold,json=lThreshold,proto3,oneof" json:"l_threshold,omitempty"`
+	// The destination of the run to evaluate. Defaults to the first SQL destination of the job
+	DestinationConnectionId *string `protobuf:"bytes,9,opt,name=destination_connection_id,json=destinationConnectionId,proto3,oneof" json:"destination_connection_id,omitempty"`
+}
+
+func (x *EvaluatePrivacyRiskRequest) Reset() {
+	*x = EvaluatePrivacyRiskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluatePrivacyRiskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluatePrivacyRiskRequest) ProtoMessage() {}
+
+func (x *EvaluatePrivacyRiskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluatePrivacyRiskRequest.ProtoReflect.Descriptor instead.
+func (*EvaluatePrivacyRiskRequest) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_compliance_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *EvaluatePrivacyRiskRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *EvaluatePrivacyRiskRequest) GetJobRunId() string {
+	if x != nil {
+		return x.JobRunId
+	}
+	return ""
+}
+
+func (x *EvaluatePrivacyRiskRequest) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *EvaluatePrivacyRiskRequest) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *EvaluatePrivacyRiskRequest) GetQuasiIdentifiers() []string {
+	if x != nil {
+		return x.QuasiIdentifiers
+	}
+	return nil
+}
+
+func (x *EvaluatePrivacyRiskRequest) GetSensitiveColumn() string {
+	if x != nil && x.SensitiveColumn != nil {
+		return *x.SensitiveColumn
+	}
+	return ""
+}
+
+func (x *EvaluatePrivacyRiskRequest) GetKThreshold() uint32 {
+	if x != nil && x.KThreshold != nil {
+		return *x.KThreshold
+	}
+	return 0
+}
+
+func (x *EvaluatePrivacyRiskRequest) GetLThreshold() uint32 {
+	if x != nil && x.LThreshold != nil {
+		return *x.LThreshold
+	}
+	return 0
+}
+
+func (x *EvaluatePrivacyRiskRequest) GetDestinationConnectionId() string {
+	if x != nil && x.DestinationConnectionId != nil {
+		return *x.DestinationConnectionId
+	}
+	return ""
+}
+
+type EvaluatePrivacyRiskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The destination connection that was evaluated
+	DestinationConnectionId string `protobuf:"bytes,1,opt,name=destination_connection_id,json=destinationConnectionId,proto3" json:"destination_connection_id,omitempty"`
+	RowCount                uint64 `protobuf:"varint,2,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	// The number of distinct combinations of quasi-identifier values
+	EquivalenceClassCount uint64 `protobuf:"varint,3,opt,name=equivalence_class_count,json=equivalenceClassCount,proto3" json:"equivalence_class_count,omitempty"`
+	// The size of the smallest equivalence class. The table is k-anonymous for any k up to this value
+	KAnonymity uint64 `protobuf:"varint,4,opt,name=k_anonymity,json=kAnonymity,proto3" json:"k_anonymity,omitempty"`
+	// The smallest number of distinct sensitive values within an equivalence class. Only set if a sensitive column was provided
+	LDiversity *uint64 `protobuf:"varint,5,opt,name=l_diversity,json=lDiversity,proto3,oneof" json:"l_diversity,omitempty"`
+	// The number of rows that belong to a flagged equivalence class
+	RowsAtRisk uint64 `protobuf:"varint,6,opt,name=rows_at_risk,json=rowsAtRisk,proto3" json:"rows_at_risk,omitempty"`
+	// The flagged equivalence classes, smallest first. Capped at 100 classes
+	RiskyClasses []*RiskyEquivalenceClass `protobuf:"bytes,7,rep,name=risky_classes,json=riskyClasses,proto3" json:"risky_classes,omitempty"`
+	KThreshold   uint32                   `protobuf:"varint,8,opt,name=k_threshold,json=kThreshold,proto3" json:"k_threshold,omitempty"`
+	LThreshold   *uint32                  `protobuf:"varint,9,opt,name=l_threshold,json=lThreshold,proto3,oneof" json:"l_threshold,omitempty"`
+}
+
+func (x *EvaluatePrivacyRiskResponse) Reset() {
+	*x = EvaluatePrivacyRiskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluatePrivacyRiskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluatePrivacyRiskResponse) ProtoMessage() {}
+
+func (x *EvaluatePrivacyRiskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluatePrivacyRiskResponse.ProtoReflect.Descriptor instead.
+func (*EvaluatePrivacyRiskResponse) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_compliance_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EvaluatePrivacyRiskResponse) GetDestinationConnectionId() string {
+	if x != nil {
+		return x.DestinationConnectionId
+	}
+	return ""
+}
+
+func (x *EvaluatePrivacyRiskResponse) GetRowCount() uint64 {
+	if x != nil {
+		return x.RowCount
+	}
+	return 0
+}
+
+func (x *EvaluatePrivacyRiskResponse) GetEquivalenceClassCount() uint64 {
+	if x != nil {
+		return x.EquivalenceClassCount
+	}
+	return 0
+}
+
+func (x *EvaluatePrivacyRiskResponse) GetKAnonymity() uint64 {
+	if x != nil {
+		return x.KAnonymity
+	}
+	return 0
+}
+
+func (x *EvaluatePrivacyRiskResponse) GetLDiversity() uint64 {
+	if x != nil && x.LDiversity != nil {
+		return *x.LDiversity
+	}
+	return 0
+}
+
+func (x *EvaluatePrivacyRiskResponse) GetRowsAtRisk() uint64 {
+	if x != nil {
+		return x.RowsAtRisk
+	}
+	return 0
+}
+
+func (x *EvaluatePrivacyRiskResponse) GetRiskyClasses() []*RiskyEquivalenceClass {
+	if x != nil {
+		return x.RiskyClasses
+	}
+	return nil
+}
+
+func (x *EvaluatePrivacyRiskResponse) GetKThreshold() uint32 {
+	if x != nil {
+		return x.KThreshold
+	}
+	return 0
+}
+
+func (x *EvaluatePrivacyRiskResponse) GetLThreshold() uint32 {
+	if x != nil && x.LThreshold != nil {
+		return *x.LThreshold
+	}
+	return 0
+}
+
+type RiskyEquivalenceClass struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The quasi-identifier values of the class, in the same order as the request. Null values are unset
+	Values                  []*EquivalenceClassValue `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	Size                    uint64                   `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	DistinctSensitiveValues *uint64                  `protobuf:"varint,3,opt,name=distinct_sensitive_values,json=distinctSensitiveValues,proto3,oneof" json:"distinct_sensitive_values,omitempty"`
+	// The class has fewer rows than the k threshold
+	ViolatesKAnonymity bool `protobuf:"varint,4,opt,name=violates_k_anonymity,json=violatesKAnonymity,proto3" json:"violates_k_anonymity,omitempty"`
+	// The class has fewer distinct sensitive values than the l threshold
+	ViolatesLDiversity bool `protobuf:"varint,5,opt,name=violates_l_diversity,json=violatesLDiversity,proto3" json:"violates_l_diversity,omitempty"`
+}
+
+func (x *RiskyEquivalenceClass) Reset() {
+	*x = RiskyEquivalenceClass{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RiskyEquivalenceClass) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskyEquivalenceClass) ProtoMessage() {}
+
+func (x *RiskyEquivalenceClass) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskyEquivalenceClass.ProtoReflect.Descriptor instead.
+func (*RiskyEquivalenceClass) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_compliance_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RiskyEquivalenceClass) GetValues() []*EquivalenceClassValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *RiskyEquivalenceClass) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *RiskyEquivalenceClass) GetDistinctSensitiveValues() uint64 {
+	if x != nil && x.DistinctSensitiveValues != nil {
+		return *x.DistinctSensitiveValues
+	}
+	return 0
+}
+
+func (x *RiskyEquivalenceClass) GetViolatesKAnonymity() bool {
+	if x != nil {
+		return x.ViolatesKAnonymity
+	}
+	return false
+}
+
+func (x *RiskyEquivalenceClass) GetViolatesLDiversity() bool {
+	if x != nil {
+		return x.ViolatesLDiversity
+	}
+	return false
+}
+
+type EquivalenceClassValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *string `protobuf:"bytes,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
+}
+
+func (x *EquivalenceClassValue) Reset() {
+	*x = EquivalenceClassValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EquivalenceClassValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EquivalenceClassValue) ProtoMessage() {}
+
+func (x *EquivalenceClassValue) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EquivalenceClassValue.ProtoReflect.Descriptor instead.
+func (*EquivalenceClassValue) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_compliance_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *EquivalenceClassValue) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return ""
+}
+
+type ImportSchemaHintsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnectionId string            `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Format       SchemaHintsFormat `protobuf:"varint,2,opt,name=format,proto3,enum=mgmt.v1alpha1.SchemaHintsFormat" json:"format,omitempty"`
+	// The contents of the file
+	Content []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// The schema of the tables that the file does not place in a schema. Defaults to public
+	DefaultSchema *string `protobuf:"bytes,4,opt,name=default_schema,json=defaultSchema,proto3,oneof" json:"default_schema,omitempty"`
+	// Returns the hints without tagging any columns
+	DryRun bool `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *ImportSchemaHintsRequest) Reset() {
+	*x = ImportSchemaHintsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportSchemaHintsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSchemaHintsRequest) ProtoMessage() {}
+
+func (x *ImportSchemaHintsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSchemaHintsRequest.ProtoReflect.Descriptor instead.
+func (*ImportSchemaHintsRequest) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_compliance_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ImportSchemaHintsRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *ImportSchemaHintsRequest) GetFormat() SchemaHintsFormat {
+	if x != nil {
+		return x.Format
+	}
+	return SchemaHintsFormat_SCHEMA_HINTS_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportSchemaHintsRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportSchemaHintsRequest) GetDefaultSchema() string {
+	if x != nil && x.DefaultSchema != nil {
+		return *x.DefaultSchema
+	}
+	return ""
+}
+
+func (x *ImportSchemaHintsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportSchemaHintsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hints for the columns of the file that exist in the connection
+	Columns []*ColumnSchemaHint `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	// Foreign keys that are declared by the file, whether or not they exist in the database. Can be used as virtual foreign keys
+	ForeignKeys []*SchemaHintForeignKey `protobuf:"bytes,2,rep,name=foreign_keys,json=foreignKeys,proto3" json:"foreign_keys,omitempty"`
+	// The columns of the file that do not exist in the connection, in schema.table.column format
+	UnmatchedColumns []string `protobuf:"bytes,3,rep,name=unmatched_columns,json=unmatchedColumns,proto3" json:"unmatched_columns,omitempty"`
+	// The full set of tags for the connection after the import has completed. Empty for dry runs
+	Tags []*ColumnTag `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *ImportSchemaHintsResponse) Reset() {
+	*x = ImportSchemaHintsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportSchemaHintsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSchemaHintsResponse) ProtoMessage() {}
+
+func (x *ImportSchemaHintsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSchemaHintsResponse.ProtoReflect.Descriptor instead.
+func (*ImportSchemaHintsResponse) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_compliance_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ImportSchemaHintsResponse) GetColumns() []*ColumnSchemaHint {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ImportSchemaHintsResponse) GetForeignKeys() []*SchemaHintForeignKey {
+	if x != nil {
+		return x.ForeignKeys
+	}
+	return nil
+}
+
+func (x *ImportSchemaHintsResponse) GetUnmatchedColumns() []string {
+	if x != nil {
+		return x.UnmatchedColumns
+	}
+	return nil
+}
+
+func (x *ImportSchemaHintsResponse) GetTags() []*ColumnTag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ColumnSchemaHint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schema      string  `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	Table       string  `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	Column      string  `protobuf:"bytes,3,opt,name=column,proto3" json:"column,omitempty"`
+	Description *string `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// The data tests that are declared for the column, such as not_null or unique
+	Tests []string `protobuf:"bytes,5,rep,name=tests,proto3" json:"tests,omitempty"`
+	// The only values that the column may contain, such as the values of an enum
+	AcceptedValues  []string             `protobuf:"bytes,6,rep,name=accepted_values,json=acceptedValues,proto3" json:"accepted_values,omitempty"`
+	Classifications []DataClassification `protobuf:"varint,7,rep,packed,name=classifications,proto3,enum=mgmt.v1alpha1.DataClassification" json:"classifications,omitempty"`
+	// The transformer that is recommended for the column. Unspecified if the column can be passed through
+	RecommendedTransformerSource TransformerSource  `protobuf:"varint,8,opt,name=recommended_transformer_source,json=recommendedTransformerSource,proto3,enum=mgmt.v1alpha1.TransformerSource" json:"recommended_transformer_source,omitempty"`
+	RecommendedTransformerConfig *TransformerConfig `protobuf:"bytes,9,opt,name=recommended_transformer_config,json=recommendedTransformerConfig,proto3" json:"recommended_transformer_config,omitempty"`
+}
+
+func (x *ColumnSchemaHint) Reset() {
+	*x = ColumnSchemaHint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColumnSchemaHint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColumnSchemaHint) ProtoMessage() {}
+
+func (x *ColumnSchemaHint) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColumnSchemaHint.ProtoReflect.Descriptor instead.
+func (*ColumnSchemaHint) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_compliance_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ColumnSchemaHint) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *ColumnSchemaHint) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *ColumnSchemaHint) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *ColumnSchemaHint) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *ColumnSchemaHint) GetTests() []string {
+	if x != nil {
+		return x.Tests
+	}
+	return nil
+}
+
+func (x *ColumnSchemaHint) GetAcceptedValues() []string {
+	if x != nil {
+		return x.AcceptedValues
+	}
+	return nil
+}
+
+func (x *ColumnSchemaHint) GetClassifications() []DataClassification {
+	if x != nil {
+		return x.Classifications
+	}
+	return nil
+}
+
+func (x *ColumnSchemaHint) GetRecommendedTransformerSource() TransformerSource {
+	if x != nil {
+		return x.RecommendedTransformerSource
+	}
+	return TransformerSource_TRANSFORMER_SOURCE_UNSPECIFIED
+}
+
+func (x *ColumnSchemaHint) GetRecommendedTransformerConfig() *TransformerConfig {
+	if x != nil {
+		return x.RecommendedTransformerConfig
+	}
+	return nil
+}
+
+type SchemaHintForeignKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schema         string   `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	Table          string   `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	Columns        []string `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	ForeignSchema  string   `protobuf:"bytes,4,opt,name=foreign_schema,json=foreignSchema,proto3" json:"foreign_schema,omitempty"`
+	ForeignTable   string   `protobuf:"bytes,5,opt,name=foreign_table,json=foreignTable,proto3" json:"foreign_table,omitempty"`
+	ForeignColumns []string `protobuf:"bytes,6,rep,name=foreign_columns,json=foreignColumns,proto3" json:"foreign_columns,omitempty"`
+}
+
+func (x *SchemaHintForeignKey) Reset() {
+	*x = SchemaHintForeignKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchemaHintForeignKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaHintForeignKey) ProtoMessage() {}
+
+func (x *SchemaHintForeignKey) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_compliance_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaHintForeignKey.ProtoReflect.Descriptor instead.
+func (*SchemaHintForeignKey) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_compliance_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SchemaHintForeignKey) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *SchemaHintForeignKey) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *SchemaHintForeignKey) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *SchemaHintForeignKey) GetForeignSchema() string {
+	if x != nil {
+		return x.ForeignSchema
+	}
+	return ""
+}
+
+func (x *SchemaHintForeignKey) GetForeignTable() string {
+	if x != nil {
+		return x.ForeignTable
+	}
+	return ""
+}
+
+func (x *SchemaHintForeignKey) GetForeignColumns() []string {
+	if x != nil {
+		return x.ForeignColumns
+	}
+	return nil
+}
+
+var File_mgmt_v1alpha1_compliance_proto protoreflect.FileDescriptor
+
+var file_mgmt_v1alpha1_compliance_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0d, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a,
+	0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x6d,
+	0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdf,
+	0x01, 0x0a, 0x1f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x69, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x48, 0x00, 0x52, 0x05,
+	0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0a, 0x6a, 0x6f, 0x62, 0x5f, 0x72, 0x75, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x48, 0x00, 0x52, 0x08, 0x6a, 0x6f, 0x62, 0x52, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x40, 0x0a,
+	0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x22, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65,
+	0x77, 0x6f, 0x72, 0x6b, 0x52, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x42,
+	0x0f, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x05, 0xba, 0x48, 0x02, 0x08, 0x01,
+	0x22, 0xc4, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x41, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x22, 0x99, 0x05, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6a,
+	0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62,
+	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a,
+	0x0a, 0x6a, 0x6f, 0x62, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x08, 0x6a, 0x6f, 0x62, 0x52, 0x75, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x3d, 0x0a, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x40, 0x0a, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72,
+	0x6b, 0x12, 0x31, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x42, 0x79, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3f, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63,
+	0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x09, 0x72, 0x6f, 0x77, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x08, 0x72, 0x6f, 0x77,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x13, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x10, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x64, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x03, 0x52,
+	0x0a, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x72, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x16, 0x0a, 0x14, 0x5f,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x22, 0xc6, 0x02, 0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e,
+	0x63, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x4b, 0x0a, 0x0f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x21, 0x2e,
+	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x4f, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72,
+	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e,
+	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65,
+	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x69, 0x73, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x69, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x22, 0xdb, 0x03, 0x0a,
+	0x09, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x61, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x4b, 0x0a, 0x0f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x21,
+	0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x61, 0x67, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x12, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x45, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2d, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03,
+	0xb0, 0x01, 0x01, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x22, 0x45, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x61,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54,
+	0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x81, 0x02, 0x0a, 0x13, 0x53, 0x65, 0x74,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2d, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01,
+	0x01, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x12, 0x1d, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x1f, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x12, 0x5a, 0x0a, 0x0f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6d, 0x67, 0x6d, 0x74,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xba, 0x48,
+	0x0a, 0x92, 0x01, 0x07, 0x22, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0f, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x42, 0x0a, 0x14,
+	0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x61, 0x67, 0x52, 0x03, 0x74, 0x61, 0x67,
+	0x22, 0x32, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x46, 0x0a, 0x15, 0x53, 0x63, 0x61, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x61, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x16, 0x53, 0x63, 0x61, 0x6e, 0x43,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22,
+	0xa0, 0x04, 0x0a, 0x1a, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76,
+	0x61, 0x63, 0x79, 0x52, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27,
+	0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0a, 0x6a, 0x6f, 0x62, 0x5f, 0x72,
+	0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x6a, 0x6f, 0x62, 0x52, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12,
+	0x1d, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x3d,
+	0x0a, 0x11, 0x71, 0x75, 0x61, 0x73, 0x69, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x10, 0xba, 0x48, 0x0d, 0x92, 0x01,
+	0x0a, 0x08, 0x01, 0x18, 0x01, 0x22, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x10, 0x71, 0x75, 0x61,
+	0x73, 0x69, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x37, 0x0a,
+	0x10, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x48, 0x00, 0x52, 0x0f, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x0b, 0x6b, 0x5f, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xba, 0x48, 0x04,
+	0x2a, 0x02, 0x28, 0x02, 0x48, 0x01, 0x52, 0x0a, 0x6b, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x0b, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xba, 0x48, 0x04, 0x2a,
+	0x02, 0x28, 0x02, 0x48, 0x02, 0x52, 0x0a, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x49, 0x0a, 0x19, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01,
+	0x01, 0x48, 0x03, 0x52, 0x17, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42,
+	0x13, 0x0a, 0x11, 0x5f, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6b, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x42, 0x1c, 0x0a, 0x1a, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x22, 0xc9, 0x03, 0x0a, 0x1b, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x72, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x65,
+	0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x65, 0x71,
+	0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69,
+	0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6b, 0x41, 0x6e, 0x6f, 0x6e, 0x79,
+	0x6d, 0x69, 0x74, 0x79, 0x12, 0x24, 0x0a, 0x0b, 0x6c, 0x5f, 0x64, 0x69, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0a, 0x6c, 0x44, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0c, 0x72, 0x6f,
+	0x77, 0x73, 0x5f, 0x61, 0x74, 0x5f, 0x72, 0x69, 0x73, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x72, 0x6f, 0x77, 0x73, 0x41, 0x74, 0x52, 0x69, 0x73, 0x6b, 0x12, 0x49, 0x0a, 0x0d,
+	0x72, 0x69, 0x73, 0x6b, 0x79, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x52, 0x69, 0x73, 0x6b, 0x79, 0x45, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c,
+	0x65, 0x6e, 0x63, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x0c, 0x72, 0x69, 0x73, 0x6b, 0x79,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x5f, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6b, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x24, 0x0a, 0x0b, 0x6c, 0x5f, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52,
+	0x0a, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x6c, 0x5f, 0x64, 0x69, 0x76, 0x65, 0x72, 0x73, 0x69, 0x74, 0x79, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0xac,
+	0x02, 0x0a, 0x15, 0x52, 0x69, 0x73, 0x6b, 0x79, 0x45, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65,
+	0x6e, 0x63, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x3c, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c,
+	0x65, 0x6e, 0x63, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x3f, 0x0a, 0x19, 0x64, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52,
+	0x17, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x14, 0x76,
+	0x69, 0x6f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x5f, 0x6b, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d,
+	0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x76, 0x69, 0x6f, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x4b, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x74, 0x79, 0x12, 0x30, 0x0a,
+	0x14, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x5f, 0x6c, 0x5f, 0x64, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x76, 0x69, 0x6f,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x4c, 0x44, 0x69, 0x76, 0x65, 0x72, 0x73, 0x69, 0x74, 0x79, 0x42,
+	0x1c, 0x0a, 0x1a, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x5f, 0x73, 0x65, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x3c, 0x0a,
+	0x15, 0x45, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01,
+	0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x94, 0x02, 0x0a, 0x18,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x48, 0x69, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x48, 0x69,
+	0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x42, 0x0b, 0xba, 0x48, 0x08, 0x82, 0x01,
+	0x05, 0x10, 0x01, 0x22, 0x01, 0x00, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x21,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x7a, 0x02, 0x10, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x12, 0x33, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x48, 0x00, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x42,
+	0x11, 0x0a, 0x0f, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x22, 0xf9, 0x01, 0x0a, 0x19, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x48, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x39, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x48, 0x69,
+	0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x46, 0x0a, 0x0c, 0x66,
+	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x48, 0x69, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x65,
+	0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b,
+	0x65, 0x79, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10,
+	0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
+	0x12, 0x2c, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0xeb,
+	0x03, 0x0a, 0x10, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x48,
+	0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0e, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12,
+	0x4b, 0x0a, 0x0f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x66, 0x0a, 0x1e,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x1c, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x66, 0x0a, 0x1e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d,
+	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x1c,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd3, 0x01, 0x0a,
+	0x14, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x48, 0x69, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x65, 0x69,
+	0x67, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x6f, 0x72,
+	0x65, 0x69, 0x67, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x6f, 0x72,
+	0x65, 0x69, 0x67, 0x6e, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0e, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x73, 0x2a, 0xb6, 0x01, 0x0a, 0x12, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x1f, 0x44, 0x41, 0x54,
+	0x41, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b,
+	0x0a, 0x17, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x49, 0x46, 0x49, 0x43,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x49, 0x49, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x44,
+	0x41, 0x54, 0x41, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x50, 0x48, 0x49, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x44, 0x41, 0x54, 0x41,
+	0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x46, 0x49, 0x4e, 0x41, 0x4e, 0x43, 0x49, 0x41, 0x4c, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x44,
+	0x41, 0x54, 0x41, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x10, 0x04, 0x2a, 0x7a, 0x0a, 0x13, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f,
+	0x72, 0x6b, 0x12, 0x24, 0x0a, 0x20, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x49, 0x41, 0x4e, 0x43, 0x45,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x4f, 0x4d, 0x50,
+	0x4c, 0x49, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x57, 0x4f, 0x52, 0x4b,
+	0x5f, 0x47, 0x44, 0x50, 0x52, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f, 0x4d, 0x50, 0x4c,
+	0x49, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x57, 0x4f, 0x52, 0x4b, 0x5f,
+	0x48, 0x49, 0x50, 0x41, 0x41, 0x10, 0x02, 0x2a, 0x93, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x54, 0x61, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x43,
+	0x4f, 0x4c, 0x55, 0x4d, 0x4e, 0x5f, 0x54, 0x41, 0x47, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d,
+	0x0a, 0x19, 0x43, 0x4f, 0x4c, 0x55, 0x4d, 0x4e, 0x5f, 0x54, 0x41, 0x47, 0x5f, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x4e, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1a, 0x0a,
+	0x16, 0x43, 0x4f, 0x4c, 0x55, 0x4d, 0x4e, 0x5f, 0x54, 0x41, 0x47, 0x5f, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x43, 0x4f, 0x4c,
+	0x55, 0x4d, 0x4e, 0x5f, 0x54, 0x41, 0x47, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53,
+	0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x48, 0x49, 0x4e, 0x54, 0x53, 0x10, 0x03, 0x2a, 0xab, 0x01,
+	0x0a, 0x11, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x48, 0x69, 0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x48, 0x49,
+	0x4e, 0x54, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x43, 0x48, 0x45,
+	0x4d, 0x41, 0x5f, 0x48, 0x49, 0x4e, 0x54, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
+	0x44, 0x42, 0x54, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x25,
+	0x0a, 0x21, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x48, 0x49, 0x4e, 0x54, 0x53, 0x5f, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x50, 0x52, 0x49, 0x53, 0x4d, 0x41, 0x5f, 0x53, 0x43, 0x48,
+	0x45, 0x4d, 0x41, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f,
+	0x48, 0x49, 0x4e, 0x54, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x52, 0x41, 0x49,
+	0x4c, 0x53, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x10, 0x03, 0x32, 0xea, 0x05, 0x0a, 0x11,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x7d, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2e, 0x2e,
+	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
+	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x61, 0x67,
+	0x73, 0x12, 0x23, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x61, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59,
+	0x0a, 0x0c, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x61, 0x67, 0x12, 0x22,
+	0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53,
+	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x61, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0f, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x61, 0x67, 0x12, 0x25, 0x2e, 0x6d,
+	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a,
+	0x0e, 0x53, 0x63, 0x61, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x61, 0x67, 0x73, 0x12,
+	0x24, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x53, 0x63, 0x61, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e,
+	0x0a, 0x13, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63,
+	0x79, 0x52, 0x69, 0x73, 0x6b, 0x12, 0x29, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2a, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79,
+	0x52, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68,
+	0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x48, 0x69,
+	0x6e, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x48, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d,
+	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x48, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0xcb, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d,
+	0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x0f,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x50, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x75,
+	0x63, 0x6c, 0x65, 0x75, 0x73, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x6e, 0x65, 0x6f, 0x73, 0x79,
+	0x6e, 0x63, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67,
+	0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x6d, 0x67, 0x6d, 0x74, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x58, 0x58, 0xaa, 0x02, 0x0d, 0x4d, 0x67, 0x6d, 0x74,
+	0x2e, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x0d, 0x4d, 0x67, 0x6d, 0x74,
+	0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2, 0x02, 0x19, 0x4d, 0x67, 0x6d, 0x74,
+	0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x4d, 0x67, 0x6d, 0x74, 0x3a, 0x3a, 0x56, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mgmt_v1alpha1_compliance_proto_rawDescOnce sync.Once
+	file_mgmt_v1alpha1_compliance_proto_rawDescData = file_mgmt_v1alpha1_compliance_proto_rawDesc
+)
+
+func file_mgmt_v1alpha1_compliance_proto_rawDescGZIP() []byte {
+	file_mgmt_v1alpha1_compliance_proto_rawDescOnce.Do(func() {
+		file_mgmt_v1alpha1_compliance_proto_rawDescData = protoimpl.X.CompressGZIP(file_mgmt_v1alpha1_compliance_proto_rawDescData)
+	})
+	return file_mgmt_v1alpha1_compliance_proto_rawDescData
+}
+
+var file_mgmt_v1alpha1_compliance_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_mgmt_v1alpha1_compliance_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_mgmt_v1alpha1_compliance_proto_goTypes = []interface{}{
+	(DataClassification)(0),                  // 0: mgmt.v1alpha1.DataClassification
+	(ComplianceFramework)(0),                 // 1: mgmt.v1alpha1.ComplianceFramework
+	(ColumnTagSource)(0),                     // 2: mgmt.v1alpha1.ColumnTagSource
+	(SchemaHintsFormat)(0),                   // 3: mgmt.v1alpha1.SchemaHintsFormat
+	(*GenerateComplianceReportRequest)(nil),  // 4: mgmt.v1alpha1.GenerateComplianceReportRequest
+	(*GenerateComplianceReportResponse)(nil), // 5: mgmt.v1alpha1.GenerateComplianceReportResponse
+	(*ComplianceReport)(nil),                 // 6: mgmt.v1alpha1.ComplianceReport
+	(*ComplianceColumnReport)(nil),           // 7: mgmt.v1alpha1.ComplianceColumnReport
+	(*ColumnTag)(nil),                        // 8: mgmt.v1alpha1.ColumnTag
+	(*GetColumnTagsRequest)(nil),             // 9: mgmt.v1alpha1.GetColumnTagsRequest
+	(*GetColumnTagsResponse)(nil),            // 10: mgmt.v1alpha1.GetColumnTagsResponse
+	(*SetColumnTagRequest)(nil),              // 11: mgmt.v1alpha1.SetColumnTagRequest
+	(*SetColumnTagResponse)(nil),             // 12: mgmt.v1alpha1.SetColumnTagResponse
+	(*DeleteColumnTagRequest)(nil),           // 13: mgmt.v1alpha1.DeleteColumnTagRequest
+	(*DeleteColumnTagResponse)(nil),          // 14: mgmt.v1alpha1.DeleteColumnTagResponse
+	(*ScanColumnTagsRequest)(nil),            // 15: mgmt.v1alpha1.ScanColumnTagsRequest
+	(*ScanColumnTagsResponse)(nil),           // 16: mgmt.v1alpha1.ScanColumnTagsResponse
+	(*EvaluatePrivacyRiskRequest)(nil),       // 17: mgmt.v1alpha1.EvaluatePrivacyRiskRequest
+	(*EvaluatePrivacyRiskResponse)(nil),      // 18: mgmt.v1alpha1.EvaluatePrivacyRiskResponse
+	(*RiskyEquivalenceClass)(nil),            // 19: mgmt.v1alpha1.RiskyEquivalenceClass
+	(*EquivalenceClassValue)(nil),            // 20: mgmt.v1alpha1.EquivalenceClassValue
+	(*ImportSchemaHintsRequest)(nil),         // 21: mgmt.v1alpha1.ImportSchemaHintsRequest
+	(*ImportSchemaHintsResponse)(nil),        // 22: mgmt.v1alpha1.ImportSchemaHintsResponse
+	(*ColumnSchemaHint)(nil),                 // 23: mgmt.v1alpha1.ColumnSchemaHint
+	(*SchemaHintForeignKey)(nil),             // 24: mgmt.v1alpha1.SchemaHintForeignKey
+	(*timestamppb.Timestamp)(nil),            // 25: google.protobuf.Timestamp
+	(TransformerSource)(0),                   // 26: mgmt.v1alpha1.TransformerSource
+	(*TransformerConfig)(nil),                // 27: mgmt.v1alpha1.TransformerConfig
+}
+var file_mgmt_v1alpha1_compliance_proto_depIdxs = []int32{
+	1,  // 0: mgmt.v1alpha1.GenerateComplianceReportRequest.framework:type_name -> mgmt.v1alpha1.ComplianceFramework
+	6,  // 1: mgmt.v1alpha1.GenerateComplianceReportResponse.report:type_name -> mgmt.v1alpha1.ComplianceReport
+	25, // 2: mgmt.v1alpha1.ComplianceReport.generated_at:type_name -> google.protobuf.Timestamp
+	1,  // 3: mgmt.v1alpha1.ComplianceReport.framework:type_name -> mgmt.v1alpha1.ComplianceFramework
+	25, // 4: mgmt.v1alpha1.ComplianceReport.configured_at:type_name -> google.protobuf.Timestamp
+	7,  // 5: mgmt.v1alpha1.ComplianceReport.columns:type_name -> mgmt.v1alpha1.ComplianceColumnReport
+	25, // 6: mgmt.v1alpha1.ComplianceReport.approved_at:type_name -> google.protobuf.Timestamp
+	0,  // 7: mgmt.v1alpha1.ComplianceColumnReport.classifications:type_name -> mgmt.v1alpha1.DataClassification
+	26, // 8: mgmt.v1alpha1.ComplianceColumnReport.transformer_source:type_name -> mgmt.v1alpha1.TransformerSource
+	0,  // 9: mgmt.v1alpha1.ColumnTag.classifications:type_name -> mgmt.v1alpha1.DataClassification
+	2,  // 10: mgmt.v1alpha1.ColumnTag.source:type_name -> mgmt.v1alpha1.ColumnTagSource
+	25, // 11: mgmt.v1alpha1.ColumnTag.created_at:type_name -> google.protobuf.Timestamp
+	25, // 12: mgmt.v1alpha1.ColumnTag.updated_at:type_name -> google.protobuf.Timestamp
+	8,  // 13: mgmt.v1alpha1.GetColumnTagsResponse.tags:type_name -> mgmt.v1alpha1.ColumnTag
+	0,  // 14: mgmt.v1alpha1.SetColumnTagRequest.classifications:type_name -> mgmt.v1alpha1.DataClassification
+	8,  // 15: mgmt.v1alpha1.SetColumnTagResponse.tag:type_name -> mgmt.v1alpha1.ColumnTag
+	8,  // 16: mgmt.v1alpha1.ScanColumnTagsResponse.tags:type_name -> mgmt.v1alpha1.ColumnTag
+	19, // 17: mgmt.v1alpha1.EvaluatePrivacyRiskResponse.risky_classes:type_name -> mgmt.v1alpha1.RiskyEquivalenceClass
+	20, // 18: mgmt.v1alpha1.RiskyEquivalenceClass.values:type_name -> mgmt.v1alpha1.EquivalenceClassValue
+	3,  // 19: mgmt.v1alpha1.ImportSchemaHintsRequest.format:type_name -> mgmt.v1alpha1.SchemaHintsFormat
+	23, // 20: mgmt.v1alpha1.ImportSchemaHintsResponse.columns:type_name -> mgmt.v1alpha1.ColumnSchemaHint
+	24, // 21: mgmt.v1alpha1.ImportSchemaHintsResponse.foreign_keys:type_name -> mgmt.v1alpha1.SchemaHintForeignKey
+	8,  // 22: mgmt.v1alpha1.ImportSchemaHintsResponse.tags:type_name -> mgmt.v1alpha1.ColumnTag
+	0,  // 23: mgmt.v1alpha1.ColumnSchemaHint.classifications:type_name -> mgmt.v1alpha1.DataClassification
+	26, // 24: mgmt.v1alpha1.ColumnSchemaHint.recommended_transformer_source:type_name -> mgmt.v1alpha1.TransformerSource
+	27, // 25: mgmt.v1alpha1.ColumnSchemaHint.recommended_transformer_config:type_name -> mgmt.v1alpha1.TransformerConfig
+	4,  // 26: mgmt.v1alpha1.ComplianceService.GenerateComplianceReport:input_type -> mgmt.v1alpha1.GenerateComplianceReportRequest
+	9,  // 27: mgmt.v1alpha1.ComplianceService.GetColumnTags:input_type -> mgmt.v1alpha1.GetColumnTagsRequest
+	11, // 28: mgmt.v1alpha1.ComplianceService.SetColumnTag:input_type -> mgmt.v1alpha1.SetColumnTagRequest
+	13, // 29: mgmt.v1alpha1.ComplianceService.DeleteColumnTag:input_type -> mgmt.v1alpha1.DeleteColumnTagRequest
+	15, // 30: mgmt.v1alpha1.ComplianceService.ScanColumnTags:input_type -> mgmt.v1alpha1.ScanColumnTagsRequest
+	17, // 31: mgmt.v1alpha1.ComplianceService.EvaluatePrivacyRisk:input_type -> mgmt.v1alpha1.EvaluatePrivacyRiskRequest
+	21, // 32: mgmt.v1alpha1.ComplianceService.ImportSchemaHints:input_type -> mgmt.v1alpha1.ImportSchemaHintsRequest
+	5,  // 33: mgmt.v1alpha1.ComplianceService.GenerateComplianceReport:output_type -> mgmt.v1alpha1.GenerateComplianceReportResponse
+	10, // 34: mgmt.v1alpha1.ComplianceService.GetColumnTags:output_type -> mgmt.v1alpha1.GetColumnTagsResponse
+	12, // 35: mgmt.v1alpha1.ComplianceService.SetColumnTag:output_type -> mgmt.v1alpha1.SetColumnTagResponse
+	14, // 36: mgmt.v1alpha1.ComplianceService.DeleteColumnTag:output_type -> mgmt.v1alpha1.DeleteColumnTagResponse
+	16, // 37: mgmt.v1alpha1.ComplianceService.ScanColumnTags:output_type -> mgmt.v1alpha1.ScanColumnTagsResponse
+	18, // 38: mgmt.v1alpha1.ComplianceService.EvaluatePrivacyRisk:output_type -> mgmt.v1alpha1.EvaluatePrivacyRiskResponse
+	22, // 39: mgmt.v1alpha1.ComplianceService.ImportSchemaHints:output_type -> mgmt.v1alpha1.ImportSchemaHintsResponse
+	33, // [33:40] is the sub-list for method output_type
+	26, // [26:33] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
+}
+
+func init() { file_mgmt_v1alpha1_compliance_proto_init() }
+func file_mgmt_v1alpha1_compliance_proto_init() {
+	if File_mgmt_v1alpha1_compliance_proto != nil {
+		return
+	}
+	file_mgmt_v1alpha1_transformer_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_mgmt_v1alpha1_compliance_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateComplianceReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_compliance_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateComplianceReportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_compliance_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComplianceReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_compliance_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComplianceColumnReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_compliance_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColumnTag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_compliance_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetColumnTagsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_compliance_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetColumnTagsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_compliance_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetColumnTagRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_compliance_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetColumnTagResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_compliance_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteColumnTagRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_compliance_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteColumnTagResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_compliance_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanColumnTagsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_compliance_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanColumnTagsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_compliance_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluatePrivacyRiskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_compliance_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluatePrivacyRiskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_compliance_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RiskyEquivalenceClass); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_compliance_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EquivalenceClassValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_compliance_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportSchemaHintsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_compliance_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportSchemaHintsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_compliance_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColumnSchemaHint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_compliance_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchemaHintForeignKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_mgmt_v1alpha1_compliance_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*GenerateComplianceReportRequest_JobId)(nil),
+		(*GenerateComplianceReportRequest_JobRunId)(nil),
+	}
+	file_mgmt_v1alpha1_compliance_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_mgmt_v1alpha1_compliance_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_mgmt_v1alpha1_compliance_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	file_mgmt_v1alpha1_compliance_proto_msgTypes[15].OneofWrappers = []interface{}{}
+	file_mgmt_v1alpha1_compliance_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_mgmt_v1alpha1_compliance_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_mgmt_v1alpha1_compliance_proto_msgTypes[19].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mgmt_v1alpha1_compliance_proto_rawDesc,
+			NumEnums:      4,
+			NumMessages:   21,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_mgmt_v1alpha1_compliance_proto_goTypes,
+		DependencyIndexes: file_mgmt_v1alpha1_compliance_proto_depIdxs,
+		EnumInfos:         file_mgmt_v1alpha1_compliance_proto_enumTypes,
+		MessageInfos:      file_mgmt_v1alpha1_compliance_proto_msgTypes,
+	}.Build()
+	File_mgmt_v1alpha1_compliance_proto = out.File
+	file_mgmt_v1alpha1_compliance_proto_rawDesc = nil
+	file_mgmt_v1alpha1_compliance_proto_goTypes = nil
+	file_mgmt_v1alpha1_compliance_proto_depIdxs = nil
+}
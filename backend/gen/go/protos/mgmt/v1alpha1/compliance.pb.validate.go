@@ -0,0 +1,2877 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: mgmt/v1alpha1/compliance.proto
+
+package mgmtv1alpha1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GenerateComplianceReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateComplianceReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateComplianceReportRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GenerateComplianceReportRequestMultiError, or nil if none found.
+func (m *GenerateComplianceReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateComplianceReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	// no validation rules for Framework
+
+	switch v := m.Target.(type) {
+	case *GenerateComplianceReportRequest_JobId:
+		if v == nil {
+			err := GenerateComplianceReportRequestValidationError{
+				field:  "Target",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for JobId
+	case *GenerateComplianceReportRequest_JobRunId:
+		if v == nil {
+			err := GenerateComplianceReportRequestValidationError{
+				field:  "Target",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for JobRunId
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return GenerateComplianceReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateComplianceReportRequestMultiError is an error wrapping multiple
+// validation errors returned by GenerateComplianceReportRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GenerateComplianceReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateComplianceReportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateComplianceReportRequestMultiError) AllErrors() []error { return m }
+
+// GenerateComplianceReportRequestValidationError is the validation error
+// returned by GenerateComplianceReportRequest.Validate if the designated
+// constraints aren't met.
+type GenerateComplianceReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateComplianceReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateComplianceReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateComplianceReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateComplianceReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateComplianceReportRequestValidationError) ErrorName() string {
+	return "GenerateComplianceReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateComplianceReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateComplianceReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateComplianceReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateComplianceReportRequestValidationError{}
+
+// Validate checks the field values on GenerateComplianceReportResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GenerateComplianceReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateComplianceReportResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GenerateComplianceReportResponseMultiError, or nil if none found.
+func (m *GenerateComplianceReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateComplianceReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GenerateComplianceReportResponseValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GenerateComplianceReportResponseValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GenerateComplianceReportResponseValidationError{
+				field:  "Report",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Content
+
+	// no validation rules for Signature
+
+	// no validation rules for SignatureAlgorithm
+
+	if len(errors) > 0 {
+		return GenerateComplianceReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateComplianceReportResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GenerateComplianceReportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateComplianceReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateComplianceReportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateComplianceReportResponseMultiError) AllErrors() []error { return m }
+
+// GenerateComplianceReportResponseValidationError is the validation error
+// returned by GenerateComplianceReportResponse.Validate if the designated
+// constraints aren't met.
+type GenerateComplianceReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateComplianceReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateComplianceReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateComplianceReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateComplianceReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateComplianceReportResponseValidationError) ErrorName() string {
+	return "GenerateComplianceReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateComplianceReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateComplianceReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateComplianceReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateComplianceReportResponseValidationError{}
+
+// Validate checks the field values on ComplianceReport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ComplianceReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ComplianceReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ComplianceReportMultiError, or nil if none found.
+func (m *ComplianceReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ComplianceReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	// no validation rules for JobId
+
+	// no validation rules for JobName
+
+	if all {
+		switch v := interface{}(m.GetGeneratedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ComplianceReportValidationError{
+					field:  "GeneratedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ComplianceReportValidationError{
+					field:  "GeneratedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGeneratedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ComplianceReportValidationError{
+				field:  "GeneratedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Framework
+
+	// no validation rules for ConfiguredByUserId
+
+	if all {
+		switch v := interface{}(m.GetConfiguredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ComplianceReportValidationError{
+					field:  "ConfiguredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ComplianceReportValidationError{
+					field:  "ConfiguredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfiguredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ComplianceReportValidationError{
+				field:  "ConfiguredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetColumns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ComplianceReportValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ComplianceReportValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ComplianceReportValidationError{
+					field:  fmt.Sprintf("Columns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.JobRunId != nil {
+		// no validation rules for JobRunId
+	}
+
+	if m.RowCount != nil {
+		// no validation rules for RowCount
+	}
+
+	if m.ApprovedByUserId != nil {
+		// no validation rules for ApprovedByUserId
+	}
+
+	if m.ApprovedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetApprovedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ComplianceReportValidationError{
+						field:  "ApprovedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ComplianceReportValidationError{
+						field:  "ApprovedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetApprovedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ComplianceReportValidationError{
+					field:  "ApprovedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ComplianceReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// ComplianceReportMultiError is an error wrapping multiple validation errors
+// returned by ComplianceReport.ValidateAll() if the designated constraints
+// aren't met.
+type ComplianceReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ComplianceReportMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ComplianceReportMultiError) AllErrors() []error { return m }
+
+// ComplianceReportValidationError is the validation error returned by
+// ComplianceReport.Validate if the designated constraints aren't met.
+type ComplianceReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ComplianceReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ComplianceReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ComplianceReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ComplianceReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ComplianceReportValidationError) ErrorName() string { return "ComplianceReportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ComplianceReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sComplianceReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ComplianceReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ComplianceReportValidationError{}
+
+// Validate checks the field values on ComplianceColumnReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ComplianceColumnReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ComplianceColumnReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ComplianceColumnReportMultiError, or nil if none found.
+func (m *ComplianceColumnReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ComplianceColumnReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for Column
+
+	// no validation rules for TransformerSource
+
+	// no validation rules for TransformerName
+
+	// no validation rules for IsExposed
+
+	if len(errors) > 0 {
+		return ComplianceColumnReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// ComplianceColumnReportMultiError is an error wrapping multiple validation
+// errors returned by ComplianceColumnReport.ValidateAll() if the designated
+// constraints aren't met.
+type ComplianceColumnReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ComplianceColumnReportMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ComplianceColumnReportMultiError) AllErrors() []error { return m }
+
+// ComplianceColumnReportValidationError is the validation error returned by
+// ComplianceColumnReport.Validate if the designated constraints aren't met.
+type ComplianceColumnReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ComplianceColumnReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ComplianceColumnReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ComplianceColumnReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ComplianceColumnReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ComplianceColumnReportValidationError) ErrorName() string {
+	return "ComplianceColumnReportValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ComplianceColumnReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sComplianceColumnReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ComplianceColumnReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ComplianceColumnReportValidationError{}
+
+// Validate checks the field values on ColumnTag with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ColumnTag) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnTag with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ColumnTagMultiError, or nil
+// if none found.
+func (m *ColumnTag) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnTag) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ConnectionId
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for Column
+
+	// no validation rules for Source
+
+	// no validation rules for CreatedByUserId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnTagValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ColumnTagValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnTagValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UpdatedByUserId
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnTagValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ColumnTagValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnTagValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ColumnTagMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnTagMultiError is an error wrapping multiple validation errors returned
+// by ColumnTag.ValidateAll() if the designated constraints aren't met.
+type ColumnTagMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnTagMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnTagMultiError) AllErrors() []error { return m }
+
+// ColumnTagValidationError is the validation error returned by
+// ColumnTag.Validate if the designated constraints aren't met.
+type ColumnTagValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnTagValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnTagValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnTagValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnTagValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnTagValidationError) ErrorName() string { return "ColumnTagValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ColumnTagValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnTag.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnTagValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnTagValidationError{}
+
+// Validate checks the field values on GetColumnTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetColumnTagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetColumnTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetColumnTagsRequestMultiError, or nil if none found.
+func (m *GetColumnTagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetColumnTagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConnectionId
+
+	if len(errors) > 0 {
+		return GetColumnTagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetColumnTagsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetColumnTagsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetColumnTagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetColumnTagsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetColumnTagsRequestMultiError) AllErrors() []error { return m }
+
+// GetColumnTagsRequestValidationError is the validation error returned by
+// GetColumnTagsRequest.Validate if the designated constraints aren't met.
+type GetColumnTagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetColumnTagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetColumnTagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetColumnTagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetColumnTagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetColumnTagsRequestValidationError) ErrorName() string {
+	return "GetColumnTagsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetColumnTagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetColumnTagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetColumnTagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetColumnTagsRequestValidationError{}
+
+// Validate checks the field values on GetColumnTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetColumnTagsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetColumnTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetColumnTagsResponseMultiError, or nil if none found.
+func (m *GetColumnTagsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetColumnTagsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetColumnTagsResponseValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetColumnTagsResponseValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetColumnTagsResponseValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetColumnTagsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetColumnTagsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetColumnTagsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetColumnTagsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetColumnTagsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetColumnTagsResponseMultiError) AllErrors() []error { return m }
+
+// GetColumnTagsResponseValidationError is the validation error returned by
+// GetColumnTagsResponse.Validate if the designated constraints aren't met.
+type GetColumnTagsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetColumnTagsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetColumnTagsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetColumnTagsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetColumnTagsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetColumnTagsResponseValidationError) ErrorName() string {
+	return "GetColumnTagsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetColumnTagsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetColumnTagsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetColumnTagsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetColumnTagsResponseValidationError{}
+
+// Validate checks the field values on SetColumnTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetColumnTagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetColumnTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetColumnTagRequestMultiError, or nil if none found.
+func (m *SetColumnTagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetColumnTagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConnectionId
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for Column
+
+	if len(errors) > 0 {
+		return SetColumnTagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetColumnTagRequestMultiError is an error wrapping multiple validation
+// errors returned by SetColumnTagRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetColumnTagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetColumnTagRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetColumnTagRequestMultiError) AllErrors() []error { return m }
+
+// SetColumnTagRequestValidationError is the validation error returned by
+// SetColumnTagRequest.Validate if the designated constraints aren't met.
+type SetColumnTagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetColumnTagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetColumnTagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetColumnTagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetColumnTagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetColumnTagRequestValidationError) ErrorName() string {
+	return "SetColumnTagRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetColumnTagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetColumnTagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetColumnTagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetColumnTagRequestValidationError{}
+
+// Validate checks the field values on SetColumnTagResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetColumnTagResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetColumnTagResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetColumnTagResponseMultiError, or nil if none found.
+func (m *SetColumnTagResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetColumnTagResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTag()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetColumnTagResponseValidationError{
+					field:  "Tag",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetColumnTagResponseValidationError{
+					field:  "Tag",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTag()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetColumnTagResponseValidationError{
+				field:  "Tag",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetColumnTagResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetColumnTagResponseMultiError is an error wrapping multiple validation
+// errors returned by SetColumnTagResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetColumnTagResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetColumnTagResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetColumnTagResponseMultiError) AllErrors() []error { return m }
+
+// SetColumnTagResponseValidationError is the validation error returned by
+// SetColumnTagResponse.Validate if the designated constraints aren't met.
+type SetColumnTagResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetColumnTagResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetColumnTagResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetColumnTagResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetColumnTagResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetColumnTagResponseValidationError) ErrorName() string {
+	return "SetColumnTagResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetColumnTagResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetColumnTagResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetColumnTagResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetColumnTagResponseValidationError{}
+
+// Validate checks the field values on DeleteColumnTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteColumnTagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteColumnTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteColumnTagRequestMultiError, or nil if none found.
+func (m *DeleteColumnTagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteColumnTagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteColumnTagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteColumnTagRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteColumnTagRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteColumnTagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteColumnTagRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteColumnTagRequestMultiError) AllErrors() []error { return m }
+
+// DeleteColumnTagRequestValidationError is the validation error returned by
+// DeleteColumnTagRequest.Validate if the designated constraints aren't met.
+type DeleteColumnTagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteColumnTagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteColumnTagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteColumnTagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteColumnTagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteColumnTagRequestValidationError) ErrorName() string {
+	return "DeleteColumnTagRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteColumnTagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteColumnTagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteColumnTagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteColumnTagRequestValidationError{}
+
+// Validate checks the field values on DeleteColumnTagResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteColumnTagResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteColumnTagResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteColumnTagResponseMultiError, or nil if none found.
+func (m *DeleteColumnTagResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteColumnTagResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteColumnTagResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteColumnTagResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteColumnTagResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteColumnTagResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteColumnTagResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteColumnTagResponseMultiError) AllErrors() []error { return m }
+
+// DeleteColumnTagResponseValidationError is the validation error returned by
+// DeleteColumnTagResponse.Validate if the designated constraints aren't met.
+type DeleteColumnTagResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteColumnTagResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteColumnTagResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteColumnTagResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteColumnTagResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteColumnTagResponseValidationError) ErrorName() string {
+	return "DeleteColumnTagResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteColumnTagResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteColumnTagResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteColumnTagResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteColumnTagResponseValidationError{}
+
+// Validate checks the field values on ScanColumnTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ScanColumnTagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScanColumnTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScanColumnTagsRequestMultiError, or nil if none found.
+func (m *ScanColumnTagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScanColumnTagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConnectionId
+
+	if len(errors) > 0 {
+		return ScanColumnTagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScanColumnTagsRequestMultiError is an error wrapping multiple validation
+// errors returned by ScanColumnTagsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ScanColumnTagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScanColumnTagsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScanColumnTagsRequestMultiError) AllErrors() []error { return m }
+
+// ScanColumnTagsRequestValidationError is the validation error returned by
+// ScanColumnTagsRequest.Validate if the designated constraints aren't met.
+type ScanColumnTagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScanColumnTagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScanColumnTagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScanColumnTagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScanColumnTagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScanColumnTagsRequestValidationError) ErrorName() string {
+	return "ScanColumnTagsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScanColumnTagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScanColumnTagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScanColumnTagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScanColumnTagsRequestValidationError{}
+
+// Validate checks the field values on ScanColumnTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ScanColumnTagsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScanColumnTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScanColumnTagsResponseMultiError, or nil if none found.
+func (m *ScanColumnTagsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScanColumnTagsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ScanColumnTagsResponseValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ScanColumnTagsResponseValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ScanColumnTagsResponseValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ScanColumnTagsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScanColumnTagsResponseMultiError is an error wrapping multiple validation
+// errors returned by ScanColumnTagsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ScanColumnTagsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScanColumnTagsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScanColumnTagsResponseMultiError) AllErrors() []error { return m }
+
+// ScanColumnTagsResponseValidationError is the validation error returned by
+// ScanColumnTagsResponse.Validate if the designated constraints aren't met.
+type ScanColumnTagsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScanColumnTagsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScanColumnTagsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScanColumnTagsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScanColumnTagsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScanColumnTagsResponseValidationError) ErrorName() string {
+	return "ScanColumnTagsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScanColumnTagsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScanColumnTagsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScanColumnTagsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScanColumnTagsResponseValidationError{}
+
+// Validate checks the field values on EvaluatePrivacyRiskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EvaluatePrivacyRiskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EvaluatePrivacyRiskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EvaluatePrivacyRiskRequestMultiError, or nil if none found.
+func (m *EvaluatePrivacyRiskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EvaluatePrivacyRiskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	// no validation rules for JobRunId
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	if m.SensitiveColumn != nil {
+		// no validation rules for SensitiveColumn
+	}
+
+	if m.KThreshold != nil {
+		// no validation rules for KThreshold
+	}
+
+	if m.LThreshold != nil {
+		// no validation rules for LThreshold
+	}
+
+	if m.DestinationConnectionId != nil {
+		// no validation rules for DestinationConnectionId
+	}
+
+	if len(errors) > 0 {
+		return EvaluatePrivacyRiskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EvaluatePrivacyRiskRequestMultiError is an error wrapping multiple
+// validation errors returned by EvaluatePrivacyRiskRequest.ValidateAll() if
+// the designated constraints aren't met.
+type EvaluatePrivacyRiskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EvaluatePrivacyRiskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EvaluatePrivacyRiskRequestMultiError) AllErrors() []error { return m }
+
+// EvaluatePrivacyRiskRequestValidationError is the validation error returned
+// by EvaluatePrivacyRiskRequest.Validate if the designated constraints aren't met.
+type EvaluatePrivacyRiskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EvaluatePrivacyRiskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EvaluatePrivacyRiskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EvaluatePrivacyRiskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EvaluatePrivacyRiskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EvaluatePrivacyRiskRequestValidationError) ErrorName() string {
+	return "EvaluatePrivacyRiskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EvaluatePrivacyRiskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvaluatePrivacyRiskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EvaluatePrivacyRiskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EvaluatePrivacyRiskRequestValidationError{}
+
+// Validate checks the field values on EvaluatePrivacyRiskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EvaluatePrivacyRiskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EvaluatePrivacyRiskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EvaluatePrivacyRiskResponseMultiError, or nil if none found.
+func (m *EvaluatePrivacyRiskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EvaluatePrivacyRiskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DestinationConnectionId
+
+	// no validation rules for RowCount
+
+	// no validation rules for EquivalenceClassCount
+
+	// no validation rules for KAnonymity
+
+	// no validation rules for RowsAtRisk
+
+	for idx, item := range m.GetRiskyClasses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EvaluatePrivacyRiskResponseValidationError{
+						field:  fmt.Sprintf("RiskyClasses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EvaluatePrivacyRiskResponseValidationError{
+						field:  fmt.Sprintf("RiskyClasses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EvaluatePrivacyRiskResponseValidationError{
+					field:  fmt.Sprintf("RiskyClasses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for KThreshold
+
+	if m.LDiversity != nil {
+		// no validation rules for LDiversity
+	}
+
+	if m.LThreshold != nil {
+		// no validation rules for LThreshold
+	}
+
+	if len(errors) > 0 {
+		return EvaluatePrivacyRiskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EvaluatePrivacyRiskResponseMultiError is an error wrapping multiple
+// validation errors returned by EvaluatePrivacyRiskResponse.ValidateAll() if
+// the designated constraints aren't met.
+type EvaluatePrivacyRiskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EvaluatePrivacyRiskResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EvaluatePrivacyRiskResponseMultiError) AllErrors() []error { return m }
+
+// EvaluatePrivacyRiskResponseValidationError is the validation error returned
+// by EvaluatePrivacyRiskResponse.Validate if the designated constraints
+// aren't met.
+type EvaluatePrivacyRiskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EvaluatePrivacyRiskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EvaluatePrivacyRiskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EvaluatePrivacyRiskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EvaluatePrivacyRiskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EvaluatePrivacyRiskResponseValidationError) ErrorName() string {
+	return "EvaluatePrivacyRiskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EvaluatePrivacyRiskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvaluatePrivacyRiskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EvaluatePrivacyRiskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EvaluatePrivacyRiskResponseValidationError{}
+
+// Validate checks the field values on RiskyEquivalenceClass with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RiskyEquivalenceClass) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RiskyEquivalenceClass with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RiskyEquivalenceClassMultiError, or nil if none found.
+func (m *RiskyEquivalenceClass) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RiskyEquivalenceClass) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetValues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RiskyEquivalenceClassValidationError{
+						field:  fmt.Sprintf("Values[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RiskyEquivalenceClassValidationError{
+						field:  fmt.Sprintf("Values[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RiskyEquivalenceClassValidationError{
+					field:  fmt.Sprintf("Values[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Size
+
+	// no validation rules for ViolatesKAnonymity
+
+	// no validation rules for ViolatesLDiversity
+
+	if m.DistinctSensitiveValues != nil {
+		// no validation rules for DistinctSensitiveValues
+	}
+
+	if len(errors) > 0 {
+		return RiskyEquivalenceClassMultiError(errors)
+	}
+
+	return nil
+}
+
+// RiskyEquivalenceClassMultiError is an error wrapping multiple validation
+// errors returned by RiskyEquivalenceClass.ValidateAll() if the designated
+// constraints aren't met.
+type RiskyEquivalenceClassMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RiskyEquivalenceClassMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RiskyEquivalenceClassMultiError) AllErrors() []error { return m }
+
+// RiskyEquivalenceClassValidationError is the validation error returned by
+// RiskyEquivalenceClass.Validate if the designated constraints aren't met.
+type RiskyEquivalenceClassValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RiskyEquivalenceClassValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RiskyEquivalenceClassValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RiskyEquivalenceClassValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RiskyEquivalenceClassValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RiskyEquivalenceClassValidationError) ErrorName() string {
+	return "RiskyEquivalenceClassValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RiskyEquivalenceClassValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRiskyEquivalenceClass.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RiskyEquivalenceClassValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RiskyEquivalenceClassValidationError{}
+
+// Validate checks the field values on EquivalenceClassValue with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EquivalenceClassValue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EquivalenceClassValue with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EquivalenceClassValueMultiError, or nil if none found.
+func (m *EquivalenceClassValue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EquivalenceClassValue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Value != nil {
+		// no validation rules for Value
+	}
+
+	if len(errors) > 0 {
+		return EquivalenceClassValueMultiError(errors)
+	}
+
+	return nil
+}
+
+// EquivalenceClassValueMultiError is an error wrapping multiple validation
+// errors returned by EquivalenceClassValue.ValidateAll() if the designated
+// constraints aren't met.
+type EquivalenceClassValueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EquivalenceClassValueMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EquivalenceClassValueMultiError) AllErrors() []error { return m }
+
+// EquivalenceClassValueValidationError is the validation error returned by
+// EquivalenceClassValue.Validate if the designated constraints aren't met.
+type EquivalenceClassValueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EquivalenceClassValueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EquivalenceClassValueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EquivalenceClassValueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EquivalenceClassValueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EquivalenceClassValueValidationError) ErrorName() string {
+	return "EquivalenceClassValueValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EquivalenceClassValueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEquivalenceClassValue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EquivalenceClassValueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EquivalenceClassValueValidationError{}
+
+// Validate checks the field values on ImportSchemaHintsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportSchemaHintsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportSchemaHintsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportSchemaHintsRequestMultiError, or nil if none found.
+func (m *ImportSchemaHintsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportSchemaHintsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConnectionId
+
+	// no validation rules for Format
+
+	// no validation rules for Content
+
+	// no validation rules for DryRun
+
+	if m.DefaultSchema != nil {
+		// no validation rules for DefaultSchema
+	}
+
+	if len(errors) > 0 {
+		return ImportSchemaHintsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportSchemaHintsRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportSchemaHintsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ImportSchemaHintsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportSchemaHintsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportSchemaHintsRequestMultiError) AllErrors() []error { return m }
+
+// ImportSchemaHintsRequestValidationError is the validation error returned by
+// ImportSchemaHintsRequest.Validate if the designated constraints aren't met.
+type ImportSchemaHintsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportSchemaHintsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportSchemaHintsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportSchemaHintsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportSchemaHintsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportSchemaHintsRequestValidationError) ErrorName() string {
+	return "ImportSchemaHintsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportSchemaHintsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportSchemaHintsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportSchemaHintsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportSchemaHintsRequestValidationError{}
+
+// Validate checks the field values on ImportSchemaHintsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportSchemaHintsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportSchemaHintsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportSchemaHintsResponseMultiError, or nil if none found.
+func (m *ImportSchemaHintsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportSchemaHintsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetColumns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportSchemaHintsResponseValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportSchemaHintsResponseValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportSchemaHintsResponseValidationError{
+					field:  fmt.Sprintf("Columns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetForeignKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportSchemaHintsResponseValidationError{
+						field:  fmt.Sprintf("ForeignKeys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportSchemaHintsResponseValidationError{
+						field:  fmt.Sprintf("ForeignKeys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportSchemaHintsResponseValidationError{
+					field:  fmt.Sprintf("ForeignKeys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportSchemaHintsResponseValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportSchemaHintsResponseValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportSchemaHintsResponseValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ImportSchemaHintsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportSchemaHintsResponseMultiError is an error wrapping multiple validation
+// errors returned by ImportSchemaHintsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ImportSchemaHintsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportSchemaHintsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportSchemaHintsResponseMultiError) AllErrors() []error { return m }
+
+// ImportSchemaHintsResponseValidationError is the validation error returned by
+// ImportSchemaHintsResponse.Validate if the designated constraints aren't met.
+type ImportSchemaHintsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportSchemaHintsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportSchemaHintsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportSchemaHintsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportSchemaHintsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportSchemaHintsResponseValidationError) ErrorName() string {
+	return "ImportSchemaHintsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportSchemaHintsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportSchemaHintsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportSchemaHintsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportSchemaHintsResponseValidationError{}
+
+// Validate checks the field values on ColumnSchemaHint with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ColumnSchemaHint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnSchemaHint with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ColumnSchemaHintMultiError, or nil if none found.
+func (m *ColumnSchemaHint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnSchemaHint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for Column
+
+	// no validation rules for RecommendedTransformerSource
+
+	if all {
+		switch v := interface{}(m.GetRecommendedTransformerConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnSchemaHintValidationError{
+					field:  "RecommendedTransformerConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ColumnSchemaHintValidationError{
+					field:  "RecommendedTransformerConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecommendedTransformerConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnSchemaHintValidationError{
+				field:  "RecommendedTransformerConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Description != nil {
+		// no validation rules for Description
+	}
+
+	if len(errors) > 0 {
+		return ColumnSchemaHintMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnSchemaHintMultiError is an error wrapping multiple validation errors
+// returned by ColumnSchemaHint.ValidateAll() if the designated constraints
+// aren't met.
+type ColumnSchemaHintMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnSchemaHintMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnSchemaHintMultiError) AllErrors() []error { return m }
+
+// ColumnSchemaHintValidationError is the validation error returned by
+// ColumnSchemaHint.Validate if the designated constraints aren't met.
+type ColumnSchemaHintValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnSchemaHintValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnSchemaHintValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnSchemaHintValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnSchemaHintValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnSchemaHintValidationError) ErrorName() string { return "ColumnSchemaHintValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ColumnSchemaHintValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnSchemaHint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnSchemaHintValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnSchemaHintValidationError{}
+
+// Validate checks the field values on SchemaHintForeignKey with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaHintForeignKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaHintForeignKey with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaHintForeignKeyMultiError, or nil if none found.
+func (m *SchemaHintForeignKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaHintForeignKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for ForeignSchema
+
+	// no validation rules for ForeignTable
+
+	if len(errors) > 0 {
+		return SchemaHintForeignKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaHintForeignKeyMultiError is an error wrapping multiple validation
+// errors returned by SchemaHintForeignKey.ValidateAll() if the designated
+// constraints aren't met.
+type SchemaHintForeignKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaHintForeignKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaHintForeignKeyMultiError) AllErrors() []error { return m }
+
+// SchemaHintForeignKeyValidationError is the validation error returned by
+// SchemaHintForeignKey.Validate if the designated constraints aren't met.
+type SchemaHintForeignKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaHintForeignKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaHintForeignKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaHintForeignKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaHintForeignKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaHintForeignKeyValidationError) ErrorName() string {
+	return "SchemaHintForeignKeyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaHintForeignKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaHintForeignKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaHintForeignKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaHintForeignKeyValidationError{}
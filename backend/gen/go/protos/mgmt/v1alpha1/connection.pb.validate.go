@@ -59,6 +59,8 @@ func (m *GetConnectionsRequest) validate(all bool) error {
 
 	// no validation rules for AccountId
 
+	// no validation rules for LabelSelector
+
 	if len(errors) > 0 {
 		return GetConnectionsRequestMultiError(errors)
 	}
@@ -565,6 +567,8 @@ func (m *CreateConnectionRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Labels
+
 	if len(errors) > 0 {
 		return CreateConnectionRequestMultiError(errors)
 	}
@@ -776,75 +780,78 @@ var _ interface {
 	ErrorName() string
 } = CreateConnectionResponseValidationError{}
 
-// Validate checks the field values on UpdateConnectionRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on BulkCreateConnectionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateConnectionRequest) Validate() error {
+func (m *BulkCreateConnectionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateConnectionRequest with the
+// ValidateAll checks the field values on BulkCreateConnectionsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateConnectionRequestMultiError, or nil if none found.
-func (m *UpdateConnectionRequest) ValidateAll() error {
+// BulkCreateConnectionsRequestMultiError, or nil if none found.
+func (m *BulkCreateConnectionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateConnectionRequest) validate(all bool) error {
+func (m *BulkCreateConnectionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for AccountId
 
-	// no validation rules for Name
+	for idx, item := range m.GetConnections() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetConnectionConfig()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateConnectionRequestValidationError{
-					field:  "ConnectionConfig",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BulkCreateConnectionsRequestValidationError{
+						field:  fmt.Sprintf("Connections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BulkCreateConnectionsRequestValidationError{
+						field:  fmt.Sprintf("Connections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateConnectionRequestValidationError{
-					field:  "ConnectionConfig",
+				return BulkCreateConnectionsRequestValidationError{
+					field:  fmt.Sprintf("Connections[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetConnectionConfig()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateConnectionRequestValidationError{
-				field:  "ConnectionConfig",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return UpdateConnectionRequestMultiError(errors)
+		return BulkCreateConnectionsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateConnectionRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateConnectionRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateConnectionRequestMultiError []error
+// BulkCreateConnectionsRequestMultiError is an error wrapping multiple
+// validation errors returned by BulkCreateConnectionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type BulkCreateConnectionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateConnectionRequestMultiError) Error() string {
+func (m BulkCreateConnectionsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -853,11 +860,12 @@ func (m UpdateConnectionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateConnectionRequestMultiError) AllErrors() []error { return m }
+func (m BulkCreateConnectionsRequestMultiError) AllErrors() []error { return m }
 
-// UpdateConnectionRequestValidationError is the validation error returned by
-// UpdateConnectionRequest.Validate if the designated constraints aren't met.
-type UpdateConnectionRequestValidationError struct {
+// BulkCreateConnectionsRequestValidationError is the validation error returned
+// by BulkCreateConnectionsRequest.Validate if the designated constraints
+// aren't met.
+type BulkCreateConnectionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -865,24 +873,24 @@ type UpdateConnectionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateConnectionRequestValidationError) Field() string { return e.field }
+func (e BulkCreateConnectionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateConnectionRequestValidationError) Reason() string { return e.reason }
+func (e BulkCreateConnectionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateConnectionRequestValidationError) Cause() error { return e.cause }
+func (e BulkCreateConnectionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateConnectionRequestValidationError) Key() bool { return e.key }
+func (e BulkCreateConnectionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateConnectionRequestValidationError) ErrorName() string {
-	return "UpdateConnectionRequestValidationError"
+func (e BulkCreateConnectionsRequestValidationError) ErrorName() string {
+	return "BulkCreateConnectionsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateConnectionRequestValidationError) Error() string {
+func (e BulkCreateConnectionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -894,14 +902,14 @@ func (e UpdateConnectionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateConnectionRequest.%s: %s%s",
+		"invalid %sBulkCreateConnectionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateConnectionRequestValidationError{}
+var _ error = BulkCreateConnectionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -909,53 +917,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateConnectionRequestValidationError{}
+} = BulkCreateConnectionsRequestValidationError{}
 
-// Validate checks the field values on UpdateConnectionResponse with the rules
+// Validate checks the field values on BulkCreateConnectionItem with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateConnectionResponse) Validate() error {
+func (m *BulkCreateConnectionItem) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateConnectionResponse with the
+// ValidateAll checks the field values on BulkCreateConnectionItem with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateConnectionResponseMultiError, or nil if none found.
-func (m *UpdateConnectionResponse) ValidateAll() error {
+// BulkCreateConnectionItemMultiError, or nil if none found.
+func (m *BulkCreateConnectionItem) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateConnectionResponse) validate(all bool) error {
+func (m *BulkCreateConnectionItem) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Name
+
 	if all {
-		switch v := interface{}(m.GetConnection()).(type) {
+		switch v := interface{}(m.GetConnectionConfig()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateConnectionResponseValidationError{
-					field:  "Connection",
+				errors = append(errors, BulkCreateConnectionItemValidationError{
+					field:  "ConnectionConfig",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateConnectionResponseValidationError{
-					field:  "Connection",
+				errors = append(errors, BulkCreateConnectionItemValidationError{
+					field:  "ConnectionConfig",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetConnection()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetConnectionConfig()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateConnectionResponseValidationError{
-				field:  "Connection",
+			return BulkCreateConnectionItemValidationError{
+				field:  "ConnectionConfig",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -963,19 +973,19 @@ func (m *UpdateConnectionResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateConnectionResponseMultiError(errors)
+		return BulkCreateConnectionItemMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateConnectionResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateConnectionResponse.ValidateAll() if the designated
+// BulkCreateConnectionItemMultiError is an error wrapping multiple validation
+// errors returned by BulkCreateConnectionItem.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateConnectionResponseMultiError []error
+type BulkCreateConnectionItemMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateConnectionResponseMultiError) Error() string {
+func (m BulkCreateConnectionItemMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -984,11 +994,11 @@ func (m UpdateConnectionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateConnectionResponseMultiError) AllErrors() []error { return m }
+func (m BulkCreateConnectionItemMultiError) AllErrors() []error { return m }
 
-// UpdateConnectionResponseValidationError is the validation error returned by
-// UpdateConnectionResponse.Validate if the designated constraints aren't met.
-type UpdateConnectionResponseValidationError struct {
+// BulkCreateConnectionItemValidationError is the validation error returned by
+// BulkCreateConnectionItem.Validate if the designated constraints aren't met.
+type BulkCreateConnectionItemValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -996,24 +1006,24 @@ type UpdateConnectionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateConnectionResponseValidationError) Field() string { return e.field }
+func (e BulkCreateConnectionItemValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateConnectionResponseValidationError) Reason() string { return e.reason }
+func (e BulkCreateConnectionItemValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateConnectionResponseValidationError) Cause() error { return e.cause }
+func (e BulkCreateConnectionItemValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateConnectionResponseValidationError) Key() bool { return e.key }
+func (e BulkCreateConnectionItemValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateConnectionResponseValidationError) ErrorName() string {
-	return "UpdateConnectionResponseValidationError"
+func (e BulkCreateConnectionItemValidationError) ErrorName() string {
+	return "BulkCreateConnectionItemValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateConnectionResponseValidationError) Error() string {
+func (e BulkCreateConnectionItemValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1025,14 +1035,14 @@ func (e UpdateConnectionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateConnectionResponse.%s: %s%s",
+		"invalid %sBulkCreateConnectionItem.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateConnectionResponseValidationError{}
+var _ error = BulkCreateConnectionItemValidationError{}
 
 var _ interface {
 	Field() string
@@ -1040,46 +1050,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateConnectionResponseValidationError{}
+} = BulkCreateConnectionItemValidationError{}
 
-// Validate checks the field values on DeleteConnectionRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on BulkCreateConnectionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteConnectionRequest) Validate() error {
+func (m *BulkCreateConnectionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteConnectionRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteConnectionRequestMultiError, or nil if none found.
-func (m *DeleteConnectionRequest) ValidateAll() error {
+// ValidateAll checks the field values on BulkCreateConnectionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BulkCreateConnectionsResponseMultiError, or nil if none found.
+func (m *BulkCreateConnectionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteConnectionRequest) validate(all bool) error {
+func (m *BulkCreateConnectionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	for idx, item := range m.GetConnections() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BulkCreateConnectionsResponseValidationError{
+						field:  fmt.Sprintf("Connections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BulkCreateConnectionsResponseValidationError{
+						field:  fmt.Sprintf("Connections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BulkCreateConnectionsResponseValidationError{
+					field:  fmt.Sprintf("Connections[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return DeleteConnectionRequestMultiError(errors)
+		return BulkCreateConnectionsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteConnectionRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteConnectionRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteConnectionRequestMultiError []error
+// BulkCreateConnectionsResponseMultiError is an error wrapping multiple
+// validation errors returned by BulkCreateConnectionsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type BulkCreateConnectionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteConnectionRequestMultiError) Error() string {
+func (m BulkCreateConnectionsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1088,11 +1130,12 @@ func (m DeleteConnectionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteConnectionRequestMultiError) AllErrors() []error { return m }
+func (m BulkCreateConnectionsResponseMultiError) AllErrors() []error { return m }
 
-// DeleteConnectionRequestValidationError is the validation error returned by
-// DeleteConnectionRequest.Validate if the designated constraints aren't met.
-type DeleteConnectionRequestValidationError struct {
+// BulkCreateConnectionsResponseValidationError is the validation error
+// returned by BulkCreateConnectionsResponse.Validate if the designated
+// constraints aren't met.
+type BulkCreateConnectionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1100,24 +1143,24 @@ type DeleteConnectionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteConnectionRequestValidationError) Field() string { return e.field }
+func (e BulkCreateConnectionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteConnectionRequestValidationError) Reason() string { return e.reason }
+func (e BulkCreateConnectionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteConnectionRequestValidationError) Cause() error { return e.cause }
+func (e BulkCreateConnectionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteConnectionRequestValidationError) Key() bool { return e.key }
+func (e BulkCreateConnectionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteConnectionRequestValidationError) ErrorName() string {
-	return "DeleteConnectionRequestValidationError"
+func (e BulkCreateConnectionsResponseValidationError) ErrorName() string {
+	return "BulkCreateConnectionsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteConnectionRequestValidationError) Error() string {
+func (e BulkCreateConnectionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1129,14 +1172,14 @@ func (e DeleteConnectionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteConnectionRequest.%s: %s%s",
+		"invalid %sBulkCreateConnectionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteConnectionRequestValidationError{}
+var _ error = BulkCreateConnectionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1144,44 +1187,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteConnectionRequestValidationError{}
+} = BulkCreateConnectionsResponseValidationError{}
 
-// Validate checks the field values on DeleteConnectionResponse with the rules
+// Validate checks the field values on UpdateConnectionRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteConnectionResponse) Validate() error {
+func (m *UpdateConnectionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteConnectionResponse with the
+// ValidateAll checks the field values on UpdateConnectionRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteConnectionResponseMultiError, or nil if none found.
-func (m *DeleteConnectionResponse) ValidateAll() error {
+// UpdateConnectionRequestMultiError, or nil if none found.
+func (m *UpdateConnectionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteConnectionResponse) validate(all bool) error {
+func (m *UpdateConnectionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetConnectionConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateConnectionRequestValidationError{
+					field:  "ConnectionConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateConnectionRequestValidationError{
+					field:  "ConnectionConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConnectionConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateConnectionRequestValidationError{
+				field:  "ConnectionConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return DeleteConnectionResponseMultiError(errors)
+		return UpdateConnectionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteConnectionResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteConnectionResponse.ValidateAll() if the designated
+// UpdateConnectionRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateConnectionRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteConnectionResponseMultiError []error
+type UpdateConnectionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteConnectionResponseMultiError) Error() string {
+func (m UpdateConnectionRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1190,11 +1266,11 @@ func (m DeleteConnectionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteConnectionResponseMultiError) AllErrors() []error { return m }
+func (m UpdateConnectionRequestMultiError) AllErrors() []error { return m }
 
-// DeleteConnectionResponseValidationError is the validation error returned by
-// DeleteConnectionResponse.Validate if the designated constraints aren't met.
-type DeleteConnectionResponseValidationError struct {
+// UpdateConnectionRequestValidationError is the validation error returned by
+// UpdateConnectionRequest.Validate if the designated constraints aren't met.
+type UpdateConnectionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1202,24 +1278,24 @@ type DeleteConnectionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteConnectionResponseValidationError) Field() string { return e.field }
+func (e UpdateConnectionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteConnectionResponseValidationError) Reason() string { return e.reason }
+func (e UpdateConnectionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteConnectionResponseValidationError) Cause() error { return e.cause }
+func (e UpdateConnectionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteConnectionResponseValidationError) Key() bool { return e.key }
+func (e UpdateConnectionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteConnectionResponseValidationError) ErrorName() string {
-	return "DeleteConnectionResponseValidationError"
+func (e UpdateConnectionRequestValidationError) ErrorName() string {
+	return "UpdateConnectionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteConnectionResponseValidationError) Error() string {
+func (e UpdateConnectionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1231,14 +1307,14 @@ func (e DeleteConnectionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteConnectionResponse.%s: %s%s",
+		"invalid %sUpdateConnectionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteConnectionResponseValidationError{}
+var _ error = UpdateConnectionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1246,24 +1322,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteConnectionResponseValidationError{}
+} = UpdateConnectionRequestValidationError{}
 
-// Validate checks the field values on CheckConnectionConfigRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateConnectionResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CheckConnectionConfigRequest) Validate() error {
+func (m *UpdateConnectionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckConnectionConfigRequest with the
+// ValidateAll checks the field values on UpdateConnectionResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CheckConnectionConfigRequestMultiError, or nil if none found.
-func (m *CheckConnectionConfigRequest) ValidateAll() error {
+// UpdateConnectionResponseMultiError, or nil if none found.
+func (m *UpdateConnectionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckConnectionConfigRequest) validate(all bool) error {
+func (m *UpdateConnectionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1271,28 +1347,28 @@ func (m *CheckConnectionConfigRequest) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetConnectionConfig()).(type) {
+		switch v := interface{}(m.GetConnection()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CheckConnectionConfigRequestValidationError{
-					field:  "ConnectionConfig",
+				errors = append(errors, UpdateConnectionResponseValidationError{
+					field:  "Connection",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CheckConnectionConfigRequestValidationError{
-					field:  "ConnectionConfig",
+				errors = append(errors, UpdateConnectionResponseValidationError{
+					field:  "Connection",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetConnectionConfig()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetConnection()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CheckConnectionConfigRequestValidationError{
-				field:  "ConnectionConfig",
+			return UpdateConnectionResponseValidationError{
+				field:  "Connection",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1300,19 +1376,19 @@ func (m *CheckConnectionConfigRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CheckConnectionConfigRequestMultiError(errors)
+		return UpdateConnectionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckConnectionConfigRequestMultiError is an error wrapping multiple
-// validation errors returned by CheckConnectionConfigRequest.ValidateAll() if
-// the designated constraints aren't met.
-type CheckConnectionConfigRequestMultiError []error
+// UpdateConnectionResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateConnectionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateConnectionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckConnectionConfigRequestMultiError) Error() string {
+func (m UpdateConnectionResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1321,12 +1397,11 @@ func (m CheckConnectionConfigRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckConnectionConfigRequestMultiError) AllErrors() []error { return m }
+func (m UpdateConnectionResponseMultiError) AllErrors() []error { return m }
 
-// CheckConnectionConfigRequestValidationError is the validation error returned
-// by CheckConnectionConfigRequest.Validate if the designated constraints
-// aren't met.
-type CheckConnectionConfigRequestValidationError struct {
+// UpdateConnectionResponseValidationError is the validation error returned by
+// UpdateConnectionResponse.Validate if the designated constraints aren't met.
+type UpdateConnectionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1334,24 +1409,24 @@ type CheckConnectionConfigRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckConnectionConfigRequestValidationError) Field() string { return e.field }
+func (e UpdateConnectionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckConnectionConfigRequestValidationError) Reason() string { return e.reason }
+func (e UpdateConnectionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckConnectionConfigRequestValidationError) Cause() error { return e.cause }
+func (e UpdateConnectionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckConnectionConfigRequestValidationError) Key() bool { return e.key }
+func (e UpdateConnectionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckConnectionConfigRequestValidationError) ErrorName() string {
-	return "CheckConnectionConfigRequestValidationError"
+func (e UpdateConnectionResponseValidationError) ErrorName() string {
+	return "UpdateConnectionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckConnectionConfigRequestValidationError) Error() string {
+func (e UpdateConnectionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1363,14 +1438,14 @@ func (e CheckConnectionConfigRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckConnectionConfigRequest.%s: %s%s",
+		"invalid %sUpdateConnectionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckConnectionConfigRequestValidationError{}
+var _ error = UpdateConnectionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1378,84 +1453,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckConnectionConfigRequestValidationError{}
+} = UpdateConnectionResponseValidationError{}
 
-// Validate checks the field values on CheckConnectionConfigResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteConnectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CheckConnectionConfigResponse) Validate() error {
+func (m *DeleteConnectionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckConnectionConfigResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// CheckConnectionConfigResponseMultiError, or nil if none found.
-func (m *CheckConnectionConfigResponse) ValidateAll() error {
+// ValidateAll checks the field values on DeleteConnectionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteConnectionRequestMultiError, or nil if none found.
+func (m *DeleteConnectionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckConnectionConfigResponse) validate(all bool) error {
+func (m *DeleteConnectionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for IsConnected
-
-	for idx, item := range m.GetPrivileges() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CheckConnectionConfigResponseValidationError{
-						field:  fmt.Sprintf("Privileges[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CheckConnectionConfigResponseValidationError{
-						field:  fmt.Sprintf("Privileges[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CheckConnectionConfigResponseValidationError{
-					field:  fmt.Sprintf("Privileges[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if m.ConnectionError != nil {
-		// no validation rules for ConnectionError
-	}
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return CheckConnectionConfigResponseMultiError(errors)
+		return DeleteConnectionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckConnectionConfigResponseMultiError is an error wrapping multiple
-// validation errors returned by CheckConnectionConfigResponse.ValidateAll()
-// if the designated constraints aren't met.
-type CheckConnectionConfigResponseMultiError []error
+// DeleteConnectionRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteConnectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteConnectionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckConnectionConfigResponseMultiError) Error() string {
+func (m DeleteConnectionRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1464,12 +1501,11 @@ func (m CheckConnectionConfigResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckConnectionConfigResponseMultiError) AllErrors() []error { return m }
+func (m DeleteConnectionRequestMultiError) AllErrors() []error { return m }
 
-// CheckConnectionConfigResponseValidationError is the validation error
-// returned by CheckConnectionConfigResponse.Validate if the designated
-// constraints aren't met.
-type CheckConnectionConfigResponseValidationError struct {
+// DeleteConnectionRequestValidationError is the validation error returned by
+// DeleteConnectionRequest.Validate if the designated constraints aren't met.
+type DeleteConnectionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1477,24 +1513,24 @@ type CheckConnectionConfigResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckConnectionConfigResponseValidationError) Field() string { return e.field }
+func (e DeleteConnectionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckConnectionConfigResponseValidationError) Reason() string { return e.reason }
+func (e DeleteConnectionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckConnectionConfigResponseValidationError) Cause() error { return e.cause }
+func (e DeleteConnectionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckConnectionConfigResponseValidationError) Key() bool { return e.key }
+func (e DeleteConnectionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckConnectionConfigResponseValidationError) ErrorName() string {
-	return "CheckConnectionConfigResponseValidationError"
+func (e DeleteConnectionRequestValidationError) ErrorName() string {
+	return "DeleteConnectionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckConnectionConfigResponseValidationError) Error() string {
+func (e DeleteConnectionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1506,14 +1542,14 @@ func (e CheckConnectionConfigResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckConnectionConfigResponse.%s: %s%s",
+		"invalid %sDeleteConnectionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckConnectionConfigResponseValidationError{}
+var _ error = DeleteConnectionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1521,50 +1557,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckConnectionConfigResponseValidationError{}
+} = DeleteConnectionRequestValidationError{}
 
-// Validate checks the field values on ConnectionRolePrivilege with the rules
+// Validate checks the field values on DeleteConnectionResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ConnectionRolePrivilege) Validate() error {
+func (m *DeleteConnectionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ConnectionRolePrivilege with the
+// ValidateAll checks the field values on DeleteConnectionResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ConnectionRolePrivilegeMultiError, or nil if none found.
-func (m *ConnectionRolePrivilege) ValidateAll() error {
+// DeleteConnectionResponseMultiError, or nil if none found.
+func (m *DeleteConnectionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ConnectionRolePrivilege) validate(all bool) error {
+func (m *DeleteConnectionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Grantee
-
-	// no validation rules for Schema
-
-	// no validation rules for Table
-
 	if len(errors) > 0 {
-		return ConnectionRolePrivilegeMultiError(errors)
+		return DeleteConnectionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ConnectionRolePrivilegeMultiError is an error wrapping multiple validation
-// errors returned by ConnectionRolePrivilege.ValidateAll() if the designated
+// DeleteConnectionResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteConnectionResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ConnectionRolePrivilegeMultiError []error
+type DeleteConnectionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ConnectionRolePrivilegeMultiError) Error() string {
+func (m DeleteConnectionResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1573,11 +1603,11 @@ func (m ConnectionRolePrivilegeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ConnectionRolePrivilegeMultiError) AllErrors() []error { return m }
+func (m DeleteConnectionResponseMultiError) AllErrors() []error { return m }
 
-// ConnectionRolePrivilegeValidationError is the validation error returned by
-// ConnectionRolePrivilege.Validate if the designated constraints aren't met.
-type ConnectionRolePrivilegeValidationError struct {
+// DeleteConnectionResponseValidationError is the validation error returned by
+// DeleteConnectionResponse.Validate if the designated constraints aren't met.
+type DeleteConnectionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1585,24 +1615,24 @@ type ConnectionRolePrivilegeValidationError struct {
 }
 
 // Field function returns field value.
-func (e ConnectionRolePrivilegeValidationError) Field() string { return e.field }
+func (e DeleteConnectionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ConnectionRolePrivilegeValidationError) Reason() string { return e.reason }
+func (e DeleteConnectionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ConnectionRolePrivilegeValidationError) Cause() error { return e.cause }
+func (e DeleteConnectionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ConnectionRolePrivilegeValidationError) Key() bool { return e.key }
+func (e DeleteConnectionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ConnectionRolePrivilegeValidationError) ErrorName() string {
-	return "ConnectionRolePrivilegeValidationError"
+func (e DeleteConnectionResponseValidationError) ErrorName() string {
+	return "DeleteConnectionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ConnectionRolePrivilegeValidationError) Error() string {
+func (e DeleteConnectionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1614,14 +1644,14 @@ func (e ConnectionRolePrivilegeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sConnectionRolePrivilege.%s: %s%s",
+		"invalid %sDeleteConnectionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ConnectionRolePrivilegeValidationError{}
+var _ error = DeleteConnectionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1629,39 +1659,35 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ConnectionRolePrivilegeValidationError{}
+} = DeleteConnectionResponseValidationError{}
 
-// Validate checks the field values on Connection with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Connection) Validate() error {
+// Validate checks the field values on CheckConnectionConfigRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckConnectionConfigRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Connection with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ConnectionMultiError, or
-// nil if none found.
-func (m *Connection) ValidateAll() error {
+// ValidateAll checks the field values on CheckConnectionConfigRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckConnectionConfigRequestMultiError, or nil if none found.
+func (m *CheckConnectionConfigRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Connection) validate(all bool) error {
+func (m *CheckConnectionConfigRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Name
-
 	if all {
 		switch v := interface{}(m.GetConnectionConfig()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ConnectionValidationError{
+				errors = append(errors, CheckConnectionConfigRequestValidationError{
 					field:  "ConnectionConfig",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1669,7 +1695,7 @@ func (m *Connection) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ConnectionValidationError{
+				errors = append(errors, CheckConnectionConfigRequestValidationError{
 					field:  "ConnectionConfig",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1678,7 +1704,7 @@ func (m *Connection) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetConnectionConfig()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ConnectionValidationError{
+			return CheckConnectionConfigRequestValidationError{
 				field:  "ConnectionConfig",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1686,83 +1712,20 @@ func (m *Connection) validate(all bool) error {
 		}
 	}
 
-	// no validation rules for CreatedByUserId
-
-	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ConnectionValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ConnectionValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ConnectionValidationError{
-				field:  "CreatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for UpdatedByUserId
-
-	if all {
-		switch v := interface{}(m.GetUpdatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ConnectionValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ConnectionValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ConnectionValidationError{
-				field:  "UpdatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for AccountId
-
 	if len(errors) > 0 {
-		return ConnectionMultiError(errors)
+		return CheckConnectionConfigRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ConnectionMultiError is an error wrapping multiple validation errors
-// returned by Connection.ValidateAll() if the designated constraints aren't met.
-type ConnectionMultiError []error
+// CheckConnectionConfigRequestMultiError is an error wrapping multiple
+// validation errors returned by CheckConnectionConfigRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CheckConnectionConfigRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ConnectionMultiError) Error() string {
+func (m CheckConnectionConfigRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1771,11 +1734,12 @@ func (m ConnectionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ConnectionMultiError) AllErrors() []error { return m }
+func (m CheckConnectionConfigRequestMultiError) AllErrors() []error { return m }
 
-// ConnectionValidationError is the validation error returned by
-// Connection.Validate if the designated constraints aren't met.
-type ConnectionValidationError struct {
+// CheckConnectionConfigRequestValidationError is the validation error returned
+// by CheckConnectionConfigRequest.Validate if the designated constraints
+// aren't met.
+type CheckConnectionConfigRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1783,22 +1747,24 @@ type ConnectionValidationError struct {
 }
 
 // Field function returns field value.
-func (e ConnectionValidationError) Field() string { return e.field }
+func (e CheckConnectionConfigRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ConnectionValidationError) Reason() string { return e.reason }
+func (e CheckConnectionConfigRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ConnectionValidationError) Cause() error { return e.cause }
+func (e CheckConnectionConfigRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ConnectionValidationError) Key() bool { return e.key }
+func (e CheckConnectionConfigRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ConnectionValidationError) ErrorName() string { return "ConnectionValidationError" }
+func (e CheckConnectionConfigRequestValidationError) ErrorName() string {
+	return "CheckConnectionConfigRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ConnectionValidationError) Error() string {
+func (e CheckConnectionConfigRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1810,14 +1776,14 @@ func (e ConnectionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sConnection.%s: %s%s",
+		"invalid %sCheckConnectionConfigRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ConnectionValidationError{}
+var _ error = CheckConnectionConfigRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1825,254 +1791,5645 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ConnectionValidationError{}
+} = CheckConnectionConfigRequestValidationError{}
 
-// Validate checks the field values on ConnectionConfig with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ConnectionConfig) Validate() error {
+// Validate checks the field values on CheckConnectionConfigResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckConnectionConfigResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ConnectionConfig with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ConnectionConfigMultiError, or nil if none found.
-func (m *ConnectionConfig) ValidateAll() error {
+// ValidateAll checks the field values on CheckConnectionConfigResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CheckConnectionConfigResponseMultiError, or nil if none found.
+func (m *CheckConnectionConfigResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ConnectionConfig) validate(all bool) error {
+func (m *CheckConnectionConfigResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Config.(type) {
-	case *ConnectionConfig_PgConfig:
-		if v == nil {
-			err := ConnectionConfigValidationError{
-				field:  "Config",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	// no validation rules for IsConnected
+
+	for idx, item := range m.GetPrivileges() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetPgConfig()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ConnectionConfigValidationError{
-						field:  "PgConfig",
+					errors = append(errors, CheckConnectionConfigResponseValidationError{
+						field:  fmt.Sprintf("Privileges[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ConnectionConfigValidationError{
-						field:  "PgConfig",
+					errors = append(errors, CheckConnectionConfigResponseValidationError{
+						field:  fmt.Sprintf("Privileges[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetPgConfig()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ConnectionConfigValidationError{
-					field:  "PgConfig",
+				return CheckConnectionConfigResponseValidationError{
+					field:  fmt.Sprintf("Privileges[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *ConnectionConfig_AwsS3Config:
-		if v == nil {
-			err := ConnectionConfigValidationError{
-				field:  "Config",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	}
 
-		if all {
-			switch v := interface{}(m.GetAwsS3Config()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ConnectionConfigValidationError{
-						field:  "AwsS3Config",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ConnectionConfigValidationError{
-						field:  "AwsS3Config",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetAwsS3Config()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ConnectionConfigValidationError{
-					field:  "AwsS3Config",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	if m.ConnectionError != nil {
+		// no validation rules for ConnectionError
+	}
 
-	case *ConnectionConfig_MysqlConfig:
-		if v == nil {
-			err := ConnectionConfigValidationError{
-				field:  "Config",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	if len(errors) > 0 {
+		return CheckConnectionConfigResponseMultiError(errors)
+	}
 
-		if all {
-			switch v := interface{}(m.GetMysqlConfig()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ConnectionConfigValidationError{
-						field:  "MysqlConfig",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ConnectionConfigValidationError{
-						field:  "MysqlConfig",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetMysqlConfig()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ConnectionConfigValidationError{
-					field:  "MysqlConfig",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	return nil
+}
 
-	case *ConnectionConfig_LocalDirConfig:
-		if v == nil {
-			err := ConnectionConfigValidationError{
+// CheckConnectionConfigResponseMultiError is an error wrapping multiple
+// validation errors returned by CheckConnectionConfigResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CheckConnectionConfigResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckConnectionConfigResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckConnectionConfigResponseMultiError) AllErrors() []error { return m }
+
+// CheckConnectionConfigResponseValidationError is the validation error
+// returned by CheckConnectionConfigResponse.Validate if the designated
+// constraints aren't met.
+type CheckConnectionConfigResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckConnectionConfigResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckConnectionConfigResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckConnectionConfigResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckConnectionConfigResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckConnectionConfigResponseValidationError) ErrorName() string {
+	return "CheckConnectionConfigResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckConnectionConfigResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckConnectionConfigResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckConnectionConfigResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckConnectionConfigResponseValidationError{}
+
+// Validate checks the field values on ConnectionRolePrivilege with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConnectionRolePrivilege) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConnectionRolePrivilege with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConnectionRolePrivilegeMultiError, or nil if none found.
+func (m *ConnectionRolePrivilege) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConnectionRolePrivilege) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Grantee
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	if len(errors) > 0 {
+		return ConnectionRolePrivilegeMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConnectionRolePrivilegeMultiError is an error wrapping multiple validation
+// errors returned by ConnectionRolePrivilege.ValidateAll() if the designated
+// constraints aren't met.
+type ConnectionRolePrivilegeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConnectionRolePrivilegeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConnectionRolePrivilegeMultiError) AllErrors() []error { return m }
+
+// ConnectionRolePrivilegeValidationError is the validation error returned by
+// ConnectionRolePrivilege.Validate if the designated constraints aren't met.
+type ConnectionRolePrivilegeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConnectionRolePrivilegeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConnectionRolePrivilegeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConnectionRolePrivilegeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConnectionRolePrivilegeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConnectionRolePrivilegeValidationError) ErrorName() string {
+	return "ConnectionRolePrivilegeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConnectionRolePrivilegeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConnectionRolePrivilege.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConnectionRolePrivilegeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConnectionRolePrivilegeValidationError{}
+
+// Validate checks the field values on Connection with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Connection) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Connection with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConnectionMultiError, or
+// nil if none found.
+func (m *Connection) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Connection) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetConnectionConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConnectionValidationError{
+					field:  "ConnectionConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConnectionValidationError{
+					field:  "ConnectionConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConnectionConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConnectionValidationError{
+				field:  "ConnectionConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CreatedByUserId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConnectionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConnectionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConnectionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UpdatedByUserId
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConnectionValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConnectionValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConnectionValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AccountId
+
+	// no validation rules for Labels
+
+	if len(errors) > 0 {
+		return ConnectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConnectionMultiError is an error wrapping multiple validation errors
+// returned by Connection.ValidateAll() if the designated constraints aren't met.
+type ConnectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConnectionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConnectionMultiError) AllErrors() []error { return m }
+
+// ConnectionValidationError is the validation error returned by
+// Connection.Validate if the designated constraints aren't met.
+type ConnectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConnectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConnectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConnectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConnectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConnectionValidationError) ErrorName() string { return "ConnectionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConnectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConnection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConnectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConnectionValidationError{}
+
+// Validate checks the field values on ConnectionConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ConnectionConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConnectionConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConnectionConfigMultiError, or nil if none found.
+func (m *ConnectionConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConnectionConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Config.(type) {
+	case *ConnectionConfig_PgConfig:
+		if v == nil {
+			err := ConnectionConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPgConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "PgConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "PgConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPgConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionConfigValidationError{
+					field:  "PgConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionConfig_AwsS3Config:
+		if v == nil {
+			err := ConnectionConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAwsS3Config()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "AwsS3Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "AwsS3Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAwsS3Config()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionConfigValidationError{
+					field:  "AwsS3Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionConfig_MysqlConfig:
+		if v == nil {
+			err := ConnectionConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMysqlConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "MysqlConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "MysqlConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMysqlConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionConfigValidationError{
+					field:  "MysqlConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionConfig_LocalDirConfig:
+		if v == nil {
+			err := ConnectionConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetLocalDirConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "LocalDirConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "LocalDirConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLocalDirConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionConfigValidationError{
+					field:  "LocalDirConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionConfig_OpenaiConfig:
+		if v == nil {
+			err := ConnectionConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetOpenaiConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "OpenaiConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "OpenaiConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetOpenaiConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionConfigValidationError{
+					field:  "OpenaiConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionConfig_CustomConfig:
+		if v == nil {
+			err := ConnectionConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetCustomConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "CustomConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "CustomConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCustomConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionConfigValidationError{
+					field:  "CustomConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionConfig_SqliteConfig:
+		if v == nil {
+			err := ConnectionConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSqliteConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "SqliteConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "SqliteConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSqliteConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionConfigValidationError{
+					field:  "SqliteConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionConfig_SnowflakeConfig:
+		if v == nil {
+			err := ConnectionConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSnowflakeConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "SnowflakeConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "SnowflakeConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSnowflakeConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionConfigValidationError{
+					field:  "SnowflakeConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionConfig_BigqueryConfig:
+		if v == nil {
+			err := ConnectionConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetBigqueryConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "BigqueryConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "BigqueryConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBigqueryConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionConfigValidationError{
+					field:  "BigqueryConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionConfig_RedshiftConfig:
+		if v == nil {
+			err := ConnectionConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRedshiftConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "RedshiftConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "RedshiftConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRedshiftConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionConfigValidationError{
+					field:  "RedshiftConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionConfig_GcpCloudstorageConfig:
+		if v == nil {
+			err := ConnectionConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetGcpCloudstorageConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "GcpCloudstorageConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "GcpCloudstorageConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGcpCloudstorageConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionConfigValidationError{
+					field:  "GcpCloudstorageConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionConfig_AzureBlobConfig:
+		if v == nil {
+			err := ConnectionConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAzureBlobConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "AzureBlobConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "AzureBlobConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAzureBlobConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionConfigValidationError{
+					field:  "AzureBlobConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionConfig_KafkaConfig:
+		if v == nil {
+			err := ConnectionConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetKafkaConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "KafkaConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "KafkaConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetKafkaConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionConfigValidationError{
+					field:  "KafkaConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionConfig_ElasticsearchConfig:
+		if v == nil {
+			err := ConnectionConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetElasticsearchConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "ElasticsearchConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "ElasticsearchConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetElasticsearchConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionConfigValidationError{
+					field:  "ElasticsearchConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionConfig_DuckdbConfig:
+		if v == nil {
+			err := ConnectionConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetDuckdbConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "DuckdbConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "DuckdbConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDuckdbConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionConfigValidationError{
+					field:  "DuckdbConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionConfig_SpannerConfig:
+		if v == nil {
+			err := ConnectionConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSpannerConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "SpannerConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "SpannerConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSpannerConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionConfigValidationError{
+					field:  "SpannerConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionConfig_MssqlConfig:
+		if v == nil {
+			err := ConnectionConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMssqlConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "MssqlConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "MssqlConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMssqlConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionConfigValidationError{
+					field:  "MssqlConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if m.Region != nil {
+		// no validation rules for Region
+	}
+
+	if len(errors) > 0 {
+		return ConnectionConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConnectionConfigMultiError is an error wrapping multiple validation errors
+// returned by ConnectionConfig.ValidateAll() if the designated constraints
+// aren't met.
+type ConnectionConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConnectionConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConnectionConfigMultiError) AllErrors() []error { return m }
+
+// ConnectionConfigValidationError is the validation error returned by
+// ConnectionConfig.Validate if the designated constraints aren't met.
+type ConnectionConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConnectionConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConnectionConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConnectionConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConnectionConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConnectionConfigValidationError) ErrorName() string { return "ConnectionConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConnectionConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConnectionConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConnectionConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConnectionConfigValidationError{}
+
+// Validate checks the field values on CustomConnectionConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CustomConnectionConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CustomConnectionConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CustomConnectionConfigMultiError, or nil if none found.
+func (m *CustomConnectionConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CustomConnectionConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Plugin
+
+	// no validation rules for Options
+
+	if len(errors) > 0 {
+		return CustomConnectionConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// CustomConnectionConfigMultiError is an error wrapping multiple validation
+// errors returned by CustomConnectionConfig.ValidateAll() if the designated
+// constraints aren't met.
+type CustomConnectionConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CustomConnectionConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CustomConnectionConfigMultiError) AllErrors() []error { return m }
+
+// CustomConnectionConfigValidationError is the validation error returned by
+// CustomConnectionConfig.Validate if the designated constraints aren't met.
+type CustomConnectionConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CustomConnectionConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CustomConnectionConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CustomConnectionConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CustomConnectionConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CustomConnectionConfigValidationError) ErrorName() string {
+	return "CustomConnectionConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CustomConnectionConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCustomConnectionConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CustomConnectionConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CustomConnectionConfigValidationError{}
+
+// Validate checks the field values on OpenAiConnectionConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OpenAiConnectionConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OpenAiConnectionConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OpenAiConnectionConfigMultiError, or nil if none found.
+func (m *OpenAiConnectionConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OpenAiConnectionConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ApiKey
+
+	// no validation rules for ApiUrl
+
+	if len(errors) > 0 {
+		return OpenAiConnectionConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// OpenAiConnectionConfigMultiError is an error wrapping multiple validation
+// errors returned by OpenAiConnectionConfig.ValidateAll() if the designated
+// constraints aren't met.
+type OpenAiConnectionConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OpenAiConnectionConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OpenAiConnectionConfigMultiError) AllErrors() []error { return m }
+
+// OpenAiConnectionConfigValidationError is the validation error returned by
+// OpenAiConnectionConfig.Validate if the designated constraints aren't met.
+type OpenAiConnectionConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OpenAiConnectionConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OpenAiConnectionConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OpenAiConnectionConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OpenAiConnectionConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OpenAiConnectionConfigValidationError) ErrorName() string {
+	return "OpenAiConnectionConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OpenAiConnectionConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOpenAiConnectionConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OpenAiConnectionConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OpenAiConnectionConfigValidationError{}
+
+// Validate checks the field values on LocalDirectoryConnectionConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LocalDirectoryConnectionConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LocalDirectoryConnectionConfig with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// LocalDirectoryConnectionConfigMultiError, or nil if none found.
+func (m *LocalDirectoryConnectionConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LocalDirectoryConnectionConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Path
+
+	if len(errors) > 0 {
+		return LocalDirectoryConnectionConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// LocalDirectoryConnectionConfigMultiError is an error wrapping multiple
+// validation errors returned by LocalDirectoryConnectionConfig.ValidateAll()
+// if the designated constraints aren't met.
+type LocalDirectoryConnectionConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LocalDirectoryConnectionConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LocalDirectoryConnectionConfigMultiError) AllErrors() []error { return m }
+
+// LocalDirectoryConnectionConfigValidationError is the validation error
+// returned by LocalDirectoryConnectionConfig.Validate if the designated
+// constraints aren't met.
+type LocalDirectoryConnectionConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LocalDirectoryConnectionConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LocalDirectoryConnectionConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LocalDirectoryConnectionConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LocalDirectoryConnectionConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LocalDirectoryConnectionConfigValidationError) ErrorName() string {
+	return "LocalDirectoryConnectionConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LocalDirectoryConnectionConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLocalDirectoryConnectionConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LocalDirectoryConnectionConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LocalDirectoryConnectionConfigValidationError{}
+
+// Validate checks the field values on PostgresConnectionConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PostgresConnectionConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PostgresConnectionConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PostgresConnectionConfigMultiError, or nil if none found.
+func (m *PostgresConnectionConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PostgresConnectionConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTunnel()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PostgresConnectionConfigValidationError{
+					field:  "Tunnel",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PostgresConnectionConfigValidationError{
+					field:  "Tunnel",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTunnel()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PostgresConnectionConfigValidationError{
+				field:  "Tunnel",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetConnectionOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PostgresConnectionConfigValidationError{
+					field:  "ConnectionOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PostgresConnectionConfigValidationError{
+					field:  "ConnectionOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConnectionOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PostgresConnectionConfigValidationError{
+				field:  "ConnectionOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetClientTls()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PostgresConnectionConfigValidationError{
+					field:  "ClientTls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PostgresConnectionConfigValidationError{
+					field:  "ClientTls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClientTls()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PostgresConnectionConfigValidationError{
+				field:  "ClientTls",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for IsCockroachdb
+
+	switch v := m.ConnectionConfig.(type) {
+	case *PostgresConnectionConfig_Url:
+		if v == nil {
+			err := PostgresConnectionConfigValidationError{
+				field:  "ConnectionConfig",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Url
+	case *PostgresConnectionConfig_Connection:
+		if v == nil {
+			err := PostgresConnectionConfigValidationError{
+				field:  "ConnectionConfig",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetConnection()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PostgresConnectionConfigValidationError{
+						field:  "Connection",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PostgresConnectionConfigValidationError{
+						field:  "Connection",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetConnection()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PostgresConnectionConfigValidationError{
+					field:  "Connection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if m.TemporaryCredentials != nil {
+
+		if all {
+			switch v := interface{}(m.GetTemporaryCredentials()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PostgresConnectionConfigValidationError{
+						field:  "TemporaryCredentials",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PostgresConnectionConfigValidationError{
+						field:  "TemporaryCredentials",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTemporaryCredentials()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PostgresConnectionConfigValidationError{
+					field:  "TemporaryCredentials",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PostgresConnectionConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// PostgresConnectionConfigMultiError is an error wrapping multiple validation
+// errors returned by PostgresConnectionConfig.ValidateAll() if the designated
+// constraints aren't met.
+type PostgresConnectionConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PostgresConnectionConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PostgresConnectionConfigMultiError) AllErrors() []error { return m }
+
+// PostgresConnectionConfigValidationError is the validation error returned by
+// PostgresConnectionConfig.Validate if the designated constraints aren't met.
+type PostgresConnectionConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PostgresConnectionConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PostgresConnectionConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PostgresConnectionConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PostgresConnectionConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PostgresConnectionConfigValidationError) ErrorName() string {
+	return "PostgresConnectionConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PostgresConnectionConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPostgresConnectionConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PostgresConnectionConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PostgresConnectionConfigValidationError{}
+
+// Validate checks the field values on ClientTlsConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ClientTlsConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClientTlsConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClientTlsConfigMultiError, or nil if none found.
+func (m *ClientTlsConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClientTlsConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.RootCert != nil {
+		// no validation rules for RootCert
+	}
+
+	if m.ClientCert != nil {
+		// no validation rules for ClientCert
+	}
+
+	if m.ClientKey != nil {
+		// no validation rules for ClientKey
+	}
+
+	if len(errors) > 0 {
+		return ClientTlsConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClientTlsConfigMultiError is an error wrapping multiple validation errors
+// returned by ClientTlsConfig.ValidateAll() if the designated constraints
+// aren't met.
+type ClientTlsConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClientTlsConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClientTlsConfigMultiError) AllErrors() []error { return m }
+
+// ClientTlsConfigValidationError is the validation error returned by
+// ClientTlsConfig.Validate if the designated constraints aren't met.
+type ClientTlsConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClientTlsConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClientTlsConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClientTlsConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClientTlsConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClientTlsConfigValidationError) ErrorName() string { return "ClientTlsConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClientTlsConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClientTlsConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClientTlsConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClientTlsConfigValidationError{}
+
+// Validate checks the field values on SqlConnectionOptions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SqlConnectionOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SqlConnectionOptions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SqlConnectionOptionsMultiError, or nil if none found.
+func (m *SqlConnectionOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SqlConnectionOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.MaxConnectionLimit != nil {
+		// no validation rules for MaxConnectionLimit
+	}
+
+	if len(errors) > 0 {
+		return SqlConnectionOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// SqlConnectionOptionsMultiError is an error wrapping multiple validation
+// errors returned by SqlConnectionOptions.ValidateAll() if the designated
+// constraints aren't met.
+type SqlConnectionOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SqlConnectionOptionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SqlConnectionOptionsMultiError) AllErrors() []error { return m }
+
+// SqlConnectionOptionsValidationError is the validation error returned by
+// SqlConnectionOptions.Validate if the designated constraints aren't met.
+type SqlConnectionOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SqlConnectionOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SqlConnectionOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SqlConnectionOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SqlConnectionOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SqlConnectionOptionsValidationError) ErrorName() string {
+	return "SqlConnectionOptionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SqlConnectionOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSqlConnectionOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SqlConnectionOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SqlConnectionOptionsValidationError{}
+
+// Validate checks the field values on SSHTunnel with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SSHTunnel) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SSHTunnel with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SSHTunnelMultiError, or nil
+// if none found.
+func (m *SSHTunnel) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SSHTunnel) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Host
+
+	// no validation rules for Port
+
+	// no validation rules for User
+
+	if all {
+		switch v := interface{}(m.GetAuthentication()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SSHTunnelValidationError{
+					field:  "Authentication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SSHTunnelValidationError{
+					field:  "Authentication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuthentication()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SSHTunnelValidationError{
+				field:  "Authentication",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.KnownHostPublicKey != nil {
+		// no validation rules for KnownHostPublicKey
+	}
+
+	if len(errors) > 0 {
+		return SSHTunnelMultiError(errors)
+	}
+
+	return nil
+}
+
+// SSHTunnelMultiError is an error wrapping multiple validation errors returned
+// by SSHTunnel.ValidateAll() if the designated constraints aren't met.
+type SSHTunnelMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SSHTunnelMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SSHTunnelMultiError) AllErrors() []error { return m }
+
+// SSHTunnelValidationError is the validation error returned by
+// SSHTunnel.Validate if the designated constraints aren't met.
+type SSHTunnelValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SSHTunnelValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SSHTunnelValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SSHTunnelValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SSHTunnelValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SSHTunnelValidationError) ErrorName() string { return "SSHTunnelValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SSHTunnelValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSSHTunnel.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SSHTunnelValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SSHTunnelValidationError{}
+
+// Validate checks the field values on SSHAuthentication with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SSHAuthentication) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SSHAuthentication with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SSHAuthenticationMultiError, or nil if none found.
+func (m *SSHAuthentication) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SSHAuthentication) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.AuthConfig.(type) {
+	case *SSHAuthentication_Passphrase:
+		if v == nil {
+			err := SSHAuthenticationValidationError{
+				field:  "AuthConfig",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPassphrase()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SSHAuthenticationValidationError{
+						field:  "Passphrase",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SSHAuthenticationValidationError{
+						field:  "Passphrase",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPassphrase()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SSHAuthenticationValidationError{
+					field:  "Passphrase",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SSHAuthentication_PrivateKey:
+		if v == nil {
+			err := SSHAuthenticationValidationError{
+				field:  "AuthConfig",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPrivateKey()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SSHAuthenticationValidationError{
+						field:  "PrivateKey",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SSHAuthenticationValidationError{
+						field:  "PrivateKey",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPrivateKey()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SSHAuthenticationValidationError{
+					field:  "PrivateKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return SSHAuthenticationMultiError(errors)
+	}
+
+	return nil
+}
+
+// SSHAuthenticationMultiError is an error wrapping multiple validation errors
+// returned by SSHAuthentication.ValidateAll() if the designated constraints
+// aren't met.
+type SSHAuthenticationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SSHAuthenticationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SSHAuthenticationMultiError) AllErrors() []error { return m }
+
+// SSHAuthenticationValidationError is the validation error returned by
+// SSHAuthentication.Validate if the designated constraints aren't met.
+type SSHAuthenticationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SSHAuthenticationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SSHAuthenticationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SSHAuthenticationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SSHAuthenticationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SSHAuthenticationValidationError) ErrorName() string {
+	return "SSHAuthenticationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SSHAuthenticationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSSHAuthentication.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SSHAuthenticationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SSHAuthenticationValidationError{}
+
+// Validate checks the field values on SSHPassphrase with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SSHPassphrase) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SSHPassphrase with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SSHPassphraseMultiError, or
+// nil if none found.
+func (m *SSHPassphrase) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SSHPassphrase) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Value
+
+	if len(errors) > 0 {
+		return SSHPassphraseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SSHPassphraseMultiError is an error wrapping multiple validation errors
+// returned by SSHPassphrase.ValidateAll() if the designated constraints
+// aren't met.
+type SSHPassphraseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SSHPassphraseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SSHPassphraseMultiError) AllErrors() []error { return m }
+
+// SSHPassphraseValidationError is the validation error returned by
+// SSHPassphrase.Validate if the designated constraints aren't met.
+type SSHPassphraseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SSHPassphraseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SSHPassphraseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SSHPassphraseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SSHPassphraseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SSHPassphraseValidationError) ErrorName() string { return "SSHPassphraseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SSHPassphraseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSSHPassphrase.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SSHPassphraseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SSHPassphraseValidationError{}
+
+// Validate checks the field values on SSHPrivateKey with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SSHPrivateKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SSHPrivateKey with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SSHPrivateKeyMultiError, or
+// nil if none found.
+func (m *SSHPrivateKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SSHPrivateKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Value
+
+	if m.Passphrase != nil {
+		// no validation rules for Passphrase
+	}
+
+	if len(errors) > 0 {
+		return SSHPrivateKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SSHPrivateKeyMultiError is an error wrapping multiple validation errors
+// returned by SSHPrivateKey.ValidateAll() if the designated constraints
+// aren't met.
+type SSHPrivateKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SSHPrivateKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SSHPrivateKeyMultiError) AllErrors() []error { return m }
+
+// SSHPrivateKeyValidationError is the validation error returned by
+// SSHPrivateKey.Validate if the designated constraints aren't met.
+type SSHPrivateKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SSHPrivateKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SSHPrivateKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SSHPrivateKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SSHPrivateKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SSHPrivateKeyValidationError) ErrorName() string { return "SSHPrivateKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SSHPrivateKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSSHPrivateKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SSHPrivateKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SSHPrivateKeyValidationError{}
+
+// Validate checks the field values on PostgresConnection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PostgresConnection) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PostgresConnection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PostgresConnectionMultiError, or nil if none found.
+func (m *PostgresConnection) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PostgresConnection) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Host
+
+	// no validation rules for Port
+
+	// no validation rules for Name
+
+	// no validation rules for User
+
+	// no validation rules for Pass
+
+	if m.SslMode != nil {
+		// no validation rules for SslMode
+	}
+
+	if len(errors) > 0 {
+		return PostgresConnectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// PostgresConnectionMultiError is an error wrapping multiple validation errors
+// returned by PostgresConnection.ValidateAll() if the designated constraints
+// aren't met.
+type PostgresConnectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PostgresConnectionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PostgresConnectionMultiError) AllErrors() []error { return m }
+
+// PostgresConnectionValidationError is the validation error returned by
+// PostgresConnection.Validate if the designated constraints aren't met.
+type PostgresConnectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PostgresConnectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PostgresConnectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PostgresConnectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PostgresConnectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PostgresConnectionValidationError) ErrorName() string {
+	return "PostgresConnectionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PostgresConnectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPostgresConnection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PostgresConnectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PostgresConnectionValidationError{}
+
+// Validate checks the field values on MysqlConnection with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MysqlConnection) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MysqlConnection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MysqlConnectionMultiError, or nil if none found.
+func (m *MysqlConnection) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MysqlConnection) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for User
+
+	// no validation rules for Pass
+
+	// no validation rules for Protocol
+
+	// no validation rules for Host
+
+	// no validation rules for Port
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return MysqlConnectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// MysqlConnectionMultiError is an error wrapping multiple validation errors
+// returned by MysqlConnection.ValidateAll() if the designated constraints
+// aren't met.
+type MysqlConnectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MysqlConnectionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MysqlConnectionMultiError) AllErrors() []error { return m }
+
+// MysqlConnectionValidationError is the validation error returned by
+// MysqlConnection.Validate if the designated constraints aren't met.
+type MysqlConnectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MysqlConnectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MysqlConnectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MysqlConnectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MysqlConnectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MysqlConnectionValidationError) ErrorName() string { return "MysqlConnectionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MysqlConnectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMysqlConnection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MysqlConnectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MysqlConnectionValidationError{}
+
+// Validate checks the field values on MysqlConnectionConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MysqlConnectionConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MysqlConnectionConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MysqlConnectionConfigMultiError, or nil if none found.
+func (m *MysqlConnectionConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MysqlConnectionConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTunnel()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MysqlConnectionConfigValidationError{
+					field:  "Tunnel",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MysqlConnectionConfigValidationError{
+					field:  "Tunnel",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTunnel()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MysqlConnectionConfigValidationError{
+				field:  "Tunnel",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetConnectionOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MysqlConnectionConfigValidationError{
+					field:  "ConnectionOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MysqlConnectionConfigValidationError{
+					field:  "ConnectionOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConnectionOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MysqlConnectionConfigValidationError{
+				field:  "ConnectionOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for IsMariadb
+
+	switch v := m.ConnectionConfig.(type) {
+	case *MysqlConnectionConfig_Url:
+		if v == nil {
+			err := MysqlConnectionConfigValidationError{
+				field:  "ConnectionConfig",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Url
+	case *MysqlConnectionConfig_Connection:
+		if v == nil {
+			err := MysqlConnectionConfigValidationError{
+				field:  "ConnectionConfig",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetConnection()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MysqlConnectionConfigValidationError{
+						field:  "Connection",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MysqlConnectionConfigValidationError{
+						field:  "Connection",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetConnection()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MysqlConnectionConfigValidationError{
+					field:  "Connection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if m.TemporaryCredentials != nil {
+
+		if all {
+			switch v := interface{}(m.GetTemporaryCredentials()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MysqlConnectionConfigValidationError{
+						field:  "TemporaryCredentials",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MysqlConnectionConfigValidationError{
+						field:  "TemporaryCredentials",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTemporaryCredentials()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MysqlConnectionConfigValidationError{
+					field:  "TemporaryCredentials",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MysqlConnectionConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// MysqlConnectionConfigMultiError is an error wrapping multiple validation
+// errors returned by MysqlConnectionConfig.ValidateAll() if the designated
+// constraints aren't met.
+type MysqlConnectionConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MysqlConnectionConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MysqlConnectionConfigMultiError) AllErrors() []error { return m }
+
+// MysqlConnectionConfigValidationError is the validation error returned by
+// MysqlConnectionConfig.Validate if the designated constraints aren't met.
+type MysqlConnectionConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MysqlConnectionConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MysqlConnectionConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MysqlConnectionConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MysqlConnectionConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MysqlConnectionConfigValidationError) ErrorName() string {
+	return "MysqlConnectionConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MysqlConnectionConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMysqlConnectionConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MysqlConnectionConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MysqlConnectionConfigValidationError{}
+
+// Validate checks the field values on TemporaryCredentialsConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TemporaryCredentialsConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TemporaryCredentialsConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TemporaryCredentialsConfigMultiError, or nil if none found.
+func (m *TemporaryCredentialsConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TemporaryCredentialsConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Config.(type) {
+	case *TemporaryCredentialsConfig_Vault:
+		if v == nil {
+			err := TemporaryCredentialsConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetVault()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TemporaryCredentialsConfigValidationError{
+						field:  "Vault",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TemporaryCredentialsConfigValidationError{
+						field:  "Vault",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetVault()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TemporaryCredentialsConfigValidationError{
+					field:  "Vault",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TemporaryCredentialsConfig_AwsIam:
+		if v == nil {
+			err := TemporaryCredentialsConfigValidationError{
 				field:  "Config",
 				reason: "oneof value cannot be a typed-nil",
 			}
-			if !all {
-				return err
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAwsIam()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TemporaryCredentialsConfigValidationError{
+						field:  "AwsIam",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TemporaryCredentialsConfigValidationError{
+						field:  "AwsIam",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAwsIam()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TemporaryCredentialsConfigValidationError{
+					field:  "AwsIam",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return TemporaryCredentialsConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// TemporaryCredentialsConfigMultiError is an error wrapping multiple
+// validation errors returned by TemporaryCredentialsConfig.ValidateAll() if
+// the designated constraints aren't met.
+type TemporaryCredentialsConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TemporaryCredentialsConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TemporaryCredentialsConfigMultiError) AllErrors() []error { return m }
+
+// TemporaryCredentialsConfigValidationError is the validation error returned
+// by TemporaryCredentialsConfig.Validate if the designated constraints aren't met.
+type TemporaryCredentialsConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TemporaryCredentialsConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TemporaryCredentialsConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TemporaryCredentialsConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TemporaryCredentialsConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TemporaryCredentialsConfigValidationError) ErrorName() string {
+	return "TemporaryCredentialsConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TemporaryCredentialsConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTemporaryCredentialsConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TemporaryCredentialsConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TemporaryCredentialsConfigValidationError{}
+
+// Validate checks the field values on VaultDatabaseCredentialsConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VaultDatabaseCredentialsConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VaultDatabaseCredentialsConfig with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// VaultDatabaseCredentialsConfigMultiError, or nil if none found.
+func (m *VaultDatabaseCredentialsConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VaultDatabaseCredentialsConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Role
+
+	if m.MountPath != nil {
+		// no validation rules for MountPath
+	}
+
+	if len(errors) > 0 {
+		return VaultDatabaseCredentialsConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// VaultDatabaseCredentialsConfigMultiError is an error wrapping multiple
+// validation errors returned by VaultDatabaseCredentialsConfig.ValidateAll()
+// if the designated constraints aren't met.
+type VaultDatabaseCredentialsConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VaultDatabaseCredentialsConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VaultDatabaseCredentialsConfigMultiError) AllErrors() []error { return m }
+
+// VaultDatabaseCredentialsConfigValidationError is the validation error
+// returned by VaultDatabaseCredentialsConfig.Validate if the designated
+// constraints aren't met.
+type VaultDatabaseCredentialsConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VaultDatabaseCredentialsConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VaultDatabaseCredentialsConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VaultDatabaseCredentialsConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VaultDatabaseCredentialsConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VaultDatabaseCredentialsConfigValidationError) ErrorName() string {
+	return "VaultDatabaseCredentialsConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VaultDatabaseCredentialsConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVaultDatabaseCredentialsConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VaultDatabaseCredentialsConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VaultDatabaseCredentialsConfigValidationError{}
+
+// Validate checks the field values on AwsIamDatabaseCredentialsConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AwsIamDatabaseCredentialsConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AwsIamDatabaseCredentialsConfig with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AwsIamDatabaseCredentialsConfigMultiError, or nil if none found.
+func (m *AwsIamDatabaseCredentialsConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AwsIamDatabaseCredentialsConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for User
+
+	// no validation rules for Region
+
+	if len(errors) > 0 {
+		return AwsIamDatabaseCredentialsConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// AwsIamDatabaseCredentialsConfigMultiError is an error wrapping multiple
+// validation errors returned by AwsIamDatabaseCredentialsConfig.ValidateAll()
+// if the designated constraints aren't met.
+type AwsIamDatabaseCredentialsConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AwsIamDatabaseCredentialsConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AwsIamDatabaseCredentialsConfigMultiError) AllErrors() []error { return m }
+
+// AwsIamDatabaseCredentialsConfigValidationError is the validation error
+// returned by AwsIamDatabaseCredentialsConfig.Validate if the designated
+// constraints aren't met.
+type AwsIamDatabaseCredentialsConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AwsIamDatabaseCredentialsConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AwsIamDatabaseCredentialsConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AwsIamDatabaseCredentialsConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AwsIamDatabaseCredentialsConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AwsIamDatabaseCredentialsConfigValidationError) ErrorName() string {
+	return "AwsIamDatabaseCredentialsConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AwsIamDatabaseCredentialsConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAwsIamDatabaseCredentialsConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AwsIamDatabaseCredentialsConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AwsIamDatabaseCredentialsConfigValidationError{}
+
+// Validate checks the field values on AwsS3ConnectionConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AwsS3ConnectionConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AwsS3ConnectionConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AwsS3ConnectionConfigMultiError, or nil if none found.
+func (m *AwsS3ConnectionConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AwsS3ConnectionConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketArn
+
+	// no validation rules for Bucket
+
+	if m.PathPrefix != nil {
+		// no validation rules for PathPrefix
+	}
+
+	if m.Credentials != nil {
+
+		if all {
+			switch v := interface{}(m.GetCredentials()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AwsS3ConnectionConfigValidationError{
+						field:  "Credentials",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AwsS3ConnectionConfigValidationError{
+						field:  "Credentials",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCredentials()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AwsS3ConnectionConfigValidationError{
+					field:  "Credentials",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Region != nil {
+		// no validation rules for Region
+	}
+
+	if m.Endpoint != nil {
+		// no validation rules for Endpoint
+	}
+
+	if m.ForcePathStyle != nil {
+		// no validation rules for ForcePathStyle
+	}
+
+	if m.InsecureSkipVerify != nil {
+		// no validation rules for InsecureSkipVerify
+	}
+
+	if len(errors) > 0 {
+		return AwsS3ConnectionConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// AwsS3ConnectionConfigMultiError is an error wrapping multiple validation
+// errors returned by AwsS3ConnectionConfig.ValidateAll() if the designated
+// constraints aren't met.
+type AwsS3ConnectionConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AwsS3ConnectionConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AwsS3ConnectionConfigMultiError) AllErrors() []error { return m }
+
+// AwsS3ConnectionConfigValidationError is the validation error returned by
+// AwsS3ConnectionConfig.Validate if the designated constraints aren't met.
+type AwsS3ConnectionConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AwsS3ConnectionConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AwsS3ConnectionConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AwsS3ConnectionConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AwsS3ConnectionConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AwsS3ConnectionConfigValidationError) ErrorName() string {
+	return "AwsS3ConnectionConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AwsS3ConnectionConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAwsS3ConnectionConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AwsS3ConnectionConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AwsS3ConnectionConfigValidationError{}
+
+// Validate checks the field values on MssqlConnectionConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MssqlConnectionConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MssqlConnectionConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MssqlConnectionConfigMultiError, or nil if none found.
+func (m *MssqlConnectionConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MssqlConnectionConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Host
+
+	// no validation rules for Port
+
+	// no validation rules for Name
+
+	// no validation rules for User
+
+	// no validation rules for Pass
+
+	// no validation rules for TrustServerCertificate
+
+	if m.Encrypt != nil {
+		// no validation rules for Encrypt
+	}
+
+	if len(errors) > 0 {
+		return MssqlConnectionConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// MssqlConnectionConfigMultiError is an error wrapping multiple validation
+// errors returned by MssqlConnectionConfig.ValidateAll() if the designated
+// constraints aren't met.
+type MssqlConnectionConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MssqlConnectionConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MssqlConnectionConfigMultiError) AllErrors() []error { return m }
+
+// MssqlConnectionConfigValidationError is the validation error returned by
+// MssqlConnectionConfig.Validate if the designated constraints aren't met.
+type MssqlConnectionConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MssqlConnectionConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MssqlConnectionConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MssqlConnectionConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MssqlConnectionConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MssqlConnectionConfigValidationError) ErrorName() string {
+	return "MssqlConnectionConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MssqlConnectionConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMssqlConnectionConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MssqlConnectionConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MssqlConnectionConfigValidationError{}
+
+// Validate checks the field values on AwsS3Credentials with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AwsS3Credentials) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AwsS3Credentials with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AwsS3CredentialsMultiError, or nil if none found.
+func (m *AwsS3Credentials) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AwsS3Credentials) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Profile != nil {
+		// no validation rules for Profile
+	}
+
+	if m.AccessKeyId != nil {
+		// no validation rules for AccessKeyId
+	}
+
+	if m.SecretAccessKey != nil {
+		// no validation rules for SecretAccessKey
+	}
+
+	if m.SessionToken != nil {
+		// no validation rules for SessionToken
+	}
+
+	if m.FromEc2Role != nil {
+		// no validation rules for FromEc2Role
+	}
+
+	if m.RoleArn != nil {
+		// no validation rules for RoleArn
+	}
+
+	if m.RoleExternalId != nil {
+		// no validation rules for RoleExternalId
+	}
+
+	if len(errors) > 0 {
+		return AwsS3CredentialsMultiError(errors)
+	}
+
+	return nil
+}
+
+// AwsS3CredentialsMultiError is an error wrapping multiple validation errors
+// returned by AwsS3Credentials.ValidateAll() if the designated constraints
+// aren't met.
+type AwsS3CredentialsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AwsS3CredentialsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AwsS3CredentialsMultiError) AllErrors() []error { return m }
+
+// AwsS3CredentialsValidationError is the validation error returned by
+// AwsS3Credentials.Validate if the designated constraints aren't met.
+type AwsS3CredentialsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AwsS3CredentialsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AwsS3CredentialsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AwsS3CredentialsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AwsS3CredentialsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AwsS3CredentialsValidationError) ErrorName() string { return "AwsS3CredentialsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AwsS3CredentialsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAwsS3Credentials.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AwsS3CredentialsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AwsS3CredentialsValidationError{}
+
+// Validate checks the field values on IsConnectionNameAvailableRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *IsConnectionNameAvailableRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IsConnectionNameAvailableRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// IsConnectionNameAvailableRequestMultiError, or nil if none found.
+func (m *IsConnectionNameAvailableRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IsConnectionNameAvailableRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	// no validation rules for ConnectionName
+
+	if len(errors) > 0 {
+		return IsConnectionNameAvailableRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IsConnectionNameAvailableRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// IsConnectionNameAvailableRequest.ValidateAll() if the designated
+// constraints aren't met.
+type IsConnectionNameAvailableRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IsConnectionNameAvailableRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IsConnectionNameAvailableRequestMultiError) AllErrors() []error { return m }
+
+// IsConnectionNameAvailableRequestValidationError is the validation error
+// returned by IsConnectionNameAvailableRequest.Validate if the designated
+// constraints aren't met.
+type IsConnectionNameAvailableRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IsConnectionNameAvailableRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IsConnectionNameAvailableRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IsConnectionNameAvailableRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IsConnectionNameAvailableRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IsConnectionNameAvailableRequestValidationError) ErrorName() string {
+	return "IsConnectionNameAvailableRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IsConnectionNameAvailableRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIsConnectionNameAvailableRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IsConnectionNameAvailableRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IsConnectionNameAvailableRequestValidationError{}
+
+// Validate checks the field values on IsConnectionNameAvailableResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *IsConnectionNameAvailableResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IsConnectionNameAvailableResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// IsConnectionNameAvailableResponseMultiError, or nil if none found.
+func (m *IsConnectionNameAvailableResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IsConnectionNameAvailableResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IsAvailable
+
+	if len(errors) > 0 {
+		return IsConnectionNameAvailableResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// IsConnectionNameAvailableResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// IsConnectionNameAvailableResponse.ValidateAll() if the designated
+// constraints aren't met.
+type IsConnectionNameAvailableResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IsConnectionNameAvailableResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IsConnectionNameAvailableResponseMultiError) AllErrors() []error { return m }
+
+// IsConnectionNameAvailableResponseValidationError is the validation error
+// returned by IsConnectionNameAvailableResponse.Validate if the designated
+// constraints aren't met.
+type IsConnectionNameAvailableResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IsConnectionNameAvailableResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IsConnectionNameAvailableResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IsConnectionNameAvailableResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IsConnectionNameAvailableResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IsConnectionNameAvailableResponseValidationError) ErrorName() string {
+	return "IsConnectionNameAvailableResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IsConnectionNameAvailableResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIsConnectionNameAvailableResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IsConnectionNameAvailableResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IsConnectionNameAvailableResponseValidationError{}
+
+// Validate checks the field values on CheckSqlQueryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckSqlQueryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckSqlQueryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckSqlQueryRequestMultiError, or nil if none found.
+func (m *CheckSqlQueryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckSqlQueryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Query
+
+	if len(errors) > 0 {
+		return CheckSqlQueryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckSqlQueryRequestMultiError is an error wrapping multiple validation
+// errors returned by CheckSqlQueryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CheckSqlQueryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckSqlQueryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckSqlQueryRequestMultiError) AllErrors() []error { return m }
+
+// CheckSqlQueryRequestValidationError is the validation error returned by
+// CheckSqlQueryRequest.Validate if the designated constraints aren't met.
+type CheckSqlQueryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckSqlQueryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckSqlQueryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckSqlQueryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckSqlQueryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckSqlQueryRequestValidationError) ErrorName() string {
+	return "CheckSqlQueryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckSqlQueryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckSqlQueryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckSqlQueryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckSqlQueryRequestValidationError{}
+
+// Validate checks the field values on CheckSqlQueryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckSqlQueryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckSqlQueryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckSqlQueryResponseMultiError, or nil if none found.
+func (m *CheckSqlQueryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckSqlQueryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IsValid
+
+	if m.ErorrMessage != nil {
+		// no validation rules for ErorrMessage
+	}
+
+	if len(errors) > 0 {
+		return CheckSqlQueryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckSqlQueryResponseMultiError is an error wrapping multiple validation
+// errors returned by CheckSqlQueryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CheckSqlQueryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckSqlQueryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckSqlQueryResponseMultiError) AllErrors() []error { return m }
+
+// CheckSqlQueryResponseValidationError is the validation error returned by
+// CheckSqlQueryResponse.Validate if the designated constraints aren't met.
+type CheckSqlQueryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckSqlQueryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckSqlQueryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckSqlQueryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckSqlQueryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckSqlQueryResponseValidationError) ErrorName() string {
+	return "CheckSqlQueryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckSqlQueryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckSqlQueryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckSqlQueryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckSqlQueryResponseValidationError{}
+
+// Validate checks the field values on SetConnectionLabelsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetConnectionLabelsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetConnectionLabelsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetConnectionLabelsRequestMultiError, or nil if none found.
+func (m *SetConnectionLabelsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetConnectionLabelsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Labels
+
+	if len(errors) > 0 {
+		return SetConnectionLabelsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetConnectionLabelsRequestMultiError is an error wrapping multiple
+// validation errors returned by SetConnectionLabelsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetConnectionLabelsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetConnectionLabelsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetConnectionLabelsRequestMultiError) AllErrors() []error { return m }
+
+// SetConnectionLabelsRequestValidationError is the validation error returned
+// by SetConnectionLabelsRequest.Validate if the designated constraints aren't met.
+type SetConnectionLabelsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetConnectionLabelsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetConnectionLabelsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetConnectionLabelsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetConnectionLabelsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetConnectionLabelsRequestValidationError) ErrorName() string {
+	return "SetConnectionLabelsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetConnectionLabelsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetConnectionLabelsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetConnectionLabelsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetConnectionLabelsRequestValidationError{}
+
+// Validate checks the field values on SetConnectionLabelsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetConnectionLabelsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetConnectionLabelsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetConnectionLabelsResponseMultiError, or nil if none found.
+func (m *SetConnectionLabelsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetConnectionLabelsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetConnection()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetConnectionLabelsResponseValidationError{
+					field:  "Connection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetConnectionLabelsResponseValidationError{
+					field:  "Connection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConnection()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetConnectionLabelsResponseValidationError{
+				field:  "Connection",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetConnectionLabelsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetConnectionLabelsResponseMultiError is an error wrapping multiple
+// validation errors returned by SetConnectionLabelsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SetConnectionLabelsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetConnectionLabelsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetConnectionLabelsResponseMultiError) AllErrors() []error { return m }
+
+// SetConnectionLabelsResponseValidationError is the validation error returned
+// by SetConnectionLabelsResponse.Validate if the designated constraints
+// aren't met.
+type SetConnectionLabelsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetConnectionLabelsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetConnectionLabelsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetConnectionLabelsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetConnectionLabelsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetConnectionLabelsResponseValidationError) ErrorName() string {
+	return "SetConnectionLabelsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetConnectionLabelsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetConnectionLabelsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetConnectionLabelsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetConnectionLabelsResponseValidationError{}
+
+// Validate checks the field values on ConnectionSavedQuery with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConnectionSavedQuery) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConnectionSavedQuery with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConnectionSavedQueryMultiError, or nil if none found.
+func (m *ConnectionSavedQuery) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConnectionSavedQuery) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ConnectionId
+
+	// no validation rules for Name
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for CreatedByUserId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConnectionSavedQueryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConnectionSavedQueryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConnectionSavedQueryValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UpdatedByUserId
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConnectionSavedQueryValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConnectionSavedQueryValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConnectionSavedQueryValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.WhereClause != nil {
+		// no validation rules for WhereClause
+	}
+
+	if len(errors) > 0 {
+		return ConnectionSavedQueryMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConnectionSavedQueryMultiError is an error wrapping multiple validation
+// errors returned by ConnectionSavedQuery.ValidateAll() if the designated
+// constraints aren't met.
+type ConnectionSavedQueryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConnectionSavedQueryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConnectionSavedQueryMultiError) AllErrors() []error { return m }
+
+// ConnectionSavedQueryValidationError is the validation error returned by
+// ConnectionSavedQuery.Validate if the designated constraints aren't met.
+type ConnectionSavedQueryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConnectionSavedQueryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConnectionSavedQueryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConnectionSavedQueryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConnectionSavedQueryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConnectionSavedQueryValidationError) ErrorName() string {
+	return "ConnectionSavedQueryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConnectionSavedQueryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConnectionSavedQuery.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConnectionSavedQueryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConnectionSavedQueryValidationError{}
+
+// Validate checks the field values on GetConnectionSavedQueriesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetConnectionSavedQueriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConnectionSavedQueriesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetConnectionSavedQueriesRequestMultiError, or nil if none found.
+func (m *GetConnectionSavedQueriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConnectionSavedQueriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConnectionId
+
+	if len(errors) > 0 {
+		return GetConnectionSavedQueriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConnectionSavedQueriesRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetConnectionSavedQueriesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetConnectionSavedQueriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConnectionSavedQueriesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConnectionSavedQueriesRequestMultiError) AllErrors() []error { return m }
+
+// GetConnectionSavedQueriesRequestValidationError is the validation error
+// returned by GetConnectionSavedQueriesRequest.Validate if the designated
+// constraints aren't met.
+type GetConnectionSavedQueriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConnectionSavedQueriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConnectionSavedQueriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConnectionSavedQueriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConnectionSavedQueriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConnectionSavedQueriesRequestValidationError) ErrorName() string {
+	return "GetConnectionSavedQueriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConnectionSavedQueriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConnectionSavedQueriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConnectionSavedQueriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConnectionSavedQueriesRequestValidationError{}
+
+// Validate checks the field values on GetConnectionSavedQueriesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetConnectionSavedQueriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConnectionSavedQueriesResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetConnectionSavedQueriesResponseMultiError, or nil if none found.
+func (m *GetConnectionSavedQueriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConnectionSavedQueriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSavedQueries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetConnectionSavedQueriesResponseValidationError{
+						field:  fmt.Sprintf("SavedQueries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetConnectionSavedQueriesResponseValidationError{
+						field:  fmt.Sprintf("SavedQueries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetConnectionSavedQueriesResponseValidationError{
+					field:  fmt.Sprintf("SavedQueries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetConnectionSavedQueriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConnectionSavedQueriesResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetConnectionSavedQueriesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetConnectionSavedQueriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConnectionSavedQueriesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConnectionSavedQueriesResponseMultiError) AllErrors() []error { return m }
+
+// GetConnectionSavedQueriesResponseValidationError is the validation error
+// returned by GetConnectionSavedQueriesResponse.Validate if the designated
+// constraints aren't met.
+type GetConnectionSavedQueriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConnectionSavedQueriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConnectionSavedQueriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConnectionSavedQueriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConnectionSavedQueriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConnectionSavedQueriesResponseValidationError) ErrorName() string {
+	return "GetConnectionSavedQueriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConnectionSavedQueriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConnectionSavedQueriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConnectionSavedQueriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConnectionSavedQueriesResponseValidationError{}
+
+// Validate checks the field values on CreateConnectionSavedQueryRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CreateConnectionSavedQueryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateConnectionSavedQueryRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CreateConnectionSavedQueryRequestMultiError, or nil if none found.
+func (m *CreateConnectionSavedQueryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateConnectionSavedQueryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConnectionId
+
+	// no validation rules for Name
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	if m.WhereClause != nil {
+		// no validation rules for WhereClause
+	}
+
+	if len(errors) > 0 {
+		return CreateConnectionSavedQueryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateConnectionSavedQueryRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// CreateConnectionSavedQueryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateConnectionSavedQueryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateConnectionSavedQueryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateConnectionSavedQueryRequestMultiError) AllErrors() []error { return m }
+
+// CreateConnectionSavedQueryRequestValidationError is the validation error
+// returned by CreateConnectionSavedQueryRequest.Validate if the designated
+// constraints aren't met.
+type CreateConnectionSavedQueryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateConnectionSavedQueryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateConnectionSavedQueryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateConnectionSavedQueryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateConnectionSavedQueryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateConnectionSavedQueryRequestValidationError) ErrorName() string {
+	return "CreateConnectionSavedQueryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateConnectionSavedQueryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateConnectionSavedQueryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateConnectionSavedQueryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateConnectionSavedQueryRequestValidationError{}
+
+// Validate checks the field values on CreateConnectionSavedQueryResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CreateConnectionSavedQueryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateConnectionSavedQueryResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CreateConnectionSavedQueryResponseMultiError, or nil if none found.
+func (m *CreateConnectionSavedQueryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateConnectionSavedQueryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSavedQuery()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateConnectionSavedQueryResponseValidationError{
+					field:  "SavedQuery",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateConnectionSavedQueryResponseValidationError{
+					field:  "SavedQuery",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			errors = append(errors, err)
 		}
+	} else if v, ok := interface{}(m.GetSavedQuery()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateConnectionSavedQueryResponseValidationError{
+				field:  "SavedQuery",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateConnectionSavedQueryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateConnectionSavedQueryResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// CreateConnectionSavedQueryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateConnectionSavedQueryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateConnectionSavedQueryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateConnectionSavedQueryResponseMultiError) AllErrors() []error { return m }
+
+// CreateConnectionSavedQueryResponseValidationError is the validation error
+// returned by CreateConnectionSavedQueryResponse.Validate if the designated
+// constraints aren't met.
+type CreateConnectionSavedQueryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateConnectionSavedQueryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateConnectionSavedQueryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateConnectionSavedQueryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateConnectionSavedQueryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateConnectionSavedQueryResponseValidationError) ErrorName() string {
+	return "CreateConnectionSavedQueryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateConnectionSavedQueryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateConnectionSavedQueryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateConnectionSavedQueryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateConnectionSavedQueryResponseValidationError{}
+
+// Validate checks the field values on UpdateConnectionSavedQueryRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdateConnectionSavedQueryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateConnectionSavedQueryRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateConnectionSavedQueryRequestMultiError, or nil if none found.
+func (m *UpdateConnectionSavedQueryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateConnectionSavedQueryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	if m.WhereClause != nil {
+		// no validation rules for WhereClause
+	}
+
+	if len(errors) > 0 {
+		return UpdateConnectionSavedQueryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateConnectionSavedQueryRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateConnectionSavedQueryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateConnectionSavedQueryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateConnectionSavedQueryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateConnectionSavedQueryRequestMultiError) AllErrors() []error { return m }
+
+// UpdateConnectionSavedQueryRequestValidationError is the validation error
+// returned by UpdateConnectionSavedQueryRequest.Validate if the designated
+// constraints aren't met.
+type UpdateConnectionSavedQueryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateConnectionSavedQueryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateConnectionSavedQueryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateConnectionSavedQueryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateConnectionSavedQueryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateConnectionSavedQueryRequestValidationError) ErrorName() string {
+	return "UpdateConnectionSavedQueryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateConnectionSavedQueryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateConnectionSavedQueryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateConnectionSavedQueryRequestValidationError{}
 
-		if all {
-			switch v := interface{}(m.GetLocalDirConfig()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ConnectionConfigValidationError{
-						field:  "LocalDirConfig",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ConnectionConfigValidationError{
-						field:  "LocalDirConfig",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateConnectionSavedQueryRequestValidationError{}
+
+// Validate checks the field values on UpdateConnectionSavedQueryResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdateConnectionSavedQueryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateConnectionSavedQueryResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateConnectionSavedQueryResponseMultiError, or nil if none found.
+func (m *UpdateConnectionSavedQueryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateConnectionSavedQueryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSavedQuery()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateConnectionSavedQueryResponseValidationError{
+					field:  "SavedQuery",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetLocalDirConfig()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ConnectionConfigValidationError{
-					field:  "LocalDirConfig",
+				errors = append(errors, UpdateConnectionSavedQueryResponseValidationError{
+					field:  "SavedQuery",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
 			}
 		}
-
-	case *ConnectionConfig_OpenaiConfig:
-		if v == nil {
-			err := ConnectionConfigValidationError{
-				field:  "Config",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
+	} else if v, ok := interface{}(m.GetSavedQuery()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateConnectionSavedQueryResponseValidationError{
+				field:  "SavedQuery",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
+	}
 
-		if all {
-			switch v := interface{}(m.GetOpenaiConfig()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ConnectionConfigValidationError{
-						field:  "OpenaiConfig",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ConnectionConfigValidationError{
-						field:  "OpenaiConfig",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetOpenaiConfig()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ConnectionConfigValidationError{
-					field:  "OpenaiConfig",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	if len(errors) > 0 {
+		return UpdateConnectionSavedQueryResponseMultiError(errors)
+	}
 
-	default:
-		_ = v // ensures v is used
+	return nil
+}
+
+// UpdateConnectionSavedQueryResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateConnectionSavedQueryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateConnectionSavedQueryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateConnectionSavedQueryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateConnectionSavedQueryResponseMultiError) AllErrors() []error { return m }
+
+// UpdateConnectionSavedQueryResponseValidationError is the validation error
+// returned by UpdateConnectionSavedQueryResponse.Validate if the designated
+// constraints aren't met.
+type UpdateConnectionSavedQueryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateConnectionSavedQueryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateConnectionSavedQueryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateConnectionSavedQueryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateConnectionSavedQueryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateConnectionSavedQueryResponseValidationError) ErrorName() string {
+	return "UpdateConnectionSavedQueryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateConnectionSavedQueryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateConnectionSavedQueryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateConnectionSavedQueryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateConnectionSavedQueryResponseValidationError{}
+
+// Validate checks the field values on DeleteConnectionSavedQueryRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *DeleteConnectionSavedQueryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteConnectionSavedQueryRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// DeleteConnectionSavedQueryRequestMultiError, or nil if none found.
+func (m *DeleteConnectionSavedQueryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteConnectionSavedQueryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteConnectionSavedQueryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteConnectionSavedQueryRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// DeleteConnectionSavedQueryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteConnectionSavedQueryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteConnectionSavedQueryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteConnectionSavedQueryRequestMultiError) AllErrors() []error { return m }
+
+// DeleteConnectionSavedQueryRequestValidationError is the validation error
+// returned by DeleteConnectionSavedQueryRequest.Validate if the designated
+// constraints aren't met.
+type DeleteConnectionSavedQueryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteConnectionSavedQueryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteConnectionSavedQueryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteConnectionSavedQueryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteConnectionSavedQueryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteConnectionSavedQueryRequestValidationError) ErrorName() string {
+	return "DeleteConnectionSavedQueryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteConnectionSavedQueryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteConnectionSavedQueryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteConnectionSavedQueryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteConnectionSavedQueryRequestValidationError{}
+
+// Validate checks the field values on DeleteConnectionSavedQueryResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *DeleteConnectionSavedQueryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteConnectionSavedQueryResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// DeleteConnectionSavedQueryResponseMultiError, or nil if none found.
+func (m *DeleteConnectionSavedQueryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteConnectionSavedQueryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
 
 	if len(errors) > 0 {
-		return ConnectionConfigMultiError(errors)
+		return DeleteConnectionSavedQueryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ConnectionConfigMultiError is an error wrapping multiple validation errors
-// returned by ConnectionConfig.ValidateAll() if the designated constraints
-// aren't met.
-type ConnectionConfigMultiError []error
+// DeleteConnectionSavedQueryResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// DeleteConnectionSavedQueryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteConnectionSavedQueryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ConnectionConfigMultiError) Error() string {
+func (m DeleteConnectionSavedQueryResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2081,11 +7438,12 @@ func (m ConnectionConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ConnectionConfigMultiError) AllErrors() []error { return m }
+func (m DeleteConnectionSavedQueryResponseMultiError) AllErrors() []error { return m }
 
-// ConnectionConfigValidationError is the validation error returned by
-// ConnectionConfig.Validate if the designated constraints aren't met.
-type ConnectionConfigValidationError struct {
+// DeleteConnectionSavedQueryResponseValidationError is the validation error
+// returned by DeleteConnectionSavedQueryResponse.Validate if the designated
+// constraints aren't met.
+type DeleteConnectionSavedQueryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2093,22 +7451,24 @@ type ConnectionConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e ConnectionConfigValidationError) Field() string { return e.field }
+func (e DeleteConnectionSavedQueryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ConnectionConfigValidationError) Reason() string { return e.reason }
+func (e DeleteConnectionSavedQueryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ConnectionConfigValidationError) Cause() error { return e.cause }
+func (e DeleteConnectionSavedQueryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ConnectionConfigValidationError) Key() bool { return e.key }
+func (e DeleteConnectionSavedQueryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ConnectionConfigValidationError) ErrorName() string { return "ConnectionConfigValidationError" }
+func (e DeleteConnectionSavedQueryResponseValidationError) ErrorName() string {
+	return "DeleteConnectionSavedQueryResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ConnectionConfigValidationError) Error() string {
+func (e DeleteConnectionSavedQueryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2120,14 +7480,14 @@ func (e ConnectionConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sConnectionConfig.%s: %s%s",
+		"invalid %sDeleteConnectionSavedQueryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ConnectionConfigValidationError{}
+var _ error = DeleteConnectionSavedQueryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2135,48 +7495,83 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ConnectionConfigValidationError{}
+} = DeleteConnectionSavedQueryResponseValidationError{}
 
-// Validate checks the field values on OpenAiConnectionConfig with the rules
+// Validate checks the field values on ConnectionTableBookmark with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *OpenAiConnectionConfig) Validate() error {
+func (m *ConnectionTableBookmark) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OpenAiConnectionConfig with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ConnectionTableBookmark with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// OpenAiConnectionConfigMultiError, or nil if none found.
-func (m *OpenAiConnectionConfig) ValidateAll() error {
+// ConnectionTableBookmarkMultiError, or nil if none found.
+func (m *ConnectionTableBookmark) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OpenAiConnectionConfig) validate(all bool) error {
+func (m *ConnectionTableBookmark) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ApiKey
+	// no validation rules for Id
 
-	// no validation rules for ApiUrl
+	// no validation rules for ConnectionId
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for CreatedByUserId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConnectionTableBookmarkValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConnectionTableBookmarkValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConnectionTableBookmarkValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return OpenAiConnectionConfigMultiError(errors)
+		return ConnectionTableBookmarkMultiError(errors)
 	}
 
 	return nil
 }
 
-// OpenAiConnectionConfigMultiError is an error wrapping multiple validation
-// errors returned by OpenAiConnectionConfig.ValidateAll() if the designated
+// ConnectionTableBookmarkMultiError is an error wrapping multiple validation
+// errors returned by ConnectionTableBookmark.ValidateAll() if the designated
 // constraints aren't met.
-type OpenAiConnectionConfigMultiError []error
+type ConnectionTableBookmarkMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OpenAiConnectionConfigMultiError) Error() string {
+func (m ConnectionTableBookmarkMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2185,11 +7580,11 @@ func (m OpenAiConnectionConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OpenAiConnectionConfigMultiError) AllErrors() []error { return m }
+func (m ConnectionTableBookmarkMultiError) AllErrors() []error { return m }
 
-// OpenAiConnectionConfigValidationError is the validation error returned by
-// OpenAiConnectionConfig.Validate if the designated constraints aren't met.
-type OpenAiConnectionConfigValidationError struct {
+// ConnectionTableBookmarkValidationError is the validation error returned by
+// ConnectionTableBookmark.Validate if the designated constraints aren't met.
+type ConnectionTableBookmarkValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2197,24 +7592,24 @@ type OpenAiConnectionConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e OpenAiConnectionConfigValidationError) Field() string { return e.field }
+func (e ConnectionTableBookmarkValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OpenAiConnectionConfigValidationError) Reason() string { return e.reason }
+func (e ConnectionTableBookmarkValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OpenAiConnectionConfigValidationError) Cause() error { return e.cause }
+func (e ConnectionTableBookmarkValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OpenAiConnectionConfigValidationError) Key() bool { return e.key }
+func (e ConnectionTableBookmarkValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OpenAiConnectionConfigValidationError) ErrorName() string {
-	return "OpenAiConnectionConfigValidationError"
+func (e ConnectionTableBookmarkValidationError) ErrorName() string {
+	return "ConnectionTableBookmarkValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e OpenAiConnectionConfigValidationError) Error() string {
+func (e ConnectionTableBookmarkValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2226,14 +7621,14 @@ func (e OpenAiConnectionConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOpenAiConnectionConfig.%s: %s%s",
+		"invalid %sConnectionTableBookmark.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OpenAiConnectionConfigValidationError{}
+var _ error = ConnectionTableBookmarkValidationError{}
 
 var _ interface {
 	Field() string
@@ -2241,46 +7636,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OpenAiConnectionConfigValidationError{}
+} = ConnectionTableBookmarkValidationError{}
 
-// Validate checks the field values on LocalDirectoryConnectionConfig with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *LocalDirectoryConnectionConfig) Validate() error {
+// Validate checks the field values on GetConnectionTableBookmarksRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetConnectionTableBookmarksRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LocalDirectoryConnectionConfig with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// LocalDirectoryConnectionConfigMultiError, or nil if none found.
-func (m *LocalDirectoryConnectionConfig) ValidateAll() error {
+// ValidateAll checks the field values on GetConnectionTableBookmarksRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetConnectionTableBookmarksRequestMultiError, or nil if none found.
+func (m *GetConnectionTableBookmarksRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LocalDirectoryConnectionConfig) validate(all bool) error {
+func (m *GetConnectionTableBookmarksRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Path
+	// no validation rules for ConnectionId
 
 	if len(errors) > 0 {
-		return LocalDirectoryConnectionConfigMultiError(errors)
+		return GetConnectionTableBookmarksRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// LocalDirectoryConnectionConfigMultiError is an error wrapping multiple
-// validation errors returned by LocalDirectoryConnectionConfig.ValidateAll()
-// if the designated constraints aren't met.
-type LocalDirectoryConnectionConfigMultiError []error
+// GetConnectionTableBookmarksRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetConnectionTableBookmarksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetConnectionTableBookmarksRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LocalDirectoryConnectionConfigMultiError) Error() string {
+func (m GetConnectionTableBookmarksRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2289,12 +7686,12 @@ func (m LocalDirectoryConnectionConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LocalDirectoryConnectionConfigMultiError) AllErrors() []error { return m }
+func (m GetConnectionTableBookmarksRequestMultiError) AllErrors() []error { return m }
 
-// LocalDirectoryConnectionConfigValidationError is the validation error
-// returned by LocalDirectoryConnectionConfig.Validate if the designated
+// GetConnectionTableBookmarksRequestValidationError is the validation error
+// returned by GetConnectionTableBookmarksRequest.Validate if the designated
 // constraints aren't met.
-type LocalDirectoryConnectionConfigValidationError struct {
+type GetConnectionTableBookmarksRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2302,24 +7699,24 @@ type LocalDirectoryConnectionConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e LocalDirectoryConnectionConfigValidationError) Field() string { return e.field }
+func (e GetConnectionTableBookmarksRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LocalDirectoryConnectionConfigValidationError) Reason() string { return e.reason }
+func (e GetConnectionTableBookmarksRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LocalDirectoryConnectionConfigValidationError) Cause() error { return e.cause }
+func (e GetConnectionTableBookmarksRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LocalDirectoryConnectionConfigValidationError) Key() bool { return e.key }
+func (e GetConnectionTableBookmarksRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LocalDirectoryConnectionConfigValidationError) ErrorName() string {
-	return "LocalDirectoryConnectionConfigValidationError"
+func (e GetConnectionTableBookmarksRequestValidationError) ErrorName() string {
+	return "GetConnectionTableBookmarksRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e LocalDirectoryConnectionConfigValidationError) Error() string {
+func (e GetConnectionTableBookmarksRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2331,14 +7728,14 @@ func (e LocalDirectoryConnectionConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLocalDirectoryConnectionConfig.%s: %s%s",
+		"invalid %sGetConnectionTableBookmarksRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LocalDirectoryConnectionConfigValidationError{}
+var _ error = GetConnectionTableBookmarksRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2346,189 +7743,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LocalDirectoryConnectionConfigValidationError{}
+} = GetConnectionTableBookmarksRequestValidationError{}
 
-// Validate checks the field values on PostgresConnectionConfig with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PostgresConnectionConfig) Validate() error {
+// Validate checks the field values on GetConnectionTableBookmarksResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetConnectionTableBookmarksResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PostgresConnectionConfig with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// PostgresConnectionConfigMultiError, or nil if none found.
-func (m *PostgresConnectionConfig) ValidateAll() error {
+// ValidateAll checks the field values on GetConnectionTableBookmarksResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetConnectionTableBookmarksResponseMultiError, or nil if none found.
+func (m *GetConnectionTableBookmarksResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PostgresConnectionConfig) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if all {
-		switch v := interface{}(m.GetTunnel()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PostgresConnectionConfigValidationError{
-					field:  "Tunnel",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PostgresConnectionConfigValidationError{
-					field:  "Tunnel",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTunnel()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PostgresConnectionConfigValidationError{
-				field:  "Tunnel",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetConnectionOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PostgresConnectionConfigValidationError{
-					field:  "ConnectionOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PostgresConnectionConfigValidationError{
-					field:  "ConnectionOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetConnectionOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PostgresConnectionConfigValidationError{
-				field:  "ConnectionOptions",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetClientTls()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PostgresConnectionConfigValidationError{
-					field:  "ClientTls",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PostgresConnectionConfigValidationError{
-					field:  "ClientTls",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetClientTls()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PostgresConnectionConfigValidationError{
-				field:  "ClientTls",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	switch v := m.ConnectionConfig.(type) {
-	case *PostgresConnectionConfig_Url:
-		if v == nil {
-			err := PostgresConnectionConfigValidationError{
-				field:  "ConnectionConfig",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for Url
-	case *PostgresConnectionConfig_Connection:
-		if v == nil {
-			err := PostgresConnectionConfigValidationError{
-				field:  "ConnectionConfig",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+func (m *GetConnectionTableBookmarksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBookmarks() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetConnection()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PostgresConnectionConfigValidationError{
-						field:  "Connection",
+					errors = append(errors, GetConnectionTableBookmarksResponseValidationError{
+						field:  fmt.Sprintf("Bookmarks[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, PostgresConnectionConfigValidationError{
-						field:  "Connection",
+					errors = append(errors, GetConnectionTableBookmarksResponseValidationError{
+						field:  fmt.Sprintf("Bookmarks[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetConnection()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return PostgresConnectionConfigValidationError{
-					field:  "Connection",
+				return GetConnectionTableBookmarksResponseValidationError{
+					field:  fmt.Sprintf("Bookmarks[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	default:
-		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return PostgresConnectionConfigMultiError(errors)
+		return GetConnectionTableBookmarksResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PostgresConnectionConfigMultiError is an error wrapping multiple validation
-// errors returned by PostgresConnectionConfig.ValidateAll() if the designated
+// GetConnectionTableBookmarksResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetConnectionTableBookmarksResponse.ValidateAll() if the designated
 // constraints aren't met.
-type PostgresConnectionConfigMultiError []error
+type GetConnectionTableBookmarksResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PostgresConnectionConfigMultiError) Error() string {
+func (m GetConnectionTableBookmarksResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2537,11 +7825,12 @@ func (m PostgresConnectionConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PostgresConnectionConfigMultiError) AllErrors() []error { return m }
+func (m GetConnectionTableBookmarksResponseMultiError) AllErrors() []error { return m }
 
-// PostgresConnectionConfigValidationError is the validation error returned by
-// PostgresConnectionConfig.Validate if the designated constraints aren't met.
-type PostgresConnectionConfigValidationError struct {
+// GetConnectionTableBookmarksResponseValidationError is the validation error
+// returned by GetConnectionTableBookmarksResponse.Validate if the designated
+// constraints aren't met.
+type GetConnectionTableBookmarksResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2549,24 +7838,24 @@ type PostgresConnectionConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e PostgresConnectionConfigValidationError) Field() string { return e.field }
+func (e GetConnectionTableBookmarksResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PostgresConnectionConfigValidationError) Reason() string { return e.reason }
+func (e GetConnectionTableBookmarksResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PostgresConnectionConfigValidationError) Cause() error { return e.cause }
+func (e GetConnectionTableBookmarksResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PostgresConnectionConfigValidationError) Key() bool { return e.key }
+func (e GetConnectionTableBookmarksResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PostgresConnectionConfigValidationError) ErrorName() string {
-	return "PostgresConnectionConfigValidationError"
+func (e GetConnectionTableBookmarksResponseValidationError) ErrorName() string {
+	return "GetConnectionTableBookmarksResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PostgresConnectionConfigValidationError) Error() string {
+func (e GetConnectionTableBookmarksResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2578,14 +7867,14 @@ func (e PostgresConnectionConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPostgresConnectionConfig.%s: %s%s",
+		"invalid %sGetConnectionTableBookmarksResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PostgresConnectionConfigValidationError{}
+var _ error = GetConnectionTableBookmarksResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2593,56 +7882,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PostgresConnectionConfigValidationError{}
+} = GetConnectionTableBookmarksResponseValidationError{}
 
-// Validate checks the field values on ClientTlsConfig with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ClientTlsConfig) Validate() error {
+// Validate checks the field values on CreateConnectionTableBookmarkRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *CreateConnectionTableBookmarkRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ClientTlsConfig with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ClientTlsConfigMultiError, or nil if none found.
-func (m *ClientTlsConfig) ValidateAll() error {
+// ValidateAll checks the field values on CreateConnectionTableBookmarkRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CreateConnectionTableBookmarkRequestMultiError, or nil if none found.
+func (m *CreateConnectionTableBookmarkRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ClientTlsConfig) validate(all bool) error {
+func (m *CreateConnectionTableBookmarkRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.RootCert != nil {
-		// no validation rules for RootCert
-	}
+	// no validation rules for ConnectionId
 
-	if m.ClientCert != nil {
-		// no validation rules for ClientCert
-	}
+	// no validation rules for Schema
 
-	if m.ClientKey != nil {
-		// no validation rules for ClientKey
-	}
+	// no validation rules for Table
 
 	if len(errors) > 0 {
-		return ClientTlsConfigMultiError(errors)
+		return CreateConnectionTableBookmarkRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ClientTlsConfigMultiError is an error wrapping multiple validation errors
-// returned by ClientTlsConfig.ValidateAll() if the designated constraints
-// aren't met.
-type ClientTlsConfigMultiError []error
+// CreateConnectionTableBookmarkRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// CreateConnectionTableBookmarkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateConnectionTableBookmarkRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ClientTlsConfigMultiError) Error() string {
+func (m CreateConnectionTableBookmarkRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2651,11 +7936,12 @@ func (m ClientTlsConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ClientTlsConfigMultiError) AllErrors() []error { return m }
+func (m CreateConnectionTableBookmarkRequestMultiError) AllErrors() []error { return m }
 
-// ClientTlsConfigValidationError is the validation error returned by
-// ClientTlsConfig.Validate if the designated constraints aren't met.
-type ClientTlsConfigValidationError struct {
+// CreateConnectionTableBookmarkRequestValidationError is the validation error
+// returned by CreateConnectionTableBookmarkRequest.Validate if the designated
+// constraints aren't met.
+type CreateConnectionTableBookmarkRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2663,22 +7949,24 @@ type ClientTlsConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e ClientTlsConfigValidationError) Field() string { return e.field }
+func (e CreateConnectionTableBookmarkRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ClientTlsConfigValidationError) Reason() string { return e.reason }
+func (e CreateConnectionTableBookmarkRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ClientTlsConfigValidationError) Cause() error { return e.cause }
+func (e CreateConnectionTableBookmarkRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ClientTlsConfigValidationError) Key() bool { return e.key }
+func (e CreateConnectionTableBookmarkRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ClientTlsConfigValidationError) ErrorName() string { return "ClientTlsConfigValidationError" }
+func (e CreateConnectionTableBookmarkRequestValidationError) ErrorName() string {
+	return "CreateConnectionTableBookmarkRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ClientTlsConfigValidationError) Error() string {
+func (e CreateConnectionTableBookmarkRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2690,14 +7978,14 @@ func (e ClientTlsConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sClientTlsConfig.%s: %s%s",
+		"invalid %sCreateConnectionTableBookmarkRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ClientTlsConfigValidationError{}
+var _ error = CreateConnectionTableBookmarkRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2705,48 +7993,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ClientTlsConfigValidationError{}
+} = CreateConnectionTableBookmarkRequestValidationError{}
 
-// Validate checks the field values on SqlConnectionOptions with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SqlConnectionOptions) Validate() error {
+// Validate checks the field values on CreateConnectionTableBookmarkResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *CreateConnectionTableBookmarkResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SqlConnectionOptions with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SqlConnectionOptionsMultiError, or nil if none found.
-func (m *SqlConnectionOptions) ValidateAll() error {
+// ValidateAll checks the field values on CreateConnectionTableBookmarkResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CreateConnectionTableBookmarkResponseMultiError, or nil if none found.
+func (m *CreateConnectionTableBookmarkResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SqlConnectionOptions) validate(all bool) error {
+func (m *CreateConnectionTableBookmarkResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.MaxConnectionLimit != nil {
-		// no validation rules for MaxConnectionLimit
+	if all {
+		switch v := interface{}(m.GetBookmark()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateConnectionTableBookmarkResponseValidationError{
+					field:  "Bookmark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateConnectionTableBookmarkResponseValidationError{
+					field:  "Bookmark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateConnectionTableBookmarkResponseValidationError{
+				field:  "Bookmark",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	if len(errors) > 0 {
-		return SqlConnectionOptionsMultiError(errors)
+		return CreateConnectionTableBookmarkResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SqlConnectionOptionsMultiError is an error wrapping multiple validation
-// errors returned by SqlConnectionOptions.ValidateAll() if the designated
+// CreateConnectionTableBookmarkResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// CreateConnectionTableBookmarkResponse.ValidateAll() if the designated
 // constraints aren't met.
-type SqlConnectionOptionsMultiError []error
+type CreateConnectionTableBookmarkResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SqlConnectionOptionsMultiError) Error() string {
+func (m CreateConnectionTableBookmarkResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2755,11 +8070,12 @@ func (m SqlConnectionOptionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SqlConnectionOptionsMultiError) AllErrors() []error { return m }
+func (m CreateConnectionTableBookmarkResponseMultiError) AllErrors() []error { return m }
 
-// SqlConnectionOptionsValidationError is the validation error returned by
-// SqlConnectionOptions.Validate if the designated constraints aren't met.
-type SqlConnectionOptionsValidationError struct {
+// CreateConnectionTableBookmarkResponseValidationError is the validation error
+// returned by CreateConnectionTableBookmarkResponse.Validate if the
+// designated constraints aren't met.
+type CreateConnectionTableBookmarkResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2767,24 +8083,24 @@ type SqlConnectionOptionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e SqlConnectionOptionsValidationError) Field() string { return e.field }
+func (e CreateConnectionTableBookmarkResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SqlConnectionOptionsValidationError) Reason() string { return e.reason }
+func (e CreateConnectionTableBookmarkResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SqlConnectionOptionsValidationError) Cause() error { return e.cause }
+func (e CreateConnectionTableBookmarkResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SqlConnectionOptionsValidationError) Key() bool { return e.key }
+func (e CreateConnectionTableBookmarkResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SqlConnectionOptionsValidationError) ErrorName() string {
-	return "SqlConnectionOptionsValidationError"
+func (e CreateConnectionTableBookmarkResponseValidationError) ErrorName() string {
+	return "CreateConnectionTableBookmarkResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SqlConnectionOptionsValidationError) Error() string {
+func (e CreateConnectionTableBookmarkResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2796,14 +8112,14 @@ func (e SqlConnectionOptionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSqlConnectionOptions.%s: %s%s",
+		"invalid %sCreateConnectionTableBookmarkResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SqlConnectionOptionsValidationError{}
+var _ error = CreateConnectionTableBookmarkResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2811,82 +8127,153 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SqlConnectionOptionsValidationError{}
+} = CreateConnectionTableBookmarkResponseValidationError{}
 
-// Validate checks the field values on SSHTunnel with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SSHTunnel) Validate() error {
+// Validate checks the field values on DeleteConnectionTableBookmarkRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *DeleteConnectionTableBookmarkRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SSHTunnel with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SSHTunnelMultiError, or nil
-// if none found.
-func (m *SSHTunnel) ValidateAll() error {
+// ValidateAll checks the field values on DeleteConnectionTableBookmarkRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// DeleteConnectionTableBookmarkRequestMultiError, or nil if none found.
+func (m *DeleteConnectionTableBookmarkRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SSHTunnel) validate(all bool) error {
+func (m *DeleteConnectionTableBookmarkRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Host
+	// no validation rules for Id
 
-	// no validation rules for Port
+	if len(errors) > 0 {
+		return DeleteConnectionTableBookmarkRequestMultiError(errors)
+	}
 
-	// no validation rules for User
+	return nil
+}
 
-	if all {
-		switch v := interface{}(m.GetAuthentication()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SSHTunnelValidationError{
-					field:  "Authentication",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SSHTunnelValidationError{
-					field:  "Authentication",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetAuthentication()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SSHTunnelValidationError{
-				field:  "Authentication",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+// DeleteConnectionTableBookmarkRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// DeleteConnectionTableBookmarkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteConnectionTableBookmarkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteConnectionTableBookmarkRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	if m.KnownHostPublicKey != nil {
-		// no validation rules for KnownHostPublicKey
+// AllErrors returns a list of validation violation errors.
+func (m DeleteConnectionTableBookmarkRequestMultiError) AllErrors() []error { return m }
+
+// DeleteConnectionTableBookmarkRequestValidationError is the validation error
+// returned by DeleteConnectionTableBookmarkRequest.Validate if the designated
+// constraints aren't met.
+type DeleteConnectionTableBookmarkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteConnectionTableBookmarkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteConnectionTableBookmarkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteConnectionTableBookmarkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteConnectionTableBookmarkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteConnectionTableBookmarkRequestValidationError) ErrorName() string {
+	return "DeleteConnectionTableBookmarkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteConnectionTableBookmarkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteConnectionTableBookmarkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteConnectionTableBookmarkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteConnectionTableBookmarkRequestValidationError{}
+
+// Validate checks the field values on DeleteConnectionTableBookmarkResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *DeleteConnectionTableBookmarkResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteConnectionTableBookmarkResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// DeleteConnectionTableBookmarkResponseMultiError, or nil if none found.
+func (m *DeleteConnectionTableBookmarkResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteConnectionTableBookmarkResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
 	if len(errors) > 0 {
-		return SSHTunnelMultiError(errors)
+		return DeleteConnectionTableBookmarkResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SSHTunnelMultiError is an error wrapping multiple validation errors returned
-// by SSHTunnel.ValidateAll() if the designated constraints aren't met.
-type SSHTunnelMultiError []error
+// DeleteConnectionTableBookmarkResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// DeleteConnectionTableBookmarkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteConnectionTableBookmarkResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SSHTunnelMultiError) Error() string {
+func (m DeleteConnectionTableBookmarkResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2895,11 +8282,12 @@ func (m SSHTunnelMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SSHTunnelMultiError) AllErrors() []error { return m }
+func (m DeleteConnectionTableBookmarkResponseMultiError) AllErrors() []error { return m }
 
-// SSHTunnelValidationError is the validation error returned by
-// SSHTunnel.Validate if the designated constraints aren't met.
-type SSHTunnelValidationError struct {
+// DeleteConnectionTableBookmarkResponseValidationError is the validation error
+// returned by DeleteConnectionTableBookmarkResponse.Validate if the
+// designated constraints aren't met.
+type DeleteConnectionTableBookmarkResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2907,22 +8295,24 @@ type SSHTunnelValidationError struct {
 }
 
 // Field function returns field value.
-func (e SSHTunnelValidationError) Field() string { return e.field }
+func (e DeleteConnectionTableBookmarkResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SSHTunnelValidationError) Reason() string { return e.reason }
+func (e DeleteConnectionTableBookmarkResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SSHTunnelValidationError) Cause() error { return e.cause }
+func (e DeleteConnectionTableBookmarkResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SSHTunnelValidationError) Key() bool { return e.key }
+func (e DeleteConnectionTableBookmarkResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SSHTunnelValidationError) ErrorName() string { return "SSHTunnelValidationError" }
+func (e DeleteConnectionTableBookmarkResponseValidationError) ErrorName() string {
+	return "DeleteConnectionTableBookmarkResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SSHTunnelValidationError) Error() string {
+func (e DeleteConnectionTableBookmarkResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2934,14 +8324,14 @@ func (e SSHTunnelValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSSHTunnel.%s: %s%s",
+		"invalid %sDeleteConnectionTableBookmarkResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SSHTunnelValidationError{}
+var _ error = DeleteConnectionTableBookmarkResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2949,131 +8339,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SSHTunnelValidationError{}
+} = DeleteConnectionTableBookmarkResponseValidationError{}
 
-// Validate checks the field values on SSHAuthentication with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *SSHAuthentication) Validate() error {
+// Validate checks the field values on SqliteConnectionConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SqliteConnectionConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SSHAuthentication with the rules
+// ValidateAll checks the field values on SqliteConnectionConfig with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SSHAuthenticationMultiError, or nil if none found.
-func (m *SSHAuthentication) ValidateAll() error {
+// SqliteConnectionConfigMultiError, or nil if none found.
+func (m *SqliteConnectionConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SSHAuthentication) validate(all bool) error {
+func (m *SqliteConnectionConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.AuthConfig.(type) {
-	case *SSHAuthentication_Passphrase:
-		if v == nil {
-			err := SSHAuthenticationValidationError{
-				field:  "AuthConfig",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetPassphrase()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SSHAuthenticationValidationError{
-						field:  "Passphrase",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SSHAuthenticationValidationError{
-						field:  "Passphrase",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetPassphrase()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SSHAuthenticationValidationError{
-					field:  "Passphrase",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *SSHAuthentication_PrivateKey:
-		if v == nil {
-			err := SSHAuthenticationValidationError{
-				field:  "AuthConfig",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetPrivateKey()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SSHAuthenticationValidationError{
-						field:  "PrivateKey",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SSHAuthenticationValidationError{
-						field:  "PrivateKey",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetPrivateKey()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SSHAuthenticationValidationError{
-					field:  "PrivateKey",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	default:
-		_ = v // ensures v is used
-	}
+	// no validation rules for Path
 
 	if len(errors) > 0 {
-		return SSHAuthenticationMultiError(errors)
+		return SqliteConnectionConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// SSHAuthenticationMultiError is an error wrapping multiple validation errors
-// returned by SSHAuthentication.ValidateAll() if the designated constraints
-// aren't met.
-type SSHAuthenticationMultiError []error
+// SqliteConnectionConfigMultiError is an error wrapping multiple validation
+// errors returned by SqliteConnectionConfig.ValidateAll() if the designated
+// constraints aren't met.
+type SqliteConnectionConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SSHAuthenticationMultiError) Error() string {
+func (m SqliteConnectionConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3082,11 +8387,11 @@ func (m SSHAuthenticationMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SSHAuthenticationMultiError) AllErrors() []error { return m }
+func (m SqliteConnectionConfigMultiError) AllErrors() []error { return m }
 
-// SSHAuthenticationValidationError is the validation error returned by
-// SSHAuthentication.Validate if the designated constraints aren't met.
-type SSHAuthenticationValidationError struct {
+// SqliteConnectionConfigValidationError is the validation error returned by
+// SqliteConnectionConfig.Validate if the designated constraints aren't met.
+type SqliteConnectionConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3094,24 +8399,24 @@ type SSHAuthenticationValidationError struct {
 }
 
 // Field function returns field value.
-func (e SSHAuthenticationValidationError) Field() string { return e.field }
+func (e SqliteConnectionConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SSHAuthenticationValidationError) Reason() string { return e.reason }
+func (e SqliteConnectionConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SSHAuthenticationValidationError) Cause() error { return e.cause }
+func (e SqliteConnectionConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SSHAuthenticationValidationError) Key() bool { return e.key }
+func (e SqliteConnectionConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SSHAuthenticationValidationError) ErrorName() string {
-	return "SSHAuthenticationValidationError"
+func (e SqliteConnectionConfigValidationError) ErrorName() string {
+	return "SqliteConnectionConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SSHAuthenticationValidationError) Error() string {
+func (e SqliteConnectionConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3123,14 +8428,14 @@ func (e SSHAuthenticationValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSSHAuthentication.%s: %s%s",
+		"invalid %sSqliteConnectionConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SSHAuthenticationValidationError{}
+var _ error = SqliteConnectionConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -3138,46 +8443,60 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SSHAuthenticationValidationError{}
+} = SqliteConnectionConfigValidationError{}
 
-// Validate checks the field values on SSHPassphrase with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SSHPassphrase) Validate() error {
+// Validate checks the field values on SnowflakeConnectionConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SnowflakeConnectionConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SSHPassphrase with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SSHPassphraseMultiError, or
-// nil if none found.
-func (m *SSHPassphrase) ValidateAll() error {
+// ValidateAll checks the field values on SnowflakeConnectionConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SnowflakeConnectionConfigMultiError, or nil if none found.
+func (m *SnowflakeConnectionConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SSHPassphrase) validate(all bool) error {
+func (m *SnowflakeConnectionConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Value
+	// no validation rules for Account
+
+	// no validation rules for User
+
+	// no validation rules for Pass
+
+	// no validation rules for Database
+
+	if m.Warehouse != nil {
+		// no validation rules for Warehouse
+	}
+
+	if m.Role != nil {
+		// no validation rules for Role
+	}
 
 	if len(errors) > 0 {
-		return SSHPassphraseMultiError(errors)
+		return SnowflakeConnectionConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// SSHPassphraseMultiError is an error wrapping multiple validation errors
-// returned by SSHPassphrase.ValidateAll() if the designated constraints
-// aren't met.
-type SSHPassphraseMultiError []error
+// SnowflakeConnectionConfigMultiError is an error wrapping multiple validation
+// errors returned by SnowflakeConnectionConfig.ValidateAll() if the
+// designated constraints aren't met.
+type SnowflakeConnectionConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SSHPassphraseMultiError) Error() string {
+func (m SnowflakeConnectionConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3186,11 +8505,11 @@ func (m SSHPassphraseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SSHPassphraseMultiError) AllErrors() []error { return m }
+func (m SnowflakeConnectionConfigMultiError) AllErrors() []error { return m }
 
-// SSHPassphraseValidationError is the validation error returned by
-// SSHPassphrase.Validate if the designated constraints aren't met.
-type SSHPassphraseValidationError struct {
+// SnowflakeConnectionConfigValidationError is the validation error returned by
+// SnowflakeConnectionConfig.Validate if the designated constraints aren't met.
+type SnowflakeConnectionConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3198,22 +8517,24 @@ type SSHPassphraseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SSHPassphraseValidationError) Field() string { return e.field }
+func (e SnowflakeConnectionConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SSHPassphraseValidationError) Reason() string { return e.reason }
+func (e SnowflakeConnectionConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SSHPassphraseValidationError) Cause() error { return e.cause }
+func (e SnowflakeConnectionConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SSHPassphraseValidationError) Key() bool { return e.key }
+func (e SnowflakeConnectionConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SSHPassphraseValidationError) ErrorName() string { return "SSHPassphraseValidationError" }
+func (e SnowflakeConnectionConfigValidationError) ErrorName() string {
+	return "SnowflakeConnectionConfigValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SSHPassphraseValidationError) Error() string {
+func (e SnowflakeConnectionConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3225,14 +8546,14 @@ func (e SSHPassphraseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSSHPassphrase.%s: %s%s",
+		"invalid %sSnowflakeConnectionConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SSHPassphraseValidationError{}
+var _ error = SnowflakeConnectionConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -3240,50 +8561,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SSHPassphraseValidationError{}
+} = SnowflakeConnectionConfigValidationError{}
 
-// Validate checks the field values on SSHPrivateKey with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SSHPrivateKey) Validate() error {
+// Validate checks the field values on BigQueryConnectionConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BigQueryConnectionConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SSHPrivateKey with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SSHPrivateKeyMultiError, or
-// nil if none found.
-func (m *SSHPrivateKey) ValidateAll() error {
+// ValidateAll checks the field values on BigQueryConnectionConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BigQueryConnectionConfigMultiError, or nil if none found.
+func (m *BigQueryConnectionConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SSHPrivateKey) validate(all bool) error {
+func (m *BigQueryConnectionConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Value
+	// no validation rules for ProjectId
 
-	if m.Passphrase != nil {
-		// no validation rules for Passphrase
+	if m.ServiceAccountKey != nil {
+		// no validation rules for ServiceAccountKey
 	}
 
 	if len(errors) > 0 {
-		return SSHPrivateKeyMultiError(errors)
+		return BigQueryConnectionConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// SSHPrivateKeyMultiError is an error wrapping multiple validation errors
-// returned by SSHPrivateKey.ValidateAll() if the designated constraints
-// aren't met.
-type SSHPrivateKeyMultiError []error
+// BigQueryConnectionConfigMultiError is an error wrapping multiple validation
+// errors returned by BigQueryConnectionConfig.ValidateAll() if the designated
+// constraints aren't met.
+type BigQueryConnectionConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SSHPrivateKeyMultiError) Error() string {
+func (m BigQueryConnectionConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3292,11 +8613,11 @@ func (m SSHPrivateKeyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SSHPrivateKeyMultiError) AllErrors() []error { return m }
+func (m BigQueryConnectionConfigMultiError) AllErrors() []error { return m }
 
-// SSHPrivateKeyValidationError is the validation error returned by
-// SSHPrivateKey.Validate if the designated constraints aren't met.
-type SSHPrivateKeyValidationError struct {
+// BigQueryConnectionConfigValidationError is the validation error returned by
+// BigQueryConnectionConfig.Validate if the designated constraints aren't met.
+type BigQueryConnectionConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3304,22 +8625,24 @@ type SSHPrivateKeyValidationError struct {
 }
 
 // Field function returns field value.
-func (e SSHPrivateKeyValidationError) Field() string { return e.field }
+func (e BigQueryConnectionConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SSHPrivateKeyValidationError) Reason() string { return e.reason }
+func (e BigQueryConnectionConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SSHPrivateKeyValidationError) Cause() error { return e.cause }
+func (e BigQueryConnectionConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SSHPrivateKeyValidationError) Key() bool { return e.key }
+func (e BigQueryConnectionConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SSHPrivateKeyValidationError) ErrorName() string { return "SSHPrivateKeyValidationError" }
+func (e BigQueryConnectionConfigValidationError) ErrorName() string {
+	return "BigQueryConnectionConfigValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SSHPrivateKeyValidationError) Error() string {
+func (e BigQueryConnectionConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3331,14 +8654,14 @@ func (e SSHPrivateKeyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSSHPrivateKey.%s: %s%s",
+		"invalid %sBigQueryConnectionConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SSHPrivateKeyValidationError{}
+var _ error = BigQueryConnectionConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -3346,24 +8669,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SSHPrivateKeyValidationError{}
+} = BigQueryConnectionConfigValidationError{}
 
-// Validate checks the field values on PostgresConnection with the rules
+// Validate checks the field values on RedshiftConnectionConfig with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PostgresConnection) Validate() error {
+func (m *RedshiftConnectionConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PostgresConnection with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RedshiftConnectionConfig with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PostgresConnectionMultiError, or nil if none found.
-func (m *PostgresConnection) ValidateAll() error {
+// RedshiftConnectionConfigMultiError, or nil if none found.
+func (m *RedshiftConnectionConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PostgresConnection) validate(all bool) error {
+func (m *RedshiftConnectionConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3385,19 +8708,19 @@ func (m *PostgresConnection) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return PostgresConnectionMultiError(errors)
+		return RedshiftConnectionConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// PostgresConnectionMultiError is an error wrapping multiple validation errors
-// returned by PostgresConnection.ValidateAll() if the designated constraints
-// aren't met.
-type PostgresConnectionMultiError []error
+// RedshiftConnectionConfigMultiError is an error wrapping multiple validation
+// errors returned by RedshiftConnectionConfig.ValidateAll() if the designated
+// constraints aren't met.
+type RedshiftConnectionConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PostgresConnectionMultiError) Error() string {
+func (m RedshiftConnectionConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3406,11 +8729,11 @@ func (m PostgresConnectionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PostgresConnectionMultiError) AllErrors() []error { return m }
+func (m RedshiftConnectionConfigMultiError) AllErrors() []error { return m }
 
-// PostgresConnectionValidationError is the validation error returned by
-// PostgresConnection.Validate if the designated constraints aren't met.
-type PostgresConnectionValidationError struct {
+// RedshiftConnectionConfigValidationError is the validation error returned by
+// RedshiftConnectionConfig.Validate if the designated constraints aren't met.
+type RedshiftConnectionConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3418,24 +8741,24 @@ type PostgresConnectionValidationError struct {
 }
 
 // Field function returns field value.
-func (e PostgresConnectionValidationError) Field() string { return e.field }
+func (e RedshiftConnectionConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PostgresConnectionValidationError) Reason() string { return e.reason }
+func (e RedshiftConnectionConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PostgresConnectionValidationError) Cause() error { return e.cause }
+func (e RedshiftConnectionConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PostgresConnectionValidationError) Key() bool { return e.key }
+func (e RedshiftConnectionConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PostgresConnectionValidationError) ErrorName() string {
-	return "PostgresConnectionValidationError"
+func (e RedshiftConnectionConfigValidationError) ErrorName() string {
+	return "RedshiftConnectionConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PostgresConnectionValidationError) Error() string {
+func (e RedshiftConnectionConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3447,14 +8770,14 @@ func (e PostgresConnectionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPostgresConnection.%s: %s%s",
+		"invalid %sRedshiftConnectionConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PostgresConnectionValidationError{}
+var _ error = RedshiftConnectionConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -3462,56 +8785,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PostgresConnectionValidationError{}
-
-// Validate checks the field values on MysqlConnection with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *MysqlConnection) Validate() error {
+} = RedshiftConnectionConfigValidationError{}
+
+// Validate checks the field values on GcpCloudStorageConnectionConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GcpCloudStorageConnectionConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MysqlConnection with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// MysqlConnectionMultiError, or nil if none found.
-func (m *MysqlConnection) ValidateAll() error {
+// ValidateAll checks the field values on GcpCloudStorageConnectionConfig with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GcpCloudStorageConnectionConfigMultiError, or nil if none found.
+func (m *GcpCloudStorageConnectionConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MysqlConnection) validate(all bool) error {
+func (m *GcpCloudStorageConnectionConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for User
-
-	// no validation rules for Pass
-
-	// no validation rules for Protocol
-
-	// no validation rules for Host
+	// no validation rules for Bucket
 
-	// no validation rules for Port
+	if m.PathPrefix != nil {
+		// no validation rules for PathPrefix
+	}
 
-	// no validation rules for Name
+	if m.ServiceAccountKey != nil {
+		// no validation rules for ServiceAccountKey
+	}
 
 	if len(errors) > 0 {
-		return MysqlConnectionMultiError(errors)
+		return GcpCloudStorageConnectionConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// MysqlConnectionMultiError is an error wrapping multiple validation errors
-// returned by MysqlConnection.ValidateAll() if the designated constraints
-// aren't met.
-type MysqlConnectionMultiError []error
+// GcpCloudStorageConnectionConfigMultiError is an error wrapping multiple
+// validation errors returned by GcpCloudStorageConnectionConfig.ValidateAll()
+// if the designated constraints aren't met.
+type GcpCloudStorageConnectionConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MysqlConnectionMultiError) Error() string {
+func (m GcpCloudStorageConnectionConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3520,11 +8841,12 @@ func (m MysqlConnectionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MysqlConnectionMultiError) AllErrors() []error { return m }
+func (m GcpCloudStorageConnectionConfigMultiError) AllErrors() []error { return m }
 
-// MysqlConnectionValidationError is the validation error returned by
-// MysqlConnection.Validate if the designated constraints aren't met.
-type MysqlConnectionValidationError struct {
+// GcpCloudStorageConnectionConfigValidationError is the validation error
+// returned by GcpCloudStorageConnectionConfig.Validate if the designated
+// constraints aren't met.
+type GcpCloudStorageConnectionConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3532,22 +8854,24 @@ type MysqlConnectionValidationError struct {
 }
 
 // Field function returns field value.
-func (e MysqlConnectionValidationError) Field() string { return e.field }
+func (e GcpCloudStorageConnectionConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MysqlConnectionValidationError) Reason() string { return e.reason }
+func (e GcpCloudStorageConnectionConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MysqlConnectionValidationError) Cause() error { return e.cause }
+func (e GcpCloudStorageConnectionConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MysqlConnectionValidationError) Key() bool { return e.key }
+func (e GcpCloudStorageConnectionConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MysqlConnectionValidationError) ErrorName() string { return "MysqlConnectionValidationError" }
+func (e GcpCloudStorageConnectionConfigValidationError) ErrorName() string {
+	return "GcpCloudStorageConnectionConfigValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e MysqlConnectionValidationError) Error() string {
+func (e GcpCloudStorageConnectionConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3559,14 +8883,14 @@ func (e MysqlConnectionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMysqlConnection.%s: %s%s",
+		"invalid %sGcpCloudStorageConnectionConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MysqlConnectionValidationError{}
+var _ error = GcpCloudStorageConnectionConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -3574,160 +8898,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MysqlConnectionValidationError{}
+} = GcpCloudStorageConnectionConfigValidationError{}
 
-// Validate checks the field values on MysqlConnectionConfig with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MysqlConnectionConfig) Validate() error {
+// Validate checks the field values on AzureBlobStorageConnectionConfig with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AzureBlobStorageConnectionConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MysqlConnectionConfig with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// MysqlConnectionConfigMultiError, or nil if none found.
-func (m *MysqlConnectionConfig) ValidateAll() error {
+// ValidateAll checks the field values on AzureBlobStorageConnectionConfig with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AzureBlobStorageConnectionConfigMultiError, or nil if none found.
+func (m *AzureBlobStorageConnectionConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MysqlConnectionConfig) validate(all bool) error {
+func (m *AzureBlobStorageConnectionConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTunnel()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MysqlConnectionConfigValidationError{
-					field:  "Tunnel",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MysqlConnectionConfigValidationError{
-					field:  "Tunnel",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTunnel()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MysqlConnectionConfigValidationError{
-				field:  "Tunnel",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for StorageAccount
 
-	if all {
-		switch v := interface{}(m.GetConnectionOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MysqlConnectionConfigValidationError{
-					field:  "ConnectionOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MysqlConnectionConfigValidationError{
-					field:  "ConnectionOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetConnectionOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MysqlConnectionConfigValidationError{
-				field:  "ConnectionOptions",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	// no validation rules for Container
+
+	if m.PathPrefix != nil {
+		// no validation rules for PathPrefix
 	}
 
-	switch v := m.ConnectionConfig.(type) {
-	case *MysqlConnectionConfig_Url:
-		if v == nil {
-			err := MysqlConnectionConfigValidationError{
-				field:  "ConnectionConfig",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for Url
-	case *MysqlConnectionConfig_Connection:
-		if v == nil {
-			err := MysqlConnectionConfigValidationError{
-				field:  "ConnectionConfig",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	if m.StorageAccessKey != nil {
+		// no validation rules for StorageAccessKey
+	}
 
-		if all {
-			switch v := interface{}(m.GetConnection()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MysqlConnectionConfigValidationError{
-						field:  "Connection",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MysqlConnectionConfigValidationError{
-						field:  "Connection",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetConnection()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MysqlConnectionConfigValidationError{
-					field:  "Connection",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	if m.SasToken != nil {
+		// no validation rules for SasToken
+	}
 
-	default:
-		_ = v // ensures v is used
+	if m.ConnectionString != nil {
+		// no validation rules for ConnectionString
 	}
 
 	if len(errors) > 0 {
-		return MysqlConnectionConfigMultiError(errors)
+		return AzureBlobStorageConnectionConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// MysqlConnectionConfigMultiError is an error wrapping multiple validation
-// errors returned by MysqlConnectionConfig.ValidateAll() if the designated
+// AzureBlobStorageConnectionConfigMultiError is an error wrapping multiple
+// validation errors returned by
+// AzureBlobStorageConnectionConfig.ValidateAll() if the designated
 // constraints aren't met.
-type MysqlConnectionConfigMultiError []error
+type AzureBlobStorageConnectionConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MysqlConnectionConfigMultiError) Error() string {
+func (m AzureBlobStorageConnectionConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3736,11 +8966,12 @@ func (m MysqlConnectionConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MysqlConnectionConfigMultiError) AllErrors() []error { return m }
+func (m AzureBlobStorageConnectionConfigMultiError) AllErrors() []error { return m }
 
-// MysqlConnectionConfigValidationError is the validation error returned by
-// MysqlConnectionConfig.Validate if the designated constraints aren't met.
-type MysqlConnectionConfigValidationError struct {
+// AzureBlobStorageConnectionConfigValidationError is the validation error
+// returned by AzureBlobStorageConnectionConfig.Validate if the designated
+// constraints aren't met.
+type AzureBlobStorageConnectionConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3748,24 +8979,24 @@ type MysqlConnectionConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e MysqlConnectionConfigValidationError) Field() string { return e.field }
+func (e AzureBlobStorageConnectionConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MysqlConnectionConfigValidationError) Reason() string { return e.reason }
+func (e AzureBlobStorageConnectionConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MysqlConnectionConfigValidationError) Cause() error { return e.cause }
+func (e AzureBlobStorageConnectionConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MysqlConnectionConfigValidationError) Key() bool { return e.key }
+func (e AzureBlobStorageConnectionConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MysqlConnectionConfigValidationError) ErrorName() string {
-	return "MysqlConnectionConfigValidationError"
+func (e AzureBlobStorageConnectionConfigValidationError) ErrorName() string {
+	return "AzureBlobStorageConnectionConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MysqlConnectionConfigValidationError) Error() string {
+func (e AzureBlobStorageConnectionConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3777,14 +9008,14 @@ func (e MysqlConnectionConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMysqlConnectionConfig.%s: %s%s",
+		"invalid %sAzureBlobStorageConnectionConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MysqlConnectionConfigValidationError{}
+var _ error = AzureBlobStorageConnectionConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -3792,63 +9023,125 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MysqlConnectionConfigValidationError{}
+} = AzureBlobStorageConnectionConfigValidationError{}
 
-// Validate checks the field values on AwsS3ConnectionConfig with the rules
+// Validate checks the field values on KafkaConnectionConfig with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AwsS3ConnectionConfig) Validate() error {
+func (m *KafkaConnectionConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AwsS3ConnectionConfig with the rules
+// ValidateAll checks the field values on KafkaConnectionConfig with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AwsS3ConnectionConfigMultiError, or nil if none found.
-func (m *AwsS3ConnectionConfig) ValidateAll() error {
+// KafkaConnectionConfigMultiError, or nil if none found.
+func (m *KafkaConnectionConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AwsS3ConnectionConfig) validate(all bool) error {
+func (m *KafkaConnectionConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BucketArn
+	// no validation rules for Topic
 
-	// no validation rules for Bucket
+	// no validation rules for SerializationFormat
+
+	if m.Sasl != nil {
+
+		if all {
+			switch v := interface{}(m.GetSasl()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, KafkaConnectionConfigValidationError{
+						field:  "Sasl",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, KafkaConnectionConfigValidationError{
+						field:  "Sasl",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSasl()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return KafkaConnectionConfigValidationError{
+					field:  "Sasl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	if m.PathPrefix != nil {
-		// no validation rules for PathPrefix
 	}
 
-	if m.Credentials != nil {
+	if m.Tls != nil {
 
 		if all {
-			switch v := interface{}(m.GetCredentials()).(type) {
+			switch v := interface{}(m.GetTls()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AwsS3ConnectionConfigValidationError{
-						field:  "Credentials",
+					errors = append(errors, KafkaConnectionConfigValidationError{
+						field:  "Tls",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, KafkaConnectionConfigValidationError{
+						field:  "Tls",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTls()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return KafkaConnectionConfigValidationError{
+					field:  "Tls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.SchemaRegistry != nil {
+
+		if all {
+			switch v := interface{}(m.GetSchemaRegistry()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, KafkaConnectionConfigValidationError{
+						field:  "SchemaRegistry",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AwsS3ConnectionConfigValidationError{
-						field:  "Credentials",
+					errors = append(errors, KafkaConnectionConfigValidationError{
+						field:  "SchemaRegistry",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetCredentials()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetSchemaRegistry()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AwsS3ConnectionConfigValidationError{
-					field:  "Credentials",
+				return KafkaConnectionConfigValidationError{
+					field:  "SchemaRegistry",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3857,28 +9150,20 @@ func (m *AwsS3ConnectionConfig) validate(all bool) error {
 
 	}
 
-	if m.Region != nil {
-		// no validation rules for Region
-	}
-
-	if m.Endpoint != nil {
-		// no validation rules for Endpoint
-	}
-
 	if len(errors) > 0 {
-		return AwsS3ConnectionConfigMultiError(errors)
+		return KafkaConnectionConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// AwsS3ConnectionConfigMultiError is an error wrapping multiple validation
-// errors returned by AwsS3ConnectionConfig.ValidateAll() if the designated
+// KafkaConnectionConfigMultiError is an error wrapping multiple validation
+// errors returned by KafkaConnectionConfig.ValidateAll() if the designated
 // constraints aren't met.
-type AwsS3ConnectionConfigMultiError []error
+type KafkaConnectionConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AwsS3ConnectionConfigMultiError) Error() string {
+func (m KafkaConnectionConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3887,11 +9172,11 @@ func (m AwsS3ConnectionConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AwsS3ConnectionConfigMultiError) AllErrors() []error { return m }
+func (m KafkaConnectionConfigMultiError) AllErrors() []error { return m }
 
-// AwsS3ConnectionConfigValidationError is the validation error returned by
-// AwsS3ConnectionConfig.Validate if the designated constraints aren't met.
-type AwsS3ConnectionConfigValidationError struct {
+// KafkaConnectionConfigValidationError is the validation error returned by
+// KafkaConnectionConfig.Validate if the designated constraints aren't met.
+type KafkaConnectionConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3899,24 +9184,24 @@ type AwsS3ConnectionConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e AwsS3ConnectionConfigValidationError) Field() string { return e.field }
+func (e KafkaConnectionConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AwsS3ConnectionConfigValidationError) Reason() string { return e.reason }
+func (e KafkaConnectionConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AwsS3ConnectionConfigValidationError) Cause() error { return e.cause }
+func (e KafkaConnectionConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AwsS3ConnectionConfigValidationError) Key() bool { return e.key }
+func (e KafkaConnectionConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AwsS3ConnectionConfigValidationError) ErrorName() string {
-	return "AwsS3ConnectionConfigValidationError"
+func (e KafkaConnectionConfigValidationError) ErrorName() string {
+	return "KafkaConnectionConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AwsS3ConnectionConfigValidationError) Error() string {
+func (e KafkaConnectionConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3928,14 +9213,14 @@ func (e AwsS3ConnectionConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAwsS3ConnectionConfig.%s: %s%s",
+		"invalid %sKafkaConnectionConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AwsS3ConnectionConfigValidationError{}
+var _ error = KafkaConnectionConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -3943,72 +9228,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AwsS3ConnectionConfigValidationError{}
+} = KafkaConnectionConfigValidationError{}
 
-// Validate checks the field values on AwsS3Credentials with the rules defined
+// Validate checks the field values on KafkaSaslConfig with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *AwsS3Credentials) Validate() error {
+func (m *KafkaSaslConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AwsS3Credentials with the rules
+// ValidateAll checks the field values on KafkaSaslConfig with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AwsS3CredentialsMultiError, or nil if none found.
-func (m *AwsS3Credentials) ValidateAll() error {
+// KafkaSaslConfigMultiError, or nil if none found.
+func (m *KafkaSaslConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AwsS3Credentials) validate(all bool) error {
+func (m *KafkaSaslConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.Profile != nil {
-		// no validation rules for Profile
-	}
-
-	if m.AccessKeyId != nil {
-		// no validation rules for AccessKeyId
-	}
-
-	if m.SecretAccessKey != nil {
-		// no validation rules for SecretAccessKey
-	}
-
-	if m.SessionToken != nil {
-		// no validation rules for SessionToken
-	}
-
-	if m.FromEc2Role != nil {
-		// no validation rules for FromEc2Role
-	}
+	// no validation rules for Mechanism
 
-	if m.RoleArn != nil {
-		// no validation rules for RoleArn
-	}
+	// no validation rules for Username
 
-	if m.RoleExternalId != nil {
-		// no validation rules for RoleExternalId
-	}
+	// no validation rules for Password
 
 	if len(errors) > 0 {
-		return AwsS3CredentialsMultiError(errors)
+		return KafkaSaslConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// AwsS3CredentialsMultiError is an error wrapping multiple validation errors
-// returned by AwsS3Credentials.ValidateAll() if the designated constraints
+// KafkaSaslConfigMultiError is an error wrapping multiple validation errors
+// returned by KafkaSaslConfig.ValidateAll() if the designated constraints
 // aren't met.
-type AwsS3CredentialsMultiError []error
+type KafkaSaslConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AwsS3CredentialsMultiError) Error() string {
+func (m KafkaSaslConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4017,11 +9280,11 @@ func (m AwsS3CredentialsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AwsS3CredentialsMultiError) AllErrors() []error { return m }
+func (m KafkaSaslConfigMultiError) AllErrors() []error { return m }
 
-// AwsS3CredentialsValidationError is the validation error returned by
-// AwsS3Credentials.Validate if the designated constraints aren't met.
-type AwsS3CredentialsValidationError struct {
+// KafkaSaslConfigValidationError is the validation error returned by
+// KafkaSaslConfig.Validate if the designated constraints aren't met.
+type KafkaSaslConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4029,22 +9292,22 @@ type AwsS3CredentialsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AwsS3CredentialsValidationError) Field() string { return e.field }
+func (e KafkaSaslConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AwsS3CredentialsValidationError) Reason() string { return e.reason }
+func (e KafkaSaslConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AwsS3CredentialsValidationError) Cause() error { return e.cause }
+func (e KafkaSaslConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AwsS3CredentialsValidationError) Key() bool { return e.key }
+func (e KafkaSaslConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AwsS3CredentialsValidationError) ErrorName() string { return "AwsS3CredentialsValidationError" }
+func (e KafkaSaslConfigValidationError) ErrorName() string { return "KafkaSaslConfigValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AwsS3CredentialsValidationError) Error() string {
+func (e KafkaSaslConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4056,14 +9319,14 @@ func (e AwsS3CredentialsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAwsS3Credentials.%s: %s%s",
+		"invalid %sKafkaSaslConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AwsS3CredentialsValidationError{}
+var _ error = KafkaSaslConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -4071,50 +9334,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AwsS3CredentialsValidationError{}
+} = KafkaSaslConfigValidationError{}
 
-// Validate checks the field values on IsConnectionNameAvailableRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *IsConnectionNameAvailableRequest) Validate() error {
+// Validate checks the field values on KafkaSchemaRegistryConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *KafkaSchemaRegistryConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IsConnectionNameAvailableRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// IsConnectionNameAvailableRequestMultiError, or nil if none found.
-func (m *IsConnectionNameAvailableRequest) ValidateAll() error {
+// ValidateAll checks the field values on KafkaSchemaRegistryConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// KafkaSchemaRegistryConfigMultiError, or nil if none found.
+func (m *KafkaSchemaRegistryConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IsConnectionNameAvailableRequest) validate(all bool) error {
+func (m *KafkaSchemaRegistryConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AccountId
+	// no validation rules for Url
 
-	// no validation rules for ConnectionName
+	if m.Username != nil {
+		// no validation rules for Username
+	}
+
+	if m.Password != nil {
+		// no validation rules for Password
+	}
+
+	if m.Subject != nil {
+		// no validation rules for Subject
+	}
 
 	if len(errors) > 0 {
-		return IsConnectionNameAvailableRequestMultiError(errors)
+		return KafkaSchemaRegistryConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// IsConnectionNameAvailableRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// IsConnectionNameAvailableRequest.ValidateAll() if the designated
-// constraints aren't met.
-type IsConnectionNameAvailableRequestMultiError []error
+// KafkaSchemaRegistryConfigMultiError is an error wrapping multiple validation
+// errors returned by KafkaSchemaRegistryConfig.ValidateAll() if the
+// designated constraints aren't met.
+type KafkaSchemaRegistryConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IsConnectionNameAvailableRequestMultiError) Error() string {
+func (m KafkaSchemaRegistryConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4123,12 +9394,11 @@ func (m IsConnectionNameAvailableRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IsConnectionNameAvailableRequestMultiError) AllErrors() []error { return m }
+func (m KafkaSchemaRegistryConfigMultiError) AllErrors() []error { return m }
 
-// IsConnectionNameAvailableRequestValidationError is the validation error
-// returned by IsConnectionNameAvailableRequest.Validate if the designated
-// constraints aren't met.
-type IsConnectionNameAvailableRequestValidationError struct {
+// KafkaSchemaRegistryConfigValidationError is the validation error returned by
+// KafkaSchemaRegistryConfig.Validate if the designated constraints aren't met.
+type KafkaSchemaRegistryConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4136,24 +9406,24 @@ type IsConnectionNameAvailableRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e IsConnectionNameAvailableRequestValidationError) Field() string { return e.field }
+func (e KafkaSchemaRegistryConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IsConnectionNameAvailableRequestValidationError) Reason() string { return e.reason }
+func (e KafkaSchemaRegistryConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IsConnectionNameAvailableRequestValidationError) Cause() error { return e.cause }
+func (e KafkaSchemaRegistryConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IsConnectionNameAvailableRequestValidationError) Key() bool { return e.key }
+func (e KafkaSchemaRegistryConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IsConnectionNameAvailableRequestValidationError) ErrorName() string {
-	return "IsConnectionNameAvailableRequestValidationError"
+func (e KafkaSchemaRegistryConfigValidationError) ErrorName() string {
+	return "KafkaSchemaRegistryConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IsConnectionNameAvailableRequestValidationError) Error() string {
+func (e KafkaSchemaRegistryConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4165,14 +9435,14 @@ func (e IsConnectionNameAvailableRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIsConnectionNameAvailableRequest.%s: %s%s",
+		"invalid %sKafkaSchemaRegistryConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IsConnectionNameAvailableRequestValidationError{}
+var _ error = KafkaSchemaRegistryConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -4180,48 +9450,89 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IsConnectionNameAvailableRequestValidationError{}
+} = KafkaSchemaRegistryConfigValidationError{}
 
-// Validate checks the field values on IsConnectionNameAvailableResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *IsConnectionNameAvailableResponse) Validate() error {
+// Validate checks the field values on ElasticsearchConnectionConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ElasticsearchConnectionConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IsConnectionNameAvailableResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// IsConnectionNameAvailableResponseMultiError, or nil if none found.
-func (m *IsConnectionNameAvailableResponse) ValidateAll() error {
+// ValidateAll checks the field values on ElasticsearchConnectionConfig with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ElasticsearchConnectionConfigMultiError, or nil if none found.
+func (m *ElasticsearchConnectionConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IsConnectionNameAvailableResponse) validate(all bool) error {
+func (m *ElasticsearchConnectionConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for IsAvailable
+	if m.Username != nil {
+		// no validation rules for Username
+	}
+
+	if m.Password != nil {
+		// no validation rules for Password
+	}
+
+	if m.ApiKey != nil {
+		// no validation rules for ApiKey
+	}
+
+	if m.Tls != nil {
+
+		if all {
+			switch v := interface{}(m.GetTls()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ElasticsearchConnectionConfigValidationError{
+						field:  "Tls",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ElasticsearchConnectionConfigValidationError{
+						field:  "Tls",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTls()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ElasticsearchConnectionConfigValidationError{
+					field:  "Tls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return IsConnectionNameAvailableResponseMultiError(errors)
+		return ElasticsearchConnectionConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// IsConnectionNameAvailableResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// IsConnectionNameAvailableResponse.ValidateAll() if the designated
-// constraints aren't met.
-type IsConnectionNameAvailableResponseMultiError []error
+// ElasticsearchConnectionConfigMultiError is an error wrapping multiple
+// validation errors returned by ElasticsearchConnectionConfig.ValidateAll()
+// if the designated constraints aren't met.
+type ElasticsearchConnectionConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IsConnectionNameAvailableResponseMultiError) Error() string {
+func (m ElasticsearchConnectionConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4230,12 +9541,12 @@ func (m IsConnectionNameAvailableResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IsConnectionNameAvailableResponseMultiError) AllErrors() []error { return m }
+func (m ElasticsearchConnectionConfigMultiError) AllErrors() []error { return m }
 
-// IsConnectionNameAvailableResponseValidationError is the validation error
-// returned by IsConnectionNameAvailableResponse.Validate if the designated
+// ElasticsearchConnectionConfigValidationError is the validation error
+// returned by ElasticsearchConnectionConfig.Validate if the designated
 // constraints aren't met.
-type IsConnectionNameAvailableResponseValidationError struct {
+type ElasticsearchConnectionConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4243,24 +9554,24 @@ type IsConnectionNameAvailableResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e IsConnectionNameAvailableResponseValidationError) Field() string { return e.field }
+func (e ElasticsearchConnectionConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IsConnectionNameAvailableResponseValidationError) Reason() string { return e.reason }
+func (e ElasticsearchConnectionConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IsConnectionNameAvailableResponseValidationError) Cause() error { return e.cause }
+func (e ElasticsearchConnectionConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IsConnectionNameAvailableResponseValidationError) Key() bool { return e.key }
+func (e ElasticsearchConnectionConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IsConnectionNameAvailableResponseValidationError) ErrorName() string {
-	return "IsConnectionNameAvailableResponseValidationError"
+func (e ElasticsearchConnectionConfigValidationError) ErrorName() string {
+	return "ElasticsearchConnectionConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IsConnectionNameAvailableResponseValidationError) Error() string {
+func (e ElasticsearchConnectionConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4272,14 +9583,14 @@ func (e IsConnectionNameAvailableResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIsConnectionNameAvailableResponse.%s: %s%s",
+		"invalid %sElasticsearchConnectionConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IsConnectionNameAvailableResponseValidationError{}
+var _ error = ElasticsearchConnectionConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -4287,48 +9598,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IsConnectionNameAvailableResponseValidationError{}
+} = ElasticsearchConnectionConfigValidationError{}
 
-// Validate checks the field values on CheckSqlQueryRequest with the rules
+// Validate checks the field values on DuckdbConnectionConfig with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CheckSqlQueryRequest) Validate() error {
+func (m *DuckdbConnectionConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckSqlQueryRequest with the rules
+// ValidateAll checks the field values on DuckdbConnectionConfig with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CheckSqlQueryRequestMultiError, or nil if none found.
-func (m *CheckSqlQueryRequest) ValidateAll() error {
+// DuckdbConnectionConfigMultiError, or nil if none found.
+func (m *DuckdbConnectionConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckSqlQueryRequest) validate(all bool) error {
+func (m *DuckdbConnectionConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if m.Path != nil {
+		// no validation rules for Path
+	}
 
-	// no validation rules for Query
+	if m.ParquetDirectory != nil {
+		// no validation rules for ParquetDirectory
+	}
 
 	if len(errors) > 0 {
-		return CheckSqlQueryRequestMultiError(errors)
+		return DuckdbConnectionConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckSqlQueryRequestMultiError is an error wrapping multiple validation
-// errors returned by CheckSqlQueryRequest.ValidateAll() if the designated
+// DuckdbConnectionConfigMultiError is an error wrapping multiple validation
+// errors returned by DuckdbConnectionConfig.ValidateAll() if the designated
 // constraints aren't met.
-type CheckSqlQueryRequestMultiError []error
+type DuckdbConnectionConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckSqlQueryRequestMultiError) Error() string {
+func (m DuckdbConnectionConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4337,11 +9652,11 @@ func (m CheckSqlQueryRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckSqlQueryRequestMultiError) AllErrors() []error { return m }
+func (m DuckdbConnectionConfigMultiError) AllErrors() []error { return m }
 
-// CheckSqlQueryRequestValidationError is the validation error returned by
-// CheckSqlQueryRequest.Validate if the designated constraints aren't met.
-type CheckSqlQueryRequestValidationError struct {
+// DuckdbConnectionConfigValidationError is the validation error returned by
+// DuckdbConnectionConfig.Validate if the designated constraints aren't met.
+type DuckdbConnectionConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4349,24 +9664,24 @@ type CheckSqlQueryRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckSqlQueryRequestValidationError) Field() string { return e.field }
+func (e DuckdbConnectionConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckSqlQueryRequestValidationError) Reason() string { return e.reason }
+func (e DuckdbConnectionConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckSqlQueryRequestValidationError) Cause() error { return e.cause }
+func (e DuckdbConnectionConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckSqlQueryRequestValidationError) Key() bool { return e.key }
+func (e DuckdbConnectionConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckSqlQueryRequestValidationError) ErrorName() string {
-	return "CheckSqlQueryRequestValidationError"
+func (e DuckdbConnectionConfigValidationError) ErrorName() string {
+	return "DuckdbConnectionConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckSqlQueryRequestValidationError) Error() string {
+func (e DuckdbConnectionConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4378,14 +9693,14 @@ func (e CheckSqlQueryRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckSqlQueryRequest.%s: %s%s",
+		"invalid %sDuckdbConnectionConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckSqlQueryRequestValidationError{}
+var _ error = DuckdbConnectionConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -4393,50 +9708,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckSqlQueryRequestValidationError{}
+} = DuckdbConnectionConfigValidationError{}
 
-// Validate checks the field values on CheckSqlQueryResponse with the rules
+// Validate checks the field values on SpannerConnectionConfig with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CheckSqlQueryResponse) Validate() error {
+func (m *SpannerConnectionConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckSqlQueryResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SpannerConnectionConfig with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CheckSqlQueryResponseMultiError, or nil if none found.
-func (m *CheckSqlQueryResponse) ValidateAll() error {
+// SpannerConnectionConfigMultiError, or nil if none found.
+func (m *SpannerConnectionConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckSqlQueryResponse) validate(all bool) error {
+func (m *SpannerConnectionConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for IsValid
+	// no validation rules for ProjectId
 
-	if m.ErorrMessage != nil {
-		// no validation rules for ErorrMessage
+	// no validation rules for InstanceId
+
+	// no validation rules for DatabaseId
+
+	if m.ServiceAccountKey != nil {
+		// no validation rules for ServiceAccountKey
+	}
+
+	if m.EmulatorHost != nil {
+		// no validation rules for EmulatorHost
 	}
 
 	if len(errors) > 0 {
-		return CheckSqlQueryResponseMultiError(errors)
+		return SpannerConnectionConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckSqlQueryResponseMultiError is an error wrapping multiple validation
-// errors returned by CheckSqlQueryResponse.ValidateAll() if the designated
+// SpannerConnectionConfigMultiError is an error wrapping multiple validation
+// errors returned by SpannerConnectionConfig.ValidateAll() if the designated
 // constraints aren't met.
-type CheckSqlQueryResponseMultiError []error
+type SpannerConnectionConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckSqlQueryResponseMultiError) Error() string {
+func (m SpannerConnectionConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4445,11 +9768,11 @@ func (m CheckSqlQueryResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckSqlQueryResponseMultiError) AllErrors() []error { return m }
+func (m SpannerConnectionConfigMultiError) AllErrors() []error { return m }
 
-// CheckSqlQueryResponseValidationError is the validation error returned by
-// CheckSqlQueryResponse.Validate if the designated constraints aren't met.
-type CheckSqlQueryResponseValidationError struct {
+// SpannerConnectionConfigValidationError is the validation error returned by
+// SpannerConnectionConfig.Validate if the designated constraints aren't met.
+type SpannerConnectionConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4457,24 +9780,24 @@ type CheckSqlQueryResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckSqlQueryResponseValidationError) Field() string { return e.field }
+func (e SpannerConnectionConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckSqlQueryResponseValidationError) Reason() string { return e.reason }
+func (e SpannerConnectionConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckSqlQueryResponseValidationError) Cause() error { return e.cause }
+func (e SpannerConnectionConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckSqlQueryResponseValidationError) Key() bool { return e.key }
+func (e SpannerConnectionConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckSqlQueryResponseValidationError) ErrorName() string {
-	return "CheckSqlQueryResponseValidationError"
+func (e SpannerConnectionConfigValidationError) ErrorName() string {
+	return "SpannerConnectionConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckSqlQueryResponseValidationError) Error() string {
+func (e SpannerConnectionConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4486,14 +9809,14 @@ func (e CheckSqlQueryResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckSqlQueryResponse.%s: %s%s",
+		"invalid %sSpannerConnectionConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckSqlQueryResponseValidationError{}
+var _ error = SpannerConnectionConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -4501,4 +9824,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckSqlQueryResponseValidationError{}
+} = SpannerConnectionConfigValidationError{}
@@ -239,6 +239,108 @@ var _ interface {
 	ErrorName() string
 } = MysqlStreamConfigValidationError{}
 
+// Validate checks the field values on MssqlStreamConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MssqlStreamConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MssqlStreamConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MssqlStreamConfigMultiError, or nil if none found.
+func (m *MssqlStreamConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MssqlStreamConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return MssqlStreamConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// MssqlStreamConfigMultiError is an error wrapping multiple validation errors
+// returned by MssqlStreamConfig.ValidateAll() if the designated constraints
+// aren't met.
+type MssqlStreamConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MssqlStreamConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MssqlStreamConfigMultiError) AllErrors() []error { return m }
+
+// MssqlStreamConfigValidationError is the validation error returned by
+// MssqlStreamConfig.Validate if the designated constraints aren't met.
+type MssqlStreamConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MssqlStreamConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MssqlStreamConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MssqlStreamConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MssqlStreamConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MssqlStreamConfigValidationError) ErrorName() string {
+	return "MssqlStreamConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MssqlStreamConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMssqlStreamConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MssqlStreamConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MssqlStreamConfigValidationError{}
+
 // Validate checks the field values on AwsS3StreamConfig with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -516,161 +618,6894 @@ func (m *ConnectionStreamConfig) validate(all bool) error {
 			}
 		}
 
-	default:
-		_ = v // ensures v is used
-	}
-
-	if len(errors) > 0 {
-		return ConnectionStreamConfigMultiError(errors)
-	}
-
-	return nil
-}
-
-// ConnectionStreamConfigMultiError is an error wrapping multiple validation
-// errors returned by ConnectionStreamConfig.ValidateAll() if the designated
-// constraints aren't met.
-type ConnectionStreamConfigMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m ConnectionStreamConfigMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m ConnectionStreamConfigMultiError) AllErrors() []error { return m }
-
-// ConnectionStreamConfigValidationError is the validation error returned by
-// ConnectionStreamConfig.Validate if the designated constraints aren't met.
-type ConnectionStreamConfigValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e ConnectionStreamConfigValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e ConnectionStreamConfigValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e ConnectionStreamConfigValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e ConnectionStreamConfigValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e ConnectionStreamConfigValidationError) ErrorName() string {
-	return "ConnectionStreamConfigValidationError"
-}
-
-// Error satisfies the builtin error interface
-func (e ConnectionStreamConfigValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
-
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sConnectionStreamConfig.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = ConnectionStreamConfigValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = ConnectionStreamConfigValidationError{}
-
-// Validate checks the field values on GetConnectionDataStreamRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetConnectionDataStreamRequest) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on GetConnectionDataStreamRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetConnectionDataStreamRequestMultiError, or nil if none found.
-func (m *GetConnectionDataStreamRequest) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *GetConnectionDataStreamRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	// no validation rules for ConnectionId
+	case *ConnectionStreamConfig_SqliteConfig:
+		if v == nil {
+			err := ConnectionStreamConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	if all {
-		switch v := interface{}(m.GetStreamConfig()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetConnectionDataStreamRequestValidationError{
-					field:  "StreamConfig",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(m.GetSqliteConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionStreamConfigValidationError{
+						field:  "SqliteConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionStreamConfigValidationError{
+						field:  "SqliteConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(m.GetSqliteConfig()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetConnectionDataStreamRequestValidationError{
-					field:  "StreamConfig",
+				return ConnectionStreamConfigValidationError{
+					field:  "SqliteConfig",
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
+				}
 			}
 		}
-	} else if v, ok := interface{}(m.GetStreamConfig()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetConnectionDataStreamRequestValidationError{
-				field:  "StreamConfig",
-				reason: "embedded message failed validation",
-				cause:  err,
+
+	case *ConnectionStreamConfig_SnowflakeConfig:
+		if v == nil {
+			err := ConnectionStreamConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
-	}
-
-	// no validation rules for Schema
-
-	// no validation rules for Table
-
-	if len(errors) > 0 {
-		return GetConnectionDataStreamRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-// GetConnectionDataStreamRequestMultiError is an error wrapping multiple
-// validation errors returned by GetConnectionDataStreamRequest.ValidateAll()
-// if the designated constraints aren't met.
-type GetConnectionDataStreamRequestMultiError []error
+
+		if all {
+			switch v := interface{}(m.GetSnowflakeConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionStreamConfigValidationError{
+						field:  "SnowflakeConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionStreamConfigValidationError{
+						field:  "SnowflakeConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSnowflakeConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionStreamConfigValidationError{
+					field:  "SnowflakeConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionStreamConfig_BigqueryConfig:
+		if v == nil {
+			err := ConnectionStreamConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetBigqueryConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionStreamConfigValidationError{
+						field:  "BigqueryConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionStreamConfigValidationError{
+						field:  "BigqueryConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBigqueryConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionStreamConfigValidationError{
+					field:  "BigqueryConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionStreamConfig_RedshiftConfig:
+		if v == nil {
+			err := ConnectionStreamConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRedshiftConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionStreamConfigValidationError{
+						field:  "RedshiftConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionStreamConfigValidationError{
+						field:  "RedshiftConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRedshiftConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionStreamConfigValidationError{
+					field:  "RedshiftConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionStreamConfig_GcpCloudstorageConfig:
+		if v == nil {
+			err := ConnectionStreamConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetGcpCloudstorageConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionStreamConfigValidationError{
+						field:  "GcpCloudstorageConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionStreamConfigValidationError{
+						field:  "GcpCloudstorageConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGcpCloudstorageConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionStreamConfigValidationError{
+					field:  "GcpCloudstorageConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionStreamConfig_AzureBlobConfig:
+		if v == nil {
+			err := ConnectionStreamConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAzureBlobConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionStreamConfigValidationError{
+						field:  "AzureBlobConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionStreamConfigValidationError{
+						field:  "AzureBlobConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAzureBlobConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionStreamConfigValidationError{
+					field:  "AzureBlobConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionStreamConfig_KafkaConfig:
+		if v == nil {
+			err := ConnectionStreamConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetKafkaConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionStreamConfigValidationError{
+						field:  "KafkaConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionStreamConfigValidationError{
+						field:  "KafkaConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetKafkaConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionStreamConfigValidationError{
+					field:  "KafkaConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionStreamConfig_ElasticsearchConfig:
+		if v == nil {
+			err := ConnectionStreamConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetElasticsearchConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionStreamConfigValidationError{
+						field:  "ElasticsearchConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionStreamConfigValidationError{
+						field:  "ElasticsearchConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetElasticsearchConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionStreamConfigValidationError{
+					field:  "ElasticsearchConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionStreamConfig_DuckdbConfig:
+		if v == nil {
+			err := ConnectionStreamConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetDuckdbConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionStreamConfigValidationError{
+						field:  "DuckdbConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionStreamConfigValidationError{
+						field:  "DuckdbConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDuckdbConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionStreamConfigValidationError{
+					field:  "DuckdbConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionStreamConfig_SpannerConfig:
+		if v == nil {
+			err := ConnectionStreamConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSpannerConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionStreamConfigValidationError{
+						field:  "SpannerConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionStreamConfigValidationError{
+						field:  "SpannerConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSpannerConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionStreamConfigValidationError{
+					field:  "SpannerConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionStreamConfig_MssqlConfig:
+		if v == nil {
+			err := ConnectionStreamConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMssqlConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionStreamConfigValidationError{
+						field:  "MssqlConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionStreamConfigValidationError{
+						field:  "MssqlConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMssqlConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionStreamConfigValidationError{
+					field:  "MssqlConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ConnectionStreamConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConnectionStreamConfigMultiError is an error wrapping multiple validation
+// errors returned by ConnectionStreamConfig.ValidateAll() if the designated
+// constraints aren't met.
+type ConnectionStreamConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConnectionStreamConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConnectionStreamConfigMultiError) AllErrors() []error { return m }
+
+// ConnectionStreamConfigValidationError is the validation error returned by
+// ConnectionStreamConfig.Validate if the designated constraints aren't met.
+type ConnectionStreamConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConnectionStreamConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConnectionStreamConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConnectionStreamConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConnectionStreamConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConnectionStreamConfigValidationError) ErrorName() string {
+	return "ConnectionStreamConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConnectionStreamConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConnectionStreamConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConnectionStreamConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConnectionStreamConfigValidationError{}
+
+// Validate checks the field values on GetConnectionDataStreamRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetConnectionDataStreamRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConnectionDataStreamRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetConnectionDataStreamRequestMultiError, or nil if none found.
+func (m *GetConnectionDataStreamRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConnectionDataStreamRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConnectionId
+
+	if all {
+		switch v := interface{}(m.GetStreamConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetConnectionDataStreamRequestValidationError{
+					field:  "StreamConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetConnectionDataStreamRequestValidationError{
+					field:  "StreamConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStreamConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetConnectionDataStreamRequestValidationError{
+				field:  "StreamConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	// no validation rules for ResumeToken
+
+	// no validation rules for RowFormat
+
+	if len(errors) > 0 {
+		return GetConnectionDataStreamRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConnectionDataStreamRequestMultiError is an error wrapping multiple
+// validation errors returned by GetConnectionDataStreamRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetConnectionDataStreamRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConnectionDataStreamRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConnectionDataStreamRequestMultiError) AllErrors() []error { return m }
+
+// GetConnectionDataStreamRequestValidationError is the validation error
+// returned by GetConnectionDataStreamRequest.Validate if the designated
+// constraints aren't met.
+type GetConnectionDataStreamRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConnectionDataStreamRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConnectionDataStreamRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConnectionDataStreamRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConnectionDataStreamRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConnectionDataStreamRequestValidationError) ErrorName() string {
+	return "GetConnectionDataStreamRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConnectionDataStreamRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConnectionDataStreamRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConnectionDataStreamRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConnectionDataStreamRequestValidationError{}
+
+// Validate checks the field values on GetConnectionDataStreamResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetConnectionDataStreamResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConnectionDataStreamResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetConnectionDataStreamResponseMultiError, or nil if none found.
+func (m *GetConnectionDataStreamResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConnectionDataStreamResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Row
+
+	{
+		sorted_keys := make([]string, len(m.GetTypedRow()))
+		i := 0
+		for key := range m.GetTypedRow() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetTypedRow()[key]
+			_ = val
+
+			// no validation rules for TypedRow[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, GetConnectionDataStreamResponseValidationError{
+							field:  fmt.Sprintf("TypedRow[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, GetConnectionDataStreamResponseValidationError{
+							field:  fmt.Sprintf("TypedRow[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return GetConnectionDataStreamResponseValidationError{
+						field:  fmt.Sprintf("TypedRow[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	for idx, item := range m.GetColumns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetConnectionDataStreamResponseValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetConnectionDataStreamResponseValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetConnectionDataStreamResponseValidationError{
+					field:  fmt.Sprintf("Columns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetConnectionDataStreamResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConnectionDataStreamResponseMultiError is an error wrapping multiple
+// validation errors returned by GetConnectionDataStreamResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetConnectionDataStreamResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConnectionDataStreamResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConnectionDataStreamResponseMultiError) AllErrors() []error { return m }
+
+// GetConnectionDataStreamResponseValidationError is the validation error
+// returned by GetConnectionDataStreamResponse.Validate if the designated
+// constraints aren't met.
+type GetConnectionDataStreamResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConnectionDataStreamResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConnectionDataStreamResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConnectionDataStreamResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConnectionDataStreamResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConnectionDataStreamResponseValidationError) ErrorName() string {
+	return "GetConnectionDataStreamResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConnectionDataStreamResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConnectionDataStreamResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConnectionDataStreamResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConnectionDataStreamResponseValidationError{}
+
+// Validate checks the field values on PostgresSchemaConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PostgresSchemaConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PostgresSchemaConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PostgresSchemaConfigMultiError, or nil if none found.
+func (m *PostgresSchemaConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PostgresSchemaConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return PostgresSchemaConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// PostgresSchemaConfigMultiError is an error wrapping multiple validation
+// errors returned by PostgresSchemaConfig.ValidateAll() if the designated
+// constraints aren't met.
+type PostgresSchemaConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PostgresSchemaConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PostgresSchemaConfigMultiError) AllErrors() []error { return m }
+
+// PostgresSchemaConfigValidationError is the validation error returned by
+// PostgresSchemaConfig.Validate if the designated constraints aren't met.
+type PostgresSchemaConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PostgresSchemaConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PostgresSchemaConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PostgresSchemaConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PostgresSchemaConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PostgresSchemaConfigValidationError) ErrorName() string {
+	return "PostgresSchemaConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PostgresSchemaConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPostgresSchemaConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PostgresSchemaConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PostgresSchemaConfigValidationError{}
+
+// Validate checks the field values on MysqlSchemaConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MysqlSchemaConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MysqlSchemaConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MysqlSchemaConfigMultiError, or nil if none found.
+func (m *MysqlSchemaConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MysqlSchemaConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return MysqlSchemaConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// MysqlSchemaConfigMultiError is an error wrapping multiple validation errors
+// returned by MysqlSchemaConfig.ValidateAll() if the designated constraints
+// aren't met.
+type MysqlSchemaConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MysqlSchemaConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MysqlSchemaConfigMultiError) AllErrors() []error { return m }
+
+// MysqlSchemaConfigValidationError is the validation error returned by
+// MysqlSchemaConfig.Validate if the designated constraints aren't met.
+type MysqlSchemaConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MysqlSchemaConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MysqlSchemaConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MysqlSchemaConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MysqlSchemaConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MysqlSchemaConfigValidationError) ErrorName() string {
+	return "MysqlSchemaConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MysqlSchemaConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMysqlSchemaConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MysqlSchemaConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MysqlSchemaConfigValidationError{}
+
+// Validate checks the field values on MssqlSchemaConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MssqlSchemaConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MssqlSchemaConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MssqlSchemaConfigMultiError, or nil if none found.
+func (m *MssqlSchemaConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MssqlSchemaConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return MssqlSchemaConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// MssqlSchemaConfigMultiError is an error wrapping multiple validation errors
+// returned by MssqlSchemaConfig.ValidateAll() if the designated constraints
+// aren't met.
+type MssqlSchemaConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MssqlSchemaConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MssqlSchemaConfigMultiError) AllErrors() []error { return m }
+
+// MssqlSchemaConfigValidationError is the validation error returned by
+// MssqlSchemaConfig.Validate if the designated constraints aren't met.
+type MssqlSchemaConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MssqlSchemaConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MssqlSchemaConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MssqlSchemaConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MssqlSchemaConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MssqlSchemaConfigValidationError) ErrorName() string {
+	return "MssqlSchemaConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MssqlSchemaConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMssqlSchemaConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MssqlSchemaConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MssqlSchemaConfigValidationError{}
+
+// Validate checks the field values on AwsS3SchemaConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AwsS3SchemaConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AwsS3SchemaConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AwsS3SchemaConfigMultiError, or nil if none found.
+func (m *AwsS3SchemaConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AwsS3SchemaConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Id.(type) {
+	case *AwsS3SchemaConfig_JobId:
+		if v == nil {
+			err := AwsS3SchemaConfigValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for JobId
+	case *AwsS3SchemaConfig_JobRunId:
+		if v == nil {
+			err := AwsS3SchemaConfigValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for JobRunId
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return AwsS3SchemaConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// AwsS3SchemaConfigMultiError is an error wrapping multiple validation errors
+// returned by AwsS3SchemaConfig.ValidateAll() if the designated constraints
+// aren't met.
+type AwsS3SchemaConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AwsS3SchemaConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AwsS3SchemaConfigMultiError) AllErrors() []error { return m }
+
+// AwsS3SchemaConfigValidationError is the validation error returned by
+// AwsS3SchemaConfig.Validate if the designated constraints aren't met.
+type AwsS3SchemaConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AwsS3SchemaConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AwsS3SchemaConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AwsS3SchemaConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AwsS3SchemaConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AwsS3SchemaConfigValidationError) ErrorName() string {
+	return "AwsS3SchemaConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AwsS3SchemaConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAwsS3SchemaConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AwsS3SchemaConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AwsS3SchemaConfigValidationError{}
+
+// Validate checks the field values on ConnectionSchemaConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConnectionSchemaConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConnectionSchemaConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConnectionSchemaConfigMultiError, or nil if none found.
+func (m *ConnectionSchemaConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConnectionSchemaConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Config.(type) {
+	case *ConnectionSchemaConfig_PgConfig:
+		if v == nil {
+			err := ConnectionSchemaConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPgConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "PgConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "PgConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPgConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionSchemaConfigValidationError{
+					field:  "PgConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionSchemaConfig_AwsS3Config:
+		if v == nil {
+			err := ConnectionSchemaConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAwsS3Config()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "AwsS3Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "AwsS3Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAwsS3Config()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionSchemaConfigValidationError{
+					field:  "AwsS3Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionSchemaConfig_MysqlConfig:
+		if v == nil {
+			err := ConnectionSchemaConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMysqlConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "MysqlConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "MysqlConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMysqlConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionSchemaConfigValidationError{
+					field:  "MysqlConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionSchemaConfig_SqliteConfig:
+		if v == nil {
+			err := ConnectionSchemaConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSqliteConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "SqliteConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "SqliteConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSqliteConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionSchemaConfigValidationError{
+					field:  "SqliteConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionSchemaConfig_SnowflakeConfig:
+		if v == nil {
+			err := ConnectionSchemaConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSnowflakeConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "SnowflakeConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "SnowflakeConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSnowflakeConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionSchemaConfigValidationError{
+					field:  "SnowflakeConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionSchemaConfig_BigqueryConfig:
+		if v == nil {
+			err := ConnectionSchemaConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetBigqueryConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "BigqueryConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "BigqueryConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBigqueryConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionSchemaConfigValidationError{
+					field:  "BigqueryConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionSchemaConfig_RedshiftConfig:
+		if v == nil {
+			err := ConnectionSchemaConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRedshiftConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "RedshiftConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "RedshiftConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRedshiftConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionSchemaConfigValidationError{
+					field:  "RedshiftConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionSchemaConfig_GcpCloudstorageConfig:
+		if v == nil {
+			err := ConnectionSchemaConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetGcpCloudstorageConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "GcpCloudstorageConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "GcpCloudstorageConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGcpCloudstorageConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionSchemaConfigValidationError{
+					field:  "GcpCloudstorageConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionSchemaConfig_AzureBlobConfig:
+		if v == nil {
+			err := ConnectionSchemaConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAzureBlobConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "AzureBlobConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "AzureBlobConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAzureBlobConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionSchemaConfigValidationError{
+					field:  "AzureBlobConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionSchemaConfig_ElasticsearchConfig:
+		if v == nil {
+			err := ConnectionSchemaConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetElasticsearchConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "ElasticsearchConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "ElasticsearchConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetElasticsearchConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionSchemaConfigValidationError{
+					field:  "ElasticsearchConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionSchemaConfig_DuckdbConfig:
+		if v == nil {
+			err := ConnectionSchemaConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetDuckdbConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "DuckdbConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "DuckdbConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDuckdbConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionSchemaConfigValidationError{
+					field:  "DuckdbConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionSchemaConfig_SpannerConfig:
+		if v == nil {
+			err := ConnectionSchemaConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSpannerConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "SpannerConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "SpannerConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSpannerConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionSchemaConfigValidationError{
+					field:  "SpannerConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionSchemaConfig_MssqlConfig:
+		if v == nil {
+			err := ConnectionSchemaConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMssqlConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "MssqlConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "MssqlConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMssqlConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionSchemaConfigValidationError{
+					field:  "MssqlConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ConnectionSchemaConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConnectionSchemaConfigMultiError is an error wrapping multiple validation
+// errors returned by ConnectionSchemaConfig.ValidateAll() if the designated
+// constraints aren't met.
+type ConnectionSchemaConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConnectionSchemaConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConnectionSchemaConfigMultiError) AllErrors() []error { return m }
+
+// ConnectionSchemaConfigValidationError is the validation error returned by
+// ConnectionSchemaConfig.Validate if the designated constraints aren't met.
+type ConnectionSchemaConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConnectionSchemaConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConnectionSchemaConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConnectionSchemaConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConnectionSchemaConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConnectionSchemaConfigValidationError) ErrorName() string {
+	return "ConnectionSchemaConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConnectionSchemaConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConnectionSchemaConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConnectionSchemaConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConnectionSchemaConfigValidationError{}
+
+// Validate checks the field values on DatabaseColumn with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DatabaseColumn) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DatabaseColumn with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DatabaseColumnMultiError,
+// or nil if none found.
+func (m *DatabaseColumn) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DatabaseColumn) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for Column
+
+	// no validation rules for DataType
+
+	// no validation rules for IsNullable
+
+	// no validation rules for TableType
+
+	if m.ColumnDefault != nil {
+		// no validation rules for ColumnDefault
+	}
+
+	if m.GeneratedType != nil {
+		// no validation rules for GeneratedType
+	}
+
+	if m.BaseDataType != nil {
+		// no validation rules for BaseDataType
+	}
+
+	if len(errors) > 0 {
+		return DatabaseColumnMultiError(errors)
+	}
+
+	return nil
+}
+
+// DatabaseColumnMultiError is an error wrapping multiple validation errors
+// returned by DatabaseColumn.ValidateAll() if the designated constraints
+// aren't met.
+type DatabaseColumnMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DatabaseColumnMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DatabaseColumnMultiError) AllErrors() []error { return m }
+
+// DatabaseColumnValidationError is the validation error returned by
+// DatabaseColumn.Validate if the designated constraints aren't met.
+type DatabaseColumnValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DatabaseColumnValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DatabaseColumnValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DatabaseColumnValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DatabaseColumnValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DatabaseColumnValidationError) ErrorName() string { return "DatabaseColumnValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DatabaseColumnValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDatabaseColumn.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DatabaseColumnValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DatabaseColumnValidationError{}
+
+// Validate checks the field values on GetConnectionSchemaRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetConnectionSchemaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConnectionSchemaRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetConnectionSchemaRequestMultiError, or nil if none found.
+func (m *GetConnectionSchemaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConnectionSchemaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConnectionId
+
+	if all {
+		switch v := interface{}(m.GetSchemaConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetConnectionSchemaRequestValidationError{
+					field:  "SchemaConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetConnectionSchemaRequestValidationError{
+					field:  "SchemaConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchemaConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetConnectionSchemaRequestValidationError{
+				field:  "SchemaConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetConnectionSchemaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConnectionSchemaRequestMultiError is an error wrapping multiple
+// validation errors returned by GetConnectionSchemaRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetConnectionSchemaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConnectionSchemaRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConnectionSchemaRequestMultiError) AllErrors() []error { return m }
+
+// GetConnectionSchemaRequestValidationError is the validation error returned
+// by GetConnectionSchemaRequest.Validate if the designated constraints aren't met.
+type GetConnectionSchemaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConnectionSchemaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConnectionSchemaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConnectionSchemaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConnectionSchemaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConnectionSchemaRequestValidationError) ErrorName() string {
+	return "GetConnectionSchemaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConnectionSchemaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConnectionSchemaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConnectionSchemaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConnectionSchemaRequestValidationError{}
+
+// Validate checks the field values on GetConnectionSchemaResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetConnectionSchemaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConnectionSchemaResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetConnectionSchemaResponseMultiError, or nil if none found.
+func (m *GetConnectionSchemaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConnectionSchemaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSchemas() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetConnectionSchemaResponseValidationError{
+						field:  fmt.Sprintf("Schemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetConnectionSchemaResponseValidationError{
+						field:  fmt.Sprintf("Schemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetConnectionSchemaResponseValidationError{
+					field:  fmt.Sprintf("Schemas[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetConnectionSchemaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConnectionSchemaResponseMultiError is an error wrapping multiple
+// validation errors returned by GetConnectionSchemaResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetConnectionSchemaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConnectionSchemaResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConnectionSchemaResponseMultiError) AllErrors() []error { return m }
+
+// GetConnectionSchemaResponseValidationError is the validation error returned
+// by GetConnectionSchemaResponse.Validate if the designated constraints
+// aren't met.
+type GetConnectionSchemaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConnectionSchemaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConnectionSchemaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConnectionSchemaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConnectionSchemaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConnectionSchemaResponseValidationError) ErrorName() string {
+	return "GetConnectionSchemaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConnectionSchemaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConnectionSchemaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConnectionSchemaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConnectionSchemaResponseValidationError{}
+
+// Validate checks the field values on GetConnectionForeignConstraintsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetConnectionForeignConstraintsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// GetConnectionForeignConstraintsRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// GetConnectionForeignConstraintsRequestMultiError, or nil if none found.
+func (m *GetConnectionForeignConstraintsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConnectionForeignConstraintsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConnectionId
+
+	if len(errors) > 0 {
+		return GetConnectionForeignConstraintsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConnectionForeignConstraintsRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// GetConnectionForeignConstraintsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetConnectionForeignConstraintsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConnectionForeignConstraintsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConnectionForeignConstraintsRequestMultiError) AllErrors() []error { return m }
+
+// GetConnectionForeignConstraintsRequestValidationError is the validation
+// error returned by GetConnectionForeignConstraintsRequest.Validate if the
+// designated constraints aren't met.
+type GetConnectionForeignConstraintsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConnectionForeignConstraintsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConnectionForeignConstraintsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConnectionForeignConstraintsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConnectionForeignConstraintsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConnectionForeignConstraintsRequestValidationError) ErrorName() string {
+	return "GetConnectionForeignConstraintsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConnectionForeignConstraintsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConnectionForeignConstraintsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConnectionForeignConstraintsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConnectionForeignConstraintsRequestValidationError{}
+
+// Validate checks the field values on ForeignKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ForeignKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ForeignKey with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ForeignKeyMultiError, or
+// nil if none found.
+func (m *ForeignKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ForeignKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Table
+
+	// no validation rules for Column
+
+	if all {
+		switch v := interface{}(m.GetTableIdentifier()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ForeignKeyValidationError{
+					field:  "TableIdentifier",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ForeignKeyValidationError{
+					field:  "TableIdentifier",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTableIdentifier()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ForeignKeyValidationError{
+				field:  "TableIdentifier",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ForeignKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ForeignKeyMultiError is an error wrapping multiple validation errors
+// returned by ForeignKey.ValidateAll() if the designated constraints aren't met.
+type ForeignKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ForeignKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ForeignKeyMultiError) AllErrors() []error { return m }
+
+// ForeignKeyValidationError is the validation error returned by
+// ForeignKey.Validate if the designated constraints aren't met.
+type ForeignKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForeignKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForeignKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForeignKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForeignKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForeignKeyValidationError) ErrorName() string { return "ForeignKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ForeignKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForeignKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForeignKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForeignKeyValidationError{}
+
+// Validate checks the field values on ForeignConstraint with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ForeignConstraint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ForeignConstraint with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ForeignConstraintMultiError, or nil if none found.
+func (m *ForeignConstraint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ForeignConstraint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Column
+
+	// no validation rules for IsNullable
+
+	if all {
+		switch v := interface{}(m.GetForeignKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ForeignConstraintValidationError{
+					field:  "ForeignKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ForeignConstraintValidationError{
+					field:  "ForeignKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetForeignKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ForeignConstraintValidationError{
+				field:  "ForeignKey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ForeignConstraintMultiError(errors)
+	}
+
+	return nil
+}
+
+// ForeignConstraintMultiError is an error wrapping multiple validation errors
+// returned by ForeignConstraint.ValidateAll() if the designated constraints
+// aren't met.
+type ForeignConstraintMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ForeignConstraintMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ForeignConstraintMultiError) AllErrors() []error { return m }
+
+// ForeignConstraintValidationError is the validation error returned by
+// ForeignConstraint.Validate if the designated constraints aren't met.
+type ForeignConstraintValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForeignConstraintValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForeignConstraintValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForeignConstraintValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForeignConstraintValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForeignConstraintValidationError) ErrorName() string {
+	return "ForeignConstraintValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ForeignConstraintValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForeignConstraint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForeignConstraintValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForeignConstraintValidationError{}
+
+// Validate checks the field values on ForeignConstraintTables with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ForeignConstraintTables) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ForeignConstraintTables with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ForeignConstraintTablesMultiError, or nil if none found.
+func (m *ForeignConstraintTables) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ForeignConstraintTables) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetConstraints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ForeignConstraintTablesValidationError{
+						field:  fmt.Sprintf("Constraints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ForeignConstraintTablesValidationError{
+						field:  fmt.Sprintf("Constraints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ForeignConstraintTablesValidationError{
+					field:  fmt.Sprintf("Constraints[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ForeignConstraintTablesMultiError(errors)
+	}
+
+	return nil
+}
+
+// ForeignConstraintTablesMultiError is an error wrapping multiple validation
+// errors returned by ForeignConstraintTables.ValidateAll() if the designated
+// constraints aren't met.
+type ForeignConstraintTablesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ForeignConstraintTablesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ForeignConstraintTablesMultiError) AllErrors() []error { return m }
+
+// ForeignConstraintTablesValidationError is the validation error returned by
+// ForeignConstraintTables.Validate if the designated constraints aren't met.
+type ForeignConstraintTablesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForeignConstraintTablesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForeignConstraintTablesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForeignConstraintTablesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForeignConstraintTablesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForeignConstraintTablesValidationError) ErrorName() string {
+	return "ForeignConstraintTablesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ForeignConstraintTablesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForeignConstraintTables.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForeignConstraintTablesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForeignConstraintTablesValidationError{}
+
+// Validate checks the field values on GetConnectionForeignConstraintsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetConnectionForeignConstraintsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// GetConnectionForeignConstraintsResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// GetConnectionForeignConstraintsResponseMultiError, or nil if none found.
+func (m *GetConnectionForeignConstraintsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConnectionForeignConstraintsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	{
+		sorted_keys := make([]string, len(m.GetTableConstraints()))
+		i := 0
+		for key := range m.GetTableConstraints() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetTableConstraints()[key]
+			_ = val
+
+			// no validation rules for TableConstraints[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, GetConnectionForeignConstraintsResponseValidationError{
+							field:  fmt.Sprintf("TableConstraints[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, GetConnectionForeignConstraintsResponseValidationError{
+							field:  fmt.Sprintf("TableConstraints[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return GetConnectionForeignConstraintsResponseValidationError{
+						field:  fmt.Sprintf("TableConstraints[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	for idx, item := range m.GetTables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetConnectionForeignConstraintsResponseValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetConnectionForeignConstraintsResponseValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetConnectionForeignConstraintsResponseValidationError{
+					field:  fmt.Sprintf("Tables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetConnectionForeignConstraintsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConnectionForeignConstraintsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// GetConnectionForeignConstraintsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetConnectionForeignConstraintsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConnectionForeignConstraintsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConnectionForeignConstraintsResponseMultiError) AllErrors() []error { return m }
+
+// GetConnectionForeignConstraintsResponseValidationError is the validation
+// error returned by GetConnectionForeignConstraintsResponse.Validate if the
+// designated constraints aren't met.
+type GetConnectionForeignConstraintsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConnectionForeignConstraintsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConnectionForeignConstraintsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConnectionForeignConstraintsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConnectionForeignConstraintsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConnectionForeignConstraintsResponseValidationError) ErrorName() string {
+	return "GetConnectionForeignConstraintsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConnectionForeignConstraintsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConnectionForeignConstraintsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConnectionForeignConstraintsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConnectionForeignConstraintsResponseValidationError{}
+
+// Validate checks the field values on InitStatementOptions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InitStatementOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InitStatementOptions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InitStatementOptionsMultiError, or nil if none found.
+func (m *InitStatementOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InitStatementOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InitSchema
+
+	// no validation rules for TruncateBeforeInsert
+
+	// no validation rules for TruncateCascade
+
+	// no validation rules for InitExtensions
+
+	if m.MysqlCharsetOverride != nil {
+
+		if all {
+			switch v := interface{}(m.GetMysqlCharsetOverride()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InitStatementOptionsValidationError{
+						field:  "MysqlCharsetOverride",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InitStatementOptionsValidationError{
+						field:  "MysqlCharsetOverride",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMysqlCharsetOverride()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InitStatementOptionsValidationError{
+					field:  "MysqlCharsetOverride",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InitStatementOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// InitStatementOptionsMultiError is an error wrapping multiple validation
+// errors returned by InitStatementOptions.ValidateAll() if the designated
+// constraints aren't met.
+type InitStatementOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InitStatementOptionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InitStatementOptionsMultiError) AllErrors() []error { return m }
+
+// InitStatementOptionsValidationError is the validation error returned by
+// InitStatementOptions.Validate if the designated constraints aren't met.
+type InitStatementOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InitStatementOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InitStatementOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InitStatementOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InitStatementOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InitStatementOptionsValidationError) ErrorName() string {
+	return "InitStatementOptionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InitStatementOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInitStatementOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InitStatementOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InitStatementOptionsValidationError{}
+
+// Validate checks the field values on GetConnectionInitStatementsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetConnectionInitStatementsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConnectionInitStatementsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetConnectionInitStatementsRequestMultiError, or nil if none found.
+func (m *GetConnectionInitStatementsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConnectionInitStatementsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConnectionId
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetConnectionInitStatementsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetConnectionInitStatementsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetConnectionInitStatementsRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetConnectionInitStatementsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConnectionInitStatementsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetConnectionInitStatementsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetConnectionInitStatementsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConnectionInitStatementsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConnectionInitStatementsRequestMultiError) AllErrors() []error { return m }
+
+// GetConnectionInitStatementsRequestValidationError is the validation error
+// returned by GetConnectionInitStatementsRequest.Validate if the designated
+// constraints aren't met.
+type GetConnectionInitStatementsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConnectionInitStatementsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConnectionInitStatementsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConnectionInitStatementsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConnectionInitStatementsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConnectionInitStatementsRequestValidationError) ErrorName() string {
+	return "GetConnectionInitStatementsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConnectionInitStatementsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConnectionInitStatementsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConnectionInitStatementsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConnectionInitStatementsRequestValidationError{}
+
+// Validate checks the field values on GetConnectionInitStatementsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetConnectionInitStatementsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConnectionInitStatementsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetConnectionInitStatementsResponseMultiError, or nil if none found.
+func (m *GetConnectionInitStatementsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConnectionInitStatementsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TableInitStatements
+
+	// no validation rules for TableTruncateStatements
+
+	for idx, item := range m.GetTables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetConnectionInitStatementsResponseValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetConnectionInitStatementsResponseValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetConnectionInitStatementsResponseValidationError{
+					field:  fmt.Sprintf("Tables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetConnectionInitStatementsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConnectionInitStatementsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetConnectionInitStatementsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetConnectionInitStatementsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConnectionInitStatementsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConnectionInitStatementsResponseMultiError) AllErrors() []error { return m }
+
+// GetConnectionInitStatementsResponseValidationError is the validation error
+// returned by GetConnectionInitStatementsResponse.Validate if the designated
+// constraints aren't met.
+type GetConnectionInitStatementsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConnectionInitStatementsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConnectionInitStatementsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConnectionInitStatementsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConnectionInitStatementsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConnectionInitStatementsResponseValidationError) ErrorName() string {
+	return "GetConnectionInitStatementsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConnectionInitStatementsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConnectionInitStatementsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConnectionInitStatementsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConnectionInitStatementsResponseValidationError{}
+
+// Validate checks the field values on PrimaryConstraint with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PrimaryConstraint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PrimaryConstraint with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PrimaryConstraintMultiError, or nil if none found.
+func (m *PrimaryConstraint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PrimaryConstraint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return PrimaryConstraintMultiError(errors)
+	}
+
+	return nil
+}
+
+// PrimaryConstraintMultiError is an error wrapping multiple validation errors
+// returned by PrimaryConstraint.ValidateAll() if the designated constraints
+// aren't met.
+type PrimaryConstraintMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PrimaryConstraintMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PrimaryConstraintMultiError) AllErrors() []error { return m }
+
+// PrimaryConstraintValidationError is the validation error returned by
+// PrimaryConstraint.Validate if the designated constraints aren't met.
+type PrimaryConstraintValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PrimaryConstraintValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PrimaryConstraintValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PrimaryConstraintValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PrimaryConstraintValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PrimaryConstraintValidationError) ErrorName() string {
+	return "PrimaryConstraintValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PrimaryConstraintValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPrimaryConstraint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PrimaryConstraintValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PrimaryConstraintValidationError{}
+
+// Validate checks the field values on GetConnectionPrimaryConstraintsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetConnectionPrimaryConstraintsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// GetConnectionPrimaryConstraintsRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// GetConnectionPrimaryConstraintsRequestMultiError, or nil if none found.
+func (m *GetConnectionPrimaryConstraintsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConnectionPrimaryConstraintsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConnectionId
+
+	if len(errors) > 0 {
+		return GetConnectionPrimaryConstraintsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConnectionPrimaryConstraintsRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// GetConnectionPrimaryConstraintsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetConnectionPrimaryConstraintsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConnectionPrimaryConstraintsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConnectionPrimaryConstraintsRequestMultiError) AllErrors() []error { return m }
+
+// GetConnectionPrimaryConstraintsRequestValidationError is the validation
+// error returned by GetConnectionPrimaryConstraintsRequest.Validate if the
+// designated constraints aren't met.
+type GetConnectionPrimaryConstraintsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConnectionPrimaryConstraintsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConnectionPrimaryConstraintsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConnectionPrimaryConstraintsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConnectionPrimaryConstraintsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConnectionPrimaryConstraintsRequestValidationError) ErrorName() string {
+	return "GetConnectionPrimaryConstraintsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConnectionPrimaryConstraintsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConnectionPrimaryConstraintsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConnectionPrimaryConstraintsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConnectionPrimaryConstraintsRequestValidationError{}
+
+// Validate checks the field values on GetConnectionPrimaryConstraintsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetConnectionPrimaryConstraintsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// GetConnectionPrimaryConstraintsResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// GetConnectionPrimaryConstraintsResponseMultiError, or nil if none found.
+func (m *GetConnectionPrimaryConstraintsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConnectionPrimaryConstraintsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	{
+		sorted_keys := make([]string, len(m.GetTableConstraints()))
+		i := 0
+		for key := range m.GetTableConstraints() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetTableConstraints()[key]
+			_ = val
+
+			// no validation rules for TableConstraints[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, GetConnectionPrimaryConstraintsResponseValidationError{
+							field:  fmt.Sprintf("TableConstraints[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, GetConnectionPrimaryConstraintsResponseValidationError{
+							field:  fmt.Sprintf("TableConstraints[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return GetConnectionPrimaryConstraintsResponseValidationError{
+						field:  fmt.Sprintf("TableConstraints[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	for idx, item := range m.GetTables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetConnectionPrimaryConstraintsResponseValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetConnectionPrimaryConstraintsResponseValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetConnectionPrimaryConstraintsResponseValidationError{
+					field:  fmt.Sprintf("Tables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetConnectionPrimaryConstraintsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConnectionPrimaryConstraintsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// GetConnectionPrimaryConstraintsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetConnectionPrimaryConstraintsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConnectionPrimaryConstraintsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConnectionPrimaryConstraintsResponseMultiError) AllErrors() []error { return m }
+
+// GetConnectionPrimaryConstraintsResponseValidationError is the validation
+// error returned by GetConnectionPrimaryConstraintsResponse.Validate if the
+// designated constraints aren't met.
+type GetConnectionPrimaryConstraintsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConnectionPrimaryConstraintsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConnectionPrimaryConstraintsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConnectionPrimaryConstraintsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConnectionPrimaryConstraintsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConnectionPrimaryConstraintsResponseValidationError) ErrorName() string {
+	return "GetConnectionPrimaryConstraintsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConnectionPrimaryConstraintsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConnectionPrimaryConstraintsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConnectionPrimaryConstraintsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConnectionPrimaryConstraintsResponseValidationError{}
+
+// Validate checks the field values on GetConnectionUniqueConstraintsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetConnectionUniqueConstraintsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConnectionUniqueConstraintsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetConnectionUniqueConstraintsRequestMultiError, or nil if none found.
+func (m *GetConnectionUniqueConstraintsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConnectionUniqueConstraintsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConnectionId
+
+	if len(errors) > 0 {
+		return GetConnectionUniqueConstraintsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConnectionUniqueConstraintsRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// GetConnectionUniqueConstraintsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetConnectionUniqueConstraintsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConnectionUniqueConstraintsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConnectionUniqueConstraintsRequestMultiError) AllErrors() []error { return m }
+
+// GetConnectionUniqueConstraintsRequestValidationError is the validation error
+// returned by GetConnectionUniqueConstraintsRequest.Validate if the
+// designated constraints aren't met.
+type GetConnectionUniqueConstraintsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConnectionUniqueConstraintsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConnectionUniqueConstraintsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConnectionUniqueConstraintsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConnectionUniqueConstraintsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConnectionUniqueConstraintsRequestValidationError) ErrorName() string {
+	return "GetConnectionUniqueConstraintsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConnectionUniqueConstraintsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConnectionUniqueConstraintsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConnectionUniqueConstraintsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConnectionUniqueConstraintsRequestValidationError{}
+
+// Validate checks the field values on GetConnectionUniqueConstraintsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetConnectionUniqueConstraintsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// GetConnectionUniqueConstraintsResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// GetConnectionUniqueConstraintsResponseMultiError, or nil if none found.
+func (m *GetConnectionUniqueConstraintsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConnectionUniqueConstraintsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	{
+		sorted_keys := make([]string, len(m.GetTableConstraints()))
+		i := 0
+		for key := range m.GetTableConstraints() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetTableConstraints()[key]
+			_ = val
+
+			// no validation rules for TableConstraints[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, GetConnectionUniqueConstraintsResponseValidationError{
+							field:  fmt.Sprintf("TableConstraints[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, GetConnectionUniqueConstraintsResponseValidationError{
+							field:  fmt.Sprintf("TableConstraints[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return GetConnectionUniqueConstraintsResponseValidationError{
+						field:  fmt.Sprintf("TableConstraints[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	for idx, item := range m.GetTables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetConnectionUniqueConstraintsResponseValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetConnectionUniqueConstraintsResponseValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetConnectionUniqueConstraintsResponseValidationError{
+					field:  fmt.Sprintf("Tables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetConnectionUniqueConstraintsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConnectionUniqueConstraintsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// GetConnectionUniqueConstraintsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetConnectionUniqueConstraintsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConnectionUniqueConstraintsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConnectionUniqueConstraintsResponseMultiError) AllErrors() []error { return m }
+
+// GetConnectionUniqueConstraintsResponseValidationError is the validation
+// error returned by GetConnectionUniqueConstraintsResponse.Validate if the
+// designated constraints aren't met.
+type GetConnectionUniqueConstraintsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConnectionUniqueConstraintsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConnectionUniqueConstraintsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConnectionUniqueConstraintsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConnectionUniqueConstraintsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConnectionUniqueConstraintsResponseValidationError) ErrorName() string {
+	return "GetConnectionUniqueConstraintsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConnectionUniqueConstraintsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConnectionUniqueConstraintsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConnectionUniqueConstraintsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConnectionUniqueConstraintsResponseValidationError{}
+
+// Validate checks the field values on UniqueConstraint with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UniqueConstraint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UniqueConstraint with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UniqueConstraintMultiError, or nil if none found.
+func (m *UniqueConstraint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UniqueConstraint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Predicate != nil {
+		// no validation rules for Predicate
+	}
+
+	if len(errors) > 0 {
+		return UniqueConstraintMultiError(errors)
+	}
+
+	return nil
+}
+
+// UniqueConstraintMultiError is an error wrapping multiple validation errors
+// returned by UniqueConstraint.ValidateAll() if the designated constraints
+// aren't met.
+type UniqueConstraintMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UniqueConstraintMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UniqueConstraintMultiError) AllErrors() []error { return m }
+
+// UniqueConstraintValidationError is the validation error returned by
+// UniqueConstraint.Validate if the designated constraints aren't met.
+type UniqueConstraintValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UniqueConstraintValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UniqueConstraintValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UniqueConstraintValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UniqueConstraintValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UniqueConstraintValidationError) ErrorName() string { return "UniqueConstraintValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UniqueConstraintValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUniqueConstraint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UniqueConstraintValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UniqueConstraintValidationError{}
+
+// Validate checks the field values on GetAiGeneratedDataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAiGeneratedDataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAiGeneratedDataRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAiGeneratedDataRequestMultiError, or nil if none found.
+func (m *GetAiGeneratedDataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAiGeneratedDataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AiConnectionId
+
+	// no validation rules for Count
+
+	// no validation rules for ModelName
+
+	// no validation rules for DataConnectionId
+
+	if all {
+		switch v := interface{}(m.GetTable()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAiGeneratedDataRequestValidationError{
+					field:  "Table",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAiGeneratedDataRequestValidationError{
+					field:  "Table",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTable()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAiGeneratedDataRequestValidationError{
+				field:  "Table",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.UserPrompt != nil {
+		// no validation rules for UserPrompt
+	}
+
+	if len(errors) > 0 {
+		return GetAiGeneratedDataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAiGeneratedDataRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAiGeneratedDataRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetAiGeneratedDataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAiGeneratedDataRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAiGeneratedDataRequestMultiError) AllErrors() []error { return m }
+
+// GetAiGeneratedDataRequestValidationError is the validation error returned by
+// GetAiGeneratedDataRequest.Validate if the designated constraints aren't met.
+type GetAiGeneratedDataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAiGeneratedDataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAiGeneratedDataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAiGeneratedDataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAiGeneratedDataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAiGeneratedDataRequestValidationError) ErrorName() string {
+	return "GetAiGeneratedDataRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAiGeneratedDataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAiGeneratedDataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAiGeneratedDataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAiGeneratedDataRequestValidationError{}
+
+// Validate checks the field values on DatabaseTable with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DatabaseTable) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DatabaseTable with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DatabaseTableMultiError, or
+// nil if none found.
+func (m *DatabaseTable) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DatabaseTable) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	if len(errors) > 0 {
+		return DatabaseTableMultiError(errors)
+	}
+
+	return nil
+}
+
+// DatabaseTableMultiError is an error wrapping multiple validation errors
+// returned by DatabaseTable.ValidateAll() if the designated constraints
+// aren't met.
+type DatabaseTableMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DatabaseTableMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DatabaseTableMultiError) AllErrors() []error { return m }
+
+// DatabaseTableValidationError is the validation error returned by
+// DatabaseTable.Validate if the designated constraints aren't met.
+type DatabaseTableValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DatabaseTableValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DatabaseTableValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DatabaseTableValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DatabaseTableValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DatabaseTableValidationError) ErrorName() string { return "DatabaseTableValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DatabaseTableValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDatabaseTable.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DatabaseTableValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DatabaseTableValidationError{}
+
+// Validate checks the field values on GetAiGeneratedDataResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAiGeneratedDataResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAiGeneratedDataResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAiGeneratedDataResponseMultiError, or nil if none found.
+func (m *GetAiGeneratedDataResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAiGeneratedDataResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRecords() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAiGeneratedDataResponseValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAiGeneratedDataResponseValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAiGeneratedDataResponseValidationError{
+					field:  fmt.Sprintf("Records[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetAiGeneratedDataResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAiGeneratedDataResponseMultiError is an error wrapping multiple
+// validation errors returned by GetAiGeneratedDataResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetAiGeneratedDataResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAiGeneratedDataResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAiGeneratedDataResponseMultiError) AllErrors() []error { return m }
+
+// GetAiGeneratedDataResponseValidationError is the validation error returned
+// by GetAiGeneratedDataResponse.Validate if the designated constraints aren't met.
+type GetAiGeneratedDataResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAiGeneratedDataResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAiGeneratedDataResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAiGeneratedDataResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAiGeneratedDataResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAiGeneratedDataResponseValidationError) ErrorName() string {
+	return "GetAiGeneratedDataResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAiGeneratedDataResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAiGeneratedDataResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAiGeneratedDataResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAiGeneratedDataResponseValidationError{}
+
+// Validate checks the field values on GetConnectionTableConstraintsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetConnectionTableConstraintsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConnectionTableConstraintsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetConnectionTableConstraintsRequestMultiError, or nil if none found.
+func (m *GetConnectionTableConstraintsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConnectionTableConstraintsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConnectionId
+
+	if len(errors) > 0 {
+		return GetConnectionTableConstraintsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConnectionTableConstraintsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetConnectionTableConstraintsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetConnectionTableConstraintsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConnectionTableConstraintsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConnectionTableConstraintsRequestMultiError) AllErrors() []error { return m }
+
+// GetConnectionTableConstraintsRequestValidationError is the validation error
+// returned by GetConnectionTableConstraintsRequest.Validate if the designated
+// constraints aren't met.
+type GetConnectionTableConstraintsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConnectionTableConstraintsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConnectionTableConstraintsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConnectionTableConstraintsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConnectionTableConstraintsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConnectionTableConstraintsRequestValidationError) ErrorName() string {
+	return "GetConnectionTableConstraintsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConnectionTableConstraintsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConnectionTableConstraintsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConnectionTableConstraintsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConnectionTableConstraintsRequestValidationError{}
+
+// Validate checks the field values on UniqueConstraints with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UniqueConstraints) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UniqueConstraints with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UniqueConstraintsMultiError, or nil if none found.
+func (m *UniqueConstraints) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UniqueConstraints) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetConstraints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UniqueConstraintsValidationError{
+						field:  fmt.Sprintf("Constraints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UniqueConstraintsValidationError{
+						field:  fmt.Sprintf("Constraints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UniqueConstraintsValidationError{
+					field:  fmt.Sprintf("Constraints[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UniqueConstraintsMultiError(errors)
+	}
+
+	return nil
+}
+
+// UniqueConstraintsMultiError is an error wrapping multiple validation errors
+// returned by UniqueConstraints.ValidateAll() if the designated constraints
+// aren't met.
+type UniqueConstraintsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UniqueConstraintsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UniqueConstraintsMultiError) AllErrors() []error { return m }
+
+// UniqueConstraintsValidationError is the validation error returned by
+// UniqueConstraints.Validate if the designated constraints aren't met.
+type UniqueConstraintsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UniqueConstraintsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UniqueConstraintsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UniqueConstraintsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UniqueConstraintsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UniqueConstraintsValidationError) ErrorName() string {
+	return "UniqueConstraintsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UniqueConstraintsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUniqueConstraints.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UniqueConstraintsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UniqueConstraintsValidationError{}
+
+// Validate checks the field values on GetConnectionTableConstraintsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetConnectionTableConstraintsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConnectionTableConstraintsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetConnectionTableConstraintsResponseMultiError, or nil if none found.
+func (m *GetConnectionTableConstraintsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConnectionTableConstraintsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	{
+		sorted_keys := make([]string, len(m.GetForeignKeyConstraints()))
+		i := 0
+		for key := range m.GetForeignKeyConstraints() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetForeignKeyConstraints()[key]
+			_ = val
+
+			// no validation rules for ForeignKeyConstraints[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, GetConnectionTableConstraintsResponseValidationError{
+							field:  fmt.Sprintf("ForeignKeyConstraints[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, GetConnectionTableConstraintsResponseValidationError{
+							field:  fmt.Sprintf("ForeignKeyConstraints[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return GetConnectionTableConstraintsResponseValidationError{
+						field:  fmt.Sprintf("ForeignKeyConstraints[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetPrimaryKeyConstraints()))
+		i := 0
+		for key := range m.GetPrimaryKeyConstraints() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetPrimaryKeyConstraints()[key]
+			_ = val
+
+			// no validation rules for PrimaryKeyConstraints[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, GetConnectionTableConstraintsResponseValidationError{
+							field:  fmt.Sprintf("PrimaryKeyConstraints[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, GetConnectionTableConstraintsResponseValidationError{
+							field:  fmt.Sprintf("PrimaryKeyConstraints[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return GetConnectionTableConstraintsResponseValidationError{
+						field:  fmt.Sprintf("PrimaryKeyConstraints[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetUniqueConstraints()))
+		i := 0
+		for key := range m.GetUniqueConstraints() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetUniqueConstraints()[key]
+			_ = val
+
+			// no validation rules for UniqueConstraints[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, GetConnectionTableConstraintsResponseValidationError{
+							field:  fmt.Sprintf("UniqueConstraints[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, GetConnectionTableConstraintsResponseValidationError{
+							field:  fmt.Sprintf("UniqueConstraints[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return GetConnectionTableConstraintsResponseValidationError{
+						field:  fmt.Sprintf("UniqueConstraints[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetExclusionConstraints()))
+		i := 0
+		for key := range m.GetExclusionConstraints() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetExclusionConstraints()[key]
+			_ = val
+
+			// no validation rules for ExclusionConstraints[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, GetConnectionTableConstraintsResponseValidationError{
+							field:  fmt.Sprintf("ExclusionConstraints[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, GetConnectionTableConstraintsResponseValidationError{
+							field:  fmt.Sprintf("ExclusionConstraints[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return GetConnectionTableConstraintsResponseValidationError{
+						field:  fmt.Sprintf("ExclusionConstraints[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	for idx, item := range m.GetTables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetConnectionTableConstraintsResponseValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetConnectionTableConstraintsResponseValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetConnectionTableConstraintsResponseValidationError{
+					field:  fmt.Sprintf("Tables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetConnectionTableConstraintsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConnectionTableConstraintsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// GetConnectionTableConstraintsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetConnectionTableConstraintsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConnectionTableConstraintsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConnectionTableConstraintsResponseMultiError) AllErrors() []error { return m }
+
+// GetConnectionTableConstraintsResponseValidationError is the validation error
+// returned by GetConnectionTableConstraintsResponse.Validate if the
+// designated constraints aren't met.
+type GetConnectionTableConstraintsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConnectionTableConstraintsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConnectionTableConstraintsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConnectionTableConstraintsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConnectionTableConstraintsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConnectionTableConstraintsResponseValidationError) ErrorName() string {
+	return "GetConnectionTableConstraintsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConnectionTableConstraintsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConnectionTableConstraintsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConnectionTableConstraintsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConnectionTableConstraintsResponseValidationError{}
+
+// Validate checks the field values on GetTableRowCountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTableRowCountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTableRowCountRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTableRowCountRequestMultiError, or nil if none found.
+func (m *GetTableRowCountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTableRowCountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConnectionId
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	if m.WhereClause != nil {
+		// no validation rules for WhereClause
+	}
+
+	if len(errors) > 0 {
+		return GetTableRowCountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTableRowCountRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTableRowCountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetTableRowCountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTableRowCountRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTableRowCountRequestMultiError) AllErrors() []error { return m }
+
+// GetTableRowCountRequestValidationError is the validation error returned by
+// GetTableRowCountRequest.Validate if the designated constraints aren't met.
+type GetTableRowCountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTableRowCountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTableRowCountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTableRowCountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTableRowCountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTableRowCountRequestValidationError) ErrorName() string {
+	return "GetTableRowCountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTableRowCountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTableRowCountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTableRowCountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTableRowCountRequestValidationError{}
+
+// Validate checks the field values on GetTableRowCountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTableRowCountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTableRowCountResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTableRowCountResponseMultiError, or nil if none found.
+func (m *GetTableRowCountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTableRowCountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return GetTableRowCountResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTableRowCountResponseMultiError is an error wrapping multiple validation
+// errors returned by GetTableRowCountResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetTableRowCountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTableRowCountResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTableRowCountResponseMultiError) AllErrors() []error { return m }
+
+// GetTableRowCountResponseValidationError is the validation error returned by
+// GetTableRowCountResponse.Validate if the designated constraints aren't met.
+type GetTableRowCountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTableRowCountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTableRowCountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTableRowCountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTableRowCountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTableRowCountResponseValidationError) ErrorName() string {
+	return "GetTableRowCountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTableRowCountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTableRowCountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTableRowCountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTableRowCountResponseValidationError{}
+
+// Validate checks the field values on ExecuteReadOnlyQueryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExecuteReadOnlyQueryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExecuteReadOnlyQueryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExecuteReadOnlyQueryRequestMultiError, or nil if none found.
+func (m *ExecuteReadOnlyQueryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExecuteReadOnlyQueryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConnectionId
+
+	// no validation rules for Query
+
+	if m.RowLimit != nil {
+		// no validation rules for RowLimit
+	}
+
+	if m.TimeoutSeconds != nil {
+		// no validation rules for TimeoutSeconds
+	}
+
+	if len(errors) > 0 {
+		return ExecuteReadOnlyQueryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExecuteReadOnlyQueryRequestMultiError is an error wrapping multiple
+// validation errors returned by ExecuteReadOnlyQueryRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ExecuteReadOnlyQueryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExecuteReadOnlyQueryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExecuteReadOnlyQueryRequestMultiError) AllErrors() []error { return m }
+
+// ExecuteReadOnlyQueryRequestValidationError is the validation error returned
+// by ExecuteReadOnlyQueryRequest.Validate if the designated constraints
+// aren't met.
+type ExecuteReadOnlyQueryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExecuteReadOnlyQueryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExecuteReadOnlyQueryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExecuteReadOnlyQueryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExecuteReadOnlyQueryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExecuteReadOnlyQueryRequestValidationError) ErrorName() string {
+	return "ExecuteReadOnlyQueryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExecuteReadOnlyQueryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExecuteReadOnlyQueryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExecuteReadOnlyQueryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExecuteReadOnlyQueryRequestValidationError{}
+
+// Validate checks the field values on QueryResultValue with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *QueryResultValue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryResultValue with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueryResultValueMultiError, or nil if none found.
+func (m *QueryResultValue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryResultValue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Value != nil {
+		// no validation rules for Value
+	}
+
+	if len(errors) > 0 {
+		return QueryResultValueMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryResultValueMultiError is an error wrapping multiple validation errors
+// returned by QueryResultValue.ValidateAll() if the designated constraints
+// aren't met.
+type QueryResultValueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryResultValueMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryResultValueMultiError) AllErrors() []error { return m }
+
+// QueryResultValueValidationError is the validation error returned by
+// QueryResultValue.Validate if the designated constraints aren't met.
+type QueryResultValueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryResultValueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryResultValueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryResultValueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryResultValueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryResultValueValidationError) ErrorName() string { return "QueryResultValueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QueryResultValueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryResultValue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryResultValueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryResultValueValidationError{}
+
+// Validate checks the field values on QueryResultRow with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QueryResultRow) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryResultRow with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QueryResultRowMultiError,
+// or nil if none found.
+func (m *QueryResultRow) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryResultRow) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetValues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueryResultRowValidationError{
+						field:  fmt.Sprintf("Values[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueryResultRowValidationError{
+						field:  fmt.Sprintf("Values[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueryResultRowValidationError{
+					field:  fmt.Sprintf("Values[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return QueryResultRowMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryResultRowMultiError is an error wrapping multiple validation errors
+// returned by QueryResultRow.ValidateAll() if the designated constraints
+// aren't met.
+type QueryResultRowMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryResultRowMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryResultRowMultiError) AllErrors() []error { return m }
+
+// QueryResultRowValidationError is the validation error returned by
+// QueryResultRow.Validate if the designated constraints aren't met.
+type QueryResultRowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryResultRowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryResultRowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryResultRowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryResultRowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryResultRowValidationError) ErrorName() string { return "QueryResultRowValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QueryResultRowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryResultRow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryResultRowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryResultRowValidationError{}
+
+// Validate checks the field values on ExecuteReadOnlyQueryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExecuteReadOnlyQueryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExecuteReadOnlyQueryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExecuteReadOnlyQueryResponseMultiError, or nil if none found.
+func (m *ExecuteReadOnlyQueryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExecuteReadOnlyQueryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExecuteReadOnlyQueryResponseValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExecuteReadOnlyQueryResponseValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExecuteReadOnlyQueryResponseValidationError{
+					field:  fmt.Sprintf("Rows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Truncated
+
+	if len(errors) > 0 {
+		return ExecuteReadOnlyQueryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExecuteReadOnlyQueryResponseMultiError is an error wrapping multiple
+// validation errors returned by ExecuteReadOnlyQueryResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ExecuteReadOnlyQueryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExecuteReadOnlyQueryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExecuteReadOnlyQueryResponseMultiError) AllErrors() []error { return m }
+
+// ExecuteReadOnlyQueryResponseValidationError is the validation error returned
+// by ExecuteReadOnlyQueryResponse.Validate if the designated constraints
+// aren't met.
+type ExecuteReadOnlyQueryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExecuteReadOnlyQueryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExecuteReadOnlyQueryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExecuteReadOnlyQueryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExecuteReadOnlyQueryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExecuteReadOnlyQueryResponseValidationError) ErrorName() string {
+	return "ExecuteReadOnlyQueryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExecuteReadOnlyQueryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExecuteReadOnlyQueryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExecuteReadOnlyQueryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExecuteReadOnlyQueryResponseValidationError{}
+
+// Validate checks the field values on ListTableRunsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTableRunsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTableRunsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTableRunsRequestMultiError, or nil if none found.
+func (m *ListTableRunsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTableRunsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConnectionId
+
+	// no validation rules for JobId
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	if len(errors) > 0 {
+		return ListTableRunsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTableRunsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTableRunsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTableRunsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTableRunsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTableRunsRequestMultiError) AllErrors() []error { return m }
+
+// ListTableRunsRequestValidationError is the validation error returned by
+// ListTableRunsRequest.Validate if the designated constraints aren't met.
+type ListTableRunsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTableRunsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTableRunsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTableRunsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTableRunsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTableRunsRequestValidationError) ErrorName() string {
+	return "ListTableRunsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTableRunsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTableRunsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTableRunsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTableRunsRequestValidationError{}
+
+// Validate checks the field values on TableRun with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TableRun) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TableRun with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TableRunMultiError, or nil
+// if none found.
+func (m *TableRun) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TableRun) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobRunId
+
+	if all {
+		switch v := interface{}(m.GetStartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TableRunValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TableRunValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TableRunValidationError{
+				field:  "StartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastModifiedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TableRunValidationError{
+					field:  "LastModifiedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TableRunValidationError{
+					field:  "LastModifiedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastModifiedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TableRunValidationError{
+				field:  "LastModifiedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FileCount
+
+	// no validation rules for RowCount
+
+	// no validation rules for SizeBytes
+
+	if len(errors) > 0 {
+		return TableRunMultiError(errors)
+	}
+
+	return nil
+}
+
+// TableRunMultiError is an error wrapping multiple validation errors returned
+// by TableRun.ValidateAll() if the designated constraints aren't met.
+type TableRunMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TableRunMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TableRunMultiError) AllErrors() []error { return m }
+
+// TableRunValidationError is the validation error returned by
+// TableRun.Validate if the designated constraints aren't met.
+type TableRunValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TableRunValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TableRunValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TableRunValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TableRunValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TableRunValidationError) ErrorName() string { return "TableRunValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TableRunValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTableRun.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TableRunValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TableRunValidationError{}
+
+// Validate checks the field values on ListTableRunsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTableRunsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTableRunsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTableRunsResponseMultiError, or nil if none found.
+func (m *ListTableRunsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTableRunsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRuns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTableRunsResponseValidationError{
+						field:  fmt.Sprintf("Runs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTableRunsResponseValidationError{
+						field:  fmt.Sprintf("Runs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTableRunsResponseValidationError{
+					field:  fmt.Sprintf("Runs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTableRunsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTableRunsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListTableRunsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListTableRunsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTableRunsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTableRunsResponseMultiError) AllErrors() []error { return m }
+
+// ListTableRunsResponseValidationError is the validation error returned by
+// ListTableRunsResponse.Validate if the designated constraints aren't met.
+type ListTableRunsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTableRunsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTableRunsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTableRunsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTableRunsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTableRunsResponseValidationError) ErrorName() string {
+	return "ListTableRunsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTableRunsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTableRunsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTableRunsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTableRunsResponseValidationError{}
+
+// Validate checks the field values on GetTableRunManifestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTableRunManifestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTableRunManifestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTableRunManifestRequestMultiError, or nil if none found.
+func (m *GetTableRunManifestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTableRunManifestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConnectionId
+
+	// no validation rules for JobRunId
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	if len(errors) > 0 {
+		return GetTableRunManifestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTableRunManifestRequestMultiError is an error wrapping multiple
+// validation errors returned by GetTableRunManifestRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetTableRunManifestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTableRunManifestRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTableRunManifestRequestMultiError) AllErrors() []error { return m }
+
+// GetTableRunManifestRequestValidationError is the validation error returned
+// by GetTableRunManifestRequest.Validate if the designated constraints aren't met.
+type GetTableRunManifestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTableRunManifestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTableRunManifestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTableRunManifestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTableRunManifestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTableRunManifestRequestValidationError) ErrorName() string {
+	return "GetTableRunManifestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTableRunManifestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTableRunManifestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTableRunManifestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTableRunManifestRequestValidationError{}
+
+// Validate checks the field values on GetTableRunManifestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTableRunManifestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTableRunManifestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTableRunManifestResponseMultiError, or nil if none found.
+func (m *GetTableRunManifestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTableRunManifestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetManifest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTableRunManifestResponseValidationError{
+					field:  "Manifest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTableRunManifestResponseValidationError{
+					field:  "Manifest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetManifest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTableRunManifestResponseValidationError{
+				field:  "Manifest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTableRunManifestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTableRunManifestResponseMultiError is an error wrapping multiple
+// validation errors returned by GetTableRunManifestResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetTableRunManifestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTableRunManifestResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTableRunManifestResponseMultiError) AllErrors() []error { return m }
+
+// GetTableRunManifestResponseValidationError is the validation error returned
+// by GetTableRunManifestResponse.Validate if the designated constraints
+// aren't met.
+type GetTableRunManifestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTableRunManifestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTableRunManifestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTableRunManifestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTableRunManifestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTableRunManifestResponseValidationError) ErrorName() string {
+	return "GetTableRunManifestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTableRunManifestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTableRunManifestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTableRunManifestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTableRunManifestResponseValidationError{}
+
+// Validate checks the field values on TableRunManifest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TableRunManifest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TableRunManifest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TableRunManifestMultiError, or nil if none found.
+func (m *TableRunManifest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TableRunManifest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobRunId
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for SchemaFingerprint
+
+	for idx, item := range m.GetFiles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TableRunManifestValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TableRunManifestValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TableRunManifestValidationError{
+					field:  fmt.Sprintf("Files[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for RowCount
+
+	// no validation rules for SizeBytes
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TableRunManifestValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TableRunManifestValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TableRunManifestValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TableRunManifestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TableRunManifestMultiError is an error wrapping multiple validation errors
+// returned by TableRunManifest.ValidateAll() if the designated constraints
+// aren't met.
+type TableRunManifestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TableRunManifestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TableRunManifestMultiError) AllErrors() []error { return m }
+
+// TableRunManifestValidationError is the validation error returned by
+// TableRunManifest.Validate if the designated constraints aren't met.
+type TableRunManifestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TableRunManifestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TableRunManifestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TableRunManifestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TableRunManifestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TableRunManifestValidationError) ErrorName() string { return "TableRunManifestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TableRunManifestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTableRunManifest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TableRunManifestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TableRunManifestValidationError{}
+
+// Validate checks the field values on TableRunManifestFile with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TableRunManifestFile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TableRunManifestFile with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TableRunManifestFileMultiError, or nil if none found.
+func (m *TableRunManifestFile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TableRunManifestFile) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	// no validation rules for RowCount
+
+	// no validation rules for SizeBytes
+
+	// no validation rules for Sha256
+
+	if len(errors) > 0 {
+		return TableRunManifestFileMultiError(errors)
+	}
+
+	return nil
+}
+
+// TableRunManifestFileMultiError is an error wrapping multiple validation
+// errors returned by TableRunManifestFile.ValidateAll() if the designated
+// constraints aren't met.
+type TableRunManifestFileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TableRunManifestFileMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TableRunManifestFileMultiError) AllErrors() []error { return m }
+
+// TableRunManifestFileValidationError is the validation error returned by
+// TableRunManifestFile.Validate if the designated constraints aren't met.
+type TableRunManifestFileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TableRunManifestFileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TableRunManifestFileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TableRunManifestFileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TableRunManifestFileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TableRunManifestFileValidationError) ErrorName() string {
+	return "TableRunManifestFileValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TableRunManifestFileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTableRunManifestFile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TableRunManifestFileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TableRunManifestFileValidationError{}
+
+// Validate checks the field values on VerifyTableRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyTableRunRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyTableRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyTableRunRequestMultiError, or nil if none found.
+func (m *VerifyTableRunRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyTableRunRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConnectionId
+
+	// no validation rules for JobRunId
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	if len(errors) > 0 {
+		return VerifyTableRunRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyTableRunRequestMultiError is an error wrapping multiple validation
+// errors returned by VerifyTableRunRequest.ValidateAll() if the designated
+// constraints aren't met.
+type VerifyTableRunRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConnectionDataStreamRequestMultiError) Error() string {
+func (m VerifyTableRunRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -679,12 +7514,11 @@ func (m GetConnectionDataStreamRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConnectionDataStreamRequestMultiError) AllErrors() []error { return m }
+func (m VerifyTableRunRequestMultiError) AllErrors() []error { return m }
 
-// GetConnectionDataStreamRequestValidationError is the validation error
-// returned by GetConnectionDataStreamRequest.Validate if the designated
-// constraints aren't met.
-type GetConnectionDataStreamRequestValidationError struct {
+// VerifyTableRunRequestValidationError is the validation error returned by
+// VerifyTableRunRequest.Validate if the designated constraints aren't met.
+type VerifyTableRunRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -692,24 +7526,24 @@ type GetConnectionDataStreamRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConnectionDataStreamRequestValidationError) Field() string { return e.field }
+func (e VerifyTableRunRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConnectionDataStreamRequestValidationError) Reason() string { return e.reason }
+func (e VerifyTableRunRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConnectionDataStreamRequestValidationError) Cause() error { return e.cause }
+func (e VerifyTableRunRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConnectionDataStreamRequestValidationError) Key() bool { return e.key }
+func (e VerifyTableRunRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConnectionDataStreamRequestValidationError) ErrorName() string {
-	return "GetConnectionDataStreamRequestValidationError"
+func (e VerifyTableRunRequestValidationError) ErrorName() string {
+	return "VerifyTableRunRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetConnectionDataStreamRequestValidationError) Error() string {
+func (e VerifyTableRunRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -721,14 +7555,14 @@ func (e GetConnectionDataStreamRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConnectionDataStreamRequest.%s: %s%s",
+		"invalid %sVerifyTableRunRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConnectionDataStreamRequestValidationError{}
+var _ error = VerifyTableRunRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -736,46 +7570,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConnectionDataStreamRequestValidationError{}
+} = VerifyTableRunRequestValidationError{}
 
-// Validate checks the field values on GetConnectionDataStreamResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on VerifyTableRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetConnectionDataStreamResponse) Validate() error {
+func (m *VerifyTableRunResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetConnectionDataStreamResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetConnectionDataStreamResponseMultiError, or nil if none found.
-func (m *GetConnectionDataStreamResponse) ValidateAll() error {
+// ValidateAll checks the field values on VerifyTableRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyTableRunResponseMultiError, or nil if none found.
+func (m *VerifyTableRunResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConnectionDataStreamResponse) validate(all bool) error {
+func (m *VerifyTableRunResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Row
+	// no validation rules for Valid
+
+	for idx, item := range m.GetFiles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VerifyTableRunResponseValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VerifyTableRunResponseValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VerifyTableRunResponseValidationError{
+					field:  fmt.Sprintf("Files[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return GetConnectionDataStreamResponseMultiError(errors)
+		return VerifyTableRunResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConnectionDataStreamResponseMultiError is an error wrapping multiple
-// validation errors returned by GetConnectionDataStreamResponse.ValidateAll()
-// if the designated constraints aren't met.
-type GetConnectionDataStreamResponseMultiError []error
+// VerifyTableRunResponseMultiError is an error wrapping multiple validation
+// errors returned by VerifyTableRunResponse.ValidateAll() if the designated
+// constraints aren't met.
+type VerifyTableRunResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConnectionDataStreamResponseMultiError) Error() string {
+func (m VerifyTableRunResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -784,12 +7652,121 @@ func (m GetConnectionDataStreamResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConnectionDataStreamResponseMultiError) AllErrors() []error { return m }
+func (m VerifyTableRunResponseMultiError) AllErrors() []error { return m }
 
-// GetConnectionDataStreamResponseValidationError is the validation error
-// returned by GetConnectionDataStreamResponse.Validate if the designated
+// VerifyTableRunResponseValidationError is the validation error returned by
+// VerifyTableRunResponse.Validate if the designated constraints aren't met.
+type VerifyTableRunResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyTableRunResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyTableRunResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyTableRunResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyTableRunResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyTableRunResponseValidationError) ErrorName() string {
+	return "VerifyTableRunResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyTableRunResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyTableRunResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyTableRunResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyTableRunResponseValidationError{}
+
+// Validate checks the field values on TableRunFileVerification with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TableRunFileVerification) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TableRunFileVerification with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TableRunFileVerificationMultiError, or nil if none found.
+func (m *TableRunFileVerification) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TableRunFileVerification) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	// no validation rules for Valid
+
+	if m.Error != nil {
+		// no validation rules for Error
+	}
+
+	if len(errors) > 0 {
+		return TableRunFileVerificationMultiError(errors)
+	}
+
+	return nil
+}
+
+// TableRunFileVerificationMultiError is an error wrapping multiple validation
+// errors returned by TableRunFileVerification.ValidateAll() if the designated
 // constraints aren't met.
-type GetConnectionDataStreamResponseValidationError struct {
+type TableRunFileVerificationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TableRunFileVerificationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TableRunFileVerificationMultiError) AllErrors() []error { return m }
+
+// TableRunFileVerificationValidationError is the validation error returned by
+// TableRunFileVerification.Validate if the designated constraints aren't met.
+type TableRunFileVerificationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -797,24 +7774,24 @@ type GetConnectionDataStreamResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConnectionDataStreamResponseValidationError) Field() string { return e.field }
+func (e TableRunFileVerificationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConnectionDataStreamResponseValidationError) Reason() string { return e.reason }
+func (e TableRunFileVerificationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConnectionDataStreamResponseValidationError) Cause() error { return e.cause }
+func (e TableRunFileVerificationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConnectionDataStreamResponseValidationError) Key() bool { return e.key }
+func (e TableRunFileVerificationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConnectionDataStreamResponseValidationError) ErrorName() string {
-	return "GetConnectionDataStreamResponseValidationError"
+func (e TableRunFileVerificationValidationError) ErrorName() string {
+	return "TableRunFileVerificationValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetConnectionDataStreamResponseValidationError) Error() string {
+func (e TableRunFileVerificationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -826,14 +7803,14 @@ func (e GetConnectionDataStreamResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConnectionDataStreamResponse.%s: %s%s",
+		"invalid %sTableRunFileVerification.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConnectionDataStreamResponseValidationError{}
+var _ error = TableRunFileVerificationValidationError{}
 
 var _ interface {
 	Field() string
@@ -841,44 +7818,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConnectionDataStreamResponseValidationError{}
+} = TableRunFileVerificationValidationError{}
 
-// Validate checks the field values on PostgresSchemaConfig with the rules
+// Validate checks the field values on ExclusionConstraint with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PostgresSchemaConfig) Validate() error {
+func (m *ExclusionConstraint) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PostgresSchemaConfig with the rules
+// ValidateAll checks the field values on ExclusionConstraint with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PostgresSchemaConfigMultiError, or nil if none found.
-func (m *PostgresSchemaConfig) ValidateAll() error {
+// ExclusionConstraintMultiError, or nil if none found.
+func (m *ExclusionConstraint) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PostgresSchemaConfig) validate(all bool) error {
+func (m *ExclusionConstraint) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Name
+
+	// no validation rules for Definition
+
 	if len(errors) > 0 {
-		return PostgresSchemaConfigMultiError(errors)
+		return ExclusionConstraintMultiError(errors)
 	}
 
 	return nil
 }
 
-// PostgresSchemaConfigMultiError is an error wrapping multiple validation
-// errors returned by PostgresSchemaConfig.ValidateAll() if the designated
+// ExclusionConstraintMultiError is an error wrapping multiple validation
+// errors returned by ExclusionConstraint.ValidateAll() if the designated
 // constraints aren't met.
-type PostgresSchemaConfigMultiError []error
+type ExclusionConstraintMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PostgresSchemaConfigMultiError) Error() string {
+func (m ExclusionConstraintMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -887,11 +7868,11 @@ func (m PostgresSchemaConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PostgresSchemaConfigMultiError) AllErrors() []error { return m }
+func (m ExclusionConstraintMultiError) AllErrors() []error { return m }
 
-// PostgresSchemaConfigValidationError is the validation error returned by
-// PostgresSchemaConfig.Validate if the designated constraints aren't met.
-type PostgresSchemaConfigValidationError struct {
+// ExclusionConstraintValidationError is the validation error returned by
+// ExclusionConstraint.Validate if the designated constraints aren't met.
+type ExclusionConstraintValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -899,24 +7880,24 @@ type PostgresSchemaConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e PostgresSchemaConfigValidationError) Field() string { return e.field }
+func (e ExclusionConstraintValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PostgresSchemaConfigValidationError) Reason() string { return e.reason }
+func (e ExclusionConstraintValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PostgresSchemaConfigValidationError) Cause() error { return e.cause }
+func (e ExclusionConstraintValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PostgresSchemaConfigValidationError) Key() bool { return e.key }
+func (e ExclusionConstraintValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PostgresSchemaConfigValidationError) ErrorName() string {
-	return "PostgresSchemaConfigValidationError"
+func (e ExclusionConstraintValidationError) ErrorName() string {
+	return "ExclusionConstraintValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PostgresSchemaConfigValidationError) Error() string {
+func (e ExclusionConstraintValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -928,14 +7909,14 @@ func (e PostgresSchemaConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPostgresSchemaConfig.%s: %s%s",
+		"invalid %sExclusionConstraint.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PostgresSchemaConfigValidationError{}
+var _ error = ExclusionConstraintValidationError{}
 
 var _ interface {
 	Field() string
@@ -943,44 +7924,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PostgresSchemaConfigValidationError{}
+} = ExclusionConstraintValidationError{}
 
-// Validate checks the field values on MysqlSchemaConfig with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *MysqlSchemaConfig) Validate() error {
+// Validate checks the field values on ExclusionConstraints with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExclusionConstraints) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MysqlSchemaConfig with the rules
+// ValidateAll checks the field values on ExclusionConstraints with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MysqlSchemaConfigMultiError, or nil if none found.
-func (m *MysqlSchemaConfig) ValidateAll() error {
+// ExclusionConstraintsMultiError, or nil if none found.
+func (m *ExclusionConstraints) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MysqlSchemaConfig) validate(all bool) error {
+func (m *ExclusionConstraints) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetConstraints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExclusionConstraintsValidationError{
+						field:  fmt.Sprintf("Constraints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExclusionConstraintsValidationError{
+						field:  fmt.Sprintf("Constraints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExclusionConstraintsValidationError{
+					field:  fmt.Sprintf("Constraints[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return MysqlSchemaConfigMultiError(errors)
+		return ExclusionConstraintsMultiError(errors)
 	}
 
 	return nil
 }
 
-// MysqlSchemaConfigMultiError is an error wrapping multiple validation errors
-// returned by MysqlSchemaConfig.ValidateAll() if the designated constraints
-// aren't met.
-type MysqlSchemaConfigMultiError []error
+// ExclusionConstraintsMultiError is an error wrapping multiple validation
+// errors returned by ExclusionConstraints.ValidateAll() if the designated
+// constraints aren't met.
+type ExclusionConstraintsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MysqlSchemaConfigMultiError) Error() string {
+func (m ExclusionConstraintsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -989,11 +8004,11 @@ func (m MysqlSchemaConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MysqlSchemaConfigMultiError) AllErrors() []error { return m }
+func (m ExclusionConstraintsMultiError) AllErrors() []error { return m }
 
-// MysqlSchemaConfigValidationError is the validation error returned by
-// MysqlSchemaConfig.Validate if the designated constraints aren't met.
-type MysqlSchemaConfigValidationError struct {
+// ExclusionConstraintsValidationError is the validation error returned by
+// ExclusionConstraints.Validate if the designated constraints aren't met.
+type ExclusionConstraintsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1001,24 +8016,24 @@ type MysqlSchemaConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e MysqlSchemaConfigValidationError) Field() string { return e.field }
+func (e ExclusionConstraintsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MysqlSchemaConfigValidationError) Reason() string { return e.reason }
+func (e ExclusionConstraintsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MysqlSchemaConfigValidationError) Cause() error { return e.cause }
+func (e ExclusionConstraintsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MysqlSchemaConfigValidationError) Key() bool { return e.key }
+func (e ExclusionConstraintsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MysqlSchemaConfigValidationError) ErrorName() string {
-	return "MysqlSchemaConfigValidationError"
+func (e ExclusionConstraintsValidationError) ErrorName() string {
+	return "ExclusionConstraintsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MysqlSchemaConfigValidationError) Error() string {
+func (e ExclusionConstraintsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1030,14 +8045,14 @@ func (e MysqlSchemaConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMysqlSchemaConfig.%s: %s%s",
+		"invalid %sExclusionConstraints.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MysqlSchemaConfigValidationError{}
+var _ error = ExclusionConstraintsValidationError{}
 
 var _ interface {
 	Field() string
@@ -1045,73 +8060,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MysqlSchemaConfigValidationError{}
+} = ExclusionConstraintsValidationError{}
 
-// Validate checks the field values on AwsS3SchemaConfig with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AwsS3SchemaConfig) Validate() error {
+// Validate checks the field values on SqliteStreamConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SqliteStreamConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AwsS3SchemaConfig with the rules
+// ValidateAll checks the field values on SqliteStreamConfig with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AwsS3SchemaConfigMultiError, or nil if none found.
-func (m *AwsS3SchemaConfig) ValidateAll() error {
+// SqliteStreamConfigMultiError, or nil if none found.
+func (m *SqliteStreamConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AwsS3SchemaConfig) validate(all bool) error {
+func (m *SqliteStreamConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Id.(type) {
-	case *AwsS3SchemaConfig_JobId:
-		if v == nil {
-			err := AwsS3SchemaConfigValidationError{
-				field:  "Id",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for JobId
-	case *AwsS3SchemaConfig_JobRunId:
-		if v == nil {
-			err := AwsS3SchemaConfigValidationError{
-				field:  "Id",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for JobRunId
-	default:
-		_ = v // ensures v is used
-	}
-
 	if len(errors) > 0 {
-		return AwsS3SchemaConfigMultiError(errors)
+		return SqliteStreamConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// AwsS3SchemaConfigMultiError is an error wrapping multiple validation errors
-// returned by AwsS3SchemaConfig.ValidateAll() if the designated constraints
-// aren't met.
-type AwsS3SchemaConfigMultiError []error
+// SqliteStreamConfigMultiError is an error wrapping multiple validation
+// errors returned by SqliteStreamConfig.ValidateAll() if the designated
+// constraints aren't met.
+type SqliteStreamConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AwsS3SchemaConfigMultiError) Error() string {
+func (m SqliteStreamConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1120,11 +8106,11 @@ func (m AwsS3SchemaConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AwsS3SchemaConfigMultiError) AllErrors() []error { return m }
+func (m SqliteStreamConfigMultiError) AllErrors() []error { return m }
 
-// AwsS3SchemaConfigValidationError is the validation error returned by
-// AwsS3SchemaConfig.Validate if the designated constraints aren't met.
-type AwsS3SchemaConfigValidationError struct {
+// SqliteStreamConfigValidationError is the validation error returned by
+// SqliteStreamConfig.Validate if the designated constraints aren't met.
+type SqliteStreamConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1132,24 +8118,24 @@ type AwsS3SchemaConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e AwsS3SchemaConfigValidationError) Field() string { return e.field }
+func (e SqliteStreamConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AwsS3SchemaConfigValidationError) Reason() string { return e.reason }
+func (e SqliteStreamConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AwsS3SchemaConfigValidationError) Cause() error { return e.cause }
+func (e SqliteStreamConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AwsS3SchemaConfigValidationError) Key() bool { return e.key }
+func (e SqliteStreamConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AwsS3SchemaConfigValidationError) ErrorName() string {
-	return "AwsS3SchemaConfigValidationError"
+func (e SqliteStreamConfigValidationError) ErrorName() string {
+	return "SqliteStreamConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AwsS3SchemaConfigValidationError) Error() string {
+func (e SqliteStreamConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1161,14 +8147,14 @@ func (e AwsS3SchemaConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAwsS3SchemaConfig.%s: %s%s",
+		"invalid %sSqliteStreamConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AwsS3SchemaConfigValidationError{}
+var _ error = SqliteStreamConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -1176,172 +8162,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AwsS3SchemaConfigValidationError{}
+} = SqliteStreamConfigValidationError{}
 
-// Validate checks the field values on ConnectionSchemaConfig with the rules
+// Validate checks the field values on SqliteSchemaConfig with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ConnectionSchemaConfig) Validate() error {
+func (m *SqliteSchemaConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ConnectionSchemaConfig with the rules
+// ValidateAll checks the field values on SqliteSchemaConfig with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ConnectionSchemaConfigMultiError, or nil if none found.
-func (m *ConnectionSchemaConfig) ValidateAll() error {
+// SqliteSchemaConfigMultiError, or nil if none found.
+func (m *SqliteSchemaConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ConnectionSchemaConfig) validate(all bool) error {
+func (m *SqliteSchemaConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Config.(type) {
-	case *ConnectionSchemaConfig_PgConfig:
-		if v == nil {
-			err := ConnectionSchemaConfigValidationError{
-				field:  "Config",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetPgConfig()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ConnectionSchemaConfigValidationError{
-						field:  "PgConfig",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ConnectionSchemaConfigValidationError{
-						field:  "PgConfig",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetPgConfig()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ConnectionSchemaConfigValidationError{
-					field:  "PgConfig",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *ConnectionSchemaConfig_AwsS3Config:
-		if v == nil {
-			err := ConnectionSchemaConfigValidationError{
-				field:  "Config",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetAwsS3Config()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ConnectionSchemaConfigValidationError{
-						field:  "AwsS3Config",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ConnectionSchemaConfigValidationError{
-						field:  "AwsS3Config",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetAwsS3Config()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ConnectionSchemaConfigValidationError{
-					field:  "AwsS3Config",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *ConnectionSchemaConfig_MysqlConfig:
-		if v == nil {
-			err := ConnectionSchemaConfigValidationError{
-				field:  "Config",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetMysqlConfig()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ConnectionSchemaConfigValidationError{
-						field:  "MysqlConfig",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ConnectionSchemaConfigValidationError{
-						field:  "MysqlConfig",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetMysqlConfig()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ConnectionSchemaConfigValidationError{
-					field:  "MysqlConfig",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	default:
-		_ = v // ensures v is used
-	}
-
 	if len(errors) > 0 {
-		return ConnectionSchemaConfigMultiError(errors)
+		return SqliteSchemaConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// ConnectionSchemaConfigMultiError is an error wrapping multiple validation
-// errors returned by ConnectionSchemaConfig.ValidateAll() if the designated
+// SqliteSchemaConfigMultiError is an error wrapping multiple validation
+// errors returned by SqliteSchemaConfig.ValidateAll() if the designated
 // constraints aren't met.
-type ConnectionSchemaConfigMultiError []error
+type SqliteSchemaConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ConnectionSchemaConfigMultiError) Error() string {
+func (m SqliteSchemaConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1350,11 +8208,11 @@ func (m ConnectionSchemaConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ConnectionSchemaConfigMultiError) AllErrors() []error { return m }
+func (m SqliteSchemaConfigMultiError) AllErrors() []error { return m }
 
-// ConnectionSchemaConfigValidationError is the validation error returned by
-// ConnectionSchemaConfig.Validate if the designated constraints aren't met.
-type ConnectionSchemaConfigValidationError struct {
+// SqliteSchemaConfigValidationError is the validation error returned by
+// SqliteSchemaConfig.Validate if the designated constraints aren't met.
+type SqliteSchemaConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1362,24 +8220,24 @@ type ConnectionSchemaConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e ConnectionSchemaConfigValidationError) Field() string { return e.field }
+func (e SqliteSchemaConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ConnectionSchemaConfigValidationError) Reason() string { return e.reason }
+func (e SqliteSchemaConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ConnectionSchemaConfigValidationError) Cause() error { return e.cause }
+func (e SqliteSchemaConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ConnectionSchemaConfigValidationError) Key() bool { return e.key }
+func (e SqliteSchemaConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ConnectionSchemaConfigValidationError) ErrorName() string {
-	return "ConnectionSchemaConfigValidationError"
+func (e SqliteSchemaConfigValidationError) ErrorName() string {
+	return "SqliteSchemaConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ConnectionSchemaConfigValidationError) Error() string {
+func (e SqliteSchemaConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1391,14 +8249,14 @@ func (e ConnectionSchemaConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sConnectionSchemaConfig.%s: %s%s",
+		"invalid %sSqliteSchemaConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ConnectionSchemaConfigValidationError{}
+var _ error = SqliteSchemaConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -1406,62 +8264,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ConnectionSchemaConfigValidationError{}
+} = SqliteSchemaConfigValidationError{}
 
-// Validate checks the field values on DatabaseColumn with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *DatabaseColumn) Validate() error {
+// Validate checks the field values on SnowflakeStreamConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SnowflakeStreamConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DatabaseColumn with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DatabaseColumnMultiError,
-// or nil if none found.
-func (m *DatabaseColumn) ValidateAll() error {
+// ValidateAll checks the field values on SnowflakeStreamConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SnowflakeStreamConfigMultiError, or nil if none found.
+func (m *SnowflakeStreamConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DatabaseColumn) validate(all bool) error {
+func (m *SnowflakeStreamConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Schema
-
-	// no validation rules for Table
-
-	// no validation rules for Column
-
-	// no validation rules for DataType
-
-	// no validation rules for IsNullable
-
-	if m.ColumnDefault != nil {
-		// no validation rules for ColumnDefault
-	}
-
-	if m.GeneratedType != nil {
-		// no validation rules for GeneratedType
-	}
-
 	if len(errors) > 0 {
-		return DatabaseColumnMultiError(errors)
+		return SnowflakeStreamConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// DatabaseColumnMultiError is an error wrapping multiple validation errors
-// returned by DatabaseColumn.ValidateAll() if the designated constraints
-// aren't met.
-type DatabaseColumnMultiError []error
+// SnowflakeStreamConfigMultiError is an error wrapping multiple validation
+// errors returned by SnowflakeStreamConfig.ValidateAll() if the designated
+// constraints aren't met.
+type SnowflakeStreamConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DatabaseColumnMultiError) Error() string {
+func (m SnowflakeStreamConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1470,11 +8310,11 @@ func (m DatabaseColumnMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DatabaseColumnMultiError) AllErrors() []error { return m }
+func (m SnowflakeStreamConfigMultiError) AllErrors() []error { return m }
 
-// DatabaseColumnValidationError is the validation error returned by
-// DatabaseColumn.Validate if the designated constraints aren't met.
-type DatabaseColumnValidationError struct {
+// SnowflakeStreamConfigValidationError is the validation error returned by
+// SnowflakeStreamConfig.Validate if the designated constraints aren't met.
+type SnowflakeStreamConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1482,22 +8322,24 @@ type DatabaseColumnValidationError struct {
 }
 
 // Field function returns field value.
-func (e DatabaseColumnValidationError) Field() string { return e.field }
+func (e SnowflakeStreamConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DatabaseColumnValidationError) Reason() string { return e.reason }
+func (e SnowflakeStreamConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DatabaseColumnValidationError) Cause() error { return e.cause }
+func (e SnowflakeStreamConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DatabaseColumnValidationError) Key() bool { return e.key }
+func (e SnowflakeStreamConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DatabaseColumnValidationError) ErrorName() string { return "DatabaseColumnValidationError" }
+func (e SnowflakeStreamConfigValidationError) ErrorName() string {
+	return "SnowflakeStreamConfigValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DatabaseColumnValidationError) Error() string {
+func (e SnowflakeStreamConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1509,14 +8351,14 @@ func (e DatabaseColumnValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDatabaseColumn.%s: %s%s",
+		"invalid %sSnowflakeStreamConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DatabaseColumnValidationError{}
+var _ error = SnowflakeStreamConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -1524,75 +8366,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DatabaseColumnValidationError{}
+} = SnowflakeStreamConfigValidationError{}
 
-// Validate checks the field values on GetConnectionSchemaRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SnowflakeSchemaConfig with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetConnectionSchemaRequest) Validate() error {
+func (m *SnowflakeSchemaConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetConnectionSchemaRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SnowflakeSchemaConfig with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetConnectionSchemaRequestMultiError, or nil if none found.
-func (m *GetConnectionSchemaRequest) ValidateAll() error {
+// SnowflakeSchemaConfigMultiError, or nil if none found.
+func (m *SnowflakeSchemaConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConnectionSchemaRequest) validate(all bool) error {
+func (m *SnowflakeSchemaConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ConnectionId
-
-	if all {
-		switch v := interface{}(m.GetSchemaConfig()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetConnectionSchemaRequestValidationError{
-					field:  "SchemaConfig",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetConnectionSchemaRequestValidationError{
-					field:  "SchemaConfig",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSchemaConfig()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetConnectionSchemaRequestValidationError{
-				field:  "SchemaConfig",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return GetConnectionSchemaRequestMultiError(errors)
+		return SnowflakeSchemaConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConnectionSchemaRequestMultiError is an error wrapping multiple
-// validation errors returned by GetConnectionSchemaRequest.ValidateAll() if
-// the designated constraints aren't met.
-type GetConnectionSchemaRequestMultiError []error
+// SnowflakeSchemaConfigMultiError is an error wrapping multiple validation
+// errors returned by SnowflakeSchemaConfig.ValidateAll() if the designated
+// constraints aren't met.
+type SnowflakeSchemaConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConnectionSchemaRequestMultiError) Error() string {
+func (m SnowflakeSchemaConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1601,11 +8412,11 @@ func (m GetConnectionSchemaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConnectionSchemaRequestMultiError) AllErrors() []error { return m }
+func (m SnowflakeSchemaConfigMultiError) AllErrors() []error { return m }
 
-// GetConnectionSchemaRequestValidationError is the validation error returned
-// by GetConnectionSchemaRequest.Validate if the designated constraints aren't met.
-type GetConnectionSchemaRequestValidationError struct {
+// SnowflakeSchemaConfigValidationError is the validation error returned by
+// SnowflakeSchemaConfig.Validate if the designated constraints aren't met.
+type SnowflakeSchemaConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1613,24 +8424,24 @@ type GetConnectionSchemaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConnectionSchemaRequestValidationError) Field() string { return e.field }
+func (e SnowflakeSchemaConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConnectionSchemaRequestValidationError) Reason() string { return e.reason }
+func (e SnowflakeSchemaConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConnectionSchemaRequestValidationError) Cause() error { return e.cause }
+func (e SnowflakeSchemaConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConnectionSchemaRequestValidationError) Key() bool { return e.key }
+func (e SnowflakeSchemaConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConnectionSchemaRequestValidationError) ErrorName() string {
-	return "GetConnectionSchemaRequestValidationError"
+func (e SnowflakeSchemaConfigValidationError) ErrorName() string {
+	return "SnowflakeSchemaConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetConnectionSchemaRequestValidationError) Error() string {
+func (e SnowflakeSchemaConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1642,14 +8453,14 @@ func (e GetConnectionSchemaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConnectionSchemaRequest.%s: %s%s",
+		"invalid %sSnowflakeSchemaConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConnectionSchemaRequestValidationError{}
+var _ error = SnowflakeSchemaConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -1657,78 +8468,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConnectionSchemaRequestValidationError{}
+} = SnowflakeSchemaConfigValidationError{}
 
-// Validate checks the field values on GetConnectionSchemaResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on BigQueryStreamConfig with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetConnectionSchemaResponse) Validate() error {
+func (m *BigQueryStreamConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetConnectionSchemaResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on BigQueryStreamConfig with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetConnectionSchemaResponseMultiError, or nil if none found.
-func (m *GetConnectionSchemaResponse) ValidateAll() error {
+// BigQueryStreamConfigMultiError, or nil if none found.
+func (m *BigQueryStreamConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConnectionSchemaResponse) validate(all bool) error {
+func (m *BigQueryStreamConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetSchemas() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetConnectionSchemaResponseValidationError{
-						field:  fmt.Sprintf("Schemas[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetConnectionSchemaResponseValidationError{
-						field:  fmt.Sprintf("Schemas[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetConnectionSchemaResponseValidationError{
-					field:  fmt.Sprintf("Schemas[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return GetConnectionSchemaResponseMultiError(errors)
+		return BigQueryStreamConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConnectionSchemaResponseMultiError is an error wrapping multiple
-// validation errors returned by GetConnectionSchemaResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetConnectionSchemaResponseMultiError []error
+// BigQueryStreamConfigMultiError is an error wrapping multiple validation
+// errors returned by BigQueryStreamConfig.ValidateAll() if the designated
+// constraints aren't met.
+type BigQueryStreamConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConnectionSchemaResponseMultiError) Error() string {
+func (m BigQueryStreamConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1737,12 +8514,11 @@ func (m GetConnectionSchemaResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConnectionSchemaResponseMultiError) AllErrors() []error { return m }
+func (m BigQueryStreamConfigMultiError) AllErrors() []error { return m }
 
-// GetConnectionSchemaResponseValidationError is the validation error returned
-// by GetConnectionSchemaResponse.Validate if the designated constraints
-// aren't met.
-type GetConnectionSchemaResponseValidationError struct {
+// BigQueryStreamConfigValidationError is the validation error returned by
+// BigQueryStreamConfig.Validate if the designated constraints aren't met.
+type BigQueryStreamConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1750,24 +8526,24 @@ type GetConnectionSchemaResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConnectionSchemaResponseValidationError) Field() string { return e.field }
+func (e BigQueryStreamConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConnectionSchemaResponseValidationError) Reason() string { return e.reason }
+func (e BigQueryStreamConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConnectionSchemaResponseValidationError) Cause() error { return e.cause }
+func (e BigQueryStreamConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConnectionSchemaResponseValidationError) Key() bool { return e.key }
+func (e BigQueryStreamConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConnectionSchemaResponseValidationError) ErrorName() string {
-	return "GetConnectionSchemaResponseValidationError"
+func (e BigQueryStreamConfigValidationError) ErrorName() string {
+	return "BigQueryStreamConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetConnectionSchemaResponseValidationError) Error() string {
+func (e BigQueryStreamConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1779,14 +8555,14 @@ func (e GetConnectionSchemaResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConnectionSchemaResponse.%s: %s%s",
+		"invalid %sBigQueryStreamConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConnectionSchemaResponseValidationError{}
+var _ error = BigQueryStreamConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -1794,49 +8570,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConnectionSchemaResponseValidationError{}
+} = BigQueryStreamConfigValidationError{}
 
-// Validate checks the field values on GetConnectionForeignConstraintsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *GetConnectionForeignConstraintsRequest) Validate() error {
+// Validate checks the field values on BigQuerySchemaConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BigQuerySchemaConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// GetConnectionForeignConstraintsRequest with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// GetConnectionForeignConstraintsRequestMultiError, or nil if none found.
-func (m *GetConnectionForeignConstraintsRequest) ValidateAll() error {
+// ValidateAll checks the field values on BigQuerySchemaConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BigQuerySchemaConfigMultiError, or nil if none found.
+func (m *BigQuerySchemaConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConnectionForeignConstraintsRequest) validate(all bool) error {
+func (m *BigQuerySchemaConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ConnectionId
-
 	if len(errors) > 0 {
-		return GetConnectionForeignConstraintsRequestMultiError(errors)
+		return BigQuerySchemaConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConnectionForeignConstraintsRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// GetConnectionForeignConstraintsRequest.ValidateAll() if the designated
+// BigQuerySchemaConfigMultiError is an error wrapping multiple validation
+// errors returned by BigQuerySchemaConfig.ValidateAll() if the designated
 // constraints aren't met.
-type GetConnectionForeignConstraintsRequestMultiError []error
+type BigQuerySchemaConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConnectionForeignConstraintsRequestMultiError) Error() string {
+func (m BigQuerySchemaConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1845,12 +8616,11 @@ func (m GetConnectionForeignConstraintsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConnectionForeignConstraintsRequestMultiError) AllErrors() []error { return m }
+func (m BigQuerySchemaConfigMultiError) AllErrors() []error { return m }
 
-// GetConnectionForeignConstraintsRequestValidationError is the validation
-// error returned by GetConnectionForeignConstraintsRequest.Validate if the
-// designated constraints aren't met.
-type GetConnectionForeignConstraintsRequestValidationError struct {
+// BigQuerySchemaConfigValidationError is the validation error returned by
+// BigQuerySchemaConfig.Validate if the designated constraints aren't met.
+type BigQuerySchemaConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1858,24 +8628,24 @@ type GetConnectionForeignConstraintsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConnectionForeignConstraintsRequestValidationError) Field() string { return e.field }
+func (e BigQuerySchemaConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConnectionForeignConstraintsRequestValidationError) Reason() string { return e.reason }
+func (e BigQuerySchemaConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConnectionForeignConstraintsRequestValidationError) Cause() error { return e.cause }
+func (e BigQuerySchemaConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConnectionForeignConstraintsRequestValidationError) Key() bool { return e.key }
+func (e BigQuerySchemaConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConnectionForeignConstraintsRequestValidationError) ErrorName() string {
-	return "GetConnectionForeignConstraintsRequestValidationError"
+func (e BigQuerySchemaConfigValidationError) ErrorName() string {
+	return "BigQuerySchemaConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetConnectionForeignConstraintsRequestValidationError) Error() string {
+func (e BigQuerySchemaConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1887,14 +8657,14 @@ func (e GetConnectionForeignConstraintsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConnectionForeignConstraintsRequest.%s: %s%s",
+		"invalid %sBigQuerySchemaConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConnectionForeignConstraintsRequestValidationError{}
+var _ error = BigQuerySchemaConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -1902,47 +8672,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConnectionForeignConstraintsRequestValidationError{}
+} = BigQuerySchemaConfigValidationError{}
 
-// Validate checks the field values on ForeignKey with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ForeignKey) Validate() error {
+// Validate checks the field values on RedshiftStreamConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RedshiftStreamConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ForeignKey with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ForeignKeyMultiError, or
-// nil if none found.
-func (m *ForeignKey) ValidateAll() error {
+// ValidateAll checks the field values on RedshiftStreamConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RedshiftStreamConfigMultiError, or nil if none found.
+func (m *RedshiftStreamConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ForeignKey) validate(all bool) error {
+func (m *RedshiftStreamConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Table
-
-	// no validation rules for Column
-
 	if len(errors) > 0 {
-		return ForeignKeyMultiError(errors)
+		return RedshiftStreamConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// ForeignKeyMultiError is an error wrapping multiple validation errors
-// returned by ForeignKey.ValidateAll() if the designated constraints aren't met.
-type ForeignKeyMultiError []error
+// RedshiftStreamConfigMultiError is an error wrapping multiple validation
+// errors returned by RedshiftStreamConfig.ValidateAll() if the designated
+// constraints aren't met.
+type RedshiftStreamConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ForeignKeyMultiError) Error() string {
+func (m RedshiftStreamConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1951,11 +8718,11 @@ func (m ForeignKeyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ForeignKeyMultiError) AllErrors() []error { return m }
+func (m RedshiftStreamConfigMultiError) AllErrors() []error { return m }
 
-// ForeignKeyValidationError is the validation error returned by
-// ForeignKey.Validate if the designated constraints aren't met.
-type ForeignKeyValidationError struct {
+// RedshiftStreamConfigValidationError is the validation error returned by
+// RedshiftStreamConfig.Validate if the designated constraints aren't met.
+type RedshiftStreamConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1963,22 +8730,24 @@ type ForeignKeyValidationError struct {
 }
 
 // Field function returns field value.
-func (e ForeignKeyValidationError) Field() string { return e.field }
+func (e RedshiftStreamConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ForeignKeyValidationError) Reason() string { return e.reason }
+func (e RedshiftStreamConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ForeignKeyValidationError) Cause() error { return e.cause }
+func (e RedshiftStreamConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ForeignKeyValidationError) Key() bool { return e.key }
+func (e RedshiftStreamConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ForeignKeyValidationError) ErrorName() string { return "ForeignKeyValidationError" }
+func (e RedshiftStreamConfigValidationError) ErrorName() string {
+	return "RedshiftStreamConfigValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ForeignKeyValidationError) Error() string {
+func (e RedshiftStreamConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1990,14 +8759,14 @@ func (e ForeignKeyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sForeignKey.%s: %s%s",
+		"invalid %sRedshiftStreamConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ForeignKeyValidationError{}
+var _ error = RedshiftStreamConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -2005,77 +8774,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ForeignKeyValidationError{}
+} = RedshiftStreamConfigValidationError{}
 
-// Validate checks the field values on ForeignConstraint with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ForeignConstraint) Validate() error {
+// Validate checks the field values on RedshiftSchemaConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RedshiftSchemaConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ForeignConstraint with the rules
+// ValidateAll checks the field values on RedshiftSchemaConfig with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ForeignConstraintMultiError, or nil if none found.
-func (m *ForeignConstraint) ValidateAll() error {
+// RedshiftSchemaConfigMultiError, or nil if none found.
+func (m *RedshiftSchemaConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ForeignConstraint) validate(all bool) error {
+func (m *RedshiftSchemaConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Column
-
-	// no validation rules for IsNullable
-
-	if all {
-		switch v := interface{}(m.GetForeignKey()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ForeignConstraintValidationError{
-					field:  "ForeignKey",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ForeignConstraintValidationError{
-					field:  "ForeignKey",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetForeignKey()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ForeignConstraintValidationError{
-				field:  "ForeignKey",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return ForeignConstraintMultiError(errors)
+		return RedshiftSchemaConfigMultiError(errors)
 	}
 
 	return nil
-}
-
-// ForeignConstraintMultiError is an error wrapping multiple validation errors
-// returned by ForeignConstraint.ValidateAll() if the designated constraints
-// aren't met.
-type ForeignConstraintMultiError []error
+}
+
+// RedshiftSchemaConfigMultiError is an error wrapping multiple validation
+// errors returned by RedshiftSchemaConfig.ValidateAll() if the designated
+// constraints aren't met.
+type RedshiftSchemaConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ForeignConstraintMultiError) Error() string {
+func (m RedshiftSchemaConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2084,11 +8820,11 @@ func (m ForeignConstraintMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ForeignConstraintMultiError) AllErrors() []error { return m }
+func (m RedshiftSchemaConfigMultiError) AllErrors() []error { return m }
 
-// ForeignConstraintValidationError is the validation error returned by
-// ForeignConstraint.Validate if the designated constraints aren't met.
-type ForeignConstraintValidationError struct {
+// RedshiftSchemaConfigValidationError is the validation error returned by
+// RedshiftSchemaConfig.Validate if the designated constraints aren't met.
+type RedshiftSchemaConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2096,24 +8832,24 @@ type ForeignConstraintValidationError struct {
 }
 
 // Field function returns field value.
-func (e ForeignConstraintValidationError) Field() string { return e.field }
+func (e RedshiftSchemaConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ForeignConstraintValidationError) Reason() string { return e.reason }
+func (e RedshiftSchemaConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ForeignConstraintValidationError) Cause() error { return e.cause }
+func (e RedshiftSchemaConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ForeignConstraintValidationError) Key() bool { return e.key }
+func (e RedshiftSchemaConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ForeignConstraintValidationError) ErrorName() string {
-	return "ForeignConstraintValidationError"
+func (e RedshiftSchemaConfigValidationError) ErrorName() string {
+	return "RedshiftSchemaConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ForeignConstraintValidationError) Error() string {
+func (e RedshiftSchemaConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2125,14 +8861,14 @@ func (e ForeignConstraintValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sForeignConstraint.%s: %s%s",
+		"invalid %sRedshiftSchemaConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ForeignConstraintValidationError{}
+var _ error = RedshiftSchemaConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -2140,78 +8876,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ForeignConstraintValidationError{}
+} = RedshiftSchemaConfigValidationError{}
 
-// Validate checks the field values on ForeignConstraintTables with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ForeignConstraintTables) Validate() error {
+// Validate checks the field values on TableIdentifier with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TableIdentifier) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ForeignConstraintTables with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TableIdentifier with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ForeignConstraintTablesMultiError, or nil if none found.
-func (m *ForeignConstraintTables) ValidateAll() error {
+// TableIdentifierMultiError, or nil if none found.
+func (m *TableIdentifier) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ForeignConstraintTables) validate(all bool) error {
+func (m *TableIdentifier) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetConstraints() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ForeignConstraintTablesValidationError{
-						field:  fmt.Sprintf("Constraints[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ForeignConstraintTablesValidationError{
-						field:  fmt.Sprintf("Constraints[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ForeignConstraintTablesValidationError{
-					field:  fmt.Sprintf("Constraints[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Schema
 
-	}
+	// no validation rules for Table
 
 	if len(errors) > 0 {
-		return ForeignConstraintTablesMultiError(errors)
+		return TableIdentifierMultiError(errors)
 	}
 
 	return nil
 }
 
-// ForeignConstraintTablesMultiError is an error wrapping multiple validation
-// errors returned by ForeignConstraintTables.ValidateAll() if the designated
-// constraints aren't met.
-type ForeignConstraintTablesMultiError []error
+// TableIdentifierMultiError is an error wrapping multiple validation errors
+// returned by TableIdentifier.ValidateAll() if the designated constraints
+// aren't met.
+type TableIdentifierMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ForeignConstraintTablesMultiError) Error() string {
+func (m TableIdentifierMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2220,11 +8926,11 @@ func (m ForeignConstraintTablesMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ForeignConstraintTablesMultiError) AllErrors() []error { return m }
+func (m TableIdentifierMultiError) AllErrors() []error { return m }
 
-// ForeignConstraintTablesValidationError is the validation error returned by
-// ForeignConstraintTables.Validate if the designated constraints aren't met.
-type ForeignConstraintTablesValidationError struct {
+// TableIdentifierValidationError is the validation error returned by
+// TableIdentifier.Validate if the designated constraints aren't met.
+type TableIdentifierValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2232,24 +8938,22 @@ type ForeignConstraintTablesValidationError struct {
 }
 
 // Field function returns field value.
-func (e ForeignConstraintTablesValidationError) Field() string { return e.field }
+func (e TableIdentifierValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ForeignConstraintTablesValidationError) Reason() string { return e.reason }
+func (e TableIdentifierValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ForeignConstraintTablesValidationError) Cause() error { return e.cause }
+func (e TableIdentifierValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ForeignConstraintTablesValidationError) Key() bool { return e.key }
+func (e TableIdentifierValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ForeignConstraintTablesValidationError) ErrorName() string {
-	return "ForeignConstraintTablesValidationError"
-}
+func (e TableIdentifierValidationError) ErrorName() string { return "TableIdentifierValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ForeignConstraintTablesValidationError) Error() string {
+func (e TableIdentifierValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2261,14 +8965,14 @@ func (e ForeignConstraintTablesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sForeignConstraintTables.%s: %s%s",
+		"invalid %sTableIdentifier.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ForeignConstraintTablesValidationError{}
+var _ error = TableIdentifierValidationError{}
 
 var _ interface {
 	Field() string
@@ -2276,93 +8980,107 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ForeignConstraintTablesValidationError{}
+} = TableIdentifierValidationError{}
 
-// Validate checks the field values on GetConnectionForeignConstraintsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *GetConnectionForeignConstraintsResponse) Validate() error {
+// Validate checks the field values on TableForeignConstraints with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TableForeignConstraints) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// GetConnectionForeignConstraintsResponse with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// GetConnectionForeignConstraintsResponseMultiError, or nil if none found.
-func (m *GetConnectionForeignConstraintsResponse) ValidateAll() error {
+// ValidateAll checks the field values on TableForeignConstraints with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TableForeignConstraintsMultiError, or nil if none found.
+func (m *TableForeignConstraints) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConnectionForeignConstraintsResponse) validate(all bool) error {
+func (m *TableForeignConstraints) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	{
-		sorted_keys := make([]string, len(m.GetTableConstraints()))
-		i := 0
-		for key := range m.GetTableConstraints() {
-			sorted_keys[i] = key
-			i++
+	if all {
+		switch v := interface{}(m.GetTable()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TableForeignConstraintsValidationError{
+					field:  "Table",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TableForeignConstraintsValidationError{
+					field:  "Table",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetTableConstraints()[key]
-			_ = val
+	} else if v, ok := interface{}(m.GetTable()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TableForeignConstraintsValidationError{
+				field:  "Table",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-			// no validation rules for TableConstraints[key]
+	for idx, item := range m.GetConstraints() {
+		_, _ = idx, item
 
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, GetConnectionForeignConstraintsResponseValidationError{
-							field:  fmt.Sprintf("TableConstraints[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, GetConnectionForeignConstraintsResponseValidationError{
-							field:  fmt.Sprintf("TableConstraints[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TableForeignConstraintsValidationError{
+						field:  fmt.Sprintf("Constraints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
 				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					return GetConnectionForeignConstraintsResponseValidationError{
-						field:  fmt.Sprintf("TableConstraints[%v]", key),
+					errors = append(errors, TableForeignConstraintsValidationError{
+						field:  fmt.Sprintf("Constraints[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
-					}
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TableForeignConstraintsValidationError{
+					field:  fmt.Sprintf("Constraints[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
 				}
 			}
-
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return GetConnectionForeignConstraintsResponseMultiError(errors)
+		return TableForeignConstraintsMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConnectionForeignConstraintsResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// GetConnectionForeignConstraintsResponse.ValidateAll() if the designated
+// TableForeignConstraintsMultiError is an error wrapping multiple validation
+// errors returned by TableForeignConstraints.ValidateAll() if the designated
 // constraints aren't met.
-type GetConnectionForeignConstraintsResponseMultiError []error
+type TableForeignConstraintsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConnectionForeignConstraintsResponseMultiError) Error() string {
+func (m TableForeignConstraintsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2371,12 +9089,11 @@ func (m GetConnectionForeignConstraintsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConnectionForeignConstraintsResponseMultiError) AllErrors() []error { return m }
+func (m TableForeignConstraintsMultiError) AllErrors() []error { return m }
 
-// GetConnectionForeignConstraintsResponseValidationError is the validation
-// error returned by GetConnectionForeignConstraintsResponse.Validate if the
-// designated constraints aren't met.
-type GetConnectionForeignConstraintsResponseValidationError struct {
+// TableForeignConstraintsValidationError is the validation error returned by
+// TableForeignConstraints.Validate if the designated constraints aren't met.
+type TableForeignConstraintsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2384,24 +9101,24 @@ type GetConnectionForeignConstraintsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConnectionForeignConstraintsResponseValidationError) Field() string { return e.field }
+func (e TableForeignConstraintsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConnectionForeignConstraintsResponseValidationError) Reason() string { return e.reason }
+func (e TableForeignConstraintsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConnectionForeignConstraintsResponseValidationError) Cause() error { return e.cause }
+func (e TableForeignConstraintsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConnectionForeignConstraintsResponseValidationError) Key() bool { return e.key }
+func (e TableForeignConstraintsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConnectionForeignConstraintsResponseValidationError) ErrorName() string {
-	return "GetConnectionForeignConstraintsResponseValidationError"
+func (e TableForeignConstraintsValidationError) ErrorName() string {
+	return "TableForeignConstraintsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetConnectionForeignConstraintsResponseValidationError) Error() string {
+func (e TableForeignConstraintsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2413,14 +9130,14 @@ func (e GetConnectionForeignConstraintsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConnectionForeignConstraintsResponse.%s: %s%s",
+		"invalid %sTableForeignConstraints.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConnectionForeignConstraintsResponseValidationError{}
+var _ error = TableForeignConstraintsValidationError{}
 
 var _ interface {
 	Field() string
@@ -2428,50 +9145,102 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConnectionForeignConstraintsResponseValidationError{}
+} = TableForeignConstraintsValidationError{}
 
-// Validate checks the field values on InitStatementOptions with the rules
+// Validate checks the field values on TablePrimaryConstraint with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *InitStatementOptions) Validate() error {
+func (m *TablePrimaryConstraint) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InitStatementOptions with the rules
+// ValidateAll checks the field values on TablePrimaryConstraint with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// InitStatementOptionsMultiError, or nil if none found.
-func (m *InitStatementOptions) ValidateAll() error {
+// TablePrimaryConstraintMultiError, or nil if none found.
+func (m *TablePrimaryConstraint) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InitStatementOptions) validate(all bool) error {
+func (m *TablePrimaryConstraint) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	// no validation rules for InitSchema
-
-	// no validation rules for TruncateBeforeInsert
-
-	// no validation rules for TruncateCascade
-
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTable()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TablePrimaryConstraintValidationError{
+					field:  "Table",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TablePrimaryConstraintValidationError{
+					field:  "Table",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTable()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TablePrimaryConstraintValidationError{
+				field:  "Table",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetConstraint()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TablePrimaryConstraintValidationError{
+					field:  "Constraint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TablePrimaryConstraintValidationError{
+					field:  "Constraint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConstraint()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TablePrimaryConstraintValidationError{
+				field:  "Constraint",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return InitStatementOptionsMultiError(errors)
+		return TablePrimaryConstraintMultiError(errors)
 	}
 
 	return nil
 }
 
-// InitStatementOptionsMultiError is an error wrapping multiple validation
-// errors returned by InitStatementOptions.ValidateAll() if the designated
+// TablePrimaryConstraintMultiError is an error wrapping multiple validation
+// errors returned by TablePrimaryConstraint.ValidateAll() if the designated
 // constraints aren't met.
-type InitStatementOptionsMultiError []error
+type TablePrimaryConstraintMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InitStatementOptionsMultiError) Error() string {
+func (m TablePrimaryConstraintMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2480,11 +9249,11 @@ func (m InitStatementOptionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InitStatementOptionsMultiError) AllErrors() []error { return m }
+func (m TablePrimaryConstraintMultiError) AllErrors() []error { return m }
 
-// InitStatementOptionsValidationError is the validation error returned by
-// InitStatementOptions.Validate if the designated constraints aren't met.
-type InitStatementOptionsValidationError struct {
+// TablePrimaryConstraintValidationError is the validation error returned by
+// TablePrimaryConstraint.Validate if the designated constraints aren't met.
+type TablePrimaryConstraintValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2492,24 +9261,24 @@ type InitStatementOptionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e InitStatementOptionsValidationError) Field() string { return e.field }
+func (e TablePrimaryConstraintValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InitStatementOptionsValidationError) Reason() string { return e.reason }
+func (e TablePrimaryConstraintValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InitStatementOptionsValidationError) Cause() error { return e.cause }
+func (e TablePrimaryConstraintValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InitStatementOptionsValidationError) Key() bool { return e.key }
+func (e TablePrimaryConstraintValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InitStatementOptionsValidationError) ErrorName() string {
-	return "InitStatementOptionsValidationError"
+func (e TablePrimaryConstraintValidationError) ErrorName() string {
+	return "TablePrimaryConstraintValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e InitStatementOptionsValidationError) Error() string {
+func (e TablePrimaryConstraintValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2521,14 +9290,14 @@ func (e InitStatementOptionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInitStatementOptions.%s: %s%s",
+		"invalid %sTablePrimaryConstraint.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InitStatementOptionsValidationError{}
+var _ error = TablePrimaryConstraintValidationError{}
 
 var _ interface {
 	Field() string
@@ -2536,77 +9305,107 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InitStatementOptionsValidationError{}
+} = TablePrimaryConstraintValidationError{}
 
-// Validate checks the field values on GetConnectionInitStatementsRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *GetConnectionInitStatementsRequest) Validate() error {
+// Validate checks the field values on TableUniqueConstraints with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TableUniqueConstraints) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetConnectionInitStatementsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// GetConnectionInitStatementsRequestMultiError, or nil if none found.
-func (m *GetConnectionInitStatementsRequest) ValidateAll() error {
+// ValidateAll checks the field values on TableUniqueConstraints with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TableUniqueConstraintsMultiError, or nil if none found.
+func (m *TableUniqueConstraints) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConnectionInitStatementsRequest) validate(all bool) error {
+func (m *TableUniqueConstraints) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ConnectionId
-
 	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
+		switch v := interface{}(m.GetTable()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetConnectionInitStatementsRequestValidationError{
-					field:  "Options",
+				errors = append(errors, TableUniqueConstraintsValidationError{
+					field:  "Table",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetConnectionInitStatementsRequestValidationError{
-					field:  "Options",
+				errors = append(errors, TableUniqueConstraintsValidationError{
+					field:  "Table",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTable()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetConnectionInitStatementsRequestValidationError{
-				field:  "Options",
+			return TableUniqueConstraintsValidationError{
+				field:  "Table",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	for idx, item := range m.GetConstraints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TableUniqueConstraintsValidationError{
+						field:  fmt.Sprintf("Constraints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TableUniqueConstraintsValidationError{
+						field:  fmt.Sprintf("Constraints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TableUniqueConstraintsValidationError{
+					field:  fmt.Sprintf("Constraints[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return GetConnectionInitStatementsRequestMultiError(errors)
+		return TableUniqueConstraintsMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConnectionInitStatementsRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// GetConnectionInitStatementsRequest.ValidateAll() if the designated
+// TableUniqueConstraintsMultiError is an error wrapping multiple validation
+// errors returned by TableUniqueConstraints.ValidateAll() if the designated
 // constraints aren't met.
-type GetConnectionInitStatementsRequestMultiError []error
+type TableUniqueConstraintsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConnectionInitStatementsRequestMultiError) Error() string {
+func (m TableUniqueConstraintsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2615,12 +9414,11 @@ func (m GetConnectionInitStatementsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConnectionInitStatementsRequestMultiError) AllErrors() []error { return m }
+func (m TableUniqueConstraintsMultiError) AllErrors() []error { return m }
 
-// GetConnectionInitStatementsRequestValidationError is the validation error
-// returned by GetConnectionInitStatementsRequest.Validate if the designated
-// constraints aren't met.
-type GetConnectionInitStatementsRequestValidationError struct {
+// TableUniqueConstraintsValidationError is the validation error returned by
+// TableUniqueConstraints.Validate if the designated constraints aren't met.
+type TableUniqueConstraintsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2628,24 +9426,24 @@ type GetConnectionInitStatementsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConnectionInitStatementsRequestValidationError) Field() string { return e.field }
+func (e TableUniqueConstraintsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConnectionInitStatementsRequestValidationError) Reason() string { return e.reason }
+func (e TableUniqueConstraintsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConnectionInitStatementsRequestValidationError) Cause() error { return e.cause }
+func (e TableUniqueConstraintsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConnectionInitStatementsRequestValidationError) Key() bool { return e.key }
+func (e TableUniqueConstraintsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConnectionInitStatementsRequestValidationError) ErrorName() string {
-	return "GetConnectionInitStatementsRequestValidationError"
+func (e TableUniqueConstraintsValidationError) ErrorName() string {
+	return "TableUniqueConstraintsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetConnectionInitStatementsRequestValidationError) Error() string {
+func (e TableUniqueConstraintsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2657,14 +9455,14 @@ func (e GetConnectionInitStatementsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConnectionInitStatementsRequest.%s: %s%s",
+		"invalid %sTableUniqueConstraints.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConnectionInitStatementsRequestValidationError{}
+var _ error = TableUniqueConstraintsValidationError{}
 
 var _ interface {
 	Field() string
@@ -2672,50 +9470,81 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConnectionInitStatementsRequestValidationError{}
+} = TableUniqueConstraintsValidationError{}
 
-// Validate checks the field values on GetConnectionInitStatementsResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *GetConnectionInitStatementsResponse) Validate() error {
+// Validate checks the field values on TableInitStatements with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TableInitStatements) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetConnectionInitStatementsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// GetConnectionInitStatementsResponseMultiError, or nil if none found.
-func (m *GetConnectionInitStatementsResponse) ValidateAll() error {
+// ValidateAll checks the field values on TableInitStatements with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TableInitStatementsMultiError, or nil if none found.
+func (m *TableInitStatements) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConnectionInitStatementsResponse) validate(all bool) error {
+func (m *TableInitStatements) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TableInitStatements
+	if all {
+		switch v := interface{}(m.GetTable()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TableInitStatementsValidationError{
+					field:  "Table",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TableInitStatementsValidationError{
+					field:  "Table",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTable()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TableInitStatementsValidationError{
+				field:  "Table",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for TableTruncateStatements
+	if m.InitStatement != nil {
+		// no validation rules for InitStatement
+	}
+
+	if m.TruncateStatement != nil {
+		// no validation rules for TruncateStatement
+	}
 
 	if len(errors) > 0 {
-		return GetConnectionInitStatementsResponseMultiError(errors)
+		return TableInitStatementsMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConnectionInitStatementsResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// GetConnectionInitStatementsResponse.ValidateAll() if the designated
+// TableInitStatementsMultiError is an error wrapping multiple validation
+// errors returned by TableInitStatements.ValidateAll() if the designated
 // constraints aren't met.
-type GetConnectionInitStatementsResponseMultiError []error
+type TableInitStatementsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConnectionInitStatementsResponseMultiError) Error() string {
+func (m TableInitStatementsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2724,12 +9553,11 @@ func (m GetConnectionInitStatementsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConnectionInitStatementsResponseMultiError) AllErrors() []error { return m }
+func (m TableInitStatementsMultiError) AllErrors() []error { return m }
 
-// GetConnectionInitStatementsResponseValidationError is the validation error
-// returned by GetConnectionInitStatementsResponse.Validate if the designated
-// constraints aren't met.
-type GetConnectionInitStatementsResponseValidationError struct {
+// TableInitStatementsValidationError is the validation error returned by
+// TableInitStatements.Validate if the designated constraints aren't met.
+type TableInitStatementsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2737,24 +9565,24 @@ type GetConnectionInitStatementsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConnectionInitStatementsResponseValidationError) Field() string { return e.field }
+func (e TableInitStatementsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConnectionInitStatementsResponseValidationError) Reason() string { return e.reason }
+func (e TableInitStatementsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConnectionInitStatementsResponseValidationError) Cause() error { return e.cause }
+func (e TableInitStatementsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConnectionInitStatementsResponseValidationError) Key() bool { return e.key }
+func (e TableInitStatementsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConnectionInitStatementsResponseValidationError) ErrorName() string {
-	return "GetConnectionInitStatementsResponseValidationError"
+func (e TableInitStatementsValidationError) ErrorName() string {
+	return "TableInitStatementsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetConnectionInitStatementsResponseValidationError) Error() string {
+func (e TableInitStatementsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2766,14 +9594,14 @@ func (e GetConnectionInitStatementsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConnectionInitStatementsResponse.%s: %s%s",
+		"invalid %sTableInitStatements.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConnectionInitStatementsResponseValidationError{}
+var _ error = TableInitStatementsValidationError{}
 
 var _ interface {
 	Field() string
@@ -2781,151 +9609,208 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConnectionInitStatementsResponseValidationError{}
+} = TableInitStatementsValidationError{}
 
-// Validate checks the field values on PrimaryConstraint with the rules defined
+// Validate checks the field values on TableConstraints with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *PrimaryConstraint) Validate() error {
+func (m *TableConstraints) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PrimaryConstraint with the rules
+// ValidateAll checks the field values on TableConstraints with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PrimaryConstraintMultiError, or nil if none found.
-func (m *PrimaryConstraint) ValidateAll() error {
+// TableConstraintsMultiError, or nil if none found.
+func (m *TableConstraints) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PrimaryConstraint) validate(all bool) error {
+func (m *TableConstraints) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(errors) > 0 {
-		return PrimaryConstraintMultiError(errors)
-	}
-
-	return nil
-}
-
-// PrimaryConstraintMultiError is an error wrapping multiple validation errors
-// returned by PrimaryConstraint.ValidateAll() if the designated constraints
-// aren't met.
-type PrimaryConstraintMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m PrimaryConstraintMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m PrimaryConstraintMultiError) AllErrors() []error { return m }
-
-// PrimaryConstraintValidationError is the validation error returned by
-// PrimaryConstraint.Validate if the designated constraints aren't met.
-type PrimaryConstraintValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e PrimaryConstraintValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e PrimaryConstraintValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e PrimaryConstraintValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e PrimaryConstraintValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e PrimaryConstraintValidationError) ErrorName() string {
-	return "PrimaryConstraintValidationError"
-}
-
-// Error satisfies the builtin error interface
-func (e PrimaryConstraintValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	if all {
+		switch v := interface{}(m.GetTable()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TableConstraintsValidationError{
+					field:  "Table",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TableConstraintsValidationError{
+					field:  "Table",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTable()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TableConstraintsValidationError{
+				field:  "Table",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	key := ""
-	if e.key {
-		key = "key for "
+	for idx, item := range m.GetForeignKeyConstraints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TableConstraintsValidationError{
+						field:  fmt.Sprintf("ForeignKeyConstraints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TableConstraintsValidationError{
+						field:  fmt.Sprintf("ForeignKeyConstraints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TableConstraintsValidationError{
+					field:  fmt.Sprintf("ForeignKeyConstraints[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
-	return fmt.Sprintf(
-		"invalid %sPrimaryConstraint.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
+	for idx, item := range m.GetUniqueConstraints() {
+		_, _ = idx, item
 
-var _ error = PrimaryConstraintValidationError{}
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TableConstraintsValidationError{
+						field:  fmt.Sprintf("UniqueConstraints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TableConstraintsValidationError{
+						field:  fmt.Sprintf("UniqueConstraints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TableConstraintsValidationError{
+					field:  fmt.Sprintf("UniqueConstraints[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = PrimaryConstraintValidationError{}
+	}
 
-// Validate checks the field values on GetConnectionPrimaryConstraintsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *GetConnectionPrimaryConstraintsRequest) Validate() error {
-	return m.validate(false)
-}
+	for idx, item := range m.GetExclusionConstraints() {
+		_, _ = idx, item
 
-// ValidateAll checks the field values on
-// GetConnectionPrimaryConstraintsRequest with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// GetConnectionPrimaryConstraintsRequestMultiError, or nil if none found.
-func (m *GetConnectionPrimaryConstraintsRequest) ValidateAll() error {
-	return m.validate(true)
-}
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TableConstraintsValidationError{
+						field:  fmt.Sprintf("ExclusionConstraints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TableConstraintsValidationError{
+						field:  fmt.Sprintf("ExclusionConstraints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TableConstraintsValidationError{
+					field:  fmt.Sprintf("ExclusionConstraints[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-func (m *GetConnectionPrimaryConstraintsRequest) validate(all bool) error {
-	if m == nil {
-		return nil
 	}
 
-	var errors []error
+	if m.PrimaryKeyConstraint != nil {
 
-	// no validation rules for ConnectionId
+		if all {
+			switch v := interface{}(m.GetPrimaryKeyConstraint()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TableConstraintsValidationError{
+						field:  "PrimaryKeyConstraint",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TableConstraintsValidationError{
+						field:  "PrimaryKeyConstraint",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPrimaryKeyConstraint()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TableConstraintsValidationError{
+					field:  "PrimaryKeyConstraint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return GetConnectionPrimaryConstraintsRequestMultiError(errors)
+		return TableConstraintsMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConnectionPrimaryConstraintsRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// GetConnectionPrimaryConstraintsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetConnectionPrimaryConstraintsRequestMultiError []error
+// TableConstraintsMultiError is an error wrapping multiple validation errors
+// returned by TableConstraints.ValidateAll() if the designated constraints
+// aren't met.
+type TableConstraintsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConnectionPrimaryConstraintsRequestMultiError) Error() string {
+func (m TableConstraintsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2934,12 +9819,11 @@ func (m GetConnectionPrimaryConstraintsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConnectionPrimaryConstraintsRequestMultiError) AllErrors() []error { return m }
+func (m TableConstraintsMultiError) AllErrors() []error { return m }
 
-// GetConnectionPrimaryConstraintsRequestValidationError is the validation
-// error returned by GetConnectionPrimaryConstraintsRequest.Validate if the
-// designated constraints aren't met.
-type GetConnectionPrimaryConstraintsRequestValidationError struct {
+// TableConstraintsValidationError is the validation error returned by
+// TableConstraints.Validate if the designated constraints aren't met.
+type TableConstraintsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2947,24 +9831,22 @@ type GetConnectionPrimaryConstraintsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConnectionPrimaryConstraintsRequestValidationError) Field() string { return e.field }
+func (e TableConstraintsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConnectionPrimaryConstraintsRequestValidationError) Reason() string { return e.reason }
+func (e TableConstraintsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConnectionPrimaryConstraintsRequestValidationError) Cause() error { return e.cause }
+func (e TableConstraintsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConnectionPrimaryConstraintsRequestValidationError) Key() bool { return e.key }
+func (e TableConstraintsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConnectionPrimaryConstraintsRequestValidationError) ErrorName() string {
-	return "GetConnectionPrimaryConstraintsRequestValidationError"
-}
+func (e TableConstraintsValidationError) ErrorName() string { return "TableConstraintsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetConnectionPrimaryConstraintsRequestValidationError) Error() string {
+func (e TableConstraintsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2976,14 +9858,14 @@ func (e GetConnectionPrimaryConstraintsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConnectionPrimaryConstraintsRequest.%s: %s%s",
+		"invalid %sTableConstraints.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConnectionPrimaryConstraintsRequestValidationError{}
+var _ error = TableConstraintsValidationError{}
 
 var _ interface {
 	Field() string
@@ -2991,93 +9873,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConnectionPrimaryConstraintsRequestValidationError{}
+} = TableConstraintsValidationError{}
 
-// Validate checks the field values on GetConnectionPrimaryConstraintsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *GetConnectionPrimaryConstraintsResponse) Validate() error {
+// Validate checks the field values on GcpCloudStorageStreamConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GcpCloudStorageStreamConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// GetConnectionPrimaryConstraintsResponse with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// GetConnectionPrimaryConstraintsResponseMultiError, or nil if none found.
-func (m *GetConnectionPrimaryConstraintsResponse) ValidateAll() error {
+// ValidateAll checks the field values on GcpCloudStorageStreamConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GcpCloudStorageStreamConfigMultiError, or nil if none found.
+func (m *GcpCloudStorageStreamConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConnectionPrimaryConstraintsResponse) validate(all bool) error {
+func (m *GcpCloudStorageStreamConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	{
-		sorted_keys := make([]string, len(m.GetTableConstraints()))
-		i := 0
-		for key := range m.GetTableConstraints() {
-			sorted_keys[i] = key
-			i++
+	switch v := m.Id.(type) {
+	case *GcpCloudStorageStreamConfig_JobId:
+		if v == nil {
+			err := GcpCloudStorageStreamConfigValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetTableConstraints()[key]
-			_ = val
-
-			// no validation rules for TableConstraints[key]
-
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, GetConnectionPrimaryConstraintsResponseValidationError{
-							field:  fmt.Sprintf("TableConstraints[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, GetConnectionPrimaryConstraintsResponseValidationError{
-							field:  fmt.Sprintf("TableConstraints[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
-				if err := v.Validate(); err != nil {
-					return GetConnectionPrimaryConstraintsResponseValidationError{
-						field:  fmt.Sprintf("TableConstraints[%v]", key),
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
-				}
+		// no validation rules for JobId
+	case *GcpCloudStorageStreamConfig_JobRunId:
+		if v == nil {
+			err := GcpCloudStorageStreamConfigValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
 			}
-
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
+		// no validation rules for JobRunId
+	default:
+		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return GetConnectionPrimaryConstraintsResponseMultiError(errors)
+		return GcpCloudStorageStreamConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConnectionPrimaryConstraintsResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// GetConnectionPrimaryConstraintsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetConnectionPrimaryConstraintsResponseMultiError []error
+// GcpCloudStorageStreamConfigMultiError is an error wrapping multiple
+// validation errors returned by GcpCloudStorageStreamConfig.ValidateAll() if
+// the designated constraints aren't met.
+type GcpCloudStorageStreamConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConnectionPrimaryConstraintsResponseMultiError) Error() string {
+func (m GcpCloudStorageStreamConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3086,12 +9948,12 @@ func (m GetConnectionPrimaryConstraintsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConnectionPrimaryConstraintsResponseMultiError) AllErrors() []error { return m }
+func (m GcpCloudStorageStreamConfigMultiError) AllErrors() []error { return m }
 
-// GetConnectionPrimaryConstraintsResponseValidationError is the validation
-// error returned by GetConnectionPrimaryConstraintsResponse.Validate if the
-// designated constraints aren't met.
-type GetConnectionPrimaryConstraintsResponseValidationError struct {
+// GcpCloudStorageStreamConfigValidationError is the validation error returned
+// by GcpCloudStorageStreamConfig.Validate if the designated constraints
+// aren't met.
+type GcpCloudStorageStreamConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3099,24 +9961,24 @@ type GetConnectionPrimaryConstraintsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConnectionPrimaryConstraintsResponseValidationError) Field() string { return e.field }
+func (e GcpCloudStorageStreamConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConnectionPrimaryConstraintsResponseValidationError) Reason() string { return e.reason }
+func (e GcpCloudStorageStreamConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConnectionPrimaryConstraintsResponseValidationError) Cause() error { return e.cause }
+func (e GcpCloudStorageStreamConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConnectionPrimaryConstraintsResponseValidationError) Key() bool { return e.key }
+func (e GcpCloudStorageStreamConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConnectionPrimaryConstraintsResponseValidationError) ErrorName() string {
-	return "GetConnectionPrimaryConstraintsResponseValidationError"
+func (e GcpCloudStorageStreamConfigValidationError) ErrorName() string {
+	return "GcpCloudStorageStreamConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetConnectionPrimaryConstraintsResponseValidationError) Error() string {
+func (e GcpCloudStorageStreamConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3128,14 +9990,14 @@ func (e GetConnectionPrimaryConstraintsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConnectionPrimaryConstraintsResponse.%s: %s%s",
+		"invalid %sGcpCloudStorageStreamConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConnectionPrimaryConstraintsResponseValidationError{}
+var _ error = GcpCloudStorageStreamConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -3143,48 +10005,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConnectionPrimaryConstraintsResponseValidationError{}
+} = GcpCloudStorageStreamConfigValidationError{}
 
-// Validate checks the field values on GetConnectionUniqueConstraintsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *GetConnectionUniqueConstraintsRequest) Validate() error {
+// Validate checks the field values on GcpCloudStorageSchemaConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GcpCloudStorageSchemaConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetConnectionUniqueConstraintsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// GetConnectionUniqueConstraintsRequestMultiError, or nil if none found.
-func (m *GetConnectionUniqueConstraintsRequest) ValidateAll() error {
+// ValidateAll checks the field values on GcpCloudStorageSchemaConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GcpCloudStorageSchemaConfigMultiError, or nil if none found.
+func (m *GcpCloudStorageSchemaConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConnectionUniqueConstraintsRequest) validate(all bool) error {
+func (m *GcpCloudStorageSchemaConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ConnectionId
+	switch v := m.Id.(type) {
+	case *GcpCloudStorageSchemaConfig_JobId:
+		if v == nil {
+			err := GcpCloudStorageSchemaConfigValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for JobId
+	case *GcpCloudStorageSchemaConfig_JobRunId:
+		if v == nil {
+			err := GcpCloudStorageSchemaConfigValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for JobRunId
+	default:
+		_ = v // ensures v is used
+	}
 
 	if len(errors) > 0 {
-		return GetConnectionUniqueConstraintsRequestMultiError(errors)
+		return GcpCloudStorageSchemaConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConnectionUniqueConstraintsRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// GetConnectionUniqueConstraintsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetConnectionUniqueConstraintsRequestMultiError []error
+// GcpCloudStorageSchemaConfigMultiError is an error wrapping multiple
+// validation errors returned by GcpCloudStorageSchemaConfig.ValidateAll() if
+// the designated constraints aren't met.
+type GcpCloudStorageSchemaConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConnectionUniqueConstraintsRequestMultiError) Error() string {
+func (m GcpCloudStorageSchemaConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3193,12 +10080,12 @@ func (m GetConnectionUniqueConstraintsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConnectionUniqueConstraintsRequestMultiError) AllErrors() []error { return m }
+func (m GcpCloudStorageSchemaConfigMultiError) AllErrors() []error { return m }
 
-// GetConnectionUniqueConstraintsRequestValidationError is the validation error
-// returned by GetConnectionUniqueConstraintsRequest.Validate if the
-// designated constraints aren't met.
-type GetConnectionUniqueConstraintsRequestValidationError struct {
+// GcpCloudStorageSchemaConfigValidationError is the validation error returned
+// by GcpCloudStorageSchemaConfig.Validate if the designated constraints
+// aren't met.
+type GcpCloudStorageSchemaConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3206,24 +10093,24 @@ type GetConnectionUniqueConstraintsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConnectionUniqueConstraintsRequestValidationError) Field() string { return e.field }
+func (e GcpCloudStorageSchemaConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConnectionUniqueConstraintsRequestValidationError) Reason() string { return e.reason }
+func (e GcpCloudStorageSchemaConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConnectionUniqueConstraintsRequestValidationError) Cause() error { return e.cause }
+func (e GcpCloudStorageSchemaConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConnectionUniqueConstraintsRequestValidationError) Key() bool { return e.key }
+func (e GcpCloudStorageSchemaConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConnectionUniqueConstraintsRequestValidationError) ErrorName() string {
-	return "GetConnectionUniqueConstraintsRequestValidationError"
+func (e GcpCloudStorageSchemaConfigValidationError) ErrorName() string {
+	return "GcpCloudStorageSchemaConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetConnectionUniqueConstraintsRequestValidationError) Error() string {
+func (e GcpCloudStorageSchemaConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3235,14 +10122,14 @@ func (e GetConnectionUniqueConstraintsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConnectionUniqueConstraintsRequest.%s: %s%s",
+		"invalid %sGcpCloudStorageSchemaConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConnectionUniqueConstraintsRequestValidationError{}
+var _ error = GcpCloudStorageSchemaConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -3250,93 +10137,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConnectionUniqueConstraintsRequestValidationError{}
+} = GcpCloudStorageSchemaConfigValidationError{}
 
-// Validate checks the field values on GetConnectionUniqueConstraintsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *GetConnectionUniqueConstraintsResponse) Validate() error {
+// Validate checks the field values on AzureBlobStorageStreamConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AzureBlobStorageStreamConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// GetConnectionUniqueConstraintsResponse with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// GetConnectionUniqueConstraintsResponseMultiError, or nil if none found.
-func (m *GetConnectionUniqueConstraintsResponse) ValidateAll() error {
+// ValidateAll checks the field values on AzureBlobStorageStreamConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AzureBlobStorageStreamConfigMultiError, or nil if none found.
+func (m *AzureBlobStorageStreamConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConnectionUniqueConstraintsResponse) validate(all bool) error {
+func (m *AzureBlobStorageStreamConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	{
-		sorted_keys := make([]string, len(m.GetTableConstraints()))
-		i := 0
-		for key := range m.GetTableConstraints() {
-			sorted_keys[i] = key
-			i++
+	switch v := m.Id.(type) {
+	case *AzureBlobStorageStreamConfig_JobId:
+		if v == nil {
+			err := AzureBlobStorageStreamConfigValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetTableConstraints()[key]
-			_ = val
-
-			// no validation rules for TableConstraints[key]
-
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, GetConnectionUniqueConstraintsResponseValidationError{
-							field:  fmt.Sprintf("TableConstraints[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, GetConnectionUniqueConstraintsResponseValidationError{
-							field:  fmt.Sprintf("TableConstraints[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
-				if err := v.Validate(); err != nil {
-					return GetConnectionUniqueConstraintsResponseValidationError{
-						field:  fmt.Sprintf("TableConstraints[%v]", key),
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
-				}
+		// no validation rules for JobId
+	case *AzureBlobStorageStreamConfig_JobRunId:
+		if v == nil {
+			err := AzureBlobStorageStreamConfigValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
 			}
-
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
+		// no validation rules for JobRunId
+	default:
+		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return GetConnectionUniqueConstraintsResponseMultiError(errors)
+		return AzureBlobStorageStreamConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConnectionUniqueConstraintsResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// GetConnectionUniqueConstraintsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetConnectionUniqueConstraintsResponseMultiError []error
+// AzureBlobStorageStreamConfigMultiError is an error wrapping multiple
+// validation errors returned by AzureBlobStorageStreamConfig.ValidateAll() if
+// the designated constraints aren't met.
+type AzureBlobStorageStreamConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConnectionUniqueConstraintsResponseMultiError) Error() string {
+func (m AzureBlobStorageStreamConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3345,12 +10212,12 @@ func (m GetConnectionUniqueConstraintsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConnectionUniqueConstraintsResponseMultiError) AllErrors() []error { return m }
+func (m AzureBlobStorageStreamConfigMultiError) AllErrors() []error { return m }
 
-// GetConnectionUniqueConstraintsResponseValidationError is the validation
-// error returned by GetConnectionUniqueConstraintsResponse.Validate if the
-// designated constraints aren't met.
-type GetConnectionUniqueConstraintsResponseValidationError struct {
+// AzureBlobStorageStreamConfigValidationError is the validation error returned
+// by AzureBlobStorageStreamConfig.Validate if the designated constraints
+// aren't met.
+type AzureBlobStorageStreamConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3358,24 +10225,24 @@ type GetConnectionUniqueConstraintsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConnectionUniqueConstraintsResponseValidationError) Field() string { return e.field }
+func (e AzureBlobStorageStreamConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConnectionUniqueConstraintsResponseValidationError) Reason() string { return e.reason }
+func (e AzureBlobStorageStreamConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConnectionUniqueConstraintsResponseValidationError) Cause() error { return e.cause }
+func (e AzureBlobStorageStreamConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConnectionUniqueConstraintsResponseValidationError) Key() bool { return e.key }
+func (e AzureBlobStorageStreamConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConnectionUniqueConstraintsResponseValidationError) ErrorName() string {
-	return "GetConnectionUniqueConstraintsResponseValidationError"
+func (e AzureBlobStorageStreamConfigValidationError) ErrorName() string {
+	return "AzureBlobStorageStreamConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetConnectionUniqueConstraintsResponseValidationError) Error() string {
+func (e AzureBlobStorageStreamConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3387,14 +10254,14 @@ func (e GetConnectionUniqueConstraintsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConnectionUniqueConstraintsResponse.%s: %s%s",
+		"invalid %sAzureBlobStorageStreamConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConnectionUniqueConstraintsResponseValidationError{}
+var _ error = AzureBlobStorageStreamConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -3402,44 +10269,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConnectionUniqueConstraintsResponseValidationError{}
+} = AzureBlobStorageStreamConfigValidationError{}
 
-// Validate checks the field values on UniqueConstraint with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *UniqueConstraint) Validate() error {
+// Validate checks the field values on AzureBlobStorageSchemaConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AzureBlobStorageSchemaConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UniqueConstraint with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AzureBlobStorageSchemaConfig with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UniqueConstraintMultiError, or nil if none found.
-func (m *UniqueConstraint) ValidateAll() error {
+// AzureBlobStorageSchemaConfigMultiError, or nil if none found.
+func (m *AzureBlobStorageSchemaConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UniqueConstraint) validate(all bool) error {
+func (m *AzureBlobStorageSchemaConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	switch v := m.Id.(type) {
+	case *AzureBlobStorageSchemaConfig_JobId:
+		if v == nil {
+			err := AzureBlobStorageSchemaConfigValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for JobId
+	case *AzureBlobStorageSchemaConfig_JobRunId:
+		if v == nil {
+			err := AzureBlobStorageSchemaConfigValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for JobRunId
+	default:
+		_ = v // ensures v is used
+	}
+
 	if len(errors) > 0 {
-		return UniqueConstraintMultiError(errors)
+		return AzureBlobStorageSchemaConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// UniqueConstraintMultiError is an error wrapping multiple validation errors
-// returned by UniqueConstraint.ValidateAll() if the designated constraints
-// aren't met.
-type UniqueConstraintMultiError []error
+// AzureBlobStorageSchemaConfigMultiError is an error wrapping multiple
+// validation errors returned by AzureBlobStorageSchemaConfig.ValidateAll() if
+// the designated constraints aren't met.
+type AzureBlobStorageSchemaConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UniqueConstraintMultiError) Error() string {
+func (m AzureBlobStorageSchemaConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3448,11 +10344,12 @@ func (m UniqueConstraintMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UniqueConstraintMultiError) AllErrors() []error { return m }
+func (m AzureBlobStorageSchemaConfigMultiError) AllErrors() []error { return m }
 
-// UniqueConstraintValidationError is the validation error returned by
-// UniqueConstraint.Validate if the designated constraints aren't met.
-type UniqueConstraintValidationError struct {
+// AzureBlobStorageSchemaConfigValidationError is the validation error returned
+// by AzureBlobStorageSchemaConfig.Validate if the designated constraints
+// aren't met.
+type AzureBlobStorageSchemaConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3460,22 +10357,24 @@ type UniqueConstraintValidationError struct {
 }
 
 // Field function returns field value.
-func (e UniqueConstraintValidationError) Field() string { return e.field }
+func (e AzureBlobStorageSchemaConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UniqueConstraintValidationError) Reason() string { return e.reason }
+func (e AzureBlobStorageSchemaConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UniqueConstraintValidationError) Cause() error { return e.cause }
+func (e AzureBlobStorageSchemaConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UniqueConstraintValidationError) Key() bool { return e.key }
+func (e AzureBlobStorageSchemaConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UniqueConstraintValidationError) ErrorName() string { return "UniqueConstraintValidationError" }
+func (e AzureBlobStorageSchemaConfigValidationError) ErrorName() string {
+	return "AzureBlobStorageSchemaConfigValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UniqueConstraintValidationError) Error() string {
+func (e AzureBlobStorageSchemaConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3487,14 +10386,14 @@ func (e UniqueConstraintValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUniqueConstraint.%s: %s%s",
+		"invalid %sAzureBlobStorageSchemaConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UniqueConstraintValidationError{}
+var _ error = AzureBlobStorageSchemaConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -3502,85 +10401,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UniqueConstraintValidationError{}
+} = AzureBlobStorageSchemaConfigValidationError{}
 
-// Validate checks the field values on GetAiGeneratedDataRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAiGeneratedDataRequest) Validate() error {
+// Validate checks the field values on KafkaStreamConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *KafkaStreamConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAiGeneratedDataRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on KafkaStreamConfig with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAiGeneratedDataRequestMultiError, or nil if none found.
-func (m *GetAiGeneratedDataRequest) ValidateAll() error {
+// KafkaStreamConfigMultiError, or nil if none found.
+func (m *KafkaStreamConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAiGeneratedDataRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	// no validation rules for AiConnectionId
-
-	// no validation rules for Count
-
-	// no validation rules for ModelName
-
-	// no validation rules for DataConnectionId
-
-	if all {
-		switch v := interface{}(m.GetTable()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetAiGeneratedDataRequestValidationError{
-					field:  "Table",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetAiGeneratedDataRequestValidationError{
-					field:  "Table",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTable()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetAiGeneratedDataRequestValidationError{
-				field:  "Table",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+func (m *KafkaStreamConfig) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	if m.UserPrompt != nil {
-		// no validation rules for UserPrompt
+	var errors []error
+
+	if m.Tail != nil {
+		// no validation rules for Tail
 	}
 
 	if len(errors) > 0 {
-		return GetAiGeneratedDataRequestMultiError(errors)
+		return KafkaStreamConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAiGeneratedDataRequestMultiError is an error wrapping multiple validation
-// errors returned by GetAiGeneratedDataRequest.ValidateAll() if the
-// designated constraints aren't met.
-type GetAiGeneratedDataRequestMultiError []error
+// KafkaStreamConfigMultiError is an error wrapping multiple validation errors
+// returned by KafkaStreamConfig.ValidateAll() if the designated constraints
+// aren't met.
+type KafkaStreamConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAiGeneratedDataRequestMultiError) Error() string {
+func (m KafkaStreamConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3589,11 +10451,11 @@ func (m GetAiGeneratedDataRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAiGeneratedDataRequestMultiError) AllErrors() []error { return m }
+func (m KafkaStreamConfigMultiError) AllErrors() []error { return m }
 
-// GetAiGeneratedDataRequestValidationError is the validation error returned by
-// GetAiGeneratedDataRequest.Validate if the designated constraints aren't met.
-type GetAiGeneratedDataRequestValidationError struct {
+// KafkaStreamConfigValidationError is the validation error returned by
+// KafkaStreamConfig.Validate if the designated constraints aren't met.
+type KafkaStreamConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3601,24 +10463,24 @@ type GetAiGeneratedDataRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAiGeneratedDataRequestValidationError) Field() string { return e.field }
+func (e KafkaStreamConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAiGeneratedDataRequestValidationError) Reason() string { return e.reason }
+func (e KafkaStreamConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAiGeneratedDataRequestValidationError) Cause() error { return e.cause }
+func (e KafkaStreamConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAiGeneratedDataRequestValidationError) Key() bool { return e.key }
+func (e KafkaStreamConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAiGeneratedDataRequestValidationError) ErrorName() string {
-	return "GetAiGeneratedDataRequestValidationError"
+func (e KafkaStreamConfigValidationError) ErrorName() string {
+	return "KafkaStreamConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAiGeneratedDataRequestValidationError) Error() string {
+func (e KafkaStreamConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3630,14 +10492,14 @@ func (e GetAiGeneratedDataRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAiGeneratedDataRequest.%s: %s%s",
+		"invalid %sKafkaStreamConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAiGeneratedDataRequestValidationError{}
+var _ error = KafkaStreamConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -3645,48 +10507,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAiGeneratedDataRequestValidationError{}
+} = KafkaStreamConfigValidationError{}
 
-// Validate checks the field values on DatabaseTable with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *DatabaseTable) Validate() error {
+// Validate checks the field values on ElasticsearchStreamConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ElasticsearchStreamConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DatabaseTable with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DatabaseTableMultiError, or
-// nil if none found.
-func (m *DatabaseTable) ValidateAll() error {
+// ValidateAll checks the field values on ElasticsearchStreamConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ElasticsearchStreamConfigMultiError, or nil if none found.
+func (m *ElasticsearchStreamConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DatabaseTable) validate(all bool) error {
+func (m *ElasticsearchStreamConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Schema
-
-	// no validation rules for Table
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
 
 	if len(errors) > 0 {
-		return DatabaseTableMultiError(errors)
+		return ElasticsearchStreamConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// DatabaseTableMultiError is an error wrapping multiple validation errors
-// returned by DatabaseTable.ValidateAll() if the designated constraints
-// aren't met.
-type DatabaseTableMultiError []error
+// ElasticsearchStreamConfigMultiError is an error wrapping multiple validation
+// errors returned by ElasticsearchStreamConfig.ValidateAll() if the
+// designated constraints aren't met.
+type ElasticsearchStreamConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DatabaseTableMultiError) Error() string {
+func (m ElasticsearchStreamConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3695,11 +10557,11 @@ func (m DatabaseTableMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DatabaseTableMultiError) AllErrors() []error { return m }
+func (m ElasticsearchStreamConfigMultiError) AllErrors() []error { return m }
 
-// DatabaseTableValidationError is the validation error returned by
-// DatabaseTable.Validate if the designated constraints aren't met.
-type DatabaseTableValidationError struct {
+// ElasticsearchStreamConfigValidationError is the validation error returned by
+// ElasticsearchStreamConfig.Validate if the designated constraints aren't met.
+type ElasticsearchStreamConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3707,22 +10569,24 @@ type DatabaseTableValidationError struct {
 }
 
 // Field function returns field value.
-func (e DatabaseTableValidationError) Field() string { return e.field }
+func (e ElasticsearchStreamConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DatabaseTableValidationError) Reason() string { return e.reason }
+func (e ElasticsearchStreamConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DatabaseTableValidationError) Cause() error { return e.cause }
+func (e ElasticsearchStreamConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DatabaseTableValidationError) Key() bool { return e.key }
+func (e ElasticsearchStreamConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DatabaseTableValidationError) ErrorName() string { return "DatabaseTableValidationError" }
+func (e ElasticsearchStreamConfigValidationError) ErrorName() string {
+	return "ElasticsearchStreamConfigValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DatabaseTableValidationError) Error() string {
+func (e ElasticsearchStreamConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3734,14 +10598,14 @@ func (e DatabaseTableValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDatabaseTable.%s: %s%s",
+		"invalid %sElasticsearchStreamConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DatabaseTableValidationError{}
+var _ error = ElasticsearchStreamConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -3749,78 +10613,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DatabaseTableValidationError{}
+} = ElasticsearchStreamConfigValidationError{}
 
-// Validate checks the field values on GetAiGeneratedDataResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ElasticsearchSchemaConfig with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAiGeneratedDataResponse) Validate() error {
+func (m *ElasticsearchSchemaConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAiGeneratedDataResponse with the
+// ValidateAll checks the field values on ElasticsearchSchemaConfig with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAiGeneratedDataResponseMultiError, or nil if none found.
-func (m *GetAiGeneratedDataResponse) ValidateAll() error {
+// ElasticsearchSchemaConfigMultiError, or nil if none found.
+func (m *ElasticsearchSchemaConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAiGeneratedDataResponse) validate(all bool) error {
+func (m *ElasticsearchSchemaConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRecords() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAiGeneratedDataResponseValidationError{
-						field:  fmt.Sprintf("Records[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAiGeneratedDataResponseValidationError{
-						field:  fmt.Sprintf("Records[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetAiGeneratedDataResponseValidationError{
-					field:  fmt.Sprintf("Records[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return GetAiGeneratedDataResponseMultiError(errors)
+		return ElasticsearchSchemaConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAiGeneratedDataResponseMultiError is an error wrapping multiple
-// validation errors returned by GetAiGeneratedDataResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetAiGeneratedDataResponseMultiError []error
+// ElasticsearchSchemaConfigMultiError is an error wrapping multiple validation
+// errors returned by ElasticsearchSchemaConfig.ValidateAll() if the
+// designated constraints aren't met.
+type ElasticsearchSchemaConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAiGeneratedDataResponseMultiError) Error() string {
+func (m ElasticsearchSchemaConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3829,11 +10659,11 @@ func (m GetAiGeneratedDataResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAiGeneratedDataResponseMultiError) AllErrors() []error { return m }
+func (m ElasticsearchSchemaConfigMultiError) AllErrors() []error { return m }
 
-// GetAiGeneratedDataResponseValidationError is the validation error returned
-// by GetAiGeneratedDataResponse.Validate if the designated constraints aren't met.
-type GetAiGeneratedDataResponseValidationError struct {
+// ElasticsearchSchemaConfigValidationError is the validation error returned by
+// ElasticsearchSchemaConfig.Validate if the designated constraints aren't met.
+type ElasticsearchSchemaConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3841,24 +10671,24 @@ type GetAiGeneratedDataResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAiGeneratedDataResponseValidationError) Field() string { return e.field }
+func (e ElasticsearchSchemaConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAiGeneratedDataResponseValidationError) Reason() string { return e.reason }
+func (e ElasticsearchSchemaConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAiGeneratedDataResponseValidationError) Cause() error { return e.cause }
+func (e ElasticsearchSchemaConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAiGeneratedDataResponseValidationError) Key() bool { return e.key }
+func (e ElasticsearchSchemaConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAiGeneratedDataResponseValidationError) ErrorName() string {
-	return "GetAiGeneratedDataResponseValidationError"
+func (e ElasticsearchSchemaConfigValidationError) ErrorName() string {
+	return "ElasticsearchSchemaConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAiGeneratedDataResponseValidationError) Error() string {
+func (e ElasticsearchSchemaConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3870,14 +10700,14 @@ func (e GetAiGeneratedDataResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAiGeneratedDataResponse.%s: %s%s",
+		"invalid %sElasticsearchSchemaConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAiGeneratedDataResponseValidationError{}
+var _ error = ElasticsearchSchemaConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -3885,48 +10715,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAiGeneratedDataResponseValidationError{}
+} = ElasticsearchSchemaConfigValidationError{}
 
-// Validate checks the field values on GetConnectionTableConstraintsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *GetConnectionTableConstraintsRequest) Validate() error {
+// Validate checks the field values on DuckdbStreamConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DuckdbStreamConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetConnectionTableConstraintsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// GetConnectionTableConstraintsRequestMultiError, or nil if none found.
-func (m *GetConnectionTableConstraintsRequest) ValidateAll() error {
+// ValidateAll checks the field values on DuckdbStreamConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DuckdbStreamConfigMultiError, or nil if none found.
+func (m *DuckdbStreamConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConnectionTableConstraintsRequest) validate(all bool) error {
+func (m *DuckdbStreamConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ConnectionId
-
 	if len(errors) > 0 {
-		return GetConnectionTableConstraintsRequestMultiError(errors)
+		return DuckdbStreamConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConnectionTableConstraintsRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// GetConnectionTableConstraintsRequest.ValidateAll() if the designated
+// DuckdbStreamConfigMultiError is an error wrapping multiple validation
+// errors returned by DuckdbStreamConfig.ValidateAll() if the designated
 // constraints aren't met.
-type GetConnectionTableConstraintsRequestMultiError []error
+type DuckdbStreamConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConnectionTableConstraintsRequestMultiError) Error() string {
+func (m DuckdbStreamConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3935,12 +10761,11 @@ func (m GetConnectionTableConstraintsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConnectionTableConstraintsRequestMultiError) AllErrors() []error { return m }
+func (m DuckdbStreamConfigMultiError) AllErrors() []error { return m }
 
-// GetConnectionTableConstraintsRequestValidationError is the validation error
-// returned by GetConnectionTableConstraintsRequest.Validate if the designated
-// constraints aren't met.
-type GetConnectionTableConstraintsRequestValidationError struct {
+// DuckdbStreamConfigValidationError is the validation error returned by
+// DuckdbStreamConfig.Validate if the designated constraints aren't met.
+type DuckdbStreamConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3948,24 +10773,24 @@ type GetConnectionTableConstraintsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConnectionTableConstraintsRequestValidationError) Field() string { return e.field }
+func (e DuckdbStreamConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConnectionTableConstraintsRequestValidationError) Reason() string { return e.reason }
+func (e DuckdbStreamConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConnectionTableConstraintsRequestValidationError) Cause() error { return e.cause }
+func (e DuckdbStreamConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConnectionTableConstraintsRequestValidationError) Key() bool { return e.key }
+func (e DuckdbStreamConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConnectionTableConstraintsRequestValidationError) ErrorName() string {
-	return "GetConnectionTableConstraintsRequestValidationError"
+func (e DuckdbStreamConfigValidationError) ErrorName() string {
+	return "DuckdbStreamConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetConnectionTableConstraintsRequestValidationError) Error() string {
+func (e DuckdbStreamConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3977,14 +10802,14 @@ func (e GetConnectionTableConstraintsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConnectionTableConstraintsRequest.%s: %s%s",
+		"invalid %sDuckdbStreamConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConnectionTableConstraintsRequestValidationError{}
+var _ error = DuckdbStreamConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -3992,78 +10817,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConnectionTableConstraintsRequestValidationError{}
+} = DuckdbStreamConfigValidationError{}
 
-// Validate checks the field values on UniqueConstraints with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *UniqueConstraints) Validate() error {
+// Validate checks the field values on DuckdbSchemaConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DuckdbSchemaConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UniqueConstraints with the rules
+// ValidateAll checks the field values on DuckdbSchemaConfig with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UniqueConstraintsMultiError, or nil if none found.
-func (m *UniqueConstraints) ValidateAll() error {
+// DuckdbSchemaConfigMultiError, or nil if none found.
+func (m *DuckdbSchemaConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UniqueConstraints) validate(all bool) error {
+func (m *DuckdbSchemaConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetConstraints() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UniqueConstraintsValidationError{
-						field:  fmt.Sprintf("Constraints[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UniqueConstraintsValidationError{
-						field:  fmt.Sprintf("Constraints[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UniqueConstraintsValidationError{
-					field:  fmt.Sprintf("Constraints[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return UniqueConstraintsMultiError(errors)
+		return DuckdbSchemaConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// UniqueConstraintsMultiError is an error wrapping multiple validation errors
-// returned by UniqueConstraints.ValidateAll() if the designated constraints
-// aren't met.
-type UniqueConstraintsMultiError []error
+// DuckdbSchemaConfigMultiError is an error wrapping multiple validation
+// errors returned by DuckdbSchemaConfig.ValidateAll() if the designated
+// constraints aren't met.
+type DuckdbSchemaConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UniqueConstraintsMultiError) Error() string {
+func (m DuckdbSchemaConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4072,11 +10863,11 @@ func (m UniqueConstraintsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UniqueConstraintsMultiError) AllErrors() []error { return m }
+func (m DuckdbSchemaConfigMultiError) AllErrors() []error { return m }
 
-// UniqueConstraintsValidationError is the validation error returned by
-// UniqueConstraints.Validate if the designated constraints aren't met.
-type UniqueConstraintsValidationError struct {
+// DuckdbSchemaConfigValidationError is the validation error returned by
+// DuckdbSchemaConfig.Validate if the designated constraints aren't met.
+type DuckdbSchemaConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4084,24 +10875,24 @@ type UniqueConstraintsValidationError struct {
 }
 
 // Field function returns field value.
-func (e UniqueConstraintsValidationError) Field() string { return e.field }
+func (e DuckdbSchemaConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UniqueConstraintsValidationError) Reason() string { return e.reason }
+func (e DuckdbSchemaConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UniqueConstraintsValidationError) Cause() error { return e.cause }
+func (e DuckdbSchemaConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UniqueConstraintsValidationError) Key() bool { return e.key }
+func (e DuckdbSchemaConfigValidationError) Key() bool { return e.key }
 
-// ErrorName returns error name.
-func (e UniqueConstraintsValidationError) ErrorName() string {
-	return "UniqueConstraintsValidationError"
+// ErrorName returns error name.
+func (e DuckdbSchemaConfigValidationError) ErrorName() string {
+	return "DuckdbSchemaConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UniqueConstraintsValidationError) Error() string {
+func (e DuckdbSchemaConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4113,14 +10904,14 @@ func (e UniqueConstraintsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUniqueConstraints.%s: %s%s",
+		"invalid %sDuckdbSchemaConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UniqueConstraintsValidationError{}
+var _ error = DuckdbSchemaConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -4128,184 +10919,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UniqueConstraintsValidationError{}
+} = DuckdbSchemaConfigValidationError{}
 
-// Validate checks the field values on GetConnectionTableConstraintsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *GetConnectionTableConstraintsResponse) Validate() error {
+// Validate checks the field values on SpannerStreamConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SpannerStreamConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetConnectionTableConstraintsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// GetConnectionTableConstraintsResponseMultiError, or nil if none found.
-func (m *GetConnectionTableConstraintsResponse) ValidateAll() error {
+// ValidateAll checks the field values on SpannerStreamConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SpannerStreamConfigMultiError, or nil if none found.
+func (m *SpannerStreamConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConnectionTableConstraintsResponse) validate(all bool) error {
+func (m *SpannerStreamConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	{
-		sorted_keys := make([]string, len(m.GetForeignKeyConstraints()))
-		i := 0
-		for key := range m.GetForeignKeyConstraints() {
-			sorted_keys[i] = key
-			i++
-		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetForeignKeyConstraints()[key]
-			_ = val
-
-			// no validation rules for ForeignKeyConstraints[key]
-
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, GetConnectionTableConstraintsResponseValidationError{
-							field:  fmt.Sprintf("ForeignKeyConstraints[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, GetConnectionTableConstraintsResponseValidationError{
-							field:  fmt.Sprintf("ForeignKeyConstraints[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
-				if err := v.Validate(); err != nil {
-					return GetConnectionTableConstraintsResponseValidationError{
-						field:  fmt.Sprintf("ForeignKeyConstraints[%v]", key),
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
-				}
-			}
-
-		}
-	}
-
-	{
-		sorted_keys := make([]string, len(m.GetPrimaryKeyConstraints()))
-		i := 0
-		for key := range m.GetPrimaryKeyConstraints() {
-			sorted_keys[i] = key
-			i++
-		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetPrimaryKeyConstraints()[key]
-			_ = val
-
-			// no validation rules for PrimaryKeyConstraints[key]
-
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, GetConnectionTableConstraintsResponseValidationError{
-							field:  fmt.Sprintf("PrimaryKeyConstraints[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, GetConnectionTableConstraintsResponseValidationError{
-							field:  fmt.Sprintf("PrimaryKeyConstraints[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
-				if err := v.Validate(); err != nil {
-					return GetConnectionTableConstraintsResponseValidationError{
-						field:  fmt.Sprintf("PrimaryKeyConstraints[%v]", key),
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
-				}
-			}
-
-		}
-	}
-
-	{
-		sorted_keys := make([]string, len(m.GetUniqueConstraints()))
-		i := 0
-		for key := range m.GetUniqueConstraints() {
-			sorted_keys[i] = key
-			i++
-		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetUniqueConstraints()[key]
-			_ = val
-
-			// no validation rules for UniqueConstraints[key]
-
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, GetConnectionTableConstraintsResponseValidationError{
-							field:  fmt.Sprintf("UniqueConstraints[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, GetConnectionTableConstraintsResponseValidationError{
-							field:  fmt.Sprintf("UniqueConstraints[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
-				if err := v.Validate(); err != nil {
-					return GetConnectionTableConstraintsResponseValidationError{
-						field:  fmt.Sprintf("UniqueConstraints[%v]", key),
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
-				}
-			}
-
-		}
-	}
-
 	if len(errors) > 0 {
-		return GetConnectionTableConstraintsResponseMultiError(errors)
+		return SpannerStreamConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConnectionTableConstraintsResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// GetConnectionTableConstraintsResponse.ValidateAll() if the designated
+// SpannerStreamConfigMultiError is an error wrapping multiple validation
+// errors returned by SpannerStreamConfig.ValidateAll() if the designated
 // constraints aren't met.
-type GetConnectionTableConstraintsResponseMultiError []error
+type SpannerStreamConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConnectionTableConstraintsResponseMultiError) Error() string {
+func (m SpannerStreamConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4314,12 +10965,11 @@ func (m GetConnectionTableConstraintsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConnectionTableConstraintsResponseMultiError) AllErrors() []error { return m }
+func (m SpannerStreamConfigMultiError) AllErrors() []error { return m }
 
-// GetConnectionTableConstraintsResponseValidationError is the validation error
-// returned by GetConnectionTableConstraintsResponse.Validate if the
-// designated constraints aren't met.
-type GetConnectionTableConstraintsResponseValidationError struct {
+// SpannerStreamConfigValidationError is the validation error returned by
+// SpannerStreamConfig.Validate if the designated constraints aren't met.
+type SpannerStreamConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4327,24 +10977,24 @@ type GetConnectionTableConstraintsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConnectionTableConstraintsResponseValidationError) Field() string { return e.field }
+func (e SpannerStreamConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConnectionTableConstraintsResponseValidationError) Reason() string { return e.reason }
+func (e SpannerStreamConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConnectionTableConstraintsResponseValidationError) Cause() error { return e.cause }
+func (e SpannerStreamConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConnectionTableConstraintsResponseValidationError) Key() bool { return e.key }
+func (e SpannerStreamConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConnectionTableConstraintsResponseValidationError) ErrorName() string {
-	return "GetConnectionTableConstraintsResponseValidationError"
+func (e SpannerStreamConfigValidationError) ErrorName() string {
+	return "SpannerStreamConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetConnectionTableConstraintsResponseValidationError) Error() string {
+func (e SpannerStreamConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4356,14 +11006,14 @@ func (e GetConnectionTableConstraintsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConnectionTableConstraintsResponse.%s: %s%s",
+		"invalid %sSpannerStreamConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConnectionTableConstraintsResponseValidationError{}
+var _ error = SpannerStreamConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -4371,54 +11021,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConnectionTableConstraintsResponseValidationError{}
+} = SpannerStreamConfigValidationError{}
 
-// Validate checks the field values on GetTableRowCountRequest with the rules
+// Validate checks the field values on SpannerSchemaConfig with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetTableRowCountRequest) Validate() error {
+func (m *SpannerSchemaConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetTableRowCountRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SpannerSchemaConfig with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetTableRowCountRequestMultiError, or nil if none found.
-func (m *GetTableRowCountRequest) ValidateAll() error {
+// SpannerSchemaConfigMultiError, or nil if none found.
+func (m *SpannerSchemaConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetTableRowCountRequest) validate(all bool) error {
+func (m *SpannerSchemaConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ConnectionId
-
-	// no validation rules for Schema
-
-	// no validation rules for Table
-
-	if m.WhereClause != nil {
-		// no validation rules for WhereClause
-	}
-
 	if len(errors) > 0 {
-		return GetTableRowCountRequestMultiError(errors)
+		return SpannerSchemaConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetTableRowCountRequestMultiError is an error wrapping multiple validation
-// errors returned by GetTableRowCountRequest.ValidateAll() if the designated
+// SpannerSchemaConfigMultiError is an error wrapping multiple validation
+// errors returned by SpannerSchemaConfig.ValidateAll() if the designated
 // constraints aren't met.
-type GetTableRowCountRequestMultiError []error
+type SpannerSchemaConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetTableRowCountRequestMultiError) Error() string {
+func (m SpannerSchemaConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4427,11 +11067,11 @@ func (m GetTableRowCountRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetTableRowCountRequestMultiError) AllErrors() []error { return m }
+func (m SpannerSchemaConfigMultiError) AllErrors() []error { return m }
 
-// GetTableRowCountRequestValidationError is the validation error returned by
-// GetTableRowCountRequest.Validate if the designated constraints aren't met.
-type GetTableRowCountRequestValidationError struct {
+// SpannerSchemaConfigValidationError is the validation error returned by
+// SpannerSchemaConfig.Validate if the designated constraints aren't met.
+type SpannerSchemaConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4439,24 +11079,24 @@ type GetTableRowCountRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetTableRowCountRequestValidationError) Field() string { return e.field }
+func (e SpannerSchemaConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetTableRowCountRequestValidationError) Reason() string { return e.reason }
+func (e SpannerSchemaConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetTableRowCountRequestValidationError) Cause() error { return e.cause }
+func (e SpannerSchemaConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetTableRowCountRequestValidationError) Key() bool { return e.key }
+func (e SpannerSchemaConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetTableRowCountRequestValidationError) ErrorName() string {
-	return "GetTableRowCountRequestValidationError"
+func (e SpannerSchemaConfigValidationError) ErrorName() string {
+	return "SpannerSchemaConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetTableRowCountRequestValidationError) Error() string {
+func (e SpannerSchemaConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4468,14 +11108,14 @@ func (e GetTableRowCountRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetTableRowCountRequest.%s: %s%s",
+		"invalid %sSpannerSchemaConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetTableRowCountRequestValidationError{}
+var _ error = SpannerSchemaConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -4483,46 +11123,51 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetTableRowCountRequestValidationError{}
+} = SpannerSchemaConfigValidationError{}
 
-// Validate checks the field values on GetTableRowCountResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetTableRowCountResponse) Validate() error {
+// Validate checks the field values on StreamColumn with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StreamColumn) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetTableRowCountResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetTableRowCountResponseMultiError, or nil if none found.
-func (m *GetTableRowCountResponse) ValidateAll() error {
+// ValidateAll checks the field values on StreamColumn with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StreamColumnMultiError, or
+// nil if none found.
+func (m *StreamColumn) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetTableRowCountResponse) validate(all bool) error {
+func (m *StreamColumn) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Count
+	// no validation rules for Name
+
+	// no validation rules for Type
+
+	// no validation rules for DataType
+
+	// no validation rules for ElementType
 
 	if len(errors) > 0 {
-		return GetTableRowCountResponseMultiError(errors)
+		return StreamColumnMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetTableRowCountResponseMultiError is an error wrapping multiple validation
-// errors returned by GetTableRowCountResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetTableRowCountResponseMultiError []error
+// StreamColumnMultiError is an error wrapping multiple validation errors
+// returned by StreamColumn.ValidateAll() if the designated constraints aren't met.
+type StreamColumnMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetTableRowCountResponseMultiError) Error() string {
+func (m StreamColumnMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4531,11 +11176,11 @@ func (m GetTableRowCountResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetTableRowCountResponseMultiError) AllErrors() []error { return m }
+func (m StreamColumnMultiError) AllErrors() []error { return m }
 
-// GetTableRowCountResponseValidationError is the validation error returned by
-// GetTableRowCountResponse.Validate if the designated constraints aren't met.
-type GetTableRowCountResponseValidationError struct {
+// StreamColumnValidationError is the validation error returned by
+// StreamColumn.Validate if the designated constraints aren't met.
+type StreamColumnValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4543,24 +11188,22 @@ type GetTableRowCountResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetTableRowCountResponseValidationError) Field() string { return e.field }
+func (e StreamColumnValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetTableRowCountResponseValidationError) Reason() string { return e.reason }
+func (e StreamColumnValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetTableRowCountResponseValidationError) Cause() error { return e.cause }
+func (e StreamColumnValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetTableRowCountResponseValidationError) Key() bool { return e.key }
+func (e StreamColumnValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetTableRowCountResponseValidationError) ErrorName() string {
-	return "GetTableRowCountResponseValidationError"
-}
+func (e StreamColumnValidationError) ErrorName() string { return "StreamColumnValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetTableRowCountResponseValidationError) Error() string {
+func (e StreamColumnValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4572,14 +11215,14 @@ func (e GetTableRowCountResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetTableRowCountResponse.%s: %s%s",
+		"invalid %sStreamColumn.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetTableRowCountResponseValidationError{}
+var _ error = StreamColumnValidationError{}
 
 var _ interface {
 	Field() string
@@ -4587,4 +11230,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetTableRowCountResponseValidationError{}
+} = StreamColumnValidationError{}
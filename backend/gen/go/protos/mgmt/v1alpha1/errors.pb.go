@@ -0,0 +1,303 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        (unknown)
+// source: mgmt/v1alpha1/errors.proto
+
+package mgmtv1alpha1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Stable, machine-readable codes that describe why a request failed.
+// These are attached to errors as an ErrorDetails message so that clients can branch on them instead of on the error message.
+type ErrorCode int32
+
+const (
+	// The error does not have a specific code
+	ErrorCode_ERROR_CODE_UNSPECIFIED ErrorCode = 0
+	// The requested schema does not exist in the connection's database
+	ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND ErrorCode = 1
+	// The requested table does not exist in the connection's database
+	ErrorCode_ERROR_CODE_TABLE_NOT_FOUND ErrorCode = 2
+	// The credentials of the connection were rejected by the database
+	ErrorCode_ERROR_CODE_CONNECTION_AUTH_FAILED ErrorCode = 3
+	// The connection does not exist
+	ErrorCode_ERROR_CODE_CONNECTION_NOT_FOUND ErrorCode = 4
+	// A connection with the same name already exists in the account
+	ErrorCode_ERROR_CODE_CONNECTION_NAME_TAKEN ErrorCode = 5
+	// The job does not exist
+	ErrorCode_ERROR_CODE_JOB_NOT_FOUND ErrorCode = 6
+	// The job run does not exist
+	ErrorCode_ERROR_CODE_JOB_RUN_NOT_FOUND ErrorCode = 7
+	// The user defined transformer does not exist
+	ErrorCode_ERROR_CODE_TRANSFORMER_NOT_FOUND ErrorCode = 8
+	// Too many requests were made. The Retry-After header indicates when to try again
+	ErrorCode_ERROR_CODE_RATE_LIMITED ErrorCode = 9
+	// Another request with the same idempotency key is still in progress
+	ErrorCode_ERROR_CODE_IDEMPOTENCY_KEY_IN_USE ErrorCode = 10
+	// The idempotency key was previously used with a different request
+	ErrorCode_ERROR_CODE_IDEMPOTENCY_KEY_MISMATCH ErrorCode = 11
+	// The request did not pass validation. The buf.validate.Violations detail lists each offending field
+	ErrorCode_ERROR_CODE_INVALID_REQUEST ErrorCode = 12
+	// The data stream was terminated because the client stopped reading from it
+	ErrorCode_ERROR_CODE_STREAM_CLIENT_IDLE ErrorCode = 13
+	// The data stream was terminated because it was open for longer than the server allows
+	ErrorCode_ERROR_CODE_STREAM_MAX_DURATION_EXCEEDED ErrorCode = 14
+	// The requested column does not exist in the table
+	ErrorCode_ERROR_CODE_COLUMN_NOT_FOUND ErrorCode = 15
+)
+
+// Enum value maps for ErrorCode.
+var (
+	ErrorCode_name = map[int32]string{
+		0:  "ERROR_CODE_UNSPECIFIED",
+		1:  "ERROR_CODE_SCHEMA_NOT_FOUND",
+		2:  "ERROR_CODE_TABLE_NOT_FOUND",
+		3:  "ERROR_CODE_CONNECTION_AUTH_FAILED",
+		4:  "ERROR_CODE_CONNECTION_NOT_FOUND",
+		5:  "ERROR_CODE_CONNECTION_NAME_TAKEN",
+		6:  "ERROR_CODE_JOB_NOT_FOUND",
+		7:  "ERROR_CODE_JOB_RUN_NOT_FOUND",
+		8:  "ERROR_CODE_TRANSFORMER_NOT_FOUND",
+		9:  "ERROR_CODE_RATE_LIMITED",
+		10: "ERROR_CODE_IDEMPOTENCY_KEY_IN_USE",
+		11: "ERROR_CODE_IDEMPOTENCY_KEY_MISMATCH",
+		12: "ERROR_CODE_INVALID_REQUEST",
+		13: "ERROR_CODE_STREAM_CLIENT_IDLE",
+		14: "ERROR_CODE_STREAM_MAX_DURATION_EXCEEDED",
+		15: "ERROR_CODE_COLUMN_NOT_FOUND",
+	}
+	ErrorCode_value = map[string]int32{
+		"ERROR_CODE_UNSPECIFIED":                  0,
+		"ERROR_CODE_SCHEMA_NOT_FOUND":             1,
+		"ERROR_CODE_TABLE_NOT_FOUND":              2,
+		"ERROR_CODE_CONNECTION_AUTH_FAILED":       3,
+		"ERROR_CODE_CONNECTION_NOT_FOUND":         4,
+		"ERROR_CODE_CONNECTION_NAME_TAKEN":        5,
+		"ERROR_CODE_JOB_NOT_FOUND":                6,
+		"ERROR_CODE_JOB_RUN_NOT_FOUND":            7,
+		"ERROR_CODE_TRANSFORMER_NOT_FOUND":        8,
+		"ERROR_CODE_RATE_LIMITED":                 9,
+		"ERROR_CODE_IDEMPOTENCY_KEY_IN_USE":       10,
+		"ERROR_CODE_IDEMPOTENCY_KEY_MISMATCH":     11,
+		"ERROR_CODE_INVALID_REQUEST":              12,
+		"ERROR_CODE_STREAM_CLIENT_IDLE":           13,
+		"ERROR_CODE_STREAM_MAX_DURATION_EXCEEDED": 14,
+		"ERROR_CODE_COLUMN_NOT_FOUND":             15,
+	}
+)
+
+func (x ErrorCode) Enum() *ErrorCode {
+	p := new(ErrorCode)
+	*p = x
+	return p
+}
+
+func (x ErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_mgmt_v1alpha1_errors_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorCode) Type() protoreflect.EnumType {
+	return &file_mgmt_v1alpha1_errors_proto_enumTypes[0]
+}
+
+func (x ErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorCode.Descriptor instead.
+func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_errors_proto_rawDescGZIP(), []int{0}
+}
+
+// Attached to the details of errors returned by the API
+type ErrorDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=mgmt.v1alpha1.ErrorCode" json:"code,omitempty"`
+}
+
+func (x *ErrorDetails) Reset() {
+	*x = ErrorDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_errors_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorDetails) ProtoMessage() {}
+
+func (x *ErrorDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_errors_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorDetails.ProtoReflect.Descriptor instead.
+func (*ErrorDetails) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_errors_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ErrorDetails) GetCode() ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+var File_mgmt_v1alpha1_errors_proto protoreflect.FileDescriptor
+
+var file_mgmt_v1alpha1_errors_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6d, 0x67,
+	0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x22, 0x3c, 0x0a, 0x0c, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x67, 0x6d, 0x74,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x2a, 0xbe, 0x04, 0x0a, 0x09, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44,
+	0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f,
+	0x44, 0x45, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x10, 0x02, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f,
+	0x44, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x55,
+	0x54, 0x48, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x23, 0x0a, 0x1f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04,
+	0x12, 0x24, 0x0a, 0x20, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x43,
+	0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54,
+	0x41, 0x4b, 0x45, 0x4e, 0x10, 0x05, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x10, 0x06, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f,
+	0x44, 0x45, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x07, 0x12, 0x24, 0x0a, 0x20, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x08, 0x12, 0x1b, 0x0a, 0x17,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f,
+	0x4c, 0x49, 0x4d, 0x49, 0x54, 0x45, 0x44, 0x10, 0x09, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x44, 0x45, 0x4d, 0x50, 0x4f, 0x54, 0x45,
+	0x4e, 0x43, 0x59, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x49, 0x4e, 0x5f, 0x55, 0x53, 0x45, 0x10, 0x0a,
+	0x12, 0x27, 0x0a, 0x23, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49,
+	0x44, 0x45, 0x4d, 0x50, 0x4f, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x4d,
+	0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x0b, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x0c, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x43,
+	0x4c, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0x0d, 0x12, 0x2b, 0x0a, 0x27,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41,
+	0x4d, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x44, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45,
+	0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x0e, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4f, 0x4c, 0x55, 0x4d, 0x4e, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x0f, 0x42, 0xc7, 0x01, 0x0a, 0x11, 0x63,
+	0x6f, 0x6d, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x42, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x50, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x75, 0x63, 0x6c,
+	0x65, 0x75, 0x73, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x6e, 0x65, 0x6f, 0x73, 0x79, 0x6e, 0x63,
+	0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x3b, 0x6d, 0x67, 0x6d, 0x74, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0xa2, 0x02, 0x03, 0x4d, 0x58, 0x58, 0xaa, 0x02, 0x0d, 0x4d, 0x67, 0x6d, 0x74, 0x2e, 0x56,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x0d, 0x4d, 0x67, 0x6d, 0x74, 0x5c, 0x56,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2, 0x02, 0x19, 0x4d, 0x67, 0x6d, 0x74, 0x5c, 0x56,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x4d, 0x67, 0x6d, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mgmt_v1alpha1_errors_proto_rawDescOnce sync.Once
+	file_mgmt_v1alpha1_errors_proto_rawDescData = file_mgmt_v1alpha1_errors_proto_rawDesc
+)
+
+func file_mgmt_v1alpha1_errors_proto_rawDescGZIP() []byte {
+	file_mgmt_v1alpha1_errors_proto_rawDescOnce.Do(func() {
+		file_mgmt_v1alpha1_errors_proto_rawDescData = protoimpl.X.CompressGZIP(file_mgmt_v1alpha1_errors_proto_rawDescData)
+	})
+	return file_mgmt_v1alpha1_errors_proto_rawDescData
+}
+
+var file_mgmt_v1alpha1_errors_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_mgmt_v1alpha1_errors_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_mgmt_v1alpha1_errors_proto_goTypes = []interface{}{
+	(ErrorCode)(0),       // 0: mgmt.v1alpha1.ErrorCode
+	(*ErrorDetails)(nil), // 1: mgmt.v1alpha1.ErrorDetails
+}
+var file_mgmt_v1alpha1_errors_proto_depIdxs = []int32{
+	0, // 0: mgmt.v1alpha1.ErrorDetails.code:type_name -> mgmt.v1alpha1.ErrorCode
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_mgmt_v1alpha1_errors_proto_init() }
+func file_mgmt_v1alpha1_errors_proto_init() {
+	if File_mgmt_v1alpha1_errors_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_mgmt_v1alpha1_errors_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mgmt_v1alpha1_errors_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mgmt_v1alpha1_errors_proto_goTypes,
+		DependencyIndexes: file_mgmt_v1alpha1_errors_proto_depIdxs,
+		EnumInfos:         file_mgmt_v1alpha1_errors_proto_enumTypes,
+		MessageInfos:      file_mgmt_v1alpha1_errors_proto_msgTypes,
+	}.Build()
+	File_mgmt_v1alpha1_errors_proto = out.File
+	file_mgmt_v1alpha1_errors_proto_rawDesc = nil
+	file_mgmt_v1alpha1_errors_proto_goTypes = nil
+	file_mgmt_v1alpha1_errors_proto_depIdxs = nil
+}
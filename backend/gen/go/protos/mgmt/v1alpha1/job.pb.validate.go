@@ -59,6 +59,8 @@ func (m *GetJobsRequest) validate(all bool) error {
 
 	// no validation rules for AccountId
 
+	// no validation rules for LabelSelector
+
 	if len(errors) > 0 {
 		return GetJobsRequestMultiError(errors)
 	}
@@ -627,6 +629,170 @@ func (m *JobSourceOptions) validate(all bool) error {
 			}
 		}
 
+	case *JobSourceOptions_Synthesize:
+		if v == nil {
+			err := JobSourceOptionsValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSynthesize()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobSourceOptionsValidationError{
+						field:  "Synthesize",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobSourceOptionsValidationError{
+						field:  "Synthesize",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSynthesize()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobSourceOptionsValidationError{
+					field:  "Synthesize",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *JobSourceOptions_Custom:
+		if v == nil {
+			err := JobSourceOptionsValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetCustom()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobSourceOptionsValidationError{
+						field:  "Custom",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobSourceOptionsValidationError{
+						field:  "Custom",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCustom()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobSourceOptionsValidationError{
+					field:  "Custom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *JobSourceOptions_SnapshotClone:
+		if v == nil {
+			err := JobSourceOptionsValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSnapshotClone()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobSourceOptionsValidationError{
+						field:  "SnapshotClone",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobSourceOptionsValidationError{
+						field:  "SnapshotClone",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSnapshotClone()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobSourceOptionsValidationError{
+					field:  "SnapshotClone",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *JobSourceOptions_InPlaceMask:
+		if v == nil {
+			err := JobSourceOptionsValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetInPlaceMask()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobSourceOptionsValidationError{
+						field:  "InPlaceMask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobSourceOptionsValidationError{
+						field:  "InPlaceMask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetInPlaceMask()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobSourceOptionsValidationError{
+					field:  "InPlaceMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -1369,28 +1535,30 @@ var _ interface {
 	ErrorName() string
 } = AiGenerateSourceTableOptionValidationError{}
 
-// Validate checks the field values on GenerateSourceOptions with the rules
+// Validate checks the field values on SynthesizeSourceOptions with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateSourceOptions) Validate() error {
+func (m *SynthesizeSourceOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateSourceOptions with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SynthesizeSourceOptions with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateSourceOptionsMultiError, or nil if none found.
-func (m *GenerateSourceOptions) ValidateAll() error {
+// SynthesizeSourceOptionsMultiError, or nil if none found.
+func (m *SynthesizeSourceOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateSourceOptions) validate(all bool) error {
+func (m *SynthesizeSourceOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for ConnectionId
+
 	for idx, item := range m.GetSchemas() {
 		_, _ = idx, item
 
@@ -1398,7 +1566,7 @@ func (m *GenerateSourceOptions) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GenerateSourceOptionsValidationError{
+					errors = append(errors, SynthesizeSourceOptionsValidationError{
 						field:  fmt.Sprintf("Schemas[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1406,7 +1574,7 @@ func (m *GenerateSourceOptions) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GenerateSourceOptionsValidationError{
+					errors = append(errors, SynthesizeSourceOptionsValidationError{
 						field:  fmt.Sprintf("Schemas[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1415,7 +1583,7 @@ func (m *GenerateSourceOptions) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GenerateSourceOptionsValidationError{
+				return SynthesizeSourceOptionsValidationError{
 					field:  fmt.Sprintf("Schemas[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1425,24 +1593,30 @@ func (m *GenerateSourceOptions) validate(all bool) error {
 
 	}
 
-	if m.FkSourceConnectionId != nil {
-		// no validation rules for FkSourceConnectionId
+	// no validation rules for Model
+
+	if m.SampleSize != nil {
+		// no validation rules for SampleSize
+	}
+
+	if m.ExternalServiceUrl != nil {
+		// no validation rules for ExternalServiceUrl
 	}
 
 	if len(errors) > 0 {
-		return GenerateSourceOptionsMultiError(errors)
+		return SynthesizeSourceOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateSourceOptionsMultiError is an error wrapping multiple validation
-// errors returned by GenerateSourceOptions.ValidateAll() if the designated
+// SynthesizeSourceOptionsMultiError is an error wrapping multiple validation
+// errors returned by SynthesizeSourceOptions.ValidateAll() if the designated
 // constraints aren't met.
-type GenerateSourceOptionsMultiError []error
+type SynthesizeSourceOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateSourceOptionsMultiError) Error() string {
+func (m SynthesizeSourceOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1451,11 +1625,11 @@ func (m GenerateSourceOptionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateSourceOptionsMultiError) AllErrors() []error { return m }
+func (m SynthesizeSourceOptionsMultiError) AllErrors() []error { return m }
 
-// GenerateSourceOptionsValidationError is the validation error returned by
-// GenerateSourceOptions.Validate if the designated constraints aren't met.
-type GenerateSourceOptionsValidationError struct {
+// SynthesizeSourceOptionsValidationError is the validation error returned by
+// SynthesizeSourceOptions.Validate if the designated constraints aren't met.
+type SynthesizeSourceOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1463,24 +1637,24 @@ type GenerateSourceOptionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateSourceOptionsValidationError) Field() string { return e.field }
+func (e SynthesizeSourceOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateSourceOptionsValidationError) Reason() string { return e.reason }
+func (e SynthesizeSourceOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateSourceOptionsValidationError) Cause() error { return e.cause }
+func (e SynthesizeSourceOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateSourceOptionsValidationError) Key() bool { return e.key }
+func (e SynthesizeSourceOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateSourceOptionsValidationError) ErrorName() string {
-	return "GenerateSourceOptionsValidationError"
+func (e SynthesizeSourceOptionsValidationError) ErrorName() string {
+	return "SynthesizeSourceOptionsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateSourceOptionsValidationError) Error() string {
+func (e SynthesizeSourceOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1492,14 +1666,14 @@ func (e GenerateSourceOptionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateSourceOptions.%s: %s%s",
+		"invalid %sSynthesizeSourceOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateSourceOptionsValidationError{}
+var _ error = SynthesizeSourceOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -1507,24 +1681,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateSourceOptionsValidationError{}
+} = SynthesizeSourceOptionsValidationError{}
 
-// Validate checks the field values on GenerateSourceSchemaOption with the
+// Validate checks the field values on SynthesizeSourceSchemaOption with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateSourceSchemaOption) Validate() error {
+func (m *SynthesizeSourceSchemaOption) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateSourceSchemaOption with the
+// ValidateAll checks the field values on SynthesizeSourceSchemaOption with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateSourceSchemaOptionMultiError, or nil if none found.
-func (m *GenerateSourceSchemaOption) ValidateAll() error {
+// SynthesizeSourceSchemaOptionMultiError, or nil if none found.
+func (m *SynthesizeSourceSchemaOption) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateSourceSchemaOption) validate(all bool) error {
+func (m *SynthesizeSourceSchemaOption) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1540,7 +1714,7 @@ func (m *GenerateSourceSchemaOption) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GenerateSourceSchemaOptionValidationError{
+					errors = append(errors, SynthesizeSourceSchemaOptionValidationError{
 						field:  fmt.Sprintf("Tables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1548,7 +1722,7 @@ func (m *GenerateSourceSchemaOption) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GenerateSourceSchemaOptionValidationError{
+					errors = append(errors, SynthesizeSourceSchemaOptionValidationError{
 						field:  fmt.Sprintf("Tables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1557,7 +1731,7 @@ func (m *GenerateSourceSchemaOption) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GenerateSourceSchemaOptionValidationError{
+				return SynthesizeSourceSchemaOptionValidationError{
 					field:  fmt.Sprintf("Tables[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1568,19 +1742,19 @@ func (m *GenerateSourceSchemaOption) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GenerateSourceSchemaOptionMultiError(errors)
+		return SynthesizeSourceSchemaOptionMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateSourceSchemaOptionMultiError is an error wrapping multiple
-// validation errors returned by GenerateSourceSchemaOption.ValidateAll() if
+// SynthesizeSourceSchemaOptionMultiError is an error wrapping multiple
+// validation errors returned by SynthesizeSourceSchemaOption.ValidateAll() if
 // the designated constraints aren't met.
-type GenerateSourceSchemaOptionMultiError []error
+type SynthesizeSourceSchemaOptionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateSourceSchemaOptionMultiError) Error() string {
+func (m SynthesizeSourceSchemaOptionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1589,11 +1763,12 @@ func (m GenerateSourceSchemaOptionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateSourceSchemaOptionMultiError) AllErrors() []error { return m }
+func (m SynthesizeSourceSchemaOptionMultiError) AllErrors() []error { return m }
 
-// GenerateSourceSchemaOptionValidationError is the validation error returned
-// by GenerateSourceSchemaOption.Validate if the designated constraints aren't met.
-type GenerateSourceSchemaOptionValidationError struct {
+// SynthesizeSourceSchemaOptionValidationError is the validation error returned
+// by SynthesizeSourceSchemaOption.Validate if the designated constraints
+// aren't met.
+type SynthesizeSourceSchemaOptionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1601,24 +1776,24 @@ type GenerateSourceSchemaOptionValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateSourceSchemaOptionValidationError) Field() string { return e.field }
+func (e SynthesizeSourceSchemaOptionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateSourceSchemaOptionValidationError) Reason() string { return e.reason }
+func (e SynthesizeSourceSchemaOptionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateSourceSchemaOptionValidationError) Cause() error { return e.cause }
+func (e SynthesizeSourceSchemaOptionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateSourceSchemaOptionValidationError) Key() bool { return e.key }
+func (e SynthesizeSourceSchemaOptionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateSourceSchemaOptionValidationError) ErrorName() string {
-	return "GenerateSourceSchemaOptionValidationError"
+func (e SynthesizeSourceSchemaOptionValidationError) ErrorName() string {
+	return "SynthesizeSourceSchemaOptionValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateSourceSchemaOptionValidationError) Error() string {
+func (e SynthesizeSourceSchemaOptionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1630,14 +1805,14 @@ func (e GenerateSourceSchemaOptionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateSourceSchemaOption.%s: %s%s",
+		"invalid %sSynthesizeSourceSchemaOption.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateSourceSchemaOptionValidationError{}
+var _ error = SynthesizeSourceSchemaOptionValidationError{}
 
 var _ interface {
 	Field() string
@@ -1645,24 +1820,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateSourceSchemaOptionValidationError{}
+} = SynthesizeSourceSchemaOptionValidationError{}
 
-// Validate checks the field values on GenerateSourceTableOption with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SynthesizeSourceTableOption with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateSourceTableOption) Validate() error {
+func (m *SynthesizeSourceTableOption) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateSourceTableOption with the
+// ValidateAll checks the field values on SynthesizeSourceTableOption with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateSourceTableOptionMultiError, or nil if none found.
-func (m *GenerateSourceTableOption) ValidateAll() error {
+// SynthesizeSourceTableOptionMultiError, or nil if none found.
+func (m *SynthesizeSourceTableOption) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateSourceTableOption) validate(all bool) error {
+func (m *SynthesizeSourceTableOption) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1674,19 +1849,19 @@ func (m *GenerateSourceTableOption) validate(all bool) error {
 	// no validation rules for RowCount
 
 	if len(errors) > 0 {
-		return GenerateSourceTableOptionMultiError(errors)
+		return SynthesizeSourceTableOptionMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateSourceTableOptionMultiError is an error wrapping multiple validation
-// errors returned by GenerateSourceTableOption.ValidateAll() if the
-// designated constraints aren't met.
-type GenerateSourceTableOptionMultiError []error
+// SynthesizeSourceTableOptionMultiError is an error wrapping multiple
+// validation errors returned by SynthesizeSourceTableOption.ValidateAll() if
+// the designated constraints aren't met.
+type SynthesizeSourceTableOptionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateSourceTableOptionMultiError) Error() string {
+func (m SynthesizeSourceTableOptionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1695,11 +1870,12 @@ func (m GenerateSourceTableOptionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateSourceTableOptionMultiError) AllErrors() []error { return m }
+func (m SynthesizeSourceTableOptionMultiError) AllErrors() []error { return m }
 
-// GenerateSourceTableOptionValidationError is the validation error returned by
-// GenerateSourceTableOption.Validate if the designated constraints aren't met.
-type GenerateSourceTableOptionValidationError struct {
+// SynthesizeSourceTableOptionValidationError is the validation error returned
+// by SynthesizeSourceTableOption.Validate if the designated constraints
+// aren't met.
+type SynthesizeSourceTableOptionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1707,24 +1883,24 @@ type GenerateSourceTableOptionValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateSourceTableOptionValidationError) Field() string { return e.field }
+func (e SynthesizeSourceTableOptionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateSourceTableOptionValidationError) Reason() string { return e.reason }
+func (e SynthesizeSourceTableOptionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateSourceTableOptionValidationError) Cause() error { return e.cause }
+func (e SynthesizeSourceTableOptionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateSourceTableOptionValidationError) Key() bool { return e.key }
+func (e SynthesizeSourceTableOptionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateSourceTableOptionValidationError) ErrorName() string {
-	return "GenerateSourceTableOptionValidationError"
+func (e SynthesizeSourceTableOptionValidationError) ErrorName() string {
+	return "SynthesizeSourceTableOptionValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateSourceTableOptionValidationError) Error() string {
+func (e SynthesizeSourceTableOptionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1736,14 +1912,14 @@ func (e GenerateSourceTableOptionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateSourceTableOption.%s: %s%s",
+		"invalid %sSynthesizeSourceTableOption.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateSourceTableOptionValidationError{}
+var _ error = SynthesizeSourceTableOptionValidationError{}
 
 var _ interface {
 	Field() string
@@ -1751,32 +1927,30 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateSourceTableOptionValidationError{}
+} = SynthesizeSourceTableOptionValidationError{}
 
-// Validate checks the field values on PostgresSourceConnectionOptions with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GenerateSourceOptions with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PostgresSourceConnectionOptions) Validate() error {
+func (m *GenerateSourceOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PostgresSourceConnectionOptions with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// PostgresSourceConnectionOptionsMultiError, or nil if none found.
-func (m *PostgresSourceConnectionOptions) ValidateAll() error {
+// ValidateAll checks the field values on GenerateSourceOptions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateSourceOptionsMultiError, or nil if none found.
+func (m *GenerateSourceOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PostgresSourceConnectionOptions) validate(all bool) error {
+func (m *GenerateSourceOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for HaltOnNewColumnAddition
-
 	for idx, item := range m.GetSchemas() {
 		_, _ = idx, item
 
@@ -1784,7 +1958,7 @@ func (m *PostgresSourceConnectionOptions) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PostgresSourceConnectionOptionsValidationError{
+					errors = append(errors, GenerateSourceOptionsValidationError{
 						field:  fmt.Sprintf("Schemas[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1792,7 +1966,7 @@ func (m *PostgresSourceConnectionOptions) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, PostgresSourceConnectionOptionsValidationError{
+					errors = append(errors, GenerateSourceOptionsValidationError{
 						field:  fmt.Sprintf("Schemas[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1801,7 +1975,7 @@ func (m *PostgresSourceConnectionOptions) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return PostgresSourceConnectionOptionsValidationError{
+				return GenerateSourceOptionsValidationError{
 					field:  fmt.Sprintf("Schemas[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1811,24 +1985,24 @@ func (m *PostgresSourceConnectionOptions) validate(all bool) error {
 
 	}
 
-	// no validation rules for ConnectionId
-
-	// no validation rules for SubsetByForeignKeyConstraints
+	if m.FkSourceConnectionId != nil {
+		// no validation rules for FkSourceConnectionId
+	}
 
 	if len(errors) > 0 {
-		return PostgresSourceConnectionOptionsMultiError(errors)
+		return GenerateSourceOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// PostgresSourceConnectionOptionsMultiError is an error wrapping multiple
-// validation errors returned by PostgresSourceConnectionOptions.ValidateAll()
-// if the designated constraints aren't met.
-type PostgresSourceConnectionOptionsMultiError []error
+// GenerateSourceOptionsMultiError is an error wrapping multiple validation
+// errors returned by GenerateSourceOptions.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateSourceOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PostgresSourceConnectionOptionsMultiError) Error() string {
+func (m GenerateSourceOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1837,12 +2011,11 @@ func (m PostgresSourceConnectionOptionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PostgresSourceConnectionOptionsMultiError) AllErrors() []error { return m }
+func (m GenerateSourceOptionsMultiError) AllErrors() []error { return m }
 
-// PostgresSourceConnectionOptionsValidationError is the validation error
-// returned by PostgresSourceConnectionOptions.Validate if the designated
-// constraints aren't met.
-type PostgresSourceConnectionOptionsValidationError struct {
+// GenerateSourceOptionsValidationError is the validation error returned by
+// GenerateSourceOptions.Validate if the designated constraints aren't met.
+type GenerateSourceOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1850,24 +2023,24 @@ type PostgresSourceConnectionOptionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e PostgresSourceConnectionOptionsValidationError) Field() string { return e.field }
+func (e GenerateSourceOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PostgresSourceConnectionOptionsValidationError) Reason() string { return e.reason }
+func (e GenerateSourceOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PostgresSourceConnectionOptionsValidationError) Cause() error { return e.cause }
+func (e GenerateSourceOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PostgresSourceConnectionOptionsValidationError) Key() bool { return e.key }
+func (e GenerateSourceOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PostgresSourceConnectionOptionsValidationError) ErrorName() string {
-	return "PostgresSourceConnectionOptionsValidationError"
+func (e GenerateSourceOptionsValidationError) ErrorName() string {
+	return "GenerateSourceOptionsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PostgresSourceConnectionOptionsValidationError) Error() string {
+func (e GenerateSourceOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1879,14 +2052,14 @@ func (e PostgresSourceConnectionOptionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPostgresSourceConnectionOptions.%s: %s%s",
+		"invalid %sGenerateSourceOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PostgresSourceConnectionOptionsValidationError{}
+var _ error = GenerateSourceOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -1894,24 +2067,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PostgresSourceConnectionOptionsValidationError{}
+} = GenerateSourceOptionsValidationError{}
 
-// Validate checks the field values on PostgresSourceSchemaOption with the
+// Validate checks the field values on GenerateSourceSchemaOption with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PostgresSourceSchemaOption) Validate() error {
+func (m *GenerateSourceSchemaOption) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PostgresSourceSchemaOption with the
+// ValidateAll checks the field values on GenerateSourceSchemaOption with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PostgresSourceSchemaOptionMultiError, or nil if none found.
-func (m *PostgresSourceSchemaOption) ValidateAll() error {
+// GenerateSourceSchemaOptionMultiError, or nil if none found.
+func (m *GenerateSourceSchemaOption) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PostgresSourceSchemaOption) validate(all bool) error {
+func (m *GenerateSourceSchemaOption) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1927,7 +2100,7 @@ func (m *PostgresSourceSchemaOption) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PostgresSourceSchemaOptionValidationError{
+					errors = append(errors, GenerateSourceSchemaOptionValidationError{
 						field:  fmt.Sprintf("Tables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1935,7 +2108,7 @@ func (m *PostgresSourceSchemaOption) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, PostgresSourceSchemaOptionValidationError{
+					errors = append(errors, GenerateSourceSchemaOptionValidationError{
 						field:  fmt.Sprintf("Tables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1944,7 +2117,7 @@ func (m *PostgresSourceSchemaOption) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return PostgresSourceSchemaOptionValidationError{
+				return GenerateSourceSchemaOptionValidationError{
 					field:  fmt.Sprintf("Tables[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1955,19 +2128,19 @@ func (m *PostgresSourceSchemaOption) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return PostgresSourceSchemaOptionMultiError(errors)
+		return GenerateSourceSchemaOptionMultiError(errors)
 	}
 
 	return nil
 }
 
-// PostgresSourceSchemaOptionMultiError is an error wrapping multiple
-// validation errors returned by PostgresSourceSchemaOption.ValidateAll() if
+// GenerateSourceSchemaOptionMultiError is an error wrapping multiple
+// validation errors returned by GenerateSourceSchemaOption.ValidateAll() if
 // the designated constraints aren't met.
-type PostgresSourceSchemaOptionMultiError []error
+type GenerateSourceSchemaOptionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PostgresSourceSchemaOptionMultiError) Error() string {
+func (m GenerateSourceSchemaOptionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1976,11 +2149,11 @@ func (m PostgresSourceSchemaOptionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PostgresSourceSchemaOptionMultiError) AllErrors() []error { return m }
+func (m GenerateSourceSchemaOptionMultiError) AllErrors() []error { return m }
 
-// PostgresSourceSchemaOptionValidationError is the validation error returned
-// by PostgresSourceSchemaOption.Validate if the designated constraints aren't met.
-type PostgresSourceSchemaOptionValidationError struct {
+// GenerateSourceSchemaOptionValidationError is the validation error returned
+// by GenerateSourceSchemaOption.Validate if the designated constraints aren't met.
+type GenerateSourceSchemaOptionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1988,24 +2161,24 @@ type PostgresSourceSchemaOptionValidationError struct {
 }
 
 // Field function returns field value.
-func (e PostgresSourceSchemaOptionValidationError) Field() string { return e.field }
+func (e GenerateSourceSchemaOptionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PostgresSourceSchemaOptionValidationError) Reason() string { return e.reason }
+func (e GenerateSourceSchemaOptionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PostgresSourceSchemaOptionValidationError) Cause() error { return e.cause }
+func (e GenerateSourceSchemaOptionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PostgresSourceSchemaOptionValidationError) Key() bool { return e.key }
+func (e GenerateSourceSchemaOptionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PostgresSourceSchemaOptionValidationError) ErrorName() string {
-	return "PostgresSourceSchemaOptionValidationError"
+func (e GenerateSourceSchemaOptionValidationError) ErrorName() string {
+	return "GenerateSourceSchemaOptionValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PostgresSourceSchemaOptionValidationError) Error() string {
+func (e GenerateSourceSchemaOptionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2017,14 +2190,14 @@ func (e PostgresSourceSchemaOptionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPostgresSourceSchemaOption.%s: %s%s",
+		"invalid %sGenerateSourceSchemaOption.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PostgresSourceSchemaOptionValidationError{}
+var _ error = GenerateSourceSchemaOptionValidationError{}
 
 var _ interface {
 	Field() string
@@ -2032,24 +2205,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PostgresSourceSchemaOptionValidationError{}
+} = GenerateSourceSchemaOptionValidationError{}
 
-// Validate checks the field values on PostgresSourceTableOption with the rules
+// Validate checks the field values on GenerateSourceTableOption with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PostgresSourceTableOption) Validate() error {
+func (m *GenerateSourceTableOption) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PostgresSourceTableOption with the
+// ValidateAll checks the field values on GenerateSourceTableOption with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PostgresSourceTableOptionMultiError, or nil if none found.
-func (m *PostgresSourceTableOption) ValidateAll() error {
+// GenerateSourceTableOptionMultiError, or nil if none found.
+func (m *GenerateSourceTableOption) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PostgresSourceTableOption) validate(all bool) error {
+func (m *GenerateSourceTableOption) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2058,24 +2231,22 @@ func (m *PostgresSourceTableOption) validate(all bool) error {
 
 	// no validation rules for Table
 
-	if m.WhereClause != nil {
-		// no validation rules for WhereClause
-	}
+	// no validation rules for RowCount
 
 	if len(errors) > 0 {
-		return PostgresSourceTableOptionMultiError(errors)
+		return GenerateSourceTableOptionMultiError(errors)
 	}
 
 	return nil
 }
 
-// PostgresSourceTableOptionMultiError is an error wrapping multiple validation
-// errors returned by PostgresSourceTableOption.ValidateAll() if the
+// GenerateSourceTableOptionMultiError is an error wrapping multiple validation
+// errors returned by GenerateSourceTableOption.ValidateAll() if the
 // designated constraints aren't met.
-type PostgresSourceTableOptionMultiError []error
+type GenerateSourceTableOptionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PostgresSourceTableOptionMultiError) Error() string {
+func (m GenerateSourceTableOptionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2084,11 +2255,11 @@ func (m PostgresSourceTableOptionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PostgresSourceTableOptionMultiError) AllErrors() []error { return m }
+func (m GenerateSourceTableOptionMultiError) AllErrors() []error { return m }
 
-// PostgresSourceTableOptionValidationError is the validation error returned by
-// PostgresSourceTableOption.Validate if the designated constraints aren't met.
-type PostgresSourceTableOptionValidationError struct {
+// GenerateSourceTableOptionValidationError is the validation error returned by
+// GenerateSourceTableOption.Validate if the designated constraints aren't met.
+type GenerateSourceTableOptionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2096,24 +2267,24 @@ type PostgresSourceTableOptionValidationError struct {
 }
 
 // Field function returns field value.
-func (e PostgresSourceTableOptionValidationError) Field() string { return e.field }
+func (e GenerateSourceTableOptionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PostgresSourceTableOptionValidationError) Reason() string { return e.reason }
+func (e GenerateSourceTableOptionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PostgresSourceTableOptionValidationError) Cause() error { return e.cause }
+func (e GenerateSourceTableOptionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PostgresSourceTableOptionValidationError) Key() bool { return e.key }
+func (e GenerateSourceTableOptionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PostgresSourceTableOptionValidationError) ErrorName() string {
-	return "PostgresSourceTableOptionValidationError"
+func (e GenerateSourceTableOptionValidationError) ErrorName() string {
+	return "GenerateSourceTableOptionValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PostgresSourceTableOptionValidationError) Error() string {
+func (e GenerateSourceTableOptionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2125,14 +2296,14 @@ func (e PostgresSourceTableOptionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPostgresSourceTableOption.%s: %s%s",
+		"invalid %sGenerateSourceTableOption.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PostgresSourceTableOptionValidationError{}
+var _ error = GenerateSourceTableOptionValidationError{}
 
 var _ interface {
 	Field() string
@@ -2140,24 +2311,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PostgresSourceTableOptionValidationError{}
+} = GenerateSourceTableOptionValidationError{}
 
-// Validate checks the field values on MysqlSourceConnectionOptions with the
+// Validate checks the field values on PostgresSourceConnectionOptions with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MysqlSourceConnectionOptions) Validate() error {
+func (m *PostgresSourceConnectionOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MysqlSourceConnectionOptions with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// MysqlSourceConnectionOptionsMultiError, or nil if none found.
-func (m *MysqlSourceConnectionOptions) ValidateAll() error {
+// ValidateAll checks the field values on PostgresSourceConnectionOptions with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PostgresSourceConnectionOptionsMultiError, or nil if none found.
+func (m *PostgresSourceConnectionOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MysqlSourceConnectionOptions) validate(all bool) error {
+func (m *PostgresSourceConnectionOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2173,7 +2344,7 @@ func (m *MysqlSourceConnectionOptions) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MysqlSourceConnectionOptionsValidationError{
+					errors = append(errors, PostgresSourceConnectionOptionsValidationError{
 						field:  fmt.Sprintf("Schemas[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -2181,7 +2352,7 @@ func (m *MysqlSourceConnectionOptions) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, MysqlSourceConnectionOptionsValidationError{
+					errors = append(errors, PostgresSourceConnectionOptionsValidationError{
 						field:  fmt.Sprintf("Schemas[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -2190,7 +2361,7 @@ func (m *MysqlSourceConnectionOptions) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return MysqlSourceConnectionOptionsValidationError{
+				return PostgresSourceConnectionOptionsValidationError{
 					field:  fmt.Sprintf("Schemas[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2204,20 +2375,87 @@ func (m *MysqlSourceConnectionOptions) validate(all bool) error {
 
 	// no validation rules for SubsetByForeignKeyConstraints
 
+	// no validation rules for IncludeForeignTables
+
+	for idx, item := range m.GetVirtualTables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PostgresSourceConnectionOptionsValidationError{
+						field:  fmt.Sprintf("VirtualTables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PostgresSourceConnectionOptionsValidationError{
+						field:  fmt.Sprintf("VirtualTables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PostgresSourceConnectionOptionsValidationError{
+					field:  fmt.Sprintf("VirtualTables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for MaterializeViews
+
+	if all {
+		switch v := interface{}(m.GetTenantExtract()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PostgresSourceConnectionOptionsValidationError{
+					field:  "TenantExtract",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PostgresSourceConnectionOptionsValidationError{
+					field:  "TenantExtract",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTenantExtract()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PostgresSourceConnectionOptionsValidationError{
+				field:  "TenantExtract",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return MysqlSourceConnectionOptionsMultiError(errors)
+		return PostgresSourceConnectionOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// MysqlSourceConnectionOptionsMultiError is an error wrapping multiple
-// validation errors returned by MysqlSourceConnectionOptions.ValidateAll() if
-// the designated constraints aren't met.
-type MysqlSourceConnectionOptionsMultiError []error
+// PostgresSourceConnectionOptionsMultiError is an error wrapping multiple
+// validation errors returned by PostgresSourceConnectionOptions.ValidateAll()
+// if the designated constraints aren't met.
+type PostgresSourceConnectionOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MysqlSourceConnectionOptionsMultiError) Error() string {
+func (m PostgresSourceConnectionOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2226,12 +2464,12 @@ func (m MysqlSourceConnectionOptionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MysqlSourceConnectionOptionsMultiError) AllErrors() []error { return m }
+func (m PostgresSourceConnectionOptionsMultiError) AllErrors() []error { return m }
 
-// MysqlSourceConnectionOptionsValidationError is the validation error returned
-// by MysqlSourceConnectionOptions.Validate if the designated constraints
-// aren't met.
-type MysqlSourceConnectionOptionsValidationError struct {
+// PostgresSourceConnectionOptionsValidationError is the validation error
+// returned by PostgresSourceConnectionOptions.Validate if the designated
+// constraints aren't met.
+type PostgresSourceConnectionOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2239,24 +2477,24 @@ type MysqlSourceConnectionOptionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e MysqlSourceConnectionOptionsValidationError) Field() string { return e.field }
+func (e PostgresSourceConnectionOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MysqlSourceConnectionOptionsValidationError) Reason() string { return e.reason }
+func (e PostgresSourceConnectionOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MysqlSourceConnectionOptionsValidationError) Cause() error { return e.cause }
+func (e PostgresSourceConnectionOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MysqlSourceConnectionOptionsValidationError) Key() bool { return e.key }
+func (e PostgresSourceConnectionOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MysqlSourceConnectionOptionsValidationError) ErrorName() string {
-	return "MysqlSourceConnectionOptionsValidationError"
+func (e PostgresSourceConnectionOptionsValidationError) ErrorName() string {
+	return "PostgresSourceConnectionOptionsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MysqlSourceConnectionOptionsValidationError) Error() string {
+func (e PostgresSourceConnectionOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2268,14 +2506,14 @@ func (e MysqlSourceConnectionOptionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMysqlSourceConnectionOptions.%s: %s%s",
+		"invalid %sPostgresSourceConnectionOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MysqlSourceConnectionOptionsValidationError{}
+var _ error = PostgresSourceConnectionOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -2283,24 +2521,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MysqlSourceConnectionOptionsValidationError{}
+} = PostgresSourceConnectionOptionsValidationError{}
 
-// Validate checks the field values on MysqlSourceSchemaOption with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on PostgresSourceSchemaOption with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MysqlSourceSchemaOption) Validate() error {
+func (m *PostgresSourceSchemaOption) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MysqlSourceSchemaOption with the
+// ValidateAll checks the field values on PostgresSourceSchemaOption with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MysqlSourceSchemaOptionMultiError, or nil if none found.
-func (m *MysqlSourceSchemaOption) ValidateAll() error {
+// PostgresSourceSchemaOptionMultiError, or nil if none found.
+func (m *PostgresSourceSchemaOption) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MysqlSourceSchemaOption) validate(all bool) error {
+func (m *PostgresSourceSchemaOption) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2316,7 +2554,7 @@ func (m *MysqlSourceSchemaOption) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MysqlSourceSchemaOptionValidationError{
+					errors = append(errors, PostgresSourceSchemaOptionValidationError{
 						field:  fmt.Sprintf("Tables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -2324,7 +2562,7 @@ func (m *MysqlSourceSchemaOption) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, MysqlSourceSchemaOptionValidationError{
+					errors = append(errors, PostgresSourceSchemaOptionValidationError{
 						field:  fmt.Sprintf("Tables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -2333,7 +2571,7 @@ func (m *MysqlSourceSchemaOption) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return MysqlSourceSchemaOptionValidationError{
+				return PostgresSourceSchemaOptionValidationError{
 					field:  fmt.Sprintf("Tables[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2344,19 +2582,19 @@ func (m *MysqlSourceSchemaOption) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return MysqlSourceSchemaOptionMultiError(errors)
+		return PostgresSourceSchemaOptionMultiError(errors)
 	}
 
 	return nil
 }
 
-// MysqlSourceSchemaOptionMultiError is an error wrapping multiple validation
-// errors returned by MysqlSourceSchemaOption.ValidateAll() if the designated
-// constraints aren't met.
-type MysqlSourceSchemaOptionMultiError []error
+// PostgresSourceSchemaOptionMultiError is an error wrapping multiple
+// validation errors returned by PostgresSourceSchemaOption.ValidateAll() if
+// the designated constraints aren't met.
+type PostgresSourceSchemaOptionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MysqlSourceSchemaOptionMultiError) Error() string {
+func (m PostgresSourceSchemaOptionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2365,11 +2603,11 @@ func (m MysqlSourceSchemaOptionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MysqlSourceSchemaOptionMultiError) AllErrors() []error { return m }
+func (m PostgresSourceSchemaOptionMultiError) AllErrors() []error { return m }
 
-// MysqlSourceSchemaOptionValidationError is the validation error returned by
-// MysqlSourceSchemaOption.Validate if the designated constraints aren't met.
-type MysqlSourceSchemaOptionValidationError struct {
+// PostgresSourceSchemaOptionValidationError is the validation error returned
+// by PostgresSourceSchemaOption.Validate if the designated constraints aren't met.
+type PostgresSourceSchemaOptionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2377,24 +2615,24 @@ type MysqlSourceSchemaOptionValidationError struct {
 }
 
 // Field function returns field value.
-func (e MysqlSourceSchemaOptionValidationError) Field() string { return e.field }
+func (e PostgresSourceSchemaOptionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MysqlSourceSchemaOptionValidationError) Reason() string { return e.reason }
+func (e PostgresSourceSchemaOptionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MysqlSourceSchemaOptionValidationError) Cause() error { return e.cause }
+func (e PostgresSourceSchemaOptionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MysqlSourceSchemaOptionValidationError) Key() bool { return e.key }
+func (e PostgresSourceSchemaOptionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MysqlSourceSchemaOptionValidationError) ErrorName() string {
-	return "MysqlSourceSchemaOptionValidationError"
+func (e PostgresSourceSchemaOptionValidationError) ErrorName() string {
+	return "PostgresSourceSchemaOptionValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MysqlSourceSchemaOptionValidationError) Error() string {
+func (e PostgresSourceSchemaOptionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2406,14 +2644,14 @@ func (e MysqlSourceSchemaOptionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMysqlSourceSchemaOption.%s: %s%s",
+		"invalid %sPostgresSourceSchemaOption.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MysqlSourceSchemaOptionValidationError{}
+var _ error = PostgresSourceSchemaOptionValidationError{}
 
 var _ interface {
 	Field() string
@@ -2421,24 +2659,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MysqlSourceSchemaOptionValidationError{}
+} = PostgresSourceSchemaOptionValidationError{}
 
-// Validate checks the field values on MysqlSourceTableOption with the rules
+// Validate checks the field values on PostgresSourceTableOption with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MysqlSourceTableOption) Validate() error {
+func (m *PostgresSourceTableOption) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MysqlSourceTableOption with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on PostgresSourceTableOption with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MysqlSourceTableOptionMultiError, or nil if none found.
-func (m *MysqlSourceTableOption) ValidateAll() error {
+// PostgresSourceTableOptionMultiError, or nil if none found.
+func (m *PostgresSourceTableOption) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MysqlSourceTableOption) validate(all bool) error {
+func (m *PostgresSourceTableOption) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2452,19 +2690,19 @@ func (m *MysqlSourceTableOption) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return MysqlSourceTableOptionMultiError(errors)
+		return PostgresSourceTableOptionMultiError(errors)
 	}
 
 	return nil
 }
 
-// MysqlSourceTableOptionMultiError is an error wrapping multiple validation
-// errors returned by MysqlSourceTableOption.ValidateAll() if the designated
-// constraints aren't met.
-type MysqlSourceTableOptionMultiError []error
+// PostgresSourceTableOptionMultiError is an error wrapping multiple validation
+// errors returned by PostgresSourceTableOption.ValidateAll() if the
+// designated constraints aren't met.
+type PostgresSourceTableOptionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MysqlSourceTableOptionMultiError) Error() string {
+func (m PostgresSourceTableOptionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2473,11 +2711,11 @@ func (m MysqlSourceTableOptionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MysqlSourceTableOptionMultiError) AllErrors() []error { return m }
+func (m PostgresSourceTableOptionMultiError) AllErrors() []error { return m }
 
-// MysqlSourceTableOptionValidationError is the validation error returned by
-// MysqlSourceTableOption.Validate if the designated constraints aren't met.
-type MysqlSourceTableOptionValidationError struct {
+// PostgresSourceTableOptionValidationError is the validation error returned by
+// PostgresSourceTableOption.Validate if the designated constraints aren't met.
+type PostgresSourceTableOptionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2485,24 +2723,24 @@ type MysqlSourceTableOptionValidationError struct {
 }
 
 // Field function returns field value.
-func (e MysqlSourceTableOptionValidationError) Field() string { return e.field }
+func (e PostgresSourceTableOptionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MysqlSourceTableOptionValidationError) Reason() string { return e.reason }
+func (e PostgresSourceTableOptionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MysqlSourceTableOptionValidationError) Cause() error { return e.cause }
+func (e PostgresSourceTableOptionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MysqlSourceTableOptionValidationError) Key() bool { return e.key }
+func (e PostgresSourceTableOptionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MysqlSourceTableOptionValidationError) ErrorName() string {
-	return "MysqlSourceTableOptionValidationError"
+func (e PostgresSourceTableOptionValidationError) ErrorName() string {
+	return "PostgresSourceTableOptionValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MysqlSourceTableOptionValidationError) Error() string {
+func (e PostgresSourceTableOptionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2514,14 +2752,14 @@ func (e MysqlSourceTableOptionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMysqlSourceTableOption.%s: %s%s",
+		"invalid %sPostgresSourceTableOption.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MysqlSourceTableOptionValidationError{}
+var _ error = PostgresSourceTableOptionValidationError{}
 
 var _ interface {
 	Field() string
@@ -2529,46 +2767,149 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MysqlSourceTableOptionValidationError{}
+} = PostgresSourceTableOptionValidationError{}
 
-// Validate checks the field values on AwsS3SourceConnectionOptions with the
+// Validate checks the field values on MysqlSourceConnectionOptions with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AwsS3SourceConnectionOptions) Validate() error {
+func (m *MysqlSourceConnectionOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AwsS3SourceConnectionOptions with the
+// ValidateAll checks the field values on MysqlSourceConnectionOptions with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AwsS3SourceConnectionOptionsMultiError, or nil if none found.
-func (m *AwsS3SourceConnectionOptions) ValidateAll() error {
+// MysqlSourceConnectionOptionsMultiError, or nil if none found.
+func (m *MysqlSourceConnectionOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AwsS3SourceConnectionOptions) validate(all bool) error {
+func (m *MysqlSourceConnectionOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for HaltOnNewColumnAddition
+
+	for idx, item := range m.GetSchemas() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MysqlSourceConnectionOptionsValidationError{
+						field:  fmt.Sprintf("Schemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MysqlSourceConnectionOptionsValidationError{
+						field:  fmt.Sprintf("Schemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MysqlSourceConnectionOptionsValidationError{
+					field:  fmt.Sprintf("Schemas[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	// no validation rules for ConnectionId
 
+	// no validation rules for SubsetByForeignKeyConstraints
+
+	for idx, item := range m.GetVirtualTables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MysqlSourceConnectionOptionsValidationError{
+						field:  fmt.Sprintf("VirtualTables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MysqlSourceConnectionOptionsValidationError{
+						field:  fmt.Sprintf("VirtualTables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MysqlSourceConnectionOptionsValidationError{
+					field:  fmt.Sprintf("VirtualTables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for MaterializeViews
+
+	if all {
+		switch v := interface{}(m.GetTenantExtract()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MysqlSourceConnectionOptionsValidationError{
+					field:  "TenantExtract",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MysqlSourceConnectionOptionsValidationError{
+					field:  "TenantExtract",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTenantExtract()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MysqlSourceConnectionOptionsValidationError{
+				field:  "TenantExtract",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return AwsS3SourceConnectionOptionsMultiError(errors)
+		return MysqlSourceConnectionOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// AwsS3SourceConnectionOptionsMultiError is an error wrapping multiple
-// validation errors returned by AwsS3SourceConnectionOptions.ValidateAll() if
+// MysqlSourceConnectionOptionsMultiError is an error wrapping multiple
+// validation errors returned by MysqlSourceConnectionOptions.ValidateAll() if
 // the designated constraints aren't met.
-type AwsS3SourceConnectionOptionsMultiError []error
+type MysqlSourceConnectionOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AwsS3SourceConnectionOptionsMultiError) Error() string {
+func (m MysqlSourceConnectionOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2577,12 +2918,12 @@ func (m AwsS3SourceConnectionOptionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AwsS3SourceConnectionOptionsMultiError) AllErrors() []error { return m }
+func (m MysqlSourceConnectionOptionsMultiError) AllErrors() []error { return m }
 
-// AwsS3SourceConnectionOptionsValidationError is the validation error returned
-// by AwsS3SourceConnectionOptions.Validate if the designated constraints
-// aren't met.
-type AwsS3SourceConnectionOptionsValidationError struct {
+// MysqlSourceConnectionOptionsValidationError is the validation error returned
+// by MysqlSourceConnectionOptions.Validate if the designated constraints
+// aren't met.
+type MysqlSourceConnectionOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2590,24 +2931,24 @@ type AwsS3SourceConnectionOptionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AwsS3SourceConnectionOptionsValidationError) Field() string { return e.field }
+func (e MysqlSourceConnectionOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AwsS3SourceConnectionOptionsValidationError) Reason() string { return e.reason }
+func (e MysqlSourceConnectionOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AwsS3SourceConnectionOptionsValidationError) Cause() error { return e.cause }
+func (e MysqlSourceConnectionOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AwsS3SourceConnectionOptionsValidationError) Key() bool { return e.key }
+func (e MysqlSourceConnectionOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AwsS3SourceConnectionOptionsValidationError) ErrorName() string {
-	return "AwsS3SourceConnectionOptionsValidationError"
+func (e MysqlSourceConnectionOptionsValidationError) ErrorName() string {
+	return "MysqlSourceConnectionOptionsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AwsS3SourceConnectionOptionsValidationError) Error() string {
+func (e MysqlSourceConnectionOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2619,14 +2960,14 @@ func (e AwsS3SourceConnectionOptionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAwsS3SourceConnectionOptions.%s: %s%s",
+		"invalid %sMysqlSourceConnectionOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AwsS3SourceConnectionOptionsValidationError{}
+var _ error = MysqlSourceConnectionOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -2634,172 +2975,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AwsS3SourceConnectionOptionsValidationError{}
+} = MysqlSourceConnectionOptionsValidationError{}
 
-// Validate checks the field values on JobDestinationOptions with the rules
+// Validate checks the field values on MysqlSourceSchemaOption with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *JobDestinationOptions) Validate() error {
+func (m *MysqlSourceSchemaOption) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobDestinationOptions with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on MysqlSourceSchemaOption with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JobDestinationOptionsMultiError, or nil if none found.
-func (m *JobDestinationOptions) ValidateAll() error {
+// MysqlSourceSchemaOptionMultiError, or nil if none found.
+func (m *MysqlSourceSchemaOption) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobDestinationOptions) validate(all bool) error {
+func (m *MysqlSourceSchemaOption) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Config.(type) {
-	case *JobDestinationOptions_PostgresOptions:
-		if v == nil {
-			err := JobDestinationOptionsValidationError{
-				field:  "Config",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetPostgresOptions()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobDestinationOptionsValidationError{
-						field:  "PostgresOptions",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, JobDestinationOptionsValidationError{
-						field:  "PostgresOptions",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetPostgresOptions()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return JobDestinationOptionsValidationError{
-					field:  "PostgresOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *JobDestinationOptions_AwsS3Options:
-		if v == nil {
-			err := JobDestinationOptionsValidationError{
-				field:  "Config",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetAwsS3Options()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobDestinationOptionsValidationError{
-						field:  "AwsS3Options",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, JobDestinationOptionsValidationError{
-						field:  "AwsS3Options",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetAwsS3Options()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return JobDestinationOptionsValidationError{
-					field:  "AwsS3Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Schema
 
-	case *JobDestinationOptions_MysqlOptions:
-		if v == nil {
-			err := JobDestinationOptionsValidationError{
-				field:  "Config",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	for idx, item := range m.GetTables() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetMysqlOptions()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobDestinationOptionsValidationError{
-						field:  "MysqlOptions",
+					errors = append(errors, MysqlSourceSchemaOptionValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, JobDestinationOptionsValidationError{
-						field:  "MysqlOptions",
+					errors = append(errors, MysqlSourceSchemaOptionValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetMysqlOptions()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return JobDestinationOptionsValidationError{
-					field:  "MysqlOptions",
+				return MysqlSourceSchemaOptionValidationError{
+					field:  fmt.Sprintf("Tables[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	default:
-		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return JobDestinationOptionsMultiError(errors)
+		return MysqlSourceSchemaOptionMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobDestinationOptionsMultiError is an error wrapping multiple validation
-// errors returned by JobDestinationOptions.ValidateAll() if the designated
+// MysqlSourceSchemaOptionMultiError is an error wrapping multiple validation
+// errors returned by MysqlSourceSchemaOption.ValidateAll() if the designated
 // constraints aren't met.
-type JobDestinationOptionsMultiError []error
+type MysqlSourceSchemaOptionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobDestinationOptionsMultiError) Error() string {
+func (m MysqlSourceSchemaOptionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2808,11 +3057,11 @@ func (m JobDestinationOptionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobDestinationOptionsMultiError) AllErrors() []error { return m }
+func (m MysqlSourceSchemaOptionMultiError) AllErrors() []error { return m }
 
-// JobDestinationOptionsValidationError is the validation error returned by
-// JobDestinationOptions.Validate if the designated constraints aren't met.
-type JobDestinationOptionsValidationError struct {
+// MysqlSourceSchemaOptionValidationError is the validation error returned by
+// MysqlSourceSchemaOption.Validate if the designated constraints aren't met.
+type MysqlSourceSchemaOptionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2820,24 +3069,24 @@ type JobDestinationOptionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobDestinationOptionsValidationError) Field() string { return e.field }
+func (e MysqlSourceSchemaOptionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobDestinationOptionsValidationError) Reason() string { return e.reason }
+func (e MysqlSourceSchemaOptionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobDestinationOptionsValidationError) Cause() error { return e.cause }
+func (e MysqlSourceSchemaOptionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobDestinationOptionsValidationError) Key() bool { return e.key }
+func (e MysqlSourceSchemaOptionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobDestinationOptionsValidationError) ErrorName() string {
-	return "JobDestinationOptionsValidationError"
+func (e MysqlSourceSchemaOptionValidationError) ErrorName() string {
+	return "MysqlSourceSchemaOptionValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e JobDestinationOptionsValidationError) Error() string {
+func (e MysqlSourceSchemaOptionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2849,14 +3098,14 @@ func (e JobDestinationOptionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobDestinationOptions.%s: %s%s",
+		"invalid %sMysqlSourceSchemaOption.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobDestinationOptionsValidationError{}
+var _ error = MysqlSourceSchemaOptionValidationError{}
 
 var _ interface {
 	Field() string
@@ -2864,106 +3113,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobDestinationOptionsValidationError{}
+} = MysqlSourceSchemaOptionValidationError{}
 
-// Validate checks the field values on PostgresDestinationConnectionOptions
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *PostgresDestinationConnectionOptions) Validate() error {
+// Validate checks the field values on MysqlSourceTableOption with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MysqlSourceTableOption) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PostgresDestinationConnectionOptions
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// PostgresDestinationConnectionOptionsMultiError, or nil if none found.
-func (m *PostgresDestinationConnectionOptions) ValidateAll() error {
+// ValidateAll checks the field values on MysqlSourceTableOption with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MysqlSourceTableOptionMultiError, or nil if none found.
+func (m *MysqlSourceTableOption) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PostgresDestinationConnectionOptions) validate(all bool) error {
+func (m *MysqlSourceTableOption) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTruncateTable()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PostgresDestinationConnectionOptionsValidationError{
-					field:  "TruncateTable",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PostgresDestinationConnectionOptionsValidationError{
-					field:  "TruncateTable",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTruncateTable()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PostgresDestinationConnectionOptionsValidationError{
-				field:  "TruncateTable",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	// no validation rules for Table
+
+	if m.WhereClause != nil {
+		// no validation rules for WhereClause
 	}
 
-	// no validation rules for InitTableSchema
-
-	if all {
-		switch v := interface{}(m.GetOnConflict()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PostgresDestinationConnectionOptionsValidationError{
-					field:  "OnConflict",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PostgresDestinationConnectionOptionsValidationError{
-					field:  "OnConflict",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOnConflict()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PostgresDestinationConnectionOptionsValidationError{
-				field:  "OnConflict",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if len(errors) > 0 {
-		return PostgresDestinationConnectionOptionsMultiError(errors)
-	}
+	if len(errors) > 0 {
+		return MysqlSourceTableOptionMultiError(errors)
+	}
 
 	return nil
 }
 
-// PostgresDestinationConnectionOptionsMultiError is an error wrapping multiple
-// validation errors returned by
-// PostgresDestinationConnectionOptions.ValidateAll() if the designated
+// MysqlSourceTableOptionMultiError is an error wrapping multiple validation
+// errors returned by MysqlSourceTableOption.ValidateAll() if the designated
 // constraints aren't met.
-type PostgresDestinationConnectionOptionsMultiError []error
+type MysqlSourceTableOptionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PostgresDestinationConnectionOptionsMultiError) Error() string {
+func (m MysqlSourceTableOptionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2972,12 +3165,11 @@ func (m PostgresDestinationConnectionOptionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PostgresDestinationConnectionOptionsMultiError) AllErrors() []error { return m }
+func (m MysqlSourceTableOptionMultiError) AllErrors() []error { return m }
 
-// PostgresDestinationConnectionOptionsValidationError is the validation error
-// returned by PostgresDestinationConnectionOptions.Validate if the designated
-// constraints aren't met.
-type PostgresDestinationConnectionOptionsValidationError struct {
+// MysqlSourceTableOptionValidationError is the validation error returned by
+// MysqlSourceTableOption.Validate if the designated constraints aren't met.
+type MysqlSourceTableOptionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2985,24 +3177,24 @@ type PostgresDestinationConnectionOptionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e PostgresDestinationConnectionOptionsValidationError) Field() string { return e.field }
+func (e MysqlSourceTableOptionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PostgresDestinationConnectionOptionsValidationError) Reason() string { return e.reason }
+func (e MysqlSourceTableOptionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PostgresDestinationConnectionOptionsValidationError) Cause() error { return e.cause }
+func (e MysqlSourceTableOptionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PostgresDestinationConnectionOptionsValidationError) Key() bool { return e.key }
+func (e MysqlSourceTableOptionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PostgresDestinationConnectionOptionsValidationError) ErrorName() string {
-	return "PostgresDestinationConnectionOptionsValidationError"
+func (e MysqlSourceTableOptionValidationError) ErrorName() string {
+	return "MysqlSourceTableOptionValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PostgresDestinationConnectionOptionsValidationError) Error() string {
+func (e MysqlSourceTableOptionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3014,14 +3206,14 @@ func (e PostgresDestinationConnectionOptionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPostgresDestinationConnectionOptions.%s: %s%s",
+		"invalid %sMysqlSourceTableOption.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PostgresDestinationConnectionOptionsValidationError{}
+var _ error = MysqlSourceTableOptionValidationError{}
 
 var _ interface {
 	Field() string
@@ -3029,46 +3221,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PostgresDestinationConnectionOptionsValidationError{}
+} = MysqlSourceTableOptionValidationError{}
 
-// Validate checks the field values on PostgresOnConflictConfig with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PostgresOnConflictConfig) Validate() error {
+// Validate checks the field values on SqlVirtualTable with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SqlVirtualTable) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PostgresOnConflictConfig with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SqlVirtualTable with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PostgresOnConflictConfigMultiError, or nil if none found.
-func (m *PostgresOnConflictConfig) ValidateAll() error {
+// SqlVirtualTableMultiError, or nil if none found.
+func (m *SqlVirtualTable) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PostgresOnConflictConfig) validate(all bool) error {
+func (m *SqlVirtualTable) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DoNothing
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for Query
 
 	if len(errors) > 0 {
-		return PostgresOnConflictConfigMultiError(errors)
+		return SqlVirtualTableMultiError(errors)
 	}
 
 	return nil
 }
 
-// PostgresOnConflictConfigMultiError is an error wrapping multiple validation
-// errors returned by PostgresOnConflictConfig.ValidateAll() if the designated
-// constraints aren't met.
-type PostgresOnConflictConfigMultiError []error
+// SqlVirtualTableMultiError is an error wrapping multiple validation errors
+// returned by SqlVirtualTable.ValidateAll() if the designated constraints
+// aren't met.
+type SqlVirtualTableMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PostgresOnConflictConfigMultiError) Error() string {
+func (m SqlVirtualTableMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3077,11 +3273,11 @@ func (m PostgresOnConflictConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PostgresOnConflictConfigMultiError) AllErrors() []error { return m }
+func (m SqlVirtualTableMultiError) AllErrors() []error { return m }
 
-// PostgresOnConflictConfigValidationError is the validation error returned by
-// PostgresOnConflictConfig.Validate if the designated constraints aren't met.
-type PostgresOnConflictConfigValidationError struct {
+// SqlVirtualTableValidationError is the validation error returned by
+// SqlVirtualTable.Validate if the designated constraints aren't met.
+type SqlVirtualTableValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3089,24 +3285,22 @@ type PostgresOnConflictConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e PostgresOnConflictConfigValidationError) Field() string { return e.field }
+func (e SqlVirtualTableValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PostgresOnConflictConfigValidationError) Reason() string { return e.reason }
+func (e SqlVirtualTableValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PostgresOnConflictConfigValidationError) Cause() error { return e.cause }
+func (e SqlVirtualTableValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PostgresOnConflictConfigValidationError) Key() bool { return e.key }
+func (e SqlVirtualTableValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PostgresOnConflictConfigValidationError) ErrorName() string {
-	return "PostgresOnConflictConfigValidationError"
-}
+func (e SqlVirtualTableValidationError) ErrorName() string { return "SqlVirtualTableValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PostgresOnConflictConfigValidationError) Error() string {
+func (e SqlVirtualTableValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3118,14 +3312,14 @@ func (e PostgresOnConflictConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPostgresOnConflictConfig.%s: %s%s",
+		"invalid %sSqlVirtualTable.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PostgresOnConflictConfigValidationError{}
+var _ error = SqlVirtualTableValidationError{}
 
 var _ interface {
 	Field() string
@@ -3133,48 +3327,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PostgresOnConflictConfigValidationError{}
+} = SqlVirtualTableValidationError{}
 
-// Validate checks the field values on PostgresTruncateTableConfig with the
+// Validate checks the field values on AwsS3SourceConnectionOptions with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PostgresTruncateTableConfig) Validate() error {
+func (m *AwsS3SourceConnectionOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PostgresTruncateTableConfig with the
+// ValidateAll checks the field values on AwsS3SourceConnectionOptions with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PostgresTruncateTableConfigMultiError, or nil if none found.
-func (m *PostgresTruncateTableConfig) ValidateAll() error {
+// AwsS3SourceConnectionOptionsMultiError, or nil if none found.
+func (m *AwsS3SourceConnectionOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PostgresTruncateTableConfig) validate(all bool) error {
+func (m *AwsS3SourceConnectionOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TruncateBeforeInsert
+	// no validation rules for ConnectionId
 
-	// no validation rules for Cascade
+	switch v := m.Run.(type) {
+	case *AwsS3SourceConnectionOptions_JobRunId:
+		if v == nil {
+			err := AwsS3SourceConnectionOptionsValidationError{
+				field:  "Run",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for JobRunId
+	case *AwsS3SourceConnectionOptions_SnapshotName:
+		if v == nil {
+			err := AwsS3SourceConnectionOptionsValidationError{
+				field:  "Run",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for SnapshotName
+	default:
+		_ = v // ensures v is used
+	}
 
 	if len(errors) > 0 {
-		return PostgresTruncateTableConfigMultiError(errors)
+		return AwsS3SourceConnectionOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// PostgresTruncateTableConfigMultiError is an error wrapping multiple
-// validation errors returned by PostgresTruncateTableConfig.ValidateAll() if
+// AwsS3SourceConnectionOptionsMultiError is an error wrapping multiple
+// validation errors returned by AwsS3SourceConnectionOptions.ValidateAll() if
 // the designated constraints aren't met.
-type PostgresTruncateTableConfigMultiError []error
+type AwsS3SourceConnectionOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PostgresTruncateTableConfigMultiError) Error() string {
+func (m AwsS3SourceConnectionOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3183,12 +3404,12 @@ func (m PostgresTruncateTableConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PostgresTruncateTableConfigMultiError) AllErrors() []error { return m }
+func (m AwsS3SourceConnectionOptionsMultiError) AllErrors() []error { return m }
 
-// PostgresTruncateTableConfigValidationError is the validation error returned
-// by PostgresTruncateTableConfig.Validate if the designated constraints
+// AwsS3SourceConnectionOptionsValidationError is the validation error returned
+// by AwsS3SourceConnectionOptions.Validate if the designated constraints
 // aren't met.
-type PostgresTruncateTableConfigValidationError struct {
+type AwsS3SourceConnectionOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3196,24 +3417,24 @@ type PostgresTruncateTableConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e PostgresTruncateTableConfigValidationError) Field() string { return e.field }
+func (e AwsS3SourceConnectionOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PostgresTruncateTableConfigValidationError) Reason() string { return e.reason }
+func (e AwsS3SourceConnectionOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PostgresTruncateTableConfigValidationError) Cause() error { return e.cause }
+func (e AwsS3SourceConnectionOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PostgresTruncateTableConfigValidationError) Key() bool { return e.key }
+func (e AwsS3SourceConnectionOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PostgresTruncateTableConfigValidationError) ErrorName() string {
-	return "PostgresTruncateTableConfigValidationError"
+func (e AwsS3SourceConnectionOptionsValidationError) ErrorName() string {
+	return "AwsS3SourceConnectionOptionsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PostgresTruncateTableConfigValidationError) Error() string {
+func (e AwsS3SourceConnectionOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3225,14 +3446,14 @@ func (e PostgresTruncateTableConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPostgresTruncateTableConfig.%s: %s%s",
+		"invalid %sAwsS3SourceConnectionOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PostgresTruncateTableConfigValidationError{}
+var _ error = AwsS3SourceConnectionOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -3240,106 +3461,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PostgresTruncateTableConfigValidationError{}
+} = AwsS3SourceConnectionOptionsValidationError{}
 
-// Validate checks the field values on MysqlDestinationConnectionOptions with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *MysqlDestinationConnectionOptions) Validate() error {
+// Validate checks the field values on CustomSourceConnectionOptions with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CustomSourceConnectionOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MysqlDestinationConnectionOptions
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// MysqlDestinationConnectionOptionsMultiError, or nil if none found.
-func (m *MysqlDestinationConnectionOptions) ValidateAll() error {
+// ValidateAll checks the field values on CustomSourceConnectionOptions with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CustomSourceConnectionOptionsMultiError, or nil if none found.
+func (m *CustomSourceConnectionOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MysqlDestinationConnectionOptions) validate(all bool) error {
+func (m *CustomSourceConnectionOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTruncateTable()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MysqlDestinationConnectionOptionsValidationError{
-					field:  "TruncateTable",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MysqlDestinationConnectionOptionsValidationError{
-					field:  "TruncateTable",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTruncateTable()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MysqlDestinationConnectionOptionsValidationError{
-				field:  "TruncateTable",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for InitTableSchema
-
-	if all {
-		switch v := interface{}(m.GetOnConflict()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MysqlDestinationConnectionOptionsValidationError{
-					field:  "OnConflict",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MysqlDestinationConnectionOptionsValidationError{
-					field:  "OnConflict",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOnConflict()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MysqlDestinationConnectionOptionsValidationError{
-				field:  "OnConflict",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for ConnectionId
 
 	if len(errors) > 0 {
-		return MysqlDestinationConnectionOptionsMultiError(errors)
+		return CustomSourceConnectionOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// MysqlDestinationConnectionOptionsMultiError is an error wrapping multiple
-// validation errors returned by
-// MysqlDestinationConnectionOptions.ValidateAll() if the designated
-// constraints aren't met.
-type MysqlDestinationConnectionOptionsMultiError []error
+// CustomSourceConnectionOptionsMultiError is an error wrapping multiple
+// validation errors returned by CustomSourceConnectionOptions.ValidateAll()
+// if the designated constraints aren't met.
+type CustomSourceConnectionOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MysqlDestinationConnectionOptionsMultiError) Error() string {
+func (m CustomSourceConnectionOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3348,12 +3509,12 @@ func (m MysqlDestinationConnectionOptionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MysqlDestinationConnectionOptionsMultiError) AllErrors() []error { return m }
+func (m CustomSourceConnectionOptionsMultiError) AllErrors() []error { return m }
 
-// MysqlDestinationConnectionOptionsValidationError is the validation error
-// returned by MysqlDestinationConnectionOptions.Validate if the designated
+// CustomSourceConnectionOptionsValidationError is the validation error
+// returned by CustomSourceConnectionOptions.Validate if the designated
 // constraints aren't met.
-type MysqlDestinationConnectionOptionsValidationError struct {
+type CustomSourceConnectionOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3361,24 +3522,24 @@ type MysqlDestinationConnectionOptionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e MysqlDestinationConnectionOptionsValidationError) Field() string { return e.field }
+func (e CustomSourceConnectionOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MysqlDestinationConnectionOptionsValidationError) Reason() string { return e.reason }
+func (e CustomSourceConnectionOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MysqlDestinationConnectionOptionsValidationError) Cause() error { return e.cause }
+func (e CustomSourceConnectionOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MysqlDestinationConnectionOptionsValidationError) Key() bool { return e.key }
+func (e CustomSourceConnectionOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MysqlDestinationConnectionOptionsValidationError) ErrorName() string {
-	return "MysqlDestinationConnectionOptionsValidationError"
+func (e CustomSourceConnectionOptionsValidationError) ErrorName() string {
+	return "CustomSourceConnectionOptionsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MysqlDestinationConnectionOptionsValidationError) Error() string {
+func (e CustomSourceConnectionOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3390,14 +3551,14 @@ func (e MysqlDestinationConnectionOptionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMysqlDestinationConnectionOptions.%s: %s%s",
+		"invalid %sCustomSourceConnectionOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MysqlDestinationConnectionOptionsValidationError{}
+var _ error = CustomSourceConnectionOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -3405,46 +3566,254 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MysqlDestinationConnectionOptionsValidationError{}
+} = CustomSourceConnectionOptionsValidationError{}
 
-// Validate checks the field values on MysqlTruncateTableConfig with the rules
+// Validate checks the field values on JobDestinationOptions with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MysqlTruncateTableConfig) Validate() error {
+func (m *JobDestinationOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MysqlTruncateTableConfig with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on JobDestinationOptions with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MysqlTruncateTableConfigMultiError, or nil if none found.
-func (m *MysqlTruncateTableConfig) ValidateAll() error {
+// JobDestinationOptionsMultiError, or nil if none found.
+func (m *JobDestinationOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MysqlTruncateTableConfig) validate(all bool) error {
+func (m *JobDestinationOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TruncateBeforeInsert
+	switch v := m.Config.(type) {
+	case *JobDestinationOptions_PostgresOptions:
+		if v == nil {
+			err := JobDestinationOptionsValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPostgresOptions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobDestinationOptionsValidationError{
+						field:  "PostgresOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobDestinationOptionsValidationError{
+						field:  "PostgresOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPostgresOptions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobDestinationOptionsValidationError{
+					field:  "PostgresOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *JobDestinationOptions_AwsS3Options:
+		if v == nil {
+			err := JobDestinationOptionsValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAwsS3Options()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobDestinationOptionsValidationError{
+						field:  "AwsS3Options",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobDestinationOptionsValidationError{
+						field:  "AwsS3Options",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAwsS3Options()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobDestinationOptionsValidationError{
+					field:  "AwsS3Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *JobDestinationOptions_MysqlOptions:
+		if v == nil {
+			err := JobDestinationOptionsValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMysqlOptions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobDestinationOptionsValidationError{
+						field:  "MysqlOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobDestinationOptionsValidationError{
+						field:  "MysqlOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMysqlOptions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobDestinationOptionsValidationError{
+					field:  "MysqlOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *JobDestinationOptions_CustomOptions:
+		if v == nil {
+			err := JobDestinationOptionsValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetCustomOptions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobDestinationOptionsValidationError{
+						field:  "CustomOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobDestinationOptionsValidationError{
+						field:  "CustomOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCustomOptions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobDestinationOptionsValidationError{
+					field:  "CustomOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *JobDestinationOptions_KafkaOptions:
+		if v == nil {
+			err := JobDestinationOptionsValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetKafkaOptions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobDestinationOptionsValidationError{
+						field:  "KafkaOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobDestinationOptionsValidationError{
+						field:  "KafkaOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetKafkaOptions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobDestinationOptionsValidationError{
+					field:  "KafkaOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
 
 	if len(errors) > 0 {
-		return MysqlTruncateTableConfigMultiError(errors)
+		return JobDestinationOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// MysqlTruncateTableConfigMultiError is an error wrapping multiple validation
-// errors returned by MysqlTruncateTableConfig.ValidateAll() if the designated
+// JobDestinationOptionsMultiError is an error wrapping multiple validation
+// errors returned by JobDestinationOptions.ValidateAll() if the designated
 // constraints aren't met.
-type MysqlTruncateTableConfigMultiError []error
+type JobDestinationOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MysqlTruncateTableConfigMultiError) Error() string {
+func (m JobDestinationOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3453,11 +3822,11 @@ func (m MysqlTruncateTableConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MysqlTruncateTableConfigMultiError) AllErrors() []error { return m }
+func (m JobDestinationOptionsMultiError) AllErrors() []error { return m }
 
-// MysqlTruncateTableConfigValidationError is the validation error returned by
-// MysqlTruncateTableConfig.Validate if the designated constraints aren't met.
-type MysqlTruncateTableConfigValidationError struct {
+// JobDestinationOptionsValidationError is the validation error returned by
+// JobDestinationOptions.Validate if the designated constraints aren't met.
+type JobDestinationOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3465,24 +3834,24 @@ type MysqlTruncateTableConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e MysqlTruncateTableConfigValidationError) Field() string { return e.field }
+func (e JobDestinationOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MysqlTruncateTableConfigValidationError) Reason() string { return e.reason }
+func (e JobDestinationOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MysqlTruncateTableConfigValidationError) Cause() error { return e.cause }
+func (e JobDestinationOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MysqlTruncateTableConfigValidationError) Key() bool { return e.key }
+func (e JobDestinationOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MysqlTruncateTableConfigValidationError) ErrorName() string {
-	return "MysqlTruncateTableConfigValidationError"
+func (e JobDestinationOptionsValidationError) ErrorName() string {
+	return "JobDestinationOptionsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MysqlTruncateTableConfigValidationError) Error() string {
+func (e JobDestinationOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3494,14 +3863,14 @@ func (e MysqlTruncateTableConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMysqlTruncateTableConfig.%s: %s%s",
+		"invalid %sJobDestinationOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MysqlTruncateTableConfigValidationError{}
+var _ error = JobDestinationOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -3509,46 +3878,166 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MysqlTruncateTableConfigValidationError{}
+} = JobDestinationOptionsValidationError{}
 
-// Validate checks the field values on MysqlOnConflictConfig with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MysqlOnConflictConfig) Validate() error {
+// Validate checks the field values on PostgresDestinationConnectionOptions
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *PostgresDestinationConnectionOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MysqlOnConflictConfig with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// MysqlOnConflictConfigMultiError, or nil if none found.
-func (m *MysqlOnConflictConfig) ValidateAll() error {
+// ValidateAll checks the field values on PostgresDestinationConnectionOptions
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PostgresDestinationConnectionOptionsMultiError, or nil if none found.
+func (m *PostgresDestinationConnectionOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MysqlOnConflictConfig) validate(all bool) error {
+func (m *PostgresDestinationConnectionOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DoNothing
+	if all {
+		switch v := interface{}(m.GetTruncateTable()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PostgresDestinationConnectionOptionsValidationError{
+					field:  "TruncateTable",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PostgresDestinationConnectionOptionsValidationError{
+					field:  "TruncateTable",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTruncateTable()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PostgresDestinationConnectionOptionsValidationError{
+				field:  "TruncateTable",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for InitTableSchema
+
+	if all {
+		switch v := interface{}(m.GetOnConflict()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PostgresDestinationConnectionOptionsValidationError{
+					field:  "OnConflict",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PostgresDestinationConnectionOptionsValidationError{
+					field:  "OnConflict",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOnConflict()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PostgresDestinationConnectionOptionsValidationError{
+				field:  "OnConflict",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRefreshMaterializedViews()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PostgresDestinationConnectionOptionsValidationError{
+					field:  "RefreshMaterializedViews",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PostgresDestinationConnectionOptionsValidationError{
+					field:  "RefreshMaterializedViews",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefreshMaterializedViews()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PostgresDestinationConnectionOptionsValidationError{
+				field:  "RefreshMaterializedViews",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DisableTriggers
+
+	if all {
+		switch v := interface{}(m.GetAnalyzeTables()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PostgresDestinationConnectionOptionsValidationError{
+					field:  "AnalyzeTables",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PostgresDestinationConnectionOptionsValidationError{
+					field:  "AnalyzeTables",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnalyzeTables()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PostgresDestinationConnectionOptionsValidationError{
+				field:  "AnalyzeTables",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return MysqlOnConflictConfigMultiError(errors)
+		return PostgresDestinationConnectionOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// MysqlOnConflictConfigMultiError is an error wrapping multiple validation
-// errors returned by MysqlOnConflictConfig.ValidateAll() if the designated
+// PostgresDestinationConnectionOptionsMultiError is an error wrapping multiple
+// validation errors returned by
+// PostgresDestinationConnectionOptions.ValidateAll() if the designated
 // constraints aren't met.
-type MysqlOnConflictConfigMultiError []error
+type PostgresDestinationConnectionOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MysqlOnConflictConfigMultiError) Error() string {
+func (m PostgresDestinationConnectionOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3557,11 +4046,12 @@ func (m MysqlOnConflictConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MysqlOnConflictConfigMultiError) AllErrors() []error { return m }
+func (m PostgresDestinationConnectionOptionsMultiError) AllErrors() []error { return m }
 
-// MysqlOnConflictConfigValidationError is the validation error returned by
-// MysqlOnConflictConfig.Validate if the designated constraints aren't met.
-type MysqlOnConflictConfigValidationError struct {
+// PostgresDestinationConnectionOptionsValidationError is the validation error
+// returned by PostgresDestinationConnectionOptions.Validate if the designated
+// constraints aren't met.
+type PostgresDestinationConnectionOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3569,24 +4059,24 @@ type MysqlOnConflictConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e MysqlOnConflictConfigValidationError) Field() string { return e.field }
+func (e PostgresDestinationConnectionOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MysqlOnConflictConfigValidationError) Reason() string { return e.reason }
+func (e PostgresDestinationConnectionOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MysqlOnConflictConfigValidationError) Cause() error { return e.cause }
+func (e PostgresDestinationConnectionOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MysqlOnConflictConfigValidationError) Key() bool { return e.key }
+func (e PostgresDestinationConnectionOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MysqlOnConflictConfigValidationError) ErrorName() string {
-	return "MysqlOnConflictConfigValidationError"
+func (e PostgresDestinationConnectionOptionsValidationError) ErrorName() string {
+	return "PostgresDestinationConnectionOptionsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MysqlOnConflictConfigValidationError) Error() string {
+func (e PostgresDestinationConnectionOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3598,14 +4088,14 @@ func (e MysqlOnConflictConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMysqlOnConflictConfig.%s: %s%s",
+		"invalid %sPostgresDestinationConnectionOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MysqlOnConflictConfigValidationError{}
+var _ error = PostgresDestinationConnectionOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -3613,46 +4103,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MysqlOnConflictConfigValidationError{}
+} = PostgresDestinationConnectionOptionsValidationError{}
 
-// Validate checks the field values on AwsS3DestinationConnectionOptions with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AwsS3DestinationConnectionOptions) Validate() error {
+// Validate checks the field values on PostgresAnalyzeTablesConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PostgresAnalyzeTablesConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AwsS3DestinationConnectionOptions
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// AwsS3DestinationConnectionOptionsMultiError, or nil if none found.
-func (m *AwsS3DestinationConnectionOptions) ValidateAll() error {
+// ValidateAll checks the field values on PostgresAnalyzeTablesConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PostgresAnalyzeTablesConfigMultiError, or nil if none found.
+func (m *PostgresAnalyzeTablesConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AwsS3DestinationConnectionOptions) validate(all bool) error {
+func (m *PostgresAnalyzeTablesConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Enabled
+
+	// no validation rules for Vacuum
+
 	if len(errors) > 0 {
-		return AwsS3DestinationConnectionOptionsMultiError(errors)
+		return PostgresAnalyzeTablesConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// AwsS3DestinationConnectionOptionsMultiError is an error wrapping multiple
-// validation errors returned by
-// AwsS3DestinationConnectionOptions.ValidateAll() if the designated
-// constraints aren't met.
-type AwsS3DestinationConnectionOptionsMultiError []error
+// PostgresAnalyzeTablesConfigMultiError is an error wrapping multiple
+// validation errors returned by PostgresAnalyzeTablesConfig.ValidateAll() if
+// the designated constraints aren't met.
+type PostgresAnalyzeTablesConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AwsS3DestinationConnectionOptionsMultiError) Error() string {
+func (m PostgresAnalyzeTablesConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3661,12 +4153,12 @@ func (m AwsS3DestinationConnectionOptionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AwsS3DestinationConnectionOptionsMultiError) AllErrors() []error { return m }
+func (m PostgresAnalyzeTablesConfigMultiError) AllErrors() []error { return m }
 
-// AwsS3DestinationConnectionOptionsValidationError is the validation error
-// returned by AwsS3DestinationConnectionOptions.Validate if the designated
-// constraints aren't met.
-type AwsS3DestinationConnectionOptionsValidationError struct {
+// PostgresAnalyzeTablesConfigValidationError is the validation error returned
+// by PostgresAnalyzeTablesConfig.Validate if the designated constraints
+// aren't met.
+type PostgresAnalyzeTablesConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3674,24 +4166,24 @@ type AwsS3DestinationConnectionOptionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AwsS3DestinationConnectionOptionsValidationError) Field() string { return e.field }
+func (e PostgresAnalyzeTablesConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AwsS3DestinationConnectionOptionsValidationError) Reason() string { return e.reason }
+func (e PostgresAnalyzeTablesConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AwsS3DestinationConnectionOptionsValidationError) Cause() error { return e.cause }
+func (e PostgresAnalyzeTablesConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AwsS3DestinationConnectionOptionsValidationError) Key() bool { return e.key }
+func (e PostgresAnalyzeTablesConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AwsS3DestinationConnectionOptionsValidationError) ErrorName() string {
-	return "AwsS3DestinationConnectionOptionsValidationError"
+func (e PostgresAnalyzeTablesConfigValidationError) ErrorName() string {
+	return "PostgresAnalyzeTablesConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AwsS3DestinationConnectionOptionsValidationError) Error() string {
+func (e PostgresAnalyzeTablesConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3703,14 +4195,14 @@ func (e AwsS3DestinationConnectionOptionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAwsS3DestinationConnectionOptions.%s: %s%s",
+		"invalid %sPostgresAnalyzeTablesConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AwsS3DestinationConnectionOptionsValidationError{}
+var _ error = PostgresAnalyzeTablesConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -3718,209 +4210,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AwsS3DestinationConnectionOptionsValidationError{}
+} = PostgresAnalyzeTablesConfigValidationError{}
 
-// Validate checks the field values on CreateJobRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *CreateJobRequest) Validate() error {
+// Validate checks the field values on PostgresRefreshMaterializedViewsConfig
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *PostgresRefreshMaterializedViewsConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateJobRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateJobRequestMultiError, or nil if none found.
-func (m *CreateJobRequest) ValidateAll() error {
+// ValidateAll checks the field values on
+// PostgresRefreshMaterializedViewsConfig with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// PostgresRefreshMaterializedViewsConfigMultiError, or nil if none found.
+func (m *PostgresRefreshMaterializedViewsConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateJobRequest) validate(all bool) error {
+func (m *PostgresRefreshMaterializedViewsConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AccountId
+	// no validation rules for Enabled
 
-	// no validation rules for JobName
+	if len(errors) > 0 {
+		return PostgresRefreshMaterializedViewsConfigMultiError(errors)
+	}
 
-	for idx, item := range m.GetMappings() {
-		_, _ = idx, item
+	return nil
+}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateJobRequestValidationError{
-						field:  fmt.Sprintf("Mappings[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateJobRequestValidationError{
-						field:  fmt.Sprintf("Mappings[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CreateJobRequestValidationError{
-					field:  fmt.Sprintf("Mappings[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if all {
-		switch v := interface{}(m.GetSource()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateJobRequestValidationError{
-					field:  "Source",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateJobRequestValidationError{
-					field:  "Source",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSource()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateJobRequestValidationError{
-				field:  "Source",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	for idx, item := range m.GetDestinations() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateJobRequestValidationError{
-						field:  fmt.Sprintf("Destinations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateJobRequestValidationError{
-						field:  fmt.Sprintf("Destinations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CreateJobRequestValidationError{
-					field:  fmt.Sprintf("Destinations[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for InitiateJobRun
-
-	if all {
-		switch v := interface{}(m.GetWorkflowOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateJobRequestValidationError{
-					field:  "WorkflowOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateJobRequestValidationError{
-					field:  "WorkflowOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetWorkflowOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateJobRequestValidationError{
-				field:  "WorkflowOptions",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetSyncOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateJobRequestValidationError{
-					field:  "SyncOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateJobRequestValidationError{
-					field:  "SyncOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSyncOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateJobRequestValidationError{
-				field:  "SyncOptions",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if m.CronSchedule != nil {
-		// no validation rules for CronSchedule
-	}
-
-	if len(errors) > 0 {
-		return CreateJobRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-// CreateJobRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateJobRequest.ValidateAll() if the designated constraints
-// aren't met.
-type CreateJobRequestMultiError []error
+// PostgresRefreshMaterializedViewsConfigMultiError is an error wrapping
+// multiple validation errors returned by
+// PostgresRefreshMaterializedViewsConfig.ValidateAll() if the designated
+// constraints aren't met.
+type PostgresRefreshMaterializedViewsConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateJobRequestMultiError) Error() string {
+func (m PostgresRefreshMaterializedViewsConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3929,11 +4261,12 @@ func (m CreateJobRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateJobRequestMultiError) AllErrors() []error { return m }
+func (m PostgresRefreshMaterializedViewsConfigMultiError) AllErrors() []error { return m }
 
-// CreateJobRequestValidationError is the validation error returned by
-// CreateJobRequest.Validate if the designated constraints aren't met.
-type CreateJobRequestValidationError struct {
+// PostgresRefreshMaterializedViewsConfigValidationError is the validation
+// error returned by PostgresRefreshMaterializedViewsConfig.Validate if the
+// designated constraints aren't met.
+type PostgresRefreshMaterializedViewsConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3941,22 +4274,24 @@ type CreateJobRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateJobRequestValidationError) Field() string { return e.field }
+func (e PostgresRefreshMaterializedViewsConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateJobRequestValidationError) Reason() string { return e.reason }
+func (e PostgresRefreshMaterializedViewsConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateJobRequestValidationError) Cause() error { return e.cause }
+func (e PostgresRefreshMaterializedViewsConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateJobRequestValidationError) Key() bool { return e.key }
+func (e PostgresRefreshMaterializedViewsConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateJobRequestValidationError) ErrorName() string { return "CreateJobRequestValidationError" }
+func (e PostgresRefreshMaterializedViewsConfigValidationError) ErrorName() string {
+	return "PostgresRefreshMaterializedViewsConfigValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CreateJobRequestValidationError) Error() string {
+func (e PostgresRefreshMaterializedViewsConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3968,14 +4303,14 @@ func (e CreateJobRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateJobRequest.%s: %s%s",
+		"invalid %sPostgresRefreshMaterializedViewsConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateJobRequestValidationError{}
+var _ error = PostgresRefreshMaterializedViewsConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -3983,48 +4318,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateJobRequestValidationError{}
+} = PostgresRefreshMaterializedViewsConfigValidationError{}
 
-// Validate checks the field values on WorkflowOptions with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *WorkflowOptions) Validate() error {
+// Validate checks the field values on PostgresOnConflictConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PostgresOnConflictConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WorkflowOptions with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on PostgresOnConflictConfig with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WorkflowOptionsMultiError, or nil if none found.
-func (m *WorkflowOptions) ValidateAll() error {
+// PostgresOnConflictConfigMultiError, or nil if none found.
+func (m *PostgresOnConflictConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WorkflowOptions) validate(all bool) error {
+func (m *PostgresOnConflictConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.RunTimeout != nil {
-		// no validation rules for RunTimeout
-	}
+	// no validation rules for DoNothing
 
 	if len(errors) > 0 {
-		return WorkflowOptionsMultiError(errors)
+		return PostgresOnConflictConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// WorkflowOptionsMultiError is an error wrapping multiple validation errors
-// returned by WorkflowOptions.ValidateAll() if the designated constraints
-// aren't met.
-type WorkflowOptionsMultiError []error
+// PostgresOnConflictConfigMultiError is an error wrapping multiple validation
+// errors returned by PostgresOnConflictConfig.ValidateAll() if the designated
+// constraints aren't met.
+type PostgresOnConflictConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WorkflowOptionsMultiError) Error() string {
+func (m PostgresOnConflictConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4033,11 +4366,11 @@ func (m WorkflowOptionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WorkflowOptionsMultiError) AllErrors() []error { return m }
+func (m PostgresOnConflictConfigMultiError) AllErrors() []error { return m }
 
-// WorkflowOptionsValidationError is the validation error returned by
-// WorkflowOptions.Validate if the designated constraints aren't met.
-type WorkflowOptionsValidationError struct {
+// PostgresOnConflictConfigValidationError is the validation error returned by
+// PostgresOnConflictConfig.Validate if the designated constraints aren't met.
+type PostgresOnConflictConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4045,22 +4378,24 @@ type WorkflowOptionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e WorkflowOptionsValidationError) Field() string { return e.field }
+func (e PostgresOnConflictConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WorkflowOptionsValidationError) Reason() string { return e.reason }
+func (e PostgresOnConflictConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WorkflowOptionsValidationError) Cause() error { return e.cause }
+func (e PostgresOnConflictConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WorkflowOptionsValidationError) Key() bool { return e.key }
+func (e PostgresOnConflictConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WorkflowOptionsValidationError) ErrorName() string { return "WorkflowOptionsValidationError" }
+func (e PostgresOnConflictConfigValidationError) ErrorName() string {
+	return "PostgresOnConflictConfigValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e WorkflowOptionsValidationError) Error() string {
+func (e PostgresOnConflictConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4072,14 +4407,14 @@ func (e WorkflowOptionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWorkflowOptions.%s: %s%s",
+		"invalid %sPostgresOnConflictConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WorkflowOptionsValidationError{}
+var _ error = PostgresOnConflictConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -4087,81 +4422,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WorkflowOptionsValidationError{}
+} = PostgresOnConflictConfigValidationError{}
 
-// Validate checks the field values on ActivityOptions with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ActivityOptions) Validate() error {
+// Validate checks the field values on PostgresTruncateTableConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PostgresTruncateTableConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ActivityOptions with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on PostgresTruncateTableConfig with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ActivityOptionsMultiError, or nil if none found.
-func (m *ActivityOptions) ValidateAll() error {
+// PostgresTruncateTableConfigMultiError, or nil if none found.
+func (m *PostgresTruncateTableConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ActivityOptions) validate(all bool) error {
+func (m *PostgresTruncateTableConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetRetryPolicy()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ActivityOptionsValidationError{
-					field:  "RetryPolicy",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ActivityOptionsValidationError{
-					field:  "RetryPolicy",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ActivityOptionsValidationError{
-				field:  "RetryPolicy",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if m.ScheduleToCloseTimeout != nil {
-		// no validation rules for ScheduleToCloseTimeout
-	}
+	// no validation rules for TruncateBeforeInsert
 
-	if m.StartToCloseTimeout != nil {
-		// no validation rules for StartToCloseTimeout
-	}
+	// no validation rules for Cascade
 
 	if len(errors) > 0 {
-		return ActivityOptionsMultiError(errors)
+		return PostgresTruncateTableConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// ActivityOptionsMultiError is an error wrapping multiple validation errors
-// returned by ActivityOptions.ValidateAll() if the designated constraints
-// aren't met.
-type ActivityOptionsMultiError []error
+// PostgresTruncateTableConfigMultiError is an error wrapping multiple
+// validation errors returned by PostgresTruncateTableConfig.ValidateAll() if
+// the designated constraints aren't met.
+type PostgresTruncateTableConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ActivityOptionsMultiError) Error() string {
+func (m PostgresTruncateTableConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4170,11 +4472,12 @@ func (m ActivityOptionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ActivityOptionsMultiError) AllErrors() []error { return m }
+func (m PostgresTruncateTableConfigMultiError) AllErrors() []error { return m }
 
-// ActivityOptionsValidationError is the validation error returned by
-// ActivityOptions.Validate if the designated constraints aren't met.
-type ActivityOptionsValidationError struct {
+// PostgresTruncateTableConfigValidationError is the validation error returned
+// by PostgresTruncateTableConfig.Validate if the designated constraints
+// aren't met.
+type PostgresTruncateTableConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4182,22 +4485,24 @@ type ActivityOptionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ActivityOptionsValidationError) Field() string { return e.field }
+func (e PostgresTruncateTableConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ActivityOptionsValidationError) Reason() string { return e.reason }
+func (e PostgresTruncateTableConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ActivityOptionsValidationError) Cause() error { return e.cause }
+func (e PostgresTruncateTableConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ActivityOptionsValidationError) Key() bool { return e.key }
+func (e PostgresTruncateTableConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ActivityOptionsValidationError) ErrorName() string { return "ActivityOptionsValidationError" }
+func (e PostgresTruncateTableConfigValidationError) ErrorName() string {
+	return "PostgresTruncateTableConfigValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ActivityOptionsValidationError) Error() string {
+func (e PostgresTruncateTableConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4209,14 +4514,14 @@ func (e ActivityOptionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sActivityOptions.%s: %s%s",
+		"invalid %sPostgresTruncateTableConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ActivityOptionsValidationError{}
+var _ error = PostgresTruncateTableConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -4224,47 +4529,168 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ActivityOptionsValidationError{}
+} = PostgresTruncateTableConfigValidationError{}
 
-// Validate checks the field values on RetryPolicy with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *RetryPolicy) Validate() error {
+// Validate checks the field values on MysqlDestinationConnectionOptions with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *MysqlDestinationConnectionOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RetryPolicy with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in RetryPolicyMultiError, or
-// nil if none found.
-func (m *RetryPolicy) ValidateAll() error {
+// ValidateAll checks the field values on MysqlDestinationConnectionOptions
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// MysqlDestinationConnectionOptionsMultiError, or nil if none found.
+func (m *MysqlDestinationConnectionOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RetryPolicy) validate(all bool) error {
+func (m *MysqlDestinationConnectionOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.MaximumAttempts != nil {
-		// no validation rules for MaximumAttempts
+	if all {
+		switch v := interface{}(m.GetTruncateTable()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MysqlDestinationConnectionOptionsValidationError{
+					field:  "TruncateTable",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MysqlDestinationConnectionOptionsValidationError{
+					field:  "TruncateTable",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTruncateTable()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MysqlDestinationConnectionOptionsValidationError{
+				field:  "TruncateTable",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for InitTableSchema
+
+	if all {
+		switch v := interface{}(m.GetOnConflict()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MysqlDestinationConnectionOptionsValidationError{
+					field:  "OnConflict",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MysqlDestinationConnectionOptionsValidationError{
+					field:  "OnConflict",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOnConflict()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MysqlDestinationConnectionOptionsValidationError{
+				field:  "OnConflict",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAnalyzeTables()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MysqlDestinationConnectionOptionsValidationError{
+					field:  "AnalyzeTables",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MysqlDestinationConnectionOptionsValidationError{
+					field:  "AnalyzeTables",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnalyzeTables()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MysqlDestinationConnectionOptionsValidationError{
+				field:  "AnalyzeTables",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.CharsetOverride != nil {
+
+		if all {
+			switch v := interface{}(m.GetCharsetOverride()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MysqlDestinationConnectionOptionsValidationError{
+						field:  "CharsetOverride",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MysqlDestinationConnectionOptionsValidationError{
+						field:  "CharsetOverride",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCharsetOverride()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MysqlDestinationConnectionOptionsValidationError{
+					field:  "CharsetOverride",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
 	if len(errors) > 0 {
-		return RetryPolicyMultiError(errors)
+		return MysqlDestinationConnectionOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// RetryPolicyMultiError is an error wrapping multiple validation errors
-// returned by RetryPolicy.ValidateAll() if the designated constraints aren't met.
-type RetryPolicyMultiError []error
+// MysqlDestinationConnectionOptionsMultiError is an error wrapping multiple
+// validation errors returned by
+// MysqlDestinationConnectionOptions.ValidateAll() if the designated
+// constraints aren't met.
+type MysqlDestinationConnectionOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RetryPolicyMultiError) Error() string {
+func (m MysqlDestinationConnectionOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4273,11 +4699,12 @@ func (m RetryPolicyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RetryPolicyMultiError) AllErrors() []error { return m }
+func (m MysqlDestinationConnectionOptionsMultiError) AllErrors() []error { return m }
 
-// RetryPolicyValidationError is the validation error returned by
-// RetryPolicy.Validate if the designated constraints aren't met.
-type RetryPolicyValidationError struct {
+// MysqlDestinationConnectionOptionsValidationError is the validation error
+// returned by MysqlDestinationConnectionOptions.Validate if the designated
+// constraints aren't met.
+type MysqlDestinationConnectionOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4285,22 +4712,24 @@ type RetryPolicyValidationError struct {
 }
 
 // Field function returns field value.
-func (e RetryPolicyValidationError) Field() string { return e.field }
+func (e MysqlDestinationConnectionOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RetryPolicyValidationError) Reason() string { return e.reason }
+func (e MysqlDestinationConnectionOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RetryPolicyValidationError) Cause() error { return e.cause }
+func (e MysqlDestinationConnectionOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RetryPolicyValidationError) Key() bool { return e.key }
+func (e MysqlDestinationConnectionOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RetryPolicyValidationError) ErrorName() string { return "RetryPolicyValidationError" }
+func (e MysqlDestinationConnectionOptionsValidationError) ErrorName() string {
+	return "MysqlDestinationConnectionOptionsValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RetryPolicyValidationError) Error() string {
+func (e MysqlDestinationConnectionOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4312,14 +4741,14 @@ func (e RetryPolicyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRetryPolicy.%s: %s%s",
+		"invalid %sMysqlDestinationConnectionOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RetryPolicyValidationError{}
+var _ error = MysqlDestinationConnectionOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -4327,73 +4756,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RetryPolicyValidationError{}
+} = MysqlDestinationConnectionOptionsValidationError{}
 
-// Validate checks the field values on CreateJobResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *CreateJobResponse) Validate() error {
+// Validate checks the field values on MysqlAnalyzeTablesConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MysqlAnalyzeTablesConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateJobResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on MysqlAnalyzeTablesConfig with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateJobResponseMultiError, or nil if none found.
-func (m *CreateJobResponse) ValidateAll() error {
+// MysqlAnalyzeTablesConfigMultiError, or nil if none found.
+func (m *MysqlAnalyzeTablesConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateJobResponse) validate(all bool) error {
+func (m *MysqlAnalyzeTablesConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetJob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateJobResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateJobResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateJobResponseValidationError{
-				field:  "Job",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Enabled
 
 	if len(errors) > 0 {
-		return CreateJobResponseMultiError(errors)
+		return MysqlAnalyzeTablesConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateJobResponseMultiError is an error wrapping multiple validation errors
-// returned by CreateJobResponse.ValidateAll() if the designated constraints
-// aren't met.
-type CreateJobResponseMultiError []error
+// MysqlAnalyzeTablesConfigMultiError is an error wrapping multiple validation
+// errors returned by MysqlAnalyzeTablesConfig.ValidateAll() if the designated
+// constraints aren't met.
+type MysqlAnalyzeTablesConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateJobResponseMultiError) Error() string {
+func (m MysqlAnalyzeTablesConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4402,11 +4804,11 @@ func (m CreateJobResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateJobResponseMultiError) AllErrors() []error { return m }
+func (m MysqlAnalyzeTablesConfigMultiError) AllErrors() []error { return m }
 
-// CreateJobResponseValidationError is the validation error returned by
-// CreateJobResponse.Validate if the designated constraints aren't met.
-type CreateJobResponseValidationError struct {
+// MysqlAnalyzeTablesConfigValidationError is the validation error returned by
+// MysqlAnalyzeTablesConfig.Validate if the designated constraints aren't met.
+type MysqlAnalyzeTablesConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4414,24 +4816,24 @@ type CreateJobResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateJobResponseValidationError) Field() string { return e.field }
+func (e MysqlAnalyzeTablesConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateJobResponseValidationError) Reason() string { return e.reason }
+func (e MysqlAnalyzeTablesConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateJobResponseValidationError) Cause() error { return e.cause }
+func (e MysqlAnalyzeTablesConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateJobResponseValidationError) Key() bool { return e.key }
+func (e MysqlAnalyzeTablesConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateJobResponseValidationError) ErrorName() string {
-	return "CreateJobResponseValidationError"
+func (e MysqlAnalyzeTablesConfigValidationError) ErrorName() string {
+	return "MysqlAnalyzeTablesConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateJobResponseValidationError) Error() string {
+func (e MysqlAnalyzeTablesConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4443,14 +4845,14 @@ func (e CreateJobResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateJobResponse.%s: %s%s",
+		"invalid %sMysqlAnalyzeTablesConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateJobResponseValidationError{}
+var _ error = MysqlAnalyzeTablesConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -4458,75 +4860,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateJobResponseValidationError{}
+} = MysqlAnalyzeTablesConfigValidationError{}
 
-// Validate checks the field values on JobMappingTransformer with the rules
+// Validate checks the field values on MysqlTruncateTableConfig with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *JobMappingTransformer) Validate() error {
+func (m *MysqlTruncateTableConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobMappingTransformer with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on MysqlTruncateTableConfig with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JobMappingTransformerMultiError, or nil if none found.
-func (m *JobMappingTransformer) ValidateAll() error {
+// MysqlTruncateTableConfigMultiError, or nil if none found.
+func (m *MysqlTruncateTableConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobMappingTransformer) validate(all bool) error {
+func (m *MysqlTruncateTableConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Source
-
-	if all {
-		switch v := interface{}(m.GetConfig()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobMappingTransformerValidationError{
-					field:  "Config",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobMappingTransformerValidationError{
-					field:  "Config",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobMappingTransformerValidationError{
-				field:  "Config",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for TruncateBeforeInsert
 
 	if len(errors) > 0 {
-		return JobMappingTransformerMultiError(errors)
+		return MysqlTruncateTableConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobMappingTransformerMultiError is an error wrapping multiple validation
-// errors returned by JobMappingTransformer.ValidateAll() if the designated
+// MysqlTruncateTableConfigMultiError is an error wrapping multiple validation
+// errors returned by MysqlTruncateTableConfig.ValidateAll() if the designated
 // constraints aren't met.
-type JobMappingTransformerMultiError []error
+type MysqlTruncateTableConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobMappingTransformerMultiError) Error() string {
+func (m MysqlTruncateTableConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4535,11 +4908,11 @@ func (m JobMappingTransformerMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobMappingTransformerMultiError) AllErrors() []error { return m }
+func (m MysqlTruncateTableConfigMultiError) AllErrors() []error { return m }
 
-// JobMappingTransformerValidationError is the validation error returned by
-// JobMappingTransformer.Validate if the designated constraints aren't met.
-type JobMappingTransformerValidationError struct {
+// MysqlTruncateTableConfigValidationError is the validation error returned by
+// MysqlTruncateTableConfig.Validate if the designated constraints aren't met.
+type MysqlTruncateTableConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4547,24 +4920,24 @@ type JobMappingTransformerValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobMappingTransformerValidationError) Field() string { return e.field }
+func (e MysqlTruncateTableConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobMappingTransformerValidationError) Reason() string { return e.reason }
+func (e MysqlTruncateTableConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobMappingTransformerValidationError) Cause() error { return e.cause }
+func (e MysqlTruncateTableConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobMappingTransformerValidationError) Key() bool { return e.key }
+func (e MysqlTruncateTableConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobMappingTransformerValidationError) ErrorName() string {
-	return "JobMappingTransformerValidationError"
+func (e MysqlTruncateTableConfigValidationError) ErrorName() string {
+	return "MysqlTruncateTableConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e JobMappingTransformerValidationError) Error() string {
+func (e MysqlTruncateTableConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4576,14 +4949,14 @@ func (e JobMappingTransformerValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobMappingTransformer.%s: %s%s",
+		"invalid %sMysqlTruncateTableConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobMappingTransformerValidationError{}
+var _ error = MysqlTruncateTableConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -4591,78 +4964,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobMappingTransformerValidationError{}
+} = MysqlTruncateTableConfigValidationError{}
 
-// Validate checks the field values on JobMapping with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *JobMapping) Validate() error {
+// Validate checks the field values on MysqlOnConflictConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MysqlOnConflictConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobMapping with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in JobMappingMultiError, or
-// nil if none found.
-func (m *JobMapping) ValidateAll() error {
+// ValidateAll checks the field values on MysqlOnConflictConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MysqlOnConflictConfigMultiError, or nil if none found.
+func (m *MysqlOnConflictConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobMapping) validate(all bool) error {
+func (m *MysqlOnConflictConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Schema
-
-	// no validation rules for Table
-
-	// no validation rules for Column
-
-	if all {
-		switch v := interface{}(m.GetTransformer()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobMappingValidationError{
-					field:  "Transformer",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobMappingValidationError{
-					field:  "Transformer",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTransformer()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobMappingValidationError{
-				field:  "Transformer",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for DoNothing
 
 	if len(errors) > 0 {
-		return JobMappingMultiError(errors)
+		return MysqlOnConflictConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobMappingMultiError is an error wrapping multiple validation errors
-// returned by JobMapping.ValidateAll() if the designated constraints aren't met.
-type JobMappingMultiError []error
+// MysqlOnConflictConfigMultiError is an error wrapping multiple validation
+// errors returned by MysqlOnConflictConfig.ValidateAll() if the designated
+// constraints aren't met.
+type MysqlOnConflictConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobMappingMultiError) Error() string {
+func (m MysqlOnConflictConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4671,11 +5012,11 @@ func (m JobMappingMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobMappingMultiError) AllErrors() []error { return m }
+func (m MysqlOnConflictConfigMultiError) AllErrors() []error { return m }
 
-// JobMappingValidationError is the validation error returned by
-// JobMapping.Validate if the designated constraints aren't met.
-type JobMappingValidationError struct {
+// MysqlOnConflictConfigValidationError is the validation error returned by
+// MysqlOnConflictConfig.Validate if the designated constraints aren't met.
+type MysqlOnConflictConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4683,22 +5024,24 @@ type JobMappingValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobMappingValidationError) Field() string { return e.field }
+func (e MysqlOnConflictConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobMappingValidationError) Reason() string { return e.reason }
+func (e MysqlOnConflictConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobMappingValidationError) Cause() error { return e.cause }
+func (e MysqlOnConflictConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobMappingValidationError) Key() bool { return e.key }
+func (e MysqlOnConflictConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobMappingValidationError) ErrorName() string { return "JobMappingValidationError" }
+func (e MysqlOnConflictConfigValidationError) ErrorName() string {
+	return "MysqlOnConflictConfigValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e JobMappingValidationError) Error() string {
+func (e MysqlOnConflictConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4710,14 +5053,14 @@ func (e JobMappingValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobMapping.%s: %s%s",
+		"invalid %sMysqlOnConflictConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobMappingValidationError{}
+var _ error = MysqlOnConflictConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -4725,46 +5068,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobMappingValidationError{}
+} = MysqlOnConflictConfigValidationError{}
 
-// Validate checks the field values on GetJobRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetJobRequest) Validate() error {
+// Validate checks the field values on MysqlCharsetOverride with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MysqlCharsetOverride) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetJobRequestMultiError, or
-// nil if none found.
-func (m *GetJobRequest) ValidateAll() error {
+// ValidateAll checks the field values on MysqlCharsetOverride with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MysqlCharsetOverrideMultiError, or nil if none found.
+func (m *MysqlCharsetOverride) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRequest) validate(all bool) error {
+func (m *MysqlCharsetOverride) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Charset
+
+	if m.Collation != nil {
+		// no validation rules for Collation
+	}
 
 	if len(errors) > 0 {
-		return GetJobRequestMultiError(errors)
+		return MysqlCharsetOverrideMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRequestMultiError is an error wrapping multiple validation errors
-// returned by GetJobRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetJobRequestMultiError []error
+// MysqlCharsetOverrideMultiError is an error wrapping multiple validation
+// errors returned by MysqlCharsetOverride.ValidateAll() if the designated
+// constraints aren't met.
+type MysqlCharsetOverrideMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRequestMultiError) Error() string {
+func (m MysqlCharsetOverrideMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4773,11 +5120,11 @@ func (m GetJobRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRequestMultiError) AllErrors() []error { return m }
+func (m MysqlCharsetOverrideMultiError) AllErrors() []error { return m }
 
-// GetJobRequestValidationError is the validation error returned by
-// GetJobRequest.Validate if the designated constraints aren't met.
-type GetJobRequestValidationError struct {
+// MysqlCharsetOverrideValidationError is the validation error returned by
+// MysqlCharsetOverride.Validate if the designated constraints aren't met.
+type MysqlCharsetOverrideValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4785,22 +5132,24 @@ type GetJobRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRequestValidationError) Field() string { return e.field }
+func (e MysqlCharsetOverrideValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRequestValidationError) Reason() string { return e.reason }
+func (e MysqlCharsetOverrideValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRequestValidationError) Cause() error { return e.cause }
+func (e MysqlCharsetOverrideValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRequestValidationError) Key() bool { return e.key }
+func (e MysqlCharsetOverrideValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRequestValidationError) ErrorName() string { return "GetJobRequestValidationError" }
+func (e MysqlCharsetOverrideValidationError) ErrorName() string {
+	return "MysqlCharsetOverrideValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetJobRequestValidationError) Error() string {
+func (e MysqlCharsetOverrideValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4812,14 +5161,14 @@ func (e GetJobRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRequest.%s: %s%s",
+		"invalid %sMysqlCharsetOverride.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRequestValidationError{}
+var _ error = MysqlCharsetOverrideValidationError{}
 
 var _ interface {
 	Field() string
@@ -4827,73 +5176,64 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRequestValidationError{}
+} = MysqlCharsetOverrideValidationError{}
 
-// Validate checks the field values on GetJobResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetJobResponse) Validate() error {
+// Validate checks the field values on AwsS3DestinationConnectionOptions with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AwsS3DestinationConnectionOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetJobResponseMultiError,
-// or nil if none found.
-func (m *GetJobResponse) ValidateAll() error {
+// ValidateAll checks the field values on AwsS3DestinationConnectionOptions
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AwsS3DestinationConnectionOptionsMultiError, or nil if none found.
+func (m *AwsS3DestinationConnectionOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobResponse) validate(all bool) error {
+func (m *AwsS3DestinationConnectionOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetJob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetJobResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetJobResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetJobResponseValidationError{
-				field:  "Job",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	// no validation rules for DisableChecksums
+
+	if m.PartSize != nil {
+		// no validation rules for PartSize
+	}
+
+	if m.UploadConcurrency != nil {
+		// no validation rules for UploadConcurrency
+	}
+
+	if m.MaxRetryAttempts != nil {
+		// no validation rules for MaxRetryAttempts
+	}
+
+	if m.MaxRetryBackoff != nil {
+		// no validation rules for MaxRetryBackoff
 	}
 
 	if len(errors) > 0 {
-		return GetJobResponseMultiError(errors)
+		return AwsS3DestinationConnectionOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobResponseMultiError is an error wrapping multiple validation errors
-// returned by GetJobResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetJobResponseMultiError []error
+// AwsS3DestinationConnectionOptionsMultiError is an error wrapping multiple
+// validation errors returned by
+// AwsS3DestinationConnectionOptions.ValidateAll() if the designated
+// constraints aren't met.
+type AwsS3DestinationConnectionOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobResponseMultiError) Error() string {
+func (m AwsS3DestinationConnectionOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4902,11 +5242,12 @@ func (m GetJobResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobResponseMultiError) AllErrors() []error { return m }
+func (m AwsS3DestinationConnectionOptionsMultiError) AllErrors() []error { return m }
 
-// GetJobResponseValidationError is the validation error returned by
-// GetJobResponse.Validate if the designated constraints aren't met.
-type GetJobResponseValidationError struct {
+// AwsS3DestinationConnectionOptionsValidationError is the validation error
+// returned by AwsS3DestinationConnectionOptions.Validate if the designated
+// constraints aren't met.
+type AwsS3DestinationConnectionOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4914,22 +5255,24 @@ type GetJobResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobResponseValidationError) Field() string { return e.field }
+func (e AwsS3DestinationConnectionOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobResponseValidationError) Reason() string { return e.reason }
+func (e AwsS3DestinationConnectionOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobResponseValidationError) Cause() error { return e.cause }
+func (e AwsS3DestinationConnectionOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobResponseValidationError) Key() bool { return e.key }
+func (e AwsS3DestinationConnectionOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobResponseValidationError) ErrorName() string { return "GetJobResponseValidationError" }
+func (e AwsS3DestinationConnectionOptionsValidationError) ErrorName() string {
+	return "AwsS3DestinationConnectionOptionsValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetJobResponseValidationError) Error() string {
+func (e AwsS3DestinationConnectionOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4941,14 +5284,14 @@ func (e GetJobResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobResponse.%s: %s%s",
+		"invalid %sAwsS3DestinationConnectionOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobResponseValidationError{}
+var _ error = AwsS3DestinationConnectionOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -4956,50 +5299,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobResponseValidationError{}
+} = AwsS3DestinationConnectionOptionsValidationError{}
 
-// Validate checks the field values on UpdateJobScheduleRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateJobScheduleRequest) Validate() error {
+// Validate checks the field values on CustomDestinationConnectionOptions with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CustomDestinationConnectionOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateJobScheduleRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateJobScheduleRequestMultiError, or nil if none found.
-func (m *UpdateJobScheduleRequest) ValidateAll() error {
+// ValidateAll checks the field values on CustomDestinationConnectionOptions
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CustomDestinationConnectionOptionsMultiError, or nil if none found.
+func (m *CustomDestinationConnectionOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateJobScheduleRequest) validate(all bool) error {
+func (m *CustomDestinationConnectionOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	if m.CronSchedule != nil {
-		// no validation rules for CronSchedule
-	}
-
 	if len(errors) > 0 {
-		return UpdateJobScheduleRequestMultiError(errors)
+		return CustomDestinationConnectionOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateJobScheduleRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateJobScheduleRequest.ValidateAll() if the designated
+// CustomDestinationConnectionOptionsMultiError is an error wrapping multiple
+// validation errors returned by
+// CustomDestinationConnectionOptions.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateJobScheduleRequestMultiError []error
+type CustomDestinationConnectionOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateJobScheduleRequestMultiError) Error() string {
+func (m CustomDestinationConnectionOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5008,11 +5347,12 @@ func (m UpdateJobScheduleRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateJobScheduleRequestMultiError) AllErrors() []error { return m }
+func (m CustomDestinationConnectionOptionsMultiError) AllErrors() []error { return m }
 
-// UpdateJobScheduleRequestValidationError is the validation error returned by
-// UpdateJobScheduleRequest.Validate if the designated constraints aren't met.
-type UpdateJobScheduleRequestValidationError struct {
+// CustomDestinationConnectionOptionsValidationError is the validation error
+// returned by CustomDestinationConnectionOptions.Validate if the designated
+// constraints aren't met.
+type CustomDestinationConnectionOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5020,24 +5360,24 @@ type UpdateJobScheduleRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateJobScheduleRequestValidationError) Field() string { return e.field }
+func (e CustomDestinationConnectionOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateJobScheduleRequestValidationError) Reason() string { return e.reason }
+func (e CustomDestinationConnectionOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateJobScheduleRequestValidationError) Cause() error { return e.cause }
+func (e CustomDestinationConnectionOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateJobScheduleRequestValidationError) Key() bool { return e.key }
+func (e CustomDestinationConnectionOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateJobScheduleRequestValidationError) ErrorName() string {
-	return "UpdateJobScheduleRequestValidationError"
+func (e CustomDestinationConnectionOptionsValidationError) ErrorName() string {
+	return "CustomDestinationConnectionOptionsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateJobScheduleRequestValidationError) Error() string {
+func (e CustomDestinationConnectionOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5049,14 +5389,14 @@ func (e UpdateJobScheduleRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateJobScheduleRequest.%s: %s%s",
+		"invalid %sCustomDestinationConnectionOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateJobScheduleRequestValidationError{}
+var _ error = CustomDestinationConnectionOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -5064,73 +5404,279 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateJobScheduleRequestValidationError{}
+} = CustomDestinationConnectionOptionsValidationError{}
 
-// Validate checks the field values on UpdateJobScheduleResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateJobScheduleResponse) Validate() error {
+// Validate checks the field values on CreateJobRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateJobRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateJobScheduleResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateJobScheduleResponseMultiError, or nil if none found.
-func (m *UpdateJobScheduleResponse) ValidateAll() error {
+// CreateJobRequestMultiError, or nil if none found.
+func (m *CreateJobRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateJobScheduleResponse) validate(all bool) error {
+func (m *CreateJobRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for AccountId
+
+	// no validation rules for JobName
+
+	for idx, item := range m.GetMappings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateJobRequestValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateJobRequestValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateJobRequestValidationError{
+					field:  fmt.Sprintf("Mappings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if all {
-		switch v := interface{}(m.GetJob()).(type) {
+		switch v := interface{}(m.GetSource()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateJobScheduleResponseValidationError{
-					field:  "Job",
+				errors = append(errors, CreateJobRequestValidationError{
+					field:  "Source",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateJobScheduleResponseValidationError{
-					field:  "Job",
+				errors = append(errors, CreateJobRequestValidationError{
+					field:  "Source",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSource()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateJobScheduleResponseValidationError{
-				field:  "Job",
+			return CreateJobRequestValidationError{
+				field:  "Source",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if len(errors) > 0 {
-		return UpdateJobScheduleResponseMultiError(errors)
-	}
+	for idx, item := range m.GetDestinations() {
+		_, _ = idx, item
 
-	return nil
-}
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateJobRequestValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateJobRequestValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateJobRequestValidationError{
+					field:  fmt.Sprintf("Destinations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// UpdateJobScheduleResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateJobScheduleResponse.ValidateAll() if the
-// designated constraints aren't met.
-type UpdateJobScheduleResponseMultiError []error
+	}
+
+	// no validation rules for InitiateJobRun
+
+	if all {
+		switch v := interface{}(m.GetWorkflowOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateJobRequestValidationError{
+					field:  "WorkflowOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateJobRequestValidationError{
+					field:  "WorkflowOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWorkflowOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateJobRequestValidationError{
+				field:  "WorkflowOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSyncOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateJobRequestValidationError{
+					field:  "SyncOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateJobRequestValidationError{
+					field:  "SyncOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSyncOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateJobRequestValidationError{
+				field:  "SyncOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Labels
+
+	for idx, item := range m.GetTableProcessors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateJobRequestValidationError{
+						field:  fmt.Sprintf("TableProcessors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateJobRequestValidationError{
+						field:  fmt.Sprintf("TableProcessors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateJobRequestValidationError{
+					field:  fmt.Sprintf("TableProcessors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetParameters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateJobRequestValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateJobRequestValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateJobRequestValidationError{
+					field:  fmt.Sprintf("Parameters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CronSchedule != nil {
+		// no validation rules for CronSchedule
+	}
+
+	if len(errors) > 0 {
+		return CreateJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateJobRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateJobRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateJobRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateJobScheduleResponseMultiError) Error() string {
+func (m CreateJobRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5139,11 +5685,11 @@ func (m UpdateJobScheduleResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateJobScheduleResponseMultiError) AllErrors() []error { return m }
+func (m CreateJobRequestMultiError) AllErrors() []error { return m }
 
-// UpdateJobScheduleResponseValidationError is the validation error returned by
-// UpdateJobScheduleResponse.Validate if the designated constraints aren't met.
-type UpdateJobScheduleResponseValidationError struct {
+// CreateJobRequestValidationError is the validation error returned by
+// CreateJobRequest.Validate if the designated constraints aren't met.
+type CreateJobRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5151,24 +5697,22 @@ type UpdateJobScheduleResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateJobScheduleResponseValidationError) Field() string { return e.field }
+func (e CreateJobRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateJobScheduleResponseValidationError) Reason() string { return e.reason }
+func (e CreateJobRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateJobScheduleResponseValidationError) Cause() error { return e.cause }
+func (e CreateJobRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateJobScheduleResponseValidationError) Key() bool { return e.key }
+func (e CreateJobRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateJobScheduleResponseValidationError) ErrorName() string {
-	return "UpdateJobScheduleResponseValidationError"
-}
+func (e CreateJobRequestValidationError) ErrorName() string { return "CreateJobRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UpdateJobScheduleResponseValidationError) Error() string {
+func (e CreateJobRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5180,14 +5724,14 @@ func (e UpdateJobScheduleResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateJobScheduleResponse.%s: %s%s",
+		"invalid %sCreateJobRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateJobScheduleResponseValidationError{}
+var _ error = CreateJobRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5195,52 +5739,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateJobScheduleResponseValidationError{}
+} = CreateJobRequestValidationError{}
 
-// Validate checks the field values on PauseJobRequest with the rules defined
+// Validate checks the field values on WorkflowOptions with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *PauseJobRequest) Validate() error {
+func (m *WorkflowOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PauseJobRequest with the rules
+// ValidateAll checks the field values on WorkflowOptions with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PauseJobRequestMultiError, or nil if none found.
-func (m *PauseJobRequest) ValidateAll() error {
+// WorkflowOptionsMultiError, or nil if none found.
+func (m *WorkflowOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PauseJobRequest) validate(all bool) error {
+func (m *WorkflowOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Pause
-
-	if m.Note != nil {
-		// no validation rules for Note
+	if m.RunTimeout != nil {
+		// no validation rules for RunTimeout
 	}
 
 	if len(errors) > 0 {
-		return PauseJobRequestMultiError(errors)
+		return WorkflowOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// PauseJobRequestMultiError is an error wrapping multiple validation errors
-// returned by PauseJobRequest.ValidateAll() if the designated constraints
+// WorkflowOptionsMultiError is an error wrapping multiple validation errors
+// returned by WorkflowOptions.ValidateAll() if the designated constraints
 // aren't met.
-type PauseJobRequestMultiError []error
+type WorkflowOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PauseJobRequestMultiError) Error() string {
+func (m WorkflowOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5249,11 +5789,11 @@ func (m PauseJobRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PauseJobRequestMultiError) AllErrors() []error { return m }
+func (m WorkflowOptionsMultiError) AllErrors() []error { return m }
 
-// PauseJobRequestValidationError is the validation error returned by
-// PauseJobRequest.Validate if the designated constraints aren't met.
-type PauseJobRequestValidationError struct {
+// WorkflowOptionsValidationError is the validation error returned by
+// WorkflowOptions.Validate if the designated constraints aren't met.
+type WorkflowOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5261,22 +5801,22 @@ type PauseJobRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PauseJobRequestValidationError) Field() string { return e.field }
+func (e WorkflowOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PauseJobRequestValidationError) Reason() string { return e.reason }
+func (e WorkflowOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PauseJobRequestValidationError) Cause() error { return e.cause }
+func (e WorkflowOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PauseJobRequestValidationError) Key() bool { return e.key }
+func (e WorkflowOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PauseJobRequestValidationError) ErrorName() string { return "PauseJobRequestValidationError" }
+func (e WorkflowOptionsValidationError) ErrorName() string { return "WorkflowOptionsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PauseJobRequestValidationError) Error() string {
+func (e WorkflowOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5288,14 +5828,14 @@ func (e PauseJobRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPauseJobRequest.%s: %s%s",
+		"invalid %sWorkflowOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PauseJobRequestValidationError{}
+var _ error = WorkflowOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -5303,24 +5843,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PauseJobRequestValidationError{}
+} = WorkflowOptionsValidationError{}
 
-// Validate checks the field values on PauseJobResponse with the rules defined
+// Validate checks the field values on ActivityOptions with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *PauseJobResponse) Validate() error {
+func (m *ActivityOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PauseJobResponse with the rules
+// ValidateAll checks the field values on ActivityOptions with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PauseJobResponseMultiError, or nil if none found.
-func (m *PauseJobResponse) ValidateAll() error {
+// ActivityOptionsMultiError, or nil if none found.
+func (m *ActivityOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PauseJobResponse) validate(all bool) error {
+func (m *ActivityOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -5328,48 +5868,56 @@ func (m *PauseJobResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetJob()).(type) {
+		switch v := interface{}(m.GetRetryPolicy()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PauseJobResponseValidationError{
-					field:  "Job",
+				errors = append(errors, ActivityOptionsValidationError{
+					field:  "RetryPolicy",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PauseJobResponseValidationError{
-					field:  "Job",
+				errors = append(errors, ActivityOptionsValidationError{
+					field:  "RetryPolicy",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PauseJobResponseValidationError{
-				field:  "Job",
+			return ActivityOptionsValidationError{
+				field:  "RetryPolicy",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	if m.ScheduleToCloseTimeout != nil {
+		// no validation rules for ScheduleToCloseTimeout
+	}
+
+	if m.StartToCloseTimeout != nil {
+		// no validation rules for StartToCloseTimeout
+	}
+
 	if len(errors) > 0 {
-		return PauseJobResponseMultiError(errors)
+		return ActivityOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// PauseJobResponseMultiError is an error wrapping multiple validation errors
-// returned by PauseJobResponse.ValidateAll() if the designated constraints
+// ActivityOptionsMultiError is an error wrapping multiple validation errors
+// returned by ActivityOptions.ValidateAll() if the designated constraints
 // aren't met.
-type PauseJobResponseMultiError []error
+type ActivityOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PauseJobResponseMultiError) Error() string {
+func (m ActivityOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5378,11 +5926,11 @@ func (m PauseJobResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PauseJobResponseMultiError) AllErrors() []error { return m }
+func (m ActivityOptionsMultiError) AllErrors() []error { return m }
 
-// PauseJobResponseValidationError is the validation error returned by
-// PauseJobResponse.Validate if the designated constraints aren't met.
-type PauseJobResponseValidationError struct {
+// ActivityOptionsValidationError is the validation error returned by
+// ActivityOptions.Validate if the designated constraints aren't met.
+type ActivityOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5390,22 +5938,22 @@ type PauseJobResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PauseJobResponseValidationError) Field() string { return e.field }
+func (e ActivityOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PauseJobResponseValidationError) Reason() string { return e.reason }
+func (e ActivityOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PauseJobResponseValidationError) Cause() error { return e.cause }
+func (e ActivityOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PauseJobResponseValidationError) Key() bool { return e.key }
+func (e ActivityOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PauseJobResponseValidationError) ErrorName() string { return "PauseJobResponseValidationError" }
+func (e ActivityOptionsValidationError) ErrorName() string { return "ActivityOptionsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PauseJobResponseValidationError) Error() string {
+func (e ActivityOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5417,14 +5965,14 @@ func (e PauseJobResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPauseJobResponse.%s: %s%s",
+		"invalid %sActivityOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PauseJobResponseValidationError{}
+var _ error = ActivityOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -5432,111 +5980,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PauseJobResponseValidationError{}
+} = ActivityOptionsValidationError{}
 
-// Validate checks the field values on UpdateJobSourceConnectionRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *UpdateJobSourceConnectionRequest) Validate() error {
+// Validate checks the field values on RetryPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RetryPolicy) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateJobSourceConnectionRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// UpdateJobSourceConnectionRequestMultiError, or nil if none found.
-func (m *UpdateJobSourceConnectionRequest) ValidateAll() error {
+// ValidateAll checks the field values on RetryPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RetryPolicyMultiError, or
+// nil if none found.
+func (m *RetryPolicy) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateJobSourceConnectionRequest) validate(all bool) error {
+func (m *RetryPolicy) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	if all {
-		switch v := interface{}(m.GetSource()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
-					field:  "Source",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
-					field:  "Source",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSource()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateJobSourceConnectionRequestValidationError{
-				field:  "Source",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	for idx, item := range m.GetMappings() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
-						field:  fmt.Sprintf("Mappings[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
-						field:  fmt.Sprintf("Mappings[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UpdateJobSourceConnectionRequestValidationError{
-					field:  fmt.Sprintf("Mappings[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
+	if m.MaximumAttempts != nil {
+		// no validation rules for MaximumAttempts
 	}
 
 	if len(errors) > 0 {
-		return UpdateJobSourceConnectionRequestMultiError(errors)
+		return RetryPolicyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateJobSourceConnectionRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// UpdateJobSourceConnectionRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateJobSourceConnectionRequestMultiError []error
+// RetryPolicyMultiError is an error wrapping multiple validation errors
+// returned by RetryPolicy.ValidateAll() if the designated constraints aren't met.
+type RetryPolicyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateJobSourceConnectionRequestMultiError) Error() string {
+func (m RetryPolicyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5545,12 +6029,11 @@ func (m UpdateJobSourceConnectionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateJobSourceConnectionRequestMultiError) AllErrors() []error { return m }
+func (m RetryPolicyMultiError) AllErrors() []error { return m }
 
-// UpdateJobSourceConnectionRequestValidationError is the validation error
-// returned by UpdateJobSourceConnectionRequest.Validate if the designated
-// constraints aren't met.
-type UpdateJobSourceConnectionRequestValidationError struct {
+// RetryPolicyValidationError is the validation error returned by
+// RetryPolicy.Validate if the designated constraints aren't met.
+type RetryPolicyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5558,24 +6041,22 @@ type UpdateJobSourceConnectionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateJobSourceConnectionRequestValidationError) Field() string { return e.field }
+func (e RetryPolicyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateJobSourceConnectionRequestValidationError) Reason() string { return e.reason }
+func (e RetryPolicyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateJobSourceConnectionRequestValidationError) Cause() error { return e.cause }
+func (e RetryPolicyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateJobSourceConnectionRequestValidationError) Key() bool { return e.key }
+func (e RetryPolicyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateJobSourceConnectionRequestValidationError) ErrorName() string {
-	return "UpdateJobSourceConnectionRequestValidationError"
-}
+func (e RetryPolicyValidationError) ErrorName() string { return "RetryPolicyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UpdateJobSourceConnectionRequestValidationError) Error() string {
+func (e RetryPolicyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5587,14 +6068,14 @@ func (e UpdateJobSourceConnectionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateJobSourceConnectionRequest.%s: %s%s",
+		"invalid %sRetryPolicy.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateJobSourceConnectionRequestValidationError{}
+var _ error = RetryPolicyValidationError{}
 
 var _ interface {
 	Field() string
@@ -5602,25 +6083,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateJobSourceConnectionRequestValidationError{}
+} = RetryPolicyValidationError{}
 
-// Validate checks the field values on UpdateJobSourceConnectionResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *UpdateJobSourceConnectionResponse) Validate() error {
+// Validate checks the field values on CreateJobResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateJobResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateJobSourceConnectionResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// UpdateJobSourceConnectionResponseMultiError, or nil if none found.
-func (m *UpdateJobSourceConnectionResponse) ValidateAll() error {
+// ValidateAll checks the field values on CreateJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateJobResponseMultiError, or nil if none found.
+func (m *CreateJobResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateJobSourceConnectionResponse) validate(all bool) error {
+func (m *CreateJobResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -5631,7 +6111,7 @@ func (m *UpdateJobSourceConnectionResponse) validate(all bool) error {
 		switch v := interface{}(m.GetJob()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateJobSourceConnectionResponseValidationError{
+				errors = append(errors, CreateJobResponseValidationError{
 					field:  "Job",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -5639,7 +6119,7 @@ func (m *UpdateJobSourceConnectionResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateJobSourceConnectionResponseValidationError{
+				errors = append(errors, CreateJobResponseValidationError{
 					field:  "Job",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -5648,7 +6128,7 @@ func (m *UpdateJobSourceConnectionResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateJobSourceConnectionResponseValidationError{
+			return CreateJobResponseValidationError{
 				field:  "Job",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -5657,20 +6137,19 @@ func (m *UpdateJobSourceConnectionResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateJobSourceConnectionResponseMultiError(errors)
+		return CreateJobResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateJobSourceConnectionResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// UpdateJobSourceConnectionResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateJobSourceConnectionResponseMultiError []error
+// CreateJobResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateJobResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateJobResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateJobSourceConnectionResponseMultiError) Error() string {
+func (m CreateJobResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5679,12 +6158,11 @@ func (m UpdateJobSourceConnectionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateJobSourceConnectionResponseMultiError) AllErrors() []error { return m }
+func (m CreateJobResponseMultiError) AllErrors() []error { return m }
 
-// UpdateJobSourceConnectionResponseValidationError is the validation error
-// returned by UpdateJobSourceConnectionResponse.Validate if the designated
-// constraints aren't met.
-type UpdateJobSourceConnectionResponseValidationError struct {
+// CreateJobResponseValidationError is the validation error returned by
+// CreateJobResponse.Validate if the designated constraints aren't met.
+type CreateJobResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5692,24 +6170,24 @@ type UpdateJobSourceConnectionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateJobSourceConnectionResponseValidationError) Field() string { return e.field }
+func (e CreateJobResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateJobSourceConnectionResponseValidationError) Reason() string { return e.reason }
+func (e CreateJobResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateJobSourceConnectionResponseValidationError) Cause() error { return e.cause }
+func (e CreateJobResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateJobSourceConnectionResponseValidationError) Key() bool { return e.key }
+func (e CreateJobResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateJobSourceConnectionResponseValidationError) ErrorName() string {
-	return "UpdateJobSourceConnectionResponseValidationError"
+func (e CreateJobResponseValidationError) ErrorName() string {
+	return "CreateJobResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateJobSourceConnectionResponseValidationError) Error() string {
+func (e CreateJobResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5721,14 +6199,14 @@ func (e UpdateJobSourceConnectionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateJobSourceConnectionResponse.%s: %s%s",
+		"invalid %sCreateJobResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateJobSourceConnectionResponseValidationError{}
+var _ error = CreateJobResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5736,78 +6214,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateJobSourceConnectionResponseValidationError{}
+} = CreateJobResponseValidationError{}
 
-// Validate checks the field values on PostgresSourceSchemaSubset with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on JobMappingTransformer with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PostgresSourceSchemaSubset) Validate() error {
+func (m *JobMappingTransformer) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PostgresSourceSchemaSubset with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on JobMappingTransformer with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PostgresSourceSchemaSubsetMultiError, or nil if none found.
-func (m *PostgresSourceSchemaSubset) ValidateAll() error {
+// JobMappingTransformerMultiError, or nil if none found.
+func (m *JobMappingTransformer) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PostgresSourceSchemaSubset) validate(all bool) error {
+func (m *JobMappingTransformer) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetPostgresSchemas() {
-		_, _ = idx, item
+	// no validation rules for Source
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PostgresSourceSchemaSubsetValidationError{
-						field:  fmt.Sprintf("PostgresSchemas[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, PostgresSourceSchemaSubsetValidationError{
-						field:  fmt.Sprintf("PostgresSchemas[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobMappingTransformerValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return PostgresSourceSchemaSubsetValidationError{
-					field:  fmt.Sprintf("PostgresSchemas[%v]", idx),
+				errors = append(errors, JobMappingTransformerValidationError{
+					field:  "Config",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobMappingTransformerValidationError{
+				field:  "Config",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return PostgresSourceSchemaSubsetMultiError(errors)
+		return JobMappingTransformerMultiError(errors)
 	}
 
 	return nil
 }
 
-// PostgresSourceSchemaSubsetMultiError is an error wrapping multiple
-// validation errors returned by PostgresSourceSchemaSubset.ValidateAll() if
-// the designated constraints aren't met.
-type PostgresSourceSchemaSubsetMultiError []error
+// JobMappingTransformerMultiError is an error wrapping multiple validation
+// errors returned by JobMappingTransformer.ValidateAll() if the designated
+// constraints aren't met.
+type JobMappingTransformerMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PostgresSourceSchemaSubsetMultiError) Error() string {
+func (m JobMappingTransformerMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5816,11 +6291,11 @@ func (m PostgresSourceSchemaSubsetMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PostgresSourceSchemaSubsetMultiError) AllErrors() []error { return m }
+func (m JobMappingTransformerMultiError) AllErrors() []error { return m }
 
-// PostgresSourceSchemaSubsetValidationError is the validation error returned
-// by PostgresSourceSchemaSubset.Validate if the designated constraints aren't met.
-type PostgresSourceSchemaSubsetValidationError struct {
+// JobMappingTransformerValidationError is the validation error returned by
+// JobMappingTransformer.Validate if the designated constraints aren't met.
+type JobMappingTransformerValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5828,24 +6303,24 @@ type PostgresSourceSchemaSubsetValidationError struct {
 }
 
 // Field function returns field value.
-func (e PostgresSourceSchemaSubsetValidationError) Field() string { return e.field }
+func (e JobMappingTransformerValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PostgresSourceSchemaSubsetValidationError) Reason() string { return e.reason }
+func (e JobMappingTransformerValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PostgresSourceSchemaSubsetValidationError) Cause() error { return e.cause }
+func (e JobMappingTransformerValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PostgresSourceSchemaSubsetValidationError) Key() bool { return e.key }
+func (e JobMappingTransformerValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PostgresSourceSchemaSubsetValidationError) ErrorName() string {
-	return "PostgresSourceSchemaSubsetValidationError"
+func (e JobMappingTransformerValidationError) ErrorName() string {
+	return "JobMappingTransformerValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PostgresSourceSchemaSubsetValidationError) Error() string {
+func (e JobMappingTransformerValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5857,14 +6332,14 @@ func (e PostgresSourceSchemaSubsetValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPostgresSourceSchemaSubset.%s: %s%s",
+		"invalid %sJobMappingTransformer.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PostgresSourceSchemaSubsetValidationError{}
+var _ error = JobMappingTransformerValidationError{}
 
 var _ interface {
 	Field() string
@@ -5872,56 +6347,123 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PostgresSourceSchemaSubsetValidationError{}
+} = JobMappingTransformerValidationError{}
 
-// Validate checks the field values on MysqlSourceSchemaSubset with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MysqlSourceSchemaSubset) Validate() error {
+// Validate checks the field values on JobMapping with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobMapping) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MysqlSourceSchemaSubset with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// MysqlSourceSchemaSubsetMultiError, or nil if none found.
-func (m *MysqlSourceSchemaSubset) ValidateAll() error {
+// ValidateAll checks the field values on JobMapping with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JobMappingMultiError, or
+// nil if none found.
+func (m *JobMapping) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MysqlSourceSchemaSubset) validate(all bool) error {
+func (m *JobMapping) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetMysqlSchemas() {
-		_, _ = idx, item
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for Column
+
+	if all {
+		switch v := interface{}(m.GetTransformer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobMappingValidationError{
+					field:  "Transformer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobMappingValidationError{
+					field:  "Transformer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransformer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobMappingValidationError{
+				field:  "Transformer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.DestinationTable != nil {
 
 		if all {
-			switch v := interface{}(item).(type) {
+			switch v := interface{}(m.GetDestinationTable()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MysqlSourceSchemaSubsetValidationError{
-						field:  fmt.Sprintf("MysqlSchemas[%v]", idx),
+					errors = append(errors, JobMappingValidationError{
+						field:  "DestinationTable",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, MysqlSourceSchemaSubsetValidationError{
-						field:  fmt.Sprintf("MysqlSchemas[%v]", idx),
+					errors = append(errors, JobMappingValidationError{
+						field:  "DestinationTable",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetDestinationTable()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return MysqlSourceSchemaSubsetValidationError{
-					field:  fmt.Sprintf("MysqlSchemas[%v]", idx),
+				return JobMappingValidationError{
+					field:  "DestinationTable",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.DestinationColumn != nil {
+
+		if all {
+			switch v := interface{}(m.GetDestinationColumn()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobMappingValidationError{
+						field:  "DestinationColumn",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobMappingValidationError{
+						field:  "DestinationColumn",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDestinationColumn()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobMappingValidationError{
+					field:  "DestinationColumn",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -5931,19 +6473,18 @@ func (m *MysqlSourceSchemaSubset) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return MysqlSourceSchemaSubsetMultiError(errors)
+		return JobMappingMultiError(errors)
 	}
 
 	return nil
 }
 
-// MysqlSourceSchemaSubsetMultiError is an error wrapping multiple validation
-// errors returned by MysqlSourceSchemaSubset.ValidateAll() if the designated
-// constraints aren't met.
-type MysqlSourceSchemaSubsetMultiError []error
+// JobMappingMultiError is an error wrapping multiple validation errors
+// returned by JobMapping.ValidateAll() if the designated constraints aren't met.
+type JobMappingMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MysqlSourceSchemaSubsetMultiError) Error() string {
+func (m JobMappingMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5952,11 +6493,11 @@ func (m MysqlSourceSchemaSubsetMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MysqlSourceSchemaSubsetMultiError) AllErrors() []error { return m }
+func (m JobMappingMultiError) AllErrors() []error { return m }
 
-// MysqlSourceSchemaSubsetValidationError is the validation error returned by
-// MysqlSourceSchemaSubset.Validate if the designated constraints aren't met.
-type MysqlSourceSchemaSubsetValidationError struct {
+// JobMappingValidationError is the validation error returned by
+// JobMapping.Validate if the designated constraints aren't met.
+type JobMappingValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5964,24 +6505,22 @@ type MysqlSourceSchemaSubsetValidationError struct {
 }
 
 // Field function returns field value.
-func (e MysqlSourceSchemaSubsetValidationError) Field() string { return e.field }
+func (e JobMappingValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MysqlSourceSchemaSubsetValidationError) Reason() string { return e.reason }
+func (e JobMappingValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MysqlSourceSchemaSubsetValidationError) Cause() error { return e.cause }
+func (e JobMappingValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MysqlSourceSchemaSubsetValidationError) Key() bool { return e.key }
+func (e JobMappingValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MysqlSourceSchemaSubsetValidationError) ErrorName() string {
-	return "MysqlSourceSchemaSubsetValidationError"
-}
+func (e JobMappingValidationError) ErrorName() string { return "JobMappingValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MysqlSourceSchemaSubsetValidationError) Error() string {
+func (e JobMappingValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5993,14 +6532,14 @@ func (e MysqlSourceSchemaSubsetValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMysqlSourceSchemaSubset.%s: %s%s",
+		"invalid %sJobMapping.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MysqlSourceSchemaSubsetValidationError{}
+var _ error = JobMappingValidationError{}
 
 var _ interface {
 	Field() string
@@ -6008,131 +6547,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MysqlSourceSchemaSubsetValidationError{}
+} = JobMappingValidationError{}
 
-// Validate checks the field values on JobSourceSqlSubetSchemas with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on JobMappingDestinationTable with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *JobSourceSqlSubetSchemas) Validate() error {
+func (m *JobMappingDestinationTable) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobSourceSqlSubetSchemas with the
+// ValidateAll checks the field values on JobMappingDestinationTable with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JobSourceSqlSubetSchemasMultiError, or nil if none found.
-func (m *JobSourceSqlSubetSchemas) ValidateAll() error {
+// JobMappingDestinationTableMultiError, or nil if none found.
+func (m *JobMappingDestinationTable) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobSourceSqlSubetSchemas) validate(all bool) error {
+func (m *JobMappingDestinationTable) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Schemas.(type) {
-	case *JobSourceSqlSubetSchemas_PostgresSubset:
-		if v == nil {
-			err := JobSourceSqlSubetSchemasValidationError{
-				field:  "Schemas",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	// no validation rules for Schema
 
-		if all {
-			switch v := interface{}(m.GetPostgresSubset()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
-						field:  "PostgresSubset",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
-						field:  "PostgresSubset",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetPostgresSubset()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return JobSourceSqlSubetSchemasValidationError{
-					field:  "PostgresSubset",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *JobSourceSqlSubetSchemas_MysqlSubset:
-		if v == nil {
-			err := JobSourceSqlSubetSchemasValidationError{
-				field:  "Schemas",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetMysqlSubset()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
-						field:  "MysqlSubset",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
-						field:  "MysqlSubset",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetMysqlSubset()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return JobSourceSqlSubetSchemasValidationError{
-					field:  "MysqlSubset",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	default:
-		_ = v // ensures v is used
-	}
+	// no validation rules for Table
 
 	if len(errors) > 0 {
-		return JobSourceSqlSubetSchemasMultiError(errors)
+		return JobMappingDestinationTableMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobSourceSqlSubetSchemasMultiError is an error wrapping multiple validation
-// errors returned by JobSourceSqlSubetSchemas.ValidateAll() if the designated
-// constraints aren't met.
-type JobSourceSqlSubetSchemasMultiError []error
+// JobMappingDestinationTableMultiError is an error wrapping multiple
+// validation errors returned by JobMappingDestinationTable.ValidateAll() if
+// the designated constraints aren't met.
+type JobMappingDestinationTableMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobSourceSqlSubetSchemasMultiError) Error() string {
+func (m JobMappingDestinationTableMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6141,11 +6597,11 @@ func (m JobSourceSqlSubetSchemasMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobSourceSqlSubetSchemasMultiError) AllErrors() []error { return m }
+func (m JobMappingDestinationTableMultiError) AllErrors() []error { return m }
 
-// JobSourceSqlSubetSchemasValidationError is the validation error returned by
-// JobSourceSqlSubetSchemas.Validate if the designated constraints aren't met.
-type JobSourceSqlSubetSchemasValidationError struct {
+// JobMappingDestinationTableValidationError is the validation error returned
+// by JobMappingDestinationTable.Validate if the designated constraints aren't met.
+type JobMappingDestinationTableValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6153,24 +6609,24 @@ type JobSourceSqlSubetSchemasValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobSourceSqlSubetSchemasValidationError) Field() string { return e.field }
+func (e JobMappingDestinationTableValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobSourceSqlSubetSchemasValidationError) Reason() string { return e.reason }
+func (e JobMappingDestinationTableValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobSourceSqlSubetSchemasValidationError) Cause() error { return e.cause }
+func (e JobMappingDestinationTableValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobSourceSqlSubetSchemasValidationError) Key() bool { return e.key }
+func (e JobMappingDestinationTableValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobSourceSqlSubetSchemasValidationError) ErrorName() string {
-	return "JobSourceSqlSubetSchemasValidationError"
+func (e JobMappingDestinationTableValidationError) ErrorName() string {
+	return "JobMappingDestinationTableValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e JobSourceSqlSubetSchemasValidationError) Error() string {
+func (e JobMappingDestinationTableValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6182,14 +6638,14 @@ func (e JobSourceSqlSubetSchemasValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobSourceSqlSubetSchemas.%s: %s%s",
+		"invalid %sJobMappingDestinationTable.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobSourceSqlSubetSchemasValidationError{}
+var _ error = JobMappingDestinationTableValidationError{}
 
 var _ interface {
 	Field() string
@@ -6197,80 +6653,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobSourceSqlSubetSchemasValidationError{}
+} = JobMappingDestinationTableValidationError{}
 
-// Validate checks the field values on SetJobSourceSqlConnectionSubsetsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *SetJobSourceSqlConnectionSubsetsRequest) Validate() error {
+// Validate checks the field values on JobMappingDestinationColumn with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobMappingDestinationColumn) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// SetJobSourceSqlConnectionSubsetsRequest with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// SetJobSourceSqlConnectionSubsetsRequestMultiError, or nil if none found.
-func (m *SetJobSourceSqlConnectionSubsetsRequest) ValidateAll() error {
+// ValidateAll checks the field values on JobMappingDestinationColumn with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobMappingDestinationColumnMultiError, or nil if none found.
+func (m *JobMappingDestinationColumn) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetJobSourceSqlConnectionSubsetsRequest) validate(all bool) error {
+func (m *JobMappingDestinationColumn) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	if all {
-		switch v := interface{}(m.GetSchemas()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SetJobSourceSqlConnectionSubsetsRequestValidationError{
-					field:  "Schemas",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SetJobSourceSqlConnectionSubsetsRequestValidationError{
-					field:  "Schemas",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSchemas()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SetJobSourceSqlConnectionSubsetsRequestValidationError{
-				field:  "Schemas",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if m.Name != nil {
+		// no validation rules for Name
 	}
 
-	// no validation rules for SubsetByForeignKeyConstraints
+	if m.DataType != nil {
+		// no validation rules for DataType
+	}
 
 	if len(errors) > 0 {
-		return SetJobSourceSqlConnectionSubsetsRequestMultiError(errors)
+		return JobMappingDestinationColumnMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetJobSourceSqlConnectionSubsetsRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// SetJobSourceSqlConnectionSubsetsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type SetJobSourceSqlConnectionSubsetsRequestMultiError []error
+// JobMappingDestinationColumnMultiError is an error wrapping multiple
+// validation errors returned by JobMappingDestinationColumn.ValidateAll() if
+// the designated constraints aren't met.
+type JobMappingDestinationColumnMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetJobSourceSqlConnectionSubsetsRequestMultiError) Error() string {
+func (m JobMappingDestinationColumnMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6279,12 +6707,12 @@ func (m SetJobSourceSqlConnectionSubsetsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetJobSourceSqlConnectionSubsetsRequestMultiError) AllErrors() []error { return m }
+func (m JobMappingDestinationColumnMultiError) AllErrors() []error { return m }
 
-// SetJobSourceSqlConnectionSubsetsRequestValidationError is the validation
-// error returned by SetJobSourceSqlConnectionSubsetsRequest.Validate if the
-// designated constraints aren't met.
-type SetJobSourceSqlConnectionSubsetsRequestValidationError struct {
+// JobMappingDestinationColumnValidationError is the validation error returned
+// by JobMappingDestinationColumn.Validate if the designated constraints
+// aren't met.
+type JobMappingDestinationColumnValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6292,24 +6720,24 @@ type SetJobSourceSqlConnectionSubsetsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Field() string { return e.field }
+func (e JobMappingDestinationColumnValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Reason() string { return e.reason }
+func (e JobMappingDestinationColumnValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Cause() error { return e.cause }
+func (e JobMappingDestinationColumnValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Key() bool { return e.key }
+func (e JobMappingDestinationColumnValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) ErrorName() string {
-	return "SetJobSourceSqlConnectionSubsetsRequestValidationError"
+func (e JobMappingDestinationColumnValidationError) ErrorName() string {
+	return "JobMappingDestinationColumnValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Error() string {
+func (e JobMappingDestinationColumnValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6321,14 +6749,14 @@ func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetJobSourceSqlConnectionSubsetsRequest.%s: %s%s",
+		"invalid %sJobMappingDestinationColumn.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetJobSourceSqlConnectionSubsetsRequestValidationError{}
+var _ error = JobMappingDestinationColumnValidationError{}
 
 var _ interface {
 	Field() string
@@ -6336,76 +6764,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetJobSourceSqlConnectionSubsetsRequestValidationError{}
+} = JobMappingDestinationColumnValidationError{}
 
-// Validate checks the field values on SetJobSourceSqlConnectionSubsetsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *SetJobSourceSqlConnectionSubsetsResponse) Validate() error {
+// Validate checks the field values on JobTableProcessor with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *JobTableProcessor) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// SetJobSourceSqlConnectionSubsetsResponse with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// SetJobSourceSqlConnectionSubsetsResponseMultiError, or nil if none found.
-func (m *SetJobSourceSqlConnectionSubsetsResponse) ValidateAll() error {
+// ValidateAll checks the field values on JobTableProcessor with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobTableProcessorMultiError, or nil if none found.
+func (m *JobTableProcessor) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetJobSourceSqlConnectionSubsetsResponse) validate(all bool) error {
+func (m *JobTableProcessor) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetJob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SetJobSourceSqlConnectionSubsetsResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SetJobSourceSqlConnectionSubsetsResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SetJobSourceSqlConnectionSubsetsResponseValidationError{
-				field:  "Job",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for Processors
 
 	if len(errors) > 0 {
-		return SetJobSourceSqlConnectionSubsetsResponseMultiError(errors)
+		return JobTableProcessorMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetJobSourceSqlConnectionSubsetsResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// SetJobSourceSqlConnectionSubsetsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type SetJobSourceSqlConnectionSubsetsResponseMultiError []error
+// JobTableProcessorMultiError is an error wrapping multiple validation errors
+// returned by JobTableProcessor.ValidateAll() if the designated constraints
+// aren't met.
+type JobTableProcessorMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetJobSourceSqlConnectionSubsetsResponseMultiError) Error() string {
+func (m JobTableProcessorMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6414,12 +6816,11 @@ func (m SetJobSourceSqlConnectionSubsetsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetJobSourceSqlConnectionSubsetsResponseMultiError) AllErrors() []error { return m }
+func (m JobTableProcessorMultiError) AllErrors() []error { return m }
 
-// SetJobSourceSqlConnectionSubsetsResponseValidationError is the validation
-// error returned by SetJobSourceSqlConnectionSubsetsResponse.Validate if the
-// designated constraints aren't met.
-type SetJobSourceSqlConnectionSubsetsResponseValidationError struct {
+// JobTableProcessorValidationError is the validation error returned by
+// JobTableProcessor.Validate if the designated constraints aren't met.
+type JobTableProcessorValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6427,24 +6828,24 @@ type SetJobSourceSqlConnectionSubsetsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Field() string { return e.field }
+func (e JobTableProcessorValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Reason() string { return e.reason }
+func (e JobTableProcessorValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Cause() error { return e.cause }
+func (e JobTableProcessorValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Key() bool { return e.key }
+func (e JobTableProcessorValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) ErrorName() string {
-	return "SetJobSourceSqlConnectionSubsetsResponseValidationError"
+func (e JobTableProcessorValidationError) ErrorName() string {
+	return "JobTableProcessorValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Error() string {
+func (e JobTableProcessorValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6456,14 +6857,14 @@ func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Error() string
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetJobSourceSqlConnectionSubsetsResponse.%s: %s%s",
+		"invalid %sJobTableProcessor.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetJobSourceSqlConnectionSubsetsResponseValidationError{}
+var _ error = JobTableProcessorValidationError{}
 
 var _ interface {
 	Field() string
@@ -6471,81 +6872,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetJobSourceSqlConnectionSubsetsResponseValidationError{}
+} = JobTableProcessorValidationError{}
 
-// Validate checks the field values on UpdateJobDestinationConnectionRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *UpdateJobDestinationConnectionRequest) Validate() error {
+// Validate checks the field values on GetJobRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetJobRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateJobDestinationConnectionRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// UpdateJobDestinationConnectionRequestMultiError, or nil if none found.
-func (m *UpdateJobDestinationConnectionRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetJobRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetJobRequestMultiError, or
+// nil if none found.
+func (m *GetJobRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateJobDestinationConnectionRequest) validate(all bool) error {
+func (m *GetJobRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobId
+	// no validation rules for Id
 
-	// no validation rules for ConnectionId
-
-	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateJobDestinationConnectionRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateJobDestinationConnectionRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateJobDestinationConnectionRequestValidationError{
-				field:  "Options",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for DestinationId
-
-	if len(errors) > 0 {
-		return UpdateJobDestinationConnectionRequestMultiError(errors)
-	}
+	if len(errors) > 0 {
+		return GetJobRequestMultiError(errors)
+	}
 
 	return nil
 }
 
-// UpdateJobDestinationConnectionRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// UpdateJobDestinationConnectionRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateJobDestinationConnectionRequestMultiError []error
+// GetJobRequestMultiError is an error wrapping multiple validation errors
+// returned by GetJobRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetJobRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateJobDestinationConnectionRequestMultiError) Error() string {
+func (m GetJobRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6554,12 +6920,11 @@ func (m UpdateJobDestinationConnectionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateJobDestinationConnectionRequestMultiError) AllErrors() []error { return m }
+func (m GetJobRequestMultiError) AllErrors() []error { return m }
 
-// UpdateJobDestinationConnectionRequestValidationError is the validation error
-// returned by UpdateJobDestinationConnectionRequest.Validate if the
-// designated constraints aren't met.
-type UpdateJobDestinationConnectionRequestValidationError struct {
+// GetJobRequestValidationError is the validation error returned by
+// GetJobRequest.Validate if the designated constraints aren't met.
+type GetJobRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6567,24 +6932,22 @@ type UpdateJobDestinationConnectionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateJobDestinationConnectionRequestValidationError) Field() string { return e.field }
+func (e GetJobRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateJobDestinationConnectionRequestValidationError) Reason() string { return e.reason }
+func (e GetJobRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateJobDestinationConnectionRequestValidationError) Cause() error { return e.cause }
+func (e GetJobRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateJobDestinationConnectionRequestValidationError) Key() bool { return e.key }
+func (e GetJobRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateJobDestinationConnectionRequestValidationError) ErrorName() string {
-	return "UpdateJobDestinationConnectionRequestValidationError"
-}
+func (e GetJobRequestValidationError) ErrorName() string { return "GetJobRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UpdateJobDestinationConnectionRequestValidationError) Error() string {
+func (e GetJobRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6596,14 +6959,14 @@ func (e UpdateJobDestinationConnectionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateJobDestinationConnectionRequest.%s: %s%s",
+		"invalid %sGetJobRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateJobDestinationConnectionRequestValidationError{}
+var _ error = GetJobRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6611,26 +6974,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateJobDestinationConnectionRequestValidationError{}
+} = GetJobRequestValidationError{}
 
-// Validate checks the field values on UpdateJobDestinationConnectionResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *UpdateJobDestinationConnectionResponse) Validate() error {
+// Validate checks the field values on GetJobResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetJobResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// UpdateJobDestinationConnectionResponse with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// UpdateJobDestinationConnectionResponseMultiError, or nil if none found.
-func (m *UpdateJobDestinationConnectionResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetJobResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetJobResponseMultiError,
+// or nil if none found.
+func (m *GetJobResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateJobDestinationConnectionResponse) validate(all bool) error {
+func (m *GetJobResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -6641,7 +7002,7 @@ func (m *UpdateJobDestinationConnectionResponse) validate(all bool) error {
 		switch v := interface{}(m.GetJob()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateJobDestinationConnectionResponseValidationError{
+				errors = append(errors, GetJobResponseValidationError{
 					field:  "Job",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -6649,7 +7010,7 @@ func (m *UpdateJobDestinationConnectionResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateJobDestinationConnectionResponseValidationError{
+				errors = append(errors, GetJobResponseValidationError{
 					field:  "Job",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -6658,7 +7019,7 @@ func (m *UpdateJobDestinationConnectionResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateJobDestinationConnectionResponseValidationError{
+			return GetJobResponseValidationError{
 				field:  "Job",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -6667,20 +7028,19 @@ func (m *UpdateJobDestinationConnectionResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateJobDestinationConnectionResponseMultiError(errors)
+		return GetJobResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateJobDestinationConnectionResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// UpdateJobDestinationConnectionResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateJobDestinationConnectionResponseMultiError []error
+// GetJobResponseMultiError is an error wrapping multiple validation errors
+// returned by GetJobResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetJobResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateJobDestinationConnectionResponseMultiError) Error() string {
+func (m GetJobResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6689,12 +7049,11 @@ func (m UpdateJobDestinationConnectionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateJobDestinationConnectionResponseMultiError) AllErrors() []error { return m }
+func (m GetJobResponseMultiError) AllErrors() []error { return m }
 
-// UpdateJobDestinationConnectionResponseValidationError is the validation
-// error returned by UpdateJobDestinationConnectionResponse.Validate if the
-// designated constraints aren't met.
-type UpdateJobDestinationConnectionResponseValidationError struct {
+// GetJobResponseValidationError is the validation error returned by
+// GetJobResponse.Validate if the designated constraints aren't met.
+type GetJobResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6702,24 +7061,22 @@ type UpdateJobDestinationConnectionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateJobDestinationConnectionResponseValidationError) Field() string { return e.field }
+func (e GetJobResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateJobDestinationConnectionResponseValidationError) Reason() string { return e.reason }
+func (e GetJobResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateJobDestinationConnectionResponseValidationError) Cause() error { return e.cause }
+func (e GetJobResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateJobDestinationConnectionResponseValidationError) Key() bool { return e.key }
+func (e GetJobResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateJobDestinationConnectionResponseValidationError) ErrorName() string {
-	return "UpdateJobDestinationConnectionResponseValidationError"
-}
+func (e GetJobResponseValidationError) ErrorName() string { return "GetJobResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UpdateJobDestinationConnectionResponseValidationError) Error() string {
+func (e GetJobResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6731,14 +7088,14 @@ func (e UpdateJobDestinationConnectionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateJobDestinationConnectionResponse.%s: %s%s",
+		"invalid %sGetJobResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateJobDestinationConnectionResponseValidationError{}
+var _ error = GetJobResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6746,48 +7103,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateJobDestinationConnectionResponseValidationError{}
+} = GetJobResponseValidationError{}
 
-// Validate checks the field values on DeleteJobDestinationConnectionRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *DeleteJobDestinationConnectionRequest) Validate() error {
+// Validate checks the field values on UpdateJobScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateJobScheduleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteJobDestinationConnectionRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// DeleteJobDestinationConnectionRequestMultiError, or nil if none found.
-func (m *DeleteJobDestinationConnectionRequest) ValidateAll() error {
+// ValidateAll checks the field values on UpdateJobScheduleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateJobScheduleRequestMultiError, or nil if none found.
+func (m *UpdateJobScheduleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteJobDestinationConnectionRequest) validate(all bool) error {
+func (m *UpdateJobScheduleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DestinationId
+	// no validation rules for Id
+
+	if m.CronSchedule != nil {
+		// no validation rules for CronSchedule
+	}
 
 	if len(errors) > 0 {
-		return DeleteJobDestinationConnectionRequestMultiError(errors)
+		return UpdateJobScheduleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteJobDestinationConnectionRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// DeleteJobDestinationConnectionRequest.ValidateAll() if the designated
+// UpdateJobScheduleRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateJobScheduleRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteJobDestinationConnectionRequestMultiError []error
+type UpdateJobScheduleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteJobDestinationConnectionRequestMultiError) Error() string {
+func (m UpdateJobScheduleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6796,12 +7155,11 @@ func (m DeleteJobDestinationConnectionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteJobDestinationConnectionRequestMultiError) AllErrors() []error { return m }
+func (m UpdateJobScheduleRequestMultiError) AllErrors() []error { return m }
 
-// DeleteJobDestinationConnectionRequestValidationError is the validation error
-// returned by DeleteJobDestinationConnectionRequest.Validate if the
-// designated constraints aren't met.
-type DeleteJobDestinationConnectionRequestValidationError struct {
+// UpdateJobScheduleRequestValidationError is the validation error returned by
+// UpdateJobScheduleRequest.Validate if the designated constraints aren't met.
+type UpdateJobScheduleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6809,24 +7167,24 @@ type DeleteJobDestinationConnectionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteJobDestinationConnectionRequestValidationError) Field() string { return e.field }
+func (e UpdateJobScheduleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteJobDestinationConnectionRequestValidationError) Reason() string { return e.reason }
+func (e UpdateJobScheduleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteJobDestinationConnectionRequestValidationError) Cause() error { return e.cause }
+func (e UpdateJobScheduleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteJobDestinationConnectionRequestValidationError) Key() bool { return e.key }
+func (e UpdateJobScheduleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteJobDestinationConnectionRequestValidationError) ErrorName() string {
-	return "DeleteJobDestinationConnectionRequestValidationError"
+func (e UpdateJobScheduleRequestValidationError) ErrorName() string {
+	return "UpdateJobScheduleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteJobDestinationConnectionRequestValidationError) Error() string {
+func (e UpdateJobScheduleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6838,14 +7196,14 @@ func (e DeleteJobDestinationConnectionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteJobDestinationConnectionRequest.%s: %s%s",
+		"invalid %sUpdateJobScheduleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteJobDestinationConnectionRequestValidationError{}
+var _ error = UpdateJobScheduleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6853,47 +7211,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteJobDestinationConnectionRequestValidationError{}
+} = UpdateJobScheduleRequestValidationError{}
 
-// Validate checks the field values on DeleteJobDestinationConnectionResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *DeleteJobDestinationConnectionResponse) Validate() error {
+// Validate checks the field values on UpdateJobScheduleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateJobScheduleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// DeleteJobDestinationConnectionResponse with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// DeleteJobDestinationConnectionResponseMultiError, or nil if none found.
-func (m *DeleteJobDestinationConnectionResponse) ValidateAll() error {
+// ValidateAll checks the field values on UpdateJobScheduleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateJobScheduleResponseMultiError, or nil if none found.
+func (m *UpdateJobScheduleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteJobDestinationConnectionResponse) validate(all bool) error {
+func (m *UpdateJobScheduleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateJobScheduleResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateJobScheduleResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateJobScheduleResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return DeleteJobDestinationConnectionResponseMultiError(errors)
+		return UpdateJobScheduleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteJobDestinationConnectionResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// DeleteJobDestinationConnectionResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteJobDestinationConnectionResponseMultiError []error
+// UpdateJobScheduleResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateJobScheduleResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateJobScheduleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteJobDestinationConnectionResponseMultiError) Error() string {
+func (m UpdateJobScheduleResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6902,12 +7286,11 @@ func (m DeleteJobDestinationConnectionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteJobDestinationConnectionResponseMultiError) AllErrors() []error { return m }
+func (m UpdateJobScheduleResponseMultiError) AllErrors() []error { return m }
 
-// DeleteJobDestinationConnectionResponseValidationError is the validation
-// error returned by DeleteJobDestinationConnectionResponse.Validate if the
-// designated constraints aren't met.
-type DeleteJobDestinationConnectionResponseValidationError struct {
+// UpdateJobScheduleResponseValidationError is the validation error returned by
+// UpdateJobScheduleResponse.Validate if the designated constraints aren't met.
+type UpdateJobScheduleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6915,24 +7298,24 @@ type DeleteJobDestinationConnectionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteJobDestinationConnectionResponseValidationError) Field() string { return e.field }
+func (e UpdateJobScheduleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteJobDestinationConnectionResponseValidationError) Reason() string { return e.reason }
+func (e UpdateJobScheduleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteJobDestinationConnectionResponseValidationError) Cause() error { return e.cause }
+func (e UpdateJobScheduleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteJobDestinationConnectionResponseValidationError) Key() bool { return e.key }
+func (e UpdateJobScheduleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteJobDestinationConnectionResponseValidationError) ErrorName() string {
-	return "DeleteJobDestinationConnectionResponseValidationError"
+func (e UpdateJobScheduleResponseValidationError) ErrorName() string {
+	return "UpdateJobScheduleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteJobDestinationConnectionResponseValidationError) Error() string {
+func (e UpdateJobScheduleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6944,14 +7327,14 @@ func (e DeleteJobDestinationConnectionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteJobDestinationConnectionResponse.%s: %s%s",
+		"invalid %sUpdateJobScheduleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteJobDestinationConnectionResponseValidationError{}
+var _ error = UpdateJobScheduleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6959,83 +7342,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteJobDestinationConnectionResponseValidationError{}
+} = UpdateJobScheduleResponseValidationError{}
 
-// Validate checks the field values on CreateJobDestinationConnectionsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *CreateJobDestinationConnectionsRequest) Validate() error {
+// Validate checks the field values on PauseJobRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PauseJobRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// CreateJobDestinationConnectionsRequest with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// CreateJobDestinationConnectionsRequestMultiError, or nil if none found.
-func (m *CreateJobDestinationConnectionsRequest) ValidateAll() error {
+// ValidateAll checks the field values on PauseJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PauseJobRequestMultiError, or nil if none found.
+func (m *PauseJobRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateJobDestinationConnectionsRequest) validate(all bool) error {
+func (m *PauseJobRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobId
-
-	for idx, item := range m.GetDestinations() {
-		_, _ = idx, item
+	// no validation rules for Id
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateJobDestinationConnectionsRequestValidationError{
-						field:  fmt.Sprintf("Destinations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateJobDestinationConnectionsRequestValidationError{
-						field:  fmt.Sprintf("Destinations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CreateJobDestinationConnectionsRequestValidationError{
-					field:  fmt.Sprintf("Destinations[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Pause
 
+	if m.Note != nil {
+		// no validation rules for Note
 	}
 
 	if len(errors) > 0 {
-		return CreateJobDestinationConnectionsRequestMultiError(errors)
+		return PauseJobRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateJobDestinationConnectionsRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// CreateJobDestinationConnectionsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateJobDestinationConnectionsRequestMultiError []error
+// PauseJobRequestMultiError is an error wrapping multiple validation errors
+// returned by PauseJobRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PauseJobRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateJobDestinationConnectionsRequestMultiError) Error() string {
+func (m PauseJobRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7044,12 +7396,11 @@ func (m CreateJobDestinationConnectionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateJobDestinationConnectionsRequestMultiError) AllErrors() []error { return m }
+func (m PauseJobRequestMultiError) AllErrors() []error { return m }
 
-// CreateJobDestinationConnectionsRequestValidationError is the validation
-// error returned by CreateJobDestinationConnectionsRequest.Validate if the
-// designated constraints aren't met.
-type CreateJobDestinationConnectionsRequestValidationError struct {
+// PauseJobRequestValidationError is the validation error returned by
+// PauseJobRequest.Validate if the designated constraints aren't met.
+type PauseJobRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7057,24 +7408,22 @@ type CreateJobDestinationConnectionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateJobDestinationConnectionsRequestValidationError) Field() string { return e.field }
+func (e PauseJobRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateJobDestinationConnectionsRequestValidationError) Reason() string { return e.reason }
+func (e PauseJobRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateJobDestinationConnectionsRequestValidationError) Cause() error { return e.cause }
+func (e PauseJobRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateJobDestinationConnectionsRequestValidationError) Key() bool { return e.key }
+func (e PauseJobRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateJobDestinationConnectionsRequestValidationError) ErrorName() string {
-	return "CreateJobDestinationConnectionsRequestValidationError"
-}
+func (e PauseJobRequestValidationError) ErrorName() string { return "PauseJobRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateJobDestinationConnectionsRequestValidationError) Error() string {
+func (e PauseJobRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7086,14 +7435,14 @@ func (e CreateJobDestinationConnectionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateJobDestinationConnectionsRequest.%s: %s%s",
+		"invalid %sPauseJobRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateJobDestinationConnectionsRequestValidationError{}
+var _ error = PauseJobRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7101,26 +7450,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateJobDestinationConnectionsRequestValidationError{}
+} = PauseJobRequestValidationError{}
 
-// Validate checks the field values on CreateJobDestinationConnectionsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *CreateJobDestinationConnectionsResponse) Validate() error {
+// Validate checks the field values on PauseJobResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PauseJobResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// CreateJobDestinationConnectionsResponse with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// CreateJobDestinationConnectionsResponseMultiError, or nil if none found.
-func (m *CreateJobDestinationConnectionsResponse) ValidateAll() error {
+// ValidateAll checks the field values on PauseJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PauseJobResponseMultiError, or nil if none found.
+func (m *PauseJobResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateJobDestinationConnectionsResponse) validate(all bool) error {
+func (m *PauseJobResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7131,7 +7478,7 @@ func (m *CreateJobDestinationConnectionsResponse) validate(all bool) error {
 		switch v := interface{}(m.GetJob()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateJobDestinationConnectionsResponseValidationError{
+				errors = append(errors, PauseJobResponseValidationError{
 					field:  "Job",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -7139,7 +7486,7 @@ func (m *CreateJobDestinationConnectionsResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateJobDestinationConnectionsResponseValidationError{
+				errors = append(errors, PauseJobResponseValidationError{
 					field:  "Job",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -7148,7 +7495,7 @@ func (m *CreateJobDestinationConnectionsResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateJobDestinationConnectionsResponseValidationError{
+			return PauseJobResponseValidationError{
 				field:  "Job",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -7157,20 +7504,19 @@ func (m *CreateJobDestinationConnectionsResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateJobDestinationConnectionsResponseMultiError(errors)
+		return PauseJobResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateJobDestinationConnectionsResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// CreateJobDestinationConnectionsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateJobDestinationConnectionsResponseMultiError []error
+// PauseJobResponseMultiError is an error wrapping multiple validation errors
+// returned by PauseJobResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PauseJobResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateJobDestinationConnectionsResponseMultiError) Error() string {
+func (m PauseJobResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7179,12 +7525,11 @@ func (m CreateJobDestinationConnectionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateJobDestinationConnectionsResponseMultiError) AllErrors() []error { return m }
+func (m PauseJobResponseMultiError) AllErrors() []error { return m }
 
-// CreateJobDestinationConnectionsResponseValidationError is the validation
-// error returned by CreateJobDestinationConnectionsResponse.Validate if the
-// designated constraints aren't met.
-type CreateJobDestinationConnectionsResponseValidationError struct {
+// PauseJobResponseValidationError is the validation error returned by
+// PauseJobResponse.Validate if the designated constraints aren't met.
+type PauseJobResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7192,24 +7537,22 @@ type CreateJobDestinationConnectionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateJobDestinationConnectionsResponseValidationError) Field() string { return e.field }
+func (e PauseJobResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateJobDestinationConnectionsResponseValidationError) Reason() string { return e.reason }
+func (e PauseJobResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateJobDestinationConnectionsResponseValidationError) Cause() error { return e.cause }
+func (e PauseJobResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateJobDestinationConnectionsResponseValidationError) Key() bool { return e.key }
+func (e PauseJobResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateJobDestinationConnectionsResponseValidationError) ErrorName() string {
-	return "CreateJobDestinationConnectionsResponseValidationError"
-}
+func (e PauseJobResponseValidationError) ErrorName() string { return "PauseJobResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateJobDestinationConnectionsResponseValidationError) Error() string {
+func (e PauseJobResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7221,14 +7564,14 @@ func (e CreateJobDestinationConnectionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateJobDestinationConnectionsResponse.%s: %s%s",
+		"invalid %sPauseJobResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateJobDestinationConnectionsResponseValidationError{}
+var _ error = PauseJobResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7236,24 +7579,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateJobDestinationConnectionsResponseValidationError{}
+} = PauseJobResponseValidationError{}
 
-// Validate checks the field values on DeleteJobRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DeleteJobRequest) Validate() error {
+// Validate checks the field values on UpdateJobSourceConnectionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdateJobSourceConnectionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteJobRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteJobRequestMultiError, or nil if none found.
-func (m *DeleteJobRequest) ValidateAll() error {
+// ValidateAll checks the field values on UpdateJobSourceConnectionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateJobSourceConnectionRequestMultiError, or nil if none found.
+func (m *UpdateJobSourceConnectionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteJobRequest) validate(all bool) error {
+func (m *UpdateJobSourceConnectionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7262,56 +7606,123 @@ func (m *DeleteJobRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
-	if len(errors) > 0 {
-		return DeleteJobRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-// DeleteJobRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteJobRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteJobRequestMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m DeleteJobRequestMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m DeleteJobRequestMultiError) AllErrors() []error { return m }
-
-// DeleteJobRequestValidationError is the validation error returned by
-// DeleteJobRequest.Validate if the designated constraints aren't met.
-type DeleteJobRequestValidationError struct {
-	field  string
+	if all {
+		switch v := interface{}(m.GetSource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
+					field:  "Source",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
+					field:  "Source",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateJobSourceConnectionRequestValidationError{
+				field:  "Source",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetMappings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateJobSourceConnectionRequestValidationError{
+					field:  fmt.Sprintf("Mappings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateJobSourceConnectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateJobSourceConnectionRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateJobSourceConnectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateJobSourceConnectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateJobSourceConnectionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateJobSourceConnectionRequestMultiError) AllErrors() []error { return m }
+
+// UpdateJobSourceConnectionRequestValidationError is the validation error
+// returned by UpdateJobSourceConnectionRequest.Validate if the designated
+// constraints aren't met.
+type UpdateJobSourceConnectionRequestValidationError struct {
+	field  string
 	reason string
 	cause  error
 	key    bool
 }
 
 // Field function returns field value.
-func (e DeleteJobRequestValidationError) Field() string { return e.field }
+func (e UpdateJobSourceConnectionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteJobRequestValidationError) Reason() string { return e.reason }
+func (e UpdateJobSourceConnectionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteJobRequestValidationError) Cause() error { return e.cause }
+func (e UpdateJobSourceConnectionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteJobRequestValidationError) Key() bool { return e.key }
+func (e UpdateJobSourceConnectionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteJobRequestValidationError) ErrorName() string { return "DeleteJobRequestValidationError" }
+func (e UpdateJobSourceConnectionRequestValidationError) ErrorName() string {
+	return "UpdateJobSourceConnectionRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DeleteJobRequestValidationError) Error() string {
+func (e UpdateJobSourceConnectionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7323,14 +7734,14 @@ func (e DeleteJobRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteJobRequest.%s: %s%s",
+		"invalid %sUpdateJobSourceConnectionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteJobRequestValidationError{}
+var _ error = UpdateJobSourceConnectionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7338,44 +7749,108 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteJobRequestValidationError{}
+} = UpdateJobSourceConnectionRequestValidationError{}
 
-// Validate checks the field values on DeleteJobResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DeleteJobResponse) Validate() error {
+// Validate checks the field values on UpdateJobSourceConnectionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdateJobSourceConnectionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteJobResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteJobResponseMultiError, or nil if none found.
-func (m *DeleteJobResponse) ValidateAll() error {
+// ValidateAll checks the field values on UpdateJobSourceConnectionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateJobSourceConnectionResponseMultiError, or nil if none found.
+func (m *UpdateJobSourceConnectionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteJobResponse) validate(all bool) error {
+func (m *UpdateJobSourceConnectionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateJobSourceConnectionResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateJobSourceConnectionResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateJobSourceConnectionResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.PendingChange != nil {
+
+		if all {
+			switch v := interface{}(m.GetPendingChange()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateJobSourceConnectionResponseValidationError{
+						field:  "PendingChange",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateJobSourceConnectionResponseValidationError{
+						field:  "PendingChange",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPendingChange()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateJobSourceConnectionResponseValidationError{
+					field:  "PendingChange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return DeleteJobResponseMultiError(errors)
+		return UpdateJobSourceConnectionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteJobResponseMultiError is an error wrapping multiple validation errors
-// returned by DeleteJobResponse.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteJobResponseMultiError []error
+// UpdateJobSourceConnectionResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateJobSourceConnectionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateJobSourceConnectionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteJobResponseMultiError) Error() string {
+func (m UpdateJobSourceConnectionResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7384,11 +7859,12 @@ func (m DeleteJobResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteJobResponseMultiError) AllErrors() []error { return m }
+func (m UpdateJobSourceConnectionResponseMultiError) AllErrors() []error { return m }
 
-// DeleteJobResponseValidationError is the validation error returned by
-// DeleteJobResponse.Validate if the designated constraints aren't met.
-type DeleteJobResponseValidationError struct {
+// UpdateJobSourceConnectionResponseValidationError is the validation error
+// returned by UpdateJobSourceConnectionResponse.Validate if the designated
+// constraints aren't met.
+type UpdateJobSourceConnectionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7396,24 +7872,24 @@ type DeleteJobResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteJobResponseValidationError) Field() string { return e.field }
+func (e UpdateJobSourceConnectionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteJobResponseValidationError) Reason() string { return e.reason }
+func (e UpdateJobSourceConnectionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteJobResponseValidationError) Cause() error { return e.cause }
+func (e UpdateJobSourceConnectionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteJobResponseValidationError) Key() bool { return e.key }
+func (e UpdateJobSourceConnectionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteJobResponseValidationError) ErrorName() string {
-	return "DeleteJobResponseValidationError"
+func (e UpdateJobSourceConnectionResponseValidationError) ErrorName() string {
+	return "UpdateJobSourceConnectionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteJobResponseValidationError) Error() string {
+func (e UpdateJobSourceConnectionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7425,14 +7901,14 @@ func (e DeleteJobResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteJobResponse.%s: %s%s",
+		"invalid %sUpdateJobSourceConnectionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteJobResponseValidationError{}
+var _ error = UpdateJobSourceConnectionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7440,48 +7916,133 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteJobResponseValidationError{}
+} = UpdateJobSourceConnectionResponseValidationError{}
 
-// Validate checks the field values on IsJobNameAvailableRequest with the rules
+// Validate checks the field values on SetJobMappingsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IsJobNameAvailableRequest) Validate() error {
+func (m *SetJobMappingsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IsJobNameAvailableRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SetJobMappingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// IsJobNameAvailableRequestMultiError, or nil if none found.
-func (m *IsJobNameAvailableRequest) ValidateAll() error {
+// SetJobMappingsRequestMultiError, or nil if none found.
+func (m *SetJobMappingsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IsJobNameAvailableRequest) validate(all bool) error {
+func (m *SetJobMappingsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
-
-	// no validation rules for AccountId
-
-	if len(errors) > 0 {
-		return IsJobNameAvailableRequestMultiError(errors)
-	}
+	// no validation rules for Id
 
-	return nil
-}
+	switch v := m.Mode.(type) {
+	case *SetJobMappingsRequest_Replace:
+		if v == nil {
+			err := SetJobMappingsRequestValidationError{
+				field:  "Mode",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// IsJobNameAvailableRequestMultiError is an error wrapping multiple validation
-// errors returned by IsJobNameAvailableRequest.ValidateAll() if the
-// designated constraints aren't met.
-type IsJobNameAvailableRequestMultiError []error
+		if all {
+			switch v := interface{}(m.GetReplace()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SetJobMappingsRequestValidationError{
+						field:  "Replace",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SetJobMappingsRequestValidationError{
+						field:  "Replace",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetReplace()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SetJobMappingsRequestValidationError{
+					field:  "Replace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SetJobMappingsRequest_Patch:
+		if v == nil {
+			err := SetJobMappingsRequestValidationError{
+				field:  "Mode",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPatch()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SetJobMappingsRequestValidationError{
+						field:  "Patch",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SetJobMappingsRequestValidationError{
+						field:  "Patch",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPatch()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SetJobMappingsRequestValidationError{
+					field:  "Patch",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return SetJobMappingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetJobMappingsRequestMultiError is an error wrapping multiple validation
+// errors returned by SetJobMappingsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetJobMappingsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IsJobNameAvailableRequestMultiError) Error() string {
+func (m SetJobMappingsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7490,11 +8051,11 @@ func (m IsJobNameAvailableRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IsJobNameAvailableRequestMultiError) AllErrors() []error { return m }
+func (m SetJobMappingsRequestMultiError) AllErrors() []error { return m }
 
-// IsJobNameAvailableRequestValidationError is the validation error returned by
-// IsJobNameAvailableRequest.Validate if the designated constraints aren't met.
-type IsJobNameAvailableRequestValidationError struct {
+// SetJobMappingsRequestValidationError is the validation error returned by
+// SetJobMappingsRequest.Validate if the designated constraints aren't met.
+type SetJobMappingsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7502,24 +8063,24 @@ type IsJobNameAvailableRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e IsJobNameAvailableRequestValidationError) Field() string { return e.field }
+func (e SetJobMappingsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IsJobNameAvailableRequestValidationError) Reason() string { return e.reason }
+func (e SetJobMappingsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IsJobNameAvailableRequestValidationError) Cause() error { return e.cause }
+func (e SetJobMappingsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IsJobNameAvailableRequestValidationError) Key() bool { return e.key }
+func (e SetJobMappingsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IsJobNameAvailableRequestValidationError) ErrorName() string {
-	return "IsJobNameAvailableRequestValidationError"
+func (e SetJobMappingsRequestValidationError) ErrorName() string {
+	return "SetJobMappingsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IsJobNameAvailableRequestValidationError) Error() string {
+func (e SetJobMappingsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7531,14 +8092,14 @@ func (e IsJobNameAvailableRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIsJobNameAvailableRequest.%s: %s%s",
+		"invalid %sSetJobMappingsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IsJobNameAvailableRequestValidationError{}
+var _ error = SetJobMappingsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7546,46 +8107,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IsJobNameAvailableRequestValidationError{}
+} = SetJobMappingsRequestValidationError{}
 
-// Validate checks the field values on IsJobNameAvailableResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on JobMappingsReplace with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IsJobNameAvailableResponse) Validate() error {
+func (m *JobMappingsReplace) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IsJobNameAvailableResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on JobMappingsReplace with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// IsJobNameAvailableResponseMultiError, or nil if none found.
-func (m *IsJobNameAvailableResponse) ValidateAll() error {
+// JobMappingsReplaceMultiError, or nil if none found.
+func (m *JobMappingsReplace) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IsJobNameAvailableResponse) validate(all bool) error {
+func (m *JobMappingsReplace) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for IsAvailable
+	for idx, item := range m.GetMappings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobMappingsReplaceValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobMappingsReplaceValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobMappingsReplaceValidationError{
+					field:  fmt.Sprintf("Mappings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return IsJobNameAvailableResponseMultiError(errors)
+		return JobMappingsReplaceMultiError(errors)
 	}
 
 	return nil
 }
 
-// IsJobNameAvailableResponseMultiError is an error wrapping multiple
-// validation errors returned by IsJobNameAvailableResponse.ValidateAll() if
-// the designated constraints aren't met.
-type IsJobNameAvailableResponseMultiError []error
+// JobMappingsReplaceMultiError is an error wrapping multiple validation errors
+// returned by JobMappingsReplace.ValidateAll() if the designated constraints
+// aren't met.
+type JobMappingsReplaceMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IsJobNameAvailableResponseMultiError) Error() string {
+func (m JobMappingsReplaceMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7594,11 +8187,11 @@ func (m IsJobNameAvailableResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IsJobNameAvailableResponseMultiError) AllErrors() []error { return m }
+func (m JobMappingsReplaceMultiError) AllErrors() []error { return m }
 
-// IsJobNameAvailableResponseValidationError is the validation error returned
-// by IsJobNameAvailableResponse.Validate if the designated constraints aren't met.
-type IsJobNameAvailableResponseValidationError struct {
+// JobMappingsReplaceValidationError is the validation error returned by
+// JobMappingsReplace.Validate if the designated constraints aren't met.
+type JobMappingsReplaceValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7606,24 +8199,24 @@ type IsJobNameAvailableResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e IsJobNameAvailableResponseValidationError) Field() string { return e.field }
+func (e JobMappingsReplaceValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IsJobNameAvailableResponseValidationError) Reason() string { return e.reason }
+func (e JobMappingsReplaceValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IsJobNameAvailableResponseValidationError) Cause() error { return e.cause }
+func (e JobMappingsReplaceValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IsJobNameAvailableResponseValidationError) Key() bool { return e.key }
+func (e JobMappingsReplaceValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IsJobNameAvailableResponseValidationError) ErrorName() string {
-	return "IsJobNameAvailableResponseValidationError"
+func (e JobMappingsReplaceValidationError) ErrorName() string {
+	return "JobMappingsReplaceValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IsJobNameAvailableResponseValidationError) Error() string {
+func (e JobMappingsReplaceValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7635,14 +8228,14 @@ func (e IsJobNameAvailableResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIsJobNameAvailableResponse.%s: %s%s",
+		"invalid %sJobMappingsReplace.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IsJobNameAvailableResponseValidationError{}
+var _ error = JobMappingsReplaceValidationError{}
 
 var _ interface {
 	Field() string
@@ -7650,73 +8243,112 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IsJobNameAvailableResponseValidationError{}
+} = JobMappingsReplaceValidationError{}
 
-// Validate checks the field values on GetJobRunsRequest with the rules defined
+// Validate checks the field values on JobMappingsPatch with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRunsRequest) Validate() error {
+func (m *JobMappingsPatch) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRunsRequest with the rules
+// ValidateAll checks the field values on JobMappingsPatch with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobRunsRequestMultiError, or nil if none found.
-func (m *GetJobRunsRequest) ValidateAll() error {
+// JobMappingsPatchMultiError, or nil if none found.
+func (m *JobMappingsPatch) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRunsRequest) validate(all bool) error {
+func (m *JobMappingsPatch) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Id.(type) {
-	case *GetJobRunsRequest_JobId:
-		if v == nil {
-			err := GetJobRunsRequestValidationError{
-				field:  "Id",
-				reason: "oneof value cannot be a typed-nil",
+	for idx, item := range m.GetUpserts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobMappingsPatchValidationError{
+						field:  fmt.Sprintf("Upserts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobMappingsPatchValidationError{
+						field:  fmt.Sprintf("Upserts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-			if !all {
-				return err
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobMappingsPatchValidationError{
+					field:  fmt.Sprintf("Upserts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
-			errors = append(errors, err)
 		}
-		// no validation rules for JobId
-	case *GetJobRunsRequest_AccountId:
-		if v == nil {
-			err := GetJobRunsRequestValidationError{
-				field:  "Id",
-				reason: "oneof value cannot be a typed-nil",
+
+	}
+
+	for idx, item := range m.GetRemovals() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobMappingsPatchValidationError{
+						field:  fmt.Sprintf("Removals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobMappingsPatchValidationError{
+						field:  fmt.Sprintf("Removals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-			if !all {
-				return err
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobMappingsPatchValidationError{
+					field:  fmt.Sprintf("Removals[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
-			errors = append(errors, err)
 		}
-		// no validation rules for AccountId
-	default:
-		_ = v // ensures v is used
+
 	}
 
 	if len(errors) > 0 {
-		return GetJobRunsRequestMultiError(errors)
+		return JobMappingsPatchMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRunsRequestMultiError is an error wrapping multiple validation errors
-// returned by GetJobRunsRequest.ValidateAll() if the designated constraints
+// JobMappingsPatchMultiError is an error wrapping multiple validation errors
+// returned by JobMappingsPatch.ValidateAll() if the designated constraints
 // aren't met.
-type GetJobRunsRequestMultiError []error
+type JobMappingsPatchMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRunsRequestMultiError) Error() string {
+func (m JobMappingsPatchMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7725,11 +8357,11 @@ func (m GetJobRunsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRunsRequestMultiError) AllErrors() []error { return m }
+func (m JobMappingsPatchMultiError) AllErrors() []error { return m }
 
-// GetJobRunsRequestValidationError is the validation error returned by
-// GetJobRunsRequest.Validate if the designated constraints aren't met.
-type GetJobRunsRequestValidationError struct {
+// JobMappingsPatchValidationError is the validation error returned by
+// JobMappingsPatch.Validate if the designated constraints aren't met.
+type JobMappingsPatchValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7737,24 +8369,22 @@ type GetJobRunsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRunsRequestValidationError) Field() string { return e.field }
+func (e JobMappingsPatchValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRunsRequestValidationError) Reason() string { return e.reason }
+func (e JobMappingsPatchValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRunsRequestValidationError) Cause() error { return e.cause }
+func (e JobMappingsPatchValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRunsRequestValidationError) Key() bool { return e.key }
+func (e JobMappingsPatchValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRunsRequestValidationError) ErrorName() string {
-	return "GetJobRunsRequestValidationError"
-}
+func (e JobMappingsPatchValidationError) ErrorName() string { return "JobMappingsPatchValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetJobRunsRequestValidationError) Error() string {
+func (e JobMappingsPatchValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7766,14 +8396,14 @@ func (e GetJobRunsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRunsRequest.%s: %s%s",
+		"invalid %sJobMappingsPatch.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRunsRequestValidationError{}
+var _ error = JobMappingsPatchValidationError{}
 
 var _ interface {
 	Field() string
@@ -7781,78 +8411,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRunsRequestValidationError{}
+} = JobMappingsPatchValidationError{}
 
-// Validate checks the field values on GetJobRunsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRunsResponse) Validate() error {
+// Validate checks the field values on JobMappingColumn with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *JobMappingColumn) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRunsResponse with the rules
+// ValidateAll checks the field values on JobMappingColumn with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobRunsResponseMultiError, or nil if none found.
-func (m *GetJobRunsResponse) ValidateAll() error {
+// JobMappingColumnMultiError, or nil if none found.
+func (m *JobMappingColumn) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRunsResponse) validate(all bool) error {
+func (m *JobMappingColumn) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetJobRuns() {
-		_, _ = idx, item
+	// no validation rules for Schema
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetJobRunsResponseValidationError{
-						field:  fmt.Sprintf("JobRuns[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetJobRunsResponseValidationError{
-						field:  fmt.Sprintf("JobRuns[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetJobRunsResponseValidationError{
-					field:  fmt.Sprintf("JobRuns[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Table
 
-	}
+	// no validation rules for Column
 
 	if len(errors) > 0 {
-		return GetJobRunsResponseMultiError(errors)
+		return JobMappingColumnMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRunsResponseMultiError is an error wrapping multiple validation errors
-// returned by GetJobRunsResponse.ValidateAll() if the designated constraints
+// JobMappingColumnMultiError is an error wrapping multiple validation errors
+// returned by JobMappingColumn.ValidateAll() if the designated constraints
 // aren't met.
-type GetJobRunsResponseMultiError []error
+type JobMappingColumnMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRunsResponseMultiError) Error() string {
+func (m JobMappingColumnMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7861,11 +8463,11 @@ func (m GetJobRunsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRunsResponseMultiError) AllErrors() []error { return m }
+func (m JobMappingColumnMultiError) AllErrors() []error { return m }
 
-// GetJobRunsResponseValidationError is the validation error returned by
-// GetJobRunsResponse.Validate if the designated constraints aren't met.
-type GetJobRunsResponseValidationError struct {
+// JobMappingColumnValidationError is the validation error returned by
+// JobMappingColumn.Validate if the designated constraints aren't met.
+type JobMappingColumnValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7873,24 +8475,22 @@ type GetJobRunsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRunsResponseValidationError) Field() string { return e.field }
+func (e JobMappingColumnValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRunsResponseValidationError) Reason() string { return e.reason }
+func (e JobMappingColumnValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRunsResponseValidationError) Cause() error { return e.cause }
+func (e JobMappingColumnValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRunsResponseValidationError) Key() bool { return e.key }
+func (e JobMappingColumnValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRunsResponseValidationError) ErrorName() string {
-	return "GetJobRunsResponseValidationError"
-}
+func (e JobMappingColumnValidationError) ErrorName() string { return "JobMappingColumnValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetJobRunsResponseValidationError) Error() string {
+func (e JobMappingColumnValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7902,14 +8502,14 @@ func (e GetJobRunsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRunsResponse.%s: %s%s",
+		"invalid %sJobMappingColumn.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRunsResponseValidationError{}
+var _ error = JobMappingColumnValidationError{}
 
 var _ interface {
 	Field() string
@@ -7917,48 +8517,106 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRunsResponseValidationError{}
+} = JobMappingColumnValidationError{}
 
-// Validate checks the field values on GetJobRunRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRunRequest) Validate() error {
+// Validate checks the field values on SetJobMappingsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetJobMappingsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRunRequest with the rules
+// ValidateAll checks the field values on SetJobMappingsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobRunRequestMultiError, or nil if none found.
-func (m *GetJobRunRequest) ValidateAll() error {
+// SetJobMappingsResponseMultiError, or nil if none found.
+func (m *SetJobMappingsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRunRequest) validate(all bool) error {
+func (m *SetJobMappingsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobRunId
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetJobMappingsResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetJobMappingsResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetJobMappingsResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for AccountId
+	if m.PendingChange != nil {
+
+		if all {
+			switch v := interface{}(m.GetPendingChange()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SetJobMappingsResponseValidationError{
+						field:  "PendingChange",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SetJobMappingsResponseValidationError{
+						field:  "PendingChange",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPendingChange()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SetJobMappingsResponseValidationError{
+					field:  "PendingChange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return GetJobRunRequestMultiError(errors)
+		return SetJobMappingsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRunRequestMultiError is an error wrapping multiple validation errors
-// returned by GetJobRunRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetJobRunRequestMultiError []error
+// SetJobMappingsResponseMultiError is an error wrapping multiple validation
+// errors returned by SetJobMappingsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetJobMappingsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRunRequestMultiError) Error() string {
+func (m SetJobMappingsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7967,11 +8625,11 @@ func (m GetJobRunRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRunRequestMultiError) AllErrors() []error { return m }
+func (m SetJobMappingsResponseMultiError) AllErrors() []error { return m }
 
-// GetJobRunRequestValidationError is the validation error returned by
-// GetJobRunRequest.Validate if the designated constraints aren't met.
-type GetJobRunRequestValidationError struct {
+// SetJobMappingsResponseValidationError is the validation error returned by
+// SetJobMappingsResponse.Validate if the designated constraints aren't met.
+type SetJobMappingsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7979,22 +8637,24 @@ type GetJobRunRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRunRequestValidationError) Field() string { return e.field }
+func (e SetJobMappingsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRunRequestValidationError) Reason() string { return e.reason }
+func (e SetJobMappingsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRunRequestValidationError) Cause() error { return e.cause }
+func (e SetJobMappingsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRunRequestValidationError) Key() bool { return e.key }
+func (e SetJobMappingsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRunRequestValidationError) ErrorName() string { return "GetJobRunRequestValidationError" }
+func (e SetJobMappingsResponseValidationError) ErrorName() string {
+	return "SetJobMappingsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetJobRunRequestValidationError) Error() string {
+func (e SetJobMappingsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8006,14 +8666,14 @@ func (e GetJobRunRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRunRequest.%s: %s%s",
+		"invalid %sSetJobMappingsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRunRequestValidationError{}
+var _ error = SetJobMappingsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8021,73 +8681,179 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRunRequestValidationError{}
+} = SetJobMappingsResponseValidationError{}
 
-// Validate checks the field values on GetJobRunResponse with the rules defined
+// Validate checks the field values on JobChangeRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRunResponse) Validate() error {
+func (m *JobChangeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRunResponse with the rules
+// ValidateAll checks the field values on JobChangeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobRunResponseMultiError, or nil if none found.
-func (m *GetJobRunResponse) ValidateAll() error {
+// JobChangeRequestMultiError, or nil if none found.
+func (m *JobChangeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRunResponse) validate(all bool) error {
+func (m *JobChangeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	// no validation rules for JobId
+
 	if all {
-		switch v := interface{}(m.GetJobRun()).(type) {
+		switch v := interface{}(m.GetSource()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetJobRunResponseValidationError{
-					field:  "JobRun",
+				errors = append(errors, JobChangeRequestValidationError{
+					field:  "Source",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetJobRunResponseValidationError{
-					field:  "JobRun",
+				errors = append(errors, JobChangeRequestValidationError{
+					field:  "Source",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetJobRun()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSource()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetJobRunResponseValidationError{
-				field:  "JobRun",
+			return JobChangeRequestValidationError{
+				field:  "Source",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetMappings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobChangeRequestValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobChangeRequestValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobChangeRequestValidationError{
+					field:  fmt.Sprintf("Mappings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for CreatedByUserId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobChangeRequestValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobChangeRequestValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobChangeRequestValidationError{
+				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	if m.ApprovedByUserId != nil {
+		// no validation rules for ApprovedByUserId
+	}
+
+	if m.ApprovedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetApprovedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobChangeRequestValidationError{
+						field:  "ApprovedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobChangeRequestValidationError{
+						field:  "ApprovedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetApprovedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobChangeRequestValidationError{
+					field:  "ApprovedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return GetJobRunResponseMultiError(errors)
+		return JobChangeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRunResponseMultiError is an error wrapping multiple validation errors
-// returned by GetJobRunResponse.ValidateAll() if the designated constraints
+// JobChangeRequestMultiError is an error wrapping multiple validation errors
+// returned by JobChangeRequest.ValidateAll() if the designated constraints
 // aren't met.
-type GetJobRunResponseMultiError []error
+type JobChangeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRunResponseMultiError) Error() string {
+func (m JobChangeRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8096,11 +8862,11 @@ func (m GetJobRunResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRunResponseMultiError) AllErrors() []error { return m }
+func (m JobChangeRequestMultiError) AllErrors() []error { return m }
 
-// GetJobRunResponseValidationError is the validation error returned by
-// GetJobRunResponse.Validate if the designated constraints aren't met.
-type GetJobRunResponseValidationError struct {
+// JobChangeRequestValidationError is the validation error returned by
+// JobChangeRequest.Validate if the designated constraints aren't met.
+type JobChangeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8108,24 +8874,22 @@ type GetJobRunResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRunResponseValidationError) Field() string { return e.field }
+func (e JobChangeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRunResponseValidationError) Reason() string { return e.reason }
+func (e JobChangeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRunResponseValidationError) Cause() error { return e.cause }
+func (e JobChangeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRunResponseValidationError) Key() bool { return e.key }
+func (e JobChangeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRunResponseValidationError) ErrorName() string {
-	return "GetJobRunResponseValidationError"
-}
+func (e JobChangeRequestValidationError) ErrorName() string { return "JobChangeRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetJobRunResponseValidationError) Error() string {
+func (e JobChangeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8137,14 +8901,14 @@ func (e GetJobRunResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRunResponse.%s: %s%s",
+		"invalid %sJobChangeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRunResponseValidationError{}
+var _ error = JobChangeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8152,24 +8916,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRunResponseValidationError{}
+} = JobChangeRequestValidationError{}
 
-// Validate checks the field values on CreateJobRunRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetJobChangeRequestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateJobRunRequest) Validate() error {
+func (m *GetJobChangeRequestsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateJobRunRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetJobChangeRequestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateJobRunRequestMultiError, or nil if none found.
-func (m *CreateJobRunRequest) ValidateAll() error {
+// GetJobChangeRequestsRequestMultiError, or nil if none found.
+func (m *GetJobChangeRequestsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateJobRunRequest) validate(all bool) error {
+func (m *GetJobChangeRequestsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8179,19 +8943,19 @@ func (m *CreateJobRunRequest) validate(all bool) error {
 	// no validation rules for JobId
 
 	if len(errors) > 0 {
-		return CreateJobRunRequestMultiError(errors)
+		return GetJobChangeRequestsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateJobRunRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateJobRunRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateJobRunRequestMultiError []error
+// GetJobChangeRequestsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetJobChangeRequestsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetJobChangeRequestsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateJobRunRequestMultiError) Error() string {
+func (m GetJobChangeRequestsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8200,11 +8964,12 @@ func (m CreateJobRunRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateJobRunRequestMultiError) AllErrors() []error { return m }
+func (m GetJobChangeRequestsRequestMultiError) AllErrors() []error { return m }
 
-// CreateJobRunRequestValidationError is the validation error returned by
-// CreateJobRunRequest.Validate if the designated constraints aren't met.
-type CreateJobRunRequestValidationError struct {
+// GetJobChangeRequestsRequestValidationError is the validation error returned
+// by GetJobChangeRequestsRequest.Validate if the designated constraints
+// aren't met.
+type GetJobChangeRequestsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8212,24 +8977,24 @@ type CreateJobRunRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateJobRunRequestValidationError) Field() string { return e.field }
+func (e GetJobChangeRequestsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateJobRunRequestValidationError) Reason() string { return e.reason }
+func (e GetJobChangeRequestsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateJobRunRequestValidationError) Cause() error { return e.cause }
+func (e GetJobChangeRequestsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateJobRunRequestValidationError) Key() bool { return e.key }
+func (e GetJobChangeRequestsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateJobRunRequestValidationError) ErrorName() string {
-	return "CreateJobRunRequestValidationError"
+func (e GetJobChangeRequestsRequestValidationError) ErrorName() string {
+	return "GetJobChangeRequestsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateJobRunRequestValidationError) Error() string {
+func (e GetJobChangeRequestsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8241,14 +9006,14 @@ func (e CreateJobRunRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateJobRunRequest.%s: %s%s",
+		"invalid %sGetJobChangeRequestsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateJobRunRequestValidationError{}
+var _ error = GetJobChangeRequestsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8256,44 +9021,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateJobRunRequestValidationError{}
+} = GetJobChangeRequestsRequestValidationError{}
 
-// Validate checks the field values on CreateJobRunResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetJobChangeRequestsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateJobRunResponse) Validate() error {
+func (m *GetJobChangeRequestsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateJobRunResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetJobChangeRequestsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateJobRunResponseMultiError, or nil if none found.
-func (m *CreateJobRunResponse) ValidateAll() error {
+// GetJobChangeRequestsResponseMultiError, or nil if none found.
+func (m *GetJobChangeRequestsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateJobRunResponse) validate(all bool) error {
+func (m *GetJobChangeRequestsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetChangeRequests() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJobChangeRequestsResponseValidationError{
+						field:  fmt.Sprintf("ChangeRequests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJobChangeRequestsResponseValidationError{
+						field:  fmt.Sprintf("ChangeRequests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJobChangeRequestsResponseValidationError{
+					field:  fmt.Sprintf("ChangeRequests[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return CreateJobRunResponseMultiError(errors)
+		return GetJobChangeRequestsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateJobRunResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateJobRunResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateJobRunResponseMultiError []error
+// GetJobChangeRequestsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetJobChangeRequestsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetJobChangeRequestsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateJobRunResponseMultiError) Error() string {
+func (m GetJobChangeRequestsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8302,11 +9101,12 @@ func (m CreateJobRunResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateJobRunResponseMultiError) AllErrors() []error { return m }
+func (m GetJobChangeRequestsResponseMultiError) AllErrors() []error { return m }
 
-// CreateJobRunResponseValidationError is the validation error returned by
-// CreateJobRunResponse.Validate if the designated constraints aren't met.
-type CreateJobRunResponseValidationError struct {
+// GetJobChangeRequestsResponseValidationError is the validation error returned
+// by GetJobChangeRequestsResponse.Validate if the designated constraints
+// aren't met.
+type GetJobChangeRequestsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8314,24 +9114,24 @@ type CreateJobRunResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateJobRunResponseValidationError) Field() string { return e.field }
+func (e GetJobChangeRequestsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateJobRunResponseValidationError) Reason() string { return e.reason }
+func (e GetJobChangeRequestsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateJobRunResponseValidationError) Cause() error { return e.cause }
+func (e GetJobChangeRequestsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateJobRunResponseValidationError) Key() bool { return e.key }
+func (e GetJobChangeRequestsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateJobRunResponseValidationError) ErrorName() string {
-	return "CreateJobRunResponseValidationError"
+func (e GetJobChangeRequestsResponseValidationError) ErrorName() string {
+	return "GetJobChangeRequestsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateJobRunResponseValidationError) Error() string {
+func (e GetJobChangeRequestsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8343,14 +9143,14 @@ func (e CreateJobRunResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateJobRunResponse.%s: %s%s",
+		"invalid %sGetJobChangeRequestsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateJobRunResponseValidationError{}
+var _ error = GetJobChangeRequestsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8358,48 +9158,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateJobRunResponseValidationError{}
+} = GetJobChangeRequestsResponseValidationError{}
 
-// Validate checks the field values on CancelJobRunRequest with the rules
+// Validate checks the field values on ApproveJobChangeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelJobRunRequest) Validate() error {
+func (m *ApproveJobChangeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelJobRunRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ApproveJobChangeRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CancelJobRunRequestMultiError, or nil if none found.
-func (m *CancelJobRunRequest) ValidateAll() error {
+// ApproveJobChangeRequestMultiError, or nil if none found.
+func (m *ApproveJobChangeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelJobRunRequest) validate(all bool) error {
+func (m *ApproveJobChangeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobRunId
-
-	// no validation rules for AccountId
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return CancelJobRunRequestMultiError(errors)
+		return ApproveJobChangeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelJobRunRequestMultiError is an error wrapping multiple validation
-// errors returned by CancelJobRunRequest.ValidateAll() if the designated
+// ApproveJobChangeRequestMultiError is an error wrapping multiple validation
+// errors returned by ApproveJobChangeRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CancelJobRunRequestMultiError []error
+type ApproveJobChangeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelJobRunRequestMultiError) Error() string {
+func (m ApproveJobChangeRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8408,11 +9206,11 @@ func (m CancelJobRunRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelJobRunRequestMultiError) AllErrors() []error { return m }
+func (m ApproveJobChangeRequestMultiError) AllErrors() []error { return m }
 
-// CancelJobRunRequestValidationError is the validation error returned by
-// CancelJobRunRequest.Validate if the designated constraints aren't met.
-type CancelJobRunRequestValidationError struct {
+// ApproveJobChangeRequestValidationError is the validation error returned by
+// ApproveJobChangeRequest.Validate if the designated constraints aren't met.
+type ApproveJobChangeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8420,24 +9218,24 @@ type CancelJobRunRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelJobRunRequestValidationError) Field() string { return e.field }
+func (e ApproveJobChangeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelJobRunRequestValidationError) Reason() string { return e.reason }
+func (e ApproveJobChangeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelJobRunRequestValidationError) Cause() error { return e.cause }
+func (e ApproveJobChangeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelJobRunRequestValidationError) Key() bool { return e.key }
+func (e ApproveJobChangeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelJobRunRequestValidationError) ErrorName() string {
-	return "CancelJobRunRequestValidationError"
+func (e ApproveJobChangeRequestValidationError) ErrorName() string {
+	return "ApproveJobChangeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CancelJobRunRequestValidationError) Error() string {
+func (e ApproveJobChangeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8449,14 +9247,14 @@ func (e CancelJobRunRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelJobRunRequest.%s: %s%s",
+		"invalid %sApproveJobChangeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelJobRunRequestValidationError{}
+var _ error = ApproveJobChangeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8464,44 +9262,102 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelJobRunRequestValidationError{}
+} = ApproveJobChangeRequestValidationError{}
 
-// Validate checks the field values on CancelJobRunResponse with the rules
+// Validate checks the field values on ApproveJobChangeResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelJobRunResponse) Validate() error {
+func (m *ApproveJobChangeResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelJobRunResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ApproveJobChangeResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CancelJobRunResponseMultiError, or nil if none found.
-func (m *CancelJobRunResponse) ValidateAll() error {
+// ApproveJobChangeResponseMultiError, or nil if none found.
+func (m *ApproveJobChangeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelJobRunResponse) validate(all bool) error {
+func (m *ApproveJobChangeResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApproveJobChangeResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApproveJobChangeResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApproveJobChangeResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetChangeRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApproveJobChangeResponseValidationError{
+					field:  "ChangeRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApproveJobChangeResponseValidationError{
+					field:  "ChangeRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChangeRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApproveJobChangeResponseValidationError{
+				field:  "ChangeRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return CancelJobRunResponseMultiError(errors)
+		return ApproveJobChangeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelJobRunResponseMultiError is an error wrapping multiple validation
-// errors returned by CancelJobRunResponse.ValidateAll() if the designated
+// ApproveJobChangeResponseMultiError is an error wrapping multiple validation
+// errors returned by ApproveJobChangeResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CancelJobRunResponseMultiError []error
+type ApproveJobChangeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelJobRunResponseMultiError) Error() string {
+func (m ApproveJobChangeResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8510,11 +9366,11 @@ func (m CancelJobRunResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelJobRunResponseMultiError) AllErrors() []error { return m }
+func (m ApproveJobChangeResponseMultiError) AllErrors() []error { return m }
 
-// CancelJobRunResponseValidationError is the validation error returned by
-// CancelJobRunResponse.Validate if the designated constraints aren't met.
-type CancelJobRunResponseValidationError struct {
+// ApproveJobChangeResponseValidationError is the validation error returned by
+// ApproveJobChangeResponse.Validate if the designated constraints aren't met.
+type ApproveJobChangeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8522,24 +9378,24 @@ type CancelJobRunResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelJobRunResponseValidationError) Field() string { return e.field }
+func (e ApproveJobChangeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelJobRunResponseValidationError) Reason() string { return e.reason }
+func (e ApproveJobChangeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelJobRunResponseValidationError) Cause() error { return e.cause }
+func (e ApproveJobChangeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelJobRunResponseValidationError) Key() bool { return e.key }
+func (e ApproveJobChangeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelJobRunResponseValidationError) ErrorName() string {
-	return "CancelJobRunResponseValidationError"
+func (e ApproveJobChangeResponseValidationError) ErrorName() string {
+	return "ApproveJobChangeResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CancelJobRunResponseValidationError) Error() string {
+func (e ApproveJobChangeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8551,14 +9407,14 @@ func (e CancelJobRunResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelJobRunResponse.%s: %s%s",
+		"invalid %sApproveJobChangeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelJobRunResponseValidationError{}
+var _ error = ApproveJobChangeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8566,269 +9422,12012 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelJobRunResponseValidationError{}
+} = ApproveJobChangeResponseValidationError{}
 
-// Validate checks the field values on Job with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *Job) Validate() error {
+// Validate checks the field values on PostgresSourceSchemaSubset with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PostgresSourceSchemaSubset) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Job with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in JobMultiError, or nil if none found.
-func (m *Job) ValidateAll() error {
+// ValidateAll checks the field values on PostgresSourceSchemaSubset with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PostgresSourceSchemaSubsetMultiError, or nil if none found.
+func (m *PostgresSourceSchemaSubset) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Job) validate(all bool) error {
+func (m *PostgresSourceSchemaSubset) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for CreatedByUserId
+	for idx, item := range m.GetPostgresSchemas() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PostgresSourceSchemaSubsetValidationError{
+						field:  fmt.Sprintf("PostgresSchemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PostgresSourceSchemaSubsetValidationError{
+						field:  fmt.Sprintf("PostgresSchemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "CreatedAt",
+				return PostgresSourceSchemaSubsetValidationError{
+					field:  fmt.Sprintf("PostgresSchemas[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
+				}
 			}
 		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobValidationError{
-				field:  "CreatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
+
+	}
+
+	if len(errors) > 0 {
+		return PostgresSourceSchemaSubsetMultiError(errors)
+	}
+
+	return nil
+}
+
+// PostgresSourceSchemaSubsetMultiError is an error wrapping multiple
+// validation errors returned by PostgresSourceSchemaSubset.ValidateAll() if
+// the designated constraints aren't met.
+type PostgresSourceSchemaSubsetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PostgresSourceSchemaSubsetMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PostgresSourceSchemaSubsetMultiError) AllErrors() []error { return m }
+
+// PostgresSourceSchemaSubsetValidationError is the validation error returned
+// by PostgresSourceSchemaSubset.Validate if the designated constraints aren't met.
+type PostgresSourceSchemaSubsetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PostgresSourceSchemaSubsetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PostgresSourceSchemaSubsetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PostgresSourceSchemaSubsetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PostgresSourceSchemaSubsetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PostgresSourceSchemaSubsetValidationError) ErrorName() string {
+	return "PostgresSourceSchemaSubsetValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PostgresSourceSchemaSubsetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPostgresSourceSchemaSubset.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PostgresSourceSchemaSubsetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PostgresSourceSchemaSubsetValidationError{}
+
+// Validate checks the field values on MysqlSourceSchemaSubset with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MysqlSourceSchemaSubset) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MysqlSourceSchemaSubset with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MysqlSourceSchemaSubsetMultiError, or nil if none found.
+func (m *MysqlSourceSchemaSubset) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MysqlSourceSchemaSubset) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMysqlSchemas() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MysqlSourceSchemaSubsetValidationError{
+						field:  fmt.Sprintf("MysqlSchemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MysqlSourceSchemaSubsetValidationError{
+						field:  fmt.Sprintf("MysqlSchemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MysqlSourceSchemaSubsetValidationError{
+					field:  fmt.Sprintf("MysqlSchemas[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MysqlSourceSchemaSubsetMultiError(errors)
+	}
+
+	return nil
+}
+
+// MysqlSourceSchemaSubsetMultiError is an error wrapping multiple validation
+// errors returned by MysqlSourceSchemaSubset.ValidateAll() if the designated
+// constraints aren't met.
+type MysqlSourceSchemaSubsetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MysqlSourceSchemaSubsetMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MysqlSourceSchemaSubsetMultiError) AllErrors() []error { return m }
+
+// MysqlSourceSchemaSubsetValidationError is the validation error returned by
+// MysqlSourceSchemaSubset.Validate if the designated constraints aren't met.
+type MysqlSourceSchemaSubsetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MysqlSourceSchemaSubsetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MysqlSourceSchemaSubsetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MysqlSourceSchemaSubsetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MysqlSourceSchemaSubsetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MysqlSourceSchemaSubsetValidationError) ErrorName() string {
+	return "MysqlSourceSchemaSubsetValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MysqlSourceSchemaSubsetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMysqlSourceSchemaSubset.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MysqlSourceSchemaSubsetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MysqlSourceSchemaSubsetValidationError{}
+
+// Validate checks the field values on JobSourceSqlSubetSchemas with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobSourceSqlSubetSchemas) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobSourceSqlSubetSchemas with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobSourceSqlSubetSchemasMultiError, or nil if none found.
+func (m *JobSourceSqlSubetSchemas) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobSourceSqlSubetSchemas) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Schemas.(type) {
+	case *JobSourceSqlSubetSchemas_PostgresSubset:
+		if v == nil {
+			err := JobSourceSqlSubetSchemasValidationError{
+				field:  "Schemas",
+				reason: "oneof value cannot be a typed-nil",
 			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
+
+		if all {
+			switch v := interface{}(m.GetPostgresSubset()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
+						field:  "PostgresSubset",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
+						field:  "PostgresSubset",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPostgresSubset()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobSourceSqlSubetSchemasValidationError{
+					field:  "PostgresSubset",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *JobSourceSqlSubetSchemas_MysqlSubset:
+		if v == nil {
+			err := JobSourceSqlSubetSchemasValidationError{
+				field:  "Schemas",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMysqlSubset()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
+						field:  "MysqlSubset",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
+						field:  "MysqlSubset",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMysqlSubset()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobSourceSqlSubetSchemasValidationError{
+					field:  "MysqlSubset",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return JobSourceSqlSubetSchemasMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobSourceSqlSubetSchemasMultiError is an error wrapping multiple validation
+// errors returned by JobSourceSqlSubetSchemas.ValidateAll() if the designated
+// constraints aren't met.
+type JobSourceSqlSubetSchemasMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobSourceSqlSubetSchemasMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobSourceSqlSubetSchemasMultiError) AllErrors() []error { return m }
+
+// JobSourceSqlSubetSchemasValidationError is the validation error returned by
+// JobSourceSqlSubetSchemas.Validate if the designated constraints aren't met.
+type JobSourceSqlSubetSchemasValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobSourceSqlSubetSchemasValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobSourceSqlSubetSchemasValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobSourceSqlSubetSchemasValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobSourceSqlSubetSchemasValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobSourceSqlSubetSchemasValidationError) ErrorName() string {
+	return "JobSourceSqlSubetSchemasValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e JobSourceSqlSubetSchemasValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobSourceSqlSubetSchemas.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobSourceSqlSubetSchemasValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobSourceSqlSubetSchemasValidationError{}
+
+// Validate checks the field values on SetJobSourceSqlConnectionSubsetsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SetJobSourceSqlConnectionSubsetsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// SetJobSourceSqlConnectionSubsetsRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// SetJobSourceSqlConnectionSubsetsRequestMultiError, or nil if none found.
+func (m *SetJobSourceSqlConnectionSubsetsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetJobSourceSqlConnectionSubsetsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetSchemas()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetJobSourceSqlConnectionSubsetsRequestValidationError{
+					field:  "Schemas",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetJobSourceSqlConnectionSubsetsRequestValidationError{
+					field:  "Schemas",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchemas()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetJobSourceSqlConnectionSubsetsRequestValidationError{
+				field:  "Schemas",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SubsetByForeignKeyConstraints
+
+	if len(errors) > 0 {
+		return SetJobSourceSqlConnectionSubsetsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetJobSourceSqlConnectionSubsetsRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// SetJobSourceSqlConnectionSubsetsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetJobSourceSqlConnectionSubsetsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetJobSourceSqlConnectionSubsetsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetJobSourceSqlConnectionSubsetsRequestMultiError) AllErrors() []error { return m }
+
+// SetJobSourceSqlConnectionSubsetsRequestValidationError is the validation
+// error returned by SetJobSourceSqlConnectionSubsetsRequest.Validate if the
+// designated constraints aren't met.
+type SetJobSourceSqlConnectionSubsetsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) ErrorName() string {
+	return "SetJobSourceSqlConnectionSubsetsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetJobSourceSqlConnectionSubsetsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetJobSourceSqlConnectionSubsetsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetJobSourceSqlConnectionSubsetsRequestValidationError{}
+
+// Validate checks the field values on SetJobSourceSqlConnectionSubsetsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SetJobSourceSqlConnectionSubsetsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// SetJobSourceSqlConnectionSubsetsResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// SetJobSourceSqlConnectionSubsetsResponseMultiError, or nil if none found.
+func (m *SetJobSourceSqlConnectionSubsetsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetJobSourceSqlConnectionSubsetsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetJobSourceSqlConnectionSubsetsResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetJobSourceSqlConnectionSubsetsResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetJobSourceSqlConnectionSubsetsResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetJobSourceSqlConnectionSubsetsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetJobSourceSqlConnectionSubsetsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// SetJobSourceSqlConnectionSubsetsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetJobSourceSqlConnectionSubsetsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetJobSourceSqlConnectionSubsetsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetJobSourceSqlConnectionSubsetsResponseMultiError) AllErrors() []error { return m }
+
+// SetJobSourceSqlConnectionSubsetsResponseValidationError is the validation
+// error returned by SetJobSourceSqlConnectionSubsetsResponse.Validate if the
+// designated constraints aren't met.
+type SetJobSourceSqlConnectionSubsetsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) ErrorName() string {
+	return "SetJobSourceSqlConnectionSubsetsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetJobSourceSqlConnectionSubsetsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetJobSourceSqlConnectionSubsetsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetJobSourceSqlConnectionSubsetsResponseValidationError{}
+
+// Validate checks the field values on UpdateJobDestinationConnectionRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *UpdateJobDestinationConnectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateJobDestinationConnectionRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateJobDestinationConnectionRequestMultiError, or nil if none found.
+func (m *UpdateJobDestinationConnectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateJobDestinationConnectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobId
+
+	// no validation rules for ConnectionId
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateJobDestinationConnectionRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateJobDestinationConnectionRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateJobDestinationConnectionRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DestinationId
+
+	if len(errors) > 0 {
+		return UpdateJobDestinationConnectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateJobDestinationConnectionRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// UpdateJobDestinationConnectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateJobDestinationConnectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateJobDestinationConnectionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateJobDestinationConnectionRequestMultiError) AllErrors() []error { return m }
+
+// UpdateJobDestinationConnectionRequestValidationError is the validation error
+// returned by UpdateJobDestinationConnectionRequest.Validate if the
+// designated constraints aren't met.
+type UpdateJobDestinationConnectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateJobDestinationConnectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateJobDestinationConnectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateJobDestinationConnectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateJobDestinationConnectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateJobDestinationConnectionRequestValidationError) ErrorName() string {
+	return "UpdateJobDestinationConnectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateJobDestinationConnectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateJobDestinationConnectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateJobDestinationConnectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateJobDestinationConnectionRequestValidationError{}
+
+// Validate checks the field values on UpdateJobDestinationConnectionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *UpdateJobDestinationConnectionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// UpdateJobDestinationConnectionResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// UpdateJobDestinationConnectionResponseMultiError, or nil if none found.
+func (m *UpdateJobDestinationConnectionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateJobDestinationConnectionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateJobDestinationConnectionResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateJobDestinationConnectionResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateJobDestinationConnectionResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateJobDestinationConnectionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateJobDestinationConnectionResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// UpdateJobDestinationConnectionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateJobDestinationConnectionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateJobDestinationConnectionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateJobDestinationConnectionResponseMultiError) AllErrors() []error { return m }
+
+// UpdateJobDestinationConnectionResponseValidationError is the validation
+// error returned by UpdateJobDestinationConnectionResponse.Validate if the
+// designated constraints aren't met.
+type UpdateJobDestinationConnectionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateJobDestinationConnectionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateJobDestinationConnectionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateJobDestinationConnectionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateJobDestinationConnectionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateJobDestinationConnectionResponseValidationError) ErrorName() string {
+	return "UpdateJobDestinationConnectionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateJobDestinationConnectionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateJobDestinationConnectionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateJobDestinationConnectionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateJobDestinationConnectionResponseValidationError{}
+
+// Validate checks the field values on DeleteJobDestinationConnectionRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *DeleteJobDestinationConnectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteJobDestinationConnectionRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// DeleteJobDestinationConnectionRequestMultiError, or nil if none found.
+func (m *DeleteJobDestinationConnectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteJobDestinationConnectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DestinationId
+
+	if len(errors) > 0 {
+		return DeleteJobDestinationConnectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteJobDestinationConnectionRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// DeleteJobDestinationConnectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteJobDestinationConnectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteJobDestinationConnectionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteJobDestinationConnectionRequestMultiError) AllErrors() []error { return m }
+
+// DeleteJobDestinationConnectionRequestValidationError is the validation error
+// returned by DeleteJobDestinationConnectionRequest.Validate if the
+// designated constraints aren't met.
+type DeleteJobDestinationConnectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteJobDestinationConnectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteJobDestinationConnectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteJobDestinationConnectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteJobDestinationConnectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteJobDestinationConnectionRequestValidationError) ErrorName() string {
+	return "DeleteJobDestinationConnectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteJobDestinationConnectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteJobDestinationConnectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteJobDestinationConnectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteJobDestinationConnectionRequestValidationError{}
+
+// Validate checks the field values on DeleteJobDestinationConnectionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *DeleteJobDestinationConnectionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// DeleteJobDestinationConnectionResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// DeleteJobDestinationConnectionResponseMultiError, or nil if none found.
+func (m *DeleteJobDestinationConnectionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteJobDestinationConnectionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteJobDestinationConnectionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteJobDestinationConnectionResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// DeleteJobDestinationConnectionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteJobDestinationConnectionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteJobDestinationConnectionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteJobDestinationConnectionResponseMultiError) AllErrors() []error { return m }
+
+// DeleteJobDestinationConnectionResponseValidationError is the validation
+// error returned by DeleteJobDestinationConnectionResponse.Validate if the
+// designated constraints aren't met.
+type DeleteJobDestinationConnectionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteJobDestinationConnectionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteJobDestinationConnectionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteJobDestinationConnectionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteJobDestinationConnectionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteJobDestinationConnectionResponseValidationError) ErrorName() string {
+	return "DeleteJobDestinationConnectionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteJobDestinationConnectionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteJobDestinationConnectionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteJobDestinationConnectionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteJobDestinationConnectionResponseValidationError{}
+
+// Validate checks the field values on CreateJobDestinationConnectionsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *CreateJobDestinationConnectionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// CreateJobDestinationConnectionsRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// CreateJobDestinationConnectionsRequestMultiError, or nil if none found.
+func (m *CreateJobDestinationConnectionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateJobDestinationConnectionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobId
+
+	for idx, item := range m.GetDestinations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateJobDestinationConnectionsRequestValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateJobDestinationConnectionsRequestValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateJobDestinationConnectionsRequestValidationError{
+					field:  fmt.Sprintf("Destinations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateJobDestinationConnectionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateJobDestinationConnectionsRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// CreateJobDestinationConnectionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateJobDestinationConnectionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateJobDestinationConnectionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateJobDestinationConnectionsRequestMultiError) AllErrors() []error { return m }
+
+// CreateJobDestinationConnectionsRequestValidationError is the validation
+// error returned by CreateJobDestinationConnectionsRequest.Validate if the
+// designated constraints aren't met.
+type CreateJobDestinationConnectionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateJobDestinationConnectionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateJobDestinationConnectionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateJobDestinationConnectionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateJobDestinationConnectionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateJobDestinationConnectionsRequestValidationError) ErrorName() string {
+	return "CreateJobDestinationConnectionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateJobDestinationConnectionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateJobDestinationConnectionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateJobDestinationConnectionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateJobDestinationConnectionsRequestValidationError{}
+
+// Validate checks the field values on CreateJobDestinationConnectionsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *CreateJobDestinationConnectionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// CreateJobDestinationConnectionsResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// CreateJobDestinationConnectionsResponseMultiError, or nil if none found.
+func (m *CreateJobDestinationConnectionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateJobDestinationConnectionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateJobDestinationConnectionsResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateJobDestinationConnectionsResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateJobDestinationConnectionsResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateJobDestinationConnectionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateJobDestinationConnectionsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// CreateJobDestinationConnectionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateJobDestinationConnectionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateJobDestinationConnectionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateJobDestinationConnectionsResponseMultiError) AllErrors() []error { return m }
+
+// CreateJobDestinationConnectionsResponseValidationError is the validation
+// error returned by CreateJobDestinationConnectionsResponse.Validate if the
+// designated constraints aren't met.
+type CreateJobDestinationConnectionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateJobDestinationConnectionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateJobDestinationConnectionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateJobDestinationConnectionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateJobDestinationConnectionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateJobDestinationConnectionsResponseValidationError) ErrorName() string {
+	return "CreateJobDestinationConnectionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateJobDestinationConnectionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateJobDestinationConnectionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateJobDestinationConnectionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateJobDestinationConnectionsResponseValidationError{}
+
+// Validate checks the field values on DeleteJobRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteJobRequestMultiError, or nil if none found.
+func (m *DeleteJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteJobRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteJobRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteJobRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteJobRequestMultiError) AllErrors() []error { return m }
+
+// DeleteJobRequestValidationError is the validation error returned by
+// DeleteJobRequest.Validate if the designated constraints aren't met.
+type DeleteJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteJobRequestValidationError) ErrorName() string { return "DeleteJobRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteJobRequestValidationError{}
+
+// Validate checks the field values on DeleteJobResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteJobResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteJobResponseMultiError, or nil if none found.
+func (m *DeleteJobResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteJobResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteJobResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteJobResponseMultiError is an error wrapping multiple validation errors
+// returned by DeleteJobResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteJobResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteJobResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteJobResponseMultiError) AllErrors() []error { return m }
+
+// DeleteJobResponseValidationError is the validation error returned by
+// DeleteJobResponse.Validate if the designated constraints aren't met.
+type DeleteJobResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteJobResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteJobResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteJobResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteJobResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteJobResponseValidationError) ErrorName() string {
+	return "DeleteJobResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteJobResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteJobResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteJobResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteJobResponseValidationError{}
+
+// Validate checks the field values on IsJobNameAvailableRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IsJobNameAvailableRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IsJobNameAvailableRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IsJobNameAvailableRequestMultiError, or nil if none found.
+func (m *IsJobNameAvailableRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IsJobNameAvailableRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for AccountId
+
+	if len(errors) > 0 {
+		return IsJobNameAvailableRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IsJobNameAvailableRequestMultiError is an error wrapping multiple validation
+// errors returned by IsJobNameAvailableRequest.ValidateAll() if the
+// designated constraints aren't met.
+type IsJobNameAvailableRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IsJobNameAvailableRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IsJobNameAvailableRequestMultiError) AllErrors() []error { return m }
+
+// IsJobNameAvailableRequestValidationError is the validation error returned by
+// IsJobNameAvailableRequest.Validate if the designated constraints aren't met.
+type IsJobNameAvailableRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IsJobNameAvailableRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IsJobNameAvailableRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IsJobNameAvailableRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IsJobNameAvailableRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IsJobNameAvailableRequestValidationError) ErrorName() string {
+	return "IsJobNameAvailableRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IsJobNameAvailableRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIsJobNameAvailableRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IsJobNameAvailableRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IsJobNameAvailableRequestValidationError{}
+
+// Validate checks the field values on IsJobNameAvailableResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IsJobNameAvailableResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IsJobNameAvailableResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IsJobNameAvailableResponseMultiError, or nil if none found.
+func (m *IsJobNameAvailableResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IsJobNameAvailableResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IsAvailable
+
+	if len(errors) > 0 {
+		return IsJobNameAvailableResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// IsJobNameAvailableResponseMultiError is an error wrapping multiple
+// validation errors returned by IsJobNameAvailableResponse.ValidateAll() if
+// the designated constraints aren't met.
+type IsJobNameAvailableResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IsJobNameAvailableResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IsJobNameAvailableResponseMultiError) AllErrors() []error { return m }
+
+// IsJobNameAvailableResponseValidationError is the validation error returned
+// by IsJobNameAvailableResponse.Validate if the designated constraints aren't met.
+type IsJobNameAvailableResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IsJobNameAvailableResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IsJobNameAvailableResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IsJobNameAvailableResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IsJobNameAvailableResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IsJobNameAvailableResponseValidationError) ErrorName() string {
+	return "IsJobNameAvailableResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IsJobNameAvailableResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIsJobNameAvailableResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IsJobNameAvailableResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IsJobNameAvailableResponseValidationError{}
+
+// Validate checks the field values on GetJobRunsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunsRequestMultiError, or nil if none found.
+func (m *GetJobRunsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Id.(type) {
+	case *GetJobRunsRequest_JobId:
+		if v == nil {
+			err := GetJobRunsRequestValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for JobId
+	case *GetJobRunsRequest_AccountId:
+		if v == nil {
+			err := GetJobRunsRequestValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for AccountId
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return GetJobRunsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRunsRequestMultiError is an error wrapping multiple validation errors
+// returned by GetJobRunsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetJobRunsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRunsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRunsRequestMultiError) AllErrors() []error { return m }
+
+// GetJobRunsRequestValidationError is the validation error returned by
+// GetJobRunsRequest.Validate if the designated constraints aren't met.
+type GetJobRunsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRunsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRunsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRunsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRunsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRunsRequestValidationError) ErrorName() string {
+	return "GetJobRunsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobRunsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRunsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRunsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRunsRequestValidationError{}
+
+// Validate checks the field values on GetJobRunsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunsResponseMultiError, or nil if none found.
+func (m *GetJobRunsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetJobRuns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJobRunsResponseValidationError{
+						field:  fmt.Sprintf("JobRuns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJobRunsResponseValidationError{
+						field:  fmt.Sprintf("JobRuns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJobRunsResponseValidationError{
+					field:  fmt.Sprintf("JobRuns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetJobRunsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRunsResponseMultiError is an error wrapping multiple validation errors
+// returned by GetJobRunsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetJobRunsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRunsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRunsResponseMultiError) AllErrors() []error { return m }
+
+// GetJobRunsResponseValidationError is the validation error returned by
+// GetJobRunsResponse.Validate if the designated constraints aren't met.
+type GetJobRunsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRunsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRunsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRunsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRunsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRunsResponseValidationError) ErrorName() string {
+	return "GetJobRunsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobRunsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRunsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRunsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRunsResponseValidationError{}
+
+// Validate checks the field values on StreamJobRunStatusesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StreamJobRunStatusesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamJobRunStatusesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StreamJobRunStatusesRequestMultiError, or nil if none found.
+func (m *StreamJobRunStatusesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamJobRunStatusesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	if m.JobId != nil {
+		// no validation rules for JobId
+	}
+
+	if len(errors) > 0 {
+		return StreamJobRunStatusesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamJobRunStatusesRequestMultiError is an error wrapping multiple
+// validation errors returned by StreamJobRunStatusesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type StreamJobRunStatusesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamJobRunStatusesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamJobRunStatusesRequestMultiError) AllErrors() []error { return m }
+
+// StreamJobRunStatusesRequestValidationError is the validation error returned
+// by StreamJobRunStatusesRequest.Validate if the designated constraints
+// aren't met.
+type StreamJobRunStatusesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamJobRunStatusesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamJobRunStatusesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamJobRunStatusesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamJobRunStatusesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamJobRunStatusesRequestValidationError) ErrorName() string {
+	return "StreamJobRunStatusesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamJobRunStatusesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamJobRunStatusesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamJobRunStatusesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamJobRunStatusesRequestValidationError{}
+
+// Validate checks the field values on StreamJobRunStatusesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StreamJobRunStatusesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamJobRunStatusesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StreamJobRunStatusesResponseMultiError, or nil if none found.
+func (m *StreamJobRunStatusesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamJobRunStatusesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJobRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StreamJobRunStatusesResponseValidationError{
+					field:  "JobRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StreamJobRunStatusesResponseValidationError{
+					field:  "JobRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJobRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StreamJobRunStatusesResponseValidationError{
+				field:  "JobRun",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StreamJobRunStatusesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamJobRunStatusesResponseMultiError is an error wrapping multiple
+// validation errors returned by StreamJobRunStatusesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type StreamJobRunStatusesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamJobRunStatusesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamJobRunStatusesResponseMultiError) AllErrors() []error { return m }
+
+// StreamJobRunStatusesResponseValidationError is the validation error returned
+// by StreamJobRunStatusesResponse.Validate if the designated constraints
+// aren't met.
+type StreamJobRunStatusesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamJobRunStatusesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamJobRunStatusesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamJobRunStatusesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamJobRunStatusesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamJobRunStatusesResponseValidationError) ErrorName() string {
+	return "StreamJobRunStatusesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamJobRunStatusesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamJobRunStatusesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamJobRunStatusesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamJobRunStatusesResponseValidationError{}
+
+// Validate checks the field values on GetJobRunRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunRequestMultiError, or nil if none found.
+func (m *GetJobRunRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobRunId
+
+	// no validation rules for AccountId
+
+	if len(errors) > 0 {
+		return GetJobRunRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRunRequestMultiError is an error wrapping multiple validation errors
+// returned by GetJobRunRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetJobRunRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRunRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRunRequestMultiError) AllErrors() []error { return m }
+
+// GetJobRunRequestValidationError is the validation error returned by
+// GetJobRunRequest.Validate if the designated constraints aren't met.
+type GetJobRunRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRunRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRunRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRunRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRunRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRunRequestValidationError) ErrorName() string { return "GetJobRunRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetJobRunRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRunRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRunRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRunRequestValidationError{}
+
+// Validate checks the field values on GetJobRunResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunResponseMultiError, or nil if none found.
+func (m *GetJobRunResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJobRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetJobRunResponseValidationError{
+					field:  "JobRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetJobRunResponseValidationError{
+					field:  "JobRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJobRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetJobRunResponseValidationError{
+				field:  "JobRun",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetJobRunResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRunResponseMultiError is an error wrapping multiple validation errors
+// returned by GetJobRunResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetJobRunResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRunResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRunResponseMultiError) AllErrors() []error { return m }
+
+// GetJobRunResponseValidationError is the validation error returned by
+// GetJobRunResponse.Validate if the designated constraints aren't met.
+type GetJobRunResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRunResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRunResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRunResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRunResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRunResponseValidationError) ErrorName() string {
+	return "GetJobRunResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobRunResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRunResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRunResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRunResponseValidationError{}
+
+// Validate checks the field values on GetJobRunDataQualityRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunDataQualityRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunDataQualityRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunDataQualityRequestMultiError, or nil if none found.
+func (m *GetJobRunDataQualityRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunDataQualityRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobRunId
+
+	// no validation rules for AccountId
+
+	if len(errors) > 0 {
+		return GetJobRunDataQualityRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRunDataQualityRequestMultiError is an error wrapping multiple
+// validation errors returned by GetJobRunDataQualityRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetJobRunDataQualityRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRunDataQualityRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRunDataQualityRequestMultiError) AllErrors() []error { return m }
+
+// GetJobRunDataQualityRequestValidationError is the validation error returned
+// by GetJobRunDataQualityRequest.Validate if the designated constraints
+// aren't met.
+type GetJobRunDataQualityRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRunDataQualityRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRunDataQualityRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRunDataQualityRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRunDataQualityRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRunDataQualityRequestValidationError) ErrorName() string {
+	return "GetJobRunDataQualityRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobRunDataQualityRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRunDataQualityRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRunDataQualityRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRunDataQualityRequestValidationError{}
+
+// Validate checks the field values on GetJobRunDataQualityResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunDataQualityResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunDataQualityResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunDataQualityResponseMultiError, or nil if none found.
+func (m *GetJobRunDataQualityResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunDataQualityResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJobRunDataQualityResponseValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJobRunDataQualityResponseValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJobRunDataQualityResponseValidationError{
+					field:  fmt.Sprintf("Tables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetJobRunDataQualityResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRunDataQualityResponseMultiError is an error wrapping multiple
+// validation errors returned by GetJobRunDataQualityResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetJobRunDataQualityResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRunDataQualityResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRunDataQualityResponseMultiError) AllErrors() []error { return m }
+
+// GetJobRunDataQualityResponseValidationError is the validation error returned
+// by GetJobRunDataQualityResponse.Validate if the designated constraints
+// aren't met.
+type GetJobRunDataQualityResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRunDataQualityResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRunDataQualityResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRunDataQualityResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRunDataQualityResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRunDataQualityResponseValidationError) ErrorName() string {
+	return "GetJobRunDataQualityResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobRunDataQualityResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRunDataQualityResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRunDataQualityResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRunDataQualityResponseValidationError{}
+
+// Validate checks the field values on TableDataQuality with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TableDataQuality) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TableDataQuality with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TableDataQualityMultiError, or nil if none found.
+func (m *TableDataQuality) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TableDataQuality) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for DestinationConnectionId
+
+	// no validation rules for Score
+
+	for idx, item := range m.GetColumns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TableDataQualityValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TableDataQualityValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TableDataQualityValidationError{
+					field:  fmt.Sprintf("Columns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ReferentialValidity != nil {
+		// no validation rules for ReferentialValidity
+	}
+
+	if len(errors) > 0 {
+		return TableDataQualityMultiError(errors)
+	}
+
+	return nil
+}
+
+// TableDataQualityMultiError is an error wrapping multiple validation errors
+// returned by TableDataQuality.ValidateAll() if the designated constraints
+// aren't met.
+type TableDataQualityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TableDataQualityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TableDataQualityMultiError) AllErrors() []error { return m }
+
+// TableDataQualityValidationError is the validation error returned by
+// TableDataQuality.Validate if the designated constraints aren't met.
+type TableDataQualityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TableDataQualityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TableDataQualityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TableDataQualityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TableDataQualityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TableDataQualityValidationError) ErrorName() string { return "TableDataQualityValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TableDataQualityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTableDataQuality.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TableDataQualityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TableDataQualityValidationError{}
+
+// Validate checks the field values on ColumnDataQuality with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ColumnDataQuality) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnDataQuality with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ColumnDataQualityMultiError, or nil if none found.
+func (m *ColumnDataQuality) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnDataQuality) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Column
+
+	if m.DistributionDistance != nil {
+		// no validation rules for DistributionDistance
+	}
+
+	if m.CardinalityPreservation != nil {
+		// no validation rules for CardinalityPreservation
+	}
+
+	if len(errors) > 0 {
+		return ColumnDataQualityMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnDataQualityMultiError is an error wrapping multiple validation errors
+// returned by ColumnDataQuality.ValidateAll() if the designated constraints
+// aren't met.
+type ColumnDataQualityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnDataQualityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnDataQualityMultiError) AllErrors() []error { return m }
+
+// ColumnDataQualityValidationError is the validation error returned by
+// ColumnDataQuality.Validate if the designated constraints aren't met.
+type ColumnDataQualityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnDataQualityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnDataQualityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnDataQualityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnDataQualityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnDataQualityValidationError) ErrorName() string {
+	return "ColumnDataQualityValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ColumnDataQualityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnDataQuality.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnDataQualityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnDataQualityValidationError{}
+
+// Validate checks the field values on JobRunArtifact with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobRunArtifact) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobRunArtifact with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JobRunArtifactMultiError,
+// or nil if none found.
+func (m *JobRunArtifact) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobRunArtifact) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for JobRunId
+
+	// no validation rules for Type
+
+	// no validation rules for Name
+
+	// no validation rules for ContentType
+
+	// no validation rules for SizeBytes
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobRunArtifactValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobRunArtifactValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobRunArtifactValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Content != nil {
+		// no validation rules for Content
+	}
+
+	if len(errors) > 0 {
+		return JobRunArtifactMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobRunArtifactMultiError is an error wrapping multiple validation errors
+// returned by JobRunArtifact.ValidateAll() if the designated constraints
+// aren't met.
+type JobRunArtifactMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobRunArtifactMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobRunArtifactMultiError) AllErrors() []error { return m }
+
+// JobRunArtifactValidationError is the validation error returned by
+// JobRunArtifact.Validate if the designated constraints aren't met.
+type JobRunArtifactValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobRunArtifactValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobRunArtifactValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobRunArtifactValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobRunArtifactValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobRunArtifactValidationError) ErrorName() string { return "JobRunArtifactValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobRunArtifactValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobRunArtifact.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobRunArtifactValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobRunArtifactValidationError{}
+
+// Validate checks the field values on CreateJobRunArtifactRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateJobRunArtifactRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateJobRunArtifactRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateJobRunArtifactRequestMultiError, or nil if none found.
+func (m *CreateJobRunArtifactRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateJobRunArtifactRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	// no validation rules for JobRunId
+
+	// no validation rules for Type
+
+	// no validation rules for Name
+
+	// no validation rules for ContentType
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return CreateJobRunArtifactRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateJobRunArtifactRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateJobRunArtifactRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateJobRunArtifactRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateJobRunArtifactRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateJobRunArtifactRequestMultiError) AllErrors() []error { return m }
+
+// CreateJobRunArtifactRequestValidationError is the validation error returned
+// by CreateJobRunArtifactRequest.Validate if the designated constraints
+// aren't met.
+type CreateJobRunArtifactRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateJobRunArtifactRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateJobRunArtifactRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateJobRunArtifactRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateJobRunArtifactRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateJobRunArtifactRequestValidationError) ErrorName() string {
+	return "CreateJobRunArtifactRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateJobRunArtifactRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateJobRunArtifactRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateJobRunArtifactRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateJobRunArtifactRequestValidationError{}
+
+// Validate checks the field values on CreateJobRunArtifactResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateJobRunArtifactResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateJobRunArtifactResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateJobRunArtifactResponseMultiError, or nil if none found.
+func (m *CreateJobRunArtifactResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateJobRunArtifactResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetArtifact()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateJobRunArtifactResponseValidationError{
+					field:  "Artifact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateJobRunArtifactResponseValidationError{
+					field:  "Artifact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArtifact()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateJobRunArtifactResponseValidationError{
+				field:  "Artifact",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateJobRunArtifactResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateJobRunArtifactResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateJobRunArtifactResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateJobRunArtifactResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateJobRunArtifactResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateJobRunArtifactResponseMultiError) AllErrors() []error { return m }
+
+// CreateJobRunArtifactResponseValidationError is the validation error returned
+// by CreateJobRunArtifactResponse.Validate if the designated constraints
+// aren't met.
+type CreateJobRunArtifactResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateJobRunArtifactResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateJobRunArtifactResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateJobRunArtifactResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateJobRunArtifactResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateJobRunArtifactResponseValidationError) ErrorName() string {
+	return "CreateJobRunArtifactResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateJobRunArtifactResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateJobRunArtifactResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateJobRunArtifactResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateJobRunArtifactResponseValidationError{}
+
+// Validate checks the field values on GetJobRunArtifactsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunArtifactsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunArtifactsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunArtifactsRequestMultiError, or nil if none found.
+func (m *GetJobRunArtifactsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunArtifactsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	// no validation rules for JobRunId
+
+	// no validation rules for IncludeContent
+
+	if m.Type != nil {
+		// no validation rules for Type
+	}
+
+	if len(errors) > 0 {
+		return GetJobRunArtifactsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRunArtifactsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetJobRunArtifactsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetJobRunArtifactsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRunArtifactsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRunArtifactsRequestMultiError) AllErrors() []error { return m }
+
+// GetJobRunArtifactsRequestValidationError is the validation error returned by
+// GetJobRunArtifactsRequest.Validate if the designated constraints aren't met.
+type GetJobRunArtifactsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRunArtifactsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRunArtifactsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRunArtifactsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRunArtifactsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRunArtifactsRequestValidationError) ErrorName() string {
+	return "GetJobRunArtifactsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobRunArtifactsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRunArtifactsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRunArtifactsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRunArtifactsRequestValidationError{}
+
+// Validate checks the field values on GetJobRunArtifactsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunArtifactsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunArtifactsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunArtifactsResponseMultiError, or nil if none found.
+func (m *GetJobRunArtifactsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunArtifactsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetArtifacts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJobRunArtifactsResponseValidationError{
+						field:  fmt.Sprintf("Artifacts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJobRunArtifactsResponseValidationError{
+						field:  fmt.Sprintf("Artifacts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJobRunArtifactsResponseValidationError{
+					field:  fmt.Sprintf("Artifacts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetJobRunArtifactsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRunArtifactsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetJobRunArtifactsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetJobRunArtifactsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRunArtifactsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRunArtifactsResponseMultiError) AllErrors() []error { return m }
+
+// GetJobRunArtifactsResponseValidationError is the validation error returned
+// by GetJobRunArtifactsResponse.Validate if the designated constraints aren't met.
+type GetJobRunArtifactsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRunArtifactsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRunArtifactsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRunArtifactsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRunArtifactsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRunArtifactsResponseValidationError) ErrorName() string {
+	return "GetJobRunArtifactsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobRunArtifactsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRunArtifactsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRunArtifactsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRunArtifactsResponseValidationError{}
+
+// Validate checks the field values on JobRunSnapshot with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobRunSnapshot) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobRunSnapshot with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JobRunSnapshotMultiError,
+// or nil if none found.
+func (m *JobRunSnapshot) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobRunSnapshot) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for AccountId
+
+	// no validation rules for Name
+
+	// no validation rules for JobId
+
+	// no validation rules for JobRunId
+
+	// no validation rules for ConnectionId
+
+	// no validation rules for CreatedByUserId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobRunSnapshotValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobRunSnapshotValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobRunSnapshotValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return JobRunSnapshotMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobRunSnapshotMultiError is an error wrapping multiple validation errors
+// returned by JobRunSnapshot.ValidateAll() if the designated constraints
+// aren't met.
+type JobRunSnapshotMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobRunSnapshotMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobRunSnapshotMultiError) AllErrors() []error { return m }
+
+// JobRunSnapshotValidationError is the validation error returned by
+// JobRunSnapshot.Validate if the designated constraints aren't met.
+type JobRunSnapshotValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobRunSnapshotValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobRunSnapshotValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobRunSnapshotValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobRunSnapshotValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobRunSnapshotValidationError) ErrorName() string { return "JobRunSnapshotValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobRunSnapshotValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobRunSnapshot.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobRunSnapshotValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobRunSnapshotValidationError{}
+
+// Validate checks the field values on CreateJobRunSnapshotRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateJobRunSnapshotRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateJobRunSnapshotRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateJobRunSnapshotRequestMultiError, or nil if none found.
+func (m *CreateJobRunSnapshotRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateJobRunSnapshotRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	// no validation rules for JobRunId
+
+	// no validation rules for ConnectionId
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return CreateJobRunSnapshotRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateJobRunSnapshotRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateJobRunSnapshotRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateJobRunSnapshotRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateJobRunSnapshotRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateJobRunSnapshotRequestMultiError) AllErrors() []error { return m }
+
+// CreateJobRunSnapshotRequestValidationError is the validation error returned
+// by CreateJobRunSnapshotRequest.Validate if the designated constraints
+// aren't met.
+type CreateJobRunSnapshotRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateJobRunSnapshotRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateJobRunSnapshotRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateJobRunSnapshotRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateJobRunSnapshotRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateJobRunSnapshotRequestValidationError) ErrorName() string {
+	return "CreateJobRunSnapshotRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateJobRunSnapshotRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateJobRunSnapshotRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateJobRunSnapshotRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateJobRunSnapshotRequestValidationError{}
+
+// Validate checks the field values on CreateJobRunSnapshotResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateJobRunSnapshotResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateJobRunSnapshotResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateJobRunSnapshotResponseMultiError, or nil if none found.
+func (m *CreateJobRunSnapshotResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateJobRunSnapshotResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSnapshot()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateJobRunSnapshotResponseValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateJobRunSnapshotResponseValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSnapshot()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateJobRunSnapshotResponseValidationError{
+				field:  "Snapshot",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateJobRunSnapshotResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateJobRunSnapshotResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateJobRunSnapshotResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateJobRunSnapshotResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateJobRunSnapshotResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateJobRunSnapshotResponseMultiError) AllErrors() []error { return m }
+
+// CreateJobRunSnapshotResponseValidationError is the validation error returned
+// by CreateJobRunSnapshotResponse.Validate if the designated constraints
+// aren't met.
+type CreateJobRunSnapshotResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateJobRunSnapshotResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateJobRunSnapshotResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateJobRunSnapshotResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateJobRunSnapshotResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateJobRunSnapshotResponseValidationError) ErrorName() string {
+	return "CreateJobRunSnapshotResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateJobRunSnapshotResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateJobRunSnapshotResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateJobRunSnapshotResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateJobRunSnapshotResponseValidationError{}
+
+// Validate checks the field values on GetJobRunSnapshotRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunSnapshotRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunSnapshotRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunSnapshotRequestMultiError, or nil if none found.
+func (m *GetJobRunSnapshotRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunSnapshotRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return GetJobRunSnapshotRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRunSnapshotRequestMultiError is an error wrapping multiple validation
+// errors returned by GetJobRunSnapshotRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobRunSnapshotRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRunSnapshotRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRunSnapshotRequestMultiError) AllErrors() []error { return m }
+
+// GetJobRunSnapshotRequestValidationError is the validation error returned by
+// GetJobRunSnapshotRequest.Validate if the designated constraints aren't met.
+type GetJobRunSnapshotRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRunSnapshotRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRunSnapshotRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRunSnapshotRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRunSnapshotRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRunSnapshotRequestValidationError) ErrorName() string {
+	return "GetJobRunSnapshotRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobRunSnapshotRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRunSnapshotRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRunSnapshotRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRunSnapshotRequestValidationError{}
+
+// Validate checks the field values on GetJobRunSnapshotResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunSnapshotResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunSnapshotResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunSnapshotResponseMultiError, or nil if none found.
+func (m *GetJobRunSnapshotResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunSnapshotResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSnapshot()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetJobRunSnapshotResponseValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetJobRunSnapshotResponseValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSnapshot()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetJobRunSnapshotResponseValidationError{
+				field:  "Snapshot",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetJobRunSnapshotResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRunSnapshotResponseMultiError is an error wrapping multiple validation
+// errors returned by GetJobRunSnapshotResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetJobRunSnapshotResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRunSnapshotResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRunSnapshotResponseMultiError) AllErrors() []error { return m }
+
+// GetJobRunSnapshotResponseValidationError is the validation error returned by
+// GetJobRunSnapshotResponse.Validate if the designated constraints aren't met.
+type GetJobRunSnapshotResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRunSnapshotResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRunSnapshotResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRunSnapshotResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRunSnapshotResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRunSnapshotResponseValidationError) ErrorName() string {
+	return "GetJobRunSnapshotResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobRunSnapshotResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRunSnapshotResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRunSnapshotResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRunSnapshotResponseValidationError{}
+
+// Validate checks the field values on GetJobRunSnapshotsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunSnapshotsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunSnapshotsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunSnapshotsRequestMultiError, or nil if none found.
+func (m *GetJobRunSnapshotsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunSnapshotsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	if m.JobId != nil {
+		// no validation rules for JobId
+	}
+
+	if len(errors) > 0 {
+		return GetJobRunSnapshotsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRunSnapshotsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetJobRunSnapshotsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetJobRunSnapshotsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRunSnapshotsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRunSnapshotsRequestMultiError) AllErrors() []error { return m }
+
+// GetJobRunSnapshotsRequestValidationError is the validation error returned by
+// GetJobRunSnapshotsRequest.Validate if the designated constraints aren't met.
+type GetJobRunSnapshotsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRunSnapshotsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRunSnapshotsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRunSnapshotsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRunSnapshotsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRunSnapshotsRequestValidationError) ErrorName() string {
+	return "GetJobRunSnapshotsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobRunSnapshotsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRunSnapshotsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRunSnapshotsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRunSnapshotsRequestValidationError{}
+
+// Validate checks the field values on GetJobRunSnapshotsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunSnapshotsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunSnapshotsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunSnapshotsResponseMultiError, or nil if none found.
+func (m *GetJobRunSnapshotsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunSnapshotsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSnapshots() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJobRunSnapshotsResponseValidationError{
+						field:  fmt.Sprintf("Snapshots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJobRunSnapshotsResponseValidationError{
+						field:  fmt.Sprintf("Snapshots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJobRunSnapshotsResponseValidationError{
+					field:  fmt.Sprintf("Snapshots[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetJobRunSnapshotsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRunSnapshotsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetJobRunSnapshotsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetJobRunSnapshotsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRunSnapshotsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRunSnapshotsResponseMultiError) AllErrors() []error { return m }
+
+// GetJobRunSnapshotsResponseValidationError is the validation error returned
+// by GetJobRunSnapshotsResponse.Validate if the designated constraints aren't met.
+type GetJobRunSnapshotsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRunSnapshotsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRunSnapshotsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRunSnapshotsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRunSnapshotsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRunSnapshotsResponseValidationError) ErrorName() string {
+	return "GetJobRunSnapshotsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobRunSnapshotsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRunSnapshotsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRunSnapshotsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRunSnapshotsResponseValidationError{}
+
+// Validate checks the field values on DeleteJobRunSnapshotRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteJobRunSnapshotRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteJobRunSnapshotRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteJobRunSnapshotRequestMultiError, or nil if none found.
+func (m *DeleteJobRunSnapshotRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteJobRunSnapshotRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return DeleteJobRunSnapshotRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteJobRunSnapshotRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteJobRunSnapshotRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteJobRunSnapshotRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteJobRunSnapshotRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteJobRunSnapshotRequestMultiError) AllErrors() []error { return m }
+
+// DeleteJobRunSnapshotRequestValidationError is the validation error returned
+// by DeleteJobRunSnapshotRequest.Validate if the designated constraints
+// aren't met.
+type DeleteJobRunSnapshotRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteJobRunSnapshotRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteJobRunSnapshotRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteJobRunSnapshotRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteJobRunSnapshotRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteJobRunSnapshotRequestValidationError) ErrorName() string {
+	return "DeleteJobRunSnapshotRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteJobRunSnapshotRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteJobRunSnapshotRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteJobRunSnapshotRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteJobRunSnapshotRequestValidationError{}
+
+// Validate checks the field values on DeleteJobRunSnapshotResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteJobRunSnapshotResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteJobRunSnapshotResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteJobRunSnapshotResponseMultiError, or nil if none found.
+func (m *DeleteJobRunSnapshotResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteJobRunSnapshotResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteJobRunSnapshotResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteJobRunSnapshotResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteJobRunSnapshotResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteJobRunSnapshotResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteJobRunSnapshotResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteJobRunSnapshotResponseMultiError) AllErrors() []error { return m }
+
+// DeleteJobRunSnapshotResponseValidationError is the validation error returned
+// by DeleteJobRunSnapshotResponse.Validate if the designated constraints
+// aren't met.
+type DeleteJobRunSnapshotResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteJobRunSnapshotResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteJobRunSnapshotResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteJobRunSnapshotResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteJobRunSnapshotResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteJobRunSnapshotResponseValidationError) ErrorName() string {
+	return "DeleteJobRunSnapshotResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteJobRunSnapshotResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteJobRunSnapshotResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteJobRunSnapshotResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteJobRunSnapshotResponseValidationError{}
+
+// Validate checks the field values on CreateJobRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateJobRunRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateJobRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateJobRunRequestMultiError, or nil if none found.
+func (m *CreateJobRunRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateJobRunRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobId
+
+	// no validation rules for Priority
+
+	// no validation rules for PreemptBatchRuns
+
+	for idx, item := range m.GetParameters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateJobRunRequestValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateJobRunRequestValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateJobRunRequestValidationError{
+					field:  fmt.Sprintf("Parameters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateJobRunRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateJobRunRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateJobRunRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateJobRunRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateJobRunRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateJobRunRequestMultiError) AllErrors() []error { return m }
+
+// CreateJobRunRequestValidationError is the validation error returned by
+// CreateJobRunRequest.Validate if the designated constraints aren't met.
+type CreateJobRunRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateJobRunRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateJobRunRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateJobRunRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateJobRunRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateJobRunRequestValidationError) ErrorName() string {
+	return "CreateJobRunRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateJobRunRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateJobRunRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateJobRunRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateJobRunRequestValidationError{}
+
+// Validate checks the field values on CreateJobRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateJobRunResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateJobRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateJobRunResponseMultiError, or nil if none found.
+func (m *CreateJobRunResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateJobRunResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CreateJobRunResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateJobRunResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateJobRunResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateJobRunResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateJobRunResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateJobRunResponseMultiError) AllErrors() []error { return m }
+
+// CreateJobRunResponseValidationError is the validation error returned by
+// CreateJobRunResponse.Validate if the designated constraints aren't met.
+type CreateJobRunResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateJobRunResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateJobRunResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateJobRunResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateJobRunResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateJobRunResponseValidationError) ErrorName() string {
+	return "CreateJobRunResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateJobRunResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateJobRunResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateJobRunResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateJobRunResponseValidationError{}
+
+// Validate checks the field values on EstimateJobRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EstimateJobRunRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EstimateJobRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EstimateJobRunRequestMultiError, or nil if none found.
+func (m *EstimateJobRunRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EstimateJobRunRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobId
+
+	if len(errors) > 0 {
+		return EstimateJobRunRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EstimateJobRunRequestMultiError is an error wrapping multiple validation
+// errors returned by EstimateJobRunRequest.ValidateAll() if the designated
+// constraints aren't met.
+type EstimateJobRunRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EstimateJobRunRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EstimateJobRunRequestMultiError) AllErrors() []error { return m }
+
+// EstimateJobRunRequestValidationError is the validation error returned by
+// EstimateJobRunRequest.Validate if the designated constraints aren't met.
+type EstimateJobRunRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EstimateJobRunRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EstimateJobRunRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EstimateJobRunRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EstimateJobRunRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EstimateJobRunRequestValidationError) ErrorName() string {
+	return "EstimateJobRunRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EstimateJobRunRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEstimateJobRunRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EstimateJobRunRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EstimateJobRunRequestValidationError{}
+
+// Validate checks the field values on EstimateJobRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EstimateJobRunResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EstimateJobRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EstimateJobRunResponseMultiError, or nil if none found.
+func (m *EstimateJobRunResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EstimateJobRunResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EstimatedRows
+
+	// no validation rules for EstimatedBytes
+
+	// no validation rules for EstimatedDurationSeconds
+
+	// no validation rules for RowsPerSecond
+
+	// no validation rules for Basis
+
+	// no validation rules for CompletedRunsSampled
+
+	for idx, item := range m.GetTables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EstimateJobRunResponseValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EstimateJobRunResponseValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EstimateJobRunResponseValidationError{
+					field:  fmt.Sprintf("Tables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return EstimateJobRunResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EstimateJobRunResponseMultiError is an error wrapping multiple validation
+// errors returned by EstimateJobRunResponse.ValidateAll() if the designated
+// constraints aren't met.
+type EstimateJobRunResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EstimateJobRunResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EstimateJobRunResponseMultiError) AllErrors() []error { return m }
+
+// EstimateJobRunResponseValidationError is the validation error returned by
+// EstimateJobRunResponse.Validate if the designated constraints aren't met.
+type EstimateJobRunResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EstimateJobRunResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EstimateJobRunResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EstimateJobRunResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EstimateJobRunResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EstimateJobRunResponseValidationError) ErrorName() string {
+	return "EstimateJobRunResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EstimateJobRunResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEstimateJobRunResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EstimateJobRunResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EstimateJobRunResponseValidationError{}
+
+// Validate checks the field values on TableRunEstimate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TableRunEstimate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TableRunEstimate with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TableRunEstimateMultiError, or nil if none found.
+func (m *TableRunEstimate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TableRunEstimate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for EstimatedRows
+
+	// no validation rules for EstimatedBytes
+
+	// no validation rules for EstimatedDurationSeconds
+
+	if len(errors) > 0 {
+		return TableRunEstimateMultiError(errors)
+	}
+
+	return nil
+}
+
+// TableRunEstimateMultiError is an error wrapping multiple validation errors
+// returned by TableRunEstimate.ValidateAll() if the designated constraints
+// aren't met.
+type TableRunEstimateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TableRunEstimateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TableRunEstimateMultiError) AllErrors() []error { return m }
+
+// TableRunEstimateValidationError is the validation error returned by
+// TableRunEstimate.Validate if the designated constraints aren't met.
+type TableRunEstimateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TableRunEstimateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TableRunEstimateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TableRunEstimateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TableRunEstimateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TableRunEstimateValidationError) ErrorName() string { return "TableRunEstimateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TableRunEstimateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTableRunEstimate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TableRunEstimateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TableRunEstimateValidationError{}
+
+// Validate checks the field values on CancelJobRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelJobRunRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelJobRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelJobRunRequestMultiError, or nil if none found.
+func (m *CancelJobRunRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelJobRunRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobRunId
+
+	// no validation rules for AccountId
+
+	if len(errors) > 0 {
+		return CancelJobRunRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelJobRunRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelJobRunRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CancelJobRunRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelJobRunRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelJobRunRequestMultiError) AllErrors() []error { return m }
+
+// CancelJobRunRequestValidationError is the validation error returned by
+// CancelJobRunRequest.Validate if the designated constraints aren't met.
+type CancelJobRunRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelJobRunRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelJobRunRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelJobRunRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelJobRunRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelJobRunRequestValidationError) ErrorName() string {
+	return "CancelJobRunRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelJobRunRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelJobRunRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelJobRunRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelJobRunRequestValidationError{}
+
+// Validate checks the field values on CancelJobRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelJobRunResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelJobRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelJobRunResponseMultiError, or nil if none found.
+func (m *CancelJobRunResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelJobRunResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CancelJobRunResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelJobRunResponseMultiError is an error wrapping multiple validation
+// errors returned by CancelJobRunResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CancelJobRunResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelJobRunResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelJobRunResponseMultiError) AllErrors() []error { return m }
+
+// CancelJobRunResponseValidationError is the validation error returned by
+// CancelJobRunResponse.Validate if the designated constraints aren't met.
+type CancelJobRunResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelJobRunResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelJobRunResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelJobRunResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelJobRunResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelJobRunResponseValidationError) ErrorName() string {
+	return "CancelJobRunResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelJobRunResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelJobRunResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelJobRunResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelJobRunResponseValidationError{}
+
+// Validate checks the field values on Job with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Job) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Job with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in JobMultiError, or nil if none found.
+func (m *Job) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Job) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for CreatedByUserId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UpdatedByUserId
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetSource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "Source",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "Source",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobValidationError{
+				field:  "Source",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetDestinations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobValidationError{
+					field:  fmt.Sprintf("Destinations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetMappings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobValidationError{
+					field:  fmt.Sprintf("Mappings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for AccountId
+
+	if all {
+		switch v := interface{}(m.GetSyncOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "SyncOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "SyncOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSyncOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobValidationError{
+				field:  "SyncOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWorkflowOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "WorkflowOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "WorkflowOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWorkflowOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobValidationError{
+				field:  "WorkflowOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Labels
+
+	for idx, item := range m.GetTableProcessors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobValidationError{
+						field:  fmt.Sprintf("TableProcessors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobValidationError{
+						field:  fmt.Sprintf("TableProcessors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobValidationError{
+					field:  fmt.Sprintf("TableProcessors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetParameters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobValidationError{
+					field:  fmt.Sprintf("Parameters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CronSchedule != nil {
+		// no validation rules for CronSchedule
+	}
+
+	if len(errors) > 0 {
+		return JobMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobMultiError is an error wrapping multiple validation errors returned by
+// Job.ValidateAll() if the designated constraints aren't met.
+type JobMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobMultiError) AllErrors() []error { return m }
+
+// JobValidationError is the validation error returned by Job.Validate if the
+// designated constraints aren't met.
+type JobValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobValidationError) ErrorName() string { return "JobValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJob.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobValidationError{}
+
+// Validate checks the field values on JobRecentRun with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobRecentRun) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobRecentRun with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JobRecentRunMultiError, or
+// nil if none found.
+func (m *JobRecentRun) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobRecentRun) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobRecentRunValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobRecentRunValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobRecentRunValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for JobRunId
+
+	if len(errors) > 0 {
+		return JobRecentRunMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobRecentRunMultiError is an error wrapping multiple validation errors
+// returned by JobRecentRun.ValidateAll() if the designated constraints aren't met.
+type JobRecentRunMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobRecentRunMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobRecentRunMultiError) AllErrors() []error { return m }
+
+// JobRecentRunValidationError is the validation error returned by
+// JobRecentRun.Validate if the designated constraints aren't met.
+type JobRecentRunValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobRecentRunValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobRecentRunValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobRecentRunValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobRecentRunValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobRecentRunValidationError) ErrorName() string { return "JobRecentRunValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobRecentRunValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobRecentRun.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobRecentRunValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobRecentRunValidationError{}
+
+// Validate checks the field values on GetJobRecentRunsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRecentRunsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRecentRunsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRecentRunsRequestMultiError, or nil if none found.
+func (m *GetJobRecentRunsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRecentRunsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobId
+
+	if len(errors) > 0 {
+		return GetJobRecentRunsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRecentRunsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetJobRecentRunsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobRecentRunsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRecentRunsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRecentRunsRequestMultiError) AllErrors() []error { return m }
+
+// GetJobRecentRunsRequestValidationError is the validation error returned by
+// GetJobRecentRunsRequest.Validate if the designated constraints aren't met.
+type GetJobRecentRunsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRecentRunsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRecentRunsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRecentRunsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRecentRunsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRecentRunsRequestValidationError) ErrorName() string {
+	return "GetJobRecentRunsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobRecentRunsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRecentRunsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRecentRunsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRecentRunsRequestValidationError{}
+
+// Validate checks the field values on GetJobRecentRunsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRecentRunsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRecentRunsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRecentRunsResponseMultiError, or nil if none found.
+func (m *GetJobRecentRunsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRecentRunsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRecentRuns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJobRecentRunsResponseValidationError{
+						field:  fmt.Sprintf("RecentRuns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJobRecentRunsResponseValidationError{
+						field:  fmt.Sprintf("RecentRuns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJobRecentRunsResponseValidationError{
+					field:  fmt.Sprintf("RecentRuns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetJobRecentRunsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRecentRunsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetJobRecentRunsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobRecentRunsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRecentRunsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRecentRunsResponseMultiError) AllErrors() []error { return m }
+
+// GetJobRecentRunsResponseValidationError is the validation error returned by
+// GetJobRecentRunsResponse.Validate if the designated constraints aren't met.
+type GetJobRecentRunsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRecentRunsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRecentRunsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRecentRunsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRecentRunsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRecentRunsResponseValidationError) ErrorName() string {
+	return "GetJobRecentRunsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobRecentRunsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRecentRunsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRecentRunsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRecentRunsResponseValidationError{}
+
+// Validate checks the field values on JobNextRuns with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobNextRuns) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobNextRuns with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JobNextRunsMultiError, or
+// nil if none found.
+func (m *JobNextRuns) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobNextRuns) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetNextRunTimes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobNextRunsValidationError{
+						field:  fmt.Sprintf("NextRunTimes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobNextRunsValidationError{
+						field:  fmt.Sprintf("NextRunTimes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobNextRunsValidationError{
+					field:  fmt.Sprintf("NextRunTimes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return JobNextRunsMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobNextRunsMultiError is an error wrapping multiple validation errors
+// returned by JobNextRuns.ValidateAll() if the designated constraints aren't met.
+type JobNextRunsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobNextRunsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobNextRunsMultiError) AllErrors() []error { return m }
+
+// JobNextRunsValidationError is the validation error returned by
+// JobNextRuns.Validate if the designated constraints aren't met.
+type JobNextRunsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobNextRunsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobNextRunsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobNextRunsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobNextRunsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobNextRunsValidationError) ErrorName() string { return "JobNextRunsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobNextRunsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobNextRuns.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobNextRunsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobNextRunsValidationError{}
+
+// Validate checks the field values on GetJobNextRunsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobNextRunsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobNextRunsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobNextRunsRequestMultiError, or nil if none found.
+func (m *GetJobNextRunsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobNextRunsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobId
+
+	if len(errors) > 0 {
+		return GetJobNextRunsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobNextRunsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetJobNextRunsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobNextRunsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobNextRunsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobNextRunsRequestMultiError) AllErrors() []error { return m }
+
+// GetJobNextRunsRequestValidationError is the validation error returned by
+// GetJobNextRunsRequest.Validate if the designated constraints aren't met.
+type GetJobNextRunsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobNextRunsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobNextRunsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobNextRunsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobNextRunsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobNextRunsRequestValidationError) ErrorName() string {
+	return "GetJobNextRunsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobNextRunsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobNextRunsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobNextRunsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobNextRunsRequestValidationError{}
+
+// Validate checks the field values on GetJobNextRunsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobNextRunsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobNextRunsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobNextRunsResponseMultiError, or nil if none found.
+func (m *GetJobNextRunsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobNextRunsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetNextRuns()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetJobNextRunsResponseValidationError{
+					field:  "NextRuns",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetJobNextRunsResponseValidationError{
+					field:  "NextRuns",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextRuns()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetJobNextRunsResponseValidationError{
+				field:  "NextRuns",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetJobNextRunsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobNextRunsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetJobNextRunsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobNextRunsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobNextRunsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobNextRunsResponseMultiError) AllErrors() []error { return m }
+
+// GetJobNextRunsResponseValidationError is the validation error returned by
+// GetJobNextRunsResponse.Validate if the designated constraints aren't met.
+type GetJobNextRunsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobNextRunsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobNextRunsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobNextRunsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobNextRunsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobNextRunsResponseValidationError) ErrorName() string {
+	return "GetJobNextRunsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobNextRunsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobNextRunsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobNextRunsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobNextRunsResponseValidationError{}
+
+// Validate checks the field values on GetJobStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobStatusRequestMultiError, or nil if none found.
+func (m *GetJobStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobId
+
+	if len(errors) > 0 {
+		return GetJobStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobStatusRequestMultiError is an error wrapping multiple validation
+// errors returned by GetJobStatusRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobStatusRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobStatusRequestMultiError) AllErrors() []error { return m }
+
+// GetJobStatusRequestValidationError is the validation error returned by
+// GetJobStatusRequest.Validate if the designated constraints aren't met.
+type GetJobStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobStatusRequestValidationError) ErrorName() string {
+	return "GetJobStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobStatusRequestValidationError{}
+
+// Validate checks the field values on GetJobStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobStatusResponseMultiError, or nil if none found.
+func (m *GetJobStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return GetJobStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobStatusResponseMultiError is an error wrapping multiple validation
+// errors returned by GetJobStatusResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobStatusResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobStatusResponseMultiError) AllErrors() []error { return m }
+
+// GetJobStatusResponseValidationError is the validation error returned by
+// GetJobStatusResponse.Validate if the designated constraints aren't met.
+type GetJobStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobStatusResponseValidationError) ErrorName() string {
+	return "GetJobStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobStatusResponseValidationError{}
+
+// Validate checks the field values on JobStatusRecord with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *JobStatusRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobStatusRecord with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobStatusRecordMultiError, or nil if none found.
+func (m *JobStatusRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobStatusRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobId
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return JobStatusRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobStatusRecordMultiError is an error wrapping multiple validation errors
+// returned by JobStatusRecord.ValidateAll() if the designated constraints
+// aren't met.
+type JobStatusRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobStatusRecordMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobStatusRecordMultiError) AllErrors() []error { return m }
+
+// JobStatusRecordValidationError is the validation error returned by
+// JobStatusRecord.Validate if the designated constraints aren't met.
+type JobStatusRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobStatusRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobStatusRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobStatusRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobStatusRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobStatusRecordValidationError) ErrorName() string { return "JobStatusRecordValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobStatusRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobStatusRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobStatusRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobStatusRecordValidationError{}
+
+// Validate checks the field values on GetJobStatusesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobStatusesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobStatusesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobStatusesRequestMultiError, or nil if none found.
+func (m *GetJobStatusesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobStatusesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	if len(errors) > 0 {
+		return GetJobStatusesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobStatusesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetJobStatusesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobStatusesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobStatusesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobStatusesRequestMultiError) AllErrors() []error { return m }
+
+// GetJobStatusesRequestValidationError is the validation error returned by
+// GetJobStatusesRequest.Validate if the designated constraints aren't met.
+type GetJobStatusesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobStatusesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobStatusesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobStatusesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobStatusesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobStatusesRequestValidationError) ErrorName() string {
+	return "GetJobStatusesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobStatusesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobStatusesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobStatusesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobStatusesRequestValidationError{}
+
+// Validate checks the field values on GetJobStatusesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobStatusesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobStatusesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobStatusesResponseMultiError, or nil if none found.
+func (m *GetJobStatusesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobStatusesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetStatuses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJobStatusesResponseValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJobStatusesResponseValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJobStatusesResponseValidationError{
+					field:  fmt.Sprintf("Statuses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetJobStatusesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobStatusesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetJobStatusesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobStatusesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobStatusesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobStatusesResponseMultiError) AllErrors() []error { return m }
+
+// GetJobStatusesResponseValidationError is the validation error returned by
+// GetJobStatusesResponse.Validate if the designated constraints aren't met.
+type GetJobStatusesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobStatusesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobStatusesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobStatusesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobStatusesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobStatusesResponseValidationError) ErrorName() string {
+	return "GetJobStatusesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobStatusesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobStatusesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobStatusesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobStatusesResponseValidationError{}
+
+// Validate checks the field values on ActivityFailure with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ActivityFailure) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ActivityFailure with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ActivityFailureMultiError, or nil if none found.
+func (m *ActivityFailure) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ActivityFailure) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return ActivityFailureMultiError(errors)
+	}
+
+	return nil
+}
+
+// ActivityFailureMultiError is an error wrapping multiple validation errors
+// returned by ActivityFailure.ValidateAll() if the designated constraints
+// aren't met.
+type ActivityFailureMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ActivityFailureMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ActivityFailureMultiError) AllErrors() []error { return m }
+
+// ActivityFailureValidationError is the validation error returned by
+// ActivityFailure.Validate if the designated constraints aren't met.
+type ActivityFailureValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ActivityFailureValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ActivityFailureValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ActivityFailureValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ActivityFailureValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ActivityFailureValidationError) ErrorName() string { return "ActivityFailureValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ActivityFailureValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sActivityFailure.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ActivityFailureValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ActivityFailureValidationError{}
+
+// Validate checks the field values on PendingActivity with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PendingActivity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PendingActivity with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PendingActivityMultiError, or nil if none found.
+func (m *PendingActivity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PendingActivity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for ActivityName
+
+	if m.LastFailure != nil {
+
+		if all {
+			switch v := interface{}(m.GetLastFailure()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PendingActivityValidationError{
+						field:  "LastFailure",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PendingActivityValidationError{
+						field:  "LastFailure",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLastFailure()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PendingActivityValidationError{
+					field:  "LastFailure",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PendingActivityMultiError(errors)
+	}
+
+	return nil
+}
+
+// PendingActivityMultiError is an error wrapping multiple validation errors
+// returned by PendingActivity.ValidateAll() if the designated constraints
+// aren't met.
+type PendingActivityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PendingActivityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PendingActivityMultiError) AllErrors() []error { return m }
+
+// PendingActivityValidationError is the validation error returned by
+// PendingActivity.Validate if the designated constraints aren't met.
+type PendingActivityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PendingActivityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PendingActivityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PendingActivityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PendingActivityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PendingActivityValidationError) ErrorName() string { return "PendingActivityValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PendingActivityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPendingActivity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PendingActivityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PendingActivityValidationError{}
+
+// Validate checks the field values on JobRun with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobRun) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobRun with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in JobRunMultiError, or nil if none found.
+func (m *JobRun) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobRun) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for JobId
+
+	// no validation rules for Name
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetStartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobRunValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobRunValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobRunValidationError{
+				field:  "StartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetPendingActivities() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobRunValidationError{
+						field:  fmt.Sprintf("PendingActivities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobRunValidationError{
+						field:  fmt.Sprintf("PendingActivities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobRunValidationError{
+					field:  fmt.Sprintf("PendingActivities[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CompletedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetCompletedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobRunValidationError{
+						field:  "CompletedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobRunValidationError{
+						field:  "CompletedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCompletedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobRunValidationError{
+					field:  "CompletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return JobRunMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobRunMultiError is an error wrapping multiple validation errors returned by
+// JobRun.ValidateAll() if the designated constraints aren't met.
+type JobRunMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobRunMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobRunMultiError) AllErrors() []error { return m }
+
+// JobRunValidationError is the validation error returned by JobRun.Validate if
+// the designated constraints aren't met.
+type JobRunValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobRunValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobRunValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobRunValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobRunValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobRunValidationError) ErrorName() string { return "JobRunValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobRunValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobRun.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobRunValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobRunValidationError{}
+
+// Validate checks the field values on JobRunEventTaskError with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobRunEventTaskError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobRunEventTaskError with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobRunEventTaskErrorMultiError, or nil if none found.
+func (m *JobRunEventTaskError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobRunEventTaskError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	// no validation rules for RetryState
+
+	if len(errors) > 0 {
+		return JobRunEventTaskErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobRunEventTaskErrorMultiError is an error wrapping multiple validation
+// errors returned by JobRunEventTaskError.ValidateAll() if the designated
+// constraints aren't met.
+type JobRunEventTaskErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobRunEventTaskErrorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobRunEventTaskErrorMultiError) AllErrors() []error { return m }
+
+// JobRunEventTaskErrorValidationError is the validation error returned by
+// JobRunEventTaskError.Validate if the designated constraints aren't met.
+type JobRunEventTaskErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobRunEventTaskErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobRunEventTaskErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobRunEventTaskErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobRunEventTaskErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobRunEventTaskErrorValidationError) ErrorName() string {
+	return "JobRunEventTaskErrorValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e JobRunEventTaskErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobRunEventTaskError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobRunEventTaskErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobRunEventTaskErrorValidationError{}
+
+// Validate checks the field values on JobRunEventTask with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *JobRunEventTask) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobRunEventTask with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobRunEventTaskMultiError, or nil if none found.
+func (m *JobRunEventTask) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobRunEventTask) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetEventTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobRunEventTaskValidationError{
+					field:  "EventTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobRunEventTaskValidationError{
+					field:  "EventTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEventTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobRunEventTaskValidationError{
+				field:  "EventTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetError()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobRunEventTaskValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobRunEventTaskValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobRunEventTaskValidationError{
+				field:  "Error",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return JobRunEventTaskMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobRunEventTaskMultiError is an error wrapping multiple validation errors
+// returned by JobRunEventTask.ValidateAll() if the designated constraints
+// aren't met.
+type JobRunEventTaskMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobRunEventTaskMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobRunEventTaskMultiError) AllErrors() []error { return m }
+
+// JobRunEventTaskValidationError is the validation error returned by
+// JobRunEventTask.Validate if the designated constraints aren't met.
+type JobRunEventTaskValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobRunEventTaskValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobRunEventTaskValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobRunEventTaskValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobRunEventTaskValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobRunEventTaskValidationError) ErrorName() string { return "JobRunEventTaskValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobRunEventTaskValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobRunEventTask.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobRunEventTaskValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobRunEventTaskValidationError{}
+
+// Validate checks the field values on JobRunSyncMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobRunSyncMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobRunSyncMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobRunSyncMetadataMultiError, or nil if none found.
+func (m *JobRunSyncMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobRunSyncMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	if len(errors) > 0 {
+		return JobRunSyncMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobRunSyncMetadataMultiError is an error wrapping multiple validation errors
+// returned by JobRunSyncMetadata.ValidateAll() if the designated constraints
+// aren't met.
+type JobRunSyncMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobRunSyncMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobRunSyncMetadataMultiError) AllErrors() []error { return m }
+
+// JobRunSyncMetadataValidationError is the validation error returned by
+// JobRunSyncMetadata.Validate if the designated constraints aren't met.
+type JobRunSyncMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobRunSyncMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobRunSyncMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobRunSyncMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobRunSyncMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobRunSyncMetadataValidationError) ErrorName() string {
+	return "JobRunSyncMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e JobRunSyncMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobRunSyncMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobRunSyncMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobRunSyncMetadataValidationError{}
+
+// Validate checks the field values on JobRunEventMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobRunEventMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobRunEventMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobRunEventMetadataMultiError, or nil if none found.
+func (m *JobRunEventMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobRunEventMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Metadata.(type) {
+	case *JobRunEventMetadata_SyncMetadata:
+		if v == nil {
+			err := JobRunEventMetadataValidationError{
+				field:  "Metadata",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSyncMetadata()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobRunEventMetadataValidationError{
+						field:  "SyncMetadata",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobRunEventMetadataValidationError{
+						field:  "SyncMetadata",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSyncMetadata()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobRunEventMetadataValidationError{
+					field:  "SyncMetadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return JobRunEventMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobRunEventMetadataMultiError is an error wrapping multiple validation
+// errors returned by JobRunEventMetadata.ValidateAll() if the designated
+// constraints aren't met.
+type JobRunEventMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobRunEventMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobRunEventMetadataMultiError) AllErrors() []error { return m }
+
+// JobRunEventMetadataValidationError is the validation error returned by
+// JobRunEventMetadata.Validate if the designated constraints aren't met.
+type JobRunEventMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobRunEventMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobRunEventMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobRunEventMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobRunEventMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobRunEventMetadataValidationError) ErrorName() string {
+	return "JobRunEventMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e JobRunEventMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobRunEventMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobRunEventMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobRunEventMetadataValidationError{}
+
+// Validate checks the field values on JobRunEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobRunEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobRunEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JobRunEventMultiError, or
+// nil if none found.
+func (m *JobRunEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobRunEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobRunEventValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobRunEventValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobRunEventValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCloseTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobRunEventValidationError{
+					field:  "CloseTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobRunEventValidationError{
+					field:  "CloseTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCloseTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobRunEventValidationError{
+				field:  "CloseTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobRunEventValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobRunEventValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobRunEventValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTasks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobRunEventValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobRunEventValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobRunEventValidationError{
+					field:  fmt.Sprintf("Tasks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return JobRunEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobRunEventMultiError is an error wrapping multiple validation errors
+// returned by JobRunEvent.ValidateAll() if the designated constraints aren't met.
+type JobRunEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobRunEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobRunEventMultiError) AllErrors() []error { return m }
+
+// JobRunEventValidationError is the validation error returned by
+// JobRunEvent.Validate if the designated constraints aren't met.
+type JobRunEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobRunEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobRunEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobRunEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobRunEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobRunEventValidationError) ErrorName() string { return "JobRunEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobRunEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobRunEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobRunEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobRunEventValidationError{}
+
+// Validate checks the field values on GetJobRunEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunEventsRequestMultiError, or nil if none found.
+func (m *GetJobRunEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobRunId
+
+	// no validation rules for AccountId
+
+	if len(errors) > 0 {
+		return GetJobRunEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRunEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetJobRunEventsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobRunEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRunEventsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRunEventsRequestMultiError) AllErrors() []error { return m }
+
+// GetJobRunEventsRequestValidationError is the validation error returned by
+// GetJobRunEventsRequest.Validate if the designated constraints aren't met.
+type GetJobRunEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRunEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRunEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRunEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRunEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRunEventsRequestValidationError) ErrorName() string {
+	return "GetJobRunEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobRunEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRunEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRunEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRunEventsRequestValidationError{}
+
+// Validate checks the field values on GetJobRunEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunEventsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunEventsResponseMultiError, or nil if none found.
+func (m *GetJobRunEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJobRunEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJobRunEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJobRunEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for IsRunComplete
+
+	if len(errors) > 0 {
+		return GetJobRunEventsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRunEventsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetJobRunEventsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobRunEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRunEventsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRunEventsResponseMultiError) AllErrors() []error { return m }
+
+// GetJobRunEventsResponseValidationError is the validation error returned by
+// GetJobRunEventsResponse.Validate if the designated constraints aren't met.
+type GetJobRunEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRunEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRunEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRunEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRunEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRunEventsResponseValidationError) ErrorName() string {
+	return "GetJobRunEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobRunEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRunEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRunEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRunEventsResponseValidationError{}
+
+// Validate checks the field values on DeleteJobRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteJobRunRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteJobRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteJobRunRequestMultiError, or nil if none found.
+func (m *DeleteJobRunRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteJobRunRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobRunId
+
+	// no validation rules for AccountId
+
+	if len(errors) > 0 {
+		return DeleteJobRunRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteJobRunRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteJobRunRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteJobRunRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteJobRunRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteJobRunRequestMultiError) AllErrors() []error { return m }
+
+// DeleteJobRunRequestValidationError is the validation error returned by
+// DeleteJobRunRequest.Validate if the designated constraints aren't met.
+type DeleteJobRunRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteJobRunRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteJobRunRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteJobRunRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteJobRunRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteJobRunRequestValidationError) ErrorName() string {
+	return "DeleteJobRunRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteJobRunRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteJobRunRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteJobRunRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteJobRunRequestValidationError{}
+
+// Validate checks the field values on DeleteJobRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteJobRunResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteJobRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteJobRunResponseMultiError, or nil if none found.
+func (m *DeleteJobRunResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteJobRunResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteJobRunResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteJobRunResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteJobRunResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteJobRunResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteJobRunResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteJobRunResponseMultiError) AllErrors() []error { return m }
+
+// DeleteJobRunResponseValidationError is the validation error returned by
+// DeleteJobRunResponse.Validate if the designated constraints aren't met.
+type DeleteJobRunResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteJobRunResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteJobRunResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteJobRunResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteJobRunResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteJobRunResponseValidationError) ErrorName() string {
+	return "DeleteJobRunResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteJobRunResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteJobRunResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteJobRunResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteJobRunResponseValidationError{}
+
+// Validate checks the field values on TerminateJobRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TerminateJobRunRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TerminateJobRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TerminateJobRunRequestMultiError, or nil if none found.
+func (m *TerminateJobRunRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TerminateJobRunRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobRunId
+
+	// no validation rules for AccountId
+
+	if len(errors) > 0 {
+		return TerminateJobRunRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TerminateJobRunRequestMultiError is an error wrapping multiple validation
+// errors returned by TerminateJobRunRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TerminateJobRunRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TerminateJobRunRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TerminateJobRunRequestMultiError) AllErrors() []error { return m }
+
+// TerminateJobRunRequestValidationError is the validation error returned by
+// TerminateJobRunRequest.Validate if the designated constraints aren't met.
+type TerminateJobRunRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TerminateJobRunRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TerminateJobRunRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TerminateJobRunRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TerminateJobRunRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TerminateJobRunRequestValidationError) ErrorName() string {
+	return "TerminateJobRunRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TerminateJobRunRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTerminateJobRunRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TerminateJobRunRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TerminateJobRunRequestValidationError{}
+
+// Validate checks the field values on TerminateJobRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TerminateJobRunResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TerminateJobRunResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TerminateJobRunResponseMultiError, or nil if none found.
+func (m *TerminateJobRunResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TerminateJobRunResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return TerminateJobRunResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TerminateJobRunResponseMultiError is an error wrapping multiple validation
+// errors returned by TerminateJobRunResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TerminateJobRunResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TerminateJobRunResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TerminateJobRunResponseMultiError) AllErrors() []error { return m }
+
+// TerminateJobRunResponseValidationError is the validation error returned by
+// TerminateJobRunResponse.Validate if the designated constraints aren't met.
+type TerminateJobRunResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TerminateJobRunResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TerminateJobRunResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TerminateJobRunResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TerminateJobRunResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TerminateJobRunResponseValidationError) ErrorName() string {
+	return "TerminateJobRunResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TerminateJobRunResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTerminateJobRunResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TerminateJobRunResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TerminateJobRunResponseValidationError{}
+
+// Validate checks the field values on GetJobRunLogsStreamRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunLogsStreamRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunLogsStreamRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunLogsStreamRequestMultiError, or nil if none found.
+func (m *GetJobRunLogsStreamRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunLogsStreamRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobRunId
+
+	// no validation rules for AccountId
+
+	// no validation rules for Window
+
+	// no validation rules for ShouldTail
+
+	if m.MaxLogLines != nil {
+		// no validation rules for MaxLogLines
+	}
+
+	if len(errors) > 0 {
+		return GetJobRunLogsStreamRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRunLogsStreamRequestMultiError is an error wrapping multiple
+// validation errors returned by GetJobRunLogsStreamRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetJobRunLogsStreamRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRunLogsStreamRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRunLogsStreamRequestMultiError) AllErrors() []error { return m }
+
+// GetJobRunLogsStreamRequestValidationError is the validation error returned
+// by GetJobRunLogsStreamRequest.Validate if the designated constraints aren't met.
+type GetJobRunLogsStreamRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRunLogsStreamRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRunLogsStreamRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRunLogsStreamRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRunLogsStreamRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRunLogsStreamRequestValidationError) ErrorName() string {
+	return "GetJobRunLogsStreamRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobRunLogsStreamRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRunLogsStreamRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRunLogsStreamRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRunLogsStreamRequestValidationError{}
+
+// Validate checks the field values on GetJobRunLogsStreamResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunLogsStreamResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunLogsStreamResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunLogsStreamResponseMultiError, or nil if none found.
+func (m *GetJobRunLogsStreamResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunLogsStreamResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LogLine
+
+	if m.Timestamp != nil {
+
+		if all {
+			switch v := interface{}(m.GetTimestamp()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJobRunLogsStreamResponseValidationError{
+						field:  "Timestamp",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJobRunLogsStreamResponseValidationError{
+						field:  "Timestamp",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJobRunLogsStreamResponseValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetJobRunLogsStreamResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRunLogsStreamResponseMultiError is an error wrapping multiple
+// validation errors returned by GetJobRunLogsStreamResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetJobRunLogsStreamResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRunLogsStreamResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRunLogsStreamResponseMultiError) AllErrors() []error { return m }
+
+// GetJobRunLogsStreamResponseValidationError is the validation error returned
+// by GetJobRunLogsStreamResponse.Validate if the designated constraints
+// aren't met.
+type GetJobRunLogsStreamResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRunLogsStreamResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRunLogsStreamResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRunLogsStreamResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRunLogsStreamResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRunLogsStreamResponseValidationError) ErrorName() string {
+	return "GetJobRunLogsStreamResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobRunLogsStreamResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRunLogsStreamResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRunLogsStreamResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRunLogsStreamResponseValidationError{}
+
+// Validate checks the field values on SetJobWorkflowOptionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetJobWorkflowOptionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetJobWorkflowOptionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetJobWorkflowOptionsRequestMultiError, or nil if none found.
+func (m *SetJobWorkflowOptionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetJobWorkflowOptionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetWorfklowOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetJobWorkflowOptionsRequestValidationError{
+					field:  "WorfklowOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetJobWorkflowOptionsRequestValidationError{
+					field:  "WorfklowOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWorfklowOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetJobWorkflowOptionsRequestValidationError{
+				field:  "WorfklowOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetJobWorkflowOptionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetJobWorkflowOptionsRequestMultiError is an error wrapping multiple
+// validation errors returned by SetJobWorkflowOptionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetJobWorkflowOptionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetJobWorkflowOptionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetJobWorkflowOptionsRequestMultiError) AllErrors() []error { return m }
+
+// SetJobWorkflowOptionsRequestValidationError is the validation error returned
+// by SetJobWorkflowOptionsRequest.Validate if the designated constraints
+// aren't met.
+type SetJobWorkflowOptionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetJobWorkflowOptionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetJobWorkflowOptionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetJobWorkflowOptionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetJobWorkflowOptionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetJobWorkflowOptionsRequestValidationError) ErrorName() string {
+	return "SetJobWorkflowOptionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetJobWorkflowOptionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetJobWorkflowOptionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetJobWorkflowOptionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetJobWorkflowOptionsRequestValidationError{}
+
+// Validate checks the field values on SetJobWorkflowOptionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetJobWorkflowOptionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetJobWorkflowOptionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetJobWorkflowOptionsResponseMultiError, or nil if none found.
+func (m *SetJobWorkflowOptionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetJobWorkflowOptionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetJobWorkflowOptionsResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetJobWorkflowOptionsResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetJobWorkflowOptionsResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetJobWorkflowOptionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetJobWorkflowOptionsResponseMultiError is an error wrapping multiple
+// validation errors returned by SetJobWorkflowOptionsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type SetJobWorkflowOptionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetJobWorkflowOptionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetJobWorkflowOptionsResponseMultiError) AllErrors() []error { return m }
+
+// SetJobWorkflowOptionsResponseValidationError is the validation error
+// returned by SetJobWorkflowOptionsResponse.Validate if the designated
+// constraints aren't met.
+type SetJobWorkflowOptionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetJobWorkflowOptionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetJobWorkflowOptionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetJobWorkflowOptionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetJobWorkflowOptionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetJobWorkflowOptionsResponseValidationError) ErrorName() string {
+	return "SetJobWorkflowOptionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetJobWorkflowOptionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetJobWorkflowOptionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetJobWorkflowOptionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetJobWorkflowOptionsResponseValidationError{}
+
+// Validate checks the field values on SetJobSyncOptionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetJobSyncOptionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetJobSyncOptionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetJobSyncOptionsRequestMultiError, or nil if none found.
+func (m *SetJobSyncOptionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetJobSyncOptionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetSyncOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetJobSyncOptionsRequestValidationError{
+					field:  "SyncOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetJobSyncOptionsRequestValidationError{
+					field:  "SyncOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSyncOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetJobSyncOptionsRequestValidationError{
+				field:  "SyncOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetJobSyncOptionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetJobSyncOptionsRequestMultiError is an error wrapping multiple validation
+// errors returned by SetJobSyncOptionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetJobSyncOptionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetJobSyncOptionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetJobSyncOptionsRequestMultiError) AllErrors() []error { return m }
+
+// SetJobSyncOptionsRequestValidationError is the validation error returned by
+// SetJobSyncOptionsRequest.Validate if the designated constraints aren't met.
+type SetJobSyncOptionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetJobSyncOptionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetJobSyncOptionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetJobSyncOptionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetJobSyncOptionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetJobSyncOptionsRequestValidationError) ErrorName() string {
+	return "SetJobSyncOptionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetJobSyncOptionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetJobSyncOptionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetJobSyncOptionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetJobSyncOptionsRequestValidationError{}
+
+// Validate checks the field values on SetJobSyncOptionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetJobSyncOptionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetJobSyncOptionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetJobSyncOptionsResponseMultiError, or nil if none found.
+func (m *SetJobSyncOptionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetJobSyncOptionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetJobSyncOptionsResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetJobSyncOptionsResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetJobSyncOptionsResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetJobSyncOptionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetJobSyncOptionsResponseMultiError is an error wrapping multiple validation
+// errors returned by SetJobSyncOptionsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type SetJobSyncOptionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetJobSyncOptionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetJobSyncOptionsResponseMultiError) AllErrors() []error { return m }
+
+// SetJobSyncOptionsResponseValidationError is the validation error returned by
+// SetJobSyncOptionsResponse.Validate if the designated constraints aren't met.
+type SetJobSyncOptionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetJobSyncOptionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetJobSyncOptionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetJobSyncOptionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetJobSyncOptionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetJobSyncOptionsResponseValidationError) ErrorName() string {
+	return "SetJobSyncOptionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetJobSyncOptionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetJobSyncOptionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetJobSyncOptionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetJobSyncOptionsResponseValidationError{}
+
+// Validate checks the field values on SetJobTableProcessorsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetJobTableProcessorsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetJobTableProcessorsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetJobTableProcessorsRequestMultiError, or nil if none found.
+func (m *SetJobTableProcessorsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetJobTableProcessorsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	for idx, item := range m.GetTableProcessors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SetJobTableProcessorsRequestValidationError{
+						field:  fmt.Sprintf("TableProcessors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SetJobTableProcessorsRequestValidationError{
+						field:  fmt.Sprintf("TableProcessors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SetJobTableProcessorsRequestValidationError{
+					field:  fmt.Sprintf("TableProcessors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SetJobTableProcessorsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetJobTableProcessorsRequestMultiError is an error wrapping multiple
+// validation errors returned by SetJobTableProcessorsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetJobTableProcessorsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetJobTableProcessorsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetJobTableProcessorsRequestMultiError) AllErrors() []error { return m }
+
+// SetJobTableProcessorsRequestValidationError is the validation error returned
+// by SetJobTableProcessorsRequest.Validate if the designated constraints
+// aren't met.
+type SetJobTableProcessorsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetJobTableProcessorsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetJobTableProcessorsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetJobTableProcessorsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetJobTableProcessorsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetJobTableProcessorsRequestValidationError) ErrorName() string {
+	return "SetJobTableProcessorsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetJobTableProcessorsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetJobTableProcessorsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetJobTableProcessorsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetJobTableProcessorsRequestValidationError{}
+
+// Validate checks the field values on SetJobTableProcessorsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetJobTableProcessorsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetJobTableProcessorsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetJobTableProcessorsResponseMultiError, or nil if none found.
+func (m *SetJobTableProcessorsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetJobTableProcessorsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetJobTableProcessorsResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetJobTableProcessorsResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetJobTableProcessorsResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetJobTableProcessorsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetJobTableProcessorsResponseMultiError is an error wrapping multiple
+// validation errors returned by SetJobTableProcessorsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type SetJobTableProcessorsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetJobTableProcessorsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetJobTableProcessorsResponseMultiError) AllErrors() []error { return m }
+
+// SetJobTableProcessorsResponseValidationError is the validation error
+// returned by SetJobTableProcessorsResponse.Validate if the designated
+// constraints aren't met.
+type SetJobTableProcessorsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetJobTableProcessorsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetJobTableProcessorsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetJobTableProcessorsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetJobTableProcessorsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetJobTableProcessorsResponseValidationError) ErrorName() string {
+	return "SetJobTableProcessorsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetJobTableProcessorsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetJobTableProcessorsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetJobTableProcessorsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetJobTableProcessorsResponseValidationError{}
+
+// Validate checks the field values on ValidateJobMappingsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidateJobMappingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidateJobMappingsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidateJobMappingsRequestMultiError, or nil if none found.
+func (m *ValidateJobMappingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidateJobMappingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	for idx, item := range m.GetMappings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ValidateJobMappingsRequestValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ValidateJobMappingsRequestValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ValidateJobMappingsRequestValidationError{
+					field:  fmt.Sprintf("Mappings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ConnectionId
+
+	if len(errors) > 0 {
+		return ValidateJobMappingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidateJobMappingsRequestMultiError is an error wrapping multiple
+// validation errors returned by ValidateJobMappingsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ValidateJobMappingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidateJobMappingsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidateJobMappingsRequestMultiError) AllErrors() []error { return m }
+
+// ValidateJobMappingsRequestValidationError is the validation error returned
+// by ValidateJobMappingsRequest.Validate if the designated constraints aren't met.
+type ValidateJobMappingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidateJobMappingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidateJobMappingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidateJobMappingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidateJobMappingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidateJobMappingsRequestValidationError) ErrorName() string {
+	return "ValidateJobMappingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidateJobMappingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidateJobMappingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidateJobMappingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidateJobMappingsRequestValidationError{}
+
+// Validate checks the field values on ColumnError with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ColumnError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnError with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ColumnErrorMultiError, or
+// nil if none found.
+func (m *ColumnError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for Column
+
+	if len(errors) > 0 {
+		return ColumnErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnErrorMultiError is an error wrapping multiple validation errors
+// returned by ColumnError.ValidateAll() if the designated constraints aren't met.
+type ColumnErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnErrorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnErrorMultiError) AllErrors() []error { return m }
+
+// ColumnErrorValidationError is the validation error returned by
+// ColumnError.Validate if the designated constraints aren't met.
+type ColumnErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnErrorValidationError) ErrorName() string { return "ColumnErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ColumnErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnErrorValidationError{}
+
+// Validate checks the field values on DatabaseError with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DatabaseError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DatabaseError with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DatabaseErrorMultiError, or
+// nil if none found.
+func (m *DatabaseError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DatabaseError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DatabaseErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// DatabaseErrorMultiError is an error wrapping multiple validation errors
+// returned by DatabaseError.ValidateAll() if the designated constraints
+// aren't met.
+type DatabaseErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DatabaseErrorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DatabaseErrorMultiError) AllErrors() []error { return m }
+
+// DatabaseErrorValidationError is the validation error returned by
+// DatabaseError.Validate if the designated constraints aren't met.
+type DatabaseErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DatabaseErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DatabaseErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DatabaseErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DatabaseErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DatabaseErrorValidationError) ErrorName() string { return "DatabaseErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DatabaseErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDatabaseError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DatabaseErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DatabaseErrorValidationError{}
+
+// Validate checks the field values on ValidateJobMappingsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidateJobMappingsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidateJobMappingsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidateJobMappingsResponseMultiError, or nil if none found.
+func (m *ValidateJobMappingsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidateJobMappingsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetColumnErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ValidateJobMappingsResponseValidationError{
+						field:  fmt.Sprintf("ColumnErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ValidateJobMappingsResponseValidationError{
+						field:  fmt.Sprintf("ColumnErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ValidateJobMappingsResponseValidationError{
+					field:  fmt.Sprintf("ColumnErrors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetDatabaseErrors()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ValidateJobMappingsResponseValidationError{
+					field:  "DatabaseErrors",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ValidateJobMappingsResponseValidationError{
+					field:  "DatabaseErrors",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDatabaseErrors()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ValidateJobMappingsResponseValidationError{
+				field:  "DatabaseErrors",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetViolations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ValidateJobMappingsResponseValidationError{
+						field:  fmt.Sprintf("Violations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ValidateJobMappingsResponseValidationError{
+						field:  fmt.Sprintf("Violations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ValidateJobMappingsResponseValidationError{
+					field:  fmt.Sprintf("Violations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ValidateJobMappingsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidateJobMappingsResponseMultiError is an error wrapping multiple
+// validation errors returned by ValidateJobMappingsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ValidateJobMappingsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidateJobMappingsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidateJobMappingsResponseMultiError) AllErrors() []error { return m }
+
+// ValidateJobMappingsResponseValidationError is the validation error returned
+// by ValidateJobMappingsResponse.Validate if the designated constraints
+// aren't met.
+type ValidateJobMappingsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidateJobMappingsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidateJobMappingsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidateJobMappingsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidateJobMappingsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidateJobMappingsResponseValidationError) ErrorName() string {
+	return "ValidateJobMappingsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidateJobMappingsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidateJobMappingsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidateJobMappingsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidateJobMappingsResponseValidationError{}
+
+// Validate checks the field values on SetJobLabelsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetJobLabelsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetJobLabelsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetJobLabelsRequestMultiError, or nil if none found.
+func (m *SetJobLabelsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetJobLabelsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Labels
+
+	if len(errors) > 0 {
+		return SetJobLabelsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetJobLabelsRequestMultiError is an error wrapping multiple validation
+// errors returned by SetJobLabelsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetJobLabelsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetJobLabelsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetJobLabelsRequestMultiError) AllErrors() []error { return m }
+
+// SetJobLabelsRequestValidationError is the validation error returned by
+// SetJobLabelsRequest.Validate if the designated constraints aren't met.
+type SetJobLabelsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetJobLabelsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetJobLabelsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetJobLabelsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetJobLabelsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetJobLabelsRequestValidationError) ErrorName() string {
+	return "SetJobLabelsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetJobLabelsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetJobLabelsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetJobLabelsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetJobLabelsRequestValidationError{}
+
+// Validate checks the field values on SetJobLabelsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetJobLabelsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetJobLabelsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetJobLabelsResponseMultiError, or nil if none found.
+func (m *SetJobLabelsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetJobLabelsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetJobLabelsResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetJobLabelsResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetJobLabelsResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetJobLabelsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetJobLabelsResponseMultiError is an error wrapping multiple validation
+// errors returned by SetJobLabelsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetJobLabelsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetJobLabelsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetJobLabelsResponseMultiError) AllErrors() []error { return m }
+
+// SetJobLabelsResponseValidationError is the validation error returned by
+// SetJobLabelsResponse.Validate if the designated constraints aren't met.
+type SetJobLabelsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetJobLabelsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetJobLabelsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetJobLabelsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetJobLabelsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetJobLabelsResponseValidationError) ErrorName() string {
+	return "SetJobLabelsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetJobLabelsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetJobLabelsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetJobLabelsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetJobLabelsResponseValidationError{}
+
+// Validate checks the field values on ResumeJobRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ResumeJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeJobRequestMultiError, or nil if none found.
+func (m *ResumeJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.Note != nil {
+		// no validation rules for Note
+	}
+
+	if len(errors) > 0 {
+		return ResumeJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeJobRequestMultiError is an error wrapping multiple validation errors
+// returned by ResumeJobRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ResumeJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeJobRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeJobRequestMultiError) AllErrors() []error { return m }
+
+// ResumeJobRequestValidationError is the validation error returned by
+// ResumeJobRequest.Validate if the designated constraints aren't met.
+type ResumeJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeJobRequestValidationError) ErrorName() string { return "ResumeJobRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResumeJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeJobRequestValidationError{}
+
+// Validate checks the field values on ResumeJobResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ResumeJobResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeJobResponseMultiError, or nil if none found.
+func (m *ResumeJobResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeJobResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResumeJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResumeJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResumeJobResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ResumeJobResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeJobResponseMultiError is an error wrapping multiple validation errors
+// returned by ResumeJobResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ResumeJobResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeJobResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeJobResponseMultiError) AllErrors() []error { return m }
+
+// ResumeJobResponseValidationError is the validation error returned by
+// ResumeJobResponse.Validate if the designated constraints aren't met.
+type ResumeJobResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeJobResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeJobResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeJobResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeJobResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeJobResponseValidationError) ErrorName() string {
+	return "ResumeJobResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResumeJobResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeJobResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeJobResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeJobResponseValidationError{}
+
+// Validate checks the field values on GetNextScheduledRunsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetNextScheduledRunsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetNextScheduledRunsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetNextScheduledRunsRequestMultiError, or nil if none found.
+func (m *GetNextScheduledRunsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetNextScheduledRunsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobId
+
+	if m.Count != nil {
+		// no validation rules for Count
+	}
+
+	if len(errors) > 0 {
+		return GetNextScheduledRunsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetNextScheduledRunsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetNextScheduledRunsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetNextScheduledRunsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetNextScheduledRunsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetNextScheduledRunsRequestMultiError) AllErrors() []error { return m }
+
+// GetNextScheduledRunsRequestValidationError is the validation error returned
+// by GetNextScheduledRunsRequest.Validate if the designated constraints
+// aren't met.
+type GetNextScheduledRunsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetNextScheduledRunsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetNextScheduledRunsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetNextScheduledRunsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetNextScheduledRunsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetNextScheduledRunsRequestValidationError) ErrorName() string {
+	return "GetNextScheduledRunsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetNextScheduledRunsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetNextScheduledRunsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetNextScheduledRunsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetNextScheduledRunsRequestValidationError{}
+
+// Validate checks the field values on GetNextScheduledRunsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetNextScheduledRunsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetNextScheduledRunsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetNextScheduledRunsResponseMultiError, or nil if none found.
+func (m *GetNextScheduledRunsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetNextScheduledRunsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetNextRunTimes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetNextScheduledRunsResponseValidationError{
+						field:  fmt.Sprintf("NextRunTimes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetNextScheduledRunsResponseValidationError{
+						field:  fmt.Sprintf("NextRunTimes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetNextScheduledRunsResponseValidationError{
+					field:  fmt.Sprintf("NextRunTimes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Paused
+
+	if len(errors) > 0 {
+		return GetNextScheduledRunsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetNextScheduledRunsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetNextScheduledRunsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetNextScheduledRunsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetNextScheduledRunsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetNextScheduledRunsResponseMultiError) AllErrors() []error { return m }
+
+// GetNextScheduledRunsResponseValidationError is the validation error returned
+// by GetNextScheduledRunsResponse.Validate if the designated constraints
+// aren't met.
+type GetNextScheduledRunsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetNextScheduledRunsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetNextScheduledRunsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetNextScheduledRunsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetNextScheduledRunsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetNextScheduledRunsResponseValidationError) ErrorName() string {
+	return "GetNextScheduledRunsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetNextScheduledRunsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetNextScheduledRunsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetNextScheduledRunsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetNextScheduledRunsResponseValidationError{}
+
+// Validate checks the field values on GetJobRunPriorityRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunPriorityRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunPriorityRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunPriorityRequestMultiError, or nil if none found.
+func (m *GetJobRunPriorityRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunPriorityRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobId
+
+	// no validation rules for JobRunId
+
+	if len(errors) > 0 {
+		return GetJobRunPriorityRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRunPriorityRequestMultiError is an error wrapping multiple validation
+// errors returned by GetJobRunPriorityRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobRunPriorityRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRunPriorityRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRunPriorityRequestMultiError) AllErrors() []error { return m }
+
+// GetJobRunPriorityRequestValidationError is the validation error returned by
+// GetJobRunPriorityRequest.Validate if the designated constraints aren't met.
+type GetJobRunPriorityRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRunPriorityRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRunPriorityRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRunPriorityRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRunPriorityRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRunPriorityRequestValidationError) ErrorName() string {
+	return "GetJobRunPriorityRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobRunPriorityRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRunPriorityRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRunPriorityRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRunPriorityRequestValidationError{}
+
+// Validate checks the field values on GetJobRunPriorityResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunPriorityResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunPriorityResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunPriorityResponseMultiError, or nil if none found.
+func (m *GetJobRunPriorityResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunPriorityResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Priority
+
+	if len(errors) > 0 {
+		return GetJobRunPriorityResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRunPriorityResponseMultiError is an error wrapping multiple validation
+// errors returned by GetJobRunPriorityResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetJobRunPriorityResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRunPriorityResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRunPriorityResponseMultiError) AllErrors() []error { return m }
+
+// GetJobRunPriorityResponseValidationError is the validation error returned by
+// GetJobRunPriorityResponse.Validate if the designated constraints aren't met.
+type GetJobRunPriorityResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRunPriorityResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRunPriorityResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRunPriorityResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRunPriorityResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRunPriorityResponseValidationError) ErrorName() string {
+	return "GetJobRunPriorityResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobRunPriorityResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRunPriorityResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRunPriorityResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRunPriorityResponseValidationError{}
+
+// Validate checks the field values on ForceCancelJobRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ForceCancelJobRunRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ForceCancelJobRunRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ForceCancelJobRunRequestMultiError, or nil if none found.
+func (m *ForceCancelJobRunRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ForceCancelJobRunRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobRunId
+
+	// no validation rules for AccountId
+
+	if m.Reason != nil {
+		// no validation rules for Reason
+	}
+
+	if len(errors) > 0 {
+		return ForceCancelJobRunRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ForceCancelJobRunRequestMultiError is an error wrapping multiple validation
+// errors returned by ForceCancelJobRunRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ForceCancelJobRunRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ForceCancelJobRunRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ForceCancelJobRunRequestMultiError) AllErrors() []error { return m }
+
+// ForceCancelJobRunRequestValidationError is the validation error returned by
+// ForceCancelJobRunRequest.Validate if the designated constraints aren't met.
+type ForceCancelJobRunRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForceCancelJobRunRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForceCancelJobRunRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForceCancelJobRunRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForceCancelJobRunRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForceCancelJobRunRequestValidationError) ErrorName() string {
+	return "ForceCancelJobRunRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ForceCancelJobRunRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForceCancelJobRunRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForceCancelJobRunRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForceCancelJobRunRequestValidationError{}
+
+// Validate checks the field values on ForceCancelJobRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ForceCancelJobRunResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ForceCancelJobRunResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ForceCancelJobRunResponseMultiError, or nil if none found.
+func (m *ForceCancelJobRunResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ForceCancelJobRunResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	// no validation rules for UpdatedByUserId
+	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetUpdatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobValidationError{
-				field:  "UpdatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if len(errors) > 0 {
+		return ForceCancelJobRunResponseMultiError(errors)
 	}
 
-	// no validation rules for Name
+	return nil
+}
 
-	if all {
-		switch v := interface{}(m.GetSource()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "Source",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "Source",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSource()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobValidationError{
-				field:  "Source",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+// ForceCancelJobRunResponseMultiError is an error wrapping multiple validation
+// errors returned by ForceCancelJobRunResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ForceCancelJobRunResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ForceCancelJobRunResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	for idx, item := range m.GetDestinations() {
-		_, _ = idx, item
+// AllErrors returns a list of validation violation errors.
+func (m ForceCancelJobRunResponseMultiError) AllErrors() []error { return m }
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobValidationError{
-						field:  fmt.Sprintf("Destinations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, JobValidationError{
-						field:  fmt.Sprintf("Destinations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return JobValidationError{
-					field:  fmt.Sprintf("Destinations[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// ForceCancelJobRunResponseValidationError is the validation error returned by
+// ForceCancelJobRunResponse.Validate if the designated constraints aren't met.
+type ForceCancelJobRunResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-	}
+// Field function returns field value.
+func (e ForceCancelJobRunResponseValidationError) Field() string { return e.field }
 
-	for idx, item := range m.GetMappings() {
-		_, _ = idx, item
+// Reason function returns reason value.
+func (e ForceCancelJobRunResponseValidationError) Reason() string { return e.reason }
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobValidationError{
-						field:  fmt.Sprintf("Mappings[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, JobValidationError{
-						field:  fmt.Sprintf("Mappings[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return JobValidationError{
-					field:  fmt.Sprintf("Mappings[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// Cause function returns cause value.
+func (e ForceCancelJobRunResponseValidationError) Cause() error { return e.cause }
 
-	}
+// Key function returns key value.
+func (e ForceCancelJobRunResponseValidationError) Key() bool { return e.key }
 
-	// no validation rules for AccountId
+// ErrorName returns error name.
+func (e ForceCancelJobRunResponseValidationError) ErrorName() string {
+	return "ForceCancelJobRunResponseValidationError"
+}
 
-	if all {
-		switch v := interface{}(m.GetSyncOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "SyncOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "SyncOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSyncOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobValidationError{
-				field:  "SyncOptions",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+// Error satisfies the builtin error interface
+func (e ForceCancelJobRunResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	if all {
-		switch v := interface{}(m.GetWorkflowOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "WorkflowOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "WorkflowOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetWorkflowOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobValidationError{
-				field:  "WorkflowOptions",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	key := ""
+	if e.key {
+		key = "key for "
 	}
 
-	if m.CronSchedule != nil {
-		// no validation rules for CronSchedule
+	return fmt.Sprintf(
+		"invalid %sForceCancelJobRunResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForceCancelJobRunResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForceCancelJobRunResponseValidationError{}
+
+// Validate checks the field values on GetJobRunRowCountsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunRowCountsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunRowCountsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunRowCountsRequestMultiError, or nil if none found.
+func (m *GetJobRunRowCountsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunRowCountsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for JobRunId
+
+	// no validation rules for AccountId
+
 	if len(errors) > 0 {
-		return JobMultiError(errors)
+		return GetJobRunRowCountsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobMultiError is an error wrapping multiple validation errors returned by
-// Job.ValidateAll() if the designated constraints aren't met.
-type JobMultiError []error
+// GetJobRunRowCountsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetJobRunRowCountsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetJobRunRowCountsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobMultiError) Error() string {
+func (m GetJobRunRowCountsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8837,11 +21436,11 @@ func (m JobMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobMultiError) AllErrors() []error { return m }
+func (m GetJobRunRowCountsRequestMultiError) AllErrors() []error { return m }
 
-// JobValidationError is the validation error returned by Job.Validate if the
-// designated constraints aren't met.
-type JobValidationError struct {
+// GetJobRunRowCountsRequestValidationError is the validation error returned by
+// GetJobRunRowCountsRequest.Validate if the designated constraints aren't met.
+type GetJobRunRowCountsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8849,22 +21448,24 @@ type JobValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobValidationError) Field() string { return e.field }
+func (e GetJobRunRowCountsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobValidationError) Reason() string { return e.reason }
+func (e GetJobRunRowCountsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobValidationError) Cause() error { return e.cause }
+func (e GetJobRunRowCountsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobValidationError) Key() bool { return e.key }
+func (e GetJobRunRowCountsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobValidationError) ErrorName() string { return "JobValidationError" }
+func (e GetJobRunRowCountsRequestValidationError) ErrorName() string {
+	return "GetJobRunRowCountsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e JobValidationError) Error() string {
+func (e GetJobRunRowCountsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8876,14 +21477,14 @@ func (e JobValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJob.%s: %s%s",
+		"invalid %sGetJobRunRowCountsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobValidationError{}
+var _ error = GetJobRunRowCountsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8891,74 +21492,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobValidationError{}
+} = GetJobRunRowCountsRequestValidationError{}
 
-// Validate checks the field values on JobRecentRun with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *JobRecentRun) Validate() error {
+// Validate checks the field values on GetJobRunRowCountsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunRowCountsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobRecentRun with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in JobRecentRunMultiError, or
-// nil if none found.
-func (m *JobRecentRun) ValidateAll() error {
+// ValidateAll checks the field values on GetJobRunRowCountsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunRowCountsResponseMultiError, or nil if none found.
+func (m *GetJobRunRowCountsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobRecentRun) validate(all bool) error {
+func (m *GetJobRunRowCountsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetStartTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobRecentRunValidationError{
-					field:  "StartTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetTables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJobRunRowCountsResponseValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJobRunRowCountsResponseValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, JobRecentRunValidationError{
-					field:  "StartTime",
+				return GetJobRunRowCountsResponseValidationError{
+					field:  fmt.Sprintf("Tables[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobRecentRunValidationError{
-				field:  "StartTime",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
 
-	// no validation rules for JobRunId
+	}
 
 	if len(errors) > 0 {
-		return JobRecentRunMultiError(errors)
+		return GetJobRunRowCountsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobRecentRunMultiError is an error wrapping multiple validation errors
-// returned by JobRecentRun.ValidateAll() if the designated constraints aren't met.
-type JobRecentRunMultiError []error
+// GetJobRunRowCountsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetJobRunRowCountsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetJobRunRowCountsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobRecentRunMultiError) Error() string {
+func (m GetJobRunRowCountsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8967,11 +21572,11 @@ func (m JobRecentRunMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobRecentRunMultiError) AllErrors() []error { return m }
+func (m GetJobRunRowCountsResponseMultiError) AllErrors() []error { return m }
 
-// JobRecentRunValidationError is the validation error returned by
-// JobRecentRun.Validate if the designated constraints aren't met.
-type JobRecentRunValidationError struct {
+// GetJobRunRowCountsResponseValidationError is the validation error returned
+// by GetJobRunRowCountsResponse.Validate if the designated constraints aren't met.
+type GetJobRunRowCountsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8979,22 +21584,24 @@ type JobRecentRunValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobRecentRunValidationError) Field() string { return e.field }
+func (e GetJobRunRowCountsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobRecentRunValidationError) Reason() string { return e.reason }
+func (e GetJobRunRowCountsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobRecentRunValidationError) Cause() error { return e.cause }
+func (e GetJobRunRowCountsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobRecentRunValidationError) Key() bool { return e.key }
+func (e GetJobRunRowCountsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobRecentRunValidationError) ErrorName() string { return "JobRecentRunValidationError" }
+func (e GetJobRunRowCountsResponseValidationError) ErrorName() string {
+	return "GetJobRunRowCountsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e JobRecentRunValidationError) Error() string {
+func (e GetJobRunRowCountsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9006,14 +21613,14 @@ func (e JobRecentRunValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobRecentRun.%s: %s%s",
+		"invalid %sGetJobRunRowCountsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobRecentRunValidationError{}
+var _ error = GetJobRunRowCountsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9021,46 +21628,86 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobRecentRunValidationError{}
+} = GetJobRunRowCountsResponseValidationError{}
 
-// Validate checks the field values on GetJobRecentRunsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRecentRunsRequest) Validate() error {
+// Validate checks the field values on TableRowCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TableRowCount) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRecentRunsRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetJobRecentRunsRequestMultiError, or nil if none found.
-func (m *GetJobRecentRunsRequest) ValidateAll() error {
+// ValidateAll checks the field values on TableRowCount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TableRowCountMultiError, or
+// nil if none found.
+func (m *TableRowCount) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRecentRunsRequest) validate(all bool) error {
+func (m *TableRowCount) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobId
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for RowsRead
+
+	for idx, item := range m.GetDestinations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TableRowCountValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TableRowCountValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TableRowCountValidationError{
+					field:  fmt.Sprintf("Destinations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for HasDiscrepancy
 
 	if len(errors) > 0 {
-		return GetJobRecentRunsRequestMultiError(errors)
+		return TableRowCountMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRecentRunsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetJobRecentRunsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetJobRecentRunsRequestMultiError []error
+// TableRowCountMultiError is an error wrapping multiple validation errors
+// returned by TableRowCount.ValidateAll() if the designated constraints
+// aren't met.
+type TableRowCountMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRecentRunsRequestMultiError) Error() string {
+func (m TableRowCountMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9069,11 +21716,11 @@ func (m GetJobRecentRunsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRecentRunsRequestMultiError) AllErrors() []error { return m }
+func (m TableRowCountMultiError) AllErrors() []error { return m }
 
-// GetJobRecentRunsRequestValidationError is the validation error returned by
-// GetJobRecentRunsRequest.Validate if the designated constraints aren't met.
-type GetJobRecentRunsRequestValidationError struct {
+// TableRowCountValidationError is the validation error returned by
+// TableRowCount.Validate if the designated constraints aren't met.
+type TableRowCountValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9081,24 +21728,22 @@ type GetJobRecentRunsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRecentRunsRequestValidationError) Field() string { return e.field }
+func (e TableRowCountValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRecentRunsRequestValidationError) Reason() string { return e.reason }
+func (e TableRowCountValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRecentRunsRequestValidationError) Cause() error { return e.cause }
+func (e TableRowCountValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRecentRunsRequestValidationError) Key() bool { return e.key }
+func (e TableRowCountValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRecentRunsRequestValidationError) ErrorName() string {
-	return "GetJobRecentRunsRequestValidationError"
-}
+func (e TableRowCountValidationError) ErrorName() string { return "TableRowCountValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetJobRecentRunsRequestValidationError) Error() string {
+func (e TableRowCountValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9110,14 +21755,14 @@ func (e GetJobRecentRunsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRecentRunsRequest.%s: %s%s",
+		"invalid %sTableRowCount.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRecentRunsRequestValidationError{}
+var _ error = TableRowCountValidationError{}
 
 var _ interface {
 	Field() string
@@ -9125,78 +21770,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRecentRunsRequestValidationError{}
+} = TableRowCountValidationError{}
 
-// Validate checks the field values on GetJobRecentRunsResponse with the rules
+// Validate checks the field values on DestinationRowCount with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRecentRunsResponse) Validate() error {
+func (m *DestinationRowCount) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRecentRunsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DestinationRowCount with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobRecentRunsResponseMultiError, or nil if none found.
-func (m *GetJobRecentRunsResponse) ValidateAll() error {
+// DestinationRowCountMultiError, or nil if none found.
+func (m *DestinationRowCount) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRecentRunsResponse) validate(all bool) error {
+func (m *DestinationRowCount) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRecentRuns() {
-		_, _ = idx, item
+	// no validation rules for Output
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetJobRecentRunsResponseValidationError{
-						field:  fmt.Sprintf("RecentRuns[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetJobRecentRunsResponseValidationError{
-						field:  fmt.Sprintf("RecentRuns[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetJobRecentRunsResponseValidationError{
-					field:  fmt.Sprintf("RecentRuns[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for ConnectionId
 
-	}
+	// no validation rules for RowsWritten
+
+	// no validation rules for RowsSkipped
+
+	// no validation rules for HasDiscrepancy
 
 	if len(errors) > 0 {
-		return GetJobRecentRunsResponseMultiError(errors)
+		return DestinationRowCountMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRecentRunsResponseMultiError is an error wrapping multiple validation
-// errors returned by GetJobRecentRunsResponse.ValidateAll() if the designated
+// DestinationRowCountMultiError is an error wrapping multiple validation
+// errors returned by DestinationRowCount.ValidateAll() if the designated
 // constraints aren't met.
-type GetJobRecentRunsResponseMultiError []error
+type DestinationRowCountMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRecentRunsResponseMultiError) Error() string {
+func (m DestinationRowCountMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9205,11 +21826,11 @@ func (m GetJobRecentRunsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRecentRunsResponseMultiError) AllErrors() []error { return m }
+func (m DestinationRowCountMultiError) AllErrors() []error { return m }
 
-// GetJobRecentRunsResponseValidationError is the validation error returned by
-// GetJobRecentRunsResponse.Validate if the designated constraints aren't met.
-type GetJobRecentRunsResponseValidationError struct {
+// DestinationRowCountValidationError is the validation error returned by
+// DestinationRowCount.Validate if the designated constraints aren't met.
+type DestinationRowCountValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9217,24 +21838,24 @@ type GetJobRecentRunsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRecentRunsResponseValidationError) Field() string { return e.field }
+func (e DestinationRowCountValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRecentRunsResponseValidationError) Reason() string { return e.reason }
+func (e DestinationRowCountValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRecentRunsResponseValidationError) Cause() error { return e.cause }
+func (e DestinationRowCountValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRecentRunsResponseValidationError) Key() bool { return e.key }
+func (e DestinationRowCountValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRecentRunsResponseValidationError) ErrorName() string {
-	return "GetJobRecentRunsResponseValidationError"
+func (e DestinationRowCountValidationError) ErrorName() string {
+	return "DestinationRowCountValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobRecentRunsResponseValidationError) Error() string {
+func (e DestinationRowCountValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9246,14 +21867,14 @@ func (e GetJobRecentRunsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRecentRunsResponse.%s: %s%s",
+		"invalid %sDestinationRowCount.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRecentRunsResponseValidationError{}
+var _ error = DestinationRowCountValidationError{}
 
 var _ interface {
 	Field() string
@@ -9261,47 +21882,105 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRecentRunsResponseValidationError{}
+} = DestinationRowCountValidationError{}
 
-// Validate checks the field values on JobNextRuns with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *JobNextRuns) Validate() error {
+// Validate checks the field values on AccountJobDefaults with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AccountJobDefaults) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobNextRuns with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in JobNextRunsMultiError, or
-// nil if none found.
-func (m *JobNextRuns) ValidateAll() error {
+// ValidateAll checks the field values on AccountJobDefaults with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccountJobDefaultsMultiError, or nil if none found.
+func (m *AccountJobDefaults) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobNextRuns) validate(all bool) error {
+func (m *AccountJobDefaults) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSyncOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccountJobDefaultsValidationError{
+					field:  "SyncOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccountJobDefaultsValidationError{
+					field:  "SyncOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSyncOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccountJobDefaultsValidationError{
+				field:  "SyncOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWorkflowOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccountJobDefaultsValidationError{
+					field:  "WorkflowOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccountJobDefaultsValidationError{
+					field:  "WorkflowOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWorkflowOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccountJobDefaultsValidationError{
+				field:  "WorkflowOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	for idx, item := range m.GetNextRunTimes() {
+	for idx, item := range m.GetDestinationOptions() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobNextRunsValidationError{
-						field:  fmt.Sprintf("NextRunTimes[%v]", idx),
+					errors = append(errors, AccountJobDefaultsValidationError{
+						field:  fmt.Sprintf("DestinationOptions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, JobNextRunsValidationError{
-						field:  fmt.Sprintf("NextRunTimes[%v]", idx),
+					errors = append(errors, AccountJobDefaultsValidationError{
+						field:  fmt.Sprintf("DestinationOptions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -9309,8 +21988,8 @@ func (m *JobNextRuns) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return JobNextRunsValidationError{
-					field:  fmt.Sprintf("NextRunTimes[%v]", idx),
+				return AccountJobDefaultsValidationError{
+					field:  fmt.Sprintf("DestinationOptions[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -9320,18 +21999,19 @@ func (m *JobNextRuns) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return JobNextRunsMultiError(errors)
+		return AccountJobDefaultsMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobNextRunsMultiError is an error wrapping multiple validation errors
-// returned by JobNextRuns.ValidateAll() if the designated constraints aren't met.
-type JobNextRunsMultiError []error
+// AccountJobDefaultsMultiError is an error wrapping multiple validation errors
+// returned by AccountJobDefaults.ValidateAll() if the designated constraints
+// aren't met.
+type AccountJobDefaultsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobNextRunsMultiError) Error() string {
+func (m AccountJobDefaultsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9340,11 +22020,11 @@ func (m JobNextRunsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobNextRunsMultiError) AllErrors() []error { return m }
+func (m AccountJobDefaultsMultiError) AllErrors() []error { return m }
 
-// JobNextRunsValidationError is the validation error returned by
-// JobNextRuns.Validate if the designated constraints aren't met.
-type JobNextRunsValidationError struct {
+// AccountJobDefaultsValidationError is the validation error returned by
+// AccountJobDefaults.Validate if the designated constraints aren't met.
+type AccountJobDefaultsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9352,22 +22032,24 @@ type JobNextRunsValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobNextRunsValidationError) Field() string { return e.field }
+func (e AccountJobDefaultsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobNextRunsValidationError) Reason() string { return e.reason }
+func (e AccountJobDefaultsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobNextRunsValidationError) Cause() error { return e.cause }
+func (e AccountJobDefaultsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobNextRunsValidationError) Key() bool { return e.key }
+func (e AccountJobDefaultsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobNextRunsValidationError) ErrorName() string { return "JobNextRunsValidationError" }
+func (e AccountJobDefaultsValidationError) ErrorName() string {
+	return "AccountJobDefaultsValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e JobNextRunsValidationError) Error() string {
+func (e AccountJobDefaultsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9379,14 +22061,14 @@ func (e JobNextRunsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobNextRuns.%s: %s%s",
+		"invalid %sAccountJobDefaults.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobNextRunsValidationError{}
+var _ error = AccountJobDefaultsValidationError{}
 
 var _ interface {
 	Field() string
@@ -9394,46 +22076,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobNextRunsValidationError{}
+} = AccountJobDefaultsValidationError{}
 
-// Validate checks the field values on GetJobNextRunsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetAccountJobDefaultsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobNextRunsRequest) Validate() error {
+func (m *GetAccountJobDefaultsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobNextRunsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetAccountJobDefaultsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobNextRunsRequestMultiError, or nil if none found.
-func (m *GetJobNextRunsRequest) ValidateAll() error {
+// GetAccountJobDefaultsRequestMultiError, or nil if none found.
+func (m *GetAccountJobDefaultsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobNextRunsRequest) validate(all bool) error {
+func (m *GetAccountJobDefaultsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobId
+	// no validation rules for AccountId
 
 	if len(errors) > 0 {
-		return GetJobNextRunsRequestMultiError(errors)
+		return GetAccountJobDefaultsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobNextRunsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetJobNextRunsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetJobNextRunsRequestMultiError []error
+// GetAccountJobDefaultsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetAccountJobDefaultsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetAccountJobDefaultsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobNextRunsRequestMultiError) Error() string {
+func (m GetAccountJobDefaultsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9442,11 +22124,12 @@ func (m GetJobNextRunsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobNextRunsRequestMultiError) AllErrors() []error { return m }
+func (m GetAccountJobDefaultsRequestMultiError) AllErrors() []error { return m }
 
-// GetJobNextRunsRequestValidationError is the validation error returned by
-// GetJobNextRunsRequest.Validate if the designated constraints aren't met.
-type GetJobNextRunsRequestValidationError struct {
+// GetAccountJobDefaultsRequestValidationError is the validation error returned
+// by GetAccountJobDefaultsRequest.Validate if the designated constraints
+// aren't met.
+type GetAccountJobDefaultsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9454,24 +22137,24 @@ type GetJobNextRunsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobNextRunsRequestValidationError) Field() string { return e.field }
+func (e GetAccountJobDefaultsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobNextRunsRequestValidationError) Reason() string { return e.reason }
+func (e GetAccountJobDefaultsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobNextRunsRequestValidationError) Cause() error { return e.cause }
+func (e GetAccountJobDefaultsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobNextRunsRequestValidationError) Key() bool { return e.key }
+func (e GetAccountJobDefaultsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobNextRunsRequestValidationError) ErrorName() string {
-	return "GetJobNextRunsRequestValidationError"
+func (e GetAccountJobDefaultsRequestValidationError) ErrorName() string {
+	return "GetAccountJobDefaultsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobNextRunsRequestValidationError) Error() string {
+func (e GetAccountJobDefaultsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9483,14 +22166,14 @@ func (e GetJobNextRunsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobNextRunsRequest.%s: %s%s",
+		"invalid %sGetAccountJobDefaultsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobNextRunsRequestValidationError{}
+var _ error = GetAccountJobDefaultsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9498,24 +22181,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobNextRunsRequestValidationError{}
+} = GetAccountJobDefaultsRequestValidationError{}
 
-// Validate checks the field values on GetJobNextRunsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetAccountJobDefaultsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobNextRunsResponse) Validate() error {
+func (m *GetAccountJobDefaultsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobNextRunsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetJobNextRunsResponseMultiError, or nil if none found.
-func (m *GetJobNextRunsResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetAccountJobDefaultsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetAccountJobDefaultsResponseMultiError, or nil if none found.
+func (m *GetAccountJobDefaultsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobNextRunsResponse) validate(all bool) error {
+func (m *GetAccountJobDefaultsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9523,28 +22206,28 @@ func (m *GetJobNextRunsResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetNextRuns()).(type) {
+		switch v := interface{}(m.GetDefaults()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetJobNextRunsResponseValidationError{
-					field:  "NextRuns",
+				errors = append(errors, GetAccountJobDefaultsResponseValidationError{
+					field:  "Defaults",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetJobNextRunsResponseValidationError{
-					field:  "NextRuns",
+				errors = append(errors, GetAccountJobDefaultsResponseValidationError{
+					field:  "Defaults",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetNextRuns()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDefaults()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetJobNextRunsResponseValidationError{
-				field:  "NextRuns",
+			return GetAccountJobDefaultsResponseValidationError{
+				field:  "Defaults",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -9552,19 +22235,19 @@ func (m *GetJobNextRunsResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetJobNextRunsResponseMultiError(errors)
+		return GetAccountJobDefaultsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobNextRunsResponseMultiError is an error wrapping multiple validation
-// errors returned by GetJobNextRunsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetJobNextRunsResponseMultiError []error
+// GetAccountJobDefaultsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetAccountJobDefaultsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetAccountJobDefaultsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobNextRunsResponseMultiError) Error() string {
+func (m GetAccountJobDefaultsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9573,11 +22256,12 @@ func (m GetJobNextRunsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobNextRunsResponseMultiError) AllErrors() []error { return m }
+func (m GetAccountJobDefaultsResponseMultiError) AllErrors() []error { return m }
 
-// GetJobNextRunsResponseValidationError is the validation error returned by
-// GetJobNextRunsResponse.Validate if the designated constraints aren't met.
-type GetJobNextRunsResponseValidationError struct {
+// GetAccountJobDefaultsResponseValidationError is the validation error
+// returned by GetAccountJobDefaultsResponse.Validate if the designated
+// constraints aren't met.
+type GetAccountJobDefaultsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9585,24 +22269,24 @@ type GetJobNextRunsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobNextRunsResponseValidationError) Field() string { return e.field }
+func (e GetAccountJobDefaultsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobNextRunsResponseValidationError) Reason() string { return e.reason }
+func (e GetAccountJobDefaultsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobNextRunsResponseValidationError) Cause() error { return e.cause }
+func (e GetAccountJobDefaultsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobNextRunsResponseValidationError) Key() bool { return e.key }
+func (e GetAccountJobDefaultsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobNextRunsResponseValidationError) ErrorName() string {
-	return "GetJobNextRunsResponseValidationError"
+func (e GetAccountJobDefaultsResponseValidationError) ErrorName() string {
+	return "GetAccountJobDefaultsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobNextRunsResponseValidationError) Error() string {
+func (e GetAccountJobDefaultsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9614,14 +22298,14 @@ func (e GetJobNextRunsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobNextRunsResponse.%s: %s%s",
+		"invalid %sGetAccountJobDefaultsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobNextRunsResponseValidationError{}
+var _ error = GetAccountJobDefaultsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9629,150 +22313,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobNextRunsResponseValidationError{}
+} = GetAccountJobDefaultsResponseValidationError{}
 
-// Validate checks the field values on GetJobStatusRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SetAccountJobDefaultsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobStatusRequest) Validate() error {
+func (m *SetAccountJobDefaultsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobStatusRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SetAccountJobDefaultsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobStatusRequestMultiError, or nil if none found.
-func (m *GetJobStatusRequest) ValidateAll() error {
+// SetAccountJobDefaultsRequestMultiError, or nil if none found.
+func (m *SetAccountJobDefaultsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobStatusRequest) validate(all bool) error {
+func (m *SetAccountJobDefaultsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobId
-
-	if len(errors) > 0 {
-		return GetJobStatusRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-// GetJobStatusRequestMultiError is an error wrapping multiple validation
-// errors returned by GetJobStatusRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetJobStatusRequestMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m GetJobStatusRequestMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m GetJobStatusRequestMultiError) AllErrors() []error { return m }
-
-// GetJobStatusRequestValidationError is the validation error returned by
-// GetJobStatusRequest.Validate if the designated constraints aren't met.
-type GetJobStatusRequestValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e GetJobStatusRequestValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e GetJobStatusRequestValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e GetJobStatusRequestValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e GetJobStatusRequestValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e GetJobStatusRequestValidationError) ErrorName() string {
-	return "GetJobStatusRequestValidationError"
-}
-
-// Error satisfies the builtin error interface
-func (e GetJobStatusRequestValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
-
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sGetJobStatusRequest.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = GetJobStatusRequestValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = GetJobStatusRequestValidationError{}
-
-// Validate checks the field values on GetJobStatusResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobStatusResponse) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on GetJobStatusResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetJobStatusResponseMultiError, or nil if none found.
-func (m *GetJobStatusResponse) ValidateAll() error {
-	return m.validate(true)
-}
+	// no validation rules for AccountId
 
-func (m *GetJobStatusResponse) validate(all bool) error {
-	if m == nil {
-		return nil
+	if all {
+		switch v := interface{}(m.GetDefaults()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetAccountJobDefaultsRequestValidationError{
+					field:  "Defaults",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetAccountJobDefaultsRequestValidationError{
+					field:  "Defaults",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDefaults()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetAccountJobDefaultsRequestValidationError{
+				field:  "Defaults",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	var errors []error
-
-	// no validation rules for Status
-
 	if len(errors) > 0 {
-		return GetJobStatusResponseMultiError(errors)
+		return SetAccountJobDefaultsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobStatusResponseMultiError is an error wrapping multiple validation
-// errors returned by GetJobStatusResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetJobStatusResponseMultiError []error
+// SetAccountJobDefaultsRequestMultiError is an error wrapping multiple
+// validation errors returned by SetAccountJobDefaultsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetAccountJobDefaultsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobStatusResponseMultiError) Error() string {
+func (m SetAccountJobDefaultsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9781,11 +22390,12 @@ func (m GetJobStatusResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobStatusResponseMultiError) AllErrors() []error { return m }
+func (m SetAccountJobDefaultsRequestMultiError) AllErrors() []error { return m }
 
-// GetJobStatusResponseValidationError is the validation error returned by
-// GetJobStatusResponse.Validate if the designated constraints aren't met.
-type GetJobStatusResponseValidationError struct {
+// SetAccountJobDefaultsRequestValidationError is the validation error returned
+// by SetAccountJobDefaultsRequest.Validate if the designated constraints
+// aren't met.
+type SetAccountJobDefaultsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9793,24 +22403,24 @@ type GetJobStatusResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobStatusResponseValidationError) Field() string { return e.field }
+func (e SetAccountJobDefaultsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobStatusResponseValidationError) Reason() string { return e.reason }
+func (e SetAccountJobDefaultsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobStatusResponseValidationError) Cause() error { return e.cause }
+func (e SetAccountJobDefaultsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobStatusResponseValidationError) Key() bool { return e.key }
+func (e SetAccountJobDefaultsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobStatusResponseValidationError) ErrorName() string {
-	return "GetJobStatusResponseValidationError"
+func (e SetAccountJobDefaultsRequestValidationError) ErrorName() string {
+	return "SetAccountJobDefaultsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobStatusResponseValidationError) Error() string {
+func (e SetAccountJobDefaultsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9822,14 +22432,14 @@ func (e GetJobStatusResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobStatusResponse.%s: %s%s",
+		"invalid %sSetAccountJobDefaultsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobStatusResponseValidationError{}
+var _ error = SetAccountJobDefaultsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9837,48 +22447,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobStatusResponseValidationError{}
+} = SetAccountJobDefaultsRequestValidationError{}
 
-// Validate checks the field values on JobStatusRecord with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *JobStatusRecord) Validate() error {
+// Validate checks the field values on SetAccountJobDefaultsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetAccountJobDefaultsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobStatusRecord with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// JobStatusRecordMultiError, or nil if none found.
-func (m *JobStatusRecord) ValidateAll() error {
+// ValidateAll checks the field values on SetAccountJobDefaultsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetAccountJobDefaultsResponseMultiError, or nil if none found.
+func (m *SetAccountJobDefaultsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobStatusRecord) validate(all bool) error {
+func (m *SetAccountJobDefaultsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobId
-
-	// no validation rules for Status
+	if all {
+		switch v := interface{}(m.GetDefaults()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetAccountJobDefaultsResponseValidationError{
+					field:  "Defaults",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetAccountJobDefaultsResponseValidationError{
+					field:  "Defaults",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDefaults()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetAccountJobDefaultsResponseValidationError{
+				field:  "Defaults",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return JobStatusRecordMultiError(errors)
+		return SetAccountJobDefaultsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobStatusRecordMultiError is an error wrapping multiple validation errors
-// returned by JobStatusRecord.ValidateAll() if the designated constraints
-// aren't met.
-type JobStatusRecordMultiError []error
+// SetAccountJobDefaultsResponseMultiError is an error wrapping multiple
+// validation errors returned by SetAccountJobDefaultsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type SetAccountJobDefaultsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobStatusRecordMultiError) Error() string {
+func (m SetAccountJobDefaultsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9887,11 +22522,12 @@ func (m JobStatusRecordMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobStatusRecordMultiError) AllErrors() []error { return m }
+func (m SetAccountJobDefaultsResponseMultiError) AllErrors() []error { return m }
 
-// JobStatusRecordValidationError is the validation error returned by
-// JobStatusRecord.Validate if the designated constraints aren't met.
-type JobStatusRecordValidationError struct {
+// SetAccountJobDefaultsResponseValidationError is the validation error
+// returned by SetAccountJobDefaultsResponse.Validate if the designated
+// constraints aren't met.
+type SetAccountJobDefaultsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9899,22 +22535,24 @@ type JobStatusRecordValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobStatusRecordValidationError) Field() string { return e.field }
+func (e SetAccountJobDefaultsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobStatusRecordValidationError) Reason() string { return e.reason }
+func (e SetAccountJobDefaultsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobStatusRecordValidationError) Cause() error { return e.cause }
+func (e SetAccountJobDefaultsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobStatusRecordValidationError) Key() bool { return e.key }
+func (e SetAccountJobDefaultsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobStatusRecordValidationError) ErrorName() string { return "JobStatusRecordValidationError" }
+func (e SetAccountJobDefaultsResponseValidationError) ErrorName() string {
+	return "SetAccountJobDefaultsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e JobStatusRecordValidationError) Error() string {
+func (e SetAccountJobDefaultsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9926,14 +22564,14 @@ func (e JobStatusRecordValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobStatusRecord.%s: %s%s",
+		"invalid %sSetAccountJobDefaultsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobStatusRecordValidationError{}
+var _ error = SetAccountJobDefaultsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9941,24 +22579,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobStatusRecordValidationError{}
+} = SetAccountJobDefaultsResponseValidationError{}
 
-// Validate checks the field values on GetJobStatusesRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobStatusesRequest) Validate() error {
+// Validate checks the field values on GetJobsOverridingDefaultsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetJobsOverridingDefaultsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobStatusesRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetJobStatusesRequestMultiError, or nil if none found.
-func (m *GetJobStatusesRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetJobsOverridingDefaultsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetJobsOverridingDefaultsRequestMultiError, or nil if none found.
+func (m *GetJobsOverridingDefaultsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobStatusesRequest) validate(all bool) error {
+func (m *GetJobsOverridingDefaultsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9968,19 +22607,20 @@ func (m *GetJobStatusesRequest) validate(all bool) error {
 	// no validation rules for AccountId
 
 	if len(errors) > 0 {
-		return GetJobStatusesRequestMultiError(errors)
+		return GetJobsOverridingDefaultsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobStatusesRequestMultiError is an error wrapping multiple validation
-// errors returned by GetJobStatusesRequest.ValidateAll() if the designated
+// GetJobsOverridingDefaultsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetJobsOverridingDefaultsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetJobStatusesRequestMultiError []error
+type GetJobsOverridingDefaultsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobStatusesRequestMultiError) Error() string {
+func (m GetJobsOverridingDefaultsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9989,11 +22629,12 @@ func (m GetJobStatusesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobStatusesRequestMultiError) AllErrors() []error { return m }
+func (m GetJobsOverridingDefaultsRequestMultiError) AllErrors() []error { return m }
 
-// GetJobStatusesRequestValidationError is the validation error returned by
-// GetJobStatusesRequest.Validate if the designated constraints aren't met.
-type GetJobStatusesRequestValidationError struct {
+// GetJobsOverridingDefaultsRequestValidationError is the validation error
+// returned by GetJobsOverridingDefaultsRequest.Validate if the designated
+// constraints aren't met.
+type GetJobsOverridingDefaultsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10001,24 +22642,24 @@ type GetJobStatusesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobStatusesRequestValidationError) Field() string { return e.field }
+func (e GetJobsOverridingDefaultsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobStatusesRequestValidationError) Reason() string { return e.reason }
+func (e GetJobsOverridingDefaultsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobStatusesRequestValidationError) Cause() error { return e.cause }
+func (e GetJobsOverridingDefaultsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobStatusesRequestValidationError) Key() bool { return e.key }
+func (e GetJobsOverridingDefaultsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobStatusesRequestValidationError) ErrorName() string {
-	return "GetJobStatusesRequestValidationError"
+func (e GetJobsOverridingDefaultsRequestValidationError) ErrorName() string {
+	return "GetJobsOverridingDefaultsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobStatusesRequestValidationError) Error() string {
+func (e GetJobsOverridingDefaultsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10030,14 +22671,14 @@ func (e GetJobStatusesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobStatusesRequest.%s: %s%s",
+		"invalid %sGetJobsOverridingDefaultsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobStatusesRequestValidationError{}
+var _ error = GetJobsOverridingDefaultsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10045,47 +22686,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobStatusesRequestValidationError{}
+} = GetJobsOverridingDefaultsRequestValidationError{}
 
-// Validate checks the field values on GetJobStatusesResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobStatusesResponse) Validate() error {
+// Validate checks the field values on GetJobsOverridingDefaultsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetJobsOverridingDefaultsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobStatusesResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetJobStatusesResponseMultiError, or nil if none found.
-func (m *GetJobStatusesResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetJobsOverridingDefaultsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetJobsOverridingDefaultsResponseMultiError, or nil if none found.
+func (m *GetJobsOverridingDefaultsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobStatusesResponse) validate(all bool) error {
+func (m *GetJobsOverridingDefaultsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetStatuses() {
+	for idx, item := range m.GetJobs() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetJobStatusesResponseValidationError{
-						field:  fmt.Sprintf("Statuses[%v]", idx),
+					errors = append(errors, GetJobsOverridingDefaultsResponseValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetJobStatusesResponseValidationError{
-						field:  fmt.Sprintf("Statuses[%v]", idx),
+					errors = append(errors, GetJobsOverridingDefaultsResponseValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -10093,8 +22735,8 @@ func (m *GetJobStatusesResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetJobStatusesResponseValidationError{
-					field:  fmt.Sprintf("Statuses[%v]", idx),
+				return GetJobsOverridingDefaultsResponseValidationError{
+					field:  fmt.Sprintf("Jobs[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -10104,19 +22746,20 @@ func (m *GetJobStatusesResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetJobStatusesResponseMultiError(errors)
+		return GetJobsOverridingDefaultsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobStatusesResponseMultiError is an error wrapping multiple validation
-// errors returned by GetJobStatusesResponse.ValidateAll() if the designated
+// GetJobsOverridingDefaultsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetJobsOverridingDefaultsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetJobStatusesResponseMultiError []error
+type GetJobsOverridingDefaultsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobStatusesResponseMultiError) Error() string {
+func (m GetJobsOverridingDefaultsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10125,11 +22768,12 @@ func (m GetJobStatusesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobStatusesResponseMultiError) AllErrors() []error { return m }
+func (m GetJobsOverridingDefaultsResponseMultiError) AllErrors() []error { return m }
 
-// GetJobStatusesResponseValidationError is the validation error returned by
-// GetJobStatusesResponse.Validate if the designated constraints aren't met.
-type GetJobStatusesResponseValidationError struct {
+// GetJobsOverridingDefaultsResponseValidationError is the validation error
+// returned by GetJobsOverridingDefaultsResponse.Validate if the designated
+// constraints aren't met.
+type GetJobsOverridingDefaultsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10137,24 +22781,24 @@ type GetJobStatusesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobStatusesResponseValidationError) Field() string { return e.field }
+func (e GetJobsOverridingDefaultsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobStatusesResponseValidationError) Reason() string { return e.reason }
+func (e GetJobsOverridingDefaultsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobStatusesResponseValidationError) Cause() error { return e.cause }
+func (e GetJobsOverridingDefaultsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobStatusesResponseValidationError) Key() bool { return e.key }
+func (e GetJobsOverridingDefaultsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobStatusesResponseValidationError) ErrorName() string {
-	return "GetJobStatusesResponseValidationError"
+func (e GetJobsOverridingDefaultsResponseValidationError) ErrorName() string {
+	return "GetJobsOverridingDefaultsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobStatusesResponseValidationError) Error() string {
+func (e GetJobsOverridingDefaultsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10166,14 +22810,14 @@ func (e GetJobStatusesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobStatusesResponse.%s: %s%s",
+		"invalid %sGetJobsOverridingDefaultsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobStatusesResponseValidationError{}
+var _ error = GetJobsOverridingDefaultsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10181,46 +22825,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobStatusesResponseValidationError{}
+} = GetJobsOverridingDefaultsResponseValidationError{}
 
-// Validate checks the field values on ActivityFailure with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ActivityFailure) Validate() error {
+// Validate checks the field values on JobDefaultsOverride with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobDefaultsOverride) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ActivityFailure with the rules
+// ValidateAll checks the field values on JobDefaultsOverride with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ActivityFailureMultiError, or nil if none found.
-func (m *ActivityFailure) ValidateAll() error {
+// JobDefaultsOverrideMultiError, or nil if none found.
+func (m *JobDefaultsOverride) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ActivityFailure) validate(all bool) error {
+func (m *JobDefaultsOverride) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
+	// no validation rules for JobId
+
+	// no validation rules for JobName
+
+	// no validation rules for SyncOptions
+
+	// no validation rules for WorkflowOptions
 
 	if len(errors) > 0 {
-		return ActivityFailureMultiError(errors)
+		return JobDefaultsOverrideMultiError(errors)
 	}
 
 	return nil
 }
 
-// ActivityFailureMultiError is an error wrapping multiple validation errors
-// returned by ActivityFailure.ValidateAll() if the designated constraints
-// aren't met.
-type ActivityFailureMultiError []error
+// JobDefaultsOverrideMultiError is an error wrapping multiple validation
+// errors returned by JobDefaultsOverride.ValidateAll() if the designated
+// constraints aren't met.
+type JobDefaultsOverrideMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ActivityFailureMultiError) Error() string {
+func (m JobDefaultsOverrideMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10229,11 +22879,11 @@ func (m ActivityFailureMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ActivityFailureMultiError) AllErrors() []error { return m }
+func (m JobDefaultsOverrideMultiError) AllErrors() []error { return m }
 
-// ActivityFailureValidationError is the validation error returned by
-// ActivityFailure.Validate if the designated constraints aren't met.
-type ActivityFailureValidationError struct {
+// JobDefaultsOverrideValidationError is the validation error returned by
+// JobDefaultsOverride.Validate if the designated constraints aren't met.
+type JobDefaultsOverrideValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10241,22 +22891,24 @@ type ActivityFailureValidationError struct {
 }
 
 // Field function returns field value.
-func (e ActivityFailureValidationError) Field() string { return e.field }
+func (e JobDefaultsOverrideValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ActivityFailureValidationError) Reason() string { return e.reason }
+func (e JobDefaultsOverrideValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ActivityFailureValidationError) Cause() error { return e.cause }
+func (e JobDefaultsOverrideValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ActivityFailureValidationError) Key() bool { return e.key }
+func (e JobDefaultsOverrideValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ActivityFailureValidationError) ErrorName() string { return "ActivityFailureValidationError" }
+func (e JobDefaultsOverrideValidationError) ErrorName() string {
+	return "JobDefaultsOverrideValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ActivityFailureValidationError) Error() string {
+func (e JobDefaultsOverrideValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10268,14 +22920,14 @@ func (e ActivityFailureValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sActivityFailure.%s: %s%s",
+		"invalid %sJobDefaultsOverride.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ActivityFailureValidationError{}
+var _ error = JobDefaultsOverrideValidationError{}
 
 var _ interface {
 	Field() string
@@ -10283,59 +22935,62 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ActivityFailureValidationError{}
+} = JobDefaultsOverrideValidationError{}
 
-// Validate checks the field values on PendingActivity with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *PendingActivity) Validate() error {
+// Validate checks the field values on JobTemplate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobTemplate) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PendingActivity with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// PendingActivityMultiError, or nil if none found.
-func (m *PendingActivity) ValidateAll() error {
+// ValidateAll checks the field values on JobTemplate with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JobTemplateMultiError, or
+// nil if none found.
+func (m *JobTemplate) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PendingActivity) validate(all bool) error {
+func (m *JobTemplate) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Status
+	// no validation rules for Id
 
-	// no validation rules for ActivityName
+	// no validation rules for Name
 
-	if m.LastFailure != nil {
+	// no validation rules for Description
+
+	for idx, item := range m.GetVariables() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetLastFailure()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PendingActivityValidationError{
-						field:  "LastFailure",
+					errors = append(errors, JobTemplateValidationError{
+						field:  fmt.Sprintf("Variables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, PendingActivityValidationError{
-						field:  "LastFailure",
+					errors = append(errors, JobTemplateValidationError{
+						field:  fmt.Sprintf("Variables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetLastFailure()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return PendingActivityValidationError{
-					field:  "LastFailure",
+				return JobTemplateValidationError{
+					field:  fmt.Sprintf("Variables[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -10345,19 +23000,18 @@ func (m *PendingActivity) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return PendingActivityMultiError(errors)
+		return JobTemplateMultiError(errors)
 	}
 
 	return nil
 }
 
-// PendingActivityMultiError is an error wrapping multiple validation errors
-// returned by PendingActivity.ValidateAll() if the designated constraints
-// aren't met.
-type PendingActivityMultiError []error
+// JobTemplateMultiError is an error wrapping multiple validation errors
+// returned by JobTemplate.ValidateAll() if the designated constraints aren't met.
+type JobTemplateMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PendingActivityMultiError) Error() string {
+func (m JobTemplateMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10366,11 +23020,11 @@ func (m PendingActivityMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PendingActivityMultiError) AllErrors() []error { return m }
+func (m JobTemplateMultiError) AllErrors() []error { return m }
 
-// PendingActivityValidationError is the validation error returned by
-// PendingActivity.Validate if the designated constraints aren't met.
-type PendingActivityValidationError struct {
+// JobTemplateValidationError is the validation error returned by
+// JobTemplate.Validate if the designated constraints aren't met.
+type JobTemplateValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10378,22 +23032,22 @@ type PendingActivityValidationError struct {
 }
 
 // Field function returns field value.
-func (e PendingActivityValidationError) Field() string { return e.field }
+func (e JobTemplateValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PendingActivityValidationError) Reason() string { return e.reason }
+func (e JobTemplateValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PendingActivityValidationError) Cause() error { return e.cause }
+func (e JobTemplateValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PendingActivityValidationError) Key() bool { return e.key }
+func (e JobTemplateValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PendingActivityValidationError) ErrorName() string { return "PendingActivityValidationError" }
+func (e JobTemplateValidationError) ErrorName() string { return "JobTemplateValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PendingActivityValidationError) Error() string {
+func (e JobTemplateValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10405,14 +23059,14 @@ func (e PendingActivityValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPendingActivity.%s: %s%s",
+		"invalid %sJobTemplate.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PendingActivityValidationError{}
+var _ error = JobTemplateValidationError{}
 
 var _ interface {
 	Field() string
@@ -10420,146 +23074,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PendingActivityValidationError{}
+} = JobTemplateValidationError{}
 
-// Validate checks the field values on JobRun with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *JobRun) Validate() error {
+// Validate checks the field values on JobTemplateVariable with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobTemplateVariable) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobRun with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in JobRunMultiError, or nil if none found.
-func (m *JobRun) ValidateAll() error {
+// ValidateAll checks the field values on JobTemplateVariable with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobTemplateVariableMultiError, or nil if none found.
+func (m *JobTemplateVariable) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobRun) validate(all bool) error {
+func (m *JobTemplateVariable) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for JobId
-
 	// no validation rules for Name
 
-	// no validation rules for Status
-
-	if all {
-		switch v := interface{}(m.GetStartedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobRunValidationError{
-					field:  "StartedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobRunValidationError{
-					field:  "StartedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobRunValidationError{
-				field:  "StartedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	for idx, item := range m.GetPendingActivities() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobRunValidationError{
-						field:  fmt.Sprintf("PendingActivities[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, JobRunValidationError{
-						field:  fmt.Sprintf("PendingActivities[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return JobRunValidationError{
-					field:  fmt.Sprintf("PendingActivities[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if m.CompletedAt != nil {
-
-		if all {
-			switch v := interface{}(m.GetCompletedAt()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobRunValidationError{
-						field:  "CompletedAt",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, JobRunValidationError{
-						field:  "CompletedAt",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetCompletedAt()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return JobRunValidationError{
-					field:  "CompletedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Description
 
+	if m.DefaultValue != nil {
+		// no validation rules for DefaultValue
 	}
 
 	if len(errors) > 0 {
-		return JobRunMultiError(errors)
+		return JobTemplateVariableMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobRunMultiError is an error wrapping multiple validation errors returned by
-// JobRun.ValidateAll() if the designated constraints aren't met.
-type JobRunMultiError []error
+// JobTemplateVariableMultiError is an error wrapping multiple validation
+// errors returned by JobTemplateVariable.ValidateAll() if the designated
+// constraints aren't met.
+type JobTemplateVariableMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobRunMultiError) Error() string {
+func (m JobTemplateVariableMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10568,11 +23128,11 @@ func (m JobRunMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobRunMultiError) AllErrors() []error { return m }
+func (m JobTemplateVariableMultiError) AllErrors() []error { return m }
 
-// JobRunValidationError is the validation error returned by JobRun.Validate if
-// the designated constraints aren't met.
-type JobRunValidationError struct {
+// JobTemplateVariableValidationError is the validation error returned by
+// JobTemplateVariable.Validate if the designated constraints aren't met.
+type JobTemplateVariableValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10580,22 +23140,24 @@ type JobRunValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobRunValidationError) Field() string { return e.field }
+func (e JobTemplateVariableValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobRunValidationError) Reason() string { return e.reason }
+func (e JobTemplateVariableValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobRunValidationError) Cause() error { return e.cause }
+func (e JobTemplateVariableValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobRunValidationError) Key() bool { return e.key }
+func (e JobTemplateVariableValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobRunValidationError) ErrorName() string { return "JobRunValidationError" }
+func (e JobTemplateVariableValidationError) ErrorName() string {
+	return "JobTemplateVariableValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e JobRunValidationError) Error() string {
+func (e JobTemplateVariableValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10607,14 +23169,14 @@ func (e JobRunValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobRun.%s: %s%s",
+		"invalid %sJobTemplateVariable.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobRunValidationError{}
+var _ error = JobTemplateVariableValidationError{}
 
 var _ interface {
 	Field() string
@@ -10622,48 +23184,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobRunValidationError{}
+} = JobTemplateVariableValidationError{}
 
-// Validate checks the field values on JobRunEventTaskError with the rules
+// Validate checks the field values on JobTemplateVariableValue with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *JobRunEventTaskError) Validate() error {
+func (m *JobTemplateVariableValue) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobRunEventTaskError with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on JobTemplateVariableValue with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JobRunEventTaskErrorMultiError, or nil if none found.
-func (m *JobRunEventTaskError) ValidateAll() error {
+// JobTemplateVariableValueMultiError, or nil if none found.
+func (m *JobTemplateVariableValue) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobRunEventTaskError) validate(all bool) error {
+func (m *JobTemplateVariableValue) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
+	// no validation rules for Name
 
-	// no validation rules for RetryState
+	// no validation rules for Value
 
 	if len(errors) > 0 {
-		return JobRunEventTaskErrorMultiError(errors)
+		return JobTemplateVariableValueMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobRunEventTaskErrorMultiError is an error wrapping multiple validation
-// errors returned by JobRunEventTaskError.ValidateAll() if the designated
+// JobTemplateVariableValueMultiError is an error wrapping multiple validation
+// errors returned by JobTemplateVariableValue.ValidateAll() if the designated
 // constraints aren't met.
-type JobRunEventTaskErrorMultiError []error
+type JobTemplateVariableValueMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobRunEventTaskErrorMultiError) Error() string {
+func (m JobTemplateVariableValueMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10672,11 +23234,11 @@ func (m JobRunEventTaskErrorMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobRunEventTaskErrorMultiError) AllErrors() []error { return m }
+func (m JobTemplateVariableValueMultiError) AllErrors() []error { return m }
 
-// JobRunEventTaskErrorValidationError is the validation error returned by
-// JobRunEventTaskError.Validate if the designated constraints aren't met.
-type JobRunEventTaskErrorValidationError struct {
+// JobTemplateVariableValueValidationError is the validation error returned by
+// JobTemplateVariableValue.Validate if the designated constraints aren't met.
+type JobTemplateVariableValueValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10684,24 +23246,24 @@ type JobRunEventTaskErrorValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobRunEventTaskErrorValidationError) Field() string { return e.field }
+func (e JobTemplateVariableValueValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobRunEventTaskErrorValidationError) Reason() string { return e.reason }
+func (e JobTemplateVariableValueValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobRunEventTaskErrorValidationError) Cause() error { return e.cause }
+func (e JobTemplateVariableValueValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobRunEventTaskErrorValidationError) Key() bool { return e.key }
+func (e JobTemplateVariableValueValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobRunEventTaskErrorValidationError) ErrorName() string {
-	return "JobRunEventTaskErrorValidationError"
+func (e JobTemplateVariableValueValidationError) ErrorName() string {
+	return "JobTemplateVariableValueValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e JobRunEventTaskErrorValidationError) Error() string {
+func (e JobTemplateVariableValueValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10713,14 +23275,14 @@ func (e JobRunEventTaskErrorValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobRunEventTaskError.%s: %s%s",
+		"invalid %sJobTemplateVariableValue.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobRunEventTaskErrorValidationError{}
+var _ error = JobTemplateVariableValueValidationError{}
 
 var _ interface {
 	Field() string
@@ -10728,106 +23290,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobRunEventTaskErrorValidationError{}
+} = JobTemplateVariableValueValidationError{}
 
-// Validate checks the field values on JobRunEventTask with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *JobRunEventTask) Validate() error {
+// Validate checks the field values on GetJobTemplatesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobTemplatesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobRunEventTask with the rules
+// ValidateAll checks the field values on GetJobTemplatesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JobRunEventTaskMultiError, or nil if none found.
-func (m *JobRunEventTask) ValidateAll() error {
+// GetJobTemplatesRequestMultiError, or nil if none found.
+func (m *GetJobTemplatesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobRunEventTask) validate(all bool) error {
+func (m *GetJobTemplatesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Type
-
-	if all {
-		switch v := interface{}(m.GetEventTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobRunEventTaskValidationError{
-					field:  "EventTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobRunEventTaskValidationError{
-					field:  "EventTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetEventTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobRunEventTaskValidationError{
-				field:  "EventTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetError()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobRunEventTaskValidationError{
-					field:  "Error",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobRunEventTaskValidationError{
-					field:  "Error",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobRunEventTaskValidationError{
-				field:  "Error",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return JobRunEventTaskMultiError(errors)
+		return GetJobTemplatesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobRunEventTaskMultiError is an error wrapping multiple validation errors
-// returned by JobRunEventTask.ValidateAll() if the designated constraints
-// aren't met.
-type JobRunEventTaskMultiError []error
+// GetJobTemplatesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetJobTemplatesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobTemplatesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobRunEventTaskMultiError) Error() string {
+func (m GetJobTemplatesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10835,12 +23335,12 @@ func (m JobRunEventTaskMultiError) Error() string {
 	return strings.Join(msgs, "; ")
 }
 
-// AllErrors returns a list of validation violation errors.
-func (m JobRunEventTaskMultiError) AllErrors() []error { return m }
-
-// JobRunEventTaskValidationError is the validation error returned by
-// JobRunEventTask.Validate if the designated constraints aren't met.
-type JobRunEventTaskValidationError struct {
+// AllErrors returns a list of validation violation errors.
+func (m GetJobTemplatesRequestMultiError) AllErrors() []error { return m }
+
+// GetJobTemplatesRequestValidationError is the validation error returned by
+// GetJobTemplatesRequest.Validate if the designated constraints aren't met.
+type GetJobTemplatesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10848,22 +23348,24 @@ type JobRunEventTaskValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobRunEventTaskValidationError) Field() string { return e.field }
+func (e GetJobTemplatesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobRunEventTaskValidationError) Reason() string { return e.reason }
+func (e GetJobTemplatesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobRunEventTaskValidationError) Cause() error { return e.cause }
+func (e GetJobTemplatesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobRunEventTaskValidationError) Key() bool { return e.key }
+func (e GetJobTemplatesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobRunEventTaskValidationError) ErrorName() string { return "JobRunEventTaskValidationError" }
+func (e GetJobTemplatesRequestValidationError) ErrorName() string {
+	return "GetJobTemplatesRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e JobRunEventTaskValidationError) Error() string {
+func (e GetJobTemplatesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10875,14 +23377,14 @@ func (e JobRunEventTaskValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobRunEventTask.%s: %s%s",
+		"invalid %sGetJobTemplatesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobRunEventTaskValidationError{}
+var _ error = GetJobTemplatesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10890,48 +23392,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobRunEventTaskValidationError{}
+} = GetJobTemplatesRequestValidationError{}
 
-// Validate checks the field values on JobRunSyncMetadata with the rules
+// Validate checks the field values on GetJobTemplatesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *JobRunSyncMetadata) Validate() error {
+func (m *GetJobTemplatesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobRunSyncMetadata with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetJobTemplatesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JobRunSyncMetadataMultiError, or nil if none found.
-func (m *JobRunSyncMetadata) ValidateAll() error {
+// GetJobTemplatesResponseMultiError, or nil if none found.
+func (m *GetJobTemplatesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobRunSyncMetadata) validate(all bool) error {
+func (m *GetJobTemplatesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Schema
+	for idx, item := range m.GetTemplates() {
+		_, _ = idx, item
 
-	// no validation rules for Table
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJobTemplatesResponseValidationError{
+						field:  fmt.Sprintf("Templates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJobTemplatesResponseValidationError{
+						field:  fmt.Sprintf("Templates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJobTemplatesResponseValidationError{
+					field:  fmt.Sprintf("Templates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return JobRunSyncMetadataMultiError(errors)
+		return GetJobTemplatesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobRunSyncMetadataMultiError is an error wrapping multiple validation errors
-// returned by JobRunSyncMetadata.ValidateAll() if the designated constraints
-// aren't met.
-type JobRunSyncMetadataMultiError []error
+// GetJobTemplatesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetJobTemplatesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobTemplatesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobRunSyncMetadataMultiError) Error() string {
+func (m GetJobTemplatesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10940,11 +23472,11 @@ func (m JobRunSyncMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobRunSyncMetadataMultiError) AllErrors() []error { return m }
+func (m GetJobTemplatesResponseMultiError) AllErrors() []error { return m }
 
-// JobRunSyncMetadataValidationError is the validation error returned by
-// JobRunSyncMetadata.Validate if the designated constraints aren't met.
-type JobRunSyncMetadataValidationError struct {
+// GetJobTemplatesResponseValidationError is the validation error returned by
+// GetJobTemplatesResponse.Validate if the designated constraints aren't met.
+type GetJobTemplatesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10952,24 +23484,24 @@ type JobRunSyncMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobRunSyncMetadataValidationError) Field() string { return e.field }
+func (e GetJobTemplatesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobRunSyncMetadataValidationError) Reason() string { return e.reason }
+func (e GetJobTemplatesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobRunSyncMetadataValidationError) Cause() error { return e.cause }
+func (e GetJobTemplatesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobRunSyncMetadataValidationError) Key() bool { return e.key }
+func (e GetJobTemplatesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobRunSyncMetadataValidationError) ErrorName() string {
-	return "JobRunSyncMetadataValidationError"
+func (e GetJobTemplatesResponseValidationError) ErrorName() string {
+	return "GetJobTemplatesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e JobRunSyncMetadataValidationError) Error() string {
+func (e GetJobTemplatesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10981,14 +23513,14 @@ func (e JobRunSyncMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobRunSyncMetadata.%s: %s%s",
+		"invalid %sGetJobTemplatesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobRunSyncMetadataValidationError{}
+var _ error = GetJobTemplatesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10996,90 +23528,86 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobRunSyncMetadataValidationError{}
+} = GetJobTemplatesResponseValidationError{}
 
-// Validate checks the field values on JobRunEventMetadata with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateJobFromTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *JobRunEventMetadata) Validate() error {
+func (m *CreateJobFromTemplateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobRunEventMetadata with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateJobFromTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JobRunEventMetadataMultiError, or nil if none found.
-func (m *JobRunEventMetadata) ValidateAll() error {
+// CreateJobFromTemplateRequestMultiError, or nil if none found.
+func (m *CreateJobFromTemplateRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobRunEventMetadata) validate(all bool) error {
+func (m *CreateJobFromTemplateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Metadata.(type) {
-	case *JobRunEventMetadata_SyncMetadata:
-		if v == nil {
-			err := JobRunEventMetadataValidationError{
-				field:  "Metadata",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	// no validation rules for AccountId
+
+	// no validation rules for TemplateId
+
+	// no validation rules for JobName
+
+	for idx, item := range m.GetVariables() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetSyncMetadata()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobRunEventMetadataValidationError{
-						field:  "SyncMetadata",
+					errors = append(errors, CreateJobFromTemplateRequestValidationError{
+						field:  fmt.Sprintf("Variables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, JobRunEventMetadataValidationError{
-						field:  "SyncMetadata",
+					errors = append(errors, CreateJobFromTemplateRequestValidationError{
+						field:  fmt.Sprintf("Variables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetSyncMetadata()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return JobRunEventMetadataValidationError{
-					field:  "SyncMetadata",
+				return CreateJobFromTemplateRequestValidationError{
+					field:  fmt.Sprintf("Variables[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	default:
-		_ = v // ensures v is used
 	}
 
+	// no validation rules for InitiateJobRun
+
 	if len(errors) > 0 {
-		return JobRunEventMetadataMultiError(errors)
+		return CreateJobFromTemplateRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobRunEventMetadataMultiError is an error wrapping multiple validation
-// errors returned by JobRunEventMetadata.ValidateAll() if the designated
-// constraints aren't met.
-type JobRunEventMetadataMultiError []error
+// CreateJobFromTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateJobFromTemplateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateJobFromTemplateRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobRunEventMetadataMultiError) Error() string {
+func (m CreateJobFromTemplateRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11088,11 +23616,12 @@ func (m JobRunEventMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobRunEventMetadataMultiError) AllErrors() []error { return m }
+func (m CreateJobFromTemplateRequestMultiError) AllErrors() []error { return m }
 
-// JobRunEventMetadataValidationError is the validation error returned by
-// JobRunEventMetadata.Validate if the designated constraints aren't met.
-type JobRunEventMetadataValidationError struct {
+// CreateJobFromTemplateRequestValidationError is the validation error returned
+// by CreateJobFromTemplateRequest.Validate if the designated constraints
+// aren't met.
+type CreateJobFromTemplateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11100,24 +23629,24 @@ type JobRunEventMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobRunEventMetadataValidationError) Field() string { return e.field }
+func (e CreateJobFromTemplateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobRunEventMetadataValidationError) Reason() string { return e.reason }
+func (e CreateJobFromTemplateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobRunEventMetadataValidationError) Cause() error { return e.cause }
+func (e CreateJobFromTemplateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobRunEventMetadataValidationError) Key() bool { return e.key }
+func (e CreateJobFromTemplateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobRunEventMetadataValidationError) ErrorName() string {
-	return "JobRunEventMetadataValidationError"
+func (e CreateJobFromTemplateRequestValidationError) ErrorName() string {
+	return "CreateJobFromTemplateRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e JobRunEventMetadataValidationError) Error() string {
+func (e CreateJobFromTemplateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11129,14 +23658,14 @@ func (e JobRunEventMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobRunEventMetadata.%s: %s%s",
+		"invalid %sCreateJobFromTemplateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobRunEventMetadataValidationError{}
+var _ error = CreateJobFromTemplateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11144,168 +23673,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobRunEventMetadataValidationError{}
+} = CreateJobFromTemplateRequestValidationError{}
 
-// Validate checks the field values on JobRunEvent with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *JobRunEvent) Validate() error {
+// Validate checks the field values on CreateJobFromTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateJobFromTemplateResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobRunEvent with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in JobRunEventMultiError, or
-// nil if none found.
-func (m *JobRunEvent) ValidateAll() error {
+// ValidateAll checks the field values on CreateJobFromTemplateResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateJobFromTemplateResponseMultiError, or nil if none found.
+func (m *CreateJobFromTemplateResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobRunEvent) validate(all bool) error {
+func (m *CreateJobFromTemplateResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Type
-
-	if all {
-		switch v := interface{}(m.GetStartTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobRunEventValidationError{
-					field:  "StartTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobRunEventValidationError{
-					field:  "StartTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobRunEventValidationError{
-				field:  "StartTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetCloseTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobRunEventValidationError{
-					field:  "CloseTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobRunEventValidationError{
-					field:  "CloseTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCloseTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobRunEventValidationError{
-				field:  "CloseTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
+		switch v := interface{}(m.GetJob()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobRunEventValidationError{
-					field:  "Metadata",
+				errors = append(errors, CreateJobFromTemplateResponseValidationError{
+					field:  "Job",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, JobRunEventValidationError{
-					field:  "Metadata",
+				errors = append(errors, CreateJobFromTemplateResponseValidationError{
+					field:  "Job",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobRunEventValidationError{
-				field:  "Metadata",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	for idx, item := range m.GetTasks() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobRunEventValidationError{
-						field:  fmt.Sprintf("Tasks[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, JobRunEventValidationError{
-						field:  fmt.Sprintf("Tasks[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return JobRunEventValidationError{
-					field:  fmt.Sprintf("Tasks[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateJobFromTemplateResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return JobRunEventMultiError(errors)
+		return CreateJobFromTemplateResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobRunEventMultiError is an error wrapping multiple validation errors
-// returned by JobRunEvent.ValidateAll() if the designated constraints aren't met.
-type JobRunEventMultiError []error
+// CreateJobFromTemplateResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateJobFromTemplateResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CreateJobFromTemplateResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobRunEventMultiError) Error() string {
+func (m CreateJobFromTemplateResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11314,11 +23748,12 @@ func (m JobRunEventMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobRunEventMultiError) AllErrors() []error { return m }
+func (m CreateJobFromTemplateResponseMultiError) AllErrors() []error { return m }
 
-// JobRunEventValidationError is the validation error returned by
-// JobRunEvent.Validate if the designated constraints aren't met.
-type JobRunEventValidationError struct {
+// CreateJobFromTemplateResponseValidationError is the validation error
+// returned by CreateJobFromTemplateResponse.Validate if the designated
+// constraints aren't met.
+type CreateJobFromTemplateResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11326,22 +23761,24 @@ type JobRunEventValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobRunEventValidationError) Field() string { return e.field }
+func (e CreateJobFromTemplateResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobRunEventValidationError) Reason() string { return e.reason }
+func (e CreateJobFromTemplateResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobRunEventValidationError) Cause() error { return e.cause }
+func (e CreateJobFromTemplateResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobRunEventValidationError) Key() bool { return e.key }
+func (e CreateJobFromTemplateResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobRunEventValidationError) ErrorName() string { return "JobRunEventValidationError" }
+func (e CreateJobFromTemplateResponseValidationError) ErrorName() string {
+	return "CreateJobFromTemplateResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e JobRunEventValidationError) Error() string {
+func (e CreateJobFromTemplateResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11353,14 +23790,14 @@ func (e JobRunEventValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobRunEvent.%s: %s%s",
+		"invalid %sCreateJobFromTemplateResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobRunEventValidationError{}
+var _ error = CreateJobFromTemplateResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11368,48 +23805,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobRunEventValidationError{}
+} = CreateJobFromTemplateResponseValidationError{}
 
-// Validate checks the field values on GetJobRunEventsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRunEventsRequest) Validate() error {
+// Validate checks the field values on KafkaDestinationConnectionOptions with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *KafkaDestinationConnectionOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRunEventsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetJobRunEventsRequestMultiError, or nil if none found.
-func (m *GetJobRunEventsRequest) ValidateAll() error {
+// ValidateAll checks the field values on KafkaDestinationConnectionOptions
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// KafkaDestinationConnectionOptionsMultiError, or nil if none found.
+func (m *KafkaDestinationConnectionOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRunEventsRequest) validate(all bool) error {
+func (m *KafkaDestinationConnectionOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobRunId
-
-	// no validation rules for AccountId
+	if m.Compression != nil {
+		// no validation rules for Compression
+	}
 
 	if len(errors) > 0 {
-		return GetJobRunEventsRequestMultiError(errors)
+		return KafkaDestinationConnectionOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRunEventsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetJobRunEventsRequest.ValidateAll() if the designated
+// KafkaDestinationConnectionOptionsMultiError is an error wrapping multiple
+// validation errors returned by
+// KafkaDestinationConnectionOptions.ValidateAll() if the designated
 // constraints aren't met.
-type GetJobRunEventsRequestMultiError []error
+type KafkaDestinationConnectionOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRunEventsRequestMultiError) Error() string {
+func (m KafkaDestinationConnectionOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11418,11 +23857,12 @@ func (m GetJobRunEventsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRunEventsRequestMultiError) AllErrors() []error { return m }
+func (m KafkaDestinationConnectionOptionsMultiError) AllErrors() []error { return m }
 
-// GetJobRunEventsRequestValidationError is the validation error returned by
-// GetJobRunEventsRequest.Validate if the designated constraints aren't met.
-type GetJobRunEventsRequestValidationError struct {
+// KafkaDestinationConnectionOptionsValidationError is the validation error
+// returned by KafkaDestinationConnectionOptions.Validate if the designated
+// constraints aren't met.
+type KafkaDestinationConnectionOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11430,24 +23870,24 @@ type GetJobRunEventsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRunEventsRequestValidationError) Field() string { return e.field }
+func (e KafkaDestinationConnectionOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRunEventsRequestValidationError) Reason() string { return e.reason }
+func (e KafkaDestinationConnectionOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRunEventsRequestValidationError) Cause() error { return e.cause }
+func (e KafkaDestinationConnectionOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRunEventsRequestValidationError) Key() bool { return e.key }
+func (e KafkaDestinationConnectionOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRunEventsRequestValidationError) ErrorName() string {
-	return "GetJobRunEventsRequestValidationError"
+func (e KafkaDestinationConnectionOptionsValidationError) ErrorName() string {
+	return "KafkaDestinationConnectionOptionsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobRunEventsRequestValidationError) Error() string {
+func (e KafkaDestinationConnectionOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11459,14 +23899,14 @@ func (e GetJobRunEventsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRunEventsRequest.%s: %s%s",
+		"invalid %sKafkaDestinationConnectionOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRunEventsRequestValidationError{}
+var _ error = KafkaDestinationConnectionOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -11474,80 +23914,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRunEventsRequestValidationError{}
+} = KafkaDestinationConnectionOptionsValidationError{}
 
-// Validate checks the field values on GetJobRunEventsResponse with the rules
+// Validate checks the field values on JobMappingViolation with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRunEventsResponse) Validate() error {
+func (m *JobMappingViolation) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRunEventsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on JobMappingViolation with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobRunEventsResponseMultiError, or nil if none found.
-func (m *GetJobRunEventsResponse) ValidateAll() error {
+// JobMappingViolationMultiError, or nil if none found.
+func (m *JobMappingViolation) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRunEventsResponse) validate(all bool) error {
+func (m *JobMappingViolation) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetEvents() {
-		_, _ = idx, item
+	// no validation rules for Type
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetJobRunEventsResponseValidationError{
-						field:  fmt.Sprintf("Events[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetJobRunEventsResponseValidationError{
-						field:  fmt.Sprintf("Events[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetJobRunEventsResponseValidationError{
-					field:  fmt.Sprintf("Events[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for ConnectionId
 
-	}
+	// no validation rules for Schema
 
-	// no validation rules for IsRunComplete
+	// no validation rules for Table
+
+	// no validation rules for Column
+
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return GetJobRunEventsResponseMultiError(errors)
+		return JobMappingViolationMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRunEventsResponseMultiError is an error wrapping multiple validation
-// errors returned by GetJobRunEventsResponse.ValidateAll() if the designated
+// JobMappingViolationMultiError is an error wrapping multiple validation
+// errors returned by JobMappingViolation.ValidateAll() if the designated
 // constraints aren't met.
-type GetJobRunEventsResponseMultiError []error
+type JobMappingViolationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRunEventsResponseMultiError) Error() string {
+func (m JobMappingViolationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11556,11 +23972,11 @@ func (m GetJobRunEventsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRunEventsResponseMultiError) AllErrors() []error { return m }
+func (m JobMappingViolationMultiError) AllErrors() []error { return m }
 
-// GetJobRunEventsResponseValidationError is the validation error returned by
-// GetJobRunEventsResponse.Validate if the designated constraints aren't met.
-type GetJobRunEventsResponseValidationError struct {
+// JobMappingViolationValidationError is the validation error returned by
+// JobMappingViolation.Validate if the designated constraints aren't met.
+type JobMappingViolationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11568,24 +23984,24 @@ type GetJobRunEventsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRunEventsResponseValidationError) Field() string { return e.field }
+func (e JobMappingViolationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRunEventsResponseValidationError) Reason() string { return e.reason }
+func (e JobMappingViolationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRunEventsResponseValidationError) Cause() error { return e.cause }
+func (e JobMappingViolationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRunEventsResponseValidationError) Key() bool { return e.key }
+func (e JobMappingViolationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRunEventsResponseValidationError) ErrorName() string {
-	return "GetJobRunEventsResponseValidationError"
+func (e JobMappingViolationValidationError) ErrorName() string {
+	return "JobMappingViolationValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobRunEventsResponseValidationError) Error() string {
+func (e JobMappingViolationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11597,14 +24013,14 @@ func (e GetJobRunEventsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRunEventsResponse.%s: %s%s",
+		"invalid %sJobMappingViolation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRunEventsResponseValidationError{}
+var _ error = JobMappingViolationValidationError{}
 
 var _ interface {
 	Field() string
@@ -11612,48 +24028,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRunEventsResponseValidationError{}
+} = JobMappingViolationValidationError{}
 
-// Validate checks the field values on DeleteJobRunRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteJobRunRequest) Validate() error {
+// Validate checks the field values on JobParameter with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobParameter) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteJobRunRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteJobRunRequestMultiError, or nil if none found.
-func (m *DeleteJobRunRequest) ValidateAll() error {
+// ValidateAll checks the field values on JobParameter with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JobParameterMultiError, or
+// nil if none found.
+func (m *JobParameter) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteJobRunRequest) validate(all bool) error {
+func (m *JobParameter) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobRunId
+	// no validation rules for Name
 
-	// no validation rules for AccountId
+	// no validation rules for Type
+
+	// no validation rules for Description
+
+	if m.DefaultValue != nil {
+		// no validation rules for DefaultValue
+	}
 
 	if len(errors) > 0 {
-		return DeleteJobRunRequestMultiError(errors)
+		return JobParameterMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteJobRunRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteJobRunRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteJobRunRequestMultiError []error
+// JobParameterMultiError is an error wrapping multiple validation errors
+// returned by JobParameter.ValidateAll() if the designated constraints aren't met.
+type JobParameterMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteJobRunRequestMultiError) Error() string {
+func (m JobParameterMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11662,11 +24083,11 @@ func (m DeleteJobRunRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteJobRunRequestMultiError) AllErrors() []error { return m }
+func (m JobParameterMultiError) AllErrors() []error { return m }
 
-// DeleteJobRunRequestValidationError is the validation error returned by
-// DeleteJobRunRequest.Validate if the designated constraints aren't met.
-type DeleteJobRunRequestValidationError struct {
+// JobParameterValidationError is the validation error returned by
+// JobParameter.Validate if the designated constraints aren't met.
+type JobParameterValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11674,24 +24095,22 @@ type DeleteJobRunRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteJobRunRequestValidationError) Field() string { return e.field }
+func (e JobParameterValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteJobRunRequestValidationError) Reason() string { return e.reason }
+func (e JobParameterValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteJobRunRequestValidationError) Cause() error { return e.cause }
+func (e JobParameterValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteJobRunRequestValidationError) Key() bool { return e.key }
+func (e JobParameterValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteJobRunRequestValidationError) ErrorName() string {
-	return "DeleteJobRunRequestValidationError"
-}
+func (e JobParameterValidationError) ErrorName() string { return "JobParameterValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteJobRunRequestValidationError) Error() string {
+func (e JobParameterValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11703,14 +24122,14 @@ func (e DeleteJobRunRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteJobRunRequest.%s: %s%s",
+		"invalid %sJobParameter.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteJobRunRequestValidationError{}
+var _ error = JobParameterValidationError{}
 
 var _ interface {
 	Field() string
@@ -11718,44 +24137,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteJobRunRequestValidationError{}
+} = JobParameterValidationError{}
 
-// Validate checks the field values on DeleteJobRunResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteJobRunResponse) Validate() error {
+// Validate checks the field values on JobParameterValue with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *JobParameterValue) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteJobRunResponse with the rules
+// ValidateAll checks the field values on JobParameterValue with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteJobRunResponseMultiError, or nil if none found.
-func (m *DeleteJobRunResponse) ValidateAll() error {
+// JobParameterValueMultiError, or nil if none found.
+func (m *JobParameterValue) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteJobRunResponse) validate(all bool) error {
+func (m *JobParameterValue) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Name
+
+	// no validation rules for Value
+
 	if len(errors) > 0 {
-		return DeleteJobRunResponseMultiError(errors)
+		return JobParameterValueMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteJobRunResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteJobRunResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteJobRunResponseMultiError []error
+// JobParameterValueMultiError is an error wrapping multiple validation errors
+// returned by JobParameterValue.ValidateAll() if the designated constraints
+// aren't met.
+type JobParameterValueMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteJobRunResponseMultiError) Error() string {
+func (m JobParameterValueMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11764,11 +24187,11 @@ func (m DeleteJobRunResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteJobRunResponseMultiError) AllErrors() []error { return m }
+func (m JobParameterValueMultiError) AllErrors() []error { return m }
 
-// DeleteJobRunResponseValidationError is the validation error returned by
-// DeleteJobRunResponse.Validate if the designated constraints aren't met.
-type DeleteJobRunResponseValidationError struct {
+// JobParameterValueValidationError is the validation error returned by
+// JobParameterValue.Validate if the designated constraints aren't met.
+type JobParameterValueValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11776,24 +24199,24 @@ type DeleteJobRunResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteJobRunResponseValidationError) Field() string { return e.field }
+func (e JobParameterValueValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteJobRunResponseValidationError) Reason() string { return e.reason }
+func (e JobParameterValueValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteJobRunResponseValidationError) Cause() error { return e.cause }
+func (e JobParameterValueValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteJobRunResponseValidationError) Key() bool { return e.key }
+func (e JobParameterValueValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteJobRunResponseValidationError) ErrorName() string {
-	return "DeleteJobRunResponseValidationError"
+func (e JobParameterValueValidationError) ErrorName() string {
+	return "JobParameterValueValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteJobRunResponseValidationError) Error() string {
+func (e JobParameterValueValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11805,14 +24228,14 @@ func (e DeleteJobRunResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteJobRunResponse.%s: %s%s",
+		"invalid %sJobParameterValue.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteJobRunResponseValidationError{}
+var _ error = JobParameterValueValidationError{}
 
 var _ interface {
 	Field() string
@@ -11820,48 +24243,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteJobRunResponseValidationError{}
+} = JobParameterValueValidationError{}
 
-// Validate checks the field values on TerminateJobRunRequest with the rules
+// Validate checks the field values on SetJobParametersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TerminateJobRunRequest) Validate() error {
+func (m *SetJobParametersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TerminateJobRunRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SetJobParametersRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TerminateJobRunRequestMultiError, or nil if none found.
-func (m *TerminateJobRunRequest) ValidateAll() error {
+// SetJobParametersRequestMultiError, or nil if none found.
+func (m *SetJobParametersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TerminateJobRunRequest) validate(all bool) error {
+func (m *SetJobParametersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobRunId
+	// no validation rules for Id
 
-	// no validation rules for AccountId
+	for idx, item := range m.GetParameters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SetJobParametersRequestValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SetJobParametersRequestValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SetJobParametersRequestValidationError{
+					field:  fmt.Sprintf("Parameters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return TerminateJobRunRequestMultiError(errors)
+		return SetJobParametersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TerminateJobRunRequestMultiError is an error wrapping multiple validation
-// errors returned by TerminateJobRunRequest.ValidateAll() if the designated
+// SetJobParametersRequestMultiError is an error wrapping multiple validation
+// errors returned by SetJobParametersRequest.ValidateAll() if the designated
 // constraints aren't met.
-type TerminateJobRunRequestMultiError []error
+type SetJobParametersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TerminateJobRunRequestMultiError) Error() string {
+func (m SetJobParametersRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11870,11 +24325,11 @@ func (m TerminateJobRunRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TerminateJobRunRequestMultiError) AllErrors() []error { return m }
+func (m SetJobParametersRequestMultiError) AllErrors() []error { return m }
 
-// TerminateJobRunRequestValidationError is the validation error returned by
-// TerminateJobRunRequest.Validate if the designated constraints aren't met.
-type TerminateJobRunRequestValidationError struct {
+// SetJobParametersRequestValidationError is the validation error returned by
+// SetJobParametersRequest.Validate if the designated constraints aren't met.
+type SetJobParametersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11882,24 +24337,24 @@ type TerminateJobRunRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TerminateJobRunRequestValidationError) Field() string { return e.field }
+func (e SetJobParametersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TerminateJobRunRequestValidationError) Reason() string { return e.reason }
+func (e SetJobParametersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TerminateJobRunRequestValidationError) Cause() error { return e.cause }
+func (e SetJobParametersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TerminateJobRunRequestValidationError) Key() bool { return e.key }
+func (e SetJobParametersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TerminateJobRunRequestValidationError) ErrorName() string {
-	return "TerminateJobRunRequestValidationError"
+func (e SetJobParametersRequestValidationError) ErrorName() string {
+	return "SetJobParametersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TerminateJobRunRequestValidationError) Error() string {
+func (e SetJobParametersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11911,14 +24366,14 @@ func (e TerminateJobRunRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTerminateJobRunRequest.%s: %s%s",
+		"invalid %sSetJobParametersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TerminateJobRunRequestValidationError{}
+var _ error = SetJobParametersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11926,44 +24381,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TerminateJobRunRequestValidationError{}
+} = SetJobParametersRequestValidationError{}
 
-// Validate checks the field values on TerminateJobRunResponse with the rules
+// Validate checks the field values on SetJobParametersResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TerminateJobRunResponse) Validate() error {
+func (m *SetJobParametersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TerminateJobRunResponse with the
+// ValidateAll checks the field values on SetJobParametersResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TerminateJobRunResponseMultiError, or nil if none found.
-func (m *TerminateJobRunResponse) ValidateAll() error {
+// SetJobParametersResponseMultiError, or nil if none found.
+func (m *SetJobParametersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TerminateJobRunResponse) validate(all bool) error {
+func (m *SetJobParametersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetJobParametersResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetJobParametersResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetJobParametersResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return TerminateJobRunResponseMultiError(errors)
+		return SetJobParametersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TerminateJobRunResponseMultiError is an error wrapping multiple validation
-// errors returned by TerminateJobRunResponse.ValidateAll() if the designated
+// SetJobParametersResponseMultiError is an error wrapping multiple validation
+// errors returned by SetJobParametersResponse.ValidateAll() if the designated
 // constraints aren't met.
-type TerminateJobRunResponseMultiError []error
+type SetJobParametersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TerminateJobRunResponseMultiError) Error() string {
+func (m SetJobParametersResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11972,11 +24456,11 @@ func (m TerminateJobRunResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TerminateJobRunResponseMultiError) AllErrors() []error { return m }
+func (m SetJobParametersResponseMultiError) AllErrors() []error { return m }
 
-// TerminateJobRunResponseValidationError is the validation error returned by
-// TerminateJobRunResponse.Validate if the designated constraints aren't met.
-type TerminateJobRunResponseValidationError struct {
+// SetJobParametersResponseValidationError is the validation error returned by
+// SetJobParametersResponse.Validate if the designated constraints aren't met.
+type SetJobParametersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11984,24 +24468,24 @@ type TerminateJobRunResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TerminateJobRunResponseValidationError) Field() string { return e.field }
+func (e SetJobParametersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TerminateJobRunResponseValidationError) Reason() string { return e.reason }
+func (e SetJobParametersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TerminateJobRunResponseValidationError) Cause() error { return e.cause }
+func (e SetJobParametersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TerminateJobRunResponseValidationError) Key() bool { return e.key }
+func (e SetJobParametersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TerminateJobRunResponseValidationError) ErrorName() string {
-	return "TerminateJobRunResponseValidationError"
+func (e SetJobParametersResponseValidationError) ErrorName() string {
+	return "SetJobParametersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TerminateJobRunResponseValidationError) Error() string {
+func (e SetJobParametersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12013,14 +24497,14 @@ func (e TerminateJobRunResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTerminateJobRunResponse.%s: %s%s",
+		"invalid %sSetJobParametersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TerminateJobRunResponseValidationError{}
+var _ error = SetJobParametersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12028,56 +24512,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TerminateJobRunResponseValidationError{}
+} = SetJobParametersResponseValidationError{}
 
-// Validate checks the field values on GetJobRunLogsStreamRequest with the
+// Validate checks the field values on GetJobRunParametersRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRunLogsStreamRequest) Validate() error {
+func (m *GetJobRunParametersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRunLogsStreamRequest with the
+// ValidateAll checks the field values on GetJobRunParametersRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobRunLogsStreamRequestMultiError, or nil if none found.
-func (m *GetJobRunLogsStreamRequest) ValidateAll() error {
+// GetJobRunParametersRequestMultiError, or nil if none found.
+func (m *GetJobRunParametersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRunLogsStreamRequest) validate(all bool) error {
+func (m *GetJobRunParametersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobRunId
-
-	// no validation rules for AccountId
-
-	// no validation rules for Window
-
-	// no validation rules for ShouldTail
+	// no validation rules for JobId
 
-	if m.MaxLogLines != nil {
-		// no validation rules for MaxLogLines
-	}
+	// no validation rules for JobRunId
 
 	if len(errors) > 0 {
-		return GetJobRunLogsStreamRequestMultiError(errors)
+		return GetJobRunParametersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRunLogsStreamRequestMultiError is an error wrapping multiple
-// validation errors returned by GetJobRunLogsStreamRequest.ValidateAll() if
+// GetJobRunParametersRequestMultiError is an error wrapping multiple
+// validation errors returned by GetJobRunParametersRequest.ValidateAll() if
 // the designated constraints aren't met.
-type GetJobRunLogsStreamRequestMultiError []error
+type GetJobRunParametersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRunLogsStreamRequestMultiError) Error() string {
+func (m GetJobRunParametersRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12086,11 +24562,11 @@ func (m GetJobRunLogsStreamRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRunLogsStreamRequestMultiError) AllErrors() []error { return m }
+func (m GetJobRunParametersRequestMultiError) AllErrors() []error { return m }
 
-// GetJobRunLogsStreamRequestValidationError is the validation error returned
-// by GetJobRunLogsStreamRequest.Validate if the designated constraints aren't met.
-type GetJobRunLogsStreamRequestValidationError struct {
+// GetJobRunParametersRequestValidationError is the validation error returned
+// by GetJobRunParametersRequest.Validate if the designated constraints aren't met.
+type GetJobRunParametersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12098,24 +24574,24 @@ type GetJobRunLogsStreamRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRunLogsStreamRequestValidationError) Field() string { return e.field }
+func (e GetJobRunParametersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRunLogsStreamRequestValidationError) Reason() string { return e.reason }
+func (e GetJobRunParametersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRunLogsStreamRequestValidationError) Cause() error { return e.cause }
+func (e GetJobRunParametersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRunLogsStreamRequestValidationError) Key() bool { return e.key }
+func (e GetJobRunParametersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRunLogsStreamRequestValidationError) ErrorName() string {
-	return "GetJobRunLogsStreamRequestValidationError"
+func (e GetJobRunParametersRequestValidationError) ErrorName() string {
+	return "GetJobRunParametersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobRunLogsStreamRequestValidationError) Error() string {
+func (e GetJobRunParametersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12127,14 +24603,14 @@ func (e GetJobRunLogsStreamRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRunLogsStreamRequest.%s: %s%s",
+		"invalid %sGetJobRunParametersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRunLogsStreamRequestValidationError{}
+var _ error = GetJobRunParametersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12142,57 +24618,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRunLogsStreamRequestValidationError{}
+} = GetJobRunParametersRequestValidationError{}
 
-// Validate checks the field values on GetJobRunLogsStreamResponse with the
+// Validate checks the field values on GetJobRunParametersResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRunLogsStreamResponse) Validate() error {
+func (m *GetJobRunParametersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRunLogsStreamResponse with the
+// ValidateAll checks the field values on GetJobRunParametersResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobRunLogsStreamResponseMultiError, or nil if none found.
-func (m *GetJobRunLogsStreamResponse) ValidateAll() error {
+// GetJobRunParametersResponseMultiError, or nil if none found.
+func (m *GetJobRunParametersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRunLogsStreamResponse) validate(all bool) error {
+func (m *GetJobRunParametersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for LogLine
-
-	if m.Timestamp != nil {
+	for idx, item := range m.GetParameters() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetTimestamp()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetJobRunLogsStreamResponseValidationError{
-						field:  "Timestamp",
+					errors = append(errors, GetJobRunParametersResponseValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetJobRunLogsStreamResponseValidationError{
-						field:  "Timestamp",
+					errors = append(errors, GetJobRunParametersResponseValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetJobRunLogsStreamResponseValidationError{
-					field:  "Timestamp",
+				return GetJobRunParametersResponseValidationError{
+					field:  fmt.Sprintf("Parameters[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -12202,19 +24677,19 @@ func (m *GetJobRunLogsStreamResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetJobRunLogsStreamResponseMultiError(errors)
+		return GetJobRunParametersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRunLogsStreamResponseMultiError is an error wrapping multiple
-// validation errors returned by GetJobRunLogsStreamResponse.ValidateAll() if
+// GetJobRunParametersResponseMultiError is an error wrapping multiple
+// validation errors returned by GetJobRunParametersResponse.ValidateAll() if
 // the designated constraints aren't met.
-type GetJobRunLogsStreamResponseMultiError []error
+type GetJobRunParametersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRunLogsStreamResponseMultiError) Error() string {
+func (m GetJobRunParametersResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12223,12 +24698,12 @@ func (m GetJobRunLogsStreamResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRunLogsStreamResponseMultiError) AllErrors() []error { return m }
+func (m GetJobRunParametersResponseMultiError) AllErrors() []error { return m }
 
-// GetJobRunLogsStreamResponseValidationError is the validation error returned
-// by GetJobRunLogsStreamResponse.Validate if the designated constraints
+// GetJobRunParametersResponseValidationError is the validation error returned
+// by GetJobRunParametersResponse.Validate if the designated constraints
 // aren't met.
-type GetJobRunLogsStreamResponseValidationError struct {
+type GetJobRunParametersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12236,24 +24711,24 @@ type GetJobRunLogsStreamResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRunLogsStreamResponseValidationError) Field() string { return e.field }
+func (e GetJobRunParametersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRunLogsStreamResponseValidationError) Reason() string { return e.reason }
+func (e GetJobRunParametersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRunLogsStreamResponseValidationError) Cause() error { return e.cause }
+func (e GetJobRunParametersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRunLogsStreamResponseValidationError) Key() bool { return e.key }
+func (e GetJobRunParametersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRunLogsStreamResponseValidationError) ErrorName() string {
-	return "GetJobRunLogsStreamResponseValidationError"
+func (e GetJobRunParametersResponseValidationError) ErrorName() string {
+	return "GetJobRunParametersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobRunLogsStreamResponseValidationError) Error() string {
+func (e GetJobRunParametersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12265,14 +24740,14 @@ func (e GetJobRunLogsStreamResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRunLogsStreamResponse.%s: %s%s",
+		"invalid %sGetJobRunParametersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRunLogsStreamResponseValidationError{}
+var _ error = GetJobRunParametersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12280,75 +24755,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRunLogsStreamResponseValidationError{}
+} = GetJobRunParametersResponseValidationError{}
 
-// Validate checks the field values on SetJobWorkflowOptionsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on TenantExtractOptions with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetJobWorkflowOptionsRequest) Validate() error {
+func (m *TenantExtractOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetJobWorkflowOptionsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TenantExtractOptions with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetJobWorkflowOptionsRequestMultiError, or nil if none found.
-func (m *SetJobWorkflowOptionsRequest) ValidateAll() error {
+// TenantExtractOptionsMultiError, or nil if none found.
+func (m *TenantExtractOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetJobWorkflowOptionsRequest) validate(all bool) error {
+func (m *TenantExtractOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for TenantValue
 
-	if all {
-		switch v := interface{}(m.GetWorfklowOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SetJobWorkflowOptionsRequestValidationError{
-					field:  "WorfklowOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetRootTables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TenantExtractOptionsValidationError{
+						field:  fmt.Sprintf("RootTables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TenantExtractOptionsValidationError{
+						field:  fmt.Sprintf("RootTables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SetJobWorkflowOptionsRequestValidationError{
-					field:  "WorfklowOptions",
+				return TenantExtractOptionsValidationError{
+					field:  fmt.Sprintf("RootTables[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetWorfklowOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SetJobWorkflowOptionsRequestValidationError{
-				field:  "WorfklowOptions",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
+	}
+
+	if m.TenantColumn != nil {
+		// no validation rules for TenantColumn
 	}
 
 	if len(errors) > 0 {
-		return SetJobWorkflowOptionsRequestMultiError(errors)
+		return TenantExtractOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetJobWorkflowOptionsRequestMultiError is an error wrapping multiple
-// validation errors returned by SetJobWorkflowOptionsRequest.ValidateAll() if
-// the designated constraints aren't met.
-type SetJobWorkflowOptionsRequestMultiError []error
+// TenantExtractOptionsMultiError is an error wrapping multiple validation
+// errors returned by TenantExtractOptions.ValidateAll() if the designated
+// constraints aren't met.
+type TenantExtractOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetJobWorkflowOptionsRequestMultiError) Error() string {
+func (m TenantExtractOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12357,12 +24841,11 @@ func (m SetJobWorkflowOptionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetJobWorkflowOptionsRequestMultiError) AllErrors() []error { return m }
+func (m TenantExtractOptionsMultiError) AllErrors() []error { return m }
 
-// SetJobWorkflowOptionsRequestValidationError is the validation error returned
-// by SetJobWorkflowOptionsRequest.Validate if the designated constraints
-// aren't met.
-type SetJobWorkflowOptionsRequestValidationError struct {
+// TenantExtractOptionsValidationError is the validation error returned by
+// TenantExtractOptions.Validate if the designated constraints aren't met.
+type TenantExtractOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12370,24 +24853,24 @@ type SetJobWorkflowOptionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetJobWorkflowOptionsRequestValidationError) Field() string { return e.field }
+func (e TenantExtractOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetJobWorkflowOptionsRequestValidationError) Reason() string { return e.reason }
+func (e TenantExtractOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetJobWorkflowOptionsRequestValidationError) Cause() error { return e.cause }
+func (e TenantExtractOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetJobWorkflowOptionsRequestValidationError) Key() bool { return e.key }
+func (e TenantExtractOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetJobWorkflowOptionsRequestValidationError) ErrorName() string {
-	return "SetJobWorkflowOptionsRequestValidationError"
+func (e TenantExtractOptionsValidationError) ErrorName() string {
+	return "TenantExtractOptionsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetJobWorkflowOptionsRequestValidationError) Error() string {
+func (e TenantExtractOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12399,14 +24882,14 @@ func (e SetJobWorkflowOptionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetJobWorkflowOptionsRequest.%s: %s%s",
+		"invalid %sTenantExtractOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetJobWorkflowOptionsRequestValidationError{}
+var _ error = TenantExtractOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -12414,73 +24897,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetJobWorkflowOptionsRequestValidationError{}
+} = TenantExtractOptionsValidationError{}
 
-// Validate checks the field values on SetJobWorkflowOptionsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on TenantExtractTable with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetJobWorkflowOptionsResponse) Validate() error {
+func (m *TenantExtractTable) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetJobWorkflowOptionsResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// SetJobWorkflowOptionsResponseMultiError, or nil if none found.
-func (m *SetJobWorkflowOptionsResponse) ValidateAll() error {
+// ValidateAll checks the field values on TenantExtractTable with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TenantExtractTableMultiError, or nil if none found.
+func (m *TenantExtractTable) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetJobWorkflowOptionsResponse) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if all {
-		switch v := interface{}(m.GetJob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SetJobWorkflowOptionsResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SetJobWorkflowOptionsResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SetJobWorkflowOptionsResponseValidationError{
-				field:  "Job",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+func (m *TenantExtractTable) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for TenantColumn
+
 	if len(errors) > 0 {
-		return SetJobWorkflowOptionsResponseMultiError(errors)
+		return TenantExtractTableMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetJobWorkflowOptionsResponseMultiError is an error wrapping multiple
-// validation errors returned by SetJobWorkflowOptionsResponse.ValidateAll()
-// if the designated constraints aren't met.
-type SetJobWorkflowOptionsResponseMultiError []error
+// TenantExtractTableMultiError is an error wrapping multiple validation errors
+// returned by TenantExtractTable.ValidateAll() if the designated constraints
+// aren't met.
+type TenantExtractTableMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetJobWorkflowOptionsResponseMultiError) Error() string {
+func (m TenantExtractTableMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12489,12 +24949,11 @@ func (m SetJobWorkflowOptionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetJobWorkflowOptionsResponseMultiError) AllErrors() []error { return m }
+func (m TenantExtractTableMultiError) AllErrors() []error { return m }
 
-// SetJobWorkflowOptionsResponseValidationError is the validation error
-// returned by SetJobWorkflowOptionsResponse.Validate if the designated
-// constraints aren't met.
-type SetJobWorkflowOptionsResponseValidationError struct {
+// TenantExtractTableValidationError is the validation error returned by
+// TenantExtractTable.Validate if the designated constraints aren't met.
+type TenantExtractTableValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12502,24 +24961,24 @@ type SetJobWorkflowOptionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetJobWorkflowOptionsResponseValidationError) Field() string { return e.field }
+func (e TenantExtractTableValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetJobWorkflowOptionsResponseValidationError) Reason() string { return e.reason }
+func (e TenantExtractTableValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetJobWorkflowOptionsResponseValidationError) Cause() error { return e.cause }
+func (e TenantExtractTableValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetJobWorkflowOptionsResponseValidationError) Key() bool { return e.key }
+func (e TenantExtractTableValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetJobWorkflowOptionsResponseValidationError) ErrorName() string {
-	return "SetJobWorkflowOptionsResponseValidationError"
+func (e TenantExtractTableValidationError) ErrorName() string {
+	return "TenantExtractTableValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetJobWorkflowOptionsResponseValidationError) Error() string {
+func (e TenantExtractTableValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12531,14 +24990,14 @@ func (e SetJobWorkflowOptionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetJobWorkflowOptionsResponse.%s: %s%s",
+		"invalid %sTenantExtractTable.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetJobWorkflowOptionsResponseValidationError{}
+var _ error = TenantExtractTableValidationError{}
 
 var _ interface {
 	Field() string
@@ -12546,75 +25005,138 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetJobWorkflowOptionsResponseValidationError{}
+} = TenantExtractTableValidationError{}
 
-// Validate checks the field values on SetJobSyncOptionsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SnapshotCloneSourceOptions with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetJobSyncOptionsRequest) Validate() error {
+func (m *SnapshotCloneSourceOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetJobSyncOptionsRequest with the
+// ValidateAll checks the field values on SnapshotCloneSourceOptions with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetJobSyncOptionsRequestMultiError, or nil if none found.
-func (m *SetJobSyncOptionsRequest) ValidateAll() error {
+// SnapshotCloneSourceOptionsMultiError, or nil if none found.
+func (m *SnapshotCloneSourceOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetJobSyncOptionsRequest) validate(all bool) error {
+func (m *SnapshotCloneSourceOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for ConnectionId
 
-	if all {
-		switch v := interface{}(m.GetSyncOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SetJobSyncOptionsRequestValidationError{
-					field:  "SyncOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for RetainOnFailure
+
+	switch v := m.Snapshot.(type) {
+	case *SnapshotCloneSourceOptions_AwsRds:
+		if v == nil {
+			err := SnapshotCloneSourceOptionsValidationError{
+				field:  "Snapshot",
+				reason: "oneof value cannot be a typed-nil",
 			}
-		case interface{ Validate() error }:
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAwsRds()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SnapshotCloneSourceOptionsValidationError{
+						field:  "AwsRds",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SnapshotCloneSourceOptionsValidationError{
+						field:  "AwsRds",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAwsRds()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SetJobSyncOptionsRequestValidationError{
-					field:  "SyncOptions",
+				return SnapshotCloneSourceOptionsValidationError{
+					field:  "AwsRds",
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
+				}
 			}
 		}
-	} else if v, ok := interface{}(m.GetSyncOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SetJobSyncOptionsRequestValidationError{
-				field:  "SyncOptions",
-				reason: "embedded message failed validation",
-				cause:  err,
+
+	case *SnapshotCloneSourceOptions_GcpCloudsql:
+		if v == nil {
+			err := SnapshotCloneSourceOptionsValidationError{
+				field:  "Snapshot",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetGcpCloudsql()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SnapshotCloneSourceOptionsValidationError{
+						field:  "GcpCloudsql",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SnapshotCloneSourceOptionsValidationError{
+						field:  "GcpCloudsql",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGcpCloudsql()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SnapshotCloneSourceOptionsValidationError{
+					field:  "GcpCloudsql",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
+	default:
+		_ = v // ensures v is used
+	}
+
+	if m.HandoverConnectionName != nil {
+		// no validation rules for HandoverConnectionName
 	}
 
 	if len(errors) > 0 {
-		return SetJobSyncOptionsRequestMultiError(errors)
+		return SnapshotCloneSourceOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetJobSyncOptionsRequestMultiError is an error wrapping multiple validation
-// errors returned by SetJobSyncOptionsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type SetJobSyncOptionsRequestMultiError []error
+// SnapshotCloneSourceOptionsMultiError is an error wrapping multiple
+// validation errors returned by SnapshotCloneSourceOptions.ValidateAll() if
+// the designated constraints aren't met.
+type SnapshotCloneSourceOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetJobSyncOptionsRequestMultiError) Error() string {
+func (m SnapshotCloneSourceOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12623,11 +25145,11 @@ func (m SetJobSyncOptionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetJobSyncOptionsRequestMultiError) AllErrors() []error { return m }
+func (m SnapshotCloneSourceOptionsMultiError) AllErrors() []error { return m }
 
-// SetJobSyncOptionsRequestValidationError is the validation error returned by
-// SetJobSyncOptionsRequest.Validate if the designated constraints aren't met.
-type SetJobSyncOptionsRequestValidationError struct {
+// SnapshotCloneSourceOptionsValidationError is the validation error returned
+// by SnapshotCloneSourceOptions.Validate if the designated constraints aren't met.
+type SnapshotCloneSourceOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12635,24 +25157,24 @@ type SetJobSyncOptionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetJobSyncOptionsRequestValidationError) Field() string { return e.field }
+func (e SnapshotCloneSourceOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetJobSyncOptionsRequestValidationError) Reason() string { return e.reason }
+func (e SnapshotCloneSourceOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetJobSyncOptionsRequestValidationError) Cause() error { return e.cause }
+func (e SnapshotCloneSourceOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetJobSyncOptionsRequestValidationError) Key() bool { return e.key }
+func (e SnapshotCloneSourceOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetJobSyncOptionsRequestValidationError) ErrorName() string {
-	return "SetJobSyncOptionsRequestValidationError"
+func (e SnapshotCloneSourceOptionsValidationError) ErrorName() string {
+	return "SnapshotCloneSourceOptionsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetJobSyncOptionsRequestValidationError) Error() string {
+func (e SnapshotCloneSourceOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12664,14 +25186,14 @@ func (e SetJobSyncOptionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetJobSyncOptionsRequest.%s: %s%s",
+		"invalid %sSnapshotCloneSourceOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetJobSyncOptionsRequestValidationError{}
+var _ error = SnapshotCloneSourceOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -12679,73 +25201,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetJobSyncOptionsRequestValidationError{}
+} = SnapshotCloneSourceOptionsValidationError{}
 
-// Validate checks the field values on SetJobSyncOptionsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetJobSyncOptionsResponse) Validate() error {
+// Validate checks the field values on AwsRdsSnapshot with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AwsRdsSnapshot) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetJobSyncOptionsResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SetJobSyncOptionsResponseMultiError, or nil if none found.
-func (m *SetJobSyncOptionsResponse) ValidateAll() error {
+// ValidateAll checks the field values on AwsRdsSnapshot with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AwsRdsSnapshotMultiError,
+// or nil if none found.
+func (m *AwsRdsSnapshot) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetJobSyncOptionsResponse) validate(all bool) error {
+func (m *AwsRdsSnapshot) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetJob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SetJobSyncOptionsResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SetJobSyncOptionsResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SetJobSyncOptionsResponseValidationError{
-				field:  "Job",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	// no validation rules for SnapshotIdentifier
+
+	// no validation rules for Region
+
+	if m.DbInstanceClass != nil {
+		// no validation rules for DbInstanceClass
+	}
+
+	if m.DbSubnetGroupName != nil {
+		// no validation rules for DbSubnetGroupName
 	}
 
 	if len(errors) > 0 {
-		return SetJobSyncOptionsResponseMultiError(errors)
+		return AwsRdsSnapshotMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetJobSyncOptionsResponseMultiError is an error wrapping multiple validation
-// errors returned by SetJobSyncOptionsResponse.ValidateAll() if the
-// designated constraints aren't met.
-type SetJobSyncOptionsResponseMultiError []error
+// AwsRdsSnapshotMultiError is an error wrapping multiple validation errors
+// returned by AwsRdsSnapshot.ValidateAll() if the designated constraints
+// aren't met.
+type AwsRdsSnapshotMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetJobSyncOptionsResponseMultiError) Error() string {
+func (m AwsRdsSnapshotMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12754,11 +25259,11 @@ func (m SetJobSyncOptionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetJobSyncOptionsResponseMultiError) AllErrors() []error { return m }
+func (m AwsRdsSnapshotMultiError) AllErrors() []error { return m }
 
-// SetJobSyncOptionsResponseValidationError is the validation error returned by
-// SetJobSyncOptionsResponse.Validate if the designated constraints aren't met.
-type SetJobSyncOptionsResponseValidationError struct {
+// AwsRdsSnapshotValidationError is the validation error returned by
+// AwsRdsSnapshot.Validate if the designated constraints aren't met.
+type AwsRdsSnapshotValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12766,24 +25271,22 @@ type SetJobSyncOptionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetJobSyncOptionsResponseValidationError) Field() string { return e.field }
+func (e AwsRdsSnapshotValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetJobSyncOptionsResponseValidationError) Reason() string { return e.reason }
+func (e AwsRdsSnapshotValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetJobSyncOptionsResponseValidationError) Cause() error { return e.cause }
+func (e AwsRdsSnapshotValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetJobSyncOptionsResponseValidationError) Key() bool { return e.key }
+func (e AwsRdsSnapshotValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetJobSyncOptionsResponseValidationError) ErrorName() string {
-	return "SetJobSyncOptionsResponseValidationError"
-}
+func (e AwsRdsSnapshotValidationError) ErrorName() string { return "AwsRdsSnapshotValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SetJobSyncOptionsResponseValidationError) Error() string {
+func (e AwsRdsSnapshotValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12795,14 +25298,14 @@ func (e SetJobSyncOptionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetJobSyncOptionsResponse.%s: %s%s",
+		"invalid %sAwsRdsSnapshot.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetJobSyncOptionsResponseValidationError{}
+var _ error = AwsRdsSnapshotValidationError{}
 
 var _ interface {
 	Field() string
@@ -12810,82 +25313,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetJobSyncOptionsResponseValidationError{}
+} = AwsRdsSnapshotValidationError{}
 
-// Validate checks the field values on ValidateJobMappingsRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ValidateJobMappingsRequest) Validate() error {
+// Validate checks the field values on GcpCloudSqlBackup with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GcpCloudSqlBackup) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ValidateJobMappingsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GcpCloudSqlBackup with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ValidateJobMappingsRequestMultiError, or nil if none found.
-func (m *ValidateJobMappingsRequest) ValidateAll() error {
+// GcpCloudSqlBackupMultiError, or nil if none found.
+func (m *GcpCloudSqlBackup) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ValidateJobMappingsRequest) validate(all bool) error {
+func (m *GcpCloudSqlBackup) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AccountId
-
-	for idx, item := range m.GetMappings() {
-		_, _ = idx, item
+	// no validation rules for Project
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ValidateJobMappingsRequestValidationError{
-						field:  fmt.Sprintf("Mappings[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ValidateJobMappingsRequestValidationError{
-						field:  fmt.Sprintf("Mappings[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ValidateJobMappingsRequestValidationError{
-					field:  fmt.Sprintf("Mappings[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Instance
 
+	if m.BackupRunId != nil {
+		// no validation rules for BackupRunId
 	}
 
-	// no validation rules for ConnectionId
+	if m.Tier != nil {
+		// no validation rules for Tier
+	}
 
 	if len(errors) > 0 {
-		return ValidateJobMappingsRequestMultiError(errors)
+		return GcpCloudSqlBackupMultiError(errors)
 	}
 
 	return nil
 }
 
-// ValidateJobMappingsRequestMultiError is an error wrapping multiple
-// validation errors returned by ValidateJobMappingsRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ValidateJobMappingsRequestMultiError []error
-
+// GcpCloudSqlBackupMultiError is an error wrapping multiple validation errors
+// returned by GcpCloudSqlBackup.ValidateAll() if the designated constraints
+// aren't met.
+type GcpCloudSqlBackupMultiError []error
+
 // Error returns a concatenation of all the error messages it wraps.
-func (m ValidateJobMappingsRequestMultiError) Error() string {
+func (m GcpCloudSqlBackupMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12894,11 +25371,11 @@ func (m ValidateJobMappingsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ValidateJobMappingsRequestMultiError) AllErrors() []error { return m }
+func (m GcpCloudSqlBackupMultiError) AllErrors() []error { return m }
 
-// ValidateJobMappingsRequestValidationError is the validation error returned
-// by ValidateJobMappingsRequest.Validate if the designated constraints aren't met.
-type ValidateJobMappingsRequestValidationError struct {
+// GcpCloudSqlBackupValidationError is the validation error returned by
+// GcpCloudSqlBackup.Validate if the designated constraints aren't met.
+type GcpCloudSqlBackupValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12906,24 +25383,24 @@ type ValidateJobMappingsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ValidateJobMappingsRequestValidationError) Field() string { return e.field }
+func (e GcpCloudSqlBackupValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ValidateJobMappingsRequestValidationError) Reason() string { return e.reason }
+func (e GcpCloudSqlBackupValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ValidateJobMappingsRequestValidationError) Cause() error { return e.cause }
+func (e GcpCloudSqlBackupValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ValidateJobMappingsRequestValidationError) Key() bool { return e.key }
+func (e GcpCloudSqlBackupValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ValidateJobMappingsRequestValidationError) ErrorName() string {
-	return "ValidateJobMappingsRequestValidationError"
+func (e GcpCloudSqlBackupValidationError) ErrorName() string {
+	return "GcpCloudSqlBackupValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ValidateJobMappingsRequestValidationError) Error() string {
+func (e GcpCloudSqlBackupValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12935,14 +25412,14 @@ func (e ValidateJobMappingsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sValidateJobMappingsRequest.%s: %s%s",
+		"invalid %sGcpCloudSqlBackup.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ValidateJobMappingsRequestValidationError{}
+var _ error = GcpCloudSqlBackupValidationError{}
 
 var _ interface {
 	Field() string
@@ -12950,49 +25427,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ValidateJobMappingsRequestValidationError{}
+} = GcpCloudSqlBackupValidationError{}
 
-// Validate checks the field values on ColumnError with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ColumnError) Validate() error {
+// Validate checks the field values on InPlaceMaskSourceOptions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InPlaceMaskSourceOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnError with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ColumnErrorMultiError, or
-// nil if none found.
-func (m *ColumnError) ValidateAll() error {
+// ValidateAll checks the field values on InPlaceMaskSourceOptions with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InPlaceMaskSourceOptionsMultiError, or nil if none found.
+func (m *InPlaceMaskSourceOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnError) validate(all bool) error {
+func (m *InPlaceMaskSourceOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Schema
+	// no validation rules for ConnectionId
 
-	// no validation rules for Table
+	// no validation rules for DryRun
 
-	// no validation rules for Column
+	if m.BatchSize != nil {
+		// no validation rules for BatchSize
+	}
 
 	if len(errors) > 0 {
-		return ColumnErrorMultiError(errors)
+		return InPlaceMaskSourceOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnErrorMultiError is an error wrapping multiple validation errors
-// returned by ColumnError.ValidateAll() if the designated constraints aren't met.
-type ColumnErrorMultiError []error
+// InPlaceMaskSourceOptionsMultiError is an error wrapping multiple validation
+// errors returned by InPlaceMaskSourceOptions.ValidateAll() if the designated
+// constraints aren't met.
+type InPlaceMaskSourceOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnErrorMultiError) Error() string {
+func (m InPlaceMaskSourceOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13001,11 +25481,11 @@ func (m ColumnErrorMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnErrorMultiError) AllErrors() []error { return m }
+func (m InPlaceMaskSourceOptionsMultiError) AllErrors() []error { return m }
 
-// ColumnErrorValidationError is the validation error returned by
-// ColumnError.Validate if the designated constraints aren't met.
-type ColumnErrorValidationError struct {
+// InPlaceMaskSourceOptionsValidationError is the validation error returned by
+// InPlaceMaskSourceOptions.Validate if the designated constraints aren't met.
+type InPlaceMaskSourceOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13013,22 +25493,24 @@ type ColumnErrorValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnErrorValidationError) Field() string { return e.field }
+func (e InPlaceMaskSourceOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnErrorValidationError) Reason() string { return e.reason }
+func (e InPlaceMaskSourceOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnErrorValidationError) Cause() error { return e.cause }
+func (e InPlaceMaskSourceOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnErrorValidationError) Key() bool { return e.key }
+func (e InPlaceMaskSourceOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnErrorValidationError) ErrorName() string { return "ColumnErrorValidationError" }
+func (e InPlaceMaskSourceOptionsValidationError) ErrorName() string {
+	return "InPlaceMaskSourceOptionsValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ColumnErrorValidationError) Error() string {
+func (e InPlaceMaskSourceOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13040,14 +25522,14 @@ func (e ColumnErrorValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnError.%s: %s%s",
+		"invalid %sInPlaceMaskSourceOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnErrorValidationError{}
+var _ error = InPlaceMaskSourceOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -13055,44 +25537,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnErrorValidationError{}
+} = InPlaceMaskSourceOptionsValidationError{}
 
-// Validate checks the field values on DatabaseError with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *DatabaseError) Validate() error {
+// Validate checks the field values on GetJobRunMaskingProgressRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunMaskingProgressRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DatabaseError with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DatabaseErrorMultiError, or
-// nil if none found.
-func (m *DatabaseError) ValidateAll() error {
+// ValidateAll checks the field values on GetJobRunMaskingProgressRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetJobRunMaskingProgressRequestMultiError, or nil if none found.
+func (m *GetJobRunMaskingProgressRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DatabaseError) validate(all bool) error {
+func (m *GetJobRunMaskingProgressRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for JobRunId
+
+	// no validation rules for AccountId
+
 	if len(errors) > 0 {
-		return DatabaseErrorMultiError(errors)
+		return GetJobRunMaskingProgressRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DatabaseErrorMultiError is an error wrapping multiple validation errors
-// returned by DatabaseError.ValidateAll() if the designated constraints
-// aren't met.
-type DatabaseErrorMultiError []error
+// GetJobRunMaskingProgressRequestMultiError is an error wrapping multiple
+// validation errors returned by GetJobRunMaskingProgressRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetJobRunMaskingProgressRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DatabaseErrorMultiError) Error() string {
+func (m GetJobRunMaskingProgressRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13101,11 +25587,12 @@ func (m DatabaseErrorMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DatabaseErrorMultiError) AllErrors() []error { return m }
+func (m GetJobRunMaskingProgressRequestMultiError) AllErrors() []error { return m }
 
-// DatabaseErrorValidationError is the validation error returned by
-// DatabaseError.Validate if the designated constraints aren't met.
-type DatabaseErrorValidationError struct {
+// GetJobRunMaskingProgressRequestValidationError is the validation error
+// returned by GetJobRunMaskingProgressRequest.Validate if the designated
+// constraints aren't met.
+type GetJobRunMaskingProgressRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13113,22 +25600,24 @@ type DatabaseErrorValidationError struct {
 }
 
 // Field function returns field value.
-func (e DatabaseErrorValidationError) Field() string { return e.field }
+func (e GetJobRunMaskingProgressRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DatabaseErrorValidationError) Reason() string { return e.reason }
+func (e GetJobRunMaskingProgressRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DatabaseErrorValidationError) Cause() error { return e.cause }
+func (e GetJobRunMaskingProgressRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DatabaseErrorValidationError) Key() bool { return e.key }
+func (e GetJobRunMaskingProgressRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DatabaseErrorValidationError) ErrorName() string { return "DatabaseErrorValidationError" }
+func (e GetJobRunMaskingProgressRequestValidationError) ErrorName() string {
+	return "GetJobRunMaskingProgressRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DatabaseErrorValidationError) Error() string {
+func (e GetJobRunMaskingProgressRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13140,14 +25629,14 @@ func (e DatabaseErrorValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDatabaseError.%s: %s%s",
+		"invalid %sGetJobRunMaskingProgressRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DatabaseErrorValidationError{}
+var _ error = GetJobRunMaskingProgressRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13155,47 +25644,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DatabaseErrorValidationError{}
+} = GetJobRunMaskingProgressRequestValidationError{}
 
-// Validate checks the field values on ValidateJobMappingsResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ValidateJobMappingsResponse) Validate() error {
+// Validate checks the field values on GetJobRunMaskingProgressResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetJobRunMaskingProgressResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ValidateJobMappingsResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ValidateJobMappingsResponseMultiError, or nil if none found.
-func (m *ValidateJobMappingsResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetJobRunMaskingProgressResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetJobRunMaskingProgressResponseMultiError, or nil if none found.
+func (m *GetJobRunMaskingProgressResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ValidateJobMappingsResponse) validate(all bool) error {
+func (m *GetJobRunMaskingProgressResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetColumnErrors() {
+	for idx, item := range m.GetTables() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ValidateJobMappingsResponseValidationError{
-						field:  fmt.Sprintf("ColumnErrors[%v]", idx),
+					errors = append(errors, GetJobRunMaskingProgressResponseValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ValidateJobMappingsResponseValidationError{
-						field:  fmt.Sprintf("ColumnErrors[%v]", idx),
+					errors = append(errors, GetJobRunMaskingProgressResponseValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -13203,8 +25693,8 @@ func (m *ValidateJobMappingsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ValidateJobMappingsResponseValidationError{
-					field:  fmt.Sprintf("ColumnErrors[%v]", idx),
+				return GetJobRunMaskingProgressResponseValidationError{
+					field:  fmt.Sprintf("Tables[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -13213,49 +25703,136 @@ func (m *ValidateJobMappingsResponse) validate(all bool) error {
 
 	}
 
-	if all {
-		switch v := interface{}(m.GetDatabaseErrors()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ValidateJobMappingsResponseValidationError{
-					field:  "DatabaseErrors",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ValidateJobMappingsResponseValidationError{
-					field:  "DatabaseErrors",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDatabaseErrors()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ValidateJobMappingsResponseValidationError{
-				field:  "DatabaseErrors",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return GetJobRunMaskingProgressResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRunMaskingProgressResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetJobRunMaskingProgressResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobRunMaskingProgressResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRunMaskingProgressResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRunMaskingProgressResponseMultiError) AllErrors() []error { return m }
+
+// GetJobRunMaskingProgressResponseValidationError is the validation error
+// returned by GetJobRunMaskingProgressResponse.Validate if the designated
+// constraints aren't met.
+type GetJobRunMaskingProgressResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRunMaskingProgressResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRunMaskingProgressResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRunMaskingProgressResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRunMaskingProgressResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRunMaskingProgressResponseValidationError) ErrorName() string {
+	return "GetJobRunMaskingProgressResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobRunMaskingProgressResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRunMaskingProgressResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRunMaskingProgressResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRunMaskingProgressResponseValidationError{}
+
+// Validate checks the field values on TableMaskingProgress with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TableMaskingProgress) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TableMaskingProgress with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TableMaskingProgressMultiError, or nil if none found.
+func (m *TableMaskingProgress) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TableMaskingProgress) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for TotalRows
+
+	// no validation rules for TotalBatches
+
+	// no validation rules for CompletedBatches
+
 	if len(errors) > 0 {
-		return ValidateJobMappingsResponseMultiError(errors)
+		return TableMaskingProgressMultiError(errors)
 	}
 
 	return nil
 }
 
-// ValidateJobMappingsResponseMultiError is an error wrapping multiple
-// validation errors returned by ValidateJobMappingsResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ValidateJobMappingsResponseMultiError []error
+// TableMaskingProgressMultiError is an error wrapping multiple validation
+// errors returned by TableMaskingProgress.ValidateAll() if the designated
+// constraints aren't met.
+type TableMaskingProgressMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ValidateJobMappingsResponseMultiError) Error() string {
+func (m TableMaskingProgressMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13264,12 +25841,11 @@ func (m ValidateJobMappingsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ValidateJobMappingsResponseMultiError) AllErrors() []error { return m }
+func (m TableMaskingProgressMultiError) AllErrors() []error { return m }
 
-// ValidateJobMappingsResponseValidationError is the validation error returned
-// by ValidateJobMappingsResponse.Validate if the designated constraints
-// aren't met.
-type ValidateJobMappingsResponseValidationError struct {
+// TableMaskingProgressValidationError is the validation error returned by
+// TableMaskingProgress.Validate if the designated constraints aren't met.
+type TableMaskingProgressValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13277,24 +25853,24 @@ type ValidateJobMappingsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ValidateJobMappingsResponseValidationError) Field() string { return e.field }
+func (e TableMaskingProgressValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ValidateJobMappingsResponseValidationError) Reason() string { return e.reason }
+func (e TableMaskingProgressValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ValidateJobMappingsResponseValidationError) Cause() error { return e.cause }
+func (e TableMaskingProgressValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ValidateJobMappingsResponseValidationError) Key() bool { return e.key }
+func (e TableMaskingProgressValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ValidateJobMappingsResponseValidationError) ErrorName() string {
-	return "ValidateJobMappingsResponseValidationError"
+func (e TableMaskingProgressValidationError) ErrorName() string {
+	return "TableMaskingProgressValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ValidateJobMappingsResponseValidationError) Error() string {
+func (e TableMaskingProgressValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13306,14 +25882,14 @@ func (e ValidateJobMappingsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sValidateJobMappingsResponse.%s: %s%s",
+		"invalid %sTableMaskingProgress.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ValidateJobMappingsResponseValidationError{}
+var _ error = TableMaskingProgressValidationError{}
 
 var _ interface {
 	Field() string
@@ -13321,4 +25897,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ValidateJobMappingsResponseValidationError{}
+} = TableMaskingProgressValidationError{}
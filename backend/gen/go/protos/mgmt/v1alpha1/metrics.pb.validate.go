@@ -973,3 +973,594 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetMetricCountResponseValidationError{}
+
+// Validate checks the field values on GetAccountDashboardRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAccountDashboardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAccountDashboardRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAccountDashboardRequestMultiError, or nil if none found.
+func (m *GetAccountDashboardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccountDashboardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	if len(errors) > 0 {
+		return GetAccountDashboardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAccountDashboardRequestMultiError is an error wrapping multiple
+// validation errors returned by GetAccountDashboardRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetAccountDashboardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccountDashboardRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccountDashboardRequestMultiError) AllErrors() []error { return m }
+
+// GetAccountDashboardRequestValidationError is the validation error returned
+// by GetAccountDashboardRequest.Validate if the designated constraints aren't met.
+type GetAccountDashboardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccountDashboardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccountDashboardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccountDashboardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccountDashboardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccountDashboardRequestValidationError) ErrorName() string {
+	return "GetAccountDashboardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAccountDashboardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccountDashboardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccountDashboardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccountDashboardRequestValidationError{}
+
+// Validate checks the field values on GetAccountDashboardResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAccountDashboardResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAccountDashboardResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAccountDashboardResponseMultiError, or nil if none found.
+func (m *GetAccountDashboardResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccountDashboardResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TotalRuns
+
+	// no validation rules for SuccessfulRuns
+
+	// no validation rules for FailedRuns
+
+	// no validation rules for SuccessRate
+
+	for idx, item := range m.GetRowsSynced() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAccountDashboardResponseValidationError{
+						field:  fmt.Sprintf("RowsSynced[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAccountDashboardResponseValidationError{
+						field:  fmt.Sprintf("RowsSynced[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAccountDashboardResponseValidationError{
+					field:  fmt.Sprintf("RowsSynced[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTopFailingJobs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAccountDashboardResponseValidationError{
+						field:  fmt.Sprintf("TopFailingJobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAccountDashboardResponseValidationError{
+						field:  fmt.Sprintf("TopFailingJobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAccountDashboardResponseValidationError{
+					field:  fmt.Sprintf("TopFailingJobs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetUpcomingRuns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAccountDashboardResponseValidationError{
+						field:  fmt.Sprintf("UpcomingRuns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAccountDashboardResponseValidationError{
+						field:  fmt.Sprintf("UpcomingRuns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAccountDashboardResponseValidationError{
+					field:  fmt.Sprintf("UpcomingRuns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetAccountDashboardResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAccountDashboardResponseMultiError is an error wrapping multiple
+// validation errors returned by GetAccountDashboardResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetAccountDashboardResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccountDashboardResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccountDashboardResponseMultiError) AllErrors() []error { return m }
+
+// GetAccountDashboardResponseValidationError is the validation error returned
+// by GetAccountDashboardResponse.Validate if the designated constraints
+// aren't met.
+type GetAccountDashboardResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccountDashboardResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccountDashboardResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccountDashboardResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccountDashboardResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccountDashboardResponseValidationError) ErrorName() string {
+	return "GetAccountDashboardResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAccountDashboardResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccountDashboardResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccountDashboardResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccountDashboardResponseValidationError{}
+
+// Validate checks the field values on DashboardFailingJob with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DashboardFailingJob) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DashboardFailingJob with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DashboardFailingJobMultiError, or nil if none found.
+func (m *DashboardFailingJob) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DashboardFailingJob) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobId
+
+	// no validation rules for JobName
+
+	// no validation rules for FailedRuns
+
+	// no validation rules for TotalRuns
+
+	if all {
+		switch v := interface{}(m.GetLastFailedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DashboardFailingJobValidationError{
+					field:  "LastFailedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DashboardFailingJobValidationError{
+					field:  "LastFailedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastFailedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DashboardFailingJobValidationError{
+				field:  "LastFailedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DashboardFailingJobMultiError(errors)
+	}
+
+	return nil
+}
+
+// DashboardFailingJobMultiError is an error wrapping multiple validation
+// errors returned by DashboardFailingJob.ValidateAll() if the designated
+// constraints aren't met.
+type DashboardFailingJobMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DashboardFailingJobMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DashboardFailingJobMultiError) AllErrors() []error { return m }
+
+// DashboardFailingJobValidationError is the validation error returned by
+// DashboardFailingJob.Validate if the designated constraints aren't met.
+type DashboardFailingJobValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DashboardFailingJobValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DashboardFailingJobValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DashboardFailingJobValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DashboardFailingJobValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DashboardFailingJobValidationError) ErrorName() string {
+	return "DashboardFailingJobValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DashboardFailingJobValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDashboardFailingJob.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DashboardFailingJobValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DashboardFailingJobValidationError{}
+
+// Validate checks the field values on DashboardUpcomingRun with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DashboardUpcomingRun) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DashboardUpcomingRun with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DashboardUpcomingRunMultiError, or nil if none found.
+func (m *DashboardUpcomingRun) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DashboardUpcomingRun) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobId
+
+	// no validation rules for JobName
+
+	if all {
+		switch v := interface{}(m.GetNextRunTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DashboardUpcomingRunValidationError{
+					field:  "NextRunTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DashboardUpcomingRunValidationError{
+					field:  "NextRunTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextRunTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DashboardUpcomingRunValidationError{
+				field:  "NextRunTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DashboardUpcomingRunMultiError(errors)
+	}
+
+	return nil
+}
+
+// DashboardUpcomingRunMultiError is an error wrapping multiple validation
+// errors returned by DashboardUpcomingRun.ValidateAll() if the designated
+// constraints aren't met.
+type DashboardUpcomingRunMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DashboardUpcomingRunMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DashboardUpcomingRunMultiError) AllErrors() []error { return m }
+
+// DashboardUpcomingRunValidationError is the validation error returned by
+// DashboardUpcomingRun.Validate if the designated constraints aren't met.
+type DashboardUpcomingRunValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DashboardUpcomingRunValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DashboardUpcomingRunValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DashboardUpcomingRunValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DashboardUpcomingRunValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DashboardUpcomingRunValidationError) ErrorName() string {
+	return "DashboardUpcomingRunValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DashboardUpcomingRunValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDashboardUpcomingRun.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DashboardUpcomingRunValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DashboardUpcomingRunValidationError{}
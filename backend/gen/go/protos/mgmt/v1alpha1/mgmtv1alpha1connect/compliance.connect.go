@@ -0,0 +1,307 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: mgmt/v1alpha1/compliance.proto
+
+package mgmtv1alpha1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ComplianceServiceName is the fully-qualified name of the ComplianceService service.
+	ComplianceServiceName = "mgmt.v1alpha1.ComplianceService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ComplianceServiceGenerateComplianceReportProcedure is the fully-qualified name of the
+	// ComplianceService's GenerateComplianceReport RPC.
+	ComplianceServiceGenerateComplianceReportProcedure = "/mgmt.v1alpha1.ComplianceService/GenerateComplianceReport"
+	// ComplianceServiceGetColumnTagsProcedure is the fully-qualified name of the ComplianceService's
+	// GetColumnTags RPC.
+	ComplianceServiceGetColumnTagsProcedure = "/mgmt.v1alpha1.ComplianceService/GetColumnTags"
+	// ComplianceServiceSetColumnTagProcedure is the fully-qualified name of the ComplianceService's
+	// SetColumnTag RPC.
+	ComplianceServiceSetColumnTagProcedure = "/mgmt.v1alpha1.ComplianceService/SetColumnTag"
+	// ComplianceServiceDeleteColumnTagProcedure is the fully-qualified name of the ComplianceService's
+	// DeleteColumnTag RPC.
+	ComplianceServiceDeleteColumnTagProcedure = "/mgmt.v1alpha1.ComplianceService/DeleteColumnTag"
+	// ComplianceServiceScanColumnTagsProcedure is the fully-qualified name of the ComplianceService's
+	// ScanColumnTags RPC.
+	ComplianceServiceScanColumnTagsProcedure = "/mgmt.v1alpha1.ComplianceService/ScanColumnTags"
+	// ComplianceServiceEvaluatePrivacyRiskProcedure is the fully-qualified name of the
+	// ComplianceService's EvaluatePrivacyRisk RPC.
+	ComplianceServiceEvaluatePrivacyRiskProcedure = "/mgmt.v1alpha1.ComplianceService/EvaluatePrivacyRisk"
+	// ComplianceServiceImportSchemaHintsProcedure is the fully-qualified name of the
+	// ComplianceService's ImportSchemaHints RPC.
+	ComplianceServiceImportSchemaHintsProcedure = "/mgmt.v1alpha1.ComplianceService/ImportSchemaHints"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	complianceServiceServiceDescriptor                        = v1alpha1.File_mgmt_v1alpha1_compliance_proto.Services().ByName("ComplianceService")
+	complianceServiceGenerateComplianceReportMethodDescriptor = complianceServiceServiceDescriptor.Methods().ByName("GenerateComplianceReport")
+	complianceServiceGetColumnTagsMethodDescriptor            = complianceServiceServiceDescriptor.Methods().ByName("GetColumnTags")
+	complianceServiceSetColumnTagMethodDescriptor             = complianceServiceServiceDescriptor.Methods().ByName("SetColumnTag")
+	complianceServiceDeleteColumnTagMethodDescriptor          = complianceServiceServiceDescriptor.Methods().ByName("DeleteColumnTag")
+	complianceServiceScanColumnTagsMethodDescriptor           = complianceServiceServiceDescriptor.Methods().ByName("ScanColumnTags")
+	complianceServiceEvaluatePrivacyRiskMethodDescriptor      = complianceServiceServiceDescriptor.Methods().ByName("EvaluatePrivacyRisk")
+	complianceServiceImportSchemaHintsMethodDescriptor        = complianceServiceServiceDescriptor.Methods().ByName("ImportSchemaHints")
+)
+
+// ComplianceServiceClient is a client for the mgmt.v1alpha1.ComplianceService service.
+type ComplianceServiceClient interface {
+	// Generates a signed report for a job or run detailing what sensitive data was found and how it was transformed
+	GenerateComplianceReport(context.Context, *connect.Request[v1alpha1.GenerateComplianceReportRequest]) (*connect.Response[v1alpha1.GenerateComplianceReportResponse], error)
+	// Returns the column tags that have been set for a connection
+	GetColumnTags(context.Context, *connect.Request[v1alpha1.GetColumnTagsRequest]) (*connect.Response[v1alpha1.GetColumnTagsResponse], error)
+	// Creates or overwrites the tag for a single column
+	SetColumnTag(context.Context, *connect.Request[v1alpha1.SetColumnTagRequest]) (*connect.Response[v1alpha1.SetColumnTagResponse], error)
+	DeleteColumnTag(context.Context, *connect.Request[v1alpha1.DeleteColumnTagRequest]) (*connect.Response[v1alpha1.DeleteColumnTagResponse], error)
+	// Scans the connection's schema and tags any columns that look sensitive. Tags that were set by users are left untouched
+	ScanColumnTags(context.Context, *connect.Request[v1alpha1.ScanColumnTagsRequest]) (*connect.Response[v1alpha1.ScanColumnTagsResponse], error)
+	// Computes k-anonymity and l-diversity of a table in the masked output of a run and flags equivalence classes that are at risk of re-identification
+	EvaluatePrivacyRisk(context.Context, *connect.Request[v1alpha1.EvaluatePrivacyRiskRequest]) (*connect.Response[v1alpha1.EvaluatePrivacyRiskResponse], error)
+	// Imports column descriptions, tests, accepted values and foreign keys from a dbt manifest, Prisma schema or Rails schema.
+	// Columns whose classifications are declared by the file are tagged unless the import is a dry run. Tags that were set by users are left untouched
+	ImportSchemaHints(context.Context, *connect.Request[v1alpha1.ImportSchemaHintsRequest]) (*connect.Response[v1alpha1.ImportSchemaHintsResponse], error)
+}
+
+// NewComplianceServiceClient constructs a client for the mgmt.v1alpha1.ComplianceService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewComplianceServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ComplianceServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &complianceServiceClient{
+		generateComplianceReport: connect.NewClient[v1alpha1.GenerateComplianceReportRequest, v1alpha1.GenerateComplianceReportResponse](
+			httpClient,
+			baseURL+ComplianceServiceGenerateComplianceReportProcedure,
+			connect.WithSchema(complianceServiceGenerateComplianceReportMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getColumnTags: connect.NewClient[v1alpha1.GetColumnTagsRequest, v1alpha1.GetColumnTagsResponse](
+			httpClient,
+			baseURL+ComplianceServiceGetColumnTagsProcedure,
+			connect.WithSchema(complianceServiceGetColumnTagsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setColumnTag: connect.NewClient[v1alpha1.SetColumnTagRequest, v1alpha1.SetColumnTagResponse](
+			httpClient,
+			baseURL+ComplianceServiceSetColumnTagProcedure,
+			connect.WithSchema(complianceServiceSetColumnTagMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteColumnTag: connect.NewClient[v1alpha1.DeleteColumnTagRequest, v1alpha1.DeleteColumnTagResponse](
+			httpClient,
+			baseURL+ComplianceServiceDeleteColumnTagProcedure,
+			connect.WithSchema(complianceServiceDeleteColumnTagMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		scanColumnTags: connect.NewClient[v1alpha1.ScanColumnTagsRequest, v1alpha1.ScanColumnTagsResponse](
+			httpClient,
+			baseURL+ComplianceServiceScanColumnTagsProcedure,
+			connect.WithSchema(complianceServiceScanColumnTagsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		evaluatePrivacyRisk: connect.NewClient[v1alpha1.EvaluatePrivacyRiskRequest, v1alpha1.EvaluatePrivacyRiskResponse](
+			httpClient,
+			baseURL+ComplianceServiceEvaluatePrivacyRiskProcedure,
+			connect.WithSchema(complianceServiceEvaluatePrivacyRiskMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		importSchemaHints: connect.NewClient[v1alpha1.ImportSchemaHintsRequest, v1alpha1.ImportSchemaHintsResponse](
+			httpClient,
+			baseURL+ComplianceServiceImportSchemaHintsProcedure,
+			connect.WithSchema(complianceServiceImportSchemaHintsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// complianceServiceClient implements ComplianceServiceClient.
+type complianceServiceClient struct {
+	generateComplianceReport *connect.Client[v1alpha1.GenerateComplianceReportRequest, v1alpha1.GenerateComplianceReportResponse]
+	getColumnTags            *connect.Client[v1alpha1.GetColumnTagsRequest, v1alpha1.GetColumnTagsResponse]
+	setColumnTag             *connect.Client[v1alpha1.SetColumnTagRequest, v1alpha1.SetColumnTagResponse]
+	deleteColumnTag          *connect.Client[v1alpha1.DeleteColumnTagRequest, v1alpha1.DeleteColumnTagResponse]
+	scanColumnTags           *connect.Client[v1alpha1.ScanColumnTagsRequest, v1alpha1.ScanColumnTagsResponse]
+	evaluatePrivacyRisk      *connect.Client[v1alpha1.EvaluatePrivacyRiskRequest, v1alpha1.EvaluatePrivacyRiskResponse]
+	importSchemaHints        *connect.Client[v1alpha1.ImportSchemaHintsRequest, v1alpha1.ImportSchemaHintsResponse]
+}
+
+// GenerateComplianceReport calls mgmt.v1alpha1.ComplianceService.GenerateComplianceReport.
+func (c *complianceServiceClient) GenerateComplianceReport(ctx context.Context, req *connect.Request[v1alpha1.GenerateComplianceReportRequest]) (*connect.Response[v1alpha1.GenerateComplianceReportResponse], error) {
+	return c.generateComplianceReport.CallUnary(ctx, req)
+}
+
+// GetColumnTags calls mgmt.v1alpha1.ComplianceService.GetColumnTags.
+func (c *complianceServiceClient) GetColumnTags(ctx context.Context, req *connect.Request[v1alpha1.GetColumnTagsRequest]) (*connect.Response[v1alpha1.GetColumnTagsResponse], error) {
+	return c.getColumnTags.CallUnary(ctx, req)
+}
+
+// SetColumnTag calls mgmt.v1alpha1.ComplianceService.SetColumnTag.
+func (c *complianceServiceClient) SetColumnTag(ctx context.Context, req *connect.Request[v1alpha1.SetColumnTagRequest]) (*connect.Response[v1alpha1.SetColumnTagResponse], error) {
+	return c.setColumnTag.CallUnary(ctx, req)
+}
+
+// DeleteColumnTag calls mgmt.v1alpha1.ComplianceService.DeleteColumnTag.
+func (c *complianceServiceClient) DeleteColumnTag(ctx context.Context, req *connect.Request[v1alpha1.DeleteColumnTagRequest]) (*connect.Response[v1alpha1.DeleteColumnTagResponse], error) {
+	return c.deleteColumnTag.CallUnary(ctx, req)
+}
+
+// ScanColumnTags calls mgmt.v1alpha1.ComplianceService.ScanColumnTags.
+func (c *complianceServiceClient) ScanColumnTags(ctx context.Context, req *connect.Request[v1alpha1.ScanColumnTagsRequest]) (*connect.Response[v1alpha1.ScanColumnTagsResponse], error) {
+	return c.scanColumnTags.CallUnary(ctx, req)
+}
+
+// EvaluatePrivacyRisk calls mgmt.v1alpha1.ComplianceService.EvaluatePrivacyRisk.
+func (c *complianceServiceClient) EvaluatePrivacyRisk(ctx context.Context, req *connect.Request[v1alpha1.EvaluatePrivacyRiskRequest]) (*connect.Response[v1alpha1.EvaluatePrivacyRiskResponse], error) {
+	return c.evaluatePrivacyRisk.CallUnary(ctx, req)
+}
+
+// ImportSchemaHints calls mgmt.v1alpha1.ComplianceService.ImportSchemaHints.
+func (c *complianceServiceClient) ImportSchemaHints(ctx context.Context, req *connect.Request[v1alpha1.ImportSchemaHintsRequest]) (*connect.Response[v1alpha1.ImportSchemaHintsResponse], error) {
+	return c.importSchemaHints.CallUnary(ctx, req)
+}
+
+// ComplianceServiceHandler is an implementation of the mgmt.v1alpha1.ComplianceService service.
+type ComplianceServiceHandler interface {
+	// Generates a signed report for a job or run detailing what sensitive data was found and how it was transformed
+	GenerateComplianceReport(context.Context, *connect.Request[v1alpha1.GenerateComplianceReportRequest]) (*connect.Response[v1alpha1.GenerateComplianceReportResponse], error)
+	// Returns the column tags that have been set for a connection
+	GetColumnTags(context.Context, *connect.Request[v1alpha1.GetColumnTagsRequest]) (*connect.Response[v1alpha1.GetColumnTagsResponse], error)
+	// Creates or overwrites the tag for a single column
+	SetColumnTag(context.Context, *connect.Request[v1alpha1.SetColumnTagRequest]) (*connect.Response[v1alpha1.SetColumnTagResponse], error)
+	DeleteColumnTag(context.Context, *connect.Request[v1alpha1.DeleteColumnTagRequest]) (*connect.Response[v1alpha1.DeleteColumnTagResponse], error)
+	// Scans the connection's schema and tags any columns that look sensitive. Tags that were set by users are left untouched
+	ScanColumnTags(context.Context, *connect.Request[v1alpha1.ScanColumnTagsRequest]) (*connect.Response[v1alpha1.ScanColumnTagsResponse], error)
+	// Computes k-anonymity and l-diversity of a table in the masked output of a run and flags equivalence classes that are at risk of re-identification
+	EvaluatePrivacyRisk(context.Context, *connect.Request[v1alpha1.EvaluatePrivacyRiskRequest]) (*connect.Response[v1alpha1.EvaluatePrivacyRiskResponse], error)
+	// Imports column descriptions, tests, accepted values and foreign keys from a dbt manifest, Prisma schema or Rails schema.
+	// Columns whose classifications are declared by the file are tagged unless the import is a dry run. Tags that were set by users are left untouched
+	ImportSchemaHints(context.Context, *connect.Request[v1alpha1.ImportSchemaHintsRequest]) (*connect.Response[v1alpha1.ImportSchemaHintsResponse], error)
+}
+
+// NewComplianceServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewComplianceServiceHandler(svc ComplianceServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	complianceServiceGenerateComplianceReportHandler := connect.NewUnaryHandler(
+		ComplianceServiceGenerateComplianceReportProcedure,
+		svc.GenerateComplianceReport,
+		connect.WithSchema(complianceServiceGenerateComplianceReportMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	complianceServiceGetColumnTagsHandler := connect.NewUnaryHandler(
+		ComplianceServiceGetColumnTagsProcedure,
+		svc.GetColumnTags,
+		connect.WithSchema(complianceServiceGetColumnTagsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	complianceServiceSetColumnTagHandler := connect.NewUnaryHandler(
+		ComplianceServiceSetColumnTagProcedure,
+		svc.SetColumnTag,
+		connect.WithSchema(complianceServiceSetColumnTagMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	complianceServiceDeleteColumnTagHandler := connect.NewUnaryHandler(
+		ComplianceServiceDeleteColumnTagProcedure,
+		svc.DeleteColumnTag,
+		connect.WithSchema(complianceServiceDeleteColumnTagMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	complianceServiceScanColumnTagsHandler := connect.NewUnaryHandler(
+		ComplianceServiceScanColumnTagsProcedure,
+		svc.ScanColumnTags,
+		connect.WithSchema(complianceServiceScanColumnTagsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	complianceServiceEvaluatePrivacyRiskHandler := connect.NewUnaryHandler(
+		ComplianceServiceEvaluatePrivacyRiskProcedure,
+		svc.EvaluatePrivacyRisk,
+		connect.WithSchema(complianceServiceEvaluatePrivacyRiskMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	complianceServiceImportSchemaHintsHandler := connect.NewUnaryHandler(
+		ComplianceServiceImportSchemaHintsProcedure,
+		svc.ImportSchemaHints,
+		connect.WithSchema(complianceServiceImportSchemaHintsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/mgmt.v1alpha1.ComplianceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ComplianceServiceGenerateComplianceReportProcedure:
+			complianceServiceGenerateComplianceReportHandler.ServeHTTP(w, r)
+		case ComplianceServiceGetColumnTagsProcedure:
+			complianceServiceGetColumnTagsHandler.ServeHTTP(w, r)
+		case ComplianceServiceSetColumnTagProcedure:
+			complianceServiceSetColumnTagHandler.ServeHTTP(w, r)
+		case ComplianceServiceDeleteColumnTagProcedure:
+			complianceServiceDeleteColumnTagHandler.ServeHTTP(w, r)
+		case ComplianceServiceScanColumnTagsProcedure:
+			complianceServiceScanColumnTagsHandler.ServeHTTP(w, r)
+		case ComplianceServiceEvaluatePrivacyRiskProcedure:
+			complianceServiceEvaluatePrivacyRiskHandler.ServeHTTP(w, r)
+		case ComplianceServiceImportSchemaHintsProcedure:
+			complianceServiceImportSchemaHintsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedComplianceServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedComplianceServiceHandler struct{}
+
+func (UnimplementedComplianceServiceHandler) GenerateComplianceReport(context.Context, *connect.Request[v1alpha1.GenerateComplianceReportRequest]) (*connect.Response[v1alpha1.GenerateComplianceReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ComplianceService.GenerateComplianceReport is not implemented"))
+}
+
+func (UnimplementedComplianceServiceHandler) GetColumnTags(context.Context, *connect.Request[v1alpha1.GetColumnTagsRequest]) (*connect.Response[v1alpha1.GetColumnTagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ComplianceService.GetColumnTags is not implemented"))
+}
+
+func (UnimplementedComplianceServiceHandler) SetColumnTag(context.Context, *connect.Request[v1alpha1.SetColumnTagRequest]) (*connect.Response[v1alpha1.SetColumnTagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ComplianceService.SetColumnTag is not implemented"))
+}
+
+func (UnimplementedComplianceServiceHandler) DeleteColumnTag(context.Context, *connect.Request[v1alpha1.DeleteColumnTagRequest]) (*connect.Response[v1alpha1.DeleteColumnTagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ComplianceService.DeleteColumnTag is not implemented"))
+}
+
+func (UnimplementedComplianceServiceHandler) ScanColumnTags(context.Context, *connect.Request[v1alpha1.ScanColumnTagsRequest]) (*connect.Response[v1alpha1.ScanColumnTagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ComplianceService.ScanColumnTags is not implemented"))
+}
+
+func (UnimplementedComplianceServiceHandler) EvaluatePrivacyRisk(context.Context, *connect.Request[v1alpha1.EvaluatePrivacyRiskRequest]) (*connect.Response[v1alpha1.EvaluatePrivacyRiskResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ComplianceService.EvaluatePrivacyRisk is not implemented"))
+}
+
+func (UnimplementedComplianceServiceHandler) ImportSchemaHints(context.Context, *connect.Request[v1alpha1.ImportSchemaHintsRequest]) (*connect.Response[v1alpha1.ImportSchemaHintsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ComplianceService.ImportSchemaHints is not implemented"))
+}
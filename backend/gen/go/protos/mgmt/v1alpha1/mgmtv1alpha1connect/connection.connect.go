@@ -42,6 +42,9 @@ const (
 	// ConnectionServiceCreateConnectionProcedure is the fully-qualified name of the ConnectionService's
 	// CreateConnection RPC.
 	ConnectionServiceCreateConnectionProcedure = "/mgmt.v1alpha1.ConnectionService/CreateConnection"
+	// ConnectionServiceBulkCreateConnectionsProcedure is the fully-qualified name of the
+	// ConnectionService's BulkCreateConnections RPC.
+	ConnectionServiceBulkCreateConnectionsProcedure = "/mgmt.v1alpha1.ConnectionService/BulkCreateConnections"
 	// ConnectionServiceUpdateConnectionProcedure is the fully-qualified name of the ConnectionService's
 	// UpdateConnection RPC.
 	ConnectionServiceUpdateConnectionProcedure = "/mgmt.v1alpha1.ConnectionService/UpdateConnection"
@@ -51,6 +54,30 @@ const (
 	// ConnectionServiceIsConnectionNameAvailableProcedure is the fully-qualified name of the
 	// ConnectionService's IsConnectionNameAvailable RPC.
 	ConnectionServiceIsConnectionNameAvailableProcedure = "/mgmt.v1alpha1.ConnectionService/IsConnectionNameAvailable"
+	// ConnectionServiceSetConnectionLabelsProcedure is the fully-qualified name of the
+	// ConnectionService's SetConnectionLabels RPC.
+	ConnectionServiceSetConnectionLabelsProcedure = "/mgmt.v1alpha1.ConnectionService/SetConnectionLabels"
+	// ConnectionServiceGetConnectionSavedQueriesProcedure is the fully-qualified name of the
+	// ConnectionService's GetConnectionSavedQueries RPC.
+	ConnectionServiceGetConnectionSavedQueriesProcedure = "/mgmt.v1alpha1.ConnectionService/GetConnectionSavedQueries"
+	// ConnectionServiceCreateConnectionSavedQueryProcedure is the fully-qualified name of the
+	// ConnectionService's CreateConnectionSavedQuery RPC.
+	ConnectionServiceCreateConnectionSavedQueryProcedure = "/mgmt.v1alpha1.ConnectionService/CreateConnectionSavedQuery"
+	// ConnectionServiceUpdateConnectionSavedQueryProcedure is the fully-qualified name of the
+	// ConnectionService's UpdateConnectionSavedQuery RPC.
+	ConnectionServiceUpdateConnectionSavedQueryProcedure = "/mgmt.v1alpha1.ConnectionService/UpdateConnectionSavedQuery"
+	// ConnectionServiceDeleteConnectionSavedQueryProcedure is the fully-qualified name of the
+	// ConnectionService's DeleteConnectionSavedQuery RPC.
+	ConnectionServiceDeleteConnectionSavedQueryProcedure = "/mgmt.v1alpha1.ConnectionService/DeleteConnectionSavedQuery"
+	// ConnectionServiceGetConnectionTableBookmarksProcedure is the fully-qualified name of the
+	// ConnectionService's GetConnectionTableBookmarks RPC.
+	ConnectionServiceGetConnectionTableBookmarksProcedure = "/mgmt.v1alpha1.ConnectionService/GetConnectionTableBookmarks"
+	// ConnectionServiceCreateConnectionTableBookmarkProcedure is the fully-qualified name of the
+	// ConnectionService's CreateConnectionTableBookmark RPC.
+	ConnectionServiceCreateConnectionTableBookmarkProcedure = "/mgmt.v1alpha1.ConnectionService/CreateConnectionTableBookmark"
+	// ConnectionServiceDeleteConnectionTableBookmarkProcedure is the fully-qualified name of the
+	// ConnectionService's DeleteConnectionTableBookmark RPC.
+	ConnectionServiceDeleteConnectionTableBookmarkProcedure = "/mgmt.v1alpha1.ConnectionService/DeleteConnectionTableBookmark"
 	// ConnectionServiceCheckConnectionConfigProcedure is the fully-qualified name of the
 	// ConnectionService's CheckConnectionConfig RPC.
 	ConnectionServiceCheckConnectionConfigProcedure = "/mgmt.v1alpha1.ConnectionService/CheckConnectionConfig"
@@ -61,15 +88,24 @@ const (
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	connectionServiceServiceDescriptor                         = v1alpha1.File_mgmt_v1alpha1_connection_proto.Services().ByName("ConnectionService")
-	connectionServiceGetConnectionsMethodDescriptor            = connectionServiceServiceDescriptor.Methods().ByName("GetConnections")
-	connectionServiceGetConnectionMethodDescriptor             = connectionServiceServiceDescriptor.Methods().ByName("GetConnection")
-	connectionServiceCreateConnectionMethodDescriptor          = connectionServiceServiceDescriptor.Methods().ByName("CreateConnection")
-	connectionServiceUpdateConnectionMethodDescriptor          = connectionServiceServiceDescriptor.Methods().ByName("UpdateConnection")
-	connectionServiceDeleteConnectionMethodDescriptor          = connectionServiceServiceDescriptor.Methods().ByName("DeleteConnection")
-	connectionServiceIsConnectionNameAvailableMethodDescriptor = connectionServiceServiceDescriptor.Methods().ByName("IsConnectionNameAvailable")
-	connectionServiceCheckConnectionConfigMethodDescriptor     = connectionServiceServiceDescriptor.Methods().ByName("CheckConnectionConfig")
-	connectionServiceCheckSqlQueryMethodDescriptor             = connectionServiceServiceDescriptor.Methods().ByName("CheckSqlQuery")
+	connectionServiceServiceDescriptor                             = v1alpha1.File_mgmt_v1alpha1_connection_proto.Services().ByName("ConnectionService")
+	connectionServiceGetConnectionsMethodDescriptor                = connectionServiceServiceDescriptor.Methods().ByName("GetConnections")
+	connectionServiceGetConnectionMethodDescriptor                 = connectionServiceServiceDescriptor.Methods().ByName("GetConnection")
+	connectionServiceCreateConnectionMethodDescriptor              = connectionServiceServiceDescriptor.Methods().ByName("CreateConnection")
+	connectionServiceBulkCreateConnectionsMethodDescriptor         = connectionServiceServiceDescriptor.Methods().ByName("BulkCreateConnections")
+	connectionServiceUpdateConnectionMethodDescriptor              = connectionServiceServiceDescriptor.Methods().ByName("UpdateConnection")
+	connectionServiceDeleteConnectionMethodDescriptor              = connectionServiceServiceDescriptor.Methods().ByName("DeleteConnection")
+	connectionServiceIsConnectionNameAvailableMethodDescriptor     = connectionServiceServiceDescriptor.Methods().ByName("IsConnectionNameAvailable")
+	connectionServiceSetConnectionLabelsMethodDescriptor           = connectionServiceServiceDescriptor.Methods().ByName("SetConnectionLabels")
+	connectionServiceGetConnectionSavedQueriesMethodDescriptor     = connectionServiceServiceDescriptor.Methods().ByName("GetConnectionSavedQueries")
+	connectionServiceCreateConnectionSavedQueryMethodDescriptor    = connectionServiceServiceDescriptor.Methods().ByName("CreateConnectionSavedQuery")
+	connectionServiceUpdateConnectionSavedQueryMethodDescriptor    = connectionServiceServiceDescriptor.Methods().ByName("UpdateConnectionSavedQuery")
+	connectionServiceDeleteConnectionSavedQueryMethodDescriptor    = connectionServiceServiceDescriptor.Methods().ByName("DeleteConnectionSavedQuery")
+	connectionServiceGetConnectionTableBookmarksMethodDescriptor   = connectionServiceServiceDescriptor.Methods().ByName("GetConnectionTableBookmarks")
+	connectionServiceCreateConnectionTableBookmarkMethodDescriptor = connectionServiceServiceDescriptor.Methods().ByName("CreateConnectionTableBookmark")
+	connectionServiceDeleteConnectionTableBookmarkMethodDescriptor = connectionServiceServiceDescriptor.Methods().ByName("DeleteConnectionTableBookmark")
+	connectionServiceCheckConnectionConfigMethodDescriptor         = connectionServiceServiceDescriptor.Methods().ByName("CheckConnectionConfig")
+	connectionServiceCheckSqlQueryMethodDescriptor                 = connectionServiceServiceDescriptor.Methods().ByName("CheckSqlQuery")
 )
 
 // ConnectionServiceClient is a client for the mgmt.v1alpha1.ConnectionService service.
@@ -80,12 +116,30 @@ type ConnectionServiceClient interface {
 	GetConnection(context.Context, *connect.Request[v1alpha1.GetConnectionRequest]) (*connect.Response[v1alpha1.GetConnectionResponse], error)
 	// Creates a new connection
 	CreateConnection(context.Context, *connect.Request[v1alpha1.CreateConnectionRequest]) (*connect.Response[v1alpha1.CreateConnectionResponse], error)
+	// Creates many connections in a single transaction. If any connection fails to be created, none of them are
+	BulkCreateConnections(context.Context, *connect.Request[v1alpha1.BulkCreateConnectionsRequest]) (*connect.Response[v1alpha1.BulkCreateConnectionsResponse], error)
 	// Updates an existing connection
 	UpdateConnection(context.Context, *connect.Request[v1alpha1.UpdateConnectionRequest]) (*connect.Response[v1alpha1.UpdateConnectionResponse], error)
 	// Removes a connection from the system.
 	DeleteConnection(context.Context, *connect.Request[v1alpha1.DeleteConnectionRequest]) (*connect.Response[v1alpha1.DeleteConnectionResponse], error)
 	// Connections have friendly names, this method checks if the requested name is available in the system based on the account
 	IsConnectionNameAvailable(context.Context, *connect.Request[v1alpha1.IsConnectionNameAvailableRequest]) (*connect.Response[v1alpha1.IsConnectionNameAvailableResponse], error)
+	// Replaces the labels on a connection
+	SetConnectionLabels(context.Context, *connect.Request[v1alpha1.SetConnectionLabelsRequest]) (*connect.Response[v1alpha1.SetConnectionLabelsResponse], error)
+	// Returns the saved table previews of a connection
+	GetConnectionSavedQueries(context.Context, *connect.Request[v1alpha1.GetConnectionSavedQueriesRequest]) (*connect.Response[v1alpha1.GetConnectionSavedQueriesResponse], error)
+	// Saves a table preview so that it can be re-opened later
+	CreateConnectionSavedQuery(context.Context, *connect.Request[v1alpha1.CreateConnectionSavedQueryRequest]) (*connect.Response[v1alpha1.CreateConnectionSavedQueryResponse], error)
+	// Updates a saved table preview
+	UpdateConnectionSavedQuery(context.Context, *connect.Request[v1alpha1.UpdateConnectionSavedQueryRequest]) (*connect.Response[v1alpha1.UpdateConnectionSavedQueryResponse], error)
+	// Removes a saved table preview
+	DeleteConnectionSavedQuery(context.Context, *connect.Request[v1alpha1.DeleteConnectionSavedQueryRequest]) (*connect.Response[v1alpha1.DeleteConnectionSavedQueryResponse], error)
+	// Returns the bookmarked tables of a connection
+	GetConnectionTableBookmarks(context.Context, *connect.Request[v1alpha1.GetConnectionTableBookmarksRequest]) (*connect.Response[v1alpha1.GetConnectionTableBookmarksResponse], error)
+	// Bookmarks a table. Bookmarking a table that has already been bookmarked returns the existing bookmark
+	CreateConnectionTableBookmark(context.Context, *connect.Request[v1alpha1.CreateConnectionTableBookmarkRequest]) (*connect.Response[v1alpha1.CreateConnectionTableBookmarkResponse], error)
+	// Removes a table bookmark
+	DeleteConnectionTableBookmark(context.Context, *connect.Request[v1alpha1.DeleteConnectionTableBookmarkRequest]) (*connect.Response[v1alpha1.DeleteConnectionTableBookmarkResponse], error)
 	// Checks if the connection config is connectable by the backend.
 	// Used mostly to verify that a connection is valid prior to creating a Connection object.
 	CheckConnectionConfig(context.Context, *connect.Request[v1alpha1.CheckConnectionConfigRequest]) (*connect.Response[v1alpha1.CheckConnectionConfigResponse], error)
@@ -122,6 +176,12 @@ func NewConnectionServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(connectionServiceCreateConnectionMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		bulkCreateConnections: connect.NewClient[v1alpha1.BulkCreateConnectionsRequest, v1alpha1.BulkCreateConnectionsResponse](
+			httpClient,
+			baseURL+ConnectionServiceBulkCreateConnectionsProcedure,
+			connect.WithSchema(connectionServiceBulkCreateConnectionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		updateConnection: connect.NewClient[v1alpha1.UpdateConnectionRequest, v1alpha1.UpdateConnectionResponse](
 			httpClient,
 			baseURL+ConnectionServiceUpdateConnectionProcedure,
@@ -140,6 +200,54 @@ func NewConnectionServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(connectionServiceIsConnectionNameAvailableMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		setConnectionLabels: connect.NewClient[v1alpha1.SetConnectionLabelsRequest, v1alpha1.SetConnectionLabelsResponse](
+			httpClient,
+			baseURL+ConnectionServiceSetConnectionLabelsProcedure,
+			connect.WithSchema(connectionServiceSetConnectionLabelsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getConnectionSavedQueries: connect.NewClient[v1alpha1.GetConnectionSavedQueriesRequest, v1alpha1.GetConnectionSavedQueriesResponse](
+			httpClient,
+			baseURL+ConnectionServiceGetConnectionSavedQueriesProcedure,
+			connect.WithSchema(connectionServiceGetConnectionSavedQueriesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createConnectionSavedQuery: connect.NewClient[v1alpha1.CreateConnectionSavedQueryRequest, v1alpha1.CreateConnectionSavedQueryResponse](
+			httpClient,
+			baseURL+ConnectionServiceCreateConnectionSavedQueryProcedure,
+			connect.WithSchema(connectionServiceCreateConnectionSavedQueryMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateConnectionSavedQuery: connect.NewClient[v1alpha1.UpdateConnectionSavedQueryRequest, v1alpha1.UpdateConnectionSavedQueryResponse](
+			httpClient,
+			baseURL+ConnectionServiceUpdateConnectionSavedQueryProcedure,
+			connect.WithSchema(connectionServiceUpdateConnectionSavedQueryMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteConnectionSavedQuery: connect.NewClient[v1alpha1.DeleteConnectionSavedQueryRequest, v1alpha1.DeleteConnectionSavedQueryResponse](
+			httpClient,
+			baseURL+ConnectionServiceDeleteConnectionSavedQueryProcedure,
+			connect.WithSchema(connectionServiceDeleteConnectionSavedQueryMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getConnectionTableBookmarks: connect.NewClient[v1alpha1.GetConnectionTableBookmarksRequest, v1alpha1.GetConnectionTableBookmarksResponse](
+			httpClient,
+			baseURL+ConnectionServiceGetConnectionTableBookmarksProcedure,
+			connect.WithSchema(connectionServiceGetConnectionTableBookmarksMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createConnectionTableBookmark: connect.NewClient[v1alpha1.CreateConnectionTableBookmarkRequest, v1alpha1.CreateConnectionTableBookmarkResponse](
+			httpClient,
+			baseURL+ConnectionServiceCreateConnectionTableBookmarkProcedure,
+			connect.WithSchema(connectionServiceCreateConnectionTableBookmarkMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteConnectionTableBookmark: connect.NewClient[v1alpha1.DeleteConnectionTableBookmarkRequest, v1alpha1.DeleteConnectionTableBookmarkResponse](
+			httpClient,
+			baseURL+ConnectionServiceDeleteConnectionTableBookmarkProcedure,
+			connect.WithSchema(connectionServiceDeleteConnectionTableBookmarkMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		checkConnectionConfig: connect.NewClient[v1alpha1.CheckConnectionConfigRequest, v1alpha1.CheckConnectionConfigResponse](
 			httpClient,
 			baseURL+ConnectionServiceCheckConnectionConfigProcedure,
@@ -157,14 +265,23 @@ func NewConnectionServiceClient(httpClient connect.HTTPClient, baseURL string, o
 
 // connectionServiceClient implements ConnectionServiceClient.
 type connectionServiceClient struct {
-	getConnections            *connect.Client[v1alpha1.GetConnectionsRequest, v1alpha1.GetConnectionsResponse]
-	getConnection             *connect.Client[v1alpha1.GetConnectionRequest, v1alpha1.GetConnectionResponse]
-	createConnection          *connect.Client[v1alpha1.CreateConnectionRequest, v1alpha1.CreateConnectionResponse]
-	updateConnection          *connect.Client[v1alpha1.UpdateConnectionRequest, v1alpha1.UpdateConnectionResponse]
-	deleteConnection          *connect.Client[v1alpha1.DeleteConnectionRequest, v1alpha1.DeleteConnectionResponse]
-	isConnectionNameAvailable *connect.Client[v1alpha1.IsConnectionNameAvailableRequest, v1alpha1.IsConnectionNameAvailableResponse]
-	checkConnectionConfig     *connect.Client[v1alpha1.CheckConnectionConfigRequest, v1alpha1.CheckConnectionConfigResponse]
-	checkSqlQuery             *connect.Client[v1alpha1.CheckSqlQueryRequest, v1alpha1.CheckSqlQueryResponse]
+	getConnections                *connect.Client[v1alpha1.GetConnectionsRequest, v1alpha1.GetConnectionsResponse]
+	getConnection                 *connect.Client[v1alpha1.GetConnectionRequest, v1alpha1.GetConnectionResponse]
+	createConnection              *connect.Client[v1alpha1.CreateConnectionRequest, v1alpha1.CreateConnectionResponse]
+	bulkCreateConnections         *connect.Client[v1alpha1.BulkCreateConnectionsRequest, v1alpha1.BulkCreateConnectionsResponse]
+	updateConnection              *connect.Client[v1alpha1.UpdateConnectionRequest, v1alpha1.UpdateConnectionResponse]
+	deleteConnection              *connect.Client[v1alpha1.DeleteConnectionRequest, v1alpha1.DeleteConnectionResponse]
+	isConnectionNameAvailable     *connect.Client[v1alpha1.IsConnectionNameAvailableRequest, v1alpha1.IsConnectionNameAvailableResponse]
+	setConnectionLabels           *connect.Client[v1alpha1.SetConnectionLabelsRequest, v1alpha1.SetConnectionLabelsResponse]
+	getConnectionSavedQueries     *connect.Client[v1alpha1.GetConnectionSavedQueriesRequest, v1alpha1.GetConnectionSavedQueriesResponse]
+	createConnectionSavedQuery    *connect.Client[v1alpha1.CreateConnectionSavedQueryRequest, v1alpha1.CreateConnectionSavedQueryResponse]
+	updateConnectionSavedQuery    *connect.Client[v1alpha1.UpdateConnectionSavedQueryRequest, v1alpha1.UpdateConnectionSavedQueryResponse]
+	deleteConnectionSavedQuery    *connect.Client[v1alpha1.DeleteConnectionSavedQueryRequest, v1alpha1.DeleteConnectionSavedQueryResponse]
+	getConnectionTableBookmarks   *connect.Client[v1alpha1.GetConnectionTableBookmarksRequest, v1alpha1.GetConnectionTableBookmarksResponse]
+	createConnectionTableBookmark *connect.Client[v1alpha1.CreateConnectionTableBookmarkRequest, v1alpha1.CreateConnectionTableBookmarkResponse]
+	deleteConnectionTableBookmark *connect.Client[v1alpha1.DeleteConnectionTableBookmarkRequest, v1alpha1.DeleteConnectionTableBookmarkResponse]
+	checkConnectionConfig         *connect.Client[v1alpha1.CheckConnectionConfigRequest, v1alpha1.CheckConnectionConfigResponse]
+	checkSqlQuery                 *connect.Client[v1alpha1.CheckSqlQueryRequest, v1alpha1.CheckSqlQueryResponse]
 }
 
 // GetConnections calls mgmt.v1alpha1.ConnectionService.GetConnections.
@@ -182,6 +299,11 @@ func (c *connectionServiceClient) CreateConnection(ctx context.Context, req *con
 	return c.createConnection.CallUnary(ctx, req)
 }
 
+// BulkCreateConnections calls mgmt.v1alpha1.ConnectionService.BulkCreateConnections.
+func (c *connectionServiceClient) BulkCreateConnections(ctx context.Context, req *connect.Request[v1alpha1.BulkCreateConnectionsRequest]) (*connect.Response[v1alpha1.BulkCreateConnectionsResponse], error) {
+	return c.bulkCreateConnections.CallUnary(ctx, req)
+}
+
 // UpdateConnection calls mgmt.v1alpha1.ConnectionService.UpdateConnection.
 func (c *connectionServiceClient) UpdateConnection(ctx context.Context, req *connect.Request[v1alpha1.UpdateConnectionRequest]) (*connect.Response[v1alpha1.UpdateConnectionResponse], error) {
 	return c.updateConnection.CallUnary(ctx, req)
@@ -197,6 +319,48 @@ func (c *connectionServiceClient) IsConnectionNameAvailable(ctx context.Context,
 	return c.isConnectionNameAvailable.CallUnary(ctx, req)
 }
 
+// SetConnectionLabels calls mgmt.v1alpha1.ConnectionService.SetConnectionLabels.
+func (c *connectionServiceClient) SetConnectionLabels(ctx context.Context, req *connect.Request[v1alpha1.SetConnectionLabelsRequest]) (*connect.Response[v1alpha1.SetConnectionLabelsResponse], error) {
+	return c.setConnectionLabels.CallUnary(ctx, req)
+}
+
+// GetConnectionSavedQueries calls mgmt.v1alpha1.ConnectionService.GetConnectionSavedQueries.
+func (c *connectionServiceClient) GetConnectionSavedQueries(ctx context.Context, req *connect.Request[v1alpha1.GetConnectionSavedQueriesRequest]) (*connect.Response[v1alpha1.GetConnectionSavedQueriesResponse], error) {
+	return c.getConnectionSavedQueries.CallUnary(ctx, req)
+}
+
+// CreateConnectionSavedQuery calls mgmt.v1alpha1.ConnectionService.CreateConnectionSavedQuery.
+func (c *connectionServiceClient) CreateConnectionSavedQuery(ctx context.Context, req *connect.Request[v1alpha1.CreateConnectionSavedQueryRequest]) (*connect.Response[v1alpha1.CreateConnectionSavedQueryResponse], error) {
+	return c.createConnectionSavedQuery.CallUnary(ctx, req)
+}
+
+// UpdateConnectionSavedQuery calls mgmt.v1alpha1.ConnectionService.UpdateConnectionSavedQuery.
+func (c *connectionServiceClient) UpdateConnectionSavedQuery(ctx context.Context, req *connect.Request[v1alpha1.UpdateConnectionSavedQueryRequest]) (*connect.Response[v1alpha1.UpdateConnectionSavedQueryResponse], error) {
+	return c.updateConnectionSavedQuery.CallUnary(ctx, req)
+}
+
+// DeleteConnectionSavedQuery calls mgmt.v1alpha1.ConnectionService.DeleteConnectionSavedQuery.
+func (c *connectionServiceClient) DeleteConnectionSavedQuery(ctx context.Context, req *connect.Request[v1alpha1.DeleteConnectionSavedQueryRequest]) (*connect.Response[v1alpha1.DeleteConnectionSavedQueryResponse], error) {
+	return c.deleteConnectionSavedQuery.CallUnary(ctx, req)
+}
+
+// GetConnectionTableBookmarks calls mgmt.v1alpha1.ConnectionService.GetConnectionTableBookmarks.
+func (c *connectionServiceClient) GetConnectionTableBookmarks(ctx context.Context, req *connect.Request[v1alpha1.GetConnectionTableBookmarksRequest]) (*connect.Response[v1alpha1.GetConnectionTableBookmarksResponse], error) {
+	return c.getConnectionTableBookmarks.CallUnary(ctx, req)
+}
+
+// CreateConnectionTableBookmark calls
+// mgmt.v1alpha1.ConnectionService.CreateConnectionTableBookmark.
+func (c *connectionServiceClient) CreateConnectionTableBookmark(ctx context.Context, req *connect.Request[v1alpha1.CreateConnectionTableBookmarkRequest]) (*connect.Response[v1alpha1.CreateConnectionTableBookmarkResponse], error) {
+	return c.createConnectionTableBookmark.CallUnary(ctx, req)
+}
+
+// DeleteConnectionTableBookmark calls
+// mgmt.v1alpha1.ConnectionService.DeleteConnectionTableBookmark.
+func (c *connectionServiceClient) DeleteConnectionTableBookmark(ctx context.Context, req *connect.Request[v1alpha1.DeleteConnectionTableBookmarkRequest]) (*connect.Response[v1alpha1.DeleteConnectionTableBookmarkResponse], error) {
+	return c.deleteConnectionTableBookmark.CallUnary(ctx, req)
+}
+
 // CheckConnectionConfig calls mgmt.v1alpha1.ConnectionService.CheckConnectionConfig.
 func (c *connectionServiceClient) CheckConnectionConfig(ctx context.Context, req *connect.Request[v1alpha1.CheckConnectionConfigRequest]) (*connect.Response[v1alpha1.CheckConnectionConfigResponse], error) {
 	return c.checkConnectionConfig.CallUnary(ctx, req)
@@ -215,12 +379,30 @@ type ConnectionServiceHandler interface {
 	GetConnection(context.Context, *connect.Request[v1alpha1.GetConnectionRequest]) (*connect.Response[v1alpha1.GetConnectionResponse], error)
 	// Creates a new connection
 	CreateConnection(context.Context, *connect.Request[v1alpha1.CreateConnectionRequest]) (*connect.Response[v1alpha1.CreateConnectionResponse], error)
+	// Creates many connections in a single transaction. If any connection fails to be created, none of them are
+	BulkCreateConnections(context.Context, *connect.Request[v1alpha1.BulkCreateConnectionsRequest]) (*connect.Response[v1alpha1.BulkCreateConnectionsResponse], error)
 	// Updates an existing connection
 	UpdateConnection(context.Context, *connect.Request[v1alpha1.UpdateConnectionRequest]) (*connect.Response[v1alpha1.UpdateConnectionResponse], error)
 	// Removes a connection from the system.
 	DeleteConnection(context.Context, *connect.Request[v1alpha1.DeleteConnectionRequest]) (*connect.Response[v1alpha1.DeleteConnectionResponse], error)
 	// Connections have friendly names, this method checks if the requested name is available in the system based on the account
 	IsConnectionNameAvailable(context.Context, *connect.Request[v1alpha1.IsConnectionNameAvailableRequest]) (*connect.Response[v1alpha1.IsConnectionNameAvailableResponse], error)
+	// Replaces the labels on a connection
+	SetConnectionLabels(context.Context, *connect.Request[v1alpha1.SetConnectionLabelsRequest]) (*connect.Response[v1alpha1.SetConnectionLabelsResponse], error)
+	// Returns the saved table previews of a connection
+	GetConnectionSavedQueries(context.Context, *connect.Request[v1alpha1.GetConnectionSavedQueriesRequest]) (*connect.Response[v1alpha1.GetConnectionSavedQueriesResponse], error)
+	// Saves a table preview so that it can be re-opened later
+	CreateConnectionSavedQuery(context.Context, *connect.Request[v1alpha1.CreateConnectionSavedQueryRequest]) (*connect.Response[v1alpha1.CreateConnectionSavedQueryResponse], error)
+	// Updates a saved table preview
+	UpdateConnectionSavedQuery(context.Context, *connect.Request[v1alpha1.UpdateConnectionSavedQueryRequest]) (*connect.Response[v1alpha1.UpdateConnectionSavedQueryResponse], error)
+	// Removes a saved table preview
+	DeleteConnectionSavedQuery(context.Context, *connect.Request[v1alpha1.DeleteConnectionSavedQueryRequest]) (*connect.Response[v1alpha1.DeleteConnectionSavedQueryResponse], error)
+	// Returns the bookmarked tables of a connection
+	GetConnectionTableBookmarks(context.Context, *connect.Request[v1alpha1.GetConnectionTableBookmarksRequest]) (*connect.Response[v1alpha1.GetConnectionTableBookmarksResponse], error)
+	// Bookmarks a table. Bookmarking a table that has already been bookmarked returns the existing bookmark
+	CreateConnectionTableBookmark(context.Context, *connect.Request[v1alpha1.CreateConnectionTableBookmarkRequest]) (*connect.Response[v1alpha1.CreateConnectionTableBookmarkResponse], error)
+	// Removes a table bookmark
+	DeleteConnectionTableBookmark(context.Context, *connect.Request[v1alpha1.DeleteConnectionTableBookmarkRequest]) (*connect.Response[v1alpha1.DeleteConnectionTableBookmarkResponse], error)
 	// Checks if the connection config is connectable by the backend.
 	// Used mostly to verify that a connection is valid prior to creating a Connection object.
 	CheckConnectionConfig(context.Context, *connect.Request[v1alpha1.CheckConnectionConfigRequest]) (*connect.Response[v1alpha1.CheckConnectionConfigResponse], error)
@@ -253,6 +435,12 @@ func NewConnectionServiceHandler(svc ConnectionServiceHandler, opts ...connect.H
 		connect.WithSchema(connectionServiceCreateConnectionMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	connectionServiceBulkCreateConnectionsHandler := connect.NewUnaryHandler(
+		ConnectionServiceBulkCreateConnectionsProcedure,
+		svc.BulkCreateConnections,
+		connect.WithSchema(connectionServiceBulkCreateConnectionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	connectionServiceUpdateConnectionHandler := connect.NewUnaryHandler(
 		ConnectionServiceUpdateConnectionProcedure,
 		svc.UpdateConnection,
@@ -271,6 +459,54 @@ func NewConnectionServiceHandler(svc ConnectionServiceHandler, opts ...connect.H
 		connect.WithSchema(connectionServiceIsConnectionNameAvailableMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	connectionServiceSetConnectionLabelsHandler := connect.NewUnaryHandler(
+		ConnectionServiceSetConnectionLabelsProcedure,
+		svc.SetConnectionLabels,
+		connect.WithSchema(connectionServiceSetConnectionLabelsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	connectionServiceGetConnectionSavedQueriesHandler := connect.NewUnaryHandler(
+		ConnectionServiceGetConnectionSavedQueriesProcedure,
+		svc.GetConnectionSavedQueries,
+		connect.WithSchema(connectionServiceGetConnectionSavedQueriesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	connectionServiceCreateConnectionSavedQueryHandler := connect.NewUnaryHandler(
+		ConnectionServiceCreateConnectionSavedQueryProcedure,
+		svc.CreateConnectionSavedQuery,
+		connect.WithSchema(connectionServiceCreateConnectionSavedQueryMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	connectionServiceUpdateConnectionSavedQueryHandler := connect.NewUnaryHandler(
+		ConnectionServiceUpdateConnectionSavedQueryProcedure,
+		svc.UpdateConnectionSavedQuery,
+		connect.WithSchema(connectionServiceUpdateConnectionSavedQueryMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	connectionServiceDeleteConnectionSavedQueryHandler := connect.NewUnaryHandler(
+		ConnectionServiceDeleteConnectionSavedQueryProcedure,
+		svc.DeleteConnectionSavedQuery,
+		connect.WithSchema(connectionServiceDeleteConnectionSavedQueryMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	connectionServiceGetConnectionTableBookmarksHandler := connect.NewUnaryHandler(
+		ConnectionServiceGetConnectionTableBookmarksProcedure,
+		svc.GetConnectionTableBookmarks,
+		connect.WithSchema(connectionServiceGetConnectionTableBookmarksMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	connectionServiceCreateConnectionTableBookmarkHandler := connect.NewUnaryHandler(
+		ConnectionServiceCreateConnectionTableBookmarkProcedure,
+		svc.CreateConnectionTableBookmark,
+		connect.WithSchema(connectionServiceCreateConnectionTableBookmarkMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	connectionServiceDeleteConnectionTableBookmarkHandler := connect.NewUnaryHandler(
+		ConnectionServiceDeleteConnectionTableBookmarkProcedure,
+		svc.DeleteConnectionTableBookmark,
+		connect.WithSchema(connectionServiceDeleteConnectionTableBookmarkMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	connectionServiceCheckConnectionConfigHandler := connect.NewUnaryHandler(
 		ConnectionServiceCheckConnectionConfigProcedure,
 		svc.CheckConnectionConfig,
@@ -291,12 +527,30 @@ func NewConnectionServiceHandler(svc ConnectionServiceHandler, opts ...connect.H
 			connectionServiceGetConnectionHandler.ServeHTTP(w, r)
 		case ConnectionServiceCreateConnectionProcedure:
 			connectionServiceCreateConnectionHandler.ServeHTTP(w, r)
+		case ConnectionServiceBulkCreateConnectionsProcedure:
+			connectionServiceBulkCreateConnectionsHandler.ServeHTTP(w, r)
 		case ConnectionServiceUpdateConnectionProcedure:
 			connectionServiceUpdateConnectionHandler.ServeHTTP(w, r)
 		case ConnectionServiceDeleteConnectionProcedure:
 			connectionServiceDeleteConnectionHandler.ServeHTTP(w, r)
 		case ConnectionServiceIsConnectionNameAvailableProcedure:
 			connectionServiceIsConnectionNameAvailableHandler.ServeHTTP(w, r)
+		case ConnectionServiceSetConnectionLabelsProcedure:
+			connectionServiceSetConnectionLabelsHandler.ServeHTTP(w, r)
+		case ConnectionServiceGetConnectionSavedQueriesProcedure:
+			connectionServiceGetConnectionSavedQueriesHandler.ServeHTTP(w, r)
+		case ConnectionServiceCreateConnectionSavedQueryProcedure:
+			connectionServiceCreateConnectionSavedQueryHandler.ServeHTTP(w, r)
+		case ConnectionServiceUpdateConnectionSavedQueryProcedure:
+			connectionServiceUpdateConnectionSavedQueryHandler.ServeHTTP(w, r)
+		case ConnectionServiceDeleteConnectionSavedQueryProcedure:
+			connectionServiceDeleteConnectionSavedQueryHandler.ServeHTTP(w, r)
+		case ConnectionServiceGetConnectionTableBookmarksProcedure:
+			connectionServiceGetConnectionTableBookmarksHandler.ServeHTTP(w, r)
+		case ConnectionServiceCreateConnectionTableBookmarkProcedure:
+			connectionServiceCreateConnectionTableBookmarkHandler.ServeHTTP(w, r)
+		case ConnectionServiceDeleteConnectionTableBookmarkProcedure:
+			connectionServiceDeleteConnectionTableBookmarkHandler.ServeHTTP(w, r)
 		case ConnectionServiceCheckConnectionConfigProcedure:
 			connectionServiceCheckConnectionConfigHandler.ServeHTTP(w, r)
 		case ConnectionServiceCheckSqlQueryProcedure:
@@ -322,6 +576,10 @@ func (UnimplementedConnectionServiceHandler) CreateConnection(context.Context, *
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionService.CreateConnection is not implemented"))
 }
 
+func (UnimplementedConnectionServiceHandler) BulkCreateConnections(context.Context, *connect.Request[v1alpha1.BulkCreateConnectionsRequest]) (*connect.Response[v1alpha1.BulkCreateConnectionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionService.BulkCreateConnections is not implemented"))
+}
+
 func (UnimplementedConnectionServiceHandler) UpdateConnection(context.Context, *connect.Request[v1alpha1.UpdateConnectionRequest]) (*connect.Response[v1alpha1.UpdateConnectionResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionService.UpdateConnection is not implemented"))
 }
@@ -334,6 +592,38 @@ func (UnimplementedConnectionServiceHandler) IsConnectionNameAvailable(context.C
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionService.IsConnectionNameAvailable is not implemented"))
 }
 
+func (UnimplementedConnectionServiceHandler) SetConnectionLabels(context.Context, *connect.Request[v1alpha1.SetConnectionLabelsRequest]) (*connect.Response[v1alpha1.SetConnectionLabelsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionService.SetConnectionLabels is not implemented"))
+}
+
+func (UnimplementedConnectionServiceHandler) GetConnectionSavedQueries(context.Context, *connect.Request[v1alpha1.GetConnectionSavedQueriesRequest]) (*connect.Response[v1alpha1.GetConnectionSavedQueriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionService.GetConnectionSavedQueries is not implemented"))
+}
+
+func (UnimplementedConnectionServiceHandler) CreateConnectionSavedQuery(context.Context, *connect.Request[v1alpha1.CreateConnectionSavedQueryRequest]) (*connect.Response[v1alpha1.CreateConnectionSavedQueryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionService.CreateConnectionSavedQuery is not implemented"))
+}
+
+func (UnimplementedConnectionServiceHandler) UpdateConnectionSavedQuery(context.Context, *connect.Request[v1alpha1.UpdateConnectionSavedQueryRequest]) (*connect.Response[v1alpha1.UpdateConnectionSavedQueryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionService.UpdateConnectionSavedQuery is not implemented"))
+}
+
+func (UnimplementedConnectionServiceHandler) DeleteConnectionSavedQuery(context.Context, *connect.Request[v1alpha1.DeleteConnectionSavedQueryRequest]) (*connect.Response[v1alpha1.DeleteConnectionSavedQueryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionService.DeleteConnectionSavedQuery is not implemented"))
+}
+
+func (UnimplementedConnectionServiceHandler) GetConnectionTableBookmarks(context.Context, *connect.Request[v1alpha1.GetConnectionTableBookmarksRequest]) (*connect.Response[v1alpha1.GetConnectionTableBookmarksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionService.GetConnectionTableBookmarks is not implemented"))
+}
+
+func (UnimplementedConnectionServiceHandler) CreateConnectionTableBookmark(context.Context, *connect.Request[v1alpha1.CreateConnectionTableBookmarkRequest]) (*connect.Response[v1alpha1.CreateConnectionTableBookmarkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionService.CreateConnectionTableBookmark is not implemented"))
+}
+
+func (UnimplementedConnectionServiceHandler) DeleteConnectionTableBookmark(context.Context, *connect.Request[v1alpha1.DeleteConnectionTableBookmarkRequest]) (*connect.Response[v1alpha1.DeleteConnectionTableBookmarkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionService.DeleteConnectionTableBookmark is not implemented"))
+}
+
 func (UnimplementedConnectionServiceHandler) CheckConnectionConfig(context.Context, *connect.Request[v1alpha1.CheckConnectionConfigRequest]) (*connect.Response[v1alpha1.CheckConnectionConfigResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionService.CheckConnectionConfig is not implemented"))
 }
@@ -60,6 +60,18 @@ const (
 	// ConnectionDataServiceGetTableRowCountProcedure is the fully-qualified name of the
 	// ConnectionDataService's GetTableRowCount RPC.
 	ConnectionDataServiceGetTableRowCountProcedure = "/mgmt.v1alpha1.ConnectionDataService/GetTableRowCount"
+	// ConnectionDataServiceExecuteReadOnlyQueryProcedure is the fully-qualified name of the
+	// ConnectionDataService's ExecuteReadOnlyQuery RPC.
+	ConnectionDataServiceExecuteReadOnlyQueryProcedure = "/mgmt.v1alpha1.ConnectionDataService/ExecuteReadOnlyQuery"
+	// ConnectionDataServiceListTableRunsProcedure is the fully-qualified name of the
+	// ConnectionDataService's ListTableRuns RPC.
+	ConnectionDataServiceListTableRunsProcedure = "/mgmt.v1alpha1.ConnectionDataService/ListTableRuns"
+	// ConnectionDataServiceGetTableRunManifestProcedure is the fully-qualified name of the
+	// ConnectionDataService's GetTableRunManifest RPC.
+	ConnectionDataServiceGetTableRunManifestProcedure = "/mgmt.v1alpha1.ConnectionDataService/GetTableRunManifest"
+	// ConnectionDataServiceVerifyTableRunProcedure is the fully-qualified name of the
+	// ConnectionDataService's VerifyTableRun RPC.
+	ConnectionDataServiceVerifyTableRunProcedure = "/mgmt.v1alpha1.ConnectionDataService/VerifyTableRun"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -74,6 +86,10 @@ var (
 	connectionDataServiceGetConnectionUniqueConstraintsMethodDescriptor  = connectionDataServiceServiceDescriptor.Methods().ByName("GetConnectionUniqueConstraints")
 	connectionDataServiceGetAiGeneratedDataMethodDescriptor              = connectionDataServiceServiceDescriptor.Methods().ByName("GetAiGeneratedData")
 	connectionDataServiceGetTableRowCountMethodDescriptor                = connectionDataServiceServiceDescriptor.Methods().ByName("GetTableRowCount")
+	connectionDataServiceExecuteReadOnlyQueryMethodDescriptor            = connectionDataServiceServiceDescriptor.Methods().ByName("ExecuteReadOnlyQuery")
+	connectionDataServiceListTableRunsMethodDescriptor                   = connectionDataServiceServiceDescriptor.Methods().ByName("ListTableRuns")
+	connectionDataServiceGetTableRunManifestMethodDescriptor             = connectionDataServiceServiceDescriptor.Methods().ByName("GetTableRunManifest")
+	connectionDataServiceVerifyTableRunMethodDescriptor                  = connectionDataServiceServiceDescriptor.Methods().ByName("VerifyTableRun")
 )
 
 // ConnectionDataServiceClient is a client for the mgmt.v1alpha1.ConnectionDataService service.
@@ -100,6 +116,17 @@ type ConnectionDataServiceClient interface {
 	GetAiGeneratedData(context.Context, *connect.Request[v1alpha1.GetAiGeneratedDataRequest]) (*connect.Response[v1alpha1.GetAiGeneratedDataResponse], error)
 	// Query table with subset to get row count
 	GetTableRowCount(context.Context, *connect.Request[v1alpha1.GetTableRowCountRequest]) (*connect.Response[v1alpha1.GetTableRowCountResponse], error)
+	// Runs an ad-hoc query against a SQL connection inside of a read-only transaction.
+	// Only available if the query console has been enabled
+	ExecuteReadOnlyQuery(context.Context, *connect.Request[v1alpha1.ExecuteReadOnlyQueryRequest]) (*connect.Response[v1alpha1.ExecuteReadOnlyQueryResponse], error)
+	// For an AWS S3 connection, returns the recent job runs that have output data for a specific table.
+	// Used to pick which run to preview or restore from.
+	ListTableRuns(context.Context, *connect.Request[v1alpha1.ListTableRunsRequest]) (*connect.Response[v1alpha1.ListTableRunsResponse], error)
+	// For an AWS S3 connection, returns the manifest written alongside a table's output for a job run.
+	// The manifest lists each file with its row count, size, and content hash.
+	GetTableRunManifest(context.Context, *connect.Request[v1alpha1.GetTableRunManifestRequest]) (*connect.Response[v1alpha1.GetTableRunManifestResponse], error)
+	// For an AWS S3 connection, re-reads a table's output for a job run and checks each file against the manifest
+	VerifyTableRun(context.Context, *connect.Request[v1alpha1.VerifyTableRunRequest]) (*connect.Response[v1alpha1.VerifyTableRunResponse], error)
 }
 
 // NewConnectionDataServiceClient constructs a client for the mgmt.v1alpha1.ConnectionDataService
@@ -166,6 +193,30 @@ func NewConnectionDataServiceClient(httpClient connect.HTTPClient, baseURL strin
 			connect.WithSchema(connectionDataServiceGetTableRowCountMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		executeReadOnlyQuery: connect.NewClient[v1alpha1.ExecuteReadOnlyQueryRequest, v1alpha1.ExecuteReadOnlyQueryResponse](
+			httpClient,
+			baseURL+ConnectionDataServiceExecuteReadOnlyQueryProcedure,
+			connect.WithSchema(connectionDataServiceExecuteReadOnlyQueryMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listTableRuns: connect.NewClient[v1alpha1.ListTableRunsRequest, v1alpha1.ListTableRunsResponse](
+			httpClient,
+			baseURL+ConnectionDataServiceListTableRunsProcedure,
+			connect.WithSchema(connectionDataServiceListTableRunsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getTableRunManifest: connect.NewClient[v1alpha1.GetTableRunManifestRequest, v1alpha1.GetTableRunManifestResponse](
+			httpClient,
+			baseURL+ConnectionDataServiceGetTableRunManifestProcedure,
+			connect.WithSchema(connectionDataServiceGetTableRunManifestMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		verifyTableRun: connect.NewClient[v1alpha1.VerifyTableRunRequest, v1alpha1.VerifyTableRunResponse](
+			httpClient,
+			baseURL+ConnectionDataServiceVerifyTableRunProcedure,
+			connect.WithSchema(connectionDataServiceVerifyTableRunMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -180,6 +231,10 @@ type connectionDataServiceClient struct {
 	getConnectionUniqueConstraints  *connect.Client[v1alpha1.GetConnectionUniqueConstraintsRequest, v1alpha1.GetConnectionUniqueConstraintsResponse]
 	getAiGeneratedData              *connect.Client[v1alpha1.GetAiGeneratedDataRequest, v1alpha1.GetAiGeneratedDataResponse]
 	getTableRowCount                *connect.Client[v1alpha1.GetTableRowCountRequest, v1alpha1.GetTableRowCountResponse]
+	executeReadOnlyQuery            *connect.Client[v1alpha1.ExecuteReadOnlyQueryRequest, v1alpha1.ExecuteReadOnlyQueryResponse]
+	listTableRuns                   *connect.Client[v1alpha1.ListTableRunsRequest, v1alpha1.ListTableRunsResponse]
+	getTableRunManifest             *connect.Client[v1alpha1.GetTableRunManifestRequest, v1alpha1.GetTableRunManifestResponse]
+	verifyTableRun                  *connect.Client[v1alpha1.VerifyTableRunRequest, v1alpha1.VerifyTableRunResponse]
 }
 
 // GetConnectionDataStream calls mgmt.v1alpha1.ConnectionDataService.GetConnectionDataStream.
@@ -232,6 +287,26 @@ func (c *connectionDataServiceClient) GetTableRowCount(ctx context.Context, req
 	return c.getTableRowCount.CallUnary(ctx, req)
 }
 
+// ExecuteReadOnlyQuery calls mgmt.v1alpha1.ConnectionDataService.ExecuteReadOnlyQuery.
+func (c *connectionDataServiceClient) ExecuteReadOnlyQuery(ctx context.Context, req *connect.Request[v1alpha1.ExecuteReadOnlyQueryRequest]) (*connect.Response[v1alpha1.ExecuteReadOnlyQueryResponse], error) {
+	return c.executeReadOnlyQuery.CallUnary(ctx, req)
+}
+
+// ListTableRuns calls mgmt.v1alpha1.ConnectionDataService.ListTableRuns.
+func (c *connectionDataServiceClient) ListTableRuns(ctx context.Context, req *connect.Request[v1alpha1.ListTableRunsRequest]) (*connect.Response[v1alpha1.ListTableRunsResponse], error) {
+	return c.listTableRuns.CallUnary(ctx, req)
+}
+
+// GetTableRunManifest calls mgmt.v1alpha1.ConnectionDataService.GetTableRunManifest.
+func (c *connectionDataServiceClient) GetTableRunManifest(ctx context.Context, req *connect.Request[v1alpha1.GetTableRunManifestRequest]) (*connect.Response[v1alpha1.GetTableRunManifestResponse], error) {
+	return c.getTableRunManifest.CallUnary(ctx, req)
+}
+
+// VerifyTableRun calls mgmt.v1alpha1.ConnectionDataService.VerifyTableRun.
+func (c *connectionDataServiceClient) VerifyTableRun(ctx context.Context, req *connect.Request[v1alpha1.VerifyTableRunRequest]) (*connect.Response[v1alpha1.VerifyTableRunResponse], error) {
+	return c.verifyTableRun.CallUnary(ctx, req)
+}
+
 // ConnectionDataServiceHandler is an implementation of the mgmt.v1alpha1.ConnectionDataService
 // service.
 type ConnectionDataServiceHandler interface {
@@ -257,6 +332,17 @@ type ConnectionDataServiceHandler interface {
 	GetAiGeneratedData(context.Context, *connect.Request[v1alpha1.GetAiGeneratedDataRequest]) (*connect.Response[v1alpha1.GetAiGeneratedDataResponse], error)
 	// Query table with subset to get row count
 	GetTableRowCount(context.Context, *connect.Request[v1alpha1.GetTableRowCountRequest]) (*connect.Response[v1alpha1.GetTableRowCountResponse], error)
+	// Runs an ad-hoc query against a SQL connection inside of a read-only transaction.
+	// Only available if the query console has been enabled
+	ExecuteReadOnlyQuery(context.Context, *connect.Request[v1alpha1.ExecuteReadOnlyQueryRequest]) (*connect.Response[v1alpha1.ExecuteReadOnlyQueryResponse], error)
+	// For an AWS S3 connection, returns the recent job runs that have output data for a specific table.
+	// Used to pick which run to preview or restore from.
+	ListTableRuns(context.Context, *connect.Request[v1alpha1.ListTableRunsRequest]) (*connect.Response[v1alpha1.ListTableRunsResponse], error)
+	// For an AWS S3 connection, returns the manifest written alongside a table's output for a job run.
+	// The manifest lists each file with its row count, size, and content hash.
+	GetTableRunManifest(context.Context, *connect.Request[v1alpha1.GetTableRunManifestRequest]) (*connect.Response[v1alpha1.GetTableRunManifestResponse], error)
+	// For an AWS S3 connection, re-reads a table's output for a job run and checks each file against the manifest
+	VerifyTableRun(context.Context, *connect.Request[v1alpha1.VerifyTableRunRequest]) (*connect.Response[v1alpha1.VerifyTableRunResponse], error)
 }
 
 // NewConnectionDataServiceHandler builds an HTTP handler from the service implementation. It
@@ -319,6 +405,30 @@ func NewConnectionDataServiceHandler(svc ConnectionDataServiceHandler, opts ...c
 		connect.WithSchema(connectionDataServiceGetTableRowCountMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	connectionDataServiceExecuteReadOnlyQueryHandler := connect.NewUnaryHandler(
+		ConnectionDataServiceExecuteReadOnlyQueryProcedure,
+		svc.ExecuteReadOnlyQuery,
+		connect.WithSchema(connectionDataServiceExecuteReadOnlyQueryMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	connectionDataServiceListTableRunsHandler := connect.NewUnaryHandler(
+		ConnectionDataServiceListTableRunsProcedure,
+		svc.ListTableRuns,
+		connect.WithSchema(connectionDataServiceListTableRunsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	connectionDataServiceGetTableRunManifestHandler := connect.NewUnaryHandler(
+		ConnectionDataServiceGetTableRunManifestProcedure,
+		svc.GetTableRunManifest,
+		connect.WithSchema(connectionDataServiceGetTableRunManifestMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	connectionDataServiceVerifyTableRunHandler := connect.NewUnaryHandler(
+		ConnectionDataServiceVerifyTableRunProcedure,
+		svc.VerifyTableRun,
+		connect.WithSchema(connectionDataServiceVerifyTableRunMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mgmt.v1alpha1.ConnectionDataService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ConnectionDataServiceGetConnectionDataStreamProcedure:
@@ -339,6 +449,14 @@ func NewConnectionDataServiceHandler(svc ConnectionDataServiceHandler, opts ...c
 			connectionDataServiceGetAiGeneratedDataHandler.ServeHTTP(w, r)
 		case ConnectionDataServiceGetTableRowCountProcedure:
 			connectionDataServiceGetTableRowCountHandler.ServeHTTP(w, r)
+		case ConnectionDataServiceExecuteReadOnlyQueryProcedure:
+			connectionDataServiceExecuteReadOnlyQueryHandler.ServeHTTP(w, r)
+		case ConnectionDataServiceListTableRunsProcedure:
+			connectionDataServiceListTableRunsHandler.ServeHTTP(w, r)
+		case ConnectionDataServiceGetTableRunManifestProcedure:
+			connectionDataServiceGetTableRunManifestHandler.ServeHTTP(w, r)
+		case ConnectionDataServiceVerifyTableRunProcedure:
+			connectionDataServiceVerifyTableRunHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -383,3 +501,19 @@ func (UnimplementedConnectionDataServiceHandler) GetAiGeneratedData(context.Cont
 func (UnimplementedConnectionDataServiceHandler) GetTableRowCount(context.Context, *connect.Request[v1alpha1.GetTableRowCountRequest]) (*connect.Response[v1alpha1.GetTableRowCountResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionDataService.GetTableRowCount is not implemented"))
 }
+
+func (UnimplementedConnectionDataServiceHandler) ExecuteReadOnlyQuery(context.Context, *connect.Request[v1alpha1.ExecuteReadOnlyQueryRequest]) (*connect.Response[v1alpha1.ExecuteReadOnlyQueryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionDataService.ExecuteReadOnlyQuery is not implemented"))
+}
+
+func (UnimplementedConnectionDataServiceHandler) ListTableRuns(context.Context, *connect.Request[v1alpha1.ListTableRunsRequest]) (*connect.Response[v1alpha1.ListTableRunsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionDataService.ListTableRuns is not implemented"))
+}
+
+func (UnimplementedConnectionDataServiceHandler) GetTableRunManifest(context.Context, *connect.Request[v1alpha1.GetTableRunManifestRequest]) (*connect.Response[v1alpha1.GetTableRunManifestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionDataService.GetTableRunManifest is not implemented"))
+}
+
+func (UnimplementedConnectionDataServiceHandler) VerifyTableRun(context.Context, *connect.Request[v1alpha1.VerifyTableRunRequest]) (*connect.Response[v1alpha1.VerifyTableRunResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionDataService.VerifyTableRun is not implemented"))
+}
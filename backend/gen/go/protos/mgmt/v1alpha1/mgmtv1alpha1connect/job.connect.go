@@ -44,12 +44,17 @@ const (
 	// JobServiceIsJobNameAvailableProcedure is the fully-qualified name of the JobService's
 	// IsJobNameAvailable RPC.
 	JobServiceIsJobNameAvailableProcedure = "/mgmt.v1alpha1.JobService/IsJobNameAvailable"
+	// JobServiceSetJobLabelsProcedure is the fully-qualified name of the JobService's SetJobLabels RPC.
+	JobServiceSetJobLabelsProcedure = "/mgmt.v1alpha1.JobService/SetJobLabels"
 	// JobServiceUpdateJobScheduleProcedure is the fully-qualified name of the JobService's
 	// UpdateJobSchedule RPC.
 	JobServiceUpdateJobScheduleProcedure = "/mgmt.v1alpha1.JobService/UpdateJobSchedule"
 	// JobServiceUpdateJobSourceConnectionProcedure is the fully-qualified name of the JobService's
 	// UpdateJobSourceConnection RPC.
 	JobServiceUpdateJobSourceConnectionProcedure = "/mgmt.v1alpha1.JobService/UpdateJobSourceConnection"
+	// JobServiceSetJobMappingsProcedure is the fully-qualified name of the JobService's SetJobMappings
+	// RPC.
+	JobServiceSetJobMappingsProcedure = "/mgmt.v1alpha1.JobService/SetJobMappings"
 	// JobServiceSetJobSourceSqlConnectionSubsetsProcedure is the fully-qualified name of the
 	// JobService's SetJobSourceSqlConnectionSubsets RPC.
 	JobServiceSetJobSourceSqlConnectionSubsetsProcedure = "/mgmt.v1alpha1.JobService/SetJobSourceSqlConnectionSubsets"
@@ -64,12 +69,17 @@ const (
 	JobServiceCreateJobDestinationConnectionsProcedure = "/mgmt.v1alpha1.JobService/CreateJobDestinationConnections"
 	// JobServicePauseJobProcedure is the fully-qualified name of the JobService's PauseJob RPC.
 	JobServicePauseJobProcedure = "/mgmt.v1alpha1.JobService/PauseJob"
+	// JobServiceResumeJobProcedure is the fully-qualified name of the JobService's ResumeJob RPC.
+	JobServiceResumeJobProcedure = "/mgmt.v1alpha1.JobService/ResumeJob"
 	// JobServiceGetJobRecentRunsProcedure is the fully-qualified name of the JobService's
 	// GetJobRecentRuns RPC.
 	JobServiceGetJobRecentRunsProcedure = "/mgmt.v1alpha1.JobService/GetJobRecentRuns"
 	// JobServiceGetJobNextRunsProcedure is the fully-qualified name of the JobService's GetJobNextRuns
 	// RPC.
 	JobServiceGetJobNextRunsProcedure = "/mgmt.v1alpha1.JobService/GetJobNextRuns"
+	// JobServiceGetNextScheduledRunsProcedure is the fully-qualified name of the JobService's
+	// GetNextScheduledRuns RPC.
+	JobServiceGetNextScheduledRunsProcedure = "/mgmt.v1alpha1.JobService/GetNextScheduledRuns"
 	// JobServiceGetJobStatusProcedure is the fully-qualified name of the JobService's GetJobStatus RPC.
 	JobServiceGetJobStatusProcedure = "/mgmt.v1alpha1.JobService/GetJobStatus"
 	// JobServiceGetJobStatusesProcedure is the fully-qualified name of the JobService's GetJobStatuses
@@ -77,20 +87,62 @@ const (
 	JobServiceGetJobStatusesProcedure = "/mgmt.v1alpha1.JobService/GetJobStatuses"
 	// JobServiceGetJobRunsProcedure is the fully-qualified name of the JobService's GetJobRuns RPC.
 	JobServiceGetJobRunsProcedure = "/mgmt.v1alpha1.JobService/GetJobRuns"
+	// JobServiceStreamJobRunStatusesProcedure is the fully-qualified name of the JobService's
+	// StreamJobRunStatuses RPC.
+	JobServiceStreamJobRunStatusesProcedure = "/mgmt.v1alpha1.JobService/StreamJobRunStatuses"
 	// JobServiceGetJobRunEventsProcedure is the fully-qualified name of the JobService's
 	// GetJobRunEvents RPC.
 	JobServiceGetJobRunEventsProcedure = "/mgmt.v1alpha1.JobService/GetJobRunEvents"
 	// JobServiceGetJobRunProcedure is the fully-qualified name of the JobService's GetJobRun RPC.
 	JobServiceGetJobRunProcedure = "/mgmt.v1alpha1.JobService/GetJobRun"
+	// JobServiceGetJobRunDataQualityProcedure is the fully-qualified name of the JobService's
+	// GetJobRunDataQuality RPC.
+	JobServiceGetJobRunDataQualityProcedure = "/mgmt.v1alpha1.JobService/GetJobRunDataQuality"
+	// JobServiceGetJobRunRowCountsProcedure is the fully-qualified name of the JobService's
+	// GetJobRunRowCounts RPC.
+	JobServiceGetJobRunRowCountsProcedure = "/mgmt.v1alpha1.JobService/GetJobRunRowCounts"
+	// JobServiceGetJobRunMaskingProgressProcedure is the fully-qualified name of the JobService's
+	// GetJobRunMaskingProgress RPC.
+	JobServiceGetJobRunMaskingProgressProcedure = "/mgmt.v1alpha1.JobService/GetJobRunMaskingProgress"
+	// JobServiceCreateJobRunArtifactProcedure is the fully-qualified name of the JobService's
+	// CreateJobRunArtifact RPC.
+	JobServiceCreateJobRunArtifactProcedure = "/mgmt.v1alpha1.JobService/CreateJobRunArtifact"
+	// JobServiceGetJobRunArtifactsProcedure is the fully-qualified name of the JobService's
+	// GetJobRunArtifacts RPC.
+	JobServiceGetJobRunArtifactsProcedure = "/mgmt.v1alpha1.JobService/GetJobRunArtifacts"
+	// JobServiceCreateJobRunSnapshotProcedure is the fully-qualified name of the JobService's
+	// CreateJobRunSnapshot RPC.
+	JobServiceCreateJobRunSnapshotProcedure = "/mgmt.v1alpha1.JobService/CreateJobRunSnapshot"
+	// JobServiceGetJobRunSnapshotProcedure is the fully-qualified name of the JobService's
+	// GetJobRunSnapshot RPC.
+	JobServiceGetJobRunSnapshotProcedure = "/mgmt.v1alpha1.JobService/GetJobRunSnapshot"
+	// JobServiceGetJobRunSnapshotsProcedure is the fully-qualified name of the JobService's
+	// GetJobRunSnapshots RPC.
+	JobServiceGetJobRunSnapshotsProcedure = "/mgmt.v1alpha1.JobService/GetJobRunSnapshots"
+	// JobServiceDeleteJobRunSnapshotProcedure is the fully-qualified name of the JobService's
+	// DeleteJobRunSnapshot RPC.
+	JobServiceDeleteJobRunSnapshotProcedure = "/mgmt.v1alpha1.JobService/DeleteJobRunSnapshot"
 	// JobServiceDeleteJobRunProcedure is the fully-qualified name of the JobService's DeleteJobRun RPC.
 	JobServiceDeleteJobRunProcedure = "/mgmt.v1alpha1.JobService/DeleteJobRun"
 	// JobServiceCreateJobRunProcedure is the fully-qualified name of the JobService's CreateJobRun RPC.
 	JobServiceCreateJobRunProcedure = "/mgmt.v1alpha1.JobService/CreateJobRun"
+	// JobServiceGetJobRunPriorityProcedure is the fully-qualified name of the JobService's
+	// GetJobRunPriority RPC.
+	JobServiceGetJobRunPriorityProcedure = "/mgmt.v1alpha1.JobService/GetJobRunPriority"
+	// JobServiceGetJobRunParametersProcedure is the fully-qualified name of the JobService's
+	// GetJobRunParameters RPC.
+	JobServiceGetJobRunParametersProcedure = "/mgmt.v1alpha1.JobService/GetJobRunParameters"
+	// JobServiceEstimateJobRunProcedure is the fully-qualified name of the JobService's EstimateJobRun
+	// RPC.
+	JobServiceEstimateJobRunProcedure = "/mgmt.v1alpha1.JobService/EstimateJobRun"
 	// JobServiceCancelJobRunProcedure is the fully-qualified name of the JobService's CancelJobRun RPC.
 	JobServiceCancelJobRunProcedure = "/mgmt.v1alpha1.JobService/CancelJobRun"
 	// JobServiceTerminateJobRunProcedure is the fully-qualified name of the JobService's
 	// TerminateJobRun RPC.
 	JobServiceTerminateJobRunProcedure = "/mgmt.v1alpha1.JobService/TerminateJobRun"
+	// JobServiceForceCancelJobRunProcedure is the fully-qualified name of the JobService's
+	// ForceCancelJobRun RPC.
+	JobServiceForceCancelJobRunProcedure = "/mgmt.v1alpha1.JobService/ForceCancelJobRun"
 	// JobServiceGetJobRunLogsStreamProcedure is the fully-qualified name of the JobService's
 	// GetJobRunLogsStream RPC.
 	JobServiceGetJobRunLogsStreamProcedure = "/mgmt.v1alpha1.JobService/GetJobRunLogsStream"
@@ -100,9 +152,36 @@ const (
 	// JobServiceSetJobSyncOptionsProcedure is the fully-qualified name of the JobService's
 	// SetJobSyncOptions RPC.
 	JobServiceSetJobSyncOptionsProcedure = "/mgmt.v1alpha1.JobService/SetJobSyncOptions"
+	// JobServiceSetJobTableProcessorsProcedure is the fully-qualified name of the JobService's
+	// SetJobTableProcessors RPC.
+	JobServiceSetJobTableProcessorsProcedure = "/mgmt.v1alpha1.JobService/SetJobTableProcessors"
+	// JobServiceSetJobParametersProcedure is the fully-qualified name of the JobService's
+	// SetJobParameters RPC.
+	JobServiceSetJobParametersProcedure = "/mgmt.v1alpha1.JobService/SetJobParameters"
 	// JobServiceValidateJobMappingsProcedure is the fully-qualified name of the JobService's
 	// ValidateJobMappings RPC.
 	JobServiceValidateJobMappingsProcedure = "/mgmt.v1alpha1.JobService/ValidateJobMappings"
+	// JobServiceGetJobChangeRequestsProcedure is the fully-qualified name of the JobService's
+	// GetJobChangeRequests RPC.
+	JobServiceGetJobChangeRequestsProcedure = "/mgmt.v1alpha1.JobService/GetJobChangeRequests"
+	// JobServiceApproveJobChangeProcedure is the fully-qualified name of the JobService's
+	// ApproveJobChange RPC.
+	JobServiceApproveJobChangeProcedure = "/mgmt.v1alpha1.JobService/ApproveJobChange"
+	// JobServiceGetAccountJobDefaultsProcedure is the fully-qualified name of the JobService's
+	// GetAccountJobDefaults RPC.
+	JobServiceGetAccountJobDefaultsProcedure = "/mgmt.v1alpha1.JobService/GetAccountJobDefaults"
+	// JobServiceSetAccountJobDefaultsProcedure is the fully-qualified name of the JobService's
+	// SetAccountJobDefaults RPC.
+	JobServiceSetAccountJobDefaultsProcedure = "/mgmt.v1alpha1.JobService/SetAccountJobDefaults"
+	// JobServiceGetJobsOverridingDefaultsProcedure is the fully-qualified name of the JobService's
+	// GetJobsOverridingDefaults RPC.
+	JobServiceGetJobsOverridingDefaultsProcedure = "/mgmt.v1alpha1.JobService/GetJobsOverridingDefaults"
+	// JobServiceGetJobTemplatesProcedure is the fully-qualified name of the JobService's
+	// GetJobTemplates RPC.
+	JobServiceGetJobTemplatesProcedure = "/mgmt.v1alpha1.JobService/GetJobTemplates"
+	// JobServiceCreateJobFromTemplateProcedure is the fully-qualified name of the JobService's
+	// CreateJobFromTemplate RPC.
+	JobServiceCreateJobFromTemplateProcedure = "/mgmt.v1alpha1.JobService/CreateJobFromTemplate"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -113,28 +192,55 @@ var (
 	jobServiceCreateJobMethodDescriptor                        = jobServiceServiceDescriptor.Methods().ByName("CreateJob")
 	jobServiceDeleteJobMethodDescriptor                        = jobServiceServiceDescriptor.Methods().ByName("DeleteJob")
 	jobServiceIsJobNameAvailableMethodDescriptor               = jobServiceServiceDescriptor.Methods().ByName("IsJobNameAvailable")
+	jobServiceSetJobLabelsMethodDescriptor                     = jobServiceServiceDescriptor.Methods().ByName("SetJobLabels")
 	jobServiceUpdateJobScheduleMethodDescriptor                = jobServiceServiceDescriptor.Methods().ByName("UpdateJobSchedule")
 	jobServiceUpdateJobSourceConnectionMethodDescriptor        = jobServiceServiceDescriptor.Methods().ByName("UpdateJobSourceConnection")
+	jobServiceSetJobMappingsMethodDescriptor                   = jobServiceServiceDescriptor.Methods().ByName("SetJobMappings")
 	jobServiceSetJobSourceSqlConnectionSubsetsMethodDescriptor = jobServiceServiceDescriptor.Methods().ByName("SetJobSourceSqlConnectionSubsets")
 	jobServiceUpdateJobDestinationConnectionMethodDescriptor   = jobServiceServiceDescriptor.Methods().ByName("UpdateJobDestinationConnection")
 	jobServiceDeleteJobDestinationConnectionMethodDescriptor   = jobServiceServiceDescriptor.Methods().ByName("DeleteJobDestinationConnection")
 	jobServiceCreateJobDestinationConnectionsMethodDescriptor  = jobServiceServiceDescriptor.Methods().ByName("CreateJobDestinationConnections")
 	jobServicePauseJobMethodDescriptor                         = jobServiceServiceDescriptor.Methods().ByName("PauseJob")
+	jobServiceResumeJobMethodDescriptor                        = jobServiceServiceDescriptor.Methods().ByName("ResumeJob")
 	jobServiceGetJobRecentRunsMethodDescriptor                 = jobServiceServiceDescriptor.Methods().ByName("GetJobRecentRuns")
 	jobServiceGetJobNextRunsMethodDescriptor                   = jobServiceServiceDescriptor.Methods().ByName("GetJobNextRuns")
+	jobServiceGetNextScheduledRunsMethodDescriptor             = jobServiceServiceDescriptor.Methods().ByName("GetNextScheduledRuns")
 	jobServiceGetJobStatusMethodDescriptor                     = jobServiceServiceDescriptor.Methods().ByName("GetJobStatus")
 	jobServiceGetJobStatusesMethodDescriptor                   = jobServiceServiceDescriptor.Methods().ByName("GetJobStatuses")
 	jobServiceGetJobRunsMethodDescriptor                       = jobServiceServiceDescriptor.Methods().ByName("GetJobRuns")
+	jobServiceStreamJobRunStatusesMethodDescriptor             = jobServiceServiceDescriptor.Methods().ByName("StreamJobRunStatuses")
 	jobServiceGetJobRunEventsMethodDescriptor                  = jobServiceServiceDescriptor.Methods().ByName("GetJobRunEvents")
 	jobServiceGetJobRunMethodDescriptor                        = jobServiceServiceDescriptor.Methods().ByName("GetJobRun")
+	jobServiceGetJobRunDataQualityMethodDescriptor             = jobServiceServiceDescriptor.Methods().ByName("GetJobRunDataQuality")
+	jobServiceGetJobRunRowCountsMethodDescriptor               = jobServiceServiceDescriptor.Methods().ByName("GetJobRunRowCounts")
+	jobServiceGetJobRunMaskingProgressMethodDescriptor         = jobServiceServiceDescriptor.Methods().ByName("GetJobRunMaskingProgress")
+	jobServiceCreateJobRunArtifactMethodDescriptor             = jobServiceServiceDescriptor.Methods().ByName("CreateJobRunArtifact")
+	jobServiceGetJobRunArtifactsMethodDescriptor               = jobServiceServiceDescriptor.Methods().ByName("GetJobRunArtifacts")
+	jobServiceCreateJobRunSnapshotMethodDescriptor             = jobServiceServiceDescriptor.Methods().ByName("CreateJobRunSnapshot")
+	jobServiceGetJobRunSnapshotMethodDescriptor                = jobServiceServiceDescriptor.Methods().ByName("GetJobRunSnapshot")
+	jobServiceGetJobRunSnapshotsMethodDescriptor               = jobServiceServiceDescriptor.Methods().ByName("GetJobRunSnapshots")
+	jobServiceDeleteJobRunSnapshotMethodDescriptor             = jobServiceServiceDescriptor.Methods().ByName("DeleteJobRunSnapshot")
 	jobServiceDeleteJobRunMethodDescriptor                     = jobServiceServiceDescriptor.Methods().ByName("DeleteJobRun")
 	jobServiceCreateJobRunMethodDescriptor                     = jobServiceServiceDescriptor.Methods().ByName("CreateJobRun")
+	jobServiceGetJobRunPriorityMethodDescriptor                = jobServiceServiceDescriptor.Methods().ByName("GetJobRunPriority")
+	jobServiceGetJobRunParametersMethodDescriptor              = jobServiceServiceDescriptor.Methods().ByName("GetJobRunParameters")
+	jobServiceEstimateJobRunMethodDescriptor                   = jobServiceServiceDescriptor.Methods().ByName("EstimateJobRun")
 	jobServiceCancelJobRunMethodDescriptor                     = jobServiceServiceDescriptor.Methods().ByName("CancelJobRun")
 	jobServiceTerminateJobRunMethodDescriptor                  = jobServiceServiceDescriptor.Methods().ByName("TerminateJobRun")
+	jobServiceForceCancelJobRunMethodDescriptor                = jobServiceServiceDescriptor.Methods().ByName("ForceCancelJobRun")
 	jobServiceGetJobRunLogsStreamMethodDescriptor              = jobServiceServiceDescriptor.Methods().ByName("GetJobRunLogsStream")
 	jobServiceSetJobWorkflowOptionsMethodDescriptor            = jobServiceServiceDescriptor.Methods().ByName("SetJobWorkflowOptions")
 	jobServiceSetJobSyncOptionsMethodDescriptor                = jobServiceServiceDescriptor.Methods().ByName("SetJobSyncOptions")
+	jobServiceSetJobTableProcessorsMethodDescriptor            = jobServiceServiceDescriptor.Methods().ByName("SetJobTableProcessors")
+	jobServiceSetJobParametersMethodDescriptor                 = jobServiceServiceDescriptor.Methods().ByName("SetJobParameters")
 	jobServiceValidateJobMappingsMethodDescriptor              = jobServiceServiceDescriptor.Methods().ByName("ValidateJobMappings")
+	jobServiceGetJobChangeRequestsMethodDescriptor             = jobServiceServiceDescriptor.Methods().ByName("GetJobChangeRequests")
+	jobServiceApproveJobChangeMethodDescriptor                 = jobServiceServiceDescriptor.Methods().ByName("ApproveJobChange")
+	jobServiceGetAccountJobDefaultsMethodDescriptor            = jobServiceServiceDescriptor.Methods().ByName("GetAccountJobDefaults")
+	jobServiceSetAccountJobDefaultsMethodDescriptor            = jobServiceServiceDescriptor.Methods().ByName("SetAccountJobDefaults")
+	jobServiceGetJobsOverridingDefaultsMethodDescriptor        = jobServiceServiceDescriptor.Methods().ByName("GetJobsOverridingDefaults")
+	jobServiceGetJobTemplatesMethodDescriptor                  = jobServiceServiceDescriptor.Methods().ByName("GetJobTemplates")
+	jobServiceCreateJobFromTemplateMethodDescriptor            = jobServiceServiceDescriptor.Methods().ByName("CreateJobFromTemplate")
 )
 
 // JobServiceClient is a client for the mgmt.v1alpha1.JobService service.
@@ -144,36 +250,91 @@ type JobServiceClient interface {
 	CreateJob(context.Context, *connect.Request[v1alpha1.CreateJobRequest]) (*connect.Response[v1alpha1.CreateJobResponse], error)
 	DeleteJob(context.Context, *connect.Request[v1alpha1.DeleteJobRequest]) (*connect.Response[v1alpha1.DeleteJobResponse], error)
 	IsJobNameAvailable(context.Context, *connect.Request[v1alpha1.IsJobNameAvailableRequest]) (*connect.Response[v1alpha1.IsJobNameAvailableResponse], error)
+	// Replaces the labels on a job
+	SetJobLabels(context.Context, *connect.Request[v1alpha1.SetJobLabelsRequest]) (*connect.Response[v1alpha1.SetJobLabelsResponse], error)
 	UpdateJobSchedule(context.Context, *connect.Request[v1alpha1.UpdateJobScheduleRequest]) (*connect.Response[v1alpha1.UpdateJobScheduleResponse], error)
 	UpdateJobSourceConnection(context.Context, *connect.Request[v1alpha1.UpdateJobSourceConnectionRequest]) (*connect.Response[v1alpha1.UpdateJobSourceConnectionResponse], error)
+	// Sets many job mappings at once, either by replacing all of them or by patching individual columns. The change is applied all-or-nothing
+	SetJobMappings(context.Context, *connect.Request[v1alpha1.SetJobMappingsRequest]) (*connect.Response[v1alpha1.SetJobMappingsResponse], error)
 	SetJobSourceSqlConnectionSubsets(context.Context, *connect.Request[v1alpha1.SetJobSourceSqlConnectionSubsetsRequest]) (*connect.Response[v1alpha1.SetJobSourceSqlConnectionSubsetsResponse], error)
 	UpdateJobDestinationConnection(context.Context, *connect.Request[v1alpha1.UpdateJobDestinationConnectionRequest]) (*connect.Response[v1alpha1.UpdateJobDestinationConnectionResponse], error)
 	DeleteJobDestinationConnection(context.Context, *connect.Request[v1alpha1.DeleteJobDestinationConnectionRequest]) (*connect.Response[v1alpha1.DeleteJobDestinationConnectionResponse], error)
 	CreateJobDestinationConnections(context.Context, *connect.Request[v1alpha1.CreateJobDestinationConnectionsRequest]) (*connect.Response[v1alpha1.CreateJobDestinationConnectionsResponse], error)
 	PauseJob(context.Context, *connect.Request[v1alpha1.PauseJobRequest]) (*connect.Response[v1alpha1.PauseJobResponse], error)
+	// Resumes a paused job schedule. Equivalent to PauseJob with pause set to false
+	ResumeJob(context.Context, *connect.Request[v1alpha1.ResumeJobRequest]) (*connect.Response[v1alpha1.ResumeJobResponse], error)
 	// Returns a list of recently invoked job runs baseds on the Temporal cron scheduler. This will return a list of job runs that include archived runs
 	GetJobRecentRuns(context.Context, *connect.Request[v1alpha1.GetJobRecentRunsRequest]) (*connect.Response[v1alpha1.GetJobRecentRunsResponse], error)
 	// Returns a list of runs that are scheduled for execution based on the Temporal cron scheduler.
 	GetJobNextRuns(context.Context, *connect.Request[v1alpha1.GetJobNextRunsRequest]) (*connect.Response[v1alpha1.GetJobNextRunsResponse], error)
+	// Returns the next N fire times of the job's schedule as computed by Temporal, after the schedule's timezone and jitter are applied
+	GetNextScheduledRuns(context.Context, *connect.Request[v1alpha1.GetNextScheduledRunsRequest]) (*connect.Response[v1alpha1.GetNextScheduledRunsResponse], error)
 	GetJobStatus(context.Context, *connect.Request[v1alpha1.GetJobStatusRequest]) (*connect.Response[v1alpha1.GetJobStatusResponse], error)
 	GetJobStatuses(context.Context, *connect.Request[v1alpha1.GetJobStatusesRequest]) (*connect.Response[v1alpha1.GetJobStatusesResponse], error)
 	// Returns a list of job runs by either account or job
 	GetJobRuns(context.Context, *connect.Request[v1alpha1.GetJobRunsRequest]) (*connect.Response[v1alpha1.GetJobRunsResponse], error)
+	// Returns a stream of job runs as their statuses change, so that clients do not need to poll GetJobRuns
+	StreamJobRunStatuses(context.Context, *connect.Request[v1alpha1.StreamJobRunStatusesRequest]) (*connect.ServerStreamForClient[v1alpha1.StreamJobRunStatusesResponse], error)
 	GetJobRunEvents(context.Context, *connect.Request[v1alpha1.GetJobRunEventsRequest]) (*connect.Response[v1alpha1.GetJobRunEventsResponse], error)
 	// Returns a specific job run, along with any of its pending activities
 	GetJobRun(context.Context, *connect.Request[v1alpha1.GetJobRunRequest]) (*connect.Response[v1alpha1.GetJobRunResponse], error)
+	// Returns the data quality scores computed for a completed job run
+	GetJobRunDataQuality(context.Context, *connect.Request[v1alpha1.GetJobRunDataQualityRequest]) (*connect.Response[v1alpha1.GetJobRunDataQualityResponse], error)
+	// Returns the rows that were read and written for each table of a completed job run.
+	// Runs that fail because of a row count discrepancy report it in the run's error instead
+	GetJobRunRowCounts(context.Context, *connect.Request[v1alpha1.GetJobRunRowCountsRequest]) (*connect.Response[v1alpha1.GetJobRunRowCountsResponse], error)
+	// Returns the batches that were planned and completed for each table of an in place mask job run. Available while the run is in progress
+	GetJobRunMaskingProgress(context.Context, *connect.Request[v1alpha1.GetJobRunMaskingProgressRequest]) (*connect.Response[v1alpha1.GetJobRunMaskingProgressResponse], error)
+	// Attaches a file, such as a schema diff or the DDL that was applied, to a job run. Used by the worker while the run is in progress
+	CreateJobRunArtifact(context.Context, *connect.Request[v1alpha1.CreateJobRunArtifactRequest]) (*connect.Response[v1alpha1.CreateJobRunArtifactResponse], error)
+	// Returns the artifacts that were attached to a job run
+	GetJobRunArtifacts(context.Context, *connect.Request[v1alpha1.GetJobRunArtifactsRequest]) (*connect.Response[v1alpha1.GetJobRunArtifactsResponse], error)
+	// Tags the AWS S3 output of a job run with a name so that it is retained and can be restored later
+	CreateJobRunSnapshot(context.Context, *connect.Request[v1alpha1.CreateJobRunSnapshotRequest]) (*connect.Response[v1alpha1.CreateJobRunSnapshotResponse], error)
+	// Returns a job run snapshot by its name
+	GetJobRunSnapshot(context.Context, *connect.Request[v1alpha1.GetJobRunSnapshotRequest]) (*connect.Response[v1alpha1.GetJobRunSnapshotResponse], error)
+	// Returns the job run snapshots of an account, optionally filtered by job
+	GetJobRunSnapshots(context.Context, *connect.Request[v1alpha1.GetJobRunSnapshotsRequest]) (*connect.Response[v1alpha1.GetJobRunSnapshotsResponse], error)
+	// Removes a job run snapshot. The job run's output is left as-is
+	DeleteJobRunSnapshot(context.Context, *connect.Request[v1alpha1.DeleteJobRunSnapshotRequest]) (*connect.Response[v1alpha1.DeleteJobRunSnapshotResponse], error)
 	DeleteJobRun(context.Context, *connect.Request[v1alpha1.DeleteJobRunRequest]) (*connect.Response[v1alpha1.DeleteJobRunResponse], error)
 	CreateJobRun(context.Context, *connect.Request[v1alpha1.CreateJobRunRequest]) (*connect.Response[v1alpha1.CreateJobRunResponse], error)
+	// Returns the priority class of a job run. Used by the worker when a run starts to pick its task queue
+	GetJobRunPriority(context.Context, *connect.Request[v1alpha1.GetJobRunPriorityRequest]) (*connect.Response[v1alpha1.GetJobRunPriorityResponse], error)
+	// Returns the values of the job's parameters for a job run. Used by the worker when a run starts to build its pipelines
+	GetJobRunParameters(context.Context, *connect.Request[v1alpha1.GetJobRunParametersRequest]) (*connect.Response[v1alpha1.GetJobRunParametersResponse], error)
+	// Predicts how long a run of the job would take and how much data it would move so that large runs can be scheduled off-hours
+	EstimateJobRun(context.Context, *connect.Request[v1alpha1.EstimateJobRunRequest]) (*connect.Response[v1alpha1.EstimateJobRunResponse], error)
 	CancelJobRun(context.Context, *connect.Request[v1alpha1.CancelJobRunRequest]) (*connect.Response[v1alpha1.CancelJobRunResponse], error)
 	TerminateJobRun(context.Context, *connect.Request[v1alpha1.TerminateJobRunRequest]) (*connect.Response[v1alpha1.TerminateJobRunResponse], error)
+	// Terminates a job run and waits until Temporal reports the workflow as closed. Used for runs whose workers have died and no longer respond to cancellation
+	ForceCancelJobRun(context.Context, *connect.Request[v1alpha1.ForceCancelJobRunRequest]) (*connect.Response[v1alpha1.ForceCancelJobRunResponse], error)
 	// Returns a stream of logs from the worker nodes that pertain to a specific job run
 	GetJobRunLogsStream(context.Context, *connect.Request[v1alpha1.GetJobRunLogsStreamRequest]) (*connect.ServerStreamForClient[v1alpha1.GetJobRunLogsStreamResponse], error)
 	// Set any job workflow options. Must provide entire object as is it will fully override the previous configuration
 	SetJobWorkflowOptions(context.Context, *connect.Request[v1alpha1.SetJobWorkflowOptionsRequest]) (*connect.Response[v1alpha1.SetJobWorkflowOptionsResponse], error)
 	// Set the job sync options. Must provide entire object as it will fully override the previous configuration
 	SetJobSyncOptions(context.Context, *connect.Request[v1alpha1.SetJobSyncOptionsRequest]) (*connect.Response[v1alpha1.SetJobSyncOptionsResponse], error)
+	// Set the raw processors that are attached to the pipelines of the job's tables. Fully overrides the previous table processors
+	SetJobTableProcessors(context.Context, *connect.Request[v1alpha1.SetJobTableProcessorsRequest]) (*connect.Response[v1alpha1.SetJobTableProcessorsResponse], error)
+	// Set the typed parameters whose values are supplied when a run of the job is triggered. Fully overrides the previous parameters
+	SetJobParameters(context.Context, *connect.Request[v1alpha1.SetJobParametersRequest]) (*connect.Response[v1alpha1.SetJobParametersResponse], error)
 	// validates that the jobmapping configured can run with table constraints
 	ValidateJobMappings(context.Context, *connect.Request[v1alpha1.ValidateJobMappingsRequest]) (*connect.Response[v1alpha1.ValidateJobMappingsResponse], error)
+	// Returns the pending and approved change requests for a job
+	GetJobChangeRequests(context.Context, *connect.Request[v1alpha1.GetJobChangeRequestsRequest]) (*connect.Response[v1alpha1.GetJobChangeRequestsResponse], error)
+	// Approves a pending job change and applies it to the job. The approver must not be the user that requested the change
+	ApproveJobChange(context.Context, *connect.Request[v1alpha1.ApproveJobChangeRequest]) (*connect.Response[v1alpha1.ApproveJobChangeResponse], error)
+	// Returns the settings that new jobs of the account inherit when they are created without them
+	GetAccountJobDefaults(context.Context, *connect.Request[v1alpha1.GetAccountJobDefaultsRequest]) (*connect.Response[v1alpha1.GetAccountJobDefaultsResponse], error)
+	// Replaces the settings that new jobs of the account inherit. Existing jobs keep their current settings
+	SetAccountJobDefaults(context.Context, *connect.Request[v1alpha1.SetAccountJobDefaultsRequest]) (*connect.Response[v1alpha1.SetAccountJobDefaultsResponse], error)
+	// Returns the jobs of the account whose settings differ from the account's job defaults
+	GetJobsOverridingDefaults(context.Context, *connect.Request[v1alpha1.GetJobsOverridingDefaultsRequest]) (*connect.Response[v1alpha1.GetJobsOverridingDefaultsResponse], error)
+	// Returns the built-in job templates
+	GetJobTemplates(context.Context, *connect.Request[v1alpha1.GetJobTemplatesRequest]) (*connect.Response[v1alpha1.GetJobTemplatesResponse], error)
+	// Creates a job from a built-in job template by substituting the provided variables
+	CreateJobFromTemplate(context.Context, *connect.Request[v1alpha1.CreateJobFromTemplateRequest]) (*connect.Response[v1alpha1.CreateJobFromTemplateResponse], error)
 }
 
 // NewJobServiceClient constructs a client for the mgmt.v1alpha1.JobService service. By default, it
@@ -216,6 +377,12 @@ func NewJobServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(jobServiceIsJobNameAvailableMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		setJobLabels: connect.NewClient[v1alpha1.SetJobLabelsRequest, v1alpha1.SetJobLabelsResponse](
+			httpClient,
+			baseURL+JobServiceSetJobLabelsProcedure,
+			connect.WithSchema(jobServiceSetJobLabelsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		updateJobSchedule: connect.NewClient[v1alpha1.UpdateJobScheduleRequest, v1alpha1.UpdateJobScheduleResponse](
 			httpClient,
 			baseURL+JobServiceUpdateJobScheduleProcedure,
@@ -228,6 +395,12 @@ func NewJobServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(jobServiceUpdateJobSourceConnectionMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		setJobMappings: connect.NewClient[v1alpha1.SetJobMappingsRequest, v1alpha1.SetJobMappingsResponse](
+			httpClient,
+			baseURL+JobServiceSetJobMappingsProcedure,
+			connect.WithSchema(jobServiceSetJobMappingsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		setJobSourceSqlConnectionSubsets: connect.NewClient[v1alpha1.SetJobSourceSqlConnectionSubsetsRequest, v1alpha1.SetJobSourceSqlConnectionSubsetsResponse](
 			httpClient,
 			baseURL+JobServiceSetJobSourceSqlConnectionSubsetsProcedure,
@@ -258,6 +431,12 @@ func NewJobServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(jobServicePauseJobMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		resumeJob: connect.NewClient[v1alpha1.ResumeJobRequest, v1alpha1.ResumeJobResponse](
+			httpClient,
+			baseURL+JobServiceResumeJobProcedure,
+			connect.WithSchema(jobServiceResumeJobMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getJobRecentRuns: connect.NewClient[v1alpha1.GetJobRecentRunsRequest, v1alpha1.GetJobRecentRunsResponse](
 			httpClient,
 			baseURL+JobServiceGetJobRecentRunsProcedure,
@@ -270,6 +449,12 @@ func NewJobServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(jobServiceGetJobNextRunsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getNextScheduledRuns: connect.NewClient[v1alpha1.GetNextScheduledRunsRequest, v1alpha1.GetNextScheduledRunsResponse](
+			httpClient,
+			baseURL+JobServiceGetNextScheduledRunsProcedure,
+			connect.WithSchema(jobServiceGetNextScheduledRunsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getJobStatus: connect.NewClient[v1alpha1.GetJobStatusRequest, v1alpha1.GetJobStatusResponse](
 			httpClient,
 			baseURL+JobServiceGetJobStatusProcedure,
@@ -288,6 +473,12 @@ func NewJobServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(jobServiceGetJobRunsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		streamJobRunStatuses: connect.NewClient[v1alpha1.StreamJobRunStatusesRequest, v1alpha1.StreamJobRunStatusesResponse](
+			httpClient,
+			baseURL+JobServiceStreamJobRunStatusesProcedure,
+			connect.WithSchema(jobServiceStreamJobRunStatusesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getJobRunEvents: connect.NewClient[v1alpha1.GetJobRunEventsRequest, v1alpha1.GetJobRunEventsResponse](
 			httpClient,
 			baseURL+JobServiceGetJobRunEventsProcedure,
@@ -300,6 +491,60 @@ func NewJobServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(jobServiceGetJobRunMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getJobRunDataQuality: connect.NewClient[v1alpha1.GetJobRunDataQualityRequest, v1alpha1.GetJobRunDataQualityResponse](
+			httpClient,
+			baseURL+JobServiceGetJobRunDataQualityProcedure,
+			connect.WithSchema(jobServiceGetJobRunDataQualityMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getJobRunRowCounts: connect.NewClient[v1alpha1.GetJobRunRowCountsRequest, v1alpha1.GetJobRunRowCountsResponse](
+			httpClient,
+			baseURL+JobServiceGetJobRunRowCountsProcedure,
+			connect.WithSchema(jobServiceGetJobRunRowCountsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getJobRunMaskingProgress: connect.NewClient[v1alpha1.GetJobRunMaskingProgressRequest, v1alpha1.GetJobRunMaskingProgressResponse](
+			httpClient,
+			baseURL+JobServiceGetJobRunMaskingProgressProcedure,
+			connect.WithSchema(jobServiceGetJobRunMaskingProgressMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createJobRunArtifact: connect.NewClient[v1alpha1.CreateJobRunArtifactRequest, v1alpha1.CreateJobRunArtifactResponse](
+			httpClient,
+			baseURL+JobServiceCreateJobRunArtifactProcedure,
+			connect.WithSchema(jobServiceCreateJobRunArtifactMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getJobRunArtifacts: connect.NewClient[v1alpha1.GetJobRunArtifactsRequest, v1alpha1.GetJobRunArtifactsResponse](
+			httpClient,
+			baseURL+JobServiceGetJobRunArtifactsProcedure,
+			connect.WithSchema(jobServiceGetJobRunArtifactsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createJobRunSnapshot: connect.NewClient[v1alpha1.CreateJobRunSnapshotRequest, v1alpha1.CreateJobRunSnapshotResponse](
+			httpClient,
+			baseURL+JobServiceCreateJobRunSnapshotProcedure,
+			connect.WithSchema(jobServiceCreateJobRunSnapshotMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getJobRunSnapshot: connect.NewClient[v1alpha1.GetJobRunSnapshotRequest, v1alpha1.GetJobRunSnapshotResponse](
+			httpClient,
+			baseURL+JobServiceGetJobRunSnapshotProcedure,
+			connect.WithSchema(jobServiceGetJobRunSnapshotMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getJobRunSnapshots: connect.NewClient[v1alpha1.GetJobRunSnapshotsRequest, v1alpha1.GetJobRunSnapshotsResponse](
+			httpClient,
+			baseURL+JobServiceGetJobRunSnapshotsProcedure,
+			connect.WithSchema(jobServiceGetJobRunSnapshotsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteJobRunSnapshot: connect.NewClient[v1alpha1.DeleteJobRunSnapshotRequest, v1alpha1.DeleteJobRunSnapshotResponse](
+			httpClient,
+			baseURL+JobServiceDeleteJobRunSnapshotProcedure,
+			connect.WithSchema(jobServiceDeleteJobRunSnapshotMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		deleteJobRun: connect.NewClient[v1alpha1.DeleteJobRunRequest, v1alpha1.DeleteJobRunResponse](
 			httpClient,
 			baseURL+JobServiceDeleteJobRunProcedure,
@@ -312,6 +557,24 @@ func NewJobServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(jobServiceCreateJobRunMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getJobRunPriority: connect.NewClient[v1alpha1.GetJobRunPriorityRequest, v1alpha1.GetJobRunPriorityResponse](
+			httpClient,
+			baseURL+JobServiceGetJobRunPriorityProcedure,
+			connect.WithSchema(jobServiceGetJobRunPriorityMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getJobRunParameters: connect.NewClient[v1alpha1.GetJobRunParametersRequest, v1alpha1.GetJobRunParametersResponse](
+			httpClient,
+			baseURL+JobServiceGetJobRunParametersProcedure,
+			connect.WithSchema(jobServiceGetJobRunParametersMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		estimateJobRun: connect.NewClient[v1alpha1.EstimateJobRunRequest, v1alpha1.EstimateJobRunResponse](
+			httpClient,
+			baseURL+JobServiceEstimateJobRunProcedure,
+			connect.WithSchema(jobServiceEstimateJobRunMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		cancelJobRun: connect.NewClient[v1alpha1.CancelJobRunRequest, v1alpha1.CancelJobRunResponse](
 			httpClient,
 			baseURL+JobServiceCancelJobRunProcedure,
@@ -324,6 +587,12 @@ func NewJobServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(jobServiceTerminateJobRunMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		forceCancelJobRun: connect.NewClient[v1alpha1.ForceCancelJobRunRequest, v1alpha1.ForceCancelJobRunResponse](
+			httpClient,
+			baseURL+JobServiceForceCancelJobRunProcedure,
+			connect.WithSchema(jobServiceForceCancelJobRunMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getJobRunLogsStream: connect.NewClient[v1alpha1.GetJobRunLogsStreamRequest, v1alpha1.GetJobRunLogsStreamResponse](
 			httpClient,
 			baseURL+JobServiceGetJobRunLogsStreamProcedure,
@@ -342,12 +611,66 @@ func NewJobServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(jobServiceSetJobSyncOptionsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		setJobTableProcessors: connect.NewClient[v1alpha1.SetJobTableProcessorsRequest, v1alpha1.SetJobTableProcessorsResponse](
+			httpClient,
+			baseURL+JobServiceSetJobTableProcessorsProcedure,
+			connect.WithSchema(jobServiceSetJobTableProcessorsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setJobParameters: connect.NewClient[v1alpha1.SetJobParametersRequest, v1alpha1.SetJobParametersResponse](
+			httpClient,
+			baseURL+JobServiceSetJobParametersProcedure,
+			connect.WithSchema(jobServiceSetJobParametersMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		validateJobMappings: connect.NewClient[v1alpha1.ValidateJobMappingsRequest, v1alpha1.ValidateJobMappingsResponse](
 			httpClient,
 			baseURL+JobServiceValidateJobMappingsProcedure,
 			connect.WithSchema(jobServiceValidateJobMappingsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getJobChangeRequests: connect.NewClient[v1alpha1.GetJobChangeRequestsRequest, v1alpha1.GetJobChangeRequestsResponse](
+			httpClient,
+			baseURL+JobServiceGetJobChangeRequestsProcedure,
+			connect.WithSchema(jobServiceGetJobChangeRequestsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		approveJobChange: connect.NewClient[v1alpha1.ApproveJobChangeRequest, v1alpha1.ApproveJobChangeResponse](
+			httpClient,
+			baseURL+JobServiceApproveJobChangeProcedure,
+			connect.WithSchema(jobServiceApproveJobChangeMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getAccountJobDefaults: connect.NewClient[v1alpha1.GetAccountJobDefaultsRequest, v1alpha1.GetAccountJobDefaultsResponse](
+			httpClient,
+			baseURL+JobServiceGetAccountJobDefaultsProcedure,
+			connect.WithSchema(jobServiceGetAccountJobDefaultsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setAccountJobDefaults: connect.NewClient[v1alpha1.SetAccountJobDefaultsRequest, v1alpha1.SetAccountJobDefaultsResponse](
+			httpClient,
+			baseURL+JobServiceSetAccountJobDefaultsProcedure,
+			connect.WithSchema(jobServiceSetAccountJobDefaultsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getJobsOverridingDefaults: connect.NewClient[v1alpha1.GetJobsOverridingDefaultsRequest, v1alpha1.GetJobsOverridingDefaultsResponse](
+			httpClient,
+			baseURL+JobServiceGetJobsOverridingDefaultsProcedure,
+			connect.WithSchema(jobServiceGetJobsOverridingDefaultsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getJobTemplates: connect.NewClient[v1alpha1.GetJobTemplatesRequest, v1alpha1.GetJobTemplatesResponse](
+			httpClient,
+			baseURL+JobServiceGetJobTemplatesProcedure,
+			connect.WithSchema(jobServiceGetJobTemplatesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createJobFromTemplate: connect.NewClient[v1alpha1.CreateJobFromTemplateRequest, v1alpha1.CreateJobFromTemplateResponse](
+			httpClient,
+			baseURL+JobServiceCreateJobFromTemplateProcedure,
+			connect.WithSchema(jobServiceCreateJobFromTemplateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -358,28 +681,55 @@ type jobServiceClient struct {
 	createJob                        *connect.Client[v1alpha1.CreateJobRequest, v1alpha1.CreateJobResponse]
 	deleteJob                        *connect.Client[v1alpha1.DeleteJobRequest, v1alpha1.DeleteJobResponse]
 	isJobNameAvailable               *connect.Client[v1alpha1.IsJobNameAvailableRequest, v1alpha1.IsJobNameAvailableResponse]
+	setJobLabels                     *connect.Client[v1alpha1.SetJobLabelsRequest, v1alpha1.SetJobLabelsResponse]
 	updateJobSchedule                *connect.Client[v1alpha1.UpdateJobScheduleRequest, v1alpha1.UpdateJobScheduleResponse]
 	updateJobSourceConnection        *connect.Client[v1alpha1.UpdateJobSourceConnectionRequest, v1alpha1.UpdateJobSourceConnectionResponse]
+	setJobMappings                   *connect.Client[v1alpha1.SetJobMappingsRequest, v1alpha1.SetJobMappingsResponse]
 	setJobSourceSqlConnectionSubsets *connect.Client[v1alpha1.SetJobSourceSqlConnectionSubsetsRequest, v1alpha1.SetJobSourceSqlConnectionSubsetsResponse]
 	updateJobDestinationConnection   *connect.Client[v1alpha1.UpdateJobDestinationConnectionRequest, v1alpha1.UpdateJobDestinationConnectionResponse]
 	deleteJobDestinationConnection   *connect.Client[v1alpha1.DeleteJobDestinationConnectionRequest, v1alpha1.DeleteJobDestinationConnectionResponse]
 	createJobDestinationConnections  *connect.Client[v1alpha1.CreateJobDestinationConnectionsRequest, v1alpha1.CreateJobDestinationConnectionsResponse]
 	pauseJob                         *connect.Client[v1alpha1.PauseJobRequest, v1alpha1.PauseJobResponse]
+	resumeJob                        *connect.Client[v1alpha1.ResumeJobRequest, v1alpha1.ResumeJobResponse]
 	getJobRecentRuns                 *connect.Client[v1alpha1.GetJobRecentRunsRequest, v1alpha1.GetJobRecentRunsResponse]
 	getJobNextRuns                   *connect.Client[v1alpha1.GetJobNextRunsRequest, v1alpha1.GetJobNextRunsResponse]
+	getNextScheduledRuns             *connect.Client[v1alpha1.GetNextScheduledRunsRequest, v1alpha1.GetNextScheduledRunsResponse]
 	getJobStatus                     *connect.Client[v1alpha1.GetJobStatusRequest, v1alpha1.GetJobStatusResponse]
 	getJobStatuses                   *connect.Client[v1alpha1.GetJobStatusesRequest, v1alpha1.GetJobStatusesResponse]
 	getJobRuns                       *connect.Client[v1alpha1.GetJobRunsRequest, v1alpha1.GetJobRunsResponse]
+	streamJobRunStatuses             *connect.Client[v1alpha1.StreamJobRunStatusesRequest, v1alpha1.StreamJobRunStatusesResponse]
 	getJobRunEvents                  *connect.Client[v1alpha1.GetJobRunEventsRequest, v1alpha1.GetJobRunEventsResponse]
 	getJobRun                        *connect.Client[v1alpha1.GetJobRunRequest, v1alpha1.GetJobRunResponse]
+	getJobRunDataQuality             *connect.Client[v1alpha1.GetJobRunDataQualityRequest, v1alpha1.GetJobRunDataQualityResponse]
+	getJobRunRowCounts               *connect.Client[v1alpha1.GetJobRunRowCountsRequest, v1alpha1.GetJobRunRowCountsResponse]
+	getJobRunMaskingProgress         *connect.Client[v1alpha1.GetJobRunMaskingProgressRequest, v1alpha1.GetJobRunMaskingProgressResponse]
+	createJobRunArtifact             *connect.Client[v1alpha1.CreateJobRunArtifactRequest, v1alpha1.CreateJobRunArtifactResponse]
+	getJobRunArtifacts               *connect.Client[v1alpha1.GetJobRunArtifactsRequest, v1alpha1.GetJobRunArtifactsResponse]
+	createJobRunSnapshot             *connect.Client[v1alpha1.CreateJobRunSnapshotRequest, v1alpha1.CreateJobRunSnapshotResponse]
+	getJobRunSnapshot                *connect.Client[v1alpha1.GetJobRunSnapshotRequest, v1alpha1.GetJobRunSnapshotResponse]
+	getJobRunSnapshots               *connect.Client[v1alpha1.GetJobRunSnapshotsRequest, v1alpha1.GetJobRunSnapshotsResponse]
+	deleteJobRunSnapshot             *connect.Client[v1alpha1.DeleteJobRunSnapshotRequest, v1alpha1.DeleteJobRunSnapshotResponse]
 	deleteJobRun                     *connect.Client[v1alpha1.DeleteJobRunRequest, v1alpha1.DeleteJobRunResponse]
 	createJobRun                     *connect.Client[v1alpha1.CreateJobRunRequest, v1alpha1.CreateJobRunResponse]
+	getJobRunPriority                *connect.Client[v1alpha1.GetJobRunPriorityRequest, v1alpha1.GetJobRunPriorityResponse]
+	getJobRunParameters              *connect.Client[v1alpha1.GetJobRunParametersRequest, v1alpha1.GetJobRunParametersResponse]
+	estimateJobRun                   *connect.Client[v1alpha1.EstimateJobRunRequest, v1alpha1.EstimateJobRunResponse]
 	cancelJobRun                     *connect.Client[v1alpha1.CancelJobRunRequest, v1alpha1.CancelJobRunResponse]
 	terminateJobRun                  *connect.Client[v1alpha1.TerminateJobRunRequest, v1alpha1.TerminateJobRunResponse]
+	forceCancelJobRun                *connect.Client[v1alpha1.ForceCancelJobRunRequest, v1alpha1.ForceCancelJobRunResponse]
 	getJobRunLogsStream              *connect.Client[v1alpha1.GetJobRunLogsStreamRequest, v1alpha1.GetJobRunLogsStreamResponse]
 	setJobWorkflowOptions            *connect.Client[v1alpha1.SetJobWorkflowOptionsRequest, v1alpha1.SetJobWorkflowOptionsResponse]
 	setJobSyncOptions                *connect.Client[v1alpha1.SetJobSyncOptionsRequest, v1alpha1.SetJobSyncOptionsResponse]
+	setJobTableProcessors            *connect.Client[v1alpha1.SetJobTableProcessorsRequest, v1alpha1.SetJobTableProcessorsResponse]
+	setJobParameters                 *connect.Client[v1alpha1.SetJobParametersRequest, v1alpha1.SetJobParametersResponse]
 	validateJobMappings              *connect.Client[v1alpha1.ValidateJobMappingsRequest, v1alpha1.ValidateJobMappingsResponse]
+	getJobChangeRequests             *connect.Client[v1alpha1.GetJobChangeRequestsRequest, v1alpha1.GetJobChangeRequestsResponse]
+	approveJobChange                 *connect.Client[v1alpha1.ApproveJobChangeRequest, v1alpha1.ApproveJobChangeResponse]
+	getAccountJobDefaults            *connect.Client[v1alpha1.GetAccountJobDefaultsRequest, v1alpha1.GetAccountJobDefaultsResponse]
+	setAccountJobDefaults            *connect.Client[v1alpha1.SetAccountJobDefaultsRequest, v1alpha1.SetAccountJobDefaultsResponse]
+	getJobsOverridingDefaults        *connect.Client[v1alpha1.GetJobsOverridingDefaultsRequest, v1alpha1.GetJobsOverridingDefaultsResponse]
+	getJobTemplates                  *connect.Client[v1alpha1.GetJobTemplatesRequest, v1alpha1.GetJobTemplatesResponse]
+	createJobFromTemplate            *connect.Client[v1alpha1.CreateJobFromTemplateRequest, v1alpha1.CreateJobFromTemplateResponse]
 }
 
 // GetJobs calls mgmt.v1alpha1.JobService.GetJobs.
@@ -407,6 +757,11 @@ func (c *jobServiceClient) IsJobNameAvailable(ctx context.Context, req *connect.
 	return c.isJobNameAvailable.CallUnary(ctx, req)
 }
 
+// SetJobLabels calls mgmt.v1alpha1.JobService.SetJobLabels.
+func (c *jobServiceClient) SetJobLabels(ctx context.Context, req *connect.Request[v1alpha1.SetJobLabelsRequest]) (*connect.Response[v1alpha1.SetJobLabelsResponse], error) {
+	return c.setJobLabels.CallUnary(ctx, req)
+}
+
 // UpdateJobSchedule calls mgmt.v1alpha1.JobService.UpdateJobSchedule.
 func (c *jobServiceClient) UpdateJobSchedule(ctx context.Context, req *connect.Request[v1alpha1.UpdateJobScheduleRequest]) (*connect.Response[v1alpha1.UpdateJobScheduleResponse], error) {
 	return c.updateJobSchedule.CallUnary(ctx, req)
@@ -417,6 +772,11 @@ func (c *jobServiceClient) UpdateJobSourceConnection(ctx context.Context, req *c
 	return c.updateJobSourceConnection.CallUnary(ctx, req)
 }
 
+// SetJobMappings calls mgmt.v1alpha1.JobService.SetJobMappings.
+func (c *jobServiceClient) SetJobMappings(ctx context.Context, req *connect.Request[v1alpha1.SetJobMappingsRequest]) (*connect.Response[v1alpha1.SetJobMappingsResponse], error) {
+	return c.setJobMappings.CallUnary(ctx, req)
+}
+
 // SetJobSourceSqlConnectionSubsets calls mgmt.v1alpha1.JobService.SetJobSourceSqlConnectionSubsets.
 func (c *jobServiceClient) SetJobSourceSqlConnectionSubsets(ctx context.Context, req *connect.Request[v1alpha1.SetJobSourceSqlConnectionSubsetsRequest]) (*connect.Response[v1alpha1.SetJobSourceSqlConnectionSubsetsResponse], error) {
 	return c.setJobSourceSqlConnectionSubsets.CallUnary(ctx, req)
@@ -442,6 +802,11 @@ func (c *jobServiceClient) PauseJob(ctx context.Context, req *connect.Request[v1
 	return c.pauseJob.CallUnary(ctx, req)
 }
 
+// ResumeJob calls mgmt.v1alpha1.JobService.ResumeJob.
+func (c *jobServiceClient) ResumeJob(ctx context.Context, req *connect.Request[v1alpha1.ResumeJobRequest]) (*connect.Response[v1alpha1.ResumeJobResponse], error) {
+	return c.resumeJob.CallUnary(ctx, req)
+}
+
 // GetJobRecentRuns calls mgmt.v1alpha1.JobService.GetJobRecentRuns.
 func (c *jobServiceClient) GetJobRecentRuns(ctx context.Context, req *connect.Request[v1alpha1.GetJobRecentRunsRequest]) (*connect.Response[v1alpha1.GetJobRecentRunsResponse], error) {
 	return c.getJobRecentRuns.CallUnary(ctx, req)
@@ -452,6 +817,11 @@ func (c *jobServiceClient) GetJobNextRuns(ctx context.Context, req *connect.Requ
 	return c.getJobNextRuns.CallUnary(ctx, req)
 }
 
+// GetNextScheduledRuns calls mgmt.v1alpha1.JobService.GetNextScheduledRuns.
+func (c *jobServiceClient) GetNextScheduledRuns(ctx context.Context, req *connect.Request[v1alpha1.GetNextScheduledRunsRequest]) (*connect.Response[v1alpha1.GetNextScheduledRunsResponse], error) {
+	return c.getNextScheduledRuns.CallUnary(ctx, req)
+}
+
 // GetJobStatus calls mgmt.v1alpha1.JobService.GetJobStatus.
 func (c *jobServiceClient) GetJobStatus(ctx context.Context, req *connect.Request[v1alpha1.GetJobStatusRequest]) (*connect.Response[v1alpha1.GetJobStatusResponse], error) {
 	return c.getJobStatus.CallUnary(ctx, req)
@@ -467,6 +837,11 @@ func (c *jobServiceClient) GetJobRuns(ctx context.Context, req *connect.Request[
 	return c.getJobRuns.CallUnary(ctx, req)
 }
 
+// StreamJobRunStatuses calls mgmt.v1alpha1.JobService.StreamJobRunStatuses.
+func (c *jobServiceClient) StreamJobRunStatuses(ctx context.Context, req *connect.Request[v1alpha1.StreamJobRunStatusesRequest]) (*connect.ServerStreamForClient[v1alpha1.StreamJobRunStatusesResponse], error) {
+	return c.streamJobRunStatuses.CallServerStream(ctx, req)
+}
+
 // GetJobRunEvents calls mgmt.v1alpha1.JobService.GetJobRunEvents.
 func (c *jobServiceClient) GetJobRunEvents(ctx context.Context, req *connect.Request[v1alpha1.GetJobRunEventsRequest]) (*connect.Response[v1alpha1.GetJobRunEventsResponse], error) {
 	return c.getJobRunEvents.CallUnary(ctx, req)
@@ -477,6 +852,51 @@ func (c *jobServiceClient) GetJobRun(ctx context.Context, req *connect.Request[v
 	return c.getJobRun.CallUnary(ctx, req)
 }
 
+// GetJobRunDataQuality calls mgmt.v1alpha1.JobService.GetJobRunDataQuality.
+func (c *jobServiceClient) GetJobRunDataQuality(ctx context.Context, req *connect.Request[v1alpha1.GetJobRunDataQualityRequest]) (*connect.Response[v1alpha1.GetJobRunDataQualityResponse], error) {
+	return c.getJobRunDataQuality.CallUnary(ctx, req)
+}
+
+// GetJobRunRowCounts calls mgmt.v1alpha1.JobService.GetJobRunRowCounts.
+func (c *jobServiceClient) GetJobRunRowCounts(ctx context.Context, req *connect.Request[v1alpha1.GetJobRunRowCountsRequest]) (*connect.Response[v1alpha1.GetJobRunRowCountsResponse], error) {
+	return c.getJobRunRowCounts.CallUnary(ctx, req)
+}
+
+// GetJobRunMaskingProgress calls mgmt.v1alpha1.JobService.GetJobRunMaskingProgress.
+func (c *jobServiceClient) GetJobRunMaskingProgress(ctx context.Context, req *connect.Request[v1alpha1.GetJobRunMaskingProgressRequest]) (*connect.Response[v1alpha1.GetJobRunMaskingProgressResponse], error) {
+	return c.getJobRunMaskingProgress.CallUnary(ctx, req)
+}
+
+// CreateJobRunArtifact calls mgmt.v1alpha1.JobService.CreateJobRunArtifact.
+func (c *jobServiceClient) CreateJobRunArtifact(ctx context.Context, req *connect.Request[v1alpha1.CreateJobRunArtifactRequest]) (*connect.Response[v1alpha1.CreateJobRunArtifactResponse], error) {
+	return c.createJobRunArtifact.CallUnary(ctx, req)
+}
+
+// GetJobRunArtifacts calls mgmt.v1alpha1.JobService.GetJobRunArtifacts.
+func (c *jobServiceClient) GetJobRunArtifacts(ctx context.Context, req *connect.Request[v1alpha1.GetJobRunArtifactsRequest]) (*connect.Response[v1alpha1.GetJobRunArtifactsResponse], error) {
+	return c.getJobRunArtifacts.CallUnary(ctx, req)
+}
+
+// CreateJobRunSnapshot calls mgmt.v1alpha1.JobService.CreateJobRunSnapshot.
+func (c *jobServiceClient) CreateJobRunSnapshot(ctx context.Context, req *connect.Request[v1alpha1.CreateJobRunSnapshotRequest]) (*connect.Response[v1alpha1.CreateJobRunSnapshotResponse], error) {
+	return c.createJobRunSnapshot.CallUnary(ctx, req)
+}
+
+// GetJobRunSnapshot calls mgmt.v1alpha1.JobService.GetJobRunSnapshot.
+func (c *jobServiceClient) GetJobRunSnapshot(ctx context.Context, req *connect.Request[v1alpha1.GetJobRunSnapshotRequest]) (*connect.Response[v1alpha1.GetJobRunSnapshotResponse], error) {
+	return c.getJobRunSnapshot.CallUnary(ctx, req)
+}
+
+// GetJobRunSnapshots calls mgmt.v1alpha1.JobService.GetJobRunSnapshots.
+func (c *jobServiceClient) GetJobRunSnapshots(ctx context.Context, req *connect.Request[v1alpha1.GetJobRunSnapshotsRequest]) (*connect.Response[v1alpha1.GetJobRunSnapshotsResponse], error) {
+	return c.getJobRunSnapshots.CallUnary(ctx, req)
+}
+
+// DeleteJobRunSnapshot calls mgmt.v1alpha1.JobService.DeleteJobRunSnapshot.
+func (c *jobServiceClient) DeleteJobRunSnapshot(ctx context.Context, req *connect.Request[v1alpha1.DeleteJobRunSnapshotRequest]) (*connect.Response[v1alpha1.DeleteJobRunSnapshotResponse], error) {
+	return c.deleteJobRunSnapshot.CallUnary(ctx, req)
+}
+
 // DeleteJobRun calls mgmt.v1alpha1.JobService.DeleteJobRun.
 func (c *jobServiceClient) DeleteJobRun(ctx context.Context, req *connect.Request[v1alpha1.DeleteJobRunRequest]) (*connect.Response[v1alpha1.DeleteJobRunResponse], error) {
 	return c.deleteJobRun.CallUnary(ctx, req)
@@ -487,6 +907,21 @@ func (c *jobServiceClient) CreateJobRun(ctx context.Context, req *connect.Reques
 	return c.createJobRun.CallUnary(ctx, req)
 }
 
+// GetJobRunPriority calls mgmt.v1alpha1.JobService.GetJobRunPriority.
+func (c *jobServiceClient) GetJobRunPriority(ctx context.Context, req *connect.Request[v1alpha1.GetJobRunPriorityRequest]) (*connect.Response[v1alpha1.GetJobRunPriorityResponse], error) {
+	return c.getJobRunPriority.CallUnary(ctx, req)
+}
+
+// GetJobRunParameters calls mgmt.v1alpha1.JobService.GetJobRunParameters.
+func (c *jobServiceClient) GetJobRunParameters(ctx context.Context, req *connect.Request[v1alpha1.GetJobRunParametersRequest]) (*connect.Response[v1alpha1.GetJobRunParametersResponse], error) {
+	return c.getJobRunParameters.CallUnary(ctx, req)
+}
+
+// EstimateJobRun calls mgmt.v1alpha1.JobService.EstimateJobRun.
+func (c *jobServiceClient) EstimateJobRun(ctx context.Context, req *connect.Request[v1alpha1.EstimateJobRunRequest]) (*connect.Response[v1alpha1.EstimateJobRunResponse], error) {
+	return c.estimateJobRun.CallUnary(ctx, req)
+}
+
 // CancelJobRun calls mgmt.v1alpha1.JobService.CancelJobRun.
 func (c *jobServiceClient) CancelJobRun(ctx context.Context, req *connect.Request[v1alpha1.CancelJobRunRequest]) (*connect.Response[v1alpha1.CancelJobRunResponse], error) {
 	return c.cancelJobRun.CallUnary(ctx, req)
@@ -497,6 +932,11 @@ func (c *jobServiceClient) TerminateJobRun(ctx context.Context, req *connect.Req
 	return c.terminateJobRun.CallUnary(ctx, req)
 }
 
+// ForceCancelJobRun calls mgmt.v1alpha1.JobService.ForceCancelJobRun.
+func (c *jobServiceClient) ForceCancelJobRun(ctx context.Context, req *connect.Request[v1alpha1.ForceCancelJobRunRequest]) (*connect.Response[v1alpha1.ForceCancelJobRunResponse], error) {
+	return c.forceCancelJobRun.CallUnary(ctx, req)
+}
+
 // GetJobRunLogsStream calls mgmt.v1alpha1.JobService.GetJobRunLogsStream.
 func (c *jobServiceClient) GetJobRunLogsStream(ctx context.Context, req *connect.Request[v1alpha1.GetJobRunLogsStreamRequest]) (*connect.ServerStreamForClient[v1alpha1.GetJobRunLogsStreamResponse], error) {
 	return c.getJobRunLogsStream.CallServerStream(ctx, req)
@@ -512,11 +952,56 @@ func (c *jobServiceClient) SetJobSyncOptions(ctx context.Context, req *connect.R
 	return c.setJobSyncOptions.CallUnary(ctx, req)
 }
 
+// SetJobTableProcessors calls mgmt.v1alpha1.JobService.SetJobTableProcessors.
+func (c *jobServiceClient) SetJobTableProcessors(ctx context.Context, req *connect.Request[v1alpha1.SetJobTableProcessorsRequest]) (*connect.Response[v1alpha1.SetJobTableProcessorsResponse], error) {
+	return c.setJobTableProcessors.CallUnary(ctx, req)
+}
+
+// SetJobParameters calls mgmt.v1alpha1.JobService.SetJobParameters.
+func (c *jobServiceClient) SetJobParameters(ctx context.Context, req *connect.Request[v1alpha1.SetJobParametersRequest]) (*connect.Response[v1alpha1.SetJobParametersResponse], error) {
+	return c.setJobParameters.CallUnary(ctx, req)
+}
+
 // ValidateJobMappings calls mgmt.v1alpha1.JobService.ValidateJobMappings.
 func (c *jobServiceClient) ValidateJobMappings(ctx context.Context, req *connect.Request[v1alpha1.ValidateJobMappingsRequest]) (*connect.Response[v1alpha1.ValidateJobMappingsResponse], error) {
 	return c.validateJobMappings.CallUnary(ctx, req)
 }
 
+// GetJobChangeRequests calls mgmt.v1alpha1.JobService.GetJobChangeRequests.
+func (c *jobServiceClient) GetJobChangeRequests(ctx context.Context, req *connect.Request[v1alpha1.GetJobChangeRequestsRequest]) (*connect.Response[v1alpha1.GetJobChangeRequestsResponse], error) {
+	return c.getJobChangeRequests.CallUnary(ctx, req)
+}
+
+// ApproveJobChange calls mgmt.v1alpha1.JobService.ApproveJobChange.
+func (c *jobServiceClient) ApproveJobChange(ctx context.Context, req *connect.Request[v1alpha1.ApproveJobChangeRequest]) (*connect.Response[v1alpha1.ApproveJobChangeResponse], error) {
+	return c.approveJobChange.CallUnary(ctx, req)
+}
+
+// GetAccountJobDefaults calls mgmt.v1alpha1.JobService.GetAccountJobDefaults.
+func (c *jobServiceClient) GetAccountJobDefaults(ctx context.Context, req *connect.Request[v1alpha1.GetAccountJobDefaultsRequest]) (*connect.Response[v1alpha1.GetAccountJobDefaultsResponse], error) {
+	return c.getAccountJobDefaults.CallUnary(ctx, req)
+}
+
+// SetAccountJobDefaults calls mgmt.v1alpha1.JobService.SetAccountJobDefaults.
+func (c *jobServiceClient) SetAccountJobDefaults(ctx context.Context, req *connect.Request[v1alpha1.SetAccountJobDefaultsRequest]) (*connect.Response[v1alpha1.SetAccountJobDefaultsResponse], error) {
+	return c.setAccountJobDefaults.CallUnary(ctx, req)
+}
+
+// GetJobsOverridingDefaults calls mgmt.v1alpha1.JobService.GetJobsOverridingDefaults.
+func (c *jobServiceClient) GetJobsOverridingDefaults(ctx context.Context, req *connect.Request[v1alpha1.GetJobsOverridingDefaultsRequest]) (*connect.Response[v1alpha1.GetJobsOverridingDefaultsResponse], error) {
+	return c.getJobsOverridingDefaults.CallUnary(ctx, req)
+}
+
+// GetJobTemplates calls mgmt.v1alpha1.JobService.GetJobTemplates.
+func (c *jobServiceClient) GetJobTemplates(ctx context.Context, req *connect.Request[v1alpha1.GetJobTemplatesRequest]) (*connect.Response[v1alpha1.GetJobTemplatesResponse], error) {
+	return c.getJobTemplates.CallUnary(ctx, req)
+}
+
+// CreateJobFromTemplate calls mgmt.v1alpha1.JobService.CreateJobFromTemplate.
+func (c *jobServiceClient) CreateJobFromTemplate(ctx context.Context, req *connect.Request[v1alpha1.CreateJobFromTemplateRequest]) (*connect.Response[v1alpha1.CreateJobFromTemplateResponse], error) {
+	return c.createJobFromTemplate.CallUnary(ctx, req)
+}
+
 // JobServiceHandler is an implementation of the mgmt.v1alpha1.JobService service.
 type JobServiceHandler interface {
 	GetJobs(context.Context, *connect.Request[v1alpha1.GetJobsRequest]) (*connect.Response[v1alpha1.GetJobsResponse], error)
@@ -524,36 +1009,91 @@ type JobServiceHandler interface {
 	CreateJob(context.Context, *connect.Request[v1alpha1.CreateJobRequest]) (*connect.Response[v1alpha1.CreateJobResponse], error)
 	DeleteJob(context.Context, *connect.Request[v1alpha1.DeleteJobRequest]) (*connect.Response[v1alpha1.DeleteJobResponse], error)
 	IsJobNameAvailable(context.Context, *connect.Request[v1alpha1.IsJobNameAvailableRequest]) (*connect.Response[v1alpha1.IsJobNameAvailableResponse], error)
+	// Replaces the labels on a job
+	SetJobLabels(context.Context, *connect.Request[v1alpha1.SetJobLabelsRequest]) (*connect.Response[v1alpha1.SetJobLabelsResponse], error)
 	UpdateJobSchedule(context.Context, *connect.Request[v1alpha1.UpdateJobScheduleRequest]) (*connect.Response[v1alpha1.UpdateJobScheduleResponse], error)
 	UpdateJobSourceConnection(context.Context, *connect.Request[v1alpha1.UpdateJobSourceConnectionRequest]) (*connect.Response[v1alpha1.UpdateJobSourceConnectionResponse], error)
+	// Sets many job mappings at once, either by replacing all of them or by patching individual columns. The change is applied all-or-nothing
+	SetJobMappings(context.Context, *connect.Request[v1alpha1.SetJobMappingsRequest]) (*connect.Response[v1alpha1.SetJobMappingsResponse], error)
 	SetJobSourceSqlConnectionSubsets(context.Context, *connect.Request[v1alpha1.SetJobSourceSqlConnectionSubsetsRequest]) (*connect.Response[v1alpha1.SetJobSourceSqlConnectionSubsetsResponse], error)
 	UpdateJobDestinationConnection(context.Context, *connect.Request[v1alpha1.UpdateJobDestinationConnectionRequest]) (*connect.Response[v1alpha1.UpdateJobDestinationConnectionResponse], error)
 	DeleteJobDestinationConnection(context.Context, *connect.Request[v1alpha1.DeleteJobDestinationConnectionRequest]) (*connect.Response[v1alpha1.DeleteJobDestinationConnectionResponse], error)
 	CreateJobDestinationConnections(context.Context, *connect.Request[v1alpha1.CreateJobDestinationConnectionsRequest]) (*connect.Response[v1alpha1.CreateJobDestinationConnectionsResponse], error)
 	PauseJob(context.Context, *connect.Request[v1alpha1.PauseJobRequest]) (*connect.Response[v1alpha1.PauseJobResponse], error)
+	// Resumes a paused job schedule. Equivalent to PauseJob with pause set to false
+	ResumeJob(context.Context, *connect.Request[v1alpha1.ResumeJobRequest]) (*connect.Response[v1alpha1.ResumeJobResponse], error)
 	// Returns a list of recently invoked job runs baseds on the Temporal cron scheduler. This will return a list of job runs that include archived runs
 	GetJobRecentRuns(context.Context, *connect.Request[v1alpha1.GetJobRecentRunsRequest]) (*connect.Response[v1alpha1.GetJobRecentRunsResponse], error)
 	// Returns a list of runs that are scheduled for execution based on the Temporal cron scheduler.
 	GetJobNextRuns(context.Context, *connect.Request[v1alpha1.GetJobNextRunsRequest]) (*connect.Response[v1alpha1.GetJobNextRunsResponse], error)
+	// Returns the next N fire times of the job's schedule as computed by Temporal, after the schedule's timezone and jitter are applied
+	GetNextScheduledRuns(context.Context, *connect.Request[v1alpha1.GetNextScheduledRunsRequest]) (*connect.Response[v1alpha1.GetNextScheduledRunsResponse], error)
 	GetJobStatus(context.Context, *connect.Request[v1alpha1.GetJobStatusRequest]) (*connect.Response[v1alpha1.GetJobStatusResponse], error)
 	GetJobStatuses(context.Context, *connect.Request[v1alpha1.GetJobStatusesRequest]) (*connect.Response[v1alpha1.GetJobStatusesResponse], error)
 	// Returns a list of job runs by either account or job
 	GetJobRuns(context.Context, *connect.Request[v1alpha1.GetJobRunsRequest]) (*connect.Response[v1alpha1.GetJobRunsResponse], error)
+	// Returns a stream of job runs as their statuses change, so that clients do not need to poll GetJobRuns
+	StreamJobRunStatuses(context.Context, *connect.Request[v1alpha1.StreamJobRunStatusesRequest], *connect.ServerStream[v1alpha1.StreamJobRunStatusesResponse]) error
 	GetJobRunEvents(context.Context, *connect.Request[v1alpha1.GetJobRunEventsRequest]) (*connect.Response[v1alpha1.GetJobRunEventsResponse], error)
 	// Returns a specific job run, along with any of its pending activities
 	GetJobRun(context.Context, *connect.Request[v1alpha1.GetJobRunRequest]) (*connect.Response[v1alpha1.GetJobRunResponse], error)
+	// Returns the data quality scores computed for a completed job run
+	GetJobRunDataQuality(context.Context, *connect.Request[v1alpha1.GetJobRunDataQualityRequest]) (*connect.Response[v1alpha1.GetJobRunDataQualityResponse], error)
+	// Returns the rows that were read and written for each table of a completed job run.
+	// Runs that fail because of a row count discrepancy report it in the run's error instead
+	GetJobRunRowCounts(context.Context, *connect.Request[v1alpha1.GetJobRunRowCountsRequest]) (*connect.Response[v1alpha1.GetJobRunRowCountsResponse], error)
+	// Returns the batches that were planned and completed for each table of an in place mask job run. Available while the run is in progress
+	GetJobRunMaskingProgress(context.Context, *connect.Request[v1alpha1.GetJobRunMaskingProgressRequest]) (*connect.Response[v1alpha1.GetJobRunMaskingProgressResponse], error)
+	// Attaches a file, such as a schema diff or the DDL that was applied, to a job run. Used by the worker while the run is in progress
+	CreateJobRunArtifact(context.Context, *connect.Request[v1alpha1.CreateJobRunArtifactRequest]) (*connect.Response[v1alpha1.CreateJobRunArtifactResponse], error)
+	// Returns the artifacts that were attached to a job run
+	GetJobRunArtifacts(context.Context, *connect.Request[v1alpha1.GetJobRunArtifactsRequest]) (*connect.Response[v1alpha1.GetJobRunArtifactsResponse], error)
+	// Tags the AWS S3 output of a job run with a name so that it is retained and can be restored later
+	CreateJobRunSnapshot(context.Context, *connect.Request[v1alpha1.CreateJobRunSnapshotRequest]) (*connect.Response[v1alpha1.CreateJobRunSnapshotResponse], error)
+	// Returns a job run snapshot by its name
+	GetJobRunSnapshot(context.Context, *connect.Request[v1alpha1.GetJobRunSnapshotRequest]) (*connect.Response[v1alpha1.GetJobRunSnapshotResponse], error)
+	// Returns the job run snapshots of an account, optionally filtered by job
+	GetJobRunSnapshots(context.Context, *connect.Request[v1alpha1.GetJobRunSnapshotsRequest]) (*connect.Response[v1alpha1.GetJobRunSnapshotsResponse], error)
+	// Removes a job run snapshot. The job run's output is left as-is
+	DeleteJobRunSnapshot(context.Context, *connect.Request[v1alpha1.DeleteJobRunSnapshotRequest]) (*connect.Response[v1alpha1.DeleteJobRunSnapshotResponse], error)
 	DeleteJobRun(context.Context, *connect.Request[v1alpha1.DeleteJobRunRequest]) (*connect.Response[v1alpha1.DeleteJobRunResponse], error)
 	CreateJobRun(context.Context, *connect.Request[v1alpha1.CreateJobRunRequest]) (*connect.Response[v1alpha1.CreateJobRunResponse], error)
+	// Returns the priority class of a job run. Used by the worker when a run starts to pick its task queue
+	GetJobRunPriority(context.Context, *connect.Request[v1alpha1.GetJobRunPriorityRequest]) (*connect.Response[v1alpha1.GetJobRunPriorityResponse], error)
+	// Returns the values of the job's parameters for a job run. Used by the worker when a run starts to build its pipelines
+	GetJobRunParameters(context.Context, *connect.Request[v1alpha1.GetJobRunParametersRequest]) (*connect.Response[v1alpha1.GetJobRunParametersResponse], error)
+	// Predicts how long a run of the job would take and how much data it would move so that large runs can be scheduled off-hours
+	EstimateJobRun(context.Context, *connect.Request[v1alpha1.EstimateJobRunRequest]) (*connect.Response[v1alpha1.EstimateJobRunResponse], error)
 	CancelJobRun(context.Context, *connect.Request[v1alpha1.CancelJobRunRequest]) (*connect.Response[v1alpha1.CancelJobRunResponse], error)
 	TerminateJobRun(context.Context, *connect.Request[v1alpha1.TerminateJobRunRequest]) (*connect.Response[v1alpha1.TerminateJobRunResponse], error)
+	// Terminates a job run and waits until Temporal reports the workflow as closed. Used for runs whose workers have died and no longer respond to cancellation
+	ForceCancelJobRun(context.Context, *connect.Request[v1alpha1.ForceCancelJobRunRequest]) (*connect.Response[v1alpha1.ForceCancelJobRunResponse], error)
 	// Returns a stream of logs from the worker nodes that pertain to a specific job run
 	GetJobRunLogsStream(context.Context, *connect.Request[v1alpha1.GetJobRunLogsStreamRequest], *connect.ServerStream[v1alpha1.GetJobRunLogsStreamResponse]) error
 	// Set any job workflow options. Must provide entire object as is it will fully override the previous configuration
 	SetJobWorkflowOptions(context.Context, *connect.Request[v1alpha1.SetJobWorkflowOptionsRequest]) (*connect.Response[v1alpha1.SetJobWorkflowOptionsResponse], error)
 	// Set the job sync options. Must provide entire object as it will fully override the previous configuration
 	SetJobSyncOptions(context.Context, *connect.Request[v1alpha1.SetJobSyncOptionsRequest]) (*connect.Response[v1alpha1.SetJobSyncOptionsResponse], error)
+	// Set the raw processors that are attached to the pipelines of the job's tables. Fully overrides the previous table processors
+	SetJobTableProcessors(context.Context, *connect.Request[v1alpha1.SetJobTableProcessorsRequest]) (*connect.Response[v1alpha1.SetJobTableProcessorsResponse], error)
+	// Set the typed parameters whose values are supplied when a run of the job is triggered. Fully overrides the previous parameters
+	SetJobParameters(context.Context, *connect.Request[v1alpha1.SetJobParametersRequest]) (*connect.Response[v1alpha1.SetJobParametersResponse], error)
 	// validates that the jobmapping configured can run with table constraints
 	ValidateJobMappings(context.Context, *connect.Request[v1alpha1.ValidateJobMappingsRequest]) (*connect.Response[v1alpha1.ValidateJobMappingsResponse], error)
+	// Returns the pending and approved change requests for a job
+	GetJobChangeRequests(context.Context, *connect.Request[v1alpha1.GetJobChangeRequestsRequest]) (*connect.Response[v1alpha1.GetJobChangeRequestsResponse], error)
+	// Approves a pending job change and applies it to the job. The approver must not be the user that requested the change
+	ApproveJobChange(context.Context, *connect.Request[v1alpha1.ApproveJobChangeRequest]) (*connect.Response[v1alpha1.ApproveJobChangeResponse], error)
+	// Returns the settings that new jobs of the account inherit when they are created without them
+	GetAccountJobDefaults(context.Context, *connect.Request[v1alpha1.GetAccountJobDefaultsRequest]) (*connect.Response[v1alpha1.GetAccountJobDefaultsResponse], error)
+	// Replaces the settings that new jobs of the account inherit. Existing jobs keep their current settings
+	SetAccountJobDefaults(context.Context, *connect.Request[v1alpha1.SetAccountJobDefaultsRequest]) (*connect.Response[v1alpha1.SetAccountJobDefaultsResponse], error)
+	// Returns the jobs of the account whose settings differ from the account's job defaults
+	GetJobsOverridingDefaults(context.Context, *connect.Request[v1alpha1.GetJobsOverridingDefaultsRequest]) (*connect.Response[v1alpha1.GetJobsOverridingDefaultsResponse], error)
+	// Returns the built-in job templates
+	GetJobTemplates(context.Context, *connect.Request[v1alpha1.GetJobTemplatesRequest]) (*connect.Response[v1alpha1.GetJobTemplatesResponse], error)
+	// Creates a job from a built-in job template by substituting the provided variables
+	CreateJobFromTemplate(context.Context, *connect.Request[v1alpha1.CreateJobFromTemplateRequest]) (*connect.Response[v1alpha1.CreateJobFromTemplateResponse], error)
 }
 
 // NewJobServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -592,6 +1132,12 @@ func NewJobServiceHandler(svc JobServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(jobServiceIsJobNameAvailableMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	jobServiceSetJobLabelsHandler := connect.NewUnaryHandler(
+		JobServiceSetJobLabelsProcedure,
+		svc.SetJobLabels,
+		connect.WithSchema(jobServiceSetJobLabelsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	jobServiceUpdateJobScheduleHandler := connect.NewUnaryHandler(
 		JobServiceUpdateJobScheduleProcedure,
 		svc.UpdateJobSchedule,
@@ -604,6 +1150,12 @@ func NewJobServiceHandler(svc JobServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(jobServiceUpdateJobSourceConnectionMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	jobServiceSetJobMappingsHandler := connect.NewUnaryHandler(
+		JobServiceSetJobMappingsProcedure,
+		svc.SetJobMappings,
+		connect.WithSchema(jobServiceSetJobMappingsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	jobServiceSetJobSourceSqlConnectionSubsetsHandler := connect.NewUnaryHandler(
 		JobServiceSetJobSourceSqlConnectionSubsetsProcedure,
 		svc.SetJobSourceSqlConnectionSubsets,
@@ -634,6 +1186,12 @@ func NewJobServiceHandler(svc JobServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(jobServicePauseJobMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	jobServiceResumeJobHandler := connect.NewUnaryHandler(
+		JobServiceResumeJobProcedure,
+		svc.ResumeJob,
+		connect.WithSchema(jobServiceResumeJobMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	jobServiceGetJobRecentRunsHandler := connect.NewUnaryHandler(
 		JobServiceGetJobRecentRunsProcedure,
 		svc.GetJobRecentRuns,
@@ -646,6 +1204,12 @@ func NewJobServiceHandler(svc JobServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(jobServiceGetJobNextRunsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	jobServiceGetNextScheduledRunsHandler := connect.NewUnaryHandler(
+		JobServiceGetNextScheduledRunsProcedure,
+		svc.GetNextScheduledRuns,
+		connect.WithSchema(jobServiceGetNextScheduledRunsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	jobServiceGetJobStatusHandler := connect.NewUnaryHandler(
 		JobServiceGetJobStatusProcedure,
 		svc.GetJobStatus,
@@ -664,6 +1228,12 @@ func NewJobServiceHandler(svc JobServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(jobServiceGetJobRunsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	jobServiceStreamJobRunStatusesHandler := connect.NewServerStreamHandler(
+		JobServiceStreamJobRunStatusesProcedure,
+		svc.StreamJobRunStatuses,
+		connect.WithSchema(jobServiceStreamJobRunStatusesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	jobServiceGetJobRunEventsHandler := connect.NewUnaryHandler(
 		JobServiceGetJobRunEventsProcedure,
 		svc.GetJobRunEvents,
@@ -676,6 +1246,60 @@ func NewJobServiceHandler(svc JobServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(jobServiceGetJobRunMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	jobServiceGetJobRunDataQualityHandler := connect.NewUnaryHandler(
+		JobServiceGetJobRunDataQualityProcedure,
+		svc.GetJobRunDataQuality,
+		connect.WithSchema(jobServiceGetJobRunDataQualityMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceGetJobRunRowCountsHandler := connect.NewUnaryHandler(
+		JobServiceGetJobRunRowCountsProcedure,
+		svc.GetJobRunRowCounts,
+		connect.WithSchema(jobServiceGetJobRunRowCountsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceGetJobRunMaskingProgressHandler := connect.NewUnaryHandler(
+		JobServiceGetJobRunMaskingProgressProcedure,
+		svc.GetJobRunMaskingProgress,
+		connect.WithSchema(jobServiceGetJobRunMaskingProgressMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceCreateJobRunArtifactHandler := connect.NewUnaryHandler(
+		JobServiceCreateJobRunArtifactProcedure,
+		svc.CreateJobRunArtifact,
+		connect.WithSchema(jobServiceCreateJobRunArtifactMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceGetJobRunArtifactsHandler := connect.NewUnaryHandler(
+		JobServiceGetJobRunArtifactsProcedure,
+		svc.GetJobRunArtifacts,
+		connect.WithSchema(jobServiceGetJobRunArtifactsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceCreateJobRunSnapshotHandler := connect.NewUnaryHandler(
+		JobServiceCreateJobRunSnapshotProcedure,
+		svc.CreateJobRunSnapshot,
+		connect.WithSchema(jobServiceCreateJobRunSnapshotMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceGetJobRunSnapshotHandler := connect.NewUnaryHandler(
+		JobServiceGetJobRunSnapshotProcedure,
+		svc.GetJobRunSnapshot,
+		connect.WithSchema(jobServiceGetJobRunSnapshotMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceGetJobRunSnapshotsHandler := connect.NewUnaryHandler(
+		JobServiceGetJobRunSnapshotsProcedure,
+		svc.GetJobRunSnapshots,
+		connect.WithSchema(jobServiceGetJobRunSnapshotsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceDeleteJobRunSnapshotHandler := connect.NewUnaryHandler(
+		JobServiceDeleteJobRunSnapshotProcedure,
+		svc.DeleteJobRunSnapshot,
+		connect.WithSchema(jobServiceDeleteJobRunSnapshotMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	jobServiceDeleteJobRunHandler := connect.NewUnaryHandler(
 		JobServiceDeleteJobRunProcedure,
 		svc.DeleteJobRun,
@@ -688,6 +1312,24 @@ func NewJobServiceHandler(svc JobServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(jobServiceCreateJobRunMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	jobServiceGetJobRunPriorityHandler := connect.NewUnaryHandler(
+		JobServiceGetJobRunPriorityProcedure,
+		svc.GetJobRunPriority,
+		connect.WithSchema(jobServiceGetJobRunPriorityMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceGetJobRunParametersHandler := connect.NewUnaryHandler(
+		JobServiceGetJobRunParametersProcedure,
+		svc.GetJobRunParameters,
+		connect.WithSchema(jobServiceGetJobRunParametersMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceEstimateJobRunHandler := connect.NewUnaryHandler(
+		JobServiceEstimateJobRunProcedure,
+		svc.EstimateJobRun,
+		connect.WithSchema(jobServiceEstimateJobRunMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	jobServiceCancelJobRunHandler := connect.NewUnaryHandler(
 		JobServiceCancelJobRunProcedure,
 		svc.CancelJobRun,
@@ -700,6 +1342,12 @@ func NewJobServiceHandler(svc JobServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(jobServiceTerminateJobRunMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	jobServiceForceCancelJobRunHandler := connect.NewUnaryHandler(
+		JobServiceForceCancelJobRunProcedure,
+		svc.ForceCancelJobRun,
+		connect.WithSchema(jobServiceForceCancelJobRunMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	jobServiceGetJobRunLogsStreamHandler := connect.NewServerStreamHandler(
 		JobServiceGetJobRunLogsStreamProcedure,
 		svc.GetJobRunLogsStream,
@@ -718,12 +1366,66 @@ func NewJobServiceHandler(svc JobServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(jobServiceSetJobSyncOptionsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	jobServiceSetJobTableProcessorsHandler := connect.NewUnaryHandler(
+		JobServiceSetJobTableProcessorsProcedure,
+		svc.SetJobTableProcessors,
+		connect.WithSchema(jobServiceSetJobTableProcessorsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceSetJobParametersHandler := connect.NewUnaryHandler(
+		JobServiceSetJobParametersProcedure,
+		svc.SetJobParameters,
+		connect.WithSchema(jobServiceSetJobParametersMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	jobServiceValidateJobMappingsHandler := connect.NewUnaryHandler(
 		JobServiceValidateJobMappingsProcedure,
 		svc.ValidateJobMappings,
 		connect.WithSchema(jobServiceValidateJobMappingsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	jobServiceGetJobChangeRequestsHandler := connect.NewUnaryHandler(
+		JobServiceGetJobChangeRequestsProcedure,
+		svc.GetJobChangeRequests,
+		connect.WithSchema(jobServiceGetJobChangeRequestsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceApproveJobChangeHandler := connect.NewUnaryHandler(
+		JobServiceApproveJobChangeProcedure,
+		svc.ApproveJobChange,
+		connect.WithSchema(jobServiceApproveJobChangeMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceGetAccountJobDefaultsHandler := connect.NewUnaryHandler(
+		JobServiceGetAccountJobDefaultsProcedure,
+		svc.GetAccountJobDefaults,
+		connect.WithSchema(jobServiceGetAccountJobDefaultsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceSetAccountJobDefaultsHandler := connect.NewUnaryHandler(
+		JobServiceSetAccountJobDefaultsProcedure,
+		svc.SetAccountJobDefaults,
+		connect.WithSchema(jobServiceSetAccountJobDefaultsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceGetJobsOverridingDefaultsHandler := connect.NewUnaryHandler(
+		JobServiceGetJobsOverridingDefaultsProcedure,
+		svc.GetJobsOverridingDefaults,
+		connect.WithSchema(jobServiceGetJobsOverridingDefaultsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceGetJobTemplatesHandler := connect.NewUnaryHandler(
+		JobServiceGetJobTemplatesProcedure,
+		svc.GetJobTemplates,
+		connect.WithSchema(jobServiceGetJobTemplatesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceCreateJobFromTemplateHandler := connect.NewUnaryHandler(
+		JobServiceCreateJobFromTemplateProcedure,
+		svc.CreateJobFromTemplate,
+		connect.WithSchema(jobServiceCreateJobFromTemplateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mgmt.v1alpha1.JobService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case JobServiceGetJobsProcedure:
@@ -736,10 +1438,14 @@ func NewJobServiceHandler(svc JobServiceHandler, opts ...connect.HandlerOption)
 			jobServiceDeleteJobHandler.ServeHTTP(w, r)
 		case JobServiceIsJobNameAvailableProcedure:
 			jobServiceIsJobNameAvailableHandler.ServeHTTP(w, r)
+		case JobServiceSetJobLabelsProcedure:
+			jobServiceSetJobLabelsHandler.ServeHTTP(w, r)
 		case JobServiceUpdateJobScheduleProcedure:
 			jobServiceUpdateJobScheduleHandler.ServeHTTP(w, r)
 		case JobServiceUpdateJobSourceConnectionProcedure:
 			jobServiceUpdateJobSourceConnectionHandler.ServeHTTP(w, r)
+		case JobServiceSetJobMappingsProcedure:
+			jobServiceSetJobMappingsHandler.ServeHTTP(w, r)
 		case JobServiceSetJobSourceSqlConnectionSubsetsProcedure:
 			jobServiceSetJobSourceSqlConnectionSubsetsHandler.ServeHTTP(w, r)
 		case JobServiceUpdateJobDestinationConnectionProcedure:
@@ -750,36 +1456,86 @@ func NewJobServiceHandler(svc JobServiceHandler, opts ...connect.HandlerOption)
 			jobServiceCreateJobDestinationConnectionsHandler.ServeHTTP(w, r)
 		case JobServicePauseJobProcedure:
 			jobServicePauseJobHandler.ServeHTTP(w, r)
+		case JobServiceResumeJobProcedure:
+			jobServiceResumeJobHandler.ServeHTTP(w, r)
 		case JobServiceGetJobRecentRunsProcedure:
 			jobServiceGetJobRecentRunsHandler.ServeHTTP(w, r)
 		case JobServiceGetJobNextRunsProcedure:
 			jobServiceGetJobNextRunsHandler.ServeHTTP(w, r)
+		case JobServiceGetNextScheduledRunsProcedure:
+			jobServiceGetNextScheduledRunsHandler.ServeHTTP(w, r)
 		case JobServiceGetJobStatusProcedure:
 			jobServiceGetJobStatusHandler.ServeHTTP(w, r)
 		case JobServiceGetJobStatusesProcedure:
 			jobServiceGetJobStatusesHandler.ServeHTTP(w, r)
 		case JobServiceGetJobRunsProcedure:
 			jobServiceGetJobRunsHandler.ServeHTTP(w, r)
+		case JobServiceStreamJobRunStatusesProcedure:
+			jobServiceStreamJobRunStatusesHandler.ServeHTTP(w, r)
 		case JobServiceGetJobRunEventsProcedure:
 			jobServiceGetJobRunEventsHandler.ServeHTTP(w, r)
 		case JobServiceGetJobRunProcedure:
 			jobServiceGetJobRunHandler.ServeHTTP(w, r)
+		case JobServiceGetJobRunDataQualityProcedure:
+			jobServiceGetJobRunDataQualityHandler.ServeHTTP(w, r)
+		case JobServiceGetJobRunRowCountsProcedure:
+			jobServiceGetJobRunRowCountsHandler.ServeHTTP(w, r)
+		case JobServiceGetJobRunMaskingProgressProcedure:
+			jobServiceGetJobRunMaskingProgressHandler.ServeHTTP(w, r)
+		case JobServiceCreateJobRunArtifactProcedure:
+			jobServiceCreateJobRunArtifactHandler.ServeHTTP(w, r)
+		case JobServiceGetJobRunArtifactsProcedure:
+			jobServiceGetJobRunArtifactsHandler.ServeHTTP(w, r)
+		case JobServiceCreateJobRunSnapshotProcedure:
+			jobServiceCreateJobRunSnapshotHandler.ServeHTTP(w, r)
+		case JobServiceGetJobRunSnapshotProcedure:
+			jobServiceGetJobRunSnapshotHandler.ServeHTTP(w, r)
+		case JobServiceGetJobRunSnapshotsProcedure:
+			jobServiceGetJobRunSnapshotsHandler.ServeHTTP(w, r)
+		case JobServiceDeleteJobRunSnapshotProcedure:
+			jobServiceDeleteJobRunSnapshotHandler.ServeHTTP(w, r)
 		case JobServiceDeleteJobRunProcedure:
 			jobServiceDeleteJobRunHandler.ServeHTTP(w, r)
 		case JobServiceCreateJobRunProcedure:
 			jobServiceCreateJobRunHandler.ServeHTTP(w, r)
+		case JobServiceGetJobRunPriorityProcedure:
+			jobServiceGetJobRunPriorityHandler.ServeHTTP(w, r)
+		case JobServiceGetJobRunParametersProcedure:
+			jobServiceGetJobRunParametersHandler.ServeHTTP(w, r)
+		case JobServiceEstimateJobRunProcedure:
+			jobServiceEstimateJobRunHandler.ServeHTTP(w, r)
 		case JobServiceCancelJobRunProcedure:
 			jobServiceCancelJobRunHandler.ServeHTTP(w, r)
 		case JobServiceTerminateJobRunProcedure:
 			jobServiceTerminateJobRunHandler.ServeHTTP(w, r)
+		case JobServiceForceCancelJobRunProcedure:
+			jobServiceForceCancelJobRunHandler.ServeHTTP(w, r)
 		case JobServiceGetJobRunLogsStreamProcedure:
 			jobServiceGetJobRunLogsStreamHandler.ServeHTTP(w, r)
 		case JobServiceSetJobWorkflowOptionsProcedure:
 			jobServiceSetJobWorkflowOptionsHandler.ServeHTTP(w, r)
 		case JobServiceSetJobSyncOptionsProcedure:
 			jobServiceSetJobSyncOptionsHandler.ServeHTTP(w, r)
+		case JobServiceSetJobTableProcessorsProcedure:
+			jobServiceSetJobTableProcessorsHandler.ServeHTTP(w, r)
+		case JobServiceSetJobParametersProcedure:
+			jobServiceSetJobParametersHandler.ServeHTTP(w, r)
 		case JobServiceValidateJobMappingsProcedure:
 			jobServiceValidateJobMappingsHandler.ServeHTTP(w, r)
+		case JobServiceGetJobChangeRequestsProcedure:
+			jobServiceGetJobChangeRequestsHandler.ServeHTTP(w, r)
+		case JobServiceApproveJobChangeProcedure:
+			jobServiceApproveJobChangeHandler.ServeHTTP(w, r)
+		case JobServiceGetAccountJobDefaultsProcedure:
+			jobServiceGetAccountJobDefaultsHandler.ServeHTTP(w, r)
+		case JobServiceSetAccountJobDefaultsProcedure:
+			jobServiceSetAccountJobDefaultsHandler.ServeHTTP(w, r)
+		case JobServiceGetJobsOverridingDefaultsProcedure:
+			jobServiceGetJobsOverridingDefaultsHandler.ServeHTTP(w, r)
+		case JobServiceGetJobTemplatesProcedure:
+			jobServiceGetJobTemplatesHandler.ServeHTTP(w, r)
+		case JobServiceCreateJobFromTemplateProcedure:
+			jobServiceCreateJobFromTemplateHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -809,6 +1565,10 @@ func (UnimplementedJobServiceHandler) IsJobNameAvailable(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.IsJobNameAvailable is not implemented"))
 }
 
+func (UnimplementedJobServiceHandler) SetJobLabels(context.Context, *connect.Request[v1alpha1.SetJobLabelsRequest]) (*connect.Response[v1alpha1.SetJobLabelsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.SetJobLabels is not implemented"))
+}
+
 func (UnimplementedJobServiceHandler) UpdateJobSchedule(context.Context, *connect.Request[v1alpha1.UpdateJobScheduleRequest]) (*connect.Response[v1alpha1.UpdateJobScheduleResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.UpdateJobSchedule is not implemented"))
 }
@@ -817,6 +1577,10 @@ func (UnimplementedJobServiceHandler) UpdateJobSourceConnection(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.UpdateJobSourceConnection is not implemented"))
 }
 
+func (UnimplementedJobServiceHandler) SetJobMappings(context.Context, *connect.Request[v1alpha1.SetJobMappingsRequest]) (*connect.Response[v1alpha1.SetJobMappingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.SetJobMappings is not implemented"))
+}
+
 func (UnimplementedJobServiceHandler) SetJobSourceSqlConnectionSubsets(context.Context, *connect.Request[v1alpha1.SetJobSourceSqlConnectionSubsetsRequest]) (*connect.Response[v1alpha1.SetJobSourceSqlConnectionSubsetsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.SetJobSourceSqlConnectionSubsets is not implemented"))
 }
@@ -837,6 +1601,10 @@ func (UnimplementedJobServiceHandler) PauseJob(context.Context, *connect.Request
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.PauseJob is not implemented"))
 }
 
+func (UnimplementedJobServiceHandler) ResumeJob(context.Context, *connect.Request[v1alpha1.ResumeJobRequest]) (*connect.Response[v1alpha1.ResumeJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.ResumeJob is not implemented"))
+}
+
 func (UnimplementedJobServiceHandler) GetJobRecentRuns(context.Context, *connect.Request[v1alpha1.GetJobRecentRunsRequest]) (*connect.Response[v1alpha1.GetJobRecentRunsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobRecentRuns is not implemented"))
 }
@@ -845,6 +1613,10 @@ func (UnimplementedJobServiceHandler) GetJobNextRuns(context.Context, *connect.R
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobNextRuns is not implemented"))
 }
 
+func (UnimplementedJobServiceHandler) GetNextScheduledRuns(context.Context, *connect.Request[v1alpha1.GetNextScheduledRunsRequest]) (*connect.Response[v1alpha1.GetNextScheduledRunsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetNextScheduledRuns is not implemented"))
+}
+
 func (UnimplementedJobServiceHandler) GetJobStatus(context.Context, *connect.Request[v1alpha1.GetJobStatusRequest]) (*connect.Response[v1alpha1.GetJobStatusResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobStatus is not implemented"))
 }
@@ -857,6 +1629,10 @@ func (UnimplementedJobServiceHandler) GetJobRuns(context.Context, *connect.Reque
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobRuns is not implemented"))
 }
 
+func (UnimplementedJobServiceHandler) StreamJobRunStatuses(context.Context, *connect.Request[v1alpha1.StreamJobRunStatusesRequest], *connect.ServerStream[v1alpha1.StreamJobRunStatusesResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.StreamJobRunStatuses is not implemented"))
+}
+
 func (UnimplementedJobServiceHandler) GetJobRunEvents(context.Context, *connect.Request[v1alpha1.GetJobRunEventsRequest]) (*connect.Response[v1alpha1.GetJobRunEventsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobRunEvents is not implemented"))
 }
@@ -865,6 +1641,42 @@ func (UnimplementedJobServiceHandler) GetJobRun(context.Context, *connect.Reques
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobRun is not implemented"))
 }
 
+func (UnimplementedJobServiceHandler) GetJobRunDataQuality(context.Context, *connect.Request[v1alpha1.GetJobRunDataQualityRequest]) (*connect.Response[v1alpha1.GetJobRunDataQualityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobRunDataQuality is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) GetJobRunRowCounts(context.Context, *connect.Request[v1alpha1.GetJobRunRowCountsRequest]) (*connect.Response[v1alpha1.GetJobRunRowCountsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobRunRowCounts is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) GetJobRunMaskingProgress(context.Context, *connect.Request[v1alpha1.GetJobRunMaskingProgressRequest]) (*connect.Response[v1alpha1.GetJobRunMaskingProgressResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobRunMaskingProgress is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) CreateJobRunArtifact(context.Context, *connect.Request[v1alpha1.CreateJobRunArtifactRequest]) (*connect.Response[v1alpha1.CreateJobRunArtifactResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.CreateJobRunArtifact is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) GetJobRunArtifacts(context.Context, *connect.Request[v1alpha1.GetJobRunArtifactsRequest]) (*connect.Response[v1alpha1.GetJobRunArtifactsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobRunArtifacts is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) CreateJobRunSnapshot(context.Context, *connect.Request[v1alpha1.CreateJobRunSnapshotRequest]) (*connect.Response[v1alpha1.CreateJobRunSnapshotResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.CreateJobRunSnapshot is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) GetJobRunSnapshot(context.Context, *connect.Request[v1alpha1.GetJobRunSnapshotRequest]) (*connect.Response[v1alpha1.GetJobRunSnapshotResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobRunSnapshot is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) GetJobRunSnapshots(context.Context, *connect.Request[v1alpha1.GetJobRunSnapshotsRequest]) (*connect.Response[v1alpha1.GetJobRunSnapshotsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobRunSnapshots is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) DeleteJobRunSnapshot(context.Context, *connect.Request[v1alpha1.DeleteJobRunSnapshotRequest]) (*connect.Response[v1alpha1.DeleteJobRunSnapshotResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.DeleteJobRunSnapshot is not implemented"))
+}
+
 func (UnimplementedJobServiceHandler) DeleteJobRun(context.Context, *connect.Request[v1alpha1.DeleteJobRunRequest]) (*connect.Response[v1alpha1.DeleteJobRunResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.DeleteJobRun is not implemented"))
 }
@@ -873,6 +1685,18 @@ func (UnimplementedJobServiceHandler) CreateJobRun(context.Context, *connect.Req
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.CreateJobRun is not implemented"))
 }
 
+func (UnimplementedJobServiceHandler) GetJobRunPriority(context.Context, *connect.Request[v1alpha1.GetJobRunPriorityRequest]) (*connect.Response[v1alpha1.GetJobRunPriorityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobRunPriority is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) GetJobRunParameters(context.Context, *connect.Request[v1alpha1.GetJobRunParametersRequest]) (*connect.Response[v1alpha1.GetJobRunParametersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobRunParameters is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) EstimateJobRun(context.Context, *connect.Request[v1alpha1.EstimateJobRunRequest]) (*connect.Response[v1alpha1.EstimateJobRunResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.EstimateJobRun is not implemented"))
+}
+
 func (UnimplementedJobServiceHandler) CancelJobRun(context.Context, *connect.Request[v1alpha1.CancelJobRunRequest]) (*connect.Response[v1alpha1.CancelJobRunResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.CancelJobRun is not implemented"))
 }
@@ -881,6 +1705,10 @@ func (UnimplementedJobServiceHandler) TerminateJobRun(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.TerminateJobRun is not implemented"))
 }
 
+func (UnimplementedJobServiceHandler) ForceCancelJobRun(context.Context, *connect.Request[v1alpha1.ForceCancelJobRunRequest]) (*connect.Response[v1alpha1.ForceCancelJobRunResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.ForceCancelJobRun is not implemented"))
+}
+
 func (UnimplementedJobServiceHandler) GetJobRunLogsStream(context.Context, *connect.Request[v1alpha1.GetJobRunLogsStreamRequest], *connect.ServerStream[v1alpha1.GetJobRunLogsStreamResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobRunLogsStream is not implemented"))
 }
@@ -893,6 +1721,42 @@ func (UnimplementedJobServiceHandler) SetJobSyncOptions(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.SetJobSyncOptions is not implemented"))
 }
 
+func (UnimplementedJobServiceHandler) SetJobTableProcessors(context.Context, *connect.Request[v1alpha1.SetJobTableProcessorsRequest]) (*connect.Response[v1alpha1.SetJobTableProcessorsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.SetJobTableProcessors is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) SetJobParameters(context.Context, *connect.Request[v1alpha1.SetJobParametersRequest]) (*connect.Response[v1alpha1.SetJobParametersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.SetJobParameters is not implemented"))
+}
+
 func (UnimplementedJobServiceHandler) ValidateJobMappings(context.Context, *connect.Request[v1alpha1.ValidateJobMappingsRequest]) (*connect.Response[v1alpha1.ValidateJobMappingsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.ValidateJobMappings is not implemented"))
 }
+
+func (UnimplementedJobServiceHandler) GetJobChangeRequests(context.Context, *connect.Request[v1alpha1.GetJobChangeRequestsRequest]) (*connect.Response[v1alpha1.GetJobChangeRequestsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobChangeRequests is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) ApproveJobChange(context.Context, *connect.Request[v1alpha1.ApproveJobChangeRequest]) (*connect.Response[v1alpha1.ApproveJobChangeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.ApproveJobChange is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) GetAccountJobDefaults(context.Context, *connect.Request[v1alpha1.GetAccountJobDefaultsRequest]) (*connect.Response[v1alpha1.GetAccountJobDefaultsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetAccountJobDefaults is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) SetAccountJobDefaults(context.Context, *connect.Request[v1alpha1.SetAccountJobDefaultsRequest]) (*connect.Response[v1alpha1.SetAccountJobDefaultsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.SetAccountJobDefaults is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) GetJobsOverridingDefaults(context.Context, *connect.Request[v1alpha1.GetJobsOverridingDefaultsRequest]) (*connect.Response[v1alpha1.GetJobsOverridingDefaultsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobsOverridingDefaults is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) GetJobTemplates(context.Context, *connect.Request[v1alpha1.GetJobTemplatesRequest]) (*connect.Response[v1alpha1.GetJobTemplatesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobTemplates is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) CreateJobFromTemplate(context.Context, *connect.Request[v1alpha1.CreateJobFromTemplateRequest]) (*connect.Response[v1alpha1.CreateJobFromTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.CreateJobFromTemplate is not implemented"))
+}
@@ -39,6 +39,9 @@ const (
 	// MetricsServiceGetMetricCountProcedure is the fully-qualified name of the MetricsService's
 	// GetMetricCount RPC.
 	MetricsServiceGetMetricCountProcedure = "/mgmt.v1alpha1.MetricsService/GetMetricCount"
+	// MetricsServiceGetAccountDashboardProcedure is the fully-qualified name of the MetricsService's
+	// GetAccountDashboard RPC.
+	MetricsServiceGetAccountDashboardProcedure = "/mgmt.v1alpha1.MetricsService/GetAccountDashboard"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -46,6 +49,7 @@ var (
 	metricsServiceServiceDescriptor                   = v1alpha1.File_mgmt_v1alpha1_metrics_proto.Services().ByName("MetricsService")
 	metricsServiceGetDailyMetricCountMethodDescriptor = metricsServiceServiceDescriptor.Methods().ByName("GetDailyMetricCount")
 	metricsServiceGetMetricCountMethodDescriptor      = metricsServiceServiceDescriptor.Methods().ByName("GetMetricCount")
+	metricsServiceGetAccountDashboardMethodDescriptor = metricsServiceServiceDescriptor.Methods().ByName("GetAccountDashboard")
 )
 
 // MetricsServiceClient is a client for the mgmt.v1alpha1.MetricsService service.
@@ -54,6 +58,8 @@ type MetricsServiceClient interface {
 	GetDailyMetricCount(context.Context, *connect.Request[v1alpha1.GetDailyMetricCountRequest]) (*connect.Response[v1alpha1.GetDailyMetricCountResponse], error)
 	// For the given metric and time range, returns the total count found
 	GetMetricCount(context.Context, *connect.Request[v1alpha1.GetMetricCountRequest]) (*connect.Response[v1alpha1.GetMetricCountResponse], error)
+	// Returns a summary of the sync activity in an account over the last 30 days
+	GetAccountDashboard(context.Context, *connect.Request[v1alpha1.GetAccountDashboardRequest]) (*connect.Response[v1alpha1.GetAccountDashboardResponse], error)
 }
 
 // NewMetricsServiceClient constructs a client for the mgmt.v1alpha1.MetricsService service. By
@@ -78,6 +84,12 @@ func NewMetricsServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(metricsServiceGetMetricCountMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getAccountDashboard: connect.NewClient[v1alpha1.GetAccountDashboardRequest, v1alpha1.GetAccountDashboardResponse](
+			httpClient,
+			baseURL+MetricsServiceGetAccountDashboardProcedure,
+			connect.WithSchema(metricsServiceGetAccountDashboardMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -85,6 +97,7 @@ func NewMetricsServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 type metricsServiceClient struct {
 	getDailyMetricCount *connect.Client[v1alpha1.GetDailyMetricCountRequest, v1alpha1.GetDailyMetricCountResponse]
 	getMetricCount      *connect.Client[v1alpha1.GetMetricCountRequest, v1alpha1.GetMetricCountResponse]
+	getAccountDashboard *connect.Client[v1alpha1.GetAccountDashboardRequest, v1alpha1.GetAccountDashboardResponse]
 }
 
 // GetDailyMetricCount calls mgmt.v1alpha1.MetricsService.GetDailyMetricCount.
@@ -97,12 +110,19 @@ func (c *metricsServiceClient) GetMetricCount(ctx context.Context, req *connect.
 	return c.getMetricCount.CallUnary(ctx, req)
 }
 
+// GetAccountDashboard calls mgmt.v1alpha1.MetricsService.GetAccountDashboard.
+func (c *metricsServiceClient) GetAccountDashboard(ctx context.Context, req *connect.Request[v1alpha1.GetAccountDashboardRequest]) (*connect.Response[v1alpha1.GetAccountDashboardResponse], error) {
+	return c.getAccountDashboard.CallUnary(ctx, req)
+}
+
 // MetricsServiceHandler is an implementation of the mgmt.v1alpha1.MetricsService service.
 type MetricsServiceHandler interface {
 	// Retrieve a timed range of records
 	GetDailyMetricCount(context.Context, *connect.Request[v1alpha1.GetDailyMetricCountRequest]) (*connect.Response[v1alpha1.GetDailyMetricCountResponse], error)
 	// For the given metric and time range, returns the total count found
 	GetMetricCount(context.Context, *connect.Request[v1alpha1.GetMetricCountRequest]) (*connect.Response[v1alpha1.GetMetricCountResponse], error)
+	// Returns a summary of the sync activity in an account over the last 30 days
+	GetAccountDashboard(context.Context, *connect.Request[v1alpha1.GetAccountDashboardRequest]) (*connect.Response[v1alpha1.GetAccountDashboardResponse], error)
 }
 
 // NewMetricsServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -123,12 +143,20 @@ func NewMetricsServiceHandler(svc MetricsServiceHandler, opts ...connect.Handler
 		connect.WithSchema(metricsServiceGetMetricCountMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	metricsServiceGetAccountDashboardHandler := connect.NewUnaryHandler(
+		MetricsServiceGetAccountDashboardProcedure,
+		svc.GetAccountDashboard,
+		connect.WithSchema(metricsServiceGetAccountDashboardMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mgmt.v1alpha1.MetricsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case MetricsServiceGetDailyMetricCountProcedure:
 			metricsServiceGetDailyMetricCountHandler.ServeHTTP(w, r)
 		case MetricsServiceGetMetricCountProcedure:
 			metricsServiceGetMetricCountHandler.ServeHTTP(w, r)
+		case MetricsServiceGetAccountDashboardProcedure:
+			metricsServiceGetAccountDashboardHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -145,3 +173,7 @@ func (UnimplementedMetricsServiceHandler) GetDailyMetricCount(context.Context, *
 func (UnimplementedMetricsServiceHandler) GetMetricCount(context.Context, *connect.Request[v1alpha1.GetMetricCountRequest]) (*connect.Response[v1alpha1.GetMetricCountResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.MetricsService.GetMetricCount is not implemented"))
 }
+
+func (UnimplementedMetricsServiceHandler) GetAccountDashboard(context.Context, *connect.Request[v1alpha1.GetAccountDashboardRequest]) (*connect.Response[v1alpha1.GetAccountDashboardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.MetricsService.GetAccountDashboard is not implemented"))
+}
@@ -24,6 +24,65 @@ func (_m *MockConnectionServiceClient) EXPECT() *MockConnectionServiceClient_Exp
 	return &MockConnectionServiceClient_Expecter{mock: &_m.Mock}
 }
 
+// BulkCreateConnections provides a mock function with given fields: _a0, _a1
+func (_m *MockConnectionServiceClient) BulkCreateConnections(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.BulkCreateConnectionsRequest]) (*connect.Response[mgmtv1alpha1.BulkCreateConnectionsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkCreateConnections")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.BulkCreateConnectionsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.BulkCreateConnectionsRequest]) (*connect.Response[mgmtv1alpha1.BulkCreateConnectionsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.BulkCreateConnectionsRequest]) *connect.Response[mgmtv1alpha1.BulkCreateConnectionsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.BulkCreateConnectionsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.BulkCreateConnectionsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConnectionServiceClient_BulkCreateConnections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkCreateConnections'
+type MockConnectionServiceClient_BulkCreateConnections_Call struct {
+	*mock.Call
+}
+
+// BulkCreateConnections is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.BulkCreateConnectionsRequest]
+func (_e *MockConnectionServiceClient_Expecter) BulkCreateConnections(_a0 interface{}, _a1 interface{}) *MockConnectionServiceClient_BulkCreateConnections_Call {
+	return &MockConnectionServiceClient_BulkCreateConnections_Call{Call: _e.mock.On("BulkCreateConnections", _a0, _a1)}
+}
+
+func (_c *MockConnectionServiceClient_BulkCreateConnections_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.BulkCreateConnectionsRequest])) *MockConnectionServiceClient_BulkCreateConnections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.BulkCreateConnectionsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockConnectionServiceClient_BulkCreateConnections_Call) Return(_a0 *connect.Response[mgmtv1alpha1.BulkCreateConnectionsResponse], _a1 error) *MockConnectionServiceClient_BulkCreateConnections_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConnectionServiceClient_BulkCreateConnections_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.BulkCreateConnectionsRequest]) (*connect.Response[mgmtv1alpha1.BulkCreateConnectionsResponse], error)) *MockConnectionServiceClient_BulkCreateConnections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckConnectionConfig provides a mock function with given fields: _a0, _a1
 func (_m *MockConnectionServiceClient) CheckConnectionConfig(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CheckConnectionConfigRequest]) (*connect.Response[mgmtv1alpha1.CheckConnectionConfigResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -201,6 +260,124 @@ func (_c *MockConnectionServiceClient_CreateConnection_Call) RunAndReturn(run fu
 	return _c
 }
 
+// CreateConnectionSavedQuery provides a mock function with given fields: _a0, _a1
+func (_m *MockConnectionServiceClient) CreateConnectionSavedQuery(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CreateConnectionSavedQueryRequest]) (*connect.Response[mgmtv1alpha1.CreateConnectionSavedQueryResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateConnectionSavedQuery")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.CreateConnectionSavedQueryResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateConnectionSavedQueryRequest]) (*connect.Response[mgmtv1alpha1.CreateConnectionSavedQueryResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateConnectionSavedQueryRequest]) *connect.Response[mgmtv1alpha1.CreateConnectionSavedQueryResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.CreateConnectionSavedQueryResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateConnectionSavedQueryRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConnectionServiceClient_CreateConnectionSavedQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateConnectionSavedQuery'
+type MockConnectionServiceClient_CreateConnectionSavedQuery_Call struct {
+	*mock.Call
+}
+
+// CreateConnectionSavedQuery is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.CreateConnectionSavedQueryRequest]
+func (_e *MockConnectionServiceClient_Expecter) CreateConnectionSavedQuery(_a0 interface{}, _a1 interface{}) *MockConnectionServiceClient_CreateConnectionSavedQuery_Call {
+	return &MockConnectionServiceClient_CreateConnectionSavedQuery_Call{Call: _e.mock.On("CreateConnectionSavedQuery", _a0, _a1)}
+}
+
+func (_c *MockConnectionServiceClient_CreateConnectionSavedQuery_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CreateConnectionSavedQueryRequest])) *MockConnectionServiceClient_CreateConnectionSavedQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.CreateConnectionSavedQueryRequest]))
+	})
+	return _c
+}
+
+func (_c *MockConnectionServiceClient_CreateConnectionSavedQuery_Call) Return(_a0 *connect.Response[mgmtv1alpha1.CreateConnectionSavedQueryResponse], _a1 error) *MockConnectionServiceClient_CreateConnectionSavedQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConnectionServiceClient_CreateConnectionSavedQuery_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.CreateConnectionSavedQueryRequest]) (*connect.Response[mgmtv1alpha1.CreateConnectionSavedQueryResponse], error)) *MockConnectionServiceClient_CreateConnectionSavedQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateConnectionTableBookmark provides a mock function with given fields: _a0, _a1
+func (_m *MockConnectionServiceClient) CreateConnectionTableBookmark(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CreateConnectionTableBookmarkRequest]) (*connect.Response[mgmtv1alpha1.CreateConnectionTableBookmarkResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateConnectionTableBookmark")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.CreateConnectionTableBookmarkResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateConnectionTableBookmarkRequest]) (*connect.Response[mgmtv1alpha1.CreateConnectionTableBookmarkResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateConnectionTableBookmarkRequest]) *connect.Response[mgmtv1alpha1.CreateConnectionTableBookmarkResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.CreateConnectionTableBookmarkResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateConnectionTableBookmarkRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConnectionServiceClient_CreateConnectionTableBookmark_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateConnectionTableBookmark'
+type MockConnectionServiceClient_CreateConnectionTableBookmark_Call struct {
+	*mock.Call
+}
+
+// CreateConnectionTableBookmark is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.CreateConnectionTableBookmarkRequest]
+func (_e *MockConnectionServiceClient_Expecter) CreateConnectionTableBookmark(_a0 interface{}, _a1 interface{}) *MockConnectionServiceClient_CreateConnectionTableBookmark_Call {
+	return &MockConnectionServiceClient_CreateConnectionTableBookmark_Call{Call: _e.mock.On("CreateConnectionTableBookmark", _a0, _a1)}
+}
+
+func (_c *MockConnectionServiceClient_CreateConnectionTableBookmark_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CreateConnectionTableBookmarkRequest])) *MockConnectionServiceClient_CreateConnectionTableBookmark_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.CreateConnectionTableBookmarkRequest]))
+	})
+	return _c
+}
+
+func (_c *MockConnectionServiceClient_CreateConnectionTableBookmark_Call) Return(_a0 *connect.Response[mgmtv1alpha1.CreateConnectionTableBookmarkResponse], _a1 error) *MockConnectionServiceClient_CreateConnectionTableBookmark_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConnectionServiceClient_CreateConnectionTableBookmark_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.CreateConnectionTableBookmarkRequest]) (*connect.Response[mgmtv1alpha1.CreateConnectionTableBookmarkResponse], error)) *MockConnectionServiceClient_CreateConnectionTableBookmark_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteConnection provides a mock function with given fields: _a0, _a1
 func (_m *MockConnectionServiceClient) DeleteConnection(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.DeleteConnectionRequest]) (*connect.Response[mgmtv1alpha1.DeleteConnectionResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -260,6 +437,124 @@ func (_c *MockConnectionServiceClient_DeleteConnection_Call) RunAndReturn(run fu
 	return _c
 }
 
+// DeleteConnectionSavedQuery provides a mock function with given fields: _a0, _a1
+func (_m *MockConnectionServiceClient) DeleteConnectionSavedQuery(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.DeleteConnectionSavedQueryRequest]) (*connect.Response[mgmtv1alpha1.DeleteConnectionSavedQueryResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteConnectionSavedQuery")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.DeleteConnectionSavedQueryResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.DeleteConnectionSavedQueryRequest]) (*connect.Response[mgmtv1alpha1.DeleteConnectionSavedQueryResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.DeleteConnectionSavedQueryRequest]) *connect.Response[mgmtv1alpha1.DeleteConnectionSavedQueryResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.DeleteConnectionSavedQueryResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.DeleteConnectionSavedQueryRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConnectionServiceClient_DeleteConnectionSavedQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteConnectionSavedQuery'
+type MockConnectionServiceClient_DeleteConnectionSavedQuery_Call struct {
+	*mock.Call
+}
+
+// DeleteConnectionSavedQuery is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.DeleteConnectionSavedQueryRequest]
+func (_e *MockConnectionServiceClient_Expecter) DeleteConnectionSavedQuery(_a0 interface{}, _a1 interface{}) *MockConnectionServiceClient_DeleteConnectionSavedQuery_Call {
+	return &MockConnectionServiceClient_DeleteConnectionSavedQuery_Call{Call: _e.mock.On("DeleteConnectionSavedQuery", _a0, _a1)}
+}
+
+func (_c *MockConnectionServiceClient_DeleteConnectionSavedQuery_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.DeleteConnectionSavedQueryRequest])) *MockConnectionServiceClient_DeleteConnectionSavedQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.DeleteConnectionSavedQueryRequest]))
+	})
+	return _c
+}
+
+func (_c *MockConnectionServiceClient_DeleteConnectionSavedQuery_Call) Return(_a0 *connect.Response[mgmtv1alpha1.DeleteConnectionSavedQueryResponse], _a1 error) *MockConnectionServiceClient_DeleteConnectionSavedQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConnectionServiceClient_DeleteConnectionSavedQuery_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.DeleteConnectionSavedQueryRequest]) (*connect.Response[mgmtv1alpha1.DeleteConnectionSavedQueryResponse], error)) *MockConnectionServiceClient_DeleteConnectionSavedQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteConnectionTableBookmark provides a mock function with given fields: _a0, _a1
+func (_m *MockConnectionServiceClient) DeleteConnectionTableBookmark(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.DeleteConnectionTableBookmarkRequest]) (*connect.Response[mgmtv1alpha1.DeleteConnectionTableBookmarkResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteConnectionTableBookmark")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.DeleteConnectionTableBookmarkResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.DeleteConnectionTableBookmarkRequest]) (*connect.Response[mgmtv1alpha1.DeleteConnectionTableBookmarkResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.DeleteConnectionTableBookmarkRequest]) *connect.Response[mgmtv1alpha1.DeleteConnectionTableBookmarkResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.DeleteConnectionTableBookmarkResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.DeleteConnectionTableBookmarkRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConnectionServiceClient_DeleteConnectionTableBookmark_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteConnectionTableBookmark'
+type MockConnectionServiceClient_DeleteConnectionTableBookmark_Call struct {
+	*mock.Call
+}
+
+// DeleteConnectionTableBookmark is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.DeleteConnectionTableBookmarkRequest]
+func (_e *MockConnectionServiceClient_Expecter) DeleteConnectionTableBookmark(_a0 interface{}, _a1 interface{}) *MockConnectionServiceClient_DeleteConnectionTableBookmark_Call {
+	return &MockConnectionServiceClient_DeleteConnectionTableBookmark_Call{Call: _e.mock.On("DeleteConnectionTableBookmark", _a0, _a1)}
+}
+
+func (_c *MockConnectionServiceClient_DeleteConnectionTableBookmark_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.DeleteConnectionTableBookmarkRequest])) *MockConnectionServiceClient_DeleteConnectionTableBookmark_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.DeleteConnectionTableBookmarkRequest]))
+	})
+	return _c
+}
+
+func (_c *MockConnectionServiceClient_DeleteConnectionTableBookmark_Call) Return(_a0 *connect.Response[mgmtv1alpha1.DeleteConnectionTableBookmarkResponse], _a1 error) *MockConnectionServiceClient_DeleteConnectionTableBookmark_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConnectionServiceClient_DeleteConnectionTableBookmark_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.DeleteConnectionTableBookmarkRequest]) (*connect.Response[mgmtv1alpha1.DeleteConnectionTableBookmarkResponse], error)) *MockConnectionServiceClient_DeleteConnectionTableBookmark_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetConnection provides a mock function with given fields: _a0, _a1
 func (_m *MockConnectionServiceClient) GetConnection(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -319,6 +614,124 @@ func (_c *MockConnectionServiceClient_GetConnection_Call) RunAndReturn(run func(
 	return _c
 }
 
+// GetConnectionSavedQueries provides a mock function with given fields: _a0, _a1
+func (_m *MockConnectionServiceClient) GetConnectionSavedQueries(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionSavedQueriesRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionSavedQueriesResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConnectionSavedQueries")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetConnectionSavedQueriesResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionSavedQueriesRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionSavedQueriesResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionSavedQueriesRequest]) *connect.Response[mgmtv1alpha1.GetConnectionSavedQueriesResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetConnectionSavedQueriesResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionSavedQueriesRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConnectionServiceClient_GetConnectionSavedQueries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionSavedQueries'
+type MockConnectionServiceClient_GetConnectionSavedQueries_Call struct {
+	*mock.Call
+}
+
+// GetConnectionSavedQueries is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetConnectionSavedQueriesRequest]
+func (_e *MockConnectionServiceClient_Expecter) GetConnectionSavedQueries(_a0 interface{}, _a1 interface{}) *MockConnectionServiceClient_GetConnectionSavedQueries_Call {
+	return &MockConnectionServiceClient_GetConnectionSavedQueries_Call{Call: _e.mock.On("GetConnectionSavedQueries", _a0, _a1)}
+}
+
+func (_c *MockConnectionServiceClient_GetConnectionSavedQueries_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionSavedQueriesRequest])) *MockConnectionServiceClient_GetConnectionSavedQueries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetConnectionSavedQueriesRequest]))
+	})
+	return _c
+}
+
+func (_c *MockConnectionServiceClient_GetConnectionSavedQueries_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetConnectionSavedQueriesResponse], _a1 error) *MockConnectionServiceClient_GetConnectionSavedQueries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConnectionServiceClient_GetConnectionSavedQueries_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionSavedQueriesRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionSavedQueriesResponse], error)) *MockConnectionServiceClient_GetConnectionSavedQueries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConnectionTableBookmarks provides a mock function with given fields: _a0, _a1
+func (_m *MockConnectionServiceClient) GetConnectionTableBookmarks(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionTableBookmarksRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionTableBookmarksResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConnectionTableBookmarks")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetConnectionTableBookmarksResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionTableBookmarksRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionTableBookmarksResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionTableBookmarksRequest]) *connect.Response[mgmtv1alpha1.GetConnectionTableBookmarksResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetConnectionTableBookmarksResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionTableBookmarksRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConnectionServiceClient_GetConnectionTableBookmarks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionTableBookmarks'
+type MockConnectionServiceClient_GetConnectionTableBookmarks_Call struct {
+	*mock.Call
+}
+
+// GetConnectionTableBookmarks is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetConnectionTableBookmarksRequest]
+func (_e *MockConnectionServiceClient_Expecter) GetConnectionTableBookmarks(_a0 interface{}, _a1 interface{}) *MockConnectionServiceClient_GetConnectionTableBookmarks_Call {
+	return &MockConnectionServiceClient_GetConnectionTableBookmarks_Call{Call: _e.mock.On("GetConnectionTableBookmarks", _a0, _a1)}
+}
+
+func (_c *MockConnectionServiceClient_GetConnectionTableBookmarks_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionTableBookmarksRequest])) *MockConnectionServiceClient_GetConnectionTableBookmarks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetConnectionTableBookmarksRequest]))
+	})
+	return _c
+}
+
+func (_c *MockConnectionServiceClient_GetConnectionTableBookmarks_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetConnectionTableBookmarksResponse], _a1 error) *MockConnectionServiceClient_GetConnectionTableBookmarks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConnectionServiceClient_GetConnectionTableBookmarks_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionTableBookmarksRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionTableBookmarksResponse], error)) *MockConnectionServiceClient_GetConnectionTableBookmarks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetConnections provides a mock function with given fields: _a0, _a1
 func (_m *MockConnectionServiceClient) GetConnections(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionsResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -437,6 +850,65 @@ func (_c *MockConnectionServiceClient_IsConnectionNameAvailable_Call) RunAndRetu
 	return _c
 }
 
+// SetConnectionLabels provides a mock function with given fields: _a0, _a1
+func (_m *MockConnectionServiceClient) SetConnectionLabels(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetConnectionLabelsRequest]) (*connect.Response[mgmtv1alpha1.SetConnectionLabelsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetConnectionLabels")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.SetConnectionLabelsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetConnectionLabelsRequest]) (*connect.Response[mgmtv1alpha1.SetConnectionLabelsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetConnectionLabelsRequest]) *connect.Response[mgmtv1alpha1.SetConnectionLabelsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetConnectionLabelsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetConnectionLabelsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConnectionServiceClient_SetConnectionLabels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetConnectionLabels'
+type MockConnectionServiceClient_SetConnectionLabels_Call struct {
+	*mock.Call
+}
+
+// SetConnectionLabels is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.SetConnectionLabelsRequest]
+func (_e *MockConnectionServiceClient_Expecter) SetConnectionLabels(_a0 interface{}, _a1 interface{}) *MockConnectionServiceClient_SetConnectionLabels_Call {
+	return &MockConnectionServiceClient_SetConnectionLabels_Call{Call: _e.mock.On("SetConnectionLabels", _a0, _a1)}
+}
+
+func (_c *MockConnectionServiceClient_SetConnectionLabels_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetConnectionLabelsRequest])) *MockConnectionServiceClient_SetConnectionLabels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetConnectionLabelsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockConnectionServiceClient_SetConnectionLabels_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetConnectionLabelsResponse], _a1 error) *MockConnectionServiceClient_SetConnectionLabels_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConnectionServiceClient_SetConnectionLabels_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetConnectionLabelsRequest]) (*connect.Response[mgmtv1alpha1.SetConnectionLabelsResponse], error)) *MockConnectionServiceClient_SetConnectionLabels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateConnection provides a mock function with given fields: _a0, _a1
 func (_m *MockConnectionServiceClient) UpdateConnection(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.UpdateConnectionRequest]) (*connect.Response[mgmtv1alpha1.UpdateConnectionResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -496,6 +968,65 @@ func (_c *MockConnectionServiceClient_UpdateConnection_Call) RunAndReturn(run fu
 	return _c
 }
 
+// UpdateConnectionSavedQuery provides a mock function with given fields: _a0, _a1
+func (_m *MockConnectionServiceClient) UpdateConnectionSavedQuery(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.UpdateConnectionSavedQueryRequest]) (*connect.Response[mgmtv1alpha1.UpdateConnectionSavedQueryResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConnectionSavedQuery")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.UpdateConnectionSavedQueryResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.UpdateConnectionSavedQueryRequest]) (*connect.Response[mgmtv1alpha1.UpdateConnectionSavedQueryResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.UpdateConnectionSavedQueryRequest]) *connect.Response[mgmtv1alpha1.UpdateConnectionSavedQueryResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.UpdateConnectionSavedQueryResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.UpdateConnectionSavedQueryRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConnectionServiceClient_UpdateConnectionSavedQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConnectionSavedQuery'
+type MockConnectionServiceClient_UpdateConnectionSavedQuery_Call struct {
+	*mock.Call
+}
+
+// UpdateConnectionSavedQuery is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.UpdateConnectionSavedQueryRequest]
+func (_e *MockConnectionServiceClient_Expecter) UpdateConnectionSavedQuery(_a0 interface{}, _a1 interface{}) *MockConnectionServiceClient_UpdateConnectionSavedQuery_Call {
+	return &MockConnectionServiceClient_UpdateConnectionSavedQuery_Call{Call: _e.mock.On("UpdateConnectionSavedQuery", _a0, _a1)}
+}
+
+func (_c *MockConnectionServiceClient_UpdateConnectionSavedQuery_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.UpdateConnectionSavedQueryRequest])) *MockConnectionServiceClient_UpdateConnectionSavedQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.UpdateConnectionSavedQueryRequest]))
+	})
+	return _c
+}
+
+func (_c *MockConnectionServiceClient_UpdateConnectionSavedQuery_Call) Return(_a0 *connect.Response[mgmtv1alpha1.UpdateConnectionSavedQueryResponse], _a1 error) *MockConnectionServiceClient_UpdateConnectionSavedQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConnectionServiceClient_UpdateConnectionSavedQuery_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.UpdateConnectionSavedQueryRequest]) (*connect.Response[mgmtv1alpha1.UpdateConnectionSavedQueryResponse], error)) *MockConnectionServiceClient_UpdateConnectionSavedQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockConnectionServiceClient creates a new instance of MockConnectionServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockConnectionServiceClient(t interface {
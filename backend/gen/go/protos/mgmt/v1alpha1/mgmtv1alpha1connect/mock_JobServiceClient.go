@@ -24,6 +24,65 @@ func (_m *MockJobServiceClient) EXPECT() *MockJobServiceClient_Expecter {
 	return &MockJobServiceClient_Expecter{mock: &_m.Mock}
 }
 
+// ApproveJobChange provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) ApproveJobChange(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ApproveJobChangeRequest]) (*connect.Response[mgmtv1alpha1.ApproveJobChangeResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveJobChange")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.ApproveJobChangeResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ApproveJobChangeRequest]) (*connect.Response[mgmtv1alpha1.ApproveJobChangeResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ApproveJobChangeRequest]) *connect.Response[mgmtv1alpha1.ApproveJobChangeResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.ApproveJobChangeResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.ApproveJobChangeRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_ApproveJobChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveJobChange'
+type MockJobServiceClient_ApproveJobChange_Call struct {
+	*mock.Call
+}
+
+// ApproveJobChange is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.ApproveJobChangeRequest]
+func (_e *MockJobServiceClient_Expecter) ApproveJobChange(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_ApproveJobChange_Call {
+	return &MockJobServiceClient_ApproveJobChange_Call{Call: _e.mock.On("ApproveJobChange", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_ApproveJobChange_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ApproveJobChangeRequest])) *MockJobServiceClient_ApproveJobChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.ApproveJobChangeRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_ApproveJobChange_Call) Return(_a0 *connect.Response[mgmtv1alpha1.ApproveJobChangeResponse], _a1 error) *MockJobServiceClient_ApproveJobChange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_ApproveJobChange_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.ApproveJobChangeRequest]) (*connect.Response[mgmtv1alpha1.ApproveJobChangeResponse], error)) *MockJobServiceClient_ApproveJobChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CancelJobRun provides a mock function with given fields: _a0, _a1
 func (_m *MockJobServiceClient) CancelJobRun(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CancelJobRunRequest]) (*connect.Response[mgmtv1alpha1.CancelJobRunResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -201,6 +260,65 @@ func (_c *MockJobServiceClient_CreateJobDestinationConnections_Call) RunAndRetur
 	return _c
 }
 
+// CreateJobFromTemplate provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) CreateJobFromTemplate(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CreateJobFromTemplateRequest]) (*connect.Response[mgmtv1alpha1.CreateJobFromTemplateResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJobFromTemplate")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.CreateJobFromTemplateResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateJobFromTemplateRequest]) (*connect.Response[mgmtv1alpha1.CreateJobFromTemplateResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateJobFromTemplateRequest]) *connect.Response[mgmtv1alpha1.CreateJobFromTemplateResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.CreateJobFromTemplateResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateJobFromTemplateRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_CreateJobFromTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJobFromTemplate'
+type MockJobServiceClient_CreateJobFromTemplate_Call struct {
+	*mock.Call
+}
+
+// CreateJobFromTemplate is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.CreateJobFromTemplateRequest]
+func (_e *MockJobServiceClient_Expecter) CreateJobFromTemplate(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_CreateJobFromTemplate_Call {
+	return &MockJobServiceClient_CreateJobFromTemplate_Call{Call: _e.mock.On("CreateJobFromTemplate", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_CreateJobFromTemplate_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CreateJobFromTemplateRequest])) *MockJobServiceClient_CreateJobFromTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.CreateJobFromTemplateRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_CreateJobFromTemplate_Call) Return(_a0 *connect.Response[mgmtv1alpha1.CreateJobFromTemplateResponse], _a1 error) *MockJobServiceClient_CreateJobFromTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_CreateJobFromTemplate_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.CreateJobFromTemplateRequest]) (*connect.Response[mgmtv1alpha1.CreateJobFromTemplateResponse], error)) *MockJobServiceClient_CreateJobFromTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateJobRun provides a mock function with given fields: _a0, _a1
 func (_m *MockJobServiceClient) CreateJobRun(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CreateJobRunRequest]) (*connect.Response[mgmtv1alpha1.CreateJobRunResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -260,6 +378,124 @@ func (_c *MockJobServiceClient_CreateJobRun_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// CreateJobRunArtifact provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) CreateJobRunArtifact(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CreateJobRunArtifactRequest]) (*connect.Response[mgmtv1alpha1.CreateJobRunArtifactResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJobRunArtifact")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.CreateJobRunArtifactResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateJobRunArtifactRequest]) (*connect.Response[mgmtv1alpha1.CreateJobRunArtifactResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateJobRunArtifactRequest]) *connect.Response[mgmtv1alpha1.CreateJobRunArtifactResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.CreateJobRunArtifactResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateJobRunArtifactRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_CreateJobRunArtifact_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJobRunArtifact'
+type MockJobServiceClient_CreateJobRunArtifact_Call struct {
+	*mock.Call
+}
+
+// CreateJobRunArtifact is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.CreateJobRunArtifactRequest]
+func (_e *MockJobServiceClient_Expecter) CreateJobRunArtifact(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_CreateJobRunArtifact_Call {
+	return &MockJobServiceClient_CreateJobRunArtifact_Call{Call: _e.mock.On("CreateJobRunArtifact", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_CreateJobRunArtifact_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CreateJobRunArtifactRequest])) *MockJobServiceClient_CreateJobRunArtifact_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.CreateJobRunArtifactRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_CreateJobRunArtifact_Call) Return(_a0 *connect.Response[mgmtv1alpha1.CreateJobRunArtifactResponse], _a1 error) *MockJobServiceClient_CreateJobRunArtifact_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_CreateJobRunArtifact_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.CreateJobRunArtifactRequest]) (*connect.Response[mgmtv1alpha1.CreateJobRunArtifactResponse], error)) *MockJobServiceClient_CreateJobRunArtifact_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateJobRunSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) CreateJobRunSnapshot(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CreateJobRunSnapshotRequest]) (*connect.Response[mgmtv1alpha1.CreateJobRunSnapshotResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJobRunSnapshot")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.CreateJobRunSnapshotResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateJobRunSnapshotRequest]) (*connect.Response[mgmtv1alpha1.CreateJobRunSnapshotResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateJobRunSnapshotRequest]) *connect.Response[mgmtv1alpha1.CreateJobRunSnapshotResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.CreateJobRunSnapshotResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateJobRunSnapshotRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_CreateJobRunSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJobRunSnapshot'
+type MockJobServiceClient_CreateJobRunSnapshot_Call struct {
+	*mock.Call
+}
+
+// CreateJobRunSnapshot is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.CreateJobRunSnapshotRequest]
+func (_e *MockJobServiceClient_Expecter) CreateJobRunSnapshot(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_CreateJobRunSnapshot_Call {
+	return &MockJobServiceClient_CreateJobRunSnapshot_Call{Call: _e.mock.On("CreateJobRunSnapshot", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_CreateJobRunSnapshot_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CreateJobRunSnapshotRequest])) *MockJobServiceClient_CreateJobRunSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.CreateJobRunSnapshotRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_CreateJobRunSnapshot_Call) Return(_a0 *connect.Response[mgmtv1alpha1.CreateJobRunSnapshotResponse], _a1 error) *MockJobServiceClient_CreateJobRunSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_CreateJobRunSnapshot_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.CreateJobRunSnapshotRequest]) (*connect.Response[mgmtv1alpha1.CreateJobRunSnapshotResponse], error)) *MockJobServiceClient_CreateJobRunSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteJob provides a mock function with given fields: _a0, _a1
 func (_m *MockJobServiceClient) DeleteJob(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.DeleteJobRequest]) (*connect.Response[mgmtv1alpha1.DeleteJobResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -437,28 +673,28 @@ func (_c *MockJobServiceClient_DeleteJobRun_Call) RunAndReturn(run func(context.
 	return _c
 }
 
-// GetJob provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceClient) GetJob(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRequest]) (*connect.Response[mgmtv1alpha1.GetJobResponse], error) {
+// DeleteJobRunSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) DeleteJobRunSnapshot(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.DeleteJobRunSnapshotRequest]) (*connect.Response[mgmtv1alpha1.DeleteJobRunSnapshotResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJob")
+		panic("no return value specified for DeleteJobRunSnapshot")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.GetJobResponse]
+	var r0 *connect.Response[mgmtv1alpha1.DeleteJobRunSnapshotResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRequest]) (*connect.Response[mgmtv1alpha1.GetJobResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.DeleteJobRunSnapshotRequest]) (*connect.Response[mgmtv1alpha1.DeleteJobRunSnapshotResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRequest]) *connect.Response[mgmtv1alpha1.GetJobResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.DeleteJobRunSnapshotRequest]) *connect.Response[mgmtv1alpha1.DeleteJobRunSnapshotResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.DeleteJobRunSnapshotResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.DeleteJobRunSnapshotRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -467,57 +703,57 @@ func (_m *MockJobServiceClient) GetJob(_a0 context.Context, _a1 *connect.Request
 	return r0, r1
 }
 
-// MockJobServiceClient_GetJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJob'
-type MockJobServiceClient_GetJob_Call struct {
+// MockJobServiceClient_DeleteJobRunSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteJobRunSnapshot'
+type MockJobServiceClient_DeleteJobRunSnapshot_Call struct {
 	*mock.Call
 }
 
-// GetJob is a helper method to define mock.On call
+// DeleteJobRunSnapshot is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRequest]
-func (_e *MockJobServiceClient_Expecter) GetJob(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJob_Call {
-	return &MockJobServiceClient_GetJob_Call{Call: _e.mock.On("GetJob", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.DeleteJobRunSnapshotRequest]
+func (_e *MockJobServiceClient_Expecter) DeleteJobRunSnapshot(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_DeleteJobRunSnapshot_Call {
+	return &MockJobServiceClient_DeleteJobRunSnapshot_Call{Call: _e.mock.On("DeleteJobRunSnapshot", _a0, _a1)}
 }
 
-func (_c *MockJobServiceClient_GetJob_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRequest])) *MockJobServiceClient_GetJob_Call {
+func (_c *MockJobServiceClient_DeleteJobRunSnapshot_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.DeleteJobRunSnapshotRequest])) *MockJobServiceClient_DeleteJobRunSnapshot_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.DeleteJobRunSnapshotRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceClient_GetJob_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobResponse], _a1 error) *MockJobServiceClient_GetJob_Call {
+func (_c *MockJobServiceClient_DeleteJobRunSnapshot_Call) Return(_a0 *connect.Response[mgmtv1alpha1.DeleteJobRunSnapshotResponse], _a1 error) *MockJobServiceClient_DeleteJobRunSnapshot_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceClient_GetJob_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRequest]) (*connect.Response[mgmtv1alpha1.GetJobResponse], error)) *MockJobServiceClient_GetJob_Call {
+func (_c *MockJobServiceClient_DeleteJobRunSnapshot_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.DeleteJobRunSnapshotRequest]) (*connect.Response[mgmtv1alpha1.DeleteJobRunSnapshotResponse], error)) *MockJobServiceClient_DeleteJobRunSnapshot_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetJobNextRuns provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceClient) GetJobNextRuns(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobNextRunsResponse], error) {
+// EstimateJobRun provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) EstimateJobRun(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.EstimateJobRunRequest]) (*connect.Response[mgmtv1alpha1.EstimateJobRunResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobNextRuns")
+		panic("no return value specified for EstimateJobRun")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.GetJobNextRunsResponse]
+	var r0 *connect.Response[mgmtv1alpha1.EstimateJobRunResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobNextRunsResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.EstimateJobRunRequest]) (*connect.Response[mgmtv1alpha1.EstimateJobRunResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]) *connect.Response[mgmtv1alpha1.GetJobNextRunsResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.EstimateJobRunRequest]) *connect.Response[mgmtv1alpha1.EstimateJobRunResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobNextRunsResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.EstimateJobRunResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.EstimateJobRunRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -526,57 +762,57 @@ func (_m *MockJobServiceClient) GetJobNextRuns(_a0 context.Context, _a1 *connect
 	return r0, r1
 }
 
-// MockJobServiceClient_GetJobNextRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobNextRuns'
-type MockJobServiceClient_GetJobNextRuns_Call struct {
+// MockJobServiceClient_EstimateJobRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EstimateJobRun'
+type MockJobServiceClient_EstimateJobRun_Call struct {
 	*mock.Call
 }
 
-// GetJobNextRuns is a helper method to define mock.On call
+// EstimateJobRun is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]
-func (_e *MockJobServiceClient_Expecter) GetJobNextRuns(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobNextRuns_Call {
-	return &MockJobServiceClient_GetJobNextRuns_Call{Call: _e.mock.On("GetJobNextRuns", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.EstimateJobRunRequest]
+func (_e *MockJobServiceClient_Expecter) EstimateJobRun(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_EstimateJobRun_Call {
+	return &MockJobServiceClient_EstimateJobRun_Call{Call: _e.mock.On("EstimateJobRun", _a0, _a1)}
 }
 
-func (_c *MockJobServiceClient_GetJobNextRuns_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest])) *MockJobServiceClient_GetJobNextRuns_Call {
+func (_c *MockJobServiceClient_EstimateJobRun_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.EstimateJobRunRequest])) *MockJobServiceClient_EstimateJobRun_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.EstimateJobRunRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceClient_GetJobNextRuns_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobNextRunsResponse], _a1 error) *MockJobServiceClient_GetJobNextRuns_Call {
+func (_c *MockJobServiceClient_EstimateJobRun_Call) Return(_a0 *connect.Response[mgmtv1alpha1.EstimateJobRunResponse], _a1 error) *MockJobServiceClient_EstimateJobRun_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceClient_GetJobNextRuns_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobNextRunsResponse], error)) *MockJobServiceClient_GetJobNextRuns_Call {
+func (_c *MockJobServiceClient_EstimateJobRun_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.EstimateJobRunRequest]) (*connect.Response[mgmtv1alpha1.EstimateJobRunResponse], error)) *MockJobServiceClient_EstimateJobRun_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetJobRecentRuns provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceClient) GetJobRecentRuns(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse], error) {
+// ForceCancelJobRun provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) ForceCancelJobRun(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ForceCancelJobRunRequest]) (*connect.Response[mgmtv1alpha1.ForceCancelJobRunResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobRecentRuns")
+		panic("no return value specified for ForceCancelJobRun")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse]
+	var r0 *connect.Response[mgmtv1alpha1.ForceCancelJobRunResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ForceCancelJobRunRequest]) (*connect.Response[mgmtv1alpha1.ForceCancelJobRunResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]) *connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ForceCancelJobRunRequest]) *connect.Response[mgmtv1alpha1.ForceCancelJobRunResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.ForceCancelJobRunResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.ForceCancelJobRunRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -585,57 +821,57 @@ func (_m *MockJobServiceClient) GetJobRecentRuns(_a0 context.Context, _a1 *conne
 	return r0, r1
 }
 
-// MockJobServiceClient_GetJobRecentRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRecentRuns'
-type MockJobServiceClient_GetJobRecentRuns_Call struct {
+// MockJobServiceClient_ForceCancelJobRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForceCancelJobRun'
+type MockJobServiceClient_ForceCancelJobRun_Call struct {
 	*mock.Call
 }
 
-// GetJobRecentRuns is a helper method to define mock.On call
+// ForceCancelJobRun is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]
-func (_e *MockJobServiceClient_Expecter) GetJobRecentRuns(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobRecentRuns_Call {
-	return &MockJobServiceClient_GetJobRecentRuns_Call{Call: _e.mock.On("GetJobRecentRuns", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.ForceCancelJobRunRequest]
+func (_e *MockJobServiceClient_Expecter) ForceCancelJobRun(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_ForceCancelJobRun_Call {
+	return &MockJobServiceClient_ForceCancelJobRun_Call{Call: _e.mock.On("ForceCancelJobRun", _a0, _a1)}
 }
 
-func (_c *MockJobServiceClient_GetJobRecentRuns_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest])) *MockJobServiceClient_GetJobRecentRuns_Call {
+func (_c *MockJobServiceClient_ForceCancelJobRun_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ForceCancelJobRunRequest])) *MockJobServiceClient_ForceCancelJobRun_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.ForceCancelJobRunRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceClient_GetJobRecentRuns_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse], _a1 error) *MockJobServiceClient_GetJobRecentRuns_Call {
+func (_c *MockJobServiceClient_ForceCancelJobRun_Call) Return(_a0 *connect.Response[mgmtv1alpha1.ForceCancelJobRunResponse], _a1 error) *MockJobServiceClient_ForceCancelJobRun_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceClient_GetJobRecentRuns_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse], error)) *MockJobServiceClient_GetJobRecentRuns_Call {
+func (_c *MockJobServiceClient_ForceCancelJobRun_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.ForceCancelJobRunRequest]) (*connect.Response[mgmtv1alpha1.ForceCancelJobRunResponse], error)) *MockJobServiceClient_ForceCancelJobRun_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetJobRun provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceClient) GetJobRun(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunResponse], error) {
+// GetAccountJobDefaults provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetAccountJobDefaults(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetAccountJobDefaultsRequest]) (*connect.Response[mgmtv1alpha1.GetAccountJobDefaultsResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobRun")
+		panic("no return value specified for GetAccountJobDefaults")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.GetJobRunResponse]
+	var r0 *connect.Response[mgmtv1alpha1.GetAccountJobDefaultsResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetAccountJobDefaultsRequest]) (*connect.Response[mgmtv1alpha1.GetAccountJobDefaultsResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunRequest]) *connect.Response[mgmtv1alpha1.GetJobRunResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetAccountJobDefaultsRequest]) *connect.Response[mgmtv1alpha1.GetAccountJobDefaultsResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetAccountJobDefaultsResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetAccountJobDefaultsRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -644,57 +880,57 @@ func (_m *MockJobServiceClient) GetJobRun(_a0 context.Context, _a1 *connect.Requ
 	return r0, r1
 }
 
-// MockJobServiceClient_GetJobRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRun'
-type MockJobServiceClient_GetJobRun_Call struct {
+// MockJobServiceClient_GetAccountJobDefaults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountJobDefaults'
+type MockJobServiceClient_GetAccountJobDefaults_Call struct {
 	*mock.Call
 }
 
-// GetJobRun is a helper method to define mock.On call
+// GetAccountJobDefaults is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunRequest]
-func (_e *MockJobServiceClient_Expecter) GetJobRun(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobRun_Call {
-	return &MockJobServiceClient_GetJobRun_Call{Call: _e.mock.On("GetJobRun", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.GetAccountJobDefaultsRequest]
+func (_e *MockJobServiceClient_Expecter) GetAccountJobDefaults(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetAccountJobDefaults_Call {
+	return &MockJobServiceClient_GetAccountJobDefaults_Call{Call: _e.mock.On("GetAccountJobDefaults", _a0, _a1)}
 }
 
-func (_c *MockJobServiceClient_GetJobRun_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunRequest])) *MockJobServiceClient_GetJobRun_Call {
+func (_c *MockJobServiceClient_GetAccountJobDefaults_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetAccountJobDefaultsRequest])) *MockJobServiceClient_GetAccountJobDefaults_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetAccountJobDefaultsRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceClient_GetJobRun_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunResponse], _a1 error) *MockJobServiceClient_GetJobRun_Call {
+func (_c *MockJobServiceClient_GetAccountJobDefaults_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetAccountJobDefaultsResponse], _a1 error) *MockJobServiceClient_GetAccountJobDefaults_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceClient_GetJobRun_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunResponse], error)) *MockJobServiceClient_GetJobRun_Call {
+func (_c *MockJobServiceClient_GetAccountJobDefaults_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetAccountJobDefaultsRequest]) (*connect.Response[mgmtv1alpha1.GetAccountJobDefaultsResponse], error)) *MockJobServiceClient_GetAccountJobDefaults_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetJobRunEvents provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceClient) GetJobRunEvents(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunEventsResponse], error) {
+// GetJob provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJob(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRequest]) (*connect.Response[mgmtv1alpha1.GetJobResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobRunEvents")
+		panic("no return value specified for GetJob")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.GetJobRunEventsResponse]
+	var r0 *connect.Response[mgmtv1alpha1.GetJobResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunEventsResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRequest]) (*connect.Response[mgmtv1alpha1.GetJobResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]) *connect.Response[mgmtv1alpha1.GetJobRunEventsResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRequest]) *connect.Response[mgmtv1alpha1.GetJobResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunEventsResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -703,57 +939,1296 @@ func (_m *MockJobServiceClient) GetJobRunEvents(_a0 context.Context, _a1 *connec
 	return r0, r1
 }
 
-// MockJobServiceClient_GetJobRunEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunEvents'
-type MockJobServiceClient_GetJobRunEvents_Call struct {
+// MockJobServiceClient_GetJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJob'
+type MockJobServiceClient_GetJob_Call struct {
+	*mock.Call
+}
+
+// GetJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRequest]
+func (_e *MockJobServiceClient_Expecter) GetJob(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJob_Call {
+	return &MockJobServiceClient_GetJob_Call{Call: _e.mock.On("GetJob", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_GetJob_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRequest])) *MockJobServiceClient_GetJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJob_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobResponse], _a1 error) *MockJobServiceClient_GetJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJob_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRequest]) (*connect.Response[mgmtv1alpha1.GetJobResponse], error)) *MockJobServiceClient_GetJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobChangeRequests provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobChangeRequests(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobChangeRequestsRequest]) (*connect.Response[mgmtv1alpha1.GetJobChangeRequestsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobChangeRequests")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobChangeRequestsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobChangeRequestsRequest]) (*connect.Response[mgmtv1alpha1.GetJobChangeRequestsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobChangeRequestsRequest]) *connect.Response[mgmtv1alpha1.GetJobChangeRequestsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobChangeRequestsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobChangeRequestsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_GetJobChangeRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobChangeRequests'
+type MockJobServiceClient_GetJobChangeRequests_Call struct {
+	*mock.Call
+}
+
+// GetJobChangeRequests is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobChangeRequestsRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobChangeRequests(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobChangeRequests_Call {
+	return &MockJobServiceClient_GetJobChangeRequests_Call{Call: _e.mock.On("GetJobChangeRequests", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_GetJobChangeRequests_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobChangeRequestsRequest])) *MockJobServiceClient_GetJobChangeRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobChangeRequestsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobChangeRequests_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobChangeRequestsResponse], _a1 error) *MockJobServiceClient_GetJobChangeRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobChangeRequests_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobChangeRequestsRequest]) (*connect.Response[mgmtv1alpha1.GetJobChangeRequestsResponse], error)) *MockJobServiceClient_GetJobChangeRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobNextRuns provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobNextRuns(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobNextRunsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobNextRuns")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobNextRunsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobNextRunsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]) *connect.Response[mgmtv1alpha1.GetJobNextRunsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobNextRunsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_GetJobNextRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobNextRuns'
+type MockJobServiceClient_GetJobNextRuns_Call struct {
+	*mock.Call
+}
+
+// GetJobNextRuns is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobNextRuns(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobNextRuns_Call {
+	return &MockJobServiceClient_GetJobNextRuns_Call{Call: _e.mock.On("GetJobNextRuns", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_GetJobNextRuns_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest])) *MockJobServiceClient_GetJobNextRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobNextRuns_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobNextRunsResponse], _a1 error) *MockJobServiceClient_GetJobNextRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobNextRuns_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobNextRunsResponse], error)) *MockJobServiceClient_GetJobNextRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRecentRuns provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobRecentRuns(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRecentRuns")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]) *connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_GetJobRecentRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRecentRuns'
+type MockJobServiceClient_GetJobRecentRuns_Call struct {
+	*mock.Call
+}
+
+// GetJobRecentRuns is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobRecentRuns(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobRecentRuns_Call {
+	return &MockJobServiceClient_GetJobRecentRuns_Call{Call: _e.mock.On("GetJobRecentRuns", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_GetJobRecentRuns_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest])) *MockJobServiceClient_GetJobRecentRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRecentRuns_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse], _a1 error) *MockJobServiceClient_GetJobRecentRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRecentRuns_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse], error)) *MockJobServiceClient_GetJobRecentRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRun provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobRun(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRun")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRunResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunRequest]) *connect.Response[mgmtv1alpha1.GetJobRunResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_GetJobRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRun'
+type MockJobServiceClient_GetJobRun_Call struct {
+	*mock.Call
+}
+
+// GetJobRun is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobRun(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobRun_Call {
+	return &MockJobServiceClient_GetJobRun_Call{Call: _e.mock.On("GetJobRun", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_GetJobRun_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunRequest])) *MockJobServiceClient_GetJobRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRun_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunResponse], _a1 error) *MockJobServiceClient_GetJobRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRun_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunResponse], error)) *MockJobServiceClient_GetJobRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRunArtifacts provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobRunArtifacts(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunArtifactsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunArtifactsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunArtifacts")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRunArtifactsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunArtifactsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunArtifactsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunArtifactsRequest]) *connect.Response[mgmtv1alpha1.GetJobRunArtifactsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunArtifactsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunArtifactsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_GetJobRunArtifacts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunArtifacts'
+type MockJobServiceClient_GetJobRunArtifacts_Call struct {
+	*mock.Call
+}
+
+// GetJobRunArtifacts is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunArtifactsRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobRunArtifacts(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobRunArtifacts_Call {
+	return &MockJobServiceClient_GetJobRunArtifacts_Call{Call: _e.mock.On("GetJobRunArtifacts", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_GetJobRunArtifacts_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunArtifactsRequest])) *MockJobServiceClient_GetJobRunArtifacts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunArtifactsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRunArtifacts_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunArtifactsResponse], _a1 error) *MockJobServiceClient_GetJobRunArtifacts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRunArtifacts_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunArtifactsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunArtifactsResponse], error)) *MockJobServiceClient_GetJobRunArtifacts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRunDataQuality provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobRunDataQuality(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunDataQualityRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunDataQualityResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunDataQuality")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRunDataQualityResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunDataQualityRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunDataQualityResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunDataQualityRequest]) *connect.Response[mgmtv1alpha1.GetJobRunDataQualityResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunDataQualityResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunDataQualityRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_GetJobRunDataQuality_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunDataQuality'
+type MockJobServiceClient_GetJobRunDataQuality_Call struct {
+	*mock.Call
+}
+
+// GetJobRunDataQuality is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunDataQualityRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobRunDataQuality(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobRunDataQuality_Call {
+	return &MockJobServiceClient_GetJobRunDataQuality_Call{Call: _e.mock.On("GetJobRunDataQuality", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_GetJobRunDataQuality_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunDataQualityRequest])) *MockJobServiceClient_GetJobRunDataQuality_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunDataQualityRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRunDataQuality_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunDataQualityResponse], _a1 error) *MockJobServiceClient_GetJobRunDataQuality_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRunDataQuality_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunDataQualityRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunDataQualityResponse], error)) *MockJobServiceClient_GetJobRunDataQuality_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRunEvents provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobRunEvents(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunEventsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunEvents")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRunEventsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunEventsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]) *connect.Response[mgmtv1alpha1.GetJobRunEventsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunEventsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_GetJobRunEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunEvents'
+type MockJobServiceClient_GetJobRunEvents_Call struct {
 	*mock.Call
 }
 
 // GetJobRunEvents is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]
-func (_e *MockJobServiceClient_Expecter) GetJobRunEvents(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobRunEvents_Call {
-	return &MockJobServiceClient_GetJobRunEvents_Call{Call: _e.mock.On("GetJobRunEvents", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobRunEvents(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobRunEvents_Call {
+	return &MockJobServiceClient_GetJobRunEvents_Call{Call: _e.mock.On("GetJobRunEvents", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_GetJobRunEvents_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest])) *MockJobServiceClient_GetJobRunEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRunEvents_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunEventsResponse], _a1 error) *MockJobServiceClient_GetJobRunEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRunEvents_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunEventsResponse], error)) *MockJobServiceClient_GetJobRunEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRunLogsStream provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobRunLogsStream(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest]) (*connect.ServerStreamForClient[mgmtv1alpha1.GetJobRunLogsStreamResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunLogsStream")
+	}
+
+	var r0 *connect.ServerStreamForClient[mgmtv1alpha1.GetJobRunLogsStreamResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest]) (*connect.ServerStreamForClient[mgmtv1alpha1.GetJobRunLogsStreamResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest]) *connect.ServerStreamForClient[mgmtv1alpha1.GetJobRunLogsStreamResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.ServerStreamForClient[mgmtv1alpha1.GetJobRunLogsStreamResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_GetJobRunLogsStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunLogsStream'
+type MockJobServiceClient_GetJobRunLogsStream_Call struct {
+	*mock.Call
+}
+
+// GetJobRunLogsStream is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobRunLogsStream(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobRunLogsStream_Call {
+	return &MockJobServiceClient_GetJobRunLogsStream_Call{Call: _e.mock.On("GetJobRunLogsStream", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_GetJobRunLogsStream_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest])) *MockJobServiceClient_GetJobRunLogsStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRunLogsStream_Call) Return(_a0 *connect.ServerStreamForClient[mgmtv1alpha1.GetJobRunLogsStreamResponse], _a1 error) *MockJobServiceClient_GetJobRunLogsStream_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRunLogsStream_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest]) (*connect.ServerStreamForClient[mgmtv1alpha1.GetJobRunLogsStreamResponse], error)) *MockJobServiceClient_GetJobRunLogsStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRunMaskingProgress provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobRunMaskingProgress(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunMaskingProgressRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunMaskingProgressResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunMaskingProgress")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRunMaskingProgressResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunMaskingProgressRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunMaskingProgressResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunMaskingProgressRequest]) *connect.Response[mgmtv1alpha1.GetJobRunMaskingProgressResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunMaskingProgressResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunMaskingProgressRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_GetJobRunMaskingProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunMaskingProgress'
+type MockJobServiceClient_GetJobRunMaskingProgress_Call struct {
+	*mock.Call
+}
+
+// GetJobRunMaskingProgress is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunMaskingProgressRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobRunMaskingProgress(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobRunMaskingProgress_Call {
+	return &MockJobServiceClient_GetJobRunMaskingProgress_Call{Call: _e.mock.On("GetJobRunMaskingProgress", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_GetJobRunMaskingProgress_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunMaskingProgressRequest])) *MockJobServiceClient_GetJobRunMaskingProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunMaskingProgressRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRunMaskingProgress_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunMaskingProgressResponse], _a1 error) *MockJobServiceClient_GetJobRunMaskingProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRunMaskingProgress_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunMaskingProgressRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunMaskingProgressResponse], error)) *MockJobServiceClient_GetJobRunMaskingProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRunParameters provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobRunParameters(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunParametersRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunParametersResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunParameters")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRunParametersResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunParametersRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunParametersResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunParametersRequest]) *connect.Response[mgmtv1alpha1.GetJobRunParametersResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunParametersResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunParametersRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_GetJobRunParameters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunParameters'
+type MockJobServiceClient_GetJobRunParameters_Call struct {
+	*mock.Call
+}
+
+// GetJobRunParameters is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunParametersRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobRunParameters(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobRunParameters_Call {
+	return &MockJobServiceClient_GetJobRunParameters_Call{Call: _e.mock.On("GetJobRunParameters", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_GetJobRunParameters_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunParametersRequest])) *MockJobServiceClient_GetJobRunParameters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunParametersRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRunParameters_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunParametersResponse], _a1 error) *MockJobServiceClient_GetJobRunParameters_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRunParameters_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunParametersRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunParametersResponse], error)) *MockJobServiceClient_GetJobRunParameters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRunPriority provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobRunPriority(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunPriorityRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunPriorityResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunPriority")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRunPriorityResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunPriorityRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunPriorityResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunPriorityRequest]) *connect.Response[mgmtv1alpha1.GetJobRunPriorityResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunPriorityResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunPriorityRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_GetJobRunPriority_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunPriority'
+type MockJobServiceClient_GetJobRunPriority_Call struct {
+	*mock.Call
+}
+
+// GetJobRunPriority is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunPriorityRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobRunPriority(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobRunPriority_Call {
+	return &MockJobServiceClient_GetJobRunPriority_Call{Call: _e.mock.On("GetJobRunPriority", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_GetJobRunPriority_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunPriorityRequest])) *MockJobServiceClient_GetJobRunPriority_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunPriorityRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRunPriority_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunPriorityResponse], _a1 error) *MockJobServiceClient_GetJobRunPriority_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRunPriority_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunPriorityRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunPriorityResponse], error)) *MockJobServiceClient_GetJobRunPriority_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRunRowCounts provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobRunRowCounts(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunRowCountsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunRowCountsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunRowCounts")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRunRowCountsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunRowCountsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunRowCountsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunRowCountsRequest]) *connect.Response[mgmtv1alpha1.GetJobRunRowCountsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunRowCountsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunRowCountsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_GetJobRunRowCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunRowCounts'
+type MockJobServiceClient_GetJobRunRowCounts_Call struct {
+	*mock.Call
+}
+
+// GetJobRunRowCounts is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunRowCountsRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobRunRowCounts(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobRunRowCounts_Call {
+	return &MockJobServiceClient_GetJobRunRowCounts_Call{Call: _e.mock.On("GetJobRunRowCounts", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_GetJobRunRowCounts_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunRowCountsRequest])) *MockJobServiceClient_GetJobRunRowCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunRowCountsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRunRowCounts_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunRowCountsResponse], _a1 error) *MockJobServiceClient_GetJobRunRowCounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRunRowCounts_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunRowCountsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunRowCountsResponse], error)) *MockJobServiceClient_GetJobRunRowCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRunSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobRunSnapshot(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunSnapshotRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunSnapshotResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunSnapshot")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRunSnapshotResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunSnapshotRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunSnapshotResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunSnapshotRequest]) *connect.Response[mgmtv1alpha1.GetJobRunSnapshotResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunSnapshotResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunSnapshotRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_GetJobRunSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunSnapshot'
+type MockJobServiceClient_GetJobRunSnapshot_Call struct {
+	*mock.Call
+}
+
+// GetJobRunSnapshot is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunSnapshotRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobRunSnapshot(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobRunSnapshot_Call {
+	return &MockJobServiceClient_GetJobRunSnapshot_Call{Call: _e.mock.On("GetJobRunSnapshot", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_GetJobRunSnapshot_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunSnapshotRequest])) *MockJobServiceClient_GetJobRunSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunSnapshotRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRunSnapshot_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunSnapshotResponse], _a1 error) *MockJobServiceClient_GetJobRunSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRunSnapshot_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunSnapshotRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunSnapshotResponse], error)) *MockJobServiceClient_GetJobRunSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRunSnapshots provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobRunSnapshots(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunSnapshotsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunSnapshotsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunSnapshots")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRunSnapshotsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunSnapshotsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunSnapshotsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunSnapshotsRequest]) *connect.Response[mgmtv1alpha1.GetJobRunSnapshotsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunSnapshotsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunSnapshotsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_GetJobRunSnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunSnapshots'
+type MockJobServiceClient_GetJobRunSnapshots_Call struct {
+	*mock.Call
+}
+
+// GetJobRunSnapshots is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunSnapshotsRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobRunSnapshots(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobRunSnapshots_Call {
+	return &MockJobServiceClient_GetJobRunSnapshots_Call{Call: _e.mock.On("GetJobRunSnapshots", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_GetJobRunSnapshots_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunSnapshotsRequest])) *MockJobServiceClient_GetJobRunSnapshots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunSnapshotsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRunSnapshots_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunSnapshotsResponse], _a1 error) *MockJobServiceClient_GetJobRunSnapshots_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRunSnapshots_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunSnapshotsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunSnapshotsResponse], error)) *MockJobServiceClient_GetJobRunSnapshots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRuns provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobRuns(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRuns")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRunsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunsRequest]) *connect.Response[mgmtv1alpha1.GetJobRunsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_GetJobRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRuns'
+type MockJobServiceClient_GetJobRuns_Call struct {
+	*mock.Call
+}
+
+// GetJobRuns is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunsRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobRuns(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobRuns_Call {
+	return &MockJobServiceClient_GetJobRuns_Call{Call: _e.mock.On("GetJobRuns", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_GetJobRuns_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunsRequest])) *MockJobServiceClient_GetJobRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRuns_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunsResponse], _a1 error) *MockJobServiceClient_GetJobRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRuns_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunsResponse], error)) *MockJobServiceClient_GetJobRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobStatus provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobStatus(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobStatusRequest]) (*connect.Response[mgmtv1alpha1.GetJobStatusResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobStatus")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobStatusResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusRequest]) (*connect.Response[mgmtv1alpha1.GetJobStatusResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusRequest]) *connect.Response[mgmtv1alpha1.GetJobStatusResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobStatusResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_GetJobStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobStatus'
+type MockJobServiceClient_GetJobStatus_Call struct {
+	*mock.Call
+}
+
+// GetJobStatus is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobStatusRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobStatus(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobStatus_Call {
+	return &MockJobServiceClient_GetJobStatus_Call{Call: _e.mock.On("GetJobStatus", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_GetJobStatus_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobStatusRequest])) *MockJobServiceClient_GetJobStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobStatusRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobStatus_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobStatusResponse], _a1 error) *MockJobServiceClient_GetJobStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobStatus_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusRequest]) (*connect.Response[mgmtv1alpha1.GetJobStatusResponse], error)) *MockJobServiceClient_GetJobStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobStatuses provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobStatuses(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobStatusesRequest]) (*connect.Response[mgmtv1alpha1.GetJobStatusesResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobStatuses")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobStatusesResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusesRequest]) (*connect.Response[mgmtv1alpha1.GetJobStatusesResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusesRequest]) *connect.Response[mgmtv1alpha1.GetJobStatusesResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobStatusesResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusesRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_GetJobStatuses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobStatuses'
+type MockJobServiceClient_GetJobStatuses_Call struct {
+	*mock.Call
+}
+
+// GetJobStatuses is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobStatusesRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobStatuses(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobStatuses_Call {
+	return &MockJobServiceClient_GetJobStatuses_Call{Call: _e.mock.On("GetJobStatuses", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_GetJobStatuses_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobStatusesRequest])) *MockJobServiceClient_GetJobStatuses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobStatusesRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobStatuses_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobStatusesResponse], _a1 error) *MockJobServiceClient_GetJobStatuses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobStatuses_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusesRequest]) (*connect.Response[mgmtv1alpha1.GetJobStatusesResponse], error)) *MockJobServiceClient_GetJobStatuses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobTemplates provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobTemplates(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobTemplatesRequest]) (*connect.Response[mgmtv1alpha1.GetJobTemplatesResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobTemplates")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobTemplatesResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobTemplatesRequest]) (*connect.Response[mgmtv1alpha1.GetJobTemplatesResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobTemplatesRequest]) *connect.Response[mgmtv1alpha1.GetJobTemplatesResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobTemplatesResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobTemplatesRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_GetJobTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobTemplates'
+type MockJobServiceClient_GetJobTemplates_Call struct {
+	*mock.Call
+}
+
+// GetJobTemplates is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobTemplatesRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobTemplates(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobTemplates_Call {
+	return &MockJobServiceClient_GetJobTemplates_Call{Call: _e.mock.On("GetJobTemplates", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_GetJobTemplates_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobTemplatesRequest])) *MockJobServiceClient_GetJobTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobTemplatesRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobTemplates_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobTemplatesResponse], _a1 error) *MockJobServiceClient_GetJobTemplates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobTemplates_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobTemplatesRequest]) (*connect.Response[mgmtv1alpha1.GetJobTemplatesResponse], error)) *MockJobServiceClient_GetJobTemplates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobs provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobs(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobs")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) *connect.Response[mgmtv1alpha1.GetJobsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_GetJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobs'
+type MockJobServiceClient_GetJobs_Call struct {
+	*mock.Call
+}
+
+// GetJobs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobsRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobs(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobs_Call {
+	return &MockJobServiceClient_GetJobs_Call{Call: _e.mock.On("GetJobs", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_GetJobs_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobsRequest])) *MockJobServiceClient_GetJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobs_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobsResponse], _a1 error) *MockJobServiceClient_GetJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobs_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsResponse], error)) *MockJobServiceClient_GetJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobsOverridingDefaults provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobsOverridingDefaults(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobsOverridingDefaultsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsOverridingDefaultsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobsOverridingDefaults")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobsOverridingDefaultsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsOverridingDefaultsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsOverridingDefaultsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsOverridingDefaultsRequest]) *connect.Response[mgmtv1alpha1.GetJobsOverridingDefaultsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobsOverridingDefaultsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsOverridingDefaultsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_GetJobsOverridingDefaults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobsOverridingDefaults'
+type MockJobServiceClient_GetJobsOverridingDefaults_Call struct {
+	*mock.Call
+}
+
+// GetJobsOverridingDefaults is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobsOverridingDefaultsRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobsOverridingDefaults(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobsOverridingDefaults_Call {
+	return &MockJobServiceClient_GetJobsOverridingDefaults_Call{Call: _e.mock.On("GetJobsOverridingDefaults", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_GetJobsOverridingDefaults_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobsOverridingDefaultsRequest])) *MockJobServiceClient_GetJobsOverridingDefaults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobsOverridingDefaultsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobsOverridingDefaults_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobsOverridingDefaultsResponse], _a1 error) *MockJobServiceClient_GetJobsOverridingDefaults_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobsOverridingDefaults_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsOverridingDefaultsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsOverridingDefaultsResponse], error)) *MockJobServiceClient_GetJobsOverridingDefaults_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNextScheduledRuns provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetNextScheduledRuns(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetNextScheduledRunsRequest]) (*connect.Response[mgmtv1alpha1.GetNextScheduledRunsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNextScheduledRuns")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetNextScheduledRunsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetNextScheduledRunsRequest]) (*connect.Response[mgmtv1alpha1.GetNextScheduledRunsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetNextScheduledRunsRequest]) *connect.Response[mgmtv1alpha1.GetNextScheduledRunsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetNextScheduledRunsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetNextScheduledRunsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_GetNextScheduledRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNextScheduledRuns'
+type MockJobServiceClient_GetNextScheduledRuns_Call struct {
+	*mock.Call
+}
+
+// GetNextScheduledRuns is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetNextScheduledRunsRequest]
+func (_e *MockJobServiceClient_Expecter) GetNextScheduledRuns(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetNextScheduledRuns_Call {
+	return &MockJobServiceClient_GetNextScheduledRuns_Call{Call: _e.mock.On("GetNextScheduledRuns", _a0, _a1)}
 }
 
-func (_c *MockJobServiceClient_GetJobRunEvents_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest])) *MockJobServiceClient_GetJobRunEvents_Call {
+func (_c *MockJobServiceClient_GetNextScheduledRuns_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetNextScheduledRunsRequest])) *MockJobServiceClient_GetNextScheduledRuns_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetNextScheduledRunsRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceClient_GetJobRunEvents_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunEventsResponse], _a1 error) *MockJobServiceClient_GetJobRunEvents_Call {
+func (_c *MockJobServiceClient_GetNextScheduledRuns_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetNextScheduledRunsResponse], _a1 error) *MockJobServiceClient_GetNextScheduledRuns_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceClient_GetJobRunEvents_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunEventsResponse], error)) *MockJobServiceClient_GetJobRunEvents_Call {
+func (_c *MockJobServiceClient_GetNextScheduledRuns_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetNextScheduledRunsRequest]) (*connect.Response[mgmtv1alpha1.GetNextScheduledRunsResponse], error)) *MockJobServiceClient_GetNextScheduledRuns_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetJobRunLogsStream provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceClient) GetJobRunLogsStream(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest]) (*connect.ServerStreamForClient[mgmtv1alpha1.GetJobRunLogsStreamResponse], error) {
+// IsJobNameAvailable provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) IsJobNameAvailable(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobRunLogsStream")
+		panic("no return value specified for IsJobNameAvailable")
 	}
 
-	var r0 *connect.ServerStreamForClient[mgmtv1alpha1.GetJobRunLogsStreamResponse]
+	var r0 *connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest]) (*connect.ServerStreamForClient[mgmtv1alpha1.GetJobRunLogsStreamResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest]) *connect.ServerStreamForClient[mgmtv1alpha1.GetJobRunLogsStreamResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) *connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.ServerStreamForClient[mgmtv1alpha1.GetJobRunLogsStreamResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -762,57 +2237,57 @@ func (_m *MockJobServiceClient) GetJobRunLogsStream(_a0 context.Context, _a1 *co
 	return r0, r1
 }
 
-// MockJobServiceClient_GetJobRunLogsStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunLogsStream'
-type MockJobServiceClient_GetJobRunLogsStream_Call struct {
+// MockJobServiceClient_IsJobNameAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsJobNameAvailable'
+type MockJobServiceClient_IsJobNameAvailable_Call struct {
 	*mock.Call
 }
 
-// GetJobRunLogsStream is a helper method to define mock.On call
+// IsJobNameAvailable is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest]
-func (_e *MockJobServiceClient_Expecter) GetJobRunLogsStream(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobRunLogsStream_Call {
-	return &MockJobServiceClient_GetJobRunLogsStream_Call{Call: _e.mock.On("GetJobRunLogsStream", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]
+func (_e *MockJobServiceClient_Expecter) IsJobNameAvailable(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_IsJobNameAvailable_Call {
+	return &MockJobServiceClient_IsJobNameAvailable_Call{Call: _e.mock.On("IsJobNameAvailable", _a0, _a1)}
 }
 
-func (_c *MockJobServiceClient_GetJobRunLogsStream_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest])) *MockJobServiceClient_GetJobRunLogsStream_Call {
+func (_c *MockJobServiceClient_IsJobNameAvailable_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest])) *MockJobServiceClient_IsJobNameAvailable_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceClient_GetJobRunLogsStream_Call) Return(_a0 *connect.ServerStreamForClient[mgmtv1alpha1.GetJobRunLogsStreamResponse], _a1 error) *MockJobServiceClient_GetJobRunLogsStream_Call {
+func (_c *MockJobServiceClient_IsJobNameAvailable_Call) Return(_a0 *connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], _a1 error) *MockJobServiceClient_IsJobNameAvailable_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceClient_GetJobRunLogsStream_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest]) (*connect.ServerStreamForClient[mgmtv1alpha1.GetJobRunLogsStreamResponse], error)) *MockJobServiceClient_GetJobRunLogsStream_Call {
+func (_c *MockJobServiceClient_IsJobNameAvailable_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], error)) *MockJobServiceClient_IsJobNameAvailable_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetJobRuns provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceClient) GetJobRuns(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunsResponse], error) {
+// PauseJob provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) PauseJob(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.PauseJobRequest]) (*connect.Response[mgmtv1alpha1.PauseJobResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobRuns")
+		panic("no return value specified for PauseJob")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.GetJobRunsResponse]
+	var r0 *connect.Response[mgmtv1alpha1.PauseJobResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunsResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) (*connect.Response[mgmtv1alpha1.PauseJobResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunsRequest]) *connect.Response[mgmtv1alpha1.GetJobRunsResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) *connect.Response[mgmtv1alpha1.PauseJobResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunsResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.PauseJobResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunsRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -821,57 +2296,57 @@ func (_m *MockJobServiceClient) GetJobRuns(_a0 context.Context, _a1 *connect.Req
 	return r0, r1
 }
 
-// MockJobServiceClient_GetJobRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRuns'
-type MockJobServiceClient_GetJobRuns_Call struct {
+// MockJobServiceClient_PauseJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseJob'
+type MockJobServiceClient_PauseJob_Call struct {
 	*mock.Call
 }
 
-// GetJobRuns is a helper method to define mock.On call
+// PauseJob is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunsRequest]
-func (_e *MockJobServiceClient_Expecter) GetJobRuns(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobRuns_Call {
-	return &MockJobServiceClient_GetJobRuns_Call{Call: _e.mock.On("GetJobRuns", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.PauseJobRequest]
+func (_e *MockJobServiceClient_Expecter) PauseJob(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_PauseJob_Call {
+	return &MockJobServiceClient_PauseJob_Call{Call: _e.mock.On("PauseJob", _a0, _a1)}
 }
 
-func (_c *MockJobServiceClient_GetJobRuns_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunsRequest])) *MockJobServiceClient_GetJobRuns_Call {
+func (_c *MockJobServiceClient_PauseJob_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.PauseJobRequest])) *MockJobServiceClient_PauseJob_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunsRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.PauseJobRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceClient_GetJobRuns_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunsResponse], _a1 error) *MockJobServiceClient_GetJobRuns_Call {
+func (_c *MockJobServiceClient_PauseJob_Call) Return(_a0 *connect.Response[mgmtv1alpha1.PauseJobResponse], _a1 error) *MockJobServiceClient_PauseJob_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceClient_GetJobRuns_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunsResponse], error)) *MockJobServiceClient_GetJobRuns_Call {
+func (_c *MockJobServiceClient_PauseJob_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) (*connect.Response[mgmtv1alpha1.PauseJobResponse], error)) *MockJobServiceClient_PauseJob_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetJobStatus provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceClient) GetJobStatus(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobStatusRequest]) (*connect.Response[mgmtv1alpha1.GetJobStatusResponse], error) {
+// ResumeJob provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) ResumeJob(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ResumeJobRequest]) (*connect.Response[mgmtv1alpha1.ResumeJobResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobStatus")
+		panic("no return value specified for ResumeJob")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.GetJobStatusResponse]
+	var r0 *connect.Response[mgmtv1alpha1.ResumeJobResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusRequest]) (*connect.Response[mgmtv1alpha1.GetJobStatusResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ResumeJobRequest]) (*connect.Response[mgmtv1alpha1.ResumeJobResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusRequest]) *connect.Response[mgmtv1alpha1.GetJobStatusResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ResumeJobRequest]) *connect.Response[mgmtv1alpha1.ResumeJobResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobStatusResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.ResumeJobResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.ResumeJobRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -880,57 +2355,57 @@ func (_m *MockJobServiceClient) GetJobStatus(_a0 context.Context, _a1 *connect.R
 	return r0, r1
 }
 
-// MockJobServiceClient_GetJobStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobStatus'
-type MockJobServiceClient_GetJobStatus_Call struct {
+// MockJobServiceClient_ResumeJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeJob'
+type MockJobServiceClient_ResumeJob_Call struct {
 	*mock.Call
 }
 
-// GetJobStatus is a helper method to define mock.On call
+// ResumeJob is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.GetJobStatusRequest]
-func (_e *MockJobServiceClient_Expecter) GetJobStatus(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobStatus_Call {
-	return &MockJobServiceClient_GetJobStatus_Call{Call: _e.mock.On("GetJobStatus", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.ResumeJobRequest]
+func (_e *MockJobServiceClient_Expecter) ResumeJob(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_ResumeJob_Call {
+	return &MockJobServiceClient_ResumeJob_Call{Call: _e.mock.On("ResumeJob", _a0, _a1)}
 }
 
-func (_c *MockJobServiceClient_GetJobStatus_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobStatusRequest])) *MockJobServiceClient_GetJobStatus_Call {
+func (_c *MockJobServiceClient_ResumeJob_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ResumeJobRequest])) *MockJobServiceClient_ResumeJob_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobStatusRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.ResumeJobRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceClient_GetJobStatus_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobStatusResponse], _a1 error) *MockJobServiceClient_GetJobStatus_Call {
+func (_c *MockJobServiceClient_ResumeJob_Call) Return(_a0 *connect.Response[mgmtv1alpha1.ResumeJobResponse], _a1 error) *MockJobServiceClient_ResumeJob_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceClient_GetJobStatus_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusRequest]) (*connect.Response[mgmtv1alpha1.GetJobStatusResponse], error)) *MockJobServiceClient_GetJobStatus_Call {
+func (_c *MockJobServiceClient_ResumeJob_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.ResumeJobRequest]) (*connect.Response[mgmtv1alpha1.ResumeJobResponse], error)) *MockJobServiceClient_ResumeJob_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetJobStatuses provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceClient) GetJobStatuses(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobStatusesRequest]) (*connect.Response[mgmtv1alpha1.GetJobStatusesResponse], error) {
+// SetAccountJobDefaults provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) SetAccountJobDefaults(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetAccountJobDefaultsRequest]) (*connect.Response[mgmtv1alpha1.SetAccountJobDefaultsResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobStatuses")
+		panic("no return value specified for SetAccountJobDefaults")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.GetJobStatusesResponse]
+	var r0 *connect.Response[mgmtv1alpha1.SetAccountJobDefaultsResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusesRequest]) (*connect.Response[mgmtv1alpha1.GetJobStatusesResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetAccountJobDefaultsRequest]) (*connect.Response[mgmtv1alpha1.SetAccountJobDefaultsResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusesRequest]) *connect.Response[mgmtv1alpha1.GetJobStatusesResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetAccountJobDefaultsRequest]) *connect.Response[mgmtv1alpha1.SetAccountJobDefaultsResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobStatusesResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetAccountJobDefaultsResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusesRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetAccountJobDefaultsRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -939,57 +2414,57 @@ func (_m *MockJobServiceClient) GetJobStatuses(_a0 context.Context, _a1 *connect
 	return r0, r1
 }
 
-// MockJobServiceClient_GetJobStatuses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobStatuses'
-type MockJobServiceClient_GetJobStatuses_Call struct {
+// MockJobServiceClient_SetAccountJobDefaults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAccountJobDefaults'
+type MockJobServiceClient_SetAccountJobDefaults_Call struct {
 	*mock.Call
 }
 
-// GetJobStatuses is a helper method to define mock.On call
+// SetAccountJobDefaults is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.GetJobStatusesRequest]
-func (_e *MockJobServiceClient_Expecter) GetJobStatuses(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobStatuses_Call {
-	return &MockJobServiceClient_GetJobStatuses_Call{Call: _e.mock.On("GetJobStatuses", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.SetAccountJobDefaultsRequest]
+func (_e *MockJobServiceClient_Expecter) SetAccountJobDefaults(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_SetAccountJobDefaults_Call {
+	return &MockJobServiceClient_SetAccountJobDefaults_Call{Call: _e.mock.On("SetAccountJobDefaults", _a0, _a1)}
 }
 
-func (_c *MockJobServiceClient_GetJobStatuses_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobStatusesRequest])) *MockJobServiceClient_GetJobStatuses_Call {
+func (_c *MockJobServiceClient_SetAccountJobDefaults_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetAccountJobDefaultsRequest])) *MockJobServiceClient_SetAccountJobDefaults_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobStatusesRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetAccountJobDefaultsRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceClient_GetJobStatuses_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobStatusesResponse], _a1 error) *MockJobServiceClient_GetJobStatuses_Call {
+func (_c *MockJobServiceClient_SetAccountJobDefaults_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetAccountJobDefaultsResponse], _a1 error) *MockJobServiceClient_SetAccountJobDefaults_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceClient_GetJobStatuses_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusesRequest]) (*connect.Response[mgmtv1alpha1.GetJobStatusesResponse], error)) *MockJobServiceClient_GetJobStatuses_Call {
+func (_c *MockJobServiceClient_SetAccountJobDefaults_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetAccountJobDefaultsRequest]) (*connect.Response[mgmtv1alpha1.SetAccountJobDefaultsResponse], error)) *MockJobServiceClient_SetAccountJobDefaults_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetJobs provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceClient) GetJobs(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsResponse], error) {
+// SetJobLabels provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) SetJobLabels(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobLabelsRequest]) (*connect.Response[mgmtv1alpha1.SetJobLabelsResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobs")
+		panic("no return value specified for SetJobLabels")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.GetJobsResponse]
+	var r0 *connect.Response[mgmtv1alpha1.SetJobLabelsResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobLabelsRequest]) (*connect.Response[mgmtv1alpha1.SetJobLabelsResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) *connect.Response[mgmtv1alpha1.GetJobsResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobLabelsRequest]) *connect.Response[mgmtv1alpha1.SetJobLabelsResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobsResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetJobLabelsResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobLabelsRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -998,57 +2473,57 @@ func (_m *MockJobServiceClient) GetJobs(_a0 context.Context, _a1 *connect.Reques
 	return r0, r1
 }
 
-// MockJobServiceClient_GetJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobs'
-type MockJobServiceClient_GetJobs_Call struct {
+// MockJobServiceClient_SetJobLabels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobLabels'
+type MockJobServiceClient_SetJobLabels_Call struct {
 	*mock.Call
 }
 
-// GetJobs is a helper method to define mock.On call
+// SetJobLabels is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.GetJobsRequest]
-func (_e *MockJobServiceClient_Expecter) GetJobs(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobs_Call {
-	return &MockJobServiceClient_GetJobs_Call{Call: _e.mock.On("GetJobs", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.SetJobLabelsRequest]
+func (_e *MockJobServiceClient_Expecter) SetJobLabels(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_SetJobLabels_Call {
+	return &MockJobServiceClient_SetJobLabels_Call{Call: _e.mock.On("SetJobLabels", _a0, _a1)}
 }
 
-func (_c *MockJobServiceClient_GetJobs_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobsRequest])) *MockJobServiceClient_GetJobs_Call {
+func (_c *MockJobServiceClient_SetJobLabels_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobLabelsRequest])) *MockJobServiceClient_SetJobLabels_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobsRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetJobLabelsRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceClient_GetJobs_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobsResponse], _a1 error) *MockJobServiceClient_GetJobs_Call {
+func (_c *MockJobServiceClient_SetJobLabels_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetJobLabelsResponse], _a1 error) *MockJobServiceClient_SetJobLabels_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceClient_GetJobs_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsResponse], error)) *MockJobServiceClient_GetJobs_Call {
+func (_c *MockJobServiceClient_SetJobLabels_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetJobLabelsRequest]) (*connect.Response[mgmtv1alpha1.SetJobLabelsResponse], error)) *MockJobServiceClient_SetJobLabels_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsJobNameAvailable provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceClient) IsJobNameAvailable(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], error) {
+// SetJobMappings provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) SetJobMappings(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobMappingsRequest]) (*connect.Response[mgmtv1alpha1.SetJobMappingsResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsJobNameAvailable")
+		panic("no return value specified for SetJobMappings")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse]
+	var r0 *connect.Response[mgmtv1alpha1.SetJobMappingsResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobMappingsRequest]) (*connect.Response[mgmtv1alpha1.SetJobMappingsResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) *connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobMappingsRequest]) *connect.Response[mgmtv1alpha1.SetJobMappingsResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetJobMappingsResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobMappingsRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1057,57 +2532,57 @@ func (_m *MockJobServiceClient) IsJobNameAvailable(_a0 context.Context, _a1 *con
 	return r0, r1
 }
 
-// MockJobServiceClient_IsJobNameAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsJobNameAvailable'
-type MockJobServiceClient_IsJobNameAvailable_Call struct {
+// MockJobServiceClient_SetJobMappings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobMappings'
+type MockJobServiceClient_SetJobMappings_Call struct {
 	*mock.Call
 }
 
-// IsJobNameAvailable is a helper method to define mock.On call
+// SetJobMappings is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]
-func (_e *MockJobServiceClient_Expecter) IsJobNameAvailable(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_IsJobNameAvailable_Call {
-	return &MockJobServiceClient_IsJobNameAvailable_Call{Call: _e.mock.On("IsJobNameAvailable", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.SetJobMappingsRequest]
+func (_e *MockJobServiceClient_Expecter) SetJobMappings(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_SetJobMappings_Call {
+	return &MockJobServiceClient_SetJobMappings_Call{Call: _e.mock.On("SetJobMappings", _a0, _a1)}
 }
 
-func (_c *MockJobServiceClient_IsJobNameAvailable_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest])) *MockJobServiceClient_IsJobNameAvailable_Call {
+func (_c *MockJobServiceClient_SetJobMappings_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobMappingsRequest])) *MockJobServiceClient_SetJobMappings_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetJobMappingsRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceClient_IsJobNameAvailable_Call) Return(_a0 *connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], _a1 error) *MockJobServiceClient_IsJobNameAvailable_Call {
+func (_c *MockJobServiceClient_SetJobMappings_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetJobMappingsResponse], _a1 error) *MockJobServiceClient_SetJobMappings_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceClient_IsJobNameAvailable_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], error)) *MockJobServiceClient_IsJobNameAvailable_Call {
+func (_c *MockJobServiceClient_SetJobMappings_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetJobMappingsRequest]) (*connect.Response[mgmtv1alpha1.SetJobMappingsResponse], error)) *MockJobServiceClient_SetJobMappings_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PauseJob provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceClient) PauseJob(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.PauseJobRequest]) (*connect.Response[mgmtv1alpha1.PauseJobResponse], error) {
+// SetJobParameters provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) SetJobParameters(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobParametersRequest]) (*connect.Response[mgmtv1alpha1.SetJobParametersResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PauseJob")
+		panic("no return value specified for SetJobParameters")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.PauseJobResponse]
+	var r0 *connect.Response[mgmtv1alpha1.SetJobParametersResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) (*connect.Response[mgmtv1alpha1.PauseJobResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobParametersRequest]) (*connect.Response[mgmtv1alpha1.SetJobParametersResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) *connect.Response[mgmtv1alpha1.PauseJobResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobParametersRequest]) *connect.Response[mgmtv1alpha1.SetJobParametersResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.PauseJobResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetJobParametersResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobParametersRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1116,31 +2591,31 @@ func (_m *MockJobServiceClient) PauseJob(_a0 context.Context, _a1 *connect.Reque
 	return r0, r1
 }
 
-// MockJobServiceClient_PauseJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseJob'
-type MockJobServiceClient_PauseJob_Call struct {
+// MockJobServiceClient_SetJobParameters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobParameters'
+type MockJobServiceClient_SetJobParameters_Call struct {
 	*mock.Call
 }
 
-// PauseJob is a helper method to define mock.On call
+// SetJobParameters is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.PauseJobRequest]
-func (_e *MockJobServiceClient_Expecter) PauseJob(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_PauseJob_Call {
-	return &MockJobServiceClient_PauseJob_Call{Call: _e.mock.On("PauseJob", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.SetJobParametersRequest]
+func (_e *MockJobServiceClient_Expecter) SetJobParameters(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_SetJobParameters_Call {
+	return &MockJobServiceClient_SetJobParameters_Call{Call: _e.mock.On("SetJobParameters", _a0, _a1)}
 }
 
-func (_c *MockJobServiceClient_PauseJob_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.PauseJobRequest])) *MockJobServiceClient_PauseJob_Call {
+func (_c *MockJobServiceClient_SetJobParameters_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobParametersRequest])) *MockJobServiceClient_SetJobParameters_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.PauseJobRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetJobParametersRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceClient_PauseJob_Call) Return(_a0 *connect.Response[mgmtv1alpha1.PauseJobResponse], _a1 error) *MockJobServiceClient_PauseJob_Call {
+func (_c *MockJobServiceClient_SetJobParameters_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetJobParametersResponse], _a1 error) *MockJobServiceClient_SetJobParameters_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceClient_PauseJob_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) (*connect.Response[mgmtv1alpha1.PauseJobResponse], error)) *MockJobServiceClient_PauseJob_Call {
+func (_c *MockJobServiceClient_SetJobParameters_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetJobParametersRequest]) (*connect.Response[mgmtv1alpha1.SetJobParametersResponse], error)) *MockJobServiceClient_SetJobParameters_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1263,6 +2738,65 @@ func (_c *MockJobServiceClient_SetJobSyncOptions_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// SetJobTableProcessors provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) SetJobTableProcessors(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobTableProcessorsRequest]) (*connect.Response[mgmtv1alpha1.SetJobTableProcessorsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetJobTableProcessors")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.SetJobTableProcessorsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobTableProcessorsRequest]) (*connect.Response[mgmtv1alpha1.SetJobTableProcessorsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobTableProcessorsRequest]) *connect.Response[mgmtv1alpha1.SetJobTableProcessorsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetJobTableProcessorsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobTableProcessorsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_SetJobTableProcessors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobTableProcessors'
+type MockJobServiceClient_SetJobTableProcessors_Call struct {
+	*mock.Call
+}
+
+// SetJobTableProcessors is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.SetJobTableProcessorsRequest]
+func (_e *MockJobServiceClient_Expecter) SetJobTableProcessors(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_SetJobTableProcessors_Call {
+	return &MockJobServiceClient_SetJobTableProcessors_Call{Call: _e.mock.On("SetJobTableProcessors", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_SetJobTableProcessors_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobTableProcessorsRequest])) *MockJobServiceClient_SetJobTableProcessors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetJobTableProcessorsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_SetJobTableProcessors_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetJobTableProcessorsResponse], _a1 error) *MockJobServiceClient_SetJobTableProcessors_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_SetJobTableProcessors_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetJobTableProcessorsRequest]) (*connect.Response[mgmtv1alpha1.SetJobTableProcessorsResponse], error)) *MockJobServiceClient_SetJobTableProcessors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetJobWorkflowOptions provides a mock function with given fields: _a0, _a1
 func (_m *MockJobServiceClient) SetJobWorkflowOptions(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobWorkflowOptionsRequest]) (*connect.Response[mgmtv1alpha1.SetJobWorkflowOptionsResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -1322,6 +2856,65 @@ func (_c *MockJobServiceClient_SetJobWorkflowOptions_Call) RunAndReturn(run func
 	return _c
 }
 
+// StreamJobRunStatuses provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) StreamJobRunStatuses(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.StreamJobRunStatusesRequest]) (*connect.ServerStreamForClient[mgmtv1alpha1.StreamJobRunStatusesResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamJobRunStatuses")
+	}
+
+	var r0 *connect.ServerStreamForClient[mgmtv1alpha1.StreamJobRunStatusesResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.StreamJobRunStatusesRequest]) (*connect.ServerStreamForClient[mgmtv1alpha1.StreamJobRunStatusesResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.StreamJobRunStatusesRequest]) *connect.ServerStreamForClient[mgmtv1alpha1.StreamJobRunStatusesResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.ServerStreamForClient[mgmtv1alpha1.StreamJobRunStatusesResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.StreamJobRunStatusesRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_StreamJobRunStatuses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamJobRunStatuses'
+type MockJobServiceClient_StreamJobRunStatuses_Call struct {
+	*mock.Call
+}
+
+// StreamJobRunStatuses is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.StreamJobRunStatusesRequest]
+func (_e *MockJobServiceClient_Expecter) StreamJobRunStatuses(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_StreamJobRunStatuses_Call {
+	return &MockJobServiceClient_StreamJobRunStatuses_Call{Call: _e.mock.On("StreamJobRunStatuses", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_StreamJobRunStatuses_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.StreamJobRunStatusesRequest])) *MockJobServiceClient_StreamJobRunStatuses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.StreamJobRunStatusesRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_StreamJobRunStatuses_Call) Return(_a0 *connect.ServerStreamForClient[mgmtv1alpha1.StreamJobRunStatusesResponse], _a1 error) *MockJobServiceClient_StreamJobRunStatuses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_StreamJobRunStatuses_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.StreamJobRunStatusesRequest]) (*connect.ServerStreamForClient[mgmtv1alpha1.StreamJobRunStatusesResponse], error)) *MockJobServiceClient_StreamJobRunStatuses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TerminateJobRun provides a mock function with given fields: _a0, _a1
 func (_m *MockJobServiceClient) TerminateJobRun(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.TerminateJobRunRequest]) (*connect.Response[mgmtv1alpha1.TerminateJobRunResponse], error) {
 	ret := _m.Called(_a0, _a1)
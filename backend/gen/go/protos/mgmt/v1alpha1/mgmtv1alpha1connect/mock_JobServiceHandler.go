@@ -24,6 +24,65 @@ func (_m *MockJobServiceHandler) EXPECT() *MockJobServiceHandler_Expecter {
 	return &MockJobServiceHandler_Expecter{mock: &_m.Mock}
 }
 
+// ApproveJobChange provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) ApproveJobChange(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ApproveJobChangeRequest]) (*connect.Response[mgmtv1alpha1.ApproveJobChangeResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveJobChange")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.ApproveJobChangeResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ApproveJobChangeRequest]) (*connect.Response[mgmtv1alpha1.ApproveJobChangeResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ApproveJobChangeRequest]) *connect.Response[mgmtv1alpha1.ApproveJobChangeResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.ApproveJobChangeResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.ApproveJobChangeRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_ApproveJobChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveJobChange'
+type MockJobServiceHandler_ApproveJobChange_Call struct {
+	*mock.Call
+}
+
+// ApproveJobChange is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.ApproveJobChangeRequest]
+func (_e *MockJobServiceHandler_Expecter) ApproveJobChange(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_ApproveJobChange_Call {
+	return &MockJobServiceHandler_ApproveJobChange_Call{Call: _e.mock.On("ApproveJobChange", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_ApproveJobChange_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ApproveJobChangeRequest])) *MockJobServiceHandler_ApproveJobChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.ApproveJobChangeRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_ApproveJobChange_Call) Return(_a0 *connect.Response[mgmtv1alpha1.ApproveJobChangeResponse], _a1 error) *MockJobServiceHandler_ApproveJobChange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_ApproveJobChange_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.ApproveJobChangeRequest]) (*connect.Response[mgmtv1alpha1.ApproveJobChangeResponse], error)) *MockJobServiceHandler_ApproveJobChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CancelJobRun provides a mock function with given fields: _a0, _a1
 func (_m *MockJobServiceHandler) CancelJobRun(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CancelJobRunRequest]) (*connect.Response[mgmtv1alpha1.CancelJobRunResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -201,6 +260,65 @@ func (_c *MockJobServiceHandler_CreateJobDestinationConnections_Call) RunAndRetu
 	return _c
 }
 
+// CreateJobFromTemplate provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) CreateJobFromTemplate(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CreateJobFromTemplateRequest]) (*connect.Response[mgmtv1alpha1.CreateJobFromTemplateResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJobFromTemplate")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.CreateJobFromTemplateResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateJobFromTemplateRequest]) (*connect.Response[mgmtv1alpha1.CreateJobFromTemplateResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateJobFromTemplateRequest]) *connect.Response[mgmtv1alpha1.CreateJobFromTemplateResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.CreateJobFromTemplateResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateJobFromTemplateRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_CreateJobFromTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJobFromTemplate'
+type MockJobServiceHandler_CreateJobFromTemplate_Call struct {
+	*mock.Call
+}
+
+// CreateJobFromTemplate is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.CreateJobFromTemplateRequest]
+func (_e *MockJobServiceHandler_Expecter) CreateJobFromTemplate(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_CreateJobFromTemplate_Call {
+	return &MockJobServiceHandler_CreateJobFromTemplate_Call{Call: _e.mock.On("CreateJobFromTemplate", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_CreateJobFromTemplate_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CreateJobFromTemplateRequest])) *MockJobServiceHandler_CreateJobFromTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.CreateJobFromTemplateRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_CreateJobFromTemplate_Call) Return(_a0 *connect.Response[mgmtv1alpha1.CreateJobFromTemplateResponse], _a1 error) *MockJobServiceHandler_CreateJobFromTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_CreateJobFromTemplate_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.CreateJobFromTemplateRequest]) (*connect.Response[mgmtv1alpha1.CreateJobFromTemplateResponse], error)) *MockJobServiceHandler_CreateJobFromTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateJobRun provides a mock function with given fields: _a0, _a1
 func (_m *MockJobServiceHandler) CreateJobRun(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CreateJobRunRequest]) (*connect.Response[mgmtv1alpha1.CreateJobRunResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -260,6 +378,124 @@ func (_c *MockJobServiceHandler_CreateJobRun_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// CreateJobRunArtifact provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) CreateJobRunArtifact(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CreateJobRunArtifactRequest]) (*connect.Response[mgmtv1alpha1.CreateJobRunArtifactResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJobRunArtifact")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.CreateJobRunArtifactResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateJobRunArtifactRequest]) (*connect.Response[mgmtv1alpha1.CreateJobRunArtifactResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateJobRunArtifactRequest]) *connect.Response[mgmtv1alpha1.CreateJobRunArtifactResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.CreateJobRunArtifactResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateJobRunArtifactRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_CreateJobRunArtifact_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJobRunArtifact'
+type MockJobServiceHandler_CreateJobRunArtifact_Call struct {
+	*mock.Call
+}
+
+// CreateJobRunArtifact is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.CreateJobRunArtifactRequest]
+func (_e *MockJobServiceHandler_Expecter) CreateJobRunArtifact(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_CreateJobRunArtifact_Call {
+	return &MockJobServiceHandler_CreateJobRunArtifact_Call{Call: _e.mock.On("CreateJobRunArtifact", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_CreateJobRunArtifact_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CreateJobRunArtifactRequest])) *MockJobServiceHandler_CreateJobRunArtifact_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.CreateJobRunArtifactRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_CreateJobRunArtifact_Call) Return(_a0 *connect.Response[mgmtv1alpha1.CreateJobRunArtifactResponse], _a1 error) *MockJobServiceHandler_CreateJobRunArtifact_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_CreateJobRunArtifact_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.CreateJobRunArtifactRequest]) (*connect.Response[mgmtv1alpha1.CreateJobRunArtifactResponse], error)) *MockJobServiceHandler_CreateJobRunArtifact_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateJobRunSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) CreateJobRunSnapshot(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CreateJobRunSnapshotRequest]) (*connect.Response[mgmtv1alpha1.CreateJobRunSnapshotResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJobRunSnapshot")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.CreateJobRunSnapshotResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateJobRunSnapshotRequest]) (*connect.Response[mgmtv1alpha1.CreateJobRunSnapshotResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateJobRunSnapshotRequest]) *connect.Response[mgmtv1alpha1.CreateJobRunSnapshotResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.CreateJobRunSnapshotResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateJobRunSnapshotRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_CreateJobRunSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJobRunSnapshot'
+type MockJobServiceHandler_CreateJobRunSnapshot_Call struct {
+	*mock.Call
+}
+
+// CreateJobRunSnapshot is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.CreateJobRunSnapshotRequest]
+func (_e *MockJobServiceHandler_Expecter) CreateJobRunSnapshot(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_CreateJobRunSnapshot_Call {
+	return &MockJobServiceHandler_CreateJobRunSnapshot_Call{Call: _e.mock.On("CreateJobRunSnapshot", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_CreateJobRunSnapshot_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CreateJobRunSnapshotRequest])) *MockJobServiceHandler_CreateJobRunSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.CreateJobRunSnapshotRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_CreateJobRunSnapshot_Call) Return(_a0 *connect.Response[mgmtv1alpha1.CreateJobRunSnapshotResponse], _a1 error) *MockJobServiceHandler_CreateJobRunSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_CreateJobRunSnapshot_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.CreateJobRunSnapshotRequest]) (*connect.Response[mgmtv1alpha1.CreateJobRunSnapshotResponse], error)) *MockJobServiceHandler_CreateJobRunSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteJob provides a mock function with given fields: _a0, _a1
 func (_m *MockJobServiceHandler) DeleteJob(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.DeleteJobRequest]) (*connect.Response[mgmtv1alpha1.DeleteJobResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -437,28 +673,28 @@ func (_c *MockJobServiceHandler_DeleteJobRun_Call) RunAndReturn(run func(context
 	return _c
 }
 
-// GetJob provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceHandler) GetJob(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRequest]) (*connect.Response[mgmtv1alpha1.GetJobResponse], error) {
+// DeleteJobRunSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) DeleteJobRunSnapshot(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.DeleteJobRunSnapshotRequest]) (*connect.Response[mgmtv1alpha1.DeleteJobRunSnapshotResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJob")
+		panic("no return value specified for DeleteJobRunSnapshot")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.GetJobResponse]
+	var r0 *connect.Response[mgmtv1alpha1.DeleteJobRunSnapshotResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRequest]) (*connect.Response[mgmtv1alpha1.GetJobResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.DeleteJobRunSnapshotRequest]) (*connect.Response[mgmtv1alpha1.DeleteJobRunSnapshotResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRequest]) *connect.Response[mgmtv1alpha1.GetJobResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.DeleteJobRunSnapshotRequest]) *connect.Response[mgmtv1alpha1.DeleteJobRunSnapshotResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.DeleteJobRunSnapshotResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.DeleteJobRunSnapshotRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -467,57 +703,57 @@ func (_m *MockJobServiceHandler) GetJob(_a0 context.Context, _a1 *connect.Reques
 	return r0, r1
 }
 
-// MockJobServiceHandler_GetJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJob'
-type MockJobServiceHandler_GetJob_Call struct {
+// MockJobServiceHandler_DeleteJobRunSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteJobRunSnapshot'
+type MockJobServiceHandler_DeleteJobRunSnapshot_Call struct {
 	*mock.Call
 }
 
-// GetJob is a helper method to define mock.On call
+// DeleteJobRunSnapshot is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRequest]
-func (_e *MockJobServiceHandler_Expecter) GetJob(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJob_Call {
-	return &MockJobServiceHandler_GetJob_Call{Call: _e.mock.On("GetJob", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.DeleteJobRunSnapshotRequest]
+func (_e *MockJobServiceHandler_Expecter) DeleteJobRunSnapshot(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_DeleteJobRunSnapshot_Call {
+	return &MockJobServiceHandler_DeleteJobRunSnapshot_Call{Call: _e.mock.On("DeleteJobRunSnapshot", _a0, _a1)}
 }
 
-func (_c *MockJobServiceHandler_GetJob_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRequest])) *MockJobServiceHandler_GetJob_Call {
+func (_c *MockJobServiceHandler_DeleteJobRunSnapshot_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.DeleteJobRunSnapshotRequest])) *MockJobServiceHandler_DeleteJobRunSnapshot_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.DeleteJobRunSnapshotRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceHandler_GetJob_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobResponse], _a1 error) *MockJobServiceHandler_GetJob_Call {
+func (_c *MockJobServiceHandler_DeleteJobRunSnapshot_Call) Return(_a0 *connect.Response[mgmtv1alpha1.DeleteJobRunSnapshotResponse], _a1 error) *MockJobServiceHandler_DeleteJobRunSnapshot_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceHandler_GetJob_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRequest]) (*connect.Response[mgmtv1alpha1.GetJobResponse], error)) *MockJobServiceHandler_GetJob_Call {
+func (_c *MockJobServiceHandler_DeleteJobRunSnapshot_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.DeleteJobRunSnapshotRequest]) (*connect.Response[mgmtv1alpha1.DeleteJobRunSnapshotResponse], error)) *MockJobServiceHandler_DeleteJobRunSnapshot_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetJobNextRuns provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceHandler) GetJobNextRuns(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobNextRunsResponse], error) {
+// EstimateJobRun provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) EstimateJobRun(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.EstimateJobRunRequest]) (*connect.Response[mgmtv1alpha1.EstimateJobRunResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobNextRuns")
+		panic("no return value specified for EstimateJobRun")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.GetJobNextRunsResponse]
+	var r0 *connect.Response[mgmtv1alpha1.EstimateJobRunResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobNextRunsResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.EstimateJobRunRequest]) (*connect.Response[mgmtv1alpha1.EstimateJobRunResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]) *connect.Response[mgmtv1alpha1.GetJobNextRunsResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.EstimateJobRunRequest]) *connect.Response[mgmtv1alpha1.EstimateJobRunResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobNextRunsResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.EstimateJobRunResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.EstimateJobRunRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -526,57 +762,57 @@ func (_m *MockJobServiceHandler) GetJobNextRuns(_a0 context.Context, _a1 *connec
 	return r0, r1
 }
 
-// MockJobServiceHandler_GetJobNextRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobNextRuns'
-type MockJobServiceHandler_GetJobNextRuns_Call struct {
+// MockJobServiceHandler_EstimateJobRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EstimateJobRun'
+type MockJobServiceHandler_EstimateJobRun_Call struct {
 	*mock.Call
 }
 
-// GetJobNextRuns is a helper method to define mock.On call
+// EstimateJobRun is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]
-func (_e *MockJobServiceHandler_Expecter) GetJobNextRuns(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobNextRuns_Call {
-	return &MockJobServiceHandler_GetJobNextRuns_Call{Call: _e.mock.On("GetJobNextRuns", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.EstimateJobRunRequest]
+func (_e *MockJobServiceHandler_Expecter) EstimateJobRun(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_EstimateJobRun_Call {
+	return &MockJobServiceHandler_EstimateJobRun_Call{Call: _e.mock.On("EstimateJobRun", _a0, _a1)}
 }
 
-func (_c *MockJobServiceHandler_GetJobNextRuns_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest])) *MockJobServiceHandler_GetJobNextRuns_Call {
+func (_c *MockJobServiceHandler_EstimateJobRun_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.EstimateJobRunRequest])) *MockJobServiceHandler_EstimateJobRun_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.EstimateJobRunRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceHandler_GetJobNextRuns_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobNextRunsResponse], _a1 error) *MockJobServiceHandler_GetJobNextRuns_Call {
+func (_c *MockJobServiceHandler_EstimateJobRun_Call) Return(_a0 *connect.Response[mgmtv1alpha1.EstimateJobRunResponse], _a1 error) *MockJobServiceHandler_EstimateJobRun_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceHandler_GetJobNextRuns_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobNextRunsResponse], error)) *MockJobServiceHandler_GetJobNextRuns_Call {
+func (_c *MockJobServiceHandler_EstimateJobRun_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.EstimateJobRunRequest]) (*connect.Response[mgmtv1alpha1.EstimateJobRunResponse], error)) *MockJobServiceHandler_EstimateJobRun_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetJobRecentRuns provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceHandler) GetJobRecentRuns(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse], error) {
+// ForceCancelJobRun provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) ForceCancelJobRun(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ForceCancelJobRunRequest]) (*connect.Response[mgmtv1alpha1.ForceCancelJobRunResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobRecentRuns")
+		panic("no return value specified for ForceCancelJobRun")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse]
+	var r0 *connect.Response[mgmtv1alpha1.ForceCancelJobRunResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ForceCancelJobRunRequest]) (*connect.Response[mgmtv1alpha1.ForceCancelJobRunResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]) *connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ForceCancelJobRunRequest]) *connect.Response[mgmtv1alpha1.ForceCancelJobRunResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.ForceCancelJobRunResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.ForceCancelJobRunRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -585,57 +821,57 @@ func (_m *MockJobServiceHandler) GetJobRecentRuns(_a0 context.Context, _a1 *conn
 	return r0, r1
 }
 
-// MockJobServiceHandler_GetJobRecentRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRecentRuns'
-type MockJobServiceHandler_GetJobRecentRuns_Call struct {
+// MockJobServiceHandler_ForceCancelJobRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForceCancelJobRun'
+type MockJobServiceHandler_ForceCancelJobRun_Call struct {
 	*mock.Call
 }
 
-// GetJobRecentRuns is a helper method to define mock.On call
+// ForceCancelJobRun is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]
-func (_e *MockJobServiceHandler_Expecter) GetJobRecentRuns(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobRecentRuns_Call {
-	return &MockJobServiceHandler_GetJobRecentRuns_Call{Call: _e.mock.On("GetJobRecentRuns", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.ForceCancelJobRunRequest]
+func (_e *MockJobServiceHandler_Expecter) ForceCancelJobRun(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_ForceCancelJobRun_Call {
+	return &MockJobServiceHandler_ForceCancelJobRun_Call{Call: _e.mock.On("ForceCancelJobRun", _a0, _a1)}
 }
 
-func (_c *MockJobServiceHandler_GetJobRecentRuns_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest])) *MockJobServiceHandler_GetJobRecentRuns_Call {
+func (_c *MockJobServiceHandler_ForceCancelJobRun_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ForceCancelJobRunRequest])) *MockJobServiceHandler_ForceCancelJobRun_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.ForceCancelJobRunRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceHandler_GetJobRecentRuns_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse], _a1 error) *MockJobServiceHandler_GetJobRecentRuns_Call {
+func (_c *MockJobServiceHandler_ForceCancelJobRun_Call) Return(_a0 *connect.Response[mgmtv1alpha1.ForceCancelJobRunResponse], _a1 error) *MockJobServiceHandler_ForceCancelJobRun_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceHandler_GetJobRecentRuns_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse], error)) *MockJobServiceHandler_GetJobRecentRuns_Call {
+func (_c *MockJobServiceHandler_ForceCancelJobRun_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.ForceCancelJobRunRequest]) (*connect.Response[mgmtv1alpha1.ForceCancelJobRunResponse], error)) *MockJobServiceHandler_ForceCancelJobRun_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetJobRun provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceHandler) GetJobRun(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunResponse], error) {
+// GetAccountJobDefaults provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetAccountJobDefaults(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetAccountJobDefaultsRequest]) (*connect.Response[mgmtv1alpha1.GetAccountJobDefaultsResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobRun")
+		panic("no return value specified for GetAccountJobDefaults")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.GetJobRunResponse]
+	var r0 *connect.Response[mgmtv1alpha1.GetAccountJobDefaultsResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetAccountJobDefaultsRequest]) (*connect.Response[mgmtv1alpha1.GetAccountJobDefaultsResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunRequest]) *connect.Response[mgmtv1alpha1.GetJobRunResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetAccountJobDefaultsRequest]) *connect.Response[mgmtv1alpha1.GetAccountJobDefaultsResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetAccountJobDefaultsResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetAccountJobDefaultsRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -644,57 +880,57 @@ func (_m *MockJobServiceHandler) GetJobRun(_a0 context.Context, _a1 *connect.Req
 	return r0, r1
 }
 
-// MockJobServiceHandler_GetJobRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRun'
-type MockJobServiceHandler_GetJobRun_Call struct {
+// MockJobServiceHandler_GetAccountJobDefaults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountJobDefaults'
+type MockJobServiceHandler_GetAccountJobDefaults_Call struct {
 	*mock.Call
 }
 
-// GetJobRun is a helper method to define mock.On call
+// GetAccountJobDefaults is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunRequest]
-func (_e *MockJobServiceHandler_Expecter) GetJobRun(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobRun_Call {
-	return &MockJobServiceHandler_GetJobRun_Call{Call: _e.mock.On("GetJobRun", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.GetAccountJobDefaultsRequest]
+func (_e *MockJobServiceHandler_Expecter) GetAccountJobDefaults(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetAccountJobDefaults_Call {
+	return &MockJobServiceHandler_GetAccountJobDefaults_Call{Call: _e.mock.On("GetAccountJobDefaults", _a0, _a1)}
 }
 
-func (_c *MockJobServiceHandler_GetJobRun_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunRequest])) *MockJobServiceHandler_GetJobRun_Call {
+func (_c *MockJobServiceHandler_GetAccountJobDefaults_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetAccountJobDefaultsRequest])) *MockJobServiceHandler_GetAccountJobDefaults_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetAccountJobDefaultsRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceHandler_GetJobRun_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunResponse], _a1 error) *MockJobServiceHandler_GetJobRun_Call {
+func (_c *MockJobServiceHandler_GetAccountJobDefaults_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetAccountJobDefaultsResponse], _a1 error) *MockJobServiceHandler_GetAccountJobDefaults_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceHandler_GetJobRun_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunResponse], error)) *MockJobServiceHandler_GetJobRun_Call {
+func (_c *MockJobServiceHandler_GetAccountJobDefaults_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetAccountJobDefaultsRequest]) (*connect.Response[mgmtv1alpha1.GetAccountJobDefaultsResponse], error)) *MockJobServiceHandler_GetAccountJobDefaults_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetJobRunEvents provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceHandler) GetJobRunEvents(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunEventsResponse], error) {
+// GetJob provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetJob(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRequest]) (*connect.Response[mgmtv1alpha1.GetJobResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobRunEvents")
+		panic("no return value specified for GetJob")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.GetJobRunEventsResponse]
+	var r0 *connect.Response[mgmtv1alpha1.GetJobResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunEventsResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRequest]) (*connect.Response[mgmtv1alpha1.GetJobResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]) *connect.Response[mgmtv1alpha1.GetJobRunEventsResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRequest]) *connect.Response[mgmtv1alpha1.GetJobResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunEventsResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -703,105 +939,1344 @@ func (_m *MockJobServiceHandler) GetJobRunEvents(_a0 context.Context, _a1 *conne
 	return r0, r1
 }
 
-// MockJobServiceHandler_GetJobRunEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunEvents'
-type MockJobServiceHandler_GetJobRunEvents_Call struct {
+// MockJobServiceHandler_GetJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJob'
+type MockJobServiceHandler_GetJob_Call struct {
+	*mock.Call
+}
+
+// GetJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRequest]
+func (_e *MockJobServiceHandler_Expecter) GetJob(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJob_Call {
+	return &MockJobServiceHandler_GetJob_Call{Call: _e.mock.On("GetJob", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_GetJob_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRequest])) *MockJobServiceHandler_GetJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJob_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobResponse], _a1 error) *MockJobServiceHandler_GetJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJob_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRequest]) (*connect.Response[mgmtv1alpha1.GetJobResponse], error)) *MockJobServiceHandler_GetJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobChangeRequests provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetJobChangeRequests(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobChangeRequestsRequest]) (*connect.Response[mgmtv1alpha1.GetJobChangeRequestsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobChangeRequests")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobChangeRequestsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobChangeRequestsRequest]) (*connect.Response[mgmtv1alpha1.GetJobChangeRequestsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobChangeRequestsRequest]) *connect.Response[mgmtv1alpha1.GetJobChangeRequestsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobChangeRequestsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobChangeRequestsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_GetJobChangeRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobChangeRequests'
+type MockJobServiceHandler_GetJobChangeRequests_Call struct {
+	*mock.Call
+}
+
+// GetJobChangeRequests is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobChangeRequestsRequest]
+func (_e *MockJobServiceHandler_Expecter) GetJobChangeRequests(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobChangeRequests_Call {
+	return &MockJobServiceHandler_GetJobChangeRequests_Call{Call: _e.mock.On("GetJobChangeRequests", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_GetJobChangeRequests_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobChangeRequestsRequest])) *MockJobServiceHandler_GetJobChangeRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobChangeRequestsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobChangeRequests_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobChangeRequestsResponse], _a1 error) *MockJobServiceHandler_GetJobChangeRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobChangeRequests_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobChangeRequestsRequest]) (*connect.Response[mgmtv1alpha1.GetJobChangeRequestsResponse], error)) *MockJobServiceHandler_GetJobChangeRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobNextRuns provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetJobNextRuns(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobNextRunsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobNextRuns")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobNextRunsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobNextRunsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]) *connect.Response[mgmtv1alpha1.GetJobNextRunsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobNextRunsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_GetJobNextRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobNextRuns'
+type MockJobServiceHandler_GetJobNextRuns_Call struct {
+	*mock.Call
+}
+
+// GetJobNextRuns is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]
+func (_e *MockJobServiceHandler_Expecter) GetJobNextRuns(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobNextRuns_Call {
+	return &MockJobServiceHandler_GetJobNextRuns_Call{Call: _e.mock.On("GetJobNextRuns", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_GetJobNextRuns_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest])) *MockJobServiceHandler_GetJobNextRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobNextRuns_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobNextRunsResponse], _a1 error) *MockJobServiceHandler_GetJobNextRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobNextRuns_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobNextRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobNextRunsResponse], error)) *MockJobServiceHandler_GetJobNextRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRecentRuns provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetJobRecentRuns(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRecentRuns")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]) *connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_GetJobRecentRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRecentRuns'
+type MockJobServiceHandler_GetJobRecentRuns_Call struct {
+	*mock.Call
+}
+
+// GetJobRecentRuns is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]
+func (_e *MockJobServiceHandler_Expecter) GetJobRecentRuns(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobRecentRuns_Call {
+	return &MockJobServiceHandler_GetJobRecentRuns_Call{Call: _e.mock.On("GetJobRecentRuns", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_GetJobRecentRuns_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest])) *MockJobServiceHandler_GetJobRecentRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRecentRuns_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse], _a1 error) *MockJobServiceHandler_GetJobRecentRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRecentRuns_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse], error)) *MockJobServiceHandler_GetJobRecentRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRun provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetJobRun(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRun")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRunResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunRequest]) *connect.Response[mgmtv1alpha1.GetJobRunResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_GetJobRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRun'
+type MockJobServiceHandler_GetJobRun_Call struct {
+	*mock.Call
+}
+
+// GetJobRun is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunRequest]
+func (_e *MockJobServiceHandler_Expecter) GetJobRun(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobRun_Call {
+	return &MockJobServiceHandler_GetJobRun_Call{Call: _e.mock.On("GetJobRun", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_GetJobRun_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunRequest])) *MockJobServiceHandler_GetJobRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRun_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunResponse], _a1 error) *MockJobServiceHandler_GetJobRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRun_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunResponse], error)) *MockJobServiceHandler_GetJobRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRunArtifacts provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetJobRunArtifacts(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunArtifactsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunArtifactsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunArtifacts")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRunArtifactsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunArtifactsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunArtifactsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunArtifactsRequest]) *connect.Response[mgmtv1alpha1.GetJobRunArtifactsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunArtifactsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunArtifactsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_GetJobRunArtifacts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunArtifacts'
+type MockJobServiceHandler_GetJobRunArtifacts_Call struct {
+	*mock.Call
+}
+
+// GetJobRunArtifacts is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunArtifactsRequest]
+func (_e *MockJobServiceHandler_Expecter) GetJobRunArtifacts(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobRunArtifacts_Call {
+	return &MockJobServiceHandler_GetJobRunArtifacts_Call{Call: _e.mock.On("GetJobRunArtifacts", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_GetJobRunArtifacts_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunArtifactsRequest])) *MockJobServiceHandler_GetJobRunArtifacts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunArtifactsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRunArtifacts_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunArtifactsResponse], _a1 error) *MockJobServiceHandler_GetJobRunArtifacts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRunArtifacts_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunArtifactsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunArtifactsResponse], error)) *MockJobServiceHandler_GetJobRunArtifacts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRunDataQuality provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetJobRunDataQuality(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunDataQualityRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunDataQualityResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunDataQuality")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRunDataQualityResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunDataQualityRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunDataQualityResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunDataQualityRequest]) *connect.Response[mgmtv1alpha1.GetJobRunDataQualityResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunDataQualityResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunDataQualityRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_GetJobRunDataQuality_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunDataQuality'
+type MockJobServiceHandler_GetJobRunDataQuality_Call struct {
+	*mock.Call
+}
+
+// GetJobRunDataQuality is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunDataQualityRequest]
+func (_e *MockJobServiceHandler_Expecter) GetJobRunDataQuality(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobRunDataQuality_Call {
+	return &MockJobServiceHandler_GetJobRunDataQuality_Call{Call: _e.mock.On("GetJobRunDataQuality", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_GetJobRunDataQuality_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunDataQualityRequest])) *MockJobServiceHandler_GetJobRunDataQuality_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunDataQualityRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRunDataQuality_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunDataQualityResponse], _a1 error) *MockJobServiceHandler_GetJobRunDataQuality_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRunDataQuality_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunDataQualityRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunDataQualityResponse], error)) *MockJobServiceHandler_GetJobRunDataQuality_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRunEvents provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetJobRunEvents(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunEventsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunEvents")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRunEventsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunEventsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]) *connect.Response[mgmtv1alpha1.GetJobRunEventsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunEventsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_GetJobRunEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunEvents'
+type MockJobServiceHandler_GetJobRunEvents_Call struct {
 	*mock.Call
 }
 
 // GetJobRunEvents is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]
-func (_e *MockJobServiceHandler_Expecter) GetJobRunEvents(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobRunEvents_Call {
-	return &MockJobServiceHandler_GetJobRunEvents_Call{Call: _e.mock.On("GetJobRunEvents", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]
+func (_e *MockJobServiceHandler_Expecter) GetJobRunEvents(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobRunEvents_Call {
+	return &MockJobServiceHandler_GetJobRunEvents_Call{Call: _e.mock.On("GetJobRunEvents", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_GetJobRunEvents_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest])) *MockJobServiceHandler_GetJobRunEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRunEvents_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunEventsResponse], _a1 error) *MockJobServiceHandler_GetJobRunEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRunEvents_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunEventsResponse], error)) *MockJobServiceHandler_GetJobRunEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRunLogsStream provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockJobServiceHandler) GetJobRunLogsStream(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest], _a2 *connect.ServerStream[mgmtv1alpha1.GetJobRunLogsStreamResponse]) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunLogsStream")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest], *connect.ServerStream[mgmtv1alpha1.GetJobRunLogsStreamResponse]) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockJobServiceHandler_GetJobRunLogsStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunLogsStream'
+type MockJobServiceHandler_GetJobRunLogsStream_Call struct {
+	*mock.Call
+}
+
+// GetJobRunLogsStream is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest]
+//   - _a2 *connect.ServerStream[mgmtv1alpha1.GetJobRunLogsStreamResponse]
+func (_e *MockJobServiceHandler_Expecter) GetJobRunLogsStream(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockJobServiceHandler_GetJobRunLogsStream_Call {
+	return &MockJobServiceHandler_GetJobRunLogsStream_Call{Call: _e.mock.On("GetJobRunLogsStream", _a0, _a1, _a2)}
+}
+
+func (_c *MockJobServiceHandler_GetJobRunLogsStream_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest], _a2 *connect.ServerStream[mgmtv1alpha1.GetJobRunLogsStreamResponse])) *MockJobServiceHandler_GetJobRunLogsStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest]), args[2].(*connect.ServerStream[mgmtv1alpha1.GetJobRunLogsStreamResponse]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRunLogsStream_Call) Return(_a0 error) *MockJobServiceHandler_GetJobRunLogsStream_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRunLogsStream_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest], *connect.ServerStream[mgmtv1alpha1.GetJobRunLogsStreamResponse]) error) *MockJobServiceHandler_GetJobRunLogsStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRunMaskingProgress provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetJobRunMaskingProgress(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunMaskingProgressRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunMaskingProgressResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunMaskingProgress")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRunMaskingProgressResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunMaskingProgressRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunMaskingProgressResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunMaskingProgressRequest]) *connect.Response[mgmtv1alpha1.GetJobRunMaskingProgressResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunMaskingProgressResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunMaskingProgressRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_GetJobRunMaskingProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunMaskingProgress'
+type MockJobServiceHandler_GetJobRunMaskingProgress_Call struct {
+	*mock.Call
+}
+
+// GetJobRunMaskingProgress is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunMaskingProgressRequest]
+func (_e *MockJobServiceHandler_Expecter) GetJobRunMaskingProgress(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobRunMaskingProgress_Call {
+	return &MockJobServiceHandler_GetJobRunMaskingProgress_Call{Call: _e.mock.On("GetJobRunMaskingProgress", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_GetJobRunMaskingProgress_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunMaskingProgressRequest])) *MockJobServiceHandler_GetJobRunMaskingProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunMaskingProgressRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRunMaskingProgress_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunMaskingProgressResponse], _a1 error) *MockJobServiceHandler_GetJobRunMaskingProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRunMaskingProgress_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunMaskingProgressRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunMaskingProgressResponse], error)) *MockJobServiceHandler_GetJobRunMaskingProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRunParameters provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetJobRunParameters(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunParametersRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunParametersResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunParameters")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRunParametersResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunParametersRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunParametersResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunParametersRequest]) *connect.Response[mgmtv1alpha1.GetJobRunParametersResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunParametersResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunParametersRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_GetJobRunParameters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunParameters'
+type MockJobServiceHandler_GetJobRunParameters_Call struct {
+	*mock.Call
+}
+
+// GetJobRunParameters is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunParametersRequest]
+func (_e *MockJobServiceHandler_Expecter) GetJobRunParameters(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobRunParameters_Call {
+	return &MockJobServiceHandler_GetJobRunParameters_Call{Call: _e.mock.On("GetJobRunParameters", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_GetJobRunParameters_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunParametersRequest])) *MockJobServiceHandler_GetJobRunParameters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunParametersRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRunParameters_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunParametersResponse], _a1 error) *MockJobServiceHandler_GetJobRunParameters_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRunParameters_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunParametersRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunParametersResponse], error)) *MockJobServiceHandler_GetJobRunParameters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRunPriority provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetJobRunPriority(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunPriorityRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunPriorityResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunPriority")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRunPriorityResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunPriorityRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunPriorityResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunPriorityRequest]) *connect.Response[mgmtv1alpha1.GetJobRunPriorityResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunPriorityResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunPriorityRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_GetJobRunPriority_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunPriority'
+type MockJobServiceHandler_GetJobRunPriority_Call struct {
+	*mock.Call
+}
+
+// GetJobRunPriority is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunPriorityRequest]
+func (_e *MockJobServiceHandler_Expecter) GetJobRunPriority(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobRunPriority_Call {
+	return &MockJobServiceHandler_GetJobRunPriority_Call{Call: _e.mock.On("GetJobRunPriority", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_GetJobRunPriority_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunPriorityRequest])) *MockJobServiceHandler_GetJobRunPriority_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunPriorityRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRunPriority_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunPriorityResponse], _a1 error) *MockJobServiceHandler_GetJobRunPriority_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRunPriority_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunPriorityRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunPriorityResponse], error)) *MockJobServiceHandler_GetJobRunPriority_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRunRowCounts provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetJobRunRowCounts(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunRowCountsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunRowCountsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunRowCounts")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRunRowCountsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunRowCountsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunRowCountsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunRowCountsRequest]) *connect.Response[mgmtv1alpha1.GetJobRunRowCountsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunRowCountsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunRowCountsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_GetJobRunRowCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunRowCounts'
+type MockJobServiceHandler_GetJobRunRowCounts_Call struct {
+	*mock.Call
+}
+
+// GetJobRunRowCounts is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunRowCountsRequest]
+func (_e *MockJobServiceHandler_Expecter) GetJobRunRowCounts(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobRunRowCounts_Call {
+	return &MockJobServiceHandler_GetJobRunRowCounts_Call{Call: _e.mock.On("GetJobRunRowCounts", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_GetJobRunRowCounts_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunRowCountsRequest])) *MockJobServiceHandler_GetJobRunRowCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunRowCountsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRunRowCounts_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunRowCountsResponse], _a1 error) *MockJobServiceHandler_GetJobRunRowCounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRunRowCounts_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunRowCountsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunRowCountsResponse], error)) *MockJobServiceHandler_GetJobRunRowCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRunSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetJobRunSnapshot(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunSnapshotRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunSnapshotResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunSnapshot")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRunSnapshotResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunSnapshotRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunSnapshotResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunSnapshotRequest]) *connect.Response[mgmtv1alpha1.GetJobRunSnapshotResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunSnapshotResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunSnapshotRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_GetJobRunSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunSnapshot'
+type MockJobServiceHandler_GetJobRunSnapshot_Call struct {
+	*mock.Call
+}
+
+// GetJobRunSnapshot is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunSnapshotRequest]
+func (_e *MockJobServiceHandler_Expecter) GetJobRunSnapshot(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobRunSnapshot_Call {
+	return &MockJobServiceHandler_GetJobRunSnapshot_Call{Call: _e.mock.On("GetJobRunSnapshot", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_GetJobRunSnapshot_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunSnapshotRequest])) *MockJobServiceHandler_GetJobRunSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunSnapshotRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRunSnapshot_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunSnapshotResponse], _a1 error) *MockJobServiceHandler_GetJobRunSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRunSnapshot_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunSnapshotRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunSnapshotResponse], error)) *MockJobServiceHandler_GetJobRunSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRunSnapshots provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetJobRunSnapshots(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunSnapshotsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunSnapshotsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunSnapshots")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRunSnapshotsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunSnapshotsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunSnapshotsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunSnapshotsRequest]) *connect.Response[mgmtv1alpha1.GetJobRunSnapshotsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunSnapshotsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunSnapshotsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_GetJobRunSnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunSnapshots'
+type MockJobServiceHandler_GetJobRunSnapshots_Call struct {
+	*mock.Call
+}
+
+// GetJobRunSnapshots is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunSnapshotsRequest]
+func (_e *MockJobServiceHandler_Expecter) GetJobRunSnapshots(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobRunSnapshots_Call {
+	return &MockJobServiceHandler_GetJobRunSnapshots_Call{Call: _e.mock.On("GetJobRunSnapshots", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_GetJobRunSnapshots_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunSnapshotsRequest])) *MockJobServiceHandler_GetJobRunSnapshots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunSnapshotsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRunSnapshots_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunSnapshotsResponse], _a1 error) *MockJobServiceHandler_GetJobRunSnapshots_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRunSnapshots_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunSnapshotsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunSnapshotsResponse], error)) *MockJobServiceHandler_GetJobRunSnapshots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRuns provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetJobRuns(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRuns")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRunsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunsRequest]) *connect.Response[mgmtv1alpha1.GetJobRunsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_GetJobRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRuns'
+type MockJobServiceHandler_GetJobRuns_Call struct {
+	*mock.Call
+}
+
+// GetJobRuns is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunsRequest]
+func (_e *MockJobServiceHandler_Expecter) GetJobRuns(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobRuns_Call {
+	return &MockJobServiceHandler_GetJobRuns_Call{Call: _e.mock.On("GetJobRuns", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_GetJobRuns_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunsRequest])) *MockJobServiceHandler_GetJobRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRuns_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunsResponse], _a1 error) *MockJobServiceHandler_GetJobRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRuns_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunsResponse], error)) *MockJobServiceHandler_GetJobRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobStatus provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetJobStatus(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobStatusRequest]) (*connect.Response[mgmtv1alpha1.GetJobStatusResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobStatus")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobStatusResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusRequest]) (*connect.Response[mgmtv1alpha1.GetJobStatusResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusRequest]) *connect.Response[mgmtv1alpha1.GetJobStatusResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobStatusResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_GetJobStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobStatus'
+type MockJobServiceHandler_GetJobStatus_Call struct {
+	*mock.Call
+}
+
+// GetJobStatus is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobStatusRequest]
+func (_e *MockJobServiceHandler_Expecter) GetJobStatus(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobStatus_Call {
+	return &MockJobServiceHandler_GetJobStatus_Call{Call: _e.mock.On("GetJobStatus", _a0, _a1)}
 }
 
-func (_c *MockJobServiceHandler_GetJobRunEvents_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest])) *MockJobServiceHandler_GetJobRunEvents_Call {
+func (_c *MockJobServiceHandler_GetJobStatus_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobStatusRequest])) *MockJobServiceHandler_GetJobStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobStatusRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobStatus_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobStatusResponse], _a1 error) *MockJobServiceHandler_GetJobStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobStatus_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusRequest]) (*connect.Response[mgmtv1alpha1.GetJobStatusResponse], error)) *MockJobServiceHandler_GetJobStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobStatuses provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetJobStatuses(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobStatusesRequest]) (*connect.Response[mgmtv1alpha1.GetJobStatusesResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobStatuses")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobStatusesResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusesRequest]) (*connect.Response[mgmtv1alpha1.GetJobStatusesResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusesRequest]) *connect.Response[mgmtv1alpha1.GetJobStatusesResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobStatusesResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusesRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_GetJobStatuses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobStatuses'
+type MockJobServiceHandler_GetJobStatuses_Call struct {
+	*mock.Call
+}
+
+// GetJobStatuses is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobStatusesRequest]
+func (_e *MockJobServiceHandler_Expecter) GetJobStatuses(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobStatuses_Call {
+	return &MockJobServiceHandler_GetJobStatuses_Call{Call: _e.mock.On("GetJobStatuses", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_GetJobStatuses_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobStatusesRequest])) *MockJobServiceHandler_GetJobStatuses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobStatusesRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobStatuses_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobStatusesResponse], _a1 error) *MockJobServiceHandler_GetJobStatuses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobStatuses_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusesRequest]) (*connect.Response[mgmtv1alpha1.GetJobStatusesResponse], error)) *MockJobServiceHandler_GetJobStatuses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobTemplates provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetJobTemplates(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobTemplatesRequest]) (*connect.Response[mgmtv1alpha1.GetJobTemplatesResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobTemplates")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobTemplatesResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobTemplatesRequest]) (*connect.Response[mgmtv1alpha1.GetJobTemplatesResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobTemplatesRequest]) *connect.Response[mgmtv1alpha1.GetJobTemplatesResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobTemplatesResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobTemplatesRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_GetJobTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobTemplates'
+type MockJobServiceHandler_GetJobTemplates_Call struct {
+	*mock.Call
+}
+
+// GetJobTemplates is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobTemplatesRequest]
+func (_e *MockJobServiceHandler_Expecter) GetJobTemplates(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobTemplates_Call {
+	return &MockJobServiceHandler_GetJobTemplates_Call{Call: _e.mock.On("GetJobTemplates", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_GetJobTemplates_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobTemplatesRequest])) *MockJobServiceHandler_GetJobTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobTemplatesRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobTemplates_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobTemplatesResponse], _a1 error) *MockJobServiceHandler_GetJobTemplates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobTemplates_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobTemplatesRequest]) (*connect.Response[mgmtv1alpha1.GetJobTemplatesResponse], error)) *MockJobServiceHandler_GetJobTemplates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobs provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetJobs(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobs")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) *connect.Response[mgmtv1alpha1.GetJobsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_GetJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobs'
+type MockJobServiceHandler_GetJobs_Call struct {
+	*mock.Call
+}
+
+// GetJobs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobsRequest]
+func (_e *MockJobServiceHandler_Expecter) GetJobs(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobs_Call {
+	return &MockJobServiceHandler_GetJobs_Call{Call: _e.mock.On("GetJobs", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_GetJobs_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobsRequest])) *MockJobServiceHandler_GetJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobs_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobsResponse], _a1 error) *MockJobServiceHandler_GetJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobs_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsResponse], error)) *MockJobServiceHandler_GetJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobsOverridingDefaults provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetJobsOverridingDefaults(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobsOverridingDefaultsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsOverridingDefaultsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobsOverridingDefaults")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobsOverridingDefaultsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsOverridingDefaultsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsOverridingDefaultsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsOverridingDefaultsRequest]) *connect.Response[mgmtv1alpha1.GetJobsOverridingDefaultsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobsOverridingDefaultsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsOverridingDefaultsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_GetJobsOverridingDefaults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobsOverridingDefaults'
+type MockJobServiceHandler_GetJobsOverridingDefaults_Call struct {
+	*mock.Call
+}
+
+// GetJobsOverridingDefaults is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobsOverridingDefaultsRequest]
+func (_e *MockJobServiceHandler_Expecter) GetJobsOverridingDefaults(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobsOverridingDefaults_Call {
+	return &MockJobServiceHandler_GetJobsOverridingDefaults_Call{Call: _e.mock.On("GetJobsOverridingDefaults", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_GetJobsOverridingDefaults_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobsOverridingDefaultsRequest])) *MockJobServiceHandler_GetJobsOverridingDefaults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobsOverridingDefaultsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobsOverridingDefaults_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobsOverridingDefaultsResponse], _a1 error) *MockJobServiceHandler_GetJobsOverridingDefaults_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobsOverridingDefaults_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsOverridingDefaultsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsOverridingDefaultsResponse], error)) *MockJobServiceHandler_GetJobsOverridingDefaults_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNextScheduledRuns provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetNextScheduledRuns(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetNextScheduledRunsRequest]) (*connect.Response[mgmtv1alpha1.GetNextScheduledRunsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNextScheduledRuns")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetNextScheduledRunsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetNextScheduledRunsRequest]) (*connect.Response[mgmtv1alpha1.GetNextScheduledRunsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetNextScheduledRunsRequest]) *connect.Response[mgmtv1alpha1.GetNextScheduledRunsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetNextScheduledRunsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetNextScheduledRunsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_GetNextScheduledRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNextScheduledRuns'
+type MockJobServiceHandler_GetNextScheduledRuns_Call struct {
+	*mock.Call
+}
+
+// GetNextScheduledRuns is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetNextScheduledRunsRequest]
+func (_e *MockJobServiceHandler_Expecter) GetNextScheduledRuns(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetNextScheduledRuns_Call {
+	return &MockJobServiceHandler_GetNextScheduledRuns_Call{Call: _e.mock.On("GetNextScheduledRuns", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_GetNextScheduledRuns_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetNextScheduledRunsRequest])) *MockJobServiceHandler_GetNextScheduledRuns_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetNextScheduledRunsRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceHandler_GetJobRunEvents_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunEventsResponse], _a1 error) *MockJobServiceHandler_GetJobRunEvents_Call {
+func (_c *MockJobServiceHandler_GetNextScheduledRuns_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetNextScheduledRunsResponse], _a1 error) *MockJobServiceHandler_GetNextScheduledRuns_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceHandler_GetJobRunEvents_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunEventsResponse], error)) *MockJobServiceHandler_GetJobRunEvents_Call {
+func (_c *MockJobServiceHandler_GetNextScheduledRuns_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetNextScheduledRunsRequest]) (*connect.Response[mgmtv1alpha1.GetNextScheduledRunsResponse], error)) *MockJobServiceHandler_GetNextScheduledRuns_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetJobRunLogsStream provides a mock function with given fields: _a0, _a1, _a2
-func (_m *MockJobServiceHandler) GetJobRunLogsStream(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest], _a2 *connect.ServerStream[mgmtv1alpha1.GetJobRunLogsStreamResponse]) error {
-	ret := _m.Called(_a0, _a1, _a2)
+// IsJobNameAvailable provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) IsJobNameAvailable(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], error) {
+	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobRunLogsStream")
+		panic("no return value specified for IsJobNameAvailable")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest], *connect.ServerStream[mgmtv1alpha1.GetJobRunLogsStreamResponse]) error); ok {
-		r0 = rf(_a0, _a1, _a2)
+	var r0 *connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) *connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse]); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse])
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockJobServiceHandler_GetJobRunLogsStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunLogsStream'
-type MockJobServiceHandler_GetJobRunLogsStream_Call struct {
+// MockJobServiceHandler_IsJobNameAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsJobNameAvailable'
+type MockJobServiceHandler_IsJobNameAvailable_Call struct {
 	*mock.Call
 }
 
-// GetJobRunLogsStream is a helper method to define mock.On call
+// IsJobNameAvailable is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest]
-//   - _a2 *connect.ServerStream[mgmtv1alpha1.GetJobRunLogsStreamResponse]
-func (_e *MockJobServiceHandler_Expecter) GetJobRunLogsStream(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockJobServiceHandler_GetJobRunLogsStream_Call {
-	return &MockJobServiceHandler_GetJobRunLogsStream_Call{Call: _e.mock.On("GetJobRunLogsStream", _a0, _a1, _a2)}
+//   - _a1 *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]
+func (_e *MockJobServiceHandler_Expecter) IsJobNameAvailable(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_IsJobNameAvailable_Call {
+	return &MockJobServiceHandler_IsJobNameAvailable_Call{Call: _e.mock.On("IsJobNameAvailable", _a0, _a1)}
 }
 
-func (_c *MockJobServiceHandler_GetJobRunLogsStream_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest], _a2 *connect.ServerStream[mgmtv1alpha1.GetJobRunLogsStreamResponse])) *MockJobServiceHandler_GetJobRunLogsStream_Call {
+func (_c *MockJobServiceHandler_IsJobNameAvailable_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest])) *MockJobServiceHandler_IsJobNameAvailable_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest]), args[2].(*connect.ServerStream[mgmtv1alpha1.GetJobRunLogsStreamResponse]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceHandler_GetJobRunLogsStream_Call) Return(_a0 error) *MockJobServiceHandler_GetJobRunLogsStream_Call {
-	_c.Call.Return(_a0)
+func (_c *MockJobServiceHandler_IsJobNameAvailable_Call) Return(_a0 *connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], _a1 error) *MockJobServiceHandler_IsJobNameAvailable_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceHandler_GetJobRunLogsStream_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunLogsStreamRequest], *connect.ServerStream[mgmtv1alpha1.GetJobRunLogsStreamResponse]) error) *MockJobServiceHandler_GetJobRunLogsStream_Call {
+func (_c *MockJobServiceHandler_IsJobNameAvailable_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], error)) *MockJobServiceHandler_IsJobNameAvailable_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetJobRuns provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceHandler) GetJobRuns(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunsResponse], error) {
+// PauseJob provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) PauseJob(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.PauseJobRequest]) (*connect.Response[mgmtv1alpha1.PauseJobResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobRuns")
+		panic("no return value specified for PauseJob")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.GetJobRunsResponse]
+	var r0 *connect.Response[mgmtv1alpha1.PauseJobResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunsResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) (*connect.Response[mgmtv1alpha1.PauseJobResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunsRequest]) *connect.Response[mgmtv1alpha1.GetJobRunsResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) *connect.Response[mgmtv1alpha1.PauseJobResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunsResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.PauseJobResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunsRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -810,57 +2285,57 @@ func (_m *MockJobServiceHandler) GetJobRuns(_a0 context.Context, _a1 *connect.Re
 	return r0, r1
 }
 
-// MockJobServiceHandler_GetJobRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRuns'
-type MockJobServiceHandler_GetJobRuns_Call struct {
+// MockJobServiceHandler_PauseJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseJob'
+type MockJobServiceHandler_PauseJob_Call struct {
 	*mock.Call
 }
 
-// GetJobRuns is a helper method to define mock.On call
+// PauseJob is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunsRequest]
-func (_e *MockJobServiceHandler_Expecter) GetJobRuns(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobRuns_Call {
-	return &MockJobServiceHandler_GetJobRuns_Call{Call: _e.mock.On("GetJobRuns", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.PauseJobRequest]
+func (_e *MockJobServiceHandler_Expecter) PauseJob(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_PauseJob_Call {
+	return &MockJobServiceHandler_PauseJob_Call{Call: _e.mock.On("PauseJob", _a0, _a1)}
 }
 
-func (_c *MockJobServiceHandler_GetJobRuns_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunsRequest])) *MockJobServiceHandler_GetJobRuns_Call {
+func (_c *MockJobServiceHandler_PauseJob_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.PauseJobRequest])) *MockJobServiceHandler_PauseJob_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunsRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.PauseJobRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceHandler_GetJobRuns_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunsResponse], _a1 error) *MockJobServiceHandler_GetJobRuns_Call {
+func (_c *MockJobServiceHandler_PauseJob_Call) Return(_a0 *connect.Response[mgmtv1alpha1.PauseJobResponse], _a1 error) *MockJobServiceHandler_PauseJob_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceHandler_GetJobRuns_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunsResponse], error)) *MockJobServiceHandler_GetJobRuns_Call {
+func (_c *MockJobServiceHandler_PauseJob_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) (*connect.Response[mgmtv1alpha1.PauseJobResponse], error)) *MockJobServiceHandler_PauseJob_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetJobStatus provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceHandler) GetJobStatus(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobStatusRequest]) (*connect.Response[mgmtv1alpha1.GetJobStatusResponse], error) {
+// ResumeJob provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) ResumeJob(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ResumeJobRequest]) (*connect.Response[mgmtv1alpha1.ResumeJobResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobStatus")
+		panic("no return value specified for ResumeJob")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.GetJobStatusResponse]
+	var r0 *connect.Response[mgmtv1alpha1.ResumeJobResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusRequest]) (*connect.Response[mgmtv1alpha1.GetJobStatusResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ResumeJobRequest]) (*connect.Response[mgmtv1alpha1.ResumeJobResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusRequest]) *connect.Response[mgmtv1alpha1.GetJobStatusResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ResumeJobRequest]) *connect.Response[mgmtv1alpha1.ResumeJobResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobStatusResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.ResumeJobResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.ResumeJobRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -869,57 +2344,57 @@ func (_m *MockJobServiceHandler) GetJobStatus(_a0 context.Context, _a1 *connect.
 	return r0, r1
 }
 
-// MockJobServiceHandler_GetJobStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobStatus'
-type MockJobServiceHandler_GetJobStatus_Call struct {
+// MockJobServiceHandler_ResumeJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeJob'
+type MockJobServiceHandler_ResumeJob_Call struct {
 	*mock.Call
 }
 
-// GetJobStatus is a helper method to define mock.On call
+// ResumeJob is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.GetJobStatusRequest]
-func (_e *MockJobServiceHandler_Expecter) GetJobStatus(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobStatus_Call {
-	return &MockJobServiceHandler_GetJobStatus_Call{Call: _e.mock.On("GetJobStatus", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.ResumeJobRequest]
+func (_e *MockJobServiceHandler_Expecter) ResumeJob(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_ResumeJob_Call {
+	return &MockJobServiceHandler_ResumeJob_Call{Call: _e.mock.On("ResumeJob", _a0, _a1)}
 }
 
-func (_c *MockJobServiceHandler_GetJobStatus_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobStatusRequest])) *MockJobServiceHandler_GetJobStatus_Call {
+func (_c *MockJobServiceHandler_ResumeJob_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ResumeJobRequest])) *MockJobServiceHandler_ResumeJob_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobStatusRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.ResumeJobRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceHandler_GetJobStatus_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobStatusResponse], _a1 error) *MockJobServiceHandler_GetJobStatus_Call {
+func (_c *MockJobServiceHandler_ResumeJob_Call) Return(_a0 *connect.Response[mgmtv1alpha1.ResumeJobResponse], _a1 error) *MockJobServiceHandler_ResumeJob_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceHandler_GetJobStatus_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusRequest]) (*connect.Response[mgmtv1alpha1.GetJobStatusResponse], error)) *MockJobServiceHandler_GetJobStatus_Call {
+func (_c *MockJobServiceHandler_ResumeJob_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.ResumeJobRequest]) (*connect.Response[mgmtv1alpha1.ResumeJobResponse], error)) *MockJobServiceHandler_ResumeJob_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetJobStatuses provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceHandler) GetJobStatuses(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobStatusesRequest]) (*connect.Response[mgmtv1alpha1.GetJobStatusesResponse], error) {
+// SetAccountJobDefaults provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) SetAccountJobDefaults(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetAccountJobDefaultsRequest]) (*connect.Response[mgmtv1alpha1.SetAccountJobDefaultsResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobStatuses")
+		panic("no return value specified for SetAccountJobDefaults")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.GetJobStatusesResponse]
+	var r0 *connect.Response[mgmtv1alpha1.SetAccountJobDefaultsResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusesRequest]) (*connect.Response[mgmtv1alpha1.GetJobStatusesResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetAccountJobDefaultsRequest]) (*connect.Response[mgmtv1alpha1.SetAccountJobDefaultsResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusesRequest]) *connect.Response[mgmtv1alpha1.GetJobStatusesResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetAccountJobDefaultsRequest]) *connect.Response[mgmtv1alpha1.SetAccountJobDefaultsResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobStatusesResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetAccountJobDefaultsResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusesRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetAccountJobDefaultsRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -928,57 +2403,57 @@ func (_m *MockJobServiceHandler) GetJobStatuses(_a0 context.Context, _a1 *connec
 	return r0, r1
 }
 
-// MockJobServiceHandler_GetJobStatuses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobStatuses'
-type MockJobServiceHandler_GetJobStatuses_Call struct {
+// MockJobServiceHandler_SetAccountJobDefaults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAccountJobDefaults'
+type MockJobServiceHandler_SetAccountJobDefaults_Call struct {
 	*mock.Call
 }
 
-// GetJobStatuses is a helper method to define mock.On call
+// SetAccountJobDefaults is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.GetJobStatusesRequest]
-func (_e *MockJobServiceHandler_Expecter) GetJobStatuses(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobStatuses_Call {
-	return &MockJobServiceHandler_GetJobStatuses_Call{Call: _e.mock.On("GetJobStatuses", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.SetAccountJobDefaultsRequest]
+func (_e *MockJobServiceHandler_Expecter) SetAccountJobDefaults(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_SetAccountJobDefaults_Call {
+	return &MockJobServiceHandler_SetAccountJobDefaults_Call{Call: _e.mock.On("SetAccountJobDefaults", _a0, _a1)}
 }
 
-func (_c *MockJobServiceHandler_GetJobStatuses_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobStatusesRequest])) *MockJobServiceHandler_GetJobStatuses_Call {
+func (_c *MockJobServiceHandler_SetAccountJobDefaults_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetAccountJobDefaultsRequest])) *MockJobServiceHandler_SetAccountJobDefaults_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobStatusesRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetAccountJobDefaultsRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceHandler_GetJobStatuses_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobStatusesResponse], _a1 error) *MockJobServiceHandler_GetJobStatuses_Call {
+func (_c *MockJobServiceHandler_SetAccountJobDefaults_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetAccountJobDefaultsResponse], _a1 error) *MockJobServiceHandler_SetAccountJobDefaults_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceHandler_GetJobStatuses_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobStatusesRequest]) (*connect.Response[mgmtv1alpha1.GetJobStatusesResponse], error)) *MockJobServiceHandler_GetJobStatuses_Call {
+func (_c *MockJobServiceHandler_SetAccountJobDefaults_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetAccountJobDefaultsRequest]) (*connect.Response[mgmtv1alpha1.SetAccountJobDefaultsResponse], error)) *MockJobServiceHandler_SetAccountJobDefaults_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetJobs provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceHandler) GetJobs(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsResponse], error) {
+// SetJobLabels provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) SetJobLabels(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobLabelsRequest]) (*connect.Response[mgmtv1alpha1.SetJobLabelsResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobs")
+		panic("no return value specified for SetJobLabels")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.GetJobsResponse]
+	var r0 *connect.Response[mgmtv1alpha1.SetJobLabelsResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobLabelsRequest]) (*connect.Response[mgmtv1alpha1.SetJobLabelsResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) *connect.Response[mgmtv1alpha1.GetJobsResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobLabelsRequest]) *connect.Response[mgmtv1alpha1.SetJobLabelsResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobsResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetJobLabelsResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobLabelsRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -987,57 +2462,57 @@ func (_m *MockJobServiceHandler) GetJobs(_a0 context.Context, _a1 *connect.Reque
 	return r0, r1
 }
 
-// MockJobServiceHandler_GetJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobs'
-type MockJobServiceHandler_GetJobs_Call struct {
+// MockJobServiceHandler_SetJobLabels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobLabels'
+type MockJobServiceHandler_SetJobLabels_Call struct {
 	*mock.Call
 }
 
-// GetJobs is a helper method to define mock.On call
+// SetJobLabels is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.GetJobsRequest]
-func (_e *MockJobServiceHandler_Expecter) GetJobs(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobs_Call {
-	return &MockJobServiceHandler_GetJobs_Call{Call: _e.mock.On("GetJobs", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.SetJobLabelsRequest]
+func (_e *MockJobServiceHandler_Expecter) SetJobLabels(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_SetJobLabels_Call {
+	return &MockJobServiceHandler_SetJobLabels_Call{Call: _e.mock.On("SetJobLabels", _a0, _a1)}
 }
 
-func (_c *MockJobServiceHandler_GetJobs_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobsRequest])) *MockJobServiceHandler_GetJobs_Call {
+func (_c *MockJobServiceHandler_SetJobLabels_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobLabelsRequest])) *MockJobServiceHandler_SetJobLabels_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobsRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetJobLabelsRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceHandler_GetJobs_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobsResponse], _a1 error) *MockJobServiceHandler_GetJobs_Call {
+func (_c *MockJobServiceHandler_SetJobLabels_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetJobLabelsResponse], _a1 error) *MockJobServiceHandler_SetJobLabels_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceHandler_GetJobs_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsResponse], error)) *MockJobServiceHandler_GetJobs_Call {
+func (_c *MockJobServiceHandler_SetJobLabels_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetJobLabelsRequest]) (*connect.Response[mgmtv1alpha1.SetJobLabelsResponse], error)) *MockJobServiceHandler_SetJobLabels_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsJobNameAvailable provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceHandler) IsJobNameAvailable(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], error) {
+// SetJobMappings provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) SetJobMappings(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobMappingsRequest]) (*connect.Response[mgmtv1alpha1.SetJobMappingsResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsJobNameAvailable")
+		panic("no return value specified for SetJobMappings")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse]
+	var r0 *connect.Response[mgmtv1alpha1.SetJobMappingsResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobMappingsRequest]) (*connect.Response[mgmtv1alpha1.SetJobMappingsResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) *connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobMappingsRequest]) *connect.Response[mgmtv1alpha1.SetJobMappingsResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetJobMappingsResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobMappingsRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1046,57 +2521,57 @@ func (_m *MockJobServiceHandler) IsJobNameAvailable(_a0 context.Context, _a1 *co
 	return r0, r1
 }
 
-// MockJobServiceHandler_IsJobNameAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsJobNameAvailable'
-type MockJobServiceHandler_IsJobNameAvailable_Call struct {
+// MockJobServiceHandler_SetJobMappings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobMappings'
+type MockJobServiceHandler_SetJobMappings_Call struct {
 	*mock.Call
 }
 
-// IsJobNameAvailable is a helper method to define mock.On call
+// SetJobMappings is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]
-func (_e *MockJobServiceHandler_Expecter) IsJobNameAvailable(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_IsJobNameAvailable_Call {
-	return &MockJobServiceHandler_IsJobNameAvailable_Call{Call: _e.mock.On("IsJobNameAvailable", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.SetJobMappingsRequest]
+func (_e *MockJobServiceHandler_Expecter) SetJobMappings(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_SetJobMappings_Call {
+	return &MockJobServiceHandler_SetJobMappings_Call{Call: _e.mock.On("SetJobMappings", _a0, _a1)}
 }
 
-func (_c *MockJobServiceHandler_IsJobNameAvailable_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest])) *MockJobServiceHandler_IsJobNameAvailable_Call {
+func (_c *MockJobServiceHandler_SetJobMappings_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobMappingsRequest])) *MockJobServiceHandler_SetJobMappings_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetJobMappingsRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceHandler_IsJobNameAvailable_Call) Return(_a0 *connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], _a1 error) *MockJobServiceHandler_IsJobNameAvailable_Call {
+func (_c *MockJobServiceHandler_SetJobMappings_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetJobMappingsResponse], _a1 error) *MockJobServiceHandler_SetJobMappings_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceHandler_IsJobNameAvailable_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], error)) *MockJobServiceHandler_IsJobNameAvailable_Call {
+func (_c *MockJobServiceHandler_SetJobMappings_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetJobMappingsRequest]) (*connect.Response[mgmtv1alpha1.SetJobMappingsResponse], error)) *MockJobServiceHandler_SetJobMappings_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PauseJob provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceHandler) PauseJob(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.PauseJobRequest]) (*connect.Response[mgmtv1alpha1.PauseJobResponse], error) {
+// SetJobParameters provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) SetJobParameters(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobParametersRequest]) (*connect.Response[mgmtv1alpha1.SetJobParametersResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PauseJob")
+		panic("no return value specified for SetJobParameters")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.PauseJobResponse]
+	var r0 *connect.Response[mgmtv1alpha1.SetJobParametersResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) (*connect.Response[mgmtv1alpha1.PauseJobResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobParametersRequest]) (*connect.Response[mgmtv1alpha1.SetJobParametersResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) *connect.Response[mgmtv1alpha1.PauseJobResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobParametersRequest]) *connect.Response[mgmtv1alpha1.SetJobParametersResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.PauseJobResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetJobParametersResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobParametersRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1105,31 +2580,31 @@ func (_m *MockJobServiceHandler) PauseJob(_a0 context.Context, _a1 *connect.Requ
 	return r0, r1
 }
 
-// MockJobServiceHandler_PauseJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseJob'
-type MockJobServiceHandler_PauseJob_Call struct {
+// MockJobServiceHandler_SetJobParameters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobParameters'
+type MockJobServiceHandler_SetJobParameters_Call struct {
 	*mock.Call
 }
 
-// PauseJob is a helper method to define mock.On call
+// SetJobParameters is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.PauseJobRequest]
-func (_e *MockJobServiceHandler_Expecter) PauseJob(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_PauseJob_Call {
-	return &MockJobServiceHandler_PauseJob_Call{Call: _e.mock.On("PauseJob", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.SetJobParametersRequest]
+func (_e *MockJobServiceHandler_Expecter) SetJobParameters(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_SetJobParameters_Call {
+	return &MockJobServiceHandler_SetJobParameters_Call{Call: _e.mock.On("SetJobParameters", _a0, _a1)}
 }
 
-func (_c *MockJobServiceHandler_PauseJob_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.PauseJobRequest])) *MockJobServiceHandler_PauseJob_Call {
+func (_c *MockJobServiceHandler_SetJobParameters_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobParametersRequest])) *MockJobServiceHandler_SetJobParameters_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.PauseJobRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetJobParametersRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceHandler_PauseJob_Call) Return(_a0 *connect.Response[mgmtv1alpha1.PauseJobResponse], _a1 error) *MockJobServiceHandler_PauseJob_Call {
+func (_c *MockJobServiceHandler_SetJobParameters_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetJobParametersResponse], _a1 error) *MockJobServiceHandler_SetJobParameters_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceHandler_PauseJob_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) (*connect.Response[mgmtv1alpha1.PauseJobResponse], error)) *MockJobServiceHandler_PauseJob_Call {
+func (_c *MockJobServiceHandler_SetJobParameters_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetJobParametersRequest]) (*connect.Response[mgmtv1alpha1.SetJobParametersResponse], error)) *MockJobServiceHandler_SetJobParameters_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1252,6 +2727,65 @@ func (_c *MockJobServiceHandler_SetJobSyncOptions_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// SetJobTableProcessors provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) SetJobTableProcessors(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobTableProcessorsRequest]) (*connect.Response[mgmtv1alpha1.SetJobTableProcessorsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetJobTableProcessors")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.SetJobTableProcessorsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobTableProcessorsRequest]) (*connect.Response[mgmtv1alpha1.SetJobTableProcessorsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobTableProcessorsRequest]) *connect.Response[mgmtv1alpha1.SetJobTableProcessorsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetJobTableProcessorsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobTableProcessorsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_SetJobTableProcessors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobTableProcessors'
+type MockJobServiceHandler_SetJobTableProcessors_Call struct {
+	*mock.Call
+}
+
+// SetJobTableProcessors is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.SetJobTableProcessorsRequest]
+func (_e *MockJobServiceHandler_Expecter) SetJobTableProcessors(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_SetJobTableProcessors_Call {
+	return &MockJobServiceHandler_SetJobTableProcessors_Call{Call: _e.mock.On("SetJobTableProcessors", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_SetJobTableProcessors_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobTableProcessorsRequest])) *MockJobServiceHandler_SetJobTableProcessors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetJobTableProcessorsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_SetJobTableProcessors_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetJobTableProcessorsResponse], _a1 error) *MockJobServiceHandler_SetJobTableProcessors_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_SetJobTableProcessors_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetJobTableProcessorsRequest]) (*connect.Response[mgmtv1alpha1.SetJobTableProcessorsResponse], error)) *MockJobServiceHandler_SetJobTableProcessors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetJobWorkflowOptions provides a mock function with given fields: _a0, _a1
 func (_m *MockJobServiceHandler) SetJobWorkflowOptions(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobWorkflowOptionsRequest]) (*connect.Response[mgmtv1alpha1.SetJobWorkflowOptionsResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -1311,6 +2845,54 @@ func (_c *MockJobServiceHandler_SetJobWorkflowOptions_Call) RunAndReturn(run fun
 	return _c
 }
 
+// StreamJobRunStatuses provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockJobServiceHandler) StreamJobRunStatuses(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.StreamJobRunStatusesRequest], _a2 *connect.ServerStream[mgmtv1alpha1.StreamJobRunStatusesResponse]) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamJobRunStatuses")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.StreamJobRunStatusesRequest], *connect.ServerStream[mgmtv1alpha1.StreamJobRunStatusesResponse]) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockJobServiceHandler_StreamJobRunStatuses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamJobRunStatuses'
+type MockJobServiceHandler_StreamJobRunStatuses_Call struct {
+	*mock.Call
+}
+
+// StreamJobRunStatuses is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.StreamJobRunStatusesRequest]
+//   - _a2 *connect.ServerStream[mgmtv1alpha1.StreamJobRunStatusesResponse]
+func (_e *MockJobServiceHandler_Expecter) StreamJobRunStatuses(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockJobServiceHandler_StreamJobRunStatuses_Call {
+	return &MockJobServiceHandler_StreamJobRunStatuses_Call{Call: _e.mock.On("StreamJobRunStatuses", _a0, _a1, _a2)}
+}
+
+func (_c *MockJobServiceHandler_StreamJobRunStatuses_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.StreamJobRunStatusesRequest], _a2 *connect.ServerStream[mgmtv1alpha1.StreamJobRunStatusesResponse])) *MockJobServiceHandler_StreamJobRunStatuses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.StreamJobRunStatusesRequest]), args[2].(*connect.ServerStream[mgmtv1alpha1.StreamJobRunStatusesResponse]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_StreamJobRunStatuses_Call) Return(_a0 error) *MockJobServiceHandler_StreamJobRunStatuses_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_StreamJobRunStatuses_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.StreamJobRunStatusesRequest], *connect.ServerStream[mgmtv1alpha1.StreamJobRunStatusesResponse]) error) *MockJobServiceHandler_StreamJobRunStatuses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TerminateJobRun provides a mock function with given fields: _a0, _a1
 func (_m *MockJobServiceHandler) TerminateJobRun(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.TerminateJobRunRequest]) (*connect.Response[mgmtv1alpha1.TerminateJobRunResponse], error) {
 	ret := _m.Called(_a0, _a1)
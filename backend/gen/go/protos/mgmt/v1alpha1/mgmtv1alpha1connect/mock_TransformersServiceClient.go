@@ -83,6 +83,65 @@ func (_c *MockTransformersServiceClient_CreateUserDefinedTransformer_Call) RunAn
 	return _c
 }
 
+// DeleteJavascriptLibrary provides a mock function with given fields: _a0, _a1
+func (_m *MockTransformersServiceClient) DeleteJavascriptLibrary(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.DeleteJavascriptLibraryRequest]) (*connect.Response[mgmtv1alpha1.DeleteJavascriptLibraryResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteJavascriptLibrary")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.DeleteJavascriptLibraryResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.DeleteJavascriptLibraryRequest]) (*connect.Response[mgmtv1alpha1.DeleteJavascriptLibraryResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.DeleteJavascriptLibraryRequest]) *connect.Response[mgmtv1alpha1.DeleteJavascriptLibraryResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.DeleteJavascriptLibraryResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.DeleteJavascriptLibraryRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransformersServiceClient_DeleteJavascriptLibrary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteJavascriptLibrary'
+type MockTransformersServiceClient_DeleteJavascriptLibrary_Call struct {
+	*mock.Call
+}
+
+// DeleteJavascriptLibrary is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.DeleteJavascriptLibraryRequest]
+func (_e *MockTransformersServiceClient_Expecter) DeleteJavascriptLibrary(_a0 interface{}, _a1 interface{}) *MockTransformersServiceClient_DeleteJavascriptLibrary_Call {
+	return &MockTransformersServiceClient_DeleteJavascriptLibrary_Call{Call: _e.mock.On("DeleteJavascriptLibrary", _a0, _a1)}
+}
+
+func (_c *MockTransformersServiceClient_DeleteJavascriptLibrary_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.DeleteJavascriptLibraryRequest])) *MockTransformersServiceClient_DeleteJavascriptLibrary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.DeleteJavascriptLibraryRequest]))
+	})
+	return _c
+}
+
+func (_c *MockTransformersServiceClient_DeleteJavascriptLibrary_Call) Return(_a0 *connect.Response[mgmtv1alpha1.DeleteJavascriptLibraryResponse], _a1 error) *MockTransformersServiceClient_DeleteJavascriptLibrary_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransformersServiceClient_DeleteJavascriptLibrary_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.DeleteJavascriptLibraryRequest]) (*connect.Response[mgmtv1alpha1.DeleteJavascriptLibraryResponse], error)) *MockTransformersServiceClient_DeleteJavascriptLibrary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteUserDefinedTransformer provides a mock function with given fields: _a0, _a1
 func (_m *MockTransformersServiceClient) DeleteUserDefinedTransformer(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.DeleteUserDefinedTransformerRequest]) (*connect.Response[mgmtv1alpha1.DeleteUserDefinedTransformerResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -142,6 +201,65 @@ func (_c *MockTransformersServiceClient_DeleteUserDefinedTransformer_Call) RunAn
 	return _c
 }
 
+// GetJavascriptLibraries provides a mock function with given fields: _a0, _a1
+func (_m *MockTransformersServiceClient) GetJavascriptLibraries(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJavascriptLibrariesRequest]) (*connect.Response[mgmtv1alpha1.GetJavascriptLibrariesResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJavascriptLibraries")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJavascriptLibrariesResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJavascriptLibrariesRequest]) (*connect.Response[mgmtv1alpha1.GetJavascriptLibrariesResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJavascriptLibrariesRequest]) *connect.Response[mgmtv1alpha1.GetJavascriptLibrariesResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJavascriptLibrariesResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJavascriptLibrariesRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransformersServiceClient_GetJavascriptLibraries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJavascriptLibraries'
+type MockTransformersServiceClient_GetJavascriptLibraries_Call struct {
+	*mock.Call
+}
+
+// GetJavascriptLibraries is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJavascriptLibrariesRequest]
+func (_e *MockTransformersServiceClient_Expecter) GetJavascriptLibraries(_a0 interface{}, _a1 interface{}) *MockTransformersServiceClient_GetJavascriptLibraries_Call {
+	return &MockTransformersServiceClient_GetJavascriptLibraries_Call{Call: _e.mock.On("GetJavascriptLibraries", _a0, _a1)}
+}
+
+func (_c *MockTransformersServiceClient_GetJavascriptLibraries_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJavascriptLibrariesRequest])) *MockTransformersServiceClient_GetJavascriptLibraries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJavascriptLibrariesRequest]))
+	})
+	return _c
+}
+
+func (_c *MockTransformersServiceClient_GetJavascriptLibraries_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJavascriptLibrariesResponse], _a1 error) *MockTransformersServiceClient_GetJavascriptLibraries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransformersServiceClient_GetJavascriptLibraries_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJavascriptLibrariesRequest]) (*connect.Response[mgmtv1alpha1.GetJavascriptLibrariesResponse], error)) *MockTransformersServiceClient_GetJavascriptLibraries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSystemTransformerBySource provides a mock function with given fields: _a0, _a1
 func (_m *MockTransformersServiceClient) GetSystemTransformerBySource(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetSystemTransformerBySourceRequest]) (*connect.Response[mgmtv1alpha1.GetSystemTransformerBySourceResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -437,6 +555,124 @@ func (_c *MockTransformersServiceClient_IsTransformerNameAvailable_Call) RunAndR
 	return _c
 }
 
+// SetJavascriptLibrary provides a mock function with given fields: _a0, _a1
+func (_m *MockTransformersServiceClient) SetJavascriptLibrary(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJavascriptLibraryRequest]) (*connect.Response[mgmtv1alpha1.SetJavascriptLibraryResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetJavascriptLibrary")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.SetJavascriptLibraryResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJavascriptLibraryRequest]) (*connect.Response[mgmtv1alpha1.SetJavascriptLibraryResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJavascriptLibraryRequest]) *connect.Response[mgmtv1alpha1.SetJavascriptLibraryResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetJavascriptLibraryResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJavascriptLibraryRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransformersServiceClient_SetJavascriptLibrary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJavascriptLibrary'
+type MockTransformersServiceClient_SetJavascriptLibrary_Call struct {
+	*mock.Call
+}
+
+// SetJavascriptLibrary is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.SetJavascriptLibraryRequest]
+func (_e *MockTransformersServiceClient_Expecter) SetJavascriptLibrary(_a0 interface{}, _a1 interface{}) *MockTransformersServiceClient_SetJavascriptLibrary_Call {
+	return &MockTransformersServiceClient_SetJavascriptLibrary_Call{Call: _e.mock.On("SetJavascriptLibrary", _a0, _a1)}
+}
+
+func (_c *MockTransformersServiceClient_SetJavascriptLibrary_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJavascriptLibraryRequest])) *MockTransformersServiceClient_SetJavascriptLibrary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetJavascriptLibraryRequest]))
+	})
+	return _c
+}
+
+func (_c *MockTransformersServiceClient_SetJavascriptLibrary_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetJavascriptLibraryResponse], _a1 error) *MockTransformersServiceClient_SetJavascriptLibrary_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransformersServiceClient_SetJavascriptLibrary_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetJavascriptLibraryRequest]) (*connect.Response[mgmtv1alpha1.SetJavascriptLibraryResponse], error)) *MockTransformersServiceClient_SetJavascriptLibrary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUserDefinedTransformerLabels provides a mock function with given fields: _a0, _a1
+func (_m *MockTransformersServiceClient) SetUserDefinedTransformerLabels(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetUserDefinedTransformerLabelsRequest]) (*connect.Response[mgmtv1alpha1.SetUserDefinedTransformerLabelsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUserDefinedTransformerLabels")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.SetUserDefinedTransformerLabelsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetUserDefinedTransformerLabelsRequest]) (*connect.Response[mgmtv1alpha1.SetUserDefinedTransformerLabelsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetUserDefinedTransformerLabelsRequest]) *connect.Response[mgmtv1alpha1.SetUserDefinedTransformerLabelsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetUserDefinedTransformerLabelsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetUserDefinedTransformerLabelsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransformersServiceClient_SetUserDefinedTransformerLabels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserDefinedTransformerLabels'
+type MockTransformersServiceClient_SetUserDefinedTransformerLabels_Call struct {
+	*mock.Call
+}
+
+// SetUserDefinedTransformerLabels is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.SetUserDefinedTransformerLabelsRequest]
+func (_e *MockTransformersServiceClient_Expecter) SetUserDefinedTransformerLabels(_a0 interface{}, _a1 interface{}) *MockTransformersServiceClient_SetUserDefinedTransformerLabels_Call {
+	return &MockTransformersServiceClient_SetUserDefinedTransformerLabels_Call{Call: _e.mock.On("SetUserDefinedTransformerLabels", _a0, _a1)}
+}
+
+func (_c *MockTransformersServiceClient_SetUserDefinedTransformerLabels_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetUserDefinedTransformerLabelsRequest])) *MockTransformersServiceClient_SetUserDefinedTransformerLabels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetUserDefinedTransformerLabelsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockTransformersServiceClient_SetUserDefinedTransformerLabels_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetUserDefinedTransformerLabelsResponse], _a1 error) *MockTransformersServiceClient_SetUserDefinedTransformerLabels_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransformersServiceClient_SetUserDefinedTransformerLabels_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetUserDefinedTransformerLabelsRequest]) (*connect.Response[mgmtv1alpha1.SetUserDefinedTransformerLabelsResponse], error)) *MockTransformersServiceClient_SetUserDefinedTransformerLabels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateUserDefinedTransformer provides a mock function with given fields: _a0, _a1
 func (_m *MockTransformersServiceClient) UpdateUserDefinedTransformer(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.UpdateUserDefinedTransformerRequest]) (*connect.Response[mgmtv1alpha1.UpdateUserDefinedTransformerResponse], error) {
 	ret := _m.Called(_a0, _a1)
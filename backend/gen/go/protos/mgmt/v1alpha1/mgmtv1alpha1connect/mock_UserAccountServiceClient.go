@@ -614,6 +614,65 @@ func (_c *MockUserAccountServiceClient_GetUserAccounts_Call) RunAndReturn(run fu
 	return _c
 }
 
+// GrantAccountPermission provides a mock function with given fields: _a0, _a1
+func (_m *MockUserAccountServiceClient) GrantAccountPermission(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GrantAccountPermissionRequest]) (*connect.Response[mgmtv1alpha1.GrantAccountPermissionResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GrantAccountPermission")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GrantAccountPermissionResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GrantAccountPermissionRequest]) (*connect.Response[mgmtv1alpha1.GrantAccountPermissionResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GrantAccountPermissionRequest]) *connect.Response[mgmtv1alpha1.GrantAccountPermissionResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GrantAccountPermissionResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GrantAccountPermissionRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserAccountServiceClient_GrantAccountPermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GrantAccountPermission'
+type MockUserAccountServiceClient_GrantAccountPermission_Call struct {
+	*mock.Call
+}
+
+// GrantAccountPermission is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GrantAccountPermissionRequest]
+func (_e *MockUserAccountServiceClient_Expecter) GrantAccountPermission(_a0 interface{}, _a1 interface{}) *MockUserAccountServiceClient_GrantAccountPermission_Call {
+	return &MockUserAccountServiceClient_GrantAccountPermission_Call{Call: _e.mock.On("GrantAccountPermission", _a0, _a1)}
+}
+
+func (_c *MockUserAccountServiceClient_GrantAccountPermission_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GrantAccountPermissionRequest])) *MockUserAccountServiceClient_GrantAccountPermission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GrantAccountPermissionRequest]))
+	})
+	return _c
+}
+
+func (_c *MockUserAccountServiceClient_GrantAccountPermission_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GrantAccountPermissionResponse], _a1 error) *MockUserAccountServiceClient_GrantAccountPermission_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserAccountServiceClient_GrantAccountPermission_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GrantAccountPermissionRequest]) (*connect.Response[mgmtv1alpha1.GrantAccountPermissionResponse], error)) *MockUserAccountServiceClient_GrantAccountPermission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // InviteUserToTeamAccount provides a mock function with given fields: _a0, _a1
 func (_m *MockUserAccountServiceClient) InviteUserToTeamAccount(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.InviteUserToTeamAccountRequest]) (*connect.Response[mgmtv1alpha1.InviteUserToTeamAccountResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -850,6 +909,65 @@ func (_c *MockUserAccountServiceClient_RemoveTeamAccountMember_Call) RunAndRetur
 	return _c
 }
 
+// RevokeAccountPermission provides a mock function with given fields: _a0, _a1
+func (_m *MockUserAccountServiceClient) RevokeAccountPermission(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.RevokeAccountPermissionRequest]) (*connect.Response[mgmtv1alpha1.RevokeAccountPermissionResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAccountPermission")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.RevokeAccountPermissionResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.RevokeAccountPermissionRequest]) (*connect.Response[mgmtv1alpha1.RevokeAccountPermissionResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.RevokeAccountPermissionRequest]) *connect.Response[mgmtv1alpha1.RevokeAccountPermissionResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.RevokeAccountPermissionResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.RevokeAccountPermissionRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserAccountServiceClient_RevokeAccountPermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAccountPermission'
+type MockUserAccountServiceClient_RevokeAccountPermission_Call struct {
+	*mock.Call
+}
+
+// RevokeAccountPermission is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.RevokeAccountPermissionRequest]
+func (_e *MockUserAccountServiceClient_Expecter) RevokeAccountPermission(_a0 interface{}, _a1 interface{}) *MockUserAccountServiceClient_RevokeAccountPermission_Call {
+	return &MockUserAccountServiceClient_RevokeAccountPermission_Call{Call: _e.mock.On("RevokeAccountPermission", _a0, _a1)}
+}
+
+func (_c *MockUserAccountServiceClient_RevokeAccountPermission_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.RevokeAccountPermissionRequest])) *MockUserAccountServiceClient_RevokeAccountPermission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.RevokeAccountPermissionRequest]))
+	})
+	return _c
+}
+
+func (_c *MockUserAccountServiceClient_RevokeAccountPermission_Call) Return(_a0 *connect.Response[mgmtv1alpha1.RevokeAccountPermissionResponse], _a1 error) *MockUserAccountServiceClient_RevokeAccountPermission_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserAccountServiceClient_RevokeAccountPermission_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.RevokeAccountPermissionRequest]) (*connect.Response[mgmtv1alpha1.RevokeAccountPermissionResponse], error)) *MockUserAccountServiceClient_RevokeAccountPermission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetAccountOnboardingConfig provides a mock function with given fields: _a0, _a1
 func (_m *MockUserAccountServiceClient) SetAccountOnboardingConfig(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetAccountOnboardingConfigRequest]) (*connect.Response[mgmtv1alpha1.SetAccountOnboardingConfigResponse], error) {
 	ret := _m.Called(_a0, _a1)
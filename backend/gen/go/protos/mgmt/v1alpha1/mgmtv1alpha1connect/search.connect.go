@@ -0,0 +1,115 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: mgmt/v1alpha1/search.proto
+
+package mgmtv1alpha1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// SearchServiceName is the fully-qualified name of the SearchService service.
+	SearchServiceName = "mgmt.v1alpha1.SearchService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// SearchServiceSearchResourcesProcedure is the fully-qualified name of the SearchService's
+	// SearchResources RPC.
+	SearchServiceSearchResourcesProcedure = "/mgmt.v1alpha1.SearchService/SearchResources"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	searchServiceServiceDescriptor               = v1alpha1.File_mgmt_v1alpha1_search_proto.Services().ByName("SearchService")
+	searchServiceSearchResourcesMethodDescriptor = searchServiceServiceDescriptor.Methods().ByName("SearchResources")
+)
+
+// SearchServiceClient is a client for the mgmt.v1alpha1.SearchService service.
+type SearchServiceClient interface {
+	// Searches the account's jobs, connections, job runs, and transformers. Intended to back type-ahead search
+	SearchResources(context.Context, *connect.Request[v1alpha1.SearchResourcesRequest]) (*connect.Response[v1alpha1.SearchResourcesResponse], error)
+}
+
+// NewSearchServiceClient constructs a client for the mgmt.v1alpha1.SearchService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSearchServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SearchServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &searchServiceClient{
+		searchResources: connect.NewClient[v1alpha1.SearchResourcesRequest, v1alpha1.SearchResourcesResponse](
+			httpClient,
+			baseURL+SearchServiceSearchResourcesProcedure,
+			connect.WithSchema(searchServiceSearchResourcesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// searchServiceClient implements SearchServiceClient.
+type searchServiceClient struct {
+	searchResources *connect.Client[v1alpha1.SearchResourcesRequest, v1alpha1.SearchResourcesResponse]
+}
+
+// SearchResources calls mgmt.v1alpha1.SearchService.SearchResources.
+func (c *searchServiceClient) SearchResources(ctx context.Context, req *connect.Request[v1alpha1.SearchResourcesRequest]) (*connect.Response[v1alpha1.SearchResourcesResponse], error) {
+	return c.searchResources.CallUnary(ctx, req)
+}
+
+// SearchServiceHandler is an implementation of the mgmt.v1alpha1.SearchService service.
+type SearchServiceHandler interface {
+	// Searches the account's jobs, connections, job runs, and transformers. Intended to back type-ahead search
+	SearchResources(context.Context, *connect.Request[v1alpha1.SearchResourcesRequest]) (*connect.Response[v1alpha1.SearchResourcesResponse], error)
+}
+
+// NewSearchServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSearchServiceHandler(svc SearchServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	searchServiceSearchResourcesHandler := connect.NewUnaryHandler(
+		SearchServiceSearchResourcesProcedure,
+		svc.SearchResources,
+		connect.WithSchema(searchServiceSearchResourcesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/mgmt.v1alpha1.SearchService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SearchServiceSearchResourcesProcedure:
+			searchServiceSearchResourcesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSearchServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSearchServiceHandler struct{}
+
+func (UnimplementedSearchServiceHandler) SearchResources(context.Context, *connect.Request[v1alpha1.SearchResourcesRequest]) (*connect.Response[v1alpha1.SearchResourcesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.SearchService.SearchResources is not implemented"))
+}
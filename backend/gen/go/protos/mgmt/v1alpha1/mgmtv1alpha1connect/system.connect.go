@@ -0,0 +1,113 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: mgmt/v1alpha1/system.proto
+
+package mgmtv1alpha1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// SystemServiceName is the fully-qualified name of the SystemService service.
+	SystemServiceName = "mgmt.v1alpha1.SystemService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// SystemServiceGetSystemHealthProcedure is the fully-qualified name of the SystemService's
+	// GetSystemHealth RPC.
+	SystemServiceGetSystemHealthProcedure = "/mgmt.v1alpha1.SystemService/GetSystemHealth"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	systemServiceServiceDescriptor               = v1alpha1.File_mgmt_v1alpha1_system_proto.Services().ByName("SystemService")
+	systemServiceGetSystemHealthMethodDescriptor = systemServiceServiceDescriptor.Methods().ByName("GetSystemHealth")
+)
+
+// SystemServiceClient is a client for the mgmt.v1alpha1.SystemService service.
+type SystemServiceClient interface {
+	GetSystemHealth(context.Context, *connect.Request[v1alpha1.GetSystemHealthRequest]) (*connect.Response[v1alpha1.GetSystemHealthResponse], error)
+}
+
+// NewSystemServiceClient constructs a client for the mgmt.v1alpha1.SystemService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSystemServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SystemServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &systemServiceClient{
+		getSystemHealth: connect.NewClient[v1alpha1.GetSystemHealthRequest, v1alpha1.GetSystemHealthResponse](
+			httpClient,
+			baseURL+SystemServiceGetSystemHealthProcedure,
+			connect.WithSchema(systemServiceGetSystemHealthMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// systemServiceClient implements SystemServiceClient.
+type systemServiceClient struct {
+	getSystemHealth *connect.Client[v1alpha1.GetSystemHealthRequest, v1alpha1.GetSystemHealthResponse]
+}
+
+// GetSystemHealth calls mgmt.v1alpha1.SystemService.GetSystemHealth.
+func (c *systemServiceClient) GetSystemHealth(ctx context.Context, req *connect.Request[v1alpha1.GetSystemHealthRequest]) (*connect.Response[v1alpha1.GetSystemHealthResponse], error) {
+	return c.getSystemHealth.CallUnary(ctx, req)
+}
+
+// SystemServiceHandler is an implementation of the mgmt.v1alpha1.SystemService service.
+type SystemServiceHandler interface {
+	GetSystemHealth(context.Context, *connect.Request[v1alpha1.GetSystemHealthRequest]) (*connect.Response[v1alpha1.GetSystemHealthResponse], error)
+}
+
+// NewSystemServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSystemServiceHandler(svc SystemServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	systemServiceGetSystemHealthHandler := connect.NewUnaryHandler(
+		SystemServiceGetSystemHealthProcedure,
+		svc.GetSystemHealth,
+		connect.WithSchema(systemServiceGetSystemHealthMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/mgmt.v1alpha1.SystemService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SystemServiceGetSystemHealthProcedure:
+			systemServiceGetSystemHealthHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSystemServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSystemServiceHandler struct{}
+
+func (UnimplementedSystemServiceHandler) GetSystemHealth(context.Context, *connect.Request[v1alpha1.GetSystemHealthRequest]) (*connect.Response[v1alpha1.GetSystemHealthResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.SystemService.GetSystemHealth is not implemented"))
+}
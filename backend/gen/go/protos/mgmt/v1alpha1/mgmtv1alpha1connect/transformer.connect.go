@@ -57,27 +57,43 @@ const (
 	// TransformersServiceIsTransformerNameAvailableProcedure is the fully-qualified name of the
 	// TransformersService's IsTransformerNameAvailable RPC.
 	TransformersServiceIsTransformerNameAvailableProcedure = "/mgmt.v1alpha1.TransformersService/IsTransformerNameAvailable"
+	// TransformersServiceSetUserDefinedTransformerLabelsProcedure is the fully-qualified name of the
+	// TransformersService's SetUserDefinedTransformerLabels RPC.
+	TransformersServiceSetUserDefinedTransformerLabelsProcedure = "/mgmt.v1alpha1.TransformersService/SetUserDefinedTransformerLabels"
 	// TransformersServiceValidateUserJavascriptCodeProcedure is the fully-qualified name of the
 	// TransformersService's ValidateUserJavascriptCode RPC.
 	TransformersServiceValidateUserJavascriptCodeProcedure = "/mgmt.v1alpha1.TransformersService/ValidateUserJavascriptCode"
 	// TransformersServiceValidateUserRegexCodeProcedure is the fully-qualified name of the
 	// TransformersService's ValidateUserRegexCode RPC.
 	TransformersServiceValidateUserRegexCodeProcedure = "/mgmt.v1alpha1.TransformersService/ValidateUserRegexCode"
+	// TransformersServiceGetJavascriptLibrariesProcedure is the fully-qualified name of the
+	// TransformersService's GetJavascriptLibraries RPC.
+	TransformersServiceGetJavascriptLibrariesProcedure = "/mgmt.v1alpha1.TransformersService/GetJavascriptLibraries"
+	// TransformersServiceSetJavascriptLibraryProcedure is the fully-qualified name of the
+	// TransformersService's SetJavascriptLibrary RPC.
+	TransformersServiceSetJavascriptLibraryProcedure = "/mgmt.v1alpha1.TransformersService/SetJavascriptLibrary"
+	// TransformersServiceDeleteJavascriptLibraryProcedure is the fully-qualified name of the
+	// TransformersService's DeleteJavascriptLibrary RPC.
+	TransformersServiceDeleteJavascriptLibraryProcedure = "/mgmt.v1alpha1.TransformersService/DeleteJavascriptLibrary"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	transformersServiceServiceDescriptor                             = v1alpha1.File_mgmt_v1alpha1_transformer_proto.Services().ByName("TransformersService")
-	transformersServiceGetSystemTransformersMethodDescriptor         = transformersServiceServiceDescriptor.Methods().ByName("GetSystemTransformers")
-	transformersServiceGetSystemTransformerBySourceMethodDescriptor  = transformersServiceServiceDescriptor.Methods().ByName("GetSystemTransformerBySource")
-	transformersServiceGetUserDefinedTransformersMethodDescriptor    = transformersServiceServiceDescriptor.Methods().ByName("GetUserDefinedTransformers")
-	transformersServiceGetUserDefinedTransformerByIdMethodDescriptor = transformersServiceServiceDescriptor.Methods().ByName("GetUserDefinedTransformerById")
-	transformersServiceCreateUserDefinedTransformerMethodDescriptor  = transformersServiceServiceDescriptor.Methods().ByName("CreateUserDefinedTransformer")
-	transformersServiceDeleteUserDefinedTransformerMethodDescriptor  = transformersServiceServiceDescriptor.Methods().ByName("DeleteUserDefinedTransformer")
-	transformersServiceUpdateUserDefinedTransformerMethodDescriptor  = transformersServiceServiceDescriptor.Methods().ByName("UpdateUserDefinedTransformer")
-	transformersServiceIsTransformerNameAvailableMethodDescriptor    = transformersServiceServiceDescriptor.Methods().ByName("IsTransformerNameAvailable")
-	transformersServiceValidateUserJavascriptCodeMethodDescriptor    = transformersServiceServiceDescriptor.Methods().ByName("ValidateUserJavascriptCode")
-	transformersServiceValidateUserRegexCodeMethodDescriptor         = transformersServiceServiceDescriptor.Methods().ByName("ValidateUserRegexCode")
+	transformersServiceServiceDescriptor                               = v1alpha1.File_mgmt_v1alpha1_transformer_proto.Services().ByName("TransformersService")
+	transformersServiceGetSystemTransformersMethodDescriptor           = transformersServiceServiceDescriptor.Methods().ByName("GetSystemTransformers")
+	transformersServiceGetSystemTransformerBySourceMethodDescriptor    = transformersServiceServiceDescriptor.Methods().ByName("GetSystemTransformerBySource")
+	transformersServiceGetUserDefinedTransformersMethodDescriptor      = transformersServiceServiceDescriptor.Methods().ByName("GetUserDefinedTransformers")
+	transformersServiceGetUserDefinedTransformerByIdMethodDescriptor   = transformersServiceServiceDescriptor.Methods().ByName("GetUserDefinedTransformerById")
+	transformersServiceCreateUserDefinedTransformerMethodDescriptor    = transformersServiceServiceDescriptor.Methods().ByName("CreateUserDefinedTransformer")
+	transformersServiceDeleteUserDefinedTransformerMethodDescriptor    = transformersServiceServiceDescriptor.Methods().ByName("DeleteUserDefinedTransformer")
+	transformersServiceUpdateUserDefinedTransformerMethodDescriptor    = transformersServiceServiceDescriptor.Methods().ByName("UpdateUserDefinedTransformer")
+	transformersServiceIsTransformerNameAvailableMethodDescriptor      = transformersServiceServiceDescriptor.Methods().ByName("IsTransformerNameAvailable")
+	transformersServiceSetUserDefinedTransformerLabelsMethodDescriptor = transformersServiceServiceDescriptor.Methods().ByName("SetUserDefinedTransformerLabels")
+	transformersServiceValidateUserJavascriptCodeMethodDescriptor      = transformersServiceServiceDescriptor.Methods().ByName("ValidateUserJavascriptCode")
+	transformersServiceValidateUserRegexCodeMethodDescriptor           = transformersServiceServiceDescriptor.Methods().ByName("ValidateUserRegexCode")
+	transformersServiceGetJavascriptLibrariesMethodDescriptor          = transformersServiceServiceDescriptor.Methods().ByName("GetJavascriptLibraries")
+	transformersServiceSetJavascriptLibraryMethodDescriptor            = transformersServiceServiceDescriptor.Methods().ByName("SetJavascriptLibrary")
+	transformersServiceDeleteJavascriptLibraryMethodDescriptor         = transformersServiceServiceDescriptor.Methods().ByName("DeleteJavascriptLibrary")
 )
 
 // TransformersServiceClient is a client for the mgmt.v1alpha1.TransformersService service.
@@ -90,8 +106,15 @@ type TransformersServiceClient interface {
 	DeleteUserDefinedTransformer(context.Context, *connect.Request[v1alpha1.DeleteUserDefinedTransformerRequest]) (*connect.Response[v1alpha1.DeleteUserDefinedTransformerResponse], error)
 	UpdateUserDefinedTransformer(context.Context, *connect.Request[v1alpha1.UpdateUserDefinedTransformerRequest]) (*connect.Response[v1alpha1.UpdateUserDefinedTransformerResponse], error)
 	IsTransformerNameAvailable(context.Context, *connect.Request[v1alpha1.IsTransformerNameAvailableRequest]) (*connect.Response[v1alpha1.IsTransformerNameAvailableResponse], error)
+	SetUserDefinedTransformerLabels(context.Context, *connect.Request[v1alpha1.SetUserDefinedTransformerLabelsRequest]) (*connect.Response[v1alpha1.SetUserDefinedTransformerLabelsResponse], error)
 	ValidateUserJavascriptCode(context.Context, *connect.Request[v1alpha1.ValidateUserJavascriptCodeRequest]) (*connect.Response[v1alpha1.ValidateUserJavascriptCodeResponse], error)
 	ValidateUserRegexCode(context.Context, *connect.Request[v1alpha1.ValidateUserRegexCodeRequest]) (*connect.Response[v1alpha1.ValidateUserRegexCodeResponse], error)
+	// Returns the javascript libraries that the account's javascript transformers can import
+	GetJavascriptLibraries(context.Context, *connect.Request[v1alpha1.GetJavascriptLibrariesRequest]) (*connect.Response[v1alpha1.GetJavascriptLibrariesResponse], error)
+	// Creates a javascript library, or replaces the code of the library with the same name
+	SetJavascriptLibrary(context.Context, *connect.Request[v1alpha1.SetJavascriptLibraryRequest]) (*connect.Response[v1alpha1.SetJavascriptLibraryResponse], error)
+	// Deletes a javascript library. Transformers that import it fail until it is created again
+	DeleteJavascriptLibrary(context.Context, *connect.Request[v1alpha1.DeleteJavascriptLibraryRequest]) (*connect.Response[v1alpha1.DeleteJavascriptLibraryResponse], error)
 }
 
 // NewTransformersServiceClient constructs a client for the mgmt.v1alpha1.TransformersService
@@ -152,6 +175,12 @@ func NewTransformersServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(transformersServiceIsTransformerNameAvailableMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		setUserDefinedTransformerLabels: connect.NewClient[v1alpha1.SetUserDefinedTransformerLabelsRequest, v1alpha1.SetUserDefinedTransformerLabelsResponse](
+			httpClient,
+			baseURL+TransformersServiceSetUserDefinedTransformerLabelsProcedure,
+			connect.WithSchema(transformersServiceSetUserDefinedTransformerLabelsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		validateUserJavascriptCode: connect.NewClient[v1alpha1.ValidateUserJavascriptCodeRequest, v1alpha1.ValidateUserJavascriptCodeResponse](
 			httpClient,
 			baseURL+TransformersServiceValidateUserJavascriptCodeProcedure,
@@ -164,21 +193,43 @@ func NewTransformersServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(transformersServiceValidateUserRegexCodeMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getJavascriptLibraries: connect.NewClient[v1alpha1.GetJavascriptLibrariesRequest, v1alpha1.GetJavascriptLibrariesResponse](
+			httpClient,
+			baseURL+TransformersServiceGetJavascriptLibrariesProcedure,
+			connect.WithSchema(transformersServiceGetJavascriptLibrariesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setJavascriptLibrary: connect.NewClient[v1alpha1.SetJavascriptLibraryRequest, v1alpha1.SetJavascriptLibraryResponse](
+			httpClient,
+			baseURL+TransformersServiceSetJavascriptLibraryProcedure,
+			connect.WithSchema(transformersServiceSetJavascriptLibraryMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteJavascriptLibrary: connect.NewClient[v1alpha1.DeleteJavascriptLibraryRequest, v1alpha1.DeleteJavascriptLibraryResponse](
+			httpClient,
+			baseURL+TransformersServiceDeleteJavascriptLibraryProcedure,
+			connect.WithSchema(transformersServiceDeleteJavascriptLibraryMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // transformersServiceClient implements TransformersServiceClient.
 type transformersServiceClient struct {
-	getSystemTransformers         *connect.Client[v1alpha1.GetSystemTransformersRequest, v1alpha1.GetSystemTransformersResponse]
-	getSystemTransformerBySource  *connect.Client[v1alpha1.GetSystemTransformerBySourceRequest, v1alpha1.GetSystemTransformerBySourceResponse]
-	getUserDefinedTransformers    *connect.Client[v1alpha1.GetUserDefinedTransformersRequest, v1alpha1.GetUserDefinedTransformersResponse]
-	getUserDefinedTransformerById *connect.Client[v1alpha1.GetUserDefinedTransformerByIdRequest, v1alpha1.GetUserDefinedTransformerByIdResponse]
-	createUserDefinedTransformer  *connect.Client[v1alpha1.CreateUserDefinedTransformerRequest, v1alpha1.CreateUserDefinedTransformerResponse]
-	deleteUserDefinedTransformer  *connect.Client[v1alpha1.DeleteUserDefinedTransformerRequest, v1alpha1.DeleteUserDefinedTransformerResponse]
-	updateUserDefinedTransformer  *connect.Client[v1alpha1.UpdateUserDefinedTransformerRequest, v1alpha1.UpdateUserDefinedTransformerResponse]
-	isTransformerNameAvailable    *connect.Client[v1alpha1.IsTransformerNameAvailableRequest, v1alpha1.IsTransformerNameAvailableResponse]
-	validateUserJavascriptCode    *connect.Client[v1alpha1.ValidateUserJavascriptCodeRequest, v1alpha1.ValidateUserJavascriptCodeResponse]
-	validateUserRegexCode         *connect.Client[v1alpha1.ValidateUserRegexCodeRequest, v1alpha1.ValidateUserRegexCodeResponse]
+	getSystemTransformers           *connect.Client[v1alpha1.GetSystemTransformersRequest, v1alpha1.GetSystemTransformersResponse]
+	getSystemTransformerBySource    *connect.Client[v1alpha1.GetSystemTransformerBySourceRequest, v1alpha1.GetSystemTransformerBySourceResponse]
+	getUserDefinedTransformers      *connect.Client[v1alpha1.GetUserDefinedTransformersRequest, v1alpha1.GetUserDefinedTransformersResponse]
+	getUserDefinedTransformerById   *connect.Client[v1alpha1.GetUserDefinedTransformerByIdRequest, v1alpha1.GetUserDefinedTransformerByIdResponse]
+	createUserDefinedTransformer    *connect.Client[v1alpha1.CreateUserDefinedTransformerRequest, v1alpha1.CreateUserDefinedTransformerResponse]
+	deleteUserDefinedTransformer    *connect.Client[v1alpha1.DeleteUserDefinedTransformerRequest, v1alpha1.DeleteUserDefinedTransformerResponse]
+	updateUserDefinedTransformer    *connect.Client[v1alpha1.UpdateUserDefinedTransformerRequest, v1alpha1.UpdateUserDefinedTransformerResponse]
+	isTransformerNameAvailable      *connect.Client[v1alpha1.IsTransformerNameAvailableRequest, v1alpha1.IsTransformerNameAvailableResponse]
+	setUserDefinedTransformerLabels *connect.Client[v1alpha1.SetUserDefinedTransformerLabelsRequest, v1alpha1.SetUserDefinedTransformerLabelsResponse]
+	validateUserJavascriptCode      *connect.Client[v1alpha1.ValidateUserJavascriptCodeRequest, v1alpha1.ValidateUserJavascriptCodeResponse]
+	validateUserRegexCode           *connect.Client[v1alpha1.ValidateUserRegexCodeRequest, v1alpha1.ValidateUserRegexCodeResponse]
+	getJavascriptLibraries          *connect.Client[v1alpha1.GetJavascriptLibrariesRequest, v1alpha1.GetJavascriptLibrariesResponse]
+	setJavascriptLibrary            *connect.Client[v1alpha1.SetJavascriptLibraryRequest, v1alpha1.SetJavascriptLibraryResponse]
+	deleteJavascriptLibrary         *connect.Client[v1alpha1.DeleteJavascriptLibraryRequest, v1alpha1.DeleteJavascriptLibraryResponse]
 }
 
 // GetSystemTransformers calls mgmt.v1alpha1.TransformersService.GetSystemTransformers.
@@ -226,6 +277,12 @@ func (c *transformersServiceClient) IsTransformerNameAvailable(ctx context.Conte
 	return c.isTransformerNameAvailable.CallUnary(ctx, req)
 }
 
+// SetUserDefinedTransformerLabels calls
+// mgmt.v1alpha1.TransformersService.SetUserDefinedTransformerLabels.
+func (c *transformersServiceClient) SetUserDefinedTransformerLabels(ctx context.Context, req *connect.Request[v1alpha1.SetUserDefinedTransformerLabelsRequest]) (*connect.Response[v1alpha1.SetUserDefinedTransformerLabelsResponse], error) {
+	return c.setUserDefinedTransformerLabels.CallUnary(ctx, req)
+}
+
 // ValidateUserJavascriptCode calls mgmt.v1alpha1.TransformersService.ValidateUserJavascriptCode.
 func (c *transformersServiceClient) ValidateUserJavascriptCode(ctx context.Context, req *connect.Request[v1alpha1.ValidateUserJavascriptCodeRequest]) (*connect.Response[v1alpha1.ValidateUserJavascriptCodeResponse], error) {
 	return c.validateUserJavascriptCode.CallUnary(ctx, req)
@@ -236,6 +293,21 @@ func (c *transformersServiceClient) ValidateUserRegexCode(ctx context.Context, r
 	return c.validateUserRegexCode.CallUnary(ctx, req)
 }
 
+// GetJavascriptLibraries calls mgmt.v1alpha1.TransformersService.GetJavascriptLibraries.
+func (c *transformersServiceClient) GetJavascriptLibraries(ctx context.Context, req *connect.Request[v1alpha1.GetJavascriptLibrariesRequest]) (*connect.Response[v1alpha1.GetJavascriptLibrariesResponse], error) {
+	return c.getJavascriptLibraries.CallUnary(ctx, req)
+}
+
+// SetJavascriptLibrary calls mgmt.v1alpha1.TransformersService.SetJavascriptLibrary.
+func (c *transformersServiceClient) SetJavascriptLibrary(ctx context.Context, req *connect.Request[v1alpha1.SetJavascriptLibraryRequest]) (*connect.Response[v1alpha1.SetJavascriptLibraryResponse], error) {
+	return c.setJavascriptLibrary.CallUnary(ctx, req)
+}
+
+// DeleteJavascriptLibrary calls mgmt.v1alpha1.TransformersService.DeleteJavascriptLibrary.
+func (c *transformersServiceClient) DeleteJavascriptLibrary(ctx context.Context, req *connect.Request[v1alpha1.DeleteJavascriptLibraryRequest]) (*connect.Response[v1alpha1.DeleteJavascriptLibraryResponse], error) {
+	return c.deleteJavascriptLibrary.CallUnary(ctx, req)
+}
+
 // TransformersServiceHandler is an implementation of the mgmt.v1alpha1.TransformersService service.
 type TransformersServiceHandler interface {
 	GetSystemTransformers(context.Context, *connect.Request[v1alpha1.GetSystemTransformersRequest]) (*connect.Response[v1alpha1.GetSystemTransformersResponse], error)
@@ -246,8 +318,15 @@ type TransformersServiceHandler interface {
 	DeleteUserDefinedTransformer(context.Context, *connect.Request[v1alpha1.DeleteUserDefinedTransformerRequest]) (*connect.Response[v1alpha1.DeleteUserDefinedTransformerResponse], error)
 	UpdateUserDefinedTransformer(context.Context, *connect.Request[v1alpha1.UpdateUserDefinedTransformerRequest]) (*connect.Response[v1alpha1.UpdateUserDefinedTransformerResponse], error)
 	IsTransformerNameAvailable(context.Context, *connect.Request[v1alpha1.IsTransformerNameAvailableRequest]) (*connect.Response[v1alpha1.IsTransformerNameAvailableResponse], error)
+	SetUserDefinedTransformerLabels(context.Context, *connect.Request[v1alpha1.SetUserDefinedTransformerLabelsRequest]) (*connect.Response[v1alpha1.SetUserDefinedTransformerLabelsResponse], error)
 	ValidateUserJavascriptCode(context.Context, *connect.Request[v1alpha1.ValidateUserJavascriptCodeRequest]) (*connect.Response[v1alpha1.ValidateUserJavascriptCodeResponse], error)
 	ValidateUserRegexCode(context.Context, *connect.Request[v1alpha1.ValidateUserRegexCodeRequest]) (*connect.Response[v1alpha1.ValidateUserRegexCodeResponse], error)
+	// Returns the javascript libraries that the account's javascript transformers can import
+	GetJavascriptLibraries(context.Context, *connect.Request[v1alpha1.GetJavascriptLibrariesRequest]) (*connect.Response[v1alpha1.GetJavascriptLibrariesResponse], error)
+	// Creates a javascript library, or replaces the code of the library with the same name
+	SetJavascriptLibrary(context.Context, *connect.Request[v1alpha1.SetJavascriptLibraryRequest]) (*connect.Response[v1alpha1.SetJavascriptLibraryResponse], error)
+	// Deletes a javascript library. Transformers that import it fail until it is created again
+	DeleteJavascriptLibrary(context.Context, *connect.Request[v1alpha1.DeleteJavascriptLibraryRequest]) (*connect.Response[v1alpha1.DeleteJavascriptLibraryResponse], error)
 }
 
 // NewTransformersServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -304,6 +383,12 @@ func NewTransformersServiceHandler(svc TransformersServiceHandler, opts ...conne
 		connect.WithSchema(transformersServiceIsTransformerNameAvailableMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	transformersServiceSetUserDefinedTransformerLabelsHandler := connect.NewUnaryHandler(
+		TransformersServiceSetUserDefinedTransformerLabelsProcedure,
+		svc.SetUserDefinedTransformerLabels,
+		connect.WithSchema(transformersServiceSetUserDefinedTransformerLabelsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	transformersServiceValidateUserJavascriptCodeHandler := connect.NewUnaryHandler(
 		TransformersServiceValidateUserJavascriptCodeProcedure,
 		svc.ValidateUserJavascriptCode,
@@ -316,6 +401,24 @@ func NewTransformersServiceHandler(svc TransformersServiceHandler, opts ...conne
 		connect.WithSchema(transformersServiceValidateUserRegexCodeMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	transformersServiceGetJavascriptLibrariesHandler := connect.NewUnaryHandler(
+		TransformersServiceGetJavascriptLibrariesProcedure,
+		svc.GetJavascriptLibraries,
+		connect.WithSchema(transformersServiceGetJavascriptLibrariesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	transformersServiceSetJavascriptLibraryHandler := connect.NewUnaryHandler(
+		TransformersServiceSetJavascriptLibraryProcedure,
+		svc.SetJavascriptLibrary,
+		connect.WithSchema(transformersServiceSetJavascriptLibraryMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	transformersServiceDeleteJavascriptLibraryHandler := connect.NewUnaryHandler(
+		TransformersServiceDeleteJavascriptLibraryProcedure,
+		svc.DeleteJavascriptLibrary,
+		connect.WithSchema(transformersServiceDeleteJavascriptLibraryMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mgmt.v1alpha1.TransformersService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TransformersServiceGetSystemTransformersProcedure:
@@ -334,10 +437,18 @@ func NewTransformersServiceHandler(svc TransformersServiceHandler, opts ...conne
 			transformersServiceUpdateUserDefinedTransformerHandler.ServeHTTP(w, r)
 		case TransformersServiceIsTransformerNameAvailableProcedure:
 			transformersServiceIsTransformerNameAvailableHandler.ServeHTTP(w, r)
+		case TransformersServiceSetUserDefinedTransformerLabelsProcedure:
+			transformersServiceSetUserDefinedTransformerLabelsHandler.ServeHTTP(w, r)
 		case TransformersServiceValidateUserJavascriptCodeProcedure:
 			transformersServiceValidateUserJavascriptCodeHandler.ServeHTTP(w, r)
 		case TransformersServiceValidateUserRegexCodeProcedure:
 			transformersServiceValidateUserRegexCodeHandler.ServeHTTP(w, r)
+		case TransformersServiceGetJavascriptLibrariesProcedure:
+			transformersServiceGetJavascriptLibrariesHandler.ServeHTTP(w, r)
+		case TransformersServiceSetJavascriptLibraryProcedure:
+			transformersServiceSetJavascriptLibraryHandler.ServeHTTP(w, r)
+		case TransformersServiceDeleteJavascriptLibraryProcedure:
+			transformersServiceDeleteJavascriptLibraryHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -379,6 +490,10 @@ func (UnimplementedTransformersServiceHandler) IsTransformerNameAvailable(contex
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.TransformersService.IsTransformerNameAvailable is not implemented"))
 }
 
+func (UnimplementedTransformersServiceHandler) SetUserDefinedTransformerLabels(context.Context, *connect.Request[v1alpha1.SetUserDefinedTransformerLabelsRequest]) (*connect.Response[v1alpha1.SetUserDefinedTransformerLabelsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.TransformersService.SetUserDefinedTransformerLabels is not implemented"))
+}
+
 func (UnimplementedTransformersServiceHandler) ValidateUserJavascriptCode(context.Context, *connect.Request[v1alpha1.ValidateUserJavascriptCodeRequest]) (*connect.Response[v1alpha1.ValidateUserJavascriptCodeResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.TransformersService.ValidateUserJavascriptCode is not implemented"))
 }
@@ -386,3 +501,15 @@ func (UnimplementedTransformersServiceHandler) ValidateUserJavascriptCode(contex
 func (UnimplementedTransformersServiceHandler) ValidateUserRegexCode(context.Context, *connect.Request[v1alpha1.ValidateUserRegexCodeRequest]) (*connect.Response[v1alpha1.ValidateUserRegexCodeResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.TransformersService.ValidateUserRegexCode is not implemented"))
 }
+
+func (UnimplementedTransformersServiceHandler) GetJavascriptLibraries(context.Context, *connect.Request[v1alpha1.GetJavascriptLibrariesRequest]) (*connect.Response[v1alpha1.GetJavascriptLibrariesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.TransformersService.GetJavascriptLibraries is not implemented"))
+}
+
+func (UnimplementedTransformersServiceHandler) SetJavascriptLibrary(context.Context, *connect.Request[v1alpha1.SetJavascriptLibraryRequest]) (*connect.Response[v1alpha1.SetJavascriptLibraryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.TransformersService.SetJavascriptLibrary is not implemented"))
+}
+
+func (UnimplementedTransformersServiceHandler) DeleteJavascriptLibrary(context.Context, *connect.Request[v1alpha1.DeleteJavascriptLibraryRequest]) (*connect.Response[v1alpha1.DeleteJavascriptLibraryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.TransformersService.DeleteJavascriptLibrary is not implemented"))
+}
@@ -54,6 +54,12 @@ const (
 	// UserAccountServiceIsUserInAccountProcedure is the fully-qualified name of the
 	// UserAccountService's IsUserInAccount RPC.
 	UserAccountServiceIsUserInAccountProcedure = "/mgmt.v1alpha1.UserAccountService/IsUserInAccount"
+	// UserAccountServiceGrantAccountPermissionProcedure is the fully-qualified name of the
+	// UserAccountService's GrantAccountPermission RPC.
+	UserAccountServiceGrantAccountPermissionProcedure = "/mgmt.v1alpha1.UserAccountService/GrantAccountPermission"
+	// UserAccountServiceRevokeAccountPermissionProcedure is the fully-qualified name of the
+	// UserAccountService's RevokeAccountPermission RPC.
+	UserAccountServiceRevokeAccountPermissionProcedure = "/mgmt.v1alpha1.UserAccountService/RevokeAccountPermission"
 	// UserAccountServiceGetAccountTemporalConfigProcedure is the fully-qualified name of the
 	// UserAccountService's GetAccountTemporalConfig RPC.
 	UserAccountServiceGetAccountTemporalConfigProcedure = "/mgmt.v1alpha1.UserAccountService/GetAccountTemporalConfig"
@@ -99,6 +105,8 @@ var (
 	userAccountServiceConvertPersonalToTeamAccountMethodDescriptor = userAccountServiceServiceDescriptor.Methods().ByName("ConvertPersonalToTeamAccount")
 	userAccountServiceCreateTeamAccountMethodDescriptor            = userAccountServiceServiceDescriptor.Methods().ByName("CreateTeamAccount")
 	userAccountServiceIsUserInAccountMethodDescriptor              = userAccountServiceServiceDescriptor.Methods().ByName("IsUserInAccount")
+	userAccountServiceGrantAccountPermissionMethodDescriptor       = userAccountServiceServiceDescriptor.Methods().ByName("GrantAccountPermission")
+	userAccountServiceRevokeAccountPermissionMethodDescriptor      = userAccountServiceServiceDescriptor.Methods().ByName("RevokeAccountPermission")
 	userAccountServiceGetAccountTemporalConfigMethodDescriptor     = userAccountServiceServiceDescriptor.Methods().ByName("GetAccountTemporalConfig")
 	userAccountServiceSetAccountTemporalConfigMethodDescriptor     = userAccountServiceServiceDescriptor.Methods().ByName("SetAccountTemporalConfig")
 	userAccountServiceGetTeamAccountMembersMethodDescriptor        = userAccountServiceServiceDescriptor.Methods().ByName("GetTeamAccountMembers")
@@ -121,6 +129,11 @@ type UserAccountServiceClient interface {
 	ConvertPersonalToTeamAccount(context.Context, *connect.Request[v1alpha1.ConvertPersonalToTeamAccountRequest]) (*connect.Response[v1alpha1.ConvertPersonalToTeamAccountResponse], error)
 	CreateTeamAccount(context.Context, *connect.Request[v1alpha1.CreateTeamAccountRequest]) (*connect.Response[v1alpha1.CreateTeamAccountResponse], error)
 	IsUserInAccount(context.Context, *connect.Request[v1alpha1.IsUserInAccountRequest]) (*connect.Response[v1alpha1.IsUserInAccountResponse], error)
+	// Grants an account permission to a member of the account. Only users that hold the permission may grant it,
+	// except for its first grant in the account, which any member may make
+	GrantAccountPermission(context.Context, *connect.Request[v1alpha1.GrantAccountPermissionRequest]) (*connect.Response[v1alpha1.GrantAccountPermissionResponse], error)
+	// Revokes an account permission from a member of the account. Only users that hold the permission may revoke it
+	RevokeAccountPermission(context.Context, *connect.Request[v1alpha1.RevokeAccountPermissionRequest]) (*connect.Response[v1alpha1.RevokeAccountPermissionResponse], error)
 	GetAccountTemporalConfig(context.Context, *connect.Request[v1alpha1.GetAccountTemporalConfigRequest]) (*connect.Response[v1alpha1.GetAccountTemporalConfigResponse], error)
 	SetAccountTemporalConfig(context.Context, *connect.Request[v1alpha1.SetAccountTemporalConfigRequest]) (*connect.Response[v1alpha1.SetAccountTemporalConfigResponse], error)
 	GetTeamAccountMembers(context.Context, *connect.Request[v1alpha1.GetTeamAccountMembersRequest]) (*connect.Response[v1alpha1.GetTeamAccountMembersResponse], error)
@@ -186,6 +199,18 @@ func NewUserAccountServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(userAccountServiceIsUserInAccountMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		grantAccountPermission: connect.NewClient[v1alpha1.GrantAccountPermissionRequest, v1alpha1.GrantAccountPermissionResponse](
+			httpClient,
+			baseURL+UserAccountServiceGrantAccountPermissionProcedure,
+			connect.WithSchema(userAccountServiceGrantAccountPermissionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		revokeAccountPermission: connect.NewClient[v1alpha1.RevokeAccountPermissionRequest, v1alpha1.RevokeAccountPermissionResponse](
+			httpClient,
+			baseURL+UserAccountServiceRevokeAccountPermissionProcedure,
+			connect.WithSchema(userAccountServiceRevokeAccountPermissionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getAccountTemporalConfig: connect.NewClient[v1alpha1.GetAccountTemporalConfigRequest, v1alpha1.GetAccountTemporalConfigResponse](
 			httpClient,
 			baseURL+UserAccountServiceGetAccountTemporalConfigProcedure,
@@ -264,6 +289,8 @@ type userAccountServiceClient struct {
 	convertPersonalToTeamAccount *connect.Client[v1alpha1.ConvertPersonalToTeamAccountRequest, v1alpha1.ConvertPersonalToTeamAccountResponse]
 	createTeamAccount            *connect.Client[v1alpha1.CreateTeamAccountRequest, v1alpha1.CreateTeamAccountResponse]
 	isUserInAccount              *connect.Client[v1alpha1.IsUserInAccountRequest, v1alpha1.IsUserInAccountResponse]
+	grantAccountPermission       *connect.Client[v1alpha1.GrantAccountPermissionRequest, v1alpha1.GrantAccountPermissionResponse]
+	revokeAccountPermission      *connect.Client[v1alpha1.RevokeAccountPermissionRequest, v1alpha1.RevokeAccountPermissionResponse]
 	getAccountTemporalConfig     *connect.Client[v1alpha1.GetAccountTemporalConfigRequest, v1alpha1.GetAccountTemporalConfigResponse]
 	setAccountTemporalConfig     *connect.Client[v1alpha1.SetAccountTemporalConfigRequest, v1alpha1.SetAccountTemporalConfigResponse]
 	getTeamAccountMembers        *connect.Client[v1alpha1.GetTeamAccountMembersRequest, v1alpha1.GetTeamAccountMembersResponse]
@@ -312,6 +339,16 @@ func (c *userAccountServiceClient) IsUserInAccount(ctx context.Context, req *con
 	return c.isUserInAccount.CallUnary(ctx, req)
 }
 
+// GrantAccountPermission calls mgmt.v1alpha1.UserAccountService.GrantAccountPermission.
+func (c *userAccountServiceClient) GrantAccountPermission(ctx context.Context, req *connect.Request[v1alpha1.GrantAccountPermissionRequest]) (*connect.Response[v1alpha1.GrantAccountPermissionResponse], error) {
+	return c.grantAccountPermission.CallUnary(ctx, req)
+}
+
+// RevokeAccountPermission calls mgmt.v1alpha1.UserAccountService.RevokeAccountPermission.
+func (c *userAccountServiceClient) RevokeAccountPermission(ctx context.Context, req *connect.Request[v1alpha1.RevokeAccountPermissionRequest]) (*connect.Response[v1alpha1.RevokeAccountPermissionResponse], error) {
+	return c.revokeAccountPermission.CallUnary(ctx, req)
+}
+
 // GetAccountTemporalConfig calls mgmt.v1alpha1.UserAccountService.GetAccountTemporalConfig.
 func (c *userAccountServiceClient) GetAccountTemporalConfig(ctx context.Context, req *connect.Request[v1alpha1.GetAccountTemporalConfigRequest]) (*connect.Response[v1alpha1.GetAccountTemporalConfigResponse], error) {
 	return c.getAccountTemporalConfig.CallUnary(ctx, req)
@@ -376,6 +413,11 @@ type UserAccountServiceHandler interface {
 	ConvertPersonalToTeamAccount(context.Context, *connect.Request[v1alpha1.ConvertPersonalToTeamAccountRequest]) (*connect.Response[v1alpha1.ConvertPersonalToTeamAccountResponse], error)
 	CreateTeamAccount(context.Context, *connect.Request[v1alpha1.CreateTeamAccountRequest]) (*connect.Response[v1alpha1.CreateTeamAccountResponse], error)
 	IsUserInAccount(context.Context, *connect.Request[v1alpha1.IsUserInAccountRequest]) (*connect.Response[v1alpha1.IsUserInAccountResponse], error)
+	// Grants an account permission to a member of the account. Only users that hold the permission may grant it,
+	// except for its first grant in the account, which any member may make
+	GrantAccountPermission(context.Context, *connect.Request[v1alpha1.GrantAccountPermissionRequest]) (*connect.Response[v1alpha1.GrantAccountPermissionResponse], error)
+	// Revokes an account permission from a member of the account. Only users that hold the permission may revoke it
+	RevokeAccountPermission(context.Context, *connect.Request[v1alpha1.RevokeAccountPermissionRequest]) (*connect.Response[v1alpha1.RevokeAccountPermissionResponse], error)
 	GetAccountTemporalConfig(context.Context, *connect.Request[v1alpha1.GetAccountTemporalConfigRequest]) (*connect.Response[v1alpha1.GetAccountTemporalConfigResponse], error)
 	SetAccountTemporalConfig(context.Context, *connect.Request[v1alpha1.SetAccountTemporalConfigRequest]) (*connect.Response[v1alpha1.SetAccountTemporalConfigResponse], error)
 	GetTeamAccountMembers(context.Context, *connect.Request[v1alpha1.GetTeamAccountMembersRequest]) (*connect.Response[v1alpha1.GetTeamAccountMembersResponse], error)
@@ -437,6 +479,18 @@ func NewUserAccountServiceHandler(svc UserAccountServiceHandler, opts ...connect
 		connect.WithSchema(userAccountServiceIsUserInAccountMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	userAccountServiceGrantAccountPermissionHandler := connect.NewUnaryHandler(
+		UserAccountServiceGrantAccountPermissionProcedure,
+		svc.GrantAccountPermission,
+		connect.WithSchema(userAccountServiceGrantAccountPermissionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	userAccountServiceRevokeAccountPermissionHandler := connect.NewUnaryHandler(
+		UserAccountServiceRevokeAccountPermissionProcedure,
+		svc.RevokeAccountPermission,
+		connect.WithSchema(userAccountServiceRevokeAccountPermissionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	userAccountServiceGetAccountTemporalConfigHandler := connect.NewUnaryHandler(
 		UserAccountServiceGetAccountTemporalConfigProcedure,
 		svc.GetAccountTemporalConfig,
@@ -519,6 +573,10 @@ func NewUserAccountServiceHandler(svc UserAccountServiceHandler, opts ...connect
 			userAccountServiceCreateTeamAccountHandler.ServeHTTP(w, r)
 		case UserAccountServiceIsUserInAccountProcedure:
 			userAccountServiceIsUserInAccountHandler.ServeHTTP(w, r)
+		case UserAccountServiceGrantAccountPermissionProcedure:
+			userAccountServiceGrantAccountPermissionHandler.ServeHTTP(w, r)
+		case UserAccountServiceRevokeAccountPermissionProcedure:
+			userAccountServiceRevokeAccountPermissionHandler.ServeHTTP(w, r)
 		case UserAccountServiceGetAccountTemporalConfigProcedure:
 			userAccountServiceGetAccountTemporalConfigHandler.ServeHTTP(w, r)
 		case UserAccountServiceSetAccountTemporalConfigProcedure:
@@ -578,6 +636,14 @@ func (UnimplementedUserAccountServiceHandler) IsUserInAccount(context.Context, *
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.UserAccountService.IsUserInAccount is not implemented"))
 }
 
+func (UnimplementedUserAccountServiceHandler) GrantAccountPermission(context.Context, *connect.Request[v1alpha1.GrantAccountPermissionRequest]) (*connect.Response[v1alpha1.GrantAccountPermissionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.UserAccountService.GrantAccountPermission is not implemented"))
+}
+
+func (UnimplementedUserAccountServiceHandler) RevokeAccountPermission(context.Context, *connect.Request[v1alpha1.RevokeAccountPermissionRequest]) (*connect.Response[v1alpha1.RevokeAccountPermissionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.UserAccountService.RevokeAccountPermission is not implemented"))
+}
+
 func (UnimplementedUserAccountServiceHandler) GetAccountTemporalConfig(context.Context, *connect.Request[v1alpha1.GetAccountTemporalConfigRequest]) (*connect.Response[v1alpha1.GetAccountTemporalConfigResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.UserAccountService.GetAccountTemporalConfig is not implemented"))
 }
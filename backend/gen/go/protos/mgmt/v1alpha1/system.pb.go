@@ -0,0 +1,632 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        (unknown)
+// source: mgmt/v1alpha1/system.proto
+
+package mgmtv1alpha1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SystemHealthStatus int32
+
+const (
+	SystemHealthStatus_SYSTEM_HEALTH_STATUS_UNSPECIFIED SystemHealthStatus = 0
+	SystemHealthStatus_SYSTEM_HEALTH_STATUS_HEALTHY     SystemHealthStatus = 1
+	// The platform is usable but something needs attention, such as migrations that have not been applied
+	SystemHealthStatus_SYSTEM_HEALTH_STATUS_DEGRADED  SystemHealthStatus = 2
+	SystemHealthStatus_SYSTEM_HEALTH_STATUS_UNHEALTHY SystemHealthStatus = 3
+)
+
+// Enum value maps for SystemHealthStatus.
+var (
+	SystemHealthStatus_name = map[int32]string{
+		0: "SYSTEM_HEALTH_STATUS_UNSPECIFIED",
+		1: "SYSTEM_HEALTH_STATUS_HEALTHY",
+		2: "SYSTEM_HEALTH_STATUS_DEGRADED",
+		3: "SYSTEM_HEALTH_STATUS_UNHEALTHY",
+	}
+	SystemHealthStatus_value = map[string]int32{
+		"SYSTEM_HEALTH_STATUS_UNSPECIFIED": 0,
+		"SYSTEM_HEALTH_STATUS_HEALTHY":     1,
+		"SYSTEM_HEALTH_STATUS_DEGRADED":    2,
+		"SYSTEM_HEALTH_STATUS_UNHEALTHY":   3,
+	}
+)
+
+func (x SystemHealthStatus) Enum() *SystemHealthStatus {
+	p := new(SystemHealthStatus)
+	*p = x
+	return p
+}
+
+func (x SystemHealthStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SystemHealthStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_mgmt_v1alpha1_system_proto_enumTypes[0].Descriptor()
+}
+
+func (SystemHealthStatus) Type() protoreflect.EnumType {
+	return &file_mgmt_v1alpha1_system_proto_enumTypes[0]
+}
+
+func (x SystemHealthStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SystemHealthStatus.Descriptor instead.
+func (SystemHealthStatus) EnumDescriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_system_proto_rawDescGZIP(), []int{0}
+}
+
+type GetSystemHealthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetSystemHealthRequest) Reset() {
+	*x = GetSystemHealthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_system_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSystemHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemHealthRequest) ProtoMessage() {}
+
+func (x *GetSystemHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_system_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemHealthRequest.ProtoReflect.Descriptor instead.
+func (*GetSystemHealthRequest) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_system_proto_rawDescGZIP(), []int{0}
+}
+
+type GetSystemHealthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The worst status of all of the checks
+	Status SystemHealthStatus   `protobuf:"varint,1,opt,name=status,proto3,enum=mgmt.v1alpha1.SystemHealthStatus" json:"status,omitempty"`
+	Checks []*SystemHealthCheck `protobuf:"bytes,2,rep,name=checks,proto3" json:"checks,omitempty"`
+}
+
+func (x *GetSystemHealthResponse) Reset() {
+	*x = GetSystemHealthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_system_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSystemHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemHealthResponse) ProtoMessage() {}
+
+func (x *GetSystemHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_system_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemHealthResponse.ProtoReflect.Descriptor instead.
+func (*GetSystemHealthResponse) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_system_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetSystemHealthResponse) GetStatus() SystemHealthStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SystemHealthStatus_SYSTEM_HEALTH_STATUS_UNSPECIFIED
+}
+
+func (x *GetSystemHealthResponse) GetChecks() []*SystemHealthCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+type SystemHealthCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The component that was checked. One of database, temporal, worker or migrations
+	Name   string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status SystemHealthStatus `protobuf:"varint,2,opt,name=status,proto3,enum=mgmt.v1alpha1.SystemHealthStatus" json:"status,omitempty"`
+	// Describes why the component is not healthy
+	Message *string `protobuf:"bytes,3,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	// Types that are assignable to Details:
+	//
+	//	*SystemHealthCheck_Worker
+	//	*SystemHealthCheck_Migrations
+	Details isSystemHealthCheck_Details `protobuf_oneof:"details"`
+}
+
+func (x *SystemHealthCheck) Reset() {
+	*x = SystemHealthCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_system_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemHealthCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemHealthCheck) ProtoMessage() {}
+
+func (x *SystemHealthCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_system_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemHealthCheck.ProtoReflect.Descriptor instead.
+func (*SystemHealthCheck) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_system_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SystemHealthCheck) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemHealthCheck) GetStatus() SystemHealthStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SystemHealthStatus_SYSTEM_HEALTH_STATUS_UNSPECIFIED
+}
+
+func (x *SystemHealthCheck) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+func (m *SystemHealthCheck) GetDetails() isSystemHealthCheck_Details {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (x *SystemHealthCheck) GetWorker() *WorkerHealthDetails {
+	if x, ok := x.GetDetails().(*SystemHealthCheck_Worker); ok {
+		return x.Worker
+	}
+	return nil
+}
+
+func (x *SystemHealthCheck) GetMigrations() *MigrationHealthDetails {
+	if x, ok := x.GetDetails().(*SystemHealthCheck_Migrations); ok {
+		return x.Migrations
+	}
+	return nil
+}
+
+type isSystemHealthCheck_Details interface {
+	isSystemHealthCheck_Details()
+}
+
+type SystemHealthCheck_Worker struct {
+	Worker *WorkerHealthDetails `protobuf:"bytes,4,opt,name=worker,proto3,oneof"`
+}
+
+type SystemHealthCheck_Migrations struct {
+	Migrations *MigrationHealthDetails `protobuf:"bytes,5,opt,name=migrations,proto3,oneof"`
+}
+
+func (*SystemHealthCheck_Worker) isSystemHealthCheck_Details() {}
+
+func (*SystemHealthCheck_Migrations) isSystemHealthCheck_Details() {}
+
+type WorkerHealthDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The Temporal task queue that the worker polls
+	TaskQueue string `protobuf:"bytes,1,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	// The number of workers that have recently polled the task queue
+	PollerCount uint32 `protobuf:"varint,2,opt,name=poller_count,json=pollerCount,proto3" json:"poller_count,omitempty"`
+	// The last time any worker polled the task queue
+	LastHeartbeatAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_heartbeat_at,json=lastHeartbeatAt,proto3,oneof" json:"last_heartbeat_at,omitempty"`
+}
+
+func (x *WorkerHealthDetails) Reset() {
+	*x = WorkerHealthDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_system_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkerHealthDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerHealthDetails) ProtoMessage() {}
+
+func (x *WorkerHealthDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_system_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerHealthDetails.ProtoReflect.Descriptor instead.
+func (*WorkerHealthDetails) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_system_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WorkerHealthDetails) GetTaskQueue() string {
+	if x != nil {
+		return x.TaskQueue
+	}
+	return ""
+}
+
+func (x *WorkerHealthDetails) GetPollerCount() uint32 {
+	if x != nil {
+		return x.PollerCount
+	}
+	return 0
+}
+
+func (x *WorkerHealthDetails) GetLastHeartbeatAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastHeartbeatAt
+	}
+	return nil
+}
+
+type MigrationHealthDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The version of the last applied migration, not set if no migrations have been applied
+	CurrentVersion *uint64 `protobuf:"varint,1,opt,name=current_version,json=currentVersion,proto3,oneof" json:"current_version,omitempty"`
+	// The version of the newest migration in the schema directory
+	LatestVersion uint64 `protobuf:"varint,2,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	// True if a migration failed part way through and must be fixed by hand
+	Dirty bool `protobuf:"varint,3,opt,name=dirty,proto3" json:"dirty,omitempty"`
+}
+
+func (x *MigrationHealthDetails) Reset() {
+	*x = MigrationHealthDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_system_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MigrationHealthDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrationHealthDetails) ProtoMessage() {}
+
+func (x *MigrationHealthDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_system_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrationHealthDetails.ProtoReflect.Descriptor instead.
+func (*MigrationHealthDetails) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_system_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MigrationHealthDetails) GetCurrentVersion() uint64 {
+	if x != nil && x.CurrentVersion != nil {
+		return *x.CurrentVersion
+	}
+	return 0
+}
+
+func (x *MigrationHealthDetails) GetLatestVersion() uint64 {
+	if x != nil {
+		return x.LatestVersion
+	}
+	return 0
+}
+
+func (x *MigrationHealthDetails) GetDirty() bool {
+	if x != nil {
+		return x.Dirty
+	}
+	return false
+}
+
+var File_mgmt_v1alpha1_system_proto protoreflect.FileDescriptor
+
+var file_mgmt_v1alpha1_system_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6d, 0x67,
+	0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x18, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8e, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x38, 0x0a,
+	0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52,
+	0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x22, 0x9f, 0x02, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x21, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x06, 0x77,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x67,
+	0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x48,
+	0x00, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x0a, 0x6d, 0x69, 0x67,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x69,
+	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xba, 0x01, 0x0a, 0x13, 0x57, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x4b, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x72,
+	0x74, 0x62, 0x65, 0x61, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0f, 0x6c, 0x61,
+	0x73, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x41, 0x74, 0x88, 0x01, 0x01,
+	0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x5f, 0x61, 0x74, 0x22, 0x97, 0x01, 0x0a, 0x16, 0x4d, 0x69, 0x67, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x12, 0x2c, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x25, 0x0a, 0x0e, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x69, 0x72, 0x74, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x69, 0x72, 0x74, 0x79, 0x42, 0x12, 0x0a, 0x10,
+	0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x2a, 0xa3, 0x01, 0x0a, 0x12, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x59, 0x53, 0x54, 0x45,
+	0x4d, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a,
+	0x1c, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x01, 0x12,
+	0x21, 0x0a, 0x1d, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x47, 0x52, 0x41, 0x44, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x48, 0x45, 0x41,
+	0x4c, 0x54, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x48, 0x45, 0x41,
+	0x4c, 0x54, 0x48, 0x59, 0x10, 0x03, 0x32, 0x73, 0x0a, 0x0d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x62, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x25, 0x2e, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0xc7, 0x01, 0x0a, 0x11,
+	0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x42, 0x0b, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x50, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x75, 0x63,
+	0x6c, 0x65, 0x75, 0x73, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x6e, 0x65, 0x6f, 0x73, 0x79, 0x6e,
+	0x63, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x6d, 0x67, 0x6d, 0x74, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x58, 0x58, 0xaa, 0x02, 0x0d, 0x4d, 0x67, 0x6d, 0x74, 0x2e,
+	0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x0d, 0x4d, 0x67, 0x6d, 0x74, 0x5c,
+	0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2, 0x02, 0x19, 0x4d, 0x67, 0x6d, 0x74, 0x5c,
+	0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x4d, 0x67, 0x6d, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mgmt_v1alpha1_system_proto_rawDescOnce sync.Once
+	file_mgmt_v1alpha1_system_proto_rawDescData = file_mgmt_v1alpha1_system_proto_rawDesc
+)
+
+func file_mgmt_v1alpha1_system_proto_rawDescGZIP() []byte {
+	file_mgmt_v1alpha1_system_proto_rawDescOnce.Do(func() {
+		file_mgmt_v1alpha1_system_proto_rawDescData = protoimpl.X.CompressGZIP(file_mgmt_v1alpha1_system_proto_rawDescData)
+	})
+	return file_mgmt_v1alpha1_system_proto_rawDescData
+}
+
+var file_mgmt_v1alpha1_system_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_mgmt_v1alpha1_system_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_mgmt_v1alpha1_system_proto_goTypes = []interface{}{
+	(SystemHealthStatus)(0),         // 0: mgmt.v1alpha1.SystemHealthStatus
+	(*GetSystemHealthRequest)(nil),  // 1: mgmt.v1alpha1.GetSystemHealthRequest
+	(*GetSystemHealthResponse)(nil), // 2: mgmt.v1alpha1.GetSystemHealthResponse
+	(*SystemHealthCheck)(nil),       // 3: mgmt.v1alpha1.SystemHealthCheck
+	(*WorkerHealthDetails)(nil),     // 4: mgmt.v1alpha1.WorkerHealthDetails
+	(*MigrationHealthDetails)(nil),  // 5: mgmt.v1alpha1.MigrationHealthDetails
+	(*timestamppb.Timestamp)(nil),   // 6: google.protobuf.Timestamp
+}
+var file_mgmt_v1alpha1_system_proto_depIdxs = []int32{
+	0, // 0: mgmt.v1alpha1.GetSystemHealthResponse.status:type_name -> mgmt.v1alpha1.SystemHealthStatus
+	3, // 1: mgmt.v1alpha1.GetSystemHealthResponse.checks:type_name -> mgmt.v1alpha1.SystemHealthCheck
+	0, // 2: mgmt.v1alpha1.SystemHealthCheck.status:type_name -> mgmt.v1alpha1.SystemHealthStatus
+	4, // 3: mgmt.v1alpha1.SystemHealthCheck.worker:type_name -> mgmt.v1alpha1.WorkerHealthDetails
+	5, // 4: mgmt.v1alpha1.SystemHealthCheck.migrations:type_name -> mgmt.v1alpha1.MigrationHealthDetails
+	6, // 5: mgmt.v1alpha1.WorkerHealthDetails.last_heartbeat_at:type_name -> google.protobuf.Timestamp
+	1, // 6: mgmt.v1alpha1.SystemService.GetSystemHealth:input_type -> mgmt.v1alpha1.GetSystemHealthRequest
+	2, // 7: mgmt.v1alpha1.SystemService.GetSystemHealth:output_type -> mgmt.v1alpha1.GetSystemHealthResponse
+	7, // [7:8] is the sub-list for method output_type
+	6, // [6:7] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_mgmt_v1alpha1_system_proto_init() }
+func file_mgmt_v1alpha1_system_proto_init() {
+	if File_mgmt_v1alpha1_system_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_mgmt_v1alpha1_system_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSystemHealthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_system_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSystemHealthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_system_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemHealthCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_system_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkerHealthDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_system_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MigrationHealthDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_mgmt_v1alpha1_system_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*SystemHealthCheck_Worker)(nil),
+		(*SystemHealthCheck_Migrations)(nil),
+	}
+	file_mgmt_v1alpha1_system_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_mgmt_v1alpha1_system_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mgmt_v1alpha1_system_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_mgmt_v1alpha1_system_proto_goTypes,
+		DependencyIndexes: file_mgmt_v1alpha1_system_proto_depIdxs,
+		EnumInfos:         file_mgmt_v1alpha1_system_proto_enumTypes,
+		MessageInfos:      file_mgmt_v1alpha1_system_proto_msgTypes,
+	}.Build()
+	File_mgmt_v1alpha1_system_proto = out.File
+	file_mgmt_v1alpha1_system_proto_rawDesc = nil
+	file_mgmt_v1alpha1_system_proto_goTypes = nil
+	file_mgmt_v1alpha1_system_proto_depIdxs = nil
+}
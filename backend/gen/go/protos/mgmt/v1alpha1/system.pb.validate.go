@@ -0,0 +1,722 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: mgmt/v1alpha1/system.proto
+
+package mgmtv1alpha1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetSystemHealthRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSystemHealthRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSystemHealthRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSystemHealthRequestMultiError, or nil if none found.
+func (m *GetSystemHealthRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSystemHealthRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetSystemHealthRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSystemHealthRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSystemHealthRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetSystemHealthRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSystemHealthRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSystemHealthRequestMultiError) AllErrors() []error { return m }
+
+// GetSystemHealthRequestValidationError is the validation error returned by
+// GetSystemHealthRequest.Validate if the designated constraints aren't met.
+type GetSystemHealthRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSystemHealthRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSystemHealthRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSystemHealthRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSystemHealthRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSystemHealthRequestValidationError) ErrorName() string {
+	return "GetSystemHealthRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSystemHealthRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSystemHealthRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSystemHealthRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSystemHealthRequestValidationError{}
+
+// Validate checks the field values on GetSystemHealthResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSystemHealthResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSystemHealthResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSystemHealthResponseMultiError, or nil if none found.
+func (m *GetSystemHealthResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSystemHealthResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	for idx, item := range m.GetChecks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSystemHealthResponseValidationError{
+						field:  fmt.Sprintf("Checks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSystemHealthResponseValidationError{
+						field:  fmt.Sprintf("Checks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSystemHealthResponseValidationError{
+					field:  fmt.Sprintf("Checks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetSystemHealthResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSystemHealthResponseMultiError is an error wrapping multiple validation
+// errors returned by GetSystemHealthResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetSystemHealthResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSystemHealthResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSystemHealthResponseMultiError) AllErrors() []error { return m }
+
+// GetSystemHealthResponseValidationError is the validation error returned by
+// GetSystemHealthResponse.Validate if the designated constraints aren't met.
+type GetSystemHealthResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSystemHealthResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSystemHealthResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSystemHealthResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSystemHealthResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSystemHealthResponseValidationError) ErrorName() string {
+	return "GetSystemHealthResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSystemHealthResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSystemHealthResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSystemHealthResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSystemHealthResponseValidationError{}
+
+// Validate checks the field values on SystemHealthCheck with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SystemHealthCheck) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SystemHealthCheck with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SystemHealthCheckMultiError, or nil if none found.
+func (m *SystemHealthCheck) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SystemHealthCheck) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Status
+
+	switch v := m.Details.(type) {
+	case *SystemHealthCheck_Worker:
+		if v == nil {
+			err := SystemHealthCheckValidationError{
+				field:  "Details",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetWorker()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SystemHealthCheckValidationError{
+						field:  "Worker",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SystemHealthCheckValidationError{
+						field:  "Worker",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetWorker()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SystemHealthCheckValidationError{
+					field:  "Worker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SystemHealthCheck_Migrations:
+		if v == nil {
+			err := SystemHealthCheckValidationError{
+				field:  "Details",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMigrations()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SystemHealthCheckValidationError{
+						field:  "Migrations",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SystemHealthCheckValidationError{
+						field:  "Migrations",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMigrations()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SystemHealthCheckValidationError{
+					field:  "Migrations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if m.Message != nil {
+		// no validation rules for Message
+	}
+
+	if len(errors) > 0 {
+		return SystemHealthCheckMultiError(errors)
+	}
+
+	return nil
+}
+
+// SystemHealthCheckMultiError is an error wrapping multiple validation errors
+// returned by SystemHealthCheck.ValidateAll() if the designated constraints
+// aren't met.
+type SystemHealthCheckMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SystemHealthCheckMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SystemHealthCheckMultiError) AllErrors() []error { return m }
+
+// SystemHealthCheckValidationError is the validation error returned by
+// SystemHealthCheck.Validate if the designated constraints aren't met.
+type SystemHealthCheckValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SystemHealthCheckValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SystemHealthCheckValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SystemHealthCheckValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SystemHealthCheckValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SystemHealthCheckValidationError) ErrorName() string {
+	return "SystemHealthCheckValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SystemHealthCheckValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSystemHealthCheck.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SystemHealthCheckValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SystemHealthCheckValidationError{}
+
+// Validate checks the field values on WorkerHealthDetails with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WorkerHealthDetails) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WorkerHealthDetails with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WorkerHealthDetailsMultiError, or nil if none found.
+func (m *WorkerHealthDetails) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WorkerHealthDetails) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TaskQueue
+
+	// no validation rules for PollerCount
+
+	if m.LastHeartbeatAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetLastHeartbeatAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WorkerHealthDetailsValidationError{
+						field:  "LastHeartbeatAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WorkerHealthDetailsValidationError{
+						field:  "LastHeartbeatAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLastHeartbeatAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WorkerHealthDetailsValidationError{
+					field:  "LastHeartbeatAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WorkerHealthDetailsMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkerHealthDetailsMultiError is an error wrapping multiple validation
+// errors returned by WorkerHealthDetails.ValidateAll() if the designated
+// constraints aren't met.
+type WorkerHealthDetailsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkerHealthDetailsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkerHealthDetailsMultiError) AllErrors() []error { return m }
+
+// WorkerHealthDetailsValidationError is the validation error returned by
+// WorkerHealthDetails.Validate if the designated constraints aren't met.
+type WorkerHealthDetailsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkerHealthDetailsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkerHealthDetailsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkerHealthDetailsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkerHealthDetailsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkerHealthDetailsValidationError) ErrorName() string {
+	return "WorkerHealthDetailsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WorkerHealthDetailsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkerHealthDetails.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkerHealthDetailsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkerHealthDetailsValidationError{}
+
+// Validate checks the field values on MigrationHealthDetails with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MigrationHealthDetails) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MigrationHealthDetails with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MigrationHealthDetailsMultiError, or nil if none found.
+func (m *MigrationHealthDetails) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MigrationHealthDetails) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LatestVersion
+
+	// no validation rules for Dirty
+
+	if m.CurrentVersion != nil {
+		// no validation rules for CurrentVersion
+	}
+
+	if len(errors) > 0 {
+		return MigrationHealthDetailsMultiError(errors)
+	}
+
+	return nil
+}
+
+// MigrationHealthDetailsMultiError is an error wrapping multiple validation
+// errors returned by MigrationHealthDetails.ValidateAll() if the designated
+// constraints aren't met.
+type MigrationHealthDetailsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MigrationHealthDetailsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MigrationHealthDetailsMultiError) AllErrors() []error { return m }
+
+// MigrationHealthDetailsValidationError is the validation error returned by
+// MigrationHealthDetails.Validate if the designated constraints aren't met.
+type MigrationHealthDetailsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MigrationHealthDetailsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MigrationHealthDetailsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MigrationHealthDetailsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MigrationHealthDetailsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MigrationHealthDetailsValidationError) ErrorName() string {
+	return "MigrationHealthDetailsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MigrationHealthDetailsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMigrationHealthDetails.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MigrationHealthDetailsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MigrationHealthDetailsValidationError{}
@@ -541,6 +541,8 @@ func (m *GetUserDefinedTransformersRequest) validate(all bool) error {
 
 	// no validation rules for AccountId
 
+	// no validation rules for LabelSelector
+
 	if len(errors) > 0 {
 		return GetUserDefinedTransformersRequestMultiError(errors)
 	}
@@ -1065,6 +1067,8 @@ func (m *CreateUserDefinedTransformerRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Labels
+
 	if len(errors) > 0 {
 		return CreateUserDefinedTransformerRequestMultiError(errors)
 	}
@@ -2104,6 +2108,8 @@ func (m *UserDefinedTransformer) validate(all bool) error {
 
 	// no validation rules for AccountId
 
+	// no validation rules for Labels
+
 	if len(errors) > 0 {
 		return UserDefinedTransformerMultiError(errors)
 	}
@@ -4068,73 +4074,934 @@ func (m *TransformerConfig) validate(all bool) error {
 			}
 		}
 
-	default:
-		_ = v // ensures v is used
-	}
-
-	if len(errors) > 0 {
-		return TransformerConfigMultiError(errors)
-	}
-
-	return nil
-}
+	case *TransformerConfig_DropColumnConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// TransformerConfigMultiError is an error wrapping multiple validation errors
-// returned by TransformerConfig.ValidateAll() if the designated constraints
-// aren't met.
-type TransformerConfigMultiError []error
+		if all {
+			switch v := interface{}(m.GetDropColumnConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "DropColumnConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "DropColumnConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDropColumnConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "DropColumnConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m TransformerConfigMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
+	case *TransformerConfig_GenerateStaticValueConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// AllErrors returns a list of validation violation errors.
-func (m TransformerConfigMultiError) AllErrors() []error { return m }
+		if all {
+			switch v := interface{}(m.GetGenerateStaticValueConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateStaticValueConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateStaticValueConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGenerateStaticValueConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "GenerateStaticValueConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// TransformerConfigValidationError is the validation error returned by
-// TransformerConfig.Validate if the designated constraints aren't met.
-type TransformerConfigValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
+	case *TransformerConfig_SqlExpressionConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// Field function returns field value.
-func (e TransformerConfigValidationError) Field() string { return e.field }
+		if all {
+			switch v := interface{}(m.GetSqlExpressionConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "SqlExpressionConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "SqlExpressionConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSqlExpressionConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "SqlExpressionConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// Reason function returns reason value.
-func (e TransformerConfigValidationError) Reason() string { return e.reason }
+	case *TransformerConfig_TransformGeoCoordinateConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// Cause function returns cause value.
-func (e TransformerConfigValidationError) Cause() error { return e.cause }
+		if all {
+			switch v := interface{}(m.GetTransformGeoCoordinateConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformGeoCoordinateConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformGeoCoordinateConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTransformGeoCoordinateConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "TransformGeoCoordinateConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// Key function returns key value.
-func (e TransformerConfigValidationError) Key() bool { return e.key }
+	case *TransformerConfig_TransformUrlConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// ErrorName returns error name.
-func (e TransformerConfigValidationError) ErrorName() string {
-	return "TransformerConfigValidationError"
-}
+		if all {
+			switch v := interface{}(m.GetTransformUrlConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformUrlConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformUrlConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTransformUrlConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "TransformUrlConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// Error satisfies the builtin error interface
-func (e TransformerConfigValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
+	case *TransformerConfig_GenerateUserAgentConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	key := ""
-	if e.key {
-		key = "key for "
-	}
+		if all {
+			switch v := interface{}(m.GetGenerateUserAgentConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateUserAgentConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateUserAgentConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGenerateUserAgentConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "GenerateUserAgentConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	return fmt.Sprintf(
+	case *TransformerConfig_GenerateBusinessTimestampConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetGenerateBusinessTimestampConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateBusinessTimestampConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateBusinessTimestampConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGenerateBusinessTimestampConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "GenerateBusinessTimestampConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TransformerConfig_TransformMoneyConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTransformMoneyConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformMoneyConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformMoneyConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTransformMoneyConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "TransformMoneyConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TransformerConfig_GenerateMoneyConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetGenerateMoneyConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateMoneyConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateMoneyConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGenerateMoneyConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "GenerateMoneyConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TransformerConfig_GenerateMedicalRecordNumberConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetGenerateMedicalRecordNumberConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateMedicalRecordNumberConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateMedicalRecordNumberConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGenerateMedicalRecordNumberConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "GenerateMedicalRecordNumberConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TransformerConfig_TransformMedicalRecordNumberConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTransformMedicalRecordNumberConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformMedicalRecordNumberConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformMedicalRecordNumberConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTransformMedicalRecordNumberConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "TransformMedicalRecordNumberConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TransformerConfig_GenerateNpiConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetGenerateNpiConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateNpiConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateNpiConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGenerateNpiConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "GenerateNpiConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TransformerConfig_TransformNpiConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTransformNpiConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformNpiConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformNpiConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTransformNpiConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "TransformNpiConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TransformerConfig_GenerateIcd10CodeConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetGenerateIcd10CodeConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateIcd10CodeConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateIcd10CodeConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGenerateIcd10CodeConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "GenerateIcd10CodeConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TransformerConfig_GenerateCptCodeConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetGenerateCptCodeConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateCptCodeConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateCptCodeConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGenerateCptCodeConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "GenerateCptCodeConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TransformerConfig_GenerateVinConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetGenerateVinConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateVinConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateVinConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGenerateVinConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "GenerateVinConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TransformerConfig_TransformVinConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTransformVinConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformVinConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformVinConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTransformVinConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "TransformVinConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TransformerConfig_GenerateImeiConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetGenerateImeiConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateImeiConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateImeiConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGenerateImeiConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "GenerateImeiConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TransformerConfig_TransformImeiConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTransformImeiConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformImeiConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformImeiConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTransformImeiConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "TransformImeiConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TransformerConfig_GenerateEanConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetGenerateEanConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateEanConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateEanConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGenerateEanConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "GenerateEanConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TransformerConfig_TransformEanConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTransformEanConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformEanConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformEanConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTransformEanConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "TransformEanConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return TransformerConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransformerConfigMultiError is an error wrapping multiple validation errors
+// returned by TransformerConfig.ValidateAll() if the designated constraints
+// aren't met.
+type TransformerConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransformerConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransformerConfigMultiError) AllErrors() []error { return m }
+
+// TransformerConfigValidationError is the validation error returned by
+// TransformerConfig.Validate if the designated constraints aren't met.
+type TransformerConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransformerConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransformerConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransformerConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransformerConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransformerConfigValidationError) ErrorName() string {
+	return "TransformerConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TransformerConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
 		"invalid %sTransformerConfig.%s: %s%s",
 		key,
 		e.field,
@@ -4142,7 +5009,2989 @@ func (e TransformerConfigValidationError) Error() string {
 		cause)
 }
 
-var _ error = TransformerConfigValidationError{}
+var _ error = TransformerConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransformerConfigValidationError{}
+
+// Validate checks the field values on GenerateEmail with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GenerateEmail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateEmail with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GenerateEmailMultiError, or
+// nil if none found.
+func (m *GenerateEmail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateEmail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.EmailType != nil {
+		// no validation rules for EmailType
+	}
+
+	if len(errors) > 0 {
+		return GenerateEmailMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateEmailMultiError is an error wrapping multiple validation errors
+// returned by GenerateEmail.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateEmailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateEmailMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateEmailMultiError) AllErrors() []error { return m }
+
+// GenerateEmailValidationError is the validation error returned by
+// GenerateEmail.Validate if the designated constraints aren't met.
+type GenerateEmailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateEmailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateEmailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateEmailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateEmailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateEmailValidationError) ErrorName() string { return "GenerateEmailValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenerateEmailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateEmail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateEmailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateEmailValidationError{}
+
+// Validate checks the field values on TransformEmail with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TransformEmail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransformEmail with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TransformEmailMultiError,
+// or nil if none found.
+func (m *TransformEmail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransformEmail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PreserveDomain
+
+	// no validation rules for PreserveLength
+
+	// no validation rules for PreservePlusTag
+
+	if m.EmailType != nil {
+		// no validation rules for EmailType
+	}
+
+	if m.InvalidEmailAction != nil {
+		// no validation rules for InvalidEmailAction
+	}
+
+	if m.ReplacementDomain != nil {
+		// no validation rules for ReplacementDomain
+	}
+
+	if len(errors) > 0 {
+		return TransformEmailMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransformEmailMultiError is an error wrapping multiple validation errors
+// returned by TransformEmail.ValidateAll() if the designated constraints
+// aren't met.
+type TransformEmailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransformEmailMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransformEmailMultiError) AllErrors() []error { return m }
+
+// TransformEmailValidationError is the validation error returned by
+// TransformEmail.Validate if the designated constraints aren't met.
+type TransformEmailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransformEmailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransformEmailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransformEmailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransformEmailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransformEmailValidationError) ErrorName() string { return "TransformEmailValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TransformEmailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransformEmail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransformEmailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransformEmailValidationError{}
+
+// Validate checks the field values on GenerateBool with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GenerateBool) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateBool with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GenerateBoolMultiError, or
+// nil if none found.
+func (m *GenerateBool) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateBool) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GenerateBoolMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateBoolMultiError is an error wrapping multiple validation errors
+// returned by GenerateBool.ValidateAll() if the designated constraints aren't met.
+type GenerateBoolMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateBoolMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateBoolMultiError) AllErrors() []error { return m }
+
+// GenerateBoolValidationError is the validation error returned by
+// GenerateBool.Validate if the designated constraints aren't met.
+type GenerateBoolValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateBoolValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateBoolValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateBoolValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateBoolValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateBoolValidationError) ErrorName() string { return "GenerateBoolValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenerateBoolValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateBool.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateBoolValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateBoolValidationError{}
+
+// Validate checks the field values on GenerateCardNumber with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateCardNumber) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateCardNumber with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateCardNumberMultiError, or nil if none found.
+func (m *GenerateCardNumber) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateCardNumber) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ValidLuhn
+
+	if len(errors) > 0 {
+		return GenerateCardNumberMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateCardNumberMultiError is an error wrapping multiple validation errors
+// returned by GenerateCardNumber.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateCardNumberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateCardNumberMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateCardNumberMultiError) AllErrors() []error { return m }
+
+// GenerateCardNumberValidationError is the validation error returned by
+// GenerateCardNumber.Validate if the designated constraints aren't met.
+type GenerateCardNumberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateCardNumberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateCardNumberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateCardNumberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateCardNumberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateCardNumberValidationError) ErrorName() string {
+	return "GenerateCardNumberValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateCardNumberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateCardNumber.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateCardNumberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateCardNumberValidationError{}
+
+// Validate checks the field values on GenerateCity with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GenerateCity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateCity with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GenerateCityMultiError, or
+// nil if none found.
+func (m *GenerateCity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateCity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GenerateCityMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateCityMultiError is an error wrapping multiple validation errors
+// returned by GenerateCity.ValidateAll() if the designated constraints aren't met.
+type GenerateCityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateCityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateCityMultiError) AllErrors() []error { return m }
+
+// GenerateCityValidationError is the validation error returned by
+// GenerateCity.Validate if the designated constraints aren't met.
+type GenerateCityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateCityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateCityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateCityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateCityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateCityValidationError) ErrorName() string { return "GenerateCityValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenerateCityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateCity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateCityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateCityValidationError{}
+
+// Validate checks the field values on GenerateDefault with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GenerateDefault) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateDefault with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateDefaultMultiError, or nil if none found.
+func (m *GenerateDefault) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateDefault) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GenerateDefaultMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateDefaultMultiError is an error wrapping multiple validation errors
+// returned by GenerateDefault.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateDefaultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateDefaultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateDefaultMultiError) AllErrors() []error { return m }
+
+// GenerateDefaultValidationError is the validation error returned by
+// GenerateDefault.Validate if the designated constraints aren't met.
+type GenerateDefaultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateDefaultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateDefaultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateDefaultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateDefaultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateDefaultValidationError) ErrorName() string { return "GenerateDefaultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenerateDefaultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateDefault.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateDefaultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateDefaultValidationError{}
+
+// Validate checks the field values on GenerateE164PhoneNumber with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateE164PhoneNumber) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateE164PhoneNumber with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateE164PhoneNumberMultiError, or nil if none found.
+func (m *GenerateE164PhoneNumber) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateE164PhoneNumber) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Min
+
+	// no validation rules for Max
+
+	if len(errors) > 0 {
+		return GenerateE164PhoneNumberMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateE164PhoneNumberMultiError is an error wrapping multiple validation
+// errors returned by GenerateE164PhoneNumber.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateE164PhoneNumberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateE164PhoneNumberMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateE164PhoneNumberMultiError) AllErrors() []error { return m }
+
+// GenerateE164PhoneNumberValidationError is the validation error returned by
+// GenerateE164PhoneNumber.Validate if the designated constraints aren't met.
+type GenerateE164PhoneNumberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateE164PhoneNumberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateE164PhoneNumberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateE164PhoneNumberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateE164PhoneNumberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateE164PhoneNumberValidationError) ErrorName() string {
+	return "GenerateE164PhoneNumberValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateE164PhoneNumberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateE164PhoneNumber.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateE164PhoneNumberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateE164PhoneNumberValidationError{}
+
+// Validate checks the field values on GenerateFirstName with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GenerateFirstName) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateFirstName with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateFirstNameMultiError, or nil if none found.
+func (m *GenerateFirstName) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateFirstName) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GenerateFirstNameMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateFirstNameMultiError is an error wrapping multiple validation errors
+// returned by GenerateFirstName.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateFirstNameMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateFirstNameMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateFirstNameMultiError) AllErrors() []error { return m }
+
+// GenerateFirstNameValidationError is the validation error returned by
+// GenerateFirstName.Validate if the designated constraints aren't met.
+type GenerateFirstNameValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateFirstNameValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateFirstNameValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateFirstNameValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateFirstNameValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateFirstNameValidationError) ErrorName() string {
+	return "GenerateFirstNameValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateFirstNameValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateFirstName.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateFirstNameValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateFirstNameValidationError{}
+
+// Validate checks the field values on GenerateFloat64 with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GenerateFloat64) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateFloat64 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateFloat64MultiError, or nil if none found.
+func (m *GenerateFloat64) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateFloat64) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RandomizeSign
+
+	// no validation rules for Min
+
+	// no validation rules for Max
+
+	// no validation rules for Precision
+
+	if len(errors) > 0 {
+		return GenerateFloat64MultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateFloat64MultiError is an error wrapping multiple validation errors
+// returned by GenerateFloat64.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateFloat64MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateFloat64MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateFloat64MultiError) AllErrors() []error { return m }
+
+// GenerateFloat64ValidationError is the validation error returned by
+// GenerateFloat64.Validate if the designated constraints aren't met.
+type GenerateFloat64ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateFloat64ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateFloat64ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateFloat64ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateFloat64ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateFloat64ValidationError) ErrorName() string { return "GenerateFloat64ValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenerateFloat64ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateFloat64.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateFloat64ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateFloat64ValidationError{}
+
+// Validate checks the field values on GenerateFullAddress with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateFullAddress) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateFullAddress with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateFullAddressMultiError, or nil if none found.
+func (m *GenerateFullAddress) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateFullAddress) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GenerateFullAddressMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateFullAddressMultiError is an error wrapping multiple validation
+// errors returned by GenerateFullAddress.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateFullAddressMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateFullAddressMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateFullAddressMultiError) AllErrors() []error { return m }
+
+// GenerateFullAddressValidationError is the validation error returned by
+// GenerateFullAddress.Validate if the designated constraints aren't met.
+type GenerateFullAddressValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateFullAddressValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateFullAddressValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateFullAddressValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateFullAddressValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateFullAddressValidationError) ErrorName() string {
+	return "GenerateFullAddressValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateFullAddressValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateFullAddress.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateFullAddressValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateFullAddressValidationError{}
+
+// Validate checks the field values on GenerateFullName with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GenerateFullName) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateFullName with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateFullNameMultiError, or nil if none found.
+func (m *GenerateFullName) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateFullName) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GenerateFullNameMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateFullNameMultiError is an error wrapping multiple validation errors
+// returned by GenerateFullName.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateFullNameMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateFullNameMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateFullNameMultiError) AllErrors() []error { return m }
+
+// GenerateFullNameValidationError is the validation error returned by
+// GenerateFullName.Validate if the designated constraints aren't met.
+type GenerateFullNameValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateFullNameValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateFullNameValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateFullNameValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateFullNameValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateFullNameValidationError) ErrorName() string { return "GenerateFullNameValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenerateFullNameValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateFullName.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateFullNameValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateFullNameValidationError{}
+
+// Validate checks the field values on GenerateGender with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GenerateGender) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateGender with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GenerateGenderMultiError,
+// or nil if none found.
+func (m *GenerateGender) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateGender) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Abbreviate
+
+	if len(errors) > 0 {
+		return GenerateGenderMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateGenderMultiError is an error wrapping multiple validation errors
+// returned by GenerateGender.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateGenderMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateGenderMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateGenderMultiError) AllErrors() []error { return m }
+
+// GenerateGenderValidationError is the validation error returned by
+// GenerateGender.Validate if the designated constraints aren't met.
+type GenerateGenderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateGenderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateGenderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateGenderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateGenderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateGenderValidationError) ErrorName() string { return "GenerateGenderValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenerateGenderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateGender.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateGenderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateGenderValidationError{}
+
+// Validate checks the field values on GenerateInt64PhoneNumber with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateInt64PhoneNumber) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateInt64PhoneNumber with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateInt64PhoneNumberMultiError, or nil if none found.
+func (m *GenerateInt64PhoneNumber) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateInt64PhoneNumber) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GenerateInt64PhoneNumberMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateInt64PhoneNumberMultiError is an error wrapping multiple validation
+// errors returned by GenerateInt64PhoneNumber.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateInt64PhoneNumberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateInt64PhoneNumberMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateInt64PhoneNumberMultiError) AllErrors() []error { return m }
+
+// GenerateInt64PhoneNumberValidationError is the validation error returned by
+// GenerateInt64PhoneNumber.Validate if the designated constraints aren't met.
+type GenerateInt64PhoneNumberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateInt64PhoneNumberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateInt64PhoneNumberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateInt64PhoneNumberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateInt64PhoneNumberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateInt64PhoneNumberValidationError) ErrorName() string {
+	return "GenerateInt64PhoneNumberValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateInt64PhoneNumberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateInt64PhoneNumber.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateInt64PhoneNumberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateInt64PhoneNumberValidationError{}
+
+// Validate checks the field values on GenerateInt64 with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GenerateInt64) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateInt64 with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GenerateInt64MultiError, or
+// nil if none found.
+func (m *GenerateInt64) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateInt64) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RandomizeSign
+
+	// no validation rules for Min
+
+	// no validation rules for Max
+
+	if len(errors) > 0 {
+		return GenerateInt64MultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateInt64MultiError is an error wrapping multiple validation errors
+// returned by GenerateInt64.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateInt64MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateInt64MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateInt64MultiError) AllErrors() []error { return m }
+
+// GenerateInt64ValidationError is the validation error returned by
+// GenerateInt64.Validate if the designated constraints aren't met.
+type GenerateInt64ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateInt64ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateInt64ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateInt64ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateInt64ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateInt64ValidationError) ErrorName() string { return "GenerateInt64ValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenerateInt64ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateInt64.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateInt64ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateInt64ValidationError{}
+
+// Validate checks the field values on GenerateLastName with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GenerateLastName) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateLastName with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateLastNameMultiError, or nil if none found.
+func (m *GenerateLastName) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateLastName) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GenerateLastNameMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateLastNameMultiError is an error wrapping multiple validation errors
+// returned by GenerateLastName.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateLastNameMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateLastNameMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateLastNameMultiError) AllErrors() []error { return m }
+
+// GenerateLastNameValidationError is the validation error returned by
+// GenerateLastName.Validate if the designated constraints aren't met.
+type GenerateLastNameValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateLastNameValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateLastNameValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateLastNameValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateLastNameValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateLastNameValidationError) ErrorName() string { return "GenerateLastNameValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenerateLastNameValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateLastName.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateLastNameValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateLastNameValidationError{}
+
+// Validate checks the field values on GenerateSha256Hash with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateSha256Hash) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateSha256Hash with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateSha256HashMultiError, or nil if none found.
+func (m *GenerateSha256Hash) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateSha256Hash) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GenerateSha256HashMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateSha256HashMultiError is an error wrapping multiple validation errors
+// returned by GenerateSha256Hash.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateSha256HashMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateSha256HashMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateSha256HashMultiError) AllErrors() []error { return m }
+
+// GenerateSha256HashValidationError is the validation error returned by
+// GenerateSha256Hash.Validate if the designated constraints aren't met.
+type GenerateSha256HashValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateSha256HashValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateSha256HashValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateSha256HashValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateSha256HashValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateSha256HashValidationError) ErrorName() string {
+	return "GenerateSha256HashValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateSha256HashValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateSha256Hash.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateSha256HashValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateSha256HashValidationError{}
+
+// Validate checks the field values on GenerateSSN with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GenerateSSN) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateSSN with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GenerateSSNMultiError, or
+// nil if none found.
+func (m *GenerateSSN) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateSSN) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GenerateSSNMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateSSNMultiError is an error wrapping multiple validation errors
+// returned by GenerateSSN.ValidateAll() if the designated constraints aren't met.
+type GenerateSSNMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateSSNMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateSSNMultiError) AllErrors() []error { return m }
+
+// GenerateSSNValidationError is the validation error returned by
+// GenerateSSN.Validate if the designated constraints aren't met.
+type GenerateSSNValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateSSNValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateSSNValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateSSNValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateSSNValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateSSNValidationError) ErrorName() string { return "GenerateSSNValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenerateSSNValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateSSN.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateSSNValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateSSNValidationError{}
+
+// Validate checks the field values on GenerateState with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GenerateState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateState with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GenerateStateMultiError, or
+// nil if none found.
+func (m *GenerateState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GenerateStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateStateMultiError is an error wrapping multiple validation errors
+// returned by GenerateState.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateStateMultiError) AllErrors() []error { return m }
+
+// GenerateStateValidationError is the validation error returned by
+// GenerateState.Validate if the designated constraints aren't met.
+type GenerateStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateStateValidationError) ErrorName() string { return "GenerateStateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenerateStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateStateValidationError{}
+
+// Validate checks the field values on GenerateStreetAddress with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateStreetAddress) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateStreetAddress with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateStreetAddressMultiError, or nil if none found.
+func (m *GenerateStreetAddress) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateStreetAddress) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GenerateStreetAddressMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateStreetAddressMultiError is an error wrapping multiple validation
+// errors returned by GenerateStreetAddress.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateStreetAddressMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateStreetAddressMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateStreetAddressMultiError) AllErrors() []error { return m }
+
+// GenerateStreetAddressValidationError is the validation error returned by
+// GenerateStreetAddress.Validate if the designated constraints aren't met.
+type GenerateStreetAddressValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateStreetAddressValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateStreetAddressValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateStreetAddressValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateStreetAddressValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateStreetAddressValidationError) ErrorName() string {
+	return "GenerateStreetAddressValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateStreetAddressValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateStreetAddress.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateStreetAddressValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateStreetAddressValidationError{}
+
+// Validate checks the field values on GenerateStringPhoneNumber with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateStringPhoneNumber) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateStringPhoneNumber with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateStringPhoneNumberMultiError, or nil if none found.
+func (m *GenerateStringPhoneNumber) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateStringPhoneNumber) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Min
+
+	// no validation rules for Max
+
+	if len(errors) > 0 {
+		return GenerateStringPhoneNumberMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateStringPhoneNumberMultiError is an error wrapping multiple validation
+// errors returned by GenerateStringPhoneNumber.ValidateAll() if the
+// designated constraints aren't met.
+type GenerateStringPhoneNumberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateStringPhoneNumberMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateStringPhoneNumberMultiError) AllErrors() []error { return m }
+
+// GenerateStringPhoneNumberValidationError is the validation error returned by
+// GenerateStringPhoneNumber.Validate if the designated constraints aren't met.
+type GenerateStringPhoneNumberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateStringPhoneNumberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateStringPhoneNumberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateStringPhoneNumberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateStringPhoneNumberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateStringPhoneNumberValidationError) ErrorName() string {
+	return "GenerateStringPhoneNumberValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateStringPhoneNumberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateStringPhoneNumber.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateStringPhoneNumberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateStringPhoneNumberValidationError{}
+
+// Validate checks the field values on GenerateString with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GenerateString) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateString with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GenerateStringMultiError,
+// or nil if none found.
+func (m *GenerateString) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateString) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Min
+
+	// no validation rules for Max
+
+	if len(errors) > 0 {
+		return GenerateStringMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateStringMultiError is an error wrapping multiple validation errors
+// returned by GenerateString.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateStringMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateStringMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateStringMultiError) AllErrors() []error { return m }
+
+// GenerateStringValidationError is the validation error returned by
+// GenerateString.Validate if the designated constraints aren't met.
+type GenerateStringValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateStringValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateStringValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateStringValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateStringValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateStringValidationError) ErrorName() string { return "GenerateStringValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenerateStringValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateString.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateStringValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateStringValidationError{}
+
+// Validate checks the field values on GenerateUnixTimestamp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateUnixTimestamp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateUnixTimestamp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateUnixTimestampMultiError, or nil if none found.
+func (m *GenerateUnixTimestamp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateUnixTimestamp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GenerateUnixTimestampMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateUnixTimestampMultiError is an error wrapping multiple validation
+// errors returned by GenerateUnixTimestamp.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateUnixTimestampMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateUnixTimestampMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateUnixTimestampMultiError) AllErrors() []error { return m }
+
+// GenerateUnixTimestampValidationError is the validation error returned by
+// GenerateUnixTimestamp.Validate if the designated constraints aren't met.
+type GenerateUnixTimestampValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateUnixTimestampValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateUnixTimestampValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateUnixTimestampValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateUnixTimestampValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateUnixTimestampValidationError) ErrorName() string {
+	return "GenerateUnixTimestampValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateUnixTimestampValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateUnixTimestamp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateUnixTimestampValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateUnixTimestampValidationError{}
+
+// Validate checks the field values on GenerateUsername with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GenerateUsername) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateUsername with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateUsernameMultiError, or nil if none found.
+func (m *GenerateUsername) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateUsername) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GenerateUsernameMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateUsernameMultiError is an error wrapping multiple validation errors
+// returned by GenerateUsername.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateUsernameMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateUsernameMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateUsernameMultiError) AllErrors() []error { return m }
+
+// GenerateUsernameValidationError is the validation error returned by
+// GenerateUsername.Validate if the designated constraints aren't met.
+type GenerateUsernameValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateUsernameValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateUsernameValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateUsernameValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateUsernameValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateUsernameValidationError) ErrorName() string { return "GenerateUsernameValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenerateUsernameValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateUsername.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateUsernameValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateUsernameValidationError{}
+
+// Validate checks the field values on GenerateUtcTimestamp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateUtcTimestamp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateUtcTimestamp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateUtcTimestampMultiError, or nil if none found.
+func (m *GenerateUtcTimestamp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateUtcTimestamp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GenerateUtcTimestampMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateUtcTimestampMultiError is an error wrapping multiple validation
+// errors returned by GenerateUtcTimestamp.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateUtcTimestampMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateUtcTimestampMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateUtcTimestampMultiError) AllErrors() []error { return m }
+
+// GenerateUtcTimestampValidationError is the validation error returned by
+// GenerateUtcTimestamp.Validate if the designated constraints aren't met.
+type GenerateUtcTimestampValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateUtcTimestampValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateUtcTimestampValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateUtcTimestampValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateUtcTimestampValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateUtcTimestampValidationError) ErrorName() string {
+	return "GenerateUtcTimestampValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateUtcTimestampValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateUtcTimestamp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateUtcTimestampValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateUtcTimestampValidationError{}
+
+// Validate checks the field values on GenerateUuid with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GenerateUuid) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateUuid with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GenerateUuidMultiError, or
+// nil if none found.
+func (m *GenerateUuid) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateUuid) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IncludeHyphens
+
+	if len(errors) > 0 {
+		return GenerateUuidMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateUuidMultiError is an error wrapping multiple validation errors
+// returned by GenerateUuid.ValidateAll() if the designated constraints aren't met.
+type GenerateUuidMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateUuidMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateUuidMultiError) AllErrors() []error { return m }
+
+// GenerateUuidValidationError is the validation error returned by
+// GenerateUuid.Validate if the designated constraints aren't met.
+type GenerateUuidValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateUuidValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateUuidValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateUuidValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateUuidValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateUuidValidationError) ErrorName() string { return "GenerateUuidValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenerateUuidValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateUuid.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateUuidValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateUuidValidationError{}
+
+// Validate checks the field values on GenerateZipcode with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GenerateZipcode) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateZipcode with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateZipcodeMultiError, or nil if none found.
+func (m *GenerateZipcode) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateZipcode) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GenerateZipcodeMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateZipcodeMultiError is an error wrapping multiple validation errors
+// returned by GenerateZipcode.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateZipcodeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateZipcodeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateZipcodeMultiError) AllErrors() []error { return m }
+
+// GenerateZipcodeValidationError is the validation error returned by
+// GenerateZipcode.Validate if the designated constraints aren't met.
+type GenerateZipcodeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateZipcodeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateZipcodeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateZipcodeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateZipcodeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateZipcodeValidationError) ErrorName() string { return "GenerateZipcodeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenerateZipcodeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateZipcode.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateZipcodeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateZipcodeValidationError{}
+
+// Validate checks the field values on TransformE164PhoneNumber with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransformE164PhoneNumber) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransformE164PhoneNumber with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransformE164PhoneNumberMultiError, or nil if none found.
+func (m *TransformE164PhoneNumber) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransformE164PhoneNumber) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PreserveLength
+
+	if len(errors) > 0 {
+		return TransformE164PhoneNumberMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransformE164PhoneNumberMultiError is an error wrapping multiple validation
+// errors returned by TransformE164PhoneNumber.ValidateAll() if the designated
+// constraints aren't met.
+type TransformE164PhoneNumberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransformE164PhoneNumberMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransformE164PhoneNumberMultiError) AllErrors() []error { return m }
+
+// TransformE164PhoneNumberValidationError is the validation error returned by
+// TransformE164PhoneNumber.Validate if the designated constraints aren't met.
+type TransformE164PhoneNumberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransformE164PhoneNumberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransformE164PhoneNumberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransformE164PhoneNumberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransformE164PhoneNumberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransformE164PhoneNumberValidationError) ErrorName() string {
+	return "TransformE164PhoneNumberValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TransformE164PhoneNumberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransformE164PhoneNumber.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransformE164PhoneNumberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransformE164PhoneNumberValidationError{}
+
+// Validate checks the field values on TransformFirstName with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransformFirstName) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransformFirstName with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransformFirstNameMultiError, or nil if none found.
+func (m *TransformFirstName) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransformFirstName) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PreserveLength
+
+	if len(errors) > 0 {
+		return TransformFirstNameMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransformFirstNameMultiError is an error wrapping multiple validation errors
+// returned by TransformFirstName.ValidateAll() if the designated constraints
+// aren't met.
+type TransformFirstNameMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransformFirstNameMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransformFirstNameMultiError) AllErrors() []error { return m }
+
+// TransformFirstNameValidationError is the validation error returned by
+// TransformFirstName.Validate if the designated constraints aren't met.
+type TransformFirstNameValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransformFirstNameValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransformFirstNameValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransformFirstNameValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransformFirstNameValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransformFirstNameValidationError) ErrorName() string {
+	return "TransformFirstNameValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TransformFirstNameValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransformFirstName.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransformFirstNameValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransformFirstNameValidationError{}
+
+// Validate checks the field values on TransformFloat64 with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TransformFloat64) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransformFloat64 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransformFloat64MultiError, or nil if none found.
+func (m *TransformFloat64) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransformFloat64) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RandomizationRangeMin
+
+	// no validation rules for RandomizationRangeMax
+
+	if len(errors) > 0 {
+		return TransformFloat64MultiError(errors)
+	}
+
+	return nil
+}
+
+// TransformFloat64MultiError is an error wrapping multiple validation errors
+// returned by TransformFloat64.ValidateAll() if the designated constraints
+// aren't met.
+type TransformFloat64MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransformFloat64MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransformFloat64MultiError) AllErrors() []error { return m }
+
+// TransformFloat64ValidationError is the validation error returned by
+// TransformFloat64.Validate if the designated constraints aren't met.
+type TransformFloat64ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransformFloat64ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransformFloat64ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransformFloat64ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransformFloat64ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransformFloat64ValidationError) ErrorName() string { return "TransformFloat64ValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TransformFloat64ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransformFloat64.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransformFloat64ValidationError{}
 
 var _ interface {
 	Field() string
@@ -4150,48 +7999,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransformerConfigValidationError{}
+} = TransformFloat64ValidationError{}
 
-// Validate checks the field values on GenerateEmail with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GenerateEmail) Validate() error {
+// Validate checks the field values on TransformFullName with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TransformFullName) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateEmail with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GenerateEmailMultiError, or
-// nil if none found.
-func (m *GenerateEmail) ValidateAll() error {
+// ValidateAll checks the field values on TransformFullName with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransformFullNameMultiError, or nil if none found.
+func (m *TransformFullName) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateEmail) validate(all bool) error {
+func (m *TransformFullName) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.EmailType != nil {
-		// no validation rules for EmailType
-	}
+	// no validation rules for PreserveLength
 
 	if len(errors) > 0 {
-		return GenerateEmailMultiError(errors)
+		return TransformFullNameMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateEmailMultiError is an error wrapping multiple validation errors
-// returned by GenerateEmail.ValidateAll() if the designated constraints
+// TransformFullNameMultiError is an error wrapping multiple validation errors
+// returned by TransformFullName.ValidateAll() if the designated constraints
 // aren't met.
-type GenerateEmailMultiError []error
+type TransformFullNameMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateEmailMultiError) Error() string {
+func (m TransformFullNameMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4200,11 +8047,11 @@ func (m GenerateEmailMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateEmailMultiError) AllErrors() []error { return m }
+func (m TransformFullNameMultiError) AllErrors() []error { return m }
 
-// GenerateEmailValidationError is the validation error returned by
-// GenerateEmail.Validate if the designated constraints aren't met.
-type GenerateEmailValidationError struct {
+// TransformFullNameValidationError is the validation error returned by
+// TransformFullName.Validate if the designated constraints aren't met.
+type TransformFullNameValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4212,22 +8059,24 @@ type GenerateEmailValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateEmailValidationError) Field() string { return e.field }
+func (e TransformFullNameValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateEmailValidationError) Reason() string { return e.reason }
+func (e TransformFullNameValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateEmailValidationError) Cause() error { return e.cause }
+func (e TransformFullNameValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateEmailValidationError) Key() bool { return e.key }
+func (e TransformFullNameValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateEmailValidationError) ErrorName() string { return "GenerateEmailValidationError" }
+func (e TransformFullNameValidationError) ErrorName() string {
+	return "TransformFullNameValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GenerateEmailValidationError) Error() string {
+func (e TransformFullNameValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4239,14 +8088,14 @@ func (e GenerateEmailValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateEmail.%s: %s%s",
+		"invalid %sTransformFullName.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateEmailValidationError{}
+var _ error = TransformFullNameValidationError{}
 
 var _ interface {
 	Field() string
@@ -4254,56 +8103,152 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateEmailValidationError{}
+} = TransformFullNameValidationError{}
 
-// Validate checks the field values on TransformEmail with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *TransformEmail) Validate() error {
+// Validate checks the field values on TransformInt64PhoneNumber with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransformInt64PhoneNumber) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TransformEmail with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TransformEmailMultiError,
-// or nil if none found.
-func (m *TransformEmail) ValidateAll() error {
+// ValidateAll checks the field values on TransformInt64PhoneNumber with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransformInt64PhoneNumberMultiError, or nil if none found.
+func (m *TransformInt64PhoneNumber) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TransformEmail) validate(all bool) error {
+func (m *TransformInt64PhoneNumber) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PreserveDomain
-
 	// no validation rules for PreserveLength
 
-	if m.EmailType != nil {
-		// no validation rules for EmailType
+	if len(errors) > 0 {
+		return TransformInt64PhoneNumberMultiError(errors)
 	}
 
-	if m.InvalidEmailAction != nil {
-		// no validation rules for InvalidEmailAction
+	return nil
+}
+
+// TransformInt64PhoneNumberMultiError is an error wrapping multiple validation
+// errors returned by TransformInt64PhoneNumber.ValidateAll() if the
+// designated constraints aren't met.
+type TransformInt64PhoneNumberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransformInt64PhoneNumberMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransformInt64PhoneNumberMultiError) AllErrors() []error { return m }
+
+// TransformInt64PhoneNumberValidationError is the validation error returned by
+// TransformInt64PhoneNumber.Validate if the designated constraints aren't met.
+type TransformInt64PhoneNumberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransformInt64PhoneNumberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransformInt64PhoneNumberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransformInt64PhoneNumberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransformInt64PhoneNumberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransformInt64PhoneNumberValidationError) ErrorName() string {
+	return "TransformInt64PhoneNumberValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TransformInt64PhoneNumberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransformInt64PhoneNumber.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransformInt64PhoneNumberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransformInt64PhoneNumberValidationError{}
+
+// Validate checks the field values on TransformInt64 with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TransformInt64) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransformInt64 with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TransformInt64MultiError,
+// or nil if none found.
+func (m *TransformInt64) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransformInt64) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for RandomizationRangeMin
+
+	// no validation rules for RandomizationRangeMax
+
 	if len(errors) > 0 {
-		return TransformEmailMultiError(errors)
+		return TransformInt64MultiError(errors)
 	}
 
 	return nil
 }
 
-// TransformEmailMultiError is an error wrapping multiple validation errors
-// returned by TransformEmail.ValidateAll() if the designated constraints
+// TransformInt64MultiError is an error wrapping multiple validation errors
+// returned by TransformInt64.ValidateAll() if the designated constraints
 // aren't met.
-type TransformEmailMultiError []error
+type TransformInt64MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransformEmailMultiError) Error() string {
+func (m TransformInt64MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4312,11 +8257,11 @@ func (m TransformEmailMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransformEmailMultiError) AllErrors() []error { return m }
+func (m TransformInt64MultiError) AllErrors() []error { return m }
 
-// TransformEmailValidationError is the validation error returned by
-// TransformEmail.Validate if the designated constraints aren't met.
-type TransformEmailValidationError struct {
+// TransformInt64ValidationError is the validation error returned by
+// TransformInt64.Validate if the designated constraints aren't met.
+type TransformInt64ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4324,22 +8269,22 @@ type TransformEmailValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransformEmailValidationError) Field() string { return e.field }
+func (e TransformInt64ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransformEmailValidationError) Reason() string { return e.reason }
+func (e TransformInt64ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransformEmailValidationError) Cause() error { return e.cause }
+func (e TransformInt64ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransformEmailValidationError) Key() bool { return e.key }
+func (e TransformInt64ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransformEmailValidationError) ErrorName() string { return "TransformEmailValidationError" }
+func (e TransformInt64ValidationError) ErrorName() string { return "TransformInt64ValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TransformEmailValidationError) Error() string {
+func (e TransformInt64ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4351,14 +8296,14 @@ func (e TransformEmailValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransformEmail.%s: %s%s",
+		"invalid %sTransformInt64.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransformEmailValidationError{}
+var _ error = TransformInt64ValidationError{}
 
 var _ interface {
 	Field() string
@@ -4366,43 +8311,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransformEmailValidationError{}
+} = TransformInt64ValidationError{}
 
-// Validate checks the field values on GenerateBool with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GenerateBool) Validate() error {
+// Validate checks the field values on TransformLastName with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TransformLastName) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateBool with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GenerateBoolMultiError, or
-// nil if none found.
-func (m *GenerateBool) ValidateAll() error {
+// ValidateAll checks the field values on TransformLastName with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransformLastNameMultiError, or nil if none found.
+func (m *TransformLastName) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateBool) validate(all bool) error {
+func (m *TransformLastName) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for PreserveLength
+
 	if len(errors) > 0 {
-		return GenerateBoolMultiError(errors)
+		return TransformLastNameMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateBoolMultiError is an error wrapping multiple validation errors
-// returned by GenerateBool.ValidateAll() if the designated constraints aren't met.
-type GenerateBoolMultiError []error
+// TransformLastNameMultiError is an error wrapping multiple validation errors
+// returned by TransformLastName.ValidateAll() if the designated constraints
+// aren't met.
+type TransformLastNameMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateBoolMultiError) Error() string {
+func (m TransformLastNameMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4411,11 +8359,11 @@ func (m GenerateBoolMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateBoolMultiError) AllErrors() []error { return m }
+func (m TransformLastNameMultiError) AllErrors() []error { return m }
 
-// GenerateBoolValidationError is the validation error returned by
-// GenerateBool.Validate if the designated constraints aren't met.
-type GenerateBoolValidationError struct {
+// TransformLastNameValidationError is the validation error returned by
+// TransformLastName.Validate if the designated constraints aren't met.
+type TransformLastNameValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4423,22 +8371,24 @@ type GenerateBoolValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateBoolValidationError) Field() string { return e.field }
+func (e TransformLastNameValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateBoolValidationError) Reason() string { return e.reason }
+func (e TransformLastNameValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateBoolValidationError) Cause() error { return e.cause }
+func (e TransformLastNameValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateBoolValidationError) Key() bool { return e.key }
+func (e TransformLastNameValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateBoolValidationError) ErrorName() string { return "GenerateBoolValidationError" }
+func (e TransformLastNameValidationError) ErrorName() string {
+	return "TransformLastNameValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GenerateBoolValidationError) Error() string {
+func (e TransformLastNameValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4450,14 +8400,14 @@ func (e GenerateBoolValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateBool.%s: %s%s",
+		"invalid %sTransformLastName.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateBoolValidationError{}
+var _ error = TransformLastNameValidationError{}
 
 var _ interface {
 	Field() string
@@ -4465,46 +8415,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateBoolValidationError{}
+} = TransformLastNameValidationError{}
 
-// Validate checks the field values on GenerateCardNumber with the rules
+// Validate checks the field values on TransformPhoneNumber with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateCardNumber) Validate() error {
+func (m *TransformPhoneNumber) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateCardNumber with the rules
+// ValidateAll checks the field values on TransformPhoneNumber with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateCardNumberMultiError, or nil if none found.
-func (m *GenerateCardNumber) ValidateAll() error {
+// TransformPhoneNumberMultiError, or nil if none found.
+func (m *TransformPhoneNumber) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateCardNumber) validate(all bool) error {
+func (m *TransformPhoneNumber) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ValidLuhn
+	// no validation rules for PreserveLength
 
 	if len(errors) > 0 {
-		return GenerateCardNumberMultiError(errors)
+		return TransformPhoneNumberMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateCardNumberMultiError is an error wrapping multiple validation errors
-// returned by GenerateCardNumber.ValidateAll() if the designated constraints
-// aren't met.
-type GenerateCardNumberMultiError []error
+// TransformPhoneNumberMultiError is an error wrapping multiple validation
+// errors returned by TransformPhoneNumber.ValidateAll() if the designated
+// constraints aren't met.
+type TransformPhoneNumberMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateCardNumberMultiError) Error() string {
+func (m TransformPhoneNumberMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4513,11 +8463,11 @@ func (m GenerateCardNumberMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateCardNumberMultiError) AllErrors() []error { return m }
+func (m TransformPhoneNumberMultiError) AllErrors() []error { return m }
 
-// GenerateCardNumberValidationError is the validation error returned by
-// GenerateCardNumber.Validate if the designated constraints aren't met.
-type GenerateCardNumberValidationError struct {
+// TransformPhoneNumberValidationError is the validation error returned by
+// TransformPhoneNumber.Validate if the designated constraints aren't met.
+type TransformPhoneNumberValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4525,24 +8475,24 @@ type GenerateCardNumberValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateCardNumberValidationError) Field() string { return e.field }
+func (e TransformPhoneNumberValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateCardNumberValidationError) Reason() string { return e.reason }
+func (e TransformPhoneNumberValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateCardNumberValidationError) Cause() error { return e.cause }
+func (e TransformPhoneNumberValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateCardNumberValidationError) Key() bool { return e.key }
+func (e TransformPhoneNumberValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateCardNumberValidationError) ErrorName() string {
-	return "GenerateCardNumberValidationError"
+func (e TransformPhoneNumberValidationError) ErrorName() string {
+	return "TransformPhoneNumberValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateCardNumberValidationError) Error() string {
+func (e TransformPhoneNumberValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4554,14 +8504,14 @@ func (e GenerateCardNumberValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateCardNumber.%s: %s%s",
+		"invalid %sTransformPhoneNumber.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateCardNumberValidationError{}
+var _ error = TransformPhoneNumberValidationError{}
 
 var _ interface {
 	Field() string
@@ -4569,43 +8519,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateCardNumberValidationError{}
+} = TransformPhoneNumberValidationError{}
 
-// Validate checks the field values on GenerateCity with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GenerateCity) Validate() error {
+// Validate checks the field values on TransformString with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TransformString) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateCity with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GenerateCityMultiError, or
-// nil if none found.
-func (m *GenerateCity) ValidateAll() error {
+// ValidateAll checks the field values on TransformString with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransformStringMultiError, or nil if none found.
+func (m *TransformString) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateCity) validate(all bool) error {
+func (m *TransformString) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for PreserveLength
+
 	if len(errors) > 0 {
-		return GenerateCityMultiError(errors)
+		return TransformStringMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateCityMultiError is an error wrapping multiple validation errors
-// returned by GenerateCity.ValidateAll() if the designated constraints aren't met.
-type GenerateCityMultiError []error
+// TransformStringMultiError is an error wrapping multiple validation errors
+// returned by TransformString.ValidateAll() if the designated constraints
+// aren't met.
+type TransformStringMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateCityMultiError) Error() string {
+func (m TransformStringMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4614,11 +8567,11 @@ func (m GenerateCityMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateCityMultiError) AllErrors() []error { return m }
+func (m TransformStringMultiError) AllErrors() []error { return m }
 
-// GenerateCityValidationError is the validation error returned by
-// GenerateCity.Validate if the designated constraints aren't met.
-type GenerateCityValidationError struct {
+// TransformStringValidationError is the validation error returned by
+// TransformString.Validate if the designated constraints aren't met.
+type TransformStringValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4626,22 +8579,22 @@ type GenerateCityValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateCityValidationError) Field() string { return e.field }
+func (e TransformStringValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateCityValidationError) Reason() string { return e.reason }
+func (e TransformStringValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateCityValidationError) Cause() error { return e.cause }
+func (e TransformStringValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateCityValidationError) Key() bool { return e.key }
+func (e TransformStringValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateCityValidationError) ErrorName() string { return "GenerateCityValidationError" }
+func (e TransformStringValidationError) ErrorName() string { return "TransformStringValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GenerateCityValidationError) Error() string {
+func (e TransformStringValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4653,14 +8606,14 @@ func (e GenerateCityValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateCity.%s: %s%s",
+		"invalid %sTransformString.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateCityValidationError{}
+var _ error = TransformStringValidationError{}
 
 var _ interface {
 	Field() string
@@ -4668,24 +8621,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateCityValidationError{}
+} = TransformStringValidationError{}
 
-// Validate checks the field values on GenerateDefault with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GenerateDefault) Validate() error {
+// Validate checks the field values on Passthrough with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Passthrough) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateDefault with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GenerateDefaultMultiError, or nil if none found.
-func (m *GenerateDefault) ValidateAll() error {
+// ValidateAll checks the field values on Passthrough with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PassthroughMultiError, or
+// nil if none found.
+func (m *Passthrough) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateDefault) validate(all bool) error {
+func (m *Passthrough) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4693,19 +8646,18 @@ func (m *GenerateDefault) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return GenerateDefaultMultiError(errors)
+		return PassthroughMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateDefaultMultiError is an error wrapping multiple validation errors
-// returned by GenerateDefault.ValidateAll() if the designated constraints
-// aren't met.
-type GenerateDefaultMultiError []error
+// PassthroughMultiError is an error wrapping multiple validation errors
+// returned by Passthrough.ValidateAll() if the designated constraints aren't met.
+type PassthroughMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateDefaultMultiError) Error() string {
+func (m PassthroughMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4714,11 +8666,11 @@ func (m GenerateDefaultMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateDefaultMultiError) AllErrors() []error { return m }
+func (m PassthroughMultiError) AllErrors() []error { return m }
 
-// GenerateDefaultValidationError is the validation error returned by
-// GenerateDefault.Validate if the designated constraints aren't met.
-type GenerateDefaultValidationError struct {
+// PassthroughValidationError is the validation error returned by
+// Passthrough.Validate if the designated constraints aren't met.
+type PassthroughValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4726,22 +8678,22 @@ type GenerateDefaultValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateDefaultValidationError) Field() string { return e.field }
+func (e PassthroughValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateDefaultValidationError) Reason() string { return e.reason }
+func (e PassthroughValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateDefaultValidationError) Cause() error { return e.cause }
+func (e PassthroughValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateDefaultValidationError) Key() bool { return e.key }
+func (e PassthroughValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateDefaultValidationError) ErrorName() string { return "GenerateDefaultValidationError" }
+func (e PassthroughValidationError) ErrorName() string { return "PassthroughValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GenerateDefaultValidationError) Error() string {
+func (e PassthroughValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4753,14 +8705,14 @@ func (e GenerateDefaultValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateDefault.%s: %s%s",
+		"invalid %sPassthrough.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateDefaultValidationError{}
+var _ error = PassthroughValidationError{}
 
 var _ interface {
 	Field() string
@@ -4768,48 +8720,42 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateDefaultValidationError{}
+} = PassthroughValidationError{}
 
-// Validate checks the field values on GenerateE164PhoneNumber with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateE164PhoneNumber) Validate() error {
+// Validate checks the field values on Null with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Null) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateE164PhoneNumber with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GenerateE164PhoneNumberMultiError, or nil if none found.
-func (m *GenerateE164PhoneNumber) ValidateAll() error {
+// ValidateAll checks the field values on Null with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in NullMultiError, or nil if none found.
+func (m *Null) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateE164PhoneNumber) validate(all bool) error {
+func (m *Null) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Min
-
-	// no validation rules for Max
-
 	if len(errors) > 0 {
-		return GenerateE164PhoneNumberMultiError(errors)
+		return NullMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateE164PhoneNumberMultiError is an error wrapping multiple validation
-// errors returned by GenerateE164PhoneNumber.ValidateAll() if the designated
-// constraints aren't met.
-type GenerateE164PhoneNumberMultiError []error
+// NullMultiError is an error wrapping multiple validation errors returned by
+// Null.ValidateAll() if the designated constraints aren't met.
+type NullMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateE164PhoneNumberMultiError) Error() string {
+func (m NullMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4818,11 +8764,11 @@ func (m GenerateE164PhoneNumberMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateE164PhoneNumberMultiError) AllErrors() []error { return m }
+func (m NullMultiError) AllErrors() []error { return m }
 
-// GenerateE164PhoneNumberValidationError is the validation error returned by
-// GenerateE164PhoneNumber.Validate if the designated constraints aren't met.
-type GenerateE164PhoneNumberValidationError struct {
+// NullValidationError is the validation error returned by Null.Validate if the
+// designated constraints aren't met.
+type NullValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4830,24 +8776,22 @@ type GenerateE164PhoneNumberValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateE164PhoneNumberValidationError) Field() string { return e.field }
+func (e NullValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateE164PhoneNumberValidationError) Reason() string { return e.reason }
+func (e NullValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateE164PhoneNumberValidationError) Cause() error { return e.cause }
+func (e NullValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateE164PhoneNumberValidationError) Key() bool { return e.key }
+func (e NullValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateE164PhoneNumberValidationError) ErrorName() string {
-	return "GenerateE164PhoneNumberValidationError"
-}
+func (e NullValidationError) ErrorName() string { return "NullValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GenerateE164PhoneNumberValidationError) Error() string {
+func (e NullValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4859,14 +8803,14 @@ func (e GenerateE164PhoneNumberValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateE164PhoneNumber.%s: %s%s",
+		"invalid %sNull.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateE164PhoneNumberValidationError{}
+var _ error = NullValidationError{}
 
 var _ interface {
 	Field() string
@@ -4874,24 +8818,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateE164PhoneNumberValidationError{}
+} = NullValidationError{}
 
-// Validate checks the field values on GenerateFirstName with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GenerateFirstName) Validate() error {
+// Validate checks the field values on DropColumn with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DropColumn) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateFirstName with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GenerateFirstNameMultiError, or nil if none found.
-func (m *GenerateFirstName) ValidateAll() error {
+// ValidateAll checks the field values on DropColumn with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DropColumnMultiError, or
+// nil if none found.
+func (m *DropColumn) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateFirstName) validate(all bool) error {
+func (m *DropColumn) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4899,19 +8843,18 @@ func (m *GenerateFirstName) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return GenerateFirstNameMultiError(errors)
+		return DropColumnMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateFirstNameMultiError is an error wrapping multiple validation errors
-// returned by GenerateFirstName.ValidateAll() if the designated constraints
-// aren't met.
-type GenerateFirstNameMultiError []error
+// DropColumnMultiError is an error wrapping multiple validation errors
+// returned by DropColumn.ValidateAll() if the designated constraints aren't met.
+type DropColumnMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateFirstNameMultiError) Error() string {
+func (m DropColumnMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4920,11 +8863,11 @@ func (m GenerateFirstNameMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateFirstNameMultiError) AllErrors() []error { return m }
+func (m DropColumnMultiError) AllErrors() []error { return m }
 
-// GenerateFirstNameValidationError is the validation error returned by
-// GenerateFirstName.Validate if the designated constraints aren't met.
-type GenerateFirstNameValidationError struct {
+// DropColumnValidationError is the validation error returned by
+// DropColumn.Validate if the designated constraints aren't met.
+type DropColumnValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4932,24 +8875,22 @@ type GenerateFirstNameValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateFirstNameValidationError) Field() string { return e.field }
+func (e DropColumnValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateFirstNameValidationError) Reason() string { return e.reason }
+func (e DropColumnValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateFirstNameValidationError) Cause() error { return e.cause }
+func (e DropColumnValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateFirstNameValidationError) Key() bool { return e.key }
+func (e DropColumnValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateFirstNameValidationError) ErrorName() string {
-	return "GenerateFirstNameValidationError"
-}
+func (e DropColumnValidationError) ErrorName() string { return "DropColumnValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GenerateFirstNameValidationError) Error() string {
+func (e DropColumnValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4961,14 +8902,14 @@ func (e GenerateFirstNameValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateFirstName.%s: %s%s",
+		"invalid %sDropColumn.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateFirstNameValidationError{}
+var _ error = DropColumnValidationError{}
 
 var _ interface {
 	Field() string
@@ -4976,52 +8917,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateFirstNameValidationError{}
+} = DropColumnValidationError{}
 
-// Validate checks the field values on GenerateFloat64 with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GenerateFloat64) Validate() error {
+// Validate checks the field values on TransformJavascript with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransformJavascript) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateFloat64 with the rules
+// ValidateAll checks the field values on TransformJavascript with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateFloat64MultiError, or nil if none found.
-func (m *GenerateFloat64) ValidateAll() error {
+// TransformJavascriptMultiError, or nil if none found.
+func (m *TransformJavascript) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateFloat64) validate(all bool) error {
+func (m *TransformJavascript) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RandomizeSign
-
-	// no validation rules for Min
+	// no validation rules for Code
 
-	// no validation rules for Max
+	// no validation rules for BatchSize
 
-	// no validation rules for Precision
+	// no validation rules for Deterministic
 
 	if len(errors) > 0 {
-		return GenerateFloat64MultiError(errors)
+		return TransformJavascriptMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateFloat64MultiError is an error wrapping multiple validation errors
-// returned by GenerateFloat64.ValidateAll() if the designated constraints
-// aren't met.
-type GenerateFloat64MultiError []error
+// TransformJavascriptMultiError is an error wrapping multiple validation
+// errors returned by TransformJavascript.ValidateAll() if the designated
+// constraints aren't met.
+type TransformJavascriptMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateFloat64MultiError) Error() string {
+func (m TransformJavascriptMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5030,11 +8969,11 @@ func (m GenerateFloat64MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateFloat64MultiError) AllErrors() []error { return m }
+func (m TransformJavascriptMultiError) AllErrors() []error { return m }
 
-// GenerateFloat64ValidationError is the validation error returned by
-// GenerateFloat64.Validate if the designated constraints aren't met.
-type GenerateFloat64ValidationError struct {
+// TransformJavascriptValidationError is the validation error returned by
+// TransformJavascript.Validate if the designated constraints aren't met.
+type TransformJavascriptValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5042,22 +8981,24 @@ type GenerateFloat64ValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateFloat64ValidationError) Field() string { return e.field }
+func (e TransformJavascriptValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateFloat64ValidationError) Reason() string { return e.reason }
+func (e TransformJavascriptValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateFloat64ValidationError) Cause() error { return e.cause }
+func (e TransformJavascriptValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateFloat64ValidationError) Key() bool { return e.key }
+func (e TransformJavascriptValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateFloat64ValidationError) ErrorName() string { return "GenerateFloat64ValidationError" }
+func (e TransformJavascriptValidationError) ErrorName() string {
+	return "TransformJavascriptValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GenerateFloat64ValidationError) Error() string {
+func (e TransformJavascriptValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5069,14 +9010,14 @@ func (e GenerateFloat64ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateFloat64.%s: %s%s",
+		"invalid %sTransformJavascript.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateFloat64ValidationError{}
+var _ error = TransformJavascriptValidationError{}
 
 var _ interface {
 	Field() string
@@ -5084,44 +9025,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateFloat64ValidationError{}
+} = TransformJavascriptValidationError{}
 
-// Validate checks the field values on GenerateFullAddress with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UserDefinedTransformerConfig with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateFullAddress) Validate() error {
+func (m *UserDefinedTransformerConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateFullAddress with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UserDefinedTransformerConfig with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateFullAddressMultiError, or nil if none found.
-func (m *GenerateFullAddress) ValidateAll() error {
+// UserDefinedTransformerConfigMultiError, or nil if none found.
+func (m *UserDefinedTransformerConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateFullAddress) validate(all bool) error {
+func (m *UserDefinedTransformerConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
 	if len(errors) > 0 {
-		return GenerateFullAddressMultiError(errors)
+		return UserDefinedTransformerConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateFullAddressMultiError is an error wrapping multiple validation
-// errors returned by GenerateFullAddress.ValidateAll() if the designated
-// constraints aren't met.
-type GenerateFullAddressMultiError []error
+// UserDefinedTransformerConfigMultiError is an error wrapping multiple
+// validation errors returned by UserDefinedTransformerConfig.ValidateAll() if
+// the designated constraints aren't met.
+type UserDefinedTransformerConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateFullAddressMultiError) Error() string {
+func (m UserDefinedTransformerConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5130,11 +9073,12 @@ func (m GenerateFullAddressMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateFullAddressMultiError) AllErrors() []error { return m }
+func (m UserDefinedTransformerConfigMultiError) AllErrors() []error { return m }
 
-// GenerateFullAddressValidationError is the validation error returned by
-// GenerateFullAddress.Validate if the designated constraints aren't met.
-type GenerateFullAddressValidationError struct {
+// UserDefinedTransformerConfigValidationError is the validation error returned
+// by UserDefinedTransformerConfig.Validate if the designated constraints
+// aren't met.
+type UserDefinedTransformerConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5142,24 +9086,24 @@ type GenerateFullAddressValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateFullAddressValidationError) Field() string { return e.field }
+func (e UserDefinedTransformerConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateFullAddressValidationError) Reason() string { return e.reason }
+func (e UserDefinedTransformerConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateFullAddressValidationError) Cause() error { return e.cause }
+func (e UserDefinedTransformerConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateFullAddressValidationError) Key() bool { return e.key }
+func (e UserDefinedTransformerConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateFullAddressValidationError) ErrorName() string {
-	return "GenerateFullAddressValidationError"
+func (e UserDefinedTransformerConfigValidationError) ErrorName() string {
+	return "UserDefinedTransformerConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateFullAddressValidationError) Error() string {
+func (e UserDefinedTransformerConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5171,14 +9115,14 @@ func (e GenerateFullAddressValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateFullAddress.%s: %s%s",
+		"invalid %sUserDefinedTransformerConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateFullAddressValidationError{}
+var _ error = UserDefinedTransformerConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -5186,44 +9130,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateFullAddressValidationError{}
+} = UserDefinedTransformerConfigValidationError{}
 
-// Validate checks the field values on GenerateFullName with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GenerateFullName) Validate() error {
+// Validate checks the field values on ValidateUserJavascriptCodeRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ValidateUserJavascriptCodeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateFullName with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GenerateFullNameMultiError, or nil if none found.
-func (m *GenerateFullName) ValidateAll() error {
+// ValidateAll checks the field values on ValidateUserJavascriptCodeRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ValidateUserJavascriptCodeRequestMultiError, or nil if none found.
+func (m *ValidateUserJavascriptCodeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateFullName) validate(all bool) error {
+func (m *ValidateUserJavascriptCodeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for AccountId
+
+	// no validation rules for Code
+
 	if len(errors) > 0 {
-		return GenerateFullNameMultiError(errors)
+		return ValidateUserJavascriptCodeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateFullNameMultiError is an error wrapping multiple validation errors
-// returned by GenerateFullName.ValidateAll() if the designated constraints
-// aren't met.
-type GenerateFullNameMultiError []error
+// ValidateUserJavascriptCodeRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ValidateUserJavascriptCodeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ValidateUserJavascriptCodeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateFullNameMultiError) Error() string {
+func (m ValidateUserJavascriptCodeRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5232,11 +9182,12 @@ func (m GenerateFullNameMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateFullNameMultiError) AllErrors() []error { return m }
+func (m ValidateUserJavascriptCodeRequestMultiError) AllErrors() []error { return m }
 
-// GenerateFullNameValidationError is the validation error returned by
-// GenerateFullName.Validate if the designated constraints aren't met.
-type GenerateFullNameValidationError struct {
+// ValidateUserJavascriptCodeRequestValidationError is the validation error
+// returned by ValidateUserJavascriptCodeRequest.Validate if the designated
+// constraints aren't met.
+type ValidateUserJavascriptCodeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5244,22 +9195,24 @@ type GenerateFullNameValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateFullNameValidationError) Field() string { return e.field }
+func (e ValidateUserJavascriptCodeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateFullNameValidationError) Reason() string { return e.reason }
+func (e ValidateUserJavascriptCodeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateFullNameValidationError) Cause() error { return e.cause }
+func (e ValidateUserJavascriptCodeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateFullNameValidationError) Key() bool { return e.key }
+func (e ValidateUserJavascriptCodeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateFullNameValidationError) ErrorName() string { return "GenerateFullNameValidationError" }
+func (e ValidateUserJavascriptCodeRequestValidationError) ErrorName() string {
+	return "ValidateUserJavascriptCodeRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GenerateFullNameValidationError) Error() string {
+func (e ValidateUserJavascriptCodeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5271,14 +9224,14 @@ func (e GenerateFullNameValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateFullName.%s: %s%s",
+		"invalid %sValidateUserJavascriptCodeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateFullNameValidationError{}
+var _ error = ValidateUserJavascriptCodeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5286,46 +9239,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateFullNameValidationError{}
+} = ValidateUserJavascriptCodeRequestValidationError{}
 
-// Validate checks the field values on GenerateGender with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GenerateGender) Validate() error {
+// Validate checks the field values on ValidateUserJavascriptCodeResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ValidateUserJavascriptCodeResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateGender with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GenerateGenderMultiError,
-// or nil if none found.
-func (m *GenerateGender) ValidateAll() error {
+// ValidateAll checks the field values on ValidateUserJavascriptCodeResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ValidateUserJavascriptCodeResponseMultiError, or nil if none found.
+func (m *ValidateUserJavascriptCodeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateGender) validate(all bool) error {
+func (m *ValidateUserJavascriptCodeResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Abbreviate
+	// no validation rules for Valid
 
 	if len(errors) > 0 {
-		return GenerateGenderMultiError(errors)
+		return ValidateUserJavascriptCodeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateGenderMultiError is an error wrapping multiple validation errors
-// returned by GenerateGender.ValidateAll() if the designated constraints
-// aren't met.
-type GenerateGenderMultiError []error
+// ValidateUserJavascriptCodeResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ValidateUserJavascriptCodeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ValidateUserJavascriptCodeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateGenderMultiError) Error() string {
+func (m ValidateUserJavascriptCodeResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5334,11 +9289,12 @@ func (m GenerateGenderMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateGenderMultiError) AllErrors() []error { return m }
+func (m ValidateUserJavascriptCodeResponseMultiError) AllErrors() []error { return m }
 
-// GenerateGenderValidationError is the validation error returned by
-// GenerateGender.Validate if the designated constraints aren't met.
-type GenerateGenderValidationError struct {
+// ValidateUserJavascriptCodeResponseValidationError is the validation error
+// returned by ValidateUserJavascriptCodeResponse.Validate if the designated
+// constraints aren't met.
+type ValidateUserJavascriptCodeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5346,22 +9302,24 @@ type GenerateGenderValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateGenderValidationError) Field() string { return e.field }
+func (e ValidateUserJavascriptCodeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateGenderValidationError) Reason() string { return e.reason }
+func (e ValidateUserJavascriptCodeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateGenderValidationError) Cause() error { return e.cause }
+func (e ValidateUserJavascriptCodeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateGenderValidationError) Key() bool { return e.key }
+func (e ValidateUserJavascriptCodeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateGenderValidationError) ErrorName() string { return "GenerateGenderValidationError" }
+func (e ValidateUserJavascriptCodeResponseValidationError) ErrorName() string {
+	return "ValidateUserJavascriptCodeResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GenerateGenderValidationError) Error() string {
+func (e ValidateUserJavascriptCodeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5373,14 +9331,14 @@ func (e GenerateGenderValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateGender.%s: %s%s",
+		"invalid %sValidateUserJavascriptCodeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateGenderValidationError{}
+var _ error = ValidateUserJavascriptCodeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5388,44 +9346,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateGenderValidationError{}
+} = ValidateUserJavascriptCodeResponseValidationError{}
 
-// Validate checks the field values on GenerateInt64PhoneNumber with the rules
+// Validate checks the field values on GenerateCategorical with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateInt64PhoneNumber) Validate() error {
+func (m *GenerateCategorical) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateInt64PhoneNumber with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GenerateCategorical with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateInt64PhoneNumberMultiError, or nil if none found.
-func (m *GenerateInt64PhoneNumber) ValidateAll() error {
+// GenerateCategoricalMultiError, or nil if none found.
+func (m *GenerateCategorical) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateInt64PhoneNumber) validate(all bool) error {
+func (m *GenerateCategorical) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Categories
+
 	if len(errors) > 0 {
-		return GenerateInt64PhoneNumberMultiError(errors)
+		return GenerateCategoricalMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateInt64PhoneNumberMultiError is an error wrapping multiple validation
-// errors returned by GenerateInt64PhoneNumber.ValidateAll() if the designated
+// GenerateCategoricalMultiError is an error wrapping multiple validation
+// errors returned by GenerateCategorical.ValidateAll() if the designated
 // constraints aren't met.
-type GenerateInt64PhoneNumberMultiError []error
+type GenerateCategoricalMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateInt64PhoneNumberMultiError) Error() string {
+func (m GenerateCategoricalMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5434,11 +9394,11 @@ func (m GenerateInt64PhoneNumberMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateInt64PhoneNumberMultiError) AllErrors() []error { return m }
+func (m GenerateCategoricalMultiError) AllErrors() []error { return m }
 
-// GenerateInt64PhoneNumberValidationError is the validation error returned by
-// GenerateInt64PhoneNumber.Validate if the designated constraints aren't met.
-type GenerateInt64PhoneNumberValidationError struct {
+// GenerateCategoricalValidationError is the validation error returned by
+// GenerateCategorical.Validate if the designated constraints aren't met.
+type GenerateCategoricalValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5446,24 +9406,24 @@ type GenerateInt64PhoneNumberValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateInt64PhoneNumberValidationError) Field() string { return e.field }
+func (e GenerateCategoricalValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateInt64PhoneNumberValidationError) Reason() string { return e.reason }
+func (e GenerateCategoricalValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateInt64PhoneNumberValidationError) Cause() error { return e.cause }
+func (e GenerateCategoricalValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateInt64PhoneNumberValidationError) Key() bool { return e.key }
+func (e GenerateCategoricalValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateInt64PhoneNumberValidationError) ErrorName() string {
-	return "GenerateInt64PhoneNumberValidationError"
+func (e GenerateCategoricalValidationError) ErrorName() string {
+	return "GenerateCategoricalValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateInt64PhoneNumberValidationError) Error() string {
+func (e GenerateCategoricalValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5475,14 +9435,14 @@ func (e GenerateInt64PhoneNumberValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateInt64PhoneNumber.%s: %s%s",
+		"invalid %sGenerateCategorical.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateInt64PhoneNumberValidationError{}
+var _ error = GenerateCategoricalValidationError{}
 
 var _ interface {
 	Field() string
@@ -5490,50 +9450,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateInt64PhoneNumberValidationError{}
+} = GenerateCategoricalValidationError{}
 
-// Validate checks the field values on GenerateInt64 with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GenerateInt64) Validate() error {
+// Validate checks the field values on TransformCharacterScramble with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransformCharacterScramble) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateInt64 with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GenerateInt64MultiError, or
-// nil if none found.
-func (m *GenerateInt64) ValidateAll() error {
+// ValidateAll checks the field values on TransformCharacterScramble with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransformCharacterScrambleMultiError, or nil if none found.
+func (m *TransformCharacterScramble) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateInt64) validate(all bool) error {
+func (m *TransformCharacterScramble) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RandomizeSign
-
-	// no validation rules for Min
-
-	// no validation rules for Max
+	if m.UserProvidedRegex != nil {
+		// no validation rules for UserProvidedRegex
+	}
 
 	if len(errors) > 0 {
-		return GenerateInt64MultiError(errors)
+		return TransformCharacterScrambleMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateInt64MultiError is an error wrapping multiple validation errors
-// returned by GenerateInt64.ValidateAll() if the designated constraints
-// aren't met.
-type GenerateInt64MultiError []error
+// TransformCharacterScrambleMultiError is an error wrapping multiple
+// validation errors returned by TransformCharacterScramble.ValidateAll() if
+// the designated constraints aren't met.
+type TransformCharacterScrambleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateInt64MultiError) Error() string {
+func (m TransformCharacterScrambleMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5542,11 +9500,11 @@ func (m GenerateInt64MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateInt64MultiError) AllErrors() []error { return m }
+func (m TransformCharacterScrambleMultiError) AllErrors() []error { return m }
 
-// GenerateInt64ValidationError is the validation error returned by
-// GenerateInt64.Validate if the designated constraints aren't met.
-type GenerateInt64ValidationError struct {
+// TransformCharacterScrambleValidationError is the validation error returned
+// by TransformCharacterScramble.Validate if the designated constraints aren't met.
+type TransformCharacterScrambleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5554,22 +9512,24 @@ type GenerateInt64ValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateInt64ValidationError) Field() string { return e.field }
+func (e TransformCharacterScrambleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateInt64ValidationError) Reason() string { return e.reason }
+func (e TransformCharacterScrambleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateInt64ValidationError) Cause() error { return e.cause }
+func (e TransformCharacterScrambleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateInt64ValidationError) Key() bool { return e.key }
+func (e TransformCharacterScrambleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateInt64ValidationError) ErrorName() string { return "GenerateInt64ValidationError" }
+func (e TransformCharacterScrambleValidationError) ErrorName() string {
+	return "TransformCharacterScrambleValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GenerateInt64ValidationError) Error() string {
+func (e TransformCharacterScrambleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5581,14 +9541,14 @@ func (e GenerateInt64ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateInt64.%s: %s%s",
+		"invalid %sTransformCharacterScramble.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateInt64ValidationError{}
+var _ error = TransformCharacterScrambleValidationError{}
 
 var _ interface {
 	Field() string
@@ -5596,44 +9556,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateInt64ValidationError{}
+} = TransformCharacterScrambleValidationError{}
 
-// Validate checks the field values on GenerateLastName with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GenerateLastName) Validate() error {
+// Validate checks the field values on GenerateJavascript with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateJavascript) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateLastName with the rules
+// ValidateAll checks the field values on GenerateJavascript with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateLastNameMultiError, or nil if none found.
-func (m *GenerateLastName) ValidateAll() error {
+// GenerateJavascriptMultiError, or nil if none found.
+func (m *GenerateJavascript) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateLastName) validate(all bool) error {
+func (m *GenerateJavascript) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Code
+
 	if len(errors) > 0 {
-		return GenerateLastNameMultiError(errors)
+		return GenerateJavascriptMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateLastNameMultiError is an error wrapping multiple validation errors
-// returned by GenerateLastName.ValidateAll() if the designated constraints
+// GenerateJavascriptMultiError is an error wrapping multiple validation errors
+// returned by GenerateJavascript.ValidateAll() if the designated constraints
 // aren't met.
-type GenerateLastNameMultiError []error
+type GenerateJavascriptMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateLastNameMultiError) Error() string {
+func (m GenerateJavascriptMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5642,11 +9604,11 @@ func (m GenerateLastNameMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateLastNameMultiError) AllErrors() []error { return m }
+func (m GenerateJavascriptMultiError) AllErrors() []error { return m }
 
-// GenerateLastNameValidationError is the validation error returned by
-// GenerateLastName.Validate if the designated constraints aren't met.
-type GenerateLastNameValidationError struct {
+// GenerateJavascriptValidationError is the validation error returned by
+// GenerateJavascript.Validate if the designated constraints aren't met.
+type GenerateJavascriptValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5654,22 +9616,24 @@ type GenerateLastNameValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateLastNameValidationError) Field() string { return e.field }
+func (e GenerateJavascriptValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateLastNameValidationError) Reason() string { return e.reason }
+func (e GenerateJavascriptValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateLastNameValidationError) Cause() error { return e.cause }
+func (e GenerateJavascriptValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateLastNameValidationError) Key() bool { return e.key }
+func (e GenerateJavascriptValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateLastNameValidationError) ErrorName() string { return "GenerateLastNameValidationError" }
+func (e GenerateJavascriptValidationError) ErrorName() string {
+	return "GenerateJavascriptValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GenerateLastNameValidationError) Error() string {
+func (e GenerateJavascriptValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5681,14 +9645,14 @@ func (e GenerateLastNameValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateLastName.%s: %s%s",
+		"invalid %sGenerateJavascript.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateLastNameValidationError{}
+var _ error = GenerateJavascriptValidationError{}
 
 var _ interface {
 	Field() string
@@ -5696,44 +9660,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateLastNameValidationError{}
+} = GenerateJavascriptValidationError{}
 
-// Validate checks the field values on GenerateSha256Hash with the rules
+// Validate checks the field values on GenerateStaticValue with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateSha256Hash) Validate() error {
+func (m *GenerateStaticValue) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateSha256Hash with the rules
+// ValidateAll checks the field values on GenerateStaticValue with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateSha256HashMultiError, or nil if none found.
-func (m *GenerateSha256Hash) ValidateAll() error {
+// GenerateStaticValueMultiError, or nil if none found.
+func (m *GenerateStaticValue) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateSha256Hash) validate(all bool) error {
+func (m *GenerateStaticValue) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Value
+
 	if len(errors) > 0 {
-		return GenerateSha256HashMultiError(errors)
+		return GenerateStaticValueMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateSha256HashMultiError is an error wrapping multiple validation errors
-// returned by GenerateSha256Hash.ValidateAll() if the designated constraints
-// aren't met.
-type GenerateSha256HashMultiError []error
+// GenerateStaticValueMultiError is an error wrapping multiple validation
+// errors returned by GenerateStaticValue.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateStaticValueMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateSha256HashMultiError) Error() string {
+func (m GenerateStaticValueMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5742,11 +9708,11 @@ func (m GenerateSha256HashMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateSha256HashMultiError) AllErrors() []error { return m }
+func (m GenerateStaticValueMultiError) AllErrors() []error { return m }
 
-// GenerateSha256HashValidationError is the validation error returned by
-// GenerateSha256Hash.Validate if the designated constraints aren't met.
-type GenerateSha256HashValidationError struct {
+// GenerateStaticValueValidationError is the validation error returned by
+// GenerateStaticValue.Validate if the designated constraints aren't met.
+type GenerateStaticValueValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5754,24 +9720,24 @@ type GenerateSha256HashValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateSha256HashValidationError) Field() string { return e.field }
+func (e GenerateStaticValueValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateSha256HashValidationError) Reason() string { return e.reason }
+func (e GenerateStaticValueValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateSha256HashValidationError) Cause() error { return e.cause }
+func (e GenerateStaticValueValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateSha256HashValidationError) Key() bool { return e.key }
+func (e GenerateStaticValueValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateSha256HashValidationError) ErrorName() string {
-	return "GenerateSha256HashValidationError"
+func (e GenerateStaticValueValidationError) ErrorName() string {
+	return "GenerateStaticValueValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateSha256HashValidationError) Error() string {
+func (e GenerateStaticValueValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5783,14 +9749,14 @@ func (e GenerateSha256HashValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateSha256Hash.%s: %s%s",
+		"invalid %sGenerateStaticValue.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateSha256HashValidationError{}
+var _ error = GenerateStaticValueValidationError{}
 
 var _ interface {
 	Field() string
@@ -5798,43 +9764,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateSha256HashValidationError{}
+} = GenerateStaticValueValidationError{}
 
-// Validate checks the field values on GenerateSSN with the rules defined in
+// Validate checks the field values on SqlExpression with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *GenerateSSN) Validate() error {
+func (m *SqlExpression) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateSSN with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GenerateSSNMultiError, or
+// ValidateAll checks the field values on SqlExpression with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SqlExpressionMultiError, or
 // nil if none found.
-func (m *GenerateSSN) ValidateAll() error {
+func (m *SqlExpression) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateSSN) validate(all bool) error {
+func (m *SqlExpression) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Expression
+
 	if len(errors) > 0 {
-		return GenerateSSNMultiError(errors)
+		return SqlExpressionMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateSSNMultiError is an error wrapping multiple validation errors
-// returned by GenerateSSN.ValidateAll() if the designated constraints aren't met.
-type GenerateSSNMultiError []error
+// SqlExpressionMultiError is an error wrapping multiple validation errors
+// returned by SqlExpression.ValidateAll() if the designated constraints
+// aren't met.
+type SqlExpressionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateSSNMultiError) Error() string {
+func (m SqlExpressionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5843,11 +9812,11 @@ func (m GenerateSSNMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateSSNMultiError) AllErrors() []error { return m }
+func (m SqlExpressionMultiError) AllErrors() []error { return m }
 
-// GenerateSSNValidationError is the validation error returned by
-// GenerateSSN.Validate if the designated constraints aren't met.
-type GenerateSSNValidationError struct {
+// SqlExpressionValidationError is the validation error returned by
+// SqlExpression.Validate if the designated constraints aren't met.
+type SqlExpressionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5855,22 +9824,22 @@ type GenerateSSNValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateSSNValidationError) Field() string { return e.field }
+func (e SqlExpressionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateSSNValidationError) Reason() string { return e.reason }
+func (e SqlExpressionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateSSNValidationError) Cause() error { return e.cause }
+func (e SqlExpressionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateSSNValidationError) Key() bool { return e.key }
+func (e SqlExpressionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateSSNValidationError) ErrorName() string { return "GenerateSSNValidationError" }
+func (e SqlExpressionValidationError) ErrorName() string { return "SqlExpressionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GenerateSSNValidationError) Error() string {
+func (e SqlExpressionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5882,14 +9851,14 @@ func (e GenerateSSNValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateSSN.%s: %s%s",
+		"invalid %sSqlExpression.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateSSNValidationError{}
+var _ error = SqlExpressionValidationError{}
 
 var _ interface {
 	Field() string
@@ -5897,44 +9866,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateSSNValidationError{}
+} = SqlExpressionValidationError{}
 
-// Validate checks the field values on GenerateState with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GenerateState) Validate() error {
+// Validate checks the field values on ValidateUserRegexCodeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidateUserRegexCodeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateState with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GenerateStateMultiError, or
-// nil if none found.
-func (m *GenerateState) ValidateAll() error {
+// ValidateAll checks the field values on ValidateUserRegexCodeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidateUserRegexCodeRequestMultiError, or nil if none found.
+func (m *ValidateUserRegexCodeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateState) validate(all bool) error {
+func (m *ValidateUserRegexCodeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for AccountId
+
+	// no validation rules for UserProvidedRegex
+
 	if len(errors) > 0 {
-		return GenerateStateMultiError(errors)
+		return ValidateUserRegexCodeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateStateMultiError is an error wrapping multiple validation errors
-// returned by GenerateState.ValidateAll() if the designated constraints
-// aren't met.
-type GenerateStateMultiError []error
+// ValidateUserRegexCodeRequestMultiError is an error wrapping multiple
+// validation errors returned by ValidateUserRegexCodeRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ValidateUserRegexCodeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateStateMultiError) Error() string {
+func (m ValidateUserRegexCodeRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5943,11 +9916,12 @@ func (m GenerateStateMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateStateMultiError) AllErrors() []error { return m }
+func (m ValidateUserRegexCodeRequestMultiError) AllErrors() []error { return m }
 
-// GenerateStateValidationError is the validation error returned by
-// GenerateState.Validate if the designated constraints aren't met.
-type GenerateStateValidationError struct {
+// ValidateUserRegexCodeRequestValidationError is the validation error returned
+// by ValidateUserRegexCodeRequest.Validate if the designated constraints
+// aren't met.
+type ValidateUserRegexCodeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5955,22 +9929,24 @@ type GenerateStateValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateStateValidationError) Field() string { return e.field }
+func (e ValidateUserRegexCodeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateStateValidationError) Reason() string { return e.reason }
+func (e ValidateUserRegexCodeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateStateValidationError) Cause() error { return e.cause }
+func (e ValidateUserRegexCodeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateStateValidationError) Key() bool { return e.key }
+func (e ValidateUserRegexCodeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateStateValidationError) ErrorName() string { return "GenerateStateValidationError" }
+func (e ValidateUserRegexCodeRequestValidationError) ErrorName() string {
+	return "ValidateUserRegexCodeRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GenerateStateValidationError) Error() string {
+func (e ValidateUserRegexCodeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5982,14 +9958,14 @@ func (e GenerateStateValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateState.%s: %s%s",
+		"invalid %sValidateUserRegexCodeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateStateValidationError{}
+var _ error = ValidateUserRegexCodeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5997,44 +9973,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateStateValidationError{}
+} = ValidateUserRegexCodeRequestValidationError{}
 
-// Validate checks the field values on GenerateStreetAddress with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ValidateUserRegexCodeResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateStreetAddress) Validate() error {
+func (m *ValidateUserRegexCodeResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateStreetAddress with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GenerateStreetAddressMultiError, or nil if none found.
-func (m *GenerateStreetAddress) ValidateAll() error {
+// ValidateAll checks the field values on ValidateUserRegexCodeResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ValidateUserRegexCodeResponseMultiError, or nil if none found.
+func (m *ValidateUserRegexCodeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateStreetAddress) validate(all bool) error {
+func (m *ValidateUserRegexCodeResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Valid
+
 	if len(errors) > 0 {
-		return GenerateStreetAddressMultiError(errors)
+		return ValidateUserRegexCodeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateStreetAddressMultiError is an error wrapping multiple validation
-// errors returned by GenerateStreetAddress.ValidateAll() if the designated
-// constraints aren't met.
-type GenerateStreetAddressMultiError []error
+// ValidateUserRegexCodeResponseMultiError is an error wrapping multiple
+// validation errors returned by ValidateUserRegexCodeResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ValidateUserRegexCodeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateStreetAddressMultiError) Error() string {
+func (m ValidateUserRegexCodeResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6043,11 +10021,12 @@ func (m GenerateStreetAddressMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateStreetAddressMultiError) AllErrors() []error { return m }
+func (m ValidateUserRegexCodeResponseMultiError) AllErrors() []error { return m }
 
-// GenerateStreetAddressValidationError is the validation error returned by
-// GenerateStreetAddress.Validate if the designated constraints aren't met.
-type GenerateStreetAddressValidationError struct {
+// ValidateUserRegexCodeResponseValidationError is the validation error
+// returned by ValidateUserRegexCodeResponse.Validate if the designated
+// constraints aren't met.
+type ValidateUserRegexCodeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6055,24 +10034,24 @@ type GenerateStreetAddressValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateStreetAddressValidationError) Field() string { return e.field }
+func (e ValidateUserRegexCodeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateStreetAddressValidationError) Reason() string { return e.reason }
+func (e ValidateUserRegexCodeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateStreetAddressValidationError) Cause() error { return e.cause }
+func (e ValidateUserRegexCodeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateStreetAddressValidationError) Key() bool { return e.key }
+func (e ValidateUserRegexCodeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateStreetAddressValidationError) ErrorName() string {
-	return "GenerateStreetAddressValidationError"
+func (e ValidateUserRegexCodeResponseValidationError) ErrorName() string {
+	return "ValidateUserRegexCodeResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateStreetAddressValidationError) Error() string {
+func (e ValidateUserRegexCodeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6084,14 +10063,14 @@ func (e GenerateStreetAddressValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateStreetAddress.%s: %s%s",
+		"invalid %sValidateUserRegexCodeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateStreetAddressValidationError{}
+var _ error = ValidateUserRegexCodeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6099,48 +10078,51 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateStreetAddressValidationError{}
+} = ValidateUserRegexCodeResponseValidationError{}
 
-// Validate checks the field values on GenerateStringPhoneNumber with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateStringPhoneNumber) Validate() error {
+// Validate checks the field values on SetUserDefinedTransformerLabelsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SetUserDefinedTransformerLabelsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateStringPhoneNumber with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GenerateStringPhoneNumberMultiError, or nil if none found.
-func (m *GenerateStringPhoneNumber) ValidateAll() error {
+// ValidateAll checks the field values on
+// SetUserDefinedTransformerLabelsRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// SetUserDefinedTransformerLabelsRequestMultiError, or nil if none found.
+func (m *SetUserDefinedTransformerLabelsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateStringPhoneNumber) validate(all bool) error {
+func (m *SetUserDefinedTransformerLabelsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Min
+	// no validation rules for TransformerId
 
-	// no validation rules for Max
+	// no validation rules for Labels
 
 	if len(errors) > 0 {
-		return GenerateStringPhoneNumberMultiError(errors)
+		return SetUserDefinedTransformerLabelsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateStringPhoneNumberMultiError is an error wrapping multiple validation
-// errors returned by GenerateStringPhoneNumber.ValidateAll() if the
-// designated constraints aren't met.
-type GenerateStringPhoneNumberMultiError []error
+// SetUserDefinedTransformerLabelsRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// SetUserDefinedTransformerLabelsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetUserDefinedTransformerLabelsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateStringPhoneNumberMultiError) Error() string {
+func (m SetUserDefinedTransformerLabelsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6149,11 +10131,12 @@ func (m GenerateStringPhoneNumberMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateStringPhoneNumberMultiError) AllErrors() []error { return m }
+func (m SetUserDefinedTransformerLabelsRequestMultiError) AllErrors() []error { return m }
 
-// GenerateStringPhoneNumberValidationError is the validation error returned by
-// GenerateStringPhoneNumber.Validate if the designated constraints aren't met.
-type GenerateStringPhoneNumberValidationError struct {
+// SetUserDefinedTransformerLabelsRequestValidationError is the validation
+// error returned by SetUserDefinedTransformerLabelsRequest.Validate if the
+// designated constraints aren't met.
+type SetUserDefinedTransformerLabelsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6161,24 +10144,24 @@ type GenerateStringPhoneNumberValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateStringPhoneNumberValidationError) Field() string { return e.field }
+func (e SetUserDefinedTransformerLabelsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateStringPhoneNumberValidationError) Reason() string { return e.reason }
+func (e SetUserDefinedTransformerLabelsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateStringPhoneNumberValidationError) Cause() error { return e.cause }
+func (e SetUserDefinedTransformerLabelsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateStringPhoneNumberValidationError) Key() bool { return e.key }
+func (e SetUserDefinedTransformerLabelsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateStringPhoneNumberValidationError) ErrorName() string {
-	return "GenerateStringPhoneNumberValidationError"
+func (e SetUserDefinedTransformerLabelsRequestValidationError) ErrorName() string {
+	return "SetUserDefinedTransformerLabelsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateStringPhoneNumberValidationError) Error() string {
+func (e SetUserDefinedTransformerLabelsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6190,14 +10173,14 @@ func (e GenerateStringPhoneNumberValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateStringPhoneNumber.%s: %s%s",
+		"invalid %sSetUserDefinedTransformerLabelsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateStringPhoneNumberValidationError{}
+var _ error = SetUserDefinedTransformerLabelsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6205,48 +10188,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateStringPhoneNumberValidationError{}
+} = SetUserDefinedTransformerLabelsRequestValidationError{}
 
-// Validate checks the field values on GenerateString with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GenerateString) Validate() error {
+// Validate checks the field values on SetUserDefinedTransformerLabelsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SetUserDefinedTransformerLabelsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateString with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GenerateStringMultiError,
-// or nil if none found.
-func (m *GenerateString) ValidateAll() error {
+// ValidateAll checks the field values on
+// SetUserDefinedTransformerLabelsResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// SetUserDefinedTransformerLabelsResponseMultiError, or nil if none found.
+func (m *SetUserDefinedTransformerLabelsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateString) validate(all bool) error {
+func (m *SetUserDefinedTransformerLabelsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Min
-
-	// no validation rules for Max
+	if all {
+		switch v := interface{}(m.GetTransformer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetUserDefinedTransformerLabelsResponseValidationError{
+					field:  "Transformer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetUserDefinedTransformerLabelsResponseValidationError{
+					field:  "Transformer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransformer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetUserDefinedTransformerLabelsResponseValidationError{
+				field:  "Transformer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GenerateStringMultiError(errors)
+		return SetUserDefinedTransformerLabelsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateStringMultiError is an error wrapping multiple validation errors
-// returned by GenerateString.ValidateAll() if the designated constraints
-// aren't met.
-type GenerateStringMultiError []error
+// SetUserDefinedTransformerLabelsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// SetUserDefinedTransformerLabelsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetUserDefinedTransformerLabelsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateStringMultiError) Error() string {
+func (m SetUserDefinedTransformerLabelsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6255,11 +10266,12 @@ func (m GenerateStringMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateStringMultiError) AllErrors() []error { return m }
+func (m SetUserDefinedTransformerLabelsResponseMultiError) AllErrors() []error { return m }
 
-// GenerateStringValidationError is the validation error returned by
-// GenerateString.Validate if the designated constraints aren't met.
-type GenerateStringValidationError struct {
+// SetUserDefinedTransformerLabelsResponseValidationError is the validation
+// error returned by SetUserDefinedTransformerLabelsResponse.Validate if the
+// designated constraints aren't met.
+type SetUserDefinedTransformerLabelsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6267,22 +10279,24 @@ type GenerateStringValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateStringValidationError) Field() string { return e.field }
+func (e SetUserDefinedTransformerLabelsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateStringValidationError) Reason() string { return e.reason }
+func (e SetUserDefinedTransformerLabelsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateStringValidationError) Cause() error { return e.cause }
+func (e SetUserDefinedTransformerLabelsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateStringValidationError) Key() bool { return e.key }
+func (e SetUserDefinedTransformerLabelsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateStringValidationError) ErrorName() string { return "GenerateStringValidationError" }
+func (e SetUserDefinedTransformerLabelsResponseValidationError) ErrorName() string {
+	return "SetUserDefinedTransformerLabelsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GenerateStringValidationError) Error() string {
+func (e SetUserDefinedTransformerLabelsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6294,14 +10308,14 @@ func (e GenerateStringValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateString.%s: %s%s",
+		"invalid %sSetUserDefinedTransformerLabelsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateStringValidationError{}
+var _ error = SetUserDefinedTransformerLabelsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6309,44 +10323,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateStringValidationError{}
+} = SetUserDefinedTransformerLabelsResponseValidationError{}
 
-// Validate checks the field values on GenerateUnixTimestamp with the rules
+// Validate checks the field values on TransformGeoCoordinate with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateUnixTimestamp) Validate() error {
+func (m *TransformGeoCoordinate) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateUnixTimestamp with the rules
+// ValidateAll checks the field values on TransformGeoCoordinate with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateUnixTimestampMultiError, or nil if none found.
-func (m *GenerateUnixTimestamp) ValidateAll() error {
+// TransformGeoCoordinateMultiError, or nil if none found.
+func (m *TransformGeoCoordinate) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateUnixTimestamp) validate(all bool) error {
+func (m *TransformGeoCoordinate) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for RadiusMeters
+
+	// no validation rules for SnapToCityCentroid
+
 	if len(errors) > 0 {
-		return GenerateUnixTimestampMultiError(errors)
+		return TransformGeoCoordinateMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateUnixTimestampMultiError is an error wrapping multiple validation
-// errors returned by GenerateUnixTimestamp.ValidateAll() if the designated
+// TransformGeoCoordinateMultiError is an error wrapping multiple validation
+// errors returned by TransformGeoCoordinate.ValidateAll() if the designated
 // constraints aren't met.
-type GenerateUnixTimestampMultiError []error
+type TransformGeoCoordinateMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateUnixTimestampMultiError) Error() string {
+func (m TransformGeoCoordinateMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6355,11 +10373,11 @@ func (m GenerateUnixTimestampMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateUnixTimestampMultiError) AllErrors() []error { return m }
+func (m TransformGeoCoordinateMultiError) AllErrors() []error { return m }
 
-// GenerateUnixTimestampValidationError is the validation error returned by
-// GenerateUnixTimestamp.Validate if the designated constraints aren't met.
-type GenerateUnixTimestampValidationError struct {
+// TransformGeoCoordinateValidationError is the validation error returned by
+// TransformGeoCoordinate.Validate if the designated constraints aren't met.
+type TransformGeoCoordinateValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6367,24 +10385,24 @@ type GenerateUnixTimestampValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateUnixTimestampValidationError) Field() string { return e.field }
+func (e TransformGeoCoordinateValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateUnixTimestampValidationError) Reason() string { return e.reason }
+func (e TransformGeoCoordinateValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateUnixTimestampValidationError) Cause() error { return e.cause }
+func (e TransformGeoCoordinateValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateUnixTimestampValidationError) Key() bool { return e.key }
+func (e TransformGeoCoordinateValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateUnixTimestampValidationError) ErrorName() string {
-	return "GenerateUnixTimestampValidationError"
+func (e TransformGeoCoordinateValidationError) ErrorName() string {
+	return "TransformGeoCoordinateValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateUnixTimestampValidationError) Error() string {
+func (e TransformGeoCoordinateValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6396,14 +10414,14 @@ func (e GenerateUnixTimestampValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateUnixTimestamp.%s: %s%s",
+		"invalid %sTransformGeoCoordinate.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateUnixTimestampValidationError{}
+var _ error = TransformGeoCoordinateValidationError{}
 
 var _ interface {
 	Field() string
@@ -6411,44 +10429,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateUnixTimestampValidationError{}
+} = TransformGeoCoordinateValidationError{}
 
-// Validate checks the field values on GenerateUsername with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GenerateUsername) Validate() error {
+// Validate checks the field values on TransformUrl with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TransformUrl) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateUsername with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GenerateUsernameMultiError, or nil if none found.
-func (m *GenerateUsername) ValidateAll() error {
+// ValidateAll checks the field values on TransformUrl with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TransformUrlMultiError, or
+// nil if none found.
+func (m *TransformUrl) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateUsername) validate(all bool) error {
+func (m *TransformUrl) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for RandomizeQueryParams
+
+	// no validation rules for StripUserInfo
+
+	// no validation rules for RandomizePathIdentifiers
+
 	if len(errors) > 0 {
-		return GenerateUsernameMultiError(errors)
+		return TransformUrlMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateUsernameMultiError is an error wrapping multiple validation errors
-// returned by GenerateUsername.ValidateAll() if the designated constraints
-// aren't met.
-type GenerateUsernameMultiError []error
+// TransformUrlMultiError is an error wrapping multiple validation errors
+// returned by TransformUrl.ValidateAll() if the designated constraints aren't met.
+type TransformUrlMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateUsernameMultiError) Error() string {
+func (m TransformUrlMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6457,11 +10480,11 @@ func (m GenerateUsernameMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateUsernameMultiError) AllErrors() []error { return m }
+func (m TransformUrlMultiError) AllErrors() []error { return m }
 
-// GenerateUsernameValidationError is the validation error returned by
-// GenerateUsername.Validate if the designated constraints aren't met.
-type GenerateUsernameValidationError struct {
+// TransformUrlValidationError is the validation error returned by
+// TransformUrl.Validate if the designated constraints aren't met.
+type TransformUrlValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6469,22 +10492,22 @@ type GenerateUsernameValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateUsernameValidationError) Field() string { return e.field }
+func (e TransformUrlValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateUsernameValidationError) Reason() string { return e.reason }
+func (e TransformUrlValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateUsernameValidationError) Cause() error { return e.cause }
+func (e TransformUrlValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateUsernameValidationError) Key() bool { return e.key }
+func (e TransformUrlValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateUsernameValidationError) ErrorName() string { return "GenerateUsernameValidationError" }
+func (e TransformUrlValidationError) ErrorName() string { return "TransformUrlValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GenerateUsernameValidationError) Error() string {
+func (e TransformUrlValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6496,14 +10519,14 @@ func (e GenerateUsernameValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateUsername.%s: %s%s",
+		"invalid %sTransformUrl.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateUsernameValidationError{}
+var _ error = TransformUrlValidationError{}
 
 var _ interface {
 	Field() string
@@ -6511,44 +10534,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateUsernameValidationError{}
+} = TransformUrlValidationError{}
 
-// Validate checks the field values on GenerateUtcTimestamp with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateUtcTimestamp) Validate() error {
+// Validate checks the field values on GenerateUserAgent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GenerateUserAgent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateUtcTimestamp with the rules
+// ValidateAll checks the field values on GenerateUserAgent with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateUtcTimestampMultiError, or nil if none found.
-func (m *GenerateUtcTimestamp) ValidateAll() error {
+// GenerateUserAgentMultiError, or nil if none found.
+func (m *GenerateUserAgent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateUtcTimestamp) validate(all bool) error {
+func (m *GenerateUserAgent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for DesktopOnly
+
 	if len(errors) > 0 {
-		return GenerateUtcTimestampMultiError(errors)
+		return GenerateUserAgentMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateUtcTimestampMultiError is an error wrapping multiple validation
-// errors returned by GenerateUtcTimestamp.ValidateAll() if the designated
-// constraints aren't met.
-type GenerateUtcTimestampMultiError []error
+// GenerateUserAgentMultiError is an error wrapping multiple validation errors
+// returned by GenerateUserAgent.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateUserAgentMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateUtcTimestampMultiError) Error() string {
+func (m GenerateUserAgentMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6557,11 +10582,11 @@ func (m GenerateUtcTimestampMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateUtcTimestampMultiError) AllErrors() []error { return m }
+func (m GenerateUserAgentMultiError) AllErrors() []error { return m }
 
-// GenerateUtcTimestampValidationError is the validation error returned by
-// GenerateUtcTimestamp.Validate if the designated constraints aren't met.
-type GenerateUtcTimestampValidationError struct {
+// GenerateUserAgentValidationError is the validation error returned by
+// GenerateUserAgent.Validate if the designated constraints aren't met.
+type GenerateUserAgentValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6569,24 +10594,24 @@ type GenerateUtcTimestampValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateUtcTimestampValidationError) Field() string { return e.field }
+func (e GenerateUserAgentValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateUtcTimestampValidationError) Reason() string { return e.reason }
+func (e GenerateUserAgentValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateUtcTimestampValidationError) Cause() error { return e.cause }
+func (e GenerateUserAgentValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateUtcTimestampValidationError) Key() bool { return e.key }
+func (e GenerateUserAgentValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateUtcTimestampValidationError) ErrorName() string {
-	return "GenerateUtcTimestampValidationError"
+func (e GenerateUserAgentValidationError) ErrorName() string {
+	return "GenerateUserAgentValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateUtcTimestampValidationError) Error() string {
+func (e GenerateUserAgentValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6598,14 +10623,14 @@ func (e GenerateUtcTimestampValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateUtcTimestamp.%s: %s%s",
+		"invalid %sGenerateUserAgent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateUtcTimestampValidationError{}
+var _ error = GenerateUserAgentValidationError{}
 
 var _ interface {
 	Field() string
@@ -6613,45 +10638,64 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateUtcTimestampValidationError{}
+} = GenerateUserAgentValidationError{}
 
-// Validate checks the field values on GenerateUuid with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GenerateUuid) Validate() error {
+// Validate checks the field values on GenerateBusinessTimestamp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateBusinessTimestamp) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateUuid with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GenerateUuidMultiError, or
-// nil if none found.
-func (m *GenerateUuid) ValidateAll() error {
+// ValidateAll checks the field values on GenerateBusinessTimestamp with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateBusinessTimestampMultiError, or nil if none found.
+func (m *GenerateBusinessTimestamp) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateUuid) validate(all bool) error {
+func (m *GenerateBusinessTimestamp) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for IncludeHyphens
+	// no validation rules for RangeStartDaysAgo
+
+	// no validation rules for RangeEndDaysAgo
+
+	// no validation rules for BusinessHoursWeight
+
+	// no validation rules for BusinessHoursStart
+
+	// no validation rules for BusinessHoursEnd
+
+	// no validation rules for WeekdayWeight
+
+	// no validation rules for Timezone
+
+	// no validation rules for MaxSecondsAfter
+
+	if m.AfterColumn != nil {
+		// no validation rules for AfterColumn
+	}
 
 	if len(errors) > 0 {
-		return GenerateUuidMultiError(errors)
+		return GenerateBusinessTimestampMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateUuidMultiError is an error wrapping multiple validation errors
-// returned by GenerateUuid.ValidateAll() if the designated constraints aren't met.
-type GenerateUuidMultiError []error
+// GenerateBusinessTimestampMultiError is an error wrapping multiple validation
+// errors returned by GenerateBusinessTimestamp.ValidateAll() if the
+// designated constraints aren't met.
+type GenerateBusinessTimestampMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateUuidMultiError) Error() string {
+func (m GenerateBusinessTimestampMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6660,11 +10704,11 @@ func (m GenerateUuidMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateUuidMultiError) AllErrors() []error { return m }
+func (m GenerateBusinessTimestampMultiError) AllErrors() []error { return m }
 
-// GenerateUuidValidationError is the validation error returned by
-// GenerateUuid.Validate if the designated constraints aren't met.
-type GenerateUuidValidationError struct {
+// GenerateBusinessTimestampValidationError is the validation error returned by
+// GenerateBusinessTimestamp.Validate if the designated constraints aren't met.
+type GenerateBusinessTimestampValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6672,22 +10716,24 @@ type GenerateUuidValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateUuidValidationError) Field() string { return e.field }
+func (e GenerateBusinessTimestampValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateUuidValidationError) Reason() string { return e.reason }
+func (e GenerateBusinessTimestampValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateUuidValidationError) Cause() error { return e.cause }
+func (e GenerateBusinessTimestampValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateUuidValidationError) Key() bool { return e.key }
+func (e GenerateBusinessTimestampValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateUuidValidationError) ErrorName() string { return "GenerateUuidValidationError" }
+func (e GenerateBusinessTimestampValidationError) ErrorName() string {
+	return "GenerateBusinessTimestampValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GenerateUuidValidationError) Error() string {
+func (e GenerateBusinessTimestampValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6699,14 +10745,14 @@ func (e GenerateUuidValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateUuid.%s: %s%s",
+		"invalid %sGenerateBusinessTimestamp.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateUuidValidationError{}
+var _ error = GenerateBusinessTimestampValidationError{}
 
 var _ interface {
 	Field() string
@@ -6714,44 +10760,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateUuidValidationError{}
+} = GenerateBusinessTimestampValidationError{}
 
-// Validate checks the field values on GenerateZipcode with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GenerateZipcode) Validate() error {
+// Validate checks the field values on TransformMoney with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TransformMoney) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateZipcode with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GenerateZipcodeMultiError, or nil if none found.
-func (m *GenerateZipcode) ValidateAll() error {
+// ValidateAll checks the field values on TransformMoney with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TransformMoneyMultiError,
+// or nil if none found.
+func (m *TransformMoney) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateZipcode) validate(all bool) error {
+func (m *TransformMoney) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if m.DecimalPlaces != nil {
+		// no validation rules for DecimalPlaces
+	}
+
 	if len(errors) > 0 {
-		return GenerateZipcodeMultiError(errors)
+		return TransformMoneyMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateZipcodeMultiError is an error wrapping multiple validation errors
-// returned by GenerateZipcode.ValidateAll() if the designated constraints
+// TransformMoneyMultiError is an error wrapping multiple validation errors
+// returned by TransformMoney.ValidateAll() if the designated constraints
 // aren't met.
-type GenerateZipcodeMultiError []error
+type TransformMoneyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateZipcodeMultiError) Error() string {
+func (m TransformMoneyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6760,11 +10810,11 @@ func (m GenerateZipcodeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateZipcodeMultiError) AllErrors() []error { return m }
+func (m TransformMoneyMultiError) AllErrors() []error { return m }
 
-// GenerateZipcodeValidationError is the validation error returned by
-// GenerateZipcode.Validate if the designated constraints aren't met.
-type GenerateZipcodeValidationError struct {
+// TransformMoneyValidationError is the validation error returned by
+// TransformMoney.Validate if the designated constraints aren't met.
+type TransformMoneyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6772,22 +10822,22 @@ type GenerateZipcodeValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateZipcodeValidationError) Field() string { return e.field }
+func (e TransformMoneyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateZipcodeValidationError) Reason() string { return e.reason }
+func (e TransformMoneyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateZipcodeValidationError) Cause() error { return e.cause }
+func (e TransformMoneyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateZipcodeValidationError) Key() bool { return e.key }
+func (e TransformMoneyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateZipcodeValidationError) ErrorName() string { return "GenerateZipcodeValidationError" }
+func (e TransformMoneyValidationError) ErrorName() string { return "TransformMoneyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GenerateZipcodeValidationError) Error() string {
+func (e TransformMoneyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6799,14 +10849,14 @@ func (e GenerateZipcodeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateZipcode.%s: %s%s",
+		"invalid %sTransformMoney.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateZipcodeValidationError{}
+var _ error = TransformMoneyValidationError{}
 
 var _ interface {
 	Field() string
@@ -6814,46 +10864,60 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateZipcodeValidationError{}
+} = TransformMoneyValidationError{}
 
-// Validate checks the field values on TransformE164PhoneNumber with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TransformE164PhoneNumber) Validate() error {
+// Validate checks the field values on GenerateMoney with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GenerateMoney) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TransformE164PhoneNumber with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TransformE164PhoneNumberMultiError, or nil if none found.
-func (m *TransformE164PhoneNumber) ValidateAll() error {
+// ValidateAll checks the field values on GenerateMoney with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GenerateMoneyMultiError, or
+// nil if none found.
+func (m *GenerateMoney) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TransformE164PhoneNumber) validate(all bool) error {
+func (m *GenerateMoney) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PreserveLength
+	// no validation rules for Median
+
+	// no validation rules for Sigma
+
+	if m.Min != nil {
+		// no validation rules for Min
+	}
+
+	if m.Max != nil {
+		// no validation rules for Max
+	}
+
+	if m.DecimalPlaces != nil {
+		// no validation rules for DecimalPlaces
+	}
 
 	if len(errors) > 0 {
-		return TransformE164PhoneNumberMultiError(errors)
+		return GenerateMoneyMultiError(errors)
 	}
 
 	return nil
 }
 
-// TransformE164PhoneNumberMultiError is an error wrapping multiple validation
-// errors returned by TransformE164PhoneNumber.ValidateAll() if the designated
-// constraints aren't met.
-type TransformE164PhoneNumberMultiError []error
+// GenerateMoneyMultiError is an error wrapping multiple validation errors
+// returned by GenerateMoney.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateMoneyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransformE164PhoneNumberMultiError) Error() string {
+func (m GenerateMoneyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6862,11 +10926,11 @@ func (m TransformE164PhoneNumberMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransformE164PhoneNumberMultiError) AllErrors() []error { return m }
-
-// TransformE164PhoneNumberValidationError is the validation error returned by
-// TransformE164PhoneNumber.Validate if the designated constraints aren't met.
-type TransformE164PhoneNumberValidationError struct {
+func (m GenerateMoneyMultiError) AllErrors() []error { return m }
+
+// GenerateMoneyValidationError is the validation error returned by
+// GenerateMoney.Validate if the designated constraints aren't met.
+type GenerateMoneyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6874,24 +10938,22 @@ type TransformE164PhoneNumberValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransformE164PhoneNumberValidationError) Field() string { return e.field }
+func (e GenerateMoneyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransformE164PhoneNumberValidationError) Reason() string { return e.reason }
+func (e GenerateMoneyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransformE164PhoneNumberValidationError) Cause() error { return e.cause }
+func (e GenerateMoneyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransformE164PhoneNumberValidationError) Key() bool { return e.key }
+func (e GenerateMoneyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransformE164PhoneNumberValidationError) ErrorName() string {
-	return "TransformE164PhoneNumberValidationError"
-}
+func (e GenerateMoneyValidationError) ErrorName() string { return "GenerateMoneyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TransformE164PhoneNumberValidationError) Error() string {
+func (e GenerateMoneyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6903,14 +10965,14 @@ func (e TransformE164PhoneNumberValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransformE164PhoneNumber.%s: %s%s",
+		"invalid %sGenerateMoney.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransformE164PhoneNumberValidationError{}
+var _ error = GenerateMoneyValidationError{}
 
 var _ interface {
 	Field() string
@@ -6918,46 +10980,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransformE164PhoneNumberValidationError{}
+} = GenerateMoneyValidationError{}
 
-// Validate checks the field values on TransformFirstName with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GenerateMedicalRecordNumber with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TransformFirstName) Validate() error {
+func (m *GenerateMedicalRecordNumber) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TransformFirstName with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GenerateMedicalRecordNumber with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TransformFirstNameMultiError, or nil if none found.
-func (m *TransformFirstName) ValidateAll() error {
+// GenerateMedicalRecordNumberMultiError, or nil if none found.
+func (m *GenerateMedicalRecordNumber) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TransformFirstName) validate(all bool) error {
+func (m *GenerateMedicalRecordNumber) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PreserveLength
+	if m.Prefix != nil {
+		// no validation rules for Prefix
+	}
+
+	if m.Length != nil {
+		// no validation rules for Length
+	}
 
 	if len(errors) > 0 {
-		return TransformFirstNameMultiError(errors)
+		return GenerateMedicalRecordNumberMultiError(errors)
 	}
 
 	return nil
 }
 
-// TransformFirstNameMultiError is an error wrapping multiple validation errors
-// returned by TransformFirstName.ValidateAll() if the designated constraints
-// aren't met.
-type TransformFirstNameMultiError []error
+// GenerateMedicalRecordNumberMultiError is an error wrapping multiple
+// validation errors returned by GenerateMedicalRecordNumber.ValidateAll() if
+// the designated constraints aren't met.
+type GenerateMedicalRecordNumberMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransformFirstNameMultiError) Error() string {
+func (m GenerateMedicalRecordNumberMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6966,11 +11034,12 @@ func (m TransformFirstNameMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransformFirstNameMultiError) AllErrors() []error { return m }
+func (m GenerateMedicalRecordNumberMultiError) AllErrors() []error { return m }
 
-// TransformFirstNameValidationError is the validation error returned by
-// TransformFirstName.Validate if the designated constraints aren't met.
-type TransformFirstNameValidationError struct {
+// GenerateMedicalRecordNumberValidationError is the validation error returned
+// by GenerateMedicalRecordNumber.Validate if the designated constraints
+// aren't met.
+type GenerateMedicalRecordNumberValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6978,24 +11047,24 @@ type TransformFirstNameValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransformFirstNameValidationError) Field() string { return e.field }
+func (e GenerateMedicalRecordNumberValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransformFirstNameValidationError) Reason() string { return e.reason }
+func (e GenerateMedicalRecordNumberValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransformFirstNameValidationError) Cause() error { return e.cause }
+func (e GenerateMedicalRecordNumberValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransformFirstNameValidationError) Key() bool { return e.key }
+func (e GenerateMedicalRecordNumberValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransformFirstNameValidationError) ErrorName() string {
-	return "TransformFirstNameValidationError"
+func (e GenerateMedicalRecordNumberValidationError) ErrorName() string {
+	return "GenerateMedicalRecordNumberValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TransformFirstNameValidationError) Error() string {
+func (e GenerateMedicalRecordNumberValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7007,14 +11076,14 @@ func (e TransformFirstNameValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransformFirstName.%s: %s%s",
+		"invalid %sGenerateMedicalRecordNumber.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransformFirstNameValidationError{}
+var _ error = GenerateMedicalRecordNumberValidationError{}
 
 var _ interface {
 	Field() string
@@ -7022,48 +11091,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransformFirstNameValidationError{}
+} = GenerateMedicalRecordNumberValidationError{}
 
-// Validate checks the field values on TransformFloat64 with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TransformFloat64) Validate() error {
+// Validate checks the field values on TransformMedicalRecordNumber with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransformMedicalRecordNumber) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TransformFloat64 with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TransformMedicalRecordNumber with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TransformFloat64MultiError, or nil if none found.
-func (m *TransformFloat64) ValidateAll() error {
+// TransformMedicalRecordNumberMultiError, or nil if none found.
+func (m *TransformMedicalRecordNumber) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TransformFloat64) validate(all bool) error {
+func (m *TransformMedicalRecordNumber) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RandomizationRangeMin
-
-	// no validation rules for RandomizationRangeMax
+	// no validation rules for PreserveLetters
 
 	if len(errors) > 0 {
-		return TransformFloat64MultiError(errors)
+		return TransformMedicalRecordNumberMultiError(errors)
 	}
 
 	return nil
 }
 
-// TransformFloat64MultiError is an error wrapping multiple validation errors
-// returned by TransformFloat64.ValidateAll() if the designated constraints
-// aren't met.
-type TransformFloat64MultiError []error
+// TransformMedicalRecordNumberMultiError is an error wrapping multiple
+// validation errors returned by TransformMedicalRecordNumber.ValidateAll() if
+// the designated constraints aren't met.
+type TransformMedicalRecordNumberMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransformFloat64MultiError) Error() string {
+func (m TransformMedicalRecordNumberMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7072,11 +11139,12 @@ func (m TransformFloat64MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransformFloat64MultiError) AllErrors() []error { return m }
+func (m TransformMedicalRecordNumberMultiError) AllErrors() []error { return m }
 
-// TransformFloat64ValidationError is the validation error returned by
-// TransformFloat64.Validate if the designated constraints aren't met.
-type TransformFloat64ValidationError struct {
+// TransformMedicalRecordNumberValidationError is the validation error returned
+// by TransformMedicalRecordNumber.Validate if the designated constraints
+// aren't met.
+type TransformMedicalRecordNumberValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7084,22 +11152,24 @@ type TransformFloat64ValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransformFloat64ValidationError) Field() string { return e.field }
+func (e TransformMedicalRecordNumberValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransformFloat64ValidationError) Reason() string { return e.reason }
+func (e TransformMedicalRecordNumberValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransformFloat64ValidationError) Cause() error { return e.cause }
+func (e TransformMedicalRecordNumberValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransformFloat64ValidationError) Key() bool { return e.key }
+func (e TransformMedicalRecordNumberValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransformFloat64ValidationError) ErrorName() string { return "TransformFloat64ValidationError" }
+func (e TransformMedicalRecordNumberValidationError) ErrorName() string {
+	return "TransformMedicalRecordNumberValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TransformFloat64ValidationError) Error() string {
+func (e TransformMedicalRecordNumberValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7111,14 +11181,14 @@ func (e TransformFloat64ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransformFloat64.%s: %s%s",
+		"invalid %sTransformMedicalRecordNumber.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransformFloat64ValidationError{}
+var _ error = TransformMedicalRecordNumberValidationError{}
 
 var _ interface {
 	Field() string
@@ -7126,46 +11196,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransformFloat64ValidationError{}
+} = TransformMedicalRecordNumberValidationError{}
 
-// Validate checks the field values on TransformFullName with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TransformFullName) Validate() error {
+// Validate checks the field values on GenerateNpi with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GenerateNpi) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TransformFullName with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TransformFullNameMultiError, or nil if none found.
-func (m *TransformFullName) ValidateAll() error {
+// ValidateAll checks the field values on GenerateNpi with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GenerateNpiMultiError, or
+// nil if none found.
+func (m *GenerateNpi) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TransformFullName) validate(all bool) error {
+func (m *GenerateNpi) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PreserveLength
-
 	if len(errors) > 0 {
-		return TransformFullNameMultiError(errors)
+		return GenerateNpiMultiError(errors)
 	}
 
 	return nil
 }
 
-// TransformFullNameMultiError is an error wrapping multiple validation errors
-// returned by TransformFullName.ValidateAll() if the designated constraints
-// aren't met.
-type TransformFullNameMultiError []error
+// GenerateNpiMultiError is an error wrapping multiple validation errors
+// returned by GenerateNpi.ValidateAll() if the designated constraints aren't met.
+type GenerateNpiMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransformFullNameMultiError) Error() string {
+func (m GenerateNpiMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7174,11 +11241,11 @@ func (m TransformFullNameMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransformFullNameMultiError) AllErrors() []error { return m }
+func (m GenerateNpiMultiError) AllErrors() []error { return m }
 
-// TransformFullNameValidationError is the validation error returned by
-// TransformFullName.Validate if the designated constraints aren't met.
-type TransformFullNameValidationError struct {
+// GenerateNpiValidationError is the validation error returned by
+// GenerateNpi.Validate if the designated constraints aren't met.
+type GenerateNpiValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7186,24 +11253,22 @@ type TransformFullNameValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransformFullNameValidationError) Field() string { return e.field }
+func (e GenerateNpiValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransformFullNameValidationError) Reason() string { return e.reason }
+func (e GenerateNpiValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransformFullNameValidationError) Cause() error { return e.cause }
+func (e GenerateNpiValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransformFullNameValidationError) Key() bool { return e.key }
+func (e GenerateNpiValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransformFullNameValidationError) ErrorName() string {
-	return "TransformFullNameValidationError"
-}
+func (e GenerateNpiValidationError) ErrorName() string { return "GenerateNpiValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TransformFullNameValidationError) Error() string {
+func (e GenerateNpiValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7215,14 +11280,14 @@ func (e TransformFullNameValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransformFullName.%s: %s%s",
+		"invalid %sGenerateNpi.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransformFullNameValidationError{}
+var _ error = GenerateNpiValidationError{}
 
 var _ interface {
 	Field() string
@@ -7230,46 +11295,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransformFullNameValidationError{}
+} = GenerateNpiValidationError{}
 
-// Validate checks the field values on TransformInt64PhoneNumber with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TransformInt64PhoneNumber) Validate() error {
+// Validate checks the field values on TransformNpi with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TransformNpi) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TransformInt64PhoneNumber with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TransformInt64PhoneNumberMultiError, or nil if none found.
-func (m *TransformInt64PhoneNumber) ValidateAll() error {
+// ValidateAll checks the field values on TransformNpi with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TransformNpiMultiError, or
+// nil if none found.
+func (m *TransformNpi) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TransformInt64PhoneNumber) validate(all bool) error {
+func (m *TransformNpi) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PreserveLength
+	// no validation rules for PreserveEntityType
 
 	if len(errors) > 0 {
-		return TransformInt64PhoneNumberMultiError(errors)
+		return TransformNpiMultiError(errors)
 	}
 
 	return nil
 }
 
-// TransformInt64PhoneNumberMultiError is an error wrapping multiple validation
-// errors returned by TransformInt64PhoneNumber.ValidateAll() if the
-// designated constraints aren't met.
-type TransformInt64PhoneNumberMultiError []error
+// TransformNpiMultiError is an error wrapping multiple validation errors
+// returned by TransformNpi.ValidateAll() if the designated constraints aren't met.
+type TransformNpiMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransformInt64PhoneNumberMultiError) Error() string {
+func (m TransformNpiMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7278,11 +11342,11 @@ func (m TransformInt64PhoneNumberMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransformInt64PhoneNumberMultiError) AllErrors() []error { return m }
+func (m TransformNpiMultiError) AllErrors() []error { return m }
 
-// TransformInt64PhoneNumberValidationError is the validation error returned by
-// TransformInt64PhoneNumber.Validate if the designated constraints aren't met.
-type TransformInt64PhoneNumberValidationError struct {
+// TransformNpiValidationError is the validation error returned by
+// TransformNpi.Validate if the designated constraints aren't met.
+type TransformNpiValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7290,24 +11354,22 @@ type TransformInt64PhoneNumberValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransformInt64PhoneNumberValidationError) Field() string { return e.field }
+func (e TransformNpiValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransformInt64PhoneNumberValidationError) Reason() string { return e.reason }
+func (e TransformNpiValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransformInt64PhoneNumberValidationError) Cause() error { return e.cause }
+func (e TransformNpiValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransformInt64PhoneNumberValidationError) Key() bool { return e.key }
+func (e TransformNpiValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransformInt64PhoneNumberValidationError) ErrorName() string {
-	return "TransformInt64PhoneNumberValidationError"
-}
+func (e TransformNpiValidationError) ErrorName() string { return "TransformNpiValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TransformInt64PhoneNumberValidationError) Error() string {
+func (e TransformNpiValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7319,14 +11381,14 @@ func (e TransformInt64PhoneNumberValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransformInt64PhoneNumber.%s: %s%s",
+		"invalid %sTransformNpi.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransformInt64PhoneNumberValidationError{}
+var _ error = TransformNpiValidationError{}
 
 var _ interface {
 	Field() string
@@ -7334,48 +11396,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransformInt64PhoneNumberValidationError{}
+} = TransformNpiValidationError{}
 
-// Validate checks the field values on TransformInt64 with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *TransformInt64) Validate() error {
+// Validate checks the field values on GenerateIcd10Code with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GenerateIcd10Code) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TransformInt64 with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TransformInt64MultiError,
-// or nil if none found.
-func (m *TransformInt64) ValidateAll() error {
+// ValidateAll checks the field values on GenerateIcd10Code with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateIcd10CodeMultiError, or nil if none found.
+func (m *GenerateIcd10Code) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TransformInt64) validate(all bool) error {
+func (m *GenerateIcd10Code) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RandomizationRangeMin
-
-	// no validation rules for RandomizationRangeMax
+	// no validation rules for ExcludeDecimalPoint
 
 	if len(errors) > 0 {
-		return TransformInt64MultiError(errors)
+		return GenerateIcd10CodeMultiError(errors)
 	}
 
 	return nil
 }
 
-// TransformInt64MultiError is an error wrapping multiple validation errors
-// returned by TransformInt64.ValidateAll() if the designated constraints
+// GenerateIcd10CodeMultiError is an error wrapping multiple validation errors
+// returned by GenerateIcd10Code.ValidateAll() if the designated constraints
 // aren't met.
-type TransformInt64MultiError []error
+type GenerateIcd10CodeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransformInt64MultiError) Error() string {
+func (m GenerateIcd10CodeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7384,11 +11444,11 @@ func (m TransformInt64MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransformInt64MultiError) AllErrors() []error { return m }
+func (m GenerateIcd10CodeMultiError) AllErrors() []error { return m }
 
-// TransformInt64ValidationError is the validation error returned by
-// TransformInt64.Validate if the designated constraints aren't met.
-type TransformInt64ValidationError struct {
+// GenerateIcd10CodeValidationError is the validation error returned by
+// GenerateIcd10Code.Validate if the designated constraints aren't met.
+type GenerateIcd10CodeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7396,22 +11456,24 @@ type TransformInt64ValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransformInt64ValidationError) Field() string { return e.field }
+func (e GenerateIcd10CodeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransformInt64ValidationError) Reason() string { return e.reason }
+func (e GenerateIcd10CodeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransformInt64ValidationError) Cause() error { return e.cause }
+func (e GenerateIcd10CodeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransformInt64ValidationError) Key() bool { return e.key }
+func (e GenerateIcd10CodeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransformInt64ValidationError) ErrorName() string { return "TransformInt64ValidationError" }
+func (e GenerateIcd10CodeValidationError) ErrorName() string {
+	return "GenerateIcd10CodeValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TransformInt64ValidationError) Error() string {
+func (e GenerateIcd10CodeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7423,14 +11485,14 @@ func (e TransformInt64ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransformInt64.%s: %s%s",
+		"invalid %sGenerateIcd10Code.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransformInt64ValidationError{}
+var _ error = GenerateIcd10CodeValidationError{}
 
 var _ interface {
 	Field() string
@@ -7438,46 +11500,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransformInt64ValidationError{}
+} = GenerateIcd10CodeValidationError{}
 
-// Validate checks the field values on TransformLastName with the rules defined
+// Validate checks the field values on GenerateCptCode with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *TransformLastName) Validate() error {
+func (m *GenerateCptCode) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TransformLastName with the rules
+// ValidateAll checks the field values on GenerateCptCode with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TransformLastNameMultiError, or nil if none found.
-func (m *TransformLastName) ValidateAll() error {
+// GenerateCptCodeMultiError, or nil if none found.
+func (m *GenerateCptCode) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TransformLastName) validate(all bool) error {
+func (m *GenerateCptCode) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PreserveLength
-
 	if len(errors) > 0 {
-		return TransformLastNameMultiError(errors)
+		return GenerateCptCodeMultiError(errors)
 	}
 
 	return nil
 }
 
-// TransformLastNameMultiError is an error wrapping multiple validation errors
-// returned by TransformLastName.ValidateAll() if the designated constraints
+// GenerateCptCodeMultiError is an error wrapping multiple validation errors
+// returned by GenerateCptCode.ValidateAll() if the designated constraints
 // aren't met.
-type TransformLastNameMultiError []error
+type GenerateCptCodeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransformLastNameMultiError) Error() string {
+func (m GenerateCptCodeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7486,11 +11546,11 @@ func (m TransformLastNameMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransformLastNameMultiError) AllErrors() []error { return m }
+func (m GenerateCptCodeMultiError) AllErrors() []error { return m }
 
-// TransformLastNameValidationError is the validation error returned by
-// TransformLastName.Validate if the designated constraints aren't met.
-type TransformLastNameValidationError struct {
+// GenerateCptCodeValidationError is the validation error returned by
+// GenerateCptCode.Validate if the designated constraints aren't met.
+type GenerateCptCodeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7498,24 +11558,22 @@ type TransformLastNameValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransformLastNameValidationError) Field() string { return e.field }
+func (e GenerateCptCodeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransformLastNameValidationError) Reason() string { return e.reason }
+func (e GenerateCptCodeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransformLastNameValidationError) Cause() error { return e.cause }
+func (e GenerateCptCodeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransformLastNameValidationError) Key() bool { return e.key }
+func (e GenerateCptCodeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransformLastNameValidationError) ErrorName() string {
-	return "TransformLastNameValidationError"
-}
+func (e GenerateCptCodeValidationError) ErrorName() string { return "GenerateCptCodeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TransformLastNameValidationError) Error() string {
+func (e GenerateCptCodeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7527,14 +11585,14 @@ func (e TransformLastNameValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransformLastName.%s: %s%s",
+		"invalid %sGenerateCptCode.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransformLastNameValidationError{}
+var _ error = GenerateCptCodeValidationError{}
 
 var _ interface {
 	Field() string
@@ -7542,46 +11600,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransformLastNameValidationError{}
+} = GenerateCptCodeValidationError{}
 
-// Validate checks the field values on TransformPhoneNumber with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TransformPhoneNumber) Validate() error {
+// Validate checks the field values on GenerateVin with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GenerateVin) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TransformPhoneNumber with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TransformPhoneNumberMultiError, or nil if none found.
-func (m *TransformPhoneNumber) ValidateAll() error {
+// ValidateAll checks the field values on GenerateVin with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GenerateVinMultiError, or
+// nil if none found.
+func (m *GenerateVin) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TransformPhoneNumber) validate(all bool) error {
+func (m *GenerateVin) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PreserveLength
-
 	if len(errors) > 0 {
-		return TransformPhoneNumberMultiError(errors)
+		return GenerateVinMultiError(errors)
 	}
 
 	return nil
 }
 
-// TransformPhoneNumberMultiError is an error wrapping multiple validation
-// errors returned by TransformPhoneNumber.ValidateAll() if the designated
-// constraints aren't met.
-type TransformPhoneNumberMultiError []error
+// GenerateVinMultiError is an error wrapping multiple validation errors
+// returned by GenerateVin.ValidateAll() if the designated constraints aren't met.
+type GenerateVinMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransformPhoneNumberMultiError) Error() string {
+func (m GenerateVinMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7590,11 +11645,11 @@ func (m TransformPhoneNumberMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransformPhoneNumberMultiError) AllErrors() []error { return m }
+func (m GenerateVinMultiError) AllErrors() []error { return m }
 
-// TransformPhoneNumberValidationError is the validation error returned by
-// TransformPhoneNumber.Validate if the designated constraints aren't met.
-type TransformPhoneNumberValidationError struct {
+// GenerateVinValidationError is the validation error returned by
+// GenerateVin.Validate if the designated constraints aren't met.
+type GenerateVinValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7602,24 +11657,22 @@ type TransformPhoneNumberValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransformPhoneNumberValidationError) Field() string { return e.field }
+func (e GenerateVinValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransformPhoneNumberValidationError) Reason() string { return e.reason }
+func (e GenerateVinValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransformPhoneNumberValidationError) Cause() error { return e.cause }
+func (e GenerateVinValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransformPhoneNumberValidationError) Key() bool { return e.key }
+func (e GenerateVinValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransformPhoneNumberValidationError) ErrorName() string {
-	return "TransformPhoneNumberValidationError"
-}
+func (e GenerateVinValidationError) ErrorName() string { return "GenerateVinValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TransformPhoneNumberValidationError) Error() string {
+func (e GenerateVinValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7631,14 +11684,14 @@ func (e TransformPhoneNumberValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransformPhoneNumber.%s: %s%s",
+		"invalid %sGenerateVin.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransformPhoneNumberValidationError{}
+var _ error = GenerateVinValidationError{}
 
 var _ interface {
 	Field() string
@@ -7646,46 +11699,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransformPhoneNumberValidationError{}
+} = GenerateVinValidationError{}
 
-// Validate checks the field values on TransformString with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TransformString) Validate() error {
+// Validate checks the field values on TransformVin with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TransformVin) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TransformString with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TransformStringMultiError, or nil if none found.
-func (m *TransformString) ValidateAll() error {
+// ValidateAll checks the field values on TransformVin with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TransformVinMultiError, or
+// nil if none found.
+func (m *TransformVin) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TransformString) validate(all bool) error {
+func (m *TransformVin) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PreserveLength
+	// no validation rules for PreserveManufacturer
 
 	if len(errors) > 0 {
-		return TransformStringMultiError(errors)
+		return TransformVinMultiError(errors)
 	}
 
 	return nil
 }
 
-// TransformStringMultiError is an error wrapping multiple validation errors
-// returned by TransformString.ValidateAll() if the designated constraints
-// aren't met.
-type TransformStringMultiError []error
+// TransformVinMultiError is an error wrapping multiple validation errors
+// returned by TransformVin.ValidateAll() if the designated constraints aren't met.
+type TransformVinMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransformStringMultiError) Error() string {
+func (m TransformVinMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7694,11 +11746,11 @@ func (m TransformStringMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransformStringMultiError) AllErrors() []error { return m }
+func (m TransformVinMultiError) AllErrors() []error { return m }
 
-// TransformStringValidationError is the validation error returned by
-// TransformString.Validate if the designated constraints aren't met.
-type TransformStringValidationError struct {
+// TransformVinValidationError is the validation error returned by
+// TransformVin.Validate if the designated constraints aren't met.
+type TransformVinValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7706,22 +11758,22 @@ type TransformStringValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransformStringValidationError) Field() string { return e.field }
+func (e TransformVinValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransformStringValidationError) Reason() string { return e.reason }
+func (e TransformVinValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransformStringValidationError) Cause() error { return e.cause }
+func (e TransformVinValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransformStringValidationError) Key() bool { return e.key }
+func (e TransformVinValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransformStringValidationError) ErrorName() string { return "TransformStringValidationError" }
+func (e TransformVinValidationError) ErrorName() string { return "TransformVinValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TransformStringValidationError) Error() string {
+func (e TransformVinValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7733,14 +11785,14 @@ func (e TransformStringValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransformString.%s: %s%s",
+		"invalid %sTransformVin.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransformStringValidationError{}
+var _ error = TransformVinValidationError{}
 
 var _ interface {
 	Field() string
@@ -7748,24 +11800,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransformStringValidationError{}
+} = TransformVinValidationError{}
 
-// Validate checks the field values on Passthrough with the rules defined in
+// Validate checks the field values on GenerateImei with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Passthrough) Validate() error {
+func (m *GenerateImei) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Passthrough with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in PassthroughMultiError, or
+// ValidateAll checks the field values on GenerateImei with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GenerateImeiMultiError, or
 // nil if none found.
-func (m *Passthrough) ValidateAll() error {
+func (m *GenerateImei) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Passthrough) validate(all bool) error {
+func (m *GenerateImei) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7773,18 +11825,18 @@ func (m *Passthrough) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return PassthroughMultiError(errors)
+		return GenerateImeiMultiError(errors)
 	}
 
 	return nil
 }
 
-// PassthroughMultiError is an error wrapping multiple validation errors
-// returned by Passthrough.ValidateAll() if the designated constraints aren't met.
-type PassthroughMultiError []error
+// GenerateImeiMultiError is an error wrapping multiple validation errors
+// returned by GenerateImei.ValidateAll() if the designated constraints aren't met.
+type GenerateImeiMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PassthroughMultiError) Error() string {
+func (m GenerateImeiMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7793,11 +11845,11 @@ func (m PassthroughMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PassthroughMultiError) AllErrors() []error { return m }
+func (m GenerateImeiMultiError) AllErrors() []error { return m }
 
-// PassthroughValidationError is the validation error returned by
-// Passthrough.Validate if the designated constraints aren't met.
-type PassthroughValidationError struct {
+// GenerateImeiValidationError is the validation error returned by
+// GenerateImei.Validate if the designated constraints aren't met.
+type GenerateImeiValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7805,22 +11857,22 @@ type PassthroughValidationError struct {
 }
 
 // Field function returns field value.
-func (e PassthroughValidationError) Field() string { return e.field }
+func (e GenerateImeiValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PassthroughValidationError) Reason() string { return e.reason }
+func (e GenerateImeiValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PassthroughValidationError) Cause() error { return e.cause }
+func (e GenerateImeiValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PassthroughValidationError) Key() bool { return e.key }
+func (e GenerateImeiValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PassthroughValidationError) ErrorName() string { return "PassthroughValidationError" }
+func (e GenerateImeiValidationError) ErrorName() string { return "GenerateImeiValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PassthroughValidationError) Error() string {
+func (e GenerateImeiValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7832,14 +11884,14 @@ func (e PassthroughValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPassthrough.%s: %s%s",
+		"invalid %sGenerateImei.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PassthroughValidationError{}
+var _ error = GenerateImeiValidationError{}
 
 var _ interface {
 	Field() string
@@ -7847,42 +11899,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PassthroughValidationError{}
+} = GenerateImeiValidationError{}
 
-// Validate checks the field values on Null with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *Null) Validate() error {
+// Validate checks the field values on TransformImei with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TransformImei) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Null with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in NullMultiError, or nil if none found.
-func (m *Null) ValidateAll() error {
+// ValidateAll checks the field values on TransformImei with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TransformImeiMultiError, or
+// nil if none found.
+func (m *TransformImei) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Null) validate(all bool) error {
+func (m *TransformImei) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for PreserveTypeAllocationCode
+
 	if len(errors) > 0 {
-		return NullMultiError(errors)
+		return TransformImeiMultiError(errors)
 	}
 
 	return nil
 }
 
-// NullMultiError is an error wrapping multiple validation errors returned by
-// Null.ValidateAll() if the designated constraints aren't met.
-type NullMultiError []error
+// TransformImeiMultiError is an error wrapping multiple validation errors
+// returned by TransformImei.ValidateAll() if the designated constraints
+// aren't met.
+type TransformImeiMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m NullMultiError) Error() string {
+func (m TransformImeiMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7891,11 +11947,11 @@ func (m NullMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m NullMultiError) AllErrors() []error { return m }
+func (m TransformImeiMultiError) AllErrors() []error { return m }
 
-// NullValidationError is the validation error returned by Null.Validate if the
-// designated constraints aren't met.
-type NullValidationError struct {
+// TransformImeiValidationError is the validation error returned by
+// TransformImei.Validate if the designated constraints aren't met.
+type TransformImeiValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7903,22 +11959,22 @@ type NullValidationError struct {
 }
 
 // Field function returns field value.
-func (e NullValidationError) Field() string { return e.field }
+func (e TransformImeiValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NullValidationError) Reason() string { return e.reason }
+func (e TransformImeiValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NullValidationError) Cause() error { return e.cause }
+func (e TransformImeiValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NullValidationError) Key() bool { return e.key }
+func (e TransformImeiValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NullValidationError) ErrorName() string { return "NullValidationError" }
+func (e TransformImeiValidationError) ErrorName() string { return "TransformImeiValidationError" }
 
 // Error satisfies the builtin error interface
-func (e NullValidationError) Error() string {
+func (e TransformImeiValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7930,14 +11986,14 @@ func (e NullValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNull.%s: %s%s",
+		"invalid %sTransformImei.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NullValidationError{}
+var _ error = TransformImeiValidationError{}
 
 var _ interface {
 	Field() string
@@ -7945,46 +12001,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NullValidationError{}
+} = TransformImeiValidationError{}
 
-// Validate checks the field values on TransformJavascript with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TransformJavascript) Validate() error {
+// Validate checks the field values on GenerateEan with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GenerateEan) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TransformJavascript with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TransformJavascriptMultiError, or nil if none found.
-func (m *TransformJavascript) ValidateAll() error {
+// ValidateAll checks the field values on GenerateEan with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GenerateEanMultiError, or
+// nil if none found.
+func (m *GenerateEan) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TransformJavascript) validate(all bool) error {
+func (m *GenerateEan) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Code
+	// no validation rules for Upc
 
 	if len(errors) > 0 {
-		return TransformJavascriptMultiError(errors)
+		return GenerateEanMultiError(errors)
 	}
 
 	return nil
 }
 
-// TransformJavascriptMultiError is an error wrapping multiple validation
-// errors returned by TransformJavascript.ValidateAll() if the designated
-// constraints aren't met.
-type TransformJavascriptMultiError []error
+// GenerateEanMultiError is an error wrapping multiple validation errors
+// returned by GenerateEan.ValidateAll() if the designated constraints aren't met.
+type GenerateEanMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransformJavascriptMultiError) Error() string {
+func (m GenerateEanMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7993,11 +12048,11 @@ func (m TransformJavascriptMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransformJavascriptMultiError) AllErrors() []error { return m }
+func (m GenerateEanMultiError) AllErrors() []error { return m }
 
-// TransformJavascriptValidationError is the validation error returned by
-// TransformJavascript.Validate if the designated constraints aren't met.
-type TransformJavascriptValidationError struct {
+// GenerateEanValidationError is the validation error returned by
+// GenerateEan.Validate if the designated constraints aren't met.
+type GenerateEanValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8005,24 +12060,22 @@ type TransformJavascriptValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransformJavascriptValidationError) Field() string { return e.field }
+func (e GenerateEanValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransformJavascriptValidationError) Reason() string { return e.reason }
+func (e GenerateEanValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransformJavascriptValidationError) Cause() error { return e.cause }
+func (e GenerateEanValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransformJavascriptValidationError) Key() bool { return e.key }
+func (e GenerateEanValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransformJavascriptValidationError) ErrorName() string {
-	return "TransformJavascriptValidationError"
-}
+func (e GenerateEanValidationError) ErrorName() string { return "GenerateEanValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TransformJavascriptValidationError) Error() string {
+func (e GenerateEanValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8034,14 +12087,14 @@ func (e TransformJavascriptValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransformJavascript.%s: %s%s",
+		"invalid %sGenerateEan.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransformJavascriptValidationError{}
+var _ error = GenerateEanValidationError{}
 
 var _ interface {
 	Field() string
@@ -8049,46 +12102,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransformJavascriptValidationError{}
+} = GenerateEanValidationError{}
 
-// Validate checks the field values on UserDefinedTransformerConfig with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserDefinedTransformerConfig) Validate() error {
+// Validate checks the field values on TransformEan with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TransformEan) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserDefinedTransformerConfig with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UserDefinedTransformerConfigMultiError, or nil if none found.
-func (m *UserDefinedTransformerConfig) ValidateAll() error {
+// ValidateAll checks the field values on TransformEan with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TransformEanMultiError, or
+// nil if none found.
+func (m *TransformEan) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserDefinedTransformerConfig) validate(all bool) error {
+func (m *TransformEan) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for PreserveManufacturerPrefix
 
 	if len(errors) > 0 {
-		return UserDefinedTransformerConfigMultiError(errors)
+		return TransformEanMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserDefinedTransformerConfigMultiError is an error wrapping multiple
-// validation errors returned by UserDefinedTransformerConfig.ValidateAll() if
-// the designated constraints aren't met.
-type UserDefinedTransformerConfigMultiError []error
+// TransformEanMultiError is an error wrapping multiple validation errors
+// returned by TransformEan.ValidateAll() if the designated constraints aren't met.
+type TransformEanMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserDefinedTransformerConfigMultiError) Error() string {
+func (m TransformEanMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8097,12 +12149,11 @@ func (m UserDefinedTransformerConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserDefinedTransformerConfigMultiError) AllErrors() []error { return m }
+func (m TransformEanMultiError) AllErrors() []error { return m }
 
-// UserDefinedTransformerConfigValidationError is the validation error returned
-// by UserDefinedTransformerConfig.Validate if the designated constraints
-// aren't met.
-type UserDefinedTransformerConfigValidationError struct {
+// TransformEanValidationError is the validation error returned by
+// TransformEan.Validate if the designated constraints aren't met.
+type TransformEanValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8110,24 +12161,22 @@ type UserDefinedTransformerConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserDefinedTransformerConfigValidationError) Field() string { return e.field }
+func (e TransformEanValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserDefinedTransformerConfigValidationError) Reason() string { return e.reason }
+func (e TransformEanValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserDefinedTransformerConfigValidationError) Cause() error { return e.cause }
+func (e TransformEanValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserDefinedTransformerConfigValidationError) Key() bool { return e.key }
+func (e TransformEanValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserDefinedTransformerConfigValidationError) ErrorName() string {
-	return "UserDefinedTransformerConfigValidationError"
-}
+func (e TransformEanValidationError) ErrorName() string { return "TransformEanValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UserDefinedTransformerConfigValidationError) Error() string {
+func (e TransformEanValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8139,14 +12188,14 @@ func (e UserDefinedTransformerConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserDefinedTransformerConfig.%s: %s%s",
+		"invalid %sTransformEan.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserDefinedTransformerConfigValidationError{}
+var _ error = TransformEanValidationError{}
 
 var _ interface {
 	Field() string
@@ -8154,50 +12203,110 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserDefinedTransformerConfigValidationError{}
+} = TransformEanValidationError{}
 
-// Validate checks the field values on ValidateUserJavascriptCodeRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *ValidateUserJavascriptCodeRequest) Validate() error {
+// Validate checks the field values on JavascriptLibrary with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *JavascriptLibrary) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ValidateUserJavascriptCodeRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// ValidateUserJavascriptCodeRequestMultiError, or nil if none found.
-func (m *ValidateUserJavascriptCodeRequest) ValidateAll() error {
+// ValidateAll checks the field values on JavascriptLibrary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JavascriptLibraryMultiError, or nil if none found.
+func (m *JavascriptLibrary) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ValidateUserJavascriptCodeRequest) validate(all bool) error {
+func (m *JavascriptLibrary) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
 	// no validation rules for AccountId
 
+	// no validation rules for Name
+
 	// no validation rules for Code
 
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JavascriptLibraryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JavascriptLibraryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JavascriptLibraryValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JavascriptLibraryValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JavascriptLibraryValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JavascriptLibraryValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return ValidateUserJavascriptCodeRequestMultiError(errors)
+		return JavascriptLibraryMultiError(errors)
 	}
 
 	return nil
 }
 
-// ValidateUserJavascriptCodeRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// ValidateUserJavascriptCodeRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ValidateUserJavascriptCodeRequestMultiError []error
+// JavascriptLibraryMultiError is an error wrapping multiple validation errors
+// returned by JavascriptLibrary.ValidateAll() if the designated constraints
+// aren't met.
+type JavascriptLibraryMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ValidateUserJavascriptCodeRequestMultiError) Error() string {
+func (m JavascriptLibraryMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8206,12 +12315,11 @@ func (m ValidateUserJavascriptCodeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ValidateUserJavascriptCodeRequestMultiError) AllErrors() []error { return m }
+func (m JavascriptLibraryMultiError) AllErrors() []error { return m }
 
-// ValidateUserJavascriptCodeRequestValidationError is the validation error
-// returned by ValidateUserJavascriptCodeRequest.Validate if the designated
-// constraints aren't met.
-type ValidateUserJavascriptCodeRequestValidationError struct {
+// JavascriptLibraryValidationError is the validation error returned by
+// JavascriptLibrary.Validate if the designated constraints aren't met.
+type JavascriptLibraryValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8219,24 +12327,24 @@ type ValidateUserJavascriptCodeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ValidateUserJavascriptCodeRequestValidationError) Field() string { return e.field }
+func (e JavascriptLibraryValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ValidateUserJavascriptCodeRequestValidationError) Reason() string { return e.reason }
+func (e JavascriptLibraryValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ValidateUserJavascriptCodeRequestValidationError) Cause() error { return e.cause }
+func (e JavascriptLibraryValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ValidateUserJavascriptCodeRequestValidationError) Key() bool { return e.key }
+func (e JavascriptLibraryValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ValidateUserJavascriptCodeRequestValidationError) ErrorName() string {
-	return "ValidateUserJavascriptCodeRequestValidationError"
+func (e JavascriptLibraryValidationError) ErrorName() string {
+	return "JavascriptLibraryValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ValidateUserJavascriptCodeRequestValidationError) Error() string {
+func (e JavascriptLibraryValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8248,14 +12356,14 @@ func (e ValidateUserJavascriptCodeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sValidateUserJavascriptCodeRequest.%s: %s%s",
+		"invalid %sJavascriptLibrary.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ValidateUserJavascriptCodeRequestValidationError{}
+var _ error = JavascriptLibraryValidationError{}
 
 var _ interface {
 	Field() string
@@ -8263,48 +12371,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ValidateUserJavascriptCodeRequestValidationError{}
+} = JavascriptLibraryValidationError{}
 
-// Validate checks the field values on ValidateUserJavascriptCodeResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *ValidateUserJavascriptCodeResponse) Validate() error {
+// Validate checks the field values on GetJavascriptLibrariesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJavascriptLibrariesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ValidateUserJavascriptCodeResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// ValidateUserJavascriptCodeResponseMultiError, or nil if none found.
-func (m *ValidateUserJavascriptCodeResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetJavascriptLibrariesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetJavascriptLibrariesRequestMultiError, or nil if none found.
+func (m *GetJavascriptLibrariesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ValidateUserJavascriptCodeResponse) validate(all bool) error {
+func (m *GetJavascriptLibrariesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Valid
+	// no validation rules for AccountId
 
 	if len(errors) > 0 {
-		return ValidateUserJavascriptCodeResponseMultiError(errors)
+		return GetJavascriptLibrariesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ValidateUserJavascriptCodeResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// ValidateUserJavascriptCodeResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ValidateUserJavascriptCodeResponseMultiError []error
+// GetJavascriptLibrariesRequestMultiError is an error wrapping multiple
+// validation errors returned by GetJavascriptLibrariesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetJavascriptLibrariesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ValidateUserJavascriptCodeResponseMultiError) Error() string {
+func (m GetJavascriptLibrariesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8313,12 +12419,12 @@ func (m ValidateUserJavascriptCodeResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ValidateUserJavascriptCodeResponseMultiError) AllErrors() []error { return m }
+func (m GetJavascriptLibrariesRequestMultiError) AllErrors() []error { return m }
 
-// ValidateUserJavascriptCodeResponseValidationError is the validation error
-// returned by ValidateUserJavascriptCodeResponse.Validate if the designated
+// GetJavascriptLibrariesRequestValidationError is the validation error
+// returned by GetJavascriptLibrariesRequest.Validate if the designated
 // constraints aren't met.
-type ValidateUserJavascriptCodeResponseValidationError struct {
+type GetJavascriptLibrariesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8326,24 +12432,24 @@ type ValidateUserJavascriptCodeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ValidateUserJavascriptCodeResponseValidationError) Field() string { return e.field }
+func (e GetJavascriptLibrariesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ValidateUserJavascriptCodeResponseValidationError) Reason() string { return e.reason }
+func (e GetJavascriptLibrariesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ValidateUserJavascriptCodeResponseValidationError) Cause() error { return e.cause }
+func (e GetJavascriptLibrariesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ValidateUserJavascriptCodeResponseValidationError) Key() bool { return e.key }
+func (e GetJavascriptLibrariesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ValidateUserJavascriptCodeResponseValidationError) ErrorName() string {
-	return "ValidateUserJavascriptCodeResponseValidationError"
+func (e GetJavascriptLibrariesRequestValidationError) ErrorName() string {
+	return "GetJavascriptLibrariesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ValidateUserJavascriptCodeResponseValidationError) Error() string {
+func (e GetJavascriptLibrariesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8355,14 +12461,14 @@ func (e ValidateUserJavascriptCodeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sValidateUserJavascriptCodeResponse.%s: %s%s",
+		"invalid %sGetJavascriptLibrariesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ValidateUserJavascriptCodeResponseValidationError{}
+var _ error = GetJavascriptLibrariesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8370,46 +12476,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ValidateUserJavascriptCodeResponseValidationError{}
+} = GetJavascriptLibrariesRequestValidationError{}
 
-// Validate checks the field values on GenerateCategorical with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetJavascriptLibrariesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateCategorical) Validate() error {
+func (m *GetJavascriptLibrariesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateCategorical with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GenerateCategoricalMultiError, or nil if none found.
-func (m *GenerateCategorical) ValidateAll() error {
+// ValidateAll checks the field values on GetJavascriptLibrariesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetJavascriptLibrariesResponseMultiError, or nil if none found.
+func (m *GetJavascriptLibrariesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateCategorical) validate(all bool) error {
+func (m *GetJavascriptLibrariesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Categories
+	for idx, item := range m.GetLibraries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJavascriptLibrariesResponseValidationError{
+						field:  fmt.Sprintf("Libraries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJavascriptLibrariesResponseValidationError{
+						field:  fmt.Sprintf("Libraries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJavascriptLibrariesResponseValidationError{
+					field:  fmt.Sprintf("Libraries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return GenerateCategoricalMultiError(errors)
+		return GetJavascriptLibrariesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateCategoricalMultiError is an error wrapping multiple validation
-// errors returned by GenerateCategorical.ValidateAll() if the designated
-// constraints aren't met.
-type GenerateCategoricalMultiError []error
+// GetJavascriptLibrariesResponseMultiError is an error wrapping multiple
+// validation errors returned by GetJavascriptLibrariesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetJavascriptLibrariesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateCategoricalMultiError) Error() string {
+func (m GetJavascriptLibrariesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8418,11 +12556,12 @@ func (m GenerateCategoricalMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateCategoricalMultiError) AllErrors() []error { return m }
+func (m GetJavascriptLibrariesResponseMultiError) AllErrors() []error { return m }
 
-// GenerateCategoricalValidationError is the validation error returned by
-// GenerateCategorical.Validate if the designated constraints aren't met.
-type GenerateCategoricalValidationError struct {
+// GetJavascriptLibrariesResponseValidationError is the validation error
+// returned by GetJavascriptLibrariesResponse.Validate if the designated
+// constraints aren't met.
+type GetJavascriptLibrariesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8430,24 +12569,24 @@ type GenerateCategoricalValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateCategoricalValidationError) Field() string { return e.field }
+func (e GetJavascriptLibrariesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateCategoricalValidationError) Reason() string { return e.reason }
+func (e GetJavascriptLibrariesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateCategoricalValidationError) Cause() error { return e.cause }
+func (e GetJavascriptLibrariesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateCategoricalValidationError) Key() bool { return e.key }
+func (e GetJavascriptLibrariesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateCategoricalValidationError) ErrorName() string {
-	return "GenerateCategoricalValidationError"
+func (e GetJavascriptLibrariesResponseValidationError) ErrorName() string {
+	return "GetJavascriptLibrariesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateCategoricalValidationError) Error() string {
+func (e GetJavascriptLibrariesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8459,14 +12598,14 @@ func (e GenerateCategoricalValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateCategorical.%s: %s%s",
+		"invalid %sGetJavascriptLibrariesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateCategoricalValidationError{}
+var _ error = GetJavascriptLibrariesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8474,48 +12613,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateCategoricalValidationError{}
+} = GetJavascriptLibrariesResponseValidationError{}
 
-// Validate checks the field values on TransformCharacterScramble with the
+// Validate checks the field values on SetJavascriptLibraryRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TransformCharacterScramble) Validate() error {
+func (m *SetJavascriptLibraryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TransformCharacterScramble with the
+// ValidateAll checks the field values on SetJavascriptLibraryRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TransformCharacterScrambleMultiError, or nil if none found.
-func (m *TransformCharacterScramble) ValidateAll() error {
+// SetJavascriptLibraryRequestMultiError, or nil if none found.
+func (m *SetJavascriptLibraryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TransformCharacterScramble) validate(all bool) error {
+func (m *SetJavascriptLibraryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.UserProvidedRegex != nil {
-		// no validation rules for UserProvidedRegex
-	}
+	// no validation rules for AccountId
+
+	// no validation rules for Name
+
+	// no validation rules for Code
 
 	if len(errors) > 0 {
-		return TransformCharacterScrambleMultiError(errors)
+		return SetJavascriptLibraryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TransformCharacterScrambleMultiError is an error wrapping multiple
-// validation errors returned by TransformCharacterScramble.ValidateAll() if
+// SetJavascriptLibraryRequestMultiError is an error wrapping multiple
+// validation errors returned by SetJavascriptLibraryRequest.ValidateAll() if
 // the designated constraints aren't met.
-type TransformCharacterScrambleMultiError []error
+type SetJavascriptLibraryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransformCharacterScrambleMultiError) Error() string {
+func (m SetJavascriptLibraryRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8524,11 +12665,12 @@ func (m TransformCharacterScrambleMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransformCharacterScrambleMultiError) AllErrors() []error { return m }
+func (m SetJavascriptLibraryRequestMultiError) AllErrors() []error { return m }
 
-// TransformCharacterScrambleValidationError is the validation error returned
-// by TransformCharacterScramble.Validate if the designated constraints aren't met.
-type TransformCharacterScrambleValidationError struct {
+// SetJavascriptLibraryRequestValidationError is the validation error returned
+// by SetJavascriptLibraryRequest.Validate if the designated constraints
+// aren't met.
+type SetJavascriptLibraryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8536,24 +12678,24 @@ type TransformCharacterScrambleValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransformCharacterScrambleValidationError) Field() string { return e.field }
+func (e SetJavascriptLibraryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransformCharacterScrambleValidationError) Reason() string { return e.reason }
+func (e SetJavascriptLibraryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransformCharacterScrambleValidationError) Cause() error { return e.cause }
+func (e SetJavascriptLibraryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransformCharacterScrambleValidationError) Key() bool { return e.key }
+func (e SetJavascriptLibraryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransformCharacterScrambleValidationError) ErrorName() string {
-	return "TransformCharacterScrambleValidationError"
+func (e SetJavascriptLibraryRequestValidationError) ErrorName() string {
+	return "SetJavascriptLibraryRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TransformCharacterScrambleValidationError) Error() string {
+func (e SetJavascriptLibraryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8565,14 +12707,14 @@ func (e TransformCharacterScrambleValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransformCharacterScramble.%s: %s%s",
+		"invalid %sSetJavascriptLibraryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransformCharacterScrambleValidationError{}
+var _ error = SetJavascriptLibraryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8580,46 +12722,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransformCharacterScrambleValidationError{}
+} = SetJavascriptLibraryRequestValidationError{}
 
-// Validate checks the field values on GenerateJavascript with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SetJavascriptLibraryResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateJavascript) Validate() error {
+func (m *SetJavascriptLibraryResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateJavascript with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SetJavascriptLibraryResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateJavascriptMultiError, or nil if none found.
-func (m *GenerateJavascript) ValidateAll() error {
+// SetJavascriptLibraryResponseMultiError, or nil if none found.
+func (m *SetJavascriptLibraryResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateJavascript) validate(all bool) error {
+func (m *SetJavascriptLibraryResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Code
+	if all {
+		switch v := interface{}(m.GetLibrary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetJavascriptLibraryResponseValidationError{
+					field:  "Library",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetJavascriptLibraryResponseValidationError{
+					field:  "Library",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLibrary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetJavascriptLibraryResponseValidationError{
+				field:  "Library",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GenerateJavascriptMultiError(errors)
+		return SetJavascriptLibraryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateJavascriptMultiError is an error wrapping multiple validation errors
-// returned by GenerateJavascript.ValidateAll() if the designated constraints
-// aren't met.
-type GenerateJavascriptMultiError []error
+// SetJavascriptLibraryResponseMultiError is an error wrapping multiple
+// validation errors returned by SetJavascriptLibraryResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SetJavascriptLibraryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateJavascriptMultiError) Error() string {
+func (m SetJavascriptLibraryResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8628,11 +12797,12 @@ func (m GenerateJavascriptMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateJavascriptMultiError) AllErrors() []error { return m }
+func (m SetJavascriptLibraryResponseMultiError) AllErrors() []error { return m }
 
-// GenerateJavascriptValidationError is the validation error returned by
-// GenerateJavascript.Validate if the designated constraints aren't met.
-type GenerateJavascriptValidationError struct {
+// SetJavascriptLibraryResponseValidationError is the validation error returned
+// by SetJavascriptLibraryResponse.Validate if the designated constraints
+// aren't met.
+type SetJavascriptLibraryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8640,24 +12810,24 @@ type GenerateJavascriptValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateJavascriptValidationError) Field() string { return e.field }
+func (e SetJavascriptLibraryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateJavascriptValidationError) Reason() string { return e.reason }
+func (e SetJavascriptLibraryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateJavascriptValidationError) Cause() error { return e.cause }
+func (e SetJavascriptLibraryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateJavascriptValidationError) Key() bool { return e.key }
+func (e SetJavascriptLibraryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateJavascriptValidationError) ErrorName() string {
-	return "GenerateJavascriptValidationError"
+func (e SetJavascriptLibraryResponseValidationError) ErrorName() string {
+	return "SetJavascriptLibraryResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateJavascriptValidationError) Error() string {
+func (e SetJavascriptLibraryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8669,14 +12839,14 @@ func (e GenerateJavascriptValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateJavascript.%s: %s%s",
+		"invalid %sSetJavascriptLibraryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateJavascriptValidationError{}
+var _ error = SetJavascriptLibraryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8684,24 +12854,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateJavascriptValidationError{}
+} = SetJavascriptLibraryResponseValidationError{}
 
-// Validate checks the field values on ValidateUserRegexCodeRequest with the
+// Validate checks the field values on DeleteJavascriptLibraryRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ValidateUserRegexCodeRequest) Validate() error {
+func (m *DeleteJavascriptLibraryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ValidateUserRegexCodeRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ValidateUserRegexCodeRequestMultiError, or nil if none found.
-func (m *ValidateUserRegexCodeRequest) ValidateAll() error {
+// ValidateAll checks the field values on DeleteJavascriptLibraryRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteJavascriptLibraryRequestMultiError, or nil if none found.
+func (m *DeleteJavascriptLibraryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ValidateUserRegexCodeRequest) validate(all bool) error {
+func (m *DeleteJavascriptLibraryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8710,22 +12880,22 @@ func (m *ValidateUserRegexCodeRequest) validate(all bool) error {
 
 	// no validation rules for AccountId
 
-	// no validation rules for UserProvidedRegex
+	// no validation rules for Name
 
 	if len(errors) > 0 {
-		return ValidateUserRegexCodeRequestMultiError(errors)
+		return DeleteJavascriptLibraryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ValidateUserRegexCodeRequestMultiError is an error wrapping multiple
-// validation errors returned by ValidateUserRegexCodeRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ValidateUserRegexCodeRequestMultiError []error
+// DeleteJavascriptLibraryRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteJavascriptLibraryRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteJavascriptLibraryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ValidateUserRegexCodeRequestMultiError) Error() string {
+func (m DeleteJavascriptLibraryRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8734,12 +12904,12 @@ func (m ValidateUserRegexCodeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ValidateUserRegexCodeRequestMultiError) AllErrors() []error { return m }
+func (m DeleteJavascriptLibraryRequestMultiError) AllErrors() []error { return m }
 
-// ValidateUserRegexCodeRequestValidationError is the validation error returned
-// by ValidateUserRegexCodeRequest.Validate if the designated constraints
-// aren't met.
-type ValidateUserRegexCodeRequestValidationError struct {
+// DeleteJavascriptLibraryRequestValidationError is the validation error
+// returned by DeleteJavascriptLibraryRequest.Validate if the designated
+// constraints aren't met.
+type DeleteJavascriptLibraryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8747,24 +12917,24 @@ type ValidateUserRegexCodeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ValidateUserRegexCodeRequestValidationError) Field() string { return e.field }
+func (e DeleteJavascriptLibraryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ValidateUserRegexCodeRequestValidationError) Reason() string { return e.reason }
+func (e DeleteJavascriptLibraryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ValidateUserRegexCodeRequestValidationError) Cause() error { return e.cause }
+func (e DeleteJavascriptLibraryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ValidateUserRegexCodeRequestValidationError) Key() bool { return e.key }
+func (e DeleteJavascriptLibraryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ValidateUserRegexCodeRequestValidationError) ErrorName() string {
-	return "ValidateUserRegexCodeRequestValidationError"
+func (e DeleteJavascriptLibraryRequestValidationError) ErrorName() string {
+	return "DeleteJavascriptLibraryRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ValidateUserRegexCodeRequestValidationError) Error() string {
+func (e DeleteJavascriptLibraryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8776,14 +12946,14 @@ func (e ValidateUserRegexCodeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sValidateUserRegexCodeRequest.%s: %s%s",
+		"invalid %sDeleteJavascriptLibraryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ValidateUserRegexCodeRequestValidationError{}
+var _ error = DeleteJavascriptLibraryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8791,46 +12961,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ValidateUserRegexCodeRequestValidationError{}
+} = DeleteJavascriptLibraryRequestValidationError{}
 
-// Validate checks the field values on ValidateUserRegexCodeResponse with the
+// Validate checks the field values on DeleteJavascriptLibraryResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ValidateUserRegexCodeResponse) Validate() error {
+func (m *DeleteJavascriptLibraryResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ValidateUserRegexCodeResponse with
+// ValidateAll checks the field values on DeleteJavascriptLibraryResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// ValidateUserRegexCodeResponseMultiError, or nil if none found.
-func (m *ValidateUserRegexCodeResponse) ValidateAll() error {
+// DeleteJavascriptLibraryResponseMultiError, or nil if none found.
+func (m *DeleteJavascriptLibraryResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ValidateUserRegexCodeResponse) validate(all bool) error {
+func (m *DeleteJavascriptLibraryResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Valid
-
 	if len(errors) > 0 {
-		return ValidateUserRegexCodeResponseMultiError(errors)
+		return DeleteJavascriptLibraryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ValidateUserRegexCodeResponseMultiError is an error wrapping multiple
-// validation errors returned by ValidateUserRegexCodeResponse.ValidateAll()
+// DeleteJavascriptLibraryResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteJavascriptLibraryResponse.ValidateAll()
 // if the designated constraints aren't met.
-type ValidateUserRegexCodeResponseMultiError []error
+type DeleteJavascriptLibraryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ValidateUserRegexCodeResponseMultiError) Error() string {
+func (m DeleteJavascriptLibraryResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8839,12 +13007,12 @@ func (m ValidateUserRegexCodeResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ValidateUserRegexCodeResponseMultiError) AllErrors() []error { return m }
+func (m DeleteJavascriptLibraryResponseMultiError) AllErrors() []error { return m }
 
-// ValidateUserRegexCodeResponseValidationError is the validation error
-// returned by ValidateUserRegexCodeResponse.Validate if the designated
+// DeleteJavascriptLibraryResponseValidationError is the validation error
+// returned by DeleteJavascriptLibraryResponse.Validate if the designated
 // constraints aren't met.
-type ValidateUserRegexCodeResponseValidationError struct {
+type DeleteJavascriptLibraryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8852,24 +13020,24 @@ type ValidateUserRegexCodeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ValidateUserRegexCodeResponseValidationError) Field() string { return e.field }
+func (e DeleteJavascriptLibraryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ValidateUserRegexCodeResponseValidationError) Reason() string { return e.reason }
+func (e DeleteJavascriptLibraryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ValidateUserRegexCodeResponseValidationError) Cause() error { return e.cause }
+func (e DeleteJavascriptLibraryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ValidateUserRegexCodeResponseValidationError) Key() bool { return e.key }
+func (e DeleteJavascriptLibraryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ValidateUserRegexCodeResponseValidationError) ErrorName() string {
-	return "ValidateUserRegexCodeResponseValidationError"
+func (e DeleteJavascriptLibraryResponseValidationError) ErrorName() string {
+	return "DeleteJavascriptLibraryResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ValidateUserRegexCodeResponseValidationError) Error() string {
+func (e DeleteJavascriptLibraryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8881,14 +13049,14 @@ func (e ValidateUserRegexCodeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sValidateUserRegexCodeResponse.%s: %s%s",
+		"invalid %sDeleteJavascriptLibraryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ValidateUserRegexCodeResponseValidationError{}
+var _ error = DeleteJavascriptLibraryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8896,4 +13064,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ValidateUserRegexCodeResponseValidationError{}
+} = DeleteJavascriptLibraryResponseValidationError{}
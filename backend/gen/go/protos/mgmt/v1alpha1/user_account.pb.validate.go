@@ -1406,6 +1406,430 @@ var _ interface {
 	ErrorName() string
 } = IsUserInAccountResponseValidationError{}
 
+// Validate checks the field values on GrantAccountPermissionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GrantAccountPermissionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GrantAccountPermissionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GrantAccountPermissionRequestMultiError, or nil if none found.
+func (m *GrantAccountPermissionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GrantAccountPermissionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	// no validation rules for UserId
+
+	// no validation rules for Permission
+
+	if len(errors) > 0 {
+		return GrantAccountPermissionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GrantAccountPermissionRequestMultiError is an error wrapping multiple
+// validation errors returned by GrantAccountPermissionRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GrantAccountPermissionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GrantAccountPermissionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GrantAccountPermissionRequestMultiError) AllErrors() []error { return m }
+
+// GrantAccountPermissionRequestValidationError is the validation error
+// returned by GrantAccountPermissionRequest.Validate if the designated
+// constraints aren't met.
+type GrantAccountPermissionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GrantAccountPermissionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GrantAccountPermissionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GrantAccountPermissionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GrantAccountPermissionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GrantAccountPermissionRequestValidationError) ErrorName() string {
+	return "GrantAccountPermissionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GrantAccountPermissionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGrantAccountPermissionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GrantAccountPermissionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GrantAccountPermissionRequestValidationError{}
+
+// Validate checks the field values on GrantAccountPermissionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GrantAccountPermissionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GrantAccountPermissionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GrantAccountPermissionResponseMultiError, or nil if none found.
+func (m *GrantAccountPermissionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GrantAccountPermissionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GrantAccountPermissionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GrantAccountPermissionResponseMultiError is an error wrapping multiple
+// validation errors returned by GrantAccountPermissionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GrantAccountPermissionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GrantAccountPermissionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GrantAccountPermissionResponseMultiError) AllErrors() []error { return m }
+
+// GrantAccountPermissionResponseValidationError is the validation error
+// returned by GrantAccountPermissionResponse.Validate if the designated
+// constraints aren't met.
+type GrantAccountPermissionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GrantAccountPermissionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GrantAccountPermissionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GrantAccountPermissionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GrantAccountPermissionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GrantAccountPermissionResponseValidationError) ErrorName() string {
+	return "GrantAccountPermissionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GrantAccountPermissionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGrantAccountPermissionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GrantAccountPermissionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GrantAccountPermissionResponseValidationError{}
+
+// Validate checks the field values on RevokeAccountPermissionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeAccountPermissionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeAccountPermissionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeAccountPermissionRequestMultiError, or nil if none found.
+func (m *RevokeAccountPermissionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeAccountPermissionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	// no validation rules for UserId
+
+	// no validation rules for Permission
+
+	if len(errors) > 0 {
+		return RevokeAccountPermissionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeAccountPermissionRequestMultiError is an error wrapping multiple
+// validation errors returned by RevokeAccountPermissionRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeAccountPermissionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeAccountPermissionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeAccountPermissionRequestMultiError) AllErrors() []error { return m }
+
+// RevokeAccountPermissionRequestValidationError is the validation error
+// returned by RevokeAccountPermissionRequest.Validate if the designated
+// constraints aren't met.
+type RevokeAccountPermissionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeAccountPermissionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeAccountPermissionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeAccountPermissionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeAccountPermissionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeAccountPermissionRequestValidationError) ErrorName() string {
+	return "RevokeAccountPermissionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeAccountPermissionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeAccountPermissionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeAccountPermissionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeAccountPermissionRequestValidationError{}
+
+// Validate checks the field values on RevokeAccountPermissionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeAccountPermissionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeAccountPermissionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeAccountPermissionResponseMultiError, or nil if none found.
+func (m *RevokeAccountPermissionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeAccountPermissionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeAccountPermissionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeAccountPermissionResponseMultiError is an error wrapping multiple
+// validation errors returned by RevokeAccountPermissionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeAccountPermissionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeAccountPermissionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeAccountPermissionResponseMultiError) AllErrors() []error { return m }
+
+// RevokeAccountPermissionResponseValidationError is the validation error
+// returned by RevokeAccountPermissionResponse.Validate if the designated
+// constraints aren't met.
+type RevokeAccountPermissionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeAccountPermissionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeAccountPermissionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeAccountPermissionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeAccountPermissionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeAccountPermissionResponseValidationError) ErrorName() string {
+	return "RevokeAccountPermissionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeAccountPermissionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeAccountPermissionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeAccountPermissionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeAccountPermissionResponseValidationError{}
+
 // Validate checks the field values on GetAccountTemporalConfigRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -2,11 +2,14 @@ package awsmanager
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/credentials/ec2rolecreds"
@@ -46,7 +49,9 @@ func (n *NeosyncAwsManager) NewS3Client(ctx context.Context, config *mgmtv1alpha
 	if err != nil {
 		return nil, err
 	}
-	return s3.NewFromConfig(*cfg), nil
+	return s3.NewFromConfig(*cfg, func(o *s3.Options) {
+		o.UsePathStyle = config.GetForcePathStyle()
+	}), nil
 }
 
 func (n *NeosyncAwsManager) ListObjectsV2(
@@ -109,19 +114,10 @@ func (n *NeosyncAwsManager) getAwsConfig(ctx context.Context, config *mgmtv1alph
 		awsCfg.Region = region
 	}
 	if endpoint := config.GetEndpoint(); endpoint != "" {
-		customResolver := aws.EndpointResolverWithOptionsFunc(func(service, region string, options ...any) (aws.Endpoint, error) {
-			return aws.Endpoint{
-				PartitionID:   "aws",
-				URL:           endpoint,
-				SigningRegion: awsCfg.Region,
-			}, nil
-		})
-
-		cfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithEndpointResolverWithOptions(customResolver))
-		if err != nil {
-			return nil, err
-		}
-		awsCfg = &cfg
+		awsCfg.BaseEndpoint = aws.String(endpoint)
+	}
+	if config.GetInsecureSkipVerify() {
+		awsCfg.HTTPClient = newInsecureHttpClient()
 	}
 
 	if role := configCreds.GetRoleArn(); role != "" {
@@ -142,6 +138,16 @@ func (n *NeosyncAwsManager) getAwsConfig(ctx context.Context, config *mgmtv1alph
 	return awsCfg, nil
 }
 
+// Returns an http client that does not verify the TLS certificate of the server, for S3-compatible stores with self-signed certificates
+func newInsecureHttpClient() *awshttp.BuildableClient {
+	return awshttp.NewBuildableClient().WithTransportOptions(func(tr *http.Transport) {
+		if tr.TLSClientConfig == nil {
+			tr.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+		tr.TLSClientConfig.InsecureSkipVerify = true //nolint:gosec
+	})
+}
+
 func IsNotFound(err error) bool {
 	if err != nil {
 		var apiErr smithy.APIError
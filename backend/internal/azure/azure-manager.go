@@ -0,0 +1,105 @@
+package azuremanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+)
+
+type NeosyncAzureManager struct {
+}
+
+type NeosyncAzureManagerClient interface {
+	GetBlobClient(ctx context.Context, config *mgmtv1alpha1.AzureBlobStorageConnectionConfig) (BlobClient, error)
+}
+
+// A client that is scoped to the container of a Blob Storage connection
+type BlobClient interface {
+	// Returns the prefixes directly beneath the given prefix, i.e. the virtual directories delimited by a /
+	ListPrefixes(ctx context.Context, prefix string) ([]string, error)
+	// Returns the names of every blob under the given prefix
+	ListObjects(ctx context.Context, prefix string) ([]string, error)
+	// Opens the blob for reading. The contents are returned as they are stored
+	GetObject(ctx context.Context, name string) (io.ReadCloser, error)
+}
+
+func New() *NeosyncAzureManager {
+	return &NeosyncAzureManager{}
+}
+
+func (n *NeosyncAzureManager) GetBlobClient(ctx context.Context, config *mgmtv1alpha1.AzureBlobStorageConnectionConfig) (BlobClient, error) {
+	serviceUrl := fmt.Sprintf("https://%s.blob.core.windows.net/", config.GetStorageAccount())
+	var client *azblob.Client
+	var err error
+	switch {
+	case config.GetConnectionString() != "":
+		client, err = azblob.NewClientFromConnectionString(config.GetConnectionString(), nil)
+	case config.GetStorageAccessKey() != "":
+		cred, credErr := azblob.NewSharedKeyCredential(config.GetStorageAccount(), config.GetStorageAccessKey())
+		if credErr != nil {
+			return nil, fmt.Errorf("unable to create azure shared key credential: %w", credErr)
+		}
+		client, err = azblob.NewClientWithSharedKeyCredential(serviceUrl, cred, nil)
+	case config.GetSasToken() != "":
+		// tokens copied from the portal are prefixed with the ? that separates them from the url
+		client, err = azblob.NewClientWithNoCredential(fmt.Sprintf("%s?%s", serviceUrl, strings.TrimPrefix(config.GetSasToken(), "?")), nil)
+	default:
+		return nil, errors.New("one of storage access key, sas token or connection string must be provided")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to create azure blob client: %w", err)
+	}
+	return &blobClient{container: client.ServiceClient().NewContainerClient(config.GetContainer())}, nil
+}
+
+type blobClient struct {
+	container *container.Client
+}
+
+func (b *blobClient) ListPrefixes(ctx context.Context, prefix string) ([]string, error) {
+	output := []string{}
+	pager := b.container.NewListBlobsHierarchyPager("/", &container.ListBlobsHierarchyOptions{Prefix: &prefix})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list azure blob prefixes under %s: %w", prefix, err)
+		}
+		for _, blobPrefix := range page.Segment.BlobPrefixes {
+			if blobPrefix.Name != nil {
+				output = append(output, *blobPrefix.Name)
+			}
+		}
+	}
+	return output, nil
+}
+
+func (b *blobClient) ListObjects(ctx context.Context, prefix string) ([]string, error) {
+	output := []string{}
+	pager := b.container.NewListBlobsFlatPager(&container.ListBlobsFlatOptions{Prefix: &prefix})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list azure blobs under %s: %w", prefix, err)
+		}
+		for _, item := range page.Segment.BlobItems {
+			if item.Name != nil {
+				output = append(output, *item.Name)
+			}
+		}
+	}
+	return output, nil
+}
+
+func (b *blobClient) GetObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	resp, err := b.container.NewBlobClient(name).DownloadStream(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read azure blob %s: %w", name, err)
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,217 @@
+package classification
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+)
+
+var (
+	columnPatterns = []*columnPattern{
+		{
+			classification: mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII,
+			regex: regexp.MustCompile(
+				`(^|_)(e?mail|email_address|phone|phone_number|mobile|ssn|social_security|first_?name|last_?name|full_?name|surname|` +
+					`address|street|zip|zipcode|postal_code|dob|birth_?date|date_of_birth|ip_address|passport|drivers_license|username|gender)($|_)`,
+			),
+		},
+		{
+			classification: mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PHI,
+			regex: regexp.MustCompile(
+				`(^|_)(patient|diagnosis|diagnoses|mrn|medical|medication|prescription|icd|icd10|npi|treatment|allergy|allergies|condition|health|insurance_id)($|_)`,
+			),
+		},
+		{
+			classification: mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_FINANCIAL,
+			regex: regexp.MustCompile(
+				`(^|_)(card|card_number|credit_card|cc_number|cvv|iban|swift|routing_number|account_number|bank_account|salary|income|tax_id)($|_)`,
+			),
+		},
+	}
+
+	sourceClassifications = map[mgmtv1alpha1.TransformerSource]mgmtv1alpha1.DataClassification{
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_EMAIL:               mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL:              mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_CITY:                mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_E164_PHONE_NUMBER:   mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_FIRST_NAME:          mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_FULL_ADDRESS:        mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_FULL_NAME:           mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_GENDER:              mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_INT64_PHONE_NUMBER:  mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_LAST_NAME:           mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_SSN:                 mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STATE:               mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STREET_ADDRESS:      mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STRING_PHONE_NUMBER: mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_USERNAME:            mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_ZIPCODE:             mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_E164_PHONE_NUMBER:  mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FIRST_NAME:         mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FULL_NAME:          mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_INT64_PHONE_NUMBER: mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_LAST_NAME:          mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_PHONE_NUMBER:       mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_CARD_NUMBER:         mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_FINANCIAL,
+	}
+)
+
+type columnPattern struct {
+	classification mgmtv1alpha1.DataClassification
+	regex          *regexp.Regexp
+}
+
+// Infers the sensitivity classes of a column based on its name and the transformer that has been configured for it.
+// The transformer is used as a hint as users tend to pick a transformer that matches the shape of the data.
+func ClassifyColumn(
+	column string,
+	source mgmtv1alpha1.TransformerSource,
+) []mgmtv1alpha1.DataClassification {
+	output := []mgmtv1alpha1.DataClassification{}
+	normalized := normalizeColumnName(column)
+	for _, pattern := range columnPatterns {
+		if pattern.regex.MatchString(normalized) {
+			output = append(output, pattern.classification)
+		}
+	}
+	if classification, ok := sourceClassifications[source]; ok {
+		output = append(output, classification)
+	}
+	slices.Sort(output)
+	return slices.Compact(output)
+}
+
+// Classifications that have been explicitly tagged on columns, keyed by BuildColumnKey
+type ColumnTagMap map[string][]mgmtv1alpha1.DataClassification
+
+func BuildColumnKey(schema, table, column string) string {
+	return fmt.Sprintf("%s.%s.%s", schema, table, column)
+}
+
+// Returns the tagged classifications of a column if it has been tagged, otherwise falls back to inferring them.
+// Tags are authoritative, which allows a user to mark a column as public even if it looks sensitive.
+func Classify(
+	tags ColumnTagMap,
+	schema, table, column string,
+	source mgmtv1alpha1.TransformerSource,
+) []mgmtv1alpha1.DataClassification {
+	if classifications, ok := tags[BuildColumnKey(schema, table, column)]; ok {
+		return classifications
+	}
+	return ClassifyColumn(column, source)
+}
+
+// Returns true if any of the classifications are considered to be sensitive
+func IsSensitive(classifications []mgmtv1alpha1.DataClassification) bool {
+	for _, classification := range classifications {
+		switch classification {
+		case mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII,
+			mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PHI,
+			mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_FINANCIAL:
+			return true
+		}
+	}
+	return false
+}
+
+// Returns true if the transformer source leaves the underlying value untouched
+func IsPassthrough(source mgmtv1alpha1.TransformerSource) bool {
+	return source == mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH ||
+		source == mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_UNSPECIFIED
+}
+
+// Returns the transformer for a column whose name looks like personal information, or nil if it does not.
+// When generate is set, the transformer generates a new value instead of transforming the existing one
+func RecommendTransformer(column string, generate bool) *mgmtv1alpha1.JobMappingTransformer {
+	name := strings.ToLower(strings.ReplaceAll(column, "_", ""))
+	switch {
+	case strings.Contains(name, "email"):
+		if generate {
+			return &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_EMAIL,
+				Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_GenerateEmailConfig{GenerateEmailConfig: &mgmtv1alpha1.GenerateEmail{}}},
+			}
+		}
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL,
+			Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_TransformEmailConfig{TransformEmailConfig: &mgmtv1alpha1.TransformEmail{PreserveDomain: true}}},
+		}
+	case strings.Contains(name, "firstname"):
+		if generate {
+			return &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_FIRST_NAME,
+				Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_GenerateFirstNameConfig{GenerateFirstNameConfig: &mgmtv1alpha1.GenerateFirstName{}}},
+			}
+		}
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FIRST_NAME,
+			Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_TransformFirstNameConfig{TransformFirstNameConfig: &mgmtv1alpha1.TransformFirstName{}}},
+		}
+	case strings.Contains(name, "lastname"), strings.Contains(name, "surname"):
+		if generate {
+			return &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_LAST_NAME,
+				Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_GenerateLastNameConfig{GenerateLastNameConfig: &mgmtv1alpha1.GenerateLastName{}}},
+			}
+		}
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_LAST_NAME,
+			Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_TransformLastNameConfig{TransformLastNameConfig: &mgmtv1alpha1.TransformLastName{}}},
+		}
+	case strings.Contains(name, "fullname"):
+		if generate {
+			return &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_FULL_NAME,
+				Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_GenerateFullNameConfig{GenerateFullNameConfig: &mgmtv1alpha1.GenerateFullName{}}},
+			}
+		}
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FULL_NAME,
+			Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_TransformFullNameConfig{TransformFullNameConfig: &mgmtv1alpha1.TransformFullName{}}},
+		}
+	case strings.Contains(name, "phone"):
+		if generate {
+			return &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STRING_PHONE_NUMBER,
+				Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_GenerateStringPhoneNumberConfig{GenerateStringPhoneNumberConfig: &mgmtv1alpha1.GenerateStringPhoneNumber{Min: 9, Max: 15}}},
+			}
+		}
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_PHONE_NUMBER,
+			Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_TransformPhoneNumberConfig{TransformPhoneNumberConfig: &mgmtv1alpha1.TransformPhoneNumber{PreserveLength: true}}},
+		}
+	case name == "ssn" || strings.Contains(name, "socialsecurity"):
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_SSN,
+			Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_GenerateSsnConfig{GenerateSsnConfig: &mgmtv1alpha1.GenerateSSN{}}},
+		}
+	case strings.Contains(name, "address"), strings.Contains(name, "street"):
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STREET_ADDRESS,
+			Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_GenerateStreetAddressConfig{GenerateStreetAddressConfig: &mgmtv1alpha1.GenerateStreetAddress{}}},
+		}
+	case name == "city":
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_CITY,
+			Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_GenerateCityConfig{GenerateCityConfig: &mgmtv1alpha1.GenerateCity{}}},
+		}
+	case strings.Contains(name, "zipcode"), strings.Contains(name, "postalcode"):
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_ZIPCODE,
+			Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_GenerateZipcodeConfig{GenerateZipcodeConfig: &mgmtv1alpha1.GenerateZipcode{}}},
+		}
+	}
+	return nil
+}
+
+var camelCaseBoundary = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+
+// lowercases the column and converts camelCase and dashes into snake case
+func normalizeColumnName(column string) string {
+	column = camelCaseBoundary.ReplaceAllString(column, "${1}_${2}")
+	column = strings.ReplaceAll(column, "-", "_")
+	return strings.ToLower(column)
+}
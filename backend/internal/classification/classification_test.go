@@ -0,0 +1,76 @@
+package classification
+
+import (
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ClassifyColumn(t *testing.T) {
+	type testcase struct {
+		column   string
+		source   mgmtv1alpha1.TransformerSource
+		expected []mgmtv1alpha1.DataClassification
+	}
+
+	passthrough := mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH
+	pii := mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII
+	phi := mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PHI
+	financial := mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_FINANCIAL
+
+	testcases := []testcase{
+		{column: "email", source: passthrough, expected: []mgmtv1alpha1.DataClassification{pii}},
+		{column: "firstName", source: passthrough, expected: []mgmtv1alpha1.DataClassification{pii}},
+		{column: "patient_ssn", source: passthrough, expected: []mgmtv1alpha1.DataClassification{pii, phi}},
+		{column: "credit_card", source: passthrough, expected: []mgmtv1alpha1.DataClassification{financial}},
+		{column: "id", source: passthrough, expected: []mgmtv1alpha1.DataClassification{}},
+		{column: "emailer_id", source: passthrough, expected: []mgmtv1alpha1.DataClassification{}},
+		{column: "foo", source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL, expected: []mgmtv1alpha1.DataClassification{pii}},
+		{column: "email", source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL, expected: []mgmtv1alpha1.DataClassification{pii}},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.column, func(t *testing.T) {
+			require.Equal(t, tc.expected, ClassifyColumn(tc.column, tc.source))
+		})
+	}
+}
+
+func Test_Classify(t *testing.T) {
+	passthrough := mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH
+	public := []mgmtv1alpha1.DataClassification{mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PUBLIC}
+	tags := ColumnTagMap{
+		BuildColumnKey("public", "users", "email"): public,
+	}
+
+	require.Equal(t, public, Classify(tags, "public", "users", "email", passthrough))
+	require.Equal(
+		t,
+		[]mgmtv1alpha1.DataClassification{mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII},
+		Classify(tags, "public", "accounts", "email", passthrough),
+	)
+	require.Equal(
+		t,
+		[]mgmtv1alpha1.DataClassification{mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII},
+		Classify(nil, "public", "users", "email", passthrough),
+	)
+}
+
+func Test_IsSensitive(t *testing.T) {
+	require.False(t, IsSensitive(nil))
+	require.False(t, IsSensitive([]mgmtv1alpha1.DataClassification{mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PUBLIC}))
+	require.True(t, IsSensitive([]mgmtv1alpha1.DataClassification{mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PHI}))
+}
+
+func Test_IsPassthrough(t *testing.T) {
+	require.True(t, IsPassthrough(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH))
+	require.False(t, IsPassthrough(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_SSN))
+}
+
+func Test_RecommendTransformer(t *testing.T) {
+	require.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL, RecommendTransformer("user_email", false).GetSource())
+	require.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_EMAIL, RecommendTransformer("user_email", true).GetSource())
+	require.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_SSN, RecommendTransformer("ssn", false).GetSource())
+	require.Nil(t, RecommendTransformer("id", false))
+}
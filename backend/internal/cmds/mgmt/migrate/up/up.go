@@ -11,6 +11,7 @@ import (
 
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
@@ -134,6 +135,76 @@ func Up(
 	return nil
 }
 
+type Status struct {
+	// The version of the last applied migration, nil if no migrations have been applied
+	CurrentVersion *uint
+	// The version of the newest migration in the schema directory
+	LatestVersion uint
+	Dirty         bool
+}
+
+// Reports the applied migration version of the database alongside the newest migration found in the schema directory
+func GetStatus(
+	connStr string,
+	schemaDir string,
+) (*Status, error) {
+	sourceUrl, err := getSourceUrl(schemaDir)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := getLatestVersion(sourceUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := migrate.New(
+		sourceUrl,
+		connStr,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer m.Close()
+
+	status := &Status{LatestVersion: latest}
+	version, dirty, err := m.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return nil, err
+	}
+	if err == nil {
+		status.CurrentVersion = &version
+		status.Dirty = dirty
+	}
+	return status, nil
+}
+
+func getLatestVersion(sourceUrl string) (uint, error) {
+	driver, err := source.Open(sourceUrl)
+	if err != nil {
+		return 0, err
+	}
+	defer driver.Close()
+
+	version, err := driver.First()
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	for {
+		next, err := driver.Next(version)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return version, nil
+			}
+			return 0, err
+		}
+		version = next
+	}
+}
+
 func getDbUrl() (string, error) {
 	dburl := viper.GetString("DB_URL")
 	if dburl != "" {
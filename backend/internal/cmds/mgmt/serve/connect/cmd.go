@@ -15,7 +15,6 @@ import (
 	"connectrpc.com/grpchealth"
 	"connectrpc.com/grpcreflect"
 	"connectrpc.com/otelconnect"
-	"connectrpc.com/validate"
 	"github.com/auth0/go-jwt-middleware/v2/validator"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
 
@@ -30,22 +29,35 @@ import (
 	"github.com/nucleuscloud/neosync/backend/internal/authmgmt/auth0"
 	"github.com/nucleuscloud/neosync/backend/internal/authmgmt/keycloak"
 	awsmanager "github.com/nucleuscloud/neosync/backend/internal/aws"
+	azuremanager "github.com/nucleuscloud/neosync/backend/internal/azure"
 	up_cmd "github.com/nucleuscloud/neosync/backend/internal/cmds/mgmt/migrate/up"
 	auth_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/auth"
 	authlogging_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/auth_logging"
 	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
 	logging_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logging"
+	validate_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/validate"
+	elasticsearchmanager "github.com/nucleuscloud/neosync/backend/internal/elasticsearch"
+	gcpmanager "github.com/nucleuscloud/neosync/backend/internal/gcp"
+	"github.com/nucleuscloud/neosync/backend/internal/graphqlapi"
+	kafkamanager "github.com/nucleuscloud/neosync/backend/internal/kafka"
 	neosynclogger "github.com/nucleuscloud/neosync/backend/internal/logger"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/nucleuscloud/neosync/backend/internal/objectstore"
+	"github.com/nucleuscloud/neosync/backend/internal/ratelimit"
 	clientmanager "github.com/nucleuscloud/neosync/backend/internal/temporal/client-manager"
+	"github.com/nucleuscloud/neosync/backend/pkg/dbcredentials"
+	"github.com/nucleuscloud/neosync/backend/pkg/nonfinite"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
 	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
 	v1alpha1_apikeyservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/api-key-service"
 	v1alpha1_authservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/auth-service"
+	v1alpha1_complianceservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/compliance-service"
 	v1alpha1_connectiondataservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/connection-data-service"
 	v1alpha1_connectionservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/connection-service"
 	v1alpha1_jobservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/job-service"
 	v1alpha1_metricsservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/metrics-service"
+	v1alpha1_searchservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/search-service"
+	v1alpha1_systemservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/system-service"
 	v1alpha1_transformerservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/transformers-service"
 	v1alpha1_useraccountservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/user-account-service"
 
@@ -101,6 +113,9 @@ func serve(ctx context.Context) error {
 		mgmtv1alpha1connect.TransformersServiceName,
 		mgmtv1alpha1connect.ApiKeyServiceName,
 		mgmtv1alpha1connect.ConnectionDataServiceName,
+		mgmtv1alpha1connect.ComplianceServiceName,
+		mgmtv1alpha1connect.SearchServiceName,
+		mgmtv1alpha1connect.SystemServiceName,
 	}
 
 	if shouldServiceMetrics() {
@@ -151,7 +166,7 @@ func serve(ctx context.Context) error {
 		}
 	}
 
-	validateInterceptor, err := validate.NewInterceptor()
+	validateInterceptor, err := validate_interceptor.NewInterceptor()
 	if err != nil {
 		return err
 	}
@@ -196,6 +211,7 @@ func serve(ctx context.Context) error {
 			mgmtv1alpha1connect.ConnectionDataServiceGetConnectionForeignConstraintsProcedure,
 			mgmtv1alpha1connect.ConnectionDataServiceGetConnectionPrimaryConstraintsProcedure,
 			mgmtv1alpha1connect.ConnectionDataServiceGetConnectionInitStatementsProcedure,
+			mgmtv1alpha1connect.JobServiceCreateJobRunArtifactProcedure,
 		})
 		stdAuthInterceptors = append(
 			stdAuthInterceptors,
@@ -257,6 +273,7 @@ func serve(ctx context.Context) error {
 		mgmtv1alpha1connect.NewAuthServiceHandler(
 			authService,
 			connect.WithInterceptors(authSvcInterceptors...),
+			connect.WithInterceptors(validateInterceptor),
 		),
 	)
 
@@ -304,13 +321,15 @@ func serve(ctx context.Context) error {
 
 	pgquerier := pg_queries.New()
 	mysqlquerier := mysql_queries.New()
-	sqlConnector := &sqlconnect.SqlOpenConnector{}
+	// connections that are configured to use temporary credentials are opened with credentials minted by the provider
+	sqlConnector := dbcredentials.NewSqlConnector(&sqlconnect.SqlOpenConnector{}, dbcredentials.New(getDbCredentialsConfig()))
 	pgpoolmap := &sync.Map{}
 	mysqlpoolmap := &sync.Map{}
 	sqlmanager := sql_manager.NewSqlManager(pgpoolmap, pgquerier, mysqlpoolmap, mysqlquerier, sqlConnector)
 
+	gcpManager := gcpmanager.New()
 	connectionService := v1alpha1_connectionservice.New(&v1alpha1_connectionservice.Config{}, db, useraccountService, sqlConnector, pgquerier,
-		mysqlquerier)
+		mysqlquerier, gcpManager)
 	api.Handle(
 		mgmtv1alpha1connect.NewConnectionServiceHandler(
 			connectionService,
@@ -325,9 +344,20 @@ func serve(ctx context.Context) error {
 		return err
 	}
 
+	var artifactStore objectstore.Interface
+	if artifactStoreConfig := getArtifactStoreConfig(); artifactStoreConfig != nil {
+		s3store, err := objectstore.NewS3Store(ctx, artifactStoreConfig)
+		if err != nil {
+			return err
+		}
+		artifactStore = s3store
+	}
+
 	jobServiceConfig := &v1alpha1_jobservice.Config{
-		IsAuthEnabled: isAuthEnabled,
-		RunLogConfig:  runLogConfig,
+		IsAuthEnabled:              isAuthEnabled,
+		RunLogConfig:               runLogConfig,
+		IsJobChangeApprovalEnabled: getIsJobChangeApprovalEnabled(),
+		StaleRunConfig:             getStaleRunConfig(),
 	}
 	jobService := v1alpha1_jobservice.New(
 		jobServiceConfig,
@@ -336,7 +366,9 @@ func serve(ctx context.Context) error {
 		connectionService,
 		useraccountService,
 		sqlmanager,
+		artifactStore,
 	)
+	go jobService.WatchStaleJobRuns(ctx, logger)
 	api.Handle(
 		mgmtv1alpha1connect.NewJobServiceHandler(
 			jobService,
@@ -356,13 +388,29 @@ func serve(ctx context.Context) error {
 		),
 	)
 
+	nonFinitePolicy, err := getNonFinitePolicy()
+	if err != nil {
+		return err
+	}
 	awsManager := awsmanager.New()
+	azureManager := azuremanager.New()
+	kafkaManager := kafkamanager.New()
+	elasticsearchManager := elasticsearchmanager.New()
 	connectionDataService := v1alpha1_connectiondataservice.New(
-		&v1alpha1_connectiondataservice.Config{},
+		&v1alpha1_connectiondataservice.Config{
+			RateLimits:            getDataAccessRateLimits(),
+			IsQueryConsoleEnabled: getIsQueryConsoleEnabled(),
+			StreamLimits:          getDataStreamLimits(),
+			NonFinitePolicy:       nonFinitePolicy,
+		},
 		useraccountService,
 		connectionService,
 		jobService,
 		awsManager,
+		gcpManager,
+		azureManager,
+		kafkaManager,
+		elasticsearchManager,
 		sqlConnector,
 		pgquerier,
 		mysqlquerier,
@@ -376,6 +424,7 @@ func serve(ctx context.Context) error {
 		),
 	)
 
+	var metricsClient mgmtv1alpha1connect.MetricsServiceClient
 	if shouldServiceMetrics() {
 		roundTripper := promapi.DefaultRoundTripper
 		promApiKey := getPromApiKey()
@@ -403,6 +452,76 @@ func serve(ctx context.Context) error {
 				connect.WithRecover(recoverHandler),
 			),
 		)
+		metricsClient = metricsService
+	}
+
+	complianceService := v1alpha1_complianceservice.New(
+		&v1alpha1_complianceservice.Config{ReportSigningKey: getComplianceReportSigningKey()},
+		db,
+		useraccountService,
+		jobService,
+		connectionService,
+		connectionDataService,
+		transformerService,
+		metricsClient,
+		sqlmanager,
+	)
+	api.Handle(
+		mgmtv1alpha1connect.NewComplianceServiceHandler(
+			complianceService,
+			connect.WithInterceptors(stdInterceptors...),
+			connect.WithInterceptors(stdAuthInterceptors...),
+			connect.WithRecover(recoverHandler),
+		),
+	)
+
+	searchService := v1alpha1_searchservice.New(
+		&v1alpha1_searchservice.Config{},
+		db,
+		useraccountService,
+		jobService,
+	)
+	api.Handle(
+		mgmtv1alpha1connect.NewSearchServiceHandler(
+			searchService,
+			connect.WithInterceptors(stdInterceptors...),
+			connect.WithInterceptors(stdAuthInterceptors...),
+			connect.WithRecover(recoverHandler),
+		),
+	)
+
+	systemService := v1alpha1_systemservice.New(
+		&v1alpha1_systemservice.Config{
+			SyncJobQueueName:   getDefaultTemporalSyncJobQueue(),
+			GetMigrationStatus: getMigrationStatusGetter(),
+		},
+		db,
+		tfwfmgr,
+	)
+	api.Handle(
+		mgmtv1alpha1connect.NewSystemServiceHandler(
+			systemService,
+			connect.WithInterceptors(stdInterceptors...),
+			connect.WithInterceptors(stdAuthInterceptors...),
+			connect.WithRecover(recoverHandler),
+		),
+	)
+	mux.Handle("/healthz", systemService.NewHealthzHandler())
+
+	if getIsGraphqlEnabled() {
+		// resolvers call back in to the api in-process so every request still goes through the auth and validation interceptors
+		inProcessClient := graphqlapi.NewInProcessClient(api)
+		graphqlHandler, err := graphqlapi.New(
+			&graphqlapi.Config{},
+			logger,
+			mgmtv1alpha1connect.NewJobServiceClient(inProcessClient, graphqlapi.InProcessBaseUrl),
+			mgmtv1alpha1connect.NewConnectionServiceClient(inProcessClient, graphqlapi.InProcessBaseUrl),
+			mgmtv1alpha1connect.NewConnectionDataServiceClient(inProcessClient, graphqlapi.InProcessBaseUrl),
+		)
+		if err != nil {
+			return err
+		}
+		mux.Handle("/graphql", graphqlHandler)
 	}
 	mux.Handle("/", api)
 
@@ -517,6 +636,38 @@ func getDbMigrationConfig() (*nucleusdb.ConnectConfig, error) {
 	}, nil
 }
 
+// Returns nil if the schema directory has not been configured as there is nothing to compare the database against
+// Used to mint temporary credentials for connections that are configured to use Vault
+func getDbCredentialsConfig() *dbcredentials.Config {
+	return &dbcredentials.Config{
+		VaultAddress:   viper.GetString("VAULT_ADDR"),
+		VaultToken:     viper.GetString("VAULT_TOKEN"),
+		VaultNamespace: viper.GetString("VAULT_NAMESPACE"),
+	}
+}
+
+func getMigrationStatusGetter() func(ctx context.Context) (*v1alpha1_systemservice.MigrationStatus, error) {
+	schemaDir := viper.GetString("DB_SCHEMA_DIR")
+	if schemaDir == "" {
+		return nil
+	}
+	return func(ctx context.Context) (*v1alpha1_systemservice.MigrationStatus, error) {
+		dbMigConfig, err := getDbMigrationConfig()
+		if err != nil {
+			return nil, err
+		}
+		status, err := up_cmd.GetStatus(nucleusdb.GetDbUrl(dbMigConfig), schemaDir)
+		if err != nil {
+			return nil, err
+		}
+		return &v1alpha1_systemservice.MigrationStatus{
+			CurrentVersion: status.CurrentVersion,
+			LatestVersion:  status.LatestVersion,
+			Dirty:          status.Dirty,
+		}, nil
+	}
+}
+
 func getTemporalAuthCertificate() ([]tls.Certificate, error) {
 	keyPath := viper.GetString("TEMPORAL_CERT_KEY_PATH")
 	certPath := viper.GetString("TEMPORAL_CERT_PATH")
@@ -708,6 +859,90 @@ func getPromApiKey() *string {
 	return &key
 }
 
+// whether or not changes to sensitive job mappings must be approved by a second user
+func getIsJobChangeApprovalEnabled() bool {
+	return viper.GetBool("JOB_CHANGE_APPROVAL_ENABLED")
+}
+
+// key used to sign compliance reports. reports are left unsigned if this is not provided
+func getComplianceReportSigningKey() *string {
+	key := viper.GetString("COMPLIANCE_REPORT_SIGNING_KEY")
+	if key == "" {
+		return nil
+	}
+	return &key
+}
+
+// s3 bucket that job run artifacts are stored in. returns nil if artifact storage has not been configured
+func getArtifactStoreConfig() *objectstore.S3Config {
+	bucket := viper.GetString("ARTIFACTS_S3_BUCKET")
+	if bucket == "" {
+		return nil
+	}
+	return &objectstore.S3Config{
+		Bucket:   bucket,
+		Prefix:   viper.GetString("ARTIFACTS_S3_PREFIX"),
+		Region:   viper.GetString("ARTIFACTS_S3_REGION"),
+		Endpoint: viper.GetString("ARTIFACTS_S3_ENDPOINT"),
+	}
+}
+
+// runs whose activities have not heartbeated within the timeout are terminated. returns nil if a timeout has not been configured
+func getStaleRunConfig() *v1alpha1_jobservice.StaleRunConfig {
+	timeout := viper.GetDuration("STALE_RUN_TIMEOUT")
+	if timeout <= 0 {
+		return nil
+	}
+	return &v1alpha1_jobservice.StaleRunConfig{
+		Timeout:        timeout,
+		CheckInterval:  viper.GetDuration("STALE_RUN_CHECK_INTERVAL"),
+		RetryStaleRuns: viper.GetBool("STALE_RUN_RETRY"),
+	}
+}
+
+func getIsQueryConsoleEnabled() bool {
+	return viper.GetBool("QUERY_CONSOLE_ENABLED")
+}
+
+func getIsGraphqlEnabled() bool {
+	return viper.GetBool("GRAPHQL_ENABLED")
+}
+
+// limits applied to connection data streams. unset values fall back to the service defaults
+func getDataStreamLimits() *v1alpha1_connectiondataservice.StreamLimits {
+	return &v1alpha1_connectiondataservice.StreamLimits{
+		MaxDuration: viper.GetDuration("DATA_STREAM_MAX_DURATION"),
+		IdleTimeout: viper.GetDuration("DATA_STREAM_IDLE_TIMEOUT"),
+		BufferSize:  viper.GetInt("DATA_STREAM_BUFFER_SIZE"),
+		FetchSize:   viper.GetInt("DATA_STREAM_FETCH_SIZE"),
+	}
+}
+
+// what happens to NaN and infinite numeric values that are streamed from a connection. defaults to preserving them
+func getNonFinitePolicy() (nonfinite.Policy, error) {
+	return nonfinite.ParsePolicy(viper.GetString("NON_FINITE_NUMBER_POLICY"))
+}
+
+// per-account and per-connection limits for the connection data service. returns nil if no limits have been configured
+func getDataAccessRateLimits() *ratelimit.Config {
+	cfg := &ratelimit.Config{
+		Account: ratelimit.Limits{
+			Qps:         viper.GetFloat64("DATA_ACCESS_ACCOUNT_QPS"),
+			Burst:       viper.GetInt("DATA_ACCESS_ACCOUNT_BURST"),
+			Concurrency: viper.GetInt("DATA_ACCESS_ACCOUNT_CONCURRENCY"),
+		},
+		Connection: ratelimit.Limits{
+			Qps:         viper.GetFloat64("DATA_ACCESS_CONNECTION_QPS"),
+			Burst:       viper.GetInt("DATA_ACCESS_CONNECTION_BURST"),
+			Concurrency: viper.GetInt("DATA_ACCESS_CONNECTION_CONCURRENCY"),
+		},
+	}
+	if cfg.Account == (ratelimit.Limits{}) && cfg.Connection == (ratelimit.Limits{}) {
+		return nil
+	}
+	return cfg
+}
+
 func getRunLogConfig() (*v1alpha1_jobservice.RunLogConfig, error) {
 	isRunLogsEnabled := viper.GetBool("RUN_LOGS_ENABLED")
 	if !isRunLogsEnabled {
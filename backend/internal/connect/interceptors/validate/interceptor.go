@@ -0,0 +1,79 @@
+package validate_interceptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/bufbuild/protovalidate-go"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// Rejects request messages that do not satisfy the buf.validate constraints declared in their protos.
+// Invalid requests return an invalid argument error that contains the per-field violations as well as the invalid request error code.
+// The auth service does not use the standard interceptors, it adds this after its own auth interceptors so that requests
+// to its authenticated procedures are only validated once the caller has been authenticated
+type Interceptor struct {
+	validator *protovalidate.Validator
+}
+
+func NewInterceptor() (connect.Interceptor, error) {
+	validator, err := protovalidate.New()
+	if err != nil {
+		return nil, fmt.Errorf("unable to construct validator: %w", err)
+	}
+	return &Interceptor{validator: validator}, nil
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := i.validate(request.Any()); err != nil {
+			return nil, err
+		}
+		return next(ctx, request)
+	}
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(ctx, &validatingHandlerConn{StreamingHandlerConn: conn, interceptor: i})
+	}
+}
+
+type validatingHandlerConn struct {
+	connect.StreamingHandlerConn
+	interceptor *Interceptor
+}
+
+func (c *validatingHandlerConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	return c.interceptor.validate(msg)
+}
+
+func (i *Interceptor) validate(msg any) error {
+	protoMsg, ok := msg.(proto.Message)
+	if !ok {
+		return fmt.Errorf("expected proto.Message, got %T", msg)
+	}
+	err := i.validator.Validate(protoMsg)
+	if err == nil {
+		return nil
+	}
+	connectErr := connect.NewError(connect.CodeInvalidArgument, err)
+	validationErr := new(protovalidate.ValidationError)
+	if errors.As(err, &validationErr) {
+		if detail, detailErr := connect.NewErrorDetail(validationErr.ToProto()); detailErr == nil {
+			connectErr.AddDetail(detail)
+		}
+	}
+	return nucleuserrors.WithErrorCode(connectErr, mgmtv1alpha1.ErrorCode_ERROR_CODE_INVALID_REQUEST)
+}
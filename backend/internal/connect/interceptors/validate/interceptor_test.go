@@ -0,0 +1,148 @@
+package validate_interceptor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockJobId = "d1f3b3b2-1b0e-4c8a-8d5e-7b1b9f4c2a11"
+)
+
+func Test_Interceptor_WrapUnary_Valid(t *testing.T) {
+	client := startJobServer(t)
+
+	schedule := "@every 1h"
+	_, err := client.UpdateJobSchedule(context.Background(), connect.NewRequest(&mgmtv1alpha1.UpdateJobScheduleRequest{
+		Id:           mockJobId,
+		CronSchedule: &schedule,
+	}))
+	assert.NoError(t, err)
+}
+
+func Test_Interceptor_WrapUnary_Invalid(t *testing.T) {
+	client := startJobServer(t)
+
+	schedule := "not a cron"
+	_, err := client.UpdateJobSchedule(context.Background(), connect.NewRequest(&mgmtv1alpha1.UpdateJobScheduleRequest{
+		Id:           "123",
+		CronSchedule: &schedule,
+	}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	assert.Equal(t, mgmtv1alpha1.ErrorCode_ERROR_CODE_INVALID_REQUEST, nucleuserrors.GetErrorCode(err))
+
+	fields := getViolationFields(t, err)
+	assert.ElementsMatch(t, []string{"id", "cron_schedule"}, fields)
+}
+
+func Test_Interceptor_WrapUnary_InvalidCron(t *testing.T) {
+	client := startJobServer(t)
+
+	schedule := "not a cron"
+	_, err := client.UpdateJobSchedule(context.Background(), connect.NewRequest(&mgmtv1alpha1.UpdateJobScheduleRequest{
+		Id:           mockJobId,
+		CronSchedule: &schedule,
+	}))
+	require.Error(t, err)
+	assert.Equal(t, mgmtv1alpha1.ErrorCode_ERROR_CODE_INVALID_REQUEST, nucleuserrors.GetErrorCode(err))
+	assert.Equal(t, []string{"cron_schedule"}, getViolationFields(t, err))
+}
+
+func Test_Interceptor_WrapUnary_NestedViolations(t *testing.T) {
+	client := startJobServer(t)
+
+	_, err := client.CreateJob(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateJobRequest{
+		AccountId:    mockJobId,
+		JobName:      "nested-violations",
+		Destinations: []*mgmtv1alpha1.CreateJobDestination{{ConnectionId: "123"}},
+	}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	assert.Equal(t, mgmtv1alpha1.ErrorCode_ERROR_CODE_INVALID_REQUEST, nucleuserrors.GetErrorCode(err))
+	assert.Equal(t, []string{"destinations[0].connection_id"}, getViolationFields(t, err))
+}
+
+func Test_Interceptor_WrapUnary_RepeatedViolations(t *testing.T) {
+	client := startJobServer(t)
+
+	_, err := client.SetJobParameters(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetJobParametersRequest{
+		Id: mockJobId,
+		Parameters: []*mgmtv1alpha1.JobParameter{
+			{Name: "tenant_id", Type: mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_STRING},
+			{Name: "Tenant", Type: mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_STRING},
+		},
+	}))
+	require.Error(t, err)
+	assert.Equal(t, []string{"parameters[1].name"}, getViolationFields(t, err))
+}
+
+func Test_cronSchedulePattern(t *testing.T) {
+	interceptor, err := NewInterceptor()
+	require.NoError(t, err)
+	validator := interceptor.(*Interceptor)
+
+	valid := []string{"", "0 0 * * *", "*/5 * * * *", "0 0 1 1 * 2024", "0 9 * * MON-FRI", "@daily", "@every 30m", "CRON_TZ=America/New_York 0 9 * * *"}
+	for _, schedule := range valid {
+		schedule := schedule
+		assert.NoError(t, validator.validate(&mgmtv1alpha1.UpdateJobScheduleRequest{Id: mockJobId, CronSchedule: &schedule}), schedule)
+	}
+	invalid := []string{"* * *", "@sometimes", "0 0 * * * * * *", "0 0 * * *; drop table"}
+	for _, schedule := range invalid {
+		schedule := schedule
+		assert.Error(t, validator.validate(&mgmtv1alpha1.UpdateJobScheduleRequest{Id: mockJobId, CronSchedule: &schedule}), schedule)
+	}
+	assert.NoError(t, validator.validate(&mgmtv1alpha1.UpdateJobScheduleRequest{Id: mockJobId}))
+}
+
+func getViolationFields(t *testing.T, err error) []string {
+	t.Helper()
+	connectErr := new(connect.Error)
+	require.ErrorAs(t, err, &connectErr)
+	fields := []string{}
+	for _, detail := range connectErr.Details() {
+		value, err := detail.Value()
+		require.NoError(t, err)
+		if violations, ok := value.(*validate.Violations); ok {
+			for _, violation := range violations.GetViolations() {
+				fields = append(fields, violation.GetFieldPath())
+			}
+		}
+	}
+	return fields
+}
+
+type jobServiceHandler struct {
+	mgmtv1alpha1connect.UnimplementedJobServiceHandler
+}
+
+func (h *jobServiceHandler) UpdateJobSchedule(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.UpdateJobScheduleRequest],
+) (*connect.Response[mgmtv1alpha1.UpdateJobScheduleResponse], error) {
+	return connect.NewResponse(&mgmtv1alpha1.UpdateJobScheduleResponse{}), nil
+}
+
+func startJobServer(t *testing.T) mgmtv1alpha1connect.JobServiceClient {
+	t.Helper()
+	interceptor, err := NewInterceptor()
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.Handle(mgmtv1alpha1connect.NewJobServiceHandler(&jobServiceHandler{}, connect.WithInterceptors(interceptor)))
+	srv := httptest.NewUnstartedServer(mux)
+	srv.EnableHTTP2 = true
+	srv.Start()
+	t.Cleanup(srv.Close)
+	return mgmtv1alpha1connect.NewJobServiceClient(srv.Client(), srv.URL)
+}
@@ -0,0 +1,50 @@
+package dtomaps
+
+import (
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/classification"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToColumnTagDto(input *db_queries.NeosyncApiConnectionColumnTag) *mgmtv1alpha1.ColumnTag {
+	return &mgmtv1alpha1.ColumnTag{
+		Id:              nucleusdb.UUIDString(input.ID),
+		ConnectionId:    nucleusdb.UUIDString(input.ConnectionID),
+		Schema:          input.SchemaName,
+		Table:           input.TableName,
+		Column:          input.ColumnName,
+		Classifications: ToDataClassificationsDto(input.Classifications),
+		Source:          mgmtv1alpha1.ColumnTagSource(input.Source),
+		CreatedByUserId: nucleusdb.UUIDString(input.CreatedByID),
+		CreatedAt:       timestamppb.New(input.CreatedAt.Time),
+		UpdatedByUserId: nucleusdb.UUIDString(input.UpdatedByID),
+		UpdatedAt:       timestamppb.New(input.UpdatedAt.Time),
+	}
+}
+
+func ToColumnTagMap(input []db_queries.NeosyncApiConnectionColumnTag) classification.ColumnTagMap {
+	output := classification.ColumnTagMap{}
+	for idx := range input {
+		tag := input[idx]
+		output[classification.BuildColumnKey(tag.SchemaName, tag.TableName, tag.ColumnName)] = ToDataClassificationsDto(tag.Classifications)
+	}
+	return output
+}
+
+func ToDataClassificationsDto(input []int32) []mgmtv1alpha1.DataClassification {
+	output := make([]mgmtv1alpha1.DataClassification, 0, len(input))
+	for _, classification := range input {
+		output = append(output, mgmtv1alpha1.DataClassification(classification))
+	}
+	return output
+}
+
+func FromDataClassificationsDto(input []mgmtv1alpha1.DataClassification) []int32 {
+	output := make([]int32, 0, len(input))
+	for _, classification := range input {
+		output = append(output, int32(classification))
+	}
+	return output
+}
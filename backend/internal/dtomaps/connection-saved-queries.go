@@ -0,0 +1,34 @@
+package dtomaps
+
+import (
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToConnectionSavedQueryDto(input *db_queries.NeosyncApiConnectionSavedQuery) *mgmtv1alpha1.ConnectionSavedQuery {
+	return &mgmtv1alpha1.ConnectionSavedQuery{
+		Id:              nucleusdb.UUIDString(input.ID),
+		ConnectionId:    nucleusdb.UUIDString(input.ConnectionID),
+		Name:            input.Name,
+		Schema:          input.SchemaName,
+		Table:           input.TableName,
+		WhereClause:     nucleusdb.ToNullableString(input.WhereClause),
+		CreatedByUserId: nucleusdb.UUIDString(input.CreatedByID),
+		CreatedAt:       timestamppb.New(input.CreatedAt.Time),
+		UpdatedByUserId: nucleusdb.UUIDString(input.UpdatedByID),
+		UpdatedAt:       timestamppb.New(input.UpdatedAt.Time),
+	}
+}
+
+func ToConnectionTableBookmarkDto(input *db_queries.NeosyncApiConnectionTableBookmark) *mgmtv1alpha1.ConnectionTableBookmark {
+	return &mgmtv1alpha1.ConnectionTableBookmark{
+		Id:              nucleusdb.UUIDString(input.ID),
+		ConnectionId:    nucleusdb.UUIDString(input.ConnectionID),
+		Schema:          input.SchemaName,
+		Table:           input.TableName,
+		CreatedByUserId: nucleusdb.UUIDString(input.CreatedByID),
+		CreatedAt:       timestamppb.New(input.CreatedAt.Time),
+	}
+}
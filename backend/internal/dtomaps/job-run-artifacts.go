@@ -0,0 +1,20 @@
+package dtomaps
+
+import (
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToJobRunArtifactDto(input *db_queries.NeosyncApiJobRunArtifact) *mgmtv1alpha1.JobRunArtifact {
+	return &mgmtv1alpha1.JobRunArtifact{
+		Id:          nucleusdb.UUIDString(input.ID),
+		JobRunId:    input.JobRunID,
+		Type:        mgmtv1alpha1.JobRunArtifactType(input.ArtifactType),
+		Name:        input.Name,
+		ContentType: input.ContentType,
+		SizeBytes:   input.SizeBytes,
+		CreatedAt:   timestamppb.New(input.CreatedAt.Time),
+	}
+}
@@ -0,0 +1,21 @@
+package dtomaps
+
+import (
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToJobRunSnapshotDto(input *db_queries.NeosyncApiJobRunSnapshot) *mgmtv1alpha1.JobRunSnapshot {
+	return &mgmtv1alpha1.JobRunSnapshot{
+		Id:              nucleusdb.UUIDString(input.ID),
+		AccountId:       nucleusdb.UUIDString(input.AccountID),
+		Name:            input.Name,
+		JobId:           nucleusdb.UUIDString(input.JobID),
+		JobRunId:        input.JobRunID,
+		ConnectionId:    nucleusdb.UUIDString(input.ConnectionID),
+		CreatedByUserId: nucleusdb.UUIDString(input.CreatedByID),
+		CreatedAt:       timestamppb.New(input.CreatedAt.Time),
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"log/slog"
 
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	computedataquality_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/compute-data-quality"
+	genbenthosconfigs_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/gen-benthos-configs"
+	sync_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync"
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/enums/v1"
 	temporalfailure "go.temporal.io/api/failure/v1"
@@ -118,3 +121,68 @@ func toWorfklowStatus(input enums.WorkflowExecutionStatus) mgmtv1alpha1.JobRunSt
 		return mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_UNSPECIFIED
 	}
 }
+
+func ToTableDataQualityDtos(input *computedataquality_activity.ComputeDataQualityResponse) []*mgmtv1alpha1.TableDataQuality {
+	if input == nil {
+		return []*mgmtv1alpha1.TableDataQuality{}
+	}
+	dtos := make([]*mgmtv1alpha1.TableDataQuality, 0, len(input.Tables))
+	for _, table := range input.Tables {
+		columns := make([]*mgmtv1alpha1.ColumnDataQuality, 0, len(table.Columns))
+		for _, col := range table.Columns {
+			columns = append(columns, &mgmtv1alpha1.ColumnDataQuality{
+				Column:                  col.Column,
+				DistributionDistance:    col.DistributionDistance,
+				CardinalityPreservation: col.CardinalityPreservation,
+			})
+		}
+		dtos = append(dtos, &mgmtv1alpha1.TableDataQuality{
+			Schema:                  table.Schema,
+			Table:                   table.Table,
+			DestinationConnectionId: table.DestinationConnectionId,
+			Score:                   table.Score,
+			ReferentialValidity:     table.ReferentialValidity,
+			Columns:                 columns,
+		})
+	}
+	return dtos
+}
+
+func ToTableRowCountDtos(input []*sync_activity.TableRowCounts) []*mgmtv1alpha1.TableRowCount {
+	dtos := make([]*mgmtv1alpha1.TableRowCount, 0, len(input))
+	for _, table := range input {
+		destinations := make([]*mgmtv1alpha1.DestinationRowCount, 0, len(table.Outputs))
+		for _, output := range table.Outputs {
+			destinations = append(destinations, &mgmtv1alpha1.DestinationRowCount{
+				Output:         output.Output,
+				ConnectionId:   output.ConnectionId,
+				RowsWritten:    output.RowsWritten,
+				RowsSkipped:    output.RowsSkipped,
+				HasDiscrepancy: output.HasDiscrepancy(table.RowsRead),
+			})
+		}
+		dtos = append(dtos, &mgmtv1alpha1.TableRowCount{
+			Schema:         table.Schema,
+			Table:          table.Table,
+			RowsRead:       table.RowsRead,
+			Destinations:   destinations,
+			HasDiscrepancy: table.HasDiscrepancy(),
+		})
+	}
+	return dtos
+}
+
+func ToTableMaskingProgressDtos(input []*genbenthosconfigs_activity.InPlaceMaskTable) []*mgmtv1alpha1.TableMaskingProgress {
+	dtos := make([]*mgmtv1alpha1.TableMaskingProgress, 0, len(input))
+	for _, table := range input {
+		dtos = append(dtos, &mgmtv1alpha1.TableMaskingProgress{
+			Schema:           table.Schema,
+			Table:            table.Table,
+			Columns:          table.Columns,
+			TotalRows:        table.TotalRows,
+			TotalBatches:     table.TotalBatches,
+			CompletedBatches: table.CompletedBatches,
+		})
+	}
+	return dtos
+}
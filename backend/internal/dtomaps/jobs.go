@@ -33,6 +33,16 @@ func ToJobDto(
 		syncOptions = inputJob.SyncOptions.ToDto()
 	}
 
+	tableProcessors := []*mgmtv1alpha1.JobTableProcessor{}
+	for _, processor := range inputJob.TableProcessors {
+		tableProcessors = append(tableProcessors, processor.ToDto())
+	}
+
+	parameters := []*mgmtv1alpha1.JobParameter{}
+	for _, parameter := range inputJob.Parameters {
+		parameters = append(parameters, parameter.ToDto())
+	}
+
 	return &mgmtv1alpha1.Job{
 		Id:              nucleusdb.UUIDString(inputJob.ID),
 		Name:            inputJob.Name,
@@ -49,7 +59,35 @@ func ToJobDto(
 		AccountId:       nucleusdb.UUIDString(inputJob.AccountID),
 		SyncOptions:     syncOptions,
 		WorkflowOptions: workflowOptions,
+		TableProcessors: tableProcessors,
+		Parameters:      parameters,
+	}
+}
+
+func ToJobChangeRequestDto(input *db_queries.NeosyncApiJobChangeRequest) *mgmtv1alpha1.JobChangeRequest {
+	mappings := []*mgmtv1alpha1.JobMapping{}
+	for _, mapping := range input.Mappings {
+		mappings = append(mappings, mapping.ToDto())
+	}
+
+	dto := &mgmtv1alpha1.JobChangeRequest{
+		Id:       nucleusdb.UUIDString(input.ID),
+		JobId:    nucleusdb.UUIDString(input.JobID),
+		Mappings: mappings,
+		Source: &mgmtv1alpha1.JobSource{
+			Options: input.ConnectionOptions.ToDto(),
+		},
+		CreatedByUserId: nucleusdb.UUIDString(input.CreatedByID),
+		CreatedAt:       timestamppb.New(input.CreatedAt.Time),
+	}
+	if input.ApprovedByID.Valid {
+		approvedBy := nucleusdb.UUIDString(input.ApprovedByID)
+		dto.ApprovedByUserId = &approvedBy
+	}
+	if input.ApprovedAt.Valid {
+		dto.ApprovedAt = timestamppb.New(input.ApprovedAt.Time)
 	}
+	return dto
 }
 
 func toDestinationDto(input *db_queries.NeosyncApiJobDestinationConnectionAssociation) *mgmtv1alpha1.JobDestination {
@@ -34,3 +34,14 @@ func ToUserDefinedTransformerDto(
 		AccountId:   nucleusdb.UUIDString(input.AccountID),
 	}, nil
 }
+
+func ToJavascriptLibraryDto(input *db_queries.NeosyncApiJavascriptLibrary) *mgmtv1alpha1.JavascriptLibrary {
+	return &mgmtv1alpha1.JavascriptLibrary{
+		Id:        nucleusdb.UUIDString(input.ID),
+		AccountId: nucleusdb.UUIDString(input.AccountID),
+		Name:      input.Name,
+		Code:      input.Code,
+		CreatedAt: timestamppb.New(input.CreatedAt.Time),
+		UpdatedAt: timestamppb.New(input.UpdatedAt.Time),
+	}
+}
@@ -0,0 +1,346 @@
+package elasticsearchmanager
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+)
+
+const (
+	// indices are surfaced as the tables of this schema
+	IndicesSchema = "indices"
+
+	// how long the cluster keeps a point in time or scroll alive between two pages
+	keepAlive = "1m"
+)
+
+type NeosyncElasticsearchManager struct {
+}
+
+type NeosyncElasticsearchManagerClient interface {
+	GetClient(ctx context.Context, config *mgmtv1alpha1.ElasticsearchConnectionConfig) (ElasticsearchClient, error)
+}
+
+// A client of an Elasticsearch or OpenSearch cluster
+type ElasticsearchClient interface {
+	// Returns the top level fields of the mappings of every index that the connection exposes
+	GetIndexFields(ctx context.Context) ([]*IndexField, error)
+	// Reads every document of the index. A point in time is used if the cluster supports it, otherwise the index is scrolled
+	StreamDocuments(ctx context.Context, index string, pageSize int, onDocument func(document map[string]any) error) error
+}
+
+type IndexField struct {
+	Index           string
+	Field           string
+	DataType        string
+	OrdinalPosition int32
+}
+
+func New() *NeosyncElasticsearchManager {
+	return &NeosyncElasticsearchManager{}
+}
+
+func (n *NeosyncElasticsearchManager) GetClient(ctx context.Context, config *mgmtv1alpha1.ElasticsearchConnectionConfig) (ElasticsearchClient, error) {
+	if len(config.GetUrls()) == 0 {
+		return nil, errors.New("at least one elasticsearch url must be provided")
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if config.GetTls() != nil {
+		tlsConfig, err := getTlsConfig(config.GetTls())
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	urls := make([]string, 0, len(config.GetUrls()))
+	for _, u := range config.GetUrls() {
+		urls = append(urls, strings.TrimSuffix(u, "/"))
+	}
+	return &elasticsearchClient{
+		urls:          urls,
+		username:      config.GetUsername(),
+		password:      config.GetPassword(),
+		apiKey:        config.GetApiKey(),
+		indexPatterns: config.GetIndexPatterns(),
+		httpClient:    &http.Client{Timeout: 30 * time.Second, Transport: transport},
+	}, nil
+}
+
+func getTlsConfig(config *mgmtv1alpha1.ClientTlsConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if config.GetRootCert() != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(config.GetRootCert())) {
+			return nil, errors.New("unable to parse elasticsearch root cert")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if config.GetClientCert() != "" && config.GetClientKey() != "" {
+		cert, err := tls.X509KeyPair([]byte(config.GetClientCert()), []byte(config.GetClientKey()))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse elasticsearch client cert: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+type elasticsearchClient struct {
+	urls          []string
+	username      string
+	password      string
+	apiKey        string
+	indexPatterns []string
+
+	httpClient *http.Client
+}
+
+// Returned when the cluster responds with a status that is not a 2xx
+type responseError struct {
+	StatusCode int
+	Body       string
+}
+
+func (r *responseError) Error() string {
+	return fmt.Sprintf("elasticsearch responded with status %d: %s", r.StatusCode, r.Body)
+}
+
+func (e *elasticsearchClient) GetIndexFields(ctx context.Context) ([]*IndexField, error) {
+	path := "/_mapping"
+	if len(e.indexPatterns) > 0 {
+		patterns := make([]string, 0, len(e.indexPatterns))
+		for _, pattern := range e.indexPatterns {
+			patterns = append(patterns, url.PathEscape(pattern))
+		}
+		path = fmt.Sprintf("/%s/_mapping", strings.Join(patterns, ","))
+	}
+	// hidden and system indices are only returned if one of the patterns names them explicitly
+	path += "?expand_wildcards=open&allow_no_indices=true&ignore_unavailable=true"
+
+	var mappings map[string]struct {
+		Mappings indexMapping `json:"mappings"`
+	}
+	if err := e.do(ctx, http.MethodGet, path, nil, &mappings); err != nil {
+		return nil, fmt.Errorf("unable to get elasticsearch index mappings: %w", err)
+	}
+
+	indices := make([]string, 0, len(mappings))
+	for index := range mappings {
+		indices = append(indices, index)
+	}
+	sort.Strings(indices)
+
+	output := []*IndexField{}
+	for _, index := range indices {
+		output = append(output, getIndexFields(index, mappings[index].Mappings)...)
+	}
+	return output, nil
+}
+
+type indexMapping struct {
+	Properties map[string]fieldMapping `json:"properties"`
+}
+
+type fieldMapping struct {
+	Type       string         `json:"type"`
+	Properties map[string]any `json:"properties"`
+}
+
+// The properties of a mapping are unordered, so the fields are sorted by name to keep their ordinal positions stable
+func getIndexFields(index string, mapping indexMapping) []*IndexField {
+	names := make([]string, 0, len(mapping.Properties))
+	for name := range mapping.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fields := make([]*IndexField, 0, len(names))
+	for idx, name := range names {
+		field := mapping.Properties[name]
+		dataType := field.Type
+		if dataType == "" {
+			// fields with sub properties and no type are objects
+			dataType = "object"
+		}
+		fields = append(fields, &IndexField{
+			Index:           index,
+			Field:           name,
+			DataType:        dataType,
+			OrdinalPosition: int32(idx + 1),
+		})
+	}
+	return fields
+}
+
+type searchResponse struct {
+	PitId    string `json:"pit_id"`
+	ScrollId string `json:"_scroll_id"`
+	Hits     struct {
+		Hits []struct {
+			Source map[string]any `json:"_source"`
+			Sort   []any          `json:"sort"`
+		} `json:"hits"`
+	} `json:"hits"`
+}
+
+func (e *elasticsearchClient) StreamDocuments(ctx context.Context, index string, pageSize int, onDocument func(document map[string]any) error) error {
+	var pit struct {
+		Id string `json:"id"`
+	}
+	err := e.do(ctx, http.MethodPost, fmt.Sprintf("/%s/_pit?keep_alive=%s", url.PathEscape(index), keepAlive), nil, &pit)
+	if err != nil {
+		// clusters without points in time, such as OpenSearch and Elasticsearch before 7.10, reject the request
+		var respErr *responseError
+		if errors.As(err, &respErr) {
+			return e.scrollDocuments(ctx, index, pageSize, onDocument)
+		}
+		return fmt.Errorf("unable to open point in time on %s: %w", index, err)
+	}
+	return e.streamPitDocuments(ctx, pit.Id, pageSize, onDocument)
+}
+
+func (e *elasticsearchClient) streamPitDocuments(ctx context.Context, pitId string, pageSize int, onDocument func(document map[string]any) error) error {
+	defer func() {
+		// the point in time expires on its own, so failing to close it is not an error
+		_ = e.do(context.WithoutCancel(ctx), http.MethodDelete, "/_pit", map[string]any{"id": pitId}, nil)
+	}()
+
+	var searchAfter []any
+	for {
+		body := map[string]any{
+			"size": pageSize,
+			"pit":  map[string]any{"id": pitId, "keep_alive": keepAlive},
+			// _shard_doc is the most efficient sort to page through a point in time with
+			"sort": []any{map[string]any{"_shard_doc": "asc"}},
+		}
+		if searchAfter != nil {
+			body["search_after"] = searchAfter
+		}
+		var resp searchResponse
+		if err := e.do(ctx, http.MethodPost, "/_search", body, &resp); err != nil {
+			return fmt.Errorf("unable to search point in time: %w", err)
+		}
+		if resp.PitId != "" {
+			pitId = resp.PitId
+		}
+		hits := resp.Hits.Hits
+		for _, hit := range hits {
+			if err := onDocument(hit.Source); err != nil {
+				return err
+			}
+		}
+		if len(hits) < pageSize {
+			return nil
+		}
+		searchAfter = hits[len(hits)-1].Sort
+	}
+}
+
+func (e *elasticsearchClient) scrollDocuments(ctx context.Context, index string, pageSize int, onDocument func(document map[string]any) error) error {
+	var resp searchResponse
+	err := e.do(ctx, http.MethodPost, fmt.Sprintf("/%s/_search?scroll=%s", url.PathEscape(index), keepAlive), map[string]any{
+		"size": pageSize,
+		"sort": []any{"_doc"},
+	}, &resp)
+	if err != nil {
+		return fmt.Errorf("unable to scroll %s: %w", index, err)
+	}
+	scrollId := resp.ScrollId
+	defer func() {
+		if scrollId != "" {
+			// the scroll expires on its own, so failing to clear it is not an error
+			_ = e.do(context.WithoutCancel(ctx), http.MethodDelete, "/_search/scroll", map[string]any{"scroll_id": []string{scrollId}}, nil)
+		}
+	}()
+
+	for {
+		hits := resp.Hits.Hits
+		for _, hit := range hits {
+			if err := onDocument(hit.Source); err != nil {
+				return err
+			}
+		}
+		if len(hits) == 0 {
+			return nil
+		}
+		resp = searchResponse{}
+		if err := e.do(ctx, http.MethodPost, "/_search/scroll", map[string]any{"scroll": keepAlive, "scroll_id": scrollId}, &resp); err != nil {
+			return fmt.Errorf("unable to scroll %s: %w", index, err)
+		}
+		if resp.ScrollId != "" {
+			scrollId = resp.ScrollId
+		}
+	}
+}
+
+// Sends the request to each url of the connection in order until one of them responds and decodes the JSON response into out.
+// Numbers are decoded as json.Number so that large integers keep their precision
+func (e *elasticsearchClient) do(ctx context.Context, method, path string, body, out any) error {
+	var bodyBits []byte
+	if body != nil {
+		bits, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		bodyBits = bits
+	}
+
+	var errs []error
+	for _, baseUrl := range e.urls {
+		req, err := http.NewRequestWithContext(ctx, method, baseUrl+path, bytes.NewReader(bodyBits))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Accept", "application/json")
+		if bodyBits != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		if e.apiKey != "" {
+			req.Header.Set("Authorization", fmt.Sprintf("ApiKey %s", e.apiKey))
+		} else if e.username != "" {
+			req.SetBasicAuth(e.username, e.password)
+		}
+
+		resp, err := e.httpClient.Do(req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			errs = append(errs, err)
+			continue
+		}
+		return decodeResponse(resp, out)
+	}
+	return errors.Join(errs...)
+}
+
+func decodeResponse(resp *http.Response, out any) error {
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return &responseError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+	if out == nil {
+		_, err := io.Copy(io.Discard, resp.Body)
+		return err
+	}
+	decoder := json.NewDecoder(resp.Body)
+	decoder.UseNumber()
+	if err := decoder.Decode(out); err != nil {
+		return fmt.Errorf("unable to decode elasticsearch response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package elasticsearchmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getIndexFields(t *testing.T) {
+	fields := getIndexFields("users", indexMapping{Properties: map[string]fieldMapping{
+		"name":    {Type: "text"},
+		"address": {Properties: map[string]any{"city": map[string]any{"type": "keyword"}}},
+		"age":     {Type: "integer"},
+	}})
+	require.Equal(t, []*IndexField{
+		{Index: "users", Field: "address", DataType: "object", OrdinalPosition: 1},
+		{Index: "users", Field: "age", DataType: "integer", OrdinalPosition: 2},
+		{Index: "users", Field: "name", DataType: "text", OrdinalPosition: 3},
+	}, fields)
+}
+
+func Test_GetIndexFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/logs-*,users/_mapping", r.URL.Path)
+		require.Equal(t, "open", r.URL.Query().Get("expand_wildcards"))
+		require.Equal(t, "ApiKey abc", r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"users":{"mappings":{"properties":{"id":{"type":"long"}}}},"logs-1":{"mappings":{"properties":{"message":{"type":"text"}}}}}`)
+	}))
+	defer server.Close()
+
+	client, err := New().GetClient(context.Background(), &mgmtv1alpha1.ElasticsearchConnectionConfig{
+		Urls:          []string{server.URL + "/"},
+		ApiKey:        ptr("abc"),
+		IndexPatterns: []string{"logs-*", "users"},
+	})
+	require.NoError(t, err)
+
+	fields, err := client.GetIndexFields(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []*IndexField{
+		{Index: "logs-1", Field: "message", DataType: "text", OrdinalPosition: 1},
+		{Index: "users", Field: "id", DataType: "long", OrdinalPosition: 1},
+	}, fields)
+}
+
+func Test_StreamDocuments_PointInTime(t *testing.T) {
+	closed := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		require.True(t, ok)
+		require.Equal(t, "elastic", user)
+		require.Equal(t, "changeme", pass)
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/users/_pit":
+			fmt.Fprint(w, `{"id":"pit-1"}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/_search":
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			if _, ok := body["search_after"]; !ok {
+				fmt.Fprint(w, `{"pit_id":"pit-2","hits":{"hits":[{"_source":{"id":1},"sort":[1]},{"_source":{"id":2},"sort":[2]}]}}`)
+				return
+			}
+			require.Equal(t, "pit-2", body["pit"].(map[string]any)["id"])
+			fmt.Fprint(w, `{"pit_id":"pit-2","hits":{"hits":[{"_source":{"id":9007199254740993},"sort":[3]}]}}`)
+		case r.Method == http.MethodDelete && r.URL.Path == "/_pit":
+			closed = true
+			fmt.Fprint(w, `{"succeeded":true}`)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := New().GetClient(context.Background(), &mgmtv1alpha1.ElasticsearchConnectionConfig{
+		Urls:     []string{server.URL},
+		Username: ptr("elastic"),
+		Password: ptr("changeme"),
+	})
+	require.NoError(t, err)
+
+	documents := []map[string]any{}
+	err = client.StreamDocuments(context.Background(), "users", 2, func(document map[string]any) error {
+		documents = append(documents, document)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []map[string]any{{"id": json.Number("1")}, {"id": json.Number("2")}, {"id": json.Number("9007199254740993")}}, documents)
+	require.True(t, closed)
+}
+
+func Test_StreamDocuments_ScrollFallback(t *testing.T) {
+	scrolls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/users/_pit":
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error":"no handler found for uri [/users/_pit]"}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/users/_search":
+			require.Equal(t, "1m", r.URL.Query().Get("scroll"))
+			fmt.Fprint(w, `{"_scroll_id":"scroll-1","hits":{"hits":[{"_source":{"id":1}}]}}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/_search/scroll":
+			scrolls++
+			if scrolls == 1 {
+				fmt.Fprint(w, `{"_scroll_id":"scroll-1","hits":{"hits":[{"_source":{"id":2}}]}}`)
+				return
+			}
+			fmt.Fprint(w, `{"_scroll_id":"scroll-1","hits":{"hits":[]}}`)
+		case r.Method == http.MethodDelete && r.URL.Path == "/_search/scroll":
+			fmt.Fprint(w, `{"succeeded":true}`)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := New().GetClient(context.Background(), &mgmtv1alpha1.ElasticsearchConnectionConfig{
+		// the first node is down, so every request should be sent to the second
+		Urls: []string{"http://127.0.0.1:1", server.URL},
+	})
+	require.NoError(t, err)
+
+	documents := []map[string]any{}
+	err = client.StreamDocuments(context.Background(), "users", 1, func(document map[string]any) error {
+		documents = append(documents, document)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []map[string]any{{"id": json.Number("1")}, {"id": json.Number("2")}}, documents)
+	require.Equal(t, 2, scrolls)
+}
+
+func ptr[T any](val T) *T {
+	return &val
+}
@@ -2,8 +2,12 @@ package nucleuserrors
 
 import (
 	"errors"
+	"math"
+	"strconv"
+	"time"
 
 	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -48,10 +52,63 @@ func NewUnauthorized(message string) error {
 	return NewUnauthenticated(message)
 }
 
+// Returns an aborted error (HTTP 409), used when a request conflicts with another one that is in progress
+func NewAborted(message string) error {
+	return connect.NewError(connect.CodeAborted, errors.New(message))
+}
+
 func NewNotImplemented(message string) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New(message))
 }
 
+const (
+	RetryAfterHeader = "Retry-After"
+)
+
+// Returns a resource exhausted error (HTTP 429) with a Retry-After header of the given duration, rounded up to the nearest second
+func NewResourceExhausted(message string, retryAfter time.Duration) error {
+	err := connect.NewError(connect.CodeResourceExhausted, errors.New(message))
+	seconds := max(int64(math.Ceil(retryAfter.Seconds())), 1)
+	err.Meta().Set(RetryAfterHeader, strconv.FormatInt(seconds, 10))
+	return err
+}
+
+// Attaches a machine-readable error code to the error's details.
+// Errors that are not already RPC errors are wrapped as internal errors
+func WithErrorCode(err error, code mgmtv1alpha1.ErrorCode) error {
+	if err == nil {
+		return nil
+	}
+	connectErr := new(connect.Error)
+	if !errors.As(err, &connectErr) {
+		connectErr = connect.NewError(connect.CodeInternal, err)
+	}
+	detail, detailErr := connect.NewErrorDetail(&mgmtv1alpha1.ErrorDetails{Code: code})
+	if detailErr != nil {
+		return connectErr
+	}
+	connectErr.AddDetail(detail)
+	return connectErr
+}
+
+// Returns the machine-readable error code attached to the error, or unspecified if there isn't one
+func GetErrorCode(err error) mgmtv1alpha1.ErrorCode {
+	connectErr := new(connect.Error)
+	if !errors.As(err, &connectErr) {
+		return mgmtv1alpha1.ErrorCode_ERROR_CODE_UNSPECIFIED
+	}
+	for _, detail := range connectErr.Details() {
+		value, err := detail.Value()
+		if err != nil {
+			continue
+		}
+		if details, ok := value.(*mgmtv1alpha1.ErrorDetails); ok {
+			return details.GetCode()
+		}
+	}
+	return mgmtv1alpha1.ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
 func IsNotFound(err error) bool {
 	if err != nil {
 		if status.Code(err) == codes.NotFound {
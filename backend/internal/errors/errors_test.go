@@ -0,0 +1,41 @@
+package nucleuserrors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_WithErrorCode(t *testing.T) {
+	err := WithErrorCode(NewNotFound("unable to find job by id"), mgmtv1alpha1.ErrorCode_ERROR_CODE_JOB_NOT_FOUND)
+
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	require.Equal(t, mgmtv1alpha1.ErrorCode_ERROR_CODE_JOB_NOT_FOUND, GetErrorCode(err))
+	require.True(t, IsNotFound(err))
+}
+
+func Test_WithErrorCode_Wrapped(t *testing.T) {
+	err := fmt.Errorf("unable to retrieve schema: %w", WithErrorCode(NewBadRequest("bad"), mgmtv1alpha1.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND))
+	require.Equal(t, mgmtv1alpha1.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND, GetErrorCode(err))
+}
+
+func Test_WithErrorCode_NonRpcError(t *testing.T) {
+	err := WithErrorCode(errors.New("boom"), mgmtv1alpha1.ErrorCode_ERROR_CODE_CONNECTION_AUTH_FAILED)
+
+	require.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+	require.Equal(t, mgmtv1alpha1.ErrorCode_ERROR_CODE_CONNECTION_AUTH_FAILED, GetErrorCode(err))
+}
+
+func Test_WithErrorCode_Nil(t *testing.T) {
+	require.NoError(t, WithErrorCode(nil, mgmtv1alpha1.ErrorCode_ERROR_CODE_JOB_NOT_FOUND))
+}
+
+func Test_GetErrorCode_Unspecified(t *testing.T) {
+	require.Equal(t, mgmtv1alpha1.ErrorCode_ERROR_CODE_UNSPECIFIED, GetErrorCode(nil))
+	require.Equal(t, mgmtv1alpha1.ErrorCode_ERROR_CODE_UNSPECIFIED, GetErrorCode(errors.New("boom")))
+	require.Equal(t, mgmtv1alpha1.ErrorCode_ERROR_CODE_UNSPECIFIED, GetErrorCode(NewNotFound("not found")))
+}
@@ -0,0 +1,281 @@
+package gcpmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/civil"
+	"cloud.google.com/go/storage"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+type NeosyncGcpManager struct {
+}
+
+type NeosyncGcpManagerClient interface {
+	GetBigQueryClient(ctx context.Context, config *mgmtv1alpha1.BigQueryConnectionConfig) (BigQueryClient, error)
+	GetStorageClient(ctx context.Context, config *mgmtv1alpha1.GcpCloudStorageConnectionConfig) (StorageClient, error)
+}
+
+type BigQueryClient interface {
+	// Returns the columns of every table in every dataset of the project
+	GetDatabaseSchema(ctx context.Context) ([]*BigQueryColumn, error)
+	// Returns the columns of a single table
+	GetTableSchema(ctx context.Context, dataset, table string) ([]*BigQueryColumn, error)
+	// Reads every row of the table through the storage read API
+	StreamTableRows(ctx context.Context, dataset, table string, onRow func(row map[string]any) error) error
+	Close() error
+}
+
+// A client that is scoped to the bucket of a Cloud Storage connection
+type StorageClient interface {
+	// Returns the prefixes directly beneath the given prefix, i.e. the folders delimited by a /
+	ListPrefixes(ctx context.Context, prefix string) ([]string, error)
+	// Returns the names of every object under the given prefix
+	ListObjects(ctx context.Context, prefix string) ([]string, error)
+	// Opens the object for reading. The contents are returned as they are stored, even if the object has a gzip content encoding
+	GetObject(ctx context.Context, name string) (io.ReadCloser, error)
+	Close() error
+}
+
+type BigQueryColumn struct {
+	Dataset         string
+	Table           string
+	Column          string
+	DataType        string
+	IsNullable      bool
+	OrdinalPosition int32
+}
+
+func New() *NeosyncGcpManager {
+	return &NeosyncGcpManager{}
+}
+
+func (n *NeosyncGcpManager) GetBigQueryClient(ctx context.Context, config *mgmtv1alpha1.BigQueryConnectionConfig) (BigQueryClient, error) {
+	opts := []option.ClientOption{}
+	if key := config.GetServiceAccountKey(); key != "" {
+		opts = append(opts, option.WithCredentialsJSON([]byte(key)))
+	}
+	client, err := bigquery.NewClient(ctx, config.GetProjectId(), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create bigquery client: %w", err)
+	}
+	// table reads are served by the storage read API instead of paging through tabledata.list
+	if err := client.EnableStorageReadClient(ctx, opts...); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("unable to create bigquery storage read client: %w", err)
+	}
+	return &bigQueryClient{client: client}, nil
+}
+
+type bigQueryClient struct {
+	client *bigquery.Client
+}
+
+func (b *bigQueryClient) GetDatabaseSchema(ctx context.Context) ([]*BigQueryColumn, error) {
+	output := []*BigQueryColumn{}
+	datasets := b.client.Datasets(ctx)
+	for {
+		dataset, err := datasets.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to list bigquery datasets: %w", err)
+		}
+		tables := dataset.Tables(ctx)
+		for {
+			table, err := tables.Next()
+			if errors.Is(err, iterator.Done) {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("unable to list tables of bigquery dataset %s: %w", dataset.DatasetID, err)
+			}
+			md, err := table.Metadata(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("unable to get metadata of bigquery table %s.%s: %w", table.DatasetID, table.TableID, err)
+			}
+			// views are not listed as their rows can not be read through the storage read API
+			if md.Type != bigquery.RegularTable {
+				continue
+			}
+			output = append(output, getBigQueryColumns(table.DatasetID, table.TableID, md.Schema)...)
+		}
+	}
+	return output, nil
+}
+
+func (b *bigQueryClient) GetTableSchema(ctx context.Context, dataset, table string) ([]*BigQueryColumn, error) {
+	md, err := b.client.Dataset(dataset).Table(table).Metadata(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get metadata of bigquery table %s.%s: %w", dataset, table, err)
+	}
+	return getBigQueryColumns(dataset, table, md.Schema), nil
+}
+
+func (b *bigQueryClient) StreamTableRows(ctx context.Context, dataset, table string, onRow func(row map[string]any) error) error {
+	rows := b.client.Dataset(dataset).Table(table).Read(ctx)
+	for {
+		var values map[string]bigquery.Value
+		err := rows.Next(&values)
+		if errors.Is(err, iterator.Done) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("unable to read bigquery table %s.%s: %w", dataset, table, err)
+		}
+		row := make(map[string]any, len(values))
+		for col, value := range values {
+			row[col] = normalizeBigQueryValue(value)
+		}
+		if err := onRow(row); err != nil {
+			return err
+		}
+	}
+}
+
+func (b *bigQueryClient) Close() error {
+	return b.client.Close()
+}
+
+func (n *NeosyncGcpManager) GetStorageClient(ctx context.Context, config *mgmtv1alpha1.GcpCloudStorageConnectionConfig) (StorageClient, error) {
+	opts := []option.ClientOption{}
+	if key := config.GetServiceAccountKey(); key != "" {
+		opts = append(opts, option.WithCredentialsJSON([]byte(key)))
+	}
+	client, err := storage.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create cloud storage client: %w", err)
+	}
+	return &storageClient{client: client, bucket: client.Bucket(config.GetBucket())}, nil
+}
+
+type storageClient struct {
+	client *storage.Client
+	bucket *storage.BucketHandle
+}
+
+func (s *storageClient) ListPrefixes(ctx context.Context, prefix string) ([]string, error) {
+	output := []string{}
+	query := &storage.Query{Prefix: prefix, Delimiter: "/"}
+	if err := query.SetAttrSelection([]string{"Prefix"}); err != nil {
+		return nil, err
+	}
+	objects := s.bucket.Objects(ctx, query)
+	for {
+		attrs, err := objects.Next()
+		if errors.Is(err, iterator.Done) {
+			return output, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to list cloud storage prefixes under %s: %w", prefix, err)
+		}
+		// objects that sit directly beneath the prefix are returned alongside the folders and have no prefix set
+		if attrs.Prefix != "" {
+			output = append(output, attrs.Prefix)
+		}
+	}
+}
+
+func (s *storageClient) ListObjects(ctx context.Context, prefix string) ([]string, error) {
+	output := []string{}
+	query := &storage.Query{Prefix: prefix}
+	if err := query.SetAttrSelection([]string{"Name"}); err != nil {
+		return nil, err
+	}
+	objects := s.bucket.Objects(ctx, query)
+	for {
+		attrs, err := objects.Next()
+		if errors.Is(err, iterator.Done) {
+			return output, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to list cloud storage objects under %s: %w", prefix, err)
+		}
+		output = append(output, attrs.Name)
+	}
+}
+
+func (s *storageClient) GetObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	// objects uploaded with a gzip content encoding would otherwise be decompressed by the client
+	reader, err := s.bucket.Object(name).ReadCompressed(true).NewReader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read cloud storage object %s: %w", name, err)
+	}
+	return reader, nil
+}
+
+func (s *storageClient) Close() error {
+	return s.client.Close()
+}
+
+func getBigQueryColumns(dataset, table string, schema bigquery.Schema) []*BigQueryColumn {
+	columns := make([]*BigQueryColumn, 0, len(schema))
+	for idx, field := range schema {
+		dataType := string(field.Type)
+		if field.Repeated {
+			dataType = fmt.Sprintf("ARRAY<%s>", dataType)
+		}
+		columns = append(columns, &BigQueryColumn{
+			Dataset:  dataset,
+			Table:    table,
+			Column:   field.Name,
+			DataType: dataType,
+			// repeated fields are never null, an empty array is returned instead
+			IsNullable:      !field.Required && !field.Repeated,
+			OrdinalPosition: int32(idx + 1),
+		})
+	}
+	return columns
+}
+
+// Converts the values returned by the bigquery client into strings, numbers, booleans, bytes, slices and maps
+// so that they can be streamed in the same text form that BigQuery uses for them.
+func normalizeBigQueryValue(value bigquery.Value) any {
+	switch t := value.(type) {
+	case time.Time:
+		return t.UTC().Format(time.RFC3339Nano)
+	case civil.Date:
+		return t.String()
+	case civil.Time:
+		return bigquery.CivilTimeString(t)
+	case civil.DateTime:
+		return bigquery.CivilDateTimeString(t)
+	case *big.Rat:
+		if t == nil {
+			return nil
+		}
+		return formatBigQueryNumeric(t)
+	case []bigquery.Value:
+		values := make([]any, 0, len(t))
+		for _, v := range t {
+			values = append(values, normalizeBigQueryValue(v))
+		}
+		return values
+	case map[string]bigquery.Value:
+		values := make(map[string]any, len(t))
+		for k, v := range t {
+			values[k] = normalizeBigQueryValue(v)
+		}
+		return values
+	default:
+		return t
+	}
+}
+
+// Formats NUMERIC and BIGNUMERIC values without rounding or trailing zeros. BIGNUMERIC has a scale of up to 38 digits
+func formatBigQueryNumeric(value *big.Rat) string {
+	if value.IsInt() {
+		return value.Num().String()
+	}
+	return strings.TrimRight(value.FloatString(38), "0")
+}
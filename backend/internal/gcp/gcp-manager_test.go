@@ -0,0 +1,35 @@
+package gcpmanager
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/civil"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getBigQueryColumns(t *testing.T) {
+	columns := getBigQueryColumns("analytics", "events", bigquery.Schema{
+		{Name: "id", Type: bigquery.IntegerFieldType, Required: true},
+		{Name: "name", Type: bigquery.StringFieldType},
+		{Name: "tags", Type: bigquery.StringFieldType, Repeated: true},
+	})
+	require.Equal(t, []*BigQueryColumn{
+		{Dataset: "analytics", Table: "events", Column: "id", DataType: "INTEGER", IsNullable: false, OrdinalPosition: 1},
+		{Dataset: "analytics", Table: "events", Column: "name", DataType: "STRING", IsNullable: true, OrdinalPosition: 2},
+		{Dataset: "analytics", Table: "events", Column: "tags", DataType: "ARRAY<STRING>", IsNullable: false, OrdinalPosition: 3},
+	}, columns)
+}
+
+func Test_normalizeBigQueryValue(t *testing.T) {
+	require.Equal(t, "2024-05-01T10:30:00.5Z", normalizeBigQueryValue(time.Date(2024, 5, 1, 10, 30, 0, 500000000, time.UTC)))
+	require.Equal(t, "2024-05-01", normalizeBigQueryValue(civil.Date{Year: 2024, Month: 5, Day: 1}))
+	require.Equal(t, "123.45", normalizeBigQueryValue(big.NewRat(12345, 100)))
+	require.Equal(t, "42", normalizeBigQueryValue(big.NewRat(42, 1)))
+	require.Equal(t, int64(1), normalizeBigQueryValue(int64(1)))
+	require.Nil(t, normalizeBigQueryValue(nil))
+	require.Equal(t, []any{"a", "b"}, normalizeBigQueryValue([]bigquery.Value{"a", "b"}))
+	require.Equal(t, map[string]any{"d": "2024-05-01"}, normalizeBigQueryValue(map[string]bigquery.Value{"d": civil.Date{Year: 2024, Month: 5, Day: 1}}))
+}
@@ -0,0 +1,183 @@
+package graphqlapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+)
+
+const (
+	defaultJobRunStatusPollInterval = 5 * time.Second
+
+	eventStreamContentType = "text/event-stream"
+)
+
+type Config struct {
+	// How often the status of a job run is polled while a subscription is open. Defaults to 5 seconds
+	JobRunStatusPollInterval time.Duration
+}
+
+// Serves a GraphQL schema over the jobs, job runs, connections and connection schemas of the mgmt services.
+// Queries and subscriptions are sent as a JSON POST body of query, variables and operationName.
+// Subscriptions must be requested with an Accept header of text/event-stream and are streamed back as server-sent events
+type Handler struct {
+	schema graphql.Schema
+	logger *slog.Logger
+}
+
+// Every resolver calls through the given service clients. When they are served in-process by NewInProcessClient,
+// the same auth, logging and validation interceptors run as for any other caller of the mgmt api
+func New(
+	cfg *Config,
+	logger *slog.Logger,
+	jobService mgmtv1alpha1connect.JobServiceClient,
+	connectionService mgmtv1alpha1connect.ConnectionServiceClient,
+	connectionDataService ConnectionSchemaClient,
+) (*Handler, error) {
+	pollInterval := cfg.JobRunStatusPollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultJobRunStatusPollInterval
+	}
+	schema, err := newSchema(&resolver{
+		jobService:            jobService,
+		connectionService:     connectionService,
+		connectionDataService: connectionDataService,
+		pollInterval:          pollInterval,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to build graphql schema: %w", err)
+	}
+	return &Handler{schema: schema, logger: logger}, nil
+}
+
+type graphqlRequest struct {
+	Query         string         `json:"query"`
+	Variables     map[string]any `json:"variables"`
+	OperationName string         `json:"operationName"`
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "graphql requests must be sent as a POST", http.StatusMethodNotAllowed)
+		return
+	}
+	var body graphqlRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, fmt.Sprintf("unable to decode graphql request: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	params := graphql.Params{
+		Schema:         h.schema,
+		RequestString:  body.Query,
+		VariableValues: body.Variables,
+		OperationName:  body.OperationName,
+		Context:        withForwardedHeaders(r.Context(), r.Header),
+	}
+
+	if isSubscription(body.Query, body.OperationName) {
+		if !acceptsEventStream(r) {
+			http.Error(w, fmt.Sprintf("subscriptions must be requested with an Accept header of %s", eventStreamContentType), http.StatusNotAcceptable)
+			return
+		}
+		h.serveSubscription(w, params)
+		return
+	}
+
+	result := graphql.Do(params)
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		h.logger.Error(fmt.Errorf("unable to write graphql response: %w", err).Error())
+	}
+}
+
+func (h *Handler) serveSubscription(w http.ResponseWriter, params graphql.Params) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported by this connection", http.StatusInternalServerError)
+		return
+	}
+	ctx, cancel := context.WithCancel(params.Context)
+	defer cancel()
+	params.Context = ctx
+
+	w.Header().Set("Content-Type", eventStreamContentType)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	// the result channel must be drained until it is closed, otherwise the goroutine that executes the subscription is leaked
+	isClientGone := false
+	for result := range graphql.Subscribe(params) {
+		if isClientGone {
+			continue
+		}
+		if err := writeEvent(w, "next", result); err != nil {
+			h.logger.Warn(fmt.Errorf("unable to write graphql subscription event, closing subscription: %w", err).Error())
+			isClientGone = true
+			cancel()
+			continue
+		}
+		flusher.Flush()
+	}
+	if !isClientGone {
+		if _, err := fmt.Fprint(w, "event: complete\ndata:\n\n"); err == nil {
+			flusher.Flush()
+		}
+	}
+}
+
+func writeEvent(w http.ResponseWriter, event string, result *graphql.Result) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data)
+	return err
+}
+
+// Returns true if the operation that will be executed is a subscription.
+// Queries that do not parse return false so that the parse errors are reported by the executor
+func isSubscription(query, operationName string) bool {
+	doc, err := parser.Parse(parser.ParseParams{Source: source.NewSource(&source.Source{Body: []byte(query)})})
+	if err != nil {
+		return false
+	}
+	var operations []*ast.OperationDefinition
+	for _, definition := range doc.Definitions {
+		if op, ok := definition.(*ast.OperationDefinition); ok {
+			operations = append(operations, op)
+		}
+	}
+	if operationName == "" {
+		return len(operations) == 1 && operations[0].Operation == ast.OperationTypeSubscription
+	}
+	for _, op := range operations {
+		if op.Name != nil && op.Name.Value == operationName {
+			return op.Operation == ast.OperationTypeSubscription
+		}
+	}
+	return false
+}
+
+func acceptsEventStream(r *http.Request) bool {
+	for _, accept := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accept))
+		if err == nil && mediaType == eventStreamContentType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,244 @@
+package graphqlapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockAccountId    = "5629813e-1a35-4874-922c-9827d85f0378"
+	mockJobId        = "8f8f1e6a-0a2b-4d0c-8e5f-2c1b6b1d9c1a"
+	mockConnectionId = "b9e0e7b5-57d6-4c3d-a0b7-3a0dd0cb6a6e"
+	mockJobRunId     = "my-job-run"
+)
+
+type serviceMocks struct {
+	jobService            *mgmtv1alpha1connect.MockJobServiceClient
+	connectionService     *mgmtv1alpha1connect.MockConnectionServiceClient
+	connectionDataService *mgmtv1alpha1connect.MockConnectionDataServiceHandler
+}
+
+func createHandler(t *testing.T) (*Handler, *serviceMocks) {
+	t.Helper()
+	mocks := &serviceMocks{
+		jobService:            mgmtv1alpha1connect.NewMockJobServiceClient(t),
+		connectionService:     mgmtv1alpha1connect.NewMockConnectionServiceClient(t),
+		connectionDataService: mgmtv1alpha1connect.NewMockConnectionDataServiceHandler(t),
+	}
+	handler, err := New(
+		&Config{JobRunStatusPollInterval: time.Millisecond},
+		slog.New(slog.NewTextHandler(os.Stdout, nil)),
+		mocks.jobService,
+		mocks.connectionService,
+		mocks.connectionDataService,
+	)
+	require.NoError(t, err)
+	return handler, mocks
+}
+
+func postGraphql(t *testing.T, handler http.Handler, query string, header http.Header) *httptest.ResponseRecorder {
+	t.Helper()
+	body, err := json.Marshal(map[string]any{"query": query})
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body))
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+type graphqlResponse struct {
+	Data   map[string]any   `json:"data"`
+	Errors []map[string]any `json:"errors"`
+}
+
+func decodeResponse(t *testing.T, data []byte) *graphqlResponse {
+	t.Helper()
+	var resp graphqlResponse
+	require.NoError(t, json.Unmarshal(data, &resp))
+	return &resp
+}
+
+func Test_Handler_Query_Job(t *testing.T) {
+	handler, mocks := createHandler(t)
+
+	mocks.jobService.On("GetJob", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetJobResponse{Job: &mgmtv1alpha1.Job{
+			Id:        mockJobId,
+			Name:      "my-job",
+			AccountId: mockAccountId,
+			Source: &mgmtv1alpha1.JobSource{Options: &mgmtv1alpha1.JobSourceOptions{
+				Config: &mgmtv1alpha1.JobSourceOptions_Postgres{Postgres: &mgmtv1alpha1.PostgresSourceConnectionOptions{ConnectionId: mockConnectionId}},
+			}},
+			Mappings: []*mgmtv1alpha1.JobMapping{{
+				Schema: "public", Table: "users", Column: "email",
+				Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL},
+			}},
+		}}), nil)
+	mocks.connectionService.On("GetConnection", mock.Anything, mock.MatchedBy(func(req *connect.Request[mgmtv1alpha1.GetConnectionRequest]) bool {
+		return req.Msg.GetId() == mockConnectionId
+	})).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{Connection: &mgmtv1alpha1.Connection{
+		Id:               mockConnectionId,
+		Name:             "prod",
+		ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{}},
+	}}), nil)
+	mocks.jobService.On("GetJobRuns", mock.Anything, mock.MatchedBy(func(req *connect.Request[mgmtv1alpha1.GetJobRunsRequest]) bool {
+		return req.Msg.GetJobId() == mockJobId
+	})).Return(connect.NewResponse(&mgmtv1alpha1.GetJobRunsResponse{JobRuns: []*mgmtv1alpha1.JobRun{
+		{Id: mockJobRunId, JobId: mockJobId, Status: mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_RUNNING},
+	}}), nil)
+
+	// only the selected fields are resolved, so GetJobStatus and GetConnectionSchema must not be called
+	rec := postGraphql(t, handler, `{
+		job(id: "`+mockJobId+`") {
+			name
+			sourceConnection { name type }
+			mappings { column transformerSource }
+			runs { id status }
+		}
+	}`, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	resp := decodeResponse(t, rec.Body.Bytes())
+	require.Empty(t, resp.Errors)
+	require.Equal(t, map[string]any{
+		"job": map[string]any{
+			"name":             "my-job",
+			"sourceConnection": map[string]any{"name": "prod", "type": "postgres"},
+			"mappings":         []any{map[string]any{"column": "email", "transformerSource": "TRANSFORM_EMAIL"}},
+			"runs":             []any{map[string]any{"id": mockJobRunId, "status": "RUNNING"}},
+		},
+	}, resp.Data)
+}
+
+func Test_Handler_Query_ServiceError(t *testing.T) {
+	handler, mocks := createHandler(t)
+
+	mocks.connectionService.On("GetConnections", mock.Anything, mock.Anything).
+		Return(nil, connect.NewError(connect.CodePermissionDenied, nil))
+
+	rec := postGraphql(t, handler, `{ connections(accountId: "`+mockAccountId+`") { id } }`, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	resp := decodeResponse(t, rec.Body.Bytes())
+	require.Len(t, resp.Errors, 1)
+	require.Contains(t, resp.Errors[0]["message"], "permission_denied")
+}
+
+func Test_Handler_Query_JobRuns_RequiresOneId(t *testing.T) {
+	handler, _ := createHandler(t)
+
+	rec := postGraphql(t, handler, `{ jobRuns(jobId: "`+mockJobId+`", accountId: "`+mockAccountId+`") { id } }`, nil)
+	resp := decodeResponse(t, rec.Body.Bytes())
+	require.Len(t, resp.Errors, 1)
+	require.Contains(t, resp.Errors[0]["message"], "exactly one of jobId or accountId")
+}
+
+func Test_Handler_MethodNotAllowed(t *testing.T) {
+	handler, _ := createHandler(t)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/graphql", http.NoBody))
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func Test_Handler_Subscription_JobRunStatus(t *testing.T) {
+	handler, mocks := createHandler(t)
+
+	statuses := []mgmtv1alpha1.JobRunStatus{
+		mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_RUNNING,
+		mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_RUNNING,
+		mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_COMPLETE,
+	}
+	for _, status := range statuses {
+		mocks.jobService.On("GetJobRun", mock.Anything, mock.Anything).
+			Return(connect.NewResponse(&mgmtv1alpha1.GetJobRunResponse{JobRun: &mgmtv1alpha1.JobRun{Id: mockJobRunId, Status: status}}), nil).
+			Once()
+	}
+
+	rec := postGraphql(
+		t,
+		handler,
+		`subscription { jobRunStatus(id: "`+mockJobRunId+`", accountId: "`+mockAccountId+`") { id status } }`,
+		http.Header{"Accept": []string{"text/event-stream"}},
+	)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+
+	events := strings.Split(strings.TrimSpace(rec.Body.String()), "\n\n")
+	// the repeated running status is only emitted once
+	require.Len(t, events, 3)
+	require.Equal(t, "event: complete\ndata:", events[2])
+	for idx, expected := range []string{"RUNNING", "COMPLETE"} {
+		lines := strings.SplitN(events[idx], "\n", 2)
+		require.Equal(t, "event: next", lines[0])
+		resp := decodeResponse(t, []byte(strings.TrimPrefix(lines[1], "data: ")))
+		require.Empty(t, resp.Errors)
+		require.Equal(t, map[string]any{"jobRunStatus": map[string]any{"id": mockJobRunId, "status": expected}}, resp.Data)
+	}
+}
+
+func Test_Handler_Subscription_RequiresEventStream(t *testing.T) {
+	handler, _ := createHandler(t)
+
+	rec := postGraphql(t, handler, `subscription { jobRunStatus(id: "`+mockJobRunId+`", accountId: "`+mockAccountId+`") { status } }`, nil)
+	require.Equal(t, http.StatusNotAcceptable, rec.Code)
+}
+
+func Test_isSubscription(t *testing.T) {
+	require.True(t, isSubscription(`subscription { jobRunStatus(id: "1", accountId: "2") { status } }`, ""))
+	require.False(t, isSubscription(`{ jobs(accountId: "1") { id } }`, ""))
+	require.False(t, isSubscription(`{ jobs(`, ""))
+
+	multiple := `query Jobs { jobs(accountId: "1") { id } } subscription Run { jobRunStatus(id: "1", accountId: "2") { status } }`
+	require.True(t, isSubscription(multiple, "Run"))
+	require.False(t, isSubscription(multiple, "Jobs"))
+	require.False(t, isSubscription(multiple, ""))
+}
+
+func Test_InProcessClient_ForwardsAuthorization(t *testing.T) {
+	jobHandler := mgmtv1alpha1connect.NewMockJobServiceHandler(t)
+	jobHandler.On("GetJob", mock.Anything, mock.MatchedBy(func(req *connect.Request[mgmtv1alpha1.GetJobRequest]) bool {
+		return req.Header().Get("Authorization") == "Bearer my-token"
+	})).Return(connect.NewResponse(&mgmtv1alpha1.GetJobResponse{Job: &mgmtv1alpha1.Job{Id: mockJobId}}), nil)
+
+	mux := http.NewServeMux()
+	mux.Handle(mgmtv1alpha1connect.NewJobServiceHandler(jobHandler))
+	client := mgmtv1alpha1connect.NewJobServiceClient(NewInProcessClient(mux), InProcessBaseUrl)
+
+	ctx := withForwardedHeaders(context.Background(), http.Header{
+		"Authorization": []string{"Bearer my-token"},
+		"Cookie":        []string{"not-forwarded"},
+	})
+	resp, err := client.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{Id: mockJobId}))
+	require.NoError(t, err)
+	require.Equal(t, mockJobId, resp.Msg.GetJob().GetId())
+}
+
+func Test_InProcessClient_Error(t *testing.T) {
+	jobHandler := mgmtv1alpha1connect.NewMockJobServiceHandler(t)
+	jobHandler.On("GetJob", mock.Anything, mock.Anything).
+		Return(nil, connect.NewError(connect.CodeNotFound, nil))
+
+	mux := http.NewServeMux()
+	mux.Handle(mgmtv1alpha1connect.NewJobServiceHandler(jobHandler))
+	client := mgmtv1alpha1connect.NewJobServiceClient(NewInProcessClient(mux), InProcessBaseUrl)
+
+	_, err := client.GetJob(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobRequest{Id: mockJobId}))
+	require.Error(t, err)
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
@@ -0,0 +1,513 @@
+package graphqlapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/graphql-go/graphql"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// The subset of the connection data service that the schema resolves against
+type ConnectionSchemaClient interface {
+	GetConnectionSchema(
+		ctx context.Context,
+		req *connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest],
+	) (*connect.Response[mgmtv1alpha1.GetConnectionSchemaResponse], error)
+}
+
+type resolver struct {
+	jobService            mgmtv1alpha1connect.JobServiceClient
+	connectionService     mgmtv1alpha1connect.ConnectionServiceClient
+	connectionDataService ConnectionSchemaClient
+
+	pollInterval time.Duration
+}
+
+var (
+	jobRunStatusEnum = newProtoEnum(
+		"JobRunStatus",
+		"JOB_RUN_STATUS_",
+		mgmtv1alpha1.JobRunStatus_name,
+		func(v int32) any { return mgmtv1alpha1.JobRunStatus(v) },
+	)
+	jobStatusEnum = newProtoEnum(
+		"JobStatus",
+		"JOB_STATUS_",
+		mgmtv1alpha1.JobStatus_name,
+		func(v int32) any { return mgmtv1alpha1.JobStatus(v) },
+	)
+	transformerSourceEnum = newProtoEnum(
+		"TransformerSource",
+		"TRANSFORMER_SOURCE_",
+		mgmtv1alpha1.TransformerSource_name,
+		func(v int32) any { return mgmtv1alpha1.TransformerSource(v) },
+	)
+
+	terminalJobRunStatuses = map[mgmtv1alpha1.JobRunStatus]struct{}{
+		mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_COMPLETE:   {},
+		mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_ERROR:      {},
+		mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_CANCELED:   {},
+		mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_TERMINATED: {},
+		mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_FAILED:     {},
+		mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_TIMED_OUT:  {},
+	}
+)
+
+// Builds a graphql enum from a generated proto enum name map, with the proto prefix trimmed from each value name
+func newProtoEnum(name, prefix string, names map[int32]string, toValue func(int32) any) *graphql.Enum {
+	values := graphql.EnumValueConfigMap{}
+	for value, valueName := range names {
+		values[strings.TrimPrefix(valueName, prefix)] = &graphql.EnumValueConfig{Value: toValue(value)}
+	}
+	return graphql.NewEnum(graphql.EnumConfig{Name: name, Values: values})
+}
+
+func newSchema(r *resolver) (graphql.Schema, error) {
+	databaseColumnType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "DatabaseColumn",
+		Fields: graphql.Fields{
+			"schema":     stringField(func(c *mgmtv1alpha1.DatabaseColumn) string { return c.GetSchema() }),
+			"table":      stringField(func(c *mgmtv1alpha1.DatabaseColumn) string { return c.GetTable() }),
+			"column":     stringField(func(c *mgmtv1alpha1.DatabaseColumn) string { return c.GetColumn() }),
+			"dataType":   stringField(func(c *mgmtv1alpha1.DatabaseColumn) string { return c.GetDataType() }),
+			"isNullable": stringField(func(c *mgmtv1alpha1.DatabaseColumn) string { return c.GetIsNullable() }),
+			"columnDefault": &graphql.Field{
+				Type: graphql.String,
+				Resolve: fromSource(func(_ context.Context, c *mgmtv1alpha1.DatabaseColumn) (any, error) {
+					if c.ColumnDefault == nil {
+						return nil, nil
+					}
+					return c.GetColumnDefault(), nil
+				}),
+			},
+		},
+	})
+
+	connectionType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Connection",
+		Fields: graphql.Fields{
+			"id":        nonNullStringField(func(c *mgmtv1alpha1.Connection) string { return c.GetId() }),
+			"name":      nonNullStringField(func(c *mgmtv1alpha1.Connection) string { return c.GetName() }),
+			"accountId": nonNullStringField(func(c *mgmtv1alpha1.Connection) string { return c.GetAccountId() }),
+			"type":      nonNullStringField(getConnectionType),
+			"createdAt": timestampField(func(c *mgmtv1alpha1.Connection) *timestamppb.Timestamp { return c.GetCreatedAt() }),
+			"updatedAt": timestampField(func(c *mgmtv1alpha1.Connection) *timestamppb.Timestamp { return c.GetUpdatedAt() }),
+			"schema": &graphql.Field{
+				Type: graphql.NewList(graphql.NewNonNull(databaseColumnType)),
+				Resolve: fromSource(func(ctx context.Context, c *mgmtv1alpha1.Connection) (any, error) {
+					return r.getConnectionSchema(ctx, c.GetId())
+				}),
+			},
+		},
+	})
+
+	jobRunType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "JobRun",
+		Fields: graphql.Fields{
+			"id":    nonNullStringField(func(jr *mgmtv1alpha1.JobRun) string { return jr.GetId() }),
+			"jobId": nonNullStringField(func(jr *mgmtv1alpha1.JobRun) string { return jr.GetJobId() }),
+			"name":  nonNullStringField(func(jr *mgmtv1alpha1.JobRun) string { return jr.GetName() }),
+			"status": &graphql.Field{
+				Type: graphql.NewNonNull(jobRunStatusEnum),
+				Resolve: fromSource(func(_ context.Context, jr *mgmtv1alpha1.JobRun) (any, error) {
+					return jr.GetStatus(), nil
+				}),
+			},
+			"startedAt":   timestampField(func(jr *mgmtv1alpha1.JobRun) *timestamppb.Timestamp { return jr.GetStartedAt() }),
+			"completedAt": timestampField(func(jr *mgmtv1alpha1.JobRun) *timestamppb.Timestamp { return jr.GetCompletedAt() }),
+		},
+	})
+
+	jobDestinationType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "JobDestination",
+		Fields: graphql.Fields{
+			"id":           nonNullStringField(func(d *mgmtv1alpha1.JobDestination) string { return d.GetId() }),
+			"connectionId": nonNullStringField(func(d *mgmtv1alpha1.JobDestination) string { return d.GetConnectionId() }),
+			"connection": &graphql.Field{
+				Type: connectionType,
+				Resolve: fromSource(func(ctx context.Context, d *mgmtv1alpha1.JobDestination) (any, error) {
+					return r.getConnection(ctx, d.GetConnectionId())
+				}),
+			},
+		},
+	})
+
+	jobMappingType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "JobMapping",
+		Fields: graphql.Fields{
+			"schema": nonNullStringField(func(m *mgmtv1alpha1.JobMapping) string { return m.GetSchema() }),
+			"table":  nonNullStringField(func(m *mgmtv1alpha1.JobMapping) string { return m.GetTable() }),
+			"column": nonNullStringField(func(m *mgmtv1alpha1.JobMapping) string { return m.GetColumn() }),
+			"transformerSource": &graphql.Field{
+				Type: graphql.NewNonNull(transformerSourceEnum),
+				Resolve: fromSource(func(_ context.Context, m *mgmtv1alpha1.JobMapping) (any, error) {
+					return m.GetTransformer().GetSource(), nil
+				}),
+			},
+		},
+	})
+
+	jobType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Job",
+		Fields: graphql.Fields{
+			"id":        nonNullStringField(func(j *mgmtv1alpha1.Job) string { return j.GetId() }),
+			"name":      nonNullStringField(func(j *mgmtv1alpha1.Job) string { return j.GetName() }),
+			"accountId": nonNullStringField(func(j *mgmtv1alpha1.Job) string { return j.GetAccountId() }),
+			"cronSchedule": &graphql.Field{
+				Type: graphql.String,
+				Resolve: fromSource(func(_ context.Context, j *mgmtv1alpha1.Job) (any, error) {
+					if j.CronSchedule == nil {
+						return nil, nil
+					}
+					return j.GetCronSchedule(), nil
+				}),
+			},
+			"createdAt": timestampField(func(j *mgmtv1alpha1.Job) *timestamppb.Timestamp { return j.GetCreatedAt() }),
+			"updatedAt": timestampField(func(j *mgmtv1alpha1.Job) *timestamppb.Timestamp { return j.GetUpdatedAt() }),
+			"sourceConnection": &graphql.Field{
+				Type: connectionType,
+				Resolve: fromSource(func(ctx context.Context, j *mgmtv1alpha1.Job) (any, error) {
+					connectionId := getJobSourceConnectionId(j.GetSource())
+					if connectionId == "" {
+						return nil, nil
+					}
+					return r.getConnection(ctx, connectionId)
+				}),
+			},
+			"destinations": &graphql.Field{
+				Type: graphql.NewList(graphql.NewNonNull(jobDestinationType)),
+				Resolve: fromSource(func(_ context.Context, j *mgmtv1alpha1.Job) (any, error) {
+					return j.GetDestinations(), nil
+				}),
+			},
+			"mappings": &graphql.Field{
+				Type: graphql.NewList(graphql.NewNonNull(jobMappingType)),
+				Resolve: fromSource(func(_ context.Context, j *mgmtv1alpha1.Job) (any, error) {
+					return j.GetMappings(), nil
+				}),
+			},
+			"status": &graphql.Field{
+				Type: jobStatusEnum,
+				Resolve: fromSource(func(ctx context.Context, j *mgmtv1alpha1.Job) (any, error) {
+					resp, err := r.jobService.GetJobStatus(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobStatusRequest{JobId: j.GetId()}))
+					if err != nil {
+						return nil, err
+					}
+					return resp.Msg.GetStatus(), nil
+				}),
+			},
+			"runs": &graphql.Field{
+				Type: graphql.NewList(graphql.NewNonNull(jobRunType)),
+				Resolve: fromSource(func(ctx context.Context, j *mgmtv1alpha1.Job) (any, error) {
+					return r.getJobRuns(ctx, &mgmtv1alpha1.GetJobRunsRequest{
+						Id: &mgmtv1alpha1.GetJobRunsRequest_JobId{JobId: j.GetId()},
+					})
+				}),
+			},
+		},
+	})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"jobs": &graphql.Field{
+				Type: graphql.NewList(graphql.NewNonNull(jobType)),
+				Args: graphql.FieldConfigArgument{
+					"accountId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					resp, err := r.jobService.GetJobs(p.Context, connect.NewRequest(&mgmtv1alpha1.GetJobsRequest{
+						AccountId: stringArg(p, "accountId"),
+					}))
+					if err != nil {
+						return nil, err
+					}
+					return resp.Msg.GetJobs(), nil
+				},
+			},
+			"job": &graphql.Field{
+				Type: jobType,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					resp, err := r.jobService.GetJob(p.Context, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{
+						Id: stringArg(p, "id"),
+					}))
+					if err != nil {
+						return nil, err
+					}
+					return resp.Msg.GetJob(), nil
+				},
+			},
+			"jobRuns": &graphql.Field{
+				Type:        graphql.NewList(graphql.NewNonNull(jobRunType)),
+				Description: "Returns the runs of a job, or of every job in an account. Exactly one of jobId or accountId must be provided",
+				Args: graphql.FieldConfigArgument{
+					"jobId":     &graphql.ArgumentConfig{Type: graphql.ID},
+					"accountId": &graphql.ArgumentConfig{Type: graphql.ID},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					jobId := stringArg(p, "jobId")
+					accountId := stringArg(p, "accountId")
+					switch {
+					case jobId != "" && accountId == "":
+						return r.getJobRuns(p.Context, &mgmtv1alpha1.GetJobRunsRequest{
+							Id: &mgmtv1alpha1.GetJobRunsRequest_JobId{JobId: jobId},
+						})
+					case accountId != "" && jobId == "":
+						return r.getJobRuns(p.Context, &mgmtv1alpha1.GetJobRunsRequest{
+							Id: &mgmtv1alpha1.GetJobRunsRequest_AccountId{AccountId: accountId},
+						})
+					default:
+						return nil, fmt.Errorf("exactly one of jobId or accountId must be provided")
+					}
+				},
+			},
+			"jobRun": &graphql.Field{
+				Type: jobRunType,
+				Args: graphql.FieldConfigArgument{
+					"id":        &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"accountId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return r.getJobRun(p.Context, stringArg(p, "accountId"), stringArg(p, "id"))
+				},
+			},
+			"connections": &graphql.Field{
+				Type: graphql.NewList(graphql.NewNonNull(connectionType)),
+				Args: graphql.FieldConfigArgument{
+					"accountId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					resp, err := r.connectionService.GetConnections(p.Context, connect.NewRequest(&mgmtv1alpha1.GetConnectionsRequest{
+						AccountId: stringArg(p, "accountId"),
+					}))
+					if err != nil {
+						return nil, err
+					}
+					return resp.Msg.GetConnections(), nil
+				},
+			},
+			"connection": &graphql.Field{
+				Type: connectionType,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return r.getConnection(p.Context, stringArg(p, "id"))
+				},
+			},
+			"connectionSchema": &graphql.Field{
+				Type: graphql.NewList(graphql.NewNonNull(databaseColumnType)),
+				Args: graphql.FieldConfigArgument{
+					"connectionId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return r.getConnectionSchema(p.Context, stringArg(p, "connectionId"))
+				},
+			},
+		},
+	})
+
+	subscriptionType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Subscription",
+		Fields: graphql.Fields{
+			"jobRunStatus": &graphql.Field{
+				Type:        graphql.NewNonNull(jobRunType),
+				Description: "Emits the job run every time its status changes. Completes once the run reaches a terminal status",
+				Args: graphql.FieldConfigArgument{
+					"id":        &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"accountId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Subscribe: func(p graphql.ResolveParams) (any, error) {
+					return r.watchJobRunStatus(p.Context, stringArg(p, "accountId"), stringArg(p, "id")), nil
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					// each event emitted by the subscribe func is handed to this resolver as the source
+					if err, ok := p.Source.(error); ok {
+						return nil, err
+					}
+					return p.Source, nil
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query:        queryType,
+		Subscription: subscriptionType,
+	})
+}
+
+// Polls the job run until it reaches a terminal status, emitting the run each time the status changes.
+// An error is emitted as the final event if the run can not be retrieved
+func (r *resolver) watchJobRunStatus(ctx context.Context, accountId, jobRunId string) chan any {
+	events := make(chan any)
+	go func() {
+		defer close(events)
+		ticker := time.NewTicker(r.pollInterval)
+		defer ticker.Stop()
+
+		var lastStatus *mgmtv1alpha1.JobRunStatus
+		for {
+			var event any
+			jobRun, err := r.getJobRun(ctx, accountId, jobRunId)
+			if err != nil {
+				event = err
+			} else if lastStatus == nil || *lastStatus != jobRun.GetStatus() {
+				status := jobRun.GetStatus()
+				lastStatus = &status
+				event = jobRun
+			}
+			if event != nil {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if err != nil {
+				return
+			}
+			if _, ok := terminalJobRunStatuses[jobRun.GetStatus()]; ok {
+				return
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events
+}
+
+func (r *resolver) getJobRun(ctx context.Context, accountId, jobRunId string) (*mgmtv1alpha1.JobRun, error) {
+	resp, err := r.jobService.GetJobRun(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRunRequest{
+		JobRunId:  jobRunId,
+		AccountId: accountId,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg.GetJobRun(), nil
+}
+
+func (r *resolver) getJobRuns(ctx context.Context, req *mgmtv1alpha1.GetJobRunsRequest) ([]*mgmtv1alpha1.JobRun, error) {
+	resp, err := r.jobService.GetJobRuns(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg.GetJobRuns(), nil
+}
+
+func (r *resolver) getConnection(ctx context.Context, connectionId string) (*mgmtv1alpha1.Connection, error) {
+	resp, err := r.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+		Id: connectionId,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg.GetConnection(), nil
+}
+
+func (r *resolver) getConnectionSchema(ctx context.Context, connectionId string) ([]*mgmtv1alpha1.DatabaseColumn, error) {
+	resp, err := r.connectionDataService.GetConnectionSchema(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionSchemaRequest{
+		ConnectionId: connectionId,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg.GetSchemas(), nil
+}
+
+// Wraps a resolver of a field on an object type, asserting the type of the parent object
+func fromSource[T any](fn func(ctx context.Context, src T) (any, error)) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (any, error) {
+		src, ok := p.Source.(T)
+		if !ok {
+			return nil, fmt.Errorf("unexpected source type %T", p.Source)
+		}
+		return fn(p.Context, src)
+	}
+}
+
+func stringField[T any](fn func(T) string) *graphql.Field {
+	return &graphql.Field{
+		Type:    graphql.String,
+		Resolve: fromSource(func(_ context.Context, src T) (any, error) { return fn(src), nil }),
+	}
+}
+
+func nonNullStringField[T any](fn func(T) string) *graphql.Field {
+	return &graphql.Field{
+		Type:    graphql.NewNonNull(graphql.String),
+		Resolve: fromSource(func(_ context.Context, src T) (any, error) { return fn(src), nil }),
+	}
+}
+
+func timestampField[T any](fn func(T) *timestamppb.Timestamp) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.DateTime,
+		Resolve: fromSource(func(_ context.Context, src T) (any, error) {
+			ts := fn(src)
+			if ts == nil {
+				return nil, nil
+			}
+			return ts.AsTime(), nil
+		}),
+	}
+}
+
+func stringArg(p graphql.ResolveParams, name string) string {
+	value, _ := p.Args[name].(string)
+	return value
+}
+
+func getConnectionType(connection *mgmtv1alpha1.Connection) string {
+	switch connection.GetConnectionConfig().GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
+		return "postgres"
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+		return "mysql"
+	case *mgmtv1alpha1.ConnectionConfig_AwsS3Config:
+		return "aws_s3"
+	case *mgmtv1alpha1.ConnectionConfig_LocalDirConfig:
+		return "local_directory"
+	case *mgmtv1alpha1.ConnectionConfig_OpenaiConfig:
+		return "openai"
+	case *mgmtv1alpha1.ConnectionConfig_CustomConfig:
+		return "custom"
+	default:
+		return "unknown"
+	}
+}
+
+func getJobSourceConnectionId(jobSource *mgmtv1alpha1.JobSource) string {
+	switch config := jobSource.GetOptions().GetConfig().(type) {
+	case *mgmtv1alpha1.JobSourceOptions_Postgres:
+		return config.Postgres.GetConnectionId()
+	case *mgmtv1alpha1.JobSourceOptions_Mysql:
+		return config.Mysql.GetConnectionId()
+	case *mgmtv1alpha1.JobSourceOptions_AwsS3:
+		return config.AwsS3.GetConnectionId()
+	case *mgmtv1alpha1.JobSourceOptions_Custom:
+		return config.Custom.GetConnectionId()
+	case *mgmtv1alpha1.JobSourceOptions_Generate:
+		return config.Generate.GetFkSourceConnectionId()
+	case *mgmtv1alpha1.JobSourceOptions_AiGenerate:
+		return config.AiGenerate.GetFkSourceConnectionId()
+	case *mgmtv1alpha1.JobSourceOptions_Synthesize:
+		return config.Synthesize.GetConnectionId()
+	default:
+		return ""
+	}
+}
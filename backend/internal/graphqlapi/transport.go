@@ -0,0 +1,87 @@
+package graphqlapi
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+
+	"connectrpc.com/connect"
+)
+
+// The base url handed to connect clients that are served in-process. Only the path is used to route a request
+const InProcessBaseUrl = "http://graphql.neosync.internal"
+
+// The headers of the graphql request that are copied on to every mgmt service request made while resolving it
+var forwardedHeaders = []string{"Authorization"}
+
+type forwardedHeadersKey struct{}
+
+func withForwardedHeaders(ctx context.Context, header http.Header) context.Context {
+	forwarded := http.Header{}
+	for _, key := range forwardedHeaders {
+		if values := header.Values(key); len(values) > 0 {
+			forwarded[http.CanonicalHeaderKey(key)] = values
+		}
+	}
+	return context.WithValue(ctx, forwardedHeadersKey{}, forwarded)
+}
+
+// Returns a connect http client that serves every request with the given handler instead of sending it over the network.
+// The caller's Authorization header is forwarded from the graphql request so the mgmt services authorize the caller as usual.
+// Only unary procedures are supported
+func NewInProcessClient(handler http.Handler) connect.HTTPClient {
+	return &inProcessClient{handler: handler}
+}
+
+type inProcessClient struct {
+	handler http.Handler
+}
+
+func (c *inProcessClient) Do(req *http.Request) (*http.Response, error) {
+	if forwarded, ok := req.Context().Value(forwardedHeadersKey{}).(http.Header); ok {
+		for key, values := range forwarded {
+			req.Header[key] = values
+		}
+	}
+	rec := &responseRecorder{header: http.Header{}}
+	c.handler.ServeHTTP(rec, req)
+	return rec.toResponse(req), nil
+}
+
+// Buffers the response of a unary procedure so that it can be handed back to the connect client
+type responseRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.WriteHeader(http.StatusOK)
+	return r.body.Write(b)
+}
+
+func (r *responseRecorder) toResponse(req *http.Request) *http.Response {
+	r.WriteHeader(http.StatusOK)
+	return &http.Response{
+		Status:        http.StatusText(r.status),
+		StatusCode:    r.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        r.header,
+		Body:          io.NopCloser(bytes.NewReader(r.body.Bytes())),
+		ContentLength: int64(r.body.Len()),
+		Request:       req,
+	}
+}
@@ -0,0 +1,141 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// The request header that clients set to make a mutating request safe to retry
+	KeyHeader = "Idempotency-Key"
+	// Set on responses that were replayed from a previous request with the same key
+	ReplayedHeader = "Idempotent-Replayed"
+
+	maxKeyLength = 255
+)
+
+type protoMessage[T any] interface {
+	*T
+	proto.Message
+}
+
+// Runs fn at most once for each idempotency key provided in the request headers.
+// If the key has already been used for a request that succeeded, the stored response is returned instead of calling fn.
+// Keys are scoped to the account and operation, and requests without a key are always run.
+func Do[T any, PT protoMessage[T]](
+	ctx context.Context,
+	db *nucleusdb.NucleusDb,
+	header http.Header,
+	accountUuid pgtype.UUID,
+	operation string,
+	request proto.Message,
+	fn func() (*connect.Response[T], error),
+) (*connect.Response[T], error) {
+	key := header.Get(KeyHeader)
+	if key == "" {
+		return fn()
+	}
+	if len(key) > maxKeyLength {
+		return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("%s must be at most %d characters", KeyHeader, maxKeyLength))
+	}
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx).With("operation", operation)
+
+	requestHash, err := hashRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	reservation, err := db.Q.ReserveIdempotencyKey(ctx, db.Db, db_queries.ReserveIdempotencyKeyParams{
+		AccountID:      accountUuid,
+		Operation:      operation,
+		IdempotencyKey: key,
+		RequestHash:    requestHash,
+	})
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, fmt.Errorf("unable to reserve idempotency key: %w", err)
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return replay[T, PT](ctx, db, accountUuid, operation, key, requestHash)
+	}
+
+	resp, err := fn()
+	if err != nil {
+		// the request did not go through, so the key is freed up to allow it to be retried
+		if removeErr := db.Q.RemoveIdempotencyKey(context.WithoutCancel(ctx), db.Db, reservation.ID); removeErr != nil {
+			logger.Warn(fmt.Sprintf("unable to remove idempotency key after failed request: %s", removeErr.Error()))
+		}
+		return nil, err
+	}
+
+	bits, err := proto.Marshal(PT(resp.Msg))
+	if err != nil {
+		return nil, err
+	}
+	if bits == nil {
+		// empty messages marshal to nil, which would be stored as null and look like the request is still in progress
+		bits = []byte{}
+	}
+	err = db.Q.SetIdempotencyKeyResponse(context.WithoutCancel(ctx), db.Db, db_queries.SetIdempotencyKeyResponseParams{
+		Response: bits,
+		ID:       reservation.ID,
+	})
+	if err != nil {
+		// the request succeeded, so this is not surfaced to the caller. the key will be reclaimed once it goes stale
+		logger.Error(fmt.Sprintf("unable to store response for idempotency key: %s", err.Error()))
+	}
+	return resp, nil
+}
+
+func replay[T any, PT protoMessage[T]](
+	ctx context.Context,
+	db *nucleusdb.NucleusDb,
+	accountUuid pgtype.UUID,
+	operation, key string,
+	requestHash []byte,
+) (*connect.Response[T], error) {
+	existing, err := db.Q.GetIdempotencyKey(ctx, db.Db, db_queries.GetIdempotencyKeyParams{
+		AccountID:      accountUuid,
+		Operation:      operation,
+		IdempotencyKey: key,
+	})
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, fmt.Errorf("unable to retrieve idempotency key: %w", err)
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		// the other request failed and released the key in between our reserve and read
+		return nil, nucleuserrors.WithErrorCode(nucleuserrors.NewAborted("a request with this idempotency key was in progress, please retry"), mgmtv1alpha1.ErrorCode_ERROR_CODE_IDEMPOTENCY_KEY_IN_USE)
+	}
+	if !bytes.Equal(existing.RequestHash, requestHash) {
+		return nil, nucleuserrors.WithErrorCode(nucleuserrors.NewBadRequest(fmt.Sprintf("%s has already been used with a different request", KeyHeader)), mgmtv1alpha1.ErrorCode_ERROR_CODE_IDEMPOTENCY_KEY_MISMATCH)
+	}
+	if existing.Response == nil {
+		return nil, nucleuserrors.WithErrorCode(nucleuserrors.NewAborted("a request with this idempotency key is still in progress"), mgmtv1alpha1.ErrorCode_ERROR_CODE_IDEMPOTENCY_KEY_IN_USE)
+	}
+
+	var msg T
+	if err := proto.Unmarshal(existing.Response, PT(&msg)); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal stored idempotent response: %w", err)
+	}
+	resp := connect.NewResponse(&msg)
+	resp.Header().Set(ReplayedHeader, "true")
+	return resp, nil
+}
+
+func hashRequest(request proto.Message) ([]byte, error) {
+	bits, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(bits)
+	return hash[:], nil
+}
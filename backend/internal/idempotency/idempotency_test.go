@@ -0,0 +1,167 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	mockAccountId = "5629813e-1a35-4874-922c-9827d85f0378"
+	mockKeyId     = "0f0c8c35-3c56-4d2a-8b7e-4d1e1b7f9a10"
+)
+
+func Test_Do_NoKey(t *testing.T) {
+	querierMock := db_queries.NewMockQuerier(t)
+	db := nucleusdb.New(nucleusdb.NewMockDBTX(t), querierMock)
+
+	calls := 0
+	resp, err := Do(context.Background(), db, http.Header{}, mustUuid(t, mockAccountId), "CreateJobRun", &mgmtv1alpha1.CreateJobRunRequest{JobId: "123"}, countingFn(&calls))
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, 1, calls)
+	querierMock.AssertNotCalled(t, "ReserveIdempotencyKey", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Do_KeyTooLong(t *testing.T) {
+	db := nucleusdb.New(nucleusdb.NewMockDBTX(t), db_queries.NewMockQuerier(t))
+
+	calls := 0
+	_, err := Do(context.Background(), db, keyHeader(string(make([]byte, maxKeyLength+1))), mustUuid(t, mockAccountId), "CreateJobRun", &mgmtv1alpha1.CreateJobRunRequest{}, countingFn(&calls))
+	require.Error(t, err)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	require.Equal(t, 0, calls)
+}
+
+func Test_Do_FirstRequest(t *testing.T) {
+	querierMock := db_queries.NewMockQuerier(t)
+	db := nucleusdb.New(nucleusdb.NewMockDBTX(t), querierMock)
+	keyUuid := mustUuid(t, mockKeyId)
+
+	querierMock.On("ReserveIdempotencyKey", mock.Anything, mock.Anything, mock.MatchedBy(func(params db_queries.ReserveIdempotencyKeyParams) bool {
+		return params.IdempotencyKey == "abc" && params.Operation == "CreateJobRun" && len(params.RequestHash) > 0
+	})).Return(db_queries.NeosyncApiIdempotencyKey{ID: keyUuid}, nil)
+	querierMock.On("SetIdempotencyKeyResponse", mock.Anything, mock.Anything, db_queries.SetIdempotencyKeyResponseParams{
+		Response: []byte{},
+		ID:       keyUuid,
+	}).Return(nil)
+
+	calls := 0
+	resp, err := Do(context.Background(), db, keyHeader("abc"), mustUuid(t, mockAccountId), "CreateJobRun", &mgmtv1alpha1.CreateJobRunRequest{JobId: "123"}, countingFn(&calls))
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, 1, calls)
+	require.Empty(t, resp.Header().Get(ReplayedHeader))
+}
+
+func Test_Do_FailedRequestReleasesKey(t *testing.T) {
+	querierMock := db_queries.NewMockQuerier(t)
+	db := nucleusdb.New(nucleusdb.NewMockDBTX(t), querierMock)
+	keyUuid := mustUuid(t, mockKeyId)
+
+	querierMock.On("ReserveIdempotencyKey", mock.Anything, mock.Anything, mock.Anything).Return(db_queries.NeosyncApiIdempotencyKey{ID: keyUuid}, nil)
+	querierMock.On("RemoveIdempotencyKey", mock.Anything, mock.Anything, keyUuid).Return(nil)
+
+	_, err := Do(context.Background(), db, keyHeader("abc"), mustUuid(t, mockAccountId), "CreateJobRun", &mgmtv1alpha1.CreateJobRunRequest{},
+		func() (*connect.Response[mgmtv1alpha1.CreateJobRunResponse], error) {
+			return nil, errors.New("temporal is down")
+		},
+	)
+	require.Error(t, err)
+	querierMock.AssertNotCalled(t, "SetIdempotencyKeyResponse", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Do_Replay(t *testing.T) {
+	querierMock := db_queries.NewMockQuerier(t)
+	db := nucleusdb.New(nucleusdb.NewMockDBTX(t), querierMock)
+
+	request := &mgmtv1alpha1.CreateConnectionRequest{AccountId: mockAccountId, Name: "prod"}
+	requestHash, err := hashRequest(request)
+	require.NoError(t, err)
+	stored, err := proto.Marshal(&mgmtv1alpha1.CreateConnectionResponse{Connection: &mgmtv1alpha1.Connection{Id: "conn-1", Name: "prod"}})
+	require.NoError(t, err)
+
+	querierMock.On("ReserveIdempotencyKey", mock.Anything, mock.Anything, mock.Anything).Return(db_queries.NeosyncApiIdempotencyKey{}, pgx.ErrNoRows)
+	querierMock.On("GetIdempotencyKey", mock.Anything, mock.Anything, mock.Anything).Return(db_queries.NeosyncApiIdempotencyKey{
+		RequestHash: requestHash,
+		Response:    stored,
+	}, nil)
+
+	resp, err := Do(context.Background(), db, keyHeader("abc"), mustUuid(t, mockAccountId), "CreateConnection", request,
+		func() (*connect.Response[mgmtv1alpha1.CreateConnectionResponse], error) {
+			t.Fatal("request must not be run again")
+			return nil, nil
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "conn-1", resp.Msg.GetConnection().GetId())
+	require.Equal(t, "true", resp.Header().Get(ReplayedHeader))
+}
+
+func Test_Do_Replay_DifferentRequest(t *testing.T) {
+	querierMock := db_queries.NewMockQuerier(t)
+	db := nucleusdb.New(nucleusdb.NewMockDBTX(t), querierMock)
+
+	querierMock.On("ReserveIdempotencyKey", mock.Anything, mock.Anything, mock.Anything).Return(db_queries.NeosyncApiIdempotencyKey{}, pgx.ErrNoRows)
+	querierMock.On("GetIdempotencyKey", mock.Anything, mock.Anything, mock.Anything).Return(db_queries.NeosyncApiIdempotencyKey{
+		RequestHash: []byte("other"),
+		Response:    []byte{},
+	}, nil)
+
+	calls := 0
+	_, err := Do(context.Background(), db, keyHeader("abc"), mustUuid(t, mockAccountId), "CreateJobRun", &mgmtv1alpha1.CreateJobRunRequest{JobId: "123"}, countingFn(&calls))
+	require.Error(t, err)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	require.Equal(t, 0, calls)
+}
+
+func Test_Do_Replay_InProgress(t *testing.T) {
+	querierMock := db_queries.NewMockQuerier(t)
+	db := nucleusdb.New(nucleusdb.NewMockDBTX(t), querierMock)
+
+	request := &mgmtv1alpha1.CreateJobRunRequest{JobId: "123"}
+	requestHash, err := hashRequest(request)
+	require.NoError(t, err)
+
+	querierMock.On("ReserveIdempotencyKey", mock.Anything, mock.Anything, mock.Anything).Return(db_queries.NeosyncApiIdempotencyKey{}, pgx.ErrNoRows)
+	querierMock.On("GetIdempotencyKey", mock.Anything, mock.Anything, mock.Anything).Return(db_queries.NeosyncApiIdempotencyKey{
+		RequestHash: requestHash,
+	}, nil)
+
+	calls := 0
+	_, err = Do(context.Background(), db, keyHeader("abc"), mustUuid(t, mockAccountId), "CreateJobRun", request, countingFn(&calls))
+	require.Error(t, err)
+	require.Equal(t, connect.CodeAborted, connect.CodeOf(err))
+	require.Equal(t, 0, calls)
+}
+
+func countingFn(calls *int) func() (*connect.Response[mgmtv1alpha1.CreateJobRunResponse], error) {
+	return func() (*connect.Response[mgmtv1alpha1.CreateJobRunResponse], error) {
+		*calls++
+		return connect.NewResponse(&mgmtv1alpha1.CreateJobRunResponse{}), nil
+	}
+}
+
+func keyHeader(key string) http.Header {
+	header := http.Header{}
+	header.Set(KeyHeader, key)
+	return header
+}
+
+func mustUuid(t testing.TB, value string) pgtype.UUID {
+	t.Helper()
+	id, err := nucleusdb.ToUuid(value)
+	require.NoError(t, err)
+	return id
+}
@@ -0,0 +1,271 @@
+package kafkamanager
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linkedin/goavro/v2"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+	"github.com/twmb/franz-go/pkg/sasl/scram"
+)
+
+const (
+	// headers that jobs set on every record with the schema and table that the record was read from
+	SchemaHeader = "neosync_schema"
+	TableHeader  = "neosync_table"
+
+	// the topic is considered to be read to its end once a poll returns nothing for this long
+	tailIdleTimeout = 5 * time.Second
+	// the first byte of a record value that is in the confluent wire format
+	confluentMagicByte = 0
+)
+
+type NeosyncKafkaManager struct {
+}
+
+type NeosyncKafkaManagerClient interface {
+	GetTopicReader(ctx context.Context, config *mgmtv1alpha1.KafkaConnectionConfig) (TopicReader, error)
+}
+
+// Reads the records of the topic of a Kafka connection
+type TopicReader interface {
+	// Reads the last count records of every partition of the topic until the end of the topic is reached.
+	// Record values are decoded with the serialization format of the connection
+	Tail(ctx context.Context, count int64, fn func(record *Record) error) error
+}
+
+type Record struct {
+	Partition int32
+	Offset    int64
+	Headers   map[string]string
+	Value     map[string]any
+}
+
+func New() *NeosyncKafkaManager {
+	return &NeosyncKafkaManager{}
+}
+
+func (n *NeosyncKafkaManager) GetTopicReader(ctx context.Context, config *mgmtv1alpha1.KafkaConnectionConfig) (TopicReader, error) {
+	opts, err := getClientOptions(config)
+	if err != nil {
+		return nil, err
+	}
+
+	var decode valueDecoder = decodeJsonValue
+	if config.GetSerializationFormat() == mgmtv1alpha1.KafkaSerializationFormat_KAFKA_SERIALIZATION_FORMAT_AVRO {
+		if config.GetSchemaRegistry() == nil {
+			return nil, errors.New("a schema registry must be configured to read avro records")
+		}
+		decode = newSchemaRegistryClient(config.GetSchemaRegistry()).decodeAvroValue
+	}
+	return &topicReader{topic: config.GetTopic(), opts: opts, decode: decode}, nil
+}
+
+// Returns the franz-go options that connect to the brokers of the connection
+func getClientOptions(config *mgmtv1alpha1.KafkaConnectionConfig) ([]kgo.Opt, error) {
+	opts := []kgo.Opt{kgo.SeedBrokers(config.GetBrokers()...)}
+
+	if sasl := config.GetSasl(); sasl != nil {
+		switch sasl.GetMechanism() {
+		case mgmtv1alpha1.KafkaSaslMechanism_KAFKA_SASL_MECHANISM_PLAIN:
+			opts = append(opts, kgo.SASL(plain.Auth{User: sasl.GetUsername(), Pass: sasl.GetPassword()}.AsMechanism()))
+		case mgmtv1alpha1.KafkaSaslMechanism_KAFKA_SASL_MECHANISM_SCRAM_SHA_256:
+			opts = append(opts, kgo.SASL(scram.Auth{User: sasl.GetUsername(), Pass: sasl.GetPassword()}.AsSha256Mechanism()))
+		case mgmtv1alpha1.KafkaSaslMechanism_KAFKA_SASL_MECHANISM_SCRAM_SHA_512:
+			opts = append(opts, kgo.SASL(scram.Auth{User: sasl.GetUsername(), Pass: sasl.GetPassword()}.AsSha512Mechanism()))
+		default:
+			return nil, fmt.Errorf("unsupported kafka sasl mechanism: %s", sasl.GetMechanism())
+		}
+	}
+
+	if config.GetTls() != nil {
+		tlsConfig, err := getTlsConfig(config.GetTls())
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, kgo.DialTLSConfig(tlsConfig))
+	}
+	return opts, nil
+}
+
+func getTlsConfig(config *mgmtv1alpha1.ClientTlsConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if config.GetRootCert() != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(config.GetRootCert())) {
+			return nil, errors.New("unable to parse kafka root cert")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if config.GetClientCert() != "" && config.GetClientKey() != "" {
+		cert, err := tls.X509KeyPair([]byte(config.GetClientCert()), []byte(config.GetClientKey()))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse kafka client cert: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+type valueDecoder func(ctx context.Context, value []byte) (map[string]any, error)
+
+type topicReader struct {
+	topic  string
+	opts   []kgo.Opt
+	decode valueDecoder
+}
+
+func (t *topicReader) Tail(ctx context.Context, count int64, fn func(record *Record) error) error {
+	client, err := kgo.NewClient(append(t.opts,
+		kgo.ConsumeTopics(t.topic),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtEnd().Relative(-count)),
+	)...)
+	if err != nil {
+		return fmt.Errorf("unable to create kafka client: %w", err)
+	}
+	defer client.Close()
+
+	for {
+		pollCtx, cancel := context.WithTimeout(ctx, tailIdleTimeout)
+		fetches := client.PollFetches(pollCtx)
+		cancel()
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		var fetchErrs []error
+		fetches.EachError(func(topic string, partition int32, err error) {
+			// the poll timing out means that the end of the topic has been reached
+			if !errors.Is(err, context.DeadlineExceeded) {
+				fetchErrs = append(fetchErrs, fmt.Errorf("unable to fetch from %s partition %d: %w", topic, partition, err))
+			}
+		})
+		if len(fetchErrs) > 0 {
+			return errors.Join(fetchErrs...)
+		}
+		if fetches.NumRecords() == 0 {
+			return nil
+		}
+
+		iter := fetches.RecordIter()
+		for !iter.Done() {
+			record := iter.Next()
+			value, err := t.decode(ctx, record.Value)
+			if err != nil {
+				return fmt.Errorf("unable to decode record at partition %d offset %d: %w", record.Partition, record.Offset, err)
+			}
+			headers := make(map[string]string, len(record.Headers))
+			for _, header := range record.Headers {
+				headers[header.Key] = string(header.Value)
+			}
+			if err := fn(&Record{Partition: record.Partition, Offset: record.Offset, Headers: headers, Value: value}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func decodeJsonValue(ctx context.Context, value []byte) (map[string]any, error) {
+	var output map[string]any
+	if err := json.Unmarshal(value, &output); err != nil {
+		return nil, fmt.Errorf("record value is not a JSON object: %w", err)
+	}
+	return output, nil
+}
+
+// A minimal client of a Confluent compatible schema registry that caches the codecs of the schemas it has looked up
+type schemaRegistryClient struct {
+	url      string
+	username string
+	password string
+
+	httpClient *http.Client
+
+	mu     sync.Mutex
+	codecs map[uint32]*goavro.Codec
+}
+
+func newSchemaRegistryClient(config *mgmtv1alpha1.KafkaSchemaRegistryConfig) *schemaRegistryClient {
+	return &schemaRegistryClient{
+		url:        strings.TrimSuffix(config.GetUrl(), "/"),
+		username:   config.GetUsername(),
+		password:   config.GetPassword(),
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		codecs:     map[uint32]*goavro.Codec{},
+	}
+}
+
+// Decodes a value in the confluent wire format: a zero byte, the 4 byte schema id and the avro encoded value
+func (s *schemaRegistryClient) decodeAvroValue(ctx context.Context, value []byte) (map[string]any, error) {
+	if len(value) < 5 || value[0] != confluentMagicByte {
+		return nil, errors.New("record value is not in the confluent wire format")
+	}
+	codec, err := s.getCodec(ctx, binary.BigEndian.Uint32(value[1:5]))
+	if err != nil {
+		return nil, err
+	}
+	native, _, err := codec.NativeFromBinary(value[5:])
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode avro value: %w", err)
+	}
+	textual, err := codec.TextualFromNative(nil, native)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert avro value to JSON: %w", err)
+	}
+	return decodeJsonValue(ctx, textual)
+}
+
+func (s *schemaRegistryClient) getCodec(ctx context.Context, id uint32) (*goavro.Codec, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if codec, ok := s.codecs[id]; ok {
+		return codec, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/schemas/ids/%d", s.url, id), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.schemaregistry.v1+json")
+	if s.username != "" {
+		req.SetBasicAuth(s.username, s.password)
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get schema %d from the schema registry: %w", id, err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to get schema %d from the schema registry: %s: %s", id, resp.Status, string(body))
+	}
+
+	var schemaResp struct {
+		Schema string `json:"schema"`
+	}
+	if err := json.Unmarshal(body, &schemaResp); err != nil {
+		return nil, fmt.Errorf("unable to parse schema registry response: %w", err)
+	}
+	// unions are decoded as their plain value instead of being wrapped in an object keyed by their type
+	codec, err := goavro.NewCodecForStandardJSONFull(schemaResp.Schema)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse avro schema %d: %w", id, err)
+	}
+	s.codecs[id] = codec
+	return codec, nil
+}
@@ -0,0 +1,92 @@
+package nucleusdb
+
+import (
+	"context"
+	"slices"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+)
+
+const (
+	LabelResourceTypeConnection  = "connection"
+	LabelResourceTypeJob         = "job"
+	LabelResourceTypeTransformer = "transformer"
+)
+
+// Replaces all of the labels on the resource with the provided labels
+func (d *NucleusDb) SetResourceLabels(
+	ctx context.Context,
+	accountId pgtype.UUID,
+	resourceType string,
+	resourceId pgtype.UUID,
+	labels map[string]string,
+) error {
+	return d.WithTx(ctx, nil, func(dbtx BaseDBTX) error {
+		err := d.Q.RemoveResourceLabels(ctx, dbtx, db_queries.RemoveResourceLabelsParams{
+			ResourceType: resourceType,
+			ResourceID:   resourceId,
+		})
+		if err != nil {
+			return err
+		}
+		if len(labels) == 0 {
+			return nil
+		}
+		keys := make([]string, 0, len(labels))
+		for key := range labels {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		values := make([]string, 0, len(keys))
+		for _, key := range keys {
+			values = append(values, labels[key])
+		}
+		return d.Q.CreateResourceLabels(ctx, dbtx, db_queries.CreateResourceLabelsParams{
+			AccountId:    accountId,
+			ResourceType: resourceType,
+			ResourceId:   resourceId,
+			Keys:         keys,
+			Values:       values,
+		})
+	})
+}
+
+// Returns the labels for each of the given resources, keyed by the resource id.
+// Resources without labels are not present in the returned map
+func (d *NucleusDb) GetResourceLabelsMap(
+	ctx context.Context,
+	resourceType string,
+	resourceIds []pgtype.UUID,
+) (map[string]map[string]string, error) {
+	output := map[string]map[string]string{}
+	if len(resourceIds) == 0 {
+		return output, nil
+	}
+	labels, err := d.Q.GetResourceLabels(ctx, d.Db, db_queries.GetResourceLabelsParams{
+		ResourceType: resourceType,
+		ResourceIds:  resourceIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for idx := range labels {
+		label := &labels[idx]
+		resourceId := UUIDString(label.ResourceID)
+		if _, ok := output[resourceId]; !ok {
+			output[resourceId] = map[string]string{}
+		}
+		output[resourceId][label.Key] = label.Value
+	}
+	return output, nil
+}
+
+// Returns true if the labels contain every key/value pair of the selector
+func MatchesLabelSelector(labels, selector map[string]string) bool {
+	for key, value := range selector {
+		if labelValue, ok := labels[key]; !ok || labelValue != value {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,76 @@
+package nucleusdb
+
+import (
+	"context"
+	"testing"
+
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	"github.com/stretchr/testify/mock"
+	"github.com/zeebo/assert"
+)
+
+// SetResourceLabels
+func Test_SetResourceLabels(t *testing.T) {
+	dbtxMock := NewMockDBTX(t)
+	querierMock := db_queries.NewMockQuerier(t)
+	mockTx := new(MockTx)
+
+	jobUuid, _ := ToUuid(mockJobId)
+	accountUuid, _ := ToUuid(mockAccountId)
+	ctx := context.Background()
+
+	service := New(dbtxMock, querierMock)
+
+	dbtxMock.On("Begin", ctx).Return(mockTx, nil)
+	querierMock.On("RemoveResourceLabels", ctx, mockTx, db_queries.RemoveResourceLabelsParams{
+		ResourceType: LabelResourceTypeJob,
+		ResourceID:   jobUuid,
+	}).Return(nil)
+	querierMock.On("CreateResourceLabels", ctx, mockTx, db_queries.CreateResourceLabelsParams{
+		AccountId:    accountUuid,
+		ResourceType: LabelResourceTypeJob,
+		ResourceId:   jobUuid,
+		Keys:         []string{"env", "team"},
+		Values:       []string{"prod", "payments"},
+	}).Return(nil)
+	mockTx.On("Commit", ctx).Return(nil)
+	mockTx.On("Rollback", ctx).Return(nil)
+
+	err := service.SetResourceLabels(ctx, accountUuid, LabelResourceTypeJob, jobUuid, map[string]string{"team": "payments", "env": "prod"})
+
+	assert.NoError(t, err)
+	mockTx.AssertCalled(t, "Commit", ctx)
+}
+
+func Test_SetResourceLabels_Empty(t *testing.T) {
+	dbtxMock := NewMockDBTX(t)
+	querierMock := db_queries.NewMockQuerier(t)
+	mockTx := new(MockTx)
+
+	jobUuid, _ := ToUuid(mockJobId)
+	accountUuid, _ := ToUuid(mockAccountId)
+	ctx := context.Background()
+
+	service := New(dbtxMock, querierMock)
+
+	dbtxMock.On("Begin", ctx).Return(mockTx, nil)
+	querierMock.On("RemoveResourceLabels", ctx, mockTx, mock.Anything).Return(nil)
+	mockTx.On("Commit", ctx).Return(nil)
+	mockTx.On("Rollback", ctx).Return(nil)
+
+	err := service.SetResourceLabels(ctx, accountUuid, LabelResourceTypeJob, jobUuid, nil)
+
+	assert.NoError(t, err)
+	querierMock.AssertNotCalled(t, "CreateResourceLabels", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// MatchesLabelSelector
+func Test_MatchesLabelSelector(t *testing.T) {
+	labels := map[string]string{"team": "payments", "env": "prod"}
+	assert.True(t, MatchesLabelSelector(labels, nil))
+	assert.True(t, MatchesLabelSelector(labels, map[string]string{"team": "payments"}))
+	assert.True(t, MatchesLabelSelector(labels, map[string]string{"team": "payments", "env": "prod"}))
+	assert.False(t, MatchesLabelSelector(labels, map[string]string{"team": "growth"}))
+	assert.False(t, MatchesLabelSelector(labels, map[string]string{"region": "us"}))
+	assert.False(t, MatchesLabelSelector(nil, map[string]string{"team": "payments"}))
+}
@@ -0,0 +1,101 @@
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// Stores blobs, such as job run artifacts, that are too large to keep in the database
+type Interface interface {
+	PutObject(ctx context.Context, key string, contentType string, content []byte) error
+	GetObject(ctx context.Context, key string) ([]byte, error)
+	DeleteObject(ctx context.Context, key string) error
+}
+
+type S3Config struct {
+	Bucket string
+	// Optionally prepended to every key
+	Prefix string
+	Region string
+	// Optionally provide a custom endpoint for S3 compatible stores like minio
+	Endpoint string
+}
+
+// Object store that is backed by an S3 bucket. Credentials are resolved with the default AWS credential chain
+type S3Store struct {
+	client *s3.Client
+	bucket string
+	prefix string
+}
+
+func NewS3Store(ctx context.Context, cfg *S3Config) (*S3Store, error) {
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load aws config: %w", err)
+	}
+	if cfg.Region != "" {
+		awsCfg.Region = cfg.Region
+	}
+	client := s3.NewFromConfig(awsCfg, func(o *s3.Options) {
+		if cfg.Endpoint != "" {
+			o.BaseEndpoint = aws.String(cfg.Endpoint)
+			o.UsePathStyle = true
+		}
+	})
+	return &S3Store{client: client, bucket: cfg.Bucket, prefix: cfg.Prefix}, nil
+}
+
+func (s *S3Store) PutObject(ctx context.Context, key, contentType string, content []byte) error {
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(s.bucket),
+		Key:           aws.String(s.getKey(key)),
+		Body:          bytes.NewReader(content),
+		ContentType:   aws.String(contentType),
+		ContentLength: aws.Int64(int64(len(content))),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to put object %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *S3Store) GetObject(ctx context.Context, key string) ([]byte, error) {
+	output, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.getKey(key)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get object %s: %w", key, err)
+	}
+	defer output.Body.Close()
+	content, err := io.ReadAll(output.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read object %s: %w", key, err)
+	}
+	return content, nil
+}
+
+func (s *S3Store) DeleteObject(ctx context.Context, key string) error {
+	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.getKey(key)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to delete object %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *S3Store) getKey(key string) string {
+	if s.prefix == "" {
+		return key
+	}
+	return path.Join(s.prefix, key)
+}
@@ -0,0 +1,181 @@
+package ratelimit
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"golang.org/x/time/rate"
+)
+
+// Limits for a single scope (account or connection). Zero values disable that particular limit.
+type Limits struct {
+	// The sustained number of requests allowed per second
+	Qps float64
+	// The number of requests that may be made at once above the sustained rate. Defaults to the ceiling of Qps
+	Burst int
+	// The number of requests that may be in flight at the same time
+	Concurrency int
+}
+
+type Config struct {
+	Account    Limits
+	Connection Limits
+}
+
+// Enforces per-account and per-connection QPS and concurrency limits
+type Limiter struct {
+	account    *scopedLimiter
+	connection *scopedLimiter
+}
+
+func New(cfg *Config) *Limiter {
+	return &Limiter{
+		account:    newScopedLimiter("account", cfg.Account),
+		connection: newScopedLimiter("connection", cfg.Connection),
+	}
+}
+
+// Reserves a slot for a request against the account and connection.
+// The returned release func must be called once the request has finished.
+// Returns a resource exhausted error with retry-after metadata if either limit has been reached.
+func (l *Limiter) Acquire(accountId, connectionId string) (release func(), err error) {
+	accountPermit, err := l.account.acquire(accountId)
+	if err != nil {
+		return nil, err
+	}
+	connectionPermit, err := l.connection.acquire(connectionId)
+	if err != nil {
+		// the request never ran, so it must not count against the account's qps either
+		accountPermit.cancel()
+		return nil, err
+	}
+	return func() {
+		connectionPermit.release()
+		accountPermit.release()
+	}, nil
+}
+
+type scopedLimiter struct {
+	name   string
+	limits Limits
+
+	mu        sync.Mutex
+	entries   map[string]*entry
+	lastPrune time.Time
+}
+
+type entry struct {
+	rate     *rate.Limiter
+	inflight int
+	lastUsed time.Time
+}
+
+const (
+	// entries that have not been used in this long are dropped so the maps do not grow unbounded
+	idleEntryTtl = 10 * time.Minute
+	// the retry-after that is returned when the concurrency limit is reached, as there is no way to know when a slot will free up
+	concurrencyRetryAfter = time.Second
+)
+
+func newScopedLimiter(name string, limits Limits) *scopedLimiter {
+	if limits.Qps > 0 && limits.Burst <= 0 {
+		limits.Burst = max(int(limits.Qps+0.999999), 1)
+	}
+	return &scopedLimiter{
+		name:      name,
+		limits:    limits,
+		entries:   map[string]*entry{},
+		lastPrune: time.Now(),
+	}
+}
+
+func (s *scopedLimiter) isEnabled() bool {
+	return s.limits.Qps > 0 || s.limits.Concurrency > 0
+}
+
+// A slot handed out by a scoped limiter
+type permit struct {
+	release func()
+
+	reservation *rate.Reservation
+	reservedAt  time.Time
+}
+
+// Gives back the concurrency slot and the rate token of a request that was rejected before it ran
+func (p *permit) cancel() {
+	if p.reservation != nil {
+		// the reservation must be cancelled at the time it was made, otherwise no tokens are restored
+		p.reservation.CancelAt(p.reservedAt)
+	}
+	p.release()
+}
+
+func (s *scopedLimiter) acquire(key string) (*permit, error) {
+	if !s.isEnabled() || key == "" {
+		return &permit{release: func() {}}, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.prune(now)
+
+	e, ok := s.entries[key]
+	if !ok {
+		e = &entry{}
+		if s.limits.Qps > 0 {
+			e.rate = rate.NewLimiter(rate.Limit(s.limits.Qps), s.limits.Burst)
+		}
+		s.entries[key] = e
+	}
+	e.lastUsed = now
+
+	if s.limits.Concurrency > 0 && e.inflight >= s.limits.Concurrency {
+		return nil, nucleuserrors.WithErrorCode(nucleuserrors.NewResourceExhausted(
+			fmt.Sprintf("too many concurrent requests for this %s, the limit is %d", s.name, s.limits.Concurrency),
+			concurrencyRetryAfter,
+		), mgmtv1alpha1.ErrorCode_ERROR_CODE_RATE_LIMITED)
+	}
+	var reservation *rate.Reservation
+	if e.rate != nil {
+		reservation = e.rate.ReserveN(now, 1)
+		if delay := reservation.DelayFrom(now); delay > 0 {
+			// the request is rejected so the token must be given back
+			reservation.CancelAt(now)
+			return nil, nucleuserrors.WithErrorCode(nucleuserrors.NewResourceExhausted(
+				fmt.Sprintf("rate limit exceeded for this %s, the limit is %g requests per second", s.name, s.limits.Qps),
+				delay,
+			), mgmtv1alpha1.ErrorCode_ERROR_CODE_RATE_LIMITED)
+		}
+	}
+
+	e.inflight++
+	var once sync.Once
+	return &permit{
+		release: func() {
+			once.Do(func() {
+				s.mu.Lock()
+				defer s.mu.Unlock()
+				e.inflight--
+				e.lastUsed = time.Now()
+			})
+		},
+		reservation: reservation,
+		reservedAt:  now,
+	}, nil
+}
+
+func (s *scopedLimiter) prune(now time.Time) {
+	if now.Sub(s.lastPrune) < idleEntryTtl {
+		return
+	}
+	for key, e := range s.entries {
+		if e.inflight == 0 && now.Sub(e.lastUsed) > idleEntryTtl {
+			delete(s.entries, key)
+		}
+	}
+	s.lastPrune = now
+}
@@ -0,0 +1,118 @@
+package ratelimit
+
+import (
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Limiter_Disabled(t *testing.T) {
+	limiter := New(&Config{})
+	for i := 0; i < 100; i++ {
+		release, err := limiter.Acquire("account", "connection")
+		require.NoError(t, err)
+		defer release()
+	}
+}
+
+func Test_Limiter_Concurrency(t *testing.T) {
+	limiter := New(&Config{Connection: Limits{Concurrency: 2}})
+
+	release1, err := limiter.Acquire("account", "conn-1")
+	require.NoError(t, err)
+	release2, err := limiter.Acquire("account", "conn-1")
+	require.NoError(t, err)
+
+	_, err = limiter.Acquire("account", "conn-1")
+	requireResourceExhausted(t, err, "1")
+
+	// other connections are not affected
+	release3, err := limiter.Acquire("account", "conn-2")
+	require.NoError(t, err)
+	release3()
+
+	release1()
+	// releasing more than once must not free up extra slots
+	release1()
+	release4, err := limiter.Acquire("account", "conn-1")
+	require.NoError(t, err)
+	_, err = limiter.Acquire("account", "conn-1")
+	require.Error(t, err)
+
+	release2()
+	release4()
+}
+
+func Test_Limiter_Qps(t *testing.T) {
+	limiter := New(&Config{Account: Limits{Qps: 0.5, Burst: 2}})
+
+	for i := 0; i < 2; i++ {
+		release, err := limiter.Acquire("account-1", "connection")
+		require.NoError(t, err)
+		release()
+	}
+	_, err := limiter.Acquire("account-1", "connection")
+	requireResourceExhausted(t, err, "2")
+
+	// a rejected request must not consume a token
+	_, err = limiter.Acquire("account-1", "connection")
+	requireResourceExhausted(t, err, "2")
+
+	release, err := limiter.Acquire("account-2", "connection")
+	require.NoError(t, err)
+	release()
+}
+
+func Test_Limiter_ConnectionRejectionReleasesAccount(t *testing.T) {
+	limiter := New(&Config{
+		Account:    Limits{Concurrency: 1},
+		Connection: Limits{Concurrency: 1},
+	})
+	release, err := limiter.Acquire("account-1", "conn-1")
+	require.NoError(t, err)
+
+	_, err = limiter.Acquire("account-2", "conn-1")
+	require.Error(t, err)
+
+	release()
+	// account-2 must not have leaked a slot from the rejected request
+	release, err = limiter.Acquire("account-2", "conn-2")
+	require.NoError(t, err)
+	release()
+}
+
+func Test_Limiter_ConnectionRejectionKeepsAccountQps(t *testing.T) {
+	limiter := New(&Config{
+		Account:    Limits{Qps: 0.5, Burst: 2},
+		Connection: Limits{Concurrency: 1},
+	})
+	release, err := limiter.Acquire("account-1", "conn-1")
+	require.NoError(t, err)
+
+	// rejected by the connection limit, these must not take tokens from account-2
+	for i := 0; i < 5; i++ {
+		_, err = limiter.Acquire("account-2", "conn-1")
+		require.Error(t, err)
+	}
+	release()
+
+	for i := 0; i < 2; i++ {
+		release, err = limiter.Acquire("account-2", "conn-2")
+		require.NoError(t, err)
+		release()
+	}
+	_, err = limiter.Acquire("account-2", "conn-2")
+	requireResourceExhausted(t, err, "2")
+}
+
+func requireResourceExhausted(t testing.TB, err error, retryAfter string) {
+	t.Helper()
+	require.Error(t, err)
+	var connectErr *connect.Error
+	require.True(t, errors.As(err, &connectErr))
+	require.Equal(t, connect.CodeResourceExhausted, connectErr.Code())
+	require.Equal(t, retryAfter, connectErr.Meta().Get(nucleuserrors.RetryAfterHeader))
+}
@@ -0,0 +1,198 @@
+package schemahints
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+type dbtManifest struct {
+	Nodes   map[string]*dbtNode `json:"nodes"`
+	Sources map[string]*dbtNode `json:"sources"`
+}
+
+type dbtNode struct {
+	ResourceType string                `json:"resource_type"`
+	Schema       string                `json:"schema"`
+	Name         string                `json:"name"`
+	Alias        string                `json:"alias"`
+	Identifier   string                `json:"identifier"`
+	SourceName   string                `json:"source_name"`
+	Columns      map[string]*dbtColumn `json:"columns"`
+
+	// only set for tests
+	ColumnName   string           `json:"column_name"`
+	AttachedNode string           `json:"attached_node"`
+	TestMetadata *dbtTestMetadata `json:"test_metadata"`
+}
+
+type dbtColumn struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Tags        []string       `json:"tags"`
+	Meta        map[string]any `json:"meta"`
+}
+
+type dbtTestMetadata struct {
+	Name      string         `json:"name"`
+	Namespace string         `json:"namespace"`
+	Kwargs    map[string]any `json:"kwargs"`
+}
+
+var (
+	dbtRefRegex    = regexp.MustCompile(`ref\(\s*['"]([^'"]+)['"]\s*(?:,\s*['"]([^'"]+)['"]\s*)?\)`)
+	dbtSourceRegex = regexp.MustCompile(`source\(\s*['"]([^'"]+)['"]\s*,\s*['"]([^'"]+)['"]\s*\)`)
+)
+
+// Parses the manifest.json artifact of a dbt project.
+// Column descriptions and tags are read from models, seeds, snapshots and sources,
+// and the not_null, unique, accepted_values and relationships generic tests are attached to their columns.
+func ParseDbtManifest(content []byte, defaultSchema string) (*Hints, error) {
+	var manifest dbtManifest
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return nil, fmt.Errorf("unable to parse dbt manifest: %w", err)
+	}
+
+	relations := map[string]*dbtNode{}
+	for id, node := range manifest.Nodes {
+		switch node.ResourceType {
+		case "model", "seed", "snapshot":
+			relations[id] = node
+		}
+	}
+	for id, node := range manifest.Sources {
+		relations[id] = node
+	}
+
+	builder := newHintsBuilder()
+	for _, id := range sortedKeys(relations) {
+		node := relations[id]
+		schema, table := node.relation(defaultSchema)
+		for _, name := range sortedKeys(node.Columns) {
+			column := node.Columns[name]
+			if column.Name != "" {
+				name = column.Name
+			}
+			hint := builder.column(schema, table, name)
+			if column.Description != "" {
+				description := column.Description
+				hint.Description = &description
+			}
+			tags := slices.Clone(column.Tags)
+			if containsPii, ok := column.Meta["contains_pii"].(bool); ok && containsPii {
+				tags = append(tags, "pii")
+			}
+			hint.Classifications = classificationsFromTags(tags)
+		}
+	}
+
+	for _, id := range sortedKeys(manifest.Nodes) {
+		test := manifest.Nodes[id]
+		if test.ResourceType != "test" || test.TestMetadata == nil || test.TestMetadata.Namespace != "" {
+			continue
+		}
+		columnName := test.ColumnName
+		if columnName == "" {
+			columnName, _ = test.TestMetadata.Kwargs["column_name"].(string)
+		}
+		if columnName == "" {
+			continue
+		}
+		parent := relations[test.AttachedNode]
+		if parent == nil {
+			model, _ := test.TestMetadata.Kwargs["model"].(string)
+			parent = findDbtRelation(relations, model)
+		}
+		if parent == nil {
+			continue
+		}
+		schema, table := parent.relation(defaultSchema)
+
+		switch test.TestMetadata.Name {
+		case NotNullTest, UniqueTest:
+			addTest(builder.column(schema, table, columnName), test.TestMetadata.Name)
+		case "accepted_values":
+			hint := builder.column(schema, table, columnName)
+			addTest(hint, test.TestMetadata.Name)
+			values, _ := test.TestMetadata.Kwargs["values"].([]any)
+			for _, value := range values {
+				accepted := fmt.Sprint(value)
+				if !slices.Contains(hint.AcceptedValues, accepted) {
+					hint.AcceptedValues = append(hint.AcceptedValues, accepted)
+				}
+			}
+		case "relationships":
+			addTest(builder.column(schema, table, columnName), test.TestMetadata.Name)
+			to, _ := test.TestMetadata.Kwargs["to"].(string)
+			field, _ := test.TestMetadata.Kwargs["field"].(string)
+			foreign := findDbtRelation(relations, to)
+			if foreign == nil || field == "" {
+				continue
+			}
+			foreignSchema, foreignTable := foreign.relation(defaultSchema)
+			builder.addForeignKey(&ForeignKey{
+				Schema:         schema,
+				Table:          table,
+				Columns:        []string{columnName},
+				ForeignSchema:  foreignSchema,
+				ForeignTable:   foreignTable,
+				ForeignColumns: []string{field},
+			})
+		}
+	}
+	return builder.build(), nil
+}
+
+// Returns the schema and table that the node is materialized as
+func (n *dbtNode) relation(defaultSchema string) (schema, table string) {
+	schema = n.Schema
+	if schema == "" {
+		schema = defaultSchema
+	}
+	switch {
+	case n.Identifier != "":
+		return schema, n.Identifier
+	case n.Alias != "":
+		return schema, n.Alias
+	default:
+		return schema, n.Name
+	}
+}
+
+// Finds the node that is referenced by a ref() or source() jinja call
+func findDbtRelation(relations map[string]*dbtNode, reference string) *dbtNode {
+	if matches := dbtSourceRegex.FindStringSubmatch(reference); matches != nil {
+		for _, id := range sortedKeys(relations) {
+			node := relations[id]
+			if node.ResourceType == "source" && node.SourceName == matches[1] && node.Name == matches[2] {
+				return node
+			}
+		}
+		return nil
+	}
+	if matches := dbtRefRegex.FindStringSubmatch(reference); matches != nil {
+		// the two argument form of ref is ref('package', 'model')
+		name := matches[1]
+		if matches[2] != "" {
+			name = matches[2]
+		}
+		for _, id := range sortedKeys(relations) {
+			node := relations[id]
+			if node.ResourceType != "source" && strings.EqualFold(node.Name, name) {
+				return node
+			}
+		}
+	}
+	return nil
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,97 @@
+package schemahints
+
+import (
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+const testDbtManifest = `{
+  "nodes": {
+    "model.shop.customers": {
+      "resource_type": "model",
+      "schema": "analytics",
+      "name": "customers",
+      "alias": "dim_customers",
+      "columns": {
+        "id": {"name": "id", "description": "The primary key"},
+        "email": {"name": "email", "description": "", "tags": ["PII"]},
+        "notes": {"name": "notes", "meta": {"contains_pii": true}}
+      }
+    },
+    "model.shop.orders": {
+      "resource_type": "model",
+      "schema": "analytics",
+      "name": "orders",
+      "columns": {
+        "status": {"name": "status"}
+      }
+    },
+    "test.shop.not_null_customers_id.1": {
+      "resource_type": "test",
+      "column_name": "id",
+      "attached_node": "model.shop.customers",
+      "test_metadata": {"name": "not_null", "kwargs": {"column_name": "id"}}
+    },
+    "test.shop.accepted_values_orders_status.2": {
+      "resource_type": "test",
+      "column_name": "status",
+      "test_metadata": {
+        "name": "accepted_values",
+        "kwargs": {"column_name": "status", "values": ["placed", "shipped"], "model": "{{ get_where_subquery(ref('orders')) }}"}
+      }
+    },
+    "test.shop.relationships_orders_customer_id.3": {
+      "resource_type": "test",
+      "column_name": "customer_id",
+      "attached_node": "model.shop.orders",
+      "test_metadata": {"name": "relationships", "kwargs": {"to": "ref('customers')", "field": "id"}}
+    },
+    "test.shop.relationships_orders_store_id.4": {
+      "resource_type": "test",
+      "column_name": "store_id",
+      "attached_node": "model.shop.orders",
+      "test_metadata": {"name": "relationships", "kwargs": {"to": "source('raw', 'stores')", "field": "id"}}
+    },
+    "test.shop.dbt_utils_expression.5": {
+      "resource_type": "test",
+      "column_name": "status",
+      "attached_node": "model.shop.orders",
+      "test_metadata": {"name": "expression_is_true", "namespace": "dbt_utils", "kwargs": {}}
+    }
+  },
+  "sources": {
+    "source.shop.raw.stores": {
+      "resource_type": "source",
+      "source_name": "raw",
+      "name": "stores",
+      "identifier": "store_locations",
+      "columns": {}
+    }
+  }
+}`
+
+func Test_ParseDbtManifest(t *testing.T) {
+	hints, err := ParseDbtManifest([]byte(testDbtManifest), "public")
+	require.NoError(t, err)
+
+	description := "The primary key"
+	require.Equal(t, []*ColumnHint{
+		{Schema: "analytics", Table: "dim_customers", Column: "email", Classifications: []mgmtv1alpha1.DataClassification{mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII}},
+		{Schema: "analytics", Table: "dim_customers", Column: "id", Description: &description, Tests: []string{NotNullTest}, Classifications: []mgmtv1alpha1.DataClassification{}},
+		{Schema: "analytics", Table: "dim_customers", Column: "notes", Classifications: []mgmtv1alpha1.DataClassification{mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII}},
+		{Schema: "analytics", Table: "orders", Column: "status", Tests: []string{"accepted_values"}, AcceptedValues: []string{"placed", "shipped"}, Classifications: []mgmtv1alpha1.DataClassification{}},
+		{Schema: "analytics", Table: "orders", Column: "customer_id", Tests: []string{"relationships"}},
+		{Schema: "analytics", Table: "orders", Column: "store_id", Tests: []string{"relationships"}},
+	}, hints.Columns)
+	require.Equal(t, []*ForeignKey{
+		{Schema: "analytics", Table: "orders", Columns: []string{"customer_id"}, ForeignSchema: "analytics", ForeignTable: "dim_customers", ForeignColumns: []string{"id"}},
+		{Schema: "analytics", Table: "orders", Columns: []string{"store_id"}, ForeignSchema: "public", ForeignTable: "store_locations", ForeignColumns: []string{"id"}},
+	}, hints.ForeignKeys)
+}
+
+func Test_ParseDbtManifest_Invalid(t *testing.T) {
+	_, err := ParseDbtManifest([]byte("{"), "public")
+	require.Error(t, err)
+}
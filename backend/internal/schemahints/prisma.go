@@ -0,0 +1,234 @@
+package schemahints
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	prismaBlockRegex     = regexp.MustCompile(`^(model|enum|view|type|datasource|generator)\s+(\w+)\s*\{\s*$`)
+	prismaFieldRegex     = regexp.MustCompile(`^(\w+)\s+(\w+)(\[\])?(\?)?(.*)$`)
+	prismaMapRegex       = regexp.MustCompile(`@map\(\s*(?:name:\s*)?"([^"]+)"\s*\)`)
+	prismaModelMapRegex  = regexp.MustCompile(`^@@map\(\s*(?:name:\s*)?"([^"]+)"\s*\)`)
+	prismaSchemaRegex    = regexp.MustCompile(`^@@schema\(\s*"([^"]+)"\s*\)`)
+	prismaRelationRegex  = regexp.MustCompile(`@relation\((.*)\)`)
+	prismaFieldsRegex    = regexp.MustCompile(`fields:\s*\[([^\]]*)\]`)
+	prismaReferenceRegex = regexp.MustCompile(`references:\s*\[([^\]]*)\]`)
+	prismaIdRegex        = regexp.MustCompile(`@(id|unique)\b`)
+
+	prismaScalarTypes = map[string]struct{}{
+		"String":      {},
+		"Boolean":     {},
+		"Int":         {},
+		"BigInt":      {},
+		"Float":       {},
+		"Decimal":     {},
+		"DateTime":    {},
+		"Json":        {},
+		"Bytes":       {},
+		"Unsupported": {},
+	}
+)
+
+type prismaModel struct {
+	name   string
+	table  string
+	schema string
+	fields []*prismaField
+}
+
+type prismaField struct {
+	name        string
+	column      string
+	fieldType   string
+	isList      bool
+	isOptional  bool
+	isUnique    bool
+	description string
+	attributes  string
+}
+
+type prismaEnum struct {
+	values []string
+}
+
+// Parses a Prisma schema file.
+// Doc comments become column descriptions, required and unique fields become not_null and unique tests,
+// enum fields list the values of their enum and @relation attributes become foreign keys
+func ParsePrismaSchema(content []byte, defaultSchema string) (*Hints, error) {
+	models := []*prismaModel{}
+	modelsByName := map[string]*prismaModel{}
+	enums := map[string]*prismaEnum{}
+
+	var (
+		currentModel *prismaModel
+		currentEnum  *prismaEnum
+		inBlock      bool
+		docComments  []string
+	)
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "///") {
+			docComments = append(docComments, strings.TrimSpace(strings.TrimPrefix(line, "///")))
+			continue
+		}
+		if idx := strings.Index(line, "//"); idx >= 0 && !strings.Contains(line[:idx], `"`) {
+			line = strings.TrimSpace(line[:idx])
+		}
+		if line == "" {
+			continue
+		}
+
+		if !inBlock {
+			matches := prismaBlockRegex.FindStringSubmatch(line)
+			if matches == nil {
+				return nil, fmt.Errorf("unable to parse prisma schema on line %d: %q", lineNumber, line)
+			}
+			inBlock = true
+			switch matches[1] {
+			case "model", "view":
+				currentModel = &prismaModel{name: matches[2], table: matches[2], schema: defaultSchema}
+				models = append(models, currentModel)
+				modelsByName[currentModel.name] = currentModel
+			case "enum":
+				currentEnum = &prismaEnum{}
+				enums[matches[2]] = currentEnum
+			}
+			docComments = nil
+			continue
+		}
+
+		if line == "}" {
+			inBlock = false
+			currentModel = nil
+			currentEnum = nil
+			docComments = nil
+			continue
+		}
+
+		switch {
+		case currentModel != nil:
+			if matches := prismaModelMapRegex.FindStringSubmatch(line); matches != nil {
+				currentModel.table = matches[1]
+			} else if matches := prismaSchemaRegex.FindStringSubmatch(line); matches != nil {
+				currentModel.schema = matches[1]
+			} else if matches := prismaFieldRegex.FindStringSubmatch(line); matches != nil && !strings.HasPrefix(line, "@@") {
+				field := &prismaField{
+					name:        matches[1],
+					column:      matches[1],
+					fieldType:   matches[2],
+					isList:      matches[3] != "",
+					isOptional:  matches[4] != "",
+					isUnique:    prismaIdRegex.MatchString(matches[5]),
+					description: strings.Join(docComments, " "),
+					attributes:  matches[5],
+				}
+				if mapMatches := prismaMapRegex.FindStringSubmatch(matches[5]); mapMatches != nil {
+					field.column = mapMatches[1]
+				}
+				currentModel.fields = append(currentModel.fields, field)
+			}
+		case currentEnum != nil:
+			if strings.HasPrefix(line, "@@") {
+				break
+			}
+			value := strings.Fields(line)[0]
+			if mapMatches := prismaMapRegex.FindStringSubmatch(line); mapMatches != nil {
+				value = mapMatches[1]
+			}
+			currentEnum.values = append(currentEnum.values, value)
+		}
+		docComments = nil
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read prisma schema: %w", err)
+	}
+
+	builder := newHintsBuilder()
+	for _, model := range models {
+		for _, field := range model.fields {
+			if related, ok := modelsByName[field.fieldType]; ok {
+				if fk := toPrismaForeignKey(model, field, related); fk != nil {
+					builder.addForeignKey(fk)
+				}
+				continue
+			}
+			enum, isEnum := enums[field.fieldType]
+			if _, isScalar := prismaScalarTypes[field.fieldType]; !isScalar && !isEnum {
+				// composite types and unknown types are not backed by a column
+				continue
+			}
+
+			hint := builder.column(model.schema, model.table, field.column)
+			if field.description != "" {
+				description := field.description
+				hint.Description = &description
+			}
+			if !field.isOptional && !field.isList {
+				addTest(hint, NotNullTest)
+			}
+			if field.isUnique {
+				addTest(hint, UniqueTest)
+			}
+			if isEnum && !field.isList {
+				hint.AcceptedValues = append(hint.AcceptedValues, enum.values...)
+			}
+		}
+	}
+	return builder.build(), nil
+}
+
+// Converts the @relation attribute of a relation field into a foreign key.
+// Returns nil for the side of the relation that does not hold the foreign key
+func toPrismaForeignKey(model *prismaModel, field *prismaField, related *prismaModel) *ForeignKey {
+	relation := prismaRelationRegex.FindStringSubmatch(field.attributes)
+	if relation == nil {
+		return nil
+	}
+	fields := prismaFieldsRegex.FindStringSubmatch(relation[1])
+	references := prismaReferenceRegex.FindStringSubmatch(relation[1])
+	if fields == nil || references == nil {
+		return nil
+	}
+	return &ForeignKey{
+		Schema:         model.schema,
+		Table:          model.table,
+		Columns:        model.columnNames(splitPrismaList(fields[1])),
+		ForeignSchema:  related.schema,
+		ForeignTable:   related.table,
+		ForeignColumns: related.columnNames(splitPrismaList(references[1])),
+	}
+}
+
+// Maps field names to the names of their underlying columns
+func (m *prismaModel) columnNames(fieldNames []string) []string {
+	columns := make([]string, 0, len(fieldNames))
+	for _, name := range fieldNames {
+		column := name
+		for _, field := range m.fields {
+			if field.name == name {
+				column = field.column
+				break
+			}
+		}
+		columns = append(columns, column)
+	}
+	return columns
+}
+
+func splitPrismaList(list string) []string {
+	output := []string{}
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			output = append(output, item)
+		}
+	}
+	return output
+}
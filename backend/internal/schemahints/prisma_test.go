@@ -0,0 +1,65 @@
+package schemahints
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testPrismaSchema = `
+datasource db {
+  provider = "postgresql" // the database
+  url      = env("DATABASE_URL")
+}
+
+/// A user of the app
+model User {
+  id    Int     @id @default(autoincrement())
+  /// The login email
+  email String  @unique
+  name  String? @map("full_name")
+  role  Role    @default(USER)
+  posts Post[]
+
+  @@map("users")
+  @@schema("auth")
+}
+
+model Post {
+  id       Int  @id
+  authorId Int  @map("author_id")
+  author   User @relation(fields: [authorId], references: [id], onDelete: Cascade)
+  tags     String[]
+}
+
+enum Role {
+  USER
+  ADMIN @map("admin")
+
+  @@schema("auth")
+}
+`
+
+func Test_ParsePrismaSchema(t *testing.T) {
+	hints, err := ParsePrismaSchema([]byte(testPrismaSchema), "public")
+	require.NoError(t, err)
+
+	description := "The login email"
+	require.Equal(t, []*ColumnHint{
+		{Schema: "auth", Table: "users", Column: "id", Tests: []string{NotNullTest, UniqueTest}},
+		{Schema: "auth", Table: "users", Column: "email", Description: &description, Tests: []string{NotNullTest, UniqueTest}},
+		{Schema: "auth", Table: "users", Column: "full_name"},
+		{Schema: "auth", Table: "users", Column: "role", Tests: []string{NotNullTest}, AcceptedValues: []string{"USER", "admin"}},
+		{Schema: "public", Table: "Post", Column: "id", Tests: []string{NotNullTest, UniqueTest}},
+		{Schema: "public", Table: "Post", Column: "author_id", Tests: []string{NotNullTest}},
+		{Schema: "public", Table: "Post", Column: "tags"},
+	}, hints.Columns)
+	require.Equal(t, []*ForeignKey{
+		{Schema: "public", Table: "Post", Columns: []string{"author_id"}, ForeignSchema: "auth", ForeignTable: "users", ForeignColumns: []string{"id"}},
+	}, hints.ForeignKeys)
+}
+
+func Test_ParsePrismaSchema_Invalid(t *testing.T) {
+	_, err := ParsePrismaSchema([]byte("model User\n  id Int\n"), "public")
+	require.Error(t, err)
+}
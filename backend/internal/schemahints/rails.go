@@ -0,0 +1,185 @@
+package schemahints
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	railsCreateTableRegex   = regexp.MustCompile(`^create_table\s+"([^"]+)"(.*?)\s+do\s+\|\w+\|$`)
+	railsColumnRegex        = regexp.MustCompile(`^\w+\.(\w+)\s+"([^"]+)"(.*)$`)
+	railsIndexRegex         = regexp.MustCompile(`^\w+\.index\s+\[([^\]]*)\](.*)$`)
+	railsCreateEnumRegex    = regexp.MustCompile(`^create_enum\s+"([^"]+)",\s*\[([^\]]*)\]`)
+	railsAddForeignKeyRegex = regexp.MustCompile(`^add_foreign_key\s+"([^"]+)",\s*"([^"]+)"(.*)$`)
+	railsStringRegex        = regexp.MustCompile(`"((?:[^"\\]|\\.)*)"`)
+	railsOptionRegex        = regexp.MustCompile(`(\w+):\s*"((?:[^"\\]|\\.)*)"`)
+	railsNotNullRegex       = regexp.MustCompile(`\bnull:\s*false\b`)
+	railsUniqueRegex        = regexp.MustCompile(`\bunique:\s*true\b`)
+	railsNoIdRegex          = regexp.MustCompile(`\bid:\s*false\b`)
+)
+
+// column methods of a table definition that do not declare a column
+var railsNonColumnMethods = map[string]struct{}{
+	"index":                {},
+	"check_constraint":     {},
+	"exclusion_constraint": {},
+	"unique_constraint":    {},
+}
+
+// Parses a Rails db/schema.rb file.
+// Column comments become descriptions, null: false becomes a not_null test, single column unique indexes become unique tests,
+// enum columns list the values of their enum type and add_foreign_key statements become foreign keys
+func ParseRailsSchema(content []byte, defaultSchema string) (*Hints, error) {
+	builder := newHintsBuilder()
+	enums := map[string][]string{}
+	type enumColumn struct {
+		hint     *ColumnHint
+		enumType string
+	}
+	enumColumns := []*enumColumn{}
+	primaryKeys := map[string]string{}
+
+	var (
+		schema, table string
+		inTable       bool
+	)
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if inTable {
+			if line == "end" {
+				inTable = false
+				continue
+			}
+			if matches := railsIndexRegex.FindStringSubmatch(line); matches != nil {
+				columns := railsStrings(matches[1])
+				if len(columns) == 1 && railsUniqueRegex.MatchString(matches[2]) {
+					addTest(builder.column(schema, table, columns[0]), UniqueTest)
+				}
+				continue
+			}
+			matches := railsColumnRegex.FindStringSubmatch(line)
+			if matches == nil {
+				continue
+			}
+			if _, ok := railsNonColumnMethods[matches[1]]; ok {
+				continue
+			}
+			hint := builder.column(schema, table, matches[2])
+			options := railsOptions(matches[3])
+			if comment, ok := options["comment"]; ok {
+				hint.Description = &comment
+			}
+			if railsNotNullRegex.MatchString(matches[3]) {
+				addTest(hint, NotNullTest)
+			}
+			if enumType, ok := options["enum_type"]; ok {
+				enumColumns = append(enumColumns, &enumColumn{hint: hint, enumType: enumType})
+			}
+			continue
+		}
+
+		if matches := railsCreateTableRegex.FindStringSubmatch(line); matches != nil {
+			inTable = true
+			schema, table = splitTableName(matches[1], defaultSchema)
+			if railsNoIdRegex.MatchString(matches[2]) {
+				continue
+			}
+			// tables have an implicit primary key column unless they opt out of it
+			primaryKey := "id"
+			if name, ok := railsOptions(matches[2])["primary_key"]; ok {
+				primaryKey = name
+			}
+			primaryKeys[schema+"."+table] = primaryKey
+			hint := builder.column(schema, table, primaryKey)
+			addTest(hint, NotNullTest)
+			addTest(hint, UniqueTest)
+			continue
+		}
+		if matches := railsCreateEnumRegex.FindStringSubmatch(line); matches != nil {
+			_, name := splitTableName(matches[1], defaultSchema)
+			enums[name] = railsStrings(matches[2])
+			continue
+		}
+		if matches := railsAddForeignKeyRegex.FindStringSubmatch(line); matches != nil {
+			fromSchema, fromTable := splitTableName(matches[1], defaultSchema)
+			toSchema, toTable := splitTableName(matches[2], defaultSchema)
+			options := railsOptions(matches[3])
+			column, ok := options["column"]
+			if !ok {
+				column = singularize(toTable) + "_id"
+			}
+			foreignColumn, ok := options["primary_key"]
+			if !ok {
+				foreignColumn = "id"
+				if primaryKey, ok := primaryKeys[toSchema+"."+toTable]; ok {
+					foreignColumn = primaryKey
+				}
+			}
+			builder.addForeignKey(&ForeignKey{
+				Schema:         fromSchema,
+				Table:          fromTable,
+				Columns:        []string{column},
+				ForeignSchema:  toSchema,
+				ForeignTable:   toTable,
+				ForeignColumns: []string{foreignColumn},
+			})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read rails schema: %w", err)
+	}
+	if inTable {
+		return nil, fmt.Errorf("unable to parse rails schema: create_table %q is missing its end", table)
+	}
+
+	for _, column := range enumColumns {
+		_, name := splitTableName(column.enumType, defaultSchema)
+		column.hint.AcceptedValues = append(column.hint.AcceptedValues, enums[name]...)
+	}
+	return builder.build(), nil
+}
+
+// Returns the string valued options of a ruby method call such as comment: "..."
+func railsOptions(options string) map[string]string {
+	output := map[string]string{}
+	for _, matches := range railsOptionRegex.FindAllStringSubmatch(options, -1) {
+		output[matches[1]] = unescapeRubyString(matches[2])
+	}
+	return output
+}
+
+// Returns the contents of each string literal in a ruby array
+func railsStrings(list string) []string {
+	output := []string{}
+	for _, matches := range railsStringRegex.FindAllStringSubmatch(list, -1) {
+		output = append(output, unescapeRubyString(matches[1]))
+	}
+	return output
+}
+
+func unescapeRubyString(value string) string {
+	return strings.NewReplacer(`\"`, `"`, `\\`, `\`, `\n`, "\n", `\t`, "\t").Replace(value)
+}
+
+// Mirrors the default foreign key column naming of Rails for regular english plurals
+func singularize(word string) string {
+	switch {
+	case strings.HasSuffix(word, "ies"):
+		return strings.TrimSuffix(word, "ies") + "y"
+	case strings.HasSuffix(word, "sses"), strings.HasSuffix(word, "shes"), strings.HasSuffix(word, "ches"), strings.HasSuffix(word, "xes"):
+		return strings.TrimSuffix(word, "es")
+	case strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss") && !strings.HasSuffix(word, "us"):
+		return strings.TrimSuffix(word, "s")
+	default:
+		return word
+	}
+}
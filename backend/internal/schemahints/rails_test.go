@@ -0,0 +1,76 @@
+package schemahints
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testRailsSchema = `
+# This file is auto-generated from the current state of the database.
+ActiveRecord::Schema[7.1].define(version: 2024_05_01_000000) do
+  enable_extension "plpgsql"
+
+  create_enum "order_status", ["placed", "shipped"]
+
+  create_table "companies", force: :cascade do |t|
+    t.string "name", null: false, comment: "The \"legal\" name"
+  end
+
+  create_table "orders", id: :uuid, force: :cascade do |t|
+    t.bigint "company_id", null: false
+    t.bigint "buyer_id"
+    t.enum "status", default: "placed", null: false, enum_type: "order_status"
+    t.string "reference"
+    t.index ["reference"], name: "index_orders_on_reference", unique: true
+    t.index ["company_id", "buyer_id"], name: "index_orders_on_company_id_and_buyer_id", unique: true
+  end
+
+  create_table "billing.people", primary_key: "person_id", force: :cascade do |t|
+    t.string "email"
+  end
+
+  create_table "joins", id: false, force: :cascade do |t|
+    t.bigint "order_id"
+  end
+
+  add_foreign_key "orders", "companies"
+  add_foreign_key "orders", "billing.people", column: "buyer_id"
+end
+`
+
+func Test_ParseRailsSchema(t *testing.T) {
+	hints, err := ParseRailsSchema([]byte(testRailsSchema), "public")
+	require.NoError(t, err)
+
+	description := `The "legal" name`
+	require.Equal(t, []*ColumnHint{
+		{Schema: "public", Table: "companies", Column: "id", Tests: []string{NotNullTest, UniqueTest}},
+		{Schema: "public", Table: "companies", Column: "name", Description: &description, Tests: []string{NotNullTest}},
+		{Schema: "public", Table: "orders", Column: "id", Tests: []string{NotNullTest, UniqueTest}},
+		{Schema: "public", Table: "orders", Column: "company_id", Tests: []string{NotNullTest}},
+		{Schema: "public", Table: "orders", Column: "buyer_id"},
+		{Schema: "public", Table: "orders", Column: "status", Tests: []string{NotNullTest}, AcceptedValues: []string{"placed", "shipped"}},
+		{Schema: "public", Table: "orders", Column: "reference", Tests: []string{UniqueTest}},
+		{Schema: "billing", Table: "people", Column: "person_id", Tests: []string{NotNullTest, UniqueTest}},
+		{Schema: "billing", Table: "people", Column: "email"},
+		{Schema: "public", Table: "joins", Column: "order_id"},
+	}, hints.Columns)
+	require.Equal(t, []*ForeignKey{
+		{Schema: "public", Table: "orders", Columns: []string{"company_id"}, ForeignSchema: "public", ForeignTable: "companies", ForeignColumns: []string{"id"}},
+		{Schema: "public", Table: "orders", Columns: []string{"buyer_id"}, ForeignSchema: "billing", ForeignTable: "people", ForeignColumns: []string{"person_id"}},
+	}, hints.ForeignKeys)
+}
+
+func Test_ParseRailsSchema_MissingEnd(t *testing.T) {
+	_, err := ParseRailsSchema([]byte(`create_table "users", force: :cascade do |t|`), "public")
+	require.Error(t, err)
+}
+
+func Test_singularize(t *testing.T) {
+	require.Equal(t, "company", singularize("companies"))
+	require.Equal(t, "address", singularize("addresses"))
+	require.Equal(t, "box", singularize("boxes"))
+	require.Equal(t, "user", singularize("users"))
+	require.Equal(t, "status", singularize("status"))
+}
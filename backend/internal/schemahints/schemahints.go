@@ -0,0 +1,124 @@
+package schemahints
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+)
+
+const (
+	NotNullTest = "not_null"
+	UniqueTest  = "unique"
+)
+
+// Column metadata that has been declared outside of the database, such as by a dbt project or an ORM
+type Hints struct {
+	Columns     []*ColumnHint
+	ForeignKeys []*ForeignKey
+}
+
+type ColumnHint struct {
+	Schema         string
+	Table          string
+	Column         string
+	Description    *string
+	Tests          []string
+	AcceptedValues []string
+	// Classifications that the file explicitly declares for the column, such as dbt column tags
+	Classifications []mgmtv1alpha1.DataClassification
+}
+
+type ForeignKey struct {
+	Schema         string
+	Table          string
+	Columns        []string
+	ForeignSchema  string
+	ForeignTable   string
+	ForeignColumns []string
+}
+
+// Parses the contents of a schema file into hints.
+// Tables that the file does not place in a schema are placed in the default schema
+func Parse(format mgmtv1alpha1.SchemaHintsFormat, content []byte, defaultSchema string) (*Hints, error) {
+	switch format {
+	case mgmtv1alpha1.SchemaHintsFormat_SCHEMA_HINTS_FORMAT_DBT_MANIFEST:
+		return ParseDbtManifest(content, defaultSchema)
+	case mgmtv1alpha1.SchemaHintsFormat_SCHEMA_HINTS_FORMAT_PRISMA_SCHEMA:
+		return ParsePrismaSchema(content, defaultSchema)
+	case mgmtv1alpha1.SchemaHintsFormat_SCHEMA_HINTS_FORMAT_RAILS_SCHEMA:
+		return ParseRailsSchema(content, defaultSchema)
+	default:
+		return nil, fmt.Errorf("unsupported schema hints format: %s", format.String())
+	}
+}
+
+var classificationTags = map[string]mgmtv1alpha1.DataClassification{
+	"pii":       mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII,
+	"phi":       mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PHI,
+	"financial": mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_FINANCIAL,
+	"public":    mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PUBLIC,
+}
+
+// Returns the classifications that are named by a set of free form tags, ignoring tags that are not classifications
+func classificationsFromTags(tags []string) []mgmtv1alpha1.DataClassification {
+	output := []mgmtv1alpha1.DataClassification{}
+	for _, tag := range tags {
+		if classification, ok := classificationTags[strings.ToLower(strings.TrimSpace(tag))]; ok {
+			output = append(output, classification)
+		}
+	}
+	slices.Sort(output)
+	return slices.Compact(output)
+}
+
+// Keeps the hints in declaration order while merging hints that are declared more than once for the same column
+type hintsBuilder struct {
+	columns     []*ColumnHint
+	columnIdx   map[string]*ColumnHint
+	foreignKeys []*ForeignKey
+}
+
+func newHintsBuilder() *hintsBuilder {
+	return &hintsBuilder{columnIdx: map[string]*ColumnHint{}}
+}
+
+func (b *hintsBuilder) column(schema, table, column string) *ColumnHint {
+	key := fmt.Sprintf("%s.%s.%s", schema, table, column)
+	if hint, ok := b.columnIdx[key]; ok {
+		return hint
+	}
+	hint := &ColumnHint{Schema: schema, Table: table, Column: column}
+	b.columnIdx[key] = hint
+	b.columns = append(b.columns, hint)
+	return hint
+}
+
+func addTest(hint *ColumnHint, test string) {
+	if !slices.Contains(hint.Tests, test) {
+		hint.Tests = append(hint.Tests, test)
+	}
+}
+
+func (b *hintsBuilder) addForeignKey(fk *ForeignKey) {
+	for _, existing := range b.foreignKeys {
+		if existing.Schema == fk.Schema && existing.Table == fk.Table && slices.Equal(existing.Columns, fk.Columns) &&
+			existing.ForeignSchema == fk.ForeignSchema && existing.ForeignTable == fk.ForeignTable && slices.Equal(existing.ForeignColumns, fk.ForeignColumns) {
+			return
+		}
+	}
+	b.foreignKeys = append(b.foreignKeys, fk)
+}
+
+func (b *hintsBuilder) build() *Hints {
+	return &Hints{Columns: b.columns, ForeignKeys: b.foreignKeys}
+}
+
+// splits a possibly schema qualified table name
+func splitTableName(name, defaultSchema string) (schema, table string) {
+	if before, after, ok := strings.Cut(name, "."); ok {
+		return before, after
+	}
+	return defaultSchema, name
+}
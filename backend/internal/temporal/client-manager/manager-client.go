@@ -26,6 +26,9 @@ type TemporalClientManager struct {
 
 	nsmap       *sync.Map
 	accountNsMu *sync.Map
+
+	defaultWfClient   temporalclient.Client
+	defaultWfClientMu sync.Mutex
 }
 
 type TemporalClientManagerClient interface {
@@ -33,6 +36,7 @@ type TemporalClientManagerClient interface {
 	ClearWorkflowClientByAccount(ctx context.Context, accountId string)
 	GetNamespaceClientByAccount(ctx context.Context, accountId string, logger *slog.Logger) (temporalclient.NamespaceClient, error)
 	GetWorkflowClientByAccount(ctx context.Context, accountId string, logger *slog.Logger) (temporalclient.Client, error)
+	GetDefaultWorkflowClient(logger *slog.Logger) (temporalclient.Client, error)
 	GetScheduleClientByAccount(ctx context.Context, accountId string, logger *slog.Logger) (temporalclient.ScheduleClient, error)
 	GetScheduleHandleClientByAccount(ctx context.Context, accountId string, scheduleId string, logger *slog.Logger) (temporalclient.ScheduleHandle, error)
 	GetTemporalConfigByAccount(ctx context.Context, accountId string) (*pg_models.TemporalConfig, error)
@@ -202,6 +206,34 @@ func (t *TemporalClientManager) GetWorkflowClientByAccount(
 	return client, nil
 }
 
+// Returns a workflow client for the default temporal config that is used by accounts that have not configured their own
+func (t *TemporalClientManager) GetDefaultWorkflowClient(logger *slog.Logger) (temporalclient.Client, error) {
+	t.defaultWfClientMu.Lock()
+	defer t.defaultWfClientMu.Unlock()
+	if t.defaultWfClient != nil {
+		return t.defaultWfClient, nil
+	}
+	dtc := t.config.DefaultTemporalConfig
+	if dtc.Url == "" || dtc.Namespace == "" {
+		return nil, errors.New("a default temporal url and namespace have not been configured")
+	}
+	opts := temporalclient.Options{
+		Logger:    logger.With("temporal-client", "true"),
+		HostPort:  dtc.Url,
+		Namespace: dtc.Namespace,
+	}
+	connectOpts := t.getClientConnectionOptions()
+	if connectOpts != nil {
+		opts.ConnectionOptions = *connectOpts
+	}
+	client, err := temporalclient.NewLazyClient(opts)
+	if err != nil {
+		return nil, err
+	}
+	t.defaultWfClient = client
+	return client, nil
+}
+
 func (t *TemporalClientManager) getNewNSClientByAccount(
 	ctx context.Context,
 	accountId string,
@@ -153,6 +153,64 @@ func (_c *MockTemporalClientManagerClient_DoesAccountHaveTemporalWorkspace_Call)
 	return _c
 }
 
+// GetDefaultWorkflowClient provides a mock function with given fields: logger
+func (_m *MockTemporalClientManagerClient) GetDefaultWorkflowClient(logger *slog.Logger) (client.Client, error) {
+	ret := _m.Called(logger)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDefaultWorkflowClient")
+	}
+
+	var r0 client.Client
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*slog.Logger) (client.Client, error)); ok {
+		return rf(logger)
+	}
+	if rf, ok := ret.Get(0).(func(*slog.Logger) client.Client); ok {
+		r0 = rf(logger)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.Client)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*slog.Logger) error); ok {
+		r1 = rf(logger)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTemporalClientManagerClient_GetDefaultWorkflowClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDefaultWorkflowClient'
+type MockTemporalClientManagerClient_GetDefaultWorkflowClient_Call struct {
+	*mock.Call
+}
+
+// GetDefaultWorkflowClient is a helper method to define mock.On call
+//   - logger *slog.Logger
+func (_e *MockTemporalClientManagerClient_Expecter) GetDefaultWorkflowClient(logger interface{}) *MockTemporalClientManagerClient_GetDefaultWorkflowClient_Call {
+	return &MockTemporalClientManagerClient_GetDefaultWorkflowClient_Call{Call: _e.mock.On("GetDefaultWorkflowClient", logger)}
+}
+
+func (_c *MockTemporalClientManagerClient_GetDefaultWorkflowClient_Call) Run(run func(logger *slog.Logger)) *MockTemporalClientManagerClient_GetDefaultWorkflowClient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*slog.Logger))
+	})
+	return _c
+}
+
+func (_c *MockTemporalClientManagerClient_GetDefaultWorkflowClient_Call) Return(_a0 client.Client, _a1 error) *MockTemporalClientManagerClient_GetDefaultWorkflowClient_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTemporalClientManagerClient_GetDefaultWorkflowClient_Call) RunAndReturn(run func(*slog.Logger) (client.Client, error)) *MockTemporalClientManagerClient_GetDefaultWorkflowClient_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetNamespaceClientByAccount provides a mock function with given fields: ctx, accountId, logger
 func (_m *MockTemporalClientManagerClient) GetNamespaceClientByAccount(ctx context.Context, accountId string, logger *slog.Logger) (client.NamespaceClient, error) {
 	ret := _m.Called(ctx, accountId, logger)
@@ -0,0 +1,34 @@
+package binaryjson
+
+import (
+	"encoding/base64"
+	"fmt"
+)
+
+// Binary values are not valid UTF-8 so they can not be written to JSON as strings.
+// They are written as an object that holds the base64 encoded value under this key, which marks the value as binary
+// so that it can be told apart from a string and decoded when the row is read back.
+const BinaryKey = "$neosync_binary"
+
+// Returns the JSON representation of a binary value
+func Encode(value []byte) map[string]any {
+	return map[string]any{BinaryKey: base64.StdEncoding.EncodeToString(value)}
+}
+
+// Decodes a value that was unmarshaled from JSON.
+// Returns false if the value was not written by Encode.
+func Decode(value any) ([]byte, bool, error) {
+	obj, ok := value.(map[string]any)
+	if !ok || len(obj) != 1 {
+		return nil, false, nil
+	}
+	encoded, ok := obj[BinaryKey].(string)
+	if !ok {
+		return nil, false, nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, true, fmt.Errorf("unable to decode binary value: %w", err)
+	}
+	return decoded, true, nil
+}
@@ -0,0 +1,37 @@
+package binaryjson
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EncodeDecode(t *testing.T) {
+	value := []byte{0x00, 0xff, 0xfe, 'a'}
+	bits, err := json.Marshal(Encode(value))
+	require.NoError(t, err)
+	require.Equal(t, `{"$neosync_binary":"AP/+YQ=="}`, string(bits))
+
+	var unmarshaled any
+	require.NoError(t, json.Unmarshal(bits, &unmarshaled))
+	decoded, ok, err := Decode(unmarshaled)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, value, decoded)
+}
+
+func Test_Decode_NotBinary(t *testing.T) {
+	for _, value := range []any{"AP/+YQ==", nil, float64(1), map[string]any{"a": "b"}, map[string]any{BinaryKey: "AP/+YQ==", "a": "b"}} {
+		decoded, ok, err := Decode(value)
+		require.NoError(t, err)
+		require.False(t, ok)
+		require.Nil(t, decoded)
+	}
+}
+
+func Test_Decode_Invalid(t *testing.T) {
+	_, ok, err := Decode(map[string]any{BinaryKey: "not base64!"})
+	require.True(t, ok)
+	require.Error(t, err)
+}
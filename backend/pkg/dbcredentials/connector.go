@@ -0,0 +1,183 @@
+package dbcredentials
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strconv"
+	"time"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// how long minting or revoking temporary credentials may take
+	credentialsTimeout = 30 * time.Second
+
+	defaultPostgresPort = 5432
+)
+
+// Wraps a sql connector so that connections configured to use temporary credentials are opened with freshly minted credentials
+// instead of their stored ones. The credentials are revoked once the connection is closed.
+type SqlConnector struct {
+	connector sqlconnect.SqlConnector
+	provider  *Provider
+}
+
+var _ sqlconnect.SqlConnector = &SqlConnector{}
+
+func NewSqlConnector(connector sqlconnect.SqlConnector, provider *Provider) *SqlConnector {
+	return &SqlConnector{connector: connector, provider: provider}
+}
+
+func (c *SqlConnector) NewDbFromConnectionConfig(
+	connectionConfig *mgmtv1alpha1.ConnectionConfig,
+	connectionTimeout *uint32,
+	logger *slog.Logger,
+) (sqlconnect.SqlDbContainer, error) {
+	cfg := getTemporaryCredentialsConfig(connectionConfig)
+	if cfg == nil {
+		return c.connector.NewDbFromConnectionConfig(connectionConfig, connectionTimeout, logger)
+	}
+	withCreds, creds, err := c.applyCredentials(connectionConfig, cfg)
+	if err != nil {
+		return nil, err
+	}
+	container, err := c.connector.NewDbFromConnectionConfig(withCreds, connectionTimeout, logger)
+	if err != nil {
+		c.revoke(creds, logger)
+		return nil, err
+	}
+	return &sqlDbContainer{SqlDbContainer: container, onClose: func() { c.revoke(creds, logger) }}, nil
+}
+
+func (c *SqlConnector) NewPgPoolFromConnectionConfig(
+	pgconfig *mgmtv1alpha1.PostgresConnectionConfig,
+	connectionTimeout *uint32,
+	logger *slog.Logger,
+) (sqlconnect.PgPoolContainer, error) {
+	if pgconfig.GetTemporaryCredentials() == nil {
+		return c.connector.NewPgPoolFromConnectionConfig(pgconfig, connectionTimeout, logger)
+	}
+	withCreds, creds, err := c.applyCredentials(
+		&mgmtv1alpha1.ConnectionConfig{Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{PgConfig: pgconfig}},
+		pgconfig.GetTemporaryCredentials(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	container, err := c.connector.NewPgPoolFromConnectionConfig(withCreds.GetPgConfig(), connectionTimeout, logger)
+	if err != nil {
+		c.revoke(creds, logger)
+		return nil, err
+	}
+	return &pgPoolContainer{PgPoolContainer: container, onClose: func() { c.revoke(creds, logger) }}, nil
+}
+
+// Mints credentials for the database and returns a copy of the connection config that authenticates with them
+func (c *SqlConnector) applyCredentials(
+	connectionConfig *mgmtv1alpha1.ConnectionConfig,
+	cfg *mgmtv1alpha1.TemporaryCredentialsConfig,
+) (*mgmtv1alpha1.ConnectionConfig, *Credentials, error) {
+	if c.provider == nil {
+		return nil, nil, errors.New("connection is configured to use temporary credentials but a credentials provider was not configured")
+	}
+	output, ok := proto.Clone(connectionConfig).(*mgmtv1alpha1.ConnectionConfig)
+	if !ok {
+		return nil, nil, errors.New("unable to clone connection config")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), credentialsTimeout)
+	defer cancel()
+
+	switch config := output.GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
+		switch cc := config.PgConfig.GetConnectionConfig().(type) {
+		case *mgmtv1alpha1.PostgresConnectionConfig_Connection:
+			creds, err := c.getCredentials(ctx, cfg, cc.Connection.GetHost(), cc.Connection.GetPort())
+			if err != nil {
+				return nil, nil, err
+			}
+			cc.Connection.User = creds.User
+			cc.Connection.Pass = creds.Pass
+			return output, creds, nil
+		case *mgmtv1alpha1.PostgresConnectionConfig_Url:
+			u, err := url.Parse(cc.Url)
+			if err != nil {
+				return nil, nil, fmt.Errorf("unable to parse postgres url: %w", err)
+			}
+			port := int32(defaultPostgresPort)
+			if u.Port() != "" {
+				parsed, err := strconv.ParseInt(u.Port(), 10, 32)
+				if err != nil {
+					return nil, nil, fmt.Errorf("invalid port in postgres url: %w", err)
+				}
+				port = int32(parsed)
+			}
+			creds, err := c.getCredentials(ctx, cfg, u.Hostname(), port)
+			if err != nil {
+				return nil, nil, err
+			}
+			u.User = url.UserPassword(creds.User, creds.Pass)
+			cc.Url = u.String()
+			return output, creds, nil
+		}
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+		if cc, ok := config.MysqlConfig.GetConnectionConfig().(*mgmtv1alpha1.MysqlConnectionConfig_Connection); ok {
+			creds, err := c.getCredentials(ctx, cfg, cc.Connection.GetHost(), cc.Connection.GetPort())
+			if err != nil {
+				return nil, nil, err
+			}
+			cc.Connection.User = creds.User
+			cc.Connection.Pass = creds.Pass
+			return output, creds, nil
+		}
+	}
+	return nil, nil, errors.New("temporary credentials are only supported for postgres and mysql connections")
+}
+
+func (c *SqlConnector) getCredentials(
+	ctx context.Context,
+	cfg *mgmtv1alpha1.TemporaryCredentialsConfig,
+	host string,
+	port int32,
+) (*Credentials, error) {
+	creds, err := c.provider.GetCredentials(ctx, cfg, host, port)
+	if err != nil {
+		return nil, fmt.Errorf("unable to mint temporary credentials: %w", err)
+	}
+	return creds, nil
+}
+
+func (c *SqlConnector) revoke(creds *Credentials, logger *slog.Logger) {
+	ctx, cancel := context.WithTimeout(context.Background(), credentialsTimeout)
+	defer cancel()
+	// credentials that fail to be revoked still expire at the end of their lease
+	if err := c.provider.Revoke(ctx, creds); err != nil {
+		logger.Warn(fmt.Sprintf("unable to revoke temporary credentials: %s", err.Error()))
+	}
+}
+
+type sqlDbContainer struct {
+	sqlconnect.SqlDbContainer
+	onClose func()
+}
+
+func (s *sqlDbContainer) Close() error {
+	err := s.SqlDbContainer.Close()
+	s.onClose()
+	return err
+}
+
+type pgPoolContainer struct {
+	sqlconnect.PgPoolContainer
+	onClose func()
+}
+
+func (p *pgPoolContainer) Close() {
+	p.PgPoolContainer.Close()
+	p.onClose()
+}
@@ -0,0 +1,74 @@
+package dbcredentials
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+)
+
+const (
+	// RDS only accepts authentication tokens for 15 minutes after they are signed
+	rdsAuthTokenTTL = 15 * time.Minute
+	// sha256 of an empty payload
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// Signs an RDS IAM authentication token that is used as the password for the configured database user
+func (p *Provider) getAwsIamCredentials(
+	ctx context.Context,
+	cfg *mgmtv1alpha1.AwsIamDatabaseCredentialsConfig,
+	host string,
+	port int32,
+) (*Credentials, error) {
+	awscfg, err := p.loadAwsCfg(ctx, cfg.GetRegion())
+	if err != nil {
+		return nil, fmt.Errorf("unable to load aws config: %w", err)
+	}
+	awscreds, err := awscfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve aws credentials: %w", err)
+	}
+
+	signedAt := time.Now().UTC()
+	token, err := buildRdsAuthToken(ctx, awscreds, fmt.Sprintf("%s:%d", host, port), cfg.GetRegion(), cfg.GetUser(), signedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build rds auth token: %w", err)
+	}
+	expiresAt := signedAt.Add(rdsAuthTokenTTL)
+	return &Credentials{
+		User:      cfg.GetUser(),
+		Pass:      token,
+		ExpiresAt: &expiresAt,
+	}, nil
+}
+
+// Mirrors the token format of the aws-sdk-go-v2 rds/auth package: a presigned connect url without its scheme
+func buildRdsAuthToken(
+	ctx context.Context,
+	creds aws.Credentials,
+	endpoint, region, user string,
+	signedAt time.Time,
+) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+endpoint, http.NoBody)
+	if err != nil {
+		return "", err
+	}
+	values := url.Values{}
+	values.Set("Action", "connect")
+	values.Set("DBUser", user)
+	values.Set("X-Amz-Expires", fmt.Sprintf("%d", int(rdsAuthTokenTTL.Seconds())))
+	req.URL.RawQuery = values.Encode()
+
+	signedUrl, _, err := v4.NewSigner().PresignHTTP(ctx, creds, req, emptyPayloadHash, "rds-db", region, signedAt)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimPrefix(signedUrl, "https://"), nil
+}
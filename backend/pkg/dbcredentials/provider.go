@@ -0,0 +1,88 @@
+// Package dbcredentials mints short-lived database credentials so that the stored long-lived credentials are not used to connect
+package dbcredentials
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+)
+
+type Credentials struct {
+	User string
+	Pass string
+	// The point after which the credentials can no longer be used to open new connections. Nil if they do not expire
+	ExpiresAt *time.Time
+
+	// the vault lease backing the credentials, empty if there is nothing to revoke
+	leaseId string
+}
+
+type Config struct {
+	// The address of the Vault server, required to use Vault database credentials
+	VaultAddress string
+	// The token the worker uses to authenticate to Vault
+	VaultToken string
+	// Optional Vault Enterprise namespace
+	VaultNamespace string
+}
+
+type Provider struct {
+	config     *Config
+	httpclient *http.Client
+	loadAwsCfg func(ctx context.Context, region string) (aws.Config, error)
+}
+
+func New(cfg *Config) *Provider {
+	return &Provider{
+		config:     cfg,
+		httpclient: &http.Client{Timeout: 30 * time.Second},
+		loadAwsCfg: func(ctx context.Context, region string) (aws.Config, error) {
+			return config.LoadDefaultConfig(ctx, config.WithRegion(region))
+		},
+	}
+}
+
+// Mints credentials for the database listening at host and port
+func (p *Provider) GetCredentials(
+	ctx context.Context,
+	cfg *mgmtv1alpha1.TemporaryCredentialsConfig,
+	host string,
+	port int32,
+) (*Credentials, error) {
+	switch c := cfg.GetConfig().(type) {
+	case *mgmtv1alpha1.TemporaryCredentialsConfig_Vault:
+		return p.getVaultCredentials(ctx, c.Vault)
+	case *mgmtv1alpha1.TemporaryCredentialsConfig_AwsIam:
+		return p.getAwsIamCredentials(ctx, c.AwsIam, host, port)
+	default:
+		return nil, fmt.Errorf("unsupported temporary credentials config: %T", c)
+	}
+}
+
+// Revokes the credentials so that they can no longer be used. A no-op for credentials that simply expire
+func (p *Provider) Revoke(ctx context.Context, creds *Credentials) error {
+	if creds == nil || creds.leaseId == "" {
+		return nil
+	}
+	return p.revokeVaultLease(ctx, creds.leaseId)
+}
+
+// Returns the temporary credentials config of a connection, or nil if it uses its stored credentials
+func GetTemporaryCredentialsConfig(connection *mgmtv1alpha1.Connection) *mgmtv1alpha1.TemporaryCredentialsConfig {
+	return getTemporaryCredentialsConfig(connection.GetConnectionConfig())
+}
+
+func getTemporaryCredentialsConfig(connectionConfig *mgmtv1alpha1.ConnectionConfig) *mgmtv1alpha1.TemporaryCredentialsConfig {
+	switch cfg := connectionConfig.GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
+		return cfg.PgConfig.GetTemporaryCredentials()
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+		return cfg.MysqlConfig.GetTemporaryCredentials()
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package dbcredentials
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Provider_Vault(t *testing.T) {
+	revoked := []string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "test-token", r.Header.Get("X-Vault-Token"))
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/db-secrets/creds/sync-writer":
+			_, _ = w.Write([]byte(`{"lease_id":"db-secrets/creds/sync-writer/abc","lease_duration":3600,"data":{"username":"v-sync-abc","password":"secret"}}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/v1/sys/leases/revoke":
+			var body map[string]string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			revoked = append(revoked, body["lease_id"])
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	provider := New(&Config{VaultAddress: srv.URL, VaultToken: "test-token"})
+	creds, err := provider.GetCredentials(context.Background(), &mgmtv1alpha1.TemporaryCredentialsConfig{
+		Config: &mgmtv1alpha1.TemporaryCredentialsConfig_Vault{
+			Vault: &mgmtv1alpha1.VaultDatabaseCredentialsConfig{MountPath: aws.String("/db-secrets/"), Role: "sync-writer"},
+		},
+	}, "localhost", 5432)
+	require.NoError(t, err)
+	require.Equal(t, "v-sync-abc", creds.User)
+	require.Equal(t, "secret", creds.Pass)
+	require.NotNil(t, creds.ExpiresAt)
+
+	require.NoError(t, provider.Revoke(context.Background(), creds))
+	require.Equal(t, []string{"db-secrets/creds/sync-writer/abc"}, revoked)
+}
+
+func Test_Provider_Vault_Error(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+	}))
+	defer srv.Close()
+
+	provider := New(&Config{VaultAddress: srv.URL, VaultToken: "test-token"})
+	_, err := provider.GetCredentials(context.Background(), &mgmtv1alpha1.TemporaryCredentialsConfig{
+		Config: &mgmtv1alpha1.TemporaryCredentialsConfig_Vault{Vault: &mgmtv1alpha1.VaultDatabaseCredentialsConfig{Role: "sync-writer"}},
+	}, "localhost", 5432)
+	require.ErrorContains(t, err, "permission denied")
+}
+
+func Test_Provider_Vault_NotConfigured(t *testing.T) {
+	provider := New(&Config{})
+	_, err := provider.GetCredentials(context.Background(), &mgmtv1alpha1.TemporaryCredentialsConfig{
+		Config: &mgmtv1alpha1.TemporaryCredentialsConfig_Vault{Vault: &mgmtv1alpha1.VaultDatabaseCredentialsConfig{Role: "sync-writer"}},
+	}, "localhost", 5432)
+	require.ErrorIs(t, err, errVaultNotConfigured)
+}
+
+func Test_Provider_AwsIam(t *testing.T) {
+	provider := New(&Config{})
+	provider.loadAwsCfg = func(ctx context.Context, region string) (aws.Config, error) {
+		return aws.Config{
+			Region:      region,
+			Credentials: credentials.NewStaticCredentialsProvider("AKIDEXAMPLE", "secret", ""),
+		}, nil
+	}
+	creds, err := provider.GetCredentials(context.Background(), &mgmtv1alpha1.TemporaryCredentialsConfig{
+		Config: &mgmtv1alpha1.TemporaryCredentialsConfig_AwsIam{
+			AwsIam: &mgmtv1alpha1.AwsIamDatabaseCredentialsConfig{User: "sync_writer", Region: "us-west-2"},
+		},
+	}, "db.example.us-west-2.rds.amazonaws.com", 5432)
+	require.NoError(t, err)
+	require.Equal(t, "sync_writer", creds.User)
+	require.True(t, strings.HasPrefix(creds.Pass, "db.example.us-west-2.rds.amazonaws.com:5432?Action=connect&DBUser=sync_writer"), creds.Pass)
+	require.Contains(t, creds.Pass, "X-Amz-Credential=AKIDEXAMPLE%2F")
+	require.Contains(t, creds.Pass, "%2Fus-west-2%2Frds-db%2Faws4_request")
+	require.Contains(t, creds.Pass, "X-Amz-Expires=900")
+	require.Contains(t, creds.Pass, "X-Amz-Signature=")
+	require.WithinDuration(t, time.Now().Add(rdsAuthTokenTTL), *creds.ExpiresAt, time.Minute)
+
+	// nothing to revoke as the token expires on its own
+	require.NoError(t, provider.Revoke(context.Background(), creds))
+}
+
+func Test_GetTemporaryCredentialsConfig(t *testing.T) {
+	cfg := &mgmtv1alpha1.TemporaryCredentialsConfig{
+		Config: &mgmtv1alpha1.TemporaryCredentialsConfig_Vault{Vault: &mgmtv1alpha1.VaultDatabaseCredentialsConfig{Role: "sync-writer"}},
+	}
+	require.Equal(t, cfg, GetTemporaryCredentialsConfig(&mgmtv1alpha1.Connection{
+		ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_MysqlConfig{MysqlConfig: &mgmtv1alpha1.MysqlConnectionConfig{TemporaryCredentials: cfg}},
+		},
+	}))
+	require.Nil(t, GetTemporaryCredentialsConfig(&mgmtv1alpha1.Connection{
+		ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{PgConfig: &mgmtv1alpha1.PostgresConnectionConfig{}},
+		},
+	}))
+}
@@ -0,0 +1,98 @@
+package dbcredentials
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+)
+
+const defaultVaultDatabaseMount = "database"
+
+var errVaultNotConfigured = errors.New("the worker has not been configured with a vault address and token")
+
+type vaultCredsResponse struct {
+	LeaseId       string `json:"lease_id"`
+	LeaseDuration int64  `json:"lease_duration"`
+	Data          struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+	} `json:"data"`
+}
+
+// Generates a new database user from the role with the Vault database secrets engine
+func (p *Provider) getVaultCredentials(ctx context.Context, cfg *mgmtv1alpha1.VaultDatabaseCredentialsConfig) (*Credentials, error) {
+	mount := defaultVaultDatabaseMount
+	if cfg.GetMountPath() != "" {
+		mount = strings.Trim(cfg.GetMountPath(), "/")
+	}
+	var resp vaultCredsResponse
+	err := p.doVaultRequest(ctx, http.MethodGet, fmt.Sprintf("%s/creds/%s", mount, url.PathEscape(cfg.GetRole())), nil, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate vault database credentials for role %s: %w", cfg.GetRole(), err)
+	}
+	creds := &Credentials{
+		User:    resp.Data.Username,
+		Pass:    resp.Data.Password,
+		leaseId: resp.LeaseId,
+	}
+	if resp.LeaseDuration > 0 {
+		expiresAt := time.Now().Add(time.Duration(resp.LeaseDuration) * time.Second)
+		creds.ExpiresAt = &expiresAt
+	}
+	return creds, nil
+}
+
+func (p *Provider) revokeVaultLease(ctx context.Context, leaseId string) error {
+	err := p.doVaultRequest(ctx, http.MethodPut, "sys/leases/revoke", map[string]string{"lease_id": leaseId}, nil)
+	if err != nil {
+		return fmt.Errorf("unable to revoke vault lease: %w", err)
+	}
+	return nil
+}
+
+func (p *Provider) doVaultRequest(ctx context.Context, method, path string, body, out any) error {
+	if p.config == nil || p.config.VaultAddress == "" || p.config.VaultToken == "" {
+		return errVaultNotConfigured
+	}
+	var reqBody io.Reader
+	if body != nil {
+		bits, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(bits)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/v1/%s", strings.TrimRight(p.config.VaultAddress, "/"), path), reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Vault-Token", p.config.VaultToken)
+	if p.config.VaultNamespace != "" {
+		req.Header.Set("X-Vault-Namespace", p.config.VaultNamespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := p.httpclient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("vault returned %s: %s", resp.Status, strings.TrimSpace(string(respBody)))
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -86,7 +86,8 @@ WHERE
     AND tc.constraint_type = 'UNIQUE'
 ORDER BY
     tc.table_name,
-    kcu.column_name;
+    tc.constraint_name,
+    kcu.ordinal_position;
 
 
 -- name: GetMysqlRolePermissions :many
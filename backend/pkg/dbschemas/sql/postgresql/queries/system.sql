@@ -51,18 +51,39 @@ SELECT
             -1
     END AS numeric_scale,
     a.attnum AS ordinal_position,
-    a.attgenerated::text as generated_type
+    a.attgenerated::text as generated_type,
+    CASE
+        WHEN c.relkind = 'f' THEN 'FOREIGN'
+        ELSE 'BASE TABLE'
+    END AS table_type,
+    COALESCE(domain_base.data_type, '')::text AS base_data_type
 FROM
     pg_catalog.pg_attribute a
     INNER JOIN pg_catalog.pg_class c ON a.attrelid = c.oid
     INNER JOIN pg_catalog.pg_namespace n ON c.relnamespace = n.oid
     INNER JOIN pg_catalog.pg_type pgt ON pgt.oid = a.atttypid
     LEFT JOIN pg_catalog.pg_attrdef d ON d.adrelid = a.attrelid AND d.adnum = a.attnum
+    LEFT JOIN LATERAL (
+        -- Resolves domains, including domains of domains, to the data type they are based on
+        WITH RECURSIVE domain_types AS (
+            SELECT pgt.typbasetype AS type_oid, pgt.typtypmod AS type_mod, 1 AS depth
+            WHERE pgt.typtype = 'd'
+            UNION ALL
+            SELECT t.typbasetype, t.typtypmod, dt.depth + 1
+            FROM domain_types dt
+            JOIN pg_catalog.pg_type t ON t.oid = dt.type_oid
+            WHERE t.typtype = 'd'
+        )
+        SELECT pg_catalog.format_type(type_oid, type_mod) AS data_type
+        FROM domain_types
+        ORDER BY depth DESC
+        LIMIT 1
+    ) AS domain_base ON TRUE
 WHERE
     n.nspname NOT IN('pg_catalog', 'pg_toast', 'information_schema')
     AND a.attnum > 0
     AND NOT a.attisdropped
-    AND c.relkind = 'r' -- ensures only tables are present
+    AND c.relkind IN ('r', 'f') -- ensures only tables and foreign tables are present
 ORDER BY
     a.attnum;
 
@@ -119,13 +140,42 @@ SELECT
             -1
     END AS numeric_scale,
     a.attnum AS ordinal_position,
-    a.attgenerated::text as generated_type
+    a.attgenerated::text as generated_type,
+    COALESCE(domain_base.data_type, '')::text AS base_data_type,
+    EXISTS (
+        SELECT
+            1
+        FROM
+            pg_catalog.pg_depend dep
+            JOIN pg_catalog.pg_class seq ON seq.oid = dep.objid AND seq.relkind = 'S'
+        WHERE
+            dep.classid = 'pg_catalog.pg_class'::regclass
+            AND dep.refobjid = a.attrelid
+            AND dep.refobjsubid = a.attnum
+            AND dep.deptype = 'a'
+    ) AS is_serial -- Only columns that own the sequence of their nextval default were created as serial columns
 FROM
     pg_catalog.pg_attribute a
     INNER JOIN pg_catalog.pg_class c ON a.attrelid = c.oid
     INNER JOIN pg_catalog.pg_namespace n ON c.relnamespace = n.oid
     INNER JOIN pg_catalog.pg_type pgt ON pgt.oid = a.atttypid
     LEFT JOIN pg_catalog.pg_attrdef d ON d.adrelid = a.attrelid AND d.adnum = a.attnum
+    LEFT JOIN LATERAL (
+        -- Resolves domains, including domains of domains, to the data type they are based on
+        WITH RECURSIVE domain_types AS (
+            SELECT pgt.typbasetype AS type_oid, pgt.typtypmod AS type_mod, 1 AS depth
+            WHERE pgt.typtype = 'd'
+            UNION ALL
+            SELECT t.typbasetype, t.typtypmod, dt.depth + 1
+            FROM domain_types dt
+            JOIN pg_catalog.pg_type t ON t.oid = dt.type_oid
+            WHERE t.typtype = 'd'
+        )
+        SELECT pg_catalog.format_type(type_oid, type_mod) AS data_type
+        FROM domain_types
+        ORDER BY depth DESC
+        LIMIT 1
+    ) AS domain_base ON TRUE
 WHERE
     c.relname = sqlc.arg('table')
     AND n.nspname = sqlc.arg('schema')
@@ -188,13 +238,42 @@ SELECT
             -1
     END AS numeric_scale,
     a.attnum AS ordinal_position,
-    a.attgenerated::text as generated_type
+    a.attgenerated::text as generated_type,
+    COALESCE(domain_base.data_type, '')::text AS base_data_type,
+    EXISTS (
+        SELECT
+            1
+        FROM
+            pg_catalog.pg_depend dep
+            JOIN pg_catalog.pg_class seq ON seq.oid = dep.objid AND seq.relkind = 'S'
+        WHERE
+            dep.classid = 'pg_catalog.pg_class'::regclass
+            AND dep.refobjid = a.attrelid
+            AND dep.refobjsubid = a.attnum
+            AND dep.deptype = 'a'
+    ) AS is_serial -- Only columns that own the sequence of their nextval default were created as serial columns
 FROM
     pg_catalog.pg_attribute a
     INNER JOIN pg_catalog.pg_class c ON a.attrelid = c.oid
     INNER JOIN pg_catalog.pg_namespace n ON c.relnamespace = n.oid
     INNER JOIN pg_catalog.pg_type pgt ON pgt.oid = a.atttypid
     LEFT JOIN pg_catalog.pg_attrdef d ON d.adrelid = a.attrelid AND d.adnum = a.attnum
+    LEFT JOIN LATERAL (
+        -- Resolves domains, including domains of domains, to the data type they are based on
+        WITH RECURSIVE domain_types AS (
+            SELECT pgt.typbasetype AS type_oid, pgt.typtypmod AS type_mod, 1 AS depth
+            WHERE pgt.typtype = 'd'
+            UNION ALL
+            SELECT t.typbasetype, t.typtypmod, dt.depth + 1
+            FROM domain_types dt
+            JOIN pg_catalog.pg_type t ON t.oid = dt.type_oid
+            WHERE t.typtype = 'd'
+        )
+        SELECT pg_catalog.format_type(type_oid, type_mod) AS data_type
+        FROM domain_types
+        ORDER BY depth DESC
+        LIMIT 1
+    ) AS domain_base ON TRUE
 WHERE
     (n.nspname || '.' || c.relname) = ANY(sqlc.arg('schematables')::TEXT[])
     AND a.attnum > 0
@@ -357,3 +436,75 @@ ORDER BY
     schema_name,
     table_name,
     index_name;
+
+-- name: GetExclusionConstraintsBySchema :many
+SELECT
+    ns.nspname AS schema_name,
+    cls.relname AS table_name,
+    con.conname AS constraint_name,
+    elements.column_names AS constraint_columns,
+    elements.operator_names AS operators,
+    pg_get_constraintdef(con.oid)::TEXT AS constraint_definition
+FROM
+    pg_catalog.pg_constraint con
+    JOIN pg_catalog.pg_class cls ON cls.oid = con.conrelid
+    JOIN pg_catalog.pg_namespace ns ON cls.relnamespace = ns.oid
+JOIN LATERAL (
+        SELECT
+            array_agg(att.attname ORDER BY k.idx)::TEXT[] AS column_names,
+            array_agg(op.oprname ORDER BY k.idx)::TEXT[] AS operator_names
+        FROM
+            generate_subscripts(con.conkey, 1) AS k(idx)
+        JOIN pg_catalog.pg_attribute att ON -- expression elements have an attnum of 0 and are not joined
+            att.attrelid = con.conrelid
+            AND att.attnum = con.conkey[k.idx]
+        JOIN pg_catalog.pg_operator op ON
+            op.oid = con.conexclop[k.idx]
+    ) AS elements ON
+    TRUE
+WHERE
+    con.contype = 'x'
+    AND ns.nspname = ANY(sqlc.arg('schema')::TEXT[])
+ORDER BY
+    schema_name,
+    table_name,
+    constraint_name;
+
+-- name: GetUniqueIndexesBySchema :many
+SELECT
+    ns.nspname AS schema_name,
+    t.relname AS table_name,
+    i.relname AS index_name,
+    index_columns.column_names AS index_columns,
+    COALESCE(pg_get_expr(ix.indpred, ix.indrelid), '')::TEXT AS index_predicate
+FROM
+    pg_catalog.pg_index ix
+    JOIN pg_catalog.pg_class t ON t.oid = ix.indrelid
+    JOIN pg_catalog.pg_class i ON i.oid = ix.indexrelid
+    JOIN pg_catalog.pg_namespace ns ON t.relnamespace = ns.oid
+LEFT JOIN pg_catalog.pg_constraint con ON con.conindid = ix.indexrelid
+JOIN LATERAL (
+        SELECT
+            array_agg(att.attname ORDER BY array_position(ix.indkey::SMALLINT[], att.attnum))::TEXT[] AS column_names
+        FROM
+            pg_catalog.pg_attribute att
+        WHERE
+            att.attrelid = t.oid
+            AND att.attnum = ANY(ix.indkey)
+    ) AS index_columns ON
+    TRUE
+WHERE
+    ix.indisunique
+    AND NOT ix.indisprimary
+    AND con.conindid IS NULL -- Excludes indexes created as part of constraints
+    AND ix.indexprs IS NULL -- Excludes expression indexes as they do not map to plain columns
+    AND ns.nspname = ANY(sqlc.arg('schema')::TEXT[])
+ORDER BY
+    schema_name,
+    table_name,
+    index_name;
+
+-- name: IsCitusExtensionInstalled :one
+SELECT EXISTS (
+    SELECT 1 FROM pg_catalog.pg_extension WHERE extname = 'citus'
+) AS is_installed;
@@ -0,0 +1,117 @@
+package nonfinite
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Determines what happens to NaN, Infinity and -Infinity values when rows are streamed or written.
+// Floats that overflow the range of float64 become Infinity and are handled the same way.
+type Policy string
+
+const (
+	// Values are kept. Formats that are unable to represent them, such as JSON, store them as text
+	PolicyPreserve Policy = "preserve"
+	// Values are replaced with null
+	PolicyNull Policy = "null"
+	// The row is not streamed or written and an error is returned
+	PolicyFail Policy = "fail"
+)
+
+var ErrNonFiniteValue = errors.New("non-finite numeric value")
+
+func ParsePolicy(input string) (Policy, error) {
+	switch policy := Policy(strings.ToLower(input)); policy {
+	case PolicyPreserve, PolicyNull, PolicyFail:
+		return policy, nil
+	case "":
+		return PolicyPreserve, nil
+	default:
+		return "", fmt.Errorf("unknown non-finite number policy %q, must be one of preserve, null or fail", input)
+	}
+}
+
+// Returns true if the value is a NaN or infinite float
+func IsNonFinite(value any) bool {
+	switch t := value.(type) {
+	case float64:
+		return math.IsNaN(t) || math.IsInf(t, 0)
+	case float32:
+		return math.IsNaN(float64(t)) || math.IsInf(float64(t), 0)
+	default:
+		return false
+	}
+}
+
+// Returns true if the value is the text form of a non-finite number, as output by Postgres for float and numeric columns
+func IsNonFiniteText(value []byte) bool {
+	switch string(value) {
+	case "NaN", "Infinity", "-Infinity":
+		return true
+	default:
+		return false
+	}
+}
+
+// Formats a non-finite float in the text form that Postgres accepts as input
+func FormatNonFinite(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "Infinity"
+	case math.IsInf(value, -1):
+		return "-Infinity"
+	default:
+		return "NaN"
+	}
+}
+
+// Applies the policy to a value that is handed to a database driver. Finite values and non-float values are returned as is
+func (p Policy) Apply(value any) (any, error) {
+	if !IsNonFinite(value) {
+		return value, nil
+	}
+	switch p {
+	case PolicyNull:
+		return nil, nil
+	case PolicyFail:
+		return nil, fmt.Errorf("%w: %v", ErrNonFiniteValue, value)
+	default:
+		return value, nil
+	}
+}
+
+// Applies the policy to a value that is written as JSON. Preserved values are converted to their text form
+func (p Policy) ApplyJSON(value any) (any, error) {
+	value, err := p.Apply(value)
+	if err != nil {
+		return nil, err
+	}
+	switch t := value.(type) {
+	case float64:
+		if IsNonFinite(t) {
+			return FormatNonFinite(t), nil
+		}
+	case float32:
+		if IsNonFinite(t) {
+			return FormatNonFinite(float64(t)), nil
+		}
+	}
+	return value, nil
+}
+
+// Applies the policy to the text form of a numeric value
+func (p Policy) ApplyText(value []byte) ([]byte, error) {
+	if !IsNonFiniteText(value) {
+		return value, nil
+	}
+	switch p {
+	case PolicyNull:
+		return nil, nil
+	case PolicyFail:
+		return nil, fmt.Errorf("%w: %s", ErrNonFiniteValue, value)
+	default:
+		return value, nil
+	}
+}
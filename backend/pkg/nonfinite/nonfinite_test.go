@@ -0,0 +1,80 @@
+package nonfinite
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParsePolicy(t *testing.T) {
+	policy, err := ParsePolicy("")
+	require.NoError(t, err)
+	require.Equal(t, PolicyPreserve, policy)
+
+	policy, err = ParsePolicy("NULL")
+	require.NoError(t, err)
+	require.Equal(t, PolicyNull, policy)
+
+	policy, err = ParsePolicy("fail")
+	require.NoError(t, err)
+	require.Equal(t, PolicyFail, policy)
+
+	_, err = ParsePolicy("zero")
+	require.Error(t, err)
+}
+
+func Test_Apply(t *testing.T) {
+	value, err := PolicyPreserve.Apply(math.Inf(1))
+	require.NoError(t, err)
+	require.True(t, math.IsInf(value.(float64), 1))
+
+	value, err = PolicyNull.Apply(math.NaN())
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	_, err = PolicyFail.Apply(float32(math.Inf(-1)))
+	require.ErrorIs(t, err, ErrNonFiniteValue)
+
+	value, err = PolicyFail.Apply(1.5)
+	require.NoError(t, err)
+	require.Equal(t, 1.5, value)
+
+	value, err = PolicyFail.Apply("NaN")
+	require.NoError(t, err)
+	require.Equal(t, "NaN", value)
+}
+
+func Test_ApplyJSON(t *testing.T) {
+	value, err := PolicyPreserve.ApplyJSON(math.Inf(-1))
+	require.NoError(t, err)
+	require.Equal(t, "-Infinity", value)
+
+	value, err = PolicyPreserve.ApplyJSON(float32(math.NaN()))
+	require.NoError(t, err)
+	require.Equal(t, "NaN", value)
+
+	value, err = PolicyNull.ApplyJSON(math.Inf(1))
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	_, err = PolicyFail.ApplyJSON(math.NaN())
+	require.ErrorIs(t, err, ErrNonFiniteValue)
+}
+
+func Test_ApplyText(t *testing.T) {
+	value, err := PolicyPreserve.ApplyText([]byte("Infinity"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("Infinity"), value)
+
+	value, err = PolicyNull.ApplyText([]byte("-Infinity"))
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	_, err = PolicyFail.ApplyText([]byte("NaN"))
+	require.ErrorIs(t, err, ErrNonFiniteValue)
+
+	value, err = PolicyFail.ApplyText([]byte("1.5"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1.5"), value)
+}
@@ -8,9 +8,11 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"net"
 	"net/url"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -80,10 +82,15 @@ type ClientCertConfig struct {
 }
 
 const (
-	mysqlDriver    = "mysql"
-	postgresDriver = "postgres"
-	localhost      = "localhost"
-	randomPort     = 0
+	mysqlDriver     = "mysql"
+	postgresDriver  = "postgres"
+	sqliteDriver    = "sqlite"
+	mssqlDriver     = "sqlserver"
+	snowflakeDriver = "snowflake"
+	duckdbDriver    = "duckdb"
+	spannerDriver   = "spanner"
+	localhost       = "localhost"
+	randomPort      = 0
 )
 
 type ClientTlsFileConfig struct {
@@ -301,6 +308,48 @@ func GetConnectionDetails(
 			GeneralDbConnectConfig: *connDetails,
 			MaxConnectionLimit:     maxConnLimit,
 		}, nil
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		// the driver creates an empty database if the file does not exist, which would hide a misconfigured path
+		if _, err := os.Stat(config.SqliteConfig.GetPath()); err != nil {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("unable to access sqlite database file: %s", err.Error()))
+		}
+		return &ConnectionDetails{
+			GeneralDbConnectConfig: *getGeneralDbConnectConfigFromSqlite(config, connectionTimeout),
+		}, nil
+	case *mgmtv1alpha1.ConnectionConfig_SnowflakeConfig:
+		return &ConnectionDetails{
+			GeneralDbConnectConfig: *getGeneralDbConnectConfigFromSnowflake(config, connectionTimeout),
+		}, nil
+	case *mgmtv1alpha1.ConnectionConfig_RedshiftConfig:
+		return &ConnectionDetails{
+			GeneralDbConnectConfig: *getGeneralDbConnectConfigFromRedshift(config, connectionTimeout),
+		}, nil
+	case *mgmtv1alpha1.ConnectionConfig_DuckdbConfig:
+		// tables are read from and written to the sub directories, so the directory itself must already exist
+		if config.DuckdbConfig.GetParquetDirectory() != "" {
+			info, err := os.Stat(config.DuckdbConfig.GetParquetDirectory())
+			if err != nil {
+				return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("unable to access duckdb parquet directory: %s", err.Error()))
+			}
+			if !info.IsDir() {
+				return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("duckdb parquet directory is not a directory: %s", config.DuckdbConfig.GetParquetDirectory()))
+			}
+		}
+		return &ConnectionDetails{
+			GeneralDbConnectConfig: *getGeneralDbConnectConfigFromDuckdb(config),
+		}, nil
+	case *mgmtv1alpha1.ConnectionConfig_SpannerConfig:
+		connDetails, err := getGeneralDbConnectConfigFromSpanner(config)
+		if err != nil {
+			return nil, err
+		}
+		return &ConnectionDetails{
+			GeneralDbConnectConfig: *connDetails,
+		}, nil
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		return &ConnectionDetails{
+			GeneralDbConnectConfig: *getGeneralDbConnectConfigFromMssql(config, connectionTimeout),
+		}, nil
 	default:
 		return nil, nucleuserrors.NewNotImplemented("this connection config is not currently supported")
 	}
@@ -405,9 +454,210 @@ func (g *GeneralDbConnectConfig) String() string {
 		}
 		return dsn
 	}
+	if g.Driver == sqliteDriver {
+		if len(g.QueryParams) > 0 {
+			return fmt.Sprintf("%s?%s", g.Database, g.QueryParams.Encode())
+		}
+		return g.Database
+	}
+	if g.Driver == duckdbDriver {
+		// an empty database opens an in-memory database
+		if len(g.QueryParams) > 0 {
+			return fmt.Sprintf("%s?%s", g.Database, g.QueryParams.Encode())
+		}
+		return g.Database
+	}
+	if g.Driver == snowflakeDriver {
+		// the snowflake driver query unescapes the user and password
+		dsn := fmt.Sprintf("%s:%s@%s/%s", url.QueryEscape(g.User), url.QueryEscape(g.Pass), g.Host, g.Database)
+		if len(g.QueryParams) > 0 {
+			dsn += "?" + g.QueryParams.Encode()
+		}
+		return dsn
+	}
+	if g.Driver == spannerDriver {
+		// the spanner driver separates its parameters with semicolons and does not unescape them
+		dsn := g.Database
+		if g.Host != "" {
+			dsn = fmt.Sprintf("%s:%d/%s", g.Host, g.Port, dsn)
+		}
+		if len(g.QueryParams) > 0 {
+			keys := make([]string, 0, len(g.QueryParams))
+			for key := range g.QueryParams {
+				keys = append(keys, key)
+			}
+			slices.Sort(keys)
+			params := make([]string, 0, len(keys))
+			for _, key := range keys {
+				params = append(params, fmt.Sprintf("%s=%s", key, g.QueryParams.Get(key)))
+			}
+			dsn += "?" + strings.Join(params, ";")
+		}
+		return dsn
+	}
+	if g.Driver == mssqlDriver {
+		// the database is passed as a query parameter as the path of the url is the name of the server instance
+		u := url.URL{
+			Scheme:   "sqlserver",
+			Host:     fmt.Sprintf("%s:%d", g.Host, g.Port),
+			User:     url.UserPassword(g.User, g.Pass),
+			RawQuery: g.QueryParams.Encode(),
+		}
+		return u.String()
+	}
 	return ""
 }
 
+func getGeneralDbConnectConfigFromSqlite(config *mgmtv1alpha1.ConnectionConfig_SqliteConfig, connectionTimeout *uint32) *GeneralDbConnectConfig {
+	query := url.Values{}
+	if connectionTimeout != nil {
+		// sqlite has no network connection, instead wait this long for a locked database file to become available
+		query.Add("_pragma", fmt.Sprintf("busy_timeout(%d)", *connectionTimeout*1000))
+	}
+	return &GeneralDbConnectConfig{
+		Driver:      sqliteDriver,
+		Database:    config.SqliteConfig.GetPath(),
+		QueryParams: query,
+	}
+}
+
+func getGeneralDbConnectConfigFromSnowflake(config *mgmtv1alpha1.ConnectionConfig_SnowflakeConfig, connectionTimeout *uint32) *GeneralDbConnectConfig {
+	query := url.Values{}
+	if config.SnowflakeConfig.Warehouse != nil {
+		query.Add("warehouse", config.SnowflakeConfig.GetWarehouse())
+	}
+	if config.SnowflakeConfig.Role != nil {
+		query.Add("role", config.SnowflakeConfig.GetRole())
+	}
+	if connectionTimeout != nil {
+		query.Add("loginTimeout", fmt.Sprintf("%d", *connectionTimeout))
+	}
+	return &GeneralDbConnectConfig{
+		Driver:      snowflakeDriver,
+		Host:        config.SnowflakeConfig.GetAccount(),
+		Database:    config.SnowflakeConfig.GetDatabase(),
+		User:        config.SnowflakeConfig.GetUser(),
+		Pass:        config.SnowflakeConfig.GetPass(),
+		QueryParams: query,
+	}
+}
+
+// DuckDB runs inside of the process so there is no connection to time out
+func getGeneralDbConnectConfigFromDuckdb(config *mgmtv1alpha1.ConnectionConfig_DuckdbConfig) *GeneralDbConnectConfig {
+	return &GeneralDbConnectConfig{
+		Driver:      duckdbDriver,
+		Database:    config.DuckdbConfig.GetPath(),
+		QueryParams: url.Values{},
+	}
+}
+
+// The spanner driver does not have a connect timeout, requests are bounded by the context they are made with instead
+func getGeneralDbConnectConfigFromSpanner(config *mgmtv1alpha1.ConnectionConfig_SpannerConfig) (*GeneralDbConnectConfig, error) {
+	query := url.Values{}
+	var host string
+	var port int32
+	if emulatorHost := config.SpannerConfig.GetEmulatorHost(); emulatorHost != "" {
+		h, p, err := net.SplitHostPort(emulatorHost)
+		if err != nil {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("spanner emulator host must be in the form of host:port: %s", err.Error()))
+		}
+		parsedPort, err := strconv.ParseInt(p, 10, 32)
+		if err != nil {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("spanner emulator port is not a valid number: %s", err.Error()))
+		}
+		host = h
+		port = int32(parsedPort)
+		query.Add("usePlainText", "true")
+	} else if key := config.SpannerConfig.GetServiceAccountKey(); key != "" {
+		// the driver only reads credentials from a file
+		keyFile, err := upsertSpannerCredentialsFile(key)
+		if err != nil {
+			return nil, err
+		}
+		query.Add("credentials", keyFile)
+	}
+	return &GeneralDbConnectConfig{
+		Driver: spannerDriver,
+		Host:   host,
+		Port:   port,
+		Database: fmt.Sprintf(
+			"projects/%s/instances/%s/databases/%s",
+			config.SpannerConfig.GetProjectId(),
+			config.SpannerConfig.GetInstanceId(),
+			config.SpannerConfig.GetDatabaseId(),
+		),
+		QueryParams: query,
+	}, nil
+}
+
+// Writes the service account key to a file named after the hash of its contents so that it is only written once
+func upsertSpannerCredentialsFile(key string) (string, error) {
+	fullpath := filepath.Join(os.TempDir(), fmt.Sprintf("%s.json", hashContent(key)))
+	_, err := os.Stat(fullpath)
+	if err != nil && !os.IsNotExist(err) {
+		return "", err
+	} else if err != nil && os.IsNotExist(err) {
+		if err := os.WriteFile(fullpath, []byte(key), 0600); err != nil {
+			return "", err
+		}
+	}
+	return fullpath, nil
+}
+
+// Redshift speaks the postgres protocol so the connection is opened with the postgres driver
+func getGeneralDbConnectConfigFromRedshift(config *mgmtv1alpha1.ConnectionConfig_RedshiftConfig, connectionTimeout *uint32) *GeneralDbConnectConfig {
+	query := url.Values{}
+	// redshift clusters require ssl by default
+	sslMode := "require"
+	if config.RedshiftConfig.SslMode != nil {
+		sslMode = config.RedshiftConfig.GetSslMode()
+	}
+	query.Add("sslmode", sslMode)
+	if connectionTimeout != nil {
+		query.Add("connect_timeout", fmt.Sprintf("%d", *connectionTimeout))
+	}
+	port := config.RedshiftConfig.GetPort()
+	if port == 0 {
+		port = 5439
+	}
+	return &GeneralDbConnectConfig{
+		Driver:      postgresDriver,
+		Host:        config.RedshiftConfig.GetHost(),
+		Port:        port,
+		Database:    config.RedshiftConfig.GetName(),
+		User:        config.RedshiftConfig.GetUser(),
+		Pass:        config.RedshiftConfig.GetPass(),
+		QueryParams: query,
+	}
+}
+
+func getGeneralDbConnectConfigFromMssql(config *mgmtv1alpha1.ConnectionConfig_MssqlConfig, connectionTimeout *uint32) *GeneralDbConnectConfig {
+	query := url.Values{}
+	query.Add("database", config.MssqlConfig.GetName())
+	if config.MssqlConfig.Encrypt != nil {
+		query.Add("encrypt", config.MssqlConfig.GetEncrypt())
+	}
+	if config.MssqlConfig.GetTrustServerCertificate() {
+		query.Add("TrustServerCertificate", "true")
+	}
+	if connectionTimeout != nil {
+		query.Add("dial timeout", fmt.Sprintf("%d", *connectionTimeout))
+	}
+	port := config.MssqlConfig.GetPort()
+	if port == 0 {
+		port = 1433
+	}
+	return &GeneralDbConnectConfig{
+		Driver:      mssqlDriver,
+		Host:        config.MssqlConfig.GetHost(),
+		Port:        port,
+		Database:    config.MssqlConfig.GetName(),
+		User:        config.MssqlConfig.GetUser(),
+		Pass:        config.MssqlConfig.GetPass(),
+		QueryParams: query,
+	}
+}
+
 func getGeneralDbConnectionConfigFromMysql(config *mgmtv1alpha1.ConnectionConfig_MysqlConfig, connectionTimeout *uint32) (*GeneralDbConnectConfig, error) {
 	switch cc := config.MysqlConfig.ConnectionConfig.(type) {
 	case *mgmtv1alpha1.MysqlConnectionConfig_Connection:
@@ -0,0 +1,357 @@
+package sqlmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+)
+
+// CockroachDB speaks the postgres wire protocol and exposes most of pg_catalog, but it does not support the recursive lateral
+// joins, pg_get_expr and pg_get_constraintdef that the postgres schema and constraint queries rely on, and it hides an implicit
+// rowid column on tables without a primary key. Everything that it does support is left to the postgres manager.
+type CockroachManager struct {
+	*PostgresManager
+}
+
+// information_schema is scoped to the current database in CockroachDB. format_type is still used for the data type so that it
+// matches what is returned for postgres
+const getCockroachDatabaseSchemaQuery = `SELECT c.table_schema, c.table_name, c.column_name, pg_catalog.format_type(a.atttypid, a.atttypmod),
+COALESCE(NULLIF(c.generation_expression, ''), c.column_default, ''), c.is_nullable,
+COALESCE(c.character_maximum_length, -1), COALESCE(c.numeric_precision, -1), COALESCE(c.numeric_scale, -1), c.ordinal_position,
+CASE WHEN c.is_generated = 'ALWAYS' THEN 's' ELSE '' END
+FROM information_schema.columns AS c
+JOIN information_schema.tables AS t ON t.table_schema = c.table_schema AND t.table_name = c.table_name
+JOIN pg_catalog.pg_namespace AS n ON n.nspname = c.table_schema
+JOIN pg_catalog.pg_class AS cl ON cl.relnamespace = n.oid AND cl.relname = c.table_name
+JOIN pg_catalog.pg_attribute AS a ON a.attrelid = cl.oid AND a.attname = c.column_name
+WHERE t.table_type = 'BASE TABLE' AND c.is_hidden = 'NO'
+AND c.table_schema NOT IN ('pg_catalog', 'information_schema', 'crdb_internal', 'pg_extension')
+ORDER BY c.table_schema, c.table_name, c.ordinal_position`
+
+func (c *CockroachManager) GetDatabaseSchema(ctx context.Context) ([]*DatabaseSchemaRow, error) {
+	rows, err := c.pool.Query(ctx, getCockroachDatabaseSchemaQuery)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return []*DatabaseSchemaRow{}, nil
+	}
+	defer rows.Close()
+
+	result := []*DatabaseSchemaRow{}
+	for rows.Next() {
+		row := &DatabaseSchemaRow{TableType: BaseTableType}
+		var generatedType string
+		if err := rows.Scan(
+			&row.TableSchema,
+			&row.TableName,
+			&row.ColumnName,
+			&row.DataType,
+			&row.ColumnDefault,
+			&row.IsNullable,
+			&row.CharacterMaximumLength,
+			&row.NumericPrecision,
+			&row.NumericScale,
+			&row.OrdinalPosition,
+			&generatedType,
+		); err != nil {
+			return nil, err
+		}
+		if generatedType != "" {
+			row.GeneratedType = &generatedType
+		}
+		result = append(result, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// returns: {public.users: { id: struct{}{}, created_at: struct{}{}}}
+func (c *CockroachManager) GetSchemaColumnMap(ctx context.Context) (map[string]map[string]*ColumnInfo, error) {
+	dbSchemas, err := c.GetDatabaseSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := GetUniqueSchemaColMappings(dbSchemas)
+	return result, nil
+}
+
+// The hidden rowid column is left out so that tables without a primary key are not reported as having one
+const getCockroachTableConstraintsQuery = `SELECT tc.constraint_type, tc.table_schema, tc.table_name, tc.constraint_name, kcu.column_name, c.is_nullable,
+COALESCE(rkcu.table_schema, ''), COALESCE(rkcu.table_name, ''), COALESCE(rkcu.column_name, '')
+FROM information_schema.table_constraints AS tc
+JOIN information_schema.key_column_usage AS kcu ON kcu.constraint_schema = tc.constraint_schema AND kcu.constraint_name = tc.constraint_name
+AND kcu.table_schema = tc.table_schema AND kcu.table_name = tc.table_name
+JOIN information_schema.columns AS c ON c.table_schema = kcu.table_schema AND c.table_name = kcu.table_name AND c.column_name = kcu.column_name
+LEFT JOIN information_schema.referential_constraints AS rc ON rc.constraint_schema = tc.constraint_schema AND rc.constraint_name = tc.constraint_name
+LEFT JOIN information_schema.key_column_usage AS rkcu ON rkcu.constraint_schema = rc.unique_constraint_schema
+AND rkcu.constraint_name = rc.unique_constraint_name AND rkcu.ordinal_position = kcu.position_in_unique_constraint
+WHERE tc.constraint_type IN ('PRIMARY KEY', 'FOREIGN KEY', 'UNIQUE') AND tc.table_schema = ANY($1) AND c.is_hidden = 'NO'
+ORDER BY tc.table_schema, tc.table_name, tc.constraint_name, kcu.ordinal_position`
+
+func (c *CockroachManager) getTableConstraints(ctx context.Context, schemas []string) ([]*informationSchemaConstraint, error) {
+	rows, err := c.pool.Query(ctx, getCockroachTableConstraintsQuery, schemas)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return []*informationSchemaConstraint{}, nil
+	}
+	defer rows.Close()
+
+	constraintRows := []*informationSchemaConstraintRow{}
+	for rows.Next() {
+		row := &informationSchemaConstraintRow{}
+		if err := rows.Scan(
+			&row.ConstraintType,
+			&row.SchemaName,
+			&row.TableName,
+			&row.ConstraintName,
+			&row.ColumnName,
+			&row.IsNullable,
+			&row.ForeignSchemaName,
+			&row.ForeignTableName,
+			&row.ForeignColumnName,
+		); err != nil {
+			return nil, err
+		}
+		constraintRows = append(constraintRows, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return groupInformationSchemaConstraintRows(constraintRows), nil
+}
+
+func (c *CockroachManager) GetTableConstraintsBySchema(ctx context.Context, schemas []string) (*TableConstraints, error) {
+	if len(schemas) == 0 {
+		return &TableConstraints{}, nil
+	}
+	constraints, err := c.getTableConstraints(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	return buildInformationSchemaTableConstraints(constraints)
+}
+
+func (c *CockroachManager) GetForeignKeyConstraints(ctx context.Context, schemas []string) ([]*ForeignKeyConstraintsRow, error) {
+	if len(schemas) == 0 {
+		return []*ForeignKeyConstraintsRow{}, nil
+	}
+	constraints, err := c.getTableConstraints(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	return buildInformationSchemaForeignKeyRows(constraints)
+}
+
+// Key is schema.table value is list of tables that key depends on
+func (c *CockroachManager) GetForeignKeyConstraintsMap(ctx context.Context, schemas []string) (map[string][]*ForeignConstraint, error) {
+	if len(schemas) == 0 {
+		return map[string][]*ForeignConstraint{}, nil
+	}
+	constraints, err := c.GetTableConstraintsBySchema(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	return constraints.ForeignKeyConstraints, nil
+}
+
+func (c *CockroachManager) GetPrimaryKeyConstraints(ctx context.Context, schemas []string) ([]*PrimaryKey, error) {
+	if len(schemas) == 0 {
+		return []*PrimaryKey{}, nil
+	}
+	constraints, err := c.getTableConstraints(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	return buildInformationSchemaPrimaryKeys(constraints), nil
+}
+
+func (c *CockroachManager) GetPrimaryKeyConstraintsMap(ctx context.Context, schemas []string) (map[string][]string, error) {
+	if len(schemas) == 0 {
+		return map[string][]string{}, nil
+	}
+	constraints, err := c.GetTableConstraintsBySchema(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	return constraints.PrimaryKeyConstraints, nil
+}
+
+func (c *CockroachManager) GetUniqueConstraintsMap(ctx context.Context, schemas []string) (map[string][][]string, error) {
+	if len(schemas) == 0 {
+		return map[string][][]string{}, nil
+	}
+	constraints, err := c.GetTableConstraintsBySchema(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	return constraints.UniqueConstraints, nil
+}
+
+// The predicate of partial indexes is only available through SHOW CREATE, so they are carried over by the create statement
+// instead of being reported here
+func (c *CockroachManager) GetPartialUniqueIndexesMap(ctx context.Context, schemas []string) (map[string][]*PartialUniqueIndex, error) {
+	return map[string][]*PartialUniqueIndex{}, nil
+}
+
+// CockroachDB does not support exclusion constraints
+func (c *CockroachManager) GetExclusionConstraintsMap(ctx context.Context, schemas []string) (map[string][]*ExclusionConstraint, error) {
+	return map[string][]*ExclusionConstraint{}, nil
+}
+
+var cockroachCreateTableRegex = regexp.MustCompile(`(?i)^\s*CREATE\s+TABLE\s+(IF\s+NOT\s+EXISTS\s+)?`)
+var cockroachAddConstraintRegex = regexp.MustCompile(`(?i)\s+ADD\s+CONSTRAINT\s+(IF\s+NOT\s+EXISTS\s+)?`)
+
+const getCockroachCreateStatementQuery = `SELECT create_statement
+FROM crdb_internal.create_statements
+WHERE database_name = current_database() AND descriptor_type = 'table' AND schema_name = $1 AND descriptor_name = $2`
+
+// crdb_internal.create_statements holds the same definition as SHOW CREATE TABLE, which includes the indexes, column families
+// and constraints of the table
+func (c *CockroachManager) GetCreateTableStatement(ctx context.Context, schema, table string) (string, error) {
+	var createSql string
+	err := c.pool.QueryRow(ctx, getCockroachCreateStatementQuery, schema, table).Scan(&createSql)
+	if err != nil {
+		return "", fmt.Errorf("unable to get table create statement: %w", err)
+	}
+	return getCockroachCreateTableStatement(createSql), nil
+}
+
+func getCockroachCreateTableStatement(ddl string) string {
+	stmt := cockroachCreateTableRegex.ReplaceAllString(strings.TrimSpace(ddl), "CREATE TABLE IF NOT EXISTS ")
+	return fmt.Sprintf("%s;", strings.TrimSuffix(stmt, ";"))
+}
+
+func getCockroachAlterTableStatement(ddl string) string {
+	stmt := cockroachAddConstraintRegex.ReplaceAllString(strings.TrimSpace(ddl), " ADD CONSTRAINT IF NOT EXISTS ")
+	return fmt.Sprintf("%s;", strings.TrimSuffix(stmt, ";"))
+}
+
+// create_nofks leaves out foreign keys so that tables can be created in any order. They are added afterwards by the alter statements,
+// which are followed by the statements that validate them
+const getCockroachTableInitStatementsQuery = `SELECT schema_name, descriptor_name, create_nofks, alter_statements, validate_statements
+FROM crdb_internal.create_statements
+WHERE database_name = current_database() AND descriptor_type = 'table' AND (schema_name || '.' || descriptor_name) = ANY($1)`
+
+// Destination table and column overrides are not supported as the statements come from cockroachdb as is
+func (c *CockroachManager) GetTableInitStatements(ctx context.Context, tables []*SchemaTable, opts *TableInitStatementOpts) ([]*TableInitStatement, error) {
+	if opts != nil && (len(opts.DestinationTables) > 0 || len(opts.DestinationColumns) > 0) {
+		return nil, fmt.Errorf("cockroachdb table init statements with destination overrides: %w", errors.ErrUnsupported)
+	}
+	if len(tables) == 0 {
+		return []*TableInitStatement{}, nil
+	}
+	tableKeys := make([]string, 0, len(tables))
+	for _, table := range tables {
+		tableKeys = append(tableKeys, table.String())
+	}
+
+	rows, err := c.pool.Query(ctx, getCockroachTableInitStatementsQuery, tableKeys)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	statementMap := map[string]*TableInitStatement{}
+	for rows.Next() {
+		var schema, table, createSql string
+		var alterSqls, validateSqls []string
+		if err := rows.Scan(&schema, &table, &createSql, &alterSqls, &validateSqls); err != nil {
+			return nil, err
+		}
+		alters := make([]*AlterTableStatement, 0, len(alterSqls)+len(validateSqls))
+		for _, stmt := range alterSqls {
+			alters = append(alters, &AlterTableStatement{
+				Statement:      getCockroachAlterTableStatement(stmt),
+				ConstraintType: ForeignConstraintType,
+			})
+		}
+		for _, stmt := range validateSqls {
+			alters = append(alters, &AlterTableStatement{
+				Statement:      fmt.Sprintf("%s;", strings.TrimSuffix(strings.TrimSpace(stmt), ";")),
+				ConstraintType: ForeignConstraintType,
+			})
+		}
+		statementMap[BuildTable(schema, table)] = &TableInitStatement{
+			CreateTableStatement: getCockroachCreateTableStatement(createSql),
+			AlterTableStatements: alters,
+			IndexStatements:      []string{},
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	output := make([]*TableInitStatement, 0, len(tables))
+	for _, table := range tables {
+		stmt, ok := statementMap[table.String()]
+		if !ok {
+			return nil, fmt.Errorf("unable to find create statement for table %s", table.String())
+		}
+		output = append(output, stmt)
+	}
+	return output, nil
+}
+
+// CockroachDB runs schema changes asynchronously and does not support every one of them inside of a multi statement transaction,
+// so statements are run one at a time
+func (c *CockroachManager) BatchExec(ctx context.Context, batchSize int, statements []string, opts *BatchExecOpts) error {
+	for _, statement := range statements {
+		if opts != nil && opts.Prefix != nil && *opts.Prefix != "" {
+			statement = fmt.Sprintf("%s %s", *opts.Prefix, statement)
+		}
+		_, err := c.pool.Exec(ctx, statement)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CockroachDB does not report the size of a table on disk, so only the row count estimate from the table statistics is returned
+const getCockroachTableSizeEstimatesQuery = `SELECT t.schema_name, t.name, COALESCE(s.estimated_row_count, 0)::INT8, 0::INT8
+FROM crdb_internal.tables AS t
+LEFT JOIN crdb_internal.table_row_statistics AS s ON s.table_id = t.table_id
+WHERE t.database_name = current_database() AND t.drop_time IS NULL AND (t.schema_name || '.' || t.name) = ANY($1)
+ORDER BY t.schema_name, t.name`
+
+func (c *CockroachManager) GetTableSizeEstimates(ctx context.Context, tables []*SchemaTable) ([]*TableSizeEstimate, error) {
+	if len(tables) == 0 {
+		return []*TableSizeEstimate{}, nil
+	}
+	tableKeys := make([]string, 0, len(tables))
+	for _, table := range tables {
+		tableKeys = append(tableKeys, BuildTable(table.Schema, table.Table))
+	}
+	rows, err := c.pool.Query(ctx, getCockroachTableSizeEstimatesQuery, tableKeys)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return []*TableSizeEstimate{}, nil
+	}
+	defer rows.Close()
+
+	estimates := []*TableSizeEstimate{}
+	for rows.Next() {
+		estimate := &TableSizeEstimate{}
+		if err := rows.Scan(&estimate.Schema, &estimate.Table, &estimate.RowCount, &estimate.SizeBytes); err != nil {
+			return nil, err
+		}
+		estimates = append(estimates, estimate)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return estimates, nil
+}
+
+// The extensions that CockroachDB supports are built in and can't be created
+func (c *CockroachManager) GetExtensions(ctx context.Context) ([]*Extension, error) {
+	return []*Extension{}, nil
+}
@@ -0,0 +1,62 @@
+package sqlmanager
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	pg_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/postgresql"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getCockroachCreateTableStatement(t *testing.T) {
+	require.Equal(
+		t,
+		"CREATE TABLE IF NOT EXISTS public.users (\n\tid INT8 NOT NULL,\n\tCONSTRAINT users_pkey PRIMARY KEY (id ASC)\n);",
+		getCockroachCreateTableStatement("CREATE TABLE public.users (\n\tid INT8 NOT NULL,\n\tCONSTRAINT users_pkey PRIMARY KEY (id ASC)\n)"),
+	)
+	require.Equal(
+		t,
+		"CREATE TABLE IF NOT EXISTS public.events (id INT8);",
+		getCockroachCreateTableStatement("create table if not exists public.events (id INT8);"),
+	)
+}
+
+func Test_getCockroachAlterTableStatement(t *testing.T) {
+	require.Equal(
+		t,
+		"ALTER TABLE public.orders ADD CONSTRAINT IF NOT EXISTS orders_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id);",
+		getCockroachAlterTableStatement("ALTER TABLE public.orders ADD CONSTRAINT orders_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id)"),
+	)
+	require.Equal(
+		t,
+		"ALTER TABLE public.orders ADD CONSTRAINT IF NOT EXISTS orders_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id);",
+		getCockroachAlterTableStatement("ALTER TABLE public.orders ADD CONSTRAINT IF NOT EXISTS orders_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id);"),
+	)
+}
+
+func Test_CockroachManager_GetTableInitStatements_DestinationOverrides(t *testing.T) {
+	manager := &CockroachManager{PostgresManager: &PostgresManager{pool: pg_queries.NewMockDBTX(t)}}
+
+	_, err := manager.GetTableInitStatements(context.Background(), []*SchemaTable{{Schema: "public", Table: "users"}}, &TableInitStatementOpts{
+		DestinationTables: map[string]*SchemaTable{"public.users": {Schema: "public", Table: "accounts"}},
+	})
+	require.True(t, errors.Is(err, errors.ErrUnsupported))
+}
+
+func Test_CockroachManager_BatchExec(t *testing.T) {
+	mockPool := pg_queries.NewMockDBTX(t)
+	manager := &CockroachManager{PostgresManager: &PostgresManager{pool: mockPool}}
+
+	var cmdtag pgconn.CommandTag
+	mockPool.On("Exec", mock.Anything, "CREATE TABLE IF NOT EXISTS public.users (id INT8);").Return(cmdtag, nil).Once()
+	mockPool.On("Exec", mock.Anything, "CREATE TABLE IF NOT EXISTS public.accounts (id INT8);").Return(cmdtag, nil).Once()
+
+	err := manager.BatchExec(context.Background(), 10, []string{
+		"CREATE TABLE IF NOT EXISTS public.users (id INT8);",
+		"CREATE TABLE IF NOT EXISTS public.accounts (id INT8);",
+	}, &BatchExecOpts{})
+	require.NoError(t, err)
+}
@@ -0,0 +1,673 @@
+package sqlmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
+)
+
+// DuckDB runs inside of the API and Worker nodes. Along with the tables of the database file, the Parquet files of the
+// connection's parquet directory are exposed as views, which are treated as tables as they have no definition to sync
+type DuckdbManager struct {
+	pool  sqlconnect.SqlDBTX
+	close func()
+
+	// the schema.table of every view that reads from the parquet directory
+	parquetTables map[string]struct{}
+}
+
+const duckdbParquetExtension = ".parquet"
+
+// Only the database that was opened is described, and not any that were attached to it
+const getDuckdbDatabaseSchemaQuery = `SELECT c.table_schema, c.table_name, c.column_name, c.data_type, COALESCE(c.column_default, ''), c.is_nullable,
+COALESCE(c.character_maximum_length, -1), COALESCE(c.numeric_precision, -1), COALESCE(c.numeric_scale, -1), c.ordinal_position, t.table_type
+FROM information_schema.columns AS c
+JOIN information_schema.tables AS t ON t.table_catalog = c.table_catalog AND t.table_schema = c.table_schema AND t.table_name = c.table_name
+WHERE c.table_catalog = current_database() AND c.table_schema NOT IN ('information_schema', 'pg_catalog')
+ORDER BY c.table_schema, c.table_name, c.ordinal_position`
+
+func (d *DuckdbManager) GetDatabaseSchema(ctx context.Context) ([]*DatabaseSchemaRow, error) {
+	rows, err := d.pool.QueryContext(ctx, getDuckdbDatabaseSchemaQuery)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return []*DatabaseSchemaRow{}, nil
+	}
+	defer rows.Close()
+
+	result := []*DatabaseSchemaRow{}
+	for rows.Next() {
+		row := &DatabaseSchemaRow{}
+		if err := rows.Scan(
+			&row.TableSchema,
+			&row.TableName,
+			&row.ColumnName,
+			&row.DataType,
+			&row.ColumnDefault,
+			&row.IsNullable,
+			&row.CharacterMaximumLength,
+			&row.NumericPrecision,
+			&row.NumericScale,
+			&row.OrdinalPosition,
+			&row.TableType,
+		); err != nil {
+			return nil, err
+		}
+		if row.TableType != BaseTableType {
+			if !d.isParquetTable(row.TableSchema, row.TableName) {
+				continue
+			}
+			row.TableType = BaseTableType
+		}
+		row.DataType = strings.ToLower(row.DataType)
+		result = append(result, row)
+	}
+	return result, rows.Err()
+}
+
+func (d *DuckdbManager) isParquetTable(schema, table string) bool {
+	_, ok := d.parquetTables[BuildTable(schema, table)]
+	return ok
+}
+
+// returns: {public.users: { id: struct{}{}, created_at: struct{}{}}}
+func (d *DuckdbManager) GetSchemaColumnMap(ctx context.Context) (map[string]map[string]*ColumnInfo, error) {
+	dbSchemas, err := d.GetDatabaseSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := GetUniqueSchemaColMappings(dbSchemas)
+	return result, nil
+}
+
+const getDuckdbViewColumnsQuery = `SELECT c.table_schema, c.table_name, c.column_name, c.data_type, c.is_nullable, c.ordinal_position
+FROM information_schema.columns AS c
+JOIN information_schema.tables AS t ON t.table_catalog = c.table_catalog AND t.table_schema = c.table_schema AND t.table_name = c.table_name
+WHERE t.table_type = 'VIEW' AND c.table_catalog = current_database() AND c.table_schema NOT IN ('information_schema', 'pg_catalog')
+ORDER BY c.table_schema, c.table_name, c.ordinal_position`
+
+// Returns the columns of every view, keyed by schema.table and then column. The views over the parquet directory are left out
+// as they are returned as tables
+func (d *DuckdbManager) GetViewColumnMap(ctx context.Context) (map[string]map[string]*ColumnInfo, error) {
+	rows, err := d.pool.QueryContext(ctx, getDuckdbViewColumnsQuery)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return map[string]map[string]*ColumnInfo{}, nil
+	}
+	defer rows.Close()
+
+	output := map[string]map[string]*ColumnInfo{}
+	for rows.Next() {
+		var schema, view, column, dataType, isNullable string
+		var ordinalPosition int32
+		if err := rows.Scan(&schema, &view, &column, &dataType, &isNullable, &ordinalPosition); err != nil {
+			return nil, err
+		}
+		if d.isParquetTable(schema, view) {
+			continue
+		}
+		key := BuildTable(schema, view)
+		if _, ok := output[key]; !ok {
+			output[key] = map[string]*ColumnInfo{}
+		}
+		output[key][column] = &ColumnInfo{
+			OrdinalPosition: ordinalPosition,
+			IsNullable:      isNullable == "YES",
+			DataType:        strings.ToLower(dataType),
+			TableType:       ViewTableType,
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+const getDuckdbTableConstraintsQuery = `SELECT tc.constraint_type, tc.table_schema, tc.table_name, tc.constraint_name, kcu.column_name, c.is_nullable,
+COALESCE(rkcu.table_schema, ''), COALESCE(rkcu.table_name, ''), COALESCE(rkcu.column_name, '')
+FROM information_schema.table_constraints AS tc
+JOIN information_schema.key_column_usage AS kcu ON kcu.constraint_schema = tc.constraint_schema AND kcu.constraint_name = tc.constraint_name
+AND kcu.table_schema = tc.table_schema AND kcu.table_name = tc.table_name
+JOIN information_schema.columns AS c ON c.table_schema = kcu.table_schema AND c.table_name = kcu.table_name AND c.column_name = kcu.column_name
+LEFT JOIN information_schema.referential_constraints AS rc ON rc.constraint_schema = tc.constraint_schema AND rc.constraint_name = tc.constraint_name
+LEFT JOIN information_schema.key_column_usage AS rkcu ON rkcu.constraint_schema = rc.unique_constraint_schema
+AND rkcu.constraint_name = rc.unique_constraint_name AND rkcu.ordinal_position = kcu.ordinal_position
+WHERE tc.constraint_type IN ('PRIMARY KEY', 'FOREIGN KEY', 'UNIQUE') AND tc.table_catalog = current_database() AND tc.table_schema IN (%s)
+ORDER BY tc.table_schema, tc.table_name, tc.constraint_name, kcu.ordinal_position`
+
+func (d *DuckdbManager) getTableConstraints(ctx context.Context, schemas []string) ([]*informationSchemaConstraint, error) {
+	args := make([]any, 0, len(schemas))
+	placeholders := make([]string, 0, len(schemas))
+	for _, schema := range schemas {
+		args = append(args, schema)
+		placeholders = append(placeholders, "?")
+	}
+	query := fmt.Sprintf(getDuckdbTableConstraintsQuery, strings.Join(placeholders, ", "))
+	rows, err := d.pool.QueryContext(ctx, query, args...)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return []*informationSchemaConstraint{}, nil
+	}
+	defer rows.Close()
+
+	constraintRows := []*informationSchemaConstraintRow{}
+	for rows.Next() {
+		row := &informationSchemaConstraintRow{}
+		if err := rows.Scan(
+			&row.ConstraintType,
+			&row.SchemaName,
+			&row.TableName,
+			&row.ConstraintName,
+			&row.ColumnName,
+			&row.IsNullable,
+			&row.ForeignSchemaName,
+			&row.ForeignTableName,
+			&row.ForeignColumnName,
+		); err != nil {
+			return nil, err
+		}
+		constraintRows = append(constraintRows, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return groupInformationSchemaConstraintRows(constraintRows), nil
+}
+
+// Parquet files have no constraints, so only the constraints of the tables of the database file are returned
+func (d *DuckdbManager) GetTableConstraintsBySchema(ctx context.Context, schemas []string) (*TableConstraints, error) {
+	if len(schemas) == 0 {
+		return &TableConstraints{}, nil
+	}
+	constraints, err := d.getTableConstraints(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	return buildInformationSchemaTableConstraints(constraints)
+}
+
+func (d *DuckdbManager) GetForeignKeyConstraints(ctx context.Context, schemas []string) ([]*ForeignKeyConstraintsRow, error) {
+	if len(schemas) == 0 {
+		return []*ForeignKeyConstraintsRow{}, nil
+	}
+	constraints, err := d.getTableConstraints(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	return buildInformationSchemaForeignKeyRows(constraints)
+}
+
+// Key is schema.table value is list of tables that key depends on
+func (d *DuckdbManager) GetForeignKeyConstraintsMap(ctx context.Context, schemas []string) (map[string][]*ForeignConstraint, error) {
+	if len(schemas) == 0 {
+		return map[string][]*ForeignConstraint{}, nil
+	}
+	constraints, err := d.GetTableConstraintsBySchema(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	return constraints.ForeignKeyConstraints, nil
+}
+
+func (d *DuckdbManager) GetPrimaryKeyConstraints(ctx context.Context, schemas []string) ([]*PrimaryKey, error) {
+	if len(schemas) == 0 {
+		return []*PrimaryKey{}, nil
+	}
+	constraints, err := d.getTableConstraints(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	return buildInformationSchemaPrimaryKeys(constraints), nil
+}
+
+func (d *DuckdbManager) GetPrimaryKeyConstraintsMap(ctx context.Context, schemas []string) (map[string][]string, error) {
+	if len(schemas) == 0 {
+		return map[string][]string{}, nil
+	}
+	constraints, err := d.GetTableConstraintsBySchema(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	return constraints.PrimaryKeyConstraints, nil
+}
+
+func (d *DuckdbManager) GetUniqueConstraintsMap(ctx context.Context, schemas []string) (map[string][][]string, error) {
+	if len(schemas) == 0 {
+		return map[string][][]string{}, nil
+	}
+	constraints, err := d.GetTableConstraintsBySchema(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	return constraints.UniqueConstraints, nil
+}
+
+// DuckDB does not support partial indexes
+func (d *DuckdbManager) GetPartialUniqueIndexesMap(ctx context.Context, schemas []string) (map[string][]*PartialUniqueIndex, error) {
+	return map[string][]*PartialUniqueIndex{}, nil
+}
+
+// DuckDB does not support exclusion constraints
+func (d *DuckdbManager) GetExclusionConstraintsMap(ctx context.Context, schemas []string) (map[string][]*ExclusionConstraint, error) {
+	return map[string][]*ExclusionConstraint{}, nil
+}
+
+const getDuckdbTablesQuery = `SELECT table_schema, table_name, table_type
+FROM information_schema.tables
+WHERE table_catalog = current_database() AND table_schema NOT IN ('information_schema', 'pg_catalog')
+ORDER BY table_schema, table_name`
+
+// DuckDB has no roles, access is determined by the permissions of the database file so every table is returned with full privileges
+func (d *DuckdbManager) GetRolePermissionsMap(ctx context.Context, role string) (map[string][]string, error) {
+	rows, err := d.pool.QueryContext(ctx, getDuckdbTablesQuery)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return map[string][]string{}, nil
+	}
+	defer rows.Close()
+
+	schemaTablePrivsMap := map[string][]string{}
+	for rows.Next() {
+		var schema, table, tableType string
+		if err := rows.Scan(&schema, &table, &tableType); err != nil {
+			return nil, err
+		}
+		if tableType != BaseTableType && !d.isParquetTable(schema, table) {
+			continue
+		}
+		schemaTablePrivsMap[BuildTable(schema, table)] = []string{"SELECT", "INSERT", "UPDATE", "DELETE"}
+	}
+	return schemaTablePrivsMap, rows.Err()
+}
+
+// Infers the columns of a virtual table by running its query without returning any rows
+func (d *DuckdbManager) GetQueryColumns(ctx context.Context, query string) (map[string]*ColumnInfo, error) {
+	sql, err := BuildVirtualTableSelectQuery(DuckdbDriver, query, nil)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := d.pool.QueryContext(ctx, fmt.Sprintf("%s LIMIT 0", sql))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	columns := map[string]*ColumnInfo{}
+	for idx, columnType := range columnTypes {
+		if _, ok := columns[columnType.Name()]; ok {
+			return nil, fmt.Errorf("query returns more than one column named %s", columnType.Name())
+		}
+		// the duckdb driver does not report nullability so every column is treated as nullable
+		columns[columnType.Name()] = &ColumnInfo{
+			OrdinalPosition: int32(idx + 1),
+			IsNullable:      true,
+			DataType:        strings.ToLower(columnType.DatabaseTypeName()),
+			TableType:       VirtualTableType,
+		}
+	}
+	return columns, rows.Err()
+}
+
+var duckdbCreateTableRegex = regexp.MustCompile(`(?i)^\s*CREATE\s+TABLE\s+`)
+
+const getDuckdbCreateTableQuery = `SELECT sql FROM duckdb_tables() WHERE database_name = current_database() AND schema_name = ? AND table_name = ?`
+
+// The parquet views have no table definition so their create statement is built from their columns
+func (d *DuckdbManager) GetCreateTableStatement(ctx context.Context, schema, table string) (string, error) {
+	if d.isParquetTable(schema, table) {
+		columnMap, err := d.GetSchemaColumnMap(ctx)
+		if err != nil {
+			return "", err
+		}
+		return BuildCreateTableFromColumns(DuckdbDriver, schema, table, columnMap[BuildTable(schema, table)], nil), nil
+	}
+
+	var createSql string
+	err := d.pool.QueryRowContext(ctx, getDuckdbCreateTableQuery, schema, table).Scan(&createSql)
+	if err != nil {
+		return "", fmt.Errorf("unable to get table create statement: %w", err)
+	}
+	return getDuckdbCreateTableStatement(createSql), nil
+}
+
+func getDuckdbCreateTableStatement(ddl string) string {
+	stmt := duckdbCreateTableRegex.ReplaceAllString(strings.TrimSpace(ddl), "CREATE TABLE IF NOT EXISTS ")
+	return fmt.Sprintf("%s;", strings.TrimSuffix(stmt, ";"))
+}
+
+// Destination table and column overrides are not supported as the create statement comes from duckdb as is
+func (d *DuckdbManager) GetTableInitStatements(ctx context.Context, tables []*SchemaTable, opts *TableInitStatementOpts) ([]*TableInitStatement, error) {
+	if opts != nil && (len(opts.DestinationTables) > 0 || len(opts.DestinationColumns) > 0) {
+		return nil, fmt.Errorf("duckdb table init statements with destination overrides: %w", errors.ErrUnsupported)
+	}
+	output := []*TableInitStatement{}
+	for _, schematable := range tables {
+		createStmt, err := d.GetCreateTableStatement(ctx, schematable.Schema, schematable.Table)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, &TableInitStatement{
+			CreateTableStatement: createStmt,
+			AlterTableStatements: []*AlterTableStatement{},
+			IndexStatements:      []string{},
+		})
+	}
+	return output, nil
+}
+
+func (d *DuckdbManager) BatchExec(ctx context.Context, batchSize int, statements []string, opts *BatchExecOpts) error {
+	for _, statement := range statements {
+		if opts != nil && opts.Prefix != nil && *opts.Prefix != "" {
+			statement = fmt.Sprintf("%s %s", *opts.Prefix, statement)
+		}
+		_, err := d.pool.ExecContext(ctx, statement)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *DuckdbManager) GetTableRowCount(
+	ctx context.Context,
+	schema, table string,
+	whereClause *string,
+) (int64, error) {
+	builder := goqu.Dialect(DuckdbDriver)
+	query := builder.From(goquTable(schema, table)).Select(goqu.COUNT("*"))
+	if whereClause != nil && *whereClause != "" {
+		query = query.Where(goqu.L(*whereClause))
+	}
+	sql, _, err := query.ToSQL()
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = d.pool.QueryRowContext(ctx, sql).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (d *DuckdbManager) GetColumnDistinctCount(
+	ctx context.Context,
+	schema, table, column string,
+) (int64, error) {
+	sql, err := buildColumnDistinctCountQuery(DuckdbDriver, schema, table, column)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = d.pool.QueryRowContext(ctx, sql).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// DuckDB does not have materialized views
+func (d *DuckdbManager) GetMaterializedViews(ctx context.Context) ([]*MaterializedView, error) {
+	return []*MaterializedView{}, nil
+}
+
+// DuckDB does not have triggers
+func (d *DuckdbManager) GetEnabledTableTriggers(ctx context.Context, tables []*SchemaTable) ([]*TableTrigger, error) {
+	return []*TableTrigger{}, nil
+}
+
+// Counting the rows of a duckdb table or parquet file only reads its metadata, so the exact count is returned
+func (d *DuckdbManager) GetTableSizeEstimates(ctx context.Context, tables []*SchemaTable) ([]*TableSizeEstimate, error) {
+	estimates := []*TableSizeEstimate{}
+	for _, table := range tables {
+		count, err := d.GetTableRowCount(ctx, table.Schema, table.Table, nil)
+		if err != nil {
+			return nil, err
+		}
+		estimates = append(estimates, &TableSizeEstimate{
+			Schema:   table.Schema,
+			Table:    table.Table,
+			RowCount: count,
+		})
+	}
+	return estimates, nil
+}
+
+// DuckDB extensions are installed into the node that runs duckdb and not into the database
+func (d *DuckdbManager) GetExtensions(ctx context.Context) ([]*Extension, error) {
+	return []*Extension{}, nil
+}
+
+// Superuser rights are only needed to create postgres extensions
+func (d *DuckdbManager) IsSuperuser(ctx context.Context) (bool, error) {
+	return false, nil
+}
+
+func (d *DuckdbManager) GetNumericColumnSample(
+	ctx context.Context,
+	schema, table, column string,
+	limit uint,
+) ([]float64, error) {
+	sql, err := buildNumericColumnSampleQuery(DuckdbDriver, schema, table, column, limit)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := d.pool.QueryContext(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	values := []float64{}
+	for rows.Next() {
+		var value float64
+		if err := rows.Scan(&value); err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, rows.Err()
+}
+
+func (d *DuckdbManager) GetTableRowSample(
+	ctx context.Context,
+	schema, table string,
+	columns []string,
+	limit uint,
+) ([][]*string, error) {
+	sql, err := buildTableRowSampleQuery(DuckdbDriver, schema, table, columns, limit)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := d.pool.QueryContext(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	output := [][]*string{}
+	for rows.Next() {
+		row := make([]*string, len(columns))
+		dest := make([]any, len(columns))
+		for idx := range row {
+			dest[idx] = &row[idx]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		output = append(output, row)
+	}
+	return output, rows.Err()
+}
+
+func (d *DuckdbManager) GetEquivalenceClasses(
+	ctx context.Context,
+	schema, table string,
+	quasiIdentifiers []string,
+	sensitiveColumn *string,
+) ([]*EquivalenceClass, error) {
+	sql, err := buildEquivalenceClassQuery(DuckdbDriver, schema, table, quasiIdentifiers, sensitiveColumn)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := d.pool.QueryContext(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	output := []*EquivalenceClass{}
+	for rows.Next() {
+		class := &EquivalenceClass{Values: make([]*string, len(quasiIdentifiers))}
+		dest := make([]any, 0, len(quasiIdentifiers)+2)
+		for idx := range class.Values {
+			dest = append(dest, &class.Values[idx])
+		}
+		dest = append(dest, &class.Size)
+		if sensitiveColumn != nil {
+			dest = append(dest, &class.DistinctSensitiveValues)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		output = append(output, class)
+	}
+	return output, rows.Err()
+}
+
+func (d *DuckdbManager) GetForeignKeyOrphanCount(
+	ctx context.Context,
+	schema, table string,
+	constraint *ForeignConstraint,
+) (int64, error) {
+	sql, err := buildForeignKeyOrphanCountQuery(DuckdbDriver, schema, table, constraint)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = d.pool.QueryRowContext(ctx, sql).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (d *DuckdbManager) Exec(ctx context.Context, statement string) error {
+	_, err := d.pool.ExecContext(ctx, statement)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DuckdbManager) Close() {
+	if d.pool != nil && d.close != nil {
+		d.close()
+	}
+}
+
+// Returns the directory that the parquet files of the table are written to
+func GetDuckdbParquetTableDirectory(parquetDirectory, schema, table string) string {
+	return filepath.Join(parquetDirectory, schema, table)
+}
+
+// A table of the parquet directory along with the files that it is read from
+type duckdbParquetTable struct {
+	Schema string
+	Table  string
+	// a single parquet file, or a glob of every parquet file of the table's directory
+	Path string
+}
+
+// Finds the tables of the parquet directory, which is laid out as <schema>/<table>, where each table is either a parquet file
+// or a directory of parquet files. Directories without parquet files are skipped as duckdb is unable to read them
+func getDuckdbParquetTables(parquetDirectory string) ([]*duckdbParquetTable, error) {
+	schemaEntries, err := os.ReadDir(parquetDirectory)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read parquet directory: %w", err)
+	}
+
+	output := []*duckdbParquetTable{}
+	for _, schemaEntry := range schemaEntries {
+		if !schemaEntry.IsDir() || strings.HasPrefix(schemaEntry.Name(), ".") {
+			continue
+		}
+		schemaDirectory := filepath.Join(parquetDirectory, schemaEntry.Name())
+		tableEntries, err := os.ReadDir(schemaDirectory)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read parquet directory: %w", err)
+		}
+		seen := map[string]struct{}{}
+		for _, tableEntry := range tableEntries {
+			var table, path string
+			if tableEntry.IsDir() {
+				files, err := filepath.Glob(filepath.Join(schemaDirectory, tableEntry.Name(), "*"+duckdbParquetExtension))
+				if err != nil {
+					return nil, err
+				}
+				if len(files) == 0 {
+					continue
+				}
+				table = tableEntry.Name()
+				path = filepath.Join(schemaDirectory, tableEntry.Name(), "*"+duckdbParquetExtension)
+			} else if strings.EqualFold(filepath.Ext(tableEntry.Name()), duckdbParquetExtension) {
+				table = strings.TrimSuffix(tableEntry.Name(), filepath.Ext(tableEntry.Name()))
+				path = filepath.Join(schemaDirectory, tableEntry.Name())
+			} else {
+				continue
+			}
+			if _, ok := seen[table]; ok {
+				return nil, fmt.Errorf("parquet table %s is both a file and a directory", BuildTable(schemaEntry.Name(), table))
+			}
+			seen[table] = struct{}{}
+			output = append(output, &duckdbParquetTable{Schema: schemaEntry.Name(), Table: table, Path: path})
+		}
+	}
+	return output, nil
+}
+
+// Files with differing columns are combined by column name, with the columns that a file does not have read as null
+func buildDuckdbParquetViewStatements(table *duckdbParquetTable) []string {
+	return []string{
+		fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s;", QuoteIdentifier(DuckdbDriver, table.Schema)),
+		fmt.Sprintf(
+			"CREATE OR REPLACE VIEW %s AS SELECT * FROM read_parquet('%s', union_by_name = true);",
+			QuoteTable(DuckdbDriver, table.Schema, table.Table),
+			strings.ReplaceAll(table.Path, "'", "''"),
+		),
+	}
+}
+
+// Creates a view over each table of the parquet directory so that they can be queried like any other table.
+// Returns the schema.table of every view that was created
+func CreateDuckdbParquetViews(ctx context.Context, db sqlconnect.SqlDBTX, parquetDirectory string) (map[string]struct{}, error) {
+	tables, err := getDuckdbParquetTables(parquetDirectory)
+	if err != nil {
+		return nil, err
+	}
+	output := map[string]struct{}{}
+	for _, table := range tables {
+		for _, statement := range buildDuckdbParquetViewStatements(table) {
+			if _, err := db.ExecContext(ctx, statement); err != nil {
+				return nil, fmt.Errorf("unable to create view over parquet table %s: %w", BuildTable(table.Schema, table.Table), err)
+			}
+		}
+		output[BuildTable(table.Schema, table.Table)] = struct{}{}
+	}
+	return output, nil
+}
@@ -0,0 +1,91 @@
+package sqlmanager
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DuckdbManager_GetDatabaseSchema(t *testing.T) {
+	db, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+	manager := &DuckdbManager{pool: db, parquetTables: map[string]struct{}{"public.events": {}}}
+
+	sqlMock.ExpectQuery(regexp.QuoteMeta(getDuckdbDatabaseSchemaQuery)).WillReturnRows(
+		sqlmock.NewRows([]string{"table_schema", "table_name", "column_name", "data_type", "column_default", "is_nullable", "character_maximum_length", "numeric_precision", "numeric_scale", "ordinal_position", "table_type"}).
+			AddRow("main", "users", "id", "INTEGER", "", "NO", -1, 32, 0, 1, "BASE TABLE").
+			AddRow("main", "user_emails", "email", "VARCHAR", "", "YES", -1, -1, -1, 1, "VIEW").
+			AddRow("public", "events", "name", "VARCHAR", "", "YES", -1, -1, -1, 1, "VIEW"),
+	)
+
+	rows, err := manager.GetDatabaseSchema(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []*DatabaseSchemaRow{
+		{TableSchema: "main", TableName: "users", ColumnName: "id", DataType: "integer", IsNullable: "NO", CharacterMaximumLength: -1, NumericPrecision: 32, NumericScale: 0, OrdinalPosition: 1, TableType: BaseTableType},
+		{TableSchema: "public", TableName: "events", ColumnName: "name", DataType: "varchar", IsNullable: "YES", CharacterMaximumLength: -1, NumericPrecision: -1, NumericScale: -1, OrdinalPosition: 1, TableType: BaseTableType},
+	}, rows)
+	require.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func Test_DuckdbManager_GetViewColumnMap(t *testing.T) {
+	db, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+	manager := &DuckdbManager{pool: db, parquetTables: map[string]struct{}{"public.events": {}}}
+
+	sqlMock.ExpectQuery(regexp.QuoteMeta(getDuckdbViewColumnsQuery)).WillReturnRows(
+		sqlmock.NewRows([]string{"table_schema", "table_name", "column_name", "data_type", "is_nullable", "ordinal_position"}).
+			AddRow("main", "user_emails", "email", "VARCHAR", "YES", 1).
+			AddRow("public", "events", "name", "VARCHAR", "YES", 1),
+	)
+
+	views, err := manager.GetViewColumnMap(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, map[string]map[string]*ColumnInfo{
+		"main.user_emails": {
+			"email": {OrdinalPosition: 1, IsNullable: true, DataType: "varchar", TableType: ViewTableType},
+		},
+	}, views)
+	require.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func Test_getDuckdbParquetTables(t *testing.T) {
+	directory := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(directory, "public", "events"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(directory, "public", "empty"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "public", "events", "part-1.parquet"), []byte{}, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "public", "users.parquet"), []byte{}, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "public", "README.md"), []byte{}, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "orphan.parquet"), []byte{}, 0o600))
+
+	tables, err := getDuckdbParquetTables(directory)
+	require.NoError(t, err)
+	require.Equal(t, []*duckdbParquetTable{
+		{Schema: "public", Table: "events", Path: filepath.Join(directory, "public", "events", "*.parquet")},
+		{Schema: "public", Table: "users", Path: filepath.Join(directory, "public", "users.parquet")},
+	}, tables)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(directory, "public", "users"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "public", "users", "part-1.parquet"), []byte{}, 0o600))
+	_, err = getDuckdbParquetTables(directory)
+	require.Error(t, err)
+}
+
+func Test_buildDuckdbParquetViewStatements(t *testing.T) {
+	require.Equal(t, []string{
+		`CREATE SCHEMA IF NOT EXISTS "public";`,
+		`CREATE OR REPLACE VIEW "public"."users" AS SELECT * FROM read_parquet('/data/o''brien/public/users/*.parquet', union_by_name = true);`,
+	}, buildDuckdbParquetViewStatements(&duckdbParquetTable{Schema: "public", Table: "users", Path: "/data/o'brien/public/users/*.parquet"}))
+}
+
+func Test_getDuckdbCreateTableStatement(t *testing.T) {
+	require.Equal(
+		t,
+		"CREATE TABLE IF NOT EXISTS main.users(id INTEGER PRIMARY KEY, email VARCHAR NOT NULL);",
+		getDuckdbCreateTableStatement("CREATE TABLE main.users(id INTEGER PRIMARY KEY, email VARCHAR NOT NULL);"),
+	)
+}
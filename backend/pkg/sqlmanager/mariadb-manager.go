@@ -0,0 +1,154 @@
+package sqlmanager
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	mysql_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/mysql"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+)
+
+// MariaDB speaks the MySQL protocol and shares most of its information_schema, but it quotes literal column defaults, only reports
+// generated columns through is_generated and supports sequences that columns can default to. Everything else is left to the mysql manager.
+type MariadbManager struct {
+	*MysqlManager
+}
+
+const getMariadbDatabaseSchemaQuery = `SELECT c.table_schema, c.table_name, c.column_name, c.ordinal_position,
+COALESCE(NULLIF(c.generation_expression, ''), c.column_default, 'NULL'), c.is_nullable, c.data_type,
+COALESCE(c.character_maximum_length, -1), COALESCE(c.numeric_precision, -1), COALESCE(c.numeric_scale, -1),
+CASE WHEN c.is_generated = 'ALWAYS' THEN c.extra ELSE '' END
+FROM information_schema.columns AS c
+JOIN information_schema.tables AS t ON c.table_schema = t.table_schema AND c.table_name = t.table_name
+WHERE c.table_schema NOT IN ('sys', 'performance_schema', 'mysql', 'information_schema')
+AND t.table_type = 'BASE TABLE'
+ORDER BY c.table_schema, c.table_name, c.ordinal_position`
+
+func (m *MariadbManager) GetDatabaseSchema(ctx context.Context) ([]*DatabaseSchemaRow, error) {
+	rows, err := m.pool.QueryContext(ctx, getMariadbDatabaseSchemaQuery)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return []*DatabaseSchemaRow{}, nil
+	}
+	defer rows.Close()
+
+	result := []*DatabaseSchemaRow{}
+	for rows.Next() {
+		row := &DatabaseSchemaRow{TableType: BaseTableType}
+		var generatedType string
+		if err := rows.Scan(
+			&row.TableSchema,
+			&row.TableName,
+			&row.ColumnName,
+			&row.OrdinalPosition,
+			&row.ColumnDefault,
+			&row.IsNullable,
+			&row.DataType,
+			&row.CharacterMaximumLength,
+			&row.NumericPrecision,
+			&row.NumericScale,
+			&generatedType,
+		); err != nil {
+			return nil, err
+		}
+		if generatedType != "" {
+			row.GeneratedType = &generatedType
+		} else {
+			row.ColumnDefault = unquoteMariadbColumnDefault(row.ColumnDefault)
+		}
+		result = append(result, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// returns: {public.users: { id: struct{}{}, created_at: struct{}{}}}
+func (m *MariadbManager) GetSchemaColumnMap(ctx context.Context) (map[string]map[string]*ColumnInfo, error) {
+	dbSchemas, err := m.GetDatabaseSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := GetUniqueSchemaColMappings(dbSchemas)
+	return result, nil
+}
+
+// MariaDB returns literal defaults as quoted strings, where MySQL returns them as is. Expressions such as current_timestamp() are not quoted
+func unquoteMariadbColumnDefault(columnDefault string) string {
+	if len(columnDefault) < 2 || !strings.HasPrefix(columnDefault, "'") || !strings.HasSuffix(columnDefault, "'") {
+		return columnDefault
+	}
+	return strings.ReplaceAll(columnDefault[1:len(columnDefault)-1], "''", "'")
+}
+
+var mariadbNextvalRegex = regexp.MustCompile("(?i)nextval\\((?:`([^`]+)`\\.)?`([^`]+)`\\)")
+
+// Sequences that columns of the table default to are created ahead of the table so that its nextval defaults resolve
+func (m *MariadbManager) GetCreateTableStatement(ctx context.Context, schema, table string) (string, error) {
+	stmt, err := m.MysqlManager.GetCreateTableStatement(ctx, schema, table)
+	if err != nil {
+		return "", err
+	}
+
+	sequenceStmts := []string{}
+	seen := map[string]struct{}{}
+	for _, sequence := range getMariadbSequenceReferences(stmt, schema) {
+		key := BuildTable(sequence.Schema, sequence.Table)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		sequenceStmt, err := getMariadbCreateSequenceStatement(ctx, m.pool, sequence.Schema, sequence.Table)
+		if err != nil {
+			return "", fmt.Errorf("unable to get sequence create statement: %w", err)
+		}
+		sequenceStmts = append(sequenceStmts, sequenceStmt)
+	}
+	if len(sequenceStmts) == 0 {
+		return stmt, nil
+	}
+	return fmt.Sprintf("%s\n%s", strings.Join(sequenceStmts, "\n"), stmt), nil
+}
+
+// Returns the sequences that are referenced by the nextval defaults of a create table statement.
+// Sequences that are not schema qualified live in the schema of the table
+func getMariadbSequenceReferences(stmt, schema string) []*SchemaTable {
+	output := []*SchemaTable{}
+	for _, matches := range mariadbNextvalRegex.FindAllStringSubmatch(stmt, -1) {
+		sequenceSchema := matches[1]
+		if sequenceSchema == "" {
+			sequenceSchema = schema
+		}
+		output = append(output, &SchemaTable{Schema: sequenceSchema, Table: matches[2]})
+	}
+	return output
+}
+
+var mariadbCreateSequenceRegex = regexp.MustCompile("(?i)^\\s*CREATE\\s+SEQUENCE\\s+(IF\\s+NOT\\s+EXISTS\\s+)?(?:`[^`]+`\\.)?`[^`]+`")
+
+func getMariadbCreateSequenceStatement(
+	ctx context.Context,
+	conn mysql_queries.DBTX,
+	schema string,
+	sequence string,
+) (string, error) {
+	row := conn.QueryRowContext(ctx, fmt.Sprintf("SHOW CREATE SEQUENCE %s;", QuoteTable(MysqlDriver, schema, sequence)))
+	var output databaseTableShowCreate
+	if err := row.Scan(&output.Table, &output.CreateTable); err != nil {
+		return "", err
+	}
+	return buildMariadbCreateSequenceStatement(output.CreateTable, schema, sequence), nil
+}
+
+// SHOW CREATE SEQUENCE leaves out the schema of the sequence
+func buildMariadbCreateSequenceStatement(ddl, schema, sequence string) string {
+	stmt := mariadbCreateSequenceRegex.ReplaceAllLiteralString(
+		strings.TrimSpace(ddl),
+		fmt.Sprintf("CREATE SEQUENCE IF NOT EXISTS %s", QuoteTable(MysqlDriver, schema, sequence)),
+	)
+	return fmt.Sprintf("%s;", strings.TrimSuffix(stmt, ";"))
+}
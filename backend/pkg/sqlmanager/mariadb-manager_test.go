@@ -0,0 +1,46 @@
+package sqlmanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_unquoteMariadbColumnDefault(t *testing.T) {
+	require.Equal(t, "active", unquoteMariadbColumnDefault("'active'"))
+	require.Equal(t, "it's", unquoteMariadbColumnDefault("'it''s'"))
+	require.Equal(t, "", unquoteMariadbColumnDefault("''"))
+	require.Equal(t, "current_timestamp()", unquoteMariadbColumnDefault("current_timestamp()"))
+	require.Equal(t, "NULL", unquoteMariadbColumnDefault("NULL"))
+	require.Equal(t, "'", unquoteMariadbColumnDefault("'"))
+}
+
+func Test_getMariadbSequenceReferences(t *testing.T) {
+	stmt := "CREATE TABLE IF NOT EXISTS  `shop`.`orders` (\n  `id` bigint NOT NULL DEFAULT nextval(`shop`.`order_seq`),\n  `ref` bigint NOT NULL DEFAULT NEXTVAL(`ref_seq`),\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB;"
+	require.Equal(
+		t,
+		[]*SchemaTable{{Schema: "shop", Table: "order_seq"}, {Schema: "shop", Table: "ref_seq"}},
+		getMariadbSequenceReferences(stmt, "shop"),
+	)
+	require.Empty(t, getMariadbSequenceReferences("CREATE TABLE IF NOT EXISTS  `shop`.`users` (\n  `id` int NOT NULL\n);", "shop"))
+}
+
+func Test_buildMariadbCreateSequenceStatement(t *testing.T) {
+	require.Equal(
+		t,
+		"CREATE SEQUENCE IF NOT EXISTS `shop`.`order_seq` start with 1 minvalue 1 maxvalue 9223372036854775806 increment by 1 cache 1000 nocycle ENGINE=InnoDB;",
+		buildMariadbCreateSequenceStatement("CREATE SEQUENCE `order_seq` start with 1 minvalue 1 maxvalue 9223372036854775806 increment by 1 cache 1000 nocycle ENGINE=InnoDB", "shop", "order_seq"),
+	)
+}
+
+func Test_RemapMysqlCreateTableStatement_MariadbSequences(t *testing.T) {
+	stmt := "CREATE SEQUENCE IF NOT EXISTS `shop`.`order_seq` start with 1 minvalue 1 maxvalue 9223372036854775806 increment by 1 cache 1000 nocycle ENGINE=InnoDB;\nCREATE TABLE IF NOT EXISTS  `shop`.`orders` (\n  `id` bigint NOT NULL DEFAULT nextval(`shop`.`order_seq`),\n  `notes` text,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB;"
+	require.Equal(
+		t,
+		"CREATE SEQUENCE IF NOT EXISTS `shop`.`order_seq` start with 1 minvalue 1 maxvalue 9223372036854775806 increment by 1 cache 1000 nocycle ENGINE=InnoDB;\nCREATE TABLE IF NOT EXISTS  `shop`.`orders_v2` (\n  `id` bigint NOT NULL DEFAULT nextval(`shop`.`order_seq`),\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB;",
+		RemapMysqlCreateTableStatement(stmt, "shop", "orders", &TableInitStatementOpts{
+			DestinationTables:  map[string]*SchemaTable{"shop.orders": {Schema: "shop", Table: "orders_v2"}},
+			DestinationColumns: map[string]map[string]*DestinationColumn{"shop.orders": {"notes": {Exclude: true}}},
+		}),
+	)
+}
@@ -149,6 +149,65 @@ func (_c *MockSqlDatabase_Exec_Call) RunAndReturn(run func(context.Context, stri
 	return _c
 }
 
+// GetColumnDistinctCount provides a mock function with given fields: ctx, schema, table, column
+func (_m *MockSqlDatabase) GetColumnDistinctCount(ctx context.Context, schema string, table string, column string) (int64, error) {
+	ret := _m.Called(ctx, schema, table, column)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetColumnDistinctCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (int64, error)); ok {
+		return rf(ctx, schema, table, column)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) int64); ok {
+		r0 = rf(ctx, schema, table, column)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, schema, table, column)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSqlDatabase_GetColumnDistinctCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetColumnDistinctCount'
+type MockSqlDatabase_GetColumnDistinctCount_Call struct {
+	*mock.Call
+}
+
+// GetColumnDistinctCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - schema string
+//   - table string
+//   - column string
+func (_e *MockSqlDatabase_Expecter) GetColumnDistinctCount(ctx interface{}, schema interface{}, table interface{}, column interface{}) *MockSqlDatabase_GetColumnDistinctCount_Call {
+	return &MockSqlDatabase_GetColumnDistinctCount_Call{Call: _e.mock.On("GetColumnDistinctCount", ctx, schema, table, column)}
+}
+
+func (_c *MockSqlDatabase_GetColumnDistinctCount_Call) Run(run func(ctx context.Context, schema string, table string, column string)) *MockSqlDatabase_GetColumnDistinctCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetColumnDistinctCount_Call) Return(_a0 int64, _a1 error) *MockSqlDatabase_GetColumnDistinctCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetColumnDistinctCount_Call) RunAndReturn(run func(context.Context, string, string, string) (int64, error)) *MockSqlDatabase_GetColumnDistinctCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCreateTableStatement provides a mock function with given fields: ctx, schema, table
 func (_m *MockSqlDatabase) GetCreateTableStatement(ctx context.Context, schema string, table string) (string, error) {
 	ret := _m.Called(ctx, schema, table)
@@ -260,34 +319,507 @@ func (_c *MockSqlDatabase_GetDatabaseSchema_Call) Return(_a0 []*DatabaseSchemaRo
 	return _c
 }
 
-func (_c *MockSqlDatabase_GetDatabaseSchema_Call) RunAndReturn(run func(context.Context) ([]*DatabaseSchemaRow, error)) *MockSqlDatabase_GetDatabaseSchema_Call {
+func (_c *MockSqlDatabase_GetDatabaseSchema_Call) RunAndReturn(run func(context.Context) ([]*DatabaseSchemaRow, error)) *MockSqlDatabase_GetDatabaseSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEnabledTableTriggers provides a mock function with given fields: ctx, tables
+func (_m *MockSqlDatabase) GetEnabledTableTriggers(ctx context.Context, tables []*SchemaTable) ([]*TableTrigger, error) {
+	ret := _m.Called(ctx, tables)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEnabledTableTriggers")
+	}
+
+	var r0 []*TableTrigger
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*SchemaTable) ([]*TableTrigger, error)); ok {
+		return rf(ctx, tables)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []*SchemaTable) []*TableTrigger); ok {
+		r0 = rf(ctx, tables)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*TableTrigger)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []*SchemaTable) error); ok {
+		r1 = rf(ctx, tables)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSqlDatabase_GetEnabledTableTriggers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEnabledTableTriggers'
+type MockSqlDatabase_GetEnabledTableTriggers_Call struct {
+	*mock.Call
+}
+
+// GetEnabledTableTriggers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tables []*SchemaTable
+func (_e *MockSqlDatabase_Expecter) GetEnabledTableTriggers(ctx interface{}, tables interface{}) *MockSqlDatabase_GetEnabledTableTriggers_Call {
+	return &MockSqlDatabase_GetEnabledTableTriggers_Call{Call: _e.mock.On("GetEnabledTableTriggers", ctx, tables)}
+}
+
+func (_c *MockSqlDatabase_GetEnabledTableTriggers_Call) Run(run func(ctx context.Context, tables []*SchemaTable)) *MockSqlDatabase_GetEnabledTableTriggers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*SchemaTable))
+	})
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetEnabledTableTriggers_Call) Return(_a0 []*TableTrigger, _a1 error) *MockSqlDatabase_GetEnabledTableTriggers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetEnabledTableTriggers_Call) RunAndReturn(run func(context.Context, []*SchemaTable) ([]*TableTrigger, error)) *MockSqlDatabase_GetEnabledTableTriggers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEquivalenceClasses provides a mock function with given fields: ctx, schema, table, quasiIdentifiers, sensitiveColumn
+func (_m *MockSqlDatabase) GetEquivalenceClasses(ctx context.Context, schema string, table string, quasiIdentifiers []string, sensitiveColumn *string) ([]*EquivalenceClass, error) {
+	ret := _m.Called(ctx, schema, table, quasiIdentifiers, sensitiveColumn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEquivalenceClasses")
+	}
+
+	var r0 []*EquivalenceClass
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string, *string) ([]*EquivalenceClass, error)); ok {
+		return rf(ctx, schema, table, quasiIdentifiers, sensitiveColumn)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string, *string) []*EquivalenceClass); ok {
+		r0 = rf(ctx, schema, table, quasiIdentifiers, sensitiveColumn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*EquivalenceClass)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []string, *string) error); ok {
+		r1 = rf(ctx, schema, table, quasiIdentifiers, sensitiveColumn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSqlDatabase_GetEquivalenceClasses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEquivalenceClasses'
+type MockSqlDatabase_GetEquivalenceClasses_Call struct {
+	*mock.Call
+}
+
+// GetEquivalenceClasses is a helper method to define mock.On call
+//   - ctx context.Context
+//   - schema string
+//   - table string
+//   - quasiIdentifiers []string
+//   - sensitiveColumn *string
+func (_e *MockSqlDatabase_Expecter) GetEquivalenceClasses(ctx interface{}, schema interface{}, table interface{}, quasiIdentifiers interface{}, sensitiveColumn interface{}) *MockSqlDatabase_GetEquivalenceClasses_Call {
+	return &MockSqlDatabase_GetEquivalenceClasses_Call{Call: _e.mock.On("GetEquivalenceClasses", ctx, schema, table, quasiIdentifiers, sensitiveColumn)}
+}
+
+func (_c *MockSqlDatabase_GetEquivalenceClasses_Call) Run(run func(ctx context.Context, schema string, table string, quasiIdentifiers []string, sensitiveColumn *string)) *MockSqlDatabase_GetEquivalenceClasses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].([]string), args[4].(*string))
+	})
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetEquivalenceClasses_Call) Return(_a0 []*EquivalenceClass, _a1 error) *MockSqlDatabase_GetEquivalenceClasses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetEquivalenceClasses_Call) RunAndReturn(run func(context.Context, string, string, []string, *string) ([]*EquivalenceClass, error)) *MockSqlDatabase_GetEquivalenceClasses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExclusionConstraintsMap provides a mock function with given fields: ctx, schemas
+func (_m *MockSqlDatabase) GetExclusionConstraintsMap(ctx context.Context, schemas []string) (map[string][]*ExclusionConstraint, error) {
+	ret := _m.Called(ctx, schemas)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExclusionConstraintsMap")
+	}
+
+	var r0 map[string][]*ExclusionConstraint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string][]*ExclusionConstraint, error)); ok {
+		return rf(ctx, schemas)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string][]*ExclusionConstraint); ok {
+		r0 = rf(ctx, schemas)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]*ExclusionConstraint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, schemas)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSqlDatabase_GetExclusionConstraintsMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExclusionConstraintsMap'
+type MockSqlDatabase_GetExclusionConstraintsMap_Call struct {
+	*mock.Call
+}
+
+// GetExclusionConstraintsMap is a helper method to define mock.On call
+//   - ctx context.Context
+//   - schemas []string
+func (_e *MockSqlDatabase_Expecter) GetExclusionConstraintsMap(ctx interface{}, schemas interface{}) *MockSqlDatabase_GetExclusionConstraintsMap_Call {
+	return &MockSqlDatabase_GetExclusionConstraintsMap_Call{Call: _e.mock.On("GetExclusionConstraintsMap", ctx, schemas)}
+}
+
+func (_c *MockSqlDatabase_GetExclusionConstraintsMap_Call) Run(run func(ctx context.Context, schemas []string)) *MockSqlDatabase_GetExclusionConstraintsMap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetExclusionConstraintsMap_Call) Return(_a0 map[string][]*ExclusionConstraint, _a1 error) *MockSqlDatabase_GetExclusionConstraintsMap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetExclusionConstraintsMap_Call) RunAndReturn(run func(context.Context, []string) (map[string][]*ExclusionConstraint, error)) *MockSqlDatabase_GetExclusionConstraintsMap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExtensions provides a mock function with given fields: ctx
+func (_m *MockSqlDatabase) GetExtensions(ctx context.Context) ([]*Extension, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExtensions")
+	}
+
+	var r0 []*Extension
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*Extension, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*Extension); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Extension)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSqlDatabase_GetExtensions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExtensions'
+type MockSqlDatabase_GetExtensions_Call struct {
+	*mock.Call
+}
+
+// GetExtensions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSqlDatabase_Expecter) GetExtensions(ctx interface{}) *MockSqlDatabase_GetExtensions_Call {
+	return &MockSqlDatabase_GetExtensions_Call{Call: _e.mock.On("GetExtensions", ctx)}
+}
+
+func (_c *MockSqlDatabase_GetExtensions_Call) Run(run func(ctx context.Context)) *MockSqlDatabase_GetExtensions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetExtensions_Call) Return(_a0 []*Extension, _a1 error) *MockSqlDatabase_GetExtensions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetExtensions_Call) RunAndReturn(run func(context.Context) ([]*Extension, error)) *MockSqlDatabase_GetExtensions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetForeignKeyConstraints provides a mock function with given fields: ctx, schemas
+func (_m *MockSqlDatabase) GetForeignKeyConstraints(ctx context.Context, schemas []string) ([]*ForeignKeyConstraintsRow, error) {
+	ret := _m.Called(ctx, schemas)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForeignKeyConstraints")
+	}
+
+	var r0 []*ForeignKeyConstraintsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*ForeignKeyConstraintsRow, error)); ok {
+		return rf(ctx, schemas)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*ForeignKeyConstraintsRow); ok {
+		r0 = rf(ctx, schemas)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ForeignKeyConstraintsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, schemas)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSqlDatabase_GetForeignKeyConstraints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForeignKeyConstraints'
+type MockSqlDatabase_GetForeignKeyConstraints_Call struct {
+	*mock.Call
+}
+
+// GetForeignKeyConstraints is a helper method to define mock.On call
+//   - ctx context.Context
+//   - schemas []string
+func (_e *MockSqlDatabase_Expecter) GetForeignKeyConstraints(ctx interface{}, schemas interface{}) *MockSqlDatabase_GetForeignKeyConstraints_Call {
+	return &MockSqlDatabase_GetForeignKeyConstraints_Call{Call: _e.mock.On("GetForeignKeyConstraints", ctx, schemas)}
+}
+
+func (_c *MockSqlDatabase_GetForeignKeyConstraints_Call) Run(run func(ctx context.Context, schemas []string)) *MockSqlDatabase_GetForeignKeyConstraints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetForeignKeyConstraints_Call) Return(_a0 []*ForeignKeyConstraintsRow, _a1 error) *MockSqlDatabase_GetForeignKeyConstraints_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetForeignKeyConstraints_Call) RunAndReturn(run func(context.Context, []string) ([]*ForeignKeyConstraintsRow, error)) *MockSqlDatabase_GetForeignKeyConstraints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetForeignKeyConstraintsMap provides a mock function with given fields: ctx, schemas
+func (_m *MockSqlDatabase) GetForeignKeyConstraintsMap(ctx context.Context, schemas []string) (map[string][]*ForeignConstraint, error) {
+	ret := _m.Called(ctx, schemas)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForeignKeyConstraintsMap")
+	}
+
+	var r0 map[string][]*ForeignConstraint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string][]*ForeignConstraint, error)); ok {
+		return rf(ctx, schemas)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string][]*ForeignConstraint); ok {
+		r0 = rf(ctx, schemas)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]*ForeignConstraint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, schemas)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSqlDatabase_GetForeignKeyConstraintsMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForeignKeyConstraintsMap'
+type MockSqlDatabase_GetForeignKeyConstraintsMap_Call struct {
+	*mock.Call
+}
+
+// GetForeignKeyConstraintsMap is a helper method to define mock.On call
+//   - ctx context.Context
+//   - schemas []string
+func (_e *MockSqlDatabase_Expecter) GetForeignKeyConstraintsMap(ctx interface{}, schemas interface{}) *MockSqlDatabase_GetForeignKeyConstraintsMap_Call {
+	return &MockSqlDatabase_GetForeignKeyConstraintsMap_Call{Call: _e.mock.On("GetForeignKeyConstraintsMap", ctx, schemas)}
+}
+
+func (_c *MockSqlDatabase_GetForeignKeyConstraintsMap_Call) Run(run func(ctx context.Context, schemas []string)) *MockSqlDatabase_GetForeignKeyConstraintsMap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetForeignKeyConstraintsMap_Call) Return(_a0 map[string][]*ForeignConstraint, _a1 error) *MockSqlDatabase_GetForeignKeyConstraintsMap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetForeignKeyConstraintsMap_Call) RunAndReturn(run func(context.Context, []string) (map[string][]*ForeignConstraint, error)) *MockSqlDatabase_GetForeignKeyConstraintsMap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetForeignKeyOrphanCount provides a mock function with given fields: ctx, schema, table, constraint
+func (_m *MockSqlDatabase) GetForeignKeyOrphanCount(ctx context.Context, schema string, table string, constraint *ForeignConstraint) (int64, error) {
+	ret := _m.Called(ctx, schema, table, constraint)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForeignKeyOrphanCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *ForeignConstraint) (int64, error)); ok {
+		return rf(ctx, schema, table, constraint)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *ForeignConstraint) int64); ok {
+		r0 = rf(ctx, schema, table, constraint)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *ForeignConstraint) error); ok {
+		r1 = rf(ctx, schema, table, constraint)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSqlDatabase_GetForeignKeyOrphanCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForeignKeyOrphanCount'
+type MockSqlDatabase_GetForeignKeyOrphanCount_Call struct {
+	*mock.Call
+}
+
+// GetForeignKeyOrphanCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - schema string
+//   - table string
+//   - constraint *ForeignConstraint
+func (_e *MockSqlDatabase_Expecter) GetForeignKeyOrphanCount(ctx interface{}, schema interface{}, table interface{}, constraint interface{}) *MockSqlDatabase_GetForeignKeyOrphanCount_Call {
+	return &MockSqlDatabase_GetForeignKeyOrphanCount_Call{Call: _e.mock.On("GetForeignKeyOrphanCount", ctx, schema, table, constraint)}
+}
+
+func (_c *MockSqlDatabase_GetForeignKeyOrphanCount_Call) Run(run func(ctx context.Context, schema string, table string, constraint *ForeignConstraint)) *MockSqlDatabase_GetForeignKeyOrphanCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*ForeignConstraint))
+	})
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetForeignKeyOrphanCount_Call) Return(_a0 int64, _a1 error) *MockSqlDatabase_GetForeignKeyOrphanCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetForeignKeyOrphanCount_Call) RunAndReturn(run func(context.Context, string, string, *ForeignConstraint) (int64, error)) *MockSqlDatabase_GetForeignKeyOrphanCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMaterializedViews provides a mock function with given fields: ctx
+func (_m *MockSqlDatabase) GetMaterializedViews(ctx context.Context) ([]*MaterializedView, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMaterializedViews")
+	}
+
+	var r0 []*MaterializedView
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*MaterializedView, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*MaterializedView); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*MaterializedView)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSqlDatabase_GetMaterializedViews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMaterializedViews'
+type MockSqlDatabase_GetMaterializedViews_Call struct {
+	*mock.Call
+}
+
+// GetMaterializedViews is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSqlDatabase_Expecter) GetMaterializedViews(ctx interface{}) *MockSqlDatabase_GetMaterializedViews_Call {
+	return &MockSqlDatabase_GetMaterializedViews_Call{Call: _e.mock.On("GetMaterializedViews", ctx)}
+}
+
+func (_c *MockSqlDatabase_GetMaterializedViews_Call) Run(run func(ctx context.Context)) *MockSqlDatabase_GetMaterializedViews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetMaterializedViews_Call) Return(_a0 []*MaterializedView, _a1 error) *MockSqlDatabase_GetMaterializedViews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetMaterializedViews_Call) RunAndReturn(run func(context.Context) ([]*MaterializedView, error)) *MockSqlDatabase_GetMaterializedViews_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetForeignKeyConstraints provides a mock function with given fields: ctx, schemas
-func (_m *MockSqlDatabase) GetForeignKeyConstraints(ctx context.Context, schemas []string) ([]*ForeignKeyConstraintsRow, error) {
-	ret := _m.Called(ctx, schemas)
+// GetNumericColumnSample provides a mock function with given fields: ctx, schema, table, column, limit
+func (_m *MockSqlDatabase) GetNumericColumnSample(ctx context.Context, schema string, table string, column string, limit uint) ([]float64, error) {
+	ret := _m.Called(ctx, schema, table, column, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetForeignKeyConstraints")
+		panic("no return value specified for GetNumericColumnSample")
 	}
 
-	var r0 []*ForeignKeyConstraintsRow
+	var r0 []float64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*ForeignKeyConstraintsRow, error)); ok {
-		return rf(ctx, schemas)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, uint) ([]float64, error)); ok {
+		return rf(ctx, schema, table, column, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []string) []*ForeignKeyConstraintsRow); ok {
-		r0 = rf(ctx, schemas)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, uint) []float64); ok {
+		r0 = rf(ctx, schema, table, column, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*ForeignKeyConstraintsRow)
+			r0 = ret.Get(0).([]float64)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
-		r1 = rf(ctx, schemas)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, uint) error); ok {
+		r1 = rf(ctx, schema, table, column, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -295,53 +827,56 @@ func (_m *MockSqlDatabase) GetForeignKeyConstraints(ctx context.Context, schemas
 	return r0, r1
 }
 
-// MockSqlDatabase_GetForeignKeyConstraints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForeignKeyConstraints'
-type MockSqlDatabase_GetForeignKeyConstraints_Call struct {
+// MockSqlDatabase_GetNumericColumnSample_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNumericColumnSample'
+type MockSqlDatabase_GetNumericColumnSample_Call struct {
 	*mock.Call
 }
 
-// GetForeignKeyConstraints is a helper method to define mock.On call
+// GetNumericColumnSample is a helper method to define mock.On call
 //   - ctx context.Context
-//   - schemas []string
-func (_e *MockSqlDatabase_Expecter) GetForeignKeyConstraints(ctx interface{}, schemas interface{}) *MockSqlDatabase_GetForeignKeyConstraints_Call {
-	return &MockSqlDatabase_GetForeignKeyConstraints_Call{Call: _e.mock.On("GetForeignKeyConstraints", ctx, schemas)}
+//   - schema string
+//   - table string
+//   - column string
+//   - limit uint
+func (_e *MockSqlDatabase_Expecter) GetNumericColumnSample(ctx interface{}, schema interface{}, table interface{}, column interface{}, limit interface{}) *MockSqlDatabase_GetNumericColumnSample_Call {
+	return &MockSqlDatabase_GetNumericColumnSample_Call{Call: _e.mock.On("GetNumericColumnSample", ctx, schema, table, column, limit)}
 }
 
-func (_c *MockSqlDatabase_GetForeignKeyConstraints_Call) Run(run func(ctx context.Context, schemas []string)) *MockSqlDatabase_GetForeignKeyConstraints_Call {
+func (_c *MockSqlDatabase_GetNumericColumnSample_Call) Run(run func(ctx context.Context, schema string, table string, column string, limit uint)) *MockSqlDatabase_GetNumericColumnSample_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(uint))
 	})
 	return _c
 }
 
-func (_c *MockSqlDatabase_GetForeignKeyConstraints_Call) Return(_a0 []*ForeignKeyConstraintsRow, _a1 error) *MockSqlDatabase_GetForeignKeyConstraints_Call {
+func (_c *MockSqlDatabase_GetNumericColumnSample_Call) Return(_a0 []float64, _a1 error) *MockSqlDatabase_GetNumericColumnSample_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockSqlDatabase_GetForeignKeyConstraints_Call) RunAndReturn(run func(context.Context, []string) ([]*ForeignKeyConstraintsRow, error)) *MockSqlDatabase_GetForeignKeyConstraints_Call {
+func (_c *MockSqlDatabase_GetNumericColumnSample_Call) RunAndReturn(run func(context.Context, string, string, string, uint) ([]float64, error)) *MockSqlDatabase_GetNumericColumnSample_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetForeignKeyConstraintsMap provides a mock function with given fields: ctx, schemas
-func (_m *MockSqlDatabase) GetForeignKeyConstraintsMap(ctx context.Context, schemas []string) (map[string][]*ForeignConstraint, error) {
+// GetPartialUniqueIndexesMap provides a mock function with given fields: ctx, schemas
+func (_m *MockSqlDatabase) GetPartialUniqueIndexesMap(ctx context.Context, schemas []string) (map[string][]*PartialUniqueIndex, error) {
 	ret := _m.Called(ctx, schemas)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetForeignKeyConstraintsMap")
+		panic("no return value specified for GetPartialUniqueIndexesMap")
 	}
 
-	var r0 map[string][]*ForeignConstraint
+	var r0 map[string][]*PartialUniqueIndex
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string][]*ForeignConstraint, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string][]*PartialUniqueIndex, error)); ok {
 		return rf(ctx, schemas)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string][]*ForeignConstraint); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string][]*PartialUniqueIndex); ok {
 		r0 = rf(ctx, schemas)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(map[string][]*ForeignConstraint)
+			r0 = ret.Get(0).(map[string][]*PartialUniqueIndex)
 		}
 	}
 
@@ -354,31 +889,31 @@ func (_m *MockSqlDatabase) GetForeignKeyConstraintsMap(ctx context.Context, sche
 	return r0, r1
 }
 
-// MockSqlDatabase_GetForeignKeyConstraintsMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForeignKeyConstraintsMap'
-type MockSqlDatabase_GetForeignKeyConstraintsMap_Call struct {
+// MockSqlDatabase_GetPartialUniqueIndexesMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPartialUniqueIndexesMap'
+type MockSqlDatabase_GetPartialUniqueIndexesMap_Call struct {
 	*mock.Call
 }
 
-// GetForeignKeyConstraintsMap is a helper method to define mock.On call
+// GetPartialUniqueIndexesMap is a helper method to define mock.On call
 //   - ctx context.Context
 //   - schemas []string
-func (_e *MockSqlDatabase_Expecter) GetForeignKeyConstraintsMap(ctx interface{}, schemas interface{}) *MockSqlDatabase_GetForeignKeyConstraintsMap_Call {
-	return &MockSqlDatabase_GetForeignKeyConstraintsMap_Call{Call: _e.mock.On("GetForeignKeyConstraintsMap", ctx, schemas)}
+func (_e *MockSqlDatabase_Expecter) GetPartialUniqueIndexesMap(ctx interface{}, schemas interface{}) *MockSqlDatabase_GetPartialUniqueIndexesMap_Call {
+	return &MockSqlDatabase_GetPartialUniqueIndexesMap_Call{Call: _e.mock.On("GetPartialUniqueIndexesMap", ctx, schemas)}
 }
 
-func (_c *MockSqlDatabase_GetForeignKeyConstraintsMap_Call) Run(run func(ctx context.Context, schemas []string)) *MockSqlDatabase_GetForeignKeyConstraintsMap_Call {
+func (_c *MockSqlDatabase_GetPartialUniqueIndexesMap_Call) Run(run func(ctx context.Context, schemas []string)) *MockSqlDatabase_GetPartialUniqueIndexesMap_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].([]string))
 	})
 	return _c
 }
 
-func (_c *MockSqlDatabase_GetForeignKeyConstraintsMap_Call) Return(_a0 map[string][]*ForeignConstraint, _a1 error) *MockSqlDatabase_GetForeignKeyConstraintsMap_Call {
+func (_c *MockSqlDatabase_GetPartialUniqueIndexesMap_Call) Return(_a0 map[string][]*PartialUniqueIndex, _a1 error) *MockSqlDatabase_GetPartialUniqueIndexesMap_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockSqlDatabase_GetForeignKeyConstraintsMap_Call) RunAndReturn(run func(context.Context, []string) (map[string][]*ForeignConstraint, error)) *MockSqlDatabase_GetForeignKeyConstraintsMap_Call {
+func (_c *MockSqlDatabase_GetPartialUniqueIndexesMap_Call) RunAndReturn(run func(context.Context, []string) (map[string][]*PartialUniqueIndex, error)) *MockSqlDatabase_GetPartialUniqueIndexesMap_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -501,6 +1036,65 @@ func (_c *MockSqlDatabase_GetPrimaryKeyConstraintsMap_Call) RunAndReturn(run fun
 	return _c
 }
 
+// GetQueryColumns provides a mock function with given fields: ctx, query
+func (_m *MockSqlDatabase) GetQueryColumns(ctx context.Context, query string) (map[string]*ColumnInfo, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQueryColumns")
+	}
+
+	var r0 map[string]*ColumnInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (map[string]*ColumnInfo, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) map[string]*ColumnInfo); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*ColumnInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSqlDatabase_GetQueryColumns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQueryColumns'
+type MockSqlDatabase_GetQueryColumns_Call struct {
+	*mock.Call
+}
+
+// GetQueryColumns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+func (_e *MockSqlDatabase_Expecter) GetQueryColumns(ctx interface{}, query interface{}) *MockSqlDatabase_GetQueryColumns_Call {
+	return &MockSqlDatabase_GetQueryColumns_Call{Call: _e.mock.On("GetQueryColumns", ctx, query)}
+}
+
+func (_c *MockSqlDatabase_GetQueryColumns_Call) Run(run func(ctx context.Context, query string)) *MockSqlDatabase_GetQueryColumns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetQueryColumns_Call) Return(_a0 map[string]*ColumnInfo, _a1 error) *MockSqlDatabase_GetQueryColumns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetQueryColumns_Call) RunAndReturn(run func(context.Context, string) (map[string]*ColumnInfo, error)) *MockSqlDatabase_GetQueryColumns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRolePermissionsMap provides a mock function with given fields: ctx, role
 func (_m *MockSqlDatabase) GetRolePermissionsMap(ctx context.Context, role string) (map[string][]string, error) {
 	ret := _m.Called(ctx, role)
@@ -677,9 +1271,9 @@ func (_c *MockSqlDatabase_GetTableConstraintsBySchema_Call) RunAndReturn(run fun
 	return _c
 }
 
-// GetTableInitStatements provides a mock function with given fields: ctx, tables
-func (_m *MockSqlDatabase) GetTableInitStatements(ctx context.Context, tables []*SchemaTable) ([]*TableInitStatement, error) {
-	ret := _m.Called(ctx, tables)
+// GetTableInitStatements provides a mock function with given fields: ctx, tables, opts
+func (_m *MockSqlDatabase) GetTableInitStatements(ctx context.Context, tables []*SchemaTable, opts *TableInitStatementOpts) ([]*TableInitStatement, error) {
+	ret := _m.Called(ctx, tables, opts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTableInitStatements")
@@ -687,19 +1281,19 @@ func (_m *MockSqlDatabase) GetTableInitStatements(ctx context.Context, tables []
 
 	var r0 []*TableInitStatement
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []*SchemaTable) ([]*TableInitStatement, error)); ok {
-		return rf(ctx, tables)
+	if rf, ok := ret.Get(0).(func(context.Context, []*SchemaTable, *TableInitStatementOpts) ([]*TableInitStatement, error)); ok {
+		return rf(ctx, tables, opts)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []*SchemaTable) []*TableInitStatement); ok {
-		r0 = rf(ctx, tables)
+	if rf, ok := ret.Get(0).(func(context.Context, []*SchemaTable, *TableInitStatementOpts) []*TableInitStatement); ok {
+		r0 = rf(ctx, tables, opts)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*TableInitStatement)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []*SchemaTable) error); ok {
-		r1 = rf(ctx, tables)
+	if rf, ok := ret.Get(1).(func(context.Context, []*SchemaTable, *TableInitStatementOpts) error); ok {
+		r1 = rf(ctx, tables, opts)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -715,13 +1309,14 @@ type MockSqlDatabase_GetTableInitStatements_Call struct {
 // GetTableInitStatements is a helper method to define mock.On call
 //   - ctx context.Context
 //   - tables []*SchemaTable
-func (_e *MockSqlDatabase_Expecter) GetTableInitStatements(ctx interface{}, tables interface{}) *MockSqlDatabase_GetTableInitStatements_Call {
-	return &MockSqlDatabase_GetTableInitStatements_Call{Call: _e.mock.On("GetTableInitStatements", ctx, tables)}
+//   - opts *TableInitStatementOpts
+func (_e *MockSqlDatabase_Expecter) GetTableInitStatements(ctx interface{}, tables interface{}, opts interface{}) *MockSqlDatabase_GetTableInitStatements_Call {
+	return &MockSqlDatabase_GetTableInitStatements_Call{Call: _e.mock.On("GetTableInitStatements", ctx, tables, opts)}
 }
 
-func (_c *MockSqlDatabase_GetTableInitStatements_Call) Run(run func(ctx context.Context, tables []*SchemaTable)) *MockSqlDatabase_GetTableInitStatements_Call {
+func (_c *MockSqlDatabase_GetTableInitStatements_Call) Run(run func(ctx context.Context, tables []*SchemaTable, opts *TableInitStatementOpts)) *MockSqlDatabase_GetTableInitStatements_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]*SchemaTable))
+		run(args[0].(context.Context), args[1].([]*SchemaTable), args[2].(*TableInitStatementOpts))
 	})
 	return _c
 }
@@ -731,7 +1326,7 @@ func (_c *MockSqlDatabase_GetTableInitStatements_Call) Return(_a0 []*TableInitSt
 	return _c
 }
 
-func (_c *MockSqlDatabase_GetTableInitStatements_Call) RunAndReturn(run func(context.Context, []*SchemaTable) ([]*TableInitStatement, error)) *MockSqlDatabase_GetTableInitStatements_Call {
+func (_c *MockSqlDatabase_GetTableInitStatements_Call) RunAndReturn(run func(context.Context, []*SchemaTable, *TableInitStatementOpts) ([]*TableInitStatement, error)) *MockSqlDatabase_GetTableInitStatements_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -795,6 +1390,127 @@ func (_c *MockSqlDatabase_GetTableRowCount_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// GetTableRowSample provides a mock function with given fields: ctx, schema, table, columns, limit
+func (_m *MockSqlDatabase) GetTableRowSample(ctx context.Context, schema string, table string, columns []string, limit uint) ([][]*string, error) {
+	ret := _m.Called(ctx, schema, table, columns, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTableRowSample")
+	}
+
+	var r0 [][]*string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string, uint) ([][]*string, error)); ok {
+		return rf(ctx, schema, table, columns, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string, uint) [][]*string); ok {
+		r0 = rf(ctx, schema, table, columns, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]*string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []string, uint) error); ok {
+		r1 = rf(ctx, schema, table, columns, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSqlDatabase_GetTableRowSample_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTableRowSample'
+type MockSqlDatabase_GetTableRowSample_Call struct {
+	*mock.Call
+}
+
+// GetTableRowSample is a helper method to define mock.On call
+//   - ctx context.Context
+//   - schema string
+//   - table string
+//   - columns []string
+//   - limit uint
+func (_e *MockSqlDatabase_Expecter) GetTableRowSample(ctx interface{}, schema interface{}, table interface{}, columns interface{}, limit interface{}) *MockSqlDatabase_GetTableRowSample_Call {
+	return &MockSqlDatabase_GetTableRowSample_Call{Call: _e.mock.On("GetTableRowSample", ctx, schema, table, columns, limit)}
+}
+
+func (_c *MockSqlDatabase_GetTableRowSample_Call) Run(run func(ctx context.Context, schema string, table string, columns []string, limit uint)) *MockSqlDatabase_GetTableRowSample_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].([]string), args[4].(uint))
+	})
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetTableRowSample_Call) Return(_a0 [][]*string, _a1 error) *MockSqlDatabase_GetTableRowSample_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetTableRowSample_Call) RunAndReturn(run func(context.Context, string, string, []string, uint) ([][]*string, error)) *MockSqlDatabase_GetTableRowSample_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTableSizeEstimates provides a mock function with given fields: ctx, tables
+func (_m *MockSqlDatabase) GetTableSizeEstimates(ctx context.Context, tables []*SchemaTable) ([]*TableSizeEstimate, error) {
+	ret := _m.Called(ctx, tables)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTableSizeEstimates")
+	}
+
+	var r0 []*TableSizeEstimate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*SchemaTable) ([]*TableSizeEstimate, error)); ok {
+		return rf(ctx, tables)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []*SchemaTable) []*TableSizeEstimate); ok {
+		r0 = rf(ctx, tables)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*TableSizeEstimate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []*SchemaTable) error); ok {
+		r1 = rf(ctx, tables)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSqlDatabase_GetTableSizeEstimates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTableSizeEstimates'
+type MockSqlDatabase_GetTableSizeEstimates_Call struct {
+	*mock.Call
+}
+
+// GetTableSizeEstimates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tables []*SchemaTable
+func (_e *MockSqlDatabase_Expecter) GetTableSizeEstimates(ctx interface{}, tables interface{}) *MockSqlDatabase_GetTableSizeEstimates_Call {
+	return &MockSqlDatabase_GetTableSizeEstimates_Call{Call: _e.mock.On("GetTableSizeEstimates", ctx, tables)}
+}
+
+func (_c *MockSqlDatabase_GetTableSizeEstimates_Call) Run(run func(ctx context.Context, tables []*SchemaTable)) *MockSqlDatabase_GetTableSizeEstimates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*SchemaTable))
+	})
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetTableSizeEstimates_Call) Return(_a0 []*TableSizeEstimate, _a1 error) *MockSqlDatabase_GetTableSizeEstimates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetTableSizeEstimates_Call) RunAndReturn(run func(context.Context, []*SchemaTable) ([]*TableSizeEstimate, error)) *MockSqlDatabase_GetTableSizeEstimates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUniqueConstraintsMap provides a mock function with given fields: ctx, schemas
 func (_m *MockSqlDatabase) GetUniqueConstraintsMap(ctx context.Context, schemas []string) (map[string][][]string, error) {
 	ret := _m.Called(ctx, schemas)
@@ -854,6 +1570,120 @@ func (_c *MockSqlDatabase_GetUniqueConstraintsMap_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// GetViewColumnMap provides a mock function with given fields: ctx
+func (_m *MockSqlDatabase) GetViewColumnMap(ctx context.Context) (map[string]map[string]*ColumnInfo, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetViewColumnMap")
+	}
+
+	var r0 map[string]map[string]*ColumnInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]map[string]*ColumnInfo, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]map[string]*ColumnInfo); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]map[string]*ColumnInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSqlDatabase_GetViewColumnMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetViewColumnMap'
+type MockSqlDatabase_GetViewColumnMap_Call struct {
+	*mock.Call
+}
+
+// GetViewColumnMap is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSqlDatabase_Expecter) GetViewColumnMap(ctx interface{}) *MockSqlDatabase_GetViewColumnMap_Call {
+	return &MockSqlDatabase_GetViewColumnMap_Call{Call: _e.mock.On("GetViewColumnMap", ctx)}
+}
+
+func (_c *MockSqlDatabase_GetViewColumnMap_Call) Run(run func(ctx context.Context)) *MockSqlDatabase_GetViewColumnMap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetViewColumnMap_Call) Return(_a0 map[string]map[string]*ColumnInfo, _a1 error) *MockSqlDatabase_GetViewColumnMap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetViewColumnMap_Call) RunAndReturn(run func(context.Context) (map[string]map[string]*ColumnInfo, error)) *MockSqlDatabase_GetViewColumnMap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsSuperuser provides a mock function with given fields: ctx
+func (_m *MockSqlDatabase) IsSuperuser(ctx context.Context) (bool, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsSuperuser")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (bool, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) bool); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSqlDatabase_IsSuperuser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsSuperuser'
+type MockSqlDatabase_IsSuperuser_Call struct {
+	*mock.Call
+}
+
+// IsSuperuser is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSqlDatabase_Expecter) IsSuperuser(ctx interface{}) *MockSqlDatabase_IsSuperuser_Call {
+	return &MockSqlDatabase_IsSuperuser_Call{Call: _e.mock.On("IsSuperuser", ctx)}
+}
+
+func (_c *MockSqlDatabase_IsSuperuser_Call) Run(run func(ctx context.Context)) *MockSqlDatabase_IsSuperuser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockSqlDatabase_IsSuperuser_Call) Return(_a0 bool, _a1 error) *MockSqlDatabase_IsSuperuser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSqlDatabase_IsSuperuser_Call) RunAndReturn(run func(context.Context) (bool, error)) *MockSqlDatabase_IsSuperuser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockSqlDatabase creates a new instance of MockSqlDatabase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockSqlDatabase(t interface {
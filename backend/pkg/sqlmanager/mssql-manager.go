@@ -0,0 +1,992 @@
+package sqlmanager
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
+
+	_ "github.com/doug-martin/goqu/v9/dialect/sqlserver"
+)
+
+// Covers SQL Server and Azure SQL. Identifiers are quoted with double quotes, which relies on QUOTED_IDENTIFIER being on,
+// as it is by default for every driver connection
+type MssqlManager struct {
+	pool  sqlconnect.SqlDBTX
+	close func()
+}
+
+// sys.tables only holds user tables, and tables that ship with SQL Server, such as dbo.sysdiagrams, are flagged as ms_shipped.
+// Identity and computed columns are returned with a generated type of IDENTITY and COMPUTED
+const getMssqlDatabaseSchemaQuery = `SELECT c.TABLE_SCHEMA, c.TABLE_NAME, c.COLUMN_NAME, c.DATA_TYPE, COALESCE(c.COLUMN_DEFAULT, ''), c.IS_NULLABLE,
+COALESCE(c.CHARACTER_MAXIMUM_LENGTH, -1), COALESCE(CAST(c.NUMERIC_PRECISION AS INT), -1), COALESCE(c.NUMERIC_SCALE, -1), c.ORDINAL_POSITION,
+CASE WHEN sc.is_identity = 1 THEN 'IDENTITY' WHEN sc.is_computed = 1 THEN 'COMPUTED' ELSE '' END
+FROM INFORMATION_SCHEMA.COLUMNS AS c
+JOIN sys.tables AS t ON t.name = c.TABLE_NAME AND SCHEMA_NAME(t.schema_id) = c.TABLE_SCHEMA
+JOIN sys.columns AS sc ON sc.object_id = t.object_id AND sc.name = c.COLUMN_NAME
+WHERE t.is_ms_shipped = 0
+ORDER BY c.TABLE_SCHEMA, c.TABLE_NAME, c.ORDINAL_POSITION`
+
+func (m *MssqlManager) GetDatabaseSchema(ctx context.Context) ([]*DatabaseSchemaRow, error) {
+	rows, err := m.pool.QueryContext(ctx, getMssqlDatabaseSchemaQuery)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return []*DatabaseSchemaRow{}, nil
+	}
+	defer rows.Close()
+
+	result := []*DatabaseSchemaRow{}
+	for rows.Next() {
+		row := &DatabaseSchemaRow{TableType: BaseTableType}
+		var generatedType string
+		if err := rows.Scan(
+			&row.TableSchema,
+			&row.TableName,
+			&row.ColumnName,
+			&row.DataType,
+			&row.ColumnDefault,
+			&row.IsNullable,
+			&row.CharacterMaximumLength,
+			&row.NumericPrecision,
+			&row.NumericScale,
+			&row.OrdinalPosition,
+			&generatedType,
+		); err != nil {
+			return nil, err
+		}
+		if generatedType != "" {
+			row.GeneratedType = Ptr(generatedType)
+		}
+		result = append(result, row)
+	}
+	return result, rows.Err()
+}
+
+// returns: {dbo.users: { id: struct{}{}, created_at: struct{}{}}}
+func (m *MssqlManager) GetSchemaColumnMap(ctx context.Context) (map[string]map[string]*ColumnInfo, error) {
+	dbSchemas, err := m.GetDatabaseSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := GetUniqueSchemaColMappings(dbSchemas)
+	return result, nil
+}
+
+const getMssqlViewColumnsQuery = `SELECT c.TABLE_SCHEMA, c.TABLE_NAME, c.COLUMN_NAME, c.DATA_TYPE, c.IS_NULLABLE, c.ORDINAL_POSITION
+FROM INFORMATION_SCHEMA.COLUMNS AS c
+JOIN INFORMATION_SCHEMA.TABLES AS t ON t.TABLE_CATALOG = c.TABLE_CATALOG AND t.TABLE_SCHEMA = c.TABLE_SCHEMA AND t.TABLE_NAME = c.TABLE_NAME
+WHERE t.TABLE_TYPE = 'VIEW' AND c.TABLE_SCHEMA NOT IN ('sys', 'INFORMATION_SCHEMA')
+ORDER BY c.TABLE_SCHEMA, c.TABLE_NAME, c.ORDINAL_POSITION`
+
+// Returns the columns of every view, including indexed views, keyed by schema.table and then column
+func (m *MssqlManager) GetViewColumnMap(ctx context.Context) (map[string]map[string]*ColumnInfo, error) {
+	rows, err := m.pool.QueryContext(ctx, getMssqlViewColumnsQuery)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return map[string]map[string]*ColumnInfo{}, nil
+	}
+	defer rows.Close()
+
+	output := map[string]map[string]*ColumnInfo{}
+	for rows.Next() {
+		var schema, view, column, dataType, isNullable string
+		var ordinalPosition int32
+		if err := rows.Scan(&schema, &view, &column, &dataType, &isNullable, &ordinalPosition); err != nil {
+			return nil, err
+		}
+		key := BuildTable(schema, view)
+		if _, ok := output[key]; !ok {
+			output[key] = map[string]*ColumnInfo{}
+		}
+		output[key][column] = &ColumnInfo{
+			OrdinalPosition: ordinalPosition,
+			IsNullable:      convertNullableTextToBool(isNullable),
+			DataType:        dataType,
+			TableType:       ViewTableType,
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+const (
+	mssqlPrimaryKeyConstraint = "PRIMARY KEY"
+	mssqlUniqueConstraint     = "UNIQUE"
+	mssqlForeignKeyConstraint = "FOREIGN KEY"
+)
+
+// A primary key, unique or foreign key constraint along with its columns in key order
+type mssqlConstraint struct {
+	ConstraintType    string
+	SchemaName        string
+	TableName         string
+	ConstraintName    string
+	Columns           []string
+	NotNullable       []bool
+	ForeignSchemaName string
+	ForeignTableName  string
+	ForeignColumns    []string
+}
+
+// The information schema of SQL Server does not say which columns of the referenced key a foreign key column points at,
+// so the key constraints and foreign keys are read from the sys catalog instead.
+// The placeholders of the schemas are shared by both halves of the union, and the last column orders the columns of each constraint
+const getMssqlTableConstraintsQuery = `SELECT CASE WHEN kc.type = 'PK' THEN 'PRIMARY KEY' ELSE 'UNIQUE' END, SCHEMA_NAME(t.schema_id), t.name, kc.name, c.name,
+c.is_nullable, '', '', '', CAST(ic.key_ordinal AS INT)
+FROM sys.key_constraints AS kc
+JOIN sys.tables AS t ON t.object_id = kc.parent_object_id
+JOIN sys.index_columns AS ic ON ic.object_id = kc.parent_object_id AND ic.index_id = kc.unique_index_id
+JOIN sys.columns AS c ON c.object_id = ic.object_id AND c.column_id = ic.column_id
+WHERE ic.key_ordinal > 0 AND SCHEMA_NAME(t.schema_id) IN (%[1]s)
+UNION ALL
+SELECT 'FOREIGN KEY', SCHEMA_NAME(t.schema_id), t.name, fk.name, c.name,
+c.is_nullable, SCHEMA_NAME(rt.schema_id), rt.name, rc.name, fkc.constraint_column_id
+FROM sys.foreign_keys AS fk
+JOIN sys.tables AS t ON t.object_id = fk.parent_object_id
+JOIN sys.foreign_key_columns AS fkc ON fkc.constraint_object_id = fk.object_id
+JOIN sys.columns AS c ON c.object_id = fkc.parent_object_id AND c.column_id = fkc.parent_column_id
+JOIN sys.tables AS rt ON rt.object_id = fkc.referenced_object_id
+JOIN sys.columns AS rc ON rc.object_id = fkc.referenced_object_id AND rc.column_id = fkc.referenced_column_id
+WHERE SCHEMA_NAME(t.schema_id) IN (%[1]s)
+ORDER BY 2, 3, 4, 10`
+
+// Returns every constraint of the tables in the given schemas, ordered by table and then constraint name
+func (m *MssqlManager) getTableConstraints(ctx context.Context, schemas []string) ([]*mssqlConstraint, error) {
+	if len(schemas) == 0 {
+		return []*mssqlConstraint{}, nil
+	}
+	placeholders, args := buildMssqlPlaceholders(schemas)
+	rows, err := m.pool.QueryContext(ctx, fmt.Sprintf(getMssqlTableConstraintsQuery, placeholders), args...)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return []*mssqlConstraint{}, nil
+	}
+	defer rows.Close()
+
+	output := []*mssqlConstraint{}
+	for rows.Next() {
+		var constraintType, schema, table, name, column, foreignSchema, foreignTable, foreignColumn string
+		var isNullable bool
+		var ordinal int32
+		if err := rows.Scan(
+			&constraintType,
+			&schema,
+			&table,
+			&name,
+			&column,
+			&isNullable,
+			&foreignSchema,
+			&foreignTable,
+			&foreignColumn,
+			&ordinal,
+		); err != nil {
+			return nil, err
+		}
+		// constraint names are unique within a schema, and the rows of a constraint are adjacent
+		var current *mssqlConstraint
+		if len(output) > 0 {
+			last := output[len(output)-1]
+			if last.SchemaName == schema && last.TableName == table && last.ConstraintName == name {
+				current = last
+			}
+		}
+		if current == nil {
+			current = &mssqlConstraint{
+				ConstraintType:    constraintType,
+				SchemaName:        schema,
+				TableName:         table,
+				ConstraintName:    name,
+				ForeignSchemaName: foreignSchema,
+				ForeignTableName:  foreignTable,
+			}
+			output = append(output, current)
+		}
+		current.Columns = append(current.Columns, column)
+		current.NotNullable = append(current.NotNullable, !isNullable)
+		if constraintType == mssqlForeignKeyConstraint {
+			current.ForeignColumns = append(current.ForeignColumns, foreignColumn)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+func (m *MssqlManager) GetTableConstraintsBySchema(ctx context.Context, schemas []string) (*TableConstraints, error) {
+	if len(schemas) == 0 {
+		return &TableConstraints{}, nil
+	}
+	constraints, err := m.getTableConstraints(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+
+	foreignKeyMap := map[string][]*ForeignConstraint{}
+	primaryKeyMap := map[string][]string{}
+	uniqueConstraintsMap := map[string][][]string{}
+	for _, constraint := range constraints {
+		key := BuildTable(constraint.SchemaName, constraint.TableName)
+		switch constraint.ConstraintType {
+		case mssqlPrimaryKeyConstraint:
+			primaryKeyMap[key] = append(primaryKeyMap[key], constraint.Columns...)
+		case mssqlUniqueConstraint:
+			uniqueConstraintsMap[key] = append(uniqueConstraintsMap[key], constraint.Columns)
+		case mssqlForeignKeyConstraint:
+			foreignKeyMap[key] = append(foreignKeyMap[key], &ForeignConstraint{
+				Columns:     constraint.Columns,
+				NotNullable: constraint.NotNullable,
+				ForeignKey: &ForeignKey{
+					Table:   BuildTable(constraint.ForeignSchemaName, constraint.ForeignTableName),
+					Columns: constraint.ForeignColumns,
+				},
+			})
+		}
+	}
+	return &TableConstraints{
+		ForeignKeyConstraints: foreignKeyMap,
+		PrimaryKeyConstraints: primaryKeyMap,
+		UniqueConstraints:     uniqueConstraintsMap,
+	}, nil
+}
+
+func (m *MssqlManager) GetForeignKeyConstraints(ctx context.Context, schemas []string) ([]*ForeignKeyConstraintsRow, error) {
+	constraints, err := m.getTableConstraints(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	result := []*ForeignKeyConstraintsRow{}
+	for _, constraint := range constraints {
+		if constraint.ConstraintType != mssqlForeignKeyConstraint {
+			continue
+		}
+		for idx, column := range constraint.Columns {
+			result = append(result, &ForeignKeyConstraintsRow{
+				ConstraintName:    constraint.ConstraintName,
+				SchemaName:        constraint.SchemaName,
+				TableName:         constraint.TableName,
+				ColumnName:        column,
+				IsNullable:        !constraint.NotNullable[idx],
+				ForeignSchemaName: constraint.ForeignSchemaName,
+				ForeignTableName:  constraint.ForeignTableName,
+				ForeignColumnName: constraint.ForeignColumns[idx],
+			})
+		}
+	}
+	return result, nil
+}
+
+// Key is schema.table value is list of tables that key depends on
+func (m *MssqlManager) GetForeignKeyConstraintsMap(ctx context.Context, schemas []string) (map[string][]*ForeignConstraint, error) {
+	constraints, err := m.GetTableConstraintsBySchema(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	if constraints.ForeignKeyConstraints == nil {
+		return map[string][]*ForeignConstraint{}, nil
+	}
+	return constraints.ForeignKeyConstraints, nil
+}
+
+func (m *MssqlManager) GetPrimaryKeyConstraints(ctx context.Context, schemas []string) ([]*PrimaryKey, error) {
+	constraints, err := m.getTableConstraints(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	result := []*PrimaryKey{}
+	for _, constraint := range constraints {
+		if constraint.ConstraintType != mssqlPrimaryKeyConstraint {
+			continue
+		}
+		result = append(result, &PrimaryKey{
+			Schema:  constraint.SchemaName,
+			Table:   constraint.TableName,
+			Columns: constraint.Columns,
+		})
+	}
+	return result, nil
+}
+
+func (m *MssqlManager) GetPrimaryKeyConstraintsMap(ctx context.Context, schemas []string) (map[string][]string, error) {
+	constraints, err := m.GetTableConstraintsBySchema(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	if constraints.PrimaryKeyConstraints == nil {
+		return map[string][]string{}, nil
+	}
+	return constraints.PrimaryKeyConstraints, nil
+}
+
+func (m *MssqlManager) GetUniqueConstraintsMap(ctx context.Context, schemas []string) (map[string][][]string, error) {
+	constraints, err := m.GetTableConstraintsBySchema(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	if constraints.UniqueConstraints == nil {
+		return map[string][][]string{}, nil
+	}
+	return constraints.UniqueConstraints, nil
+}
+
+// Filtered indexes are the SQL Server equivalent of partial indexes
+const getMssqlPartialUniqueIndexesQuery = `SELECT SCHEMA_NAME(t.schema_id), t.name, i.name, c.name, i.filter_definition
+FROM sys.indexes AS i
+JOIN sys.tables AS t ON t.object_id = i.object_id
+JOIN sys.index_columns AS ic ON ic.object_id = i.object_id AND ic.index_id = i.index_id
+JOIN sys.columns AS c ON c.object_id = ic.object_id AND c.column_id = ic.column_id
+WHERE i.is_unique = 1 AND i.has_filter = 1 AND ic.key_ordinal > 0 AND SCHEMA_NAME(t.schema_id) IN (%s)
+ORDER BY 1, 2, 3, ic.key_ordinal`
+
+func (m *MssqlManager) GetPartialUniqueIndexesMap(ctx context.Context, schemas []string) (map[string][]*PartialUniqueIndex, error) {
+	if len(schemas) == 0 {
+		return map[string][]*PartialUniqueIndex{}, nil
+	}
+	placeholders, args := buildMssqlPlaceholders(schemas)
+	rows, err := m.pool.QueryContext(ctx, fmt.Sprintf(getMssqlPartialUniqueIndexesQuery, placeholders), args...)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return map[string][]*PartialUniqueIndex{}, nil
+	}
+	defer rows.Close()
+
+	output := map[string][]*PartialUniqueIndex{}
+	for rows.Next() {
+		var schema, table, indexName, column, predicate string
+		if err := rows.Scan(&schema, &table, &indexName, &column, &predicate); err != nil {
+			return nil, err
+		}
+		key := BuildTable(schema, table)
+		indexes := output[key]
+		if len(indexes) == 0 || indexes[len(indexes)-1].Name != indexName {
+			indexes = append(indexes, &PartialUniqueIndex{Name: indexName, Columns: []string{}, Predicate: predicate})
+		}
+		indexes[len(indexes)-1].Columns = append(indexes[len(indexes)-1].Columns, column)
+		output[key] = indexes
+	}
+	return output, rows.Err()
+}
+
+// SQL Server does not support exclusion constraints
+func (m *MssqlManager) GetExclusionConstraintsMap(ctx context.Context, schemas []string) (map[string][]*ExclusionConstraint, error) {
+	return map[string][]*ExclusionConstraint{}, nil
+}
+
+// Access is often granted through database ownership or fixed roles, such as db_datareader, which are not listed as grants,
+// so the effective privileges of the connected user are checked instead
+const getMssqlRolePermissionsQuery = `SELECT SCHEMA_NAME(t.schema_id), t.name, p.privilege_type
+FROM sys.tables AS t
+CROSS JOIN (VALUES ('DELETE'), ('INSERT'), ('SELECT'), ('UPDATE')) AS p(privilege_type)
+WHERE t.is_ms_shipped = 0
+AND HAS_PERMS_BY_NAME(QUOTENAME(SCHEMA_NAME(t.schema_id)) + '.' + QUOTENAME(t.name), 'OBJECT', p.privilege_type) = 1
+ORDER BY 1, 2, 3`
+
+// The role is not used as the permissions of another principal can only be checked by impersonating it
+func (m *MssqlManager) GetRolePermissionsMap(ctx context.Context, role string) (map[string][]string, error) {
+	rows, err := m.pool.QueryContext(ctx, getMssqlRolePermissionsQuery)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return map[string][]string{}, nil
+	}
+	defer rows.Close()
+
+	schemaTablePrivsMap := map[string][]string{}
+	for rows.Next() {
+		var schema, table, privilege string
+		if err := rows.Scan(&schema, &table, &privilege); err != nil {
+			return nil, err
+		}
+		key := BuildTable(schema, table)
+		schemaTablePrivsMap[key] = append(schemaTablePrivsMap[key], privilege)
+	}
+	return schemaTablePrivsMap, rows.Err()
+}
+
+// Infers the columns of a virtual table by running its query without returning any rows
+func (m *MssqlManager) GetQueryColumns(ctx context.Context, query string) (map[string]*ColumnInfo, error) {
+	sql, err := BuildVirtualTableSelectQuery(MssqlDriver, query, nil)
+	if err != nil {
+		return nil, err
+	}
+	// SQL Server has no LIMIT clause, and TOP can not be added to the end of the query
+	rows, err := m.pool.QueryContext(ctx, fmt.Sprintf("%s WHERE 1 = 0", sql))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	columns := map[string]*ColumnInfo{}
+	for idx, columnType := range columnTypes {
+		if _, ok := columns[columnType.Name()]; ok {
+			return nil, fmt.Errorf("query returns more than one column named %s", columnType.Name())
+		}
+		isNullable, ok := columnType.Nullable()
+		columns[columnType.Name()] = &ColumnInfo{
+			OrdinalPosition: int32(idx + 1),
+			IsNullable:      isNullable || !ok,
+			DataType:        strings.ToLower(columnType.DatabaseTypeName()),
+			TableType:       VirtualTableType,
+		}
+	}
+	return columns, rows.Err()
+}
+
+// A column as it is declared in the table definition
+type mssqlColumnDefinition struct {
+	Name              string
+	DataType          string
+	MaxLength         int16
+	Precision         uint8
+	Scale             uint8
+	IsNullable        bool
+	Default           string
+	IsIdentity        bool
+	IdentitySeed      int64
+	IdentityIncrement int64
+	Computed          string
+}
+
+// max_length is in bytes and is -1 for the (max) types
+const getMssqlTableColumnsQuery = `SELECT c.name, ty.name, c.max_length, c.precision, c.scale, c.is_nullable,
+COALESCE(dc.definition, ''), c.is_identity, COALESCE(CAST(ic.seed_value AS BIGINT), 0), COALESCE(CAST(ic.increment_value AS BIGINT), 0),
+COALESCE(cc.definition, '')
+FROM sys.columns AS c
+JOIN sys.types AS ty ON ty.user_type_id = c.user_type_id
+LEFT JOIN sys.default_constraints AS dc ON dc.object_id = c.default_object_id
+LEFT JOIN sys.identity_columns AS ic ON ic.object_id = c.object_id AND ic.column_id = c.column_id
+LEFT JOIN sys.computed_columns AS cc ON cc.object_id = c.object_id AND cc.column_id = c.column_id
+WHERE c.object_id = OBJECT_ID(QUOTENAME(@p1) + '.' + QUOTENAME(@p2))
+ORDER BY c.column_id`
+
+func (m *MssqlManager) getTableColumns(ctx context.Context, schema, table string) ([]*mssqlColumnDefinition, error) {
+	rows, err := m.pool.QueryContext(ctx, getMssqlTableColumnsQuery, schema, table)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return []*mssqlColumnDefinition{}, nil
+	}
+	defer rows.Close()
+
+	output := []*mssqlColumnDefinition{}
+	for rows.Next() {
+		column := &mssqlColumnDefinition{}
+		if err := rows.Scan(
+			&column.Name,
+			&column.DataType,
+			&column.MaxLength,
+			&column.Precision,
+			&column.Scale,
+			&column.IsNullable,
+			&column.Default,
+			&column.IsIdentity,
+			&column.IdentitySeed,
+			&column.IdentityIncrement,
+			&column.Computed,
+		); err != nil {
+			return nil, err
+		}
+		output = append(output, column)
+	}
+	return output, rows.Err()
+}
+
+// SQL Server does not return the DDL of a table, so the create statement is built from the sys catalog.
+// Primary key and unique constraints are created along with the table, foreign keys are added by GetTableInitStatements
+func (m *MssqlManager) GetCreateTableStatement(ctx context.Context, schema, table string) (string, error) {
+	columns, err := m.getTableColumns(ctx, schema, table)
+	if err != nil {
+		return "", err
+	}
+	if len(columns) == 0 {
+		return "", fmt.Errorf("unable to find table %s", BuildTable(schema, table))
+	}
+	constraints, err := m.getTableConstraints(ctx, []string{schema})
+	if err != nil {
+		return "", err
+	}
+	tableConstraints := []*mssqlConstraint{}
+	for _, constraint := range constraints {
+		if constraint.ConstraintType != mssqlForeignKeyConstraint && constraint.TableName == table {
+			tableConstraints = append(tableConstraints, constraint)
+		}
+	}
+	return buildMssqlCreateTableStatement(schema, table, columns, tableConstraints), nil
+}
+
+// SQL Server does not support CREATE TABLE IF NOT EXISTS, so the statement is guarded by a lookup of the table
+func buildMssqlCreateTableStatement(
+	schema, table string,
+	columns []*mssqlColumnDefinition,
+	constraints []*mssqlConstraint,
+) string {
+	definitions := make([]string, 0, len(columns)+len(constraints))
+	for _, column := range columns {
+		name := EscapeMssqlColumn(column.Name)
+		if column.Computed != "" {
+			definitions = append(definitions, fmt.Sprintf("%s AS %s", name, column.Computed))
+			continue
+		}
+		definition := fmt.Sprintf("%s %s", name, buildMssqlColumnType(column))
+		if column.IsIdentity {
+			definition = fmt.Sprintf("%s IDENTITY(%d, %d)", definition, column.IdentitySeed, column.IdentityIncrement)
+		}
+		if column.IsNullable {
+			definition = fmt.Sprintf("%s NULL", definition)
+		} else {
+			definition = fmt.Sprintf("%s NOT NULL", definition)
+		}
+		if column.Default != "" {
+			definition = fmt.Sprintf("%s DEFAULT %s", definition, column.Default)
+		}
+		definitions = append(definitions, definition)
+	}
+	// the primary key is declared before the unique constraints
+	slices.SortStableFunc(constraints, func(a, b *mssqlConstraint) int {
+		return cmp.Compare(getMssqlConstraintOrder(a), getMssqlConstraintOrder(b))
+	})
+	for _, constraint := range constraints {
+		definitions = append(definitions, fmt.Sprintf(
+			"CONSTRAINT %s %s (%s)",
+			EscapeMssqlColumn(constraint.ConstraintName),
+			constraint.ConstraintType,
+			strings.Join(EscapeMssqlColumns(constraint.Columns), ", "),
+		))
+	}
+	return fmt.Sprintf(
+		"IF NOT EXISTS (SELECT 1 FROM sys.tables WHERE SCHEMA_NAME(schema_id) = N'%s' AND name = N'%s')\nCREATE TABLE %s.%s (\n  %s\n);",
+		escapeMssqlString(schema),
+		escapeMssqlString(table),
+		EscapeMssqlColumn(schema),
+		EscapeMssqlColumn(table),
+		strings.Join(definitions, ",\n  "),
+	)
+}
+
+func getMssqlConstraintOrder(constraint *mssqlConstraint) int {
+	if constraint.ConstraintType == mssqlPrimaryKeyConstraint {
+		return 0
+	}
+	return 1
+}
+
+// Adds the length, precision or scale that the type was declared with
+func buildMssqlColumnType(column *mssqlColumnDefinition) string {
+	switch column.DataType {
+	case "char", "varchar", "binary", "varbinary":
+		if column.MaxLength == -1 {
+			return fmt.Sprintf("%s(max)", column.DataType)
+		}
+		return fmt.Sprintf("%s(%d)", column.DataType, column.MaxLength)
+	case "nchar", "nvarchar":
+		// the length of unicode types is declared in characters, which take two bytes each
+		if column.MaxLength == -1 {
+			return fmt.Sprintf("%s(max)", column.DataType)
+		}
+		return fmt.Sprintf("%s(%d)", column.DataType, column.MaxLength/2)
+	case "decimal", "numeric":
+		return fmt.Sprintf("%s(%d, %d)", column.DataType, column.Precision, column.Scale)
+	case "datetime2", "datetimeoffset", "time":
+		return fmt.Sprintf("%s(%d)", column.DataType, column.Scale)
+	default:
+		return column.DataType
+	}
+}
+
+func escapeMssqlString(value string) string {
+	return strings.ReplaceAll(value, "'", "''")
+}
+
+// Destination table and column overrides are not supported as the create statement is built from the source table as is.
+// Foreign keys are added once every table has been created
+func (m *MssqlManager) GetTableInitStatements(ctx context.Context, tables []*SchemaTable, opts *TableInitStatementOpts) ([]*TableInitStatement, error) {
+	if opts != nil && (len(opts.DestinationTables) > 0 || len(opts.DestinationColumns) > 0) {
+		return nil, fmt.Errorf("mssql table init statements with destination overrides: %w", errors.ErrUnsupported)
+	}
+	schemas := []string{}
+	for _, schematable := range tables {
+		if !slices.Contains(schemas, schematable.Schema) {
+			schemas = append(schemas, schematable.Schema)
+		}
+	}
+	constraints, err := m.getTableConstraints(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	foreignKeys := map[string][]*mssqlConstraint{}
+	for _, constraint := range constraints {
+		if constraint.ConstraintType != mssqlForeignKeyConstraint {
+			continue
+		}
+		key := BuildTable(constraint.SchemaName, constraint.TableName)
+		foreignKeys[key] = append(foreignKeys[key], constraint)
+	}
+
+	output := []*TableInitStatement{}
+	for _, schematable := range tables {
+		createStmt, err := m.GetCreateTableStatement(ctx, schematable.Schema, schematable.Table)
+		if err != nil {
+			return nil, err
+		}
+		alterStmts := []*AlterTableStatement{}
+		for _, constraint := range foreignKeys[BuildTable(schematable.Schema, schematable.Table)] {
+			alterStmts = append(alterStmts, &AlterTableStatement{
+				Statement:      buildMssqlAddForeignKeyStatement(constraint),
+				ConstraintType: ForeignConstraintType,
+			})
+		}
+		output = append(output, &TableInitStatement{
+			CreateTableStatement: createStmt,
+			AlterTableStatements: alterStmts,
+			IndexStatements:      []string{},
+		})
+	}
+	return output, nil
+}
+
+func buildMssqlAddForeignKeyStatement(constraint *mssqlConstraint) string {
+	return fmt.Sprintf(
+		"ALTER TABLE %s.%s ADD CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s.%s (%s);",
+		EscapeMssqlColumn(constraint.SchemaName),
+		EscapeMssqlColumn(constraint.TableName),
+		EscapeMssqlColumn(constraint.ConstraintName),
+		strings.Join(EscapeMssqlColumns(constraint.Columns), ", "),
+		EscapeMssqlColumn(constraint.ForeignSchemaName),
+		EscapeMssqlColumn(constraint.ForeignTableName),
+		strings.Join(EscapeMssqlColumns(constraint.ForeignColumns), ", "),
+	)
+}
+
+// The statements of a batch are sent together, so a batch runs in a single round trip
+func (m *MssqlManager) BatchExec(ctx context.Context, batchSize int, statements []string, opts *BatchExecOpts) error {
+	for i := 0; i < len(statements); i += batchSize {
+		end := i + batchSize
+		if end > len(statements) {
+			end = len(statements)
+		}
+
+		batchCmd := strings.Join(statements[i:end], "\n")
+		if opts != nil && opts.Prefix != nil && *opts.Prefix != "" {
+			batchCmd = fmt.Sprintf("%s %s", *opts.Prefix, batchCmd)
+		}
+		_, err := m.pool.ExecContext(ctx, batchCmd)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MssqlManager) GetTableRowCount(
+	ctx context.Context,
+	schema, table string,
+	whereClause *string,
+) (int64, error) {
+	builder := goqu.Dialect(MssqlDriver)
+	query := builder.From(goquTable(schema, table)).Select(goqu.COUNT("*"))
+	if whereClause != nil && *whereClause != "" {
+		query = query.Where(goqu.L(*whereClause))
+	}
+	sql, _, err := query.ToSQL()
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = m.pool.QueryRowContext(ctx, sql).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (m *MssqlManager) GetColumnDistinctCount(
+	ctx context.Context,
+	schema, table, column string,
+) (int64, error) {
+	sql, err := buildColumnDistinctCountQuery(MssqlDriver, schema, table, column)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = m.pool.QueryRowContext(ctx, sql).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// Indexed views are kept up to date by SQL Server as their tables change, so they never need to be refreshed
+func (m *MssqlManager) GetMaterializedViews(ctx context.Context) ([]*MaterializedView, error) {
+	return []*MaterializedView{}, nil
+}
+
+// Triggers are only disabled on postgres destinations
+func (m *MssqlManager) GetEnabledTableTriggers(ctx context.Context, tables []*SchemaTable) ([]*TableTrigger, error) {
+	return []*TableTrigger{}, nil
+}
+
+// Rows are counted from the heap or clustered index of each table, and the size includes every index. Pages are 8 KB
+func (m *MssqlManager) GetTableSizeEstimates(ctx context.Context, tables []*SchemaTable) ([]*TableSizeEstimate, error) {
+	if len(tables) == 0 {
+		return []*TableSizeEstimate{}, nil
+	}
+	names := make([]string, 0, len(tables))
+	for _, table := range tables {
+		names = append(names, BuildTable(table.Schema, table.Table))
+	}
+	placeholders, args := buildMssqlPlaceholders(names)
+	query := fmt.Sprintf(`SELECT SCHEMA_NAME(t.schema_id), t.name,
+CAST(SUM(CASE WHEN ps.index_id IN (0, 1) THEN ps.row_count ELSE 0 END) AS BIGINT), CAST(SUM(ps.reserved_page_count) AS BIGINT) * 8192
+FROM sys.tables AS t
+JOIN sys.dm_db_partition_stats AS ps ON ps.object_id = t.object_id
+WHERE SCHEMA_NAME(t.schema_id) + '.' + t.name IN (%s)
+GROUP BY t.schema_id, t.name
+ORDER BY 1, 2`, placeholders)
+
+	rows, err := m.pool.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	estimates := []*TableSizeEstimate{}
+	for rows.Next() {
+		estimate := &TableSizeEstimate{}
+		if err := rows.Scan(&estimate.Schema, &estimate.Table, &estimate.RowCount, &estimate.SizeBytes); err != nil {
+			return nil, err
+		}
+		estimates = append(estimates, estimate)
+	}
+	return estimates, rows.Err()
+}
+
+// SQL Server does not have extensions
+func (m *MssqlManager) GetExtensions(ctx context.Context) ([]*Extension, error) {
+	return []*Extension{}, nil
+}
+
+// Superuser rights are only needed to create postgres extensions
+func (m *MssqlManager) IsSuperuser(ctx context.Context) (bool, error) {
+	return false, nil
+}
+
+func (m *MssqlManager) GetNumericColumnSample(
+	ctx context.Context,
+	schema, table, column string,
+	limit uint,
+) ([]float64, error) {
+	sql, err := buildNumericColumnSampleQuery(MssqlDriver, schema, table, column, limit)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := m.pool.QueryContext(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	values := []float64{}
+	for rows.Next() {
+		var value float64
+		if err := rows.Scan(&value); err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, rows.Err()
+}
+
+func (m *MssqlManager) GetTableRowSample(
+	ctx context.Context,
+	schema, table string,
+	columns []string,
+	limit uint,
+) ([][]*string, error) {
+	sql, err := buildTableRowSampleQuery(MssqlDriver, schema, table, columns, limit)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := m.pool.QueryContext(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	output := [][]*string{}
+	for rows.Next() {
+		row := make([]*string, len(columns))
+		dest := make([]any, len(columns))
+		for idx := range row {
+			dest[idx] = &row[idx]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		output = append(output, row)
+	}
+	return output, rows.Err()
+}
+
+func (m *MssqlManager) GetEquivalenceClasses(
+	ctx context.Context,
+	schema, table string,
+	quasiIdentifiers []string,
+	sensitiveColumn *string,
+) ([]*EquivalenceClass, error) {
+	sql, err := buildEquivalenceClassQuery(MssqlDriver, schema, table, quasiIdentifiers, sensitiveColumn)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := m.pool.QueryContext(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	output := []*EquivalenceClass{}
+	for rows.Next() {
+		class := &EquivalenceClass{Values: make([]*string, len(quasiIdentifiers))}
+		dest := make([]any, 0, len(quasiIdentifiers)+2)
+		for idx := range class.Values {
+			dest = append(dest, &class.Values[idx])
+		}
+		dest = append(dest, &class.Size)
+		if sensitiveColumn != nil {
+			dest = append(dest, &class.DistinctSensitiveValues)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		output = append(output, class)
+	}
+	return output, rows.Err()
+}
+
+func (m *MssqlManager) GetForeignKeyOrphanCount(
+	ctx context.Context,
+	schema, table string,
+	constraint *ForeignConstraint,
+) (int64, error) {
+	sql, err := buildForeignKeyOrphanCountQuery(MssqlDriver, schema, table, constraint)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = m.pool.QueryRowContext(ctx, sql).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (m *MssqlManager) Exec(ctx context.Context, statement string) error {
+	_, err := m.pool.ExecContext(ctx, statement)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *MssqlManager) Close() {
+	if m.pool != nil && m.close != nil {
+		m.close()
+	}
+}
+
+// Returns @p1, @p2, ... @pn along with the values as query args
+func buildMssqlPlaceholders(values []string) (string, []any) {
+	args := make([]any, 0, len(values))
+	placeholders := make([]string, 0, len(values))
+	for idx, value := range values {
+		args = append(args, value)
+		placeholders = append(placeholders, fmt.Sprintf("@p%d", idx+1))
+	}
+	return strings.Join(placeholders, ", "), args
+}
+
+// SQL Server can not truncate a table that is referenced by a foreign key, even when the referencing table is empty,
+// so the rows are deleted instead
+func BuildMssqlTruncateStatement(
+	schema string,
+	table string,
+) (string, error) {
+	builder := goqu.Dialect(MssqlDriver)
+	sqltable := goqu.S(schema).Table(table)
+	stmt, _, err := builder.Delete(sqltable).ToSQL()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s;", stmt), nil
+}
+
+// Returns the expression that selects the column so that its value scans into bytes that read the same as the value does in SQL Server.
+// Only the types that the go-mssqldb driver does not return as text or numbers are converted, every other column is selected as is.
+// Binary values are selected as is and are hex encoded once they are read, see EncodeMssqlBinary
+func BuildMssqlSelectColumn(column, dataType string) string {
+	escaped := EscapeMssqlColumn(column)
+	switch strings.ToLower(dataType) {
+	case "uniqueidentifier":
+		// returned in the mixed endian byte order that guids are stored in
+		return fmt.Sprintf("CAST(%s AS NVARCHAR(36)) AS %s", escaped, escaped)
+	case "date":
+		return fmt.Sprintf("CAST(%s AS NVARCHAR(10)) AS %s", escaped, escaped)
+	case "time":
+		return fmt.Sprintf("CAST(%s AS NVARCHAR(16)) AS %s", escaped, escaped)
+	case "datetime", "datetime2", "smalldatetime":
+		// the driver returns these as a time.Time in UTC, even though they do not have a time zone
+		return fmt.Sprintf("CONVERT(NVARCHAR(33), %s, 126) AS %s", escaped, escaped)
+	case "geography", "geometry":
+		// returned in the binary format of the CLR type
+		return fmt.Sprintf("%s.STAsText() AS %s", escaped, escaped)
+	case "hierarchyid":
+		return fmt.Sprintf("%s.ToString() AS %s", escaped, escaped)
+	default:
+		return escaped
+	}
+}
+
+// Determines if the values of the data type are raw bytes that are not readable as text
+func IsMssqlBinaryType(dataType string) bool {
+	switch strings.ToLower(dataType) {
+	case "binary", "varbinary", "image", "timestamp", "rowversion":
+		return true
+	default:
+		return false
+	}
+}
+
+// Hex encodes a binary value the way SQL Server writes binary literals, e.g. 0x0A1B
+func EncodeMssqlBinary(value []byte) []byte {
+	if value == nil {
+		return nil
+	}
+	return []byte(fmt.Sprintf("0x%X", value))
+}
+
+func EscapeMssqlColumns(cols []string) []string {
+	outcols := make([]string, len(cols))
+	for idx := range cols {
+		outcols[idx] = EscapeMssqlColumn(cols[idx])
+	}
+	return outcols
+}
+
+func EscapeMssqlColumn(col string) string {
+	return QuoteIdentifier(MssqlDriver, col)
+}
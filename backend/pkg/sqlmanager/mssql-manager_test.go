@@ -0,0 +1,159 @@
+package sqlmanager
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MssqlManager_GetDatabaseSchema(t *testing.T) {
+	db, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+	manager := &MssqlManager{pool: db}
+
+	sqlMock.ExpectQuery(regexp.QuoteMeta(getMssqlDatabaseSchemaQuery)).WillReturnRows(
+		sqlmock.NewRows([]string{"table_schema", "table_name", "column_name", "data_type", "column_default", "is_nullable", "character_maximum_length", "numeric_precision", "numeric_scale", "ordinal_position", "generated_type"}).
+			AddRow("dbo", "users", "id", "int", "", "NO", -1, 10, 0, 1, "IDENTITY").
+			AddRow("dbo", "users", "email", "nvarchar", "", "YES", 256, -1, -1, 2, "").
+			AddRow("dbo", "users", "created_at", "datetime2", "(sysutcdatetime())", "NO", -1, -1, -1, 3, ""),
+	)
+
+	rows, err := manager.GetDatabaseSchema(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []*DatabaseSchemaRow{
+		{TableSchema: "dbo", TableName: "users", ColumnName: "id", DataType: "int", IsNullable: "NO", CharacterMaximumLength: -1, NumericPrecision: 10, NumericScale: 0, OrdinalPosition: 1, GeneratedType: Ptr("IDENTITY"), TableType: BaseTableType},
+		{TableSchema: "dbo", TableName: "users", ColumnName: "email", DataType: "nvarchar", IsNullable: "YES", CharacterMaximumLength: 256, NumericPrecision: -1, NumericScale: -1, OrdinalPosition: 2, TableType: BaseTableType},
+		{TableSchema: "dbo", TableName: "users", ColumnName: "created_at", DataType: "datetime2", ColumnDefault: "(sysutcdatetime())", IsNullable: "NO", CharacterMaximumLength: -1, NumericPrecision: -1, NumericScale: -1, OrdinalPosition: 3, TableType: BaseTableType},
+	}, rows)
+	require.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func Test_MssqlManager_GetTableConstraintsBySchema(t *testing.T) {
+	db, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+	manager := &MssqlManager{pool: db}
+
+	sqlMock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(getMssqlTableConstraintsQuery, "@p1, @p2"))).WithArgs("dbo", "sales").WillReturnRows(
+		sqlmock.NewRows([]string{"constraint_type", "table_schema", "table_name", "constraint_name", "column_name", "is_nullable", "foreign_schema", "foreign_table", "foreign_column", "ordinal"}).
+			AddRow("PRIMARY KEY", "dbo", "users", "PK_users", "id", false, "", "", "", 1).
+			AddRow("UNIQUE", "dbo", "users", "UQ_users_email", "email", false, "", "", "", 1).
+			AddRow("FOREIGN KEY", "sales", "orders", "FK_orders_users", "user_id", false, "dbo", "users", "id", 1).
+			AddRow("FOREIGN KEY", "sales", "orders", "FK_orders_users", "user_email", true, "dbo", "users", "email", 2).
+			AddRow("PRIMARY KEY", "sales", "orders", "PK_orders", "id", false, "", "", "", 1),
+	)
+
+	constraints, err := manager.GetTableConstraintsBySchema(context.Background(), []string{"dbo", "sales"})
+	require.NoError(t, err)
+	require.Equal(t, &TableConstraints{
+		ForeignKeyConstraints: map[string][]*ForeignConstraint{
+			"sales.orders": {{
+				Columns:     []string{"user_id", "user_email"},
+				NotNullable: []bool{true, false},
+				ForeignKey:  &ForeignKey{Table: "dbo.users", Columns: []string{"id", "email"}},
+			}},
+		},
+		PrimaryKeyConstraints: map[string][]string{"dbo.users": {"id"}, "sales.orders": {"id"}},
+		UniqueConstraints:     map[string][][]string{"dbo.users": {{"email"}}},
+	}, constraints)
+	require.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func Test_MssqlManager_GetPartialUniqueIndexesMap(t *testing.T) {
+	db, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+	manager := &MssqlManager{pool: db}
+
+	sqlMock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(getMssqlPartialUniqueIndexesQuery, "@p1"))).WithArgs("dbo").WillReturnRows(
+		sqlmock.NewRows([]string{"table_schema", "table_name", "index_name", "column_name", "filter_definition"}).
+			AddRow("dbo", "users", "UX_users_account_email", "account_id", "([deleted_at] IS NULL)").
+			AddRow("dbo", "users", "UX_users_account_email", "email", "([deleted_at] IS NULL)").
+			AddRow("dbo", "users", "UX_users_handle", "handle", "([handle] IS NOT NULL)"),
+	)
+
+	indexes, err := manager.GetPartialUniqueIndexesMap(context.Background(), []string{"dbo"})
+	require.NoError(t, err)
+	require.Equal(t, map[string][]*PartialUniqueIndex{
+		"dbo.users": {
+			{Name: "UX_users_account_email", Columns: []string{"account_id", "email"}, Predicate: "([deleted_at] IS NULL)"},
+			{Name: "UX_users_handle", Columns: []string{"handle"}, Predicate: "([handle] IS NOT NULL)"},
+		},
+	}, indexes)
+	require.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func Test_buildMssqlCreateTableStatement(t *testing.T) {
+	stmt := buildMssqlCreateTableStatement(
+		"dbo",
+		"user's",
+		[]*mssqlColumnDefinition{
+			{Name: "id", DataType: "bigint", IsIdentity: true, IdentitySeed: 1, IdentityIncrement: 1},
+			{Name: "email", DataType: "nvarchar", MaxLength: 512},
+			{Name: "bio", DataType: "varchar", MaxLength: -1, IsNullable: true},
+			{Name: "balance", DataType: "decimal", Precision: 18, Scale: 2, Default: "((0))"},
+			{Name: "created_at", DataType: "datetime2", Scale: 7, Default: "(sysutcdatetime())"},
+			{Name: "email_domain", DataType: "nvarchar", MaxLength: -1, IsNullable: true, Computed: "(substring([email],charindex('@',[email])+(1),(256)))"},
+		},
+		[]*mssqlConstraint{
+			{ConstraintType: "UNIQUE", ConstraintName: "UQ_users_email", Columns: []string{"email"}},
+			{ConstraintType: "PRIMARY KEY", ConstraintName: "PK_users", Columns: []string{"id"}},
+		},
+	)
+	require.Equal(t, `IF NOT EXISTS (SELECT 1 FROM sys.tables WHERE SCHEMA_NAME(schema_id) = N'dbo' AND name = N'user''s')
+CREATE TABLE "dbo"."user's" (
+  "id" bigint IDENTITY(1, 1) NOT NULL,
+  "email" nvarchar(256) NOT NULL,
+  "bio" varchar(max) NULL,
+  "balance" decimal(18, 2) NOT NULL DEFAULT ((0)),
+  "created_at" datetime2(7) NOT NULL DEFAULT (sysutcdatetime()),
+  "email_domain" AS (substring([email],charindex('@',[email])+(1),(256))),
+  CONSTRAINT "PK_users" PRIMARY KEY ("id"),
+  CONSTRAINT "UQ_users_email" UNIQUE ("email")
+);`, stmt)
+}
+
+func Test_buildMssqlAddForeignKeyStatement(t *testing.T) {
+	stmt := buildMssqlAddForeignKeyStatement(&mssqlConstraint{
+		ConstraintType:    "FOREIGN KEY",
+		SchemaName:        "sales",
+		TableName:         "orders",
+		ConstraintName:    "FK_orders_users",
+		Columns:           []string{"user_id"},
+		ForeignSchemaName: "dbo",
+		ForeignTableName:  "users",
+		ForeignColumns:    []string{"id"},
+	})
+	require.Equal(t, `ALTER TABLE "sales"."orders" ADD CONSTRAINT "FK_orders_users" FOREIGN KEY ("user_id") REFERENCES "dbo"."users" ("id");`, stmt)
+}
+
+func Test_buildMssqlPlaceholders(t *testing.T) {
+	placeholders, args := buildMssqlPlaceholders([]string{"dbo", "sales"})
+	require.Equal(t, "@p1, @p2", placeholders)
+	require.Equal(t, []any{"dbo", "sales"}, args)
+}
+
+func Test_BuildMssqlTruncateStatement(t *testing.T) {
+	stmt, err := BuildMssqlTruncateStatement("dbo", "users")
+	require.NoError(t, err)
+	require.Equal(t, `DELETE FROM "dbo"."users";`, stmt)
+}
+
+func Test_BuildMssqlSelectColumn(t *testing.T) {
+	require.Equal(t, `"id"`, BuildMssqlSelectColumn("id", "int"))
+	require.Equal(t, `"payload"`, BuildMssqlSelectColumn("payload", "varbinary"))
+	require.Equal(t, `CAST("guid" AS NVARCHAR(36)) AS "guid"`, BuildMssqlSelectColumn("guid", "uniqueidentifier"))
+	require.Equal(t, `CONVERT(NVARCHAR(33), "created_at", 126) AS "created_at"`, BuildMssqlSelectColumn("created_at", "DATETIME2"))
+	require.Equal(t, `"loc".STAsText() AS "loc"`, BuildMssqlSelectColumn("loc", "geography"))
+	require.Equal(t, `"my""col"`, BuildMssqlSelectColumn(`my"col`, "nvarchar"))
+}
+
+func Test_EncodeMssqlBinary(t *testing.T) {
+	require.Equal(t, []byte("0x0A1BFF"), EncodeMssqlBinary([]byte{0x0a, 0x1b, 0xff}))
+	require.Equal(t, []byte("0x"), EncodeMssqlBinary([]byte{}))
+	require.Nil(t, EncodeMssqlBinary(nil))
+	require.True(t, IsMssqlBinaryType("VARBINARY"))
+	require.False(t, IsMssqlBinaryType("nvarchar"))
+}
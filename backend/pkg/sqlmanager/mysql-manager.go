@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/doug-martin/goqu/v9"
@@ -43,6 +45,7 @@ func (m *MysqlManager) GetDatabaseSchema(ctx context.Context) ([]*DatabaseSchema
 			ColumnDefault: row.ColumnDefault,
 			IsNullable:    row.IsNullable,
 			GeneratedType: generatedType,
+			TableType:     BaseTableType,
 		})
 	}
 	return result, nil
@@ -58,6 +61,43 @@ func (m *MysqlManager) GetSchemaColumnMap(ctx context.Context) (map[string]map[s
 	return result, nil
 }
 
+// The full column type is used as the data type as it is needed to create tables from the columns of the views
+const getMysqlViewColumnsQuery = `SELECT c.table_schema, c.table_name, c.column_name, c.column_type, c.ordinal_position, c.is_nullable
+FROM information_schema.columns AS c
+JOIN information_schema.tables AS t ON c.table_schema = t.table_schema AND c.table_name = t.table_name
+WHERE c.table_schema NOT IN ('sys', 'performance_schema', 'mysql')
+AND t.table_type = 'VIEW'`
+
+// Returns the columns of every view, keyed by schema.table and then column
+func (m *MysqlManager) GetViewColumnMap(ctx context.Context) (map[string]map[string]*ColumnInfo, error) {
+	rows, err := m.pool.QueryContext(ctx, getMysqlViewColumnsQuery)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return map[string]map[string]*ColumnInfo{}, nil
+	}
+	defer rows.Close()
+
+	output := map[string]map[string]*ColumnInfo{}
+	for rows.Next() {
+		var schema, table, column, isNullable string
+		info := &ColumnInfo{TableType: ViewTableType}
+		if err := rows.Scan(&schema, &table, &column, &info.DataType, &info.OrdinalPosition, &isNullable); err != nil {
+			return nil, err
+		}
+		info.IsNullable = convertNullableTextToBool(isNullable)
+		key := BuildTable(schema, table)
+		if _, ok := output[key]; !ok {
+			output[key] = map[string]*ColumnInfo{}
+		}
+		output[key][column] = info
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
 func (m *MysqlManager) GetTableConstraintsBySchema(ctx context.Context, schemas []string) (*TableConstraints, error) {
 	if len(schemas) == 0 {
 		return &TableConstraints{}, nil
@@ -236,33 +276,35 @@ func (m *MysqlManager) GetUniqueConstraintsMap(ctx context.Context, schemas []st
 		rows = append(rows, schemas...)
 	}
 
-	uniqueConstraintMap := map[string][]*mysql_queries.GetUniqueConstraintsRow{}
+	// constraint names are only unique within a table so each constraint is keyed by its table and name
+	output := map[string][][]string{}
+	constraintIdx := map[string]map[string]int{}
 	for _, c := range rows {
-		_, ok := uniqueConstraintMap[c.ConstraintName]
-		if ok {
-			uniqueConstraintMap[c.ConstraintName] = append(uniqueConstraintMap[c.ConstraintName], c)
-		} else {
-			uniqueConstraintMap[c.ConstraintName] = []*mysql_queries.GetUniqueConstraintsRow{c}
+		key := BuildTable(c.SchemaName, c.TableName)
+		if _, ok := constraintIdx[key]; !ok {
+			constraintIdx[key] = map[string]int{}
 		}
-	}
-	output := map[string][][]string{}
-	for _, constraints := range uniqueConstraintMap {
-		uc := []string{}
-		var key string
-		for _, c := range constraints {
-			key = BuildTable(c.SchemaName, c.TableName)
-			_, ok := output[key]
-			if !ok {
-				output[key] = [][]string{}
-			}
-			uc = append(uc, c.ColumnName)
+		idx, ok := constraintIdx[key][c.ConstraintName]
+		if !ok {
+			idx = len(output[key])
+			constraintIdx[key][c.ConstraintName] = idx
+			output[key] = append(output[key], []string{})
 		}
-		output[key] = append(output[key], uc)
+		output[key][idx] = append(output[key][idx], c.ColumnName)
 	}
-
 	return output, nil
 }
 
+// Mysql does not support partial indexes
+func (m *MysqlManager) GetPartialUniqueIndexesMap(ctx context.Context, schemas []string) (map[string][]*PartialUniqueIndex, error) {
+	return map[string][]*PartialUniqueIndex{}, nil
+}
+
+// Mysql does not support exclusion constraints
+func (m *MysqlManager) GetExclusionConstraintsMap(ctx context.Context, schemas []string) (map[string][]*ExclusionConstraint, error) {
+	return map[string][]*ExclusionConstraint{}, nil
+}
+
 func (m *MysqlManager) GetRolePermissionsMap(ctx context.Context, role string) (map[string][]string, error) {
 	rows, err := m.querier.GetMysqlRolePermissions(ctx, m.pool, role)
 	if err != nil && !nucleusdb.IsNoRows(err) {
@@ -279,8 +321,40 @@ func (m *MysqlManager) GetRolePermissionsMap(ctx context.Context, role string) (
 	return schemaTablePrivsMap, err
 }
 
+// Infers the columns of a virtual table by running its query without returning any rows
+func (m *MysqlManager) GetQueryColumns(ctx context.Context, query string) (map[string]*ColumnInfo, error) {
+	sql, err := BuildVirtualTableSelectQuery(MysqlDriver, query, nil)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := m.pool.QueryContext(ctx, fmt.Sprintf("%s LIMIT 0", sql))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	columns := map[string]*ColumnInfo{}
+	for idx, columnType := range columnTypes {
+		if _, ok := columns[columnType.Name()]; ok {
+			return nil, fmt.Errorf("query returns more than one column named %s", columnType.Name())
+		}
+		isNullable, ok := columnType.Nullable()
+		columns[columnType.Name()] = &ColumnInfo{
+			OrdinalPosition: int32(idx + 1),
+			IsNullable:      isNullable || !ok,
+			DataType:        strings.ToLower(columnType.DatabaseTypeName()),
+			TableType:       VirtualTableType,
+		}
+	}
+	return columns, rows.Err()
+}
+
 // todo
-func (m *MysqlManager) GetTableInitStatements(ctx context.Context, tables []*SchemaTable) ([]*TableInitStatement, error) {
+func (m *MysqlManager) GetTableInitStatements(ctx context.Context, tables []*SchemaTable, opts *TableInitStatementOpts) ([]*TableInitStatement, error) {
 	return nil, errors.ErrUnsupported
 }
 
@@ -291,12 +365,250 @@ func (m *MysqlManager) GetCreateTableStatement(ctx context.Context, schema, tabl
 	}
 	result.CreateTable = strings.Replace(
 		result.CreateTable,
-		fmt.Sprintf("CREATE TABLE `%s`", table),
-		fmt.Sprintf("CREATE TABLE `%s`.`%s`", schema, table),
+		fmt.Sprintf("CREATE TABLE %s", EscapeMysqlColumn(table)),
+		fmt.Sprintf("CREATE TABLE %s", QuoteTable(MysqlDriver, schema, table)),
 		1, // do it once
 	)
 	split := strings.Split(result.CreateTable, "CREATE TABLE")
-	return fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s;", split[1]), nil
+	stmt := fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s;", split[1])
+
+	// SHOW CREATE TABLE leaves out the table collation when it is the default for the character set, and column
+	// character sets and collations when they match the table. Setting them explicitly keeps the destination from
+	// falling back to its own server defaults, which may compare strings differently.
+	charset, err := getMysqlTableCharset(ctx, m.pool, schema, table)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return "", fmt.Errorf("unable to get table character set: %w", err)
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return stmt, nil
+	}
+	return setMysqlTableCharset(stmt, charset.Charset, &charset.Collation), nil
+}
+
+type mysqlTableCharset struct {
+	Charset   string
+	Collation string
+}
+
+const getMysqlTableCharsetQuery = `SELECT ccsa.CHARACTER_SET_NAME, t.TABLE_COLLATION
+FROM information_schema.TABLES t
+INNER JOIN information_schema.COLLATION_CHARACTER_SET_APPLICABILITY ccsa ON ccsa.COLLATION_NAME = t.TABLE_COLLATION
+WHERE t.TABLE_SCHEMA = ? AND t.TABLE_NAME = ?`
+
+func getMysqlTableCharset(
+	ctx context.Context,
+	conn mysql_queries.DBTX,
+	schema string,
+	table string,
+) (*mysqlTableCharset, error) {
+	row := conn.QueryRowContext(ctx, getMysqlTableCharsetQuery, schema, table)
+	var output mysqlTableCharset
+	err := row.Scan(
+		&output.Charset,
+		&output.Collation,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &output, nil
+}
+
+var (
+	mysqlColumnCharsetRegex   = regexp.MustCompile(`(?i) CHARACTER SET [A-Za-z0-9_]+`)
+	mysqlColumnCollationRegex = regexp.MustCompile(`(?i) COLLATE [A-Za-z0-9_]+`)
+	mysqlTableCharsetRegex    = regexp.MustCompile(`(?i) (DEFAULT )?CHARSET=[A-Za-z0-9_]+`)
+	mysqlTableCollationRegex  = regexp.MustCompile(`(?i) COLLATE=[A-Za-z0-9_]+`)
+	mysqlReferencesRegex      = regexp.MustCompile("REFERENCES (?:`([^`]+)`\\.)?`([^`]+)`")
+	mysqlIdentifierRegex      = regexp.MustCompile("`[^`]+`")
+	mysqlColumnTypeRegex      = regexp.MustCompile("^(\\s*`([^`]+)` )[a-z]+(?:\\([^)]*\\))?(?: unsigned)?(?: zerofill)?")
+)
+
+// Rewrites a CREATE TABLE statement generated by GetCreateTableStatement so that the table, its columns, and anything its foreign keys
+// reference use their destination names. Column data types are overridden as well.
+// Statements that come before the CREATE TABLE, such as the sequences of a MariaDB table, are left as is
+func RemapMysqlCreateTableStatement(stmt, schema, table string, opts *TableInitStatementOpts) string {
+	bodyEnd := strings.Index(stmt, "\n)")
+	if bodyEnd == -1 {
+		return stmt
+	}
+	renames := opts.getColumnRenames(schema, table)
+	excluded := opts.getExcludedColumns(schema, table)
+	lines := strings.Split(stmt[:bodyEnd], "\n")
+	createIdx := slices.IndexFunc(lines, func(line string) bool {
+		return strings.HasPrefix(line, "CREATE TABLE")
+	})
+	if createIdx == -1 {
+		createIdx = 0
+	}
+	output := make([]string, 0, len(lines))
+	for idx, line := range lines {
+		if idx < createIdx {
+			output = append(output, line)
+			continue
+		}
+		if idx > createIdx && referencesExcludedMysqlColumn(line, schema, opts, excluded) {
+			continue
+		}
+		switch {
+		case idx == createIdx:
+			dest := opts.getDestinationTable(schema, table)
+			lines[idx] = strings.Replace(line, QuoteTable(MysqlDriver, schema, table), QuoteTable(MysqlDriver, dest.Schema, dest.Table), 1)
+		case strings.HasPrefix(strings.TrimSpace(line), "`"):
+			// column definition
+			matches := mysqlColumnTypeRegex.FindStringSubmatch(line)
+			if matches != nil {
+				destColumn := opts.getDestinationColumn(schema, table, matches[2])
+				if destColumn.DataType != "" {
+					line = matches[1] + destColumn.DataType + line[len(matches[0]):]
+				}
+			}
+			lines[idx] = renameMysqlColumns(line, renames)
+		case strings.Contains(line, " REFERENCES "):
+			lines[idx] = remapMysqlForeignKey(line, schema, opts, renames)
+		default:
+			// keys and check constraints, where only the part in parentheses contains columns
+			if parenIdx := strings.Index(line, "("); parenIdx != -1 {
+				lines[idx] = line[:parenIdx] + renameMysqlColumns(line[parenIdx:], renames)
+			}
+		}
+		output = append(output, lines[idx])
+	}
+	if len(output) < len(lines) && len(output) > createIdx+1 {
+		// the original last line of the body may have been removed, leaving a dangling comma
+		output[len(output)-1] = strings.TrimSuffix(output[len(output)-1], ",")
+	}
+	return strings.Join(output, "\n") + stmt[bodyEnd:]
+}
+
+// Determines if a line of the CREATE TABLE body defines a column that is left out of the destination, or is a key or
+// constraint that covers one, either of its own table or the table referenced by a foreign key
+func referencesExcludedMysqlColumn(line, schema string, opts *TableInitStatementOpts, excluded map[string]struct{}) bool {
+	if strings.HasPrefix(strings.TrimSpace(line), "`") {
+		matches := mysqlColumnTypeRegex.FindStringSubmatch(line)
+		if matches == nil {
+			return false
+		}
+		_, ok := excluded[matches[2]]
+		return ok
+	}
+	local := line
+	refIdx := strings.Index(line, " REFERENCES ")
+	if refIdx != -1 {
+		local = line[:refIdx]
+	}
+	if parenIdx := strings.Index(local, "("); parenIdx != -1 && hasMysqlColumnReference(local[parenIdx:], excluded) {
+		return true
+	}
+	if refIdx == -1 {
+		return false
+	}
+	reference := line[refIdx:]
+	matches := mysqlReferencesRegex.FindStringSubmatchIndex(reference)
+	if matches == nil {
+		return false
+	}
+	refSchema := schema
+	if matches[2] != -1 {
+		refSchema = reference[matches[2]:matches[3]]
+	}
+	rest := reference[matches[1]:]
+	if parenEnd := strings.Index(rest, ")"); parenEnd != -1 {
+		rest = rest[:parenEnd]
+	}
+	return hasMysqlColumnReference(rest, opts.getExcludedColumns(refSchema, reference[matches[4]:matches[5]]))
+}
+
+func hasMysqlColumnReference(input string, columns map[string]struct{}) bool {
+	if len(columns) == 0 {
+		return false
+	}
+	for _, match := range mysqlIdentifierRegex.FindAllString(input, -1) {
+		if _, ok := columns[strings.Trim(match, "`")]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Applies the destination names to a foreign key line. The local columns are renamed using the table's renames and
+// the referenced columns using the referenced table's renames
+func remapMysqlForeignKey(line, schema string, opts *TableInitStatementOpts, renames map[string]string) string {
+	refIdx := strings.Index(line, " REFERENCES ")
+	local := line[:refIdx]
+	if parenIdx := strings.Index(local, "("); parenIdx != -1 {
+		local = local[:parenIdx] + renameMysqlColumns(local[parenIdx:], renames)
+	}
+	reference := line[refIdx:]
+	matches := mysqlReferencesRegex.FindStringSubmatchIndex(reference)
+	if matches == nil {
+		return local + reference
+	}
+	// SHOW CREATE TABLE leaves out the schema when the referenced table is in the same schema
+	refSchema := schema
+	if matches[2] != -1 {
+		refSchema = reference[matches[2]:matches[3]]
+	}
+	refTable := reference[matches[4]:matches[5]]
+	refDest := opts.getDestinationTable(refSchema, refTable)
+	rest := reference[matches[1]:]
+	if parenEnd := strings.Index(rest, ")"); parenEnd != -1 {
+		rest = renameMysqlColumns(rest[:parenEnd], opts.getColumnRenames(refSchema, refTable)) + rest[parenEnd:]
+	}
+	if refDest.Schema == refSchema && refDest.Table == refTable {
+		return local + reference[:matches[1]] + rest
+	}
+	return fmt.Sprintf("%s%sREFERENCES %s%s", local, reference[:matches[0]], QuoteTable(MysqlDriver, refDest.Schema, refDest.Table), rest)
+}
+
+// Renames the backtick quoted column identifiers
+func renameMysqlColumns(input string, renames map[string]string) string {
+	if len(renames) == 0 {
+		return input
+	}
+	return mysqlIdentifierRegex.ReplaceAllStringFunc(input, func(match string) string {
+		if name, ok := renames[strings.Trim(match, "`")]; ok {
+			return EscapeMysqlColumn(name)
+		}
+		return match
+	})
+}
+
+// Rewrites a CREATE TABLE statement generated by GetCreateTableStatement so that the table and all of its columns use the given character set.
+// The collation defaults to the default collation of the character set if not provided
+func ApplyMysqlCharsetOverride(stmt, charset string, collation *string) string {
+	bodyEnd := strings.Index(stmt, "\n)")
+	if bodyEnd == -1 {
+		return stmt
+	}
+	body := mysqlColumnCharsetRegex.ReplaceAllString(stmt[:bodyEnd], "")
+	body = mysqlColumnCollationRegex.ReplaceAllString(body, "")
+	return setMysqlTableCharset(body+stmt[bodyEnd:], charset, collation)
+}
+
+// Replaces the default character set and collation in the table options, which follow the closing parenthesis of the column definitions.
+// Anything after the table options, such as partitioning, is left as-is
+func setMysqlTableCharset(stmt, charset string, collation *string) string {
+	bodyEnd := strings.Index(stmt, "\n)")
+	if bodyEnd == -1 {
+		return stmt
+	}
+	optsStart := bodyEnd + len("\n)")
+	optsEnd := len(stmt)
+	if idx := strings.Index(stmt[optsStart:], "\n"); idx != -1 {
+		optsEnd = optsStart + idx
+	}
+	tableOpts := stmt[optsStart:optsEnd]
+	hasTerminator := strings.HasSuffix(tableOpts, ";")
+	tableOpts = strings.TrimSuffix(tableOpts, ";")
+	tableOpts = mysqlTableCharsetRegex.ReplaceAllString(tableOpts, "")
+	tableOpts = mysqlTableCollationRegex.ReplaceAllString(tableOpts, "")
+	tableOpts = fmt.Sprintf("%s DEFAULT CHARSET=%s", tableOpts, charset)
+	if collation != nil && *collation != "" {
+		tableOpts = fmt.Sprintf("%s COLLATE=%s", tableOpts, *collation)
+	}
+	if hasTerminator {
+		tableOpts += ";"
+	}
+	return stmt[:optsStart] + tableOpts + stmt[optsEnd:]
 }
 
 type databaseTableShowCreate struct {
@@ -310,7 +622,7 @@ func getShowTableCreate(
 	schema string,
 	table string,
 ) (*databaseTableShowCreate, error) {
-	getShowTableCreateSql := fmt.Sprintf("SHOW CREATE TABLE `%s`.`%s`;", schema, table)
+	getShowTableCreateSql := fmt.Sprintf("SHOW CREATE TABLE %s;", QuoteTable(MysqlDriver, schema, table))
 	row := conn.QueryRowContext(ctx, getShowTableCreateSql)
 	var output databaseTableShowCreate
 	err := row.Scan(
@@ -360,13 +672,197 @@ func (m *MysqlManager) GetTableRowCount(
 		return 0, err
 	}
 	var count int64
-	err = m.pool.QueryRowContext(ctx, sql).Scan(&count)
+	err = WithMysqlQueryCancel(ctx, m.pool, func(ctx context.Context, db mysql_queries.DBTX) error {
+		return db.QueryRowContext(ctx, sql).Scan(&count)
+	})
 	if err != nil {
 		return 0, err
 	}
 	return count, err
 }
 
+func (m *MysqlManager) GetColumnDistinctCount(
+	ctx context.Context,
+	schema, table, column string,
+) (int64, error) {
+	sql, err := buildColumnDistinctCountQuery(MysqlDriver, schema, table, column)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = WithMysqlQueryCancel(ctx, m.pool, func(ctx context.Context, db mysql_queries.DBTX) error {
+		return db.QueryRowContext(ctx, sql).Scan(&count)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// Mysql does not support materialized views
+func (m *MysqlManager) GetMaterializedViews(ctx context.Context) ([]*MaterializedView, error) {
+	return []*MaterializedView{}, nil
+}
+
+// MySQL does not support disabling triggers so there are never any triggers to manage
+func (m *MysqlManager) GetEnabledTableTriggers(ctx context.Context, tables []*SchemaTable) ([]*TableTrigger, error) {
+	return []*TableTrigger{}, nil
+}
+
+// TABLE_ROWS is an estimate for InnoDB tables
+func (m *MysqlManager) GetTableSizeEstimates(ctx context.Context, tables []*SchemaTable) ([]*TableSizeEstimate, error) {
+	if len(tables) == 0 {
+		return []*TableSizeEstimate{}, nil
+	}
+	placeholders := make([]string, 0, len(tables))
+	args := make([]any, 0, len(tables))
+	for _, table := range tables {
+		placeholders = append(placeholders, "?")
+		args = append(args, BuildTable(table.Schema, table.Table))
+	}
+	query := fmt.Sprintf(`SELECT TABLE_SCHEMA, TABLE_NAME, COALESCE(TABLE_ROWS, 0), COALESCE(DATA_LENGTH, 0) + COALESCE(INDEX_LENGTH, 0)
+FROM information_schema.TABLES
+WHERE CONCAT(TABLE_SCHEMA, '.', TABLE_NAME) IN (%s)
+ORDER BY TABLE_SCHEMA, TABLE_NAME`, strings.Join(placeholders, ", "))
+
+	rows, err := m.pool.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	estimates := []*TableSizeEstimate{}
+	for rows.Next() {
+		estimate := &TableSizeEstimate{}
+		if err := rows.Scan(&estimate.Schema, &estimate.Table, &estimate.RowCount, &estimate.SizeBytes); err != nil {
+			return nil, err
+		}
+		estimates = append(estimates, estimate)
+	}
+	return estimates, rows.Err()
+}
+
+// MySQL does not have extensions
+func (m *MysqlManager) GetExtensions(ctx context.Context) ([]*Extension, error) {
+	return []*Extension{}, nil
+}
+
+// Superuser rights are only needed to create postgres extensions
+func (m *MysqlManager) IsSuperuser(ctx context.Context) (bool, error) {
+	return false, nil
+}
+
+func (m *MysqlManager) GetNumericColumnSample(
+	ctx context.Context,
+	schema, table, column string,
+	limit uint,
+) ([]float64, error) {
+	sql, err := buildNumericColumnSampleQuery(MysqlDriver, schema, table, column, limit)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := m.pool.QueryContext(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	values := []float64{}
+	for rows.Next() {
+		var value float64
+		if err := rows.Scan(&value); err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, rows.Err()
+}
+
+func (m *MysqlManager) GetTableRowSample(
+	ctx context.Context,
+	schema, table string,
+	columns []string,
+	limit uint,
+) ([][]*string, error) {
+	sql, err := buildTableRowSampleQuery(MysqlDriver, schema, table, columns, limit)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := m.pool.QueryContext(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	output := [][]*string{}
+	for rows.Next() {
+		row := make([]*string, len(columns))
+		dest := make([]any, len(columns))
+		for idx := range row {
+			dest[idx] = &row[idx]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		output = append(output, row)
+	}
+	return output, rows.Err()
+}
+
+func (m *MysqlManager) GetEquivalenceClasses(
+	ctx context.Context,
+	schema, table string,
+	quasiIdentifiers []string,
+	sensitiveColumn *string,
+) ([]*EquivalenceClass, error) {
+	sql, err := buildEquivalenceClassQuery(MysqlDriver, schema, table, quasiIdentifiers, sensitiveColumn)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := m.pool.QueryContext(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	output := []*EquivalenceClass{}
+	for rows.Next() {
+		class := &EquivalenceClass{Values: make([]*string, len(quasiIdentifiers))}
+		dest := make([]any, 0, len(quasiIdentifiers)+2)
+		for idx := range class.Values {
+			dest = append(dest, &class.Values[idx])
+		}
+		dest = append(dest, &class.Size)
+		if sensitiveColumn != nil {
+			dest = append(dest, &class.DistinctSensitiveValues)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		output = append(output, class)
+	}
+	return output, rows.Err()
+}
+
+func (m *MysqlManager) GetForeignKeyOrphanCount(
+	ctx context.Context,
+	schema, table string,
+	constraint *ForeignConstraint,
+) (int64, error) {
+	sql, err := buildForeignKeyOrphanCountQuery(MysqlDriver, schema, table, constraint)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = WithMysqlQueryCancel(ctx, m.pool, func(ctx context.Context, db mysql_queries.DBTX) error {
+		return db.QueryRowContext(ctx, sql).Scan(&count)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 func (m *MysqlManager) Exec(ctx context.Context, statement string) error {
 	_, err := m.pool.ExecContext(ctx, statement)
 	if err != nil {
@@ -395,14 +891,14 @@ func BuildMysqlTruncateStatement(
 	return fmt.Sprintf("%s;", stmt), nil
 }
 
+func BuildMysqlAnalyzeStatement(table *SchemaTable) string {
+	return fmt.Sprintf("ANALYZE TABLE %s;", QuoteTable(MysqlDriver, table.Schema, table.Table))
+}
+
 func EscapeMysqlColumns(cols []string) []string {
-	outcols := make([]string, len(cols))
-	for idx := range cols {
-		outcols[idx] = EscapeMysqlColumn(cols[idx])
-	}
-	return outcols
+	return QuoteIdentifiers(MysqlDriver, cols)
 }
 
 func EscapeMysqlColumn(col string) string {
-	return fmt.Sprintf("`%s`", col)
+	return QuoteIdentifier(MysqlDriver, col)
 }
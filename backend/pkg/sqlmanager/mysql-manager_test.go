@@ -54,6 +54,7 @@ func Test_GetDatabaseSchema_Mysql(t *testing.T) {
 			DataType:      "varchar",
 			ColumnDefault: "",
 			IsNullable:    "NO",
+			TableType:     BaseTableType,
 		},
 		{
 			TableSchema:   "public",
@@ -62,6 +63,7 @@ func Test_GetDatabaseSchema_Mysql(t *testing.T) {
 			DataType:      "integer",
 			ColumnDefault: "",
 			IsNullable:    "NO",
+			TableType:     BaseTableType,
 		},
 	}
 
@@ -262,12 +264,19 @@ func Test_GetUniqueConstraintsMap_Mysql(t *testing.T) {
 				ColumnName:     "name",
 				ConstraintName: "region-name",
 			},
+			{
+				SchemaName:     "public",
+				TableName:      "users",
+				ColumnName:     "email",
+				ConstraintName: "person-name-email",
+			},
 		}, nil,
 	)
 
 	expected := map[string][][]string{
 		"public.person": {{"name", "email"}},
 		"public.region": {{"code"}, {"name"}},
+		"public.users":  {{"email"}},
 	}
 
 	actual, err := manager.GetUniqueConstraintsMap(context.Background(), schemas)
@@ -394,3 +403,91 @@ func Test_BuildMysqlTruncateStatement(t *testing.T) {
 		actual,
 	)
 }
+
+func Test_BuildMysqlAnalyzeStatement(t *testing.T) {
+	require.Equal(
+		t,
+		"ANALYZE TABLE `public`.`users`;",
+		BuildMysqlAnalyzeStatement(&SchemaTable{Schema: "public", Table: "users"}),
+	)
+}
+
+func Test_setMysqlTableCharset(t *testing.T) {
+	collation := "utf8mb4_0900_ai_ci"
+	latinCollation := "latin1_bin"
+	require.Equal(
+		t,
+		"CREATE TABLE IF NOT EXISTS  `public`.`users` (\n  `id` int NOT NULL\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;",
+		setMysqlTableCharset("CREATE TABLE IF NOT EXISTS  `public`.`users` (\n  `id` int NOT NULL\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;", "utf8mb4", &collation),
+	)
+	require.Equal(
+		t,
+		"CREATE TABLE IF NOT EXISTS  `public`.`users` (\n  `id` int NOT NULL\n) ENGINE=InnoDB DEFAULT CHARSET=latin1 COLLATE=latin1_bin\n/*!50100 PARTITION BY HASH (`id`)\nPARTITIONS 4 */;",
+		setMysqlTableCharset("CREATE TABLE IF NOT EXISTS  `public`.`users` (\n  `id` int NOT NULL\n) ENGINE=InnoDB DEFAULT CHARSET=latin1\n/*!50100 PARTITION BY HASH (`id`)\nPARTITIONS 4 */;", "latin1", &latinCollation),
+	)
+}
+
+func Test_ApplyMysqlCharsetOverride(t *testing.T) {
+	binCollation := "utf8mb4_bin"
+	stmt := "CREATE TABLE IF NOT EXISTS  `public`.`users` (\n  `id` int NOT NULL,\n  `email` varchar(255) CHARACTER SET latin1 COLLATE latin1_bin NOT NULL,\n  `name` varchar(255) COLLATE utf8mb4_bin DEFAULT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;"
+	require.Equal(
+		t,
+		"CREATE TABLE IF NOT EXISTS  `public`.`users` (\n  `id` int NOT NULL,\n  `email` varchar(255) NOT NULL,\n  `name` varchar(255) DEFAULT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb3;",
+		ApplyMysqlCharsetOverride(stmt, "utf8mb3", nil),
+	)
+	require.Equal(
+		t,
+		"CREATE TABLE IF NOT EXISTS  `public`.`users` (\n  `id` int NOT NULL,\n  `email` varchar(255) NOT NULL,\n  `name` varchar(255) DEFAULT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;",
+		ApplyMysqlCharsetOverride(stmt, "utf8mb4", &binCollation),
+	)
+}
+
+func Test_RemapMysqlCreateTableStatement(t *testing.T) {
+	stmt := "CREATE TABLE IF NOT EXISTS  `public`.`orders` (\n  `id` int NOT NULL,\n  `user_id` int NOT NULL,\n  `product_id` int NOT NULL,\n  PRIMARY KEY (`id`),\n  CONSTRAINT `orders_user_fk` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`),\n  CONSTRAINT `orders_product_fk` FOREIGN KEY (`product_id`) REFERENCES `catalog`.`products` (`id`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;"
+	opts := &TableInitStatementOpts{
+		DestinationTables: map[string]*SchemaTable{
+			"public.orders": {Schema: "masked", Table: "orders_v2"},
+			"public.users":  {Schema: "masked", Table: "users_v2"},
+		},
+	}
+	require.Equal(
+		t,
+		"CREATE TABLE IF NOT EXISTS  `masked`.`orders_v2` (\n  `id` int NOT NULL,\n  `user_id` int NOT NULL,\n  `product_id` int NOT NULL,\n  PRIMARY KEY (`id`),\n  CONSTRAINT `orders_user_fk` FOREIGN KEY (`user_id`) REFERENCES `masked`.`users_v2` (`id`),\n  CONSTRAINT `orders_product_fk` FOREIGN KEY (`product_id`) REFERENCES `catalog`.`products` (`id`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;",
+		RemapMysqlCreateTableStatement(stmt, "public", "orders", opts),
+	)
+	require.Equal(t, stmt, RemapMysqlCreateTableStatement(stmt, "public", "orders", nil))
+}
+
+func Test_RemapMysqlCreateTableStatement_Columns(t *testing.T) {
+	stmt := "CREATE TABLE IF NOT EXISTS  `public`.`orders` (\n  `id` int unsigned NOT NULL,\n  `user_id` int NOT NULL,\n  `email` text COLLATE utf8mb4_bin,\n  PRIMARY KEY (`id`),\n  KEY `email` (`email`(64)),\n  CONSTRAINT `orders_user_fk` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;"
+	opts := &TableInitStatementOpts{
+		DestinationColumns: map[string]map[string]*DestinationColumn{
+			"public.orders": {
+				"id":      {DataType: "bigint"},
+				"user_id": {Name: "customer_id"},
+				"email":   {Name: "email_hash", DataType: "varchar(64)"},
+			},
+			"public.users": {"id": {Name: "uid"}},
+		},
+	}
+	require.Equal(
+		t,
+		"CREATE TABLE IF NOT EXISTS  `public`.`orders` (\n  `id` bigint NOT NULL,\n  `customer_id` int NOT NULL,\n  `email_hash` varchar(64) COLLATE utf8mb4_bin,\n  PRIMARY KEY (`id`),\n  KEY `email` (`email_hash`(64)),\n  CONSTRAINT `orders_user_fk` FOREIGN KEY (`customer_id`) REFERENCES `users` (`uid`) ON DELETE CASCADE\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;",
+		RemapMysqlCreateTableStatement(stmt, "public", "orders", opts),
+	)
+}
+
+func Test_RemapMysqlCreateTableStatement_ExcludedColumns(t *testing.T) {
+	stmt := "CREATE TABLE IF NOT EXISTS  `public`.`orders` (\n  `id` int unsigned NOT NULL,\n  `user_id` int NOT NULL,\n  `notes` text,\n  PRIMARY KEY (`id`),\n  KEY `notes_idx` (`notes`(64)),\n  CONSTRAINT `orders_user_fk` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;"
+
+	require.Equal(
+		t,
+		"CREATE TABLE IF NOT EXISTS  `public`.`orders` (\n  `id` int unsigned NOT NULL,\n  `user_id` int NOT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;",
+		RemapMysqlCreateTableStatement(stmt, "public", "orders", &TableInitStatementOpts{
+			DestinationColumns: map[string]map[string]*DestinationColumn{
+				"public.orders": {"notes": {Exclude: true}},
+				"public.users":  {"id": {Exclude: true}},
+			},
+		}),
+	)
+}
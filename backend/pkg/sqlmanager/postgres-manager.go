@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/doug-martin/goqu/v9"
+	"github.com/jackc/pgx/v5/pgconn"
 	pg_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/postgresql"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
 	"golang.org/x/sync/errgroup"
@@ -25,8 +27,15 @@ func (p *PostgresManager) GetDatabaseSchema(ctx context.Context) ([]*DatabaseSch
 	} else if err != nil && nucleusdb.IsNoRows(err) {
 		return []*DatabaseSchemaRow{}, nil
 	}
+	shardTables, err := p.getCitusShardTables(ctx)
+	if err != nil {
+		return nil, err
+	}
 	result := []*DatabaseSchemaRow{}
 	for _, row := range dbSchemas {
+		if _, ok := shardTables[BuildTable(row.TableSchema, row.TableName)]; ok {
+			continue
+		}
 		var generatedType *string
 		if row.GeneratedType != "" {
 			generatedType = &row.GeneratedType
@@ -43,11 +52,58 @@ func (p *PostgresManager) GetDatabaseSchema(ctx context.Context) ([]*DatabaseSch
 			NumericScale:           row.NumericScale,
 			OrdinalPosition:        row.OrdinalPosition,
 			GeneratedType:          generatedType,
+			TableType:              row.TableType,
+			BaseDataType:           row.BaseDataType,
 		})
 	}
 	return result, nil
 }
 
+// Citus 10+ exposes the shards that are stored on the node that we are connected to through this view
+const getCitusShardTablesQuery = `SELECT COALESCE(array_agg(schema_name::text || '.' || shard_name::text), '{}')::text[]
+FROM pg_catalog.citus_shards_on_worker`
+
+// undefined_table, returned by Citus versions before 10 that do not have the citus_shards_on_worker view
+const pgUndefinedTableCode = "42P01"
+
+// Citus stores the shards of distributed tables as regular tables that may be visible on the node we are connected to.
+// These are returned so that they can be hidden from the schema as only the logical tables on the coordinator should be synced
+func (p *PostgresManager) getCitusShardTables(ctx context.Context) (map[string]struct{}, error) {
+	isCitus, err := p.querier.IsCitusExtensionInstalled(ctx, p.pool)
+	if err != nil {
+		return nil, fmt.Errorf("unable to determine if database is a citus cluster: %w", err)
+	}
+	if !isCitus {
+		return map[string]struct{}{}, nil
+	}
+	var shards []string
+	if err := p.pool.QueryRow(ctx, getCitusShardTablesQuery).Scan(&shards); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUndefinedTableCode {
+			return map[string]struct{}{}, nil
+		}
+		return nil, fmt.Errorf("unable to retrieve citus shard tables: %w", err)
+	}
+	shardTables := make(map[string]struct{}, len(shards))
+	for _, shard := range shards {
+		shardTables[shard] = struct{}{}
+	}
+	return shardTables, nil
+}
+
+// Returns true if the constraint is defined on, or references, one of the Citus shard tables
+func isCitusShardConstraint(shardTables map[string]struct{}, row *pg_queries.GetTableConstraintsBySchemaRow) bool {
+	if _, ok := shardTables[BuildTable(row.SchemaName, row.TableName)]; ok {
+		return true
+	}
+	if row.ConstraintType == "f" {
+		if _, ok := shardTables[BuildTable(row.ForeignSchemaName, row.ForeignTableName)]; ok {
+			return true
+		}
+	}
+	return false
+}
+
 // returns: {public.users: { id: struct{}{}, created_at: struct{}{}}}
 func (p *PostgresManager) GetSchemaColumnMap(ctx context.Context) (map[string]map[string]*ColumnInfo, error) {
 	dbSchemas, err := p.GetDatabaseSchema(ctx)
@@ -58,6 +114,45 @@ func (p *PostgresManager) GetSchemaColumnMap(ctx context.Context) (map[string]ma
 	return result, nil
 }
 
+const getViewColumnsQuery = `SELECT n.nspname, c.relname, a.attname, pg_catalog.format_type(a.atttypid, a.atttypmod), a.attnum, NOT a.attnotnull
+FROM pg_catalog.pg_attribute a
+INNER JOIN pg_catalog.pg_class c ON a.attrelid = c.oid
+INNER JOIN pg_catalog.pg_namespace n ON c.relnamespace = n.oid
+WHERE n.nspname NOT IN ('pg_catalog', 'pg_toast', 'information_schema')
+AND a.attnum > 0
+AND NOT a.attisdropped
+AND c.relkind IN ('v', 'm')
+ORDER BY a.attnum`
+
+// Returns the columns of every view and materialized view, keyed by schema.table and then column
+func (p *PostgresManager) GetViewColumnMap(ctx context.Context) (map[string]map[string]*ColumnInfo, error) {
+	rows, err := p.pool.Query(ctx, getViewColumnsQuery)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return map[string]map[string]*ColumnInfo{}, nil
+	}
+	defer rows.Close()
+
+	output := map[string]map[string]*ColumnInfo{}
+	for rows.Next() {
+		var schema, table, column string
+		info := &ColumnInfo{TableType: ViewTableType}
+		if err := rows.Scan(&schema, &table, &column, &info.DataType, &info.OrdinalPosition, &info.IsNullable); err != nil {
+			return nil, err
+		}
+		key := BuildTable(schema, table)
+		if _, ok := output[key]; !ok {
+			output[key] = map[string]*ColumnInfo{}
+		}
+		output[key][column] = info
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
 func (p *PostgresManager) GetTableConstraintsBySchema(ctx context.Context, schemas []string) (*TableConstraints, error) {
 	if len(schemas) == 0 {
 		return &TableConstraints{}, nil
@@ -68,11 +163,18 @@ func (p *PostgresManager) GetTableConstraintsBySchema(ctx context.Context, schem
 	} else if err != nil && nucleusdb.IsNoRows(err) {
 		return &TableConstraints{}, nil
 	}
+	shardTables, err := p.getCitusShardTables(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	foreignKeyMap := map[string][]*ForeignConstraint{}
 	primaryKeyMap := map[string][]string{}
 	uniqueConstraintsMap := map[string][][]string{}
 	for _, row := range rows {
+		if isCitusShardConstraint(shardTables, row) {
+			continue
+		}
 		tableName := BuildTable(row.SchemaName, row.TableName)
 		switch row.ConstraintType {
 		case "f":
@@ -118,12 +220,19 @@ func (p *PostgresManager) GetForeignKeyConstraints(ctx context.Context, schemas
 	} else if err != nil && nucleusdb.IsNoRows(err) {
 		return []*ForeignKeyConstraintsRow{}, nil
 	}
+	shardTables, err := p.getCitusShardTables(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	result := []*ForeignKeyConstraintsRow{}
 	for _, row := range rows {
 		if row.ConstraintType != "f" {
 			continue
 		}
+		if isCitusShardConstraint(shardTables, row) {
+			continue
+		}
 		if len(row.ConstraintColumns) != len(row.ForeignColumnNames) {
 			return nil, fmt.Errorf("length of columns was not equal to length of foreign key cols: %d %d", len(row.ConstraintColumns), len(row.ForeignColumnNames))
 		}
@@ -177,12 +286,19 @@ func (p *PostgresManager) GetPrimaryKeyConstraints(ctx context.Context, schemas
 	} else if err != nil && nucleusdb.IsNoRows(err) {
 		return []*PrimaryKey{}, nil
 	}
+	shardTables, err := p.getCitusShardTables(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	constraints := []*pg_queries.GetTableConstraintsBySchemaRow{}
 	for _, row := range rows {
 		if row.ConstraintType != "p" {
 			continue
 		}
+		if isCitusShardConstraint(shardTables, row) {
+			continue
+		}
 		constraints = append(constraints, row)
 	}
 	result := []*PrimaryKey{}
@@ -222,11 +338,90 @@ func (p *PostgresManager) GetUniqueConstraintsMap(ctx context.Context, schemas [
 		return nil, err
 	}
 
-	if constraints == nil {
-		return map[string][][]string{}, nil
+	output := map[string][][]string{}
+	if constraints != nil && constraints.UniqueConstraints != nil {
+		output = constraints.UniqueConstraints
+	}
+
+	// unique indexes enforce uniqueness the same as a unique constraint but are not stored as constraints
+	indexes, err := p.querier.GetUniqueIndexesBySchema(ctx, p.pool, schemas)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	}
+	shardTables, err := p.getCitusShardTables(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range indexes {
+		// partial indexes only enforce uniqueness for some rows and are returned by GetPartialUniqueIndexesMap
+		if index.IndexPredicate != "" {
+			continue
+		}
+		tableName := BuildTable(index.SchemaName, index.TableName)
+		if _, ok := shardTables[tableName]; ok {
+			continue
+		}
+		output[tableName] = append(output[tableName], index.IndexColumns)
+	}
+	return output, nil
+}
+
+func (p *PostgresManager) GetPartialUniqueIndexesMap(ctx context.Context, schemas []string) (map[string][]*PartialUniqueIndex, error) {
+	if len(schemas) == 0 {
+		return map[string][]*PartialUniqueIndex{}, nil
+	}
+	indexes, err := p.querier.GetUniqueIndexesBySchema(ctx, p.pool, schemas)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
 	}
+	shardTables, err := p.getCitusShardTables(ctx)
+	if err != nil {
+		return nil, err
+	}
+	output := map[string][]*PartialUniqueIndex{}
+	for _, index := range indexes {
+		if index.IndexPredicate == "" {
+			continue
+		}
+		tableName := BuildTable(index.SchemaName, index.TableName)
+		if _, ok := shardTables[tableName]; ok {
+			continue
+		}
+		output[tableName] = append(output[tableName], &PartialUniqueIndex{
+			Name:      index.IndexName,
+			Columns:   index.IndexColumns,
+			Predicate: index.IndexPredicate,
+		})
+	}
+	return output, nil
+}
 
-	return constraints.UniqueConstraints, nil
+func (p *PostgresManager) GetExclusionConstraintsMap(ctx context.Context, schemas []string) (map[string][]*ExclusionConstraint, error) {
+	if len(schemas) == 0 {
+		return map[string][]*ExclusionConstraint{}, nil
+	}
+	rows, err := p.querier.GetExclusionConstraintsBySchema(ctx, p.pool, schemas)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	}
+	shardTables, err := p.getCitusShardTables(ctx)
+	if err != nil {
+		return nil, err
+	}
+	output := map[string][]*ExclusionConstraint{}
+	for _, row := range rows {
+		tableName := BuildTable(row.SchemaName, row.TableName)
+		if _, ok := shardTables[tableName]; ok {
+			continue
+		}
+		output[tableName] = append(output[tableName], &ExclusionConstraint{
+			Name:       row.ConstraintName,
+			Columns:    row.ConstraintColumns,
+			Operators:  row.Operators,
+			Definition: row.ConstraintDefinition,
+		})
+	}
+	return output, nil
 }
 
 func (p *PostgresManager) GetRolePermissionsMap(ctx context.Context, role string) (map[string][]string, error) {
@@ -245,6 +440,68 @@ func (p *PostgresManager) GetRolePermissionsMap(ctx context.Context, role string
 	return schemaTablePrivsMap, err
 }
 
+const formatQueryColumnTypesQuery = `SELECT pg_catalog.format_type(t.oid, t.typmod)
+FROM unnest($1::oid[], $2::int4[]) WITH ORDINALITY AS t(oid, typmod, idx)
+ORDER BY t.idx`
+
+// Infers the columns of a virtual table by running its query without returning any rows.
+// The data types are formatted the same way as they are when retrieving the database schema
+func (p *PostgresManager) GetQueryColumns(ctx context.Context, query string) (map[string]*ColumnInfo, error) {
+	sql, err := BuildVirtualTableSelectQuery(PostgresDriver, query, nil)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := p.pool.Query(ctx, fmt.Sprintf("%s LIMIT 0", sql))
+	if err != nil {
+		return nil, err
+	}
+	fields := rows.FieldDescriptions()
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	oids := make([]uint32, len(fields))
+	typmods := make([]int32, len(fields))
+	for idx, field := range fields {
+		oids[idx] = field.DataTypeOID
+		typmods[idx] = field.TypeModifier
+	}
+	typeRows, err := p.pool.Query(ctx, formatQueryColumnTypesQuery, oids, typmods)
+	if err != nil {
+		return nil, err
+	}
+	defer typeRows.Close()
+	dataTypes := make([]string, 0, len(fields))
+	for typeRows.Next() {
+		var dataType string
+		if err := typeRows.Scan(&dataType); err != nil {
+			return nil, err
+		}
+		dataTypes = append(dataTypes, dataType)
+	}
+	if err := typeRows.Err(); err != nil {
+		return nil, err
+	}
+	if len(dataTypes) != len(fields) {
+		return nil, fmt.Errorf("expected %d column data types but received %d", len(fields), len(dataTypes))
+	}
+
+	columns := map[string]*ColumnInfo{}
+	for idx, field := range fields {
+		if _, ok := columns[field.Name]; ok {
+			return nil, fmt.Errorf("query returns more than one column named %s", field.Name)
+		}
+		columns[field.Name] = &ColumnInfo{
+			OrdinalPosition: int32(idx + 1),
+			IsNullable:      true,
+			DataType:        dataTypes[idx],
+			TableType:       VirtualTableType,
+		}
+	}
+	return columns, nil
+}
+
 func (p *PostgresManager) GetCreateTableStatement(ctx context.Context, schema, table string) (string, error) {
 	errgrp, errctx := errgroup.WithContext(ctx)
 
@@ -327,7 +584,7 @@ func (s SchemaTable) String() string {
 	return BuildTable(s.Schema, s.Table)
 }
 
-func (p *PostgresManager) GetTableInitStatements(ctx context.Context, tables []*SchemaTable) ([]*TableInitStatement, error) {
+func (p *PostgresManager) GetTableInitStatements(ctx context.Context, tables []*SchemaTable, opts *TableInitStatementOpts) ([]*TableInitStatement, error) {
 	if len(tables) == 0 {
 		return []*TableInitStatement{}, nil
 	}
@@ -371,7 +628,7 @@ func (p *PostgresManager) GetTableInitStatements(ctx context.Context, tables []*
 		return nil
 	})
 
-	indexmap := map[string][]string{}
+	indexmap := map[string][]*pg_queries.GetIndicesBySchemasAndTablesRow{}
 	errgrp.Go(func() error {
 		idxrecords, err := p.querier.GetIndicesBySchemasAndTables(errctx, p.pool, combined)
 		if err != nil {
@@ -379,7 +636,7 @@ func (p *PostgresManager) GetTableInitStatements(ctx context.Context, tables []*
 		}
 		for _, record := range idxrecords {
 			key := SchemaTable{Schema: record.SchemaName, Table: record.TableName}
-			indexmap[key.String()] = append(indexmap[key.String()], wrapPgIdempotentIndex(record.SchemaName, record.IndexName, record.IndexDefinition))
+			indexmap[key.String()] = append(indexmap[key.String()], record)
 		}
 		return nil
 	})
@@ -396,24 +653,52 @@ func (p *PostgresManager) GetTableInitStatements(ctx context.Context, tables []*
 		if !ok {
 			continue
 		}
+		renames := opts.getColumnRenames(tableData[0].SchemaName, tableData[0].TableName)
+		excluded := opts.getExcludedColumns(tableData[0].SchemaName, tableData[0].TableName)
 		columns := make([]string, 0, len(tableData))
+		colRequests := make([]*buildTableColRequest, 0, len(tableData))
 		for _, td := range tableData {
-			columns = append(columns, buildTableCol(&buildTableColRequest{
-				ColumnName:    td.ColumnName,
-				ColumnDefault: td.ColumnDefault,
-				DataType:      td.DataType,
+			destColumn := opts.getDestinationColumn(td.SchemaName, td.TableName, td.ColumnName)
+			if destColumn.Exclude {
+				continue
+			}
+			dataType := td.DataType
+			if td.BaseDataType != "" {
+				dataType = td.BaseDataType
+			}
+			if destColumn.DataType != "" {
+				dataType = destColumn.DataType
+			}
+			columnDefault := td.ColumnDefault
+			if td.GeneratedType == "s" {
+				columnDefault = renamePgColumns(columnDefault, renames)
+			}
+			colRequest := &buildTableColRequest{
+				ColumnName:    destColumn.Name,
+				ColumnDefault: columnDefault,
+				DataType:      dataType,
 				IsNullable:    td.IsNullable == "YES",
 				GeneratedType: td.GeneratedType,
-			}))
+				IsSerial:      td.IsSerial,
+			}
+			colRequests = append(colRequests, colRequest)
+			columns = append(columns, buildTableCol(colRequest))
 		}
 
+		dest := opts.getDestinationTable(tableData[0].SchemaName, tableData[0].TableName)
+
 		info := &TableInitStatement{
-			CreateTableStatement: fmt.Sprintf("CREATE TABLE IF NOT EXISTS %q.%q (%s);", tableData[0].SchemaName, tableData[0].TableName, strings.Join(columns, ", ")),
+			CreateTableStatement: fmt.Sprintf("%sCREATE TABLE IF NOT EXISTS %s (%s);", buildPgCreateSequenceStatements(colRequests), QuoteTable(PostgresDriver, dest.Schema, dest.Table), strings.Join(columns, ", ")),
 			AlterTableStatements: []*AlterTableStatement{},
-			IndexStatements:      indexmap[key],
+			IndexStatements:      []string{},
 		}
 		for _, constraint := range constraintmap[key] {
-			stmt, err := buildAlterStatementByConstraint(constraint)
+			if referencesExcludedPgColumn(opts, constraint) {
+				continue
+			}
+			remapped := *constraint
+			remapped.ConstraintDefinition = remapPgConstraintDefinition(opts, constraint)
+			stmt, err := buildAlterStatementByConstraint(dest, &remapped)
 			if err != nil {
 				return nil, err
 			}
@@ -422,10 +707,25 @@ func (p *PostgresManager) GetTableInitStatements(ctx context.Context, tables []*
 				return nil, err
 			}
 			info.AlterTableStatements = append(info.AlterTableStatements, &AlterTableStatement{
-				Statement:      wrapPgIdempotentConstraint(constraint.SchemaName, constraint.TableName, constraint.ConstraintName, stmt),
+				Statement:      wrapPgIdempotentConstraint(dest.Schema, dest.Table, constraint.ConstraintName, stmt),
 				ConstraintType: constraintType,
 			})
 		}
+		for _, record := range indexmap[key] {
+			indexDefinition := record.IndexDefinition
+			if idx := strings.Index(indexDefinition, " USING "); idx != -1 && hasPgColumnReference(indexDefinition[idx:], excluded) {
+				continue
+			}
+			if dest.Schema != record.SchemaName || dest.Table != record.TableName {
+				indexDefinition = setPgIndexTable(indexDefinition, dest)
+			}
+			if len(renames) > 0 {
+				if idx := strings.Index(indexDefinition, " USING "); idx != -1 {
+					indexDefinition = indexDefinition[:idx] + renamePgColumns(indexDefinition[idx:], renames)
+				}
+			}
+			info.IndexStatements = append(info.IndexStatements, wrapPgIdempotentIndex(dest.Schema, record.IndexName, indexDefinition))
+		}
 		output = append(output, info)
 	}
 	return output, nil
@@ -450,7 +750,7 @@ BEGIN
 		%s
 	END IF;
 END $$;
-`, constraintname, schema, addSuffixIfNotExist(alterStatement, ";"))
+`, escapePgStringLiteral(constraintname), escapePgStringLiteral(schema), addSuffixIfNotExist(alterStatement, ";"))
 	return strings.TrimSpace(stmt)
 }
 
@@ -472,10 +772,20 @@ BEGIN
 		%s
 	END IF;
 END $$;
-	`, constraintName, schema, table, addSuffixIfNotExist(alterStatement, ";"))
+	`,
+		escapePgStringLiteral(constraintName),
+		escapePgStringLiteral(EscapePgColumn(schema)),
+		escapePgStringLiteral(QuoteTable(PostgresDriver, schema, table)),
+		addSuffixIfNotExist(alterStatement, ";"),
+	)
 	return strings.TrimSpace(stmt)
 }
 
+// Doubles any single quotes so that the input can be placed within a string literal
+func escapePgStringLiteral(input string) string {
+	return strings.ReplaceAll(input, "'", "''")
+}
+
 func addSuffixIfNotExist(input, suffix string) string {
 	if !strings.HasSuffix(input, suffix) {
 		return fmt.Sprintf("%s%s", input, suffix)
@@ -484,17 +794,195 @@ func addSuffixIfNotExist(input, suffix string) string {
 }
 
 func buildAlterStatementByConstraint(
+	table *SchemaTable,
 	constraint *pg_queries.GetTableConstraintsBySchemaRow,
 ) (string, error) {
 	if constraint == nil {
 		return "", errors.New("unable to build alter statement as constraint is nil")
 	}
 	return fmt.Sprintf(
-		"ALTER TABLE %q.%q ADD CONSTRAINT %s %s;",
-		constraint.SchemaName, constraint.TableName, constraint.ConstraintName, constraint.ConstraintDefinition,
+		"ALTER TABLE %s ADD CONSTRAINT %s %s;",
+		QuoteTable(PostgresDriver, table.Schema, table.Table), EscapePgColumn(constraint.ConstraintName), constraint.ConstraintDefinition,
 	), nil
 }
 
+// Determines if a constraint covers a column, either of its own table or the referenced table, that is left out of the destination
+func referencesExcludedPgColumn(opts *TableInitStatementOpts, constraint *pg_queries.GetTableConstraintsBySchemaRow) bool {
+	excluded := opts.getExcludedColumns(constraint.SchemaName, constraint.TableName)
+	for _, column := range constraint.ConstraintColumns {
+		if _, ok := excluded[column]; ok {
+			return true
+		}
+	}
+	if constraint.ConstraintType == "c" && hasPgColumnReference(constraint.ConstraintDefinition, excluded) {
+		return true
+	}
+	if constraint.ConstraintType == "f" {
+		foreignExcluded := opts.getExcludedColumns(constraint.ForeignSchemaName, constraint.ForeignTableName)
+		for _, column := range constraint.ForeignColumnNames {
+			if _, ok := foreignExcluded[column]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Applies the destination table and column names to a constraint definition from pg_get_constraintdef
+func remapPgConstraintDefinition(opts *TableInitStatementOpts, constraint *pg_queries.GetTableConstraintsBySchemaRow) string {
+	renames := opts.getColumnRenames(constraint.SchemaName, constraint.TableName)
+	idx := strings.Index(constraint.ConstraintDefinition, "REFERENCES ")
+	if constraint.ConstraintType != "f" || idx == -1 {
+		return renamePgColumns(constraint.ConstraintDefinition, renames)
+	}
+
+	local := renamePgColumns(constraint.ConstraintDefinition[:idx], renames)
+	reference := constraint.ConstraintDefinition[idx:]
+	foreignDest := opts.getDestinationTable(constraint.ForeignSchemaName, constraint.ForeignTableName)
+	if foreignDest.Schema != constraint.ForeignSchemaName || foreignDest.Table != constraint.ForeignTableName {
+		reference = setPgForeignKeyReference(reference, foreignDest)
+	}
+	// only the referenced column list belongs to the foreign table
+	start := strings.Index(reference, "(")
+	if start == -1 {
+		return local + reference
+	}
+	end := strings.Index(reference[start:], ")")
+	if end == -1 {
+		return local + reference
+	}
+	foreignRenames := opts.getColumnRenames(constraint.ForeignSchemaName, constraint.ForeignTableName)
+	return local + reference[:start] + renamePgColumns(reference[start:start+end], foreignRenames) + reference[start+end:]
+}
+
+// Renames the column identifiers in a column list or expression from pg_get_constraintdef or pg_get_indexdef.
+// Postgres prints identifiers bare when they are lower case and quoted otherwise. String literals, function calls,
+// qualified names, and type casts are left as-is
+func renamePgColumns(expr string, renames map[string]string) string {
+	if len(renames) == 0 {
+		return expr
+	}
+	return mapPgColumns(expr, func(column string) (string, bool) {
+		name, ok := renames[column]
+		return name, ok
+	})
+}
+
+// Determines if an expression from pg_get_constraintdef or pg_get_indexdef references any of the given columns
+func hasPgColumnReference(expr string, columns map[string]struct{}) bool {
+	if len(columns) == 0 {
+		return false
+	}
+	found := false
+	mapPgColumns(expr, func(column string) (string, bool) {
+		if _, ok := columns[column]; ok {
+			found = true
+		}
+		return "", false
+	})
+	return found
+}
+
+// Walks the column identifiers of an expression, replacing any that the mapper returns a new name for
+func mapPgColumns(expr string, mapper func(column string) (string, bool)) string {
+	var sb strings.Builder
+	for i := 0; i < len(expr); {
+		c := expr[i]
+		switch {
+		case c == '\'' || c == '"':
+			end := findPgQuoteEnd(expr, i)
+			token := expr[i:end]
+			if c == '"' {
+				ident := strings.ReplaceAll(strings.TrimSuffix(token[1:], `"`), `""`, `"`)
+				if !isQualifiedPgIdentifier(expr, i, end) {
+					if name, ok := mapper(ident); ok {
+						token = EscapePgColumn(name)
+					}
+				}
+			}
+			sb.WriteString(token)
+			i = end
+		case isPgIdentifierStart(c):
+			end := i + 1
+			for end < len(expr) && isPgIdentifierChar(expr[end]) {
+				end++
+			}
+			token := expr[i:end]
+			if !isQualifiedPgIdentifier(expr, i, end) && !strings.HasPrefix(strings.TrimLeft(expr[end:], " "), "(") {
+				if name, ok := mapper(token); ok {
+					token = EscapePgColumn(name)
+				}
+			}
+			sb.WriteString(token)
+			i = end
+		default:
+			sb.WriteByte(c)
+			i++
+		}
+	}
+	return sb.String()
+}
+
+// Returns the index after the closing quote of the quoted string or identifier that starts at the given index
+func findPgQuoteEnd(expr string, start int) int {
+	quote := expr[start]
+	for i := start + 1; i < len(expr); i++ {
+		if expr[i] != quote {
+			continue
+		}
+		if i+1 < len(expr) && expr[i+1] == quote {
+			i++
+			continue
+		}
+		return i + 1
+	}
+	return len(expr)
+}
+
+func isQualifiedPgIdentifier(expr string, start, end int) bool {
+	return (start > 0 && (expr[start-1] == '.' || expr[start-1] == ':')) || (end < len(expr) && expr[end] == '.')
+}
+
+func isPgIdentifierStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isPgIdentifierChar(c byte) bool {
+	return isPgIdentifierStart(c) || (c >= '0' && c <= '9') || c == '$'
+}
+
+// Points the REFERENCES clause of a foreign key constraint definition at a different table.
+// pg_get_constraintdef only qualifies the referenced table when it is not on the search path, so the whole identifier is replaced
+func setPgForeignKeyReference(definition string, table *SchemaTable) string {
+	idx := strings.Index(definition, "REFERENCES ")
+	if idx == -1 {
+		return definition
+	}
+	start := idx + len("REFERENCES ")
+	end := strings.Index(definition[start:], "(")
+	if end == -1 {
+		return definition
+	}
+	return fmt.Sprintf("%s%s%s", definition[:start], QuoteTable(PostgresDriver, table.Schema, table.Table), definition[start+end:])
+}
+
+// Points an index definition from pg_get_indexdef at a different table
+func setPgIndexTable(definition string, table *SchemaTable) string {
+	start := strings.Index(definition, " ON ")
+	if start == -1 {
+		return definition
+	}
+	start += len(" ON ")
+	if strings.HasPrefix(definition[start:], "ONLY ") {
+		start += len("ONLY ")
+	}
+	end := strings.Index(definition[start:], " USING ")
+	if end == -1 {
+		return definition
+	}
+	return fmt.Sprintf("%s%s%s", definition[:start], QuoteTable(PostgresDriver, table.Schema, table.Table), definition[start+end:])
+}
+
 // This assumes that the schemas and constraints as for a single table, not an entire db schema
 func generateCreateTableStatement(
 	schema string,
@@ -503,24 +991,31 @@ func generateCreateTableStatement(
 	tableConstraints []*pg_queries.GetTableConstraintsRow,
 ) string {
 	columns := make([]string, len(tableSchemas))
+	colRequests := make([]*buildTableColRequest, len(tableSchemas))
 	for idx := range tableSchemas {
 		record := tableSchemas[idx]
-		columns[idx] = buildTableCol(&buildTableColRequest{
+		dataType := record.DataType
+		if record.BaseDataType != "" {
+			dataType = record.BaseDataType
+		}
+		colRequests[idx] = &buildTableColRequest{
 			ColumnName:    record.ColumnName,
 			ColumnDefault: record.ColumnDefault,
-			DataType:      record.DataType,
+			DataType:      dataType,
 			IsNullable:    record.IsNullable == "YES",
 			GeneratedType: record.GeneratedType,
-		})
+			IsSerial:      record.IsSerial,
+		}
+		columns[idx] = buildTableCol(colRequests[idx])
 	}
 
 	constraints := make([]string, len(tableConstraints))
 	for idx := range tableConstraints {
 		constraint := tableConstraints[idx]
-		constraints[idx] = fmt.Sprintf("CONSTRAINT %s %s", constraint.ConstraintName, constraint.ConstraintDefinition)
+		constraints[idx] = fmt.Sprintf("CONSTRAINT %s %s", EscapePgColumn(constraint.ConstraintName), constraint.ConstraintDefinition)
 	}
 	tableDefs := append(columns, constraints...) //nolint:gocritic
-	return fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %q.%q (%s);`, schema, table, strings.Join(tableDefs, ", "))
+	return fmt.Sprintf(`%sCREATE TABLE IF NOT EXISTS %s (%s);`, buildPgCreateSequenceStatements(colRequests), QuoteTable(PostgresDriver, schema, table), strings.Join(tableDefs, ", "))
 }
 
 type buildTableColRequest struct {
@@ -529,6 +1024,8 @@ type buildTableColRequest struct {
 	DataType      string
 	IsNullable    bool
 	GeneratedType string
+	// The column owns the sequence of its nextval default
+	IsSerial bool
 }
 
 func buildTableCol(record *buildTableColRequest) string {
@@ -536,12 +1033,8 @@ func buildTableCol(record *buildTableColRequest) string {
 	if record.ColumnDefault != "" {
 		if record.GeneratedType == "s" {
 			pieces = append(pieces, fmt.Sprintf("GENERATED ALWAYS AS (%s) STORED", record.ColumnDefault))
-		} else if strings.HasPrefix(record.ColumnDefault, "nextval") && record.DataType == "integer" {
-			pieces[1] = "SERIAL"
-		} else if strings.HasPrefix(record.ColumnDefault, "nextval") && record.DataType == "bigint" {
-			pieces[1] = "BIGSERIAL"
-		} else if strings.HasPrefix(record.ColumnDefault, "nextval") && record.DataType == "smallint" {
-			pieces[1] = "SMALLSERIAL"
+		} else if serialType, ok := getPgSerialType(record); ok {
+			pieces[1] = serialType
 		} else if record.ColumnDefault != "NULL" {
 			pieces = append(pieces, "DEFAULT", record.ColumnDefault)
 		}
@@ -549,6 +1042,46 @@ func buildTableCol(record *buildTableColRequest) string {
 	return strings.Join(pieces, " ")
 }
 
+// Only columns that own the sequence of their nextval default are recreated as serial columns
+func getPgSerialType(record *buildTableColRequest) (string, bool) {
+	if !record.IsSerial || !strings.HasPrefix(record.ColumnDefault, "nextval") {
+		return "", false
+	}
+	switch record.DataType {
+	case "integer":
+		return "SERIAL", true
+	case "bigint":
+		return "BIGSERIAL", true
+	case "smallint":
+		return "SMALLSERIAL", true
+	default:
+		return "", false
+	}
+}
+
+var pgNextvalSequenceRegex = regexp.MustCompile(`nextval\('((?:[^']|'')+)'::regclass\)`)
+
+// Serial columns create their own sequence, any other default that calls nextval is kept verbatim so the sequences it draws from
+// are created ahead of the table. The sequence names are already quoted and schema qualified as needed by pg_get_expr.
+func buildPgCreateSequenceStatements(records []*buildTableColRequest) string {
+	seen := map[string]struct{}{}
+	statements := []string{}
+	for _, record := range records {
+		if _, ok := getPgSerialType(record); ok || record.GeneratedType == "s" {
+			continue
+		}
+		for _, matches := range pgNextvalSequenceRegex.FindAllStringSubmatch(record.ColumnDefault, -1) {
+			sequence := strings.ReplaceAll(matches[1], "''", "'")
+			if _, ok := seen[sequence]; ok {
+				continue
+			}
+			seen[sequence] = struct{}{}
+			statements = append(statements, fmt.Sprintf("CREATE SEQUENCE IF NOT EXISTS %s; ", sequence))
+		}
+	}
+	return strings.Join(statements, "")
+}
+
 func buildNullableText(isNullable bool) string {
 	if isNullable {
 		return "NULL"
@@ -608,13 +1141,327 @@ func (p *PostgresManager) GetTableRowCount(
 		return 0, err
 	}
 	var count int64
-	err = p.pool.QueryRow(ctx, sql).Scan(&count)
+	err = WithPgQueryCancel(ctx, p.pool, func(ctx context.Context, db pg_queries.DBTX) error {
+		return db.QueryRow(ctx, sql).Scan(&count)
+	})
 	if err != nil {
 		return 0, err
 	}
 	return count, err
 }
 
+func (p *PostgresManager) GetColumnDistinctCount(
+	ctx context.Context,
+	schema, table, column string,
+) (int64, error) {
+	sql, err := buildColumnDistinctCountQuery(PostgresDriver, schema, table, column)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = WithPgQueryCancel(ctx, p.pool, func(ctx context.Context, db pg_queries.DBTX) error {
+		return db.QueryRow(ctx, sql).Scan(&count)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (p *PostgresManager) GetNumericColumnSample(
+	ctx context.Context,
+	schema, table, column string,
+	limit uint,
+) ([]float64, error) {
+	sql, err := buildNumericColumnSampleQuery(PostgresDriver, schema, table, column, limit)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := p.pool.Query(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	values := []float64{}
+	for rows.Next() {
+		var value float64
+		if err := rows.Scan(&value); err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, rows.Err()
+}
+
+func (p *PostgresManager) GetTableRowSample(
+	ctx context.Context,
+	schema, table string,
+	columns []string,
+	limit uint,
+) ([][]*string, error) {
+	sql, err := buildTableRowSampleQuery(PostgresDriver, schema, table, columns, limit)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := p.pool.Query(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	output := [][]*string{}
+	for rows.Next() {
+		row := make([]*string, len(columns))
+		dest := make([]any, len(columns))
+		for idx := range row {
+			dest[idx] = &row[idx]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		output = append(output, row)
+	}
+	return output, rows.Err()
+}
+
+func (p *PostgresManager) GetEquivalenceClasses(
+	ctx context.Context,
+	schema, table string,
+	quasiIdentifiers []string,
+	sensitiveColumn *string,
+) ([]*EquivalenceClass, error) {
+	sql, err := buildEquivalenceClassQuery(PostgresDriver, schema, table, quasiIdentifiers, sensitiveColumn)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := p.pool.Query(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	output := []*EquivalenceClass{}
+	for rows.Next() {
+		class := &EquivalenceClass{Values: make([]*string, len(quasiIdentifiers))}
+		dest := make([]any, 0, len(quasiIdentifiers)+2)
+		for idx := range class.Values {
+			dest = append(dest, &class.Values[idx])
+		}
+		dest = append(dest, &class.Size)
+		if sensitiveColumn != nil {
+			dest = append(dest, &class.DistinctSensitiveValues)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		output = append(output, class)
+	}
+	return output, rows.Err()
+}
+
+func (p *PostgresManager) GetForeignKeyOrphanCount(
+	ctx context.Context,
+	schema, table string,
+	constraint *ForeignConstraint,
+) (int64, error) {
+	sql, err := buildForeignKeyOrphanCountQuery(PostgresDriver, schema, table, constraint)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = WithPgQueryCancel(ctx, p.pool, func(ctx context.Context, db pg_queries.DBTX) error {
+		return db.QueryRow(ctx, sql).Scan(&count)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// Returned in creation order so that views built on top of other views are refreshed after them
+const getMaterializedViewsQuery = `SELECT n.nspname, c.relname, c.relispopulated,
+EXISTS (
+  SELECT 1 FROM pg_catalog.pg_index i
+  WHERE i.indrelid = c.oid AND i.indisunique AND i.indpred IS NULL AND i.indexprs IS NULL
+) AS has_unique_index
+FROM pg_catalog.pg_class c
+INNER JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+WHERE c.relkind = 'm'
+ORDER BY c.oid`
+
+func (p *PostgresManager) GetMaterializedViews(ctx context.Context) ([]*MaterializedView, error) {
+	rows, err := p.pool.Query(ctx, getMaterializedViewsQuery)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return []*MaterializedView{}, nil
+	}
+	defer rows.Close()
+
+	views := []*MaterializedView{}
+	for rows.Next() {
+		view := &MaterializedView{}
+		if err := rows.Scan(&view.Schema, &view.Name, &view.IsPopulated, &view.HasUniqueIndex); err != nil {
+			return nil, err
+		}
+		views = append(views, view)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return views, nil
+}
+
+// Only user defined triggers are returned as internal triggers enforce foreign keys
+const getEnabledTableTriggersQuery = `SELECT n.nspname, c.relname, t.tgname
+FROM pg_catalog.pg_trigger t
+INNER JOIN pg_catalog.pg_class c ON c.oid = t.tgrelid
+INNER JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+WHERE NOT t.tgisinternal AND t.tgenabled <> 'D' AND (n.nspname || '.' || c.relname) = ANY($1)
+ORDER BY n.nspname, c.relname, t.tgname`
+
+func (p *PostgresManager) GetEnabledTableTriggers(ctx context.Context, tables []*SchemaTable) ([]*TableTrigger, error) {
+	if len(tables) == 0 {
+		return []*TableTrigger{}, nil
+	}
+	tableKeys := make([]string, 0, len(tables))
+	for _, table := range tables {
+		tableKeys = append(tableKeys, BuildTable(table.Schema, table.Table))
+	}
+	rows, err := p.pool.Query(ctx, getEnabledTableTriggersQuery, tableKeys)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return []*TableTrigger{}, nil
+	}
+	defer rows.Close()
+
+	triggers := []*TableTrigger{}
+	for rows.Next() {
+		trigger := &TableTrigger{}
+		if err := rows.Scan(&trigger.Schema, &trigger.Table, &trigger.Name); err != nil {
+			return nil, err
+		}
+		triggers = append(triggers, trigger)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return triggers, nil
+}
+
+// reltuples is -1 for tables that have never been analyzed
+const getTableSizeEstimatesQuery = `SELECT n.nspname, c.relname, GREATEST(c.reltuples, 0)::bigint, pg_catalog.pg_total_relation_size(c.oid)
+FROM pg_catalog.pg_class c
+INNER JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+WHERE c.relkind IN ('r', 'p') AND (n.nspname || '.' || c.relname) = ANY($1)
+ORDER BY n.nspname, c.relname`
+
+func (p *PostgresManager) GetTableSizeEstimates(ctx context.Context, tables []*SchemaTable) ([]*TableSizeEstimate, error) {
+	if len(tables) == 0 {
+		return []*TableSizeEstimate{}, nil
+	}
+	tableKeys := make([]string, 0, len(tables))
+	for _, table := range tables {
+		tableKeys = append(tableKeys, BuildTable(table.Schema, table.Table))
+	}
+	rows, err := p.pool.Query(ctx, getTableSizeEstimatesQuery, tableKeys)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return []*TableSizeEstimate{}, nil
+	}
+	defer rows.Close()
+
+	estimates := []*TableSizeEstimate{}
+	for rows.Next() {
+		estimate := &TableSizeEstimate{}
+		if err := rows.Scan(&estimate.Schema, &estimate.Table, &estimate.RowCount, &estimate.SizeBytes); err != nil {
+			return nil, err
+		}
+		estimates = append(estimates, estimate)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return estimates, nil
+}
+
+// plpgsql is installed by default in every database.
+// Returned in creation order so that extensions are created after the extensions they depend on
+const getExtensionsQuery = `SELECT e.extname, n.nspname, e.extversion
+FROM pg_catalog.pg_extension e
+INNER JOIN pg_catalog.pg_namespace n ON n.oid = e.extnamespace
+WHERE e.extname <> 'plpgsql'
+ORDER BY e.oid`
+
+func (p *PostgresManager) GetExtensions(ctx context.Context) ([]*Extension, error) {
+	rows, err := p.pool.Query(ctx, getExtensionsQuery)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return []*Extension{}, nil
+	}
+	defer rows.Close()
+
+	extensions := []*Extension{}
+	for rows.Next() {
+		extension := &Extension{}
+		if err := rows.Scan(&extension.Name, &extension.Schema, &extension.Version); err != nil {
+			return nil, err
+		}
+		extensions = append(extensions, extension)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return extensions, nil
+}
+
+const isSuperuserQuery = `SELECT rolsuper FROM pg_catalog.pg_roles WHERE rolname = current_user`
+
+func (p *PostgresManager) IsSuperuser(ctx context.Context) (bool, error) {
+	var isSuperuser bool
+	err := p.pool.QueryRow(ctx, isSuperuserQuery).Scan(&isSuperuser)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return false, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return false, nil
+	}
+	return isSuperuser, nil
+}
+
+// Extensions that are not trusted can only be created by superusers.
+// The version is left out as the destination may not have the same version available
+func BuildPgCreateExtensionStatement(extension *Extension) string {
+	return fmt.Sprintf("CREATE EXTENSION IF NOT EXISTS %s WITH SCHEMA %s CASCADE;", EscapePgColumn(extension.Name), EscapePgColumn(extension.Schema))
+}
+
+func BuildPgDisableTriggerStatement(trigger *TableTrigger) string {
+	return fmt.Sprintf("ALTER TABLE %s.%s DISABLE TRIGGER %s;", EscapePgColumn(trigger.Schema), EscapePgColumn(trigger.Table), EscapePgColumn(trigger.Name))
+}
+
+func BuildPgEnableTriggerStatement(trigger *TableTrigger) string {
+	return fmt.Sprintf("ALTER TABLE %s.%s ENABLE TRIGGER %s;", EscapePgColumn(trigger.Schema), EscapePgColumn(trigger.Table), EscapePgColumn(trigger.Name))
+}
+
+// Concurrent refreshes do not block reads of the view but require it to be populated and to have a unique index
+func BuildPgRefreshMaterializedViewStatement(view *MaterializedView) string {
+	if view.IsPopulated && view.HasUniqueIndex {
+		return fmt.Sprintf("REFRESH MATERIALIZED VIEW CONCURRENTLY %s.%s;", EscapePgColumn(view.Schema), EscapePgColumn(view.Name))
+	}
+	return fmt.Sprintf("REFRESH MATERIALIZED VIEW %s.%s;", EscapePgColumn(view.Schema), EscapePgColumn(view.Name))
+}
+
+// VACUUM can not run inside of a transaction block so the statement must be executed on its own
+func BuildPgAnalyzeStatement(table *SchemaTable, vacuum bool) string {
+	if vacuum {
+		return fmt.Sprintf("VACUUM ANALYZE %s.%s;", EscapePgColumn(table.Schema), EscapePgColumn(table.Table))
+	}
+	return fmt.Sprintf("ANALYZE %s.%s;", EscapePgColumn(table.Schema), EscapePgColumn(table.Table))
+}
+
 func BuildPgTruncateStatement(
 	tables []string,
 ) string {
@@ -635,13 +1482,9 @@ func BuildPgTruncateCascadeStatement(
 }
 
 func EscapePgColumns(cols []string) []string {
-	outcols := make([]string, len(cols))
-	for idx := range cols {
-		outcols[idx] = EscapePgColumn(cols[idx])
-	}
-	return outcols
+	return QuoteIdentifiers(PostgresDriver, cols)
 }
 
 func EscapePgColumn(col string) string {
-	return fmt.Sprintf("%q", col)
+	return QuoteIdentifier(PostgresDriver, col)
 }
@@ -29,6 +29,7 @@ func Test_GetDatabaseSchema(t *testing.T) {
 		pool:    mockPool,
 	}
 
+	pgquerier.On("IsCitusExtensionInstalled", mock.Anything, mockPool).Return(false, nil)
 	pgquerier.On("GetDatabaseSchema", mock.Anything, mockPool).Return(
 		[]*pg_queries.GetDatabaseSchemaRow{
 			{
@@ -42,6 +43,7 @@ func Test_GetDatabaseSchema(t *testing.T) {
 				NumericPrecision:       -1,
 				NumericScale:           -1,
 				OrdinalPosition:        4,
+				TableType:              "BASE TABLE",
 			},
 			{
 				TableSchema:            "public",
@@ -54,6 +56,7 @@ func Test_GetDatabaseSchema(t *testing.T) {
 				NumericPrecision:       32,
 				NumericScale:           0,
 				OrdinalPosition:        5,
+				TableType:              "FOREIGN",
 			},
 		}, nil,
 	)
@@ -70,6 +73,7 @@ func Test_GetDatabaseSchema(t *testing.T) {
 			NumericPrecision:       -1,
 			NumericScale:           -1,
 			OrdinalPosition:        4,
+			TableType:              BaseTableType,
 		},
 		{
 			TableSchema:            "public",
@@ -82,6 +86,7 @@ func Test_GetDatabaseSchema(t *testing.T) {
 			NumericPrecision:       32,
 			NumericScale:           0,
 			OrdinalPosition:        5,
+			TableType:              ForeignTableType,
 		},
 	}
 
@@ -90,6 +95,104 @@ func Test_GetDatabaseSchema(t *testing.T) {
 	require.ElementsMatch(t, expected, actual)
 }
 
+func Test_GetDatabaseSchema_Citus(t *testing.T) {
+	pgquerier := pg_queries.NewMockQuerier(t)
+	mockPool := pg_queries.NewMockDBTX(t)
+	manager := PostgresManager{
+		querier: pgquerier,
+		pool:    mockPool,
+	}
+
+	pgquerier.On("IsCitusExtensionInstalled", mock.Anything, mockPool).Return(true, nil)
+	mockPool.On("QueryRow", mock.Anything, getCitusShardTablesQuery).Return(&mockPgRow{values: []any{[]string{"public.users_102008", "public.users_102009"}}})
+	pgquerier.On("GetDatabaseSchema", mock.Anything, mockPool).Return(
+		[]*pg_queries.GetDatabaseSchemaRow{
+			{TableSchema: "public", TableName: "users", ColumnName: "id", TableType: "BASE TABLE"},
+			{TableSchema: "public", TableName: "users_102008", ColumnName: "id", TableType: "BASE TABLE"},
+			{TableSchema: "public", TableName: "users_102009", ColumnName: "id", TableType: "BASE TABLE"},
+		}, nil,
+	)
+
+	actual, err := manager.GetDatabaseSchema(context.Background())
+	require.NoError(t, err)
+	require.Len(t, actual, 1)
+	require.Equal(t, "users", actual[0].TableName)
+}
+
+func Test_GetDatabaseSchema_Citus_NoShardView(t *testing.T) {
+	pgquerier := pg_queries.NewMockQuerier(t)
+	mockPool := pg_queries.NewMockDBTX(t)
+	manager := PostgresManager{
+		querier: pgquerier,
+		pool:    mockPool,
+	}
+
+	pgquerier.On("IsCitusExtensionInstalled", mock.Anything, mockPool).Return(true, nil)
+	mockPool.On("QueryRow", mock.Anything, getCitusShardTablesQuery).Return(&mockPgRow{err: &pgconn.PgError{Code: pgUndefinedTableCode}})
+	pgquerier.On("GetDatabaseSchema", mock.Anything, mockPool).Return(
+		[]*pg_queries.GetDatabaseSchemaRow{
+			{TableSchema: "public", TableName: "users", ColumnName: "id", TableType: "BASE TABLE"},
+			{TableSchema: "public", TableName: "orders", ColumnName: "id", TableType: "BASE TABLE"},
+		}, nil,
+	)
+
+	actual, err := manager.GetDatabaseSchema(context.Background())
+	require.NoError(t, err)
+	require.Len(t, actual, 2)
+}
+
+func Test_GetTableConstraintsBySchema_Citus(t *testing.T) {
+	pgquerier := pg_queries.NewMockQuerier(t)
+	mockPool := pg_queries.NewMockDBTX(t)
+	manager := PostgresManager{
+		querier: pgquerier,
+		pool:    mockPool,
+	}
+
+	schemas := []string{"public"}
+
+	pgquerier.On("IsCitusExtensionInstalled", mock.Anything, mockPool).Return(true, nil)
+	mockPool.On("QueryRow", mock.Anything, getCitusShardTablesQuery).Return(&mockPgRow{values: []any{[]string{"public.users_102008", "public.orders_102040"}}})
+	pgquerier.On("GetTableConstraintsBySchema", mock.Anything, mockPool, schemas).Return(
+		[]*pg_queries.GetTableConstraintsBySchemaRow{
+			{ConstraintName: "users_pkey", SchemaName: "public", TableName: "users", ConstraintColumns: []string{"id"}, ConstraintType: "p"},
+			{ConstraintName: "users_pkey_102008", SchemaName: "public", TableName: "users_102008", ConstraintColumns: []string{"id"}, ConstraintType: "p"},
+			{ConstraintName: "users_email_key_102008", SchemaName: "public", TableName: "users_102008", ConstraintColumns: []string{"email"}, ConstraintType: "u"},
+			{ConstraintName: "orders_buyer_id_fkey", SchemaName: "public", TableName: "orders", ConstraintColumns: []string{"buyer_id"}, ForeignSchemaName: "public", ForeignTableName: "users", ForeignColumnNames: []string{"id"}, Notnullable: []bool{true}, ConstraintType: "f"},
+			{ConstraintName: "orders_buyer_id_fkey_102040", SchemaName: "public", TableName: "orders_102040", ConstraintColumns: []string{"buyer_id"}, ForeignSchemaName: "public", ForeignTableName: "users_102008", ForeignColumnNames: []string{"id"}, Notnullable: []bool{true}, ConstraintType: "f"},
+			{ConstraintName: "orders_buyer_id_fkey_shard", SchemaName: "public", TableName: "orders", ConstraintColumns: []string{"buyer_id"}, ForeignSchemaName: "public", ForeignTableName: "users_102008", ForeignColumnNames: []string{"id"}, Notnullable: []bool{true}, ConstraintType: "f"},
+		}, nil,
+	)
+
+	actual, err := manager.GetTableConstraintsBySchema(context.Background(), schemas)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]string{"public.users": {"id"}}, actual.PrimaryKeyConstraints)
+	require.Empty(t, actual.UniqueConstraints)
+	require.Equal(t, map[string][]*ForeignConstraint{
+		"public.orders": {{Columns: []string{"buyer_id"}, NotNullable: []bool{true}, ForeignKey: &ForeignKey{Table: "public.users", Columns: []string{"id"}}}},
+	}, actual.ForeignKeyConstraints)
+}
+
+type mockPgRow struct {
+	values []any
+	err    error
+}
+
+func (r *mockPgRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	for idx := range dest {
+		switch d := dest[idx].(type) {
+		case *[]string:
+			*d = r.values[idx].([]string)
+		default:
+			return fmt.Errorf("unsupported scan type: %T", d)
+		}
+	}
+	return nil
+}
+
 func Test_GetForeignKeyConstraintsMap(t *testing.T) {
 	pgquerier := pg_queries.NewMockQuerier(t)
 	mockPool := pg_queries.NewMockDBTX(t)
@@ -100,6 +203,7 @@ func Test_GetForeignKeyConstraintsMap(t *testing.T) {
 
 	schemas := []string{"public"}
 
+	pgquerier.On("IsCitusExtensionInstalled", mock.Anything, mockPool).Return(false, nil)
 	pgquerier.On("GetTableConstraintsBySchema", mock.Anything, mockPool, schemas).Return(
 		mockTableConstraintsRows(), nil,
 	)
@@ -148,6 +252,7 @@ func Test_GetForeignKeyConstraintsMap_ExtraEdgeCases(t *testing.T) {
 
 	schemas := []string{"public"}
 
+	pgquerier.On("IsCitusExtensionInstalled", mock.Anything, mockPool).Return(false, nil)
 	constraints := []*pg_queries.GetTableConstraintsBySchemaRow{
 		{ConstraintName: "t1_b_c_fkey", SchemaName: "neosync_api", TableName: "t1", ConstraintColumns: []string{"b"}, ForeignSchemaName: "neosync_api", ForeignTableName: "account_user_associations", ForeignColumnNames: []string{"account_id"}, Notnullable: []bool{true}, ConstraintType: "f"},
 		{ConstraintName: "t1_b_c_fkey", SchemaName: "neosync_api", TableName: "t1", ConstraintColumns: []string{"c"}, ForeignSchemaName: "neosync_api", ForeignTableName: "account_user_associations", ForeignColumnNames: []string{"user_id"}, Notnullable: []bool{true}, ConstraintType: "f"},
@@ -188,6 +293,7 @@ func Test_GetPrimaryKeyConstraintsMap(t *testing.T) {
 
 	schemas := []string{"public"}
 
+	pgquerier.On("IsCitusExtensionInstalled", mock.Anything, mockPool).Return(false, nil)
 	pgquerier.On("GetTableConstraintsBySchema", mock.Anything, mockPool, schemas).Return(
 		mockTableConstraintsRows(), nil,
 	)
@@ -216,23 +322,95 @@ func Test_GetUniqueConstraintsMap(t *testing.T) {
 
 	schemas := []string{"public"}
 
+	pgquerier.On("IsCitusExtensionInstalled", mock.Anything, mockPool).Return(false, nil)
 	pgquerier.On("GetTableConstraintsBySchema", mock.Anything, mockPool, schemas).Return(
 		mockTableConstraintsRows(), nil,
 	)
+	pgquerier.On("GetUniqueIndexesBySchema", mock.Anything, mockPool, schemas).Return(
+		[]*pg_queries.GetUniqueIndexesBySchemaRow{
+			{SchemaName: "public", TableName: "person", IndexName: "person_email_idx", IndexColumns: []string{"email"}},
+			{SchemaName: "public", TableName: "orders", IndexName: "orders_buyer_id_number_idx", IndexColumns: []string{"buyer_id", "number"}},
+			{SchemaName: "public", TableName: "orders", IndexName: "orders_open_idx", IndexColumns: []string{"buyer_id"}, IndexPredicate: "(status = 'open'::text)"},
+		}, nil,
+	)
 
 	expected := map[string][][]string{
-		"public.person": {{"name", "email"}},
+		"public.person": {{"name", "email"}, {"email"}},
 		"public.region": {{"code"}, {"name"}},
+		"public.orders": {{"buyer_id", "number"}},
 	}
 
 	actual, err := manager.GetUniqueConstraintsMap(context.Background(), schemas)
 
 	require.NoError(t, err)
-	require.Len(t, actual["public.person"], 1)
-	require.Len(t, actual["public.region"], 2)
-	require.ElementsMatch(t, expected["public.person"][0], actual["public.person"][0])
-	require.ElementsMatch(t, expected["public.region"][0], actual["public.region"][0])
-	require.ElementsMatch(t, expected["public.region"][1], actual["public.region"][1])
+	require.Len(t, actual, len(expected))
+	for table, constraints := range expected {
+		require.Len(t, actual[table], len(constraints))
+		for idx, columns := range constraints {
+			require.ElementsMatch(t, columns, actual[table][idx])
+		}
+	}
+}
+
+func Test_GetPartialUniqueIndexesMap(t *testing.T) {
+	pgquerier := pg_queries.NewMockQuerier(t)
+	mockPool := pg_queries.NewMockDBTX(t)
+	manager := PostgresManager{
+		querier: pgquerier,
+		pool:    mockPool,
+	}
+
+	schemas := []string{"public"}
+
+	pgquerier.On("IsCitusExtensionInstalled", mock.Anything, mockPool).Return(false, nil)
+	pgquerier.On("GetUniqueIndexesBySchema", mock.Anything, mockPool, schemas).Return(
+		[]*pg_queries.GetUniqueIndexesBySchemaRow{
+			{SchemaName: "public", TableName: "person", IndexName: "person_email_idx", IndexColumns: []string{"email"}},
+			{SchemaName: "public", TableName: "orders", IndexName: "orders_open_idx", IndexColumns: []string{"buyer_id"}, IndexPredicate: "(status = 'open'::text)"},
+		}, nil,
+	)
+
+	actual, err := manager.GetPartialUniqueIndexesMap(context.Background(), schemas)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]*PartialUniqueIndex{
+		"public.orders": {{Name: "orders_open_idx", Columns: []string{"buyer_id"}, Predicate: "(status = 'open'::text)"}},
+	}, actual)
+}
+
+func Test_GetExclusionConstraintsMap(t *testing.T) {
+	pgquerier := pg_queries.NewMockQuerier(t)
+	mockPool := pg_queries.NewMockDBTX(t)
+	manager := PostgresManager{
+		querier: pgquerier,
+		pool:    mockPool,
+	}
+
+	schemas := []string{"public"}
+
+	pgquerier.On("IsCitusExtensionInstalled", mock.Anything, mockPool).Return(false, nil)
+	pgquerier.On("GetExclusionConstraintsBySchema", mock.Anything, mockPool, schemas).Return(
+		[]*pg_queries.GetExclusionConstraintsBySchemaRow{
+			{
+				SchemaName:           "public",
+				TableName:            "reservations",
+				ConstraintName:       "reservations_room_during_excl",
+				ConstraintColumns:    []string{"room", "during"},
+				Operators:            []string{"=", "&&"},
+				ConstraintDefinition: "EXCLUDE USING gist (room WITH =, during WITH &&)",
+			},
+		}, nil,
+	)
+
+	actual, err := manager.GetExclusionConstraintsMap(context.Background(), schemas)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]*ExclusionConstraint{
+		"public.reservations": {{
+			Name:       "reservations_room_during_excl",
+			Columns:    []string{"room", "during"},
+			Operators:  []string{"=", "&&"},
+			Definition: "EXCLUDE USING gist (room WITH =, during WITH &&)",
+		}},
+	}, actual)
 }
 
 func Test_GetRolePermissionsMap(t *testing.T) {
@@ -320,7 +498,7 @@ func Test_GetCreateTableStatement(t *testing.T) {
 
 	actual, err := manager.GetCreateTableStatement(context.Background(), "public", "users")
 	require.NoError(t, err)
-	require.Equal(t, "CREATE TABLE IF NOT EXISTS \"public\".\"users\" (\"id\" varchar NOT NULL, \"age\" integer NULL, CONSTRAINT users_pkey PRIMARY KEY (id));", actual)
+	require.Equal(t, "CREATE TABLE IF NOT EXISTS \"public\".\"users\" (\"id\" varchar NOT NULL, \"age\" integer NULL, CONSTRAINT \"users_pkey\" PRIMARY KEY (id));", actual)
 }
 
 func Test_GetTableInitStatements_Empty(t *testing.T) {
@@ -331,7 +509,7 @@ func Test_GetTableInitStatements_Empty(t *testing.T) {
 		pool:    mockpool,
 	}
 
-	output, err := manager.GetTableInitStatements(context.Background(), []*SchemaTable{})
+	output, err := manager.GetTableInitStatements(context.Background(), []*SchemaTable{}, nil)
 	require.NoError(t, err)
 	require.Empty(t, output)
 }
@@ -413,7 +591,7 @@ func Test_GetTableInitStatements(t *testing.T) {
 	output, err := manager.GetTableInitStatements(context.Background(), []*SchemaTable{
 		{Schema: "public", Table: "users"},
 		{Schema: "public2", Table: "users"},
-	})
+	}, nil)
 
 	require.NoError(t, err)
 	require.Equal(t, 2, len(output))
@@ -422,7 +600,7 @@ func Test_GetTableInitStatements(t *testing.T) {
 		[]*TableInitStatement{
 			{CreateTableStatement: "CREATE TABLE IF NOT EXISTS \"public\".\"users\" (\"id\" uuid NOT NULL);",
 				AlterTableStatements: []*AlterTableStatement{
-					{ConstraintType: PrimaryConstraintType, Statement: "DO $$\nBEGIN\n\tIF NOT EXISTS (\n\t\tSELECT 1\n\t\tFROM pg_constraint\n\t\tWHERE conname = 'pk_public_users'\n\t\tAND connamespace = 'public'::regnamespace\n\t\tAND conrelid = 'users'::regclass\n\t) THEN\n\t\tALTER TABLE \"public\".\"users\" ADD CONSTRAINT pk_public_users PRIMARY KEY(id);\n\tEND IF;\nEND $$;"},
+					{ConstraintType: PrimaryConstraintType, Statement: "DO $$\nBEGIN\n\tIF NOT EXISTS (\n\t\tSELECT 1\n\t\tFROM pg_constraint\n\t\tWHERE conname = 'pk_public_users'\n\t\tAND connamespace = '\"public\"'::regnamespace\n\t\tAND conrelid = '\"public\".\"users\"'::regclass\n\t) THEN\n\t\tALTER TABLE \"public\".\"users\" ADD CONSTRAINT \"pk_public_users\" PRIMARY KEY(id);\n\tEND IF;\nEND $$;"},
 				},
 				IndexStatements: []string{
 					"DO $$\nBEGIN\n\tIF NOT EXISTS (\n\t\tSELECT 1\n\t\tFROM pg_class c\n\t\tJOIN pg_namespace n ON n.oid = c.relnamespace\n\t\tWHERE c.relkind = 'i'\n\t\tAND c.relname = 'foo'\n\t\tAND n.nspname = 'public'\n\t) THEN\n\t\tCREATE INDEX foo ON public.users USING btree (users_id);\n\tEND IF;\nEND $$;",
@@ -430,7 +608,7 @@ func Test_GetTableInitStatements(t *testing.T) {
 			},
 			{CreateTableStatement: "CREATE TABLE IF NOT EXISTS \"public2\".\"users\" (\"id\" uuid NOT NULL);",
 				AlterTableStatements: []*AlterTableStatement{
-					{ConstraintType: PrimaryConstraintType, Statement: "DO $$\nBEGIN\n\tIF NOT EXISTS (\n\t\tSELECT 1\n\t\tFROM pg_constraint\n\t\tWHERE conname = 'pk_public2_users'\n\t\tAND connamespace = 'public2'::regnamespace\n\t\tAND conrelid = 'users'::regclass\n\t) THEN\n\t\tALTER TABLE \"public2\".\"users\" ADD CONSTRAINT pk_public2_users PRIMARY KEY(id);\n\tEND IF;\nEND $$;"},
+					{ConstraintType: PrimaryConstraintType, Statement: "DO $$\nBEGIN\n\tIF NOT EXISTS (\n\t\tSELECT 1\n\t\tFROM pg_constraint\n\t\tWHERE conname = 'pk_public2_users'\n\t\tAND connamespace = '\"public2\"'::regnamespace\n\t\tAND conrelid = '\"public2\".\"users\"'::regclass\n\t) THEN\n\t\tALTER TABLE \"public2\".\"users\" ADD CONSTRAINT \"pk_public2_users\" PRIMARY KEY(id);\n\tEND IF;\nEND $$;"},
 				},
 				IndexStatements: []string{
 					"DO $$\nBEGIN\n\tIF NOT EXISTS (\n\t\tSELECT 1\n\t\tFROM pg_class c\n\t\tJOIN pg_namespace n ON n.oid = c.relnamespace\n\t\tWHERE c.relkind = 'i'\n\t\tAND c.relname = 'foo'\n\t\tAND n.nspname = 'public2'\n\t) THEN\n\t\tCREATE INDEX foo ON public2.users USING btree (users_id);\n\tEND IF;\nEND $$;",
@@ -441,6 +619,247 @@ func Test_GetTableInitStatements(t *testing.T) {
 	)
 }
 
+func Test_GetTableInitStatements_DestinationTables(t *testing.T) {
+	pgquerier := pg_queries.NewMockQuerier(t)
+	mockpool := pg_queries.NewMockDBTX(t)
+	manager := PostgresManager{
+		querier: pgquerier,
+		pool:    mockpool,
+	}
+
+	pgquerier.On("GetDatabaseTableSchemasBySchemasAndTables", mock.Anything, mockpool, []string{"public.users"}).
+		Return(
+			[]*pg_queries.GetDatabaseTableSchemasBySchemasAndTablesRow{
+				{
+					SchemaName: "public",
+					TableName:  "users",
+					ColumnName: "id",
+					DataType:   "uuid",
+					IsNullable: "NO",
+				},
+			},
+			nil,
+		)
+	pgquerier.On("GetTableConstraintsBySchema", mock.Anything, mockpool, []string{"public"}).
+		Return(
+			[]*pg_queries.GetTableConstraintsBySchemaRow{
+				{
+					ConstraintName:       "users_manager_fk",
+					ConstraintType:       "f",
+					SchemaName:           "public",
+					TableName:            "users",
+					ConstraintColumns:    []string{"manager_id"},
+					Notnullable:          []bool{false},
+					ForeignSchemaName:    "public",
+					ForeignTableName:     "users",
+					ForeignColumnNames:   []string{"id"},
+					ConstraintDefinition: "FOREIGN KEY (manager_id) REFERENCES users(id) ON DELETE CASCADE",
+				},
+			},
+			nil,
+		)
+	pgquerier.On("GetIndicesBySchemasAndTables", mock.Anything, mockpool, []string{"public.users"}).
+		Return(
+			[]*pg_queries.GetIndicesBySchemasAndTablesRow{
+				{
+					SchemaName:      "public",
+					TableName:       "users",
+					IndexName:       "foo",
+					IndexDefinition: "CREATE INDEX foo ON public.users USING btree (users_id)",
+				},
+			},
+			nil,
+		)
+
+	output, err := manager.GetTableInitStatements(context.Background(), []*SchemaTable{
+		{Schema: "public", Table: "users"},
+	}, &TableInitStatementOpts{
+		DestinationTables: map[string]*SchemaTable{"public.users": {Schema: "masked", Table: "users_v2"}},
+	})
+
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]*TableInitStatement{
+			{CreateTableStatement: "CREATE TABLE IF NOT EXISTS \"masked\".\"users_v2\" (\"id\" uuid NOT NULL);",
+				AlterTableStatements: []*AlterTableStatement{
+					{ConstraintType: ForeignConstraintType, Statement: "DO $$\nBEGIN\n\tIF NOT EXISTS (\n\t\tSELECT 1\n\t\tFROM pg_constraint\n\t\tWHERE conname = 'users_manager_fk'\n\t\tAND connamespace = '\"masked\"'::regnamespace\n\t\tAND conrelid = '\"masked\".\"users_v2\"'::regclass\n\t) THEN\n\t\tALTER TABLE \"masked\".\"users_v2\" ADD CONSTRAINT \"users_manager_fk\" FOREIGN KEY (manager_id) REFERENCES \"masked\".\"users_v2\"(id) ON DELETE CASCADE;\n\tEND IF;\nEND $$;"},
+				},
+				IndexStatements: []string{
+					"DO $$\nBEGIN\n\tIF NOT EXISTS (\n\t\tSELECT 1\n\t\tFROM pg_class c\n\t\tJOIN pg_namespace n ON n.oid = c.relnamespace\n\t\tWHERE c.relkind = 'i'\n\t\tAND c.relname = 'foo'\n\t\tAND n.nspname = 'masked'\n\t) THEN\n\t\tCREATE INDEX foo ON \"masked\".\"users_v2\" USING btree (users_id);\n\tEND IF;\nEND $$;",
+				},
+			},
+		},
+		output,
+	)
+}
+
+func Test_GetTableInitStatements_DestinationColumns(t *testing.T) {
+	pgquerier := pg_queries.NewMockQuerier(t)
+	mockpool := pg_queries.NewMockDBTX(t)
+	manager := PostgresManager{
+		querier: pgquerier,
+		pool:    mockpool,
+	}
+
+	pgquerier.On("GetDatabaseTableSchemasBySchemasAndTables", mock.Anything, mockpool, []string{"public.users"}).
+		Return(
+			[]*pg_queries.GetDatabaseTableSchemasBySchemasAndTablesRow{
+				{SchemaName: "public", TableName: "users", ColumnName: "id", DataType: "uuid", IsNullable: "NO"},
+				{SchemaName: "public", TableName: "users", ColumnName: "email", DataType: "text", IsNullable: "NO"},
+			},
+			nil,
+		)
+	pgquerier.On("GetTableConstraintsBySchema", mock.Anything, mockpool, []string{"public"}).
+		Return(
+			[]*pg_queries.GetTableConstraintsBySchemaRow{
+				{
+					ConstraintName:       "users_email_check",
+					ConstraintType:       "c",
+					SchemaName:           "public",
+					TableName:            "users",
+					ConstraintColumns:    []string{"email"},
+					Notnullable:          []bool{true},
+					ConstraintDefinition: "CHECK ((length(email) > 3))",
+				},
+			},
+			nil,
+		)
+	pgquerier.On("GetIndicesBySchemasAndTables", mock.Anything, mockpool, []string{"public.users"}).
+		Return(
+			[]*pg_queries.GetIndicesBySchemasAndTablesRow{
+				{
+					SchemaName:      "public",
+					TableName:       "users",
+					IndexName:       "users_email_idx",
+					IndexDefinition: "CREATE INDEX users_email_idx ON public.users USING btree (lower(email))",
+				},
+			},
+			nil,
+		)
+
+	output, err := manager.GetTableInitStatements(context.Background(), []*SchemaTable{
+		{Schema: "public", Table: "users"},
+	}, &TableInitStatementOpts{
+		DestinationColumns: map[string]map[string]*DestinationColumn{
+			"public.users": {"email": {Name: "email_hash", DataType: "varchar(64)"}},
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output, 1)
+	require.Equal(t, "CREATE TABLE IF NOT EXISTS \"public\".\"users\" (\"id\" uuid NOT NULL, \"email_hash\" varchar(64) NOT NULL);", output[0].CreateTableStatement)
+	require.Len(t, output[0].AlterTableStatements, 1)
+	require.Contains(t, output[0].AlterTableStatements[0].Statement, "ADD CONSTRAINT \"users_email_check\" CHECK ((length(\"email_hash\") > 3));")
+	require.Len(t, output[0].IndexStatements, 1)
+	require.Contains(t, output[0].IndexStatements[0], "CREATE INDEX users_email_idx ON public.users USING btree (lower(\"email_hash\"));")
+}
+
+func Test_GetTableInitStatements_ExcludedColumns(t *testing.T) {
+	pgquerier := pg_queries.NewMockQuerier(t)
+	mockpool := pg_queries.NewMockDBTX(t)
+	manager := PostgresManager{
+		querier: pgquerier,
+		pool:    mockpool,
+	}
+
+	pgquerier.On("GetDatabaseTableSchemasBySchemasAndTables", mock.Anything, mockpool, []string{"public.users"}).
+		Return(
+			[]*pg_queries.GetDatabaseTableSchemasBySchemasAndTablesRow{
+				{SchemaName: "public", TableName: "users", ColumnName: "id", DataType: "uuid", IsNullable: "NO"},
+				{SchemaName: "public", TableName: "users", ColumnName: "ssn", DataType: "text", IsNullable: "YES"},
+			},
+			nil,
+		)
+	pgquerier.On("GetTableConstraintsBySchema", mock.Anything, mockpool, []string{"public"}).
+		Return(
+			[]*pg_queries.GetTableConstraintsBySchemaRow{
+				{
+					ConstraintName:       "users_pkey",
+					ConstraintType:       "p",
+					SchemaName:           "public",
+					TableName:            "users",
+					ConstraintColumns:    []string{"id"},
+					Notnullable:          []bool{true},
+					ConstraintDefinition: "PRIMARY KEY (id)",
+				},
+				{
+					ConstraintName:       "users_ssn_key",
+					ConstraintType:       "u",
+					SchemaName:           "public",
+					TableName:            "users",
+					ConstraintColumns:    []string{"ssn"},
+					Notnullable:          []bool{false},
+					ConstraintDefinition: "UNIQUE (ssn)",
+				},
+			},
+			nil,
+		)
+	pgquerier.On("GetIndicesBySchemasAndTables", mock.Anything, mockpool, []string{"public.users"}).
+		Return(
+			[]*pg_queries.GetIndicesBySchemasAndTablesRow{
+				{
+					SchemaName:      "public",
+					TableName:       "users",
+					IndexName:       "users_ssn_idx",
+					IndexDefinition: "CREATE INDEX users_ssn_idx ON public.users USING btree (lower(ssn))",
+				},
+			},
+			nil,
+		)
+
+	output, err := manager.GetTableInitStatements(context.Background(), []*SchemaTable{
+		{Schema: "public", Table: "users"},
+	}, &TableInitStatementOpts{
+		DestinationColumns: map[string]map[string]*DestinationColumn{
+			"public.users": {"ssn": {Exclude: true}},
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output, 1)
+	require.Equal(t, "CREATE TABLE IF NOT EXISTS \"public\".\"users\" (\"id\" uuid NOT NULL);", output[0].CreateTableStatement)
+	require.Len(t, output[0].AlterTableStatements, 1)
+	require.Contains(t, output[0].AlterTableStatements[0].Statement, "ADD CONSTRAINT \"users_pkey\" PRIMARY KEY (id);")
+	require.Empty(t, output[0].IndexStatements)
+}
+
+func Test_renamePgColumns(t *testing.T) {
+	renames := map[string]string{"id": "user_id", "Name": "full_name", "lower": "lowered"}
+	require.Equal(t, `PRIMARY KEY ("user_id")`, renamePgColumns("PRIMARY KEY (id)", renames))
+	require.Equal(t, `UNIQUE ("full_name", "user_id")`, renamePgColumns(`UNIQUE ("Name", id)`, renames))
+	require.Equal(t, `CHECK ((lower("full_name") <> 'id'::text))`, renamePgColumns(`CHECK ((lower("Name") <> 'id'::text))`, renames))
+	require.Equal(t, "PRIMARY KEY (id)", renamePgColumns("PRIMARY KEY (id)", map[string]string{}))
+}
+
+func Test_hasPgColumnReference(t *testing.T) {
+	columns := map[string]struct{}{"ssn": {}, "Name": {}}
+	require.True(t, hasPgColumnReference(" USING btree (lower(ssn))", columns))
+	require.True(t, hasPgColumnReference(`CHECK ((length("Name") > 3))`, columns))
+	require.False(t, hasPgColumnReference("CHECK ((ssn.length > 3))", columns))
+	require.False(t, hasPgColumnReference("CHECK ((email <> 'ssn'::text))", columns))
+	require.False(t, hasPgColumnReference(" USING btree (ssn)", map[string]struct{}{}))
+}
+
+func Test_remapPgConstraintDefinition_ForeignKey(t *testing.T) {
+	opts := &TableInitStatementOpts{
+		DestinationTables: map[string]*SchemaTable{"public.users": {Schema: "masked", Table: "users_v2"}},
+		DestinationColumns: map[string]map[string]*DestinationColumn{
+			"public.orders": {"user_id": {Name: "customer_id"}},
+			"public.users":  {"id": {Name: "user_id"}},
+		},
+	}
+	actual := remapPgConstraintDefinition(opts, &pg_queries.GetTableConstraintsBySchemaRow{
+		ConstraintType:       "f",
+		SchemaName:           "public",
+		TableName:            "orders",
+		ForeignSchemaName:    "public",
+		ForeignTableName:     "users",
+		ConstraintDefinition: "FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE",
+	})
+	require.Equal(t, `FOREIGN KEY ("customer_id") REFERENCES "masked"."users_v2"("user_id") ON DELETE CASCADE`, actual)
+}
+
 func Test_GenerateCreateTableStatement(t *testing.T) {
 	type testcase struct {
 		schema      string
@@ -495,7 +914,7 @@ func Test_GenerateCreateTableStatement(t *testing.T) {
 					ConstraintDefinition: "PRIMARY KEY (id)",
 				},
 			},
-			expected: `CREATE TABLE IF NOT EXISTS "public"."users" ("id" uuid NOT NULL DEFAULT gen_random_uuid(), "created_at" timestamp without time zone NOT NULL DEFAULT now(), "updated_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP, "extra" varchar NULL, "name" varchar(40) NULL, CONSTRAINT users_pkey PRIMARY KEY (id));`,
+			expected: `CREATE TABLE IF NOT EXISTS "public"."users" ("id" uuid NOT NULL DEFAULT gen_random_uuid(), "created_at" timestamp without time zone NOT NULL DEFAULT now(), "updated_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP, "extra" varchar NULL, "name" varchar(40) NULL, CONSTRAINT "users_pkey" PRIMARY KEY (id));`,
 		},
 		{
 			schema: "public",
@@ -507,6 +926,7 @@ func Test_GenerateCreateTableStatement(t *testing.T) {
 					OrdinalPosition: 1,
 					IsNullable:      "NO",
 					ColumnDefault:   "nextval('users_id_seq'::regclass)",
+					IsSerial:        true,
 				},
 				{
 					ColumnName:      "id2",
@@ -514,6 +934,7 @@ func Test_GenerateCreateTableStatement(t *testing.T) {
 					OrdinalPosition: 2,
 					IsNullable:      "NO",
 					ColumnDefault:   "nextval('users_id2_seq'::regclass)",
+					IsSerial:        true,
 				},
 				{
 					ColumnName:      "id3",
@@ -521,11 +942,41 @@ func Test_GenerateCreateTableStatement(t *testing.T) {
 					OrdinalPosition: 3,
 					IsNullable:      "NO",
 					ColumnDefault:   "nextval('users_id3_seq'::regclass)",
+					IsSerial:        true,
 				},
 			},
 			constraints: []*pg_queries.GetTableConstraintsRow{},
 			expected:    `CREATE TABLE IF NOT EXISTS "public"."users" ("id" SERIAL NOT NULL, "id2" SMALLSERIAL NOT NULL, "id3" BIGSERIAL NOT NULL);`,
 		},
+		{
+			schema: "public",
+			table:  "invoices",
+			rows: []*pg_queries.GetDatabaseTableSchemaRow{
+				{
+					ColumnName:      "number",
+					DataType:        "bigint",
+					OrdinalPosition: 1,
+					IsNullable:      "NO",
+					ColumnDefault:   "nextval('billing.invoice_number_seq'::regclass)",
+				},
+				{
+					ColumnName:      "email",
+					DataType:        "email_address",
+					BaseDataType:    "character varying(255)",
+					OrdinalPosition: 2,
+					IsNullable:      "YES",
+				},
+				{
+					ColumnName:      "reference",
+					DataType:        "text",
+					OrdinalPosition: 3,
+					IsNullable:      "NO",
+					ColumnDefault:   "('INV-'::text || lpad((nextval('invoice_reference_seq'::regclass))::text, 8, '0'::text))",
+				},
+			},
+			constraints: []*pg_queries.GetTableConstraintsRow{},
+			expected:    `CREATE SEQUENCE IF NOT EXISTS billing.invoice_number_seq; CREATE SEQUENCE IF NOT EXISTS invoice_reference_seq; CREATE TABLE IF NOT EXISTS "public"."invoices" ("number" bigint NOT NULL DEFAULT nextval('billing.invoice_number_seq'::regclass), "email" character varying(255) NULL, "reference" text NOT NULL DEFAULT ('INV-'::text || lpad((nextval('invoice_reference_seq'::regclass))::text, 8, '0'::text)));`,
+		},
 	}
 
 	for _, testcase := range cases {
@@ -629,6 +1080,44 @@ func Test_BuildPgTruncateCascadeStatement(t *testing.T) {
 	)
 }
 
+func Test_BuildPgRefreshMaterializedViewStatement(t *testing.T) {
+	require.Equal(
+		t,
+		`REFRESH MATERIALIZED VIEW CONCURRENTLY "public"."user_stats";`,
+		BuildPgRefreshMaterializedViewStatement(&MaterializedView{Schema: "public", Name: "user_stats", IsPopulated: true, HasUniqueIndex: true}),
+	)
+	require.Equal(
+		t,
+		`REFRESH MATERIALIZED VIEW "public"."user_stats";`,
+		BuildPgRefreshMaterializedViewStatement(&MaterializedView{Schema: "public", Name: "user_stats", IsPopulated: false, HasUniqueIndex: true}),
+	)
+	require.Equal(
+		t,
+		`REFRESH MATERIALIZED VIEW "public"."user_stats";`,
+		BuildPgRefreshMaterializedViewStatement(&MaterializedView{Schema: "public", Name: "user_stats", IsPopulated: true, HasUniqueIndex: false}),
+	)
+}
+
+func Test_BuildPgAnalyzeStatement(t *testing.T) {
+	table := &SchemaTable{Schema: "public", Table: "users"}
+	require.Equal(t, `ANALYZE "public"."users";`, BuildPgAnalyzeStatement(table, false))
+	require.Equal(t, `VACUUM ANALYZE "public"."users";`, BuildPgAnalyzeStatement(table, true))
+}
+
+func Test_BuildPgToggleTriggerStatements(t *testing.T) {
+	trigger := &TableTrigger{Schema: "public", Table: "users", Name: "audit_users"}
+	require.Equal(t, `ALTER TABLE "public"."users" DISABLE TRIGGER "audit_users";`, BuildPgDisableTriggerStatement(trigger))
+	require.Equal(t, `ALTER TABLE "public"."users" ENABLE TRIGGER "audit_users";`, BuildPgEnableTriggerStatement(trigger))
+}
+
+func Test_BuildPgCreateExtensionStatement(t *testing.T) {
+	require.Equal(
+		t,
+		`CREATE EXTENSION IF NOT EXISTS "uuid-ossp" WITH SCHEMA "public" CASCADE;`,
+		BuildPgCreateExtensionStatement(&Extension{Name: "uuid-ossp", Schema: "public", Version: "1.1"}),
+	)
+}
+
 func mockTableConstraintsRows() []*pg_queries.GetTableConstraintsBySchemaRow {
 	return []*pg_queries.GetTableConstraintsBySchemaRow{
 		{
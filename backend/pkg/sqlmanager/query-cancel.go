@@ -0,0 +1,109 @@
+package sqlmanager
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	mysql_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/mysql"
+	pg_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/postgresql"
+)
+
+// How long the cancel request itself is given to reach the database once the caller's context is done
+const queryCancelTimeout = 5 * time.Second
+
+// Canceling a context only abandons the client side of a connection, the database keeps running the query.
+// WatchQueryCancel calls cancelQuery with a fresh context if ctx is done before the returned stop func is called,
+// so that the database stops working on a query nobody is waiting for.
+// The stop func must be called once the query has returned and blocks until any in flight cancel request has completed.
+func WatchQueryCancel(ctx context.Context, cancelQuery func(ctx context.Context) error) (stop func()) {
+	done := make(chan struct{})
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		select {
+		case <-done:
+		case <-ctx.Done():
+			cancelCtx, cancel := context.WithTimeout(context.Background(), queryCancelTimeout)
+			defer cancel()
+			// best effort, the query has already been abandoned by the client
+			_ = cancelQuery(cancelCtx)
+		}
+	}()
+	return func() {
+		close(done)
+		<-finished
+	}
+}
+
+// Returns a func that cancels the query currently running on the connection that conn is bound to.
+// conn must be pinned to a single connection (a *sql.Conn or *sql.Tx) and db must hand out a different connection to issue the cancel from.
+func GetSqlQueryCanceler(
+	ctx context.Context,
+	driver string,
+	db mysql_queries.DBTX,
+	conn mysql_queries.DBTX,
+) (func(ctx context.Context) error, error) {
+	switch driver {
+	case PostgresDriver:
+		var pid int64
+		if err := conn.QueryRowContext(ctx, "SELECT pg_backend_pid();").Scan(&pid); err != nil {
+			return nil, fmt.Errorf("unable to get postgres backend pid: %w", err)
+		}
+		return func(ctx context.Context) error {
+			_, err := db.ExecContext(ctx, "SELECT pg_cancel_backend($1);", pid)
+			return err
+		}, nil
+	case MysqlDriver:
+		var connectionId int64
+		if err := conn.QueryRowContext(ctx, "SELECT CONNECTION_ID();").Scan(&connectionId); err != nil {
+			return nil, fmt.Errorf("unable to get mysql connection id: %w", err)
+		}
+		return func(ctx context.Context) error {
+			_, err := db.ExecContext(ctx, fmt.Sprintf("KILL QUERY %d;", connectionId))
+			return err
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported sql driver for query cancellation: %s", driver)
+	}
+}
+
+// Runs fn on a single connection acquired from the pool and sends a postgres cancel request for it if ctx is done before fn returns.
+// Falls back to running fn directly against db if it is not a pool, as is the case in tests.
+func WithPgQueryCancel(ctx context.Context, db pg_queries.DBTX, fn func(ctx context.Context, db pg_queries.DBTX) error) error {
+	pool, ok := db.(*pgxpool.Pool)
+	if !ok {
+		return fn(ctx, db)
+	}
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+	stop := WatchQueryCancel(ctx, conn.Conn().PgConn().CancelRequest)
+	defer stop()
+	return fn(ctx, conn)
+}
+
+// Runs fn on a single connection pinned from db and kills its running query if ctx is done before fn returns.
+// Falls back to running fn directly against db if it is not a *sql.DB, as is the case in tests.
+func WithMysqlQueryCancel(ctx context.Context, db mysql_queries.DBTX, fn func(ctx context.Context, db mysql_queries.DBTX) error) error {
+	sqldb, ok := db.(*sql.DB)
+	if !ok {
+		return fn(ctx, db)
+	}
+	conn, err := sqldb.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	cancelQuery, err := GetSqlQueryCanceler(ctx, MysqlDriver, sqldb, conn)
+	if err != nil {
+		return err
+	}
+	stop := WatchQueryCancel(ctx, cancelQuery)
+	defer stop()
+	return fn(ctx, conn)
+}
@@ -0,0 +1,69 @@
+package sqlmanager
+
+import (
+	"context"
+	"regexp"
+	"sync/atomic"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_WatchQueryCancel_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	canceled := make(chan struct{})
+	stop := WatchQueryCancel(ctx, func(ctx context.Context) error {
+		require.NoError(t, ctx.Err())
+		close(canceled)
+		return nil
+	})
+	cancel()
+	<-canceled
+	stop()
+}
+
+func Test_WatchQueryCancel_Completed(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls atomic.Int32
+	stop := WatchQueryCancel(ctx, func(ctx context.Context) error {
+		calls.Add(1)
+		return nil
+	})
+	stop()
+	cancel()
+	require.Equal(t, int32(0), calls.Load())
+}
+
+func Test_GetSqlQueryCanceler_Postgres(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT pg_backend_pid();")).WillReturnRows(mock.NewRows([]string{"pg_backend_pid"}).AddRow(42))
+	mock.ExpectExec(regexp.QuoteMeta("SELECT pg_cancel_backend($1);")).WithArgs(int64(42)).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	cancelQuery, err := GetSqlQueryCanceler(context.Background(), PostgresDriver, db, db)
+	require.NoError(t, err)
+	require.NoError(t, cancelQuery(context.Background()))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_GetSqlQueryCanceler_Mysql(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT CONNECTION_ID();")).WillReturnRows(mock.NewRows([]string{"CONNECTION_ID()"}).AddRow(42))
+	mock.ExpectExec(regexp.QuoteMeta("KILL QUERY 42;")).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	cancelQuery, err := GetSqlQueryCanceler(context.Background(), MysqlDriver, db, db)
+	require.NoError(t, err)
+	require.NoError(t, cancelQuery(context.Background()))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_GetSqlQueryCanceler_Unsupported(t *testing.T) {
+	_, err := GetSqlQueryCanceler(context.Background(), "sqlite", nil, nil)
+	require.Error(t, err)
+}
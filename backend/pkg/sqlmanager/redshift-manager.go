@@ -0,0 +1,623 @@
+package sqlmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
+)
+
+// Redshift speaks the postgres wire protocol but its catalog is based on postgres 8.0, so the postgres queries that rely on
+// attgenerated, attidentity, pg_get_constraintdef and pg_get_expr do not work. The svv_* system views are used instead.
+type RedshiftManager struct {
+	pool  sqlconnect.SqlDBTX
+	close func()
+}
+
+const (
+	// Redshift identity columns are reported with a default of "identity"(<table oid>, <column index>, '<seed>,<step>'::text)
+	redshiftIdentityGeneratedType = "IDENTITY"
+	redshiftIdentityDefaultPrefix = `"identity"(`
+)
+
+// The svv views also describe external (spectrum) tables and the tables of other databases in the cluster
+const getRedshiftDatabaseSchemaQuery = `SELECT c.table_schema, c.table_name, c.column_name, c.data_type, COALESCE(c.column_default, ''), c.is_nullable,
+COALESCE(c.character_maximum_length, -1), COALESCE(c.numeric_precision, -1), COALESCE(c.numeric_scale, -1), c.ordinal_position
+FROM svv_columns AS c
+JOIN svv_tables AS t ON t.table_catalog = c.table_catalog AND t.table_schema = c.table_schema AND t.table_name = c.table_name
+WHERE t.table_type = 'BASE TABLE' AND c.table_catalog = current_database()
+AND c.table_schema NOT IN ('pg_catalog', 'information_schema', 'pg_internal', 'pg_automv')
+ORDER BY c.table_schema, c.table_name, c.ordinal_position`
+
+func (r *RedshiftManager) GetDatabaseSchema(ctx context.Context) ([]*DatabaseSchemaRow, error) {
+	rows, err := r.pool.QueryContext(ctx, getRedshiftDatabaseSchemaQuery)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return []*DatabaseSchemaRow{}, nil
+	}
+	defer rows.Close()
+
+	result := []*DatabaseSchemaRow{}
+	for rows.Next() {
+		row := &DatabaseSchemaRow{TableType: BaseTableType}
+		if err := rows.Scan(
+			&row.TableSchema,
+			&row.TableName,
+			&row.ColumnName,
+			&row.DataType,
+			&row.ColumnDefault,
+			&row.IsNullable,
+			&row.CharacterMaximumLength,
+			&row.NumericPrecision,
+			&row.NumericScale,
+			&row.OrdinalPosition,
+		); err != nil {
+			return nil, err
+		}
+		row.GeneratedType = getRedshiftGeneratedType(row.ColumnDefault)
+		result = append(result, row)
+	}
+	return result, rows.Err()
+}
+
+func getRedshiftGeneratedType(columnDefault string) *string {
+	if strings.HasPrefix(columnDefault, redshiftIdentityDefaultPrefix) {
+		return Ptr(redshiftIdentityGeneratedType)
+	}
+	return nil
+}
+
+// returns: {public.users: { id: struct{}{}, created_at: struct{}{}}}
+func (r *RedshiftManager) GetSchemaColumnMap(ctx context.Context) (map[string]map[string]*ColumnInfo, error) {
+	dbSchemas, err := r.GetDatabaseSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := GetUniqueSchemaColMappings(dbSchemas)
+	return result, nil
+}
+
+const getRedshiftViewColumnsQuery = `SELECT c.table_schema, c.table_name, c.column_name, c.data_type, c.is_nullable, c.ordinal_position
+FROM svv_columns AS c
+JOIN svv_tables AS t ON t.table_catalog = c.table_catalog AND t.table_schema = c.table_schema AND t.table_name = c.table_name
+WHERE t.table_type = 'VIEW' AND c.table_catalog = current_database()
+AND c.table_schema NOT IN ('pg_catalog', 'information_schema', 'pg_internal', 'pg_automv')
+ORDER BY c.table_schema, c.table_name, c.ordinal_position`
+
+// Returns the columns of every view and materialized view, keyed by schema.table and then column
+func (r *RedshiftManager) GetViewColumnMap(ctx context.Context) (map[string]map[string]*ColumnInfo, error) {
+	rows, err := r.pool.QueryContext(ctx, getRedshiftViewColumnsQuery)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return map[string]map[string]*ColumnInfo{}, nil
+	}
+	defer rows.Close()
+
+	output := map[string]map[string]*ColumnInfo{}
+	for rows.Next() {
+		var schema, view, column, dataType, isNullable string
+		var ordinalPosition int32
+		if err := rows.Scan(&schema, &view, &column, &dataType, &isNullable, &ordinalPosition); err != nil {
+			return nil, err
+		}
+		key := BuildTable(schema, view)
+		if _, ok := output[key]; !ok {
+			output[key] = map[string]*ColumnInfo{}
+		}
+		output[key][column] = &ColumnInfo{
+			OrdinalPosition: ordinalPosition,
+			IsNullable:      isNullable == "YES",
+			DataType:        dataType,
+			TableType:       ViewTableType,
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// Referenced columns are matched by their position in the referenced key as the information schema of redshift does not
+// have position_in_unique_constraint
+const getRedshiftTableConstraintsQuery = `SELECT tc.constraint_type, tc.table_schema, tc.table_name, tc.constraint_name, kcu.column_name, c.is_nullable,
+COALESCE(rkcu.table_schema, ''), COALESCE(rkcu.table_name, ''), COALESCE(rkcu.column_name, '')
+FROM information_schema.table_constraints AS tc
+JOIN information_schema.key_column_usage AS kcu ON kcu.constraint_schema = tc.constraint_schema AND kcu.constraint_name = tc.constraint_name
+AND kcu.table_schema = tc.table_schema AND kcu.table_name = tc.table_name
+JOIN information_schema.columns AS c ON c.table_schema = kcu.table_schema AND c.table_name = kcu.table_name AND c.column_name = kcu.column_name
+LEFT JOIN information_schema.referential_constraints AS rc ON rc.constraint_schema = tc.constraint_schema AND rc.constraint_name = tc.constraint_name
+LEFT JOIN information_schema.key_column_usage AS rkcu ON rkcu.constraint_schema = rc.unique_constraint_schema
+AND rkcu.constraint_name = rc.unique_constraint_name AND rkcu.ordinal_position = kcu.ordinal_position
+WHERE tc.constraint_type IN ('PRIMARY KEY', 'FOREIGN KEY', 'UNIQUE') AND tc.table_schema IN (%s)
+ORDER BY tc.table_schema, tc.table_name, tc.constraint_name, kcu.ordinal_position`
+
+func (r *RedshiftManager) getTableConstraints(ctx context.Context, schemas []string) ([]*informationSchemaConstraint, error) {
+	args := make([]any, 0, len(schemas))
+	for _, schema := range schemas {
+		args = append(args, schema)
+	}
+	query := fmt.Sprintf(getRedshiftTableConstraintsQuery, buildRedshiftPlaceholders(len(schemas)))
+	rows, err := r.pool.QueryContext(ctx, query, args...)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return []*informationSchemaConstraint{}, nil
+	}
+	defer rows.Close()
+
+	constraintRows := []*informationSchemaConstraintRow{}
+	for rows.Next() {
+		row := &informationSchemaConstraintRow{}
+		if err := rows.Scan(
+			&row.ConstraintType,
+			&row.SchemaName,
+			&row.TableName,
+			&row.ConstraintName,
+			&row.ColumnName,
+			&row.IsNullable,
+			&row.ForeignSchemaName,
+			&row.ForeignTableName,
+			&row.ForeignColumnName,
+		); err != nil {
+			return nil, err
+		}
+		constraintRows = append(constraintRows, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return groupInformationSchemaConstraintRows(constraintRows), nil
+}
+
+// Redshift does not enforce primary key, foreign key or unique constraints, but they are still declared and the query planner
+// assumes they hold, so they are returned like they are for postgres
+func (r *RedshiftManager) GetTableConstraintsBySchema(ctx context.Context, schemas []string) (*TableConstraints, error) {
+	if len(schemas) == 0 {
+		return &TableConstraints{}, nil
+	}
+	constraints, err := r.getTableConstraints(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	return buildInformationSchemaTableConstraints(constraints)
+}
+
+func (r *RedshiftManager) GetForeignKeyConstraints(ctx context.Context, schemas []string) ([]*ForeignKeyConstraintsRow, error) {
+	if len(schemas) == 0 {
+		return []*ForeignKeyConstraintsRow{}, nil
+	}
+	constraints, err := r.getTableConstraints(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	return buildInformationSchemaForeignKeyRows(constraints)
+}
+
+// Key is schema.table value is list of tables that key depends on
+func (r *RedshiftManager) GetForeignKeyConstraintsMap(ctx context.Context, schemas []string) (map[string][]*ForeignConstraint, error) {
+	if len(schemas) == 0 {
+		return map[string][]*ForeignConstraint{}, nil
+	}
+	constraints, err := r.GetTableConstraintsBySchema(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	return constraints.ForeignKeyConstraints, nil
+}
+
+func (r *RedshiftManager) GetPrimaryKeyConstraints(ctx context.Context, schemas []string) ([]*PrimaryKey, error) {
+	if len(schemas) == 0 {
+		return []*PrimaryKey{}, nil
+	}
+	constraints, err := r.getTableConstraints(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	return buildInformationSchemaPrimaryKeys(constraints), nil
+}
+
+func (r *RedshiftManager) GetPrimaryKeyConstraintsMap(ctx context.Context, schemas []string) (map[string][]string, error) {
+	if len(schemas) == 0 {
+		return map[string][]string{}, nil
+	}
+	constraints, err := r.GetTableConstraintsBySchema(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	return constraints.PrimaryKeyConstraints, nil
+}
+
+func (r *RedshiftManager) GetUniqueConstraintsMap(ctx context.Context, schemas []string) (map[string][][]string, error) {
+	if len(schemas) == 0 {
+		return map[string][][]string{}, nil
+	}
+	constraints, err := r.GetTableConstraintsBySchema(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	return constraints.UniqueConstraints, nil
+}
+
+// Redshift does not have indexes
+func (r *RedshiftManager) GetPartialUniqueIndexesMap(ctx context.Context, schemas []string) (map[string][]*PartialUniqueIndex, error) {
+	return map[string][]*PartialUniqueIndex{}, nil
+}
+
+// Redshift does not support exclusion constraints
+func (r *RedshiftManager) GetExclusionConstraintsMap(ctx context.Context, schemas []string) (map[string][]*ExclusionConstraint, error) {
+	return map[string][]*ExclusionConstraint{}, nil
+}
+
+// The privileges of the connected user are returned when no role is provided
+const getRedshiftRolePermissionsQuery = `SELECT table_schema, table_name, privilege_type
+FROM information_schema.table_privileges
+WHERE grantee = COALESCE(NULLIF($1, ''), current_user)
+AND table_schema NOT IN ('pg_catalog', 'information_schema', 'pg_internal', 'pg_automv')
+ORDER BY table_schema, table_name, privilege_type`
+
+func (r *RedshiftManager) GetRolePermissionsMap(ctx context.Context, role string) (map[string][]string, error) {
+	rows, err := r.pool.QueryContext(ctx, getRedshiftRolePermissionsQuery, role)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return map[string][]string{}, nil
+	}
+	defer rows.Close()
+
+	schemaTablePrivsMap := map[string][]string{}
+	for rows.Next() {
+		var schema, table, privilege string
+		if err := rows.Scan(&schema, &table, &privilege); err != nil {
+			return nil, err
+		}
+		key := BuildTable(schema, table)
+		schemaTablePrivsMap[key] = append(schemaTablePrivsMap[key], privilege)
+	}
+	return schemaTablePrivsMap, rows.Err()
+}
+
+// Infers the columns of a virtual table by running its query without returning any rows
+func (r *RedshiftManager) GetQueryColumns(ctx context.Context, query string) (map[string]*ColumnInfo, error) {
+	sql, err := BuildVirtualTableSelectQuery(RedshiftDriver, query, nil)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.pool.QueryContext(ctx, fmt.Sprintf("%s LIMIT 0", sql))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	columns := map[string]*ColumnInfo{}
+	for idx, columnType := range columnTypes {
+		if _, ok := columns[columnType.Name()]; ok {
+			return nil, fmt.Errorf("query returns more than one column named %s", columnType.Name())
+		}
+		// the postgres driver does not report nullability so every column is treated as nullable
+		columns[columnType.Name()] = &ColumnInfo{
+			OrdinalPosition: int32(idx + 1),
+			IsNullable:      true,
+			DataType:        strings.ToLower(columnType.DatabaseTypeName()),
+			TableType:       VirtualTableType,
+		}
+	}
+	return columns, rows.Err()
+}
+
+var redshiftCreateTableRegex = regexp.MustCompile(`(?i)^\s*CREATE\s+(TEMP\s+|TEMPORARY\s+)?TABLE\s+(IF\s+NOT\s+EXISTS\s+)?`)
+
+// SHOW TABLE returns the full table definition, including the identity, encoding, distribution and sort keys,
+// which pg_catalog does not expose
+func (r *RedshiftManager) GetCreateTableStatement(ctx context.Context, schema, table string) (string, error) {
+	var createSql string
+	err := r.pool.QueryRowContext(ctx, fmt.Sprintf("SHOW TABLE %s", QuoteTable(RedshiftDriver, schema, table))).Scan(&createSql)
+	if err != nil {
+		return "", fmt.Errorf("unable to get table create statement: %w", err)
+	}
+	return getRedshiftCreateTableStatement(createSql), nil
+}
+
+func getRedshiftCreateTableStatement(ddl string) string {
+	stmt := redshiftCreateTableRegex.ReplaceAllString(strings.TrimSpace(ddl), "CREATE ${1}TABLE IF NOT EXISTS ")
+	return fmt.Sprintf("%s;", strings.TrimSuffix(stmt, ";"))
+}
+
+// Destination table and column overrides are not supported as the create statement comes from redshift as is
+func (r *RedshiftManager) GetTableInitStatements(ctx context.Context, tables []*SchemaTable, opts *TableInitStatementOpts) ([]*TableInitStatement, error) {
+	if opts != nil && (len(opts.DestinationTables) > 0 || len(opts.DestinationColumns) > 0) {
+		return nil, fmt.Errorf("redshift table init statements with destination overrides: %w", errors.ErrUnsupported)
+	}
+	output := []*TableInitStatement{}
+	for _, schematable := range tables {
+		createStmt, err := r.GetCreateTableStatement(ctx, schematable.Schema, schematable.Table)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, &TableInitStatement{
+			CreateTableStatement: createStmt,
+			AlterTableStatements: []*AlterTableStatement{},
+			IndexStatements:      []string{},
+		})
+	}
+	return output, nil
+}
+
+// Redshift does not allow some statements, such as ALTER TABLE ADD COLUMN, to run in a multi statement transaction,
+// so statements are run one at a time
+func (r *RedshiftManager) BatchExec(ctx context.Context, batchSize int, statements []string, opts *BatchExecOpts) error {
+	for _, statement := range statements {
+		if opts != nil && opts.Prefix != nil && *opts.Prefix != "" {
+			statement = fmt.Sprintf("%s %s", *opts.Prefix, statement)
+		}
+		_, err := r.pool.ExecContext(ctx, statement)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *RedshiftManager) GetTableRowCount(
+	ctx context.Context,
+	schema, table string,
+	whereClause *string,
+) (int64, error) {
+	builder := goqu.Dialect(RedshiftDriver)
+	query := builder.From(goquTable(schema, table)).Select(goqu.COUNT("*"))
+	if whereClause != nil && *whereClause != "" {
+		query = query.Where(goqu.L(*whereClause))
+	}
+	sql, _, err := query.ToSQL()
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = r.pool.QueryRowContext(ctx, sql).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *RedshiftManager) GetColumnDistinctCount(
+	ctx context.Context,
+	schema, table, column string,
+) (int64, error) {
+	sql, err := buildColumnDistinctCountQuery(RedshiftDriver, schema, table, column)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = r.pool.QueryRowContext(ctx, sql).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// Redshift materialized views can't be refreshed concurrently, so they are never reported as having a unique index.
+// A materialized view that has not been refreshed yet can still be queried, so every view is treated as populated
+func (r *RedshiftManager) GetMaterializedViews(ctx context.Context) ([]*MaterializedView, error) {
+	rows, err := r.pool.QueryContext(ctx, `SELECT schema, name FROM svv_mv_info WHERE database_name = current_database() ORDER BY schema, name`)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return []*MaterializedView{}, nil
+	}
+	defer rows.Close()
+
+	output := []*MaterializedView{}
+	for rows.Next() {
+		view := &MaterializedView{IsPopulated: true}
+		if err := rows.Scan(&view.Schema, &view.Name); err != nil {
+			return nil, err
+		}
+		view.Schema = strings.TrimSpace(view.Schema)
+		view.Name = strings.TrimSpace(view.Name)
+		output = append(output, view)
+	}
+	return output, rows.Err()
+}
+
+// Redshift does not have triggers
+func (r *RedshiftManager) GetEnabledTableTriggers(ctx context.Context, tables []*SchemaTable) ([]*TableTrigger, error) {
+	return []*TableTrigger{}, nil
+}
+
+// svv_table_info reports the size of each table in 1 MB blocks
+func (r *RedshiftManager) GetTableSizeEstimates(ctx context.Context, tables []*SchemaTable) ([]*TableSizeEstimate, error) {
+	if len(tables) == 0 {
+		return []*TableSizeEstimate{}, nil
+	}
+	args := make([]any, 0, len(tables))
+	for _, table := range tables {
+		args = append(args, BuildTable(table.Schema, table.Table))
+	}
+	query := fmt.Sprintf(`SELECT TRIM("schema"), TRIM("table"), COALESCE(tbl_rows, 0)::BIGINT, COALESCE(size, 0)::BIGINT * 1048576
+FROM svv_table_info
+WHERE TRIM("schema") || '.' || TRIM("table") IN (%s)
+ORDER BY 1, 2`, buildRedshiftPlaceholders(len(tables)))
+
+	rows, err := r.pool.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	estimates := []*TableSizeEstimate{}
+	for rows.Next() {
+		estimate := &TableSizeEstimate{}
+		if err := rows.Scan(&estimate.Schema, &estimate.Table, &estimate.RowCount, &estimate.SizeBytes); err != nil {
+			return nil, err
+		}
+		estimates = append(estimates, estimate)
+	}
+	return estimates, rows.Err()
+}
+
+// Redshift does not support extensions
+func (r *RedshiftManager) GetExtensions(ctx context.Context) ([]*Extension, error) {
+	return []*Extension{}, nil
+}
+
+// Superuser rights are only needed to create postgres extensions
+func (r *RedshiftManager) IsSuperuser(ctx context.Context) (bool, error) {
+	return false, nil
+}
+
+func (r *RedshiftManager) GetNumericColumnSample(
+	ctx context.Context,
+	schema, table, column string,
+	limit uint,
+) ([]float64, error) {
+	sql, err := buildNumericColumnSampleQuery(RedshiftDriver, schema, table, column, limit)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.pool.QueryContext(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	values := []float64{}
+	for rows.Next() {
+		var value float64
+		if err := rows.Scan(&value); err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, rows.Err()
+}
+
+func (r *RedshiftManager) GetTableRowSample(
+	ctx context.Context,
+	schema, table string,
+	columns []string,
+	limit uint,
+) ([][]*string, error) {
+	sql, err := buildTableRowSampleQuery(RedshiftDriver, schema, table, columns, limit)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.pool.QueryContext(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	output := [][]*string{}
+	for rows.Next() {
+		row := make([]*string, len(columns))
+		dest := make([]any, len(columns))
+		for idx := range row {
+			dest[idx] = &row[idx]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		output = append(output, row)
+	}
+	return output, rows.Err()
+}
+
+func (r *RedshiftManager) GetEquivalenceClasses(
+	ctx context.Context,
+	schema, table string,
+	quasiIdentifiers []string,
+	sensitiveColumn *string,
+) ([]*EquivalenceClass, error) {
+	sql, err := buildEquivalenceClassQuery(RedshiftDriver, schema, table, quasiIdentifiers, sensitiveColumn)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.pool.QueryContext(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	output := []*EquivalenceClass{}
+	for rows.Next() {
+		class := &EquivalenceClass{Values: make([]*string, len(quasiIdentifiers))}
+		dest := make([]any, 0, len(quasiIdentifiers)+2)
+		for idx := range class.Values {
+			dest = append(dest, &class.Values[idx])
+		}
+		dest = append(dest, &class.Size)
+		if sensitiveColumn != nil {
+			dest = append(dest, &class.DistinctSensitiveValues)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		output = append(output, class)
+	}
+	return output, rows.Err()
+}
+
+func (r *RedshiftManager) GetForeignKeyOrphanCount(
+	ctx context.Context,
+	schema, table string,
+	constraint *ForeignConstraint,
+) (int64, error) {
+	sql, err := buildForeignKeyOrphanCountQuery(RedshiftDriver, schema, table, constraint)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = r.pool.QueryRowContext(ctx, sql).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *RedshiftManager) Exec(ctx context.Context, statement string) error {
+	_, err := r.pool.ExecContext(ctx, statement)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *RedshiftManager) Close() {
+	if r.pool != nil && r.close != nil {
+		r.close()
+	}
+}
+
+// Returns $1, $2, ... $n
+func buildRedshiftPlaceholders(n int) string {
+	placeholders := make([]string, 0, n)
+	for idx := 1; idx <= n; idx++ {
+		placeholders = append(placeholders, fmt.Sprintf("$%d", idx))
+	}
+	return strings.Join(placeholders, ", ")
+}
+
+// Redshift does not support TRUNCATE ... CASCADE
+func BuildRedshiftTruncateStatement(
+	schema string,
+	table string,
+) (string, error) {
+	builder := goqu.Dialect(RedshiftDriver)
+	sqltable := goqu.S(schema).Table(table)
+	stmt, _, err := builder.From(sqltable).Truncate().ToSQL()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s;", stmt), nil
+}
@@ -0,0 +1,86 @@
+package sqlmanager
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RedshiftManager_GetDatabaseSchema(t *testing.T) {
+	db, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+	manager := &RedshiftManager{pool: db}
+
+	sqlMock.ExpectQuery(regexp.QuoteMeta(getRedshiftDatabaseSchemaQuery)).WillReturnRows(
+		sqlmock.NewRows([]string{"table_schema", "table_name", "column_name", "data_type", "column_default", "is_nullable", "character_maximum_length", "numeric_precision", "numeric_scale", "ordinal_position"}).
+			AddRow("public", "users", "id", "bigint", `"identity"(108210, 0, '1,1'::text)`, "NO", -1, 64, 0, 1).
+			AddRow("public", "users", "email", "character varying", "", "YES", 256, -1, -1, 2),
+	)
+
+	rows, err := manager.GetDatabaseSchema(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []*DatabaseSchemaRow{
+		{TableSchema: "public", TableName: "users", ColumnName: "id", DataType: "bigint", ColumnDefault: `"identity"(108210, 0, '1,1'::text)`, IsNullable: "NO", CharacterMaximumLength: -1, NumericPrecision: 64, NumericScale: 0, OrdinalPosition: 1, GeneratedType: Ptr("IDENTITY"), TableType: BaseTableType},
+		{TableSchema: "public", TableName: "users", ColumnName: "email", DataType: "character varying", IsNullable: "YES", CharacterMaximumLength: 256, NumericPrecision: -1, NumericScale: -1, OrdinalPosition: 2, TableType: BaseTableType},
+	}, rows)
+	require.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func Test_RedshiftManager_GetTableConstraintsBySchema(t *testing.T) {
+	db, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+	manager := &RedshiftManager{pool: db}
+
+	sqlMock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(getRedshiftTableConstraintsQuery, "$1"))).WithArgs("public").WillReturnRows(
+		sqlmock.NewRows([]string{"constraint_type", "table_schema", "table_name", "constraint_name", "column_name", "is_nullable", "foreign_schema", "foreign_table", "foreign_column"}).
+			AddRow("FOREIGN KEY", "public", "orders", "orders_user_fkey", "user_id", "NO", "public", "users", "id").
+			AddRow("FOREIGN KEY", "public", "orders", "orders_user_fkey", "user_account", "YES", "public", "users", "account").
+			AddRow("PRIMARY KEY", "public", "orders", "orders_pkey", "id", "NO", "", "", "").
+			AddRow("PRIMARY KEY", "public", "users", "users_pkey", "id", "NO", "", "", "").
+			AddRow("UNIQUE", "public", "users", "users_id_account_key", "id", "NO", "", "", "").
+			AddRow("UNIQUE", "public", "users", "users_id_account_key", "account", "NO", "", "", ""),
+	)
+
+	constraints, err := manager.GetTableConstraintsBySchema(context.Background(), []string{"public"})
+	require.NoError(t, err)
+	require.Equal(t, &TableConstraints{
+		ForeignKeyConstraints: map[string][]*ForeignConstraint{
+			"public.orders": {{
+				Columns:     []string{"user_id", "user_account"},
+				NotNullable: []bool{true, false},
+				ForeignKey:  &ForeignKey{Table: "public.users", Columns: []string{"id", "account"}},
+			}},
+		},
+		PrimaryKeyConstraints: map[string][]string{"public.orders": {"id"}, "public.users": {"id"}},
+		UniqueConstraints:     map[string][][]string{"public.users": {{"id", "account"}}},
+	}, constraints)
+	require.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func Test_getRedshiftCreateTableStatement(t *testing.T) {
+	require.Equal(
+		t,
+		"CREATE TABLE IF NOT EXISTS public.users (\n\tid bigint NOT NULL identity(1, 1) ENCODE az64\n)\nDISTSTYLE AUTO;",
+		getRedshiftCreateTableStatement("CREATE TABLE public.users (\n\tid bigint NOT NULL identity(1, 1) ENCODE az64\n)\nDISTSTYLE AUTO;"),
+	)
+	require.Equal(
+		t,
+		"CREATE TABLE IF NOT EXISTS public.events (id INTEGER);",
+		getRedshiftCreateTableStatement("create table if not exists public.events (id INTEGER)"),
+	)
+}
+
+func Test_buildRedshiftPlaceholders(t *testing.T) {
+	require.Equal(t, "$1", buildRedshiftPlaceholders(1))
+	require.Equal(t, "$1, $2, $3", buildRedshiftPlaceholders(3))
+}
+
+func Test_BuildRedshiftTruncateStatement(t *testing.T) {
+	stmt, err := BuildRedshiftTruncateStatement("public", "users")
+	require.NoError(t, err)
+	require.Equal(t, `TRUNCATE "public"."users";`, stmt)
+}
@@ -0,0 +1,485 @@
+package sqlmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
+)
+
+type SnowflakeManager struct {
+	pool  sqlconnect.SqlDBTX
+	close func()
+}
+
+// The information schema only describes the database that was connected to
+const getSnowflakeDatabaseSchemaQuery = `SELECT c.table_schema, c.table_name, c.column_name, c.data_type, COALESCE(c.column_default, ''), c.is_nullable,
+COALESCE(c.character_maximum_length, -1), COALESCE(c.numeric_precision, -1), COALESCE(c.numeric_scale, -1), c.ordinal_position
+FROM information_schema.columns AS c
+JOIN information_schema.tables AS t ON t.table_schema = c.table_schema AND t.table_name = c.table_name
+WHERE t.table_type = 'BASE TABLE' AND c.table_schema != 'INFORMATION_SCHEMA'
+ORDER BY c.table_schema, c.table_name, c.ordinal_position`
+
+func (s *SnowflakeManager) GetDatabaseSchema(ctx context.Context) ([]*DatabaseSchemaRow, error) {
+	rows, err := s.pool.QueryContext(ctx, getSnowflakeDatabaseSchemaQuery)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return []*DatabaseSchemaRow{}, nil
+	}
+	defer rows.Close()
+
+	result := []*DatabaseSchemaRow{}
+	for rows.Next() {
+		row := &DatabaseSchemaRow{TableType: BaseTableType}
+		if err := rows.Scan(
+			&row.TableSchema,
+			&row.TableName,
+			&row.ColumnName,
+			&row.DataType,
+			&row.ColumnDefault,
+			&row.IsNullable,
+			&row.CharacterMaximumLength,
+			&row.NumericPrecision,
+			&row.NumericScale,
+			&row.OrdinalPosition,
+		); err != nil {
+			return nil, err
+		}
+		row.DataType = strings.ToLower(row.DataType)
+		result = append(result, row)
+	}
+	return result, rows.Err()
+}
+
+// returns: {public.users: { id: struct{}{}, created_at: struct{}{}}}
+func (s *SnowflakeManager) GetSchemaColumnMap(ctx context.Context) (map[string]map[string]*ColumnInfo, error) {
+	dbSchemas, err := s.GetDatabaseSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := GetUniqueSchemaColMappings(dbSchemas)
+	return result, nil
+}
+
+const getSnowflakeViewColumnsQuery = `SELECT c.table_schema, c.table_name, c.column_name, c.data_type, c.is_nullable, c.ordinal_position
+FROM information_schema.columns AS c
+JOIN information_schema.tables AS t ON t.table_schema = c.table_schema AND t.table_name = c.table_name
+WHERE t.table_type IN ('VIEW', 'MATERIALIZED VIEW') AND c.table_schema != 'INFORMATION_SCHEMA'
+ORDER BY c.table_schema, c.table_name, c.ordinal_position`
+
+// Returns the columns of every view and materialized view, keyed by schema.table and then column
+func (s *SnowflakeManager) GetViewColumnMap(ctx context.Context) (map[string]map[string]*ColumnInfo, error) {
+	rows, err := s.pool.QueryContext(ctx, getSnowflakeViewColumnsQuery)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return map[string]map[string]*ColumnInfo{}, nil
+	}
+	defer rows.Close()
+
+	output := map[string]map[string]*ColumnInfo{}
+	for rows.Next() {
+		var schema, view, column, dataType, isNullable string
+		var ordinalPosition int32
+		if err := rows.Scan(&schema, &view, &column, &dataType, &isNullable, &ordinalPosition); err != nil {
+			return nil, err
+		}
+		key := BuildTable(schema, view)
+		if _, ok := output[key]; !ok {
+			output[key] = map[string]*ColumnInfo{}
+		}
+		output[key][column] = &ColumnInfo{
+			OrdinalPosition: ordinalPosition,
+			IsNullable:      isNullable == "YES",
+			DataType:        strings.ToLower(dataType),
+			TableType:       ViewTableType,
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// Snowflake only enforces not null constraints. Primary key, foreign key and unique constraints are informational
+// and are not returned as the data is not guaranteed to satisfy them
+func (s *SnowflakeManager) GetTableConstraintsBySchema(ctx context.Context, schemas []string) (*TableConstraints, error) {
+	return &TableConstraints{
+		ForeignKeyConstraints: map[string][]*ForeignConstraint{},
+		PrimaryKeyConstraints: map[string][]string{},
+		UniqueConstraints:     map[string][][]string{},
+	}, nil
+}
+
+func (s *SnowflakeManager) GetForeignKeyConstraints(ctx context.Context, schemas []string) ([]*ForeignKeyConstraintsRow, error) {
+	return []*ForeignKeyConstraintsRow{}, nil
+}
+
+func (s *SnowflakeManager) GetForeignKeyConstraintsMap(ctx context.Context, schemas []string) (map[string][]*ForeignConstraint, error) {
+	return map[string][]*ForeignConstraint{}, nil
+}
+
+func (s *SnowflakeManager) GetPrimaryKeyConstraints(ctx context.Context, schemas []string) ([]*PrimaryKey, error) {
+	return []*PrimaryKey{}, nil
+}
+
+func (s *SnowflakeManager) GetPrimaryKeyConstraintsMap(ctx context.Context, schemas []string) (map[string][]string, error) {
+	return map[string][]string{}, nil
+}
+
+func (s *SnowflakeManager) GetUniqueConstraintsMap(ctx context.Context, schemas []string) (map[string][][]string, error) {
+	return map[string][][]string{}, nil
+}
+
+// Snowflake does not have indexes
+func (s *SnowflakeManager) GetPartialUniqueIndexesMap(ctx context.Context, schemas []string) (map[string][]*PartialUniqueIndex, error) {
+	return map[string][]*PartialUniqueIndex{}, nil
+}
+
+// Snowflake does not support exclusion constraints
+func (s *SnowflakeManager) GetExclusionConstraintsMap(ctx context.Context, schemas []string) (map[string][]*ExclusionConstraint, error) {
+	return map[string][]*ExclusionConstraint{}, nil
+}
+
+// The privileges of the connection's current role are returned when no role is provided
+const getSnowflakeRolePermissionsQuery = `SELECT table_schema, table_name, privilege_type
+FROM information_schema.table_privileges
+WHERE grantee = COALESCE(NULLIF(?, ''), CURRENT_ROLE()) AND table_schema != 'INFORMATION_SCHEMA'
+ORDER BY table_schema, table_name, privilege_type`
+
+func (s *SnowflakeManager) GetRolePermissionsMap(ctx context.Context, role string) (map[string][]string, error) {
+	rows, err := s.pool.QueryContext(ctx, getSnowflakeRolePermissionsQuery, role)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return map[string][]string{}, nil
+	}
+	defer rows.Close()
+
+	schemaTablePrivsMap := map[string][]string{}
+	for rows.Next() {
+		var schema, table, privilege string
+		if err := rows.Scan(&schema, &table, &privilege); err != nil {
+			return nil, err
+		}
+		key := BuildTable(schema, table)
+		schemaTablePrivsMap[key] = append(schemaTablePrivsMap[key], privilege)
+	}
+	return schemaTablePrivsMap, rows.Err()
+}
+
+// Infers the columns of a virtual table by running its query without returning any rows
+func (s *SnowflakeManager) GetQueryColumns(ctx context.Context, query string) (map[string]*ColumnInfo, error) {
+	sql, err := BuildVirtualTableSelectQuery(SnowflakeDriver, query, nil)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.pool.QueryContext(ctx, fmt.Sprintf("%s LIMIT 0", sql))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	columns := map[string]*ColumnInfo{}
+	for idx, columnType := range columnTypes {
+		if _, ok := columns[columnType.Name()]; ok {
+			return nil, fmt.Errorf("query returns more than one column named %s", columnType.Name())
+		}
+		isNullable, ok := columnType.Nullable()
+		columns[columnType.Name()] = &ColumnInfo{
+			OrdinalPosition: int32(idx + 1),
+			IsNullable:      isNullable || !ok,
+			DataType:        strings.ToLower(columnType.DatabaseTypeName()),
+			TableType:       VirtualTableType,
+		}
+	}
+	return columns, rows.Err()
+}
+
+var snowflakeCreateTableRegex = regexp.MustCompile(`(?i)^\s*CREATE\s+OR\s+REPLACE\s+(TRANSIENT\s+)?TABLE\s+`)
+
+// Snowflake generates the statement that recreates the table, which is changed to leave an existing table in place
+func (s *SnowflakeManager) GetCreateTableStatement(ctx context.Context, schema, table string) (string, error) {
+	var createSql string
+	err := s.pool.QueryRowContext(ctx, "SELECT GET_DDL('TABLE', ?)", QuoteTable(SnowflakeDriver, schema, table)).Scan(&createSql)
+	if err != nil {
+		return "", fmt.Errorf("unable to get table create statement: %w", err)
+	}
+	return getSnowflakeCreateTableStatement(createSql), nil
+}
+
+func getSnowflakeCreateTableStatement(ddl string) string {
+	stmt := snowflakeCreateTableRegex.ReplaceAllString(strings.TrimSpace(ddl), "CREATE ${1}TABLE IF NOT EXISTS ")
+	return fmt.Sprintf("%s;", strings.TrimSuffix(stmt, ";"))
+}
+
+// Destination table and column overrides are not supported as snowflake is not a sync destination
+func (s *SnowflakeManager) GetTableInitStatements(ctx context.Context, tables []*SchemaTable, opts *TableInitStatementOpts) ([]*TableInitStatement, error) {
+	if opts != nil && (len(opts.DestinationTables) > 0 || len(opts.DestinationColumns) > 0) {
+		return nil, fmt.Errorf("snowflake table init statements with destination overrides: %w", errors.ErrUnsupported)
+	}
+	output := []*TableInitStatement{}
+	for _, schematable := range tables {
+		createStmt, err := s.GetCreateTableStatement(ctx, schematable.Schema, schematable.Table)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, &TableInitStatement{
+			CreateTableStatement: createStmt,
+			AlterTableStatements: []*AlterTableStatement{},
+			IndexStatements:      []string{},
+		})
+	}
+	return output, nil
+}
+
+// The snowflake driver only runs a single statement per request so statements are run one at a time
+func (s *SnowflakeManager) BatchExec(ctx context.Context, batchSize int, statements []string, opts *BatchExecOpts) error {
+	for _, statement := range statements {
+		if opts != nil && opts.Prefix != nil && *opts.Prefix != "" {
+			statement = fmt.Sprintf("%s %s", *opts.Prefix, statement)
+		}
+		_, err := s.pool.ExecContext(ctx, statement)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SnowflakeManager) GetTableRowCount(
+	ctx context.Context,
+	schema, table string,
+	whereClause *string,
+) (int64, error) {
+	builder := goqu.Dialect(SnowflakeDriver)
+	query := builder.From(goquTable(schema, table)).Select(goqu.COUNT("*"))
+	if whereClause != nil && *whereClause != "" {
+		query = query.Where(goqu.L(*whereClause))
+	}
+	sql, _, err := query.ToSQL()
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = s.pool.QueryRowContext(ctx, sql).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (s *SnowflakeManager) GetColumnDistinctCount(
+	ctx context.Context,
+	schema, table, column string,
+) (int64, error) {
+	sql, err := buildColumnDistinctCountQuery(SnowflakeDriver, schema, table, column)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = s.pool.QueryRowContext(ctx, sql).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// Snowflake keeps materialized views up to date itself so they never need to be refreshed
+func (s *SnowflakeManager) GetMaterializedViews(ctx context.Context) ([]*MaterializedView, error) {
+	return []*MaterializedView{}, nil
+}
+
+// Snowflake does not have triggers
+func (s *SnowflakeManager) GetEnabledTableTriggers(ctx context.Context, tables []*SchemaTable) ([]*TableTrigger, error) {
+	return []*TableTrigger{}, nil
+}
+
+// Snowflake keeps an exact row count and size for every table in its metadata
+func (s *SnowflakeManager) GetTableSizeEstimates(ctx context.Context, tables []*SchemaTable) ([]*TableSizeEstimate, error) {
+	if len(tables) == 0 {
+		return []*TableSizeEstimate{}, nil
+	}
+	placeholders := make([]string, 0, len(tables))
+	args := make([]any, 0, len(tables))
+	for _, table := range tables {
+		placeholders = append(placeholders, "?")
+		args = append(args, BuildTable(table.Schema, table.Table))
+	}
+	query := fmt.Sprintf(`SELECT table_schema, table_name, COALESCE(row_count, 0), COALESCE(bytes, 0)
+FROM information_schema.tables
+WHERE table_schema || '.' || table_name IN (%s)
+ORDER BY table_schema, table_name`, strings.Join(placeholders, ", "))
+
+	rows, err := s.pool.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	estimates := []*TableSizeEstimate{}
+	for rows.Next() {
+		estimate := &TableSizeEstimate{}
+		if err := rows.Scan(&estimate.Schema, &estimate.Table, &estimate.RowCount, &estimate.SizeBytes); err != nil {
+			return nil, err
+		}
+		estimates = append(estimates, estimate)
+	}
+	return estimates, rows.Err()
+}
+
+// Snowflake does not have extensions that are installed into the database
+func (s *SnowflakeManager) GetExtensions(ctx context.Context) ([]*Extension, error) {
+	return []*Extension{}, nil
+}
+
+// Superuser rights are only needed to create postgres extensions
+func (s *SnowflakeManager) IsSuperuser(ctx context.Context) (bool, error) {
+	return false, nil
+}
+
+func (s *SnowflakeManager) GetNumericColumnSample(
+	ctx context.Context,
+	schema, table, column string,
+	limit uint,
+) ([]float64, error) {
+	sql, err := buildNumericColumnSampleQuery(SnowflakeDriver, schema, table, column, limit)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.pool.QueryContext(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	values := []float64{}
+	for rows.Next() {
+		var value float64
+		if err := rows.Scan(&value); err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, rows.Err()
+}
+
+func (s *SnowflakeManager) GetTableRowSample(
+	ctx context.Context,
+	schema, table string,
+	columns []string,
+	limit uint,
+) ([][]*string, error) {
+	sql, err := buildTableRowSampleQuery(SnowflakeDriver, schema, table, columns, limit)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.pool.QueryContext(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	output := [][]*string{}
+	for rows.Next() {
+		row := make([]*string, len(columns))
+		dest := make([]any, len(columns))
+		for idx := range row {
+			dest[idx] = &row[idx]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		output = append(output, row)
+	}
+	return output, rows.Err()
+}
+
+func (s *SnowflakeManager) GetEquivalenceClasses(
+	ctx context.Context,
+	schema, table string,
+	quasiIdentifiers []string,
+	sensitiveColumn *string,
+) ([]*EquivalenceClass, error) {
+	sql, err := buildEquivalenceClassQuery(SnowflakeDriver, schema, table, quasiIdentifiers, sensitiveColumn)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.pool.QueryContext(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	output := []*EquivalenceClass{}
+	for rows.Next() {
+		class := &EquivalenceClass{Values: make([]*string, len(quasiIdentifiers))}
+		dest := make([]any, 0, len(quasiIdentifiers)+2)
+		for idx := range class.Values {
+			dest = append(dest, &class.Values[idx])
+		}
+		dest = append(dest, &class.Size)
+		if sensitiveColumn != nil {
+			dest = append(dest, &class.DistinctSensitiveValues)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		output = append(output, class)
+	}
+	return output, rows.Err()
+}
+
+func (s *SnowflakeManager) GetForeignKeyOrphanCount(
+	ctx context.Context,
+	schema, table string,
+	constraint *ForeignConstraint,
+) (int64, error) {
+	sql, err := buildForeignKeyOrphanCountQuery(SnowflakeDriver, schema, table, constraint)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = s.pool.QueryRowContext(ctx, sql).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (s *SnowflakeManager) Exec(ctx context.Context, statement string) error {
+	_, err := s.pool.ExecContext(ctx, statement)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *SnowflakeManager) Close() {
+	if s.pool != nil && s.close != nil {
+		s.close()
+	}
+}
+
+func BuildSnowflakeTruncateStatement(
+	schema string,
+	table string,
+) (string, error) {
+	builder := goqu.Dialect(SnowflakeDriver)
+	sqltable := goqu.S(schema).Table(table)
+	stmt, _, err := builder.From(sqltable).Truncate().ToSQL()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s;", stmt), nil
+}
@@ -0,0 +1,66 @@
+package sqlmanager
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SnowflakeManager_GetDatabaseSchema(t *testing.T) {
+	db, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+	manager := &SnowflakeManager{pool: db}
+
+	sqlMock.ExpectQuery(regexp.QuoteMeta(getSnowflakeDatabaseSchemaQuery)).WillReturnRows(
+		sqlmock.NewRows([]string{"table_schema", "table_name", "column_name", "data_type", "column_default", "is_nullable", "character_maximum_length", "numeric_precision", "numeric_scale", "ordinal_position"}).
+			AddRow("PUBLIC", "USERS", "ID", "NUMBER", "", "NO", -1, 38, 0, 1).
+			AddRow("PUBLIC", "USERS", "EMAIL", "TEXT", "", "YES", 255, -1, -1, 2),
+	)
+
+	rows, err := manager.GetDatabaseSchema(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []*DatabaseSchemaRow{
+		{TableSchema: "PUBLIC", TableName: "USERS", ColumnName: "ID", DataType: "number", IsNullable: "NO", CharacterMaximumLength: -1, NumericPrecision: 38, NumericScale: 0, OrdinalPosition: 1, TableType: BaseTableType},
+		{TableSchema: "PUBLIC", TableName: "USERS", ColumnName: "EMAIL", DataType: "text", IsNullable: "YES", CharacterMaximumLength: 255, NumericPrecision: -1, NumericScale: -1, OrdinalPosition: 2, TableType: BaseTableType},
+	}, rows)
+	require.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func Test_SnowflakeManager_GetRolePermissionsMap(t *testing.T) {
+	db, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+	manager := &SnowflakeManager{pool: db}
+
+	sqlMock.ExpectQuery(regexp.QuoteMeta(getSnowflakeRolePermissionsQuery)).WithArgs("ANALYST").WillReturnRows(
+		sqlmock.NewRows([]string{"table_schema", "table_name", "privilege_type"}).
+			AddRow("PUBLIC", "USERS", "INSERT").
+			AddRow("PUBLIC", "USERS", "SELECT"),
+	)
+
+	privs, err := manager.GetRolePermissionsMap(context.Background(), "ANALYST")
+	require.NoError(t, err)
+	require.Equal(t, map[string][]string{"PUBLIC.USERS": {"INSERT", "SELECT"}}, privs)
+	require.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func Test_getSnowflakeCreateTableStatement(t *testing.T) {
+	require.Equal(
+		t,
+		"CREATE TABLE IF NOT EXISTS USERS (\n\tID NUMBER(38,0) NOT NULL\n);",
+		getSnowflakeCreateTableStatement("create or replace TABLE USERS (\n\tID NUMBER(38,0) NOT NULL\n);\n"),
+	)
+	require.Equal(
+		t,
+		"CREATE TRANSIENT TABLE IF NOT EXISTS EVENTS (ID NUMBER(38,0));",
+		getSnowflakeCreateTableStatement("create or replace TRANSIENT TABLE EVENTS (ID NUMBER(38,0))"),
+	)
+}
+
+func Test_BuildSnowflakeTruncateStatement(t *testing.T) {
+	stmt, err := BuildSnowflakeTruncateStatement("PUBLIC", "USERS")
+	require.NoError(t, err)
+	require.Equal(t, `TRUNCATE "PUBLIC"."USERS";`, stmt)
+}
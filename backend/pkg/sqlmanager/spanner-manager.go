@@ -0,0 +1,750 @@
+package sqlmanager
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
+)
+
+// Only databases that use the GoogleSQL dialect are supported. Tables of the default schema have an empty schema name,
+// so they are keyed by their table name alone
+type SpannerManager struct {
+	pool  sqlconnect.SqlDBTX
+	close func()
+}
+
+func init() {
+	// GoogleSQL quotes identifiers with backticks
+	opts := goqu.DefaultDialectOptions()
+	opts.QuoteRune = '`'
+	goqu.RegisterDialect(SpannerDriver, opts)
+}
+
+const (
+	spannerGeneratedType = "ALWAYS"
+	// Interleaved tables are returned as foreign keys to their parent table, under a name that can not clash with a real constraint
+	spannerInterleaveConstraintPrefix = "INTERLEAVE IN PARENT "
+)
+
+var spannerTypeLengthRegex = regexp.MustCompile(`^(?:STRING|BYTES)\((\d+)\)$`)
+
+const getSpannerDatabaseSchemaQuery = `SELECT c.TABLE_SCHEMA, c.TABLE_NAME, c.COLUMN_NAME, c.SPANNER_TYPE, COALESCE(c.COLUMN_DEFAULT, ''), c.IS_NULLABLE,
+c.ORDINAL_POSITION, COALESCE(c.IS_GENERATED, 'NEVER')
+FROM INFORMATION_SCHEMA.COLUMNS AS c
+JOIN INFORMATION_SCHEMA.TABLES AS t ON t.TABLE_SCHEMA = c.TABLE_SCHEMA AND t.TABLE_NAME = c.TABLE_NAME
+WHERE t.TABLE_TYPE = 'BASE TABLE' AND c.TABLE_SCHEMA NOT IN ('INFORMATION_SCHEMA', 'SPANNER_SYS')
+ORDER BY c.TABLE_SCHEMA, c.TABLE_NAME, c.ORDINAL_POSITION`
+
+func (s *SpannerManager) GetDatabaseSchema(ctx context.Context) ([]*DatabaseSchemaRow, error) {
+	rows, err := s.pool.QueryContext(ctx, getSpannerDatabaseSchemaQuery)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return []*DatabaseSchemaRow{}, nil
+	}
+	defer rows.Close()
+
+	result := []*DatabaseSchemaRow{}
+	for rows.Next() {
+		row := &DatabaseSchemaRow{TableType: BaseTableType, NumericPrecision: -1, NumericScale: -1}
+		var isGenerated string
+		if err := rows.Scan(
+			&row.TableSchema,
+			&row.TableName,
+			&row.ColumnName,
+			&row.DataType,
+			&row.ColumnDefault,
+			&row.IsNullable,
+			&row.OrdinalPosition,
+			&isGenerated,
+		); err != nil {
+			return nil, err
+		}
+		row.CharacterMaximumLength = getSpannerTypeLength(row.DataType)
+		if isGenerated == spannerGeneratedType {
+			row.GeneratedType = Ptr(spannerGeneratedType)
+		}
+		result = append(result, row)
+	}
+	return result, rows.Err()
+}
+
+// Returns the length of STRING(n) and BYTES(n) columns, or -1 for every other type including STRING(MAX)
+func getSpannerTypeLength(spannerType string) int32 {
+	matches := spannerTypeLengthRegex.FindStringSubmatch(spannerType)
+	if len(matches) != 2 {
+		return -1
+	}
+	length, err := strconv.ParseInt(matches[1], 10, 32)
+	if err != nil {
+		return -1
+	}
+	return int32(length)
+}
+
+// returns: {users: { id: struct{}{}, created_at: struct{}{}}}
+func (s *SpannerManager) GetSchemaColumnMap(ctx context.Context) (map[string]map[string]*ColumnInfo, error) {
+	dbSchemas, err := s.GetDatabaseSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := GetUniqueSchemaColMappings(dbSchemas)
+	return result, nil
+}
+
+const getSpannerViewColumnsQuery = `SELECT c.TABLE_SCHEMA, c.TABLE_NAME, c.COLUMN_NAME, c.SPANNER_TYPE, c.IS_NULLABLE, c.ORDINAL_POSITION
+FROM INFORMATION_SCHEMA.COLUMNS AS c
+JOIN INFORMATION_SCHEMA.TABLES AS t ON t.TABLE_SCHEMA = c.TABLE_SCHEMA AND t.TABLE_NAME = c.TABLE_NAME
+WHERE t.TABLE_TYPE = 'VIEW' AND c.TABLE_SCHEMA NOT IN ('INFORMATION_SCHEMA', 'SPANNER_SYS')
+ORDER BY c.TABLE_SCHEMA, c.TABLE_NAME, c.ORDINAL_POSITION`
+
+// Returns the columns of every view, keyed by schema.table and then column
+func (s *SpannerManager) GetViewColumnMap(ctx context.Context) (map[string]map[string]*ColumnInfo, error) {
+	rows, err := s.pool.QueryContext(ctx, getSpannerViewColumnsQuery)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return map[string]map[string]*ColumnInfo{}, nil
+	}
+	defer rows.Close()
+
+	output := map[string]map[string]*ColumnInfo{}
+	for rows.Next() {
+		var schema, view, column, dataType, isNullable string
+		var ordinalPosition int32
+		if err := rows.Scan(&schema, &view, &column, &dataType, &isNullable, &ordinalPosition); err != nil {
+			return nil, err
+		}
+		key := BuildTable(schema, view)
+		if _, ok := output[key]; !ok {
+			output[key] = map[string]*ColumnInfo{}
+		}
+		output[key][column] = &ColumnInfo{
+			OrdinalPosition: ordinalPosition,
+			IsNullable:      isNullable == "YES",
+			DataType:        dataType,
+			TableType:       ViewTableType,
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+const getSpannerTableConstraintsQuery = `SELECT tc.CONSTRAINT_TYPE, tc.TABLE_SCHEMA, tc.TABLE_NAME, tc.CONSTRAINT_NAME, kcu.COLUMN_NAME, c.IS_NULLABLE,
+COALESCE(rkcu.TABLE_SCHEMA, ''), COALESCE(rkcu.TABLE_NAME, ''), COALESCE(rkcu.COLUMN_NAME, '')
+FROM INFORMATION_SCHEMA.TABLE_CONSTRAINTS AS tc
+JOIN INFORMATION_SCHEMA.KEY_COLUMN_USAGE AS kcu ON kcu.CONSTRAINT_SCHEMA = tc.CONSTRAINT_SCHEMA AND kcu.CONSTRAINT_NAME = tc.CONSTRAINT_NAME
+AND kcu.TABLE_SCHEMA = tc.TABLE_SCHEMA AND kcu.TABLE_NAME = tc.TABLE_NAME
+JOIN INFORMATION_SCHEMA.COLUMNS AS c ON c.TABLE_SCHEMA = kcu.TABLE_SCHEMA AND c.TABLE_NAME = kcu.TABLE_NAME AND c.COLUMN_NAME = kcu.COLUMN_NAME
+LEFT JOIN INFORMATION_SCHEMA.REFERENTIAL_CONSTRAINTS AS rc ON rc.CONSTRAINT_SCHEMA = tc.CONSTRAINT_SCHEMA AND rc.CONSTRAINT_NAME = tc.CONSTRAINT_NAME
+LEFT JOIN INFORMATION_SCHEMA.KEY_COLUMN_USAGE AS rkcu ON rkcu.CONSTRAINT_SCHEMA = rc.UNIQUE_CONSTRAINT_SCHEMA
+AND rkcu.CONSTRAINT_NAME = rc.UNIQUE_CONSTRAINT_NAME AND rkcu.ORDINAL_POSITION = kcu.POSITION_IN_UNIQUE_CONSTRAINT
+WHERE tc.CONSTRAINT_TYPE IN ('PRIMARY KEY', 'FOREIGN KEY', 'UNIQUE') AND tc.TABLE_SCHEMA IN (%s)
+ORDER BY tc.TABLE_SCHEMA, tc.TABLE_NAME, tc.CONSTRAINT_NAME, kcu.ORDINAL_POSITION`
+
+// An interleaved table is stored alongside the rows of its parent table, and its primary key starts with the parent's primary key
+type spannerInterleavedTable struct {
+	Schema         string
+	Table          string
+	ParentTable    string
+	OnDeleteAction string
+}
+
+// Interleaved tables must be in the same schema as their parent
+const getSpannerInterleavedTablesQuery = `SELECT TABLE_SCHEMA, TABLE_NAME, PARENT_TABLE_NAME, COALESCE(ON_DELETE_ACTION, '')
+FROM INFORMATION_SCHEMA.TABLES
+WHERE TABLE_TYPE = 'BASE TABLE' AND PARENT_TABLE_NAME IS NOT NULL AND TABLE_SCHEMA IN (%s)
+ORDER BY TABLE_SCHEMA, TABLE_NAME`
+
+func buildSpannerSchemaPlaceholders(schemas []string) (string, []any) {
+	args := make([]any, 0, len(schemas))
+	placeholders := make([]string, 0, len(schemas))
+	for _, schema := range schemas {
+		args = append(args, schema)
+		placeholders = append(placeholders, "?")
+	}
+	return strings.Join(placeholders, ", "), args
+}
+
+func (s *SpannerManager) getInterleavedTables(ctx context.Context, schemas []string) ([]*spannerInterleavedTable, error) {
+	placeholders, args := buildSpannerSchemaPlaceholders(schemas)
+	rows, err := s.pool.QueryContext(ctx, fmt.Sprintf(getSpannerInterleavedTablesQuery, placeholders), args...)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return []*spannerInterleavedTable{}, nil
+	}
+	defer rows.Close()
+
+	output := []*spannerInterleavedTable{}
+	for rows.Next() {
+		table := &spannerInterleavedTable{}
+		if err := rows.Scan(&table.Schema, &table.Table, &table.ParentTable, &table.OnDeleteAction); err != nil {
+			return nil, err
+		}
+		output = append(output, table)
+	}
+	return output, rows.Err()
+}
+
+// Returns the constraints of the tables in the given schemas, along with a foreign key from every interleaved table to its parent
+func (s *SpannerManager) getTableConstraints(ctx context.Context, schemas []string) ([]*informationSchemaConstraint, error) {
+	placeholders, args := buildSpannerSchemaPlaceholders(schemas)
+	rows, err := s.pool.QueryContext(ctx, fmt.Sprintf(getSpannerTableConstraintsQuery, placeholders), args...)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return []*informationSchemaConstraint{}, nil
+	}
+	defer rows.Close()
+
+	constraintRows := []*informationSchemaConstraintRow{}
+	for rows.Next() {
+		row := &informationSchemaConstraintRow{}
+		if err := rows.Scan(
+			&row.ConstraintType,
+			&row.SchemaName,
+			&row.TableName,
+			&row.ConstraintName,
+			&row.ColumnName,
+			&row.IsNullable,
+			&row.ForeignSchemaName,
+			&row.ForeignTableName,
+			&row.ForeignColumnName,
+		); err != nil {
+			return nil, err
+		}
+		constraintRows = append(constraintRows, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	interleavedTables, err := s.getInterleavedTables(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	return addSpannerInterleaveConstraints(groupInformationSchemaConstraintRows(constraintRows), interleavedTables)
+}
+
+// Spanner requires the parent row of an interleaved row to exist, so the interleaved table is given a foreign key on the
+// leading columns of its primary key that references the primary key of its parent
+func addSpannerInterleaveConstraints(
+	constraints []*informationSchemaConstraint,
+	interleavedTables []*spannerInterleavedTable,
+) ([]*informationSchemaConstraint, error) {
+	primaryKeys := map[string]*informationSchemaConstraint{}
+	for _, constraint := range constraints {
+		if constraint.ConstraintType == "PRIMARY KEY" {
+			primaryKeys[BuildTable(constraint.SchemaName, constraint.TableName)] = constraint
+		}
+	}
+
+	output := slices.Clone(constraints)
+	for _, interleaved := range interleavedTables {
+		parentKey, ok := primaryKeys[BuildTable(interleaved.Schema, interleaved.ParentTable)]
+		if !ok {
+			return nil, fmt.Errorf("unable to find primary key of %s, the parent of interleaved table %s", BuildTable(interleaved.Schema, interleaved.ParentTable), BuildTable(interleaved.Schema, interleaved.Table))
+		}
+		childKey, ok := primaryKeys[BuildTable(interleaved.Schema, interleaved.Table)]
+		if !ok || len(childKey.Columns) < len(parentKey.Columns) {
+			return nil, fmt.Errorf("primary key of interleaved table %s does not start with the primary key of its parent", BuildTable(interleaved.Schema, interleaved.Table))
+		}
+		numColumns := len(parentKey.Columns)
+		output = append(output, &informationSchemaConstraint{
+			ConstraintType:     "FOREIGN KEY",
+			SchemaName:         interleaved.Schema,
+			TableName:          interleaved.Table,
+			ConstraintName:     spannerInterleaveConstraintPrefix + interleaved.ParentTable,
+			Columns:            slices.Clone(childKey.Columns[:numColumns]),
+			NotNullable:        slices.Clone(childKey.NotNullable[:numColumns]),
+			ForeignSchemaName:  interleaved.Schema,
+			ForeignTableName:   interleaved.ParentTable,
+			ForeignColumnNames: slices.Clone(parentKey.Columns),
+		})
+	}
+	return output, nil
+}
+
+// Interleaved tables are returned as a foreign key to their parent table so that parents are synced before their children
+func (s *SpannerManager) GetTableConstraintsBySchema(ctx context.Context, schemas []string) (*TableConstraints, error) {
+	if len(schemas) == 0 {
+		return &TableConstraints{}, nil
+	}
+	constraints, err := s.getTableConstraints(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	return buildInformationSchemaTableConstraints(constraints)
+}
+
+func (s *SpannerManager) GetForeignKeyConstraints(ctx context.Context, schemas []string) ([]*ForeignKeyConstraintsRow, error) {
+	if len(schemas) == 0 {
+		return []*ForeignKeyConstraintsRow{}, nil
+	}
+	constraints, err := s.getTableConstraints(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	return buildInformationSchemaForeignKeyRows(constraints)
+}
+
+// Key is schema.table value is list of tables that key depends on
+func (s *SpannerManager) GetForeignKeyConstraintsMap(ctx context.Context, schemas []string) (map[string][]*ForeignConstraint, error) {
+	if len(schemas) == 0 {
+		return map[string][]*ForeignConstraint{}, nil
+	}
+	constraints, err := s.GetTableConstraintsBySchema(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	return constraints.ForeignKeyConstraints, nil
+}
+
+func (s *SpannerManager) GetPrimaryKeyConstraints(ctx context.Context, schemas []string) ([]*PrimaryKey, error) {
+	if len(schemas) == 0 {
+		return []*PrimaryKey{}, nil
+	}
+	constraints, err := s.getTableConstraints(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	return buildInformationSchemaPrimaryKeys(constraints), nil
+}
+
+func (s *SpannerManager) GetPrimaryKeyConstraintsMap(ctx context.Context, schemas []string) (map[string][]string, error) {
+	if len(schemas) == 0 {
+		return map[string][]string{}, nil
+	}
+	constraints, err := s.GetTableConstraintsBySchema(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	return constraints.PrimaryKeyConstraints, nil
+}
+
+func (s *SpannerManager) GetUniqueConstraintsMap(ctx context.Context, schemas []string) (map[string][][]string, error) {
+	if len(schemas) == 0 {
+		return map[string][][]string{}, nil
+	}
+	constraints, err := s.GetTableConstraintsBySchema(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	return constraints.UniqueConstraints, nil
+}
+
+// Spanner indexes can be null filtered, but can not have a WHERE clause
+func (s *SpannerManager) GetPartialUniqueIndexesMap(ctx context.Context, schemas []string) (map[string][]*PartialUniqueIndex, error) {
+	return map[string][]*PartialUniqueIndex{}, nil
+}
+
+// Spanner does not support exclusion constraints
+func (s *SpannerManager) GetExclusionConstraintsMap(ctx context.Context, schemas []string) (map[string][]*ExclusionConstraint, error) {
+	return map[string][]*ExclusionConstraint{}, nil
+}
+
+const getSpannerTablesQuery = `SELECT TABLE_SCHEMA, TABLE_NAME
+FROM INFORMATION_SCHEMA.TABLES
+WHERE TABLE_TYPE = 'BASE TABLE' AND TABLE_SCHEMA NOT IN ('INFORMATION_SCHEMA', 'SPANNER_SYS')
+ORDER BY TABLE_SCHEMA, TABLE_NAME`
+
+// Access to a Spanner database is granted through IAM, which can not be queried from the database itself,
+// so every table is returned with full privileges
+func (s *SpannerManager) GetRolePermissionsMap(ctx context.Context, role string) (map[string][]string, error) {
+	rows, err := s.pool.QueryContext(ctx, getSpannerTablesQuery)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return map[string][]string{}, nil
+	}
+	defer rows.Close()
+
+	schemaTablePrivsMap := map[string][]string{}
+	for rows.Next() {
+		var schema, table string
+		if err := rows.Scan(&schema, &table); err != nil {
+			return nil, err
+		}
+		schemaTablePrivsMap[BuildTable(schema, table)] = []string{"SELECT", "INSERT", "UPDATE", "DELETE"}
+	}
+	return schemaTablePrivsMap, rows.Err()
+}
+
+// Infers the columns of a virtual table by running its query without returning any rows
+func (s *SpannerManager) GetQueryColumns(ctx context.Context, query string) (map[string]*ColumnInfo, error) {
+	sql, err := BuildVirtualTableSelectQuery(SpannerDriver, query, nil)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.pool.QueryContext(ctx, fmt.Sprintf("%s LIMIT 0", sql))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	columns := map[string]*ColumnInfo{}
+	for idx, columnType := range columnTypes {
+		if _, ok := columns[columnType.Name()]; ok {
+			return nil, fmt.Errorf("query returns more than one column named %s", columnType.Name())
+		}
+		// the spanner driver does not report nullability so every column is treated as nullable
+		columns[columnType.Name()] = &ColumnInfo{
+			OrdinalPosition: int32(idx + 1),
+			IsNullable:      true,
+			DataType:        columnType.DatabaseTypeName(),
+			TableType:       VirtualTableType,
+		}
+	}
+	return columns, rows.Err()
+}
+
+// Spanner only returns the DDL of a database through its admin API, so the create statement is built from the information schema.
+// Generated columns are created as plain columns as their expressions are not carried over
+func (s *SpannerManager) GetCreateTableStatement(ctx context.Context, schema, table string) (string, error) {
+	columnMap, err := s.GetSchemaColumnMap(ctx)
+	if err != nil {
+		return "", err
+	}
+	columns, ok := columnMap[BuildTable(schema, table)]
+	if !ok {
+		return "", fmt.Errorf("unable to find table %s", BuildTable(schema, table))
+	}
+	constraints, err := s.getTableConstraints(ctx, []string{schema})
+	if err != nil {
+		return "", err
+	}
+	var primaryKey []string
+	for _, constraint := range constraints {
+		if constraint.ConstraintType == "PRIMARY KEY" && constraint.SchemaName == schema && constraint.TableName == table {
+			primaryKey = constraint.Columns
+		}
+	}
+	interleavedTables, err := s.getInterleavedTables(ctx, []string{schema})
+	if err != nil {
+		return "", err
+	}
+	var parent *spannerInterleavedTable
+	for _, interleaved := range interleavedTables {
+		if interleaved.Table == table {
+			parent = interleaved
+		}
+	}
+	return buildSpannerCreateTableStatement(schema, table, columns, primaryKey, parent), nil
+}
+
+// Spanner DDL statements can not end with a semicolon
+func buildSpannerCreateTableStatement(
+	schema, table string,
+	columns map[string]*ColumnInfo,
+	primaryKey []string,
+	parent *spannerInterleavedTable,
+) string {
+	names := make([]string, 0, len(columns))
+	for name := range columns {
+		names = append(names, name)
+	}
+	slices.SortFunc(names, func(a, b string) int {
+		return cmp.Compare(columns[a].OrdinalPosition, columns[b].OrdinalPosition)
+	})
+
+	columnDefs := make([]string, 0, len(names))
+	for _, name := range names {
+		columnDef := fmt.Sprintf("%s %s", QuoteIdentifier(SpannerDriver, name), columns[name].DataType)
+		if !columns[name].IsNullable {
+			columnDef = fmt.Sprintf("%s NOT NULL", columnDef)
+		}
+		if columns[name].ColumnDefault != "" {
+			columnDef = fmt.Sprintf("%s DEFAULT (%s)", columnDef, columns[name].ColumnDefault)
+		}
+		columnDefs = append(columnDefs, columnDef)
+	}
+	stmt := fmt.Sprintf(
+		"CREATE TABLE IF NOT EXISTS %s (\n  %s\n) PRIMARY KEY (%s)",
+		QuoteTable(SpannerDriver, schema, table),
+		strings.Join(columnDefs, ",\n  "),
+		strings.Join(QuoteIdentifiers(SpannerDriver, primaryKey), ", "),
+	)
+	if parent != nil {
+		stmt = fmt.Sprintf("%s,\n  INTERLEAVE IN PARENT %s", stmt, QuoteTable(SpannerDriver, schema, parent.ParentTable))
+		if parent.OnDeleteAction != "" {
+			stmt = fmt.Sprintf("%s ON DELETE %s", stmt, parent.OnDeleteAction)
+		}
+	}
+	return stmt
+}
+
+// Destination table and column overrides are not supported as interleaved tables must keep the name of their parent and its key columns.
+// Foreign keys are added once every table has been created, interleaved tables are instead created along with their parent relationship
+func (s *SpannerManager) GetTableInitStatements(ctx context.Context, tables []*SchemaTable, opts *TableInitStatementOpts) ([]*TableInitStatement, error) {
+	if opts != nil && (len(opts.DestinationTables) > 0 || len(opts.DestinationColumns) > 0) {
+		return nil, fmt.Errorf("spanner table init statements with destination overrides: %w", errors.ErrUnsupported)
+	}
+	schemas := []string{}
+	for _, schematable := range tables {
+		if !slices.Contains(schemas, schematable.Schema) {
+			schemas = append(schemas, schematable.Schema)
+		}
+	}
+	foreignKeys := map[string][]*informationSchemaConstraint{}
+	if len(schemas) > 0 {
+		constraints, err := s.getTableConstraints(ctx, schemas)
+		if err != nil {
+			return nil, err
+		}
+		for _, constraint := range constraints {
+			if constraint.ConstraintType != "FOREIGN KEY" || strings.HasPrefix(constraint.ConstraintName, spannerInterleaveConstraintPrefix) {
+				continue
+			}
+			key := BuildTable(constraint.SchemaName, constraint.TableName)
+			foreignKeys[key] = append(foreignKeys[key], constraint)
+		}
+	}
+
+	output := []*TableInitStatement{}
+	for _, schematable := range tables {
+		createStmt, err := s.GetCreateTableStatement(ctx, schematable.Schema, schematable.Table)
+		if err != nil {
+			return nil, err
+		}
+		alterStmts := []*AlterTableStatement{}
+		for _, constraint := range foreignKeys[BuildTable(schematable.Schema, schematable.Table)] {
+			alterStmts = append(alterStmts, &AlterTableStatement{
+				Statement:      buildSpannerAddForeignKeyStatement(constraint),
+				ConstraintType: ForeignConstraintType,
+			})
+		}
+		output = append(output, &TableInitStatement{
+			CreateTableStatement: createStmt,
+			AlterTableStatements: alterStmts,
+			IndexStatements:      []string{},
+		})
+	}
+	return output, nil
+}
+
+func buildSpannerAddForeignKeyStatement(constraint *informationSchemaConstraint) string {
+	return fmt.Sprintf(
+		"ALTER TABLE %s ADD CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (%s)",
+		QuoteTable(SpannerDriver, constraint.SchemaName, constraint.TableName),
+		QuoteIdentifier(SpannerDriver, constraint.ConstraintName),
+		strings.Join(QuoteIdentifiers(SpannerDriver, constraint.Columns), ", "),
+		QuoteTable(SpannerDriver, constraint.ForeignSchemaName, constraint.ForeignTableName),
+		strings.Join(QuoteIdentifiers(SpannerDriver, constraint.ForeignColumnNames), ", "),
+	)
+}
+
+// DDL and DML statements can not be mixed in a batch so statements are run one at a time
+func (s *SpannerManager) BatchExec(ctx context.Context, batchSize int, statements []string, opts *BatchExecOpts) error {
+	for _, statement := range statements {
+		if opts != nil && opts.Prefix != nil && *opts.Prefix != "" {
+			statement = fmt.Sprintf("%s %s", *opts.Prefix, statement)
+		}
+		_, err := s.pool.ExecContext(ctx, statement)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SpannerManager) GetTableRowCount(
+	ctx context.Context,
+	schema, table string,
+	whereClause *string,
+) (int64, error) {
+	builder := goqu.Dialect(SpannerDriver)
+	query := builder.From(goquTable(schema, table)).Select(goqu.COUNT("*"))
+	if whereClause != nil && *whereClause != "" {
+		query = query.Where(goqu.L(*whereClause))
+	}
+	sql, _, err := query.ToSQL()
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = s.pool.QueryRowContext(ctx, sql).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (s *SpannerManager) GetColumnDistinctCount(
+	ctx context.Context,
+	schema, table, column string,
+) (int64, error) {
+	sql, err := buildColumnDistinctCountQuery(SpannerDriver, schema, table, column)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = s.pool.QueryRowContext(ctx, sql).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// Spanner does not have materialized views
+func (s *SpannerManager) GetMaterializedViews(ctx context.Context) ([]*MaterializedView, error) {
+	return []*MaterializedView{}, nil
+}
+
+// Spanner does not have triggers
+func (s *SpannerManager) GetEnabledTableTriggers(ctx context.Context, tables []*SchemaTable) ([]*TableTrigger, error) {
+	return []*TableTrigger{}, nil
+}
+
+// Spanner does not keep row counts in its metadata, and counting the rows of every table would scan all of them
+func (s *SpannerManager) GetTableSizeEstimates(ctx context.Context, tables []*SchemaTable) ([]*TableSizeEstimate, error) {
+	return []*TableSizeEstimate{}, nil
+}
+
+// Spanner does not have extensions
+func (s *SpannerManager) GetExtensions(ctx context.Context) ([]*Extension, error) {
+	return []*Extension{}, nil
+}
+
+// Superuser rights are only needed to create postgres extensions
+func (s *SpannerManager) IsSuperuser(ctx context.Context) (bool, error) {
+	return false, nil
+}
+
+func (s *SpannerManager) GetNumericColumnSample(
+	ctx context.Context,
+	schema, table, column string,
+	limit uint,
+) ([]float64, error) {
+	sql, err := buildNumericColumnSampleQuery(SpannerDriver, schema, table, column, limit)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.pool.QueryContext(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	values := []float64{}
+	for rows.Next() {
+		var value float64
+		if err := rows.Scan(&value); err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, rows.Err()
+}
+
+func (s *SpannerManager) GetTableRowSample(
+	ctx context.Context,
+	schema, table string,
+	columns []string,
+	limit uint,
+) ([][]*string, error) {
+	sql, err := buildTableRowSampleQuery(SpannerDriver, schema, table, columns, limit)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.pool.QueryContext(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	output := [][]*string{}
+	for rows.Next() {
+		row := make([]*string, len(columns))
+		dest := make([]any, len(columns))
+		for idx := range row {
+			dest[idx] = &row[idx]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		output = append(output, row)
+	}
+	return output, rows.Err()
+}
+
+func (s *SpannerManager) GetEquivalenceClasses(
+	ctx context.Context,
+	schema, table string,
+	quasiIdentifiers []string,
+	sensitiveColumn *string,
+) ([]*EquivalenceClass, error) {
+	sql, err := buildEquivalenceClassQuery(SpannerDriver, schema, table, quasiIdentifiers, sensitiveColumn)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.pool.QueryContext(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	output := []*EquivalenceClass{}
+	for rows.Next() {
+		class := &EquivalenceClass{Values: make([]*string, len(quasiIdentifiers))}
+		dest := make([]any, 0, len(quasiIdentifiers)+2)
+		for idx := range class.Values {
+			dest = append(dest, &class.Values[idx])
+		}
+		dest = append(dest, &class.Size)
+		if sensitiveColumn != nil {
+			dest = append(dest, &class.DistinctSensitiveValues)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		output = append(output, class)
+	}
+	return output, rows.Err()
+}
+
+func (s *SpannerManager) GetForeignKeyOrphanCount(
+	ctx context.Context,
+	schema, table string,
+	constraint *ForeignConstraint,
+) (int64, error) {
+	sql, err := buildForeignKeyOrphanCountQuery(SpannerDriver, schema, table, constraint)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = s.pool.QueryRowContext(ctx, sql).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (s *SpannerManager) Exec(ctx context.Context, statement string) error {
+	_, err := s.pool.ExecContext(ctx, statement)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *SpannerManager) Close() {
+	if s.pool != nil && s.close != nil {
+		s.close()
+	}
+}
@@ -0,0 +1,102 @@
+package sqlmanager
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SpannerManager_GetDatabaseSchema(t *testing.T) {
+	db, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+	manager := &SpannerManager{pool: db}
+
+	sqlMock.ExpectQuery(regexp.QuoteMeta(getSpannerDatabaseSchemaQuery)).WillReturnRows(
+		sqlmock.NewRows([]string{"table_schema", "table_name", "column_name", "spanner_type", "column_default", "is_nullable", "ordinal_position", "is_generated"}).
+			AddRow("", "Singers", "SingerId", "INT64", "", "NO", 1, "NEVER").
+			AddRow("", "Singers", "FirstName", "STRING(1024)", "", "YES", 2, "NEVER").
+			AddRow("", "Singers", "FullName", "STRING(MAX)", "", "YES", 3, "ALWAYS"),
+	)
+
+	rows, err := manager.GetDatabaseSchema(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []*DatabaseSchemaRow{
+		{TableName: "Singers", ColumnName: "SingerId", DataType: "INT64", IsNullable: "NO", CharacterMaximumLength: -1, NumericPrecision: -1, NumericScale: -1, OrdinalPosition: 1, TableType: BaseTableType},
+		{TableName: "Singers", ColumnName: "FirstName", DataType: "STRING(1024)", IsNullable: "YES", CharacterMaximumLength: 1024, NumericPrecision: -1, NumericScale: -1, OrdinalPosition: 2, TableType: BaseTableType},
+		{TableName: "Singers", ColumnName: "FullName", DataType: "STRING(MAX)", IsNullable: "YES", CharacterMaximumLength: -1, NumericPrecision: -1, NumericScale: -1, OrdinalPosition: 3, GeneratedType: Ptr("ALWAYS"), TableType: BaseTableType},
+	}, rows)
+	require.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func Test_SpannerManager_GetForeignKeyConstraintsMap_Interleaved(t *testing.T) {
+	db, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+	manager := &SpannerManager{pool: db}
+
+	sqlMock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(getSpannerTableConstraintsQuery, "?"))).WithArgs("").WillReturnRows(
+		sqlmock.NewRows([]string{"constraint_type", "table_schema", "table_name", "constraint_name", "column_name", "is_nullable", "foreign_schema", "foreign_table", "foreign_column"}).
+			AddRow("PRIMARY KEY", "", "Albums", "PK_Albums", "SingerId", "NO", "", "", "").
+			AddRow("PRIMARY KEY", "", "Albums", "PK_Albums", "AlbumId", "NO", "", "", "").
+			AddRow("FOREIGN KEY", "", "Concerts", "FK_Concerts_Venues", "VenueId", "YES", "", "Venues", "VenueId").
+			AddRow("PRIMARY KEY", "", "Concerts", "PK_Concerts", "ConcertId", "NO", "", "", "").
+			AddRow("PRIMARY KEY", "", "Singers", "PK_Singers", "SingerId", "NO", "", "", "").
+			AddRow("PRIMARY KEY", "", "Venues", "PK_Venues", "VenueId", "NO", "", "", ""),
+	)
+	sqlMock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(getSpannerInterleavedTablesQuery, "?"))).WithArgs("").WillReturnRows(
+		sqlmock.NewRows([]string{"table_schema", "table_name", "parent_table_name", "on_delete_action"}).
+			AddRow("", "Albums", "Singers", "CASCADE"),
+	)
+
+	constraints, err := manager.GetForeignKeyConstraintsMap(context.Background(), []string{""})
+	require.NoError(t, err)
+	require.Equal(t, map[string][]*ForeignConstraint{
+		"Albums": {
+			{Columns: []string{"SingerId"}, NotNullable: []bool{true}, ForeignKey: &ForeignKey{Table: "Singers", Columns: []string{"SingerId"}}},
+		},
+		"Concerts": {
+			{Columns: []string{"VenueId"}, NotNullable: []bool{false}, ForeignKey: &ForeignKey{Table: "Venues", Columns: []string{"VenueId"}}},
+		},
+	}, constraints)
+	require.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func Test_addSpannerInterleaveConstraints_MissingParentKey(t *testing.T) {
+	_, err := addSpannerInterleaveConstraints(
+		[]*informationSchemaConstraint{},
+		[]*spannerInterleavedTable{{Table: "Albums", ParentTable: "Singers", OnDeleteAction: "CASCADE"}},
+	)
+	require.Error(t, err)
+}
+
+func Test_buildSpannerCreateTableStatement(t *testing.T) {
+	columns := map[string]*ColumnInfo{
+		"AlbumId":   {OrdinalPosition: 2, DataType: "INT64"},
+		"SingerId":  {OrdinalPosition: 1, DataType: "INT64"},
+		"Title":     {OrdinalPosition: 3, DataType: "STRING(MAX)", IsNullable: true},
+		"CreatedAt": {OrdinalPosition: 4, DataType: "TIMESTAMP", ColumnDefault: "CURRENT_TIMESTAMP()"},
+	}
+	require.Equal(
+		t,
+		"CREATE TABLE IF NOT EXISTS `Albums` (\n  `SingerId` INT64 NOT NULL,\n  `AlbumId` INT64 NOT NULL,\n  `Title` STRING(MAX),\n  `CreatedAt` TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP())\n) PRIMARY KEY (`SingerId`, `AlbumId`),\n  INTERLEAVE IN PARENT `Singers` ON DELETE CASCADE",
+		buildSpannerCreateTableStatement("", "Albums", columns, []string{"SingerId", "AlbumId"}, &spannerInterleavedTable{Table: "Albums", ParentTable: "Singers", OnDeleteAction: "CASCADE"}),
+	)
+	require.Equal(
+		t,
+		"CREATE TABLE IF NOT EXISTS `music`.`Singers` (\n  `SingerId` INT64 NOT NULL\n) PRIMARY KEY (`SingerId`)",
+		buildSpannerCreateTableStatement("music", "Singers", map[string]*ColumnInfo{"SingerId": {OrdinalPosition: 1, DataType: "INT64"}}, []string{"SingerId"}, nil),
+	)
+}
+
+func Test_buildSpannerQueries(t *testing.T) {
+	sql, err := buildColumnDistinctCountQuery(SpannerDriver, "", "Singers", "FirstName")
+	require.NoError(t, err)
+	require.Equal(t, "SELECT COUNT(DISTINCT(`FirstName`)) FROM `Singers`", sql)
+
+	sql, err = buildTableRowSampleQuery(SpannerDriver, "", "Singers", []string{"SingerId"}, 10)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT CAST(`SingerId` AS STRING) AS `SingerId` FROM `Singers` LIMIT 10", sql)
+}
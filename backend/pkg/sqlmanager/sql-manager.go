@@ -15,17 +15,103 @@ import (
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
 
 	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/googleapis/go-sql-spanner"
+	_ "github.com/lib/pq"
+	_ "github.com/marcboeker/go-duckdb"
+	_ "github.com/microsoft/go-mssqldb"
+	_ "github.com/snowflakedb/gosnowflake"
+	_ "modernc.org/sqlite"
 )
 
 const (
-	PostgresDriver = "postgres"
-	MysqlDriver    = "mysql"
+	PostgresDriver  = "postgres"
+	MysqlDriver     = "mysql"
+	SqliteDriver    = "sqlite"
+	MssqlDriver     = "sqlserver" // the name that both the go-mssqldb driver and the goqu dialect are registered under
+	SnowflakeDriver = "snowflake"
+	RedshiftDriver  = "redshift"
+	DuckdbDriver    = "duckdb"
+	SpannerDriver   = "spanner"
+)
+
+const (
+	BaseTableType = "BASE TABLE"
+	// Tables that are backed by another database, such as postgres foreign data wrappers
+	ForeignTableType = "FOREIGN"
+	// Tables that are backed by a user provided query instead of a table in the database
+	VirtualTableType = "VIRTUAL"
+	// Views and materialized views, which are only synced when they are materialized into tables at the destination
+	ViewTableType = "VIEW"
 )
 
 type BatchExecOpts struct {
 	Prefix *string // this string will be added to the start of each statement
 }
 
+type TableInitStatementOpts struct {
+	// Creates tables under a different schema or name than they have in the source. Keyed by the source schema.table
+	DestinationTables map[string]*SchemaTable
+	// Creates columns under a different name or data type than they have in the source, or leaves them out entirely.
+	// Keyed by the source schema.table and then column
+	DestinationColumns map[string]map[string]*DestinationColumn
+}
+
+type DestinationColumn struct {
+	Name     string // keeps the source column name when empty
+	DataType string // keeps the source data type when empty
+	Exclude  bool   // leaves the column, and any constraints or indices that reference it, out of the destination table
+}
+
+func (o *TableInitStatementOpts) getDestinationTable(schema, table string) *SchemaTable {
+	if o != nil {
+		if dest, ok := o.DestinationTables[BuildTable(schema, table)]; ok {
+			return dest
+		}
+	}
+	return &SchemaTable{Schema: schema, Table: table}
+}
+
+func (o *TableInitStatementOpts) getDestinationColumn(schema, table, column string) *DestinationColumn {
+	if o != nil {
+		if dest, ok := o.DestinationColumns[BuildTable(schema, table)][column]; ok {
+			output := *dest
+			if output.Name == "" {
+				output.Name = column
+			}
+			return &output
+		}
+	}
+	return &DestinationColumn{Name: column}
+}
+
+// Returns the source name of every column of the table that is left out of the destination
+func (o *TableInitStatementOpts) getExcludedColumns(schema, table string) map[string]struct{} {
+	excluded := map[string]struct{}{}
+	if o == nil {
+		return excluded
+	}
+	for column, dest := range o.DestinationColumns[BuildTable(schema, table)] {
+		if dest.Exclude {
+			excluded[column] = struct{}{}
+		}
+	}
+	return excluded
+}
+
+// Returns the source to destination name of every renamed column of the table
+func (o *TableInitStatementOpts) getColumnRenames(schema, table string) map[string]string {
+	renames := map[string]string{}
+	if o == nil {
+		return renames
+	}
+	for column, dest := range o.DestinationColumns[BuildTable(schema, table)] {
+		if dest.Name != "" && dest.Name != column {
+			renames[column] = dest.Name
+		}
+	}
+	return renames
+}
+
 type ForeignKey struct {
 	Table   string
 	Columns []string
@@ -42,6 +128,23 @@ type TableConstraints struct {
 	UniqueConstraints     map[string][][]string
 }
 
+// A unique index with a WHERE clause. Uniqueness is only enforced for the rows that match the predicate.
+type PartialUniqueIndex struct {
+	Name      string
+	Columns   []string
+	Predicate string
+}
+
+// Guarantees that no two rows compare true for every column and operator pair, e.g. EXCLUDE USING gist (room WITH =, during WITH &&)
+type ExclusionConstraint struct {
+	Name string
+	// Only contains the plain columns of the constraint, expression elements are left out
+	Columns []string
+	// The operator that each column is compared with, in the same order as Columns
+	Operators  []string
+	Definition string
+}
+
 type ColumnInfo struct {
 	OrdinalPosition        int32  // Specifies the sequence or order in which each column is defined within the table. Starts at 1 for the first column.
 	ColumnDefault          string // Specifies the default value for a column, if any is set.
@@ -50,21 +153,73 @@ type ColumnInfo struct {
 	CharacterMaximumLength *int32 // Specifies the maximum allowable length of the column for character-based data types. For datatypes such as integers, boolean, dates etc. this is NULL.
 	NumericPrecision       *int32 // Specifies the precision for numeric data types. It represents the TOTAL count of significant digits in the whole number, that is, the number of digits to BOTH sides of the decimal point. Null for non-numeric data types.
 	NumericScale           *int32 // Specifies the scale of the column for numeric data types, specifically non-integers. It represents the number of digits to the RIGHT of the decimal point. Null for non-numeric data types and integers.
+	TableType              string // Specifies the type of table the column belongs to, i.e., BASE TABLE or FOREIGN.
+	BaseDataType           string // Specifies the data type that the column's domain is based on. Empty if the data type is not a domain.
+}
+
+// A group of rows that share the same quasi-identifier values
+type EquivalenceClass struct {
+	Values []*string
+	Size   int64
+	// The number of distinct values of the sensitive column within the class. Only set if a sensitive column was provided.
+	DistinctSensitiveValues *int64
+}
+
+type MaterializedView struct {
+	Schema      string
+	Name        string
+	IsPopulated bool
+	// Materialized views can only be refreshed concurrently if they have a unique index on plain columns
+	HasUniqueIndex bool
+}
+
+type TableTrigger struct {
+	Schema string
+	Table  string
+	Name   string
+}
+
+// Row counts and sizes are read from the database catalog so they are cheap to retrieve but may lag behind the true values
+type TableSizeEstimate struct {
+	Schema    string
+	Table     string
+	RowCount  int64
+	SizeBytes int64
+}
+
+type Extension struct {
+	Name    string
+	Schema  string
+	Version string
 }
 
 type SqlDatabase interface {
 	GetDatabaseSchema(ctx context.Context) ([]*DatabaseSchemaRow, error)
 	GetSchemaColumnMap(ctx context.Context) (map[string]map[string]*ColumnInfo, error) // ex: {public.users: { id: struct{}{}, created_at: struct{}{}}}
+	GetViewColumnMap(ctx context.Context) (map[string]map[string]*ColumnInfo, error)
 	GetTableConstraintsBySchema(ctx context.Context, schemas []string) (*TableConstraints, error)
 	GetForeignKeyConstraints(ctx context.Context, schemas []string) ([]*ForeignKeyConstraintsRow, error)
 	GetForeignKeyConstraintsMap(ctx context.Context, schemas []string) (map[string][]*ForeignConstraint, error)
 	GetPrimaryKeyConstraints(ctx context.Context, schemas []string) ([]*PrimaryKey, error)
 	GetPrimaryKeyConstraintsMap(ctx context.Context, schemas []string) (map[string][]string, error)
 	GetUniqueConstraintsMap(ctx context.Context, schemas []string) (map[string][][]string, error)
+	GetPartialUniqueIndexesMap(ctx context.Context, schemas []string) (map[string][]*PartialUniqueIndex, error)
+	GetExclusionConstraintsMap(ctx context.Context, schemas []string) (map[string][]*ExclusionConstraint, error)
 	GetCreateTableStatement(ctx context.Context, schema, table string) (string, error)
-	GetTableInitStatements(ctx context.Context, tables []*SchemaTable) ([]*TableInitStatement, error)
+	GetTableInitStatements(ctx context.Context, tables []*SchemaTable, opts *TableInitStatementOpts) ([]*TableInitStatement, error)
 	GetRolePermissionsMap(ctx context.Context, role string) (map[string][]string, error)
+	GetQueryColumns(ctx context.Context, query string) (map[string]*ColumnInfo, error)
 	GetTableRowCount(ctx context.Context, schema, table string, whereClause *string) (int64, error)
+	GetColumnDistinctCount(ctx context.Context, schema, table, column string) (int64, error)
+	GetNumericColumnSample(ctx context.Context, schema, table, column string, limit uint) ([]float64, error)
+	GetTableRowSample(ctx context.Context, schema, table string, columns []string, limit uint) ([][]*string, error)
+	GetEquivalenceClasses(ctx context.Context, schema, table string, quasiIdentifiers []string, sensitiveColumn *string) ([]*EquivalenceClass, error)
+	GetForeignKeyOrphanCount(ctx context.Context, schema, table string, constraint *ForeignConstraint) (int64, error)
+	GetMaterializedViews(ctx context.Context) ([]*MaterializedView, error)
+	GetEnabledTableTriggers(ctx context.Context, tables []*SchemaTable) ([]*TableTrigger, error)
+	GetTableSizeEstimates(ctx context.Context, tables []*SchemaTable) ([]*TableSizeEstimate, error)
+	GetExtensions(ctx context.Context) ([]*Extension, error)
+	IsSuperuser(ctx context.Context) (bool, error)
 	BatchExec(ctx context.Context, batchSize int, statements []string, opts *BatchExecOpts) error
 	Exec(ctx context.Context, statement string) error
 	Close()
@@ -139,6 +294,8 @@ type DatabaseSchemaRow struct {
 	NumericScale           int32
 	OrdinalPosition        int16
 	GeneratedType          *string
+	TableType              string
+	BaseDataType           string
 }
 
 type ForeignKeyConstraintsRow struct {
@@ -197,7 +354,7 @@ func (s *SqlManager) NewPooledSqlDb(
 			return nil, fmt.Errorf("pool found, but type assertion to pg_queries.DBTX failed")
 		}
 		adapter.pool = pool
-		db = adapter
+		db = newPostgresDatabase(adapter, connection.ConnectionConfig.GetPgConfig())
 		driver = PostgresDriver
 	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
 		adapter := &MysqlManager{
@@ -229,8 +386,67 @@ func (s *SqlManager) NewPooledSqlDb(
 			return nil, fmt.Errorf("pool found, but type assertion to mysql_queries.DBTX failed")
 		}
 		adapter.pool = pool
-		db = adapter
+		db = newMysqlDatabase(adapter, connection.ConnectionConfig.GetMysqlConfig())
 		driver = MysqlDriver
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		// opening a database file is cheap so sqlite connections are not pooled
+		adapter, err := s.newSqliteManager(slogger, connection.ConnectionConfig, Ptr(uint32(5)))
+		if err != nil {
+			return nil, err
+		}
+		db = adapter
+		driver = SqliteDriver
+	case *mgmtv1alpha1.ConnectionConfig_SnowflakeConfig:
+		adapter, err := s.newSnowflakeManager(slogger, connection.ConnectionConfig, Ptr(uint32(5)))
+		if err != nil {
+			return nil, err
+		}
+		db = adapter
+		driver = SnowflakeDriver
+	case *mgmtv1alpha1.ConnectionConfig_RedshiftConfig:
+		adapter, err := s.newRedshiftManager(slogger, connection.ConnectionConfig, Ptr(uint32(5)))
+		if err != nil {
+			return nil, err
+		}
+		db = adapter
+		driver = RedshiftDriver
+	case *mgmtv1alpha1.ConnectionConfig_DuckdbConfig:
+		// duckdb runs in process so its connections are not pooled
+		adapter, err := s.newDuckdbManager(ctx, slogger, connection.ConnectionConfig, Ptr(uint32(5)))
+		if err != nil {
+			return nil, err
+		}
+		db = adapter
+		driver = DuckdbDriver
+	case *mgmtv1alpha1.ConnectionConfig_SpannerConfig:
+		// the spanner driver keeps its own pool of sessions so connections are not pooled
+		adapter, err := s.newSpannerManager(slogger, connection.ConnectionConfig, Ptr(uint32(5)))
+		if err != nil {
+			return nil, err
+		}
+		db = adapter
+		driver = SpannerDriver
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		adapter := &MssqlManager{}
+		conn, err := s.sqlconnector.NewDbFromConnectionConfig(connection.ConnectionConfig, Ptr(uint32(5)), slogger)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create new mssql pool from connection config: %w", err)
+		}
+		pool, err := conn.Open()
+		if err != nil {
+			return nil, fmt.Errorf("unable to open mssql connection: %w", err)
+		}
+		adapter.close = func() {
+			if conn != nil {
+				err := conn.Close()
+				if err != nil {
+					slogger.Error(fmt.Errorf("failed to close connection: %w", err).Error())
+				}
+			}
+		}
+		adapter.pool = pool
+		db = adapter
+		driver = MssqlDriver
 	default:
 		return nil, errors.New("unsupported sql database connection: %s")
 	}
@@ -287,7 +503,7 @@ func (s *SqlManager) NewSqlDbFromConnectionConfig(
 			}
 		}
 		adapter.pool = pool
-		db = adapter
+		db = newPostgresDatabase(adapter, pgconfig)
 		driver = PostgresDriver
 	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
 		adapter := &MysqlManager{
@@ -310,8 +526,64 @@ func (s *SqlManager) NewSqlDbFromConnectionConfig(
 			}
 		}
 		adapter.pool = pool
-		db = adapter
+		db = newMysqlDatabase(adapter, connectionConfig.GetMysqlConfig())
 		driver = MysqlDriver
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		adapter, err := s.newSqliteManager(slogger, connectionConfig, connTimeout)
+		if err != nil {
+			return nil, err
+		}
+		db = adapter
+		driver = SqliteDriver
+	case *mgmtv1alpha1.ConnectionConfig_SnowflakeConfig:
+		adapter, err := s.newSnowflakeManager(slogger, connectionConfig, connTimeout)
+		if err != nil {
+			return nil, err
+		}
+		db = adapter
+		driver = SnowflakeDriver
+	case *mgmtv1alpha1.ConnectionConfig_RedshiftConfig:
+		adapter, err := s.newRedshiftManager(slogger, connectionConfig, connTimeout)
+		if err != nil {
+			return nil, err
+		}
+		db = adapter
+		driver = RedshiftDriver
+	case *mgmtv1alpha1.ConnectionConfig_DuckdbConfig:
+		adapter, err := s.newDuckdbManager(ctx, slogger, connectionConfig, connTimeout)
+		if err != nil {
+			return nil, err
+		}
+		db = adapter
+		driver = DuckdbDriver
+	case *mgmtv1alpha1.ConnectionConfig_SpannerConfig:
+		adapter, err := s.newSpannerManager(slogger, connectionConfig, connTimeout)
+		if err != nil {
+			return nil, err
+		}
+		db = adapter
+		driver = SpannerDriver
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		adapter := &MssqlManager{}
+		conn, err := s.sqlconnector.NewDbFromConnectionConfig(connectionConfig, connTimeout, slogger)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create new mssql pool from connection config: %w", err)
+		}
+		pool, err := conn.Open()
+		if err != nil {
+			return nil, fmt.Errorf("unable to open mssql connection: %w", err)
+		}
+		adapter.close = func() {
+			if conn != nil {
+				err := conn.Close()
+				if err != nil {
+					slogger.Error(fmt.Errorf("failed to close connection: %w", err).Error())
+				}
+			}
+		}
+		adapter.pool = pool
+		db = adapter
+		driver = MssqlDriver
 	default:
 		return nil, errors.New("unsupported sql database connection: %s")
 	}
@@ -322,6 +594,165 @@ func (s *SqlManager) NewSqlDbFromConnectionConfig(
 	}, nil
 }
 
+func (s *SqlManager) newSqliteManager(
+	slogger *slog.Logger,
+	connectionConfig *mgmtv1alpha1.ConnectionConfig,
+	connectionTimeout *uint32,
+) (*SqliteManager, error) {
+	conn, err := s.sqlconnector.NewDbFromConnectionConfig(connectionConfig, connectionTimeout, slogger)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create new sqlite db from connection config: %w", err)
+	}
+	pool, err := conn.Open()
+	if err != nil {
+		return nil, fmt.Errorf("unable to open sqlite connection: %w", err)
+	}
+	return &SqliteManager{
+		pool: pool,
+		close: func() {
+			if conn != nil {
+				err := conn.Close()
+				if err != nil {
+					slogger.Error(fmt.Errorf("failed to close connection: %w", err).Error())
+				}
+			}
+		},
+	}, nil
+}
+
+func (s *SqlManager) newSnowflakeManager(
+	slogger *slog.Logger,
+	connectionConfig *mgmtv1alpha1.ConnectionConfig,
+	connectionTimeout *uint32,
+) (*SnowflakeManager, error) {
+	conn, err := s.sqlconnector.NewDbFromConnectionConfig(connectionConfig, connectionTimeout, slogger)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create new snowflake db from connection config: %w", err)
+	}
+	pool, err := conn.Open()
+	if err != nil {
+		return nil, fmt.Errorf("unable to open snowflake connection: %w", err)
+	}
+	return &SnowflakeManager{
+		pool: pool,
+		close: func() {
+			if conn != nil {
+				err := conn.Close()
+				if err != nil {
+					slogger.Error(fmt.Errorf("failed to close connection: %w", err).Error())
+				}
+			}
+		},
+	}, nil
+}
+
+func (s *SqlManager) newRedshiftManager(
+	slogger *slog.Logger,
+	connectionConfig *mgmtv1alpha1.ConnectionConfig,
+	connectionTimeout *uint32,
+) (*RedshiftManager, error) {
+	conn, err := s.sqlconnector.NewDbFromConnectionConfig(connectionConfig, connectionTimeout, slogger)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create new redshift db from connection config: %w", err)
+	}
+	pool, err := conn.Open()
+	if err != nil {
+		return nil, fmt.Errorf("unable to open redshift connection: %w", err)
+	}
+	return &RedshiftManager{
+		pool: pool,
+		close: func() {
+			if conn != nil {
+				err := conn.Close()
+				if err != nil {
+					slogger.Error(fmt.Errorf("failed to close connection: %w", err).Error())
+				}
+			}
+		},
+	}, nil
+}
+
+func (s *SqlManager) newSpannerManager(
+	slogger *slog.Logger,
+	connectionConfig *mgmtv1alpha1.ConnectionConfig,
+	connectionTimeout *uint32,
+) (*SpannerManager, error) {
+	conn, err := s.sqlconnector.NewDbFromConnectionConfig(connectionConfig, connectionTimeout, slogger)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create new spanner db from connection config: %w", err)
+	}
+	pool, err := conn.Open()
+	if err != nil {
+		return nil, fmt.Errorf("unable to open spanner connection: %w", err)
+	}
+	return &SpannerManager{
+		pool: pool,
+		close: func() {
+			if conn != nil {
+				err := conn.Close()
+				if err != nil {
+					slogger.Error(fmt.Errorf("failed to close connection: %w", err).Error())
+				}
+			}
+		},
+	}, nil
+}
+
+// The views over the parquet directory are created each time the database is opened so that they pick up
+// any tables that were added since
+func (s *SqlManager) newDuckdbManager(
+	ctx context.Context,
+	slogger *slog.Logger,
+	connectionConfig *mgmtv1alpha1.ConnectionConfig,
+	connectionTimeout *uint32,
+) (*DuckdbManager, error) {
+	conn, err := s.sqlconnector.NewDbFromConnectionConfig(connectionConfig, connectionTimeout, slogger)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create new duckdb db from connection config: %w", err)
+	}
+	pool, err := conn.Open()
+	if err != nil {
+		return nil, fmt.Errorf("unable to open duckdb connection: %w", err)
+	}
+	closeConn := func() {
+		if conn != nil {
+			err := conn.Close()
+			if err != nil {
+				slogger.Error(fmt.Errorf("failed to close connection: %w", err).Error())
+			}
+		}
+	}
+	parquetTables := map[string]struct{}{}
+	if parquetDirectory := connectionConfig.GetDuckdbConfig().GetParquetDirectory(); parquetDirectory != "" {
+		parquetTables, err = CreateDuckdbParquetViews(ctx, pool, parquetDirectory)
+		if err != nil {
+			closeConn()
+			return nil, err
+		}
+	}
+	return &DuckdbManager{
+		pool:          pool,
+		close:         closeConn,
+		parquetTables: parquetTables,
+	}, nil
+}
+
+// CockroachDB connections are served by the postgres manager, with the queries that rely on postgres only catalog features swapped out
+func newPostgresDatabase(adapter *PostgresManager, pgconfig *mgmtv1alpha1.PostgresConnectionConfig) SqlDatabase {
+	if pgconfig.GetIsCockroachdb() {
+		return &CockroachManager{PostgresManager: adapter}
+	}
+	return adapter
+}
+
+// MariaDB connections are served by the mysql manager, with the queries that rely on MySQL specific behavior swapped out
+func newMysqlDatabase(adapter *MysqlManager, mysqlconfig *mgmtv1alpha1.MysqlConnectionConfig) SqlDatabase {
+	if mysqlconfig.GetIsMariadb() {
+		return &MariadbManager{MysqlManager: adapter}
+	}
+	return adapter
+}
+
 func (s *SqlManager) NewSqlDbFromUrl(
 	ctx context.Context,
 	driver, connectionUrl string,
@@ -360,6 +791,92 @@ func (s *SqlManager) NewSqlDbFromUrl(
 		adapter.pool = conn
 		db = adapter
 		driver = MysqlDriver
+	case SqliteDriver:
+		conn, err := sql.Open(SqliteDriver, connectionUrl)
+		if err != nil {
+			return nil, err
+		}
+		db = &SqliteManager{
+			pool: conn,
+			close: func() {
+				if conn != nil {
+					conn.Close()
+				}
+			},
+		}
+		driver = SqliteDriver
+	case SnowflakeDriver:
+		conn, err := sql.Open(SnowflakeDriver, connectionUrl)
+		if err != nil {
+			return nil, err
+		}
+		db = &SnowflakeManager{
+			pool: conn,
+			close: func() {
+				if conn != nil {
+					conn.Close()
+				}
+			},
+		}
+		driver = SnowflakeDriver
+	case RedshiftDriver:
+		// redshift connection urls use the postgres format
+		conn, err := sql.Open(PostgresDriver, connectionUrl)
+		if err != nil {
+			return nil, err
+		}
+		db = &RedshiftManager{
+			pool: conn,
+			close: func() {
+				if conn != nil {
+					conn.Close()
+				}
+			},
+		}
+		driver = RedshiftDriver
+	case DuckdbDriver:
+		conn, err := sql.Open(DuckdbDriver, connectionUrl)
+		if err != nil {
+			return nil, err
+		}
+		db = &DuckdbManager{
+			pool: conn,
+			close: func() {
+				if conn != nil {
+					conn.Close()
+				}
+			},
+			parquetTables: map[string]struct{}{},
+		}
+		driver = DuckdbDriver
+	case SpannerDriver:
+		conn, err := sql.Open(SpannerDriver, connectionUrl)
+		if err != nil {
+			return nil, err
+		}
+		db = &SpannerManager{
+			pool: conn,
+			close: func() {
+				if conn != nil {
+					conn.Close()
+				}
+			},
+		}
+		driver = SpannerDriver
+	case MssqlDriver:
+		adapter := &MssqlManager{}
+		conn, err := sql.Open(MssqlDriver, connectionUrl)
+		if err != nil {
+			return nil, err
+		}
+		adapter.close = func() {
+			if conn != nil {
+				conn.Close()
+			}
+		}
+		adapter.pool = conn
+		db = adapter
+		driver = MssqlDriver
 	default:
 		return nil, errors.New("unsupported sql database connection: %s")
 	}
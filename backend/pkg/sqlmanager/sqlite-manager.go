@@ -0,0 +1,804 @@
+package sqlmanager
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
+)
+
+const (
+	// Sqlite has a single schema per database file. Attached databases are not supported
+	SqliteSchema = "main"
+)
+
+type SqliteManager struct {
+	pool  sqlconnect.SqlDBTX
+	close func()
+}
+
+const getSqliteDatabaseSchemaQuery = `SELECT m.name, p.name, p.type, p."notnull", p.dflt_value, p.cid, p.hidden
+FROM sqlite_master AS m
+JOIN pragma_table_xinfo(m.name) AS p
+WHERE m.type = 'table' AND m.name NOT LIKE 'sqlite_%'
+ORDER BY m.name, p.cid`
+
+// pragma_table_xinfo marks generated columns as hidden, 2 for virtual and 3 for stored columns
+const (
+	sqliteHiddenVirtualGenerated = 2
+	sqliteHiddenStoredGenerated  = 3
+)
+
+func (s *SqliteManager) GetDatabaseSchema(ctx context.Context) ([]*DatabaseSchemaRow, error) {
+	rows, err := s.pool.QueryContext(ctx, getSqliteDatabaseSchemaQuery)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return []*DatabaseSchemaRow{}, nil
+	}
+	defer rows.Close()
+
+	result := []*DatabaseSchemaRow{}
+	for rows.Next() {
+		var table, column, dataType string
+		var notNull bool
+		var columnDefault sql.NullString
+		var cid, hidden int16
+		if err := rows.Scan(&table, &column, &dataType, &notNull, &columnDefault, &cid, &hidden); err != nil {
+			return nil, err
+		}
+		// hidden columns of virtual tables can not be selected
+		if hidden == 1 {
+			continue
+		}
+		var generatedType *string
+		if hidden == sqliteHiddenVirtualGenerated || hidden == sqliteHiddenStoredGenerated {
+			generatedType = Ptr("GENERATED")
+		}
+		isNullable := "YES"
+		if notNull {
+			isNullable = "NO"
+		}
+		result = append(result, &DatabaseSchemaRow{
+			TableSchema:     SqliteSchema,
+			TableName:       table,
+			ColumnName:      column,
+			DataType:        strings.ToLower(dataType),
+			ColumnDefault:   columnDefault.String,
+			IsNullable:      isNullable,
+			OrdinalPosition: cid + 1,
+			GeneratedType:   generatedType,
+			TableType:       BaseTableType,
+		})
+	}
+	return result, rows.Err()
+}
+
+// returns: {main.users: { id: struct{}{}, created_at: struct{}{}}}
+func (s *SqliteManager) GetSchemaColumnMap(ctx context.Context) (map[string]map[string]*ColumnInfo, error) {
+	dbSchemas, err := s.GetDatabaseSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := GetUniqueSchemaColMappings(dbSchemas)
+	return result, nil
+}
+
+const getSqliteViewColumnsQuery = `SELECT m.name, p.name, p.type, p."notnull", p.cid
+FROM sqlite_master AS m
+JOIN pragma_table_info(m.name) AS p
+WHERE m.type = 'view'
+ORDER BY m.name, p.cid`
+
+// Returns the columns of every view, keyed by schema.table and then column
+func (s *SqliteManager) GetViewColumnMap(ctx context.Context) (map[string]map[string]*ColumnInfo, error) {
+	rows, err := s.pool.QueryContext(ctx, getSqliteViewColumnsQuery)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return map[string]map[string]*ColumnInfo{}, nil
+	}
+	defer rows.Close()
+
+	output := map[string]map[string]*ColumnInfo{}
+	for rows.Next() {
+		var view, column, dataType string
+		var notNull bool
+		var cid int32
+		if err := rows.Scan(&view, &column, &dataType, &notNull, &cid); err != nil {
+			return nil, err
+		}
+		key := BuildTable(SqliteSchema, view)
+		if _, ok := output[key]; !ok {
+			output[key] = map[string]*ColumnInfo{}
+		}
+		output[key][column] = &ColumnInfo{
+			OrdinalPosition: cid + 1,
+			IsNullable:      !notNull,
+			DataType:        strings.ToLower(dataType),
+			TableType:       ViewTableType,
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+func (s *SqliteManager) GetTableConstraintsBySchema(ctx context.Context, schemas []string) (*TableConstraints, error) {
+	if len(schemas) == 0 {
+		return &TableConstraints{}, nil
+	}
+
+	foreignKeyMap, err := s.GetForeignKeyConstraintsMap(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+
+	primaryKeyMap, err := s.GetPrimaryKeyConstraintsMap(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+
+	uniqueConstraintsMap, err := s.GetUniqueConstraintsMap(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TableConstraints{
+		ForeignKeyConstraints: foreignKeyMap,
+		PrimaryKeyConstraints: primaryKeyMap,
+		UniqueConstraints:     uniqueConstraintsMap,
+	}, nil
+}
+
+// The referenced column is null when the foreign key references the primary key of the parent table without naming its columns
+const getSqliteForeignKeyConstraintsQuery = `SELECT m.name, f.id, f."from", COALESCE(c."notnull", 0), f."table", f."to"
+FROM sqlite_master AS m
+JOIN pragma_foreign_key_list(m.name) AS f
+LEFT JOIN pragma_table_info(m.name) AS c ON c.name = f."from"
+WHERE m.type = 'table' AND m.name NOT LIKE 'sqlite_%'
+ORDER BY m.name, f.id, f.seq`
+
+func (s *SqliteManager) GetForeignKeyConstraints(ctx context.Context, schemas []string) ([]*ForeignKeyConstraintsRow, error) {
+	if !hasSqliteSchema(schemas) {
+		return []*ForeignKeyConstraintsRow{}, nil
+	}
+	rows, err := s.pool.QueryContext(ctx, getSqliteForeignKeyConstraintsQuery)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return []*ForeignKeyConstraintsRow{}, nil
+	}
+	defer rows.Close()
+
+	type foreignKeyRow struct {
+		row *ForeignKeyConstraintsRow
+		to  sql.NullString
+	}
+	fkRows := []*foreignKeyRow{}
+	for rows.Next() {
+		var table, column, foreignTable string
+		var id int64
+		var notNull bool
+		var foreignColumn sql.NullString
+		if err := rows.Scan(&table, &id, &column, &notNull, &foreignTable, &foreignColumn); err != nil {
+			return nil, err
+		}
+		fkRows = append(fkRows, &foreignKeyRow{
+			row: &ForeignKeyConstraintsRow{
+				// sqlite does not return the names of foreign keys so they are named by their table and position instead
+				ConstraintName:    fmt.Sprintf("%s_fkey_%d", table, id),
+				SchemaName:        SqliteSchema,
+				TableName:         table,
+				ColumnName:        column,
+				IsNullable:        !notNull,
+				ForeignSchemaName: SqliteSchema,
+				ForeignTableName:  foreignTable,
+			},
+			to: foreignColumn,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var primaryKeyMap map[string][]string
+	result := make([]*ForeignKeyConstraintsRow, 0, len(fkRows))
+	seq := map[string]int{}
+	for _, fk := range fkRows {
+		position := seq[fk.row.ConstraintName]
+		seq[fk.row.ConstraintName]++
+		if fk.to.Valid {
+			fk.row.ForeignColumnName = fk.to.String
+		} else {
+			if primaryKeyMap == nil {
+				primaryKeyMap, err = s.GetPrimaryKeyConstraintsMap(ctx, schemas)
+				if err != nil {
+					return nil, err
+				}
+			}
+			pkCols := primaryKeyMap[BuildTable(SqliteSchema, fk.row.ForeignTableName)]
+			if position >= len(pkCols) {
+				return nil, fmt.Errorf("unable to resolve the column referenced by foreign key %s, %s has no matching primary key column", fk.row.ConstraintName, fk.row.ForeignTableName)
+			}
+			fk.row.ForeignColumnName = pkCols[position]
+		}
+		result = append(result, fk.row)
+	}
+	return result, nil
+}
+
+// Key is schema.table value is list of tables that key depends on
+func (s *SqliteManager) GetForeignKeyConstraintsMap(ctx context.Context, schemas []string) (map[string][]*ForeignConstraint, error) {
+	fkConstraints, err := s.GetForeignKeyConstraints(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	groupedFks := map[string][]*ForeignKeyConstraintsRow{} //  grouped by constraint name
+	constraintNames := []string{}
+	for _, row := range fkConstraints {
+		if _, ok := groupedFks[row.ConstraintName]; !ok {
+			constraintNames = append(constraintNames, row.ConstraintName)
+		}
+		groupedFks[row.ConstraintName] = append(groupedFks[row.ConstraintName], row)
+	}
+	constraints := map[string][]*ForeignConstraint{}
+	for _, name := range constraintNames {
+		fks := groupedFks[name]
+		cols := []string{}
+		notNullable := []bool{}
+		fkCols := []string{}
+		for _, fk := range fks {
+			cols = append(cols, fk.ColumnName)
+			notNullable = append(notNullable, !fk.IsNullable)
+			fkCols = append(fkCols, fk.ForeignColumnName)
+		}
+		row := fks[0]
+		tableName := BuildTable(row.SchemaName, row.TableName)
+		constraints[tableName] = append(constraints[tableName], &ForeignConstraint{
+			Columns:     cols,
+			NotNullable: notNullable,
+			ForeignKey: &ForeignKey{
+				Table:   BuildTable(row.ForeignSchemaName, row.ForeignTableName),
+				Columns: fkCols,
+			},
+		})
+	}
+	return constraints, nil
+}
+
+const getSqlitePrimaryKeyConstraintsQuery = `SELECT m.name, p.name
+FROM sqlite_master AS m
+JOIN pragma_table_info(m.name) AS p
+WHERE m.type = 'table' AND m.name NOT LIKE 'sqlite_%' AND p.pk > 0
+ORDER BY m.name, p.pk`
+
+func (s *SqliteManager) GetPrimaryKeyConstraints(ctx context.Context, schemas []string) ([]*PrimaryKey, error) {
+	if !hasSqliteSchema(schemas) {
+		return []*PrimaryKey{}, nil
+	}
+	rows, err := s.pool.QueryContext(ctx, getSqlitePrimaryKeyConstraintsQuery)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return []*PrimaryKey{}, nil
+	}
+	defer rows.Close()
+
+	result := []*PrimaryKey{}
+	tableIdx := map[string]int{}
+	for rows.Next() {
+		var table, column string
+		if err := rows.Scan(&table, &column); err != nil {
+			return nil, err
+		}
+		idx, ok := tableIdx[table]
+		if !ok {
+			idx = len(result)
+			tableIdx[table] = idx
+			result = append(result, &PrimaryKey{Schema: SqliteSchema, Table: table, Columns: []string{}})
+		}
+		result[idx].Columns = append(result[idx].Columns, column)
+	}
+	return result, rows.Err()
+}
+
+func (s *SqliteManager) GetPrimaryKeyConstraintsMap(ctx context.Context, schemas []string) (map[string][]string, error) {
+	primaryKeys, err := s.GetPrimaryKeyConstraints(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	result := map[string][]string{}
+	for _, row := range primaryKeys {
+		tableName := BuildTable(row.Schema, row.Table)
+		result[tableName] = append(result[tableName], row.Columns...)
+	}
+	return result, nil
+}
+
+// Unique constraints and unique indexes are both stored as indexes, the primary key index of WITHOUT ROWID tables is left out.
+// The column name is null for index elements that are expressions
+const getSqliteUniqueIndexesQuery = `SELECT m.name, il.name, il.partial, ii.name, COALESCE(im.sql, '')
+FROM sqlite_master AS m
+JOIN pragma_index_list(m.name) AS il
+JOIN pragma_index_info(il.name) AS ii
+LEFT JOIN sqlite_master AS im ON im.type = 'index' AND im.name = il.name
+WHERE m.type = 'table' AND m.name NOT LIKE 'sqlite_%' AND il."unique" = 1 AND il.origin != 'pk'
+ORDER BY m.name, il.name, ii.seqno`
+
+type sqliteUniqueIndex struct {
+	Table   string
+	Name    string
+	Columns []string
+	// Empty if the index covers every row
+	Predicate string
+	// Indexes with expression elements can not be represented as a list of columns
+	HasExpression bool
+}
+
+var sqliteIndexPredicateRegex = regexp.MustCompile(`(?is)\sWHERE\s+(.+?);?\s*$`)
+
+func (s *SqliteManager) getUniqueIndexes(ctx context.Context) ([]*sqliteUniqueIndex, error) {
+	rows, err := s.pool.QueryContext(ctx, getSqliteUniqueIndexesQuery)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return []*sqliteUniqueIndex{}, nil
+	}
+	defer rows.Close()
+
+	output := []*sqliteUniqueIndex{}
+	indexIdx := map[string]int{}
+	for rows.Next() {
+		var table, name, indexSql string
+		var partial bool
+		var column sql.NullString
+		if err := rows.Scan(&table, &name, &partial, &column, &indexSql); err != nil {
+			return nil, err
+		}
+		idx, ok := indexIdx[name]
+		if !ok {
+			idx = len(output)
+			indexIdx[name] = idx
+			index := &sqliteUniqueIndex{Table: table, Name: name, Columns: []string{}}
+			if partial {
+				index.Predicate = getSqliteIndexPredicate(indexSql)
+			}
+			output = append(output, index)
+		}
+		if !column.Valid {
+			output[idx].HasExpression = true
+			continue
+		}
+		output[idx].Columns = append(output[idx].Columns, column.String)
+	}
+	return output, rows.Err()
+}
+
+// Returns the WHERE clause of a CREATE INDEX statement, or an empty string if it has none
+func getSqliteIndexPredicate(indexSql string) string {
+	matches := sqliteIndexPredicateRegex.FindStringSubmatch(indexSql)
+	if matches == nil {
+		return ""
+	}
+	return strings.TrimSpace(matches[1])
+}
+
+func (s *SqliteManager) GetUniqueConstraintsMap(ctx context.Context, schemas []string) (map[string][][]string, error) {
+	if !hasSqliteSchema(schemas) {
+		return map[string][][]string{}, nil
+	}
+	indexes, err := s.getUniqueIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	output := map[string][][]string{}
+	for _, index := range indexes {
+		// partial indexes only enforce uniqueness for some rows and are returned by GetPartialUniqueIndexesMap
+		if index.HasExpression || index.Predicate != "" {
+			continue
+		}
+		tableName := BuildTable(SqliteSchema, index.Table)
+		output[tableName] = append(output[tableName], index.Columns)
+	}
+	return output, nil
+}
+
+func (s *SqliteManager) GetPartialUniqueIndexesMap(ctx context.Context, schemas []string) (map[string][]*PartialUniqueIndex, error) {
+	if !hasSqliteSchema(schemas) {
+		return map[string][]*PartialUniqueIndex{}, nil
+	}
+	indexes, err := s.getUniqueIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	output := map[string][]*PartialUniqueIndex{}
+	for _, index := range indexes {
+		if index.HasExpression || index.Predicate == "" {
+			continue
+		}
+		tableName := BuildTable(SqliteSchema, index.Table)
+		output[tableName] = append(output[tableName], &PartialUniqueIndex{
+			Name:      index.Name,
+			Columns:   index.Columns,
+			Predicate: index.Predicate,
+		})
+	}
+	return output, nil
+}
+
+// Sqlite does not support exclusion constraints
+func (s *SqliteManager) GetExclusionConstraintsMap(ctx context.Context, schemas []string) (map[string][]*ExclusionConstraint, error) {
+	return map[string][]*ExclusionConstraint{}, nil
+}
+
+const getSqliteTablesQuery = `SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%' ORDER BY name`
+
+// Sqlite has no roles, access is determined by the permissions of the database file so every table is returned with full privileges
+func (s *SqliteManager) GetRolePermissionsMap(ctx context.Context, role string) (map[string][]string, error) {
+	rows, err := s.pool.QueryContext(ctx, getSqliteTablesQuery)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return map[string][]string{}, nil
+	}
+	defer rows.Close()
+
+	schemaTablePrivsMap := map[string][]string{}
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			return nil, err
+		}
+		schemaTablePrivsMap[BuildTable(SqliteSchema, table)] = []string{"SELECT", "INSERT", "UPDATE", "DELETE"}
+	}
+	return schemaTablePrivsMap, rows.Err()
+}
+
+// Infers the columns of a virtual table by running its query without returning any rows
+func (s *SqliteManager) GetQueryColumns(ctx context.Context, query string) (map[string]*ColumnInfo, error) {
+	sql, err := BuildVirtualTableSelectQuery(SqliteDriver, query, nil)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.pool.QueryContext(ctx, fmt.Sprintf("%s LIMIT 0", sql))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	columns := map[string]*ColumnInfo{}
+	for idx, columnType := range columnTypes {
+		if _, ok := columns[columnType.Name()]; ok {
+			return nil, fmt.Errorf("query returns more than one column named %s", columnType.Name())
+		}
+		isNullable, ok := columnType.Nullable()
+		columns[columnType.Name()] = &ColumnInfo{
+			OrdinalPosition: int32(idx + 1),
+			IsNullable:      isNullable || !ok,
+			DataType:        strings.ToLower(columnType.DatabaseTypeName()),
+			TableType:       VirtualTableType,
+		}
+	}
+	return columns, rows.Err()
+}
+
+var sqliteCreateTableRegex = regexp.MustCompile(`(?i)^\s*CREATE\s+TABLE\s+(IF\s+NOT\s+EXISTS\s+)?`)
+
+// Sqlite stores the statement that created the table, which is returned so that it can be run as-is
+func (s *SqliteManager) GetCreateTableStatement(ctx context.Context, schema, table string) (string, error) {
+	var createSql string
+	err := s.pool.QueryRowContext(ctx, "SELECT sql FROM sqlite_master WHERE type = 'table' AND name = ?", table).Scan(&createSql)
+	if err != nil {
+		return "", fmt.Errorf("unable to get table create statement: %w", err)
+	}
+	return fmt.Sprintf("%s;", sqliteCreateTableRegex.ReplaceAllString(createSql, "CREATE TABLE IF NOT EXISTS ")), nil
+}
+
+var sqliteCreateIndexRegex = regexp.MustCompile(`(?i)^\s*CREATE\s+(UNIQUE\s+)?INDEX\s+(IF\s+NOT\s+EXISTS\s+)?`)
+
+// Destination table and column overrides are not supported as sqlite is not a sync destination
+func (s *SqliteManager) GetTableInitStatements(ctx context.Context, tables []*SchemaTable, opts *TableInitStatementOpts) ([]*TableInitStatement, error) {
+	if opts != nil && (len(opts.DestinationTables) > 0 || len(opts.DestinationColumns) > 0) {
+		return nil, fmt.Errorf("sqlite table init statements with destination overrides: %w", errors.ErrUnsupported)
+	}
+	output := []*TableInitStatement{}
+	for _, schematable := range tables {
+		createStmt, err := s.GetCreateTableStatement(ctx, schematable.Schema, schematable.Table)
+		if err != nil {
+			return nil, err
+		}
+		indexStmts, err := s.getIndexStatements(ctx, schematable.Table)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, &TableInitStatement{
+			CreateTableStatement: createStmt,
+			AlterTableStatements: []*AlterTableStatement{},
+			IndexStatements:      indexStmts,
+		})
+	}
+	return output, nil
+}
+
+// Returns the statements of the indexes that were explicitly created on the table.
+// Indexes that back unique and primary key constraints are part of the create table statement
+func (s *SqliteManager) getIndexStatements(ctx context.Context, table string) ([]string, error) {
+	rows, err := s.pool.QueryContext(ctx, "SELECT sql FROM sqlite_master WHERE type = 'index' AND tbl_name = ? AND sql IS NOT NULL ORDER BY name", table)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return []string{}, nil
+	}
+	defer rows.Close()
+
+	output := []string{}
+	for rows.Next() {
+		var indexSql string
+		if err := rows.Scan(&indexSql); err != nil {
+			return nil, err
+		}
+		output = append(output, fmt.Sprintf("%s;", sqliteCreateIndexRegex.ReplaceAllStringFunc(indexSql, func(match string) string {
+			if strings.Contains(strings.ToUpper(match), "UNIQUE") {
+				return "CREATE UNIQUE INDEX IF NOT EXISTS "
+			}
+			return "CREATE INDEX IF NOT EXISTS "
+		})))
+	}
+	return output, rows.Err()
+}
+
+func (s *SqliteManager) BatchExec(ctx context.Context, batchSize int, statements []string, opts *BatchExecOpts) error {
+	for i := 0; i < len(statements); i += batchSize {
+		end := i + batchSize
+		if end > len(statements) {
+			end = len(statements)
+		}
+
+		batchCmd := strings.Join(statements[i:end], "\n")
+		if opts != nil && opts.Prefix != nil && *opts.Prefix != "" {
+			batchCmd = fmt.Sprintf("%s %s", *opts.Prefix, batchCmd)
+		}
+		_, err := s.pool.ExecContext(ctx, batchCmd)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SqliteManager) GetTableRowCount(
+	ctx context.Context,
+	schema, table string,
+	whereClause *string,
+) (int64, error) {
+	tableName := BuildTable(schema, table)
+	builder := goqu.Dialect(SqliteDriver)
+	sqltable := goqu.I(tableName)
+
+	query := builder.From(sqltable).Select(goqu.COUNT("*"))
+	if whereClause != nil && *whereClause != "" {
+		query = query.Where(goqu.L(*whereClause))
+	}
+	sql, _, err := query.ToSQL()
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = s.pool.QueryRowContext(ctx, sql).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (s *SqliteManager) GetColumnDistinctCount(
+	ctx context.Context,
+	schema, table, column string,
+) (int64, error) {
+	sql, err := buildColumnDistinctCountQuery(SqliteDriver, schema, table, column)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = s.pool.QueryRowContext(ctx, sql).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// Sqlite does not support materialized views
+func (s *SqliteManager) GetMaterializedViews(ctx context.Context) ([]*MaterializedView, error) {
+	return []*MaterializedView{}, nil
+}
+
+// Sqlite does not support disabling triggers so there are never any triggers to manage
+func (s *SqliteManager) GetEnabledTableTriggers(ctx context.Context, tables []*SchemaTable) ([]*TableTrigger, error) {
+	return []*TableTrigger{}, nil
+}
+
+// Sqlite does not keep row count statistics so the rows are counted, which is exact and cheap enough for a local file.
+// The size is not available and is always zero
+func (s *SqliteManager) GetTableSizeEstimates(ctx context.Context, tables []*SchemaTable) ([]*TableSizeEstimate, error) {
+	estimates := []*TableSizeEstimate{}
+	for _, table := range tables {
+		count, err := s.GetTableRowCount(ctx, table.Schema, table.Table, nil)
+		if err != nil {
+			return nil, err
+		}
+		estimates = append(estimates, &TableSizeEstimate{
+			Schema:   table.Schema,
+			Table:    table.Table,
+			RowCount: count,
+		})
+	}
+	return estimates, nil
+}
+
+// Sqlite does not have extensions that are installed into the database
+func (s *SqliteManager) GetExtensions(ctx context.Context) ([]*Extension, error) {
+	return []*Extension{}, nil
+}
+
+// Superuser rights are only needed to create postgres extensions
+func (s *SqliteManager) IsSuperuser(ctx context.Context) (bool, error) {
+	return false, nil
+}
+
+func (s *SqliteManager) GetNumericColumnSample(
+	ctx context.Context,
+	schema, table, column string,
+	limit uint,
+) ([]float64, error) {
+	sql, err := buildNumericColumnSampleQuery(SqliteDriver, schema, table, column, limit)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.pool.QueryContext(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	values := []float64{}
+	for rows.Next() {
+		var value float64
+		if err := rows.Scan(&value); err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, rows.Err()
+}
+
+func (s *SqliteManager) GetTableRowSample(
+	ctx context.Context,
+	schema, table string,
+	columns []string,
+	limit uint,
+) ([][]*string, error) {
+	sql, err := buildTableRowSampleQuery(SqliteDriver, schema, table, columns, limit)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.pool.QueryContext(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	output := [][]*string{}
+	for rows.Next() {
+		row := make([]*string, len(columns))
+		dest := make([]any, len(columns))
+		for idx := range row {
+			dest[idx] = &row[idx]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		output = append(output, row)
+	}
+	return output, rows.Err()
+}
+
+func (s *SqliteManager) GetEquivalenceClasses(
+	ctx context.Context,
+	schema, table string,
+	quasiIdentifiers []string,
+	sensitiveColumn *string,
+) ([]*EquivalenceClass, error) {
+	sql, err := buildEquivalenceClassQuery(SqliteDriver, schema, table, quasiIdentifiers, sensitiveColumn)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.pool.QueryContext(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	output := []*EquivalenceClass{}
+	for rows.Next() {
+		class := &EquivalenceClass{Values: make([]*string, len(quasiIdentifiers))}
+		dest := make([]any, 0, len(quasiIdentifiers)+2)
+		for idx := range class.Values {
+			dest = append(dest, &class.Values[idx])
+		}
+		dest = append(dest, &class.Size)
+		if sensitiveColumn != nil {
+			dest = append(dest, &class.DistinctSensitiveValues)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		output = append(output, class)
+	}
+	return output, rows.Err()
+}
+
+func (s *SqliteManager) GetForeignKeyOrphanCount(
+	ctx context.Context,
+	schema, table string,
+	constraint *ForeignConstraint,
+) (int64, error) {
+	sql, err := buildForeignKeyOrphanCountQuery(SqliteDriver, schema, table, constraint)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = s.pool.QueryRowContext(ctx, sql).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (s *SqliteManager) Exec(ctx context.Context, statement string) error {
+	_, err := s.pool.ExecContext(ctx, statement)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *SqliteManager) Close() {
+	if s.pool != nil && s.close != nil {
+		s.close()
+	}
+}
+
+func hasSqliteSchema(schemas []string) bool {
+	for _, schema := range schemas {
+		if schema == SqliteSchema {
+			return true
+		}
+	}
+	return false
+}
+
+// Sqlite does not support TRUNCATE, a DELETE without a WHERE clause is optimized to drop all of the table's rows at once
+func BuildSqliteTruncateStatement(
+	schema string,
+	table string,
+) (string, error) {
+	builder := goqu.Dialect(SqliteDriver)
+	sqltable := goqu.S(schema).Table(table)
+	stmt, _, err := builder.Delete(sqltable).ToSQL()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s;", stmt), nil
+}
@@ -0,0 +1,129 @@
+package sqlmanager
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const sqliteTestSchema = `
+CREATE TABLE users (id INTEGER PRIMARY KEY, email VARCHAR(255) NOT NULL UNIQUE, name TEXT DEFAULT 'x', full_name TEXT GENERATED ALWAYS AS (name || '!') VIRTUAL);
+CREATE TABLE orgs (a INTEGER, b TEXT, PRIMARY KEY (a, b));
+CREATE TABLE posts (id INTEGER PRIMARY KEY, user_id INTEGER NOT NULL REFERENCES users, org_a INTEGER, org_b TEXT, title TEXT, FOREIGN KEY (org_a, org_b) REFERENCES orgs);
+CREATE UNIQUE INDEX posts_title_idx ON posts (title) WHERE title IS NOT NULL;
+CREATE UNIQUE INDEX posts_lower_idx ON posts (lower(title));
+CREATE INDEX posts_user_idx ON posts (user_id);
+CREATE VIEW user_emails AS SELECT id, email FROM users;
+`
+
+func newTestSqliteManager(t *testing.T) *SqliteManager {
+	t.Helper()
+	db, err := sql.Open(SqliteDriver, filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	_, err = db.Exec(sqliteTestSchema)
+	require.NoError(t, err)
+	return &SqliteManager{pool: db}
+}
+
+func Test_SqliteManager_GetDatabaseSchema(t *testing.T) {
+	manager := newTestSqliteManager(t)
+
+	rows, err := manager.GetDatabaseSchema(context.Background())
+	require.NoError(t, err)
+
+	users := []*DatabaseSchemaRow{}
+	for _, row := range rows {
+		if row.TableName == "users" {
+			users = append(users, row)
+		}
+	}
+	require.Equal(t, []*DatabaseSchemaRow{
+		{TableSchema: "main", TableName: "users", ColumnName: "id", DataType: "integer", IsNullable: "YES", OrdinalPosition: 1, TableType: BaseTableType},
+		{TableSchema: "main", TableName: "users", ColumnName: "email", DataType: "varchar(255)", IsNullable: "NO", OrdinalPosition: 2, TableType: BaseTableType},
+		{TableSchema: "main", TableName: "users", ColumnName: "name", DataType: "text", ColumnDefault: "'x'", IsNullable: "YES", OrdinalPosition: 3, TableType: BaseTableType},
+		{TableSchema: "main", TableName: "users", ColumnName: "full_name", DataType: "text", IsNullable: "YES", OrdinalPosition: 4, GeneratedType: Ptr("GENERATED"), TableType: BaseTableType},
+	}, users)
+
+	views, err := manager.GetViewColumnMap(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, map[string]map[string]*ColumnInfo{
+		"main.user_emails": {
+			"id":    {OrdinalPosition: 1, IsNullable: true, DataType: "integer", TableType: ViewTableType},
+			"email": {OrdinalPosition: 2, IsNullable: true, DataType: "varchar(255)", TableType: ViewTableType},
+		},
+	}, views)
+}
+
+func Test_SqliteManager_Constraints(t *testing.T) {
+	manager := newTestSqliteManager(t)
+	schemas := []string{"main"}
+
+	fks, err := manager.GetForeignKeyConstraintsMap(context.Background(), schemas)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []*ForeignConstraint{
+		{Columns: []string{"org_a", "org_b"}, NotNullable: []bool{false, false}, ForeignKey: &ForeignKey{Table: "main.orgs", Columns: []string{"a", "b"}}},
+		{Columns: []string{"user_id"}, NotNullable: []bool{true}, ForeignKey: &ForeignKey{Table: "main.users", Columns: []string{"id"}}},
+	}, fks["main.posts"])
+
+	pks, err := manager.GetPrimaryKeyConstraintsMap(context.Background(), schemas)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]string{
+		"main.orgs":  {"a", "b"},
+		"main.posts": {"id"},
+		"main.users": {"id"},
+	}, pks)
+
+	uniques, err := manager.GetUniqueConstraintsMap(context.Background(), schemas)
+	require.NoError(t, err)
+	require.Equal(t, map[string][][]string{
+		"main.users": {{"email"}},
+	}, uniques)
+
+	partials, err := manager.GetPartialUniqueIndexesMap(context.Background(), schemas)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]*PartialUniqueIndex{
+		"main.posts": {{Name: "posts_title_idx", Columns: []string{"title"}, Predicate: "title IS NOT NULL"}},
+	}, partials)
+
+	fks, err = manager.GetForeignKeyConstraintsMap(context.Background(), []string{"public"})
+	require.NoError(t, err)
+	require.Empty(t, fks)
+}
+
+func Test_SqliteManager_GetTableInitStatements(t *testing.T) {
+	manager := newTestSqliteManager(t)
+
+	stmt, err := manager.GetCreateTableStatement(context.Background(), "main", "orgs")
+	require.NoError(t, err)
+	require.Equal(t, "CREATE TABLE IF NOT EXISTS orgs (a INTEGER, b TEXT, PRIMARY KEY (a, b));", stmt)
+
+	stmts, err := manager.GetTableInitStatements(context.Background(), []*SchemaTable{{Schema: "main", Table: "posts"}}, nil)
+	require.NoError(t, err)
+	require.Len(t, stmts, 1)
+	require.Equal(t, []string{
+		"CREATE UNIQUE INDEX IF NOT EXISTS posts_lower_idx ON posts (lower(title));",
+		"CREATE UNIQUE INDEX IF NOT EXISTS posts_title_idx ON posts (title) WHERE title IS NOT NULL;",
+		"CREATE INDEX IF NOT EXISTS posts_user_idx ON posts (user_id);",
+	}, stmts[0].IndexStatements)
+
+	_, err = manager.GetTableInitStatements(context.Background(), []*SchemaTable{{Schema: "main", Table: "posts"}}, &TableInitStatementOpts{
+		DestinationTables: map[string]*SchemaTable{"main.posts": {Schema: "main", Table: "articles"}},
+	})
+	require.Error(t, err)
+}
+
+func Test_getSqliteIndexPredicate(t *testing.T) {
+	require.Equal(t, "title IS NOT NULL", getSqliteIndexPredicate("CREATE UNIQUE INDEX a ON posts (title) WHERE title IS NOT NULL"))
+	require.Equal(t, "a > 1 and b", getSqliteIndexPredicate("CREATE UNIQUE INDEX a ON t (a)\nwhere a > 1 and b;"))
+	require.Equal(t, "", getSqliteIndexPredicate("CREATE UNIQUE INDEX a ON t (a)"))
+}
+
+func Test_BuildSqliteTruncateStatement(t *testing.T) {
+	stmt, err := BuildSqliteTruncateStatement("main", "users")
+	require.NoError(t, err)
+	require.Equal(t, `DELETE FROM "main"."users";`, stmt)
+}
@@ -1,6 +1,15 @@
 package sqlmanager
 
-import "fmt"
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+)
 
 func GetUniqueSchemaColMappings(
 	schemas []*DatabaseSchemaRow,
@@ -28,6 +37,8 @@ func toColumnInfo(row *DatabaseSchemaRow) *ColumnInfo {
 		CharacterMaximumLength: Ptr(row.CharacterMaximumLength),
 		NumericPrecision:       Ptr(row.NumericPrecision),
 		NumericScale:           Ptr(row.NumericScale),
+		TableType:              row.TableType,
+		BaseDataType:           row.BaseDataType,
 	}
 }
 
@@ -46,6 +57,41 @@ func BuildTable(schema, table string) string {
 	return table
 }
 
+// Quotes an identifier for the given driver so that mixed-case names, reserved words and names with spaces are used as is.
+// Quote characters within the identifier are doubled
+func QuoteIdentifier(driver, identifier string) string {
+	quote := `"`
+	if driver == MysqlDriver || driver == SpannerDriver {
+		quote = "`"
+	}
+	return quote + strings.ReplaceAll(identifier, quote, quote+quote) + quote
+}
+
+func QuoteIdentifiers(driver string, identifiers []string) []string {
+	output := make([]string, len(identifiers))
+	for idx := range identifiers {
+		output[idx] = QuoteIdentifier(driver, identifiers[idx])
+	}
+	return output
+}
+
+// Quotes the schema and table for the given driver. The schema is left off when it is empty
+func QuoteTable(driver, schema, table string) string {
+	if schema == "" {
+		return QuoteIdentifier(driver, table)
+	}
+	return fmt.Sprintf("%s.%s", QuoteIdentifier(driver, schema), QuoteIdentifier(driver, table))
+}
+
+// Returns the goqu table for the schema and table.
+// Unlike goqu.I, the names are not split on dots
+func goquTable(schema, table string) exp.IdentifierExpression {
+	if schema == "" {
+		return goqu.T(table)
+	}
+	return goqu.S(schema).Table(table)
+}
+
 func dedupeSlice(input []string) []string {
 	set := map[string]any{}
 	for _, i := range input {
@@ -57,3 +103,320 @@ func dedupeSlice(input []string) []string {
 	}
 	return output
 }
+
+func buildColumnDistinctCountQuery(driver, schema, table, column string) (string, error) {
+	builder := goqu.Dialect(driver)
+	query := builder.From(goquTable(schema, table)).Select(goqu.COUNT(goqu.DISTINCT(goqu.C(column))))
+	sql, _, err := query.ToSQL()
+	if err != nil {
+		return "", err
+	}
+	return sql, nil
+}
+
+func buildNumericColumnSampleQuery(driver, schema, table, column string, limit uint) (string, error) {
+	builder := goqu.Dialect(driver)
+	var selectCol any = goqu.C(column)
+	if driver == PostgresDriver || driver == RedshiftDriver || driver == DuckdbDriver {
+		// numeric, decimal and money types are not scannable into a float without an explicit cast
+		selectCol = goqu.Cast(goqu.C(column), "DOUBLE PRECISION")
+	}
+	if driver == SpannerDriver {
+		// numeric columns are scanned into a big.Rat by the spanner driver
+		selectCol = goqu.Cast(goqu.C(column), "FLOAT64")
+	}
+	if driver == MssqlDriver {
+		// decimal and money columns are returned as text by the go-mssqldb driver
+		selectCol = goqu.Cast(goqu.I(column), "FLOAT")
+	}
+	query := builder.From(goquTable(schema, table)).
+		Select(selectCol).
+		Where(goqu.C(column).IsNotNull()).
+		Limit(limit)
+	sql, _, err := query.ToSQL()
+	if err != nil {
+		return "", err
+	}
+	return sql, nil
+}
+
+// Builds a query that selects the given columns from the result set of a virtual table's query.
+// Every column is selected when none are provided
+func BuildVirtualTableSelectQuery(driver, query string, columns []string) (string, error) {
+	query = strings.TrimSuffix(strings.TrimSpace(query), ";")
+	if query == "" {
+		return "", errors.New("must provide a query for the virtual table")
+	}
+	selectCols := "*"
+	if len(columns) > 0 {
+		selectCols = strings.Join(QuoteIdentifiers(driver, columns), ", ")
+	}
+	return fmt.Sprintf("SELECT %s FROM (%s) AS %s", selectCols, query, QuoteIdentifier(driver, "neosync_virtual_table")), nil
+}
+
+// Builds a create table statement from the columns of a relation that has no table definition of its own, such as a view.
+// Only the columns are created as there are no constraints or indices to carry over
+func BuildCreateTableFromColumns(driver, schema, table string, columns map[string]*ColumnInfo, opts *TableInitStatementOpts) string {
+	names := make([]string, 0, len(columns))
+	for name := range columns {
+		names = append(names, name)
+	}
+	slices.SortFunc(names, func(a, b string) int {
+		return cmp.Compare(columns[a].OrdinalPosition, columns[b].OrdinalPosition)
+	})
+
+	columnDefs := make([]string, 0, len(names))
+	for _, name := range names {
+		destColumn := opts.getDestinationColumn(schema, table, name)
+		if destColumn.Exclude {
+			continue
+		}
+		dataType := columns[name].DataType
+		if columns[name].BaseDataType != "" {
+			dataType = columns[name].BaseDataType
+		}
+		if destColumn.DataType != "" {
+			dataType = destColumn.DataType
+		}
+		columnDef := fmt.Sprintf("%s %s", QuoteIdentifier(driver, destColumn.Name), dataType)
+		if !columns[name].IsNullable {
+			columnDef = fmt.Sprintf("%s NOT NULL", columnDef)
+		}
+		columnDefs = append(columnDefs, columnDef)
+	}
+	dest := opts.getDestinationTable(schema, table)
+	if driver == MysqlDriver {
+		// laid out like the output of SHOW CREATE TABLE so that the mysql charset override can be applied
+		return fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (\n  %s\n);", QuoteTable(driver, dest.Schema, dest.Table), strings.Join(columnDefs, ",\n  "))
+	}
+	return fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s);", QuoteTable(driver, dest.Schema, dest.Table), strings.Join(columnDefs, ", "))
+}
+
+// Returns the type that any column can be cast to without losing its value
+func getTextCastType(driver string) string {
+	switch driver {
+	case MysqlDriver:
+		return "CHAR"
+	case RedshiftDriver:
+		// TEXT is an alias for VARCHAR(256) in redshift
+		return "VARCHAR(MAX)"
+	case SpannerDriver:
+		return "STRING"
+	case MssqlDriver:
+		// TEXT is a deprecated type that can not be compared or grouped by
+		return "NVARCHAR(MAX)"
+	default:
+		return "TEXT"
+	}
+}
+
+// Builds a query that samples rows from the table with every column cast to text so that values of any type can be scanned uniformly.
+func buildTableRowSampleQuery(driver, schema, table string, columns []string, limit uint) (string, error) {
+	if len(columns) == 0 {
+		return "", errors.New("must provide at least one column to sample")
+	}
+	textType := getTextCastType(driver)
+	builder := goqu.Dialect(driver)
+	selectCols := make([]any, 0, len(columns))
+	for _, col := range columns {
+		selectCols = append(selectCols, goqu.Cast(goqu.C(col), textType).As(col))
+	}
+	query := builder.From(goquTable(schema, table)).
+		Select(selectCols...).
+		Limit(limit)
+	sql, _, err := query.ToSQL()
+	if err != nil {
+		return "", err
+	}
+	return sql, nil
+}
+
+// Builds a query that groups the table by the quasi-identifier columns and returns the size of each group,
+// optionally along with the number of distinct values of the sensitive column within each group.
+func buildEquivalenceClassQuery(driver, schema, table string, quasiIdentifiers []string, sensitiveColumn *string) (string, error) {
+	if len(quasiIdentifiers) == 0 {
+		return "", errors.New("must provide at least one quasi-identifier column")
+	}
+	textType := getTextCastType(driver)
+	builder := goqu.Dialect(driver)
+	selectCols := make([]any, 0, len(quasiIdentifiers)+2)
+	groupCols := make([]any, 0, len(quasiIdentifiers))
+	for _, col := range quasiIdentifiers {
+		selectCols = append(selectCols, goqu.Cast(goqu.C(col), textType))
+		groupCols = append(groupCols, goqu.C(col))
+	}
+	selectCols = append(selectCols, goqu.COUNT("*"))
+	if sensitiveColumn != nil {
+		selectCols = append(selectCols, goqu.COUNT(goqu.DISTINCT(goqu.C(*sensitiveColumn))))
+	}
+	query := builder.From(goquTable(schema, table)).
+		Select(selectCols...).
+		GroupBy(groupCols...)
+	sql, _, err := query.ToSQL()
+	if err != nil {
+		return "", err
+	}
+	return sql, nil
+}
+
+// Builds a query that counts the rows in the table whose foreign key does not resolve to a row in the referenced table.
+// Rows with any null foreign key columns are not considered orphans.
+func buildForeignKeyOrphanCountQuery(driver, schema, table string, constraint *ForeignConstraint) (string, error) {
+	if constraint == nil || constraint.ForeignKey == nil {
+		return "", errors.New("must provide foreign key constraint")
+	}
+	if len(constraint.Columns) == 0 || len(constraint.Columns) != len(constraint.ForeignKey.Columns) {
+		return "", fmt.Errorf("foreign key constraint columns do not match referenced columns: %v -> %v", constraint.Columns, constraint.ForeignKey.Columns)
+	}
+	builder := goqu.Dialect(driver)
+
+	notNulls := make([]exp.Expression, 0, len(constraint.Columns))
+	joins := make([]exp.Expression, 0, len(constraint.Columns))
+	for idx, col := range constraint.Columns {
+		notNulls = append(notNulls, goqu.T("c").Col(col).IsNotNull())
+		joins = append(joins, goqu.T("p").Col(constraint.ForeignKey.Columns[idx]).Eq(goqu.T("c").Col(col)))
+	}
+	refSchema, refTable, found := strings.Cut(constraint.ForeignKey.Table, ".")
+	if !found {
+		refSchema, refTable = "", constraint.ForeignKey.Table
+	}
+	parent := builder.From(goquTable(refSchema, refTable).As("p")).Select(goqu.L("1")).Where(joins...)
+
+	query := builder.From(goquTable(schema, table).As("c")).
+		Select(goqu.COUNT("*")).
+		Where(goqu.And(notNulls...), goqu.L("NOT EXISTS ?", parent))
+	sql, _, err := query.ToSQL()
+	if err != nil {
+		return "", err
+	}
+	return sql, nil
+}
+
+// A column of a constraint as it is described by the information schema, for databases whose pg_catalog can't be used
+type informationSchemaConstraintRow struct {
+	ConstraintType    string
+	SchemaName        string
+	TableName         string
+	ConstraintName    string
+	ColumnName        string
+	IsNullable        string
+	ForeignSchemaName string
+	ForeignTableName  string
+	ForeignColumnName string
+}
+
+// A constraint along with all of its columns, in the order they are declared in the constraint
+type informationSchemaConstraint struct {
+	ConstraintType     string
+	SchemaName         string
+	TableName          string
+	ConstraintName     string
+	Columns            []string
+	NotNullable        []bool
+	ForeignSchemaName  string
+	ForeignTableName   string
+	ForeignColumnNames []string
+}
+
+// Combines the column rows of each constraint, keeping the order that the constraints were returned in
+func groupInformationSchemaConstraintRows(rows []*informationSchemaConstraintRow) []*informationSchemaConstraint {
+	output := []*informationSchemaConstraint{}
+	constraintMap := map[string]*informationSchemaConstraint{}
+	for _, row := range rows {
+		key := fmt.Sprintf("%s.%s", BuildTable(row.SchemaName, row.TableName), row.ConstraintName)
+		constraint, ok := constraintMap[key]
+		if !ok {
+			constraint = &informationSchemaConstraint{
+				ConstraintType:     row.ConstraintType,
+				SchemaName:         row.SchemaName,
+				TableName:          row.TableName,
+				ConstraintName:     row.ConstraintName,
+				Columns:            []string{},
+				NotNullable:        []bool{},
+				ForeignSchemaName:  row.ForeignSchemaName,
+				ForeignTableName:   row.ForeignTableName,
+				ForeignColumnNames: []string{},
+			}
+			constraintMap[key] = constraint
+			output = append(output, constraint)
+		}
+		constraint.Columns = append(constraint.Columns, row.ColumnName)
+		constraint.NotNullable = append(constraint.NotNullable, row.IsNullable == "NO")
+		if row.ForeignColumnName != "" {
+			constraint.ForeignColumnNames = append(constraint.ForeignColumnNames, row.ForeignColumnName)
+		}
+	}
+	return output
+}
+
+func buildInformationSchemaTableConstraints(constraints []*informationSchemaConstraint) (*TableConstraints, error) {
+	foreignKeyMap := map[string][]*ForeignConstraint{}
+	primaryKeyMap := map[string][]string{}
+	uniqueConstraintsMap := map[string][][]string{}
+	for _, constraint := range constraints {
+		tableName := BuildTable(constraint.SchemaName, constraint.TableName)
+		switch constraint.ConstraintType {
+		case "FOREIGN KEY":
+			if len(constraint.Columns) != len(constraint.ForeignColumnNames) {
+				return nil, fmt.Errorf("length of columns was not equal to length of foreign key cols: %d %d", len(constraint.Columns), len(constraint.ForeignColumnNames))
+			}
+			foreignKeyMap[tableName] = append(foreignKeyMap[tableName], &ForeignConstraint{
+				Columns:     constraint.Columns,
+				NotNullable: constraint.NotNullable,
+				ForeignKey: &ForeignKey{
+					Table:   BuildTable(constraint.ForeignSchemaName, constraint.ForeignTableName),
+					Columns: constraint.ForeignColumnNames,
+				},
+			})
+		case "PRIMARY KEY":
+			primaryKeyMap[tableName] = append(primaryKeyMap[tableName], dedupeSlice(constraint.Columns)...)
+		case "UNIQUE":
+			uniqueConstraintsMap[tableName] = append(uniqueConstraintsMap[tableName], dedupeSlice(constraint.Columns))
+		}
+	}
+	return &TableConstraints{
+		ForeignKeyConstraints: foreignKeyMap,
+		PrimaryKeyConstraints: primaryKeyMap,
+		UniqueConstraints:     uniqueConstraintsMap,
+	}, nil
+}
+
+func buildInformationSchemaForeignKeyRows(constraints []*informationSchemaConstraint) ([]*ForeignKeyConstraintsRow, error) {
+	result := []*ForeignKeyConstraintsRow{}
+	for _, constraint := range constraints {
+		if constraint.ConstraintType != "FOREIGN KEY" {
+			continue
+		}
+		if len(constraint.Columns) != len(constraint.ForeignColumnNames) {
+			return nil, fmt.Errorf("length of columns was not equal to length of foreign key cols: %d %d", len(constraint.Columns), len(constraint.ForeignColumnNames))
+		}
+		for idx, colname := range constraint.Columns {
+			result = append(result, &ForeignKeyConstraintsRow{
+				SchemaName:        constraint.SchemaName,
+				TableName:         constraint.TableName,
+				ColumnName:        colname,
+				IsNullable:        !constraint.NotNullable[idx],
+				ConstraintName:    constraint.ConstraintName,
+				ForeignSchemaName: constraint.ForeignSchemaName,
+				ForeignTableName:  constraint.ForeignTableName,
+				ForeignColumnName: constraint.ForeignColumnNames[idx],
+			})
+		}
+	}
+	return result, nil
+}
+
+func buildInformationSchemaPrimaryKeys(constraints []*informationSchemaConstraint) []*PrimaryKey {
+	result := []*PrimaryKey{}
+	for _, constraint := range constraints {
+		if constraint.ConstraintType != "PRIMARY KEY" {
+			continue
+		}
+		result = append(result, &PrimaryKey{
+			Schema:  constraint.SchemaName,
+			Table:   constraint.TableName,
+			Columns: dedupeSlice(constraint.Columns),
+		})
+	}
+	return result
+}
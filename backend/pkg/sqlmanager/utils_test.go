@@ -1,6 +1,7 @@
 package sqlmanager
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -23,3 +24,173 @@ func Test_getUniqueSchemaColMappings(t *testing.T) {
 	require.Contains(t, mappings["public.users"], "updated_by", "")
 	require.Contains(t, mappings["neosync_api.accounts"], "id", "")
 }
+
+func Test_QuoteIdentifier(t *testing.T) {
+	tests := []struct {
+		driver     string
+		identifier string
+		expected   string
+	}{
+		{PostgresDriver, "userAccounts", `"userAccounts"`},
+		{PostgresDriver, "first name", `"first name"`},
+		{PostgresDriver, "select", `"select"`},
+		{PostgresDriver, `say "hi"`, `"say ""hi"""`},
+		{SqliteDriver, "Order", `"Order"`},
+		{MysqlDriver, "userAccounts", "`userAccounts`"},
+		{MysqlDriver, "first name", "`first name`"},
+		{MysqlDriver, "order", "`order`"},
+		{MysqlDriver, "back`tick", "`back``tick`"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s_%s", tt.driver, tt.identifier), func(t *testing.T) {
+			require.Equal(t, tt.expected, QuoteIdentifier(tt.driver, tt.identifier))
+		})
+	}
+}
+
+func Test_QuoteTable(t *testing.T) {
+	require.Equal(t, `"Public"."user accounts"`, QuoteTable(PostgresDriver, "Public", "user accounts"))
+	require.Equal(t, "`public`.`group`", QuoteTable(MysqlDriver, "public", "group"))
+	require.Equal(t, `"table"`, QuoteTable(SqliteDriver, "", "table"))
+}
+
+func Test_QueryBuilders_SpecialIdentifiers(t *testing.T) {
+	actual, err := buildColumnDistinctCountQuery(PostgresDriver, "Public", "userAccounts", "emailAddress")
+	require.NoError(t, err)
+	require.Equal(t, `SELECT COUNT(DISTINCT("emailAddress")) FROM "Public"."userAccounts"`, actual)
+
+	actual, err = buildNumericColumnSampleQuery(PostgresDriver, "public", "order items", "unit.price", 10)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT CAST("unit.price" AS DOUBLE PRECISION) FROM "public"."order items" WHERE ("unit.price" IS NOT NULL) LIMIT 10`, actual)
+
+	actual, err = buildTableRowSampleQuery(PostgresDriver, "public", "user", []string{"select", "first name"}, 5)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT CAST("select" AS TEXT) AS "select", CAST("first name" AS TEXT) AS "first name" FROM "public"."user" LIMIT 5`, actual)
+
+	actual, err = buildForeignKeyOrphanCountQuery(PostgresDriver, "public", "order", &ForeignConstraint{
+		Columns:    []string{"userId"},
+		ForeignKey: &ForeignKey{Table: "public.user", Columns: []string{"id"}},
+	})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		`SELECT COUNT(*) FROM "public"."order" AS "c" WHERE (("c"."userId" IS NOT NULL) AND NOT EXISTS (SELECT 1 FROM "public"."user" AS "p" WHERE ("p"."id" = "c"."userId")))`,
+		actual,
+	)
+
+	actual, err = BuildVirtualTableSelectQuery(MysqlDriver, "SELECT 1", []string{"group", "back`tick"})
+	require.NoError(t, err)
+	require.Equal(t, "SELECT `group`, `back``tick` FROM (SELECT 1) AS `neosync_virtual_table`", actual)
+
+	require.Equal(
+		t,
+		`CREATE TABLE IF NOT EXISTS "Public"."userTotals" ("order" integer NOT NULL);`,
+		BuildCreateTableFromColumns(PostgresDriver, "Public", "userTotals", map[string]*ColumnInfo{"order": {DataType: "integer"}}, nil),
+	)
+}
+
+func Test_buildColumnDistinctCountQuery(t *testing.T) {
+	actual, err := buildColumnDistinctCountQuery(PostgresDriver, "public", "users", "email")
+	require.NoError(t, err)
+	require.Equal(t, `SELECT COUNT(DISTINCT("email")) FROM "public"."users"`, actual)
+}
+
+func Test_buildNumericColumnSampleQuery(t *testing.T) {
+	actual, err := buildNumericColumnSampleQuery(PostgresDriver, "public", "users", "age", 100)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT CAST("age" AS DOUBLE PRECISION) FROM "public"."users" WHERE ("age" IS NOT NULL) LIMIT 100`, actual)
+
+	actual, err = buildNumericColumnSampleQuery(MssqlDriver, "dbo", "users", "age", 100)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT  TOP (100) CAST("age" AS FLOAT) FROM "dbo"."users" WHERE ("age" IS NOT NULL)`, actual)
+}
+
+func Test_buildTableRowSampleQuery(t *testing.T) {
+	actual, err := buildTableRowSampleQuery(PostgresDriver, "public", "users", []string{"id", "age"}, 50)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT CAST("id" AS TEXT) AS "id", CAST("age" AS TEXT) AS "age" FROM "public"."users" LIMIT 50`, actual)
+
+	actual, err = buildTableRowSampleQuery(RedshiftDriver, "public", "users", []string{"id"}, 50)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT CAST("id" AS VARCHAR(MAX)) AS "id" FROM "public"."users" LIMIT 50`, actual)
+
+	actual, err = buildTableRowSampleQuery(MssqlDriver, "dbo", "users", []string{"id"}, 50)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT  TOP (50) CAST("id" AS NVARCHAR(MAX)) AS "id" FROM "dbo"."users"`, actual)
+
+	_, err = buildTableRowSampleQuery(PostgresDriver, "public", "users", nil, 50)
+	require.Error(t, err)
+}
+
+func Test_BuildVirtualTableSelectQuery(t *testing.T) {
+	actual, err := BuildVirtualTableSelectQuery(PostgresDriver, " SELECT u.id, o.total FROM users u JOIN orders o ON o.user_id = u.id; ", nil)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT * FROM (SELECT u.id, o.total FROM users u JOIN orders o ON o.user_id = u.id) AS "neosync_virtual_table"`, actual)
+
+	actual, err = BuildVirtualTableSelectQuery(MysqlDriver, "SELECT id, total FROM orders", []string{"id", "total"})
+	require.NoError(t, err)
+	require.Equal(t, "SELECT `id`, `total` FROM (SELECT id, total FROM orders) AS `neosync_virtual_table`", actual)
+
+	_, err = BuildVirtualTableSelectQuery(PostgresDriver, " ; ", nil)
+	require.Error(t, err)
+}
+
+func Test_BuildCreateTableFromColumns(t *testing.T) {
+	columns := map[string]*ColumnInfo{
+		"total":   {OrdinalPosition: 3, DataType: "numeric(10,2)", IsNullable: true},
+		"id":      {OrdinalPosition: 1, DataType: "uuid", IsNullable: false},
+		"email":   {OrdinalPosition: 2, DataType: "text", IsNullable: true},
+		"user_id": {OrdinalPosition: 4, DataType: "uuid", IsNullable: true},
+	}
+	actual := BuildCreateTableFromColumns(PostgresDriver, "public", "user_totals", columns, nil)
+	require.Equal(t, `CREATE TABLE IF NOT EXISTS "public"."user_totals" ("id" uuid NOT NULL, "email" text, "total" numeric(10,2), "user_id" uuid);`, actual)
+
+	actual = BuildCreateTableFromColumns(MysqlDriver, "public", "user_totals", columns, &TableInitStatementOpts{
+		DestinationTables: map[string]*SchemaTable{"public.user_totals": {Schema: "reporting", Table: "totals"}},
+		DestinationColumns: map[string]map[string]*DestinationColumn{
+			"public.user_totals": {
+				"email":   {Name: "email_hash", DataType: "char(64)"},
+				"user_id": {Exclude: true},
+			},
+		},
+	})
+	require.Equal(t, "CREATE TABLE IF NOT EXISTS `reporting`.`totals` (\n  `id` uuid NOT NULL,\n  `email_hash` char(64),\n  `total` numeric(10,2)\n);", actual)
+	require.Equal(
+		t,
+		"CREATE TABLE IF NOT EXISTS `reporting`.`totals` (\n  `id` uuid NOT NULL,\n  `email_hash` char(64),\n  `total` numeric(10,2)\n) DEFAULT CHARSET=utf8mb4;",
+		ApplyMysqlCharsetOverride(actual, "utf8mb4", nil),
+	)
+}
+
+func Test_buildEquivalenceClassQuery(t *testing.T) {
+	actual, err := buildEquivalenceClassQuery(PostgresDriver, "public", "users", []string{"zip", "age"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT CAST("zip" AS TEXT), CAST("age" AS TEXT), COUNT(*) FROM "public"."users" GROUP BY "zip", "age"`, actual)
+
+	sensitive := "diagnosis"
+	actual, err = buildEquivalenceClassQuery(PostgresDriver, "public", "users", []string{"zip"}, &sensitive)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT CAST("zip" AS TEXT), COUNT(*), COUNT(DISTINCT("diagnosis")) FROM "public"."users" GROUP BY "zip"`, actual)
+
+	_, err = buildEquivalenceClassQuery(PostgresDriver, "public", "users", nil, nil)
+	require.Error(t, err)
+}
+
+func Test_buildForeignKeyOrphanCountQuery(t *testing.T) {
+	actual, err := buildForeignKeyOrphanCountQuery(PostgresDriver, "public", "orders", &ForeignConstraint{
+		Columns:    []string{"user_id", "tenant_id"},
+		ForeignKey: &ForeignKey{Table: "public.users", Columns: []string{"id", "tenant_id"}},
+	})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		`SELECT COUNT(*) FROM "public"."orders" AS "c" WHERE ((("c"."user_id" IS NOT NULL) AND ("c"."tenant_id" IS NOT NULL)) AND NOT EXISTS (SELECT 1 FROM "public"."users" AS "p" WHERE (("p"."id" = "c"."user_id") AND ("p"."tenant_id" = "c"."tenant_id"))))`,
+		actual,
+	)
+
+	_, err = buildForeignKeyOrphanCountQuery(PostgresDriver, "public", "orders", &ForeignConstraint{
+		Columns:    []string{"user_id"},
+		ForeignKey: &ForeignKey{Table: "public.users", Columns: []string{"id", "tenant_id"}},
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,80 @@
+package tableprocessors
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/benthosdev/benthos/v4/public/service"
+	"gopkg.in/yaml.v3"
+
+	// registers the processors that are allowed so that they can be linted
+	_ "github.com/benthosdev/benthos/v4/public/components/pure"
+)
+
+// The processors that may be used as table processors. Table processors are written by job editors and run on the worker,
+// so only processors that transform rows in memory are allowed. Processors that run commands, make network calls,
+// query databases or read files are not, nor are processors that nest other processors.
+var allowedProcessors = map[string]struct{}{
+	"bloblang": {},
+	"grok":     {},
+	"jmespath": {},
+	"jq":       {},
+	"log":      {},
+	"mapping":  {},
+	"mutation": {},
+	"noop":     {},
+}
+
+// Returns the sorted names of the processors that may be used as table processors
+func AllowedProcessors() []string {
+	names := make([]string, 0, len(allowedProcessors))
+	for name := range allowedProcessors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Parses the table processors, a YAML list of Benthos processor configs.
+// Every processor must be one of the allowed processors and pass the Benthos linter
+func Parse(processors string) ([]map[string]any, error) {
+	var configs []map[string]any
+	if err := yaml.Unmarshal([]byte(processors), &configs); err != nil {
+		return nil, fmt.Errorf("processors must be a YAML list of processor configs: %w", err)
+	}
+	if len(configs) == 0 {
+		return nil, errors.New("at least one processor must be provided")
+	}
+	for idx, config := range configs {
+		types := []string{}
+		for key := range config {
+			if key != "label" {
+				types = append(types, key)
+			}
+		}
+		if len(types) != 1 {
+			return nil, fmt.Errorf("processor %d must specify exactly one processor type, found %d", idx, len(types))
+		}
+		if _, ok := allowedProcessors[types[0]]; !ok {
+			return nil, fmt.Errorf("processor %d is a %s processor, which is not allowed. Must be one of: %s", idx, types[0], strings.Join(AllowedProcessors(), ", "))
+		}
+		if err := lint(config); err != nil {
+			return nil, fmt.Errorf("processor %d is invalid: %w", idx, err)
+		}
+	}
+	return configs, nil
+}
+
+func lint(config map[string]any) error {
+	bits, err := yaml.Marshal(config)
+	if err != nil {
+		return err
+	}
+	env := service.NewEnvironment()
+	// mappings may not read environment variables or files, or import other mappings
+	env.UseBloblangEnvironment(bloblang.GlobalEnvironment().OnlyPure().WithDisabledImports())
+	return env.NewStreamBuilder().AddProcessorYAML(string(bits))
+}
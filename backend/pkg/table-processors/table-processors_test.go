@@ -0,0 +1,47 @@
+package tableprocessors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Parse(t *testing.T) {
+	configs, err := Parse("- label: tag\n  mapping: 'root = this'\n- log:\n    message: hi")
+	require.NoError(t, err)
+	require.Equal(t, []map[string]any{
+		{"label": "tag", "mapping": "root = this"},
+		{"log": map[string]any{"message": "hi"}},
+	}, configs)
+
+	invalid := []string{
+		"mapping: 'root = this'",
+		"[]",
+		"- label: only-a-label",
+		"- mapping: 'root = this'\n  log:\n    message: hi",
+		"- mapping: 'root = '",
+		"- mapping: 'root = env(\"DB_PASSWORD\")'",
+		"- mapping: 'root = file(\"/etc/passwd\")'",
+		"- log:\n    not_a_field: hi",
+	}
+	for _, processors := range invalid {
+		_, err := Parse(processors)
+		require.Error(t, err, processors)
+	}
+}
+
+func Test_Parse_NotAllowed(t *testing.T) {
+	notAllowed := []string{
+		"- command:\n    name: rm\n    args_mapping: '[ \"-rf\", \"/\" ]'",
+		"- subprocess:\n    name: sh",
+		"- http:\n    url: http://example.com",
+		"- sql_raw:\n    driver: postgres\n    dsn: postgres://localhost\n    query: DROP TABLE users",
+		"- branch:\n    processors:\n      - command:\n          name: rm",
+		"- switch:\n    - processors:\n        - http:\n            url: http://example.com",
+	}
+	for _, processors := range notAllowed {
+		_, err := Parse(processors)
+		require.Error(t, err, processors)
+		require.Contains(t, err.Error(), "not allowed", processors)
+	}
+}
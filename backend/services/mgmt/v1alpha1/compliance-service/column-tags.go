@@ -0,0 +1,207 @@
+package v1alpha1_complianceservice
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/classification"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+)
+
+func (s *Service) GetColumnTags(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetColumnTagsRequest],
+) (*connect.Response[mgmtv1alpha1.GetColumnTagsResponse], error) {
+	// verifies the user has access to the connection
+	_, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{Id: req.Msg.GetConnectionId()}))
+	if err != nil {
+		return nil, err
+	}
+	connectionUuid, err := nucleusdb.ToUuid(req.Msg.GetConnectionId())
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.db.Q.GetColumnTagsByConnection(ctx, s.db.Db, connectionUuid)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GetColumnTagsResponse{
+		Tags: toColumnTagDtos(tags),
+	}), nil
+}
+
+func (s *Service) SetColumnTag(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.SetColumnTagRequest],
+) (*connect.Response[mgmtv1alpha1.SetColumnTagResponse], error) {
+	_, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{Id: req.Msg.GetConnectionId()}))
+	if err != nil {
+		return nil, err
+	}
+	connectionUuid, err := nucleusdb.ToUuid(req.Msg.GetConnectionId())
+	if err != nil {
+		return nil, err
+	}
+	userUuid, err := s.getUserUuid(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := s.db.Q.UpsertColumnTag(ctx, s.db.Db, db_queries.UpsertColumnTagParams{
+		ConnectionID:    connectionUuid,
+		SchemaName:      req.Msg.GetSchema(),
+		TableName:       req.Msg.GetTable(),
+		ColumnName:      req.Msg.GetColumn(),
+		Classifications: dtomaps.FromDataClassificationsDto(req.Msg.GetClassifications()),
+		Source:          int16(mgmtv1alpha1.ColumnTagSource_COLUMN_TAG_SOURCE_USER),
+		CreatedByID:     *userUuid,
+		UpdatedByID:     *userUuid,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.SetColumnTagResponse{
+		Tag: dtomaps.ToColumnTagDto(&tag),
+	}), nil
+}
+
+func (s *Service) DeleteColumnTag(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.DeleteColumnTagRequest],
+) (*connect.Response[mgmtv1alpha1.DeleteColumnTagResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("columnTagId", req.Msg.GetId())
+
+	tagUuid, err := nucleusdb.ToUuid(req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	tag, err := s.db.Q.GetColumnTagById(ctx, s.db.Db, tagUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		logger.Info("column tag not found or has already been removed")
+		return connect.NewResponse(&mgmtv1alpha1.DeleteColumnTagResponse{}), nil
+	}
+
+	_, err = s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{Id: nucleusdb.UUIDString(tag.ConnectionID)}))
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.Q.RemoveColumnTag(ctx, s.db.Db, tag.ID)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.DeleteColumnTagResponse{}), nil
+}
+
+func (s *Service) ScanColumnTags(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.ScanColumnTagsRequest],
+) (*connect.Response[mgmtv1alpha1.ScanColumnTagsResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("connectionId", req.Msg.GetConnectionId())
+
+	_, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{Id: req.Msg.GetConnectionId()}))
+	if err != nil {
+		return nil, err
+	}
+	connectionUuid, err := nucleusdb.ToUuid(req.Msg.GetConnectionId())
+	if err != nil {
+		return nil, err
+	}
+	userUuid, err := s.getUserUuid(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	schemaResp, err := s.connectionDataService.GetConnectionSchema(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionSchemaRequest{
+		ConnectionId: req.Msg.GetConnectionId(),
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	existingTags, err := s.db.Q.GetColumnTagsByConnection(ctx, s.db.Db, connectionUuid)
+	if err != nil {
+		return nil, err
+	}
+	userTagged := map[string]struct{}{}
+	for _, tag := range existingTags {
+		if tag.Source == int16(mgmtv1alpha1.ColumnTagSource_COLUMN_TAG_SOURCE_USER) {
+			userTagged[classification.BuildColumnKey(tag.SchemaName, tag.TableName, tag.ColumnName)] = struct{}{}
+		}
+	}
+
+	var tags []db_queries.NeosyncApiConnectionColumnTag
+	if err := s.db.WithTx(ctx, nil, func(dbtx nucleusdb.BaseDBTX) error {
+		scanned := 0
+		for _, col := range schemaResp.Msg.GetSchemas() {
+			if _, ok := userTagged[classification.BuildColumnKey(col.GetSchema(), col.GetTable(), col.GetColumn())]; ok {
+				continue
+			}
+			classifications := classification.ClassifyColumn(col.GetColumn(), mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_UNSPECIFIED)
+			if len(classifications) == 0 {
+				continue
+			}
+			_, err := s.db.Q.UpsertColumnTag(ctx, dbtx, db_queries.UpsertColumnTagParams{
+				ConnectionID:    connectionUuid,
+				SchemaName:      col.GetSchema(),
+				TableName:       col.GetTable(),
+				ColumnName:      col.GetColumn(),
+				Classifications: dtomaps.FromDataClassificationsDto(classifications),
+				Source:          int16(mgmtv1alpha1.ColumnTagSource_COLUMN_TAG_SOURCE_SCANNER),
+				CreatedByID:     *userUuid,
+				UpdatedByID:     *userUuid,
+			})
+			if err != nil {
+				return fmt.Errorf("unable to upsert column tag: %w", err)
+			}
+			scanned++
+		}
+		logger.Info(fmt.Sprintf("tagged %d column(s) from scan", scanned))
+
+		tags, err = s.db.Q.GetColumnTagsByConnection(ctx, dbtx, connectionUuid)
+		if err != nil {
+			return err
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.ScanColumnTagsResponse{
+		Tags: toColumnTagDtos(tags),
+	}), nil
+}
+
+// Returns the tags for a connection in a format that can be consumed by the classifier
+func (s *Service) getColumnTagMap(
+	ctx context.Context,
+	connectionId string,
+) (classification.ColumnTagMap, error) {
+	connectionUuid, err := nucleusdb.ToUuid(connectionId)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := s.db.Q.GetColumnTagsByConnection(ctx, s.db.Db, connectionUuid)
+	if err != nil {
+		return nil, err
+	}
+	return dtomaps.ToColumnTagMap(tags), nil
+}
+
+func toColumnTagDtos(tags []db_queries.NeosyncApiConnectionColumnTag) []*mgmtv1alpha1.ColumnTag {
+	dtos := make([]*mgmtv1alpha1.ColumnTag, 0, len(tags))
+	for idx := range tags {
+		dtos = append(dtos, dtomaps.ToColumnTagDto(&tags[idx]))
+	}
+	return dtos
+}
@@ -0,0 +1,166 @@
+package v1alpha1_complianceservice
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockColumnTagId = "a1c2e3f4-5b6d-4e7f-8a9b-0c1d2e3f4a5b"
+)
+
+func Test_GetColumnTags(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+
+	mockGetConnection(m.ConnectionServiceMock)
+	m.QuerierMock.On("GetColumnTagsByConnection", mock.Anything, mock.Anything, connectionUuid).
+		Return([]db_queries.NeosyncApiConnectionColumnTag{
+			mockColumnTag("public", "users", "email", mgmtv1alpha1.ColumnTagSource_COLUMN_TAG_SOURCE_USER, mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII),
+		}, nil)
+
+	resp, err := m.Service.GetColumnTags(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetColumnTagsRequest{
+		ConnectionId: mockConnectionId,
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.GetTags(), 1)
+	tag := resp.Msg.GetTags()[0]
+	require.Equal(t, "email", tag.GetColumn())
+	require.Equal(t, mgmtv1alpha1.ColumnTagSource_COLUMN_TAG_SOURCE_USER, tag.GetSource())
+	require.Equal(t, []mgmtv1alpha1.DataClassification{mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII}, tag.GetClassifications())
+}
+
+func Test_GetColumnTags_ConnectionNotFound(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+
+	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).
+		Return(nil, connect.NewError(connect.CodeNotFound, nil))
+
+	resp, err := m.Service.GetColumnTags(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetColumnTagsRequest{
+		ConnectionId: mockConnectionId,
+	}))
+	require.Error(t, err)
+	require.Nil(t, resp)
+}
+
+func Test_SetColumnTag(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+
+	mockGetConnection(m.ConnectionServiceMock)
+	mockGetUser(m.UserAccountServiceMock)
+	m.QuerierMock.On("UpsertColumnTag", mock.Anything, mock.Anything, db_queries.UpsertColumnTagParams{
+		ConnectionID:    connectionUuid,
+		SchemaName:      "public",
+		TableName:       "users",
+		ColumnName:      "notes",
+		Classifications: []int32{int32(mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PHI)},
+		Source:          int16(mgmtv1alpha1.ColumnTagSource_COLUMN_TAG_SOURCE_USER),
+		CreatedByID:     userUuid,
+		UpdatedByID:     userUuid,
+	}).Return(mockColumnTag("public", "users", "notes", mgmtv1alpha1.ColumnTagSource_COLUMN_TAG_SOURCE_USER, mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PHI), nil)
+
+	resp, err := m.Service.SetColumnTag(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetColumnTagRequest{
+		ConnectionId:    mockConnectionId,
+		Schema:          "public",
+		Table:           "users",
+		Column:          "notes",
+		Classifications: []mgmtv1alpha1.DataClassification{mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PHI},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "notes", resp.Msg.GetTag().GetColumn())
+}
+
+func Test_DeleteColumnTag(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	tag := mockColumnTag("public", "users", "email", mgmtv1alpha1.ColumnTagSource_COLUMN_TAG_SOURCE_USER, mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII)
+
+	mockGetConnection(m.ConnectionServiceMock)
+	m.QuerierMock.On("GetColumnTagById", mock.Anything, mock.Anything, tag.ID).Return(tag, nil)
+	m.QuerierMock.On("RemoveColumnTag", mock.Anything, mock.Anything, tag.ID).Return(nil)
+
+	resp, err := m.Service.DeleteColumnTag(context.Background(), connect.NewRequest(&mgmtv1alpha1.DeleteColumnTagRequest{
+		Id: mockColumnTagId,
+	}))
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+}
+
+func Test_ScanColumnTags(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	mockTx := new(nucleusdb.MockTx)
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+
+	mockGetConnection(m.ConnectionServiceMock)
+	mockGetUser(m.UserAccountServiceMock)
+	m.DbtxMock.On("Begin", mock.Anything).Return(mockTx, nil)
+	mockTx.On("Commit", mock.Anything).Return(nil)
+	mockTx.On("Rollback", mock.Anything).Return(nil)
+
+	m.ConnectionDataServiceMock.On("GetConnectionSchema", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionSchemaResponse{
+			Schemas: []*mgmtv1alpha1.DatabaseColumn{
+				{Schema: "public", Table: "users", Column: "id"},
+				{Schema: "public", Table: "users", Column: "email"},
+				{Schema: "public", Table: "users", Column: "ssn"},
+			},
+		}), nil)
+
+	userTag := mockColumnTag("public", "users", "email", mgmtv1alpha1.ColumnTagSource_COLUMN_TAG_SOURCE_USER, mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PUBLIC)
+	scannedTag := mockColumnTag("public", "users", "ssn", mgmtv1alpha1.ColumnTagSource_COLUMN_TAG_SOURCE_SCANNER, mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII)
+	m.QuerierMock.On("GetColumnTagsByConnection", mock.Anything, mock.Anything, connectionUuid).
+		Return([]db_queries.NeosyncApiConnectionColumnTag{userTag}, nil).Once()
+	m.QuerierMock.On("UpsertColumnTag", mock.Anything, mockTx, mock.MatchedBy(func(params db_queries.UpsertColumnTagParams) bool {
+		return params.ColumnName == "ssn" && params.Source == int16(mgmtv1alpha1.ColumnTagSource_COLUMN_TAG_SOURCE_SCANNER)
+	})).Return(scannedTag, nil).Once()
+	m.QuerierMock.On("GetColumnTagsByConnection", mock.Anything, mockTx, connectionUuid).
+		Return([]db_queries.NeosyncApiConnectionColumnTag{userTag, scannedTag}, nil).Once()
+
+	resp, err := m.Service.ScanColumnTags(context.Background(), connect.NewRequest(&mgmtv1alpha1.ScanColumnTagsRequest{
+		ConnectionId: mockConnectionId,
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.GetTags(), 2)
+}
+
+func mockGetConnection(connectionServiceMock *mgmtv1alpha1connect.MockConnectionServiceClient) {
+	connectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: &mgmtv1alpha1.Connection{Id: mockConnectionId, AccountId: mockAccountId},
+	}), nil)
+}
+
+func mockGetUser(userAccountServiceMock *mgmtv1alpha1connect.MockUserAccountServiceClient) {
+	userAccountServiceMock.On("GetUser", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetUserResponse{
+		UserId: mockUserId,
+	}), nil)
+}
+
+func mockColumnTag(
+	schema, table, column string,
+	source mgmtv1alpha1.ColumnTagSource,
+	classification mgmtv1alpha1.DataClassification,
+) db_queries.NeosyncApiConnectionColumnTag {
+	id, _ := nucleusdb.ToUuid(mockColumnTagId)
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	return db_queries.NeosyncApiConnectionColumnTag{
+		ID:              id,
+		ConnectionID:    connectionUuid,
+		SchemaName:      schema,
+		TableName:       table,
+		ColumnName:      column,
+		Classifications: []int32{int32(classification)},
+		Source:          int16(source),
+		CreatedByID:     userUuid,
+		UpdatedByID:     userUuid,
+	}
+}
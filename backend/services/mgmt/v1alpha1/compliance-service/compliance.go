@@ -0,0 +1,302 @@
+package v1alpha1_complianceservice
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/classification"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	hmacSha256Algorithm = "HMAC-SHA256"
+)
+
+func (s *Service) GenerateComplianceReport(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GenerateComplianceReportRequest],
+) (*connect.Response[mgmtv1alpha1.GenerateComplianceReportResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+
+	var jobId string
+	var jobRun *mgmtv1alpha1.JobRun
+	switch target := req.Msg.GetTarget().(type) {
+	case *mgmtv1alpha1.GenerateComplianceReportRequest_JobId:
+		jobId = target.JobId
+	case *mgmtv1alpha1.GenerateComplianceReportRequest_JobRunId:
+		if req.Msg.GetAccountId() == "" {
+			return nil, nucleuserrors.NewBadRequest("must provide account id when generating a report for a job run")
+		}
+		if _, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId()); err != nil {
+			return nil, err
+		}
+		jrResp, err := s.jobService.GetJobRun(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRunRequest{
+			JobRunId:  target.JobRunId,
+			AccountId: req.Msg.GetAccountId(),
+		}))
+		if err != nil {
+			return nil, err
+		}
+		jobRun = jrResp.Msg.GetJobRun()
+	default:
+		return nil, nucleuserrors.NewBadRequest("must provide a valid target to proceed")
+	}
+
+	var job *mgmtv1alpha1.Job
+	var err error
+	if jobRun != nil {
+		// the job may have changed since the run, so the report must attest to the configuration the run used
+		job, err = s.getJobRunConfig(ctx, req.Msg.GetAccountId(), jobRun.GetId())
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		jobResp, err := s.jobService.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{Id: jobId}))
+		if err != nil {
+			return nil, err
+		}
+		job = jobResp.Msg.GetJob()
+	}
+	logger = logger.With("jobId", job.GetId())
+
+	tags := classification.ColumnTagMap{}
+	if sourceConnectionId := getJobSourceConnectionId(job.GetSource()); sourceConnectionId != "" {
+		tags, err = s.getColumnTagMap(ctx, sourceConnectionId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	columns, err := s.getColumnReports(ctx, job.GetMappings(), tags)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &mgmtv1alpha1.ComplianceReport{
+		AccountId:          job.GetAccountId(),
+		JobId:              job.GetId(),
+		JobName:            job.GetName(),
+		GeneratedAt:        timestamppb.Now(),
+		Framework:          req.Msg.GetFramework(),
+		ConfiguredByUserId: job.GetUpdatedByUserId(),
+		ConfiguredAt:       job.GetUpdatedAt(),
+		Columns:            columns,
+	}
+	var approvedBefore *time.Time
+	if jobRun.GetStartedAt() != nil {
+		startedAt := jobRun.GetStartedAt().AsTime()
+		approvedBefore = &startedAt
+	}
+	approval, err := s.getLatestApproval(ctx, job.GetId(), approvedBefore)
+	if err != nil {
+		return nil, err
+	}
+	if approval != nil {
+		report.ApprovedByUserId = approval.ApprovedByUserId
+		report.ApprovedAt = approval.GetApprovedAt()
+	}
+
+	if jobRun != nil {
+		runId := jobRun.GetId()
+		report.JobRunId = &runId
+		report.RowCount = s.getRunRowCount(ctx, jobRun, logger)
+	}
+
+	content, err := protojson.Marshal(report)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal compliance report: %w", err)
+	}
+
+	resp := &mgmtv1alpha1.GenerateComplianceReportResponse{
+		Report:  report,
+		Content: content,
+	}
+	if s.cfg.ReportSigningKey != nil && *s.cfg.ReportSigningKey != "" {
+		resp.Signature = signContent([]byte(*s.cfg.ReportSigningKey), content)
+		resp.SignatureAlgorithm = hmacSha256Algorithm
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *Service) getColumnReports(
+	ctx context.Context,
+	mappings []*mgmtv1alpha1.JobMapping,
+	tags classification.ColumnTagMap,
+) ([]*mgmtv1alpha1.ComplianceColumnReport, error) {
+	systemTransformerNames := map[mgmtv1alpha1.TransformerSource]string{}
+	stResp, err := s.transformerService.GetSystemTransformers(ctx, connect.NewRequest(&mgmtv1alpha1.GetSystemTransformersRequest{}))
+	if err != nil {
+		return nil, err
+	}
+	for _, st := range stResp.Msg.GetTransformers() {
+		systemTransformerNames[st.GetSource()] = st.GetName()
+	}
+
+	userDefinedTransformers := map[string]*mgmtv1alpha1.UserDefinedTransformer{}
+
+	output := make([]*mgmtv1alpha1.ComplianceColumnReport, 0, len(mappings))
+	for _, mapping := range mappings {
+		source := mapping.GetTransformer().GetSource()
+		transformerName := systemTransformerNames[source]
+
+		if udtConfig := mapping.GetTransformer().GetConfig().GetUserDefinedTransformerConfig(); udtConfig != nil {
+			udt, ok := userDefinedTransformers[udtConfig.GetId()]
+			if !ok {
+				udtResp, err := s.transformerService.GetUserDefinedTransformerById(ctx, connect.NewRequest(&mgmtv1alpha1.GetUserDefinedTransformerByIdRequest{
+					TransformerId: udtConfig.GetId(),
+				}))
+				if err != nil {
+					return nil, err
+				}
+				udt = udtResp.Msg.GetTransformer()
+				userDefinedTransformers[udtConfig.GetId()] = udt
+			}
+			source = udt.GetSource()
+			transformerName = udt.GetName()
+		}
+
+		classifications := classification.Classify(tags, mapping.GetSchema(), mapping.GetTable(), mapping.GetColumn(), source)
+		output = append(output, &mgmtv1alpha1.ComplianceColumnReport{
+			Schema:            mapping.GetSchema(),
+			Table:             mapping.GetTable(),
+			Column:            mapping.GetColumn(),
+			Classifications:   classifications,
+			TransformerSource: source,
+			TransformerName:   transformerName,
+			IsExposed:         classification.IsSensitive(classifications) && classification.IsPassthrough(source),
+		})
+	}
+	return output, nil
+}
+
+// Returns the job configuration that the run was started with.
+// The worker records it as an artifact of the run, so runs from before it did so, or without an artifact store, can not be reported on
+func (s *Service) getJobRunConfig(
+	ctx context.Context,
+	accountId string,
+	jobRunId string,
+) (*mgmtv1alpha1.Job, error) {
+	artifactType := mgmtv1alpha1.JobRunArtifactType_JOB_RUN_ARTIFACT_TYPE_JOB_CONFIG
+	resp, err := s.jobService.GetJobRunArtifacts(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRunArtifactsRequest{
+		AccountId:      accountId,
+		JobRunId:       jobRunId,
+		Type:           &artifactType,
+		IncludeContent: true,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	artifacts := resp.Msg.GetArtifacts()
+	if len(artifacts) == 0 {
+		return nil, nucleuserrors.NewNotFound("the job configuration was not recorded for this run, a compliance report can not be generated for it")
+	}
+	// artifacts are ordered by creation, the last one is from the most recent attempt of the run
+	job := &mgmtv1alpha1.Job{}
+	if err := protojson.Unmarshal(artifacts[len(artifacts)-1].GetContent(), job); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal job run config: %w", err)
+	}
+	return job, nil
+}
+
+// Returns the most recently approved change request for the job, if there is one.
+// If provided, only change requests that were approved before the given time are considered
+func (s *Service) getLatestApproval(
+	ctx context.Context,
+	jobId string,
+	approvedBefore *time.Time,
+) (*mgmtv1alpha1.JobChangeRequest, error) {
+	resp, err := s.jobService.GetJobChangeRequests(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobChangeRequestsRequest{JobId: jobId}))
+	if err != nil {
+		return nil, err
+	}
+	for _, changeRequest := range resp.Msg.GetChangeRequests() {
+		if changeRequest.ApprovedByUserId == nil {
+			continue
+		}
+		if approvedBefore != nil && changeRequest.GetApprovedAt().AsTime().After(*approvedBefore) {
+			continue
+		}
+		return changeRequest, nil
+	}
+	return nil, nil
+}
+
+// Returns the number of rows that were received by the run.
+// Returns nil if the metrics service is not enabled or the count could not be retrieved
+func (s *Service) getRunRowCount(
+	ctx context.Context,
+	jobRun *mgmtv1alpha1.JobRun,
+	logger *slog.Logger,
+) *uint64 {
+	if s.metricsService == nil || jobRun.GetStartedAt() == nil {
+		return nil
+	}
+	start := jobRun.GetStartedAt().AsTime()
+	end := time.Now()
+	if jobRun.GetCompletedAt() != nil {
+		end = jobRun.GetCompletedAt().AsTime()
+	}
+	resp, err := s.metricsService.GetMetricCount(ctx, connect.NewRequest(&mgmtv1alpha1.GetMetricCountRequest{
+		Metric:     mgmtv1alpha1.RangedMetricName_RANGED_METRIC_NAME_INPUT_RECEIVED,
+		Identifier: &mgmtv1alpha1.GetMetricCountRequest_RunId{RunId: jobRun.GetId()},
+		StartDay:   timeToDate(start),
+		EndDay:     timeToDate(end),
+	}))
+	if err != nil {
+		logger.Warn(fmt.Sprintf("unable to retrieve row count for compliance report: %s", err.Error()))
+		return nil
+	}
+	count := resp.Msg.GetCount()
+	return &count
+}
+
+// Returns the connection that the job reads from, or an empty string if the job does not have one
+func getJobSourceConnectionId(jobSource *mgmtv1alpha1.JobSource) string {
+	switch config := jobSource.GetOptions().GetConfig().(type) {
+	case *mgmtv1alpha1.JobSourceOptions_Postgres:
+		return config.Postgres.GetConnectionId()
+	case *mgmtv1alpha1.JobSourceOptions_Mysql:
+		return config.Mysql.GetConnectionId()
+	case *mgmtv1alpha1.JobSourceOptions_AwsS3:
+		return config.AwsS3.GetConnectionId()
+	case *mgmtv1alpha1.JobSourceOptions_Custom:
+		return config.Custom.GetConnectionId()
+	case *mgmtv1alpha1.JobSourceOptions_SnapshotClone:
+		return config.SnapshotClone.GetConnectionId()
+	case *mgmtv1alpha1.JobSourceOptions_InPlaceMask:
+		return config.InPlaceMask.GetConnectionId()
+	case *mgmtv1alpha1.JobSourceOptions_Generate:
+		return config.Generate.GetFkSourceConnectionId()
+	case *mgmtv1alpha1.JobSourceOptions_AiGenerate:
+		return config.AiGenerate.GetFkSourceConnectionId()
+	case *mgmtv1alpha1.JobSourceOptions_Synthesize:
+		return config.Synthesize.GetConnectionId()
+	default:
+		return ""
+	}
+}
+
+func timeToDate(t time.Time) *mgmtv1alpha1.Date {
+	t = t.UTC()
+	return &mgmtv1alpha1.Date{
+		Year:  uint32(t.Year()),
+		Month: uint32(t.Month()),
+		Day:   uint32(t.Day()),
+	}
+}
+
+func signContent(key, content []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(content)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,435 @@
+package v1alpha1_complianceservice
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	mockUserId       = "d5e29f1f-b920-458c-8b86-f3a180e06d98"
+	mockAccountId    = "5629813e-1a35-4874-922c-9827d85f0378"
+	mockJobId        = "884765c6-1708-488d-b03a-70a02b12c81e"
+	mockJobRunId     = "884765c6-1708-488d-b03a-70a02b12c81e-2024-03-10T00:00:00Z"
+	mockTransformId  = "cae5ff88-4a55-4a3f-9b21-8a9b83e2b1f6"
+	mockSigningKey   = "super-secret"
+	mockUdtName      = "my-ssn-hasher"
+	mockConnectionId = "3c2b4a0d-2f5e-4b8f-8d57-6a9e1f0c2d11"
+	emailTransformer = "Transform Email"
+	mockChangeId1    = "0a6e0e2c-6f1c-4f4c-a1c0-4c4b5d3b4b01"
+	mockChangeId2    = "0a6e0e2c-6f1c-4f4c-a1c0-4c4b5d3b4b02"
+	mockRunUserId    = "9b2d7c1e-3a4f-4e6b-8c5d-0f1e2a3b4c5d"
+)
+
+var (
+	updatedAt = time.Date(2024, 3, 9, 12, 0, 0, 0, time.UTC)
+	startedAt = time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	approverUserId = "6f1b5a4e-0c3e-4f5a-9a57-2b8f3c1d9e10"
+)
+
+func Test_GenerateComplianceReport_Job(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	ctx := context.Background()
+
+	mockGetJob(m.JobServiceMock)
+	mockGetTransformers(m.TransformerServiceMock)
+
+	resp, err := m.Service.GenerateComplianceReport(ctx, connect.NewRequest(&mgmtv1alpha1.GenerateComplianceReportRequest{
+		Target:    &mgmtv1alpha1.GenerateComplianceReportRequest_JobId{JobId: mockJobId},
+		Framework: mgmtv1alpha1.ComplianceFramework_COMPLIANCE_FRAMEWORK_GDPR,
+	}))
+	require.NoError(t, err)
+	report := resp.Msg.GetReport()
+	require.Equal(t, mockAccountId, report.GetAccountId())
+	require.Equal(t, mockJobId, report.GetJobId())
+	require.Equal(t, mockUserId, report.GetConfiguredByUserId())
+	require.Equal(t, updatedAt, report.GetConfiguredAt().AsTime())
+	require.Equal(t, mgmtv1alpha1.ComplianceFramework_COMPLIANCE_FRAMEWORK_GDPR, report.GetFramework())
+	require.Equal(t, approverUserId, report.GetApprovedByUserId())
+	require.Equal(t, updatedAt, report.GetApprovedAt().AsTime())
+	require.Nil(t, report.JobRunId)
+	require.Nil(t, report.RowCount)
+	require.NotEmpty(t, resp.Msg.GetContent())
+	require.Empty(t, resp.Msg.GetSignature())
+	require.Empty(t, resp.Msg.GetSignatureAlgorithm())
+
+	require.Len(t, report.GetColumns(), 4)
+	columnsByName := map[string]*mgmtv1alpha1.ComplianceColumnReport{}
+	for _, col := range report.GetColumns() {
+		columnsByName[col.GetColumn()] = col
+	}
+
+	require.Equal(t, emailTransformer, columnsByName["email"].GetTransformerName())
+	require.False(t, columnsByName["email"].GetIsExposed())
+	require.Equal(t, []mgmtv1alpha1.DataClassification{mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII}, columnsByName["email"].GetClassifications())
+
+	require.True(t, columnsByName["first_name"].GetIsExposed())
+
+	require.Empty(t, columnsByName["id"].GetClassifications())
+	require.False(t, columnsByName["id"].GetIsExposed())
+
+	require.Equal(t, mockUdtName, columnsByName["ssn"].GetTransformerName())
+	require.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_SSN, columnsByName["ssn"].GetTransformerSource())
+	require.False(t, columnsByName["ssn"].GetIsExposed())
+}
+
+func Test_GenerateComplianceReport_Job_ColumnTags(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	ctx := context.Background()
+
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+	m.JobServiceMock.On("GetJobChangeRequests", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetJobChangeRequestsResponse{}), nil)
+	m.JobServiceMock.On("GetJob", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetJobResponse{
+		Job: &mgmtv1alpha1.Job{
+			Id:        mockJobId,
+			AccountId: mockAccountId,
+			Source: &mgmtv1alpha1.JobSource{
+				Options: &mgmtv1alpha1.JobSourceOptions{
+					Config: &mgmtv1alpha1.JobSourceOptions_Postgres{
+						Postgres: &mgmtv1alpha1.PostgresSourceConnectionOptions{ConnectionId: mockConnectionId},
+					},
+				},
+			},
+			Mappings: []*mgmtv1alpha1.JobMapping{
+				{Schema: "public", Table: "users", Column: "email", Transformer: &mgmtv1alpha1.JobMappingTransformer{
+					Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH,
+				}},
+				{Schema: "public", Table: "users", Column: "notes", Transformer: &mgmtv1alpha1.JobMappingTransformer{
+					Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH,
+				}},
+			},
+		},
+	}), nil)
+	m.TransformerServiceMock.On("GetSystemTransformers", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetSystemTransformersResponse{}), nil)
+	m.QuerierMock.On("GetColumnTagsByConnection", mock.Anything, mock.Anything, connectionUuid).
+		Return([]db_queries.NeosyncApiConnectionColumnTag{
+			{SchemaName: "public", TableName: "users", ColumnName: "email", Classifications: []int32{int32(mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PUBLIC)}},
+			{SchemaName: "public", TableName: "users", ColumnName: "notes", Classifications: []int32{int32(mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PHI)}},
+		}, nil)
+
+	resp, err := m.Service.GenerateComplianceReport(ctx, connect.NewRequest(&mgmtv1alpha1.GenerateComplianceReportRequest{
+		Target: &mgmtv1alpha1.GenerateComplianceReportRequest_JobId{JobId: mockJobId},
+	}))
+	require.NoError(t, err)
+	columns := resp.Msg.GetReport().GetColumns()
+	require.Len(t, columns, 2)
+	require.Equal(t, []mgmtv1alpha1.DataClassification{mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PUBLIC}, columns[0].GetClassifications())
+	require.False(t, columns[0].GetIsExposed())
+	require.Equal(t, []mgmtv1alpha1.DataClassification{mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PHI}, columns[1].GetClassifications())
+	require.True(t, columns[1].GetIsExposed())
+}
+
+func Test_GenerateComplianceReport_Job_Signed(t *testing.T) {
+	signingKey := mockSigningKey
+	m := createServiceMock(t, &Config{ReportSigningKey: &signingKey})
+	ctx := context.Background()
+
+	mockGetJob(m.JobServiceMock)
+	mockGetTransformers(m.TransformerServiceMock)
+
+	resp, err := m.Service.GenerateComplianceReport(ctx, connect.NewRequest(&mgmtv1alpha1.GenerateComplianceReportRequest{
+		Target: &mgmtv1alpha1.GenerateComplianceReportRequest_JobId{JobId: mockJobId},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, hmacSha256Algorithm, resp.Msg.GetSignatureAlgorithm())
+	require.Equal(t, signContent([]byte(mockSigningKey), resp.Msg.GetContent()), resp.Msg.GetSignature())
+	require.NotEqual(t, signContent([]byte("other-key"), resp.Msg.GetContent()), resp.Msg.GetSignature())
+}
+
+func Test_GenerateComplianceReport_Run(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	ctx := context.Background()
+
+	// the job has since been changed, the report must reflect the configuration the run used
+	runJob := getMockJob()
+	runJob.UpdatedByUserId = mockRunUserId
+	runJob.Mappings[1].Transformer.Source = mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH
+	laterApproverUserId := mockRunUserId
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetJobRunConfig(t, m.JobServiceMock, runJob)
+	mockGetTransformers(m.TransformerServiceMock)
+	m.JobServiceMock.On("GetJobChangeRequests", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetJobChangeRequestsResponse{
+		ChangeRequests: []*mgmtv1alpha1.JobChangeRequest{
+			{Id: mockChangeId2, JobId: mockJobId, CreatedByUserId: mockUserId, ApprovedByUserId: &laterApproverUserId, ApprovedAt: timestamppb.New(startedAt.Add(2 * time.Hour))},
+			{Id: mockChangeId1, JobId: mockJobId, CreatedByUserId: mockUserId, ApprovedByUserId: &approverUserId, ApprovedAt: timestamppb.New(updatedAt)},
+		},
+	}), nil)
+	m.JobServiceMock.On("GetJobRun", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetJobRunResponse{
+			JobRun: &mgmtv1alpha1.JobRun{
+				Id:          mockJobRunId,
+				JobId:       mockJobId,
+				StartedAt:   timestamppb.New(startedAt),
+				CompletedAt: timestamppb.New(startedAt.Add(time.Hour)),
+			},
+		}), nil)
+	m.MetricsServiceMock.On("GetMetricCount", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetMetricCountResponse{Count: 42}), nil)
+
+	resp, err := m.Service.GenerateComplianceReport(ctx, connect.NewRequest(&mgmtv1alpha1.GenerateComplianceReportRequest{
+		Target:    &mgmtv1alpha1.GenerateComplianceReportRequest_JobRunId{JobRunId: mockJobRunId},
+		AccountId: mockAccountId,
+	}))
+	require.NoError(t, err)
+	report := resp.Msg.GetReport()
+	require.Equal(t, mockJobRunId, report.GetJobRunId())
+	require.NotNil(t, report.RowCount)
+	require.Equal(t, uint64(42), report.GetRowCount())
+	require.Equal(t, mockRunUserId, report.GetConfiguredByUserId())
+	require.Equal(t, approverUserId, report.GetApprovedByUserId())
+	m.JobServiceMock.AssertNotCalled(t, "GetJob", mock.Anything, mock.Anything)
+
+	columnsByName := map[string]*mgmtv1alpha1.ComplianceColumnReport{}
+	for _, col := range report.GetColumns() {
+		columnsByName[col.GetColumn()] = col
+	}
+	require.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH, columnsByName["email"].GetTransformerSource())
+	require.True(t, columnsByName["email"].GetIsExposed())
+}
+
+func Test_GenerateComplianceReport_Run_NoJobConfig(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	ctx := context.Background()
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.JobServiceMock.On("GetJobRun", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetJobRunResponse{
+			JobRun: &mgmtv1alpha1.JobRun{Id: mockJobRunId, JobId: mockJobId, StartedAt: timestamppb.New(startedAt)},
+		}), nil)
+	m.JobServiceMock.On("GetJobRunArtifacts", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetJobRunArtifactsResponse{}), nil)
+
+	resp, err := m.Service.GenerateComplianceReport(ctx, connect.NewRequest(&mgmtv1alpha1.GenerateComplianceReportRequest{
+		Target:    &mgmtv1alpha1.GenerateComplianceReportRequest_JobRunId{JobRunId: mockJobRunId},
+		AccountId: mockAccountId,
+	}))
+	require.Error(t, err)
+	require.Nil(t, resp)
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	m.JobServiceMock.AssertNotCalled(t, "GetJob", mock.Anything, mock.Anything)
+}
+
+func Test_GenerateComplianceReport_Run_NoMetrics(t *testing.T) {
+	mockUserAccService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+	mockJobService := mgmtv1alpha1connect.NewMockJobServiceHandler(t)
+	mockTransformerService := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
+	service := New(&Config{}, nucleusdb.New(nucleusdb.NewMockDBTX(t), db_queries.NewMockQuerier(t)), mockUserAccService, mockJobService, nil, nil, mockTransformerService, nil, nil)
+	ctx := context.Background()
+
+	mockIsUserInAccount(mockUserAccService, true)
+	mockGetJobChangeRequests(mockJobService)
+	mockGetJobRunConfig(t, mockJobService, getMockJob())
+	mockGetTransformers(mockTransformerService)
+	mockJobService.On("GetJobRun", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetJobRunResponse{
+			JobRun: &mgmtv1alpha1.JobRun{Id: mockJobRunId, JobId: mockJobId, StartedAt: timestamppb.New(startedAt)},
+		}), nil)
+
+	resp, err := service.GenerateComplianceReport(ctx, connect.NewRequest(&mgmtv1alpha1.GenerateComplianceReportRequest{
+		Target:    &mgmtv1alpha1.GenerateComplianceReportRequest_JobRunId{JobRunId: mockJobRunId},
+		AccountId: mockAccountId,
+	}))
+	require.NoError(t, err)
+	require.Nil(t, resp.Msg.GetReport().RowCount)
+}
+
+func Test_GenerateComplianceReport_Run_MissingAccount(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	ctx := context.Background()
+
+	resp, err := m.Service.GenerateComplianceReport(ctx, connect.NewRequest(&mgmtv1alpha1.GenerateComplianceReportRequest{
+		Target: &mgmtv1alpha1.GenerateComplianceReportRequest_JobRunId{JobRunId: mockJobRunId},
+	}))
+	require.Error(t, err)
+	require.Nil(t, resp)
+}
+
+func Test_GenerateComplianceReport_Run_NotInAccount(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	ctx := context.Background()
+
+	mockIsUserInAccount(m.UserAccountServiceMock, false)
+
+	resp, err := m.Service.GenerateComplianceReport(ctx, connect.NewRequest(&mgmtv1alpha1.GenerateComplianceReportRequest{
+		Target:    &mgmtv1alpha1.GenerateComplianceReportRequest_JobRunId{JobRunId: mockJobRunId},
+		AccountId: mockAccountId,
+	}))
+	require.Error(t, err)
+	require.Nil(t, resp)
+}
+
+type serviceMocks struct {
+	Service                   *Service
+	DbtxMock                  *nucleusdb.MockDBTX
+	QuerierMock               *db_queries.MockQuerier
+	UserAccountServiceMock    *mgmtv1alpha1connect.MockUserAccountServiceClient
+	JobServiceMock            *mgmtv1alpha1connect.MockJobServiceHandler
+	ConnectionServiceMock     *mgmtv1alpha1connect.MockConnectionServiceClient
+	ConnectionDataServiceMock *mgmtv1alpha1connect.MockConnectionDataServiceHandler
+	TransformerServiceMock    *mgmtv1alpha1connect.MockTransformersServiceClient
+	MetricsServiceMock        *metricsServiceClientMock
+	SqlManagerMock            *sql_manager.MockSqlManagerClient
+	SqlDbMock                 *sql_manager.MockSqlDatabase
+}
+
+func createServiceMock(t testing.TB, config *Config) *serviceMocks {
+	t.Helper()
+
+	mockDbtx := nucleusdb.NewMockDBTX(t)
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockUserAccService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+	mockJobService := mgmtv1alpha1connect.NewMockJobServiceHandler(t)
+	mockConnectionService := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
+	mockConnectionDataService := mgmtv1alpha1connect.NewMockConnectionDataServiceHandler(t)
+	mockTransformerService := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
+	mockMetricsService := newMetricsServiceClientMock(t)
+	mockSqlManager := sql_manager.NewMockSqlManagerClient(t)
+	mockSqlDb := sql_manager.NewMockSqlDatabase(t)
+
+	service := New(
+		config,
+		nucleusdb.New(mockDbtx, mockQuerier),
+		mockUserAccService,
+		mockJobService,
+		mockConnectionService,
+		mockConnectionDataService,
+		mockTransformerService,
+		mockMetricsService,
+		mockSqlManager,
+	)
+	return &serviceMocks{
+		Service:                   service,
+		DbtxMock:                  mockDbtx,
+		QuerierMock:               mockQuerier,
+		UserAccountServiceMock:    mockUserAccService,
+		JobServiceMock:            mockJobService,
+		ConnectionServiceMock:     mockConnectionService,
+		ConnectionDataServiceMock: mockConnectionDataService,
+		TransformerServiceMock:    mockTransformerService,
+		MetricsServiceMock:        mockMetricsService,
+		SqlManagerMock:            mockSqlManager,
+		SqlDbMock:                 mockSqlDb,
+	}
+}
+
+// the metrics service client has no generated mock, so only the methods the service calls are mocked here
+type metricsServiceClientMock struct {
+	mgmtv1alpha1connect.MetricsServiceClient
+	mock.Mock
+}
+
+func newMetricsServiceClientMock(t testing.TB) *metricsServiceClientMock {
+	m := &metricsServiceClientMock{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+func (m *metricsServiceClientMock) GetMetricCount(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetMetricCountRequest],
+) (*connect.Response[mgmtv1alpha1.GetMetricCountResponse], error) {
+	args := m.Called(ctx, req)
+	resp, _ := args.Get(0).(*connect.Response[mgmtv1alpha1.GetMetricCountResponse])
+	return resp, args.Error(1)
+}
+
+//nolint:unparam
+func mockIsUserInAccount(userAccountServiceMock *mgmtv1alpha1connect.MockUserAccountServiceClient, isInAccount bool) {
+	userAccountServiceMock.On("IsUserInAccount", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.IsUserInAccountResponse{
+		Ok: isInAccount,
+	}), nil)
+}
+
+func mockGetJob(jobServiceMock *mgmtv1alpha1connect.MockJobServiceHandler) {
+	mockGetJobChangeRequests(jobServiceMock)
+	jobServiceMock.On("GetJob", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetJobResponse{
+		Job: getMockJob(),
+	}), nil)
+}
+
+// Mocks the job configuration that was recorded for the run
+func mockGetJobRunConfig(t testing.TB, jobServiceMock *mgmtv1alpha1connect.MockJobServiceHandler, job *mgmtv1alpha1.Job) {
+	content, err := protojson.Marshal(job)
+	require.NoError(t, err)
+	jobServiceMock.On("GetJobRunArtifacts", mock.Anything, mock.MatchedBy(func(req *connect.Request[mgmtv1alpha1.GetJobRunArtifactsRequest]) bool {
+		return req.Msg.GetJobRunId() == mockJobRunId &&
+			req.Msg.GetType() == mgmtv1alpha1.JobRunArtifactType_JOB_RUN_ARTIFACT_TYPE_JOB_CONFIG &&
+			req.Msg.GetIncludeContent()
+	})).Return(connect.NewResponse(&mgmtv1alpha1.GetJobRunArtifactsResponse{
+		Artifacts: []*mgmtv1alpha1.JobRunArtifact{
+			{JobRunId: mockJobRunId, Type: mgmtv1alpha1.JobRunArtifactType_JOB_RUN_ARTIFACT_TYPE_JOB_CONFIG, Content: content},
+		},
+	}), nil)
+}
+
+func mockGetJobChangeRequests(jobServiceMock *mgmtv1alpha1connect.MockJobServiceHandler) {
+	jobServiceMock.On("GetJobChangeRequests", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetJobChangeRequestsResponse{
+		ChangeRequests: []*mgmtv1alpha1.JobChangeRequest{
+			{Id: mockChangeId2, JobId: mockJobId, CreatedByUserId: mockUserId},
+			{Id: mockChangeId1, JobId: mockJobId, CreatedByUserId: mockUserId, ApprovedByUserId: &approverUserId, ApprovedAt: timestamppb.New(updatedAt)},
+		},
+	}), nil)
+}
+
+func getMockJob() *mgmtv1alpha1.Job {
+	return &mgmtv1alpha1.Job{
+		Id:              mockJobId,
+		AccountId:       mockAccountId,
+		Name:            "test-job",
+		UpdatedByUserId: mockUserId,
+		UpdatedAt:       timestamppb.New(updatedAt),
+		Mappings: []*mgmtv1alpha1.JobMapping{
+			{Schema: "public", Table: "users", Column: "id", Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH,
+			}},
+			{Schema: "public", Table: "users", Column: "email", Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL,
+			}},
+			{Schema: "public", Table: "users", Column: "first_name", Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH,
+			}},
+			{Schema: "public", Table: "users", Column: "ssn", Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_USER_DEFINED,
+				Config: &mgmtv1alpha1.TransformerConfig{
+					Config: &mgmtv1alpha1.TransformerConfig_UserDefinedTransformerConfig{
+						UserDefinedTransformerConfig: &mgmtv1alpha1.UserDefinedTransformerConfig{Id: mockTransformId},
+					},
+				},
+			}},
+		},
+	}
+}
+
+func mockGetTransformers(transformerServiceMock *mgmtv1alpha1connect.MockTransformersServiceClient) {
+	transformerServiceMock.On("GetSystemTransformers", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetSystemTransformersResponse{
+			Transformers: []*mgmtv1alpha1.SystemTransformer{
+				{Name: "Passthrough", Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH},
+				{Name: emailTransformer, Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL},
+			},
+		}), nil)
+	transformerServiceMock.On("GetUserDefinedTransformerById", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetUserDefinedTransformerByIdResponse{
+			Transformer: &mgmtv1alpha1.UserDefinedTransformer{
+				Id:     mockTransformId,
+				Name:   mockUdtName,
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_SSN,
+			},
+		}), nil)
+}
@@ -0,0 +1,187 @@
+package v1alpha1_complianceservice
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+)
+
+const (
+	defaultKThreshold = 5
+	defaultLThreshold = 2
+	maxRiskyClasses   = 100
+)
+
+func (s *Service) EvaluatePrivacyRisk(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.EvaluatePrivacyRiskRequest],
+) (*connect.Response[mgmtv1alpha1.EvaluatePrivacyRiskResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("jobRunId", req.Msg.GetJobRunId())
+
+	if _, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId()); err != nil {
+		return nil, err
+	}
+	if slices.Contains(req.Msg.GetQuasiIdentifiers(), req.Msg.GetSensitiveColumn()) {
+		return nil, nucleuserrors.NewBadRequest("the sensitive column must not also be a quasi-identifier")
+	}
+
+	jrResp, err := s.jobService.GetJobRun(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRunRequest{
+		JobRunId:  req.Msg.GetJobRunId(),
+		AccountId: req.Msg.GetAccountId(),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	jobRun := jrResp.Msg.GetJobRun()
+	if jobRun.GetStatus() != mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_COMPLETE {
+		return nil, nucleuserrors.NewBadRequest("privacy risk can only be evaluated for job runs that have completed successfully")
+	}
+
+	jobResp, err := s.jobService.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{Id: jobRun.GetJobId()}))
+	if err != nil {
+		return nil, err
+	}
+	job := jobResp.Msg.GetJob()
+	logger = logger.With("jobId", job.GetId())
+
+	destination, err := s.getSqlDestinationConnection(ctx, job, req.Msg.DestinationConnectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	connectionTimeout := 5
+	db, err := s.sqlmanager.NewSqlDb(ctx, logger, destination, &connectionTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Db.Close()
+
+	classes, err := db.Db.GetEquivalenceClasses(ctx, req.Msg.GetSchema(), req.Msg.GetTable(), req.Msg.GetQuasiIdentifiers(), req.Msg.SensitiveColumn)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compute equivalence classes: %w", err)
+	}
+
+	kThreshold := uint32(defaultKThreshold)
+	if req.Msg.KThreshold != nil {
+		kThreshold = req.Msg.GetKThreshold()
+	}
+	var lThreshold *uint32
+	if req.Msg.SensitiveColumn != nil {
+		l := uint32(defaultLThreshold)
+		if req.Msg.LThreshold != nil {
+			l = req.Msg.GetLThreshold()
+		}
+		lThreshold = &l
+	}
+
+	resp := evaluateEquivalenceClasses(classes, kThreshold, lThreshold)
+	resp.DestinationConnectionId = destination.GetId()
+	return connect.NewResponse(resp), nil
+}
+
+// Returns the requested destination connection of the job, or the first SQL destination if one was not requested
+func (s *Service) getSqlDestinationConnection(
+	ctx context.Context,
+	job *mgmtv1alpha1.Job,
+	connectionId *string,
+) (*mgmtv1alpha1.Connection, error) {
+	for _, destination := range job.GetDestinations() {
+		if connectionId != nil && destination.GetConnectionId() != *connectionId {
+			continue
+		}
+		connResp, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+			Id: destination.GetConnectionId(),
+		}))
+		if err != nil {
+			return nil, err
+		}
+		connection := connResp.Msg.GetConnection()
+		switch connection.GetConnectionConfig().GetConfig().(type) {
+		case *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+			return connection, nil
+		}
+		if connectionId != nil {
+			return nil, nucleuserrors.NewBadRequest("privacy risk can only be evaluated for SQL destinations")
+		}
+	}
+	if connectionId != nil {
+		return nil, nucleuserrors.NewNotFound("the provided connection is not a destination of the job")
+	}
+	return nil, nucleuserrors.NewBadRequest("job does not have a SQL destination to evaluate")
+}
+
+func evaluateEquivalenceClasses(
+	classes []*sql_manager.EquivalenceClass,
+	kThreshold uint32,
+	lThreshold *uint32,
+) *mgmtv1alpha1.EvaluatePrivacyRiskResponse {
+	resp := &mgmtv1alpha1.EvaluatePrivacyRiskResponse{
+		EquivalenceClassCount: uint64(len(classes)),
+		KThreshold:            kThreshold,
+		LThreshold:            lThreshold,
+		RiskyClasses:          []*mgmtv1alpha1.RiskyEquivalenceClass{},
+	}
+	if len(classes) == 0 {
+		return resp
+	}
+
+	risky := []*mgmtv1alpha1.RiskyEquivalenceClass{}
+	for idx, class := range classes {
+		size := uint64(class.Size)
+		resp.RowCount += size
+		if idx == 0 || size < resp.KAnonymity {
+			resp.KAnonymity = size
+		}
+
+		violatesK := size < uint64(kThreshold)
+		violatesL := false
+		var distinct *uint64
+		if lThreshold != nil && class.DistinctSensitiveValues != nil {
+			d := uint64(*class.DistinctSensitiveValues)
+			distinct = &d
+			if resp.LDiversity == nil || d < resp.GetLDiversity() {
+				resp.LDiversity = &d
+			}
+			violatesL = d < uint64(*lThreshold)
+		}
+		if !violatesK && !violatesL {
+			continue
+		}
+		resp.RowsAtRisk += size
+
+		values := make([]*mgmtv1alpha1.EquivalenceClassValue, 0, len(class.Values))
+		for _, value := range class.Values {
+			values = append(values, &mgmtv1alpha1.EquivalenceClassValue{Value: value})
+		}
+		risky = append(risky, &mgmtv1alpha1.RiskyEquivalenceClass{
+			Values:                  values,
+			Size:                    size,
+			DistinctSensitiveValues: distinct,
+			ViolatesKAnonymity:      violatesK,
+			ViolatesLDiversity:      violatesL,
+		})
+	}
+
+	slices.SortStableFunc(risky, func(a, b *mgmtv1alpha1.RiskyEquivalenceClass) int {
+		switch {
+		case a.Size < b.Size:
+			return -1
+		case a.Size > b.Size:
+			return 1
+		default:
+			return 0
+		}
+	})
+	if len(risky) > maxRiskyClasses {
+		risky = risky[:maxRiskyClasses]
+	}
+	resp.RiskyClasses = risky
+	return resp
+}
@@ -0,0 +1,144 @@
+package v1alpha1_complianceservice
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockDestinationId = "9a1c3e2f-7b6d-4c5e-8f9a-0b1c2d3e4f50"
+)
+
+func Test_EvaluatePrivacyRisk(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	ctx := context.Background()
+
+	sensitive := "diagnosis"
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetJobRunStatus(m.JobServiceMock, mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_COMPLETE)
+	mockGetJobWithDestination(m.JobServiceMock)
+	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: &mgmtv1alpha1.Connection{
+			Id: mockDestinationId,
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{PgConfig: &mgmtv1alpha1.PostgresConnectionConfig{}},
+			},
+		},
+	}), nil)
+	m.SqlManagerMock.On("NewSqlDb", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(&sql_manager.SqlConnection{Db: m.SqlDbMock, Driver: sql_manager.PostgresDriver}, nil)
+	m.SqlDbMock.On("GetEquivalenceClasses", mock.Anything, "public", "patients", []string{"zip", "age"}, &sensitive).
+		Return([]*sql_manager.EquivalenceClass{
+			{Values: []*string{ptr("94107"), ptr("30")}, Size: 10, DistinctSensitiveValues: ptr(int64(4))},
+			{Values: []*string{ptr("94107"), nil}, Size: 3, DistinctSensitiveValues: ptr(int64(2))},
+			{Values: []*string{ptr("10001"), ptr("45")}, Size: 6, DistinctSensitiveValues: ptr(int64(1))},
+		}, nil)
+	m.SqlDbMock.On("Close").Return()
+
+	resp, err := m.Service.EvaluatePrivacyRisk(ctx, connect.NewRequest(&mgmtv1alpha1.EvaluatePrivacyRiskRequest{
+		AccountId:        mockAccountId,
+		JobRunId:         mockJobRunId,
+		Schema:           "public",
+		Table:            "patients",
+		QuasiIdentifiers: []string{"zip", "age"},
+		SensitiveColumn:  &sensitive,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, mockDestinationId, resp.Msg.GetDestinationConnectionId())
+	require.Equal(t, uint64(19), resp.Msg.GetRowCount())
+	require.Equal(t, uint64(3), resp.Msg.GetEquivalenceClassCount())
+	require.Equal(t, uint64(3), resp.Msg.GetKAnonymity())
+	require.Equal(t, uint64(1), resp.Msg.GetLDiversity())
+	require.Equal(t, uint64(9), resp.Msg.GetRowsAtRisk())
+	require.Equal(t, uint32(5), resp.Msg.GetKThreshold())
+	require.Equal(t, uint32(2), resp.Msg.GetLThreshold())
+
+	risky := resp.Msg.GetRiskyClasses()
+	require.Len(t, risky, 2)
+	require.Equal(t, uint64(3), risky[0].GetSize())
+	require.True(t, risky[0].GetViolatesKAnonymity())
+	require.False(t, risky[0].GetViolatesLDiversity())
+	require.Nil(t, risky[0].GetValues()[1].Value)
+	require.Equal(t, uint64(6), risky[1].GetSize())
+	require.False(t, risky[1].GetViolatesKAnonymity())
+	require.True(t, risky[1].GetViolatesLDiversity())
+}
+
+func Test_EvaluatePrivacyRisk_RunNotComplete(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetJobRunStatus(m.JobServiceMock, mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_RUNNING)
+
+	_, err := m.Service.EvaluatePrivacyRisk(context.Background(), connect.NewRequest(&mgmtv1alpha1.EvaluatePrivacyRiskRequest{
+		AccountId:        mockAccountId,
+		JobRunId:         mockJobRunId,
+		Schema:           "public",
+		Table:            "patients",
+		QuasiIdentifiers: []string{"zip"},
+	}))
+	require.Error(t, err)
+	m.SqlManagerMock.AssertNotCalled(t, "NewSqlDb", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_EvaluatePrivacyRisk_SensitiveIsQuasiIdentifier(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+
+	_, err := m.Service.EvaluatePrivacyRisk(context.Background(), connect.NewRequest(&mgmtv1alpha1.EvaluatePrivacyRiskRequest{
+		AccountId:        mockAccountId,
+		JobRunId:         mockJobRunId,
+		Schema:           "public",
+		Table:            "patients",
+		QuasiIdentifiers: []string{"zip"},
+		SensitiveColumn:  ptr("zip"),
+	}))
+	require.Error(t, err)
+}
+
+func Test_evaluateEquivalenceClasses_Empty(t *testing.T) {
+	resp := evaluateEquivalenceClasses(nil, 5, nil)
+	require.Equal(t, uint64(0), resp.GetRowCount())
+	require.Equal(t, uint64(0), resp.GetKAnonymity())
+	require.Nil(t, resp.LDiversity)
+	require.Empty(t, resp.GetRiskyClasses())
+}
+
+func Test_evaluateEquivalenceClasses_CapsRiskyClasses(t *testing.T) {
+	classes := []*sql_manager.EquivalenceClass{}
+	for i := 0; i < maxRiskyClasses+10; i++ {
+		classes = append(classes, &sql_manager.EquivalenceClass{Values: []*string{ptr("a")}, Size: 1})
+	}
+	resp := evaluateEquivalenceClasses(classes, 2, nil)
+	require.Len(t, resp.GetRiskyClasses(), maxRiskyClasses)
+	require.Equal(t, uint64(maxRiskyClasses+10), resp.GetRowsAtRisk())
+	require.Nil(t, resp.LDiversity)
+}
+
+func mockGetJobRunStatus(jobServiceMock *mgmtv1alpha1connect.MockJobServiceHandler, status mgmtv1alpha1.JobRunStatus) {
+	jobServiceMock.On("GetJobRun", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetJobRunResponse{
+		JobRun: &mgmtv1alpha1.JobRun{Id: mockJobRunId, JobId: mockJobId, Status: status},
+	}), nil)
+}
+
+func mockGetJobWithDestination(jobServiceMock *mgmtv1alpha1connect.MockJobServiceHandler) {
+	jobServiceMock.On("GetJob", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetJobResponse{
+		Job: &mgmtv1alpha1.Job{
+			Id:           mockJobId,
+			AccountId:    mockAccountId,
+			Destinations: []*mgmtv1alpha1.JobDestination{{ConnectionId: mockDestinationId}},
+		},
+	}), nil)
+}
+
+func ptr[T any](val T) *T {
+	return &val
+}
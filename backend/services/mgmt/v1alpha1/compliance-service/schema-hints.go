@@ -0,0 +1,201 @@
+package v1alpha1_complianceservice
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/classification"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/nucleuscloud/neosync/backend/internal/schemahints"
+)
+
+const defaultSchemaHintsSchema = "public"
+
+func (s *Service) ImportSchemaHints(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.ImportSchemaHintsRequest],
+) (*connect.Response[mgmtv1alpha1.ImportSchemaHintsResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("connectionId", req.Msg.GetConnectionId(), "format", req.Msg.GetFormat().String())
+
+	_, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{Id: req.Msg.GetConnectionId()}))
+	if err != nil {
+		return nil, err
+	}
+	connectionUuid, err := nucleusdb.ToUuid(req.Msg.GetConnectionId())
+	if err != nil {
+		return nil, err
+	}
+
+	defaultSchema := defaultSchemaHintsSchema
+	if req.Msg.DefaultSchema != nil {
+		defaultSchema = req.Msg.GetDefaultSchema()
+	}
+	hints, err := schemahints.Parse(req.Msg.GetFormat(), req.Msg.GetContent(), defaultSchema)
+	if err != nil {
+		return nil, nucleuserrors.NewBadRequest(err.Error())
+	}
+
+	schemaResp, err := s.connectionDataService.GetConnectionSchema(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionSchemaRequest{
+		ConnectionId: req.Msg.GetConnectionId(),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	dbColumns := map[string]*mgmtv1alpha1.DatabaseColumn{}
+	for _, col := range schemaResp.Msg.GetSchemas() {
+		dbColumns[classification.BuildColumnKey(col.GetSchema(), col.GetTable(), col.GetColumn())] = col
+	}
+
+	columnHints := []*mgmtv1alpha1.ColumnSchemaHint{}
+	unmatchedColumns := []string{}
+	taggableHints := []*schemahints.ColumnHint{}
+	for _, hint := range hints.Columns {
+		key := classification.BuildColumnKey(hint.Schema, hint.Table, hint.Column)
+		dbColumn, ok := dbColumns[key]
+		if !ok {
+			unmatchedColumns = append(unmatchedColumns, key)
+			continue
+		}
+		columnHints = append(columnHints, toColumnSchemaHintDto(hint, dbColumn))
+		if len(hint.Classifications) > 0 {
+			taggableHints = append(taggableHints, hint)
+		}
+	}
+	logger.Info(fmt.Sprintf("matched %d of %d column(s) from schema hints", len(columnHints), len(hints.Columns)))
+
+	foreignKeys := make([]*mgmtv1alpha1.SchemaHintForeignKey, 0, len(hints.ForeignKeys))
+	for _, fk := range hints.ForeignKeys {
+		foreignKeys = append(foreignKeys, &mgmtv1alpha1.SchemaHintForeignKey{
+			Schema:         fk.Schema,
+			Table:          fk.Table,
+			Columns:        fk.Columns,
+			ForeignSchema:  fk.ForeignSchema,
+			ForeignTable:   fk.ForeignTable,
+			ForeignColumns: fk.ForeignColumns,
+		})
+	}
+
+	resp := &mgmtv1alpha1.ImportSchemaHintsResponse{
+		Columns:          columnHints,
+		ForeignKeys:      foreignKeys,
+		UnmatchedColumns: unmatchedColumns,
+	}
+	if req.Msg.GetDryRun() {
+		return connect.NewResponse(resp), nil
+	}
+
+	userUuid, err := s.getUserUuid(ctx)
+	if err != nil {
+		return nil, err
+	}
+	existingTags, err := s.db.Q.GetColumnTagsByConnection(ctx, s.db.Db, connectionUuid)
+	if err != nil {
+		return nil, err
+	}
+	userTagged := map[string]struct{}{}
+	for _, tag := range existingTags {
+		if tag.Source == int16(mgmtv1alpha1.ColumnTagSource_COLUMN_TAG_SOURCE_USER) {
+			userTagged[classification.BuildColumnKey(tag.SchemaName, tag.TableName, tag.ColumnName)] = struct{}{}
+		}
+	}
+
+	var tags []db_queries.NeosyncApiConnectionColumnTag
+	if err := s.db.WithTx(ctx, nil, func(dbtx nucleusdb.BaseDBTX) error {
+		imported := 0
+		for _, hint := range taggableHints {
+			if _, ok := userTagged[classification.BuildColumnKey(hint.Schema, hint.Table, hint.Column)]; ok {
+				continue
+			}
+			_, err := s.db.Q.UpsertColumnTag(ctx, dbtx, db_queries.UpsertColumnTagParams{
+				ConnectionID:    connectionUuid,
+				SchemaName:      hint.Schema,
+				TableName:       hint.Table,
+				ColumnName:      hint.Column,
+				Classifications: dtomaps.FromDataClassificationsDto(hint.Classifications),
+				Source:          int16(mgmtv1alpha1.ColumnTagSource_COLUMN_TAG_SOURCE_SCHEMA_HINTS),
+				CreatedByID:     *userUuid,
+				UpdatedByID:     *userUuid,
+			})
+			if err != nil {
+				return fmt.Errorf("unable to upsert column tag: %w", err)
+			}
+			imported++
+		}
+		logger.Info(fmt.Sprintf("tagged %d column(s) from schema hints", imported))
+
+		tags, err = s.db.Q.GetColumnTagsByConnection(ctx, dbtx, connectionUuid)
+		if err != nil {
+			return err
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	resp.Tags = toColumnTagDtos(tags)
+	return connect.NewResponse(resp), nil
+}
+
+func toColumnSchemaHintDto(hint *schemahints.ColumnHint, dbColumn *mgmtv1alpha1.DatabaseColumn) *mgmtv1alpha1.ColumnSchemaHint {
+	// classifications declared by the file are authoritative, otherwise they are inferred from the column name
+	classifications := hint.Classifications
+	if len(classifications) == 0 {
+		classifications = classification.ClassifyColumn(hint.Column, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_UNSPECIFIED)
+	}
+	dto := &mgmtv1alpha1.ColumnSchemaHint{
+		Schema:          hint.Schema,
+		Table:           hint.Table,
+		Column:          hint.Column,
+		Description:     hint.Description,
+		Tests:           hint.Tests,
+		AcceptedValues:  hint.AcceptedValues,
+		Classifications: classifications,
+	}
+	if transformer := recommendSchemaHintTransformer(hint, dbColumn, classifications); transformer != nil {
+		dto.RecommendedTransformerSource = transformer.GetSource()
+		dto.RecommendedTransformerConfig = transformer.GetConfig()
+	}
+	return dto
+}
+
+// Recommends a transformer for a column based on its hints. Returns nil if the column can be passed through.
+// Columns with a fixed set of values keep their distribution by generating from the same categories
+func recommendSchemaHintTransformer(
+	hint *schemahints.ColumnHint,
+	dbColumn *mgmtv1alpha1.DatabaseColumn,
+	classifications []mgmtv1alpha1.DataClassification,
+) *mgmtv1alpha1.JobMappingTransformer {
+	if len(hint.AcceptedValues) > 0 && !slices.Contains(hint.Tests, schemahints.UniqueTest) {
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_CATEGORICAL,
+			Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_GenerateCategoricalConfig{
+				GenerateCategoricalConfig: &mgmtv1alpha1.GenerateCategorical{Categories: strings.Join(hint.AcceptedValues, ",")},
+			}},
+		}
+	}
+	if !classification.IsSensitive(classifications) {
+		return nil
+	}
+	if transformer := classification.RecommendTransformer(hint.Column, false); transformer != nil {
+		return transformer
+	}
+	dataType := strings.ToLower(dbColumn.GetDataType())
+	if strings.Contains(dataType, "char") || strings.Contains(dataType, "text") {
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_STRING,
+			Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_TransformStringConfig{
+				TransformStringConfig: &mgmtv1alpha1.TransformString{PreserveLength: true},
+			}},
+		}
+	}
+	return nil
+}
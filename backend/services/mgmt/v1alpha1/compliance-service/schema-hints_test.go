@@ -0,0 +1,142 @@
+package v1alpha1_complianceservice
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/nucleuscloud/neosync/backend/internal/schemahints"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testSchemaHintsManifest = `{
+  "nodes": {
+    "model.shop.users": {
+      "resource_type": "model",
+      "schema": "public",
+      "name": "users",
+      "columns": {
+        "email": {"name": "email", "tags": ["public"]},
+        "notes": {"name": "notes", "tags": ["phi"]},
+        "plan": {"name": "plan"},
+        "legacy": {"name": "legacy", "tags": ["pii"]}
+      }
+    },
+    "test.shop.accepted_values_users_plan.1": {
+      "resource_type": "test",
+      "column_name": "plan",
+      "attached_node": "model.shop.users",
+      "test_metadata": {"name": "accepted_values", "kwargs": {"values": ["free", "pro"]}}
+    }
+  }
+}`
+
+func Test_ImportSchemaHints_DryRun(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+
+	mockGetConnection(m.ConnectionServiceMock)
+	mockGetSchemaHintsConnectionSchema(m)
+
+	resp, err := m.Service.ImportSchemaHints(context.Background(), connect.NewRequest(&mgmtv1alpha1.ImportSchemaHintsRequest{
+		ConnectionId: mockConnectionId,
+		Format:       mgmtv1alpha1.SchemaHintsFormat_SCHEMA_HINTS_FORMAT_DBT_MANIFEST,
+		Content:      []byte(testSchemaHintsManifest),
+		DryRun:       true,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"public.users.legacy"}, resp.Msg.GetUnmatchedColumns())
+	require.Empty(t, resp.Msg.GetTags())
+
+	hints := map[string]*mgmtv1alpha1.ColumnSchemaHint{}
+	for _, hint := range resp.Msg.GetColumns() {
+		hints[hint.GetColumn()] = hint
+	}
+	require.Len(t, hints, 3)
+	require.Equal(t, []mgmtv1alpha1.DataClassification{mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PUBLIC}, hints["email"].GetClassifications())
+	require.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_UNSPECIFIED, hints["email"].GetRecommendedTransformerSource())
+	require.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_STRING, hints["notes"].GetRecommendedTransformerSource())
+	require.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_CATEGORICAL, hints["plan"].GetRecommendedTransformerSource())
+	require.Equal(t, "free,pro", hints["plan"].GetRecommendedTransformerConfig().GetGenerateCategoricalConfig().GetCategories())
+}
+
+func Test_ImportSchemaHints(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	mockTx := new(nucleusdb.MockTx)
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+
+	mockGetConnection(m.ConnectionServiceMock)
+	mockGetUser(m.UserAccountServiceMock)
+	mockGetSchemaHintsConnectionSchema(m)
+	m.DbtxMock.On("Begin", mock.Anything).Return(mockTx, nil)
+	mockTx.On("Commit", mock.Anything).Return(nil)
+	mockTx.On("Rollback", mock.Anything).Return(nil)
+
+	userTag := mockColumnTag("public", "users", "email", mgmtv1alpha1.ColumnTagSource_COLUMN_TAG_SOURCE_USER, mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII)
+	importedTag := mockColumnTag("public", "users", "notes", mgmtv1alpha1.ColumnTagSource_COLUMN_TAG_SOURCE_SCHEMA_HINTS, mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PHI)
+	m.QuerierMock.On("GetColumnTagsByConnection", mock.Anything, mock.Anything, connectionUuid).
+		Return([]db_queries.NeosyncApiConnectionColumnTag{userTag}, nil).Once()
+	m.QuerierMock.On("UpsertColumnTag", mock.Anything, mockTx, mock.MatchedBy(func(params db_queries.UpsertColumnTagParams) bool {
+		return params.ColumnName == "notes" && params.Source == int16(mgmtv1alpha1.ColumnTagSource_COLUMN_TAG_SOURCE_SCHEMA_HINTS)
+	})).Return(importedTag, nil).Once()
+	m.QuerierMock.On("GetColumnTagsByConnection", mock.Anything, mockTx, connectionUuid).
+		Return([]db_queries.NeosyncApiConnectionColumnTag{userTag, importedTag}, nil).Once()
+
+	resp, err := m.Service.ImportSchemaHints(context.Background(), connect.NewRequest(&mgmtv1alpha1.ImportSchemaHintsRequest{
+		ConnectionId: mockConnectionId,
+		Format:       mgmtv1alpha1.SchemaHintsFormat_SCHEMA_HINTS_FORMAT_DBT_MANIFEST,
+		Content:      []byte(testSchemaHintsManifest),
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.GetTags(), 2)
+	m.QuerierMock.AssertNumberOfCalls(t, "UpsertColumnTag", 1)
+}
+
+func Test_ImportSchemaHints_InvalidContent(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+
+	mockGetConnection(m.ConnectionServiceMock)
+
+	resp, err := m.Service.ImportSchemaHints(context.Background(), connect.NewRequest(&mgmtv1alpha1.ImportSchemaHintsRequest{
+		ConnectionId: mockConnectionId,
+		Format:       mgmtv1alpha1.SchemaHintsFormat_SCHEMA_HINTS_FORMAT_DBT_MANIFEST,
+		Content:      []byte("not json"),
+	}))
+	require.Error(t, err)
+	require.Nil(t, resp)
+}
+
+func Test_recommendSchemaHintTransformer(t *testing.T) {
+	pii := []mgmtv1alpha1.DataClassification{mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PII}
+	text := &mgmtv1alpha1.DatabaseColumn{DataType: "text"}
+
+	require.Nil(t, recommendSchemaHintTransformer(&schemahints.ColumnHint{Column: "id"}, text, nil))
+	require.Equal(
+		t,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL,
+		recommendSchemaHintTransformer(&schemahints.ColumnHint{Column: "email"}, text, pii).GetSource(),
+	)
+	require.Nil(t, recommendSchemaHintTransformer(&schemahints.ColumnHint{Column: "dob"}, &mgmtv1alpha1.DatabaseColumn{DataType: "date"}, pii))
+	require.Equal(
+		t,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_STRING,
+		recommendSchemaHintTransformer(&schemahints.ColumnHint{Column: "dob"}, &mgmtv1alpha1.DatabaseColumn{DataType: "character varying"}, pii).GetSource(),
+	)
+	// unique columns must not be collapsed into a small set of categories
+	require.Nil(t, recommendSchemaHintTransformer(&schemahints.ColumnHint{Column: "code", AcceptedValues: []string{"a", "b"}, Tests: []string{schemahints.UniqueTest}}, text, nil))
+}
+
+func mockGetSchemaHintsConnectionSchema(m *serviceMocks) {
+	m.ConnectionDataServiceMock.On("GetConnectionSchema", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionSchemaResponse{
+			Schemas: []*mgmtv1alpha1.DatabaseColumn{
+				{Schema: "public", Table: "users", Column: "id", DataType: "integer"},
+				{Schema: "public", Table: "users", Column: "email", DataType: "text"},
+				{Schema: "public", Table: "users", Column: "notes", DataType: "text"},
+				{Schema: "public", Table: "users", Column: "plan", DataType: "text"},
+			},
+		}), nil)
+}
@@ -0,0 +1,51 @@
+package v1alpha1_complianceservice
+
+import (
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+)
+
+type Service struct {
+	cfg *Config
+	db  *nucleusdb.NucleusDb
+
+	useraccountService    mgmtv1alpha1connect.UserAccountServiceClient
+	jobService            mgmtv1alpha1connect.JobServiceHandler
+	connectionService     mgmtv1alpha1connect.ConnectionServiceClient
+	connectionDataService mgmtv1alpha1connect.ConnectionDataServiceHandler
+	transformerService    mgmtv1alpha1connect.TransformersServiceClient
+	// optional, only available if the metrics service has been enabled
+	metricsService mgmtv1alpha1connect.MetricsServiceClient
+
+	sqlmanager sql_manager.SqlManagerClient
+}
+
+type Config struct {
+	// The key used to sign generated reports. Reports will be left unsigned if not provided
+	ReportSigningKey *string
+}
+
+func New(
+	cfg *Config,
+	db *nucleusdb.NucleusDb,
+	useraccountService mgmtv1alpha1connect.UserAccountServiceClient,
+	jobService mgmtv1alpha1connect.JobServiceHandler,
+	connectionService mgmtv1alpha1connect.ConnectionServiceClient,
+	connectionDataService mgmtv1alpha1connect.ConnectionDataServiceHandler,
+	transformerService mgmtv1alpha1connect.TransformersServiceClient,
+	metricsService mgmtv1alpha1connect.MetricsServiceClient,
+	sqlmanager sql_manager.SqlManagerClient,
+) *Service {
+	return &Service{
+		cfg:                   cfg,
+		db:                    db,
+		useraccountService:    useraccountService,
+		jobService:            jobService,
+		connectionService:     connectionService,
+		connectionDataService: connectionDataService,
+		transformerService:    transformerService,
+		metricsService:        metricsService,
+		sqlmanager:            sqlmanager,
+	}
+}
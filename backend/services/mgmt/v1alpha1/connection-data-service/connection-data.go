@@ -7,25 +7,42 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"sort"
 	"strings"
-	"time"
 
 	"connectrpc.com/connect"
 	"github.com/Azure/azure-sdk-for-go/sdk/ai/azopenai"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/go-sql-driver/mysql"
 	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	mysql_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/mysql"
 	pg_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/postgresql"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	elasticsearchmanager "github.com/nucleuscloud/neosync/backend/internal/elasticsearch"
 	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	gcpmanager "github.com/nucleuscloud/neosync/backend/internal/gcp"
+	kafkamanager "github.com/nucleuscloud/neosync/backend/internal/kafka"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/nucleuscloud/neosync/backend/pkg/binaryjson"
 	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
+const (
+	// the number of records read from the end of each partition when previewing a Kafka topic
+	defaultKafkaStreamTail = 100
+	// the number of documents read per request when streaming an Elasticsearch index
+	defaultElasticsearchPageSize = 1000
+)
+
 type DatabaseSchema struct {
 	TableSchema string `db:"table_schema,omitempty"`
 	TableName   string `db:"table_name,omitempty"`
@@ -33,21 +50,29 @@ type DatabaseSchema struct {
 	DataType    string `db:"data_type,omitempty"`
 }
 
-type DateScanner struct {
-	val *time.Time
-}
-
-func (ds *DateScanner) Scan(input any) error {
-	if input == nil {
-		return nil
+// Temporal values are selected as the text output of postgres so that microseconds, offsets and interval formats
+// are kept exactly as they are stored and can be inserted back into a column of the same type
+func buildPgStreamColumn(name string, dataTypeOID uint32) string {
+	switch dataTypeOID {
+	case pgtype.DateOID, pgtype.DateArrayOID,
+		pgtype.TimeOID, pgtype.TimeArrayOID,
+		pgtype.TimetzOID, pgtype.TimetzArrayOID,
+		pgtype.TimestampOID, pgtype.TimestampArrayOID,
+		pgtype.TimestamptzOID, pgtype.TimestamptzArrayOID,
+		pgtype.IntervalOID, pgtype.IntervalArrayOID:
+		return fmt.Sprintf("%s::text AS %s", sql_manager.EscapePgColumn(name), sql_manager.EscapePgColumn(name))
+	default:
+		return sql_manager.EscapePgColumn(name)
 	}
+}
 
-	switch input := input.(type) {
-	case time.Time:
-		*ds.val = input
-		return nil
+// Returns true for the column types that are able to hold NaN and infinite values
+func isPgNumericOID(dataTypeOID uint32) bool {
+	switch dataTypeOID {
+	case pgtype.Float4OID, pgtype.Float8OID, pgtype.NumericOID:
+		return true
 	default:
-		return fmt.Errorf("unable to scan type %T into DateScanner", input)
+		return false
 	}
 }
 
@@ -69,8 +94,41 @@ func (s *Service) GetConnectionDataStream(
 	if err != nil {
 		return err
 	}
+	ctx, release, err := s.acquireDataAccess(ctx, connection)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	page, err := getStreamPage(req.Msg)
+	if err != nil {
+		return err
+	}
+	if page.after != nil && connection.GetConnectionConfig().GetPgConfig() == nil && connection.GetConnectionConfig().GetMysqlConfig() == nil {
+		return nucleuserrors.NewBadRequest("resume tokens are only supported for Postgres and Mysql connections")
+	}
+	typed := req.Msg.GetRowFormat() == mgmtv1alpha1.StreamRowFormat_STREAM_ROW_FORMAT_TYPED
+	if typed && connection.GetConnectionConfig().GetPgConfig() == nil && connection.GetConnectionConfig().GetMysqlConfig() == nil {
+		return nucleuserrors.NewBadRequest("typed rows are only supported for Postgres and Mysql connections")
+	}
 
 	connectionTimeout := uint32(5)
+	columns := req.Msg.GetColumns()
+	sendRow := func(row map[string][]byte) error {
+		return stream.Send(&mgmtv1alpha1.GetConnectionDataStreamResponse{Row: row})
+	}
+	var encoder *typedRowEncoder
+	if typed {
+		encoder = newTypedRowEncoder(columns, s.cfg.NonFinitePolicy)
+		sendRow = func(row map[string][]byte) error {
+			return encoder.send(row, stream.Send)
+		}
+	}
+	setResumeToken := func(token string) {
+		stream.ResponseTrailer().Set(resumeTokenTrailer, token)
+	}
+	// sql connections only select the requested columns, the rest return every column of the row
+	send := newPagedSend(req.Msg.Schema, req.Msg.Table, columns, nil, setResumeToken, sendRow)
 
 	switch config := connection.ConnectionConfig.Config.(type) {
 	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
@@ -78,136 +136,367 @@ func (s *Service) GetConnectionDataStream(
 		if err != nil {
 			return err
 		}
-
-		conn, err := s.sqlConnector.NewDbFromConnectionConfig(connection.ConnectionConfig, &connectionTimeout, logger)
+		keyColumns, err := s.getStreamKeyColumns(ctx, logger, connection, req.Msg.Schema, req.Msg.Table, page)
 		if err != nil {
 			return err
 		}
-		defer conn.Close()
-		db, err := conn.Open()
-		if err != nil {
-			return err
+		// rows are read in batches by the primary key even when the stream is not paged by it
+		batchKeyColumns := keyColumns
+		if !page.isKeyset() {
+			batchKeyColumns, err = s.getTablePrimaryKey(ctx, logger, connection, req.Msg.Schema, req.Msg.Table)
+			if err != nil {
+				return err
+			}
 		}
+		send := newPagedSend(req.Msg.Schema, req.Msg.Table, columns, keyColumns, setResumeToken, sendRow)
 
-		// used to get column names
-		query := fmt.Sprintf("SELECT * FROM %s LIMIT 1;", sql_manager.BuildTable(req.Msg.Schema, req.Msg.Table))
-		r, err := db.QueryContext(ctx, query)
-		if err != nil && !nucleusdb.IsNoRows(err) {
-			return err
-		}
+		err = s.streamRows(ctx, logger, send, func(ctx context.Context, emit func(row map[string][]byte) error) error {
+			conn, err := s.sqlConnector.NewDbFromConnectionConfig(connection.ConnectionConfig, &connectionTimeout, logger)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			db, err := conn.Open()
+			if err != nil {
+				return err
+			}
+
+			return sql_manager.WithMysqlQueryCancel(ctx, db, func(ctx context.Context, db mysql_queries.DBTX) error {
+				// used to get column names
+				query := fmt.Sprintf("SELECT * FROM %s LIMIT 1;", sql_manager.QuoteTable(sql_manager.MysqlDriver, req.Msg.Schema, req.Msg.Table))
+				r, err := db.QueryContext(ctx, query)
+				if err != nil && !nucleusdb.IsNoRows(err) {
+					return err
+				}
+
+				columnTypes, err := r.ColumnTypes()
+				r.Close()
+				if err != nil {
+					return err
+				}
+				columnNames := make([]string, 0, len(columnTypes))
+				streamColumns := make([]*mgmtv1alpha1.StreamColumn, 0, len(columnTypes))
+				for _, columnType := range columnTypes {
+					columnNames = append(columnNames, columnType.Name())
+					streamColumns = append(streamColumns, getMysqlStreamColumn(columnType.Name(), columnType.DatabaseTypeName()))
+				}
+				columnNames, err = selectStreamColumns(columnNames, withKeyColumns(columns, batchKeyColumns))
+				if err != nil {
+					return err
+				}
+				if typed {
+					encoder.setColumns(streamColumns)
+				}
 
-		columnNames, err := r.Columns()
+				return queryMysqlBatches(ctx, db, req.Msg.Schema, req.Msg.Table, columnNames, batchKeyColumns, page, s.getStreamLimits().FetchSize, func(values [][]byte) error {
+					row := map[string][]byte{}
+					for i, v := range values {
+						col := columnNames[i]
+						row[col] = v
+					}
+					return emit(row)
+				})
+			})
+		})
 		if err != nil {
 			return err
 		}
+		return encoder.flush(stream.Send)
 
-		selectQuery := fmt.Sprintf("SELECT %s FROM %s;", strings.Join(columnNames, ", "), sql_manager.BuildTable(req.Msg.Schema, req.Msg.Table))
-		rows, err := db.QueryContext(ctx, selectQuery)
-		if err != nil && !nucleusdb.IsNoRows(err) {
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig, *mgmtv1alpha1.ConnectionConfig_SnowflakeConfig, *mgmtv1alpha1.ConnectionConfig_RedshiftConfig,
+		*mgmtv1alpha1.ConnectionConfig_DuckdbConfig, *mgmtv1alpha1.ConnectionConfig_SpannerConfig:
+		err := s.areSchemaAndTableValid(ctx, connection, req.Msg.Schema, req.Msg.Table)
+		if err != nil {
 			return err
 		}
+		driver := sql_manager.SqliteDriver
+		switch config.(type) {
+		case *mgmtv1alpha1.ConnectionConfig_SnowflakeConfig:
+			driver = sql_manager.SnowflakeDriver
+		case *mgmtv1alpha1.ConnectionConfig_RedshiftConfig:
+			driver = sql_manager.RedshiftDriver
+		case *mgmtv1alpha1.ConnectionConfig_DuckdbConfig:
+			driver = sql_manager.DuckdbDriver
+		case *mgmtv1alpha1.ConnectionConfig_SpannerConfig:
+			driver = sql_manager.SpannerDriver
+		}
+
+		return s.streamRows(ctx, logger, send, func(ctx context.Context, emit func(row map[string][]byte) error) error {
+			conn, err := s.sqlConnector.NewDbFromConnectionConfig(connection.ConnectionConfig, &connectionTimeout, logger)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			db, err := conn.Open()
+			if err != nil {
+				return err
+			}
+			if parquetDirectory := connection.GetConnectionConfig().GetDuckdbConfig().GetParquetDirectory(); parquetDirectory != "" {
+				if _, err := sql_manager.CreateDuckdbParquetViews(ctx, db, parquetDirectory); err != nil {
+					return err
+				}
+			}
 
-		for rows.Next() {
-			values := make([][]byte, len(columnNames))
-			valuesWrapped := make([]any, 0, len(columnNames))
-			for i := range values {
-				valuesWrapped = append(valuesWrapped, &values[i])
+			// used to get column names
+			query := fmt.Sprintf("SELECT * FROM %s LIMIT 1;", sql_manager.QuoteTable(driver, req.Msg.Schema, req.Msg.Table))
+			r, err := db.QueryContext(ctx, query)
+			if err != nil && !nucleusdb.IsNoRows(err) {
+				return err
 			}
-			if err := rows.Scan(valuesWrapped...); err != nil {
+
+			columnNames, err := r.Columns()
+			r.Close()
+			if err != nil {
 				return err
 			}
-			row := map[string][]byte{}
-			for i, v := range values {
-				col := columnNames[i]
-				row[col] = v
+			columnNames, err = selectStreamColumns(columnNames, columns)
+			if err != nil {
+				return err
 			}
 
-			if err := stream.Send(&mgmtv1alpha1.GetConnectionDataStreamResponse{Row: row}); err != nil {
+			selectCols := sql_manager.QuoteIdentifiers(driver, columnNames)
+			if driver == sql_manager.DuckdbDriver {
+				// the duckdb driver scans dates, decimals and uuids into types that can not be converted to bytes
+				for idx, col := range selectCols {
+					selectCols[idx] = fmt.Sprintf("CAST(%s AS TEXT) AS %s", col, col)
+				}
+			}
+			if driver == sql_manager.SpannerDriver {
+				// the spanner driver scans values into its own null types, which can not be converted to bytes
+				for idx, col := range selectCols {
+					selectCols[idx] = fmt.Sprintf("CAST(%s AS STRING) AS %s", col, col)
+				}
+			}
+			selectQuery, args := buildStreamSelectQuery(driver, req.Msg.Schema, req.Msg.Table, selectCols, nil, page)
+			rows, err := db.QueryContext(ctx, selectQuery, args...)
+			if err != nil && !nucleusdb.IsNoRows(err) {
 				return err
 			}
-		}
+			defer rows.Close()
+
+			for rows.Next() {
+				values := make([][]byte, len(columnNames))
+				valuesWrapped := make([]any, 0, len(columnNames))
+				for i := range values {
+					valuesWrapped = append(valuesWrapped, &values[i])
+				}
+				if err := rows.Scan(valuesWrapped...); err != nil {
+					return err
+				}
+				row := map[string][]byte{}
+				for i, v := range values {
+					col := columnNames[i]
+					row[col] = v
+				}
+
+				if err := emit(row); err != nil {
+					return err
+				}
+			}
+			return rows.Err()
+		})
 
 	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
 		err := s.areSchemaAndTableValid(ctx, connection, req.Msg.Schema, req.Msg.Table)
 		if err != nil {
 			return err
 		}
-
-		conn, err := s.sqlConnector.NewPgPoolFromConnectionConfig(config.PgConfig, &connectionTimeout, logger)
+		keyColumns, err := s.getStreamKeyColumns(ctx, logger, connection, req.Msg.Schema, req.Msg.Table, page)
 		if err != nil {
 			return err
 		}
-		db, err := conn.Open(ctx)
+		send := newPagedSend(req.Msg.Schema, req.Msg.Table, columns, keyColumns, setResumeToken, sendRow)
+
+		err = s.streamRows(ctx, logger, send, func(ctx context.Context, emit func(row map[string][]byte) error) error {
+			conn, err := s.sqlConnector.NewPgPoolFromConnectionConfig(config.PgConfig, &connectionTimeout, logger)
+			if err != nil {
+				return err
+			}
+			db, err := conn.Open(ctx)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			return sql_manager.WithPgQueryCancel(ctx, db, func(ctx context.Context, db pg_queries.DBTX) error {
+				// used to get column names
+				query := fmt.Sprintf("SELECT * FROM %s LIMIT 1;", sql_manager.QuoteTable(sql_manager.PostgresDriver, req.Msg.Schema, req.Msg.Table))
+				r, err := db.Query(ctx, query)
+				if err != nil && !nucleusdb.IsNoRows(err) {
+					return err
+				}
+				columnNames := []string{}
+				columnOids := map[string]uint32{}
+				for _, col := range r.FieldDescriptions() {
+					columnNames = append(columnNames, col.Name)
+					columnOids[col.Name] = col.DataTypeOID
+				}
+				// the connection is busy until the result is closed
+				r.Close()
+				columnNames, err = selectStreamColumns(columnNames, withKeyColumns(columns, keyColumns))
+				if err != nil {
+					return err
+				}
+				selectColumns := make([]string, 0, len(columnNames))
+				for _, col := range columnNames {
+					if typed {
+						selectColumns = append(selectColumns, buildPgTypedStreamColumn(col, columnOids[col]))
+					} else {
+						selectColumns = append(selectColumns, buildPgStreamColumn(col, columnOids[col]))
+					}
+				}
+				if typed {
+					streamColumns := make([]*mgmtv1alpha1.StreamColumn, 0, len(columnNames))
+					for _, col := range columnNames {
+						streamColumns = append(streamColumns, getPgStreamColumn(col, columnOids[col]))
+					}
+					encoder.setColumns(streamColumns)
+				}
+
+				selectQuery, args := buildStreamSelectQuery(sql_manager.PostgresDriver, req.Msg.Schema, req.Msg.Table, selectColumns, keyColumns, page)
+				return queryPgCursor(ctx, db, selectQuery, args, s.getStreamLimits().FetchSize, func(rows pgx.Rows) error {
+					values := make([][]byte, len(columnNames))
+					valuesWrapped := make([]any, 0, len(columnNames))
+					for i := range values {
+						valuesWrapped = append(valuesWrapped, &values[i])
+					}
+
+					if err := rows.Scan(valuesWrapped...); err != nil {
+						return err
+					}
+					row := map[string][]byte{}
+					for i, v := range values {
+						col := columnNames[i]
+						if rows.FieldDescriptions()[i].DataTypeOID == pgtype.UUIDOID {
+							// Convert the byte slice to a uuid.UUID type
+							uuidValue, err := uuid.FromBytes(v)
+							if err == nil {
+								row[col] = []byte(uuidValue.String())
+							} else {
+								row[col] = v
+							}
+						} else if isPgNumericOID(rows.FieldDescriptions()[i].DataTypeOID) {
+							value, err := s.cfg.NonFinitePolicy.ApplyText(v)
+							if err != nil {
+								return fmt.Errorf("unable to stream column %s: %w", col, err)
+							}
+							row[col] = value
+						} else {
+							row[col] = v
+						}
+					}
+					return emit(row)
+				})
+			})
+		})
 		if err != nil {
 			return err
 		}
-		defer conn.Close()
+		return encoder.flush(stream.Send)
 
-		// used to get column names
-		query := fmt.Sprintf("SELECT * FROM %s LIMIT 1;", sql_manager.BuildTable(req.Msg.Schema, req.Msg.Table))
-		r, err := db.Query(ctx, query)
-		if err != nil && !nucleusdb.IsNoRows(err) {
+	case *mgmtv1alpha1.ConnectionConfig_BigqueryConfig:
+		err := s.areSchemaAndTableValid(ctx, connection, req.Msg.Schema, req.Msg.Table)
+		if err != nil {
 			return err
 		}
-		defer r.Close()
 
-		columnNames := []string{}
-		for _, col := range r.FieldDescriptions() {
-			columnNames = append(columnNames, col.Name)
-		}
+		return s.streamRows(ctx, logger, send, pageRows(page, func(ctx context.Context, emit func(row map[string][]byte) error) error {
+			client, err := s.gcpManager.GetBigQueryClient(ctx, config.BigqueryConfig)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
 
-		selectQuery := fmt.Sprintf("SELECT %s FROM %s;", strings.Join(columnNames, ", "), sql_manager.BuildTable(req.Msg.Schema, req.Msg.Table))
-		rows, err := db.Query(ctx, selectQuery)
-		if err != nil && !nucleusdb.IsNoRows(err) {
+			// rows are read through the storage read API, which streams the table instead of paging through query results
+			return client.StreamTableRows(ctx, req.Msg.Schema, req.Msg.Table, func(data map[string]any) error {
+				row := make(map[string][]byte, len(data))
+				for col, v := range data {
+					value, err := s.cfg.NonFinitePolicy.ApplyJSON(v)
+					if err != nil {
+						return fmt.Errorf("unable to stream column %s: %w", col, err)
+					}
+					byteValue, err := getBigQueryValueBytes(value)
+					if err != nil {
+						return fmt.Errorf("unable to stream column %s: %w", col, err)
+					}
+					row[col] = byteValue
+				}
+				return emit(row)
+			})
+		}))
+
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		err := s.areSchemaAndTableValid(ctx, connection, req.Msg.Schema, req.Msg.Table)
+		if err != nil {
 			return err
 		}
-		defer rows.Close()
-
-		for rows.Next() {
-			values := make([][]byte, len(columnNames))
-			valuesWrapped := make([]any, 0, len(columnNames))
+		// the data type of each column decides how it is selected and encoded
+		tableColumns, err := s.getConnectionTableSchema(ctx, connection, req.Msg.Schema, req.Msg.Table, logger)
+		if err != nil {
+			return err
+		}
+		columnsByName := make(map[string]*mgmtv1alpha1.DatabaseColumn, len(tableColumns))
+		columnNames := make([]string, 0, len(tableColumns))
+		for _, col := range tableColumns {
+			columnsByName[col.Column] = col
+			columnNames = append(columnNames, col.Column)
+		}
+		columnNames, err = selectStreamColumns(columnNames, columns)
+		if err != nil {
+			return err
+		}
+		selectedColumns := make([]*mgmtv1alpha1.DatabaseColumn, 0, len(columnNames))
+		for _, col := range columnNames {
+			selectedColumns = append(selectedColumns, columnsByName[col])
+		}
 
-			for i, col := range r.FieldDescriptions() {
-				if col.DataTypeOID == 1082 { // OID for date
-					var t time.Time
-					ds := DateScanner{val: &t}
-					valuesWrapped = append(valuesWrapped, &ds)
-				} else {
-					valuesWrapped = append(valuesWrapped, &values[i])
-				}
+		return s.streamRows(ctx, logger, send, func(ctx context.Context, emit func(row map[string][]byte) error) error {
+			conn, err := s.sqlConnector.NewDbFromConnectionConfig(connection.ConnectionConfig, &connectionTimeout, logger)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			db, err := conn.Open()
+			if err != nil {
+				return err
 			}
 
-			if err := rows.Scan(valuesWrapped...); err != nil {
+			selectCols := make([]string, 0, len(selectedColumns))
+			for _, col := range selectedColumns {
+				selectCols = append(selectCols, sql_manager.BuildMssqlSelectColumn(col.Column, col.DataType))
+			}
+			selectQuery, args := buildStreamSelectQuery(sql_manager.MssqlDriver, req.Msg.Schema, req.Msg.Table, selectCols, nil, page)
+			rows, err := db.QueryContext(ctx, selectQuery, args...)
+			if err != nil && !nucleusdb.IsNoRows(err) {
 				return err
 			}
-			row := map[string][]byte{}
-			for i, v := range values {
-				col := columnNames[i]
-				if r.FieldDescriptions()[i].DataTypeOID == 1082 { // OID for date
-					// Convert time.Time value to []byte
-					if ds, ok := valuesWrapped[i].(*DateScanner); ok && ds.val != nil {
-						row[col] = []byte(ds.val.Format(time.RFC3339))
-					} else {
-						row[col] = v
-					}
-				} else if r.FieldDescriptions()[i].DataTypeOID == 2950 { // OID for UUID
-					// Convert the byte slice to a uuid.UUID type
-					uuidValue, err := uuid.FromBytes(v)
-					if err == nil {
-						row[col] = []byte(uuidValue.String())
+			defer rows.Close()
+
+			for rows.Next() {
+				values := make([][]byte, len(selectedColumns))
+				valuesWrapped := make([]any, 0, len(selectedColumns))
+				for i := range values {
+					valuesWrapped = append(valuesWrapped, &values[i])
+				}
+				if err := rows.Scan(valuesWrapped...); err != nil {
+					return err
+				}
+				row := map[string][]byte{}
+				for i, v := range values {
+					col := selectedColumns[i]
+					if sql_manager.IsMssqlBinaryType(col.DataType) {
+						row[col.Column] = sql_manager.EncodeMssqlBinary(v)
 					} else {
-						row[col] = v
+						row[col.Column] = v
 					}
-				} else {
-					row[col] = v
 				}
-			}
 
-			if err := stream.Send(&mgmtv1alpha1.GetConnectionDataStreamResponse{Row: row}); err != nil {
-				return err
+				if err := emit(row); err != nil {
+					return err
+				}
 			}
-		}
-		return nil
+			return rows.Err()
+		})
 
 	case *mgmtv1alpha1.ConnectionConfig_AwsS3Config:
 		awsS3StreamCfg := req.Msg.StreamConfig.GetAwsS3Config()
@@ -239,93 +528,190 @@ func (s *Service) GetConnectionDataStream(
 
 		tableName := sql_manager.BuildTable(req.Msg.Schema, req.Msg.Table)
 		path := fmt.Sprintf("workflows/%s/activities/%s/data", jobRunId, tableName)
-		var pageToken *string
-		for {
-			output, err := s.awsManager.ListObjectsV2(ctx, s3Client, awsS3Config.Region, &s3.ListObjectsV2Input{
-				Bucket:            aws.String(awsS3Config.Bucket),
-				Prefix:            aws.String(path),
-				ContinuationToken: pageToken,
-			})
-			if err != nil {
-				return err
-			}
-			if output == nil {
-				logger.Info(fmt.Sprintf("0 files found for path: %s", path))
-				break
-			}
-			for _, item := range output.Contents {
-				result, err := s.awsManager.GetObject(ctx, s3Client, awsS3Config.Region, &s3.GetObjectInput{
-					Bucket: aws.String(awsS3Config.Bucket),
-					Key:    aws.String(*item.Key),
+		return s.streamRows(ctx, logger, send, pageRows(page, func(ctx context.Context, emit func(row map[string][]byte) error) error {
+			var pageToken *string
+			for {
+				output, err := s.awsManager.ListObjectsV2(ctx, s3Client, awsS3Config.Region, &s3.ListObjectsV2Input{
+					Bucket:            aws.String(awsS3Config.Bucket),
+					Prefix:            aws.String(path),
+					ContinuationToken: pageToken,
 				})
 				if err != nil {
 					return err
 				}
-
-				gzr, err := gzip.NewReader(result.Body)
-				if err != nil {
-					result.Body.Close()
-					return fmt.Errorf("error creating gzip reader: %w", err)
+				if output == nil {
+					logger.Info(fmt.Sprintf("0 files found for path: %s", path))
+					return nil
 				}
-
-				scanner := bufio.NewScanner(gzr)
-				for scanner.Scan() {
-					line := scanner.Bytes()
-					var data map[string]any
-					err = json.Unmarshal(line, &data)
+				for _, item := range output.Contents {
+					result, err := s.awsManager.GetObject(ctx, s3Client, awsS3Config.Region, &s3.GetObjectInput{
+						Bucket: aws.String(awsS3Config.Bucket),
+						Key:    aws.String(*item.Key),
+					})
 					if err != nil {
-						result.Body.Close()
-						gzr.Close()
 						return err
 					}
 
-					rowMap := make(map[string][]byte)
-					for key, value := range data {
-						var byteValue []byte
-						if str, ok := value.(string); ok {
-							// try converting string directly to []byte
-							// prevents quoted strings
-							byteValue = []byte(str)
-						} else {
-							// if not a string use JSON encoding
-							byteValue, err = json.Marshal(value)
-							if err != nil {
-								result.Body.Close()
-								gzr.Close()
-								return err
-							}
-							if string(byteValue) == "null" {
-								byteValue = nil
-							}
-						}
-						rowMap[key] = byteValue
-					}
-					if err := stream.Send(&mgmtv1alpha1.GetConnectionDataStreamResponse{Row: rowMap}); err != nil {
-						result.Body.Close()
-						gzr.Close()
+					err = streamGzipJsonlRows(result.Body, emit)
+					result.Body.Close()
+					if err != nil {
 						return err
 					}
 				}
-				if err := scanner.Err(); err != nil {
-					result.Body.Close()
-					gzr.Close()
-					return err
+				if *output.IsTruncated {
+					pageToken = output.NextContinuationToken
+					continue
 				}
-				result.Body.Close()
-				gzr.Close()
+				return nil
 			}
-			if *output.IsTruncated {
-				pageToken = output.NextContinuationToken
-				continue
+		}))
+
+	case *mgmtv1alpha1.ConnectionConfig_GcpCloudstorageConfig:
+		gcsStreamCfg := req.Msg.StreamConfig.GetGcpCloudstorageConfig()
+		if gcsStreamCfg == nil {
+			return nucleuserrors.NewBadRequest("jobId or jobRunId required for GCP Cloud Storage connections")
+		}
+
+		gcsConfig := config.GcpCloudstorageConfig
+		client, err := s.gcpManager.GetStorageClient(ctx, gcsConfig)
+		if err != nil {
+			logger.Error("unable to create GCP Cloud Storage client")
+			return err
+		}
+		defer client.Close()
+		logger.Info("created GCP Cloud Storage client")
+
+		var jobRunId string
+		switch id := gcsStreamCfg.Id.(type) {
+		case *mgmtv1alpha1.GcpCloudStorageStreamConfig_JobRunId:
+			jobRunId = id.JobRunId
+		case *mgmtv1alpha1.GcpCloudStorageStreamConfig_JobId:
+			runId, err := s.getLatestJobRunFromObjectStore(ctx, logger, client, gcsConfig.GetPathPrefix(), id.JobId)
+			if err != nil {
+				return err
 			}
-			break
+			jobRunId = runId
+		default:
+			return nucleuserrors.NewInternalError("unsupported GCP Cloud Storage config id")
+		}
+
+		path := fmt.Sprintf("%s%s/data", getJobRunPath(gcsConfig.GetPathPrefix(), jobRunId), sql_manager.BuildTable(req.Msg.Schema, req.Msg.Table))
+		return s.streamRows(ctx, logger, send, pageRows(page, func(ctx context.Context, emit func(row map[string][]byte) error) error {
+			return streamJobRunObjects(ctx, logger, client, path, emit)
+		}))
+
+	case *mgmtv1alpha1.ConnectionConfig_AzureBlobConfig:
+		azStreamCfg := req.Msg.StreamConfig.GetAzureBlobConfig()
+		if azStreamCfg == nil {
+			return nucleuserrors.NewBadRequest("jobId or jobRunId required for Azure Blob Storage connections")
+		}
+
+		azConfig := config.AzureBlobConfig
+		client, err := s.azureManager.GetBlobClient(ctx, azConfig)
+		if err != nil {
+			logger.Error("unable to create Azure Blob Storage client")
+			return err
 		}
+		logger.Info("created Azure Blob Storage client")
+
+		var jobRunId string
+		switch id := azStreamCfg.Id.(type) {
+		case *mgmtv1alpha1.AzureBlobStorageStreamConfig_JobRunId:
+			jobRunId = id.JobRunId
+		case *mgmtv1alpha1.AzureBlobStorageStreamConfig_JobId:
+			runId, err := s.getLatestJobRunFromObjectStore(ctx, logger, client, azConfig.GetPathPrefix(), id.JobId)
+			if err != nil {
+				return err
+			}
+			jobRunId = runId
+		default:
+			return nucleuserrors.NewInternalError("unsupported Azure Blob Storage config id")
+		}
+
+		path := fmt.Sprintf("%s%s/data", getJobRunPath(azConfig.GetPathPrefix(), jobRunId), sql_manager.BuildTable(req.Msg.Schema, req.Msg.Table))
+		return s.streamRows(ctx, logger, send, pageRows(page, func(ctx context.Context, emit func(row map[string][]byte) error) error {
+			return streamJobRunObjects(ctx, logger, client, path, emit)
+		}))
+
+	case *mgmtv1alpha1.ConnectionConfig_KafkaConfig:
+		reader, err := s.kafkaManager.GetTopicReader(ctx, config.KafkaConfig)
+		if err != nil {
+			logger.Error("unable to create Kafka topic reader")
+			return err
+		}
+		logger.Info("created Kafka topic reader")
+
+		tail := int64(defaultKafkaStreamTail)
+		if kafkaStreamCfg := req.Msg.GetStreamConfig().GetKafkaConfig(); kafkaStreamCfg != nil && kafkaStreamCfg.Tail != nil {
+			tail = int64(kafkaStreamCfg.GetTail())
+		}
+		return s.streamRows(ctx, logger, send, pageRows(page, func(ctx context.Context, emit func(row map[string][]byte) error) error {
+			return reader.Tail(ctx, tail, func(record *kafkamanager.Record) error {
+				if !isKafkaRecordForTable(record, req.Msg.Schema, req.Msg.Table) {
+					return nil
+				}
+				row, err := toStreamRow(record.Value)
+				if err != nil {
+					return err
+				}
+				return emit(row)
+			})
+		}))
+
+	case *mgmtv1alpha1.ConnectionConfig_ElasticsearchConfig:
+		err := s.areSchemaAndTableValid(ctx, connection, req.Msg.Schema, req.Msg.Table)
+		if err != nil {
+			return err
+		}
+
+		client, err := s.elasticsearchManager.GetClient(ctx, config.ElasticsearchConfig)
+		if err != nil {
+			logger.Error("unable to create Elasticsearch client")
+			return err
+		}
+		logger.Info("created Elasticsearch client")
+
+		pageSize := defaultElasticsearchPageSize
+		if esStreamCfg := req.Msg.GetStreamConfig().GetElasticsearchConfig(); esStreamCfg != nil && esStreamCfg.PageSize != nil {
+			pageSize = int(esStreamCfg.GetPageSize())
+		}
+		return s.streamRows(ctx, logger, send, pageRows(page, func(ctx context.Context, emit func(row map[string][]byte) error) error {
+			return client.StreamDocuments(ctx, req.Msg.Table, pageSize, func(document map[string]any) error {
+				row, err := toStreamRow(document)
+				if err != nil {
+					return err
+				}
+				return emit(row)
+			})
+		}))
 
 	default:
 		return nucleuserrors.NewNotImplemented("this connection config is not currently supported")
 	}
+}
 
-	return nil
+// Jobs write the table of each record to its headers. Records without them were not written by a job and are always kept
+func isKafkaRecordForTable(record *kafkamanager.Record, schema, table string) bool {
+	recordSchema, hasSchema := record.Headers[kafkamanager.SchemaHeader]
+	recordTable, hasTable := record.Headers[kafkamanager.TableHeader]
+	if !hasSchema || !hasTable {
+		return true
+	}
+	return recordSchema == schema && recordTable == table
+}
+
+// Converts a BigQuery value into the bytes sent on the data stream. Strings and bytes are sent as is, everything else is JSON encoded
+func getBigQueryValueBytes(value any) ([]byte, error) {
+	switch t := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []byte(t), nil
+	case []byte:
+		return t, nil
+	default:
+		return json.Marshal(t)
+	}
 }
 
 func (s *Service) GetConnectionSchema(
@@ -345,19 +731,25 @@ func (s *Service) GetConnectionSchema(
 	if err != nil {
 		return nil, err
 	}
+	ctx, release, err := s.acquireDataAccess(ctx, connection)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 
 	switch config := connection.ConnectionConfig.Config.(type) {
-	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_PgConfig:
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_SqliteConfig, *mgmtv1alpha1.ConnectionConfig_SnowflakeConfig,
+		*mgmtv1alpha1.ConnectionConfig_RedshiftConfig, *mgmtv1alpha1.ConnectionConfig_DuckdbConfig, *mgmtv1alpha1.ConnectionConfig_SpannerConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
 		connectionTimeout := 5
 		db, err := s.sqlmanager.NewSqlDb(ctx, logger, connection, &connectionTimeout)
 		if err != nil {
-			return nil, err
+			return nil, withConnectionErrorCode(err)
 		}
 		defer db.Db.Close()
 
 		dbschema, err := db.Db.GetDatabaseSchema(ctx)
 		if err != nil {
-			return nil, err
+			return nil, withConnectionErrorCode(err)
 		}
 
 		schemas := []*mgmtv1alpha1.DatabaseColumn{}
@@ -366,6 +758,10 @@ func (s *Service) GetConnectionSchema(
 			if col.ColumnDefault != "" {
 				defaultColumn = &col.ColumnDefault
 			}
+			var baseDataType *string
+			if col.BaseDataType != "" {
+				baseDataType = &col.BaseDataType
+			}
 
 			schemas = append(schemas, &mgmtv1alpha1.DatabaseColumn{
 				Schema:        col.TableSchema,
@@ -375,6 +771,8 @@ func (s *Service) GetConnectionSchema(
 				IsNullable:    col.IsNullable,
 				ColumnDefault: defaultColumn,
 				GeneratedType: col.GeneratedType,
+				TableType:     col.TableType,
+				BaseDataType:  baseDataType,
 			})
 		}
 
@@ -382,6 +780,22 @@ func (s *Service) GetConnectionSchema(
 			Schemas: schemas,
 		}), nil
 
+	case *mgmtv1alpha1.ConnectionConfig_BigqueryConfig:
+		client, err := s.gcpManager.GetBigQueryClient(ctx, config.BigqueryConfig)
+		if err != nil {
+			return nil, withConnectionErrorCode(err)
+		}
+		defer client.Close()
+
+		columns, err := client.GetDatabaseSchema(ctx)
+		if err != nil {
+			return nil, withConnectionErrorCode(err)
+		}
+
+		return connect.NewResponse(&mgmtv1alpha1.GetConnectionSchemaResponse{
+			Schemas: toBigQueryDatabaseColumns(columns),
+		}), nil
+
 	case *mgmtv1alpha1.ConnectionConfig_AwsS3Config:
 		awsCfg := req.Msg.SchemaConfig.GetAwsS3Config()
 		if awsCfg == nil {
@@ -431,6 +845,22 @@ func (s *Service) GetConnectionSchema(
 				tableFolder := strings.ReplaceAll(folders[len(folders)-1], "/", "")
 				schemaTableList := strings.Split(tableFolder, ".")
 
+				// runs that wrote a manifest record their columns, which avoids sampling a data file
+				manifest, err := s.getTableRunManifest(ctx, s3Client, awsS3Config, jobRunId, sql_manager.BuildTable(schemaTableList[0], schemaTableList[1]))
+				if err != nil {
+					return nil, err
+				}
+				if len(manifest.GetColumns()) > 0 {
+					for _, column := range manifest.GetColumns() {
+						schemas = append(schemas, &mgmtv1alpha1.DatabaseColumn{
+							Schema: schemaTableList[0],
+							Table:  schemaTableList[1],
+							Column: column,
+						})
+					}
+					continue
+				}
+
 				filePath := fmt.Sprintf("%s%s/data", path, sql_manager.BuildTable(schemaTableList[0], schemaTableList[1]))
 				out, err := s.awsManager.ListObjectsV2(ctx, s3Client, awsS3Config.Region, &s3.ListObjectsV2Input{
 					Bucket:  aws.String(awsS3Config.Bucket),
@@ -452,38 +882,18 @@ func (s *Service) GetConnectionSchema(
 					return nil, err
 				}
 
-				gzr, err := gzip.NewReader(result.Body)
+				columns, err := getGzipJsonlColumns(result.Body)
+				result.Body.Close()
 				if err != nil {
-					result.Body.Close()
-					return nil, fmt.Errorf("error creating gzip reader: %w", err)
-				}
-
-				scanner := bufio.NewScanner(gzr)
-				if scanner.Scan() {
-					line := scanner.Bytes()
-					var data map[string]any
-					err = json.Unmarshal(line, &data)
-					if err != nil {
-						result.Body.Close()
-						gzr.Close()
-						return nil, err
-					}
-
-					for key := range data {
-						schemas = append(schemas, &mgmtv1alpha1.DatabaseColumn{
-							Schema: schemaTableList[0],
-							Table:  schemaTableList[1],
-							Column: key,
-						})
-					}
-				}
-				if err := scanner.Err(); err != nil {
-					result.Body.Close()
-					gzr.Close()
 					return nil, err
 				}
-				result.Body.Close()
-				gzr.Close()
+				for _, column := range columns {
+					schemas = append(schemas, &mgmtv1alpha1.DatabaseColumn{
+						Schema: schemaTableList[0],
+						Table:  schemaTableList[1],
+						Column: column,
+					})
+				}
 			}
 			if *output.IsTruncated {
 				pageToken = output.NextContinuationToken
@@ -495,6 +905,92 @@ func (s *Service) GetConnectionSchema(
 			Schemas: schemas,
 		}), nil
 
+	case *mgmtv1alpha1.ConnectionConfig_GcpCloudstorageConfig:
+		gcsCfg := req.Msg.GetSchemaConfig().GetGcpCloudstorageConfig()
+		if gcsCfg == nil {
+			return nil, nucleuserrors.NewBadRequest("jobId or jobRunId required for GCP Cloud Storage connections")
+		}
+
+		gcsConfig := config.GcpCloudstorageConfig
+		client, err := s.gcpManager.GetStorageClient(ctx, gcsConfig)
+		if err != nil {
+			return nil, err
+		}
+		defer client.Close()
+		logger.Info("created GCP Cloud Storage client")
+
+		var jobRunId string
+		switch id := gcsCfg.Id.(type) {
+		case *mgmtv1alpha1.GcpCloudStorageSchemaConfig_JobRunId:
+			jobRunId = id.JobRunId
+		case *mgmtv1alpha1.GcpCloudStorageSchemaConfig_JobId:
+			runId, err := s.getLatestJobRunFromObjectStore(ctx, logger, client, gcsConfig.GetPathPrefix(), id.JobId)
+			if err != nil {
+				return nil, err
+			}
+			jobRunId = runId
+		default:
+			return nil, nucleuserrors.NewInternalError("unsupported GCP Cloud Storage config id")
+		}
+
+		schemas, err := getJobRunObjectsSchema(ctx, client, getJobRunPath(gcsConfig.GetPathPrefix(), jobRunId))
+		if err != nil {
+			return nil, err
+		}
+		return connect.NewResponse(&mgmtv1alpha1.GetConnectionSchemaResponse{
+			Schemas: schemas,
+		}), nil
+
+	case *mgmtv1alpha1.ConnectionConfig_AzureBlobConfig:
+		azCfg := req.Msg.GetSchemaConfig().GetAzureBlobConfig()
+		if azCfg == nil {
+			return nil, nucleuserrors.NewBadRequest("jobId or jobRunId required for Azure Blob Storage connections")
+		}
+
+		azConfig := config.AzureBlobConfig
+		client, err := s.azureManager.GetBlobClient(ctx, azConfig)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info("created Azure Blob Storage client")
+
+		var jobRunId string
+		switch id := azCfg.Id.(type) {
+		case *mgmtv1alpha1.AzureBlobStorageSchemaConfig_JobRunId:
+			jobRunId = id.JobRunId
+		case *mgmtv1alpha1.AzureBlobStorageSchemaConfig_JobId:
+			runId, err := s.getLatestJobRunFromObjectStore(ctx, logger, client, azConfig.GetPathPrefix(), id.JobId)
+			if err != nil {
+				return nil, err
+			}
+			jobRunId = runId
+		default:
+			return nil, nucleuserrors.NewInternalError("unsupported Azure Blob Storage config id")
+		}
+
+		schemas, err := getJobRunObjectsSchema(ctx, client, getJobRunPath(azConfig.GetPathPrefix(), jobRunId))
+		if err != nil {
+			return nil, err
+		}
+		return connect.NewResponse(&mgmtv1alpha1.GetConnectionSchemaResponse{
+			Schemas: schemas,
+		}), nil
+
+	case *mgmtv1alpha1.ConnectionConfig_ElasticsearchConfig:
+		client, err := s.elasticsearchManager.GetClient(ctx, config.ElasticsearchConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		fields, err := client.GetIndexFields(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return connect.NewResponse(&mgmtv1alpha1.GetConnectionSchemaResponse{
+			Schemas: toElasticsearchDatabaseColumns(fields),
+		}), nil
+
 	default:
 		return nil, nucleuserrors.NewNotImplemented("this connection config is not currently supported")
 	}
@@ -516,20 +1012,18 @@ func (s *Service) GetConnectionForeignConstraints(
 	if err != nil {
 		return nil, err
 	}
+	ctx, release, err := s.acquireDataAccess(ctx, connection.Msg.Connection)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 
 	schemaResp, err := s.getConnectionSchema(ctx, connection.Msg.Connection, &schemaOpts{})
 	if err != nil {
 		return nil, err
 	}
 
-	schemaMap := map[string]struct{}{}
-	for _, s := range schemaResp {
-		schemaMap[s.Schema] = struct{}{}
-	}
-	schemas := []string{}
-	for s := range schemaMap {
-		schemas = append(schemas, s)
-	}
+	schemaTables := getTableIdentifiers(schemaResp)
 
 	connectionTimeout := 5
 	db, err := s.sqlmanager.NewSqlDb(ctx, logger, connection.Msg.GetConnection(), &connectionTimeout)
@@ -537,33 +1031,54 @@ func (s *Service) GetConnectionForeignConstraints(
 		return nil, err
 	}
 	defer db.Db.Close()
-	foreignKeyMap, err := db.Db.GetForeignKeyConstraintsMap(ctx, schemas)
-	if err != nil {
-		return nil, err
-	}
 
 	tableConstraints := map[string]*mgmtv1alpha1.ForeignConstraintTables{}
-	for tableName, d := range foreignKeyMap {
-		tableConstraints[tableName] = &mgmtv1alpha1.ForeignConstraintTables{
-			Constraints: []*mgmtv1alpha1.ForeignConstraint{},
-		}
-		for _, constraint := range d {
-			for idx, col := range constraint.Columns {
-				tableConstraints[tableName].Constraints = append(tableConstraints[tableName].Constraints, &mgmtv1alpha1.ForeignConstraint{
-					Column: col, IsNullable: !constraint.NotNullable[idx], ForeignKey: &mgmtv1alpha1.ForeignKey{
-						Table:  constraint.ForeignKey.Table,
-						Column: constraint.ForeignKey.Columns[idx],
-					},
-				})
+	tables := []*mgmtv1alpha1.TableForeignConstraints{}
+	keys := getTableKeys(schemaTables)
+	for _, group := range getSchemaGroups(schemaTables) {
+		foreignKeyMap, err := db.Db.GetForeignKeyConstraintsMap(ctx, group.schemas)
+		if err != nil {
+			return nil, err
+		}
+
+		for tableName, d := range foreignKeyMap {
+			if _, ok := tableConstraints[tableName]; !ok {
+				tableConstraints[tableName] = &mgmtv1alpha1.ForeignConstraintTables{
+					Constraints: []*mgmtv1alpha1.ForeignConstraint{},
+				}
+			}
+			tableConstraints[tableName].Constraints = append(tableConstraints[tableName].Constraints, toColumnForeignConstraints(d, keys)...)
+		}
+		for _, table := range group.tables {
+			if d, ok := foreignKeyMap[sql_manager.BuildTable(table.Schema, table.Table)]; ok {
+				tables = append(tables, &mgmtv1alpha1.TableForeignConstraints{Table: table, Constraints: toColumnForeignConstraints(d, keys)})
 			}
 		}
 	}
 
 	return connect.NewResponse(&mgmtv1alpha1.GetConnectionForeignConstraintsResponse{
 		TableConstraints: tableConstraints,
+		Tables:           tables,
 	}), nil
 }
 
+// Flattens the foreign key constraints into one constraint per column
+func toColumnForeignConstraints(constraints []*sql_manager.ForeignConstraint, keys tableKeys) []*mgmtv1alpha1.ForeignConstraint {
+	output := []*mgmtv1alpha1.ForeignConstraint{}
+	for _, constraint := range constraints {
+		for idx, col := range constraint.Columns {
+			output = append(output, &mgmtv1alpha1.ForeignConstraint{
+				Column: col, IsNullable: !constraint.NotNullable[idx], ForeignKey: &mgmtv1alpha1.ForeignKey{
+					Table:           constraint.ForeignKey.Table,
+					Column:          constraint.ForeignKey.Columns[idx],
+					TableIdentifier: keys.resolve(constraint.ForeignKey.Table),
+				},
+			})
+		}
+	}
+	return output
+}
+
 func (s *Service) GetConnectionPrimaryConstraints(
 	ctx context.Context,
 	req *connect.Request[mgmtv1alpha1.GetConnectionPrimaryConstraintsRequest],
@@ -580,20 +1095,18 @@ func (s *Service) GetConnectionPrimaryConstraints(
 	if err != nil {
 		return nil, err
 	}
+	ctx, release, err := s.acquireDataAccess(ctx, connection.Msg.Connection)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 
 	schemaResp, err := s.getConnectionSchema(ctx, connection.Msg.Connection, &schemaOpts{})
 	if err != nil {
 		return nil, err
 	}
 
-	schemaMap := map[string]struct{}{}
-	for _, s := range schemaResp {
-		schemaMap[s.Schema] = struct{}{}
-	}
-	schemas := []string{}
-	for s := range schemaMap {
-		schemas = append(schemas, s)
-	}
+	schemaTables := getTableIdentifiers(schemaResp)
 
 	connectionTimeout := 5
 	db, err := s.sqlmanager.NewSqlDb(ctx, logger, connection.Msg.GetConnection(), &connectionTimeout)
@@ -602,20 +1115,37 @@ func (s *Service) GetConnectionPrimaryConstraints(
 	}
 	defer db.Db.Close()
 
-	primaryKeysMap, err := db.Db.GetPrimaryKeyConstraintsMap(ctx, schemas)
-	if err != nil {
-		return nil, err
-	}
-
 	tableConstraints := map[string]*mgmtv1alpha1.PrimaryConstraint{}
-	for tableName, cols := range primaryKeysMap {
-		tableConstraints[tableName] = &mgmtv1alpha1.PrimaryConstraint{
-			Columns: cols,
+	tables := []*mgmtv1alpha1.TablePrimaryConstraint{}
+	for _, group := range getSchemaGroups(schemaTables) {
+		primaryKeysMap, err := db.Db.GetPrimaryKeyConstraintsMap(ctx, group.schemas)
+		if err != nil {
+			return nil, err
+		}
+
+		for tableName, cols := range primaryKeysMap {
+			// tables that share a key are merged in the keyed map
+			if existing, ok := tableConstraints[tableName]; ok {
+				existing.Columns = append(existing.Columns, cols...)
+				continue
+			}
+			tableConstraints[tableName] = &mgmtv1alpha1.PrimaryConstraint{
+				Columns: cols,
+			}
+		}
+		for _, table := range group.tables {
+			if cols, ok := primaryKeysMap[sql_manager.BuildTable(table.Schema, table.Table)]; ok {
+				tables = append(tables, &mgmtv1alpha1.TablePrimaryConstraint{
+					Table:      table,
+					Constraint: &mgmtv1alpha1.PrimaryConstraint{Columns: cols},
+				})
+			}
 		}
 	}
 
 	return connect.NewResponse(&mgmtv1alpha1.GetConnectionPrimaryConstraintsResponse{
 		TableConstraints: tableConstraints,
+		Tables:           tables,
 	}), nil
 }
 
@@ -635,15 +1165,20 @@ func (s *Service) GetConnectionInitStatements(
 	if err != nil {
 		return nil, err
 	}
+	ctx, release, err := s.acquireDataAccess(ctx, connection.Msg.Connection)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 
 	schemaResp, err := s.getConnectionSchema(ctx, connection.Msg.Connection, &schemaOpts{})
 	if err != nil {
 		return nil, err
 	}
 
-	schemaTableMap := map[string]*mgmtv1alpha1.DatabaseColumn{}
-	for _, s := range schemaResp {
-		schemaTableMap[sql_manager.BuildTable(s.Schema, s.Table)] = s
+	tableStmts := []*mgmtv1alpha1.TableInitStatements{}
+	for _, table := range getTableIdentifiers(schemaResp) {
+		tableStmts = append(tableStmts, &mgmtv1alpha1.TableInitStatements{Table: table})
 	}
 
 	connectionTimeout := 5
@@ -653,50 +1188,139 @@ func (s *Service) GetConnectionInitStatements(
 	}
 	defer db.Db.Close()
 
-	createStmtsMap := map[string]string{}
-	truncateStmtsMap := map[string]string{}
+	extensionStmts := []string{}
 	if req.Msg.GetOptions().GetInitSchema() {
-		for k, v := range schemaTableMap {
-			stmt, err := db.Db.GetCreateTableStatement(ctx, v.Schema, v.Table)
+		for _, t := range tableStmts {
+			stmt, err := db.Db.GetCreateTableStatement(ctx, t.Table.Schema, t.Table.Table)
 			if err != nil {
 				return nil, err
 			}
-			createStmtsMap[k] = stmt
+			t.InitStatement = &stmt
 		}
 	}
 
 	switch connection.Msg.Connection.ConnectionConfig.Config.(type) {
 	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+		if charsetOverride := req.Msg.GetOptions().GetMysqlCharsetOverride(); charsetOverride != nil {
+			for _, t := range tableStmts {
+				if t.InitStatement == nil {
+					continue
+				}
+				stmt := sql_manager.ApplyMysqlCharsetOverride(t.GetInitStatement(), charsetOverride.GetCharset(), charsetOverride.Collation)
+				t.InitStatement = &stmt
+			}
+		}
 		if req.Msg.GetOptions().GetTruncateBeforeInsert() {
-			for k, v := range schemaTableMap {
-				stmt, err := sql_manager.BuildMysqlTruncateStatement(v.Schema, v.Table)
+			for _, t := range tableStmts {
+				stmt, err := sql_manager.BuildMysqlTruncateStatement(t.Table.Schema, t.Table.Table)
 				if err != nil {
 					return nil, err
 				}
-				truncateStmtsMap[k] = stmt
+				t.TruncateStatement = &stmt
 			}
 		}
 
 	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
 		if req.Msg.GetOptions().GetTruncateCascade() {
-			for k, v := range schemaTableMap {
-				stmt, err := sql_manager.BuildPgTruncateCascadeStatement(v.Schema, v.Table)
+			for _, t := range tableStmts {
+				stmt, err := sql_manager.BuildPgTruncateCascadeStatement(t.Table.Schema, t.Table.Table)
 				if err != nil {
 					return nil, err
 				}
-				truncateStmtsMap[k] = stmt
+				t.TruncateStatement = &stmt
 			}
 		} else if req.Msg.GetOptions().GetTruncateBeforeInsert() {
 			return nil, nucleuserrors.NewNotImplemented("postgres truncate unsupported. table foreig keys required to build truncate statement.")
 		}
+		if req.Msg.GetOptions().GetInitExtensions() {
+			extensions, err := db.Db.GetExtensions(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("unable to retrieve postgres extensions: %w", err)
+			}
+			for _, extension := range extensions {
+				extensionStmts = append(extensionStmts, sql_manager.BuildPgCreateExtensionStatement(extension))
+			}
+		}
+
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		if req.Msg.GetOptions().GetTruncateCascade() {
+			return nil, nucleuserrors.NewNotImplemented("sqlite truncate cascade unsupported. sqlite does not support TRUNCATE.")
+		} else if req.Msg.GetOptions().GetTruncateBeforeInsert() {
+			for _, t := range tableStmts {
+				stmt, err := sql_manager.BuildSqliteTruncateStatement(t.Table.Schema, t.Table.Table)
+				if err != nil {
+					return nil, err
+				}
+				t.TruncateStatement = &stmt
+			}
+		}
+
+	case *mgmtv1alpha1.ConnectionConfig_SnowflakeConfig:
+		if req.Msg.GetOptions().GetTruncateCascade() {
+			return nil, nucleuserrors.NewNotImplemented("snowflake truncate cascade unsupported. snowflake does not enforce foreign keys.")
+		} else if req.Msg.GetOptions().GetTruncateBeforeInsert() {
+			for _, t := range tableStmts {
+				stmt, err := sql_manager.BuildSnowflakeTruncateStatement(t.Table.Schema, t.Table.Table)
+				if err != nil {
+					return nil, err
+				}
+				t.TruncateStatement = &stmt
+			}
+		}
+
+	case *mgmtv1alpha1.ConnectionConfig_RedshiftConfig:
+		if req.Msg.GetOptions().GetTruncateCascade() {
+			return nil, nucleuserrors.NewNotImplemented("redshift truncate cascade unsupported. redshift does not enforce foreign keys.")
+		} else if req.Msg.GetOptions().GetTruncateBeforeInsert() {
+			for _, t := range tableStmts {
+				stmt, err := sql_manager.BuildRedshiftTruncateStatement(t.Table.Schema, t.Table.Table)
+				if err != nil {
+					return nil, err
+				}
+				t.TruncateStatement = &stmt
+			}
+		}
+
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		if req.Msg.GetOptions().GetTruncateCascade() {
+			return nil, nucleuserrors.NewNotImplemented("mssql truncate cascade unsupported. sql server does not support TRUNCATE ... CASCADE.")
+		} else if req.Msg.GetOptions().GetTruncateBeforeInsert() {
+			for _, t := range tableStmts {
+				stmt, err := sql_manager.BuildMssqlTruncateStatement(t.Table.Schema, t.Table.Table)
+				if err != nil {
+					return nil, err
+				}
+				t.TruncateStatement = &stmt
+			}
+		}
 
 	default:
 		return nil, errors.New("unsupported connection config")
 	}
 
+	// the keyed maps hold a single statement for tables that share the same <schema>.<table> key
+	createStmtsMap := map[string]string{}
+	truncateStmtsMap := map[string]string{}
+	tables := []*mgmtv1alpha1.TableInitStatements{}
+	for _, t := range tableStmts {
+		if t.InitStatement == nil && t.TruncateStatement == nil {
+			continue
+		}
+		key := sql_manager.BuildTable(t.Table.Schema, t.Table.Table)
+		if t.InitStatement != nil {
+			createStmtsMap[key] = t.GetInitStatement()
+		}
+		if t.TruncateStatement != nil {
+			truncateStmtsMap[key] = t.GetTruncateStatement()
+		}
+		tables = append(tables, t)
+	}
+
 	return connect.NewResponse(&mgmtv1alpha1.GetConnectionInitStatementsResponse{
 		TableInitStatements:     createStmtsMap,
 		TableTruncateStatements: truncateStmtsMap,
+		ExtensionInitStatements: extensionStmts,
+		Tables:                  tables,
 	}), nil
 }
 
@@ -712,14 +1336,56 @@ func (s *Service) getConnectionSchema(ctx context.Context, connection *mgmtv1alp
 	switch connection.ConnectionConfig.Config.(type) {
 	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
 		schemaReq.SchemaConfig = &mgmtv1alpha1.ConnectionSchemaConfig{
-			Config: &mgmtv1alpha1.ConnectionSchemaConfig_PgConfig{
-				PgConfig: &mgmtv1alpha1.PostgresSchemaConfig{},
+			Config: &mgmtv1alpha1.ConnectionSchemaConfig_PgConfig{
+				PgConfig: &mgmtv1alpha1.PostgresSchemaConfig{},
+			},
+		}
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+		schemaReq.SchemaConfig = &mgmtv1alpha1.ConnectionSchemaConfig{
+			Config: &mgmtv1alpha1.ConnectionSchemaConfig_MysqlConfig{
+				MysqlConfig: &mgmtv1alpha1.MysqlSchemaConfig{},
+			},
+		}
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		schemaReq.SchemaConfig = &mgmtv1alpha1.ConnectionSchemaConfig{
+			Config: &mgmtv1alpha1.ConnectionSchemaConfig_SqliteConfig{
+				SqliteConfig: &mgmtv1alpha1.SqliteSchemaConfig{},
+			},
+		}
+	case *mgmtv1alpha1.ConnectionConfig_SnowflakeConfig:
+		schemaReq.SchemaConfig = &mgmtv1alpha1.ConnectionSchemaConfig{
+			Config: &mgmtv1alpha1.ConnectionSchemaConfig_SnowflakeConfig{
+				SnowflakeConfig: &mgmtv1alpha1.SnowflakeSchemaConfig{},
+			},
+		}
+	case *mgmtv1alpha1.ConnectionConfig_RedshiftConfig:
+		schemaReq.SchemaConfig = &mgmtv1alpha1.ConnectionSchemaConfig{
+			Config: &mgmtv1alpha1.ConnectionSchemaConfig_RedshiftConfig{
+				RedshiftConfig: &mgmtv1alpha1.RedshiftSchemaConfig{},
+			},
+		}
+	case *mgmtv1alpha1.ConnectionConfig_DuckdbConfig:
+		schemaReq.SchemaConfig = &mgmtv1alpha1.ConnectionSchemaConfig{
+			Config: &mgmtv1alpha1.ConnectionSchemaConfig_DuckdbConfig{
+				DuckdbConfig: &mgmtv1alpha1.DuckdbSchemaConfig{},
+			},
+		}
+	case *mgmtv1alpha1.ConnectionConfig_SpannerConfig:
+		schemaReq.SchemaConfig = &mgmtv1alpha1.ConnectionSchemaConfig{
+			Config: &mgmtv1alpha1.ConnectionSchemaConfig_SpannerConfig{
+				SpannerConfig: &mgmtv1alpha1.SpannerSchemaConfig{},
+			},
+		}
+	case *mgmtv1alpha1.ConnectionConfig_BigqueryConfig:
+		schemaReq.SchemaConfig = &mgmtv1alpha1.ConnectionSchemaConfig{
+			Config: &mgmtv1alpha1.ConnectionSchemaConfig_BigqueryConfig{
+				BigqueryConfig: &mgmtv1alpha1.BigQuerySchemaConfig{},
 			},
 		}
-	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
 		schemaReq.SchemaConfig = &mgmtv1alpha1.ConnectionSchemaConfig{
-			Config: &mgmtv1alpha1.ConnectionSchemaConfig_MysqlConfig{
-				MysqlConfig: &mgmtv1alpha1.MysqlSchemaConfig{},
+			Config: &mgmtv1alpha1.ConnectionSchemaConfig_MssqlConfig{
+				MssqlConfig: &mgmtv1alpha1.MssqlSchemaConfig{},
 			},
 		}
 	case *mgmtv1alpha1.ConnectionConfig_AwsS3Config:
@@ -734,6 +1400,36 @@ func (s *Service) getConnectionSchema(ctx context.Context, connection *mgmtv1alp
 				AwsS3Config: cfg,
 			},
 		}
+	case *mgmtv1alpha1.ConnectionConfig_GcpCloudstorageConfig:
+		var cfg *mgmtv1alpha1.GcpCloudStorageSchemaConfig
+		if opts.JobRunId != nil && *opts.JobRunId != "" {
+			cfg = &mgmtv1alpha1.GcpCloudStorageSchemaConfig{Id: &mgmtv1alpha1.GcpCloudStorageSchemaConfig_JobRunId{JobRunId: *opts.JobRunId}}
+		} else if opts.JobId != nil && *opts.JobId != "" {
+			cfg = &mgmtv1alpha1.GcpCloudStorageSchemaConfig{Id: &mgmtv1alpha1.GcpCloudStorageSchemaConfig_JobId{JobId: *opts.JobId}}
+		}
+		schemaReq.SchemaConfig = &mgmtv1alpha1.ConnectionSchemaConfig{
+			Config: &mgmtv1alpha1.ConnectionSchemaConfig_GcpCloudstorageConfig{
+				GcpCloudstorageConfig: cfg,
+			},
+		}
+	case *mgmtv1alpha1.ConnectionConfig_AzureBlobConfig:
+		var cfg *mgmtv1alpha1.AzureBlobStorageSchemaConfig
+		if opts.JobRunId != nil && *opts.JobRunId != "" {
+			cfg = &mgmtv1alpha1.AzureBlobStorageSchemaConfig{Id: &mgmtv1alpha1.AzureBlobStorageSchemaConfig_JobRunId{JobRunId: *opts.JobRunId}}
+		} else if opts.JobId != nil && *opts.JobId != "" {
+			cfg = &mgmtv1alpha1.AzureBlobStorageSchemaConfig{Id: &mgmtv1alpha1.AzureBlobStorageSchemaConfig_JobId{JobId: *opts.JobId}}
+		}
+		schemaReq.SchemaConfig = &mgmtv1alpha1.ConnectionSchemaConfig{
+			Config: &mgmtv1alpha1.ConnectionSchemaConfig_AzureBlobConfig{
+				AzureBlobConfig: cfg,
+			},
+		}
+	case *mgmtv1alpha1.ConnectionConfig_ElasticsearchConfig:
+		schemaReq.SchemaConfig = &mgmtv1alpha1.ConnectionSchemaConfig{
+			Config: &mgmtv1alpha1.ConnectionSchemaConfig_ElasticsearchConfig{
+				ElasticsearchConfig: &mgmtv1alpha1.ElasticsearchSchemaConfig{},
+			},
+		}
 
 	default:
 		return nil, nucleuserrors.NewNotImplemented("this connection config is not currently supported")
@@ -801,11 +1497,73 @@ func (s *Service) getConnectionTableSchema(ctx context.Context, connection *mgmt
 			})
 		}
 		return schemas, nil
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig, *mgmtv1alpha1.ConnectionConfig_SnowflakeConfig, *mgmtv1alpha1.ConnectionConfig_RedshiftConfig,
+		*mgmtv1alpha1.ConnectionConfig_DuckdbConfig, *mgmtv1alpha1.ConnectionConfig_SpannerConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		connectionTimeout := int(conntimeout)
+		db, err := s.sqlmanager.NewSqlDb(ctx, logger, connection, &connectionTimeout)
+		if err != nil {
+			return nil, err
+		}
+		defer db.Db.Close()
+		dbschema, err := db.Db.GetDatabaseSchema(ctx)
+		if err != nil {
+			return nil, err
+		}
+		schemas := []*mgmtv1alpha1.DatabaseColumn{}
+		for _, col := range dbschema {
+			if col.TableSchema != schema || col.TableName != table {
+				continue
+			}
+			schemas = append(schemas, &mgmtv1alpha1.DatabaseColumn{
+				Schema:     col.TableSchema,
+				Table:      col.TableName,
+				Column:     col.ColumnName,
+				DataType:   col.DataType,
+				IsNullable: col.IsNullable,
+			})
+		}
+		return schemas, nil
 	default:
 		return nil, nucleuserrors.NewBadRequest("this connection config is not currently supported")
 	}
 }
 
+// BigQuery datasets are surfaced as schemas
+func toBigQueryDatabaseColumns(columns []*gcpmanager.BigQueryColumn) []*mgmtv1alpha1.DatabaseColumn {
+	schemas := make([]*mgmtv1alpha1.DatabaseColumn, 0, len(columns))
+	for _, col := range columns {
+		isNullable := "NO"
+		if col.IsNullable {
+			isNullable = "YES"
+		}
+		schemas = append(schemas, &mgmtv1alpha1.DatabaseColumn{
+			Schema:     col.Dataset,
+			Table:      col.Table,
+			Column:     col.Column,
+			DataType:   col.DataType,
+			IsNullable: isNullable,
+			TableType:  sql_manager.BaseTableType,
+		})
+	}
+	return schemas
+}
+
+// Elasticsearch indices are surfaced as the tables of a single schema. Documents may leave out any field, so every column is nullable
+func toElasticsearchDatabaseColumns(fields []*elasticsearchmanager.IndexField) []*mgmtv1alpha1.DatabaseColumn {
+	schemas := make([]*mgmtv1alpha1.DatabaseColumn, 0, len(fields))
+	for _, field := range fields {
+		schemas = append(schemas, &mgmtv1alpha1.DatabaseColumn{
+			Schema:     elasticsearchmanager.IndicesSchema,
+			Table:      field.Index,
+			Column:     field.Field,
+			DataType:   field.DataType,
+			IsNullable: "YES",
+			TableType:  sql_manager.BaseTableType,
+		})
+	}
+	return schemas
+}
+
 // returns the first job run id for a given job that is in S3
 func (s *Service) getLastestJobRunFromAwsS3(
 	ctx context.Context,
@@ -844,18 +1602,247 @@ func (s *Service) getLastestJobRunFromAwsS3(
 	return "", nucleuserrors.NewInternalError(fmt.Sprintf("unable to find latest job run for job: %s", jobId))
 }
 
+// The listing and reading of a bucket or container that job runs have been written to. Implemented by the
+// GCP Cloud Storage and Azure Blob Storage clients
+type jobRunObjectStore interface {
+	ListPrefixes(ctx context.Context, prefix string) ([]string, error)
+	ListObjects(ctx context.Context, prefix string) ([]string, error)
+	GetObject(ctx context.Context, name string) (io.ReadCloser, error)
+}
+
+// returns the most recent job run for a given job that has outputs in the object store
+func (s *Service) getLatestJobRunFromObjectStore(
+	ctx context.Context,
+	logger *slog.Logger,
+	store jobRunObjectStore,
+	pathPrefix string,
+	jobId string,
+) (string, error) {
+	jobRunsResp, err := s.jobService.GetJobRecentRuns(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRecentRunsRequest{
+		JobId: jobId,
+	}))
+	if err != nil {
+		return "", err
+	}
+	jobRuns := jobRunsResp.Msg.GetRecentRuns()
+
+	for i := len(jobRuns) - 1; i >= 0; i-- {
+		runId := jobRuns[i].JobRunId
+		prefixes, err := store.ListPrefixes(ctx, getJobRunPath(pathPrefix, runId))
+		if err != nil {
+			return "", err
+		}
+		if len(prefixes) > 0 {
+			logger.Info(fmt.Sprintf("found latest job run: %s", runId))
+			return runId, nil
+		}
+	}
+	return "", nucleuserrors.NewInternalError(fmt.Sprintf("unable to find latest job run for job: %s", jobId))
+}
+
+// Returns the folder that a job run writes its tables to, i.e. <path_prefix>/workflows/<runid>/activities/
+func getJobRunPath(pathPrefix, jobRunId string) string {
+	pieces := []string{}
+	if prefix := strings.Trim(pathPrefix, "/"); prefix != "" {
+		pieces = append(pieces, prefix)
+	}
+	pieces = append(pieces, "workflows", jobRunId, "activities")
+	return fmt.Sprintf("%s/", strings.Join(pieces, "/"))
+}
+
+// Returns the columns of every table folder beneath the job run path
+func getJobRunObjectsSchema(ctx context.Context, store jobRunObjectStore, path string) ([]*mgmtv1alpha1.DatabaseColumn, error) {
+	prefixes, err := store.ListPrefixes(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	schemas := []*mgmtv1alpha1.DatabaseColumn{}
+	for _, prefix := range prefixes {
+		tableFolder := strings.TrimSuffix(strings.TrimPrefix(prefix, path), "/")
+		schemaTableList := strings.SplitN(tableFolder, ".", 2)
+		if len(schemaTableList) != 2 {
+			continue
+		}
+
+		// the columns are sampled from the first line of the table's first data file
+		objects, err := store.ListObjects(ctx, fmt.Sprintf("%s%s/data", path, tableFolder))
+		if err != nil {
+			return nil, err
+		}
+		if len(objects) == 0 {
+			continue
+		}
+		reader, err := store.GetObject(ctx, objects[0])
+		if err != nil {
+			return nil, err
+		}
+		columns, err := getGzipJsonlColumns(reader)
+		reader.Close()
+		if err != nil {
+			return nil, err
+		}
+		for _, column := range columns {
+			schemas = append(schemas, &mgmtv1alpha1.DatabaseColumn{
+				Schema: schemaTableList[0],
+				Table:  schemaTableList[1],
+				Column: column,
+			})
+		}
+	}
+	return schemas, nil
+}
+
+// Emits the rows of every data file of a table, in the order they are listed
+func streamJobRunObjects(ctx context.Context, logger *slog.Logger, store jobRunObjectStore, path string, emit func(row map[string][]byte) error) error {
+	objects, err := store.ListObjects(ctx, path)
+	if err != nil {
+		return err
+	}
+	if len(objects) == 0 {
+		logger.Info(fmt.Sprintf("0 files found for path: %s", path))
+		return nil
+	}
+	for _, object := range objects {
+		reader, err := store.GetObject(ctx, object)
+		if err != nil {
+			return err
+		}
+		err = streamGzipJsonlRows(reader, emit)
+		reader.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Job runs are written to object storage as gzip'd files of JSON lines. Each line is emitted as a row
+func streamGzipJsonlRows(body io.Reader, emit func(row map[string][]byte) error) error {
+	gzr, err := gzip.NewReader(body)
+	if err != nil {
+		return fmt.Errorf("error creating gzip reader: %w", err)
+	}
+	defer gzr.Close()
+
+	scanner := bufio.NewScanner(gzr)
+	for scanner.Scan() {
+		var data map[string]any
+		err = json.Unmarshal(scanner.Bytes(), &data)
+		if err != nil {
+			return err
+		}
+
+		rowMap, err := toStreamRow(data)
+		if err != nil {
+			return err
+		}
+		if err := emit(rowMap); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// Converts a decoded JSON object into a row of the data stream
+func toStreamRow(data map[string]any) (map[string][]byte, error) {
+	rowMap := make(map[string][]byte, len(data))
+	for key, value := range data {
+		var byteValue []byte
+		if bits, ok, err := binaryjson.Decode(value); ok {
+			if err != nil {
+				return nil, err
+			}
+			byteValue = bits
+		} else if str, ok := value.(string); ok {
+			// try converting string directly to []byte
+			// prevents quoted strings
+			byteValue = []byte(str)
+		} else {
+			// if not a string use JSON encoding
+			byteValue, err = json.Marshal(value)
+			if err != nil {
+				return nil, err
+			}
+			if string(byteValue) == "null" {
+				byteValue = nil
+			}
+		}
+		rowMap[key] = byteValue
+	}
+	return rowMap, nil
+}
+
+// Returns the sorted keys of the first line of a gzip'd JSON lines file, or nil if the file is empty
+func getGzipJsonlColumns(body io.Reader) ([]string, error) {
+	gzr, err := gzip.NewReader(body)
+	if err != nil {
+		return nil, fmt.Errorf("error creating gzip reader: %w", err)
+	}
+	defer gzr.Close()
+
+	scanner := bufio.NewScanner(gzr)
+	if !scanner.Scan() {
+		return nil, scanner.Err()
+	}
+	var data map[string]any
+	if err := json.Unmarshal(scanner.Bytes(), &data); err != nil {
+		return nil, err
+	}
+	columns := make([]string, 0, len(data))
+	for key := range data {
+		columns = append(columns, key)
+	}
+	sort.Strings(columns)
+	return columns, nil
+}
+
 func (s *Service) areSchemaAndTableValid(ctx context.Context, connection *mgmtv1alpha1.Connection, schema, table string) error {
 	schemas, err := s.getConnectionSchema(ctx, connection, &schemaOpts{})
 	if err != nil {
 		return err
 	}
 
-	if !isValidSchema(schema, schemas) || !isValidTable(table, schemas) {
-		return nucleuserrors.NewBadRequest("must provide valid schema and table")
+	if !isValidSchema(schema, schemas) {
+		return nucleuserrors.WithErrorCode(nucleuserrors.NewBadRequest(fmt.Sprintf("must provide valid schema and table: schema %q does not exist", schema)), mgmtv1alpha1.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND)
+	}
+	if !isValidTable(table, schemas) {
+		return nucleuserrors.WithErrorCode(nucleuserrors.NewBadRequest(fmt.Sprintf("must provide valid schema and table: table %q does not exist", table)), mgmtv1alpha1.ErrorCode_ERROR_CODE_TABLE_NOT_FOUND)
 	}
 	return nil
 }
 
+// Returns the requested columns in the order they were requested, or every column of the table when none were requested
+func selectStreamColumns(tableColumns, requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return tableColumns, nil
+	}
+	existing := make(map[string]struct{}, len(tableColumns))
+	for _, col := range tableColumns {
+		existing[col] = struct{}{}
+	}
+	for _, col := range requested {
+		if _, ok := existing[col]; !ok {
+			return nil, nucleuserrors.WithErrorCode(nucleuserrors.NewBadRequest(fmt.Sprintf("must provide valid columns: column %q does not exist", col)), mgmtv1alpha1.ErrorCode_ERROR_CODE_COLUMN_NOT_FOUND)
+		}
+	}
+	return requested, nil
+}
+
+// Removes the columns that were not requested from the row. Requested columns that the row does not have are left out
+func filterStreamRow(row map[string][]byte, columns []string) map[string][]byte {
+	if len(columns) == 0 {
+		return row
+	}
+	filtered := make(map[string][]byte, len(columns))
+	for _, col := range columns {
+		if value, ok := row[col]; ok {
+			filtered[col] = value
+		}
+	}
+	return filtered
+}
+
 func isValidTable(table string, columns []*mgmtv1alpha1.DatabaseColumn) bool {
 	for _, c := range columns {
 		if c.Table == table {
@@ -865,6 +1852,25 @@ func isValidTable(table string, columns []*mgmtv1alpha1.DatabaseColumn) bool {
 	return false
 }
 
+const (
+	pgInvalidAuthorizationCode = "28000"
+	pgInvalidPasswordCode      = "28P01"
+	mysqlAccessDeniedNumber    = 1045
+)
+
+// Marks errors caused by the database rejecting the connection's credentials with the auth failed error code
+func withConnectionErrorCode(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && (pgErr.Code == pgInvalidAuthorizationCode || pgErr.Code == pgInvalidPasswordCode) {
+		return nucleuserrors.WithErrorCode(nucleuserrors.NewBadRequest(fmt.Sprintf("unable to authenticate with connection: %s", pgErr.Message)), mgmtv1alpha1.ErrorCode_ERROR_CODE_CONNECTION_AUTH_FAILED)
+	}
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlAccessDeniedNumber {
+		return nucleuserrors.WithErrorCode(nucleuserrors.NewBadRequest(fmt.Sprintf("unable to authenticate with connection: %s", mysqlErr.Message)), mgmtv1alpha1.ErrorCode_ERROR_CODE_CONNECTION_AUTH_FAILED)
+	}
+	return err
+}
+
 func isValidSchema(schema string, columns []*mgmtv1alpha1.DatabaseColumn) bool {
 	for _, c := range columns {
 		if c.Schema == schema {
@@ -890,20 +1896,18 @@ func (s *Service) GetConnectionUniqueConstraints(
 	if err != nil {
 		return nil, err
 	}
+	ctx, release, err := s.acquireDataAccess(ctx, connection.Msg.Connection)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 
 	schemaResp, err := s.getConnectionSchema(ctx, connection.Msg.Connection, &schemaOpts{})
 	if err != nil {
 		return nil, err
 	}
 
-	schemaMap := map[string]struct{}{}
-	for _, s := range schemaResp {
-		schemaMap[s.Schema] = struct{}{}
-	}
-	schemas := []string{}
-	for s := range schemaMap {
-		schemas = append(schemas, s)
-	}
+	schemaTables := getTableIdentifiers(schemaResp)
 
 	connectionTimeout := 5
 	db, err := s.sqlmanager.NewSqlDb(ctx, logger, connection.Msg.GetConnection(), &connectionTimeout)
@@ -912,28 +1916,66 @@ func (s *Service) GetConnectionUniqueConstraints(
 	}
 	defer db.Db.Close()
 
-	ucMap, err := db.Db.GetUniqueConstraintsMap(ctx, schemas)
-	if err != nil {
-		return nil, err
-	}
-
-	tableConstraints := map[string]*mgmtv1alpha1.UniqueConstraint{}
-	for tableName, uc := range ucMap {
-		columns := []string{}
-		for _, c := range uc {
-			columns = append(columns, c...)
+	tableConstraints := map[string]*mgmtv1alpha1.UniqueConstraints{}
+	tables := []*mgmtv1alpha1.TableUniqueConstraints{}
+	for _, group := range getSchemaGroups(schemaTables) {
+		groupConstraints, err := getUniqueConstraints(ctx, db.Db, group.schemas)
+		if err != nil {
+			return nil, err
+		}
+		for tableName, constraints := range groupConstraints {
+			if _, ok := tableConstraints[tableName]; !ok {
+				tableConstraints[tableName] = &mgmtv1alpha1.UniqueConstraints{Constraints: []*mgmtv1alpha1.UniqueConstraint{}}
+			}
+			tableConstraints[tableName].Constraints = append(tableConstraints[tableName].Constraints, constraints...)
 		}
-		tableConstraints[tableName] = &mgmtv1alpha1.UniqueConstraint{
-			// TODO: this doesn't fully represent unique constraints
-			Columns: columns,
+		for _, table := range group.tables {
+			if constraints, ok := groupConstraints[sql_manager.BuildTable(table.Schema, table.Table)]; ok {
+				tables = append(tables, &mgmtv1alpha1.TableUniqueConstraints{Table: table, Constraints: constraints})
+			}
 		}
 	}
 
 	return connect.NewResponse(&mgmtv1alpha1.GetConnectionUniqueConstraintsResponse{
 		TableConstraints: tableConstraints,
+		Tables:           tables,
 	}), nil
 }
 
+// Returns the unique constraints, unique indexes and partial unique indexes of each table, keyed by <schema>.<table>
+func getUniqueConstraints(ctx context.Context, db sql_manager.SqlDatabase, schemas []string) (map[string][]*mgmtv1alpha1.UniqueConstraint, error) {
+	ucMap, err := db.GetUniqueConstraintsMap(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+
+	tableConstraints := map[string][]*mgmtv1alpha1.UniqueConstraint{}
+	for tableName, uniqueConstraints := range ucMap {
+		constraints := make([]*mgmtv1alpha1.UniqueConstraint, 0, len(uniqueConstraints))
+		for _, columns := range uniqueConstraints {
+			constraints = append(constraints, &mgmtv1alpha1.UniqueConstraint{
+				Columns: columns,
+			})
+		}
+		tableConstraints[tableName] = constraints
+	}
+
+	partialIndexMap, err := db.GetPartialUniqueIndexesMap(ctx, schemas)
+	if err != nil {
+		return nil, err
+	}
+	for tableName, indexes := range partialIndexMap {
+		for _, index := range indexes {
+			predicate := index.Predicate
+			tableConstraints[tableName] = append(tableConstraints[tableName], &mgmtv1alpha1.UniqueConstraint{
+				Columns:   index.Columns,
+				Predicate: &predicate,
+			})
+		}
+	}
+	return tableConstraints, nil
+}
+
 type completionResponse struct {
 	Data []map[string]any `json:"data"`
 }
@@ -955,6 +1997,11 @@ func (s *Service) GetAiGeneratedData(
 	if err != nil {
 		return nil, err
 	}
+	ctx, release, err := s.acquireDataAccess(ctx, aiconnection)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 
 	dbconnectionResp, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
 		Id: req.Msg.GetDataConnectionId(),
@@ -1050,20 +2097,18 @@ func (s *Service) GetConnectionTableConstraints(
 	if err != nil {
 		return nil, err
 	}
+	ctx, release, err := s.acquireDataAccess(ctx, connection.Msg.Connection)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 
 	schemaResp, err := s.getConnectionSchema(ctx, connection.Msg.Connection, &schemaOpts{})
 	if err != nil {
 		return nil, err
 	}
 
-	schemaMap := map[string]struct{}{}
-	for _, s := range schemaResp {
-		schemaMap[s.Schema] = struct{}{}
-	}
-	schemas := []string{}
-	for s := range schemaMap {
-		schemas = append(schemas, s)
-	}
+	schemaTables := getTableIdentifiers(schemaResp)
 
 	connectionTimeout := 5
 	db, err := s.sqlmanager.NewSqlDb(ctx, logger, connection.Msg.GetConnection(), &connectionTimeout)
@@ -1071,41 +2116,102 @@ func (s *Service) GetConnectionTableConstraints(
 		return nil, err
 	}
 	defer db.Db.Close()
-	tableConstraints, err := db.Db.GetTableConstraintsBySchema(ctx, schemas)
-	if err != nil {
-		return nil, err
-	}
-
 	fkConstraintsMap := map[string]*mgmtv1alpha1.ForeignConstraintTables{}
-	for tableName, d := range tableConstraints.ForeignKeyConstraints {
-		fkConstraintsMap[tableName] = &mgmtv1alpha1.ForeignConstraintTables{
-			Constraints: []*mgmtv1alpha1.ForeignConstraint{},
-		}
-		for _, constraint := range d {
-			fkConstraintsMap[tableName].Constraints = append(fkConstraintsMap[tableName].Constraints, &mgmtv1alpha1.ForeignConstraint{
-				Columns: constraint.Columns, NotNullable: constraint.NotNullable, ForeignKey: &mgmtv1alpha1.ForeignKey{
-					Table:   constraint.ForeignKey.Table,
-					Columns: constraint.ForeignKey.Columns,
-				},
-			})
+	pkConstraintsMap := map[string]*mgmtv1alpha1.PrimaryConstraint{}
+	uniqueConstraintsMap := map[string]*mgmtv1alpha1.UniqueConstraints{}
+	exclusionConstraintsMap := map[string]*mgmtv1alpha1.ExclusionConstraints{}
+	tables := []*mgmtv1alpha1.TableConstraints{}
+	keys := getTableKeys(schemaTables)
+	for _, group := range getSchemaGroups(schemaTables) {
+		tableConstraints, err := db.Db.GetTableConstraintsBySchema(ctx, group.schemas)
+		if err != nil {
+			return nil, err
+		}
+		exclusionConstraints, err := db.Db.GetExclusionConstraintsMap(ctx, group.schemas)
+		if err != nil {
+			return nil, err
 		}
-	}
 
-	pkConstraintsMap := map[string]*mgmtv1alpha1.PrimaryConstraint{}
-	for table, pks := range tableConstraints.PrimaryKeyConstraints {
-		pkConstraintsMap[table] = &mgmtv1alpha1.PrimaryConstraint{
-			Columns: pks,
+		fks := map[string][]*mgmtv1alpha1.ForeignConstraint{}
+		for tableName, d := range tableConstraints.ForeignKeyConstraints {
+			for _, constraint := range d {
+				fks[tableName] = append(fks[tableName], &mgmtv1alpha1.ForeignConstraint{
+					Columns: constraint.Columns, NotNullable: constraint.NotNullable, ForeignKey: &mgmtv1alpha1.ForeignKey{
+						Table:           constraint.ForeignKey.Table,
+						Columns:         constraint.ForeignKey.Columns,
+						TableIdentifier: keys.resolve(constraint.ForeignKey.Table),
+					},
+				})
+			}
+			if _, ok := fkConstraintsMap[tableName]; !ok {
+				fkConstraintsMap[tableName] = &mgmtv1alpha1.ForeignConstraintTables{
+					Constraints: []*mgmtv1alpha1.ForeignConstraint{},
+				}
+			}
+			fkConstraintsMap[tableName].Constraints = append(fkConstraintsMap[tableName].Constraints, fks[tableName]...)
 		}
-	}
 
-	uniqueConstraintsMap := map[string]*mgmtv1alpha1.UniqueConstraints{}
-	for table, uniqueConstraints := range tableConstraints.UniqueConstraints {
-		uniqueConstraintsMap[table] = &mgmtv1alpha1.UniqueConstraints{
-			Constraints: []*mgmtv1alpha1.UniqueConstraint{},
+		pks := map[string]*mgmtv1alpha1.PrimaryConstraint{}
+		for table, cols := range tableConstraints.PrimaryKeyConstraints {
+			pks[table] = &mgmtv1alpha1.PrimaryConstraint{
+				Columns: cols,
+			}
+			if existing, ok := pkConstraintsMap[table]; ok {
+				existing.Columns = append(existing.Columns, cols...)
+				continue
+			}
+			pkConstraintsMap[table] = &mgmtv1alpha1.PrimaryConstraint{
+				Columns: cols,
+			}
+		}
+
+		uniques := map[string][]*mgmtv1alpha1.UniqueConstraint{}
+		for table, uniqueConstraints := range tableConstraints.UniqueConstraints {
+			for _, uc := range uniqueConstraints {
+				uniques[table] = append(uniques[table], &mgmtv1alpha1.UniqueConstraint{
+					Columns: uc,
+				})
+			}
+			if _, ok := uniqueConstraintsMap[table]; !ok {
+				uniqueConstraintsMap[table] = &mgmtv1alpha1.UniqueConstraints{
+					Constraints: []*mgmtv1alpha1.UniqueConstraint{},
+				}
+			}
+			uniqueConstraintsMap[table].Constraints = append(uniqueConstraintsMap[table].Constraints, uniques[table]...)
+		}
+
+		exclusions := map[string][]*mgmtv1alpha1.ExclusionConstraint{}
+		for table, constraints := range exclusionConstraints {
+			for _, ec := range constraints {
+				exclusions[table] = append(exclusions[table], &mgmtv1alpha1.ExclusionConstraint{
+					Name:       ec.Name,
+					Columns:    ec.Columns,
+					Operators:  ec.Operators,
+					Definition: ec.Definition,
+				})
+			}
+			if _, ok := exclusionConstraintsMap[table]; !ok {
+				exclusionConstraintsMap[table] = &mgmtv1alpha1.ExclusionConstraints{
+					Constraints: []*mgmtv1alpha1.ExclusionConstraint{},
+				}
+			}
+			exclusionConstraintsMap[table].Constraints = append(exclusionConstraintsMap[table].Constraints, exclusions[table]...)
 		}
-		for _, uc := range uniqueConstraints {
-			uniqueConstraintsMap[table].Constraints = append(uniqueConstraintsMap[table].Constraints, &mgmtv1alpha1.UniqueConstraint{
-				Columns: uc,
+
+		for _, table := range group.tables {
+			key := sql_manager.BuildTable(table.Schema, table.Table)
+			_, hasFks := tableConstraints.ForeignKeyConstraints[key]
+			_, hasUniques := tableConstraints.UniqueConstraints[key]
+			_, hasExclusions := exclusionConstraints[key]
+			if !hasFks && pks[key] == nil && !hasUniques && !hasExclusions {
+				continue
+			}
+			tables = append(tables, &mgmtv1alpha1.TableConstraints{
+				Table:                 table,
+				ForeignKeyConstraints: fks[key],
+				PrimaryKeyConstraint:  pks[key],
+				UniqueConstraints:     uniques[key],
+				ExclusionConstraints:  exclusions[key],
 			})
 		}
 	}
@@ -1114,6 +2220,8 @@ func (s *Service) GetConnectionTableConstraints(
 		ForeignKeyConstraints: fkConstraintsMap,
 		PrimaryKeyConstraints: pkConstraintsMap,
 		UniqueConstraints:     uniqueConstraintsMap,
+		ExclusionConstraints:  exclusionConstraintsMap,
+		Tables:                tables,
 	}), nil
 }
 
@@ -1133,6 +2241,11 @@ func (s *Service) GetTableRowCount(
 	if err != nil {
 		return nil, err
 	}
+	ctx, release, err := s.acquireDataAccess(ctx, connection.Msg.Connection)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 
 	connectionTimeout := 5
 	db, err := s.sqlmanager.NewSqlDb(ctx, logger, connection.Msg.GetConnection(), &connectionTimeout)
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"testing"
 	"time"
 
@@ -16,17 +17,28 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	mysql_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/mysql"
 	pg_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/postgresql"
 	awsmanager "github.com/nucleuscloud/neosync/backend/internal/aws"
+	azuremanager "github.com/nucleuscloud/neosync/backend/internal/azure"
+	elasticsearchmanager "github.com/nucleuscloud/neosync/backend/internal/elasticsearch"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	gcpmanager "github.com/nucleuscloud/neosync/backend/internal/gcp"
+	kafkamanager "github.com/nucleuscloud/neosync/backend/internal/kafka"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/nucleuscloud/neosync/backend/internal/ratelimit"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -45,10 +57,32 @@ type ConnTypeMock string
 const (
 	MysqlMock    ConnTypeMock = "mysql"
 	PostgresMock ConnTypeMock = "postgres"
+	SqliteMock   ConnTypeMock = "sqlite"
 	AwsS3Mock    ConnTypeMock = "awsS3"
+	BigQueryMock ConnTypeMock = "bigquery"
+	GcsMock      ConnTypeMock = "gcs"
+	AzureMock    ConnTypeMock = "azure"
+	MssqlMock    ConnTypeMock = "mssql"
 )
 
 // GetConnectionSchema
+func Test_buildPgStreamColumn(t *testing.T) {
+	require.Equal(t, `"id"`, buildPgStreamColumn("id", pgtype.Int4OID))
+	require.Equal(t, `"UserId"`, buildPgStreamColumn("UserId", pgtype.Int4OID))
+	require.Equal(t, `"created_at"::text AS "created_at"`, buildPgStreamColumn("created_at", pgtype.TimestamptzOID))
+	require.Equal(t, `"CreatedAt"::text AS "CreatedAt"`, buildPgStreamColumn("CreatedAt", pgtype.TimestamptzOID))
+	require.Equal(t, `"opens_at"::text AS "opens_at"`, buildPgStreamColumn("opens_at", pgtype.TimetzOID))
+	require.Equal(t, `"durations"::text AS "durations"`, buildPgStreamColumn("durations", pgtype.IntervalArrayOID))
+
+	query, _ := buildStreamSelectQuery(
+		sql_manager.PostgresDriver, "public", "Users",
+		[]string{buildPgStreamColumn("UserId", pgtype.Int4OID), buildPgStreamColumn("FirstName", pgtype.TextOID)},
+		[]string{"UserId"},
+		&streamPage{},
+	)
+	require.Equal(t, `SELECT "UserId", "FirstName" FROM "public"."Users";`, query)
+}
+
 func Test_GetConnectionSchema_AwsS3(t *testing.T) {
 	m := createServiceMock(t)
 
@@ -81,6 +115,11 @@ func Test_GetConnectionSchema_AwsS3(t *testing.T) {
 		Contents: []types.Object{{Key: &mockKey}},
 	}, nil)
 
+	m.AwsManagerMock.On("GetObject", mock.Anything, mock.Anything, mock.Anything, &s3.GetObjectInput{
+		Bucket: aws.String(connection.ConnectionConfig.GetAwsS3Config().GetBucket()),
+		Key:    aws.String(fmt.Sprintf("%spublic.regions/manifest.json", path)),
+	}).Return(nil, &types.NoSuchKey{})
+
 	data, _ := gzipData([]byte(`{"region_id":1,"region_name":"Europe"}`))
 	m.AwsManagerMock.On("GetObject", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&s3.GetObjectOutput{
 		Body:          io.NopCloser(bytes.NewReader(data)),
@@ -112,6 +151,62 @@ func Test_GetConnectionSchema_AwsS3(t *testing.T) {
 	require.ElementsMatch(t, expected, resp.Msg.Schemas)
 }
 
+func Test_GetConnectionSchema_AwsS3_Manifest(t *testing.T) {
+	m := createServiceMock(t)
+
+	mockJobRunId := "7c54e1ce-3924-477c-bfa8-ab8bd36cfee2-2023-12-21T22:02:35Z"
+	mockPrefix := "workflows/7c54e1ce-3924-477c-bfa8-ab8bd36cfee2-2023-12-21T22:02:35Z/activities/public.regions/"
+	path := fmt.Sprintf("workflows/%s/activities/", mockJobRunId)
+	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, AwsS3Mock)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: connection,
+	}), nil)
+	m.AwsManagerMock.On("NewS3Client", mock.Anything, mock.Anything).Return(nil, nil)
+	isTruncated := false
+	m.AwsManagerMock.On("ListObjectsV2", mock.Anything, mock.Anything, mock.Anything, &s3.ListObjectsV2Input{
+		Bucket:            aws.String(connection.ConnectionConfig.GetAwsS3Config().GetBucket()),
+		Prefix:            aws.String(path),
+		Delimiter:         aws.String("/"),
+		ContinuationToken: nil,
+	}).Return(&s3.ListObjectsV2Output{
+		CommonPrefixes: []types.CommonPrefix{{Prefix: &mockPrefix}},
+		IsTruncated:    &isTruncated,
+	}, nil)
+
+	manifest, err := protojson.Marshal(&mgmtv1alpha1.TableRunManifest{
+		Schema:  "public",
+		Table:   "regions",
+		Columns: []string{"region_id", "region_name"},
+	})
+	require.NoError(t, err)
+	m.AwsManagerMock.On("GetObject", mock.Anything, mock.Anything, mock.Anything, &s3.GetObjectInput{
+		Bucket: aws.String(connection.ConnectionConfig.GetAwsS3Config().GetBucket()),
+		Key:    aws.String(fmt.Sprintf("%spublic.regions/manifest.json", path)),
+	}).Return(&s3.GetObjectOutput{
+		Body: io.NopCloser(bytes.NewReader(manifest)),
+	}, nil)
+
+	resp, err := m.Service.GetConnectionSchema(context.Background(), &connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest]{
+		Msg: &mgmtv1alpha1.GetConnectionSchemaRequest{
+			ConnectionId: mockConnectionId,
+			SchemaConfig: &mgmtv1alpha1.ConnectionSchemaConfig{
+				Config: &mgmtv1alpha1.ConnectionSchemaConfig_AwsS3Config{
+					AwsS3Config: &mgmtv1alpha1.AwsS3SchemaConfig{
+						Id: &mgmtv1alpha1.AwsS3SchemaConfig_JobRunId{JobRunId: mockJobRunId},
+					},
+				},
+			},
+		},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []*mgmtv1alpha1.DatabaseColumn{
+		{Schema: "public", Table: "regions", Column: "region_id"},
+		{Schema: "public", Table: "regions", Column: "region_name"},
+	}, resp.Msg.GetSchemas())
+}
+
 func Test_GetConnectionSchema_Postgres(t *testing.T) {
 	m := createServiceMock(t)
 
@@ -127,6 +222,13 @@ func Test_GetConnectionSchema_Postgres(t *testing.T) {
 			TableName:   "users",
 			ColumnName:  "name",
 			DataType:    "character varying",
+		},
+		{
+			TableSchema:  "public",
+			TableName:    "users",
+			ColumnName:   "email",
+			DataType:     "email_address",
+			BaseDataType: "character varying(255)",
 		}}
 
 	pool, _ := pgxpool.New(context.Background(), "")
@@ -139,6 +241,7 @@ func Test_GetConnectionSchema_Postgres(t *testing.T) {
 	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
 		Connection: connection,
 	}), nil)
+	m.PgQueierMock.On("IsCitusExtensionInstalled", mock.Anything, mock.Anything).Return(false, nil)
 	m.PgQueierMock.On("GetDatabaseSchema", mock.Anything, mock.Anything).
 		Return(mockColumns, nil)
 
@@ -150,17 +253,22 @@ func Test_GetConnectionSchema_Postgres(t *testing.T) {
 
 	expected := []*mgmtv1alpha1.DatabaseColumn{}
 	for _, col := range mockColumns {
+		var baseDataType *string
+		if col.BaseDataType != "" {
+			baseDataType = &col.BaseDataType
+		}
 		expected = append(expected, &mgmtv1alpha1.DatabaseColumn{
-			Schema:   col.TableSchema,
-			Table:    col.TableName,
-			Column:   col.ColumnName,
-			DataType: col.DataType,
+			Schema:       col.TableSchema,
+			Table:        col.TableName,
+			Column:       col.ColumnName,
+			DataType:     col.DataType,
+			BaseDataType: baseDataType,
 		})
 	}
 
 	require.NoError(t, err)
 	require.NotNil(t, resp)
-	require.Equal(t, 2, len(resp.Msg.GetSchemas()))
+	require.Equal(t, 3, len(resp.Msg.GetSchemas()))
 	require.ElementsMatch(t, expected, resp.Msg.Schemas)
 }
 
@@ -209,6 +317,248 @@ func Test_GetConnectionSchema_Mysql(t *testing.T) {
 	}
 }
 
+func Test_GetConnectionSchema_BigQuery(t *testing.T) {
+	m := createServiceMock(t)
+
+	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, BigQueryMock)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: connection,
+	}), nil)
+	mockBigQueryClient := gcpmanager.NewMockBigQueryClient(t)
+	mockBigQueryClient.On("GetDatabaseSchema", mock.Anything).Return([]*gcpmanager.BigQueryColumn{
+		{Dataset: "analytics", Table: "events", Column: "id", DataType: "INTEGER", IsNullable: false, OrdinalPosition: 1},
+		{Dataset: "analytics", Table: "events", Column: "tags", DataType: "ARRAY<STRING>", IsNullable: false, OrdinalPosition: 2},
+		{Dataset: "analytics", Table: "events", Column: "name", DataType: "STRING", IsNullable: true, OrdinalPosition: 3},
+	}, nil)
+	mockBigQueryClient.On("Close").Return(nil)
+	m.GcpManagerMock.On("GetBigQueryClient", mock.Anything, connection.ConnectionConfig.GetBigqueryConfig()).Return(mockBigQueryClient, nil)
+
+	resp, err := m.Service.GetConnectionSchema(context.Background(), &connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest]{
+		Msg: &mgmtv1alpha1.GetConnectionSchemaRequest{
+			ConnectionId: mockConnectionId,
+		},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, []*mgmtv1alpha1.DatabaseColumn{
+		{Schema: "analytics", Table: "events", Column: "id", DataType: "INTEGER", IsNullable: "NO", TableType: "BASE TABLE"},
+		{Schema: "analytics", Table: "events", Column: "tags", DataType: "ARRAY<STRING>", IsNullable: "NO", TableType: "BASE TABLE"},
+		{Schema: "analytics", Table: "events", Column: "name", DataType: "STRING", IsNullable: "YES", TableType: "BASE TABLE"},
+	}, resp.Msg.GetSchemas())
+}
+
+func Test_GetConnectionSchema_GcpCloudStorage(t *testing.T) {
+	m := createServiceMock(t)
+
+	olderRunId := "7c54e1ce-3924-477c-bfa8-ab8bd36cfee2-2023-12-21T22:02:35Z"
+	newerRunId := "7c54e1ce-3924-477c-bfa8-ab8bd36cfee2-2023-12-22T22:02:35Z"
+	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, GcsMock)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: connection,
+	}), nil)
+	m.JobServiceMock.On("GetJobRecentRuns", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetJobRecentRunsResponse{
+		RecentRuns: []*mgmtv1alpha1.JobRecentRun{{JobRunId: olderRunId}, {JobRunId: newerRunId}},
+	}), nil)
+
+	mockStorageClient := gcpmanager.NewMockStorageClient(t)
+	// the newest run has not written any output yet, so the one before it is used
+	mockStorageClient.On("ListPrefixes", mock.Anything, fmt.Sprintf("exports/workflows/%s/activities/", newerRunId)).Return([]string{}, nil)
+	path := fmt.Sprintf("exports/workflows/%s/activities/", olderRunId)
+	mockStorageClient.On("ListPrefixes", mock.Anything, path).Return([]string{path + "public.regions/"}, nil)
+	mockStorageClient.On("ListObjects", mock.Anything, path+"public.regions/data").Return([]string{path + "public.regions/data/1.txt.gz", path + "public.regions/data/2.txt.gz"}, nil)
+	data, err := gzipData([]byte(`{"region_name":"Europe","region_id":1}`))
+	require.NoError(t, err)
+	mockStorageClient.On("GetObject", mock.Anything, path+"public.regions/data/1.txt.gz").Return(io.NopCloser(bytes.NewReader(data)), nil)
+	mockStorageClient.On("Close").Return(nil)
+	m.GcpManagerMock.On("GetStorageClient", mock.Anything, connection.ConnectionConfig.GetGcpCloudstorageConfig()).Return(mockStorageClient, nil)
+
+	resp, err := m.Service.GetConnectionSchema(context.Background(), &connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest]{
+		Msg: &mgmtv1alpha1.GetConnectionSchemaRequest{
+			ConnectionId: mockConnectionId,
+			SchemaConfig: &mgmtv1alpha1.ConnectionSchemaConfig{
+				Config: &mgmtv1alpha1.ConnectionSchemaConfig_GcpCloudstorageConfig{
+					GcpCloudstorageConfig: &mgmtv1alpha1.GcpCloudStorageSchemaConfig{
+						Id: &mgmtv1alpha1.GcpCloudStorageSchemaConfig_JobId{JobId: "7c54e1ce-3924-477c-bfa8-ab8bd36cfee2"},
+					},
+				},
+			},
+		},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, []*mgmtv1alpha1.DatabaseColumn{
+		{Schema: "public", Table: "regions", Column: "region_id"},
+		{Schema: "public", Table: "regions", Column: "region_name"},
+	}, resp.Msg.GetSchemas())
+}
+
+func Test_streamGzipJsonlRows(t *testing.T) {
+	data, err := gzipData([]byte(`{"id":1,"name":"Europe","code":null,"tags":["a"]}` + "\n" + `{"id":2,"name":"Asia","code":"AS","tags":[]}` + "\n"))
+	require.NoError(t, err)
+
+	rows := []map[string][]byte{}
+	err = streamGzipJsonlRows(bytes.NewReader(data), func(row map[string][]byte) error {
+		rows = append(rows, row)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []map[string][]byte{
+		{"id": []byte("1"), "name": []byte("Europe"), "code": nil, "tags": []byte(`["a"]`)},
+		{"id": []byte("2"), "name": []byte("Asia"), "code": []byte("AS"), "tags": []byte(`[]`)},
+	}, rows)
+
+	err = streamGzipJsonlRows(bytes.NewReader([]byte("not gzip")), func(row map[string][]byte) error { return nil })
+	require.Error(t, err)
+}
+
+func Test_GetConnectionSchema_AzureBlobStorage(t *testing.T) {
+	m := createServiceMock(t)
+
+	jobRunId := "7c54e1ce-3924-477c-bfa8-ab8bd36cfee2-2023-12-21T22:02:35Z"
+	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, AzureMock)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: connection,
+	}), nil)
+
+	mockBlobClient := azuremanager.NewMockBlobClient(t)
+	path := fmt.Sprintf("workflows/%s/activities/", jobRunId)
+	// folders that are not named after a schema and table are skipped
+	mockBlobClient.On("ListPrefixes", mock.Anything, path).Return([]string{path + "public.regions/", path + "tmp/"}, nil)
+	mockBlobClient.On("ListObjects", mock.Anything, path+"public.regions/data").Return([]string{path + "public.regions/data/1.txt.gz"}, nil)
+	data, err := gzipData([]byte(`{"region_name":"Europe","region_id":1}`))
+	require.NoError(t, err)
+	mockBlobClient.On("GetObject", mock.Anything, path+"public.regions/data/1.txt.gz").Return(io.NopCloser(bytes.NewReader(data)), nil)
+	m.AzureManagerMock.On("GetBlobClient", mock.Anything, connection.ConnectionConfig.GetAzureBlobConfig()).Return(mockBlobClient, nil)
+
+	resp, err := m.Service.GetConnectionSchema(context.Background(), &connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest]{
+		Msg: &mgmtv1alpha1.GetConnectionSchemaRequest{
+			ConnectionId: mockConnectionId,
+			SchemaConfig: &mgmtv1alpha1.ConnectionSchemaConfig{
+				Config: &mgmtv1alpha1.ConnectionSchemaConfig_AzureBlobConfig{
+					AzureBlobConfig: &mgmtv1alpha1.AzureBlobStorageSchemaConfig{
+						Id: &mgmtv1alpha1.AzureBlobStorageSchemaConfig_JobRunId{JobRunId: jobRunId},
+					},
+				},
+			},
+		},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, []*mgmtv1alpha1.DatabaseColumn{
+		{Schema: "public", Table: "regions", Column: "region_id"},
+		{Schema: "public", Table: "regions", Column: "region_name"},
+	}, resp.Msg.GetSchemas())
+}
+
+func Test_getJobRunPath(t *testing.T) {
+	require.Equal(t, "workflows/run-1/activities/", getJobRunPath("", "run-1"))
+	require.Equal(t, "exports/daily/workflows/run-1/activities/", getJobRunPath("/exports/daily/", "run-1"))
+}
+
+func Test_streamJobRunObjects(t *testing.T) {
+	mockBlobClient := azuremanager.NewMockBlobClient(t)
+	path := "workflows/run-1/activities/public.regions/data"
+	mockBlobClient.On("ListObjects", mock.Anything, path).Return([]string{path + "/1.txt.gz", path + "/2.txt.gz"}, nil)
+	first, err := gzipData([]byte(`{"region_id":1}`))
+	require.NoError(t, err)
+	second, err := gzipData([]byte(`{"region_id":2}`))
+	require.NoError(t, err)
+	mockBlobClient.On("GetObject", mock.Anything, path+"/1.txt.gz").Return(io.NopCloser(bytes.NewReader(first)), nil)
+	mockBlobClient.On("GetObject", mock.Anything, path+"/2.txt.gz").Return(io.NopCloser(bytes.NewReader(second)), nil)
+
+	rows := []map[string][]byte{}
+	err = streamJobRunObjects(context.Background(), slog.Default(), mockBlobClient, path, func(row map[string][]byte) error {
+		rows = append(rows, row)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []map[string][]byte{{"region_id": []byte("1")}, {"region_id": []byte("2")}}, rows)
+}
+
+func Test_isKafkaRecordForTable(t *testing.T) {
+	record := &kafkamanager.Record{Headers: map[string]string{kafkamanager.SchemaHeader: "public", kafkamanager.TableHeader: "regions"}}
+	require.True(t, isKafkaRecordForTable(record, "public", "regions"))
+	require.False(t, isKafkaRecordForTable(record, "public", "countries"))
+	require.True(t, isKafkaRecordForTable(&kafkamanager.Record{Headers: map[string]string{}}, "public", "countries"), "records that were not written by a job should be kept")
+}
+
+func Test_toStreamRow(t *testing.T) {
+	row, err := toStreamRow(map[string]any{"region_id": float64(1), "region_name": "Europe", "deleted_at": nil})
+	require.NoError(t, err)
+	require.Equal(t, map[string][]byte{"region_id": []byte("1"), "region_name": []byte("Europe"), "deleted_at": nil}, row)
+}
+
+func Test_toElasticsearchDatabaseColumns(t *testing.T) {
+	columns := toElasticsearchDatabaseColumns([]*elasticsearchmanager.IndexField{
+		{Index: "users", Field: "email", DataType: "keyword", OrdinalPosition: 1},
+		{Index: "users", Field: "profile", DataType: "object", OrdinalPosition: 2},
+	})
+	require.Equal(t, []*mgmtv1alpha1.DatabaseColumn{
+		{Schema: elasticsearchmanager.IndicesSchema, Table: "users", Column: "email", DataType: "keyword", IsNullable: "YES", TableType: sql_manager.BaseTableType},
+		{Schema: elasticsearchmanager.IndicesSchema, Table: "users", Column: "profile", DataType: "object", IsNullable: "YES", TableType: sql_manager.BaseTableType},
+	}, columns)
+}
+
+func Test_getBigQueryValueBytes(t *testing.T) {
+	value, err := getBigQueryValueBytes(nil)
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	value, err = getBigQueryValueBytes("neosync")
+	require.NoError(t, err)
+	require.Equal(t, []byte("neosync"), value)
+
+	value, err = getBigQueryValueBytes([]byte{0x01, 0x02})
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x01, 0x02}, value)
+
+	value, err = getBigQueryValueBytes(int64(42))
+	require.NoError(t, err)
+	require.Equal(t, []byte("42"), value)
+
+	value, err = getBigQueryValueBytes([]any{"a", "b"})
+	require.NoError(t, err)
+	require.Equal(t, []byte(`["a","b"]`), value)
+}
+
+func Test_GetConnectionSchema_Mssql(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, MssqlMock)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: connection,
+	}), nil)
+	m.SqlDbContainerMock.On("Open").Return(m.SqlDbMock, nil)
+	m.SqlDbContainerMock.On("Close").Return(nil)
+	m.SqlConnectorMock.On("NewDbFromConnectionConfig", mock.Anything, mock.Anything, mock.Anything).Return(m.SqlDbContainerMock, nil)
+	m.SqlMock.ExpectQuery("FROM INFORMATION_SCHEMA.COLUMNS").WillReturnRows(
+		sqlmock.NewRows([]string{"table_schema", "table_name", "column_name", "data_type", "column_default", "is_nullable", "character_maximum_length", "numeric_precision", "numeric_scale", "ordinal_position", "generated_type"}).
+			AddRow("dbo", "users", "id", "int", "", "NO", -1, 10, 0, 1, "IDENTITY").
+			AddRow("dbo", "users", "name", "nvarchar", "", "YES", 100, -1, -1, 2, ""),
+	)
+
+	resp, err := m.Service.GetConnectionSchema(context.Background(), &connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest]{
+		Msg: &mgmtv1alpha1.GetConnectionSchemaRequest{
+			ConnectionId: mockConnectionId,
+		},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Len(t, resp.Msg.GetSchemas(), 2)
+	require.Equal(t, "IDENTITY", resp.Msg.GetSchemas()[0].GetGeneratedType())
+	require.Nil(t, resp.Msg.GetSchemas()[1].GeneratedType)
+	require.NoError(t, m.SqlMock.ExpectationsWereMet())
+}
+
 func Test_GetConnectionSchema_NoRows(t *testing.T) {
 	m := createServiceMock(t)
 	defer m.SqlDbMock.Close()
@@ -318,11 +668,46 @@ func Test_GetConnectionForeignConstraints_Mysql(t *testing.T) {
 	require.Len(t, resp.Msg.TableConstraints, 1)
 	require.EqualValues(t, map[string]*mgmtv1alpha1.ForeignConstraintTables{
 		"public.user_account_associations": {Constraints: []*mgmtv1alpha1.ForeignConstraint{
-			{Column: "user_id", IsNullable: false, ForeignKey: &mgmtv1alpha1.ForeignKey{Table: "public.users", Column: "id"}},
+			{Column: "user_id", IsNullable: false, ForeignKey: &mgmtv1alpha1.ForeignKey{Table: "public.users", Column: "id", TableIdentifier: &mgmtv1alpha1.TableIdentifier{Schema: "public", Table: "users"}}},
 		}},
 	}, resp.Msg.TableConstraints)
 }
 
+func Test_GetConnectionForeignConstraints_RateLimited_NestedCallsShareSlot(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+	m.Service.limiter = ratelimit.New(&ratelimit.Config{Connection: ratelimit.Limits{Concurrency: 1}})
+
+	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, MysqlMock)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: connection,
+	}), nil)
+	m.SqlDbContainerMock.On("Open").Return(m.SqlDbMock, nil)
+	m.SqlDbContainerMock.On("Close").Return(nil)
+	m.SqlConnectorMock.On("NewDbFromConnectionConfig", mock.Anything, mock.Anything, mock.Anything).Return(m.SqlDbContainerMock, nil)
+	m.MysqlQueierMock.On("GetDatabaseSchema", mock.Anything, mock.Anything).
+		Return([]*mysql_queries.GetDatabaseSchemaRow{{TableSchema: "public", TableName: "users", ColumnName: "id"}}, nil)
+	m.MysqlQueierMock.On("GetForeignKeyConstraints", mock.Anything, mock.Anything, mock.Anything).
+		Return([]*mysql_queries.GetForeignKeyConstraintsRow{}, nil)
+
+	_, err := m.Service.GetConnectionForeignConstraints(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetConnectionForeignConstraintsRequest{
+		ConnectionId: mockConnectionId,
+	}))
+	require.NoError(t, err)
+
+	// the slot must have been released once the request finished
+	release, err := m.Service.limiter.Acquire(mockAccountId, mockConnectionId)
+	require.NoError(t, err)
+
+	_, err = m.Service.GetConnectionForeignConstraints(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetConnectionForeignConstraintsRequest{
+		ConnectionId: mockConnectionId,
+	}))
+	require.Error(t, err)
+	require.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+	release()
+}
+
 func Test_GetConnectionForeignConstraints_Postgres(t *testing.T) {
 	m := createServiceMock(t)
 	defer m.SqlDbMock.Close()
@@ -337,6 +722,7 @@ func Test_GetConnectionForeignConstraints_Postgres(t *testing.T) {
 		Connection: connection,
 	}), nil)
 
+	m.PgQueierMock.On("IsCitusExtensionInstalled", mock.Anything, mock.Anything).Return(false, nil)
 	m.PgQueierMock.On("GetDatabaseSchema", mock.Anything, mock.Anything).
 		Return([]*pg_queries.GetDatabaseSchemaRow{
 			{
@@ -376,7 +762,7 @@ func Test_GetConnectionForeignConstraints_Postgres(t *testing.T) {
 	require.Len(t, resp.Msg.TableConstraints, 1)
 	require.EqualValues(t, map[string]*mgmtv1alpha1.ForeignConstraintTables{
 		"public.user_account_associations": {Constraints: []*mgmtv1alpha1.ForeignConstraint{
-			{Column: "user_id", IsNullable: false, ForeignKey: &mgmtv1alpha1.ForeignKey{Table: "public.users", Column: "id"}},
+			{Column: "user_id", IsNullable: false, ForeignKey: &mgmtv1alpha1.ForeignKey{Table: "public.users", Column: "id", TableIdentifier: &mgmtv1alpha1.TableIdentifier{Schema: "public", Table: "users"}}},
 		}},
 	}, resp.Msg.TableConstraints)
 }
@@ -429,6 +815,9 @@ func Test_GetConnectionPrimaryConstraints_Mysql(t *testing.T) {
 	require.EqualValues(t, map[string]*mgmtv1alpha1.PrimaryConstraint{
 		"public.users": {Columns: []string{"id"}},
 	}, resp.Msg.TableConstraints)
+	require.EqualValues(t, []*mgmtv1alpha1.TablePrimaryConstraint{
+		{Table: &mgmtv1alpha1.TableIdentifier{Schema: "public", Table: "users"}, Constraint: &mgmtv1alpha1.PrimaryConstraint{Columns: []string{"id"}}},
+	}, resp.Msg.Tables)
 }
 
 func Test_GetConnectionPrimaryConstraints_Postgres(t *testing.T) {
@@ -445,6 +834,7 @@ func Test_GetConnectionPrimaryConstraints_Postgres(t *testing.T) {
 		Connection: connection,
 	}), nil)
 
+	m.PgQueierMock.On("IsCitusExtensionInstalled", mock.Anything, mock.Anything).Return(false, nil)
 	m.PgQueierMock.On("GetDatabaseSchema", mock.Anything, mock.Anything).
 		Return([]*pg_queries.GetDatabaseSchemaRow{
 			{
@@ -507,9 +897,7 @@ func Test_GetConnectionInitStatements_Mysql_Create(t *testing.T) {
 				ColumnName:  "name",
 			},
 		}, nil)
-	rows := sqlmock.NewRows([]string{"Table", "Create Table"}).
-		AddRow("users", "CREATE TABLE public.users")
-	m.SqlMock.ExpectQuery("SHOW CREATE TABLE `public`.`users`;").WillReturnRows(rows)
+	mockMysqlShowCreateTable(m)
 
 	resp, err := m.Service.GetConnectionInitStatements(context.Background(), &connect.Request[mgmtv1alpha1.GetConnectionInitStatementsRequest]{
 		Msg: &mgmtv1alpha1.GetConnectionInitStatementsRequest{
@@ -521,13 +909,60 @@ func Test_GetConnectionInitStatements_Mysql_Create(t *testing.T) {
 		},
 	})
 
-	expectedInit := "CREATE TABLE IF NOT EXISTS  public.users;"
+	expectedInit := "CREATE TABLE IF NOT EXISTS  `public`.`users` (\n  `id` int NOT NULL,\n  `name` varchar(255) COLLATE utf8mb4_bin DEFAULT NULL\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;"
 	require.Nil(t, err)
 	require.Len(t, resp.Msg.TableInitStatements, 1)
 	require.Len(t, resp.Msg.TableTruncateStatements, 0)
 	require.Equal(t, expectedInit, resp.Msg.TableInitStatements["public.users"])
 }
 
+func Test_GetConnectionInitStatements_Mysql_CharsetOverride(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, MysqlMock)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: connection,
+	}), nil)
+	m.SqlDbContainerMock.On("Open").Return(m.SqlDbMock, nil)
+	m.SqlDbContainerMock.On("Close").Return(nil)
+	m.SqlConnectorMock.On("NewDbFromConnectionConfig", mock.Anything, mock.Anything, mock.Anything).Return(m.SqlDbContainerMock, nil)
+	m.MysqlQueierMock.On("GetDatabaseSchema", mock.Anything, mock.Anything).
+		Return([]*mysql_queries.GetDatabaseSchemaRow{
+			{
+				TableSchema: "public",
+				TableName:   "users",
+				ColumnName:  "id",
+			},
+		}, nil)
+	mockMysqlShowCreateTable(m)
+
+	collation := "latin1_bin"
+	resp, err := m.Service.GetConnectionInitStatements(context.Background(), &connect.Request[mgmtv1alpha1.GetConnectionInitStatementsRequest]{
+		Msg: &mgmtv1alpha1.GetConnectionInitStatementsRequest{
+			ConnectionId: mockConnectionId,
+			Options: &mgmtv1alpha1.InitStatementOptions{
+				InitSchema:           true,
+				MysqlCharsetOverride: &mgmtv1alpha1.MysqlCharsetOverride{Charset: "latin1", Collation: &collation},
+			},
+		},
+	})
+
+	expectedInit := "CREATE TABLE IF NOT EXISTS  `public`.`users` (\n  `id` int NOT NULL,\n  `name` varchar(255) DEFAULT NULL\n) ENGINE=InnoDB DEFAULT CHARSET=latin1 COLLATE=latin1_bin;"
+	require.Nil(t, err)
+	require.Equal(t, expectedInit, resp.Msg.TableInitStatements["public.users"])
+}
+
+func mockMysqlShowCreateTable(m *serviceMocks) {
+	rows := sqlmock.NewRows([]string{"Table", "Create Table"}).
+		AddRow("users", "CREATE TABLE `users` (\n  `id` int NOT NULL,\n  `name` varchar(255) COLLATE utf8mb4_bin DEFAULT NULL\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4")
+	m.SqlMock.ExpectQuery("SHOW CREATE TABLE `public`.`users`;").WillReturnRows(rows)
+	charsetRows := sqlmock.NewRows([]string{"CHARACTER_SET_NAME", "TABLE_COLLATION"}).
+		AddRow("utf8mb4", "utf8mb4_0900_ai_ci")
+	m.SqlMock.ExpectQuery("SELECT ccsa.CHARACTER_SET_NAME, t.TABLE_COLLATION").WithArgs("public", "users").WillReturnRows(charsetRows)
+}
+
 func Test_GetConnectionInitStatements_Mysql_Truncate(t *testing.T) {
 	m := createServiceMock(t)
 	defer m.SqlDbMock.Close()
@@ -571,6 +1006,87 @@ func Test_GetConnectionInitStatements_Mysql_Truncate(t *testing.T) {
 	require.Equal(t, expectedTruncate, resp.Msg.TableTruncateStatements["public.users"])
 }
 
+func Test_GetConnectionInitStatements_Sqlite_Truncate(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, SqliteMock)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: connection,
+	}), nil)
+	m.SqlDbContainerMock.On("Open").Return(m.SqlDbMock, nil)
+	m.SqlDbContainerMock.On("Close").Return(nil)
+	m.SqlConnectorMock.On("NewDbFromConnectionConfig", mock.Anything, mock.Anything, mock.Anything).Return(m.SqlDbContainerMock, nil)
+	m.SqlMock.ExpectQuery("pragma_table_xinfo").WillReturnRows(
+		sqlmock.NewRows([]string{"table", "column", "type", "notnull", "dflt_value", "cid", "hidden"}).
+			AddRow("users", "id", "INTEGER", int64(1), nil, int64(0), int64(0)).
+			AddRow("users", "name", "TEXT", int64(0), nil, int64(1), int64(0)),
+	)
+
+	resp, err := m.Service.GetConnectionInitStatements(context.Background(), &connect.Request[mgmtv1alpha1.GetConnectionInitStatementsRequest]{
+		Msg: &mgmtv1alpha1.GetConnectionInitStatementsRequest{
+			ConnectionId: mockConnectionId,
+			Options: &mgmtv1alpha1.InitStatementOptions{
+				InitSchema:           false,
+				TruncateBeforeInsert: true,
+			},
+		},
+	})
+
+	require.Nil(t, err)
+	require.Len(t, resp.Msg.TableInitStatements, 0)
+	require.Len(t, resp.Msg.TableTruncateStatements, 1)
+	require.Equal(t, `DELETE FROM "main"."users";`, resp.Msg.TableTruncateStatements["main.users"])
+}
+
+func Test_GetConnectionInitStatements_Mssql_Truncate(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, MssqlMock)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: connection,
+	}), nil)
+	m.SqlDbContainerMock.On("Open").Return(m.SqlDbMock, nil)
+	m.SqlDbContainerMock.On("Close").Return(nil)
+	m.SqlConnectorMock.On("NewDbFromConnectionConfig", mock.Anything, mock.Anything, mock.Anything).Return(m.SqlDbContainerMock, nil)
+	m.SqlMock.ExpectQuery("FROM INFORMATION_SCHEMA.COLUMNS").WillReturnRows(
+		sqlmock.NewRows([]string{"table_schema", "table_name", "column_name", "data_type", "column_default", "is_nullable", "character_maximum_length", "numeric_precision", "numeric_scale", "ordinal_position", "generated_type"}).
+			AddRow("dbo", "users", "id", "int", "", "NO", -1, 10, 0, 1, "IDENTITY"),
+	)
+
+	resp, err := m.Service.GetConnectionInitStatements(context.Background(), &connect.Request[mgmtv1alpha1.GetConnectionInitStatementsRequest]{
+		Msg: &mgmtv1alpha1.GetConnectionInitStatementsRequest{
+			ConnectionId: mockConnectionId,
+			Options: &mgmtv1alpha1.InitStatementOptions{
+				InitSchema:           false,
+				TruncateBeforeInsert: true,
+			},
+		},
+	})
+
+	require.Nil(t, err)
+	require.Len(t, resp.Msg.TableInitStatements, 0)
+	require.Len(t, resp.Msg.TableTruncateStatements, 1)
+	require.Equal(t, `DELETE FROM "dbo"."users";`, resp.Msg.TableTruncateStatements["dbo.users"])
+
+	m.SqlMock.ExpectQuery("FROM INFORMATION_SCHEMA.COLUMNS").WillReturnRows(
+		sqlmock.NewRows([]string{"table_schema", "table_name", "column_name", "data_type", "column_default", "is_nullable", "character_maximum_length", "numeric_precision", "numeric_scale", "ordinal_position", "generated_type"}).
+			AddRow("dbo", "users", "id", "int", "", "NO", -1, 10, 0, 1, "IDENTITY"),
+	)
+	_, err = m.Service.GetConnectionInitStatements(context.Background(), &connect.Request[mgmtv1alpha1.GetConnectionInitStatementsRequest]{
+		Msg: &mgmtv1alpha1.GetConnectionInitStatementsRequest{
+			ConnectionId: mockConnectionId,
+			Options: &mgmtv1alpha1.InitStatementOptions{
+				TruncateCascade: true,
+			},
+		},
+	})
+	require.Error(t, err)
+}
+
 func Test_GetConnectionInitStatements_Postgres_Create(t *testing.T) {
 	m := createServiceMock(t)
 	defer m.SqlDbMock.Close()
@@ -584,6 +1100,7 @@ func Test_GetConnectionInitStatements_Postgres_Create(t *testing.T) {
 	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
 		Connection: connection,
 	}), nil)
+	m.PgQueierMock.On("IsCitusExtensionInstalled", mock.Anything, mock.Anything).Return(false, nil)
 	m.PgQueierMock.On("GetDatabaseSchema", mock.Anything, mock.Anything).
 		Return([]*pg_queries.GetDatabaseSchemaRow{
 			{
@@ -658,6 +1175,7 @@ func Test_GetConnectionInitStatements_Postgres_Truncate(t *testing.T) {
 	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
 		Connection: connection,
 	}), nil)
+	m.PgQueierMock.On("IsCitusExtensionInstalled", mock.Anything, mock.Anything).Return(false, nil)
 	m.PgQueierMock.On("GetDatabaseSchema", mock.Anything, mock.Anything).
 		Return([]*pg_queries.GetDatabaseSchemaRow{
 			{
@@ -691,20 +1209,24 @@ func Test_GetConnectionInitStatements_Postgres_Truncate(t *testing.T) {
 }
 
 type serviceMocks struct {
-	Service                *Service
-	DbtxMock               *nucleusdb.MockDBTX
-	QuerierMock            *db_queries.MockQuerier
-	UserAccountServiceMock *mgmtv1alpha1connect.MockUserAccountServiceClient
-	ConnectionServiceMock  *mgmtv1alpha1connect.MockConnectionServiceClient
-	JobServiceMock         *mgmtv1alpha1connect.MockJobServiceHandler
-	SqlMock                sqlmock.Sqlmock
-	SqlDbMock              *sql.DB
-	SqlDbContainerMock     *sqlconnect.MockSqlDbContainer
-	PgPoolContainerMock    *sqlconnect.MockPgPoolContainer
-	PgQueierMock           *pg_queries.MockQuerier
-	MysqlQueierMock        *mysql_queries.MockQuerier
-	SqlConnectorMock       *sqlconnect.MockSqlConnector
-	AwsManagerMock         *awsmanager.MockNeosyncAwsManagerClient
+	Service                  *Service
+	DbtxMock                 *nucleusdb.MockDBTX
+	QuerierMock              *db_queries.MockQuerier
+	UserAccountServiceMock   *mgmtv1alpha1connect.MockUserAccountServiceClient
+	ConnectionServiceMock    *mgmtv1alpha1connect.MockConnectionServiceClient
+	JobServiceMock           *mgmtv1alpha1connect.MockJobServiceHandler
+	SqlMock                  sqlmock.Sqlmock
+	SqlDbMock                *sql.DB
+	SqlDbContainerMock       *sqlconnect.MockSqlDbContainer
+	PgPoolContainerMock      *sqlconnect.MockPgPoolContainer
+	PgQueierMock             *pg_queries.MockQuerier
+	MysqlQueierMock          *mysql_queries.MockQuerier
+	SqlConnectorMock         *sqlconnect.MockSqlConnector
+	AwsManagerMock           *awsmanager.MockNeosyncAwsManagerClient
+	GcpManagerMock           *gcpmanager.MockNeosyncGcpManagerClient
+	AzureManagerMock         *azuremanager.MockNeosyncAzureManagerClient
+	KafkaManagerMock         *kafkamanager.MockNeosyncKafkaManagerClient
+	ElasticsearchManagerMock *elasticsearchmanager.MockNeosyncElasticsearchManagerClient
 }
 
 func createServiceMock(t *testing.T) *serviceMocks {
@@ -717,29 +1239,37 @@ func createServiceMock(t *testing.T) *serviceMocks {
 	mockMysqlquerier := mysql_queries.NewMockQuerier(t)
 	mockSqlConnector := sqlconnect.NewMockSqlConnector(t)
 	mockAwsManager := awsmanager.NewMockNeosyncAwsManagerClient(t)
+	mockGcpManager := gcpmanager.NewMockNeosyncGcpManagerClient(t)
+	mockAzureManager := azuremanager.NewMockNeosyncAzureManagerClient(t)
+	mockKafkaManager := kafkamanager.NewMockNeosyncKafkaManagerClient(t)
+	mockElasticsearchManager := elasticsearchmanager.NewMockNeosyncElasticsearchManagerClient(t)
 
 	sqlDbMock, sqlMock, err := sqlmock.New(sqlmock.MonitorPingsOption(false))
 	if err != nil {
 		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
 	}
 
-	service := New(&Config{}, mockUserAccountService, mockConnectionService, mockJobService, mockAwsManager, mockSqlConnector, mockPgquerier, mockMysqlquerier)
+	service := New(&Config{}, mockUserAccountService, mockConnectionService, mockJobService, mockAwsManager, mockGcpManager, mockAzureManager, mockKafkaManager, mockElasticsearchManager, mockSqlConnector, mockPgquerier, mockMysqlquerier)
 
 	return &serviceMocks{
-		Service:                service,
-		DbtxMock:               mockDbtx,
-		QuerierMock:            mockQuerier,
-		UserAccountServiceMock: mockUserAccountService,
-		ConnectionServiceMock:  mockConnectionService,
-		JobServiceMock:         mockJobService,
-		SqlMock:                sqlMock,
-		SqlDbMock:              sqlDbMock,
-		SqlDbContainerMock:     sqlconnect.NewMockSqlDbContainer(t),
-		PgPoolContainerMock:    sqlconnect.NewMockPgPoolContainer(t),
-		PgQueierMock:           mockPgquerier,
-		MysqlQueierMock:        mockMysqlquerier,
-		SqlConnectorMock:       mockSqlConnector,
-		AwsManagerMock:         mockAwsManager,
+		Service:                  service,
+		DbtxMock:                 mockDbtx,
+		QuerierMock:              mockQuerier,
+		UserAccountServiceMock:   mockUserAccountService,
+		ConnectionServiceMock:    mockConnectionService,
+		JobServiceMock:           mockJobService,
+		SqlMock:                  sqlMock,
+		SqlDbMock:                sqlDbMock,
+		SqlDbContainerMock:       sqlconnect.NewMockSqlDbContainer(t),
+		PgPoolContainerMock:      sqlconnect.NewMockPgPoolContainer(t),
+		PgQueierMock:             mockPgquerier,
+		MysqlQueierMock:          mockMysqlquerier,
+		SqlConnectorMock:         mockSqlConnector,
+		AwsManagerMock:           mockAwsManager,
+		GcpManagerMock:           mockGcpManager,
+		AzureManagerMock:         mockAzureManager,
+		KafkaManagerMock:         mockKafkaManager,
+		ElasticsearchManagerMock: mockElasticsearchManager,
 	}
 }
 
@@ -797,6 +1327,26 @@ func getConnectionMock(accountId, name string, id string, connType ConnTypeMock)
 			},
 		}
 
+	} else if connType == SqliteMock {
+		connection.ConnectionConfig = &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_SqliteConfig{
+				SqliteConfig: &mgmtv1alpha1.SqliteConnectionConfig{
+					Path: "/tmp/neosync.db",
+				},
+			},
+		}
+	} else if connType == MssqlMock {
+		connection.ConnectionConfig = &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_MssqlConfig{
+				MssqlConfig: &mgmtv1alpha1.MssqlConnectionConfig{
+					Host: "host",
+					Port: 1433,
+					Name: "database",
+					User: "user",
+					Pass: "topsecret",
+				},
+			},
+		}
 	} else if connType == AwsS3Mock {
 		connection.ConnectionConfig = &mgmtv1alpha1.ConnectionConfig{
 			Config: &mgmtv1alpha1.ConnectionConfig_AwsS3Config{
@@ -805,6 +1355,33 @@ func getConnectionMock(accountId, name string, id string, connType ConnTypeMock)
 				},
 			},
 		}
+	} else if connType == BigQueryMock {
+		connection.ConnectionConfig = &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_BigqueryConfig{
+				BigqueryConfig: &mgmtv1alpha1.BigQueryConnectionConfig{
+					ProjectId: "neosync-test",
+				},
+			},
+		}
+	} else if connType == GcsMock {
+		connection.ConnectionConfig = &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_GcpCloudstorageConfig{
+				GcpCloudstorageConfig: &mgmtv1alpha1.GcpCloudStorageConnectionConfig{
+					Bucket:     "neosync",
+					PathPrefix: aws.String("/exports/"),
+				},
+			},
+		}
+	} else if connType == AzureMock {
+		connection.ConnectionConfig = &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_AzureBlobConfig{
+				AzureBlobConfig: &mgmtv1alpha1.AzureBlobStorageConnectionConfig{
+					StorageAccount:   "neosync",
+					Container:        "exports",
+					StorageAccessKey: aws.String("key"),
+				},
+			},
+		}
 	}
 
 	return connection
@@ -963,6 +1540,18 @@ func Test_GetConnectionUniqueConstraints_Mysql(t *testing.T) {
 				TableName:      "users",
 				ColumnName:     "id",
 			},
+			{
+				ConstraintName: "name_email",
+				SchemaName:     "public",
+				TableName:      "users",
+				ColumnName:     "name",
+			},
+			{
+				ConstraintName: "name_email",
+				SchemaName:     "public",
+				TableName:      "users",
+				ColumnName:     "email",
+			},
 		}, nil)
 
 	resp, err := m.Service.GetConnectionUniqueConstraints(context.Background(), &connect.Request[mgmtv1alpha1.GetConnectionUniqueConstraintsRequest]{
@@ -973,8 +1562,13 @@ func Test_GetConnectionUniqueConstraints_Mysql(t *testing.T) {
 
 	require.Nil(t, err)
 	require.Len(t, resp.Msg.TableConstraints, 1)
-	require.EqualValues(t, map[string]*mgmtv1alpha1.UniqueConstraint{
-		"public.users": {Columns: []string{"id"}},
+	predicate := "(deleted_at IS NULL)"
+	require.EqualValues(t, map[string]*mgmtv1alpha1.UniqueConstraints{
+		"public.users": {Constraints: []*mgmtv1alpha1.UniqueConstraint{
+			{Columns: []string{"id"}},
+			{Columns: []string{"name", "email"}},
+			{Columns: []string{"name"}, Predicate: &predicate},
+		}},
 	}, resp.Msg.TableConstraints)
 }
 
@@ -992,6 +1586,7 @@ func Test_GetConnectionUniqueConstraints_Postgres(t *testing.T) {
 		Connection: connection,
 	}), nil)
 
+	m.PgQueierMock.On("IsCitusExtensionInstalled", mock.Anything, mock.Anything).Return(false, nil)
 	m.PgQueierMock.On("GetDatabaseSchema", mock.Anything, mock.Anything).
 		Return([]*pg_queries.GetDatabaseSchemaRow{
 			{
@@ -1015,6 +1610,22 @@ func Test_GetConnectionUniqueConstraints_Postgres(t *testing.T) {
 				ConstraintType:    "u",
 			},
 		}, nil)
+	m.PgQueierMock.On("GetUniqueIndexesBySchema", mock.Anything, mock.Anything, mock.Anything).
+		Return([]*pg_queries.GetUniqueIndexesBySchemaRow{
+			{
+				SchemaName:   "public",
+				TableName:    "users",
+				IndexName:    "users_name_email_idx",
+				IndexColumns: []string{"name", "email"},
+			},
+			{
+				SchemaName:     "public",
+				TableName:      "users",
+				IndexName:      "users_active_name_idx",
+				IndexColumns:   []string{"name"},
+				IndexPredicate: "(deleted_at IS NULL)",
+			},
+		}, nil)
 
 	resp, err := m.Service.GetConnectionUniqueConstraints(context.Background(), &connect.Request[mgmtv1alpha1.GetConnectionUniqueConstraintsRequest]{
 		Msg: &mgmtv1alpha1.GetConnectionUniqueConstraintsRequest{
@@ -1024,7 +1635,41 @@ func Test_GetConnectionUniqueConstraints_Postgres(t *testing.T) {
 
 	require.Nil(t, err)
 	require.Len(t, resp.Msg.TableConstraints, 1)
-	require.EqualValues(t, map[string]*mgmtv1alpha1.UniqueConstraint{
-		"public.users": {Columns: []string{"id"}},
+	require.EqualValues(t, map[string]*mgmtv1alpha1.UniqueConstraints{
+		"public.users": {Constraints: []*mgmtv1alpha1.UniqueConstraint{
+			{Columns: []string{"id"}},
+			{Columns: []string{"name", "email"}},
+		}},
 	}, resp.Msg.TableConstraints)
 }
+
+func Test_withConnectionErrorCode(t *testing.T) {
+	pgErr := fmt.Errorf("failed to connect: %w", &pgconn.PgError{Code: "28P01", Message: "password authentication failed"})
+	require.Equal(t, mgmtv1alpha1.ErrorCode_ERROR_CODE_CONNECTION_AUTH_FAILED, nucleuserrors.GetErrorCode(withConnectionErrorCode(pgErr)))
+
+	mysqlErr := &mysql.MySQLError{Number: 1045, Message: "Access denied for user"}
+	require.Equal(t, mgmtv1alpha1.ErrorCode_ERROR_CODE_CONNECTION_AUTH_FAILED, nucleuserrors.GetErrorCode(withConnectionErrorCode(mysqlErr)))
+
+	otherErr := &pgconn.PgError{Code: "42P01"}
+	require.Equal(t, otherErr, withConnectionErrorCode(otherErr))
+}
+
+func Test_selectStreamColumns(t *testing.T) {
+	columns, err := selectStreamColumns([]string{"id", "name", "avatar"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"id", "name", "avatar"}, columns)
+
+	columns, err = selectStreamColumns([]string{"id", "name", "avatar"}, []string{"name", "id"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"name", "id"}, columns)
+
+	_, err = selectStreamColumns([]string{"id", "name"}, []string{"avatar"})
+	require.Error(t, err)
+	require.Equal(t, mgmtv1alpha1.ErrorCode_ERROR_CODE_COLUMN_NOT_FOUND, nucleuserrors.GetErrorCode(err))
+}
+
+func Test_filterStreamRow(t *testing.T) {
+	row := map[string][]byte{"id": []byte("1"), "name": []byte("nick"), "avatar": []byte("...")}
+	require.Equal(t, row, filterStreamRow(row, nil))
+	require.Equal(t, map[string][]byte{"id": []byte("1")}, filterStreamRow(row, []string{"id", "missing"}))
+}
@@ -0,0 +1,204 @@
+package v1alpha1_connectiondataservice
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+)
+
+const (
+	defaultQueryConsoleRowLimit = 100
+	defaultQueryConsoleTimeout  = 30 * time.Second
+)
+
+var (
+	readOnlyQueryPrefixRegex = regexp.MustCompile(`(?i)^(select|with)\b`)
+)
+
+func (s *Service) ExecuteReadOnlyQuery(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.ExecuteReadOnlyQueryRequest],
+) (*connect.Response[mgmtv1alpha1.ExecuteReadOnlyQueryResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("connectionId", req.Msg.GetConnectionId())
+	if !s.cfg.IsQueryConsoleEnabled {
+		return nil, nucleuserrors.NewForbidden("the query console has not been enabled")
+	}
+	if isWorkerApiKey(ctx) {
+		return nil, nucleuserrors.NewForbidden("worker api keys are not permitted to use the query console")
+	}
+	query, err := getReadOnlyQuery(req.Msg.GetQuery())
+	if err != nil {
+		return nil, err
+	}
+
+	connection, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+		Id: req.Msg.GetConnectionId(),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.verifyUserInAccount(ctx, connection.Msg.GetConnection().GetAccountId(), mgmtv1alpha1.AccountPermission_ACCOUNT_PERMISSION_QUERY_CONSOLE)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.useraccountService.GetUser(ctx, connect.NewRequest(&mgmtv1alpha1.GetUserRequest{}))
+	if err != nil {
+		return nil, err
+	}
+
+	isPostgres := false
+	switch connection.Msg.GetConnection().GetConnectionConfig().GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
+		isPostgres = true
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+	default:
+		return nil, nucleuserrors.NewBadRequest("the query console is only supported for postgres and mysql connections")
+	}
+
+	rowLimit := uint32(defaultQueryConsoleRowLimit)
+	if req.Msg.RowLimit != nil {
+		rowLimit = req.Msg.GetRowLimit()
+	}
+	timeout := defaultQueryConsoleTimeout
+	if req.Msg.TimeoutSeconds != nil {
+		timeout = time.Duration(req.Msg.GetTimeoutSeconds()) * time.Second
+	}
+
+	logger = logger.With(
+		"userId", user.Msg.GetUserId(),
+		"accountId", connection.Msg.GetConnection().GetAccountId(),
+	)
+	// audit log of every query that is run through the console
+	logger.Info("executing query console query", "query", req.Msg.GetQuery(), "rowLimit", rowLimit)
+
+	ctx, release, err := s.acquireDataAccess(ctx, connection.Msg.GetConnection())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	connectionTimeout := uint32(5)
+	conn, err := s.sqlConnector.NewDbFromConnectionConfig(connection.Msg.GetConnection().GetConnectionConfig(), &connectionTimeout, logger)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error(fmt.Errorf("failed to close connection: %w", err).Error())
+		}
+	}()
+	db, err := conn.Open()
+	if err != nil {
+		return nil, withConnectionErrorCode(err)
+	}
+
+	// the transaction is always rolled back so that nothing the query does can be persisted
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, withConnectionErrorCode(err)
+	}
+	defer nucleusdb.HandleSqlRollback(tx, logger)
+
+	if isPostgres {
+		_, err = tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL statement_timeout = %d", timeout.Milliseconds()))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	driver := sql_manager.MysqlDriver
+	if isPostgres {
+		driver = sql_manager.PostgresDriver
+	}
+	// stops the query on the database if the client goes away or the timeout is hit while it is still running
+	cancelQuery, err := sql_manager.GetSqlQueryCanceler(ctx, driver, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	stopCancelWatch := sql_manager.WatchQueryCancel(ctx, cancelQuery)
+	defer stopCancelWatch()
+
+	start := time.Now()
+	// one extra row is requested to determine if the results were truncated
+	rows, err := tx.QueryContext(ctx, buildLimitedQuery(query, rowLimit+1))
+	if err != nil {
+		logger.Info("query console query failed", "error", err.Error())
+		return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("unable to execute query: %s", err.Error()))
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	resultRows := []*mgmtv1alpha1.QueryResultRow{}
+	truncated := false
+	for rows.Next() {
+		if uint32(len(resultRows)) == rowLimit {
+			truncated = true
+			break
+		}
+		values := make([]sql.NullString, len(columns))
+		valuesWrapped := make([]any, 0, len(columns))
+		for i := range values {
+			valuesWrapped = append(valuesWrapped, &values[i])
+		}
+		if err := rows.Scan(valuesWrapped...); err != nil {
+			return nil, err
+		}
+		row := &mgmtv1alpha1.QueryResultRow{Values: make([]*mgmtv1alpha1.QueryResultValue, 0, len(values))}
+		for _, v := range values {
+			value := &mgmtv1alpha1.QueryResultValue{}
+			if v.Valid {
+				str := v.String
+				value.Value = &str
+			}
+			row.Values = append(row.Values, value)
+		}
+		resultRows = append(resultRows, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("unable to execute query: %s", err.Error()))
+	}
+	logger.Info("query console query completed", "rowCount", len(resultRows), "truncated", truncated, "duration", time.Since(start).String())
+
+	return connect.NewResponse(&mgmtv1alpha1.ExecuteReadOnlyQueryResponse{
+		Columns:   columns,
+		Rows:      resultRows,
+		Truncated: truncated,
+	}), nil
+}
+
+// Wraps the query with a row limit. The query is followed by a newline so that a trailing line comment can not comment out the wrapper
+func buildLimitedQuery(query string, limit uint32) string {
+	return fmt.Sprintf("SELECT * FROM (%s\n) AS query_console_results LIMIT %d", query, limit)
+}
+
+// Ensures the query is a single SELECT statement so that it can be safely wrapped with a row limit
+func getReadOnlyQuery(query string) (string, error) {
+	trimmed := strings.TrimRight(strings.TrimSpace(query), "; \t\n\r")
+	if trimmed == "" {
+		return "", nucleuserrors.NewBadRequest("query must not be empty")
+	}
+	if strings.Contains(trimmed, ";") {
+		return "", nucleuserrors.NewBadRequest("query must be a single statement")
+	}
+	if !readOnlyQueryPrefixRegex.MatchString(trimmed) {
+		return "", nucleuserrors.NewBadRequest("query must be a SELECT statement")
+	}
+	return trimmed, nil
+}
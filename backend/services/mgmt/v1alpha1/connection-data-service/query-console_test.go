@@ -0,0 +1,177 @@
+package v1alpha1_connectiondataservice
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/DATA-DOG/go-sqlmock"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ExecuteReadOnlyQuery_Postgres(t *testing.T) {
+	m := createServiceMock(t)
+	m.Service.cfg.IsQueryConsoleEnabled = true
+
+	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, PostgresMock)
+	mockQueryConsoleConnection(m, connection)
+
+	m.SqlMock.ExpectBegin()
+	m.SqlMock.ExpectExec(regexp.QuoteMeta("SET LOCAL statement_timeout = 30000")).WillReturnResult(sqlmock.NewResult(0, 0))
+	m.SqlMock.ExpectQuery(regexp.QuoteMeta("SELECT pg_backend_pid();")).WillReturnRows(sqlmock.NewRows([]string{"pg_backend_pid"}).AddRow(123))
+	m.SqlMock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM (SELECT id, name FROM public.users\n) AS query_console_results LIMIT 3")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).
+			AddRow(1, "alice").
+			AddRow(2, nil).
+			AddRow(3, "carol"))
+	m.SqlMock.ExpectRollback()
+
+	rowLimit := uint32(2)
+	resp, err := m.Service.ExecuteReadOnlyQuery(context.Background(), connect.NewRequest(&mgmtv1alpha1.ExecuteReadOnlyQueryRequest{
+		ConnectionId: mockConnectionId,
+		Query:        "SELECT id, name FROM public.users;",
+		RowLimit:     &rowLimit,
+	}))
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"id", "name"}, resp.Msg.GetColumns())
+	require.Len(t, resp.Msg.GetRows(), 2)
+	require.True(t, resp.Msg.GetTruncated())
+	require.Equal(t, "alice", resp.Msg.GetRows()[0].GetValues()[1].GetValue())
+	require.Nil(t, resp.Msg.GetRows()[1].GetValues()[1].Value)
+	require.NoError(t, m.SqlMock.ExpectationsWereMet())
+}
+
+func Test_ExecuteReadOnlyQuery_Mysql(t *testing.T) {
+	m := createServiceMock(t)
+	m.Service.cfg.IsQueryConsoleEnabled = true
+
+	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, MysqlMock)
+	mockQueryConsoleConnection(m, connection)
+
+	m.SqlMock.ExpectBegin()
+	m.SqlMock.ExpectQuery(regexp.QuoteMeta("SELECT CONNECTION_ID();")).WillReturnRows(sqlmock.NewRows([]string{"CONNECTION_ID()"}).AddRow(123))
+	m.SqlMock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM (WITH u AS (SELECT id FROM users) SELECT id FROM u\n) AS query_console_results LIMIT 101")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	m.SqlMock.ExpectRollback()
+
+	resp, err := m.Service.ExecuteReadOnlyQuery(context.Background(), connect.NewRequest(&mgmtv1alpha1.ExecuteReadOnlyQueryRequest{
+		ConnectionId: mockConnectionId,
+		Query:        "WITH u AS (SELECT id FROM users) SELECT id FROM u",
+	}))
+
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.GetRows(), 1)
+	require.False(t, resp.Msg.GetTruncated())
+	require.NoError(t, m.SqlMock.ExpectationsWereMet())
+}
+
+func Test_ExecuteReadOnlyQuery_Disabled(t *testing.T) {
+	m := createServiceMock(t)
+
+	resp, err := m.Service.ExecuteReadOnlyQuery(context.Background(), connect.NewRequest(&mgmtv1alpha1.ExecuteReadOnlyQueryRequest{
+		ConnectionId: mockConnectionId,
+		Query:        "SELECT 1",
+	}))
+
+	require.Error(t, err)
+	require.Nil(t, resp)
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+}
+
+func Test_ExecuteReadOnlyQuery_MissingPermission(t *testing.T) {
+	m := createServiceMock(t)
+	m.Service.cfg.IsQueryConsoleEnabled = true
+
+	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, PostgresMock)
+	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: connection,
+	}), nil)
+	m.UserAccountServiceMock.On("IsUserInAccount", mock.Anything, mock.MatchedBy(func(req *connect.Request[mgmtv1alpha1.IsUserInAccountRequest]) bool {
+		return req.Msg.GetAccountId() == mockAccountId &&
+			len(req.Msg.GetPermissions()) == 1 &&
+			req.Msg.GetPermissions()[0] == mgmtv1alpha1.AccountPermission_ACCOUNT_PERMISSION_QUERY_CONSOLE
+	})).Return(connect.NewResponse(&mgmtv1alpha1.IsUserInAccountResponse{
+		Ok: false,
+	}), nil)
+
+	resp, err := m.Service.ExecuteReadOnlyQuery(context.Background(), connect.NewRequest(&mgmtv1alpha1.ExecuteReadOnlyQueryRequest{
+		ConnectionId: mockConnectionId,
+		Query:        "SELECT 1",
+	}))
+
+	require.Error(t, err)
+	require.Nil(t, resp)
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	m.SqlConnectorMock.AssertNotCalled(t, "NewDbFromConnectionConfig", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_ExecuteReadOnlyQuery_NotSelect(t *testing.T) {
+	m := createServiceMock(t)
+	m.Service.cfg.IsQueryConsoleEnabled = true
+
+	resp, err := m.Service.ExecuteReadOnlyQuery(context.Background(), connect.NewRequest(&mgmtv1alpha1.ExecuteReadOnlyQueryRequest{
+		ConnectionId: mockConnectionId,
+		Query:        "DELETE FROM public.users",
+	}))
+
+	require.Error(t, err)
+	require.Nil(t, resp)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func Test_getReadOnlyQuery(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+		isValid  bool
+	}{
+		{"SELECT 1", "SELECT 1", true},
+		{"  select * from users;  ", "select * from users", true},
+		{"WITH a AS (SELECT 1) SELECT * FROM a", "WITH a AS (SELECT 1) SELECT * FROM a", true},
+		{"", "", false},
+		{";", "", false},
+		{"SELECT 1; DROP TABLE users", "", false},
+		{"UPDATE users SET name = 'a'", "", false},
+		{"selected", "", false},
+		{"SELECT id FROM users -- trailing comment", "SELECT id FROM users -- trailing comment", true},
+		{"EXPLAIN ANALYZE DELETE FROM users", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			actual, err := getReadOnlyQuery(tt.query)
+			if !tt.isValid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_buildLimitedQuery(t *testing.T) {
+	require.Equal(t, "SELECT * FROM (SELECT 1\n) AS query_console_results LIMIT 11", buildLimitedQuery("SELECT 1", 11))
+	require.Equal(
+		t,
+		"SELECT * FROM (SELECT id FROM users -- trailing comment\n) AS query_console_results LIMIT 101",
+		buildLimitedQuery("SELECT id FROM users -- trailing comment", 101),
+	)
+}
+
+func mockQueryConsoleConnection(m *serviceMocks, connection *mgmtv1alpha1.Connection) {
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: connection,
+	}), nil)
+	m.UserAccountServiceMock.On("GetUser", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetUserResponse{
+		UserId: mockUserId,
+	}), nil)
+	m.SqlConnectorMock.On("NewDbFromConnectionConfig", mock.Anything, mock.Anything, mock.Anything).Return(m.SqlDbContainerMock, nil)
+	m.SqlDbContainerMock.On("Open").Return(m.SqlDbMock, nil)
+	m.SqlDbContainerMock.On("Close").Return(nil)
+}
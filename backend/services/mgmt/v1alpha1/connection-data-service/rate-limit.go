@@ -0,0 +1,27 @@
+package v1alpha1_connectiondataservice
+
+import (
+	"context"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+)
+
+type dataAccessCtxKey struct{}
+
+// Reserves a data access slot against the connection's account and the connection itself.
+// The returned func must be called once the request has finished reading from the connection.
+// Requests made by the worker are never limited so that running jobs are not disrupted.
+// The returned context marks the slot as held so that RPCs calling into other RPCs of this service only reserve a single slot.
+func (s *Service) acquireDataAccess(
+	ctx context.Context,
+	connection *mgmtv1alpha1.Connection,
+) (context.Context, func(), error) {
+	if s.limiter == nil || isWorkerApiKey(ctx) || ctx.Value(dataAccessCtxKey{}) != nil {
+		return ctx, func() {}, nil
+	}
+	release, err := s.limiter.Acquire(connection.GetAccountId(), connection.GetId())
+	if err != nil {
+		return ctx, nil, err
+	}
+	return context.WithValue(ctx, dataAccessCtxKey{}, struct{}{}), release, nil
+}
@@ -7,6 +7,12 @@ import (
 	pg_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/postgresql"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
 	awsmanager "github.com/nucleuscloud/neosync/backend/internal/aws"
+	azuremanager "github.com/nucleuscloud/neosync/backend/internal/azure"
+	elasticsearchmanager "github.com/nucleuscloud/neosync/backend/internal/elasticsearch"
+	gcpmanager "github.com/nucleuscloud/neosync/backend/internal/gcp"
+	kafkamanager "github.com/nucleuscloud/neosync/backend/internal/kafka"
+	"github.com/nucleuscloud/neosync/backend/internal/ratelimit"
+	"github.com/nucleuscloud/neosync/backend/pkg/nonfinite"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
 	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
 )
@@ -17,15 +23,30 @@ type Service struct {
 	connectionService  mgmtv1alpha1connect.ConnectionServiceClient
 	jobService         mgmtv1alpha1connect.JobServiceHandler
 
-	awsManager awsmanager.NeosyncAwsManagerClient
+	awsManager           awsmanager.NeosyncAwsManagerClient
+	gcpManager           gcpmanager.NeosyncGcpManagerClient
+	azureManager         azuremanager.NeosyncAzureManagerClient
+	kafkaManager         kafkamanager.NeosyncKafkaManagerClient
+	elasticsearchManager elasticsearchmanager.NeosyncElasticsearchManagerClient
 
 	sqlConnector sqlconnect.SqlConnector
 	pgquerier    pg_queries.Querier
 	mysqlquerier mysql_queries.Querier
 	sqlmanager   sql_manager.SqlManagerClient
+
+	// optional, only set if data access rate limits have been configured
+	limiter *ratelimit.Limiter
 }
 
 type Config struct {
+	// Per-account and per-connection limits applied to RPCs that read from a connection. No limits are enforced if not provided
+	RateLimits *ratelimit.Config
+	// Allows users to run ad-hoc read-only queries against their connections
+	IsQueryConsoleEnabled bool
+	// Limits applied to data streams so that slow clients do not hold database connections open. Defaults are used if not provided
+	StreamLimits *StreamLimits
+	// What happens to NaN and infinite float and numeric values that are streamed from a connection. Values are preserved if not provided
+	NonFinitePolicy nonfinite.Policy
 }
 
 func New(
@@ -35,6 +56,10 @@ func New(
 	jobService mgmtv1alpha1connect.JobServiceHandler,
 
 	awsManager awsmanager.NeosyncAwsManagerClient,
+	gcpManager gcpmanager.NeosyncGcpManagerClient,
+	azureManager azuremanager.NeosyncAzureManagerClient,
+	kafkaManager kafkamanager.NeosyncKafkaManagerClient,
+	elasticsearchManager elasticsearchmanager.NeosyncElasticsearchManagerClient,
 
 	sqlConnector sqlconnect.SqlConnector,
 	pgquerier pg_queries.Querier,
@@ -44,15 +69,24 @@ func New(
 	mysqlpoolmap := &sync.Map{}
 
 	sqlmanager := sql_manager.NewSqlManager(pgpoolmap, pgquerier, mysqlpoolmap, mysqlquerier, sqlConnector)
+	var limiter *ratelimit.Limiter
+	if cfg.RateLimits != nil {
+		limiter = ratelimit.New(cfg.RateLimits)
+	}
 	return &Service{
-		cfg:                cfg,
-		useraccountService: useraccountService,
-		connectionService:  connectionService,
-		jobService:         jobService,
-		awsManager:         awsManager,
-		sqlConnector:       sqlConnector,
-		pgquerier:          pgquerier,
-		mysqlquerier:       mysqlquerier,
-		sqlmanager:         sqlmanager,
+		cfg:                  cfg,
+		useraccountService:   useraccountService,
+		connectionService:    connectionService,
+		jobService:           jobService,
+		awsManager:           awsManager,
+		gcpManager:           gcpManager,
+		azureManager:         azureManager,
+		kafkaManager:         kafkaManager,
+		elasticsearchManager: elasticsearchManager,
+		sqlConnector:         sqlConnector,
+		pgquerier:            pgquerier,
+		mysqlquerier:         mysqlquerier,
+		sqlmanager:           sqlmanager,
+		limiter:              limiter,
 	}
 }
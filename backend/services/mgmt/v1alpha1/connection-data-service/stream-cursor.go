@@ -0,0 +1,167 @@
+package v1alpha1_connectiondataservice
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	mysql_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/mysql"
+	pg_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/postgresql"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+)
+
+const pgStreamCursorName = "neosync_stream_cursor"
+
+// Runs query through a server side cursor and calls onRow for every row, fetching fetchSize rows at a time.
+// Unlike a plain query, postgres only materializes one batch of the result at a time, which keeps the memory of both the backend and the database bounded for very large tables.
+// db must be a single connection as the cursor only lives within the transaction it was declared in.
+func queryPgCursor(
+	ctx context.Context,
+	db pg_queries.DBTX,
+	query string,
+	args []any,
+	fetchSize int,
+	onRow func(rows pgx.Rows) error,
+) (err error) {
+	if _, err := db.Exec(ctx, "BEGIN READ ONLY;"); err != nil {
+		return fmt.Errorf("unable to begin cursor transaction: %w", err)
+	}
+	defer func() {
+		// the transaction only reads, so it is always rolled back. uses a context that is not canceled so the connection is not returned to the pool mid transaction
+		if _, rollbackErr := db.Exec(context.WithoutCancel(ctx), "ROLLBACK;"); rollbackErr != nil && err == nil {
+			err = fmt.Errorf("unable to close cursor transaction: %w", rollbackErr)
+		}
+	}()
+
+	if _, err := db.Exec(ctx, buildPgDeclareCursorStatement(query), args...); err != nil {
+		return err
+	}
+	fetch := buildPgFetchCursorStatement(fetchSize)
+	for {
+		fetched, err := fetchPgCursor(ctx, db, fetch, onRow)
+		if err != nil {
+			return err
+		}
+		if fetched < fetchSize {
+			return nil
+		}
+	}
+}
+
+func fetchPgCursor(ctx context.Context, db pg_queries.DBTX, fetch string, onRow func(rows pgx.Rows) error) (int, error) {
+	rows, err := db.Query(ctx, fetch)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	fetched := 0
+	for rows.Next() {
+		fetched++
+		if err := onRow(rows); err != nil {
+			return fetched, err
+		}
+	}
+	return fetched, rows.Err()
+}
+
+func buildPgDeclareCursorStatement(query string) string {
+	return fmt.Sprintf("DECLARE %s NO SCROLL CURSOR FOR %s;", pgStreamCursorName, strings.TrimSuffix(strings.TrimSpace(query), ";"))
+}
+
+func buildPgFetchCursorStatement(fetchSize int) string {
+	return fmt.Sprintf("FETCH FORWARD %d FROM %s;", fetchSize, pgStreamCursorName)
+}
+
+// Reads the page of the table in batches of fetchSize rows ordered by its primary key and calls onRow with the values of columnNames for every row.
+// Mysql has no server side cursors for plain queries and keeps a result open on the connection until every row has been read, so a single query
+// over a very large table is held open for as long as the client takes to read it. Each batch instead starts after the key of the last row of the batch before it,
+// which bounds the result that is open at a time. Tables without a primary key can not be batched and are read with a single query.
+func queryMysqlBatches(
+	ctx context.Context,
+	db mysql_queries.DBTX,
+	schema, table string,
+	columnNames, keyColumns []string,
+	page *streamPage,
+	fetchSize int,
+	onRow func(values [][]byte) error,
+) error {
+	selectColumns := sql_manager.EscapeMysqlColumns(columnNames)
+	if len(keyColumns) == 0 {
+		query, args := buildStreamSelectQuery(sql_manager.MysqlDriver, schema, table, selectColumns, nil, page)
+		_, _, err := fetchMysqlBatch(ctx, db, query, args, len(columnNames), onRow)
+		return err
+	}
+	keyIndexes := make([]int, 0, len(keyColumns))
+	for _, col := range keyColumns {
+		idx := slices.Index(columnNames, col)
+		if idx < 0 {
+			return fmt.Errorf("primary key column %s must be selected to read the table in batches", col)
+		}
+		keyIndexes = append(keyIndexes, idx)
+	}
+
+	remaining := page.limit
+	batch := &streamPage{offset: page.offset, after: page.after}
+	for {
+		batch.limit = uint64(fetchSize)
+		if page.limit > 0 && remaining < batch.limit {
+			batch.limit = remaining
+		}
+		query, args := buildStreamSelectQuery(sql_manager.MysqlDriver, schema, table, selectColumns, keyColumns, batch)
+		fetched, last, err := fetchMysqlBatch(ctx, db, query, args, len(columnNames), onRow)
+		if err != nil {
+			return err
+		}
+		if fetched < batch.limit {
+			return nil
+		}
+		if page.limit > 0 {
+			remaining -= fetched
+			if remaining == 0 {
+				return nil
+			}
+		}
+		values := make([]string, 0, len(keyIndexes))
+		for _, idx := range keyIndexes {
+			values = append(values, string(last[idx]))
+		}
+		batch = &streamPage{after: &resumeToken{Schema: schema, Table: table, Columns: keyColumns, Values: values}}
+	}
+}
+
+// Returns the number of rows that were read along with the values of the last one
+func fetchMysqlBatch(
+	ctx context.Context,
+	db mysql_queries.DBTX,
+	query string,
+	args []any,
+	numColumns int,
+	onRow func(values [][]byte) error,
+) (uint64, [][]byte, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer rows.Close()
+
+	var fetched uint64
+	var last [][]byte
+	for rows.Next() {
+		values := make([][]byte, numColumns)
+		valuesWrapped := make([]any, 0, numColumns)
+		for i := range values {
+			valuesWrapped = append(valuesWrapped, &values[i])
+		}
+		if err := rows.Scan(valuesWrapped...); err != nil {
+			return fetched, nil, err
+		}
+		fetched++
+		last = values
+		if err := onRow(values); err != nil {
+			return fetched, nil, err
+		}
+	}
+	return fetched, last, rows.Err()
+}
@@ -0,0 +1,81 @@
+package v1alpha1_connectiondataservice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_buildPgDeclareCursorStatement(t *testing.T) {
+	require.Equal(
+		t,
+		`DECLARE neosync_stream_cursor NO SCROLL CURSOR FOR SELECT "id" FROM "public"."users" WHERE ("id") > ($1) ORDER BY "id";`,
+		buildPgDeclareCursorStatement(`SELECT "id" FROM "public"."users" WHERE ("id") > ($1) ORDER BY "id";`),
+	)
+}
+
+func Test_buildPgFetchCursorStatement(t *testing.T) {
+	require.Equal(t, "FETCH FORWARD 500 FROM neosync_stream_cursor;", buildPgFetchCursorStatement(500))
+}
+
+func Test_queryMysqlBatches(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT `id`, `name` FROM `public`.`users` ORDER BY `id` LIMIT 2;").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow("1", "a").AddRow("2", "b"))
+	mock.ExpectQuery("SELECT `id`, `name` FROM `public`.`users` WHERE (`id`) > (?) ORDER BY `id` LIMIT 2;").
+		WithArgs("2").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow("3", "c"))
+
+	names := []string{}
+	err = queryMysqlBatches(context.Background(), db, "public", "users", []string{"id", "name"}, []string{"id"}, &streamPage{}, 2, func(values [][]byte) error {
+		names = append(names, string(values[1]))
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b", "c"}, names)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_queryMysqlBatches_Limit(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT `id` FROM `public`.`users` ORDER BY `id` LIMIT 2 OFFSET 1;").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("2").AddRow("3"))
+	mock.ExpectQuery("SELECT `id` FROM `public`.`users` WHERE (`id`) > (?) ORDER BY `id` LIMIT 1;").
+		WithArgs("3").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("4"))
+
+	count := 0
+	err = queryMysqlBatches(context.Background(), db, "public", "users", []string{"id"}, []string{"id"}, &streamPage{limit: 3, offset: 1}, 2, func(values [][]byte) error {
+		count++
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, count, "stops once the limit of the page has been read")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_queryMysqlBatches_NoPrimaryKey(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT `name` FROM `public`.`events`;").
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("a").AddRow("b").AddRow("c"))
+
+	count := 0
+	err = queryMysqlBatches(context.Background(), db, "public", "events", []string{"name"}, nil, &streamPage{}, 2, func(values [][]byte) error {
+		count++
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, count, "tables without a primary key are read with a single query")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,135 @@
+package v1alpha1_connectiondataservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+)
+
+type StreamLimits struct {
+	// The longest a single data stream may stay open. Unlimited if zero
+	MaxDuration time.Duration
+	// How long a client may stop reading while the server side buffer is full before the stream is terminated. Defaults to one minute
+	IdleTimeout time.Duration
+	// The number of rows that are buffered on the server ahead of the client. Defaults to 100
+	BufferSize int
+	// The number of rows that are fetched from the database at a time. Defaults to 1000
+	FetchSize int
+}
+
+const (
+	defaultStreamIdleTimeout = time.Minute
+	defaultStreamBufferSize  = 100
+	defaultStreamFetchSize   = 1000
+)
+
+var (
+	errStreamClientIdle  = errors.New("client stopped reading from the stream")
+	errStreamMaxDuration = errors.New("stream exceeded its maximum duration")
+)
+
+func (s *Service) getStreamLimits() *StreamLimits {
+	limits := &StreamLimits{
+		IdleTimeout: defaultStreamIdleTimeout,
+		BufferSize:  defaultStreamBufferSize,
+		FetchSize:   defaultStreamFetchSize,
+	}
+	if s.cfg.StreamLimits == nil {
+		return limits
+	}
+	limits.MaxDuration = s.cfg.StreamLimits.MaxDuration
+	if s.cfg.StreamLimits.IdleTimeout > 0 {
+		limits.IdleTimeout = s.cfg.StreamLimits.IdleTimeout
+	}
+	if s.cfg.StreamLimits.BufferSize > 0 {
+		limits.BufferSize = s.cfg.StreamLimits.BufferSize
+	}
+	if s.cfg.StreamLimits.FetchSize > 0 {
+		limits.FetchSize = s.cfg.StreamLimits.FetchSize
+	}
+	return limits
+}
+
+// Reads rows from the producer into a bounded buffer that is drained by sending each row to the client.
+// The producer runs in its own goroutine with a context that is canceled as soon as the client stops reading for longer than the
+// idle timeout or the stream exceeds its maximum duration, so that database cursors and pool connections are released
+// even while a send to the client is still blocked.
+func (s *Service) streamRows(
+	ctx context.Context,
+	logger *slog.Logger,
+	send func(row map[string][]byte) error,
+	produce func(ctx context.Context, emit func(row map[string][]byte) error) error,
+) error {
+	limits := s.getStreamLimits()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if limits.MaxDuration > 0 {
+		var cancelTimeout context.CancelFunc
+		ctx, cancelTimeout = context.WithTimeoutCause(ctx, limits.MaxDuration, errStreamMaxDuration)
+		defer cancelTimeout()
+	}
+
+	rows := make(chan map[string][]byte, limits.BufferSize)
+	produceErr := make(chan error, 1)
+	go func() {
+		defer close(rows)
+		produceErr <- produce(ctx, func(row map[string][]byte) error {
+			select {
+			case rows <- row:
+				return nil
+			case <-ctx.Done():
+				return context.Cause(ctx)
+			default:
+			}
+			// the buffer is full, the client has until the idle timeout to make room
+			timer := time.NewTimer(limits.IdleTimeout)
+			defer timer.Stop()
+			select {
+			case rows <- row:
+				return nil
+			case <-timer.C:
+				return errStreamClientIdle
+			case <-ctx.Done():
+				return context.Cause(ctx)
+			}
+		})
+	}()
+
+	var sendErr error
+	for row := range rows {
+		if sendErr != nil {
+			// drains the buffer so that the producer is able to exit
+			continue
+		}
+		if err := send(row); err != nil {
+			sendErr = err
+			cancel()
+		}
+	}
+	err := <-produceErr
+	if sendErr != nil {
+		return sendErr
+	}
+
+	switch {
+	case errors.Is(err, errStreamClientIdle):
+		logger.Warn("terminating data stream as the client stopped reading")
+		return nucleuserrors.WithErrorCode(
+			connect.NewError(connect.CodeDeadlineExceeded, fmt.Errorf("%w for longer than %s", errStreamClientIdle, limits.IdleTimeout)),
+			mgmtv1alpha1.ErrorCode_ERROR_CODE_STREAM_CLIENT_IDLE,
+		)
+	case errors.Is(err, errStreamMaxDuration) || (err != nil && errors.Is(context.Cause(ctx), errStreamMaxDuration)):
+		logger.Warn("terminating data stream as it exceeded its maximum duration")
+		return nucleuserrors.WithErrorCode(
+			connect.NewError(connect.CodeDeadlineExceeded, fmt.Errorf("%w of %s", errStreamMaxDuration, limits.MaxDuration)),
+			mgmtv1alpha1.ErrorCode_ERROR_CODE_STREAM_MAX_DURATION_EXCEEDED,
+		)
+	}
+	return err
+}
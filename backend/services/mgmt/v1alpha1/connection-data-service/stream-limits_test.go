@@ -0,0 +1,92 @@
+package v1alpha1_connectiondataservice
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_streamRows(t *testing.T) {
+	s := &Service{cfg: &Config{}}
+	sent := []map[string][]byte{}
+	err := s.streamRows(context.Background(), slog.Default(), func(row map[string][]byte) error {
+		sent = append(sent, row)
+		return nil
+	}, func(ctx context.Context, emit func(row map[string][]byte) error) error {
+		for _, id := range []string{"1", "2", "3"} {
+			if err := emit(map[string][]byte{"id": []byte(id)}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []map[string][]byte{{"id": []byte("1")}, {"id": []byte("2")}, {"id": []byte("3")}}, sent)
+}
+
+func Test_streamRows_ClientIdle(t *testing.T) {
+	s := &Service{cfg: &Config{StreamLimits: &StreamLimits{IdleTimeout: 10 * time.Millisecond, BufferSize: 1}}}
+	producerDone := make(chan struct{})
+	err := s.streamRows(context.Background(), slog.Default(), func(row map[string][]byte) error {
+		// the client does not read again until the producer has given up
+		<-producerDone
+		return nil
+	}, func(ctx context.Context, emit func(row map[string][]byte) error) error {
+		defer close(producerDone)
+		for {
+			if err := emit(map[string][]byte{}); err != nil {
+				return err
+			}
+		}
+	})
+	require.Error(t, err)
+	require.Equal(t, connect.CodeDeadlineExceeded, connect.CodeOf(err))
+	require.Equal(t, mgmtv1alpha1.ErrorCode_ERROR_CODE_STREAM_CLIENT_IDLE, nucleuserrors.GetErrorCode(err))
+}
+
+func Test_streamRows_MaxDuration(t *testing.T) {
+	s := &Service{cfg: &Config{StreamLimits: &StreamLimits{MaxDuration: 10 * time.Millisecond, BufferSize: 1}}}
+	err := s.streamRows(context.Background(), slog.Default(), func(row map[string][]byte) error {
+		return nil
+	}, func(ctx context.Context, emit func(row map[string][]byte) error) error {
+		// simulates a long running query that is canceled with the context
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	require.Error(t, err)
+	require.Equal(t, connect.CodeDeadlineExceeded, connect.CodeOf(err))
+	require.Equal(t, mgmtv1alpha1.ErrorCode_ERROR_CODE_STREAM_MAX_DURATION_EXCEEDED, nucleuserrors.GetErrorCode(err))
+}
+
+func Test_streamRows_SendError(t *testing.T) {
+	s := &Service{cfg: &Config{}}
+	sendErr := errors.New("client disconnected")
+	var producerErr error
+	err := s.streamRows(context.Background(), slog.Default(), func(row map[string][]byte) error {
+		return sendErr
+	}, func(ctx context.Context, emit func(row map[string][]byte) error) error {
+		for {
+			if err := emit(map[string][]byte{}); err != nil {
+				producerErr = err
+				return err
+			}
+		}
+	})
+	require.ErrorIs(t, err, sendErr)
+	require.ErrorIs(t, producerErr, context.Canceled)
+}
+
+func Test_getStreamLimits(t *testing.T) {
+	s := &Service{cfg: &Config{}}
+	require.Equal(t, &StreamLimits{IdleTimeout: time.Minute, BufferSize: 100, FetchSize: 1000}, s.getStreamLimits())
+
+	s = &Service{cfg: &Config{StreamLimits: &StreamLimits{MaxDuration: time.Hour, FetchSize: 250}}}
+	require.Equal(t, &StreamLimits{MaxDuration: time.Hour, IdleTimeout: time.Minute, BufferSize: 100, FetchSize: 250}, s.getStreamLimits())
+}
@@ -0,0 +1,264 @@
+package v1alpha1_connectiondataservice
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+)
+
+// The trailer that holds the token a stream can be resumed from, which is the primary key of the last row that was sent
+const resumeTokenTrailer = "Neosync-Resume-Token"
+
+var errStreamLimitReached = errors.New("stream reached its row limit")
+
+// The page of the table that is streamed
+type streamPage struct {
+	limit  uint64
+	offset uint64
+	// nil if the stream starts from the first row
+	after *resumeToken
+}
+
+// Identifies the last row that was sent by a stream
+type resumeToken struct {
+	Schema  string   `json:"schema"`
+	Table   string   `json:"table"`
+	Columns []string `json:"columns"`
+	Values  []string `json:"values"`
+}
+
+func getStreamPage(req *mgmtv1alpha1.GetConnectionDataStreamRequest) (*streamPage, error) {
+	page := &streamPage{limit: req.GetLimit(), offset: req.GetOffset()}
+	if req.GetResumeToken() == "" {
+		return page, nil
+	}
+	token, err := decodeResumeToken(req.GetResumeToken())
+	if err != nil {
+		return nil, err
+	}
+	if token.Schema != req.GetSchema() || token.Table != req.GetTable() {
+		return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("resume token was issued for %s and can not be used for %s", sql_manager.BuildTable(token.Schema, token.Table), sql_manager.BuildTable(req.GetSchema(), req.GetTable())))
+	}
+	page.after = token
+	return page, nil
+}
+
+// Whether rows are ordered by the primary key so that the stream can be paged through and resumed
+func (p *streamPage) isKeyset() bool {
+	return p.limit > 0 || p.offset > 0 || p.after != nil
+}
+
+func encodeResumeToken(token *resumeToken) (string, error) {
+	bits, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bits), nil
+}
+
+func decodeResumeToken(token string) (*resumeToken, error) {
+	bits, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, nucleuserrors.NewBadRequest("resume token is not valid")
+	}
+	decoded := &resumeToken{}
+	if err := json.Unmarshal(bits, decoded); err != nil || len(decoded.Columns) == 0 || len(decoded.Columns) != len(decoded.Values) {
+		return nil, nucleuserrors.NewBadRequest("resume token is not valid")
+	}
+	return decoded, nil
+}
+
+// Returns the primary key of the table when the stream is paged by it. The stream can not be resumed if the table does not have one
+func (s *Service) getStreamKeyColumns(
+	ctx context.Context,
+	logger *slog.Logger,
+	connection *mgmtv1alpha1.Connection,
+	schema, table string,
+	page *streamPage,
+) ([]string, error) {
+	if !page.isKeyset() {
+		return nil, nil
+	}
+	keyColumns, err := s.getTablePrimaryKey(ctx, logger, connection, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	if page.after != nil && !sameColumns(keyColumns, page.after.Columns) {
+		return nil, nucleuserrors.NewBadRequest("resume token does not match the primary key of the table")
+	}
+	return keyColumns, nil
+}
+
+func (s *Service) getTablePrimaryKey(
+	ctx context.Context,
+	logger *slog.Logger,
+	connection *mgmtv1alpha1.Connection,
+	schema, table string,
+) ([]string, error) {
+	connectionTimeout := 5
+	db, err := s.sqlmanager.NewSqlDb(ctx, logger, connection, &connectionTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Db.Close()
+	primaryKeysMap, err := db.Db.GetPrimaryKeyConstraintsMap(ctx, []string{schema})
+	if err != nil {
+		return nil, err
+	}
+	return primaryKeysMap[sql_manager.BuildTable(schema, table)], nil
+}
+
+func sameColumns(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for idx := range a {
+		if a[idx] != b[idx] {
+			return false
+		}
+	}
+	return true
+}
+
+// Adds the key columns that were not requested, as they are needed to build the resume token of each row
+func withKeyColumns(columns, keyColumns []string) []string {
+	if len(columns) == 0 {
+		return columns
+	}
+	selected := append([]string{}, columns...)
+	for _, col := range keyColumns {
+		if !containsColumn(selected, col) {
+			selected = append(selected, col)
+		}
+	}
+	return selected
+}
+
+func containsColumn(columns []string, col string) bool {
+	for _, c := range columns {
+		if c == col {
+			return true
+		}
+	}
+	return false
+}
+
+// Builds the select query of a page of the table. Rows are ordered by the key columns when the page is paged by them,
+// and start after the row of the resume token when there is one
+func buildStreamSelectQuery(
+	driver, schema, table string,
+	selectColumns []string,
+	keyColumns []string,
+	page *streamPage,
+) (string, []any) {
+	query := fmt.Sprintf("SELECT %s FROM %s", strings.Join(selectColumns, ", "), sql_manager.QuoteTable(driver, schema, table))
+	args := []any{}
+	quotedKeys := sql_manager.QuoteIdentifiers(driver, keyColumns)
+	if page.after != nil && len(keyColumns) > 0 {
+		placeholders := make([]string, 0, len(page.after.Values))
+		for idx, value := range page.after.Values {
+			if driver == sql_manager.PostgresDriver {
+				placeholders = append(placeholders, fmt.Sprintf("$%d", idx+1))
+			} else {
+				placeholders = append(placeholders, "?")
+			}
+			args = append(args, value)
+		}
+		query += fmt.Sprintf(" WHERE (%s) > (%s)", strings.Join(quotedKeys, ", "), strings.Join(placeholders, ", "))
+	}
+	if page.isKeyset() && len(keyColumns) > 0 {
+		query += fmt.Sprintf(" ORDER BY %s", strings.Join(quotedKeys, ", "))
+	}
+	if driver == sql_manager.MssqlDriver {
+		// sql server skips and limits rows with OFFSET and FETCH, which can only follow an ORDER BY
+		if page.limit == 0 && page.offset == 0 {
+			return query + ";", args
+		}
+		if !page.isKeyset() || len(keyColumns) == 0 {
+			query += " ORDER BY (SELECT NULL)"
+		}
+		query += fmt.Sprintf(" OFFSET %d ROWS", page.offset)
+		if page.limit > 0 {
+			query += fmt.Sprintf(" FETCH NEXT %d ROWS ONLY", page.limit)
+		}
+		return query + ";", args
+	}
+	switch {
+	case page.limit > 0:
+		query += fmt.Sprintf(" LIMIT %d", page.limit)
+	case page.offset > 0 && driver != sql_manager.PostgresDriver:
+		// every other driver requires a limit to skip rows
+		query += fmt.Sprintf(" LIMIT %d", math.MaxInt64)
+	}
+	if page.offset > 0 {
+		query += fmt.Sprintf(" OFFSET %d", page.offset)
+	}
+	return query + ";", args
+}
+
+// Sends the rows with the requested columns, and keeps the trailer up to date with the token of the last row that was sent
+func newPagedSend(
+	schema, table string,
+	columns, keyColumns []string,
+	setTrailer func(token string),
+	send func(row map[string][]byte) error,
+) func(row map[string][]byte) error {
+	return func(row map[string][]byte) error {
+		if err := send(filterStreamRow(row, columns)); err != nil {
+			return err
+		}
+		if len(keyColumns) == 0 {
+			return nil
+		}
+		values := make([]string, 0, len(keyColumns))
+		for _, col := range keyColumns {
+			values = append(values, string(row[col]))
+		}
+		token, err := encodeResumeToken(&resumeToken{Schema: schema, Table: table, Columns: keyColumns, Values: values})
+		if err != nil {
+			return err
+		}
+		setTrailer(token)
+		return nil
+	}
+}
+
+// Skips the offset and stops after the limit for the connections whose rows can not be paged by a query
+func pageRows(
+	page *streamPage,
+	produce func(ctx context.Context, emit func(row map[string][]byte) error) error,
+) func(ctx context.Context, emit func(row map[string][]byte) error) error {
+	if page.limit == 0 && page.offset == 0 {
+		return produce
+	}
+	return func(ctx context.Context, emit func(row map[string][]byte) error) error {
+		var seen, sent uint64
+		err := produce(ctx, func(row map[string][]byte) error {
+			seen++
+			if seen <= page.offset {
+				return nil
+			}
+			if err := emit(row); err != nil {
+				return err
+			}
+			sent++
+			if page.limit > 0 && sent >= page.limit {
+				return errStreamLimitReached
+			}
+			return nil
+		})
+		if errors.Is(err, errStreamLimitReached) {
+			return nil
+		}
+		return err
+	}
+}
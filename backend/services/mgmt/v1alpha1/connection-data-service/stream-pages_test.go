@@ -0,0 +1,99 @@
+package v1alpha1_connectiondataservice
+
+import (
+	"context"
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getStreamPage(t *testing.T) {
+	page, err := getStreamPage(&mgmtv1alpha1.GetConnectionDataStreamRequest{Schema: "public", Table: "users"})
+	require.NoError(t, err)
+	require.False(t, page.isKeyset())
+
+	token, err := encodeResumeToken(&resumeToken{Schema: "public", Table: "users", Columns: []string{"id"}, Values: []string{"10"}})
+	require.NoError(t, err)
+	page, err = getStreamPage(&mgmtv1alpha1.GetConnectionDataStreamRequest{Schema: "public", Table: "users", Limit: 5, ResumeToken: token})
+	require.NoError(t, err)
+	require.True(t, page.isKeyset())
+	require.Equal(t, []string{"10"}, page.after.Values)
+
+	_, err = getStreamPage(&mgmtv1alpha1.GetConnectionDataStreamRequest{Schema: "public", Table: "accounts", ResumeToken: token})
+	require.Error(t, err)
+	_, err = getStreamPage(&mgmtv1alpha1.GetConnectionDataStreamRequest{Schema: "public", Table: "users", ResumeToken: "not a token"})
+	require.Error(t, err)
+}
+
+func Test_buildStreamSelectQuery(t *testing.T) {
+	query, args := buildStreamSelectQuery(sql_manager.PostgresDriver, "public", "users", []string{`"id"`, `"name"`}, []string{"id"}, &streamPage{})
+	require.Equal(t, `SELECT "id", "name" FROM "public"."users";`, query)
+	require.Empty(t, args)
+
+	query, args = buildStreamSelectQuery(sql_manager.PostgresDriver, "public", "users", []string{`"id"`, `"name"`}, []string{"org_id", "id"}, &streamPage{
+		limit: 100,
+		after: &resumeToken{Columns: []string{"org_id", "id"}, Values: []string{"1", "10"}},
+	})
+	require.Equal(t, `SELECT "id", "name" FROM "public"."users" WHERE ("org_id", "id") > ($1, $2) ORDER BY "org_id", "id" LIMIT 100;`, query)
+	require.Equal(t, []any{"1", "10"}, args)
+
+	query, args = buildStreamSelectQuery(sql_manager.MysqlDriver, "public", "users", []string{"`id`"}, []string{"id"}, &streamPage{
+		offset: 20,
+		after:  &resumeToken{Columns: []string{"id"}, Values: []string{"10"}},
+	})
+	require.Equal(t, "SELECT `id` FROM `public`.`users` WHERE (`id`) > (?) ORDER BY `id` LIMIT 9223372036854775807 OFFSET 20;", query)
+	require.Equal(t, []any{"10"}, args)
+
+	query, _ = buildStreamSelectQuery(sql_manager.PostgresDriver, "public", "users", []string{`"id"`}, nil, &streamPage{offset: 20})
+	require.Equal(t, `SELECT "id" FROM "public"."users" OFFSET 20;`, query)
+
+	query, _ = buildStreamSelectQuery(sql_manager.MssqlDriver, "dbo", "users", []string{`"id"`}, nil, &streamPage{offset: 20, limit: 100})
+	require.Equal(t, `SELECT "id" FROM "dbo"."users" ORDER BY (SELECT NULL) OFFSET 20 ROWS FETCH NEXT 100 ROWS ONLY;`, query)
+
+	query, _ = buildStreamSelectQuery(sql_manager.MssqlDriver, "dbo", "users", []string{`"id"`}, nil, &streamPage{})
+	require.Equal(t, `SELECT "id" FROM "dbo"."users";`, query)
+}
+
+func Test_withKeyColumns(t *testing.T) {
+	require.Empty(t, withKeyColumns(nil, []string{"id"}))
+	require.Equal(t, []string{"name", "id"}, withKeyColumns([]string{"name"}, []string{"id"}))
+	require.Equal(t, []string{"id", "name"}, withKeyColumns([]string{"id", "name"}, []string{"id"}))
+}
+
+func Test_newPagedSend(t *testing.T) {
+	sent := []map[string][]byte{}
+	var trailer string
+	send := newPagedSend("public", "users", []string{"name"}, []string{"id"}, func(token string) { trailer = token }, func(row map[string][]byte) error {
+		sent = append(sent, row)
+		return nil
+	})
+
+	require.NoError(t, send(map[string][]byte{"id": []byte("1"), "name": []byte("nick")}))
+	require.NoError(t, send(map[string][]byte{"id": []byte("2"), "name": []byte("alisha")}))
+	require.Equal(t, []map[string][]byte{{"name": []byte("nick")}, {"name": []byte("alisha")}}, sent)
+
+	token, err := decodeResumeToken(trailer)
+	require.NoError(t, err)
+	require.Equal(t, &resumeToken{Schema: "public", Table: "users", Columns: []string{"id"}, Values: []string{"2"}}, token)
+}
+
+func Test_pageRows(t *testing.T) {
+	produce := func(ctx context.Context, emit func(row map[string][]byte) error) error {
+		for _, id := range []string{"1", "2", "3", "4", "5"} {
+			if err := emit(map[string][]byte{"id": []byte(id)}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	rows := []map[string][]byte{}
+	err := pageRows(&streamPage{offset: 1, limit: 2}, produce)(context.Background(), func(row map[string][]byte) error {
+		rows = append(rows, row)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []map[string][]byte{{"id": []byte("2")}, {"id": []byte("3")}}, rows)
+}
@@ -0,0 +1,413 @@
+package v1alpha1_connectiondataservice
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/pkg/nonfinite"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// integers outside of this range can not be represented exactly by a json number
+const maxSafeInteger = 1<<53 - 1
+
+var pgTypeMap = pgtype.NewMap()
+
+// the element types of the postgres array types that are streamed as typed arrays
+var pgArrayElementOIDs = map[uint32]uint32{
+	pgtype.Int2ArrayOID:        pgtype.Int2OID,
+	pgtype.Int4ArrayOID:        pgtype.Int4OID,
+	pgtype.Int8ArrayOID:        pgtype.Int8OID,
+	pgtype.Float4ArrayOID:      pgtype.Float4OID,
+	pgtype.Float8ArrayOID:      pgtype.Float8OID,
+	pgtype.NumericArrayOID:     pgtype.NumericOID,
+	pgtype.BoolArrayOID:        pgtype.BoolOID,
+	pgtype.TimestampArrayOID:   pgtype.TimestampOID,
+	pgtype.TimestamptzArrayOID: pgtype.TimestamptzOID,
+	pgtype.DateArrayOID:        pgtype.DateOID,
+	pgtype.TimeArrayOID:        pgtype.TimeOID,
+	pgtype.TimetzArrayOID:      pgtype.TimetzOID,
+	pgtype.ByteaArrayOID:       pgtype.ByteaOID,
+	pgtype.JSONArrayOID:        pgtype.JSONOID,
+	pgtype.JSONBArrayOID:       pgtype.JSONBOID,
+	pgtype.TextArrayOID:        pgtype.TextOID,
+	pgtype.VarcharArrayOID:     pgtype.VarcharOID,
+	pgtype.BPCharArrayOID:      pgtype.BPCharOID,
+	pgtype.UUIDArrayOID:        pgtype.UUIDOID,
+	pgtype.IntervalArrayOID:    pgtype.IntervalOID,
+}
+
+// Converts the rows of a stream into typed rows.
+// The producer sets the columns once it has read them from the source and they are sent in the first response of the stream
+type typedRowEncoder struct {
+	mu              sync.Mutex
+	requested       []string
+	nonFinitePolicy nonfinite.Policy
+
+	columns     []*mgmtv1alpha1.StreamColumn
+	columnMap   map[string]*mgmtv1alpha1.StreamColumn
+	columnsSent bool
+}
+
+func newTypedRowEncoder(requested []string, nonFinitePolicy nonfinite.Policy) *typedRowEncoder {
+	return &typedRowEncoder{
+		requested:       requested,
+		nonFinitePolicy: nonFinitePolicy,
+		columnMap:       map[string]*mgmtv1alpha1.StreamColumn{},
+	}
+}
+
+// Sets the columns of the table. Only the requested columns are sent if any were requested,
+// the key columns that are selected to build resume tokens are left out
+func (e *typedRowEncoder) setColumns(columns []*mgmtv1alpha1.StreamColumn) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, column := range columns {
+		e.columnMap[column.GetName()] = column
+	}
+	if len(e.requested) == 0 {
+		e.columns = columns
+		return
+	}
+	e.columns = make([]*mgmtv1alpha1.StreamColumn, 0, len(e.requested))
+	for _, name := range e.requested {
+		if column, ok := e.columnMap[name]; ok {
+			e.columns = append(e.columns, column)
+		}
+	}
+}
+
+// Sends the columns of the stream if they have not been sent yet.
+// Called once the rows have been streamed so that the columns are sent for empty tables
+func (e *typedRowEncoder) flush(send func(*mgmtv1alpha1.GetConnectionDataStreamResponse) error) error {
+	if e == nil {
+		return nil
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.columnsSent {
+		return nil
+	}
+	if err := send(&mgmtv1alpha1.GetConnectionDataStreamResponse{Columns: e.columns}); err != nil {
+		return err
+	}
+	e.columnsSent = true
+	return nil
+}
+
+// Sends the row as a typed row, preceded by the columns if it is the first row of the stream
+func (e *typedRowEncoder) send(row map[string][]byte, send func(*mgmtv1alpha1.GetConnectionDataStreamResponse) error) error {
+	if err := e.flush(send); err != nil {
+		return err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	typedRow := make(map[string]*structpb.Value, len(row))
+	for col, value := range row {
+		typedValue, err := toTypedStreamValue(e.columnMap[col], value, e.nonFinitePolicy)
+		if err != nil {
+			return fmt.Errorf("unable to stream column %s: %w", col, err)
+		}
+		typedRow[col] = typedValue
+	}
+	return send(&mgmtv1alpha1.GetConnectionDataStreamResponse{TypedRow: typedRow})
+}
+
+// Typed postgres columns are selected as their text output, except for bytea columns which are scanned as raw bytes
+func buildPgTypedStreamColumn(name string, dataTypeOID uint32) string {
+	if dataTypeOID == pgtype.ByteaOID {
+		return sql_manager.EscapePgColumn(name)
+	}
+	return fmt.Sprintf("%s::text AS %s", sql_manager.EscapePgColumn(name), sql_manager.EscapePgColumn(name))
+}
+
+func getPgStreamColumn(name string, dataTypeOID uint32) *mgmtv1alpha1.StreamColumn {
+	column := &mgmtv1alpha1.StreamColumn{Name: name, Type: getPgStreamColumnType(dataTypeOID)}
+	if dataType, ok := pgTypeMap.TypeForOID(dataTypeOID); ok {
+		column.DataType = dataType.Name
+	}
+	if elementOID, ok := pgArrayElementOIDs[dataTypeOID]; ok {
+		column.Type = mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_ARRAY
+		column.ElementType = getPgStreamColumnType(elementOID)
+	}
+	return column
+}
+
+func getPgStreamColumnType(dataTypeOID uint32) mgmtv1alpha1.StreamColumnType {
+	switch dataTypeOID {
+	case pgtype.Int2OID, pgtype.Int4OID, pgtype.Int8OID, pgtype.OIDOID:
+		return mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_INTEGER
+	case pgtype.Float4OID, pgtype.Float8OID:
+		return mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_FLOAT
+	case pgtype.NumericOID:
+		return mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_DECIMAL
+	case pgtype.BoolOID:
+		return mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_BOOLEAN
+	case pgtype.TimestampOID, pgtype.TimestamptzOID:
+		return mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_TIMESTAMP
+	case pgtype.DateOID:
+		return mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_DATE
+	case pgtype.TimeOID, pgtype.TimetzOID:
+		return mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_TIME
+	case pgtype.ByteaOID:
+		return mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_BYTES
+	case pgtype.JSONOID, pgtype.JSONBOID:
+		return mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_JSON
+	default:
+		return mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_STRING
+	}
+}
+
+// The database type name is the one reported by the mysql driver, such as UNSIGNED BIGINT or VARCHAR
+func getMysqlStreamColumn(name, databaseTypeName string) *mgmtv1alpha1.StreamColumn {
+	column := &mgmtv1alpha1.StreamColumn{
+		Name:     name,
+		Type:     mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_STRING,
+		DataType: strings.ToLower(databaseTypeName),
+	}
+	switch strings.TrimPrefix(strings.ToUpper(databaseTypeName), "UNSIGNED ") {
+	case "TINYINT", "SMALLINT", "MEDIUMINT", "INT", "BIGINT", "YEAR":
+		column.Type = mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_INTEGER
+	case "FLOAT", "DOUBLE":
+		column.Type = mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_FLOAT
+	case "DECIMAL":
+		column.Type = mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_DECIMAL
+	case "DATETIME", "TIMESTAMP":
+		column.Type = mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_TIMESTAMP
+	case "DATE":
+		column.Type = mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_DATE
+	case "TIME":
+		column.Type = mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_TIME
+	case "JSON":
+		column.Type = mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_JSON
+	case "BINARY", "VARBINARY", "TINYBLOB", "BLOB", "MEDIUMBLOB", "LONGBLOB", "BIT", "GEOMETRY":
+		column.Type = mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_BYTES
+	}
+	return column
+}
+
+// Converts the text form of a value into its typed value. Columns of an unknown type are streamed as strings
+func toTypedStreamValue(column *mgmtv1alpha1.StreamColumn, value []byte, nonFinitePolicy nonfinite.Policy) (*structpb.Value, error) {
+	if value == nil {
+		return structpb.NewNullValue(), nil
+	}
+	if column.GetType() == mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_ARRAY {
+		elements, err := parsePgArray(string(value))
+		if err != nil {
+			// arrays that can not be parsed are streamed as their text form
+			return structpb.NewStringValue(string(value)), nil
+		}
+		return toTypedStreamArray(column.GetElementType(), elements, nonFinitePolicy)
+	}
+	return toTypedStreamScalar(column.GetType(), value, nonFinitePolicy)
+}
+
+func toTypedStreamScalar(columnType mgmtv1alpha1.StreamColumnType, value []byte, nonFinitePolicy nonfinite.Policy) (*structpb.Value, error) {
+	text := string(value)
+	switch columnType {
+	case mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_INTEGER:
+		if n, err := strconv.ParseInt(text, 10, 64); err == nil {
+			if n >= -maxSafeInteger && n <= maxSafeInteger {
+				return structpb.NewNumberValue(float64(n)), nil
+			}
+			return structpb.NewStringValue(text), nil
+		}
+		if _, err := strconv.ParseUint(text, 10, 64); err == nil {
+			return structpb.NewStringValue(text), nil
+		}
+		return nil, fmt.Errorf("%q is not an integer", text)
+
+	case mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_FLOAT, mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_DECIMAL:
+		value, err := nonFinitePolicy.ApplyText(value)
+		if err != nil {
+			return nil, err
+		}
+		if value == nil {
+			return structpb.NewNullValue(), nil
+		}
+		if columnType == mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_DECIMAL || nonfinite.IsNonFiniteText(value) {
+			return structpb.NewStringValue(string(value)), nil
+		}
+		f, err := strconv.ParseFloat(string(value), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a float", value)
+		}
+		return structpb.NewNumberValue(f), nil
+
+	case mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_BOOLEAN:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a boolean", text)
+		}
+		return structpb.NewBoolValue(b), nil
+
+	case mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_TIMESTAMP:
+		return structpb.NewStringValue(formatStreamTimestamp(text)), nil
+
+	case mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_BYTES:
+		return structpb.NewStringValue(base64.StdEncoding.EncodeToString(value)), nil
+
+	case mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_JSON:
+		jsonValue := &structpb.Value{}
+		if err := protojson.Unmarshal(value, jsonValue); err != nil {
+			return nil, fmt.Errorf("unable to decode json value: %w", err)
+		}
+		return jsonValue, nil
+
+	default:
+		return structpb.NewStringValue(text), nil
+	}
+}
+
+func toTypedStreamArray(elementType mgmtv1alpha1.StreamColumnType, elements []any, nonFinitePolicy nonfinite.Policy) (*structpb.Value, error) {
+	values := make([]*structpb.Value, 0, len(elements))
+	for _, element := range elements {
+		switch e := element.(type) {
+		case []any:
+			value, err := toTypedStreamArray(elementType, e, nonFinitePolicy)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		case string:
+			elementValue := []byte(e)
+			if elementType == mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_BYTES {
+				// bytea elements are in the hex format of the array's text output
+				decoded, err := hex.DecodeString(strings.TrimPrefix(e, `\x`))
+				if err != nil {
+					return nil, fmt.Errorf("unable to decode bytea array element: %w", err)
+				}
+				elementValue = decoded
+			}
+			value, err := toTypedStreamScalar(elementType, elementValue, nonFinitePolicy)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		default:
+			values = append(values, structpb.NewNullValue())
+		}
+	}
+	return structpb.NewListValue(&structpb.ListValue{Values: values}), nil
+}
+
+// Converts the text form of a postgres or mysql timestamp to ISO 8601. The offset is only present for columns with a time zone.
+// Values that are not in the expected form, such as infinity or BC dates, are returned as is
+func formatStreamTimestamp(text string) string {
+	if strings.HasSuffix(text, " BC") {
+		return text
+	}
+	date, clock, ok := strings.Cut(text, " ")
+	if !ok {
+		return text
+	}
+	// postgres leaves out the minutes of whole hour offsets
+	if idx := strings.LastIndexAny(clock, "+-"); idx >= 0 && len(clock)-idx == 3 {
+		clock += ":00"
+	}
+	return date + "T" + clock
+}
+
+// Parses the text form of a postgres array into nested slices of strings, with nil for NULL elements
+func parsePgArray(text string) ([]any, error) {
+	// arrays that do not start at index 1 are prefixed with their dimensions, e.g. [0:1]={1,2}
+	if strings.HasPrefix(text, "[") {
+		if idx := strings.Index(text, "="); idx >= 0 {
+			text = text[idx+1:]
+		}
+	}
+	parser := &pgArrayParser{input: text}
+	elements, err := parser.parseArray()
+	if err != nil {
+		return nil, err
+	}
+	if parser.pos != len(parser.input) {
+		return nil, fmt.Errorf("unexpected characters after array at position %d", parser.pos)
+	}
+	return elements, nil
+}
+
+type pgArrayParser struct {
+	input string
+	pos   int
+}
+
+func (p *pgArrayParser) consume(c byte) bool {
+	if p.pos < len(p.input) && p.input[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *pgArrayParser) parseArray() ([]any, error) {
+	if !p.consume('{') {
+		return nil, fmt.Errorf("expected { at position %d", p.pos)
+	}
+	elements := []any{}
+	if p.consume('}') {
+		return elements, nil
+	}
+	for {
+		element, err := p.parseElement()
+		if err != nil {
+			return nil, err
+		}
+		elements = append(elements, element)
+		if p.consume(',') {
+			continue
+		}
+		if p.consume('}') {
+			return elements, nil
+		}
+		return nil, fmt.Errorf("expected , or } at position %d", p.pos)
+	}
+}
+
+func (p *pgArrayParser) parseElement() (any, error) {
+	if p.pos >= len(p.input) {
+		return nil, errors.New("unexpected end of array")
+	}
+	switch p.input[p.pos] {
+	case '{':
+		return p.parseArray()
+	case '"':
+		p.pos++
+		var sb strings.Builder
+		for p.pos < len(p.input) {
+			c := p.input[p.pos]
+			p.pos++
+			switch c {
+			case '\\':
+				if p.pos < len(p.input) {
+					sb.WriteByte(p.input[p.pos])
+					p.pos++
+				}
+			case '"':
+				return sb.String(), nil
+			default:
+				sb.WriteByte(c)
+			}
+		}
+		return nil, errors.New("unterminated quoted array element")
+	default:
+		start := p.pos
+		for p.pos < len(p.input) && p.input[p.pos] != ',' && p.input[p.pos] != '}' {
+			p.pos++
+		}
+		element := strings.TrimSpace(p.input[start:p.pos])
+		if element == "NULL" {
+			return nil, nil
+		}
+		return element, nil
+	}
+}
@@ -0,0 +1,126 @@
+package v1alpha1_connectiondataservice
+
+import (
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/pkg/nonfinite"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func Test_typedRowEncoder(t *testing.T) {
+	responses := []*mgmtv1alpha1.GetConnectionDataStreamResponse{}
+	send := func(resp *mgmtv1alpha1.GetConnectionDataStreamResponse) error {
+		responses = append(responses, resp)
+		return nil
+	}
+
+	encoder := newTypedRowEncoder([]string{"name", "id"}, nonfinite.PolicyPreserve)
+	encoder.setColumns([]*mgmtv1alpha1.StreamColumn{
+		getPgStreamColumn("id", pgtype.Int8OID),
+		getPgStreamColumn("name", pgtype.TextOID),
+		getPgStreamColumn("org_id", pgtype.Int8OID),
+	})
+	require.NoError(t, encoder.send(map[string][]byte{"id": []byte("1"), "name": nil}, send))
+	require.NoError(t, encoder.send(map[string][]byte{"id": []byte("2"), "name": []byte("nick")}, send))
+	require.NoError(t, encoder.flush(send))
+
+	require.Len(t, responses, 3)
+	require.Equal(t, []string{"name", "id"}, []string{responses[0].GetColumns()[0].GetName(), responses[0].GetColumns()[1].GetName()})
+	require.Equal(t, "int8", responses[0].GetColumns()[1].GetDataType())
+	require.Nil(t, responses[0].GetTypedRow())
+	require.Equal(t, float64(1), responses[1].GetTypedRow()["id"].GetNumberValue())
+	require.Equal(t, "nick", responses[2].GetTypedRow()["name"].GetStringValue())
+
+	// the columns are sent for tables without rows
+	responses = nil
+	encoder = newTypedRowEncoder(nil, nonfinite.PolicyPreserve)
+	encoder.setColumns([]*mgmtv1alpha1.StreamColumn{getMysqlStreamColumn("id", "UNSIGNED BIGINT")})
+	require.NoError(t, encoder.flush(send))
+	require.Len(t, responses, 1)
+	require.Equal(t, mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_INTEGER, responses[0].GetColumns()[0].GetType())
+	require.Equal(t, "unsigned bigint", responses[0].GetColumns()[0].GetDataType())
+
+	var nilEncoder *typedRowEncoder
+	require.NoError(t, nilEncoder.flush(send))
+}
+
+func Test_getPgStreamColumn(t *testing.T) {
+	column := getPgStreamColumn("tags", pgtype.TextArrayOID)
+	require.Equal(t, mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_ARRAY, column.GetType())
+	require.Equal(t, mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_STRING, column.GetElementType())
+	require.Equal(t, "_text", column.GetDataType())
+
+	require.Equal(t, mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_TIMESTAMP, getPgStreamColumn("created_at", pgtype.TimestamptzOID).GetType())
+	require.Equal(t, mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_JSON, getPgStreamColumn("data", pgtype.JSONBOID).GetType())
+	// user defined types such as enums are streamed as strings
+	require.Equal(t, mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_STRING, getPgStreamColumn("status", 16384).GetType())
+}
+
+func Test_getMysqlStreamColumn(t *testing.T) {
+	tests := map[string]mgmtv1alpha1.StreamColumnType{
+		"INT":      mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_INTEGER,
+		"DECIMAL":  mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_DECIMAL,
+		"DOUBLE":   mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_FLOAT,
+		"DATETIME": mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_TIMESTAMP,
+		"DATE":     mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_DATE,
+		"JSON":     mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_JSON,
+		"BLOB":     mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_BYTES,
+		"VARCHAR":  mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_STRING,
+		"ENUM":     mgmtv1alpha1.StreamColumnType_STREAM_COLUMN_TYPE_STRING,
+	}
+	for databaseTypeName, expected := range tests {
+		require.Equal(t, expected, getMysqlStreamColumn("col", databaseTypeName).GetType(), databaseTypeName)
+	}
+}
+
+func Test_toTypedStreamValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		column   *mgmtv1alpha1.StreamColumn
+		value    []byte
+		expected string
+	}{
+		{"null", getPgStreamColumn("id", pgtype.Int4OID), nil, `null`},
+		{"integer", getPgStreamColumn("id", pgtype.Int4OID), []byte("-42"), `-42`},
+		{"large integer", getPgStreamColumn("id", pgtype.Int8OID), []byte("9223372036854775807"), `"9223372036854775807"`},
+		{"unsigned integer", getMysqlStreamColumn("id", "UNSIGNED BIGINT"), []byte("18446744073709551615"), `"18446744073709551615"`},
+		{"float", getPgStreamColumn("score", pgtype.Float8OID), []byte("1.5"), `1.5`},
+		{"nan", getPgStreamColumn("score", pgtype.Float8OID), []byte("NaN"), `"NaN"`},
+		{"decimal", getPgStreamColumn("price", pgtype.NumericOID), []byte("12345678901234567890.12"), `"12345678901234567890.12"`},
+		{"pg boolean", getPgStreamColumn("active", pgtype.BoolOID), []byte("true"), `true`},
+		{"timestamptz", getPgStreamColumn("created_at", pgtype.TimestamptzOID), []byte("2024-01-02 03:04:05.123456+05:30"), `"2024-01-02T03:04:05.123456+05:30"`},
+		{"timestamptz whole hour offset", getPgStreamColumn("created_at", pgtype.TimestamptzOID), []byte("2024-01-02 03:04:05-07"), `"2024-01-02T03:04:05-07:00"`},
+		{"timestamp infinity", getPgStreamColumn("created_at", pgtype.TimestampOID), []byte("infinity"), `"infinity"`},
+		{"mysql datetime", getMysqlStreamColumn("created_at", "DATETIME"), []byte("2024-01-02 03:04:05"), `"2024-01-02T03:04:05"`},
+		{"date", getPgStreamColumn("birthday", pgtype.DateOID), []byte("2024-01-02"), `"2024-01-02"`},
+		{"bytes", getPgStreamColumn("data", pgtype.ByteaOID), []byte{0x01, 0xff}, `"Af8="`},
+		{"json", getPgStreamColumn("data", pgtype.JSONBOID), []byte(`{"a": [1, "b", null]}`), `{"a":[1,"b",null]}`},
+		{"int array", getPgStreamColumn("ids", pgtype.Int4ArrayOID), []byte("{{1,2},{3,NULL}}"), `[[1,2],[3,null]]`},
+		{"text array", getPgStreamColumn("tags", pgtype.TextArrayOID), []byte(`{a,"b,c","d \"e\"",NULL,"NULL"}`), `["a","b,c","d \"e\"",null,"NULL"]`},
+		{"bytea array", getPgStreamColumn("data", pgtype.ByteaArrayOID), []byte(`{"\\x01ff"}`), `["Af8="]`},
+		{"array with dimensions", getPgStreamColumn("ids", pgtype.Int4ArrayOID), []byte("[0:1]={1,2}"), `[1,2]`},
+		{"malformed array", getPgStreamColumn("ids", pgtype.Int4ArrayOID), []byte("{1,2"), `"{1,2"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := toTypedStreamValue(tt.column, tt.value, nonfinite.PolicyPreserve)
+			require.NoError(t, err)
+			actual, err := protojson.Marshal(value)
+			require.NoError(t, err)
+			require.JSONEq(t, tt.expected, string(actual))
+		})
+	}
+
+	value, err := toTypedStreamValue(getPgStreamColumn("score", pgtype.Float8OID), []byte("Infinity"), nonfinite.PolicyNull)
+	require.NoError(t, err)
+	_, ok := value.GetKind().(*structpb.Value_NullValue)
+	require.True(t, ok)
+	_, err = toTypedStreamValue(getPgStreamColumn("score", pgtype.Float8OID), []byte("Infinity"), nonfinite.PolicyFail)
+	require.Error(t, err)
+	_, err = toTypedStreamValue(getPgStreamColumn("id", pgtype.Int4OID), []byte("abc"), nonfinite.PolicyPreserve)
+	require.Error(t, err)
+}
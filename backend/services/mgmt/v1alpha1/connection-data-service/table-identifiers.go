@@ -0,0 +1,82 @@
+package v1alpha1_connectiondataservice
+
+import (
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+)
+
+// Table maps are keyed by <schema>.<table>, which is ambiguous when a name contains a dot.
+// Schema "a.b" with table "c" and schema "a" with table "b.c" both have the key "a.b.c"
+type tableKeys map[string][]*mgmtv1alpha1.TableIdentifier
+
+func getTableKeys(tables []*mgmtv1alpha1.TableIdentifier) tableKeys {
+	keys := tableKeys{}
+	for _, table := range tables {
+		key := sql_manager.BuildTable(table.Schema, table.Table)
+		keys[key] = append(keys[key], table)
+	}
+	return keys
+}
+
+// Returns the table that the key belongs to, or nil if the key is unknown or shared by more than one table
+func (t tableKeys) resolve(key string) *mgmtv1alpha1.TableIdentifier {
+	tables := t[key]
+	if len(tables) != 1 {
+		return nil
+	}
+	return tables[0]
+}
+
+func (t tableKeys) hasCollisions() bool {
+	for _, tables := range t {
+		if len(tables) > 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns each table of the connection once, in the order they are first listed in its schema
+func getTableIdentifiers(columns []*mgmtv1alpha1.DatabaseColumn) []*mgmtv1alpha1.TableIdentifier {
+	seen := map[string]map[string]struct{}{}
+	tables := []*mgmtv1alpha1.TableIdentifier{}
+	for _, col := range columns {
+		if _, ok := seen[col.Schema]; !ok {
+			seen[col.Schema] = map[string]struct{}{}
+		}
+		if _, ok := seen[col.Schema][col.Table]; ok {
+			continue
+		}
+		seen[col.Schema][col.Table] = struct{}{}
+		tables = append(tables, &mgmtv1alpha1.TableIdentifier{Schema: col.Schema, Table: col.Table})
+	}
+	return tables
+}
+
+type schemaGroup struct {
+	schemas []string
+	tables  []*mgmtv1alpha1.TableIdentifier
+}
+
+// Constraints are read for all schemas at once, and returned in maps keyed by <schema>.<table>.
+// If two tables share the same key their constraints would be merged, so each schema is read on its own instead.
+// The keys of the tables within a single schema never collide
+func getSchemaGroups(tables []*mgmtv1alpha1.TableIdentifier) []*schemaGroup {
+	schemas := []string{}
+	tablesBySchema := map[string][]*mgmtv1alpha1.TableIdentifier{}
+	for _, table := range tables {
+		if _, ok := tablesBySchema[table.Schema]; !ok {
+			schemas = append(schemas, table.Schema)
+		}
+		tablesBySchema[table.Schema] = append(tablesBySchema[table.Schema], table)
+	}
+
+	if !getTableKeys(tables).hasCollisions() {
+		return []*schemaGroup{{schemas: schemas, tables: tables}}
+	}
+	groups := make([]*schemaGroup, 0, len(schemas))
+	for _, schema := range schemas {
+		groups = append(groups, &schemaGroup{schemas: []string{schema}, tables: tablesBySchema[schema]})
+	}
+	return groups
+}
@@ -0,0 +1,62 @@
+package v1alpha1_connectiondataservice
+
+import (
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getTableIdentifiers(t *testing.T) {
+	tables := getTableIdentifiers([]*mgmtv1alpha1.DatabaseColumn{
+		{Schema: "public", Table: "users", Column: "id"},
+		{Schema: "public", Table: "users", Column: "name"},
+		{Schema: "a.b", Table: "c", Column: "id"},
+		{Schema: "a", Table: "b.c", Column: "id"},
+		{Schema: "public", Table: "accounts", Column: "id"},
+	})
+	require.Equal(t, []*mgmtv1alpha1.TableIdentifier{
+		{Schema: "public", Table: "users"},
+		{Schema: "a.b", Table: "c"},
+		{Schema: "a", Table: "b.c"},
+		{Schema: "public", Table: "accounts"},
+	}, tables)
+}
+
+func Test_tableKeys_resolve(t *testing.T) {
+	keys := getTableKeys([]*mgmtv1alpha1.TableIdentifier{
+		{Schema: "public", Table: "users"},
+		{Schema: "a.b", Table: "c"},
+		{Schema: "a", Table: "b.c"},
+	})
+	require.True(t, keys.hasCollisions())
+	require.Equal(t, &mgmtv1alpha1.TableIdentifier{Schema: "public", Table: "users"}, keys.resolve("public.users"))
+	require.Nil(t, keys.resolve("a.b.c"), "a shared key must not resolve to either table")
+	require.Nil(t, keys.resolve("public.accounts"))
+}
+
+func Test_getSchemaGroups(t *testing.T) {
+	t.Run("no collisions", func(t *testing.T) {
+		tables := []*mgmtv1alpha1.TableIdentifier{
+			{Schema: "public", Table: "users"},
+			{Schema: "neosync", Table: "accounts"},
+		}
+		groups := getSchemaGroups(tables)
+		require.Len(t, groups, 1)
+		require.Equal(t, []string{"public", "neosync"}, groups[0].schemas)
+		require.Equal(t, tables, groups[0].tables)
+	})
+
+	t.Run("collisions", func(t *testing.T) {
+		groups := getSchemaGroups([]*mgmtv1alpha1.TableIdentifier{
+			{Schema: "a.b", Table: "c"},
+			{Schema: "a", Table: "b.c"},
+			{Schema: "a.b", Table: "d"},
+		})
+		require.Len(t, groups, 2)
+		require.Equal(t, []string{"a.b"}, groups[0].schemas)
+		require.Equal(t, []*mgmtv1alpha1.TableIdentifier{{Schema: "a.b", Table: "c"}, {Schema: "a.b", Table: "d"}}, groups[0].tables)
+		require.Equal(t, []string{"a"}, groups[1].schemas)
+		require.Equal(t, []*mgmtv1alpha1.TableIdentifier{{Schema: "a", Table: "b.c"}}, groups[1].tables)
+	})
+}
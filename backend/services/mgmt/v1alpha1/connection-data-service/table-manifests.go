@@ -0,0 +1,240 @@
+package v1alpha1_connectiondataservice
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	awsmanager "github.com/nucleuscloud/neosync/backend/internal/aws"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func (s *Service) GetTableRunManifest(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetTableRunManifestRequest],
+) (*connect.Response[mgmtv1alpha1.GetTableRunManifestResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("connectionId", req.Msg.GetConnectionId(), "jobRunId", req.Msg.GetJobRunId())
+	awsS3Config, err := s.getVerifiedAwsS3Config(ctx, req.Msg.GetConnectionId())
+	if err != nil {
+		return nil, err
+	}
+	s3Client, err := s.awsManager.NewS3Client(ctx, awsS3Config)
+	if err != nil {
+		logger.Error("unable to create AWS S3 client")
+		return nil, err
+	}
+
+	manifest, err := s.getTableRunManifest(ctx, s3Client, awsS3Config, req.Msg.GetJobRunId(), sql_manager.BuildTable(req.Msg.GetSchema(), req.Msg.GetTable()))
+	if err != nil {
+		return nil, err
+	}
+	if manifest == nil {
+		return nil, nucleuserrors.NewNotFound("unable to find manifest for table in job run")
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GetTableRunManifestResponse{
+		Manifest: manifest,
+	}), nil
+}
+
+func (s *Service) VerifyTableRun(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.VerifyTableRunRequest],
+) (*connect.Response[mgmtv1alpha1.VerifyTableRunResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("connectionId", req.Msg.GetConnectionId(), "jobRunId", req.Msg.GetJobRunId())
+	awsS3Config, err := s.getVerifiedAwsS3Config(ctx, req.Msg.GetConnectionId())
+	if err != nil {
+		return nil, err
+	}
+	s3Client, err := s.awsManager.NewS3Client(ctx, awsS3Config)
+	if err != nil {
+		logger.Error("unable to create AWS S3 client")
+		return nil, err
+	}
+
+	tableName := sql_manager.BuildTable(req.Msg.GetSchema(), req.Msg.GetTable())
+	manifest, err := s.getTableRunManifest(ctx, s3Client, awsS3Config, req.Msg.GetJobRunId(), tableName)
+	if err != nil {
+		return nil, err
+	}
+	if manifest == nil {
+		return nil, nucleuserrors.NewNotFound("unable to find manifest for table in job run")
+	}
+
+	results := []*mgmtv1alpha1.TableRunFileVerification{}
+	valid := true
+	manifestKeys := map[string]struct{}{}
+	for _, file := range manifest.GetFiles() {
+		manifestKeys[file.GetKey()] = struct{}{}
+		result, err := s.verifyTableRunFile(ctx, logger, s3Client, awsS3Config, file)
+		if err != nil {
+			return nil, err
+		}
+		valid = valid && result.GetValid()
+		results = append(results, result)
+	}
+
+	// files that are not in the manifest were written by an attempt the manifest does not account for
+	unlisted, err := s.getUnlistedTableRunFiles(ctx, s3Client, awsS3Config, req.Msg.GetJobRunId(), tableName, manifestKeys)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range unlisted {
+		valid = false
+		results = append(results, &mgmtv1alpha1.TableRunFileVerification{
+			Key:   key,
+			Valid: false,
+			Error: aws.String("file is not listed in the manifest"),
+		})
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.VerifyTableRunResponse{
+		Valid: valid,
+		Files: results,
+	}), nil
+}
+
+// returns the AWS S3 config of the connection after checking that the user has access to it
+func (s *Service) getVerifiedAwsS3Config(ctx context.Context, connectionId string) (*mgmtv1alpha1.AwsS3ConnectionConfig, error) {
+	connResp, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+		Id: connectionId,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	connection := connResp.Msg.GetConnection()
+	_, err = s.verifyUserInAccount(ctx, connection.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	awsS3Config := connection.GetConnectionConfig().GetAwsS3Config()
+	if awsS3Config == nil {
+		return nil, nucleuserrors.NewBadRequest("table run manifests are only available for AWS S3 connections")
+	}
+	return awsS3Config, nil
+}
+
+func getTableRunManifestKey(jobRunId, tableName string) string {
+	return fmt.Sprintf("workflows/%s/activities/%s/manifest.json", jobRunId, tableName)
+}
+
+// returns the manifest written alongside the table's output for a job run.
+// returns nil if the job run did not write a manifest for the table, such as runs from before manifests were written
+func (s *Service) getTableRunManifest(
+	ctx context.Context,
+	s3Client *s3.Client,
+	awsS3Config *mgmtv1alpha1.AwsS3ConnectionConfig,
+	jobRunId, tableName string,
+) (*mgmtv1alpha1.TableRunManifest, error) {
+	result, err := s.awsManager.GetObject(ctx, s3Client, awsS3Config.Region, &s3.GetObjectInput{
+		Bucket: aws.String(awsS3Config.Bucket),
+		Key:    aws.String(getTableRunManifestKey(jobRunId, tableName)),
+	})
+	if err != nil {
+		if awsmanager.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer result.Body.Close()
+
+	data, err := io.ReadAll(result.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read table run manifest: %w", err)
+	}
+	manifest := &mgmtv1alpha1.TableRunManifest{}
+	err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, manifest)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal table run manifest: %w", err)
+	}
+	return manifest, nil
+}
+
+// re-reads the file from the bucket and compares its size and content hash against the manifest
+func (s *Service) verifyTableRunFile(
+	ctx context.Context,
+	logger *slog.Logger,
+	s3Client *s3.Client,
+	awsS3Config *mgmtv1alpha1.AwsS3ConnectionConfig,
+	file *mgmtv1alpha1.TableRunManifestFile,
+) (*mgmtv1alpha1.TableRunFileVerification, error) {
+	result := &mgmtv1alpha1.TableRunFileVerification{Key: file.GetKey()}
+	object, err := s.awsManager.GetObject(ctx, s3Client, awsS3Config.Region, &s3.GetObjectInput{
+		Bucket: aws.String(awsS3Config.Bucket),
+		Key:    aws.String(file.GetKey()),
+	})
+	if err != nil {
+		if awsmanager.IsNotFound(err) {
+			result.Error = aws.String("file is missing")
+			return result, nil
+		}
+		return nil, err
+	}
+	defer object.Body.Close()
+
+	hasher := sha256.New()
+	size, err := io.Copy(hasher, object.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read %s: %w", file.GetKey(), err)
+	}
+	if size != file.GetSizeBytes() {
+		logger.Warn(fmt.Sprintf("table run file %s has size %d, expected %d", file.GetKey(), size, file.GetSizeBytes()))
+		result.Error = aws.String(fmt.Sprintf("file is %d bytes, expected %d bytes", size, file.GetSizeBytes()))
+		return result, nil
+	}
+	if hex.EncodeToString(hasher.Sum(nil)) != file.GetSha256() {
+		logger.Warn(fmt.Sprintf("table run file %s does not match its content hash", file.GetKey()))
+		result.Error = aws.String("file contents do not match the manifest hash")
+		return result, nil
+	}
+	result.Valid = true
+	return result, nil
+}
+
+// returns the keys of the table's data files that are not in the manifest
+func (s *Service) getUnlistedTableRunFiles(
+	ctx context.Context,
+	s3Client *s3.Client,
+	awsS3Config *mgmtv1alpha1.AwsS3ConnectionConfig,
+	jobRunId, tableName string,
+	manifestKeys map[string]struct{},
+) ([]string, error) {
+	unlisted := []string{}
+	path := fmt.Sprintf("workflows/%s/activities/%s/data", jobRunId, tableName)
+	var pageToken *string
+	for {
+		output, err := s.awsManager.ListObjectsV2(ctx, s3Client, awsS3Config.Region, &s3.ListObjectsV2Input{
+			Bucket:            aws.String(awsS3Config.Bucket),
+			Prefix:            aws.String(path),
+			ContinuationToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if output == nil {
+			break
+		}
+		for _, item := range output.Contents {
+			if _, ok := manifestKeys[aws.ToString(item.Key)]; !ok {
+				unlisted = append(unlisted, aws.ToString(item.Key))
+			}
+		}
+		if output.IsTruncated != nil && *output.IsTruncated {
+			pageToken = output.NextContinuationToken
+			continue
+		}
+		break
+	}
+	return unlisted, nil
+}
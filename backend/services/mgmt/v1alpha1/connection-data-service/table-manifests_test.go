@@ -0,0 +1,163 @@
+package v1alpha1_connectiondataservice
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	mockManifestJobRunId = mockJobId + "-2023-12-21T22:02:35Z"
+)
+
+func Test_GetTableRunManifest(t *testing.T) {
+	m := createServiceMock(t)
+	mockAwsS3Connection(m)
+
+	manifest := &mgmtv1alpha1.TableRunManifest{
+		JobRunId:          mockManifestJobRunId,
+		Schema:            "public",
+		Table:             "regions",
+		Columns:           []string{"region_id", "region_name"},
+		SchemaFingerprint: "abc",
+		Files: []*mgmtv1alpha1.TableRunManifestFile{
+			{Key: "workflows/run/activities/public.regions/data/1.txt.gz", RowCount: 2, SizeBytes: 10, Sha256: "def"},
+		},
+		RowCount:  2,
+		SizeBytes: 10,
+	}
+	mockGetTableRunManifest(t, m, manifest)
+
+	resp, err := m.Service.GetTableRunManifest(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetTableRunManifestRequest{
+		ConnectionId: mockConnectionId,
+		JobRunId:     mockManifestJobRunId,
+		Schema:       "public",
+		Table:        "regions",
+	}))
+	require.NoError(t, err)
+	require.Equal(t, manifest.GetColumns(), resp.Msg.GetManifest().GetColumns())
+	require.Equal(t, manifest.GetSchemaFingerprint(), resp.Msg.GetManifest().GetSchemaFingerprint())
+	require.Len(t, resp.Msg.GetManifest().GetFiles(), 1)
+	require.Equal(t, int64(2), resp.Msg.GetManifest().GetRowCount())
+}
+
+func Test_GetTableRunManifest_NotFound(t *testing.T) {
+	m := createServiceMock(t)
+	mockAwsS3Connection(m)
+
+	m.AwsManagerMock.On("GetObject", mock.Anything, mock.Anything, mock.Anything, &s3.GetObjectInput{
+		Bucket: aws.String("neosync"),
+		Key:    aws.String(getTableRunManifestKey(mockManifestJobRunId, "public.regions")),
+	}).Return(nil, &types.NoSuchKey{})
+
+	resp, err := m.Service.GetTableRunManifest(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetTableRunManifestRequest{
+		ConnectionId: mockConnectionId,
+		JobRunId:     mockManifestJobRunId,
+		Schema:       "public",
+		Table:        "regions",
+	}))
+	require.Error(t, err)
+	require.Nil(t, resp)
+}
+
+func Test_VerifyTableRun(t *testing.T) {
+	m := createServiceMock(t)
+	mockAwsS3Connection(m)
+
+	validKey := "workflows/run/activities/public.regions/data/1.txt.gz"
+	corruptKey := "workflows/run/activities/public.regions/data/2.txt.gz"
+	missingKey := "workflows/run/activities/public.regions/data/3.txt.gz"
+	unlistedKey := "workflows/run/activities/public.regions/data/4.txt.gz"
+	validData := []byte("valid")
+	mockGetTableRunManifest(t, m, &mgmtv1alpha1.TableRunManifest{
+		Files: []*mgmtv1alpha1.TableRunManifestFile{
+			{Key: validKey, SizeBytes: int64(len(validData)), Sha256: sha256Hex(validData)},
+			{Key: corruptKey, SizeBytes: int64(len(validData)), Sha256: sha256Hex(validData)},
+			{Key: missingKey, SizeBytes: 1, Sha256: "abc"},
+		},
+	})
+	mockGetRawObject(m, validKey, validData)
+	mockGetRawObject(m, corruptKey, []byte("dilav"))
+	m.AwsManagerMock.On("GetObject", mock.Anything, mock.Anything, mock.Anything, &s3.GetObjectInput{
+		Bucket: aws.String("neosync"),
+		Key:    aws.String(missingKey),
+	}).Return(nil, &types.NoSuchKey{})
+
+	isTruncated := false
+	m.AwsManagerMock.On("ListObjectsV2", mock.Anything, mock.Anything, mock.Anything, &s3.ListObjectsV2Input{
+		Bucket: aws.String("neosync"),
+		Prefix: aws.String("workflows/" + mockManifestJobRunId + "/activities/public.regions/data"),
+	}).Return(&s3.ListObjectsV2Output{
+		Contents: []types.Object{
+			{Key: aws.String(validKey)},
+			{Key: aws.String(corruptKey)},
+			{Key: aws.String(unlistedKey)},
+		},
+		IsTruncated: &isTruncated,
+	}, nil)
+
+	resp, err := m.Service.VerifyTableRun(context.Background(), connect.NewRequest(&mgmtv1alpha1.VerifyTableRunRequest{
+		ConnectionId: mockConnectionId,
+		JobRunId:     mockManifestJobRunId,
+		Schema:       "public",
+		Table:        "regions",
+	}))
+	require.NoError(t, err)
+	require.False(t, resp.Msg.GetValid())
+
+	files := resp.Msg.GetFiles()
+	require.Len(t, files, 4)
+	require.Equal(t, validKey, files[0].GetKey())
+	require.True(t, files[0].GetValid())
+	require.Nil(t, files[0].Error)
+	require.Equal(t, corruptKey, files[1].GetKey())
+	require.False(t, files[1].GetValid())
+	require.NotEmpty(t, files[1].GetError())
+	require.Equal(t, missingKey, files[2].GetKey())
+	require.False(t, files[2].GetValid())
+	require.NotEmpty(t, files[2].GetError())
+	require.Equal(t, unlistedKey, files[3].GetKey())
+	require.False(t, files[3].GetValid())
+}
+
+func mockAwsS3Connection(m *serviceMocks) {
+	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, AwsS3Mock)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: connection,
+	}), nil)
+	m.AwsManagerMock.On("NewS3Client", mock.Anything, mock.Anything).Return(nil, nil)
+}
+
+func mockGetTableRunManifest(t *testing.T, m *serviceMocks, manifest *mgmtv1alpha1.TableRunManifest) {
+	t.Helper()
+	data, err := protojson.Marshal(manifest)
+	require.NoError(t, err)
+	mockGetRawObject(m, getTableRunManifestKey(mockManifestJobRunId, "public.regions"), data)
+}
+
+func mockGetRawObject(m *serviceMocks, key string, data []byte) {
+	m.AwsManagerMock.On("GetObject", mock.Anything, mock.Anything, mock.Anything, &s3.GetObjectInput{
+		Bucket: aws.String("neosync"),
+		Key:    aws.String(key),
+	}).Return(&s3.GetObjectOutput{
+		Body: io.NopCloser(bytes.NewReader(data)),
+	}, nil)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,166 @@
+package v1alpha1_connectiondataservice
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+
+	"connectrpc.com/connect"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Service) ListTableRuns(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.ListTableRunsRequest],
+) (*connect.Response[mgmtv1alpha1.ListTableRunsResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("connectionId", req.Msg.GetConnectionId(), "jobId", req.Msg.GetJobId())
+	connResp, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+		Id: req.Msg.GetConnectionId(),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	connection := connResp.Msg.GetConnection()
+	_, err = s.verifyUserInAccount(ctx, connection.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	awsS3Config := connection.GetConnectionConfig().GetAwsS3Config()
+	if awsS3Config == nil {
+		return nil, nucleuserrors.NewBadRequest("job run history is only available for AWS S3 connections")
+	}
+
+	jobRunsResp, err := s.jobService.GetJobRecentRuns(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRecentRunsRequest{
+		JobId: req.Msg.GetJobId(),
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	s3Client, err := s.awsManager.NewS3Client(ctx, awsS3Config)
+	if err != nil {
+		logger.Error("unable to create AWS S3 client")
+		return nil, err
+	}
+	logger.Info("created AWS S3 client")
+
+	tableName := sql_manager.BuildTable(req.Msg.GetSchema(), req.Msg.GetTable())
+	runs := []*mgmtv1alpha1.TableRun{}
+	for _, jobRun := range jobRunsResp.Msg.GetRecentRuns() {
+		run, err := s.getTableRunFromAwsS3(ctx, logger, s3Client, awsS3Config, jobRun.GetJobRunId(), tableName)
+		if err != nil {
+			return nil, err
+		}
+		if run == nil {
+			continue
+		}
+		run.StartedAt = jobRun.GetStartTime()
+		runs = append(runs, run)
+	}
+	sort.SliceStable(runs, func(i, j int) bool {
+		return runs[i].GetStartedAt().AsTime().After(runs[j].GetStartedAt().AsTime())
+	})
+
+	return connect.NewResponse(&mgmtv1alpha1.ListTableRunsResponse{
+		Runs: runs,
+	}), nil
+}
+
+// returns the file and row counts for the table's output of a single job run.
+// returns nil if the job run did not write any data for the table
+func (s *Service) getTableRunFromAwsS3(
+	ctx context.Context,
+	logger *slog.Logger,
+	s3Client *s3.Client,
+	awsS3Config *mgmtv1alpha1.AwsS3ConnectionConfig,
+	jobRunId, tableName string,
+) (*mgmtv1alpha1.TableRun, error) {
+	run := &mgmtv1alpha1.TableRun{
+		JobRunId: jobRunId,
+	}
+	path := fmt.Sprintf("workflows/%s/activities/%s/data", jobRunId, tableName)
+	var pageToken *string
+	for {
+		output, err := s.awsManager.ListObjectsV2(ctx, s3Client, awsS3Config.Region, &s3.ListObjectsV2Input{
+			Bucket:            aws.String(awsS3Config.Bucket),
+			Prefix:            aws.String(path),
+			ContinuationToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if output == nil {
+			break
+		}
+		for _, item := range output.Contents {
+			rowCount, err := s.countAwsS3ObjectRows(ctx, s3Client, awsS3Config, *item.Key)
+			if err != nil {
+				return nil, err
+			}
+			run.FileCount++
+			run.RowCount += rowCount
+			if item.Size != nil {
+				run.SizeBytes += *item.Size
+			}
+			if item.LastModified != nil && (run.LastModifiedAt == nil || item.LastModified.After(run.LastModifiedAt.AsTime())) {
+				run.LastModifiedAt = timestamppb.New(*item.LastModified)
+			}
+		}
+		if output.IsTruncated != nil && *output.IsTruncated {
+			pageToken = output.NextContinuationToken
+			continue
+		}
+		break
+	}
+	if run.GetFileCount() == 0 {
+		logger.Debug(fmt.Sprintf("0 files found for path: %s", path))
+		return nil, nil
+	}
+	return run, nil
+}
+
+// counts the number of rows in a gzipped json lines object
+func (s *Service) countAwsS3ObjectRows(
+	ctx context.Context,
+	s3Client *s3.Client,
+	awsS3Config *mgmtv1alpha1.AwsS3ConnectionConfig,
+	key string,
+) (int64, error) {
+	result, err := s.awsManager.GetObject(ctx, s3Client, awsS3Config.Region, &s3.GetObjectInput{
+		Bucket: aws.String(awsS3Config.Bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer result.Body.Close()
+
+	gzr, err := gzip.NewReader(result.Body)
+	if err != nil {
+		return 0, fmt.Errorf("error creating gzip reader: %w", err)
+	}
+	defer gzr.Close()
+
+	var count int64
+	scanner := bufio.NewScanner(gzr)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) > 0 {
+			count++
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
@@ -0,0 +1,137 @@
+package v1alpha1_connectiondataservice
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	mockJobId = "7c54e1ce-3924-477c-bfa8-ab8bd36cfee2"
+)
+
+func Test_ListTableRuns(t *testing.T) {
+	m := createServiceMock(t)
+
+	olderRunId := mockJobId + "-2023-12-21T22:02:35Z"
+	newerRunId := mockJobId + "-2023-12-22T22:02:35Z"
+	emptyRunId := mockJobId + "-2023-12-23T22:02:35Z"
+	olderStart := time.Date(2023, 12, 21, 22, 2, 35, 0, time.UTC)
+	newerStart := time.Date(2023, 12, 22, 22, 2, 35, 0, time.UTC)
+	emptyStart := time.Date(2023, 12, 23, 22, 2, 35, 0, time.UTC)
+
+	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, AwsS3Mock)
+	bucket := connection.GetConnectionConfig().GetAwsS3Config().GetBucket()
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: connection,
+	}), nil)
+	m.JobServiceMock.On("GetJobRecentRuns", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetJobRecentRunsResponse{
+		RecentRuns: []*mgmtv1alpha1.JobRecentRun{
+			{JobRunId: olderRunId, StartTime: timestamppb.New(olderStart)},
+			{JobRunId: newerRunId, StartTime: timestamppb.New(newerStart)},
+			{JobRunId: emptyRunId, StartTime: timestamppb.New(emptyStart)},
+		},
+	}), nil)
+	m.AwsManagerMock.On("NewS3Client", mock.Anything, mock.Anything).Return(nil, nil)
+
+	isTruncated := false
+	olderModified := olderStart.Add(time.Minute)
+	m.AwsManagerMock.On("ListObjectsV2", mock.Anything, mock.Anything, mock.Anything, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String("workflows/" + olderRunId + "/activities/public.regions/data"),
+	}).Return(&s3.ListObjectsV2Output{
+		Contents: []types.Object{
+			{Key: aws.String("older/1.txt.gz"), Size: aws.Int64(10), LastModified: &olderModified},
+		},
+		IsTruncated: &isTruncated,
+	}, nil)
+	newerModified := newerStart.Add(time.Minute)
+	newerLastModified := newerStart.Add(2 * time.Minute)
+	m.AwsManagerMock.On("ListObjectsV2", mock.Anything, mock.Anything, mock.Anything, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String("workflows/" + newerRunId + "/activities/public.regions/data"),
+	}).Return(&s3.ListObjectsV2Output{
+		Contents: []types.Object{
+			{Key: aws.String("newer/1.txt.gz"), Size: aws.Int64(20), LastModified: &newerLastModified},
+			{Key: aws.String("newer/2.txt.gz"), Size: aws.Int64(30), LastModified: &newerModified},
+		},
+		IsTruncated: &isTruncated,
+	}, nil)
+	m.AwsManagerMock.On("ListObjectsV2", mock.Anything, mock.Anything, mock.Anything, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String("workflows/" + emptyRunId + "/activities/public.regions/data"),
+	}).Return(&s3.ListObjectsV2Output{
+		IsTruncated: &isTruncated,
+	}, nil)
+
+	mockGetGzipObject(t, m, "older/1.txt.gz", "{\"region_id\":1}\n")
+	mockGetGzipObject(t, m, "newer/1.txt.gz", "{\"region_id\":1}\n{\"region_id\":2}\n")
+	mockGetGzipObject(t, m, "newer/2.txt.gz", "{\"region_id\":3}\n")
+
+	resp, err := m.Service.ListTableRuns(context.Background(), connect.NewRequest(&mgmtv1alpha1.ListTableRunsRequest{
+		ConnectionId: mockConnectionId,
+		JobId:        mockJobId,
+		Schema:       "public",
+		Table:        "regions",
+	}))
+	require.NoError(t, err)
+
+	runs := resp.Msg.GetRuns()
+	require.Len(t, runs, 2)
+
+	require.Equal(t, newerRunId, runs[0].GetJobRunId())
+	require.Equal(t, newerStart, runs[0].GetStartedAt().AsTime())
+	require.Equal(t, newerLastModified, runs[0].GetLastModifiedAt().AsTime())
+	require.Equal(t, int64(2), runs[0].GetFileCount())
+	require.Equal(t, int64(3), runs[0].GetRowCount())
+	require.Equal(t, int64(50), runs[0].GetSizeBytes())
+
+	require.Equal(t, olderRunId, runs[1].GetJobRunId())
+	require.Equal(t, olderStart, runs[1].GetStartedAt().AsTime())
+	require.Equal(t, int64(1), runs[1].GetFileCount())
+	require.Equal(t, int64(1), runs[1].GetRowCount())
+	require.Equal(t, int64(10), runs[1].GetSizeBytes())
+}
+
+func Test_ListTableRuns_NotAwsS3(t *testing.T) {
+	m := createServiceMock(t)
+
+	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, PostgresMock)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: connection,
+	}), nil)
+
+	resp, err := m.Service.ListTableRuns(context.Background(), connect.NewRequest(&mgmtv1alpha1.ListTableRunsRequest{
+		ConnectionId: mockConnectionId,
+		JobId:        mockJobId,
+		Schema:       "public",
+		Table:        "regions",
+	}))
+	require.Error(t, err)
+	require.Nil(t, resp)
+}
+
+func mockGetGzipObject(t *testing.T, m *serviceMocks, key, contents string) {
+	t.Helper()
+	data, err := gzipData([]byte(contents))
+	require.NoError(t, err)
+	m.AwsManagerMock.On("GetObject", mock.Anything, mock.Anything, mock.Anything, &s3.GetObjectInput{
+		Bucket: aws.String("neosync"),
+		Key:    aws.String(key),
+	}).Return(&s3.GetObjectOutput{
+		Body: io.NopCloser(bytes.NewReader(data)),
+	}, nil)
+}
@@ -15,6 +15,7 @@ import (
 func (s *Service) verifyUserInAccount(
 	ctx context.Context,
 	accountId string,
+	permissions ...mgmtv1alpha1.AccountPermission,
 ) (*pgtype.UUID, error) { //nolint:unparam
 	accountUuid, err := nucleusdb.ToUuid(accountId)
 	if err != nil {
@@ -23,11 +24,17 @@ func (s *Service) verifyUserInAccount(
 	if isWorkerApiKey(ctx) {
 		return &accountUuid, nil
 	}
-	resp, err := s.useraccountService.IsUserInAccount(ctx, connect.NewRequest(&mgmtv1alpha1.IsUserInAccountRequest{AccountId: accountId}))
+	resp, err := s.useraccountService.IsUserInAccount(ctx, connect.NewRequest(&mgmtv1alpha1.IsUserInAccountRequest{
+		AccountId:   accountId,
+		Permissions: permissions,
+	}))
 	if err != nil {
 		return nil, err
 	}
 	if !resp.Msg.Ok {
+		if len(permissions) > 0 {
+			return nil, nucleuserrors.NewForbidden("user is not in requested account or is missing the required account permissions")
+		}
 		return nil, nucleuserrors.NewForbidden("user in not in requested account")
 	}
 
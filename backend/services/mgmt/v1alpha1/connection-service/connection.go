@@ -9,11 +9,13 @@ import (
 	"strings"
 
 	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
 	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
 	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
 	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/idempotency"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
 	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
 )
@@ -40,6 +42,30 @@ func (s *Service) CheckConnectionConfig(
 		}
 		role = user
 
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		// sqlite has no users, access is determined by the permissions of the database file
+
+	case *mgmtv1alpha1.ConnectionConfig_SnowflakeConfig:
+		// privileges are granted to roles, the user's default role is checked when none is configured
+		role = cconfig.SnowflakeConfig.GetRole()
+
+	case *mgmtv1alpha1.ConnectionConfig_RedshiftConfig:
+		role = cconfig.RedshiftConfig.GetUser()
+
+	case *mgmtv1alpha1.ConnectionConfig_DuckdbConfig:
+		// duckdb has no users, access is determined by the permissions of the database file and parquet directory
+
+	case *mgmtv1alpha1.ConnectionConfig_SpannerConfig:
+		// spanner access is granted through IAM to the service account or default credentials, not to database roles
+
+	case *mgmtv1alpha1.ConnectionConfig_BigqueryConfig:
+		// bigquery is not queried through a sql driver, every table the credentials can list is readable
+		return s.checkBigQueryConnectionConfig(ctx, cconfig.BigqueryConfig)
+
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		// the effective permissions of the connected user are checked, as they often come from fixed roles that are not listed as grants
+		role = cconfig.MssqlConfig.GetUser()
+
 	default:
 		return nil, fmt.Errorf("unsupported connection config: %T", cconfig)
 	}
@@ -80,6 +106,49 @@ func (s *Service) CheckConnectionConfig(
 	}), nil
 }
 
+func (s *Service) checkBigQueryConnectionConfig(
+	ctx context.Context,
+	config *mgmtv1alpha1.BigQueryConnectionConfig,
+) (*connect.Response[mgmtv1alpha1.CheckConnectionConfigResponse], error) {
+	client, err := s.gcpManager.GetBigQueryClient(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	columns, err := client.GetDatabaseSchema(ctx)
+	if err != nil {
+		errorMsg := err.Error()
+		return connect.NewResponse(&mgmtv1alpha1.CheckConnectionConfigResponse{
+			IsConnected:     false,
+			ConnectionError: &errorMsg,
+			Privileges:      nil,
+		}), nil
+	}
+
+	privs := []*mgmtv1alpha1.ConnectionRolePrivilege{}
+	seen := map[string]struct{}{}
+	for _, column := range columns {
+		key := fmt.Sprintf("%s.%s", column.Dataset, column.Table)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		privs = append(privs, &mgmtv1alpha1.ConnectionRolePrivilege{
+			Grantee:       config.GetProjectId(),
+			Schema:        column.Dataset,
+			Table:         column.Table,
+			PrivilegeType: []string{"SELECT"},
+		})
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.CheckConnectionConfigResponse{
+		IsConnected:     true,
+		ConnectionError: nil,
+		Privileges:      privs,
+	}), nil
+}
+
 func getPostgresUserFromConnectionConfig(pgconfig *mgmtv1alpha1.PostgresConnectionConfig) (string, error) {
 	switch config := pgconfig.ConnectionConfig.(type) {
 	case *mgmtv1alpha1.PostgresConnectionConfig_Connection:
@@ -154,10 +223,25 @@ func (s *Service) GetConnections(
 		return nil, err
 	}
 
+	connectionIds := make([]pgtype.UUID, 0, len(connections))
+	for idx := range connections {
+		connectionIds = append(connectionIds, connections[idx].ID)
+	}
+	labelsMap, err := s.db.GetResourceLabelsMap(ctx, nucleusdb.LabelResourceTypeConnection, connectionIds)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve connection labels: %w", err)
+	}
+
 	dtoConns := []*mgmtv1alpha1.Connection{}
 	for idx := range connections {
 		connection := connections[idx]
-		dtoConns = append(dtoConns, dtomaps.ToConnectionDto(&connection))
+		labels := labelsMap[nucleusdb.UUIDString(connection.ID)]
+		if !nucleusdb.MatchesLabelSelector(labels, req.Msg.GetLabelSelector()) {
+			continue
+		}
+		dto := dtomaps.ToConnectionDto(&connection)
+		dto.Labels = labels
+		dtoConns = append(dtoConns, dto)
 	}
 
 	return connect.NewResponse(&mgmtv1alpha1.GetConnectionsResponse{
@@ -178,7 +262,7 @@ func (s *Service) GetConnection(
 	if err != nil && !nucleusdb.IsNoRows(err) {
 		return nil, err
 	} else if err != nil && nucleusdb.IsNoRows(err) {
-		return nil, nucleuserrors.NewNotFound("unable to find connection by id")
+		return nil, nucleuserrors.WithErrorCode(nucleuserrors.NewNotFound("unable to find connection by id"), mgmtv1alpha1.ErrorCode_ERROR_CODE_CONNECTION_NOT_FOUND)
 	}
 
 	_, err = s.verifyUserInAccount(ctx, nucleusdb.UUIDString(connection.AccountID))
@@ -210,19 +294,87 @@ func (s *Service) CreateConnection(
 		return nil, err
 	}
 
-	connection, err := s.db.Q.CreateConnection(ctx, s.db.Db, db_queries.CreateConnectionParams{
-		AccountID:        *accountUuid,
-		Name:             req.Msg.Name,
-		ConnectionConfig: cc,
-		CreatedByID:      *userUuid,
-		UpdatedByID:      *userUuid,
+	return idempotency.Do(ctx, s.db, req.Header(), *accountUuid, "CreateConnection", req.Msg, func() (*connect.Response[mgmtv1alpha1.CreateConnectionResponse], error) {
+		connection, err := s.db.Q.CreateConnection(ctx, s.db.Db, db_queries.CreateConnectionParams{
+			AccountID:        *accountUuid,
+			Name:             req.Msg.Name,
+			ConnectionConfig: cc,
+			CreatedByID:      *userUuid,
+			UpdatedByID:      *userUuid,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(req.Msg.GetLabels()) > 0 {
+			err = s.db.SetResourceLabels(ctx, *accountUuid, nucleusdb.LabelResourceTypeConnection, connection.ID, req.Msg.GetLabels())
+			if err != nil {
+				return nil, fmt.Errorf("unable to set connection labels: %w", err)
+			}
+		}
+
+		dto := dtomaps.ToConnectionDto(&connection)
+		dto.Labels = req.Msg.GetLabels()
+		return connect.NewResponse(&mgmtv1alpha1.CreateConnectionResponse{
+			Connection: dto,
+		}), nil
 	})
+}
+
+func (s *Service) BulkCreateConnections(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.BulkCreateConnectionsRequest],
+) (*connect.Response[mgmtv1alpha1.BulkCreateConnectionsResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("accountId", req.Msg.GetAccountId())
+
+	configs := make([]*pg_models.ConnectionConfig, 0, len(req.Msg.GetConnections()))
+	names := map[string]struct{}{}
+	for _, item := range req.Msg.GetConnections() {
+		if _, ok := names[item.GetName()]; ok {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("connection name %q is used more than once", item.GetName()))
+		}
+		names[item.GetName()] = struct{}{}
+		cc := &pg_models.ConnectionConfig{}
+		if err := cc.FromDto(item.GetConnectionConfig()); err != nil {
+			return nil, err
+		}
+		configs = append(configs, cc)
+	}
+
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	userUuid, err := s.getUserUuid(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return connect.NewResponse(&mgmtv1alpha1.CreateConnectionResponse{
-		Connection: dtomaps.ToConnectionDto(&connection),
+	logger.Info(fmt.Sprintf("creating %d connections", len(configs)))
+	dtos := make([]*mgmtv1alpha1.Connection, 0, len(configs))
+	if err := s.db.WithTx(ctx, nil, func(dbtx nucleusdb.BaseDBTX) error {
+		for idx, item := range req.Msg.GetConnections() {
+			connection, err := s.db.Q.CreateConnection(ctx, dbtx, db_queries.CreateConnectionParams{
+				AccountID:        *accountUuid,
+				Name:             item.GetName(),
+				ConnectionConfig: configs[idx],
+				CreatedByID:      *userUuid,
+				UpdatedByID:      *userUuid,
+			})
+			if err != nil && nucleusdb.IsConflict(err) {
+				return nucleuserrors.WithErrorCode(nucleuserrors.NewAlreadyExists(fmt.Sprintf("a connection named %q already exists in this account", item.GetName())), mgmtv1alpha1.ErrorCode_ERROR_CODE_CONNECTION_NAME_TAKEN)
+			} else if err != nil {
+				return fmt.Errorf("unable to create connection %q: %w", item.GetName(), err)
+			}
+			dtos = append(dtos, dtomaps.ToConnectionDto(&connection))
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.BulkCreateConnectionsResponse{
+		Connections: dtos,
 	}), nil
 }
 
@@ -238,7 +390,7 @@ func (s *Service) UpdateConnection(
 	if err != nil && !nucleusdb.IsNoRows(err) {
 		return nil, err
 	} else if err != nil && nucleusdb.IsNoRows(err) {
-		return nil, nucleuserrors.NewNotFound("unable to find connection by id")
+		return nil, nucleuserrors.WithErrorCode(nucleuserrors.NewNotFound("unable to find connection by id"), mgmtv1alpha1.ErrorCode_ERROR_CODE_CONNECTION_NOT_FOUND)
 	}
 
 	_, err = s.verifyUserInAccount(ctx, nucleusdb.UUIDString(connection.AccountID))
@@ -296,9 +448,49 @@ func (s *Service) DeleteConnection(
 	if err != nil {
 		return nil, err
 	}
+	err = s.db.Q.RemoveResourceLabels(ctx, s.db.Db, db_queries.RemoveResourceLabelsParams{
+		ResourceType: nucleusdb.LabelResourceTypeConnection,
+		ResourceID:   connection.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to remove connection labels: %w", err)
+	}
 	return connect.NewResponse(&mgmtv1alpha1.DeleteConnectionResponse{}), nil
 }
 
+func (s *Service) SetConnectionLabels(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.SetConnectionLabelsRequest],
+) (*connect.Response[mgmtv1alpha1.SetConnectionLabelsResponse], error) {
+	idUuid, err := nucleusdb.ToUuid(req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	connection, err := s.db.Q.GetConnectionById(ctx, s.db.Db, idUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return nil, nucleuserrors.WithErrorCode(nucleuserrors.NewNotFound("unable to find connection by id"), mgmtv1alpha1.ErrorCode_ERROR_CODE_CONNECTION_NOT_FOUND)
+	}
+
+	_, err = s.verifyUserInAccount(ctx, nucleusdb.UUIDString(connection.AccountID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.SetResourceLabels(ctx, connection.AccountID, nucleusdb.LabelResourceTypeConnection, connection.ID, req.Msg.GetLabels())
+	if err != nil {
+		return nil, fmt.Errorf("unable to set connection labels: %w", err)
+	}
+
+	dto := dtomaps.ToConnectionDto(&connection)
+	dto.Labels = req.Msg.GetLabels()
+	return connect.NewResponse(&mgmtv1alpha1.SetConnectionLabelsResponse{
+		Connection: dto,
+	}), nil
+}
+
 func (s *Service) CheckSqlQuery(
 	ctx context.Context,
 	req *connect.Request[mgmtv1alpha1.CheckSqlQueryRequest],
@@ -9,6 +9,7 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
@@ -19,6 +20,7 @@ import (
 
 	"github.com/nucleuscloud/neosync/backend/internal/apikey"
 	auth_apikey "github.com/nucleuscloud/neosync/backend/internal/auth/apikey"
+	gcpmanager "github.com/nucleuscloud/neosync/backend/internal/gcp"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
 	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
@@ -113,6 +115,66 @@ func Test_CheckConnectionConfig_Mysql(t *testing.T) {
 	}
 }
 
+func Test_CheckConnectionConfig_Sqlite(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	m.SqlDbContainerMock.On("Open").Return(m.SqlDbMock, nil)
+	m.SqlDbContainerMock.On("Close").Return(nil)
+	m.SqlConnectorMock.On("NewDbFromConnectionConfig", mock.Anything, mock.Anything, mock.Anything).Return(m.SqlDbContainerMock, nil)
+	m.SqlMock.ExpectQuery("sqlite_master").WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("users"))
+
+	resp, err := m.Service.CheckConnectionConfig(context.Background(), &connect.Request[mgmtv1alpha1.CheckConnectionConfigRequest]{
+		Msg: &mgmtv1alpha1.CheckConnectionConfigRequest{
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_SqliteConfig{
+					SqliteConfig: &mgmtv1alpha1.SqliteConnectionConfig{Path: "/tmp/neosync.db"},
+				},
+			},
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, true, resp.Msg.IsConnected)
+	assert.Len(t, resp.Msg.Privileges, 1)
+	assert.Equal(t, "main", resp.Msg.Privileges[0].Schema)
+	assert.Equal(t, "users", resp.Msg.Privileges[0].Table)
+	if err := m.SqlMock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func Test_CheckConnectionConfig_BigQuery(t *testing.T) {
+	m := createServiceMock(t)
+
+	mockBigQueryClient := gcpmanager.NewMockBigQueryClient(t)
+	mockBigQueryClient.On("GetDatabaseSchema", mock.Anything).Return([]*gcpmanager.BigQueryColumn{
+		{Dataset: "analytics", Table: "events", Column: "id", DataType: "INTEGER", OrdinalPosition: 1},
+		{Dataset: "analytics", Table: "events", Column: "name", DataType: "STRING", IsNullable: true, OrdinalPosition: 2},
+	}, nil)
+	mockBigQueryClient.On("Close").Return(nil)
+	m.GcpManagerMock.On("GetBigQueryClient", mock.Anything, mock.Anything).Return(mockBigQueryClient, nil)
+
+	resp, err := m.Service.CheckConnectionConfig(context.Background(), &connect.Request[mgmtv1alpha1.CheckConnectionConfigRequest]{
+		Msg: &mgmtv1alpha1.CheckConnectionConfigRequest{
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_BigqueryConfig{
+					BigqueryConfig: &mgmtv1alpha1.BigQueryConnectionConfig{ProjectId: "neosync-test"},
+				},
+			},
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, true, resp.Msg.IsConnected)
+	assert.Len(t, resp.Msg.Privileges, 1)
+	assert.Equal(t, "analytics", resp.Msg.Privileges[0].Schema)
+	assert.Equal(t, "events", resp.Msg.Privileges[0].Table)
+	assert.Equal(t, []string{"SELECT"}, resp.Msg.Privileges[0].PrivilegeType)
+}
+
 func Test_CheckConnectionConfigs_Fail(t *testing.T) {
 	m := createServiceMock(t)
 	defer m.SqlDbMock.Close()
@@ -208,6 +270,10 @@ func Test_GetConnections(t *testing.T) {
 	connections := []db_queries.NeosyncApiConnection{getConnectionMock(mockAccountId, mockConnectionName, connectionUuid, PostgresMock)}
 	mockIsUserInAccount(m.UserAccountServiceMock, true)
 	m.QuerierMock.On("GetConnectionsByAccount", context.Background(), mock.Anything, accountUuid).Return(connections, nil)
+	m.QuerierMock.On("GetResourceLabels", context.Background(), mock.Anything, db_queries.GetResourceLabelsParams{
+		ResourceType: nucleusdb.LabelResourceTypeConnection,
+		ResourceIds:  []pgtype.UUID{connectionUuid},
+	}).Return([]db_queries.NeosyncApiResourceLabel{{ResourceID: connectionUuid, Key: "team", Value: "payments"}}, nil)
 
 	resp, err := m.Service.GetConnections(context.Background(), &connect.Request[mgmtv1alpha1.GetConnectionsRequest]{
 		Msg: &mgmtv1alpha1.GetConnectionsRequest{
@@ -219,6 +285,39 @@ func Test_GetConnections(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.Equal(t, 1, len(resp.Msg.GetConnections()))
 	assert.Equal(t, mockConnectionId, resp.Msg.Connections[0].Id)
+	assert.Equal(t, map[string]string{"team": "payments"}, resp.Msg.Connections[0].GetLabels())
+}
+
+func Test_GetConnections_LabelSelector(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+	otherConnectionUuid, _ := nucleusdb.ToUuid("1ef1ea6d-1d31-4f4e-9b0c-3a7c36a2d0e4")
+	connections := []db_queries.NeosyncApiConnection{
+		getConnectionMock(mockAccountId, mockConnectionName, connectionUuid, PostgresMock),
+		getConnectionMock(mockAccountId, "other-connection", otherConnectionUuid, PostgresMock),
+	}
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetConnectionsByAccount", context.Background(), mock.Anything, accountUuid).Return(connections, nil)
+	m.QuerierMock.On("GetResourceLabels", context.Background(), mock.Anything, mock.Anything).Return([]db_queries.NeosyncApiResourceLabel{
+		{ResourceID: connectionUuid, Key: "team", Value: "payments"},
+		{ResourceID: connectionUuid, Key: "env", Value: "prod"},
+		{ResourceID: otherConnectionUuid, Key: "team", Value: "growth"},
+	}, nil)
+
+	resp, err := m.Service.GetConnections(context.Background(), &connect.Request[mgmtv1alpha1.GetConnectionsRequest]{
+		Msg: &mgmtv1alpha1.GetConnectionsRequest{
+			AccountId:     mockAccountId,
+			LabelSelector: map[string]string{"team": "payments"},
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(resp.Msg.GetConnections()))
+	assert.Equal(t, mockConnectionId, resp.Msg.Connections[0].Id)
+	assert.Equal(t, map[string]string{"team": "payments", "env": "prod"}, resp.Msg.Connections[0].GetLabels())
 }
 
 func Test_GetConnections_Error(t *testing.T) {
@@ -372,6 +471,87 @@ func Test_CreateConnection_Error(t *testing.T) {
 	assert.Nil(t, resp)
 }
 
+// BulkCreateConnections
+func Test_BulkCreateConnections(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+	mockTx := new(nucleusdb.MockTx)
+
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	m.DbtxMock.On("Begin", mock.Anything).Return(mockTx, nil)
+	mockTx.On("Commit", mock.Anything).Return(nil)
+	mockTx.On("Rollback", mock.Anything).Return(nil)
+	m.QuerierMock.On("CreateConnection", mock.Anything, mockTx, mock.MatchedBy(func(params db_queries.CreateConnectionParams) bool {
+		return params.Name == "conn-one"
+	})).Return(getConnectionMock(mockAccountId, "conn-one", connectionUuid, PostgresMock), nil)
+	m.QuerierMock.On("CreateConnection", mock.Anything, mockTx, mock.MatchedBy(func(params db_queries.CreateConnectionParams) bool {
+		return params.Name == "conn-two"
+	})).Return(getConnectionMock(mockAccountId, "conn-two", connectionUuid, MysqlMock), nil)
+
+	resp, err := m.Service.BulkCreateConnections(context.Background(), connect.NewRequest(&mgmtv1alpha1.BulkCreateConnectionsRequest{
+		AccountId: mockAccountId,
+		Connections: []*mgmtv1alpha1.BulkCreateConnectionItem{
+			{Name: "conn-one", ConnectionConfig: getPostgresConfigMock()},
+			{Name: "conn-two", ConnectionConfig: getMysqlConfigMock()},
+		},
+	}))
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.Msg.GetConnections(), 2)
+	assert.Equal(t, "conn-one", resp.Msg.GetConnections()[0].GetName())
+	assert.Equal(t, "conn-two", resp.Msg.GetConnections()[1].GetName())
+	mockTx.AssertCalled(t, "Commit", mock.Anything)
+}
+
+func Test_BulkCreateConnections_RollsBackOnError(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+	mockTx := new(nucleusdb.MockTx)
+
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	m.DbtxMock.On("Begin", mock.Anything).Return(mockTx, nil)
+	mockTx.On("Rollback", mock.Anything).Return(nil)
+	m.QuerierMock.On("CreateConnection", mock.Anything, mockTx, mock.MatchedBy(func(params db_queries.CreateConnectionParams) bool {
+		return params.Name == "conn-one"
+	})).Return(getConnectionMock(mockAccountId, "conn-one", connectionUuid, PostgresMock), nil)
+	m.QuerierMock.On("CreateConnection", mock.Anything, mockTx, mock.MatchedBy(func(params db_queries.CreateConnectionParams) bool {
+		return params.Name == "conn-two"
+	})).Return(db_queries.NeosyncApiConnection{}, &pgconn.PgError{Code: nucleusdb.PqUniqueViolationCode})
+
+	resp, err := m.Service.BulkCreateConnections(context.Background(), connect.NewRequest(&mgmtv1alpha1.BulkCreateConnectionsRequest{
+		AccountId: mockAccountId,
+		Connections: []*mgmtv1alpha1.BulkCreateConnectionItem{
+			{Name: "conn-one", ConnectionConfig: getPostgresConfigMock()},
+			{Name: "conn-two", ConnectionConfig: getMysqlConfigMock()},
+		},
+	}))
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+	mockTx.AssertNotCalled(t, "Commit", mock.Anything)
+	mockTx.AssertCalled(t, "Rollback", mock.Anything)
+}
+
+func Test_BulkCreateConnections_DuplicateName(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	_, err := m.Service.BulkCreateConnections(context.Background(), connect.NewRequest(&mgmtv1alpha1.BulkCreateConnectionsRequest{
+		AccountId: mockAccountId,
+		Connections: []*mgmtv1alpha1.BulkCreateConnectionItem{
+			{Name: "conn-one", ConnectionConfig: getPostgresConfigMock()},
+			{Name: "conn-one", ConnectionConfig: getMysqlConfigMock()},
+		},
+	}))
+
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	m.DbtxMock.AssertNotCalled(t, "Begin", mock.Anything)
+}
+
 // UpdateConnection
 func Test_UpdateConnection(t *testing.T) {
 	m := createServiceMock(t)
@@ -497,6 +677,10 @@ func Test_DeleteConnection(t *testing.T) {
 
 	m.QuerierMock.On("GetConnectionById", context.Background(), mock.Anything, connectionUuid).Return(connection, nil)
 	m.QuerierMock.On("RemoveConnectionById", context.Background(), mock.Anything, connectionUuid).Return(nil)
+	m.QuerierMock.On("RemoveResourceLabels", context.Background(), mock.Anything, db_queries.RemoveResourceLabelsParams{
+		ResourceType: nucleusdb.LabelResourceTypeConnection,
+		ResourceID:   connectionUuid,
+	}).Return(nil)
 
 	resp, err := m.Service.DeleteConnection(context.Background(), &connect.Request[mgmtv1alpha1.DeleteConnectionRequest]{
 		Msg: &mgmtv1alpha1.DeleteConnectionRequest{
@@ -570,6 +754,42 @@ func Test_DeleteConnection_UnverifiedUserError(t *testing.T) {
 	assert.Nil(t, resp)
 }
 
+// SetConnectionLabels
+func Test_SetConnectionLabels(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+	mockTx := new(nucleusdb.MockTx)
+
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+	connection := getConnectionMock(mockAccountId, mockConnectionName, connectionUuid, PostgresMock)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.DbtxMock.On("Begin", mock.Anything).Return(mockTx, nil)
+	mockTx.On("Commit", mock.Anything).Return(nil)
+	mockTx.On("Rollback", mock.Anything).Return(nil)
+	m.QuerierMock.On("GetConnectionById", context.Background(), mock.Anything, connectionUuid).Return(connection, nil)
+	m.QuerierMock.On("RemoveResourceLabels", context.Background(), mockTx, db_queries.RemoveResourceLabelsParams{
+		ResourceType: nucleusdb.LabelResourceTypeConnection,
+		ResourceID:   connectionUuid,
+	}).Return(nil)
+	m.QuerierMock.On("CreateResourceLabels", context.Background(), mockTx, db_queries.CreateResourceLabelsParams{
+		AccountId:    accountUuid,
+		ResourceType: nucleusdb.LabelResourceTypeConnection,
+		ResourceId:   connectionUuid,
+		Keys:         []string{"env", "team"},
+		Values:       []string{"prod", "payments"},
+	}).Return(nil)
+
+	resp, err := m.Service.SetConnectionLabels(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetConnectionLabelsRequest{
+		Id:     mockConnectionId,
+		Labels: map[string]string{"team": "payments", "env": "prod"},
+	}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "payments", "env": "prod"}, resp.Msg.GetConnection().GetLabels())
+	mockTx.AssertCalled(t, "Commit", mock.Anything)
+}
+
 // CheckSqlQuery
 func Test_CheckSqlQuery_Valid(t *testing.T) {
 	m := createServiceMock(t)
@@ -674,6 +894,7 @@ type serviceMocks struct {
 	PgPoolContainerMock    *sqlconnect.MockPgPoolContainer
 	PgQuerierMock          *pg_queries.MockQuerier
 	MysqlQuerierMock       *mysql_queries.MockQuerier
+	GcpManagerMock         *gcpmanager.MockNeosyncGcpManagerClient
 }
 
 func createServiceMock(t *testing.T) *serviceMocks {
@@ -683,13 +904,14 @@ func createServiceMock(t *testing.T) *serviceMocks {
 	mockSqlConnector := sqlconnect.NewMockSqlConnector(t)
 	mockPgquerier := pg_queries.NewMockQuerier(t)
 	mockMysqlquerier := mysql_queries.NewMockQuerier(t)
+	mockGcpManager := gcpmanager.NewMockNeosyncGcpManagerClient(t)
 
 	sqlDbMock, sqlMock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
 	if err != nil {
 		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
 	}
 
-	service := New(&Config{}, nucleusdb.New(mockDbtx, mockQuerier), mockUserAccountService, mockSqlConnector, mockPgquerier, mockMysqlquerier)
+	service := New(&Config{}, nucleusdb.New(mockDbtx, mockQuerier), mockUserAccountService, mockSqlConnector, mockPgquerier, mockMysqlquerier, mockGcpManager)
 
 	return &serviceMocks{
 		Service:                service,
@@ -703,6 +925,7 @@ func createServiceMock(t *testing.T) *serviceMocks {
 		PgPoolContainerMock:    sqlconnect.NewMockPgPoolContainer(t),
 		PgQuerierMock:          mockPgquerier,
 		MysqlQuerierMock:       mockMysqlquerier,
+		GcpManagerMock:         mockGcpManager,
 	}
 }
 
@@ -0,0 +1,243 @@
+package v1alpha1_connectionservice
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+)
+
+func (s *Service) GetConnectionSavedQueries(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetConnectionSavedQueriesRequest],
+) (*connect.Response[mgmtv1alpha1.GetConnectionSavedQueriesResponse], error) {
+	// verifies the user has access to the connection
+	_, err := s.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{Id: req.Msg.GetConnectionId()}))
+	if err != nil {
+		return nil, err
+	}
+	connectionUuid, err := nucleusdb.ToUuid(req.Msg.GetConnectionId())
+	if err != nil {
+		return nil, err
+	}
+
+	savedQueries, err := s.db.Q.GetConnectionSavedQueriesByConnection(ctx, s.db.Db, connectionUuid)
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]*mgmtv1alpha1.ConnectionSavedQuery, 0, len(savedQueries))
+	for idx := range savedQueries {
+		dtos = append(dtos, dtomaps.ToConnectionSavedQueryDto(&savedQueries[idx]))
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GetConnectionSavedQueriesResponse{
+		SavedQueries: dtos,
+	}), nil
+}
+
+func (s *Service) CreateConnectionSavedQuery(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.CreateConnectionSavedQueryRequest],
+) (*connect.Response[mgmtv1alpha1.CreateConnectionSavedQueryResponse], error) {
+	_, err := s.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{Id: req.Msg.GetConnectionId()}))
+	if err != nil {
+		return nil, err
+	}
+	connectionUuid, err := nucleusdb.ToUuid(req.Msg.GetConnectionId())
+	if err != nil {
+		return nil, err
+	}
+	userUuid, err := s.getUserUuid(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	savedQuery, err := s.db.Q.CreateConnectionSavedQuery(ctx, s.db.Db, db_queries.CreateConnectionSavedQueryParams{
+		ConnectionID: connectionUuid,
+		Name:         req.Msg.GetName(),
+		SchemaName:   req.Msg.GetSchema(),
+		TableName:    req.Msg.GetTable(),
+		WhereClause:  toWhereClauseText(req.Msg.WhereClause),
+		CreatedByID:  *userUuid,
+		UpdatedByID:  *userUuid,
+	})
+	if err != nil && nucleusdb.IsConflict(err) {
+		return nil, nucleuserrors.NewAlreadyExists(fmt.Sprintf("a saved query named %q already exists for this connection", req.Msg.GetName()))
+	} else if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.CreateConnectionSavedQueryResponse{
+		SavedQuery: dtomaps.ToConnectionSavedQueryDto(&savedQuery),
+	}), nil
+}
+
+func (s *Service) UpdateConnectionSavedQuery(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.UpdateConnectionSavedQueryRequest],
+) (*connect.Response[mgmtv1alpha1.UpdateConnectionSavedQueryResponse], error) {
+	savedQueryUuid, err := nucleusdb.ToUuid(req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	existing, err := s.db.Q.GetConnectionSavedQueryById(ctx, s.db.Db, savedQueryUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return nil, nucleuserrors.NewNotFound("unable to find saved query by id")
+	}
+	_, err = s.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{Id: nucleusdb.UUIDString(existing.ConnectionID)}))
+	if err != nil {
+		return nil, err
+	}
+	userUuid, err := s.getUserUuid(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	savedQuery, err := s.db.Q.UpdateConnectionSavedQuery(ctx, s.db.Db, db_queries.UpdateConnectionSavedQueryParams{
+		Name:        req.Msg.GetName(),
+		SchemaName:  req.Msg.GetSchema(),
+		TableName:   req.Msg.GetTable(),
+		WhereClause: toWhereClauseText(req.Msg.WhereClause),
+		UpdatedByID: *userUuid,
+		ID:          existing.ID,
+	})
+	if err != nil && nucleusdb.IsConflict(err) {
+		return nil, nucleuserrors.NewAlreadyExists(fmt.Sprintf("a saved query named %q already exists for this connection", req.Msg.GetName()))
+	} else if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.UpdateConnectionSavedQueryResponse{
+		SavedQuery: dtomaps.ToConnectionSavedQueryDto(&savedQuery),
+	}), nil
+}
+
+func (s *Service) DeleteConnectionSavedQuery(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.DeleteConnectionSavedQueryRequest],
+) (*connect.Response[mgmtv1alpha1.DeleteConnectionSavedQueryResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("savedQueryId", req.Msg.GetId())
+
+	savedQueryUuid, err := nucleusdb.ToUuid(req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	savedQuery, err := s.db.Q.GetConnectionSavedQueryById(ctx, s.db.Db, savedQueryUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		logger.Info("saved query not found or has already been removed")
+		return connect.NewResponse(&mgmtv1alpha1.DeleteConnectionSavedQueryResponse{}), nil
+	}
+	_, err = s.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{Id: nucleusdb.UUIDString(savedQuery.ConnectionID)}))
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.Q.RemoveConnectionSavedQuery(ctx, s.db.Db, savedQuery.ID)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.DeleteConnectionSavedQueryResponse{}), nil
+}
+
+func (s *Service) GetConnectionTableBookmarks(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetConnectionTableBookmarksRequest],
+) (*connect.Response[mgmtv1alpha1.GetConnectionTableBookmarksResponse], error) {
+	_, err := s.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{Id: req.Msg.GetConnectionId()}))
+	if err != nil {
+		return nil, err
+	}
+	connectionUuid, err := nucleusdb.ToUuid(req.Msg.GetConnectionId())
+	if err != nil {
+		return nil, err
+	}
+
+	bookmarks, err := s.db.Q.GetConnectionTableBookmarksByConnection(ctx, s.db.Db, connectionUuid)
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]*mgmtv1alpha1.ConnectionTableBookmark, 0, len(bookmarks))
+	for idx := range bookmarks {
+		dtos = append(dtos, dtomaps.ToConnectionTableBookmarkDto(&bookmarks[idx]))
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GetConnectionTableBookmarksResponse{
+		Bookmarks: dtos,
+	}), nil
+}
+
+func (s *Service) CreateConnectionTableBookmark(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.CreateConnectionTableBookmarkRequest],
+) (*connect.Response[mgmtv1alpha1.CreateConnectionTableBookmarkResponse], error) {
+	_, err := s.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{Id: req.Msg.GetConnectionId()}))
+	if err != nil {
+		return nil, err
+	}
+	connectionUuid, err := nucleusdb.ToUuid(req.Msg.GetConnectionId())
+	if err != nil {
+		return nil, err
+	}
+	userUuid, err := s.getUserUuid(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bookmark, err := s.db.Q.UpsertConnectionTableBookmark(ctx, s.db.Db, db_queries.UpsertConnectionTableBookmarkParams{
+		ConnectionID: connectionUuid,
+		SchemaName:   req.Msg.GetSchema(),
+		TableName:    req.Msg.GetTable(),
+		CreatedByID:  *userUuid,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.CreateConnectionTableBookmarkResponse{
+		Bookmark: dtomaps.ToConnectionTableBookmarkDto(&bookmark),
+	}), nil
+}
+
+func (s *Service) DeleteConnectionTableBookmark(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.DeleteConnectionTableBookmarkRequest],
+) (*connect.Response[mgmtv1alpha1.DeleteConnectionTableBookmarkResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("bookmarkId", req.Msg.GetId())
+
+	bookmarkUuid, err := nucleusdb.ToUuid(req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	bookmark, err := s.db.Q.GetConnectionTableBookmarkById(ctx, s.db.Db, bookmarkUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		logger.Info("table bookmark not found or has already been removed")
+		return connect.NewResponse(&mgmtv1alpha1.DeleteConnectionTableBookmarkResponse{}), nil
+	}
+	_, err = s.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{Id: nucleusdb.UUIDString(bookmark.ConnectionID)}))
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.Q.RemoveConnectionTableBookmark(ctx, s.db.Db, bookmark.ID)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.DeleteConnectionTableBookmarkResponse{}), nil
+}
+
+func toWhereClauseText(whereClause *string) pgtype.Text {
+	if whereClause == nil || *whereClause == "" {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: *whereClause, Valid: true}
+}
@@ -0,0 +1,280 @@
+package v1alpha1_connectionservice
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetConnectionSavedQueries(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+	connection := getConnectionMock(mockAccountId, mockConnectionName, connectionUuid, PostgresMock)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetConnectionById", mock.Anything, mock.Anything, connectionUuid).Return(connection, nil)
+	m.QuerierMock.On("GetConnectionSavedQueriesByConnection", mock.Anything, mock.Anything, connectionUuid).Return([]db_queries.NeosyncApiConnectionSavedQuery{
+		getSavedQueryMock(connectionUuid, "recent users", pgtype.Text{String: "created_at > '2024-01-01'", Valid: true}),
+		getSavedQueryMock(connectionUuid, "all users", pgtype.Text{}),
+	}, nil)
+
+	resp, err := m.Service.GetConnectionSavedQueries(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetConnectionSavedQueriesRequest{
+		ConnectionId: mockConnectionId,
+	}))
+
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.GetSavedQueries(), 2)
+	assert.Equal(t, "created_at > '2024-01-01'", resp.Msg.GetSavedQueries()[0].GetWhereClause())
+	assert.Nil(t, resp.Msg.GetSavedQueries()[1].WhereClause)
+}
+
+func Test_CreateConnectionSavedQuery(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	connection := getConnectionMock(mockAccountId, mockConnectionName, connectionUuid, PostgresMock)
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	whereClause := "created_at > '2024-01-01'"
+	m.QuerierMock.On("GetConnectionById", mock.Anything, mock.Anything, connectionUuid).Return(connection, nil)
+	m.QuerierMock.On("CreateConnectionSavedQuery", mock.Anything, mock.Anything, db_queries.CreateConnectionSavedQueryParams{
+		ConnectionID: connectionUuid,
+		Name:         "recent users",
+		SchemaName:   "public",
+		TableName:    "users",
+		WhereClause:  pgtype.Text{String: whereClause, Valid: true},
+		CreatedByID:  userUuid,
+		UpdatedByID:  userUuid,
+	}).Return(getSavedQueryMock(connectionUuid, "recent users", pgtype.Text{String: whereClause, Valid: true}), nil)
+
+	resp, err := m.Service.CreateConnectionSavedQuery(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateConnectionSavedQueryRequest{
+		ConnectionId: mockConnectionId,
+		Name:         "recent users",
+		Schema:       "public",
+		Table:        "users",
+		WhereClause:  &whereClause,
+	}))
+
+	require.NoError(t, err)
+	assert.Equal(t, "recent users", resp.Msg.GetSavedQuery().GetName())
+	assert.Equal(t, whereClause, resp.Msg.GetSavedQuery().GetWhereClause())
+}
+
+func Test_CreateConnectionSavedQuery_NameTaken(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+	connection := getConnectionMock(mockAccountId, mockConnectionName, connectionUuid, PostgresMock)
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetConnectionById", mock.Anything, mock.Anything, connectionUuid).Return(connection, nil)
+	m.QuerierMock.On("CreateConnectionSavedQuery", mock.Anything, mock.Anything, mock.Anything).
+		Return(db_queries.NeosyncApiConnectionSavedQuery{}, &pgconn.PgError{Code: nucleusdb.PqUniqueViolationCode})
+
+	resp, err := m.Service.CreateConnectionSavedQuery(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateConnectionSavedQueryRequest{
+		ConnectionId: mockConnectionId,
+		Name:         "recent users",
+		Schema:       "public",
+		Table:        "users",
+	}))
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+}
+
+func Test_UpdateConnectionSavedQuery(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	connection := getConnectionMock(mockAccountId, mockConnectionName, connectionUuid, PostgresMock)
+	existing := getSavedQueryMock(connectionUuid, "recent users", pgtype.Text{String: "id > 1", Valid: true})
+	updated := getSavedQueryMock(connectionUuid, "all users", pgtype.Text{})
+	updated.ID = existing.ID
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetConnectionSavedQueryById", mock.Anything, mock.Anything, existing.ID).Return(existing, nil)
+	m.QuerierMock.On("GetConnectionById", mock.Anything, mock.Anything, connectionUuid).Return(connection, nil)
+	m.QuerierMock.On("UpdateConnectionSavedQuery", mock.Anything, mock.Anything, db_queries.UpdateConnectionSavedQueryParams{
+		Name:        "all users",
+		SchemaName:  "public",
+		TableName:   "users",
+		WhereClause: pgtype.Text{},
+		UpdatedByID: userUuid,
+		ID:          existing.ID,
+	}).Return(updated, nil)
+
+	resp, err := m.Service.UpdateConnectionSavedQuery(context.Background(), connect.NewRequest(&mgmtv1alpha1.UpdateConnectionSavedQueryRequest{
+		Id:     nucleusdb.UUIDString(existing.ID),
+		Name:   "all users",
+		Schema: "public",
+		Table:  "users",
+	}))
+
+	require.NoError(t, err)
+	assert.Equal(t, "all users", resp.Msg.GetSavedQuery().GetName())
+	assert.Nil(t, resp.Msg.GetSavedQuery().WhereClause)
+}
+
+func Test_UpdateConnectionSavedQuery_NotFound(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	m.QuerierMock.On("GetConnectionSavedQueryById", mock.Anything, mock.Anything, mock.Anything).
+		Return(db_queries.NeosyncApiConnectionSavedQuery{}, pgx.ErrNoRows)
+
+	resp, err := m.Service.UpdateConnectionSavedQuery(context.Background(), connect.NewRequest(&mgmtv1alpha1.UpdateConnectionSavedQueryRequest{
+		Id:     uuid.NewString(),
+		Name:   "all users",
+		Schema: "public",
+		Table:  "users",
+	}))
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func Test_DeleteConnectionSavedQuery(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+	connection := getConnectionMock(mockAccountId, mockConnectionName, connectionUuid, PostgresMock)
+	savedQuery := getSavedQueryMock(connectionUuid, "recent users", pgtype.Text{})
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetConnectionSavedQueryById", mock.Anything, mock.Anything, savedQuery.ID).Return(savedQuery, nil)
+	m.QuerierMock.On("GetConnectionById", mock.Anything, mock.Anything, connectionUuid).Return(connection, nil)
+	m.QuerierMock.On("RemoveConnectionSavedQuery", mock.Anything, mock.Anything, savedQuery.ID).Return(nil)
+
+	resp, err := m.Service.DeleteConnectionSavedQuery(context.Background(), connect.NewRequest(&mgmtv1alpha1.DeleteConnectionSavedQueryRequest{
+		Id: nucleusdb.UUIDString(savedQuery.ID),
+	}))
+
+	require.NoError(t, err)
+	assert.NotNil(t, resp)
+}
+
+func Test_DeleteConnectionSavedQuery_UnverifiedUser(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+	connection := getConnectionMock(mockAccountId, mockConnectionName, connectionUuid, PostgresMock)
+	savedQuery := getSavedQueryMock(connectionUuid, "recent users", pgtype.Text{})
+	mockIsUserInAccount(m.UserAccountServiceMock, false)
+	m.QuerierMock.On("GetConnectionSavedQueryById", mock.Anything, mock.Anything, savedQuery.ID).Return(savedQuery, nil)
+	m.QuerierMock.On("GetConnectionById", mock.Anything, mock.Anything, connectionUuid).Return(connection, nil)
+
+	resp, err := m.Service.DeleteConnectionSavedQuery(context.Background(), connect.NewRequest(&mgmtv1alpha1.DeleteConnectionSavedQueryRequest{
+		Id: nucleusdb.UUIDString(savedQuery.ID),
+	}))
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	m.QuerierMock.AssertNotCalled(t, "RemoveConnectionSavedQuery", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_GetConnectionTableBookmarks(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+	connection := getConnectionMock(mockAccountId, mockConnectionName, connectionUuid, PostgresMock)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetConnectionById", mock.Anything, mock.Anything, connectionUuid).Return(connection, nil)
+	m.QuerierMock.On("GetConnectionTableBookmarksByConnection", mock.Anything, mock.Anything, connectionUuid).Return([]db_queries.NeosyncApiConnectionTableBookmark{
+		getTableBookmarkMock(connectionUuid, "public", "users"),
+	}, nil)
+
+	resp, err := m.Service.GetConnectionTableBookmarks(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetConnectionTableBookmarksRequest{
+		ConnectionId: mockConnectionId,
+	}))
+
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.GetBookmarks(), 1)
+	assert.Equal(t, "users", resp.Msg.GetBookmarks()[0].GetTable())
+}
+
+func Test_CreateConnectionTableBookmark(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	connection := getConnectionMock(mockAccountId, mockConnectionName, connectionUuid, PostgresMock)
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetConnectionById", mock.Anything, mock.Anything, connectionUuid).Return(connection, nil)
+	m.QuerierMock.On("UpsertConnectionTableBookmark", mock.Anything, mock.Anything, db_queries.UpsertConnectionTableBookmarkParams{
+		ConnectionID: connectionUuid,
+		SchemaName:   "public",
+		TableName:    "users",
+		CreatedByID:  userUuid,
+	}).Return(getTableBookmarkMock(connectionUuid, "public", "users"), nil)
+
+	resp, err := m.Service.CreateConnectionTableBookmark(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateConnectionTableBookmarkRequest{
+		ConnectionId: mockConnectionId,
+		Schema:       "public",
+		Table:        "users",
+	}))
+
+	require.NoError(t, err)
+	assert.Equal(t, "public", resp.Msg.GetBookmark().GetSchema())
+	assert.Equal(t, "users", resp.Msg.GetBookmark().GetTable())
+}
+
+func Test_DeleteConnectionTableBookmark_NotFound(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	m.QuerierMock.On("GetConnectionTableBookmarkById", mock.Anything, mock.Anything, mock.Anything).
+		Return(db_queries.NeosyncApiConnectionTableBookmark{}, pgx.ErrNoRows)
+
+	resp, err := m.Service.DeleteConnectionTableBookmark(context.Background(), connect.NewRequest(&mgmtv1alpha1.DeleteConnectionTableBookmarkRequest{
+		Id: uuid.NewString(),
+	}))
+
+	require.NoError(t, err)
+	assert.NotNil(t, resp)
+}
+
+func getSavedQueryMock(connectionId pgtype.UUID, name string, whereClause pgtype.Text) db_queries.NeosyncApiConnectionSavedQuery {
+	id, _ := nucleusdb.ToUuid(uuid.NewString())
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	return db_queries.NeosyncApiConnectionSavedQuery{
+		ID:           id,
+		ConnectionID: connectionId,
+		Name:         name,
+		SchemaName:   "public",
+		TableName:    "users",
+		WhereClause:  whereClause,
+		CreatedByID:  userUuid,
+		UpdatedByID:  userUuid,
+	}
+}
+
+func getTableBookmarkMock(connectionId pgtype.UUID, schema, table string) db_queries.NeosyncApiConnectionTableBookmark {
+	id, _ := nucleusdb.ToUuid(uuid.NewString())
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	return db_queries.NeosyncApiConnectionTableBookmark{
+		ID:           id,
+		ConnectionID: connectionId,
+		SchemaName:   schema,
+		TableName:    table,
+		CreatedByID:  userUuid,
+	}
+}
@@ -6,6 +6,7 @@ import (
 	mysql_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/mysql"
 	pg_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/postgresql"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	gcpmanager "github.com/nucleuscloud/neosync/backend/internal/gcp"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
 	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
@@ -17,6 +18,7 @@ type Service struct {
 	useraccountService mgmtv1alpha1connect.UserAccountServiceClient
 	sqlConnector       sqlconnect.SqlConnector
 	sqlmanager         sql_manager.SqlManagerClient
+	gcpManager         gcpmanager.NeosyncGcpManagerClient
 
 	pgquerier    pg_queries.Querier
 	mysqlquerier mysql_queries.Querier
@@ -32,6 +34,7 @@ func New(
 	sqlConnector sqlconnect.SqlConnector,
 	pgquerier pg_queries.Querier,
 	mysqlquerier mysql_queries.Querier,
+	gcpManager gcpmanager.NeosyncGcpManagerClient,
 ) *Service {
 	pgpoolmap := &sync.Map{}
 	mysqlpoolmap := &sync.Map{}
@@ -44,5 +47,6 @@ func New(
 		pgquerier:          pgquerier,
 		mysqlquerier:       mysqlquerier,
 		sqlmanager:         sqlmanager,
+		gcpManager:         gcpManager,
 	}
 }
@@ -0,0 +1,287 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"google.golang.org/protobuf/proto"
+)
+
+type destinationOptionsType string
+
+const (
+	postgresDestinationOptions destinationOptionsType = "postgres"
+	mysqlDestinationOptions    destinationOptionsType = "mysql"
+	awsS3DestinationOptions    destinationOptionsType = "awsS3"
+	customDestinationOptions   destinationOptionsType = "custom"
+	kafkaDestinationOptions    destinationOptionsType = "kafka"
+)
+
+func (s *Service) GetAccountJobDefaults(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetAccountJobDefaultsRequest],
+) (*connect.Response[mgmtv1alpha1.GetAccountJobDefaultsResponse], error) {
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	defaults, err := s.getAccountJobDefaults(ctx, *accountUuid)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GetAccountJobDefaultsResponse{
+		Defaults: defaults.ToDto(),
+	}), nil
+}
+
+func (s *Service) SetAccountJobDefaults(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.SetAccountJobDefaultsRequest],
+) (*connect.Response[mgmtv1alpha1.SetAccountJobDefaultsResponse], error) {
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyAccountJobDefaults(req.Msg.GetDefaults()); err != nil {
+		return nil, err
+	}
+
+	defaults := &pg_models.AccountJobDefaults{}
+	if req.Msg.GetDefaults() != nil {
+		if err := defaults.FromDto(req.Msg.GetDefaults()); err != nil {
+			return nil, nucleuserrors.NewBadRequest(err.Error())
+		}
+	}
+	account, err := s.db.Q.UpdateAccountJobDefaults(ctx, s.db.Db, db_queries.UpdateAccountJobDefaultsParams{
+		JobDefaults: defaults,
+		AccountId:   *accountUuid,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to update account job defaults: %w", err)
+	}
+	return connect.NewResponse(&mgmtv1alpha1.SetAccountJobDefaultsResponse{
+		Defaults: account.JobDefaults.ToDto(),
+	}), nil
+}
+
+func (s *Service) GetJobsOverridingDefaults(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetJobsOverridingDefaultsRequest],
+) (*connect.Response[mgmtv1alpha1.GetJobsOverridingDefaultsResponse], error) {
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	defaults, err := s.getAccountJobDefaults(ctx, *accountUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := s.db.Q.GetJobsByAccount(ctx, s.db.Db, *accountUuid)
+	if err != nil {
+		return nil, err
+	}
+	jobIds := make([]pgtype.UUID, 0, len(jobs))
+	for idx := range jobs {
+		jobIds = append(jobIds, jobs[idx].ID)
+	}
+	associationMap := map[pgtype.UUID][]db_queries.NeosyncApiJobDestinationConnectionAssociation{}
+	if len(jobIds) > 0 {
+		destinationAssociations, err := s.db.Q.GetJobConnectionDestinationsByJobIds(ctx, s.db.Db, jobIds)
+		if err != nil {
+			return nil, err
+		}
+		for _, assoc := range destinationAssociations {
+			associationMap[assoc.JobID] = append(associationMap[assoc.JobID], assoc)
+		}
+	}
+
+	defaultsDto := defaults.ToDto()
+	overrides := []*mgmtv1alpha1.JobDefaultsOverride{}
+	for idx := range jobs {
+		job := jobs[idx]
+		override := getJobDefaultsOverride(dtomaps.ToJobDto(&job, associationMap[job.ID]), defaultsDto)
+		if override != nil {
+			overrides = append(overrides, override)
+		}
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GetJobsOverridingDefaultsResponse{
+		Jobs: overrides,
+	}), nil
+}
+
+func (s *Service) getAccountJobDefaults(ctx context.Context, accountUuid pgtype.UUID) (*pg_models.AccountJobDefaults, error) {
+	defaults, err := s.db.Q.GetAccountJobDefaults(ctx, s.db.Db, accountUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, fmt.Errorf("unable to retrieve account job defaults: %w", err)
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return nil, nucleuserrors.NewNotFound("unable to find account by id")
+	}
+	if defaults == nil {
+		return &pg_models.AccountJobDefaults{}, nil
+	}
+	return defaults, nil
+}
+
+// Destination defaults are looked up by the type of the connection, so each type may only have a single entry
+func verifyAccountJobDefaults(defaults *mgmtv1alpha1.AccountJobDefaults) error {
+	seen := map[destinationOptionsType]struct{}{}
+	for _, opts := range defaults.GetDestinationOptions() {
+		optsType, ok := getDestinationOptionsType(opts)
+		if !ok {
+			return nucleuserrors.NewBadRequest("default destination options must have a config")
+		}
+		if _, ok := seen[optsType]; ok {
+			return nucleuserrors.NewBadRequest(fmt.Sprintf("only one set of default destination options may be provided for %s connections", optsType))
+		}
+		seen[optsType] = struct{}{}
+	}
+	return nil
+}
+
+func getDestinationOptionsType(opts *mgmtv1alpha1.JobDestinationOptions) (destinationOptionsType, bool) {
+	switch opts.GetConfig().(type) {
+	case *mgmtv1alpha1.JobDestinationOptions_PostgresOptions:
+		return postgresDestinationOptions, true
+	case *mgmtv1alpha1.JobDestinationOptions_MysqlOptions:
+		return mysqlDestinationOptions, true
+	case *mgmtv1alpha1.JobDestinationOptions_AwsS3Options:
+		return awsS3DestinationOptions, true
+	case *mgmtv1alpha1.JobDestinationOptions_CustomOptions:
+		return customDestinationOptions, true
+	case *mgmtv1alpha1.JobDestinationOptions_KafkaOptions:
+		return kafkaDestinationOptions, true
+	default:
+		return "", false
+	}
+}
+
+// Returns the type of destination options that a connection accepts
+func getConnectionDestinationOptionsType(cfg *pg_models.ConnectionConfig) (destinationOptionsType, bool) {
+	if cfg == nil {
+		return "", false
+	}
+	switch {
+	case cfg.PgConfig != nil:
+		return postgresDestinationOptions, true
+	case cfg.MysqlConfig != nil:
+		return mysqlDestinationOptions, true
+	case cfg.AwsS3Config != nil:
+		return awsS3DestinationOptions, true
+	case cfg.CustomConfig != nil:
+		return customDestinationOptions, true
+	case cfg.KafkaConfig != nil:
+		return kafkaDestinationOptions, true
+	default:
+		return "", false
+	}
+}
+
+// Returns the default destination options for the given type, or nil if the account has none
+func getDefaultDestinationOptions(
+	defaults *mgmtv1alpha1.AccountJobDefaults,
+	optsType destinationOptionsType,
+) *mgmtv1alpha1.JobDestinationOptions {
+	for _, opts := range defaults.GetDestinationOptions() {
+		if t, ok := getDestinationOptionsType(opts); ok && t == optsType {
+			return opts
+		}
+	}
+	return nil
+}
+
+// Fills in the destination options of a new job that were not provided, using the account defaults for the type of each destination connection
+func (s *Service) applyDefaultDestinationOptions(
+	ctx context.Context,
+	destinations []*mgmtv1alpha1.CreateJobDestination,
+	defaults *mgmtv1alpha1.AccountJobDefaults,
+) ([]*mgmtv1alpha1.CreateJobDestination, error) {
+	missing := []pgtype.UUID{}
+	for _, dest := range destinations {
+		if dest.GetOptions() != nil {
+			continue
+		}
+		destUuid, err := nucleusdb.ToUuid(dest.GetConnectionId())
+		if err != nil {
+			return nil, err
+		}
+		missing = append(missing, destUuid)
+	}
+	if len(missing) == 0 {
+		return destinations, nil
+	}
+
+	connections, err := s.db.Q.GetConnectionsByIds(ctx, s.db.Db, missing)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve destination connections: %w", err)
+	}
+	connectionMap := map[string]*db_queries.NeosyncApiConnection{}
+	for idx := range connections {
+		connectionMap[nucleusdb.UUIDString(connections[idx].ID)] = &connections[idx]
+	}
+
+	updated := make([]*mgmtv1alpha1.CreateJobDestination, 0, len(destinations))
+	for _, dest := range destinations {
+		if dest.GetOptions() != nil {
+			updated = append(updated, dest)
+			continue
+		}
+		connection, ok := connectionMap[dest.GetConnectionId()]
+		if !ok {
+			return nil, nucleuserrors.NewNotFound(fmt.Sprintf("unable to find destination connection %s", dest.GetConnectionId()))
+		}
+		var opts *mgmtv1alpha1.JobDestinationOptions
+		if optsType, ok := getConnectionDestinationOptionsType(connection.ConnectionConfig); ok {
+			opts = getDefaultDestinationOptions(defaults, optsType)
+		}
+		if opts == nil {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("destination options must be provided for connection %s as the account has no defaults for its type", dest.GetConnectionId()))
+		}
+		updated = append(updated, &mgmtv1alpha1.CreateJobDestination{
+			ConnectionId: dest.GetConnectionId(),
+			Options:      opts,
+		})
+	}
+	return updated, nil
+}
+
+// Returns the settings of the job that differ from the account defaults, or nil if the job matches all of them
+func getJobDefaultsOverride(
+	job *mgmtv1alpha1.Job,
+	defaults *mgmtv1alpha1.AccountJobDefaults,
+) *mgmtv1alpha1.JobDefaultsOverride {
+	override := &mgmtv1alpha1.JobDefaultsOverride{
+		JobId:          job.GetId(),
+		JobName:        job.GetName(),
+		DestinationIds: []string{},
+	}
+	if defaults.GetSyncOptions() != nil && !proto.Equal(job.GetSyncOptions(), defaults.GetSyncOptions()) {
+		override.SyncOptions = true
+	}
+	if defaults.GetWorkflowOptions() != nil && !proto.Equal(job.GetWorkflowOptions(), defaults.GetWorkflowOptions()) {
+		override.WorkflowOptions = true
+	}
+	for _, dest := range job.GetDestinations() {
+		optsType, ok := getDestinationOptionsType(dest.GetOptions())
+		if !ok {
+			continue
+		}
+		defaultOpts := getDefaultDestinationOptions(defaults, optsType)
+		if defaultOpts != nil && !proto.Equal(dest.GetOptions(), defaultOpts) {
+			override.DestinationIds = append(override.DestinationIds, dest.GetId())
+		}
+	}
+
+	if !override.SyncOptions && !override.WorkflowOptions && len(override.DestinationIds) == 0 {
+		return nil
+	}
+	return override
+}
@@ -0,0 +1,146 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SetAccountJobDefaults(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	runTimeout := int64(60)
+
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	defaults := &pg_models.AccountJobDefaults{
+		WorkflowOptions:    &pg_models.WorkflowOptions{RunTimeout: &runTimeout},
+		DestinationOptions: []*pg_models.JobDestinationOptions{{CustomOptions: &pg_models.CustomDestinationOptions{}}},
+	}
+	m.QuerierMock.On("UpdateAccountJobDefaults", mock.Anything, mock.Anything, db_queries.UpdateAccountJobDefaultsParams{
+		JobDefaults: defaults,
+		AccountId:   accountUuid,
+	}).Return(db_queries.NeosyncApiAccount{ID: accountUuid, JobDefaults: defaults}, nil)
+
+	resp, err := m.Service.SetAccountJobDefaults(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetAccountJobDefaultsRequest{
+		AccountId: mockAccountId,
+		Defaults: &mgmtv1alpha1.AccountJobDefaults{
+			WorkflowOptions: &mgmtv1alpha1.WorkflowOptions{RunTimeout: &runTimeout},
+			DestinationOptions: []*mgmtv1alpha1.JobDestinationOptions{
+				{Config: &mgmtv1alpha1.JobDestinationOptions_CustomOptions{CustomOptions: &mgmtv1alpha1.CustomDestinationConnectionOptions{}}},
+			},
+		},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, runTimeout, resp.Msg.GetDefaults().GetWorkflowOptions().GetRunTimeout())
+	require.Len(t, resp.Msg.GetDefaults().GetDestinationOptions(), 1)
+}
+
+func Test_SetAccountJobDefaults_DuplicateDestinationType(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+
+	resp, err := m.Service.SetAccountJobDefaults(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetAccountJobDefaultsRequest{
+		AccountId: mockAccountId,
+		Defaults: &mgmtv1alpha1.AccountJobDefaults{
+			DestinationOptions: []*mgmtv1alpha1.JobDestinationOptions{
+				{Config: &mgmtv1alpha1.JobDestinationOptions_CustomOptions{CustomOptions: &mgmtv1alpha1.CustomDestinationConnectionOptions{}}},
+				{Config: &mgmtv1alpha1.JobDestinationOptions_CustomOptions{CustomOptions: &mgmtv1alpha1.CustomDestinationConnectionOptions{}}},
+			},
+		},
+	}))
+	require.Error(t, err)
+	require.Nil(t, resp)
+	m.QuerierMock.AssertNotCalled(t, "UpdateAccountJobDefaults", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_applyDefaultDestinationOptions(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	pgConn := getConnectionMock(mockAccountId, "pg")
+	providedConnId := uuid.NewString()
+	providedOpts := &mgmtv1alpha1.JobDestinationOptions{
+		Config: &mgmtv1alpha1.JobDestinationOptions_CustomOptions{CustomOptions: &mgmtv1alpha1.CustomDestinationConnectionOptions{}},
+	}
+	pgDefault := &mgmtv1alpha1.JobDestinationOptions{
+		Config: &mgmtv1alpha1.JobDestinationOptions_PostgresOptions{PostgresOptions: &mgmtv1alpha1.PostgresDestinationConnectionOptions{InitTableSchema: true}},
+	}
+
+	m.QuerierMock.On("GetConnectionsByIds", mock.Anything, mock.Anything, []pgtype.UUID{pgConn.ID}).Return([]db_queries.NeosyncApiConnection{pgConn}, nil)
+
+	t.Run("inherits default", func(t *testing.T) {
+		destinations, err := m.Service.applyDefaultDestinationOptions(context.Background(), []*mgmtv1alpha1.CreateJobDestination{
+			{ConnectionId: providedConnId, Options: providedOpts},
+			{ConnectionId: nucleusdb.UUIDString(pgConn.ID)},
+		}, &mgmtv1alpha1.AccountJobDefaults{DestinationOptions: []*mgmtv1alpha1.JobDestinationOptions{pgDefault}})
+		require.NoError(t, err)
+		require.Len(t, destinations, 2)
+		require.Equal(t, providedOpts, destinations[0].GetOptions())
+		require.Equal(t, pgDefault, destinations[1].GetOptions())
+	})
+
+	t.Run("no default for type", func(t *testing.T) {
+		_, err := m.Service.applyDefaultDestinationOptions(context.Background(), []*mgmtv1alpha1.CreateJobDestination{
+			{ConnectionId: nucleusdb.UUIDString(pgConn.ID)},
+		}, &mgmtv1alpha1.AccountJobDefaults{})
+		require.Error(t, err)
+	})
+}
+
+func Test_getJobDefaultsOverride(t *testing.T) {
+	runTimeout := int64(60)
+	otherRunTimeout := int64(120)
+	pgDefault := &mgmtv1alpha1.JobDestinationOptions{
+		Config: &mgmtv1alpha1.JobDestinationOptions_PostgresOptions{PostgresOptions: &mgmtv1alpha1.PostgresDestinationConnectionOptions{InitTableSchema: true}},
+	}
+	pgOverride := &mgmtv1alpha1.JobDestinationOptions{
+		Config: &mgmtv1alpha1.JobDestinationOptions_PostgresOptions{PostgresOptions: &mgmtv1alpha1.PostgresDestinationConnectionOptions{InitTableSchema: false}},
+	}
+	mysqlOpts := &mgmtv1alpha1.JobDestinationOptions{
+		Config: &mgmtv1alpha1.JobDestinationOptions_MysqlOptions{MysqlOptions: &mgmtv1alpha1.MysqlDestinationConnectionOptions{}},
+	}
+	defaults := &mgmtv1alpha1.AccountJobDefaults{
+		WorkflowOptions:    &mgmtv1alpha1.WorkflowOptions{RunTimeout: &runTimeout},
+		DestinationOptions: []*mgmtv1alpha1.JobDestinationOptions{pgDefault},
+	}
+
+	t.Run("matches defaults", func(t *testing.T) {
+		override := getJobDefaultsOverride(&mgmtv1alpha1.Job{
+			Id:              "job",
+			WorkflowOptions: &mgmtv1alpha1.WorkflowOptions{RunTimeout: &runTimeout},
+			// the account has no sync option or mysql defaults
+			SyncOptions: &mgmtv1alpha1.ActivityOptions{},
+			Destinations: []*mgmtv1alpha1.JobDestination{
+				{Id: "pg", Options: pgDefault},
+				{Id: "mysql", Options: mysqlOpts},
+			},
+		}, defaults)
+		require.Nil(t, override)
+	})
+
+	t.Run("overrides defaults", func(t *testing.T) {
+		override := getJobDefaultsOverride(&mgmtv1alpha1.Job{
+			Id:              "job",
+			Name:            "name",
+			WorkflowOptions: &mgmtv1alpha1.WorkflowOptions{RunTimeout: &otherRunTimeout},
+			Destinations: []*mgmtv1alpha1.JobDestination{
+				{Id: "pg-1", Options: pgDefault},
+				{Id: "pg-2", Options: pgOverride},
+			},
+		}, defaults)
+		require.Equal(t, &mgmtv1alpha1.JobDefaultsOverride{
+			JobId:           "job",
+			JobName:         "name",
+			WorkflowOptions: true,
+			DestinationIds:  []string{"pg-2"},
+		}, override)
+	})
+}
@@ -0,0 +1,153 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+)
+
+const (
+	defaultArtifactContentType = "application/octet-stream"
+)
+
+func (s *Service) CreateJobRunArtifact(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.CreateJobRunArtifactRequest],
+) (*connect.Response[mgmtv1alpha1.CreateJobRunArtifactResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("jobRunId", req.Msg.GetJobRunId())
+	if s.artifactStore == nil {
+		return nil, nucleuserrors.NewNotImplemented("job run artifacts are not enabled as an artifact store has not been configured")
+	}
+	verifResp, err := s.getVerifiedJobRun(ctx, logger, req.Msg.GetJobRunId(), req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	accountUuid, err := nucleusdb.ToUuid(req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	jobRunId := verifResp.WorkflowExecution.GetExecution().GetWorkflowId()
+
+	contentType := req.Msg.GetContentType()
+	if contentType == "" {
+		contentType = defaultArtifactContentType
+	}
+	objectKey := getArtifactObjectKey(req.Msg.GetAccountId(), jobRunId, req.Msg.GetName())
+	err = s.artifactStore.PutObject(ctx, objectKey, contentType, req.Msg.GetContent())
+	if err != nil {
+		return nil, fmt.Errorf("unable to store job run artifact: %w", err)
+	}
+
+	artifact, err := s.db.Q.CreateJobRunArtifact(ctx, s.db.Db, db_queries.CreateJobRunArtifactParams{
+		AccountID:    accountUuid,
+		JobRunID:     jobRunId,
+		ArtifactType: int16(req.Msg.GetType()),
+		Name:         req.Msg.GetName(),
+		ContentType:  contentType,
+		SizeBytes:    int64(len(req.Msg.GetContent())),
+		ObjectKey:    objectKey,
+	})
+	if err != nil {
+		if deleteErr := s.artifactStore.DeleteObject(ctx, objectKey); deleteErr != nil {
+			logger.Warn(fmt.Sprintf("unable to clean up orphaned job run artifact: %s", deleteErr.Error()))
+		}
+		return nil, fmt.Errorf("unable to create job run artifact: %w", err)
+	}
+	logger.Info(fmt.Sprintf("attached %s artifact %s to job run", req.Msg.GetType().String(), req.Msg.GetName()))
+
+	return connect.NewResponse(&mgmtv1alpha1.CreateJobRunArtifactResponse{
+		Artifact: dtomaps.ToJobRunArtifactDto(&artifact),
+	}), nil
+}
+
+func (s *Service) GetJobRunArtifacts(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetJobRunArtifactsRequest],
+) (*connect.Response[mgmtv1alpha1.GetJobRunArtifactsResponse], error) {
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.GetIncludeContent() && s.artifactStore == nil {
+		return nil, nucleuserrors.NewNotImplemented("job run artifacts are not enabled as an artifact store has not been configured")
+	}
+
+	artifacts, err := s.db.Q.GetJobRunArtifacts(ctx, s.db.Db, db_queries.GetJobRunArtifactsParams{
+		AccountID: *accountUuid,
+		JobRunID:  req.Msg.GetJobRunId(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve job run artifacts: %w", err)
+	}
+
+	dtos := []*mgmtv1alpha1.JobRunArtifact{}
+	for idx := range artifacts {
+		artifact := &artifacts[idx]
+		if req.Msg.Type != nil && mgmtv1alpha1.JobRunArtifactType(artifact.ArtifactType) != req.Msg.GetType() {
+			continue
+		}
+		dto := dtomaps.ToJobRunArtifactDto(artifact)
+		if req.Msg.GetIncludeContent() {
+			content, err := s.artifactStore.GetObject(ctx, artifact.ObjectKey)
+			if err != nil {
+				return nil, fmt.Errorf("unable to retrieve contents of job run artifact %s: %w", artifact.Name, err)
+			}
+			dto.Content = content
+		}
+		dtos = append(dtos, dto)
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GetJobRunArtifactsResponse{
+		Artifacts: dtos,
+	}), nil
+}
+
+// Removes the artifacts of a job run along with their contents in the artifact store.
+// Contents that fail to delete are logged and left behind so that they do not block the run from being deleted
+func (s *Service) removeJobRunArtifacts(
+	ctx context.Context,
+	logger *slog.Logger,
+	accountId string,
+	jobRunId string,
+) error {
+	if s.artifactStore == nil {
+		return nil
+	}
+	accountUuid, err := nucleusdb.ToUuid(accountId)
+	if err != nil {
+		return err
+	}
+	artifacts, err := s.db.Q.GetJobRunArtifacts(ctx, s.db.Db, db_queries.GetJobRunArtifactsParams{
+		AccountID: accountUuid,
+		JobRunID:  jobRunId,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to retrieve job run artifacts: %w", err)
+	}
+	if len(artifacts) == 0 {
+		return nil
+	}
+	for idx := range artifacts {
+		if err := s.artifactStore.DeleteObject(ctx, artifacts[idx].ObjectKey); err != nil {
+			logger.Warn(fmt.Sprintf("unable to delete contents of job run artifact %s: %s", artifacts[idx].Name, err.Error()))
+		}
+	}
+	return s.db.Q.RemoveJobRunArtifacts(ctx, s.db.Db, db_queries.RemoveJobRunArtifactsParams{
+		AccountID: accountUuid,
+		JobRunID:  jobRunId,
+	})
+}
+
+// Artifacts are keyed by a random id so that re-uploading an artifact with the same name does not overwrite the original
+func getArtifactObjectKey(accountId, jobRunId, name string) string {
+	return fmt.Sprintf("job-run-artifacts/%s/%s/%s/%s", accountId, jobRunId, uuid.NewString(), name)
+}
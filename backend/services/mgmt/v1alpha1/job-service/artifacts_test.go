@@ -0,0 +1,175 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/nucleuscloud/neosync/backend/internal/objectstore"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/common/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+)
+
+// CreateJobRunArtifact
+func Test_CreateJobRunArtifact(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	storeMock := objectstore.NewMockInterface(t)
+	m.Service.artifactStore = storeMock
+	temporalClientMock := new(MockTemporalClient)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	workflowId := uuid.NewString()
+	workflows := []*workflowpb.WorkflowExecutionInfo{{
+		Execution: &common.WorkflowExecution{WorkflowId: workflowId, RunId: uuid.NewString()},
+	}}
+	content := []byte("CREATE TABLE public.users (id int);")
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetVerifiedJobRun(m.TemporalWfManagerMock, accountUuid, temporalClientMock, workflows)
+	var objectKey string
+	storeMock.On("PutObject", mock.Anything, mock.Anything, "application/sql", content).Run(func(args mock.Arguments) {
+		objectKey = args.String(1)
+	}).Return(nil)
+	m.QuerierMock.On("CreateJobRunArtifact", mock.Anything, mock.Anything, mock.MatchedBy(func(params db_queries.CreateJobRunArtifactParams) bool {
+		return params.AccountID == accountUuid &&
+			params.JobRunID == workflowId &&
+			params.ArtifactType == int16(mgmtv1alpha1.JobRunArtifactType_JOB_RUN_ARTIFACT_TYPE_DDL_APPLIED) &&
+			params.SizeBytes == int64(len(content)) &&
+			params.ObjectKey == objectKey
+	})).Return(db_queries.NeosyncApiJobRunArtifact{
+		ID:           newArtifactId(),
+		AccountID:    accountUuid,
+		JobRunID:     workflowId,
+		ArtifactType: int16(mgmtv1alpha1.JobRunArtifactType_JOB_RUN_ARTIFACT_TYPE_DDL_APPLIED),
+		Name:         "ddl-applied.sql",
+		ContentType:  "application/sql",
+		SizeBytes:    int64(len(content)),
+		CreatedAt:    pgtype.Timestamp{Time: time.Now(), Valid: true},
+	}, nil)
+
+	resp, err := m.Service.CreateJobRunArtifact(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateJobRunArtifactRequest{
+		AccountId:   mockAccountId,
+		JobRunId:    workflowId,
+		Type:        mgmtv1alpha1.JobRunArtifactType_JOB_RUN_ARTIFACT_TYPE_DDL_APPLIED,
+		Name:        "ddl-applied.sql",
+		ContentType: "application/sql",
+		Content:     content,
+	}))
+
+	require.NoError(t, err)
+	require.Equal(t, "ddl-applied.sql", resp.Msg.GetArtifact().GetName())
+	require.Equal(t, int64(len(content)), resp.Msg.GetArtifact().GetSizeBytes())
+	require.Nil(t, resp.Msg.GetArtifact().Content)
+	require.True(t, strings.HasPrefix(objectKey, "job-run-artifacts/"+mockAccountId+"/"+workflowId+"/"))
+	require.True(t, strings.HasSuffix(objectKey, "/ddl-applied.sql"))
+}
+
+func Test_CreateJobRunArtifact_DbError_RemovesObject(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	storeMock := objectstore.NewMockInterface(t)
+	m.Service.artifactStore = storeMock
+	temporalClientMock := new(MockTemporalClient)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	workflowId := uuid.NewString()
+	workflows := []*workflowpb.WorkflowExecutionInfo{{
+		Execution: &common.WorkflowExecution{WorkflowId: workflowId, RunId: uuid.NewString()},
+	}}
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetVerifiedJobRun(m.TemporalWfManagerMock, accountUuid, temporalClientMock, workflows)
+	storeMock.On("PutObject", mock.Anything, mock.Anything, defaultArtifactContentType, mock.Anything).Return(nil)
+	m.QuerierMock.On("CreateJobRunArtifact", mock.Anything, mock.Anything, mock.Anything).Return(db_queries.NeosyncApiJobRunArtifact{}, errors.New("boom"))
+	storeMock.On("DeleteObject", mock.Anything, mock.Anything).Return(nil)
+
+	resp, err := m.Service.CreateJobRunArtifact(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateJobRunArtifactRequest{
+		AccountId: mockAccountId,
+		JobRunId:  workflowId,
+		Type:      mgmtv1alpha1.JobRunArtifactType_JOB_RUN_ARTIFACT_TYPE_SCHEMA_DIFF,
+		Name:      "schema-diff.json",
+		Content:   []byte("{}"),
+	}))
+
+	require.Error(t, err)
+	require.Nil(t, resp)
+	storeMock.AssertNumberOfCalls(t, "DeleteObject", 1)
+}
+
+func Test_CreateJobRunArtifact_NotConfigured(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+
+	resp, err := m.Service.CreateJobRunArtifact(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateJobRunArtifactRequest{
+		AccountId: mockAccountId,
+		JobRunId:  uuid.NewString(),
+		Type:      mgmtv1alpha1.JobRunArtifactType_JOB_RUN_ARTIFACT_TYPE_SCHEMA_DIFF,
+		Name:      "schema-diff.json",
+	}))
+
+	require.Error(t, err)
+	require.Nil(t, resp)
+	require.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+}
+
+// GetJobRunArtifacts
+func Test_GetJobRunArtifacts(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	storeMock := objectstore.NewMockInterface(t)
+	m.Service.artifactStore = storeMock
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	jobRunId := uuid.NewString()
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobRunArtifacts", mock.Anything, mock.Anything, db_queries.GetJobRunArtifactsParams{
+		AccountID: accountUuid,
+		JobRunID:  jobRunId,
+	}).Return([]db_queries.NeosyncApiJobRunArtifact{
+		{ID: newArtifactId(), JobRunID: jobRunId, ArtifactType: int16(mgmtv1alpha1.JobRunArtifactType_JOB_RUN_ARTIFACT_TYPE_DDL_APPLIED), Name: "ddl-applied.sql", ObjectKey: "ddl"},
+		{ID: newArtifactId(), JobRunID: jobRunId, ArtifactType: int16(mgmtv1alpha1.JobRunArtifactType_JOB_RUN_ARTIFACT_TYPE_ASSERTION_RESULTS), Name: "assertions.json", ObjectKey: "assertions"},
+	}, nil)
+	storeMock.On("GetObject", mock.Anything, "assertions").Return([]byte(`{"passed":true}`), nil)
+
+	artifactType := mgmtv1alpha1.JobRunArtifactType_JOB_RUN_ARTIFACT_TYPE_ASSERTION_RESULTS
+	resp, err := m.Service.GetJobRunArtifacts(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobRunArtifactsRequest{
+		AccountId:      mockAccountId,
+		JobRunId:       jobRunId,
+		Type:           &artifactType,
+		IncludeContent: true,
+	}))
+
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.GetArtifacts(), 1)
+	require.Equal(t, "assertions.json", resp.Msg.GetArtifacts()[0].GetName())
+	require.Equal(t, []byte(`{"passed":true}`), resp.Msg.GetArtifacts()[0].GetContent())
+}
+
+func Test_GetJobRunArtifacts_WithoutContent(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	jobRunId := uuid.NewString()
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobRunArtifacts", mock.Anything, mock.Anything, mock.Anything).Return([]db_queries.NeosyncApiJobRunArtifact{
+		{ID: newArtifactId(), JobRunID: jobRunId, ArtifactType: int16(mgmtv1alpha1.JobRunArtifactType_JOB_RUN_ARTIFACT_TYPE_DDL_APPLIED), Name: "ddl-applied.sql"},
+	}, nil)
+
+	resp, err := m.Service.GetJobRunArtifacts(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobRunArtifactsRequest{
+		AccountId: mockAccountId,
+		JobRunId:  jobRunId,
+	}))
+
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.GetArtifacts(), 1)
+	require.Nil(t, resp.Msg.GetArtifacts()[0].Content)
+}
+
+func newArtifactId() pgtype.UUID {
+	id, _ := nucleusdb.ToUuid(uuid.NewString())
+	return id
+}
@@ -0,0 +1,216 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"sort"
+	"time"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	"go.temporal.io/api/workflowservice/v1"
+)
+
+const (
+	// the number of recently completed runs that the historical throughput is derived from
+	estimateRunSampleSize = 10
+	// used when the job has not completed any runs yet
+	defaultEstimateRowsPerSecond = 10000
+)
+
+func (s *Service) EstimateJobRun(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.EstimateJobRunRequest],
+) (*connect.Response[mgmtv1alpha1.EstimateJobRunResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("jobId", req.Msg.JobId)
+
+	jobResp, err := s.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{Id: req.Msg.JobId}))
+	if err != nil {
+		return nil, err
+	}
+	job := jobResp.Msg.GetJob()
+
+	tables, err := s.getJobTableEstimates(ctx, logger, job)
+	if err != nil {
+		return nil, err
+	}
+
+	runDurations, err := s.getCompletedRunDurations(ctx, logger, job)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(buildJobRunEstimate(tables, runDurations)), nil
+}
+
+// Returns the rows and bytes of each table the job reads from the source, or generates
+func (s *Service) getJobTableEstimates(
+	ctx context.Context,
+	logger *slog.Logger,
+	job *mgmtv1alpha1.Job,
+) ([]*mgmtv1alpha1.TableRunEstimate, error) {
+	switch source := job.GetSource().GetOptions().GetConfig().(type) {
+	case *mgmtv1alpha1.JobSourceOptions_Generate:
+		tables := []*mgmtv1alpha1.TableRunEstimate{}
+		for _, schema := range source.Generate.GetSchemas() {
+			for _, table := range schema.GetTables() {
+				tables = append(tables, &mgmtv1alpha1.TableRunEstimate{
+					Schema:        schema.GetSchema(),
+					Table:         table.GetTable(),
+					EstimatedRows: table.GetRowCount(),
+				})
+			}
+		}
+		return tables, nil
+	case *mgmtv1alpha1.JobSourceOptions_Postgres:
+		return s.getSqlTableEstimates(ctx, logger, source.Postgres.GetConnectionId(), job.GetMappings())
+	case *mgmtv1alpha1.JobSourceOptions_Mysql:
+		return s.getSqlTableEstimates(ctx, logger, source.Mysql.GetConnectionId(), job.GetMappings())
+	default:
+		return nil, nucleuserrors.NewNotImplemented(fmt.Sprintf("run estimates are not supported for this job source: %T", source))
+	}
+}
+
+func (s *Service) getSqlTableEstimates(
+	ctx context.Context,
+	logger *slog.Logger,
+	connectionId string,
+	mappings []*mgmtv1alpha1.JobMapping,
+) ([]*mgmtv1alpha1.TableRunEstimate, error) {
+	connResp, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{Id: connectionId}))
+	if err != nil {
+		return nil, err
+	}
+
+	connectionTimeout := 5
+	db, err := s.sqlmanager.NewSqlDb(ctx, logger, connResp.Msg.GetConnection(), &connectionTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Db.Close()
+
+	seen := map[sql_manager.SchemaTable]struct{}{}
+	schemaTables := []*sql_manager.SchemaTable{}
+	for _, mapping := range mappings {
+		key := sql_manager.SchemaTable{Schema: mapping.GetSchema(), Table: mapping.GetTable()}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		schemaTables = append(schemaTables, &sql_manager.SchemaTable{Schema: mapping.GetSchema(), Table: mapping.GetTable()})
+	}
+
+	sizes, err := db.Db.GetTableSizeEstimates(ctx, schemaTables)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve table size estimates: %w", err)
+	}
+	tables := make([]*mgmtv1alpha1.TableRunEstimate, 0, len(sizes))
+	for _, size := range sizes {
+		tables = append(tables, &mgmtv1alpha1.TableRunEstimate{
+			Schema:         size.Schema,
+			Table:          size.Table,
+			EstimatedRows:  size.RowCount,
+			EstimatedBytes: size.SizeBytes,
+		})
+	}
+	return tables, nil
+}
+
+// Returns how long the most recently completed runs of the job took
+func (s *Service) getCompletedRunDurations(
+	ctx context.Context,
+	logger *slog.Logger,
+	job *mgmtv1alpha1.Job,
+) ([]time.Duration, error) {
+	tclient, err := s.temporalWfManager.GetWorkflowClientByAccount(ctx, job.GetAccountId(), logger)
+	if err != nil {
+		return nil, err
+	}
+	tconfig, err := s.temporalWfManager.GetTemporalConfigByAccount(ctx, job.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := tclient.ListWorkflow(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+		Namespace: tconfig.Namespace,
+		PageSize:  estimateRunSampleSize,
+		Query:     fmt.Sprintf("%s AND ExecutionStatus = 'Completed'", buildJobIdsWorkflowQuery([]string{job.GetId()})),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve completed job runs: %w", err)
+	}
+
+	durations := []time.Duration{}
+	for _, execution := range resp.GetExecutions() {
+		if execution.GetStartTime() == nil || execution.GetCloseTime() == nil {
+			continue
+		}
+		duration := execution.GetCloseTime().AsTime().Sub(execution.GetStartTime().AsTime())
+		if duration > 0 {
+			durations = append(durations, duration)
+		}
+	}
+	return durations, nil
+}
+
+// Previous runs are assumed to have moved a similar amount of data, so the median of their durations is the throughput the job sees.
+// Without any completed runs a conservative default throughput is used instead.
+func buildJobRunEstimate(
+	tables []*mgmtv1alpha1.TableRunEstimate,
+	runDurations []time.Duration,
+) *mgmtv1alpha1.EstimateJobRunResponse {
+	resp := &mgmtv1alpha1.EstimateJobRunResponse{
+		Tables:               tables,
+		CompletedRunsSampled: uint32(len(runDurations)),
+	}
+	for _, table := range tables {
+		resp.EstimatedRows += table.GetEstimatedRows()
+		resp.EstimatedBytes += table.GetEstimatedBytes()
+	}
+
+	if len(runDurations) == 0 {
+		resp.Basis = mgmtv1alpha1.JobRunEstimateBasis_JOB_RUN_ESTIMATE_BASIS_DEFAULT
+		resp.RowsPerSecond = defaultEstimateRowsPerSecond
+		resp.EstimatedDurationSeconds = rowsToSeconds(resp.EstimatedRows, resp.RowsPerSecond)
+	} else {
+		resp.Basis = mgmtv1alpha1.JobRunEstimateBasis_JOB_RUN_ESTIMATE_BASIS_HISTORICAL
+		median := getMedianDuration(runDurations)
+		resp.EstimatedDurationSeconds = int64(math.Ceil(median.Seconds()))
+		if median > 0 {
+			resp.RowsPerSecond = float64(resp.EstimatedRows) / median.Seconds()
+		}
+	}
+
+	for _, table := range tables {
+		table.EstimatedDurationSeconds = rowsToSeconds(table.GetEstimatedRows(), resp.RowsPerSecond)
+	}
+	// largest tables first as they dominate the run
+	sort.SliceStable(tables, func(i, j int) bool {
+		return tables[i].GetEstimatedRows() > tables[j].GetEstimatedRows()
+	})
+	return resp
+}
+
+func rowsToSeconds(rows int64, rowsPerSecond float64) int64 {
+	if rowsPerSecond <= 0 {
+		return 0
+	}
+	return int64(math.Ceil(float64(rows) / rowsPerSecond))
+}
+
+func getMedianDuration(durations []time.Duration) time.Duration {
+	sorted := slices.Clone(durations)
+	slices.Sort(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
@@ -0,0 +1,89 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_EstimateJobRun_Generate(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	temporalClientMock := new(MockTemporalClient)
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	job.ConnectionOptions = &pg_models.JobSourceOptions{
+		GenerateOptions: &pg_models.GenerateSourceOptions{
+			Schemas: []*pg_models.GenerateSourceSchemaOption{
+				{Schema: "public", Tables: []*pg_models.GenerateSourceTableOption{
+					{Table: "users", RowCount: 1000},
+					{Table: "orders", RowCount: 9000},
+				}},
+			},
+		},
+	}
+	jobId := nucleusdb.UUIDString(job.ID)
+	mockGetJob(m.UserAccountServiceMock, m.QuerierMock, job, []db_queries.NeosyncApiJobDestinationConnectionAssociation{})
+	m.TemporalWfManagerMock.On("GetWorkflowClientByAccount", mock.Anything, mockAccountId, mock.Anything).Return(temporalClientMock, nil)
+	m.TemporalWfManagerMock.On("GetTemporalConfigByAccount", mock.Anything, mockAccountId).Return(&pg_models.TemporalConfig{Namespace: "default"}, nil)
+
+	now := time.Now()
+	run := getWorfklowExecutionInfoMock(jobId, uuid.NewString())
+	run.StartTime = timestamppb.New(now.Add(-20 * time.Second))
+	run.CloseTime = timestamppb.New(now)
+	temporalClientMock.On("ListWorkflow", mock.Anything, mock.MatchedBy(func(req *workflowservice.ListWorkflowExecutionsRequest) bool {
+		return strings.Contains(req.Query, jobId) && strings.Contains(req.Query, "ExecutionStatus = 'Completed'")
+	})).Return(&workflowservice.ListWorkflowExecutionsResponse{Executions: []*workflowpb.WorkflowExecutionInfo{run}}, nil)
+
+	resp, err := m.Service.EstimateJobRun(context.Background(), connect.NewRequest(&mgmtv1alpha1.EstimateJobRunRequest{JobId: jobId}))
+	require.NoError(t, err)
+	require.Equal(t, int64(10000), resp.Msg.GetEstimatedRows())
+	require.Equal(t, int64(20), resp.Msg.GetEstimatedDurationSeconds())
+	require.Equal(t, float64(500), resp.Msg.GetRowsPerSecond())
+	require.Equal(t, mgmtv1alpha1.JobRunEstimateBasis_JOB_RUN_ESTIMATE_BASIS_HISTORICAL, resp.Msg.GetBasis())
+	require.Equal(t, uint32(1), resp.Msg.GetCompletedRunsSampled())
+	require.Len(t, resp.Msg.GetTables(), 2)
+	require.Equal(t, "orders", resp.Msg.GetTables()[0].GetTable())
+	require.Equal(t, int64(18), resp.Msg.GetTables()[0].GetEstimatedDurationSeconds())
+}
+
+func Test_buildJobRunEstimate_Default(t *testing.T) {
+	resp := buildJobRunEstimate([]*mgmtv1alpha1.TableRunEstimate{
+		{Schema: "public", Table: "users", EstimatedRows: 25000, EstimatedBytes: 4096},
+		{Schema: "public", Table: "orders", EstimatedRows: 5000, EstimatedBytes: 1024},
+	}, nil)
+	require.Equal(t, mgmtv1alpha1.JobRunEstimateBasis_JOB_RUN_ESTIMATE_BASIS_DEFAULT, resp.GetBasis())
+	require.Equal(t, int64(30000), resp.GetEstimatedRows())
+	require.Equal(t, int64(5120), resp.GetEstimatedBytes())
+	require.Equal(t, float64(defaultEstimateRowsPerSecond), resp.GetRowsPerSecond())
+	require.Equal(t, int64(3), resp.GetEstimatedDurationSeconds())
+	require.Equal(t, int64(3), resp.GetTables()[0].GetEstimatedDurationSeconds())
+	require.Equal(t, int64(1), resp.GetTables()[1].GetEstimatedDurationSeconds())
+}
+
+func Test_buildJobRunEstimate_Historical(t *testing.T) {
+	resp := buildJobRunEstimate([]*mgmtv1alpha1.TableRunEstimate{
+		{Schema: "public", Table: "users", EstimatedRows: 6000},
+	}, []time.Duration{time.Minute, 10 * time.Second, 30 * time.Second})
+	require.Equal(t, mgmtv1alpha1.JobRunEstimateBasis_JOB_RUN_ESTIMATE_BASIS_HISTORICAL, resp.GetBasis())
+	require.Equal(t, uint32(3), resp.GetCompletedRunsSampled())
+	require.Equal(t, int64(30), resp.GetEstimatedDurationSeconds())
+	require.Equal(t, float64(200), resp.GetRowsPerSecond())
+}
+
+func Test_getMedianDuration(t *testing.T) {
+	require.Equal(t, 2*time.Second, getMedianDuration([]time.Duration{3 * time.Second, time.Second, 2 * time.Second}))
+	require.Equal(t, 15*time.Second, getMedianDuration([]time.Duration{10 * time.Second, 20 * time.Second}))
+}
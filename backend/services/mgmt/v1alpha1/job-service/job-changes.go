@@ -0,0 +1,292 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/classification"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *Service) GetJobChangeRequests(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetJobChangeRequestsRequest],
+) (*connect.Response[mgmtv1alpha1.GetJobChangeRequestsResponse], error) {
+	jobUuid, err := nucleusdb.ToUuid(req.Msg.GetJobId())
+	if err != nil {
+		return nil, err
+	}
+	job, err := s.db.Q.GetJobById(ctx, s.db.Db, jobUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return nil, nucleuserrors.WithErrorCode(nucleuserrors.NewNotFound("unable to find job by id"), mgmtv1alpha1.ErrorCode_ERROR_CODE_JOB_NOT_FOUND)
+	}
+	_, err = s.verifyUserInAccount(ctx, nucleusdb.UUIDString(job.AccountID))
+	if err != nil {
+		return nil, err
+	}
+
+	changeRequests, err := s.db.Q.GetJobChangeRequestsByJob(ctx, s.db.Db, job.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*mgmtv1alpha1.JobChangeRequest, 0, len(changeRequests))
+	for idx := range changeRequests {
+		dtos = append(dtos, dtomaps.ToJobChangeRequestDto(&changeRequests[idx]))
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GetJobChangeRequestsResponse{
+		ChangeRequests: dtos,
+	}), nil
+}
+
+func (s *Service) ApproveJobChange(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.ApproveJobChangeRequest],
+) (*connect.Response[mgmtv1alpha1.ApproveJobChangeResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("jobChangeRequestId", req.Msg.GetId())
+	if !s.cfg.IsJobChangeApprovalEnabled {
+		return nil, nucleuserrors.NewBadRequest("job change approvals are not enabled")
+	}
+
+	changeRequestUuid, err := nucleusdb.ToUuid(req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	changeRequest, err := s.db.Q.GetJobChangeRequestById(ctx, s.db.Db, changeRequestUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return nil, nucleuserrors.NewNotFound("unable to find job change request by id")
+	}
+	if changeRequest.ApprovedByID.Valid {
+		return nil, nucleuserrors.NewBadRequest("job change request has already been approved")
+	}
+
+	job, err := s.db.Q.GetJobById(ctx, s.db.Db, changeRequest.JobID)
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.verifyUserInAccount(ctx, nucleusdb.UUIDString(job.AccountID))
+	if err != nil {
+		return nil, err
+	}
+	userUuid, err := s.getUserUuid(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if *userUuid == changeRequest.CreatedByID {
+		return nil, nucleuserrors.NewForbidden("job changes must be approved by a different user than the one that requested them")
+	}
+	if isJobChangeRequestStale(&job, &changeRequest) {
+		return nil, nucleuserrors.NewAborted("the job has been updated since this change was requested, the change must be requested again")
+	}
+
+	logger.Info("approving job change request")
+	var approved db_queries.NeosyncApiJobChangeRequest
+	if err := s.db.WithTx(ctx, nil, func(dbtx nucleusdb.BaseDBTX) error {
+		approved, err = s.db.Q.ApproveJobChangeRequest(ctx, dbtx, db_queries.ApproveJobChangeRequestParams{
+			ApprovedByID: *userUuid,
+			ID:           changeRequest.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to approve job change request: %w", err)
+		}
+
+		// the job is attributed to the user that authored the change, the approval is tracked on the change request
+		_, err = s.db.Q.UpdateJobSource(ctx, dbtx, db_queries.UpdateJobSourceParams{
+			ID:                job.ID,
+			ConnectionOptions: changeRequest.ConnectionOptions,
+			UpdatedByID:       changeRequest.CreatedByID,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to update job source: %w", err)
+		}
+
+		_, err = s.db.Q.UpdateJobMappings(ctx, dbtx, db_queries.UpdateJobMappingsParams{
+			ID:          job.ID,
+			Mappings:    changeRequest.Mappings,
+			UpdatedByID: changeRequest.CreatedByID,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to update job mappings: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	updatedJob, err := s.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{
+		Id: nucleusdb.UUIDString(job.ID),
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve job after approving change: %w", err)
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.ApproveJobChangeResponse{
+		Job:           updatedJob.Msg.GetJob(),
+		ChangeRequest: dtomaps.ToJobChangeRequestDto(&approved),
+	}), nil
+}
+
+// A change request is stale if the job was updated after it was requested, as approving it would overwrite that update
+func isJobChangeRequestStale(job *db_queries.NeosyncApiJob, changeRequest *db_queries.NeosyncApiJobChangeRequest) bool {
+	if !job.UpdatedAt.Valid || !changeRequest.CreatedAt.Valid {
+		return false
+	}
+	return job.UpdatedAt.Time.After(changeRequest.CreatedAt.Time)
+}
+
+// If job change approvals are enabled and the updated mappings modify any sensitive columns, the update is stored as a change request
+// that must be approved before it is applied. Returns nil if the update does not need approval and may be applied directly
+func (s *Service) holdSensitiveJobChange(
+	ctx context.Context,
+	logger *slog.Logger,
+	job *db_queries.NeosyncApiJob,
+	sourceConnectionId *string,
+	connectionOptions *pg_models.JobSourceOptions,
+	mappings []*pg_models.JobMapping,
+	userUuid pgtype.UUID,
+) (*db_queries.NeosyncApiJobChangeRequest, error) {
+	sensitiveChanges, err := s.getSensitiveJobChanges(ctx, job, sourceConnectionId, mappings)
+	if err != nil {
+		return nil, err
+	}
+	if len(sensitiveChanges) == 0 {
+		return nil, nil
+	}
+
+	logger.Info(fmt.Sprintf("job change modifies %d sensitive column(s), holding change for approval", len(sensitiveChanges)))
+	var changeRequest *db_queries.NeosyncApiJobChangeRequest
+	if err := s.db.WithTx(ctx, nil, func(dbtx nucleusdb.BaseDBTX) error {
+		changeRequest, err = s.createJobChangeRequest(ctx, dbtx, job, connectionOptions, mappings, userUuid)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return changeRequest, nil
+}
+
+// Returns the schema.table.column of every sensitive column the updated mappings modify.
+// Always empty if job change approvals are disabled
+func (s *Service) getSensitiveJobChanges(
+	ctx context.Context,
+	job *db_queries.NeosyncApiJob,
+	sourceConnectionId *string,
+	mappings []*pg_models.JobMapping,
+) ([]string, error) {
+	if !s.cfg.IsJobChangeApprovalEnabled {
+		return nil, nil
+	}
+	tags, err := s.getColumnTagMap(ctx, sourceConnectionId)
+	if err != nil {
+		return nil, err
+	}
+	return getSensitiveMappingChanges(job.Mappings, mappings, tags), nil
+}
+
+// Stores the job change as a pending change request, superseding any previously pending change. Must be called within a transaction
+func (s *Service) createJobChangeRequest(
+	ctx context.Context,
+	dbtx nucleusdb.BaseDBTX,
+	job *db_queries.NeosyncApiJob,
+	connectionOptions *pg_models.JobSourceOptions,
+	mappings []*pg_models.JobMapping,
+	userUuid pgtype.UUID,
+) (*db_queries.NeosyncApiJobChangeRequest, error) {
+	err := s.db.Q.RemovePendingJobChangeRequests(ctx, dbtx, job.ID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to remove pending job change requests: %w", err)
+	}
+	changeRequest, err := s.db.Q.CreateJobChangeRequest(ctx, dbtx, db_queries.CreateJobChangeRequestParams{
+		JobID:             job.ID,
+		ConnectionOptions: connectionOptions,
+		Mappings:          mappings,
+		CreatedByID:       userUuid,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create job change request: %w", err)
+	}
+	return &changeRequest, nil
+}
+
+// Returns the column tags of the connection. Tags take precedence over the inferred classification of a column
+func (s *Service) getColumnTagMap(
+	ctx context.Context,
+	connectionId *string,
+) (classification.ColumnTagMap, error) {
+	if connectionId == nil || *connectionId == "" {
+		return classification.ColumnTagMap{}, nil
+	}
+	connectionUuid, err := nucleusdb.ToUuid(*connectionId)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := s.db.Q.GetColumnTagsByConnection(ctx, s.db.Db, connectionUuid)
+	if err != nil {
+		return nil, err
+	}
+	return dtomaps.ToColumnTagMap(tags), nil
+}
+
+// Returns the schema.table.column of every sensitive column whose transformer was added, removed, or modified
+func getSensitiveMappingChanges(
+	current []*pg_models.JobMapping,
+	updated []*pg_models.JobMapping,
+	tags classification.ColumnTagMap,
+) []string {
+	currentMap := map[string]*mgmtv1alpha1.JobMapping{}
+	for _, mapping := range current {
+		currentMap[getMappingKey(mapping)] = mapping.ToDto()
+	}
+	updatedMap := map[string]*mgmtv1alpha1.JobMapping{}
+	for _, mapping := range updated {
+		updatedMap[getMappingKey(mapping)] = mapping.ToDto()
+	}
+
+	output := []string{}
+	isSensitive := func(mapping *mgmtv1alpha1.JobMapping) bool {
+		if mapping == nil {
+			return false
+		}
+		return classification.IsSensitive(
+			classification.Classify(tags, mapping.GetSchema(), mapping.GetTable(), mapping.GetColumn(), mapping.GetTransformer().GetSource()),
+		)
+	}
+	for key, currentMapping := range currentMap {
+		updatedMapping := updatedMap[key]
+		if updatedMapping != nil && proto.Equal(currentMapping.GetTransformer(), updatedMapping.GetTransformer()) {
+			continue
+		}
+		if isSensitive(currentMapping) || isSensitive(updatedMapping) {
+			output = append(output, key)
+		}
+	}
+	for key, updatedMapping := range updatedMap {
+		if _, ok := currentMap[key]; ok {
+			continue
+		}
+		if isSensitive(updatedMapping) {
+			output = append(output, key)
+		}
+	}
+	slices.Sort(output)
+	return output
+}
+
+func getMappingKey(mapping *pg_models.JobMapping) string {
+	return classification.BuildColumnKey(mapping.Schema, mapping.Table, mapping.Column)
+}
@@ -0,0 +1,288 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/classification"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockApproverUserId = "1f6a7c5e-7b3d-4b8e-9a0c-52f0f3d4b9a1"
+)
+
+func Test_UpdateJobSourceConnection_SensitiveChange_RequiresApproval(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true, IsJobChangeApprovalEnabled: true})
+	mockTx := new(nucleusdb.MockTx)
+
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	job.Mappings = []*pg_models.JobMapping{
+		{Schema: "public", Table: "users", Column: "email", JobMappingTransformer: &pg_models.JobMappingTransformerModel{
+			Source: int32(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL),
+			Config: &pg_models.TransformerConfigs{},
+		}},
+	}
+	conn := getConnectionMock(mockAccountId, "test-1")
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	changeRequest := mockJobChangeRequest(job.ID, userUuid)
+
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	mockDbTransaction(m.DbtxMock, mockTx)
+	mockGetJob(m.UserAccountServiceMock, m.QuerierMock, job, []db_queries.NeosyncApiJobDestinationConnectionAssociation{})
+	m.QuerierMock.On("IsConnectionInAccount", mock.Anything, mock.Anything, db_queries.IsConnectionInAccountParams{
+		AccountId:    accountUuid,
+		ConnectionId: conn.ID,
+	}).Return(int64(1), nil)
+	m.ConnectionServiceClientMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: &mgmtv1alpha1.Connection{
+			Id: mockConnectionId,
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{
+					PgConfig: &mgmtv1alpha1.PostgresConnectionConfig{},
+				},
+			},
+		},
+	}), nil)
+	m.QuerierMock.On("GetColumnTagsByConnection", mock.Anything, mock.Anything, conn.ID).
+		Return([]db_queries.NeosyncApiConnectionColumnTag{}, nil)
+	m.QuerierMock.On("RemovePendingJobChangeRequests", mock.Anything, mockTx, job.ID).Return(nil)
+	m.QuerierMock.On("CreateJobChangeRequest", mock.Anything, mockTx, mock.Anything).Return(changeRequest, nil)
+
+	resp, err := m.Service.UpdateJobSourceConnection(context.Background(), connect.NewRequest(&mgmtv1alpha1.UpdateJobSourceConnectionRequest{
+		Id: nucleusdb.UUIDString(job.ID),
+		Source: &mgmtv1alpha1.JobSource{
+			Options: &mgmtv1alpha1.JobSourceOptions{
+				Config: &mgmtv1alpha1.JobSourceOptions_Postgres{
+					Postgres: &mgmtv1alpha1.PostgresSourceConnectionOptions{
+						ConnectionId: nucleusdb.UUIDString(conn.ID),
+					},
+				},
+			},
+		},
+		Mappings: []*mgmtv1alpha1.JobMapping{
+			{Schema: "public", Table: "users", Column: "email", Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH,
+				Config: &mgmtv1alpha1.TransformerConfig{},
+			}},
+		},
+	}))
+
+	require.NoError(t, err)
+	require.NotNil(t, resp.Msg.GetPendingChange())
+	require.Equal(t, nucleusdb.UUIDString(changeRequest.ID), resp.Msg.GetPendingChange().GetId())
+	m.QuerierMock.AssertNotCalled(t, "UpdateJobMappings", mock.Anything, mock.Anything, mock.Anything)
+	m.QuerierMock.AssertNotCalled(t, "UpdateJobSource", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_ApproveJobChange(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true, IsJobChangeApprovalEnabled: true})
+	mockTx := new(nucleusdb.MockTx)
+
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	requesterUuid, _ := nucleusdb.ToUuid(mockApproverUserId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	changeRequest := mockJobChangeRequest(job.ID, requesterUuid)
+	job.UpdatedAt = pgtype.Timestamp{Time: changeRequest.CreatedAt.Time.Add(-time.Hour), Valid: true}
+	approved := changeRequest
+	approved.ApprovedByID = userUuid
+
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	mockDbTransaction(m.DbtxMock, mockTx)
+	mockGetJob(m.UserAccountServiceMock, m.QuerierMock, job, []db_queries.NeosyncApiJobDestinationConnectionAssociation{})
+	m.QuerierMock.On("GetJobChangeRequestById", mock.Anything, mock.Anything, changeRequest.ID).Return(changeRequest, nil)
+	m.QuerierMock.On("ApproveJobChangeRequest", mock.Anything, mockTx, db_queries.ApproveJobChangeRequestParams{
+		ApprovedByID: userUuid,
+		ID:           changeRequest.ID,
+	}).Return(approved, nil)
+	m.QuerierMock.On("UpdateJobSource", mock.Anything, mockTx, db_queries.UpdateJobSourceParams{
+		ID:                job.ID,
+		ConnectionOptions: changeRequest.ConnectionOptions,
+		UpdatedByID:       requesterUuid,
+	}).Return(job, nil)
+	m.QuerierMock.On("UpdateJobMappings", mock.Anything, mockTx, db_queries.UpdateJobMappingsParams{
+		ID:          job.ID,
+		Mappings:    changeRequest.Mappings,
+		UpdatedByID: requesterUuid,
+	}).Return(job, nil)
+
+	resp, err := m.Service.ApproveJobChange(context.Background(), connect.NewRequest(&mgmtv1alpha1.ApproveJobChangeRequest{
+		Id: nucleusdb.UUIDString(changeRequest.ID),
+	}))
+
+	require.NoError(t, err)
+	require.Equal(t, mockUserId, resp.Msg.GetChangeRequest().GetApprovedByUserId())
+	require.Equal(t, nucleusdb.UUIDString(job.ID), resp.Msg.GetJob().GetId())
+}
+
+func Test_ApproveJobChange_SameUser(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true, IsJobChangeApprovalEnabled: true})
+
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	changeRequest := mockJobChangeRequest(job.ID, userUuid)
+
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobChangeRequestById", mock.Anything, mock.Anything, changeRequest.ID).Return(changeRequest, nil)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+
+	resp, err := m.Service.ApproveJobChange(context.Background(), connect.NewRequest(&mgmtv1alpha1.ApproveJobChangeRequest{
+		Id: nucleusdb.UUIDString(changeRequest.ID),
+	}))
+
+	require.Error(t, err)
+	require.Nil(t, resp)
+}
+
+func Test_ApproveJobChange_AlreadyApproved(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true, IsJobChangeApprovalEnabled: true})
+
+	jobUuid, _ := nucleusdb.ToUuid(uuid.NewString())
+	requesterUuid, _ := nucleusdb.ToUuid(mockApproverUserId)
+	changeRequest := mockJobChangeRequest(jobUuid, requesterUuid)
+	changeRequest.ApprovedByID = requesterUuid
+
+	m.QuerierMock.On("GetJobChangeRequestById", mock.Anything, mock.Anything, changeRequest.ID).Return(changeRequest, nil)
+
+	resp, err := m.Service.ApproveJobChange(context.Background(), connect.NewRequest(&mgmtv1alpha1.ApproveJobChangeRequest{
+		Id: nucleusdb.UUIDString(changeRequest.ID),
+	}))
+
+	require.Error(t, err)
+	require.Nil(t, resp)
+}
+
+func Test_ApproveJobChange_Stale(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true, IsJobChangeApprovalEnabled: true})
+
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	requesterUuid, _ := nucleusdb.ToUuid(mockApproverUserId)
+	changeRequest := mockJobChangeRequest(job.ID, requesterUuid)
+	changeRequest.CreatedAt = pgtype.Timestamp{Time: time.Now().Add(-time.Hour), Valid: true}
+	job.UpdatedAt = pgtype.Timestamp{Time: time.Now(), Valid: true}
+
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobChangeRequestById", mock.Anything, mock.Anything, changeRequest.ID).Return(changeRequest, nil)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+
+	resp, err := m.Service.ApproveJobChange(context.Background(), connect.NewRequest(&mgmtv1alpha1.ApproveJobChangeRequest{
+		Id: nucleusdb.UUIDString(changeRequest.ID),
+	}))
+
+	require.Error(t, err)
+	require.Nil(t, resp)
+	m.QuerierMock.AssertNotCalled(t, "ApproveJobChangeRequest", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_ApproveJobChange_Disabled(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+
+	resp, err := m.Service.ApproveJobChange(context.Background(), connect.NewRequest(&mgmtv1alpha1.ApproveJobChangeRequest{
+		Id: uuid.NewString(),
+	}))
+
+	require.Error(t, err)
+	require.Nil(t, resp)
+	m.QuerierMock.AssertNotCalled(t, "GetJobChangeRequestById", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_GetJobChangeRequests(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetJobChangeRequestsByJob", mock.Anything, mock.Anything, job.ID).
+		Return([]db_queries.NeosyncApiJobChangeRequest{mockJobChangeRequest(job.ID, userUuid)}, nil)
+
+	resp, err := m.Service.GetJobChangeRequests(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobChangeRequestsRequest{
+		JobId: nucleusdb.UUIDString(job.ID),
+	}))
+
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.GetChangeRequests(), 1)
+	require.Nil(t, resp.Msg.GetChangeRequests()[0].ApprovedByUserId)
+}
+
+func Test_getSensitiveMappingChanges(t *testing.T) {
+	passthrough := &pg_models.JobMappingTransformerModel{
+		Source: int32(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH),
+		Config: &pg_models.TransformerConfigs{},
+	}
+	generateEmail := &pg_models.JobMappingTransformerModel{
+		Source: int32(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_EMAIL),
+		Config: &pg_models.TransformerConfigs{},
+	}
+
+	current := []*pg_models.JobMapping{
+		{Schema: "public", Table: "users", Column: "id", JobMappingTransformer: passthrough},
+		{Schema: "public", Table: "users", Column: "email", JobMappingTransformer: generateEmail},
+		{Schema: "public", Table: "users", Column: "ssn", JobMappingTransformer: passthrough},
+	}
+
+	require.Empty(t, getSensitiveMappingChanges(current, current, nil))
+
+	updated := []*pg_models.JobMapping{
+		{Schema: "public", Table: "users", Column: "id", JobMappingTransformer: generateEmail},
+		{Schema: "public", Table: "users", Column: "email", JobMappingTransformer: passthrough},
+		{Schema: "public", Table: "users", Column: "phone", JobMappingTransformer: passthrough},
+	}
+	require.Equal(
+		t,
+		[]string{"public.users.email", "public.users.id", "public.users.phone", "public.users.ssn"},
+		getSensitiveMappingChanges(current, updated, nil),
+	)
+
+	require.Empty(t, getSensitiveMappingChanges(
+		[]*pg_models.JobMapping{{Schema: "public", Table: "users", Column: "id", JobMappingTransformer: passthrough}},
+		[]*pg_models.JobMapping{{Schema: "public", Table: "users", Column: "id", JobMappingTransformer: &pg_models.JobMappingTransformerModel{
+			Source: int32(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_UUID),
+			Config: &pg_models.TransformerConfigs{},
+		}}},
+		nil,
+	))
+
+	tags := classification.ColumnTagMap{
+		"public.users.id":    {mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_FINANCIAL},
+		"public.users.email": {mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_PUBLIC},
+	}
+	require.Equal(
+		t,
+		[]string{"public.users.id"},
+		getSensitiveMappingChanges(
+			[]*pg_models.JobMapping{
+				{Schema: "public", Table: "users", Column: "id", JobMappingTransformer: passthrough},
+				{Schema: "public", Table: "users", Column: "email", JobMappingTransformer: passthrough},
+			},
+			[]*pg_models.JobMapping{
+				{Schema: "public", Table: "users", Column: "id", JobMappingTransformer: generateEmail},
+				{Schema: "public", Table: "users", Column: "email", JobMappingTransformer: generateEmail},
+			},
+			tags,
+		),
+	)
+}
+
+func mockJobChangeRequest(jobUuid, createdByUuid pgtype.UUID) db_queries.NeosyncApiJobChangeRequest {
+	id, _ := nucleusdb.ToUuid(uuid.NewString())
+	return db_queries.NeosyncApiJobChangeRequest{
+		ID:                id,
+		JobID:             jobUuid,
+		ConnectionOptions: &pg_models.JobSourceOptions{},
+		Mappings:          []*pg_models.JobMapping{},
+		CreatedByID:       createdByUuid,
+		CreatedAt:         pgtype.Timestamp{Time: time.Now(), Valid: true},
+	}
+}
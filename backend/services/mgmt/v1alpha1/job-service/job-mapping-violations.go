@@ -0,0 +1,414 @@
+package v1alpha1_jobservice
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+)
+
+// The kind of values a column holds, which decides what can be written to it. Empty if the kind is not known,
+// in which case the column is not checked
+type columnTypeKind string
+
+const (
+	columnTypeKindUnknown  columnTypeKind = ""
+	columnTypeKindText     columnTypeKind = "text"
+	columnTypeKindInteger  columnTypeKind = "integer"
+	columnTypeKindDecimal  columnTypeKind = "decimal"
+	columnTypeKindBoolean  columnTypeKind = "boolean"
+	columnTypeKindTemporal columnTypeKind = "temporal"
+	columnTypeKindUuid     columnTypeKind = "uuid"
+)
+
+// The kind of values each system transformer generates. Transformers that are not listed, such as passthrough and javascript, may generate any kind
+var transformerOutputKinds = map[mgmtv1alpha1.TransformerSource]columnTypeKind{
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_BOOL:                   columnTypeKindBoolean,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_CARD_NUMBER:            columnTypeKindInteger,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_INT64_PHONE_NUMBER:     columnTypeKindInteger,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_INT64:                  columnTypeKindInteger,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_UNIXTIMESTAMP:          columnTypeKindInteger,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_INT64_PHONE_NUMBER:    columnTypeKindInteger,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_INT64:                 columnTypeKindInteger,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_FLOAT64:                columnTypeKindDecimal,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FLOAT64:               columnTypeKindDecimal,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_MONEY:                  columnTypeKindDecimal,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_MONEY:                 columnTypeKindDecimal,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_UTCTIMESTAMP:           columnTypeKindTemporal,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_BUSINESS_TIMESTAMP:     columnTypeKindTemporal,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_UUID:                   columnTypeKindUuid,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_EMAIL:                  columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL:                 columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_CITY:                   columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_E164_PHONE_NUMBER:      columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_FIRST_NAME:             columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_FULL_ADDRESS:           columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_FULL_NAME:              columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_GENDER:                 columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_LAST_NAME:              columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_SHA256HASH:             columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_SSN:                    columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STATE:                  columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STREET_ADDRESS:         columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STRING_PHONE_NUMBER:    columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_RANDOM_STRING:          columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_USERNAME:               columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_ZIPCODE:                columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_E164_PHONE_NUMBER:     columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FIRST_NAME:            columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FULL_NAME:             columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_LAST_NAME:             columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_PHONE_NUMBER:          columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_STRING:                columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_CATEGORICAL:            columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_CHARACTER_SCRAMBLE:    columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_GEO_COORDINATE:        columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_URL:                   columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_USER_AGENT:             columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_MEDICAL_RECORD_NUMBER:  columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_MEDICAL_RECORD_NUMBER: columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NPI:                    columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_NPI:                   columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_ICD10_CODE:             columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_CPT_CODE:               columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_VIN:                    columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_VIN:                   columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_IMEI:                   columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_IMEI:                  columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_EAN:                    columnTypeKindText,
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EAN:                   columnTypeKindText,
+}
+
+var dataTypeModifierRegex = regexp.MustCompile(`\([^)]*\)`)
+
+func getColumnTypeKind(info *sql_manager.ColumnInfo) columnTypeKind {
+	dataType := info.DataType
+	if info.BaseDataType != "" {
+		dataType = info.BaseDataType
+	}
+	dataType = strings.ToLower(strings.TrimSpace(dataType))
+	if strings.HasSuffix(dataType, "[]") || strings.HasPrefix(dataType, "array") {
+		return columnTypeKindUnknown
+	}
+	// mysql stores booleans as tinyint(1)
+	if strings.HasPrefix(dataType, "tinyint(1)") {
+		return columnTypeKindBoolean
+	}
+	dataType = strings.Join(strings.Fields(dataTypeModifierRegex.ReplaceAllString(dataType, "")), " ")
+	dataType = strings.TrimSuffix(strings.TrimSuffix(dataType, " zerofill"), " unsigned")
+
+	switch dataType {
+	case "text", "character varying", "varchar", "character", "char", "bpchar", "nchar", "nvarchar", "citext", "name",
+		"tinytext", "mediumtext", "longtext", "string":
+		return columnTypeKindText
+	case "smallint", "integer", "int", "int2", "int4", "int8", "bigint", "tinyint", "mediumint", "serial", "smallserial", "bigserial", "int64":
+		return columnTypeKindInteger
+	case "numeric", "decimal", "real", "double precision", "double", "float", "float4", "float8", "float64":
+		return columnTypeKindDecimal
+	case "boolean", "bool":
+		return columnTypeKindBoolean
+	case "date", "datetime", "timestamp", "timestamptz", "timestamp without time zone", "timestamp with time zone",
+		"time", "timetz", "time without time zone", "time with time zone":
+		return columnTypeKindTemporal
+	case "uuid":
+		return columnTypeKindUuid
+	default:
+		return columnTypeKindUnknown
+	}
+}
+
+// Whether values of the kind can be written to a column of the other kind. Text columns accept every kind of value
+func canWriteKind(value, column columnTypeKind) bool {
+	if value == columnTypeKindUnknown || column == columnTypeKindUnknown || value == column || column == columnTypeKindText {
+		return true
+	}
+	return column == columnTypeKindDecimal && value == columnTypeKindInteger
+}
+
+// Returns the violation of writing the values of the mapping to the target column, or nil if they can be written.
+// The source column is nil if it does not exist
+func getColumnTypeViolation(
+	connectionId string,
+	jm *mgmtv1alpha1.JobMapping,
+	schema, table, column string,
+	source, target *sql_manager.ColumnInfo,
+) *mgmtv1alpha1.JobMappingViolation {
+	targetKind := getColumnTypeKind(target)
+	transformer := jm.GetTransformer().GetSource()
+	switch transformer {
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_UNSPECIFIED, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH:
+		if source == nil {
+			return nil
+		}
+		if sourceKind := getColumnTypeKind(source); !canWriteKind(sourceKind, targetKind) {
+			return &mgmtv1alpha1.JobMappingViolation{
+				Type:         mgmtv1alpha1.JobMappingViolationType_JOB_MAPPING_VIOLATION_TYPE_TYPE_MISMATCH,
+				ConnectionId: connectionId,
+				Schema:       schema,
+				Table:        table,
+				Column:       column,
+				Message:      fmt.Sprintf("Values of source column %s.%s.%s of type %s can not be written to column of type %s", jm.GetSchema(), jm.GetTable(), jm.GetColumn(), source.DataType, target.DataType),
+			}
+		}
+		return nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NULL:
+		if !target.IsNullable {
+			return &mgmtv1alpha1.JobMappingViolation{
+				Type:         mgmtv1alpha1.JobMappingViolationType_JOB_MAPPING_VIOLATION_TYPE_TRANSFORMER_TYPE_MISMATCH,
+				ConnectionId: connectionId,
+				Schema:       schema,
+				Table:        table,
+				Column:       column,
+				Message:      "Null values can not be written to a column that is not nullable",
+			}
+		}
+		return nil
+	}
+
+	outputKind, ok := transformerOutputKinds[transformer]
+	if !ok || canWriteKind(outputKind, targetKind) {
+		return nil
+	}
+	return &mgmtv1alpha1.JobMappingViolation{
+		Type:         mgmtv1alpha1.JobMappingViolationType_JOB_MAPPING_VIOLATION_TYPE_TRANSFORMER_TYPE_MISMATCH,
+		ConnectionId: connectionId,
+		Schema:       schema,
+		Table:        table,
+		Column:       column,
+		Message:      fmt.Sprintf("Transformer %s generates %s values, which can not be written to column of type %s", transformer, outputKind, target.DataType),
+	}
+}
+
+// Checks the mappings against the schema of the source. The values of transformers are checked against the source columns
+// when there are no destinations to check them against, as the destination schema is initialized from the source
+func getSourceMappingViolations(
+	connectionId string,
+	mappings []*mgmtv1alpha1.JobMapping,
+	colInfoMap map[string]map[string]*sql_manager.ColumnInfo,
+	checkTransformers bool,
+) []*mgmtv1alpha1.JobMappingViolation {
+	violations := []*mgmtv1alpha1.JobMappingViolation{}
+	mappedTables := map[string]map[string]struct{}{}
+	for _, jm := range mappings {
+		table := sql_manager.BuildTable(jm.GetSchema(), jm.GetTable())
+		tableCols, ok := colInfoMap[table]
+		if !ok {
+			if _, ok := mappedTables[table]; !ok {
+				violations = append(violations, &mgmtv1alpha1.JobMappingViolation{
+					Type:         mgmtv1alpha1.JobMappingViolationType_JOB_MAPPING_VIOLATION_TYPE_MISSING_TABLE,
+					ConnectionId: connectionId,
+					Schema:       jm.GetSchema(),
+					Table:        jm.GetTable(),
+					Message:      fmt.Sprintf("Table does not exist [%s]", table),
+				})
+				mappedTables[table] = map[string]struct{}{}
+			}
+			continue
+		}
+		if _, ok := mappedTables[table]; !ok {
+			mappedTables[table] = map[string]struct{}{}
+		}
+		mappedTables[table][jm.GetColumn()] = struct{}{}
+
+		info, ok := tableCols[jm.GetColumn()]
+		if !ok {
+			violations = append(violations, &mgmtv1alpha1.JobMappingViolation{
+				Type:         mgmtv1alpha1.JobMappingViolationType_JOB_MAPPING_VIOLATION_TYPE_MISSING_COLUMN,
+				ConnectionId: connectionId,
+				Schema:       jm.GetSchema(),
+				Table:        jm.GetTable(),
+				Column:       jm.GetColumn(),
+				Message:      fmt.Sprintf("Column does not exist. Table: %s  Column: %s", table, jm.GetColumn()),
+			})
+			continue
+		}
+		if !checkTransformers || jm.GetTransformer().GetSource() == mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_DROP_COLUMN {
+			continue
+		}
+		target := info
+		if dataType := jm.GetDestinationColumn().GetDataType(); dataType != "" {
+			target = &sql_manager.ColumnInfo{DataType: dataType, IsNullable: info.IsNullable}
+		}
+		// the destination is created from the source, so the values of passthrough columns always fit
+		if jm.GetTransformer().GetSource() == mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH {
+			continue
+		}
+		if violation := getColumnTypeViolation(connectionId, jm, jm.GetSchema(), jm.GetTable(), jm.GetColumn(), info, target); violation != nil {
+			violations = append(violations, violation)
+		}
+	}
+
+	for table, mappedCols := range mappedTables {
+		for col, info := range colInfoMap[table] {
+			if _, ok := mappedCols[col]; ok || info.IsNullable {
+				continue
+			}
+			schema, tableName := splitSchemaTable(table)
+			violations = append(violations, &mgmtv1alpha1.JobMappingViolation{
+				Type:         mgmtv1alpha1.JobMappingViolationType_JOB_MAPPING_VIOLATION_TYPE_UNMAPPED_REQUIRED_COLUMN,
+				ConnectionId: connectionId,
+				Schema:       schema,
+				Table:        tableName,
+				Column:       col,
+				Message:      fmt.Sprintf("Violates not-null constraint. Missing required column. Table: %s  Column: %s", table, col),
+			})
+		}
+	}
+	sortJobMappingViolations(violations)
+	return violations
+}
+
+// Checks the mappings against the schema of a destination, following the destination table and column of each mapping
+func getDestinationMappingViolations(
+	connectionId string,
+	mappings []*mgmtv1alpha1.JobMapping,
+	sourceColInfoMap map[string]map[string]*sql_manager.ColumnInfo,
+	colInfoMap map[string]map[string]*sql_manager.ColumnInfo,
+) []*mgmtv1alpha1.JobMappingViolation {
+	violations := []*mgmtv1alpha1.JobMappingViolation{}
+	writtenTables := map[string]map[string]struct{}{}
+	missingTables := map[string]struct{}{}
+	for _, jm := range mappings {
+		schema, table := jm.GetSchema(), jm.GetTable()
+		if dest := jm.GetDestinationTable(); dest != nil {
+			schema, table = dest.GetSchema(), dest.GetTable()
+		}
+		tableKey := sql_manager.BuildTable(schema, table)
+		tableCols, ok := colInfoMap[tableKey]
+		if !ok {
+			if _, ok := missingTables[tableKey]; !ok {
+				missingTables[tableKey] = struct{}{}
+				violations = append(violations, &mgmtv1alpha1.JobMappingViolation{
+					Type:         mgmtv1alpha1.JobMappingViolationType_JOB_MAPPING_VIOLATION_TYPE_MISSING_TABLE,
+					ConnectionId: connectionId,
+					Schema:       schema,
+					Table:        table,
+					Message:      fmt.Sprintf("Destination table does not exist [%s]", tableKey),
+				})
+			}
+			continue
+		}
+		if _, ok := writtenTables[tableKey]; !ok {
+			writtenTables[tableKey] = map[string]struct{}{}
+		}
+		if jm.GetTransformer().GetSource() == mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_DROP_COLUMN {
+			continue
+		}
+
+		column := jm.GetColumn()
+		if name := jm.GetDestinationColumn().GetName(); name != "" {
+			column = name
+		}
+		writtenTables[tableKey][column] = struct{}{}
+		info, ok := tableCols[column]
+		if !ok {
+			violations = append(violations, &mgmtv1alpha1.JobMappingViolation{
+				Type:         mgmtv1alpha1.JobMappingViolationType_JOB_MAPPING_VIOLATION_TYPE_MISSING_COLUMN,
+				ConnectionId: connectionId,
+				Schema:       schema,
+				Table:        table,
+				Column:       column,
+				Message:      fmt.Sprintf("Destination column does not exist. Table: %s  Column: %s", tableKey, column),
+			})
+			continue
+		}
+		source := sourceColInfoMap[sql_manager.BuildTable(jm.GetSchema(), jm.GetTable())][jm.GetColumn()]
+		if violation := getColumnTypeViolation(connectionId, jm, schema, table, column, source, info); violation != nil {
+			violations = append(violations, violation)
+		}
+	}
+
+	for tableKey, writtenCols := range writtenTables {
+		for col, info := range colInfoMap[tableKey] {
+			if _, ok := writtenCols[col]; ok || info.IsNullable || info.ColumnDefault != "" {
+				continue
+			}
+			schema, table := splitSchemaTable(tableKey)
+			violations = append(violations, &mgmtv1alpha1.JobMappingViolation{
+				Type:         mgmtv1alpha1.JobMappingViolationType_JOB_MAPPING_VIOLATION_TYPE_UNMAPPED_REQUIRED_COLUMN,
+				ConnectionId: connectionId,
+				Schema:       schema,
+				Table:        table,
+				Column:       col,
+				Message:      fmt.Sprintf("Destination column is not nullable, has no default and is not mapped. Table: %s  Column: %s", tableKey, col),
+			})
+		}
+	}
+	sortJobMappingViolations(violations)
+	return violations
+}
+
+func (s *Service) getDestinationConnectionViolations(
+	ctx context.Context,
+	logger *slog.Logger,
+	connectionId string,
+	mappings []*mgmtv1alpha1.JobMapping,
+	sourceColInfoMap map[string]map[string]*sql_manager.ColumnInfo,
+) ([]*mgmtv1alpha1.JobMappingViolation, error) {
+	connection, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+		Id: connectionId,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	if !hasSqlSchema(connection.Msg.GetConnection()) {
+		// files, topics and indices are written as they are, so there is no schema to check
+		return []*mgmtv1alpha1.JobMappingViolation{}, nil
+	}
+
+	connectionTimeout := 5
+	db, err := s.sqlmanager.NewSqlDb(ctx, logger, connection.Msg.GetConnection(), &connectionTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Db.Close()
+
+	colInfoMap, err := db.Db.GetSchemaColumnMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return getDestinationMappingViolations(connectionId, mappings, sourceColInfoMap, colInfoMap), nil
+}
+
+func hasSqlSchema(connection *mgmtv1alpha1.Connection) bool {
+	switch connection.GetConnectionConfig().GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig,
+		*mgmtv1alpha1.ConnectionConfig_MysqlConfig,
+		*mgmtv1alpha1.ConnectionConfig_SqliteConfig,
+		*mgmtv1alpha1.ConnectionConfig_SnowflakeConfig,
+		*mgmtv1alpha1.ConnectionConfig_RedshiftConfig,
+		*mgmtv1alpha1.ConnectionConfig_DuckdbConfig,
+		*mgmtv1alpha1.ConnectionConfig_SpannerConfig,
+		*mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		return true
+	default:
+		return false
+	}
+}
+
+// Splits a schema.table key. Unlike utils.SplitTableKey, tables without a schema, such as sqlite tables, keep an empty schema
+func splitSchemaTable(key string) (schema, table string) {
+	schema, table, found := strings.Cut(key, ".")
+	if !found {
+		return "", key
+	}
+	return schema, table
+}
+
+func sortJobMappingViolations(violations []*mgmtv1alpha1.JobMappingViolation) {
+	slices.SortFunc(violations, func(a, b *mgmtv1alpha1.JobMappingViolation) int {
+		return cmp.Or(
+			cmp.Compare(a.GetSchema(), b.GetSchema()),
+			cmp.Compare(a.GetTable(), b.GetTable()),
+			cmp.Compare(a.GetColumn(), b.GetColumn()),
+			cmp.Compare(a.GetType(), b.GetType()),
+		)
+	})
+}
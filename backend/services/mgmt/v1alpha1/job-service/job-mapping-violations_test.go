@@ -0,0 +1,129 @@
+package v1alpha1_jobservice
+
+import (
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getColumnTypeKind(t *testing.T) {
+	tests := []struct {
+		info     *sql_manager.ColumnInfo
+		expected columnTypeKind
+	}{
+		{&sql_manager.ColumnInfo{DataType: "character varying(255)"}, columnTypeKindText},
+		{&sql_manager.ColumnInfo{DataType: "int unsigned"}, columnTypeKindInteger},
+		{&sql_manager.ColumnInfo{DataType: "numeric(10, 2)"}, columnTypeKindDecimal},
+		{&sql_manager.ColumnInfo{DataType: "tinyint(1)"}, columnTypeKindBoolean},
+		{&sql_manager.ColumnInfo{DataType: "tinyint(4)"}, columnTypeKindInteger},
+		{&sql_manager.ColumnInfo{DataType: "timestamp(6) with time zone"}, columnTypeKindTemporal},
+		{&sql_manager.ColumnInfo{DataType: "USER-DEFINED", BaseDataType: "uuid"}, columnTypeKindUuid},
+		{&sql_manager.ColumnInfo{DataType: "integer[]"}, columnTypeKindUnknown},
+		{&sql_manager.ColumnInfo{DataType: "jsonb"}, columnTypeKindUnknown},
+		{&sql_manager.ColumnInfo{}, columnTypeKindUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.info.DataType, func(t *testing.T) {
+			require.Equal(t, tt.expected, getColumnTypeKind(tt.info))
+		})
+	}
+}
+
+func Test_canWriteKind(t *testing.T) {
+	require.True(t, canWriteKind(columnTypeKindInteger, columnTypeKindText))
+	require.True(t, canWriteKind(columnTypeKindInteger, columnTypeKindDecimal))
+	require.True(t, canWriteKind(columnTypeKindUnknown, columnTypeKindInteger))
+	require.True(t, canWriteKind(columnTypeKindText, columnTypeKindUnknown))
+	require.False(t, canWriteKind(columnTypeKindDecimal, columnTypeKindInteger))
+	require.False(t, canWriteKind(columnTypeKindText, columnTypeKindUuid))
+}
+
+func Test_getSourceMappingViolations(t *testing.T) {
+	colInfoMap := map[string]map[string]*sql_manager.ColumnInfo{
+		"public.users": {
+			"id":    {DataType: "uuid"},
+			"email": {DataType: "text", IsNullable: true},
+			"age":   {DataType: "integer", IsNullable: true},
+		},
+	}
+	mappings := []*mgmtv1alpha1.JobMapping{
+		{Schema: "public", Table: "users", Column: "id", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_UUID}},
+		{Schema: "public", Table: "users", Column: "age", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_EMAIL}},
+		{Schema: "public", Table: "users", Column: "nickname", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH}},
+		{Schema: "public", Table: "orders", Column: "id", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH}},
+	}
+
+	violations := getSourceMappingViolations("source", mappings, colInfoMap, true)
+	require.Equal(t, []mgmtv1alpha1.JobMappingViolationType{
+		mgmtv1alpha1.JobMappingViolationType_JOB_MAPPING_VIOLATION_TYPE_MISSING_TABLE,
+		mgmtv1alpha1.JobMappingViolationType_JOB_MAPPING_VIOLATION_TYPE_TRANSFORMER_TYPE_MISMATCH,
+		mgmtv1alpha1.JobMappingViolationType_JOB_MAPPING_VIOLATION_TYPE_MISSING_COLUMN,
+	}, getViolationTypes(violations))
+	require.Equal(t, "orders", violations[0].GetTable())
+	require.Equal(t, "age", violations[1].GetColumn())
+	require.Equal(t, "nickname", violations[2].GetColumn())
+
+	violations = getSourceMappingViolations("source", mappings[:2], colInfoMap, false)
+	require.Empty(t, violations)
+}
+
+func Test_getDestinationMappingViolations(t *testing.T) {
+	sourceColInfoMap := map[string]map[string]*sql_manager.ColumnInfo{
+		"public.users": {
+			"id":    {DataType: "uuid"},
+			"email": {DataType: "text"},
+			"age":   {DataType: "numeric"},
+			"ssn":   {DataType: "text"},
+		},
+	}
+	colInfoMap := map[string]map[string]*sql_manager.ColumnInfo{
+		"public.customers": {
+			"id":         {DataType: "uuid"},
+			"email":      {DataType: "text"},
+			"age":        {DataType: "integer", IsNullable: true},
+			"deleted":    {DataType: "boolean", IsNullable: true},
+			"created_at": {DataType: "timestamp", ColumnDefault: "now()"},
+			"org_id":     {DataType: "uuid"},
+		},
+	}
+	destinationTable := &mgmtv1alpha1.JobMappingDestinationTable{Schema: "public", Table: "customers"}
+	mappings := []*mgmtv1alpha1.JobMapping{
+		{Schema: "public", Table: "users", Column: "id", DestinationTable: destinationTable, Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH}},
+		{Schema: "public", Table: "users", Column: "email", DestinationTable: destinationTable, Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NULL}},
+		{Schema: "public", Table: "users", Column: "age", DestinationTable: destinationTable, Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH}},
+		{Schema: "public", Table: "users", Column: "ssn", DestinationTable: destinationTable, DestinationColumn: &mgmtv1alpha1.JobMappingDestinationColumn{Name: ptr("social")}, Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH}},
+		{Schema: "public", Table: "users", Column: "deleted", DestinationTable: destinationTable, Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_DROP_COLUMN}},
+		{Schema: "public", Table: "orders", Column: "id", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH}},
+	}
+
+	violations := getDestinationMappingViolations("dest", mappings, sourceColInfoMap, colInfoMap)
+	require.Equal(t, []mgmtv1alpha1.JobMappingViolationType{
+		mgmtv1alpha1.JobMappingViolationType_JOB_MAPPING_VIOLATION_TYPE_TYPE_MISMATCH,
+		mgmtv1alpha1.JobMappingViolationType_JOB_MAPPING_VIOLATION_TYPE_TRANSFORMER_TYPE_MISMATCH,
+		mgmtv1alpha1.JobMappingViolationType_JOB_MAPPING_VIOLATION_TYPE_UNMAPPED_REQUIRED_COLUMN,
+		mgmtv1alpha1.JobMappingViolationType_JOB_MAPPING_VIOLATION_TYPE_MISSING_COLUMN,
+		mgmtv1alpha1.JobMappingViolationType_JOB_MAPPING_VIOLATION_TYPE_MISSING_TABLE,
+	}, getViolationTypes(violations))
+	require.Equal(t, []string{"age", "email", "org_id", "social", ""}, getViolationColumns(violations))
+	for _, violation := range violations {
+		require.Equal(t, "dest", violation.GetConnectionId())
+	}
+}
+
+func getViolationTypes(violations []*mgmtv1alpha1.JobMappingViolation) []mgmtv1alpha1.JobMappingViolationType {
+	types := []mgmtv1alpha1.JobMappingViolationType{}
+	for _, violation := range violations {
+		types = append(types, violation.GetType())
+	}
+	return types
+}
+
+func getViolationColumns(violations []*mgmtv1alpha1.JobMappingViolation) []string {
+	columns := []string{}
+	for _, violation := range violations {
+		columns = append(columns, violation.GetColumn())
+	}
+	return columns
+}
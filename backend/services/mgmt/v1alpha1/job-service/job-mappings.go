@@ -0,0 +1,231 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/classification"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+)
+
+func (s *Service) SetJobMappings(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.SetJobMappingsRequest],
+) (*connect.Response[mgmtv1alpha1.SetJobMappingsResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("jobId", req.Msg.GetId())
+	jobUuid, err := nucleusdb.ToUuid(req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	job, err := s.db.Q.GetJobById(ctx, s.db.Db, jobUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return nil, nucleuserrors.WithErrorCode(nucleuserrors.NewNotFound("unable to find job by id"), mgmtv1alpha1.ErrorCode_ERROR_CODE_JOB_NOT_FOUND)
+	}
+
+	_, err = s.verifyUserInAccount(ctx, nucleusdb.UUIDString(job.AccountID))
+	if err != nil {
+		return nil, err
+	}
+	userUuid, err := s.getUserUuid(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var sourceConnectionId *string
+	if job.ConnectionOptions != nil {
+		if sourceOptions := job.ConnectionOptions.ToDto(); sourceOptions != nil {
+			// generate sources without a foreign key connection do not have a connection id, which is fine as there are no column tags to look up
+			sourceConnectionId, _ = getJobSourceConnectionId(&mgmtv1alpha1.JobSource{Options: sourceOptions})
+		}
+	}
+
+	// the job is locked for the duration of the update so that concurrent patches are applied on top of each other instead of overwriting each other
+	var changeRequest *db_queries.NeosyncApiJobChangeRequest
+	if err := s.db.WithTx(ctx, nil, func(dbtx nucleusdb.BaseDBTX) error {
+		lockedJob, err := s.db.Q.GetJobByIdForUpdate(ctx, dbtx, job.ID)
+		if err != nil {
+			return fmt.Errorf("unable to lock job: %w", err)
+		}
+
+		var mappings []*pg_models.JobMapping
+		switch mode := req.Msg.GetMode().(type) {
+		case *mgmtv1alpha1.SetJobMappingsRequest_Replace:
+			logger.Info(fmt.Sprintf("replacing job mappings with %d mapping(s)", len(mode.Replace.GetMappings())))
+			mappings, err = replaceJobMappings(mode.Replace)
+		case *mgmtv1alpha1.SetJobMappingsRequest_Patch:
+			logger.Info(fmt.Sprintf("patching job mappings with %d upsert(s) and %d removal(s)", len(mode.Patch.GetUpserts()), len(mode.Patch.GetRemovals())))
+			mappings, err = patchJobMappings(lockedJob.Mappings, mode.Patch)
+		default:
+			return nucleuserrors.NewBadRequest(fmt.Sprintf("unsupported job mappings mode: %T", mode))
+		}
+		if err != nil {
+			return err
+		}
+		if err := verifyJobMappingDestinations(mappings); err != nil {
+			return err
+		}
+
+		sensitiveChanges, err := s.getSensitiveJobChanges(ctx, &lockedJob, sourceConnectionId, mappings)
+		if err != nil {
+			return err
+		}
+		if len(sensitiveChanges) > 0 {
+			logger.Info(fmt.Sprintf("job change modifies %d sensitive column(s), holding change for approval", len(sensitiveChanges)))
+			changeRequest, err = s.createJobChangeRequest(ctx, dbtx, &lockedJob, lockedJob.ConnectionOptions, mappings, *userUuid)
+			return err
+		}
+
+		_, err = s.db.Q.UpdateJobMappings(ctx, dbtx, db_queries.UpdateJobMappingsParams{
+			ID:          job.ID,
+			Mappings:    mappings,
+			UpdatedByID: *userUuid,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to update job mappings: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	updatedJob, err := s.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{
+		Id: req.Msg.GetId(),
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve job after setting mappings: %w", err)
+	}
+	resp := &mgmtv1alpha1.SetJobMappingsResponse{
+		Job: updatedJob.Msg.GetJob(),
+	}
+	if changeRequest != nil {
+		resp.PendingChange = dtomaps.ToJobChangeRequestDto(changeRequest)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func replaceJobMappings(replace *mgmtv1alpha1.JobMappingsReplace) ([]*pg_models.JobMapping, error) {
+	mappings := make([]*pg_models.JobMapping, 0, len(replace.GetMappings()))
+	seen := map[string]struct{}{}
+	for _, dto := range replace.GetMappings() {
+		jm := &pg_models.JobMapping{}
+		if err := jm.FromDto(dto); err != nil {
+			return nil, err
+		}
+		key := getMappingKey(jm)
+		if _, ok := seen[key]; ok {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("column %s is mapped more than once", key))
+		}
+		seen[key] = struct{}{}
+		mappings = append(mappings, jm)
+	}
+	return mappings, nil
+}
+
+// Applies the patch to the current mappings. Upserted mappings keep the position of the mapping they replace, new mappings are appended
+func patchJobMappings(current []*pg_models.JobMapping, patch *mgmtv1alpha1.JobMappingsPatch) ([]*pg_models.JobMapping, error) {
+	mappings := make([]*pg_models.JobMapping, 0, len(current)+len(patch.GetUpserts()))
+	indexByKey := map[string]int{}
+	for _, jm := range current {
+		indexByKey[getMappingKey(jm)] = len(mappings)
+		mappings = append(mappings, jm)
+	}
+
+	upserted := map[string]struct{}{}
+	for _, dto := range patch.GetUpserts() {
+		jm := &pg_models.JobMapping{}
+		if err := jm.FromDto(dto); err != nil {
+			return nil, err
+		}
+		key := getMappingKey(jm)
+		if _, ok := upserted[key]; ok {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("column %s is upserted more than once", key))
+		}
+		upserted[key] = struct{}{}
+		if idx, ok := indexByKey[key]; ok {
+			mappings[idx] = jm
+			continue
+		}
+		indexByKey[key] = len(mappings)
+		mappings = append(mappings, jm)
+	}
+
+	removed := map[string]struct{}{}
+	for _, column := range patch.GetRemovals() {
+		key := classification.BuildColumnKey(column.GetSchema(), column.GetTable(), column.GetColumn())
+		if _, ok := upserted[key]; ok {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("column %s cannot be both upserted and removed", key))
+		}
+		if _, ok := indexByKey[key]; !ok {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("column %s is not mapped and cannot be removed", key))
+		}
+		removed[key] = struct{}{}
+	}
+	if len(removed) == 0 {
+		return mappings, nil
+	}
+
+	output := make([]*pg_models.JobMapping, 0, len(mappings)-len(removed))
+	for _, jm := range mappings {
+		if _, ok := removed[getMappingKey(jm)]; ok {
+			continue
+		}
+		output = append(output, jm)
+	}
+	return output, nil
+}
+
+// Every mapped column of a table must agree on where the table is written to, no two tables may be written to the same destination table,
+// and no two columns of a table may be written to the same destination column
+func verifyJobMappingDestinations(mappings []*pg_models.JobMapping) error {
+	tableDestinations := map[sql_manager.SchemaTable]*pg_models.JobMappingDestinationTable{}
+	columnDestinations := map[sql_manager.SchemaTable]map[string]string{}
+	for _, jm := range mappings {
+		// keyed by schema and table as the <schema>.<table> string is ambiguous when names contain a dot
+		table := sql_manager.SchemaTable{Schema: jm.Schema, Table: jm.Table}
+		destColumn := jm.Column
+		if jm.DestinationColumn != nil && jm.DestinationColumn.Name != nil {
+			destColumn = *jm.DestinationColumn.Name
+		}
+		if _, ok := columnDestinations[table]; !ok {
+			columnDestinations[table] = map[string]string{}
+		}
+		if other, ok := columnDestinations[table][destColumn]; ok && other != jm.Column {
+			return nucleuserrors.NewBadRequest(fmt.Sprintf("columns %s and %s of table %s can not both be written to destination column %s", other, jm.Column, table, destColumn))
+		}
+		columnDestinations[table][destColumn] = jm.Column
+
+		dest := jm.DestinationTable
+		if dest == nil {
+			dest = &pg_models.JobMappingDestinationTable{Schema: jm.Schema, Table: jm.Table}
+		}
+		current, ok := tableDestinations[table]
+		if !ok {
+			tableDestinations[table] = dest
+			continue
+		}
+		if current.Schema != dest.Schema || current.Table != dest.Table {
+			return nucleuserrors.NewBadRequest(fmt.Sprintf("all columns of table %s must have the same destination table", table))
+		}
+	}
+
+	destinationTables := map[sql_manager.SchemaTable]sql_manager.SchemaTable{}
+	for table, dest := range tableDestinations {
+		destTable := sql_manager.SchemaTable{Schema: dest.Schema, Table: dest.Table}
+		if other, ok := destinationTables[destTable]; ok {
+			return nucleuserrors.NewBadRequest(fmt.Sprintf("tables %s and %s can not both be written to destination table %s", other, table, destTable))
+		}
+		destinationTables[destTable] = table
+	}
+	return nil
+}
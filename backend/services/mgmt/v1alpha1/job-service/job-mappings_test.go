@@ -0,0 +1,191 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SetJobMappings_Patch(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	job.Mappings = []*pg_models.JobMapping{
+		mockPgJobMapping("id", mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH),
+		mockPgJobMapping("email", mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH),
+		mockPgJobMapping("name", mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH),
+	}
+
+	mockTx := new(nucleusdb.MockTx)
+	mockDbTransaction(m.DbtxMock, mockTx)
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	mockGetJob(m.UserAccountServiceMock, m.QuerierMock, job, []db_queries.NeosyncApiJobDestinationConnectionAssociation{})
+	m.QuerierMock.On("GetJobByIdForUpdate", mock.Anything, mockTx, job.ID).Return(job, nil)
+	m.QuerierMock.On("UpdateJobMappings", mock.Anything, mockTx, mock.MatchedBy(func(params db_queries.UpdateJobMappingsParams) bool {
+		if len(params.Mappings) != 3 {
+			return false
+		}
+		return params.Mappings[0].Column == "id" &&
+			params.Mappings[1].Column == "email" &&
+			params.Mappings[1].JobMappingTransformer.Source == int32(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL) &&
+			params.Mappings[2].Column == "age"
+	})).Return(job, nil)
+
+	resp, err := m.Service.SetJobMappings(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetJobMappingsRequest{
+		Id: nucleusdb.UUIDString(job.ID),
+		Mode: &mgmtv1alpha1.SetJobMappingsRequest_Patch{
+			Patch: &mgmtv1alpha1.JobMappingsPatch{
+				Upserts: []*mgmtv1alpha1.JobMapping{
+					mockPgJobMapping("email", mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL).ToDto(),
+					mockPgJobMapping("age", mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH).ToDto(),
+				},
+				Removals: []*mgmtv1alpha1.JobMappingColumn{
+					{Schema: "public", Table: "users", Column: "name"},
+				},
+			},
+		},
+	}))
+	require.NoError(t, err)
+	require.Nil(t, resp.Msg.PendingChange)
+}
+
+// A patch that commits between the initial read and the update must not be overwritten by this patch
+func Test_SetJobMappings_Patch_Concurrent(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	job.Mappings = []*pg_models.JobMapping{
+		mockPgJobMapping("id", mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH),
+	}
+	lockedJob := job
+	lockedJob.Mappings = []*pg_models.JobMapping{
+		mockPgJobMapping("id", mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH),
+		mockPgJobMapping("email", mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL),
+	}
+
+	mockTx := new(nucleusdb.MockTx)
+	mockDbTransaction(m.DbtxMock, mockTx)
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	mockGetJob(m.UserAccountServiceMock, m.QuerierMock, job, []db_queries.NeosyncApiJobDestinationConnectionAssociation{})
+	m.QuerierMock.On("GetJobByIdForUpdate", mock.Anything, mockTx, job.ID).Return(lockedJob, nil)
+	m.QuerierMock.On("UpdateJobMappings", mock.Anything, mockTx, mock.MatchedBy(func(params db_queries.UpdateJobMappingsParams) bool {
+		if len(params.Mappings) != 3 {
+			return false
+		}
+		return params.Mappings[0].Column == "id" &&
+			params.Mappings[1].Column == "email" &&
+			params.Mappings[2].Column == "age"
+	})).Return(lockedJob, nil)
+
+	_, err := m.Service.SetJobMappings(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetJobMappingsRequest{
+		Id: nucleusdb.UUIDString(job.ID),
+		Mode: &mgmtv1alpha1.SetJobMappingsRequest_Patch{
+			Patch: &mgmtv1alpha1.JobMappingsPatch{
+				Upserts: []*mgmtv1alpha1.JobMapping{
+					mockPgJobMapping("age", mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH).ToDto(),
+				},
+			},
+		},
+	}))
+	require.NoError(t, err)
+	mockTx.AssertCalled(t, "Commit", mock.Anything)
+}
+
+func Test_SetJobMappings_Patch_InvalidRemoval(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	mockTx := new(nucleusdb.MockTx)
+	mockDbTransaction(m.DbtxMock, mockTx)
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetJobByIdForUpdate", mock.Anything, mockTx, job.ID).Return(job, nil)
+
+	_, err := m.Service.SetJobMappings(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetJobMappingsRequest{
+		Id: nucleusdb.UUIDString(job.ID),
+		Mode: &mgmtv1alpha1.SetJobMappingsRequest_Patch{
+			Patch: &mgmtv1alpha1.JobMappingsPatch{
+				Removals: []*mgmtv1alpha1.JobMappingColumn{
+					{Schema: "public", Table: "users", Column: "name"},
+				},
+			},
+		},
+	}))
+	require.Error(t, err)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	m.QuerierMock.AssertNotCalled(t, "UpdateJobMappings", mock.Anything, mock.Anything, mock.Anything)
+	mockTx.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+func Test_replaceJobMappings_Duplicate(t *testing.T) {
+	_, err := replaceJobMappings(&mgmtv1alpha1.JobMappingsReplace{
+		Mappings: []*mgmtv1alpha1.JobMapping{
+			mockPgJobMapping("id", mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH).ToDto(),
+			mockPgJobMapping("id", mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_UUID).ToDto(),
+		},
+	})
+	require.Error(t, err)
+}
+
+func Test_patchJobMappings_UpsertAndRemoveSameColumn(t *testing.T) {
+	current := []*pg_models.JobMapping{
+		mockPgJobMapping("id", mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH),
+	}
+	_, err := patchJobMappings(current, &mgmtv1alpha1.JobMappingsPatch{
+		Upserts: []*mgmtv1alpha1.JobMapping{
+			mockPgJobMapping("id", mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_UUID).ToDto(),
+		},
+		Removals: []*mgmtv1alpha1.JobMappingColumn{
+			{Schema: "public", Table: "users", Column: "id"},
+		},
+	})
+	require.Error(t, err)
+}
+
+func Test_verifyJobMappingDestinations(t *testing.T) {
+	remapped := &pg_models.JobMappingDestinationTable{Schema: "masked", Table: "users_v2"}
+
+	id := mockPgJobMapping("id", mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH)
+	id.DestinationTable = remapped
+	email := mockPgJobMapping("email", mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH)
+	email.DestinationTable = remapped
+	require.NoError(t, verifyJobMappingDestinations([]*pg_models.JobMapping{id, email}))
+
+	email.DestinationTable = nil
+	err := verifyJobMappingDestinations([]*pg_models.JobMapping{id, email})
+	require.Error(t, err)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	other := &pg_models.JobMapping{Schema: "masked", Table: "users_v2", Column: "id"}
+	err = verifyJobMappingDestinations([]*pg_models.JobMapping{id, other})
+	require.Error(t, err)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	emailName := "id"
+	email.DestinationTable = remapped
+	email.DestinationColumn = &pg_models.JobMappingDestinationColumn{Name: &emailName}
+	err = verifyJobMappingDestinations([]*pg_models.JobMapping{id, email})
+	require.Error(t, err)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func mockPgJobMapping(column string, source mgmtv1alpha1.TransformerSource) *pg_models.JobMapping {
+	return &pg_models.JobMapping{
+		Schema: "public",
+		Table:  "users",
+		Column: column,
+		JobMappingTransformer: &pg_models.JobMappingTransformerModel{
+			Source: int32(source),
+			Config: &pg_models.TransformerConfigs{},
+		},
+	}
+}
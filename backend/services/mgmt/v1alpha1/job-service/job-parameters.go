@@ -0,0 +1,236 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"connectrpc.com/connect"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+)
+
+const (
+	jobParameterDateLayout = "2006-01-02"
+	// the longest string value, in characters, that a job parameter may have
+	maxJobParameterStringLength = 1024
+)
+
+func (s *Service) SetJobParameters(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.SetJobParametersRequest],
+) (*connect.Response[mgmtv1alpha1.SetJobParametersResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("jobId", req.Msg.Id)
+
+	job, err := s.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{
+		Id: req.Msg.Id,
+	}))
+	if err != nil {
+		logger.Error(fmt.Errorf("unable to retrieve job: %w", err).Error())
+		return nil, err
+	}
+	_, err = s.verifyUserInAccount(ctx, job.Msg.Job.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	parameters, err := toJobParameters(req.Msg.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	jobUuid, err := nucleusdb.ToUuid(req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	userUuid, err := s.getUserUuid(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.db.Q.SetJobParameters(ctx, s.db.Db, db_queries.SetJobParametersParams{
+		ID:          jobUuid,
+		Parameters:  parameters,
+		UpdatedByID: *userUuid,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	updatedJob, err := s.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{
+		Id: req.Msg.Id,
+	}))
+	if err != nil {
+		logger.Error(fmt.Errorf("unable to retrieve job: %w", err).Error())
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.SetJobParametersResponse{Job: updatedJob.Msg.Job}), nil
+}
+
+func (s *Service) GetJobRunParameters(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetJobRunParametersRequest],
+) (*connect.Response[mgmtv1alpha1.GetJobRunParametersResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("jobId", req.Msg.GetJobId(), "jobRunId", req.Msg.GetJobRunId())
+	jobUuid, err := nucleusdb.ToUuid(req.Msg.GetJobId())
+	if err != nil {
+		return nil, err
+	}
+	job, err := s.db.Q.GetJobById(ctx, s.db.Db, jobUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return nil, nucleuserrors.NewNotFound("unable to find job by id")
+	}
+	_, err = s.verifyUserInAccount(ctx, nucleusdb.UUIDString(job.AccountID))
+	if err != nil {
+		return nil, err
+	}
+
+	runRequest, err := s.claimJobRunRequest(ctx, job.ID, req.Msg.GetJobRunId())
+	if err != nil {
+		return nil, err
+	}
+	// runs that were not explicitly requested, such as those fired by the job's schedule, use the default values
+	values := []*mgmtv1alpha1.JobParameterValue{}
+	if runRequest != nil {
+		for _, value := range runRequest.Parameters {
+			values = append(values, value.ToDto())
+		}
+	}
+	resolved, err := resolveJobRunParameters(job.Parameters, values)
+	if err != nil {
+		logger.Error(fmt.Errorf("unable to resolve job run parameters: %w", err).Error())
+		return nil, err
+	}
+
+	dtos := make([]*mgmtv1alpha1.JobParameterValue, 0, len(resolved))
+	for _, value := range resolved {
+		dtos = append(dtos, value.ToDto())
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GetJobRunParametersResponse{
+		Parameters: dtos,
+	}), nil
+}
+
+// Validates the job parameters and converts them to their db models.
+// Parameter names must be unique and default values must be valid for the parameter's type
+func toJobParameters(dtos []*mgmtv1alpha1.JobParameter) ([]*pg_models.JobParameter, error) {
+	parameters := []*pg_models.JobParameter{}
+	seen := map[string]struct{}{}
+	for _, dto := range dtos {
+		if _, ok := seen[dto.GetName()]; ok {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("job parameters must have unique names: %s", dto.GetName()))
+		}
+		seen[dto.GetName()] = struct{}{}
+		if dto.DefaultValue != nil {
+			if err := verifyJobParameterValue(dto.GetType(), dto.GetDefaultValue()); err != nil {
+				return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("invalid default value for job parameter %s: %s", dto.GetName(), err.Error()))
+			}
+		}
+		parameter := &pg_models.JobParameter{}
+		parameter.FromDto(dto)
+		parameters = append(parameters, parameter)
+	}
+	return parameters, nil
+}
+
+// Resolves the values of a job run's parameters.
+// Every provided value must belong to one of the job's parameters and be valid for its type.
+// Parameters that were not provided fall back to their default value and are required if they have none.
+func resolveJobRunParameters(
+	parameters []*pg_models.JobParameter,
+	values []*mgmtv1alpha1.JobParameterValue,
+) ([]*pg_models.JobParameterValue, error) {
+	parameterMap := map[string]*pg_models.JobParameter{}
+	for _, parameter := range parameters {
+		parameterMap[parameter.Name] = parameter
+	}
+	valueMap := map[string]string{}
+	for _, value := range values {
+		parameter, ok := parameterMap[value.GetName()]
+		if !ok {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("job does not have a parameter named %s", value.GetName()))
+		}
+		if _, ok := valueMap[value.GetName()]; ok {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("job parameter %s was provided more than once", value.GetName()))
+		}
+		if err := verifyJobParameterValue(mgmtv1alpha1.JobParameterType(parameter.Type), value.GetValue()); err != nil {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("invalid value for job parameter %s: %s", value.GetName(), err.Error()))
+		}
+		valueMap[value.GetName()] = value.GetValue()
+	}
+
+	resolved := []*pg_models.JobParameterValue{}
+	for _, parameter := range parameters {
+		value, ok := valueMap[parameter.Name]
+		if !ok {
+			if parameter.DefaultValue == nil {
+				return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("job parameter %s is required as it has no default value", parameter.Name))
+			}
+			value = *parameter.DefaultValue
+		}
+		resolved = append(resolved, &pg_models.JobParameterValue{Name: parameter.Name, Value: value})
+	}
+	return resolved, nil
+}
+
+func verifyJobParameterValue(parameterType mgmtv1alpha1.JobParameterType, value string) error {
+	switch parameterType {
+	case mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_STRING:
+		if !utf8.ValidString(value) {
+			return fmt.Errorf("%q is not a valid utf-8 string", value)
+		}
+		if utf8.RuneCountInString(value) > maxJobParameterStringLength {
+			return fmt.Errorf("string is longer than %d characters", maxJobParameterStringLength)
+		}
+		if strings.ContainsFunc(value, unicode.IsControl) {
+			return fmt.Errorf("%q contains control characters", value)
+		}
+		return nil
+	case mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_INTEGER:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("%q is not an integer", value)
+		}
+		return nil
+	case mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_BOOLEAN:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("%q is not a boolean", value)
+		}
+		return nil
+	case mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_DATE:
+		if _, err := time.Parse(jobParameterDateLayout, value); err != nil {
+			return fmt.Errorf("%q is not a date in the YYYY-MM-DD format", value)
+		}
+		return nil
+	case mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_DATE_RANGE:
+		start, end, ok := strings.Cut(value, "/")
+		if !ok {
+			return fmt.Errorf("%q is not a date range in the YYYY-MM-DD/YYYY-MM-DD format", value)
+		}
+		startDate, err := time.Parse(jobParameterDateLayout, start)
+		if err != nil {
+			return fmt.Errorf("%q is not a date range in the YYYY-MM-DD/YYYY-MM-DD format", value)
+		}
+		endDate, err := time.Parse(jobParameterDateLayout, end)
+		if err != nil {
+			return fmt.Errorf("%q is not a date range in the YYYY-MM-DD/YYYY-MM-DD format", value)
+		}
+		if endDate.Before(startDate) {
+			return fmt.Errorf("the end of date range %q is before its start", value)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported job parameter type: %s", parameterType.String())
+	}
+}
@@ -0,0 +1,248 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	temporalclient "go.temporal.io/sdk/client"
+)
+
+// SetJobParameters
+func Test_SetJobParameters(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	destConn := getConnectionMock(mockAccountId, "test-1")
+	destConnAssociation := mockJobDestConnAssociation(job.ID, destConn.ID, &pg_models.JobDestinationOptions{})
+	jobId := nucleusdb.UUIDString(job.ID)
+
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	mockGetJob(m.UserAccountServiceMock, m.QuerierMock, job, []db_queries.NeosyncApiJobDestinationConnectionAssociation{destConnAssociation})
+
+	m.QuerierMock.On("SetJobParameters", mock.Anything, mock.Anything, db_queries.SetJobParametersParams{
+		ID: job.ID,
+		Parameters: []*pg_models.JobParameter{
+			{Name: "tenant_id", Type: int32(mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_STRING), Description: "The tenant to sync"},
+		},
+		UpdatedByID: userUuid,
+	}).Return(job, nil)
+
+	resp, err := m.Service.SetJobParameters(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetJobParametersRequest{
+		Id: jobId,
+		Parameters: []*mgmtv1alpha1.JobParameter{
+			{Name: "tenant_id", Type: mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_STRING, Description: "The tenant to sync"},
+		},
+	}))
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+}
+
+// GetJobRunParameters
+func Test_GetJobRunParameters_ClaimedRunRequest(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	job.Parameters = mockJobParameters()
+	jobRunId := uuid.NewString()
+	runRequest := mockJobRunRequest(job.ID, mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_BATCH, jobRunId)
+	runRequest.Parameters = []*pg_models.JobParameterValue{{Name: "tenant_id", Value: "acme"}}
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetJobRunRequestByJobRun", mock.Anything, mock.Anything, pgtype.Text{String: jobRunId, Valid: true}).Return(runRequest, nil)
+
+	resp, err := m.Service.GetJobRunParameters(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobRunParametersRequest{
+		JobId:    nucleusdb.UUIDString(job.ID),
+		JobRunId: jobRunId,
+	}))
+
+	require.NoError(t, err)
+	require.Equal(t, []*mgmtv1alpha1.JobParameterValue{
+		{Name: "tenant_id", Value: "acme"},
+		{Name: "date_range", Value: "2024-01-01/2024-01-31"},
+	}, resp.Msg.GetParameters())
+}
+
+func Test_GetJobRunParameters_NoRunRequest(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	job.Parameters = mockJobParameters()
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetJobRunRequestByJobRun", mock.Anything, mock.Anything, mock.Anything).Return(db_queries.NeosyncApiJobRunRequest{}, sql.ErrNoRows)
+	m.QuerierMock.On("ClaimJobRunRequest", mock.Anything, mock.Anything, mock.Anything).Return(db_queries.NeosyncApiJobRunRequest{}, sql.ErrNoRows)
+
+	resp, err := m.Service.GetJobRunParameters(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobRunParametersRequest{
+		JobId:    nucleusdb.UUIDString(job.ID),
+		JobRunId: uuid.NewString(),
+	}))
+
+	require.Error(t, err)
+	require.Nil(t, resp)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+// CreateJobRun
+func Test_CreateJobRun_Parameters(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	mockHandle := new(MockScheduleHandle)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	job.Parameters = mockJobParameters()
+
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.TemporalWfManagerMock.On("GetScheduleHandleClientByAccount", mock.Anything, mockAccountId, nucleusdb.UUIDString(job.ID), mock.Anything).Return(mockHandle, nil)
+	m.QuerierMock.On("CreateJobRunRequest", mock.Anything, mock.Anything, db_queries.CreateJobRunRequestParams{
+		JobID:       job.ID,
+		Priority:    int16(mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_UNSPECIFIED),
+		CreatedByID: userUuid,
+		Parameters: []*pg_models.JobParameterValue{
+			{Name: "tenant_id", Value: "acme"},
+			{Name: "date_range", Value: "2024-01-01/2024-01-31"},
+		},
+	}).Return(mockJobRunRequest(job.ID, mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_BATCH, ""), nil)
+	mockHandle.On("Trigger", mock.Anything, temporalclient.ScheduleTriggerOptions{}).Return(nil)
+
+	resp, err := m.Service.CreateJobRun(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateJobRunRequest{
+		JobId:      nucleusdb.UUIDString(job.ID),
+		Parameters: []*mgmtv1alpha1.JobParameterValue{{Name: "tenant_id", Value: "acme"}},
+	}))
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+}
+
+func Test_CreateJobRun_InvalidParameters(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	job.Parameters = mockJobParameters()
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+
+	resp, err := m.Service.CreateJobRun(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateJobRunRequest{
+		JobId: nucleusdb.UUIDString(job.ID),
+		Parameters: []*mgmtv1alpha1.JobParameterValue{
+			{Name: "tenant_id", Value: "acme"},
+			{Name: "date_range", Value: "2024-01-31/2024-01-01"},
+		},
+	}))
+
+	require.Error(t, err)
+	require.Nil(t, resp)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	m.QuerierMock.AssertNotCalled(t, "CreateJobRunRequest", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_toJobParameters(t *testing.T) {
+	output, err := toJobParameters(nil)
+	require.NoError(t, err)
+	require.Empty(t, output)
+
+	output, err = toJobParameters([]*mgmtv1alpha1.JobParameter{
+		{Name: "tenant_id", Type: mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_STRING},
+		{Name: "limit", Type: mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_INTEGER, DefaultValue: ptr("100")},
+	})
+	require.NoError(t, err)
+	require.Len(t, output, 2)
+	require.Equal(t, "100", *output[1].DefaultValue)
+
+	_, err = toJobParameters([]*mgmtv1alpha1.JobParameter{
+		{Name: "tenant_id", Type: mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_STRING},
+		{Name: "tenant_id", Type: mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_INTEGER},
+	})
+	require.Error(t, err)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	_, err = toJobParameters([]*mgmtv1alpha1.JobParameter{
+		{Name: "limit", Type: mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_INTEGER, DefaultValue: ptr("ten")},
+	})
+	require.Error(t, err)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func Test_resolveJobRunParameters(t *testing.T) {
+	parameters := mockJobParameters()
+
+	output, err := resolveJobRunParameters(parameters, []*mgmtv1alpha1.JobParameterValue{
+		{Name: "date_range", Value: "2024-02-01/2024-02-29"},
+		{Name: "tenant_id", Value: "acme"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*pg_models.JobParameterValue{
+		{Name: "tenant_id", Value: "acme"},
+		{Name: "date_range", Value: "2024-02-01/2024-02-29"},
+	}, output)
+
+	output, err = resolveJobRunParameters(nil, nil)
+	require.NoError(t, err)
+	require.Empty(t, output)
+
+	invalid := [][]*mgmtv1alpha1.JobParameterValue{
+		{},
+		{{Name: "tenant_id", Value: "acme"}, {Name: "unknown", Value: "foo"}},
+		{{Name: "tenant_id", Value: "acme"}, {Name: "tenant_id", Value: "other"}},
+		{{Name: "tenant_id", Value: "acme"}, {Name: "date_range", Value: "2024-02-01"}},
+	}
+	for _, values := range invalid {
+		_, err := resolveJobRunParameters(parameters, values)
+		require.Error(t, err)
+		require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	}
+}
+
+func Test_verifyJobParameterValue(t *testing.T) {
+	tests := []struct {
+		parameterType mgmtv1alpha1.JobParameterType
+		value         string
+		valid         bool
+	}{
+		{mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_STRING, "", true},
+		{mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_STRING, `o'neil\`, true},
+		{mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_STRING, "acme\x00", false},
+		{mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_STRING, "acme\n", false},
+		{mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_STRING, "\xff", false},
+		{mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_STRING, strings.Repeat("a", 1025), false},
+		{mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_INTEGER, "-42", true},
+		{mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_INTEGER, "4.2", false},
+		{mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_BOOLEAN, "true", true},
+		{mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_BOOLEAN, "yes", false},
+		{mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_DATE, "2024-02-29", true},
+		{mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_DATE, "2023-02-29", false},
+		{mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_DATE_RANGE, "2024-01-01/2024-01-01", true},
+		{mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_DATE_RANGE, "2024-01-02/2024-01-01", false},
+		{mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_DATE_RANGE, "2024-01-01", false},
+		{mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_UNSPECIFIED, "foo", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.parameterType.String()+"_"+tt.value, func(t *testing.T) {
+			err := verifyJobParameterValue(tt.parameterType, tt.value)
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func mockJobParameters() []*pg_models.JobParameter {
+	return []*pg_models.JobParameter{
+		{Name: "tenant_id", Type: int32(mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_STRING)},
+		{Name: "date_range", Type: int32(mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_DATE_RANGE), DefaultValue: ptr("2024-01-01/2024-01-31")},
+	}
+}
@@ -0,0 +1,514 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/classification"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/idempotency"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+)
+
+const (
+	sourceConnectionIdTemplateVariable      = "source_connection_id"
+	destinationConnectionIdTemplateVariable = "destination_connection_id"
+	schemasTemplateVariable                 = "schemas"
+	cronScheduleTemplateVariable            = "cron_schedule"
+	rowCountTemplateVariable                = "row_count"
+)
+
+type jobTemplate struct {
+	template *mgmtv1alpha1.JobTemplate
+	// builds the job from the resolved template variables. The account, name and initial run are set by the caller
+	build func(ctx context.Context, s *Service, logger *slog.Logger, variables map[string]string) (*mgmtv1alpha1.CreateJobRequest, error)
+}
+
+var (
+	defaultTemplateSchemas  = ""
+	defaultTemplateCron     = "0 2 * * *"
+	defaultTemplateRowCount = "10"
+
+	templateIntegerDataTypes = []string{"smallint", "integer", "int", "bigint", "tinyint", "mediumint", "int2", "int4", "int8"}
+
+	schemasVariable = &mgmtv1alpha1.JobTemplateVariable{
+		Name:         schemasTemplateVariable,
+		Description:  "A comma separated list of the schemas to include. All schemas are included when empty",
+		DefaultValue: &defaultTemplateSchemas,
+	}
+
+	jobTemplates = []*jobTemplate{
+		{
+			template: &mgmtv1alpha1.JobTemplate{
+				Id:          "mask-postgres-to-staging",
+				Name:        "Mask a Postgres database into staging",
+				Description: "Copies a Postgres database into a staging Postgres database. Columns that look like personal information are anonymized and every other column is passed through.",
+				Variables: []*mgmtv1alpha1.JobTemplateVariable{
+					{Name: sourceConnectionIdTemplateVariable, Description: "The Postgres connection to copy the data from"},
+					{Name: destinationConnectionIdTemplateVariable, Description: "The staging Postgres connection. Its tables are truncated before the data is inserted"},
+					schemasVariable,
+				},
+			},
+			build: buildMaskPostgresToStagingJob,
+		},
+		{
+			template: &mgmtv1alpha1.JobTemplate{
+				Id:          "nightly-s3-archive",
+				Name:        "Nightly S3 archive",
+				Description: "Archives a Postgres or Mysql database into AWS S3 on a schedule.",
+				Variables: []*mgmtv1alpha1.JobTemplateVariable{
+					{Name: sourceConnectionIdTemplateVariable, Description: "The Postgres or Mysql connection to archive"},
+					{Name: destinationConnectionIdTemplateVariable, Description: "The AWS S3 connection to write the archive to"},
+					{Name: cronScheduleTemplateVariable, Description: "The schedule of the archive. Defaults to every night", DefaultValue: &defaultTemplateCron},
+					schemasVariable,
+				},
+			},
+			build: buildNightlyS3ArchiveJob,
+		},
+		{
+			template: &mgmtv1alpha1.JobTemplate{
+				Id:          "seed-synthetic-data",
+				Name:        "Seed an empty database with synthetic data",
+				Description: "Generates synthetic rows for every table of an existing, empty Postgres or Mysql database based on the names and types of its columns.",
+				Variables: []*mgmtv1alpha1.JobTemplateVariable{
+					{Name: destinationConnectionIdTemplateVariable, Description: "The Postgres or Mysql connection to seed. Its tables must already exist"},
+					{Name: rowCountTemplateVariable, Description: "The number of rows to generate for each table", DefaultValue: &defaultTemplateRowCount},
+					schemasVariable,
+				},
+			},
+			build: buildSeedSyntheticDataJob,
+		},
+	}
+)
+
+func (s *Service) GetJobTemplates(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetJobTemplatesRequest],
+) (*connect.Response[mgmtv1alpha1.GetJobTemplatesResponse], error) {
+	templates := make([]*mgmtv1alpha1.JobTemplate, 0, len(jobTemplates))
+	for _, jt := range jobTemplates {
+		templates = append(templates, jt.template)
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GetJobTemplatesResponse{
+		Templates: templates,
+	}), nil
+}
+
+func (s *Service) CreateJobFromTemplate(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.CreateJobFromTemplateRequest],
+) (*connect.Response[mgmtv1alpha1.CreateJobFromTemplateResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("accountId", req.Msg.GetAccountId(), "templateId", req.Msg.GetTemplateId())
+
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	idx := slices.IndexFunc(jobTemplates, func(jt *jobTemplate) bool {
+		return jt.template.GetId() == req.Msg.GetTemplateId()
+	})
+	if idx == -1 {
+		return nil, nucleuserrors.NewNotFound(fmt.Sprintf("unable to find job template: %s", req.Msg.GetTemplateId()))
+	}
+	jt := jobTemplates[idx]
+
+	variables, err := resolveJobTemplateVariables(jt.template.GetVariables(), req.Msg.GetVariables())
+	if err != nil {
+		return nil, err
+	}
+	// the templates read the schema of their connections, so they are verified before the job itself is
+	for _, name := range []string{sourceConnectionIdTemplateVariable, destinationConnectionIdTemplateVariable} {
+		if connectionId, ok := variables[name]; ok {
+			if err := s.verifyConnectionInAccount(ctx, connectionId, req.Msg.GetAccountId()); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return idempotency.Do(ctx, s.db, req.Header(), *accountUuid, "CreateJobFromTemplate", req.Msg, func() (*connect.Response[mgmtv1alpha1.CreateJobFromTemplateResponse], error) {
+		createReq, err := jt.build(ctx, s, logger, variables)
+		if err != nil {
+			return nil, err
+		}
+		createReq.AccountId = req.Msg.GetAccountId()
+		createReq.JobName = req.Msg.GetJobName()
+		createReq.InitiateJobRun = req.Msg.GetInitiateJobRun()
+
+		resp, err := s.createJob(ctx, connect.NewRequest(createReq), accountUuid)
+		if err != nil {
+			return nil, err
+		}
+		return connect.NewResponse(&mgmtv1alpha1.CreateJobFromTemplateResponse{
+			Job: resp.Msg.GetJob(),
+		}), nil
+	})
+}
+
+// Returns the value of every template variable, falling back to the default values of the variables that were not provided
+func resolveJobTemplateVariables(
+	templateVariables []*mgmtv1alpha1.JobTemplateVariable,
+	values []*mgmtv1alpha1.JobTemplateVariableValue,
+) (map[string]string, error) {
+	provided := map[string]string{}
+	for _, value := range values {
+		if _, ok := provided[value.GetName()]; ok {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("template variable was provided more than once: %s", value.GetName()))
+		}
+		provided[value.GetName()] = value.GetValue()
+	}
+
+	resolved := map[string]string{}
+	for _, variable := range templateVariables {
+		value, ok := provided[variable.GetName()]
+		if ok {
+			delete(provided, variable.GetName())
+		} else if variable.DefaultValue != nil {
+			value = variable.GetDefaultValue()
+		} else {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("missing required template variable: %s", variable.GetName()))
+		}
+		resolved[variable.GetName()] = value
+	}
+	for name := range provided {
+		return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("unknown template variable: %s", name))
+	}
+	return resolved, nil
+}
+
+func buildMaskPostgresToStagingJob(
+	ctx context.Context,
+	s *Service,
+	logger *slog.Logger,
+	variables map[string]string,
+) (*mgmtv1alpha1.CreateJobRequest, error) {
+	sourceId := variables[sourceConnectionIdTemplateVariable]
+	destinationId := variables[destinationConnectionIdTemplateVariable]
+	if err := s.verifyTemplateConnectionType(ctx, destinationId, isPostgresConnection); err != nil {
+		return nil, err
+	}
+	source, columns, err := s.getTemplateConnectionColumns(ctx, logger, sourceId, variables[schemasTemplateVariable])
+	if err != nil {
+		return nil, err
+	}
+	if !isPostgresConnection(source) {
+		return nil, nucleuserrors.NewBadRequest("source connection must be a postgres connection")
+	}
+
+	mappings := make([]*mgmtv1alpha1.JobMapping, 0, len(columns))
+	for _, column := range columns {
+		transformer := &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH,
+			Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_PassthroughConfig{PassthroughConfig: &mgmtv1alpha1.Passthrough{}}},
+		}
+		if column.GeneratedType == nil && isTemplateStringColumn(column) {
+			if masked := classification.RecommendTransformer(column.ColumnName, false); masked != nil {
+				transformer = masked
+			}
+		}
+		mappings = append(mappings, toTemplateJobMapping(column, transformer))
+	}
+
+	return &mgmtv1alpha1.CreateJobRequest{
+		Mappings: mappings,
+		Source: &mgmtv1alpha1.JobSource{
+			Options: &mgmtv1alpha1.JobSourceOptions{
+				Config: &mgmtv1alpha1.JobSourceOptions_Postgres{
+					Postgres: &mgmtv1alpha1.PostgresSourceConnectionOptions{ConnectionId: sourceId},
+				},
+			},
+		},
+		Destinations: []*mgmtv1alpha1.CreateJobDestination{
+			{
+				ConnectionId: destinationId,
+				Options: &mgmtv1alpha1.JobDestinationOptions{
+					Config: &mgmtv1alpha1.JobDestinationOptions_PostgresOptions{
+						PostgresOptions: &mgmtv1alpha1.PostgresDestinationConnectionOptions{
+							TruncateTable: &mgmtv1alpha1.PostgresTruncateTableConfig{TruncateBeforeInsert: true, Cascade: true},
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func buildNightlyS3ArchiveJob(
+	ctx context.Context,
+	s *Service,
+	logger *slog.Logger,
+	variables map[string]string,
+) (*mgmtv1alpha1.CreateJobRequest, error) {
+	sourceId := variables[sourceConnectionIdTemplateVariable]
+	destinationId := variables[destinationConnectionIdTemplateVariable]
+	if err := s.verifyTemplateConnectionType(ctx, destinationId, func(connection *mgmtv1alpha1.Connection) bool {
+		return connection.GetConnectionConfig().GetAwsS3Config() != nil
+	}); err != nil {
+		return nil, err
+	}
+	source, columns, err := s.getTemplateConnectionColumns(ctx, logger, sourceId, variables[schemasTemplateVariable])
+	if err != nil {
+		return nil, err
+	}
+
+	sourceOptions := &mgmtv1alpha1.JobSourceOptions{}
+	switch {
+	case isPostgresConnection(source):
+		sourceOptions.Config = &mgmtv1alpha1.JobSourceOptions_Postgres{
+			Postgres: &mgmtv1alpha1.PostgresSourceConnectionOptions{ConnectionId: sourceId},
+		}
+	case source.GetConnectionConfig().GetMysqlConfig() != nil:
+		sourceOptions.Config = &mgmtv1alpha1.JobSourceOptions_Mysql{
+			Mysql: &mgmtv1alpha1.MysqlSourceConnectionOptions{ConnectionId: sourceId},
+		}
+	default:
+		return nil, nucleuserrors.NewBadRequest("source connection must be a postgres or mysql connection")
+	}
+
+	mappings := make([]*mgmtv1alpha1.JobMapping, 0, len(columns))
+	for _, column := range columns {
+		mappings = append(mappings, toTemplateJobMapping(column, &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH,
+			Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_PassthroughConfig{PassthroughConfig: &mgmtv1alpha1.Passthrough{}}},
+		}))
+	}
+
+	cronSchedule := variables[cronScheduleTemplateVariable]
+	return &mgmtv1alpha1.CreateJobRequest{
+		CronSchedule: &cronSchedule,
+		Mappings:     mappings,
+		Source:       &mgmtv1alpha1.JobSource{Options: sourceOptions},
+		Destinations: []*mgmtv1alpha1.CreateJobDestination{
+			{
+				ConnectionId: destinationId,
+				Options: &mgmtv1alpha1.JobDestinationOptions{
+					Config: &mgmtv1alpha1.JobDestinationOptions_AwsS3Options{AwsS3Options: &mgmtv1alpha1.AwsS3DestinationConnectionOptions{}},
+				},
+			},
+		},
+	}, nil
+}
+
+func buildSeedSyntheticDataJob(
+	ctx context.Context,
+	s *Service,
+	logger *slog.Logger,
+	variables map[string]string,
+) (*mgmtv1alpha1.CreateJobRequest, error) {
+	destinationId := variables[destinationConnectionIdTemplateVariable]
+	rowCount, err := strconv.ParseInt(variables[rowCountTemplateVariable], 10, 64)
+	if err != nil || rowCount < 1 {
+		return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("%s must be a positive number", rowCountTemplateVariable))
+	}
+	destination, columns, err := s.getTemplateConnectionColumns(ctx, logger, destinationId, variables[schemasTemplateVariable])
+	if err != nil {
+		return nil, err
+	}
+
+	destinationOptions := &mgmtv1alpha1.JobDestinationOptions{}
+	switch {
+	case isPostgresConnection(destination):
+		destinationOptions.Config = &mgmtv1alpha1.JobDestinationOptions_PostgresOptions{
+			PostgresOptions: &mgmtv1alpha1.PostgresDestinationConnectionOptions{},
+		}
+	case destination.GetConnectionConfig().GetMysqlConfig() != nil:
+		destinationOptions.Config = &mgmtv1alpha1.JobDestinationOptions_MysqlOptions{
+			MysqlOptions: &mgmtv1alpha1.MysqlDestinationConnectionOptions{},
+		}
+	default:
+		return nil, nucleuserrors.NewBadRequest("destination connection must be a postgres or mysql connection")
+	}
+
+	mappings := make([]*mgmtv1alpha1.JobMapping, 0, len(columns))
+	schemaOptions := []*mgmtv1alpha1.GenerateSourceSchemaOption{}
+	for _, column := range columns {
+		transformer, err := getSyntheticColumnTransformer(column)
+		if err != nil {
+			return nil, err
+		}
+		mappings = append(mappings, toTemplateJobMapping(column, transformer))
+
+		schemaIdx := slices.IndexFunc(schemaOptions, func(opt *mgmtv1alpha1.GenerateSourceSchemaOption) bool {
+			return opt.GetSchema() == column.TableSchema
+		})
+		if schemaIdx == -1 {
+			schemaOptions = append(schemaOptions, &mgmtv1alpha1.GenerateSourceSchemaOption{Schema: column.TableSchema})
+			schemaIdx = len(schemaOptions) - 1
+		}
+		schemaOpt := schemaOptions[schemaIdx]
+		if !slices.ContainsFunc(schemaOpt.Tables, func(opt *mgmtv1alpha1.GenerateSourceTableOption) bool {
+			return opt.GetTable() == column.TableName
+		}) {
+			schemaOpt.Tables = append(schemaOpt.Tables, &mgmtv1alpha1.GenerateSourceTableOption{Table: column.TableName, RowCount: rowCount})
+		}
+	}
+
+	return &mgmtv1alpha1.CreateJobRequest{
+		Mappings: mappings,
+		Source: &mgmtv1alpha1.JobSource{
+			Options: &mgmtv1alpha1.JobSourceOptions{
+				Config: &mgmtv1alpha1.JobSourceOptions_Generate{
+					Generate: &mgmtv1alpha1.GenerateSourceOptions{
+						Schemas:              schemaOptions,
+						FkSourceConnectionId: &destinationId,
+					},
+				},
+			},
+		},
+		Destinations: []*mgmtv1alpha1.CreateJobDestination{
+			{ConnectionId: destinationId, Options: destinationOptions},
+		},
+	}, nil
+}
+
+func (s *Service) verifyTemplateConnectionType(
+	ctx context.Context,
+	connectionId string,
+	isValid func(connection *mgmtv1alpha1.Connection) bool,
+) error {
+	connResp, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{Id: connectionId}))
+	if err != nil {
+		return err
+	}
+	if !isValid(connResp.Msg.GetConnection()) {
+		return nucleuserrors.NewBadRequest(fmt.Sprintf("connection %s is not supported by this template", connectionId))
+	}
+	return nil
+}
+
+// Returns the connection along with the columns of its tables, limited to the comma separated schemas when provided
+func (s *Service) getTemplateConnectionColumns(
+	ctx context.Context,
+	logger *slog.Logger,
+	connectionId string,
+	schemas string,
+) (*mgmtv1alpha1.Connection, []*sql_manager.DatabaseSchemaRow, error) {
+	connResp, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{Id: connectionId}))
+	if err != nil {
+		return nil, nil, err
+	}
+	connection := connResp.Msg.GetConnection()
+	if connection.GetConnectionConfig().GetPgConfig() == nil && connection.GetConnectionConfig().GetMysqlConfig() == nil {
+		return nil, nil, nucleuserrors.NewBadRequest(fmt.Sprintf("connection %s is not supported by this template", connectionId))
+	}
+
+	connectionTimeout := 5
+	db, err := s.sqlmanager.NewSqlDb(ctx, logger, connection, &connectionTimeout)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer db.Db.Close()
+
+	rows, err := db.Db.GetDatabaseSchema(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to retrieve database schema: %w", err)
+	}
+
+	includedSchemas := []string{}
+	for _, schema := range strings.Split(schemas, ",") {
+		if schema = strings.TrimSpace(schema); schema != "" {
+			includedSchemas = append(includedSchemas, schema)
+		}
+	}
+	columns := []*sql_manager.DatabaseSchemaRow{}
+	for _, row := range rows {
+		if row.TableType != "" && row.TableType != sql_manager.BaseTableType {
+			continue
+		}
+		if len(includedSchemas) > 0 && !slices.Contains(includedSchemas, row.TableSchema) {
+			continue
+		}
+		columns = append(columns, row)
+	}
+	if len(columns) == 0 {
+		return nil, nil, nucleuserrors.NewBadRequest(fmt.Sprintf("connection %s does not have any tables to include", connectionId))
+	}
+	return connection, columns, nil
+}
+
+func isPostgresConnection(connection *mgmtv1alpha1.Connection) bool {
+	return connection.GetConnectionConfig().GetPgConfig() != nil
+}
+
+func toTemplateJobMapping(column *sql_manager.DatabaseSchemaRow, transformer *mgmtv1alpha1.JobMappingTransformer) *mgmtv1alpha1.JobMapping {
+	return &mgmtv1alpha1.JobMapping{
+		Schema:      column.TableSchema,
+		Table:       column.TableName,
+		Column:      column.ColumnName,
+		Transformer: transformer,
+	}
+}
+
+func isTemplateStringColumn(column *sql_manager.DatabaseSchemaRow) bool {
+	dataType := strings.ToLower(column.DataType)
+	return strings.Contains(dataType, "char") || strings.Contains(dataType, "text")
+}
+
+// Picks a generator for a column of an empty table based on its name and data type
+func getSyntheticColumnTransformer(column *sql_manager.DatabaseSchemaRow) (*mgmtv1alpha1.JobMappingTransformer, error) {
+	if column.ColumnDefault != "" || column.GeneratedType != nil {
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT,
+			Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_GenerateDefaultConfig{GenerateDefaultConfig: &mgmtv1alpha1.GenerateDefault{}}},
+		}, nil
+	}
+	if isTemplateStringColumn(column) {
+		if transformer := classification.RecommendTransformer(column.ColumnName, true); transformer != nil {
+			return transformer, nil
+		}
+		maxLength := int64(column.CharacterMaximumLength)
+		if maxLength <= 0 || maxLength > 20 {
+			maxLength = 20
+		}
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STRING,
+			Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_GenerateStringConfig{GenerateStringConfig: &mgmtv1alpha1.GenerateString{Min: 1, Max: maxLength}}},
+		}, nil
+	}
+
+	dataType := strings.ToLower(column.DataType)
+	switch {
+	case slices.Contains(templateIntegerDataTypes, dataType):
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_INT64,
+			Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_GenerateInt64Config{GenerateInt64Config: &mgmtv1alpha1.GenerateInt64{Min: 1, Max: 100}}},
+		}, nil
+	case strings.Contains(dataType, "numeric"), strings.Contains(dataType, "decimal"), strings.Contains(dataType, "double"),
+		strings.Contains(dataType, "float"), strings.Contains(dataType, "real"):
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_FLOAT64,
+			Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_GenerateFloat64Config{GenerateFloat64Config: &mgmtv1alpha1.GenerateFloat64{Min: 1, Max: 100, Precision: 6}}},
+		}, nil
+	case strings.HasPrefix(dataType, "bool"):
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_BOOL,
+			Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_GenerateBoolConfig{GenerateBoolConfig: &mgmtv1alpha1.GenerateBool{}}},
+		}, nil
+	case dataType == "uuid":
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_UUID,
+			Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_GenerateUuidConfig{GenerateUuidConfig: &mgmtv1alpha1.GenerateUuid{IncludeHyphens: true}}},
+		}, nil
+	case strings.HasPrefix(dataType, "timestamp"), strings.HasPrefix(dataType, "date"):
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_UTCTIMESTAMP,
+			Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_GenerateUtctimestampConfig{GenerateUtctimestampConfig: &mgmtv1alpha1.GenerateUtcTimestamp{}}},
+		}, nil
+	}
+
+	if column.IsNullable == "YES" {
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NULL,
+			Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_Nullconfig{Nullconfig: &mgmtv1alpha1.Null{}}},
+		}, nil
+	}
+	return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("unable to generate values for column %s.%s.%s of type %s", column.TableSchema, column.TableName, column.ColumnName, column.DataType))
+}
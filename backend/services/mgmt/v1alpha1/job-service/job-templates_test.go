@@ -0,0 +1,151 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetJobTemplates(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+
+	resp, err := m.Service.GetJobTemplates(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobTemplatesRequest{}))
+	require.NoError(t, err)
+
+	ids := map[string]struct{}{}
+	for _, template := range resp.Msg.GetTemplates() {
+		ids[template.GetId()] = struct{}{}
+	}
+	require.Len(t, ids, len(jobTemplates))
+	require.Contains(t, ids, "mask-postgres-to-staging")
+	require.Contains(t, ids, "nightly-s3-archive")
+	require.Contains(t, ids, "seed-synthetic-data")
+}
+
+func Test_CreateJobFromTemplate_UnknownTemplate(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+
+	resp, err := m.Service.CreateJobFromTemplate(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateJobFromTemplateRequest{
+		AccountId:  mockAccountId,
+		TemplateId: "unknown",
+		JobName:    "job",
+	}))
+	require.Error(t, err)
+	require.Nil(t, resp)
+}
+
+func Test_resolveJobTemplateVariables(t *testing.T) {
+	defaultValue := "default"
+	templateVariables := []*mgmtv1alpha1.JobTemplateVariable{
+		{Name: "required"},
+		{Name: "optional", DefaultValue: &defaultValue},
+	}
+
+	t.Run("uses defaults", func(t *testing.T) {
+		variables, err := resolveJobTemplateVariables(templateVariables, []*mgmtv1alpha1.JobTemplateVariableValue{{Name: "required", Value: "value"}})
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"required": "value", "optional": "default"}, variables)
+	})
+
+	t.Run("overrides defaults", func(t *testing.T) {
+		variables, err := resolveJobTemplateVariables(templateVariables, []*mgmtv1alpha1.JobTemplateVariableValue{
+			{Name: "required", Value: "value"},
+			{Name: "optional", Value: ""},
+		})
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"required": "value", "optional": ""}, variables)
+	})
+
+	t.Run("missing required", func(t *testing.T) {
+		_, err := resolveJobTemplateVariables(templateVariables, []*mgmtv1alpha1.JobTemplateVariableValue{{Name: "optional", Value: "value"}})
+		require.Error(t, err)
+	})
+
+	t.Run("unknown variable", func(t *testing.T) {
+		_, err := resolveJobTemplateVariables(templateVariables, []*mgmtv1alpha1.JobTemplateVariableValue{
+			{Name: "required", Value: "value"},
+			{Name: "other", Value: "value"},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("duplicate variable", func(t *testing.T) {
+		_, err := resolveJobTemplateVariables(templateVariables, []*mgmtv1alpha1.JobTemplateVariableValue{
+			{Name: "required", Value: "value"},
+			{Name: "required", Value: "value"},
+		})
+		require.Error(t, err)
+	})
+}
+
+func Test_buildMaskPostgresToStagingJob(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	pgConnection := &mgmtv1alpha1.GetConnectionResponse{
+		Connection: &mgmtv1alpha1.Connection{
+			Id: mockConnectionId,
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{PgConfig: &mgmtv1alpha1.PostgresConnectionConfig{}},
+			},
+		},
+	}
+
+	m.ConnectionServiceClientMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(pgConnection), nil)
+	m.SqlManagerMock.On("NewSqlDb", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&sql_manager.SqlConnection{Db: m.SqlDbMock, Driver: sql_manager.PostgresDriver}, nil)
+	m.SqlDbMock.On("Close").Return(nil)
+	m.SqlDbMock.On("GetDatabaseSchema", mock.Anything).Return([]*sql_manager.DatabaseSchemaRow{
+		{TableSchema: "public", TableName: "users", ColumnName: "id", DataType: "integer", TableType: sql_manager.BaseTableType},
+		{TableSchema: "public", TableName: "users", ColumnName: "email", DataType: "text", TableType: sql_manager.BaseTableType},
+		{TableSchema: "public", TableName: "users_view", ColumnName: "email", DataType: "text", TableType: "VIEW"},
+		{TableSchema: "other", TableName: "events", ColumnName: "id", DataType: "integer", TableType: sql_manager.BaseTableType},
+	}, nil)
+
+	req, err := buildMaskPostgresToStagingJob(context.Background(), m.Service, nil, map[string]string{
+		sourceConnectionIdTemplateVariable:      "source",
+		destinationConnectionIdTemplateVariable: "destination",
+		schemasTemplateVariable:                 "public",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "source", req.GetSource().GetOptions().GetPostgres().GetConnectionId())
+	require.Len(t, req.GetDestinations(), 1)
+	require.True(t, req.GetDestinations()[0].GetOptions().GetPostgresOptions().GetTruncateTable().GetCascade())
+	require.Len(t, req.GetMappings(), 2)
+	require.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH, req.GetMappings()[0].GetTransformer().GetSource())
+	require.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL, req.GetMappings()[1].GetTransformer().GetSource())
+}
+
+func Test_getSyntheticColumnTransformer(t *testing.T) {
+	generatedType := "s"
+	tests := []struct {
+		column   *sql_manager.DatabaseSchemaRow
+		expected mgmtv1alpha1.TransformerSource
+	}{
+		{&sql_manager.DatabaseSchemaRow{ColumnName: "id", DataType: "integer", ColumnDefault: "nextval('users_id_seq'::regclass)"}, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT},
+		{&sql_manager.DatabaseSchemaRow{ColumnName: "total", DataType: "numeric", GeneratedType: &generatedType}, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT},
+		{&sql_manager.DatabaseSchemaRow{ColumnName: "email", DataType: "character varying"}, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_EMAIL},
+		{&sql_manager.DatabaseSchemaRow{ColumnName: "first_name", DataType: "varchar"}, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_FIRST_NAME},
+		{&sql_manager.DatabaseSchemaRow{ColumnName: "notes", DataType: "text"}, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STRING},
+		{&sql_manager.DatabaseSchemaRow{ColumnName: "age", DataType: "bigint"}, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_INT64},
+		{&sql_manager.DatabaseSchemaRow{ColumnName: "price", DataType: "double precision"}, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_FLOAT64},
+		{&sql_manager.DatabaseSchemaRow{ColumnName: "active", DataType: "boolean"}, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_BOOL},
+		{&sql_manager.DatabaseSchemaRow{ColumnName: "external_id", DataType: "uuid"}, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_UUID},
+		{&sql_manager.DatabaseSchemaRow{ColumnName: "created_at", DataType: "timestamp without time zone"}, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_UTCTIMESTAMP},
+		{&sql_manager.DatabaseSchemaRow{ColumnName: "duration", DataType: "interval", IsNullable: "YES"}, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NULL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.column.ColumnName, func(t *testing.T) {
+			transformer, err := getSyntheticColumnTransformer(tt.column)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, transformer.GetSource())
+		})
+	}
+
+	_, err := getSyntheticColumnTransformer(&sql_manager.DatabaseSchemaRow{ColumnName: "data", DataType: "jsonb", IsNullable: "NO"})
+	require.Error(t, err)
+}
@@ -15,6 +15,7 @@ import (
 	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
 	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
 	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/idempotency"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
 	"github.com/nucleuscloud/neosync/backend/internal/utils"
 	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
@@ -25,10 +26,16 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	temporalclient "go.temporal.io/sdk/client"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
 	defaultCronStr = "0 0 1 1 *"
+
+	defaultNextScheduledRunsCount = 10
+	// How far ahead Temporal is asked for matching schedule times.
+	// Schedules that fire less often than this return fewer than the requested count
+	nextScheduledRunsLookahead = 366 * 24 * time.Hour
 )
 
 func (s *Service) GetJobs(
@@ -78,11 +85,22 @@ func (s *Service) GetJobs(
 		}
 	}
 
+	labelsMap, err := s.db.GetResourceLabelsMap(ctx, nucleusdb.LabelResourceTypeJob, jobIds)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve job labels: %w", err)
+	}
+
 	dtos := []*mgmtv1alpha1.Job{}
 	// Use jobIds to retain original query order
 	for _, jobId := range jobIds {
 		job := jobMap[jobId]
-		dtos = append(dtos, dtomaps.ToJobDto(job, associationMap[job.ID]))
+		labels := labelsMap[nucleusdb.UUIDString(job.ID)]
+		if !nucleusdb.MatchesLabelSelector(labels, req.Msg.GetLabelSelector()) {
+			continue
+		}
+		dto := dtomaps.ToJobDto(job, associationMap[job.ID])
+		dto.Labels = labels
+		dtos = append(dtos, dto)
 	}
 
 	return connect.NewResponse(&mgmtv1alpha1.GetJobsResponse{
@@ -121,7 +139,7 @@ func (s *Service) GetJob(
 	})
 	if err = errgrp.Wait(); err != nil {
 		if nucleusdb.IsNoRows(err) {
-			return nil, nucleuserrors.NewNotFound("unable to find job by id")
+			return nil, nucleuserrors.WithErrorCode(nucleuserrors.NewNotFound("unable to find job by id"), mgmtv1alpha1.ErrorCode_ERROR_CODE_JOB_NOT_FOUND)
 		}
 		return nil, err
 	}
@@ -299,6 +317,80 @@ func (s *Service) GetJobNextRuns(
 	}), nil
 }
 
+func (s *Service) GetNextScheduledRuns(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetNextScheduledRunsRequest],
+) (*connect.Response[mgmtv1alpha1.GetNextScheduledRunsResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("jobId", req.Msg.JobId)
+	jobUuid, err := nucleusdb.ToUuid(req.Msg.JobId)
+	if err != nil {
+		return nil, err
+	}
+	job, err := s.db.Q.GetJobById(ctx, s.db.Db, jobUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return nil, nucleuserrors.WithErrorCode(nucleuserrors.NewNotFound("unable to find job by id"), mgmtv1alpha1.ErrorCode_ERROR_CODE_JOB_NOT_FOUND)
+	}
+
+	_, err = s.verifyUserInAccount(ctx, nucleusdb.UUIDString(job.AccountID))
+	if err != nil {
+		return nil, err
+	}
+
+	count := defaultNextScheduledRunsCount
+	if req.Msg.Count != nil {
+		count = int(req.Msg.GetCount())
+	}
+
+	scheduleHandle, err := s.temporalWfManager.GetScheduleHandleClientByAccount(ctx, nucleusdb.UUIDString(job.AccountID), nucleusdb.UUIDString(job.ID), logger)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := scheduleHandle.Describe(ctx)
+	if err != nil {
+		logger.Error(fmt.Errorf("unable to retrieve schedule: %w", err).Error())
+		return nil, err
+	}
+
+	tclient, err := s.temporalWfManager.GetWorkflowClientByAccount(ctx, nucleusdb.UUIDString(job.AccountID), logger)
+	if err != nil {
+		return nil, err
+	}
+	tconfig, err := s.temporalWfManager.GetTemporalConfigByAccount(ctx, nucleusdb.UUIDString(job.AccountID))
+	if err != nil {
+		return nil, err
+	}
+
+	// temporal computes the matching times from the schedule spec, which includes the timezone and jitter
+	now := time.Now().UTC()
+	matchingTimes, err := tclient.WorkflowService().ListScheduleMatchingTimes(ctx, &workflowservice.ListScheduleMatchingTimesRequest{
+		Namespace:  tconfig.Namespace,
+		ScheduleId: nucleusdb.UUIDString(job.ID),
+		StartTime:  timestamppb.New(now),
+		EndTime:    timestamppb.New(now.Add(nextScheduledRunsLookahead)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list schedule matching times: %w", err)
+	}
+
+	nextRunTimes := matchingTimes.GetStartTime()
+	if len(nextRunTimes) > count {
+		nextRunTimes = nextRunTimes[:count]
+	}
+
+	paused := false
+	if schedule.Schedule.State != nil {
+		paused = schedule.Schedule.State.Paused
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.GetNextScheduledRunsResponse{
+		NextRunTimes: nextRunTimes,
+		Paused:       paused,
+	}), nil
+}
+
 type Destination struct {
 	ConnectionId pgtype.UUID
 	Options      *pg_models.JobDestinationOptions
@@ -307,15 +399,35 @@ type Destination struct {
 func (s *Service) CreateJob(
 	ctx context.Context,
 	req *connect.Request[mgmtv1alpha1.CreateJobRequest],
+) (*connect.Response[mgmtv1alpha1.CreateJobResponse], error) {
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	return idempotency.Do(ctx, s.db, req.Header(), *accountUuid, "CreateJob", req.Msg, func() (*connect.Response[mgmtv1alpha1.CreateJobResponse], error) {
+		return s.createJob(ctx, req, accountUuid)
+	})
+}
+
+func (s *Service) createJob(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.CreateJobRequest],
+	accountUuid *pgtype.UUID,
 ) (*connect.Response[mgmtv1alpha1.CreateJobResponse], error) {
 	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
 	logger = logger.With("jobName", req.Msg.JobName, "accountId", req.Msg.AccountId)
 
-	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.AccountId)
+	userUuid, err := s.getUserUuid(ctx)
 	if err != nil {
 		return nil, err
 	}
-	userUuid, err := s.getUserUuid(ctx)
+
+	jobDefaults, err := s.getAccountJobDefaults(ctx, *accountUuid)
+	if err != nil {
+		return nil, err
+	}
+	jobDefaultsDto := jobDefaults.ToDto()
+	createDestinations, err := s.applyDefaultDestinationOptions(ctx, req.Msg.Destinations, jobDefaultsDto)
 	if err != nil {
 		return nil, err
 	}
@@ -323,7 +435,7 @@ func (s *Service) CreateJob(
 	connectionUuids := []pgtype.UUID{}
 	connectionIds := []string{}
 	destinations := []*Destination{}
-	for _, dest := range req.Msg.Destinations {
+	for _, dest := range createDestinations {
 		destUuid, err := nucleusdb.ToUuid(dest.ConnectionId)
 		if err != nil {
 			return nil, err
@@ -359,6 +471,20 @@ func (s *Service) CreateJob(
 		connectionIds = append(connectionIds, config.Postgres.ConnectionId)
 	case *mgmtv1alpha1.JobSourceOptions_AwsS3:
 		connectionIds = append(connectionIds, config.AwsS3.ConnectionId)
+	case *mgmtv1alpha1.JobSourceOptions_Custom:
+		connectionIds = append(connectionIds, config.Custom.ConnectionId)
+	case *mgmtv1alpha1.JobSourceOptions_Synthesize:
+		connectionIds = append(connectionIds, config.Synthesize.ConnectionId)
+	case *mgmtv1alpha1.JobSourceOptions_SnapshotClone:
+		if len(destinations) > 0 {
+			return nil, nucleuserrors.NewBadRequest("snapshot clone jobs mask the restored instance in place and cannot have destinations")
+		}
+		connectionIds = append(connectionIds, config.SnapshotClone.ConnectionId)
+	case *mgmtv1alpha1.JobSourceOptions_InPlaceMask:
+		if len(destinations) > 0 {
+			return nil, nucleuserrors.NewBadRequest("in place mask jobs update the source database and cannot have destinations")
+		}
+		connectionIds = append(connectionIds, config.InPlaceMask.ConnectionId)
 	default:
 	}
 
@@ -388,6 +514,16 @@ func (s *Service) CreateJob(
 		if !areConnectionsCompatible {
 			return nil, nucleuserrors.NewBadRequest("connection types are incompatible")
 		}
+		if req.Msg.GetSource().GetOptions().GetSnapshotClone() != nil {
+			if err := verifyInPlaceSourceConnection(ctx, s.db, sourceUuid, "snapshot clone"); err != nil {
+				return nil, err
+			}
+		}
+		if req.Msg.GetSource().GetOptions().GetInPlaceMask() != nil {
+			if err := verifyInPlaceSourceConnection(ctx, s.db, sourceUuid, "in place mask"); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	cronStr := req.Msg.GetCronSchedule()
@@ -409,6 +545,9 @@ func (s *Service) CreateJob(
 		}
 		mappings = append(mappings, jm)
 	}
+	if err := verifyJobMappingDestinations(mappings); err != nil {
+		return nil, err
+	}
 
 	connectionOptions := &pg_models.JobSourceOptions{}
 	err = connectionOptions.FromDto(req.Msg.Source.Options)
@@ -436,14 +575,29 @@ func (s *Service) CreateJob(
 		return nil, nucleuserrors.NewBadRequest("must first configure temporal namespace in account settings")
 	}
 
+	// options that were not provided are inherited from the account's job defaults
 	workflowOptions := &pg_models.WorkflowOptions{}
 	if req.Msg.WorkflowOptions != nil {
 		workflowOptions.FromDto(req.Msg.WorkflowOptions)
+	} else if jobDefaultsDto.WorkflowOptions != nil {
+		workflowOptions.FromDto(jobDefaultsDto.WorkflowOptions)
 	}
 
 	activitySyncOptions := &pg_models.ActivityOptions{}
 	if req.Msg.SyncOptions != nil {
 		activitySyncOptions.FromDto(req.Msg.SyncOptions)
+	} else if jobDefaultsDto.SyncOptions != nil {
+		activitySyncOptions.FromDto(jobDefaultsDto.SyncOptions)
+	}
+
+	tableProcessors, err := toJobTableProcessors(req.Msg.TableProcessors)
+	if err != nil {
+		return nil, err
+	}
+
+	parameters, err := toJobParameters(req.Msg.Parameters)
+	if err != nil {
+		return nil, err
 	}
 
 	tScheduleClient, err := s.temporalWfManager.GetScheduleClientByAccount(ctx, req.Msg.AccountId, logger)
@@ -468,6 +622,8 @@ func (s *Service) CreateJob(
 		UpdatedByID:       *userUuid,
 		WorkflowOptions:   workflowOptions,
 		SyncOptions:       activitySyncOptions,
+		TableProcessors:   tableProcessors,
+		Parameters:        parameters,
 	}, connDestParams)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create job: %w", err)
@@ -476,6 +632,18 @@ func (s *Service) CreateJob(
 	logger = logger.With("jobId", jobUuid)
 	logger.Info("created job")
 
+	if len(req.Msg.GetLabels()) > 0 {
+		err = s.db.SetResourceLabels(ctx, *accountUuid, nucleusdb.LabelResourceTypeJob, cj.ID, req.Msg.GetLabels())
+		if err != nil {
+			logger.Info("deleting newly created job")
+			removeJobErr := s.db.Q.RemoveJobById(ctx, s.db.Db, cj.ID)
+			if removeJobErr != nil {
+				return nil, fmt.Errorf("unable to set job labels and was unable to fully cleanup partially created resources: %w: %w", removeJobErr, err)
+			}
+			return nil, fmt.Errorf("unable to set job labels: %w", err)
+		}
+	}
+
 	logger = logger.With("jobId", jobUuid)
 	schedule := nucleusdb.ToNullableString(cj.CronSchedule)
 	paused := true
@@ -528,8 +696,10 @@ func (s *Service) CreateJob(
 		logger.Error("unable to retrieve job destination connections")
 	}
 
+	dto := dtomaps.ToJobDto(cj, destinationConnections)
+	dto.Labels = req.Msg.GetLabels()
 	return connect.NewResponse(&mgmtv1alpha1.CreateJobResponse{
-		Job: dtomaps.ToJobDto(cj, destinationConnections),
+		Job: dto,
 	}), nil
 }
 
@@ -609,9 +779,47 @@ func (s *Service) DeleteJob(
 	if err != nil {
 		return nil, err
 	}
+	err = s.db.Q.RemoveResourceLabels(ctx, s.db.Db, db_queries.RemoveResourceLabelsParams{
+		ResourceType: nucleusdb.LabelResourceTypeJob,
+		ResourceID:   job.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to remove job labels: %w", err)
+	}
 	return connect.NewResponse(&mgmtv1alpha1.DeleteJobResponse{}), nil
 }
 
+func (s *Service) SetJobLabels(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.SetJobLabelsRequest],
+) (*connect.Response[mgmtv1alpha1.SetJobLabelsResponse], error) {
+	job, err := s.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{
+		Id: req.Msg.GetId(),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	accountUuid, err := nucleusdb.ToUuid(job.Msg.GetJob().GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	jobUuid, err := nucleusdb.ToUuid(job.Msg.GetJob().GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.SetResourceLabels(ctx, accountUuid, nucleusdb.LabelResourceTypeJob, jobUuid, req.Msg.GetLabels())
+	if err != nil {
+		return nil, fmt.Errorf("unable to set job labels: %w", err)
+	}
+
+	dto := job.Msg.GetJob()
+	dto.Labels = req.Msg.GetLabels()
+	return connect.NewResponse(&mgmtv1alpha1.SetJobLabelsResponse{
+		Job: dto,
+	}), nil
+}
+
 func (s *Service) CreateJobDestinationConnections(
 	ctx context.Context,
 	req *connect.Request[mgmtv1alpha1.CreateJobDestinationConnectionsRequest],
@@ -640,10 +848,26 @@ func (s *Service) CreateJobDestinationConnections(
 	}
 	logger = logger.With("userId", userUuid)
 
+	if job.Msg.GetJob().GetSource().GetOptions().GetSnapshotClone() != nil {
+		return nil, nucleuserrors.NewBadRequest("snapshot clone jobs mask the restored instance in place and cannot have destinations")
+	}
+	if job.Msg.GetJob().GetSource().GetOptions().GetInPlaceMask() != nil {
+		return nil, nucleuserrors.NewBadRequest("in place mask jobs update the source database and cannot have destinations")
+	}
+
+	jobDefaults, err := s.getAccountJobDefaults(ctx, *accountUuid)
+	if err != nil {
+		return nil, err
+	}
+	createDestinations, err := s.applyDefaultDestinationOptions(ctx, req.Msg.Destinations, jobDefaults.ToDto())
+	if err != nil {
+		return nil, err
+	}
+
 	connectionIds := []string{}
 	connectionUuids := []pgtype.UUID{}
 	destinations := []*Destination{}
-	for _, dest := range req.Msg.Destinations {
+	for _, dest := range createDestinations {
 		destUuid, err := nucleusdb.ToUuid(dest.ConnectionId)
 		if err != nil {
 			return nil, err
@@ -713,7 +937,7 @@ func (s *Service) UpdateJobSchedule(
 	if err != nil && !nucleusdb.IsNoRows(err) {
 		return nil, err
 	} else if err != nil && nucleusdb.IsNoRows(err) {
-		return nil, nucleuserrors.NewNotFound("unable to find job by id")
+		return nil, nucleuserrors.WithErrorCode(nucleuserrors.NewNotFound("unable to find job by id"), mgmtv1alpha1.ErrorCode_ERROR_CODE_JOB_NOT_FOUND)
 	}
 
 	_, err = s.verifyUserInAccount(ctx, nucleusdb.UUIDString(job.AccountID))
@@ -798,7 +1022,7 @@ func (s *Service) PauseJob(
 	if err != nil && !nucleusdb.IsNoRows(err) {
 		return nil, err
 	} else if err != nil && nucleusdb.IsNoRows(err) {
-		return nil, nucleuserrors.NewNotFound("unable to find job by id")
+		return nil, nucleuserrors.WithErrorCode(nucleuserrors.NewNotFound("unable to find job by id"), mgmtv1alpha1.ErrorCode_ERROR_CODE_JOB_NOT_FOUND)
 	}
 
 	_, err = s.verifyUserInAccount(ctx, nucleusdb.UUIDString(job.AccountID))
@@ -837,6 +1061,23 @@ func (s *Service) PauseJob(
 	}), nil
 }
 
+func (s *Service) ResumeJob(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.ResumeJobRequest],
+) (*connect.Response[mgmtv1alpha1.ResumeJobResponse], error) {
+	resp, err := s.PauseJob(ctx, connect.NewRequest(&mgmtv1alpha1.PauseJobRequest{
+		Id:    req.Msg.Id,
+		Pause: false,
+		Note:  req.Msg.Note,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.ResumeJobResponse{
+		Job: resp.Msg.Job,
+	}), nil
+}
+
 func (s *Service) UpdateJobSourceConnection(
 	ctx context.Context,
 	req *connect.Request[mgmtv1alpha1.UpdateJobSourceConnectionRequest],
@@ -852,7 +1093,7 @@ func (s *Service) UpdateJobSourceConnection(
 	if err != nil && !nucleusdb.IsNoRows(err) {
 		return nil, err
 	} else if err != nil && nucleusdb.IsNoRows(err) {
-		return nil, nucleuserrors.NewNotFound("unable to find job by id")
+		return nil, nucleuserrors.WithErrorCode(nucleuserrors.NewNotFound("unable to find job by id"), mgmtv1alpha1.ErrorCode_ERROR_CODE_JOB_NOT_FOUND)
 	}
 
 	_, err = s.verifyUserInAccount(ctx, nucleusdb.UUIDString(job.AccountID))
@@ -872,6 +1113,12 @@ func (s *Service) UpdateJobSourceConnection(
 		connectionIdToVerify = &config.Postgres.ConnectionId
 	case *mgmtv1alpha1.JobSourceOptions_AwsS3:
 		connectionIdToVerify = &config.AwsS3.ConnectionId
+	case *mgmtv1alpha1.JobSourceOptions_Custom:
+		connectionIdToVerify = &config.Custom.ConnectionId
+	case *mgmtv1alpha1.JobSourceOptions_SnapshotClone:
+		connectionIdToVerify = &config.SnapshotClone.ConnectionId
+	case *mgmtv1alpha1.JobSourceOptions_InPlaceMask:
+		connectionIdToVerify = &config.InPlaceMask.ConnectionId
 	case *mgmtv1alpha1.JobSourceOptions_Generate:
 		fkConnId := config.Generate.GetFkSourceConnectionId()
 		if fkConnId != "" {
@@ -882,6 +1129,11 @@ func (s *Service) UpdateJobSourceConnection(
 		if fkConnId != "" {
 			connectionIdToVerify = &fkConnId
 		}
+	case *mgmtv1alpha1.JobSourceOptions_Synthesize:
+		if err := verifySynthesizeSourceOptions(config.Synthesize); err != nil {
+			return nil, err
+		}
+		connectionIdToVerify = &config.Synthesize.ConnectionId
 	}
 
 	// verifies that the account has access to that connection id
@@ -902,20 +1154,30 @@ func (s *Service) UpdateJobSourceConnection(
 		dbConf := req.Msg.GetSource().GetOptions().GetMysql()
 		generateConf := req.Msg.GetSource().GetOptions().GetGenerate()
 		aigenerateConf := req.Msg.GetSource().GetOptions().GetAiGenerate()
-		if dbConf == nil && generateConf == nil && aigenerateConf == nil {
+		synthesizeConf := req.Msg.GetSource().GetOptions().GetSynthesize()
+		snapshotCloneConf := req.Msg.GetSource().GetOptions().GetSnapshotClone()
+		inPlaceMaskConf := req.Msg.GetSource().GetOptions().GetInPlaceMask()
+		if dbConf == nil && generateConf == nil && aigenerateConf == nil && synthesizeConf == nil && snapshotCloneConf == nil && inPlaceMaskConf == nil {
 			return nil, nucleuserrors.NewBadRequest("job source option config type and connection type mismatch")
 		}
 	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
 		dbConf := req.Msg.GetSource().GetOptions().GetPostgres()
 		generateConf := req.Msg.GetSource().GetOptions().GetGenerate()
 		aigenerateConf := req.Msg.GetSource().GetOptions().GetAiGenerate()
-		if dbConf == nil && generateConf == nil && aigenerateConf == nil {
+		synthesizeConf := req.Msg.GetSource().GetOptions().GetSynthesize()
+		snapshotCloneConf := req.Msg.GetSource().GetOptions().GetSnapshotClone()
+		inPlaceMaskConf := req.Msg.GetSource().GetOptions().GetInPlaceMask()
+		if dbConf == nil && generateConf == nil && aigenerateConf == nil && synthesizeConf == nil && snapshotCloneConf == nil && inPlaceMaskConf == nil {
 			return nil, nucleuserrors.NewBadRequest("job source option config type and connection type mismatch")
 		}
 	case *mgmtv1alpha1.ConnectionConfig_AwsS3Config:
 		if _, ok := req.Msg.Source.Options.Config.(*mgmtv1alpha1.JobSourceOptions_AwsS3); !ok {
 			return nil, fmt.Errorf("job source option config type and connection type mismatch")
 		}
+	case *mgmtv1alpha1.ConnectionConfig_CustomConfig:
+		if _, ok := req.Msg.Source.Options.Config.(*mgmtv1alpha1.JobSourceOptions_Custom); !ok {
+			return nil, nucleuserrors.NewBadRequest("job source option config type and connection type mismatch")
+		}
 	default:
 		return nil, nucleuserrors.NewNotImplemented(fmt.Sprintf("connection config is not currently supported: %T", cconfig))
 	}
@@ -935,6 +1197,26 @@ func (s *Service) UpdateJobSourceConnection(
 		}
 		mappings = append(mappings, jm)
 	}
+	if err := verifyJobMappingDestinations(mappings); err != nil {
+		return nil, err
+	}
+
+	changeRequest, err := s.holdSensitiveJobChange(ctx, logger, &job, connectionIdToVerify, connectionOptions, mappings, *userUuid)
+	if err != nil {
+		return nil, err
+	}
+	if changeRequest != nil {
+		currentJob, err := s.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{
+			Id: req.Msg.Id,
+		}))
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve job after creating change request: %w", err)
+		}
+		return connect.NewResponse(&mgmtv1alpha1.UpdateJobSourceConnectionResponse{
+			Job:           currentJob.Msg.Job,
+			PendingChange: dtomaps.ToJobChangeRequestDto(changeRequest),
+		}), nil
+	}
 
 	if err := s.db.WithTx(ctx, nil, func(dbtx nucleusdb.BaseDBTX) error {
 		_, err = s.db.Q.UpdateJobSource(ctx, dbtx, db_queries.UpdateJobSourceParams{
@@ -988,7 +1270,7 @@ func (s *Service) SetJobSourceSqlConnectionSubsets(
 	if err != nil && !nucleusdb.IsNoRows(err) {
 		return nil, err
 	} else if err != nil && nucleusdb.IsNoRows(err) {
-		return nil, nucleuserrors.NewNotFound("unable to find job by id")
+		return nil, nucleuserrors.WithErrorCode(nucleuserrors.NewNotFound("unable to find job by id"), mgmtv1alpha1.ErrorCode_ERROR_CODE_JOB_NOT_FOUND)
 	}
 
 	_, err = s.verifyUserInAccount(ctx, nucleusdb.UUIDString(job.AccountID))
@@ -1150,7 +1432,7 @@ func (s *Service) DeleteJobDestinationConnection(
 	if err != nil && !nucleusdb.IsNoRows(err) {
 		return nil, err
 	} else if err != nil && nucleusdb.IsNoRows(err) {
-		return nil, nucleuserrors.NewNotFound("unable to find job by id")
+		return nil, nucleuserrors.WithErrorCode(nucleuserrors.NewNotFound("unable to find job by id"), mgmtv1alpha1.ErrorCode_ERROR_CODE_JOB_NOT_FOUND)
 	}
 
 	_, err = s.verifyUserInAccount(ctx, nucleusdb.UUIDString(job.AccountID))
@@ -1236,15 +1518,27 @@ func getWorkflowExecutionsByJobIds(
 	namespace string,
 	jobIds []string,
 ) ([]*workflowpb.WorkflowExecutionInfo, error) {
+	if len(jobIds) == 0 {
+		return []*workflowpb.WorkflowExecutionInfo{}, nil
+	}
+	return listWorkflowExecutions(ctx, tc, namespace, buildJobIdsWorkflowQuery(jobIds))
+}
+
+func buildJobIdsWorkflowQuery(jobIds []string) string {
 	jobIdStr := ""
 	for _, id := range jobIds {
 		jobIdStr += fmt.Sprintf(`%q,`, id)
 	}
-	query := fmt.Sprintf("TemporalScheduledById IN (%s)", strings.TrimSuffix(jobIdStr, ","))
+	return fmt.Sprintf("TemporalScheduledById IN (%s)", strings.TrimSuffix(jobIdStr, ","))
+}
+
+func listWorkflowExecutions(
+	ctx context.Context,
+	tc temporalclient.Client,
+	namespace string,
+	query string,
+) ([]*workflowpb.WorkflowExecutionInfo, error) {
 	executions := []*workflowpb.WorkflowExecutionInfo{}
-	if len(jobIds) == 0 {
-		return executions, nil
-	}
 	var nextPageToken []byte
 	for hasMore := true; hasMore; hasMore = len(nextPageToken) > 0 {
 		resp, err := tc.ListWorkflow(ctx, &workflowservice.ListWorkflowExecutionsRequest{
@@ -1323,6 +1617,10 @@ func verifyConnectionsAreCompatible(ctx context.Context, db *nucleusdb.NucleusDb
 
 	for i := range dests {
 		d := dests[i]
+		if sourceConnection.ConnectionConfig.AwsS3Config != nil && d.ConnectionConfig.AwsS3Config != nil {
+			// AWS S3 sources can only be restored into SQL destinations
+			return false, nil
+		}
 		// AWS S3 is always a valid destination regardless of source connection type
 		if d.ConnectionConfig.AwsS3Config != nil {
 			continue
@@ -1655,6 +1953,64 @@ func (s *Service) ValidateJobMappings(
 		}
 	}
 
+	// verify that no primary or foreign key columns are dropped from the destination
+	isDropped := func(table, col string) bool {
+		m, ok := tableColMappings[table][col]
+		return ok && m.GetTransformer().GetSource() == mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_DROP_COLUMN
+	}
+	addDroppedKeyError := func(table, col string) {
+		if _, ok := colErrorsMap[table]; !ok {
+			colErrorsMap[table] = map[string][]string{}
+		}
+		colErrorsMap[table][col] = append(colErrorsMap[table][col], fmt.Sprintf("Key columns can not be dropped. Table: %s  Column: %s", table, col))
+	}
+	for table, pks := range tableConstraints.PrimaryKeyConstraints {
+		for _, col := range pks {
+			if isDropped(table, col) {
+				addDroppedKeyError(table, col)
+			}
+		}
+	}
+	for table, fks := range tableConstraints.ForeignKeyConstraints {
+		for _, fk := range fks {
+			for idx, col := range fk.Columns {
+				if isDropped(table, col) {
+					addDroppedKeyError(table, col)
+				}
+				fkCol := fk.ForeignKey.Columns[idx]
+				if isDropped(fk.ForeignKey.Table, fkCol) && !slices.Contains(tableConstraints.PrimaryKeyConstraints[fk.ForeignKey.Table], fkCol) {
+					addDroppedKeyError(fk.ForeignKey.Table, fkCol)
+				}
+			}
+		}
+	}
+
+	// verify the mappings against the columns of the source and destinations
+	violations := getSourceMappingViolations(req.Msg.GetConnectionId(), req.Msg.GetMappings(), colInfoMap, len(req.Msg.GetDestinationConnectionIds()) == 0)
+	for _, destinationId := range req.Msg.GetDestinationConnectionIds() {
+		destViolations, err := s.getDestinationConnectionViolations(ctx, logger, destinationId, req.Msg.GetMappings(), colInfoMap)
+		if err != nil {
+			return nil, err
+		}
+		violations = append(violations, destViolations...)
+	}
+	for _, violation := range violations {
+		isSource := violation.GetConnectionId() == req.Msg.GetConnectionId()
+		switch {
+		case isSource && (violation.GetType() == mgmtv1alpha1.JobMappingViolationType_JOB_MAPPING_VIOLATION_TYPE_MISSING_TABLE ||
+			violation.GetType() == mgmtv1alpha1.JobMappingViolationType_JOB_MAPPING_VIOLATION_TYPE_UNMAPPED_REQUIRED_COLUMN):
+			// skip. already described by the checks above
+		case violation.GetColumn() == "":
+			dbErrors.Errors = append(dbErrors.Errors, violation.GetMessage())
+		default:
+			table := fmt.Sprintf("%s.%s", violation.GetSchema(), violation.GetTable())
+			if _, ok := colErrorsMap[table]; !ok {
+				colErrorsMap[table] = map[string][]string{}
+			}
+			colErrorsMap[table][violation.GetColumn()] = append(colErrorsMap[table][violation.GetColumn()], violation.GetMessage())
+		}
+	}
+
 	colErrors := []*mgmtv1alpha1.ColumnError{}
 	for tableName, colMap := range colErrorsMap {
 		for col, errors := range colMap {
@@ -1671,6 +2027,7 @@ func (s *Service) ValidateJobMappings(
 	return connect.NewResponse(&mgmtv1alpha1.ValidateJobMappingsResponse{
 		DatabaseErrors: dbErrors,
 		ColumnErrors:   colErrors,
+		Violations:     violations,
 	}), nil
 }
 
@@ -1683,6 +2040,12 @@ func getJobSourceConnectionId(jobSource *mgmtv1alpha1.JobSource) (*string, error
 		connectionIdToVerify = &config.Postgres.ConnectionId
 	case *mgmtv1alpha1.JobSourceOptions_AwsS3:
 		connectionIdToVerify = &config.AwsS3.ConnectionId
+	case *mgmtv1alpha1.JobSourceOptions_Custom:
+		connectionIdToVerify = &config.Custom.ConnectionId
+	case *mgmtv1alpha1.JobSourceOptions_SnapshotClone:
+		connectionIdToVerify = &config.SnapshotClone.ConnectionId
+	case *mgmtv1alpha1.JobSourceOptions_InPlaceMask:
+		connectionIdToVerify = &config.InPlaceMask.ConnectionId
 	case *mgmtv1alpha1.JobSourceOptions_Generate:
 		fkConnId := config.Generate.GetFkSourceConnectionId()
 		if fkConnId != "" {
@@ -1693,8 +2056,32 @@ func getJobSourceConnectionId(jobSource *mgmtv1alpha1.JobSource) (*string, error
 		if fkConnId != "" {
 			connectionIdToVerify = &fkConnId
 		}
+	case *mgmtv1alpha1.JobSourceOptions_Synthesize:
+		if err := verifySynthesizeSourceOptions(config.Synthesize); err != nil {
+			return nil, err
+		}
+		connectionIdToVerify = &config.Synthesize.ConnectionId
 	default:
 		return nil, errors.New("unsupported source option config type")
 	}
 	return connectionIdToVerify, nil
 }
+
+func verifySynthesizeSourceOptions(opts *mgmtv1alpha1.SynthesizeSourceOptions) error {
+	if opts.GetModel() == mgmtv1alpha1.SynthesizerModel_SYNTHESIZER_MODEL_CTGAN && opts.GetExternalServiceUrl() == "" {
+		return nucleuserrors.NewBadRequest("must provide an external service url when using the CTGAN synthesizer model")
+	}
+	return nil
+}
+
+// Rows are only updated in place in Postgres and Mysql databases, which are also the engines that RDS and Cloud SQL snapshots restore
+func verifyInPlaceSourceConnection(ctx context.Context, db *nucleusdb.NucleusDb, connectionId pgtype.UUID, jobType string) error {
+	connection, err := db.Q.GetConnectionById(ctx, db.Db, connectionId)
+	if err != nil {
+		return err
+	}
+	if connection.ConnectionConfig.PgConfig == nil && connection.ConnectionConfig.MysqlConfig == nil {
+		return nucleuserrors.NewBadRequest(fmt.Sprintf("%s jobs are only supported for Postgres and Mysql connections", jobType))
+	}
+	return nil
+}
@@ -23,7 +23,9 @@ import (
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/workflowservice/v1"
 	temporal "go.temporal.io/sdk/client"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -64,6 +66,10 @@ func Test_GetJobs(t *testing.T) {
 	destConnAssociation2 := mockJobDestConnAssociation(job2.ID, destConn2.ID, &pg_models.JobDestinationOptions{})
 	m.QuerierMock.On("GetJobsByAccount", context.Background(), mock.Anything, accountUuid).Return([]db_queries.NeosyncApiJob{job1, job2}, nil)
 	m.QuerierMock.On("GetJobConnectionDestinationsByJobIds", context.Background(), mock.Anything, []pgtype.UUID{job1.ID, job2.ID}).Return([]db_queries.NeosyncApiJobDestinationConnectionAssociation{destConnAssociation1, destConnAssociation2}, nil)
+	m.QuerierMock.On("GetResourceLabels", context.Background(), mock.Anything, db_queries.GetResourceLabelsParams{
+		ResourceType: nucleusdb.LabelResourceTypeJob,
+		ResourceIds:  []pgtype.UUID{job1.ID, job2.ID},
+	}).Return([]db_queries.NeosyncApiResourceLabel{{ResourceID: job1.ID, Key: "team", Value: "payments"}}, nil)
 	resp, err := m.Service.GetJobs(context.Background(), &connect.Request[mgmtv1alpha1.GetJobsRequest]{
 		Msg: &mgmtv1alpha1.GetJobsRequest{
 			AccountId: mockAccountId,
@@ -84,6 +90,31 @@ func Test_GetJobs(t *testing.T) {
 	require.NotNil(t, jobActualMap[job2Id])
 	require.Equal(t, nucleusdb.UUIDString(destConn1.ID), jobActualMap[job1Id].Destinations[0].ConnectionId)
 	require.Equal(t, nucleusdb.UUIDString(destConn2.ID), jobActualMap[job2Id].Destinations[0].ConnectionId)
+	require.Equal(t, map[string]string{"team": "payments"}, jobActualMap[job1Id].GetLabels())
+	require.Empty(t, jobActualMap[job2Id].GetLabels())
+}
+
+func Test_GetJobs_LabelSelector(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	job1 := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	job2 := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	m.QuerierMock.On("GetJobsByAccount", context.Background(), mock.Anything, accountUuid).Return([]db_queries.NeosyncApiJob{job1, job2}, nil)
+	m.QuerierMock.On("GetJobConnectionDestinationsByJobIds", context.Background(), mock.Anything, []pgtype.UUID{job1.ID, job2.ID}).Return([]db_queries.NeosyncApiJobDestinationConnectionAssociation{}, nil)
+	m.QuerierMock.On("GetResourceLabels", context.Background(), mock.Anything, mock.Anything).Return([]db_queries.NeosyncApiResourceLabel{
+		{ResourceID: job1.ID, Key: "team", Value: "growth"},
+		{ResourceID: job2.ID, Key: "team", Value: "payments"},
+	}, nil)
+	resp, err := m.Service.GetJobs(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobsRequest{
+		AccountId:     mockAccountId,
+		LabelSelector: map[string]string{"team": "payments"},
+	}))
+
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Msg.GetJobs()))
+	require.Equal(t, nucleusdb.UUIDString(job2.ID), resp.Msg.GetJobs()[0].GetId())
 }
 
 func Test_GetJobs_MissingDestinations(t *testing.T) {
@@ -94,6 +125,7 @@ func Test_GetJobs_MissingDestinations(t *testing.T) {
 	job1 := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
 	m.QuerierMock.On("GetJobsByAccount", context.Background(), mock.Anything, accountUuid).Return([]db_queries.NeosyncApiJob{job1}, nil)
 	m.QuerierMock.On("GetJobConnectionDestinationsByJobIds", context.Background(), mock.Anything, []pgtype.UUID{job1.ID}).Return([]db_queries.NeosyncApiJobDestinationConnectionAssociation{}, nil)
+	m.QuerierMock.On("GetResourceLabels", context.Background(), mock.Anything, mock.Anything).Return([]db_queries.NeosyncApiResourceLabel{}, nil)
 	resp, err := m.Service.GetJobs(context.Background(), &connect.Request[mgmtv1alpha1.GetJobsRequest]{
 		Msg: &mgmtv1alpha1.GetJobsRequest{
 			AccountId: mockAccountId,
@@ -360,6 +392,7 @@ func Test_CreateJob(t *testing.T) {
 
 	mockUserAccountCalls(m.UserAccountServiceMock, true)
 	mockDbTransaction(m.DbtxMock, mockTx)
+	m.QuerierMock.On("GetAccountJobDefaults", mock.Anything, mock.Anything, accountUuid).Return(&pg_models.AccountJobDefaults{}, nil)
 	m.QuerierMock.On("AreConnectionsInAccount", mock.Anything, mock.Anything, db_queries.AreConnectionsInAccountParams{
 		AccountId:     accountUuid,
 		ConnectionIds: []pgtype.UUID{destConn.ID},
@@ -414,6 +447,8 @@ func Test_CreateJob(t *testing.T) {
 		UpdatedByID:     userUuid,
 		WorkflowOptions: &pg_models.WorkflowOptions{},
 		SyncOptions:     &pg_models.ActivityOptions{},
+		TableProcessors: []*pg_models.JobTableProcessor{},
+		Parameters:      []*pg_models.JobParameter{},
 	}).Return(job1, nil)
 	m.QuerierMock.On("CreateJobConnectionDestinations", mock.Anything, mockTx, destinationParams).Return(int64(1), nil)
 	m.QuerierMock.On("GetJobConnectionDestinations", mock.Anything, mock.Anything, job1.ID).Return([]db_queries.NeosyncApiJobDestinationConnectionAssociation{destConnAssociation}, nil)
@@ -510,6 +545,7 @@ func Test_CreateJob_Schedule_Creation_Error(t *testing.T) {
 
 	mockUserAccountCalls(m.UserAccountServiceMock, true)
 	mockDbTransaction(m.DbtxMock, mockTx)
+	m.QuerierMock.On("GetAccountJobDefaults", mock.Anything, mock.Anything, accountUuid).Return(&pg_models.AccountJobDefaults{}, nil)
 	m.QuerierMock.On("AreConnectionsInAccount", mock.Anything, mock.Anything, db_queries.AreConnectionsInAccountParams{
 		AccountId:     accountUuid,
 		ConnectionIds: []pgtype.UUID{destConn.ID},
@@ -560,6 +596,8 @@ func Test_CreateJob_Schedule_Creation_Error(t *testing.T) {
 		UpdatedByID:     userUuid,
 		WorkflowOptions: &pg_models.WorkflowOptions{},
 		SyncOptions:     &pg_models.ActivityOptions{},
+		TableProcessors: []*pg_models.JobTableProcessor{},
+		Parameters:      []*pg_models.JobParameter{},
 	}).Return(job1, nil)
 	m.QuerierMock.On("CreateJobConnectionDestinations", mock.Anything, mockTx, destinationParams).Return(int64(1), nil)
 
@@ -660,6 +698,7 @@ func Test_CreateJob_Schedule_Creation_Error_JobCleanup_Error(t *testing.T) {
 
 	mockUserAccountCalls(m.UserAccountServiceMock, true)
 	mockDbTransaction(m.DbtxMock, mockTx)
+	m.QuerierMock.On("GetAccountJobDefaults", mock.Anything, mock.Anything, accountUuid).Return(&pg_models.AccountJobDefaults{}, nil)
 	m.QuerierMock.On("AreConnectionsInAccount", mock.Anything, mock.Anything, db_queries.AreConnectionsInAccountParams{
 		AccountId:     accountUuid,
 		ConnectionIds: []pgtype.UUID{destConn.ID},
@@ -710,6 +749,8 @@ func Test_CreateJob_Schedule_Creation_Error_JobCleanup_Error(t *testing.T) {
 		UpdatedByID:     userUuid,
 		WorkflowOptions: &pg_models.WorkflowOptions{},
 		SyncOptions:     &pg_models.ActivityOptions{},
+		TableProcessors: []*pg_models.JobTableProcessor{},
+		Parameters:      []*pg_models.JobParameter{},
 	}).Return(job1, nil)
 	m.QuerierMock.On("CreateJobConnectionDestinations", mock.Anything, mockTx, destinationParams).Return(int64(1), nil)
 
@@ -791,6 +832,7 @@ func Test_CreateJobDestinationConnections(t *testing.T) {
 	destConnAssociation := mockJobDestConnAssociation(job.ID, destConn.ID, &pg_models.JobDestinationOptions{})
 
 	mockGetJob(m.UserAccountServiceMock, m.QuerierMock, job, []db_queries.NeosyncApiJobDestinationConnectionAssociation{destConnAssociation})
+	m.QuerierMock.On("GetAccountJobDefaults", mock.Anything, mock.Anything, job.AccountID).Return(&pg_models.AccountJobDefaults{}, nil)
 	m.QuerierMock.On("GetConnectionsByIds", mock.Anything, mock.Anything, []pgtype.UUID{destConn.ID}).Return([]db_queries.NeosyncApiConnection{destConn}, nil)
 	m.QuerierMock.On("CreateJobConnectionDestinations", mock.Anything, mock.Anything, []db_queries.CreateJobConnectionDestinationsParams{
 		{
@@ -853,6 +895,7 @@ func Test_CreateJobDestinationConnections_ConnectionNotInAccount(t *testing.T) {
 	destConnAssociation := mockJobDestConnAssociation(job.ID, destConn.ID, &pg_models.JobDestinationOptions{})
 
 	mockGetJob(m.UserAccountServiceMock, m.QuerierMock, job, []db_queries.NeosyncApiJobDestinationConnectionAssociation{destConnAssociation})
+	m.QuerierMock.On("GetAccountJobDefaults", mock.Anything, mock.Anything, job.AccountID).Return(&pg_models.AccountJobDefaults{}, nil)
 	m.QuerierMock.On("GetConnectionsByIds", mock.Anything, mock.Anything, []pgtype.UUID{destConn.ID}).Return([]db_queries.NeosyncApiConnection{destConn}, nil)
 
 	jobId := nucleusdb.UUIDString(job.ID)
@@ -974,6 +1017,86 @@ func Test_PauseJob_UnPause(t *testing.T) {
 	require.NotNil(t, resp)
 }
 
+// ResumeJob
+func Test_ResumeJob(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	mockHandle := new(MockScheduleHandle)
+
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	destConn := getConnectionMock(mockAccountId, "test-1")
+	destConnAssociation := mockJobDestConnAssociation(job.ID, destConn.ID, &pg_models.JobDestinationOptions{})
+	jobId := nucleusdb.UUIDString(job.ID)
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetJob(m.UserAccountServiceMock, m.QuerierMock, job, []db_queries.NeosyncApiJobDestinationConnectionAssociation{destConnAssociation})
+	m.TemporalWfManagerMock.On("GetScheduleHandleClientByAccount", mock.Anything, mockAccountId, jobId, mock.Anything).Return(mockHandle, nil)
+	mockHandle.On("Unpause", mock.Anything, temporal.ScheduleUnpauseOptions{Note: "back on"}).Return(nil)
+
+	note := "back on"
+	resp, err := m.Service.ResumeJob(context.Background(), &connect.Request[mgmtv1alpha1.ResumeJobRequest]{
+		Msg: &mgmtv1alpha1.ResumeJobRequest{
+			Id:   jobId,
+			Note: &note,
+		},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, jobId, resp.Msg.Job.Id)
+	mockHandle.AssertNotCalled(t, "Pause", mock.Anything, mock.Anything)
+}
+
+// GetNextScheduledRuns
+func Test_GetNextScheduledRuns(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	mockHandle := new(MockScheduleHandle)
+	temporalClientMock := new(MockTemporalClient)
+	workflowServiceMock := new(MockWorkflowServiceClient)
+
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	jobId := nucleusdb.UUIDString(job.ID)
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.TemporalWfManagerMock.On("GetScheduleHandleClientByAccount", mock.Anything, mockAccountId, jobId, mock.Anything).Return(mockHandle, nil)
+	mockHandle.On("Describe", mock.Anything).Return(&temporal.ScheduleDescription{
+		Schedule: temporal.Schedule{
+			State: &temporal.ScheduleState{
+				Paused: true,
+			},
+		},
+	}, nil)
+	m.TemporalWfManagerMock.On("GetWorkflowClientByAccount", mock.Anything, mockAccountId, mock.Anything).Return(temporalClientMock, nil)
+	m.TemporalWfManagerMock.On("GetTemporalConfigByAccount", mock.Anything, mockAccountId).Return(&pg_models.TemporalConfig{
+		Namespace:        "default",
+		SyncJobQueueName: "sync-job",
+		Url:              "localhost:7233",
+	}, nil)
+	temporalClientMock.On("WorkflowService").Return(workflowServiceMock)
+
+	start := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	matchingTimes := []*timestamppb.Timestamp{}
+	for i := 0; i < 5; i++ {
+		matchingTimes = append(matchingTimes, timestamppb.New(start.Add(time.Duration(i)*time.Hour)))
+	}
+	workflowServiceMock.On("ListScheduleMatchingTimes", mock.Anything, mock.MatchedBy(func(req *workflowservice.ListScheduleMatchingTimesRequest) bool {
+		return req.Namespace == "default" && req.ScheduleId == jobId && req.EndTime.AsTime().After(req.StartTime.AsTime())
+	})).Return(&workflowservice.ListScheduleMatchingTimesResponse{StartTime: matchingTimes}, nil)
+
+	count := uint32(3)
+	resp, err := m.Service.GetNextScheduledRuns(context.Background(), &connect.Request[mgmtv1alpha1.GetNextScheduledRunsRequest]{
+		Msg: &mgmtv1alpha1.GetNextScheduledRunsRequest{
+			JobId: jobId,
+			Count: &count,
+		},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.True(t, resp.Msg.Paused)
+	require.Equal(t, matchingTimes[:3], resp.Msg.NextRunTimes)
+}
+
 // UpdateJobSourceConnection
 func Test_UpdateJobSourceConnection_Success(t *testing.T) {
 	m := createServiceMock(t, &Config{IsAuthEnabled: true})
@@ -1315,6 +1438,40 @@ func Test_UpdateJobSourceConnection_AwsS3MismatchError(t *testing.T) {
 }
 
 // SetJobSourceSqlConnectionSubsets
+func Test_UpdateJobSourceConnection_SynthesizeCtganMissingUrl(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+
+	_, err := m.Service.UpdateJobSourceConnection(context.Background(), &connect.Request[mgmtv1alpha1.UpdateJobSourceConnectionRequest]{
+		Msg: &mgmtv1alpha1.UpdateJobSourceConnectionRequest{
+			Id: nucleusdb.UUIDString(job.ID),
+			Source: &mgmtv1alpha1.JobSource{
+				Options: &mgmtv1alpha1.JobSourceOptions{
+					Config: &mgmtv1alpha1.JobSourceOptions_Synthesize{
+						Synthesize: &mgmtv1alpha1.SynthesizeSourceOptions{
+							ConnectionId: uuid.NewString(),
+							Model:        mgmtv1alpha1.SynthesizerModel_SYNTHESIZER_MODEL_CTGAN,
+							Schemas: []*mgmtv1alpha1.SynthesizeSourceSchemaOption{
+								{Schema: "schema-1", Tables: []*mgmtv1alpha1.SynthesizeSourceTableOption{
+									{Table: "table-1", RowCount: 10},
+								}},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	require.Error(t, err)
+	m.QuerierMock.AssertNotCalled(t, "UpdateJobSource", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func Test_SetJobSourceSqlConnectionSubsets_Invalid_Connection_No_ConnectionId(t *testing.T) {
 	m := createServiceMock(t, &Config{IsAuthEnabled: true})
 	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
@@ -1576,7 +1733,7 @@ func createServiceMock(t *testing.T, config *Config) *serviceMocks {
 	mockSqlDb := sql_manager.NewMockSqlDatabase(t)
 	mockSqlManager := sql_manager.NewMockSqlManagerClient(t)
 
-	service := New(config, nucleusdb.New(mockDbtx, mockQuerier), mockTemporalWfManager, mockConnectionService, mockUserAccountService, mockSqlManager)
+	service := New(config, nucleusdb.New(mockDbtx, mockQuerier), mockTemporalWfManager, mockConnectionService, mockUserAccountService, mockSqlManager, nil)
 
 	return &serviceMocks{
 		Service:                     service,
@@ -1961,6 +2118,175 @@ func Test_ValidateJobMappings_ValidationErrors(t *testing.T) {
 	}
 }
 
+func Test_ValidateJobMappings_DroppedKeyColumns(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	conn := getConnectionMock(mockAccountId, "test-4")
+	connId := nucleusdb.UUIDString(conn.ID)
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+
+	m.ConnectionServiceClientMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: &mgmtv1alpha1.Connection{
+			Id: connId,
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{
+					PgConfig: &mgmtv1alpha1.PostgresConnectionConfig{},
+				},
+			},
+		},
+	}), nil)
+
+	m.SqlManagerMock.On("NewSqlDb", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&sql_manager.SqlConnection{Db: m.SqlDbMock, Driver: sql_manager.PostgresDriver}, nil)
+	m.SqlDbMock.On("Close").Return(nil)
+	m.SqlDbMock.On("GetSchemaColumnMap", mock.Anything).Return(map[string]map[string]*sql_manager.ColumnInfo{
+		"public.users":  {"id": &sql_manager.ColumnInfo{IsNullable: true}, "ssn": &sql_manager.ColumnInfo{IsNullable: true}},
+		"public.orders": {"id": &sql_manager.ColumnInfo{IsNullable: true}, "buyer_id": &sql_manager.ColumnInfo{IsNullable: true}},
+	}, nil)
+	m.SqlDbMock.On("GetTableConstraintsBySchema", mock.Anything, mock.Anything).Return(&sql_manager.TableConstraints{
+		ForeignKeyConstraints: map[string][]*sql_manager.ForeignConstraint{
+			"public.orders": {{Columns: []string{"buyer_id"}, NotNullable: []bool{false}, ForeignKey: &sql_manager.ForeignKey{Table: "public.users", Columns: []string{"id"}}}},
+		},
+		PrimaryKeyConstraints: map[string][]string{"public.users": {"id"}, "public.orders": {"id"}},
+	}, nil)
+
+	dropTransformer := &mgmtv1alpha1.JobMappingTransformer{
+		Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_DROP_COLUMN,
+		Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_DropColumnConfig{DropColumnConfig: &mgmtv1alpha1.DropColumn{}}},
+	}
+	passthrough := &mgmtv1alpha1.JobMappingTransformer{
+		Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH,
+		Config: &mgmtv1alpha1.TransformerConfig{},
+	}
+	resp, err := m.Service.ValidateJobMappings(context.Background(), &connect.Request[mgmtv1alpha1.ValidateJobMappingsRequest]{
+		Msg: &mgmtv1alpha1.ValidateJobMappingsRequest{
+			AccountId:    mockAccountId,
+			ConnectionId: connId,
+			Mappings: []*mgmtv1alpha1.JobMapping{
+				{Schema: "public", Table: "users", Column: "id", Transformer: dropTransformer},
+				{Schema: "public", Table: "users", Column: "ssn", Transformer: dropTransformer},
+				{Schema: "public", Table: "orders", Column: "id", Transformer: passthrough},
+				{Schema: "public", Table: "orders", Column: "buyer_id", Transformer: dropTransformer},
+			},
+		},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.ElementsMatch(t, []*mgmtv1alpha1.ColumnError{
+		{
+			Schema: "public",
+			Table:  "users",
+			Column: "id",
+			Errors: []string{"Key columns can not be dropped. Table: public.users  Column: id"},
+		},
+		{
+			Schema: "public",
+			Table:  "orders",
+			Column: "buyer_id",
+			Errors: []string{"Key columns can not be dropped. Table: public.orders  Column: buyer_id"},
+		},
+	}, resp.Msg.ColumnErrors)
+	require.Empty(t, resp.Msg.DatabaseErrors.Errors)
+}
+
+func Test_ValidateJobMappings_DestinationViolations(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	sourceId := uuid.NewString()
+	destinationId := uuid.NewString()
+	bucketId := uuid.NewString()
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+
+	pgConnection := func(id string) *connect.Response[mgmtv1alpha1.GetConnectionResponse] {
+		return connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+			Connection: &mgmtv1alpha1.Connection{
+				Id: id,
+				ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+					Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{
+						PgConfig: &mgmtv1alpha1.PostgresConnectionConfig{},
+					},
+				},
+			},
+		})
+	}
+	isConnection := func(id string) any {
+		return mock.MatchedBy(func(req *connect.Request[mgmtv1alpha1.GetConnectionRequest]) bool {
+			return req.Msg.GetId() == id
+		})
+	}
+	m.ConnectionServiceClientMock.On("GetConnection", mock.Anything, isConnection(sourceId)).Return(pgConnection(sourceId), nil)
+	m.ConnectionServiceClientMock.On("GetConnection", mock.Anything, isConnection(destinationId)).Return(pgConnection(destinationId), nil)
+	m.ConnectionServiceClientMock.On("GetConnection", mock.Anything, isConnection(bucketId)).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: &mgmtv1alpha1.Connection{
+			Id: bucketId,
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_AwsS3Config{
+					AwsS3Config: &mgmtv1alpha1.AwsS3ConnectionConfig{},
+				},
+			},
+		},
+	}), nil)
+
+	m.SqlManagerMock.On("NewSqlDb", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&sql_manager.SqlConnection{Db: m.SqlDbMock, Driver: sql_manager.PostgresDriver}, nil)
+	m.SqlDbMock.On("Close").Return(nil)
+	m.SqlDbMock.On("GetSchemaColumnMap", mock.Anything).Return(map[string]map[string]*sql_manager.ColumnInfo{
+		"public.users": {"id": &sql_manager.ColumnInfo{DataType: "uuid"}, "age": &sql_manager.ColumnInfo{DataType: "text", IsNullable: true}},
+	}, nil).Once()
+	m.SqlDbMock.On("GetSchemaColumnMap", mock.Anything).Return(map[string]map[string]*sql_manager.ColumnInfo{
+		"public.users": {
+			"id":     &sql_manager.ColumnInfo{DataType: "uuid"},
+			"age":    &sql_manager.ColumnInfo{DataType: "integer", IsNullable: true},
+			"org_id": &sql_manager.ColumnInfo{DataType: "uuid"},
+		},
+	}, nil).Once()
+	m.SqlDbMock.On("GetTableConstraintsBySchema", mock.Anything, mock.Anything).Return(&sql_manager.TableConstraints{
+		ForeignKeyConstraints: map[string][]*sql_manager.ForeignConstraint{},
+		PrimaryKeyConstraints: map[string][]string{"public.users": {"id"}},
+	}, nil)
+
+	passthrough := &mgmtv1alpha1.JobMappingTransformer{
+		Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH,
+		Config: &mgmtv1alpha1.TransformerConfig{},
+	}
+	resp, err := m.Service.ValidateJobMappings(context.Background(), &connect.Request[mgmtv1alpha1.ValidateJobMappingsRequest]{
+		Msg: &mgmtv1alpha1.ValidateJobMappingsRequest{
+			AccountId:                mockAccountId,
+			ConnectionId:             sourceId,
+			DestinationConnectionIds: []string{destinationId, bucketId},
+			Mappings: []*mgmtv1alpha1.JobMapping{
+				{Schema: "public", Table: "users", Column: "id", Transformer: passthrough},
+				{Schema: "public", Table: "users", Column: "age", Transformer: passthrough},
+			},
+		},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Empty(t, resp.Msg.DatabaseErrors.Errors)
+	require.Len(t, resp.Msg.Violations, 2)
+	require.Equal(t, mgmtv1alpha1.JobMappingViolationType_JOB_MAPPING_VIOLATION_TYPE_TYPE_MISMATCH, resp.Msg.Violations[0].GetType())
+	require.Equal(t, "age", resp.Msg.Violations[0].GetColumn())
+	require.Equal(t, mgmtv1alpha1.JobMappingViolationType_JOB_MAPPING_VIOLATION_TYPE_UNMAPPED_REQUIRED_COLUMN, resp.Msg.Violations[1].GetType())
+	require.Equal(t, "org_id", resp.Msg.Violations[1].GetColumn())
+	for _, violation := range resp.Msg.Violations {
+		require.Equal(t, destinationId, violation.GetConnectionId())
+	}
+	require.ElementsMatch(t, []*mgmtv1alpha1.ColumnError{
+		{
+			Schema: "public",
+			Table:  "users",
+			Column: "age",
+			Errors: []string{resp.Msg.Violations[0].GetMessage()},
+		},
+		{
+			Schema: "public",
+			Table:  "users",
+			Column: "org_id",
+			Errors: []string{resp.Msg.Violations[1].GetMessage()},
+		},
+	}, resp.Msg.ColumnErrors)
+}
+
 func ptr[T any](val T) *T {
 	return &val
 }
@@ -10,6 +10,7 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	temporalclient "go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/converter"
+	"google.golang.org/grpc"
 )
 
 // MockTemporalClient is a mock of temporal client interface.
@@ -185,6 +186,21 @@ func (m *MockTemporalClient) Close() {
 	m.Called()
 }
 
+// MockWorkflowServiceClient is a mock of the WorkflowServiceClient interface.
+// Only the methods used by the job service are mocked; calling any other method panics.
+type MockWorkflowServiceClient struct {
+	workflowservice.WorkflowServiceClient
+	mock.Mock
+}
+
+func (m *MockWorkflowServiceClient) ListScheduleMatchingTimes(ctx context.Context, in *workflowservice.ListScheduleMatchingTimesRequest, opts ...grpc.CallOption) (*workflowservice.ListScheduleMatchingTimesResponse, error) {
+	args := m.Called(ctx, in)
+	if r := args.Get(0); r != nil {
+		return r.(*workflowservice.ListScheduleMatchingTimesResponse), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
 // MockScheduleHandle is a mock of ScheduleHandle interface.
 type MockScheduleHandle struct {
 	mock.Mock
@@ -0,0 +1,210 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	datasync_workflow "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/workflow"
+	workflowpb "go.temporal.io/api/workflow/v1"
+)
+
+const (
+	// Schedule triggers can not carry arguments, so a run claims the oldest pending request of its job when it starts.
+	// Requests that have not been claimed within this window are considered abandoned.
+	jobRunRequestClaimWindow = 10 * time.Minute
+	// Batch runs younger than this are not preempted as they have not had a chance to make progress
+	minPreemptibleRunAge = 10 * time.Minute
+	// How long a preempted batch run holds off on starting new tables
+	preemptYieldDuration = 15 * time.Minute
+)
+
+func (s *Service) GetJobRunPriority(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetJobRunPriorityRequest],
+) (*connect.Response[mgmtv1alpha1.GetJobRunPriorityResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("jobId", req.Msg.GetJobId(), "jobRunId", req.Msg.GetJobRunId())
+	jobUuid, err := nucleusdb.ToUuid(req.Msg.GetJobId())
+	if err != nil {
+		return nil, err
+	}
+	job, err := s.db.Q.GetJobById(ctx, s.db.Db, jobUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return nil, nucleuserrors.NewNotFound("unable to find job by id")
+	}
+	_, err = s.verifyUserInAccount(ctx, nucleusdb.UUIDString(job.AccountID))
+	if err != nil {
+		return nil, err
+	}
+
+	runRequest, err := s.claimJobRunRequest(ctx, job.ID, req.Msg.GetJobRunId())
+	if err != nil {
+		return nil, err
+	}
+	if runRequest == nil {
+		// runs that were not explicitly requested, such as those fired by the job's schedule, are batch runs
+		return connect.NewResponse(&mgmtv1alpha1.GetJobRunPriorityResponse{
+			Priority: mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_BATCH,
+		}), nil
+	}
+	priority := toJobRunPriority(runRequest.Priority)
+	logger.Info("job run claimed run request", "priority", priority.String())
+	return connect.NewResponse(&mgmtv1alpha1.GetJobRunPriorityResponse{
+		Priority: priority,
+	}), nil
+}
+
+// Returns the run request that was claimed by the job run, claiming the job's oldest pending request if it has not done so yet.
+// Returns nil if the run was not explicitly requested, such as those fired by the job's schedule.
+func (s *Service) claimJobRunRequest(
+	ctx context.Context,
+	jobUuid pgtype.UUID,
+	jobRunId string,
+) (*db_queries.NeosyncApiJobRunRequest, error) {
+	jobRunText := pgtype.Text{String: jobRunId, Valid: true}
+	// the run may have already claimed its request on a previous attempt or through another call
+	runRequest, err := s.db.Q.GetJobRunRequestByJobRun(ctx, s.db.Db, jobRunText)
+	if err == nil {
+		return &runRequest, nil
+	} else if !nucleusdb.IsNoRows(err) {
+		return nil, fmt.Errorf("unable to retrieve job run request: %w", err)
+	}
+
+	createdAfter, err := nucleusdb.ToTimestamp(time.Now().UTC().Add(-jobRunRequestClaimWindow))
+	if err != nil {
+		return nil, err
+	}
+	runRequest, err = s.db.Q.ClaimJobRunRequest(ctx, s.db.Db, db_queries.ClaimJobRunRequestParams{
+		JobRunId:     jobRunText,
+		JobId:        jobUuid,
+		CreatedAfter: createdAfter,
+	})
+	if err != nil && nucleusdb.IsNoRows(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to claim job run request: %w", err)
+	}
+	return &runRequest, nil
+}
+
+// Asks the long-running batch runs in the account to yield at their next table boundary.
+// Failures are logged as preemption is best effort and must not fail the run that requested it.
+func (s *Service) preemptBatchRuns(
+	ctx context.Context,
+	logger *slog.Logger,
+	accountId string,
+) {
+	workflows, err := s.getPreemptibleJobRuns(ctx, logger, accountId)
+	if err != nil {
+		logger.Warn(fmt.Sprintf("unable to retrieve preemptible job runs: %s", err.Error()))
+		return
+	}
+	if len(workflows) == 0 {
+		return
+	}
+	tclient, err := s.temporalWfManager.GetWorkflowClientByAccount(ctx, accountId, logger)
+	if err != nil {
+		logger.Warn(fmt.Sprintf("unable to retrieve temporal client: %s", err.Error()))
+		return
+	}
+	for _, wf := range workflows {
+		err := tclient.SignalWorkflow(
+			ctx,
+			wf.GetExecution().GetWorkflowId(),
+			wf.GetExecution().GetRunId(),
+			datasync_workflow.PreemptSignalName,
+			&datasync_workflow.PreemptSignal{YieldFor: preemptYieldDuration},
+		)
+		if err != nil {
+			logger.Warn(fmt.Sprintf("unable to preempt job run %s: %s", wf.GetExecution().GetWorkflowId(), err.Error()))
+			continue
+		}
+		logger.Info("preempted batch job run", "preemptedJobRunId", wf.GetExecution().GetWorkflowId())
+	}
+}
+
+func (s *Service) getPreemptibleJobRuns(
+	ctx context.Context,
+	logger *slog.Logger,
+	accountId string,
+) ([]*workflowpb.WorkflowExecutionInfo, error) {
+	accountUuid, err := nucleusdb.ToUuid(accountId)
+	if err != nil {
+		return nil, err
+	}
+	jobs, err := s.db.Q.GetJobsByAccount(ctx, s.db.Db, accountUuid)
+	if err != nil {
+		return nil, err
+	}
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+	jobIds := make([]string, 0, len(jobs))
+	for idx := range jobs {
+		jobIds = append(jobIds, nucleusdb.UUIDString(jobs[idx].ID))
+	}
+
+	tclient, err := s.temporalWfManager.GetWorkflowClientByAccount(ctx, accountId, logger)
+	if err != nil {
+		return nil, err
+	}
+	tconfig, err := s.temporalWfManager.GetTemporalConfigByAccount(ctx, accountId)
+	if err != nil {
+		return nil, err
+	}
+	running, err := listWorkflowExecutions(ctx, tclient, tconfig.Namespace, fmt.Sprintf("%s AND ExecutionStatus = 'Running'", buildJobIdsWorkflowQuery(jobIds)))
+	if err != nil {
+		return nil, err
+	}
+
+	startedBefore := time.Now().Add(-minPreemptibleRunAge)
+	candidates := []*workflowpb.WorkflowExecutionInfo{}
+	candidateIds := []string{}
+	for _, wf := range running {
+		if wf.GetStartTime() == nil || wf.GetStartTime().AsTime().After(startedBefore) {
+			continue
+		}
+		candidates = append(candidates, wf)
+		candidateIds = append(candidateIds, wf.GetExecution().GetWorkflowId())
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	runRequests, err := s.db.Q.GetJobRunRequestsByJobRuns(ctx, s.db.Db, candidateIds)
+	if err != nil {
+		return nil, err
+	}
+	interactive := map[string]struct{}{}
+	for idx := range runRequests {
+		if toJobRunPriority(runRequests[idx].Priority) == mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_INTERACTIVE {
+			interactive[runRequests[idx].JobRunID.String] = struct{}{}
+		}
+	}
+	preemptible := make([]*workflowpb.WorkflowExecutionInfo, 0, len(candidates))
+	for _, wf := range candidates {
+		if _, ok := interactive[wf.GetExecution().GetWorkflowId()]; ok {
+			continue
+		}
+		preemptible = append(preemptible, wf)
+	}
+	return preemptible, nil
+}
+
+func toJobRunPriority(priority int16) mgmtv1alpha1.JobRunPriority {
+	if priority == int16(mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_INTERACTIVE) {
+		return mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_INTERACTIVE
+	}
+	return mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_BATCH
+}
@@ -0,0 +1,210 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	clientmanager "github.com/nucleuscloud/neosync/backend/internal/temporal/client-manager"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	datasync_workflow "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/workflow"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	temporalclient "go.temporal.io/sdk/client"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateJobRun
+func Test_CreateJobRun_Interactive_PreemptsBatchRuns(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	mockHandle := new(MockScheduleHandle)
+	temporalClientMock := new(MockTemporalClient)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	otherJob := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+
+	batchRun := mockRunningWorkflow(nucleusdb.UUIDString(otherJob.ID), uuid.NewString(), time.Now().Add(-1*time.Hour))
+	interactiveRun := mockRunningWorkflow(nucleusdb.UUIDString(otherJob.ID), uuid.NewString(), time.Now().Add(-1*time.Hour))
+	youngRun := mockRunningWorkflow(nucleusdb.UUIDString(otherJob.ID), uuid.NewString(), time.Now())
+
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.TemporalWfManagerMock.On("GetScheduleHandleClientByAccount", mock.Anything, mockAccountId, nucleusdb.UUIDString(job.ID), mock.Anything).Return(mockHandle, nil)
+	m.QuerierMock.On("CreateJobRunRequest", mock.Anything, mock.Anything, db_queries.CreateJobRunRequestParams{
+		JobID:       job.ID,
+		Priority:    int16(mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_INTERACTIVE),
+		CreatedByID: userUuid,
+		Parameters:  []*pg_models.JobParameterValue{},
+	}).Return(mockJobRunRequest(job.ID, mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_INTERACTIVE, ""), nil)
+	mockHandle.On("Trigger", mock.Anything, temporalclient.ScheduleTriggerOptions{}).Return(nil)
+
+	m.QuerierMock.On("GetJobsByAccount", mock.Anything, mock.Anything, accountUuid).Return([]db_queries.NeosyncApiJob{job, otherJob}, nil)
+	mockListRunningWorkflows(m.TemporalWfManagerMock, accountUuid, temporalClientMock, []*workflowpb.WorkflowExecutionInfo{batchRun, interactiveRun, youngRun})
+	m.QuerierMock.On("GetJobRunRequestsByJobRuns", mock.Anything, mock.Anything, []string{batchRun.Execution.WorkflowId, interactiveRun.Execution.WorkflowId}).
+		Return([]db_queries.NeosyncApiJobRunRequest{
+			mockJobRunRequest(otherJob.ID, mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_INTERACTIVE, interactiveRun.Execution.WorkflowId),
+		}, nil)
+	temporalClientMock.On("SignalWorkflow", mock.Anything, batchRun.Execution.WorkflowId, batchRun.Execution.RunId, datasync_workflow.PreemptSignalName, &datasync_workflow.PreemptSignal{YieldFor: preemptYieldDuration}).Return(nil)
+
+	resp, err := m.Service.CreateJobRun(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateJobRunRequest{
+		JobId:            nucleusdb.UUIDString(job.ID),
+		Priority:         mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_INTERACTIVE,
+		PreemptBatchRuns: true,
+	}))
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	temporalClientMock.AssertNumberOfCalls(t, "SignalWorkflow", 1)
+}
+
+func Test_CreateJobRun_TriggerFailed_RemovesRunRequest(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	mockHandle := new(MockScheduleHandle)
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	runRequest := mockJobRunRequest(job.ID, mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_BATCH, "")
+
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.TemporalWfManagerMock.On("GetScheduleHandleClientByAccount", mock.Anything, mockAccountId, nucleusdb.UUIDString(job.ID), mock.Anything).Return(mockHandle, nil)
+	m.QuerierMock.On("CreateJobRunRequest", mock.Anything, mock.Anything, mock.Anything).Return(runRequest, nil)
+	mockHandle.On("Trigger", mock.Anything, temporalclient.ScheduleTriggerOptions{}).Return(sql.ErrConnDone)
+	m.QuerierMock.On("RemoveJobRunRequest", mock.Anything, mock.Anything, runRequest.ID).Return(nil)
+
+	resp, err := m.Service.CreateJobRun(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateJobRunRequest{
+		JobId:    nucleusdb.UUIDString(job.ID),
+		Priority: mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_BATCH,
+	}))
+
+	require.Error(t, err)
+	require.Nil(t, resp)
+	m.QuerierMock.AssertCalled(t, "RemoveJobRunRequest", mock.Anything, mock.Anything, runRequest.ID)
+}
+
+func Test_CreateJobRun_Preempt_NotInteractive(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+
+	resp, err := m.Service.CreateJobRun(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateJobRunRequest{
+		JobId:            nucleusdb.UUIDString(job.ID),
+		Priority:         mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_BATCH,
+		PreemptBatchRuns: true,
+	}))
+
+	require.Error(t, err)
+	require.Nil(t, resp)
+}
+
+// GetJobRunPriority
+func Test_GetJobRunPriority_ClaimsRunRequest(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	jobRunId := uuid.NewString()
+	jobRunText := pgtype.Text{String: jobRunId, Valid: true}
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetJobRunRequestByJobRun", mock.Anything, mock.Anything, jobRunText).Return(db_queries.NeosyncApiJobRunRequest{}, sql.ErrNoRows)
+	m.QuerierMock.On("ClaimJobRunRequest", mock.Anything, mock.Anything, mock.MatchedBy(func(arg db_queries.ClaimJobRunRequestParams) bool {
+		return arg.JobRunId == jobRunText && arg.JobId == job.ID && arg.CreatedAfter.Time.Before(time.Now())
+	})).Return(mockJobRunRequest(job.ID, mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_INTERACTIVE, jobRunId), nil)
+
+	resp, err := m.Service.GetJobRunPriority(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobRunPriorityRequest{
+		JobId:    nucleusdb.UUIDString(job.ID),
+		JobRunId: jobRunId,
+	}))
+
+	require.NoError(t, err)
+	require.Equal(t, mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_INTERACTIVE, resp.Msg.GetPriority())
+}
+
+func Test_GetJobRunPriority_AlreadyClaimed(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	jobRunId := uuid.NewString()
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetJobRunRequestByJobRun", mock.Anything, mock.Anything, pgtype.Text{String: jobRunId, Valid: true}).
+		Return(mockJobRunRequest(job.ID, mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_INTERACTIVE, jobRunId), nil)
+
+	resp, err := m.Service.GetJobRunPriority(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobRunPriorityRequest{
+		JobId:    nucleusdb.UUIDString(job.ID),
+		JobRunId: jobRunId,
+	}))
+
+	require.NoError(t, err)
+	require.Equal(t, mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_INTERACTIVE, resp.Msg.GetPriority())
+	m.QuerierMock.AssertNotCalled(t, "ClaimJobRunRequest", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_GetJobRunPriority_NoRunRequest(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetJobRunRequestByJobRun", mock.Anything, mock.Anything, mock.Anything).Return(db_queries.NeosyncApiJobRunRequest{}, sql.ErrNoRows)
+	m.QuerierMock.On("ClaimJobRunRequest", mock.Anything, mock.Anything, mock.Anything).Return(db_queries.NeosyncApiJobRunRequest{}, sql.ErrNoRows)
+
+	resp, err := m.Service.GetJobRunPriority(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobRunPriorityRequest{
+		JobId:    nucleusdb.UUIDString(job.ID),
+		JobRunId: uuid.NewString(),
+	}))
+
+	require.NoError(t, err)
+	require.Equal(t, mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_BATCH, resp.Msg.GetPriority())
+}
+
+func mockJobRunRequest(jobUuid pgtype.UUID, priority mgmtv1alpha1.JobRunPriority, jobRunId string) db_queries.NeosyncApiJobRunRequest {
+	id, _ := nucleusdb.ToUuid(uuid.NewString())
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	return db_queries.NeosyncApiJobRunRequest{
+		ID:          id,
+		JobID:       jobUuid,
+		Priority:    int16(priority),
+		JobRunID:    pgtype.Text{String: jobRunId, Valid: jobRunId != ""},
+		CreatedByID: userUuid,
+		CreatedAt:   pgtype.Timestamp{Time: time.Now(), Valid: true},
+	}
+}
+
+func mockRunningWorkflow(jobId, workflowId string, startTime time.Time) *workflowpb.WorkflowExecutionInfo {
+	wf := getWorfklowExecutionInfoMock(jobId, workflowId)
+	wf.StartTime = timestamppb.New(startTime)
+	wf.CloseTime = nil
+	wf.Status = enums.WORKFLOW_EXECUTION_STATUS_RUNNING
+	wf.Execution.RunId = uuid.NewString()
+	return wf
+}
+
+// mocks the calls made when listing the running workflows of an account without verifying a specific job run
+func mockListRunningWorkflows(
+	temporalWfManagerMock *clientmanager.MockTemporalClientManagerClient,
+	accountUuid pgtype.UUID,
+	temporalClientMock *MockTemporalClient,
+	workflowsMock []*workflowpb.WorkflowExecutionInfo,
+) {
+	temporalWfManagerMock.On("GetTemporalConfigByAccount", mock.Anything, nucleusdb.UUIDString(accountUuid)).Return(&pg_models.TemporalConfig{
+		Namespace:        "default",
+		SyncJobQueueName: "sync-job",
+		Url:              "localhost:7233",
+	}, nil)
+	temporalWfManagerMock.On("GetWorkflowClientByAccount", mock.Anything, nucleusdb.UUIDString(accountUuid), mock.Anything).Return(temporalClientMock, nil)
+	temporalClientMock.On("ListWorkflow", mock.Anything, mock.Anything).Return(&workflowservice.ListWorkflowExecutionsResponse{
+		Executions: workflowsMock,
+	}, nil)
+}
@@ -0,0 +1,131 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	temporalclient "go.temporal.io/sdk/client"
+)
+
+// How often temporal is checked for run status changes while a stream is open
+var jobRunStatusPollInterval = 3 * time.Second
+
+func (s *Service) StreamJobRunStatuses(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.StreamJobRunStatusesRequest],
+	stream *connect.ServerStream[mgmtv1alpha1.StreamJobRunStatusesResponse],
+) error {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("accountId", req.Msg.GetAccountId())
+
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return err
+	}
+	if req.Msg.JobId != nil {
+		jobUuid, err := nucleusdb.ToUuid(req.Msg.GetJobId())
+		if err != nil {
+			return err
+		}
+		job, err := s.db.Q.GetJobById(ctx, s.db.Db, jobUuid)
+		if err != nil && !nucleusdb.IsNoRows(err) {
+			return err
+		} else if err != nil || job.AccountID != *accountUuid {
+			return nucleuserrors.NewNotFound("unable to find job by id")
+		}
+	}
+
+	tclient, err := s.temporalWfManager.GetWorkflowClientByAccount(ctx, req.Msg.GetAccountId(), logger)
+	if err != nil {
+		return err
+	}
+	tconfig, err := s.temporalWfManager.GetTemporalConfigByAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return err
+	}
+
+	return s.watchJobRunStatuses(ctx, tclient, tconfig.Namespace, *accountUuid, req.Msg.JobId, logger, func(run *mgmtv1alpha1.JobRun) error {
+		return stream.Send(&mgmtv1alpha1.StreamJobRunStatusesResponse{JobRun: run})
+	})
+}
+
+// Sends the current state of every run, followed by every run whose status changes, until the context is canceled.
+// After the first poll only runs that are in progress or that started or closed since the previous poll are retrieved
+func (s *Service) watchJobRunStatuses(
+	ctx context.Context,
+	tclient temporalclient.Client,
+	namespace string,
+	accountUuid pgtype.UUID,
+	jobId *string,
+	logger *slog.Logger,
+	send func(run *mgmtv1alpha1.JobRun) error,
+) error {
+	statuses := map[string]mgmtv1alpha1.JobRunStatus{}
+	var since time.Time
+	ticker := time.NewTicker(jobRunStatusPollInterval)
+	defer ticker.Stop()
+	for {
+		jobIds, err := s.getAccountJobIds(ctx, accountUuid, jobId)
+		if err != nil {
+			return err
+		}
+		pollStartedAt := time.Now()
+		if len(jobIds) > 0 {
+			query := buildJobIdsWorkflowQuery(jobIds)
+			if !since.IsZero() {
+				ts := since.UTC().Format(time.RFC3339Nano)
+				query = fmt.Sprintf("%s AND (ExecutionStatus = 'Running' OR StartTime >= %q OR CloseTime >= %q)", query, ts, ts)
+			}
+			workflows, err := listWorkflowExecutions(ctx, tclient, namespace, query)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+			for _, workflow := range workflows {
+				run := dtomaps.ToJobRunDtoFromWorkflowExecutionInfo(workflow, logger)
+				if status, ok := statuses[run.Id]; ok && status == run.Status {
+					continue
+				}
+				statuses[run.Id] = run.Status
+				if err := send(run); err != nil {
+					return err
+				}
+			}
+		}
+		// overlaps with the previous poll so that runs are not missed due to visibility delays
+		since = pollStartedAt.Add(-jobRunStatusPollInterval)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Returns the account's job ids, re-read on every poll so that runs of newly created jobs are streamed
+func (s *Service) getAccountJobIds(ctx context.Context, accountUuid pgtype.UUID, jobId *string) ([]string, error) {
+	if jobId != nil {
+		return []string{*jobId}, nil
+	}
+	jobs, err := s.db.Q.GetJobsByAccount(ctx, s.db.Db, accountUuid)
+	if err != nil {
+		return nil, err
+	}
+	jobIds := make([]string, 0, len(jobs))
+	for idx := range jobs {
+		jobIds = append(jobIds, nucleusdb.UUIDString(jobs[idx].ID))
+	}
+	return jobIds, nil
+}
@@ -0,0 +1,71 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+)
+
+func Test_watchJobRunStatuses(t *testing.T) {
+	jobRunStatusPollInterval = 10 * time.Millisecond
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	temporalClientMock := new(MockTemporalClient)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	jobId := uuid.NewString()
+
+	running := getWorfklowExecutionInfoMock(jobId, "run-1")
+	running.Status = enums.WORKFLOW_EXECUTION_STATUS_RUNNING
+	completed := getWorfklowExecutionInfoMock(jobId, "run-1")
+	completed.Status = enums.WORKFLOW_EXECUTION_STATUS_COMPLETED
+
+	temporalClientMock.On("ListWorkflow", mock.Anything, mock.MatchedBy(func(req *workflowservice.ListWorkflowExecutionsRequest) bool {
+		return !strings.Contains(req.Query, "ExecutionStatus")
+	})).Return(&workflowservice.ListWorkflowExecutionsResponse{Executions: []*workflowpb.WorkflowExecutionInfo{running}}, nil).Once()
+	temporalClientMock.On("ListWorkflow", mock.Anything, mock.MatchedBy(func(req *workflowservice.ListWorkflowExecutionsRequest) bool {
+		return strings.Contains(req.Query, "ExecutionStatus = 'Running'")
+	})).Return(&workflowservice.ListWorkflowExecutionsResponse{Executions: []*workflowpb.WorkflowExecutionInfo{completed}}, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	sent := []*mgmtv1alpha1.JobRun{}
+	err := m.Service.watchJobRunStatuses(ctx, temporalClientMock, "default", accountUuid, &jobId, slog.Default(), func(run *mgmtv1alpha1.JobRun) error {
+		sent = append(sent, run)
+		if len(sent) == 2 {
+			cancel()
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, sent, 2)
+	require.Equal(t, mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_RUNNING, sent[0].Status)
+	require.Equal(t, mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_COMPLETE, sent[1].Status)
+}
+
+func Test_getAccountJobIds(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+
+	jobId := uuid.NewString()
+	jobIds, err := m.Service.getAccountJobIds(context.Background(), accountUuid, &jobId)
+	require.NoError(t, err)
+	require.Equal(t, []string{jobId}, jobIds)
+
+	m.QuerierMock.On("GetJobsByAccount", mock.Anything, mock.Anything, accountUuid).Return([]db_queries.NeosyncApiJob{job}, nil)
+	jobIds, err = m.Service.getAccountJobIds(context.Background(), accountUuid, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{nucleusdb.UUIDString(job.ID)}, jobIds)
+}
@@ -12,13 +12,17 @@ import (
 	"time"
 
 	"connectrpc.com/connect"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
 	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
 	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/idempotency"
 	"github.com/nucleuscloud/neosync/backend/internal/loki"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
 	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	genbenthosconfigs_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/gen-benthos-configs"
+	datasync_workflow "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/workflow"
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/enums/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
@@ -131,6 +135,111 @@ func (s *Service) GetJobRun(
 	}), nil
 }
 
+func (s *Service) GetJobRunDataQuality(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetJobRunDataQualityRequest],
+) (*connect.Response[mgmtv1alpha1.GetJobRunDataQualityResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("jobRunId", req.Msg.JobRunId)
+	verifResp, err := s.getVerifiedJobRun(ctx, logger, req.Msg.JobRunId, req.Msg.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	if verifResp.WorkflowExecution.GetStatus() != enums.WORKFLOW_EXECUTION_STATUS_COMPLETED {
+		return nil, nucleuserrors.NewBadRequest("data quality is only available for job runs that have completed successfully")
+	}
+
+	tclient, err := s.temporalWfManager.GetWorkflowClientByAccount(ctx, verifResp.NeosyncAccountId, logger)
+	if err != nil {
+		return nil, err
+	}
+	var result datasync_workflow.WorkflowResponse
+	err = tclient.GetWorkflow(
+		ctx,
+		verifResp.WorkflowExecution.Execution.WorkflowId,
+		verifResp.WorkflowExecution.Execution.RunId,
+	).Get(ctx, &result)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve job run result: %w", err)
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.GetJobRunDataQualityResponse{
+		Tables: dtomaps.ToTableDataQualityDtos(result.DataQuality),
+	}), nil
+}
+
+func (s *Service) GetJobRunRowCounts(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetJobRunRowCountsRequest],
+) (*connect.Response[mgmtv1alpha1.GetJobRunRowCountsResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("jobRunId", req.Msg.JobRunId)
+	verifResp, err := s.getVerifiedJobRun(ctx, logger, req.Msg.JobRunId, req.Msg.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	if verifResp.WorkflowExecution.GetStatus() != enums.WORKFLOW_EXECUTION_STATUS_COMPLETED {
+		return nil, nucleuserrors.NewBadRequest("row counts are only available for job runs that have completed successfully")
+	}
+
+	tclient, err := s.temporalWfManager.GetWorkflowClientByAccount(ctx, verifResp.NeosyncAccountId, logger)
+	if err != nil {
+		return nil, err
+	}
+	var result datasync_workflow.WorkflowResponse
+	err = tclient.GetWorkflow(
+		ctx,
+		verifResp.WorkflowExecution.Execution.WorkflowId,
+		verifResp.WorkflowExecution.Execution.RunId,
+	).Get(ctx, &result)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve job run result: %w", err)
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.GetJobRunRowCountsResponse{
+		Tables: dtomaps.ToTableRowCountDtos(result.RowCounts),
+	}), nil
+}
+
+func (s *Service) GetJobRunMaskingProgress(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetJobRunMaskingProgressRequest],
+) (*connect.Response[mgmtv1alpha1.GetJobRunMaskingProgressResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("jobRunId", req.Msg.JobRunId)
+	verifResp, err := s.getVerifiedJobRun(ctx, logger, req.Msg.JobRunId, req.Msg.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	tclient, err := s.temporalWfManager.GetWorkflowClientByAccount(ctx, verifResp.NeosyncAccountId, logger)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := tclient.QueryWorkflow(
+		ctx,
+		verifResp.WorkflowExecution.Execution.WorkflowId,
+		verifResp.WorkflowExecution.Execution.RunId,
+		datasync_workflow.InPlaceMaskProgressQueryName,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query masking progress of job run, it may not be an in place mask run: %w", err)
+	}
+	var plan *genbenthosconfigs_activity.InPlaceMaskPlan
+	err = encoded.Get(&plan)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode masking progress of job run: %w", err)
+	}
+	if plan == nil {
+		return nil, nucleuserrors.NewBadRequest("job run is not an in place mask run")
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.GetJobRunMaskingProgressResponse{
+		Tables: dtomaps.ToTableMaskingProgressDtos(plan.Tables),
+		DryRun: plan.DryRun,
+	}), nil
+}
+
 func (s *Service) GetJobRunEvents(
 	ctx context.Context,
 	req *connect.Request[mgmtv1alpha1.GetJobRunEventsRequest],
@@ -266,19 +375,57 @@ func (s *Service) CreateJobRun(
 	if err != nil {
 		return nil, err
 	}
-
-	scheduleHandle, err := s.temporalWfManager.GetScheduleHandleClientByAccount(ctx, nucleusdb.UUIDString(job.AccountID), nucleusdb.UUIDString(job.ID), logger)
-	if err != nil {
-		return nil, err
+	if req.Msg.GetPreemptBatchRuns() && req.Msg.GetPriority() != mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_INTERACTIVE {
+		return nil, nucleuserrors.NewBadRequest("only interactive job runs may preempt batch runs")
 	}
-	logger.Info("creating job run")
-	err = scheduleHandle.Trigger(ctx, temporalclient.ScheduleTriggerOptions{})
+	runParameters, err := resolveJobRunParameters(job.Parameters, req.Msg.GetParameters())
 	if err != nil {
-		logger.Error(fmt.Errorf("unable to create job run: %w", err).Error())
 		return nil, err
 	}
 
-	return connect.NewResponse(&mgmtv1alpha1.CreateJobRunResponse{}), nil
+	return idempotency.Do(ctx, s.db, req.Header(), job.AccountID, "CreateJobRun", req.Msg, func() (*connect.Response[mgmtv1alpha1.CreateJobRunResponse], error) {
+		scheduleHandle, err := s.temporalWfManager.GetScheduleHandleClientByAccount(ctx, nucleusdb.UUIDString(job.AccountID), nucleusdb.UUIDString(job.ID), logger)
+		if err != nil {
+			return nil, err
+		}
+
+		var runRequest *db_queries.NeosyncApiJobRunRequest
+		if req.Msg.GetPriority() != mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_UNSPECIFIED || len(req.Msg.GetParameters()) > 0 {
+			userUuid, err := s.getUserUuid(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// picked up by the run when it starts as schedule triggers can not carry the priority or parameters themselves
+			created, err := s.db.Q.CreateJobRunRequest(ctx, s.db.Db, db_queries.CreateJobRunRequestParams{
+				JobID:       job.ID,
+				Priority:    int16(req.Msg.GetPriority()),
+				CreatedByID: *userUuid,
+				Parameters:  runParameters,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("unable to create job run request: %w", err)
+			}
+			runRequest = &created
+		}
+
+		logger.Info("creating job run", "priority", req.Msg.GetPriority().String())
+		err = scheduleHandle.Trigger(ctx, temporalclient.ScheduleTriggerOptions{})
+		if err != nil {
+			logger.Error(fmt.Errorf("unable to create job run: %w", err).Error())
+			if runRequest != nil {
+				if removeErr := s.db.Q.RemoveJobRunRequest(ctx, s.db.Db, runRequest.ID); removeErr != nil {
+					logger.Warn(fmt.Sprintf("unable to remove job run request: %s", removeErr.Error()))
+				}
+			}
+			return nil, err
+		}
+
+		if req.Msg.GetPreemptBatchRuns() {
+			s.preemptBatchRuns(ctx, logger, accountId)
+		}
+
+		return connect.NewResponse(&mgmtv1alpha1.CreateJobRunResponse{}), nil
+	})
 }
 
 func (s *Service) CancelJobRun(
@@ -346,6 +493,10 @@ func (s *Service) DeleteJobRun(
 	if err != nil {
 		return nil, err
 	}
+	err = s.verifyJobRunIsNotSnapshotted(ctx, logger, verifResp.NeosyncAccountId, verifResp.WorkflowExecution.Execution.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
 	logger.Info("deleting job run")
 	tclient, err := s.temporalWfManager.GetWorkflowClientByAccount(ctx, verifResp.NeosyncAccountId, logger)
 	if err != nil {
@@ -362,6 +513,10 @@ func (s *Service) DeleteJobRun(
 		logger.Error(fmt.Errorf("unable to delete job run: %w", err).Error())
 		return nil, err
 	}
+	err = s.removeJobRunArtifacts(ctx, logger, verifResp.NeosyncAccountId, verifResp.WorkflowExecution.Execution.WorkflowId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to remove job run artifacts: %w", err)
+	}
 
 	return connect.NewResponse(&mgmtv1alpha1.DeleteJobRunResponse{}), nil
 }
@@ -379,7 +534,7 @@ func getWorkflowExecutionsByRunId(
 		return nil, err
 	}
 	if len(resp.Executions) == 0 {
-		return nil, nucleuserrors.NewNotFound("job run not found")
+		return nil, nucleuserrors.WithErrorCode(nucleuserrors.NewNotFound("job run not found"), mgmtv1alpha1.ErrorCode_ERROR_CODE_JOB_RUN_NOT_FOUND)
 	}
 	if len(resp.Executions) > 1 {
 		return nil, nucleuserrors.NewInternalError("found more than 1 job run")
@@ -13,6 +13,9 @@ import (
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
 	clientmanager "github.com/nucleuscloud/neosync/backend/internal/temporal/client-manager"
 	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	computedataquality_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/compute-data-quality"
+	sync_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync"
+	datasync_workflow "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/workflow"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -22,6 +25,7 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	temporalclient "go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/converter"
+	temporalmocks "go.temporal.io/sdk/mocks"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -125,6 +129,154 @@ func Test_GetJobRun(t *testing.T) {
 	assert.NotNil(t, resp)
 }
 
+// GetJobRunDataQuality
+func Test_GetJobRunDataQuality(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	temporalClientMock := new(MockTemporalClient)
+	workflowRunMock := temporalmocks.NewWorkflowRun(t)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	runId := uuid.NewString()
+	workflowId := uuid.NewString()
+	workflows := []*workflowpb.WorkflowExecutionInfo{{
+		Execution: &common.WorkflowExecution{
+			WorkflowId: workflowId,
+			RunId:      runId,
+		},
+		Status: enums.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+	}}
+	validity := 0.5
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetVerifiedJobRun(m.TemporalWfManagerMock, accountUuid, temporalClientMock, workflows)
+	temporalClientMock.On("GetWorkflow", mock.Anything, workflowId, runId).Return(workflowRunMock)
+	workflowRunMock.On("Get", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		result := args.Get(1).(*datasync_workflow.WorkflowResponse)
+		result.DataQuality = &computedataquality_activity.ComputeDataQualityResponse{
+			Tables: []*computedataquality_activity.TableQuality{
+				{
+					Schema:              "public",
+					Table:               "users",
+					Score:               0.75,
+					ReferentialValidity: &validity,
+					Columns:             []*computedataquality_activity.ColumnQuality{{Column: "id"}},
+				},
+			},
+		}
+	}).Return(nil)
+
+	resp, err := m.Service.GetJobRunDataQuality(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobRunDataQualityRequest{
+		JobRunId:  runId,
+		AccountId: mockAccountId,
+	}))
+
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.GetTables(), 1)
+	table := resp.Msg.GetTables()[0]
+	require.Equal(t, "users", table.GetTable())
+	require.Equal(t, 0.75, table.GetScore())
+	require.Equal(t, validity, table.GetReferentialValidity())
+	require.Len(t, table.GetColumns(), 1)
+}
+
+func Test_GetJobRunDataQuality_NotCompleted(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	temporalClientMock := new(MockTemporalClient)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	workflows := []*workflowpb.WorkflowExecutionInfo{{
+		Execution: &common.WorkflowExecution{
+			WorkflowId: uuid.NewString(),
+			RunId:      uuid.NewString(),
+		},
+		Status: enums.WORKFLOW_EXECUTION_STATUS_RUNNING,
+	}}
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetVerifiedJobRun(m.TemporalWfManagerMock, accountUuid, temporalClientMock, workflows)
+
+	resp, err := m.Service.GetJobRunDataQuality(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobRunDataQualityRequest{
+		JobRunId:  workflows[0].Execution.WorkflowId,
+		AccountId: mockAccountId,
+	}))
+
+	require.Error(t, err)
+	require.Nil(t, resp)
+}
+
+// GetJobRunRowCounts
+func Test_GetJobRunRowCounts(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	temporalClientMock := new(MockTemporalClient)
+	workflowRunMock := temporalmocks.NewWorkflowRun(t)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	runId := uuid.NewString()
+	workflowId := uuid.NewString()
+	workflows := []*workflowpb.WorkflowExecutionInfo{{
+		Execution: &common.WorkflowExecution{
+			WorkflowId: workflowId,
+			RunId:      runId,
+		},
+		Status: enums.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+	}}
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetVerifiedJobRun(m.TemporalWfManagerMock, accountUuid, temporalClientMock, workflows)
+	temporalClientMock.On("GetWorkflow", mock.Anything, workflowId, runId).Return(workflowRunMock)
+	workflowRunMock.On("Get", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		result := args.Get(1).(*datasync_workflow.WorkflowResponse)
+		result.RowCounts = []*sync_activity.TableRowCounts{
+			{
+				Schema:   "public",
+				Table:    "users",
+				RowsRead: 10,
+				Outputs: []*sync_activity.OutputRowCounts{
+					{Output: "pooled_sql_insert", ConnectionId: "conn-id", RowsWritten: 8, RowsSkipped: 2},
+					{Output: "neosync_aws_s3", RowsWritten: 9},
+				},
+			},
+		}
+	}).Return(nil)
+
+	resp, err := m.Service.GetJobRunRowCounts(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobRunRowCountsRequest{
+		JobRunId:  runId,
+		AccountId: mockAccountId,
+	}))
+
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.GetTables(), 1)
+	table := resp.Msg.GetTables()[0]
+	require.Equal(t, "users", table.GetTable())
+	require.Equal(t, int64(10), table.GetRowsRead())
+	require.True(t, table.GetHasDiscrepancy())
+	require.Len(t, table.GetDestinations(), 2)
+	require.Equal(t, "conn-id", table.GetDestinations()[0].GetConnectionId())
+	require.False(t, table.GetDestinations()[0].GetHasDiscrepancy())
+	require.True(t, table.GetDestinations()[1].GetHasDiscrepancy())
+}
+
+func Test_GetJobRunRowCounts_NotCompleted(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	temporalClientMock := new(MockTemporalClient)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	workflows := []*workflowpb.WorkflowExecutionInfo{{
+		Execution: &common.WorkflowExecution{
+			WorkflowId: uuid.NewString(),
+			RunId:      uuid.NewString(),
+		},
+		Status: enums.WORKFLOW_EXECUTION_STATUS_FAILED,
+	}}
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetVerifiedJobRun(m.TemporalWfManagerMock, accountUuid, temporalClientMock, workflows)
+
+	resp, err := m.Service.GetJobRunRowCounts(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobRunRowCountsRequest{
+		JobRunId:  workflows[0].Execution.WorkflowId,
+		AccountId: mockAccountId,
+	}))
+
+	require.Error(t, err)
+	require.Nil(t, resp)
+}
+
 // GetJobRunEvents
 
 // CreateJobRun
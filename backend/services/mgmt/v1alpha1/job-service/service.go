@@ -1,8 +1,11 @@
 package v1alpha1_jobservice
 
 import (
+	"time"
+
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/nucleuscloud/neosync/backend/internal/objectstore"
 	clientmanager "github.com/nucleuscloud/neosync/backend/internal/temporal/client-manager"
 	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
 )
@@ -13,6 +16,8 @@ type Service struct {
 	connectionService  mgmtv1alpha1connect.ConnectionServiceClient
 	useraccountService mgmtv1alpha1connect.UserAccountServiceClient
 	sqlmanager         sql_manager.SqlManagerClient
+	// nil if job run artifacts have not been configured
+	artifactStore objectstore.Interface
 
 	temporalWfManager clientmanager.TemporalClientManagerClient
 }
@@ -41,6 +46,20 @@ type Config struct {
 	IsAuthEnabled bool
 
 	RunLogConfig *RunLogConfig
+
+	// When enabled, changes to the mappings of sensitive columns must be approved by a second user before they are applied
+	IsJobChangeApprovalEnabled bool
+
+	// nil if stale run detection has not been enabled
+	StaleRunConfig *StaleRunConfig
+}
+
+// Runs whose activities have not heartbeated within the timeout are considered stale and are terminated
+type StaleRunConfig struct {
+	Timeout       time.Duration
+	CheckInterval time.Duration // defaults to one minute
+	// Triggers a new run of the job after a stale run has been terminated
+	RetryStaleRuns bool
 }
 
 type RunLogConfig struct {
@@ -57,6 +76,7 @@ func New(
 	connectionService mgmtv1alpha1connect.ConnectionServiceClient,
 	useraccountService mgmtv1alpha1connect.UserAccountServiceClient,
 	sqlmanager sql_manager.SqlManagerClient,
+	artifactStore objectstore.Interface,
 ) *Service {
 	return &Service{
 		cfg:                cfg,
@@ -65,5 +85,6 @@ func New(
 		connectionService:  connectionService,
 		useraccountService: useraccountService,
 		sqlmanager:         sqlmanager,
+		artifactStore:      artifactStore,
 	}
 }
@@ -0,0 +1,214 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+)
+
+func (s *Service) CreateJobRunSnapshot(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.CreateJobRunSnapshotRequest],
+) (*connect.Response[mgmtv1alpha1.CreateJobRunSnapshotResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("jobRunId", req.Msg.GetJobRunId(), "snapshotName", req.Msg.GetName())
+	verifResp, err := s.getVerifiedJobRun(ctx, logger, req.Msg.GetJobRunId(), req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	accountUuid, err := nucleusdb.ToUuid(req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	jobRunId := verifResp.WorkflowExecution.GetExecution().GetWorkflowId()
+	jobUuid, err := nucleusdb.ToUuid(dtomaps.GetJobIdFromWorkflow(logger, verifResp.WorkflowExecution.GetSearchAttributes()))
+	if err != nil {
+		return nil, fmt.Errorf("unable to determine the job of the job run: %w", err)
+	}
+
+	connectionResp, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+		Id: req.Msg.GetConnectionId(),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	connection := connectionResp.Msg.GetConnection()
+	if connection.GetAccountId() != req.Msg.GetAccountId() {
+		return nil, nucleuserrors.NewForbidden("connection does not belong to the requested account")
+	}
+	if connection.GetConnectionConfig().GetAwsS3Config() == nil {
+		return nil, nucleuserrors.NewBadRequest("job run snapshots are only supported for AWS S3 connections")
+	}
+	destinations, err := s.db.Q.GetJobConnectionDestinations(ctx, s.db.Db, jobUuid)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve job destinations: %w", err)
+	}
+	if !isConnectionInDestinations(req.Msg.GetConnectionId(), destinations) {
+		return nil, nucleuserrors.NewBadRequest("connection is not a destination of the job run's job")
+	}
+
+	connectionUuid, err := nucleusdb.ToUuid(connection.GetId())
+	if err != nil {
+		return nil, err
+	}
+	userUuid, err := s.getUserUuid(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := s.db.Q.CreateJobRunSnapshot(ctx, s.db.Db, db_queries.CreateJobRunSnapshotParams{
+		AccountID:    accountUuid,
+		JobID:        jobUuid,
+		JobRunID:     jobRunId,
+		ConnectionID: connectionUuid,
+		Name:         req.Msg.GetName(),
+		CreatedByID:  *userUuid,
+	})
+	if err != nil && nucleusdb.IsConflict(err) {
+		return nil, nucleuserrors.NewAlreadyExists(fmt.Sprintf("a job run snapshot with the name %s already exists", req.Msg.GetName()))
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to create job run snapshot: %w", err)
+	}
+	logger.Info("created job run snapshot")
+
+	return connect.NewResponse(&mgmtv1alpha1.CreateJobRunSnapshotResponse{
+		Snapshot: dtomaps.ToJobRunSnapshotDto(&snapshot),
+	}), nil
+}
+
+func (s *Service) GetJobRunSnapshot(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetJobRunSnapshotRequest],
+) (*connect.Response[mgmtv1alpha1.GetJobRunSnapshotResponse], error) {
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := s.getJobRunSnapshotByName(ctx, *accountUuid, req.Msg.GetName())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GetJobRunSnapshotResponse{
+		Snapshot: dtomaps.ToJobRunSnapshotDto(snapshot),
+	}), nil
+}
+
+func (s *Service) GetJobRunSnapshots(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetJobRunSnapshotsRequest],
+) (*connect.Response[mgmtv1alpha1.GetJobRunSnapshotsResponse], error) {
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshots []db_queries.NeosyncApiJobRunSnapshot
+	if req.Msg.JobId != nil {
+		jobUuid, err := nucleusdb.ToUuid(req.Msg.GetJobId())
+		if err != nil {
+			return nil, err
+		}
+		snapshots, err = s.db.Q.GetJobRunSnapshotsByJob(ctx, s.db.Db, db_queries.GetJobRunSnapshotsByJobParams{
+			AccountID: *accountUuid,
+			JobID:     jobUuid,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve job run snapshots: %w", err)
+		}
+	} else {
+		snapshots, err = s.db.Q.GetJobRunSnapshotsByAccount(ctx, s.db.Db, *accountUuid)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve job run snapshots: %w", err)
+		}
+	}
+
+	dtos := make([]*mgmtv1alpha1.JobRunSnapshot, 0, len(snapshots))
+	for idx := range snapshots {
+		dtos = append(dtos, dtomaps.ToJobRunSnapshotDto(&snapshots[idx]))
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GetJobRunSnapshotsResponse{
+		Snapshots: dtos,
+	}), nil
+}
+
+func (s *Service) DeleteJobRunSnapshot(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.DeleteJobRunSnapshotRequest],
+) (*connect.Response[mgmtv1alpha1.DeleteJobRunSnapshotResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("snapshotName", req.Msg.GetName())
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := s.getJobRunSnapshotByName(ctx, *accountUuid, req.Msg.GetName())
+	if err != nil {
+		return nil, err
+	}
+	err = s.db.Q.RemoveJobRunSnapshot(ctx, s.db.Db, snapshot.ID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to remove job run snapshot: %w", err)
+	}
+	logger.Info("removed job run snapshot")
+	return connect.NewResponse(&mgmtv1alpha1.DeleteJobRunSnapshotResponse{}), nil
+}
+
+func (s *Service) getJobRunSnapshotByName(
+	ctx context.Context,
+	accountUuid pgtype.UUID,
+	name string,
+) (*db_queries.NeosyncApiJobRunSnapshot, error) {
+	snapshot, err := s.db.Q.GetJobRunSnapshotByName(ctx, s.db.Db, db_queries.GetJobRunSnapshotByNameParams{
+		AccountID: accountUuid,
+		Name:      name,
+	})
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return nil, nucleuserrors.NewNotFound(fmt.Sprintf("unable to find job run snapshot: %s", name))
+	}
+	return &snapshot, nil
+}
+
+// Snapshotted job runs must be retained until all of their snapshots have been removed
+func (s *Service) verifyJobRunIsNotSnapshotted(
+	ctx context.Context,
+	logger *slog.Logger,
+	accountId string,
+	jobRunId string,
+) error {
+	accountUuid, err := nucleusdb.ToUuid(accountId)
+	if err != nil {
+		return err
+	}
+	snapshots, err := s.db.Q.GetJobRunSnapshotsByJobRun(ctx, s.db.Db, db_queries.GetJobRunSnapshotsByJobRunParams{
+		AccountID: accountUuid,
+		JobRunID:  jobRunId,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to retrieve job run snapshots: %w", err)
+	}
+	if len(snapshots) > 0 {
+		logger.Warn(fmt.Sprintf("refusing to delete job run as it is referenced by %d snapshot(s)", len(snapshots)))
+		return nucleuserrors.NewBadRequest(fmt.Sprintf("job run is referenced by the snapshot %s and can not be deleted until the snapshot has been removed", snapshots[0].Name))
+	}
+	return nil
+}
+
+func isConnectionInDestinations(connectionId string, destinations []db_queries.NeosyncApiJobDestinationConnectionAssociation) bool {
+	for idx := range destinations {
+		if nucleusdb.UUIDString(destinations[idx].ConnectionID) == connectionId {
+			return true
+		}
+	}
+	return false
+}
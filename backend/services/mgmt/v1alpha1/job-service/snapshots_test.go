@@ -0,0 +1,193 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	workflowpb "go.temporal.io/api/workflow/v1"
+)
+
+// CreateJobRunSnapshot
+func Test_CreateJobRunSnapshot(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	temporalClientMock := new(MockTemporalClient)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	jobId := uuid.NewString()
+	jobUuid, _ := nucleusdb.ToUuid(jobId)
+	workflowId := uuid.NewString()
+
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	mockGetVerifiedJobRun(m.TemporalWfManagerMock, accountUuid, temporalClientMock, []*workflowpb.WorkflowExecutionInfo{getWorfklowExecutionInfoMock(jobId, workflowId)})
+	mockGetAwsS3Connection(m)
+	m.QuerierMock.On("GetJobConnectionDestinations", mock.Anything, mock.Anything, jobUuid).Return([]db_queries.NeosyncApiJobDestinationConnectionAssociation{
+		mockJobDestConnAssociation(jobUuid, connectionUuid, nil),
+	}, nil)
+	m.QuerierMock.On("CreateJobRunSnapshot", mock.Anything, mock.Anything, db_queries.CreateJobRunSnapshotParams{
+		AccountID:    accountUuid,
+		JobID:        jobUuid,
+		JobRunID:     workflowId,
+		ConnectionID: connectionUuid,
+		Name:         "golden-2024-06",
+		CreatedByID:  userUuid,
+	}).Return(mockJobRunSnapshot(accountUuid, jobUuid, connectionUuid, workflowId, "golden-2024-06"), nil)
+
+	resp, err := m.Service.CreateJobRunSnapshot(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateJobRunSnapshotRequest{
+		AccountId:    mockAccountId,
+		JobRunId:     workflowId,
+		ConnectionId: mockConnectionId,
+		Name:         "golden-2024-06",
+	}))
+
+	require.NoError(t, err)
+	require.Equal(t, "golden-2024-06", resp.Msg.GetSnapshot().GetName())
+	require.Equal(t, workflowId, resp.Msg.GetSnapshot().GetJobRunId())
+	require.Equal(t, jobId, resp.Msg.GetSnapshot().GetJobId())
+	require.Equal(t, mockConnectionId, resp.Msg.GetSnapshot().GetConnectionId())
+}
+
+func Test_CreateJobRunSnapshot_NotJobDestination(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	temporalClientMock := new(MockTemporalClient)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	jobId := uuid.NewString()
+	jobUuid, _ := nucleusdb.ToUuid(jobId)
+	otherConnectionUuid, _ := nucleusdb.ToUuid(uuid.NewString())
+	workflowId := uuid.NewString()
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetVerifiedJobRun(m.TemporalWfManagerMock, accountUuid, temporalClientMock, []*workflowpb.WorkflowExecutionInfo{getWorfklowExecutionInfoMock(jobId, workflowId)})
+	mockGetAwsS3Connection(m)
+	m.QuerierMock.On("GetJobConnectionDestinations", mock.Anything, mock.Anything, jobUuid).Return([]db_queries.NeosyncApiJobDestinationConnectionAssociation{
+		mockJobDestConnAssociation(jobUuid, otherConnectionUuid, nil),
+	}, nil)
+
+	resp, err := m.Service.CreateJobRunSnapshot(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateJobRunSnapshotRequest{
+		AccountId:    mockAccountId,
+		JobRunId:     workflowId,
+		ConnectionId: mockConnectionId,
+		Name:         "golden-2024-06",
+	}))
+
+	require.Error(t, err)
+	require.Nil(t, resp)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+// GetJobRunSnapshot
+func Test_GetJobRunSnapshot_NotFound(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobRunSnapshotByName", mock.Anything, mock.Anything, db_queries.GetJobRunSnapshotByNameParams{
+		AccountID: accountUuid,
+		Name:      "golden-2024-06",
+	}).Return(db_queries.NeosyncApiJobRunSnapshot{}, sql.ErrNoRows)
+
+	resp, err := m.Service.GetJobRunSnapshot(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobRunSnapshotRequest{
+		AccountId: mockAccountId,
+		Name:      "golden-2024-06",
+	}))
+
+	require.Error(t, err)
+	require.Nil(t, resp)
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+// GetJobRunSnapshots
+func Test_GetJobRunSnapshots_ByJob(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+	jobId := uuid.NewString()
+	jobUuid, _ := nucleusdb.ToUuid(jobId)
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobRunSnapshotsByJob", mock.Anything, mock.Anything, db_queries.GetJobRunSnapshotsByJobParams{
+		AccountID: accountUuid,
+		JobID:     jobUuid,
+	}).Return([]db_queries.NeosyncApiJobRunSnapshot{
+		mockJobRunSnapshot(accountUuid, jobUuid, connectionUuid, uuid.NewString(), "golden-2024-06"),
+		mockJobRunSnapshot(accountUuid, jobUuid, connectionUuid, uuid.NewString(), "golden-2024-05"),
+	}, nil)
+
+	resp, err := m.Service.GetJobRunSnapshots(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobRunSnapshotsRequest{
+		AccountId: mockAccountId,
+		JobId:     &jobId,
+	}))
+
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.GetSnapshots(), 2)
+	require.Equal(t, "golden-2024-06", resp.Msg.GetSnapshots()[0].GetName())
+}
+
+// DeleteJobRun
+func Test_DeleteJobRun_Snapshotted(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	temporalClientMock := new(MockTemporalClient)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+	jobId := uuid.NewString()
+	jobUuid, _ := nucleusdb.ToUuid(jobId)
+	workflowId := uuid.NewString()
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetVerifiedJobRun(m.TemporalWfManagerMock, accountUuid, temporalClientMock, []*workflowpb.WorkflowExecutionInfo{getWorfklowExecutionInfoMock(jobId, workflowId)})
+	m.QuerierMock.On("GetJobRunSnapshotsByJobRun", mock.Anything, mock.Anything, db_queries.GetJobRunSnapshotsByJobRunParams{
+		AccountID: accountUuid,
+		JobRunID:  workflowId,
+	}).Return([]db_queries.NeosyncApiJobRunSnapshot{
+		mockJobRunSnapshot(accountUuid, jobUuid, connectionUuid, workflowId, "golden-2024-06"),
+	}, nil)
+
+	resp, err := m.Service.DeleteJobRun(context.Background(), connect.NewRequest(&mgmtv1alpha1.DeleteJobRunRequest{
+		AccountId: mockAccountId,
+		JobRunId:  workflowId,
+	}))
+
+	require.Error(t, err)
+	require.Nil(t, resp)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	temporalClientMock.AssertNotCalled(t, "WorkflowService")
+}
+
+func mockGetAwsS3Connection(m *serviceMocks) {
+	m.ConnectionServiceClientMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: &mgmtv1alpha1.Connection{
+			Id:        mockConnectionId,
+			AccountId: mockAccountId,
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_AwsS3Config{
+					AwsS3Config: &mgmtv1alpha1.AwsS3ConnectionConfig{Bucket: "neosync"},
+				},
+			},
+		},
+	}), nil)
+}
+
+func mockJobRunSnapshot(accountUuid, jobUuid, connectionUuid pgtype.UUID, jobRunId, name string) db_queries.NeosyncApiJobRunSnapshot {
+	idUuid, _ := nucleusdb.ToUuid(uuid.NewString())
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	return db_queries.NeosyncApiJobRunSnapshot{
+		ID:           idUuid,
+		AccountID:    accountUuid,
+		JobID:        jobUuid,
+		JobRunID:     jobRunId,
+		ConnectionID: connectionUuid,
+		Name:         name,
+		CreatedByID:  userUuid,
+		CreatedAt:    pgtype.Timestamp{Time: time.Now(), Valid: true},
+	}
+}
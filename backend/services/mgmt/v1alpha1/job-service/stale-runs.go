@@ -0,0 +1,250 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	temporalclient "go.temporal.io/sdk/client"
+)
+
+const (
+	defaultStaleRunCheckInterval = time.Minute
+
+	// number of times a force cancel checks that the workflow has closed before giving up
+	forceCancelMaxAttempts = 5
+)
+
+// how long a force cancel waits between termination attempts
+var forceCancelRetryInterval = time.Second
+
+func (s *Service) ForceCancelJobRun(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.ForceCancelJobRunRequest],
+) (*connect.Response[mgmtv1alpha1.ForceCancelJobRunResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("jobRunId", req.Msg.GetJobRunId())
+	verifResp, err := s.getVerifiedJobRun(ctx, logger, req.Msg.GetJobRunId(), req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	if verifResp.WorkflowExecution.GetStatus() != enums.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		return connect.NewResponse(&mgmtv1alpha1.ForceCancelJobRunResponse{}), nil
+	}
+
+	tclient, err := s.temporalWfManager.GetWorkflowClientByAccount(ctx, verifResp.NeosyncAccountId, logger)
+	if err != nil {
+		return nil, err
+	}
+	reason := "force cancelling run"
+	if req.Msg.Reason != nil {
+		reason = req.Msg.GetReason()
+	}
+	logger.Info("force cancelling job run")
+	err = terminateWorkflowAndWait(ctx, tclient, verifResp.WorkflowExecution.GetExecution().GetWorkflowId(), verifResp.WorkflowExecution.GetExecution().GetRunId(), reason)
+	if err != nil {
+		return nil, fmt.Errorf("unable to force cancel job run: %w", err)
+	}
+	return connect.NewResponse(&mgmtv1alpha1.ForceCancelJobRunResponse{}), nil
+}
+
+// Terminates the workflow and does not return until Temporal reports it as closed.
+// Termination is retried as the request may be accepted while the workflow is in the middle of a task.
+func terminateWorkflowAndWait(
+	ctx context.Context,
+	tclient temporalclient.Client,
+	workflowId string,
+	runId string,
+	reason string,
+) error {
+	for attempt := 1; ; attempt++ {
+		err := tclient.TerminateWorkflow(ctx, workflowId, runId, reason)
+		if err != nil && !isWorkflowNotFound(err) {
+			return err
+		}
+		desc, err := tclient.DescribeWorkflowExecution(ctx, workflowId, runId)
+		if err != nil {
+			return fmt.Errorf("unable to describe workflow: %w", err)
+		}
+		if desc.GetWorkflowExecutionInfo().GetStatus() != enums.WORKFLOW_EXECUTION_STATUS_RUNNING {
+			return nil
+		}
+		if attempt >= forceCancelMaxAttempts {
+			return fmt.Errorf("workflow is still running after %d termination attempts", attempt)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(forceCancelRetryInterval):
+		}
+	}
+}
+
+// Temporal returns not found when terminating a workflow that has already completed
+func isWorkflowNotFound(err error) bool {
+	var notFound *serviceerror.NotFound
+	return errors.As(err, &notFound)
+}
+
+// Periodically terminates job runs whose activities have stopped heartbeating, which happens when a worker dies mid-activity.
+// Blocks until the context is cancelled. Does nothing if stale run detection has not been configured.
+func (s *Service) WatchStaleJobRuns(ctx context.Context, logger *slog.Logger) {
+	if s.cfg.StaleRunConfig == nil || s.cfg.StaleRunConfig.Timeout <= 0 {
+		return
+	}
+	interval := s.cfg.StaleRunConfig.CheckInterval
+	if interval <= 0 {
+		interval = defaultStaleRunCheckInterval
+	}
+	logger = logger.With("staleRunTimeout", s.cfg.StaleRunConfig.Timeout.String())
+	logger.Info("watching for stale job runs")
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.cleanupStaleJobRuns(ctx, logger)
+		}
+	}
+}
+
+func (s *Service) cleanupStaleJobRuns(ctx context.Context, logger *slog.Logger) {
+	accountIds, err := s.db.Q.GetJobAccountIds(ctx, s.db.Db)
+	if err != nil {
+		logger.Error(fmt.Errorf("unable to retrieve accounts with jobs: %w", err).Error())
+		return
+	}
+	for _, accountUuid := range accountIds {
+		accountId := nucleusdb.UUIDString(accountUuid)
+		err := s.cleanupAccountStaleJobRuns(ctx, logger.With("accountId", accountId), accountId)
+		if err != nil {
+			logger.Warn(fmt.Sprintf("unable to clean up stale job runs for account %s: %s", accountId, err.Error()))
+		}
+	}
+}
+
+func (s *Service) cleanupAccountStaleJobRuns(ctx context.Context, logger *slog.Logger, accountId string) error {
+	accountUuid, err := nucleusdb.ToUuid(accountId)
+	if err != nil {
+		return err
+	}
+	jobs, err := s.db.Q.GetJobsByAccount(ctx, s.db.Db, accountUuid)
+	if err != nil {
+		return err
+	}
+	if len(jobs) == 0 {
+		return nil
+	}
+	jobIds := make([]string, 0, len(jobs))
+	for idx := range jobs {
+		jobIds = append(jobIds, nucleusdb.UUIDString(jobs[idx].ID))
+	}
+
+	tclient, err := s.temporalWfManager.GetWorkflowClientByAccount(ctx, accountId, logger)
+	if err != nil {
+		return err
+	}
+	tconfig, err := s.temporalWfManager.GetTemporalConfigByAccount(ctx, accountId)
+	if err != nil {
+		return err
+	}
+	running, err := listWorkflowExecutions(ctx, tclient, tconfig.Namespace, fmt.Sprintf("%s AND ExecutionStatus = 'Running'", buildJobIdsWorkflowQuery(jobIds)))
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, wf := range running {
+		runLogger := logger.With("jobRunId", wf.GetExecution().GetWorkflowId())
+		desc, err := tclient.DescribeWorkflowExecution(ctx, wf.GetExecution().GetWorkflowId(), wf.GetExecution().GetRunId())
+		if err != nil {
+			runLogger.Warn(fmt.Sprintf("unable to describe job run: %s", err.Error()))
+			continue
+		}
+		lastActivity, ok := getOldestActivityHeartbeat(desc.GetPendingActivities())
+		if !ok || now.Sub(lastActivity) < s.cfg.StaleRunConfig.Timeout {
+			continue
+		}
+
+		runLogger.Warn("terminating stale job run", "lastHeartbeat", lastActivity.String())
+		err = tclient.TerminateWorkflow(
+			ctx,
+			wf.GetExecution().GetWorkflowId(),
+			wf.GetExecution().GetRunId(),
+			fmt.Sprintf("stale run: no activity heartbeat since %s", lastActivity.UTC().Format(time.RFC3339)),
+		)
+		if err != nil {
+			// another api instance may have already terminated the run
+			if !isWorkflowNotFound(err) {
+				runLogger.Warn(fmt.Sprintf("unable to terminate stale job run: %s", err.Error()))
+			}
+			continue
+		}
+
+		if s.cfg.StaleRunConfig.RetryStaleRuns {
+			s.retryStaleJobRun(ctx, runLogger, accountId, wf)
+		}
+	}
+	return nil
+}
+
+func (s *Service) retryStaleJobRun(
+	ctx context.Context,
+	logger *slog.Logger,
+	accountId string,
+	wf *workflowpb.WorkflowExecutionInfo,
+) {
+	jobId := dtomaps.GetJobIdFromWorkflow(logger, wf.GetSearchAttributes())
+	if jobId == "" {
+		logger.Warn("unable to retry stale job run as it was not started by a job")
+		return
+	}
+	scheduleHandle, err := s.temporalWfManager.GetScheduleHandleClientByAccount(ctx, accountId, jobId, logger)
+	if err != nil {
+		logger.Warn(fmt.Sprintf("unable to retrieve job schedule: %s", err.Error()))
+		return
+	}
+	err = scheduleHandle.Trigger(ctx, temporalclient.ScheduleTriggerOptions{})
+	if err != nil {
+		logger.Warn(fmt.Sprintf("unable to retry stale job run: %s", err.Error()))
+		return
+	}
+	logger.Info("retried stale job run", "jobId", jobId)
+}
+
+// Returns the oldest last sign of life across the started activities of a run, as a dead worker only stalls its own activities.
+// Returns false if no activity is currently running, as runs waiting on a timer or for a worker to pick up a task are not stale.
+func getOldestActivityHeartbeat(activities []*workflowpb.PendingActivityInfo) (time.Time, bool) {
+	var oldest time.Time
+	found := false
+	for _, activity := range activities {
+		if activity.GetState() != enums.PENDING_ACTIVITY_STATE_STARTED {
+			continue
+		}
+		var lastSeen time.Time
+		if ts := activity.GetLastStartedTime(); ts != nil {
+			lastSeen = ts.AsTime()
+		}
+		if ts := activity.GetLastHeartbeatTime(); ts != nil && ts.AsTime().After(lastSeen) {
+			lastSeen = ts.AsTime()
+		}
+		if !found || lastSeen.Before(oldest) {
+			oldest = lastSeen
+		}
+		found = true
+	}
+	return oldest, found
+}
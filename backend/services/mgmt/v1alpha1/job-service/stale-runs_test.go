@@ -0,0 +1,232 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	temporalclient "go.temporal.io/sdk/client"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ForceCancelJobRun
+func Test_ForceCancelJobRun_WaitsForTermination(t *testing.T) {
+	setForceCancelRetryInterval(t, time.Millisecond)
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	temporalClientMock := new(MockTemporalClient)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	wf := mockRunningWorkflow(uuid.NewString(), uuid.NewString(), time.Now())
+	wfId, runId := wf.Execution.WorkflowId, wf.Execution.RunId
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetVerifiedJobRun(m.TemporalWfManagerMock, accountUuid, temporalClientMock, []*workflowpb.WorkflowExecutionInfo{wf})
+	temporalClientMock.On("TerminateWorkflow", mock.Anything, wfId, runId, "worker lost", mock.Anything).Return(nil)
+	temporalClientMock.On("DescribeWorkflowExecution", mock.Anything, wfId, runId).
+		Return(mockDescribeWorkflow(wf, enums.WORKFLOW_EXECUTION_STATUS_RUNNING), nil).Once()
+	temporalClientMock.On("DescribeWorkflowExecution", mock.Anything, wfId, runId).
+		Return(mockDescribeWorkflow(wf, enums.WORKFLOW_EXECUTION_STATUS_TERMINATED), nil).Once()
+
+	reason := "worker lost"
+	resp, err := m.Service.ForceCancelJobRun(context.Background(), connect.NewRequest(&mgmtv1alpha1.ForceCancelJobRunRequest{
+		JobRunId:  wfId,
+		AccountId: mockAccountId,
+		Reason:    &reason,
+	}))
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	temporalClientMock.AssertNumberOfCalls(t, "TerminateWorkflow", 2)
+}
+
+func Test_ForceCancelJobRun_CompletedWhileTerminating(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	temporalClientMock := new(MockTemporalClient)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	wf := mockRunningWorkflow(uuid.NewString(), uuid.NewString(), time.Now())
+	wfId, runId := wf.Execution.WorkflowId, wf.Execution.RunId
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetVerifiedJobRun(m.TemporalWfManagerMock, accountUuid, temporalClientMock, []*workflowpb.WorkflowExecutionInfo{wf})
+	temporalClientMock.On("TerminateWorkflow", mock.Anything, wfId, runId, mock.Anything, mock.Anything).
+		Return(serviceerror.NewNotFound("workflow execution already completed"))
+	temporalClientMock.On("DescribeWorkflowExecution", mock.Anything, wfId, runId).
+		Return(mockDescribeWorkflow(wf, enums.WORKFLOW_EXECUTION_STATUS_COMPLETED), nil)
+
+	resp, err := m.Service.ForceCancelJobRun(context.Background(), connect.NewRequest(&mgmtv1alpha1.ForceCancelJobRunRequest{
+		JobRunId:  wfId,
+		AccountId: mockAccountId,
+	}))
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+}
+
+func Test_ForceCancelJobRun_StillRunning(t *testing.T) {
+	setForceCancelRetryInterval(t, time.Millisecond)
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	temporalClientMock := new(MockTemporalClient)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	wf := mockRunningWorkflow(uuid.NewString(), uuid.NewString(), time.Now())
+	wfId, runId := wf.Execution.WorkflowId, wf.Execution.RunId
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetVerifiedJobRun(m.TemporalWfManagerMock, accountUuid, temporalClientMock, []*workflowpb.WorkflowExecutionInfo{wf})
+	temporalClientMock.On("TerminateWorkflow", mock.Anything, wfId, runId, mock.Anything, mock.Anything).Return(nil)
+	temporalClientMock.On("DescribeWorkflowExecution", mock.Anything, wfId, runId).
+		Return(mockDescribeWorkflow(wf, enums.WORKFLOW_EXECUTION_STATUS_RUNNING), nil)
+
+	resp, err := m.Service.ForceCancelJobRun(context.Background(), connect.NewRequest(&mgmtv1alpha1.ForceCancelJobRunRequest{
+		JobRunId:  wfId,
+		AccountId: mockAccountId,
+	}))
+
+	require.Error(t, err)
+	require.Nil(t, resp)
+	temporalClientMock.AssertNumberOfCalls(t, "TerminateWorkflow", forceCancelMaxAttempts)
+}
+
+func Test_ForceCancelJobRun_NotRunning(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	temporalClientMock := new(MockTemporalClient)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	wf := getWorfklowExecutionInfoMock(uuid.NewString(), uuid.NewString())
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetVerifiedJobRun(m.TemporalWfManagerMock, accountUuid, temporalClientMock, []*workflowpb.WorkflowExecutionInfo{wf})
+
+	resp, err := m.Service.ForceCancelJobRun(context.Background(), connect.NewRequest(&mgmtv1alpha1.ForceCancelJobRunRequest{
+		JobRunId:  wf.Execution.WorkflowId,
+		AccountId: mockAccountId,
+	}))
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	temporalClientMock.AssertNotCalled(t, "TerminateWorkflow", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// cleanupStaleJobRuns
+func Test_cleanupStaleJobRuns_TerminatesAndRetriesStaleRuns(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true, StaleRunConfig: &StaleRunConfig{Timeout: 10 * time.Minute, RetryStaleRuns: true}})
+	temporalClientMock := new(MockTemporalClient)
+	mockHandle := new(MockScheduleHandle)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	jobId := nucleusdb.UUIDString(job.ID)
+
+	staleRun := mockRunningWorkflow(jobId, uuid.NewString(), time.Now().Add(-2*time.Hour))
+	healthyRun := mockRunningWorkflow(jobId, uuid.NewString(), time.Now().Add(-2*time.Hour))
+	idleRun := mockRunningWorkflow(jobId, uuid.NewString(), time.Now().Add(-2*time.Hour))
+
+	m.QuerierMock.On("GetJobAccountIds", mock.Anything, mock.Anything).Return([]pgtype.UUID{accountUuid}, nil)
+	m.QuerierMock.On("GetJobsByAccount", mock.Anything, mock.Anything, accountUuid).Return([]db_queries.NeosyncApiJob{job}, nil)
+	mockListRunningWorkflows(m.TemporalWfManagerMock, accountUuid, temporalClientMock, []*workflowpb.WorkflowExecutionInfo{staleRun, healthyRun, idleRun})
+	temporalClientMock.On("DescribeWorkflowExecution", mock.Anything, staleRun.Execution.WorkflowId, staleRun.Execution.RunId).
+		Return(mockDescribeWorkflow(staleRun, enums.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			mockStartedActivity(time.Now().Add(-time.Hour), time.Now().Add(-30*time.Minute)),
+			mockStartedActivity(time.Now().Add(-time.Hour), time.Now()),
+		), nil)
+	temporalClientMock.On("DescribeWorkflowExecution", mock.Anything, healthyRun.Execution.WorkflowId, healthyRun.Execution.RunId).
+		Return(mockDescribeWorkflow(healthyRun, enums.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			mockStartedActivity(time.Now().Add(-time.Hour), time.Now().Add(-time.Minute)),
+		), nil)
+	temporalClientMock.On("DescribeWorkflowExecution", mock.Anything, idleRun.Execution.WorkflowId, idleRun.Execution.RunId).
+		Return(mockDescribeWorkflow(idleRun, enums.WORKFLOW_EXECUTION_STATUS_RUNNING), nil)
+	temporalClientMock.On("TerminateWorkflow", mock.Anything, staleRun.Execution.WorkflowId, staleRun.Execution.RunId, mock.Anything, mock.Anything).Return(nil)
+	m.TemporalWfManagerMock.On("GetScheduleHandleClientByAccount", mock.Anything, mockAccountId, jobId, mock.Anything).Return(mockHandle, nil)
+	mockHandle.On("Trigger", mock.Anything, temporalclient.ScheduleTriggerOptions{}).Return(nil)
+
+	m.Service.cleanupStaleJobRuns(context.Background(), slog.Default())
+
+	temporalClientMock.AssertNumberOfCalls(t, "TerminateWorkflow", 1)
+	mockHandle.AssertNumberOfCalls(t, "Trigger", 1)
+}
+
+func Test_cleanupStaleJobRuns_AlreadyTerminated_DoesNotRetry(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true, StaleRunConfig: &StaleRunConfig{Timeout: 10 * time.Minute, RetryStaleRuns: true}})
+	temporalClientMock := new(MockTemporalClient)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	staleRun := mockRunningWorkflow(nucleusdb.UUIDString(job.ID), uuid.NewString(), time.Now().Add(-2*time.Hour))
+
+	m.QuerierMock.On("GetJobAccountIds", mock.Anything, mock.Anything).Return([]pgtype.UUID{accountUuid}, nil)
+	m.QuerierMock.On("GetJobsByAccount", mock.Anything, mock.Anything, accountUuid).Return([]db_queries.NeosyncApiJob{job}, nil)
+	mockListRunningWorkflows(m.TemporalWfManagerMock, accountUuid, temporalClientMock, []*workflowpb.WorkflowExecutionInfo{staleRun})
+	temporalClientMock.On("DescribeWorkflowExecution", mock.Anything, staleRun.Execution.WorkflowId, staleRun.Execution.RunId).
+		Return(mockDescribeWorkflow(staleRun, enums.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			mockStartedActivity(time.Now().Add(-time.Hour), time.Time{}),
+		), nil)
+	temporalClientMock.On("TerminateWorkflow", mock.Anything, staleRun.Execution.WorkflowId, staleRun.Execution.RunId, mock.Anything, mock.Anything).
+		Return(serviceerror.NewNotFound("workflow execution already completed"))
+
+	m.Service.cleanupStaleJobRuns(context.Background(), slog.Default())
+
+	m.TemporalWfManagerMock.AssertNotCalled(t, "GetScheduleHandleClientByAccount", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_getOldestActivityHeartbeat(t *testing.T) {
+	now := time.Now().UTC()
+
+	_, ok := getOldestActivityHeartbeat(nil)
+	require.False(t, ok)
+
+	_, ok = getOldestActivityHeartbeat([]*workflowpb.PendingActivityInfo{
+		{State: enums.PENDING_ACTIVITY_STATE_SCHEDULED},
+	})
+	require.False(t, ok)
+
+	oldest, ok := getOldestActivityHeartbeat([]*workflowpb.PendingActivityInfo{
+		mockStartedActivity(now.Add(-time.Hour), now),
+		mockStartedActivity(now.Add(-time.Hour), now.Add(-20*time.Minute)),
+		mockStartedActivity(now.Add(-5*time.Minute), time.Time{}),
+		{State: enums.PENDING_ACTIVITY_STATE_SCHEDULED},
+	})
+	require.True(t, ok)
+	require.Equal(t, now.Add(-20*time.Minute), oldest)
+}
+
+func setForceCancelRetryInterval(t *testing.T, interval time.Duration) {
+	original := forceCancelRetryInterval
+	forceCancelRetryInterval = interval
+	t.Cleanup(func() { forceCancelRetryInterval = original })
+}
+
+func mockDescribeWorkflow(
+	wf *workflowpb.WorkflowExecutionInfo,
+	status enums.WorkflowExecutionStatus,
+	activities ...*workflowpb.PendingActivityInfo,
+) *workflowservice.DescribeWorkflowExecutionResponse {
+	return &workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			Execution: wf.Execution,
+			Type:      wf.Type,
+			StartTime: wf.StartTime,
+			Status:    status,
+		},
+		PendingActivities: activities,
+	}
+}
+
+func mockStartedActivity(startedAt, lastHeartbeat time.Time) *workflowpb.PendingActivityInfo {
+	activity := &workflowpb.PendingActivityInfo{
+		ActivityId:      uuid.NewString(),
+		State:           enums.PENDING_ACTIVITY_STATE_STARTED,
+		LastStartedTime: timestamppb.New(startedAt),
+	}
+	if !lastHeartbeat.IsZero() {
+		activity.LastHeartbeatTime = timestamppb.New(lastHeartbeat)
+	}
+	return activity
+}
@@ -0,0 +1,89 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	tableprocessors "github.com/nucleuscloud/neosync/backend/pkg/table-processors"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+)
+
+func (s *Service) SetJobTableProcessors(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.SetJobTableProcessorsRequest],
+) (*connect.Response[mgmtv1alpha1.SetJobTableProcessorsResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("jobId", req.Msg.Id)
+
+	job, err := s.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{
+		Id: req.Msg.Id,
+	}))
+	if err != nil {
+		logger.Error(fmt.Errorf("unable to retrieve job: %w", err).Error())
+		return nil, err
+	}
+	_, err = s.verifyUserInAccount(ctx, job.Msg.Job.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	tableProcessors, err := toJobTableProcessors(req.Msg.TableProcessors)
+	if err != nil {
+		return nil, err
+	}
+
+	jobUuid, err := nucleusdb.ToUuid(req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	userUuid, err := s.getUserUuid(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.db.Q.SetJobTableProcessors(ctx, s.db.Db, db_queries.SetJobTableProcessorsParams{
+		ID:              jobUuid,
+		TableProcessors: tableProcessors,
+		UpdatedByID:     *userUuid,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	updatedJob, err := s.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{
+		Id: req.Msg.Id,
+	}))
+	if err != nil {
+		logger.Error(fmt.Errorf("unable to retrieve job: %w", err).Error())
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.SetJobTableProcessorsResponse{Job: updatedJob.Msg.Job}), nil
+}
+
+// Validates the table processors and converts them to their db models.
+// Each table may only have a single entry, and its processors must be a YAML list of the allowed Benthos processor configs
+func toJobTableProcessors(dtos []*mgmtv1alpha1.JobTableProcessor) ([]*pg_models.JobTableProcessor, error) {
+	tableProcessors := []*pg_models.JobTableProcessor{}
+	seen := map[sql_manager.SchemaTable]struct{}{}
+	for _, dto := range dtos {
+		key := sql_manager.SchemaTable{Schema: dto.GetSchema(), Table: dto.GetTable()}
+		if _, ok := seen[key]; ok {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("table processors may only be provided once per table: %s", key))
+		}
+		seen[key] = struct{}{}
+		if _, err := tableprocessors.Parse(dto.GetProcessors()); err != nil {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("invalid table processors for %s: %s", key, err.Error()))
+		}
+		tp := &pg_models.JobTableProcessor{}
+		tp.FromDto(dto)
+		tableProcessors = append(tableProcessors, tp)
+	}
+	return tableProcessors, nil
+}
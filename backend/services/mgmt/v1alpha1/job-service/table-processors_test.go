@@ -0,0 +1,94 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// SetJobTableProcessors
+func Test_SetJobTableProcessors(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	destConn := getConnectionMock(mockAccountId, "test-1")
+	destConnAssociation := mockJobDestConnAssociation(job.ID, destConn.ID, &pg_models.JobDestinationOptions{})
+	jobId := nucleusdb.UUIDString(job.ID)
+
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	mockGetJob(m.UserAccountServiceMock, m.QuerierMock, job, []db_queries.NeosyncApiJobDestinationConnectionAssociation{destConnAssociation})
+
+	processors := "- mapping: 'root = this.merge({\"source\": \"neosync\"})'"
+	m.QuerierMock.On("SetJobTableProcessors", mock.Anything, mock.Anything, db_queries.SetJobTableProcessorsParams{
+		ID: job.ID,
+		TableProcessors: []*pg_models.JobTableProcessor{
+			{Schema: "public", Table: "users", Processors: processors},
+		},
+		UpdatedByID: userUuid,
+	}).Return(job, nil)
+
+	resp, err := m.Service.SetJobTableProcessors(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetJobTableProcessorsRequest{
+		Id: jobId,
+		TableProcessors: []*mgmtv1alpha1.JobTableProcessor{
+			{Schema: "public", Table: "users", Processors: processors},
+		},
+	}))
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+}
+
+func Test_toJobTableProcessors(t *testing.T) {
+	output, err := toJobTableProcessors(nil)
+	require.NoError(t, err)
+	require.Empty(t, output)
+
+	output, err = toJobTableProcessors([]*mgmtv1alpha1.JobTableProcessor{
+		{Schema: "public", Table: "users", Processors: "- label: tag\n  mapping: 'root = this'\n- log:\n    message: hi"},
+	})
+	require.NoError(t, err)
+	require.Len(t, output, 1)
+
+	_, err = toJobTableProcessors([]*mgmtv1alpha1.JobTableProcessor{
+		{Schema: "public", Table: "users", Processors: "- mapping: 'root = this'"},
+		{Schema: "public", Table: "users", Processors: "- mapping: 'root = this'"},
+	})
+	require.Error(t, err)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	output, err = toJobTableProcessors([]*mgmtv1alpha1.JobTableProcessor{
+		{Schema: "a.b", Table: "c", Processors: "- mapping: 'root = this'"},
+		{Schema: "a", Table: "b.c", Processors: "- mapping: 'root = this'"},
+	})
+	require.NoError(t, err)
+	require.Len(t, output, 2)
+
+	invalid := []string{
+		"mapping: 'root = this'",
+		"[]",
+		"- label: only-a-label",
+		"- mapping: 'root = this'\n  log:\n    message: hi",
+		"- command:\n    name: rm",
+		"- subprocess:\n    name: sh",
+		"- http:\n    url: http://example.com",
+		"- sql_raw:\n    driver: postgres\n    dsn: postgres://localhost\n    query: DROP TABLE users",
+		"- mapping: 'root = '",
+	}
+	for _, processors := range invalid {
+		_, err = toJobTableProcessors([]*mgmtv1alpha1.JobTableProcessor{
+			{Schema: "public", Table: "users", Processors: processors},
+		})
+		require.Error(t, err, processors)
+		require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), processors)
+	}
+}
@@ -0,0 +1,214 @@
+package v1alpha1_metricsservice
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	"github.com/nucleuscloud/neosync/backend/pkg/metrics"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	dashboardDays            = 30
+	dashboardTopFailingJobs  = 5
+	dashboardUpcomingRuns    = 10
+	dashboardNextRunsWorkers = 10
+)
+
+func (s *Service) GetAccountDashboard(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetAccountDashboardRequest],
+) (*connect.Response[mgmtv1alpha1.GetAccountDashboardResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("accountId", req.Msg.GetAccountId())
+
+	if _, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId()); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	end := toEndOfDay(now)
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -(dashboardDays - 1))
+
+	errgrp, errctx := errgroup.WithContext(ctx)
+
+	var jobs []*mgmtv1alpha1.Job
+	errgrp.Go(func() error {
+		resp, err := s.jobservice.GetJobs(errctx, connect.NewRequest(&mgmtv1alpha1.GetJobsRequest{AccountId: req.Msg.GetAccountId()}))
+		if err != nil {
+			return fmt.Errorf("unable to retrieve jobs: %w", err)
+		}
+		jobs = resp.Msg.GetJobs()
+		return nil
+	})
+	var runs []*mgmtv1alpha1.JobRun
+	errgrp.Go(func() error {
+		resp, err := s.jobservice.GetJobRuns(errctx, connect.NewRequest(&mgmtv1alpha1.GetJobRunsRequest{
+			Id: &mgmtv1alpha1.GetJobRunsRequest_AccountId{AccountId: req.Msg.GetAccountId()},
+		}))
+		if err != nil {
+			return fmt.Errorf("unable to retrieve job runs: %w", err)
+		}
+		runs = resp.Msg.GetJobRuns()
+		return nil
+	})
+	var rowsSynced []*mgmtv1alpha1.DayResult
+	errgrp.Go(func() error {
+		query, err := getPromQueryFromMetric(mgmtv1alpha1.RangedMetricName_RANGED_METRIC_NAME_INPUT_RECEIVED, metrics.MetricLabels{
+			metrics.NewNotEqLabel(metrics.IsUpdateConfigLabel, "true"),
+			metrics.NewEqLabel(metrics.AccountIdLabel, req.Msg.GetAccountId()),
+		}, "1d")
+		if err != nil {
+			return fmt.Errorf("unable to compute valid prometheus query: %w", err)
+		}
+		results, _, err := getDailyUsageFromProm(errctx, s.prometheusclient, query, start, end, logger)
+		if err != nil {
+			return err
+		}
+		rowsSynced = results
+		return nil
+	})
+	if err := errgrp.Wait(); err != nil {
+		return nil, err
+	}
+
+	jobNames := make(map[string]string, len(jobs))
+	for _, job := range jobs {
+		jobNames[job.GetId()] = job.GetName()
+	}
+
+	summary := summarizeJobRuns(runs, start, jobNames)
+	upcomingRuns := s.getUpcomingRuns(ctx, jobs)
+
+	return connect.NewResponse(&mgmtv1alpha1.GetAccountDashboardResponse{
+		TotalRuns:      summary.totalRuns,
+		SuccessfulRuns: summary.successfulRuns,
+		FailedRuns:     summary.failedRuns,
+		SuccessRate:    summary.successRate(),
+		RowsSynced:     rowsSynced,
+		TopFailingJobs: summary.topFailingJobs(dashboardTopFailingJobs),
+		UpcomingRuns:   upcomingRuns,
+	}), nil
+}
+
+type jobRunSummary struct {
+	totalRuns      uint32
+	successfulRuns uint32
+	failedRuns     uint32
+	jobs           map[string]*mgmtv1alpha1.DashboardFailingJob
+}
+
+// Tallies the runs that started at or after the given time
+func summarizeJobRuns(runs []*mgmtv1alpha1.JobRun, since time.Time, jobNames map[string]string) *jobRunSummary {
+	summary := &jobRunSummary{jobs: map[string]*mgmtv1alpha1.DashboardFailingJob{}}
+	for _, run := range runs {
+		if run.GetStartedAt() == nil || run.GetStartedAt().AsTime().Before(since) {
+			continue
+		}
+		job, ok := summary.jobs[run.GetJobId()]
+		if !ok {
+			job = &mgmtv1alpha1.DashboardFailingJob{JobId: run.GetJobId(), JobName: jobNames[run.GetJobId()]}
+			summary.jobs[run.GetJobId()] = job
+		}
+		summary.totalRuns++
+		job.TotalRuns++
+
+		switch run.GetStatus() {
+		case mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_COMPLETE:
+			summary.successfulRuns++
+		case mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_ERROR,
+			mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_FAILED,
+			mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_TERMINATED:
+			summary.failedRuns++
+			job.FailedRuns++
+			if job.GetLastFailedAt() == nil || run.GetStartedAt().AsTime().After(job.GetLastFailedAt().AsTime()) {
+				job.LastFailedAt = run.GetStartedAt()
+			}
+		}
+	}
+	return summary
+}
+
+// Runs that are still in progress or were canceled do not count against the success rate
+func (j *jobRunSummary) successRate() float64 {
+	finished := j.successfulRuns + j.failedRuns
+	if finished == 0 {
+		return 0
+	}
+	return float64(j.successfulRuns) / float64(finished)
+}
+
+func (j *jobRunSummary) topFailingJobs(limit int) []*mgmtv1alpha1.DashboardFailingJob {
+	failing := []*mgmtv1alpha1.DashboardFailingJob{}
+	for _, job := range j.jobs {
+		if job.GetFailedRuns() > 0 {
+			failing = append(failing, job)
+		}
+	}
+	slices.SortFunc(failing, func(a, b *mgmtv1alpha1.DashboardFailingJob) int {
+		return cmp.Or(
+			cmp.Compare(b.GetFailedRuns(), a.GetFailedRuns()),
+			b.GetLastFailedAt().AsTime().Compare(a.GetLastFailedAt().AsTime()),
+			cmp.Compare(a.GetJobId(), b.GetJobId()),
+		)
+	})
+	if len(failing) > limit {
+		failing = failing[:limit]
+	}
+	return failing
+}
+
+// Returns the next run of every scheduled job, soonest first.
+// Jobs whose schedule can't be retrieved are left out so that a single bad schedule doesn't fail the dashboard
+func (s *Service) getUpcomingRuns(ctx context.Context, jobs []*mgmtv1alpha1.Job) []*mgmtv1alpha1.DashboardUpcomingRun {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+
+	upcoming := []*mgmtv1alpha1.DashboardUpcomingRun{}
+	mu := sync.Mutex{}
+	errgrp := errgroup.Group{}
+	errgrp.SetLimit(dashboardNextRunsWorkers)
+	for _, job := range jobs {
+		job := job
+		if job.GetCronSchedule() == "" {
+			continue
+		}
+		errgrp.Go(func() error {
+			resp, err := s.jobservice.GetJobNextRuns(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobNextRunsRequest{JobId: job.GetId()}))
+			if err != nil {
+				logger.Warn(fmt.Sprintf("unable to retrieve next runs for job %s: %s", job.GetId(), err.Error()))
+				return nil
+			}
+			nextRunTimes := resp.Msg.GetNextRuns().GetNextRunTimes()
+			if len(nextRunTimes) == 0 {
+				return nil
+			}
+			mu.Lock()
+			upcoming = append(upcoming, &mgmtv1alpha1.DashboardUpcomingRun{
+				JobId:       job.GetId(),
+				JobName:     job.GetName(),
+				NextRunTime: nextRunTimes[0],
+			})
+			mu.Unlock()
+			return nil
+		})
+	}
+	_ = errgrp.Wait()
+
+	slices.SortFunc(upcoming, func(a, b *mgmtv1alpha1.DashboardUpcomingRun) int {
+		return cmp.Or(
+			a.GetNextRunTime().AsTime().Compare(b.GetNextRunTime().AsTime()),
+			cmp.Compare(a.GetJobId(), b.GetJobId()),
+		)
+	})
+	if len(upcoming) > dashboardUpcomingRuns {
+		upcoming = upcoming[:dashboardUpcomingRuns]
+	}
+	return upcoming
+}
@@ -0,0 +1,126 @@
+package v1alpha1_metricsservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_GetAccountDashboard(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+
+	now := time.Now().UTC()
+	scheduledJobId := "a5b6d4a3-2f74-4bd8-a1f9-6f58a8e7d7f1"
+	schedule := "0 * * * *"
+	m.JobServiceMock.On("GetJobs", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetJobsResponse{
+		Jobs: []*mgmtv1alpha1.Job{
+			{Id: mockJobId, Name: "failing-job"},
+			{Id: scheduledJobId, Name: "scheduled-job", CronSchedule: &schedule},
+		},
+	}), nil)
+	m.JobServiceMock.On("GetJobRuns", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetJobRunsResponse{
+		JobRuns: []*mgmtv1alpha1.JobRun{
+			{Id: "1", JobId: mockJobId, Status: mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_ERROR, StartedAt: timestamppb.New(now.Add(-2 * time.Hour))},
+			{Id: "2", JobId: mockJobId, Status: mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_FAILED, StartedAt: timestamppb.New(now.Add(-1 * time.Hour))},
+			{Id: "3", JobId: mockJobId, Status: mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_COMPLETE, StartedAt: timestamppb.New(now.Add(-3 * time.Hour))},
+			{Id: "4", JobId: scheduledJobId, Status: mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_COMPLETE, StartedAt: timestamppb.New(now.Add(-4 * time.Hour))},
+			{Id: "5", JobId: scheduledJobId, Status: mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_RUNNING, StartedAt: timestamppb.New(now)},
+			// outside of the dashboard window
+			{Id: "6", JobId: scheduledJobId, Status: mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_FAILED, StartedAt: timestamppb.New(now.AddDate(0, 0, -45))},
+		},
+	}), nil)
+	nextRun := timestamppb.New(now.Add(time.Hour))
+	m.JobServiceMock.On("GetJobNextRuns", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetJobNextRunsResponse{
+		NextRuns: &mgmtv1alpha1.JobNextRuns{NextRunTimes: []*timestamppb.Timestamp{nextRun}},
+	}), nil)
+	m.PromApiMock.On("Query", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).
+		Return(model.Vector{{Value: 10}}, promv1.Warnings{}, nil)
+
+	resp, err := m.Service.GetAccountDashboard(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetAccountDashboardRequest{
+		AccountId: mockAccountId,
+	}))
+
+	require.NoError(t, err)
+	require.Equal(t, uint32(5), resp.Msg.GetTotalRuns())
+	require.Equal(t, uint32(2), resp.Msg.GetSuccessfulRuns())
+	require.Equal(t, uint32(2), resp.Msg.GetFailedRuns())
+	require.Equal(t, 0.5, resp.Msg.GetSuccessRate())
+	require.Len(t, resp.Msg.GetRowsSynced(), dashboardDays)
+	require.Equal(t, uint64(10), resp.Msg.GetRowsSynced()[0].GetCount())
+
+	require.Len(t, resp.Msg.GetTopFailingJobs(), 1)
+	failing := resp.Msg.GetTopFailingJobs()[0]
+	require.Equal(t, mockJobId, failing.GetJobId())
+	require.Equal(t, "failing-job", failing.GetJobName())
+	require.Equal(t, uint32(2), failing.GetFailedRuns())
+	require.Equal(t, uint32(3), failing.GetTotalRuns())
+	require.True(t, failing.GetLastFailedAt().AsTime().Equal(now.Add(-1*time.Hour)))
+
+	require.Len(t, resp.Msg.GetUpcomingRuns(), 1)
+	require.Equal(t, scheduledJobId, resp.Msg.GetUpcomingRuns()[0].GetJobId())
+	require.True(t, resp.Msg.GetUpcomingRuns()[0].GetNextRunTime().AsTime().Equal(nextRun.AsTime()))
+	m.JobServiceMock.AssertNumberOfCalls(t, "GetJobNextRuns", 1)
+}
+
+func Test_GetAccountDashboard_NextRunsError(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+
+	schedule := "0 * * * *"
+	m.JobServiceMock.On("GetJobs", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetJobsResponse{
+		Jobs: []*mgmtv1alpha1.Job{{Id: mockJobId, Name: "scheduled-job", CronSchedule: &schedule}},
+	}), nil)
+	m.JobServiceMock.On("GetJobRuns", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetJobRunsResponse{}), nil)
+	m.JobServiceMock.On("GetJobNextRuns", mock.Anything, mock.Anything).Return(nil, errors.New("schedule not found"))
+	m.PromApiMock.On("Query", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).
+		Return(model.Vector{}, promv1.Warnings{}, nil)
+
+	resp, err := m.Service.GetAccountDashboard(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetAccountDashboardRequest{
+		AccountId: mockAccountId,
+	}))
+
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), resp.Msg.GetTotalRuns())
+	require.Equal(t, float64(0), resp.Msg.GetSuccessRate())
+	require.Empty(t, resp.Msg.GetTopFailingJobs())
+	require.Empty(t, resp.Msg.GetUpcomingRuns())
+}
+
+func Test_GetAccountDashboard_Unauthorized(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	mockIsUserInAccount(m.UserAccountServiceMock, false)
+
+	resp, err := m.Service.GetAccountDashboard(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetAccountDashboardRequest{
+		AccountId: mockAccountId,
+	}))
+
+	require.Error(t, err)
+	require.Nil(t, resp)
+}
+
+func Test_summarizeJobRuns_TopFailingJobs_Limit(t *testing.T) {
+	now := time.Now().UTC()
+	runs := []*mgmtv1alpha1.JobRun{
+		{JobId: "a", Status: mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_FAILED, StartedAt: timestamppb.New(now)},
+		{JobId: "b", Status: mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_FAILED, StartedAt: timestamppb.New(now)},
+		{JobId: "b", Status: mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_TERMINATED, StartedAt: timestamppb.New(now)},
+		{JobId: "c", Status: mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_CANCELED, StartedAt: timestamppb.New(now)},
+	}
+	summary := summarizeJobRuns(runs, now.Add(-time.Hour), map[string]string{})
+
+	failing := summary.topFailingJobs(1)
+	require.Len(t, failing, 1)
+	require.Equal(t, "b", failing[0].GetJobId())
+	require.Len(t, summary.topFailingJobs(5), 2)
+	require.Equal(t, float64(0), summary.successRate())
+}
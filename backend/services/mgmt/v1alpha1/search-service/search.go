@@ -0,0 +1,275 @@
+package v1alpha1_searchservice
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/classification"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+)
+
+const (
+	defaultSearchLimit = 20
+
+	fieldName  = "name"
+	fieldId    = "id"
+	fieldTable = "table"
+	fieldTag   = "tag"
+)
+
+// Lower scores are more relevant
+const (
+	scoreExactName = iota
+	scoreNamePrefix
+	scoreName
+	scoreFieldPrefix
+	scoreField
+)
+
+var defaultSearchTypes = []mgmtv1alpha1.SearchResourceType{
+	mgmtv1alpha1.SearchResourceType_SEARCH_RESOURCE_TYPE_JOB,
+	mgmtv1alpha1.SearchResourceType_SEARCH_RESOURCE_TYPE_CONNECTION,
+	mgmtv1alpha1.SearchResourceType_SEARCH_RESOURCE_TYPE_TRANSFORMER,
+}
+
+func (s *Service) SearchResources(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.SearchResourcesRequest],
+) (*connect.Response[mgmtv1alpha1.SearchResourcesResponse], error) {
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	query := strings.ToLower(strings.TrimSpace(req.Msg.GetQuery()))
+	if query == "" {
+		return nil, nucleuserrors.NewBadRequest("must provide a non-empty search query")
+	}
+	types := req.Msg.GetTypes()
+	if len(types) == 0 {
+		types = defaultSearchTypes
+	}
+	limit := defaultSearchLimit
+	if req.Msg.Limit != nil {
+		limit = int(req.Msg.GetLimit())
+	}
+
+	matches := []*searchMatch{}
+	if slices.Contains(types, mgmtv1alpha1.SearchResourceType_SEARCH_RESOURCE_TYPE_JOB) {
+		jobs, err := s.db.Q.GetJobsByAccount(ctx, s.db.Db, *accountUuid)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve jobs: %w", err)
+		}
+		matches = append(matches, matchJobs(jobs, query)...)
+	}
+	if slices.Contains(types, mgmtv1alpha1.SearchResourceType_SEARCH_RESOURCE_TYPE_CONNECTION) {
+		connections, err := s.db.Q.GetConnectionsByAccount(ctx, s.db.Db, *accountUuid)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve connections: %w", err)
+		}
+		tags, err := s.db.Q.GetColumnTagsByAccount(ctx, s.db.Db, *accountUuid)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve column tags: %w", err)
+		}
+		matches = append(matches, matchConnections(connections, tags, query)...)
+	}
+	if slices.Contains(types, mgmtv1alpha1.SearchResourceType_SEARCH_RESOURCE_TYPE_TRANSFORMER) {
+		transformers, err := s.db.Q.GetUserDefinedTransformersByAccount(ctx, s.db.Db, *accountUuid)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve transformers: %w", err)
+		}
+		matches = append(matches, matchTransformers(transformers, query)...)
+	}
+	if slices.Contains(types, mgmtv1alpha1.SearchResourceType_SEARCH_RESOURCE_TYPE_JOB_RUN) {
+		runsResp, err := s.jobService.GetJobRuns(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRunsRequest{
+			Id: &mgmtv1alpha1.GetJobRunsRequest_AccountId{AccountId: req.Msg.GetAccountId()},
+		}))
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve job runs: %w", err)
+		}
+		matches = append(matches, matchJobRuns(runsResp.Msg.GetJobRuns(), query)...)
+	}
+
+	slices.SortStableFunc(matches, func(a, b *searchMatch) int {
+		return cmp.Or(
+			cmp.Compare(a.score, b.score),
+			cmp.Compare(a.result.GetName(), b.result.GetName()),
+			cmp.Compare(a.result.GetType(), b.result.GetType()),
+		)
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	results := make([]*mgmtv1alpha1.SearchResult, 0, len(matches))
+	for _, match := range matches {
+		results = append(results, match.result)
+	}
+	return connect.NewResponse(&mgmtv1alpha1.SearchResourcesResponse{
+		Results: results,
+	}), nil
+}
+
+type searchMatch struct {
+	result *mgmtv1alpha1.SearchResult
+	score  int
+}
+
+type searchCandidate struct {
+	field string
+	// the value that is matched against the query
+	value string
+	// the value that is returned to the caller. defaults to value
+	display string
+}
+
+func matchJobs(jobs []db_queries.NeosyncApiJob, query string) []*searchMatch {
+	matches := []*searchMatch{}
+	for idx := range jobs {
+		job := &jobs[idx]
+		candidates := []*searchCandidate{{field: fieldName, value: job.Name}}
+		seen := map[string]struct{}{}
+		for _, mapping := range job.Mappings {
+			table := fmt.Sprintf("%s.%s", mapping.Schema, mapping.Table)
+			if _, ok := seen[table]; ok {
+				continue
+			}
+			seen[table] = struct{}{}
+			candidates = append(candidates, &searchCandidate{field: fieldTable, value: table})
+		}
+		if match := bestMatch(candidates, query, &mgmtv1alpha1.SearchResult{
+			Type: mgmtv1alpha1.SearchResourceType_SEARCH_RESOURCE_TYPE_JOB,
+			Id:   nucleusdb.UUIDString(job.ID),
+			Name: job.Name,
+		}); match != nil {
+			matches = append(matches, match)
+		}
+	}
+	return matches
+}
+
+func matchConnections(
+	connections []db_queries.NeosyncApiConnection,
+	tags []db_queries.NeosyncApiConnectionColumnTag,
+	query string,
+) []*searchMatch {
+	tagCandidates := map[string][]*searchCandidate{}
+	for _, tag := range tags {
+		connectionId := nucleusdb.UUIDString(tag.ConnectionID)
+		column := classification.BuildColumnKey(tag.SchemaName, tag.TableName, tag.ColumnName)
+		for _, c := range tag.Classifications {
+			label := getClassificationLabel(mgmtv1alpha1.DataClassification(c))
+			tagCandidates[connectionId] = append(tagCandidates[connectionId], &searchCandidate{
+				field:   fieldTag,
+				value:   label,
+				display: fmt.Sprintf("%s: %s", label, column),
+			})
+		}
+	}
+
+	matches := []*searchMatch{}
+	for idx := range connections {
+		connection := &connections[idx]
+		connectionId := nucleusdb.UUIDString(connection.ID)
+		candidates := append([]*searchCandidate{{field: fieldName, value: connection.Name}}, tagCandidates[connectionId]...)
+		if match := bestMatch(candidates, query, &mgmtv1alpha1.SearchResult{
+			Type: mgmtv1alpha1.SearchResourceType_SEARCH_RESOURCE_TYPE_CONNECTION,
+			Id:   connectionId,
+			Name: connection.Name,
+		}); match != nil {
+			matches = append(matches, match)
+		}
+	}
+	return matches
+}
+
+func matchTransformers(transformers []db_queries.NeosyncApiTransformer, query string) []*searchMatch {
+	matches := []*searchMatch{}
+	for idx := range transformers {
+		transformer := &transformers[idx]
+		if match := bestMatch([]*searchCandidate{{field: fieldName, value: transformer.Name}}, query, &mgmtv1alpha1.SearchResult{
+			Type: mgmtv1alpha1.SearchResourceType_SEARCH_RESOURCE_TYPE_TRANSFORMER,
+			Id:   nucleusdb.UUIDString(transformer.ID),
+			Name: transformer.Name,
+		}); match != nil {
+			matches = append(matches, match)
+		}
+	}
+	return matches
+}
+
+func matchJobRuns(runs []*mgmtv1alpha1.JobRun, query string) []*searchMatch {
+	matches := []*searchMatch{}
+	for _, run := range runs {
+		jobId := run.GetJobId()
+		if match := bestMatch([]*searchCandidate{
+			{field: fieldName, value: run.GetName()},
+			{field: fieldId, value: run.GetId()},
+		}, query, &mgmtv1alpha1.SearchResult{
+			Type:  mgmtv1alpha1.SearchResourceType_SEARCH_RESOURCE_TYPE_JOB_RUN,
+			Id:    run.GetId(),
+			Name:  run.GetName(),
+			JobId: &jobId,
+		}); match != nil {
+			matches = append(matches, match)
+		}
+	}
+	return matches
+}
+
+// Scores every candidate against the query and returns the result populated with the most relevant one.
+// Returns nil if no candidate matches
+func bestMatch(candidates []*searchCandidate, query string, result *mgmtv1alpha1.SearchResult) *searchMatch {
+	var best *searchCandidate
+	bestScore := -1
+	for _, candidate := range candidates {
+		score := scoreCandidate(candidate, query)
+		if score < 0 {
+			continue
+		}
+		if best == nil || score < bestScore {
+			best = candidate
+			bestScore = score
+		}
+	}
+	if best == nil {
+		return nil
+	}
+	result.MatchedField = best.field
+	result.MatchedValue = best.value
+	if best.display != "" {
+		result.MatchedValue = best.display
+	}
+	return &searchMatch{result: result, score: bestScore}
+}
+
+// Returns -1 if the candidate does not match the query
+func scoreCandidate(candidate *searchCandidate, query string) int {
+	value := strings.ToLower(candidate.value)
+	isName := candidate.field == fieldName
+	switch {
+	case isName && value == query:
+		return scoreExactName
+	case isName && strings.HasPrefix(value, query):
+		return scoreNamePrefix
+	case isName && strings.Contains(value, query):
+		return scoreName
+	case strings.HasPrefix(value, query):
+		return scoreFieldPrefix
+	case strings.Contains(value, query):
+		return scoreField
+	default:
+		return -1
+	}
+}
+
+// Returns the lower case name of the classification, e.g. pii
+func getClassificationLabel(c mgmtv1alpha1.DataClassification) string {
+	return strings.ToLower(strings.TrimPrefix(c.String(), "DATA_CLASSIFICATION_"))
+}
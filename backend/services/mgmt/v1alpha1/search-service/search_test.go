@@ -0,0 +1,164 @@
+package v1alpha1_searchservice
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockAccountId    = "5629813e-1a35-4874-922c-9827d85f0378"
+	mockJobId        = "9e9ec62a-e0f8-4f0b-bf04-7de327ab6f9c"
+	mockOtherJobId   = "1f6a7c5e-7b3d-4b8e-9a0c-52f0f3d4b9a1"
+	mockConnectionId = "884765c6-1708-488d-b03a-70a02b12c81e"
+)
+
+type serviceMocks struct {
+	Service                *Service
+	DbtxMock               *nucleusdb.MockDBTX
+	QuerierMock            *db_queries.MockQuerier
+	UserAccountServiceMock *mgmtv1alpha1connect.MockUserAccountServiceClient
+	JobServiceMock         *mgmtv1alpha1connect.MockJobServiceHandler
+}
+
+func createServiceMock(t *testing.T) *serviceMocks {
+	mockDbtx := nucleusdb.NewMockDBTX(t)
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+	mockJobService := mgmtv1alpha1connect.NewMockJobServiceHandler(t)
+
+	service := New(&Config{}, nucleusdb.New(mockDbtx, mockQuerier), mockUserAccountService, mockJobService)
+
+	return &serviceMocks{
+		Service:                service,
+		DbtxMock:               mockDbtx,
+		QuerierMock:            mockQuerier,
+		UserAccountServiceMock: mockUserAccountService,
+		JobServiceMock:         mockJobService,
+	}
+}
+
+func Test_SearchResources(t *testing.T) {
+	m := createServiceMock(t)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+
+	jobUuid, _ := nucleusdb.ToUuid(mockJobId)
+	otherJobUuid, _ := nucleusdb.ToUuid(mockOtherJobId)
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+	m.QuerierMock.On("GetJobsByAccount", mock.Anything, mock.Anything, mock.Anything).Return([]db_queries.NeosyncApiJob{
+		{ID: jobUuid, Name: "nightly-sync", Mappings: []*pg_models.JobMapping{
+			{Schema: "billing", Table: "invoices", Column: "id"},
+			{Schema: "billing", Table: "invoices", Column: "amount"},
+		}},
+		{ID: otherJobUuid, Name: "invoices", Mappings: []*pg_models.JobMapping{}},
+	}, nil)
+	m.QuerierMock.On("GetConnectionsByAccount", mock.Anything, mock.Anything, mock.Anything).Return([]db_queries.NeosyncApiConnection{
+		{ID: connectionUuid, Name: "prod-db"},
+	}, nil)
+	m.QuerierMock.On("GetColumnTagsByAccount", mock.Anything, mock.Anything, mock.Anything).Return([]db_queries.NeosyncApiConnectionColumnTag{
+		{ConnectionID: connectionUuid, SchemaName: "billing", TableName: "invoices", ColumnName: "card", Classifications: []int32{int32(mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_FINANCIAL)}},
+	}, nil)
+	m.QuerierMock.On("GetUserDefinedTransformersByAccount", mock.Anything, mock.Anything, mock.Anything).Return([]db_queries.NeosyncApiTransformer{}, nil)
+
+	resp, err := m.Service.SearchResources(context.Background(), connect.NewRequest(&mgmtv1alpha1.SearchResourcesRequest{
+		AccountId: mockAccountId,
+		Query:     "Invoices",
+	}))
+	require.NoError(t, err)
+	results := resp.Msg.GetResults()
+	require.Len(t, results, 2)
+	require.Equal(t, mockOtherJobId, results[0].GetId())
+	require.Equal(t, "name", results[0].GetMatchedField())
+	require.Equal(t, mockJobId, results[1].GetId())
+	require.Equal(t, "table", results[1].GetMatchedField())
+	require.Equal(t, "billing.invoices", results[1].GetMatchedValue())
+	m.JobServiceMock.AssertNotCalled(t, "GetJobRuns", mock.Anything, mock.Anything)
+}
+
+func Test_SearchResources_Tags(t *testing.T) {
+	m := createServiceMock(t)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+
+	connectionUuid, _ := nucleusdb.ToUuid(mockConnectionId)
+	m.QuerierMock.On("GetConnectionsByAccount", mock.Anything, mock.Anything, mock.Anything).Return([]db_queries.NeosyncApiConnection{
+		{ID: connectionUuid, Name: "prod-db"},
+	}, nil)
+	m.QuerierMock.On("GetColumnTagsByAccount", mock.Anything, mock.Anything, mock.Anything).Return([]db_queries.NeosyncApiConnectionColumnTag{
+		{ConnectionID: connectionUuid, SchemaName: "billing", TableName: "invoices", ColumnName: "card", Classifications: []int32{int32(mgmtv1alpha1.DataClassification_DATA_CLASSIFICATION_FINANCIAL)}},
+	}, nil)
+
+	resp, err := m.Service.SearchResources(context.Background(), connect.NewRequest(&mgmtv1alpha1.SearchResourcesRequest{
+		AccountId: mockAccountId,
+		Query:     "financ",
+		Types:     []mgmtv1alpha1.SearchResourceType{mgmtv1alpha1.SearchResourceType_SEARCH_RESOURCE_TYPE_CONNECTION},
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.GetResults(), 1)
+	require.Equal(t, "tag", resp.Msg.GetResults()[0].GetMatchedField())
+	require.Equal(t, "financial: billing.invoices.card", resp.Msg.GetResults()[0].GetMatchedValue())
+	m.QuerierMock.AssertNotCalled(t, "GetJobsByAccount", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_SearchResources_JobRuns(t *testing.T) {
+	m := createServiceMock(t)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+
+	m.JobServiceMock.On("GetJobRuns", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetJobRunsResponse{
+		JobRuns: []*mgmtv1alpha1.JobRun{
+			{Id: "nightly-sync-2024-04-17T00:00:00Z", JobId: mockJobId, Name: "nightly-sync"},
+			{Id: "other-2024-04-17T00:00:00Z", JobId: mockOtherJobId, Name: "other"},
+		},
+	}), nil)
+
+	resp, err := m.Service.SearchResources(context.Background(), connect.NewRequest(&mgmtv1alpha1.SearchResourcesRequest{
+		AccountId: mockAccountId,
+		Query:     "nightly",
+		Types:     []mgmtv1alpha1.SearchResourceType{mgmtv1alpha1.SearchResourceType_SEARCH_RESOURCE_TYPE_JOB_RUN},
+		Limit:     ptr(uint32(5)),
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.GetResults(), 1)
+	require.Equal(t, mockJobId, resp.Msg.GetResults()[0].GetJobId())
+}
+
+func Test_SearchResources_Limit(t *testing.T) {
+	m := createServiceMock(t)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+
+	transformers := []db_queries.NeosyncApiTransformer{}
+	for _, name := range []string{"mask-email", "mask", "unmask", "mask-name"} {
+		transformers = append(transformers, db_queries.NeosyncApiTransformer{Name: name})
+	}
+	m.QuerierMock.On("GetUserDefinedTransformersByAccount", mock.Anything, mock.Anything, mock.Anything).Return(transformers, nil)
+
+	resp, err := m.Service.SearchResources(context.Background(), connect.NewRequest(&mgmtv1alpha1.SearchResourcesRequest{
+		AccountId: mockAccountId,
+		Query:     "mask",
+		Types:     []mgmtv1alpha1.SearchResourceType{mgmtv1alpha1.SearchResourceType_SEARCH_RESOURCE_TYPE_TRANSFORMER},
+		Limit:     ptr(uint32(3)),
+	}))
+	require.NoError(t, err)
+	names := []string{}
+	for _, result := range resp.Msg.GetResults() {
+		names = append(names, result.GetName())
+	}
+	require.Equal(t, []string{"mask", "mask-email", "mask-name"}, names)
+}
+
+func mockIsUserInAccount(userAccountServiceMock *mgmtv1alpha1connect.MockUserAccountServiceClient, isInAccount bool) {
+	userAccountServiceMock.On("IsUserInAccount", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.IsUserInAccountResponse{
+		Ok: isInAccount,
+	}), nil)
+}
+
+func ptr[T any](val T) *T {
+	return &val
+}
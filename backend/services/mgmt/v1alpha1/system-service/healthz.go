@@ -0,0 +1,42 @@
+package v1alpha1_systemservice
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+)
+
+type healthzResponse struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks"`
+}
+
+// Returns an unauthenticated handler that reports the status of each check for load balancers and uptime monitors.
+// Responds with a 503 if any check is unhealthy. Check messages are left out as they may leak internal details.
+func (s *Service) NewHealthzHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		health := s.getSystemHealth(r.Context())
+
+		resp := healthzResponse{
+			Status: toHealthzStatus(health.GetStatus()),
+			Checks: map[string]string{},
+		}
+		for _, check := range health.GetChecks() {
+			resp.Checks[check.GetName()] = toHealthzStatus(check.GetStatus())
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if health.GetStatus() == mgmtv1alpha1.SystemHealthStatus_SYSTEM_HEALTH_STATUS_UNHEALTHY {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		} else {
+			w.WriteHeader(http.StatusOK)
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+}
+
+func toHealthzStatus(status mgmtv1alpha1.SystemHealthStatus) string {
+	return strings.ToLower(strings.TrimPrefix(status.String(), "SYSTEM_HEALTH_STATUS_"))
+}
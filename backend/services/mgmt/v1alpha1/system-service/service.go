@@ -0,0 +1,48 @@
+package v1alpha1_systemservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	clientmanager "github.com/nucleuscloud/neosync/backend/internal/temporal/client-manager"
+)
+
+type Service struct {
+	cfg     *Config
+	db      *nucleusdb.NucleusDb
+	tfwfmgr clientmanager.TemporalClientManagerClient
+}
+
+type Config struct {
+	// The task queue that the default sync worker polls
+	SyncJobQueueName string
+	// How long a worker can go without polling the task queue before it is considered unhealthy
+	WorkerHeartbeatThreshold time.Duration
+	// Reports the status of the database migrations. Nil if the schema directory has not been configured
+	GetMigrationStatus func(ctx context.Context) (*MigrationStatus, error)
+}
+
+type MigrationStatus struct {
+	// The version of the last applied migration, nil if no migrations have been applied
+	CurrentVersion *uint
+	LatestVersion  uint
+	Dirty          bool
+}
+
+const defaultWorkerHeartbeatThreshold = 2 * time.Minute
+
+func New(
+	cfg *Config,
+	db *nucleusdb.NucleusDb,
+	tfwfmgr clientmanager.TemporalClientManagerClient,
+) *Service {
+	if cfg.WorkerHeartbeatThreshold <= 0 {
+		cfg.WorkerHeartbeatThreshold = defaultWorkerHeartbeatThreshold
+	}
+	return &Service{
+		cfg:     cfg,
+		db:      db,
+		tfwfmgr: tfwfmgr,
+	}
+}
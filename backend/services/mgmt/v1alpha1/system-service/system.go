@@ -0,0 +1,194 @@
+package v1alpha1_systemservice
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	enumspb "go.temporal.io/api/enums/v1"
+	temporalclient "go.temporal.io/sdk/client"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	checkTimeout = 5 * time.Second
+
+	databaseCheckName   = "database"
+	temporalCheckName   = "temporal"
+	workerCheckName     = "worker"
+	migrationsCheckName = "migrations"
+)
+
+func (s *Service) GetSystemHealth(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetSystemHealthRequest],
+) (*connect.Response[mgmtv1alpha1.GetSystemHealthResponse], error) {
+	return connect.NewResponse(s.getSystemHealth(ctx)), nil
+}
+
+func (s *Service) getSystemHealth(ctx context.Context) *mgmtv1alpha1.GetSystemHealthResponse {
+	checkFns := []func(ctx context.Context) *mgmtv1alpha1.SystemHealthCheck{
+		s.checkDatabase,
+		s.checkTemporal,
+		s.checkWorker,
+	}
+	if s.cfg.GetMigrationStatus != nil {
+		checkFns = append(checkFns, s.checkMigrations)
+	}
+
+	checks := make([]*mgmtv1alpha1.SystemHealthCheck, len(checkFns))
+	var wg sync.WaitGroup
+	for idx, checkFn := range checkFns {
+		wg.Add(1)
+		go func(idx int, checkFn func(ctx context.Context) *mgmtv1alpha1.SystemHealthCheck) {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+			defer cancel()
+			checks[idx] = checkFn(checkCtx)
+		}(idx, checkFn)
+	}
+	wg.Wait()
+
+	return &mgmtv1alpha1.GetSystemHealthResponse{
+		Status: getOverallStatus(checks),
+		Checks: checks,
+	}
+}
+
+func (s *Service) checkDatabase(ctx context.Context) *mgmtv1alpha1.SystemHealthCheck {
+	_, err := s.db.Db.Exec(ctx, "SELECT 1")
+	if err != nil {
+		return unhealthyCheck(databaseCheckName, fmt.Sprintf("unable to reach the database: %s", err.Error()))
+	}
+	return &mgmtv1alpha1.SystemHealthCheck{Name: databaseCheckName, Status: mgmtv1alpha1.SystemHealthStatus_SYSTEM_HEALTH_STATUS_HEALTHY}
+}
+
+func (s *Service) checkTemporal(ctx context.Context) *mgmtv1alpha1.SystemHealthCheck {
+	client, err := s.getTemporalClient(ctx)
+	if err != nil {
+		return unhealthyCheck(temporalCheckName, err.Error())
+	}
+	_, err = client.CheckHealth(ctx, &temporalclient.CheckHealthRequest{})
+	if err != nil {
+		return unhealthyCheck(temporalCheckName, fmt.Sprintf("unable to reach temporal: %s", err.Error()))
+	}
+	return &mgmtv1alpha1.SystemHealthCheck{Name: temporalCheckName, Status: mgmtv1alpha1.SystemHealthStatus_SYSTEM_HEALTH_STATUS_HEALTHY}
+}
+
+func (s *Service) checkWorker(ctx context.Context) *mgmtv1alpha1.SystemHealthCheck {
+	client, err := s.getTemporalClient(ctx)
+	if err != nil {
+		return unhealthyCheck(workerCheckName, err.Error())
+	}
+	resp, err := client.DescribeTaskQueue(ctx, s.cfg.SyncJobQueueName, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return unhealthyCheck(workerCheckName, fmt.Sprintf("unable to describe task queue %s: %s", s.cfg.SyncJobQueueName, err.Error()))
+	}
+
+	details := &mgmtv1alpha1.WorkerHealthDetails{TaskQueue: s.cfg.SyncJobQueueName}
+	var lastHeartbeat *timestamppb.Timestamp
+	for _, poller := range resp.GetPollers() {
+		lastAccess := poller.GetLastAccessTime()
+		if lastAccess == nil {
+			continue
+		}
+		if time.Since(lastAccess.AsTime()) <= s.cfg.WorkerHeartbeatThreshold {
+			details.PollerCount++
+		}
+		if lastHeartbeat == nil || lastAccess.AsTime().After(lastHeartbeat.AsTime()) {
+			lastHeartbeat = lastAccess
+		}
+	}
+	details.LastHeartbeatAt = lastHeartbeat
+
+	check := &mgmtv1alpha1.SystemHealthCheck{
+		Name:    workerCheckName,
+		Status:  mgmtv1alpha1.SystemHealthStatus_SYSTEM_HEALTH_STATUS_HEALTHY,
+		Details: &mgmtv1alpha1.SystemHealthCheck_Worker{Worker: details},
+	}
+	if details.PollerCount == 0 {
+		check.Status = mgmtv1alpha1.SystemHealthStatus_SYSTEM_HEALTH_STATUS_UNHEALTHY
+		check.Message = ptr(fmt.Sprintf("no worker has polled task queue %s in the last %s", s.cfg.SyncJobQueueName, s.cfg.WorkerHeartbeatThreshold))
+	}
+	return check
+}
+
+func (s *Service) checkMigrations(ctx context.Context) *mgmtv1alpha1.SystemHealthCheck {
+	status, err := s.cfg.GetMigrationStatus(ctx)
+	if err != nil {
+		return unhealthyCheck(migrationsCheckName, fmt.Sprintf("unable to retrieve migration status: %s", err.Error()))
+	}
+
+	details := &mgmtv1alpha1.MigrationHealthDetails{
+		LatestVersion: uint64(status.LatestVersion),
+		Dirty:         status.Dirty,
+	}
+	if status.CurrentVersion != nil {
+		current := uint64(*status.CurrentVersion)
+		details.CurrentVersion = &current
+	}
+
+	check := &mgmtv1alpha1.SystemHealthCheck{
+		Name:    migrationsCheckName,
+		Status:  mgmtv1alpha1.SystemHealthStatus_SYSTEM_HEALTH_STATUS_HEALTHY,
+		Details: &mgmtv1alpha1.SystemHealthCheck_Migrations{Migrations: details},
+	}
+	switch {
+	case status.Dirty:
+		check.Status = mgmtv1alpha1.SystemHealthStatus_SYSTEM_HEALTH_STATUS_UNHEALTHY
+		check.Message = ptr(fmt.Sprintf("migration %d failed part way through and must be fixed manually", details.GetCurrentVersion()))
+	case status.CurrentVersion == nil || *status.CurrentVersion < status.LatestVersion:
+		check.Status = mgmtv1alpha1.SystemHealthStatus_SYSTEM_HEALTH_STATUS_DEGRADED
+		check.Message = ptr(fmt.Sprintf("migrations up to version %d have not been applied", status.LatestVersion))
+	}
+	return check
+}
+
+func (s *Service) getTemporalClient(ctx context.Context) (temporalclient.Client, error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	client, err := s.tfwfmgr.GetDefaultWorkflowClient(logger)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create temporal client: %w", err)
+	}
+	return client, nil
+}
+
+// Returns the worst status of the checks, ignoring any that could not determine a status
+func getOverallStatus(checks []*mgmtv1alpha1.SystemHealthCheck) mgmtv1alpha1.SystemHealthStatus {
+	overall := mgmtv1alpha1.SystemHealthStatus_SYSTEM_HEALTH_STATUS_UNSPECIFIED
+	for _, check := range checks {
+		if getStatusSeverity(check.GetStatus()) > getStatusSeverity(overall) {
+			overall = check.GetStatus()
+		}
+	}
+	return overall
+}
+
+func getStatusSeverity(status mgmtv1alpha1.SystemHealthStatus) int {
+	switch status {
+	case mgmtv1alpha1.SystemHealthStatus_SYSTEM_HEALTH_STATUS_HEALTHY:
+		return 1
+	case mgmtv1alpha1.SystemHealthStatus_SYSTEM_HEALTH_STATUS_DEGRADED:
+		return 2
+	case mgmtv1alpha1.SystemHealthStatus_SYSTEM_HEALTH_STATUS_UNHEALTHY:
+		return 3
+	default:
+		return 0
+	}
+}
+
+func unhealthyCheck(name, message string) *mgmtv1alpha1.SystemHealthCheck {
+	return &mgmtv1alpha1.SystemHealthCheck{
+		Name:    name,
+		Status:  mgmtv1alpha1.SystemHealthStatus_SYSTEM_HEALTH_STATUS_UNHEALTHY,
+		Message: &message,
+	}
+}
+
+func ptr[T any](val T) *T {
+	return &val
+}
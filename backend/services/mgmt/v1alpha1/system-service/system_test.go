@@ -0,0 +1,184 @@
+package v1alpha1_systemservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgconn"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	clientmanager "github.com/nucleuscloud/neosync/backend/internal/temporal/client-manager"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	temporalclient "go.temporal.io/sdk/client"
+	temporalmocks "go.temporal.io/sdk/mocks"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const mockQueueName = "sync-job"
+
+type serviceMocks struct {
+	Service            *Service
+	DbtxMock           *nucleusdb.MockDBTX
+	TemporalClientMock *temporalmocks.Client
+	TfWfMgrMock        *clientmanager.MockTemporalClientManagerClient
+}
+
+func createServiceMock(t *testing.T, cfg *Config) *serviceMocks {
+	mockDbtx := nucleusdb.NewMockDBTX(t)
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockTfWfMgr := clientmanager.NewMockTemporalClientManagerClient(t)
+	mockTemporalClient := temporalmocks.NewClient(t)
+
+	cfg.SyncJobQueueName = mockQueueName
+	service := New(cfg, nucleusdb.New(mockDbtx, mockQuerier), mockTfWfMgr)
+
+	return &serviceMocks{
+		Service:            service,
+		DbtxMock:           mockDbtx,
+		TemporalClientMock: mockTemporalClient,
+		TfWfMgrMock:        mockTfWfMgr,
+	}
+}
+
+func mockHealthyTemporal(m *serviceMocks, pollers []*taskqueuepb.PollerInfo) {
+	m.TfWfMgrMock.On("GetDefaultWorkflowClient", mock.Anything).Return(m.TemporalClientMock, nil)
+	m.TemporalClientMock.On("CheckHealth", mock.Anything, mock.Anything).Return(&temporalclient.CheckHealthResponse{}, nil)
+	m.TemporalClientMock.On("DescribeTaskQueue", mock.Anything, mockQueueName, mock.Anything).
+		Return(&workflowservice.DescribeTaskQueueResponse{Pollers: pollers}, nil)
+}
+
+func getCheck(t *testing.T, resp *mgmtv1alpha1.GetSystemHealthResponse, name string) *mgmtv1alpha1.SystemHealthCheck {
+	t.Helper()
+	for _, check := range resp.GetChecks() {
+		if check.GetName() == name {
+			return check
+		}
+	}
+	require.Failf(t, "missing check", "no check named %s", name)
+	return nil
+}
+
+func Test_GetSystemHealth_Healthy(t *testing.T) {
+	lastAccess := time.Now().Add(-10 * time.Second)
+	current := uint(12)
+	m := createServiceMock(t, &Config{
+		GetMigrationStatus: func(ctx context.Context) (*MigrationStatus, error) {
+			return &MigrationStatus{CurrentVersion: &current, LatestVersion: 12}, nil
+		},
+	})
+	m.DbtxMock.On("Exec", mock.Anything, "SELECT 1").Return(pgconn.NewCommandTag("SELECT 1"), nil)
+	mockHealthyTemporal(m, []*taskqueuepb.PollerInfo{
+		{Identity: "worker-1", LastAccessTime: timestamppb.New(lastAccess)},
+		{Identity: "worker-2", LastAccessTime: timestamppb.New(time.Now().Add(-time.Hour))},
+	})
+
+	resp, err := m.Service.GetSystemHealth(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetSystemHealthRequest{}))
+	require.NoError(t, err)
+	require.Equal(t, mgmtv1alpha1.SystemHealthStatus_SYSTEM_HEALTH_STATUS_HEALTHY, resp.Msg.GetStatus())
+	require.Len(t, resp.Msg.GetChecks(), 4)
+
+	worker := getCheck(t, resp.Msg, workerCheckName)
+	require.Equal(t, mgmtv1alpha1.SystemHealthStatus_SYSTEM_HEALTH_STATUS_HEALTHY, worker.GetStatus())
+	require.Equal(t, mockQueueName, worker.GetWorker().GetTaskQueue())
+	require.Equal(t, uint32(1), worker.GetWorker().GetPollerCount())
+	require.WithinDuration(t, lastAccess, worker.GetWorker().GetLastHeartbeatAt().AsTime(), time.Millisecond)
+
+	migrations := getCheck(t, resp.Msg, migrationsCheckName)
+	require.Equal(t, uint64(12), migrations.GetMigrations().GetCurrentVersion())
+	require.Equal(t, uint64(12), migrations.GetMigrations().GetLatestVersion())
+}
+
+func Test_GetSystemHealth_NoMigrationStatus(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	m.DbtxMock.On("Exec", mock.Anything, "SELECT 1").Return(pgconn.NewCommandTag("SELECT 1"), nil)
+	mockHealthyTemporal(m, []*taskqueuepb.PollerInfo{{LastAccessTime: timestamppb.Now()}})
+
+	resp, err := m.Service.GetSystemHealth(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetSystemHealthRequest{}))
+	require.NoError(t, err)
+	require.Equal(t, mgmtv1alpha1.SystemHealthStatus_SYSTEM_HEALTH_STATUS_HEALTHY, resp.Msg.GetStatus())
+	require.Len(t, resp.Msg.GetChecks(), 3)
+}
+
+func Test_GetSystemHealth_PendingMigrations(t *testing.T) {
+	current := uint(10)
+	m := createServiceMock(t, &Config{
+		GetMigrationStatus: func(ctx context.Context) (*MigrationStatus, error) {
+			return &MigrationStatus{CurrentVersion: &current, LatestVersion: 12}, nil
+		},
+	})
+	m.DbtxMock.On("Exec", mock.Anything, "SELECT 1").Return(pgconn.NewCommandTag("SELECT 1"), nil)
+	mockHealthyTemporal(m, []*taskqueuepb.PollerInfo{{LastAccessTime: timestamppb.Now()}})
+
+	resp, err := m.Service.GetSystemHealth(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetSystemHealthRequest{}))
+	require.NoError(t, err)
+	require.Equal(t, mgmtv1alpha1.SystemHealthStatus_SYSTEM_HEALTH_STATUS_DEGRADED, resp.Msg.GetStatus())
+	migrations := getCheck(t, resp.Msg, migrationsCheckName)
+	require.Equal(t, mgmtv1alpha1.SystemHealthStatus_SYSTEM_HEALTH_STATUS_DEGRADED, migrations.GetStatus())
+	require.NotEmpty(t, migrations.GetMessage())
+}
+
+func Test_GetSystemHealth_DirtyMigrations(t *testing.T) {
+	current := uint(12)
+	m := createServiceMock(t, &Config{
+		GetMigrationStatus: func(ctx context.Context) (*MigrationStatus, error) {
+			return &MigrationStatus{CurrentVersion: &current, LatestVersion: 12, Dirty: true}, nil
+		},
+	})
+	m.DbtxMock.On("Exec", mock.Anything, "SELECT 1").Return(pgconn.NewCommandTag("SELECT 1"), nil)
+	mockHealthyTemporal(m, []*taskqueuepb.PollerInfo{{LastAccessTime: timestamppb.Now()}})
+
+	resp, err := m.Service.GetSystemHealth(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetSystemHealthRequest{}))
+	require.NoError(t, err)
+	require.Equal(t, mgmtv1alpha1.SystemHealthStatus_SYSTEM_HEALTH_STATUS_UNHEALTHY, resp.Msg.GetStatus())
+	require.True(t, getCheck(t, resp.Msg, migrationsCheckName).GetMigrations().GetDirty())
+}
+
+func Test_GetSystemHealth_Unhealthy(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	m.DbtxMock.On("Exec", mock.Anything, "SELECT 1").Return(pgconn.CommandTag{}, errors.New("connection refused"))
+	m.TfWfMgrMock.On("GetDefaultWorkflowClient", mock.Anything).Return(m.TemporalClientMock, nil)
+	m.TemporalClientMock.On("CheckHealth", mock.Anything, mock.Anything).Return(nil, errors.New("unavailable"))
+	m.TemporalClientMock.On("DescribeTaskQueue", mock.Anything, mockQueueName, mock.Anything).
+		Return(&workflowservice.DescribeTaskQueueResponse{}, nil)
+
+	resp, err := m.Service.GetSystemHealth(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetSystemHealthRequest{}))
+	require.NoError(t, err)
+	require.Equal(t, mgmtv1alpha1.SystemHealthStatus_SYSTEM_HEALTH_STATUS_UNHEALTHY, resp.Msg.GetStatus())
+	for _, name := range []string{databaseCheckName, temporalCheckName, workerCheckName} {
+		check := getCheck(t, resp.Msg, name)
+		require.Equal(t, mgmtv1alpha1.SystemHealthStatus_SYSTEM_HEALTH_STATUS_UNHEALTHY, check.GetStatus(), name)
+		require.NotEmpty(t, check.GetMessage(), name)
+	}
+}
+
+func Test_NewHealthzHandler(t *testing.T) {
+	m := createServiceMock(t, &Config{})
+	m.DbtxMock.On("Exec", mock.Anything, "SELECT 1").Return(pgconn.NewCommandTag("SELECT 1"), nil)
+	m.TfWfMgrMock.On("GetDefaultWorkflowClient", mock.Anything).Return(nil, errors.New("not configured"))
+
+	rec := httptest.NewRecorder()
+	m.Service.NewHealthzHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", http.NoBody))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	var body healthzResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Equal(t, healthzResponse{
+		Status: "unhealthy",
+		Checks: map[string]string{
+			databaseCheckName: "healthy",
+			temporalCheckName: "unhealthy",
+			workerCheckName:   "unhealthy",
+		},
+	}, body)
+	require.NotContains(t, rec.Body.String(), "not configured")
+}
@@ -0,0 +1,96 @@
+package v1alpha1_transformersservice
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/dop251/goja"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+)
+
+func (s *Service) GetJavascriptLibraries(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetJavascriptLibrariesRequest],
+) (*connect.Response[mgmtv1alpha1.GetJavascriptLibrariesResponse], error) {
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	libraries, err := s.db.Q.GetJavascriptLibrariesByAccount(ctx, s.db.Db, *accountUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*mgmtv1alpha1.JavascriptLibrary, 0, len(libraries))
+	for idx := range libraries {
+		dtos = append(dtos, dtomaps.ToJavascriptLibraryDto(&libraries[idx]))
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GetJavascriptLibrariesResponse{
+		Libraries: dtos,
+	}), nil
+}
+
+func (s *Service) SetJavascriptLibrary(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.SetJavascriptLibraryRequest],
+) (*connect.Response[mgmtv1alpha1.SetJavascriptLibraryResponse], error) {
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	// libraries are compiled up front so that a broken library is not found in the middle of a job run
+	_, err = goja.Compile(req.Msg.GetName(), constructJavascriptLibraryCode(req.Msg.GetCode()), true)
+	if err != nil {
+		return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("unable to compile javascript library: %s", err.Error()))
+	}
+
+	userUuid, err := s.getUserUuid(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	library, err := s.db.Q.UpsertJavascriptLibrary(ctx, s.db.Db, db_queries.UpsertJavascriptLibraryParams{
+		AccountID:   *accountUuid,
+		Name:        req.Msg.GetName(),
+		Code:        req.Msg.GetCode(),
+		CreatedByID: *userUuid,
+		UpdatedByID: *userUuid,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.SetJavascriptLibraryResponse{
+		Library: dtomaps.ToJavascriptLibraryDto(&library),
+	}), nil
+}
+
+func (s *Service) DeleteJavascriptLibrary(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.DeleteJavascriptLibraryRequest],
+) (*connect.Response[mgmtv1alpha1.DeleteJavascriptLibraryResponse], error) {
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.Q.RemoveJavascriptLibrary(ctx, s.db.Db, db_queries.RemoveJavascriptLibraryParams{
+		AccountId: *accountUuid,
+		Name:      req.Msg.GetName(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.DeleteJavascriptLibraryResponse{}), nil
+}
+
+// Libraries are wrapped the same way the worker loads them, as a CommonJS style module
+func constructJavascriptLibraryCode(jsCode string) string {
+	return fmt.Sprintf("(function(module, exports, require){\n%s\n})", jsCode)
+}
@@ -0,0 +1,89 @@
+package v1alpha1_transformersservice
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_GetJavascriptLibraries(t *testing.T) {
+	m := createServiceMock(t)
+
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJavascriptLibrariesByAccount", context.Background(), mock.Anything, accountUuid).
+		Return([]db_queries.NeosyncApiJavascriptLibrary{{AccountID: accountUuid, Name: "helpers", Code: "module.exports = {};"}}, nil)
+
+	resp, err := m.Service.GetJavascriptLibraries(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJavascriptLibrariesRequest{
+		AccountId: mockAccountId,
+	}))
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.Msg.GetLibraries(), 1)
+	assert.Equal(t, "helpers", resp.Msg.GetLibraries()[0].GetName())
+	assert.Equal(t, mockAccountId, resp.Msg.GetLibraries()[0].GetAccountId())
+}
+
+func Test_SetJavascriptLibrary(t *testing.T) {
+	m := createServiceMock(t)
+
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	code := `module.exports = { upper: (value) => value.toUpperCase() };`
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("UpsertJavascriptLibrary", context.Background(), mock.Anything, db_queries.UpsertJavascriptLibraryParams{
+		AccountID:   accountUuid,
+		Name:        "helpers",
+		Code:        code,
+		CreatedByID: userUuid,
+		UpdatedByID: userUuid,
+	}).Return(db_queries.NeosyncApiJavascriptLibrary{AccountID: accountUuid, Name: "helpers", Code: code}, nil)
+
+	resp, err := m.Service.SetJavascriptLibrary(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetJavascriptLibraryRequest{
+		AccountId: mockAccountId,
+		Name:      "helpers",
+		Code:      code,
+	}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, code, resp.Msg.GetLibrary().GetCode())
+}
+
+func Test_SetJavascriptLibrary_InvalidCode(t *testing.T) {
+	m := createServiceMock(t)
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+
+	_, err := m.Service.SetJavascriptLibrary(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetJavascriptLibraryRequest{
+		AccountId: mockAccountId,
+		Name:      "helpers",
+		Code:      `module.exports = {`,
+	}))
+
+	assert.Error(t, err)
+	m.QuerierMock.AssertNotCalled(t, "UpsertJavascriptLibrary", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_DeleteJavascriptLibrary(t *testing.T) {
+	m := createServiceMock(t)
+
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("RemoveJavascriptLibrary", context.Background(), mock.Anything, db_queries.RemoveJavascriptLibraryParams{
+		AccountId: accountUuid,
+		Name:      "helpers",
+	}).Return(nil)
+
+	_, err := m.Service.DeleteJavascriptLibrary(context.Background(), connect.NewRequest(&mgmtv1alpha1.DeleteJavascriptLibraryRequest{
+		AccountId: mockAccountId,
+		Name:      "helpers",
+	}))
+
+	assert.NoError(t, err)
+}
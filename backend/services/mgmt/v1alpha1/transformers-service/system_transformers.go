@@ -15,6 +15,10 @@ var (
 
 	defaultInvalidEmailAction = mgmtv1alpha1.InvalidEmailAction_INVALID_EMAIL_ACTION_REJECT
 
+	defaultMoneyDecimalPlaces = int32(2)
+
+	defaultMedicalRecordNumberLength = int64(8)
+
 	systemTransformers = []*mgmtv1alpha1.SystemTransformer{
 		{
 
@@ -359,6 +363,28 @@ var (
 				},
 			},
 		},
+		{
+			Name:              "Generate Business Timestamp",
+			Description:       "Generates a UTC timestamp that is weighted towards business hours and weekdays. Can be generated relative to another column, such as an updated_at that always comes after created_at.",
+			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_TIME,
+			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_TIME, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
+			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_GENERATE, mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
+			Source:            mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_BUSINESS_TIMESTAMP,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateBusinessTimestampConfig{
+					GenerateBusinessTimestampConfig: &mgmtv1alpha1.GenerateBusinessTimestamp{
+						RangeStartDaysAgo:   365,
+						RangeEndDaysAgo:     0,
+						BusinessHoursWeight: 0.8,
+						BusinessHoursStart:  9,
+						BusinessHoursEnd:    17,
+						WeekdayWeight:       0.9,
+						Timezone:            "UTC",
+						MaxSecondsAfter:     2592000,
+					},
+				},
+			},
+		},
 		{
 			Name:              "Generate UUID",
 			Description:       "Generates a new UUIDv4 identifier.",
@@ -550,6 +576,19 @@ var (
 				},
 			},
 		},
+		{
+			Name:              "Drop Column",
+			Description:       "Removes the column from the destination entirely. The column is not written to the destination and is omitted from the destination schema.",
+			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_ANY,
+			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_ANY, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
+			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
+			Source:            mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_DROP_COLUMN,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_DropColumnConfig{
+					DropColumnConfig: &mgmtv1alpha1.DropColumn{},
+				},
+			},
+		},
 		{
 			Name:              "Transform Javascript",
 			Description:       "Write custom javascript to transform data",
@@ -606,6 +645,285 @@ var (
 				},
 			},
 		},
+		{
+			Name:              "Generate Static Value",
+			Description:       "Sets the column to the same value for every row.",
+			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_ANY,
+			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_ANY},
+			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_GENERATE, mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
+			Source:            mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STATIC_VALUE,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateStaticValueConfig{
+					GenerateStaticValueConfig: &mgmtv1alpha1.GenerateStaticValue{Value: ""},
+				},
+			},
+		},
+		{
+			Name:              "SQL Expression",
+			Description:       "Sets the column to the result of a SQL expression that is evaluated by the destination database, such as now() or concat(id, '@example.test').",
+			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_ANY,
+			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_ANY, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
+			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_GENERATE, mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
+			Source:            mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_SQL_EXPRESSION,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_SqlExpressionConfig{
+					SqlExpressionConfig: &mgmtv1alpha1.SqlExpression{Expression: "now()"},
+				},
+			},
+		},
+		{
+			Name:              "Transform Geo Coordinate",
+			Description:       "Moves a latitude/longitude pair to a random point within a radius of the original, optionally snapping it to the centroid of the nearest city first. Supports lat,lng strings, (lng,lat) points and JSON objects with lat/lng keys.",
+			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
+			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_ANY, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
+			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
+			Source:            mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_GEO_COORDINATE,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformGeoCoordinateConfig{
+					TransformGeoCoordinateConfig: &mgmtv1alpha1.TransformGeoCoordinate{
+						RadiusMeters:       500,
+						SnapToCityCentroid: false,
+					},
+				},
+			},
+		},
+		{
+			Name:              "Transform URL",
+			Description:       "Scrubs tracking query parameters and user identifiers out of a URL while keeping its scheme, host and path shape. Query parameters can be removed or randomized, and ids, uuids and emails in the path are replaced with random values of the same shape.",
+			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
+			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
+			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
+			Source:            mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_URL,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformUrlConfig{
+					TransformUrlConfig: &mgmtv1alpha1.TransformUrl{
+						QueryParams:              []string{},
+						RandomizeQueryParams:     false,
+						StripUserInfo:            true,
+						RandomizePathIdentifiers: true,
+					},
+				},
+			},
+		},
+		{
+			Name:              "Generate User Agent",
+			Description:       "Generates a realistic user agent string of a recent version of a popular desktop or mobile browser.",
+			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
+			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
+			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_GENERATE, mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
+			Source:            mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_USER_AGENT,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateUserAgentConfig{
+					GenerateUserAgentConfig: &mgmtv1alpha1.GenerateUserAgent{
+						DesktopOnly: false,
+					},
+				},
+			},
+		},
+		{
+			Name:              "Transform Money",
+			Description:       "Randomizes a monetary amount while keeping its sign, order of magnitude and currency, so that $1,234.56 becomes another amount between $1,000.00 and $9,999.99. Amounts stored as strings keep their currency symbol or code and formatting.",
+			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_FLOAT64,
+			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_FLOAT64, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_INT64, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
+			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
+			Source:            mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_MONEY,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformMoneyConfig{
+					TransformMoneyConfig: &mgmtv1alpha1.TransformMoney{
+						DecimalPlaces: &defaultMoneyDecimalPlaces,
+					},
+				},
+			},
+		},
+		{
+			Name:              "Generate Money",
+			Description:       "Generates monetary amounts drawn from a log-normal distribution with the given median, which gives the long tail of large amounts that prices and transactions have.",
+			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_FLOAT64,
+			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_FLOAT64, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
+			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_GENERATE, mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
+			Source:            mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_MONEY,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateMoneyConfig{
+					GenerateMoneyConfig: &mgmtv1alpha1.GenerateMoney{
+						Median:        20,
+						Sigma:         1,
+						DecimalPlaces: &defaultMoneyDecimalPlaces,
+					},
+				},
+			},
+		},
+		{
+			Name:              "Generate Medical Record Number",
+			Description:       "Generates a medical record number made up of an optional prefix, such as the code of a facility, followed by random digits.",
+			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
+			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
+			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_GENERATE, mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
+			Source:            mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_MEDICAL_RECORD_NUMBER,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateMedicalRecordNumberConfig{
+					GenerateMedicalRecordNumberConfig: &mgmtv1alpha1.GenerateMedicalRecordNumber{
+						Length: &defaultMedicalRecordNumberLength,
+					},
+				},
+			},
+		},
+		{
+			Name:              "Transform Medical Record Number",
+			Description:       "Randomizes a medical record number while keeping its format, so that letters are replaced by letters, digits by digits and separators are kept. The letters of the record number can optionally be kept.",
+			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
+			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_INT64, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
+			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
+			Source:            mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_MEDICAL_RECORD_NUMBER,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformMedicalRecordNumberConfig{
+					TransformMedicalRecordNumberConfig: &mgmtv1alpha1.TransformMedicalRecordNumber{
+						PreserveLetters: false,
+					},
+				},
+			},
+		},
+		{
+			Name:              "Generate NPI",
+			Description:       "Generates a 10 digit National Provider Identifier with a valid check digit.",
+			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
+			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
+			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_GENERATE, mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
+			Source:            mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NPI,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateNpiConfig{
+					GenerateNpiConfig: &mgmtv1alpha1.GenerateNpi{},
+				},
+			},
+		},
+		{
+			Name:              "Transform NPI",
+			Description:       "Replaces a National Provider Identifier with another one that has a valid check digit, optionally keeping whether it belongs to an individual provider or an organization.",
+			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
+			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_INT64, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
+			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
+			Source:            mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_NPI,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformNpiConfig{
+					TransformNpiConfig: &mgmtv1alpha1.TransformNpi{
+						PreserveEntityType: true,
+					},
+				},
+			},
+		},
+		{
+			Name:              "Generate ICD-10 Code",
+			Description:       "Generates an ICD-10-CM diagnosis code from a set of codes that are commonly seen in claims, such as E11.9.",
+			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
+			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
+			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_GENERATE, mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
+			Source:            mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_ICD10_CODE,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateIcd10CodeConfig{
+					GenerateIcd10CodeConfig: &mgmtv1alpha1.GenerateIcd10Code{
+						ExcludeDecimalPoint: false,
+					},
+				},
+			},
+		},
+		{
+			Name:              "Generate CPT Code",
+			Description:       "Generates a CPT procedure code from a set of codes that are commonly seen in claims, such as 99213.",
+			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
+			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
+			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_GENERATE, mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
+			Source:            mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_CPT_CODE,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateCptCodeConfig{
+					GenerateCptCodeConfig: &mgmtv1alpha1.GenerateCptCode{},
+				},
+			},
+		},
+		{
+			Name:              "Generate VIN",
+			Description:       "Generates a 17 character vehicle identification number with a valid check digit, a known manufacturer and a model year code.",
+			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
+			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
+			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_GENERATE, mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
+			Source:            mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_VIN,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateVinConfig{
+					GenerateVinConfig: &mgmtv1alpha1.GenerateVin{},
+				},
+			},
+		},
+		{
+			Name:              "Transform VIN",
+			Description:       "Replaces a vehicle identification number with another one that has a valid check digit, optionally keeping the manufacturer.",
+			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
+			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
+			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
+			Source:            mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_VIN,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformVinConfig{
+					TransformVinConfig: &mgmtv1alpha1.TransformVin{
+						PreserveManufacturer: true,
+					},
+				},
+			},
+		},
+		{
+			Name:              "Generate IMEI",
+			Description:       "Generates a 15 digit IMEI number that ends in a valid Luhn check digit.",
+			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
+			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
+			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_GENERATE, mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
+			Source:            mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_IMEI,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateImeiConfig{
+					GenerateImeiConfig: &mgmtv1alpha1.GenerateImei{},
+				},
+			},
+		},
+		{
+			Name:              "Transform IMEI",
+			Description:       "Replaces an IMEI number with another one that has a valid Luhn check digit, optionally keeping the type allocation code that identifies the make and model of the device.",
+			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
+			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_INT64, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
+			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
+			Source:            mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_IMEI,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformImeiConfig{
+					TransformImeiConfig: &mgmtv1alpha1.TransformImei{
+						PreserveTypeAllocationCode: false,
+					},
+				},
+			},
+		},
+		{
+			Name:              "Generate EAN",
+			Description:       "Generates a 13 digit EAN-13 or 12 digit UPC-A product code with a valid check digit.",
+			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
+			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
+			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_GENERATE, mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
+			Source:            mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_EAN,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateEanConfig{
+					GenerateEanConfig: &mgmtv1alpha1.GenerateEan{
+						Upc: false,
+					},
+				},
+			},
+		},
+		{
+			Name:              "Transform EAN",
+			Description:       "Replaces an EAN-13 or UPC-A product code with another code of the same length that has a valid check digit, optionally keeping the manufacturer prefix.",
+			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
+			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_INT64, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
+			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
+			Source:            mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EAN,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformEanConfig{
+					TransformEanConfig: &mgmtv1alpha1.TransformEan{
+						PreserveManufacturerPrefix: true,
+					},
+				},
+			},
+		},
 	}
 
 	systemTransformerSourceMap = map[mgmtv1alpha1.TransformerSource]*mgmtv1alpha1.SystemTransformer{}
@@ -7,6 +7,7 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/dop251/goja"
+	"github.com/jackc/pgx/v5/pgtype"
 	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
@@ -30,13 +31,27 @@ func (s *Service) GetUserDefinedTransformers(
 		return nil, err
 	}
 
+	transformerIds := make([]pgtype.UUID, 0, len(transformers))
+	for idx := range transformers {
+		transformerIds = append(transformerIds, transformers[idx].ID)
+	}
+	labelsMap, err := s.db.GetResourceLabelsMap(ctx, nucleusdb.LabelResourceTypeTransformer, transformerIds)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve transformer labels: %w", err)
+	}
+
 	dtoTransformers := []*mgmtv1alpha1.UserDefinedTransformer{}
 	for idx := range transformers {
 		transformer := transformers[idx]
+		labels := labelsMap[nucleusdb.UUIDString(transformer.ID)]
+		if !nucleusdb.MatchesLabelSelector(labels, req.Msg.GetLabelSelector()) {
+			continue
+		}
 		dto, err := dtomaps.ToUserDefinedTransformerDto(&transformer, systemTransformerSourceMap)
 		if err != nil {
 			return nil, fmt.Errorf("failed to map user defined transformer %s with source %d", nucleusdb.UUIDString(transformer.ID), transformer.Source)
 		}
+		dto.Labels = labels
 		dtoTransformers = append(dtoTransformers, dto)
 	}
 
@@ -58,7 +73,7 @@ func (s *Service) GetUserDefinedTransformerById(
 	if err != nil && !nucleusdb.IsNoRows(err) {
 		return nil, err
 	} else if err != nil && nucleusdb.IsNoRows(err) {
-		return nil, nucleuserrors.NewNotFound("unable to find transformer by id")
+		return nil, nucleuserrors.WithErrorCode(nucleuserrors.NewNotFound("unable to find transformer by id"), mgmtv1alpha1.ErrorCode_ERROR_CODE_TRANSFORMER_NOT_FOUND)
 	}
 
 	_, err = s.verifyUserInAccount(ctx, nucleusdb.UUIDString(transformer.AccountID))
@@ -106,11 +121,18 @@ func (s *Service) CreateUserDefinedTransformer(ctx context.Context, req *connect
 	if err != nil {
 		return nil, err
 	}
+	if len(req.Msg.GetLabels()) > 0 {
+		err = s.db.SetResourceLabels(ctx, *accountUuid, nucleusdb.LabelResourceTypeTransformer, ct.ID, req.Msg.GetLabels())
+		if err != nil {
+			return nil, fmt.Errorf("unable to set transformer labels: %w", err)
+		}
+	}
 
 	dto, err := dtomaps.ToUserDefinedTransformerDto(&ct, systemTransformerSourceMap)
 	if err != nil {
 		return nil, fmt.Errorf("failed to map user defined transformer %s with source %d", nucleusdb.UUIDString(ct.ID), ct.Source)
 	}
+	dto.Labels = req.Msg.GetLabels()
 
 	return connect.NewResponse(&mgmtv1alpha1.CreateUserDefinedTransformerResponse{
 		Transformer: dto,
@@ -144,10 +166,53 @@ func (s *Service) DeleteUserDefinedTransformer(ctx context.Context, req *connect
 	} else if err != nil && nucleusdb.IsNoRows(err) {
 		logger.Info("transformer not found or has already been removed")
 	}
+	err = s.db.Q.RemoveResourceLabels(ctx, s.db.Db, db_queries.RemoveResourceLabelsParams{
+		ResourceType: nucleusdb.LabelResourceTypeTransformer,
+		ResourceID:   transformer.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to remove transformer labels: %w", err)
+	}
 
 	return connect.NewResponse(&mgmtv1alpha1.DeleteUserDefinedTransformerResponse{}), nil
 }
 
+func (s *Service) SetUserDefinedTransformerLabels(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.SetUserDefinedTransformerLabelsRequest],
+) (*connect.Response[mgmtv1alpha1.SetUserDefinedTransformerLabelsResponse], error) {
+	tUuid, err := nucleusdb.ToUuid(req.Msg.GetTransformerId())
+	if err != nil {
+		return nil, err
+	}
+	transformer, err := s.db.Q.GetUserDefinedTransformerById(ctx, s.db.Db, tUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return nil, nucleuserrors.WithErrorCode(nucleuserrors.NewNotFound("unable to find transformer by id"), mgmtv1alpha1.ErrorCode_ERROR_CODE_TRANSFORMER_NOT_FOUND)
+	}
+
+	_, err = s.verifyUserInAccount(ctx, nucleusdb.UUIDString(transformer.AccountID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.SetResourceLabels(ctx, transformer.AccountID, nucleusdb.LabelResourceTypeTransformer, transformer.ID, req.Msg.GetLabels())
+	if err != nil {
+		return nil, fmt.Errorf("unable to set transformer labels: %w", err)
+	}
+
+	dto, err := dtomaps.ToUserDefinedTransformerDto(&transformer, systemTransformerSourceMap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map user defined transformer %s with source %d", nucleusdb.UUIDString(transformer.ID), transformer.Source)
+	}
+	dto.Labels = req.Msg.GetLabels()
+
+	return connect.NewResponse(&mgmtv1alpha1.SetUserDefinedTransformerLabelsResponse{
+		Transformer: dto,
+	}), nil
+}
+
 func (s *Service) UpdateUserDefinedTransformer(ctx context.Context, req *connect.Request[mgmtv1alpha1.UpdateUserDefinedTransformerRequest]) (*connect.Response[mgmtv1alpha1.UpdateUserDefinedTransformerResponse], error) {
 	tUuid, err := nucleusdb.ToUuid(req.Msg.TransformerId)
 	if err != nil {
@@ -157,7 +222,7 @@ func (s *Service) UpdateUserDefinedTransformer(ctx context.Context, req *connect
 	if err != nil && !nucleusdb.IsNoRows(err) {
 		return nil, err
 	} else if err != nil && nucleusdb.IsNoRows(err) {
-		return nil, nucleuserrors.NewNotFound("unable to find transformer by id")
+		return nil, nucleuserrors.WithErrorCode(nucleuserrors.NewNotFound("unable to find transformer by id"), mgmtv1alpha1.ErrorCode_ERROR_CODE_TRANSFORMER_NOT_FOUND)
 	}
 
 	_, err = s.verifyUserInAccount(ctx, nucleusdb.UUIDString(transformer.AccountID))
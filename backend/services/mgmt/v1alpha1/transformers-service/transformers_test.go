@@ -40,6 +40,10 @@ func Test_GetUserDefinedTransformers(t *testing.T) {
 	transformers := []db_queries.NeosyncApiTransformer{mockTransformer(mockAccountId, mockUserId, mockTransformerId)}
 	mockIsUserInAccount(m.UserAccountServiceMock, true)
 	m.QuerierMock.On("GetUserDefinedTransformersByAccount", context.Background(), mock.Anything, accountUuid).Return(transformers, nil)
+	m.QuerierMock.On("GetResourceLabels", context.Background(), mock.Anything, db_queries.GetResourceLabelsParams{
+		ResourceType: nucleusdb.LabelResourceTypeTransformer,
+		ResourceIds:  []pgtype.UUID{transformers[0].ID},
+	}).Return([]db_queries.NeosyncApiResourceLabel{{ResourceID: transformers[0].ID, Key: "team", Value: "payments"}}, nil)
 
 	resp, err := m.Service.GetUserDefinedTransformers(context.Background(), &connect.Request[mgmtv1alpha1.GetUserDefinedTransformersRequest]{
 		Msg: &mgmtv1alpha1.GetUserDefinedTransformersRequest{
@@ -51,6 +55,26 @@ func Test_GetUserDefinedTransformers(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.Equal(t, 1, len(resp.Msg.GetTransformers()))
 	assert.Equal(t, mockTransformerId, resp.Msg.Transformers[0].Id)
+	assert.Equal(t, map[string]string{"team": "payments"}, resp.Msg.Transformers[0].GetLabels())
+}
+
+func Test_GetUserDefinedTransformers_LabelSelector(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	transformers := []db_queries.NeosyncApiTransformer{mockTransformer(mockAccountId, mockUserId, mockTransformerId)}
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetUserDefinedTransformersByAccount", context.Background(), mock.Anything, accountUuid).Return(transformers, nil)
+	m.QuerierMock.On("GetResourceLabels", context.Background(), mock.Anything, mock.Anything).Return([]db_queries.NeosyncApiResourceLabel{}, nil)
+
+	resp, err := m.Service.GetUserDefinedTransformers(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetUserDefinedTransformersRequest{
+		AccountId:     mockAccountId,
+		LabelSelector: map[string]string{"team": "payments"},
+	}))
+
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Msg.GetTransformers())
 }
 
 func Test_GetUserDefinedTransformersById(t *testing.T) {
@@ -185,6 +209,10 @@ func Test_DeleteUserDefinedTransformer(t *testing.T) {
 	mockIsUserInAccount(m.UserAccountServiceMock, true)
 	m.QuerierMock.On("GetUserDefinedTransformerById", context.Background(), mock.Anything, transformerUuid).Return(transformer, nil)
 	m.QuerierMock.On("DeleteUserDefinedTransformerById", context.Background(), mock.Anything, transformerUuid).Return(nil)
+	m.QuerierMock.On("RemoveResourceLabels", context.Background(), mock.Anything, db_queries.RemoveResourceLabelsParams{
+		ResourceType: nucleusdb.LabelResourceTypeTransformer,
+		ResourceID:   transformerUuid,
+	}).Return(nil)
 
 	resp, err := m.Service.DeleteUserDefinedTransformer(context.Background(), &connect.Request[mgmtv1alpha1.DeleteUserDefinedTransformerRequest]{
 		Msg: &mgmtv1alpha1.DeleteUserDefinedTransformerRequest{
@@ -0,0 +1,116 @@
+package v1alpha1_useraccountservice
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	auth_apikey "github.com/nucleuscloud/neosync/backend/internal/auth/apikey"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+)
+
+func (s *Service) GrantAccountPermission(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GrantAccountPermissionRequest],
+) (*connect.Response[mgmtv1alpha1.GrantAccountPermissionResponse], error) {
+	accountId, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.verifyCanManageAccountPermission(ctx, *accountId, req.Msg.GetPermission(), true); err != nil {
+		return nil, err
+	}
+	memberUserId, err := nucleusdb.ToUuid(req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	count, err := s.db.Q.IsUserInAccount(ctx, s.db.Db, db_queries.IsUserInAccountParams{
+		AccountId: *accountId,
+		UserId:    memberUserId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return nil, nucleuserrors.NewBadRequest("user is not a member of the account")
+	}
+
+	err = s.db.Q.GrantAccountUserPermission(ctx, s.db.Db, db_queries.GrantAccountUserPermissionParams{
+		AccountId:  *accountId,
+		UserId:     memberUserId,
+		Permission: req.Msg.GetPermission().String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GrantAccountPermissionResponse{}), nil
+}
+
+func (s *Service) RevokeAccountPermission(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.RevokeAccountPermissionRequest],
+) (*connect.Response[mgmtv1alpha1.RevokeAccountPermissionResponse], error) {
+	accountId, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.verifyCanManageAccountPermission(ctx, *accountId, req.Msg.GetPermission(), false); err != nil {
+		return nil, err
+	}
+	memberUserId, err := nucleusdb.ToUuid(req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.Q.RevokeAccountUserPermission(ctx, s.db.Db, db_queries.RevokeAccountUserPermissionParams{
+		AccountId:  *accountId,
+		UserId:     memberUserId,
+		Permission: req.Msg.GetPermission().String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.RevokeAccountPermissionResponse{}), nil
+}
+
+// Only users that hold a permission may grant or revoke it. As a permission could otherwise never be granted,
+// its first grant in an account may be made by any member. Account api keys may never manage permissions
+func (s *Service) verifyCanManageAccountPermission(
+	ctx context.Context,
+	accountId pgtype.UUID,
+	permission mgmtv1alpha1.AccountPermission,
+	isGrant bool,
+) error {
+	apiTokenCtxData, _ := auth_apikey.GetTokenDataFromCtx(ctx)
+	if apiTokenCtxData != nil {
+		return nucleuserrors.NewForbidden("account permissions can not be managed with an api key")
+	}
+
+	resp, err := s.IsUserInAccount(ctx, connect.NewRequest(&mgmtv1alpha1.IsUserInAccountRequest{
+		AccountId:   nucleusdb.UUIDString(accountId),
+		Permissions: []mgmtv1alpha1.AccountPermission{permission},
+	}))
+	if err != nil {
+		return err
+	}
+	if resp.Msg.GetOk() {
+		return nil
+	}
+	if isGrant {
+		count, err := s.db.Q.GetAccountPermissionUserCount(ctx, s.db.Db, db_queries.GetAccountPermissionUserCountParams{
+			AccountId:  accountId,
+			Permission: permission.String(),
+		})
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return nil
+		}
+	}
+	return nucleuserrors.NewForbidden(fmt.Sprintf("only users with the %s permission may grant or revoke it", permission.String()))
+}
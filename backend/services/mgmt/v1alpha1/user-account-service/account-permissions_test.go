@@ -0,0 +1,182 @@
+package v1alpha1_useraccountservice
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockMemberUserId = "0f3cc4d4-0a5c-4a51-a1a6-1c4a5c9b0a61"
+)
+
+// Mocks an authenticated caller that is a member of the account and holds the given permissions
+func setAccountPermissionCallerMocks(m *serviceMocks, ctx context.Context, granted []string) {
+	userAssociation := getUserIdentityProviderAssociationMock(mockUserId, mockAuthProvider)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	m.QuerierMock.On("GetUserAssociationByProviderSub", ctx, mock.Anything, mockAuthProvider).Return(userAssociation, nil)
+	m.QuerierMock.On("IsUserInAccountApiKey", ctx, mock.Anything, db_queries.IsUserInAccountApiKeyParams{
+		AccountId: accountUuid,
+		UserId:    userUuid,
+	}).Return(int64(0), nil)
+	m.QuerierMock.On("IsUserInAccount", ctx, mock.Anything, db_queries.IsUserInAccountParams{
+		AccountId: accountUuid,
+		UserId:    userUuid,
+	}).Return(int64(1), nil)
+	m.QuerierMock.On("GetAccountUserPermissions", ctx, mock.Anything, db_queries.GetAccountUserPermissionsParams{
+		AccountId: accountUuid,
+		UserId:    userUuid,
+	}).Return(granted, nil)
+}
+
+func Test_GrantAccountPermission(t *testing.T) {
+	tests := []struct {
+		name        string
+		granted     []string
+		holderCount int64
+	}{
+		{"holder", []string{"ACCOUNT_PERMISSION_QUERY_CONSOLE"}, 1},
+		{"first grant", nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := createServiceMock(t, &Config{IsAuthEnabled: true})
+			ctx := getJwtAuthenticatedCtxMock(mockAuthProvider)
+			setAccountPermissionCallerMocks(m, ctx, tt.granted)
+			accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+			memberUuid, _ := nucleusdb.ToUuid(mockMemberUserId)
+			m.QuerierMock.On("GetAccountPermissionUserCount", ctx, mock.Anything, db_queries.GetAccountPermissionUserCountParams{
+				AccountId:  accountUuid,
+				Permission: "ACCOUNT_PERMISSION_QUERY_CONSOLE",
+			}).Return(tt.holderCount, nil).Maybe()
+			m.QuerierMock.On("IsUserInAccount", ctx, mock.Anything, db_queries.IsUserInAccountParams{
+				AccountId: accountUuid,
+				UserId:    memberUuid,
+			}).Return(int64(1), nil)
+			m.QuerierMock.On("GrantAccountUserPermission", ctx, mock.Anything, db_queries.GrantAccountUserPermissionParams{
+				AccountId:  accountUuid,
+				UserId:     memberUuid,
+				Permission: "ACCOUNT_PERMISSION_QUERY_CONSOLE",
+			}).Return(nil)
+
+			resp, err := m.Service.GrantAccountPermission(ctx, connect.NewRequest(&mgmtv1alpha1.GrantAccountPermissionRequest{
+				AccountId:  mockAccountId,
+				UserId:     mockMemberUserId,
+				Permission: mgmtv1alpha1.AccountPermission_ACCOUNT_PERMISSION_QUERY_CONSOLE,
+			}))
+
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+		})
+	}
+}
+
+func Test_GrantAccountPermission_NotHolder(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	ctx := getJwtAuthenticatedCtxMock(mockAuthProvider)
+	setAccountPermissionCallerMocks(m, ctx, nil)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	m.QuerierMock.On("GetAccountPermissionUserCount", ctx, mock.Anything, db_queries.GetAccountPermissionUserCountParams{
+		AccountId:  accountUuid,
+		Permission: "ACCOUNT_PERMISSION_QUERY_CONSOLE",
+	}).Return(int64(1), nil)
+
+	_, err := m.Service.GrantAccountPermission(ctx, connect.NewRequest(&mgmtv1alpha1.GrantAccountPermissionRequest{
+		AccountId:  mockAccountId,
+		UserId:     mockUserId,
+		Permission: mgmtv1alpha1.AccountPermission_ACCOUNT_PERMISSION_QUERY_CONSOLE,
+	}))
+
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	m.QuerierMock.AssertNotCalled(t, "GrantAccountUserPermission", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_GrantAccountPermission_NotMember(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	ctx := getJwtAuthenticatedCtxMock(mockAuthProvider)
+	setAccountPermissionCallerMocks(m, ctx, []string{"ACCOUNT_PERMISSION_QUERY_CONSOLE"})
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	memberUuid, _ := nucleusdb.ToUuid(mockMemberUserId)
+	m.QuerierMock.On("IsUserInAccount", ctx, mock.Anything, db_queries.IsUserInAccountParams{
+		AccountId: accountUuid,
+		UserId:    memberUuid,
+	}).Return(int64(0), nil)
+
+	_, err := m.Service.GrantAccountPermission(ctx, connect.NewRequest(&mgmtv1alpha1.GrantAccountPermissionRequest{
+		AccountId:  mockAccountId,
+		UserId:     mockMemberUserId,
+		Permission: mgmtv1alpha1.AccountPermission_ACCOUNT_PERMISSION_QUERY_CONSOLE,
+	}))
+
+	assert.Error(t, err)
+	m.QuerierMock.AssertNotCalled(t, "GrantAccountUserPermission", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_GrantAccountPermission_ApiKey(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: false})
+	ctx := getApiKeyAuthenticatedCtxMock(mockUserId)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	m.QuerierMock.On("IsUserInAccountApiKey", ctx, mock.Anything, db_queries.IsUserInAccountApiKeyParams{
+		AccountId: accountUuid,
+		UserId:    userUuid,
+	}).Return(int64(1), nil)
+
+	_, err := m.Service.GrantAccountPermission(ctx, connect.NewRequest(&mgmtv1alpha1.GrantAccountPermissionRequest{
+		AccountId:  mockAccountId,
+		UserId:     mockMemberUserId,
+		Permission: mgmtv1alpha1.AccountPermission_ACCOUNT_PERMISSION_QUERY_CONSOLE,
+	}))
+
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	m.QuerierMock.AssertNotCalled(t, "GrantAccountUserPermission", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_RevokeAccountPermission(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	ctx := getJwtAuthenticatedCtxMock(mockAuthProvider)
+	setAccountPermissionCallerMocks(m, ctx, []string{"ACCOUNT_PERMISSION_QUERY_CONSOLE"})
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	memberUuid, _ := nucleusdb.ToUuid(mockMemberUserId)
+	m.QuerierMock.On("RevokeAccountUserPermission", ctx, mock.Anything, db_queries.RevokeAccountUserPermissionParams{
+		AccountId:  accountUuid,
+		UserId:     memberUuid,
+		Permission: "ACCOUNT_PERMISSION_QUERY_CONSOLE",
+	}).Return(nil)
+
+	resp, err := m.Service.RevokeAccountPermission(ctx, connect.NewRequest(&mgmtv1alpha1.RevokeAccountPermissionRequest{
+		AccountId:  mockAccountId,
+		UserId:     mockMemberUserId,
+		Permission: mgmtv1alpha1.AccountPermission_ACCOUNT_PERMISSION_QUERY_CONSOLE,
+	}))
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+}
+
+func Test_RevokeAccountPermission_NotHolder(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	ctx := getJwtAuthenticatedCtxMock(mockAuthProvider)
+	setAccountPermissionCallerMocks(m, ctx, nil)
+
+	_, err := m.Service.RevokeAccountPermission(ctx, connect.NewRequest(&mgmtv1alpha1.RevokeAccountPermissionRequest{
+		AccountId:  mockAccountId,
+		UserId:     mockMemberUserId,
+		Permission: mgmtv1alpha1.AccountPermission_ACCOUNT_PERMISSION_QUERY_CONSOLE,
+	}))
+
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	m.QuerierMock.AssertNotCalled(t, "GetAccountPermissionUserCount", mock.Anything, mock.Anything, mock.Anything)
+	m.QuerierMock.AssertNotCalled(t, "RevokeAccountUserPermission", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -204,8 +204,9 @@ func (s *Service) IsUserInAccount(
 		return nil, err
 	}
 	if apiKeyCount > 0 {
+		// account api keys are never granted account permissions
 		return connect.NewResponse(&mgmtv1alpha1.IsUserInAccountResponse{
-			Ok: apiKeyCount > 0,
+			Ok: len(req.Msg.GetPermissions()) == 0,
 		}), nil
 	}
 	count, err := s.db.Q.IsUserInAccount(ctx, s.db.Db, db_queries.IsUserInAccountParams{
@@ -215,11 +216,38 @@ func (s *Service) IsUserInAccount(
 	if err != nil {
 		return nil, err
 	}
+	if count == 0 || len(req.Msg.GetPermissions()) == 0 || !s.cfg.IsAuthEnabled {
+		return connect.NewResponse(&mgmtv1alpha1.IsUserInAccountResponse{
+			Ok: count > 0,
+		}), nil
+	}
+
+	grantedPermissions, err := s.db.Q.GetAccountUserPermissions(ctx, s.db.Db, db_queries.GetAccountUserPermissionsParams{
+		AccountId: accountId,
+		UserId:    userId,
+	})
+	if err != nil {
+		return nil, err
+	}
 	return connect.NewResponse(&mgmtv1alpha1.IsUserInAccountResponse{
-		Ok: count > 0,
+		Ok: hasAccountPermissions(grantedPermissions, req.Msg.GetPermissions()),
 	}), nil
 }
 
+// Returns true if every required permission is present in the granted permission names
+func hasAccountPermissions(granted []string, required []mgmtv1alpha1.AccountPermission) bool {
+	grantedSet := map[string]struct{}{}
+	for _, permission := range granted {
+		grantedSet[permission] = struct{}{}
+	}
+	for _, permission := range required {
+		if _, ok := grantedSet[permission.String()]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
 func (s *Service) CreateTeamAccount(
 	ctx context.Context,
 	req *connect.Request[mgmtv1alpha1.CreateTeamAccountRequest],
@@ -335,6 +335,74 @@ func Test_IsUserInAccount_ApiKey(t *testing.T) {
 	assert.Equal(t, true, resp.Msg.Ok)
 }
 
+func Test_IsUserInAccount_Permissions(t *testing.T) {
+	tests := []struct {
+		name     string
+		granted  []string
+		expected bool
+	}{
+		{"granted", []string{"ACCOUNT_PERMISSION_QUERY_CONSOLE"}, true},
+		{"missing", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := createServiceMock(t, &Config{IsAuthEnabled: true})
+
+			ctx := getJwtAuthenticatedCtxMock(mockAuthProvider)
+			userAssociation := getUserIdentityProviderAssociationMock(mockUserId, mockAuthProvider)
+			accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+			userUuid, _ := nucleusdb.ToUuid(mockUserId)
+			m.QuerierMock.On("GetUserAssociationByProviderSub", ctx, mock.Anything, mockAuthProvider).Return(userAssociation, nil)
+			m.QuerierMock.On("IsUserInAccountApiKey", ctx, mock.Anything, db_queries.IsUserInAccountApiKeyParams{
+				AccountId: accountUuid,
+				UserId:    userUuid,
+			}).Return(int64(0), nil)
+			m.QuerierMock.On("IsUserInAccount", ctx, mock.Anything, db_queries.IsUserInAccountParams{
+				AccountId: accountUuid,
+				UserId:    userUuid,
+			}).Return(int64(1), nil)
+			m.QuerierMock.On("GetAccountUserPermissions", ctx, mock.Anything, db_queries.GetAccountUserPermissionsParams{
+				AccountId: accountUuid,
+				UserId:    userUuid,
+			}).Return(tt.granted, nil)
+
+			resp, err := m.Service.IsUserInAccount(ctx, &connect.Request[mgmtv1alpha1.IsUserInAccountRequest]{Msg: &mgmtv1alpha1.IsUserInAccountRequest{
+				AccountId:   mockAccountId,
+				Permissions: []mgmtv1alpha1.AccountPermission{mgmtv1alpha1.AccountPermission_ACCOUNT_PERMISSION_QUERY_CONSOLE},
+			}})
+
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+			assert.Equal(t, tt.expected, resp.Msg.Ok)
+		})
+	}
+}
+
+func Test_IsUserInAccount_ApiKey_Permissions(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+
+	ctx := getJwtAuthenticatedCtxMock(mockAuthProvider)
+	userAssociation := getUserIdentityProviderAssociationMock(mockUserId, mockAuthProvider)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	m.QuerierMock.On("GetUserAssociationByProviderSub", ctx, mock.Anything, mockAuthProvider).Return(userAssociation, nil)
+	m.QuerierMock.On("IsUserInAccountApiKey", ctx, mock.Anything, db_queries.IsUserInAccountApiKeyParams{
+		AccountId: accountUuid,
+		UserId:    userUuid,
+	}).Return(int64(1), nil)
+
+	resp, err := m.Service.IsUserInAccount(ctx, &connect.Request[mgmtv1alpha1.IsUserInAccountRequest]{Msg: &mgmtv1alpha1.IsUserInAccountRequest{
+		AccountId:   mockAccountId,
+		Permissions: []mgmtv1alpha1.AccountPermission{mgmtv1alpha1.AccountPermission_ACCOUNT_PERMISSION_QUERY_CONSOLE},
+	}})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, false, resp.Msg.Ok)
+	m.QuerierMock.AssertNotCalled(t, "GetAccountUserPermissions", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func Test_CreateTeamAccount(t *testing.T) {
 	m := createServiceMock(t, &Config{IsAuthEnabled: true})
 	mockTx := new(nucleusdb.MockTx)
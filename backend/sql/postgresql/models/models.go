@@ -8,14 +8,36 @@ import (
 )
 
 type ConnectionConfig struct {
-	PgConfig             *PostgresConnectionConfig       `json:"pgConfig,omitempty"`
-	AwsS3Config          *AwsS3ConnectionConfig          `json:"awsS3Config,omitempty"`
-	MysqlConfig          *MysqlConnectionConfig          `json:"mysqlConfig,omitempty"`
-	LocalDirectoryConfig *LocalDirectoryConnectionConfig `json:"localDirConfig,omitempty"`
-	OpenAiConfig         *OpenAiConnectionConfig         `json:"openaiConfig,omitempty"`
+	PgConfig               *PostgresConnectionConfig         `json:"pgConfig,omitempty"`
+	AwsS3Config            *AwsS3ConnectionConfig            `json:"awsS3Config,omitempty"`
+	MysqlConfig            *MysqlConnectionConfig            `json:"mysqlConfig,omitempty"`
+	LocalDirectoryConfig   *LocalDirectoryConnectionConfig   `json:"localDirConfig,omitempty"`
+	OpenAiConfig           *OpenAiConnectionConfig           `json:"openaiConfig,omitempty"`
+	CustomConfig           *CustomConnectionConfig           `json:"customConfig,omitempty"`
+	SqliteConfig           *SqliteConnectionConfig           `json:"sqliteConfig,omitempty"`
+	SnowflakeConfig        *SnowflakeConnectionConfig        `json:"snowflakeConfig,omitempty"`
+	BigQueryConfig         *BigQueryConnectionConfig         `json:"bigqueryConfig,omitempty"`
+	RedshiftConfig         *RedshiftConnectionConfig         `json:"redshiftConfig,omitempty"`
+	MssqlConfig            *MssqlConnectionConfig            `json:"mssqlConfig,omitempty"`
+	GcpCloudStorageConfig  *GcpCloudStorageConnectionConfig  `json:"gcpCloudStorageConfig,omitempty"`
+	AzureBlobStorageConfig *AzureBlobStorageConnectionConfig `json:"azureBlobStorageConfig,omitempty"`
+	KafkaConfig            *KafkaConnectionConfig            `json:"kafkaConfig,omitempty"`
+	ElasticsearchConfig    *ElasticsearchConnectionConfig    `json:"elasticsearchConfig,omitempty"`
+	DuckdbConfig           *DuckdbConnectionConfig           `json:"duckdbConfig,omitempty"`
+	SpannerConfig          *SpannerConnectionConfig          `json:"spannerConfig,omitempty"`
+
+	Region *string `json:"region,omitempty"`
 }
 
 func (c *ConnectionConfig) ToDto() *mgmtv1alpha1.ConnectionConfig {
+	dto := c.toConfigDto()
+	if dto != nil {
+		dto.Region = c.Region
+	}
+	return dto
+}
+
+func (c *ConnectionConfig) toConfigDto() *mgmtv1alpha1.ConnectionConfig {
 	if c.PgConfig != nil {
 		var tunnel *mgmtv1alpha1.SSHTunnel
 		if c.PgConfig.SSHTunnel != nil {
@@ -29,6 +51,10 @@ func (c *ConnectionConfig) ToDto() *mgmtv1alpha1.ConnectionConfig {
 		if c.PgConfig.ClientTls != nil {
 			clientTls = c.PgConfig.ClientTls.ToDto()
 		}
+		var temporaryCredentials *mgmtv1alpha1.TemporaryCredentialsConfig
+		if c.PgConfig.TemporaryCredentials != nil {
+			temporaryCredentials = c.PgConfig.TemporaryCredentials.ToDto()
+		}
 		if c.PgConfig.Connection != nil {
 			return &mgmtv1alpha1.ConnectionConfig{
 				Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{
@@ -43,9 +69,11 @@ func (c *ConnectionConfig) ToDto() *mgmtv1alpha1.ConnectionConfig {
 								SslMode: c.PgConfig.Connection.SslMode,
 							},
 						},
-						Tunnel:            tunnel,
-						ConnectionOptions: connectionOptions,
-						ClientTls:         clientTls,
+						Tunnel:               tunnel,
+						ConnectionOptions:    connectionOptions,
+						ClientTls:            clientTls,
+						TemporaryCredentials: temporaryCredentials,
+						IsCockroachdb:        c.PgConfig.IsCockroachDb,
 					},
 				},
 			}
@@ -56,9 +84,11 @@ func (c *ConnectionConfig) ToDto() *mgmtv1alpha1.ConnectionConfig {
 						ConnectionConfig: &mgmtv1alpha1.PostgresConnectionConfig_Url{
 							Url: *c.PgConfig.Url,
 						},
-						Tunnel:            tunnel,
-						ConnectionOptions: connectionOptions,
-						ClientTls:         clientTls,
+						Tunnel:               tunnel,
+						ConnectionOptions:    connectionOptions,
+						ClientTls:            clientTls,
+						TemporaryCredentials: temporaryCredentials,
+						IsCockroachdb:        c.PgConfig.IsCockroachDb,
 					},
 				},
 			}
@@ -72,6 +102,10 @@ func (c *ConnectionConfig) ToDto() *mgmtv1alpha1.ConnectionConfig {
 		if c.MysqlConfig.ConnectionOptions != nil {
 			connectionOptions = c.MysqlConfig.ConnectionOptions.ToDto()
 		}
+		var temporaryCredentials *mgmtv1alpha1.TemporaryCredentialsConfig
+		if c.MysqlConfig.TemporaryCredentials != nil {
+			temporaryCredentials = c.MysqlConfig.TemporaryCredentials.ToDto()
+		}
 		if c.MysqlConfig.Connection != nil {
 			return &mgmtv1alpha1.ConnectionConfig{
 				Config: &mgmtv1alpha1.ConnectionConfig_MysqlConfig{
@@ -86,8 +120,10 @@ func (c *ConnectionConfig) ToDto() *mgmtv1alpha1.ConnectionConfig {
 								Name:     c.MysqlConfig.Connection.Name,
 							},
 						},
-						Tunnel:            tunnel,
-						ConnectionOptions: connectionOptions,
+						Tunnel:               tunnel,
+						ConnectionOptions:    connectionOptions,
+						TemporaryCredentials: temporaryCredentials,
+						IsMariadb:            c.MysqlConfig.IsMariaDb,
 					},
 				},
 			}
@@ -98,7 +134,9 @@ func (c *ConnectionConfig) ToDto() *mgmtv1alpha1.ConnectionConfig {
 						ConnectionConfig: &mgmtv1alpha1.MysqlConnectionConfig_Url{
 							Url: *c.MysqlConfig.Url,
 						},
-						Tunnel: tunnel,
+						Tunnel:               tunnel,
+						TemporaryCredentials: temporaryCredentials,
+						IsMariadb:            c.MysqlConfig.IsMariaDb,
 					},
 				},
 			}
@@ -121,14 +159,89 @@ func (c *ConnectionConfig) ToDto() *mgmtv1alpha1.ConnectionConfig {
 				OpenaiConfig: c.OpenAiConfig.ToDto(),
 			},
 		}
+	} else if c.CustomConfig != nil {
+		return &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_CustomConfig{
+				CustomConfig: c.CustomConfig.ToDto(),
+			},
+		}
+	} else if c.SqliteConfig != nil {
+		return &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_SqliteConfig{
+				SqliteConfig: c.SqliteConfig.ToDto(),
+			},
+		}
+	} else if c.SnowflakeConfig != nil {
+		return &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_SnowflakeConfig{
+				SnowflakeConfig: c.SnowflakeConfig.ToDto(),
+			},
+		}
+	} else if c.BigQueryConfig != nil {
+		return &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_BigqueryConfig{
+				BigqueryConfig: c.BigQueryConfig.ToDto(),
+			},
+		}
+	} else if c.RedshiftConfig != nil {
+		return &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_RedshiftConfig{
+				RedshiftConfig: c.RedshiftConfig.ToDto(),
+			},
+		}
+	} else if c.GcpCloudStorageConfig != nil {
+		return &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_GcpCloudstorageConfig{
+				GcpCloudstorageConfig: c.GcpCloudStorageConfig.ToDto(),
+			},
+		}
+	} else if c.AzureBlobStorageConfig != nil {
+		return &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_AzureBlobConfig{
+				AzureBlobConfig: c.AzureBlobStorageConfig.ToDto(),
+			},
+		}
+	} else if c.KafkaConfig != nil {
+		return &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_KafkaConfig{
+				KafkaConfig: c.KafkaConfig.ToDto(),
+			},
+		}
+	} else if c.ElasticsearchConfig != nil {
+		return &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_ElasticsearchConfig{
+				ElasticsearchConfig: c.ElasticsearchConfig.ToDto(),
+			},
+		}
+	} else if c.DuckdbConfig != nil {
+		return &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_DuckdbConfig{
+				DuckdbConfig: c.DuckdbConfig.ToDto(),
+			},
+		}
+	} else if c.SpannerConfig != nil {
+		return &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_SpannerConfig{
+				SpannerConfig: c.SpannerConfig.ToDto(),
+			},
+		}
+	} else if c.MssqlConfig != nil {
+		return &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_MssqlConfig{
+				MssqlConfig: c.MssqlConfig.ToDto(),
+			},
+		}
 	}
 	return nil
 }
 
 func (c *ConnectionConfig) FromDto(dto *mgmtv1alpha1.ConnectionConfig) error {
+	c.Region = dto.Region
 	switch config := dto.Config.(type) {
 	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
-		c.PgConfig = &PostgresConnectionConfig{}
+		c.PgConfig = &PostgresConnectionConfig{
+			IsCockroachDb: config.PgConfig.GetIsCockroachdb(),
+		}
 		if config.PgConfig.Tunnel != nil {
 			c.PgConfig.SSHTunnel = &SSHTunnel{}
 			c.PgConfig.SSHTunnel.FromDto(config.PgConfig.Tunnel)
@@ -144,6 +257,10 @@ func (c *ConnectionConfig) FromDto(dto *mgmtv1alpha1.ConnectionConfig) error {
 				ClientKey:  config.PgConfig.GetClientTls().ClientKey,
 			}
 		}
+		if config.PgConfig.GetTemporaryCredentials() != nil {
+			c.PgConfig.TemporaryCredentials = &TemporaryCredentials{}
+			c.PgConfig.TemporaryCredentials.FromDto(config.PgConfig.GetTemporaryCredentials())
+		}
 		switch pgcfg := config.PgConfig.ConnectionConfig.(type) {
 		case *mgmtv1alpha1.PostgresConnectionConfig_Connection:
 			c.PgConfig.Connection = &PostgresConnection{
@@ -160,7 +277,9 @@ func (c *ConnectionConfig) FromDto(dto *mgmtv1alpha1.ConnectionConfig) error {
 			return fmt.Errorf("invalid postgres format")
 		}
 	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
-		c.MysqlConfig = &MysqlConnectionConfig{}
+		c.MysqlConfig = &MysqlConnectionConfig{
+			IsMariaDb: config.MysqlConfig.GetIsMariadb(),
+		}
 		if config.MysqlConfig.Tunnel != nil {
 			c.MysqlConfig.SSHTunnel = &SSHTunnel{}
 			c.MysqlConfig.SSHTunnel.FromDto(config.MysqlConfig.Tunnel)
@@ -169,6 +288,10 @@ func (c *ConnectionConfig) FromDto(dto *mgmtv1alpha1.ConnectionConfig) error {
 			c.MysqlConfig.ConnectionOptions = &ConnectionOptions{}
 			c.MysqlConfig.ConnectionOptions.FromDto(config.MysqlConfig.ConnectionOptions)
 		}
+		if config.MysqlConfig.GetTemporaryCredentials() != nil {
+			c.MysqlConfig.TemporaryCredentials = &TemporaryCredentials{}
+			c.MysqlConfig.TemporaryCredentials.FromDto(config.MysqlConfig.GetTemporaryCredentials())
+		}
 		switch mysqlcfg := config.MysqlConfig.ConnectionConfig.(type) {
 		case *mgmtv1alpha1.MysqlConnectionConfig_Connection:
 			c.MysqlConfig.Connection = &MysqlConnection{
@@ -196,6 +319,42 @@ func (c *ConnectionConfig) FromDto(dto *mgmtv1alpha1.ConnectionConfig) error {
 	case *mgmtv1alpha1.ConnectionConfig_OpenaiConfig:
 		c.OpenAiConfig = &OpenAiConnectionConfig{}
 		c.OpenAiConfig.FromDto(config.OpenaiConfig)
+	case *mgmtv1alpha1.ConnectionConfig_CustomConfig:
+		c.CustomConfig = &CustomConnectionConfig{}
+		c.CustomConfig.FromDto(config.CustomConfig)
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		c.SqliteConfig = &SqliteConnectionConfig{}
+		c.SqliteConfig.FromDto(config.SqliteConfig)
+	case *mgmtv1alpha1.ConnectionConfig_SnowflakeConfig:
+		c.SnowflakeConfig = &SnowflakeConnectionConfig{}
+		c.SnowflakeConfig.FromDto(config.SnowflakeConfig)
+	case *mgmtv1alpha1.ConnectionConfig_BigqueryConfig:
+		c.BigQueryConfig = &BigQueryConnectionConfig{}
+		c.BigQueryConfig.FromDto(config.BigqueryConfig)
+	case *mgmtv1alpha1.ConnectionConfig_RedshiftConfig:
+		c.RedshiftConfig = &RedshiftConnectionConfig{}
+		c.RedshiftConfig.FromDto(config.RedshiftConfig)
+	case *mgmtv1alpha1.ConnectionConfig_GcpCloudstorageConfig:
+		c.GcpCloudStorageConfig = &GcpCloudStorageConnectionConfig{}
+		c.GcpCloudStorageConfig.FromDto(config.GcpCloudstorageConfig)
+	case *mgmtv1alpha1.ConnectionConfig_AzureBlobConfig:
+		c.AzureBlobStorageConfig = &AzureBlobStorageConnectionConfig{}
+		c.AzureBlobStorageConfig.FromDto(config.AzureBlobConfig)
+	case *mgmtv1alpha1.ConnectionConfig_KafkaConfig:
+		c.KafkaConfig = &KafkaConnectionConfig{}
+		c.KafkaConfig.FromDto(config.KafkaConfig)
+	case *mgmtv1alpha1.ConnectionConfig_ElasticsearchConfig:
+		c.ElasticsearchConfig = &ElasticsearchConnectionConfig{}
+		c.ElasticsearchConfig.FromDto(config.ElasticsearchConfig)
+	case *mgmtv1alpha1.ConnectionConfig_DuckdbConfig:
+		c.DuckdbConfig = &DuckdbConnectionConfig{}
+		c.DuckdbConfig.FromDto(config.DuckdbConfig)
+	case *mgmtv1alpha1.ConnectionConfig_SpannerConfig:
+		c.SpannerConfig = &SpannerConnectionConfig{}
+		c.SpannerConfig.FromDto(config.SpannerConfig)
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		c.MssqlConfig = &MssqlConnectionConfig{}
+		c.MssqlConfig.FromDto(config.MssqlConfig)
 	default:
 		return fmt.Errorf("unable to convert to ConnectionConfig from DTO ConnectionConfig, type not supported: %T", config)
 	}
@@ -203,11 +362,13 @@ func (c *ConnectionConfig) FromDto(dto *mgmtv1alpha1.ConnectionConfig) error {
 }
 
 type PostgresConnectionConfig struct {
-	Connection        *PostgresConnection `json:"connection,omitempty"`
-	Url               *string             `json:"url,omitempty"`
-	SSHTunnel         *SSHTunnel          `json:"sshTunnel,omitempty"`
-	ConnectionOptions *ConnectionOptions  `json:"connectionOptions,omitempty"`
-	ClientTls         *ClientTls          `json:"clientTls,omitempty"`
+	Connection           *PostgresConnection   `json:"connection,omitempty"`
+	Url                  *string               `json:"url,omitempty"`
+	SSHTunnel            *SSHTunnel            `json:"sshTunnel,omitempty"`
+	ConnectionOptions    *ConnectionOptions    `json:"connectionOptions,omitempty"`
+	ClientTls            *ClientTls            `json:"clientTls,omitempty"`
+	TemporaryCredentials *TemporaryCredentials `json:"temporaryCredentials,omitempty"`
+	IsCockroachDb        bool                  `json:"isCockroachDb,omitempty"`
 }
 
 type PostgresConnection struct {
@@ -341,10 +502,65 @@ func (c *ClientTls) FromDto(dto *mgmtv1alpha1.ClientTlsConfig) {
 }
 
 type MysqlConnectionConfig struct {
-	Connection        *MysqlConnection   `json:"connection,omitempty"`
-	Url               *string            `json:"url,omitempty"`
-	SSHTunnel         *SSHTunnel         `json:"sshTunnel,omitempty"`
-	ConnectionOptions *ConnectionOptions `json:"connectionOptions,omitempty"`
+	Connection           *MysqlConnection      `json:"connection,omitempty"`
+	Url                  *string               `json:"url,omitempty"`
+	SSHTunnel            *SSHTunnel            `json:"sshTunnel,omitempty"`
+	ConnectionOptions    *ConnectionOptions    `json:"connectionOptions,omitempty"`
+	TemporaryCredentials *TemporaryCredentials `json:"temporaryCredentials,omitempty"`
+	IsMariaDb            bool                  `json:"isMariaDb,omitempty"`
+}
+
+type TemporaryCredentials struct {
+	Vault  *VaultDatabaseCredentials  `json:"vault,omitempty"`
+	AwsIam *AwsIamDatabaseCredentials `json:"awsIam,omitempty"`
+}
+
+type VaultDatabaseCredentials struct {
+	MountPath *string `json:"mountPath,omitempty"`
+	Role      string  `json:"role"`
+}
+
+type AwsIamDatabaseCredentials struct {
+	User   string `json:"user"`
+	Region string `json:"region"`
+}
+
+func (t *TemporaryCredentials) ToDto() *mgmtv1alpha1.TemporaryCredentialsConfig {
+	if t.Vault != nil {
+		return &mgmtv1alpha1.TemporaryCredentialsConfig{
+			Config: &mgmtv1alpha1.TemporaryCredentialsConfig_Vault{
+				Vault: &mgmtv1alpha1.VaultDatabaseCredentialsConfig{
+					MountPath: t.Vault.MountPath,
+					Role:      t.Vault.Role,
+				},
+			},
+		}
+	} else if t.AwsIam != nil {
+		return &mgmtv1alpha1.TemporaryCredentialsConfig{
+			Config: &mgmtv1alpha1.TemporaryCredentialsConfig_AwsIam{
+				AwsIam: &mgmtv1alpha1.AwsIamDatabaseCredentialsConfig{
+					User:   t.AwsIam.User,
+					Region: t.AwsIam.Region,
+				},
+			},
+		}
+	}
+	return nil
+}
+
+func (t *TemporaryCredentials) FromDto(dto *mgmtv1alpha1.TemporaryCredentialsConfig) {
+	switch cfg := dto.GetConfig().(type) {
+	case *mgmtv1alpha1.TemporaryCredentialsConfig_Vault:
+		t.Vault = &VaultDatabaseCredentials{
+			MountPath: cfg.Vault.MountPath,
+			Role:      cfg.Vault.GetRole(),
+		}
+	case *mgmtv1alpha1.TemporaryCredentialsConfig_AwsIam:
+		t.AwsIam = &AwsIamDatabaseCredentials{
+			User:   cfg.AwsIam.GetUser(),
+			Region: cfg.AwsIam.GetRegion(),
+		}
+	}
 }
 
 type MysqlConnection struct {
@@ -379,6 +595,292 @@ func (l *LocalDirectoryConnectionConfig) FromDto(dto *mgmtv1alpha1.LocalDirector
 	l.Path = dto.Path
 }
 
+type SqliteConnectionConfig struct {
+	Path string `json:"path"`
+}
+
+func (s *SqliteConnectionConfig) ToDto() *mgmtv1alpha1.SqliteConnectionConfig {
+	return &mgmtv1alpha1.SqliteConnectionConfig{
+		Path: s.Path,
+	}
+}
+func (s *SqliteConnectionConfig) FromDto(dto *mgmtv1alpha1.SqliteConnectionConfig) {
+	s.Path = dto.Path
+}
+
+type DuckdbConnectionConfig struct {
+	Path             *string `json:"path,omitempty"`
+	ParquetDirectory *string `json:"parquetDirectory,omitempty"`
+}
+
+func (d *DuckdbConnectionConfig) ToDto() *mgmtv1alpha1.DuckdbConnectionConfig {
+	return &mgmtv1alpha1.DuckdbConnectionConfig{
+		Path:             d.Path,
+		ParquetDirectory: d.ParquetDirectory,
+	}
+}
+func (d *DuckdbConnectionConfig) FromDto(dto *mgmtv1alpha1.DuckdbConnectionConfig) {
+	d.Path = dto.Path
+	d.ParquetDirectory = dto.ParquetDirectory
+}
+
+type SpannerConnectionConfig struct {
+	ProjectId         string  `json:"projectId"`
+	InstanceId        string  `json:"instanceId"`
+	DatabaseId        string  `json:"databaseId"`
+	ServiceAccountKey *string `json:"serviceAccountKey,omitempty"`
+	EmulatorHost      *string `json:"emulatorHost,omitempty"`
+}
+
+func (s *SpannerConnectionConfig) ToDto() *mgmtv1alpha1.SpannerConnectionConfig {
+	return &mgmtv1alpha1.SpannerConnectionConfig{
+		ProjectId:         s.ProjectId,
+		InstanceId:        s.InstanceId,
+		DatabaseId:        s.DatabaseId,
+		ServiceAccountKey: s.ServiceAccountKey,
+		EmulatorHost:      s.EmulatorHost,
+	}
+}
+func (s *SpannerConnectionConfig) FromDto(dto *mgmtv1alpha1.SpannerConnectionConfig) {
+	s.ProjectId = dto.ProjectId
+	s.InstanceId = dto.InstanceId
+	s.DatabaseId = dto.DatabaseId
+	s.ServiceAccountKey = dto.ServiceAccountKey
+	s.EmulatorHost = dto.EmulatorHost
+}
+
+type SnowflakeConnectionConfig struct {
+	Account   string  `json:"account"`
+	User      string  `json:"user"`
+	Pass      string  `json:"pass"`
+	Database  string  `json:"database"`
+	Warehouse *string `json:"warehouse,omitempty"`
+	Role      *string `json:"role,omitempty"`
+}
+
+func (s *SnowflakeConnectionConfig) ToDto() *mgmtv1alpha1.SnowflakeConnectionConfig {
+	return &mgmtv1alpha1.SnowflakeConnectionConfig{
+		Account:   s.Account,
+		User:      s.User,
+		Pass:      s.Pass,
+		Database:  s.Database,
+		Warehouse: s.Warehouse,
+		Role:      s.Role,
+	}
+}
+func (s *SnowflakeConnectionConfig) FromDto(dto *mgmtv1alpha1.SnowflakeConnectionConfig) {
+	s.Account = dto.Account
+	s.User = dto.User
+	s.Pass = dto.Pass
+	s.Database = dto.Database
+	s.Warehouse = dto.Warehouse
+	s.Role = dto.Role
+}
+
+type BigQueryConnectionConfig struct {
+	ProjectId         string  `json:"projectId"`
+	ServiceAccountKey *string `json:"serviceAccountKey,omitempty"`
+}
+
+func (b *BigQueryConnectionConfig) ToDto() *mgmtv1alpha1.BigQueryConnectionConfig {
+	return &mgmtv1alpha1.BigQueryConnectionConfig{
+		ProjectId:         b.ProjectId,
+		ServiceAccountKey: b.ServiceAccountKey,
+	}
+}
+
+func (b *BigQueryConnectionConfig) FromDto(dto *mgmtv1alpha1.BigQueryConnectionConfig) {
+	b.ProjectId = dto.ProjectId
+	b.ServiceAccountKey = dto.ServiceAccountKey
+}
+
+type RedshiftConnectionConfig struct {
+	Host    string  `json:"host"`
+	Port    int32   `json:"port"`
+	Name    string  `json:"name"`
+	User    string  `json:"user"`
+	Pass    string  `json:"pass"`
+	SslMode *string `json:"sslMode,omitempty"`
+}
+
+func (r *RedshiftConnectionConfig) ToDto() *mgmtv1alpha1.RedshiftConnectionConfig {
+	return &mgmtv1alpha1.RedshiftConnectionConfig{
+		Host:    r.Host,
+		Port:    r.Port,
+		Name:    r.Name,
+		User:    r.User,
+		Pass:    r.Pass,
+		SslMode: r.SslMode,
+	}
+}
+
+func (r *RedshiftConnectionConfig) FromDto(dto *mgmtv1alpha1.RedshiftConnectionConfig) {
+	r.Host = dto.Host
+	r.Port = dto.Port
+	r.Name = dto.Name
+	r.User = dto.User
+	r.Pass = dto.Pass
+	r.SslMode = dto.SslMode
+}
+
+type GcpCloudStorageConnectionConfig struct {
+	Bucket            string  `json:"bucket"`
+	PathPrefix        *string `json:"pathPrefix,omitempty"`
+	ServiceAccountKey *string `json:"serviceAccountKey,omitempty"`
+}
+
+func (g *GcpCloudStorageConnectionConfig) ToDto() *mgmtv1alpha1.GcpCloudStorageConnectionConfig {
+	return &mgmtv1alpha1.GcpCloudStorageConnectionConfig{
+		Bucket:            g.Bucket,
+		PathPrefix:        g.PathPrefix,
+		ServiceAccountKey: g.ServiceAccountKey,
+	}
+}
+
+func (g *GcpCloudStorageConnectionConfig) FromDto(dto *mgmtv1alpha1.GcpCloudStorageConnectionConfig) {
+	g.Bucket = dto.Bucket
+	g.PathPrefix = dto.PathPrefix
+	g.ServiceAccountKey = dto.ServiceAccountKey
+}
+
+type AzureBlobStorageConnectionConfig struct {
+	StorageAccount   string  `json:"storageAccount"`
+	Container        string  `json:"container"`
+	PathPrefix       *string `json:"pathPrefix,omitempty"`
+	StorageAccessKey *string `json:"storageAccessKey,omitempty"`
+	SasToken         *string `json:"sasToken,omitempty"`
+	ConnectionString *string `json:"connectionString,omitempty"`
+}
+
+func (a *AzureBlobStorageConnectionConfig) ToDto() *mgmtv1alpha1.AzureBlobStorageConnectionConfig {
+	return &mgmtv1alpha1.AzureBlobStorageConnectionConfig{
+		StorageAccount:   a.StorageAccount,
+		Container:        a.Container,
+		PathPrefix:       a.PathPrefix,
+		StorageAccessKey: a.StorageAccessKey,
+		SasToken:         a.SasToken,
+		ConnectionString: a.ConnectionString,
+	}
+}
+
+func (a *AzureBlobStorageConnectionConfig) FromDto(dto *mgmtv1alpha1.AzureBlobStorageConnectionConfig) {
+	a.StorageAccount = dto.StorageAccount
+	a.Container = dto.Container
+	a.PathPrefix = dto.PathPrefix
+	a.StorageAccessKey = dto.StorageAccessKey
+	a.SasToken = dto.SasToken
+	a.ConnectionString = dto.ConnectionString
+}
+
+type KafkaConnectionConfig struct {
+	Brokers             []string                   `json:"brokers"`
+	Topic               string                     `json:"topic"`
+	Sasl                *KafkaSaslConfig           `json:"sasl,omitempty"`
+	Tls                 *ClientTls                 `json:"tls,omitempty"`
+	SerializationFormat int32                      `json:"serializationFormat,omitempty"`
+	SchemaRegistry      *KafkaSchemaRegistryConfig `json:"schemaRegistry,omitempty"`
+}
+
+func (k *KafkaConnectionConfig) ToDto() *mgmtv1alpha1.KafkaConnectionConfig {
+	dto := &mgmtv1alpha1.KafkaConnectionConfig{
+		Brokers:             k.Brokers,
+		Topic:               k.Topic,
+		SerializationFormat: mgmtv1alpha1.KafkaSerializationFormat(k.SerializationFormat),
+	}
+	if k.Sasl != nil {
+		dto.Sasl = &mgmtv1alpha1.KafkaSaslConfig{
+			Mechanism: mgmtv1alpha1.KafkaSaslMechanism(k.Sasl.Mechanism),
+			Username:  k.Sasl.Username,
+			Password:  k.Sasl.Password,
+		}
+	}
+	if k.Tls != nil {
+		dto.Tls = k.Tls.ToDto()
+	}
+	if k.SchemaRegistry != nil {
+		dto.SchemaRegistry = &mgmtv1alpha1.KafkaSchemaRegistryConfig{
+			Url:      k.SchemaRegistry.Url,
+			Username: k.SchemaRegistry.Username,
+			Password: k.SchemaRegistry.Password,
+			Subject:  k.SchemaRegistry.Subject,
+		}
+	}
+	return dto
+}
+
+func (k *KafkaConnectionConfig) FromDto(dto *mgmtv1alpha1.KafkaConnectionConfig) {
+	k.Brokers = dto.Brokers
+	k.Topic = dto.Topic
+	k.SerializationFormat = int32(dto.SerializationFormat)
+	if dto.Sasl != nil {
+		k.Sasl = &KafkaSaslConfig{
+			Mechanism: int32(dto.Sasl.Mechanism),
+			Username:  dto.Sasl.Username,
+			Password:  dto.Sasl.Password,
+		}
+	}
+	if dto.Tls != nil {
+		k.Tls = &ClientTls{}
+		k.Tls.FromDto(dto.Tls)
+	}
+	if dto.SchemaRegistry != nil {
+		k.SchemaRegistry = &KafkaSchemaRegistryConfig{
+			Url:      dto.SchemaRegistry.Url,
+			Username: dto.SchemaRegistry.Username,
+			Password: dto.SchemaRegistry.Password,
+			Subject:  dto.SchemaRegistry.Subject,
+		}
+	}
+}
+
+type KafkaSaslConfig struct {
+	Mechanism int32  `json:"mechanism"`
+	Username  string `json:"username"`
+	Password  string `json:"password"`
+}
+
+type KafkaSchemaRegistryConfig struct {
+	Url      string  `json:"url"`
+	Username *string `json:"username,omitempty"`
+	Password *string `json:"password,omitempty"`
+	Subject  *string `json:"subject,omitempty"`
+}
+
+type ElasticsearchConnectionConfig struct {
+	Urls          []string   `json:"urls"`
+	Username      *string    `json:"username,omitempty"`
+	Password      *string    `json:"password,omitempty"`
+	ApiKey        *string    `json:"apiKey,omitempty"`
+	Tls           *ClientTls `json:"tls,omitempty"`
+	IndexPatterns []string   `json:"indexPatterns,omitempty"`
+}
+
+func (e *ElasticsearchConnectionConfig) ToDto() *mgmtv1alpha1.ElasticsearchConnectionConfig {
+	dto := &mgmtv1alpha1.ElasticsearchConnectionConfig{
+		Urls:          e.Urls,
+		Username:      e.Username,
+		Password:      e.Password,
+		ApiKey:        e.ApiKey,
+		IndexPatterns: e.IndexPatterns,
+	}
+	if e.Tls != nil {
+		dto.Tls = e.Tls.ToDto()
+	}
+	return dto
+}
+
+func (e *ElasticsearchConnectionConfig) FromDto(dto *mgmtv1alpha1.ElasticsearchConnectionConfig) {
+	e.Urls = dto.Urls
+	e.Username = dto.Username
+	e.Password = dto.Password
+	e.ApiKey = dto.ApiKey
+	e.IndexPatterns = dto.IndexPatterns
+	if dto.Tls != nil {
+		e.Tls = &ClientTls{}
+		e.Tls.FromDto(dto.Tls)
+	}
+}
+
 type OpenAiConnectionConfig struct {
 	ApiUrl string `json:"apiUrl"`
 	ApiKey string `json:"apiKey"`
@@ -399,6 +901,58 @@ func (o *OpenAiConnectionConfig) FromDto(dto *mgmtv1alpha1.OpenAiConnectionConfi
 	o.ApiUrl = dto.ApiUrl
 }
 
+type CustomConnectionConfig struct {
+	Plugin  string            `json:"plugin"`
+	Options map[string]string `json:"options,omitempty"`
+}
+
+func (c *CustomConnectionConfig) ToDto() *mgmtv1alpha1.CustomConnectionConfig {
+	return &mgmtv1alpha1.CustomConnectionConfig{
+		Plugin:  c.Plugin,
+		Options: c.Options,
+	}
+}
+
+func (c *CustomConnectionConfig) FromDto(dto *mgmtv1alpha1.CustomConnectionConfig) {
+	if dto == nil {
+		return
+	}
+	c.Plugin = dto.Plugin
+	c.Options = dto.Options
+}
+
+type MssqlConnectionConfig struct {
+	Host                   string  `json:"host"`
+	Port                   int32   `json:"port"`
+	Name                   string  `json:"name"`
+	User                   string  `json:"user"`
+	Pass                   string  `json:"pass"`
+	Encrypt                *string `json:"encrypt,omitempty"`
+	TrustServerCertificate bool    `json:"trustServerCertificate,omitempty"`
+}
+
+func (m *MssqlConnectionConfig) ToDto() *mgmtv1alpha1.MssqlConnectionConfig {
+	return &mgmtv1alpha1.MssqlConnectionConfig{
+		Host:                   m.Host,
+		Port:                   m.Port,
+		Name:                   m.Name,
+		User:                   m.User,
+		Pass:                   m.Pass,
+		Encrypt:                m.Encrypt,
+		TrustServerCertificate: m.TrustServerCertificate,
+	}
+}
+
+func (m *MssqlConnectionConfig) FromDto(dto *mgmtv1alpha1.MssqlConnectionConfig) {
+	m.Host = dto.Host
+	m.Port = dto.Port
+	m.Name = dto.Name
+	m.User = dto.User
+	m.Pass = dto.Pass
+	m.Encrypt = dto.Encrypt
+	m.TrustServerCertificate = dto.TrustServerCertificate
+}
+
 func (a *AwsS3Credentials) ToDto() *mgmtv1alpha1.AwsS3Credentials {
 	return &mgmtv1alpha1.AwsS3Credentials{
 		Profile:         a.Profile,
@@ -430,6 +984,9 @@ type AwsS3ConnectionConfig struct {
 	Credentials *AwsS3Credentials
 	Region      *string
 	Endpoint    *string
+
+	ForcePathStyle     *bool
+	InsecureSkipVerify *bool
 }
 
 func (a *AwsS3ConnectionConfig) ToDto() *mgmtv1alpha1.AwsS3ConnectionConfig {
@@ -444,6 +1001,9 @@ func (a *AwsS3ConnectionConfig) ToDto() *mgmtv1alpha1.AwsS3ConnectionConfig {
 		Credentials: a.Credentials.ToDto(),
 		Region:      a.Region,
 		Endpoint:    a.Endpoint,
+
+		ForcePathStyle:     a.ForcePathStyle,
+		InsecureSkipVerify: a.InsecureSkipVerify,
 	}
 }
 func (a *AwsS3ConnectionConfig) FromDto(dto *mgmtv1alpha1.AwsS3ConnectionConfig) error {
@@ -453,22 +1013,28 @@ func (a *AwsS3ConnectionConfig) FromDto(dto *mgmtv1alpha1.AwsS3ConnectionConfig)
 	a.Credentials.FromDto(dto.Credentials)
 	a.Region = dto.Region
 	a.Endpoint = dto.Endpoint
+	a.ForcePathStyle = dto.ForcePathStyle
+	a.InsecureSkipVerify = dto.InsecureSkipVerify
 	return nil
 }
 
 type JobMapping struct {
-	Schema                string                      `json:"schema"`
-	Table                 string                      `json:"table"`
-	Column                string                      `json:"column"`
-	JobMappingTransformer *JobMappingTransformerModel `json:"jobMappingTransformerModel,omitempty"`
+	Schema                string                       `json:"schema"`
+	Table                 string                       `json:"table"`
+	Column                string                       `json:"column"`
+	JobMappingTransformer *JobMappingTransformerModel  `json:"jobMappingTransformerModel,omitempty"`
+	DestinationTable      *JobMappingDestinationTable  `json:"destinationTable,omitempty"`
+	DestinationColumn     *JobMappingDestinationColumn `json:"destinationColumn,omitempty"`
 }
 
 func (jm *JobMapping) ToDto() *mgmtv1alpha1.JobMapping {
 	return &mgmtv1alpha1.JobMapping{
-		Schema:      jm.Schema,
-		Table:       jm.Table,
-		Column:      jm.Column,
-		Transformer: jm.JobMappingTransformer.ToTransformerDto(),
+		Schema:            jm.Schema,
+		Table:             jm.Table,
+		Column:            jm.Column,
+		Transformer:       jm.JobMappingTransformer.ToTransformerDto(),
+		DestinationTable:  jm.DestinationTable.ToDto(),
+		DestinationColumn: jm.DestinationColumn.ToDto(),
 	}
 }
 
@@ -481,14 +1047,127 @@ func (jm *JobMapping) FromDto(dto *mgmtv1alpha1.JobMapping) error {
 	jm.Table = dto.Table
 	jm.Column = dto.Column
 	jm.JobMappingTransformer = t
+	if dto.DestinationTable != nil {
+		jm.DestinationTable = &JobMappingDestinationTable{}
+		jm.DestinationTable.FromDto(dto.DestinationTable)
+	}
+	if dto.DestinationColumn != nil {
+		jm.DestinationColumn = &JobMappingDestinationColumn{}
+		jm.DestinationColumn.FromDto(dto.DestinationColumn)
+	}
 	return nil
 }
 
+type JobMappingDestinationTable struct {
+	Schema string `json:"schema"`
+	Table  string `json:"table"`
+}
+
+func (t *JobMappingDestinationTable) ToDto() *mgmtv1alpha1.JobMappingDestinationTable {
+	if t == nil {
+		return nil
+	}
+	return &mgmtv1alpha1.JobMappingDestinationTable{
+		Schema: t.Schema,
+		Table:  t.Table,
+	}
+}
+
+func (t *JobMappingDestinationTable) FromDto(dto *mgmtv1alpha1.JobMappingDestinationTable) {
+	t.Schema = dto.GetSchema()
+	t.Table = dto.GetTable()
+}
+
+type JobMappingDestinationColumn struct {
+	Name     *string `json:"name,omitempty"`
+	DataType *string `json:"dataType,omitempty"`
+}
+
+func (c *JobMappingDestinationColumn) ToDto() *mgmtv1alpha1.JobMappingDestinationColumn {
+	if c == nil {
+		return nil
+	}
+	return &mgmtv1alpha1.JobMappingDestinationColumn{
+		Name:     c.Name,
+		DataType: c.DataType,
+	}
+}
+
+func (c *JobMappingDestinationColumn) FromDto(dto *mgmtv1alpha1.JobMappingDestinationColumn) {
+	c.Name = dto.Name
+	c.DataType = dto.DataType
+}
+
+type JobTableProcessor struct {
+	Schema     string `json:"schema"`
+	Table      string `json:"table"`
+	Processors string `json:"processors"`
+}
+
+func (p *JobTableProcessor) ToDto() *mgmtv1alpha1.JobTableProcessor {
+	return &mgmtv1alpha1.JobTableProcessor{
+		Schema:     p.Schema,
+		Table:      p.Table,
+		Processors: p.Processors,
+	}
+}
+
+func (p *JobTableProcessor) FromDto(dto *mgmtv1alpha1.JobTableProcessor) {
+	p.Schema = dto.Schema
+	p.Table = dto.Table
+	p.Processors = dto.Processors
+}
+
+type JobParameter struct {
+	Name         string  `json:"name"`
+	Type         int32   `json:"type"`
+	Description  string  `json:"description,omitempty"`
+	DefaultValue *string `json:"defaultValue,omitempty"`
+}
+
+func (p *JobParameter) ToDto() *mgmtv1alpha1.JobParameter {
+	return &mgmtv1alpha1.JobParameter{
+		Name:         p.Name,
+		Type:         mgmtv1alpha1.JobParameterType(p.Type),
+		Description:  p.Description,
+		DefaultValue: p.DefaultValue,
+	}
+}
+
+func (p *JobParameter) FromDto(dto *mgmtv1alpha1.JobParameter) {
+	p.Name = dto.Name
+	p.Type = int32(dto.Type)
+	p.Description = dto.Description
+	p.DefaultValue = dto.DefaultValue
+}
+
+type JobParameterValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+func (p *JobParameterValue) ToDto() *mgmtv1alpha1.JobParameterValue {
+	return &mgmtv1alpha1.JobParameterValue{
+		Name:  p.Name,
+		Value: p.Value,
+	}
+}
+
+func (p *JobParameterValue) FromDto(dto *mgmtv1alpha1.JobParameterValue) {
+	p.Name = dto.Name
+	p.Value = dto.Value
+}
+
 type JobSourceOptions struct {
-	PostgresOptions   *PostgresSourceOptions   `json:"postgresOptions,omitempty"`
-	MysqlOptions      *MysqlSourceOptions      `json:"mysqlOptions,omitempty"`
-	GenerateOptions   *GenerateSourceOptions   `json:"generateOptions,omitempty"`
-	AiGenerateOptions *AiGenerateSourceOptions `json:"aiGenerateOptions,omitempty"`
+	PostgresOptions      *PostgresSourceOptions      `json:"postgresOptions,omitempty"`
+	MysqlOptions         *MysqlSourceOptions         `json:"mysqlOptions,omitempty"`
+	GenerateOptions      *GenerateSourceOptions      `json:"generateOptions,omitempty"`
+	AiGenerateOptions    *AiGenerateSourceOptions    `json:"aiGenerateOptions,omitempty"`
+	SynthesizeOptions    *SynthesizeSourceOptions    `json:"synthesizeOptions,omitempty"`
+	AwsS3Options         *AwsS3SourceOptions         `json:"awsS3Options,omitempty"`
+	CustomOptions        *CustomSourceOptions        `json:"customOptions,omitempty"`
+	SnapshotCloneOptions *SnapshotCloneSourceOptions `json:"snapshotCloneOptions,omitempty"`
+	InPlaceMaskOptions   *InPlaceMaskSourceOptions   `json:"inPlaceMaskOptions,omitempty"`
 }
 
 type MysqlSourceOptions struct {
@@ -496,12 +1175,119 @@ type MysqlSourceOptions struct {
 	SubsetByForeignKeyConstraints bool                       `json:"subsetByForeignKeyConstraints"`
 	Schemas                       []*MysqlSourceSchemaOption `json:"schemas"`
 	ConnectionId                  string                     `json:"connectionId"`
+	VirtualTables                 []*SqlVirtualTable         `json:"virtualTables,omitempty"`
+	MaterializeViews              bool                       `json:"materializeViews,omitempty"`
+	TenantExtract                 *TenantExtractOptions      `json:"tenantExtract,omitempty"`
 }
 type PostgresSourceOptions struct {
 	HaltOnNewColumnAddition       bool                          `json:"haltOnNewColumnAddition"`
 	SubsetByForeignKeyConstraints bool                          `json:"subsetByForeignKeyConstraints"`
 	Schemas                       []*PostgresSourceSchemaOption `json:"schemas"`
 	ConnectionId                  string                        `json:"connectionId"`
+	IncludeForeignTables          bool                          `json:"includeForeignTables,omitempty"`
+	VirtualTables                 []*SqlVirtualTable            `json:"virtualTables,omitempty"`
+	MaterializeViews              bool                          `json:"materializeViews,omitempty"`
+	TenantExtract                 *TenantExtractOptions         `json:"tenantExtract,omitempty"`
+}
+
+type SqlVirtualTable struct {
+	Schema string `json:"schema"`
+	Table  string `json:"table"`
+	Query  string `json:"query"`
+}
+
+func (s *SqlVirtualTable) ToDto() *mgmtv1alpha1.SqlVirtualTable {
+	return &mgmtv1alpha1.SqlVirtualTable{
+		Schema: s.Schema,
+		Table:  s.Table,
+		Query:  s.Query,
+	}
+}
+
+func (s *SqlVirtualTable) FromDto(dto *mgmtv1alpha1.SqlVirtualTable) {
+	s.Schema = dto.Schema
+	s.Table = dto.Table
+	s.Query = dto.Query
+}
+
+func toSqlVirtualTableDtos(tables []*SqlVirtualTable) []*mgmtv1alpha1.SqlVirtualTable {
+	if len(tables) == 0 {
+		return nil
+	}
+	dtos := make([]*mgmtv1alpha1.SqlVirtualTable, len(tables))
+	for idx := range tables {
+		dtos[idx] = tables[idx].ToDto()
+	}
+	return dtos
+}
+
+func fromSqlVirtualTableDtos(dtos []*mgmtv1alpha1.SqlVirtualTable) []*SqlVirtualTable {
+	if len(dtos) == 0 {
+		return nil
+	}
+	tables := make([]*SqlVirtualTable, len(dtos))
+	for idx := range dtos {
+		tables[idx] = &SqlVirtualTable{}
+		tables[idx].FromDto(dtos[idx])
+	}
+	return tables
+}
+
+type TenantExtractOptions struct {
+	TenantValue  string                `json:"tenantValue"`
+	TenantColumn *string               `json:"tenantColumn,omitempty"`
+	RootTables   []*TenantExtractTable `json:"rootTables,omitempty"`
+}
+
+type TenantExtractTable struct {
+	Schema       string `json:"schema"`
+	Table        string `json:"table"`
+	TenantColumn string `json:"tenantColumn"`
+}
+
+func (t *TenantExtractOptions) ToDto() *mgmtv1alpha1.TenantExtractOptions {
+	rootTables := make([]*mgmtv1alpha1.TenantExtractTable, len(t.RootTables))
+	for idx := range t.RootTables {
+		rootTables[idx] = &mgmtv1alpha1.TenantExtractTable{
+			Schema:       t.RootTables[idx].Schema,
+			Table:        t.RootTables[idx].Table,
+			TenantColumn: t.RootTables[idx].TenantColumn,
+		}
+	}
+	return &mgmtv1alpha1.TenantExtractOptions{
+		TenantValue:  t.TenantValue,
+		TenantColumn: t.TenantColumn,
+		RootTables:   rootTables,
+	}
+}
+
+func (t *TenantExtractOptions) FromDto(dto *mgmtv1alpha1.TenantExtractOptions) {
+	t.TenantValue = dto.TenantValue
+	t.TenantColumn = dto.TenantColumn
+	t.RootTables = make([]*TenantExtractTable, len(dto.RootTables))
+	for idx := range dto.RootTables {
+		t.RootTables[idx] = &TenantExtractTable{
+			Schema:       dto.RootTables[idx].Schema,
+			Table:        dto.RootTables[idx].Table,
+			TenantColumn: dto.RootTables[idx].TenantColumn,
+		}
+	}
+}
+
+func toTenantExtractDto(tenantExtract *TenantExtractOptions) *mgmtv1alpha1.TenantExtractOptions {
+	if tenantExtract == nil {
+		return nil
+	}
+	return tenantExtract.ToDto()
+}
+
+func fromTenantExtractDto(dto *mgmtv1alpha1.TenantExtractOptions) *TenantExtractOptions {
+	if dto == nil {
+		return nil
+	}
+	tenantExtract := &TenantExtractOptions{}
+	tenantExtract.FromDto(dto)
+	return tenantExtract
 }
 
 type GenerateSourceOptions struct {
@@ -526,6 +1312,62 @@ type AiGenerateSourceOptions struct {
 	UserPrompt           *string                         `json:"userPrompt,omitempty"`
 }
 
+type SynthesizeSourceOptions struct {
+	ConnectionId       string                          `json:"connectionId"`
+	Schemas            []*SynthesizeSourceSchemaOption `json:"schemas"`
+	Model              int32                           `json:"model"`
+	SampleSize         *uint32                         `json:"sampleSize,omitempty"`
+	ExternalServiceUrl *string                         `json:"externalServiceUrl,omitempty"`
+}
+
+type AwsS3SourceOptions struct {
+	ConnectionId string  `json:"connectionId"`
+	JobRunId     *string `json:"jobRunId,omitempty"`
+	SnapshotName *string `json:"snapshotName,omitempty"`
+}
+
+type CustomSourceOptions struct {
+	ConnectionId string `json:"connectionId"`
+}
+
+type SnapshotCloneSourceOptions struct {
+	ConnectionId           string             `json:"connectionId"`
+	AwsRds                 *AwsRdsSnapshot    `json:"awsRds,omitempty"`
+	GcpCloudSql            *GcpCloudSqlBackup `json:"gcpCloudSql,omitempty"`
+	HandoverConnectionName *string            `json:"handoverConnectionName,omitempty"`
+	RetainOnFailure        bool               `json:"retainOnFailure,omitempty"`
+}
+
+type InPlaceMaskSourceOptions struct {
+	ConnectionId string  `json:"connectionId"`
+	BatchSize    *uint32 `json:"batchSize,omitempty"`
+	DryRun       bool    `json:"dryRun,omitempty"`
+}
+
+type AwsRdsSnapshot struct {
+	SnapshotIdentifier  string   `json:"snapshotIdentifier"`
+	Region              string   `json:"region"`
+	DbInstanceClass     *string  `json:"dbInstanceClass,omitempty"`
+	DbSubnetGroupName   *string  `json:"dbSubnetGroupName,omitempty"`
+	VpcSecurityGroupIds []string `json:"vpcSecurityGroupIds,omitempty"`
+}
+
+type GcpCloudSqlBackup struct {
+	Project     string  `json:"project"`
+	Instance    string  `json:"instance"`
+	BackupRunId *int64  `json:"backupRunId,omitempty"`
+	Tier        *string `json:"tier,omitempty"`
+}
+
+type SynthesizeSourceSchemaOption struct {
+	Schema string                         `json:"schema"`
+	Tables []*SynthesizeSourceTableOption `json:"tables"`
+}
+type SynthesizeSourceTableOption struct {
+	Table    string `json:"table"`
+	RowCount int64  `json:"rowCount,omitempty"`
+}
+
 type AiGenerateSourceSchemaOption struct {
 	Schema string                         `json:"schema"`
 	Tables []*AiGenerateSourceTableOption `json:"tables"`
@@ -540,6 +1382,10 @@ func (s *PostgresSourceOptions) ToDto() *mgmtv1alpha1.PostgresSourceConnectionOp
 		HaltOnNewColumnAddition:       s.HaltOnNewColumnAddition,
 		SubsetByForeignKeyConstraints: s.SubsetByForeignKeyConstraints,
 		ConnectionId:                  s.ConnectionId,
+		IncludeForeignTables:          s.IncludeForeignTables,
+		VirtualTables:                 toSqlVirtualTableDtos(s.VirtualTables),
+		MaterializeViews:              s.MaterializeViews,
+		TenantExtract:                 toTenantExtractDto(s.TenantExtract),
 	}
 	dto.Schemas = make([]*mgmtv1alpha1.PostgresSourceSchemaOption, len(s.Schemas))
 	for idx := range s.Schemas {
@@ -565,6 +1411,10 @@ func (s *PostgresSourceOptions) FromDto(dto *mgmtv1alpha1.PostgresSourceConnecti
 	s.SubsetByForeignKeyConstraints = dto.SubsetByForeignKeyConstraints
 	s.Schemas = FromDtoPostgresSourceSchemaOptions(dto.Schemas)
 	s.ConnectionId = dto.ConnectionId
+	s.IncludeForeignTables = dto.IncludeForeignTables
+	s.VirtualTables = fromSqlVirtualTableDtos(dto.VirtualTables)
+	s.MaterializeViews = dto.MaterializeViews
+	s.TenantExtract = fromTenantExtractDto(dto.TenantExtract)
 }
 
 func FromDtoPostgresSourceSchemaOptions(dtos []*mgmtv1alpha1.PostgresSourceSchemaOption) []*PostgresSourceSchemaOption {
@@ -593,6 +1443,9 @@ func (s *MysqlSourceOptions) ToDto() *mgmtv1alpha1.MysqlSourceConnectionOptions
 		HaltOnNewColumnAddition:       s.HaltOnNewColumnAddition,
 		SubsetByForeignKeyConstraints: s.SubsetByForeignKeyConstraints,
 		ConnectionId:                  s.ConnectionId,
+		VirtualTables:                 toSqlVirtualTableDtos(s.VirtualTables),
+		MaterializeViews:              s.MaterializeViews,
+		TenantExtract:                 toTenantExtractDto(s.TenantExtract),
 	}
 	dto.Schemas = make([]*mgmtv1alpha1.MysqlSourceSchemaOption, len(s.Schemas))
 	for idx := range s.Schemas {
@@ -618,6 +1471,9 @@ func (s *MysqlSourceOptions) FromDto(dto *mgmtv1alpha1.MysqlSourceConnectionOpti
 	s.SubsetByForeignKeyConstraints = dto.SubsetByForeignKeyConstraints
 	s.Schemas = FromDtoMysqlSourceSchemaOptions(dto.Schemas)
 	s.ConnectionId = dto.ConnectionId
+	s.VirtualTables = fromSqlVirtualTableDtos(dto.VirtualTables)
+	s.MaterializeViews = dto.MaterializeViews
+	s.TenantExtract = fromTenantExtractDto(dto.TenantExtract)
 }
 
 func FromDtoMysqlSourceSchemaOptions(dtos []*mgmtv1alpha1.MysqlSourceSchemaOption) []*MysqlSourceSchemaOption {
@@ -744,6 +1600,148 @@ func FromDtoAiGenerateSourceSchemaOptions(dtos []*mgmtv1alpha1.AiGenerateSourceS
 	return output
 }
 
+func (s *SynthesizeSourceOptions) ToDto() *mgmtv1alpha1.SynthesizeSourceOptions {
+	dto := &mgmtv1alpha1.SynthesizeSourceOptions{
+		ConnectionId:       s.ConnectionId,
+		Model:              mgmtv1alpha1.SynthesizerModel(s.Model),
+		SampleSize:         s.SampleSize,
+		ExternalServiceUrl: s.ExternalServiceUrl,
+	}
+	dto.Schemas = make([]*mgmtv1alpha1.SynthesizeSourceSchemaOption, len(s.Schemas))
+	for idx := range s.Schemas {
+		schema := s.Schemas[idx]
+		tables := make([]*mgmtv1alpha1.SynthesizeSourceTableOption, len(schema.Tables))
+		for tidx := range schema.Tables {
+			table := schema.Tables[tidx]
+			tables[tidx] = &mgmtv1alpha1.SynthesizeSourceTableOption{
+				Table:    table.Table,
+				RowCount: table.RowCount,
+			}
+		}
+		dto.Schemas[idx] = &mgmtv1alpha1.SynthesizeSourceSchemaOption{
+			Schema: schema.Schema,
+			Tables: tables,
+		}
+	}
+	return dto
+}
+func (s *SynthesizeSourceOptions) FromDto(dto *mgmtv1alpha1.SynthesizeSourceOptions) {
+	s.ConnectionId = dto.ConnectionId
+	s.Model = int32(dto.Model)
+	s.SampleSize = dto.SampleSize
+	s.ExternalServiceUrl = dto.ExternalServiceUrl
+	s.Schemas = make([]*SynthesizeSourceSchemaOption, len(dto.Schemas))
+	for idx := range dto.Schemas {
+		schema := dto.Schemas[idx]
+		tables := make([]*SynthesizeSourceTableOption, len(schema.Tables))
+		for tidx := range schema.Tables {
+			table := schema.Tables[tidx]
+			tables[tidx] = &SynthesizeSourceTableOption{
+				Table:    table.Table,
+				RowCount: table.RowCount,
+			}
+		}
+		s.Schemas[idx] = &SynthesizeSourceSchemaOption{
+			Schema: schema.Schema,
+			Tables: tables,
+		}
+	}
+}
+
+func (s *AwsS3SourceOptions) ToDto() *mgmtv1alpha1.AwsS3SourceConnectionOptions {
+	dto := &mgmtv1alpha1.AwsS3SourceConnectionOptions{
+		ConnectionId: s.ConnectionId,
+	}
+	if s.SnapshotName != nil {
+		dto.Run = &mgmtv1alpha1.AwsS3SourceConnectionOptions_SnapshotName{SnapshotName: *s.SnapshotName}
+	} else if s.JobRunId != nil {
+		dto.Run = &mgmtv1alpha1.AwsS3SourceConnectionOptions_JobRunId{JobRunId: *s.JobRunId}
+	}
+	return dto
+}
+func (s *AwsS3SourceOptions) FromDto(dto *mgmtv1alpha1.AwsS3SourceConnectionOptions) {
+	s.ConnectionId = dto.ConnectionId
+	switch run := dto.Run.(type) {
+	case *mgmtv1alpha1.AwsS3SourceConnectionOptions_JobRunId:
+		s.JobRunId = &run.JobRunId
+	case *mgmtv1alpha1.AwsS3SourceConnectionOptions_SnapshotName:
+		s.SnapshotName = &run.SnapshotName
+	}
+}
+
+func (s *CustomSourceOptions) ToDto() *mgmtv1alpha1.CustomSourceConnectionOptions {
+	return &mgmtv1alpha1.CustomSourceConnectionOptions{
+		ConnectionId: s.ConnectionId,
+	}
+}
+func (s *CustomSourceOptions) FromDto(dto *mgmtv1alpha1.CustomSourceConnectionOptions) {
+	s.ConnectionId = dto.ConnectionId
+}
+
+func (s *SnapshotCloneSourceOptions) ToDto() *mgmtv1alpha1.SnapshotCloneSourceOptions {
+	dto := &mgmtv1alpha1.SnapshotCloneSourceOptions{
+		ConnectionId:           s.ConnectionId,
+		HandoverConnectionName: s.HandoverConnectionName,
+		RetainOnFailure:        s.RetainOnFailure,
+	}
+	if s.AwsRds != nil {
+		dto.Snapshot = &mgmtv1alpha1.SnapshotCloneSourceOptions_AwsRds{
+			AwsRds: &mgmtv1alpha1.AwsRdsSnapshot{
+				SnapshotIdentifier:  s.AwsRds.SnapshotIdentifier,
+				Region:              s.AwsRds.Region,
+				DbInstanceClass:     s.AwsRds.DbInstanceClass,
+				DbSubnetGroupName:   s.AwsRds.DbSubnetGroupName,
+				VpcSecurityGroupIds: s.AwsRds.VpcSecurityGroupIds,
+			},
+		}
+	} else if s.GcpCloudSql != nil {
+		dto.Snapshot = &mgmtv1alpha1.SnapshotCloneSourceOptions_GcpCloudsql{
+			GcpCloudsql: &mgmtv1alpha1.GcpCloudSqlBackup{
+				Project:     s.GcpCloudSql.Project,
+				Instance:    s.GcpCloudSql.Instance,
+				BackupRunId: s.GcpCloudSql.BackupRunId,
+				Tier:        s.GcpCloudSql.Tier,
+			},
+		}
+	}
+	return dto
+}
+func (s *SnapshotCloneSourceOptions) FromDto(dto *mgmtv1alpha1.SnapshotCloneSourceOptions) {
+	s.ConnectionId = dto.GetConnectionId()
+	s.HandoverConnectionName = dto.HandoverConnectionName
+	s.RetainOnFailure = dto.GetRetainOnFailure()
+	switch snapshot := dto.Snapshot.(type) {
+	case *mgmtv1alpha1.SnapshotCloneSourceOptions_AwsRds:
+		s.AwsRds = &AwsRdsSnapshot{
+			SnapshotIdentifier:  snapshot.AwsRds.GetSnapshotIdentifier(),
+			Region:              snapshot.AwsRds.GetRegion(),
+			DbInstanceClass:     snapshot.AwsRds.DbInstanceClass,
+			DbSubnetGroupName:   snapshot.AwsRds.DbSubnetGroupName,
+			VpcSecurityGroupIds: snapshot.AwsRds.GetVpcSecurityGroupIds(),
+		}
+	case *mgmtv1alpha1.SnapshotCloneSourceOptions_GcpCloudsql:
+		s.GcpCloudSql = &GcpCloudSqlBackup{
+			Project:     snapshot.GcpCloudsql.GetProject(),
+			Instance:    snapshot.GcpCloudsql.GetInstance(),
+			BackupRunId: snapshot.GcpCloudsql.BackupRunId,
+			Tier:        snapshot.GcpCloudsql.Tier,
+		}
+	}
+}
+
+func (s *InPlaceMaskSourceOptions) ToDto() *mgmtv1alpha1.InPlaceMaskSourceOptions {
+	return &mgmtv1alpha1.InPlaceMaskSourceOptions{
+		ConnectionId: s.ConnectionId,
+		BatchSize:    s.BatchSize,
+		DryRun:       s.DryRun,
+	}
+}
+func (s *InPlaceMaskSourceOptions) FromDto(dto *mgmtv1alpha1.InPlaceMaskSourceOptions) {
+	s.ConnectionId = dto.GetConnectionId()
+	s.BatchSize = dto.BatchSize
+	s.DryRun = dto.GetDryRun()
+}
+
 type PostgresSourceSchemaOption struct {
 	Schema string                       `json:"schema"`
 	Tables []*PostgresSourceTableOption `json:"tables"`
@@ -791,6 +1789,41 @@ func (j *JobSourceOptions) ToDto() *mgmtv1alpha1.JobSourceOptions {
 			},
 		}
 	}
+	if j.SynthesizeOptions != nil {
+		return &mgmtv1alpha1.JobSourceOptions{
+			Config: &mgmtv1alpha1.JobSourceOptions_Synthesize{
+				Synthesize: j.SynthesizeOptions.ToDto(),
+			},
+		}
+	}
+	if j.AwsS3Options != nil {
+		return &mgmtv1alpha1.JobSourceOptions{
+			Config: &mgmtv1alpha1.JobSourceOptions_AwsS3{
+				AwsS3: j.AwsS3Options.ToDto(),
+			},
+		}
+	}
+	if j.CustomOptions != nil {
+		return &mgmtv1alpha1.JobSourceOptions{
+			Config: &mgmtv1alpha1.JobSourceOptions_Custom{
+				Custom: j.CustomOptions.ToDto(),
+			},
+		}
+	}
+	if j.SnapshotCloneOptions != nil {
+		return &mgmtv1alpha1.JobSourceOptions{
+			Config: &mgmtv1alpha1.JobSourceOptions_SnapshotClone{
+				SnapshotClone: j.SnapshotCloneOptions.ToDto(),
+			},
+		}
+	}
+	if j.InPlaceMaskOptions != nil {
+		return &mgmtv1alpha1.JobSourceOptions{
+			Config: &mgmtv1alpha1.JobSourceOptions_InPlaceMask{
+				InPlaceMask: j.InPlaceMaskOptions.ToDto(),
+			},
+		}
+	}
 	return nil
 }
 
@@ -812,6 +1845,26 @@ func (j *JobSourceOptions) FromDto(dto *mgmtv1alpha1.JobSourceOptions) error {
 		genOpts := &AiGenerateSourceOptions{}
 		genOpts.FromDto(config.AiGenerate)
 		j.AiGenerateOptions = genOpts
+	case *mgmtv1alpha1.JobSourceOptions_Synthesize:
+		synthOpts := &SynthesizeSourceOptions{}
+		synthOpts.FromDto(config.Synthesize)
+		j.SynthesizeOptions = synthOpts
+	case *mgmtv1alpha1.JobSourceOptions_AwsS3:
+		awsS3Opts := &AwsS3SourceOptions{}
+		awsS3Opts.FromDto(config.AwsS3)
+		j.AwsS3Options = awsS3Opts
+	case *mgmtv1alpha1.JobSourceOptions_Custom:
+		customOpts := &CustomSourceOptions{}
+		customOpts.FromDto(config.Custom)
+		j.CustomOptions = customOpts
+	case *mgmtv1alpha1.JobSourceOptions_SnapshotClone:
+		cloneOpts := &SnapshotCloneSourceOptions{}
+		cloneOpts.FromDto(config.SnapshotClone)
+		j.SnapshotCloneOptions = cloneOpts
+	case *mgmtv1alpha1.JobSourceOptions_InPlaceMask:
+		maskOpts := &InPlaceMaskSourceOptions{}
+		maskOpts.FromDto(config.InPlaceMask)
+		j.InPlaceMaskOptions = maskOpts
 	default:
 		return fmt.Errorf("invalid job source options config, received type: %T", config)
 	}
@@ -822,12 +1875,99 @@ type JobDestinationOptions struct {
 	PostgresOptions *PostgresDestinationOptions `json:"postgresOptions,omitempty"`
 	AwsS3Options    *AwsS3DestinationOptions    `json:"awsS3Options,omitempty"`
 	MysqlOptions    *MysqlDestinationOptions    `json:"mysqlOptions,omitempty"`
+	CustomOptions   *CustomDestinationOptions   `json:"customOptions,omitempty"`
+	KafkaOptions    *KafkaDestinationOptions    `json:"kafkaOptions,omitempty"`
+}
+type AwsS3DestinationOptions struct {
+	PartSize          *int64 `json:"partSize,omitempty"`
+	UploadConcurrency *int32 `json:"uploadConcurrency,omitempty"`
+	MaxRetryAttempts  *int32 `json:"maxRetryAttempts,omitempty"`
+	MaxRetryBackoff   *int64 `json:"maxRetryBackoff,omitempty"`
+	DisableChecksums  bool   `json:"disableChecksums,omitempty"`
+}
+
+func (a *AwsS3DestinationOptions) ToDto() *mgmtv1alpha1.AwsS3DestinationConnectionOptions {
+	return &mgmtv1alpha1.AwsS3DestinationConnectionOptions{
+		PartSize:          a.PartSize,
+		UploadConcurrency: a.UploadConcurrency,
+		MaxRetryAttempts:  a.MaxRetryAttempts,
+		MaxRetryBackoff:   a.MaxRetryBackoff,
+		DisableChecksums:  a.DisableChecksums,
+	}
+}
+
+func (a *AwsS3DestinationOptions) FromDto(dto *mgmtv1alpha1.AwsS3DestinationConnectionOptions) {
+	if dto == nil {
+		return
+	}
+	a.PartSize = dto.PartSize
+	a.UploadConcurrency = dto.UploadConcurrency
+	a.MaxRetryAttempts = dto.MaxRetryAttempts
+	a.MaxRetryBackoff = dto.MaxRetryBackoff
+	a.DisableChecksums = dto.GetDisableChecksums()
+}
+
+type CustomDestinationOptions struct{}
+
+type KafkaDestinationOptions struct {
+	Compression *string `json:"compression,omitempty"`
 }
-type AwsS3DestinationOptions struct{}
+
+func (k *KafkaDestinationOptions) ToDto() *mgmtv1alpha1.KafkaDestinationConnectionOptions {
+	return &mgmtv1alpha1.KafkaDestinationConnectionOptions{
+		Compression: k.Compression,
+	}
+}
+
+func (k *KafkaDestinationOptions) FromDto(dto *mgmtv1alpha1.KafkaDestinationConnectionOptions) {
+	if dto == nil {
+		return
+	}
+	k.Compression = dto.Compression
+}
+
 type PostgresDestinationOptions struct {
 	TruncateTableConfig *PostgresTruncateTableConfig `json:"truncateTableconfig,omitempty"`
 	InitTableSchema     bool                         `json:"initTableSchema"`
 	OnConflictConfig    *PostgresOnConflictConfig    `json:"onConflictConfig,omitempty"`
+	DisableTriggers     bool                         `json:"disableTriggers,omitempty"`
+
+	RefreshMaterializedViewsConfig *PostgresRefreshMaterializedViewsConfig `json:"refreshMaterializedViewsConfig,omitempty"`
+	AnalyzeTablesConfig            *PostgresAnalyzeTablesConfig            `json:"analyzeTablesConfig,omitempty"`
+}
+
+type PostgresAnalyzeTablesConfig struct {
+	Enabled bool `json:"enabled"`
+	Vacuum  bool `json:"vacuum,omitempty"`
+}
+
+func (t *PostgresAnalyzeTablesConfig) ToDto() *mgmtv1alpha1.PostgresAnalyzeTablesConfig {
+	return &mgmtv1alpha1.PostgresAnalyzeTablesConfig{
+		Enabled: t.Enabled,
+		Vacuum:  t.Vacuum,
+	}
+}
+
+func (t *PostgresAnalyzeTablesConfig) FromDto(dto *mgmtv1alpha1.PostgresAnalyzeTablesConfig) {
+	t.Enabled = dto.Enabled
+	t.Vacuum = dto.Vacuum
+}
+
+type PostgresRefreshMaterializedViewsConfig struct {
+	Enabled bool     `json:"enabled"`
+	Views   []string `json:"views,omitempty"`
+}
+
+func (t *PostgresRefreshMaterializedViewsConfig) ToDto() *mgmtv1alpha1.PostgresRefreshMaterializedViewsConfig {
+	return &mgmtv1alpha1.PostgresRefreshMaterializedViewsConfig{
+		Enabled: t.Enabled,
+		Views:   t.Views,
+	}
+}
+
+func (t *PostgresRefreshMaterializedViewsConfig) FromDto(dto *mgmtv1alpha1.PostgresRefreshMaterializedViewsConfig) {
+	t.Enabled = dto.Enabled
+	t.Views = dto.Views
 }
 
 type PostgresOnConflictConfig struct {
@@ -865,6 +2005,39 @@ type MysqlDestinationOptions struct {
 	TruncateTableConfig *MysqlTruncateTableConfig `json:"truncateTableConfig,omitempty"`
 	InitTableSchema     bool                      `json:"initTableSchema"`
 	OnConflictConfig    *MysqlOnConflictConfig    `json:"onConflict,omitempty"`
+	CharsetOverride     *MysqlCharsetOverride     `json:"charsetOverride,omitempty"`
+	AnalyzeTablesConfig *MysqlAnalyzeTablesConfig `json:"analyzeTablesConfig,omitempty"`
+}
+
+type MysqlAnalyzeTablesConfig struct {
+	Enabled bool `json:"enabled"`
+}
+
+func (t *MysqlAnalyzeTablesConfig) ToDto() *mgmtv1alpha1.MysqlAnalyzeTablesConfig {
+	return &mgmtv1alpha1.MysqlAnalyzeTablesConfig{
+		Enabled: t.Enabled,
+	}
+}
+
+func (t *MysqlAnalyzeTablesConfig) FromDto(dto *mgmtv1alpha1.MysqlAnalyzeTablesConfig) {
+	t.Enabled = dto.Enabled
+}
+
+type MysqlCharsetOverride struct {
+	Charset   string  `json:"charset"`
+	Collation *string `json:"collation,omitempty"`
+}
+
+func (m *MysqlCharsetOverride) ToDto() *mgmtv1alpha1.MysqlCharsetOverride {
+	return &mgmtv1alpha1.MysqlCharsetOverride{
+		Charset:   m.Charset,
+		Collation: m.Collation,
+	}
+}
+
+func (m *MysqlCharsetOverride) FromDto(dto *mgmtv1alpha1.MysqlCharsetOverride) {
+	m.Charset = dto.Charset
+	m.Collation = dto.Collation
 }
 
 type MysqlOnConflictConfig struct {
@@ -903,12 +2076,21 @@ func (j *JobDestinationOptions) ToDto() *mgmtv1alpha1.JobDestinationOptions {
 		if j.PostgresOptions.OnConflictConfig == nil {
 			j.PostgresOptions.OnConflictConfig = &PostgresOnConflictConfig{}
 		}
+		if j.PostgresOptions.RefreshMaterializedViewsConfig == nil {
+			j.PostgresOptions.RefreshMaterializedViewsConfig = &PostgresRefreshMaterializedViewsConfig{}
+		}
+		if j.PostgresOptions.AnalyzeTablesConfig == nil {
+			j.PostgresOptions.AnalyzeTablesConfig = &PostgresAnalyzeTablesConfig{}
+		}
 		return &mgmtv1alpha1.JobDestinationOptions{
 			Config: &mgmtv1alpha1.JobDestinationOptions_PostgresOptions{
 				PostgresOptions: &mgmtv1alpha1.PostgresDestinationConnectionOptions{
-					TruncateTable:   j.PostgresOptions.TruncateTableConfig.ToDto(),
-					InitTableSchema: j.PostgresOptions.InitTableSchema,
-					OnConflict:      j.PostgresOptions.OnConflictConfig.ToDto(),
+					TruncateTable:            j.PostgresOptions.TruncateTableConfig.ToDto(),
+					InitTableSchema:          j.PostgresOptions.InitTableSchema,
+					OnConflict:               j.PostgresOptions.OnConflictConfig.ToDto(),
+					RefreshMaterializedViews: j.PostgresOptions.RefreshMaterializedViewsConfig.ToDto(),
+					DisableTriggers:          j.PostgresOptions.DisableTriggers,
+					AnalyzeTables:            j.PostgresOptions.AnalyzeTablesConfig.ToDto(),
 				},
 			},
 		}
@@ -920,20 +2102,42 @@ func (j *JobDestinationOptions) ToDto() *mgmtv1alpha1.JobDestinationOptions {
 		if j.MysqlOptions.OnConflictConfig == nil {
 			j.MysqlOptions.OnConflictConfig = &MysqlOnConflictConfig{}
 		}
+		if j.MysqlOptions.AnalyzeTablesConfig == nil {
+			j.MysqlOptions.AnalyzeTablesConfig = &MysqlAnalyzeTablesConfig{}
+		}
+		mysqlOptions := &mgmtv1alpha1.MysqlDestinationConnectionOptions{
+			TruncateTable:   j.MysqlOptions.TruncateTableConfig.ToDto(),
+			InitTableSchema: j.MysqlOptions.InitTableSchema,
+			OnConflict:      j.MysqlOptions.OnConflictConfig.ToDto(),
+			AnalyzeTables:   j.MysqlOptions.AnalyzeTablesConfig.ToDto(),
+		}
+		if j.MysqlOptions.CharsetOverride != nil {
+			mysqlOptions.CharsetOverride = j.MysqlOptions.CharsetOverride.ToDto()
+		}
 		return &mgmtv1alpha1.JobDestinationOptions{
 			Config: &mgmtv1alpha1.JobDestinationOptions_MysqlOptions{
-				MysqlOptions: &mgmtv1alpha1.MysqlDestinationConnectionOptions{
-					TruncateTable:   j.MysqlOptions.TruncateTableConfig.ToDto(),
-					InitTableSchema: j.MysqlOptions.InitTableSchema,
-					OnConflict:      j.MysqlOptions.OnConflictConfig.ToDto(),
-				},
+				MysqlOptions: mysqlOptions,
 			},
 		}
 	}
 	if j.AwsS3Options != nil {
 		return &mgmtv1alpha1.JobDestinationOptions{
 			Config: &mgmtv1alpha1.JobDestinationOptions_AwsS3Options{
-				AwsS3Options: &mgmtv1alpha1.AwsS3DestinationConnectionOptions{},
+				AwsS3Options: j.AwsS3Options.ToDto(),
+			},
+		}
+	}
+	if j.CustomOptions != nil {
+		return &mgmtv1alpha1.JobDestinationOptions{
+			Config: &mgmtv1alpha1.JobDestinationOptions_CustomOptions{
+				CustomOptions: &mgmtv1alpha1.CustomDestinationConnectionOptions{},
+			},
+		}
+	}
+	if j.KafkaOptions != nil {
+		return &mgmtv1alpha1.JobDestinationOptions{
+			Config: &mgmtv1alpha1.JobDestinationOptions_KafkaOptions{
+				KafkaOptions: j.KafkaOptions.ToDto(),
 			},
 		}
 	}
@@ -949,12 +2153,23 @@ func (j *JobDestinationOptions) FromDto(dto *mgmtv1alpha1.JobDestinationOptions)
 		j.PostgresOptions = &PostgresDestinationOptions{
 			InitTableSchema:     config.PostgresOptions.InitTableSchema,
 			TruncateTableConfig: truncateCfg,
+			DisableTriggers:     config.PostgresOptions.DisableTriggers,
 		}
 		if config.PostgresOptions.OnConflict != nil {
 			onConflictCfg := &PostgresOnConflictConfig{}
 			onConflictCfg.FromDto(config.PostgresOptions.OnConflict)
 			j.PostgresOptions.OnConflictConfig = onConflictCfg
 		}
+		if config.PostgresOptions.RefreshMaterializedViews != nil {
+			refreshCfg := &PostgresRefreshMaterializedViewsConfig{}
+			refreshCfg.FromDto(config.PostgresOptions.RefreshMaterializedViews)
+			j.PostgresOptions.RefreshMaterializedViewsConfig = refreshCfg
+		}
+		if config.PostgresOptions.AnalyzeTables != nil {
+			analyzeCfg := &PostgresAnalyzeTablesConfig{}
+			analyzeCfg.FromDto(config.PostgresOptions.AnalyzeTables)
+			j.PostgresOptions.AnalyzeTablesConfig = analyzeCfg
+		}
 	case *mgmtv1alpha1.JobDestinationOptions_MysqlOptions:
 		truncateCfg := &MysqlTruncateTableConfig{}
 		truncateCfg.FromDto(config.MysqlOptions.TruncateTable)
@@ -967,8 +2182,24 @@ func (j *JobDestinationOptions) FromDto(dto *mgmtv1alpha1.JobDestinationOptions)
 			onConflictCfg.FromDto(config.MysqlOptions.OnConflict)
 			j.MysqlOptions.OnConflictConfig = onConflictCfg
 		}
+		if config.MysqlOptions.CharsetOverride != nil {
+			charsetOverride := &MysqlCharsetOverride{}
+			charsetOverride.FromDto(config.MysqlOptions.CharsetOverride)
+			j.MysqlOptions.CharsetOverride = charsetOverride
+		}
+		if config.MysqlOptions.AnalyzeTables != nil {
+			analyzeCfg := &MysqlAnalyzeTablesConfig{}
+			analyzeCfg.FromDto(config.MysqlOptions.AnalyzeTables)
+			j.MysqlOptions.AnalyzeTablesConfig = analyzeCfg
+		}
 	case *mgmtv1alpha1.JobDestinationOptions_AwsS3Options:
 		j.AwsS3Options = &AwsS3DestinationOptions{}
+		j.AwsS3Options.FromDto(config.AwsS3Options)
+	case *mgmtv1alpha1.JobDestinationOptions_CustomOptions:
+		j.CustomOptions = &CustomDestinationOptions{}
+	case *mgmtv1alpha1.JobDestinationOptions_KafkaOptions:
+		j.KafkaOptions = &KafkaDestinationOptions{}
+		j.KafkaOptions.FromDto(config.KafkaOptions)
 	default:
 		return fmt.Errorf("invalid job destination options config")
 	}
@@ -1072,3 +2303,47 @@ func (t *AccountOnboardingConfig) FromDto(dto *mgmtv1alpha1.AccountOnboardingCon
 	t.HasCreatedJob = dto.GetHasCreatedJob()
 	t.HasInvitedMembers = dto.GetHasInvitedMembers()
 }
+
+type AccountJobDefaults struct {
+	SyncOptions        *ActivityOptions         `json:"syncOptions,omitempty"`
+	WorkflowOptions    *WorkflowOptions         `json:"workflowOptions,omitempty"`
+	DestinationOptions []*JobDestinationOptions `json:"destinationOptions,omitempty"`
+}
+
+func (a *AccountJobDefaults) ToDto() *mgmtv1alpha1.AccountJobDefaults {
+	dto := &mgmtv1alpha1.AccountJobDefaults{
+		DestinationOptions: []*mgmtv1alpha1.JobDestinationOptions{},
+	}
+	if a.SyncOptions != nil {
+		dto.SyncOptions = a.SyncOptions.ToDto()
+	}
+	if a.WorkflowOptions != nil {
+		dto.WorkflowOptions = a.WorkflowOptions.ToDto()
+	}
+	for _, opts := range a.DestinationOptions {
+		dto.DestinationOptions = append(dto.DestinationOptions, opts.ToDto())
+	}
+	return dto
+}
+
+func (a *AccountJobDefaults) FromDto(dto *mgmtv1alpha1.AccountJobDefaults) error {
+	a.SyncOptions = nil
+	if dto.GetSyncOptions() != nil {
+		a.SyncOptions = &ActivityOptions{}
+		a.SyncOptions.FromDto(dto.GetSyncOptions())
+	}
+	a.WorkflowOptions = nil
+	if dto.GetWorkflowOptions() != nil {
+		a.WorkflowOptions = &WorkflowOptions{}
+		a.WorkflowOptions.FromDto(dto.GetWorkflowOptions())
+	}
+	a.DestinationOptions = []*JobDestinationOptions{}
+	for _, optsDto := range dto.GetDestinationOptions() {
+		opts := &JobDestinationOptions{}
+		if err := opts.FromDto(optsDto); err != nil {
+			return err
+		}
+		a.DestinationOptions = append(a.DestinationOptions, opts)
+	}
+	return nil
+}
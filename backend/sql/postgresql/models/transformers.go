@@ -10,48 +10,69 @@ type JobMappingTransformerModel struct {
 }
 
 type TransformerConfigs struct {
-	GenerateEmail              *GenerateEmailConfig             `json:"generateEmailConfig,omitempty"`
-	TransformEmail             *TransformEmailConfig            `json:"transformEmail,omitempty"`
-	GenerateBool               *GenerateBoolConfig              `json:"generateBool,omitempty"`
-	GenerateCardNumber         *GenerateCardNumberConfig        `json:"generateCardNumber,omitempty"`
-	GenerateCity               *GenerateCityConfig              `json:"generateCity,omitempty"`
-	GenerateDefault            *GenerateDefaultConfig           `json:"generateDefault,omitempty"`
-	GenerateE164PhoneNumber    *GenerateE164PhoneNumberConfig   `json:"generateE164PhoneNumber,omitempty"`
-	GenerateFirstName          *GenerateFirstNameConfig         `json:"generateFirstName,omitempty"`
-	GenerateFloat64            *GenerateFloat64Config           `json:"generateFloat64,omitempty"`
-	GenerateFullAddress        *GenerateFullAddressConfig       `json:"generateFullAddress,omitempty"`
-	GenerateFullName           *GenerateFullNameConfig          `json:"generateFullName,omitempty"`
-	GenerateGender             *GenerateGenderConfig            `json:"generateGender,omitempty"`
-	GenerateInt64PhoneNumber   *GenerateInt64PhoneNumberConfig  `json:"generateInt64PhoneNumber,omitempty"`
-	GenerateInt64              *GenerateInt64Config             `json:"GenerateInt64,omitempty"`
-	GenerateLastName           *GenerateLastNameConfig          `json:"generateLastName,omitempty"`
-	GenerateSha256Hash         *GenerateSha256HashConfig        `json:"generateSha256Hash,omitempty"`
-	GenerateSsn                *GenerateSsnConfig               `json:"generateSsnConfig,omitempty"`
-	GenerateState              *GenerateStateConfig             `json:"generateStateConfig,omitempty"`
-	GenerateStreetAddress      *GenerateStreetAddressConfig     `json:"generateStreetAddressConfig,omitempty"`
-	GenerateStringPhoneNumber  *GenerateStringPhoneNumberConfig `json:"generateStringPhoneNumber,omitempty"`
-	GenerateString             *GenerateStringConfig            `json:"generateString,omitempty"`
-	GenerateUnixTimestamp      *GenerateUnixTimestampConfig     `json:"generateUnixTimestamp,omitempty"`
-	GenerateUsername           *GenerateUsernameConfig          `json:"generateUsername,omitempty"`
-	GenerateUtcTimestamp       *GenerateUtcTimestampConfig      `json:"generateUtcTimestamp,omitempty"`
-	GenerateUuid               *GenerateUuidConfig              `json:"generateUuid,omitempty"`
-	GenerateZipcode            *GenerateZipcodeConfig           `json:"generateZipcode,omitempty"`
-	TransformE164PhoneNumber   *TransformE164PhoneNumberConfig  `json:"transformE164PhoneNumber,omitempty"`
-	TransformFirstname         *TransformFirstNameConfig        `json:"transformFirstName,omitempty"`
-	TransformFloat64           *TransformFloat64Config          `json:"transformFloat64,omitempty"`
-	TransformFullName          *TransformFullNameConfig         `json:"transformFullName,,omitempty"`
-	TransformInt64PhoneNumber  *TransformInt64PhoneNumberConfig `json:"transformInt64PhoneNumber,omitempty"`
-	TransformInt64             *TransformInt64Config            `json:"transformInt64,omitempty"`
-	TransformLastName          *TransformLastNameConfig         `json:"transformLastName,omitempty"`
-	TransformPhoneNumber       *TransformPhoneNumberConfig      `json:"transformPhoneNumber,omitempty"`
-	TransformString            *TransformStringConfig           `json:"transformString,omitempty"`
-	Passthrough                *PassthroughConfig               `json:"passthrough,omitempty"`
-	Null                       *NullConfig                      `json:"null,omitempty"`
-	UserDefinedTransformer     *UserDefinedTransformerConfig    `json:"userDefinedTransformer,omitempty"`
-	TransformJavascript        *TransformJavascriptConfig       `json:"transformJavascript,omitempty"`
-	GenerateCategorical        *GenerateCategoricalConfig       `json:"generateCategorical,omitempty"`
-	TransformCharacterScramble *TransformCharacterScramble      `json:"transformCharacterScramble,omitempty"`
-	GenerateJavascript         *GenerateJavascript              `json:"generateJavascript,omitempty"`
+	GenerateEmail                *GenerateEmailConfig                `json:"generateEmailConfig,omitempty"`
+	TransformEmail               *TransformEmailConfig               `json:"transformEmail,omitempty"`
+	GenerateBool                 *GenerateBoolConfig                 `json:"generateBool,omitempty"`
+	GenerateCardNumber           *GenerateCardNumberConfig           `json:"generateCardNumber,omitempty"`
+	GenerateCity                 *GenerateCityConfig                 `json:"generateCity,omitempty"`
+	GenerateDefault              *GenerateDefaultConfig              `json:"generateDefault,omitempty"`
+	GenerateE164PhoneNumber      *GenerateE164PhoneNumberConfig      `json:"generateE164PhoneNumber,omitempty"`
+	GenerateFirstName            *GenerateFirstNameConfig            `json:"generateFirstName,omitempty"`
+	GenerateFloat64              *GenerateFloat64Config              `json:"generateFloat64,omitempty"`
+	GenerateFullAddress          *GenerateFullAddressConfig          `json:"generateFullAddress,omitempty"`
+	GenerateFullName             *GenerateFullNameConfig             `json:"generateFullName,omitempty"`
+	GenerateGender               *GenerateGenderConfig               `json:"generateGender,omitempty"`
+	GenerateInt64PhoneNumber     *GenerateInt64PhoneNumberConfig     `json:"generateInt64PhoneNumber,omitempty"`
+	GenerateInt64                *GenerateInt64Config                `json:"GenerateInt64,omitempty"`
+	GenerateLastName             *GenerateLastNameConfig             `json:"generateLastName,omitempty"`
+	GenerateSha256Hash           *GenerateSha256HashConfig           `json:"generateSha256Hash,omitempty"`
+	GenerateSsn                  *GenerateSsnConfig                  `json:"generateSsnConfig,omitempty"`
+	GenerateState                *GenerateStateConfig                `json:"generateStateConfig,omitempty"`
+	GenerateStreetAddress        *GenerateStreetAddressConfig        `json:"generateStreetAddressConfig,omitempty"`
+	GenerateStringPhoneNumber    *GenerateStringPhoneNumberConfig    `json:"generateStringPhoneNumber,omitempty"`
+	GenerateString               *GenerateStringConfig               `json:"generateString,omitempty"`
+	GenerateUnixTimestamp        *GenerateUnixTimestampConfig        `json:"generateUnixTimestamp,omitempty"`
+	GenerateUsername             *GenerateUsernameConfig             `json:"generateUsername,omitempty"`
+	GenerateUtcTimestamp         *GenerateUtcTimestampConfig         `json:"generateUtcTimestamp,omitempty"`
+	GenerateUuid                 *GenerateUuidConfig                 `json:"generateUuid,omitempty"`
+	GenerateZipcode              *GenerateZipcodeConfig              `json:"generateZipcode,omitempty"`
+	TransformE164PhoneNumber     *TransformE164PhoneNumberConfig     `json:"transformE164PhoneNumber,omitempty"`
+	TransformFirstname           *TransformFirstNameConfig           `json:"transformFirstName,omitempty"`
+	TransformFloat64             *TransformFloat64Config             `json:"transformFloat64,omitempty"`
+	TransformFullName            *TransformFullNameConfig            `json:"transformFullName,,omitempty"`
+	TransformInt64PhoneNumber    *TransformInt64PhoneNumberConfig    `json:"transformInt64PhoneNumber,omitempty"`
+	TransformInt64               *TransformInt64Config               `json:"transformInt64,omitempty"`
+	TransformLastName            *TransformLastNameConfig            `json:"transformLastName,omitempty"`
+	TransformPhoneNumber         *TransformPhoneNumberConfig         `json:"transformPhoneNumber,omitempty"`
+	TransformString              *TransformStringConfig              `json:"transformString,omitempty"`
+	Passthrough                  *PassthroughConfig                  `json:"passthrough,omitempty"`
+	Null                         *NullConfig                         `json:"null,omitempty"`
+	UserDefinedTransformer       *UserDefinedTransformerConfig       `json:"userDefinedTransformer,omitempty"`
+	TransformJavascript          *TransformJavascriptConfig          `json:"transformJavascript,omitempty"`
+	GenerateCategorical          *GenerateCategoricalConfig          `json:"generateCategorical,omitempty"`
+	TransformCharacterScramble   *TransformCharacterScramble         `json:"transformCharacterScramble,omitempty"`
+	GenerateJavascript           *GenerateJavascript                 `json:"generateJavascript,omitempty"`
+	DropColumn                   *DropColumnConfig                   `json:"dropColumn,omitempty"`
+	GenerateStaticValue          *GenerateStaticValueConfig          `json:"generateStaticValue,omitempty"`
+	SqlExpression                *SqlExpressionConfig                `json:"sqlExpression,omitempty"`
+	TransformGeoCoordinate       *TransformGeoCoordinateConfig       `json:"transformGeoCoordinate,omitempty"`
+	TransformUrl                 *TransformUrlConfig                 `json:"transformUrl,omitempty"`
+	GenerateUserAgent            *GenerateUserAgentConfig            `json:"generateUserAgent,omitempty"`
+	GenerateBusinessTimestamp    *GenerateBusinessTimestampConfig    `json:"generateBusinessTimestamp,omitempty"`
+	TransformMoney               *TransformMoneyConfig               `json:"transformMoney,omitempty"`
+	GenerateMoney                *GenerateMoneyConfig                `json:"generateMoney,omitempty"`
+	GenerateMedicalRecordNumber  *GenerateMedicalRecordNumberConfig  `json:"generateMedicalRecordNumber,omitempty"`
+	TransformMedicalRecordNumber *TransformMedicalRecordNumberConfig `json:"transformMedicalRecordNumber,omitempty"`
+	GenerateNpi                  *GenerateNpiConfig                  `json:"generateNpi,omitempty"`
+	TransformNpi                 *TransformNpiConfig                 `json:"transformNpi,omitempty"`
+	GenerateIcd10Code            *GenerateIcd10CodeConfig            `json:"generateIcd10Code,omitempty"`
+	GenerateCptCode              *GenerateCptCodeConfig              `json:"generateCptCode,omitempty"`
+	GenerateVin                  *GenerateVinConfig                  `json:"generateVin,omitempty"`
+	TransformVin                 *TransformVinConfig                 `json:"transformVin,omitempty"`
+	GenerateImei                 *GenerateImeiConfig                 `json:"generateImei,omitempty"`
+	TransformImei                *TransformImeiConfig                `json:"transformImei,omitempty"`
+	GenerateEan                  *GenerateEanConfig                  `json:"generateEan,omitempty"`
+	TransformEan                 *TransformEanConfig                 `json:"transformEan,omitempty"`
 }
 
 type GenerateEmailConfig struct {
@@ -64,6 +85,8 @@ type TransformEmailConfig struct {
 	ExcludedDomains    []string `json:"excludedDomains"`
 	EmailType          *int32   `json:"emailType,omitempty"`
 	InvalidEmailAction *int32   `json:"invalidEmailAction,omitempty"`
+	ReplacementDomain  *string  `json:"replacementDomain,omitempty"`
+	PreservePlusTag    bool     `json:"preservePlusTag,omitempty"`
 }
 
 type GenerateBoolConfig struct{}
@@ -178,12 +201,16 @@ type PassthroughConfig struct{}
 
 type NullConfig struct{}
 
+type DropColumnConfig struct{}
+
 type UserDefinedTransformerConfig struct {
 	Id string `json:"id"`
 }
 
 type TransformJavascriptConfig struct {
-	Code string `json:"code"`
+	Code          string `json:"code"`
+	BatchSize     uint32 `json:"batchSize,omitempty"`
+	Deterministic bool   `json:"deterministic,omitempty"`
 }
 
 type GenerateCategoricalConfig struct {
@@ -198,6 +225,95 @@ type GenerateJavascript struct {
 	Code string `json:"code"`
 }
 
+type GenerateStaticValueConfig struct {
+	Value string `json:"value"`
+}
+
+type SqlExpressionConfig struct {
+	Expression string `json:"expression"`
+}
+
+type TransformGeoCoordinateConfig struct {
+	RadiusMeters       float64 `json:"radiusMeters"`
+	SnapToCityCentroid bool    `json:"snapToCityCentroid"`
+}
+
+type TransformUrlConfig struct {
+	QueryParams              []string `json:"queryParams"`
+	RandomizeQueryParams     bool     `json:"randomizeQueryParams"`
+	StripUserInfo            bool     `json:"stripUserInfo"`
+	RandomizePathIdentifiers bool     `json:"randomizePathIdentifiers"`
+}
+
+type GenerateUserAgentConfig struct {
+	DesktopOnly bool `json:"desktopOnly"`
+}
+
+type GenerateBusinessTimestampConfig struct {
+	RangeStartDaysAgo   int64   `json:"rangeStartDaysAgo"`
+	RangeEndDaysAgo     int64   `json:"rangeEndDaysAgo"`
+	BusinessHoursWeight float64 `json:"businessHoursWeight"`
+	BusinessHoursStart  int32   `json:"businessHoursStart"`
+	BusinessHoursEnd    int32   `json:"businessHoursEnd"`
+	WeekdayWeight       float64 `json:"weekdayWeight"`
+	Timezone            string  `json:"timezone"`
+	AfterColumn         *string `json:"afterColumn,omitempty"`
+	MaxSecondsAfter     int64   `json:"maxSecondsAfter"`
+}
+
+type TransformMoneyConfig struct {
+	DecimalPlaces *int32 `json:"decimalPlaces,omitempty"`
+}
+
+type GenerateMoneyConfig struct {
+	Median        float64  `json:"median"`
+	Sigma         float64  `json:"sigma"`
+	Min           *float64 `json:"min,omitempty"`
+	Max           *float64 `json:"max,omitempty"`
+	DecimalPlaces *int32   `json:"decimalPlaces,omitempty"`
+}
+
+type GenerateMedicalRecordNumberConfig struct {
+	Prefix *string `json:"prefix,omitempty"`
+	Length *int64  `json:"length,omitempty"`
+}
+
+type TransformMedicalRecordNumberConfig struct {
+	PreserveLetters bool `json:"preserveLetters"`
+}
+
+type GenerateNpiConfig struct{}
+
+type TransformNpiConfig struct {
+	PreserveEntityType bool `json:"preserveEntityType"`
+}
+
+type GenerateIcd10CodeConfig struct {
+	ExcludeDecimalPoint bool `json:"excludeDecimalPoint"`
+}
+
+type GenerateCptCodeConfig struct{}
+
+type GenerateVinConfig struct{}
+
+type TransformVinConfig struct {
+	PreserveManufacturer bool `json:"preserveManufacturer"`
+}
+
+type GenerateImeiConfig struct{}
+
+type TransformImeiConfig struct {
+	PreserveTypeAllocationCode bool `json:"preserveTypeAllocationCode"`
+}
+
+type GenerateEanConfig struct {
+	Upc bool `json:"upc"`
+}
+
+type TransformEanConfig struct {
+	PreserveManufacturerPrefix bool `json:"preserveManufacturerPrefix"`
+}
+
 // from API -> DB
 func (t *JobMappingTransformerModel) FromTransformerDto(tr *mgmtv1alpha1.JobMappingTransformer) error {
 	t.Source = int32(tr.Source)
@@ -226,6 +342,8 @@ func (t *TransformerConfigs) FromTransformerConfigDto(tr *mgmtv1alpha1.Transform
 			ExcludedDomains:    tr.GetTransformEmailConfig().ExcludedDomains,
 			EmailType:          (*int32)(tr.GetTransformEmailConfig().EmailType),
 			InvalidEmailAction: (*int32)(tr.GetTransformEmailConfig().InvalidEmailAction),
+			ReplacementDomain:  tr.GetTransformEmailConfig().ReplacementDomain,
+			PreservePlusTag:    tr.GetTransformEmailConfig().GetPreservePlusTag(),
 		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateBoolConfig:
 		t.GenerateBool = &GenerateBoolConfig{}
@@ -341,13 +459,17 @@ func (t *TransformerConfigs) FromTransformerConfigDto(tr *mgmtv1alpha1.Transform
 		t.Passthrough = &PassthroughConfig{}
 	case *mgmtv1alpha1.TransformerConfig_Nullconfig:
 		t.Null = &NullConfig{}
+	case *mgmtv1alpha1.TransformerConfig_DropColumnConfig:
+		t.DropColumn = &DropColumnConfig{}
 	case *mgmtv1alpha1.TransformerConfig_UserDefinedTransformerConfig:
 		t.UserDefinedTransformer = &UserDefinedTransformerConfig{
 			Id: tr.GetUserDefinedTransformerConfig().Id,
 		}
 	case *mgmtv1alpha1.TransformerConfig_TransformJavascriptConfig:
 		t.TransformJavascript = &TransformJavascriptConfig{
-			Code: tr.GetTransformJavascriptConfig().Code,
+			Code:          tr.GetTransformJavascriptConfig().Code,
+			BatchSize:     tr.GetTransformJavascriptConfig().GetBatchSize(),
+			Deterministic: tr.GetTransformJavascriptConfig().GetDeterministic(),
 		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateCategoricalConfig:
 		t.GenerateCategorical = &GenerateCategoricalConfig{
@@ -361,6 +483,95 @@ func (t *TransformerConfigs) FromTransformerConfigDto(tr *mgmtv1alpha1.Transform
 		t.GenerateJavascript = &GenerateJavascript{
 			Code: tr.GetGenerateJavascriptConfig().Code,
 		}
+	case *mgmtv1alpha1.TransformerConfig_GenerateStaticValueConfig:
+		t.GenerateStaticValue = &GenerateStaticValueConfig{
+			Value: tr.GetGenerateStaticValueConfig().Value,
+		}
+	case *mgmtv1alpha1.TransformerConfig_SqlExpressionConfig:
+		t.SqlExpression = &SqlExpressionConfig{
+			Expression: tr.GetSqlExpressionConfig().Expression,
+		}
+	case *mgmtv1alpha1.TransformerConfig_TransformGeoCoordinateConfig:
+		t.TransformGeoCoordinate = &TransformGeoCoordinateConfig{
+			RadiusMeters:       tr.GetTransformGeoCoordinateConfig().RadiusMeters,
+			SnapToCityCentroid: tr.GetTransformGeoCoordinateConfig().SnapToCityCentroid,
+		}
+	case *mgmtv1alpha1.TransformerConfig_TransformUrlConfig:
+		t.TransformUrl = &TransformUrlConfig{
+			QueryParams:              tr.GetTransformUrlConfig().QueryParams,
+			RandomizeQueryParams:     tr.GetTransformUrlConfig().RandomizeQueryParams,
+			StripUserInfo:            tr.GetTransformUrlConfig().StripUserInfo,
+			RandomizePathIdentifiers: tr.GetTransformUrlConfig().RandomizePathIdentifiers,
+		}
+	case *mgmtv1alpha1.TransformerConfig_GenerateUserAgentConfig:
+		t.GenerateUserAgent = &GenerateUserAgentConfig{
+			DesktopOnly: tr.GetGenerateUserAgentConfig().DesktopOnly,
+		}
+	case *mgmtv1alpha1.TransformerConfig_GenerateBusinessTimestampConfig:
+		t.GenerateBusinessTimestamp = &GenerateBusinessTimestampConfig{
+			RangeStartDaysAgo:   tr.GetGenerateBusinessTimestampConfig().RangeStartDaysAgo,
+			RangeEndDaysAgo:     tr.GetGenerateBusinessTimestampConfig().RangeEndDaysAgo,
+			BusinessHoursWeight: tr.GetGenerateBusinessTimestampConfig().BusinessHoursWeight,
+			BusinessHoursStart:  tr.GetGenerateBusinessTimestampConfig().BusinessHoursStart,
+			BusinessHoursEnd:    tr.GetGenerateBusinessTimestampConfig().BusinessHoursEnd,
+			WeekdayWeight:       tr.GetGenerateBusinessTimestampConfig().WeekdayWeight,
+			Timezone:            tr.GetGenerateBusinessTimestampConfig().Timezone,
+			AfterColumn:         tr.GetGenerateBusinessTimestampConfig().AfterColumn,
+			MaxSecondsAfter:     tr.GetGenerateBusinessTimestampConfig().MaxSecondsAfter,
+		}
+	case *mgmtv1alpha1.TransformerConfig_TransformMoneyConfig:
+		t.TransformMoney = &TransformMoneyConfig{
+			DecimalPlaces: tr.GetTransformMoneyConfig().DecimalPlaces,
+		}
+	case *mgmtv1alpha1.TransformerConfig_GenerateMoneyConfig:
+		t.GenerateMoney = &GenerateMoneyConfig{
+			Median:        tr.GetGenerateMoneyConfig().Median,
+			Sigma:         tr.GetGenerateMoneyConfig().Sigma,
+			Min:           tr.GetGenerateMoneyConfig().Min,
+			Max:           tr.GetGenerateMoneyConfig().Max,
+			DecimalPlaces: tr.GetGenerateMoneyConfig().DecimalPlaces,
+		}
+	case *mgmtv1alpha1.TransformerConfig_GenerateMedicalRecordNumberConfig:
+		t.GenerateMedicalRecordNumber = &GenerateMedicalRecordNumberConfig{
+			Prefix: tr.GetGenerateMedicalRecordNumberConfig().Prefix,
+			Length: tr.GetGenerateMedicalRecordNumberConfig().Length,
+		}
+	case *mgmtv1alpha1.TransformerConfig_TransformMedicalRecordNumberConfig:
+		t.TransformMedicalRecordNumber = &TransformMedicalRecordNumberConfig{
+			PreserveLetters: tr.GetTransformMedicalRecordNumberConfig().PreserveLetters,
+		}
+	case *mgmtv1alpha1.TransformerConfig_GenerateNpiConfig:
+		t.GenerateNpi = &GenerateNpiConfig{}
+	case *mgmtv1alpha1.TransformerConfig_TransformNpiConfig:
+		t.TransformNpi = &TransformNpiConfig{
+			PreserveEntityType: tr.GetTransformNpiConfig().PreserveEntityType,
+		}
+	case *mgmtv1alpha1.TransformerConfig_GenerateIcd10CodeConfig:
+		t.GenerateIcd10Code = &GenerateIcd10CodeConfig{
+			ExcludeDecimalPoint: tr.GetGenerateIcd10CodeConfig().ExcludeDecimalPoint,
+		}
+	case *mgmtv1alpha1.TransformerConfig_GenerateCptCodeConfig:
+		t.GenerateCptCode = &GenerateCptCodeConfig{}
+	case *mgmtv1alpha1.TransformerConfig_GenerateVinConfig:
+		t.GenerateVin = &GenerateVinConfig{}
+	case *mgmtv1alpha1.TransformerConfig_TransformVinConfig:
+		t.TransformVin = &TransformVinConfig{
+			PreserveManufacturer: tr.GetTransformVinConfig().PreserveManufacturer,
+		}
+	case *mgmtv1alpha1.TransformerConfig_GenerateImeiConfig:
+		t.GenerateImei = &GenerateImeiConfig{}
+	case *mgmtv1alpha1.TransformerConfig_TransformImeiConfig:
+		t.TransformImei = &TransformImeiConfig{
+			PreserveTypeAllocationCode: tr.GetTransformImeiConfig().PreserveTypeAllocationCode,
+		}
+	case *mgmtv1alpha1.TransformerConfig_GenerateEanConfig:
+		t.GenerateEan = &GenerateEanConfig{
+			Upc: tr.GetGenerateEanConfig().Upc,
+		}
+	case *mgmtv1alpha1.TransformerConfig_TransformEanConfig:
+		t.TransformEan = &TransformEanConfig{
+			PreserveManufacturerPrefix: tr.GetTransformEanConfig().PreserveManufacturerPrefix,
+		}
 	default:
 		t = &TransformerConfigs{}
 	}
@@ -402,6 +613,8 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 					ExcludedDomains:    t.TransformEmail.ExcludedDomains,
 					EmailType:          (*mgmtv1alpha1.GenerateEmailType)(t.TransformEmail.EmailType),
 					InvalidEmailAction: (*mgmtv1alpha1.InvalidEmailAction)(t.TransformEmail.InvalidEmailAction),
+					ReplacementDomain:  t.TransformEmail.ReplacementDomain,
+					PreservePlusTag:    t.TransformEmail.PreservePlusTag,
 				},
 			},
 		}
@@ -657,6 +870,12 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 				Nullconfig: &mgmtv1alpha1.Null{},
 			},
 		}
+	case t.DropColumn != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_DropColumnConfig{
+				DropColumnConfig: &mgmtv1alpha1.DropColumn{},
+			},
+		}
 	case t.UserDefinedTransformer != nil:
 		return &mgmtv1alpha1.TransformerConfig{
 			Config: &mgmtv1alpha1.TransformerConfig_UserDefinedTransformerConfig{
@@ -669,7 +888,9 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 		return &mgmtv1alpha1.TransformerConfig{
 			Config: &mgmtv1alpha1.TransformerConfig_TransformJavascriptConfig{
 				TransformJavascriptConfig: &mgmtv1alpha1.TransformJavascript{
-					Code: t.TransformJavascript.Code,
+					Code:          t.TransformJavascript.Code,
+					BatchSize:     t.TransformJavascript.BatchSize,
+					Deterministic: t.TransformJavascript.Deterministic,
 				},
 			},
 		}
@@ -697,6 +918,175 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 				},
 			},
 		}
+	case t.GenerateStaticValue != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_GenerateStaticValueConfig{
+				GenerateStaticValueConfig: &mgmtv1alpha1.GenerateStaticValue{
+					Value: t.GenerateStaticValue.Value,
+				},
+			},
+		}
+	case t.SqlExpression != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_SqlExpressionConfig{
+				SqlExpressionConfig: &mgmtv1alpha1.SqlExpression{
+					Expression: t.SqlExpression.Expression,
+				},
+			},
+		}
+	case t.TransformGeoCoordinate != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_TransformGeoCoordinateConfig{
+				TransformGeoCoordinateConfig: &mgmtv1alpha1.TransformGeoCoordinate{
+					RadiusMeters:       t.TransformGeoCoordinate.RadiusMeters,
+					SnapToCityCentroid: t.TransformGeoCoordinate.SnapToCityCentroid,
+				},
+			},
+		}
+	case t.TransformUrl != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_TransformUrlConfig{
+				TransformUrlConfig: &mgmtv1alpha1.TransformUrl{
+					QueryParams:              t.TransformUrl.QueryParams,
+					RandomizeQueryParams:     t.TransformUrl.RandomizeQueryParams,
+					StripUserInfo:            t.TransformUrl.StripUserInfo,
+					RandomizePathIdentifiers: t.TransformUrl.RandomizePathIdentifiers,
+				},
+			},
+		}
+	case t.GenerateUserAgent != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_GenerateUserAgentConfig{
+				GenerateUserAgentConfig: &mgmtv1alpha1.GenerateUserAgent{
+					DesktopOnly: t.GenerateUserAgent.DesktopOnly,
+				},
+			},
+		}
+	case t.GenerateBusinessTimestamp != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_GenerateBusinessTimestampConfig{
+				GenerateBusinessTimestampConfig: &mgmtv1alpha1.GenerateBusinessTimestamp{
+					RangeStartDaysAgo:   t.GenerateBusinessTimestamp.RangeStartDaysAgo,
+					RangeEndDaysAgo:     t.GenerateBusinessTimestamp.RangeEndDaysAgo,
+					BusinessHoursWeight: t.GenerateBusinessTimestamp.BusinessHoursWeight,
+					BusinessHoursStart:  t.GenerateBusinessTimestamp.BusinessHoursStart,
+					BusinessHoursEnd:    t.GenerateBusinessTimestamp.BusinessHoursEnd,
+					WeekdayWeight:       t.GenerateBusinessTimestamp.WeekdayWeight,
+					Timezone:            t.GenerateBusinessTimestamp.Timezone,
+					AfterColumn:         t.GenerateBusinessTimestamp.AfterColumn,
+					MaxSecondsAfter:     t.GenerateBusinessTimestamp.MaxSecondsAfter,
+				},
+			},
+		}
+	case t.TransformMoney != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_TransformMoneyConfig{
+				TransformMoneyConfig: &mgmtv1alpha1.TransformMoney{
+					DecimalPlaces: t.TransformMoney.DecimalPlaces,
+				},
+			},
+		}
+	case t.GenerateMoney != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_GenerateMoneyConfig{
+				GenerateMoneyConfig: &mgmtv1alpha1.GenerateMoney{
+					Median:        t.GenerateMoney.Median,
+					Sigma:         t.GenerateMoney.Sigma,
+					Min:           t.GenerateMoney.Min,
+					Max:           t.GenerateMoney.Max,
+					DecimalPlaces: t.GenerateMoney.DecimalPlaces,
+				},
+			},
+		}
+	case t.GenerateMedicalRecordNumber != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_GenerateMedicalRecordNumberConfig{
+				GenerateMedicalRecordNumberConfig: &mgmtv1alpha1.GenerateMedicalRecordNumber{
+					Prefix: t.GenerateMedicalRecordNumber.Prefix,
+					Length: t.GenerateMedicalRecordNumber.Length,
+				},
+			},
+		}
+	case t.TransformMedicalRecordNumber != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_TransformMedicalRecordNumberConfig{
+				TransformMedicalRecordNumberConfig: &mgmtv1alpha1.TransformMedicalRecordNumber{
+					PreserveLetters: t.TransformMedicalRecordNumber.PreserveLetters,
+				},
+			},
+		}
+	case t.GenerateNpi != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_GenerateNpiConfig{
+				GenerateNpiConfig: &mgmtv1alpha1.GenerateNpi{},
+			},
+		}
+	case t.TransformNpi != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_TransformNpiConfig{
+				TransformNpiConfig: &mgmtv1alpha1.TransformNpi{
+					PreserveEntityType: t.TransformNpi.PreserveEntityType,
+				},
+			},
+		}
+	case t.GenerateIcd10Code != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_GenerateIcd10CodeConfig{
+				GenerateIcd10CodeConfig: &mgmtv1alpha1.GenerateIcd10Code{
+					ExcludeDecimalPoint: t.GenerateIcd10Code.ExcludeDecimalPoint,
+				},
+			},
+		}
+	case t.GenerateCptCode != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_GenerateCptCodeConfig{
+				GenerateCptCodeConfig: &mgmtv1alpha1.GenerateCptCode{},
+			},
+		}
+	case t.GenerateVin != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_GenerateVinConfig{
+				GenerateVinConfig: &mgmtv1alpha1.GenerateVin{},
+			},
+		}
+	case t.TransformVin != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_TransformVinConfig{
+				TransformVinConfig: &mgmtv1alpha1.TransformVin{
+					PreserveManufacturer: t.TransformVin.PreserveManufacturer,
+				},
+			},
+		}
+	case t.GenerateImei != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_GenerateImeiConfig{
+				GenerateImeiConfig: &mgmtv1alpha1.GenerateImei{},
+			},
+		}
+	case t.TransformImei != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_TransformImeiConfig{
+				TransformImeiConfig: &mgmtv1alpha1.TransformImei{
+					PreserveTypeAllocationCode: t.TransformImei.PreserveTypeAllocationCode,
+				},
+			},
+		}
+	case t.GenerateEan != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_GenerateEanConfig{
+				GenerateEanConfig: &mgmtv1alpha1.GenerateEan{
+					Upc: t.GenerateEan.Upc,
+				},
+			},
+		}
+	case t.TransformEan != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_TransformEanConfig{
+				TransformEanConfig: &mgmtv1alpha1.TransformEan{
+					PreserveManufacturerPrefix: t.TransformEan.PreserveManufacturerPrefix,
+				},
+			},
+		}
 	default:
 		return &mgmtv1alpha1.TransformerConfig{}
 	}
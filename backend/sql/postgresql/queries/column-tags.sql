@@ -0,0 +1,30 @@
+-- name: GetColumnTagById :one
+SELECT * from neosync_api.connection_column_tags WHERE id = $1;
+
+-- name: GetColumnTagsByConnection :many
+SELECT * from neosync_api.connection_column_tags
+WHERE connection_id = $1
+ORDER BY schema_name, table_name, column_name;
+
+-- name: UpsertColumnTag :one
+INSERT INTO neosync_api.connection_column_tags (
+  connection_id, schema_name, table_name, column_name, classifications, source, created_by_id, updated_by_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+)
+ON CONFLICT(connection_id, schema_name, table_name, column_name)
+DO UPDATE SET
+  classifications = EXCLUDED.classifications,
+  source = EXCLUDED.source,
+  updated_by_id = EXCLUDED.updated_by_id,
+  updated_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: RemoveColumnTag :exec
+DELETE FROM neosync_api.connection_column_tags WHERE id = $1;
+
+-- name: GetColumnTagsByAccount :many
+SELECT t.* from neosync_api.connection_column_tags t
+INNER JOIN neosync_api.connections c ON c.id = t.connection_id
+WHERE c.account_id = $1
+ORDER BY t.connection_id, t.schema_name, t.table_name, t.column_name;
@@ -0,0 +1,46 @@
+-- name: GetConnectionSavedQueryById :one
+SELECT * from neosync_api.connection_saved_queries WHERE id = $1;
+
+-- name: GetConnectionSavedQueriesByConnection :many
+SELECT * from neosync_api.connection_saved_queries
+WHERE connection_id = $1
+ORDER BY name;
+
+-- name: CreateConnectionSavedQuery :one
+INSERT INTO neosync_api.connection_saved_queries (
+  connection_id, name, schema_name, table_name, where_clause, created_by_id, updated_by_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+)
+RETURNING *;
+
+-- name: UpdateConnectionSavedQuery :one
+UPDATE neosync_api.connection_saved_queries
+SET name = $1, schema_name = $2, table_name = $3, where_clause = $4,
+updated_by_id = $5, updated_at = CURRENT_TIMESTAMP
+WHERE id = $6
+RETURNING *;
+
+-- name: RemoveConnectionSavedQuery :exec
+DELETE FROM neosync_api.connection_saved_queries WHERE id = $1;
+
+-- name: GetConnectionTableBookmarkById :one
+SELECT * from neosync_api.connection_table_bookmarks WHERE id = $1;
+
+-- name: GetConnectionTableBookmarksByConnection :many
+SELECT * from neosync_api.connection_table_bookmarks
+WHERE connection_id = $1
+ORDER BY schema_name, table_name;
+
+-- name: UpsertConnectionTableBookmark :one
+INSERT INTO neosync_api.connection_table_bookmarks (
+  connection_id, schema_name, table_name, created_by_id
+) VALUES (
+  $1, $2, $3, $4
+)
+ON CONFLICT(connection_id, schema_name, table_name)
+DO UPDATE SET connection_id = EXCLUDED.connection_id
+RETURNING *;
+
+-- name: RemoveConnectionTableBookmark :exec
+DELETE FROM neosync_api.connection_table_bookmarks WHERE id = $1;
@@ -0,0 +1,30 @@
+-- name: ReserveIdempotencyKey :one
+-- Claims the key for a new request. Keys that have expired, or whose request never finished, are reclaimed.
+-- Returns no rows if the key is currently held by another request.
+INSERT INTO neosync_api.idempotency_keys (
+  account_id, operation, idempotency_key, request_hash
+) VALUES (
+  $1, $2, $3, $4
+)
+ON CONFLICT(account_id, operation, idempotency_key)
+DO UPDATE SET
+  request_hash = EXCLUDED.request_hash,
+  response = NULL,
+  created_at = now(),
+  updated_at = CURRENT_TIMESTAMP
+WHERE neosync_api.idempotency_keys.created_at < now() - interval '24 hours'
+  OR (neosync_api.idempotency_keys.response IS NULL AND neosync_api.idempotency_keys.updated_at < now() - interval '5 minutes')
+RETURNING *;
+
+-- name: GetIdempotencyKey :one
+SELECT * from neosync_api.idempotency_keys
+WHERE account_id = $1 AND operation = $2 AND idempotency_key = $3;
+
+-- name: SetIdempotencyKeyResponse :exec
+UPDATE neosync_api.idempotency_keys
+SET response = $1,
+  updated_at = CURRENT_TIMESTAMP
+WHERE id = $2;
+
+-- name: RemoveIdempotencyKey :exec
+DELETE FROM neosync_api.idempotency_keys WHERE id = $1;
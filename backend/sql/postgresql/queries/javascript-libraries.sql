@@ -0,0 +1,21 @@
+-- name: GetJavascriptLibrariesByAccount :many
+SELECT * from neosync_api.javascript_libraries
+WHERE account_id = sqlc.arg('accountId')
+ORDER BY name ASC;
+
+-- name: UpsertJavascriptLibrary :one
+INSERT INTO neosync_api.javascript_libraries (
+  account_id, name, code, created_by_id, updated_by_id
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+ON CONFLICT (account_id, name) DO UPDATE
+SET
+  code = EXCLUDED.code,
+  updated_by_id = EXCLUDED.updated_by_id,
+  updated_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: RemoveJavascriptLibrary :exec
+DELETE FROM neosync_api.javascript_libraries
+WHERE account_id = sqlc.arg('accountId') AND name = sqlc.arg('name');
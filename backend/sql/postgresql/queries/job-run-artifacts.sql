@@ -0,0 +1,15 @@
+-- name: CreateJobRunArtifact :one
+INSERT INTO neosync_api.job_run_artifacts (
+  account_id, job_run_id, artifact_type, name, content_type, size_bytes, object_key
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+)
+RETURNING *;
+
+-- name: GetJobRunArtifacts :many
+SELECT * from neosync_api.job_run_artifacts
+WHERE account_id = $1 AND job_run_id = $2
+ORDER BY created_at, name;
+
+-- name: RemoveJobRunArtifacts :exec
+DELETE FROM neosync_api.job_run_artifacts WHERE account_id = $1 AND job_run_id = $2;
@@ -0,0 +1,30 @@
+-- name: CreateJobRunRequest :one
+INSERT INTO neosync_api.job_run_requests (
+  job_id, priority, created_by_id, parameters
+) VALUES (
+  $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: ClaimJobRunRequest :one
+UPDATE neosync_api.job_run_requests
+SET job_run_id = sqlc.arg('jobRunId')
+WHERE id = (
+  SELECT r.id from neosync_api.job_run_requests r
+  WHERE r.job_id = sqlc.arg('jobId') AND r.job_run_id IS NULL AND r.created_at > sqlc.arg('createdAfter')
+  ORDER BY r.created_at
+  LIMIT 1
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: GetJobRunRequestByJobRun :one
+SELECT * from neosync_api.job_run_requests
+WHERE job_run_id = $1;
+
+-- name: GetJobRunRequestsByJobRuns :many
+SELECT * from neosync_api.job_run_requests
+WHERE job_run_id = ANY(sqlc.arg('jobRunIds')::text[]);
+
+-- name: RemoveJobRunRequest :exec
+DELETE FROM neosync_api.job_run_requests WHERE id = $1;
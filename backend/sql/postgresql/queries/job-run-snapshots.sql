@@ -0,0 +1,29 @@
+-- name: CreateJobRunSnapshot :one
+INSERT INTO neosync_api.job_run_snapshots (
+  account_id, job_id, job_run_id, connection_id, name, created_by_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+RETURNING *;
+
+-- name: GetJobRunSnapshotByName :one
+SELECT * from neosync_api.job_run_snapshots
+WHERE account_id = $1 AND name = $2;
+
+-- name: GetJobRunSnapshotsByAccount :many
+SELECT * from neosync_api.job_run_snapshots
+WHERE account_id = $1
+ORDER BY created_at DESC, name;
+
+-- name: GetJobRunSnapshotsByJob :many
+SELECT * from neosync_api.job_run_snapshots
+WHERE account_id = $1 AND job_id = $2
+ORDER BY created_at DESC, name;
+
+-- name: GetJobRunSnapshotsByJobRun :many
+SELECT * from neosync_api.job_run_snapshots
+WHERE account_id = $1 AND job_run_id = $2
+ORDER BY name;
+
+-- name: RemoveJobRunSnapshot :exec
+DELETE FROM neosync_api.job_run_snapshots WHERE id = $1;
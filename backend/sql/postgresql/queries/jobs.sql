@@ -1,6 +1,9 @@
 -- name: GetJobById :one
 SELECT * from neosync_api.jobs WHERE id = $1;
 
+-- name: GetJobByIdForUpdate :one
+SELECT * from neosync_api.jobs WHERE id = $1 FOR UPDATE;
+
 -- name: GetJobByNameAndAccount :one
 SELECT j.* from neosync_api.jobs j
 INNER JOIN neosync_api.accounts a ON a.id = j.account_id
@@ -12,15 +15,19 @@ INNER JOIN neosync_api.accounts a ON a.id = j.account_id
 WHERE a.id = sqlc.arg('accountId')
 ORDER BY j.created_at DESC;
 
+-- name: GetJobAccountIds :many
+SELECT DISTINCT j.account_id FROM neosync_api.jobs j;
+
 -- name: RemoveJobById :exec
 DELETE FROM neosync_api.jobs WHERE id = $1;
 
 -- name: CreateJob :one
 INSERT INTO neosync_api.jobs (
   name, account_id, status, connection_options, mappings,
-  cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options
+  cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options,
+  table_processors, parameters
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
 )
 RETURNING *;
 
@@ -48,6 +55,20 @@ updated_by_id = $2
 WHERE id = $3
 RETURNING *;
 
+-- name: SetJobTableProcessors :one
+UPDATE neosync_api.jobs
+SET table_processors = $1,
+updated_by_id = $2
+WHERE id = $3
+RETURNING *;
+
+-- name: SetJobParameters :one
+UPDATE neosync_api.jobs
+SET parameters = $1,
+updated_by_id = $2
+WHERE id = $3
+RETURNING *;
+
 -- name: UpdateJobMappings :one
 UPDATE neosync_api.jobs
 SET mappings = $1,
@@ -113,3 +134,30 @@ RETURNING *;
 
 -- name: RemoveJobConnectionDestination :exec
 DELETE FROM neosync_api.job_destination_connection_associations WHERE id = $1;
+
+-- name: CreateJobChangeRequest :one
+INSERT INTO neosync_api.job_change_requests (
+  job_id, connection_options, mappings, created_by_id
+) VALUES (
+  $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: GetJobChangeRequestById :one
+SELECT * from neosync_api.job_change_requests WHERE id = $1;
+
+-- name: GetJobChangeRequestsByJob :many
+SELECT * from neosync_api.job_change_requests
+WHERE job_id = $1
+ORDER BY created_at DESC;
+
+-- name: ApproveJobChangeRequest :one
+UPDATE neosync_api.job_change_requests
+SET approved_by_id = $1,
+approved_at = CURRENT_TIMESTAMP
+WHERE id = $2 AND approved_by_id IS NULL
+RETURNING *;
+
+-- name: RemovePendingJobChangeRequests :exec
+DELETE FROM neosync_api.job_change_requests
+WHERE job_id = $1 AND approved_by_id IS NULL;
@@ -0,0 +1,13 @@
+-- name: GetResourceLabels :many
+SELECT * from neosync_api.resource_labels
+WHERE resource_type = sqlc.arg('resourceType') AND resource_id = ANY(sqlc.arg('resourceIds')::uuid[])
+ORDER BY resource_id, key;
+
+-- name: CreateResourceLabels :exec
+INSERT INTO neosync_api.resource_labels (
+  account_id, resource_type, resource_id, key, value
+)
+SELECT sqlc.arg('accountId')::uuid, sqlc.arg('resourceType')::text, sqlc.arg('resourceId')::uuid, unnest(sqlc.arg('keys')::text[]), unnest(sqlc.arg('values')::text[]);
+
+-- name: RemoveResourceLabels :exec
+DELETE FROM neosync_api.resource_labels WHERE resource_type = $1 AND resource_id = $2;
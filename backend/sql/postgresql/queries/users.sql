@@ -117,6 +117,26 @@ INNER JOIN neosync_api.accounts a ON a.id = aua.account_id
 INNER JOIN neosync_api.users u ON u.id = aua.user_id
 WHERE a.id = sqlc.arg('accountId') AND u.id = sqlc.arg('userId');
 
+-- name: GetAccountUserPermissions :many
+SELECT aup.permission from neosync_api.account_user_permissions aup
+WHERE aup.account_id = sqlc.arg('accountId') AND aup.user_id = sqlc.arg('userId');
+
+-- name: GetAccountPermissionUserCount :one
+SELECT count(aup.id) from neosync_api.account_user_permissions aup
+WHERE aup.account_id = sqlc.arg('accountId') AND aup.permission = sqlc.arg('permission');
+
+-- name: GrantAccountUserPermission :exec
+INSERT INTO neosync_api.account_user_permissions (
+  account_id, user_id, permission
+) VALUES (
+  sqlc.arg('accountId'), sqlc.arg('userId'), sqlc.arg('permission')
+)
+ON CONFLICT (account_id, user_id, permission) DO NOTHING;
+
+-- name: RevokeAccountUserPermission :exec
+DELETE FROM neosync_api.account_user_permissions
+WHERE account_id = sqlc.arg('accountId') AND user_id = sqlc.arg('userId') AND permission = sqlc.arg('permission');
+
 -- name: GetAnonymousUser :one
 SELECT * from neosync_api.users
 WHERE id = '00000000-0000-0000-0000-000000000000';
@@ -202,3 +222,14 @@ SET onboarding_config = $1
 WHERE id = sqlc.arg('accountId')
 RETURNING *;
 
+-- name: GetAccountJobDefaults :one
+SELECT job_defaults
+FROM neosync_api.accounts
+WHERE id = $1;
+
+-- name: UpdateAccountJobDefaults :one
+UPDATE neosync_api.accounts
+SET job_defaults = $1
+WHERE id = sqlc.arg('accountId')
+RETURNING *;
+
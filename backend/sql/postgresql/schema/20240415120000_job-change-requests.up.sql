@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS neosync_api.job_change_requests (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+  job_id uuid NOT NULL,
+
+  connection_options jsonb NOT NULL,
+  mappings jsonb NOT NULL,
+
+  created_by_id uuid NOT NULL,
+  created_at timestamp NOT NULL DEFAULT now(),
+
+  approved_by_id uuid NULL,
+  approved_at timestamp NULL,
+
+  CONSTRAINT job_change_requests_pkey PRIMARY KEY (id),
+  CONSTRAINT fk_job_change_requests_jobs_id FOREIGN KEY (job_id) REFERENCES neosync_api.jobs(id) ON DELETE CASCADE,
+  CONSTRAINT fk_job_change_requests_created_by_id FOREIGN KEY (created_by_id) REFERENCES neosync_api.users(id),
+  CONSTRAINT fk_job_change_requests_approved_by_id FOREIGN KEY (approved_by_id) REFERENCES neosync_api.users(id)
+);
+CREATE INDEX IF NOT EXISTS job_change_requests_job_id_idx ON neosync_api.job_change_requests(job_id);
+ALTER TABLE neosync_api.job_change_requests OWNER TO neosync_api_owner;
+GRANT ALL ON TABLE neosync_api.job_change_requests TO neosync_api_owner;
+GRANT INSERT, DELETE, UPDATE, SELECT ON TABLE neosync_api.job_change_requests TO neosync_api_readwrite;
+GRANT SELECT ON TABLE neosync_api.job_change_requests TO neosync_api_readonly;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS neosync_api.connection_column_tags;
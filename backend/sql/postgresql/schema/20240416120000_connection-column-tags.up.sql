@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS neosync_api.connection_column_tags (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+  connection_id uuid NOT NULL,
+
+  schema_name text NOT NULL,
+  table_name text NOT NULL,
+  column_name text NOT NULL,
+
+  classifications int[] NOT NULL DEFAULT '{}',
+  source smallint NOT NULL,
+
+  created_by_id uuid NOT NULL,
+  updated_by_id uuid NOT NULL,
+  created_at timestamp NOT NULL DEFAULT now(),
+  updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  CONSTRAINT connection_column_tags_pkey PRIMARY KEY (id),
+  CONSTRAINT connection_column_tags_connection_id_column UNIQUE(connection_id, schema_name, table_name, column_name),
+  CONSTRAINT fk_connection_column_tags_connections_id FOREIGN KEY (connection_id) REFERENCES neosync_api.connections(id) ON DELETE CASCADE,
+  CONSTRAINT fk_connection_column_tags_created_by_id FOREIGN KEY (created_by_id) REFERENCES neosync_api.users(id),
+  CONSTRAINT fk_connection_column_tags_updated_by_id FOREIGN KEY (updated_by_id) REFERENCES neosync_api.users(id)
+);
+ALTER TABLE neosync_api.connection_column_tags OWNER TO neosync_api_owner;
+GRANT ALL ON TABLE neosync_api.connection_column_tags TO neosync_api_owner;
+GRANT INSERT, DELETE, UPDATE, SELECT ON TABLE neosync_api.connection_column_tags TO neosync_api_readwrite;
+GRANT SELECT ON TABLE neosync_api.connection_column_tags TO neosync_api_readonly;
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS neosync_api.idempotency_keys (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+  account_id uuid NOT NULL,
+
+  operation text NOT NULL,
+  idempotency_key text NOT NULL,
+  request_hash bytea NOT NULL,
+  response bytea NULL,
+
+  created_at timestamp NOT NULL DEFAULT now(),
+  updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  CONSTRAINT idempotency_keys_pkey PRIMARY KEY (id),
+  CONSTRAINT idempotency_keys_account_operation_key UNIQUE(account_id, operation, idempotency_key),
+  CONSTRAINT fk_idempotency_keys_accounts_id FOREIGN KEY (account_id) REFERENCES neosync_api.accounts(id) ON DELETE CASCADE
+);
+ALTER TABLE neosync_api.idempotency_keys OWNER TO neosync_api_owner;
+GRANT ALL ON TABLE neosync_api.idempotency_keys TO neosync_api_owner;
+GRANT INSERT, DELETE, UPDATE, SELECT ON TABLE neosync_api.idempotency_keys TO neosync_api_readwrite;
+GRANT SELECT ON TABLE neosync_api.idempotency_keys TO neosync_api_readonly;
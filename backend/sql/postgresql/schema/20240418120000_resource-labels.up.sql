@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS neosync_api.resource_labels (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+  account_id uuid NOT NULL,
+
+  -- one of connection, job, or transformer
+  resource_type text NOT NULL,
+  resource_id uuid NOT NULL,
+
+  key text NOT NULL,
+  value text NOT NULL,
+
+  created_at timestamp NOT NULL DEFAULT now(),
+  updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  CONSTRAINT resource_labels_pkey PRIMARY KEY (id),
+  CONSTRAINT resource_labels_resource_key UNIQUE(resource_type, resource_id, key),
+  CONSTRAINT fk_resource_labels_accounts_id FOREIGN KEY (account_id) REFERENCES neosync_api.accounts(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS resource_labels_account_id_resource_type_idx ON neosync_api.resource_labels(account_id, resource_type);
+
+ALTER TABLE neosync_api.resource_labels OWNER TO neosync_api_owner;
+GRANT ALL ON TABLE neosync_api.resource_labels TO neosync_api_owner;
+GRANT INSERT, DELETE, UPDATE, SELECT ON TABLE neosync_api.resource_labels TO neosync_api_readwrite;
+GRANT SELECT ON TABLE neosync_api.resource_labels TO neosync_api_readonly;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS neosync_api.job_run_artifacts;
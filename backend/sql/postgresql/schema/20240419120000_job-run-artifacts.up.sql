@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS neosync_api.job_run_artifacts (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+  account_id uuid NOT NULL,
+  job_run_id text NOT NULL,
+
+  -- maps to the mgmt.v1alpha1.JobRunArtifactType enum
+  artifact_type smallint NOT NULL,
+  name text NOT NULL,
+  content_type text NOT NULL,
+  size_bytes bigint NOT NULL,
+
+  -- the key of the artifact contents in the configured object store
+  object_key text NOT NULL,
+
+  created_at timestamp NOT NULL DEFAULT now(),
+
+  CONSTRAINT job_run_artifacts_pkey PRIMARY KEY (id),
+  CONSTRAINT fk_job_run_artifacts_accounts_id FOREIGN KEY (account_id) REFERENCES neosync_api.accounts(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS job_run_artifacts_account_id_job_run_id_idx ON neosync_api.job_run_artifacts(account_id, job_run_id);
+
+ALTER TABLE neosync_api.job_run_artifacts OWNER TO neosync_api_owner;
+GRANT ALL ON TABLE neosync_api.job_run_artifacts TO neosync_api_owner;
+GRANT INSERT, DELETE, UPDATE, SELECT ON TABLE neosync_api.job_run_artifacts TO neosync_api_readwrite;
+GRANT SELECT ON TABLE neosync_api.job_run_artifacts TO neosync_api_readonly;
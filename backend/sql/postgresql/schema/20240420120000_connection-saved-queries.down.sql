@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS neosync_api.connection_table_bookmarks;
+DROP TABLE IF EXISTS neosync_api.connection_saved_queries;
@@ -0,0 +1,44 @@
+CREATE TABLE IF NOT EXISTS neosync_api.connection_saved_queries (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+  connection_id uuid NOT NULL,
+
+  name text NOT NULL,
+  schema_name text NOT NULL,
+  table_name text NOT NULL,
+  where_clause text NULL,
+
+  created_by_id uuid NOT NULL,
+  updated_by_id uuid NOT NULL,
+  created_at timestamp NOT NULL DEFAULT now(),
+  updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  CONSTRAINT connection_saved_queries_pkey PRIMARY KEY (id),
+  CONSTRAINT connection_saved_queries_connection_id_name UNIQUE(connection_id, name),
+  CONSTRAINT fk_connection_saved_queries_connections_id FOREIGN KEY (connection_id) REFERENCES neosync_api.connections(id) ON DELETE CASCADE,
+  CONSTRAINT fk_connection_saved_queries_created_by_id FOREIGN KEY (created_by_id) REFERENCES neosync_api.users(id),
+  CONSTRAINT fk_connection_saved_queries_updated_by_id FOREIGN KEY (updated_by_id) REFERENCES neosync_api.users(id)
+);
+ALTER TABLE neosync_api.connection_saved_queries OWNER TO neosync_api_owner;
+GRANT ALL ON TABLE neosync_api.connection_saved_queries TO neosync_api_owner;
+GRANT INSERT, DELETE, UPDATE, SELECT ON TABLE neosync_api.connection_saved_queries TO neosync_api_readwrite;
+GRANT SELECT ON TABLE neosync_api.connection_saved_queries TO neosync_api_readonly;
+
+CREATE TABLE IF NOT EXISTS neosync_api.connection_table_bookmarks (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+  connection_id uuid NOT NULL,
+
+  schema_name text NOT NULL,
+  table_name text NOT NULL,
+
+  created_by_id uuid NOT NULL,
+  created_at timestamp NOT NULL DEFAULT now(),
+
+  CONSTRAINT connection_table_bookmarks_pkey PRIMARY KEY (id),
+  CONSTRAINT connection_table_bookmarks_connection_id_table UNIQUE(connection_id, schema_name, table_name),
+  CONSTRAINT fk_connection_table_bookmarks_connections_id FOREIGN KEY (connection_id) REFERENCES neosync_api.connections(id) ON DELETE CASCADE,
+  CONSTRAINT fk_connection_table_bookmarks_created_by_id FOREIGN KEY (created_by_id) REFERENCES neosync_api.users(id)
+);
+ALTER TABLE neosync_api.connection_table_bookmarks OWNER TO neosync_api_owner;
+GRANT ALL ON TABLE neosync_api.connection_table_bookmarks TO neosync_api_owner;
+GRANT INSERT, DELETE, UPDATE, SELECT ON TABLE neosync_api.connection_table_bookmarks TO neosync_api_readwrite;
+GRANT SELECT ON TABLE neosync_api.connection_table_bookmarks TO neosync_api_readonly;
@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS neosync_api.job_run_snapshots (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+  account_id uuid NOT NULL,
+  job_id uuid NOT NULL,
+  job_run_id text NOT NULL,
+  -- the AWS S3 connection that holds the job run's output
+  connection_id uuid NOT NULL,
+
+  name text NOT NULL,
+
+  created_by_id uuid NOT NULL,
+  created_at timestamp NOT NULL DEFAULT now(),
+
+  CONSTRAINT job_run_snapshots_pkey PRIMARY KEY (id),
+  CONSTRAINT job_run_snapshots_account_id_name UNIQUE(account_id, name),
+  CONSTRAINT fk_job_run_snapshots_accounts_id FOREIGN KEY (account_id) REFERENCES neosync_api.accounts(id) ON DELETE CASCADE,
+  CONSTRAINT fk_job_run_snapshots_jobs_id FOREIGN KEY (job_id) REFERENCES neosync_api.jobs(id) ON DELETE CASCADE,
+  CONSTRAINT fk_job_run_snapshots_connections_id FOREIGN KEY (connection_id) REFERENCES neosync_api.connections(id) ON DELETE CASCADE,
+  CONSTRAINT fk_job_run_snapshots_created_by_id FOREIGN KEY (created_by_id) REFERENCES neosync_api.users(id)
+);
+CREATE INDEX IF NOT EXISTS job_run_snapshots_account_id_job_run_id_idx ON neosync_api.job_run_snapshots(account_id, job_run_id);
+
+ALTER TABLE neosync_api.job_run_snapshots OWNER TO neosync_api_owner;
+GRANT ALL ON TABLE neosync_api.job_run_snapshots TO neosync_api_owner;
+GRANT INSERT, DELETE, UPDATE, SELECT ON TABLE neosync_api.job_run_snapshots TO neosync_api_readwrite;
+GRANT SELECT ON TABLE neosync_api.job_run_snapshots TO neosync_api_readonly;
@@ -0,0 +1,2 @@
+ALTER TABLE neosync_api.jobs
+DROP COLUMN IF EXISTS table_processors;
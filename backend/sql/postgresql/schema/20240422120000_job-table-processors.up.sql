@@ -0,0 +1,2 @@
+ALTER TABLE neosync_api.jobs
+ADD COLUMN IF NOT EXISTS table_processors jsonb NOT NULL DEFAULT '[]'::jsonb;
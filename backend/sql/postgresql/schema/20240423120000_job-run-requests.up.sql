@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS neosync_api.job_run_requests (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+  job_id uuid NOT NULL,
+  -- mgmtv1alpha1.JobRunPriority
+  priority smallint NOT NULL,
+  -- the job run that claimed this request. null until the triggered run has started
+  job_run_id text NULL,
+
+  created_by_id uuid NOT NULL,
+  created_at timestamp NOT NULL DEFAULT now(),
+
+  CONSTRAINT job_run_requests_pkey PRIMARY KEY (id),
+  CONSTRAINT job_run_requests_job_run_id UNIQUE(job_run_id),
+  CONSTRAINT fk_job_run_requests_jobs_id FOREIGN KEY (job_id) REFERENCES neosync_api.jobs(id) ON DELETE CASCADE,
+  CONSTRAINT fk_job_run_requests_created_by_id FOREIGN KEY (created_by_id) REFERENCES neosync_api.users(id)
+);
+CREATE INDEX IF NOT EXISTS job_run_requests_job_id_created_at_idx ON neosync_api.job_run_requests(job_id, created_at);
+
+ALTER TABLE neosync_api.job_run_requests OWNER TO neosync_api_owner;
+GRANT ALL ON TABLE neosync_api.job_run_requests TO neosync_api_owner;
+GRANT INSERT, DELETE, UPDATE, SELECT ON TABLE neosync_api.job_run_requests TO neosync_api_readwrite;
+GRANT SELECT ON TABLE neosync_api.job_run_requests TO neosync_api_readonly;
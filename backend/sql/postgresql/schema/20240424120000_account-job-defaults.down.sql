@@ -0,0 +1,3 @@
+ALTER TABLE
+  neosync_api.accounts
+DROP COLUMN IF EXISTS job_defaults;
@@ -0,0 +1,3 @@
+ALTER TABLE
+  neosync_api.accounts
+ADD COLUMN IF NOT EXISTS job_defaults jsonb NOT NULL DEFAULT '{}'::jsonb;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS neosync_api.javascript_libraries;
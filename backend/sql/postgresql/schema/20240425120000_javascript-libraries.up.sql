@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS neosync_api.javascript_libraries (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+  account_id uuid NOT NULL,
+  -- the name that transformer code imports the library by with require()
+  name text NOT NULL,
+  code text NOT NULL,
+
+  created_by_id uuid NOT NULL,
+  updated_by_id uuid NOT NULL,
+  created_at timestamp NOT NULL DEFAULT now(),
+  updated_at timestamp NOT NULL DEFAULT now(),
+
+  CONSTRAINT javascript_libraries_pkey PRIMARY KEY (id),
+  CONSTRAINT javascript_libraries_account_id_name UNIQUE (account_id, name),
+  CONSTRAINT fk_javascript_libraries_accounts_id FOREIGN KEY (account_id) REFERENCES neosync_api.accounts(id) ON DELETE CASCADE,
+  CONSTRAINT fk_javascript_libraries_created_by_users_id FOREIGN KEY (created_by_id) REFERENCES neosync_api.users(id),
+  CONSTRAINT fk_javascript_libraries_updated_by_users_id FOREIGN KEY (updated_by_id) REFERENCES neosync_api.users(id)
+);
+
+ALTER TABLE neosync_api.javascript_libraries OWNER TO neosync_api_owner;
+GRANT ALL ON TABLE neosync_api.javascript_libraries TO neosync_api_owner;
+GRANT INSERT, DELETE, UPDATE, SELECT ON TABLE neosync_api.javascript_libraries TO neosync_api_readwrite;
+GRANT SELECT ON TABLE neosync_api.javascript_libraries TO neosync_api_readonly;
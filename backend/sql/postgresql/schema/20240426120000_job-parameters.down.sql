@@ -0,0 +1,5 @@
+ALTER TABLE neosync_api.job_run_requests
+DROP COLUMN IF EXISTS parameters;
+
+ALTER TABLE neosync_api.jobs
+DROP COLUMN IF EXISTS parameters;
@@ -0,0 +1,5 @@
+ALTER TABLE neosync_api.jobs
+ADD COLUMN IF NOT EXISTS parameters jsonb NOT NULL DEFAULT '[]'::jsonb;
+
+ALTER TABLE neosync_api.job_run_requests
+ADD COLUMN IF NOT EXISTS parameters jsonb NOT NULL DEFAULT '[]'::jsonb;
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS neosync_api.account_user_permissions (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+  account_id uuid NOT NULL,
+  user_id uuid NOT NULL,
+  -- the mgmt.v1alpha1.AccountPermission enum name, e.g. ACCOUNT_PERMISSION_QUERY_CONSOLE
+  permission text NOT NULL,
+
+  created_at timestamp NOT NULL DEFAULT now(),
+  updated_at timestamp NOT NULL DEFAULT now(),
+
+  CONSTRAINT account_user_permissions_pkey PRIMARY KEY (id),
+  CONSTRAINT account_user_permissions_account_id_user_id_permission UNIQUE (account_id, user_id, permission),
+  CONSTRAINT fk_account_user_permissions_accounts_id FOREIGN KEY (account_id) REFERENCES neosync_api.accounts(id) ON DELETE CASCADE,
+  CONSTRAINT fk_account_user_permissions_users_id FOREIGN KEY (user_id) REFERENCES neosync_api.users(id) ON DELETE CASCADE
+);
+
+ALTER TABLE neosync_api.account_user_permissions OWNER TO neosync_api_owner;
+GRANT ALL ON TABLE neosync_api.account_user_permissions TO neosync_api_owner;
+GRANT INSERT, DELETE, UPDATE, SELECT ON TABLE neosync_api.account_user_permissions TO neosync_api_readwrite;
+GRANT SELECT ON TABLE neosync_api.account_user_permissions TO neosync_api_readonly;
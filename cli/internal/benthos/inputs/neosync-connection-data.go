@@ -2,8 +2,11 @@ package input
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"strconv"
 	"sync"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/benthosdev/benthos/v4/public/service"
@@ -122,8 +125,16 @@ type neosyncInput struct {
 	recvMut sync.Mutex
 
 	resp *connect.ServerStreamForClient[mgmtv1alpha1.GetConnectionDataStreamResponse]
+	// the first message is received during Connect so that rate limit errors can be retried
+	hasPeeked bool
+	peekedOk  bool
 }
 
+const (
+	maxRateLimitRetries = 5
+	maxRateLimitWait    = 30 * time.Second
+)
+
 func (g *neosyncInput) Connect(ctx context.Context) error {
 	g.neosyncConnectApi = mgmtv1alpha1connect.NewConnectionDataServiceClient(
 		http.DefaultClient,
@@ -148,17 +159,50 @@ func (g *neosyncInput) Connect(ctx context.Context) error {
 		}
 	}
 
-	resp, err := g.neosyncConnectApi.GetConnectionDataStream(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionDataStreamRequest{
-		ConnectionId: g.connectionId,
-		Schema:       g.schema,
-		Table:        g.table,
-		StreamConfig: streamCfg,
-	}))
-	if err != nil {
-		return err
+	for attempt := 0; ; attempt++ {
+		resp, err := g.neosyncConnectApi.GetConnectionDataStream(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionDataStreamRequest{
+			ConnectionId: g.connectionId,
+			Schema:       g.schema,
+			Table:        g.table,
+			StreamConfig: streamCfg,
+		}))
+		if err == nil {
+			ok := resp.Receive()
+			if ok || resp.Err() == nil {
+				g.resp = resp
+				g.hasPeeked = true
+				g.peekedOk = ok
+				return nil
+			}
+			err = resp.Err()
+			_ = resp.Close()
+		}
+
+		wait, isRateLimited := getRateLimitRetryAfter(err)
+		if !isRateLimited || attempt >= maxRateLimitRetries {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
 	}
-	g.resp = resp
-	return nil
+}
+
+// Returns how long to wait before retrying if the error was due to the server rate limiting the request
+func getRateLimitRetryAfter(err error) (time.Duration, bool) {
+	if connect.CodeOf(err) != connect.CodeResourceExhausted {
+		return 0, false
+	}
+	wait := time.Second
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		if seconds, err := strconv.Atoi(connectErr.Meta().Get("Retry-After")); err == nil && seconds > 0 {
+			wait = time.Duration(seconds) * time.Second
+		}
+	}
+	return min(wait, maxRateLimitWait), true
 }
 
 func (g *neosyncInput) Read(ctx context.Context) (*service.Message, service.AckFunc, error) {
@@ -172,7 +216,13 @@ func (g *neosyncInput) Read(ctx context.Context) (*service.Message, service.AckF
 		return nil, nil, service.ErrEndOfInput
 	}
 
-	ok := g.resp.Receive()
+	var ok bool
+	if g.hasPeeked {
+		ok = g.peekedOk
+		g.hasPeeked = false
+	} else {
+		ok = g.resp.Receive()
+	}
 	if !ok {
 		err := g.resp.Err()
 		if err != nil {
@@ -121,6 +121,30 @@ func getCategory(cc *mgmtv1alpha1.ConnectionConfig) string {
 		return "Mysql"
 	case *mgmtv1alpha1.ConnectionConfig_AwsS3Config:
 		return "AWS S3"
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		return "SQLite"
+	case *mgmtv1alpha1.ConnectionConfig_SnowflakeConfig:
+		return "Snowflake"
+	case *mgmtv1alpha1.ConnectionConfig_RedshiftConfig:
+		return "Redshift"
+	case *mgmtv1alpha1.ConnectionConfig_BigqueryConfig:
+		return "BigQuery"
+	case *mgmtv1alpha1.ConnectionConfig_GcpCloudstorageConfig:
+		return "GCP Cloud Storage"
+	case *mgmtv1alpha1.ConnectionConfig_AzureBlobConfig:
+		return "Azure Blob Storage"
+	case *mgmtv1alpha1.ConnectionConfig_KafkaConfig:
+		return "Kafka"
+	case *mgmtv1alpha1.ConnectionConfig_ElasticsearchConfig:
+		return "Elasticsearch"
+	case *mgmtv1alpha1.ConnectionConfig_DuckdbConfig:
+		return "DuckDB"
+	case *mgmtv1alpha1.ConnectionConfig_SpannerConfig:
+		return "Spanner"
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		return "SQL Server"
+	case *mgmtv1alpha1.ConnectionConfig_CustomConfig:
+		return "Custom"
 	default:
 		return "Unknown"
 	}
@@ -43,18 +43,58 @@ func newTriggerCmd() *cobra.Command {
 				return err
 			}
 
+			idempotencyKey, err := cmd.Flags().GetString("idempotency-key")
+			if err != nil {
+				return err
+			}
+
+			priorityFlag, err := cmd.Flags().GetString("priority")
+			if err != nil {
+				return err
+			}
+			priority, err := parseJobRunPriority(priorityFlag)
+			if err != nil {
+				return err
+			}
+			preempt, err := cmd.Flags().GetBool("preempt")
+			if err != nil {
+				return err
+			}
+			if preempt && priority != mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_INTERACTIVE {
+				return errors.New("--preempt may only be used with --priority interactive")
+			}
+
 			cmd.SilenceUsage = true
-			return triggerJob(cmd.Context(), jobUuid.String(), &apiKey, &accountId)
+			return triggerJob(cmd.Context(), jobUuid.String(), &apiKey, &accountId, idempotencyKey, priority, preempt)
 		},
 	}
 	cmd.Flags().String("account-id", "", "Account that job is in. Defaults to account id in cli context")
+	cmd.Flags().String("idempotency-key", "", "If provided, retrying the command with the same key will not trigger the job more than once")
+	cmd.Flags().String("priority", "", "Priority class of the run, interactive or batch. Interactive runs are scheduled ahead of batch runs when workers are at capacity. Defaults to batch")
+	cmd.Flags().Bool("preempt", false, "Asks long-running batch runs in the account to yield at their next table boundary. Only valid for interactive runs")
 	return cmd
 }
 
+func parseJobRunPriority(priority string) (mgmtv1alpha1.JobRunPriority, error) {
+	switch priority {
+	case "":
+		return mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_UNSPECIFIED, nil
+	case "interactive":
+		return mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_INTERACTIVE, nil
+	case "batch":
+		return mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_BATCH, nil
+	default:
+		return mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_UNSPECIFIED, fmt.Errorf("invalid priority %q, must be one of interactive, batch", priority)
+	}
+}
+
 func triggerJob(
 	ctx context.Context,
 	jobId string,
 	apiKey, accountIdFlag *string,
+	idempotencyKey string,
+	priority mgmtv1alpha1.JobRunPriority,
+	preempt bool,
 ) error {
 	isAuthEnabled, err := auth.IsAuthEnabled(ctx)
 	if err != nil {
@@ -88,9 +128,15 @@ func triggerJob(
 	if job.Msg.Job.AccountId != *accountId {
 		return fmt.Errorf("Unable to trigger job run. Job not found. AccountId: %s", *accountId)
 	}
-	_, err = jobclient.CreateJobRun(ctx, connect.NewRequest[mgmtv1alpha1.CreateJobRunRequest](&mgmtv1alpha1.CreateJobRunRequest{
-		JobId: jobId,
-	}))
+	createReq := connect.NewRequest[mgmtv1alpha1.CreateJobRunRequest](&mgmtv1alpha1.CreateJobRunRequest{
+		JobId:            jobId,
+		Priority:         priority,
+		PreemptBatchRuns: preempt,
+	})
+	if idempotencyKey != "" {
+		createReq.Header().Set("Idempotency-Key", idempotencyKey)
+	}
+	_, err = jobclient.CreateJobRun(ctx, createReq)
 	if err != nil {
 		return err
 	}
@@ -104,12 +104,16 @@ type sourceConfig struct {
 type connectionOpts struct {
 	JobId    *string `yaml:"job-id,omitempty"`
 	JobRunId *string `yaml:"job-run-id,omitempty"`
+	Snapshot *string `yaml:"snapshot,omitempty"`
 }
 
 type destinationConfig struct {
 	ConnectionUrl        string     `yaml:"connection-url"`
 	Driver               DriverType `yaml:"driver"`
 	InitSchema           bool       `yaml:"init-schema,omitempty"`
+	InitExtensions       bool       `yaml:"init-extensions,omitempty"`
+	MysqlCharset         *string    `yaml:"mysql-charset,omitempty"`
+	MysqlCollation       *string    `yaml:"mysql-collation,omitempty"`
 	TruncateBeforeInsert bool       `yaml:"truncate-before-insert,omitempty"`
 	TruncateCascade      bool       `yaml:"truncate-cascade,omitempty"`
 }
@@ -195,6 +199,30 @@ func NewCmd() *cobra.Command {
 				config.Destination.InitSchema = initSchema
 			}
 
+			initExtensions, err := cmd.Flags().GetBool("init-extensions")
+			if err != nil {
+				return err
+			}
+			if initExtensions {
+				config.Destination.InitExtensions = initExtensions
+			}
+
+			mysqlCharset, err := cmd.Flags().GetString("mysql-charset")
+			if err != nil {
+				return err
+			}
+			if mysqlCharset != "" {
+				config.Destination.MysqlCharset = &mysqlCharset
+			}
+
+			mysqlCollation, err := cmd.Flags().GetString("mysql-collation")
+			if err != nil {
+				return err
+			}
+			if mysqlCollation != "" {
+				config.Destination.MysqlCollation = &mysqlCollation
+			}
+
 			truncateBeforeInsert, err := cmd.Flags().GetBool("truncate-before-insert")
 			if err != nil {
 				return err
@@ -227,6 +255,14 @@ func NewCmd() *cobra.Command {
 				config.Source.ConnectionOpts.JobRunId = &jobRunId
 			}
 
+			snapshot, err := cmd.Flags().GetString("snapshot")
+			if err != nil {
+				return err
+			}
+			if snapshot != "" {
+				config.Source.ConnectionOpts.Snapshot = &snapshot
+			}
+
 			if config.Source.ConnectionId == "" {
 				return fmt.Errorf("must provide connection-id")
 			}
@@ -241,6 +277,17 @@ func NewCmd() *cobra.Command {
 				return fmt.Errorf("wrong driver type. truncate cascade is only supported in postgres")
 			}
 
+			if config.Destination.InitExtensions && config.Destination.Driver != postgresDriver {
+				return fmt.Errorf("wrong driver type. init extensions is only supported in postgres")
+			}
+
+			if (config.Destination.MysqlCharset != nil || config.Destination.MysqlCollation != nil) && config.Destination.Driver != mysqlDriver {
+				return fmt.Errorf("wrong driver type. mysql charset and collation are only supported in mysql")
+			}
+			if config.Destination.MysqlCollation != nil && config.Destination.MysqlCharset == nil {
+				return fmt.Errorf("must provide mysql-charset when providing mysql-collation")
+			}
+
 			if config.Destination.Driver != mysqlDriver && config.Destination.Driver != postgresDriver {
 				return errors.New("unsupported destination driver. only postgres and mysql are currently supported")
 			}
@@ -262,11 +309,15 @@ func NewCmd() *cobra.Command {
 	cmd.Flags().String("connection-id", "", "Connection id for sync source")
 	cmd.Flags().String("job-id", "", "Id of Job to sync data from. Only used with AWS S3 connections. Can use job-run-id instead.")
 	cmd.Flags().String("job-run-id", "", "Id of Job run to sync data from. Only used with AWS S3 connections. Can use job-id instead.")
+	cmd.Flags().String("snapshot", "", "Name of a job run snapshot to sync data from. Only used with AWS S3 connections. Can use job-id or job-run-id instead.")
 	cmd.Flags().String("destination-connection-url", "", "Connection url for sync output")
 	cmd.Flags().String("destination-driver", "", "Connection driver for sync output")
 	cmd.Flags().String("account-id", "", "Account source connection is in. Defaults to account id in cli context")
 	cmd.Flags().String("config", "", "Location of config file")
 	cmd.Flags().Bool("init-schema", false, "Create table schema and its constraints")
+	cmd.Flags().Bool("init-extensions", false, "Create the extensions installed in the source database before creating tables (postgres only)")
+	cmd.Flags().String("mysql-charset", "", "Character set to create every table and column with when used with init-schema, instead of the source's (mysql only)")
+	cmd.Flags().String("mysql-collation", "", "Collation to create every table and column with when used with init-schema. Requires mysql-charset (mysql only)")
 	cmd.Flags().Bool("truncate-before-insert", false, "Truncate table before insert")
 	cmd.Flags().Bool("truncate-cascade", false, "Truncate cascade table before insert (postgres only)")
 	output.AttachOutputFlag(cmd)
@@ -301,6 +352,14 @@ func sync(
 		),
 	)
 
+	jobclient := mgmtv1alpha1connect.NewJobServiceClient(
+		http.DefaultClient,
+		serverconfig.GetApiBaseUrl(),
+		connect.WithInterceptors(
+			auth_interceptor.NewInterceptor(isAuthEnabled, auth.AuthHeader, auth.GetAuthHeaderTokenFn(apiKey)),
+		),
+	)
+
 	pgpoolmap := &syncmap.Map{}
 	mysqlpoolmap := &syncmap.Map{}
 	pgquerier := pg_queries.New()
@@ -320,8 +379,16 @@ func sync(
 		return err
 	}
 
+	if connectionType == awsS3Connection && cmd.Source.ConnectionOpts.Snapshot != nil && *cmd.Source.ConnectionOpts.Snapshot != "" {
+		jobRunId, err := getSnapshotJobRunId(ctx, jobclient, connection, *cmd.Source.ConnectionOpts.Snapshot)
+		if err != nil {
+			return err
+		}
+		cmd.Source.ConnectionOpts.JobRunId = &jobRunId
+	}
+
 	if connectionType == awsS3Connection && (cmd.Source.ConnectionOpts.JobId == nil || *cmd.Source.ConnectionOpts.JobId == "") && (cmd.Source.ConnectionOpts.JobRunId == nil || *cmd.Source.ConnectionOpts.JobRunId == "") {
-		return errors.New("S3 source connection type requires job-id or job-run-id.")
+		return errors.New("S3 source connection type requires job-id, job-run-id, or snapshot.")
 	}
 
 	var token *string
@@ -563,6 +630,12 @@ func runDestinationInitStatements(ctx context.Context, sqlmanager sql_manager.Sq
 		return err
 	}
 	defer db.Db.Close()
+	if cmd.Destination.InitExtensions && len(schemaConfig.ExtensionInitStatements) > 0 {
+		err = runDestinationExtensionStatements(ctx, db.Db, schemaConfig.ExtensionInitStatements)
+		if err != nil {
+			return err
+		}
+	}
 	if cmd.Destination.InitSchema {
 		orderedTablesResp, err := tabledependency.GetTablesOrderedByDependency(dependencyMap)
 		if err != nil {
@@ -601,7 +674,11 @@ func runDestinationInitStatements(ctx context.Context, sqlmanager sql_manager.Sq
 				return err
 			}
 
-			orderedTruncateStatement := sql_manager.BuildPgTruncateStatement(orderedTablesResp.OrderedTables)
+			orderedTables := make([]string, len(orderedTablesResp.OrderedTables))
+			for idx, t := range orderedTablesResp.OrderedTables {
+				orderedTables[idx] = quoteTableKey(cmd.Destination.Driver, t)
+			}
+			orderedTruncateStatement := sql_manager.BuildPgTruncateStatement(orderedTables)
 			err = db.Db.Exec(ctx, orderedTruncateStatement)
 			if err != nil {
 				fmt.Println("Error truncating tables:", err) //nolint:forbidigo
@@ -697,6 +774,28 @@ func buildSyncConfigs(
 	return syncConfigs
 }
 
+// Extensions that are not trusted require superuser rights to create.
+// When the destination role is not a superuser, failures are reported as warnings so that already installed extensions do not block the sync
+func runDestinationExtensionStatements(ctx context.Context, db sql_manager.SqlDatabase, statements []string) error {
+	isSuperuser, err := db.IsSuperuser(ctx)
+	if err != nil {
+		return err
+	}
+	if !isSuperuser {
+		fmt.Println(printlog.Render("Warning: destination role is not a superuser. Extensions that are not trusted may fail to be created.")) //nolint:forbidigo
+	}
+	for _, stmt := range statements {
+		err := db.Exec(ctx, stmt)
+		if err != nil && isSuperuser {
+			fmt.Println("Error creating extensions:", err) //nolint:forbidigo
+			return err
+		} else if err != nil {
+			fmt.Println(printlog.Render(fmt.Sprintf("Warning: unable to create extension: %s", err.Error()))) //nolint:forbidigo
+		}
+	}
+	return nil
+}
+
 func buildDependencyMap(syncConfigs []*syncConfig) map[string][]string {
 	dependencyMap := map[string][]string{}
 	for _, cfg := range syncConfigs {
@@ -714,7 +813,7 @@ func buildDependencyMap(syncConfigs []*syncConfig) map[string][]string {
 }
 
 func getTableInitStatementMap(ctx context.Context, connectiondataclient mgmtv1alpha1connect.ConnectionDataServiceClient, connectionId string, opts *destinationConfig) (*mgmtv1alpha1.GetConnectionInitStatementsResponse, error) {
-	if opts.InitSchema || opts.TruncateBeforeInsert || opts.TruncateCascade {
+	if opts.InitSchema || opts.InitExtensions || opts.TruncateBeforeInsert || opts.TruncateCascade {
 		fmt.Println(printlog.Render("Creating init statements...")) //nolint:forbidigo
 		truncateBeforeInsert := opts.TruncateBeforeInsert
 		if opts.Driver == postgresDriver && truncateBeforeInsert {
@@ -722,6 +821,13 @@ func getTableInitStatementMap(ctx context.Context, connectiondataclient mgmtv1al
 			// handled in runDestinationInitStatements function
 			truncateBeforeInsert = false
 		}
+		var charsetOverride *mgmtv1alpha1.MysqlCharsetOverride
+		if opts.MysqlCharset != nil {
+			charsetOverride = &mgmtv1alpha1.MysqlCharsetOverride{
+				Charset:   *opts.MysqlCharset,
+				Collation: opts.MysqlCollation,
+			}
+		}
 		initStatementResp, err := connectiondataclient.GetConnectionInitStatements(ctx,
 			connect.NewRequest(&mgmtv1alpha1.GetConnectionInitStatementsRequest{
 				ConnectionId: connectionId,
@@ -729,6 +835,8 @@ func getTableInitStatementMap(ctx context.Context, connectiondataclient mgmtv1al
 					InitSchema:           opts.InitSchema,
 					TruncateBeforeInsert: truncateBeforeInsert,
 					TruncateCascade:      opts.TruncateCascade,
+					InitExtensions:       opts.InitExtensions,
+					MysqlCharsetOverride: charsetOverride,
 				},
 			},
 			))
@@ -1024,6 +1132,7 @@ type schemaConfig struct {
 	TablePrimaryKeys           map[string]*mgmtv1alpha1.PrimaryConstraint
 	InitTableStatementsMap     map[string]string
 	TruncateTableStatementsMap map[string]string
+	ExtensionInitStatements    []string
 }
 
 func getConnectionSchemaConfig(
@@ -1038,6 +1147,7 @@ func getConnectionSchemaConfig(
 	var tablePrimaryKeys map[string]*mgmtv1alpha1.PrimaryConstraint
 	var initTableStatementsMap map[string]string
 	var truncateTableStatementsMap map[string]string
+	var extensionInitStatements []string
 	errgrp, errctx := errgroup.WithContext(ctx)
 	errgrp.Go(func() error {
 		schemaResp, err := connectiondataclient.GetConnectionSchema(errctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionSchemaRequest{
@@ -1076,6 +1186,7 @@ func getConnectionSchemaConfig(
 		}
 		initTableStatementsMap = initStatementsResp.GetTableInitStatements()
 		truncateTableStatementsMap = initStatementsResp.GetTableTruncateStatements()
+		extensionInitStatements = initStatementsResp.GetExtensionInitStatements()
 		return nil
 	})
 	if err := errgrp.Wait(); err != nil {
@@ -1106,6 +1217,7 @@ func getConnectionSchemaConfig(
 		TablePrimaryKeys:           tablePrimaryKeys,
 		InitTableStatementsMap:     initTableStatementsMap,
 		TruncateTableStatementsMap: truncateTableStatementsMap,
+		ExtensionInitStatements:    extensionInitStatements,
 	}, nil
 }
 
@@ -1157,9 +1269,9 @@ func getDestinationSchemaConfig(
 		statements := []string{}
 		if cmd.Destination.TruncateBeforeInsert {
 			if cmd.Destination.TruncateCascade {
-				statements = append(statements, fmt.Sprintf("TRUNCATE TABLE %s CASCADE;", t))
+				statements = append(statements, fmt.Sprintf("TRUNCATE TABLE %s CASCADE;", quoteTableKey(cmd.Destination.Driver, t)))
 			} else {
-				statements = append(statements, fmt.Sprintf("TRUNCATE TABLE %s;", t))
+				statements = append(statements, fmt.Sprintf("TRUNCATE TABLE %s;", quoteTableKey(cmd.Destination.Driver, t)))
 			}
 		}
 		initTableStatementsMap[t] = strings.Join(statements, "\n")
@@ -1286,6 +1398,27 @@ func parseDriverString(str string) (DriverType, bool) {
 	return p, ok
 }
 
+// Resolves a named job run snapshot to the job run it references
+func getSnapshotJobRunId(
+	ctx context.Context,
+	jobclient mgmtv1alpha1connect.JobServiceClient,
+	connection *mgmtv1alpha1.Connection,
+	snapshotName string,
+) (string, error) {
+	resp, err := jobclient.GetJobRunSnapshot(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRunSnapshotRequest{
+		AccountId: connection.GetAccountId(),
+		Name:      snapshotName,
+	}))
+	if err != nil {
+		return "", fmt.Errorf("unable to get job run snapshot %s: %w", snapshotName, err)
+	}
+	snapshot := resp.Msg.GetSnapshot()
+	if snapshot.GetConnectionId() != connection.GetId() {
+		return "", fmt.Errorf("snapshot %s was not taken from connection %s", snapshotName, connection.GetId())
+	}
+	return snapshot.GetJobRunId(), nil
+}
+
 func getConnectionType(connection *mgmtv1alpha1.Connection) (ConnectionType, error) {
 	if connection.ConnectionConfig.GetAwsS3Config() != nil {
 		return awsS3Connection, nil
@@ -1299,6 +1432,15 @@ func getConnectionType(connection *mgmtv1alpha1.Connection) (ConnectionType, err
 	return "", errors.New("unsupported connection type")
 }
 
+// Quotes a schema.table key for the destination driver
+func quoteTableKey(driver DriverType, key string) string {
+	schema, table, found := strings.Cut(key, ".")
+	if !found {
+		return sql_manager.QuoteTable(string(driver), "", key)
+	}
+	return sql_manager.QuoteTable(string(driver), schema, table)
+}
+
 func buildPostgresUpdateQuery(schema, table string, columns, primaryKeys []string) string {
 	values := make([]string, len(columns))
 	var where string
@@ -1315,7 +1457,7 @@ func buildPostgresUpdateQuery(schema, table string, columns, primaryKeys []strin
 		}
 		where = fmt.Sprintf("WHERE %s", strings.Join(clauses, " AND "))
 	}
-	return fmt.Sprintf("UPDATE %s SET %s %s;", fmt.Sprintf("%q.%q", schema, table), strings.Join(values, ", "), where)
+	return fmt.Sprintf("UPDATE %s SET %s %s;", sql_manager.QuoteTable(sql_manager.PostgresDriver, schema, table), strings.Join(values, ", "), where)
 }
 
 func buildPostgresInsertQuery(schema, table string, columns []string) string {
@@ -1325,7 +1467,7 @@ func buildPostgresInsertQuery(schema, table string, columns []string) string {
 		values[i] = fmt.Sprintf("$%d", paramCount)
 		paramCount++
 	}
-	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s);", fmt.Sprintf("%q.%q", schema, table), strings.Join(sql_manager.EscapePgColumns(columns), ", "), strings.Join(values, ", "))
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s);", sql_manager.QuoteTable(sql_manager.PostgresDriver, schema, table), strings.Join(sql_manager.EscapePgColumns(columns), ", "), strings.Join(values, ", "))
 }
 
 func buildMysqlInsertQuery(schema, table string, columns []string) string {
@@ -1333,7 +1475,7 @@ func buildMysqlInsertQuery(schema, table string, columns []string) string {
 	for i := range columns {
 		values[i] = "?"
 	}
-	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s);", fmt.Sprintf("`%s`.`%s`", schema, table), strings.Join(sql_manager.EscapeMysqlColumns(columns), ", "), strings.Join(values, ", "))
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s);", sql_manager.QuoteTable(sql_manager.MysqlDriver, schema, table), strings.Join(sql_manager.EscapeMysqlColumns(columns), ", "), strings.Join(values, ", "))
 }
 
 func buildMysqlUpdateQuery(schema, table string, columns, primaryKeys []string) string {
@@ -1349,5 +1491,5 @@ func buildMysqlUpdateQuery(schema, table string, columns, primaryKeys []string)
 		}
 		where = fmt.Sprintf("WHERE %s", strings.Join(clauses, " AND "))
 	}
-	return fmt.Sprintf("UPDATE %s SET %s %s;", fmt.Sprintf("`%s`.`%s`", schema, table), strings.Join(values, ", "), where)
+	return fmt.Sprintf("UPDATE %s SET %s %s;", sql_manager.QuoteTable(sql_manager.MysqlDriver, schema, table), strings.Join(values, ", "), where)
 }
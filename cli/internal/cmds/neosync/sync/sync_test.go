@@ -168,6 +168,7 @@ func Test_buildPostgresInsertQuery(t *testing.T) {
 	}{
 		{"Single Column", "public", "users", []string{"name"}, `INSERT INTO "public"."users" ("name") VALUES ($1);`},
 		{"Multiple Columns", "public", "users", []string{"name", "email"}, `INSERT INTO "public"."users" ("name", "email") VALUES ($1, $2);`},
+		{"Special Identifiers", "Public", "userAccounts", []string{"first name", "order", `say "hi"`}, `INSERT INTO "Public"."userAccounts" ("first name", "order", "say ""hi""") VALUES ($1, $2, $3);`},
 	}
 
 	for _, tt := range tests {
@@ -189,6 +190,7 @@ func Test_buildPostgresUpdateQuery(t *testing.T) {
 	}{
 		{"Single Column", "public", "users", []string{"name"}, []string{"id"}, `UPDATE "public"."users" SET "name" = $1 WHERE "id" = $2;`},
 		{"Multiple Primary Keys", "public", "users", []string{"name", "email"}, []string{"id", "other"}, `UPDATE "public"."users" SET "name" = $1, "email" = $2 WHERE "id" = $3 AND "other" = $4;`},
+		{"Special Identifiers", "public", "user", []string{"firstName", "select"}, []string{"user id"}, `UPDATE "public"."user" SET "firstName" = $1, "select" = $2 WHERE "user id" = $3;`},
 	}
 
 	for _, tt := range tests {
@@ -209,6 +211,7 @@ func Test_buildMysqlInsertQuery(t *testing.T) {
 	}{
 		{"Single Column", "public", "users", []string{"name"}, "INSERT INTO `public`.`users` (`name`) VALUES (?);"},
 		{"Multiple Columns", "public", "users", []string{"name", "email"}, "INSERT INTO `public`.`users` (`name`, `email`) VALUES (?, ?);"},
+		{"Special Identifiers", "public", "userAccounts", []string{"first name", "order", "back`tick"}, "INSERT INTO `public`.`userAccounts` (`first name`, `order`, `back``tick`) VALUES (?, ?, ?);"},
 	}
 
 	for _, tt := range tests {
@@ -230,6 +233,7 @@ func Test_buildMysqlUpdateQuery(t *testing.T) {
 	}{
 		{"Single Column", "public", "users", []string{"name"}, []string{"id"}, "UPDATE `public`.`users` SET `name` = ? WHERE `id` = ?;"},
 		{"Multiple Primary Keys", "public", "users", []string{"name", "email"}, []string{"id", "other"}, "UPDATE `public`.`users` SET `name` = ?, `email` = ? WHERE `id` = ? AND `other` = ?;"},
+		{"Special Identifiers", "public", "user", []string{"firstName", "select"}, []string{"user id"}, "UPDATE `public`.`user` SET `firstName` = ?, `select` = ? WHERE `user id` = ?;"},
 	}
 
 	for _, tt := range tests {
@@ -240,6 +244,12 @@ func Test_buildMysqlUpdateQuery(t *testing.T) {
 	}
 }
 
+func Test_quoteTableKey(t *testing.T) {
+	require.Equal(t, `"public"."userAccounts"`, quoteTableKey(postgresDriver, "public.userAccounts"))
+	require.Equal(t, "`public`.`order items`", quoteTableKey(mysqlDriver, "public.order items"))
+	require.Equal(t, `"users"`, quoteTableKey(postgresDriver, "users"))
+}
+
 func Test_buildSyncConfigs_postgres(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -0,0 +1,156 @@
+package neosync_benthos_aws
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/benthosdev/benthos/v4/public/service"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Tracks the files written by the output so that a manifest describing them can be written once the output closes
+type s3Manifest struct {
+	key      string
+	jobRunId string
+	schema   string
+	table    string
+
+	mu      sync.Mutex
+	files   []*mgmtv1alpha1.TableRunManifestFile
+	columns map[string]struct{}
+}
+
+func getS3Manifest(conf *service.ParsedConfig) (*s3Manifest, error) {
+	if !conf.Contains("manifest") {
+		return nil, nil
+	}
+	manifestConf := conf.Namespace("manifest")
+	path, err := manifestConf.FieldString("path")
+	if err != nil {
+		return nil, err
+	}
+	jobRunId, err := manifestConf.FieldString("job_run_id")
+	if err != nil {
+		return nil, err
+	}
+	schema, err := manifestConf.FieldString("schema")
+	if err != nil {
+		return nil, err
+	}
+	table, err := manifestConf.FieldString("table")
+	if err != nil {
+		return nil, err
+	}
+	return &s3Manifest{
+		key:      strings.TrimPrefix(path, "/"),
+		jobRunId: jobRunId,
+		schema:   schema,
+		table:    table,
+		columns:  map[string]struct{}{},
+	}, nil
+}
+
+// Records a file that has been uploaded. The body is the file's contents as stored in the bucket
+func (m *s3Manifest) addFile(key string, body []byte, rowCount int64, columns []string) {
+	sum := sha256.Sum256(body)
+	file := &mgmtv1alpha1.TableRunManifestFile{
+		Key:       key,
+		RowCount:  rowCount,
+		SizeBytes: int64(len(body)),
+		Sha256:    hex.EncodeToString(sum[:]),
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.files = append(m.files, file)
+	for _, column := range columns {
+		m.columns[column] = struct{}{}
+	}
+}
+
+// Returns the protojson encoded manifest, or nil if no files have been written
+func (m *s3Manifest) marshal() ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.files) == 0 {
+		return nil, nil
+	}
+
+	columns := make([]string, 0, len(m.columns))
+	for column := range m.columns {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+	fingerprint := sha256.Sum256([]byte(strings.Join(columns, ",")))
+
+	manifest := &mgmtv1alpha1.TableRunManifest{
+		JobRunId:          m.jobRunId,
+		Schema:            m.schema,
+		Table:             m.table,
+		Columns:           columns,
+		SchemaFingerprint: hex.EncodeToString(fingerprint[:]),
+		Files:             m.files,
+		CreatedAt:         timestamppb.Now(),
+	}
+	for _, file := range m.files {
+		manifest.RowCount += file.GetRowCount()
+		manifest.SizeBytes += file.GetSizeBytes()
+	}
+	return protojson.Marshal(manifest)
+}
+
+// Counts the json lines in a file, which may be gzipped, and returns the keys of the first row
+func readRows(body []byte) (int64, []string, error) {
+	var reader io.Reader = bytes.NewReader(body)
+	if isGzip(body) {
+		gzr, err := gzip.NewReader(reader)
+		if err != nil {
+			return 0, nil, fmt.Errorf("error creating gzip reader: %w", err)
+		}
+		defer gzr.Close()
+		reader = gzr
+	}
+
+	var count int64
+	var columns []string
+	// rows can be larger than the max token size of a bufio.Scanner
+	br := bufio.NewReader(reader)
+	for {
+		line, err := br.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return 0, nil, err
+		}
+		line = bytes.TrimSpace(line)
+		if len(line) > 0 {
+			if count == 0 {
+				var row map[string]any
+				if jsonErr := json.Unmarshal(line, &row); jsonErr == nil {
+					for key := range row {
+						columns = append(columns, key)
+					}
+				}
+			}
+			count++
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+	}
+	return count, columns, nil
+}
+
+func isGzip(body []byte) bool {
+	return len(body) > 1 && body[0] == 0x1f && body[1] == 0x8b
+}
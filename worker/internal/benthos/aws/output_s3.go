@@ -0,0 +1,424 @@
+package neosync_benthos_aws
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/benthosdev/benthos/v4/public/service"
+	neosync_benthos_rowcount "github.com/nucleuscloud/neosync/worker/internal/benthos/rowcount"
+)
+
+const (
+	defaultMaxRetryAttempts = 3
+	defaultMaxRetryBackoff  = "20s"
+)
+
+func s3OutputSpec() *service.ConfigSpec {
+	return service.NewConfigSpec().
+		Summary("Uploads messages to an S3 bucket using multipart uploads with tunable part sizes, concurrency, and retries").
+		Field(service.NewStringField("bucket")).
+		Field(service.NewInterpolatedStringField("path")).
+		Field(service.NewStringField("region").Default("")).
+		Field(service.NewStringField("endpoint").Default("")).
+		Field(service.NewBoolField("force_path_style").Default(false)).
+		Field(service.NewBoolField("insecure_skip_verify").Default(false)).
+		Field(service.NewObjectField("credentials",
+			service.NewStringField("profile").Default(""),
+			service.NewStringField("id").Default(""),
+			service.NewStringField("secret").Default(""),
+			service.NewStringField("token").Default(""),
+			service.NewBoolField("from_ec2_role").Default(false),
+			service.NewStringField("role").Default(""),
+			service.NewStringField("role_external_id").Default(""),
+		).Optional()).
+		Field(service.NewIntField("part_size").Default(int(manager.DefaultUploadPartSize))).
+		Field(service.NewIntField("upload_concurrency").Default(manager.DefaultUploadConcurrency)).
+		Field(service.NewIntField("max_retry_attempts").Default(defaultMaxRetryAttempts)).
+		Field(service.NewDurationField("max_retry_backoff").Default(defaultMaxRetryBackoff)).
+		Field(service.NewBoolField("disable_checksums").Default(false)).
+		Field(service.NewObjectField("manifest",
+			service.NewStringField("path"),
+			service.NewStringField("job_run_id").Default(""),
+			service.NewStringField("schema").Default(""),
+			service.NewStringField("table").Default(""),
+		).Optional()).
+		Field(service.NewIntField("max_in_flight").Default(64)).
+		Field(service.NewBatchPolicyField("batching"))
+}
+
+// Registers an output on a benthos environment called neosync_aws_s3
+func RegisterS3Output(env *service.Environment, tracker *neosync_benthos_rowcount.Tracker) error {
+	return env.RegisterBatchOutput(
+		"neosync_aws_s3", s3OutputSpec(),
+		func(conf *service.ParsedConfig, mgr *service.Resources) (service.BatchOutput, service.BatchPolicy, int, error) {
+			batchPolicy, err := conf.FieldBatchPolicy("batching")
+			if err != nil {
+				return nil, batchPolicy, -1, err
+			}
+
+			maxInFlight, err := conf.FieldInt("max_in_flight")
+			if err != nil {
+				return nil, service.BatchPolicy{}, -1, err
+			}
+			out, err := newS3Output(conf, mgr)
+			if err != nil {
+				return nil, service.BatchPolicy{}, -1, err
+			}
+			out.rowCounter = tracker.NewOutputCounter("neosync_aws_s3", "")
+			return out, batchPolicy, maxInFlight, nil
+		},
+	)
+}
+
+type s3Uploader interface {
+	Upload(ctx context.Context, input *s3.PutObjectInput, opts ...func(*manager.Uploader)) (*manager.UploadOutput, error)
+}
+
+var _ service.BatchOutput = &s3Output{}
+
+type s3Output struct {
+	logger *service.Logger
+
+	bucket    string
+	path      *service.InterpolatedString
+	checksums bool
+	// nil if the output does not write a manifest
+	manifest *s3Manifest
+	// nil if rows are not being counted
+	rowCounter *neosync_benthos_rowcount.OutputCounter
+
+	// builds the uploader when the output connects
+	uploaderCtor func(ctx context.Context) (s3Uploader, error)
+	uploaderMut  sync.RWMutex
+	uploader     s3Uploader
+}
+
+type s3UploadConfig struct {
+	region      string
+	endpoint    string
+	credentials *s3Credentials
+
+	forcePathStyle     bool
+	insecureSkipVerify bool
+
+	partSize         int64
+	concurrency      int
+	maxRetryAttempts int
+	maxRetryBackoff  time.Duration
+}
+
+type s3Credentials struct {
+	profile        string
+	id             string
+	secret         string
+	token          string
+	fromEc2Role    bool
+	role           string
+	roleExternalId string
+}
+
+func newS3Output(conf *service.ParsedConfig, mgr *service.Resources) (*s3Output, error) {
+	bucket, err := conf.FieldString("bucket")
+	if err != nil {
+		return nil, err
+	}
+	path, err := conf.FieldInterpolatedString("path")
+	if err != nil {
+		return nil, err
+	}
+	disableChecksums, err := conf.FieldBool("disable_checksums")
+	if err != nil {
+		return nil, err
+	}
+	uploadConfig, err := getS3UploadConfig(conf)
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := getS3Manifest(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3Output{
+		logger:    mgr.Logger(),
+		bucket:    bucket,
+		path:      path,
+		checksums: !disableChecksums,
+		manifest:  manifest,
+		uploaderCtor: func(ctx context.Context) (s3Uploader, error) {
+			return newS3Uploader(ctx, uploadConfig)
+		},
+	}, nil
+}
+
+func getS3UploadConfig(conf *service.ParsedConfig) (*s3UploadConfig, error) {
+	region, err := conf.FieldString("region")
+	if err != nil {
+		return nil, err
+	}
+	endpoint, err := conf.FieldString("endpoint")
+	if err != nil {
+		return nil, err
+	}
+	forcePathStyle, err := conf.FieldBool("force_path_style")
+	if err != nil {
+		return nil, err
+	}
+	insecureSkipVerify, err := conf.FieldBool("insecure_skip_verify")
+	if err != nil {
+		return nil, err
+	}
+	partSize, err := conf.FieldInt("part_size")
+	if err != nil {
+		return nil, err
+	}
+	if int64(partSize) < manager.MinUploadPartSize {
+		return nil, fmt.Errorf("part_size must be at least %d bytes", manager.MinUploadPartSize)
+	}
+	concurrency, err := conf.FieldInt("upload_concurrency")
+	if err != nil {
+		return nil, err
+	}
+	if concurrency < 1 {
+		return nil, errors.New("upload_concurrency must be at least 1")
+	}
+	maxRetryAttempts, err := conf.FieldInt("max_retry_attempts")
+	if err != nil {
+		return nil, err
+	}
+	if maxRetryAttempts < 1 {
+		return nil, errors.New("max_retry_attempts must be at least 1")
+	}
+	maxRetryBackoff, err := conf.FieldDuration("max_retry_backoff")
+	if err != nil {
+		return nil, err
+	}
+
+	var creds *s3Credentials
+	if conf.Contains("credentials") {
+		credsConf := conf.Namespace("credentials")
+		creds = &s3Credentials{}
+		if creds.profile, err = credsConf.FieldString("profile"); err != nil {
+			return nil, err
+		}
+		if creds.id, err = credsConf.FieldString("id"); err != nil {
+			return nil, err
+		}
+		if creds.secret, err = credsConf.FieldString("secret"); err != nil {
+			return nil, err
+		}
+		if creds.token, err = credsConf.FieldString("token"); err != nil {
+			return nil, err
+		}
+		if creds.fromEc2Role, err = credsConf.FieldBool("from_ec2_role"); err != nil {
+			return nil, err
+		}
+		if creds.role, err = credsConf.FieldString("role"); err != nil {
+			return nil, err
+		}
+		if creds.roleExternalId, err = credsConf.FieldString("role_external_id"); err != nil {
+			return nil, err
+		}
+	}
+
+	return &s3UploadConfig{
+		region:             region,
+		endpoint:           endpoint,
+		credentials:        creds,
+		forcePathStyle:     forcePathStyle,
+		insecureSkipVerify: insecureSkipVerify,
+		partSize:           int64(partSize),
+		concurrency:        concurrency,
+		maxRetryAttempts:   maxRetryAttempts,
+		maxRetryBackoff:    maxRetryBackoff,
+	}, nil
+}
+
+func newS3Uploader(ctx context.Context, config *s3UploadConfig) (s3Uploader, error) {
+	awsCfg, err := getAwsConfig(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	client := s3.NewFromConfig(*awsCfg, func(o *s3.Options) {
+		o.UsePathStyle = config.forcePathStyle
+		o.Retryer = retry.AddWithErrorCodes(
+			retry.NewStandard(func(so *retry.StandardOptions) {
+				so.MaxAttempts = config.maxRetryAttempts
+				so.MaxBackoff = config.maxRetryBackoff
+				// the default token bucket gives up on retries after a burst of failures, which is the norm on a flaky link
+				so.RateLimiter = ratelimit.None
+			}),
+			// returned by S3 when a part's checksum does not match its contents
+			"BadDigest",
+		)
+	})
+	return manager.NewUploader(client, func(u *manager.Uploader) {
+		u.PartSize = config.partSize
+		u.Concurrency = config.concurrency
+	}), nil
+}
+
+func getAwsConfig(ctx context.Context, config *s3UploadConfig) (*aws.Config, error) {
+	awsCfg := aws.NewConfig()
+
+	creds := config.credentials
+	if creds == nil {
+		creds = &s3Credentials{}
+	}
+	if creds.profile != "" {
+		cfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithSharedConfigProfile(creds.profile))
+		if err != nil {
+			return nil, err
+		}
+		awsCfg = &cfg
+	} else if creds.id != "" {
+		awsCfg.Credentials = aws.NewCredentialsCache(credentials.NewStaticCredentialsProvider(creds.id, creds.secret, creds.token))
+	}
+
+	if config.region != "" {
+		awsCfg.Region = config.region
+	}
+	if config.endpoint != "" {
+		awsCfg.BaseEndpoint = aws.String(config.endpoint)
+	}
+	if config.insecureSkipVerify {
+		awsCfg.HTTPClient = awshttp.NewBuildableClient().WithTransportOptions(func(tr *http.Transport) {
+			if tr.TLSClientConfig == nil {
+				tr.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+			}
+			tr.TLSClientConfig.InsecureSkipVerify = true //nolint:gosec
+		})
+	}
+
+	if creds.role != "" {
+		awsCfg.Credentials = aws.NewCredentialsCache(
+			stscreds.NewAssumeRoleProvider(sts.NewFromConfig(*awsCfg), creds.role, func(aro *stscreds.AssumeRoleOptions) {
+				if creds.roleExternalId != "" {
+					aro.ExternalID = aws.String(creds.roleExternalId)
+				}
+				aro.RoleSessionName = "neosync-sync"
+			}),
+		)
+	}
+
+	if creds.fromEc2Role {
+		awsCfg.Credentials = ec2rolecreds.New()
+	}
+
+	return awsCfg, nil
+}
+
+func (s *s3Output) Connect(ctx context.Context) error {
+	s.uploaderMut.Lock()
+	defer s.uploaderMut.Unlock()
+	if s.uploader != nil {
+		return nil
+	}
+	uploader, err := s.uploaderCtor(ctx)
+	if err != nil {
+		return err
+	}
+	s.uploader = uploader
+	return nil
+}
+
+func (s *s3Output) WriteBatch(ctx context.Context, batch service.MessageBatch) error {
+	s.uploaderMut.RLock()
+	uploader := s.uploader
+	s.uploaderMut.RUnlock()
+	if uploader == nil {
+		return service.ErrNotConnected
+	}
+
+	var written int64
+	for i, msg := range batch {
+		path, err := batch.TryInterpolatedString(i, s.path)
+		if err != nil {
+			return fmt.Errorf("path interpolation error: %w", err)
+		}
+		body, err := msg.AsBytes()
+		if err != nil {
+			return err
+		}
+		input := &s3.PutObjectInput{
+			Bucket: aws.String(s.bucket),
+			Key:    aws.String(strings.TrimPrefix(path, "/")),
+			Body:   bytes.NewReader(body),
+		}
+		if s.checksums {
+			// the sdk sends the checksum of each part as a trailer that S3 validates before accepting the part
+			input.ChecksumAlgorithm = types.ChecksumAlgorithmSha256
+		}
+		if _, err := uploader.Upload(ctx, input); err != nil {
+			return fmt.Errorf("unable to upload %s to s3: %w", path, err)
+		}
+		if s.manifest == nil && s.rowCounter == nil {
+			continue
+		}
+		rowCount, columns, err := readRows(body)
+		if err != nil {
+			return fmt.Errorf("unable to read rows of %s: %w", path, err)
+		}
+		written += rowCount
+		if s.manifest != nil {
+			s.manifest.addFile(aws.ToString(input.Key), body, rowCount, columns)
+		}
+	}
+	// rows are only counted once the whole batch has been uploaded as benthos retries failed batches in full
+	s.rowCounter.AddWritten(written)
+	return nil
+}
+
+func (s *s3Output) Close(ctx context.Context) error {
+	s.uploaderMut.Lock()
+	defer s.uploaderMut.Unlock()
+	if s.uploader != nil && s.manifest != nil {
+		if err := s.writeManifest(ctx, s.uploader); err != nil {
+			return err
+		}
+	}
+	s.uploader = nil
+	return nil
+}
+
+func (s *s3Output) writeManifest(ctx context.Context, uploader s3Uploader) error {
+	body, err := s.manifest.marshal()
+	if err != nil {
+		return err
+	}
+	if body == nil {
+		return nil
+	}
+	input := &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(s.manifest.key),
+		Body:        bytes.NewReader(body),
+		ContentType: aws.String("application/json"),
+	}
+	if s.checksums {
+		input.ChecksumAlgorithm = types.ChecksumAlgorithmSha256
+	}
+	if _, err := uploader.Upload(ctx, input); err != nil {
+		return fmt.Errorf("unable to upload manifest %s to s3: %w", s.manifest.key, err)
+	}
+	return nil
+}
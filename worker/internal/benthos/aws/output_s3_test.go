@@ -0,0 +1,236 @@
+package neosync_benthos_aws
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/benthosdev/benthos/v4/public/service"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	neosync_benthos_rowcount "github.com/nucleuscloud/neosync/worker/internal/benthos/rowcount"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+type fakeUploader struct {
+	inputs []*s3.PutObjectInput
+	bodies []string
+}
+
+func (f *fakeUploader) Upload(ctx context.Context, input *s3.PutObjectInput, opts ...func(*manager.Uploader)) (*manager.UploadOutput, error) {
+	body, err := io.ReadAll(input.Body)
+	if err != nil {
+		return nil, err
+	}
+	f.inputs = append(f.inputs, input)
+	f.bodies = append(f.bodies, string(body))
+	return &manager.UploadOutput{}, nil
+}
+
+func newTestS3Output(t *testing.T, conf string, uploader s3Uploader) *s3Output {
+	t.Helper()
+	config, err := s3OutputSpec().ParseYAML(conf, service.NewEnvironment())
+	require.NoError(t, err)
+	out, err := newS3Output(config, service.MockResources())
+	require.NoError(t, err)
+	out.uploaderCtor = func(ctx context.Context) (s3Uploader, error) {
+		return uploader, nil
+	}
+	return out
+}
+
+func Test_S3Output_UploadConfig_Defaults(t *testing.T) {
+	config, err := s3OutputSpec().ParseYAML(`
+bucket: test-bucket
+path: /foo.txt
+`, service.NewEnvironment())
+	require.NoError(t, err)
+
+	uploadConfig, err := getS3UploadConfig(config)
+	require.NoError(t, err)
+	require.Equal(t, manager.DefaultUploadPartSize, uploadConfig.partSize)
+	require.Equal(t, manager.DefaultUploadConcurrency, uploadConfig.concurrency)
+	require.Equal(t, defaultMaxRetryAttempts, uploadConfig.maxRetryAttempts)
+	require.Equal(t, 20*time.Second, uploadConfig.maxRetryBackoff)
+	require.Nil(t, uploadConfig.credentials)
+}
+
+func Test_S3Output_UploadConfig_Tuned(t *testing.T) {
+	config, err := s3OutputSpec().ParseYAML(`
+bucket: test-bucket
+path: /foo.txt
+part_size: 10485760
+upload_concurrency: 10
+max_retry_attempts: 8
+max_retry_backoff: 45s
+credentials:
+  id: access-key
+  secret: secret
+`, service.NewEnvironment())
+	require.NoError(t, err)
+
+	uploadConfig, err := getS3UploadConfig(config)
+	require.NoError(t, err)
+	require.Equal(t, int64(10485760), uploadConfig.partSize)
+	require.Equal(t, 10, uploadConfig.concurrency)
+	require.Equal(t, 8, uploadConfig.maxRetryAttempts)
+	require.Equal(t, 45*time.Second, uploadConfig.maxRetryBackoff)
+	require.NotNil(t, uploadConfig.credentials)
+	require.Equal(t, "access-key", uploadConfig.credentials.id)
+}
+
+func Test_S3Output_UploadConfig_S3Compatible(t *testing.T) {
+	config, err := s3OutputSpec().ParseYAML(`
+bucket: test-bucket
+path: /foo.txt
+region: us-east-1
+endpoint: https://minio.internal:9000
+force_path_style: true
+insecure_skip_verify: true
+`, service.NewEnvironment())
+	require.NoError(t, err)
+
+	uploadConfig, err := getS3UploadConfig(config)
+	require.NoError(t, err)
+	require.Equal(t, "https://minio.internal:9000", uploadConfig.endpoint)
+	require.True(t, uploadConfig.forcePathStyle)
+	require.True(t, uploadConfig.insecureSkipVerify)
+
+	awsCfg, err := getAwsConfig(context.Background(), uploadConfig)
+	require.NoError(t, err)
+	require.Equal(t, "https://minio.internal:9000", *awsCfg.BaseEndpoint)
+	require.Equal(t, "us-east-1", awsCfg.Region)
+	require.NotNil(t, awsCfg.HTTPClient)
+}
+
+func Test_S3Output_UploadConfig_PartSizeTooSmall(t *testing.T) {
+	config, err := s3OutputSpec().ParseYAML(`
+bucket: test-bucket
+path: /foo.txt
+part_size: 1024
+`, service.NewEnvironment())
+	require.NoError(t, err)
+
+	_, err = getS3UploadConfig(config)
+	require.Error(t, err)
+}
+
+func Test_S3Output_WriteBatch(t *testing.T) {
+	uploader := &fakeUploader{}
+	out := newTestS3Output(t, `
+bucket: test-bucket
+path: /workflows/${! meta("table") }/data.txt.gz
+`, uploader)
+
+	ctx := context.Background()
+	require.NoError(t, out.Connect(ctx))
+
+	msg := service.NewMessage([]byte("content"))
+	msg.MetaSet("table", "public.users")
+	require.NoError(t, out.WriteBatch(ctx, service.MessageBatch{msg}))
+
+	require.Len(t, uploader.inputs, 1)
+	require.Equal(t, "test-bucket", *uploader.inputs[0].Bucket)
+	require.Equal(t, "workflows/public.users/data.txt.gz", *uploader.inputs[0].Key)
+	require.Equal(t, types.ChecksumAlgorithmSha256, uploader.inputs[0].ChecksumAlgorithm)
+	require.Equal(t, "content", uploader.bodies[0])
+	require.NoError(t, out.Close(ctx))
+}
+
+func Test_S3Output_WriteBatch_DisableChecksums(t *testing.T) {
+	uploader := &fakeUploader{}
+	out := newTestS3Output(t, `
+bucket: test-bucket
+path: /data.txt.gz
+disable_checksums: true
+`, uploader)
+
+	ctx := context.Background()
+	require.NoError(t, out.Connect(ctx))
+	require.NoError(t, out.WriteBatch(ctx, service.MessageBatch{service.NewMessage([]byte("content"))}))
+
+	require.Len(t, uploader.inputs, 1)
+	require.Empty(t, uploader.inputs[0].ChecksumAlgorithm)
+}
+
+func Test_S3Output_WriteBatch_NotConnected(t *testing.T) {
+	out := newTestS3Output(t, `
+bucket: test-bucket
+path: /data.txt.gz
+`, &fakeUploader{})
+
+	err := out.WriteBatch(context.Background(), service.MessageBatch{service.NewMessage([]byte("content"))})
+	require.ErrorIs(t, err, service.ErrNotConnected)
+}
+
+func Test_S3Output_Manifest(t *testing.T) {
+	uploader := &fakeUploader{}
+	out := newTestS3Output(t, `
+bucket: test-bucket
+path: /workflows/123/activities/public.users/data/1.txt.gz
+manifest:
+  path: /workflows/123/activities/public.users/manifest.json
+  job_run_id: "123"
+  schema: public
+  table: users
+`, uploader)
+	tracker := neosync_benthos_rowcount.NewTracker()
+	out.rowCounter = tracker.NewOutputCounter("neosync_aws_s3", "")
+
+	ctx := context.Background()
+	require.NoError(t, out.Connect(ctx))
+
+	var buf bytes.Buffer
+	gzw := gzip.NewWriter(&buf)
+	_, err := gzw.Write([]byte("{\"name\":\"a\",\"id\":1}\n{\"name\":\"b\",\"id\":2}\n"))
+	require.NoError(t, err)
+	require.NoError(t, gzw.Close())
+	data := buf.Bytes()
+
+	require.NoError(t, out.WriteBatch(ctx, service.MessageBatch{service.NewMessage(data)}))
+	require.NoError(t, out.Close(ctx))
+
+	require.Len(t, uploader.inputs, 2)
+	require.Equal(t, "workflows/123/activities/public.users/manifest.json", *uploader.inputs[1].Key)
+
+	manifest := &mgmtv1alpha1.TableRunManifest{}
+	require.NoError(t, protojson.Unmarshal([]byte(uploader.bodies[1]), manifest))
+	require.Equal(t, "123", manifest.GetJobRunId())
+	require.Equal(t, "public", manifest.GetSchema())
+	require.Equal(t, "users", manifest.GetTable())
+	require.Equal(t, []string{"id", "name"}, manifest.GetColumns())
+	fingerprint := sha256.Sum256([]byte("id,name"))
+	require.Equal(t, hex.EncodeToString(fingerprint[:]), manifest.GetSchemaFingerprint())
+	require.Equal(t, int64(2), manifest.GetRowCount())
+	require.Equal(t, int64(len(data)), manifest.GetSizeBytes())
+
+	require.Len(t, manifest.GetFiles(), 1)
+	sum := sha256.Sum256(data)
+	require.Equal(t, "workflows/123/activities/public.users/data/1.txt.gz", manifest.GetFiles()[0].GetKey())
+	require.Equal(t, hex.EncodeToString(sum[:]), manifest.GetFiles()[0].GetSha256())
+	require.Equal(t, int64(2), manifest.GetFiles()[0].GetRowCount())
+	require.Equal(t, int64(2), tracker.Outputs()[0].Written)
+}
+
+func Test_S3Output_Manifest_NoFiles(t *testing.T) {
+	uploader := &fakeUploader{}
+	out := newTestS3Output(t, `
+bucket: test-bucket
+path: /data.txt.gz
+manifest:
+  path: /manifest.json
+`, uploader)
+
+	ctx := context.Background()
+	require.NoError(t, out.Connect(ctx))
+	require.NoError(t, out.Close(ctx))
+	require.Empty(t, uploader.inputs)
+}
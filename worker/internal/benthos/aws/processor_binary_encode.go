@@ -0,0 +1,48 @@
+package neosync_benthos_aws
+
+import (
+	"context"
+
+	"github.com/benthosdev/benthos/v4/public/service"
+	"github.com/nucleuscloud/neosync/backend/pkg/binaryjson"
+)
+
+func binaryEncodeProcessorSpec() *service.ConfigSpec {
+	return service.NewConfigSpec().
+		Summary(`Encodes the binary values of each row so that the row can be written as JSON`)
+}
+
+// Registers a processor on a benthos environment called neosync_binary_encode
+func RegisterBinaryEncodeProcessor(env *service.Environment) error {
+	return env.RegisterBatchProcessor(
+		"neosync_binary_encode", binaryEncodeProcessorSpec(),
+		func(conf *service.ParsedConfig, mgr *service.Resources) (service.BatchProcessor, error) {
+			return &binaryEncodeProcessor{}, nil
+		})
+}
+
+type binaryEncodeProcessor struct{}
+
+func (b *binaryEncodeProcessor) ProcessBatch(_ context.Context, batch service.MessageBatch) ([]service.MessageBatch, error) {
+	for _, msg := range batch {
+		root, err := msg.AsStructuredMut()
+		if err != nil {
+			return nil, err
+		}
+		row, ok := root.(map[string]any)
+		if !ok {
+			continue
+		}
+		for col, value := range row {
+			if bits, ok := value.([]byte); ok {
+				row[col] = binaryjson.Encode(bits)
+			}
+		}
+		msg.SetStructuredMut(row)
+	}
+	return []service.MessageBatch{batch}, nil
+}
+
+func (b *binaryEncodeProcessor) Close(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,25 @@
+package neosync_benthos_aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/service"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BinaryEncodeProcessor(t *testing.T) {
+	processor := &binaryEncodeProcessor{}
+
+	msg := service.NewMessage(nil)
+	msg.SetStructuredMut(map[string]any{"id": 1, "name": "nick", "avatar": []byte{0x00, 0xff}})
+	out, err := processor.ProcessBatch(context.Background(), service.MessageBatch{msg})
+	require.NoError(t, err)
+	require.Len(t, out, 1)
+	require.Len(t, out[0], 1)
+
+	bits, err := out[0][0].AsBytes()
+	require.NoError(t, err)
+	require.JSONEq(t, `{"id": 1, "name": "nick", "avatar": {"$neosync_binary": "AP8="}}`, string(bits))
+	require.NoError(t, processor.Close(context.Background()))
+}
@@ -0,0 +1,53 @@
+package neosync_benthos_aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/benthosdev/benthos/v4/public/service"
+	"github.com/nucleuscloud/neosync/backend/pkg/nonfinite"
+)
+
+func nonFiniteProcessorSpec() *service.ConfigSpec {
+	return service.NewConfigSpec().
+		Summary(`Applies the non-finite number policy to the NaN and infinite values of each row so that the row can be written as JSON`)
+}
+
+// Registers a processor on a benthos environment called neosync_non_finite
+func RegisterNonFiniteProcessor(env *service.Environment, policy nonfinite.Policy) error {
+	return env.RegisterBatchProcessor(
+		"neosync_non_finite", nonFiniteProcessorSpec(),
+		func(conf *service.ParsedConfig, mgr *service.Resources) (service.BatchProcessor, error) {
+			return &nonFiniteProcessor{policy: policy}, nil
+		})
+}
+
+type nonFiniteProcessor struct {
+	policy nonfinite.Policy
+}
+
+func (n *nonFiniteProcessor) ProcessBatch(_ context.Context, batch service.MessageBatch) ([]service.MessageBatch, error) {
+	for _, msg := range batch {
+		root, err := msg.AsStructuredMut()
+		if err != nil {
+			return nil, err
+		}
+		row, ok := root.(map[string]any)
+		if !ok {
+			continue
+		}
+		for col, value := range row {
+			newValue, err := n.policy.ApplyJSON(value)
+			if err != nil {
+				return nil, fmt.Errorf("unable to write column %s: %w", col, err)
+			}
+			row[col] = newValue
+		}
+		msg.SetStructuredMut(row)
+	}
+	return []service.MessageBatch{batch}, nil
+}
+
+func (n *nonFiniteProcessor) Close(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,35 @@
+package neosync_benthos_aws
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/service"
+	"github.com/nucleuscloud/neosync/backend/pkg/nonfinite"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NonFiniteProcessor(t *testing.T) {
+	msg := service.NewMessage(nil)
+	msg.SetStructuredMut(map[string]any{"id": 1, "score": math.NaN(), "ratio": math.Inf(-1)})
+
+	processor := &nonFiniteProcessor{policy: nonfinite.PolicyPreserve}
+	out, err := processor.ProcessBatch(context.Background(), service.MessageBatch{msg.Copy()})
+	require.NoError(t, err)
+	bits, err := out[0][0].AsBytes()
+	require.NoError(t, err)
+	require.JSONEq(t, `{"id": 1, "score": "NaN", "ratio": "-Infinity"}`, string(bits))
+
+	processor = &nonFiniteProcessor{policy: nonfinite.PolicyNull}
+	out, err = processor.ProcessBatch(context.Background(), service.MessageBatch{msg.Copy()})
+	require.NoError(t, err)
+	bits, err = out[0][0].AsBytes()
+	require.NoError(t, err)
+	require.JSONEq(t, `{"id": 1, "score": null, "ratio": null}`, string(bits))
+
+	processor = &nonFiniteProcessor{policy: nonfinite.PolicyFail}
+	_, err = processor.ProcessBatch(context.Background(), service.MessageBatch{msg.Copy()})
+	require.ErrorIs(t, err, nonfinite.ErrNonFiniteValue)
+	require.NoError(t, processor.Close(context.Background()))
+}
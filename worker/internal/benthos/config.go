@@ -54,10 +54,46 @@ type InputConfig struct {
 }
 
 type Inputs struct {
-	SqlSelect      *SqlSelect         `json:"sql_select,omitempty" yaml:"sql_select,omitempty"`
-	PooledSqlRaw   *InputPooledSqlRaw `json:"pooled_sql_raw,omitempty" yaml:"pooled_sql_raw,omitempty"`
-	Generate       *Generate          `json:"generate,omitempty" yaml:"generate,omitempty"`
-	OpenAiGenerate *OpenAiGenerate    `json:"openai_generate,omitempty" yaml:"openai_generate,omitempty"`
+	SqlSelect          *SqlSelect           `json:"sql_select,omitempty" yaml:"sql_select,omitempty"`
+	PooledSqlRaw       *InputPooledSqlRaw   `json:"pooled_sql_raw,omitempty" yaml:"pooled_sql_raw,omitempty"`
+	PooledSqlPaged     *InputPooledSqlPaged `json:"pooled_sql_paged,omitempty" yaml:"pooled_sql_paged,omitempty"`
+	Generate           *Generate            `json:"generate,omitempty" yaml:"generate,omitempty"`
+	OpenAiGenerate     *OpenAiGenerate      `json:"openai_generate,omitempty" yaml:"openai_generate,omitempty"`
+	SynthesizeGenerate *SynthesizeGenerate  `json:"synthesize_generate,omitempty" yaml:"synthesize_generate,omitempty"`
+
+	NeosyncConnectionData *NeosyncConnectionData `json:"neosync_connection_data,omitempty" yaml:"neosync_connection_data,omitempty"`
+	NeosyncPlugin         *NeosyncPluginInput    `json:"neosync_plugin,omitempty" yaml:"neosync_plugin,omitempty"`
+
+	Broker *InputBrokerConfig `json:"broker,omitempty" yaml:"broker,omitempty"`
+}
+
+type InputBrokerConfig struct {
+	Inputs   []Inputs  `json:"inputs" yaml:"inputs"`
+	Batching *Batching `json:"batching,omitempty" yaml:"batching,omitempty"`
+}
+
+type NeosyncPluginInput struct {
+	Plugin  string            `json:"plugin" yaml:"plugin"`
+	Schema  string            `json:"schema" yaml:"schema"`
+	Table   string            `json:"table" yaml:"table"`
+	Columns []string          `json:"columns" yaml:"columns"`
+	Options map[string]string `json:"options,omitempty" yaml:"options,omitempty"`
+}
+
+type NeosyncConnectionData struct {
+	ConnectionId string `json:"connection_id" yaml:"connection_id"`
+	JobRunId     string `json:"job_run_id" yaml:"job_run_id"`
+	Schema       string `json:"schema" yaml:"schema"`
+	Table        string `json:"table" yaml:"table"`
+}
+
+type SynthesizeGenerate struct {
+	Model      *string  `json:"model,omitempty" yaml:"model,omitempty"`
+	ServiceUrl *string  `json:"service_url,omitempty" yaml:"service_url,omitempty"`
+	ModelId    *string  `json:"model_id,omitempty" yaml:"model_id,omitempty"`
+	Columns    []string `json:"columns" yaml:"columns"`
+	Count      int      `json:"count" yaml:"count"`
+	BatchSize  int      `json:"batch_size" yaml:"batch_size"`
 }
 
 type OpenAiGenerate struct {
@@ -86,6 +122,16 @@ type InputPooledSqlRaw struct {
 	ArgsMapping string `json:"args_mapping,omitempty" yaml:"args_mapping,omitempty"`
 }
 
+type InputPooledSqlPaged struct {
+	Driver     string   `json:"driver" yaml:"driver"`
+	Dsn        string   `json:"dsn" yaml:"dsn"`
+	Schema     string   `json:"schema" yaml:"schema"`
+	Table      string   `json:"table" yaml:"table"`
+	Columns    []string `json:"columns" yaml:"columns"`
+	KeyColumns []string `json:"key_columns" yaml:"key_columns"`
+	PageSize   int      `json:"page_size" yaml:"page_size"`
+}
+
 type SqlSelect struct {
 	Driver        string   `json:"driver" yaml:"driver"`
 	Dsn           string   `json:"dsn" yaml:"dsn"`
@@ -104,15 +150,26 @@ type PipelineConfig struct {
 }
 
 type ProcessorConfig struct {
-	Mutation   *string               `json:"mutation,omitempty" yaml:"mutation,omitempty"`
-	Javascript *JavascriptConfig     `json:"javascript,omitempty" yaml:"javascript,omitempty"`
-	Branch     *BranchConfig         `json:"branch,omitempty" yaml:"branch,omitempty"`
-	Cache      *CacheConfig          `json:"cache,omitempty" yaml:"cache,omitempty"`
-	Mapping    *string               `json:"mapping,omitempty" yaml:"mapping,omitempty"`
-	Redis      *RedisProcessorConfig `json:"redis,omitempty" yaml:"redis,omitempty"`
-	Error      *ErrorProcessorConfig `json:"error,omitempty" yaml:"error,omitempty"`
-	Catch      []*ProcessorConfig    `json:"catch,omitempty" yaml:"catch,omitempty"`
-	While      *WhileProcessorConfig `json:"while,omitempty" yaml:"while,omitempty"`
+	Mutation          *string                  `json:"mutation,omitempty" yaml:"mutation,omitempty"`
+	Javascript        *JavascriptConfig        `json:"javascript,omitempty" yaml:"javascript,omitempty"`
+	NeosyncJavascript *NeosyncJavascriptConfig `json:"neosync_javascript,omitempty" yaml:"neosync_javascript,omitempty"`
+	Branch            *BranchConfig            `json:"branch,omitempty" yaml:"branch,omitempty"`
+	Cache             *CacheConfig             `json:"cache,omitempty" yaml:"cache,omitempty"`
+	Mapping           *string                  `json:"mapping,omitempty" yaml:"mapping,omitempty"`
+	Redis             *RedisProcessorConfig    `json:"redis,omitempty" yaml:"redis,omitempty"`
+	Error             *ErrorProcessorConfig    `json:"error,omitempty" yaml:"error,omitempty"`
+	Catch             []*ProcessorConfig       `json:"catch,omitempty" yaml:"catch,omitempty"`
+	While             *WhileProcessorConfig    `json:"while,omitempty" yaml:"while,omitempty"`
+	// Any other processor config, as provided by the user. When set, it is written out as-is
+	Raw map[string]any `json:"raw,omitempty" yaml:"-"`
+}
+
+func (p ProcessorConfig) MarshalYAML() (any, error) {
+	if p.Raw != nil {
+		return p.Raw, nil
+	}
+	type processorConfig ProcessorConfig
+	return processorConfig(p), nil
 }
 
 type WhileProcessorConfig struct {
@@ -161,6 +218,16 @@ type JavascriptConfig struct {
 	Code string `json:"code" yaml:"code"`
 }
 
+type NeosyncJavascriptConfig struct {
+	Code string `json:"code" yaml:"code"`
+	// When set, the code is run once for each group of up to this many messages of a batch
+	BatchSize int `json:"batch_size,omitempty" yaml:"batch_size,omitempty"`
+	// The code of the javascript libraries that the code may require, by library name
+	Libraries map[string]string `json:"libraries,omitempty" yaml:"libraries,omitempty"`
+	// Seeds Math.random so that the values that are generated are the same every time the run is retried
+	Seed *int64 `json:"seed,omitempty" yaml:"seed,omitempty"`
+}
+
 type OutputConfig struct {
 	Label      string `json:"label" yaml:"label"`
 	Outputs    `json:",inline" yaml:",inline"`
@@ -175,6 +242,8 @@ type Outputs struct {
 	PooledSqlInsert *PooledSqlInsert       `json:"pooled_sql_insert,omitempty" yaml:"pooled_sql_insert,omitempty"`
 	PooledSqlUpdate *PooledSqlUpdate       `json:"pooled_sql_update,omitempty" yaml:"pooled_sql_update,omitempty"`
 	AwsS3           *AwsS3Insert           `json:"aws_s3,omitempty" yaml:"aws_s3,omitempty"`
+	NeosyncAwsS3    *NeosyncAwsS3Insert    `json:"neosync_aws_s3,omitempty" yaml:"neosync_aws_s3,omitempty"`
+	KafkaFranz      *KafkaFranzOutput      `json:"kafka_franz,omitempty" yaml:"kafka_franz,omitempty"`
 	Retry           *RetryConfig           `json:"retry,omitempty" yaml:"retry,omitempty"`
 	Broker          *OutputBrokerConfig    `json:"broker,omitempty" yaml:"broker,omitempty"`
 	DropOn          *DropOnConfig          `json:"drop_on,omitempty" yaml:"drop_on,omitempty"`
@@ -184,6 +253,8 @@ type Outputs struct {
 	RedisHashOutput *RedisHashOutputConfig `json:"redis_hash_output,omitempty" yaml:"redis_hash_output,omitempty"`
 	Error           *ErrorOutputConfig     `json:"error,omitempty" yaml:"error,omitempty"`
 	Switch          *SwitchOutputConfig    `json:"switch,omitempty" yaml:"switch,omitempty"`
+	NeosyncPlugin   *NeosyncPluginOutput   `json:"neosync_plugin,omitempty" yaml:"neosync_plugin,omitempty"`
+	NeosyncDuckdb   *NeosyncDuckdbOutput   `json:"neosync_duckdb,omitempty" yaml:"neosync_duckdb,omitempty"`
 }
 
 type SwitchOutputConfig struct {
@@ -289,15 +360,17 @@ type PooledSqlUpdate struct {
 }
 
 type PooledSqlInsert struct {
-	Driver              string    `json:"driver" yaml:"driver"`
-	Dsn                 string    `json:"dsn" yaml:"dsn"`
-	Schema              string    `json:"schema" yaml:"schema"`
-	Table               string    `json:"table" yaml:"table"`
-	Columns             []string  `json:"columns" yaml:"columns"`
-	OnConflictDoNothing bool      `json:"on_conflict_do_nothing" yaml:"on_conflict_do_nothing"`
-	TruncateOnRetry     bool      `json:"truncate_on_retry" yaml:"truncate_on_retry"`
-	ArgsMapping         string    `json:"args_mapping" yaml:"args_mapping"`
-	Batching            *Batching `json:"batching,omitempty" yaml:"batching,omitempty"`
+	Driver              string            `json:"driver" yaml:"driver"`
+	Dsn                 string            `json:"dsn" yaml:"dsn"`
+	Schema              string            `json:"schema" yaml:"schema"`
+	Table               string            `json:"table" yaml:"table"`
+	Columns             []string          `json:"columns" yaml:"columns"`
+	ColumnExpressions   map[string]string `json:"column_expressions,omitempty" yaml:"column_expressions,omitempty"`
+	OnConflictDoNothing bool              `json:"on_conflict_do_nothing" yaml:"on_conflict_do_nothing"`
+	TruncateOnRetry     bool              `json:"truncate_on_retry" yaml:"truncate_on_retry"`
+	ArgsMapping         string            `json:"args_mapping" yaml:"args_mapping"`
+	AdaptiveBatching    bool              `json:"adaptive_batching,omitempty" yaml:"adaptive_batching,omitempty"`
+	Batching            *Batching         `json:"batching,omitempty" yaml:"batching,omitempty"`
 }
 
 type SqlInsert struct {
@@ -314,6 +387,28 @@ type SqlInsert struct {
 	Batching        *Batching `json:"batching,omitempty" yaml:"batching,omitempty"`
 }
 
+type NeosyncPluginOutput struct {
+	Plugin      string            `json:"plugin" yaml:"plugin"`
+	Schema      string            `json:"schema" yaml:"schema"`
+	Table       string            `json:"table" yaml:"table"`
+	Columns     []string          `json:"columns" yaml:"columns"`
+	Options     map[string]string `json:"options,omitempty" yaml:"options,omitempty"`
+	ArgsMapping string            `json:"args_mapping,omitempty" yaml:"args_mapping,omitempty"`
+	MaxInFlight int               `json:"max_in_flight" yaml:"max_in_flight"`
+	Batching    *Batching         `json:"batching,omitempty" yaml:"batching,omitempty"`
+}
+
+type NeosyncDuckdbOutput struct {
+	Path             string    `json:"path,omitempty" yaml:"path,omitempty"`
+	ParquetDirectory string    `json:"parquet_directory,omitempty" yaml:"parquet_directory,omitempty"`
+	Schema           string    `json:"schema" yaml:"schema"`
+	Table            string    `json:"table" yaml:"table"`
+	Columns          []string  `json:"columns" yaml:"columns"`
+	ArgsMapping      string    `json:"args_mapping,omitempty" yaml:"args_mapping,omitempty"`
+	MaxInFlight      int       `json:"max_in_flight" yaml:"max_in_flight"`
+	Batching         *Batching `json:"batching,omitempty" yaml:"batching,omitempty"`
+}
+
 type AwsS3Insert struct {
 	Bucket      string    `json:"bucket" yaml:"bucket"`
 	MaxInFlight int       `json:"max_in_flight" yaml:"max_in_flight"`
@@ -326,6 +421,36 @@ type AwsS3Insert struct {
 	Credentials *AwsCredentials `json:"credentials,omitempty" yaml:"credentials,omitempty"`
 }
 
+type NeosyncAwsS3Insert struct {
+	Bucket      string    `json:"bucket" yaml:"bucket"`
+	MaxInFlight int       `json:"max_in_flight" yaml:"max_in_flight"`
+	Path        string    `json:"path" yaml:"path"`
+	Batching    *Batching `json:"batching,omitempty" yaml:"batching,omitempty"`
+
+	Region   string `json:"region,omitempty" yaml:"region,omitempty"`
+	Endpoint string `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
+
+	ForcePathStyle     bool `json:"force_path_style,omitempty" yaml:"force_path_style,omitempty"`
+	InsecureSkipVerify bool `json:"insecure_skip_verify,omitempty" yaml:"insecure_skip_verify,omitempty"`
+
+	Credentials *AwsCredentials `json:"credentials,omitempty" yaml:"credentials,omitempty"`
+
+	PartSize          *int64  `json:"part_size,omitempty" yaml:"part_size,omitempty"`
+	UploadConcurrency *int32  `json:"upload_concurrency,omitempty" yaml:"upload_concurrency,omitempty"`
+	MaxRetryAttempts  *int32  `json:"max_retry_attempts,omitempty" yaml:"max_retry_attempts,omitempty"`
+	MaxRetryBackoff   *string `json:"max_retry_backoff,omitempty" yaml:"max_retry_backoff,omitempty"`
+	DisableChecksums  bool    `json:"disable_checksums,omitempty" yaml:"disable_checksums,omitempty"`
+
+	Manifest *S3ManifestConfig `json:"manifest,omitempty" yaml:"manifest,omitempty"`
+}
+
+type S3ManifestConfig struct {
+	Path     string `json:"path" yaml:"path"`
+	JobRunId string `json:"job_run_id,omitempty" yaml:"job_run_id,omitempty"`
+	Schema   string `json:"schema,omitempty" yaml:"schema,omitempty"`
+	Table    string `json:"table,omitempty" yaml:"table,omitempty"`
+}
+
 type AwsCredentials struct {
 	Profile        string `json:"profile,omitempty" yaml:"profile,omitempty"`
 	Id             string `json:"id,omitempty" yaml:"id,omitempty"`
@@ -336,6 +461,39 @@ type AwsCredentials struct {
 	RoleExternalId string `json:"role_external_id,omitempty" yaml:"role_external_id,omitempty"`
 }
 
+type KafkaFranzOutput struct {
+	SeedBrokers []string            `json:"seed_brokers" yaml:"seed_brokers"`
+	Topic       string              `json:"topic" yaml:"topic"`
+	Key         string              `json:"key,omitempty" yaml:"key,omitempty"`
+	Metadata    *KafkaFranzMetadata `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+	MaxInFlight int                 `json:"max_in_flight" yaml:"max_in_flight"`
+	Compression string              `json:"compression,omitempty" yaml:"compression,omitempty"`
+	Batching    *Batching           `json:"batching,omitempty" yaml:"batching,omitempty"`
+	Tls         *KafkaFranzTls      `json:"tls,omitempty" yaml:"tls,omitempty"`
+	Sasl        []*KafkaFranzSasl   `json:"sasl,omitempty" yaml:"sasl,omitempty"`
+}
+
+type KafkaFranzMetadata struct {
+	IncludePatterns []string `json:"include_patterns,omitempty" yaml:"include_patterns,omitempty"`
+}
+
+type KafkaFranzTls struct {
+	Enabled     bool          `json:"enabled" yaml:"enabled"`
+	RootCas     string        `json:"root_cas,omitempty" yaml:"root_cas,omitempty"`
+	ClientCerts []*ClientCert `json:"client_certs,omitempty" yaml:"client_certs,omitempty"`
+}
+
+type ClientCert struct {
+	Cert string `json:"cert" yaml:"cert"`
+	Key  string `json:"key" yaml:"key"`
+}
+
+type KafkaFranzSasl struct {
+	Mechanism string `json:"mechanism" yaml:"mechanism"`
+	Username  string `json:"username" yaml:"username"`
+	Password  string `json:"password" yaml:"password"`
+}
+
 type Batching struct {
 	Count      int               `json:"count" yaml:"count"`
 	ByteSize   int               `json:"byte_size" yaml:"byte_size"`
@@ -345,10 +503,18 @@ type Batching struct {
 }
 
 type BatchProcessor struct {
-	Archive  *ArchiveProcessor  `json:"archive,omitempty" yaml:"archive,omitempty"`
-	Compress *CompressProcessor `json:"compress,omitempty" yaml:"compress,omitempty"`
+	NeosyncBinaryEncode  *NeosyncBinaryEncodeProcessor  `json:"neosync_binary_encode,omitempty" yaml:"neosync_binary_encode,omitempty"`
+	NeosyncNonFinite     *NeosyncNonFiniteProcessor     `json:"neosync_non_finite,omitempty" yaml:"neosync_non_finite,omitempty"`
+	Archive              *ArchiveProcessor              `json:"archive,omitempty" yaml:"archive,omitempty"`
+	Compress             *CompressProcessor             `json:"compress,omitempty" yaml:"compress,omitempty"`
+	Mutation             *string                        `json:"mutation,omitempty" yaml:"mutation,omitempty"`
+	SchemaRegistryEncode *SchemaRegistryEncodeProcessor `json:"schema_registry_encode,omitempty" yaml:"schema_registry_encode,omitempty"`
 }
 
+type NeosyncBinaryEncodeProcessor struct{}
+
+type NeosyncNonFiniteProcessor struct{}
+
 type ArchiveProcessor struct {
 	Format string  `json:"format" yaml:"format"`
 	Path   *string `json:"path,omitempty" yaml:"path,omitempty"`
@@ -358,6 +524,19 @@ type CompressProcessor struct {
 	Algorithm string `json:"algorithm" yaml:"algorithm"`
 }
 
+type SchemaRegistryEncodeProcessor struct {
+	Url         string     `json:"url" yaml:"url"`
+	Subject     string     `json:"subject" yaml:"subject"`
+	AvroRawJson bool       `json:"avro_raw_json" yaml:"avro_raw_json"`
+	BasicAuth   *BasicAuth `json:"basic_auth,omitempty" yaml:"basic_auth,omitempty"`
+}
+
+type BasicAuth struct {
+	Enabled  bool   `json:"enabled" yaml:"enabled"`
+	Username string `json:"username" yaml:"username"`
+	Password string `json:"password" yaml:"password"`
+}
+
 type OutputBrokerConfig struct {
 	Pattern string    `json:"pattern" yaml:"pattern"`
 	Outputs []Outputs `json:"outputs" yaml:"outputs"`
@@ -0,0 +1,334 @@
+package neosync_benthos_duckdb
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/benthosdev/benthos/v4/public/service"
+	"github.com/google/uuid"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	neosync_benthos_rowcount "github.com/nucleuscloud/neosync/worker/internal/benthos/rowcount"
+)
+
+func duckdbOutputSpec() *service.ConfigSpec {
+	return service.NewConfigSpec().
+		Summary("Writes a table to a DuckDB database, or as Parquet files to a directory when a parquet directory is provided").
+		Field(service.NewStringField("path").Default("")).
+		Field(service.NewStringField("parquet_directory").Default("")).
+		Field(service.NewStringField("schema")).
+		Field(service.NewStringField("table")).
+		Field(service.NewStringListField("columns")).
+		Field(service.NewBloblangField("args_mapping").Optional()).
+		Field(service.NewIntField("max_in_flight").Default(64)).
+		Field(service.NewBatchPolicyField("batching"))
+}
+
+// Registers an output on a benthos environment called neosync_duckdb
+func RegisterDuckdbOutput(env *service.Environment, tracker *neosync_benthos_rowcount.Tracker) error {
+	return env.RegisterBatchOutput(
+		"neosync_duckdb", duckdbOutputSpec(),
+		func(conf *service.ParsedConfig, mgr *service.Resources) (service.BatchOutput, service.BatchPolicy, int, error) {
+			batchPolicy, err := conf.FieldBatchPolicy("batching")
+			if err != nil {
+				return nil, batchPolicy, -1, err
+			}
+
+			maxInFlight, err := conf.FieldInt("max_in_flight")
+			if err != nil {
+				return nil, service.BatchPolicy{}, -1, err
+			}
+			out, err := newDuckdbOutput(conf)
+			if err != nil {
+				return nil, service.BatchPolicy{}, -1, err
+			}
+			out.rowCounter = tracker.NewOutputCounter("neosync_duckdb", "")
+			return out, batchPolicy, maxInFlight, nil
+		},
+	)
+}
+
+// A database can only be opened once per process, so every output that writes to the same database shares its handle
+type databaseRegistry struct {
+	mu        sync.Mutex
+	databases map[string]*sharedDatabase
+}
+
+type sharedDatabase struct {
+	db   *sql.DB
+	refs int
+}
+
+var databases = &databaseRegistry{databases: map[string]*sharedDatabase{}}
+
+func (r *databaseRegistry) acquire(path string) (*sql.DB, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if shared, ok := r.databases[path]; ok {
+		shared.refs++
+		return shared.db, nil
+	}
+	db, err := sql.Open(sql_manager.DuckdbDriver, path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open duckdb database: %w", err)
+	}
+	r.databases[path] = &sharedDatabase{db: db, refs: 1}
+	return db, nil
+}
+
+func (r *databaseRegistry) release(path string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	shared, ok := r.databases[path]
+	if !ok {
+		return nil
+	}
+	shared.refs--
+	if shared.refs > 0 {
+		return nil
+	}
+	delete(r.databases, path)
+	return shared.db.Close()
+}
+
+var _ service.BatchOutput = &duckdbOutput{}
+
+type duckdbOutput struct {
+	path             string
+	parquetDirectory string
+	schema           string
+	table            string
+	columns          []string
+	// when set, returns the row's values in the same order as the columns
+	argsMapping *bloblang.Executor
+	// nil if rows are not being counted
+	rowCounter *neosync_benthos_rowcount.OutputCounter
+
+	// batches are written one at a time so that the table is only created once
+	dbMut sync.Mutex
+	db    *sql.DB
+}
+
+func newDuckdbOutput(conf *service.ParsedConfig) (*duckdbOutput, error) {
+	path, err := conf.FieldString("path")
+	if err != nil {
+		return nil, err
+	}
+	parquetDirectory, err := conf.FieldString("parquet_directory")
+	if err != nil {
+		return nil, err
+	}
+	schema, err := conf.FieldString("schema")
+	if err != nil {
+		return nil, err
+	}
+	table, err := conf.FieldString("table")
+	if err != nil {
+		return nil, err
+	}
+	columns, err := conf.FieldStringList("columns")
+	if err != nil {
+		return nil, err
+	}
+	if len(columns) == 0 {
+		return nil, errors.New("must provide at least one column to write")
+	}
+	var argsMapping *bloblang.Executor
+	if conf.Contains("args_mapping") {
+		if argsMapping, err = conf.FieldBloblang("args_mapping"); err != nil {
+			return nil, err
+		}
+	}
+	return &duckdbOutput{
+		path:             path,
+		parquetDirectory: parquetDirectory,
+		schema:           schema,
+		table:            table,
+		columns:          columns,
+		argsMapping:      argsMapping,
+	}, nil
+}
+
+func (d *duckdbOutput) Connect(ctx context.Context) error {
+	d.dbMut.Lock()
+	defer d.dbMut.Unlock()
+	if d.db != nil {
+		return nil
+	}
+	if d.parquetDirectory != "" {
+		if err := os.MkdirAll(sql_manager.GetDuckdbParquetTableDirectory(d.parquetDirectory, d.schema, d.table), 0o755); err != nil {
+			return fmt.Errorf("unable to create parquet table directory: %w", err)
+		}
+	}
+	db, err := databases.acquire(d.path)
+	if err != nil {
+		return err
+	}
+	d.db = db
+	return nil
+}
+
+func (d *duckdbOutput) WriteBatch(ctx context.Context, batch service.MessageBatch) error {
+	rows := make([][]any, 0, len(batch))
+	for i := range batch {
+		row, err := d.getRow(batch, i)
+		if err != nil {
+			return err
+		}
+		rows = append(rows, row)
+	}
+
+	// duckdb reads the batch from a file of JSON lines, which lets it infer the column types
+	file, err := os.CreateTemp("", "neosync-duckdb-*.json")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	if err := writeJsonLines(file, d.columns, rows); err != nil {
+		file.Close()
+		return fmt.Errorf("unable to write batch for duckdb: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	var statements []string
+	if d.parquetDirectory != "" {
+		parquetFile := filepath.Join(sql_manager.GetDuckdbParquetTableDirectory(d.parquetDirectory, d.schema, d.table), fmt.Sprintf("%s.parquet", uuid.NewString()))
+		statements = []string{buildParquetCopyStatement(file.Name(), d.columns, parquetFile)}
+	} else {
+		statements = buildInsertStatements(file.Name(), d.schema, d.table, d.columns)
+	}
+
+	d.dbMut.Lock()
+	defer d.dbMut.Unlock()
+	if d.db == nil {
+		return service.ErrNotConnected
+	}
+	for _, statement := range statements {
+		if _, err := d.db.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("unable to write batch to duckdb: %w", err)
+		}
+	}
+	d.rowCounter.AddWritten(int64(len(rows)))
+	return nil
+}
+
+func (d *duckdbOutput) getRow(batch service.MessageBatch, idx int) ([]any, error) {
+	if d.argsMapping == nil {
+		structured, err := batch[idx].AsStructured()
+		if err != nil {
+			return nil, err
+		}
+		object, ok := structured.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("message must be an object to be written to duckdb, received: %T", structured)
+		}
+		row := make([]any, len(d.columns))
+		for colIdx, col := range d.columns {
+			row[colIdx] = object[col]
+		}
+		return row, nil
+	}
+
+	resMsg, err := batch.BloblangQuery(idx, d.argsMapping)
+	if err != nil {
+		return nil, err
+	}
+	iargs, err := resMsg.AsStructured()
+	if err != nil {
+		return nil, err
+	}
+	args, ok := iargs.([]any)
+	if !ok {
+		return nil, fmt.Errorf("mapping returned non-array result: %T", iargs)
+	}
+	if len(args) != len(d.columns) {
+		return nil, fmt.Errorf("mapping returned %d values but %d columns are being written", len(args), len(d.columns))
+	}
+	return args, nil
+}
+
+// Writes each row as a JSON object with its columns in order, as duckdb orders the columns it reads by their first appearance.
+// Binary values are written as base64 strings as JSON has no binary type
+func writeJsonLines(w io.Writer, columns []string, rows [][]any) error {
+	keys := make([][]byte, len(columns))
+	for idx, col := range columns {
+		key, err := json.Marshal(col)
+		if err != nil {
+			return err
+		}
+		keys[idx] = key
+	}
+
+	buf := bufio.NewWriter(w)
+	for _, row := range rows {
+		buf.WriteByte('{')
+		for idx := range columns {
+			if idx > 0 {
+				buf.WriteByte(',')
+			}
+			value, err := json.Marshal(row[idx])
+			if err != nil {
+				return err
+			}
+			buf.Write(keys[idx])
+			buf.WriteByte(':')
+			buf.Write(value)
+		}
+		buf.WriteString("}\n")
+	}
+	return buf.Flush()
+}
+
+func quoteLiteral(value string) string {
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(value, "'", "''"))
+}
+
+func buildReadJsonQuery(jsonFile string, columns []string) string {
+	return fmt.Sprintf(
+		"SELECT %s FROM read_json_auto(%s, format = 'newline_delimited')",
+		strings.Join(sql_manager.QuoteIdentifiers(sql_manager.DuckdbDriver, columns), ", "),
+		quoteLiteral(jsonFile),
+	)
+}
+
+// Each batch is written to its own parquet file in the table's directory
+func buildParquetCopyStatement(jsonFile string, columns []string, parquetFile string) string {
+	return fmt.Sprintf("COPY (%s) TO %s (FORMAT PARQUET);", buildReadJsonQuery(jsonFile, columns), quoteLiteral(parquetFile))
+}
+
+// The table is created from the types of the first batch if it does not already exist
+func buildInsertStatements(jsonFile, schema, table string, columns []string) []string {
+	quotedTable := sql_manager.QuoteTable(sql_manager.DuckdbDriver, schema, table)
+	readQuery := buildReadJsonQuery(jsonFile, columns)
+	return []string{
+		fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s;", sql_manager.QuoteIdentifier(sql_manager.DuckdbDriver, schema)),
+		fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s AS %s LIMIT 0;", quotedTable, readQuery),
+		fmt.Sprintf(
+			"INSERT INTO %s (%s) %s;",
+			quotedTable,
+			strings.Join(sql_manager.QuoteIdentifiers(sql_manager.DuckdbDriver, columns), ", "),
+			readQuery,
+		),
+	}
+}
+
+func (d *duckdbOutput) Close(ctx context.Context) error {
+	d.dbMut.Lock()
+	defer d.dbMut.Unlock()
+	if d.db == nil {
+		return nil
+	}
+	d.db = nil
+	return databases.release(d.path)
+}
@@ -0,0 +1,35 @@
+package neosync_benthos_duckdb
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_writeJsonLines(t *testing.T) {
+	var buf bytes.Buffer
+	err := writeJsonLines(&buf, []string{"name", "id", "data"}, [][]any{
+		{"ada", int64(1), []byte("hi")},
+		{nil, int64(2), nil},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "{\"name\":\"ada\",\"id\":1,\"data\":\"aGk=\"}\n{\"name\":null,\"id\":2,\"data\":null}\n", buf.String())
+}
+
+func Test_buildParquetCopyStatement(t *testing.T) {
+	require.Equal(
+		t,
+		`COPY (SELECT "id", "name" FROM read_json_auto('/tmp/batch.json', format = 'newline_delimited')) TO '/data/o''brien/public/users/part.parquet' (FORMAT PARQUET);`,
+		buildParquetCopyStatement("/tmp/batch.json", []string{"id", "name"}, "/data/o'brien/public/users/part.parquet"),
+	)
+}
+
+func Test_buildInsertStatements(t *testing.T) {
+	readQuery := `SELECT "id", "name" FROM read_json_auto('/tmp/batch.json', format = 'newline_delimited')`
+	require.Equal(t, []string{
+		`CREATE SCHEMA IF NOT EXISTS "public";`,
+		`CREATE TABLE IF NOT EXISTS "public"."users" AS ` + readQuery + ` LIMIT 0;`,
+		`INSERT INTO "public"."users" ("id", "name") ` + readQuery + `;`,
+	}, buildInsertStatements("/tmp/batch.json", "public", "users", []string{"id", "name"}))
+}
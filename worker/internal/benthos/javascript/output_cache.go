@@ -0,0 +1,106 @@
+package neosync_benthos_javascript
+
+import (
+	"container/list"
+	"encoding/json"
+	"sync"
+
+	"github.com/benthosdev/benthos/v4/public/service"
+)
+
+// Caches the outputs of deterministic transformers by their input so that values that repeat during a run are only transformed once.
+// The least recently used output is evicted once the cache is full
+type outputCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[outputCacheKey]*list.Element
+	// most recently used first
+	order *list.List
+
+	hits   *service.MetricCounter // optional
+	misses *service.MetricCounter // optional
+}
+
+type outputCacheKey struct {
+	// the column the transformer writes
+	name  string
+	value any
+}
+
+type outputCacheEntry struct {
+	key    outputCacheKey
+	output any
+}
+
+func newOutputCache(size int, metrics *service.Metrics) *outputCache {
+	c := &outputCache{
+		size:    size,
+		entries: map[outputCacheKey]*list.Element{},
+		order:   list.New(),
+	}
+	if metrics != nil {
+		c.hits = metrics.NewCounter("neosync_transformer_cache_hits", "column")
+		c.misses = metrics.NewCounter("neosync_transformer_cache_misses", "column")
+	}
+	return c
+}
+
+// Whether outputs are cached at all
+func (c *outputCache) enabled() bool {
+	return c != nil && c.size > 0
+}
+
+// Returns the output that is cached for the value of the column. Only values that are primitives are cached
+func (c *outputCache) get(name string, value any) (any, bool) {
+	var output any
+	c.mu.Lock()
+	element, ok := c.entries[outputCacheKey{name: name, value: value}]
+	if ok {
+		c.order.MoveToFront(element)
+		output = element.Value.(*outputCacheEntry).output
+	}
+	c.mu.Unlock()
+
+	if !ok {
+		if c.misses != nil {
+			c.misses.Incr(1, name)
+		}
+		return nil, false
+	}
+	if c.hits != nil {
+		c.hits.Incr(1, name)
+	}
+	return output, true
+}
+
+func (c *outputCache) add(name string, value, output any) {
+	key := outputCacheKey{name: name, value: value}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[key]; ok {
+		element.Value.(*outputCacheEntry).output = output
+		c.order.MoveToFront(element)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&outputCacheEntry{key: key, output: output})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*outputCacheEntry).key)
+	}
+}
+
+func (c *outputCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+func isCacheable(value any) bool {
+	switch value.(type) {
+	case nil, string, bool, int64, float64, json.Number:
+		return true
+	default:
+		return false
+	}
+}
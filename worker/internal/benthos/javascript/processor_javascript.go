@@ -0,0 +1,514 @@
+package neosync_benthos_javascript
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/rand"
+	"runtime"
+	"runtime/metrics"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/benthosdev/benthos/v4/public/service"
+	"github.com/dop251/goja"
+)
+
+// Limits that are applied to the code that javascript processors run, and to what they keep in memory
+type Limits struct {
+	// The longest the code may run for a single message. Unlimited when 0
+	Timeout time.Duration
+	// The most bytes that may be allocated while the code runs for a single message. Unlimited when 0.
+	// Allocations are measured for the whole worker, so work that runs at the same time counts towards the limit
+	MaxMemoryBytes uint64
+	// The most outputs of deterministic transformers that each processor caches. Outputs are not cached when 0
+	OutputCacheSize int
+}
+
+func javascriptProcessorSpec() *service.ConfigSpec {
+	return service.NewConfigSpec().
+		Summary("Runs javascript code for each message in a sandbox that has no access to the network, the file system or the environment. " +
+			"The code reads and writes the message with benthos.v0_msg_as_structured() and benthos.v0_msg_set_structured(), " +
+			"and can import the provided libraries by name with require(). " +
+			"When batch_size is set, the code is run once for each group of up to batch_size messages of a batch instead, " +
+			"and reads and writes the array of messages with benthos.v0_batch_as_structured() and benthos.v0_batch_set_structured(). " +
+			"benthos.v0_cached(name, value, fn) returns the output of fn for the value, which is only run the first time the value is seen for the name").
+		Field(service.NewStringField("code")).
+		Field(service.NewIntField("batch_size").Default(0)).
+		Field(service.NewStringMapField("libraries").Default(map[string]any{})).
+		Field(service.NewIntField("seed").Optional())
+}
+
+// Registers a processor on a benthos environment called neosync_javascript
+func RegisterNeosyncJavascriptProcessor(env *service.Environment, limits *Limits) error {
+	return env.RegisterBatchProcessor(
+		"neosync_javascript", javascriptProcessorSpec(),
+		func(conf *service.ParsedConfig, mgr *service.Resources) (service.BatchProcessor, error) {
+			return newJavascriptProcessor(conf, mgr, limits)
+		})
+}
+
+// the most compiled programs that are kept before the cache is emptied
+const maxCachedPrograms = 512
+
+// Compiled programs are shared by every processor of the worker, as the same code is run for each table of a job and for each run of the job
+type programCache struct {
+	mu       sync.Mutex
+	programs map[[sha256.Size]byte]*goja.Program
+}
+
+var programs = &programCache{programs: map[[sha256.Size]byte]*goja.Program{}}
+
+func (c *programCache) compile(name, code string) (*goja.Program, error) {
+	key := sha256.Sum256([]byte(name + "\x00" + code))
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if program, ok := c.programs[key]; ok {
+		return program, nil
+	}
+	program, err := goja.Compile(name, code, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(c.programs) >= maxCachedPrograms {
+		c.programs = map[[sha256.Size]byte]*goja.Program{}
+	}
+	c.programs[key] = program
+	return program, nil
+}
+
+var _ service.BatchProcessor = &javascriptProcessor{}
+
+type javascriptProcessor struct {
+	program *goja.Program
+	// the code is run once per message when 0
+	batchSize int
+	libraries map[string]*goja.Program
+	// nil if Math.random is not seeded
+	seed   *int64
+	limits Limits
+	// shared by the runtimes of the processor
+	outputs *outputCache
+
+	// idle runtimes, as creating a runtime for each message is far slower than running the code
+	runtimes chan *vmRuntime
+	// the number of runtimes that have been created, which offsets the seed of each new runtime
+	created atomic.Int64
+}
+
+func newJavascriptProcessor(conf *service.ParsedConfig, mgr *service.Resources, limits *Limits) (*javascriptProcessor, error) {
+	code, err := conf.FieldString("code")
+	if err != nil {
+		return nil, err
+	}
+	program, err := programs.compile("main", code)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compile javascript: %w", err)
+	}
+
+	batchSize, err := conf.FieldInt("batch_size")
+	if err != nil {
+		return nil, err
+	}
+	if batchSize < 0 {
+		return nil, fmt.Errorf("batch_size must not be negative, got %d", batchSize)
+	}
+
+	libraryCode, err := conf.FieldStringMap("libraries")
+	if err != nil {
+		return nil, err
+	}
+	libraries := make(map[string]*goja.Program, len(libraryCode))
+	for name, code := range libraryCode {
+		library, err := programs.compile(name, constructLibraryCode(code))
+		if err != nil {
+			return nil, fmt.Errorf("unable to compile javascript library %s: %w", name, err)
+		}
+		libraries[name] = library
+	}
+
+	var seed *int64
+	if conf.Contains("seed") {
+		value, err := conf.FieldInt("seed")
+		if err != nil {
+			return nil, err
+		}
+		s := int64(value)
+		seed = &s
+	}
+
+	processor := &javascriptProcessor{
+		program:   program,
+		batchSize: batchSize,
+		libraries: libraries,
+		seed:      seed,
+		runtimes:  make(chan *vmRuntime, runtime.GOMAXPROCS(0)),
+	}
+	if limits != nil {
+		processor.limits = *limits
+	}
+	var metrics *service.Metrics
+	if mgr != nil {
+		metrics = mgr.Metrics()
+	}
+	processor.outputs = newOutputCache(processor.limits.OutputCacheSize, metrics)
+	return processor, nil
+}
+
+// Libraries are run as CommonJS style modules, so they share what they assign to module.exports
+func constructLibraryCode(code string) string {
+	return fmt.Sprintf("(function(module, exports, require){\n%s\n})", code)
+}
+
+func (j *javascriptProcessor) ProcessBatch(ctx context.Context, batch service.MessageBatch) ([]service.MessageBatch, error) {
+	output := make(service.MessageBatch, 0, len(batch))
+	if j.batchSize == 0 {
+		for _, msg := range batch {
+			processed, err := j.Process(ctx, msg)
+			if err != nil {
+				msg.SetError(err)
+				output = append(output, msg)
+				continue
+			}
+			output = append(output, processed...)
+		}
+		return []service.MessageBatch{output}, nil
+	}
+
+	for start := 0; start < len(batch); start += j.batchSize {
+		group := batch[start:min(start+j.batchSize, len(batch))]
+		processed, err := j.processGroup(group)
+		if err != nil {
+			// the code is run for the group as a whole, so every message of the group fails with it
+			for _, msg := range group {
+				msg.SetError(err)
+			}
+			output = append(output, group...)
+			continue
+		}
+		output = append(output, processed...)
+	}
+	return []service.MessageBatch{output}, nil
+}
+
+func (j *javascriptProcessor) Process(ctx context.Context, msg *service.Message) (service.MessageBatch, error) {
+	newMsg := msg.Copy()
+	if err := j.run(&vmInput{message: newMsg}); err != nil {
+		return nil, err
+	}
+	return service.MessageBatch{newMsg}, nil
+}
+
+func (j *javascriptProcessor) processGroup(group service.MessageBatch) (service.MessageBatch, error) {
+	newGroup := group.Copy()
+	if err := j.run(&vmInput{batch: newGroup}); err != nil {
+		return nil, err
+	}
+	return newGroup, nil
+}
+
+func (j *javascriptProcessor) run(input *vmInput) error {
+	rt, err := j.getRuntime()
+	if err != nil {
+		return err
+	}
+
+	rt.input = input
+	invocation := j.startInvocation(rt.vm)
+	_, err = rt.vm.RunProgram(j.program)
+	invocation.finish()
+	rt.input = nil
+	if err != nil {
+		// the runtime may have been left part of the way through the code, so it is not reused
+		return fmt.Errorf("unable to run javascript: %w", err)
+	}
+	j.putRuntime(rt)
+	return nil
+}
+
+func (j *javascriptProcessor) Close(ctx context.Context) error {
+	return nil
+}
+
+func (j *javascriptProcessor) getRuntime() (*vmRuntime, error) {
+	select {
+	case rt := <-j.runtimes:
+		return rt, nil
+	default:
+	}
+	var seed *int64
+	if j.seed != nil {
+		// each runtime draws from its own sequence, so the values only repeat between runs when messages are processed in the same order
+		s := *j.seed + j.created.Add(1) - 1
+		seed = &s
+	}
+	return newVmRuntime(j.libraries, seed, j.outputs)
+}
+
+func (j *javascriptProcessor) putRuntime(rt *vmRuntime) {
+	select {
+	case j.runtimes <- rt:
+	default:
+	}
+}
+
+// What the code is currently being run for, either a single message or a group of messages
+type vmInput struct {
+	message *service.Message
+	batch   service.MessageBatch
+}
+
+type vmRuntime struct {
+	vm *goja.Runtime
+	// nil when the code is not running
+	input *vmInput
+
+	libraries map[string]goja.Callable
+	// the exports of each library that has been required, as a library is only run once per runtime
+	modules map[string]goja.Value
+	outputs *outputCache
+}
+
+func newVmRuntime(libraries map[string]*goja.Program, seed *int64, outputs *outputCache) (*vmRuntime, error) {
+	vm := goja.New()
+	if seed != nil {
+		vm.SetRandSource(rand.New(rand.NewSource(*seed)).Float64) //nolint:gosec
+	}
+	r := &vmRuntime{
+		vm:        vm,
+		libraries: make(map[string]goja.Callable, len(libraries)),
+		modules:   map[string]goja.Value{},
+		outputs:   outputs,
+	}
+	for name, program := range libraries {
+		value, err := vm.RunProgram(program)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load javascript library %s: %w", name, err)
+		}
+		fn, ok := goja.AssertFunction(value)
+		if !ok {
+			return nil, fmt.Errorf("javascript library %s did not load as a module", name)
+		}
+		r.libraries[name] = fn
+	}
+
+	benthos := vm.NewObject()
+	if err := benthos.Set("v0_msg_as_structured", r.msgAsStructured); err != nil {
+		return nil, err
+	}
+	if err := benthos.Set("v0_msg_set_structured", r.msgSetStructured); err != nil {
+		return nil, err
+	}
+	if err := benthos.Set("v0_batch_as_structured", r.batchAsStructured); err != nil {
+		return nil, err
+	}
+	if err := benthos.Set("v0_batch_set_structured", r.batchSetStructured); err != nil {
+		return nil, err
+	}
+	if err := benthos.Set("v0_cached", r.cached); err != nil {
+		return nil, err
+	}
+	if err := vm.Set("benthos", benthos); err != nil {
+		return nil, err
+	}
+	if err := vm.Set("require", r.require); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *vmRuntime) msgAsStructured(call goja.FunctionCall) goja.Value {
+	if r.input == nil || r.input.message == nil {
+		r.throw(errors.New("there is no message to read, batches are read with v0_batch_as_structured"))
+	}
+	value, err := r.input.message.AsStructuredMut()
+	if err != nil {
+		r.throw(err)
+	}
+	return r.vm.ToValue(value)
+}
+
+func (r *vmRuntime) msgSetStructured(call goja.FunctionCall) goja.Value {
+	if r.input == nil || r.input.message == nil {
+		r.throw(errors.New("there is no message to write, batches are written with v0_batch_set_structured"))
+	}
+	r.input.message.SetStructuredMut(call.Argument(0).Export())
+	return goja.Undefined()
+}
+
+func (r *vmRuntime) batchAsStructured(call goja.FunctionCall) goja.Value {
+	if r.input == nil || r.input.batch == nil {
+		r.throw(errors.New("there is no batch to read, messages are read with v0_msg_as_structured"))
+	}
+	values := make([]any, 0, len(r.input.batch))
+	for _, msg := range r.input.batch {
+		value, err := msg.AsStructuredMut()
+		if err != nil {
+			r.throw(err)
+		}
+		values = append(values, value)
+	}
+	return r.vm.ToValue(values)
+}
+
+func (r *vmRuntime) batchSetStructured(call goja.FunctionCall) goja.Value {
+	if r.input == nil || r.input.batch == nil {
+		r.throw(errors.New("there is no batch to write, messages are written with v0_msg_set_structured"))
+	}
+	values, ok := call.Argument(0).Export().([]any)
+	if !ok {
+		r.throw(errors.New("the batch must be written as an array"))
+	}
+	if len(values) != len(r.input.batch) {
+		r.throw(fmt.Errorf("the batch must be written with %d values, one for each message, but received %d", len(r.input.batch), len(values)))
+	}
+	for idx, msg := range r.input.batch {
+		msg.SetStructuredMut(values[idx])
+	}
+	return goja.Undefined()
+}
+
+func (r *vmRuntime) cached(call goja.FunctionCall) goja.Value {
+	name := call.Argument(0).String()
+	value := call.Argument(1).Export()
+	transform, ok := goja.AssertFunction(call.Argument(2))
+	if !ok {
+		r.throw(errors.New("the output to cache must be given as a function"))
+	}
+	if !r.outputs.enabled() || !isCacheable(value) {
+		output, err := transform(goja.Undefined())
+		if err != nil {
+			r.throw(err)
+		}
+		return output
+	}
+
+	if output, ok := r.outputs.get(name, value); ok {
+		return r.vm.ToValue(output)
+	}
+	output, err := transform(goja.Undefined())
+	if err != nil {
+		r.throw(err)
+	}
+	// objects are not cached, as the code may change them after they are returned
+	if exported := output.Export(); isCacheable(exported) {
+		r.outputs.add(name, value, exported)
+	}
+	return output
+}
+
+func (r *vmRuntime) require(call goja.FunctionCall) goja.Value {
+	name := call.Argument(0).String()
+	if exports, ok := r.modules[name]; ok {
+		return exports
+	}
+	library, ok := r.libraries[name]
+	if !ok {
+		r.throw(fmt.Errorf("javascript library %s does not exist", name))
+	}
+
+	module := r.vm.NewObject()
+	exports := r.vm.NewObject()
+	if err := module.Set("exports", exports); err != nil {
+		r.throw(err)
+	}
+	// stored before the library runs so that libraries that require each other receive what has been exported so far
+	r.modules[name] = exports
+	if _, err := library(goja.Undefined(), module, exports, r.vm.Get("require")); err != nil {
+		delete(r.modules, name)
+		r.throw(err)
+	}
+	exported := module.Get("exports")
+	r.modules[name] = exported
+	return exported
+}
+
+// Throws the error in the code that is running. Errors from the runtime, such as interruptions, are thrown as-is
+func (r *vmRuntime) throw(err error) {
+	var exception *goja.Exception
+	var interrupted *goja.InterruptedError
+	if errors.As(err, &exception) || errors.As(err, &interrupted) {
+		panic(err)
+	}
+	panic(r.vm.NewGoError(err))
+}
+
+// how often the memory that has been allocated is checked while the code runs
+const memoryCheckInterval = 5 * time.Millisecond
+
+type invocation struct {
+	vm *goja.Runtime
+
+	mu       sync.Mutex
+	finished bool
+	done     chan struct{}
+	timer    *time.Timer
+}
+
+// Interrupts the code once it runs past the limits
+func (j *javascriptProcessor) startInvocation(vm *goja.Runtime) *invocation {
+	i := &invocation{vm: vm}
+	if j.limits.Timeout > 0 {
+		timeout := j.limits.Timeout
+		i.timer = time.AfterFunc(timeout, func() {
+			i.interrupt(fmt.Errorf("javascript ran for longer than the limit of %s", timeout))
+		})
+	}
+	if j.limits.MaxMemoryBytes > 0 {
+		i.done = make(chan struct{})
+		go i.watchMemory(getAllocatedBytes(), j.limits.MaxMemoryBytes)
+	}
+	return i
+}
+
+func (i *invocation) interrupt(err error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if !i.finished {
+		i.vm.Interrupt(err)
+	}
+}
+
+func (i *invocation) finish() {
+	i.mu.Lock()
+	i.finished = true
+	i.mu.Unlock()
+	if i.timer != nil {
+		i.timer.Stop()
+	}
+	if i.done != nil {
+		close(i.done)
+	}
+	// an interrupt that came in after the code finished must not stop the next message
+	i.vm.ClearInterrupt()
+}
+
+func (i *invocation) watchMemory(start, maxBytes uint64) {
+	ticker := time.NewTicker(memoryCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-i.done:
+			return
+		case <-ticker.C:
+			if allocated := getAllocatedBytes() - start; allocated > maxBytes {
+				i.interrupt(fmt.Errorf("javascript allocated %d bytes, which is more than the limit of %d bytes", allocated, maxBytes))
+				return
+			}
+		}
+	}
+}
+
+const heapAllocsMetric = "/gc/heap/allocs:bytes"
+
+// Returns the bytes that the process has allocated on the heap since it started. Unlike runtime.ReadMemStats, it does not stop the world
+func getAllocatedBytes() uint64 {
+	samples := []metrics.Sample{{Name: heapAllocsMetric}}
+	metrics.Read(samples)
+	if samples[0].Value.Kind() != metrics.KindUint64 {
+		return 0
+	}
+	return samples[0].Value.Uint64()
+}
@@ -0,0 +1,244 @@
+package neosync_benthos_javascript
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benthosdev/benthos/v4/public/service"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestProcessor(t *testing.T, config string, limits *Limits) *javascriptProcessor {
+	t.Helper()
+	conf, err := javascriptProcessorSpec().ParseYAML(config, nil)
+	require.NoError(t, err)
+	processor, err := newJavascriptProcessor(conf, nil, limits)
+	require.NoError(t, err)
+	return processor
+}
+
+func processValue(t *testing.T, processor *javascriptProcessor, value map[string]any) (map[string]any, error) {
+	t.Helper()
+	msg := service.NewMessage(nil)
+	msg.SetStructuredMut(value)
+	batch, err := processor.Process(context.Background(), msg)
+	if err != nil {
+		return nil, err
+	}
+	require.Len(t, batch, 1)
+	out, err := batch[0].AsStructured()
+	require.NoError(t, err)
+	result, ok := out.(map[string]any)
+	require.True(t, ok)
+	return result, nil
+}
+
+func Test_JavascriptProcessor_Libraries(t *testing.T) {
+	processor := newTestProcessor(t, `
+code: |
+  (() => {
+  const input = benthos.v0_msg_as_structured();
+  const output = { ...input };
+  output["name"] = require("helpers").shout(input["name"]);
+  benthos.v0_msg_set_structured(output);
+  })();
+libraries:
+  helpers: |
+    const strings = require("strings");
+    module.exports = { shout: (value) => strings.upper(value) + "!" };
+  strings: |
+    exports.upper = (value) => value.toUpperCase();
+`, nil)
+
+	out, err := processValue(t, processor, map[string]any{"id": 1, "name": "nick"})
+	require.NoError(t, err)
+	require.Equal(t, "NICK!", out["name"])
+
+	// the runtime is reused, along with the libraries it has already loaded
+	out, err = processValue(t, processor, map[string]any{"id": 2, "name": "alisha"})
+	require.NoError(t, err)
+	require.Equal(t, "ALISHA!", out["name"])
+	require.NoError(t, processor.Close(context.Background()))
+}
+
+func Test_JavascriptProcessor_MissingLibrary(t *testing.T) {
+	processor := newTestProcessor(t, `
+code: |
+  const helpers = require("helpers");
+`, nil)
+
+	_, err := processValue(t, processor, map[string]any{"name": "nick"})
+	require.ErrorContains(t, err, "javascript library helpers does not exist")
+}
+
+func Test_JavascriptProcessor_Seed(t *testing.T) {
+	config := `
+code: |
+  benthos.v0_msg_set_structured({ value: Math.random() });
+seed: 42
+`
+	first, err := processValue(t, newTestProcessor(t, config, nil), map[string]any{})
+	require.NoError(t, err)
+	second, err := processValue(t, newTestProcessor(t, config, nil), map[string]any{})
+	require.NoError(t, err)
+	require.Equal(t, first["value"], second["value"])
+
+	other, err := processValue(t, newTestProcessor(t, `
+code: |
+  benthos.v0_msg_set_structured({ value: Math.random() });
+seed: 7
+`, nil), map[string]any{})
+	require.NoError(t, err)
+	require.NotEqual(t, first["value"], other["value"])
+}
+
+func Test_JavascriptProcessor_Timeout(t *testing.T) {
+	processor := newTestProcessor(t, `
+code: |
+  const input = benthos.v0_msg_as_structured();
+  while (input.loop) {}
+  benthos.v0_msg_set_structured({ ok: true });
+`, &Limits{Timeout: 50 * time.Millisecond})
+
+	_, err := processValue(t, processor, map[string]any{"loop": true})
+	require.ErrorContains(t, err, "javascript ran for longer than the limit of 50ms")
+
+	// the interrupt of the last message does not stop the next one
+	out, err := processValue(t, processor, map[string]any{"loop": false})
+	require.NoError(t, err)
+	require.Equal(t, true, out["ok"])
+}
+
+func Test_JavascriptProcessor_MaxMemory(t *testing.T) {
+	processor := newTestProcessor(t, `
+code: |
+  const values = [];
+  while (true) { values.push("value" + values.length); }
+`, &Limits{MaxMemoryBytes: 1 << 20})
+
+	_, err := processValue(t, processor, map[string]any{})
+	require.ErrorContains(t, err, "more than the limit of 1048576 bytes")
+}
+
+func Test_JavascriptProcessor_InvalidCode(t *testing.T) {
+	conf, err := javascriptProcessorSpec().ParseYAML(`code: "const = ;"`, nil)
+	require.NoError(t, err)
+	_, err = newJavascriptProcessor(conf, nil, nil)
+	require.Error(t, err)
+
+	conf, err = javascriptProcessorSpec().ParseYAML(`
+code: require("helpers");
+libraries:
+  helpers: "module.exports = {"
+`, nil)
+	require.NoError(t, err)
+	_, err = newJavascriptProcessor(conf, nil, nil)
+	require.ErrorContains(t, err, "unable to compile javascript library helpers")
+}
+
+func Test_JavascriptProcessor_Batch(t *testing.T) {
+	processor := newTestProcessor(t, `
+code: |
+  const inputs = benthos.v0_batch_as_structured();
+  benthos.v0_batch_set_structured(inputs.map((input) => ({ ...input, size: inputs.length })));
+batch_size: 2
+`, nil)
+
+	batch := service.MessageBatch{}
+	for idx := 0; idx < 3; idx++ {
+		msg := service.NewMessage(nil)
+		msg.SetStructuredMut(map[string]any{"id": idx})
+		batch = append(batch, msg)
+	}
+	batches, err := processor.ProcessBatch(context.Background(), batch)
+	require.NoError(t, err)
+	require.Len(t, batches, 1)
+	require.Len(t, batches[0], 3)
+
+	sizes := []any{}
+	for _, msg := range batches[0] {
+		require.NoError(t, msg.GetError())
+		out, err := msg.AsStructured()
+		require.NoError(t, err)
+		sizes = append(sizes, out.(map[string]any)["size"])
+	}
+	require.Equal(t, []any{int64(2), int64(2), int64(1)}, sizes)
+}
+
+func Test_JavascriptProcessor_Batch_WrongLength(t *testing.T) {
+	processor := newTestProcessor(t, `
+code: |
+  benthos.v0_batch_set_structured([]);
+batch_size: 10
+`, nil)
+
+	msg := service.NewMessage(nil)
+	msg.SetStructuredMut(map[string]any{"id": 1})
+	batches, err := processor.ProcessBatch(context.Background(), service.MessageBatch{msg})
+	require.NoError(t, err)
+	require.Len(t, batches[0], 1)
+	require.ErrorContains(t, batches[0][0].GetError(), "the batch must be written with 1 values")
+}
+
+func Test_JavascriptProcessor_Cached(t *testing.T) {
+	config := `
+code: |
+  (() => {
+  const input = benthos.v0_msg_as_structured();
+  const output = { ...input };
+  output["name"] = benthos.v0_cached("name", input["name"], () => input["name"] + Math.random());
+  benthos.v0_msg_set_structured(output);
+  })();
+`
+	processor := newTestProcessor(t, config, &Limits{OutputCacheSize: 10})
+	first, err := processValue(t, processor, map[string]any{"name": "nick"})
+	require.NoError(t, err)
+	second, err := processValue(t, processor, map[string]any{"name": "nick"})
+	require.NoError(t, err)
+	require.Equal(t, first["name"], second["name"])
+	other, err := processValue(t, processor, map[string]any{"name": "alisha"})
+	require.NoError(t, err)
+	require.NotEqual(t, first["name"], other["name"])
+	require.Equal(t, 2, processor.outputs.len())
+
+	// the code is run for every value when outputs are not cached
+	processor = newTestProcessor(t, config, nil)
+	first, err = processValue(t, processor, map[string]any{"name": "nick"})
+	require.NoError(t, err)
+	second, err = processValue(t, processor, map[string]any{"name": "nick"})
+	require.NoError(t, err)
+	require.NotEqual(t, first["name"], second["name"])
+}
+
+func Test_outputCache(t *testing.T) {
+	cache := newOutputCache(2, nil)
+	cache.add("name", "nick", "a")
+	cache.add("name", "alisha", "b")
+	_, ok := cache.get("name", "nick")
+	require.True(t, ok)
+
+	// alisha is the least recently used value, so it is evicted
+	cache.add("name", "evis", "c")
+	require.Equal(t, 2, cache.len())
+	_, ok = cache.get("name", "alisha")
+	require.False(t, ok)
+	output, ok := cache.get("name", "nick")
+	require.True(t, ok)
+	require.Equal(t, "a", output)
+
+	// the same value is cached separately for each column
+	_, ok = cache.get("email", "nick")
+	require.False(t, ok)
+
+	require.True(t, isCacheable(int64(1)))
+	require.False(t, isCacheable(map[string]any{}))
+}
+
+func Test_programCache(t *testing.T) {
+	first, err := programs.compile("main", "1 + 1")
+	require.NoError(t, err)
+	second, err := programs.compile("main", "1 + 1")
+	require.NoError(t, err)
+	require.Same(t, first, second)
+}
@@ -0,0 +1,139 @@
+package neosyncconnectiondata
+
+import (
+	"context"
+	"sync"
+
+	"connectrpc.com/connect"
+	"github.com/benthosdev/benthos/v4/public/service"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+)
+
+func getSpec() *service.ConfigSpec {
+	return service.NewConfigSpec().
+		Summary("Streams the output of a previous job run from an AWS S3 connection").
+		Field(service.NewStringField("connection_id")).
+		Field(service.NewStringField("job_run_id")).
+		Field(service.NewStringField("schema")).
+		Field(service.NewStringField("table"))
+}
+
+func RegisterNeosyncConnectionDataInput(env *service.Environment, dataclient mgmtv1alpha1connect.ConnectionDataServiceClient) error {
+	return env.RegisterInput("neosync_connection_data", getSpec(), func(conf *service.ParsedConfig, mgr *service.Resources) (service.Input, error) {
+		rdr, err := newConnectionDataReader(conf, dataclient)
+		if err != nil {
+			return nil, err
+		}
+		return service.AutoRetryNacks(rdr), nil
+	})
+}
+
+type connectionDataReader struct {
+	dataclient mgmtv1alpha1connect.ConnectionDataServiceClient
+
+	connectionId string
+	jobRunId     string
+	schema       string
+	table        string
+
+	recvMut sync.Mutex
+	resp    *connect.ServerStreamForClient[mgmtv1alpha1.GetConnectionDataStreamResponse]
+}
+
+func newConnectionDataReader(conf *service.ParsedConfig, dataclient mgmtv1alpha1connect.ConnectionDataServiceClient) (*connectionDataReader, error) {
+	connectionId, err := conf.FieldString("connection_id")
+	if err != nil {
+		return nil, err
+	}
+	jobRunId, err := conf.FieldString("job_run_id")
+	if err != nil {
+		return nil, err
+	}
+	schema, err := conf.FieldString("schema")
+	if err != nil {
+		return nil, err
+	}
+	table, err := conf.FieldString("table")
+	if err != nil {
+		return nil, err
+	}
+	return &connectionDataReader{
+		dataclient:   dataclient,
+		connectionId: connectionId,
+		jobRunId:     jobRunId,
+		schema:       schema,
+		table:        table,
+	}, nil
+}
+
+var _ service.Input = &connectionDataReader{}
+
+func (r *connectionDataReader) Connect(ctx context.Context) error {
+	r.recvMut.Lock()
+	defer r.recvMut.Unlock()
+	if r.resp != nil {
+		return nil
+	}
+
+	resp, err := r.dataclient.GetConnectionDataStream(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionDataStreamRequest{
+		ConnectionId: r.connectionId,
+		Schema:       r.schema,
+		Table:        r.table,
+		StreamConfig: &mgmtv1alpha1.ConnectionStreamConfig{
+			Config: &mgmtv1alpha1.ConnectionStreamConfig_AwsS3Config{
+				AwsS3Config: &mgmtv1alpha1.AwsS3StreamConfig{
+					Id: &mgmtv1alpha1.AwsS3StreamConfig_JobRunId{JobRunId: r.jobRunId},
+				},
+			},
+		},
+	}))
+	if err != nil {
+		return err
+	}
+	r.resp = resp
+	return nil
+}
+
+func (r *connectionDataReader) Read(ctx context.Context) (*service.Message, service.AckFunc, error) {
+	r.recvMut.Lock()
+	defer r.recvMut.Unlock()
+
+	if r.resp == nil {
+		return nil, nil, service.ErrNotConnected
+	}
+
+	if !r.resp.Receive() {
+		if err := r.resp.Err(); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, service.ErrEndOfInput
+	}
+
+	valuesMap := map[string]any{}
+	for col, val := range r.resp.Msg().GetRow() {
+		if len(val) == 0 {
+			valuesMap[col] = nil
+		} else {
+			valuesMap[col] = val
+		}
+	}
+
+	msg := service.NewMessage(nil)
+	msg.SetStructuredMut(valuesMap)
+	return msg, func(ctx context.Context, err error) error {
+		// Nacks are retried automatically when we use service.AutoRetryNacks
+		return nil
+	}, nil
+}
+
+func (r *connectionDataReader) Close(ctx context.Context) error {
+	r.recvMut.Lock()
+	defer r.recvMut.Unlock()
+	if r.resp == nil {
+		return nil
+	}
+	err := r.resp.Close()
+	r.resp = nil
+	return err
+}
@@ -0,0 +1,138 @@
+package neosync_benthos_plugin
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/benthosdev/benthos/v4/public/service"
+	neosync_plugins "github.com/nucleuscloud/neosync/worker/pkg/plugins"
+)
+
+func pluginInputSpec() *service.ConfigSpec {
+	return service.NewConfigSpec().
+		Summary("Reads a table from a custom connection using a plugin that has been registered with the worker").
+		Field(service.NewStringField("plugin")).
+		Field(service.NewStringField("schema")).
+		Field(service.NewStringField("table")).
+		Field(service.NewStringListField("columns")).
+		Field(service.NewStringMapField("options").Optional())
+}
+
+// Registers an input on a benthos environment called neosync_plugin
+func RegisterPluginInput(env *service.Environment) error {
+	return env.RegisterBatchInput("neosync_plugin", pluginInputSpec(), func(conf *service.ParsedConfig, mgr *service.Resources) (service.BatchInput, error) {
+		rdr, err := newPluginInput(conf)
+		if err != nil {
+			return nil, err
+		}
+		return service.AutoRetryNacksBatched(rdr), nil
+	})
+}
+
+var _ service.BatchInput = &pluginInput{}
+
+type pluginInput struct {
+	source neosync_plugins.Source
+	req    *neosync_plugins.TableRequest
+
+	readerMut sync.Mutex
+	reader    neosync_plugins.RowReader
+}
+
+func newPluginInput(conf *service.ParsedConfig) (*pluginInput, error) {
+	pluginName, err := conf.FieldString("plugin")
+	if err != nil {
+		return nil, err
+	}
+	source, err := neosync_plugins.GetSource(pluginName)
+	if err != nil {
+		return nil, err
+	}
+	req, err := getTableRequest(conf)
+	if err != nil {
+		return nil, err
+	}
+	return &pluginInput{source: source, req: req}, nil
+}
+
+func (p *pluginInput) Connect(ctx context.Context) error {
+	p.readerMut.Lock()
+	defer p.readerMut.Unlock()
+	if p.reader != nil {
+		return nil
+	}
+	reader, err := p.source.OpenReader(ctx, p.req)
+	if err != nil {
+		return err
+	}
+	p.reader = reader
+	return nil
+}
+
+func (p *pluginInput) ReadBatch(ctx context.Context) (service.MessageBatch, service.AckFunc, error) {
+	p.readerMut.Lock()
+	defer p.readerMut.Unlock()
+	if p.reader == nil {
+		return nil, nil, service.ErrNotConnected
+	}
+
+	rows, err := p.reader.Read(ctx)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil, service.ErrEndOfInput
+		}
+		return nil, nil, err
+	}
+
+	batch := make(service.MessageBatch, 0, len(rows))
+	for _, row := range rows {
+		msg := service.NewMessage(nil)
+		msg.SetStructuredMut(row)
+		batch = append(batch, msg)
+	}
+	return batch, func(ctx context.Context, err error) error {
+		// Nacks are retried automatically when we use service.AutoRetryNacksBatched
+		return nil
+	}, nil
+}
+
+func (p *pluginInput) Close(ctx context.Context) error {
+	p.readerMut.Lock()
+	defer p.readerMut.Unlock()
+	if p.reader == nil {
+		return nil
+	}
+	err := p.reader.Close(ctx)
+	p.reader = nil
+	return err
+}
+
+func getTableRequest(conf *service.ParsedConfig) (*neosync_plugins.TableRequest, error) {
+	schema, err := conf.FieldString("schema")
+	if err != nil {
+		return nil, err
+	}
+	table, err := conf.FieldString("table")
+	if err != nil {
+		return nil, err
+	}
+	columns, err := conf.FieldStringList("columns")
+	if err != nil {
+		return nil, err
+	}
+	options := map[string]string{}
+	if conf.Contains("options") {
+		options, err = conf.FieldStringMap("options")
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &neosync_plugins.TableRequest{
+		Schema:  schema,
+		Table:   table,
+		Columns: columns,
+		Options: options,
+	}, nil
+}
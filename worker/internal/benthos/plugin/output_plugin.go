@@ -0,0 +1,168 @@
+package neosync_benthos_plugin
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/benthosdev/benthos/v4/public/service"
+	neosync_benthos_rowcount "github.com/nucleuscloud/neosync/worker/internal/benthos/rowcount"
+	neosync_plugins "github.com/nucleuscloud/neosync/worker/pkg/plugins"
+)
+
+func pluginOutputSpec() *service.ConfigSpec {
+	return service.NewConfigSpec().
+		Summary("Writes a table to a custom connection using a plugin that has been registered with the worker").
+		Field(service.NewStringField("plugin")).
+		Field(service.NewStringField("schema")).
+		Field(service.NewStringField("table")).
+		Field(service.NewStringListField("columns")).
+		Field(service.NewStringMapField("options").Optional()).
+		Field(service.NewBloblangField("args_mapping").Optional()).
+		Field(service.NewIntField("max_in_flight").Default(64)).
+		Field(service.NewBatchPolicyField("batching"))
+}
+
+// Registers an output on a benthos environment called neosync_plugin
+func RegisterPluginOutput(env *service.Environment, tracker *neosync_benthos_rowcount.Tracker) error {
+	return env.RegisterBatchOutput(
+		"neosync_plugin", pluginOutputSpec(),
+		func(conf *service.ParsedConfig, mgr *service.Resources) (service.BatchOutput, service.BatchPolicy, int, error) {
+			batchPolicy, err := conf.FieldBatchPolicy("batching")
+			if err != nil {
+				return nil, batchPolicy, -1, err
+			}
+
+			maxInFlight, err := conf.FieldInt("max_in_flight")
+			if err != nil {
+				return nil, service.BatchPolicy{}, -1, err
+			}
+			out, err := newPluginOutput(conf)
+			if err != nil {
+				return nil, service.BatchPolicy{}, -1, err
+			}
+			out.rowCounter = tracker.NewOutputCounter("neosync_plugin", "")
+			return out, batchPolicy, maxInFlight, nil
+		},
+	)
+}
+
+var _ service.BatchOutput = &pluginOutput{}
+
+type pluginOutput struct {
+	sink neosync_plugins.Sink
+	req  *neosync_plugins.TableRequest
+	// when set, returns the row's values in the same order as the request's columns
+	argsMapping *bloblang.Executor
+	// nil if rows are not being counted
+	rowCounter *neosync_benthos_rowcount.OutputCounter
+
+	// writers are not expected to be safe for concurrent use, so all writes are serialized
+	writerMut sync.Mutex
+	writer    neosync_plugins.RowWriter
+}
+
+func newPluginOutput(conf *service.ParsedConfig) (*pluginOutput, error) {
+	pluginName, err := conf.FieldString("plugin")
+	if err != nil {
+		return nil, err
+	}
+	sink, err := neosync_plugins.GetSink(pluginName)
+	if err != nil {
+		return nil, err
+	}
+	req, err := getTableRequest(conf)
+	if err != nil {
+		return nil, err
+	}
+	var argsMapping *bloblang.Executor
+	if conf.Contains("args_mapping") {
+		if argsMapping, err = conf.FieldBloblang("args_mapping"); err != nil {
+			return nil, err
+		}
+	}
+	return &pluginOutput{sink: sink, req: req, argsMapping: argsMapping}, nil
+}
+
+func (p *pluginOutput) Connect(ctx context.Context) error {
+	p.writerMut.Lock()
+	defer p.writerMut.Unlock()
+	if p.writer != nil {
+		return nil
+	}
+	writer, err := p.sink.OpenWriter(ctx, p.req)
+	if err != nil {
+		return err
+	}
+	p.writer = writer
+	return nil
+}
+
+func (p *pluginOutput) WriteBatch(ctx context.Context, batch service.MessageBatch) error {
+	rows := make([]map[string]any, 0, len(batch))
+	for i := range batch {
+		row, err := p.getRow(batch, i)
+		if err != nil {
+			return err
+		}
+		rows = append(rows, row)
+	}
+
+	p.writerMut.Lock()
+	defer p.writerMut.Unlock()
+	if p.writer == nil {
+		return service.ErrNotConnected
+	}
+	if err := p.writer.Write(ctx, rows); err != nil {
+		return err
+	}
+	p.rowCounter.AddWritten(int64(len(rows)))
+	return nil
+}
+
+func (p *pluginOutput) getRow(batch service.MessageBatch, idx int) (map[string]any, error) {
+	if p.argsMapping == nil {
+		structured, err := batch[idx].AsStructured()
+		if err != nil {
+			return nil, err
+		}
+		row, ok := structured.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("message must be an object to be written by a plugin, received: %T", structured)
+		}
+		return row, nil
+	}
+
+	resMsg, err := batch.BloblangQuery(idx, p.argsMapping)
+	if err != nil {
+		return nil, err
+	}
+	iargs, err := resMsg.AsStructured()
+	if err != nil {
+		return nil, err
+	}
+	args, ok := iargs.([]any)
+	if !ok {
+		return nil, fmt.Errorf("mapping returned non-array result: %T", iargs)
+	}
+	if len(args) != len(p.req.Columns) {
+		return nil, fmt.Errorf("mapping returned %d values but %d columns are being written", len(args), len(p.req.Columns))
+	}
+	row := make(map[string]any, len(args))
+	for colIdx, col := range p.req.Columns {
+		row[col] = args[colIdx]
+	}
+	return row, nil
+}
+
+func (p *pluginOutput) Close(ctx context.Context) error {
+	p.writerMut.Lock()
+	defer p.writerMut.Unlock()
+	if p.writer == nil {
+		return nil
+	}
+	err := p.writer.Close(ctx)
+	p.writer = nil
+	return err
+}
@@ -0,0 +1,141 @@
+package neosync_benthos_plugin
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/service"
+	neosync_benthos_rowcount "github.com/nucleuscloud/neosync/worker/internal/benthos/rowcount"
+	neosync_plugins "github.com/nucleuscloud/neosync/worker/pkg/plugins"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryPlugin struct {
+	rows    []map[string]any
+	written []map[string]any
+	reqs    []*neosync_plugins.TableRequest
+}
+
+func (m *memoryPlugin) OpenReader(ctx context.Context, req *neosync_plugins.TableRequest) (neosync_plugins.RowReader, error) {
+	m.reqs = append(m.reqs, req)
+	return &memoryReader{rows: m.rows}, nil
+}
+
+func (m *memoryPlugin) OpenWriter(ctx context.Context, req *neosync_plugins.TableRequest) (neosync_plugins.RowWriter, error) {
+	m.reqs = append(m.reqs, req)
+	return &memoryWriter{plugin: m}, nil
+}
+
+type memoryReader struct {
+	rows []map[string]any
+	done bool
+}
+
+func (r *memoryReader) Read(ctx context.Context) ([]map[string]any, error) {
+	if r.done {
+		return nil, io.EOF
+	}
+	r.done = true
+	return r.rows, nil
+}
+
+func (r *memoryReader) Close(ctx context.Context) error {
+	return nil
+}
+
+type memoryWriter struct {
+	plugin *memoryPlugin
+}
+
+func (w *memoryWriter) Write(ctx context.Context, rows []map[string]any) error {
+	w.plugin.written = append(w.plugin.written, rows...)
+	return nil
+}
+
+func (w *memoryWriter) Close(ctx context.Context) error {
+	return nil
+}
+
+func Test_PluginInput(t *testing.T) {
+	plugin := &memoryPlugin{rows: []map[string]any{{"id": "1"}, {"id": "2"}}}
+	neosync_plugins.RegisterSource("test-input", plugin)
+
+	conf := `
+plugin: test-input
+schema: public
+table: users
+columns:
+  - id
+options:
+  region: eu
+`
+	parsed, err := pluginInputSpec().ParseYAML(conf, service.NewEnvironment())
+	require.NoError(t, err)
+
+	input, err := newPluginInput(parsed)
+	require.NoError(t, err)
+	ctx := context.Background()
+	require.NoError(t, input.Connect(ctx))
+
+	batch, _, err := input.ReadBatch(ctx)
+	require.NoError(t, err)
+	require.Len(t, batch, 2)
+	structured, err := batch[1].AsStructured()
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"id": "2"}, structured)
+
+	_, _, err = input.ReadBatch(ctx)
+	require.ErrorIs(t, err, service.ErrEndOfInput)
+	require.NoError(t, input.Close(ctx))
+
+	require.Equal(t, []*neosync_plugins.TableRequest{
+		{Schema: "public", Table: "users", Columns: []string{"id"}, Options: map[string]string{"region": "eu"}},
+	}, plugin.reqs)
+}
+
+func Test_PluginInput_UnknownPlugin(t *testing.T) {
+	conf := `
+plugin: unknown
+schema: public
+table: users
+columns: []
+`
+	parsed, err := pluginInputSpec().ParseYAML(conf, service.NewEnvironment())
+	require.NoError(t, err)
+
+	_, err = newPluginInput(parsed)
+	require.Error(t, err)
+}
+
+func Test_PluginOutput(t *testing.T) {
+	plugin := &memoryPlugin{}
+	neosync_plugins.RegisterSink("test-output", plugin)
+
+	conf := `
+plugin: test-output
+schema: public
+table: people
+columns:
+  - id
+  - full_name
+args_mapping: 'root = [this.id, this.name]'
+`
+	parsed, err := pluginOutputSpec().ParseYAML(conf, service.NewEnvironment())
+	require.NoError(t, err)
+
+	output, err := newPluginOutput(parsed)
+	require.NoError(t, err)
+	tracker := neosync_benthos_rowcount.NewTracker()
+	output.rowCounter = tracker.NewOutputCounter("neosync_plugin", "")
+	ctx := context.Background()
+	require.NoError(t, output.Connect(ctx))
+
+	msg := service.NewMessage(nil)
+	msg.SetStructuredMut(map[string]any{"id": "1", "name": "foo", "ignored": true})
+	require.NoError(t, output.WriteBatch(ctx, service.MessageBatch{msg}))
+	require.NoError(t, output.Close(ctx))
+
+	require.Equal(t, []map[string]any{{"id": "1", "full_name": "foo"}}, plugin.written)
+	require.Equal(t, int64(1), tracker.Outputs()[0].Written)
+}
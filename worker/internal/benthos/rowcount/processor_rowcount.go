@@ -0,0 +1,34 @@
+package neosync_benthos_rowcount
+
+import (
+	"context"
+
+	"github.com/benthosdev/benthos/v4/public/service"
+)
+
+func rowCountProcessorSpec() *service.ConfigSpec {
+	return service.NewConfigSpec().
+		Summary(`Counts the rows that pass through the processor`)
+}
+
+// Registers a processor on a benthos environment called neosync_row_count
+func RegisterRowCountProcessor(env *service.Environment, tracker *Tracker) error {
+	return env.RegisterBatchProcessor(
+		"neosync_row_count", rowCountProcessorSpec(),
+		func(conf *service.ParsedConfig, mgr *service.Resources) (service.BatchProcessor, error) {
+			return &rowCountProcessor{tracker: tracker}, nil
+		})
+}
+
+type rowCountProcessor struct {
+	tracker *Tracker
+}
+
+func (r *rowCountProcessor) ProcessBatch(_ context.Context, batch service.MessageBatch) ([]service.MessageBatch, error) {
+	r.tracker.AddRead(int64(len(batch)))
+	return []service.MessageBatch{batch}, nil
+}
+
+func (r *rowCountProcessor) Close(ctx context.Context) error {
+	return nil
+}
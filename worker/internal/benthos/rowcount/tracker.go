@@ -0,0 +1,99 @@
+package neosync_benthos_rowcount
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Tracks the rows that a stream hands to its outputs and the rows that each output writes
+// so that rows that go missing between the source and a destination can be detected.
+// A nil tracker is valid and counts nothing.
+type Tracker struct {
+	read atomic.Int64
+
+	mu      sync.Mutex
+	outputs []*OutputCounter
+}
+
+func NewTracker() *Tracker {
+	return &Tracker{}
+}
+
+func (t *Tracker) AddRead(n int64) {
+	if t == nil {
+		return
+	}
+	t.read.Add(n)
+}
+
+func (t *Tracker) Read() int64 {
+	if t == nil {
+		return 0
+	}
+	return t.read.Load()
+}
+
+// Returns a counter for an output that is created by the stream. The dsn is used to resolve the destination connection
+// and is empty for outputs that do not connect with one.
+// Returns nil if the tracker is nil.
+func (t *Tracker) NewOutputCounter(output, dsn string) *OutputCounter {
+	if t == nil {
+		return nil
+	}
+	counter := &OutputCounter{output: output, dsn: dsn}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.outputs = append(t.outputs, counter)
+	return counter
+}
+
+type OutputCount struct {
+	// The benthos output that wrote the rows, e.g. pooled_sql_insert
+	Output  string
+	Dsn     string
+	Written int64
+	Skipped int64
+}
+
+// Returns the counts of every output in the order they were created
+func (t *Tracker) Outputs() []*OutputCount {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	counts := make([]*OutputCount, 0, len(t.outputs))
+	for _, counter := range t.outputs {
+		counts = append(counts, &OutputCount{
+			Output:  counter.output,
+			Dsn:     counter.dsn,
+			Written: counter.written.Load(),
+			Skipped: counter.skipped.Load(),
+		})
+	}
+	return counts
+}
+
+// A nil counter is valid and counts nothing
+type OutputCounter struct {
+	output string
+	dsn    string
+
+	written atomic.Int64
+	skipped atomic.Int64
+}
+
+func (c *OutputCounter) AddWritten(n int64) {
+	if c == nil {
+		return
+	}
+	c.written.Add(n)
+}
+
+// Skipped rows were intentionally not written, such as rows that conflict with existing rows when on conflict do nothing is set
+func (c *OutputCounter) AddSkipped(n int64) {
+	if c == nil {
+		return
+	}
+	c.skipped.Add(n)
+}
@@ -0,0 +1,50 @@
+package neosync_benthos_rowcount
+
+import (
+	"context"
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/service"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Tracker(t *testing.T) {
+	tracker := NewTracker()
+	tracker.AddRead(5)
+	tracker.AddRead(2)
+
+	insert := tracker.NewOutputCounter("pooled_sql_insert", "dsn")
+	insert.AddWritten(6)
+	insert.AddSkipped(1)
+	s3 := tracker.NewOutputCounter("neosync_aws_s3", "")
+	s3.AddWritten(7)
+
+	require.Equal(t, int64(7), tracker.Read())
+	require.Equal(t, []*OutputCount{
+		{Output: "pooled_sql_insert", Dsn: "dsn", Written: 6, Skipped: 1},
+		{Output: "neosync_aws_s3", Written: 7},
+	}, tracker.Outputs())
+}
+
+func Test_Tracker_Nil(t *testing.T) {
+	var tracker *Tracker
+	tracker.AddRead(1)
+	counter := tracker.NewOutputCounter("pooled_sql_insert", "dsn")
+	require.Nil(t, counter)
+	counter.AddWritten(1)
+	counter.AddSkipped(1)
+	require.Equal(t, int64(0), tracker.Read())
+	require.Empty(t, tracker.Outputs())
+}
+
+func Test_RowCountProcessor(t *testing.T) {
+	tracker := NewTracker()
+	processor := &rowCountProcessor{tracker: tracker}
+
+	batch := service.MessageBatch{service.NewMessage([]byte("a")), service.NewMessage([]byte("b"))}
+	out, err := processor.ProcessBatch(context.Background(), batch)
+	require.NoError(t, err)
+	require.Equal(t, []service.MessageBatch{batch}, out)
+	require.Equal(t, int64(2), tracker.Read())
+	require.NoError(t, processor.Close(context.Background()))
+}
@@ -0,0 +1,93 @@
+package neosync_benthos_sql
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// postgres and mysql both cap the number of bind parameters in a single statement
+	maxStatementParams = 65535
+
+	defaultAdaptiveStartSize = 100
+	defaultAdaptiveMaxSize   = 1000
+	defaultAdaptiveMinSize   = 10
+	// the latency a single insert statement should stay under
+	defaultAdaptiveTargetLatency = time.Second
+	// the rough amount of row data a single insert statement should carry
+	defaultAdaptiveTargetBytes = 4 * 1024 * 1024
+	// weight of the newest observation in the moving average of row width
+	rowBytesSmoothing = 0.2
+)
+
+// Adjusts how many rows are written per insert statement for a single table.
+// The size grows while writes are fast and shrinks when they slow down or fail, and is always capped so that
+// wide rows do not build oversized statements.
+type adaptiveBatchController struct {
+	mu sync.Mutex
+
+	size    int
+	minSize int
+	maxSize int
+
+	targetLatency time.Duration
+	targetBytes   int
+	// moving average of the observed row width, zero until the first observation
+	avgRowBytes float64
+}
+
+func newAdaptiveBatchController(maxSize, numColumns int) *adaptiveBatchController {
+	if numColumns > 0 {
+		maxSize = min(maxSize, maxStatementParams/numColumns)
+	}
+	maxSize = max(maxSize, 1)
+	minSize := min(defaultAdaptiveMinSize, maxSize)
+	return &adaptiveBatchController{
+		size:          max(min(defaultAdaptiveStartSize, maxSize), minSize),
+		minSize:       minSize,
+		maxSize:       maxSize,
+		targetLatency: defaultAdaptiveTargetLatency,
+		targetBytes:   defaultAdaptiveTargetBytes,
+	}
+}
+
+// Returns the number of rows the next statement should contain
+func (c *adaptiveBatchController) NextSize() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	size := c.size
+	if c.avgRowBytes > 0 {
+		byteCap := int(float64(c.targetBytes) / c.avgRowBytes)
+		size = min(size, max(byteCap, c.minSize))
+	}
+	return size
+}
+
+// Records the outcome of writing a statement of the given number of rows and bytes
+func (c *adaptiveBatchController) Observe(rows, bytes int, latency time.Duration, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if rows > 0 && bytes > 0 {
+		rowBytes := float64(bytes) / float64(rows)
+		if c.avgRowBytes == 0 {
+			c.avgRowBytes = rowBytes
+		} else {
+			c.avgRowBytes = rowBytesSmoothing*rowBytes + (1-rowBytesSmoothing)*c.avgRowBytes
+		}
+	}
+
+	switch {
+	case err != nil:
+		c.size = c.size / 2
+	case latency > 2*c.targetLatency:
+		c.size = c.size / 2
+	case latency > c.targetLatency:
+		c.size = int(math.Floor(float64(c.size) * 0.75))
+	case latency < c.targetLatency/2 && rows >= c.size:
+		// only grow when the statement was full, otherwise a small trailing batch would inflate the size
+		c.size += max(c.size/4, 1)
+	}
+	c.size = min(max(c.size, c.minSize), c.maxSize)
+}
@@ -0,0 +1,65 @@
+package neosync_benthos_sql
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_newAdaptiveBatchController(t *testing.T) {
+	controller := newAdaptiveBatchController(1000, 2)
+	require.Equal(t, 1000, controller.maxSize)
+	require.Equal(t, defaultAdaptiveStartSize, controller.NextSize())
+
+	// wide tables are capped by the statement parameter limit
+	controller = newAdaptiveBatchController(1000, 1000)
+	require.Equal(t, 65, controller.maxSize)
+	require.Equal(t, 65, controller.NextSize())
+
+	controller = newAdaptiveBatchController(5, 1)
+	require.Equal(t, 5, controller.minSize)
+	require.Equal(t, 5, controller.NextSize())
+}
+
+func Test_adaptiveBatchController_Observe(t *testing.T) {
+	controller := newAdaptiveBatchController(1000, 1)
+
+	controller.Observe(100, 100, time.Millisecond, nil)
+	require.Equal(t, 125, controller.NextSize())
+
+	// a partial statement does not grow the size
+	controller.Observe(50, 50, time.Millisecond, nil)
+	require.Equal(t, 125, controller.NextSize())
+
+	controller.Observe(125, 125, 1500*time.Millisecond, nil)
+	require.Equal(t, 93, controller.NextSize())
+
+	controller.Observe(93, 93, 3*time.Second, nil)
+	require.Equal(t, 46, controller.NextSize())
+
+	controller.Observe(46, 46, time.Millisecond, errors.New("too many parameters"))
+	require.Equal(t, 23, controller.NextSize())
+
+	for range 5 {
+		controller.Observe(1, 1, time.Millisecond, errors.New("timeout"))
+	}
+	require.Equal(t, defaultAdaptiveMinSize, controller.NextSize())
+
+	for range 100 {
+		controller.Observe(controller.NextSize(), controller.NextSize(), time.Millisecond, nil)
+	}
+	require.Equal(t, 1000, controller.NextSize())
+}
+
+func Test_adaptiveBatchController_RowWidth(t *testing.T) {
+	controller := newAdaptiveBatchController(1000, 1)
+	controller.Observe(10, 10*1024*1024, time.Millisecond, nil)
+	// 1MB rows only fit 4 rows in the target statement size, but the size never drops below the minimum
+	require.Equal(t, defaultAdaptiveMinSize, controller.NextSize())
+
+	controller = newAdaptiveBatchController(1000, 1)
+	controller.Observe(100, 100*64*1024, time.Millisecond, nil)
+	require.Equal(t, 64, controller.NextSize())
+}
@@ -0,0 +1,369 @@
+package neosync_benthos_sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/Jeffail/shutdown"
+	"github.com/benthosdev/benthos/v4/public/service"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	mysql_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/mysql"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/internal/benthos"
+)
+
+func sqlPagedInputSpec() *service.ConfigSpec {
+	return service.NewConfigSpec().
+		Field(service.NewStringField("driver")).
+		Field(service.NewStringField("dsn")).
+		Field(service.NewStringField("schema")).
+		Field(service.NewStringField("table")).
+		Field(service.NewStringListField("columns")).
+		Field(service.NewStringListField("key_columns")).
+		Field(service.NewIntField("page_size"))
+}
+
+// Called with the number of pages of the table that have been read and acknowledged by the output so far.
+// Pages are only counted in order, so a page that finishes before the pages ahead of it is reported once they have finished
+type PageCompletedFunc func(schema, table string, completedPages uint32)
+
+// Registers an input on a benthos environment called pooled_sql_paged.
+// The input reads a table in pages of page_size rows ordered by its key columns, starting each page after the last key of the page before it,
+// so that no single query has to hold the whole table open.
+// onPageCompleted is optional and is called each time another page of the table has been fully written
+func RegisterPooledSqlPagedInput(env *service.Environment, dbprovider DbPoolProvider, stopActivityChannel chan<- error, onPageCompleted PageCompletedFunc) error {
+	return env.RegisterInput(
+		"pooled_sql_paged", sqlPagedInputSpec(),
+		func(conf *service.ParsedConfig, mgr *service.Resources) (service.Input, error) {
+			input, err := newPagedInput(conf, mgr, dbprovider, stopActivityChannel, onPageCompleted)
+			if err != nil {
+				return nil, err
+			}
+			return input, nil
+		},
+	)
+}
+
+type pooledPagedInput struct {
+	driver   string
+	dsn      string
+	provider DbPoolProvider
+	logger   *service.Logger
+
+	schema     string
+	table      string
+	columns    []string
+	keyColumns []string
+	pageSize   int
+
+	db    mysql_queries.DBTX
+	dbMut sync.Mutex
+	rows  *sql.Rows
+	// the key of the last row that was read, which the next page starts after. Nil until the first page has been read
+	lastKey []any
+	// the rows that have been read from the current page
+	pageRows int
+	// set once a page comes back with fewer rows than the page size
+	isDone bool
+
+	onPageCompleted PageCompletedFunc
+	pageMut         sync.Mutex
+	// the pages that have been read but not yet completed, oldest first
+	pendingPages []*pagedInputPage
+	// the number of pages that have been read and fully acknowledged, in order
+	completedPages uint32
+
+	shutSig *shutdown.Signaller
+
+	stopActivityChannel chan<- error
+}
+
+// Tracks the messages of a single page that are still waiting to be acknowledged
+type pagedInputPage struct {
+	unacked int
+	// set once every row of the page has been read
+	isRead bool
+	// set if any of the page's messages were acknowledged with an error. The page is never completed
+	isFailed bool
+}
+
+func newPagedInput(conf *service.ParsedConfig, mgr *service.Resources, dbprovider DbPoolProvider, channel chan<- error, onPageCompleted PageCompletedFunc) (*pooledPagedInput, error) {
+	driver, err := conf.FieldString("driver")
+	if err != nil {
+		return nil, err
+	}
+	dsn, err := conf.FieldString("dsn")
+	if err != nil {
+		return nil, err
+	}
+	schema, err := conf.FieldString("schema")
+	if err != nil {
+		return nil, err
+	}
+	table, err := conf.FieldString("table")
+	if err != nil {
+		return nil, err
+	}
+	columns, err := conf.FieldStringList("columns")
+	if err != nil {
+		return nil, err
+	}
+	keyColumns, err := conf.FieldStringList("key_columns")
+	if err != nil {
+		return nil, err
+	}
+	if len(keyColumns) == 0 {
+		return nil, errors.New("pooled_sql_paged requires at least one key column to page by")
+	}
+	pageSize, err := conf.FieldInt("page_size")
+	if err != nil {
+		return nil, err
+	}
+	if pageSize <= 0 {
+		return nil, errors.New("pooled_sql_paged page_size must be greater than zero")
+	}
+
+	return &pooledPagedInput{
+		logger:              mgr.Logger(),
+		shutSig:             shutdown.NewSignaller(),
+		driver:              driver,
+		dsn:                 dsn,
+		schema:              schema,
+		table:               table,
+		columns:             columns,
+		keyColumns:          keyColumns,
+		pageSize:            pageSize,
+		provider:            dbprovider,
+		stopActivityChannel: channel,
+		onPageCompleted:     onPageCompleted,
+	}, nil
+}
+
+var _ service.Input = &pooledPagedInput{}
+
+func (s *pooledPagedInput) Connect(ctx context.Context) error {
+	s.logger.Debug("connecting to pooled paged input")
+	s.dbMut.Lock()
+	defer s.dbMut.Unlock()
+
+	if s.db != nil {
+		return nil
+	}
+
+	db, err := s.provider.GetDb(s.driver, s.dsn)
+	if err != nil {
+		return err
+	}
+	s.db = db
+
+	go func() {
+		<-s.shutSig.HardStopChan()
+
+		s.dbMut.Lock()
+		if s.rows != nil {
+			_ = s.rows.Close()
+			s.rows = nil
+		}
+		// not closing the connection here as that is managed by an outside force
+		s.db = nil
+		s.dbMut.Unlock()
+
+		s.shutSig.TriggerHasStopped()
+	}()
+	return nil
+}
+
+func (s *pooledPagedInput) Read(ctx context.Context) (*service.Message, service.AckFunc, error) {
+	s.dbMut.Lock()
+	defer s.dbMut.Unlock()
+
+	if s.db == nil {
+		return nil, nil, service.ErrNotConnected
+	}
+	for {
+		if s.rows == nil {
+			if s.isDone {
+				return nil, nil, service.ErrEndOfInput
+			}
+			if err := s.queryNextPage(ctx); err != nil {
+				return nil, nil, err
+			}
+		}
+		if s.rows.Next() {
+			break
+		}
+		err := s.rows.Err()
+		_ = s.rows.Close()
+		s.rows = nil
+		if err != nil {
+			return nil, nil, err
+		}
+		if s.pageRows < s.pageSize {
+			s.isDone = true
+		}
+		if s.pageRows > 0 {
+			s.markPageRead()
+		}
+	}
+
+	obj, err := sqlRowToMap(s.rows)
+	if err != nil {
+		_ = s.rows.Close()
+		s.rows = nil
+		return nil, nil, err
+	}
+	lastKey := make([]any, len(s.keyColumns))
+	for idx, col := range s.keyColumns {
+		lastKey[idx] = obj[col]
+	}
+	s.lastKey = lastKey
+	s.pageRows++
+
+	msg := service.NewMessage(nil)
+	msg.SetStructured(obj)
+	return msg, s.trackPageMessage(), nil
+}
+
+// Adds a message to the page that is currently being read and returns the ack func that removes it again
+func (s *pooledPagedInput) trackPageMessage() service.AckFunc {
+	if s.onPageCompleted == nil {
+		return emptyAck
+	}
+	s.pageMut.Lock()
+	defer s.pageMut.Unlock()
+	if len(s.pendingPages) == 0 || s.pendingPages[len(s.pendingPages)-1].isRead {
+		s.pendingPages = append(s.pendingPages, &pagedInputPage{})
+	}
+	page := s.pendingPages[len(s.pendingPages)-1]
+	page.unacked++
+	return func(ctx context.Context, err error) error {
+		s.ackPageMessage(page, err)
+		return nil
+	}
+}
+
+// Records that every row of the page that is currently being read has been read
+func (s *pooledPagedInput) markPageRead() {
+	if s.onPageCompleted == nil {
+		return
+	}
+	s.pageMut.Lock()
+	if len(s.pendingPages) > 0 {
+		s.pendingPages[len(s.pendingPages)-1].isRead = true
+	}
+	completed, ok := s.completePages()
+	s.pageMut.Unlock()
+	if ok {
+		s.onPageCompleted(s.schema, s.table, completed)
+	}
+}
+
+func (s *pooledPagedInput) ackPageMessage(page *pagedInputPage, err error) {
+	s.pageMut.Lock()
+	page.unacked--
+	if err != nil {
+		page.isFailed = true
+	}
+	completed, ok := s.completePages()
+	s.pageMut.Unlock()
+	if ok {
+		s.onPageCompleted(s.schema, s.table, completed)
+	}
+}
+
+// Pops the pages off the front of the pending pages that have been read and acknowledged.
+// Returns the new number of completed pages and whether it changed. Must be called with the page mutex held
+func (s *pooledPagedInput) completePages() (uint32, bool) {
+	changed := false
+	for len(s.pendingPages) > 0 {
+		page := s.pendingPages[0]
+		if !page.isRead || page.unacked > 0 || page.isFailed {
+			break
+		}
+		s.pendingPages = s.pendingPages[1:]
+		s.completedPages++
+		changed = true
+	}
+	return s.completedPages, changed
+}
+
+func (s *pooledPagedInput) queryNextPage(ctx context.Context) error {
+	query, args, err := buildPagedSelectQuery(s.driver, s.schema, s.table, s.columns, s.keyColumns, s.lastKey, uint(s.pageSize))
+	if err != nil {
+		return err
+	}
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		if neosync_benthos.IsCriticalError(err.Error()) {
+			s.logger.Error(fmt.Sprintf("Benthos input error - sending stop activity signal: %s ", err.Error()))
+			s.stopActivityChannel <- err
+		}
+		return err
+	}
+	s.rows = rows
+	s.pageRows = 0
+	return nil
+}
+
+func (s *pooledPagedInput) Close(ctx context.Context) error {
+	s.shutSig.TriggerHardStop()
+	s.dbMut.Lock()
+	isNil := s.db == nil
+	s.dbMut.Unlock()
+	if isNil {
+		return nil
+	}
+	select {
+	case <-s.shutSig.HasStoppedChan():
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return nil
+}
+
+// Selects the page of rows that comes after lastKey when ordered by the key columns. A nil lastKey selects the first page
+func buildPagedSelectQuery(
+	driver, schema, table string,
+	columns, keyColumns []string,
+	lastKey []any,
+	pageSize uint,
+) (string, []any, error) {
+	selectColumns := make([]any, len(columns))
+	for i, col := range columns {
+		selectColumns[i] = col
+	}
+	orderColumns := make([]exp.OrderedExpression, len(keyColumns))
+	for i, col := range keyColumns {
+		orderColumns[i] = goqu.C(col).Asc()
+	}
+	query := goqu.Dialect(driver).
+		From(goqu.S(schema).Table(table)).
+		Select(selectColumns...).
+		Order(orderColumns...).
+		Limit(pageSize).
+		Prepared(true)
+	if lastKey != nil {
+		if len(lastKey) != len(keyColumns) {
+			return "", nil, fmt.Errorf("last key has %d values but there are %d key columns", len(lastKey), len(keyColumns))
+		}
+		query = query.Where(buildKeyAfterExpression(keyColumns, lastKey))
+	}
+	return query.ToSQL()
+}
+
+// Matches the keys that come after the given key in the lexicographic order of the key columns
+func buildKeyAfterExpression(keyColumns []string, key []any) exp.Expression {
+	ors := make([]exp.Expression, 0, len(keyColumns))
+	for idx, col := range keyColumns {
+		ands := make([]exp.Expression, 0, idx+1)
+		for prevIdx := 0; prevIdx < idx; prevIdx++ {
+			ands = append(ands, goqu.C(keyColumns[prevIdx]).Eq(key[prevIdx]))
+		}
+		ands = append(ands, goqu.C(col).Gt(key[idx]))
+		ors = append(ors, goqu.And(ands...))
+	}
+	return goqu.Or(ors...)
+}
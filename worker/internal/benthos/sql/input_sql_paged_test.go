@@ -0,0 +1,126 @@
+package neosync_benthos_sql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/benthosdev/benthos/v4/public/service"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SqlPagedInputEmptyShutdown(t *testing.T) {
+	conf := `
+driver: postgres
+dsn: foo
+schema: public
+table: users
+columns: [id, email]
+key_columns: [id]
+page_size: 100
+`
+	spec := sqlPagedInputSpec()
+	env := service.NewEnvironment()
+
+	pagedConfig, err := spec.ParseYAML(conf, env)
+	require.NoError(t, err)
+
+	pagedInput, err := newPagedInput(pagedConfig, service.MockResources(), nil, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, pagedInput.Close(context.Background()))
+}
+
+func Test_buildPagedSelectQuery(t *testing.T) {
+	query, args, err := buildPagedSelectQuery("postgres", "public", "users", []string{"id", "email"}, []string{"id"}, nil, 100)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT "id", "email" FROM "public"."users" ORDER BY "id" ASC LIMIT $1`, query)
+	require.Equal(t, []any{int64(100)}, args)
+
+	query, args, err = buildPagedSelectQuery("postgres", "public", "users", []string{"id", "email"}, []string{"id"}, []any{int64(10)}, 100)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT "id", "email" FROM "public"."users" WHERE ("id" > $1) ORDER BY "id" ASC LIMIT $2`, query)
+	require.Equal(t, []any{int64(10), int64(100)}, args)
+}
+
+func Test_buildPagedSelectQuery_CompositeKey(t *testing.T) {
+	query, args, err := buildPagedSelectQuery("mysql", "public", "orders", []string{"a", "b", "note"}, []string{"a", "b"}, []any{int64(1), "x"}, 50)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT `a`, `b`, `note` FROM `public`.`orders` WHERE ((`a` > ?) OR ((`a` = ?) AND (`b` > ?))) ORDER BY `a` ASC, `b` ASC LIMIT ?", query)
+	require.Equal(t, []any{int64(1), int64(1), "x", int64(50)}, args)
+
+	_, _, err = buildPagedSelectQuery("mysql", "public", "orders", []string{"a"}, []string{"a", "b"}, []any{int64(1)}, 50)
+	require.Error(t, err)
+}
+
+func Test_SqlPagedInput_ReportsCompletedPages(t *testing.T) {
+	completed := []uint32{}
+	pagedInput, mock := newTestPagedInput(t, func(schema, table string, completedPages uint32) {
+		require.Equal(t, "public", schema)
+		require.Equal(t, "users", table)
+		completed = append(completed, completedPages)
+	})
+	mock.ExpectQuery("SELECT").WillReturnRows(sqlmock.NewRows([]string{"id", "email"}).AddRow(int64(1), "a").AddRow(int64(2), "b"))
+	mock.ExpectQuery("SELECT").WillReturnRows(sqlmock.NewRows([]string{"id", "email"}).AddRow(int64(3), "c"))
+	ctx := context.Background()
+
+	_, ack1, err := pagedInput.Read(ctx)
+	require.NoError(t, err)
+	_, ack2, err := pagedInput.Read(ctx)
+	require.NoError(t, err)
+	_, ack3, err := pagedInput.Read(ctx)
+	require.NoError(t, err)
+	require.Empty(t, completed, "the first page is read but none of its rows have been written")
+
+	require.NoError(t, ack2(ctx, nil))
+	require.Empty(t, completed)
+	require.NoError(t, ack1(ctx, nil))
+	require.Equal(t, []uint32{1}, completed)
+
+	_, _, err = pagedInput.Read(ctx)
+	require.ErrorIs(t, err, service.ErrEndOfInput)
+	require.Equal(t, []uint32{1}, completed)
+	require.NoError(t, ack3(ctx, nil))
+	require.Equal(t, []uint32{1, 2}, completed)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_SqlPagedInput_FailedPageIsNotCompleted(t *testing.T) {
+	completed := []uint32{}
+	pagedInput, mock := newTestPagedInput(t, func(schema, table string, completedPages uint32) {
+		completed = append(completed, completedPages)
+	})
+	mock.ExpectQuery("SELECT").WillReturnRows(sqlmock.NewRows([]string{"id", "email"}).AddRow(int64(1), "a"))
+	ctx := context.Background()
+
+	_, ack, err := pagedInput.Read(ctx)
+	require.NoError(t, err)
+	_, _, err = pagedInput.Read(ctx)
+	require.ErrorIs(t, err, service.ErrEndOfInput)
+	require.NoError(t, ack(ctx, errors.New("unable to update row")))
+	require.Empty(t, completed)
+}
+
+func newTestPagedInput(t *testing.T, onPageCompleted PageCompletedFunc) (*pooledPagedInput, sqlmock.Sqlmock) {
+	t.Helper()
+	conf := `
+driver: postgres
+dsn: foo
+schema: public
+table: users
+columns: [id, email]
+key_columns: [id]
+page_size: 2
+`
+	pagedConfig, err := sqlPagedInputSpec().ParseYAML(conf, service.NewEnvironment())
+	require.NoError(t, err)
+
+	pagedInput, err := newPagedInput(pagedConfig, service.MockResources(), nil, nil, onPageCompleted)
+	require.NoError(t, err)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	pagedInput.db = db
+	return pagedInput, mock
+}
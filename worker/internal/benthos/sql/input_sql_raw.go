@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/Jeffail/shutdown"
 	"github.com/benthosdev/benthos/v4/public/bloblang"
@@ -210,14 +211,25 @@ func sqlRowToMap(rows *sql.Rows) (map[string]any, error) {
 	if err := rows.Scan(valuesWrapped...); err != nil {
 		return nil, err
 	}
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
 	jObj := map[string]any{}
 	for i, v := range values {
 		col := columnNames[i]
 		switch t := v.(type) {
+		case time.Time:
+			jObj[col] = formatTemporalValue(columnTypes[i].DatabaseTypeName(), t)
 		case string:
 			jObj[col] = t
 		case []byte:
-			jObj[col] = string(t)
+			// binary values are kept as bytes as they are not valid strings
+			if isBinaryDatabaseType(columnTypes[i].DatabaseTypeName()) {
+				jObj[col] = t
+			} else {
+				jObj[col] = string(t)
+			}
 		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
 			jObj[col] = t
 		case float32, float64:
@@ -230,3 +242,27 @@ func sqlRowToMap(rows *sql.Rows) (map[string]any, error) {
 	}
 	return jObj, nil
 }
+
+// Formats date and timestamp values the way the database outputs them so that they keep their microseconds and offset
+// and are not widened to a different type, e.g. a date becoming a UTC timestamp when it is written to JSON
+func formatTemporalValue(databaseTypeName string, value time.Time) any {
+	switch databaseTypeName {
+	case "DATE":
+		return value.Format("2006-01-02")
+	case "TIMESTAMP", "DATETIME":
+		return value.Format("2006-01-02 15:04:05.999999")
+	case "TIMESTAMPTZ":
+		return value.Format("2006-01-02 15:04:05.999999-07:00")
+	default:
+		return value
+	}
+}
+
+func isBinaryDatabaseType(databaseTypeName string) bool {
+	switch databaseTypeName {
+	case "BYTEA", "BINARY", "VARBINARY", "BLOB", "TINYBLOB", "MEDIUMBLOB", "LONGBLOB":
+		return true
+	default:
+		return false
+	}
+}
@@ -3,6 +3,7 @@ package neosync_benthos_sql
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/benthosdev/benthos/v4/public/service"
 	"github.com/stretchr/testify/require"
@@ -25,3 +26,14 @@ args_mapping: 'root = [this.id]'
 	require.NoError(t, err)
 	require.NoError(t, selectInput.Close(context.Background()))
 }
+
+func Test_formatTemporalValue(t *testing.T) {
+	value := time.Date(2024, 3, 9, 14, 5, 6, 123456000, time.FixedZone("", 5*60*60+30*60))
+
+	require.Equal(t, "2024-03-09", formatTemporalValue("DATE", value))
+	require.Equal(t, "2024-03-09 14:05:06.123456", formatTemporalValue("TIMESTAMP", value))
+	require.Equal(t, "2024-03-09 14:05:06.123456", formatTemporalValue("DATETIME", value))
+	require.Equal(t, "2024-03-09 14:05:06.123456+05:30", formatTemporalValue("TIMESTAMPTZ", value))
+	require.Equal(t, "2024-03-09 14:05:06+05:30", formatTemporalValue("TIMESTAMPTZ", value.Truncate(time.Second)))
+	require.Equal(t, value, formatTemporalValue("UNKNOWN", value))
+}
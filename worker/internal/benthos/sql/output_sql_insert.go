@@ -3,7 +3,9 @@ package neosync_benthos_sql
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/Jeffail/shutdown"
 	"github.com/benthosdev/benthos/v4/public/bloblang"
@@ -12,6 +14,8 @@ import (
 	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
 	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
 	mysql_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/mysql"
+	"github.com/nucleuscloud/neosync/backend/pkg/nonfinite"
+	neosync_benthos_rowcount "github.com/nucleuscloud/neosync/worker/internal/benthos/rowcount"
 )
 
 func sqlInsertOutputSpec() *service.ConfigSpec {
@@ -22,14 +26,22 @@ func sqlInsertOutputSpec() *service.ConfigSpec {
 		Field(service.NewStringField("table")).
 		Field(service.NewStringListField("columns")).
 		Field(service.NewBloblangField("args_mapping").Optional()).
+		Field(service.NewStringMapField("column_expressions").Optional()).
 		Field(service.NewBoolField("on_conflict_do_nothing").Optional().Default(false)).
 		Field(service.NewBoolField("truncate_on_retry").Optional().Default(false)).
+		Field(service.NewBoolField("adaptive_batching").Optional().Default(false)).
 		Field(service.NewIntField("max_in_flight").Default(64)).
 		Field(service.NewBatchPolicyField("batching"))
 }
 
 // Registers an output on a benthos environment called pooled_sql_raw
-func RegisterPooledSqlInsertOutput(env *service.Environment, dbprovider DbPoolProvider, isRetry bool) error {
+func RegisterPooledSqlInsertOutput(
+	env *service.Environment,
+	dbprovider DbPoolProvider,
+	isRetry bool,
+	tracker *neosync_benthos_rowcount.Tracker,
+	nonFinitePolicy nonfinite.Policy,
+) error {
 	return env.RegisterBatchOutput(
 		"pooled_sql_insert", sqlInsertOutputSpec(),
 		func(conf *service.ParsedConfig, mgr *service.Resources) (service.BatchOutput, service.BatchPolicy, int, error) {
@@ -46,6 +58,8 @@ func RegisterPooledSqlInsertOutput(env *service.Environment, dbprovider DbPoolPr
 			if err != nil {
 				return nil, service.BatchPolicy{}, -1, err
 			}
+			out.rowCounter = tracker.NewOutputCounter("pooled_sql_insert", out.dsn)
+			out.nonFinitePolicy = nonFinitePolicy
 			return out, batchPolicy, maxInFlight, nil
 		},
 	)
@@ -58,14 +72,20 @@ type pooledInsertOutput struct {
 	dsn      string
 	provider DbPoolProvider
 	dbMut    sync.RWMutex
-	db       mysql_queries.DBTX
+	db       SqlDbtx
 	logger   *service.Logger
 
 	schema              string
 	table               string
 	columns             []string
+	columnExpressions   map[int]*sqlExpression // column index -> expression evaluated by the database
 	onConflictDoNothing bool
 	truncateOnRetry     bool
+	// nil unless adaptive batching is enabled, in which case batches are split in to statements of varying size
+	batchController *adaptiveBatchController
+	// nil if rows are not being counted
+	rowCounter      *neosync_benthos_rowcount.OutputCounter
+	nonFinitePolicy nonfinite.Policy
 
 	argsMapping *bloblang.Executor
 	shutSig     *shutdown.Signaller
@@ -97,6 +117,21 @@ func newInsertOutput(conf *service.ParsedConfig, mgr *service.Resources, provide
 		return nil, err
 	}
 
+	columnExpressions := map[int]*sqlExpression{}
+	if conf.Contains("column_expressions") {
+		expressions, err := conf.FieldStringMap("column_expressions")
+		if err != nil {
+			return nil, err
+		}
+		for col, expression := range expressions {
+			idx := slices.Index(columns, col)
+			if idx == -1 {
+				return nil, fmt.Errorf("column expression provided for column %s that is not being inserted", col)
+			}
+			columnExpressions[idx] = parseSqlExpression(expression, columns)
+		}
+	}
+
 	onConflictDoNothing, err := conf.FieldBool("on_conflict_do_nothing")
 	if err != nil {
 		return nil, err
@@ -114,6 +149,24 @@ func newInsertOutput(conf *service.ParsedConfig, mgr *service.Resources, provide
 		}
 	}
 
+	adaptiveBatching, err := conf.FieldBool("adaptive_batching")
+	if err != nil {
+		return nil, err
+	}
+	var batchController *adaptiveBatchController
+	if adaptiveBatching {
+		batchPolicy, err := conf.FieldBatchPolicy("batching")
+		if err != nil {
+			return nil, err
+		}
+		// the batch count is the largest statement the controller is allowed to build
+		maxSize := batchPolicy.Count
+		if maxSize <= 0 {
+			maxSize = defaultAdaptiveMaxSize
+		}
+		batchController = newAdaptiveBatchController(maxSize, len(columns))
+	}
+
 	output := &pooledInsertOutput{
 		driver:              driver,
 		dsn:                 dsn,
@@ -124,8 +177,10 @@ func newInsertOutput(conf *service.ParsedConfig, mgr *service.Resources, provide
 		schema:              schema,
 		table:               table,
 		columns:             columns,
+		columnExpressions:   columnExpressions,
 		onConflictDoNothing: onConflictDoNothing,
 		truncateOnRetry:     truncateOnRetry,
+		batchController:     batchController,
 		isRetry:             isRetry,
 	}
 	return output, nil
@@ -181,32 +236,75 @@ func (s *pooledInsertOutput) WriteBatch(ctx context.Context, batch service.Messa
 	if batchLen == 0 {
 		return nil
 	}
-	builder := goqu.Dialect(s.driver)
-	table := goqu.S(s.schema).Table(s.table)
-	insertCols := make([]any, len(s.columns))
-	for i, col := range s.columns {
-		insertCols[i] = col
+	rows, err := s.buildRows(batch)
+	if err != nil {
+		return err
 	}
-	insert := builder.Insert(table).Cols(insertCols...)
-	rows := [][]interface{}{} //nolint:gofmt
+	var inserted int64
+	if s.batchController == nil {
+		inserted, err = s.insertRows(ctx, s.db, rows)
+	} else {
+		batchBytes := 0
+		for _, msg := range batch {
+			bits, err := msg.AsBytes()
+			if err == nil {
+				batchBytes += len(bits)
+			}
+		}
+		inserted, err = s.writeAdaptive(ctx, rows, batchBytes/batchLen)
+	}
+	if err != nil {
+		return err
+	}
+	s.countRows(len(rows), inserted)
+	return nil
+}
 
+// Rows that the database did not insert are only expected when conflicts are ignored
+func (s *pooledInsertOutput) countRows(numRows int, inserted int64) {
+	if s.onConflictDoNothing && inserted < int64(numRows) {
+		s.rowCounter.AddWritten(inserted)
+		s.rowCounter.AddSkipped(int64(numRows) - inserted)
+		return
+	}
+	s.rowCounter.AddWritten(inserted)
+}
+
+func (s *pooledInsertOutput) buildRows(batch service.MessageBatch) ([][]any, error) {
+	rows := [][]any{}
 	for i := range batch {
 		if s.argsMapping == nil {
 			continue
 		}
 		resMsg, err := batch.BloblangQuery(i, s.argsMapping)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		iargs, err := resMsg.AsStructured()
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		args, ok := iargs.([]any)
 		if !ok {
-			return fmt.Errorf("mapping returned non-array result: %T", iargs)
+			return nil, fmt.Errorf("mapping returned non-array result: %T", iargs)
+		}
+		if err := applyNonFinitePolicy(s.nonFinitePolicy, s.columns, args); err != nil {
+			return nil, err
+		}
+
+		// expressions are bound to the row values before any of them are replaced
+		values := slices.Clone(args)
+		for idx, expression := range s.columnExpressions {
+			if idx >= len(args) {
+				return nil, fmt.Errorf("mapping returned %d values but a column expression is set for column %d", len(args), idx)
+			}
+			expr, err := expression.build(values)
+			if err != nil {
+				return nil, err
+			}
+			args[idx] = expr
 		}
 
 		// set any default transformations
@@ -218,6 +316,33 @@ func (s *pooledInsertOutput) WriteBatch(ctx context.Context, batch service.Messa
 
 		rows = append(rows, args)
 	}
+	return rows, nil
+}
+
+// Replaces or rejects NaN and infinite floats before they are handed to the database driver
+func applyNonFinitePolicy(policy nonfinite.Policy, columns []string, args []any) error {
+	for idx, arg := range args {
+		value, err := policy.Apply(arg)
+		if err != nil {
+			if idx < len(columns) {
+				return fmt.Errorf("unable to write column %s: %w", columns[idx], err)
+			}
+			return err
+		}
+		args[idx] = value
+	}
+	return nil
+}
+
+// Returns the number of rows the database inserted
+func (s *pooledInsertOutput) insertRows(ctx context.Context, db mysql_queries.DBTX, rows [][]any) (int64, error) {
+	builder := goqu.Dialect(s.driver)
+	table := goqu.S(s.schema).Table(s.table)
+	insertCols := make([]any, len(s.columns))
+	for i, col := range s.columns {
+		insertCols[i] = col
+	}
+	insert := builder.Insert(table).Cols(insertCols...)
 	// add rows to the dataset
 	for _, row := range rows {
 		insert = insert.Vals(row)
@@ -229,12 +354,68 @@ func (s *pooledInsertOutput) WriteBatch(ctx context.Context, batch service.Messa
 
 	query, args, err := insert.ToSQL()
 	if err != nil {
-		return err
+		return 0, err
 	}
-	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
-		return err
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
 	}
-	return nil
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		// not every driver reports affected rows, in which case the rows are assumed to have been inserted
+		return int64(len(rows)), nil
+	}
+	return inserted, nil
+}
+
+// Writes the rows in statements sized by the batch controller. A failed write is retried with smaller statements
+// until the controller reaches its minimum size, as oversized statements are a common cause of failures for wide tables.
+func (s *pooledInsertOutput) writeAdaptive(ctx context.Context, rows [][]any, rowBytes int) (int64, error) {
+	for {
+		size := s.batchController.NextSize()
+		inserted, err := s.writeInStatements(ctx, rows, size, rowBytes)
+		if err == nil {
+			return inserted, nil
+		}
+		if size <= s.batchController.minSize || ctx.Err() != nil {
+			return 0, err
+		}
+		s.logger.Debugf("retrying insert of %d rows with smaller statements: %s", len(rows), err.Error())
+	}
+}
+
+// Batches that need more than one statement are written in a transaction so that a failure
+// does not leave part of the batch behind when benthos retries it
+func (s *pooledInsertOutput) writeInStatements(ctx context.Context, rows [][]any, size, rowBytes int) (int64, error) {
+	if len(rows) <= size {
+		return s.observedInsert(ctx, s.db, rows, rowBytes)
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	var inserted int64
+	for start := 0; start < len(rows); start += size {
+		n, err := s.observedInsert(ctx, tx, rows[start:min(start+size, len(rows))], rowBytes)
+		if err != nil {
+			if rerr := tx.Rollback(); rerr != nil {
+				s.logger.Warnf("unable to rollback insert transaction: %s", rerr.Error())
+			}
+			return 0, err
+		}
+		inserted += n
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return inserted, nil
+}
+
+func (s *pooledInsertOutput) observedInsert(ctx context.Context, db mysql_queries.DBTX, rows [][]any, rowBytes int) (int64, error) {
+	start := time.Now()
+	inserted, err := s.insertRows(ctx, db, rows)
+	s.batchController.Observe(len(rows), len(rows)*rowBytes, time.Since(start), err)
+	return inserted, err
 }
 
 func (s *pooledInsertOutput) Close(ctx context.Context) error {
@@ -2,9 +2,15 @@ package neosync_benthos_sql
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
 	"testing"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/benthosdev/benthos/v4/public/service"
+	"github.com/nucleuscloud/neosync/backend/pkg/nonfinite"
+	neosync_benthos_rowcount "github.com/nucleuscloud/neosync/worker/internal/benthos/rowcount"
 	"github.com/stretchr/testify/require"
 )
 
@@ -26,3 +32,192 @@ args_mapping: 'root = [this.id]'
 	require.NoError(t, err)
 	require.NoError(t, insertOutput.Close(context.Background()))
 }
+
+func Test_SqlInsertOutputColumnExpressions(t *testing.T) {
+	conf := `
+driver: postgres
+dsn: foo
+schema: bar
+table: baz
+columns:
+  - id
+  - email
+column_expressions:
+  email: "concat(id, '@example.test')"
+args_mapping: 'root = [this.id, this.email]'
+`
+	spec := sqlInsertOutputSpec()
+	env := service.NewEnvironment()
+
+	insertConfig, err := spec.ParseYAML(conf, env)
+	require.NoError(t, err)
+
+	insertOutput, err := newInsertOutput(insertConfig, service.MockResources(), nil, false)
+	require.NoError(t, err)
+	require.Len(t, insertOutput.columnExpressions, 1)
+	require.NotNil(t, insertOutput.columnExpressions[1])
+	require.NoError(t, insertOutput.Close(context.Background()))
+}
+
+func Test_SqlInsertOutputColumnExpressions_UnknownColumn(t *testing.T) {
+	conf := `
+driver: postgres
+dsn: foo
+schema: bar
+table: baz
+columns:
+  - id
+column_expressions:
+  email: "now()"
+args_mapping: 'root = [this.id]'
+`
+	spec := sqlInsertOutputSpec()
+	env := service.NewEnvironment()
+
+	insertConfig, err := spec.ParseYAML(conf, env)
+	require.NoError(t, err)
+
+	_, err = newInsertOutput(insertConfig, service.MockResources(), nil, false)
+	require.Error(t, err)
+}
+
+func Test_SqlInsertOutputAdaptiveBatching(t *testing.T) {
+	insertOutput, mock := newAdaptiveInsertOutput(t)
+	tracker := neosync_benthos_rowcount.NewTracker()
+	insertOutput.rowCounter = tracker.NewOutputCounter("pooled_sql_insert", insertOutput.dsn)
+
+	// the batch is larger than the controller's statement size so it is split in a transaction
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO").WillReturnResult(sqlmock.NewResult(0, 20))
+	mock.ExpectExec("INSERT INTO").WillReturnResult(sqlmock.NewResult(0, 5))
+	mock.ExpectCommit()
+
+	require.NoError(t, insertOutput.WriteBatch(context.Background(), buildIdBatch(25)))
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, []*neosync_benthos_rowcount.OutputCount{{Output: "pooled_sql_insert", Dsn: "foo", Written: 25}}, tracker.Outputs())
+}
+
+func Test_SqlInsertOutputAdaptiveBatching_RetriesSmaller(t *testing.T) {
+	insertOutput, mock := newAdaptiveInsertOutput(t)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO").WillReturnError(errors.New("statement too large"))
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO").WillReturnResult(sqlmock.NewResult(0, 10))
+	mock.ExpectExec("INSERT INTO").WillReturnResult(sqlmock.NewResult(0, 10))
+	mock.ExpectExec("INSERT INTO").WillReturnResult(sqlmock.NewResult(0, 5))
+	mock.ExpectCommit()
+
+	require.NoError(t, insertOutput.WriteBatch(context.Background(), buildIdBatch(25)))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_SqlInsertOutputAdaptiveBatching_MinSizeError(t *testing.T) {
+	insertOutput, mock := newAdaptiveInsertOutput(t)
+	insertOutput.batchController.size = insertOutput.batchController.minSize
+
+	mock.ExpectExec("INSERT INTO").WillReturnError(errors.New("connection reset"))
+
+	require.Error(t, insertOutput.WriteBatch(context.Background(), buildIdBatch(5)))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_SqlInsertOutput_CountsSkippedRows(t *testing.T) {
+	conf := `
+driver: postgres
+dsn: foo
+schema: bar
+table: baz
+columns:
+  - id
+args_mapping: 'root = [this.id]'
+on_conflict_do_nothing: true
+`
+	insertConfig, err := sqlInsertOutputSpec().ParseYAML(conf, service.NewEnvironment())
+	require.NoError(t, err)
+	insertOutput, err := newInsertOutput(insertConfig, service.MockResources(), nil, false)
+	require.NoError(t, err)
+	tracker := neosync_benthos_rowcount.NewTracker()
+	insertOutput.rowCounter = tracker.NewOutputCounter("pooled_sql_insert", insertOutput.dsn)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	insertOutput.db = db
+
+	// conflicting rows are not inserted
+	mock.ExpectExec("INSERT INTO").WillReturnResult(sqlmock.NewResult(0, 3))
+
+	require.NoError(t, insertOutput.WriteBatch(context.Background(), buildIdBatch(5)))
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, []*neosync_benthos_rowcount.OutputCount{{Output: "pooled_sql_insert", Dsn: "foo", Written: 3, Skipped: 2}}, tracker.Outputs())
+}
+
+func Test_SqlInsertOutput_NonFinitePolicy(t *testing.T) {
+	conf := `
+driver: postgres
+dsn: foo
+schema: bar
+table: baz
+columns:
+  - id
+  - score
+args_mapping: 'root = [this.id, this.score]'
+`
+	insertConfig, err := sqlInsertOutputSpec().ParseYAML(conf, service.NewEnvironment())
+	require.NoError(t, err)
+	insertOutput, err := newInsertOutput(insertConfig, service.MockResources(), nil, false)
+	require.NoError(t, err)
+
+	msg := service.NewMessage(nil)
+	msg.SetStructuredMut(map[string]any{"id": int64(1), "score": math.Inf(1)})
+	batch := service.MessageBatch{msg}
+
+	insertOutput.nonFinitePolicy = nonfinite.PolicyNull
+	rows, err := insertOutput.buildRows(batch)
+	require.NoError(t, err)
+	require.Equal(t, [][]any{{int64(1), nil}}, rows)
+
+	insertOutput.nonFinitePolicy = nonfinite.PolicyFail
+	_, err = insertOutput.buildRows(batch)
+	require.ErrorIs(t, err, nonfinite.ErrNonFiniteValue)
+	require.ErrorContains(t, err, "score")
+}
+
+func newAdaptiveInsertOutput(t *testing.T) (*pooledInsertOutput, sqlmock.Sqlmock) {
+	t.Helper()
+	conf := `
+driver: postgres
+dsn: foo
+schema: bar
+table: baz
+columns:
+  - id
+args_mapping: 'root = [this.id]'
+adaptive_batching: true
+batching:
+  count: 20
+`
+	insertConfig, err := sqlInsertOutputSpec().ParseYAML(conf, service.NewEnvironment())
+	require.NoError(t, err)
+
+	insertOutput, err := newInsertOutput(insertConfig, service.MockResources(), nil, false)
+	require.NoError(t, err)
+	require.NotNil(t, insertOutput.batchController)
+	require.Equal(t, 20, insertOutput.batchController.NextSize())
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	insertOutput.db = db
+	return insertOutput, mock
+}
+
+func buildIdBatch(count int) service.MessageBatch {
+	batch := service.MessageBatch{}
+	for i := range count {
+		batch = append(batch, service.NewMessage([]byte(fmt.Sprintf(`{"id":%d}`, i))))
+	}
+	return batch
+}
@@ -14,6 +14,8 @@ import (
 	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
 	"github.com/doug-martin/goqu/v9/exp"
 	mysql_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/mysql"
+	"github.com/nucleuscloud/neosync/backend/pkg/nonfinite"
+	neosync_benthos_rowcount "github.com/nucleuscloud/neosync/worker/internal/benthos/rowcount"
 )
 
 type SqlDbtx interface {
@@ -41,7 +43,12 @@ func sqlUpdateOutputSpec() *service.ConfigSpec {
 }
 
 // Registers an output on a benthos environment called pooled_sql_raw
-func RegisterPooledSqlUpdateOutput(env *service.Environment, dbprovider DbPoolProvider) error {
+func RegisterPooledSqlUpdateOutput(
+	env *service.Environment,
+	dbprovider DbPoolProvider,
+	tracker *neosync_benthos_rowcount.Tracker,
+	nonFinitePolicy nonfinite.Policy,
+) error {
 	return env.RegisterBatchOutput(
 		"pooled_sql_update", sqlUpdateOutputSpec(),
 		func(conf *service.ParsedConfig, mgr *service.Resources) (service.BatchOutput, service.BatchPolicy, int, error) {
@@ -58,6 +65,8 @@ func RegisterPooledSqlUpdateOutput(env *service.Environment, dbprovider DbPoolPr
 			if err != nil {
 				return nil, service.BatchPolicy{}, -1, err
 			}
+			out.rowCounter = tracker.NewOutputCounter("pooled_sql_update", out.dsn)
+			out.nonFinitePolicy = nonFinitePolicy
 			return out, batchPolicy, maxInFlight, nil
 		},
 	)
@@ -77,6 +86,9 @@ type pooledUpdateOutput struct {
 	table     string
 	columns   []string
 	whereCols []string
+	// nil if rows are not being counted
+	rowCounter      *neosync_benthos_rowcount.OutputCounter
+	nonFinitePolicy nonfinite.Policy
 
 	argsMapping *bloblang.Executor
 	shutSig     *shutdown.Signaller
@@ -172,6 +184,7 @@ func (s *pooledUpdateOutput) WriteBatch(ctx context.Context, batch service.Messa
 	builder := goqu.Dialect(s.driver)
 	table := goqu.S(s.schema).Table(s.table)
 
+	var updated int64
 	for i := range batch {
 		if s.argsMapping == nil {
 			continue
@@ -194,6 +207,9 @@ func (s *pooledUpdateOutput) WriteBatch(ctx context.Context, batch service.Messa
 		allCols := []string{}
 		allCols = append(allCols, s.columns...)
 		allCols = append(allCols, s.whereCols...)
+		if err := applyNonFinitePolicy(s.nonFinitePolicy, allCols, args); err != nil {
+			return err
+		}
 
 		colValMap := map[string]any{}
 		for idx, col := range allCols {
@@ -228,7 +244,10 @@ func (s *pooledUpdateOutput) WriteBatch(ctx context.Context, batch service.Messa
 		if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
 			return err
 		}
+		updated++
 	}
+	// rows are only counted once the whole batch has been written as benthos retries failed batches in full
+	s.rowCounter.AddWritten(updated)
 	return nil
 }
 
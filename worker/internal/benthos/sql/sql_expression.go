@@ -0,0 +1,126 @@
+package neosync_benthos_sql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+)
+
+// A SQL expression that is evaluated by the destination database in place of a column value.
+// Unqualified column identifiers are bound to the values of the row that is being written
+type sqlExpression struct {
+	parts []*sqlExpressionPart
+}
+
+type sqlExpressionPart struct {
+	sql       string
+	columnIdx int // only used when sql is empty
+}
+
+// Splits the expression into raw sql and references to the given columns.
+// String literals, function calls, qualified names, and type casts are never treated as column references
+func parseSqlExpression(expression string, columns []string) *sqlExpression {
+	columnIdxs := make(map[string]int, len(columns))
+	for idx, col := range columns {
+		columnIdxs[col] = idx
+	}
+
+	parts := []*sqlExpressionPart{}
+	var sb strings.Builder
+	addColumn := func(idx int) {
+		if sb.Len() > 0 {
+			parts = append(parts, &sqlExpressionPart{sql: sb.String()})
+			sb.Reset()
+		}
+		parts = append(parts, &sqlExpressionPart{columnIdx: idx})
+	}
+
+	for i := 0; i < len(expression); {
+		c := expression[i]
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			end := findQuoteEnd(expression, i)
+			token := expression[i:end]
+			if c != '\'' && !isQualifiedIdentifier(expression, i, end) {
+				ident := strings.ReplaceAll(strings.TrimSuffix(token[1:], string(c)), string([]byte{c, c}), string(c))
+				if idx, ok := columnIdxs[ident]; ok {
+					addColumn(idx)
+					i = end
+					continue
+				}
+			}
+			sb.WriteString(token)
+			i = end
+		case isIdentifierStart(c):
+			end := i + 1
+			for end < len(expression) && isIdentifierChar(expression[end]) {
+				end++
+			}
+			token := expression[i:end]
+			if !isQualifiedIdentifier(expression, i, end) && !strings.HasPrefix(strings.TrimLeft(expression[end:], " "), "(") {
+				if idx, ok := columnIdxs[token]; ok {
+					addColumn(idx)
+					i = end
+					continue
+				}
+			}
+			sb.WriteString(token)
+			i = end
+		default:
+			sb.WriteByte(c)
+			i++
+		}
+	}
+	if sb.Len() > 0 {
+		parts = append(parts, &sqlExpressionPart{sql: sb.String()})
+	}
+	return &sqlExpression{parts: parts}
+}
+
+// Builds the literal expression for a single row
+func (e *sqlExpression) build(values []any) (exp.LiteralExpression, error) {
+	template := strings.Repeat("?", len(e.parts))
+	args := make([]any, 0, len(e.parts))
+	for _, part := range e.parts {
+		if part.sql != "" {
+			// literals without args are written as-is so any placeholders in the raw sql are left alone
+			args = append(args, goqu.L(part.sql))
+			continue
+		}
+		if part.columnIdx >= len(values) {
+			return nil, fmt.Errorf("sql expression references column %d but the row only has %d values", part.columnIdx, len(values))
+		}
+		args = append(args, values[part.columnIdx])
+	}
+	return goqu.L(template, args...), nil
+}
+
+// Returns the index after the closing quote of the quoted string or identifier that starts at the given index
+func findQuoteEnd(expression string, start int) int {
+	quote := expression[start]
+	for i := start + 1; i < len(expression); i++ {
+		if expression[i] != quote {
+			continue
+		}
+		if i+1 < len(expression) && expression[i+1] == quote {
+			i++
+			continue
+		}
+		return i + 1
+	}
+	return len(expression)
+}
+
+func isQualifiedIdentifier(expression string, start, end int) bool {
+	return (start > 0 && (expression[start-1] == '.' || expression[start-1] == ':')) || (end < len(expression) && expression[end] == '.')
+}
+
+func isIdentifierStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentifierChar(c byte) bool {
+	return isIdentifierStart(c) || (c >= '0' && c <= '9') || c == '$'
+}
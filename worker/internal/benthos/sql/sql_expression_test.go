@@ -0,0 +1,40 @@
+package neosync_benthos_sql
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseSqlExpression(t *testing.T) {
+	columns := []string{"id", "email", "Name"}
+
+	type testcase struct {
+		expression string
+		values     []any
+		expected   string
+	}
+	testcases := []testcase{
+		{"now()", []any{1, "foo", "bar"}, `INSERT INTO "users" ("id") VALUES (now())`},
+		{"concat(id, '@example.test')", []any{1, "foo", "bar"}, `INSERT INTO "users" ("id") VALUES (concat(1, '@example.test'))`},
+		{`upper("Name") || 'id?'`, []any{1, "foo", "bar"}, `INSERT INTO "users" ("id") VALUES (upper('bar') || 'id?')`},
+		{"email::text || users.email", []any{1, "foo", nil}, `INSERT INTO "users" ("id") VALUES ('foo'::text || users.email)`},
+		{"coalesce(Name, 'unknown')", []any{1, "foo", nil}, `INSERT INTO "users" ("id") VALUES (coalesce(NULL, 'unknown'))`},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.expression, func(t *testing.T) {
+			expr, err := parseSqlExpression(tc.expression, columns).build(tc.values)
+			require.NoError(t, err)
+			query, _, err := goqu.Dialect("postgres").Insert("users").Cols("id").Vals([]any{expr}).ToSQL()
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, query)
+		})
+	}
+}
+
+func Test_parseSqlExpression_MissingValue(t *testing.T) {
+	_, err := parseSqlExpression("lower(email)", []string{"id", "email"}).build([]any{1})
+	require.Error(t, err)
+}
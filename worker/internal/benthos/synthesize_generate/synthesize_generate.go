@@ -0,0 +1,168 @@
+package synthesizegenerate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/benthosdev/benthos/v4/public/service"
+	"github.com/nucleuscloud/neosync/worker/internal/synthesizer"
+)
+
+func getSpec() *service.ConfigSpec {
+	return service.NewConfigSpec().
+		Field(service.NewStringField("model").Optional()).
+		Field(service.NewStringField("service_url").Optional()).
+		Field(service.NewStringField("model_id").Optional()).
+		Field(service.NewStringListField("columns")).
+		Field(service.NewIntField("count")).
+		Field(service.NewIntField("batch_size"))
+}
+
+func RegisterSynthesizeGenerate(env *service.Environment) error {
+	return env.RegisterBatchInput("synthesize_generate", getSpec(), func(conf *service.ParsedConfig, mgr *service.Resources) (service.BatchInput, error) {
+		rdr, err := newGenerateReader(conf, mgr)
+		if err != nil {
+			return nil, err
+		}
+		return service.AutoRetryNacksBatched(rdr), nil
+	})
+}
+
+type generateReader struct {
+	count     int
+	batchsize int
+	columns   []string
+
+	// exactly one of these is set
+	model    *synthesizer.Model
+	external *synthesizer.ExternalClient
+	modelId  string
+
+	sampler *synthesizer.Sampler
+	mu      sync.Mutex
+
+	log *service.Logger
+}
+
+func newGenerateReader(conf *service.ParsedConfig, mgr *service.Resources) (*generateReader, error) {
+	columns, err := conf.FieldStringList("columns")
+	if err != nil {
+		return nil, err
+	}
+	count, err := conf.FieldInt("count")
+	if err != nil {
+		return nil, err
+	}
+	batchsize, err := conf.FieldInt("batch_size")
+	if err != nil {
+		return nil, err
+	}
+	if batchsize <= 0 {
+		return nil, errors.New("batch_size must be greater than 0")
+	}
+
+	rdr := &generateReader{
+		count:     count,
+		batchsize: batchsize,
+		columns:   columns,
+		log:       mgr.Logger(),
+	}
+
+	switch {
+	case conf.Contains("model"):
+		modelJson, err := conf.FieldString("model")
+		if err != nil {
+			return nil, err
+		}
+		var model synthesizer.Model
+		if err := json.Unmarshal([]byte(modelJson), &model); err != nil {
+			return nil, err
+		}
+		rdr.model = &model
+	case conf.Contains("service_url") && conf.Contains("model_id"):
+		serviceUrl, err := conf.FieldString("service_url")
+		if err != nil {
+			return nil, err
+		}
+		modelId, err := conf.FieldString("model_id")
+		if err != nil {
+			return nil, err
+		}
+		rdr.external = synthesizer.NewExternalClient(serviceUrl, http.DefaultClient)
+		rdr.modelId = modelId
+	default:
+		return nil, errors.New("must provide either a model or a service_url and model_id")
+	}
+	return rdr, nil
+}
+
+var _ service.BatchInput = &generateReader{}
+
+func (b *generateReader) Connect(ctx context.Context) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.model != nil && b.sampler == nil {
+		b.sampler = synthesizer.NewSampler(b.model, time.Now().UnixNano())
+	}
+	return nil
+}
+
+func (b *generateReader) ReadBatch(ctx context.Context) (service.MessageBatch, service.AckFunc, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.model != nil && b.sampler == nil {
+		return nil, nil, service.ErrNotConnected
+	}
+	if b.count <= 0 {
+		return nil, nil, service.ErrEndOfInput
+	}
+	batchSize := min(b.batchsize, b.count)
+
+	var records []map[string]any
+	if b.sampler != nil {
+		records = make([]map[string]any, 0, batchSize)
+		for i := 0; i < batchSize; i++ {
+			records = append(records, b.sampler.Sample())
+		}
+	} else {
+		data, err := b.external.Sample(ctx, b.modelId, batchSize)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(data) == 0 {
+			return nil, nil, errors.New("synthesizer service returned no records")
+		}
+		records = data
+	}
+
+	messageBatch := service.MessageBatch{}
+	for _, record := range records {
+		// only the configured columns are passed along in case the external service returns extra fields
+		structured := make(map[string]any, len(b.columns))
+		for _, col := range b.columns {
+			structured[col] = record[col]
+		}
+		msg := service.NewMessage(nil)
+		msg.SetStructured(structured)
+		messageBatch = append(messageBatch, msg)
+	}
+	b.count -= len(messageBatch)
+	return messageBatch, emptyAck, nil
+}
+
+func (b *generateReader) Close(ctx context.Context) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.sampler = nil
+	return nil
+}
+
+func emptyAck(ctx context.Context, err error) error {
+	// Nacks are handled by AutoRetryNacks because we don't have an explicit
+	// ack mechanism right now.
+	return nil
+}
@@ -0,0 +1,127 @@
+package transformers_dataset
+
+type CityCentroid struct {
+	City      string
+	State     string
+	Country   string
+	Latitude  float64
+	Longitude float64
+}
+
+// The approximate centroids of the cities in the addresses dataset along with the largest cities of the US and the rest of the world
+var CityCentroids = []CityCentroid{
+	{City: "New York", State: "NY", Country: "US", Latitude: 40.7128, Longitude: -74.0060},
+	{City: "Los Angeles", State: "CA", Country: "US", Latitude: 34.0522, Longitude: -118.2437},
+	{City: "Chicago", State: "IL", Country: "US", Latitude: 41.8781, Longitude: -87.6298},
+	{City: "Houston", State: "TX", Country: "US", Latitude: 29.7604, Longitude: -95.3698},
+	{City: "Phoenix", State: "AZ", Country: "US", Latitude: 33.4484, Longitude: -112.0740},
+	{City: "Philadelphia", State: "PA", Country: "US", Latitude: 39.9526, Longitude: -75.1652},
+	{City: "San Antonio", State: "TX", Country: "US", Latitude: 29.4241, Longitude: -98.4936},
+	{City: "San Diego", State: "CA", Country: "US", Latitude: 32.7157, Longitude: -117.1611},
+	{City: "Dallas", State: "TX", Country: "US", Latitude: 32.7767, Longitude: -96.7970},
+	{City: "San Jose", State: "CA", Country: "US", Latitude: 37.3382, Longitude: -121.8863},
+	{City: "Austin", State: "TX", Country: "US", Latitude: 30.2672, Longitude: -97.7431},
+	{City: "Jacksonville", State: "FL", Country: "US", Latitude: 30.3322, Longitude: -81.6557},
+	{City: "Fort Worth", State: "TX", Country: "US", Latitude: 32.7555, Longitude: -97.3308},
+	{City: "Columbus", State: "OH", Country: "US", Latitude: 39.9612, Longitude: -82.9988},
+	{City: "Charlotte", State: "NC", Country: "US", Latitude: 35.2271, Longitude: -80.8431},
+	{City: "San Francisco", State: "CA", Country: "US", Latitude: 37.7749, Longitude: -122.4194},
+	{City: "Indianapolis", State: "IN", Country: "US", Latitude: 39.7684, Longitude: -86.1581},
+	{City: "Seattle", State: "WA", Country: "US", Latitude: 47.6062, Longitude: -122.3321},
+	{City: "Denver", State: "CO", Country: "US", Latitude: 39.7392, Longitude: -104.9903},
+	{City: "Washington", State: "DC", Country: "US", Latitude: 38.9072, Longitude: -77.0369},
+	{City: "Boston", State: "MA", Country: "US", Latitude: 42.3601, Longitude: -71.0589},
+	{City: "Nashville", State: "TN", Country: "US", Latitude: 36.1627, Longitude: -86.7816},
+	{City: "Detroit", State: "MI", Country: "US", Latitude: 42.3314, Longitude: -83.0458},
+	{City: "Oklahoma City", State: "OK", Country: "US", Latitude: 35.4676, Longitude: -97.5164},
+	{City: "Portland", State: "OR", Country: "US", Latitude: 45.5152, Longitude: -122.6784},
+	{City: "Las Vegas", State: "NV", Country: "US", Latitude: 36.1699, Longitude: -115.1398},
+	{City: "Louisville", State: "KY", Country: "US", Latitude: 38.2527, Longitude: -85.7585},
+	{City: "Memphis", State: "TN", Country: "US", Latitude: 35.1495, Longitude: -90.0490},
+	{City: "Baltimore", State: "MD", Country: "US", Latitude: 39.2904, Longitude: -76.6122},
+	{City: "Milwaukee", State: "WI", Country: "US", Latitude: 43.0389, Longitude: -87.9065},
+	{City: "Albuquerque", State: "NM", Country: "US", Latitude: 35.0844, Longitude: -106.6504},
+	{City: "Tucson", State: "AZ", Country: "US", Latitude: 32.2226, Longitude: -110.9747},
+	{City: "Fresno", State: "CA", Country: "US", Latitude: 36.7378, Longitude: -119.7871},
+	{City: "Sacramento", State: "CA", Country: "US", Latitude: 38.5816, Longitude: -121.4944},
+	{City: "Kansas City", State: "MO", Country: "US", Latitude: 39.0997, Longitude: -94.5786},
+	{City: "Atlanta", State: "GA", Country: "US", Latitude: 33.7490, Longitude: -84.3880},
+	{City: "Miami", State: "FL", Country: "US", Latitude: 25.7617, Longitude: -80.1918},
+	{City: "Minneapolis", State: "MN", Country: "US", Latitude: 44.9778, Longitude: -93.2650},
+	{City: "New Orleans", State: "LA", Country: "US", Latitude: 29.9511, Longitude: -90.0715},
+	{City: "Cleveland", State: "OH", Country: "US", Latitude: 41.4993, Longitude: -81.6944},
+	{City: "Cincinnati", State: "OH", Country: "US", Latitude: 39.1031, Longitude: -84.5120},
+	{City: "Tampa", State: "FL", Country: "US", Latitude: 27.9506, Longitude: -82.4572},
+	{City: "Orlando", State: "FL", Country: "US", Latitude: 28.5383, Longitude: -81.3792},
+	{City: "Pittsburgh", State: "PA", Country: "US", Latitude: 40.4406, Longitude: -79.9959},
+	{City: "St. Louis", State: "MO", Country: "US", Latitude: 38.6270, Longitude: -90.1994},
+	{City: "Raleigh", State: "NC", Country: "US", Latitude: 35.7796, Longitude: -78.6382},
+	{City: "Salt Lake City", State: "UT", Country: "US", Latitude: 40.7608, Longitude: -111.8910},
+	{City: "Honolulu", State: "HI", Country: "US", Latitude: 21.3069, Longitude: -157.8583},
+	{City: "Anchorage", State: "AK", Country: "US", Latitude: 61.2181, Longitude: -149.9003},
+	{City: "Oakland", State: "CA", Country: "US", Latitude: 37.8044, Longitude: -122.2712},
+	{City: "Berkeley", State: "CA", Country: "US", Latitude: 37.8715, Longitude: -122.2730},
+	{City: "Hayward", State: "CA", Country: "US", Latitude: 37.6688, Longitude: -122.0808},
+	{City: "Fremont", State: "CA", Country: "US", Latitude: 37.5485, Longitude: -121.9886},
+	{City: "Arvada", State: "CO", Country: "US", Latitude: 39.8028, Longitude: -105.0875},
+	{City: "Montgomery", State: "AL", Country: "US", Latitude: 32.3668, Longitude: -86.3000},
+	{City: "Glendale", State: "AZ", Country: "US", Latitude: 33.5387, Longitude: -112.1860},
+	{City: "Fayetteville", State: "AR", Country: "US", Latitude: 36.0822, Longitude: -94.1719},
+	{City: "Fayetteville", State: "NC", Country: "US", Latitude: 35.0527, Longitude: -78.8784},
+	{City: "Manchester", State: "NH", Country: "US", Latitude: 42.9956, Longitude: -71.4548},
+	{City: "Savannah", State: "GA", Country: "US", Latitude: 32.0809, Longitude: -81.0912},
+	{City: "Panama City", State: "FL", Country: "US", Latitude: 30.1588, Longitude: -85.6602},
+	{City: "Annapolis", State: "MD", Country: "US", Latitude: 38.9784, Longitude: -76.4922},
+	{City: "Toronto", State: "ON", Country: "CA", Latitude: 43.6532, Longitude: -79.3832},
+	{City: "Montreal", State: "QC", Country: "CA", Latitude: 45.5017, Longitude: -73.5673},
+	{City: "Vancouver", State: "BC", Country: "CA", Latitude: 49.2827, Longitude: -123.1207},
+	{City: "Mexico City", Country: "MX", Latitude: 19.4326, Longitude: -99.1332},
+	{City: "Bogota", Country: "CO", Latitude: 4.7110, Longitude: -74.0721},
+	{City: "Lima", Country: "PE", Latitude: -12.0464, Longitude: -77.0428},
+	{City: "Santiago", Country: "CL", Latitude: -33.4489, Longitude: -70.6693},
+	{City: "Buenos Aires", Country: "AR", Latitude: -34.6037, Longitude: -58.3816},
+	{City: "Sao Paulo", Country: "BR", Latitude: -23.5505, Longitude: -46.6333},
+	{City: "Rio de Janeiro", Country: "BR", Latitude: -22.9068, Longitude: -43.1729},
+	{City: "London", Country: "GB", Latitude: 51.5074, Longitude: -0.1278},
+	{City: "Dublin", Country: "IE", Latitude: 53.3498, Longitude: -6.2603},
+	{City: "Paris", Country: "FR", Latitude: 48.8566, Longitude: 2.3522},
+	{City: "Brussels", Country: "BE", Latitude: 50.8503, Longitude: 4.3517},
+	{City: "Amsterdam", Country: "NL", Latitude: 52.3676, Longitude: 4.9041},
+	{City: "Berlin", Country: "DE", Latitude: 52.5200, Longitude: 13.4050},
+	{City: "Zurich", Country: "CH", Latitude: 47.3769, Longitude: 8.5417},
+	{City: "Vienna", Country: "AT", Latitude: 48.2082, Longitude: 16.3738},
+	{City: "Prague", Country: "CZ", Latitude: 50.0755, Longitude: 14.4378},
+	{City: "Warsaw", Country: "PL", Latitude: 52.2297, Longitude: 21.0122},
+	{City: "Copenhagen", Country: "DK", Latitude: 55.6761, Longitude: 12.5683},
+	{City: "Oslo", Country: "NO", Latitude: 59.9139, Longitude: 10.7522},
+	{City: "Stockholm", Country: "SE", Latitude: 59.3293, Longitude: 18.0686},
+	{City: "Helsinki", Country: "FI", Latitude: 60.1699, Longitude: 24.9384},
+	{City: "Madrid", Country: "ES", Latitude: 40.4168, Longitude: -3.7038},
+	{City: "Lisbon", Country: "PT", Latitude: 38.7223, Longitude: -9.1393},
+	{City: "Rome", Country: "IT", Latitude: 41.9028, Longitude: 12.4964},
+	{City: "Athens", Country: "GR", Latitude: 37.9838, Longitude: 23.7275},
+	{City: "Istanbul", Country: "TR", Latitude: 41.0082, Longitude: 28.9784},
+	{City: "Moscow", Country: "RU", Latitude: 55.7558, Longitude: 37.6173},
+	{City: "Cairo", Country: "EG", Latitude: 30.0444, Longitude: 31.2357},
+	{City: "Lagos", Country: "NG", Latitude: 6.5244, Longitude: 3.3792},
+	{City: "Nairobi", Country: "KE", Latitude: -1.2921, Longitude: 36.8219},
+	{City: "Johannesburg", Country: "ZA", Latitude: -26.2041, Longitude: 28.0473},
+	{City: "Cape Town", Country: "ZA", Latitude: -33.9249, Longitude: 18.4241},
+	{City: "Dubai", Country: "AE", Latitude: 25.2048, Longitude: 55.2708},
+	{City: "Mumbai", Country: "IN", Latitude: 19.0760, Longitude: 72.8777},
+	{City: "Delhi", Country: "IN", Latitude: 28.7041, Longitude: 77.1025},
+	{City: "Bangalore", Country: "IN", Latitude: 12.9716, Longitude: 77.5946},
+	{City: "Bangkok", Country: "TH", Latitude: 13.7563, Longitude: 100.5018},
+	{City: "Singapore", Country: "SG", Latitude: 1.3521, Longitude: 103.8198},
+	{City: "Jakarta", Country: "ID", Latitude: -6.2088, Longitude: 106.8456},
+	{City: "Manila", Country: "PH", Latitude: 14.5995, Longitude: 120.9842},
+	{City: "Hong Kong", Country: "HK", Latitude: 22.3193, Longitude: 114.1694},
+	{City: "Shanghai", Country: "CN", Latitude: 31.2304, Longitude: 121.4737},
+	{City: "Beijing", Country: "CN", Latitude: 39.9042, Longitude: 116.4074},
+	{City: "Seoul", Country: "KR", Latitude: 37.5665, Longitude: 126.9780},
+	{City: "Tokyo", Country: "JP", Latitude: 35.6762, Longitude: 139.6503},
+	{City: "Osaka", Country: "JP", Latitude: 34.6937, Longitude: 135.5023},
+	{City: "Sydney", Country: "AU", Latitude: -33.8688, Longitude: 151.2093},
+	{City: "Melbourne", Country: "AU", Latitude: -37.8136, Longitude: 144.9631},
+	{City: "Auckland", Country: "NZ", Latitude: -36.8485, Longitude: 174.7633},
+}
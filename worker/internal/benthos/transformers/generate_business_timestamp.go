@@ -0,0 +1,254 @@
+package transformers
+
+import (
+	"errors"
+	"fmt"
+	"time"
+	// embeds the time zone database so that business hours can be resolved in containers without one
+	_ "time/tzdata"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+const (
+	defaultBusinessTimestampSecondsAfter = 30 * 24 * 60 * 60
+	// the number of candidates that are drawn before giving up on landing in the requested part of the week
+	maxBusinessTimestampAttempts = 100
+)
+
+var businessTimestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999-07",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	time.DateOnly,
+}
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewAnyParam("after").Optional()).
+		Param(bloblang.NewInt64Param("range_start_days_ago").Default(365)).
+		Param(bloblang.NewInt64Param("range_end_days_ago").Default(0)).
+		Param(bloblang.NewFloat64Param("business_hours_weight").Default(0.8)).
+		Param(bloblang.NewInt64Param("business_hours_start").Default(9)).
+		Param(bloblang.NewInt64Param("business_hours_end").Default(17)).
+		Param(bloblang.NewFloat64Param("weekday_weight").Default(0.9)).
+		Param(bloblang.NewStringParam("timezone").Default("UTC")).
+		Param(bloblang.NewInt64Param("max_seconds_after").Default(defaultBusinessTimestampSecondsAfter)).
+		Param(bloblang.NewInt64Param("seed").Optional())
+
+	err := bloblang.RegisterFunctionV2("generate_business_timestamp", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		after, err := args.Get("after")
+		if err != nil {
+			return nil, err
+		}
+
+		rangeStartDaysAgo, err := args.GetInt64("range_start_days_ago")
+		if err != nil {
+			return nil, err
+		}
+
+		rangeEndDaysAgo, err := args.GetInt64("range_end_days_ago")
+		if err != nil {
+			return nil, err
+		}
+
+		businessHoursWeight, err := args.GetFloat64("business_hours_weight")
+		if err != nil {
+			return nil, err
+		}
+
+		businessHoursStart, err := args.GetInt64("business_hours_start")
+		if err != nil {
+			return nil, err
+		}
+
+		businessHoursEnd, err := args.GetInt64("business_hours_end")
+		if err != nil {
+			return nil, err
+		}
+
+		weekdayWeight, err := args.GetFloat64("weekday_weight")
+		if err != nil {
+			return nil, err
+		}
+
+		timezone, err := args.GetString("timezone")
+		if err != nil {
+			return nil, err
+		}
+
+		maxSecondsAfter, err := args.GetInt64("max_seconds_after")
+		if err != nil {
+			return nil, err
+		}
+
+		seedArg, err := args.GetOptionalInt64("seed")
+		if err != nil {
+			return nil, err
+		}
+		var seed int64
+		if seedArg != nil {
+			seed = *seedArg
+		} else {
+			seed, err = transformer_utils.GenerateCryptoSeed()
+			if err != nil {
+				return nil, err
+			}
+		}
+		randomizer := rng.New(seed)
+
+		opts, err := newBusinessTimestampOptions(
+			rangeStartDaysAgo,
+			rangeEndDaysAgo,
+			businessHoursWeight,
+			businessHoursStart,
+			businessHoursEnd,
+			weekdayWeight,
+			timezone,
+			maxSecondsAfter,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return func() (any, error) {
+			res, err := generateBusinessTimestamp(randomizer, opts, after, time.Now())
+			if err != nil {
+				return nil, fmt.Errorf("unable to run generate_business_timestamp: %w", err)
+			}
+			return res, nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+type businessTimestampOptions struct {
+	RangeStartDaysAgo   int64
+	RangeEndDaysAgo     int64
+	BusinessHoursWeight float64
+	BusinessHoursStart  int
+	BusinessHoursEnd    int
+	WeekdayWeight       float64
+	Location            *time.Location
+	MaxSecondsAfter     int64
+}
+
+func newBusinessTimestampOptions(
+	rangeStartDaysAgo, rangeEndDaysAgo int64,
+	businessHoursWeight float64,
+	businessHoursStart, businessHoursEnd int64,
+	weekdayWeight float64,
+	timezone string,
+	maxSecondsAfter int64,
+) (*businessTimestampOptions, error) {
+	if rangeStartDaysAgo < rangeEndDaysAgo {
+		return nil, errors.New("range_start_days_ago must not be less than range_end_days_ago")
+	}
+	if businessHoursWeight < 0 || businessHoursWeight > 1 {
+		return nil, errors.New("business_hours_weight must be between 0 and 1")
+	}
+	if weekdayWeight < 0 || weekdayWeight > 1 {
+		return nil, errors.New("weekday_weight must be between 0 and 1")
+	}
+	if businessHoursStart < 0 || businessHoursEnd > 24 || businessHoursStart >= businessHoursEnd {
+		return nil, errors.New("business hours must start before they end and fall between 0 and 24")
+	}
+	if maxSecondsAfter <= 0 {
+		maxSecondsAfter = defaultBusinessTimestampSecondsAfter
+	}
+	location := time.UTC
+	if timezone != "" {
+		loc, err := time.LoadLocation(timezone)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load timezone: %w", err)
+		}
+		location = loc
+	}
+	return &businessTimestampOptions{
+		RangeStartDaysAgo:   rangeStartDaysAgo,
+		RangeEndDaysAgo:     rangeEndDaysAgo,
+		BusinessHoursWeight: businessHoursWeight,
+		BusinessHoursStart:  int(businessHoursStart),
+		BusinessHoursEnd:    int(businessHoursEnd),
+		WeekdayWeight:       weekdayWeight,
+		Location:            location,
+		MaxSecondsAfter:     maxSecondsAfter,
+	}, nil
+}
+
+// Generates a UTC timestamp within the range that lands in business hours and on a weekday as often as the weights ask for.
+// When after is set, the timestamp is generated after it instead of within the day range
+func generateBusinessTimestamp(randomizer rng.Rand, opts *businessTimestampOptions, after any, now time.Time) (time.Time, error) {
+	start := now.Add(-time.Duration(opts.RangeStartDaysAgo) * 24 * time.Hour)
+	end := now.Add(-time.Duration(opts.RangeEndDaysAgo) * 24 * time.Hour)
+	if after != nil {
+		afterTime, err := anyToTime(after)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("unable to parse after: %w", err)
+		}
+		start = afterTime
+		end = afterTime.Add(time.Duration(opts.MaxSecondsAfter) * time.Second)
+	}
+
+	spanSeconds := int64(end.Sub(start) / time.Second)
+	if spanSeconds <= 0 {
+		return start.UTC(), nil
+	}
+
+	wantWeekday := randomizer.Float64() < opts.WeekdayWeight
+	wantBusinessHours := randomizer.Float64() < opts.BusinessHoursWeight
+
+	// rejection sampling keeps the timestamps uniform within the part of the week that was picked.
+	// Short ranges may not contain that part of the week at all, in which case the last candidate is used
+	var candidate time.Time
+	for i := 0; i < maxBusinessTimestampAttempts; i++ {
+		candidate = start.Add(time.Duration(randomizer.Int63n(spanSeconds+1)) * time.Second)
+		local := candidate.In(opts.Location)
+		isWeekday := local.Weekday() != time.Saturday && local.Weekday() != time.Sunday
+		isBusinessHours := local.Hour() >= opts.BusinessHoursStart && local.Hour() < opts.BusinessHoursEnd
+		if isWeekday == wantWeekday && isBusinessHours == wantBusinessHours {
+			break
+		}
+	}
+	return candidate.UTC(), nil
+}
+
+func anyToTime(value any) (time.Time, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case *time.Time:
+		if v == nil {
+			return time.Time{}, errors.New("time must not be nil")
+		}
+		return *v, nil
+	case string:
+		return parseBusinessTimestamp(v)
+	case []byte:
+		return parseBusinessTimestamp(string(v))
+	case int64:
+		return time.Unix(v, 0), nil
+	case int:
+		return time.Unix(int64(v), 0), nil
+	case float64:
+		return time.Unix(int64(v), 0), nil
+	default:
+		return time.Time{}, fmt.Errorf("unsupported timestamp type: %T", value)
+	}
+}
+
+func parseBusinessTimestamp(value string) (time.Time, error) {
+	for _, layout := range businessTimestampLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unable to parse timestamp: %s", value)
+}
@@ -0,0 +1,103 @@
+package transformers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+	"github.com/stretchr/testify/require"
+)
+
+var businessTimestampNow = time.Date(2024, 6, 12, 15, 0, 0, 0, time.UTC)
+
+func Test_GenerateBusinessTimestamp_Range(t *testing.T) {
+	opts, err := newBusinessTimestampOptions(30, 7, 0.8, 9, 17, 0.9, "UTC", 0)
+	require.NoError(t, err)
+
+	randomizer := rng.New(1)
+	for i := 0; i < 100; i++ {
+		res, err := generateBusinessTimestamp(randomizer, opts, nil, businessTimestampNow)
+		require.NoError(t, err)
+		require.Equal(t, time.UTC, res.Location())
+		require.False(t, res.Before(businessTimestampNow.Add(-30*24*time.Hour)), res)
+		require.False(t, res.After(businessTimestampNow.Add(-7*24*time.Hour)), res)
+	}
+}
+
+func Test_GenerateBusinessTimestamp_Weights(t *testing.T) {
+	opts, err := newBusinessTimestampOptions(365, 0, 1, 9, 17, 1, "America/New_York", 0)
+	require.NoError(t, err)
+	location, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	randomizer := rng.New(1)
+	for i := 0; i < 100; i++ {
+		res, err := generateBusinessTimestamp(randomizer, opts, nil, businessTimestampNow)
+		require.NoError(t, err)
+		local := res.In(location)
+		require.True(t, local.Hour() >= 9 && local.Hour() < 17, "expected business hours: %s", local)
+		require.True(t, local.Weekday() != time.Saturday && local.Weekday() != time.Sunday, "expected a weekday: %s", local)
+	}
+
+	opts, err = newBusinessTimestampOptions(365, 0, 0, 9, 17, 0, "UTC", 0)
+	require.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		res, err := generateBusinessTimestamp(randomizer, opts, nil, businessTimestampNow)
+		require.NoError(t, err)
+		require.False(t, res.Hour() >= 9 && res.Hour() < 17, "expected outside of business hours: %s", res)
+		require.True(t, res.Weekday() == time.Saturday || res.Weekday() == time.Sunday, "expected a weekend: %s", res)
+	}
+}
+
+func Test_GenerateBusinessTimestamp_After(t *testing.T) {
+	opts, err := newBusinessTimestampOptions(365, 0, 0.8, 9, 17, 0.9, "", 3600)
+	require.NoError(t, err)
+
+	createdAt := time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC)
+	randomizer := rng.New(1)
+	for _, after := range []any{createdAt, &createdAt, "2024-01-03T10:00:00Z", []byte("2024-01-03 10:00:00"), createdAt.Unix()} {
+		res, err := generateBusinessTimestamp(randomizer, opts, after, businessTimestampNow)
+		require.NoError(t, err)
+		require.False(t, res.Before(createdAt), res)
+		require.False(t, res.After(createdAt.Add(time.Hour)), res)
+	}
+
+	_, err = generateBusinessTimestamp(randomizer, opts, "yesterday", businessTimestampNow)
+	require.Error(t, err)
+	_, err = generateBusinessTimestamp(randomizer, opts, true, businessTimestampNow)
+	require.Error(t, err)
+}
+
+func Test_newBusinessTimestampOptions_Invalid(t *testing.T) {
+	_, err := newBusinessTimestampOptions(7, 30, 0.8, 9, 17, 0.9, "UTC", 0)
+	require.Error(t, err)
+	_, err = newBusinessTimestampOptions(30, 0, 1.5, 9, 17, 0.9, "UTC", 0)
+	require.Error(t, err)
+	_, err = newBusinessTimestampOptions(30, 0, 0.8, 9, 17, -0.1, "UTC", 0)
+	require.Error(t, err)
+	_, err = newBusinessTimestampOptions(30, 0, 0.8, 17, 9, 0.9, "UTC", 0)
+	require.Error(t, err)
+	_, err = newBusinessTimestampOptions(30, 0, 0.8, 9, 25, 0.9, "UTC", 0)
+	require.Error(t, err)
+	_, err = newBusinessTimestampOptions(30, 0, 0.8, 9, 17, 0.9, "Not/AZone", 0)
+	require.Error(t, err)
+}
+
+func Test_GenerateBusinessTimestamp_Benthos(t *testing.T) {
+	mapping := `root = generate_business_timestamp(after:this.created_at,max_seconds_after:60,seed:1)`
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the business timestamp generator")
+
+	res, err := ex.Query(map[string]any{"created_at": "2024-01-03T10:00:00Z"})
+	require.NoError(t, err)
+	output, ok := res.(time.Time)
+	require.True(t, ok)
+	require.False(t, output.Before(time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC)))
+	require.False(t, output.After(time.Date(2024, 1, 3, 10, 1, 0, 0, time.UTC)))
+
+	res, err = ex.Query(nil)
+	require.NoError(t, err)
+	_, ok = res.(time.Time)
+	require.True(t, ok)
+}
@@ -0,0 +1,116 @@
+package transformers
+
+import (
+	"strings"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+const (
+	ean13Length = 13
+	upcLength   = 12
+	// the GS1 prefix and company number that identify the manufacturer, which is 7 digits for most EAN-13 codes
+	ean13ManufacturerPrefixLength = 7
+	// the number system digit and the 5 digit manufacturer code
+	upcManufacturerPrefixLength = 6
+)
+
+var (
+	// GS1 prefixes of countries that issue a high volume of codes. Prefixes that are reserved for books,
+	// periodicals, coupons and in-store codes are left out
+	ean13Gs1Prefixes = []string{
+		"300", "400", "450", "460", "471", "480", "489", "500", "540", "560",
+		"690", "760", "789", "800", "840", "870", "880", "890", "930", "940",
+	}
+
+	// UPC-A number system digits that are used for regular products
+	upcNumberSystemDigits = []string{"0", "1", "6", "7", "8"}
+)
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewBoolParam("upc").Default(false)).
+		Param(bloblang.NewInt64Param("seed").Optional())
+
+	err := bloblang.RegisterFunctionV2("generate_ean", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		upc, err := args.GetBool("upc")
+		if err != nil {
+			return nil, err
+		}
+
+		seedArg, err := args.GetOptionalInt64("seed")
+		if err != nil {
+			return nil, err
+		}
+		var seed int64
+		if seedArg != nil {
+			seed = *seedArg
+		} else {
+			seed, err = transformer_utils.GenerateCryptoSeed()
+			if err != nil {
+				return nil, err
+			}
+		}
+		randomizer := rng.New(seed)
+
+		length := ean13Length
+		if upc {
+			length = upcLength
+		}
+
+		return func() (any, error) {
+			return generateEan(randomizer, length, ""), nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+/*
+Generates a 13 digit EAN-13 code or a 12 digit UPC-A code that ends in a valid check digit.
+
+The code starts with the given prefix, or with a random GS1 prefix or UPC number system digit when no prefix is given.
+*/
+func generateEan(randomizer rng.Rand, length int, prefix string) string {
+	var sb strings.Builder
+	switch {
+	case prefix != "":
+		sb.WriteString(prefix)
+	case length == upcLength:
+		sb.WriteString(upcNumberSystemDigits[randomizer.Intn(len(upcNumberSystemDigits))])
+	default:
+		sb.WriteString(ean13Gs1Prefixes[randomizer.Intn(len(ean13Gs1Prefixes))])
+	}
+	for sb.Len() < length-1 {
+		sb.WriteByte(byte('0' + randomizer.Intn(10)))
+	}
+	base := sb.String()
+	sb.WriteByte(getEanCheckDigit(base))
+	return sb.String()
+}
+
+// Returns the check digit of the digits of an EAN or UPC code that come before the check digit
+func getEanCheckDigit(base string) byte {
+	sum := 0
+	for idx := range base {
+		digit := int(base[idx] - '0')
+		// every other digit is weighted by 3 starting from the rightmost digit of the base
+		if (len(base)-idx)%2 == 1 {
+			digit *= 3
+		}
+		sum += digit
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+// Returns true if the value is a 13 digit EAN-13 code or a 12 digit UPC-A code with a valid check digit
+func isValidEan(value string) bool {
+	if (len(value) != ean13Length && len(value) != upcLength) || !isDigits(value) {
+		return false
+	}
+	return getEanCheckDigit(value[:len(value)-1]) == value[len(value)-1]
+}
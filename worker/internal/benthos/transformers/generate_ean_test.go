@@ -0,0 +1,58 @@
+package transformers
+
+import (
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GenerateEan(t *testing.T) {
+	randomizer := rng.New(1)
+	for i := 0; i < 100; i++ {
+		res := generateEan(randomizer, ean13Length, "")
+		require.Len(t, res, ean13Length)
+		require.True(t, isValidEan(res), res)
+		require.Contains(t, ean13Gs1Prefixes, res[:3])
+	}
+}
+
+func Test_GenerateEan_Upc(t *testing.T) {
+	randomizer := rng.New(1)
+	for i := 0; i < 100; i++ {
+		res := generateEan(randomizer, upcLength, "")
+		require.Len(t, res, upcLength)
+		require.True(t, isValidEan(res), res)
+		require.Contains(t, upcNumberSystemDigits, res[:1])
+	}
+}
+
+func Test_GenerateEan_Prefix(t *testing.T) {
+	randomizer := rng.New(1)
+	res := generateEan(randomizer, ean13Length, "4006381")
+	require.Equal(t, "4006381", res[:ean13ManufacturerPrefixLength])
+	require.True(t, isValidEan(res), res)
+}
+
+func Test_isValidEan(t *testing.T) {
+	require.True(t, isValidEan("4006381333931"))
+	require.True(t, isValidEan("036000291452"))
+	require.False(t, isValidEan("4006381333932"))
+	require.False(t, isValidEan("036000291453"))
+	require.False(t, isValidEan("40063813339"))
+	require.False(t, isValidEan("400638133393a"))
+}
+
+func Test_GenerateEan_Benthos(t *testing.T) {
+	mapping := `root = generate_ean(upc:true,seed:1)`
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the ean generator")
+
+	res, err := ex.Query(nil)
+	require.NoError(t, err)
+	output, ok := res.(string)
+	require.True(t, ok)
+	require.Len(t, output, upcLength)
+	require.True(t, isValidEan(output), output)
+}
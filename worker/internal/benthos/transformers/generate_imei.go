@@ -0,0 +1,104 @@
+package transformers
+
+import (
+	"strings"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+const (
+	imeiLength = 15
+	// the type allocation code identifies the make and model of the device
+	imeiTypeAllocationCodeLength = 8
+)
+
+var (
+	// the reporting body identifiers that type allocation codes are most commonly issued under
+	imeiReportingBodyIds = []string{"01", "35", "86"}
+)
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewInt64Param("seed").Optional())
+
+	err := bloblang.RegisterFunctionV2("generate_imei", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		seedArg, err := args.GetOptionalInt64("seed")
+		if err != nil {
+			return nil, err
+		}
+		var seed int64
+		if seedArg != nil {
+			seed = *seedArg
+		} else {
+			seed, err = transformer_utils.GenerateCryptoSeed()
+			if err != nil {
+				return nil, err
+			}
+		}
+		randomizer := rng.New(seed)
+
+		return func() (any, error) {
+			return generateImei(randomizer, ""), nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+/*
+Generates a 15 digit IMEI made up of an 8 digit type allocation code, a 6 digit serial number and a Luhn check digit.
+
+The type allocation code is generated at random unless one is given.
+*/
+func generateImei(randomizer rng.Rand, typeAllocationCode string) string {
+	var sb strings.Builder
+	if len(typeAllocationCode) == imeiTypeAllocationCodeLength {
+		sb.WriteString(typeAllocationCode)
+	} else {
+		sb.WriteString(imeiReportingBodyIds[randomizer.Intn(len(imeiReportingBodyIds))])
+	}
+	for sb.Len() < imeiLength-1 {
+		sb.WriteByte(byte('0' + randomizer.Intn(10)))
+	}
+	base := sb.String()
+	sb.WriteByte(getLuhnCheckDigit(base))
+	return sb.String()
+}
+
+// Returns the Luhn check digit of a string of digits
+func getLuhnCheckDigit(base string) byte {
+	sum := 0
+	for idx := range base {
+		digit := int(base[idx] - '0')
+		// every other digit is doubled starting from the rightmost digit of the base
+		if (len(base)-idx)%2 == 1 {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+// Returns true if the value is a 15 digit IMEI with a valid check digit
+func isValidImei(value string) bool {
+	if len(value) != imeiLength || !isDigits(value) {
+		return false
+	}
+	return getLuhnCheckDigit(value[:imeiLength-1]) == value[imeiLength-1]
+}
+
+func isDigits(value string) bool {
+	for idx := range value {
+		if value[idx] < '0' || value[idx] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,46 @@
+package transformers
+
+import (
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GenerateImei(t *testing.T) {
+	randomizer := rng.New(1)
+	for i := 0; i < 100; i++ {
+		res := generateImei(randomizer, "")
+		require.True(t, isValidImei(res), res)
+		require.Contains(t, imeiReportingBodyIds, res[:2])
+	}
+}
+
+func Test_GenerateImei_TypeAllocationCode(t *testing.T) {
+	randomizer := rng.New(1)
+	for i := 0; i < 20; i++ {
+		res := generateImei(randomizer, "35209900")
+		require.Equal(t, "35209900", res[:imeiTypeAllocationCodeLength])
+		require.True(t, isValidImei(res), res)
+	}
+}
+
+func Test_isValidImei(t *testing.T) {
+	require.True(t, isValidImei("490154203237518"))
+	require.False(t, isValidImei("490154203237519"))
+	require.False(t, isValidImei("49015420323751"))
+	require.False(t, isValidImei("49015420323751a"))
+}
+
+func Test_GenerateImei_Benthos(t *testing.T) {
+	mapping := `root = generate_imei(seed:1)`
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the imei generator")
+
+	res, err := ex.Query(nil)
+	require.NoError(t, err)
+	output, ok := res.(string)
+	require.True(t, ok)
+	require.True(t, isValidImei(output), output)
+}
@@ -0,0 +1,105 @@
+package transformers
+
+import (
+	"strings"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+var (
+	// diagnosis codes that are commonly billed across primary care, urgent care and specialty visits
+	icd10Codes = []string{
+		"A09", "B34.9", "C34.90", "C50.919", "C61", "D64.9", "E03.9", "E11.65", "E11.9", "E55.9",
+		"E66.9", "E78.2", "E78.5", "E87.6", "F32.9", "F41.1", "F41.9", "F90.0", "G43.909", "G47.33",
+		"H10.9", "H66.90", "I10", "I25.10", "I48.91", "I50.9", "I63.9", "J01.90", "J02.9", "J06.9",
+		"J18.9", "J20.9", "J44.9", "J45.909", "K21.9", "K57.30", "K59.00", "L30.9", "M17.11", "M25.561",
+		"M54.2", "M54.50", "M79.1", "N18.30", "N39.0", "O80", "R05.9", "R07.9", "R10.9", "R11.2",
+		"R42", "R51.9", "R53.83", "S61.411A", "S93.401A", "T78.40XA", "U07.1", "Z00.00", "Z00.01", "Z23",
+		"Z34.90", "Z51.11", "Z79.4", "Z79.899",
+	}
+
+	// procedure codes that are commonly billed, covering office visits, labs, imaging, therapy and common procedures
+	cptCodes = []string{
+		"11042", "12001", "20610", "29881", "36415", "43239", "45378", "59400", "66984", "70450",
+		"71046", "72148", "73030", "73560", "74177", "77067", "80053", "80061", "81001", "83036",
+		"84443", "85025", "87086", "88305", "90471", "90686", "90791", "90834", "90837", "93000",
+		"93306", "96372", "97110", "97140", "97530", "99202", "99203", "99204", "99205", "99211",
+		"99212", "99213", "99214", "99215", "99223", "99232", "99233", "99283", "99284", "99285",
+		"99385", "99386", "99395", "99396",
+	}
+)
+
+func init() {
+	icd10Spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewBoolParam("exclude_decimal_point").Default(false)).
+		Param(bloblang.NewInt64Param("seed").Optional())
+
+	err := bloblang.RegisterFunctionV2("generate_icd10_code", icd10Spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		excludeDecimalPoint, err := args.GetBool("exclude_decimal_point")
+		if err != nil {
+			return nil, err
+		}
+
+		randomizer, err := getMedicalCodeRandomizer(args)
+		if err != nil {
+			return nil, err
+		}
+
+		return func() (any, error) {
+			return generateIcd10Code(randomizer, excludeDecimalPoint), nil
+		}, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	cptSpec := bloblang.NewPluginSpec().
+		Param(bloblang.NewInt64Param("seed").Optional())
+
+	err = bloblang.RegisterFunctionV2("generate_cpt_code", cptSpec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		randomizer, err := getMedicalCodeRandomizer(args)
+		if err != nil {
+			return nil, err
+		}
+
+		return func() (any, error) {
+			return generateCptCode(randomizer), nil
+		}, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+func getMedicalCodeRandomizer(args *bloblang.ParsedParams) (rng.Rand, error) {
+	seedArg, err := args.GetOptionalInt64("seed")
+	if err != nil {
+		return nil, err
+	}
+	var seed int64
+	if seedArg != nil {
+		seed = *seedArg
+	} else {
+		seed, err = transformer_utils.GenerateCryptoSeed()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rng.New(seed), nil
+}
+
+// Generates an ICD-10-CM diagnosis code such as E11.9. Codes are stored both with and without their decimal point
+func generateIcd10Code(randomizer rng.Rand, excludeDecimalPoint bool) string {
+	code := icd10Codes[randomizer.Intn(len(icd10Codes))]
+	if excludeDecimalPoint {
+		return strings.ReplaceAll(code, ".", "")
+	}
+	return code
+}
+
+// Generates a five digit CPT procedure code such as 99213
+func generateCptCode(randomizer rng.Rand) string {
+	return cptCodes[randomizer.Intn(len(cptCodes))]
+}
@@ -0,0 +1,38 @@
+package transformers
+
+import (
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GenerateIcd10Code(t *testing.T) {
+	randomizer := rng.New(1)
+	for i := 0; i < 100; i++ {
+		require.Regexp(t, `^[A-Z]\d[0-9A-Z](\.[0-9A-Z]{1,4})?$`, generateIcd10Code(randomizer, false))
+		require.Regexp(t, `^[A-Z]\d[0-9A-Z]{1,5}$`, generateIcd10Code(randomizer, true))
+	}
+}
+
+func Test_GenerateCptCode(t *testing.T) {
+	randomizer := rng.New(1)
+	for i := 0; i < 100; i++ {
+		require.Regexp(t, `^\d{5}$`, generateCptCode(randomizer))
+	}
+}
+
+func Test_GenerateMedicalCodes_Benthos(t *testing.T) {
+	ex, err := bloblang.Parse(`root = generate_icd10_code(exclude_decimal_point:true,seed:1)`)
+	require.NoError(t, err, "failed to parse the icd10 code generator")
+	res, err := ex.Query(nil)
+	require.NoError(t, err)
+	require.NotContains(t, res, ".")
+
+	ex, err = bloblang.Parse(`root = generate_cpt_code(seed:1)`)
+	require.NoError(t, err, "failed to parse the cpt code generator")
+	res, err = ex.Query(nil)
+	require.NoError(t, err)
+	require.Contains(t, cptCodes, res)
+}
@@ -0,0 +1,71 @@
+package transformers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+const (
+	defaultMedicalRecordNumberLength = 8
+	maxMedicalRecordNumberLength     = 32
+)
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewStringParam("prefix").Default("")).
+		Param(bloblang.NewInt64Param("length").Default(defaultMedicalRecordNumberLength)).
+		Param(bloblang.NewInt64Param("seed").Optional())
+
+	err := bloblang.RegisterFunctionV2("generate_medical_record_number", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		prefix, err := args.GetString("prefix")
+		if err != nil {
+			return nil, err
+		}
+
+		length, err := args.GetInt64("length")
+		if err != nil {
+			return nil, err
+		}
+		if length <= 0 || length > maxMedicalRecordNumberLength {
+			return nil, fmt.Errorf("length must be between 1 and %d, received: %d", maxMedicalRecordNumberLength, length)
+		}
+
+		seedArg, err := args.GetOptionalInt64("seed")
+		if err != nil {
+			return nil, err
+		}
+		var seed int64
+		if seedArg != nil {
+			seed = *seedArg
+		} else {
+			seed, err = transformer_utils.GenerateCryptoSeed()
+			if err != nil {
+				return nil, err
+			}
+		}
+		randomizer := rng.New(seed)
+
+		return func() (any, error) {
+			return generateMedicalRecordNumber(randomizer, prefix, int(length)), nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Generates a record number made up of the prefix followed by the given number of digits.
+// Record numbers are usually zero padded, so the digits may start with a zero
+func generateMedicalRecordNumber(randomizer rng.Rand, prefix string, length int) string {
+	var sb strings.Builder
+	sb.WriteString(prefix)
+	for i := 0; i < length; i++ {
+		sb.WriteByte(byte('0' + randomizer.Intn(10)))
+	}
+	return sb.String()
+}
@@ -0,0 +1,27 @@
+package transformers
+
+import (
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GenerateMedicalRecordNumber(t *testing.T) {
+	res := generateMedicalRecordNumber(rng.New(1), "MRN-", 6)
+	require.Regexp(t, `^MRN-\d{6}$`, res)
+}
+
+func Test_GenerateMedicalRecordNumber_Benthos(t *testing.T) {
+	mapping := `root = generate_medical_record_number(seed:1)`
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the medical record number generator")
+
+	res, err := ex.Query(nil)
+	require.NoError(t, err)
+	require.Regexp(t, `^\d{8}$`, res)
+
+	_, err = bloblang.Parse(`root = generate_medical_record_number(length:0)`)
+	require.Error(t, err)
+}
@@ -0,0 +1,137 @@
+package transformers
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+// the number of amounts that are drawn before giving up on landing between min and max
+const maxGenerateMoneyAttempts = 100
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewFloat64Param("median").Default(20)).
+		Param(bloblang.NewFloat64Param("sigma").Default(1)).
+		Param(bloblang.NewFloat64Param("min").Optional()).
+		Param(bloblang.NewFloat64Param("max").Optional()).
+		Param(bloblang.NewInt64Param("decimal_places").Default(defaultMoneyDecimalPlaces)).
+		Param(bloblang.NewInt64Param("seed").Optional())
+
+	err := bloblang.RegisterFunctionV2("generate_money", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		median, err := args.GetFloat64("median")
+		if err != nil {
+			return nil, err
+		}
+
+		sigma, err := args.GetFloat64("sigma")
+		if err != nil {
+			return nil, err
+		}
+
+		minValue, err := args.GetOptionalFloat64("min")
+		if err != nil {
+			return nil, err
+		}
+
+		maxValue, err := args.GetOptionalFloat64("max")
+		if err != nil {
+			return nil, err
+		}
+
+		decimalPlaces, err := args.GetInt64("decimal_places")
+		if err != nil {
+			return nil, err
+		}
+
+		seedArg, err := args.GetOptionalInt64("seed")
+		if err != nil {
+			return nil, err
+		}
+		var seed int64
+		if seedArg != nil {
+			seed = *seedArg
+		} else {
+			seed, err = transformer_utils.GenerateCryptoSeed()
+			if err != nil {
+				return nil, err
+			}
+		}
+		randomizer := rng.New(seed)
+
+		opts, err := newGenerateMoneyOptions(median, sigma, minValue, maxValue, decimalPlaces)
+		if err != nil {
+			return nil, err
+		}
+
+		return func() (any, error) {
+			return generateMoney(randomizer, opts), nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+type generateMoneyOptions struct {
+	// the mean of the natural log of the amounts, which is the log of the median
+	Mu            float64
+	Sigma         float64
+	Min           *float64
+	Max           *float64
+	DecimalPlaces int
+}
+
+func newGenerateMoneyOptions(median, sigma float64, minValue, maxValue *float64, decimalPlaces int64) (*generateMoneyOptions, error) {
+	if median <= 0 {
+		return nil, errors.New("median must be greater than 0")
+	}
+	if sigma < 0 {
+		return nil, errors.New("sigma must not be negative")
+	}
+	if minValue != nil && maxValue != nil && *minValue > *maxValue {
+		return nil, fmt.Errorf("min must not be greater than max: %f > %f", *minValue, *maxValue)
+	}
+	if decimalPlaces < 0 {
+		decimalPlaces = defaultMoneyDecimalPlaces
+	}
+	return &generateMoneyOptions{
+		Mu:            math.Log(median),
+		Sigma:         sigma,
+		Min:           minValue,
+		Max:           maxValue,
+		DecimalPlaces: int(decimalPlaces),
+	}, nil
+}
+
+// Generates an amount from the log-normal distribution. Amounts outside of min and max are redrawn,
+// and if none of the draws land in range the closest bound is used
+func generateMoney(randomizer rng.Rand, opts *generateMoneyOptions) float64 {
+	var amount float64
+	for i := 0; i < maxGenerateMoneyAttempts; i++ {
+		amount = roundMoney(math.Exp(opts.Mu+opts.Sigma*normalFloat64(randomizer)), opts.DecimalPlaces)
+		if (opts.Min == nil || amount >= *opts.Min) && (opts.Max == nil || amount <= *opts.Max) {
+			return amount
+		}
+	}
+	if opts.Min != nil && amount < *opts.Min {
+		return *opts.Min
+	}
+	if opts.Max != nil && amount > *opts.Max {
+		return *opts.Max
+	}
+	return amount
+}
+
+// Returns a standard normally distributed value using the Box-Muller transform
+func normalFloat64(randomizer rng.Rand) float64 {
+	// 1 - Float64 is in (0, 1], which keeps the log finite
+	u1 := 1 - randomizer.Float64()
+	u2 := randomizer.Float64()
+	return math.Sqrt(-2*math.Log(u1)) * math.Cos(2*math.Pi*u2)
+}
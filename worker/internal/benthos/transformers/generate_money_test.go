@@ -0,0 +1,72 @@
+package transformers
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GenerateMoney_Median(t *testing.T) {
+	opts, err := newGenerateMoneyOptions(50, 0.5, nil, nil, 2)
+	require.NoError(t, err)
+
+	randomizer := rng.New(1)
+	amounts := make([]float64, 0, 1001)
+	for i := 0; i < 1001; i++ {
+		amount := generateMoney(randomizer, opts)
+		require.Greater(t, amount, float64(0))
+		require.Equal(t, roundMoney(amount, 2), amount)
+		amounts = append(amounts, amount)
+	}
+	sort.Float64s(amounts)
+	require.InDelta(t, 50, amounts[500], 5, "the sample median should be close to the configured median")
+}
+
+func Test_GenerateMoney_Bounds(t *testing.T) {
+	minValue := float64(10)
+	maxValue := float64(20)
+	opts, err := newGenerateMoneyOptions(100, 2, &minValue, &maxValue, 0)
+	require.NoError(t, err)
+
+	randomizer := rng.New(1)
+	for i := 0; i < 100; i++ {
+		amount := generateMoney(randomizer, opts)
+		require.GreaterOrEqual(t, amount, minValue)
+		require.LessOrEqual(t, amount, maxValue)
+		require.Equal(t, math.Round(amount), amount)
+	}
+}
+
+func Test_GenerateMoney_ZeroSigma(t *testing.T) {
+	opts, err := newGenerateMoneyOptions(19.99, 0, nil, nil, 2)
+	require.NoError(t, err)
+	require.Equal(t, 19.99, generateMoney(rng.New(1), opts))
+}
+
+func Test_GenerateMoney_InvalidOptions(t *testing.T) {
+	_, err := newGenerateMoneyOptions(0, 1, nil, nil, 2)
+	require.Error(t, err)
+	_, err = newGenerateMoneyOptions(10, -1, nil, nil, 2)
+	require.Error(t, err)
+	minValue := float64(20)
+	maxValue := float64(10)
+	_, err = newGenerateMoneyOptions(10, 1, &minValue, &maxValue, 2)
+	require.Error(t, err)
+}
+
+func Test_GenerateMoney_Benthos(t *testing.T) {
+	mapping := `root = generate_money(median:25,sigma:1,min:1,max:500,decimal_places:2,seed:1)`
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the money generator")
+
+	res, err := ex.Query(nil)
+	require.NoError(t, err)
+	output, ok := res.(float64)
+	require.True(t, ok)
+	require.GreaterOrEqual(t, output, float64(1))
+	require.LessOrEqual(t, output, float64(500))
+}
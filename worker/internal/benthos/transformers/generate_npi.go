@@ -0,0 +1,95 @@
+package transformers
+
+import (
+	"strings"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+const (
+	npiLength = 10
+	// the prefix of the health industry card issuer number that is included when computing the check digit of an NPI
+	npiLuhnPrefixSum = 24
+)
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewInt64Param("seed").Optional())
+
+	err := bloblang.RegisterFunctionV2("generate_npi", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		seedArg, err := args.GetOptionalInt64("seed")
+		if err != nil {
+			return nil, err
+		}
+		var seed int64
+		if seedArg != nil {
+			seed = *seedArg
+		} else {
+			seed, err = transformer_utils.GenerateCryptoSeed()
+			if err != nil {
+				return nil, err
+			}
+		}
+		randomizer := rng.New(seed)
+
+		return func() (any, error) {
+			return generateNpi(randomizer, 0), nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+/*
+Generates a 10 digit National Provider Identifier. The first digit is 1 for individual providers or 2 for organizations,
+and the last digit is a Luhn check digit that is computed as if the number were prefixed by 80840.
+
+The entity type is picked at random unless one is given.
+*/
+func generateNpi(randomizer rng.Rand, entityType byte) string {
+	if entityType != '1' && entityType != '2' {
+		entityType = byte('1' + randomizer.Intn(2))
+	}
+	var sb strings.Builder
+	sb.WriteByte(entityType)
+	for i := 1; i < npiLength-1; i++ {
+		sb.WriteByte(byte('0' + randomizer.Intn(10)))
+	}
+	base := sb.String()
+	sb.WriteByte(getNpiCheckDigit(base))
+	return sb.String()
+}
+
+// Returns the check digit of the first 9 digits of an NPI
+func getNpiCheckDigit(base string) byte {
+	sum := npiLuhnPrefixSum
+	for idx := range base {
+		digit := int(base[idx] - '0')
+		// every other digit is doubled starting from the rightmost digit of the base
+		if (len(base)-idx)%2 == 1 {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+// Returns true if the value is a 10 digit NPI with a valid check digit
+func isValidNpi(value string) bool {
+	if len(value) != npiLength {
+		return false
+	}
+	for idx := range value {
+		if value[idx] < '0' || value[idx] > '9' {
+			return false
+		}
+	}
+	return getNpiCheckDigit(value[:npiLength-1]) == value[npiLength-1]
+}
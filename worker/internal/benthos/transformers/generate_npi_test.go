@@ -0,0 +1,47 @@
+package transformers
+
+import (
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GenerateNpi(t *testing.T) {
+	randomizer := rng.New(1)
+	for i := 0; i < 100; i++ {
+		res := generateNpi(randomizer, 0)
+		require.True(t, isValidNpi(res), res)
+		require.Contains(t, []byte{'1', '2'}, res[0])
+	}
+}
+
+func Test_GenerateNpi_EntityType(t *testing.T) {
+	randomizer := rng.New(1)
+	for i := 0; i < 20; i++ {
+		res := generateNpi(randomizer, '2')
+		require.Equal(t, byte('2'), res[0])
+		require.True(t, isValidNpi(res), res)
+	}
+}
+
+func Test_isValidNpi(t *testing.T) {
+	// the example NPI from the CMS check digit documentation
+	require.True(t, isValidNpi("1234567893"))
+	require.False(t, isValidNpi("1234567890"))
+	require.False(t, isValidNpi("123456789"))
+	require.False(t, isValidNpi("12345678a3"))
+}
+
+func Test_GenerateNpi_Benthos(t *testing.T) {
+	mapping := `root = generate_npi(seed:1)`
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the npi generator")
+
+	res, err := ex.Query(nil)
+	require.NoError(t, err)
+	output, ok := res.(string)
+	require.True(t, ok)
+	require.True(t, isValidNpi(output), output)
+}
@@ -0,0 +1,83 @@
+package transformers
+
+import (
+	"fmt"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+type userAgentTemplate struct {
+	// format string that is given the major browser version
+	Format   string
+	Versions []string
+}
+
+var (
+	desktopUserAgentTemplates = []userAgentTemplate{
+		{Format: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/%s.0.0.0 Safari/537.36", Versions: chromeUserAgentVersions},
+		{Format: "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/%s.0.0.0 Safari/537.36", Versions: chromeUserAgentVersions},
+		{Format: "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/%s.0.0.0 Safari/537.36", Versions: chromeUserAgentVersions},
+		{Format: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/%[1]s.0.0.0 Safari/537.36 Edg/%[1]s.0.0.0", Versions: chromeUserAgentVersions},
+		{Format: "Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:%[1]s.0) Gecko/20100101 Firefox/%[1]s.0", Versions: firefoxUserAgentVersions},
+		{Format: "Mozilla/5.0 (Macintosh; Intel Mac OS X 10.15; rv:%[1]s.0) Gecko/20100101 Firefox/%[1]s.0", Versions: firefoxUserAgentVersions},
+		{Format: "Mozilla/5.0 (X11; Linux x86_64; rv:%[1]s.0) Gecko/20100101 Firefox/%[1]s.0", Versions: firefoxUserAgentVersions},
+		{Format: "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/%s Safari/605.1.15", Versions: safariUserAgentVersions},
+	}
+	mobileUserAgentTemplates = []userAgentTemplate{
+		{Format: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_5 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/%s Mobile/15E148 Safari/604.1", Versions: safariUserAgentVersions},
+		{Format: "Mozilla/5.0 (Linux; Android 10; K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/%s.0.0.0 Mobile Safari/537.36", Versions: chromeUserAgentVersions},
+	}
+
+	chromeUserAgentVersions  = []string{"118", "119", "120", "121", "122", "123", "124", "125", "126"}
+	firefoxUserAgentVersions = []string{"115", "118", "120", "121", "122", "123", "124", "125", "126", "127"}
+	safariUserAgentVersions  = []string{"16.6", "17.0", "17.1", "17.2", "17.3", "17.4", "17.5"}
+)
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewBoolParam("desktop_only").Default(false)).
+		Param(bloblang.NewInt64Param("seed").Optional())
+
+	err := bloblang.RegisterFunctionV2("generate_user_agent", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		desktopOnly, err := args.GetBool("desktop_only")
+		if err != nil {
+			return nil, err
+		}
+
+		seedArg, err := args.GetOptionalInt64("seed")
+		if err != nil {
+			return nil, err
+		}
+		var seed int64
+		if seedArg != nil {
+			seed = *seedArg
+		} else {
+			seed, err = transformer_utils.GenerateCryptoSeed()
+			if err != nil {
+				return nil, err
+			}
+		}
+		randomizer := rng.New(seed)
+
+		return func() (any, error) {
+			return generateUserAgent(randomizer, desktopOnly), nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Generates a user agent of a recent version of a popular browser
+func generateUserAgent(randomizer rng.Rand, desktopOnly bool) string {
+	templates := desktopUserAgentTemplates
+	if !desktopOnly {
+		templates = append(templates[:len(templates):len(templates)], mobileUserAgentTemplates...)
+	}
+	template := templates[randomizer.Intn(len(templates))]
+	version := template.Versions[randomizer.Intn(len(template.Versions))]
+	return fmt.Sprintf(template.Format, version)
+}
@@ -0,0 +1,39 @@
+package transformers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GenerateUserAgent(t *testing.T) {
+	randomizer := rng.New(1)
+	for i := 0; i < 100; i++ {
+		res := generateUserAgent(randomizer, false)
+		require.True(t, strings.HasPrefix(res, "Mozilla/5.0 ("), res)
+		require.NotContains(t, res, "%!", "the version should be formatted into the template")
+	}
+}
+
+func Test_GenerateUserAgent_DesktopOnly(t *testing.T) {
+	randomizer := rng.New(1)
+	for i := 0; i < 100; i++ {
+		res := generateUserAgent(randomizer, true)
+		require.NotContains(t, res, "Mobile", res)
+	}
+}
+
+func Test_GenerateUserAgent_Benthos(t *testing.T) {
+	mapping := `root = generate_user_agent(desktop_only:true,seed:1)`
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the user agent generator")
+
+	res, err := ex.Query(nil)
+	require.NoError(t, err)
+	output, ok := res.(string)
+	require.True(t, ok)
+	require.NotEmpty(t, output)
+}
@@ -0,0 +1,137 @@
+package transformers
+
+import (
+	"strings"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+const (
+	vinLength = 17
+	// the position of the check digit in the VIN
+	vinCheckDigitIdx = 8
+	// the position of the model year code in the VIN
+	vinModelYearIdx = 9
+	// the letters I, O and Q are never used as they are easily mistaken for 1 and 0
+	vinCharacters = "0123456789ABCDEFGHJKLMNPRSTUVWXYZ"
+	// the model year codes, which repeat every 30 years
+	vinModelYearCodes = "ABCDEFGHJKLMNPRSTVWXY123456789"
+)
+
+var (
+	// world manufacturer identifiers of manufacturers that sell high volumes of vehicles
+	vinWorldManufacturerIds = []string{
+		"1FA", "1FT", "1G1", "1GC", "1HG", "1N4", "2HG", "2T1", "3FA", "3VW",
+		"4T1", "5FN", "5YJ", "JHM", "JN1", "JTD", "KMH", "KNA", "SAL", "VF1",
+		"WAU", "WBA", "WDD", "WVW", "YV1", "ZFA",
+	}
+
+	vinWeights = [vinLength]int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+)
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewInt64Param("seed").Optional())
+
+	err := bloblang.RegisterFunctionV2("generate_vin", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		seedArg, err := args.GetOptionalInt64("seed")
+		if err != nil {
+			return nil, err
+		}
+		var seed int64
+		if seedArg != nil {
+			seed = *seedArg
+		} else {
+			seed, err = transformer_utils.GenerateCryptoSeed()
+			if err != nil {
+				return nil, err
+			}
+		}
+		randomizer := rng.New(seed)
+
+		return func() (any, error) {
+			return generateVin(randomizer, ""), nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+/*
+Generates a 17 character vehicle identification number. The first 3 characters are the world manufacturer identifier,
+the 9th character is the check digit and the 10th character is the model year code.
+The serial number that makes up the last 6 characters is always numeric.
+
+The manufacturer is picked at random unless a world manufacturer identifier is given.
+*/
+func generateVin(randomizer rng.Rand, worldManufacturerId string) string {
+	if len(worldManufacturerId) != 3 {
+		worldManufacturerId = vinWorldManufacturerIds[randomizer.Intn(len(vinWorldManufacturerIds))]
+	}
+	vin := []byte(worldManufacturerId)
+	for len(vin) < vinLength {
+		switch {
+		case len(vin) == vinCheckDigitIdx:
+			// filled in once the rest of the VIN is known
+			vin = append(vin, '0')
+		case len(vin) == vinModelYearIdx:
+			vin = append(vin, vinModelYearCodes[randomizer.Intn(len(vinModelYearCodes))])
+		case len(vin) >= vinLength-6:
+			vin = append(vin, byte('0'+randomizer.Intn(10)))
+		default:
+			vin = append(vin, vinCharacters[randomizer.Intn(len(vinCharacters))])
+		}
+	}
+	vin[vinCheckDigitIdx] = getVinCheckDigit(string(vin))
+	return string(vin)
+}
+
+// Returns the check digit of a 17 character VIN. The character at the check digit position is ignored
+func getVinCheckDigit(vin string) byte {
+	sum := 0
+	for idx := range vin {
+		sum += getVinCharacterValue(vin[idx]) * vinWeights[idx]
+	}
+	remainder := sum % 11
+	if remainder == 10 {
+		return 'X'
+	}
+	return byte('0' + remainder)
+}
+
+// Returns the value that a VIN character is transliterated to when computing the check digit
+func getVinCharacterValue(char byte) int {
+	switch {
+	case char >= '0' && char <= '9':
+		return int(char - '0')
+	case char >= 'A' && char <= 'H':
+		return int(char-'A') + 1
+	case char >= 'J' && char <= 'N':
+		return int(char-'J') + 1
+	case char == 'P':
+		return 7
+	case char == 'R':
+		return 9
+	case char >= 'S' && char <= 'Z':
+		return int(char-'S') + 2
+	default:
+		return 0
+	}
+}
+
+// Returns true if the value is a 17 character VIN with a valid check digit
+func isValidVin(value string) bool {
+	if len(value) != vinLength {
+		return false
+	}
+	for idx := range value {
+		if !strings.ContainsRune(vinCharacters, rune(value[idx])) {
+			return false
+		}
+	}
+	return getVinCheckDigit(value) == value[vinCheckDigitIdx]
+}
@@ -0,0 +1,49 @@
+package transformers
+
+import (
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GenerateVin(t *testing.T) {
+	randomizer := rng.New(1)
+	for i := 0; i < 100; i++ {
+		res := generateVin(randomizer, "")
+		require.True(t, isValidVin(res), res)
+		require.Contains(t, vinWorldManufacturerIds, res[:3])
+		require.Contains(t, vinModelYearCodes, string(res[vinModelYearIdx]))
+		require.Regexp(t, `\d{6}$`, res)
+	}
+}
+
+func Test_GenerateVin_WorldManufacturerId(t *testing.T) {
+	randomizer := rng.New(1)
+	for i := 0; i < 20; i++ {
+		res := generateVin(randomizer, "WP0")
+		require.Equal(t, "WP0", res[:3])
+		require.True(t, isValidVin(res), res)
+	}
+}
+
+func Test_isValidVin(t *testing.T) {
+	// the example VIN from the check digit section of 49 CFR 565
+	require.True(t, isValidVin("1M8GDM9AXKP042788"))
+	require.False(t, isValidVin("1M8GDM9A1KP042788"))
+	require.False(t, isValidVin("1M8GDM9AXKP04278"))
+	require.False(t, isValidVin("1M8GDM9AXKO042788"))
+}
+
+func Test_GenerateVin_Benthos(t *testing.T) {
+	mapping := `root = generate_vin(seed:1)`
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the vin generator")
+
+	res, err := ex.Query(nil)
+	require.NoError(t, err)
+	output, ok := res.(string)
+	require.True(t, ok)
+	require.True(t, isValidVin(output), output)
+}
@@ -0,0 +1,95 @@
+package transformers
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewAnyParam("value").Optional()).
+		Param(bloblang.NewBoolParam("preserve_manufacturer_prefix").Default(false)).
+		Param(bloblang.NewInt64Param("seed").Optional())
+
+	err := bloblang.RegisterFunctionV2("transform_ean", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		value, err := args.Get("value")
+		if err != nil {
+			return nil, err
+		}
+
+		preserveManufacturerPrefix, err := args.GetBool("preserve_manufacturer_prefix")
+		if err != nil {
+			return nil, err
+		}
+
+		seedArg, err := args.GetOptionalInt64("seed")
+		if err != nil {
+			return nil, err
+		}
+		var seed int64
+		if seedArg != nil {
+			seed = *seedArg
+		} else {
+			seed, err = transformer_utils.GenerateCryptoSeed()
+			if err != nil {
+				return nil, err
+			}
+		}
+		randomizer := rng.New(seed)
+
+		return func() (any, error) {
+			res, err := transformEan(randomizer, value, preserveManufacturerPrefix)
+			if err != nil {
+				return nil, fmt.Errorf("unable to run transform_ean: %w", err)
+			}
+			return res, nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Replaces the EAN-13 or UPC-A code with a new valid one. Codes that are stored as numbers are returned as numbers
+func transformEan(randomizer rng.Rand, value any, preserveManufacturerPrefix bool) (any, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return transformEanString(randomizer, v, preserveManufacturerPrefix), nil
+	case []byte:
+		return transformEanString(randomizer, string(v), preserveManufacturerPrefix), nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		// UPC-A codes commonly start with a zero, which is dropped when the code is stored as a number
+		digits := fmt.Sprintf("%d", v)
+		if len(digits) < upcLength {
+			digits = fmt.Sprintf("%0*d", upcLength, v)
+		}
+		return strconv.ParseInt(transformEanString(randomizer, digits, preserveManufacturerPrefix), 10, 64)
+	default:
+		return nil, fmt.Errorf("ean must be a string or an integer, received: %T", value)
+	}
+}
+
+// UPC-A codes are replaced by UPC-A codes, all other codes are replaced by EAN-13 codes
+func transformEanString(randomizer rng.Rand, value string, preserveManufacturerPrefix bool) string {
+	if value == "" {
+		return value
+	}
+	length := ean13Length
+	prefixLength := ean13ManufacturerPrefixLength
+	if len(value) == upcLength {
+		length = upcLength
+		prefixLength = upcManufacturerPrefixLength
+	}
+	var prefix string
+	if preserveManufacturerPrefix && len(value) == length && isDigits(value[:prefixLength]) {
+		prefix = value[:prefixLength]
+	}
+	return generateEan(randomizer, length, prefix)
+}
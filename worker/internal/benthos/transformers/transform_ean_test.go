@@ -0,0 +1,58 @@
+package transformers
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TransformEan(t *testing.T) {
+	randomizer := rng.New(1)
+
+	res, err := transformEan(randomizer, "4006381333931", true)
+	require.NoError(t, err)
+	output, ok := res.(string)
+	require.True(t, ok)
+	require.Len(t, output, ean13Length)
+	require.Equal(t, "4006381", output[:ean13ManufacturerPrefixLength])
+	require.True(t, isValidEan(output), output)
+
+	res, err = transformEan(randomizer, "036000291452", true)
+	require.NoError(t, err)
+	output, ok = res.(string)
+	require.True(t, ok)
+	require.Len(t, output, upcLength)
+	require.Equal(t, "036000", output[:upcManufacturerPrefixLength])
+	require.True(t, isValidEan(output), output)
+
+	res, err = transformEan(randomizer, int64(36000291452), true)
+	require.NoError(t, err)
+	number, ok := res.(int64)
+	require.True(t, ok)
+	padded := fmt.Sprintf("%012d", number)
+	require.Equal(t, "036000", padded[:upcManufacturerPrefixLength], "the leading zero of the upc should be kept")
+	require.True(t, isValidEan(padded), padded)
+
+	res, err = transformEan(randomizer, nil, false)
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	_, err = transformEan(randomizer, 1.5, false)
+	require.Error(t, err)
+}
+
+func Test_TransformEan_Benthos(t *testing.T) {
+	mapping := `root = transform_ean(value:this.ean,preserve_manufacturer_prefix:true,seed:1)`
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the ean transformer")
+
+	res, err := ex.Query(map[string]any{"ean": "4006381333931"})
+	require.NoError(t, err)
+	output, ok := res.(string)
+	require.True(t, ok)
+	require.Equal(t, "4006381", output[:ean13ManufacturerPrefixLength])
+	require.True(t, isValidEan(output), output)
+}
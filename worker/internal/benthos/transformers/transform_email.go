@@ -22,6 +22,12 @@ const (
 	InvalidEmailAction_Generate    InvalidEmailAction = "generate"
 )
 
+// RFC 5321 limits the local part of an address to 64 octets and the address itself to 254 octets
+const (
+	maxEmailLocalPartLength = 64
+	maxEmailAddressLength   = 254
+)
+
 func (g InvalidEmailAction) String() string {
 	return string(g)
 }
@@ -42,7 +48,9 @@ func init() {
 		Param(bloblang.NewInt64Param("max_length").Default(10000)).
 		Param(bloblang.NewInt64Param("seed").Optional()).
 		Param(bloblang.NewStringParam("email_type").Default(GenerateEmailType_UuidV4.String())).
-		Param(bloblang.NewStringParam("invalid_email_action").Default(InvalidEmailAction_Reject.String()))
+		Param(bloblang.NewStringParam("invalid_email_action").Default(InvalidEmailAction_Reject.String())).
+		Param(bloblang.NewStringParam("replacement_domain").Default("")).
+		Param(bloblang.NewBoolParam("preserve_plus_tag").Default(false))
 
 	err := bloblang.RegisterFunctionV2("transform_email", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
 		emailPtr, err := args.GetOptionalString("email")
@@ -96,6 +104,21 @@ func init() {
 
 		invalidEmailAction := InvalidEmailAction(invalidEmailActionArg)
 
+		replacementDomain, err := args.GetString("replacement_domain")
+		if err != nil {
+			return nil, err
+		}
+		if replacementDomain != "" {
+			if _, err := mail.ParseAddress(fmt.Sprintf("a@%s", replacementDomain)); err != nil {
+				return nil, fmt.Errorf("not a valid replacement_domain argument: %w", err)
+			}
+		}
+
+		preservePlusTag, err := args.GetBool("preserve_plus_tag")
+		if err != nil {
+			return nil, err
+		}
+
 		seedArg, err := args.GetOptionalInt64("seed")
 		if err != nil {
 			return nil, err
@@ -120,6 +143,8 @@ func init() {
 				ExcludedDomains:    excludedDomains,
 				EmailType:          emailType,
 				InvalidEmailAction: invalidEmailAction,
+				ReplacementDomain:  replacementDomain,
+				PreservePlusTag:    preservePlusTag,
 			})
 			if err != nil {
 				return nil, fmt.Errorf("unable to run transform_email: %w", err)
@@ -162,6 +187,10 @@ type transformeEmailOptions struct {
 	ExcludedDomains    []string
 	EmailType          GenerateEmailType
 	InvalidEmailAction InvalidEmailAction
+	// Used in place of a randomly generated domain for every domain that is not preserved
+	ReplacementDomain string
+	// Carries over the plus-addressing tag of the input, e.g. +billing in jane+billing@acme.com
+	PreservePlusTag bool
 }
 
 // Anonymizes an existing email address. This function returns a string pointer to handle nullable email columns where an input email value may not exist.
@@ -176,6 +205,7 @@ func transformEmail(
 	if opts.MaxLength <= 0 {
 		opts.MaxLength = math.MaxInt64
 	}
+	opts.MaxLength = min(opts.MaxLength, maxEmailAddressLength)
 	emailType := opts.EmailType
 	if emailType == GenerateEmailType_Any {
 		emailType = getRandomEmailType(randomizer)
@@ -199,11 +229,18 @@ func transformEmail(
 		}
 	}
 
-	_, domain, found := strings.Cut(parsedInputEmail.Address, "@")
+	localPart, domain, found := strings.Cut(parsedInputEmail.Address, "@")
 	if !found {
 		return nil, errors.New("did not find @ when parsing email address")
 	}
 
+	var plusTag string
+	if opts.PreservePlusTag {
+		if idx := strings.Index(localPart, "+"); idx > 0 {
+			plusTag = localPart[idx:]
+		}
+	}
+
 	excludedDomainsSet := transformer_utils.ToSet(opts.ExcludedDomains)
 
 	preserveDomain := opts.PreserveDomain
@@ -219,14 +256,19 @@ func transformEmail(
 
 	domainMaxLength := maxLength - 3 // is there enough room for at least two characters and an @ sign
 	if opts.PreserveLength {
-		domainMaxLength = int64(len(email)) - 3
+		domainMaxLength = min(int64(len(email)), maxLength) - 3
 	}
 	if (domainMaxLength) <= 0 {
 		return nil, fmt.Errorf("for the given max length, unable to generate an email of sufficient length: %d", maxLength)
 	}
 
 	newdomain := domain
-	if !preserveDomain {
+	if !preserveDomain && opts.ReplacementDomain != "" {
+		if int64(len(opts.ReplacementDomain)) > domainMaxLength {
+			return nil, fmt.Errorf("for the given max length, unable to use replacement domain to generate transformed email: %d", maxLength)
+		}
+		newdomain = opts.ReplacementDomain
+	} else if !preserveDomain {
 		// generate a new domain, but do not generate any that are in the excluded domains list
 		randomdomain, err := getRandomEmailDomain(randomizer, domainMaxLength, opts.ExcludedDomains)
 		if err != nil {
@@ -235,11 +277,19 @@ func transformEmail(
 		newdomain = randomdomain
 	}
 
-	maxNameLength := maxLength - int64(len(newdomain)) - 1
+	// the plus tag is dropped if there is not enough room left for it
+	localPartMaxLength := min(maxLength-int64(len(newdomain))-1, maxEmailLocalPartLength)
+	if opts.PreserveLength {
+		localPartMaxLength = min(int64(len(email))-int64(len(newdomain))-1, localPartMaxLength)
+	}
+	if localPartMaxLength-int64(len(plusTag)) < 1 {
+		plusTag = ""
+	}
+
+	maxNameLength := localPartMaxLength - int64(len(plusTag))
 	var minNameLength *int64
 	if opts.PreserveLength {
-		minLength := int64(len(email)) - int64(len(newdomain)) - 1
-		maxNameLength = minLength
+		minLength := maxNameLength
 		minNameLength = &minLength
 	}
 
@@ -259,6 +309,6 @@ func transformEmail(
 		newname = name
 	}
 
-	generatedemail := fmt.Sprintf("%s@%s", newname, newdomain)
+	generatedemail := fmt.Sprintf("%s%s@%s", newname, plusTag, newdomain)
 	return &generatedemail, nil
 }
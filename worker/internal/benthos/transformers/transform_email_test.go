@@ -261,6 +261,102 @@ func Test_TransformEmail_PreserveLength_True_PreserveDomain_True_Excluded(t *tes
 	require.NotEqual(t, "gmail.com", domain)
 }
 
+func Test_TransformEmail_ReplacementDomain(t *testing.T) {
+	randomizer := rand.New(rand.NewSource(1))
+	res, err := transformEmail(randomizer, email, transformeEmailOptions{
+		MaxLength:         maxEmailCharLimit,
+		ReplacementDomain: "example.com",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.True(t, strings.HasSuffix(*res, "@example.com"))
+
+	// excluded domains act as an allow-list that keeps corporate addresses as is
+	res, err = transformEmail(randomizer, email, transformeEmailOptions{
+		MaxLength:         maxEmailCharLimit,
+		ReplacementDomain: "example.com",
+		ExcludedDomains:   excludedDomains,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.True(t, strings.HasSuffix(*res, "@gmail.com"))
+}
+
+func Test_TransformEmail_ReplacementDomain_PreserveLength(t *testing.T) {
+	randomizer := rand.New(rand.NewSource(1))
+	input := "evis.burrows@acmecorp.io"
+	res, err := transformEmail(randomizer, input, transformeEmailOptions{
+		MaxLength:         maxEmailCharLimit,
+		PreserveLength:    true,
+		ReplacementDomain: "example.com",
+		EmailType:         GenerateEmailType_FullName,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Len(t, *res, len(input))
+	require.True(t, strings.HasSuffix(*res, "@example.com"))
+
+	_, err = transformEmail(randomizer, input, transformeEmailOptions{
+		MaxLength:         10,
+		ReplacementDomain: "example.com",
+	})
+	require.Error(t, err)
+}
+
+func Test_TransformEmail_PreservePlusTag(t *testing.T) {
+	randomizer := rand.New(rand.NewSource(1))
+	res, err := transformEmail(randomizer, "evis+billing@gmail.com", transformeEmailOptions{
+		MaxLength:       maxEmailCharLimit,
+		PreserveDomain:  true,
+		PreservePlusTag: true,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.True(t, strings.HasSuffix(*res, "+billing@gmail.com"))
+	require.False(t, strings.HasPrefix(*res, "evis+"))
+
+	res, err = transformEmail(randomizer, "evis+billing@gmail.com", transformeEmailOptions{
+		MaxLength:      maxEmailCharLimit,
+		PreserveDomain: true,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.NotContains(t, *res, "+billing")
+
+	// the tag is dropped when it does not fit within the max length
+	res, err = transformEmail(randomizer, "evis+billing@gmail.com", transformeEmailOptions{
+		MaxLength:       16,
+		PreserveDomain:  true,
+		PreservePlusTag: true,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.NotContains(t, *res, "+billing")
+	require.LessOrEqual(t, len(*res), 16)
+}
+
+func Test_TransformEmail_RfcLengthLimits(t *testing.T) {
+	randomizer := rand.New(rand.NewSource(1))
+	input := fmt.Sprintf("%s@gmail.com", strings.Repeat("a", 64))
+	for _, emailType := range []GenerateEmailType{GenerateEmailType_UuidV4, GenerateEmailType_FullName} {
+		res, err := transformEmail(randomizer, input, transformeEmailOptions{
+			PreserveLength: true,
+			EmailType:      emailType,
+			// the destination column allows longer values than an address may have
+			MaxLength: 1000,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		localPart, _, found := strings.Cut(*res, "@")
+		require.True(t, found)
+		require.LessOrEqual(t, len(localPart), maxEmailLocalPartLength)
+		require.LessOrEqual(t, len(*res), maxEmailAddressLength)
+
+		_, err = mail.ParseAddress(*res)
+		require.NoError(t, err)
+	}
+}
+
 func Test_TransformEmail_InvalidEmailArg(t *testing.T) {
 	randomizer := rand.New(rand.NewSource(1))
 
@@ -327,6 +423,22 @@ func Test_Bloblang_transform_email(t *testing.T) {
 	require.NotEqual(t, strings.Split(*resStr, "@")[1], "gmail.com", "The actual value should be have gmail.com as the domain")
 }
 
+func Test_Bloblang_transform_email_ReplacementDomain(t *testing.T) {
+	mapping := fmt.Sprintf(`root = transform_email(email:%q,max_length:%d,replacement_domain:"example.com",preserve_plus_tag:true,seed:1)`, "evis+billing@gmail.com", maxEmailCharLimit)
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the email transformer")
+
+	res, err := ex.Query(nil)
+	require.NoError(t, err)
+	resStr, ok := res.(*string)
+	require.True(t, ok)
+	require.NotNil(t, resStr)
+	require.True(t, strings.HasSuffix(*resStr, "+billing@example.com"))
+
+	_, err = bloblang.Parse(`root = transform_email(email:"evis@gmail.com",replacement_domain:"not a domain")`)
+	require.Error(t, err)
+}
+
 // the case where the input value is null
 func Test_TransformEmailTransformerWithEmptyValue(t *testing.T) {
 	nilEmail := ""
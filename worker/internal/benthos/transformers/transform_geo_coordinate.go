@@ -0,0 +1,257 @@
+package transformers
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/data-sets"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+const (
+	earthRadiusMeters = 6371008.8
+	// coordinates further than this from every known city centroid are not snapped
+	maxCitySnapDistanceMeters = 50_000
+	// ~11cm, which is more precise than any address needs
+	geoCoordinatePrecision = 6
+)
+
+var (
+	// lat,lng
+	latLngRegex = regexp.MustCompile(`^\s*(-?\d+(?:\.\d+)?)(\s*,\s*)(-?\d+(?:\.\d+)?)\s*$`)
+	// (x,y) where x is the longitude and y the latitude, as used by the postgres point type
+	pointRegex = regexp.MustCompile(`^\s*\(\s*(-?\d+(?:\.\d+)?)(\s*,\s*)(-?\d+(?:\.\d+)?)\s*\)\s*$`)
+
+	geoCoordinateKeys = [][2]string{{"lat", "lng"}, {"lat", "lon"}, {"latitude", "longitude"}}
+)
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewAnyParam("value").Optional()).
+		Param(bloblang.NewFloat64Param("radius_meters")).
+		Param(bloblang.NewBoolParam("snap_to_city_centroid").Default(false)).
+		Param(bloblang.NewInt64Param("seed").Optional())
+
+	err := bloblang.RegisterFunctionV2("transform_geo_coordinate", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		value, err := args.Get("value")
+		if err != nil {
+			return nil, err
+		}
+
+		radiusMeters, err := args.GetFloat64("radius_meters")
+		if err != nil {
+			return nil, err
+		}
+		if radiusMeters < 0 {
+			return nil, errors.New("radius_meters must not be negative")
+		}
+
+		snapToCityCentroid, err := args.GetBool("snap_to_city_centroid")
+		if err != nil {
+			return nil, err
+		}
+
+		seedArg, err := args.GetOptionalInt64("seed")
+		if err != nil {
+			return nil, err
+		}
+		var seed int64
+		if seedArg != nil {
+			seed = *seedArg
+		} else {
+			seed, err = transformer_utils.GenerateCryptoSeed()
+			if err != nil {
+				return nil, err
+			}
+		}
+		randomizer := rng.New(seed)
+
+		return func() (any, error) {
+			res, err := transformGeoCoordinate(randomizer, value, radiusMeters, snapToCityCentroid)
+			if err != nil {
+				return nil, fmt.Errorf("unable to run transform_geo_coordinate: %w", err)
+			}
+			return res, nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+type geoCoordinate struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Moves the coordinate to a random point within the radius. The value is returned in the same form that it was given in
+func transformGeoCoordinate(randomizer rng.Rand, value any, radiusMeters float64, snapToCityCentroid bool) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	transform := func(coord geoCoordinate) (geoCoordinate, error) {
+		if coord.Latitude < -90 || coord.Latitude > 90 || coord.Longitude < -180 || coord.Longitude > 180 {
+			return geoCoordinate{}, fmt.Errorf("coordinate is out of range: %f,%f", coord.Latitude, coord.Longitude)
+		}
+		if snapToCityCentroid {
+			coord = snapToNearestCityCentroid(coord)
+		}
+		return jitterGeoCoordinate(randomizer, coord, radiusMeters), nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		return transformGeoCoordinateString(v, transform)
+	case []byte:
+		return transformGeoCoordinateString(string(v), transform)
+	case map[string]any:
+		for _, keys := range geoCoordinateKeys {
+			latValue, hasLat := v[keys[0]]
+			lngValue, hasLng := v[keys[1]]
+			if !hasLat || !hasLng {
+				continue
+			}
+			lat, err := transformer_utils.AnyToFloat64(latValue)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse %s: %w", keys[0], err)
+			}
+			lng, err := transformer_utils.AnyToFloat64(lngValue)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse %s: %w", keys[1], err)
+			}
+			coord, err := transform(geoCoordinate{Latitude: lat, Longitude: lng})
+			if err != nil {
+				return nil, err
+			}
+			output := make(map[string]any, len(v))
+			for key, val := range v {
+				output[key] = val
+			}
+			output[keys[0]] = roundGeoCoordinateValue(coord.Latitude)
+			output[keys[1]] = roundGeoCoordinateValue(coord.Longitude)
+			return output, nil
+		}
+		return nil, errors.New("object must contain lat/lng, lat/lon or latitude/longitude keys")
+	default:
+		return nil, fmt.Errorf("unsupported geo coordinate type: %T", value)
+	}
+}
+
+func transformGeoCoordinateString(value string, transform func(coord geoCoordinate) (geoCoordinate, error)) (*string, error) {
+	isPoint := false
+	matches := latLngRegex.FindStringSubmatch(value)
+	if matches == nil {
+		matches = pointRegex.FindStringSubmatch(value)
+		isPoint = true
+	}
+	if matches == nil {
+		return nil, errors.New("value must be a lat,lng pair or an (x,y) point")
+	}
+	first, err := strconv.ParseFloat(matches[1], 64)
+	if err != nil {
+		return nil, err
+	}
+	second, err := strconv.ParseFloat(matches[3], 64)
+	if err != nil {
+		return nil, err
+	}
+
+	coord := geoCoordinate{Latitude: first, Longitude: second}
+	if isPoint {
+		coord = geoCoordinate{Latitude: second, Longitude: first}
+	}
+	coord, err = transform(coord)
+	if err != nil {
+		return nil, err
+	}
+
+	lat := formatGeoCoordinateValue(coord.Latitude)
+	lng := formatGeoCoordinateValue(coord.Longitude)
+	separator := matches[2]
+	var output string
+	if isPoint {
+		output = fmt.Sprintf("(%s%s%s)", lng, separator, lat)
+	} else {
+		output = fmt.Sprintf("%s%s%s", lat, separator, lng)
+	}
+	return &output, nil
+}
+
+// Returns a point that is uniformly distributed within the radius of the coordinate
+func jitterGeoCoordinate(randomizer rng.Rand, coord geoCoordinate, radiusMeters float64) geoCoordinate {
+	if radiusMeters <= 0 {
+		return coord
+	}
+	// the square root keeps points from clustering around the center of the circle
+	distance := radiusMeters * math.Sqrt(randomizer.Float64())
+	bearing := 2 * math.Pi * randomizer.Float64()
+	return getGeoDestination(coord, distance, bearing)
+}
+
+// Returns the point that is the given distance away from the coordinate along the great circle of the bearing
+func getGeoDestination(coord geoCoordinate, distanceMeters, bearing float64) geoCoordinate {
+	angularDistance := distanceMeters / earthRadiusMeters
+	lat := degreesToRadians(coord.Latitude)
+	lng := degreesToRadians(coord.Longitude)
+
+	destLat := math.Asin(math.Sin(lat)*math.Cos(angularDistance) + math.Cos(lat)*math.Sin(angularDistance)*math.Cos(bearing))
+	destLng := lng + math.Atan2(
+		math.Sin(bearing)*math.Sin(angularDistance)*math.Cos(lat),
+		math.Cos(angularDistance)-math.Sin(lat)*math.Sin(destLat),
+	)
+	// wraps the longitude back into -180..180 if the point crossed the antimeridian
+	destLng = math.Mod(destLng+3*math.Pi, 2*math.Pi) - math.Pi
+	return geoCoordinate{Latitude: radiansToDegrees(destLat), Longitude: radiansToDegrees(destLng)}
+}
+
+// Returns the centroid of the nearest city, or the coordinate as is if it is not near a known city
+func snapToNearestCityCentroid(coord geoCoordinate) geoCoordinate {
+	nearest := coord
+	nearestDistance := math.Inf(1)
+	for _, city := range transformers_dataset.CityCentroids {
+		centroid := geoCoordinate{Latitude: city.Latitude, Longitude: city.Longitude}
+		if distance := getGeoDistance(coord, centroid); distance < nearestDistance {
+			nearest = centroid
+			nearestDistance = distance
+		}
+	}
+	if nearestDistance > maxCitySnapDistanceMeters {
+		return coord
+	}
+	return nearest
+}
+
+// Returns the great circle distance between two coordinates in meters
+func getGeoDistance(a, b geoCoordinate) float64 {
+	latA := degreesToRadians(a.Latitude)
+	latB := degreesToRadians(b.Latitude)
+	deltaLat := latB - latA
+	deltaLng := degreesToRadians(b.Longitude - a.Longitude)
+
+	h := math.Sin(deltaLat/2)*math.Sin(deltaLat/2) + math.Cos(latA)*math.Cos(latB)*math.Sin(deltaLng/2)*math.Sin(deltaLng/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+func roundGeoCoordinateValue(value float64) float64 {
+	scale := math.Pow(10, geoCoordinatePrecision)
+	return math.Round(value*scale) / scale
+}
+
+func formatGeoCoordinateValue(value float64) string {
+	return strconv.FormatFloat(roundGeoCoordinateValue(value), 'f', -1, 64)
+}
+
+func degreesToRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+func radiansToDegrees(radians float64) float64 {
+	return radians * 180 / math.Pi
+}
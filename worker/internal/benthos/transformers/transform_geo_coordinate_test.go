@@ -0,0 +1,120 @@
+package transformers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+	"github.com/stretchr/testify/require"
+)
+
+// within a meter, as the output is rounded to six decimal places
+const geoCoordinateTolerance = 1
+
+var sanFrancisco = geoCoordinate{Latitude: 37.7749, Longitude: -122.4194}
+
+func Test_TransformGeoCoordinate_LatLng(t *testing.T) {
+	randomizer := rng.New(1)
+	for i := 0; i < 100; i++ {
+		res, err := transformGeoCoordinate(randomizer, "37.776, -122.417", 500, false)
+		require.NoError(t, err)
+		output := *res.(*string)
+		require.Contains(t, output, ", ", "the separator should be preserved")
+		coord := parseTestLatLng(t, output)
+		require.LessOrEqual(t, getGeoDistance(geoCoordinate{Latitude: 37.776, Longitude: -122.417}, coord), float64(500+geoCoordinateTolerance))
+	}
+}
+
+func Test_TransformGeoCoordinate_Point(t *testing.T) {
+	res, err := transformGeoCoordinate(rng.New(1), []byte("(-122.417,37.776)"), 500, false)
+	require.NoError(t, err)
+	output := *res.(*string)
+	require.True(t, strings.HasPrefix(output, "(-122."), "the longitude should stay first: %s", output)
+
+	pieces := strings.Split(strings.Trim(output, "()"), ",")
+	require.Len(t, pieces, 2)
+	lng, err := strconv.ParseFloat(pieces[0], 64)
+	require.NoError(t, err)
+	lat, err := strconv.ParseFloat(pieces[1], 64)
+	require.NoError(t, err)
+	require.LessOrEqual(t, getGeoDistance(geoCoordinate{Latitude: 37.776, Longitude: -122.417}, geoCoordinate{Latitude: lat, Longitude: lng}), float64(500+geoCoordinateTolerance))
+}
+
+func Test_TransformGeoCoordinate_Map(t *testing.T) {
+	res, err := transformGeoCoordinate(rng.New(1), map[string]any{"latitude": 37.776, "longitude": "-122.417", "label": "home"}, 0, true)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"latitude": sanFrancisco.Latitude, "longitude": sanFrancisco.Longitude, "label": "home"}, res)
+
+	_, err = transformGeoCoordinate(rng.New(1), map[string]any{"x": 1, "y": 2}, 100, false)
+	require.Error(t, err)
+}
+
+func Test_TransformGeoCoordinate_SnapToCityCentroid(t *testing.T) {
+	res, err := transformGeoCoordinate(rng.New(1), "37.776,-122.417", 0, true)
+	require.NoError(t, err)
+	require.Equal(t, "37.7749,-122.4194", *res.(*string))
+
+	// the middle of the pacific is not close to any city, so it is left as is
+	res, err = transformGeoCoordinate(rng.New(1), "20.5,-140.25", 0, true)
+	require.NoError(t, err)
+	require.Equal(t, "20.5,-140.25", *res.(*string))
+}
+
+func Test_TransformGeoCoordinate_Invalid(t *testing.T) {
+	res, err := transformGeoCoordinate(rng.New(1), nil, 100, false)
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	_, err = transformGeoCoordinate(rng.New(1), "not a coordinate", 100, false)
+	require.Error(t, err)
+	_, err = transformGeoCoordinate(rng.New(1), "91,0", 100, false)
+	require.Error(t, err)
+	_, err = transformGeoCoordinate(rng.New(1), 37.776, 100, false)
+	require.Error(t, err)
+}
+
+func Test_jitterGeoCoordinate(t *testing.T) {
+	randomizer := rng.New(1)
+	for i := 0; i < 1000; i++ {
+		coord := jitterGeoCoordinate(randomizer, sanFrancisco, 1000)
+		require.LessOrEqual(t, getGeoDistance(sanFrancisco, coord), float64(1000+geoCoordinateTolerance))
+	}
+
+	// points near the antimeridian wrap around instead of falling outside of -180..180
+	coord := getGeoDestination(geoCoordinate{Latitude: 0, Longitude: 179.9999}, 1000, 0.5*3.141592653589793)
+	require.Less(t, coord.Longitude, float64(-179))
+	require.Equal(t, sanFrancisco, jitterGeoCoordinate(randomizer, sanFrancisco, 0))
+}
+
+func Test_getGeoDistance(t *testing.T) {
+	// san francisco to los angeles is roughly 559km
+	distance := getGeoDistance(sanFrancisco, geoCoordinate{Latitude: 34.0522, Longitude: -118.2437})
+	require.InDelta(t, 559_000, distance, 2_000)
+	require.Equal(t, float64(0), getGeoDistance(sanFrancisco, sanFrancisco))
+}
+
+func Test_TransformGeoCoordinate_Benthos(t *testing.T) {
+	mapping := `root = transform_geo_coordinate(value:this.location,radius_meters:250,snap_to_city_centroid:false,seed:1)`
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the geo coordinate transformer")
+
+	res, err := ex.Query(map[string]any{"location": "40.7128,-74.006"})
+	require.NoError(t, err)
+	output, ok := res.(*string)
+	require.True(t, ok, fmt.Sprintf("expected *string, got %T", res))
+	require.LessOrEqual(t, getGeoDistance(geoCoordinate{Latitude: 40.7128, Longitude: -74.006}, parseTestLatLng(t, *output)), float64(250+geoCoordinateTolerance))
+}
+
+func parseTestLatLng(t *testing.T, value string) geoCoordinate {
+	t.Helper()
+	pieces := strings.Split(value, ",")
+	require.Len(t, pieces, 2)
+	lat, err := strconv.ParseFloat(strings.TrimSpace(pieces[0]), 64)
+	require.NoError(t, err)
+	lng, err := strconv.ParseFloat(strings.TrimSpace(pieces[1]), 64)
+	require.NoError(t, err)
+	return geoCoordinate{Latitude: lat, Longitude: lng}
+}
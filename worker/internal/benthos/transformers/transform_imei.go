@@ -0,0 +1,84 @@
+package transformers
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewAnyParam("value").Optional()).
+		Param(bloblang.NewBoolParam("preserve_type_allocation_code").Default(false)).
+		Param(bloblang.NewInt64Param("seed").Optional())
+
+	err := bloblang.RegisterFunctionV2("transform_imei", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		value, err := args.Get("value")
+		if err != nil {
+			return nil, err
+		}
+
+		preserveTypeAllocationCode, err := args.GetBool("preserve_type_allocation_code")
+		if err != nil {
+			return nil, err
+		}
+
+		seedArg, err := args.GetOptionalInt64("seed")
+		if err != nil {
+			return nil, err
+		}
+		var seed int64
+		if seedArg != nil {
+			seed = *seedArg
+		} else {
+			seed, err = transformer_utils.GenerateCryptoSeed()
+			if err != nil {
+				return nil, err
+			}
+		}
+		randomizer := rng.New(seed)
+
+		return func() (any, error) {
+			res, err := transformImei(randomizer, value, preserveTypeAllocationCode)
+			if err != nil {
+				return nil, fmt.Errorf("unable to run transform_imei: %w", err)
+			}
+			return res, nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Replaces the IMEI with a new valid one. IMEIs that are stored as numbers are returned as numbers
+func transformImei(randomizer rng.Rand, value any, preserveTypeAllocationCode bool) (any, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return transformImeiString(randomizer, v, preserveTypeAllocationCode), nil
+	case []byte:
+		return transformImeiString(randomizer, string(v), preserveTypeAllocationCode), nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		// type allocation codes can start with a zero, which is dropped when the IMEI is stored as a number
+		return strconv.ParseInt(transformImeiString(randomizer, fmt.Sprintf("%015d", v), preserveTypeAllocationCode), 10, 64)
+	default:
+		return nil, fmt.Errorf("imei must be a string or an integer, received: %T", value)
+	}
+}
+
+func transformImeiString(randomizer rng.Rand, value string, preserveTypeAllocationCode bool) string {
+	if value == "" {
+		return value
+	}
+	var typeAllocationCode string
+	if preserveTypeAllocationCode && len(value) >= imeiTypeAllocationCodeLength && isDigits(value[:imeiTypeAllocationCodeLength]) {
+		typeAllocationCode = value[:imeiTypeAllocationCodeLength]
+	}
+	return generateImei(randomizer, typeAllocationCode)
+}
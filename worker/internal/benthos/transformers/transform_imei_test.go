@@ -0,0 +1,49 @@
+package transformers
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TransformImei(t *testing.T) {
+	randomizer := rng.New(1)
+
+	res, err := transformImei(randomizer, "490154203237518", true)
+	require.NoError(t, err)
+	output, ok := res.(string)
+	require.True(t, ok)
+	require.Equal(t, "49015420", output[:imeiTypeAllocationCodeLength])
+	require.True(t, isValidImei(output), output)
+
+	res, err = transformImei(randomizer, int64(13520990012345), true)
+	require.NoError(t, err)
+	number, ok := res.(int64)
+	require.True(t, ok)
+	padded := fmt.Sprintf("%015d", number)
+	require.Equal(t, "01352099", padded[:imeiTypeAllocationCodeLength], "the leading zero of the type allocation code should be kept")
+	require.True(t, isValidImei(padded), padded)
+
+	res, err = transformImei(randomizer, nil, false)
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	_, err = transformImei(randomizer, 1.5, false)
+	require.Error(t, err)
+}
+
+func Test_TransformImei_Benthos(t *testing.T) {
+	mapping := `root = transform_imei(value:this.imei,preserve_type_allocation_code:true,seed:1)`
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the imei transformer")
+
+	res, err := ex.Query(map[string]any{"imei": "352099001761481"})
+	require.NoError(t, err)
+	output, ok := res.(string)
+	require.True(t, ok)
+	require.Equal(t, "35209900", output[:imeiTypeAllocationCodeLength])
+	require.True(t, isValidImei(output), output)
+}
@@ -0,0 +1,96 @@
+package transformers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewAnyParam("value").Optional()).
+		Param(bloblang.NewBoolParam("preserve_letters").Default(false)).
+		Param(bloblang.NewInt64Param("seed").Optional())
+
+	err := bloblang.RegisterFunctionV2("transform_medical_record_number", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		value, err := args.Get("value")
+		if err != nil {
+			return nil, err
+		}
+
+		preserveLetters, err := args.GetBool("preserve_letters")
+		if err != nil {
+			return nil, err
+		}
+
+		seedArg, err := args.GetOptionalInt64("seed")
+		if err != nil {
+			return nil, err
+		}
+		var seed int64
+		if seedArg != nil {
+			seed = *seedArg
+		} else {
+			seed, err = transformer_utils.GenerateCryptoSeed()
+			if err != nil {
+				return nil, err
+			}
+		}
+		randomizer := rng.New(seed)
+
+		return func() (any, error) {
+			res, err := transformMedicalRecordNumber(randomizer, value, preserveLetters)
+			if err != nil {
+				return nil, fmt.Errorf("unable to run transform_medical_record_number: %w", err)
+			}
+			return res, nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Randomizes the record number while keeping its format. Record numbers that are stored as numbers are returned as numbers with the same number of digits
+func transformMedicalRecordNumber(randomizer rng.Rand, value any, preserveLetters bool) (any, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return transformMedicalRecordNumberString(randomizer, v, preserveLetters), nil
+	case []byte:
+		return transformMedicalRecordNumberString(randomizer, string(v), preserveLetters), nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		digits := transformMedicalRecordNumberString(randomizer, fmt.Sprintf("%d", v), preserveLetters)
+		// a leading zero would be dropped, which would shorten the number
+		if len(digits) > 1 && digits[0] == '0' {
+			digits = string(rune('1'+randomizer.Intn(9))) + digits[1:]
+		}
+		return strconv.ParseInt(digits, 10, 64)
+	default:
+		return nil, fmt.Errorf("medical record number must be a string or an integer, received: %T", value)
+	}
+}
+
+// Replaces digits with random digits and ASCII letters with random letters of the same case. All other characters, such as separators, are kept as is
+func transformMedicalRecordNumberString(randomizer rng.Rand, value string, preserveLetters bool) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= '0' && r <= '9':
+			return rune('0' + randomizer.Intn(10))
+		case preserveLetters:
+			return r
+		case r >= 'a' && r <= 'z':
+			return rune('a' + randomizer.Intn(26))
+		case r >= 'A' && r <= 'Z':
+			return rune('A' + randomizer.Intn(26))
+		default:
+			return r
+		}
+	}, value)
+}
@@ -0,0 +1,44 @@
+package transformers
+
+import (
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TransformMedicalRecordNumber(t *testing.T) {
+	randomizer := rng.New(1)
+
+	res, err := transformMedicalRecordNumber(randomizer, "Ab-12345", false)
+	require.NoError(t, err)
+	require.Regexp(t, `^[A-Z][a-z]-\d{5}$`, res)
+
+	res, err = transformMedicalRecordNumber(randomizer, "MRN-0012345", true)
+	require.NoError(t, err)
+	require.Regexp(t, `^MRN-\d{7}$`, res)
+
+	for i := 0; i < 50; i++ {
+		res, err = transformMedicalRecordNumber(randomizer, int64(1234), false)
+		require.NoError(t, err)
+		number, ok := res.(int64)
+		require.True(t, ok)
+		require.GreaterOrEqual(t, number, int64(1000))
+		require.LessOrEqual(t, number, int64(9999))
+	}
+
+	res, err = transformMedicalRecordNumber(randomizer, nil, false)
+	require.NoError(t, err)
+	require.Nil(t, res)
+}
+
+func Test_TransformMedicalRecordNumber_Benthos(t *testing.T) {
+	mapping := `root = transform_medical_record_number(value:this.mrn,preserve_letters:true,seed:1)`
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the medical record number transformer")
+
+	res, err := ex.Query(map[string]any{"mrn": "H01-445566"})
+	require.NoError(t, err)
+	require.Regexp(t, `^H\d{2}-\d{6}$`, res)
+}
@@ -0,0 +1,182 @@
+package transformers
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+const defaultMoneyDecimalPlaces = 2
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewAnyParam("value").Optional()).
+		Param(bloblang.NewInt64Param("decimal_places").Default(defaultMoneyDecimalPlaces)).
+		Param(bloblang.NewInt64Param("seed").Optional())
+
+	err := bloblang.RegisterFunctionV2("transform_money", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		value, err := args.Get("value")
+		if err != nil {
+			return nil, err
+		}
+
+		decimalPlaces, err := args.GetInt64("decimal_places")
+		if err != nil {
+			return nil, err
+		}
+
+		seedArg, err := args.GetOptionalInt64("seed")
+		if err != nil {
+			return nil, err
+		}
+		var seed int64
+		if seedArg != nil {
+			seed = *seedArg
+		} else {
+			seed, err = transformer_utils.GenerateCryptoSeed()
+			if err != nil {
+				return nil, err
+			}
+		}
+		randomizer := rng.New(seed)
+
+		return func() (any, error) {
+			res, err := transformMoney(randomizer, value, int(decimalPlaces))
+			if err != nil {
+				return nil, fmt.Errorf("unable to run transform_money: %w", err)
+			}
+			return res, nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Randomizes an amount within its order of magnitude. Numbers are returned as numbers of the same kind,
+// and strings keep their currency symbol or code, thousands separators and decimal places
+func transformMoney(randomizer rng.Rand, value any, decimalPlaces int) (any, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return transformMoneyString(randomizer, v)
+	case []byte:
+		return transformMoneyString(randomizer, string(v))
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		parsed, err := transformer_utils.AnyToFloat64(v)
+		if err != nil {
+			return nil, err
+		}
+		return int64(randomizeMoneyAmount(randomizer, parsed, 0)), nil
+	default:
+		parsed, err := transformer_utils.AnyToFloat64(v)
+		if err != nil {
+			return nil, err
+		}
+		if decimalPlaces < 0 {
+			decimalPlaces = defaultMoneyDecimalPlaces
+		}
+		return randomizeMoneyAmount(randomizer, parsed, decimalPlaces), nil
+	}
+}
+
+// Splits the amount out of a formatted string such as $1,234.56, -12.00 EUR or (USD 5.00) and randomizes it.
+// Everything around the digits, including the sign and the currency, is kept as is
+func transformMoneyString(randomizer rng.Rand, value string) (string, error) {
+	first := strings.IndexFunc(value, isMoneyDigit)
+	if first == -1 {
+		return value, nil
+	}
+	last := strings.LastIndexFunc(value, isMoneyDigit)
+	prefix, amount, suffix := value[:first], value[first:last+1], value[last+1:]
+
+	grouped := strings.Contains(amount, ",")
+	integerPart, fractionPart, _ := strings.Cut(amount, ".")
+	if grouped && !isGroupedInteger(integerPart) {
+		return "", fmt.Errorf("unable to parse money amount: %s", value)
+	}
+	parsed, err := strconv.ParseFloat(strings.ReplaceAll(amount, ",", ""), 64)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse money amount: %s", value)
+	}
+
+	decimalPlaces := len(fractionPart)
+	output := strconv.FormatFloat(randomizeMoneyAmount(randomizer, parsed, decimalPlaces), 'f', decimalPlaces, 64)
+	if grouped {
+		output = groupThousands(output)
+	}
+	return prefix + output + suffix, nil
+}
+
+func isMoneyDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+// Returns true if the digits are grouped in threes by commas, such as 1,234,567
+func isGroupedInteger(value string) bool {
+	groups := strings.Split(value, ",")
+	if len(groups[0]) == 0 || len(groups[0]) > 3 {
+		return false
+	}
+	for _, group := range groups[1:] {
+		if len(group) != 3 {
+			return false
+		}
+	}
+	return true
+}
+
+func groupThousands(value string) string {
+	integerPart, fractionPart, hasFraction := strings.Cut(value, ".")
+	var sb strings.Builder
+	for idx, char := range integerPart {
+		if idx > 0 && (len(integerPart)-idx)%3 == 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteRune(char)
+	}
+	if hasFraction {
+		sb.WriteByte('.')
+		sb.WriteString(fractionPart)
+	}
+	return sb.String()
+}
+
+// Returns a random amount with the same sign and order of magnitude as the given amount, so that 1,234.56 becomes an amount between 1,000 and 9,999.99.
+// Amounts that are too small to be randomized at the given number of decimal places are returned as is
+func randomizeMoneyAmount(randomizer rng.Rand, amount float64, decimalPlaces int) float64 {
+	abs := math.Abs(amount)
+	unit := math.Pow(10, -float64(decimalPlaces))
+	if abs < unit || math.IsInf(abs, 0) || math.IsNaN(abs) {
+		return amount
+	}
+
+	lower := math.Pow(10, math.Floor(math.Log10(abs)))
+	// log10 is not exact for every power of ten, such as 1000 which comes out just under 3
+	if lower*10 <= abs {
+		lower *= 10
+	} else if lower > abs {
+		lower /= 10
+	}
+	upper := lower * 10
+	// amounts are drawn in whole units of the last decimal place so that they never round up into the next order of magnitude
+	units := math.Round((upper - lower) / unit)
+	newAmount := lower + math.Floor(randomizer.Float64()*units)*unit
+	newAmount = roundMoney(newAmount, decimalPlaces)
+	if amount < 0 {
+		return -newAmount
+	}
+	return newAmount
+}
+
+func roundMoney(amount float64, decimalPlaces int) float64 {
+	factor := math.Pow(10, float64(decimalPlaces))
+	return math.Round(amount*factor) / factor
+}
@@ -0,0 +1,112 @@
+package transformers
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TransformMoney_Float(t *testing.T) {
+	randomizer := rng.New(1)
+	for i := 0; i < 100; i++ {
+		res, err := transformMoney(randomizer, float64(1234.56), 2)
+		require.NoError(t, err)
+		amount, ok := res.(float64)
+		require.True(t, ok)
+		require.GreaterOrEqual(t, amount, float64(1000))
+		require.Less(t, amount, float64(10000))
+		require.Equal(t, roundMoney(amount, 2), amount)
+	}
+}
+
+func Test_TransformMoney_Negative(t *testing.T) {
+	randomizer := rng.New(1)
+	res, err := transformMoney(randomizer, float64(-42.5), 2)
+	require.NoError(t, err)
+	amount := res.(float64)
+	require.LessOrEqual(t, amount, float64(-10))
+	require.Greater(t, amount, float64(-100))
+}
+
+func Test_TransformMoney_Int(t *testing.T) {
+	randomizer := rng.New(1)
+	for i := 0; i < 100; i++ {
+		res, err := transformMoney(randomizer, int64(1000), 2)
+		require.NoError(t, err)
+		amount, ok := res.(int64)
+		require.True(t, ok)
+		require.GreaterOrEqual(t, amount, int64(1000), "powers of ten should stay in their own order of magnitude")
+		require.Less(t, amount, int64(10000))
+	}
+}
+
+func Test_TransformMoney_String(t *testing.T) {
+	randomizer := rng.New(1)
+	tests := []struct {
+		input   string
+		pattern string
+	}{
+		{input: "$1,234.56", pattern: `^\$[1-9],\d{3}\.\d{2}$`},
+		{input: "-12.00 EUR", pattern: `^-[1-9]\d\.\d{2} EUR$`},
+		{input: "(USD 5.5)", pattern: `^\(USD [1-9]\.\d\)$`},
+		{input: "¥12,345,678", pattern: `^¥[1-9]\d,\d{3},\d{3}$`},
+		{input: "99.99", pattern: `^[1-9]\d\.\d{2}$`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			for i := 0; i < 20; i++ {
+				res, err := transformMoney(randomizer, tt.input, 2)
+				require.NoError(t, err)
+				require.Regexp(t, regexp.MustCompile(tt.pattern), res)
+			}
+		})
+	}
+}
+
+func Test_TransformMoney_String_NoAmount(t *testing.T) {
+	res, err := transformMoney(rng.New(1), "n/a", 2)
+	require.NoError(t, err)
+	require.Equal(t, "n/a", res)
+}
+
+func Test_TransformMoney_String_Invalid(t *testing.T) {
+	_, err := transformMoney(rng.New(1), "$12,34.00", 2)
+	require.Error(t, err)
+}
+
+func Test_TransformMoney_TooSmall(t *testing.T) {
+	res, err := transformMoney(rng.New(1), float64(0.001), 2)
+	require.NoError(t, err)
+	require.Equal(t, float64(0.001), res)
+
+	res, err = transformMoney(rng.New(1), nil, 2)
+	require.NoError(t, err)
+	require.Nil(t, res)
+}
+
+func Test_groupThousands(t *testing.T) {
+	require.Equal(t, "1,234,567.89", groupThousands("1234567.89"))
+	require.Equal(t, "123", groupThousands("123"))
+	require.Equal(t, "1,000", groupThousands("1000"))
+}
+
+func Test_TransformMoney_Benthos(t *testing.T) {
+	mapping := `root = transform_money(value:"$1,234.56",seed:1)`
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the money transformer")
+
+	res, err := ex.Query(nil)
+	require.NoError(t, err)
+	output, ok := res.(string)
+	require.True(t, ok)
+	require.True(t, strings.HasPrefix(output, "$"), output)
+	amount, err := strconv.ParseFloat(strings.ReplaceAll(strings.TrimPrefix(output, "$"), ",", ""), 64)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, amount, float64(1000))
+	require.Less(t, amount, float64(10000))
+}
@@ -0,0 +1,83 @@
+package transformers
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewAnyParam("value").Optional()).
+		Param(bloblang.NewBoolParam("preserve_entity_type").Default(false)).
+		Param(bloblang.NewInt64Param("seed").Optional())
+
+	err := bloblang.RegisterFunctionV2("transform_npi", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		value, err := args.Get("value")
+		if err != nil {
+			return nil, err
+		}
+
+		preserveEntityType, err := args.GetBool("preserve_entity_type")
+		if err != nil {
+			return nil, err
+		}
+
+		seedArg, err := args.GetOptionalInt64("seed")
+		if err != nil {
+			return nil, err
+		}
+		var seed int64
+		if seedArg != nil {
+			seed = *seedArg
+		} else {
+			seed, err = transformer_utils.GenerateCryptoSeed()
+			if err != nil {
+				return nil, err
+			}
+		}
+		randomizer := rng.New(seed)
+
+		return func() (any, error) {
+			res, err := transformNpi(randomizer, value, preserveEntityType)
+			if err != nil {
+				return nil, fmt.Errorf("unable to run transform_npi: %w", err)
+			}
+			return res, nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Replaces the NPI with a new valid one. NPIs that are stored as numbers are returned as numbers
+func transformNpi(randomizer rng.Rand, value any, preserveEntityType bool) (any, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return transformNpiString(randomizer, v, preserveEntityType), nil
+	case []byte:
+		return transformNpiString(randomizer, string(v), preserveEntityType), nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return strconv.ParseInt(transformNpiString(randomizer, fmt.Sprintf("%d", v), preserveEntityType), 10, 64)
+	default:
+		return nil, fmt.Errorf("npi must be a string or an integer, received: %T", value)
+	}
+}
+
+func transformNpiString(randomizer rng.Rand, value string, preserveEntityType bool) string {
+	if value == "" {
+		return value
+	}
+	var entityType byte
+	if preserveEntityType {
+		entityType = value[0]
+	}
+	return generateNpi(randomizer, entityType)
+}
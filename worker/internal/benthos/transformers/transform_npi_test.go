@@ -0,0 +1,47 @@
+package transformers
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TransformNpi(t *testing.T) {
+	randomizer := rng.New(1)
+
+	res, err := transformNpi(randomizer, "2234567891", true)
+	require.NoError(t, err)
+	output, ok := res.(string)
+	require.True(t, ok)
+	require.Equal(t, byte('2'), output[0])
+	require.True(t, isValidNpi(output), output)
+
+	res, err = transformNpi(randomizer, int64(1234567893), false)
+	require.NoError(t, err)
+	number, ok := res.(int64)
+	require.True(t, ok)
+	require.True(t, isValidNpi(strconv.FormatInt(number, 10)))
+
+	res, err = transformNpi(randomizer, nil, false)
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	_, err = transformNpi(randomizer, 1.5, false)
+	require.Error(t, err)
+}
+
+func Test_TransformNpi_Benthos(t *testing.T) {
+	mapping := `root = transform_npi(value:this.npi,preserve_entity_type:true,seed:1)`
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the npi transformer")
+
+	res, err := ex.Query(map[string]any{"npi": "1234567893"})
+	require.NoError(t, err)
+	output, ok := res.(string)
+	require.True(t, ok)
+	require.Equal(t, byte('1'), output[0])
+	require.True(t, isValidNpi(output), output)
+}
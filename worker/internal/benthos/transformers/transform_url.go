@@ -0,0 +1,236 @@
+package transformers
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+const (
+	urlDigits       = "0123456789"
+	urlHexLower     = "0123456789abcdef"
+	urlHexUpper     = "0123456789ABCDEF"
+	urlLowerLetters = "abcdefghijklmnopqrstuvwxyz"
+	urlUpperLetters = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+)
+
+var (
+	urlNumericSegmentRegex = regexp.MustCompile(`^\d+$`)
+	urlUuidSegmentRegex    = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	// long hex strings are almost always hashes or object ids
+	urlHexSegmentRegex   = regexp.MustCompile(`^[0-9a-fA-F]{16,}$`)
+	urlEmailSegmentRegex = regexp.MustCompile(`^[^@/\s]+@[^@/\s]+\.[^@/\s]+$`)
+)
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewAnyParam("value").Optional()).
+		Param(bloblang.NewAnyParam("query_params").Default([]any{})).
+		Param(bloblang.NewBoolParam("randomize_query_params").Default(false)).
+		Param(bloblang.NewBoolParam("strip_user_info").Default(true)).
+		Param(bloblang.NewBoolParam("randomize_path_identifiers").Default(true)).
+		Param(bloblang.NewInt64Param("seed").Optional())
+
+	err := bloblang.RegisterFunctionV2("transform_url", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		value, err := args.Get("value")
+		if err != nil {
+			return nil, err
+		}
+
+		queryParamsArg, err := args.Get("query_params")
+		if err != nil {
+			return nil, err
+		}
+		queryParams, err := fromAnyToStringSlice(queryParamsArg)
+		if err != nil {
+			return nil, err
+		}
+
+		randomizeQueryParams, err := args.GetBool("randomize_query_params")
+		if err != nil {
+			return nil, err
+		}
+
+		stripUserInfo, err := args.GetBool("strip_user_info")
+		if err != nil {
+			return nil, err
+		}
+
+		randomizePathIdentifiers, err := args.GetBool("randomize_path_identifiers")
+		if err != nil {
+			return nil, err
+		}
+
+		seedArg, err := args.GetOptionalInt64("seed")
+		if err != nil {
+			return nil, err
+		}
+		var seed int64
+		if seedArg != nil {
+			seed = *seedArg
+		} else {
+			seed, err = transformer_utils.GenerateCryptoSeed()
+			if err != nil {
+				return nil, err
+			}
+		}
+		randomizer := rng.New(seed)
+
+		opts := &transformUrlOptions{
+			QueryParams:              queryParams,
+			RandomizeQueryParams:     randomizeQueryParams,
+			StripUserInfo:            stripUserInfo,
+			RandomizePathIdentifiers: randomizePathIdentifiers,
+		}
+
+		return func() (any, error) {
+			res, err := transformUrl(randomizer, value, opts)
+			if err != nil {
+				return nil, fmt.Errorf("unable to run transform_url: %w", err)
+			}
+			return res, nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+type transformUrlOptions struct {
+	// The query parameters to scrub, compared case-insensitively. Every query parameter is scrubbed if this is empty
+	QueryParams []string
+	// Replaces the values of the scrubbed query parameters with random values instead of removing them
+	RandomizeQueryParams     bool
+	StripUserInfo            bool
+	RandomizePathIdentifiers bool
+}
+
+// Scrubs the query parameters, user info and path identifiers of a url while leaving its scheme, host and the rest of its path as is
+func transformUrl(randomizer rng.Rand, value any, opts *transformUrlOptions) (*string, error) {
+	var input string
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		input = v
+	case []byte:
+		input = string(v)
+	default:
+		return nil, fmt.Errorf("unsupported url type: %T", value)
+	}
+	if input == "" {
+		return &input, nil
+	}
+
+	u, err := url.Parse(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse url: %w", err)
+	}
+
+	if opts.StripUserInfo {
+		u.User = nil
+	}
+	if u.RawQuery != "" {
+		u.RawQuery = scrubUrlQuery(randomizer, u.RawQuery, opts)
+	}
+	if opts.RandomizePathIdentifiers && u.Path != "" {
+		segments := strings.Split(u.Path, "/")
+		changed := false
+		for idx, segment := range segments {
+			if randomized, ok := randomizeUrlPathIdentifier(randomizer, segment); ok {
+				segments[idx] = randomized
+				changed = true
+			}
+		}
+		if changed {
+			u.Path = strings.Join(segments, "/")
+			u.RawPath = ""
+		}
+	}
+
+	output := u.String()
+	return &output, nil
+}
+
+// The raw query is edited in place instead of going through url.Values so that the order of the parameters is kept
+func scrubUrlQuery(randomizer rng.Rand, rawQuery string, opts *transformUrlOptions) string {
+	scrubbed := make(map[string]struct{}, len(opts.QueryParams))
+	for _, param := range opts.QueryParams {
+		scrubbed[strings.ToLower(param)] = struct{}{}
+	}
+
+	pairs := strings.Split(rawQuery, "&")
+	output := make([]string, 0, len(pairs))
+	for _, pair := range pairs {
+		if pair == "" {
+			continue
+		}
+		rawKey, rawValue, hasValue := strings.Cut(pair, "=")
+		key, err := url.QueryUnescape(rawKey)
+		if err != nil {
+			key = rawKey
+		}
+		if _, ok := scrubbed[strings.ToLower(key)]; len(scrubbed) > 0 && !ok {
+			output = append(output, pair)
+			continue
+		}
+		if !opts.RandomizeQueryParams {
+			continue
+		}
+		if !hasValue {
+			output = append(output, pair)
+			continue
+		}
+		value, err := url.QueryUnescape(rawValue)
+		if err != nil {
+			value = rawValue
+		}
+		output = append(output, fmt.Sprintf("%s=%s", rawKey, url.QueryEscape(scrambleUrlValue(randomizer, value))))
+	}
+	return strings.Join(output, "&")
+}
+
+// Returns a random value with the same shape as the path segment if the segment looks like it identifies a user or record
+func randomizeUrlPathIdentifier(randomizer rng.Rand, segment string) (string, bool) {
+	switch {
+	case segment == "":
+		return "", false
+	case urlNumericSegmentRegex.MatchString(segment), urlUuidSegmentRegex.MatchString(segment), urlHexSegmentRegex.MatchString(segment):
+		return scrambleUrlValue(randomizer, segment), true
+	case urlEmailSegmentRegex.MatchString(segment):
+		localPart, domain, _ := strings.Cut(segment, "@")
+		return fmt.Sprintf("%s@%s", scrambleUrlValue(randomizer, localPart), domain), true
+	default:
+		return "", false
+	}
+}
+
+// Replaces every letter and digit with a random one of the same class so that the value keeps its length and format
+func scrambleUrlValue(randomizer rng.Rand, value string) string {
+	isHex := !urlNumericSegmentRegex.MatchString(value) && (urlHexSegmentRegex.MatchString(value) || urlUuidSegmentRegex.MatchString(value))
+	var sb strings.Builder
+	sb.Grow(len(value))
+	for _, char := range value {
+		switch {
+		case isHex && char >= '0' && char <= '9', isHex && char >= 'a' && char <= 'f':
+			sb.WriteByte(urlHexLower[randomizer.Intn(len(urlHexLower))])
+		case isHex && char >= 'A' && char <= 'F':
+			sb.WriteByte(urlHexUpper[randomizer.Intn(len(urlHexUpper))])
+		case char >= '0' && char <= '9':
+			sb.WriteByte(urlDigits[randomizer.Intn(len(urlDigits))])
+		case char >= 'a' && char <= 'z':
+			sb.WriteByte(urlLowerLetters[randomizer.Intn(len(urlLowerLetters))])
+		case char >= 'A' && char <= 'Z':
+			sb.WriteByte(urlUpperLetters[randomizer.Intn(len(urlUpperLetters))])
+		default:
+			sb.WriteRune(char)
+		}
+	}
+	return sb.String()
+}
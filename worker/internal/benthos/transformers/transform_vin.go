@@ -0,0 +1,81 @@
+package transformers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewAnyParam("value").Optional()).
+		Param(bloblang.NewBoolParam("preserve_manufacturer").Default(false)).
+		Param(bloblang.NewInt64Param("seed").Optional())
+
+	err := bloblang.RegisterFunctionV2("transform_vin", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		value, err := args.Get("value")
+		if err != nil {
+			return nil, err
+		}
+
+		preserveManufacturer, err := args.GetBool("preserve_manufacturer")
+		if err != nil {
+			return nil, err
+		}
+
+		seedArg, err := args.GetOptionalInt64("seed")
+		if err != nil {
+			return nil, err
+		}
+		var seed int64
+		if seedArg != nil {
+			seed = *seedArg
+		} else {
+			seed, err = transformer_utils.GenerateCryptoSeed()
+			if err != nil {
+				return nil, err
+			}
+		}
+		randomizer := rng.New(seed)
+
+		return func() (any, error) {
+			res, err := transformVin(randomizer, value, preserveManufacturer)
+			if err != nil {
+				return nil, fmt.Errorf("unable to run transform_vin: %w", err)
+			}
+			return res, nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Replaces the VIN with a new valid one
+func transformVin(randomizer rng.Rand, value any, preserveManufacturer bool) (any, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return transformVinString(randomizer, v, preserveManufacturer), nil
+	case []byte:
+		return transformVinString(randomizer, string(v), preserveManufacturer), nil
+	default:
+		return nil, fmt.Errorf("vin must be a string, received: %T", value)
+	}
+}
+
+func transformVinString(randomizer rng.Rand, value string, preserveManufacturer bool) string {
+	if value == "" {
+		return value
+	}
+	var worldManufacturerId string
+	if preserveManufacturer && len(value) >= 3 {
+		worldManufacturerId = strings.ToUpper(value[:3])
+	}
+	return generateVin(randomizer, worldManufacturerId)
+}
@@ -0,0 +1,50 @@
+package transformers
+
+import (
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TransformVin(t *testing.T) {
+	randomizer := rng.New(1)
+
+	res, err := transformVin(randomizer, "1m8GDM9AXKP042788", true)
+	require.NoError(t, err)
+	output, ok := res.(string)
+	require.True(t, ok)
+	require.Equal(t, "1M8", output[:3])
+	require.True(t, isValidVin(output), output)
+
+	res, err = transformVin(randomizer, []byte("1M8GDM9AXKP042788"), false)
+	require.NoError(t, err)
+	output, ok = res.(string)
+	require.True(t, ok)
+	require.True(t, isValidVin(output), output)
+
+	res, err = transformVin(randomizer, "", true)
+	require.NoError(t, err)
+	require.Equal(t, "", res)
+
+	res, err = transformVin(randomizer, nil, false)
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	_, err = transformVin(randomizer, 123, false)
+	require.Error(t, err)
+}
+
+func Test_TransformVin_Benthos(t *testing.T) {
+	mapping := `root = transform_vin(value:this.vin,preserve_manufacturer:true,seed:1)`
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the vin transformer")
+
+	res, err := ex.Query(map[string]any{"vin": "5YJ3E1EA7KF317000"})
+	require.NoError(t, err)
+	output, ok := res.(string)
+	require.True(t, ok)
+	require.Equal(t, "5YJ", output[:3])
+	require.True(t, isValidVin(output), output)
+}
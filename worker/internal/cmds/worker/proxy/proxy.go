@@ -0,0 +1,129 @@
+package proxy_cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os/signal"
+	"syscall"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
+	logger_utils "github.com/nucleuscloud/neosync/worker/internal/logger"
+	"github.com/nucleuscloud/neosync/worker/internal/masking"
+	"github.com/nucleuscloud/neosync/worker/internal/pgproxy"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func NewCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "proxy",
+		Short: "serves a postgres endpoint that masks a job's source connection with the job's transformers",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return serve(cmd.Context())
+		},
+	}
+}
+
+func serve(ctx context.Context) error {
+	logger, _ := logger_utils.NewLoggers()
+	ctx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	jobId := viper.GetString("PROXY_JOB_ID")
+	if jobId == "" {
+		return errors.New("must provide PROXY_JOB_ID environment variable")
+	}
+	password := viper.GetString("PROXY_PASSWORD")
+	if password == "" {
+		return errors.New("must provide PROXY_PASSWORD environment variable")
+	}
+
+	neosyncurl := shared.GetNeosyncUrl()
+	httpclient := shared.GetNeosyncHttpClient()
+	connclient := mgmtv1alpha1connect.NewConnectionServiceClient(httpclient, neosyncurl)
+	jobclient := mgmtv1alpha1connect.NewJobServiceClient(httpclient, neosyncurl)
+	transformerclient := mgmtv1alpha1connect.NewTransformersServiceClient(httpclient, neosyncurl)
+
+	jobResp, err := jobclient.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{Id: jobId}))
+	if err != nil {
+		return fmt.Errorf("unable to get job by id (%s): %w", jobId, err)
+	}
+	job := jobResp.Msg.GetJob()
+
+	sourceConnection, err := shared.GetJobSourceConnection(ctx, job.GetSource(), connclient)
+	if err != nil {
+		return err
+	}
+	pgconfig := sourceConnection.GetConnectionConfig().GetPgConfig()
+	if pgconfig == nil {
+		return errors.New("the masking proxy only supports jobs with a postgres source connection")
+	}
+
+	masker, err := masking.NewMaskerFromMappings(ctx, transformerclient, job.GetMappings())
+	if err != nil {
+		return err
+	}
+
+	poolContainer, err := (&sqlconnect.SqlOpenConnector{}).NewPgPoolFromConnectionConfig(pgconfig, nil, logger)
+	if err != nil {
+		return err
+	}
+	db, err := poolContainer.Open(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to open source connection: %w", err)
+	}
+	defer poolContainer.Close()
+	pool, ok := db.(*pgxpool.Pool)
+	if !ok {
+		return fmt.Errorf("unexpected postgres connection type: %T", db)
+	}
+	connector := func(ctx context.Context) (*pgx.Conn, error) {
+		conn, err := pool.Acquire(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// sessions hold their connection for their entire lifetime and change its settings, so it is removed from the pool
+		return conn.Hijack(), nil
+	}
+
+	listenAddress := viper.GetString("PROXY_LISTEN_ADDRESS")
+	if listenAddress == "" {
+		listenAddress = ":5433"
+	}
+	ln, err := net.Listen("tcp", listenAddress)
+	if err != nil {
+		return fmt.Errorf("unable to listen on %s: %w", listenAddress, err)
+	}
+
+	server, err := pgproxy.New(connector, masker, &pgproxy.Config{
+		Password: password,
+		Strict:   getIsStrict(),
+	}, logger.With("jobId", jobId))
+	if err != nil {
+		return err
+	}
+
+	logger.Info(fmt.Sprintf("masking proxy listening on %s", ln.Addr().String()))
+	if err := server.Serve(ctx, ln); err != nil {
+		return err
+	}
+	logger.Info("masking proxy stopped")
+	return nil
+}
+
+func getIsStrict() bool {
+	if viper.GetString("PROXY_STRICT") == "" {
+		return true
+	}
+	return viper.GetBool("PROXY_STRICT")
+}
@@ -0,0 +1,196 @@
+package s3gateway_cmd
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	logger_utils "github.com/nucleuscloud/neosync/worker/internal/logger"
+	"github.com/nucleuscloud/neosync/worker/internal/masking"
+	"github.com/nucleuscloud/neosync/worker/internal/s3gateway"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func NewCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "s3-gateway",
+		Short: "serves the objects of an s3 connection masked with a job's transformers",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return serve(cmd.Context())
+		},
+	}
+}
+
+func serve(ctx context.Context) error {
+	logger, loglogger := logger_utils.NewLoggers()
+	ctx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	jobId := viper.GetString("S3_GATEWAY_JOB_ID")
+	if jobId == "" {
+		return errors.New("must provide S3_GATEWAY_JOB_ID environment variable")
+	}
+	token := viper.GetString("S3_GATEWAY_TOKEN")
+	if token == "" {
+		return errors.New("must provide S3_GATEWAY_TOKEN environment variable")
+	}
+
+	neosyncurl := shared.GetNeosyncUrl()
+	httpclient := shared.GetNeosyncHttpClient()
+	connclient := mgmtv1alpha1connect.NewConnectionServiceClient(httpclient, neosyncurl)
+	jobclient := mgmtv1alpha1connect.NewJobServiceClient(httpclient, neosyncurl)
+	transformerclient := mgmtv1alpha1connect.NewTransformersServiceClient(httpclient, neosyncurl)
+
+	jobResp, err := jobclient.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{Id: jobId}))
+	if err != nil {
+		return fmt.Errorf("unable to get job by id (%s): %w", jobId, err)
+	}
+	job := jobResp.Msg.GetJob()
+
+	connectionId := viper.GetString("S3_GATEWAY_CONNECTION_ID")
+	if connectionId == "" {
+		// defaults to the bucket that the job writes to
+		for _, dest := range job.GetDestinations() {
+			if dest.GetOptions().GetAwsS3Options() != nil {
+				connectionId = dest.GetConnectionId()
+				break
+			}
+		}
+	}
+	if connectionId == "" {
+		return errors.New("must provide S3_GATEWAY_CONNECTION_ID environment variable when the job has no aws s3 destination")
+	}
+	connection, err := shared.GetConnectionById(ctx, connclient, connectionId)
+	if err != nil {
+		return fmt.Errorf("unable to get connection by id (%s): %w", connectionId, err)
+	}
+	s3config := connection.GetConnectionConfig().GetAwsS3Config()
+	if s3config == nil {
+		return errors.New("the s3 gateway only supports aws s3 connections")
+	}
+
+	masker, err := masking.NewMaskerFromMappings(ctx, transformerclient, job.GetMappings())
+	if err != nil {
+		return err
+	}
+
+	awsCfg, err := getAwsConfig(ctx, s3config)
+	if err != nil {
+		return fmt.Errorf("unable to build aws config: %w", err)
+	}
+
+	s3client := s3.NewFromConfig(*awsCfg, func(o *s3.Options) {
+		o.UsePathStyle = s3config.GetForcePathStyle()
+	})
+	gateway, err := s3gateway.New(s3client, masker, &s3gateway.Config{
+		Bucket:     s3config.GetBucket(),
+		PathPrefix: s3config.GetPathPrefix(),
+		Token:      token,
+		Strict:     getIsStrict(),
+	}, logger.With("jobId", jobId))
+	if err != nil {
+		return err
+	}
+
+	listenAddress := viper.GetString("S3_GATEWAY_LISTEN_ADDRESS")
+	if listenAddress == "" {
+		listenAddress = ":8090"
+	}
+	httpServer := &http.Server{
+		Addr:              listenAddress,
+		Handler:           gateway,
+		ErrorLog:          loglogger,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			logger.Error(err.Error())
+		}
+	}()
+
+	logger.Info(fmt.Sprintf("s3 gateway listening on %s", listenAddress))
+	if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	logger.Info("s3 gateway stopped")
+	return nil
+}
+
+func getAwsConfig(ctx context.Context, config *mgmtv1alpha1.AwsS3ConnectionConfig) (*aws.Config, error) {
+	awsCfg := aws.NewConfig()
+
+	configCreds := config.GetCredentials()
+	if profile := configCreds.GetProfile(); profile != "" {
+		cfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithSharedConfigProfile(profile))
+		if err != nil {
+			return nil, err
+		}
+		awsCfg = &cfg
+	} else if accessKeyId := configCreds.GetAccessKeyId(); accessKeyId != "" {
+		staticCredsProvider := credentials.NewStaticCredentialsProvider(accessKeyId, configCreds.GetSecretAccessKey(), configCreds.GetSessionToken())
+		awsCfg.Credentials = aws.NewCredentialsCache(staticCredsProvider)
+	}
+
+	if region := config.GetRegion(); region != "" {
+		awsCfg.Region = region
+	}
+	if endpoint := config.GetEndpoint(); endpoint != "" {
+		awsCfg.BaseEndpoint = aws.String(endpoint)
+	}
+	if config.GetInsecureSkipVerify() {
+		awsCfg.HTTPClient = awshttp.NewBuildableClient().WithTransportOptions(func(tr *http.Transport) {
+			if tr.TLSClientConfig == nil {
+				tr.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+			}
+			tr.TLSClientConfig.InsecureSkipVerify = true //nolint:gosec
+		})
+	}
+
+	if role := configCreds.GetRoleArn(); role != "" {
+		awsCfg.Credentials = aws.NewCredentialsCache(
+			stscreds.NewAssumeRoleProvider(sts.NewFromConfig(*awsCfg), role, func(aro *stscreds.AssumeRoleOptions) {
+				if externalId := configCreds.GetRoleExternalId(); externalId != "" {
+					aro.ExternalID = aws.String(externalId)
+				}
+				aro.RoleSessionName = "neosync-s3-gateway"
+			}),
+		)
+	}
+
+	if configCreds.GetFromEc2Role() {
+		awsCfg.Credentials = ec2rolecreds.New()
+	}
+
+	return awsCfg, nil
+}
+
+func getIsStrict() bool {
+	if viper.GetString("S3_GATEWAY_STRICT") == "" {
+		return true
+	}
+	return viper.GetBool("S3_GATEWAY_STRICT")
+}
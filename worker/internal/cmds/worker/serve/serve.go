@@ -7,16 +7,31 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"os"
 	"sync"
 	"time"
 
 	"connectrpc.com/grpchealth"
 	"connectrpc.com/grpcreflect"
 	"github.com/go-logr/logr"
+	mysql_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/mysql"
+	pg_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/postgresql"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/pkg/dbcredentials"
+	"github.com/nucleuscloud/neosync/backend/pkg/nonfinite"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	neosync_benthos_javascript "github.com/nucleuscloud/neosync/worker/internal/benthos/javascript"
 	logger_utils "github.com/nucleuscloud/neosync/worker/internal/logger"
+	"github.com/nucleuscloud/neosync/worker/internal/openlineage"
+	"github.com/nucleuscloud/neosync/worker/internal/telemetryexport"
+	analyzetables_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/analyze-tables"
+	computedataquality_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/compute-data-quality"
+	desttriggers_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/destination-triggers"
+	emitrunevent_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/emit-run-event"
 	genbenthosconfigs_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/gen-benthos-configs"
+	refreshmatviews_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/refresh-materialized-views"
+	restoresnapshot_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/restore-snapshot"
 	runsqlinittablestmts_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/run-sql-init-table-stmts"
 	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 	sync_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync"
@@ -55,12 +70,24 @@ func NewCmd() *cobra.Command {
 }
 
 func serve(ctx context.Context) error {
+	// the exporter reports its own failures through a logger that does not forward its logs
+	exporterLogger := logger_utils.NewJsonSLogger()
+	telemetryExporter := telemetryexport.New(getTelemetryExportConfig(), exporterLogger)
+	logger_utils.SetLogExporter(telemetryExporter)
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := telemetryExporter.Shutdown(ctx); err != nil {
+			exporterLogger.Error(fmt.Sprintf("unable to flush forwarded logs: %s", err.Error()))
+		}
+	}()
+
 	logger, loglogger := logger_utils.NewLoggers()
 
 	var activityMeter metric.Meter
-	if getIsOtelEnabled() {
+	if getIsOtelEnabled() || telemetryExporter.IsEnabled() {
 		otel.SetLogger(logr.FromSlogHandler(logger.Handler()))
-		metricProvider, ok, err := getConfiguredMeterProvider(ctx)
+		metricProvider, ok, err := getConfiguredMeterProvider(ctx, telemetryExporter)
 		if err != nil {
 			return err
 		}
@@ -91,6 +118,11 @@ func serve(ctx context.Context) error {
 	if taskQueue == "" {
 		return errors.New("must provide TEMPORAL_TASK_QUEUE environment variable")
 	}
+	if region := viper.GetString("TEMPORAL_WORKER_REGION"); region != "" {
+		// regional workers only run the activities of jobs whose connections live in their region
+		taskQueue = shared.GetRegionalTaskQueue(taskQueue, region)
+		logger = logger.With("region", region)
+	}
 
 	certificates, err := getTemporalAuthCertificate()
 	if err != nil {
@@ -120,13 +152,20 @@ func serve(ctx context.Context) error {
 
 	w := worker.New(temporalClient, taskQueue, worker.Options{})
 	_ = w
+	// interactive runs route their activities to a dedicated queue so they are not stuck behind batch runs
+	interactiveWorker := worker.New(temporalClient, shared.GetInteractiveTaskQueue(taskQueue), worker.Options{
+		MaxConcurrentActivityExecutionSize: getInteractiveMaxConcurrentActivities(),
+	})
 
 	neosyncurl := shared.GetNeosyncUrl()
 	httpclient := shared.GetNeosyncHttpClient()
 	connclient := mgmtv1alpha1connect.NewConnectionServiceClient(httpclient, neosyncurl)
 	jobclient := mgmtv1alpha1connect.NewJobServiceClient(httpclient, neosyncurl)
 	transformerclient := mgmtv1alpha1connect.NewTransformersServiceClient(httpclient, neosyncurl)
-	sqlconnector := &sqlconnect.SqlOpenConnector{}
+	dataclient := mgmtv1alpha1connect.NewConnectionDataServiceClient(httpclient, neosyncurl)
+	credentialsprovider := dbcredentials.New(getDbCredentialsConfig())
+	// connections that are configured to use temporary credentials are opened with credentials minted by the provider
+	sqlconnector := dbcredentials.NewSqlConnector(&sqlconnect.SqlOpenConnector{}, credentialsprovider)
 	redisconfig := shared.GetRedisConfig()
 
 	genbenthosActivity := genbenthosconfigs_activity.New(
@@ -135,20 +174,78 @@ func serve(ctx context.Context) error {
 		transformerclient,
 		sqlconnector,
 		redisconfig,
-		getIsOtelEnabled(),
+		activityMeter != nil,
+	)
+	dataQualityActivity := computedataquality_activity.New(
+		jobclient,
+		connclient,
+		sql_manager.NewSqlManager(&sync.Map{}, pg_queries.New(), &sync.Map{}, mysql_queries.New(), sqlconnector),
+		shared.IsDataQualityScoringEnabled(),
+	)
+	destTriggersActivity := desttriggers_activity.New(
+		jobclient,
+		connclient,
+		sql_manager.NewSqlManager(&sync.Map{}, pg_queries.New(), &sync.Map{}, mysql_queries.New(), sqlconnector),
+	)
+	analyzeTablesActivity := analyzetables_activity.New(
+		jobclient,
+		connclient,
+		sql_manager.NewSqlManager(&sync.Map{}, pg_queries.New(), &sync.Map{}, mysql_queries.New(), sqlconnector),
+	)
+	refreshMatViewsActivity := refreshmatviews_activity.New(
+		jobclient,
+		connclient,
+		sql_manager.NewSqlManager(&sync.Map{}, pg_queries.New(), &sync.Map{}, mysql_queries.New(), sqlconnector),
+	)
+	initTableStmtsActivity := runsqlinittablestmts_activity.New(
+		jobclient,
+		connclient,
+		sql_manager.NewSqlManager(&sync.Map{}, pg_queries.New(), &sync.Map{}, mysql_queries.New(), sqlconnector),
+	)
+	restoreSnapshotActivity := restoresnapshot_activity.New(jobclient, connclient)
+	runEventActivity := emitrunevent_activity.New(
+		jobclient,
+		connclient,
+		openlineage.New(getOpenLineageConfig()),
+		telemetryExporter,
+	)
+	rowCountPolicy, err := getRowCountPolicy()
+	if err != nil {
+		return err
+	}
+	nonFinitePolicy, err := getNonFinitePolicy()
+	if err != nil {
+		return err
+	}
+	syncActivity := sync_activity.New(
+		connclient, dataclient, &sync.Map{}, temporalClient, activityMeter, sync_activity.NewBenthosStreamManager(), credentialsprovider,
+		rowCountPolicy, nonFinitePolicy, getJavascriptLimits(),
 	)
-	syncActivity := sync_activity.New(connclient, &sync.Map{}, temporalClient, activityMeter, sync_activity.NewBenthosStreamManager())
 
 	w.RegisterWorkflow(datasync_workflow.Workflow)
-	w.RegisterActivity(syncActivity.Sync)
-	w.RegisterActivity(syncactivityopts_activity.RetrieveActivityOptions)
-	w.RegisterActivity(runsqlinittablestmts_activity.RunSqlInitTableStatements)
-	w.RegisterActivity(syncrediscleanup_activity.DeleteRedisHash)
-	w.RegisterActivity(genbenthosActivity.GenerateBenthosConfigs)
+	for _, aw := range []worker.Worker{w, interactiveWorker} {
+		aw.RegisterActivity(syncActivity.Sync)
+		aw.RegisterActivity(syncactivityopts_activity.RetrieveActivityOptions)
+		aw.RegisterActivity(initTableStmtsActivity.RunSqlInitTableStatements)
+		aw.RegisterActivity(syncrediscleanup_activity.DeleteRedisHash)
+		aw.RegisterActivity(genbenthosActivity.GenerateBenthosConfigs)
+		aw.RegisterActivity(destTriggersActivity.DisableDestinationTriggers)
+		aw.RegisterActivity(destTriggersActivity.EnableDestinationTriggers)
+		aw.RegisterActivity(analyzeTablesActivity.AnalyzeTables)
+		aw.RegisterActivity(refreshMatViewsActivity.RefreshMaterializedViews)
+		aw.RegisterActivity(dataQualityActivity.ComputeDataQuality)
+		aw.RegisterActivity(runEventActivity.EmitRunEvent)
+		aw.RegisterActivity(restoreSnapshotActivity.RestoreSnapshot)
+		aw.RegisterActivity(restoreSnapshotActivity.DeleteRestoredSnapshot)
+	}
 
 	if err := w.Start(); err != nil {
 		return fmt.Errorf("unable to start temporal worker: %w", err)
 	}
+	if err := interactiveWorker.Start(); err != nil {
+		w.Stop()
+		return fmt.Errorf("unable to start interactive temporal worker: %w", err)
+	}
 
 	httpServer := getHttpServer(loglogger)
 
@@ -162,6 +259,7 @@ func serve(ctx context.Context) error {
 
 	<-worker.InterruptCh()
 	logger.Info("received interrupt, stopping worker...")
+	interactiveWorker.Stop()
 	w.Stop()
 	logger.Info("temporal worker shut down, proceeding to shutting down http server")
 	ctx, cancelHandler := context.WithDeadline(context.Background(), time.Now().Add(2*time.Second))
@@ -277,36 +375,90 @@ func temporalitySelector(ik metricsdk.InstrumentKind) metricdata.Temporality {
 	return metricdata.DeltaTemporality
 }
 
-func getConfiguredMeterProvider(ctx context.Context) (*metricsdk.MeterProvider, bool, error) {
-	if !getIsOtelEnabled() {
-		return nil, false, nil
-	}
-	// todo: may want to conditionally allow http, prometheus metering based on env vars
-	var exporter metricsdk.Exporter
-	exporterType := getMetricsExporter()
-	if exporterType == "otlp" {
-		grpcExporter, err := otlpmetricgrpc.New(ctx, otlpmetricgrpc.WithTemporalitySelector(temporalitySelector))
-		if err != nil {
-			return nil, false, err
+// Metrics are sent to the OTLP exporter when OTEL is enabled, and forwarded to Datadog or Splunk when the telemetry exporter is
+func getConfiguredMeterProvider(ctx context.Context, telemetryExporter *telemetryexport.Exporter) (*metricsdk.MeterProvider, bool, error) {
+	opts := []metricsdk.Option{}
+	if getIsOtelEnabled() {
+		// todo: may want to conditionally allow http, prometheus metering based on env vars
+		var exporter metricsdk.Exporter
+		exporterType := getMetricsExporter()
+		if exporterType == "otlp" {
+			grpcExporter, err := otlpmetricgrpc.New(ctx, otlpmetricgrpc.WithTemporalitySelector(temporalitySelector))
+			if err != nil {
+				return nil, false, err
+			}
+			exporter = grpcExporter
+		} else {
+			return nil, false, fmt.Errorf("that exporter type is not currently supported")
 		}
-		exporter = grpcExporter
-	} else {
-		return nil, false, fmt.Errorf("that exporter type is not currently supported")
+		opts = append(opts, metricsdk.WithReader(
+			metricsdk.NewPeriodicReader(
+				exporter,
+			),
+		))
+	}
+	if telemetryExporter.IsEnabled() {
+		opts = append(opts, metricsdk.WithReader(
+			metricsdk.NewPeriodicReader(
+				telemetryexport.NewMetricExporter(telemetryExporter),
+			),
+		))
+	}
+	if len(opts) == 0 {
+		return nil, false, nil
 	}
 
-	reader := metricsdk.WithReader(
-		metricsdk.NewPeriodicReader(
-			exporter,
-		),
-	)
 	attrs := []attribute.KeyValue{
 		semconv.ServiceVersion(getAppVersion()),
 	}
 	res := resource.NewWithAttributes(semconv.SchemaURL, attrs...)
-	provider := metricsdk.NewMeterProvider(reader, metricsdk.WithResource(res))
+	provider := metricsdk.NewMeterProvider(append(opts, metricsdk.WithResource(res))...)
 	return provider, true, nil
 }
 
+// Used to mint temporary credentials for connections that are configured to use Vault
+func getDbCredentialsConfig() *dbcredentials.Config {
+	return &dbcredentials.Config{
+		VaultAddress:   viper.GetString("VAULT_ADDR"),
+		VaultToken:     viper.GetString("VAULT_TOKEN"),
+		VaultNamespace: viper.GetString("VAULT_NAMESPACE"),
+	}
+}
+
+// Used to report runs to an OpenLineage backend such as Marquez. Events are not sent unless OPENLINEAGE_URL is set
+func getOpenLineageConfig() *openlineage.Config {
+	return &openlineage.Config{
+		Url:       viper.GetString("OPENLINEAGE_URL"),
+		Endpoint:  viper.GetString("OPENLINEAGE_ENDPOINT"),
+		ApiKey:    viper.GetString("OPENLINEAGE_API_KEY"),
+		Namespace: viper.GetString("OPENLINEAGE_NAMESPACE"),
+	}
+}
+
+// Used to forward logs, metrics and run events to Datadog or Splunk.
+// Nothing is forwarded unless DATADOG_API_KEY or SPLUNK_HEC_URL is set
+func getTelemetryExportConfig() *telemetryexport.Config {
+	hostname, _ := os.Hostname()
+	cfg := &telemetryexport.Config{Hostname: hostname}
+	if apiKey := viper.GetString("DATADOG_API_KEY"); apiKey != "" {
+		cfg.Datadog = &telemetryexport.DatadogConfig{
+			ApiKey:  apiKey,
+			Site:    viper.GetString("DATADOG_SITE"),
+			Service: viper.GetString("DATADOG_SERVICE"),
+		}
+	}
+	if url := viper.GetString("SPLUNK_HEC_URL"); url != "" {
+		cfg.Splunk = &telemetryexport.SplunkConfig{
+			Url:                url,
+			Token:              viper.GetString("SPLUNK_HEC_TOKEN"),
+			Index:              viper.GetString("SPLUNK_HEC_INDEX"),
+			Source:             viper.GetString("SPLUNK_HEC_SOURCE"),
+			InsecureSkipVerify: viper.GetBool("SPLUNK_HEC_INSECURE_SKIP_VERIFY"),
+		}
+	}
+	return cfg
+}
+
 func getIsOtelEnabled() bool {
 	isDisabledStr := viper.GetString("OTEL_SDK_DISABLED")
 	if isDisabledStr == "" {
@@ -315,6 +467,40 @@ func getIsOtelEnabled() bool {
 	return !viper.GetBool("OTEL_SDK_DISABLED")
 }
 
+// Determines what a sync does when a destination did not receive every row that was read for a table. Defaults to failing the sync
+func getRowCountPolicy() (sync_activity.RowCountPolicy, error) {
+	return sync_activity.ParseRowCountPolicy(viper.GetString("ROW_COUNT_VERIFICATION"))
+}
+
+// Determines what happens to NaN and infinite values that are written to a destination. Defaults to preserving them
+func getNonFinitePolicy() (nonfinite.Policy, error) {
+	return nonfinite.ParsePolicy(viper.GetString("NON_FINITE_NUMBER_POLICY"))
+}
+
+// Limits how long, and how much memory, javascript transformers may use for each row. Unlimited when not set.
+// Also caps the outputs of deterministic javascript transformers that are cached for each table
+func getJavascriptLimits() *neosync_benthos_javascript.Limits {
+	return &neosync_benthos_javascript.Limits{
+		Timeout:         viper.GetDuration("JAVASCRIPT_TRANSFORMER_TIMEOUT"),
+		MaxMemoryBytes:  viper.GetUint64("JAVASCRIPT_TRANSFORMER_MAX_MEMORY"),
+		OutputCacheSize: getJavascriptTransformerCacheSize(),
+	}
+}
+
+const defaultJavascriptTransformerCacheSize = 10000
+
+func getJavascriptTransformerCacheSize() int {
+	if !viper.IsSet("JAVASCRIPT_TRANSFORMER_CACHE_SIZE") {
+		return defaultJavascriptTransformerCacheSize
+	}
+	return viper.GetInt("JAVASCRIPT_TRANSFORMER_CACHE_SIZE")
+}
+
+// Caps the activities of interactive runs this worker executes at once. Defaults to the temporal sdk's default when not set
+func getInteractiveMaxConcurrentActivities() int {
+	return viper.GetInt("TEMPORAL_INTERACTIVE_CONCURRENCY")
+}
+
 func getAppVersion() string {
 	return viper.GetString("OTEL_SERVICE_VERSION")
 }
@@ -3,6 +3,8 @@ package worker_cmd
 import (
 	"fmt"
 
+	proxy_cmd "github.com/nucleuscloud/neosync/worker/internal/cmds/worker/proxy"
+	s3gateway_cmd "github.com/nucleuscloud/neosync/worker/internal/cmds/worker/s3gateway"
 	serve_connect "github.com/nucleuscloud/neosync/worker/internal/cmds/worker/serve"
 
 	"github.com/spf13/cobra"
@@ -25,6 +27,8 @@ func Execute() {
 
 	// Wire up subcommands here
 	rootCmd.AddCommand(serve_connect.NewCmd())
+	rootCmd.AddCommand(proxy_cmd.NewCmd())
+	rootCmd.AddCommand(s3gateway_cmd.NewCmd())
 
 	cobra.CheckErr(rootCmd.Execute())
 }
@@ -5,10 +5,20 @@ import (
 	"log/slog"
 	"os"
 	"strings"
+	"sync/atomic"
 
+	"github.com/nucleuscloud/neosync/worker/internal/telemetryexport"
 	"github.com/spf13/viper"
 )
 
+// When set, every logger created afterwards also forwards its logs through the exporter
+var logExporter atomic.Pointer[telemetryexport.Exporter]
+
+// Forwards the logs of every logger that is created from this point on through the exporter
+func SetLogExporter(exporter *telemetryexport.Exporter) {
+	logExporter.Store(exporter)
+}
+
 func NewLoggers() (slogger *slog.Logger, loglogger *log.Logger) {
 	handler := NewJsonLogHandler()
 	return slog.New(handler), slog.NewLogLogger(handler, getLogLevel())
@@ -22,10 +32,14 @@ func NewJsonLogLogger() *log.Logger {
 	return slog.NewLogLogger(NewJsonLogHandler(), getLogLevel())
 }
 
-func NewJsonLogHandler() *slog.JSONHandler {
-	return slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+func NewJsonLogHandler() slog.Handler {
+	handler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
 		Level: getLogLevel(),
 	})
+	if exporter := logExporter.Load(); exporter != nil {
+		return telemetryexport.NewLogHandler(handler, exporter)
+	}
+	return handler
 }
 
 func getLogLevel() slog.Level {
@@ -0,0 +1,130 @@
+// Package masking applies a job's column transformers to individual rows outside of a sync run
+package masking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/internal/benthos"
+	genbenthosconfigs_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/gen-benthos-configs"
+
+	// registers the neosync transformers as bloblang functions
+	_ "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers"
+)
+
+// A column that may be returned to proxy clients
+type MaskedColumn struct {
+	Schema string
+	Table  string
+	Column string
+	// The bloblang mapping that computes the column's masked value. The column is returned as-is when empty
+	Mutation string
+}
+
+// Applies the column transformers to the rows that are returned to proxy clients
+type Masker struct {
+	tables map[string]*tableMasker // schema.table
+}
+
+type tableMasker struct {
+	columns map[string]*bloblang.Executor // every column that may be returned, nil when it is returned as-is
+}
+
+func NewMasker(columns []*MaskedColumn) (*Masker, error) {
+	tables := map[string]*tableMasker{}
+	for _, col := range columns {
+		key := neosync_benthos.BuildBenthosTable(col.Schema, col.Table)
+		tm, ok := tables[key]
+		if !ok {
+			tm = &tableMasker{columns: map[string]*bloblang.Executor{}}
+			tables[key] = tm
+		}
+		tm.columns[col.Column] = nil
+		if col.Mutation == "" {
+			continue
+		}
+		exec, err := bloblang.Parse(fmt.Sprintf("root = %s", col.Mutation))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse column transformer for %s.%s: %w", key, col.Column, err)
+		}
+		tm.columns[col.Column] = exec
+	}
+	return &Masker{tables: tables}, nil
+}
+
+// Builds a masker that applies the job mappings' transformers
+func NewMaskerFromMappings(
+	ctx context.Context,
+	transformerclient mgmtv1alpha1connect.TransformersServiceClient,
+	mappings []*mgmtv1alpha1.JobMapping,
+) (*Masker, error) {
+	columns := make([]*MaskedColumn, 0, len(mappings))
+	for _, mapping := range mappings {
+		mutation, err := genbenthosconfigs_activity.BuildColumnMutation(ctx, transformerclient, mapping)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, &MaskedColumn{
+			Schema:   mapping.GetSchema(),
+			Table:    mapping.GetTable(),
+			Column:   mapping.GetColumn(),
+			Mutation: mutation,
+		})
+	}
+	return NewMasker(columns)
+}
+
+// Returns true if the table is part of the job's mappings
+func (m *Masker) IsKnownTable(schema, table string) bool {
+	_, ok := m.tables[neosync_benthos.BuildBenthosTable(schema, table)]
+	return ok
+}
+
+// Returns true if the column is part of the job's mappings and may be returned to clients
+func (m *Masker) IsKnownColumn(schema, table, column string) bool {
+	tm, ok := m.tables[neosync_benthos.BuildBenthosTable(schema, table)]
+	if !ok {
+		return false
+	}
+	_, ok = tm.columns[column]
+	return ok
+}
+
+// Returns true if the column's values must be transformed before they are returned to clients
+func (m *Masker) IsMaskedColumn(schema, table, column string) bool {
+	return m.getExecutor(schema, table, column) != nil
+}
+
+// Transforms the masked columns of a single table's row. The values are the row's columns that belong to the table, keyed by column name.
+// The returned map contains every masked column that was provided, columns that the transformer removed are returned as nil
+func (m *Masker) Mask(schema, table string, values map[string]any) (map[string]any, error) {
+	output := map[string]any{}
+	for col := range values {
+		exec := m.getExecutor(schema, table, col)
+		if exec == nil {
+			continue
+		}
+		result, err := exec.Query(values)
+		if err != nil {
+			if errors.Is(err, bloblang.ErrRootDeleted) {
+				output[col] = nil
+				continue
+			}
+			return nil, fmt.Errorf("unable to transform %s.%s.%s: %w", schema, table, col, err)
+		}
+		output[col] = result
+	}
+	return output, nil
+}
+
+func (m *Masker) getExecutor(schema, table, column string) *bloblang.Executor {
+	tm, ok := m.tables[neosync_benthos.BuildBenthosTable(schema, table)]
+	if !ok {
+		return nil
+	}
+	return tm.columns[column]
+}
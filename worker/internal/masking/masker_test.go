@@ -0,0 +1,45 @@
+package masking
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Masker(t *testing.T) {
+	masker, err := NewMasker([]*MaskedColumn{
+		{Schema: "public", Table: "users", Column: "id"},
+		{Schema: "public", Table: "users", Column: "email", Mutation: `"masked@example.com"`},
+		{Schema: "public", Table: "users", Column: "ssn", Mutation: "deleted()"},
+		{Schema: "public", Table: "users", Column: "name", Mutation: `this.name.uppercase()`},
+	})
+	require.NoError(t, err)
+
+	require.True(t, masker.IsKnownTable("public", "users"))
+	require.False(t, masker.IsKnownTable("public", "orders"))
+	require.True(t, masker.IsKnownColumn("public", "users", "id"))
+	require.False(t, masker.IsMaskedColumn("public", "users", "id"))
+	require.True(t, masker.IsMaskedColumn("public", "users", "email"))
+	require.False(t, masker.IsKnownColumn("public", "users", "password"))
+	require.False(t, masker.IsKnownColumn("public", "orders", "id"))
+
+	masked, err := masker.Mask("public", "users", map[string]any{
+		"id":    int64(1),
+		"email": "jane@example.com",
+		"ssn":   "123-45-6789",
+		"name":  "jane",
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"email": "masked@example.com",
+		"ssn":   nil,
+		"name":  "JANE",
+	}, masked)
+}
+
+func Test_NewMasker_InvalidMutation(t *testing.T) {
+	_, err := NewMasker([]*MaskedColumn{
+		{Schema: "public", Table: "users", Column: "email", Mutation: "not a (valid mapping"},
+	})
+	require.Error(t, err)
+}
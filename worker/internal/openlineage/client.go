@@ -0,0 +1,93 @@
+// Package openlineage sends OpenLineage run events to a lineage backend such as Marquez, so that the tables a job reads and writes
+// show up in an organization's existing lineage graph
+package openlineage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	defaultEndpoint  = "api/v1/lineage"
+	defaultNamespace = "neosync"
+)
+
+type Config struct {
+	// The base url of the lineage backend, e.g. http://marquez:5000. Events are not sent if not provided
+	Url string
+	// The path events are posted to, relative to the url. Defaults to api/v1/lineage
+	Endpoint string
+	// Sent as a bearer token if provided
+	ApiKey string
+	// The namespace that jobs are reported under. Defaults to neosync
+	Namespace string
+}
+
+type Client struct {
+	config     *Config
+	httpclient *http.Client
+}
+
+func New(cfg *Config) *Client {
+	return &Client{
+		config:     cfg,
+		httpclient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Returns true if the worker has been configured with a lineage backend
+func (c *Client) IsEnabled() bool {
+	return c != nil && c.config != nil && c.config.Url != ""
+}
+
+// Returns the namespace that jobs are reported under
+func (c *Client) Namespace() string {
+	if c == nil || c.config == nil || c.config.Namespace == "" {
+		return defaultNamespace
+	}
+	return c.config.Namespace
+}
+
+// Sends the event to the lineage backend. A no-op if the client is not enabled
+func (c *Client) Emit(ctx context.Context, event *RunEvent) error {
+	if !c.IsEnabled() {
+		return nil
+	}
+	bits, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("unable to marshal openlineage event: %w", err)
+	}
+	endpoint := defaultEndpoint
+	if c.config.Endpoint != "" {
+		endpoint = c.config.Endpoint
+	}
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("%s/%s", strings.TrimRight(c.config.Url, "/"), strings.TrimLeft(endpoint, "/")),
+		bytes.NewReader(bits),
+	)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.config.ApiKey != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.config.ApiKey))
+	}
+	resp, err := c.httpclient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("openlineage backend returned %s: %s", resp.Status, strings.TrimSpace(string(respBody)))
+	}
+	return nil
+}
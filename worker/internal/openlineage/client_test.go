@@ -0,0 +1,76 @@
+package openlineage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Client_Emit(t *testing.T) {
+	var received map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/api/v1/lineage", r.URL.Path)
+		require.Equal(t, "Bearer test-key", r.Header.Get("Authorization"))
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer srv.Close()
+
+	client := New(&Config{Url: srv.URL + "/", ApiKey: "test-key"})
+	require.True(t, client.IsEnabled())
+	require.Equal(t, "neosync", client.Namespace())
+
+	event := NewRunEvent(
+		EventTypeStart,
+		time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC),
+		&Run{RunId: "0b5a1c4e-6d1f-4a8e-9d59-1f6f0f1b8a2c"},
+		&Job{Namespace: client.Namespace(), Name: "nightly-masking"},
+	)
+	event.Inputs = append(event.Inputs, &Dataset{
+		Namespace: "postgres://localhost:5432",
+		Name:      "prod.public.users",
+		Facets:    &DatasetFacets{Schema: NewSchemaDatasetFacet([]*SchemaDatasetFacetField{{Name: "id"}, {Name: "email"}})},
+	})
+	require.NoError(t, client.Emit(context.Background(), event))
+
+	require.Equal(t, "START", received["eventType"])
+	require.Equal(t, "2024-05-01T12:30:00Z", received["eventTime"])
+	require.Equal(t, Producer, received["producer"])
+	require.Equal(t, map[string]any{"namespace": "neosync", "name": "nightly-masking"}, received["job"])
+	inputs := received["inputs"].([]any)
+	require.Len(t, inputs, 1)
+	input := inputs[0].(map[string]any)
+	require.Equal(t, "prod.public.users", input["name"])
+	schema := input["facets"].(map[string]any)["schema"].(map[string]any)
+	require.Equal(t, "https://openlineage.io/spec/facets/1-1-1/SchemaDatasetFacet.json#/$defs/SchemaDatasetFacet", schema["_schemaURL"])
+	require.Equal(t, []any{map[string]any{"name": "id"}, map[string]any{"name": "email"}}, schema["fields"])
+	require.Equal(t, []any{}, received["outputs"])
+}
+
+func Test_Client_Emit_Error(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/lineage/events", r.URL.Path)
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid event"}`))
+	}))
+	defer srv.Close()
+
+	client := New(&Config{Url: srv.URL, Endpoint: "/lineage/events", Namespace: "data-platform"})
+	require.Equal(t, "data-platform", client.Namespace())
+	err := client.Emit(context.Background(), NewRunEvent(EventTypeComplete, time.Now(), &Run{RunId: "run"}, &Job{Name: "job"}))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid event")
+}
+
+func Test_Client_Disabled(t *testing.T) {
+	client := New(&Config{})
+	require.False(t, client.IsEnabled())
+	require.NoError(t, client.Emit(context.Background(), NewRunEvent(EventTypeStart, time.Now(), &Run{RunId: "run"}, &Job{Name: "job"})))
+}
@@ -0,0 +1,155 @@
+package openlineage
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	Producer = "https://github.com/nucleuscloud/neosync"
+
+	specUrl       = "https://openlineage.io/spec/2-0-2/OpenLineage.json"
+	facetsSpecUrl = "https://openlineage.io/spec/facets"
+)
+
+type EventType string
+
+const (
+	EventTypeStart    EventType = "START"
+	EventTypeComplete EventType = "COMPLETE"
+	EventTypeFail     EventType = "FAIL"
+	EventTypeAbort    EventType = "ABORT"
+)
+
+// https://openlineage.io/docs/spec/object-model#run-event
+type RunEvent struct {
+	EventType EventType  `json:"eventType"`
+	EventTime string     `json:"eventTime"`
+	Run       *Run       `json:"run"`
+	Job       *Job       `json:"job"`
+	Inputs    []*Dataset `json:"inputs"`
+	Outputs   []*Dataset `json:"outputs"`
+	Producer  string     `json:"producer"`
+	SchemaUrl string     `json:"schemaURL"`
+}
+
+func NewRunEvent(eventType EventType, eventTime time.Time, run *Run, job *Job) *RunEvent {
+	return &RunEvent{
+		EventType: eventType,
+		EventTime: eventTime.UTC().Format(time.RFC3339Nano),
+		Run:       run,
+		Job:       job,
+		Inputs:    []*Dataset{},
+		Outputs:   []*Dataset{},
+		Producer:  Producer,
+		SchemaUrl: fmt.Sprintf("%s#/$defs/RunEvent", specUrl),
+	}
+}
+
+type Run struct {
+	// Must be a UUID
+	RunId  string     `json:"runId"`
+	Facets *RunFacets `json:"facets,omitempty"`
+}
+
+type RunFacets struct {
+	ErrorMessage *ErrorMessageRunFacet `json:"errorMessage,omitempty"`
+}
+
+type Job struct {
+	Namespace string     `json:"namespace"`
+	Name      string     `json:"name"`
+	Facets    *JobFacets `json:"facets,omitempty"`
+}
+
+type JobFacets struct {
+	JobType *JobTypeJobFacet `json:"jobType,omitempty"`
+}
+
+// https://openlineage.io/docs/spec/naming
+type Dataset struct {
+	Namespace    string               `json:"namespace"`
+	Name         string               `json:"name"`
+	Facets       *DatasetFacets       `json:"facets,omitempty"`
+	OutputFacets *OutputDatasetFacets `json:"outputFacets,omitempty"`
+}
+
+type DatasetFacets struct {
+	Schema *SchemaDatasetFacet `json:"schema,omitempty"`
+}
+
+type OutputDatasetFacets struct {
+	OutputStatistics *OutputStatisticsOutputDatasetFacet `json:"outputStatistics,omitempty"`
+}
+
+// Fields that are common to every facet
+type BaseFacet struct {
+	Producer  string `json:"_producer"`
+	SchemaUrl string `json:"_schemaURL"`
+}
+
+func newBaseFacet(name, version string) BaseFacet {
+	return BaseFacet{
+		Producer:  Producer,
+		SchemaUrl: fmt.Sprintf("%s/%s/%s.json#/$defs/%s", facetsSpecUrl, version, name, name),
+	}
+}
+
+type ErrorMessageRunFacet struct {
+	BaseFacet
+	Message             string `json:"message"`
+	ProgrammingLanguage string `json:"programmingLanguage"`
+}
+
+func NewErrorMessageRunFacet(message string) *ErrorMessageRunFacet {
+	return &ErrorMessageRunFacet{
+		BaseFacet:           newBaseFacet("ErrorMessageRunFacet", "1-0-1"),
+		Message:             message,
+		ProgrammingLanguage: "go",
+	}
+}
+
+type JobTypeJobFacet struct {
+	BaseFacet
+	ProcessingType string `json:"processingType"`
+	Integration    string `json:"integration"`
+	JobType        string `json:"jobType"`
+}
+
+func NewJobTypeJobFacet() *JobTypeJobFacet {
+	return &JobTypeJobFacet{
+		BaseFacet:      newBaseFacet("JobTypeJobFacet", "2-0-3"),
+		ProcessingType: "BATCH",
+		Integration:    "NEOSYNC",
+		JobType:        "JOB",
+	}
+}
+
+type SchemaDatasetFacet struct {
+	BaseFacet
+	Fields []*SchemaDatasetFacetField `json:"fields"`
+}
+
+type SchemaDatasetFacetField struct {
+	Name string `json:"name"`
+	Type string `json:"type,omitempty"`
+}
+
+func NewSchemaDatasetFacet(fields []*SchemaDatasetFacetField) *SchemaDatasetFacet {
+	return &SchemaDatasetFacet{
+		BaseFacet: newBaseFacet("SchemaDatasetFacet", "1-1-1"),
+		Fields:    fields,
+	}
+}
+
+type OutputStatisticsOutputDatasetFacet struct {
+	BaseFacet
+	RowCount int64 `json:"rowCount"`
+}
+
+func NewOutputStatisticsOutputDatasetFacet(rowCount int64) *OutputStatisticsOutputDatasetFacet {
+	return &OutputStatisticsOutputDatasetFacet{
+		BaseFacet: newBaseFacet("OutputStatisticsOutputDatasetFacet", "1-0-2"),
+		RowCount:  rowCount,
+	}
+}
@@ -0,0 +1,219 @@
+// Package pgproxy serves a Postgres wire protocol endpoint that reads through to a source connection and applies the
+// job's column transformers to query results before they are returned, so that production data can be queried live without ever being unmasked.
+package pgproxy
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/nucleuscloud/neosync/worker/internal/masking"
+	"github.com/xdg-go/scram"
+)
+
+const (
+	scramMechanism  = "SCRAM-SHA-256"
+	scramIterations = 4096
+)
+
+var errPasswordAuthFailed = errors.New("password authentication failed")
+
+type Config struct {
+	// Clients must authenticate with this password using SCRAM-SHA-256, so that it is never sent over the wire
+	Password string
+	// Rejects queries that return computed columns, or columns that are not part of the job's mappings, as their values can not be masked
+	Strict bool
+}
+
+// Opens a new connection to the upstream database for a single client session
+type UpstreamConnector func(ctx context.Context) (*pgx.Conn, error)
+
+type Server struct {
+	connector UpstreamConnector
+	masker    *masking.Masker
+	config    *Config
+	scram     *scram.Server
+	logger    *slog.Logger
+}
+
+func New(connector UpstreamConnector, masker *masking.Masker, config *Config, logger *slog.Logger) (*Server, error) {
+	if config.Password == "" {
+		return nil, errors.New("a password must be provided for the masking proxy")
+	}
+	client, err := scram.SHA256.NewClient("", config.Password, "")
+	if err != nil {
+		return nil, fmt.Errorf("invalid masking proxy password: %w", err)
+	}
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	credentials := client.GetStoredCredentials(scram.KeyFactors{Salt: string(salt), Iters: scramIterations})
+	// postgres clients do not send a user name in the SCRAM exchange, the password is shared by every user
+	scramServer, err := scram.SHA256.NewServer(func(string) (scram.StoredCredentials, error) {
+		return credentials, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Server{
+		connector: connector,
+		masker:    masker,
+		config:    config,
+		scram:     scramServer,
+		logger:    logger,
+	}, nil
+}
+
+// Accepts client connections until the context is canceled or the listener fails
+func (s *Server) Serve(ctx context.Context, ln net.Listener) error {
+	go func() {
+		<-ctx.Done()
+		ln.Close()
+	}()
+
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		go s.handleConn(ctx, conn)
+	}
+}
+
+func (s *Server) handleConn(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+	logger := s.logger.With("remoteAddr", conn.RemoteAddr().String())
+	backend := pgproto3.NewBackend(conn, conn)
+
+	if err := s.startup(backend, conn); err != nil {
+		logger.Warn(fmt.Sprintf("unable to start proxy session: %s", err.Error()))
+		code := "28000"
+		if errors.Is(err, errPasswordAuthFailed) {
+			code = "28P01"
+		}
+		sendFatal(backend, code, err.Error())
+		return
+	}
+
+	upstream, err := s.connector(ctx)
+	if err != nil {
+		logger.Error(fmt.Sprintf("unable to connect to upstream database: %s", err.Error()))
+		sendFatal(backend, "08006", "unable to connect to upstream database")
+		return
+	}
+	defer upstream.Close(context.Background())
+
+	sess, err := newSession(ctx, backend, upstream, s.masker, s.config.Strict, logger)
+	if err != nil {
+		logger.Error(fmt.Sprintf("unable to prepare upstream connection: %s", err.Error()))
+		sendFatal(backend, "08006", "unable to prepare upstream connection")
+		return
+	}
+
+	backend.Send(&pgproto3.AuthenticationOk{})
+	for name, value := range map[string]string{
+		"server_version":              upstream.PgConn().ParameterStatus("server_version"),
+		"server_encoding":             "UTF8",
+		"client_encoding":             "UTF8",
+		"DateStyle":                   "ISO, MDY",
+		"integer_datetimes":           "on",
+		"standard_conforming_strings": "on",
+	} {
+		backend.Send(&pgproto3.ParameterStatus{Name: name, Value: value})
+	}
+	backend.Send(&pgproto3.ReadyForQuery{TxStatus: 'I'})
+	if err := backend.Flush(); err != nil {
+		return
+	}
+
+	if err := sess.run(ctx); err != nil {
+		logger.Warn(fmt.Sprintf("proxy session ended with error: %s", err.Error()))
+	}
+}
+
+// Handles the startup handshake and authenticates the client
+func (s *Server) startup(backend *pgproto3.Backend, conn net.Conn) error {
+	for {
+		msg, err := backend.ReceiveStartupMessage()
+		if err != nil {
+			return err
+		}
+		switch msg.(type) {
+		case *pgproto3.SSLRequest, *pgproto3.GSSEncRequest:
+			// encryption is not supported, the client may continue in plaintext
+			if _, err := conn.Write([]byte{'N'}); err != nil {
+				return err
+			}
+		case *pgproto3.StartupMessage:
+			return s.authenticate(backend)
+		case *pgproto3.CancelRequest:
+			return errors.New("cancel requests are not supported")
+		default:
+			return fmt.Errorf("unexpected startup message: %T", msg)
+		}
+	}
+}
+
+// Authenticates the client with SCRAM-SHA-256
+func (s *Server) authenticate(backend *pgproto3.Backend) error {
+	backend.Send(&pgproto3.AuthenticationSASL{AuthMechanisms: []string{scramMechanism}})
+	if err := backend.Flush(); err != nil {
+		return err
+	}
+	if err := backend.SetAuthType(pgproto3.AuthTypeSASL); err != nil {
+		return err
+	}
+	msg, err := backend.Receive()
+	if err != nil {
+		return err
+	}
+	initial, ok := msg.(*pgproto3.SASLInitialResponse)
+	if !ok {
+		return fmt.Errorf("expected SASL initial response, received: %T", msg)
+	}
+	if initial.AuthMechanism != scramMechanism {
+		return fmt.Errorf("unsupported SASL mechanism: %s", initial.AuthMechanism)
+	}
+
+	conv := s.scram.NewConversation()
+	serverFirst, err := conv.Step(string(initial.Data))
+	if err != nil {
+		return errPasswordAuthFailed
+	}
+	backend.Send(&pgproto3.AuthenticationSASLContinue{Data: []byte(serverFirst)})
+	if err := backend.Flush(); err != nil {
+		return err
+	}
+	if err := backend.SetAuthType(pgproto3.AuthTypeSASLContinue); err != nil {
+		return err
+	}
+	msg, err = backend.Receive()
+	if err != nil {
+		return err
+	}
+	response, ok := msg.(*pgproto3.SASLResponse)
+	if !ok {
+		return fmt.Errorf("expected SASL response, received: %T", msg)
+	}
+	serverFinal, err := conv.Step(string(response.Data))
+	if err != nil || !conv.Valid() {
+		return errPasswordAuthFailed
+	}
+	backend.Send(&pgproto3.AuthenticationSASLFinal{Data: []byte(serverFinal)})
+	return nil
+}
+
+func sendFatal(backend *pgproto3.Backend, code, message string) {
+	backend.Send(&pgproto3.ErrorResponse{Severity: "FATAL", Code: code, Message: message})
+	_ = backend.Flush()
+}
@@ -0,0 +1,50 @@
+package pgproxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_New_RequiresPassword(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	_, err := New(nil, nil, &Config{}, logger)
+	require.Error(t, err)
+}
+
+func Test_Server_Authenticate(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	connector := func(ctx context.Context) (*pgx.Conn, error) {
+		return nil, errors.New("no upstream")
+	}
+	server, err := New(connector, nil, &Config{Password: "secret"}, logger)
+	require.NoError(t, err)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = server.Serve(ctx, ln)
+	}()
+
+	connect := func(password string) *pgconn.PgError {
+		_, err := pgconn.Connect(ctx, fmt.Sprintf("postgres://neosync:%s@%s/neosync?sslmode=disable", password, ln.Addr().String()))
+		require.Error(t, err)
+		var pgErr *pgconn.PgError
+		require.True(t, errors.As(err, &pgErr), err.Error())
+		return pgErr
+	}
+
+	require.Equal(t, "28P01", connect("wrong").Code)
+	// authentication succeeded, the session then fails as there is no upstream database
+	require.Equal(t, "08006", connect("secret").Code)
+}
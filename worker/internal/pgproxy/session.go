@@ -0,0 +1,429 @@
+package pgproxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgproto3"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/internal/benthos"
+	"github.com/nucleuscloud/neosync/worker/internal/masking"
+	pg_query "github.com/pganalyze/pg_query_go/v5"
+	pgquery "github.com/wasilibs/go-pgquery"
+)
+
+const (
+	// resolves the table and column name of every result field
+	catalogQuery = `SELECT c.oid, a.attnum, n.nspname, c.relname, a.attname
+FROM pg_catalog.pg_attribute a
+JOIN pg_catalog.pg_class c ON c.oid = a.attrelid
+JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+WHERE a.attnum > 0 AND NOT a.attisdropped AND c.relkind IN ('r', 'p', 'v', 'm', 'f');`
+
+	// flush buffered rows to the client after this many rows have been sent
+	flushInterval = 500
+)
+
+var errClientWrite = errors.New("unable to write to proxy client")
+
+type columnKey struct {
+	tableOid uint32
+	attnum   uint16
+}
+
+type columnRef struct {
+	schema string
+	table  string
+	column string
+}
+
+// Returns true if the column belongs to one of postgres' own catalogs, which never hold user data
+func (c *columnRef) isSystem() bool {
+	return c.schema == "pg_catalog" || c.schema == "information_schema" || strings.HasPrefix(c.schema, "pg_toast")
+}
+
+// A single client connection and its dedicated upstream connection
+type session struct {
+	backend  *pgproto3.Backend
+	upstream *pgx.Conn
+	masker   *masking.Masker
+	strict   bool
+	columns  map[columnKey]*columnRef
+	logger   *slog.Logger
+}
+
+func newSession(
+	ctx context.Context,
+	backend *pgproto3.Backend,
+	upstream *pgx.Conn,
+	masker *masking.Masker,
+	strict bool,
+	logger *slog.Logger,
+) (*session, error) {
+	// the proxy only ever serves reads
+	if _, err := upstream.Exec(ctx, "SET SESSION CHARACTERISTICS AS TRANSACTION READ ONLY;"); err != nil {
+		return nil, err
+	}
+
+	rows, err := upstream.Query(ctx, catalogQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns := map[columnKey]*columnRef{}
+	for rows.Next() {
+		var tableOid uint32
+		var attnum int16
+		ref := &columnRef{}
+		if err := rows.Scan(&tableOid, &attnum, &ref.schema, &ref.table, &ref.column); err != nil {
+			return nil, err
+		}
+		columns[columnKey{tableOid: tableOid, attnum: uint16(attnum)}] = ref
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &session{
+		backend:  backend,
+		upstream: upstream,
+		masker:   masker,
+		strict:   strict,
+		columns:  columns,
+		logger:   logger,
+	}, nil
+}
+
+// Serves client messages until the client terminates the session or the connection fails
+func (s *session) run(ctx context.Context) error {
+	ignoreTillSync := false
+	for {
+		msg, err := s.backend.Receive()
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil
+			}
+			return err
+		}
+
+		switch msg := msg.(type) {
+		case *pgproto3.Query:
+			if err := s.query(ctx, msg.String); err != nil {
+				return err
+			}
+		case *pgproto3.Terminate:
+			return nil
+		case *pgproto3.Sync:
+			ignoreTillSync = false
+			if err := s.readyForQuery(); err != nil {
+				return err
+			}
+		default:
+			// the extended query protocol is rejected, as parameter and result formats can not be masked reliably.
+			// per the protocol, every message up until the next sync is discarded after an error
+			if !ignoreTillSync {
+				ignoreTillSync = true
+				s.backend.Send(&pgproto3.ErrorResponse{
+					Severity: "ERROR",
+					Code:     "0A000",
+					Message:  "the masking proxy only supports the simple query protocol",
+				})
+				if err := s.backend.Flush(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// Runs the query against the upstream database and forwards the masked results to the client.
+// Only errors that are caused by the client connection are returned, all others are sent to the client
+func (s *session) query(ctx context.Context, sql string) error {
+	if err := checkReadOnly(sql); err != nil {
+		s.sendError(&pgconn.PgError{Severity: "ERROR", Code: "25006", Message: err.Error()})
+		return s.readyForQuery()
+	}
+
+	// the transaction is enforced by the upstream server, so a query can not make the session writable
+	if _, err := s.upstream.Exec(ctx, "BEGIN READ ONLY;"); err != nil {
+		return err
+	}
+	if err := s.forwardQuery(ctx, sql); err != nil {
+		return err
+	}
+	if _, err := s.upstream.Exec(ctx, "ROLLBACK;"); err != nil {
+		return err
+	}
+	return s.readyForQuery()
+}
+
+// Only errors that are caused by the client connection are returned, all others are sent to the client
+func (s *session) forwardQuery(ctx context.Context, sql string) error {
+	mrr := s.upstream.PgConn().Exec(ctx, sql)
+	hasResult := false
+	for mrr.NextResult() {
+		hasResult = true
+		if err := s.forwardResult(mrr.ResultReader()); err != nil {
+			_ = mrr.Close()
+			if errors.Is(err, errClientWrite) {
+				return err
+			}
+			s.sendError(err)
+			return nil
+		}
+	}
+	if err := mrr.Close(); err != nil {
+		s.sendError(err)
+	} else if !hasResult {
+		s.backend.Send(&pgproto3.EmptyQueryResponse{})
+	}
+	return nil
+}
+
+// Returns an error unless every statement only reads.
+// Transaction control and SET statements are rejected as they could end the proxy's read only transaction or change the session
+func checkReadOnly(sql string) error {
+	tree, err := pgquery.Parse(sql)
+	if err != nil {
+		return fmt.Errorf("unable to parse query: %w", err)
+	}
+	for _, stmt := range tree.GetStmts() {
+		if !isReadOnlyStatement(stmt.GetStmt()) {
+			return fmt.Errorf("the masking proxy only serves SELECT, SHOW and EXPLAIN statements, received: %s", statementName(stmt.GetStmt()))
+		}
+	}
+	return nil
+}
+
+func isReadOnlyStatement(node *pg_query.Node) bool {
+	switch stmt := node.GetNode().(type) {
+	case *pg_query.Node_SelectStmt:
+		return stmt.SelectStmt.GetIntoClause() == nil
+	case *pg_query.Node_VariableShowStmt:
+		return true
+	case *pg_query.Node_ExplainStmt:
+		// EXPLAIN ANALYZE runs the statement that it explains
+		return isReadOnlyStatement(stmt.ExplainStmt.GetQuery())
+	default:
+		return false
+	}
+}
+
+func statementName(node *pg_query.Node) string {
+	if node == nil || node.GetNode() == nil {
+		return "empty statement"
+	}
+	return strings.TrimPrefix(fmt.Sprintf("%T", node.GetNode()), "*pg_query.Node_")
+}
+
+func (s *session) forwardResult(rr *pgconn.ResultReader) error {
+	fields := rr.FieldDescriptions()
+	refs, err := s.resolveFields(fields)
+	if err != nil {
+		_, _ = rr.Close()
+		return err
+	}
+
+	if len(fields) > 0 {
+		s.backend.Send(&pgproto3.RowDescription{Fields: toProtoFields(fields)})
+	}
+
+	rowCount := 0
+	for rr.NextRow() {
+		values, err := s.maskRow(fields, refs, rr.Values())
+		if err != nil {
+			_, _ = rr.Close()
+			return err
+		}
+		s.backend.Send(&pgproto3.DataRow{Values: values})
+		rowCount++
+		if rowCount%flushInterval == 0 {
+			if err := s.backend.Flush(); err != nil {
+				_, _ = rr.Close()
+				return fmt.Errorf("%w: %w", errClientWrite, err)
+			}
+		}
+	}
+
+	tag, err := rr.Close()
+	if err != nil {
+		return err
+	}
+	s.backend.Send(&pgproto3.CommandComplete{CommandTag: []byte(tag.String())})
+	return nil
+}
+
+// Resolves the table column of every result field, nil if the field is not a plain table column.
+// In strict mode, results with fields that can not be masked are rejected
+func (s *session) resolveFields(fields []pgconn.FieldDescription) ([]*columnRef, error) {
+	refs := make([]*columnRef, len(fields))
+	for idx := range fields {
+		field := fields[idx]
+		var ref *columnRef
+		if field.TableOID != 0 {
+			ref = s.columns[columnKey{tableOid: field.TableOID, attnum: field.TableAttributeNumber}]
+		}
+		refs[idx] = ref
+		if !s.strict {
+			continue
+		}
+		if ref == nil {
+			return nil, fmt.Errorf("result column %q is not a table column and can not be masked", field.Name)
+		}
+		if !ref.isSystem() && !s.masker.IsKnownColumn(ref.schema, ref.table, ref.column) {
+			return nil, fmt.Errorf("column %s.%s is not part of the job's mappings", neosync_benthos.BuildBenthosTable(ref.schema, ref.table), ref.column)
+		}
+	}
+	return refs, nil
+}
+
+// Returns the row's values with every masked column replaced by its transformed value
+func (s *session) maskRow(fields []pgconn.FieldDescription, refs []*columnRef, values [][]byte) ([][]byte, error) {
+	type tableRow struct {
+		schema  string
+		table   string
+		values  map[string]any
+		indices map[string][]int
+	}
+	tableRows := map[string]*tableRow{}
+	for idx, ref := range refs {
+		if ref == nil || !s.masker.IsMaskedColumn(ref.schema, ref.table, ref.column) {
+			continue
+		}
+		key := neosync_benthos.BuildBenthosTable(ref.schema, ref.table)
+		tr, ok := tableRows[key]
+		if !ok {
+			tr = &tableRow{schema: ref.schema, table: ref.table, values: map[string]any{}, indices: map[string][]int{}}
+			tableRows[key] = tr
+		}
+		tr.values[ref.column] = s.decodeValue(fields[idx], values[idx])
+		tr.indices[ref.column] = append(tr.indices[ref.column], idx)
+	}
+	if len(tableRows) == 0 {
+		return values, nil
+	}
+
+	output := make([][]byte, len(values))
+	copy(output, values)
+	for _, tr := range tableRows {
+		masked, err := s.masker.Mask(tr.schema, tr.table, tr.values)
+		if err != nil {
+			return nil, err
+		}
+		for col, indices := range tr.indices {
+			for _, idx := range indices {
+				encoded, err := s.encodeValue(fields[idx], masked[col])
+				if err != nil {
+					return nil, fmt.Errorf("unable to encode masked value for %s.%s.%s: %w", tr.schema, tr.table, col, err)
+				}
+				output[idx] = encoded
+			}
+		}
+	}
+	return output, nil
+}
+
+// Decodes a wire value into a value that the transformers understand, falling back to its text representation
+func (s *session) decodeValue(field pgconn.FieldDescription, src []byte) any {
+	if src == nil {
+		return nil
+	}
+	dt, ok := s.upstream.TypeMap().TypeForOID(field.DataTypeOID)
+	if !ok {
+		return string(src)
+	}
+	value, err := dt.Codec.DecodeValue(s.upstream.TypeMap(), field.DataTypeOID, field.Format, src)
+	if err != nil {
+		return string(src)
+	}
+	switch v := value.(type) {
+	case nil, string, bool, int64, float64, time.Time, []byte:
+		return v
+	case int16:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case float32:
+		return float64(v)
+	default:
+		if field.Format == pgx.TextFormatCode {
+			return string(src)
+		}
+		return v
+	}
+}
+
+func (s *session) encodeValue(field pgconn.FieldDescription, value any) ([]byte, error) {
+	if value == nil {
+		return nil, nil
+	}
+	encoded, err := s.upstream.TypeMap().Encode(field.DataTypeOID, field.Format, value, nil)
+	if err == nil && encoded != nil {
+		return encoded, nil
+	}
+	if field.Format != pgx.TextFormatCode {
+		if err == nil {
+			err = errors.New("value encoded as null")
+		}
+		return nil, err
+	}
+	return []byte(fmt.Sprint(value)), nil
+}
+
+func (s *session) sendError(err error) {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		s.backend.Send(&pgproto3.ErrorResponse{
+			Severity:         pgErr.Severity,
+			Code:             pgErr.Code,
+			Message:          pgErr.Message,
+			Detail:           pgErr.Detail,
+			Hint:             pgErr.Hint,
+			Position:         pgErr.Position,
+			InternalPosition: pgErr.InternalPosition,
+			InternalQuery:    pgErr.InternalQuery,
+			Where:            pgErr.Where,
+			SchemaName:       pgErr.SchemaName,
+			TableName:        pgErr.TableName,
+			ColumnName:       pgErr.ColumnName,
+			DataTypeName:     pgErr.DataTypeName,
+			ConstraintName:   pgErr.ConstraintName,
+			File:             pgErr.File,
+			Line:             pgErr.Line,
+			Routine:          pgErr.Routine,
+		})
+		return
+	}
+	s.logger.Warn(fmt.Sprintf("unable to serve proxy query: %s", err.Error()))
+	s.backend.Send(&pgproto3.ErrorResponse{Severity: "ERROR", Code: "XX000", Message: err.Error()})
+}
+
+func (s *session) readyForQuery() error {
+	s.backend.Send(&pgproto3.ReadyForQuery{TxStatus: s.upstream.PgConn().TxStatus()})
+	if err := s.backend.Flush(); err != nil {
+		return fmt.Errorf("%w: %w", errClientWrite, err)
+	}
+	return nil
+}
+
+func toProtoFields(fields []pgconn.FieldDescription) []pgproto3.FieldDescription {
+	output := make([]pgproto3.FieldDescription, 0, len(fields))
+	for _, field := range fields {
+		output = append(output, pgproto3.FieldDescription{
+			Name:                 []byte(field.Name),
+			TableOID:             field.TableOID,
+			TableAttributeNumber: field.TableAttributeNumber,
+			DataTypeOID:          field.DataTypeOID,
+			DataTypeSize:         field.DataTypeSize,
+			TypeModifier:         field.TypeModifier,
+			Format:               field.Format,
+		})
+	}
+	return output
+}
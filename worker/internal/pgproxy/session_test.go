@@ -0,0 +1,38 @@
+package pgproxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_checkReadOnly(t *testing.T) {
+	allowed := []string{
+		"SELECT * FROM public.users",
+		"select id from users; select id from orders;",
+		"SHOW search_path",
+		"EXPLAIN ANALYZE SELECT * FROM users",
+		"WITH u AS (SELECT * FROM users) SELECT * FROM u",
+	}
+	for _, sql := range allowed {
+		require.NoError(t, checkReadOnly(sql), sql)
+	}
+
+	rejected := []string{
+		"SET default_transaction_read_only = off",
+		"SET SESSION CHARACTERISTICS AS TRANSACTION READ WRITE",
+		"BEGIN READ WRITE",
+		"START TRANSACTION",
+		"SELECT 1; COMMIT; INSERT INTO users (id) VALUES (1)",
+		"ROLLBACK",
+		"SELECT * INTO copied FROM users",
+		"EXPLAIN ANALYZE DELETE FROM users",
+		"DELETE FROM users",
+		"DO $$ BEGIN PERFORM 1; END $$",
+		"RESET ALL",
+		"not sql",
+	}
+	for _, sql := range rejected {
+		require.Error(t, checkReadOnly(sql), sql)
+	}
+}
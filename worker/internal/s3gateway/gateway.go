@@ -0,0 +1,315 @@
+// Package s3gateway serves an HTTP endpoint that reads JSONL and CSV objects from a bucket and applies the job's column
+// transformers to every record before it is returned, so that data lake consumers can read masked files without a batch job.
+package s3gateway
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/subtle"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/nucleuscloud/neosync/worker/internal/masking"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+)
+
+// The subset of the S3 client that the gateway requires
+type ObjectGetter interface {
+	GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error)
+}
+
+type Config struct {
+	Bucket string
+	// Prepended to every requested object key, objects outside of it can not be read
+	PathPrefix string
+	// Clients must provide this value as a bearer token
+	Token string
+	// Removes fields that are not part of the job's mappings from every record, as their values can not be masked
+	Strict bool
+}
+
+type objectFormat int
+
+const (
+	formatJsonl objectFormat = iota
+	formatCsv
+)
+
+type Gateway struct {
+	client ObjectGetter
+	masker *masking.Masker
+	config *Config
+	logger *slog.Logger
+}
+
+func New(client ObjectGetter, masker *masking.Masker, config *Config, logger *slog.Logger) (*Gateway, error) {
+	if config.Token == "" {
+		return nil, errors.New("a token must be provided for the s3 gateway")
+	}
+	return &Gateway{
+		client: client,
+		masker: masker,
+		config: config,
+		logger: logger,
+	}, nil
+}
+
+// Serves masked objects. The object key is the request path, which must follow the layout of neosync's S3 destination
+// so that the table that the object holds is known. The optional table query parameter (schema.table) must match it
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !g.isAuthorized(r) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	key := strings.TrimPrefix(r.URL.Path, "/")
+	if key == "" {
+		http.Error(w, "must provide an object key", http.StatusBadRequest)
+		return
+	}
+	objectKey, err := getObjectKey(g.config.PathPrefix, key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	schema, table, err := getObjectTable(key, r.URL.Query().Get("table"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !g.masker.IsKnownTable(schema, table) {
+		http.Error(w, fmt.Sprintf("table %s.%s is not part of the job's mappings", schema, table), http.StatusForbidden)
+		return
+	}
+	isGzip := strings.HasSuffix(key, ".gz")
+	format, ok := getObjectFormat(strings.TrimSuffix(key, ".gz"))
+	if !ok {
+		http.Error(w, "only jsonl and csv objects are supported", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	output, err := g.client.GetObject(r.Context(), &s3.GetObjectInput{
+		Bucket: aws.String(g.config.Bucket),
+		Key:    aws.String(objectKey),
+	})
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			http.Error(w, "object not found", http.StatusNotFound)
+			return
+		}
+		g.logger.Error(fmt.Sprintf("unable to get object %s: %s", key, err.Error()))
+		http.Error(w, "unable to get object", http.StatusBadGateway)
+		return
+	}
+	defer output.Body.Close()
+
+	var reader io.Reader = output.Body
+	var writer io.Writer = w
+	if isGzip {
+		gzipReader, err := gzip.NewReader(output.Body)
+		if err != nil {
+			http.Error(w, "unable to read gzip object", http.StatusBadGateway)
+			return
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+		gzipWriter := gzip.NewWriter(w)
+		defer gzipWriter.Close()
+		writer = gzipWriter
+		w.Header().Set("Content-Type", "application/gzip")
+	} else if format == formatCsv {
+		w.Header().Set("Content-Type", "text/csv")
+	} else {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+	}
+
+	switch format {
+	case formatCsv:
+		err = g.maskCsv(reader, writer, schema, table)
+	default:
+		err = g.maskJsonl(reader, writer, schema, table)
+	}
+	if err != nil {
+		// the response has already started, so the client is only able to tell from the truncated body
+		g.logger.Error(fmt.Sprintf("unable to mask object %s: %s", key, err.Error()))
+		panic(http.ErrAbortHandler)
+	}
+}
+
+func (g *Gateway) isAuthorized(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return ok && subtle.ConstantTimeCompare([]byte(token), []byte(g.config.Token)) == 1
+}
+
+func (g *Gateway) maskJsonl(reader io.Reader, writer io.Writer, schema, table string) error {
+	decoder := json.NewDecoder(reader)
+	decoder.UseNumber()
+	encoder := json.NewEncoder(writer)
+	for {
+		var record map[string]any
+		if err := decoder.Decode(&record); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		masked, err := g.maskRecord(schema, table, record)
+		if err != nil {
+			return err
+		}
+		if err := encoder.Encode(masked); err != nil {
+			return err
+		}
+	}
+}
+
+func (g *Gateway) maskCsv(reader io.Reader, writer io.Writer, schema, table string) error {
+	csvReader := csv.NewReader(reader)
+	csvWriter := csv.NewWriter(writer)
+
+	header, err := csvReader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return err
+	}
+	columns := []string{}
+	for _, col := range header {
+		if g.config.Strict && !g.masker.IsKnownColumn(schema, table, col) {
+			continue
+		}
+		columns = append(columns, col)
+	}
+	if err := csvWriter.Write(columns); err != nil {
+		return err
+	}
+
+	for {
+		row, err := csvReader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+		record := make(map[string]any, len(header))
+		for idx, col := range header {
+			if idx < len(row) {
+				record[col] = row[idx]
+			}
+		}
+		masked, err := g.maskRecord(schema, table, record)
+		if err != nil {
+			return err
+		}
+		values := make([]string, 0, len(columns))
+		for _, col := range columns {
+			values = append(values, toCsvValue(masked[col]))
+		}
+		if err := csvWriter.Write(values); err != nil {
+			return err
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// Returns the record with every masked field replaced by its transformed value
+func (g *Gateway) maskRecord(schema, table string, record map[string]any) (map[string]any, error) {
+	masked, err := g.masker.Mask(schema, table, record)
+	if err != nil {
+		return nil, err
+	}
+	for col, value := range masked {
+		record[col] = value
+	}
+	if g.config.Strict {
+		for col := range record {
+			if !g.masker.IsKnownColumn(schema, table, col) {
+				delete(record, col)
+			}
+		}
+	}
+	return record, nil
+}
+
+func toCsvValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case map[string]any, []any:
+		bits, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(bits)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Returns the bucket key of the requested object, which may not leave the path prefix
+func getObjectKey(pathPrefix, key string) (string, error) {
+	if strings.Contains(key, "..") {
+		return "", errors.New("object key may not contain ..")
+	}
+	objectKey := path.Join(pathPrefix, key)
+	if pathPrefix != "" && !strings.HasPrefix(objectKey, strings.TrimSuffix(path.Clean(pathPrefix), "/")+"/") {
+		return "", errors.New("object key is outside of the path prefix")
+	}
+	return objectKey, nil
+}
+
+// Resolves the object's table from the key layout used by neosync's S3 destination:
+// workflows/<run id>/activities/<schema.table>/data/<file>.
+// The table is never taken from the table parameter alone, as it decides which transformers are applied
+func getObjectTable(key, tableParam string) (schema, table string, err error) {
+	segments := strings.Split(key, "/")
+	if len(segments) < 4 || segments[len(segments)-4] != "activities" || segments[len(segments)-2] != "data" {
+		return "", "", errors.New("object key must follow the layout workflows/<run id>/activities/<schema.table>/data/<file>")
+	}
+	tableKey := segments[len(segments)-3]
+	if tableParam != "" && tableParam != tableKey {
+		return "", "", fmt.Errorf("table parameter %s does not match the object's table %s", tableParam, tableKey)
+	}
+	if !strings.Contains(tableKey, ".") {
+		return "", "", fmt.Errorf("object key must contain a schema qualified table, received: %s", tableKey)
+	}
+	schema, table = shared.SplitTableKey(tableKey)
+	if schema == "" || table == "" {
+		return "", "", fmt.Errorf("object key must contain a schema qualified table, received: %s", tableKey)
+	}
+	return schema, table, nil
+}
+
+func getObjectFormat(key string) (objectFormat, bool) {
+	switch strings.ToLower(path.Ext(key)) {
+	case ".json", ".jsonl", ".ndjson":
+		return formatJsonl, true
+	case ".csv":
+		return formatCsv, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,146 @@
+package s3gateway
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/nucleuscloud/neosync/worker/internal/masking"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryBucket struct {
+	objects map[string][]byte
+}
+
+func (m *memoryBucket) GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error) {
+	obj, ok := m.objects[*params.Bucket+"/"+*params.Key]
+	if !ok {
+		return nil, &types.NoSuchKey{}
+	}
+	return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(obj))}, nil
+}
+
+func newTestGateway(t *testing.T, objects map[string][]byte, config *Config) *Gateway {
+	t.Helper()
+	masker, err := masking.NewMasker([]*masking.MaskedColumn{
+		{Schema: "public", Table: "users", Column: "id"},
+		{Schema: "public", Table: "users", Column: "email", Mutation: `"masked@example.com"`},
+	})
+	require.NoError(t, err)
+	config.Bucket = "bucket"
+	config.Token = "secret"
+	gateway, err := New(&memoryBucket{objects: objects}, masker, config, slog.Default())
+	require.NoError(t, err)
+	return gateway
+}
+
+func get(gateway *Gateway, target string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, target, http.NoBody)
+	req.Header.Set("Authorization", "Bearer secret")
+	rec := httptest.NewRecorder()
+	gateway.ServeHTTP(rec, req)
+	return rec
+}
+
+func Test_New_RequiresToken(t *testing.T) {
+	_, err := New(&memoryBucket{}, nil, &Config{Bucket: "bucket"}, slog.Default())
+	require.Error(t, err)
+}
+
+func Test_Gateway_Jsonl(t *testing.T) {
+	gateway := newTestGateway(t, map[string][]byte{
+		"bucket/exports/workflows/run-id/activities/public.users/data/1.jsonl": []byte("{\"id\":1,\"email\":\"jane@example.com\",\"ssn\":\"123\"}\n"),
+	}, &Config{PathPrefix: "exports", Strict: true})
+
+	rec := get(gateway, "/workflows/run-id/activities/public.users/data/1.jsonl?table=public.users")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "{\"email\":\"masked@example.com\",\"id\":1}\n", rec.Body.String())
+}
+
+func Test_Gateway_GzipJobOutput(t *testing.T) {
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	_, err := gzipWriter.Write([]byte("{\"id\":1,\"email\":\"jane@example.com\"}\n{\"id\":2,\"email\":null}\n"))
+	require.NoError(t, err)
+	require.NoError(t, gzipWriter.Close())
+
+	gateway := newTestGateway(t, map[string][]byte{
+		"bucket/workflows/run-id/activities/public.users/data/1.json.gz": buf.Bytes(),
+	}, &Config{})
+
+	rec := get(gateway, "/workflows/run-id/activities/public.users/data/1.json.gz")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	gzipReader, err := gzip.NewReader(rec.Body)
+	require.NoError(t, err)
+	body, err := io.ReadAll(gzipReader)
+	require.NoError(t, err)
+	require.Equal(t, "{\"email\":\"masked@example.com\",\"id\":1}\n{\"email\":\"masked@example.com\",\"id\":2}\n", string(body))
+}
+
+func Test_Gateway_Csv(t *testing.T) {
+	gateway := newTestGateway(t, map[string][]byte{
+		"bucket/workflows/run-id/activities/public.users/data/1.csv": []byte("id,email,ssn\n1,jane@example.com,123\n"),
+	}, &Config{Strict: true})
+
+	rec := get(gateway, "/workflows/run-id/activities/public.users/data/1.csv")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "id,email\n1,masked@example.com\n", rec.Body.String())
+}
+
+func Test_Gateway_Errors(t *testing.T) {
+	gateway := newTestGateway(t, map[string][]byte{
+		"bucket/exports/workflows/run-id/activities/public.orders/data/1.csv": []byte("id,total\n1,10\n"),
+	}, &Config{PathPrefix: "exports"})
+
+	rec := httptest.NewRecorder()
+	gateway.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/workflows/run-id/activities/public.users/data/1.csv", http.NoBody))
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	require.Equal(t, http.StatusNotFound, get(gateway, "/workflows/run-id/activities/public.users/data/1.csv").Code)
+	require.Equal(t, http.StatusBadRequest, get(gateway, "/users.csv?table=public.users").Code)
+	require.Equal(t, http.StatusForbidden, get(gateway, "/workflows/run-id/activities/public.orders/data/1.csv").Code)
+	require.Equal(t, http.StatusUnsupportedMediaType, get(gateway, "/workflows/run-id/activities/public.users/data/1.parquet").Code)
+}
+
+func Test_Gateway_TableParamMismatch(t *testing.T) {
+	gateway := newTestGateway(t, map[string][]byte{
+		"bucket/workflows/run-id/activities/public.orders/data/1.csv": []byte("id,total\n1,10\n"),
+	}, &Config{})
+
+	// the orders table has no mappings, naming a masked table may not unlock it
+	rec := get(gateway, "/workflows/run-id/activities/public.orders/data/1.csv?table=public.users")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func Test_Gateway_PathTraversal(t *testing.T) {
+	gateway := newTestGateway(t, map[string][]byte{
+		"bucket/secrets/workflows/run-id/activities/public.users/data/1.csv": []byte("id,email\n1,jane@example.com\n"),
+	}, &Config{PathPrefix: "exports"})
+
+	rec := get(gateway, "/../secrets/workflows/run-id/activities/public.users/data/1.csv")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func Test_getObjectKey(t *testing.T) {
+	key, err := getObjectKey("exports/", "workflows/1.csv")
+	require.NoError(t, err)
+	require.Equal(t, "exports/workflows/1.csv", key)
+
+	key, err = getObjectKey("", "workflows/1.csv")
+	require.NoError(t, err)
+	require.Equal(t, "workflows/1.csv", key)
+
+	_, err = getObjectKey("exports", "../secrets/1.csv")
+	require.Error(t, err)
+	_, err = getObjectKey("exports", "workflows/..%2f../1.csv")
+	require.Error(t, err)
+}
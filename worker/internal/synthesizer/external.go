@@ -0,0 +1,94 @@
+package synthesizer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Client for an external synthesizer service (e.g. one hosting CTGAN models).
+//
+// The service must implement:
+//
+//	POST {url}/fit    {"columns": [...], "data_types": [...], "rows": [[...]]} -> {"model_id": "..."}
+//	POST {url}/sample {"model_id": "...", "count": n}                          -> {"data": [{...}]}
+type ExternalClient struct {
+	url        string
+	httpclient *http.Client
+}
+
+func NewExternalClient(url string, httpclient *http.Client) *ExternalClient {
+	return &ExternalClient{url: strings.TrimSuffix(url, "/"), httpclient: httpclient}
+}
+
+type fitRequest struct {
+	Columns   []string    `json:"columns"`
+	DataTypes []string    `json:"data_types"`
+	Rows      [][]*string `json:"rows"`
+}
+
+type fitResponse struct {
+	ModelId string `json:"model_id"`
+}
+
+type sampleRequest struct {
+	ModelId string `json:"model_id"`
+	Count   int    `json:"count"`
+}
+
+type sampleResponse struct {
+	Data []map[string]any `json:"data"`
+}
+
+// Fits a model on the sampled rows and returns the id of the model that was trained by the service
+func (c *ExternalClient) Fit(ctx context.Context, specs []*ColumnSpec, rows [][]*string) (string, error) {
+	req := &fitRequest{Rows: rows}
+	for _, spec := range specs {
+		req.Columns = append(req.Columns, spec.Name)
+		req.DataTypes = append(req.DataTypes, spec.DataType)
+	}
+	var resp fitResponse
+	if err := c.post(ctx, "/fit", req, &resp); err != nil {
+		return "", err
+	}
+	if resp.ModelId == "" {
+		return "", errors.New("synthesizer service did not return a model id")
+	}
+	return resp.ModelId, nil
+}
+
+// Samples rows from a model that was previously fit by the service
+func (c *ExternalClient) Sample(ctx context.Context, modelId string, count int) ([]map[string]any, error) {
+	var resp sampleResponse
+	if err := c.post(ctx, "/sample", &sampleRequest{ModelId: modelId, Count: count}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+func (c *ExternalClient) post(ctx context.Context, path string, body, out any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url+path, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.httpclient.Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to reach synthesizer service: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("synthesizer service returned status %d: %s", resp.StatusCode, string(respBody))
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,432 @@
+package synthesizer
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ColumnKind string
+
+const (
+	ColumnKindNumeric     ColumnKind = "numeric"
+	ColumnKindInteger     ColumnKind = "integer"
+	ColumnKindTemporal    ColumnKind = "temporal"
+	ColumnKindCategorical ColumnKind = "categorical"
+	ColumnKindText        ColumnKind = "text"
+	ColumnKindUuid        ColumnKind = "uuid"
+	ColumnKindSequence    ColumnKind = "sequence"
+	ColumnKindReference   ColumnKind = "reference"
+)
+
+const (
+	// categories seen fewer times than this are dropped from the model so that rare (and likely identifying) values are never reproduced
+	minCategoryCount = 5
+	// columns whose distinct/non-null ratio is above this are treated as free text instead of categorical
+	textCardinalityRatio = 0.5
+	// the highest number of decimal places that will be preserved for numeric columns
+	maxNumericScale = 6
+)
+
+// Describes a source column that a model will be fit on
+type ColumnSpec struct {
+	Name     string
+	DataType string
+	// The column is a single column primary key or unique constraint, or was chosen to keep a multi column unique constraint unique
+	IsSequence bool
+	// Set when the column references the sequence column of another synthesized table with the given row count
+	ReferenceRowCount *int64
+}
+
+// Gaussian copula model fit on a source table.
+// Only aggregate statistics are stored so the model can be serialized into a benthos config without carrying any source rows.
+type Model struct {
+	Columns []*ColumnModel `json:"columns"`
+	// Lower triangular cholesky factor of the correlation matrix between the normal scores of the copula columns
+	Cholesky [][]float64 `json:"cholesky"`
+}
+
+type ColumnModel struct {
+	Name     string     `json:"name"`
+	Kind     ColumnKind `json:"kind"`
+	NullRate float64    `json:"null_rate"`
+	// Index of the column in the copula, or -1 if the column is generated independently
+	CopulaIndex int `json:"copula_index"`
+
+	// Quantile knots of the marginal distribution. For text columns these are of the value length.
+	Quantiles []float64 `json:"quantiles,omitempty"`
+	Scale     int       `json:"scale,omitempty"`
+
+	Categories []string  `json:"categories,omitempty"`
+	Cumulative []float64 `json:"cumulative,omitempty"`
+
+	TemporalLayout string `json:"temporal_layout,omitempty"`
+
+	ReferenceRowCount int64 `json:"reference_row_count,omitempty"`
+}
+
+// Fits a gaussian copula model on the sampled rows. Each row must contain a value for every column spec, nil values are nulls.
+func Fit(specs []*ColumnSpec, rows [][]*string) (*Model, error) {
+	if len(specs) == 0 {
+		return nil, errors.New("must provide at least one column to fit")
+	}
+	for idx, row := range rows {
+		if len(row) != len(specs) {
+			return nil, fmt.Errorf("row %d has %d values but %d columns were provided", idx, len(row), len(specs))
+		}
+	}
+
+	model := &Model{Columns: make([]*ColumnModel, 0, len(specs))}
+	// normal scores for each copula column, NaN where the row value is not modeled
+	scores := [][]float64{}
+	for colIdx, spec := range specs {
+		values := make([]*string, 0, len(rows))
+		for _, row := range rows {
+			values = append(values, row[colIdx])
+		}
+		colModel, colScores := fitColumn(spec, values)
+		if colScores != nil {
+			colModel.CopulaIndex = len(scores)
+			scores = append(scores, colScores)
+		}
+		model.Columns = append(model.Columns, colModel)
+	}
+	model.Cholesky = cholesky(correlationMatrix(scores))
+	return model, nil
+}
+
+func fitColumn(spec *ColumnSpec, values []*string) (*ColumnModel, []float64) {
+	colModel := &ColumnModel{
+		Name:        spec.Name,
+		NullRate:    nullRate(values),
+		CopulaIndex: -1,
+	}
+	dataType := strings.ToLower(spec.DataType)
+
+	switch {
+	case spec.ReferenceRowCount != nil:
+		colModel.Kind = ColumnKindReference
+		colModel.ReferenceRowCount = *spec.ReferenceRowCount
+		return colModel, nil
+	case spec.IsSequence && isIntegerDataType(dataType):
+		colModel.Kind = ColumnKindSequence
+		colModel.NullRate = 0
+		return colModel, nil
+	case isUuidDataType(dataType):
+		colModel.Kind = ColumnKindUuid
+		if spec.IsSequence {
+			colModel.NullRate = 0
+		}
+		return colModel, nil
+	case isIntegerDataType(dataType):
+		colModel.Kind = ColumnKindInteger
+		return fitQuantileColumn(colModel, values, parseFloat)
+	case isNumericDataType(dataType):
+		colModel.Kind = ColumnKindNumeric
+		colModel.Scale = numericScale(values)
+		return fitQuantileColumn(colModel, values, parseFloat)
+	case isTemporalDataType(dataType):
+		colModel.Kind = ColumnKindTemporal
+		colModel.TemporalLayout = "2006-01-02 15:04:05"
+		if dataType == "date" {
+			colModel.TemporalLayout = "2006-01-02"
+		}
+		return fitQuantileColumn(colModel, values, parseTemporal)
+	}
+
+	if !spec.IsSequence {
+		if categories, cumulative, ok := fitCategories(values); ok {
+			colModel.Kind = ColumnKindCategorical
+			colModel.Categories = categories
+			colModel.Cumulative = cumulative
+			return colModel, categoricalScores(values, categories, cumulative)
+		}
+	}
+	colModel.Kind = ColumnKindText
+	if spec.IsSequence {
+		colModel.NullRate = 0
+	}
+	return fitQuantileColumn(colModel, values, func(value string) (float64, bool) {
+		return float64(len([]rune(value))), true
+	})
+}
+
+// Fits the quantile knots of the column and returns the normal scores of each value
+func fitQuantileColumn(
+	colModel *ColumnModel,
+	values []*string,
+	parse func(value string) (float64, bool),
+) (*ColumnModel, []float64) {
+	parsed := make([]float64, len(values))
+	observed := []float64{}
+	for idx, value := range values {
+		parsed[idx] = math.NaN()
+		if value == nil {
+			continue
+		}
+		if f, ok := parse(*value); ok {
+			parsed[idx] = f
+			observed = append(observed, f)
+		}
+	}
+	if len(observed) == 0 {
+		colModel.NullRate = 1
+		return colModel, nil
+	}
+	colModel.Quantiles = quantileKnots(observed)
+	return colModel, rankScores(parsed)
+}
+
+// Returns the categories that appear at least minCategoryCount times along with their cumulative probabilities.
+// Returns false if the column is too high cardinality to be treated as categorical or if no category survives suppression.
+func fitCategories(values []*string) (categories []string, cumulative []float64, ok bool) {
+	counts := map[string]int{}
+	nonNull := 0
+	for _, value := range values {
+		if value == nil {
+			continue
+		}
+		counts[*value]++
+		nonNull++
+	}
+	if nonNull == 0 || float64(len(counts))/float64(nonNull) > textCardinalityRatio {
+		return nil, nil, false
+	}
+
+	total := 0
+	for category, count := range counts {
+		if count >= minCategoryCount {
+			categories = append(categories, category)
+			total += count
+		}
+	}
+	if len(categories) == 0 {
+		return nil, nil, false
+	}
+	slices.Sort(categories)
+	running := 0
+	for _, category := range categories {
+		running += counts[category]
+		cumulative = append(cumulative, float64(running)/float64(total))
+	}
+	return categories, cumulative, true
+}
+
+// Maps each category to the normal score of the midpoint of its probability band
+func categoricalScores(values []*string, categories []string, cumulative []float64) []float64 {
+	midpoints := map[string]float64{}
+	prev := 0.0
+	for idx, category := range categories {
+		midpoints[category] = normalQuantile((prev + cumulative[idx]) / 2)
+		prev = cumulative[idx]
+	}
+	scores := make([]float64, len(values))
+	for idx, value := range values {
+		scores[idx] = math.NaN()
+		if value == nil {
+			continue
+		}
+		if score, ok := midpoints[*value]; ok {
+			scores[idx] = score
+		}
+	}
+	return scores
+}
+
+// Samples rows from a fitted model
+type Sampler struct {
+	model *Model
+	rand  *rand.Rand
+	next  int64
+}
+
+func NewSampler(model *Model, seed int64) *Sampler {
+	return &Sampler{
+		model: model,
+		rand:  rand.New(rand.NewSource(seed)), //nolint:gosec
+		next:  1,
+	}
+}
+
+// Generates the next synthetic row
+func (s *Sampler) Sample() map[string]any {
+	correlated := s.correlatedUniforms()
+	row := make(map[string]any, len(s.model.Columns))
+	for _, col := range s.model.Columns {
+		if col.Kind == ColumnKindSequence {
+			row[col.Name] = s.next
+			continue
+		}
+		if col.NullRate > 0 && s.rand.Float64() < col.NullRate {
+			row[col.Name] = nil
+			continue
+		}
+		u := s.rand.Float64()
+		if col.CopulaIndex >= 0 && col.CopulaIndex < len(correlated) {
+			u = correlated[col.CopulaIndex]
+		}
+		row[col.Name] = s.sampleColumn(col, u)
+	}
+	s.next++
+	return row
+}
+
+func (s *Sampler) correlatedUniforms() []float64 {
+	size := len(s.model.Cholesky)
+	independent := make([]float64, size)
+	for idx := range independent {
+		independent[idx] = s.rand.NormFloat64()
+	}
+	uniforms := make([]float64, size)
+	for i := 0; i < size; i++ {
+		z := 0.0
+		for j := 0; j <= i && j < len(s.model.Cholesky[i]); j++ {
+			z += s.model.Cholesky[i][j] * independent[j]
+		}
+		uniforms[i] = normalCdf(z)
+	}
+	return uniforms
+}
+
+func (s *Sampler) sampleColumn(col *ColumnModel, u float64) any {
+	switch col.Kind {
+	case ColumnKindUuid:
+		return uuid.NewString()
+	case ColumnKindReference:
+		if col.ReferenceRowCount <= 0 {
+			return nil
+		}
+		return s.rand.Int63n(col.ReferenceRowCount) + 1
+	case ColumnKindCategorical:
+		for idx, cum := range col.Cumulative {
+			if u <= cum {
+				return col.Categories[idx]
+			}
+		}
+		if len(col.Categories) == 0 {
+			return nil
+		}
+		return col.Categories[len(col.Categories)-1]
+	}
+
+	if len(col.Quantiles) == 0 {
+		return nil
+	}
+	value := interpolateQuantile(col.Quantiles, u)
+	switch col.Kind {
+	case ColumnKindInteger:
+		return int64(math.Round(value))
+	case ColumnKindNumeric:
+		pow := math.Pow10(col.Scale)
+		return math.Round(value*pow) / pow
+	case ColumnKindTemporal:
+		return time.Unix(int64(math.Round(value)), 0).UTC().Format(col.TemporalLayout)
+	case ColumnKindText:
+		return s.randomText(max(int(math.Round(value)), 1))
+	default:
+		return nil
+	}
+}
+
+const alphanumeric = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+func (s *Sampler) randomText(length int) string {
+	var builder strings.Builder
+	builder.Grow(length)
+	for i := 0; i < length; i++ {
+		builder.WriteByte(alphanumeric[s.rand.Intn(len(alphanumeric))])
+	}
+	return builder.String()
+}
+
+func nullRate(values []*string) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	nulls := 0
+	for _, value := range values {
+		if value == nil {
+			nulls++
+		}
+	}
+	return float64(nulls) / float64(len(values))
+}
+
+func numericScale(values []*string) int {
+	scale := 0
+	for _, value := range values {
+		if value == nil {
+			continue
+		}
+		if idx := strings.IndexByte(*value, '.'); idx >= 0 {
+			scale = max(scale, len(strings.TrimRight((*value)[idx+1:], "0")))
+		}
+	}
+	return min(scale, maxNumericScale)
+}
+
+func parseFloat(value string) (float64, bool) {
+	f, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, false
+	}
+	return f, true
+}
+
+var temporalLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999-07",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+}
+
+func parseTemporal(value string) (float64, bool) {
+	for _, layout := range temporalLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return float64(t.Unix()), true
+		}
+	}
+	return 0, false
+}
+
+var (
+	integerDataTypes = map[string]struct{}{
+		"smallint": {}, "integer": {}, "int": {}, "bigint": {}, "int2": {}, "int4": {}, "int8": {},
+		"tinyint": {}, "mediumint": {}, "serial": {}, "bigserial": {}, "smallserial": {},
+	}
+	numericDataTypes = map[string]struct{}{
+		"numeric": {}, "decimal": {}, "real": {}, "double precision": {}, "double": {}, "float": {},
+		"float4": {}, "float8": {}, "money": {},
+	}
+	temporalDataTypes = map[string]struct{}{
+		"date": {}, "datetime": {}, "timestamp": {}, "timestamp without time zone": {},
+		"timestamp with time zone": {}, "timestamptz": {},
+	}
+)
+
+func isIntegerDataType(dataType string) bool {
+	_, ok := integerDataTypes[dataType]
+	return ok
+}
+
+func isNumericDataType(dataType string) bool {
+	_, ok := numericDataTypes[dataType]
+	return ok
+}
+
+func isTemporalDataType(dataType string) bool {
+	_, ok := temporalDataTypes[dataType]
+	return ok
+}
+
+func isUuidDataType(dataType string) bool {
+	return dataType == "uuid"
+}
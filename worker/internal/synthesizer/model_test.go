@@ -0,0 +1,188 @@
+package synthesizer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func ptr[T any](val T) *T {
+	return &val
+}
+
+func buildRows(n int) [][]*string {
+	rows := [][]*string{}
+	for i := 0; i < n; i++ {
+		var status *string
+		switch {
+		case i%10 == 0:
+			status = nil
+		case i%2 == 0:
+			status = ptr("active")
+		default:
+			status = ptr("inactive")
+		}
+		rows = append(rows, []*string{
+			ptr(fmt.Sprintf("%d", i+1)),
+			ptr(fmt.Sprintf("%d", 20+i%50)),
+			ptr(fmt.Sprintf("%.2f", float64(20+i%50)*1000.5)),
+			status,
+			ptr(fmt.Sprintf("2024-01-%02d", 1+i%28)),
+			ptr(fmt.Sprintf("user-%d@example.com", i)),
+		})
+	}
+	return rows
+}
+
+var testSpecs = []*ColumnSpec{
+	{Name: "id", DataType: "integer", IsSequence: true},
+	{Name: "age", DataType: "integer"},
+	{Name: "salary", DataType: "numeric"},
+	{Name: "status", DataType: "text"},
+	{Name: "created", DataType: "date"},
+	{Name: "email", DataType: "text"},
+}
+
+func Test_Fit(t *testing.T) {
+	model, err := Fit(testSpecs, buildRows(500))
+	require.NoError(t, err)
+	require.Len(t, model.Columns, 6)
+
+	kinds := map[string]ColumnKind{}
+	for _, col := range model.Columns {
+		kinds[col.Name] = col.Kind
+	}
+	require.Equal(t, map[string]ColumnKind{
+		"id":      ColumnKindSequence,
+		"age":     ColumnKindInteger,
+		"salary":  ColumnKindNumeric,
+		"status":  ColumnKindCategorical,
+		"created": ColumnKindTemporal,
+		"email":   ColumnKindText,
+	}, kinds)
+
+	status := model.Columns[3]
+	require.Equal(t, []string{"active", "inactive"}, status.Categories)
+	require.InDelta(t, 0.1, status.NullRate, 0.001)
+	require.Equal(t, 1, model.Columns[2].Scale)
+
+	// every column except the sequence is part of the copula
+	require.Len(t, model.Cholesky, 5)
+}
+
+func Test_Fit_Errors(t *testing.T) {
+	_, err := Fit(nil, nil)
+	require.Error(t, err)
+	_, err = Fit(testSpecs, [][]*string{{ptr("1")}})
+	require.Error(t, err)
+}
+
+func Test_Fit_SuppressesRareCategories(t *testing.T) {
+	rows := [][]*string{}
+	for i := 0; i < 40; i++ {
+		rows = append(rows, []*string{ptr("common")})
+	}
+	rows = append(rows, []*string{ptr("rare")})
+	model, err := Fit([]*ColumnSpec{{Name: "c", DataType: "text"}}, rows)
+	require.NoError(t, err)
+	require.Equal(t, ColumnKindCategorical, model.Columns[0].Kind)
+	require.Equal(t, []string{"common"}, model.Columns[0].Categories)
+}
+
+func Test_Sampler(t *testing.T) {
+	model, err := Fit(testSpecs, buildRows(500))
+	require.NoError(t, err)
+
+	// the model must survive being serialized into a benthos config
+	bits, err := json.Marshal(model)
+	require.NoError(t, err)
+	var decoded Model
+	require.NoError(t, json.Unmarshal(bits, &decoded))
+
+	sampler := NewSampler(&decoded, 1)
+	ages := []float64{}
+	salaries := []float64{}
+	for i := 0; i < 1000; i++ {
+		row := sampler.Sample()
+		require.Equal(t, int64(i+1), row["id"])
+		if status := row["status"]; status != nil {
+			require.Contains(t, []string{"active", "inactive"}, status)
+		}
+		if created := row["created"]; created != nil {
+			require.Regexp(t, `^2024-01-\d{2}$`, created)
+		}
+		if email := row["email"]; email != nil {
+			require.NotContains(t, email, "@example.com")
+		}
+		if age, ok := row["age"].(int64); ok {
+			require.GreaterOrEqual(t, age, int64(20))
+			require.LessOrEqual(t, age, int64(69))
+			if salary, ok := row["salary"].(float64); ok {
+				ages = append(ages, float64(age))
+				salaries = append(salaries, salary)
+			}
+		}
+	}
+	require.Greater(t, pearson(ages, salaries), 0.9)
+}
+
+func Test_cholesky_NotPositiveDefinite(t *testing.T) {
+	factor := cholesky([][]float64{
+		{1, 0.9, -0.9},
+		{0.9, 1, 0.9},
+		{-0.9, 0.9, 1},
+	})
+	require.Len(t, factor, 3)
+	for _, row := range factor {
+		norm := 0.0
+		for _, v := range row {
+			norm += v * v
+		}
+		require.InDelta(t, 1, math.Sqrt(norm), 1e-9)
+	}
+}
+
+func Test_normalQuantile(t *testing.T) {
+	require.InDelta(t, 0, normalQuantile(0.5), 1e-9)
+	require.InDelta(t, 1.959964, normalQuantile(0.975), 1e-5)
+	require.InDelta(t, 0.975, normalCdf(1.959964), 1e-5)
+}
+
+func Test_ExternalClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/fit":
+			var req fitRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.Equal(t, []string{"id"}, req.Columns)
+			_, _ = w.Write([]byte(`{"model_id":"m-1"}`))
+		case "/sample":
+			var req sampleRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.Equal(t, "m-1", req.ModelId)
+			require.Equal(t, 2, req.Count)
+			_, _ = w.Write([]byte(`{"data":[{"id":1},{"id":2}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client := NewExternalClient(srv.URL+"/", srv.Client())
+	modelId, err := client.Fit(context.Background(), []*ColumnSpec{{Name: "id", DataType: "integer"}}, [][]*string{{ptr("1")}})
+	require.NoError(t, err)
+	require.Equal(t, "m-1", modelId)
+
+	rows, err := client.Sample(context.Background(), modelId, 2)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+
+	_, err = NewExternalClient(srv.URL+"/missing", srv.Client()).Sample(context.Background(), modelId, 2)
+	require.Error(t, err)
+}
@@ -0,0 +1,210 @@
+package synthesizer
+
+import (
+	"math"
+	"slices"
+)
+
+const (
+	// the marginals are stored as knots between these percentiles so that the most extreme source values are never reproduced
+	lowerPercentile = 0.01
+	upperPercentile = 0.99
+	numKnots        = 99
+	// the minimum number of rows two columns must both have values in for their correlation to be estimated
+	minCorrelationPairs = 3
+)
+
+// Returns evenly spaced quantile knots of the values, trimmed to the lower and upper percentiles
+func quantileKnots(values []float64) []float64 {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	knots := make([]float64, numKnots)
+	for idx := range knots {
+		p := lowerPercentile + (upperPercentile-lowerPercentile)*float64(idx)/float64(numKnots-1)
+		knots[idx] = percentile(sorted, p)
+	}
+	return knots
+}
+
+// Linearly interpolated percentile of an already sorted slice
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 1 {
+		return sorted[0]
+	}
+	pos := p * float64(len(sorted)-1)
+	lower := int(math.Floor(pos))
+	upper := int(math.Ceil(pos))
+	frac := pos - float64(lower)
+	return sorted[lower] + (sorted[upper]-sorted[lower])*frac
+}
+
+// Maps a uniform value in [0, 1] back onto the marginal described by the quantile knots
+func interpolateQuantile(knots []float64, u float64) float64 {
+	if len(knots) == 1 {
+		return knots[0]
+	}
+	u = math.Min(math.Max(u, 0), 1)
+	return percentile(knots, u)
+}
+
+// Converts the values to normal scores using their average rank. NaN values are left as NaN.
+func rankScores(values []float64) []float64 {
+	type indexed struct {
+		idx   int
+		value float64
+	}
+	present := []indexed{}
+	for idx, value := range values {
+		if !math.IsNaN(value) {
+			present = append(present, indexed{idx: idx, value: value})
+		}
+	}
+	slices.SortFunc(present, func(a, b indexed) int {
+		switch {
+		case a.value < b.value:
+			return -1
+		case a.value > b.value:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	scores := make([]float64, len(values))
+	for idx := range scores {
+		scores[idx] = math.NaN()
+	}
+	n := float64(len(present))
+	for start := 0; start < len(present); {
+		end := start
+		for end+1 < len(present) && present[end+1].value == present[start].value {
+			end++
+		}
+		// ties share the average of their ranks
+		avgRank := float64(start+end) / 2
+		score := normalQuantile((avgRank + 0.5) / n)
+		for i := start; i <= end; i++ {
+			scores[present[i].idx] = score
+		}
+		start = end + 1
+	}
+	return scores
+}
+
+// Computes the pairwise complete pearson correlation matrix of the normal scores
+func correlationMatrix(scores [][]float64) [][]float64 {
+	size := len(scores)
+	matrix := make([][]float64, size)
+	for i := range matrix {
+		matrix[i] = make([]float64, size)
+		matrix[i][i] = 1
+	}
+	for i := 0; i < size; i++ {
+		for j := 0; j < i; j++ {
+			corr := pearson(scores[i], scores[j])
+			matrix[i][j] = corr
+			matrix[j][i] = corr
+		}
+	}
+	return matrix
+}
+
+func pearson(a, b []float64) float64 {
+	var n, sumA, sumB float64
+	for idx := range a {
+		if math.IsNaN(a[idx]) || math.IsNaN(b[idx]) {
+			continue
+		}
+		n++
+		sumA += a[idx]
+		sumB += b[idx]
+	}
+	if n < minCorrelationPairs {
+		return 0
+	}
+	meanA := sumA / n
+	meanB := sumB / n
+	var cov, varA, varB float64
+	for idx := range a {
+		if math.IsNaN(a[idx]) || math.IsNaN(b[idx]) {
+			continue
+		}
+		da := a[idx] - meanA
+		db := b[idx] - meanB
+		cov += da * db
+		varA += da * da
+		varB += db * db
+	}
+	if varA == 0 || varB == 0 {
+		return 0
+	}
+	return cov / math.Sqrt(varA*varB)
+}
+
+// Returns the lower triangular cholesky factor of the matrix.
+// Pairwise complete correlations are not guaranteed to be positive definite, so the diagonal is increasingly
+// inflated until the decomposition succeeds, falling back to the identity (independent columns).
+func cholesky(matrix [][]float64) [][]float64 {
+	for _, jitter := range []float64{0, 1e-6, 1e-4, 1e-2, 1e-1} {
+		if factor, ok := tryCholesky(matrix, jitter); ok {
+			return factor
+		}
+	}
+	identity := make([][]float64, len(matrix))
+	for i := range identity {
+		identity[i] = make([]float64, len(matrix))
+		identity[i][i] = 1
+	}
+	return identity
+}
+
+func tryCholesky(matrix [][]float64, jitter float64) ([][]float64, bool) {
+	size := len(matrix)
+	factor := make([][]float64, size)
+	for i := range factor {
+		factor[i] = make([]float64, size)
+	}
+	for i := 0; i < size; i++ {
+		for j := 0; j <= i; j++ {
+			sum := matrix[i][j]
+			if i == j {
+				sum += jitter
+			}
+			for k := 0; k < j; k++ {
+				sum -= factor[i][k] * factor[j][k]
+			}
+			if i == j {
+				if sum <= 0 {
+					return nil, false
+				}
+				factor[i][j] = math.Sqrt(sum)
+			} else {
+				factor[i][j] = sum / factor[j][j]
+			}
+		}
+	}
+	if jitter > 0 {
+		// rescale so each row keeps unit variance
+		for i := range factor {
+			norm := 0.0
+			for _, v := range factor[i] {
+				norm += v * v
+			}
+			norm = math.Sqrt(norm)
+			for j := range factor[i] {
+				factor[i][j] /= norm
+			}
+		}
+	}
+	return factor, true
+}
+
+func normalCdf(z float64) float64 {
+	return 0.5 * math.Erfc(-z/math.Sqrt2)
+}
+
+// Inverse of the standard normal cdf
+func normalQuantile(p float64) float64 {
+	p = math.Min(math.Max(p, 1e-12), 1-1e-12)
+	return math.Sqrt2 * math.Erfinv(2*p-1)
+}
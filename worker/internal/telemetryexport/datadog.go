@@ -0,0 +1,163 @@
+package telemetryexport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"time"
+)
+
+const (
+	defaultDatadogSite    = "datadoghq.com"
+	defaultDatadogService = "neosync-worker"
+
+	datadogMetricTypeCount = 1
+	datadogMetricTypeGauge = 3
+)
+
+type DatadogConfig struct {
+	ApiKey string
+	// The Datadog site the account is in, e.g. datadoghq.eu. Defaults to datadoghq.com
+	Site string
+	// Reported as the service of all logs. Defaults to neosync-worker
+	Service string
+}
+
+type datadogSink struct {
+	config     *DatadogConfig
+	hostname   string
+	httpclient *http.Client
+	// overridden in tests
+	apiUrl  string
+	logsUrl string
+}
+
+func newDatadogSink(cfg *DatadogConfig, hostname string) *datadogSink {
+	site := cfg.Site
+	if site == "" {
+		site = defaultDatadogSite
+	}
+	return &datadogSink{
+		config:     cfg,
+		hostname:   hostname,
+		httpclient: &http.Client{Timeout: 30 * time.Second},
+		apiUrl:     fmt.Sprintf("https://api.%s", site),
+		logsUrl:    fmt.Sprintf("https://http-intake.logs.%s", site),
+	}
+}
+
+// https://docs.datadoghq.com/api/latest/logs/#send-logs
+func (d *datadogSink) sendLogs(ctx context.Context, logs []*LogRecord) error {
+	service := d.config.Service
+	if service == "" {
+		service = defaultDatadogService
+	}
+	entries := make([]map[string]any, 0, len(logs))
+	for _, record := range logs {
+		entry := make(map[string]any, len(record.Attributes)+6)
+		for key, value := range record.Attributes {
+			entry[key] = value
+		}
+		entry["message"] = record.Message
+		entry["status"] = toDatadogStatus(record.Level)
+		entry["timestamp"] = record.Time.UnixMilli()
+		entry["service"] = service
+		entry["ddsource"] = "neosync"
+		entry["hostname"] = d.hostname
+		entries = append(entries, entry)
+	}
+	return d.post(ctx, fmt.Sprintf("%s/api/v2/logs", d.logsUrl), entries)
+}
+
+type datadogSeries struct {
+	Metric    string               `json:"metric"`
+	Type      int                  `json:"type"`
+	Points    []datadogMetricPoint `json:"points"`
+	Interval  int64                `json:"interval,omitempty"`
+	Tags      []string             `json:"tags,omitempty"`
+	Resources []datadogResource    `json:"resources,omitempty"`
+}
+
+type datadogMetricPoint struct {
+	Timestamp int64   `json:"timestamp"`
+	Value     float64 `json:"value"`
+}
+
+type datadogResource struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// https://docs.datadoghq.com/api/latest/metrics/#submit-metrics
+func (d *datadogSink) sendMetrics(ctx context.Context, points []*MetricPoint) error {
+	series := make([]*datadogSeries, 0, len(points))
+	for _, point := range points {
+		s := &datadogSeries{
+			Metric: point.Name,
+			Type:   datadogMetricTypeGauge,
+			Points: []datadogMetricPoint{{Timestamp: point.Time.Unix(), Value: point.Value}},
+			Tags:   toDatadogTags(point.Tags),
+		}
+		if point.Kind == MetricKindCount {
+			s.Type = datadogMetricTypeCount
+			s.Interval = int64(point.Interval.Seconds())
+		}
+		if d.hostname != "" {
+			s.Resources = []datadogResource{{Name: d.hostname, Type: "host"}}
+		}
+		series = append(series, s)
+	}
+	return d.post(ctx, fmt.Sprintf("%s/api/v2/series", d.apiUrl), map[string]any{"series": series})
+}
+
+// https://docs.datadoghq.com/api/latest/events/#post-an-event
+func (d *datadogSink) sendEvent(ctx context.Context, event *Event) error {
+	return d.post(ctx, fmt.Sprintf("%s/api/v1/events", d.apiUrl), map[string]any{
+		"title":            event.Title,
+		"text":             event.Text,
+		"alert_type":       string(event.AlertType),
+		"date_happened":    event.Time.Unix(),
+		"aggregation_key":  event.AggregationKey,
+		"source_type_name": "neosync",
+		"host":             d.hostname,
+		"tags":             toDatadogTags(event.Tags),
+	})
+}
+
+func (d *datadogSink) post(ctx context.Context, url string, body any) error {
+	bits, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	err = postRequest(ctx, d.httpclient, url, map[string]string{"DD-API-KEY": d.config.ApiKey}, bits)
+	if err != nil {
+		return fmt.Errorf("unable to send to datadog: %w", err)
+	}
+	return nil
+}
+
+func toDatadogStatus(level slog.Level) string {
+	switch {
+	case level >= slog.LevelError:
+		return "error"
+	case level >= slog.LevelWarn:
+		return "warn"
+	case level >= slog.LevelInfo:
+		return "info"
+	default:
+		return "debug"
+	}
+}
+
+// Returns the tags in Datadog's key:value format, sorted by key
+func toDatadogTags(tags map[string]string) []string {
+	output := make([]string, 0, len(tags))
+	for key, value := range tags {
+		output = append(output, fmt.Sprintf("%s:%s", key, value))
+	}
+	slices.Sort(output)
+	return output
+}
@@ -0,0 +1,245 @@
+// Package telemetryexport forwards the worker's logs, metrics and run events to Datadog or Splunk,
+// for teams that collect telemetry there instead of scraping it from the cluster
+package telemetryexport
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// logs are sent once this many are buffered, or when the flush interval elapses
+	maxLogBatchSize  = 500
+	logFlushInterval = 5 * time.Second
+	// logs that are buffered past this point are dropped so that an unreachable sink can not exhaust memory
+	maxBufferedLogs = 10000
+)
+
+type Config struct {
+	// Nil if logs, metrics and run events are not forwarded to Datadog
+	Datadog *DatadogConfig
+	// Nil if logs, metrics and run events are not forwarded to Splunk
+	Splunk *SplunkConfig
+	// Reported as the host of all logs and metrics
+	Hostname string
+}
+
+type LogRecord struct {
+	Time    time.Time
+	Level   slog.Level
+	Message string
+	// Attributes of nested groups are keyed by their dotted path
+	Attributes map[string]any
+}
+
+type MetricKind int
+
+const (
+	// The change in value over the interval of the point
+	MetricKindCount MetricKind = iota
+	// The value at the time of the point
+	MetricKindGauge
+)
+
+type MetricPoint struct {
+	Name  string
+	Kind  MetricKind
+	Value float64
+	Time  time.Time
+	// The length of time a count was accumulated over
+	Interval time.Duration
+	Tags     map[string]string
+}
+
+type EventAlertType string
+
+const (
+	EventAlertTypeInfo    EventAlertType = "info"
+	EventAlertTypeSuccess EventAlertType = "success"
+	EventAlertTypeWarning EventAlertType = "warning"
+	EventAlertTypeError   EventAlertType = "error"
+)
+
+type Event struct {
+	Title     string
+	Text      string
+	AlertType EventAlertType
+	Time      time.Time
+	// Used to group the events of the same run
+	AggregationKey string
+	Tags           map[string]string
+}
+
+type sink interface {
+	sendLogs(ctx context.Context, logs []*LogRecord) error
+	sendMetrics(ctx context.Context, points []*MetricPoint) error
+	sendEvent(ctx context.Context, event *Event) error
+}
+
+type Exporter struct {
+	sinks []sink
+	// only used to report failures to forward logs, so must not itself forward its logs
+	logger *slog.Logger
+
+	mu      sync.Mutex
+	logs    []*LogRecord
+	flushCh chan struct{}
+	stopCh  chan struct{}
+	doneCh  chan struct{}
+}
+
+// Returns an exporter that forwards to the configured sinks. The exporter is not enabled if none are configured
+func New(cfg *Config, logger *slog.Logger) *Exporter {
+	e := &Exporter{
+		logger:  logger,
+		flushCh: make(chan struct{}, 1),
+		stopCh:  make(chan struct{}),
+		doneCh:  make(chan struct{}),
+	}
+	if cfg != nil && cfg.Datadog != nil && cfg.Datadog.ApiKey != "" {
+		e.sinks = append(e.sinks, newDatadogSink(cfg.Datadog, cfg.Hostname))
+	}
+	if cfg != nil && cfg.Splunk != nil && cfg.Splunk.Url != "" {
+		e.sinks = append(e.sinks, newSplunkSink(cfg.Splunk, cfg.Hostname))
+	}
+	if e.IsEnabled() {
+		go e.flushLoop()
+	} else {
+		close(e.doneCh)
+	}
+	return e
+}
+
+// Returns true if at least one sink has been configured
+func (e *Exporter) IsEnabled() bool {
+	return e != nil && len(e.sinks) > 0
+}
+
+// Sends the event to every sink
+func (e *Exporter) SendEvent(ctx context.Context, event *Event) error {
+	if !e.IsEnabled() {
+		return nil
+	}
+	var errs error
+	for _, s := range e.sinks {
+		errs = errors.Join(errs, s.sendEvent(ctx, event))
+	}
+	return errs
+}
+
+// Sends the metric points to every sink
+func (e *Exporter) SendMetrics(ctx context.Context, points []*MetricPoint) error {
+	if !e.IsEnabled() || len(points) == 0 {
+		return nil
+	}
+	var errs error
+	for _, s := range e.sinks {
+		errs = errors.Join(errs, s.sendMetrics(ctx, points))
+	}
+	return errs
+}
+
+// Buffers the log record until the next flush
+func (e *Exporter) enqueueLog(record *LogRecord) {
+	if !e.IsEnabled() {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if len(e.logs) >= maxBufferedLogs {
+		return
+	}
+	e.logs = append(e.logs, record)
+	if len(e.logs) >= maxLogBatchSize {
+		select {
+		case e.flushCh <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Sends any buffered logs to every sink
+func (e *Exporter) Flush(ctx context.Context) error {
+	if !e.IsEnabled() {
+		return nil
+	}
+	e.mu.Lock()
+	logs := e.logs
+	e.logs = nil
+	e.mu.Unlock()
+
+	var errs error
+	for start := 0; start < len(logs); start += maxLogBatchSize {
+		end := min(start+maxLogBatchSize, len(logs))
+		for _, s := range e.sinks {
+			errs = errors.Join(errs, s.sendLogs(ctx, logs[start:end]))
+		}
+	}
+	return errs
+}
+
+// Stops the background flushing and sends any logs that are still buffered
+func (e *Exporter) Shutdown(ctx context.Context) error {
+	if !e.IsEnabled() {
+		return nil
+	}
+	select {
+	case <-e.stopCh:
+	default:
+		close(e.stopCh)
+	}
+	select {
+	case <-e.doneCh:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return e.Flush(ctx)
+}
+
+func (e *Exporter) flushLoop() {
+	defer close(e.doneCh)
+	ticker := time.NewTicker(logFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-e.stopCh:
+			return
+		case <-ticker.C:
+		case <-e.flushCh:
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), logFlushInterval)
+		if err := e.Flush(ctx); err != nil && e.logger != nil {
+			e.logger.Warn("unable to forward logs", "err", err)
+		}
+		cancel()
+	}
+}
+
+func postRequest(ctx context.Context, httpclient *http.Client, url string, headers map[string]string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	resp, err := httpclient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("returned %s: %s", resp.Status, strings.TrimSpace(string(respBody)))
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package telemetryexport
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Exporter_Disabled(t *testing.T) {
+	exporter := New(&Config{Datadog: &DatadogConfig{}, Splunk: &SplunkConfig{}}, nil)
+	require.False(t, exporter.IsEnabled())
+	require.NoError(t, exporter.SendEvent(context.Background(), &Event{Title: "event"}))
+	require.NoError(t, exporter.Shutdown(context.Background()))
+
+	inner := slog.NewJSONHandler(io.Discard, nil)
+	require.Equal(t, slog.Handler(inner), NewLogHandler(inner, exporter))
+}
+
+func Test_Exporter_Datadog(t *testing.T) {
+	received := map[string][]byte{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "test-key", r.Header.Get("DD-API-KEY"))
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		received[r.URL.Path] = body
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	ddsink := newDatadogSink(&DatadogConfig{ApiKey: "test-key"}, "worker-1")
+	ddsink.apiUrl = srv.URL
+	ddsink.logsUrl = srv.URL
+	exporter := &Exporter{sinks: []sink{ddsink}}
+	ts := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+
+	exporter.enqueueLog(&LogRecord{Time: ts, Level: slog.LevelWarn, Message: "retrying", Attributes: map[string]any{"jobId": "job-1"}})
+	require.NoError(t, exporter.Flush(context.Background()))
+	var logs []map[string]any
+	require.NoError(t, json.Unmarshal(received["/api/v2/logs"], &logs))
+	require.Equal(t, []map[string]any{{
+		"message":   "retrying",
+		"status":    "warn",
+		"timestamp": float64(ts.UnixMilli()),
+		"service":   "neosync-worker",
+		"ddsource":  "neosync",
+		"hostname":  "worker-1",
+		"jobId":     "job-1",
+	}}, logs)
+
+	require.NoError(t, exporter.SendMetrics(context.Background(), []*MetricPoint{
+		{Name: "neosync.rows", Kind: MetricKindCount, Value: 10, Time: ts, Interval: time.Minute, Tags: map[string]string{"table": "users", "schema": "public"}},
+	}))
+	var series map[string]any
+	require.NoError(t, json.Unmarshal(received["/api/v2/series"], &series))
+	require.Equal(t, []any{map[string]any{
+		"metric":    "neosync.rows",
+		"type":      float64(datadogMetricTypeCount),
+		"points":    []any{map[string]any{"timestamp": float64(ts.Unix()), "value": float64(10)}},
+		"interval":  float64(60),
+		"tags":      []any{"schema:public", "table:users"},
+		"resources": []any{map[string]any{"name": "worker-1", "type": "host"}},
+	}}, series["series"])
+
+	require.NoError(t, exporter.SendEvent(context.Background(), &Event{
+		Title: "Job run failed: nightly", AlertType: EventAlertTypeError, Time: ts, AggregationKey: "run-1", Tags: map[string]string{"status": "fail"},
+	}))
+	var event map[string]any
+	require.NoError(t, json.Unmarshal(received["/api/v1/events"], &event))
+	require.Equal(t, "Job run failed: nightly", event["title"])
+	require.Equal(t, "error", event["alert_type"])
+	require.Equal(t, "run-1", event["aggregation_key"])
+	require.Equal(t, []any{"status:fail"}, event["tags"])
+}
+
+func Test_Exporter_Splunk(t *testing.T) {
+	var received []map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/services/collector/event", r.URL.Path)
+		require.Equal(t, "Splunk test-token", r.Header.Get("Authorization"))
+		scanner := bufio.NewScanner(r.Body)
+		for scanner.Scan() {
+			var event map[string]any
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+			received = append(received, event)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	exporter := New(&Config{Splunk: &SplunkConfig{Url: srv.URL + "/", Token: "test-token", Index: "neosync"}, Hostname: "worker-1"}, nil)
+	require.True(t, exporter.IsEnabled())
+	ts := time.Date(2024, 5, 1, 12, 30, 0, 500_000_000, time.UTC)
+
+	logger := slog.New(NewLogHandler(slog.NewJSONHandler(io.Discard, nil), exporter))
+	logger.With("jobId", "job-1").WithGroup("sync").Info("synced table", "table", "users", "err", errors.New("boom"))
+	require.NoError(t, exporter.SendMetrics(context.Background(), []*MetricPoint{
+		{Name: "neosync.rows", Kind: MetricKindGauge, Value: 10, Time: ts, Tags: map[string]string{"table": "users"}},
+	}))
+	require.NoError(t, exporter.Shutdown(context.Background()))
+
+	require.Len(t, received, 2)
+	require.Equal(t, map[string]any{
+		"time":   float64(ts.UnixMilli()) / 1000,
+		"host":   "worker-1",
+		"source": "neosync-worker",
+		"index":  "neosync",
+		"event":  "metric",
+		"fields": map[string]any{"metric_name:neosync.rows": float64(10), "table": "users"},
+	}, received[0])
+	log := received[1]
+	require.Equal(t, "neosync:log", log["sourcetype"])
+	require.Equal(t, map[string]any{
+		"message":    "synced table",
+		"level":      "INFO",
+		"jobId":      "job-1",
+		"sync.table": "users",
+		"sync.err":   "boom",
+	}, log["event"])
+}
+
+func Test_Exporter_Error(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errors":["Forbidden"]}`))
+	}))
+	defer srv.Close()
+
+	ddsink := newDatadogSink(&DatadogConfig{ApiKey: "bad-key"}, "")
+	ddsink.apiUrl = srv.URL
+	exporter := &Exporter{sinks: []sink{ddsink}}
+	err := exporter.SendEvent(context.Background(), &Event{Title: "event"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unable to send to datadog")
+	require.Contains(t, err.Error(), "Forbidden")
+}
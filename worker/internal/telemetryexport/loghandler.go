@@ -0,0 +1,115 @@
+package telemetryexport
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+type logHandler struct {
+	inner    slog.Handler
+	exporter *Exporter
+
+	attrs  []slog.Attr
+	groups []string
+}
+
+// Returns a handler that writes every record to the inner handler and also forwards it through the exporter.
+// The inner handler is returned as is if the exporter is not enabled
+func NewLogHandler(inner slog.Handler, exporter *Exporter) slog.Handler {
+	if !exporter.IsEnabled() {
+		return inner
+	}
+	return &logHandler{inner: inner, exporter: exporter}
+}
+
+func (h *logHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.inner.Enabled(ctx, level)
+}
+
+func (h *logHandler) Handle(ctx context.Context, record slog.Record) error {
+	attributes := map[string]any{}
+	prefix := ""
+	// attributes added through WithAttrs are qualified by the groups that were open at the time they were added
+	for _, attr := range h.attrs {
+		addAttribute(attributes, attr.Key, attr.Value)
+	}
+	if len(h.groups) > 0 {
+		prefix = strings.Join(h.groups, ".")
+	}
+	record.Attrs(func(attr slog.Attr) bool {
+		key := attr.Key
+		if prefix != "" {
+			key = fmt.Sprintf("%s.%s", prefix, key)
+		}
+		addAttribute(attributes, key, attr.Value)
+		return true
+	})
+	h.exporter.enqueueLog(&LogRecord{
+		Time:       record.Time,
+		Level:      record.Level,
+		Message:    record.Message,
+		Attributes: attributes,
+	})
+	return h.inner.Handle(ctx, record)
+}
+
+func (h *logHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	qualified := make([]slog.Attr, 0, len(h.attrs)+len(attrs))
+	qualified = append(qualified, h.attrs...)
+	for _, attr := range attrs {
+		if len(h.groups) > 0 {
+			attr = slog.Attr{Key: fmt.Sprintf("%s.%s", strings.Join(h.groups, "."), attr.Key), Value: attr.Value}
+		}
+		qualified = append(qualified, attr)
+	}
+	return &logHandler{
+		inner:    h.inner.WithAttrs(attrs),
+		exporter: h.exporter,
+		attrs:    qualified,
+		groups:   h.groups,
+	}
+}
+
+func (h *logHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	groups := make([]string, 0, len(h.groups)+1)
+	groups = append(groups, h.groups...)
+	groups = append(groups, name)
+	return &logHandler{
+		inner:    h.inner.WithGroup(name),
+		exporter: h.exporter,
+		attrs:    h.attrs,
+		groups:   groups,
+	}
+}
+
+// Flattens group values into dotted keys so that every sink receives a flat set of attributes
+func addAttribute(attributes map[string]any, key string, value slog.Value) {
+	value = value.Resolve()
+	switch value.Kind() {
+	case slog.KindGroup:
+		for _, attr := range value.Group() {
+			groupKey := attr.Key
+			if key != "" {
+				groupKey = fmt.Sprintf("%s.%s", key, attr.Key)
+			}
+			addAttribute(attributes, groupKey, attr.Value)
+		}
+	case slog.KindAny:
+		if err, ok := value.Any().(error); ok {
+			attributes[key] = err.Error()
+			return
+		}
+		attributes[key] = fmt.Sprint(value.Any())
+	case slog.KindDuration:
+		attributes[key] = value.Duration().String()
+	case slog.KindTime:
+		attributes[key] = value.Time()
+	default:
+		attributes[key] = value.Any()
+	}
+}
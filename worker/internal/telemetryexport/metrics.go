@@ -0,0 +1,128 @@
+package telemetryexport
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	metricsdk "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+type metricExporter struct {
+	exporter *Exporter
+}
+
+// Returns an OpenTelemetry metric exporter that forwards everything it is given through the exporter,
+// so that the worker's metrics reach Datadog or Splunk without running a collector
+func NewMetricExporter(exporter *Exporter) metricsdk.Exporter {
+	return &metricExporter{exporter: exporter}
+}
+
+func (m *metricExporter) Temporality(ik metricsdk.InstrumentKind) metricdata.Temporality {
+	switch ik {
+	case metricsdk.InstrumentKindUpDownCounter, metricsdk.InstrumentKindObservableUpDownCounter:
+		// up down counters are reported as the current value, which is only known with cumulative temporality
+		return metricdata.CumulativeTemporality
+	default:
+		return metricdata.DeltaTemporality
+	}
+}
+
+func (m *metricExporter) Aggregation(ik metricsdk.InstrumentKind) metricsdk.Aggregation {
+	return metricsdk.DefaultAggregationSelector(ik)
+}
+
+func (m *metricExporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	return m.exporter.SendMetrics(ctx, toMetricPoints(rm))
+}
+
+func (m *metricExporter) ForceFlush(ctx context.Context) error {
+	return ctx.Err()
+}
+
+func (m *metricExporter) Shutdown(ctx context.Context) error {
+	return ctx.Err()
+}
+
+func toMetricPoints(rm *metricdata.ResourceMetrics) []*MetricPoint {
+	points := []*MetricPoint{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, metric := range sm.Metrics {
+			switch data := metric.Data.(type) {
+			case metricdata.Sum[int64]:
+				points = append(points, sumToPoints(metric.Name, data)...)
+			case metricdata.Sum[float64]:
+				points = append(points, sumToPoints(metric.Name, data)...)
+			case metricdata.Gauge[int64]:
+				points = append(points, gaugeToPoints(metric.Name, data)...)
+			case metricdata.Gauge[float64]:
+				points = append(points, gaugeToPoints(metric.Name, data)...)
+			case metricdata.Histogram[int64]:
+				points = append(points, histogramToPoints(metric.Name, data)...)
+			case metricdata.Histogram[float64]:
+				points = append(points, histogramToPoints(metric.Name, data)...)
+			}
+		}
+	}
+	return points
+}
+
+func sumToPoints[N int64 | float64](name string, sum metricdata.Sum[N]) []*MetricPoint {
+	kind := MetricKindGauge
+	if sum.IsMonotonic && sum.Temporality == metricdata.DeltaTemporality {
+		kind = MetricKindCount
+	}
+	points := make([]*MetricPoint, 0, len(sum.DataPoints))
+	for _, dp := range sum.DataPoints {
+		points = append(points, &MetricPoint{
+			Name:     name,
+			Kind:     kind,
+			Value:    float64(dp.Value),
+			Time:     dp.Time,
+			Interval: dp.Time.Sub(dp.StartTime),
+			Tags:     toTags(dp.Attributes.ToSlice()),
+		})
+	}
+	return points
+}
+
+func gaugeToPoints[N int64 | float64](name string, gauge metricdata.Gauge[N]) []*MetricPoint {
+	points := make([]*MetricPoint, 0, len(gauge.DataPoints))
+	for _, dp := range gauge.DataPoints {
+		points = append(points, &MetricPoint{
+			Name:  name,
+			Kind:  MetricKindGauge,
+			Value: float64(dp.Value),
+			Time:  dp.Time,
+			Tags:  toTags(dp.Attributes.ToSlice()),
+		})
+	}
+	return points
+}
+
+// Histograms are reported as the count and sum of their observations, which is enough to chart rates and averages
+func histogramToPoints[N int64 | float64](name string, histogram metricdata.Histogram[N]) []*MetricPoint {
+	kind := MetricKindGauge
+	if histogram.Temporality == metricdata.DeltaTemporality {
+		kind = MetricKindCount
+	}
+	points := make([]*MetricPoint, 0, len(histogram.DataPoints)*2)
+	for _, dp := range histogram.DataPoints {
+		tags := toTags(dp.Attributes.ToSlice())
+		interval := dp.Time.Sub(dp.StartTime)
+		points = append(points,
+			&MetricPoint{Name: fmt.Sprintf("%s.count", name), Kind: kind, Value: float64(dp.Count), Time: dp.Time, Interval: interval, Tags: tags},
+			&MetricPoint{Name: fmt.Sprintf("%s.sum", name), Kind: kind, Value: float64(dp.Sum), Time: dp.Time, Interval: interval, Tags: tags},
+		)
+	}
+	return points
+}
+
+func toTags(attrs []attribute.KeyValue) map[string]string {
+	tags := make(map[string]string, len(attrs))
+	for _, attr := range attrs {
+		tags[string(attr.Key)] = attr.Value.Emit()
+	}
+	return tags
+}
@@ -0,0 +1,139 @@
+package telemetryexport
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	defaultSplunkSource = "neosync-worker"
+
+	splunkLogSourceType   = "neosync:log"
+	splunkEventSourceType = "neosync:run_event"
+)
+
+type SplunkConfig struct {
+	// The base url of the HTTP Event Collector, e.g. https://splunk.example.com:8088
+	Url   string
+	Token string
+	// The index events are written to. The token's default index is used if not provided
+	Index string
+	// Reported as the source of all events. Defaults to neosync-worker
+	Source string
+	// Skips verifying the collector's certificate, for collectors that use self-signed certificates
+	InsecureSkipVerify bool
+}
+
+type splunkSink struct {
+	config     *SplunkConfig
+	hostname   string
+	httpclient *http.Client
+}
+
+func newSplunkSink(cfg *SplunkConfig, hostname string) *splunkSink {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg.InsecureSkipVerify {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec
+	}
+	return &splunkSink{
+		config:     cfg,
+		hostname:   hostname,
+		httpclient: &http.Client{Timeout: 30 * time.Second, Transport: transport},
+	}
+}
+
+// https://docs.splunk.com/Documentation/Splunk/latest/Data/FormateventsforHTTPEventCollector
+type splunkHecEvent struct {
+	Time       float64        `json:"time"`
+	Host       string         `json:"host,omitempty"`
+	Source     string         `json:"source,omitempty"`
+	SourceType string         `json:"sourcetype,omitempty"`
+	Index      string         `json:"index,omitempty"`
+	Event      any            `json:"event"`
+	Fields     map[string]any `json:"fields,omitempty"`
+}
+
+func (s *splunkSink) sendLogs(ctx context.Context, logs []*LogRecord) error {
+	events := make([]*splunkHecEvent, 0, len(logs))
+	for _, record := range logs {
+		event := make(map[string]any, len(record.Attributes)+2)
+		for key, value := range record.Attributes {
+			event[key] = value
+		}
+		event["message"] = record.Message
+		event["level"] = record.Level.String()
+		events = append(events, s.newEvent(record.Time, splunkLogSourceType, event, nil))
+	}
+	return s.post(ctx, events)
+}
+
+// Metrics are sent as HEC metric events, where each point is a single metric_name:<name> field alongside its dimensions
+func (s *splunkSink) sendMetrics(ctx context.Context, points []*MetricPoint) error {
+	events := make([]*splunkHecEvent, 0, len(points))
+	for _, point := range points {
+		fields := make(map[string]any, len(point.Tags)+1)
+		for key, value := range point.Tags {
+			fields[key] = value
+		}
+		fields[fmt.Sprintf("metric_name:%s", point.Name)] = point.Value
+		events = append(events, s.newEvent(point.Time, "", "metric", fields))
+	}
+	return s.post(ctx, events)
+}
+
+func (s *splunkSink) sendEvent(ctx context.Context, event *Event) error {
+	body := map[string]any{
+		"title":           event.Title,
+		"text":            event.Text,
+		"alert_type":      string(event.AlertType),
+		"aggregation_key": event.AggregationKey,
+	}
+	for key, value := range event.Tags {
+		body[key] = value
+	}
+	return s.post(ctx, []*splunkHecEvent{s.newEvent(event.Time, splunkEventSourceType, body, nil)})
+}
+
+func (s *splunkSink) newEvent(ts time.Time, sourceType string, event any, fields map[string]any) *splunkHecEvent {
+	source := s.config.Source
+	if source == "" {
+		source = defaultSplunkSource
+	}
+	return &splunkHecEvent{
+		Time:       float64(ts.UnixMilli()) / 1000,
+		Host:       s.hostname,
+		Source:     source,
+		SourceType: sourceType,
+		Index:      s.config.Index,
+		Event:      event,
+		Fields:     fields,
+	}
+}
+
+// The collector accepts a batch of events as concatenated JSON objects
+func (s *splunkSink) post(ctx context.Context, events []*splunkHecEvent) error {
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	for _, event := range events {
+		if err := encoder.Encode(event); err != nil {
+			return err
+		}
+	}
+	err := postRequest(
+		ctx,
+		s.httpclient,
+		fmt.Sprintf("%s/services/collector/event", strings.TrimRight(s.config.Url, "/")),
+		map[string]string{"Authorization": fmt.Sprintf("Splunk %s", s.config.Token)},
+		body.Bytes(),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to send to splunk: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+// Package neosync_plugins allows self-hosted workers to compile in connectors for datastores that Neosync does not support natively.
+//
+// Plugins are registered by name, typically from an init function that is pulled in with a blank import in the worker's main package.
+// Connections with a custom connection config are then read from and written to by the plugin whose name matches the config's plugin.
+package neosync_plugins
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// The table that a plugin is being asked to read or write
+type TableRequest struct {
+	Schema  string
+	Table   string
+	Columns []string
+	// The options from the custom connection config, as provided by the user
+	Options map[string]string
+}
+
+// A plugin that reads rows from a custom datastore
+type Source interface {
+	// Opens a reader over every row in the requested table
+	OpenReader(ctx context.Context, req *TableRequest) (RowReader, error)
+}
+
+// A plugin that writes rows to a custom datastore
+type Sink interface {
+	// Opens a writer for the requested table
+	OpenWriter(ctx context.Context, req *TableRequest) (RowWriter, error)
+}
+
+type RowReader interface {
+	// Returns the next batch of rows, keyed by column name. io.EOF is returned once every row has been read
+	Read(ctx context.Context) ([]map[string]any, error)
+	Close(ctx context.Context) error
+}
+
+type RowWriter interface {
+	// Writes a batch of rows, keyed by column name. Returning an error causes the batch to be retried
+	Write(ctx context.Context, rows []map[string]any) error
+	Close(ctx context.Context) error
+}
+
+var (
+	registryMu sync.RWMutex
+	sources    = map[string]Source{}
+	sinks      = map[string]Sink{}
+)
+
+// Makes the source available to custom connections under the given name.
+// Panics if the name is empty or a source has already been registered with it
+func RegisterSource(name string, source Source) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if name == "" || source == nil {
+		panic("neosync_plugins: source must have a name and must not be nil")
+	}
+	if _, ok := sources[name]; ok {
+		panic(fmt.Sprintf("neosync_plugins: source already registered: %s", name))
+	}
+	sources[name] = source
+}
+
+// Makes the sink available to custom connections under the given name.
+// Panics if the name is empty or a sink has already been registered with it
+func RegisterSink(name string, sink Sink) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if name == "" || sink == nil {
+		panic("neosync_plugins: sink must have a name and must not be nil")
+	}
+	if _, ok := sinks[name]; ok {
+		panic(fmt.Sprintf("neosync_plugins: sink already registered: %s", name))
+	}
+	sinks[name] = sink
+}
+
+func GetSource(name string) (Source, error) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	source, ok := sources[name]
+	if !ok {
+		return nil, fmt.Errorf("no source plugin has been registered with the name %q", name)
+	}
+	return source, nil
+}
+
+func GetSink(name string) (Sink, error) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	sink, ok := sinks[name]
+	if !ok {
+		return nil, fmt.Errorf("no sink plugin has been registered with the name %q", name)
+	}
+	return sink, nil
+}
+
+// Returns the names of all registered sources
+func Sources() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	names := make([]string, 0, len(sources))
+	for name := range sources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Returns the names of all registered sinks
+func Sinks() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	names := make([]string, 0, len(sinks))
+	for name := range sinks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,43 @@
+package neosync_plugins
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testPlugin struct{}
+
+func (t *testPlugin) OpenReader(ctx context.Context, req *TableRequest) (RowReader, error) {
+	return nil, nil
+}
+
+func (t *testPlugin) OpenWriter(ctx context.Context, req *TableRequest) (RowWriter, error) {
+	return nil, nil
+}
+
+func Test_Registry(t *testing.T) {
+	plugin := &testPlugin{}
+	RegisterSource("registry-test", plugin)
+	RegisterSink("registry-test", plugin)
+
+	source, err := GetSource("registry-test")
+	require.NoError(t, err)
+	require.Equal(t, plugin, source)
+	sink, err := GetSink("registry-test")
+	require.NoError(t, err)
+	require.Equal(t, plugin, sink)
+
+	require.Contains(t, Sources(), "registry-test")
+	require.Contains(t, Sinks(), "registry-test")
+
+	_, err = GetSource("missing")
+	require.Error(t, err)
+	_, err = GetSink("missing")
+	require.Error(t, err)
+
+	require.Panics(t, func() { RegisterSource("registry-test", plugin) })
+	require.Panics(t, func() { RegisterSink("registry-test", plugin) })
+	require.Panics(t, func() { RegisterSource("", plugin) })
+}
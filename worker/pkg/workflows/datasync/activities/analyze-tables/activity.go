@@ -0,0 +1,183 @@
+package analyzetables_activity
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	logger_utils "github.com/nucleuscloud/neosync/worker/internal/logger"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"go.temporal.io/sdk/activity"
+)
+
+type AnalyzeTablesRequest struct {
+	JobId string
+}
+
+type AnalyzeTablesResponse struct {
+	// The tables that were analyzed across all destinations. Format: schema.table
+	AnalyzedTables []string
+}
+
+type Activity struct {
+	jobclient  mgmtv1alpha1connect.JobServiceClient
+	connclient mgmtv1alpha1connect.ConnectionServiceClient
+	sqlmanager sql_manager.SqlManagerClient
+}
+
+func New(
+	jobclient mgmtv1alpha1connect.JobServiceClient,
+	connclient mgmtv1alpha1connect.ConnectionServiceClient,
+	sqlmanager sql_manager.SqlManagerClient,
+) *Activity {
+	return &Activity{
+		jobclient:  jobclient,
+		connclient: connclient,
+		sqlmanager: sqlmanager,
+	}
+}
+
+// Refreshes the planner statistics of the synced tables in destinations that have opted in,
+// so that queries against the freshly loaded data are not planned with the statistics of the empty tables
+func (a *Activity) AnalyzeTables(
+	ctx context.Context,
+	req *AnalyzeTablesRequest,
+) (*AnalyzeTablesResponse, error) {
+	info := activity.GetInfo(ctx)
+	loggerKeyVals := []any{
+		"jobId", req.JobId,
+		"WorkflowID", info.WorkflowExecution.ID,
+		"RunID", info.WorkflowExecution.RunID,
+	}
+	go func() {
+		for {
+			select {
+			case <-time.After(1 * time.Second):
+				activity.RecordHeartbeat(ctx)
+			case <-activity.GetWorkerStopChannel(ctx):
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	slogger := logger_utils.NewJsonSLogger().With(loggerKeyVals...)
+	return a.analyzeTables(ctx, req, slogger)
+}
+
+func (a *Activity) analyzeTables(
+	ctx context.Context,
+	req *AnalyzeTablesRequest,
+	slogger *slog.Logger,
+) (*AnalyzeTablesResponse, error) {
+	jobResp, err := a.jobclient.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{Id: req.JobId}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get job by id: %w", err)
+	}
+	job := jobResp.Msg.GetJob()
+	tables := getSyncedDestinationTables(job.GetMappings())
+
+	analyzed := []string{}
+	for _, destination := range job.GetDestinations() {
+		statements := buildAnalyzeStatements(destination.GetOptions(), tables)
+		if len(statements) == 0 {
+			continue
+		}
+		destinationConnection, err := shared.GetConnectionById(ctx, a.connclient, destination.GetConnectionId())
+		if err != nil {
+			return nil, fmt.Errorf("unable to get destination connection by id (%s): %w", destination.GetConnectionId(), err)
+		}
+		if !isMatchingConnection(destination.GetOptions(), destinationConnection) {
+			continue
+		}
+
+		db, err := a.sqlmanager.NewSqlDb(ctx, slogger, destinationConnection, nil)
+		if err != nil {
+			return nil, err
+		}
+		err = runAnalyzeStatements(ctx, slogger, db.Db, statements)
+		db.Db.Close()
+		if err != nil {
+			return nil, fmt.Errorf("unable to analyze tables for destination (%s): %w", destination.GetConnectionId(), err)
+		}
+		for _, table := range tables {
+			analyzed = append(analyzed, sql_manager.BuildTable(table.Schema, table.Table))
+		}
+	}
+	return &AnalyzeTablesResponse{AnalyzedTables: analyzed}, nil
+}
+
+// Returns the tables the job writes to in SQL destinations, in the order they first appear in the mappings
+func getSyncedDestinationTables(mappings []*mgmtv1alpha1.JobMapping) []*sql_manager.SchemaTable {
+	destinationTables := shared.GetDestinationTablesFromMappings(mappings)
+	seen := map[string]struct{}{}
+	tables := []*sql_manager.SchemaTable{}
+	for _, mapping := range mappings {
+		table := shared.GetDestinationTable(destinationTables, mapping.GetSchema(), mapping.GetTable())
+		key := sql_manager.BuildTable(table.Schema, table.Table)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		tables = append(tables, table)
+	}
+	return tables
+}
+
+// Returns the statements that refresh the statistics of each table, or nothing if the destination has not opted in
+func buildAnalyzeStatements(options *mgmtv1alpha1.JobDestinationOptions, tables []*sql_manager.SchemaTable) []string {
+	statements := []string{}
+	switch config := options.GetConfig().(type) {
+	case *mgmtv1alpha1.JobDestinationOptions_PostgresOptions:
+		analyzeCfg := config.PostgresOptions.GetAnalyzeTables()
+		if !analyzeCfg.GetEnabled() {
+			return nil
+		}
+		for _, table := range tables {
+			statements = append(statements, sql_manager.BuildPgAnalyzeStatement(table, analyzeCfg.GetVacuum()))
+		}
+	case *mgmtv1alpha1.JobDestinationOptions_MysqlOptions:
+		if !config.MysqlOptions.GetAnalyzeTables().GetEnabled() {
+			return nil
+		}
+		for _, table := range tables {
+			statements = append(statements, sql_manager.BuildMysqlAnalyzeStatement(table))
+		}
+	default:
+		return nil
+	}
+	return statements
+}
+
+func isMatchingConnection(options *mgmtv1alpha1.JobDestinationOptions, connection *mgmtv1alpha1.Connection) bool {
+	switch options.GetConfig().(type) {
+	case *mgmtv1alpha1.JobDestinationOptions_PostgresOptions:
+		return connection.GetConnectionConfig().GetPgConfig() != nil
+	case *mgmtv1alpha1.JobDestinationOptions_MysqlOptions:
+		return connection.GetConnectionConfig().GetMysqlConfig() != nil
+	default:
+		return false
+	}
+}
+
+// Statements are run one at a time as VACUUM can not be run in a multi-statement transaction
+func runAnalyzeStatements(
+	ctx context.Context,
+	slogger *slog.Logger,
+	db sql_manager.SqlDatabase,
+	statements []string,
+) error {
+	for _, stmt := range statements {
+		slogger.Info("refreshing table statistics", "statement", stmt)
+		if err := db.Exec(ctx, stmt); err != nil {
+			return fmt.Errorf("unable to exec statement %q: %w", stmt, err)
+		}
+	}
+	return nil
+}
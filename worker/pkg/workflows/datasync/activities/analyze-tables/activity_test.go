@@ -0,0 +1,64 @@
+package analyzetables_activity
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getSyncedDestinationTables(t *testing.T) {
+	actual := getSyncedDestinationTables([]*mgmtv1alpha1.JobMapping{
+		{Schema: "public", Table: "users", Column: "id"},
+		{Schema: "public", Table: "users", Column: "name"},
+		{Schema: "public", Table: "orders", Column: "id", DestinationTable: &mgmtv1alpha1.JobMappingDestinationTable{Schema: "archive", Table: "orders_2024"}},
+	})
+	require.Equal(t, []*sql_manager.SchemaTable{
+		{Schema: "public", Table: "users"},
+		{Schema: "archive", Table: "orders_2024"},
+	}, actual)
+}
+
+func Test_buildAnalyzeStatements(t *testing.T) {
+	tables := []*sql_manager.SchemaTable{{Schema: "public", Table: "users"}}
+
+	require.Empty(t, buildAnalyzeStatements(&mgmtv1alpha1.JobDestinationOptions{
+		Config: &mgmtv1alpha1.JobDestinationOptions_PostgresOptions{PostgresOptions: &mgmtv1alpha1.PostgresDestinationConnectionOptions{}},
+	}, tables))
+
+	require.Equal(t, []string{`ANALYZE "public"."users";`}, buildAnalyzeStatements(&mgmtv1alpha1.JobDestinationOptions{
+		Config: &mgmtv1alpha1.JobDestinationOptions_PostgresOptions{PostgresOptions: &mgmtv1alpha1.PostgresDestinationConnectionOptions{
+			AnalyzeTables: &mgmtv1alpha1.PostgresAnalyzeTablesConfig{Enabled: true},
+		}},
+	}, tables))
+
+	require.Equal(t, []string{`VACUUM ANALYZE "public"."users";`}, buildAnalyzeStatements(&mgmtv1alpha1.JobDestinationOptions{
+		Config: &mgmtv1alpha1.JobDestinationOptions_PostgresOptions{PostgresOptions: &mgmtv1alpha1.PostgresDestinationConnectionOptions{
+			AnalyzeTables: &mgmtv1alpha1.PostgresAnalyzeTablesConfig{Enabled: true, Vacuum: true},
+		}},
+	}, tables))
+
+	require.Equal(t, []string{"ANALYZE TABLE `public`.`users`;"}, buildAnalyzeStatements(&mgmtv1alpha1.JobDestinationOptions{
+		Config: &mgmtv1alpha1.JobDestinationOptions_MysqlOptions{MysqlOptions: &mgmtv1alpha1.MysqlDestinationConnectionOptions{
+			AnalyzeTables: &mgmtv1alpha1.MysqlAnalyzeTablesConfig{Enabled: true},
+		}},
+	}, tables))
+
+	require.Empty(t, buildAnalyzeStatements(&mgmtv1alpha1.JobDestinationOptions{
+		Config: &mgmtv1alpha1.JobDestinationOptions_AwsS3Options{AwsS3Options: &mgmtv1alpha1.AwsS3DestinationConnectionOptions{}},
+	}, tables))
+}
+
+func Test_runAnalyzeStatements(t *testing.T) {
+	db := sql_manager.NewMockSqlDatabase(t)
+	db.On("Exec", mock.Anything, `ANALYZE "public"."users";`).Return(nil)
+	db.On("Exec", mock.Anything, `ANALYZE "public"."orders";`).Return(errors.New("boom"))
+
+	err := runAnalyzeStatements(context.Background(), slog.Default(), db, []string{`ANALYZE "public"."users";`, `ANALYZE "public"."orders";`})
+	require.ErrorContains(t, err, "boom")
+}
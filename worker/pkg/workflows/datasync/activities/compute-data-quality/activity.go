@@ -0,0 +1,316 @@
+package computedataquality_activity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	logger_utils "github.com/nucleuscloud/neosync/worker/internal/logger"
+	"go.temporal.io/sdk/activity"
+
+	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
+	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+)
+
+const (
+	// max number of values that are pulled from each side when comparing numeric distributions
+	numericSampleSize uint = 10000
+)
+
+type ComputeDataQualityRequest struct {
+	JobId string
+	// The tables that were synced by the run. Format: schema.table
+	Tables []string
+}
+
+type ComputeDataQualityResponse struct {
+	Tables []*TableQuality
+}
+
+type TableQuality struct {
+	Schema                  string
+	Table                   string
+	DestinationConnectionId string
+	// Between 0 and 1. 1 means the masked data closely resembles the source
+	Score float64
+	// Nil if the table has no foreign keys
+	ReferentialValidity *float64
+	Columns             []*ColumnQuality
+}
+
+type ColumnQuality struct {
+	Column string
+	// Nil for non-numeric columns
+	DistributionDistance    *float64
+	CardinalityPreservation *float64
+}
+
+type Activity struct {
+	jobclient  mgmtv1alpha1connect.JobServiceClient
+	connclient mgmtv1alpha1connect.ConnectionServiceClient
+	sqlmanager sql_manager.SqlManagerClient
+
+	isEnabled bool
+}
+
+func New(
+	jobclient mgmtv1alpha1connect.JobServiceClient,
+	connclient mgmtv1alpha1connect.ConnectionServiceClient,
+	sqlmanager sql_manager.SqlManagerClient,
+	isEnabled bool,
+) *Activity {
+	return &Activity{
+		jobclient:  jobclient,
+		connclient: connclient,
+		sqlmanager: sqlmanager,
+		isEnabled:  isEnabled,
+	}
+}
+
+// Compares the synced destination tables against the source to measure how realistic the masked data is
+func (a *Activity) ComputeDataQuality(
+	ctx context.Context,
+	req *ComputeDataQualityRequest,
+) (*ComputeDataQualityResponse, error) {
+	info := activity.GetInfo(ctx)
+	loggerKeyVals := []any{
+		"jobId", req.JobId,
+		"WorkflowID", info.WorkflowExecution.ID,
+		"RunID", info.WorkflowExecution.RunID,
+	}
+	go func() {
+		for {
+			select {
+			case <-time.After(1 * time.Second):
+				activity.RecordHeartbeat(ctx)
+			case <-activity.GetWorkerStopChannel(ctx):
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	if !a.isEnabled {
+		return &ComputeDataQualityResponse{}, nil
+	}
+	slogger := logger_utils.NewJsonSLogger().With(loggerKeyVals...)
+	return a.computeDataQuality(ctx, req, slogger)
+}
+
+func (a *Activity) computeDataQuality(
+	ctx context.Context,
+	req *ComputeDataQualityRequest,
+	slogger *slog.Logger,
+) (*ComputeDataQualityResponse, error) {
+	jobResp, err := a.jobclient.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{Id: req.JobId}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get job by id: %w", err)
+	}
+	job := jobResp.Msg.GetJob()
+
+	sourceConnectionId, ok := getSqlSourceConnectionId(job.GetSource())
+	if !ok {
+		slogger.Info("job source does not support data quality scoring")
+		return &ComputeDataQualityResponse{}, nil
+	}
+	sourceConnection, err := a.getConnectionById(ctx, sourceConnectionId)
+	if err != nil {
+		return nil, err
+	}
+	sourceDb, err := a.sqlmanager.NewSqlDb(ctx, slogger, sourceConnection, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer sourceDb.Db.Close()
+
+	schemas := getUniqueSchemas(req.Tables)
+	columnMap, err := sourceDb.Db.GetSchemaColumnMap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve source database schema: %w", err)
+	}
+	foreignKeyMap, err := sourceDb.Db.GetForeignKeyConstraintsMap(ctx, schemas)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve source foreign key constraints: %w", err)
+	}
+
+	tables := []*TableQuality{}
+	for _, destination := range job.GetDestinations() {
+		destinationConnection, err := a.getConnectionById(ctx, destination.GetConnectionId())
+		if err != nil {
+			return nil, err
+		}
+		if !isSqlConnection(destinationConnection) {
+			slogger.Info("skipping data quality scoring for non-sql destination", "destinationConnectionId", destination.GetConnectionId())
+			continue
+		}
+		destinationTables, err := a.computeDestinationQuality(ctx, slogger, sourceDb.Db, destinationConnection, req.Tables, columnMap, foreignKeyMap)
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, destinationTables...)
+	}
+
+	return &ComputeDataQualityResponse{Tables: tables}, nil
+}
+
+func (a *Activity) computeDestinationQuality(
+	ctx context.Context,
+	slogger *slog.Logger,
+	sourceDb sql_manager.SqlDatabase,
+	destinationConnection *mgmtv1alpha1.Connection,
+	schematables []string,
+	columnMap map[string]map[string]*sql_manager.ColumnInfo,
+	foreignKeyMap map[string][]*sql_manager.ForeignConstraint,
+) ([]*TableQuality, error) {
+	destinationDb, err := a.sqlmanager.NewSqlDb(ctx, slogger, destinationConnection, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer destinationDb.Db.Close()
+
+	tables := []*TableQuality{}
+	for _, schematable := range schematables {
+		schema, table, ok := strings.Cut(schematable, ".")
+		if !ok {
+			continue
+		}
+		tableQuality, err := computeTableQuality(
+			ctx,
+			sourceDb,
+			destinationDb.Db,
+			schema,
+			table,
+			columnMap[schematable],
+			foreignKeyMap[schematable],
+		)
+		if err != nil {
+			// a single table failing should not prevent the rest of the report from being built
+			slogger.Warn(fmt.Sprintf("unable to compute data quality for table: %s", err.Error()), "schema", schema, "table", table)
+			continue
+		}
+		tableQuality.DestinationConnectionId = destinationConnection.GetId()
+		tables = append(tables, tableQuality)
+	}
+	return tables, nil
+}
+
+func computeTableQuality(
+	ctx context.Context,
+	sourceDb, destinationDb sql_manager.SqlDatabase,
+	schema, table string,
+	columns map[string]*sql_manager.ColumnInfo,
+	foreignKeys []*sql_manager.ForeignConstraint,
+) (*TableQuality, error) {
+	columnNames := make([]string, 0, len(columns))
+	for col := range columns {
+		columnNames = append(columnNames, col)
+	}
+	slices.Sort(columnNames)
+
+	columnQualities := make([]*ColumnQuality, 0, len(columnNames))
+	for _, col := range columnNames {
+		srcDistinct, err := sourceDb.GetColumnDistinctCount(ctx, schema, table, col)
+		if err != nil {
+			return nil, err
+		}
+		destDistinct, err := destinationDb.GetColumnDistinctCount(ctx, schema, table, col)
+		if err != nil {
+			return nil, err
+		}
+		cardinality := cardinalityPreservation(srcDistinct, destDistinct)
+		columnQuality := &ColumnQuality{
+			Column:                  col,
+			CardinalityPreservation: &cardinality,
+		}
+
+		if isNumericDataType(columns[col].DataType) {
+			srcSample, err := sourceDb.GetNumericColumnSample(ctx, schema, table, col, numericSampleSize)
+			if err != nil {
+				return nil, err
+			}
+			destSample, err := destinationDb.GetNumericColumnSample(ctx, schema, table, col, numericSampleSize)
+			if err != nil {
+				return nil, err
+			}
+			columnQuality.DistributionDistance = distributionDistance(srcSample, destSample)
+		}
+		columnQualities = append(columnQualities, columnQuality)
+	}
+
+	var validity *float64
+	if len(foreignKeys) > 0 {
+		rowCount, err := destinationDb.GetTableRowCount(ctx, schema, table, nil)
+		if err != nil {
+			return nil, err
+		}
+		validities := make([]float64, 0, len(foreignKeys))
+		for _, fk := range foreignKeys {
+			orphans, err := destinationDb.GetForeignKeyOrphanCount(ctx, schema, table, fk)
+			if err != nil {
+				return nil, err
+			}
+			validities = append(validities, referentialValidity(orphans, rowCount))
+		}
+		avg := mean(validities)
+		validity = &avg
+	}
+
+	return &TableQuality{
+		Schema:              schema,
+		Table:               table,
+		Score:               qualityScore(columnQualities, validity),
+		ReferentialValidity: validity,
+		Columns:             columnQualities,
+	}, nil
+}
+
+func (a *Activity) getConnectionById(ctx context.Context, connectionId string) (*mgmtv1alpha1.Connection, error) {
+	resp, err := a.connclient.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{Id: connectionId}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get connection by id (%s): %w", connectionId, err)
+	}
+	if resp.Msg.GetConnection() == nil {
+		return nil, errors.New("connection was nil")
+	}
+	return resp.Msg.GetConnection(), nil
+}
+
+func getSqlSourceConnectionId(source *mgmtv1alpha1.JobSource) (string, bool) {
+	switch cfg := source.GetOptions().GetConfig().(type) {
+	case *mgmtv1alpha1.JobSourceOptions_Postgres:
+		return cfg.Postgres.GetConnectionId(), true
+	case *mgmtv1alpha1.JobSourceOptions_Mysql:
+		return cfg.Mysql.GetConnectionId(), true
+	default:
+		return "", false
+	}
+}
+
+func isSqlConnection(connection *mgmtv1alpha1.Connection) bool {
+	switch connection.GetConnectionConfig().GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+		return true
+	default:
+		return false
+	}
+}
+
+func getUniqueSchemas(tables []string) []string {
+	schemas := []string{}
+	for _, schematable := range tables {
+		schema, _, ok := strings.Cut(schematable, ".")
+		if ok && !slices.Contains(schemas, schema) {
+			schemas = append(schemas, schema)
+		}
+	}
+	return schemas
+}
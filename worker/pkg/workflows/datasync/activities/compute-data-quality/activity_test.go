@@ -0,0 +1,65 @@
+package computedataquality_activity
+
+import (
+	"context"
+	"testing"
+
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_computeTableQuality(t *testing.T) {
+	ctx := context.Background()
+	sourceDb := sql_manager.NewMockSqlDatabase(t)
+	destDb := sql_manager.NewMockSqlDatabase(t)
+
+	sourceDb.On("GetColumnDistinctCount", mock.Anything, "public", "orders", "age").Return(int64(10), nil)
+	destDb.On("GetColumnDistinctCount", mock.Anything, "public", "orders", "age").Return(int64(5), nil)
+	sourceDb.On("GetColumnDistinctCount", mock.Anything, "public", "orders", "name").Return(int64(10), nil)
+	destDb.On("GetColumnDistinctCount", mock.Anything, "public", "orders", "name").Return(int64(10), nil)
+
+	sourceDb.On("GetNumericColumnSample", mock.Anything, "public", "orders", "age", numericSampleSize).Return([]float64{1, 2, 3, 4}, nil)
+	destDb.On("GetNumericColumnSample", mock.Anything, "public", "orders", "age", numericSampleSize).Return([]float64{3, 4, 5, 6}, nil)
+
+	fk := &sql_manager.ForeignConstraint{
+		Columns:    []string{"user_id"},
+		ForeignKey: &sql_manager.ForeignKey{Table: "public.users", Columns: []string{"id"}},
+	}
+	destDb.On("GetTableRowCount", mock.Anything, "public", "orders", (*string)(nil)).Return(int64(100), nil)
+	destDb.On("GetForeignKeyOrphanCount", mock.Anything, "public", "orders", fk).Return(int64(10), nil)
+
+	actual, err := computeTableQuality(
+		ctx,
+		sourceDb,
+		destDb,
+		"public",
+		"orders",
+		map[string]*sql_manager.ColumnInfo{
+			"name": {DataType: "text"},
+			"age":  {DataType: "integer"},
+		},
+		[]*sql_manager.ForeignConstraint{fk},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "public", actual.Schema)
+	require.Equal(t, "orders", actual.Table)
+	require.Len(t, actual.Columns, 2)
+
+	require.Equal(t, "age", actual.Columns[0].Column)
+	require.NotNil(t, actual.Columns[0].DistributionDistance)
+	require.InDelta(t, 0.5, *actual.Columns[0].DistributionDistance, 0.0001)
+	require.Equal(t, 0.5, *actual.Columns[0].CardinalityPreservation)
+
+	require.Equal(t, "name", actual.Columns[1].Column)
+	require.Nil(t, actual.Columns[1].DistributionDistance)
+	require.Equal(t, float64(1), *actual.Columns[1].CardinalityPreservation)
+
+	require.NotNil(t, actual.ReferentialValidity)
+	require.InDelta(t, 0.9, *actual.ReferentialValidity, 0.0001)
+	require.InDelta(t, (0.5+0.75+0.9)/3, actual.Score, 0.0001)
+}
+
+func Test_getUniqueSchemas(t *testing.T) {
+	require.Equal(t, []string{"public", "neosync"}, getUniqueSchemas([]string{"public.users", "neosync.jobs", "public.orders", "invalid"}))
+}
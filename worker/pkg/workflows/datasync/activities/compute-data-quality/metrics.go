@@ -0,0 +1,122 @@
+package computedataquality_activity
+
+import (
+	"slices"
+	"strings"
+)
+
+var (
+	numericDataTypes = map[string]struct{}{
+		// postgres
+		"smallint":         {},
+		"integer":          {},
+		"bigint":           {},
+		"numeric":          {},
+		"real":             {},
+		"double precision": {},
+		// mysql
+		"tinyint":   {},
+		"mediumint": {},
+		"int":       {},
+		"decimal":   {},
+		"float":     {},
+		"double":    {},
+	}
+)
+
+func isNumericDataType(dataType string) bool {
+	_, ok := numericDataTypes[strings.ToLower(dataType)]
+	return ok
+}
+
+// Computes the two-sample Kolmogorov-Smirnov statistic, the largest distance between the empirical distributions of the two samples.
+// 0 means the distributions are identical, 1 means they do not overlap at all.
+// Returns nil if either sample is empty.
+func distributionDistance(source, destination []float64) *float64 {
+	if len(source) == 0 || len(destination) == 0 {
+		return nil
+	}
+	a := slices.Clone(source)
+	b := slices.Clone(destination)
+	slices.Sort(a)
+	slices.Sort(b)
+
+	var distance float64
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		value := min(a[i], b[j])
+		for i < len(a) && a[i] == value {
+			i++
+		}
+		for j < len(b) && b[j] == value {
+			j++
+		}
+		diff := float64(i)/float64(len(a)) - float64(j)/float64(len(b))
+		if diff < 0 {
+			diff = -diff
+		}
+		distance = max(distance, diff)
+	}
+	return &distance
+}
+
+// Ratio of the smaller distinct count to the larger distinct count.
+// 1 means the masked column has the same amount of unique values as the source.
+func cardinalityPreservation(sourceDistinct, destinationDistinct int64) float64 {
+	if sourceDistinct == destinationDistinct {
+		return 1
+	}
+	if sourceDistinct <= 0 || destinationDistinct <= 0 {
+		return 0
+	}
+	return float64(min(sourceDistinct, destinationDistinct)) / float64(max(sourceDistinct, destinationDistinct))
+}
+
+// The fraction of rows whose foreign keys resolve to a row in the referenced table
+func referentialValidity(orphanCount, rowCount int64) float64 {
+	if rowCount <= 0 {
+		return 1
+	}
+	return 1 - float64(min(orphanCount, rowCount))/float64(rowCount)
+}
+
+// Combines the table metrics into a single score between 0 and 1, where 1 is the highest quality.
+// Each available metric category contributes equally to the score.
+func qualityScore(columns []*ColumnQuality, referentialValidity *float64) float64 {
+	components := []float64{}
+
+	distances := []float64{}
+	cardinalities := []float64{}
+	for _, col := range columns {
+		if col.DistributionDistance != nil {
+			distances = append(distances, *col.DistributionDistance)
+		}
+		if col.CardinalityPreservation != nil {
+			cardinalities = append(cardinalities, *col.CardinalityPreservation)
+		}
+	}
+	if len(distances) > 0 {
+		components = append(components, 1-mean(distances))
+	}
+	if len(cardinalities) > 0 {
+		components = append(components, mean(cardinalities))
+	}
+	if referentialValidity != nil {
+		components = append(components, *referentialValidity)
+	}
+	if len(components) == 0 {
+		return 1
+	}
+	return mean(components)
+}
+
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
@@ -0,0 +1,62 @@
+package computedataquality_activity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_isNumericDataType(t *testing.T) {
+	require.True(t, isNumericDataType("integer"))
+	require.True(t, isNumericDataType("DOUBLE PRECISION"))
+	require.True(t, isNumericDataType("decimal"))
+	require.False(t, isNumericDataType("text"))
+	require.False(t, isNumericDataType("money"))
+}
+
+func Test_distributionDistance(t *testing.T) {
+	require.Nil(t, distributionDistance(nil, []float64{1}))
+	require.Nil(t, distributionDistance([]float64{1}, []float64{}))
+
+	same := distributionDistance([]float64{3, 1, 2}, []float64{1, 2, 3})
+	require.NotNil(t, same)
+	require.Equal(t, float64(0), *same)
+
+	disjoint := distributionDistance([]float64{1, 2, 3}, []float64{10, 11, 12})
+	require.NotNil(t, disjoint)
+	require.Equal(t, float64(1), *disjoint)
+
+	partial := distributionDistance([]float64{1, 2, 3, 4}, []float64{3, 4, 5, 6})
+	require.NotNil(t, partial)
+	require.InDelta(t, 0.5, *partial, 0.0001)
+}
+
+func Test_cardinalityPreservation(t *testing.T) {
+	require.Equal(t, float64(1), cardinalityPreservation(0, 0))
+	require.Equal(t, float64(1), cardinalityPreservation(10, 10))
+	require.Equal(t, float64(0), cardinalityPreservation(10, 0))
+	require.Equal(t, 0.5, cardinalityPreservation(10, 5))
+	require.Equal(t, 0.5, cardinalityPreservation(5, 10))
+}
+
+func Test_referentialValidity(t *testing.T) {
+	require.Equal(t, float64(1), referentialValidity(0, 0))
+	require.Equal(t, float64(1), referentialValidity(0, 10))
+	require.Equal(t, 0.75, referentialValidity(25, 100))
+	require.Equal(t, float64(0), referentialValidity(20, 10))
+}
+
+func Test_qualityScore(t *testing.T) {
+	require.Equal(t, float64(1), qualityScore(nil, nil))
+
+	columns := []*ColumnQuality{
+		{Column: "id", CardinalityPreservation: ptr(1.0)},
+		{Column: "age", DistributionDistance: ptr(0.2), CardinalityPreservation: ptr(0.5)},
+	}
+	require.InDelta(t, (0.8+0.75)/2, qualityScore(columns, nil), 0.0001)
+	require.InDelta(t, (0.8+0.75+0.5)/3, qualityScore(columns, ptr(0.5)), 0.0001)
+}
+
+func ptr[T any](val T) *T {
+	return &val
+}
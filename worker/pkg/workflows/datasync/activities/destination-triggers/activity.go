@@ -0,0 +1,226 @@
+package desttriggers_activity
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	logger_utils "github.com/nucleuscloud/neosync/worker/internal/logger"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"go.temporal.io/sdk/activity"
+)
+
+type DisableDestinationTriggersRequest struct {
+	JobId string
+}
+
+type DisableDestinationTriggersResponse struct {
+	// The triggers that were disabled and must be re-enabled once the sync has finished
+	Destinations []*DestinationTriggers
+}
+
+type EnableDestinationTriggersRequest struct {
+	JobId        string
+	Destinations []*DestinationTriggers
+}
+
+type EnableDestinationTriggersResponse struct{}
+
+type DestinationTriggers struct {
+	ConnectionId string
+	Triggers     []*sql_manager.TableTrigger
+}
+
+type Activity struct {
+	jobclient  mgmtv1alpha1connect.JobServiceClient
+	connclient mgmtv1alpha1connect.ConnectionServiceClient
+	sqlmanager sql_manager.SqlManagerClient
+}
+
+func New(
+	jobclient mgmtv1alpha1connect.JobServiceClient,
+	connclient mgmtv1alpha1connect.ConnectionServiceClient,
+	sqlmanager sql_manager.SqlManagerClient,
+) *Activity {
+	return &Activity{
+		jobclient:  jobclient,
+		connclient: connclient,
+		sqlmanager: sqlmanager,
+	}
+}
+
+// Disables the enabled user triggers on the synced tables of postgres destinations that have opted in.
+// Only triggers that were enabled are returned so that triggers that were already disabled stay that way.
+func (a *Activity) DisableDestinationTriggers(
+	ctx context.Context,
+	req *DisableDestinationTriggersRequest,
+) (*DisableDestinationTriggersResponse, error) {
+	startHeartbeat(ctx)
+	slogger := getLogger(ctx, req.JobId)
+
+	jobResp, err := a.jobclient.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{Id: req.JobId}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get job by id: %w", err)
+	}
+	job := jobResp.Msg.GetJob()
+
+	destinationTables := shared.GetDestinationTablesFromMappings(job.GetMappings())
+	tables := []*sql_manager.SchemaTable{}
+	for tableKey := range shared.GetUniqueTablesMapFromJob(job) {
+		schema, table := shared.SplitTableKey(tableKey)
+		tables = append(tables, shared.GetDestinationTable(destinationTables, schema, table))
+	}
+
+	destinations := []*DestinationTriggers{}
+	for _, destination := range job.GetDestinations() {
+		if !destination.GetOptions().GetPostgresOptions().GetDisableTriggers() {
+			continue
+		}
+		connection, err := shared.GetConnectionById(ctx, a.connclient, destination.GetConnectionId())
+		if err != nil {
+			return nil, fmt.Errorf("unable to get destination connection by id (%s): %w", destination.GetConnectionId(), err)
+		}
+		if connection.GetConnectionConfig().GetPgConfig() == nil {
+			continue
+		}
+		triggers, err := a.disableTriggers(ctx, slogger, connection, tables)
+		if err != nil {
+			// re-enable what was already disabled as the workflow will not know about these destinations
+			if enableErr := a.enableDestinations(ctx, slogger, destinations); enableErr != nil {
+				slogger.Error(fmt.Sprintf("unable to re-enable destination triggers: %s", enableErr.Error()))
+			}
+			return nil, fmt.Errorf("unable to disable triggers for destination (%s): %w", destination.GetConnectionId(), err)
+		}
+		if len(triggers) > 0 {
+			destinations = append(destinations, &DestinationTriggers{ConnectionId: destination.GetConnectionId(), Triggers: triggers})
+		}
+	}
+	return &DisableDestinationTriggersResponse{Destinations: destinations}, nil
+}
+
+// Re-enables triggers that were previously disabled by DisableDestinationTriggers. This is safe to run multiple times.
+func (a *Activity) EnableDestinationTriggers(
+	ctx context.Context,
+	req *EnableDestinationTriggersRequest,
+) (*EnableDestinationTriggersResponse, error) {
+	startHeartbeat(ctx)
+	slogger := getLogger(ctx, req.JobId)
+
+	if err := a.enableDestinations(ctx, slogger, req.Destinations); err != nil {
+		return nil, err
+	}
+	return &EnableDestinationTriggersResponse{}, nil
+}
+
+func (a *Activity) disableTriggers(
+	ctx context.Context,
+	slogger *slog.Logger,
+	connection *mgmtv1alpha1.Connection,
+	tables []*sql_manager.SchemaTable,
+) ([]*sql_manager.TableTrigger, error) {
+	db, err := a.sqlmanager.NewSqlDb(ctx, slogger, connection, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Db.Close()
+	return disableTriggersInDb(ctx, slogger, db.Db, tables)
+}
+
+func (a *Activity) enableDestinations(
+	ctx context.Context,
+	slogger *slog.Logger,
+	destinations []*DestinationTriggers,
+) error {
+	for _, destination := range destinations {
+		connection, err := shared.GetConnectionById(ctx, a.connclient, destination.ConnectionId)
+		if err != nil {
+			return fmt.Errorf("unable to get destination connection by id (%s): %w", destination.ConnectionId, err)
+		}
+		db, err := a.sqlmanager.NewSqlDb(ctx, slogger, connection, nil)
+		if err != nil {
+			return err
+		}
+		err = enableTriggersInDb(ctx, slogger, db.Db, destination.Triggers)
+		db.Db.Close()
+		if err != nil {
+			return fmt.Errorf("unable to enable triggers for destination (%s): %w", destination.ConnectionId, err)
+		}
+	}
+	return nil
+}
+
+func disableTriggersInDb(
+	ctx context.Context,
+	slogger *slog.Logger,
+	db sql_manager.SqlDatabase,
+	tables []*sql_manager.SchemaTable,
+) ([]*sql_manager.TableTrigger, error) {
+	triggers, err := db.GetEnabledTableTriggers(ctx, tables)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve table triggers: %w", err)
+	}
+	disabled := make([]*sql_manager.TableTrigger, 0, len(triggers))
+	for _, trigger := range triggers {
+		if err := db.Exec(ctx, sql_manager.BuildPgDisableTriggerStatement(trigger)); err != nil {
+			if enableErr := enableTriggersInDb(ctx, slogger, db, disabled); enableErr != nil {
+				slogger.Error(fmt.Sprintf("unable to re-enable triggers: %s", enableErr.Error()))
+			}
+			return nil, fmt.Errorf("unable to disable trigger %s on %s.%s: %w", trigger.Name, trigger.Schema, trigger.Table, err)
+		}
+		disabled = append(disabled, trigger)
+	}
+	slogger.Info(fmt.Sprintf("disabled %d triggers", len(disabled)))
+	return disabled, nil
+}
+
+// Attempts to enable every trigger before returning so that a single failure does not leave the rest disabled
+func enableTriggersInDb(
+	ctx context.Context,
+	slogger *slog.Logger,
+	db sql_manager.SqlDatabase,
+	triggers []*sql_manager.TableTrigger,
+) error {
+	var firstErr error
+	enabled := 0
+	for _, trigger := range triggers {
+		if err := db.Exec(ctx, sql_manager.BuildPgEnableTriggerStatement(trigger)); err != nil {
+			slogger.Error(fmt.Sprintf("unable to enable trigger: %s", err.Error()), "schema", trigger.Schema, "table", trigger.Table, "trigger", trigger.Name)
+			if firstErr == nil {
+				firstErr = fmt.Errorf("unable to enable trigger %s on %s.%s: %w", trigger.Name, trigger.Schema, trigger.Table, err)
+			}
+			continue
+		}
+		enabled++
+	}
+	slogger.Info(fmt.Sprintf("enabled %d triggers", enabled))
+	return firstErr
+}
+
+func startHeartbeat(ctx context.Context) {
+	go func() {
+		for {
+			select {
+			case <-time.After(1 * time.Second):
+				activity.RecordHeartbeat(ctx)
+			case <-activity.GetWorkerStopChannel(ctx):
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func getLogger(ctx context.Context, jobId string) *slog.Logger {
+	info := activity.GetInfo(ctx)
+	return logger_utils.NewJsonSLogger().With(
+		"jobId", jobId,
+		"WorkflowID", info.WorkflowExecution.ID,
+		"RunID", info.WorkflowExecution.RunID,
+	)
+}
@@ -0,0 +1,55 @@
+package desttriggers_activity
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_disableTriggersInDb(t *testing.T) {
+	db := sql_manager.NewMockSqlDatabase(t)
+	tables := []*sql_manager.SchemaTable{{Schema: "public", Table: "users"}}
+	triggers := []*sql_manager.TableTrigger{
+		{Schema: "public", Table: "users", Name: "audit_users"},
+		{Schema: "public", Table: "users", Name: "denormalize_users"},
+	}
+	db.On("GetEnabledTableTriggers", mock.Anything, tables).Return(triggers, nil)
+	db.On("Exec", mock.Anything, `ALTER TABLE "public"."users" DISABLE TRIGGER "audit_users";`).Return(nil)
+	db.On("Exec", mock.Anything, `ALTER TABLE "public"."users" DISABLE TRIGGER "denormalize_users";`).Return(nil)
+
+	actual, err := disableTriggersInDb(context.Background(), slog.Default(), db, tables)
+	require.NoError(t, err)
+	require.Equal(t, triggers, actual)
+}
+
+func Test_disableTriggersInDb_ReEnablesOnFailure(t *testing.T) {
+	db := sql_manager.NewMockSqlDatabase(t)
+	tables := []*sql_manager.SchemaTable{{Schema: "public", Table: "users"}}
+	db.On("GetEnabledTableTriggers", mock.Anything, tables).Return([]*sql_manager.TableTrigger{
+		{Schema: "public", Table: "users", Name: "audit_users"},
+		{Schema: "public", Table: "users", Name: "denormalize_users"},
+	}, nil)
+	db.On("Exec", mock.Anything, `ALTER TABLE "public"."users" DISABLE TRIGGER "audit_users";`).Return(nil)
+	db.On("Exec", mock.Anything, `ALTER TABLE "public"."users" DISABLE TRIGGER "denormalize_users";`).Return(errors.New("permission denied"))
+	db.On("Exec", mock.Anything, `ALTER TABLE "public"."users" ENABLE TRIGGER "audit_users";`).Return(nil)
+
+	_, err := disableTriggersInDb(context.Background(), slog.Default(), db, tables)
+	require.Error(t, err)
+}
+
+func Test_enableTriggersInDb_ContinuesOnFailure(t *testing.T) {
+	db := sql_manager.NewMockSqlDatabase(t)
+	db.On("Exec", mock.Anything, `ALTER TABLE "public"."users" ENABLE TRIGGER "audit_users";`).Return(errors.New("boom"))
+	db.On("Exec", mock.Anything, `ALTER TABLE "public"."orders" ENABLE TRIGGER "audit_orders";`).Return(nil)
+
+	err := enableTriggersInDb(context.Background(), slog.Default(), db, []*sql_manager.TableTrigger{
+		{Schema: "public", Table: "users", Name: "audit_users"},
+		{Schema: "public", Table: "orders", Name: "audit_orders"},
+	})
+	require.ErrorContains(t, err, "audit_users")
+}
@@ -0,0 +1,254 @@
+package emitrunevent_activity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	logger_utils "github.com/nucleuscloud/neosync/worker/internal/logger"
+	"github.com/nucleuscloud/neosync/worker/internal/openlineage"
+	"github.com/nucleuscloud/neosync/worker/internal/telemetryexport"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	sync_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync"
+	"go.temporal.io/sdk/activity"
+)
+
+type EmitRunEventRequest struct {
+	JobId     string
+	EventType openlineage.EventType
+	// The point in the run the event describes. Set by the workflow so that retried activities report the same time
+	EventTime time.Time
+	// Only set for failed runs
+	ErrorMessage string
+	// The rows written by each sync. Only set for completed runs
+	RowCounts []*sync_activity.TableRowCounts
+}
+
+type EmitRunEventResponse struct{}
+
+type Activity struct {
+	jobclient  mgmtv1alpha1connect.JobServiceClient
+	connclient mgmtv1alpha1connect.ConnectionServiceClient
+	lineage    *openlineage.Client
+	telemetry  *telemetryexport.Exporter
+}
+
+func New(
+	jobclient mgmtv1alpha1connect.JobServiceClient,
+	connclient mgmtv1alpha1connect.ConnectionServiceClient,
+	lineage *openlineage.Client,
+	telemetry *telemetryexport.Exporter,
+) *Activity {
+	return &Activity{
+		jobclient:  jobclient,
+		connclient: connclient,
+		lineage:    lineage,
+		telemetry:  telemetry,
+	}
+}
+
+// Reports the state of the run to the configured OpenLineage backend, along with the tables it reads and writes,
+// and to the configured Datadog or Splunk forwarders. A no-op if the worker has been configured with neither
+func (a *Activity) EmitRunEvent(
+	ctx context.Context,
+	req *EmitRunEventRequest,
+) (*EmitRunEventResponse, error) {
+	info := activity.GetInfo(ctx)
+	loggerKeyVals := []any{
+		"jobId", req.JobId,
+		"WorkflowID", info.WorkflowExecution.ID,
+		"RunID", info.WorkflowExecution.RunID,
+	}
+
+	if !a.lineage.IsEnabled() && !a.telemetry.IsEnabled() {
+		return &EmitRunEventResponse{}, nil
+	}
+	slogger := logger_utils.NewJsonSLogger().With(loggerKeyVals...)
+
+	jobResp, err := a.jobclient.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{Id: req.JobId}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get job by id: %w", err)
+	}
+	job := jobResp.Msg.GetJob()
+
+	var errs error
+	if a.lineage.IsEnabled() {
+		errs = errors.Join(errs, a.emitLineageEvent(ctx, req, job, info.WorkflowExecution.ID, info.WorkflowExecution.RunID, slogger))
+	}
+	if a.telemetry.IsEnabled() {
+		slogger.Debug("forwarding run event", "eventType", req.EventType)
+		if err := a.telemetry.SendEvent(ctx, getTelemetryEvent(req, job, info.WorkflowExecution.ID)); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("unable to forward run event: %w", err))
+		}
+	}
+	if errs != nil {
+		return nil, errs
+	}
+	return &EmitRunEventResponse{}, nil
+}
+
+func (a *Activity) emitLineageEvent(
+	ctx context.Context,
+	req *EmitRunEventRequest,
+	job *mgmtv1alpha1.Job,
+	jobRunId string,
+	runId string,
+	slogger *slog.Logger,
+) error {
+	// the temporal run id is used as it is a uuid, which OpenLineage requires of run ids
+	run := &openlineage.Run{RunId: runId}
+	if req.ErrorMessage != "" {
+		run.Facets = &openlineage.RunFacets{ErrorMessage: openlineage.NewErrorMessageRunFacet(req.ErrorMessage)}
+	}
+	event := openlineage.NewRunEvent(req.EventType, req.EventTime, run, &openlineage.Job{
+		Namespace: a.lineage.Namespace(),
+		Name:      job.GetName(),
+		Facets:    &openlineage.JobFacets{JobType: openlineage.NewJobTypeJobFacet()},
+	})
+
+	tables := getMappedTables(job.GetMappings())
+	inputs, err := a.getInputDatasets(ctx, job.GetSource(), tables, slogger)
+	if err != nil {
+		return err
+	}
+	event.Inputs = append(event.Inputs, inputs...)
+
+	destinationTables := shared.GetDestinationTablesFromMappings(job.GetMappings())
+	destinationColumns := shared.GetDestinationColumnsFromMappings(job.GetMappings())
+	for _, destination := range job.GetDestinations() {
+		connection, err := shared.GetConnectionById(ctx, a.connclient, destination.GetConnectionId())
+		if err != nil {
+			return fmt.Errorf("unable to get destination connection by id (%s): %w", destination.GetConnectionId(), err)
+		}
+		for _, table := range tables {
+			destTable := shared.GetDestinationTable(destinationTables, table.Schema, table.Table)
+			tableKey := sql_manager.BuildTable(table.Schema, table.Table)
+			dataset := getDataset(connection, destTable.Schema, destTable.Table, jobRunId)
+			if dataset == nil {
+				continue
+			}
+			dataset.Facets = &openlineage.DatasetFacets{Schema: openlineage.NewSchemaDatasetFacet(
+				toSchemaFields(getDestinationColumns(destinationColumns[tableKey], table.Columns)),
+			)}
+			if rowsWritten, ok := getRowsWritten(req.RowCounts, table.Schema, table.Table, destination.GetConnectionId()); ok {
+				dataset.OutputFacets = &openlineage.OutputDatasetFacets{
+					OutputStatistics: openlineage.NewOutputStatisticsOutputDatasetFacet(rowsWritten),
+				}
+			}
+			event.Outputs = append(event.Outputs, dataset)
+		}
+	}
+
+	slogger.Debug("emitting openlineage event", "eventType", req.EventType, "inputs", len(event.Inputs), "outputs", len(event.Outputs))
+	if err := a.lineage.Emit(ctx, event); err != nil {
+		return fmt.Errorf("unable to emit openlineage event: %w", err)
+	}
+	return nil
+}
+
+// Generated sources do not read any tables, so they have no inputs
+func (a *Activity) getInputDatasets(
+	ctx context.Context,
+	source *mgmtv1alpha1.JobSource,
+	tables []*mappedTable,
+	slogger *slog.Logger,
+) ([]*openlineage.Dataset, error) {
+	var s3RunId string
+	switch cfg := source.GetOptions().GetConfig().(type) {
+	case *mgmtv1alpha1.JobSourceOptions_Postgres, *mgmtv1alpha1.JobSourceOptions_Mysql,
+		*mgmtv1alpha1.JobSourceOptions_Synthesize, *mgmtv1alpha1.JobSourceOptions_Custom,
+		*mgmtv1alpha1.JobSourceOptions_SnapshotClone, *mgmtv1alpha1.JobSourceOptions_InPlaceMask:
+	case *mgmtv1alpha1.JobSourceOptions_AwsS3:
+		s3RunId = cfg.AwsS3.GetJobRunId()
+		if s3RunId == "" {
+			slogger.Debug("s3 source restores a snapshot, leaving out its input datasets")
+			return nil, nil
+		}
+	default:
+		return nil, nil
+	}
+	connection, err := shared.GetJobSourceConnection(ctx, source, a.connclient)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs := []*openlineage.Dataset{}
+	for _, table := range tables {
+		dataset := getDataset(connection, table.Schema, table.Table, s3RunId)
+		if dataset == nil {
+			continue
+		}
+		dataset.Facets = &openlineage.DatasetFacets{Schema: openlineage.NewSchemaDatasetFacet(toSchemaFields(table.Columns))}
+		inputs = append(inputs, dataset)
+	}
+	return inputs, nil
+}
+
+type mappedTable struct {
+	Schema  string
+	Table   string
+	Columns []string
+}
+
+// Returns the tables of the job mappings with their columns, in the order they first appear
+func getMappedTables(mappings []*mgmtv1alpha1.JobMapping) []*mappedTable {
+	tables := []*mappedTable{}
+	tablesByKey := map[string]*mappedTable{}
+	for _, mapping := range mappings {
+		key := sql_manager.BuildTable(mapping.GetSchema(), mapping.GetTable())
+		table, ok := tablesByKey[key]
+		if !ok {
+			table = &mappedTable{Schema: mapping.GetSchema(), Table: mapping.GetTable()}
+			tablesByKey[key] = table
+			tables = append(tables, table)
+		}
+		table.Columns = append(table.Columns, mapping.GetColumn())
+	}
+	return tables
+}
+
+// Returns the names of the columns that are written to the destination, leaving out dropped columns
+func getDestinationColumns(tableDestinationColumns map[string]*sql_manager.DestinationColumn, columns []string) []string {
+	written := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if dest, ok := tableDestinationColumns[column]; ok && dest.Exclude {
+			continue
+		}
+		written = append(written, column)
+	}
+	return shared.GetDestinationColumnNames(tableDestinationColumns, written)
+}
+
+func toSchemaFields(columns []string) []*openlineage.SchemaDatasetFacetField {
+	fields := make([]*openlineage.SchemaDatasetFacetField, 0, len(columns))
+	for _, column := range columns {
+		fields = append(fields, &openlineage.SchemaDatasetFacetField{Name: column})
+	}
+	return fields
+}
+
+// Returns the rows the run wrote to the table in the destination connection.
+// Outputs that are not resolved by connection, such as AWS S3, are not counted
+func getRowsWritten(rowCounts []*sync_activity.TableRowCounts, schema, table, connectionId string) (int64, bool) {
+	var rowsWritten int64
+	found := false
+	for _, counts := range rowCounts {
+		if counts.Schema != schema || counts.Table != table {
+			continue
+		}
+		for _, output := range counts.Outputs {
+			if output.ConnectionId != connectionId {
+				continue
+			}
+			rowsWritten += output.RowsWritten
+			found = true
+		}
+	}
+	return rowsWritten, found
+}
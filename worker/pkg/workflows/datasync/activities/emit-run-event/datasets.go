@@ -0,0 +1,115 @@
+package emitrunevent_activity
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/internal/benthos"
+	"github.com/nucleuscloud/neosync/worker/internal/openlineage"
+)
+
+const (
+	defaultPostgresPort = 5432
+	defaultMysqlPort    = 3306
+	defaultRedshiftPort = 5439
+	defaultMssqlPort    = 1433
+)
+
+// Returns the dataset a table is stored as in the connection, named following https://openlineage.io/docs/spec/naming.
+// Tables of connections that have no naming convention are namespaced by the connection id.
+// The run id is only used by AWS S3 connections, whose tables are stored under the run that wrote them. Returns nil if the table can not be named
+func getDataset(connection *mgmtv1alpha1.Connection, schema, table, s3RunId string) *openlineage.Dataset {
+	switch cfg := connection.GetConnectionConfig().GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
+		var host, database string
+		switch conn := cfg.PgConfig.GetConnectionConfig().(type) {
+		case *mgmtv1alpha1.PostgresConnectionConfig_Connection:
+			host = joinHostPort(conn.Connection.GetHost(), conn.Connection.GetPort(), defaultPostgresPort)
+			database = conn.Connection.GetName()
+		case *mgmtv1alpha1.PostgresConnectionConfig_Url:
+			host, database = parseConnectionUrl(conn.Url, defaultPostgresPort)
+		}
+		if host == "" {
+			break
+		}
+		return &openlineage.Dataset{Namespace: fmt.Sprintf("postgres://%s", host), Name: strings.Join([]string{database, schema, table}, ".")}
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+		var host string
+		switch conn := cfg.MysqlConfig.GetConnectionConfig().(type) {
+		case *mgmtv1alpha1.MysqlConnectionConfig_Connection:
+			host = joinHostPort(conn.Connection.GetHost(), conn.Connection.GetPort(), defaultMysqlPort)
+		case *mgmtv1alpha1.MysqlConnectionConfig_Url:
+			host, _ = parseConnectionUrl(conn.Url, defaultMysqlPort)
+		}
+		if host == "" {
+			break
+		}
+		// mysql schemas are databases
+		return &openlineage.Dataset{Namespace: fmt.Sprintf("mysql://%s", host), Name: fmt.Sprintf("%s.%s", schema, table)}
+	case *mgmtv1alpha1.ConnectionConfig_RedshiftConfig:
+		host := joinHostPort(cfg.RedshiftConfig.GetHost(), cfg.RedshiftConfig.GetPort(), defaultRedshiftPort)
+		return &openlineage.Dataset{Namespace: fmt.Sprintf("redshift://%s", host), Name: strings.Join([]string{cfg.RedshiftConfig.GetName(), schema, table}, ".")}
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		host := joinHostPort(cfg.MssqlConfig.GetHost(), cfg.MssqlConfig.GetPort(), defaultMssqlPort)
+		return &openlineage.Dataset{Namespace: fmt.Sprintf("sqlserver://%s", host), Name: strings.Join([]string{cfg.MssqlConfig.GetName(), schema, table}, ".")}
+	case *mgmtv1alpha1.ConnectionConfig_SnowflakeConfig:
+		return &openlineage.Dataset{
+			Namespace: fmt.Sprintf("snowflake://%s", cfg.SnowflakeConfig.GetAccount()),
+			Name:      strings.Join([]string{cfg.SnowflakeConfig.GetDatabase(), schema, table}, "."),
+		}
+	case *mgmtv1alpha1.ConnectionConfig_BigqueryConfig:
+		return &openlineage.Dataset{Namespace: "bigquery", Name: strings.Join([]string{cfg.BigqueryConfig.GetProjectId(), schema, table}, ".")}
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		return &openlineage.Dataset{Namespace: fmt.Sprintf("file://%s", cfg.SqliteConfig.GetPath()), Name: table}
+	case *mgmtv1alpha1.ConnectionConfig_DuckdbConfig:
+		// tables are read from and written to the parquet directory when there is one
+		if dir := cfg.DuckdbConfig.GetParquetDirectory(); dir != "" {
+			return &openlineage.Dataset{Namespace: fmt.Sprintf("file://%s", dir), Name: fmt.Sprintf("%s/%s", schema, table)}
+		}
+		if cfg.DuckdbConfig.GetPath() == "" {
+			break
+		}
+		return &openlineage.Dataset{Namespace: fmt.Sprintf("file://%s", cfg.DuckdbConfig.GetPath()), Name: fmt.Sprintf("%s.%s", schema, table)}
+	case *mgmtv1alpha1.ConnectionConfig_AwsS3Config:
+		if s3RunId == "" {
+			return nil
+		}
+		pieces := []string{}
+		if prefix := strings.Trim(cfg.AwsS3Config.GetPathPrefix(), "/"); prefix != "" {
+			pieces = append(pieces, prefix)
+		}
+		pieces = append(pieces, "workflows", s3RunId, "activities", neosync_benthos.BuildBenthosTable(schema, table), "data")
+		return &openlineage.Dataset{Namespace: fmt.Sprintf("s3://%s", cfg.AwsS3Config.GetBucket()), Name: strings.Join(pieces, "/")}
+	case *mgmtv1alpha1.ConnectionConfig_KafkaConfig:
+		// every table is written to the same topic
+		if len(cfg.KafkaConfig.GetBrokers()) == 0 {
+			return nil
+		}
+		return &openlineage.Dataset{Namespace: fmt.Sprintf("kafka://%s", cfg.KafkaConfig.GetBrokers()[0]), Name: cfg.KafkaConfig.GetTopic()}
+	}
+	return &openlineage.Dataset{Namespace: fmt.Sprintf("neosync://%s", connection.GetId()), Name: fmt.Sprintf("%s.%s", schema, table)}
+}
+
+func joinHostPort(host string, port, defaultPort int32) string {
+	if port == 0 {
+		port = defaultPort
+	}
+	return net.JoinHostPort(host, strconv.Itoa(int(port)))
+}
+
+// Returns the host and database of a connection url, or nothing if it is not a url, such as a mysql dsn
+func parseConnectionUrl(connectionUrl string, defaultPort int32) (host, database string) {
+	u, err := url.Parse(connectionUrl)
+	if err != nil || u.Hostname() == "" {
+		return "", ""
+	}
+	port := defaultPort
+	if p, err := strconv.ParseInt(u.Port(), 10, 32); err == nil {
+		port = int32(p)
+	}
+	return joinHostPort(u.Hostname(), port, defaultPort), strings.TrimPrefix(u.Path, "/")
+}
@@ -0,0 +1,59 @@
+package emitrunevent_activity
+
+import (
+	"fmt"
+	"strings"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/worker/internal/openlineage"
+	"github.com/nucleuscloud/neosync/worker/internal/telemetryexport"
+)
+
+// Returns the run event that is forwarded to Datadog or Splunk. Events of the same run share an aggregation key
+// so that they are grouped together
+func getTelemetryEvent(req *EmitRunEventRequest, job *mgmtv1alpha1.Job, jobRunId string) *telemetryexport.Event {
+	var title string
+	var alertType telemetryexport.EventAlertType
+	text := []string{fmt.Sprintf("Job run %s", jobRunId)}
+	switch req.EventType {
+	case openlineage.EventTypeStart:
+		title = fmt.Sprintf("Job run started: %s", job.GetName())
+		alertType = telemetryexport.EventAlertTypeInfo
+	case openlineage.EventTypeComplete:
+		title = fmt.Sprintf("Job run completed: %s", job.GetName())
+		alertType = telemetryexport.EventAlertTypeSuccess
+		var rowsRead, rowsWritten int64
+		for _, counts := range req.RowCounts {
+			rowsRead += counts.RowsRead
+			for _, output := range counts.Outputs {
+				rowsWritten += output.RowsWritten
+			}
+		}
+		text = append(text, fmt.Sprintf("Synced %d tables, reading %d rows and writing %d rows", len(req.RowCounts), rowsRead, rowsWritten))
+	case openlineage.EventTypeFail:
+		title = fmt.Sprintf("Job run failed: %s", job.GetName())
+		alertType = telemetryexport.EventAlertTypeError
+	case openlineage.EventTypeAbort:
+		title = fmt.Sprintf("Job run canceled: %s", job.GetName())
+		alertType = telemetryexport.EventAlertTypeWarning
+	default:
+		title = fmt.Sprintf("Job run %s: %s", strings.ToLower(string(req.EventType)), job.GetName())
+		alertType = telemetryexport.EventAlertTypeInfo
+	}
+	if req.ErrorMessage != "" {
+		text = append(text, req.ErrorMessage)
+	}
+	return &telemetryexport.Event{
+		Title:          title,
+		Text:           strings.Join(text, "\n"),
+		AlertType:      alertType,
+		Time:           req.EventTime,
+		AggregationKey: jobRunId,
+		Tags: map[string]string{
+			"job_id":     job.GetId(),
+			"job_name":   job.GetName(),
+			"job_run_id": jobRunId,
+			"status":     strings.ToLower(string(req.EventType)),
+		},
+	}
+}
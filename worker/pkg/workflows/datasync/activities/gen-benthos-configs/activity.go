@@ -22,9 +22,15 @@ import (
 type GenerateBenthosConfigsRequest struct {
 	JobId      string
 	WorkflowId string
+	// The connection of the instance restored for a snapshot clone job, whose rows are masked in place
+	RestoredConnectionId string
 }
 type GenerateBenthosConfigsResponse struct {
 	BenthosConfigs []*BenthosConfigResponse
+	// Set for snapshot clone jobs when no restored connection was provided. The snapshot must be restored before the configs can be built
+	RequiresSnapshotRestore bool
+	// Set for in place mask jobs with the batches that each table is updated in
+	InPlaceMask *InPlaceMaskPlan
 }
 
 type BenthosRedisConfig struct {
@@ -47,7 +53,8 @@ type BenthosConfigResponse struct {
 	BenthosDsns []*shared.BenthosDsn
 	RedisConfig []*BenthosRedisConfig
 
-	primaryKeys []string
+	primaryKeys    []string
+	sqlExpressions map[string]string // column -> sql expression evaluated by sql destinations
 
 	metriclabels metrics.MetricLabels
 }
@@ -126,6 +133,7 @@ func (a *Activity) GenerateBenthosConfigs(
 		a.connclient,
 		a.transformerclient,
 		req.JobId,
+		info.WorkflowExecution.ID,
 		info.WorkflowExecution.RunID,
 		a.redisConfig,
 		a.metricsEnabled,
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"log/slog"
+	"strings"
 
 	"connectrpc.com/connect"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
@@ -12,8 +14,10 @@ import (
 	"github.com/nucleuscloud/neosync/backend/pkg/metrics"
 	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
 	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
+	tableprocessors "github.com/nucleuscloud/neosync/backend/pkg/table-processors"
 	neosync_benthos "github.com/nucleuscloud/neosync/worker/internal/benthos"
 	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 const (
@@ -23,6 +27,10 @@ const (
 	jobmappingSubsetErrMsg     = "job mappings are not equal to or a subset of the database schema found in the source connection"
 	haltOnSchemaAdditionErrMsg = "job mappings does not contain a column mapping for all " +
 		"columns found in the source connection for the selected schemas and tables"
+	// the most rows buffered for a single insert, the output sizes its statements adaptively up to this limit
+	maxInsertBatchCount = 1000
+	// the name of the artifact that records the job configuration the run was started with
+	jobConfigArtifactName = "job-config.json"
 )
 
 type benthosBuilder struct {
@@ -32,8 +40,9 @@ type benthosBuilder struct {
 	connclient        mgmtv1alpha1connect.ConnectionServiceClient
 	transformerclient mgmtv1alpha1connect.TransformersServiceClient
 
-	jobId string
-	runId string
+	jobId      string
+	workflowId string
+	runId      string
 
 	redisConfig *shared.RedisConfig
 
@@ -47,7 +56,7 @@ func newBenthosBuilder(
 	connclient mgmtv1alpha1connect.ConnectionServiceClient,
 	transformerclient mgmtv1alpha1connect.TransformersServiceClient,
 
-	jobId, runId string,
+	jobId, workflowId, runId string,
 
 	redisConfig *shared.RedisConfig,
 
@@ -59,6 +68,7 @@ func newBenthosBuilder(
 		connclient:        connclient,
 		transformerclient: transformerclient,
 		jobId:             jobId,
+		workflowId:        workflowId,
 		runId:             runId,
 		redisConfig:       redisConfig,
 		metricsEnabled:    metricsEnabled,
@@ -74,13 +84,22 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 	if err != nil {
 		return nil, fmt.Errorf("unable to get job by id: %w", err)
 	}
+	parameters, err := b.getJobRunParameters(ctx, job)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get job run parameters: %w", err)
+	}
+	job, err = applyJobParameters(job, parameters)
+	if err != nil {
+		return nil, fmt.Errorf("unable to apply job run parameters: %w", err)
+	}
 	responses := []*BenthosConfigResponse{}
 
 	// reverse of table dependency
 	// map of foreign key to source table + column
 	var primaryKeyToForeignKeysMap map[string]map[string][]*referenceKey            // schema.table -> column -> ForeignKey
 	var colTransformerMap map[string]map[string]*mgmtv1alpha1.JobMappingTransformer // schema.table -> column -> transformer
-	var aiGroupedTableCols map[string][]string                                      // map of table key to columns for AI Generated schemas
+	var aiGroupedTableCols map[string][]string                                      // map of table key to columns for AI Generated and Synthesized schemas
+	var inPlaceMask *InPlaceMaskPlan
 
 	switch job.Source.Options.Config.(type) {
 	case *mgmtv1alpha1.JobSourceOptions_AiGenerate:
@@ -90,12 +109,47 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 		}
 		aiGroupedTableCols = aimappings
 		responses = append(responses, sourceResponses...)
+	case *mgmtv1alpha1.JobSourceOptions_Synthesize:
+		sourceResponses, synthmappings, err := b.getSynthesizeBenthosConfigResponses(ctx, job, slogger)
+		if err != nil {
+			return nil, fmt.Errorf("unable to build benthos Synthesize source config responses: %w", err)
+		}
+		aiGroupedTableCols = synthmappings
+		responses = append(responses, sourceResponses...)
 	case *mgmtv1alpha1.JobSourceOptions_Generate:
 		sourceResponses, err := b.getGenerateBenthosConfigResponses(ctx, job)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build benthos Generate source config responses: %w", err)
 		}
 		responses = append(responses, sourceResponses...)
+	case *mgmtv1alpha1.JobSourceOptions_AwsS3:
+		sourceResponses, err := b.getAwsS3RestoreBenthosConfigResponses(ctx, job, slogger)
+		if err != nil {
+			return nil, fmt.Errorf("unable to build benthos AWS S3 restore source config responses: %w", err)
+		}
+		responses = append(responses, sourceResponses...)
+	case *mgmtv1alpha1.JobSourceOptions_Custom:
+		sourceResponses, err := b.getCustomSourceBenthosConfigResponses(ctx, job, slogger)
+		if err != nil {
+			return nil, fmt.Errorf("unable to build benthos custom source config responses: %w", err)
+		}
+		responses = append(responses, sourceResponses...)
+	case *mgmtv1alpha1.JobSourceOptions_SnapshotClone:
+		if req.RestoredConnectionId == "" {
+			return &GenerateBenthosConfigsResponse{RequiresSnapshotRestore: true}, nil
+		}
+		sourceResponses, err := b.getSnapshotCloneBenthosConfigResponses(ctx, job, req.RestoredConnectionId, slogger)
+		if err != nil {
+			return nil, fmt.Errorf("unable to build benthos snapshot clone config responses: %w", err)
+		}
+		responses = append(responses, sourceResponses...)
+	case *mgmtv1alpha1.JobSourceOptions_InPlaceMask:
+		sourceResponses, plan, err := b.getInPlaceMaskBenthosConfigResponses(ctx, job, slogger)
+		if err != nil {
+			return nil, fmt.Errorf("unable to build benthos in place mask config responses: %w", err)
+		}
+		responses = append(responses, sourceResponses...)
+		inPlaceMask = plan
 	case *mgmtv1alpha1.JobSourceOptions_Postgres, *mgmtv1alpha1.JobSourceOptions_Mysql:
 		resp, err := b.getSqlSyncBenthosConfigResponses(ctx, job, slogger)
 		if err != nil {
@@ -108,11 +162,24 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 		return nil, errors.New("unsupported job source")
 	}
 
+	if err := addTableProcessors(responses, job.GetTableProcessors()); err != nil {
+		return nil, err
+	}
+	if err := b.addJavascriptRuntimeConfigs(ctx, responses, job.GetAccountId()); err != nil {
+		return nil, err
+	}
+
+	destinationTables := shared.GetDestinationTablesFromMappings(job.GetMappings())
+	destinationColumns := shared.GetDestinationColumnsFromMappings(job.GetMappings())
 	for destIdx, destination := range job.Destinations {
 		destinationConnection, err := shared.GetConnectionById(ctx, b.connclient, destination.ConnectionId)
 		if err != nil {
 			return nil, fmt.Errorf("unable to get destination connection (%s) by id: %w", destination.ConnectionId, err)
 		}
+		destinationConnection, err = applyConnectionParameters(destinationConnection, parameters)
+		if err != nil {
+			return nil, fmt.Errorf("unable to apply job run parameters to destination connection (%s): %w", destination.ConnectionId, err)
+		}
 		for _, resp := range responses {
 			dstEnvVarKey := fmt.Sprintf("DESTINATION_%d_CONNECTION_DSN", destIdx)
 			dsn := fmt.Sprintf("${%s}", dstEnvVarKey)
@@ -124,20 +191,22 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 					return nil, err
 				}
 				resp.BenthosDsns = append(resp.BenthosDsns, &shared.BenthosDsn{EnvVarKey: dstEnvVarKey, ConnectionId: destinationConnection.Id})
-				if resp.Config.Input.SqlSelect != nil || resp.Config.Input.PooledSqlRaw != nil {
-					// SQL sync output
-					outputs, err := b.getSqlSyncBenthosOutput(driver, destination, resp, dsn, primaryKeyToForeignKeysMap, colTransformerMap)
+				destTable := shared.GetDestinationTable(destinationTables, resp.TableSchema, resp.TableName)
+				destColumns := destinationColumns[neosync_benthos.BuildBenthosTable(resp.TableSchema, resp.TableName)]
+				if resp.Config.Input.SqlSelect != nil || resp.Config.Input.PooledSqlRaw != nil || resp.Config.Input.NeosyncConnectionData != nil || resp.Config.Input.NeosyncPlugin != nil {
+					// SQL sync, AWS S3 restore, and custom source output
+					outputs, err := b.getSqlSyncBenthosOutput(driver, destination, destTable, destColumns, resp, dsn, primaryKeyToForeignKeysMap, colTransformerMap)
 					if err != nil {
 						return nil, err
 					}
 					resp.Config.Output.Broker.Outputs = append(resp.Config.Output.Broker.Outputs, outputs...)
 				} else if resp.Config.Input.Generate != nil {
 					// SQL generate output
-					outputs := b.getSqlGenerateOutput(driver, resp, destination, dsn)
+					outputs := b.getSqlGenerateOutput(driver, resp, destination, destTable, destColumns, dsn)
 					resp.Config.Output.Broker.Outputs = append(resp.Config.Output.Broker.Outputs, outputs...)
-				} else if resp.Config.Input.OpenAiGenerate != nil {
-					// SQL AI generate output
-					outputs, err := b.getSqlAiGenerateOutput(driver, resp, destination, dsn, aiGroupedTableCols)
+				} else if resp.Config.Input.OpenAiGenerate != nil || resp.Config.Input.SynthesizeGenerate != nil {
+					// SQL AI generate and synthesize output
+					outputs, err := b.getSqlAiGenerateOutput(driver, resp, destination, destTable, destColumns, dsn, aiGroupedTableCols)
 					if err != nil {
 						return nil, err
 					}
@@ -149,7 +218,29 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 				if resp.RunType == tabledependency.RunTypeUpdate {
 					continue
 				}
-				outputs := b.getAwsS3SyncBenthosOutput(connection, resp, req.WorkflowId)
+				outputs := b.getAwsS3SyncBenthosOutput(connection, destination, resp, req.WorkflowId)
+				resp.Config.Output.Broker.Outputs = append(resp.Config.Output.Broker.Outputs, outputs...)
+			case *mgmtv1alpha1.ConnectionConfig_KafkaConfig:
+				if resp.RunType == tabledependency.RunTypeUpdate {
+					continue
+				}
+				outputs := getKafkaSyncBenthosOutput(connection, destination, resp)
+				resp.Config.Output.Broker.Outputs = append(resp.Config.Output.Broker.Outputs, outputs...)
+			case *mgmtv1alpha1.ConnectionConfig_CustomConfig:
+				if resp.RunType == tabledependency.RunTypeUpdate {
+					continue
+				}
+				destTable := shared.GetDestinationTable(destinationTables, resp.TableSchema, resp.TableName)
+				destColumns := destinationColumns[neosync_benthos.BuildBenthosTable(resp.TableSchema, resp.TableName)]
+				outputs := getCustomSinkBenthosOutput(connection, resp, destTable, destColumns)
+				resp.Config.Output.Broker.Outputs = append(resp.Config.Output.Broker.Outputs, outputs...)
+			case *mgmtv1alpha1.ConnectionConfig_DuckdbConfig:
+				if resp.RunType == tabledependency.RunTypeUpdate {
+					continue
+				}
+				destTable := shared.GetDestinationTable(destinationTables, resp.TableSchema, resp.TableName)
+				destColumns := destinationColumns[neosync_benthos.BuildBenthosTable(resp.TableSchema, resp.TableName)]
+				outputs := getDuckdbSyncBenthosOutput(connection, resp, destTable, destColumns)
 				resp.Config.Output.Broker.Outputs = append(resp.Config.Output.Broker.Outputs, outputs...)
 			default:
 				return nil, fmt.Errorf("unsupported destination connection config")
@@ -157,6 +248,8 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 		}
 	}
 
+	batchJavascriptInputs(responses)
+
 	if b.metricsEnabled {
 		labels := metrics.MetricLabels{
 			metrics.NewEqLabel(metrics.AccountIdLabel, job.AccountId),
@@ -173,9 +266,12 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 		}
 	}
 
+	b.attachJobConfigArtifact(ctx, slogger, job)
+
 	slogger.Info(fmt.Sprintf("successfully built %d benthos configs", len(responses)))
 	return &GenerateBenthosConfigsResponse{
 		BenthosConfigs: responses,
+		InPlaceMask:    inPlaceMask,
 	}, nil
 }
 
@@ -193,10 +289,160 @@ func (b *benthosBuilder) getJobById(
 	return getjobResp.Msg.Job, nil
 }
 
+// Attaches the job configuration, with its parameters applied, to the job run so that it can later be reported on.
+// This is best effort as artifact storage is optional and should never fail the run
+func (b *benthosBuilder) attachJobConfigArtifact(
+	ctx context.Context,
+	slogger *slog.Logger,
+	job *mgmtv1alpha1.Job,
+) {
+	content, err := protojson.Marshal(job)
+	if err != nil {
+		slogger.Warn(fmt.Sprintf("unable to marshal job config artifact: %s", err.Error()))
+		return
+	}
+	_, err = b.jobclient.CreateJobRunArtifact(ctx, connect.NewRequest(&mgmtv1alpha1.CreateJobRunArtifactRequest{
+		AccountId:   job.GetAccountId(),
+		JobRunId:    b.workflowId,
+		Type:        mgmtv1alpha1.JobRunArtifactType_JOB_RUN_ARTIFACT_TYPE_JOB_CONFIG,
+		Name:        jobConfigArtifactName,
+		ContentType: "application/json",
+		Content:     content,
+	}))
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeUnimplemented {
+			slogger.Debug("skipping job config artifact as job run artifacts are not enabled")
+			return
+		}
+		slogger.Warn(fmt.Sprintf("unable to attach job config artifact to job run: %s", err.Error()))
+	}
+}
+
 func hasTransformer(t mgmtv1alpha1.TransformerSource) bool {
 	return t != mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_UNSPECIFIED && t != mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH
 }
 
+// Appends the user provided processors to the table's pipeline so that they run after all of the column transformers.
+// Only the allowed processors may be used, and each one must pass the Benthos linter
+func addTableProcessors(responses []*BenthosConfigResponse, tableProcessors []*mgmtv1alpha1.JobTableProcessor) error {
+	processorsByTable := map[string][]*neosync_benthos.ProcessorConfig{}
+	for _, tp := range tableProcessors {
+		// verified again as the job may have been saved before a processor was disallowed
+		configs, err := tableprocessors.Parse(tp.GetProcessors())
+		if err != nil {
+			return fmt.Errorf("invalid table processors for %s.%s: %w", tp.GetSchema(), tp.GetTable(), err)
+		}
+		key := neosync_benthos.BuildBenthosTable(tp.GetSchema(), tp.GetTable())
+		for _, config := range configs {
+			processorsByTable[key] = append(processorsByTable[key], &neosync_benthos.ProcessorConfig{Raw: config})
+		}
+	}
+	if len(processorsByTable) == 0 {
+		return nil
+	}
+
+	for _, resp := range responses {
+		if resp.RunType == tabledependency.RunTypeUpdate {
+			// the rows have already been processed by the insert
+			continue
+		}
+		processors, ok := processorsByTable[neosync_benthos.BuildBenthosTable(resp.TableSchema, resp.TableName)]
+		if !ok {
+			continue
+		}
+		if len(resp.Processors) > 0 {
+			// generate jobs run their processors as part of the output
+			resp.Processors = append(resp.Processors, processors...)
+			continue
+		}
+		for _, pc := range processors {
+			resp.Config.StreamConfig.Pipeline.Processors = append(resp.Config.StreamConfig.Pipeline.Processors, *pc)
+		}
+	}
+	return nil
+}
+
+// the longest the input waits to fill a batch for the batched javascript transformers
+const javascriptBatchPeriod = "1s"
+
+// Batches the rows that are read for the tables that have batched javascript transformers, as the input otherwise
+// sends rows through the pipeline one at a time. Must run after the outputs are built, as they are chosen by the type of input
+func batchJavascriptInputs(responses []*BenthosConfigResponse) {
+	for _, resp := range responses {
+		if resp.Config == nil || resp.Config.Input == nil || resp.Config.StreamConfig.Pipeline == nil {
+			continue
+		}
+		batchSize := 0
+		for _, pc := range resp.Config.StreamConfig.Pipeline.Processors {
+			if pc.NeosyncJavascript != nil && pc.NeosyncJavascript.BatchSize > batchSize {
+				batchSize = pc.NeosyncJavascript.BatchSize
+			}
+		}
+		if batchSize == 0 {
+			continue
+		}
+		resp.Config.Input.Inputs = neosync_benthos.Inputs{
+			Broker: &neosync_benthos.InputBrokerConfig{
+				Inputs: []neosync_benthos.Inputs{resp.Config.Input.Inputs},
+				Batching: &neosync_benthos.Batching{
+					Count:  batchSize,
+					Period: javascriptBatchPeriod,
+				},
+			},
+		}
+	}
+}
+
+// Seeds the javascript processors from the run id so that a run can be repeated,
+// and provides the account's javascript libraries to the processors that require them
+func (b *benthosBuilder) addJavascriptRuntimeConfigs(ctx context.Context, responses []*BenthosConfigResponse, accountId string) error {
+	jsConfigs := []*neosync_benthos.NeosyncJavascriptConfig{}
+	for _, resp := range responses {
+		for _, pc := range resp.Processors {
+			if pc != nil && pc.NeosyncJavascript != nil {
+				jsConfigs = append(jsConfigs, pc.NeosyncJavascript)
+			}
+		}
+		if resp.Config == nil || resp.Config.StreamConfig.Pipeline == nil {
+			continue
+		}
+		for idx := range resp.Config.StreamConfig.Pipeline.Processors {
+			if js := resp.Config.StreamConfig.Pipeline.Processors[idx].NeosyncJavascript; js != nil {
+				jsConfigs = append(jsConfigs, js)
+			}
+		}
+	}
+	if len(jsConfigs) == 0 {
+		return nil
+	}
+
+	hasher := fnv.New64a()
+	_, _ = hasher.Write([]byte(b.runId))
+	seed := int64(hasher.Sum64()) //nolint:gosec
+
+	var libraries map[string]string
+	for _, js := range jsConfigs {
+		js.Seed = &seed
+		if !strings.Contains(js.Code, "require(") {
+			continue
+		}
+		if libraries == nil {
+			resp, err := b.transformerclient.GetJavascriptLibraries(ctx, connect.NewRequest(&mgmtv1alpha1.GetJavascriptLibrariesRequest{
+				AccountId: accountId,
+			}))
+			if err != nil {
+				return fmt.Errorf("unable to get javascript libraries: %w", err)
+			}
+			libraries = make(map[string]string, len(resp.Msg.GetLibraries()))
+			for _, library := range resp.Msg.GetLibraries() {
+				libraries[library.GetName()] = library.GetCode()
+			}
+		}
+		js.Libraries = libraries
+	}
+	return nil
+}
+
 func groupGenerateSourceOptionsByTable(
 	schemaOptions []*mgmtv1alpha1.GenerateSourceSchemaOption,
 ) map[string]*generateSourceTableOptions {
@@ -2,10 +2,12 @@ package genbenthosconfigs_activity
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strings"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/benthosdev/benthos/v4/public/bloblang"
@@ -13,21 +15,28 @@ import (
 	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/pkg/nonfinite"
 	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
 	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
 	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"github.com/nucleuscloud/neosync/worker/internal/synthesizer"
 	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"gopkg.in/yaml.v3"
 
 	_ "github.com/benthosdev/benthos/v4/public/components/aws"
+	_ "github.com/benthosdev/benthos/v4/public/components/confluent"
 	_ "github.com/benthosdev/benthos/v4/public/components/io"
 	_ "github.com/benthosdev/benthos/v4/public/components/javascript"
+	_ "github.com/benthosdev/benthos/v4/public/components/kafka"
 	_ "github.com/benthosdev/benthos/v4/public/components/pure"
 	_ "github.com/benthosdev/benthos/v4/public/components/pure/extended"
 	_ "github.com/benthosdev/benthos/v4/public/components/redis"
 	_ "github.com/benthosdev/benthos/v4/public/components/sql"
+	neosync_benthos_aws "github.com/nucleuscloud/neosync/worker/internal/benthos/aws"
 	neosync_benthos_error "github.com/nucleuscloud/neosync/worker/internal/benthos/error"
 	benthos_metrics "github.com/nucleuscloud/neosync/worker/internal/benthos/metrics"
 	_ "github.com/nucleuscloud/neosync/worker/internal/benthos/redis"
@@ -38,12 +47,14 @@ import (
 )
 
 const (
-	mockJobId = "b1767636-3992-4cb4-9bf2-4bb9bddbf43c"
-	mockRunId = "26444272-0bb0-4325-ae60-17dcd9744785"
+	mockJobId      = "b1767636-3992-4cb4-9bf2-4bb9bddbf43c"
+	mockWorkflowId = "b1767636-3992-4cb4-9bf2-4bb9bddbf43c-2024-04-26T12:00:00Z"
+	mockRunId      = "26444272-0bb0-4325-ae60-17dcd9744785"
 )
 
 func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Generate_Pg(t *testing.T) {
 	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockCreateJobConfigArtifact(mockJobClient)
 	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
 	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
 	mockSqlManager := sql_manager.NewMockSqlManagerClient(t)
@@ -142,7 +153,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Generate_Pg(t *testing.T)
 		},
 	}), nil)
 
-	bbuilder := newBenthosBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mockTransformerClient, mockJobId, mockRunId, nil, false)
+	bbuilder := newBenthosBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mockTransformerClient, mockJobId, mockWorkflowId, mockRunId, nil, false)
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
 		&GenerateBenthosConfigsRequest{JobId: "123", WorkflowId: "123"},
@@ -188,8 +199,9 @@ output:
                             on_conflict_do_nothing: true
                             truncate_on_retry: true
                             args_mapping: root = [this."id", this."name"]
+                            adaptive_batching: true
                             batching:
-                                count: 100
+                                count: 1000
                                 byte_size: 0
                                 period: 5s
                                 check: ""
@@ -217,7 +229,7 @@ output:
 
 	// create a new streambuilder instance so we can access the SetYaml method
 	benthosenv := service.NewEnvironment()
-	err = neosync_benthos_sql.RegisterPooledSqlInsertOutput(benthosenv, nil, false)
+	err = neosync_benthos_sql.RegisterPooledSqlInsertOutput(benthosenv, nil, false, nil, nonfinite.PolicyPreserve)
 	require.NoError(t, err)
 	err = neosync_benthos_sql.RegisterPooledSqlRawInput(benthosenv, nil, nil)
 	require.NoError(t, err)
@@ -234,6 +246,7 @@ output:
 
 func Test_BenthosBuilder_GenerateBenthosConfigs_Metrics(t *testing.T) {
 	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockCreateJobConfigArtifact(mockJobClient)
 	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
 	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
 	mockSqlManager := sql_manager.NewMockSqlManagerClient(t)
@@ -322,7 +335,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_Metrics(t *testing.T) {
 		},
 	}), nil)
 
-	bbuilder := newBenthosBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mockTransformerClient, mockJobId, mockRunId, nil, true)
+	bbuilder := newBenthosBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mockTransformerClient, mockJobId, mockWorkflowId, mockRunId, nil, true)
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
 		&GenerateBenthosConfigsRequest{JobId: "123", WorkflowId: "123"},
@@ -368,8 +381,9 @@ output:
                             on_conflict_do_nothing: false
                             truncate_on_retry: false
                             args_mapping: root = [this."id", this."name"]
+                            adaptive_batching: true
                             batching:
-                                count: 100
+                                count: 1000
                                 byte_size: 0
                                 period: 5s
                                 check: ""
@@ -407,7 +421,7 @@ metrics:
 
 	// create a new streambuilder instance so we can access the SetYaml method
 	benthosenv := service.NewEnvironment()
-	err = neosync_benthos_sql.RegisterPooledSqlInsertOutput(benthosenv, nil, false)
+	err = neosync_benthos_sql.RegisterPooledSqlInsertOutput(benthosenv, nil, false, nil, nonfinite.PolicyPreserve)
 	require.NoError(t, err)
 	err = neosync_benthos_sql.RegisterPooledSqlRawInput(benthosenv, nil, nil)
 	require.NoError(t, err)
@@ -426,6 +440,7 @@ metrics:
 
 func Test_BenthosBuilder_GenerateBenthosConfigs_Generate_Pg_Pg(t *testing.T) {
 	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockCreateJobConfigArtifact(mockJobClient)
 	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
 	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
 	mockSqlManager := sql_manager.NewMockSqlManagerClient(t)
@@ -510,7 +525,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_Generate_Pg_Pg(t *testing.T) {
 		},
 	}), nil)
 
-	bbuilder := newBenthosBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mockTransformerClient, mockJobId, mockRunId, nil, false)
+	bbuilder := newBenthosBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mockTransformerClient, mockJobId, mockWorkflowId, mockRunId, nil, false)
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
 		&GenerateBenthosConfigsRequest{JobId: "123", WorkflowId: "123"},
@@ -557,8 +572,9 @@ output:
                             on_conflict_do_nothing: false
                             truncate_on_retry: false
                             args_mapping: root = [this."id", this."name"]
+                            adaptive_batching: true
                             batching:
-                                count: 100
+                                count: 1000
                                 byte_size: 0
                                 period: 5s
                                 check: ""
@@ -585,7 +601,7 @@ output:
 
 	// create a new streambuilder instance so we can access the SetYaml method
 	benthosenv := service.NewEnvironment()
-	err = neosync_benthos_sql.RegisterPooledSqlInsertOutput(benthosenv, nil, false)
+	err = neosync_benthos_sql.RegisterPooledSqlInsertOutput(benthosenv, nil, false, nil, nonfinite.PolicyPreserve)
 	require.NoError(t, err)
 	err = neosync_benthos_sql.RegisterPooledSqlRawInput(benthosenv, nil, nil)
 	require.NoError(t, err)
@@ -602,6 +618,7 @@ output:
 
 func Test_BenthosBuilder_GenerateBenthosConfigs_PrimaryKey_Transformer_Pg_Pg(t *testing.T) {
 	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockCreateJobConfigArtifact(mockJobClient)
 	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
 	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
 	mockSqlDb := sql_manager.NewMockSqlDatabase(t)
@@ -725,7 +742,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_PrimaryKey_Transformer_Pg_Pg(t *
 		PrimaryKeyConstraints: map[string][]string{"public.users": {"id"},
 			"public.orders": {"id"}},
 	}, nil)
-	bbuilder := newBenthosBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mockTransformerClient, mockJobId, mockRunId, redisConfig, false)
+	bbuilder := newBenthosBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mockTransformerClient, mockJobId, mockWorkflowId, mockRunId, redisConfig, false)
 
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
@@ -785,8 +802,9 @@ output:
                     on_conflict_do_nothing: false
                     truncate_on_retry: false
                     args_mapping: root = [this."id", this."name"]
+                    adaptive_batching: true
                     batching:
-                        count: 100
+                        count: 1000
                         byte_size: 0
                         period: 5s
                         check: ""
@@ -849,8 +867,9 @@ output:
                     on_conflict_do_nothing: false
                     truncate_on_retry: false
                     args_mapping: root = [this."id", this."buyer_id"]
+                    adaptive_batching: true
                     batching:
-                        count: 100
+                        count: 1000
                         byte_size: 0
                         period: 5s
                         check: ""
@@ -867,7 +886,7 @@ output:
 	)
 
 	benthosenv := service.NewEnvironment()
-	err = neosync_benthos_sql.RegisterPooledSqlInsertOutput(benthosenv, nil, false)
+	err = neosync_benthos_sql.RegisterPooledSqlInsertOutput(benthosenv, nil, false, nil, nonfinite.PolicyPreserve)
 	require.NoError(t, err)
 	err = neosync_benthos_sql.RegisterPooledSqlRawInput(benthosenv, nil, nil)
 	require.NoError(t, err)
@@ -885,6 +904,7 @@ output:
 
 func Test_BenthosBuilder_GenerateBenthosConfigs_PrimaryKey_Passthrough_Pg_Pg(t *testing.T) {
 	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockCreateJobConfigArtifact(mockJobClient)
 	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
 	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
 	mockSqlDb := sql_manager.NewMockSqlDatabase(t)
@@ -998,7 +1018,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_PrimaryKey_Passthrough_Pg_Pg(t *
 			"public.orders": {"id"}},
 	}, nil)
 
-	bbuilder := newBenthosBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mockTransformerClient, mockJobId, mockRunId, nil, false)
+	bbuilder := newBenthosBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mockTransformerClient, mockJobId, mockWorkflowId, mockRunId, nil, false)
 
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
@@ -1044,8 +1064,9 @@ output:
                     on_conflict_do_nothing: false
                     truncate_on_retry: false
                     args_mapping: root = [this."id", this."name"]
+                    adaptive_batching: true
                     batching:
-                        count: 100
+                        count: 1000
                         byte_size: 0
                         period: 5s
                         check: ""
@@ -1096,8 +1117,9 @@ output:
                     on_conflict_do_nothing: false
                     truncate_on_retry: false
                     args_mapping: root = [this."id", this."buyer_id"]
+                    adaptive_batching: true
                     batching:
-                        count: 100
+                        count: 1000
                         byte_size: 0
                         period: 5s
                         check: ""
@@ -1114,7 +1136,7 @@ output:
 	)
 
 	benthosenv := service.NewEnvironment()
-	err = neosync_benthos_sql.RegisterPooledSqlInsertOutput(benthosenv, nil, false)
+	err = neosync_benthos_sql.RegisterPooledSqlInsertOutput(benthosenv, nil, false, nil, nonfinite.PolicyPreserve)
 	require.NoError(t, err)
 	err = neosync_benthos_sql.RegisterPooledSqlRawInput(benthosenv, nil, nil)
 	require.NoError(t, err)
@@ -1129,6 +1151,7 @@ output:
 
 func Test_BenthosBuilder_GenerateBenthosConfigs_CircularDependency_PrimaryKey_Transformer_Pg_Pg(t *testing.T) {
 	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockCreateJobConfigArtifact(mockJobClient)
 	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
 	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
 	mockSqlDb := sql_manager.NewMockSqlDatabase(t)
@@ -1236,7 +1259,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_CircularDependency_PrimaryKey_Tr
 		PrimaryKeyConstraints: map[string][]string{"public.jobs": {"id"}},
 	}, nil)
 
-	bbuilder := newBenthosBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mockTransformerClient, mockJobId, mockRunId, redisConfig, false)
+	bbuilder := newBenthosBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mockTransformerClient, mockJobId, mockWorkflowId, mockRunId, redisConfig, false)
 
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
@@ -1295,8 +1318,9 @@ output:
                     on_conflict_do_nothing: false
                     truncate_on_retry: false
                     args_mapping: root = [this."id"]
+                    adaptive_batching: true
                     batching:
-                        count: 100
+                        count: 1000
                         byte_size: 0
                         period: 5s
                         check: ""
@@ -1386,9 +1410,9 @@ output:
 	)
 
 	benthosenv := service.NewEnvironment()
-	err = neosync_benthos_sql.RegisterPooledSqlInsertOutput(benthosenv, nil, false)
+	err = neosync_benthos_sql.RegisterPooledSqlInsertOutput(benthosenv, nil, false, nil, nonfinite.PolicyPreserve)
 	require.NoError(t, err)
-	err = neosync_benthos_sql.RegisterPooledSqlUpdateOutput(benthosenv, nil)
+	err = neosync_benthos_sql.RegisterPooledSqlUpdateOutput(benthosenv, nil, nil, nonfinite.PolicyPreserve)
 	require.NoError(t, err)
 	err = neosync_benthos_sql.RegisterPooledSqlRawInput(benthosenv, nil, nil)
 	require.NoError(t, err)
@@ -1405,6 +1429,7 @@ output:
 
 func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Pg_Pg_With_Constraints(t *testing.T) {
 	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockCreateJobConfigArtifact(mockJobClient)
 	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
 	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
 	mockSqlDb := sql_manager.NewMockSqlDatabase(t)
@@ -1520,7 +1545,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Pg_Pg_With_Constraints(t *
 		},
 	}, nil)
 
-	bbuilder := newBenthosBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mockTransformerClient, mockJobId, mockRunId, nil, false)
+	bbuilder := newBenthosBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mockTransformerClient, mockJobId, mockWorkflowId, mockRunId, nil, false)
 
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
@@ -1545,7 +1570,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Pg_Pg_With_Constraints(t *
 	require.NoError(t, err)
 
 	benthosenv := service.NewEnvironment()
-	err = neosync_benthos_sql.RegisterPooledSqlInsertOutput(benthosenv, nil, false)
+	err = neosync_benthos_sql.RegisterPooledSqlInsertOutput(benthosenv, nil, false, nil, nonfinite.PolicyPreserve)
 	require.NoError(t, err)
 	err = neosync_benthos_sql.RegisterPooledSqlRawInput(benthosenv, nil, nil)
 	require.NoError(t, err)
@@ -1563,6 +1588,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Pg_Pg_With_Constraints(t *
 
 func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Pg_Pg_With_Circular_Dependency(t *testing.T) {
 	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockCreateJobConfigArtifact(mockJobClient)
 	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
 	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
 	mockSqlDb := sql_manager.NewMockSqlDatabase(t)
@@ -1688,7 +1714,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Pg_Pg_With_Circular_Depend
 		},
 	}, nil)
 
-	bbuilder := newBenthosBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mockTransformerClient, mockJobId, mockRunId, nil, false)
+	bbuilder := newBenthosBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mockTransformerClient, mockJobId, mockWorkflowId, mockRunId, nil, false)
 
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
@@ -1735,8 +1761,9 @@ output:
                     on_conflict_do_nothing: false
                     truncate_on_retry: false
                     args_mapping: root = [this."id", this."name"]
+                    adaptive_batching: true
                     batching:
-                        count: 100
+                        count: 1000
                         byte_size: 0
                         period: 5s
                         check: ""
@@ -1839,8 +1866,9 @@ output:
                     on_conflict_do_nothing: false
                     truncate_on_retry: false
                     args_mapping: root = [this."id", this."user_id"]
+                    adaptive_batching: true
                     batching:
-                        count: 100
+                        count: 1000
                         byte_size: 0
                         period: 5s
                         check: ""
@@ -1857,9 +1885,9 @@ output:
 	)
 
 	benthosenv := service.NewEnvironment()
-	err = neosync_benthos_sql.RegisterPooledSqlInsertOutput(benthosenv, nil, false)
+	err = neosync_benthos_sql.RegisterPooledSqlInsertOutput(benthosenv, nil, false, nil, nonfinite.PolicyPreserve)
 	require.NoError(t, err)
-	err = neosync_benthos_sql.RegisterPooledSqlUpdateOutput(benthosenv, nil)
+	err = neosync_benthos_sql.RegisterPooledSqlUpdateOutput(benthosenv, nil, nil, nonfinite.PolicyPreserve)
 	require.NoError(t, err)
 	err = neosync_benthos_sql.RegisterPooledSqlRawInput(benthosenv, nil, nil)
 	require.NoError(t, err)
@@ -1877,6 +1905,7 @@ output:
 
 func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Pg_Pg_With_Circular_Dependency_S3(t *testing.T) {
 	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockCreateJobConfigArtifact(mockJobClient)
 	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
 	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
 	mockSqlDb := sql_manager.NewMockSqlDatabase(t)
@@ -2032,7 +2061,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Pg_Pg_With_Circular_Depend
 		},
 	}, nil)
 
-	bbuilder := newBenthosBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mockTransformerClient, mockJobId, mockRunId, nil, false)
+	bbuilder := newBenthosBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mockTransformerClient, mockJobId, mockWorkflowId, mockRunId, nil, false)
 
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
@@ -2080,8 +2109,9 @@ output:
                     on_conflict_do_nothing: false
                     truncate_on_retry: false
                     args_mapping: root = [this."id", this."name"]
+                    adaptive_batching: true
                     batching:
-                        count: 100
+                        count: 1000
                         byte_size: 0
                         period: 5s
                         check: ""
@@ -2095,7 +2125,7 @@ output:
                         check: ""
                         processors: []
             - fallback:
-                - aws_s3:
+                - neosync_aws_s3:
                     bucket: s3-bucket
                     max_in_flight: 64
                     path: /workflows/123/activities/public.users/data/${!count("files")}.txt.gz
@@ -2105,6 +2135,8 @@ output:
                         period: 5s
                         check: ""
                         processors:
+                            - neosync_binary_encode: {}
+                            - neosync_non_finite: {}
                             - archive:
                                 format: lines
                             - compress:
@@ -2113,6 +2145,11 @@ output:
                     credentials:
                         id: access-key
                         secret: secret
+                    manifest:
+                        path: /workflows/123/activities/public.users/manifest.json
+                        job_run_id: "123"
+                        schema: public
+                        table: users
                 - error:
                     error_msg: ${! meta("fallback_error")}
                     batching:
@@ -2212,8 +2249,9 @@ output:
                     on_conflict_do_nothing: false
                     truncate_on_retry: false
                     args_mapping: root = [this."id", this."user_id"]
+                    adaptive_batching: true
                     batching:
-                        count: 100
+                        count: 1000
                         byte_size: 0
                         period: 5s
                         check: ""
@@ -2227,7 +2265,7 @@ output:
                         check: ""
                         processors: []
             - fallback:
-                - aws_s3:
+                - neosync_aws_s3:
                     bucket: s3-bucket
                     max_in_flight: 64
                     path: /workflows/123/activities/public.user_account_associations/data/${!count("files")}.txt.gz
@@ -2237,6 +2275,8 @@ output:
                         period: 5s
                         check: ""
                         processors:
+                            - neosync_binary_encode: {}
+                            - neosync_non_finite: {}
                             - archive:
                                 format: lines
                             - compress:
@@ -2245,6 +2285,11 @@ output:
                     credentials:
                         id: access-key
                         secret: secret
+                    manifest:
+                        path: /workflows/123/activities/public.user_account_associations/manifest.json
+                        job_run_id: "123"
+                        schema: public
+                        table: user_account_associations
                 - error:
                     error_msg: ${! meta("fallback_error")}
                     batching:
@@ -2257,12 +2302,18 @@ output:
 	)
 
 	benthosenv := service.NewEnvironment()
-	err = neosync_benthos_sql.RegisterPooledSqlInsertOutput(benthosenv, nil, false)
+	err = neosync_benthos_sql.RegisterPooledSqlInsertOutput(benthosenv, nil, false, nil, nonfinite.PolicyPreserve)
 	require.NoError(t, err)
 	err = neosync_benthos_sql.RegisterPooledSqlRawInput(benthosenv, nil, nil)
 	require.NoError(t, err)
 	err = neosync_benthos_error.RegisterErrorOutput(benthosenv, nil)
 	require.NoError(t, err)
+	err = neosync_benthos_aws.RegisterS3Output(benthosenv, nil)
+	require.NoError(t, err)
+	err = neosync_benthos_aws.RegisterBinaryEncodeProcessor(benthosenv)
+	require.NoError(t, err)
+	err = neosync_benthos_aws.RegisterNonFiniteProcessor(benthosenv, nonfinite.PolicyPreserve)
+	require.NoError(t, err)
 	newSB := benthosenv.NewStreamBuilder()
 
 	// SetYAML parses a full Benthos config and uses it to configure the builder.
@@ -2275,6 +2326,7 @@ output:
 
 func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Mysql_Mysql(t *testing.T) {
 	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockCreateJobConfigArtifact(mockJobClient)
 	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
 	mockTransformersClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
 	mockSqlDb := sql_manager.NewMockSqlDatabase(t)
@@ -2391,7 +2443,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Mysql_Mysql(t *testing.T)
 		},
 	}, nil)
 
-	bbuilder := newBenthosBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mockTransformersClient, mockJobId, mockRunId, nil, false)
+	bbuilder := newBenthosBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mockTransformersClient, mockJobId, mockWorkflowId, mockRunId, nil, false)
 
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
@@ -2437,8 +2489,9 @@ output:
                     on_conflict_do_nothing: false
                     truncate_on_retry: false
                     args_mapping: root = [this."id", this."name"]
+                    adaptive_batching: true
                     batching:
-                        count: 100
+                        count: 1000
                         byte_size: 0
                         period: 5s
                         check: ""
@@ -2489,8 +2542,9 @@ output:
                     on_conflict_do_nothing: false
                     truncate_on_retry: false
                     args_mapping: root = [this."id", this."user_id"]
+                    adaptive_batching: true
                     batching:
-                        count: 100
+                        count: 1000
                         byte_size: 0
                         period: 5s
                         check: ""
@@ -2507,7 +2561,7 @@ output:
 	)
 
 	benthosenv := service.NewEnvironment()
-	err = neosync_benthos_sql.RegisterPooledSqlInsertOutput(benthosenv, nil, false)
+	err = neosync_benthos_sql.RegisterPooledSqlInsertOutput(benthosenv, nil, false, nil, nonfinite.PolicyPreserve)
 	require.NoError(t, err)
 	err = neosync_benthos_sql.RegisterPooledSqlRawInput(benthosenv, nil, nil)
 	require.NoError(t, err)
@@ -2524,8 +2578,136 @@ output:
 	require.NoError(t, err)
 }
 
+func Test_BenthosBuilder_GenerateBenthosConfigs_DestinationTable_Pg_Pg(t *testing.T) {
+	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockCreateJobConfigArtifact(mockJobClient)
+	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
+	mockTransformersClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
+	mockSqlDb := sql_manager.NewMockSqlDatabase(t)
+	mockSqlManager := sql_manager.NewMockSqlManagerClient(t)
+
+	destinationTable := &mgmtv1alpha1.JobMappingDestinationTable{Schema: "masked", Table: "users_v2"}
+	mockJobClient.On("GetJob", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetJobResponse{
+			Job: &mgmtv1alpha1.Job{
+				Source: &mgmtv1alpha1.JobSource{
+					Options: &mgmtv1alpha1.JobSourceOptions{
+						Config: &mgmtv1alpha1.JobSourceOptions_Postgres{
+							Postgres: &mgmtv1alpha1.PostgresSourceConnectionOptions{
+								ConnectionId: "123",
+							},
+						},
+					},
+				},
+				Mappings: []*mgmtv1alpha1.JobMapping{
+					{
+						Schema: "public",
+						Table:  "users",
+						Column: "id",
+						Transformer: &mgmtv1alpha1.JobMappingTransformer{
+							Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH,
+						},
+						DestinationTable: destinationTable,
+					},
+					{
+						Schema: "public",
+						Table:  "users",
+						Column: "name",
+						Transformer: &mgmtv1alpha1.JobMappingTransformer{
+							Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH,
+						},
+						DestinationTable:  destinationTable,
+						DestinationColumn: &mgmtv1alpha1.JobMappingDestinationColumn{Name: shared.Ptr("full_name")},
+					},
+				},
+				Destinations: []*mgmtv1alpha1.JobDestination{
+					{
+						ConnectionId: "456",
+					},
+				},
+			},
+		}), nil)
+	mockConnectionClient.On(
+		"GetConnection",
+		mock.Anything,
+		connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+			Id: "123",
+		}),
+	).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: &mgmtv1alpha1.Connection{
+			Id:   "123",
+			Name: "prod",
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{
+					PgConfig: &mgmtv1alpha1.PostgresConnectionConfig{
+						ConnectionConfig: &mgmtv1alpha1.PostgresConnectionConfig_Url{
+							Url: "fake-prod-url",
+						},
+					},
+				},
+			},
+		},
+	}), nil)
+	mockConnectionClient.On(
+		"GetConnection",
+		mock.Anything,
+		connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+			Id: "456",
+		}),
+	).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: &mgmtv1alpha1.Connection{
+			Id:   "456",
+			Name: "stage",
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{
+					PgConfig: &mgmtv1alpha1.PostgresConnectionConfig{
+						ConnectionConfig: &mgmtv1alpha1.PostgresConnectionConfig_Url{
+							Url: "fake-stage-url",
+						},
+					},
+				},
+			},
+		},
+	}), nil)
+	mockSqlManager.On("NewPooledSqlDb", mock.Anything, mock.Anything, mock.Anything).Return(&sql_manager.SqlConnection{Db: mockSqlDb, Driver: sql_manager.PostgresDriver}, nil)
+	mockSqlDb.On("Close").Return(nil)
+	mockSqlDb.On("GetSchemaColumnMap", mock.Anything).Return(map[string]map[string]*sql_manager.ColumnInfo{
+		"public.users": {"id": &sql_manager.ColumnInfo{}, "name": &sql_manager.ColumnInfo{}},
+	}, nil)
+	mockSqlDb.On("GetTableConstraintsBySchema", mock.Anything, []string{"public"}).Return(&sql_manager.TableConstraints{
+		PrimaryKeyConstraints: map[string][]string{
+			"public.users": {"id"},
+		},
+	}, nil)
+
+	bbuilder := newBenthosBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mockTransformersClient, mockJobId, mockWorkflowId, mockRunId, nil, false)
+
+	resp, err := bbuilder.GenerateBenthosConfigs(
+		context.Background(),
+		&GenerateBenthosConfigsRequest{JobId: "123", WorkflowId: "123"},
+		slog.Default(),
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.BenthosConfigs, 1)
+
+	bc := getBenthosConfigByName(resp.BenthosConfigs, "public.users.insert")
+	require.NotNil(t, bc)
+	// the source table is still read from and keyed by its source name
+	require.Equal(t, "public", bc.TableSchema)
+	require.Equal(t, "users", bc.TableName)
+	require.Len(t, bc.Config.Output.Broker.Outputs, 1)
+	insert := bc.Config.Output.Broker.Outputs[0].Fallback[0].PooledSqlInsert
+	require.NotNil(t, insert)
+	require.Equal(t, "masked", insert.Schema)
+	require.Equal(t, "users_v2", insert.Table)
+	require.Equal(t, []string{"id", "full_name"}, insert.Columns)
+	// the insert args are still read from the source column names of the message
+	require.Equal(t, `root = [this."id", this."name"]`, insert.ArgsMapping)
+}
+
 func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Mysql_Mysql_With_Circular_Dependency(t *testing.T) {
 	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockCreateJobConfigArtifact(mockJobClient)
 	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
 	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
 	mockSqlDb := sql_manager.NewMockSqlDatabase(t)
@@ -2652,7 +2834,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Mysql_Mysql_With_Circular_
 		},
 	}, nil)
 
-	bbuilder := newBenthosBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mockTransformerClient, mockJobId, mockRunId, nil, false)
+	bbuilder := newBenthosBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mockTransformerClient, mockJobId, mockWorkflowId, mockRunId, nil, false)
 
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
@@ -2684,9 +2866,9 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Mysql_Mysql_With_Circular_
 	require.NoError(t, err)
 
 	benthosenv := service.NewEnvironment()
-	err = neosync_benthos_sql.RegisterPooledSqlInsertOutput(benthosenv, nil, false)
+	err = neosync_benthos_sql.RegisterPooledSqlInsertOutput(benthosenv, nil, false, nil, nonfinite.PolicyPreserve)
 	require.NoError(t, err)
-	err = neosync_benthos_sql.RegisterPooledSqlUpdateOutput(benthosenv, nil)
+	err = neosync_benthos_sql.RegisterPooledSqlUpdateOutput(benthosenv, nil, nil, nonfinite.PolicyPreserve)
 	require.NoError(t, err)
 	err = neosync_benthos_sql.RegisterPooledSqlRawInput(benthosenv, nil, nil)
 	require.NoError(t, err)
@@ -2937,7 +3119,7 @@ func Test_ProcessorConfigMultiJavascript(t *testing.T) {
 	require.Equal(
 		t,
 		strings.TrimSpace(`
-- javascript:
+- neosync_javascript:
     code: |4-
         (() => {
 
@@ -3048,7 +3230,7 @@ func Test_ProcessorConfigMutationAndJavascript(t *testing.T) {
 		t,
 		strings.TrimSpace(`
 - mutation: root."email" = generate_email(max_length:40,email_type:"uuidv4")
-- javascript:
+- neosync_javascript:
     code: |4-
         (() => {
 
@@ -3112,6 +3294,129 @@ func TestAreMappingsSubsetOfSchemas(t *testing.T) {
 	require.False(t, ok, "job mappings contain more mappings than are present in the source schema")
 }
 
+func Test_excludeForeignTables(t *testing.T) {
+	schemas, mappings, excluded := excludeForeignTables(
+		map[string]map[string]*sql_manager.ColumnInfo{
+			"public.users": {
+				"id": &sql_manager.ColumnInfo{TableType: sql_manager.BaseTableType},
+			},
+			"public.remote_orders": {
+				"id":      &sql_manager.ColumnInfo{TableType: sql_manager.ForeignTableType},
+				"user_id": &sql_manager.ColumnInfo{TableType: sql_manager.ForeignTableType},
+			},
+			"public.remote_events": {
+				"id": &sql_manager.ColumnInfo{TableType: sql_manager.ForeignTableType},
+			},
+		},
+		[]*mgmtv1alpha1.JobMapping{
+			{Schema: "public", Table: "users", Column: "id"},
+			{Schema: "public", Table: "remote_orders", Column: "id"},
+			{Schema: "public", Table: "remote_orders", Column: "user_id"},
+		},
+	)
+	require.Len(t, schemas, 1)
+	require.Contains(t, schemas, "public.users")
+	require.Equal(t, []*mgmtv1alpha1.JobMapping{{Schema: "public", Table: "users", Column: "id"}}, mappings)
+	require.Equal(t, []string{"public.remote_orders"}, excluded)
+}
+
+func Test_addVirtualTableColumns(t *testing.T) {
+	mockSqlDb := sql_manager.NewMockSqlDatabase(t)
+	query := "SELECT u.id, o.total FROM public.users u JOIN public.orders o ON o.user_id = u.id"
+	columns := map[string]*sql_manager.ColumnInfo{
+		"id":    {OrdinalPosition: 1, DataType: "uuid", IsNullable: true, TableType: sql_manager.VirtualTableType},
+		"total": {OrdinalPosition: 2, DataType: "numeric", IsNullable: true, TableType: sql_manager.VirtualTableType},
+	}
+	mockSqlDb.On("GetQueryColumns", mock.Anything, query).Return(columns, nil)
+
+	groupedSchemas := map[string]map[string]*sql_manager.ColumnInfo{
+		"public.users": {"id": &sql_manager.ColumnInfo{TableType: sql_manager.BaseTableType}},
+	}
+	err := addVirtualTableColumns(context.Background(), mockSqlDb, groupedSchemas, map[string]*mgmtv1alpha1.SqlVirtualTable{
+		"public.user_totals": {Schema: "public", Table: "user_totals", Query: query},
+	})
+	require.NoError(t, err)
+	require.Equal(t, columns, groupedSchemas["public.user_totals"])
+
+	err = addVirtualTableColumns(context.Background(), mockSqlDb, groupedSchemas, map[string]*mgmtv1alpha1.SqlVirtualTable{
+		"public.users": {Schema: "public", Table: "users", Query: query},
+	})
+	require.Error(t, err)
+}
+
+func Test_setVirtualTableSelectQueries(t *testing.T) {
+	queryMap := map[string]map[tabledependency.RunType]string{
+		"public.users":       {tabledependency.RunTypeInsert: `SELECT "id" FROM "public"."users"`},
+		"public.user_totals": {tabledependency.RunTypeInsert: `SELECT "id", "total" FROM "public"."user_totals"`},
+	}
+	err := setVirtualTableSelectQueries(
+		sql_manager.PostgresDriver,
+		queryMap,
+		[]*tabledependency.RunConfig{
+			{Table: "public.users", RunType: tabledependency.RunTypeInsert, SelectColumns: []string{"id"}},
+			{Table: "public.user_totals", RunType: tabledependency.RunTypeInsert, SelectColumns: []string{"id", "total"}},
+		},
+		map[string]*mgmtv1alpha1.SqlVirtualTable{
+			"public.user_totals": {Schema: "public", Table: "user_totals", Query: "SELECT user_id AS id, sum(total) AS total FROM public.orders GROUP BY user_id"},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT "id" FROM "public"."users"`, queryMap["public.users"][tabledependency.RunTypeInsert])
+	require.Equal(
+		t,
+		`SELECT "id", "total" FROM (SELECT user_id AS id, sum(total) AS total FROM public.orders GROUP BY user_id) AS "neosync_virtual_table"`,
+		queryMap["public.user_totals"][tabledependency.RunTypeInsert],
+	)
+}
+
+func Test_buildBenthosAwsS3RestoreSourceConfigResponses(t *testing.T) {
+	groupedMappings := groupMappingsByTable([]*mgmtv1alpha1.JobMapping{
+		{Schema: "public", Table: "users", Column: "id"},
+		{Schema: "public", Table: "orders", Column: "id"},
+		{Schema: "public", Table: "orders", Column: "user_id"},
+	})
+	runconfigs := []*tabledependency.RunConfig{
+		{Table: "public.users", RunType: tabledependency.RunTypeInsert, InsertColumns: []string{"id"}, PrimaryKeys: []string{"id"}},
+		{
+			Table:         "public.orders",
+			RunType:       tabledependency.RunTypeInsert,
+			InsertColumns: []string{"id", "user_id"},
+			PrimaryKeys:   []string{"id"},
+			DependsOn:     []*tabledependency.DependsOn{{Table: "public.users", Columns: []string{"id"}}},
+		},
+	}
+
+	responses, err := buildBenthosAwsS3RestoreSourceConfigResponses(
+		getTableMappingsMap(groupedMappings),
+		runconfigs,
+		"conn-id",
+		"run-id",
+	)
+	require.NoError(t, err)
+	require.Len(t, responses, 2)
+
+	orders := responses[1]
+	require.Equal(t, "public.orders.insert", orders.Name)
+	require.Equal(t, []string{"id", "user_id"}, orders.Columns)
+	require.Equal(t, []*tabledependency.DependsOn{{Table: "public.users", Columns: []string{"id"}}}, orders.DependsOn)
+	require.Equal(t, &neosync_benthos.NeosyncConnectionData{
+		ConnectionId: "conn-id",
+		JobRunId:     "run-id",
+		Schema:       "public",
+		Table:        "orders",
+	}, orders.Config.Input.NeosyncConnectionData)
+}
+
+func Test_buildBenthosAwsS3RestoreSourceConfigResponses_MissingMappings(t *testing.T) {
+	_, err := buildBenthosAwsS3RestoreSourceConfigResponses(
+		map[string]*tableMapping{},
+		[]*tabledependency.RunConfig{{Table: "public.users", RunType: tabledependency.RunTypeInsert}},
+		"conn-id",
+		"run-id",
+	)
+	require.Error(t, err)
+}
+
 func TestShouldHaltOnSchemaAddition(t *testing.T) {
 	ok := shouldHaltOnSchemaAddition(
 		map[string]map[string]*sql_manager.ColumnInfo{
@@ -3296,10 +3601,89 @@ const output = { ...input };
 output["address"] = fn_address(input["address"], input);
 benthos.v0_msg_set_structured(output);
 })();`,
-		res[0].Javascript.Code,
+		res[0].NeosyncJavascript.Code,
+	)
+}
+
+func Test_buildProcessorConfigsJavascriptBatch(t *testing.T) {
+	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
+
+	ctx := context.Background()
+	batchTransformer := func(code string, batchSize uint32) *mgmtv1alpha1.JobMappingTransformer {
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_JAVASCRIPT,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformJavascriptConfig{
+					TransformJavascriptConfig: &mgmtv1alpha1.TransformJavascript{Code: code, BatchSize: batchSize},
+				},
+			},
+		}
+	}
+
+	res, err := buildProcessorConfigs(ctx, mockTransformerClient, []*mgmtv1alpha1.JobMapping{
+		{Schema: "public", Table: "users", Column: "name", Transformer: batchTransformer(`return values;`, 500)},
+		{Schema: "public", Table: "users", Column: "email", Transformer: batchTransformer(`return values.map((value) => value + "!");`, 100)},
+	}, map[string]*sql_manager.ColumnInfo{}, map[string][]*referenceKey{}, []string{}, mockJobId, mockRunId, nil)
+
+	require.NoError(t, err)
+	// the batched transformers are the only javascript processor, followed by the catch processor
+	require.Len(t, res, 2)
+	require.Equal(t, 100, res[0].NeosyncJavascript.BatchSize)
+	require.Equal(t, `
+(() => {
+
+function fn_name(values, inputs){
+  return values;
+};
+
+
+function fn_email(values, inputs){
+  return values.map((value) => value + "!");
+};
+
+function neosync_set_batch_values(outputs, col, values) {
+  if (!Array.isArray(values) || values.length !== outputs.length) {
+    throw new Error("the javascript transformer for " + col + " must return an array of " + outputs.length + " values");
+  }
+  values.forEach((value, idx) => { outputs[idx][col] = value; });
+}
+const inputs = benthos.v0_batch_as_structured();
+const outputs = inputs.map((input) => ({ ...input }));
+neosync_set_batch_values(outputs, "name", fn_name(inputs.map((input) => input["name"]), inputs));
+neosync_set_batch_values(outputs, "email", fn_email(inputs.map((input) => input["email"]), inputs));
+benthos.v0_batch_set_structured(outputs);
+})();`,
+		res[0].NeosyncJavascript.Code,
 	)
 }
 
+func Test_batchJavascriptInputs(t *testing.T) {
+	newResponse := func(processors ...neosync_benthos.ProcessorConfig) *BenthosConfigResponse {
+		return &BenthosConfigResponse{
+			Config: &neosync_benthos.BenthosConfig{
+				StreamConfig: neosync_benthos.StreamConfig{
+					Input: &neosync_benthos.InputConfig{
+						Inputs: neosync_benthos.Inputs{PooledSqlRaw: &neosync_benthos.InputPooledSqlRaw{Query: "select * from users;"}},
+					},
+					Pipeline: &neosync_benthos.PipelineConfig{Processors: processors},
+				},
+			},
+		}
+	}
+	batched := newResponse(neosync_benthos.ProcessorConfig{NeosyncJavascript: &neosync_benthos.NeosyncJavascriptConfig{Code: "1 + 1", BatchSize: 50}})
+	unbatched := newResponse(neosync_benthos.ProcessorConfig{NeosyncJavascript: &neosync_benthos.NeosyncJavascriptConfig{Code: "1 + 1"}})
+
+	batchJavascriptInputs([]*BenthosConfigResponse{batched, unbatched})
+
+	require.Nil(t, batched.Config.Input.PooledSqlRaw)
+	require.NotNil(t, batched.Config.Input.Broker)
+	require.Len(t, batched.Config.Input.Broker.Inputs, 1)
+	require.Equal(t, "select * from users;", batched.Config.Input.Broker.Inputs[0].PooledSqlRaw.Query)
+	require.Equal(t, 50, batched.Config.Input.Broker.Batching.Count)
+	require.NotNil(t, unbatched.Config.Input.PooledSqlRaw)
+	require.Nil(t, unbatched.Config.Input.Broker)
+}
+
 func Test_buildProcessorConfigsGenerateJavascript(t *testing.T) {
 	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
 
@@ -3336,7 +3720,7 @@ const output = { ...input };
 output["test"] = fn_test();
 benthos.v0_msg_set_structured(output);
 })();`,
-		res[0].Javascript.Code,
+		res[0].NeosyncJavascript.Code,
 	)
 }
 
@@ -3384,7 +3768,7 @@ const output = { ...input };
 output["name"] = fn_name(input["name"], input);
 benthos.v0_msg_set_structured(output);
 })();`,
-		res[0].Javascript.Code,
+		res[0].NeosyncJavascript.Code,
 	)
 }
 
@@ -3446,7 +3830,7 @@ output["name"] = fn_name(input["name"], input);
 output["age"] = fn_age(input["age"], input);
 benthos.v0_msg_set_structured(output);
 })();`,
-		res[0].Javascript.Code,
+		res[0].NeosyncJavascript.Code,
 	)
 }
 
@@ -3508,7 +3892,7 @@ output["name"] = fn_name(input["name"], input);
 output["test"] = fn_test();
 benthos.v0_msg_set_structured(output);
 })();`,
-		res[0].Javascript.Code,
+		res[0].NeosyncJavascript.Code,
 	)
 }
 
@@ -3628,6 +4012,11 @@ func Test_ConstructBenthosOutputGenerateJs(t *testing.T) {
 	require.Equal(t, `output["col"] = fn_col();`, res)
 }
 
+func Test_ConstructCachedBenthosOutputTransformJs(t *testing.T) {
+	res := constructCachedBenthosJavascriptObject("col")
+	require.Equal(t, `output["col"] = benthos.v0_cached("col", input["col"], () => fn_col(input["col"], input));`, res)
+}
+
 func Test_buildProcessorConfigsJavascriptEmpty(t *testing.T) {
 	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
 	ctx := context.Background()
@@ -3739,6 +4128,50 @@ func Test_buildPlainColumns(t *testing.T) {
 	)
 }
 
+func Test_filterDroppedColumns(t *testing.T) {
+	dropTransformer := &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_DROP_COLUMN}
+	passthrough := &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH}
+	require.Equal(t, []string{"foo", "bar"}, filterDroppedColumns([]string{"foo", "bar"}, nil))
+	require.Equal(
+		t,
+		[]string{"foo", "baz"},
+		filterDroppedColumns([]string{"foo", "bar", "baz"}, []*mgmtv1alpha1.JobMapping{
+			{Column: "foo", Transformer: passthrough},
+			{Column: "bar", Transformer: dropTransformer},
+			{Column: "baz"},
+		}),
+	)
+}
+
+func Test_buildSqlExpressionMap(t *testing.T) {
+	require.Empty(t, buildSqlExpressionMap(nil))
+	require.Equal(
+		t,
+		map[string]string{"email": "concat(id, '@example.test')"},
+		buildSqlExpressionMap([]*mgmtv1alpha1.JobMapping{
+			{Column: "id", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH}},
+			{Column: "email", Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_SQL_EXPRESSION,
+				Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_SqlExpressionConfig{
+					SqlExpressionConfig: &mgmtv1alpha1.SqlExpression{Expression: "concat(id, '@example.test')"},
+				}},
+			}},
+		}),
+	)
+}
+
+func Test_getDestinationSqlExpressions(t *testing.T) {
+	require.Nil(t, getDestinationSqlExpressions(nil, map[string]string{}))
+	require.Equal(
+		t,
+		map[string]string{"email_address": "now()", "id": "1"},
+		getDestinationSqlExpressions(
+			map[string]*sql_manager.DestinationColumn{"email": {Name: "email_address"}},
+			map[string]string{"email": "now()", "id": "1"},
+		),
+	)
+}
+
 func Test_buildBenthosS3Credentials(t *testing.T) {
 	require.Nil(t, buildBenthosS3Credentials(nil))
 
@@ -3805,6 +4238,65 @@ func Test_buildBenthosS3Credentials(t *testing.T) {
 	)
 }
 
+func Test_getKafkaSyncBenthosOutput_Json(t *testing.T) {
+	outputs := getKafkaSyncBenthosOutput(
+		&mgmtv1alpha1.ConnectionConfig_KafkaConfig{KafkaConfig: &mgmtv1alpha1.KafkaConnectionConfig{
+			Brokers: []string{"kafka:9092"},
+			Topic:   "masked",
+			Sasl:    &mgmtv1alpha1.KafkaSaslConfig{Mechanism: mgmtv1alpha1.KafkaSaslMechanism_KAFKA_SASL_MECHANISM_SCRAM_SHA_512, Username: "user", Password: "pass"},
+			Tls:     &mgmtv1alpha1.ClientTlsConfig{RootCert: shared.Ptr("root")},
+		}},
+		&mgmtv1alpha1.JobDestination{Options: &mgmtv1alpha1.JobDestinationOptions{
+			Config: &mgmtv1alpha1.JobDestinationOptions_KafkaOptions{KafkaOptions: &mgmtv1alpha1.KafkaDestinationConnectionOptions{Compression: shared.Ptr("zstd")}},
+		}},
+		&BenthosConfigResponse{TableSchema: "public", TableName: "users", primaryKeys: []string{"id"}},
+	)
+	require.Len(t, outputs, 1)
+	require.Len(t, outputs[0].Fallback, 2)
+	kafka := outputs[0].Fallback[0].KafkaFranz
+	require.NotNil(t, kafka)
+	require.Equal(t, []string{"kafka:9092"}, kafka.SeedBrokers)
+	require.Equal(t, "masked", kafka.Topic)
+	require.Equal(t, `${! json("id") }`, kafka.Key)
+	require.Equal(t, "zstd", kafka.Compression)
+	require.Equal(t, &neosync_benthos.KafkaFranzTls{Enabled: true, RootCas: "root"}, kafka.Tls)
+	require.Equal(t, []*neosync_benthos.KafkaFranzSasl{{Mechanism: "SCRAM-SHA-512", Username: "user", Password: "pass"}}, kafka.Sasl)
+	require.Equal(t, []*neosync_benthos.BatchProcessor{
+		{Mutation: shared.Ptr("meta neosync_schema = \"public\"\nmeta neosync_table = \"users\"")},
+		{NeosyncBinaryEncode: &neosync_benthos.NeosyncBinaryEncodeProcessor{}},
+		{NeosyncNonFinite: &neosync_benthos.NeosyncNonFiniteProcessor{}},
+	}, kafka.Batching.Processors)
+}
+
+func Test_getKafkaSyncBenthosOutput_Avro(t *testing.T) {
+	outputs := getKafkaSyncBenthosOutput(
+		&mgmtv1alpha1.ConnectionConfig_KafkaConfig{KafkaConfig: &mgmtv1alpha1.KafkaConnectionConfig{
+			Brokers:             []string{"kafka:9092"},
+			Topic:               "masked",
+			SerializationFormat: mgmtv1alpha1.KafkaSerializationFormat_KAFKA_SERIALIZATION_FORMAT_AVRO,
+			SchemaRegistry:      &mgmtv1alpha1.KafkaSchemaRegistryConfig{Url: "http://registry:8081", Username: shared.Ptr("user"), Password: shared.Ptr("pass")},
+		}},
+		&mgmtv1alpha1.JobDestination{},
+		&BenthosConfigResponse{TableSchema: "public", TableName: "users"},
+	)
+	kafka := outputs[0].Fallback[0].KafkaFranz
+	require.Empty(t, kafka.Key, "tables without a primary key should not be keyed")
+	require.Empty(t, kafka.Compression)
+	require.Nil(t, kafka.Tls)
+	require.Nil(t, kafka.Sasl)
+	require.Equal(t, &neosync_benthos.SchemaRegistryEncodeProcessor{
+		Url:         "http://registry:8081",
+		Subject:     "masked-value",
+		AvroRawJson: true,
+		BasicAuth:   &neosync_benthos.BasicAuth{Enabled: true, Username: "user", Password: "pass"},
+	}, kafka.Batching.Processors[2].SchemaRegistryEncode)
+}
+
+func Test_buildKafkaRecordKey(t *testing.T) {
+	require.Empty(t, buildKafkaRecordKey(nil))
+	require.Equal(t, `${! json("a") }|${! json("b") }`, buildKafkaRecordKey([]string{"a", "b"}))
+}
+
 func Test_computeMutationFunction_null(t *testing.T) {
 	val, err := computeMutationFunction(
 		&mgmtv1alpha1.JobMapping{
@@ -3816,28 +4308,356 @@ func Test_computeMutationFunction_null(t *testing.T) {
 	require.Equal(t, val, "null")
 }
 
-func Test_computeMutationFunction_Validate_Bloblang_Output(t *testing.T) {
-	uuidEmailType := mgmtv1alpha1.GenerateEmailType_GENERATE_EMAIL_TYPE_UUID_V4
-	transformers := []*mgmtv1alpha1.SystemTransformer{
-		{
-			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_EMAIL,
-			Config: &mgmtv1alpha1.TransformerConfig{
-				Config: &mgmtv1alpha1.TransformerConfig_GenerateEmailConfig{
-					GenerateEmailConfig: &mgmtv1alpha1.GenerateEmail{
-						EmailType: &uuidEmailType,
-					},
-				},
+func Test_computeMutationFunction_dropColumn(t *testing.T) {
+	val, err := computeMutationFunction(
+		&mgmtv1alpha1.JobMapping{
+			Column: "ssn",
+			Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_DROP_COLUMN,
 			},
-		},
-		{
-			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL,
-			Config: &mgmtv1alpha1.TransformerConfig{
-				Config: &mgmtv1alpha1.TransformerConfig_TransformEmailConfig{
-					TransformEmailConfig: &mgmtv1alpha1.TransformEmail{
-						PreserveDomain:  false,
-						PreserveLength:  false,
-						ExcludedDomains: []string{},
-						EmailType:       &uuidEmailType,
+		}, &sql_manager.ColumnInfo{})
+	require.NoError(t, err)
+	require.Equal(t, "deleted()", val)
+
+	ex, err := bloblang.Parse(fmt.Sprintf("root = this\nroot.%q = %s", "ssn", val))
+	require.NoError(t, err)
+	output, err := ex.Query(map[string]any{"id": 1, "ssn": "123-45-6789"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"id": 1}, output)
+}
+
+func Test_computeMutationFunction_staticValue(t *testing.T) {
+	val, err := computeMutationFunction(
+		&mgmtv1alpha1.JobMapping{
+			Column: "status",
+			Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STATIC_VALUE,
+				Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_GenerateStaticValueConfig{
+					GenerateStaticValueConfig: &mgmtv1alpha1.GenerateStaticValue{Value: `say "hi"`},
+				}},
+			},
+		}, &sql_manager.ColumnInfo{})
+	require.NoError(t, err)
+
+	ex, err := bloblang.Parse(val)
+	require.NoError(t, err)
+	output, err := ex.Query(nil)
+	require.NoError(t, err)
+	require.Equal(t, `say "hi"`, output)
+}
+
+func Test_computeMutationFunction_sqlExpression(t *testing.T) {
+	val, err := computeMutationFunction(
+		&mgmtv1alpha1.JobMapping{
+			Column: "created_at",
+			Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_SQL_EXPRESSION,
+				Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_SqlExpressionConfig{
+					SqlExpressionConfig: &mgmtv1alpha1.SqlExpression{Expression: "now()"},
+				}},
+			},
+		}, &sql_manager.ColumnInfo{})
+	require.NoError(t, err)
+	require.Equal(t, "null", val)
+}
+
+func Test_computeMutationFunction_transformEmail_ReplacementDomain(t *testing.T) {
+	val, err := computeMutationFunction(
+		&mgmtv1alpha1.JobMapping{
+			Column: "email",
+			Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL,
+				Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_TransformEmailConfig{
+					TransformEmailConfig: &mgmtv1alpha1.TransformEmail{
+						ExcludedDomains:   []string{"acme.com"},
+						ReplacementDomain: shared.Ptr("example.com"),
+						PreservePlusTag:   true,
+					},
+				}},
+			},
+		}, &sql_manager.ColumnInfo{CharacterMaximumLength: shared.Ptr(int32(40))})
+	require.NoError(t, err)
+	require.Equal(t, `transform_email(email:this."email",preserve_domain:false,preserve_length:false,excluded_domains:["acme.com"],max_length:40,email_type:"uuidv4",invalid_email_action:"reject",replacement_domain:"example.com",preserve_plus_tag:true)`, val)
+
+	ex, err := bloblang.Parse(val)
+	require.NoError(t, err)
+	output, err := ex.Query(map[string]any{"email": "jane+billing@gmail.com"})
+	require.NoError(t, err)
+	require.True(t, strings.HasSuffix(*output.(*string), "+billing@example.com"))
+}
+
+func Test_computeMutationFunction_transformGeoCoordinate(t *testing.T) {
+	val, err := computeMutationFunction(
+		&mgmtv1alpha1.JobMapping{
+			Column: "location",
+			Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_GEO_COORDINATE,
+				Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_TransformGeoCoordinateConfig{
+					TransformGeoCoordinateConfig: &mgmtv1alpha1.TransformGeoCoordinate{RadiusMeters: 0, SnapToCityCentroid: true},
+				}},
+			},
+		}, &sql_manager.ColumnInfo{})
+	require.NoError(t, err)
+	require.Equal(t, `transform_geo_coordinate(value:this."location",radius_meters:0.000000,snap_to_city_centroid:true)`, val)
+
+	ex, err := bloblang.Parse(val)
+	require.NoError(t, err)
+	output, err := ex.Query(map[string]any{"location": "37.776,-122.417"})
+	require.NoError(t, err)
+	require.Equal(t, "37.7749,-122.4194", *output.(*string))
+}
+
+func Test_computeMutationFunction_transformUrl(t *testing.T) {
+	val, err := computeMutationFunction(
+		&mgmtv1alpha1.JobMapping{
+			Column: "referrer",
+			Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_URL,
+				Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_TransformUrlConfig{
+					TransformUrlConfig: &mgmtv1alpha1.TransformUrl{QueryParams: []string{"utm_source"}, StripUserInfo: true},
+				}},
+			},
+		}, &sql_manager.ColumnInfo{})
+	require.NoError(t, err)
+	require.Equal(t, `transform_url(value:this."referrer",query_params:["utm_source"],randomize_query_params:false,strip_user_info:true,randomize_path_identifiers:false)`, val)
+
+	ex, err := bloblang.Parse(val)
+	require.NoError(t, err)
+	output, err := ex.Query(map[string]any{"referrer": "https://bob@example.com/blog?utm_source=ads&page=2"})
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/blog?page=2", *output.(*string))
+}
+
+func Test_computeMutationFunction_generateUserAgent(t *testing.T) {
+	val, err := computeMutationFunction(
+		&mgmtv1alpha1.JobMapping{
+			Column: "user_agent",
+			Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_USER_AGENT,
+				Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_GenerateUserAgentConfig{
+					GenerateUserAgentConfig: &mgmtv1alpha1.GenerateUserAgent{DesktopOnly: true},
+				}},
+			},
+		}, &sql_manager.ColumnInfo{})
+	require.NoError(t, err)
+	require.Equal(t, `generate_user_agent(desktop_only:true)`, val)
+}
+
+func Test_computeMutationFunction_generateBusinessTimestamp(t *testing.T) {
+	afterColumn := "created_at"
+	val, err := computeMutationFunction(
+		&mgmtv1alpha1.JobMapping{
+			Column: "updated_at",
+			Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_BUSINESS_TIMESTAMP,
+				Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_GenerateBusinessTimestampConfig{
+					GenerateBusinessTimestampConfig: &mgmtv1alpha1.GenerateBusinessTimestamp{
+						RangeStartDaysAgo:   30,
+						BusinessHoursWeight: 1,
+						WeekdayWeight:       1,
+						AfterColumn:         &afterColumn,
+						MaxSecondsAfter:     3600,
+					},
+				}},
+			},
+		}, &sql_manager.ColumnInfo{})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		`generate_business_timestamp(after:this."created_at",range_start_days_ago:30,range_end_days_ago:0,business_hours_weight:1.000000,business_hours_start:9,business_hours_end:17,weekday_weight:1.000000,timezone:"",max_seconds_after:3600)`,
+		val,
+	)
+
+	ex, err := bloblang.Parse(val)
+	require.NoError(t, err)
+	output, err := ex.Query(map[string]any{"created_at": "2024-01-03T10:00:00Z"})
+	require.NoError(t, err)
+	createdAt := time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC)
+	require.False(t, output.(time.Time).Before(createdAt))
+	require.False(t, output.(time.Time).After(createdAt.Add(time.Hour)))
+}
+
+func Test_computeMutationFunction_transformMoney(t *testing.T) {
+	scale := int32(4)
+	val, err := computeMutationFunction(
+		&mgmtv1alpha1.JobMapping{
+			Column: "amount",
+			Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_MONEY,
+				Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_TransformMoneyConfig{
+					TransformMoneyConfig: &mgmtv1alpha1.TransformMoney{},
+				}},
+			},
+		}, &sql_manager.ColumnInfo{NumericScale: &scale})
+	require.NoError(t, err)
+	require.Equal(t, `transform_money(value:this."amount",decimal_places:4)`, val, "the scale of the column should be used when decimal places are not configured")
+
+	ex, err := bloblang.Parse(val)
+	require.NoError(t, err)
+	output, err := ex.Query(map[string]any{"amount": "$1,234.56"})
+	require.NoError(t, err)
+	require.Regexp(t, `^\$[1-9],\d{3}\.\d{2}$`, output)
+}
+
+func Test_computeMutationFunction_generateMoney(t *testing.T) {
+	minValue := float64(1)
+	val, err := computeMutationFunction(
+		&mgmtv1alpha1.JobMapping{
+			Column: "price",
+			Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_MONEY,
+				Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_GenerateMoneyConfig{
+					GenerateMoneyConfig: &mgmtv1alpha1.GenerateMoney{Sigma: 0.5, Min: &minValue},
+				}},
+			},
+		}, &sql_manager.ColumnInfo{})
+	require.NoError(t, err)
+	require.Equal(t, `generate_money(median:20.000000,sigma:0.500000,decimal_places:2,min:1.000000)`, val)
+
+	ex, err := bloblang.Parse(val)
+	require.NoError(t, err)
+	output, err := ex.Query(nil)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, output.(float64), minValue)
+}
+
+func Test_computeMutationFunction_generateMedicalRecordNumber(t *testing.T) {
+	prefix := "MRN-"
+	val, err := computeMutationFunction(
+		&mgmtv1alpha1.JobMapping{
+			Column: "mrn",
+			Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_MEDICAL_RECORD_NUMBER,
+				Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_GenerateMedicalRecordNumberConfig{
+					GenerateMedicalRecordNumberConfig: &mgmtv1alpha1.GenerateMedicalRecordNumber{Prefix: &prefix},
+				}},
+			},
+		}, &sql_manager.ColumnInfo{})
+	require.NoError(t, err)
+	require.Equal(t, `generate_medical_record_number(prefix:"MRN-",length:8)`, val, "the default length should be used when it is not configured")
+
+	ex, err := bloblang.Parse(val)
+	require.NoError(t, err)
+	output, err := ex.Query(nil)
+	require.NoError(t, err)
+	require.Regexp(t, `^MRN-\d{8}$`, output)
+}
+
+func Test_computeMutationFunction_transformNpi(t *testing.T) {
+	val, err := computeMutationFunction(
+		&mgmtv1alpha1.JobMapping{
+			Column: "npi",
+			Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_NPI,
+				Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_TransformNpiConfig{
+					TransformNpiConfig: &mgmtv1alpha1.TransformNpi{PreserveEntityType: true},
+				}},
+			},
+		}, &sql_manager.ColumnInfo{})
+	require.NoError(t, err)
+	require.Equal(t, `transform_npi(value:this."npi",preserve_entity_type:true)`, val)
+
+	ex, err := bloblang.Parse(val)
+	require.NoError(t, err)
+	output, err := ex.Query(map[string]any{"npi": "2234567891"})
+	require.NoError(t, err)
+	require.Regexp(t, `^2\d{9}$`, output)
+}
+
+func Test_computeMutationFunction_generateIcd10Code(t *testing.T) {
+	val, err := computeMutationFunction(
+		&mgmtv1alpha1.JobMapping{
+			Column: "diagnosis_code",
+			Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_ICD10_CODE,
+				Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_GenerateIcd10CodeConfig{
+					GenerateIcd10CodeConfig: &mgmtv1alpha1.GenerateIcd10Code{ExcludeDecimalPoint: true},
+				}},
+			},
+		}, &sql_manager.ColumnInfo{})
+	require.NoError(t, err)
+	require.Equal(t, `generate_icd10_code(exclude_decimal_point:true)`, val)
+}
+
+func Test_computeMutationFunction_transformVin(t *testing.T) {
+	val, err := computeMutationFunction(
+		&mgmtv1alpha1.JobMapping{
+			Column: "vin",
+			Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_VIN,
+				Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_TransformVinConfig{
+					TransformVinConfig: &mgmtv1alpha1.TransformVin{PreserveManufacturer: true},
+				}},
+			},
+		}, &sql_manager.ColumnInfo{})
+	require.NoError(t, err)
+	require.Equal(t, `transform_vin(value:this."vin",preserve_manufacturer:true)`, val)
+
+	ex, err := bloblang.Parse(val)
+	require.NoError(t, err)
+	output, err := ex.Query(map[string]any{"vin": "1M8GDM9AXKP042788"})
+	require.NoError(t, err)
+	require.Regexp(t, `^1M8[0-9A-HJ-NPR-Z]{14}$`, output)
+}
+
+func Test_computeMutationFunction_generateEan(t *testing.T) {
+	val, err := computeMutationFunction(
+		&mgmtv1alpha1.JobMapping{
+			Column: "upc",
+			Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_EAN,
+				Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_GenerateEanConfig{
+					GenerateEanConfig: &mgmtv1alpha1.GenerateEan{Upc: true},
+				}},
+			},
+		}, &sql_manager.ColumnInfo{})
+	require.NoError(t, err)
+	require.Equal(t, `generate_ean(upc:true)`, val)
+
+	ex, err := bloblang.Parse(val)
+	require.NoError(t, err)
+	output, err := ex.Query(nil)
+	require.NoError(t, err)
+	require.Regexp(t, `^\d{12}$`, output)
+}
+
+func Test_computeMutationFunction_transformImei(t *testing.T) {
+	val, err := computeMutationFunction(
+		&mgmtv1alpha1.JobMapping{
+			Column: "imei",
+			Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_IMEI,
+				Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_TransformImeiConfig{
+					TransformImeiConfig: &mgmtv1alpha1.TransformImei{PreserveTypeAllocationCode: true},
+				}},
+			},
+		}, &sql_manager.ColumnInfo{})
+	require.NoError(t, err)
+	require.Equal(t, `transform_imei(value:this."imei",preserve_type_allocation_code:true)`, val)
+}
+
+func Test_computeMutationFunction_Validate_Bloblang_Output(t *testing.T) {
+	uuidEmailType := mgmtv1alpha1.GenerateEmailType_GENERATE_EMAIL_TYPE_UUID_V4
+	transformers := []*mgmtv1alpha1.SystemTransformer{
+		{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_EMAIL,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateEmailConfig{
+					GenerateEmailConfig: &mgmtv1alpha1.GenerateEmail{
+						EmailType: &uuidEmailType,
+					},
+				},
+			},
+		},
+		{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformEmailConfig{
+					TransformEmailConfig: &mgmtv1alpha1.TransformEmail{
+						PreserveDomain:  false,
+						PreserveLength:  false,
+						ExcludedDomains: []string{},
+						EmailType:       &uuidEmailType,
 					},
 				},
 			},
@@ -4162,6 +4982,170 @@ func Test_computeMutationFunction_Validate_Bloblang_Output(t *testing.T) {
 				},
 			},
 		},
+		{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_GEO_COORDINATE,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformGeoCoordinateConfig{
+					TransformGeoCoordinateConfig: &mgmtv1alpha1.TransformGeoCoordinate{
+						RadiusMeters:       500,
+						SnapToCityCentroid: true,
+					},
+				},
+			},
+		},
+		{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_URL,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformUrlConfig{
+					TransformUrlConfig: &mgmtv1alpha1.TransformUrl{
+						QueryParams:              []string{"utm_source", "token"},
+						RandomizeQueryParams:     true,
+						StripUserInfo:            true,
+						RandomizePathIdentifiers: true,
+					},
+				},
+			},
+		},
+		{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_USER_AGENT,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateUserAgentConfig{
+					GenerateUserAgentConfig: &mgmtv1alpha1.GenerateUserAgent{
+						DesktopOnly: true,
+					},
+				},
+			},
+		},
+		{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_BUSINESS_TIMESTAMP,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateBusinessTimestampConfig{
+					GenerateBusinessTimestampConfig: &mgmtv1alpha1.GenerateBusinessTimestamp{
+						RangeStartDaysAgo:   365,
+						BusinessHoursWeight: 0.8,
+						BusinessHoursStart:  9,
+						BusinessHoursEnd:    17,
+						WeekdayWeight:       0.9,
+						Timezone:            "America/New_York",
+					},
+				},
+			},
+		},
+		{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_MONEY,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformMoneyConfig{
+					TransformMoneyConfig: &mgmtv1alpha1.TransformMoney{},
+				},
+			},
+		},
+		{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_MONEY,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateMoneyConfig{
+					GenerateMoneyConfig: &mgmtv1alpha1.GenerateMoney{
+						Median: 50,
+						Sigma:  1,
+					},
+				},
+			},
+		},
+		{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_MEDICAL_RECORD_NUMBER,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateMedicalRecordNumberConfig{
+					GenerateMedicalRecordNumberConfig: &mgmtv1alpha1.GenerateMedicalRecordNumber{},
+				},
+			},
+		},
+		{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_MEDICAL_RECORD_NUMBER,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformMedicalRecordNumberConfig{
+					TransformMedicalRecordNumberConfig: &mgmtv1alpha1.TransformMedicalRecordNumber{},
+				},
+			},
+		},
+		{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NPI,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateNpiConfig{
+					GenerateNpiConfig: &mgmtv1alpha1.GenerateNpi{},
+				},
+			},
+		},
+		{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_NPI,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformNpiConfig{
+					TransformNpiConfig: &mgmtv1alpha1.TransformNpi{},
+				},
+			},
+		},
+		{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_ICD10_CODE,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateIcd10CodeConfig{
+					GenerateIcd10CodeConfig: &mgmtv1alpha1.GenerateIcd10Code{},
+				},
+			},
+		},
+		{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_CPT_CODE,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateCptCodeConfig{
+					GenerateCptCodeConfig: &mgmtv1alpha1.GenerateCptCode{},
+				},
+			},
+		},
+		{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_VIN,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateVinConfig{
+					GenerateVinConfig: &mgmtv1alpha1.GenerateVin{},
+				},
+			},
+		},
+		{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_VIN,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformVinConfig{
+					TransformVinConfig: &mgmtv1alpha1.TransformVin{},
+				},
+			},
+		},
+		{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_IMEI,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateImeiConfig{
+					GenerateImeiConfig: &mgmtv1alpha1.GenerateImei{},
+				},
+			},
+		},
+		{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_IMEI,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformImeiConfig{
+					TransformImeiConfig: &mgmtv1alpha1.TransformImei{},
+				},
+			},
+		},
+		{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_EAN,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateEanConfig{
+					GenerateEanConfig: &mgmtv1alpha1.GenerateEan{},
+				},
+			},
+		},
+		{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EAN,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformEanConfig{
+					TransformEanConfig: &mgmtv1alpha1.TransformEan{},
+				},
+			},
+		},
 		{
 			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT,
 			Config: &mgmtv1alpha1.TransformerConfig{
@@ -4599,3 +5583,368 @@ func Test_getPrimaryKeyDependencyMap_compositekeys(t *testing.T) {
 	actual := getPrimaryKeyDependencyMap(tableDependencies)
 	require.Equal(t, expected, actual)
 }
+
+func Test_buildSynthesizeMappings(t *testing.T) {
+	mappings, err := buildSynthesizeMappings(
+		[]*mgmtv1alpha1.SynthesizeSourceSchemaOption{
+			{Schema: "public", Tables: []*mgmtv1alpha1.SynthesizeSourceTableOption{
+				{Table: "users", RowCount: 10},
+				{Table: "orders", RowCount: 50},
+			}},
+		},
+		map[string]map[string]*sql_manager.ColumnInfo{
+			"public.users": {
+				"email": {OrdinalPosition: 2, DataType: "text"},
+				"id":    {OrdinalPosition: 1, DataType: "integer"},
+			},
+			"public.orders": {
+				"id":       {OrdinalPosition: 1, DataType: "uuid"},
+				"user_id":  {OrdinalPosition: 2, DataType: "integer"},
+				"store_id": {OrdinalPosition: 3, DataType: "integer"},
+				"number":   {OrdinalPosition: 4, DataType: "integer"},
+			},
+		},
+		map[string][]string{"public.users": {"id"}, "public.orders": {"id"}},
+		map[string][][]string{
+			"public.users": {{"email"}},
+			// user_id is drawn from users so number is generated as the sequence
+			"public.orders": {{"user_id", "number"}},
+		},
+		map[string][]*sql_manager.ForeignConstraint{
+			"public.orders": {
+				{Columns: []string{"user_id"}, ForeignKey: &sql_manager.ForeignKey{Table: "public.users", Columns: []string{"id"}}},
+				// not a selected table, so it is modeled like any other column
+				{Columns: []string{"store_id"}, ForeignKey: &sql_manager.ForeignKey{Table: "public.stores", Columns: []string{"id"}}},
+			},
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, mappings, 2)
+
+	users := mappings[0]
+	require.Equal(t, "users", users.Table)
+	require.Equal(t, 10, users.Count)
+	require.Empty(t, users.DependsOn)
+	require.Equal(t, []*synthesizer.ColumnSpec{
+		{Name: "id", DataType: "integer", IsSequence: true},
+		{Name: "email", DataType: "text", IsSequence: true},
+	}, users.Columns)
+
+	orders := mappings[1]
+	require.Equal(t, []*tabledependency.DependsOn{{Table: "public.users", Columns: []string{"id"}}}, orders.DependsOn)
+	require.Equal(t, []*synthesizer.ColumnSpec{
+		{Name: "id", DataType: "uuid", IsSequence: true},
+		{Name: "user_id", DataType: "integer", ReferenceRowCount: shared.Ptr(int64(10))},
+		{Name: "store_id", DataType: "integer"},
+		{Name: "number", DataType: "integer", IsSequence: true},
+	}, orders.Columns)
+
+	_, err = buildSynthesizeMappings(
+		[]*mgmtv1alpha1.SynthesizeSourceSchemaOption{{Schema: "public", Tables: []*mgmtv1alpha1.SynthesizeSourceTableOption{{Table: "missing", RowCount: 1}}}},
+		map[string]map[string]*sql_manager.ColumnInfo{},
+		nil, nil, nil,
+	)
+	require.Error(t, err)
+}
+
+func Test_getSynthesizeUniqueConstraints(t *testing.T) {
+	schemaOpts := []*mgmtv1alpha1.SynthesizeSourceSchemaOption{
+		{Schema: "public", Tables: []*mgmtv1alpha1.SynthesizeSourceTableOption{{Table: "users", RowCount: 10}}},
+	}
+	actual, err := getSynthesizeUniqueConstraints(
+		schemaOpts,
+		map[string][][]string{"public.users": {{"id"}}},
+		map[string][]*sql_manager.PartialUniqueIndex{
+			"public.users": {{Name: "users_active_email_idx", Columns: []string{"email"}, Predicate: "(deleted_at IS NULL)"}},
+		},
+		map[string][]*sql_manager.ExclusionConstraint{
+			"public.users": {{Name: "users_org_handle_excl", Columns: []string{"org_id", "handle"}, Operators: []string{"=", "="}}},
+			// not a selected table
+			"public.reservations": {{Name: "reservations_room_during_excl", Columns: []string{"room", "during"}, Operators: []string{"=", "&&"}}},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, map[string][][]string{
+		"public.users": {{"id"}, {"email"}, {"org_id", "handle"}},
+	}, actual)
+
+	_, err = getSynthesizeUniqueConstraints(
+		schemaOpts,
+		nil,
+		nil,
+		map[string][]*sql_manager.ExclusionConstraint{
+			"public.users": {{Name: "users_active_excl", Columns: []string{"active_during"}, Operators: []string{"&&"}, Definition: "EXCLUDE USING gist (active_during WITH &&)"}},
+		},
+	)
+	require.EqualError(t, err, "unable to synthesize public.users: exclusion constraint users_active_excl can not be guaranteed by generated data: EXCLUDE USING gist (active_during WITH &&)")
+}
+
+func Test_getAwsS3RestoreJobRunId_Snapshot(t *testing.T) {
+	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockCreateJobConfigArtifact(mockJobClient)
+	bbuilder := newBenthosBuilder(nil, mockJobClient, nil, nil, mockJobId, mockWorkflowId, mockRunId, nil, false)
+
+	mockJobClient.On("GetJobRunSnapshot", mock.Anything, connect.NewRequest(&mgmtv1alpha1.GetJobRunSnapshotRequest{
+		AccountId: "account-id",
+		Name:      "golden-2024-06",
+	})).Return(connect.NewResponse(&mgmtv1alpha1.GetJobRunSnapshotResponse{
+		Snapshot: &mgmtv1alpha1.JobRunSnapshot{Name: "golden-2024-06", JobRunId: "run-id", ConnectionId: "conn-id"},
+	}), nil)
+
+	jobRunId, err := bbuilder.getAwsS3RestoreJobRunId(context.Background(), "account-id", &mgmtv1alpha1.AwsS3SourceConnectionOptions{
+		ConnectionId: "conn-id",
+		Run:          &mgmtv1alpha1.AwsS3SourceConnectionOptions_SnapshotName{SnapshotName: "golden-2024-06"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "run-id", jobRunId)
+
+	_, err = bbuilder.getAwsS3RestoreJobRunId(context.Background(), "account-id", &mgmtv1alpha1.AwsS3SourceConnectionOptions{
+		ConnectionId: "other-conn-id",
+		Run:          &mgmtv1alpha1.AwsS3SourceConnectionOptions_SnapshotName{SnapshotName: "golden-2024-06"},
+	})
+	require.Error(t, err)
+}
+
+func Test_getAwsS3RestoreJobRunId_JobRunId(t *testing.T) {
+	bbuilder := newBenthosBuilder(nil, nil, nil, nil, mockJobId, mockWorkflowId, mockRunId, nil, false)
+
+	jobRunId, err := bbuilder.getAwsS3RestoreJobRunId(context.Background(), "account-id", &mgmtv1alpha1.AwsS3SourceConnectionOptions{
+		ConnectionId: "conn-id",
+		Run:          &mgmtv1alpha1.AwsS3SourceConnectionOptions_JobRunId{JobRunId: "run-id"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "run-id", jobRunId)
+
+	_, err = bbuilder.getAwsS3RestoreJobRunId(context.Background(), "account-id", &mgmtv1alpha1.AwsS3SourceConnectionOptions{ConnectionId: "conn-id"})
+	require.Error(t, err)
+}
+
+func Test_addTableProcessors(t *testing.T) {
+	newResponse := func(table string, runType tabledependency.RunType, processors []*neosync_benthos.ProcessorConfig) *BenthosConfigResponse {
+		return &BenthosConfigResponse{
+			TableSchema: "public",
+			TableName:   table,
+			RunType:     runType,
+			Processors:  processors,
+			Config: &neosync_benthos.BenthosConfig{
+				StreamConfig: neosync_benthos.StreamConfig{
+					Pipeline: &neosync_benthos.PipelineConfig{Processors: []neosync_benthos.ProcessorConfig{}},
+				},
+			},
+		}
+	}
+	users := newResponse("users", tabledependency.RunTypeInsert, nil)
+	usersUpdate := newResponse("users", tabledependency.RunTypeUpdate, nil)
+	generated := newResponse("accounts", tabledependency.RunTypeInsert, []*neosync_benthos.ProcessorConfig{{Mutation: shared.Ptr("root.id = 1")}})
+	other := newResponse("other", tabledependency.RunTypeInsert, nil)
+
+	err := addTableProcessors([]*BenthosConfigResponse{users, usersUpdate, generated, other}, []*mgmtv1alpha1.JobTableProcessor{
+		{Schema: "public", Table: "users", Processors: "- log:\n    message: hello\n- mapping: root = this"},
+		{Schema: "public", Table: "accounts", Processors: "- mapping: root = this"},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, users.Config.StreamConfig.Pipeline.Processors, 2)
+	require.Equal(t, map[string]any{"log": map[string]any{"message": "hello"}}, users.Config.StreamConfig.Pipeline.Processors[0].Raw)
+	require.Empty(t, usersUpdate.Config.StreamConfig.Pipeline.Processors)
+	require.Len(t, generated.Processors, 2)
+	require.Equal(t, map[string]any{"mapping": "root = this"}, generated.Processors[1].Raw)
+	require.Empty(t, generated.Config.StreamConfig.Pipeline.Processors)
+	require.Empty(t, other.Config.StreamConfig.Pipeline.Processors)
+
+	out, err := yaml.Marshal(users.Config.StreamConfig.Pipeline.Processors)
+	require.NoError(t, err)
+	require.Equal(t, "- log:\n    message: hello\n- mapping: root = this\n", string(out))
+
+	err = addTableProcessors([]*BenthosConfigResponse{users}, []*mgmtv1alpha1.JobTableProcessor{
+		{Schema: "public", Table: "users", Processors: "mapping: root = this"},
+	})
+	require.Error(t, err)
+
+	err = addTableProcessors([]*BenthosConfigResponse{other}, []*mgmtv1alpha1.JobTableProcessor{
+		{Schema: "public", Table: "other", Processors: "- command:\n    name: rm"},
+	})
+	require.Error(t, err)
+	require.Empty(t, other.Config.StreamConfig.Pipeline.Processors)
+}
+
+func Test_addJavascriptRuntimeConfigs(t *testing.T) {
+	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
+	mockTransformerClient.On("GetJavascriptLibraries", mock.Anything, connect.NewRequest(&mgmtv1alpha1.GetJavascriptLibrariesRequest{AccountId: "account-id"})).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetJavascriptLibrariesResponse{
+			Libraries: []*mgmtv1alpha1.JavascriptLibrary{{Name: "helpers", Code: "module.exports = {};"}},
+		}), nil).Once()
+	bbuilder := newBenthosBuilder(nil, nil, nil, mockTransformerClient, mockJobId, mockWorkflowId, mockRunId, nil, false)
+
+	withLibraries := &BenthosConfigResponse{
+		Config: &neosync_benthos.BenthosConfig{
+			StreamConfig: neosync_benthos.StreamConfig{
+				Pipeline: &neosync_benthos.PipelineConfig{Processors: []neosync_benthos.ProcessorConfig{
+					{NeosyncJavascript: &neosync_benthos.NeosyncJavascriptConfig{Code: `require("helpers");`}},
+				}},
+			},
+		},
+	}
+	generated := &BenthosConfigResponse{
+		Processors: []*neosync_benthos.ProcessorConfig{{NeosyncJavascript: &neosync_benthos.NeosyncJavascriptConfig{Code: "1 + 1"}}},
+	}
+
+	err := bbuilder.addJavascriptRuntimeConfigs(context.Background(), []*BenthosConfigResponse{withLibraries, generated}, "account-id")
+	require.NoError(t, err)
+
+	js := withLibraries.Config.StreamConfig.Pipeline.Processors[0].NeosyncJavascript
+	require.Equal(t, map[string]string{"helpers": "module.exports = {};"}, js.Libraries)
+	require.NotNil(t, js.Seed)
+	require.Nil(t, generated.Processors[0].NeosyncJavascript.Libraries)
+	require.Equal(t, js.Seed, generated.Processors[0].NeosyncJavascript.Seed)
+}
+
+func Test_buildBenthosCustomSourceConfigResponses(t *testing.T) {
+	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
+	customConfig := &mgmtv1alpha1.CustomConnectionConfig{Plugin: "warehouse", Options: map[string]string{"region": "eu"}}
+
+	responses, err := buildBenthosCustomSourceConfigResponses(
+		context.Background(),
+		mockTransformerClient,
+		map[string]*tableMapping{
+			"public.users": {Schema: "public", Table: "users", Mappings: []*mgmtv1alpha1.JobMapping{
+				{Schema: "public", Table: "users", Column: "id", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH}},
+				{Schema: "public", Table: "users", Column: "name", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH}},
+			}},
+		},
+		[]*tabledependency.RunConfig{
+			{Table: "public.users", SelectColumns: []string{"id", "name"}, InsertColumns: []string{"id", "name"}, RunType: tabledependency.RunTypeInsert},
+		},
+		customConfig,
+		mockJobId,
+		mockRunId,
+	)
+	require.NoError(t, err)
+	require.Len(t, responses, 1)
+	require.Equal(t, &neosync_benthos.NeosyncPluginInput{
+		Plugin:  "warehouse",
+		Schema:  "public",
+		Table:   "users",
+		Columns: []string{"id", "name"},
+		Options: map[string]string{"region": "eu"},
+	}, responses[0].Config.Input.NeosyncPlugin)
+	require.Equal(t, []string{"id", "name"}, responses[0].Columns)
+
+	outputs := getCustomSinkBenthosOutput(
+		&mgmtv1alpha1.ConnectionConfig_CustomConfig{CustomConfig: customConfig},
+		responses[0],
+		&sql_manager.SchemaTable{Schema: "public", Table: "people"},
+		map[string]*sql_manager.DestinationColumn{"name": {Name: "full_name"}},
+	)
+	require.Len(t, outputs, 1)
+	pluginOutput := outputs[0].Fallback[0].NeosyncPlugin
+	require.NotNil(t, pluginOutput)
+	require.Equal(t, "people", pluginOutput.Table)
+	require.Equal(t, []string{"id", "full_name"}, pluginOutput.Columns)
+	require.Equal(t, `root = [this."id", this."name"]`, pluginOutput.ArgsMapping)
+}
+
+func Test_getDuckdbSyncBenthosOutput(t *testing.T) {
+	outputs := getDuckdbSyncBenthosOutput(
+		&mgmtv1alpha1.ConnectionConfig_DuckdbConfig{DuckdbConfig: &mgmtv1alpha1.DuckdbConnectionConfig{ParquetDirectory: shared.Ptr("/data/lake")}},
+		&BenthosConfigResponse{TableSchema: "public", TableName: "users", Columns: []string{"id", "name"}},
+		&sql_manager.SchemaTable{Schema: "public", Table: "people"},
+		map[string]*sql_manager.DestinationColumn{"name": {Name: "full_name"}},
+	)
+	require.Len(t, outputs, 1)
+	require.Len(t, outputs[0].Fallback, 2)
+	duckdbOutput := outputs[0].Fallback[0].NeosyncDuckdb
+	require.NotNil(t, duckdbOutput)
+	require.Equal(t, "", duckdbOutput.Path)
+	require.Equal(t, "/data/lake", duckdbOutput.ParquetDirectory)
+	require.Equal(t, "public", duckdbOutput.Schema)
+	require.Equal(t, "people", duckdbOutput.Table)
+	require.Equal(t, []string{"id", "full_name"}, duckdbOutput.Columns)
+	require.Equal(t, `root = [this."id", this."name"]`, duckdbOutput.ArgsMapping)
+	require.NotNil(t, outputs[0].Fallback[1].Error)
+}
+
+func Test_BuildColumnMutation(t *testing.T) {
+	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
+	ctx := context.Background()
+
+	mutation, err := BuildColumnMutation(ctx, mockTransformerClient, &mgmtv1alpha1.JobMapping{
+		Schema: "public", Table: "users", Column: "id",
+		Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH},
+	})
+	require.NoError(t, err)
+	require.Empty(t, mutation)
+
+	mutation, err = BuildColumnMutation(ctx, mockTransformerClient, &mgmtv1alpha1.JobMapping{
+		Schema: "public", Table: "users", Column: "status",
+		Transformer: &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STATIC_VALUE,
+			Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_GenerateStaticValueConfig{
+				GenerateStaticValueConfig: &mgmtv1alpha1.GenerateStaticValue{Value: "active"},
+			}},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, `"active"`, mutation)
+
+	mutation, err = BuildColumnMutation(ctx, mockTransformerClient, &mgmtv1alpha1.JobMapping{
+		Schema: "public", Table: "users", Column: "created_at",
+		Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "null", mutation)
+
+	_, err = BuildColumnMutation(ctx, mockTransformerClient, &mgmtv1alpha1.JobMapping{
+		Schema: "public", Table: "users", Column: "address",
+		Transformer: &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_JAVASCRIPT,
+			Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_TransformJavascriptConfig{
+				TransformJavascriptConfig: &mgmtv1alpha1.TransformJavascript{Code: transformJsCodeFnStr},
+			}},
+		},
+	})
+	require.Error(t, err)
+}
+
+func Test_BenthosBuilder_attachJobConfigArtifact(t *testing.T) {
+	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	job := &mgmtv1alpha1.Job{
+		Id:              mockJobId,
+		AccountId:       "5629813e-1a35-4874-922c-9827d85f0378",
+		UpdatedByUserId: "d5e29f1f-b920-458c-8b86-f3a180e06d98",
+		Mappings: []*mgmtv1alpha1.JobMapping{
+			{Schema: "public", Table: "users", Column: "email", Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL,
+			}},
+		},
+	}
+	mockJobClient.On("CreateJobRunArtifact", mock.Anything, mock.MatchedBy(func(req *connect.Request[mgmtv1alpha1.CreateJobRunArtifactRequest]) bool {
+		snapshot := &mgmtv1alpha1.Job{}
+		if err := protojson.Unmarshal(req.Msg.GetContent(), snapshot); err != nil {
+			return false
+		}
+		return req.Msg.GetAccountId() == job.GetAccountId() &&
+			req.Msg.GetJobRunId() == mockWorkflowId &&
+			req.Msg.GetType() == mgmtv1alpha1.JobRunArtifactType_JOB_RUN_ARTIFACT_TYPE_JOB_CONFIG &&
+			proto.Equal(job, snapshot)
+	})).Return(connect.NewResponse(&mgmtv1alpha1.CreateJobRunArtifactResponse{}), nil).Once()
+
+	bbuilder := newBenthosBuilder(nil, mockJobClient, nil, nil, mockJobId, mockWorkflowId, mockRunId, nil, false)
+	bbuilder.attachJobConfigArtifact(context.Background(), slog.Default(), job)
+}
+
+func Test_BenthosBuilder_attachJobConfigArtifact_NotEnabled(t *testing.T) {
+	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockJobClient.On("CreateJobRunArtifact", mock.Anything, mock.Anything).
+		Return(nil, connect.NewError(connect.CodeUnimplemented, errors.New("artifacts are not enabled"))).Once()
+
+	bbuilder := newBenthosBuilder(nil, mockJobClient, nil, nil, mockJobId, mockWorkflowId, mockRunId, nil, false)
+	// must not fail or panic, artifacts are optional
+	bbuilder.attachJobConfigArtifact(context.Background(), slog.Default(), &mgmtv1alpha1.Job{Id: mockJobId})
+}
+
+// The job config artifact is attached to every run that generates its configs
+func mockCreateJobConfigArtifact(mockJobClient *mgmtv1alpha1connect.MockJobServiceClient) {
+	mockJobClient.On("CreateJobRunArtifact", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.CreateJobRunArtifactResponse{}), nil).Maybe()
+}
@@ -0,0 +1,182 @@
+package genbenthosconfigs_activity
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/pkg/metrics"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/internal/benthos"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+)
+
+// Builds configs that read the job's tables from a custom connection using the worker plugin the connection is configured with
+func (b *benthosBuilder) getCustomSourceBenthosConfigResponses(
+	ctx context.Context,
+	job *mgmtv1alpha1.Job,
+	slogger *slog.Logger,
+) ([]*BenthosConfigResponse, error) {
+	sourceConnection, err := shared.GetJobSourceConnection(ctx, job.GetSource(), b.connclient)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get connection by id: %w", err)
+	}
+	customConfig := sourceConnection.GetConnectionConfig().GetCustomConfig()
+	if customConfig == nil {
+		return nil, fmt.Errorf("source connection is not a custom connection, has: %T", sourceConnection.GetConnectionConfig().GetConfig())
+	}
+
+	// the plugin does not expose constraints, so the table order is determined by the destination if there is a SQL one
+	tableConstraints := &sql_manager.TableConstraints{
+		ForeignKeyConstraints: map[string][]*sql_manager.ForeignConstraint{},
+		PrimaryKeyConstraints: map[string][]string{},
+	}
+	destinationConnection, err := b.getFirstSqlDestinationConnection(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+	if destinationConnection != nil {
+		db, err := b.sqlmanager.NewPooledSqlDb(ctx, slogger, destinationConnection)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create new sql db: %w", err)
+		}
+		defer db.Db.Close()
+		tableConstraints, err = db.Db.GetTableConstraintsBySchema(ctx, shared.GetUniqueSchemasFromMappings(job.GetMappings()))
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve destination database table constraints: %w", err)
+		}
+	}
+
+	groupedMappings := groupMappingsByTable(job.GetMappings())
+	tableColMap := getTableColMapFromMappings(groupedMappings)
+	runConfigs, err := tabledependency.GetRunConfigs(tableConstraints.ForeignKeyConstraints, map[string]string{}, tableConstraints.PrimaryKeyConstraints, tableColMap)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildBenthosCustomSourceConfigResponses(
+		ctx,
+		b.transformerclient,
+		getTableMappingsMap(groupedMappings),
+		runConfigs,
+		customConfig,
+		b.jobId,
+		b.runId,
+	)
+}
+
+func buildBenthosCustomSourceConfigResponses(
+	ctx context.Context,
+	transformerclient mgmtv1alpha1connect.TransformersServiceClient,
+	groupedTableMapping map[string]*tableMapping,
+	runconfigs []*tabledependency.RunConfig,
+	customConfig *mgmtv1alpha1.CustomConnectionConfig,
+	jobId, runId string,
+) ([]*BenthosConfigResponse, error) {
+	responses := []*BenthosConfigResponse{}
+
+	for _, config := range runconfigs {
+		mappings, ok := groupedTableMapping[config.Table]
+		if !ok {
+			return nil, fmt.Errorf("missing column mappings for table: %s", config.Table)
+		}
+
+		bc := &neosync_benthos.BenthosConfig{
+			StreamConfig: neosync_benthos.StreamConfig{
+				Input: &neosync_benthos.InputConfig{
+					Inputs: neosync_benthos.Inputs{
+						NeosyncPlugin: &neosync_benthos.NeosyncPluginInput{
+							Plugin:  customConfig.GetPlugin(),
+							Schema:  mappings.Schema,
+							Table:   mappings.Table,
+							Columns: config.SelectColumns,
+							Options: customConfig.GetOptions(),
+						},
+					},
+				},
+				Pipeline: &neosync_benthos.PipelineConfig{
+					Threads:    -1,
+					Processors: []neosync_benthos.ProcessorConfig{},
+				},
+				Output: &neosync_benthos.OutputConfig{
+					Outputs: neosync_benthos.Outputs{
+						Broker: &neosync_benthos.OutputBrokerConfig{
+							Pattern: "fan_out",
+							Outputs: []neosync_benthos.Outputs{},
+						},
+					},
+				},
+			},
+		}
+
+		processorConfigs, err := buildProcessorConfigs(ctx, transformerclient, mappings.Mappings, map[string]*sql_manager.ColumnInfo{}, map[string][]*referenceKey{}, []string{}, jobId, runId, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, pc := range processorConfigs {
+			bc.StreamConfig.Pipeline.Processors = append(bc.StreamConfig.Pipeline.Processors, *pc)
+		}
+
+		responses = append(responses, &BenthosConfigResponse{
+			Name:      fmt.Sprintf("%s.%s", config.Table, config.RunType),
+			Config:    bc,
+			DependsOn: config.DependsOn,
+			RunType:   config.RunType,
+
+			TableSchema:    mappings.Schema,
+			TableName:      mappings.Table,
+			Columns:        filterDroppedColumns(config.InsertColumns, mappings.Mappings),
+			primaryKeys:    config.PrimaryKeys,
+			sqlExpressions: buildSqlExpressionMap(mappings.Mappings),
+
+			metriclabels: metrics.MetricLabels{
+				metrics.NewEqLabel(metrics.TableSchemaLabel, mappings.Schema),
+				metrics.NewEqLabel(metrics.TableNameLabel, mappings.Table),
+				metrics.NewEqLabel(metrics.JobTypeLabel, "custom"),
+			},
+		})
+	}
+
+	return responses, nil
+}
+
+// Writes the table to a custom connection using the worker plugin the connection is configured with
+func getCustomSinkBenthosOutput(
+	connection *mgmtv1alpha1.ConnectionConfig_CustomConfig,
+	benthosConfig *BenthosConfigResponse,
+	destTable *sql_manager.SchemaTable,
+	destColumns map[string]*sql_manager.DestinationColumn,
+) []neosync_benthos.Outputs {
+	return []neosync_benthos.Outputs{
+		{
+			Fallback: []neosync_benthos.Outputs{
+				{
+					NeosyncPlugin: &neosync_benthos.NeosyncPluginOutput{
+						Plugin:      connection.CustomConfig.GetPlugin(),
+						Schema:      destTable.Schema,
+						Table:       destTable.Table,
+						Columns:     shared.GetDestinationColumnNames(destColumns, benthosConfig.Columns),
+						Options:     connection.CustomConfig.GetOptions(),
+						ArgsMapping: buildPlainInsertArgs(benthosConfig.Columns),
+						MaxInFlight: 64,
+						Batching: &neosync_benthos.Batching{
+							Period: "5s",
+							Count:  100,
+						},
+					},
+				},
+				// kills activity depending on error
+				{Error: &neosync_benthos.ErrorOutputConfig{
+					ErrorMsg: `${! meta("fallback_error")}`,
+					Batching: &neosync_benthos.Batching{
+						Period: "5s",
+						Count:  100,
+					},
+				}},
+			},
+		},
+	}
+}
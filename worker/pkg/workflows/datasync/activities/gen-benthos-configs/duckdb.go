@@ -0,0 +1,50 @@
+package genbenthosconfigs_activity
+
+import (
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/internal/benthos"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+)
+
+// Writes the table to a DuckDB database, or as Parquet files to the connection's parquet directory.
+// The column types are inferred from the rows as the table does not need to exist up front
+func getDuckdbSyncBenthosOutput(
+	connection *mgmtv1alpha1.ConnectionConfig_DuckdbConfig,
+	benthosConfig *BenthosConfigResponse,
+	destTable *sql_manager.SchemaTable,
+	destColumns map[string]*sql_manager.DestinationColumn,
+) []neosync_benthos.Outputs {
+	return []neosync_benthos.Outputs{
+		{
+			Fallback: []neosync_benthos.Outputs{
+				{
+					NeosyncDuckdb: &neosync_benthos.NeosyncDuckdbOutput{
+						Path:             connection.DuckdbConfig.GetPath(),
+						ParquetDirectory: connection.DuckdbConfig.GetParquetDirectory(),
+						Schema:           destTable.Schema,
+						Table:            destTable.Table,
+						Columns:          shared.GetDestinationColumnNames(destColumns, benthosConfig.Columns),
+						ArgsMapping:      buildPlainInsertArgs(benthosConfig.Columns),
+						MaxInFlight:      64,
+						Batching: &neosync_benthos.Batching{
+							Period: "5s",
+							Count:  100,
+							Processors: []*neosync_benthos.BatchProcessor{
+								{NeosyncNonFinite: &neosync_benthos.NeosyncNonFiniteProcessor{}},
+							},
+						},
+					},
+				},
+				// kills activity depending on error
+				{Error: &neosync_benthos.ErrorOutputConfig{
+					ErrorMsg: `${! meta("fallback_error")}`,
+					Batching: &neosync_benthos.Batching{
+						Period: "5s",
+						Count:  100,
+					},
+				}},
+			},
+		},
+	}
+}
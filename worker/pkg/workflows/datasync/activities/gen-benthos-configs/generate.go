@@ -10,6 +10,7 @@ import (
 	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
 	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
 	neosync_benthos "github.com/nucleuscloud/neosync/worker/internal/benthos"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 )
 
 func (b *benthosBuilder) getGenerateBenthosConfigResponses(
@@ -57,6 +58,7 @@ func buildBenthosGenerateSourceConfigResponses(
 		if err != nil {
 			return nil, err
 		}
+		jsBatchCode, jsBatchSize := extractJsBatchFunctionsAndOutputs(tableMapping.Mappings)
 
 		mutations, err := buildMutationConfigs(ctx, transformerclient, tableMapping.Mappings, columnInfo)
 		if err != nil {
@@ -71,7 +73,10 @@ func buildBenthosGenerateSourceConfigResponses(
 		processors = append(processors, &neosync_benthos.ProcessorConfig{Mutation: &mutations})
 
 		if jsCode != "" {
-			processors = append(processors, &neosync_benthos.ProcessorConfig{Javascript: &neosync_benthos.JavascriptConfig{Code: jsCode}})
+			processors = append(processors, &neosync_benthos.ProcessorConfig{NeosyncJavascript: &neosync_benthos.NeosyncJavascriptConfig{Code: jsCode}})
+		}
+		if jsBatchCode != "" {
+			processors = append(processors, &neosync_benthos.ProcessorConfig{NeosyncJavascript: &neosync_benthos.NeosyncJavascriptConfig{Code: jsBatchCode, BatchSize: jsBatchSize}})
 		}
 		if len(processors) > 0 {
 			// add catch and error processor
@@ -115,10 +120,12 @@ func buildBenthosGenerateSourceConfigResponses(
 
 			TableSchema: tableMapping.Schema,
 			TableName:   tableMapping.Table,
-			Columns:     buildPlainColumns(tableMapping.Mappings),
+			Columns:     filterDroppedColumns(buildPlainColumns(tableMapping.Mappings), tableMapping.Mappings),
 
 			Processors: processors,
 
+			sqlExpressions: buildSqlExpressionMap(tableMapping.Mappings),
+
 			metriclabels: metrics.MetricLabels{
 				metrics.NewEqLabel(metrics.TableSchemaLabel, tableMapping.Schema),
 				metrics.NewEqLabel(metrics.TableNameLabel, tableMapping.Table),
@@ -134,6 +141,8 @@ func (b *benthosBuilder) getSqlGenerateOutput(
 	driver string,
 	benthosConfig *BenthosConfigResponse,
 	destination *mgmtv1alpha1.JobDestination,
+	destTable *sql_manager.SchemaTable,
+	destColumns map[string]*sql_manager.DestinationColumn,
 	dsn string,
 ) []neosync_benthos.Outputs {
 	outputs := []neosync_benthos.Outputs{}
@@ -158,17 +167,19 @@ func (b *benthosBuilder) getSqlGenerateOutput(
 								Driver: driver,
 								Dsn:    dsn,
 
-								Schema:              benthosConfig.TableSchema,
-								Table:               benthosConfig.TableName,
-								Columns:             benthosConfig.Columns,
+								Schema:              destTable.Schema,
+								Table:               destTable.Table,
+								Columns:             shared.GetDestinationColumnNames(destColumns, benthosConfig.Columns),
+								ColumnExpressions:   getDestinationSqlExpressions(destColumns, benthosConfig.sqlExpressions),
 								OnConflictDoNothing: destOpts.OnConflictDoNothing,
 								TruncateOnRetry:     destOpts.Truncate,
 
 								ArgsMapping: buildPlainInsertArgs(benthosConfig.Columns),
 
+								AdaptiveBatching: true,
 								Batching: &neosync_benthos.Batching{
 									Period: "5s",
-									Count:  100,
+									Count:  maxInsertBatchCount,
 								},
 							},
 						},
@@ -194,6 +205,8 @@ func (b *benthosBuilder) getSqlAiGenerateOutput(
 	driver string,
 	benthosConfig *BenthosConfigResponse,
 	destination *mgmtv1alpha1.JobDestination,
+	destTable *sql_manager.SchemaTable,
+	destColumns map[string]*sql_manager.DestinationColumn,
 	dsn string,
 	aiGroupedTableCols map[string][]string,
 ) ([]neosync_benthos.Outputs, error) {
@@ -225,17 +238,18 @@ func (b *benthosBuilder) getSqlAiGenerateOutput(
 								Driver: driver,
 								Dsn:    dsn,
 
-								Schema:              benthosConfig.TableSchema,
-								Table:               benthosConfig.TableName,
-								Columns:             cols,
+								Schema:              destTable.Schema,
+								Table:               destTable.Table,
+								Columns:             shared.GetDestinationColumnNames(destColumns, cols),
 								OnConflictDoNothing: destOpts.OnConflictDoNothing,
 								TruncateOnRetry:     destOpts.Truncate,
 
 								ArgsMapping: buildPlainInsertArgs(cols),
 
+								AdaptiveBatching: true,
 								Batching: &neosync_benthos.Batching{
 									Period: "5s",
-									Count:  100,
+									Count:  maxInsertBatchCount,
 								},
 							},
 						},
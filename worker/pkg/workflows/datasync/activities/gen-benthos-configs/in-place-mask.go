@@ -0,0 +1,230 @@
+package genbenthosconfigs_activity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/pkg/metrics"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/internal/benthos"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+)
+
+const defaultInPlaceMaskBatchSize = 100000
+
+// The batches that each table of an in place mask job is updated in
+type InPlaceMaskPlan struct {
+	// Set if the run only plans the batches and does not update any rows
+	DryRun bool
+	Tables []*InPlaceMaskTable
+}
+
+type InPlaceMaskTable struct {
+	Schema           string
+	Table            string
+	Columns          []string
+	TotalRows        int64
+	TotalBatches     uint32
+	CompletedBatches uint32
+}
+
+// Records that all of the table's batches have been updated
+func (p *InPlaceMaskPlan) CompleteTable(schema, table string) {
+	if t := p.getTable(schema, table); t != nil {
+		t.CompletedBatches = t.TotalBatches
+	}
+}
+
+// Records the number of the table's batches that have been updated so far.
+// Progress never goes backwards and is capped at the planned batches, as rows may have been added since the table was counted
+func (p *InPlaceMaskPlan) SetCompletedBatches(schema, table string, completed uint32) {
+	t := p.getTable(schema, table)
+	if t == nil {
+		return
+	}
+	completed = min(completed, t.TotalBatches)
+	if completed > t.CompletedBatches {
+		t.CompletedBatches = completed
+	}
+}
+
+func (p *InPlaceMaskPlan) getTable(schema, table string) *InPlaceMaskTable {
+	if p == nil {
+		return nil
+	}
+	for _, t := range p.Tables {
+		if t.Schema == schema && t.Table == table {
+			return t
+		}
+	}
+	return nil
+}
+
+// Builds configs that mask the job's source database in place.
+// Each table with transformed columns gets a single config that reads its rows back in pages of batch size rows ordered by primary key
+// and overwrites them with UPDATE statements, so only one batch of a table is held at a time. Separate tables run in parallel.
+func (b *benthosBuilder) getInPlaceMaskBenthosConfigResponses(
+	ctx context.Context,
+	job *mgmtv1alpha1.Job,
+	slogger *slog.Logger,
+) ([]*BenthosConfigResponse, *InPlaceMaskPlan, error) {
+	opts := job.GetSource().GetOptions().GetInPlaceMask()
+	connection, err := shared.GetJobSourceConnection(ctx, job.GetSource(), b.connclient)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get connection by id: %w", err)
+	}
+	db, err := b.sqlmanager.NewPooledSqlDb(ctx, slogger, connection)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create new sql db: %w", err)
+	}
+	defer db.Db.Close()
+
+	groupedSchemas, err := db.Db.GetSchemaColumnMap(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get database schema for connection: %w", err)
+	}
+	if !areMappingsSubsetOfSchemas(groupedSchemas, job.GetMappings()) {
+		return nil, nil, errors.New(jobmappingSubsetErrMsg)
+	}
+	tableConstraints, err := db.Db.GetTableConstraintsBySchema(ctx, shared.GetUniqueSchemasFromMappings(job.GetMappings()))
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to retrieve database table constraints: %w", err)
+	}
+	tables, err := getInPlaceMaskTables(job.GetMappings(), tableConstraints)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	batchSize := uint(defaultInPlaceMaskBatchSize)
+	if opts.BatchSize != nil {
+		batchSize = uint(opts.GetBatchSize())
+	}
+	plan := &InPlaceMaskPlan{DryRun: opts.GetDryRun()}
+	responses := []*BenthosConfigResponse{}
+	for _, tm := range tables {
+		table := neosync_benthos.BuildBenthosTable(tm.Schema, tm.Table)
+		rowCount, err := db.Db.GetTableRowCount(ctx, tm.Schema, tm.Table, nil)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to count rows of %s: %w", table, err)
+		}
+		plan.Tables = append(plan.Tables, &InPlaceMaskTable{
+			Schema:       tm.Schema,
+			Table:        tm.Table,
+			Columns:      buildPlainColumns(tm.Mappings),
+			TotalRows:    rowCount,
+			TotalBatches: getInPlaceMaskBatchCount(rowCount, batchSize),
+		})
+		if plan.DryRun {
+			continue
+		}
+
+		resp, err := buildInPlaceMaskConfigResponse(ctx, b.transformerclient, tm, groupedSchemas[table], tableConstraints.PrimaryKeyConstraints[table], batchSize, connection.GetId(), db.Driver, b.jobId, b.runId)
+		if err != nil {
+			return nil, nil, err
+		}
+		responses = append(responses, resp)
+	}
+	slogger.Info(fmt.Sprintf("masking %d tables in place in batches of %d rows", len(plan.Tables), batchSize), "dryRun", plan.DryRun)
+	return responses, plan, nil
+}
+
+// Returns the number of batches of batch size rows that the table is read in
+func getInPlaceMaskBatchCount(rowCount int64, batchSize uint) uint32 {
+	if rowCount <= 0 || batchSize == 0 {
+		return 0
+	}
+	return uint32((uint64(rowCount) + uint64(batchSize) - 1) / uint64(batchSize))
+}
+
+// Returns the tables that have transformed columns along with the mappings of just those columns, ordered by table
+func getInPlaceMaskTables(
+	mappings []*mgmtv1alpha1.JobMapping,
+	tableConstraints *sql_manager.TableConstraints,
+) ([]*tableMapping, error) {
+	groupedMappings := groupMappingsByTable(mappings)
+	sort.Slice(groupedMappings, func(i, j int) bool {
+		return neosync_benthos.BuildBenthosTable(groupedMappings[i].Schema, groupedMappings[i].Table) <
+			neosync_benthos.BuildBenthosTable(groupedMappings[j].Schema, groupedMappings[j].Table)
+	})
+
+	tables := []*tableMapping{}
+	for _, tm := range groupedMappings {
+		table := neosync_benthos.BuildBenthosTable(tm.Schema, tm.Table)
+		maskedMappings, err := getInPlaceMaskedMappings(table, tm.Mappings, tableConstraints)
+		if err != nil {
+			return nil, err
+		}
+		if len(maskedMappings) == 0 {
+			continue
+		}
+		if len(tableConstraints.PrimaryKeyConstraints[table]) == 0 {
+			return nil, fmt.Errorf("unable to mask %s in place as it does not have a primary key to update its rows by", table)
+		}
+		tables = append(tables, &tableMapping{Schema: tm.Schema, Table: tm.Table, Mappings: maskedMappings})
+	}
+	return tables, nil
+}
+
+// Builds the config that pages through the table's rows by primary key and updates the masked columns of each row
+func buildInPlaceMaskConfigResponse(
+	ctx context.Context,
+	transformerclient mgmtv1alpha1connect.TransformersServiceClient,
+	tm *tableMapping,
+	columnInfo map[string]*sql_manager.ColumnInfo,
+	primaryKeys []string,
+	batchSize uint,
+	connectionId string,
+	driver string,
+	jobId, runId string,
+) (*BenthosConfigResponse, error) {
+	table := neosync_benthos.BuildBenthosTable(tm.Schema, tm.Table)
+	maskedColumns := buildPlainColumns(tm.Mappings)
+	processorConfigs, err := buildProcessorConfigs(ctx, transformerclient, tm.Mappings, columnInfo, nil, nil, jobId, runId, nil)
+	if err != nil {
+		return nil, err
+	}
+	processors := make([]neosync_benthos.ProcessorConfig, 0, len(processorConfigs))
+	for _, pc := range processorConfigs {
+		processors = append(processors, *pc)
+	}
+
+	input := &neosync_benthos.InputConfig{
+		Inputs: neosync_benthos.Inputs{
+			PooledSqlPaged: &neosync_benthos.InputPooledSqlPaged{
+				Driver:     driver,
+				Dsn:        "${SOURCE_CONNECTION_DSN}",
+				Schema:     tm.Schema,
+				Table:      tm.Table,
+				Columns:    append(slices.Clone(primaryKeys), maskedColumns...),
+				KeyColumns: primaryKeys,
+				PageSize:   int(batchSize),
+			},
+		},
+	}
+
+	return &BenthosConfigResponse{
+		Name:    fmt.Sprintf("%s.%s", table, tabledependency.RunTypeUpdate),
+		Config:  buildInPlaceUpdateBenthosConfig(driver, tm.Schema, tm.Table, input, processors, maskedColumns, primaryKeys),
+		RunType: tabledependency.RunTypeUpdate,
+
+		BenthosDsns: []*shared.BenthosDsn{{ConnectionId: connectionId, EnvVarKey: "SOURCE_CONNECTION_DSN"}},
+
+		TableSchema: tm.Schema,
+		TableName:   tm.Table,
+		Columns:     maskedColumns,
+		primaryKeys: primaryKeys,
+
+		metriclabels: metrics.MetricLabels{
+			metrics.NewEqLabel(metrics.TableSchemaLabel, tm.Schema),
+			metrics.NewEqLabel(metrics.TableNameLabel, tm.Table),
+			metrics.NewEqLabel(metrics.JobTypeLabel, "in-place-mask"),
+		},
+	}, nil
+}
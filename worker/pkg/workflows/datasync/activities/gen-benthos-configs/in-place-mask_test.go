@@ -0,0 +1,78 @@
+package genbenthosconfigs_activity
+
+import (
+	"context"
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/internal/benthos"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_buildInPlaceMaskConfigResponse(t *testing.T) {
+	tm := &tableMapping{
+		Schema: "public",
+		Table:  "users",
+		Mappings: []*mgmtv1alpha1.JobMapping{
+			{Schema: "public", Table: "users", Column: "email", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NULL}},
+		},
+	}
+	columnInfo := map[string]*sql_manager.ColumnInfo{"id": {DataType: "integer"}, "email": {DataType: "text"}}
+
+	actual, err := buildInPlaceMaskConfigResponse(context.Background(), nil, tm, columnInfo, []string{"id"}, 100, "source", sql_manager.PostgresDriver, "job", "run")
+	require.NoError(t, err)
+
+	require.Equal(t, "public.users.update", actual.Name)
+	require.Empty(t, actual.DependsOn)
+	require.Equal(t, tabledependency.RunTypeUpdate, actual.RunType)
+	require.Equal(t, []*shared.BenthosDsn{{ConnectionId: "source", EnvVarKey: "SOURCE_CONNECTION_DSN"}}, actual.BenthosDsns)
+	require.Nil(t, actual.Config.Input.PooledSqlRaw)
+	require.Equal(t, &neosync_benthos.InputPooledSqlPaged{
+		Driver:     sql_manager.PostgresDriver,
+		Dsn:        "${SOURCE_CONNECTION_DSN}",
+		Schema:     "public",
+		Table:      "users",
+		Columns:    []string{"id", "email"},
+		KeyColumns: []string{"id"},
+		PageSize:   100,
+	}, actual.Config.Input.PooledSqlPaged)
+	require.NotEmpty(t, actual.Config.Pipeline.Processors)
+}
+
+func Test_getInPlaceMaskBatchCount(t *testing.T) {
+	require.Equal(t, uint32(0), getInPlaceMaskBatchCount(0, 100))
+	require.Equal(t, uint32(1), getInPlaceMaskBatchCount(1, 100))
+	require.Equal(t, uint32(1), getInPlaceMaskBatchCount(100, 100))
+	require.Equal(t, uint32(2), getInPlaceMaskBatchCount(101, 100))
+	require.Equal(t, uint32(3), getInPlaceMaskBatchCount(300, 100))
+}
+
+func Test_InPlaceMaskPlan_CompleteTable(t *testing.T) {
+	plan := &InPlaceMaskPlan{Tables: []*InPlaceMaskTable{{Schema: "public", Table: "users", TotalBatches: 2}}}
+	plan.CompleteTable("public", "users")
+	plan.CompleteTable("public", "orders")
+	require.Equal(t, uint32(2), plan.Tables[0].CompletedBatches)
+
+	var nilPlan *InPlaceMaskPlan
+	require.NotPanics(t, func() { nilPlan.CompleteTable("public", "users") })
+}
+
+func Test_InPlaceMaskPlan_SetCompletedBatches(t *testing.T) {
+	plan := &InPlaceMaskPlan{Tables: []*InPlaceMaskTable{{Schema: "public", Table: "users", TotalBatches: 3}}}
+	plan.SetCompletedBatches("public", "users", 2)
+	require.Equal(t, uint32(2), plan.Tables[0].CompletedBatches)
+
+	plan.SetCompletedBatches("public", "users", 1)
+	require.Equal(t, uint32(2), plan.Tables[0].CompletedBatches, "progress does not go backwards")
+
+	plan.SetCompletedBatches("public", "users", 5)
+	require.Equal(t, uint32(3), plan.Tables[0].CompletedBatches, "progress is capped at the planned batches")
+
+	plan.SetCompletedBatches("public", "orders", 1)
+
+	var nilPlan *InPlaceMaskPlan
+	require.NotPanics(t, func() { nilPlan.SetCompletedBatches("public", "users", 1) })
+}
@@ -0,0 +1,139 @@
+package genbenthosconfigs_activity
+
+import (
+	"fmt"
+	"strings"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/internal/benthos"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+)
+
+const (
+	// headers that are set on every record with the schema and table that the record was read from.
+	// These are used by the backend to filter a topic down to a single table when previewing its data
+	kafkaSchemaHeader = "neosync_schema"
+	kafkaTableHeader  = "neosync_table"
+)
+
+// Builds the output that writes the rows of a table to the topic of a Kafka connection.
+// Every table of the job is written to the same topic and is told apart by the record headers
+func getKafkaSyncBenthosOutput(
+	connection *mgmtv1alpha1.ConnectionConfig_KafkaConfig,
+	destination *mgmtv1alpha1.JobDestination,
+	benthosConfig *BenthosConfigResponse,
+) []neosync_benthos.Outputs {
+	kafkaConfig := connection.KafkaConfig
+
+	processors := []*neosync_benthos.BatchProcessor{
+		{Mutation: shared.Ptr(fmt.Sprintf("meta %s = %q\nmeta %s = %q", kafkaSchemaHeader, benthosConfig.TableSchema, kafkaTableHeader, benthosConfig.TableName))},
+	}
+	if kafkaConfig.GetSerializationFormat() == mgmtv1alpha1.KafkaSerializationFormat_KAFKA_SERIALIZATION_FORMAT_AVRO {
+		processors = append(processors,
+			&neosync_benthos.BatchProcessor{NeosyncNonFinite: &neosync_benthos.NeosyncNonFiniteProcessor{}},
+			&neosync_benthos.BatchProcessor{SchemaRegistryEncode: buildSchemaRegistryEncodeProcessor(kafkaConfig)},
+		)
+	} else {
+		processors = append(processors,
+			&neosync_benthos.BatchProcessor{NeosyncBinaryEncode: &neosync_benthos.NeosyncBinaryEncodeProcessor{}},
+			&neosync_benthos.BatchProcessor{NeosyncNonFinite: &neosync_benthos.NeosyncNonFiniteProcessor{}},
+		)
+	}
+
+	return []neosync_benthos.Outputs{
+		{
+			Fallback: []neosync_benthos.Outputs{
+				{
+					KafkaFranz: &neosync_benthos.KafkaFranzOutput{
+						SeedBrokers: kafkaConfig.GetBrokers(),
+						Topic:       kafkaConfig.GetTopic(),
+						Key:         buildKafkaRecordKey(benthosConfig.primaryKeys),
+						Metadata: &neosync_benthos.KafkaFranzMetadata{
+							IncludePatterns: []string{fmt.Sprintf("^%s$", kafkaSchemaHeader), fmt.Sprintf("^%s$", kafkaTableHeader)},
+						},
+						MaxInFlight: 64,
+						Compression: destination.GetOptions().GetKafkaOptions().GetCompression(),
+						Batching: &neosync_benthos.Batching{
+							Count:      100,
+							Period:     "5s",
+							Processors: processors,
+						},
+						Tls:  buildKafkaFranzTls(kafkaConfig.GetTls()),
+						Sasl: buildKafkaFranzSasl(kafkaConfig.GetSasl()),
+					},
+				},
+				// kills activity depending on error
+				{Error: &neosync_benthos.ErrorOutputConfig{
+					ErrorMsg: `${! meta("fallback_error")}`,
+					Batching: &neosync_benthos.Batching{
+						Period: "5s",
+						Count:  100,
+					},
+				}},
+			},
+		},
+	}
+}
+
+// Records are keyed by the primary key of their row so that every change to a row lands on the same partition.
+// Tables without a primary key are spread across the partitions
+func buildKafkaRecordKey(primaryKeys []string) string {
+	if len(primaryKeys) == 0 {
+		return ""
+	}
+	pieces := make([]string, 0, len(primaryKeys))
+	for _, key := range primaryKeys {
+		pieces = append(pieces, fmt.Sprintf("${! json(%q) }", key))
+	}
+	return strings.Join(pieces, "|")
+}
+
+func buildSchemaRegistryEncodeProcessor(config *mgmtv1alpha1.KafkaConnectionConfig) *neosync_benthos.SchemaRegistryEncodeProcessor {
+	registry := config.GetSchemaRegistry()
+	subject := registry.GetSubject()
+	if subject == "" {
+		subject = fmt.Sprintf("%s-value", config.GetTopic())
+	}
+	processor := &neosync_benthos.SchemaRegistryEncodeProcessor{
+		Url:         registry.GetUrl(),
+		Subject:     subject,
+		AvroRawJson: true,
+	}
+	if registry.Username != nil {
+		processor.BasicAuth = &neosync_benthos.BasicAuth{
+			Enabled:  true,
+			Username: registry.GetUsername(),
+			Password: registry.GetPassword(),
+		}
+	}
+	return processor
+}
+
+func buildKafkaFranzTls(config *mgmtv1alpha1.ClientTlsConfig) *neosync_benthos.KafkaFranzTls {
+	if config == nil {
+		return nil
+	}
+	tls := &neosync_benthos.KafkaFranzTls{Enabled: true, RootCas: config.GetRootCert()}
+	if config.GetClientCert() != "" && config.GetClientKey() != "" {
+		tls.ClientCerts = []*neosync_benthos.ClientCert{{Cert: config.GetClientCert(), Key: config.GetClientKey()}}
+	}
+	return tls
+}
+
+func buildKafkaFranzSasl(config *mgmtv1alpha1.KafkaSaslConfig) []*neosync_benthos.KafkaFranzSasl {
+	if config == nil {
+		return nil
+	}
+	var mechanism string
+	switch config.GetMechanism() {
+	case mgmtv1alpha1.KafkaSaslMechanism_KAFKA_SASL_MECHANISM_PLAIN:
+		mechanism = "PLAIN"
+	case mgmtv1alpha1.KafkaSaslMechanism_KAFKA_SASL_MECHANISM_SCRAM_SHA_256:
+		mechanism = "SCRAM-SHA-256"
+	case mgmtv1alpha1.KafkaSaslMechanism_KAFKA_SASL_MECHANISM_SCRAM_SHA_512:
+		mechanism = "SCRAM-SHA-512"
+	default:
+		return nil
+	}
+	return []*neosync_benthos.KafkaFranzSasl{{Mechanism: mechanism, Username: config.GetUsername(), Password: config.GetPassword()}}
+}
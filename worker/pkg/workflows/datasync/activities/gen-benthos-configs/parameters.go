@@ -0,0 +1,338 @@
+package genbenthosconfigs_activity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/doug-martin/goqu/v9"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Matches {{ name }} as well as {{ name.start }} and {{ name.end }} for date range parameters
+var jobParameterRefRegex = regexp.MustCompile(`\{\{\s*([a-z][a-z0-9_]*)(?:\.(start|end))?\s*\}\}`)
+
+// The resolved values of the job's parameters for the current run
+type jobRunParameters struct {
+	types  map[string]mgmtv1alpha1.JobParameterType
+	values map[string]string
+}
+
+// Returns nil if the job does not define any parameters
+func (b *benthosBuilder) getJobRunParameters(
+	ctx context.Context,
+	job *mgmtv1alpha1.Job,
+) (*jobRunParameters, error) {
+	if len(job.GetParameters()) == 0 {
+		return nil, nil
+	}
+	resp, err := b.jobclient.GetJobRunParameters(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRunParametersRequest{
+		JobId:    job.GetId(),
+		JobRunId: b.workflowId,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return newJobRunParameters(job.GetParameters(), resp.Msg.GetParameters()), nil
+}
+
+func newJobRunParameters(
+	parameters []*mgmtv1alpha1.JobParameter,
+	values []*mgmtv1alpha1.JobParameterValue,
+) *jobRunParameters {
+	params := &jobRunParameters{
+		types:  map[string]mgmtv1alpha1.JobParameterType{},
+		values: map[string]string{},
+	}
+	for _, parameter := range parameters {
+		params.types[parameter.GetName()] = parameter.GetType()
+	}
+	for _, value := range values {
+		params.values[value.GetName()] = value.GetValue()
+	}
+	return params
+}
+
+// Renders a parameter value for where it is substituted
+type renderJobParameterFunc func(parameterType mgmtv1alpha1.JobParameterType, value string) (string, error)
+
+// Replaces the parameter references in the input with their values.
+// The render func, if provided, is applied to each value before it is substituted
+func (p *jobRunParameters) substitute(input string, render renderJobParameterFunc) (string, error) {
+	var substituteErr error
+	output := jobParameterRefRegex.ReplaceAllStringFunc(input, func(ref string) string {
+		if substituteErr != nil {
+			return ref
+		}
+		parameterType, value, err := p.getValue(ref)
+		if err != nil {
+			substituteErr = err
+			return ref
+		}
+		if render == nil {
+			return value
+		}
+		rendered, err := render(parameterType, value)
+		if err != nil {
+			substituteErr = fmt.Errorf("unable to render job parameter %s: %w", ref, err)
+			return ref
+		}
+		return rendered
+	})
+	if substituteErr != nil {
+		return "", substituteErr
+	}
+	return output, nil
+}
+
+// Returns the type and value of the referenced parameter. The start and end of a date range are dates
+func (p *jobRunParameters) getValue(ref string) (mgmtv1alpha1.JobParameterType, string, error) {
+	matches := jobParameterRefRegex.FindStringSubmatch(ref)
+	name, part := matches[1], matches[2]
+	value, ok := p.values[name]
+	if !ok {
+		return 0, "", fmt.Errorf("unknown job parameter referenced: %s", ref)
+	}
+	if part == "" {
+		return p.types[name], value, nil
+	}
+	if p.types[name] != mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_DATE_RANGE {
+		return 0, "", fmt.Errorf("only date range job parameters have a start and an end: %s", ref)
+	}
+	start, end, ok := strings.Cut(value, "/")
+	if !ok {
+		return 0, "", fmt.Errorf("job parameter %s is not a date range: %s", name, value)
+	}
+	if part == "start" {
+		return mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_DATE, start, nil
+	}
+	return mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_DATE, end, nil
+}
+
+// Returns a copy of the job with the parameter references in its subset where clauses, tenant extract and transformer configs replaced by their values.
+// References in where clauses are replaced by complete SQL literals of the source's dialect, and references in javascript code by complete
+// javascript literals, so they must not be quoted
+func applyJobParameters(job *mgmtv1alpha1.Job, params *jobRunParameters) (*mgmtv1alpha1.Job, error) {
+	if params == nil {
+		return job, nil
+	}
+	job = proto.Clone(job).(*mgmtv1alpha1.Job)
+
+	driver := sql_manager.PostgresDriver
+	whereClauses := []*string{}
+	for _, schemaOpt := range job.GetSource().GetOptions().GetPostgres().GetSchemas() {
+		for _, tableOpt := range schemaOpt.GetTables() {
+			whereClauses = append(whereClauses, tableOpt.WhereClause)
+		}
+	}
+	if job.GetSource().GetOptions().GetMysql() != nil {
+		driver = sql_manager.MysqlDriver
+		for _, schemaOpt := range job.GetSource().GetOptions().GetMysql().GetSchemas() {
+			for _, tableOpt := range schemaOpt.GetTables() {
+				whereClauses = append(whereClauses, tableOpt.WhereClause)
+			}
+		}
+	}
+	for _, where := range whereClauses {
+		if where == nil {
+			continue
+		}
+		substituted, err := params.substitute(*where, func(parameterType mgmtv1alpha1.JobParameterType, value string) (string, error) {
+			return buildJobParameterSqlLiteral(driver, parameterType, value)
+		})
+		if err != nil {
+			return nil, err
+		}
+		*where = substituted
+	}
+
+	tenantExtract := job.GetSource().GetOptions().GetPostgres().GetTenantExtract()
+	if tenantExtract == nil {
+		tenantExtract = job.GetSource().GetOptions().GetMysql().GetTenantExtract()
+	}
+	if tenantExtract != nil {
+		// the tenant value is rendered as a literal when the tenant where clauses are built
+		substituted, err := params.substitute(tenantExtract.GetTenantValue(), nil)
+		if err != nil {
+			return nil, fmt.Errorf("unable to apply job parameters to tenant extract: %w", err)
+		}
+		tenantExtract.TenantValue = substituted
+	}
+
+	for _, mapping := range job.GetMappings() {
+		config := mapping.GetTransformer().GetConfig()
+		if config == nil {
+			continue
+		}
+		render, err := getTransformerJobParameterRender(config)
+		if err != nil {
+			return nil, fmt.Errorf("unable to apply job parameters to transformer of %s.%s.%s: %w", mapping.GetSchema(), mapping.GetTable(), mapping.GetColumn(), err)
+		}
+		err = substituteMessageStrings(config.ProtoReflect(), func(value string) (string, error) {
+			return params.substitute(value, render)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to apply job parameters to transformer of %s.%s.%s: %w", mapping.GetSchema(), mapping.GetTable(), mapping.GetColumn(), err)
+		}
+	}
+	return job, nil
+}
+
+// Returns a copy of the destination connection with the parameter references in its bucket path prefix replaced by their values.
+// The values may not contain / or .. so that they can not move the prefix
+func applyConnectionParameters(connection *mgmtv1alpha1.Connection, params *jobRunParameters) (*mgmtv1alpha1.Connection, error) {
+	if params == nil {
+		return connection, nil
+	}
+	connection = proto.Clone(connection).(*mgmtv1alpha1.Connection)
+
+	var pathPrefix *string
+	switch config := connection.GetConnectionConfig().GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_AwsS3Config:
+		pathPrefix = config.AwsS3Config.PathPrefix
+	case *mgmtv1alpha1.ConnectionConfig_GcpCloudstorageConfig:
+		pathPrefix = config.GcpCloudstorageConfig.PathPrefix
+	case *mgmtv1alpha1.ConnectionConfig_AzureBlobConfig:
+		pathPrefix = config.AzureBlobConfig.PathPrefix
+	}
+	if pathPrefix == nil {
+		return connection, nil
+	}
+	substituted, err := params.substitute(*pathPrefix, func(parameterType mgmtv1alpha1.JobParameterType, value string) (string, error) {
+		if strings.Contains(value, "/") || strings.Contains(value, "..") {
+			return "", fmt.Errorf("%q can not be used in a path prefix as it contains / or ..", value)
+		}
+		return value, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	*pathPrefix = substituted
+	return connection, nil
+}
+
+// Returns the render func for values substituted into the transformer config, nil if they are substituted as is
+func getTransformerJobParameterRender(config *mgmtv1alpha1.TransformerConfig) (renderJobParameterFunc, error) {
+	switch config.GetConfig().(type) {
+	case *mgmtv1alpha1.TransformerConfig_TransformJavascriptConfig, *mgmtv1alpha1.TransformerConfig_GenerateJavascriptConfig:
+		return buildJobParameterJavascriptLiteral, nil
+	case *mgmtv1alpha1.TransformerConfig_TransformCharacterScrambleConfig:
+		return func(parameterType mgmtv1alpha1.JobParameterType, value string) (string, error) {
+			return regexp.QuoteMeta(value), nil
+		}, nil
+	case *mgmtv1alpha1.TransformerConfig_SqlExpressionConfig:
+		// the expression runs on the destination, whose dialect is not known here
+		if jobParameterRefRegex.MatchString(config.GetSqlExpressionConfig().GetExpression()) {
+			return nil, errors.New("job parameters can not be referenced in sql expressions")
+		}
+		return nil, nil
+	default:
+		return nil, nil
+	}
+}
+
+// Renders the parameter value as a SQL literal of the driver's dialect.
+// Integers and booleans are rendered as numbers and booleans, every other type as a quoted string
+func buildJobParameterSqlLiteral(driver string, parameterType mgmtv1alpha1.JobParameterType, value string) (string, error) {
+	literal, err := parseJobParameterValue(parameterType, value)
+	if err != nil {
+		return "", err
+	}
+	return buildSqlLiteral(driver, literal)
+}
+
+// Renders the parameter value as a javascript literal, with the same types as SQL literals
+func buildJobParameterJavascriptLiteral(parameterType mgmtv1alpha1.JobParameterType, value string) (string, error) {
+	literal, err := parseJobParameterValue(parameterType, value)
+	if err != nil {
+		return "", err
+	}
+	bits, err := json.Marshal(literal)
+	if err != nil {
+		return "", fmt.Errorf("unable to render javascript literal: %w", err)
+	}
+	return string(bits), nil
+}
+
+func parseJobParameterValue(parameterType mgmtv1alpha1.JobParameterType, value string) (any, error) {
+	switch parameterType {
+	case mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_INTEGER:
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an integer", value)
+		}
+		return parsed, nil
+	case mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_BOOLEAN:
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a boolean", value)
+		}
+		return parsed, nil
+	default:
+		return value, nil
+	}
+}
+
+// Renders the value as a complete SQL literal, quoted and escaped by the driver's dialect
+func buildSqlLiteral(driver string, value any) (string, error) {
+	query, _, err := goqu.Dialect(driver).Select(goqu.V(value)).ToSQL()
+	if err != nil {
+		return "", fmt.Errorf("unable to render sql literal: %w", err)
+	}
+	return strings.TrimPrefix(query, "SELECT "), nil
+}
+
+// Applies the substitute func to every string field of the message and its nested messages
+func substituteMessageStrings(msg protoreflect.Message, substitute func(string) (string, error)) error {
+	type populatedField struct {
+		fd    protoreflect.FieldDescriptor
+		value protoreflect.Value
+	}
+	fields := []populatedField{}
+	msg.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		fields = append(fields, populatedField{fd: fd, value: value})
+		return true
+	})
+
+	for _, field := range fields {
+		switch {
+		case field.fd.IsMap():
+			continue
+		case field.fd.IsList():
+			list := field.value.List()
+			for idx := 0; idx < list.Len(); idx++ {
+				switch field.fd.Kind() {
+				case protoreflect.StringKind:
+					substituted, err := substitute(list.Get(idx).String())
+					if err != nil {
+						return err
+					}
+					list.Set(idx, protoreflect.ValueOfString(substituted))
+				case protoreflect.MessageKind:
+					if err := substituteMessageStrings(list.Get(idx).Message(), substitute); err != nil {
+						return err
+					}
+				}
+			}
+		case field.fd.Kind() == protoreflect.StringKind:
+			substituted, err := substitute(field.value.String())
+			if err != nil {
+				return err
+			}
+			msg.Set(field.fd, protoreflect.ValueOfString(substituted))
+		case field.fd.Kind() == protoreflect.MessageKind:
+			if err := substituteMessageStrings(field.value.Message(), substitute); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
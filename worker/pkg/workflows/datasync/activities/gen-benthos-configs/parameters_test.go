@@ -0,0 +1,277 @@
+package genbenthosconfigs_activity
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func Test_getJobRunParameters(t *testing.T) {
+	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	bbuilder := newBenthosBuilder(nil, mockJobClient, nil, nil, mockJobId, mockWorkflowId, mockRunId, nil, false)
+
+	params, err := bbuilder.getJobRunParameters(context.Background(), &mgmtv1alpha1.Job{Id: mockJobId})
+	require.NoError(t, err)
+	require.Nil(t, params)
+
+	mockJobClient.On("GetJobRunParameters", mock.Anything, connect.NewRequest(&mgmtv1alpha1.GetJobRunParametersRequest{
+		JobId:    mockJobId,
+		JobRunId: mockWorkflowId,
+	})).Return(connect.NewResponse(&mgmtv1alpha1.GetJobRunParametersResponse{
+		Parameters: []*mgmtv1alpha1.JobParameterValue{{Name: "tenant_id", Value: "acme"}},
+	}), nil)
+
+	params, err = bbuilder.getJobRunParameters(context.Background(), &mgmtv1alpha1.Job{
+		Id:         mockJobId,
+		Parameters: []*mgmtv1alpha1.JobParameter{{Name: "tenant_id", Type: mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_STRING}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"tenant_id": "acme"}, params.values)
+}
+
+func Test_jobRunParameters_substitute(t *testing.T) {
+	params := mockJobRunParameters()
+
+	renderPostgres := func(parameterType mgmtv1alpha1.JobParameterType, value string) (string, error) {
+		return buildJobParameterSqlLiteral(sql_manager.PostgresDriver, parameterType, value)
+	}
+
+	tests := []struct {
+		input    string
+		render   renderJobParameterFunc
+		expected string
+	}{
+		{"tenant_id = '{{ tenant_id }}'", nil, "tenant_id = 'o'neil'"},
+		{"tenant_id = {{tenant_id}}", renderPostgres, "tenant_id = 'o''neil'"},
+		{"created_at >= {{ date_range.start }} AND created_at <= {{ date_range.end }}", renderPostgres, "created_at >= '2024-01-01' AND created_at <= '2024-01-31'"},
+		{"exports/{{ date_range }}", nil, "exports/2024-01-01/2024-01-31"},
+		{"no references", nil, "no references"},
+		{"{{ Tenant_id }}", nil, "{{ Tenant_id }}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			output, err := params.substitute(tt.input, tt.render)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, output)
+		})
+	}
+
+	_, err := params.substitute("{{ unknown }}", nil)
+	require.Error(t, err)
+	_, err = params.substitute("{{ tenant_id.start }}", nil)
+	require.Error(t, err)
+}
+
+func Test_applyJobParameters(t *testing.T) {
+	job := &mgmtv1alpha1.Job{
+		Source: &mgmtv1alpha1.JobSource{
+			Options: &mgmtv1alpha1.JobSourceOptions{
+				Config: &mgmtv1alpha1.JobSourceOptions_Postgres{
+					Postgres: &mgmtv1alpha1.PostgresSourceConnectionOptions{
+						Schemas: []*mgmtv1alpha1.PostgresSourceSchemaOption{
+							{Schema: "public", Tables: []*mgmtv1alpha1.PostgresSourceTableOption{
+								{Table: "users", WhereClause: shared.Ptr("tenant_id = {{ tenant_id }}")},
+								{Table: "orders"},
+							}},
+						},
+						TenantExtract: &mgmtv1alpha1.TenantExtractOptions{TenantValue: "{{ tenant_id }}", TenantColumn: shared.Ptr("tenant_id")},
+					},
+				},
+			},
+		},
+		Mappings: []*mgmtv1alpha1.JobMapping{
+			{Schema: "public", Table: "users", Column: "tier", Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_CATEGORICAL,
+				Config: &mgmtv1alpha1.TransformerConfig{
+					Config: &mgmtv1alpha1.TransformerConfig_GenerateCategoricalConfig{
+						GenerateCategoricalConfig: &mgmtv1alpha1.GenerateCategorical{Categories: "{{ tenant_id }}-free,{{ tenant_id }}-paid"},
+					},
+				},
+			}},
+			{Schema: "public", Table: "users", Column: "id", Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH,
+			}},
+		},
+	}
+
+	output, err := applyJobParameters(job, nil)
+	require.NoError(t, err)
+	require.Same(t, job, output)
+
+	output, err = applyJobParameters(job, mockJobRunParameters())
+	require.NoError(t, err)
+	require.Equal(t, "tenant_id = 'o''neil'", output.GetSource().GetOptions().GetPostgres().GetSchemas()[0].GetTables()[0].GetWhereClause())
+	require.Nil(t, output.GetSource().GetOptions().GetPostgres().GetSchemas()[0].GetTables()[1].WhereClause)
+	require.Equal(t, "o'neil", output.GetSource().GetOptions().GetPostgres().GetTenantExtract().GetTenantValue())
+	require.Equal(t, "o'neil-free,o'neil-paid", output.GetMappings()[0].GetTransformer().GetConfig().GetGenerateCategoricalConfig().GetCategories())
+	// the original job is left untouched
+	require.Equal(t, "tenant_id = {{ tenant_id }}", job.GetSource().GetOptions().GetPostgres().GetSchemas()[0].GetTables()[0].GetWhereClause())
+
+	job.Mappings[0].Transformer.Config.GetGenerateCategoricalConfig().Categories = "{{ unknown }}"
+	_, err = applyJobParameters(job, mockJobRunParameters())
+	require.Error(t, err)
+}
+
+func Test_applyJobParameters_Mysql(t *testing.T) {
+	job := &mgmtv1alpha1.Job{
+		Source: &mgmtv1alpha1.JobSource{
+			Options: &mgmtv1alpha1.JobSourceOptions{
+				Config: &mgmtv1alpha1.JobSourceOptions_Mysql{
+					Mysql: &mgmtv1alpha1.MysqlSourceConnectionOptions{
+						Schemas: []*mgmtv1alpha1.MysqlSourceSchemaOption{
+							{Schema: "public", Tables: []*mgmtv1alpha1.MysqlSourceTableOption{
+								{Table: "users", WhereClause: shared.Ptr("tenant_id = {{ tenant_id }}")},
+							}},
+						},
+					},
+				},
+			},
+		},
+	}
+	params := newJobRunParameters(
+		[]*mgmtv1alpha1.JobParameter{{Name: "tenant_id", Type: mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_STRING}},
+		[]*mgmtv1alpha1.JobParameterValue{{Name: "tenant_id", Value: `acme\' OR 1=1 -- `}},
+	)
+
+	output, err := applyJobParameters(job, params)
+	require.NoError(t, err)
+	require.Equal(t, `tenant_id = 'acme\\\' OR 1=1 -- '`, output.GetSource().GetOptions().GetMysql().GetSchemas()[0].GetTables()[0].GetWhereClause())
+}
+
+func Test_applyJobParameters_TransformerConfigs(t *testing.T) {
+	newJob := func(config *mgmtv1alpha1.TransformerConfig) *mgmtv1alpha1.Job {
+		return &mgmtv1alpha1.Job{
+			Mappings: []*mgmtv1alpha1.JobMapping{
+				{Schema: "public", Table: "users", Column: "name", Transformer: &mgmtv1alpha1.JobMappingTransformer{Config: config}},
+			},
+		}
+	}
+	params := newJobRunParameters(
+		[]*mgmtv1alpha1.JobParameter{
+			{Name: "tenant_id", Type: mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_STRING},
+			{Name: "limit", Type: mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_INTEGER},
+		},
+		[]*mgmtv1alpha1.JobParameterValue{
+			{Name: "tenant_id", Value: `acme"; require('child_process'); "`},
+			{Name: "limit", Value: "10"},
+		},
+	)
+
+	output, err := applyJobParameters(newJob(&mgmtv1alpha1.TransformerConfig{
+		Config: &mgmtv1alpha1.TransformerConfig_TransformJavascriptConfig{
+			TransformJavascriptConfig: &mgmtv1alpha1.TransformJavascript{Code: "return value + {{ tenant_id }} + {{ limit }};"},
+		},
+	}), params)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		`return value + "acme\"; require('child_process'); \"" + 10;`,
+		output.GetMappings()[0].GetTransformer().GetConfig().GetTransformJavascriptConfig().GetCode(),
+	)
+
+	output, err = applyJobParameters(newJob(&mgmtv1alpha1.TransformerConfig{
+		Config: &mgmtv1alpha1.TransformerConfig_TransformCharacterScrambleConfig{
+			TransformCharacterScrambleConfig: &mgmtv1alpha1.TransformCharacterScramble{UserProvidedRegex: shared.Ptr("^{{ limit }}.*")},
+		},
+	}), newJobRunParameters(
+		[]*mgmtv1alpha1.JobParameter{{Name: "limit", Type: mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_STRING}},
+		[]*mgmtv1alpha1.JobParameterValue{{Name: "limit", Value: "1.5"}},
+	))
+	require.NoError(t, err)
+	require.Equal(t, `^1\.5.*`, output.GetMappings()[0].GetTransformer().GetConfig().GetTransformCharacterScrambleConfig().GetUserProvidedRegex())
+
+	_, err = applyJobParameters(newJob(&mgmtv1alpha1.TransformerConfig{
+		Config: &mgmtv1alpha1.TransformerConfig_SqlExpressionConfig{
+			SqlExpressionConfig: &mgmtv1alpha1.SqlExpression{Expression: "upper({{ tenant_id }})"},
+		},
+	}), params)
+	require.Error(t, err)
+}
+
+func Test_buildJobParameterSqlLiteral(t *testing.T) {
+	tests := []struct {
+		driver        string
+		parameterType mgmtv1alpha1.JobParameterType
+		value         string
+		expected      string
+	}{
+		{sql_manager.PostgresDriver, mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_STRING, `o'neil\`, `'o''neil\'`},
+		{sql_manager.MysqlDriver, mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_STRING, `o'neil\`, `'o\'neil\\'`},
+		{sql_manager.PostgresDriver, mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_INTEGER, "-42", "-42"},
+		{sql_manager.PostgresDriver, mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_BOOLEAN, "true", "TRUE"},
+		{sql_manager.MysqlDriver, mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_BOOLEAN, "true", "1"},
+		{sql_manager.PostgresDriver, mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_DATE, "2024-01-01", "'2024-01-01'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.driver+"_"+tt.parameterType.String(), func(t *testing.T) {
+			actual, err := buildJobParameterSqlLiteral(tt.driver, tt.parameterType, tt.value)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+
+	_, err := buildJobParameterSqlLiteral(sql_manager.PostgresDriver, mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_INTEGER, "1 OR 1=1")
+	require.Error(t, err)
+}
+
+func Test_applyConnectionParameters(t *testing.T) {
+	connection := &mgmtv1alpha1.Connection{
+		ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_AwsS3Config{
+				AwsS3Config: &mgmtv1alpha1.AwsS3ConnectionConfig{
+					Bucket:     "neosync",
+					PathPrefix: shared.Ptr("/exports/{{ tenant_id }}/{{ date_range.start }}"),
+				},
+			},
+		},
+	}
+
+	output, err := applyConnectionParameters(connection, mockJobRunParameters())
+	require.NoError(t, err)
+	require.Equal(t, "/exports/o'neil/2024-01-01", output.GetConnectionConfig().GetAwsS3Config().GetPathPrefix())
+	require.Equal(t, "/exports/{{ tenant_id }}/{{ date_range.start }}", connection.GetConnectionConfig().GetAwsS3Config().GetPathPrefix())
+
+	for _, value := range []string{"../secrets", "acme/../../secrets", "2024-01-01/2024-01-31"} {
+		_, err = applyConnectionParameters(connection, newJobRunParameters(
+			[]*mgmtv1alpha1.JobParameter{
+				{Name: "tenant_id", Type: mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_STRING},
+				{Name: "date_range", Type: mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_DATE_RANGE},
+			},
+			[]*mgmtv1alpha1.JobParameterValue{
+				{Name: "tenant_id", Value: value},
+				{Name: "date_range", Value: "2024-01-01/2024-01-31"},
+			},
+		))
+		require.Error(t, err, value)
+	}
+
+	pgConnection := &mgmtv1alpha1.Connection{
+		ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{},
+		},
+	}
+	output, err = applyConnectionParameters(pgConnection, mockJobRunParameters())
+	require.NoError(t, err)
+	require.True(t, proto.Equal(pgConnection, output))
+}
+
+func mockJobRunParameters() *jobRunParameters {
+	return newJobRunParameters(
+		[]*mgmtv1alpha1.JobParameter{
+			{Name: "tenant_id", Type: mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_STRING},
+			{Name: "date_range", Type: mgmtv1alpha1.JobParameterType_JOB_PARAMETER_TYPE_DATE_RANGE},
+		},
+		[]*mgmtv1alpha1.JobParameterValue{
+			{Name: "tenant_id", Value: "o'neil"},
+			{Name: "date_range", Value: "2024-01-01/2024-01-31"},
+		},
+	)
+}
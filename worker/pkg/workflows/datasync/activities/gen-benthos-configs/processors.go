@@ -18,6 +18,20 @@ import (
 	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 )
 
+const (
+	// used when a business timestamp config leaves its business hours unset
+	defaultBusinessHoursStart = 9
+	defaultBusinessHoursEnd   = 17
+
+	// used when a money config leaves its decimal places unset and the column has no numeric scale
+	defaultMoneyDecimalPlaces = 2
+	// used when a money generator config leaves its median unset
+	defaultMoneyMedian = 20
+
+	// used when a medical record number config leaves its length unset
+	defaultMedicalRecordNumberLength = 8
+)
+
 func buildSqlUpdateProcessorConfigs(
 	config *tabledependency.RunConfig,
 	redisConfig *shared.RedisConfig,
@@ -81,6 +95,7 @@ func buildProcessorConfigs(
 	if err != nil {
 		return nil, err
 	}
+	jsBatchCode, jsBatchSize := extractJsBatchFunctionsAndOutputs(cols)
 
 	mutations, err := buildMutationConfigs(ctx, transformerclient, cols, tableColumnInfo)
 	if err != nil {
@@ -102,7 +117,10 @@ func buildProcessorConfigs(
 		processorConfigs = append(processorConfigs, &neosync_benthos.ProcessorConfig{Mutation: &mutations})
 	}
 	if jsCode != "" {
-		processorConfigs = append(processorConfigs, &neosync_benthos.ProcessorConfig{Javascript: &neosync_benthos.JavascriptConfig{Code: jsCode}})
+		processorConfigs = append(processorConfigs, &neosync_benthos.ProcessorConfig{NeosyncJavascript: &neosync_benthos.NeosyncJavascriptConfig{Code: jsCode}})
+	}
+	if jsBatchCode != "" {
+		processorConfigs = append(processorConfigs, &neosync_benthos.ProcessorConfig{NeosyncJavascript: &neosync_benthos.NeosyncJavascriptConfig{Code: jsBatchCode, BatchSize: jsBatchSize}})
 	}
 	if len(cacheBranches) > 0 {
 		for _, config := range cacheBranches {
@@ -138,9 +156,13 @@ func extractJsFunctionsAndOutputs(ctx context.Context, transformerclient mgmtv1a
 			switch col.Transformer.Source {
 			case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_JAVASCRIPT:
 				code := col.Transformer.Config.GetTransformJavascriptConfig().Code
-				if code != "" {
+				if code != "" && !isBatchedJsTransformer(col.Transformer) {
 					jsFunctions = append(jsFunctions, constructJsFunction(code, col.Column, col.Transformer.Source))
-					benthosOutputs = append(benthosOutputs, constructBenthosJavascriptObject(col.Column, col.Transformer.Source))
+					if col.Transformer.Config.GetTransformJavascriptConfig().GetDeterministic() {
+						benthosOutputs = append(benthosOutputs, constructCachedBenthosJavascriptObject(col.Column))
+					} else {
+						benthosOutputs = append(benthosOutputs, constructBenthosJavascriptObject(col.Column, col.Transformer.Source))
+					}
 				}
 			case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_JAVASCRIPT:
 				code := col.Transformer.Config.GetGenerateJavascriptConfig().Code
@@ -191,6 +213,43 @@ func buildMutationConfigs(
 	return strings.Join(mutations, "\n"), nil
 }
 
+// Returns the bloblang mapping that computes the column's transformed value, or an empty string if the column is passed through as-is.
+// Javascript transformers are run by the javascript processor instead, so they are not supported here
+func BuildColumnMutation(
+	ctx context.Context,
+	transformerclient mgmtv1alpha1connect.TransformersServiceClient,
+	col *mgmtv1alpha1.JobMapping,
+) (string, error) {
+	if !shouldProcessColumn(col.Transformer) {
+		return "", nil
+	}
+	transformer := col.Transformer
+	if _, ok := transformer.Config.GetConfig().(*mgmtv1alpha1.TransformerConfig_UserDefinedTransformerConfig); ok {
+		val, err := convertUserDefinedFunctionConfig(ctx, transformerclient, transformer)
+		if err != nil {
+			return "", errors.New("unable to look up user defined transformer config by id")
+		}
+		transformer = val
+	}
+	if transformer.Source == mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_JAVASCRIPT || transformer.Source == mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_JAVASCRIPT {
+		return "", fmt.Errorf("javascript transformers are not supported for column %s.%s.%s", col.Schema, col.Table, col.Column)
+	}
+	if transformer.Source == mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT {
+		// there is no destination to compute the default, so the value is withheld
+		return shared.NullString, nil
+	}
+	mutation, err := computeMutationFunction(&mgmtv1alpha1.JobMapping{
+		Schema:      col.Schema,
+		Table:       col.Table,
+		Column:      col.Column,
+		Transformer: transformer,
+	}, nil)
+	if err != nil {
+		return "", fmt.Errorf("%s is not a supported transformer: %w", transformer, err)
+	}
+	return mutation, nil
+}
+
 func buildPrimaryKeyMappingConfigs(cols []*mgmtv1alpha1.JobMapping, primaryKeys []string) string {
 	mappings := []string{}
 	for _, col := range cols {
@@ -258,6 +317,59 @@ func buildRedisGetBranchConfig(
 	}, nil
 }
 
+func isBatchedJsTransformer(t *mgmtv1alpha1.JobMappingTransformer) bool {
+	return t.GetSource() == mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_JAVASCRIPT &&
+		t.GetConfig().GetTransformJavascriptConfig().GetBatchSize() > 0
+}
+
+// Builds the code of the javascript transformers that are run for a batch of rows at a time, along with the
+// largest batch that every one of them accepts. Must be called after extractJsFunctionsAndOutputs, which resolves user defined transformers
+func extractJsBatchFunctionsAndOutputs(cols []*mgmtv1alpha1.JobMapping) (jsCode string, batchSize int) {
+	var benthosOutputs []string
+	var jsFunctions []string
+
+	for _, col := range cols {
+		if !shouldProcessStrict(col.Transformer) || !isBatchedJsTransformer(col.Transformer) {
+			continue
+		}
+		config := col.Transformer.GetConfig().GetTransformJavascriptConfig()
+		if config.GetCode() == "" {
+			continue
+		}
+		jsFunctions = append(jsFunctions, fmt.Sprintf(`
+function fn_%s(values, inputs){
+  %s
+};
+`, col.Column, config.GetCode()))
+		benthosOutputs = append(benthosOutputs, fmt.Sprintf(`neosync_set_batch_values(outputs, "%[1]s", fn_%[1]s(inputs.map((input) => input["%[1]s"]), inputs));`, col.Column))
+		if batchSize == 0 || int(config.GetBatchSize()) < batchSize {
+			batchSize = int(config.GetBatchSize())
+		}
+	}
+
+	if len(jsFunctions) == 0 {
+		return "", 0
+	}
+	return constructBenthosJsBatchProcessor(jsFunctions, benthosOutputs), batchSize
+}
+
+func constructBenthosJsBatchProcessor(jsFunctions, benthosOutputs []string) string {
+	return fmt.Sprintf(`
+(() => {
+%s
+function neosync_set_batch_values(outputs, col, values) {
+  if (!Array.isArray(values) || values.length !== outputs.length) {
+    throw new Error("the javascript transformer for " + col + " must return an array of " + outputs.length + " values");
+  }
+  values.forEach((value, idx) => { outputs[idx][col] = value; });
+}
+const inputs = benthos.v0_batch_as_structured();
+const outputs = inputs.map((input) => ({ ...input }));
+%s
+benthos.v0_batch_set_structured(outputs);
+})();`, strings.Join(jsFunctions, "\n"), strings.Join(benthosOutputs, "\n"))
+}
+
 func constructJsFunction(jsCode, col string, source mgmtv1alpha1.TransformerSource) string {
 	switch source {
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_JAVASCRIPT:
@@ -304,6 +416,11 @@ func constructBenthosJavascriptObject(col string, source mgmtv1alpha1.Transforme
 	}
 }
 
+// The output of a deterministic transformer only depends on the value, so it is cached by the processor for values that repeat
+func constructCachedBenthosJavascriptObject(col string) string {
+	return fmt.Sprintf(`output["%[1]s"] = benthos.v0_cached("%[1]s", input["%[1]s"], () => fn_%[1]s(input["%[1]s"], input));`, col)
+}
+
 // takes in an user defined config with just an id field and return the right transformer config for that user defined function id
 func convertUserDefinedFunctionConfig(
 	ctx context.Context,
@@ -326,6 +443,17 @@ function transformers
 root.{destination_col} = transformerfunction(args)
 */
 
+// Money is rounded to the decimal places of the config, falling back to the scale of the column so that amounts fit numeric columns
+func getMoneyDecimalPlaces(configured *int32, colInfo *sql_manager.ColumnInfo) int32 {
+	if configured != nil {
+		return *configured
+	}
+	if colInfo != nil && colInfo.NumericScale != nil && *colInfo.NumericScale >= 0 {
+		return *colInfo.NumericScale
+	}
+	return defaultMoneyDecimalPlaces
+}
+
 func computeMutationFunction(col *mgmtv1alpha1.JobMapping, colInfo *sql_manager.ColumnInfo) (string, error) {
 	var maxLen int64 = 10000
 	if colInfo != nil && colInfo.CharacterMaximumLength != nil && *colInfo.CharacterMaximumLength > 0 {
@@ -361,10 +489,19 @@ func computeMutationFunction(col *mgmtv1alpha1.JobMapping, colInfo *sql_manager.
 			invalidEmailAction = mgmtv1alpha1.InvalidEmailAction_INVALID_EMAIL_ACTION_REJECT
 		}
 
-		return fmt.Sprintf(
-			"transform_email(email:this.%q,preserve_domain:%t,preserve_length:%t,excluded_domains:%v,max_length:%d,email_type:%q,invalid_email_action:%q)",
-			col.Column, pd, pl, excludedDomainsStr, maxLen, dtoEmailTypeToBenthosEmailType(emailType), dtoInvalidEmailActionToBenthosInvalidEmailAction(invalidEmailAction),
-		), nil
+		fnStr := []string{"email:this.%q", "preserve_domain:%t", "preserve_length:%t", "excluded_domains:%v", "max_length:%d", "email_type:%q", "invalid_email_action:%q"}
+		params := []any{col.Column, pd, pl, excludedDomainsStr, maxLen, dtoEmailTypeToBenthosEmailType(emailType), dtoInvalidEmailActionToBenthosInvalidEmailAction(invalidEmailAction)}
+
+		if replacementDomain := col.GetTransformer().GetConfig().GetTransformEmailConfig().GetReplacementDomain(); replacementDomain != "" {
+			fnStr = append(fnStr, "replacement_domain:%q")
+			params = append(params, replacementDomain)
+		}
+		if col.GetTransformer().GetConfig().GetTransformEmailConfig().GetPreservePlusTag() {
+			fnStr = append(fnStr, "preserve_plus_tag:%t")
+			params = append(params, true)
+		}
+		template := fmt.Sprintf("transform_email(%s)", strings.Join(fnStr, ","))
+		return fmt.Sprintf(template, params...), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_BOOL:
 		return "generate_bool()", nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_CARD_NUMBER:
@@ -517,6 +654,13 @@ func computeMutationFunction(col *mgmtv1alpha1.JobMapping, colInfo *sql_manager.
 		return fmt.Sprintf(`transform_string(value:this.%q,preserve_length:%t,min_length:%d,max_length:%d)`, col.Column, pl, minLength, maxLen), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NULL:
 		return shared.NullString, nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_DROP_COLUMN:
+		return "deleted()", nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STATIC_VALUE:
+		return fmt.Sprintf("%q", col.Transformer.Config.GetGenerateStaticValueConfig().GetValue()), nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_SQL_EXPRESSION:
+		// the expression is evaluated by sql destinations, everything else receives null instead of the source value
+		return shared.NullString, nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT:
 		return `"DEFAULT"`, nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_CHARACTER_SCRAMBLE:
@@ -528,6 +672,97 @@ func computeMutationFunction(col *mgmtv1alpha1.JobMapping, colInfo *sql_manager.
 		} else {
 			return fmt.Sprintf(`transform_character_scramble(value:this.%q)`, col.Column), nil
 		}
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_GEO_COORDINATE:
+		radius := col.GetTransformer().GetConfig().GetTransformGeoCoordinateConfig().GetRadiusMeters()
+		snap := col.GetTransformer().GetConfig().GetTransformGeoCoordinateConfig().GetSnapToCityCentroid()
+		return fmt.Sprintf(`transform_geo_coordinate(value:this.%q,radius_meters:%f,snap_to_city_centroid:%t)`, col.Column, radius, snap), nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_URL:
+		config := col.GetTransformer().GetConfig().GetTransformUrlConfig()
+		queryParamsStr, err := convertStringSliceToString(config.GetQueryParams())
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(
+			`transform_url(value:this.%q,query_params:%v,randomize_query_params:%t,strip_user_info:%t,randomize_path_identifiers:%t)`,
+			col.Column, queryParamsStr, config.GetRandomizeQueryParams(), config.GetStripUserInfo(), config.GetRandomizePathIdentifiers(),
+		), nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_USER_AGENT:
+		desktopOnly := col.GetTransformer().GetConfig().GetGenerateUserAgentConfig().GetDesktopOnly()
+		return fmt.Sprintf(`generate_user_agent(desktop_only:%t)`, desktopOnly), nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_BUSINESS_TIMESTAMP:
+		config := col.GetTransformer().GetConfig().GetGenerateBusinessTimestampConfig()
+		hoursStart := config.GetBusinessHoursStart()
+		hoursEnd := config.GetBusinessHoursEnd()
+		if hoursStart == 0 && hoursEnd == 0 {
+			hoursStart = defaultBusinessHoursStart
+			hoursEnd = defaultBusinessHoursEnd
+		}
+		fnStr := []string{"range_start_days_ago:%d", "range_end_days_ago:%d", "business_hours_weight:%f", "business_hours_start:%d", "business_hours_end:%d", "weekday_weight:%f", "timezone:%q", "max_seconds_after:%d"}
+		params := []any{config.GetRangeStartDaysAgo(), config.GetRangeEndDaysAgo(), config.GetBusinessHoursWeight(), hoursStart, hoursEnd, config.GetWeekdayWeight(), config.GetTimezone(), config.GetMaxSecondsAfter()}
+		if config.AfterColumn != nil {
+			fnStr = append([]string{"after:this.%q"}, fnStr...)
+			params = append([]any{config.GetAfterColumn()}, params...)
+		}
+		template := fmt.Sprintf("generate_business_timestamp(%s)", strings.Join(fnStr, ","))
+		return fmt.Sprintf(template, params...), nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_MONEY:
+		config := col.GetTransformer().GetConfig().GetTransformMoneyConfig()
+		decimalPlaces := getMoneyDecimalPlaces(config.DecimalPlaces, colInfo)
+		return fmt.Sprintf(`transform_money(value:this.%q,decimal_places:%d)`, col.Column, decimalPlaces), nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_MONEY:
+		config := col.GetTransformer().GetConfig().GetGenerateMoneyConfig()
+		median := config.GetMedian()
+		if median == 0 {
+			median = defaultMoneyMedian
+		}
+		fnStr := []string{"median:%f", "sigma:%f", "decimal_places:%d"}
+		params := []any{median, config.GetSigma(), getMoneyDecimalPlaces(config.DecimalPlaces, colInfo)}
+		if config.Min != nil {
+			fnStr = append(fnStr, "min:%f")
+			params = append(params, config.GetMin())
+		}
+		if config.Max != nil {
+			fnStr = append(fnStr, "max:%f")
+			params = append(params, config.GetMax())
+		}
+		template := fmt.Sprintf("generate_money(%s)", strings.Join(fnStr, ","))
+		return fmt.Sprintf(template, params...), nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_MEDICAL_RECORD_NUMBER:
+		config := col.GetTransformer().GetConfig().GetGenerateMedicalRecordNumberConfig()
+		length := config.GetLength()
+		if config.Length == nil {
+			length = defaultMedicalRecordNumberLength
+		}
+		return fmt.Sprintf(`generate_medical_record_number(prefix:%q,length:%d)`, config.GetPrefix(), length), nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_MEDICAL_RECORD_NUMBER:
+		preserveLetters := col.GetTransformer().GetConfig().GetTransformMedicalRecordNumberConfig().GetPreserveLetters()
+		return fmt.Sprintf(`transform_medical_record_number(value:this.%q,preserve_letters:%t)`, col.Column, preserveLetters), nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NPI:
+		return "generate_npi()", nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_NPI:
+		preserveEntityType := col.GetTransformer().GetConfig().GetTransformNpiConfig().GetPreserveEntityType()
+		return fmt.Sprintf(`transform_npi(value:this.%q,preserve_entity_type:%t)`, col.Column, preserveEntityType), nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_ICD10_CODE:
+		excludeDecimalPoint := col.GetTransformer().GetConfig().GetGenerateIcd10CodeConfig().GetExcludeDecimalPoint()
+		return fmt.Sprintf(`generate_icd10_code(exclude_decimal_point:%t)`, excludeDecimalPoint), nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_CPT_CODE:
+		return "generate_cpt_code()", nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_VIN:
+		return "generate_vin()", nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_VIN:
+		preserveManufacturer := col.GetTransformer().GetConfig().GetTransformVinConfig().GetPreserveManufacturer()
+		return fmt.Sprintf(`transform_vin(value:this.%q,preserve_manufacturer:%t)`, col.Column, preserveManufacturer), nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_IMEI:
+		return "generate_imei()", nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_IMEI:
+		preserveTypeAllocationCode := col.GetTransformer().GetConfig().GetTransformImeiConfig().GetPreserveTypeAllocationCode()
+		return fmt.Sprintf(`transform_imei(value:this.%q,preserve_type_allocation_code:%t)`, col.Column, preserveTypeAllocationCode), nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_EAN:
+		upc := col.GetTransformer().GetConfig().GetGenerateEanConfig().GetUpc()
+		return fmt.Sprintf(`generate_ean(upc:%t)`, upc), nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EAN:
+		preserveManufacturerPrefix := col.GetTransformer().GetConfig().GetTransformEanConfig().GetPreserveManufacturerPrefix()
+		return fmt.Sprintf(`transform_ean(value:this.%q,preserve_manufacturer_prefix:%t)`, col.Column, preserveManufacturerPrefix), nil
 
 	default:
 		return "", fmt.Errorf("unsupported transformer")
@@ -151,7 +151,8 @@ func buildSelectRecursiveQuery(
 	selectColumns := make([]any, len(columns))
 	for i, col := range columns {
 		colInfo := columnInfoMap[col]
-		if driver == sql_manager.PostgresDriver && colInfo != nil && colInfo.DataType == "json" {
+		// json columns, including domains based on json, can not be compared so they are selected as jsonb for the DISTINCT
+		if driver == sql_manager.PostgresDriver && colInfo != nil && (colInfo.DataType == "json" || colInfo.BaseDataType == "json") {
 			selectColumns[i] = goqu.L("to_jsonb(?)", goqu.I(buildSqlIdentifier(table, col))).As(col)
 		} else {
 			selectColumns[i] = buildSqlIdentifier(table, col)
@@ -193,6 +193,19 @@ func Test_buildSelectRecursiveQuery(t *testing.T) {
 			},
 			expected: `WITH RECURSIVE related AS (SELECT "public"."employees"."employee_id", "public"."employees"."name", "public"."employees"."manager_id", to_jsonb("public"."employees"."additional_info") AS "additional_info" FROM "public"."employees" WHERE "public"."employees"."name" = 'alisha' UNION (SELECT "public"."employees"."employee_id", "public"."employees"."name", "public"."employees"."manager_id", to_jsonb("public"."employees"."additional_info") AS "additional_info" FROM "public"."employees" INNER JOIN "related" ON ("public"."employees"."employee_id" = "related"."manager_id"))) SELECT DISTINCT "employee_id", "name", "manager_id", "additional_info" FROM "related";`,
 		},
+		{
+			name:          "json domain field",
+			driver:        sql_manager.PostgresDriver,
+			table:         "public.employees",
+			columns:       []string{"employee_id", "name", "manager_id", "additional_info"},
+			columnInfoMap: map[string]*sql_manager.ColumnInfo{"additional_info": {DataType: "employee_info", BaseDataType: "json"}},
+			joins:         []*sqlJoin{},
+			whereClauses:  []string{`"public"."employees"."name" = 'alisha'`},
+			dependencies: []*selfReferencingCircularDependency{
+				{PrimaryKeyColumns: []string{"employee_id"}, ForeignKeyColumns: [][]string{{"manager_id"}}},
+			},
+			expected: `WITH RECURSIVE related AS (SELECT "public"."employees"."employee_id", "public"."employees"."name", "public"."employees"."manager_id", to_jsonb("public"."employees"."additional_info") AS "additional_info" FROM "public"."employees" WHERE "public"."employees"."name" = 'alisha' UNION (SELECT "public"."employees"."employee_id", "public"."employees"."name", "public"."employees"."manager_id", to_jsonb("public"."employees"."additional_info") AS "additional_info" FROM "public"."employees" INNER JOIN "related" ON ("public"."employees"."employee_id" = "related"."manager_id"))) SELECT DISTINCT "employee_id", "name", "manager_id", "additional_info" FROM "related";`,
+		},
 		{
 			name:          "json field mysql",
 			driver:        sql_manager.MysqlDriver,
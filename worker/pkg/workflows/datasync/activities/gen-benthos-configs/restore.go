@@ -0,0 +1,177 @@
+package genbenthosconfigs_activity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/pkg/metrics"
+	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/internal/benthos"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+)
+
+// Builds configs that load the output of a previous job run from AWS S3 into the job's SQL destinations.
+// The restored data has already been through the previous run's transformers, so it is inserted as-is.
+func (b *benthosBuilder) getAwsS3RestoreBenthosConfigResponses(
+	ctx context.Context,
+	job *mgmtv1alpha1.Job,
+	slogger *slog.Logger,
+) ([]*BenthosConfigResponse, error) {
+	jobSource := job.GetSource()
+	sourceOptions := jobSource.GetOptions().GetAwsS3()
+	if sourceOptions == nil {
+		return nil, fmt.Errorf("job does not have AWS S3 source options, has: %T", jobSource.GetOptions().Config)
+	}
+	jobRunId, err := b.getAwsS3RestoreJobRunId(ctx, job.GetAccountId(), sourceOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, mapping := range job.GetMappings() {
+		if hasTransformer(mapping.GetTransformer().GetSource()) {
+			slogger.Warn(fmt.Sprintf("transformers are not applied when restoring from AWS S3. %s.%s.%s will be restored as-is", mapping.GetSchema(), mapping.GetTable(), mapping.GetColumn()))
+		}
+	}
+
+	// there is no source database to read constraints from, so the table order is determined by the destination
+	destinationConnection, err := b.getFirstSqlDestinationConnection(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+	if destinationConnection == nil {
+		return nil, errors.New("restoring from AWS S3 requires at least one SQL destination connection")
+	}
+	db, err := b.sqlmanager.NewPooledSqlDb(ctx, slogger, destinationConnection)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create new sql db: %w", err)
+	}
+	defer db.Db.Close()
+
+	uniqueSchemas := shared.GetUniqueSchemasFromMappings(job.GetMappings())
+	tableConstraints, err := db.Db.GetTableConstraintsBySchema(ctx, uniqueSchemas)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve destination database table constraints: %w", err)
+	}
+
+	groupedMappings := groupMappingsByTable(job.GetMappings())
+	tableColMap := getTableColMapFromMappings(groupedMappings)
+	runConfigs, err := tabledependency.GetRunConfigs(tableConstraints.ForeignKeyConstraints, map[string]string{}, tableConstraints.PrimaryKeyConstraints, tableColMap)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildBenthosAwsS3RestoreSourceConfigResponses(getTableMappingsMap(groupedMappings), runConfigs, sourceOptions.GetConnectionId(), jobRunId)
+}
+
+// Returns the job run to restore, resolving it from the named snapshot if one was provided
+func (b *benthosBuilder) getAwsS3RestoreJobRunId(
+	ctx context.Context,
+	accountId string,
+	sourceOptions *mgmtv1alpha1.AwsS3SourceConnectionOptions,
+) (string, error) {
+	switch run := sourceOptions.GetRun().(type) {
+	case *mgmtv1alpha1.AwsS3SourceConnectionOptions_JobRunId:
+		if run.JobRunId != "" {
+			return run.JobRunId, nil
+		}
+	case *mgmtv1alpha1.AwsS3SourceConnectionOptions_SnapshotName:
+		resp, err := b.jobclient.GetJobRunSnapshot(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRunSnapshotRequest{
+			AccountId: accountId,
+			Name:      run.SnapshotName,
+		}))
+		if err != nil {
+			return "", fmt.Errorf("unable to get job run snapshot %s: %w", run.SnapshotName, err)
+		}
+		snapshot := resp.Msg.GetSnapshot()
+		if snapshot.GetConnectionId() != sourceOptions.GetConnectionId() {
+			return "", fmt.Errorf("job run snapshot %s was not taken from the source connection", run.SnapshotName)
+		}
+		return snapshot.GetJobRunId(), nil
+	}
+	return "", errors.New("AWS S3 source options must specify the job run or snapshot to restore")
+}
+
+// Returns the job's first SQL destination connection, or nil if it does not have one
+func (b *benthosBuilder) getFirstSqlDestinationConnection(
+	ctx context.Context,
+	job *mgmtv1alpha1.Job,
+) (*mgmtv1alpha1.Connection, error) {
+	for _, destination := range job.GetDestinations() {
+		connection, err := shared.GetConnectionById(ctx, b.connclient, destination.GetConnectionId())
+		if err != nil {
+			return nil, fmt.Errorf("unable to get destination connection (%s) by id: %w", destination.GetConnectionId(), err)
+		}
+		switch connection.GetConnectionConfig().GetConfig().(type) {
+		case *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+			return connection, nil
+		}
+	}
+	return nil, nil
+}
+
+func buildBenthosAwsS3RestoreSourceConfigResponses(
+	groupedTableMapping map[string]*tableMapping,
+	runconfigs []*tabledependency.RunConfig,
+	connectionId string,
+	jobRunId string,
+) ([]*BenthosConfigResponse, error) {
+	responses := []*BenthosConfigResponse{}
+
+	for _, config := range runconfigs {
+		mappings, ok := groupedTableMapping[config.Table]
+		if !ok {
+			return nil, fmt.Errorf("missing column mappings for table: %s", config.Table)
+		}
+
+		bc := &neosync_benthos.BenthosConfig{
+			StreamConfig: neosync_benthos.StreamConfig{
+				Input: &neosync_benthos.InputConfig{
+					Inputs: neosync_benthos.Inputs{
+						NeosyncConnectionData: &neosync_benthos.NeosyncConnectionData{
+							ConnectionId: connectionId,
+							JobRunId:     jobRunId,
+							Schema:       mappings.Schema,
+							Table:        mappings.Table,
+						},
+					},
+				},
+				Pipeline: &neosync_benthos.PipelineConfig{
+					Threads:    -1,
+					Processors: []neosync_benthos.ProcessorConfig{},
+				},
+				Output: &neosync_benthos.OutputConfig{
+					Outputs: neosync_benthos.Outputs{
+						Broker: &neosync_benthos.OutputBrokerConfig{
+							Pattern: "fan_out",
+							Outputs: []neosync_benthos.Outputs{},
+						},
+					},
+				},
+			},
+		}
+
+		responses = append(responses, &BenthosConfigResponse{
+			Name:      fmt.Sprintf("%s.%s", config.Table, config.RunType),
+			Config:    bc,
+			DependsOn: config.DependsOn,
+			RunType:   config.RunType,
+
+			TableSchema: mappings.Schema,
+			TableName:   mappings.Table,
+			Columns:     filterDroppedColumns(config.InsertColumns, mappings.Mappings),
+			primaryKeys: config.PrimaryKeys,
+
+			metriclabels: metrics.MetricLabels{
+				metrics.NewEqLabel(metrics.TableSchemaLabel, mappings.Schema),
+				metrics.NewEqLabel(metrics.TableNameLabel, mappings.Table),
+				metrics.NewEqLabel(metrics.JobTypeLabel, "restore"),
+			},
+		})
+	}
+
+	return responses, nil
+}
@@ -0,0 +1,238 @@
+package genbenthosconfigs_activity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/pkg/metrics"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/internal/benthos"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+)
+
+// Builds configs that mask the instance restored from the job's snapshot in place.
+// Each table with transformed columns is read back by its primary key and the transformed values are written over the
+// original rows with UPDATE statements, which avoids copying every row of a large database into a destination.
+func (b *benthosBuilder) getSnapshotCloneBenthosConfigResponses(
+	ctx context.Context,
+	job *mgmtv1alpha1.Job,
+	restoredConnectionId string,
+	slogger *slog.Logger,
+) ([]*BenthosConfigResponse, error) {
+	restoredConnection, err := shared.GetConnectionById(ctx, b.connclient, restoredConnectionId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get restored connection (%s) by id: %w", restoredConnectionId, err)
+	}
+	db, err := b.sqlmanager.NewPooledSqlDb(ctx, slogger, restoredConnection)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create new sql db: %w", err)
+	}
+	defer db.Db.Close()
+
+	groupedSchemas, err := db.Db.GetSchemaColumnMap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get database schema for restored connection: %w", err)
+	}
+	if !areMappingsSubsetOfSchemas(groupedSchemas, job.GetMappings()) {
+		return nil, errors.New(jobmappingSubsetErrMsg)
+	}
+	tableConstraints, err := db.Db.GetTableConstraintsBySchema(ctx, shared.GetUniqueSchemasFromMappings(job.GetMappings()))
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve restored database table constraints: %w", err)
+	}
+
+	responses, err := buildSnapshotCloneConfigResponses(ctx, b.transformerclient, job.GetMappings(), groupedSchemas, tableConstraints, restoredConnectionId, db.Driver, b.jobId, b.runId)
+	if err != nil {
+		return nil, err
+	}
+	slogger.Info(fmt.Sprintf("masking %d tables of the restored snapshot in place", len(responses)))
+	return responses, nil
+}
+
+func buildSnapshotCloneConfigResponses(
+	ctx context.Context,
+	transformerclient mgmtv1alpha1connect.TransformersServiceClient,
+	mappings []*mgmtv1alpha1.JobMapping,
+	groupedSchemas map[string]map[string]*sql_manager.ColumnInfo,
+	tableConstraints *sql_manager.TableConstraints,
+	connectionId string,
+	driver string,
+	jobId, runId string,
+) ([]*BenthosConfigResponse, error) {
+	groupedMappings := groupMappingsByTable(mappings)
+	sort.Slice(groupedMappings, func(i, j int) bool {
+		return neosync_benthos.BuildBenthosTable(groupedMappings[i].Schema, groupedMappings[i].Table) <
+			neosync_benthos.BuildBenthosTable(groupedMappings[j].Schema, groupedMappings[j].Table)
+	})
+
+	responses := []*BenthosConfigResponse{}
+	for _, tm := range groupedMappings {
+		table := neosync_benthos.BuildBenthosTable(tm.Schema, tm.Table)
+		maskedMappings, err := getInPlaceMaskedMappings(table, tm.Mappings, tableConstraints)
+		if err != nil {
+			return nil, err
+		}
+		if len(maskedMappings) == 0 {
+			// the rows of the restored table are kept as they are
+			continue
+		}
+		primaryKeys := tableConstraints.PrimaryKeyConstraints[table]
+		if len(primaryKeys) == 0 {
+			return nil, fmt.Errorf("unable to mask %s in place as it does not have a primary key to update its rows by", table)
+		}
+
+		maskedColumns := buildPlainColumns(maskedMappings)
+		query, err := buildSelectQuery(driver, table, append(slices.Clone(primaryKeys), maskedColumns...), nil)
+		if err != nil {
+			return nil, fmt.Errorf("unable to build select query for %s: %w", table, err)
+		}
+		processorConfigs, err := buildProcessorConfigs(ctx, transformerclient, maskedMappings, groupedSchemas[table], nil, nil, jobId, runId, nil)
+		if err != nil {
+			return nil, err
+		}
+		processors := make([]neosync_benthos.ProcessorConfig, 0, len(processorConfigs))
+		for _, pc := range processorConfigs {
+			processors = append(processors, *pc)
+		}
+		input := &neosync_benthos.InputConfig{
+			Inputs: neosync_benthos.Inputs{
+				PooledSqlRaw: &neosync_benthos.InputPooledSqlRaw{
+					Driver: driver,
+					Dsn:    "${SOURCE_CONNECTION_DSN}",
+					Query:  query,
+				},
+			},
+		}
+
+		responses = append(responses, &BenthosConfigResponse{
+			Name:    fmt.Sprintf("%s.%s", table, tabledependency.RunTypeUpdate),
+			Config:  buildInPlaceUpdateBenthosConfig(driver, tm.Schema, tm.Table, input, processors, maskedColumns, primaryKeys),
+			RunType: tabledependency.RunTypeUpdate,
+
+			BenthosDsns: []*shared.BenthosDsn{{ConnectionId: connectionId, EnvVarKey: "SOURCE_CONNECTION_DSN"}},
+
+			TableSchema: tm.Schema,
+			TableName:   tm.Table,
+			Columns:     maskedColumns,
+			primaryKeys: primaryKeys,
+
+			metriclabels: metrics.MetricLabels{
+				metrics.NewEqLabel(metrics.TableSchemaLabel, tm.Schema),
+				metrics.NewEqLabel(metrics.TableNameLabel, tm.Table),
+				metrics.NewEqLabel(metrics.JobTypeLabel, "snapshot-clone"),
+			},
+		})
+	}
+	return responses, nil
+}
+
+// Returns the mappings of the columns that are overwritten in place.
+// Keys can not be transformed as the rows are updated by their primary key and foreign keys are not rewritten alongside them
+func getInPlaceMaskedMappings(
+	table string,
+	mappings []*mgmtv1alpha1.JobMapping,
+	tableConstraints *sql_manager.TableConstraints,
+) ([]*mgmtv1alpha1.JobMapping, error) {
+	keyColumns := map[string]struct{}{}
+	for _, col := range tableConstraints.PrimaryKeyConstraints[table] {
+		keyColumns[col] = struct{}{}
+	}
+	for _, fk := range tableConstraints.ForeignKeyConstraints[table] {
+		for _, col := range fk.Columns {
+			keyColumns[col] = struct{}{}
+		}
+	}
+	for _, fks := range tableConstraints.ForeignKeyConstraints {
+		for _, fk := range fks {
+			if fk.ForeignKey == nil || fk.ForeignKey.Table != table {
+				continue
+			}
+			for _, col := range fk.ForeignKey.Columns {
+				keyColumns[col] = struct{}{}
+			}
+		}
+	}
+
+	masked := []*mgmtv1alpha1.JobMapping{}
+	for _, mapping := range mappings {
+		source := mapping.GetTransformer().GetSource()
+		if !hasTransformer(source) {
+			continue
+		}
+		switch source {
+		case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_DROP_COLUMN,
+			mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT,
+			mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_SQL_EXPRESSION:
+			return nil, fmt.Errorf("unable to mask %s.%s in place: %s transformers are only supported when inserting rows", table, mapping.GetColumn(), source)
+		}
+		if _, ok := keyColumns[mapping.GetColumn()]; ok {
+			return nil, fmt.Errorf("unable to mask %s.%s in place: primary and foreign key columns can not be transformed", table, mapping.GetColumn())
+		}
+		masked = append(masked, mapping)
+	}
+	return masked, nil
+}
+
+// Builds a config that overwrites the columns of the rows read by the input, matching each row by its primary key
+func buildInPlaceUpdateBenthosConfig(
+	driver, schema, table string,
+	input *neosync_benthos.InputConfig,
+	processors []neosync_benthos.ProcessorConfig,
+	columns, primaryKeys []string,
+) *neosync_benthos.BenthosConfig {
+	args := append(slices.Clone(columns), primaryKeys...)
+	return &neosync_benthos.BenthosConfig{
+		StreamConfig: neosync_benthos.StreamConfig{
+			Input: input,
+			Pipeline: &neosync_benthos.PipelineConfig{
+				Threads:    -1,
+				Processors: processors,
+			},
+			Output: &neosync_benthos.OutputConfig{
+				Outputs: neosync_benthos.Outputs{
+					Broker: &neosync_benthos.OutputBrokerConfig{
+						Pattern: "fan_out",
+						Outputs: []neosync_benthos.Outputs{
+							{
+								Fallback: []neosync_benthos.Outputs{
+									{
+										PooledSqlUpdate: &neosync_benthos.PooledSqlUpdate{
+											Driver: driver,
+											Dsn:    "${SOURCE_CONNECTION_DSN}",
+
+											Schema:       schema,
+											Table:        table,
+											Columns:      columns,
+											WhereColumns: primaryKeys,
+											ArgsMapping:  buildPlainInsertArgs(args),
+
+											Batching: &neosync_benthos.Batching{
+												Period: "5s",
+												Count:  100,
+											},
+										},
+									},
+									// kills activity depending on error
+									{Error: &neosync_benthos.ErrorOutputConfig{
+										ErrorMsg: `${! meta("fallback_error")}`,
+										Batching: &neosync_benthos.Batching{
+											Period: "5s",
+											Count:  100,
+										},
+									}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,98 @@
+package genbenthosconfigs_activity
+
+import (
+	"context"
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_buildSnapshotCloneConfigResponses(t *testing.T) {
+	emailTransformer := &mgmtv1alpha1.JobMappingTransformer{
+		Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL,
+		Config: &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_TransformEmailConfig{
+				TransformEmailConfig: &mgmtv1alpha1.TransformEmail{PreserveDomain: true},
+			},
+		},
+	}
+	mappings := []*mgmtv1alpha1.JobMapping{
+		{Schema: "public", Table: "users", Column: "id", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH}},
+		{Schema: "public", Table: "users", Column: "email", Transformer: emailTransformer},
+		{Schema: "public", Table: "regions", Column: "id", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH}},
+		{Schema: "public", Table: "regions", Column: "name", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH}},
+	}
+	groupedSchemas := map[string]map[string]*sql_manager.ColumnInfo{
+		"public.users":   {"id": {DataType: "uuid"}, "email": {DataType: "text"}},
+		"public.regions": {"id": {DataType: "uuid"}, "name": {DataType: "text"}},
+	}
+	tableConstraints := &sql_manager.TableConstraints{
+		PrimaryKeyConstraints: map[string][]string{
+			"public.users":   {"id"},
+			"public.regions": {"id"},
+		},
+	}
+
+	actual, err := buildSnapshotCloneConfigResponses(context.Background(), nil, mappings, groupedSchemas, tableConstraints, "restored", sql_manager.PostgresDriver, "job", "run")
+	require.NoError(t, err)
+	require.Len(t, actual, 1, "tables without transformed columns are not updated")
+
+	resp := actual[0]
+	require.Equal(t, "public.users.update", resp.Name)
+	require.Equal(t, tabledependency.RunTypeUpdate, resp.RunType)
+	require.Equal(t, []string{"email"}, resp.Columns)
+	require.Equal(t, []*shared.BenthosDsn{{ConnectionId: "restored", EnvVarKey: "SOURCE_CONNECTION_DSN"}}, resp.BenthosDsns)
+	require.Equal(t, `SELECT "id", "email" FROM "public"."users";`, resp.Config.Input.PooledSqlRaw.Query)
+	require.NotEmpty(t, resp.Config.Pipeline.Processors)
+
+	update := resp.Config.Output.Broker.Outputs[0].Fallback[0].PooledSqlUpdate
+	require.NotNil(t, update)
+	require.Equal(t, []string{"email"}, update.Columns)
+	require.Equal(t, []string{"id"}, update.WhereColumns)
+	require.Equal(t, `root = [this."email", this."id"]`, update.ArgsMapping)
+
+	_, err = buildSnapshotCloneConfigResponses(context.Background(), nil, mappings, groupedSchemas, &sql_manager.TableConstraints{}, "restored", sql_manager.PostgresDriver, "job", "run")
+	require.Error(t, err, "tables without a primary key can not be updated in place")
+}
+
+func Test_getInPlaceMaskedMappings(t *testing.T) {
+	tableConstraints := &sql_manager.TableConstraints{
+		PrimaryKeyConstraints: map[string][]string{
+			"public.users":  {"id"},
+			"public.orders": {"id"},
+		},
+		ForeignKeyConstraints: map[string][]*sql_manager.ForeignConstraint{
+			"public.orders": {
+				{Columns: []string{"user_email"}, ForeignKey: &sql_manager.ForeignKey{Table: "public.users", Columns: []string{"email"}}},
+			},
+		},
+	}
+	masked := &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NULL}
+
+	actual, err := getInPlaceMaskedMappings("public.users", []*mgmtv1alpha1.JobMapping{
+		{Schema: "public", Table: "users", Column: "id"},
+		{Schema: "public", Table: "users", Column: "name", Transformer: masked},
+	}, tableConstraints)
+	require.NoError(t, err)
+	require.Len(t, actual, 1)
+	require.Equal(t, "name", actual[0].GetColumn())
+
+	errorCases := []struct {
+		table   string
+		mapping *mgmtv1alpha1.JobMapping
+	}{
+		{"public.users", &mgmtv1alpha1.JobMapping{Column: "id", Transformer: masked}},
+		{"public.users", &mgmtv1alpha1.JobMapping{Column: "email", Transformer: masked}},
+		{"public.orders", &mgmtv1alpha1.JobMapping{Column: "user_email", Transformer: masked}},
+		{"public.users", &mgmtv1alpha1.JobMapping{Column: "name", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_DROP_COLUMN}}},
+		{"public.users", &mgmtv1alpha1.JobMapping{Column: "name", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT}}},
+	}
+	for _, tt := range errorCases {
+		_, err := getInPlaceMaskedMappings(tt.table, []*mgmtv1alpha1.JobMapping{tt.mapping}, tableConstraints)
+		require.Error(t, err, "%s.%s", tt.table, tt.mapping.GetColumn())
+	}
+}
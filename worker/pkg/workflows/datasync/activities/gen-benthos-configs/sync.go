@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
@@ -51,14 +52,46 @@ func (b *benthosBuilder) getSqlSyncBenthosConfigResponses(
 	if err != nil {
 		return nil, fmt.Errorf("unable to get database schema for connection: %w", err)
 	}
+	if sqlSourceOpts != nil && sqlSourceOpts.MaterializeViews {
+		viewColumns, err := db.Db.GetViewColumnMap(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get view columns for connection: %w", err)
+		}
+		for view, columns := range viewColumns {
+			if _, ok := groupedSchemas[view]; !ok {
+				groupedSchemas[view] = columns
+			}
+		}
+	}
+	virtualTables := shared.GetVirtualTablesFromJob(job)
+	if err := addVirtualTableColumns(ctx, db.Db, groupedSchemas, virtualTables); err != nil {
+		return nil, err
+	}
+	for table := range virtualTables {
+		if opts, ok := sourceTableOpts[table]; ok && opts.WhereClause != nil && *opts.WhereClause != "" {
+			slogger.Warn(fmt.Sprintf("ignoring where clause for virtual table %s. virtual tables must be filtered in their query", table))
+			delete(sourceTableOpts, table)
+		}
+	}
 	if !areMappingsSubsetOfSchemas(groupedSchemas, job.Mappings) {
 		return nil, errors.New(jobmappingSubsetErrMsg)
 	}
+	mappings := job.Mappings
+	if sqlSourceOpts == nil || !sqlSourceOpts.IncludeForeignTables {
+		var excludedTables []string
+		groupedSchemas, mappings, excludedTables = excludeForeignTables(groupedSchemas, job.Mappings)
+		for _, table := range excludedTables {
+			slogger.Warn(fmt.Sprintf("excluding foreign table %s from sync. foreign tables must be explicitly included in the job source options", table))
+		}
+		if len(excludedTables) > 0 && len(mappings) == 0 {
+			return nil, errors.New("all mapped tables are foreign tables. foreign tables must be explicitly included in the job source options")
+		}
+	}
 	if sqlSourceOpts != nil && sqlSourceOpts.HaltOnNewColumnAddition &&
-		shouldHaltOnSchemaAddition(groupedSchemas, job.Mappings) {
+		shouldHaltOnSchemaAddition(groupedSchemas, mappings) {
 		return nil, errors.New(haltOnSchemaAdditionErrMsg)
 	}
-	uniqueSchemas := shared.GetUniqueSchemasFromMappings(job.Mappings)
+	uniqueSchemas := shared.GetUniqueSchemasFromMappings(mappings)
 
 	tableConstraints, err := db.Db.GetTableConstraintsBySchema(ctx, uniqueSchemas)
 	if err != nil {
@@ -68,7 +101,21 @@ func (b *benthosBuilder) getSqlSyncBenthosConfigResponses(
 	slogger.Info(fmt.Sprintf("found %d foreign key constraints for database", getMapValuesCount(tableConstraints.ForeignKeyConstraints)))
 	slogger.Info(fmt.Sprintf("found %d primary key constraints for database", getMapValuesCount(tableConstraints.PrimaryKeyConstraints)))
 
-	groupedMappings := groupMappingsByTable(job.Mappings)
+	if sqlSourceOpts != nil && sqlSourceOpts.TenantExtract != nil {
+		tenantWhereClauses, err := getTenantExtractWhereClauses(db.Driver, sqlSourceOpts.TenantExtract, groupedSchemas, mappings, virtualTables)
+		if err != nil {
+			return nil, fmt.Errorf("unable to build tenant extract: %w", err)
+		}
+		if sourceTableOpts == nil {
+			sourceTableOpts = map[string]*sqlSourceTableOptions{}
+		}
+		addTenantExtractWhereClauses(sourceTableOpts, tenantWhereClauses)
+		// the tables without a tenant column follow the tenant's rows through their foreign keys
+		sqlSourceOpts.SubsetByForeignKeyConstraints = true
+		slogger.Info(fmt.Sprintf("extracting tenant from %d tables with a tenant column", len(tenantWhereClauses)))
+	}
+
+	groupedMappings := groupMappingsByTable(mappings)
 	groupedTableMapping := getTableMappingsMap(groupedMappings)
 	colTransformerMap := getColumnTransformerMap(groupedTableMapping) // schema.table ->  column -> transformer
 
@@ -86,6 +133,9 @@ func (b *benthosBuilder) getSqlSyncBenthosConfigResponses(
 	if err != nil {
 		return nil, fmt.Errorf("unable to build select queries: %w", err)
 	}
+	if err := setVirtualTableSelectQueries(db.Driver, tableRunTypeQueryMap, runConfigs, virtualTables); err != nil {
+		return nil, err
+	}
 
 	sourceResponses, err := buildBenthosSqlSourceConfigResponses(ctx, b.transformerclient, groupedTableMapping, runConfigs, sourceConnection.Id, db.Driver, tableRunTypeQueryMap, groupedSchemas, tableConstraints.ForeignKeyConstraints, colTransformerMap, b.jobId, b.runId, b.redisConfig, primaryKeyToForeignKeysMap)
 	if err != nil {
@@ -190,9 +240,11 @@ func buildBenthosSqlSourceConfigResponses(
 
 			TableSchema: mappings.Schema,
 			TableName:   mappings.Table,
-			Columns:     config.InsertColumns,
+			Columns:     filterDroppedColumns(config.InsertColumns, mappings.Mappings),
 			primaryKeys: config.PrimaryKeys,
 
+			sqlExpressions: buildSqlExpressionMap(mappings.Mappings),
+
 			metriclabels: metrics.MetricLabels{
 				metrics.NewEqLabel(metrics.TableSchemaLabel, mappings.Schema),
 				metrics.NewEqLabel(metrics.TableNameLabel, mappings.Table),
@@ -276,6 +328,8 @@ func buildProcessorConfigsByRunType(
 func (b *benthosBuilder) getSqlSyncBenthosOutput(
 	driver string,
 	destination *mgmtv1alpha1.JobDestination,
+	destTable *sql_manager.SchemaTable,
+	destColumns map[string]*sql_manager.DestinationColumn,
 	benthosConfig *BenthosConfigResponse,
 	dsn string,
 	primaryKeyToForeignKeysMap map[string]map[string][]*referenceKey,
@@ -294,10 +348,10 @@ func (b *benthosBuilder) getSqlSyncBenthosOutput(
 						Driver: driver,
 						Dsn:    dsn,
 
-						Schema:       benthosConfig.TableSchema,
-						Table:        benthosConfig.TableName,
-						Columns:      benthosConfig.Columns,
-						WhereColumns: benthosConfig.primaryKeys,
+						Schema:       destTable.Schema,
+						Table:        destTable.Table,
+						Columns:      shared.GetDestinationColumnNames(destColumns, benthosConfig.Columns),
+						WhereColumns: shared.GetDestinationColumnNames(destColumns, benthosConfig.primaryKeys),
 						ArgsMapping:  buildPlainInsertArgs(args),
 
 						Batching: &neosync_benthos.Batching{
@@ -352,16 +406,18 @@ func (b *benthosBuilder) getSqlSyncBenthosOutput(
 						Driver: driver,
 						Dsn:    dsn,
 
-						Schema:              benthosConfig.TableSchema,
-						Table:               benthosConfig.TableName,
-						Columns:             benthosConfig.Columns,
+						Schema:              destTable.Schema,
+						Table:               destTable.Table,
+						Columns:             shared.GetDestinationColumnNames(destColumns, benthosConfig.Columns),
+						ColumnExpressions:   getDestinationSqlExpressions(destColumns, benthosConfig.sqlExpressions),
 						OnConflictDoNothing: destOpts.OnConflictDoNothing,
 						TruncateOnRetry:     destOpts.Truncate,
 						ArgsMapping:         buildPlainInsertArgs(benthosConfig.Columns),
 
+						AdaptiveBatching: true,
 						Batching: &neosync_benthos.Batching{
 							Period: "5s",
-							Count:  100,
+							Count:  maxInsertBatchCount,
 						},
 					},
 				},
@@ -380,12 +436,26 @@ func (b *benthosBuilder) getSqlSyncBenthosOutput(
 	return outputs, nil
 }
 
+// converts the retry backoff from seconds into a benthos duration
+func getS3MaxRetryBackoff(opts *mgmtv1alpha1.AwsS3DestinationConnectionOptions) *string {
+	if opts.MaxRetryBackoff == nil {
+		return nil
+	}
+	backoff := fmt.Sprintf("%ds", opts.GetMaxRetryBackoff())
+	return &backoff
+}
+
 func (b *benthosBuilder) getAwsS3SyncBenthosOutput(
 	connection *mgmtv1alpha1.ConnectionConfig_AwsS3Config,
+	destination *mgmtv1alpha1.JobDestination,
 	benthosConfig *BenthosConfigResponse,
 	workflowId string,
 ) []neosync_benthos.Outputs {
 	outputs := []neosync_benthos.Outputs{}
+	s3Opts := destination.GetOptions().GetAwsS3Options()
+	if s3Opts == nil {
+		s3Opts = &mgmtv1alpha1.AwsS3DestinationConnectionOptions{}
+	}
 
 	s3pathpieces := []string{}
 	if connection.AwsS3Config.PathPrefix != nil && *connection.AwsS3Config.PathPrefix != "" {
@@ -398,6 +468,10 @@ func (b *benthosBuilder) getAwsS3SyncBenthosOutput(
 		workflowId,
 		"activities",
 		neosync_benthos.BuildBenthosTable(benthosConfig.TableSchema, benthosConfig.TableName),
+	)
+	manifestPath := fmt.Sprintf("/%s/manifest.json", strings.Join(s3pathpieces, "/"))
+	s3pathpieces = append(
+		s3pathpieces,
 		"data",
 		`${!count("files")}.txt.gz`,
 	)
@@ -405,7 +479,7 @@ func (b *benthosBuilder) getAwsS3SyncBenthosOutput(
 	outputs = append(outputs, neosync_benthos.Outputs{
 		Fallback: []neosync_benthos.Outputs{
 			{
-				AwsS3: &neosync_benthos.AwsS3Insert{
+				NeosyncAwsS3: &neosync_benthos.NeosyncAwsS3Insert{
 					Bucket:      connection.AwsS3Config.Bucket,
 					MaxInFlight: 64,
 					Path:        fmt.Sprintf("/%s", strings.Join(s3pathpieces, "/")),
@@ -413,6 +487,8 @@ func (b *benthosBuilder) getAwsS3SyncBenthosOutput(
 						Count:  100,
 						Period: "5s",
 						Processors: []*neosync_benthos.BatchProcessor{
+							{NeosyncBinaryEncode: &neosync_benthos.NeosyncBinaryEncodeProcessor{}},
+							{NeosyncNonFinite: &neosync_benthos.NeosyncNonFiniteProcessor{}},
 							{Archive: &neosync_benthos.ArchiveProcessor{Format: "lines"}},
 							{Compress: &neosync_benthos.CompressProcessor{Algorithm: "gzip"}},
 						},
@@ -420,6 +496,22 @@ func (b *benthosBuilder) getAwsS3SyncBenthosOutput(
 					Credentials: buildBenthosS3Credentials(connection.AwsS3Config.Credentials),
 					Region:      connection.AwsS3Config.GetRegion(),
 					Endpoint:    connection.AwsS3Config.GetEndpoint(),
+
+					ForcePathStyle:     connection.AwsS3Config.GetForcePathStyle(),
+					InsecureSkipVerify: connection.AwsS3Config.GetInsecureSkipVerify(),
+
+					PartSize:          s3Opts.PartSize,
+					UploadConcurrency: s3Opts.UploadConcurrency,
+					MaxRetryAttempts:  s3Opts.MaxRetryAttempts,
+					MaxRetryBackoff:   getS3MaxRetryBackoff(s3Opts),
+					DisableChecksums:  s3Opts.GetDisableChecksums(),
+
+					Manifest: &neosync_benthos.S3ManifestConfig{
+						Path:     manifestPath,
+						JobRunId: workflowId,
+						Schema:   benthosConfig.TableSchema,
+						Table:    benthosConfig.TableName,
+					},
 				},
 			},
 			// kills activity depending on error
@@ -541,6 +633,9 @@ type sqlJobSourceOpts struct {
 	HaltOnNewColumnAddition       bool
 	SubsetByForeignKeyConstraints bool
 	SchemaOpt                     []*schemaOptions
+	IncludeForeignTables          bool
+	MaterializeViews              bool
+	TenantExtract                 *mgmtv1alpha1.TenantExtractOptions
 }
 type schemaOptions struct {
 	Schema string
@@ -577,6 +672,9 @@ func getSqlJobSourceOpts(
 			HaltOnNewColumnAddition:       jobSourceConfig.Postgres.HaltOnNewColumnAddition,
 			SubsetByForeignKeyConstraints: jobSourceConfig.Postgres.SubsetByForeignKeyConstraints,
 			SchemaOpt:                     schemaOpt,
+			IncludeForeignTables:          jobSourceConfig.Postgres.IncludeForeignTables,
+			MaterializeViews:              jobSourceConfig.Postgres.MaterializeViews,
+			TenantExtract:                 jobSourceConfig.Postgres.TenantExtract,
 		}, nil
 	case *mgmtv1alpha1.JobSourceOptions_Mysql:
 		if jobSourceConfig.Mysql == nil {
@@ -600,6 +698,8 @@ func getSqlJobSourceOpts(
 			HaltOnNewColumnAddition:       jobSourceConfig.Mysql.HaltOnNewColumnAddition,
 			SubsetByForeignKeyConstraints: jobSourceConfig.Mysql.SubsetByForeignKeyConstraints,
 			SchemaOpt:                     schemaOpt,
+			MaterializeViews:              jobSourceConfig.Mysql.MaterializeViews,
+			TenantExtract:                 jobSourceConfig.Mysql.TenantExtract,
 		}, nil
 	default:
 		return nil, errors.New("unsupported job source options type")
@@ -689,6 +789,88 @@ func getUniqueColMappingsMap(
 	return tableColMappings
 }
 
+// Removes foreign tables from the schema and mappings.
+// Returns the mapped tables that were removed so that their exclusion can be surfaced
+func excludeForeignTables(
+	groupedSchemas map[string]map[string]*sql_manager.ColumnInfo,
+	mappings []*mgmtv1alpha1.JobMapping,
+) (map[string]map[string]*sql_manager.ColumnInfo, []*mgmtv1alpha1.JobMapping, []string) {
+	filteredSchemas := map[string]map[string]*sql_manager.ColumnInfo{}
+	foreignTables := map[string]struct{}{}
+	for table, cols := range groupedSchemas {
+		if isForeignTable(cols) {
+			foreignTables[table] = struct{}{}
+			continue
+		}
+		filteredSchemas[table] = cols
+	}
+
+	filteredMappings := []*mgmtv1alpha1.JobMapping{}
+	excludedTables := []string{}
+	for _, mapping := range mappings {
+		table := sql_manager.BuildTable(mapping.GetSchema(), mapping.GetTable())
+		if _, ok := foreignTables[table]; ok {
+			if !slices.Contains(excludedTables, table) {
+				excludedTables = append(excludedTables, table)
+			}
+			continue
+		}
+		filteredMappings = append(filteredMappings, mapping)
+	}
+	return filteredSchemas, filteredMappings, excludedTables
+}
+
+func isForeignTable(cols map[string]*sql_manager.ColumnInfo) bool {
+	for _, col := range cols {
+		return col.TableType == sql_manager.ForeignTableType
+	}
+	return false
+}
+
+// Infers the columns of each virtual table from its query and adds them to the schema so that they can be mapped like any other table
+func addVirtualTableColumns(
+	ctx context.Context,
+	db sql_manager.SqlDatabase,
+	groupedSchemas map[string]map[string]*sql_manager.ColumnInfo,
+	virtualTables map[string]*mgmtv1alpha1.SqlVirtualTable,
+) error {
+	for table, vt := range virtualTables {
+		if _, ok := groupedSchemas[table]; ok {
+			return fmt.Errorf("virtual table %s has the same name as a table in the source connection", table)
+		}
+		columns, err := db.GetQueryColumns(ctx, vt.GetQuery())
+		if err != nil {
+			return fmt.Errorf("unable to infer columns for virtual table %s: %w", table, err)
+		}
+		groupedSchemas[table] = columns
+	}
+	return nil
+}
+
+// Virtual tables do not exist in the source so their select queries are replaced with the virtual table's query
+func setVirtualTableSelectQueries(
+	driver string,
+	tableRunTypeQueryMap map[string]map[tabledependency.RunType]string,
+	runConfigs []*tabledependency.RunConfig,
+	virtualTables map[string]*mgmtv1alpha1.SqlVirtualTable,
+) error {
+	for _, cfg := range runConfigs {
+		vt, ok := virtualTables[cfg.Table]
+		if !ok {
+			continue
+		}
+		query, err := sql_manager.BuildVirtualTableSelectQuery(driver, vt.GetQuery(), cfg.SelectColumns)
+		if err != nil {
+			return fmt.Errorf("unable to build select query for virtual table %s: %w", cfg.Table, err)
+		}
+		if _, ok := tableRunTypeQueryMap[cfg.Table]; !ok {
+			tableRunTypeQueryMap[cfg.Table] = map[tabledependency.RunType]string{}
+		}
+		tableRunTypeQueryMap[cfg.Table][cfg.RunType] = query
+	}
+	return nil
+}
+
 func shouldHaltOnSchemaAddition(
 	groupedSchemas map[string]map[string]*sql_manager.ColumnInfo,
 	mappings []*mgmtv1alpha1.JobMapping,
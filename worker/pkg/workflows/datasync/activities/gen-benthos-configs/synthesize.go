@@ -0,0 +1,359 @@
+package genbenthosconfigs_activity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/pkg/metrics"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/internal/benthos"
+	"github.com/nucleuscloud/neosync/worker/internal/synthesizer"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+)
+
+const (
+	defaultSynthesizeSampleSize = 10000
+	synthesizeBatchSize         = 100
+)
+
+type synthesizeMappings struct {
+	Schema    string
+	Table     string
+	Columns   []*synthesizer.ColumnSpec
+	Count     int
+	DependsOn []*tabledependency.DependsOn
+}
+
+func (b *benthosBuilder) getSynthesizeBenthosConfigResponses(
+	ctx context.Context,
+	job *mgmtv1alpha1.Job,
+	slogger *slog.Logger,
+) ([]*BenthosConfigResponse, map[string][]string, error) {
+	jobSource := job.GetSource()
+	sourceOptions := jobSource.GetOptions().GetSynthesize()
+	if sourceOptions == nil {
+		return nil, nil, fmt.Errorf("job does not have Synthesize source options, has: %T", jobSource.GetOptions().Config)
+	}
+	isCtgan := sourceOptions.GetModel() == mgmtv1alpha1.SynthesizerModel_SYNTHESIZER_MODEL_CTGAN
+	if isCtgan && sourceOptions.GetExternalServiceUrl() == "" {
+		return nil, nil, errors.New("must provide an external service url when using the CTGAN synthesizer model")
+	}
+
+	sourceConnection, err := shared.GetJobSourceConnection(ctx, jobSource, b.connclient)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, err := b.sqlmanager.NewPooledSqlDb(ctx, slogger, sourceConnection)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create new sql db: %w", err)
+	}
+	defer db.Db.Close()
+
+	schemas := shared.GetUniqueSchemasFromJob(job)
+	groupedSchemas, err := db.Db.GetSchemaColumnMap(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get database schema for connection: %w", err)
+	}
+	primaryKeys, err := db.Db.GetPrimaryKeyConstraintsMap(ctx, schemas)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get primary key constraints: %w", err)
+	}
+	uniqueConstraints, err := db.Db.GetUniqueConstraintsMap(ctx, schemas)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get unique constraints: %w", err)
+	}
+	partialIndexes, err := db.Db.GetPartialUniqueIndexesMap(ctx, schemas)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get partial unique indexes: %w", err)
+	}
+	exclusionConstraints, err := db.Db.GetExclusionConstraintsMap(ctx, schemas)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get exclusion constraints: %w", err)
+	}
+	uniqueConstraints, err = getSynthesizeUniqueConstraints(sourceOptions.GetSchemas(), uniqueConstraints, partialIndexes, exclusionConstraints)
+	if err != nil {
+		return nil, nil, err
+	}
+	foreignKeys, err := db.Db.GetForeignKeyConstraintsMap(ctx, schemas)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get foreign key constraints: %w", err)
+	}
+
+	mappings, err := buildSynthesizeMappings(sourceOptions.GetSchemas(), groupedSchemas, primaryKeys, uniqueConstraints, foreignKeys)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(mappings) == 0 {
+		return nil, nil, fmt.Errorf("did not generate any mapping configs during Synthesize build for connection: %s", sourceConnection.GetId())
+	}
+
+	sampleSize := uint(defaultSynthesizeSampleSize)
+	if sourceOptions.SampleSize != nil {
+		sampleSize = uint(sourceOptions.GetSampleSize())
+	}
+	var external *synthesizer.ExternalClient
+	if isCtgan {
+		external = synthesizer.NewExternalClient(sourceOptions.GetExternalServiceUrl(), http.DefaultClient)
+	}
+
+	responses := []*BenthosConfigResponse{}
+	groupedTableCols := map[string][]string{}
+	for _, mapping := range mappings {
+		columns := make([]string, 0, len(mapping.Columns))
+		for _, col := range mapping.Columns {
+			columns = append(columns, col.Name)
+		}
+		rows, err := db.Db.GetTableRowSample(ctx, mapping.Schema, mapping.Table, columns, sampleSize)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to sample rows from %s: %w", sql_manager.BuildTable(mapping.Schema, mapping.Table), err)
+		}
+		slogger.Debug(fmt.Sprintf("fitting synthesizer on %d sampled rows", len(rows)), "schema", mapping.Schema, "table", mapping.Table)
+
+		input := &neosync_benthos.SynthesizeGenerate{
+			Columns:   columns,
+			Count:     mapping.Count,
+			BatchSize: min(mapping.Count, synthesizeBatchSize),
+		}
+		if external != nil {
+			modelId, err := external.Fit(ctx, mapping.Columns, rows)
+			if err != nil {
+				return nil, nil, fmt.Errorf("unable to fit CTGAN model for %s: %w", sql_manager.BuildTable(mapping.Schema, mapping.Table), err)
+			}
+			input.ServiceUrl = shared.Ptr(sourceOptions.GetExternalServiceUrl())
+			input.ModelId = &modelId
+		} else {
+			model, err := synthesizer.Fit(mapping.Columns, rows)
+			if err != nil {
+				return nil, nil, fmt.Errorf("unable to fit synthesizer model for %s: %w", sql_manager.BuildTable(mapping.Schema, mapping.Table), err)
+			}
+			bits, err := json.Marshal(model)
+			if err != nil {
+				return nil, nil, err
+			}
+			input.Model = shared.Ptr(string(bits))
+		}
+
+		responses = append(responses, buildBenthosSynthesizeSourceConfigResponse(mapping, input))
+		groupedTableCols[neosync_benthos.BuildBenthosTable(mapping.Schema, mapping.Table)] = columns
+	}
+	return responses, groupedTableCols, nil
+}
+
+// Returns the column sets that generated rows must keep unique.
+// Generated rows may match the predicate of a partial unique index, so its columns are kept unique for every row.
+// Exclusion constraints that only compare with = hold the same as a unique constraint. Any other exclusion constraint
+// on a selected table, such as one that prevents overlapping ranges, can not be guaranteed by generated data and returns an error.
+func getSynthesizeUniqueConstraints(
+	schemaOpts []*mgmtv1alpha1.SynthesizeSourceSchemaOption,
+	uniqueConstraints map[string][][]string,
+	partialIndexes map[string][]*sql_manager.PartialUniqueIndex,
+	exclusionConstraints map[string][]*sql_manager.ExclusionConstraint,
+) (map[string][][]string, error) {
+	output := map[string][][]string{}
+	for table, constraints := range uniqueConstraints {
+		output[table] = slices.Clone(constraints)
+	}
+	for table, indexes := range partialIndexes {
+		for _, index := range indexes {
+			output[table] = append(output[table], index.Columns)
+		}
+	}
+
+	selectedTables := map[string]struct{}{}
+	for _, schema := range schemaOpts {
+		for _, table := range schema.GetTables() {
+			selectedTables[sql_manager.BuildTable(schema.GetSchema(), table.GetTable())] = struct{}{}
+		}
+	}
+	for table, constraints := range exclusionConstraints {
+		for _, constraint := range constraints {
+			isEquality := len(constraint.Columns) > 0 && !slices.ContainsFunc(constraint.Operators, func(op string) bool {
+				return op != "="
+			})
+			if isEquality {
+				output[table] = append(output[table], constraint.Columns)
+				continue
+			}
+			if _, ok := selectedTables[table]; ok {
+				return nil, fmt.Errorf("unable to synthesize %s: exclusion constraint %s can not be guaranteed by generated data: %s", table, constraint.Name, constraint.Definition)
+			}
+		}
+	}
+	return output, nil
+}
+
+// Builds the column specs for each selected table.
+// Integer primary keys and unique columns are generated as sequences, and single column foreign keys that point at
+// a sequence column of another selected table are drawn from that table's generated rows so referential integrity holds.
+// Each multi column unique constraint gets one sequence column so that its column sets can not repeat.
+func buildSynthesizeMappings(
+	schemaOpts []*mgmtv1alpha1.SynthesizeSourceSchemaOption,
+	groupedSchemas map[string]map[string]*sql_manager.ColumnInfo,
+	primaryKeys map[string][]string,
+	uniqueConstraints map[string][][]string,
+	foreignKeys map[string][]*sql_manager.ForeignConstraint,
+) ([]*synthesizeMappings, error) {
+	rowCounts := map[string]int64{}
+	for _, schema := range schemaOpts {
+		for _, table := range schema.GetTables() {
+			rowCounts[sql_manager.BuildTable(schema.GetSchema(), table.GetTable())] = table.GetRowCount()
+		}
+	}
+
+	isSequenceCol := func(tableKey, column string) bool {
+		if pks := primaryKeys[tableKey]; len(pks) == 1 && pks[0] == column {
+			return true
+		}
+		for _, uc := range uniqueConstraints[tableKey] {
+			if len(uc) == 1 && uc[0] == column {
+				return true
+			}
+		}
+		return false
+	}
+
+	mappings := []*synthesizeMappings{}
+	for _, schema := range schemaOpts {
+		for _, table := range schema.GetTables() {
+			tableKey := sql_manager.BuildTable(schema.GetSchema(), table.GetTable())
+			tableColsMap, ok := groupedSchemas[tableKey]
+			if !ok {
+				return nil, fmt.Errorf("did not find schema data when building Synthesize config: %s", tableKey)
+			}
+
+			references := map[string]int64{}
+			dependsOn := []*tabledependency.DependsOn{}
+			for _, fk := range foreignKeys[tableKey] {
+				if fk.ForeignKey == nil || len(fk.Columns) != 1 || len(fk.ForeignKey.Columns) != 1 {
+					continue
+				}
+				parentCount, ok := rowCounts[fk.ForeignKey.Table]
+				if !ok || fk.ForeignKey.Table == tableKey {
+					continue
+				}
+				parentCols := groupedSchemas[fk.ForeignKey.Table]
+				parentCol, ok := parentCols[fk.ForeignKey.Columns[0]]
+				if !ok || !isSequenceCol(fk.ForeignKey.Table, fk.ForeignKey.Columns[0]) || !isIntegerType(parentCol.DataType) {
+					continue
+				}
+				references[fk.Columns[0]] = parentCount
+				dependsOn = append(dependsOn, &tabledependency.DependsOn{Table: fk.ForeignKey.Table, Columns: fk.ForeignKey.Columns})
+			}
+
+			columnNames := make([]string, 0, len(tableColsMap))
+			for col := range tableColsMap {
+				columnNames = append(columnNames, col)
+			}
+			slices.SortFunc(columnNames, func(a, b string) int {
+				return int(tableColsMap[a].OrdinalPosition - tableColsMap[b].OrdinalPosition)
+			})
+
+			// a multi column unique constraint holds as long as one of its columns is unique, so one column that is
+			// not drawn from a parent table is generated as a sequence. Integer and uuid columns are preferred as they are always unique
+			compositeSequenceCols := map[string]struct{}{}
+			for _, uc := range uniqueConstraints[tableKey] {
+				if len(uc) < 2 || slices.ContainsFunc(uc, func(col string) bool {
+					_, ok := compositeSequenceCols[col]
+					return ok || isSequenceCol(tableKey, col)
+				}) {
+					continue
+				}
+				candidates := slices.DeleteFunc(slices.Clone(uc), func(col string) bool {
+					_, ok := tableColsMap[col]
+					_, isReference := references[col]
+					return !ok || isReference
+				})
+				if len(candidates) == 0 {
+					continue
+				}
+				idx := slices.IndexFunc(candidates, func(col string) bool {
+					dataType := tableColsMap[col].DataType
+					return isIntegerType(dataType) || dataType == "uuid"
+				})
+				compositeSequenceCols[candidates[max(idx, 0)]] = struct{}{}
+			}
+
+			columns := make([]*synthesizer.ColumnSpec, 0, len(columnNames))
+			for _, col := range columnNames {
+				_, isCompositeSequence := compositeSequenceCols[col]
+				spec := &synthesizer.ColumnSpec{
+					Name:       col,
+					DataType:   tableColsMap[col].DataType,
+					IsSequence: isSequenceCol(tableKey, col) || isCompositeSequence,
+				}
+				if count, ok := references[col]; ok {
+					spec.ReferenceRowCount = &count
+				}
+				columns = append(columns, spec)
+			}
+
+			mappings = append(mappings, &synthesizeMappings{
+				Schema:    schema.GetSchema(),
+				Table:     table.GetTable(),
+				Columns:   columns,
+				Count:     int(table.GetRowCount()),
+				DependsOn: dependsOn,
+			})
+		}
+	}
+	return mappings, nil
+}
+
+func isIntegerType(dataType string) bool {
+	switch dataType {
+	case "smallint", "integer", "int", "bigint", "tinyint", "mediumint":
+		return true
+	default:
+		return false
+	}
+}
+
+func buildBenthosSynthesizeSourceConfigResponse(
+	mapping *synthesizeMappings,
+	input *neosync_benthos.SynthesizeGenerate,
+) *BenthosConfigResponse {
+	bc := &neosync_benthos.BenthosConfig{
+		StreamConfig: neosync_benthos.StreamConfig{
+			Input: &neosync_benthos.InputConfig{
+				Inputs: neosync_benthos.Inputs{
+					SynthesizeGenerate: input,
+				},
+			},
+			Pipeline: &neosync_benthos.PipelineConfig{
+				Threads:    -1,
+				Processors: []neosync_benthos.ProcessorConfig{},
+			},
+			Output: &neosync_benthos.OutputConfig{
+				Outputs: neosync_benthos.Outputs{
+					Broker: &neosync_benthos.OutputBrokerConfig{
+						Pattern: "fan_out",
+						Outputs: []neosync_benthos.Outputs{},
+					},
+				},
+			},
+		},
+	}
+
+	return &BenthosConfigResponse{
+		Name:      neosync_benthos.BuildBenthosTable(mapping.Schema, mapping.Table),
+		Config:    bc,
+		DependsOn: mapping.DependsOn,
+
+		TableSchema: mapping.Schema,
+		TableName:   mapping.Table,
+		Columns:     input.Columns,
+
+		metriclabels: metrics.MetricLabels{
+			metrics.NewEqLabel(metrics.TableSchemaLabel, mapping.Schema),
+			metrics.NewEqLabel(metrics.TableNameLabel, mapping.Table),
+			metrics.NewEqLabel(metrics.JobTypeLabel, "synthesize"),
+		},
+	}
+}
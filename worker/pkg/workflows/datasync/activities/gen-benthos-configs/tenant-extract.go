@@ -0,0 +1,84 @@
+package genbenthosconfigs_activity
+
+import (
+	"errors"
+	"fmt"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/internal/benthos"
+)
+
+// Builds the where clause that filters each table holding a tenant column down to the tenant's rows.
+// Root tables must be mapped and have their tenant column. The tenant column, if provided, is inferred for every other mapped table that has it.
+// Virtual tables are never filtered as they must be filtered in their query.
+func getTenantExtractWhereClauses(
+	driver string,
+	opts *mgmtv1alpha1.TenantExtractOptions,
+	groupedSchemas map[string]map[string]*sql_manager.ColumnInfo,
+	mappings []*mgmtv1alpha1.JobMapping,
+	virtualTables map[string]*mgmtv1alpha1.SqlVirtualTable,
+) (map[string]string, error) {
+	if opts.GetTenantValue() == "" {
+		return nil, errors.New("tenant extract must have a tenant value")
+	}
+	mappedTables := map[string]struct{}{}
+	for _, mapping := range mappings {
+		mappedTables[neosync_benthos.BuildBenthosTable(mapping.GetSchema(), mapping.GetTable())] = struct{}{}
+	}
+	tenantValue, err := buildSqlLiteral(driver, opts.GetTenantValue())
+	if err != nil {
+		return nil, err
+	}
+
+	tenantColumns := map[string]string{}
+	for _, rootTable := range opts.GetRootTables() {
+		table := neosync_benthos.BuildBenthosTable(rootTable.GetSchema(), rootTable.GetTable())
+		if _, ok := mappedTables[table]; !ok {
+			return nil, fmt.Errorf("tenant extract root table %s is not mapped", table)
+		}
+		if _, ok := virtualTables[table]; ok {
+			return nil, fmt.Errorf("tenant extract root table %s is a virtual table. virtual tables must be filtered in their query", table)
+		}
+		if _, ok := groupedSchemas[table][rootTable.GetTenantColumn()]; !ok {
+			return nil, fmt.Errorf("tenant extract root table %s does not have tenant column %s", table, rootTable.GetTenantColumn())
+		}
+		tenantColumns[table] = rootTable.GetTenantColumn()
+	}
+	if opts.TenantColumn != nil && opts.GetTenantColumn() != "" {
+		for table := range mappedTables {
+			if _, ok := tenantColumns[table]; ok {
+				continue
+			}
+			if _, ok := virtualTables[table]; ok {
+				continue
+			}
+			if _, ok := groupedSchemas[table][opts.GetTenantColumn()]; ok {
+				tenantColumns[table] = opts.GetTenantColumn()
+			}
+		}
+	}
+	if len(tenantColumns) == 0 {
+		return nil, errors.New("tenant extract did not find any mapped tables with a tenant column")
+	}
+
+	whereClauses := make(map[string]string, len(tenantColumns))
+	for table, column := range tenantColumns {
+		whereClauses[table] = fmt.Sprintf("%s = %s", sql_manager.QuoteIdentifier(driver, column), tenantValue)
+	}
+	return whereClauses, nil
+}
+
+// Adds the tenant where clauses to the source table options, combining them with any where clause the table already has
+func addTenantExtractWhereClauses(
+	sourceTableOpts map[string]*sqlSourceTableOptions,
+	tenantWhereClauses map[string]string,
+) {
+	for table, tenantWhere := range tenantWhereClauses {
+		where := tenantWhere
+		if opts, ok := sourceTableOpts[table]; ok && opts != nil && opts.WhereClause != nil && *opts.WhereClause != "" {
+			where = fmt.Sprintf("(%s) AND %s", *opts.WhereClause, where)
+		}
+		sourceTableOpts[table] = &sqlSourceTableOptions{WhereClause: &where}
+	}
+}
@@ -0,0 +1,90 @@
+package genbenthosconfigs_activity
+
+import (
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getTenantExtractWhereClauses(t *testing.T) {
+	groupedSchemas := map[string]map[string]*sql_manager.ColumnInfo{
+		"public.accounts": {"id": {}, "org_id": {}},
+		"public.users":    {"id": {}, "account_id": {}, "tenant_id": {}},
+		"public.orders":   {"id": {}, "user_id": {}, "tenant_id": {}},
+		"public.regions":  {"id": {}},
+		"public.audit":    {"id": {}, "tenant_id": {}},
+		"public.report":   {"id": {}, "tenant_id": {}},
+	}
+	mappings := []*mgmtv1alpha1.JobMapping{
+		{Schema: "public", Table: "accounts", Column: "id"},
+		{Schema: "public", Table: "users", Column: "id"},
+		{Schema: "public", Table: "orders", Column: "id"},
+		{Schema: "public", Table: "regions", Column: "id"},
+		{Schema: "public", Table: "report", Column: "id"},
+	}
+	virtualTables := map[string]*mgmtv1alpha1.SqlVirtualTable{
+		"public.report": {Schema: "public", Table: "report", Query: "select 1 as id"},
+	}
+
+	t.Run("root tables and inferred tenant column", func(t *testing.T) {
+		actual, err := getTenantExtractWhereClauses(sql_manager.PostgresDriver, &mgmtv1alpha1.TenantExtractOptions{
+			TenantValue:  "o'neil",
+			TenantColumn: shared.Ptr("tenant_id"),
+			RootTables: []*mgmtv1alpha1.TenantExtractTable{
+				{Schema: "public", Table: "accounts", TenantColumn: "org_id"},
+			},
+		}, groupedSchemas, mappings, virtualTables)
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{
+			"public.accounts": `"org_id" = 'o''neil'`,
+			"public.users":    `"tenant_id" = 'o''neil'`,
+			"public.orders":   `"tenant_id" = 'o''neil'`,
+		}, actual)
+	})
+
+	t.Run("mysql", func(t *testing.T) {
+		actual, err := getTenantExtractWhereClauses(sql_manager.MysqlDriver, &mgmtv1alpha1.TenantExtractOptions{
+			TenantValue: `acme\'`,
+			RootTables: []*mgmtv1alpha1.TenantExtractTable{
+				{Schema: "public", Table: "users", TenantColumn: "tenant_id"},
+			},
+		}, groupedSchemas, mappings, virtualTables)
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{
+			"public.users": "`tenant_id` = " + `'acme\\\''`,
+		}, actual)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tests := []*mgmtv1alpha1.TenantExtractOptions{
+			{TenantColumn: shared.Ptr("tenant_id")},
+			{TenantValue: "acme", TenantColumn: shared.Ptr("org_id"), RootTables: []*mgmtv1alpha1.TenantExtractTable{{Schema: "public", Table: "audit", TenantColumn: "tenant_id"}}},
+			{TenantValue: "acme", RootTables: []*mgmtv1alpha1.TenantExtractTable{{Schema: "public", Table: "report", TenantColumn: "tenant_id"}}},
+			{TenantValue: "acme", RootTables: []*mgmtv1alpha1.TenantExtractTable{{Schema: "public", Table: "regions", TenantColumn: "tenant_id"}}},
+			{TenantValue: "acme", TenantColumn: shared.Ptr("missing")},
+		}
+		for _, tt := range tests {
+			_, err := getTenantExtractWhereClauses(sql_manager.PostgresDriver, tt, groupedSchemas, mappings, virtualTables)
+			require.Error(t, err)
+		}
+	})
+}
+
+func Test_addTenantExtractWhereClauses(t *testing.T) {
+	sourceTableOpts := map[string]*sqlSourceTableOptions{
+		"public.users":   {WhereClause: shared.Ptr("active = true")},
+		"public.regions": {WhereClause: shared.Ptr("code = 'us'")},
+	}
+	addTenantExtractWhereClauses(sourceTableOpts, map[string]string{
+		"public.users":  `"tenant_id" = 'acme'`,
+		"public.orders": `"tenant_id" = 'acme'`,
+	})
+	require.Equal(t, map[string]string{
+		"public.users":   `(active = true) AND "tenant_id" = 'acme'`,
+		"public.orders":  `"tenant_id" = 'acme'`,
+		"public.regions": "code = 'us'",
+	}, buildTableSubsetMap(sourceTableOpts))
+}
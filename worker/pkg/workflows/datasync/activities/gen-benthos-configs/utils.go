@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
 )
 
 func convertStringSliceToString(slc []string) (string, error) {
@@ -61,5 +62,54 @@ func shouldProcessStrict(t *mgmtv1alpha1.JobMappingTransformer) bool {
 		t.Source != mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_UNSPECIFIED &&
 		t.Source != mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NULL &&
 		t.Source != mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH &&
-		t.Source != mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT
+		t.Source != mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT &&
+		t.Source != mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_DROP_COLUMN &&
+		t.Source != mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_SQL_EXPRESSION
+}
+
+// Returns the sql expression of every column that has been mapped with the sql expression transformer
+func buildSqlExpressionMap(mappings []*mgmtv1alpha1.JobMapping) map[string]string {
+	expressions := map[string]string{}
+	for _, mapping := range mappings {
+		if mapping.GetTransformer().GetSource() == mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_SQL_EXPRESSION {
+			expressions[mapping.GetColumn()] = mapping.GetTransformer().GetConfig().GetSqlExpressionConfig().GetExpression()
+		}
+	}
+	return expressions
+}
+
+// Keys the sql expressions by the names the columns are written to in the destination
+func getDestinationSqlExpressions(tableDestinationColumns map[string]*sql_manager.DestinationColumn, expressions map[string]string) map[string]string {
+	if len(expressions) == 0 {
+		return nil
+	}
+	output := make(map[string]string, len(expressions))
+	for column, expression := range expressions {
+		name := column
+		if dest, ok := tableDestinationColumns[column]; ok && dest.Name != "" {
+			name = dest.Name
+		}
+		output[name] = expression
+	}
+	return output
+}
+
+// Removes any columns that have been mapped with the drop column transformer as they are never written to the destination
+func filterDroppedColumns(columns []string, mappings []*mgmtv1alpha1.JobMapping) []string {
+	dropped := map[string]struct{}{}
+	for _, mapping := range mappings {
+		if mapping.GetTransformer().GetSource() == mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_DROP_COLUMN {
+			dropped[mapping.GetColumn()] = struct{}{}
+		}
+	}
+	if len(dropped) == 0 {
+		return columns
+	}
+	filtered := make([]string, 0, len(columns))
+	for _, col := range columns {
+		if _, ok := dropped[col]; !ok {
+			filtered = append(filtered, col)
+		}
+	}
+	return filtered
 }
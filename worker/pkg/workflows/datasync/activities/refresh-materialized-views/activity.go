@@ -0,0 +1,174 @@
+package refreshmatviews_activity
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	logger_utils "github.com/nucleuscloud/neosync/worker/internal/logger"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"go.temporal.io/sdk/activity"
+)
+
+type RefreshMaterializedViewsRequest struct {
+	JobId string
+}
+
+type RefreshMaterializedViewsResponse struct {
+	// The views that were refreshed. Format: schema.view
+	RefreshedViews []string
+}
+
+type Activity struct {
+	jobclient  mgmtv1alpha1connect.JobServiceClient
+	connclient mgmtv1alpha1connect.ConnectionServiceClient
+	sqlmanager sql_manager.SqlManagerClient
+}
+
+func New(
+	jobclient mgmtv1alpha1connect.JobServiceClient,
+	connclient mgmtv1alpha1connect.ConnectionServiceClient,
+	sqlmanager sql_manager.SqlManagerClient,
+) *Activity {
+	return &Activity{
+		jobclient:  jobclient,
+		connclient: connclient,
+		sqlmanager: sqlmanager,
+	}
+}
+
+// Refreshes the materialized views of postgres destinations that have opted in, so that they reflect the newly synced data
+func (a *Activity) RefreshMaterializedViews(
+	ctx context.Context,
+	req *RefreshMaterializedViewsRequest,
+) (*RefreshMaterializedViewsResponse, error) {
+	info := activity.GetInfo(ctx)
+	loggerKeyVals := []any{
+		"jobId", req.JobId,
+		"WorkflowID", info.WorkflowExecution.ID,
+		"RunID", info.WorkflowExecution.RunID,
+	}
+	go func() {
+		for {
+			select {
+			case <-time.After(1 * time.Second):
+				activity.RecordHeartbeat(ctx)
+			case <-activity.GetWorkerStopChannel(ctx):
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	slogger := logger_utils.NewJsonSLogger().With(loggerKeyVals...)
+	return a.refreshMaterializedViews(ctx, req, slogger)
+}
+
+func (a *Activity) refreshMaterializedViews(
+	ctx context.Context,
+	req *RefreshMaterializedViewsRequest,
+	slogger *slog.Logger,
+) (*RefreshMaterializedViewsResponse, error) {
+	jobResp, err := a.jobclient.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{Id: req.JobId}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get job by id: %w", err)
+	}
+
+	refreshed := []string{}
+	for _, destination := range jobResp.Msg.GetJob().GetDestinations() {
+		refreshCfg := destination.GetOptions().GetPostgresOptions().GetRefreshMaterializedViews()
+		if !refreshCfg.GetEnabled() {
+			continue
+		}
+		destinationConnection, err := shared.GetConnectionById(ctx, a.connclient, destination.GetConnectionId())
+		if err != nil {
+			return nil, fmt.Errorf("unable to get destination connection by id (%s): %w", destination.GetConnectionId(), err)
+		}
+		if destinationConnection.GetConnectionConfig().GetPgConfig() == nil {
+			continue
+		}
+		views, err := a.refreshDestination(ctx, slogger, destinationConnection, refreshCfg.GetViews())
+		if err != nil {
+			return nil, fmt.Errorf("unable to refresh materialized views for destination (%s): %w", destination.GetConnectionId(), err)
+		}
+		refreshed = append(refreshed, views...)
+	}
+	return &RefreshMaterializedViewsResponse{RefreshedViews: refreshed}, nil
+}
+
+func (a *Activity) refreshDestination(
+	ctx context.Context,
+	slogger *slog.Logger,
+	connection *mgmtv1alpha1.Connection,
+	selectedViews []string,
+) ([]string, error) {
+	db, err := a.sqlmanager.NewSqlDb(ctx, slogger, connection, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Db.Close()
+	return refreshMaterializedViewsInDb(ctx, slogger, db.Db, selectedViews)
+}
+
+func refreshMaterializedViewsInDb(
+	ctx context.Context,
+	slogger *slog.Logger,
+	db sql_manager.SqlDatabase,
+	selectedViews []string,
+) ([]string, error) {
+	views, err := db.GetMaterializedViews(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve materialized views: %w", err)
+	}
+	views, err = filterMaterializedViews(views, selectedViews)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshed := make([]string, 0, len(views))
+	for _, view := range views {
+		stmt := sql_manager.BuildPgRefreshMaterializedViewStatement(view)
+		slogger.Info("refreshing materialized view", "schema", view.Schema, "view", view.Name, "concurrently", view.IsPopulated && view.HasUniqueIndex)
+		if err := db.Exec(ctx, stmt); err != nil {
+			return nil, fmt.Errorf("unable to refresh materialized view %s.%s: %w", view.Schema, view.Name, err)
+		}
+		refreshed = append(refreshed, fmt.Sprintf("%s.%s", view.Schema, view.Name))
+	}
+	return refreshed, nil
+}
+
+// Returns the selected views in the order they were found in the database. All views are returned if none were selected
+func filterMaterializedViews(views []*sql_manager.MaterializedView, selected []string) ([]*sql_manager.MaterializedView, error) {
+	if len(selected) == 0 {
+		return views, nil
+	}
+	remaining := map[string]struct{}{}
+	for _, view := range selected {
+		remaining[view] = struct{}{}
+	}
+	filtered := []*sql_manager.MaterializedView{}
+	for _, view := range views {
+		key := fmt.Sprintf("%s.%s", view.Schema, view.Name)
+		if _, ok := remaining[key]; ok {
+			filtered = append(filtered, view)
+			delete(remaining, key)
+		}
+	}
+	if len(remaining) > 0 {
+		missing := make([]string, 0, len(remaining))
+		for _, view := range selected {
+			if _, ok := remaining[view]; ok {
+				missing = append(missing, view)
+			}
+		}
+		return nil, fmt.Errorf("materialized views not found in destination: %s", strings.Join(missing, ", "))
+	}
+	return filtered, nil
+}
@@ -0,0 +1,69 @@
+package refreshmatviews_activity
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_refreshMaterializedViewsInDb_All(t *testing.T) {
+	db := sql_manager.NewMockSqlDatabase(t)
+	db.On("GetMaterializedViews", mock.Anything).Return([]*sql_manager.MaterializedView{
+		{Schema: "public", Name: "user_stats", IsPopulated: true, HasUniqueIndex: true},
+		{Schema: "reporting", Name: "daily_orders", IsPopulated: true},
+	}, nil)
+	db.On("Exec", mock.Anything, `REFRESH MATERIALIZED VIEW CONCURRENTLY "public"."user_stats";`).Return(nil)
+	db.On("Exec", mock.Anything, `REFRESH MATERIALIZED VIEW "reporting"."daily_orders";`).Return(nil)
+
+	actual, err := refreshMaterializedViewsInDb(context.Background(), slog.Default(), db, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"public.user_stats", "reporting.daily_orders"}, actual)
+}
+
+func Test_refreshMaterializedViewsInDb_Selected(t *testing.T) {
+	db := sql_manager.NewMockSqlDatabase(t)
+	db.On("GetMaterializedViews", mock.Anything).Return([]*sql_manager.MaterializedView{
+		{Schema: "public", Name: "user_stats", IsPopulated: true, HasUniqueIndex: true},
+		{Schema: "reporting", Name: "daily_orders", IsPopulated: true},
+	}, nil)
+	db.On("Exec", mock.Anything, `REFRESH MATERIALIZED VIEW "reporting"."daily_orders";`).Return(nil)
+
+	actual, err := refreshMaterializedViewsInDb(context.Background(), slog.Default(), db, []string{"reporting.daily_orders"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"reporting.daily_orders"}, actual)
+}
+
+func Test_refreshMaterializedViewsInDb_Error(t *testing.T) {
+	db := sql_manager.NewMockSqlDatabase(t)
+	db.On("GetMaterializedViews", mock.Anything).Return([]*sql_manager.MaterializedView{
+		{Schema: "public", Name: "user_stats"},
+	}, nil)
+	db.On("Exec", mock.Anything, `REFRESH MATERIALIZED VIEW "public"."user_stats";`).Return(errors.New("boom"))
+
+	_, err := refreshMaterializedViewsInDb(context.Background(), slog.Default(), db, nil)
+	require.Error(t, err)
+}
+
+func Test_filterMaterializedViews(t *testing.T) {
+	views := []*sql_manager.MaterializedView{
+		{Schema: "public", Name: "a"},
+		{Schema: "public", Name: "b"},
+		{Schema: "other", Name: "a"},
+	}
+
+	actual, err := filterMaterializedViews(views, nil)
+	require.NoError(t, err)
+	require.Equal(t, views, actual)
+
+	actual, err = filterMaterializedViews(views, []string{"other.a", "public.a"})
+	require.NoError(t, err)
+	require.Equal(t, []*sql_manager.MaterializedView{views[0], views[2]}, actual)
+
+	_, err = filterMaterializedViews(views, []string{"public.a", "public.missing"})
+	require.ErrorContains(t, err, "public.missing")
+}
@@ -0,0 +1,298 @@
+package restoresnapshot_activity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	logger_utils "github.com/nucleuscloud/neosync/worker/internal/logger"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"go.temporal.io/sdk/activity"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	maxConnectionNameLength = 30
+	// the number of trailing characters of the instance id that the handover connection name is suffixed with
+	handoverConnectionSuffixLength = 8
+)
+
+type RestoreSnapshotRequest struct {
+	JobId string
+	// The identifier to restore the instance under, see GetRestoredInstanceId
+	InstanceId string
+}
+
+type RestoreSnapshotResponse struct {
+	// The connection that was created for the restored instance
+	ConnectionId string
+	// The identifier of the restored instance with the cloud provider
+	InstanceId string
+	// Whether the restored instance is kept around for debugging when the run fails
+	RetainOnFailure bool
+}
+
+type DeleteRestoredSnapshotRequest struct {
+	JobId        string
+	ConnectionId string
+	InstanceId   string
+}
+
+// Restores, waits on and tears down database instances with a cloud provider
+type snapshotProvider interface {
+	// Starts restoring the snapshot into a new instance. Succeeds if the instance already exists so that retries pick up where they left off
+	Restore(ctx context.Context, instanceId string) error
+	// Blocks until the restored instance accepts connections and returns where it can be reached
+	WaitUntilAvailable(ctx context.Context, instanceId string) (*instanceEndpoint, error)
+	// Deletes the instance. Succeeds if the instance does not exist
+	Delete(ctx context.Context, instanceId string) error
+}
+
+type instanceEndpoint struct {
+	Host string
+	Port int32
+}
+
+type Activity struct {
+	jobclient   mgmtv1alpha1connect.JobServiceClient
+	connclient  mgmtv1alpha1connect.ConnectionServiceClient
+	newProvider func(ctx context.Context, opts *mgmtv1alpha1.SnapshotCloneSourceOptions) (snapshotProvider, error)
+}
+
+func New(
+	jobclient mgmtv1alpha1connect.JobServiceClient,
+	connclient mgmtv1alpha1connect.ConnectionServiceClient,
+) *Activity {
+	return &Activity{
+		jobclient:   jobclient,
+		connclient:  connclient,
+		newProvider: newSnapshotProvider,
+	}
+}
+
+func newSnapshotProvider(ctx context.Context, opts *mgmtv1alpha1.SnapshotCloneSourceOptions) (snapshotProvider, error) {
+	switch snapshot := opts.GetSnapshot().(type) {
+	case *mgmtv1alpha1.SnapshotCloneSourceOptions_AwsRds:
+		return newAwsRdsProvider(ctx, snapshot.AwsRds)
+	case *mgmtv1alpha1.SnapshotCloneSourceOptions_GcpCloudsql:
+		return newGcpCloudSqlProvider(ctx, snapshot.GcpCloudsql)
+	default:
+		return nil, fmt.Errorf("unsupported snapshot type: %T", snapshot)
+	}
+}
+
+// Restores the job's snapshot into a new instance and registers it as a connection so that it can be masked in place.
+// Restores can take a long time for large databases, so the activity heartbeats for as long as it runs
+func (a *Activity) RestoreSnapshot(
+	ctx context.Context,
+	req *RestoreSnapshotRequest,
+) (*RestoreSnapshotResponse, error) {
+	info := activity.GetInfo(ctx)
+	loggerKeyVals := []any{
+		"jobId", req.JobId,
+		"WorkflowID", info.WorkflowExecution.ID,
+		"RunID", info.WorkflowExecution.RunID,
+	}
+	go recordHeartbeats(ctx)
+
+	slogger := logger_utils.NewJsonSLogger().With(loggerKeyVals...)
+	return a.restoreSnapshot(ctx, req, slogger)
+}
+
+func (a *Activity) restoreSnapshot(
+	ctx context.Context,
+	req *RestoreSnapshotRequest,
+	slogger *slog.Logger,
+) (*RestoreSnapshotResponse, error) {
+	instanceId := req.InstanceId
+	job, opts, err := a.getSnapshotCloneJob(ctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	sourceConnection, err := shared.GetConnectionById(ctx, a.connclient, opts.GetConnectionId())
+	if err != nil {
+		return nil, fmt.Errorf("unable to get source connection by id (%s): %w", opts.GetConnectionId(), err)
+	}
+	provider, err := a.newProvider(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	slogger.Info("restoring snapshot", "instanceId", instanceId)
+	if err := provider.Restore(ctx, instanceId); err != nil {
+		return nil, fmt.Errorf("unable to restore snapshot into instance %s: %w", instanceId, err)
+	}
+	endpoint, err := provider.WaitUntilAvailable(ctx, instanceId)
+	if err != nil {
+		return nil, fmt.Errorf("restored instance %s did not become available: %w", instanceId, err)
+	}
+	slogger.Info("restored instance is available", "instanceId", instanceId, "host", endpoint.Host)
+
+	connectionConfig, err := buildRestoredConnectionConfig(sourceConnection.GetConnectionConfig(), endpoint)
+	if err != nil {
+		return nil, err
+	}
+	connectionName := instanceId
+	if opts.HandoverConnectionName != nil {
+		connectionName = buildHandoverConnectionName(opts.GetHandoverConnectionName(), instanceId)
+	}
+	connResp, err := a.connclient.CreateConnection(ctx, connect.NewRequest(&mgmtv1alpha1.CreateConnectionRequest{
+		AccountId:        job.GetAccountId(),
+		Name:             connectionName,
+		ConnectionConfig: connectionConfig,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to create connection for restored instance %s: %w", instanceId, err)
+	}
+	return &RestoreSnapshotResponse{
+		ConnectionId:    connResp.Msg.GetConnection().GetId(),
+		InstanceId:      instanceId,
+		RetainOnFailure: opts.GetRetainOnFailure(),
+	}, nil
+}
+
+// Deletes the instance that was restored for a failed run along with its connection, if one was created
+func (a *Activity) DeleteRestoredSnapshot(
+	ctx context.Context,
+	req *DeleteRestoredSnapshotRequest,
+) error {
+	info := activity.GetInfo(ctx)
+	loggerKeyVals := []any{
+		"jobId", req.JobId,
+		"WorkflowID", info.WorkflowExecution.ID,
+		"RunID", info.WorkflowExecution.RunID,
+	}
+	go recordHeartbeats(ctx)
+
+	slogger := logger_utils.NewJsonSLogger().With(loggerKeyVals...)
+	return a.deleteRestoredSnapshot(ctx, req, slogger)
+}
+
+func (a *Activity) deleteRestoredSnapshot(
+	ctx context.Context,
+	req *DeleteRestoredSnapshotRequest,
+	slogger *slog.Logger,
+) error {
+	_, opts, err := a.getSnapshotCloneJob(ctx, req.JobId)
+	if err != nil {
+		return err
+	}
+	provider, err := a.newProvider(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	slogger.Info("deleting restored instance", "instanceId", req.InstanceId)
+	if err := provider.Delete(ctx, req.InstanceId); err != nil {
+		return fmt.Errorf("unable to delete restored instance %s: %w", req.InstanceId, err)
+	}
+	if req.ConnectionId == "" {
+		return nil
+	}
+	_, err = a.connclient.DeleteConnection(ctx, connect.NewRequest(&mgmtv1alpha1.DeleteConnectionRequest{Id: req.ConnectionId}))
+	if err != nil {
+		return fmt.Errorf("unable to delete connection (%s) of restored instance: %w", req.ConnectionId, err)
+	}
+	return nil
+}
+
+func (a *Activity) getSnapshotCloneJob(
+	ctx context.Context,
+	jobId string,
+) (*mgmtv1alpha1.Job, *mgmtv1alpha1.SnapshotCloneSourceOptions, error) {
+	jobResp, err := a.jobclient.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{Id: jobId}))
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get job by id: %w", err)
+	}
+	job := jobResp.Msg.GetJob()
+	opts := job.GetSource().GetOptions().GetSnapshotClone()
+	if opts == nil {
+		return nil, nil, errors.New("job does not have a snapshot clone source")
+	}
+	return job, opts, nil
+}
+
+func recordHeartbeats(ctx context.Context) {
+	for {
+		select {
+		case <-time.After(1 * time.Second):
+			activity.RecordHeartbeat(ctx)
+		case <-activity.GetWorkerStopChannel(ctx):
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Derives the identifier of the restored instance from the workflow id so that the workflow can clean up an instance whose restore failed part way through.
+// Kept to 30 characters to fit within the instance name limits of both RDS and Cloud SQL
+func GetRestoredInstanceId(workflowId string) string {
+	h := fnv.New64a()
+	h.Write([]byte(workflowId))
+	return fmt.Sprintf("neosync-clone-%016x", h.Sum64())
+}
+
+// Suffixes the handover connection name with the run's part of the instance id, as connection names are unique within an account
+// and every run restores a new instance. The name is trimmed so that the result stays within the 30 character connection name limit
+func buildHandoverConnectionName(name, instanceId string) string {
+	suffix := instanceId
+	if len(suffix) > handoverConnectionSuffixLength {
+		suffix = suffix[len(suffix)-handoverConnectionSuffixLength:]
+	}
+	maxNameLength := maxConnectionNameLength - len(suffix) - 1
+	if len(name) > maxNameLength {
+		name = strings.TrimRight(name[:maxNameLength], "-")
+	}
+	return fmt.Sprintf("%s-%s", name, suffix)
+}
+
+// Points a copy of the source connection's config at the restored instance. The restored instance keeps the source's users and databases,
+// and is restored into the source's network so any tunnel of the source is kept
+func buildRestoredConnectionConfig(
+	sourceConfig *mgmtv1alpha1.ConnectionConfig,
+	endpoint *instanceEndpoint,
+) (*mgmtv1alpha1.ConnectionConfig, error) {
+	cc, ok := proto.Clone(sourceConfig).(*mgmtv1alpha1.ConnectionConfig)
+	if !ok {
+		return nil, errors.New("unable to clone source connection config")
+	}
+	switch config := cc.GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
+		switch pgcc := config.PgConfig.GetConnectionConfig().(type) {
+		case *mgmtv1alpha1.PostgresConnectionConfig_Connection:
+			pgcc.Connection.Host = endpoint.Host
+			pgcc.Connection.Port = endpoint.Port
+		case *mgmtv1alpha1.PostgresConnectionConfig_Url:
+			u, err := url.Parse(pgcc.Url)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse postgres url: %w", err)
+			}
+			u.Host = net.JoinHostPort(endpoint.Host, strconv.Itoa(int(endpoint.Port)))
+			pgcc.Url = u.String()
+		default:
+			return nil, fmt.Errorf("unsupported postgres connection config: %T", pgcc)
+		}
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+		switch mysqlcc := config.MysqlConfig.GetConnectionConfig().(type) {
+		case *mgmtv1alpha1.MysqlConnectionConfig_Connection:
+			mysqlcc.Connection.Host = endpoint.Host
+			mysqlcc.Connection.Port = endpoint.Port
+		default:
+			return nil, fmt.Errorf("unsupported mysql connection config: %T", mysqlcc)
+		}
+	default:
+		return nil, fmt.Errorf("snapshot clones are only supported for postgres and mysql connections: %T", config)
+	}
+	return cc, nil
+}
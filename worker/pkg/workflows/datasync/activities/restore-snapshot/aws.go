@@ -0,0 +1,73 @@
+package restoresnapshot_activity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+)
+
+// The longest the activity waits on RDS to finish restoring an instance
+const maxRdsRestoreWait = 4 * time.Hour
+
+type awsRdsProvider struct {
+	client   *rds.Client
+	snapshot *mgmtv1alpha1.AwsRdsSnapshot
+}
+
+// Authenticates with the worker's default AWS credential chain
+func newAwsRdsProvider(ctx context.Context, snapshot *mgmtv1alpha1.AwsRdsSnapshot) (snapshotProvider, error) {
+	cfg, err := config.LoadDefaultConfig(ctx, config.WithRegion(snapshot.GetRegion()))
+	if err != nil {
+		return nil, fmt.Errorf("unable to load aws config: %w", err)
+	}
+	return &awsRdsProvider{client: rds.NewFromConfig(cfg), snapshot: snapshot}, nil
+}
+
+func (p *awsRdsProvider) Restore(ctx context.Context, instanceId string) error {
+	_, err := p.client.RestoreDBInstanceFromDBSnapshot(ctx, &rds.RestoreDBInstanceFromDBSnapshotInput{
+		DBInstanceIdentifier: aws.String(instanceId),
+		DBSnapshotIdentifier: aws.String(p.snapshot.GetSnapshotIdentifier()),
+		DBInstanceClass:      p.snapshot.DbInstanceClass,
+		DBSubnetGroupName:    p.snapshot.DbSubnetGroupName,
+		VpcSecurityGroupIds:  p.snapshot.GetVpcSecurityGroupIds(),
+	})
+	var alreadyExists *types.DBInstanceAlreadyExistsFault
+	if err != nil && !errors.As(err, &alreadyExists) {
+		return err
+	}
+	return nil
+}
+
+func (p *awsRdsProvider) WaitUntilAvailable(ctx context.Context, instanceId string) (*instanceEndpoint, error) {
+	output, err := rds.NewDBInstanceAvailableWaiter(p.client).WaitForOutput(ctx, &rds.DescribeDBInstancesInput{
+		DBInstanceIdentifier: aws.String(instanceId),
+	}, maxRdsRestoreWait)
+	if err != nil {
+		return nil, err
+	}
+	if len(output.DBInstances) == 0 || output.DBInstances[0].Endpoint == nil {
+		return nil, fmt.Errorf("rds did not return an endpoint for instance %s", instanceId)
+	}
+	endpoint := output.DBInstances[0].Endpoint
+	return &instanceEndpoint{Host: aws.ToString(endpoint.Address), Port: aws.ToInt32(endpoint.Port)}, nil
+}
+
+func (p *awsRdsProvider) Delete(ctx context.Context, instanceId string) error {
+	_, err := p.client.DeleteDBInstance(ctx, &rds.DeleteDBInstanceInput{
+		DBInstanceIdentifier:   aws.String(instanceId),
+		SkipFinalSnapshot:      aws.Bool(true),
+		DeleteAutomatedBackups: aws.Bool(true),
+	})
+	var notFound *types.DBInstanceNotFoundFault
+	if err != nil && !errors.As(err, &notFound) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package restoresnapshot_activity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"google.golang.org/api/googleapi"
+	sqladmin "google.golang.org/api/sqladmin/v1"
+)
+
+const cloudSqlOperationPollInterval = 10 * time.Second
+
+type gcpCloudSqlProvider struct {
+	service *sqladmin.Service
+	backup  *mgmtv1alpha1.GcpCloudSqlBackup
+}
+
+// Authenticates with the worker's application default credentials
+func newGcpCloudSqlProvider(ctx context.Context, backup *mgmtv1alpha1.GcpCloudSqlBackup) (snapshotProvider, error) {
+	service, err := sqladmin.NewService(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create cloud sql admin client: %w", err)
+	}
+	return &gcpCloudSqlProvider{service: service, backup: backup}, nil
+}
+
+// Cloud SQL can only restore a backup into an existing instance, so an instance matching the source is created first
+func (p *gcpCloudSqlProvider) Restore(ctx context.Context, instanceId string) error {
+	project := p.backup.GetProject()
+	source, err := p.service.Instances.Get(project, p.backup.GetInstance()).Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("unable to get source instance %s: %w", p.backup.GetInstance(), err)
+	}
+	backupRunId, err := p.getBackupRunId(ctx)
+	if err != nil {
+		return err
+	}
+
+	op, err := p.service.Instances.Insert(project, buildRestoredCloudSqlInstance(source, instanceId, p.backup.Tier)).Context(ctx).Do()
+	if err != nil && !isGoogleApiErrorCode(err, http.StatusConflict) {
+		return fmt.Errorf("unable to create instance: %w", err)
+	}
+	if op != nil {
+		if err := p.waitForOperation(ctx, op); err != nil {
+			return fmt.Errorf("unable to create instance: %w", err)
+		}
+	}
+
+	op, err = p.service.Instances.RestoreBackup(project, instanceId, &sqladmin.InstancesRestoreBackupRequest{
+		RestoreBackupContext: &sqladmin.RestoreBackupContext{
+			BackupRunId: backupRunId,
+			InstanceId:  p.backup.GetInstance(),
+			Project:     project,
+		},
+	}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	return p.waitForOperation(ctx, op)
+}
+
+func (p *gcpCloudSqlProvider) WaitUntilAvailable(ctx context.Context, instanceId string) (*instanceEndpoint, error) {
+	for {
+		instance, err := p.service.Instances.Get(p.backup.GetProject(), instanceId).Context(ctx).Do()
+		if err != nil {
+			return nil, err
+		}
+		if instance.State == "RUNNABLE" {
+			return getCloudSqlInstanceEndpoint(instance)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(cloudSqlOperationPollInterval):
+		}
+	}
+}
+
+func (p *gcpCloudSqlProvider) Delete(ctx context.Context, instanceId string) error {
+	op, err := p.service.Instances.Delete(p.backup.GetProject(), instanceId).Context(ctx).Do()
+	if err != nil {
+		if isGoogleApiErrorCode(err, http.StatusNotFound) {
+			return nil
+		}
+		return err
+	}
+	return p.waitForOperation(ctx, op)
+}
+
+// Uses the configured backup run or falls back to the most recent successful backup of the source instance
+func (p *gcpCloudSqlProvider) getBackupRunId(ctx context.Context) (int64, error) {
+	if p.backup.BackupRunId != nil {
+		return p.backup.GetBackupRunId(), nil
+	}
+	var backupRunId int64
+	errFound := errors.New("found")
+	// backup runs are listed in reverse chronological order
+	err := p.service.BackupRuns.List(p.backup.GetProject(), p.backup.GetInstance()).Pages(ctx, func(resp *sqladmin.BackupRunsListResponse) error {
+		for _, run := range resp.Items {
+			if run.Status == "SUCCESSFUL" {
+				backupRunId = run.Id
+				return errFound
+			}
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errFound) {
+		return 0, fmt.Errorf("unable to list backup runs of instance %s: %w", p.backup.GetInstance(), err)
+	}
+	if backupRunId == 0 {
+		return 0, fmt.Errorf("instance %s does not have a successful backup to restore", p.backup.GetInstance())
+	}
+	return backupRunId, nil
+}
+
+func (p *gcpCloudSqlProvider) waitForOperation(ctx context.Context, op *sqladmin.Operation) error {
+	for op.Status != "DONE" {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(cloudSqlOperationPollInterval):
+		}
+		var err error
+		op, err = p.service.Operations.Get(p.backup.GetProject(), op.Name).Context(ctx).Do()
+		if err != nil {
+			return err
+		}
+	}
+	if op.Error != nil && len(op.Error.Errors) > 0 {
+		messages := make([]string, 0, len(op.Error.Errors))
+		for _, opErr := range op.Error.Errors {
+			messages = append(messages, fmt.Sprintf("%s: %s", opErr.Code, opErr.Message))
+		}
+		return fmt.Errorf("cloud sql operation %s failed: %s", op.Name, strings.Join(messages, "; "))
+	}
+	return nil
+}
+
+// The restored instance runs the same database version in the same region and network as the source so that the backup can be restored into it
+func buildRestoredCloudSqlInstance(source *sqladmin.DatabaseInstance, instanceId string, tier *string) *sqladmin.DatabaseInstance {
+	settings := &sqladmin.Settings{}
+	if source.Settings != nil {
+		settings.Tier = source.Settings.Tier
+		settings.IpConfiguration = source.Settings.IpConfiguration
+		settings.Edition = source.Settings.Edition
+	}
+	if tier != nil {
+		settings.Tier = *tier
+	}
+	return &sqladmin.DatabaseInstance{
+		Name:            instanceId,
+		DatabaseVersion: source.DatabaseVersion,
+		Region:          source.Region,
+		Settings:        settings,
+	}
+}
+
+// Prefers the private address as the worker is expected to run alongside the instance
+func getCloudSqlInstanceEndpoint(instance *sqladmin.DatabaseInstance) (*instanceEndpoint, error) {
+	var port int32
+	switch {
+	case strings.HasPrefix(instance.DatabaseVersion, "POSTGRES"):
+		port = 5432
+	case strings.HasPrefix(instance.DatabaseVersion, "MYSQL"):
+		port = 3306
+	default:
+		return nil, fmt.Errorf("unsupported cloud sql database version: %s", instance.DatabaseVersion)
+	}
+
+	var host string
+	for _, ip := range instance.IpAddresses {
+		if ip.Type == "PRIVATE" {
+			host = ip.IpAddress
+			break
+		}
+		if ip.Type == "PRIMARY" && host == "" {
+			host = ip.IpAddress
+		}
+	}
+	if host == "" {
+		return nil, fmt.Errorf("cloud sql instance %s does not have an ip address", instance.Name)
+	}
+	return &instanceEndpoint{Host: host, Port: port}, nil
+}
+
+func isGoogleApiErrorCode(err error, code int) bool {
+	var apiErr *googleapi.Error
+	return errors.As(err, &apiErr) && apiErr.Code == code
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 
 	"connectrpc.com/connect"
@@ -52,25 +53,48 @@ func (b *initStatementBuilder) RunSqlInitTableStatements(
 		return nil, fmt.Errorf("unable to get job by id: %w", err)
 	}
 
-	sourceConnection, err := shared.GetJobSourceConnection(ctx, job.GetSource(), b.connclient)
-	if err != nil {
-		return nil, fmt.Errorf("unable to get connection by id: %w", err)
+	if job.GetSource().GetOptions().GetSnapshotClone() != nil || job.GetSource().GetOptions().GetInPlaceMask() != nil {
+		// snapshot clones and in place masks update the existing rows, so there are no destination tables to initialize
+		return &RunSqlInitTableStatementsResponse{}, nil
 	}
-	if job.GetSource().GetOptions().GetAiGenerate() != nil {
-		sourceConnection, err = shared.GetConnectionById(ctx, b.connclient, *job.GetSource().GetOptions().GetAiGenerate().FkSourceConnectionId)
+
+	// when restoring from AWS S3 there is no source database, so the destination's own constraints are used instead
+	isAwsS3Source := job.GetSource().GetOptions().GetAwsS3() != nil
+	var sourcedb *sql_manager.SqlConnection
+	if !isAwsS3Source {
+		sourceConnection, err := shared.GetJobSourceConnection(ctx, job.GetSource(), b.connclient)
 		if err != nil {
 			return nil, fmt.Errorf("unable to get connection by id: %w", err)
 		}
-	}
+		if job.GetSource().GetOptions().GetAiGenerate() != nil {
+			sourceConnection, err = shared.GetConnectionById(ctx, b.connclient, *job.GetSource().GetOptions().GetAiGenerate().FkSourceConnectionId)
+			if err != nil {
+				return nil, fmt.Errorf("unable to get connection by id: %w", err)
+			}
+		}
 
-	sourcedb, err := b.sqlmanager.NewPooledSqlDb(ctx, slogger, sourceConnection)
-	if err != nil {
-		return nil, fmt.Errorf("unable to create new sql db: %w", err)
+		sourcedb, err = b.sqlmanager.NewPooledSqlDb(ctx, slogger, sourceConnection)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create new sql db: %w", err)
+		}
+		defer sourcedb.Db.Close()
 	}
-	defer sourcedb.Db.Close()
 
 	uniqueTables := shared.GetUniqueTablesMapFromJob(job)
 	uniqueSchemas := shared.GetUniqueSchemasFromJob(job)
+	viewColumns := map[string]map[string]*sql_manager.ColumnInfo{}
+	if sourcedb != nil && shared.ShouldMaterializeViews(job) {
+		viewColumns, err = sourcedb.Db.GetViewColumnMap(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get view columns for source connection: %w", err)
+		}
+	}
+	// virtual tables do not exist in the source so their destination tables must already exist
+	initTables, initViews := getInitSchemaTables(uniqueTables, shared.GetVirtualTablesFromJob(job), viewColumns)
+	initStatementOpts := &sql_manager.TableInitStatementOpts{
+		DestinationTables:  shared.GetDestinationTablesFromMappings(job.GetMappings()),
+		DestinationColumns: shared.GetDestinationColumnsFromMappings(job.GetMappings()),
+	}
 
 	for _, destination := range job.Destinations {
 		destinationConnection, err := shared.GetConnectionById(ctx, b.connclient, destination.ConnectionId)
@@ -102,6 +126,11 @@ func (b *initStatementBuilder) RunSqlInitTableStatements(
 			}
 		}
 
+		if isAwsS3Source && sqlopts.InitSchema {
+			slogger.Warn("cannot init schema when restoring from an AWS S3 source connection")
+			sqlopts.InitSchema = false
+		}
+
 		if !sqlopts.TruncateCascade && !sqlopts.TruncateBeforeInsert && !sqlopts.InitSchema {
 			slogger.Info("skipping truncate and schema init as none were set to true")
 			continue
@@ -111,16 +140,20 @@ func (b *initStatementBuilder) RunSqlInitTableStatements(
 		if err != nil {
 			return nil, fmt.Errorf("unable to create new sql db: %w", err)
 		}
+		schemadb := sourcedb
+		if isAwsS3Source {
+			schemadb = destdb
+		}
 
 		switch destinationConnection.ConnectionConfig.Config.(type) {
 		case *mgmtv1alpha1.ConnectionConfig_PgConfig:
 			if sqlopts.InitSchema {
 				tables := []*sql_manager.SchemaTable{}
-				for tableKey := range uniqueTables {
+				for tableKey := range initTables {
 					schema, table := shared.SplitTableKey(tableKey)
 					tables = append(tables, &sql_manager.SchemaTable{Schema: schema, Table: table})
 				}
-				initStatementCfgs, err := sourcedb.Db.GetTableInitStatements(ctx, tables)
+				initStatementCfgs, err := schemadb.Db.GetTableInitStatements(ctx, tables, initStatementOpts)
 				if err != nil {
 					return nil, err
 				}
@@ -139,6 +172,10 @@ func (b *initStatementBuilder) RunSqlInitTableStatements(
 					}
 					idxStmts = append(idxStmts, stmtCfg.IndexStatements...)
 				}
+				for _, view := range initViews {
+					schema, table := shared.SplitTableKey(view)
+					createTables = append(createTables, sql_manager.BuildCreateTableFromColumns(sql_manager.PostgresDriver, schema, table, viewColumns[view], initStatementOpts))
+				}
 
 				destPgConfig := destinationConnection.ConnectionConfig.GetPgConfig()
 				if destPgConfig == nil {
@@ -151,6 +188,7 @@ func (b *initStatementBuilder) RunSqlInitTableStatements(
 					{label: "fk alter table", statements: fkAlterStmts},
 					{label: "table index", statements: idxStmts},
 				}
+				appliedStmts := []string{}
 				for _, block := range initblocks {
 					slogger.Info(fmt.Sprintf("[%s] found %d statements to execute during schema initialization", block.label, len(block.statements)))
 					if len(block.statements) == 0 {
@@ -161,14 +199,17 @@ func (b *initStatementBuilder) RunSqlInitTableStatements(
 						destdb.Db.Close()
 						return nil, fmt.Errorf("unable to exec pg %s statements: %w", block.label, err)
 					}
+					appliedStmts = append(appliedStmts, block.statements...)
 				}
+				b.attachDdlArtifact(ctx, slogger, job.GetAccountId(), req.WorkflowId, destination.GetConnectionId(), appliedStmts)
 			}
 			// truncate statements
 			if sqlopts.TruncateCascade {
 				tableTruncateStmts := []string{}
-				for table := range uniqueTables {
-					split := strings.Split(table, ".")
-					stmt, err := sql_manager.BuildPgTruncateCascadeStatement(split[0], split[1])
+				for tableKey := range uniqueTables {
+					schema, table := shared.SplitTableKey(tableKey)
+					dest := shared.GetDestinationTable(initStatementOpts.DestinationTables, schema, table)
+					stmt, err := sql_manager.BuildPgTruncateCascadeStatement(dest.Schema, dest.Table)
 					if err != nil {
 						destdb.Db.Close()
 						return nil, err
@@ -182,7 +223,7 @@ func (b *initStatementBuilder) RunSqlInitTableStatements(
 					return nil, fmt.Errorf("unable to exec truncate cascade statements: %w", err)
 				}
 			} else if sqlopts.TruncateBeforeInsert {
-				tableDependencies, err := sourcedb.Db.GetForeignKeyConstraintsMap(ctx, uniqueSchemas)
+				tableDependencies, err := schemadb.Db.GetForeignKeyConstraintsMap(ctx, uniqueSchemas)
 				if err != nil {
 					return nil, fmt.Errorf("unable to retrieve database foreign key constraints: %w", err)
 				}
@@ -195,9 +236,10 @@ func (b *initStatementBuilder) RunSqlInitTableStatements(
 				}
 
 				orderedTableTruncate := []string{}
-				for _, table := range orderedTablesResp.OrderedTables {
-					split := strings.Split(table, ".")
-					orderedTableTruncate = append(orderedTableTruncate, fmt.Sprintf(`%q.%q`, split[0], split[1]))
+				for _, tableKey := range orderedTablesResp.OrderedTables {
+					schema, table := shared.SplitTableKey(tableKey)
+					dest := shared.GetDestinationTable(initStatementOpts.DestinationTables, schema, table)
+					orderedTableTruncate = append(orderedTableTruncate, sql_manager.QuoteTable(sql_manager.PostgresDriver, dest.Schema, dest.Table))
 				}
 				slogger.Info(fmt.Sprintf("executing %d sql statements that will truncate tables", len(orderedTableTruncate)))
 				truncateStmt := sql_manager.BuildPgTruncateStatement(orderedTableTruncate)
@@ -210,12 +252,12 @@ func (b *initStatementBuilder) RunSqlInitTableStatements(
 			destdb.Db.Close()
 		case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
 			if sqlopts.InitSchema {
-				tableDependencies, err := sourcedb.Db.GetForeignKeyConstraintsMap(ctx, uniqueSchemas)
+				tableDependencies, err := schemadb.Db.GetForeignKeyConstraintsMap(ctx, uniqueSchemas)
 				if err != nil {
 					return nil, fmt.Errorf("unable to retrieve database foreign key constraints: %w", err)
 				}
 				slogger.Info(fmt.Sprintf("found %d foreign key constraints for database", len(tableDependencies)))
-				tableForeignDependencyMap := getFilteredForeignToPrimaryTableMap(tableDependencies, uniqueTables)
+				tableForeignDependencyMap := getFilteredForeignToPrimaryTableMap(tableDependencies, initTables)
 				orderedTablesResp, err := tabledependency.GetTablesOrderedByDependency(tableForeignDependencyMap)
 				if err != nil {
 					destdb.Db.Close()
@@ -230,7 +272,7 @@ func (b *initStatementBuilder) RunSqlInitTableStatements(
 				for _, table := range orderedTablesResp.OrderedTables {
 					split := strings.Split(table, ".")
 					// todo: make this more efficient to reduce amount of times we have to connect to the source database
-					initStmt, err := sourcedb.Db.GetCreateTableStatement(
+					initStmt, err := schemadb.Db.GetCreateTableStatement(
 						ctx,
 						split[0],
 						split[1],
@@ -239,6 +281,18 @@ func (b *initStatementBuilder) RunSqlInitTableStatements(
 						destdb.Db.Close()
 						return nil, fmt.Errorf("unable to build init statement for postgres: %w", err)
 					}
+					initStmt = sql_manager.RemapMysqlCreateTableStatement(initStmt, split[0], split[1], initStatementOpts)
+					if sqlopts.CharsetOverride != nil {
+						initStmt = sql_manager.ApplyMysqlCharsetOverride(initStmt, sqlopts.CharsetOverride.GetCharset(), sqlopts.CharsetOverride.Collation)
+					}
+					tableCreateStmts = append(tableCreateStmts, initStmt)
+				}
+				for _, view := range initViews {
+					schema, table := shared.SplitTableKey(view)
+					initStmt := sql_manager.BuildCreateTableFromColumns(sql_manager.MysqlDriver, schema, table, viewColumns[view], initStatementOpts)
+					if sqlopts.CharsetOverride != nil {
+						initStmt = sql_manager.ApplyMysqlCharsetOverride(initStmt, sqlopts.CharsetOverride.GetCharset(), sqlopts.CharsetOverride.Collation)
+					}
 					tableCreateStmts = append(tableCreateStmts, initStmt)
 				}
 				slogger.Info(fmt.Sprintf("executing %d sql statements that will initialize tables", len(tableCreateStmts)))
@@ -247,13 +301,15 @@ func (b *initStatementBuilder) RunSqlInitTableStatements(
 					destdb.Db.Close()
 					return nil, fmt.Errorf("unable to exec postgres table create statements: %w", err)
 				}
+				b.attachDdlArtifact(ctx, slogger, job.GetAccountId(), req.WorkflowId, destination.GetConnectionId(), tableCreateStmts)
 			}
 			// truncate statements
 			if sqlopts.TruncateBeforeInsert {
 				tableTruncate := []string{}
-				for table := range uniqueTables {
-					split := strings.Split(table, ".")
-					stmt, err := sql_manager.BuildMysqlTruncateStatement(split[0], split[1])
+				for tableKey := range uniqueTables {
+					schema, table := shared.SplitTableKey(tableKey)
+					dest := shared.GetDestinationTable(initStatementOpts.DestinationTables, schema, table)
+					stmt, err := sql_manager.BuildMysqlTruncateStatement(dest.Schema, dest.Table)
 					if err != nil {
 						destdb.Db.Close()
 						return nil, err
@@ -269,7 +325,7 @@ func (b *initStatementBuilder) RunSqlInitTableStatements(
 				}
 			}
 			destdb.Db.Close()
-		case *mgmtv1alpha1.ConnectionConfig_AwsS3Config:
+		case *mgmtv1alpha1.ConnectionConfig_AwsS3Config, *mgmtv1alpha1.ConnectionConfig_CustomConfig, *mgmtv1alpha1.ConnectionConfig_KafkaConfig, *mgmtv1alpha1.ConnectionConfig_DuckdbConfig:
 			// nothing to do here
 		default:
 			return nil, fmt.Errorf("unsupported destination connection config")
@@ -279,6 +335,36 @@ func (b *initStatementBuilder) RunSqlInitTableStatements(
 	return &RunSqlInitTableStatementsResponse{}, nil
 }
 
+// Attaches the DDL that was applied to the destination to the job run.
+// This is best effort as artifact storage is optional and should never fail the run
+func (b *initStatementBuilder) attachDdlArtifact(
+	ctx context.Context,
+	slogger *slog.Logger,
+	accountId string,
+	jobRunId string,
+	destinationConnectionId string,
+	statements []string,
+) {
+	if len(statements) == 0 {
+		return
+	}
+	_, err := b.jobclient.CreateJobRunArtifact(ctx, connect.NewRequest(&mgmtv1alpha1.CreateJobRunArtifactRequest{
+		AccountId:   accountId,
+		JobRunId:    jobRunId,
+		Type:        mgmtv1alpha1.JobRunArtifactType_JOB_RUN_ARTIFACT_TYPE_DDL_APPLIED,
+		Name:        fmt.Sprintf("ddl-applied-%s.sql", destinationConnectionId),
+		ContentType: "application/sql",
+		Content:     []byte(strings.Join(statements, "\n\n")),
+	}))
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeUnimplemented {
+			slogger.Debug("skipping ddl artifact as job run artifacts are not enabled")
+			return
+		}
+		slogger.Warn(fmt.Sprintf("unable to attach ddl artifact to job run: %s", err.Error()))
+	}
+}
+
 func (b *initStatementBuilder) getJobById(
 	ctx context.Context,
 	jobId string,
@@ -292,6 +378,29 @@ func (b *initStatementBuilder) getJobById(
 	return getjobResp.Msg.Job, nil
 }
 
+// Returns the source tables that should be created during schema initialization,
+// along with the source views that are materialized into tables from the columns of the views
+func getInitSchemaTables(
+	uniqueTables map[string]struct{},
+	virtualTables map[string]*mgmtv1alpha1.SqlVirtualTable,
+	viewColumns map[string]map[string]*sql_manager.ColumnInfo,
+) (map[string]struct{}, []string) {
+	initTables := map[string]struct{}{}
+	initViews := []string{}
+	for table := range uniqueTables {
+		if _, ok := virtualTables[table]; ok {
+			continue
+		}
+		if _, ok := viewColumns[table]; ok {
+			initViews = append(initViews, table)
+			continue
+		}
+		initTables[table] = struct{}{}
+	}
+	slices.Sort(initViews)
+	return initTables, initViews
+}
+
 // filtered by tables found in job mappings
 func getFilteredForeignToPrimaryTableMap(td map[string][]*sql_manager.ForeignConstraint, uniqueTables map[string]struct{}) map[string][]string {
 	dpMap := map[string][]string{}
@@ -319,6 +428,8 @@ type sqlJobDestinationOpts struct {
 	TruncateBeforeInsert bool
 	TruncateCascade      bool
 	InitSchema           bool
+	// mysql only
+	CharsetOverride *mgmtv1alpha1.MysqlCharsetOverride
 }
 
 func getSqlJobDestinationOpts(
@@ -338,6 +449,7 @@ func getSqlJobDestinationOpts(
 		return &sqlJobDestinationOpts{
 			TruncateBeforeInsert: opts.MysqlOptions.GetTruncateTable().GetTruncateBeforeInsert(),
 			InitSchema:           opts.MysqlOptions.GetInitTableSchema(),
+			CharsetOverride:      opts.MysqlOptions.GetCharsetOverride(),
 		}, nil
 	default:
 		return nil, errors.New("unsupported job destination options type")
@@ -2,6 +2,7 @@ package runsqlinittablestmts_activity
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"slices"
 	"testing"
@@ -137,7 +138,7 @@ func Test_InitStatementBuilder_Pg_Generate_InitSchema(t *testing.T) {
 		},
 	}), nil)
 	mockSqlManager.On("NewPooledSqlDb", mock.Anything, mock.Anything, mock.Anything).Return(&sql_manager.SqlConnection{Db: mockSqlDb}, nil)
-	mockSqlDb.On("GetTableInitStatements", mock.Anything, []*sql_manager.SchemaTable{{Schema: "public", Table: "users"}}).Return([]*sql_manager.TableInitStatement{
+	mockSqlDb.On("GetTableInitStatements", mock.Anything, []*sql_manager.SchemaTable{{Schema: "public", Table: "users"}}, mock.Anything).Return([]*sql_manager.TableInitStatement{
 		{
 			CreateTableStatement: "test-create-statement",
 			AlterTableStatements: []*sql_manager.AlterTableStatement{
@@ -160,6 +161,8 @@ func Test_InitStatementBuilder_Pg_Generate_InitSchema(t *testing.T) {
 	mockSqlDb.On("BatchExec", mock.Anything, mock.Anything, []string{"test-idx-statement"}, &sql_manager.BatchExecOpts{}).Return(nil)
 	mockSqlDb.On("Close").Return(nil)
 
+	mockJobClient.On("CreateJobRunArtifact", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.CreateJobRunArtifactResponse{}), nil)
+
 	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient)
 	_, err := bbuilder.RunSqlInitTableStatements(
 		context.Background(),
@@ -657,7 +660,7 @@ func Test_InitStatementBuilder_Pg_InitSchema(t *testing.T) {
 	}), nil)
 
 	mockSqlManager.On("NewPooledSqlDb", mock.Anything, mock.Anything, mock.Anything).Return(&sql_manager.SqlConnection{Db: mockSqlDb}, nil)
-	mockSqlDb.On("GetTableInitStatements", mock.Anything, mock.Anything).Return([]*sql_manager.TableInitStatement{
+	mockSqlDb.On("GetTableInitStatements", mock.Anything, mock.Anything, mock.Anything).Return([]*sql_manager.TableInitStatement{
 		{
 			CreateTableStatement: "test-create-statement",
 			AlterTableStatements: []*sql_manager.AlterTableStatement{
@@ -680,6 +683,13 @@ func Test_InitStatementBuilder_Pg_InitSchema(t *testing.T) {
 	mockSqlDb.On("BatchExec", mock.Anything, mock.Anything, []string{"test-idx-statement"}, &sql_manager.BatchExecOpts{}).Return(nil)
 	mockSqlDb.On("Close").Return(nil)
 
+	mockJobClient.On("CreateJobRunArtifact", mock.Anything, mock.MatchedBy(func(req *connect.Request[mgmtv1alpha1.CreateJobRunArtifactRequest]) bool {
+		return req.Msg.GetJobRunId() == "123" &&
+			req.Msg.GetType() == mgmtv1alpha1.JobRunArtifactType_JOB_RUN_ARTIFACT_TYPE_DDL_APPLIED &&
+			req.Msg.GetName() == "ddl-applied-456.sql" &&
+			string(req.Msg.GetContent()) == "test-create-statement\n\ntest-pk-statement\n\ntest-fk-statement\n\ntest-idx-statement"
+	})).Return(nil, connect.NewError(connect.CodeUnimplemented, errors.New("artifacts are not enabled")))
+
 	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient)
 	_, err := bbuilder.RunSqlInitTableStatements(
 		context.Background(),
@@ -943,6 +953,8 @@ func Test_InitStatementBuilder_Mysql_TruncateCreate(t *testing.T) {
 	mockSqlDb.On("BatchExec", mock.Anything, mock.Anything, mock.MatchedBy(func(query []string) bool { return compareSlices(query, truncateStmts) }), &sql_manager.BatchExecOpts{Prefix: &disableFkChecks}).Return(nil)
 	mockSqlDb.On("Close").Return(nil)
 
+	mockJobClient.On("CreateJobRunArtifact", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.CreateJobRunArtifactResponse{}), nil)
+
 	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient)
 	_, err := bbuilder.RunSqlInitTableStatements(
 		context.Background(),
@@ -1034,6 +1046,24 @@ func Test_getFilteredForeignToPrimaryTableMap_filtered(t *testing.T) {
 	}
 }
 
+func Test_getInitSchemaTables(t *testing.T) {
+	tables := map[string]struct{}{
+		"public.users":        {},
+		"public.user_summary": {},
+		"public.active_users": {},
+	}
+	virtualTables := map[string]*mgmtv1alpha1.SqlVirtualTable{
+		"public.user_summary": {Schema: "public", Table: "user_summary", Query: "SELECT id FROM public.users"},
+	}
+	viewColumns := map[string]map[string]*sql_manager.ColumnInfo{
+		"public.active_users": {"id": {OrdinalPosition: 1, DataType: "uuid", IsNullable: true, TableType: sql_manager.ViewTableType}},
+		"public.unmapped":     {"id": {OrdinalPosition: 1, DataType: "uuid", IsNullable: true, TableType: sql_manager.ViewTableType}},
+	}
+	initTables, initViews := getInitSchemaTables(tables, virtualTables, viewColumns)
+	assert.Equal(t, map[string]struct{}{"public.users": {}}, initTables)
+	assert.Equal(t, []string{"public.active_users"}, initViews)
+}
+
 func compareSlices(slice1, slice2 []string) bool {
 	for _, ele := range slice1 {
 		if !slices.Contains(slice2, ele) {
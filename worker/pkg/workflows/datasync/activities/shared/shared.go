@@ -50,6 +50,18 @@ func GetNeosyncHttpClient() *http.Client {
 	return http_client.NewWithAuth(&apikey)
 }
 
+// Returns true if data quality scores should be computed after each sync
+func IsDataQualityScoringEnabled() bool {
+	return viper.GetBool("DATA_QUALITY_SCORING_ENABLED")
+}
+
+// Returns true if the worker has been configured with an OpenLineage backend or a Datadog or Splunk forwarder to report runs to
+func IsRunEventReportingEnabled() bool {
+	return viper.GetString("OPENLINEAGE_URL") != "" ||
+		viper.GetString("DATADOG_API_KEY") != "" ||
+		viper.GetString("SPLUNK_HEC_URL") != ""
+}
+
 // Generic util method that turns any value into its pointer
 func Ptr[T any](val T) *T {
 	return &val
@@ -68,6 +80,16 @@ func GetUniqueSchemasFromJob(job *mgmtv1alpha1.Job) []string {
 			schemas = append(schemas, s)
 		}
 		return schemas
+	case *mgmtv1alpha1.JobSourceOptions_Synthesize:
+		uniqueSchemas := map[string]struct{}{}
+		for _, schema := range jobSourceConfig.Synthesize.Schemas {
+			uniqueSchemas[schema.Schema] = struct{}{}
+		}
+		schemas := []string{}
+		for s := range uniqueSchemas {
+			schemas = append(schemas, s)
+		}
+		return schemas
 	default:
 		return GetUniqueSchemasFromMappings(job.GetMappings())
 	}
@@ -99,6 +121,14 @@ func GetUniqueTablesMapFromJob(job *mgmtv1alpha1.Job) map[string]struct{} {
 			}
 		}
 		return uniqueTables
+	case *mgmtv1alpha1.JobSourceOptions_Synthesize:
+		uniqueTables := map[string]struct{}{}
+		for _, schema := range jobSourceConfig.Synthesize.Schemas {
+			for _, table := range schema.Tables {
+				uniqueTables[sql_manager.BuildTable(schema.Schema, table.Table)] = struct{}{}
+			}
+		}
+		return uniqueTables
 	default:
 		return GetUniqueTablesFromMappings(job.GetMappings())
 	}
@@ -125,6 +155,94 @@ func GetUniqueTablesFromMappings(mappings []*mgmtv1alpha1.JobMapping) map[string
 	return filteredTables
 }
 
+// Returns the virtual tables that are configured on a SQL job source, keyed by their schema.table
+func GetVirtualTablesFromJob(job *mgmtv1alpha1.Job) map[string]*mgmtv1alpha1.SqlVirtualTable {
+	var virtualTables []*mgmtv1alpha1.SqlVirtualTable
+	switch jobSourceConfig := job.GetSource().GetOptions().GetConfig().(type) {
+	case *mgmtv1alpha1.JobSourceOptions_Postgres:
+		virtualTables = jobSourceConfig.Postgres.GetVirtualTables()
+	case *mgmtv1alpha1.JobSourceOptions_Mysql:
+		virtualTables = jobSourceConfig.Mysql.GetVirtualTables()
+	}
+	output := map[string]*mgmtv1alpha1.SqlVirtualTable{}
+	for _, vt := range virtualTables {
+		output[sql_manager.BuildTable(vt.GetSchema(), vt.GetTable())] = vt
+	}
+	return output
+}
+
+// Returns true if the views of a SQL job source are synced into physical tables at the destination
+func ShouldMaterializeViews(job *mgmtv1alpha1.Job) bool {
+	switch jobSourceConfig := job.GetSource().GetOptions().GetConfig().(type) {
+	case *mgmtv1alpha1.JobSourceOptions_Postgres:
+		return jobSourceConfig.Postgres.GetMaterializeViews()
+	case *mgmtv1alpha1.JobSourceOptions_Mysql:
+		return jobSourceConfig.Mysql.GetMaterializeViews()
+	default:
+		return false
+	}
+}
+
+// Parses the job mappings and returns the tables that are written to a different schema or table name in SQL destinations.
+// Keyed by the source schema.table
+func GetDestinationTablesFromMappings(mappings []*mgmtv1alpha1.JobMapping) map[string]*sql_manager.SchemaTable {
+	destinationTables := map[string]*sql_manager.SchemaTable{}
+	for _, mapping := range mappings {
+		if mapping.GetDestinationTable() == nil {
+			continue
+		}
+		destinationTables[sql_manager.BuildTable(mapping.GetSchema(), mapping.GetTable())] = &sql_manager.SchemaTable{
+			Schema: mapping.GetDestinationTable().GetSchema(),
+			Table:  mapping.GetDestinationTable().GetTable(),
+		}
+	}
+	return destinationTables
+}
+
+// Returns the table that the source table is written to in SQL destinations
+func GetDestinationTable(destinationTables map[string]*sql_manager.SchemaTable, schema, table string) *sql_manager.SchemaTable {
+	if dest, ok := destinationTables[sql_manager.BuildTable(schema, table)]; ok {
+		return dest
+	}
+	return &sql_manager.SchemaTable{Schema: schema, Table: table}
+}
+
+// Parses the job mappings and returns the columns that are written under a different name or data type in SQL destinations,
+// or that are dropped from the destination entirely.
+// Keyed by the source schema.table and then column
+func GetDestinationColumnsFromMappings(mappings []*mgmtv1alpha1.JobMapping) map[string]map[string]*sql_manager.DestinationColumn {
+	destinationColumns := map[string]map[string]*sql_manager.DestinationColumn{}
+	for _, mapping := range mappings {
+		isDropped := mapping.GetTransformer().GetSource() == mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_DROP_COLUMN
+		if mapping.GetDestinationColumn() == nil && !isDropped {
+			continue
+		}
+		key := sql_manager.BuildTable(mapping.GetSchema(), mapping.GetTable())
+		if _, ok := destinationColumns[key]; !ok {
+			destinationColumns[key] = map[string]*sql_manager.DestinationColumn{}
+		}
+		destinationColumns[key][mapping.GetColumn()] = &sql_manager.DestinationColumn{
+			Name:     mapping.GetDestinationColumn().GetName(),
+			DataType: mapping.GetDestinationColumn().GetDataType(),
+			Exclude:  isDropped,
+		}
+	}
+	return destinationColumns
+}
+
+// Returns the names that the columns of a table are written to in SQL destinations
+func GetDestinationColumnNames(tableDestinationColumns map[string]*sql_manager.DestinationColumn, columns []string) []string {
+	output := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if dest, ok := tableDestinationColumns[column]; ok && dest.Name != "" {
+			output = append(output, dest.Name)
+			continue
+		}
+		output = append(output, column)
+	}
+	return output
+}
+
 type RedisConfig struct {
 	Url    string
 	Kind   string
@@ -193,6 +311,19 @@ func GetJobSourceConnection(
 	jobSource *mgmtv1alpha1.JobSource,
 	connclient mgmtv1alpha1connect.ConnectionServiceClient,
 ) (*mgmtv1alpha1.Connection, error) {
+	connectionId, err := GetJobSourceConnectionId(jobSource)
+	if err != nil {
+		return nil, err
+	}
+	sourceConnection, err := GetConnectionById(ctx, connclient, connectionId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get connection by id (%s): %w", connectionId, err)
+	}
+	return sourceConnection, nil
+}
+
+// Returns the id of the connection the job reads from. May be empty for generate jobs that do not have a foreign key source
+func GetJobSourceConnectionId(jobSource *mgmtv1alpha1.JobSource) (string, error) {
 	var connectionId string
 	switch jobSourceConfig := jobSource.GetOptions().GetConfig().(type) {
 	case *mgmtv1alpha1.JobSourceOptions_Postgres:
@@ -203,14 +334,34 @@ func GetJobSourceConnection(
 		connectionId = jobSourceConfig.Generate.GetFkSourceConnectionId()
 	case *mgmtv1alpha1.JobSourceOptions_AiGenerate:
 		connectionId = jobSourceConfig.AiGenerate.GetAiConnectionId()
+	case *mgmtv1alpha1.JobSourceOptions_Synthesize:
+		connectionId = jobSourceConfig.Synthesize.GetConnectionId()
+	case *mgmtv1alpha1.JobSourceOptions_AwsS3:
+		connectionId = jobSourceConfig.AwsS3.GetConnectionId()
+	case *mgmtv1alpha1.JobSourceOptions_Custom:
+		connectionId = jobSourceConfig.Custom.GetConnectionId()
+	case *mgmtv1alpha1.JobSourceOptions_SnapshotClone:
+		connectionId = jobSourceConfig.SnapshotClone.GetConnectionId()
+	case *mgmtv1alpha1.JobSourceOptions_InPlaceMask:
+		connectionId = jobSourceConfig.InPlaceMask.GetConnectionId()
 	default:
-		return nil, errors.New("unsupported job source options type")
+		return "", errors.New("unsupported job source options type")
 	}
-	sourceConnection, err := GetConnectionById(ctx, connclient, connectionId)
-	if err != nil {
-		return nil, fmt.Errorf("unable to get connection by id (%s): %w", connectionId, err)
+	return connectionId, nil
+}
+
+// Returns the task queue polled by workers started in the given region
+func GetRegionalTaskQueue(taskQueue, region string) string {
+	if region == "" {
+		return taskQueue
 	}
-	return sourceConnection, nil
+	return fmt.Sprintf("%s-%s", taskQueue, region)
+}
+
+// Returns the task queue polled for the activities of interactive job runs.
+// It is kept separate so that interactive runs are not stuck behind batch runs when workers are at capacity.
+func GetInteractiveTaskQueue(taskQueue string) string {
+	return fmt.Sprintf("%s-interactive", taskQueue)
 }
 
 func GetConnectionById(
@@ -3,6 +3,8 @@ package shared
 import (
 	"testing"
 
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
 	"github.com/stretchr/testify/require"
 )
 
@@ -15,3 +17,35 @@ func Test_splitTableKey(t *testing.T) {
 	require.Equal(t, schema, "neosync")
 	require.Equal(t, table, "foo")
 }
+
+func Test_GetDestinationTable(t *testing.T) {
+	destinationTables := GetDestinationTablesFromMappings([]*mgmtv1alpha1.JobMapping{
+		{Schema: "public", Table: "users", Column: "id", DestinationTable: &mgmtv1alpha1.JobMappingDestinationTable{Schema: "masked", Table: "users_v2"}},
+		{Schema: "public", Table: "orders", Column: "id"},
+	})
+	require.Len(t, destinationTables, 1)
+	require.Equal(t, &sql_manager.SchemaTable{Schema: "masked", Table: "users_v2"}, GetDestinationTable(destinationTables, "public", "users"))
+	require.Equal(t, &sql_manager.SchemaTable{Schema: "public", Table: "orders"}, GetDestinationTable(destinationTables, "public", "orders"))
+}
+
+func Test_GetDestinationColumnNames(t *testing.T) {
+	name := "email_hash"
+	destinationColumns := GetDestinationColumnsFromMappings([]*mgmtv1alpha1.JobMapping{
+		{Schema: "public", Table: "users", Column: "email", DestinationColumn: &mgmtv1alpha1.JobMappingDestinationColumn{Name: &name}},
+		{Schema: "public", Table: "users", Column: "id"},
+	})
+	require.Equal(t, []string{"id", "email_hash"}, GetDestinationColumnNames(destinationColumns["public.users"], []string{"id", "email"}))
+	require.Equal(t, []string{"id"}, GetDestinationColumnNames(destinationColumns["public.orders"], []string{"id"}))
+}
+
+func Test_GetDestinationColumnsFromMappings_DropColumn(t *testing.T) {
+	destinationColumns := GetDestinationColumnsFromMappings([]*mgmtv1alpha1.JobMapping{
+		{Schema: "public", Table: "users", Column: "id"},
+		{Schema: "public", Table: "users", Column: "ssn", Transformer: &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_DROP_COLUMN,
+		}},
+	})
+	require.Equal(t, map[string]map[string]*sql_manager.DestinationColumn{
+		"public.users": {"ssn": {Exclude: true}},
+	}, destinationColumns)
+}
@@ -20,6 +20,11 @@ type RetrieveActivityOptionsRequest struct {
 }
 type RetrieveActivityOptionsResponse struct {
 	SyncActivityOptions *workflow.ActivityOptions
+	Priority            mgmtv1alpha1.JobRunPriority
+	// The optional steps that at least one of the job's destinations has opted in to. The workflow only schedules the steps that are set
+	DisableDestinationTriggers bool
+	AnalyzeDestinationTables   bool
+	RefreshMaterializedViews   bool
 }
 
 func RetrieveActivityOptions(
@@ -33,7 +38,6 @@ func RetrieveActivityOptions(
 		"WorkflowID", wfmetadata.WorkflowId,
 		"RunID", wfmetadata.RunId,
 	)
-	_ = logger
 
 	neosyncUrl := shared.GetNeosyncUrl()
 	httpClient := shared.GetNeosyncHttpClient()
@@ -48,11 +52,121 @@ func RetrieveActivityOptions(
 		return nil, fmt.Errorf("unable to get job by id: %w", err)
 	}
 	job := jobResp.Msg.Job
+	syncActivityOptions := getSyncActivityOptionsFromJob(job)
+
+	connclient := mgmtv1alpha1connect.NewConnectionServiceClient(
+		httpClient,
+		neosyncUrl,
+	)
+	region, err := getJobRegion(ctx, job, connclient)
+	if err != nil {
+		return nil, err
+	}
+	if region != "" {
+		// the workflow runs on the default task queue, activities that touch data are routed to the workers in the connection's region
+		syncActivityOptions.TaskQueue = shared.GetRegionalTaskQueue(activity.GetInfo(ctx).TaskQueue, region)
+		logger.Info("routing sync activities to regional task queue", "region", region, "taskQueue", syncActivityOptions.TaskQueue)
+	}
+
+	priorityResp, err := jobclient.GetJobRunPriority(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRunPriorityRequest{
+		JobId:    req.JobId,
+		JobRunId: wfmetadata.WorkflowId,
+	}))
+	priority := mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_BATCH
+	if err != nil {
+		// the priority only affects scheduling, so the run carries on as a batch run
+		logger.Warn(fmt.Sprintf("unable to get job run priority, running as a batch run: %s", err.Error()))
+	} else {
+		priority = priorityResp.Msg.GetPriority()
+	}
+	if priority == mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_INTERACTIVE {
+		taskQueue := syncActivityOptions.TaskQueue
+		if taskQueue == "" {
+			taskQueue = activity.GetInfo(ctx).TaskQueue
+		}
+		syncActivityOptions.TaskQueue = shared.GetInteractiveTaskQueue(taskQueue)
+		logger.Info("routing sync activities to interactive task queue", "taskQueue", syncActivityOptions.TaskQueue)
+	}
 	return &RetrieveActivityOptionsResponse{
-		SyncActivityOptions: getSyncActivityOptionsFromJob(job),
+		SyncActivityOptions:        syncActivityOptions,
+		Priority:                   priority,
+		DisableDestinationTriggers: hasDestinationOption(job.GetDestinations(), isDisableTriggersEnabled),
+		AnalyzeDestinationTables:   hasDestinationOption(job.GetDestinations(), isAnalyzeTablesEnabled),
+		RefreshMaterializedViews:   hasDestinationOption(job.GetDestinations(), isRefreshMaterializedViewsEnabled),
 	}, nil
 }
 
+func hasDestinationOption(
+	destinations []*mgmtv1alpha1.JobDestination,
+	isEnabled func(options *mgmtv1alpha1.JobDestinationOptions) bool,
+) bool {
+	for _, destination := range destinations {
+		if isEnabled(destination.GetOptions()) {
+			return true
+		}
+	}
+	return false
+}
+
+func isDisableTriggersEnabled(options *mgmtv1alpha1.JobDestinationOptions) bool {
+	return options.GetPostgresOptions().GetDisableTriggers()
+}
+
+func isAnalyzeTablesEnabled(options *mgmtv1alpha1.JobDestinationOptions) bool {
+	return options.GetPostgresOptions().GetAnalyzeTables().GetEnabled() || options.GetMysqlOptions().GetAnalyzeTables().GetEnabled()
+}
+
+func isRefreshMaterializedViewsEnabled(options *mgmtv1alpha1.JobDestinationOptions) bool {
+	return options.GetPostgresOptions().GetRefreshMaterializedViews().GetEnabled()
+}
+
+func getJobRegion(
+	ctx context.Context,
+	job *mgmtv1alpha1.Job,
+	connclient mgmtv1alpha1connect.ConnectionServiceClient,
+) (string, error) {
+	sourceConnectionId, err := shared.GetJobSourceConnectionId(job.GetSource())
+	if err != nil {
+		return "", err
+	}
+	var source *mgmtv1alpha1.Connection
+	if sourceConnectionId != "" {
+		source, err = shared.GetConnectionById(ctx, connclient, sourceConnectionId)
+		if err != nil {
+			return "", fmt.Errorf("unable to get connection by id (%s): %w", sourceConnectionId, err)
+		}
+	}
+	destinations := make([]*mgmtv1alpha1.Connection, 0, len(job.GetDestinations()))
+	for _, destination := range job.GetDestinations() {
+		connection, err := shared.GetConnectionById(ctx, connclient, destination.GetConnectionId())
+		if err != nil {
+			return "", fmt.Errorf("unable to get connection by id (%s): %w", destination.GetConnectionId(), err)
+		}
+		destinations = append(destinations, connection)
+	}
+	return getRegionFromConnections(source, destinations)
+}
+
+// The source connection's region wins as that is where the bulk of the data is read from.
+// Otherwise the destinations must agree on a region as there is no single region the activities could be kept in.
+func getRegionFromConnections(source *mgmtv1alpha1.Connection, destinations []*mgmtv1alpha1.Connection) (string, error) {
+	if region := source.GetConnectionConfig().GetRegion(); region != "" {
+		return region, nil
+	}
+	region := ""
+	for _, destination := range destinations {
+		destRegion := destination.GetConnectionConfig().GetRegion()
+		if destRegion == "" {
+			continue
+		}
+		if region != "" && region != destRegion {
+			return "", fmt.Errorf("job destinations are in multiple regions (%s, %s), set a region on the source connection to choose where the job runs", region, destRegion)
+		}
+		region = destRegion
+	}
+	return region, nil
+}
+
 const (
 	defaultStartCloseTimeout = 10 * time.Minute
 	defaultMaxAttempts       = 1
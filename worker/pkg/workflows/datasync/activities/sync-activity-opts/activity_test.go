@@ -46,3 +46,51 @@ func Test_getSyncActivityOptionsFromJob(t *testing.T) {
 		})
 	}
 }
+
+func Test_getRegionFromConnections(t *testing.T) {
+	withRegion := func(region string) *mgmtv1alpha1.Connection {
+		return &mgmtv1alpha1.Connection{ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{Region: &region}}
+	}
+	noRegion := &mgmtv1alpha1.Connection{ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{}}
+
+	type testcase struct {
+		name         string
+		source       *mgmtv1alpha1.Connection
+		destinations []*mgmtv1alpha1.Connection
+		expected     string
+		expectErr    bool
+	}
+	tests := []testcase{
+		{name: "no regions", source: noRegion, destinations: []*mgmtv1alpha1.Connection{noRegion}, expected: ""},
+		{name: "no source", source: nil, destinations: []*mgmtv1alpha1.Connection{withRegion("eu-west-1")}, expected: "eu-west-1"},
+		{name: "source region wins", source: withRegion("us-east-1"), destinations: []*mgmtv1alpha1.Connection{withRegion("eu-west-1")}, expected: "us-east-1"},
+		{name: "destinations agree", source: noRegion, destinations: []*mgmtv1alpha1.Connection{withRegion("eu-west-1"), noRegion, withRegion("eu-west-1")}, expected: "eu-west-1"},
+		{name: "destinations conflict", source: noRegion, destinations: []*mgmtv1alpha1.Connection{withRegion("eu-west-1"), withRegion("us-east-1")}, expectErr: true},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s_%s", t.Name(), test.name), func(t *testing.T) {
+			output, err := getRegionFromConnections(test.source, test.destinations)
+			if test.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, output)
+		})
+	}
+}
+
+func Test_hasDestinationOption(t *testing.T) {
+	destinations := []*mgmtv1alpha1.JobDestination{
+		{Options: &mgmtv1alpha1.JobDestinationOptions{Config: &mgmtv1alpha1.JobDestinationOptions_AwsS3Options{}}},
+		{Options: &mgmtv1alpha1.JobDestinationOptions{Config: &mgmtv1alpha1.JobDestinationOptions_MysqlOptions{
+			MysqlOptions: &mgmtv1alpha1.MysqlDestinationConnectionOptions{
+				AnalyzeTables: &mgmtv1alpha1.MysqlAnalyzeTablesConfig{Enabled: true},
+			},
+		}}},
+	}
+	assert.True(t, hasDestinationOption(destinations, isAnalyzeTablesEnabled))
+	assert.False(t, hasDestinationOption(destinations, isDisableTriggersEnabled))
+	assert.False(t, hasDestinationOption(destinations, isRefreshMaterializedViewsEnabled))
+	assert.False(t, hasDestinationOption(nil, isAnalyzeTablesEnabled))
+}
@@ -9,18 +9,27 @@ import (
 	"time"
 
 	_ "github.com/benthosdev/benthos/v4/public/components/aws"
+	_ "github.com/benthosdev/benthos/v4/public/components/confluent"
 	_ "github.com/benthosdev/benthos/v4/public/components/io"
 	_ "github.com/benthosdev/benthos/v4/public/components/javascript"
+	_ "github.com/benthosdev/benthos/v4/public/components/kafka"
 	_ "github.com/benthosdev/benthos/v4/public/components/pure"
 	_ "github.com/benthosdev/benthos/v4/public/components/pure/extended"
 	_ "github.com/benthosdev/benthos/v4/public/components/redis"
 	_ "github.com/benthosdev/benthos/v4/public/components/sql"
 	"github.com/google/uuid"
+	neosync_benthos_aws "github.com/nucleuscloud/neosync/worker/internal/benthos/aws"
+	neosync_benthos_duckdb "github.com/nucleuscloud/neosync/worker/internal/benthos/duckdb"
 	neosync_benthos_error "github.com/nucleuscloud/neosync/worker/internal/benthos/error"
+	neosync_benthos_javascript "github.com/nucleuscloud/neosync/worker/internal/benthos/javascript"
 	benthos_metrics "github.com/nucleuscloud/neosync/worker/internal/benthos/metrics"
+	neosyncconnectiondata "github.com/nucleuscloud/neosync/worker/internal/benthos/neosync_connection_data"
 	openaigenerate "github.com/nucleuscloud/neosync/worker/internal/benthos/openai_generate"
+	neosync_benthos_plugin "github.com/nucleuscloud/neosync/worker/internal/benthos/plugin"
 	_ "github.com/nucleuscloud/neosync/worker/internal/benthos/redis"
+	neosync_benthos_rowcount "github.com/nucleuscloud/neosync/worker/internal/benthos/rowcount"
 	neosync_benthos_sql "github.com/nucleuscloud/neosync/worker/internal/benthos/sql"
+	synthesizegenerate "github.com/nucleuscloud/neosync/worker/internal/benthos/synthesize_generate"
 	_ "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers"
 	logger_utils "github.com/nucleuscloud/neosync/worker/internal/logger"
 	"go.opentelemetry.io/otel/metric"
@@ -29,6 +38,7 @@ import (
 	"github.com/benthosdev/benthos/v4/public/service"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/pkg/nonfinite"
 	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/sdk/activity"
@@ -46,28 +56,51 @@ type SyncRequest struct {
 	BenthosConfig string
 	BenthosDsns   []*shared.BenthosDsn
 }
-type SyncResponse struct{}
+type SyncResponse struct {
+	// Nil if row counting is turned off on the worker
+	RowCounts *TableRowCounts
+}
+
+// Sent to the workflow each time another batch of a table that is being masked in place has been updated
+const InPlaceMaskBatchSignalName = "in-place-mask-batch"
+
+type InPlaceMaskBatchSignal struct {
+	Schema string
+	Table  string
+	// The number of the table's batches that have been updated so far
+	CompletedBatches uint32
+}
 
 func New(
 	connclient mgmtv1alpha1connect.ConnectionServiceClient,
+	dataclient mgmtv1alpha1connect.ConnectionDataServiceClient,
 	tunnelmanagermap *sync.Map,
 	temporalclient client.Client,
 	meter metric.Meter,
 	benthosStreamManager BenthosStreamManagerClient,
+	credentialsprovider credentialsProvider,
+	rowCountPolicy RowCountPolicy,
+	nonFinitePolicy nonfinite.Policy,
+	javascriptLimits *neosync_benthos_javascript.Limits,
 ) *Activity {
-	return &Activity{connclient: connclient, tunnelmanagermap: tunnelmanagermap, temporalclient: temporalclient, meter: meter, benthosStreamManager: benthosStreamManager}
+	return &Activity{connclient: connclient, dataclient: dataclient, tunnelmanagermap: tunnelmanagermap, temporalclient: temporalclient, meter: meter, benthosStreamManager: benthosStreamManager, credentialsprovider: credentialsprovider, rowCountPolicy: rowCountPolicy, nonFinitePolicy: nonFinitePolicy, javascriptLimits: javascriptLimits}
 }
 
 type Activity struct {
 	connclient           mgmtv1alpha1connect.ConnectionServiceClient
+	dataclient           mgmtv1alpha1connect.ConnectionDataServiceClient
 	tunnelmanagermap     *sync.Map
 	temporalclient       client.Client
 	meter                metric.Meter // optional
 	benthosStreamManager BenthosStreamManagerClient
+	credentialsprovider  credentialsProvider // optional
+	rowCountPolicy       RowCountPolicy
+	nonFinitePolicy      nonfinite.Policy
+	javascriptLimits     *neosync_benthos_javascript.Limits // optional
 }
 
 func (a *Activity) getTunnelManagerByRunId(wfId, runId string) (*ConnectionTunnelManager, error) {
-	val, loaded := a.tunnelmanagermap.LoadOrStore(runId, NewConnectionTunnelManager(&defaultSqlProvider{}))
+	val, loaded := a.tunnelmanagermap.LoadOrStore(runId, NewConnectionTunnelManager(&defaultSqlProvider{}, a.credentialsprovider))
 	manager, ok := val.(*ConnectionTunnelManager)
 	if !ok {
 		return nil, fmt.Errorf("unable to retrieve connection tunnel manager from tunnel manager map. Expected *ConnectionTunnelManager, received: %T", manager)
@@ -90,6 +123,24 @@ func (a *Activity) getTunnelManagerByRunId(wfId, runId string) (*ConnectionTunne
 	return manager, nil
 }
 
+// Signals the workflow with the progress of each table that is being masked in place.
+// Progress is best effort, so a signal that fails is logged and the sync carries on
+func (a *Activity) getInPlaceMaskBatchReporter(ctx context.Context, info activity.Info, slogger *slog.Logger) neosync_benthos_sql.PageCompletedFunc {
+	if a.temporalclient == nil {
+		return nil
+	}
+	return func(schema, table string, completedPages uint32) {
+		err := a.temporalclient.SignalWorkflow(ctx, info.WorkflowExecution.ID, info.WorkflowExecution.RunID, InPlaceMaskBatchSignalName, &InPlaceMaskBatchSignal{
+			Schema:           schema,
+			Table:            table,
+			CompletedBatches: completedPages,
+		})
+		if err != nil {
+			slogger.Warn(fmt.Sprintf("unable to report in place mask progress for %s.%s: %s", schema, table, err.Error()))
+		}
+	}
+}
+
 // Temporal activity that runs benthos and syncs a source connection to one or more destination connections
 func (a *Activity) Sync(ctx context.Context, req *SyncRequest, metadata *SyncMetadata) (*SyncResponse, error) {
 	session := uuid.NewString()
@@ -213,11 +264,16 @@ func (a *Activity) Sync(ctx context.Context, req *SyncRequest, metadata *SyncMet
 
 	poolprovider := newPoolProvider(getPoolProviderGetter(tunnelmanager, &dsnToConnectionIdMap, connectionMap, session, slogger))
 
-	err = neosync_benthos_sql.RegisterPooledSqlInsertOutput(benthosenv, poolprovider, isRetry)
+	var rowTracker *neosync_benthos_rowcount.Tracker
+	if a.rowCountPolicy != RowCountPolicyOff {
+		rowTracker = neosync_benthos_rowcount.NewTracker()
+	}
+
+	err = neosync_benthos_sql.RegisterPooledSqlInsertOutput(benthosenv, poolprovider, isRetry, rowTracker, a.nonFinitePolicy)
 	if err != nil {
 		return nil, fmt.Errorf("unable to register pooled_sql_insert output to benthos instance: %w", err)
 	}
-	err = neosync_benthos_sql.RegisterPooledSqlUpdateOutput(benthosenv, poolprovider)
+	err = neosync_benthos_sql.RegisterPooledSqlUpdateOutput(benthosenv, poolprovider, rowTracker, a.nonFinitePolicy)
 	if err != nil {
 		return nil, err
 	}
@@ -225,6 +281,10 @@ func (a *Activity) Sync(ctx context.Context, req *SyncRequest, metadata *SyncMet
 	if err != nil {
 		return nil, fmt.Errorf("unable to register pooled_sql_raw input to benthos instance: %w", err)
 	}
+	err = neosync_benthos_sql.RegisterPooledSqlPagedInput(benthosenv, poolprovider, stopActivityChan, a.getInPlaceMaskBatchReporter(ctx, info, slogger))
+	if err != nil {
+		return nil, fmt.Errorf("unable to register pooled_sql_paged input to benthos instance: %w", err)
+	}
 	err = neosync_benthos_error.RegisterErrorProcessor(benthosenv, stopActivityChan)
 	if err != nil {
 		return nil, fmt.Errorf("unable to register error processor to benthos instance: %w", err)
@@ -237,6 +297,46 @@ func (a *Activity) Sync(ctx context.Context, req *SyncRequest, metadata *SyncMet
 	if err != nil {
 		return nil, fmt.Errorf("unable to register openai_generate input to benthos instance: %w", err)
 	}
+	err = synthesizegenerate.RegisterSynthesizeGenerate(benthosenv)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register synthesize_generate input to benthos instance: %w", err)
+	}
+	err = neosyncconnectiondata.RegisterNeosyncConnectionDataInput(benthosenv, a.dataclient)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register neosync_connection_data input to benthos instance: %w", err)
+	}
+	err = neosync_benthos_plugin.RegisterPluginInput(benthosenv)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register neosync_plugin input to benthos instance: %w", err)
+	}
+	err = neosync_benthos_plugin.RegisterPluginOutput(benthosenv, rowTracker)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register neosync_plugin output to benthos instance: %w", err)
+	}
+	err = neosync_benthos_duckdb.RegisterDuckdbOutput(benthosenv, rowTracker)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register neosync_duckdb output to benthos instance: %w", err)
+	}
+	err = neosync_benthos_aws.RegisterS3Output(benthosenv, rowTracker)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register neosync_aws_s3 output to benthos instance: %w", err)
+	}
+	err = neosync_benthos_aws.RegisterBinaryEncodeProcessor(benthosenv)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register neosync_binary_encode processor to benthos instance: %w", err)
+	}
+	err = neosync_benthos_aws.RegisterNonFiniteProcessor(benthosenv, a.nonFinitePolicy)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register neosync_non_finite processor to benthos instance: %w", err)
+	}
+	err = neosync_benthos_javascript.RegisterNeosyncJavascriptProcessor(benthosenv, a.javascriptLimits)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register neosync_javascript processor to benthos instance: %w", err)
+	}
+	err = neosync_benthos_rowcount.RegisterRowCountProcessor(benthosenv, rowTracker)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register neosync_row_count processor to benthos instance: %w", err)
+	}
 
 	envKeyMap := syncMapToStringMap(&envKeyDsnSyncMap)
 	envKeyMap["TEMPORAL_WORKFLOW_ID"] = info.WorkflowExecution.ID
@@ -255,6 +355,13 @@ func (a *Activity) Sync(ctx context.Context, req *SyncRequest, metadata *SyncMet
 	if err != nil {
 		return nil, fmt.Errorf("unable to convert benthos config to yaml for stream builder: %w", err)
 	}
+	if rowTracker != nil {
+		// rows are counted at the end of the pipeline so that rows dropped on purpose by table processors are not expected at the outputs
+		err = streambldr.AddProcessorYAML(`neosync_row_count: {}`)
+		if err != nil {
+			return nil, fmt.Errorf("unable to add row count processor to benthos stream: %w", err)
+		}
+	}
 
 	stream, err := a.benthosStreamManager.NewBenthosStreamFromBuilder(streambldr)
 	if err != nil {
@@ -286,8 +393,17 @@ func (a *Activity) Sync(ctx context.Context, req *SyncRequest, metadata *SyncMet
 	benthosStream = nil
 	benthosStreamMutex.Unlock()
 
+	rowCounts := getTableRowCounts(metadata, rowTracker, &dsnToConnectionIdMap)
+	if err := getRowCountDiscrepancyError(rowCounts); err != nil {
+		if a.rowCountPolicy != RowCountPolicyWarn {
+			logger.Error(err.Error())
+			return nil, err
+		}
+		logger.Warn(err.Error())
+	}
+
 	logger.Info("sync complete")
-	return &SyncResponse{}, nil
+	return &SyncResponse{RowCounts: rowCounts}, nil
 }
 
 func getPoolProviderGetter(
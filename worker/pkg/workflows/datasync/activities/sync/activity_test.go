@@ -1,6 +1,7 @@
 package sync_activity
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -9,6 +10,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/nucleuscloud/neosync/backend/pkg/nonfinite"
+	neosync_plugins "github.com/nucleuscloud/neosync/worker/pkg/plugins"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -21,7 +24,7 @@ func Test_Sync_Run_Success(t *testing.T) {
 	env := testSuite.NewTestActivityEnvironment()
 
 	benthosStreamManager := NewBenthosStreamManager()
-	activity := New(nil, &sync.Map{}, nil, nil, benthosStreamManager)
+	activity := New(nil, nil, &sync.Map{}, nil, nil, benthosStreamManager, nil, RowCountPolicyFail, nonfinite.PolicyPreserve, nil)
 
 	env.RegisterActivity(activity.Sync)
 
@@ -51,7 +54,7 @@ func Test_Sync_Run_Metrics_Success(t *testing.T) {
 	meterProvider := metricsdk.NewMeterProvider()
 	meter := meterProvider.Meter("test")
 	benthosStreamManager := NewBenthosStreamManager()
-	activity := New(nil, &sync.Map{}, nil, meter, benthosStreamManager)
+	activity := New(nil, nil, &sync.Map{}, nil, meter, benthosStreamManager, nil, RowCountPolicyFail, nonfinite.PolicyPreserve, nil)
 
 	env.RegisterActivity(activity.Sync)
 
@@ -81,7 +84,7 @@ func Test_Sync_Fake_Mutation_Success(t *testing.T) {
 	env := testSuite.NewTestActivityEnvironment()
 
 	benthosStreamManager := NewBenthosStreamManager()
-	activity := New(nil, &sync.Map{}, nil, nil, benthosStreamManager)
+	activity := New(nil, nil, &sync.Map{}, nil, nil, benthosStreamManager, nil, RowCountPolicyFail, nonfinite.PolicyPreserve, nil)
 	env.RegisterActivity(activity.Sync)
 
 	val, err := env.ExecuteActivity(activity.Sync, &SyncRequest{
@@ -113,7 +116,7 @@ func Test_Sync_Run_Success_Javascript(t *testing.T) {
 	env := testSuite.NewTestActivityEnvironment()
 
 	benthosStreamManager := NewBenthosStreamManager()
-	activity := New(nil, &sync.Map{}, nil, nil, benthosStreamManager)
+	activity := New(nil, nil, &sync.Map{}, nil, nil, benthosStreamManager, nil, RowCountPolicyFail, nonfinite.PolicyPreserve, nil)
 	env.RegisterActivity(activity.Sync)
 
 	tmpFile, err := os.CreateTemp("", "test")
@@ -169,7 +172,7 @@ func Test_Sync_Run_Success_MutataionAndJavascript(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestActivityEnvironment()
 	benthosStreamManager := NewBenthosStreamManager()
-	activity := New(nil, &sync.Map{}, nil, nil, benthosStreamManager)
+	activity := New(nil, nil, &sync.Map{}, nil, nil, benthosStreamManager, nil, RowCountPolicyFail, nonfinite.PolicyPreserve, nil)
 	env.RegisterActivity(activity.Sync)
 
 	tmpFile, err := os.CreateTemp("", "test")
@@ -228,7 +231,7 @@ func Test_Sync_Run_Processor_Error(t *testing.T) {
 	env := testSuite.NewTestActivityEnvironment()
 
 	benthosStreamManager := NewBenthosStreamManager()
-	activity := New(nil, &sync.Map{}, nil, nil, benthosStreamManager)
+	activity := New(nil, nil, &sync.Map{}, nil, nil, benthosStreamManager, nil, RowCountPolicyFail, nonfinite.PolicyPreserve, nil)
 
 	env.RegisterActivity(activity.Sync)
 
@@ -259,7 +262,7 @@ func Test_Sync_Run_Output_Error(t *testing.T) {
 
 	mockBenthosStreamManager := NewMockBenthosStreamManagerClient(t)
 	mockBenthosStream := NewMockBenthosStreamClient(t)
-	activity := New(nil, &sync.Map{}, nil, nil, mockBenthosStreamManager)
+	activity := New(nil, nil, &sync.Map{}, nil, nil, mockBenthosStreamManager, nil, RowCountPolicyFail, nonfinite.PolicyPreserve, nil)
 
 	env.RegisterActivity(activity.Sync)
 
@@ -310,7 +313,7 @@ output:
 	mockBenthosStream.On("Run", mock.Anything).After(5 * time.Second).Return(nil)
 	mockBenthosStream.On("StopWithin", mock.Anything).Return(nil)
 
-	activity := New(nil, &sync.Map{}, nil, nil, mockBenthosStreamManager)
+	activity := New(nil, nil, &sync.Map{}, nil, nil, mockBenthosStreamManager, nil, RowCountPolicyFail, nonfinite.PolicyPreserve, nil)
 	env.RegisterActivity(activity.Sync)
 
 	stopCh := make(chan struct{})
@@ -333,7 +336,7 @@ func Test_Sync_Run_ActivityWorkerStop(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestActivityEnvironment()
 	benthosStreamManager := NewBenthosStreamManager()
-	activity := New(nil, &sync.Map{}, nil, nil, benthosStreamManager)
+	activity := New(nil, nil, &sync.Map{}, nil, nil, benthosStreamManager, nil, RowCountPolicyFail, nonfinite.PolicyPreserve, nil)
 
 	env.RegisterActivity(activity.Sync)
 	stopCh := make(chan struct{})
@@ -385,7 +388,7 @@ output:
 	mockBenthosStream.On("Run", mock.Anything).Return(errors.New(errmsg))
 	mockBenthosStream.On("StopWithin", mock.Anything).Return(nil).Maybe()
 
-	activity := New(nil, &sync.Map{}, nil, nil, mockBenthosStreamManager)
+	activity := New(nil, nil, &sync.Map{}, nil, nil, mockBenthosStreamManager, nil, RowCountPolicyFail, nonfinite.PolicyPreserve, nil)
 
 	env.RegisterActivity(activity.Sync)
 	_, err := env.ExecuteActivity(activity.Sync, &SyncRequest{
@@ -395,6 +398,76 @@ output:
 	require.Contains(t, err.Error(), errmsg)
 }
 
+type discardSink struct{}
+
+func (d *discardSink) OpenWriter(ctx context.Context, req *neosync_plugins.TableRequest) (neosync_plugins.RowWriter, error) {
+	return d, nil
+}
+
+func (d *discardSink) Write(ctx context.Context, rows []map[string]any) error {
+	return nil
+}
+
+func (d *discardSink) Close(ctx context.Context) error {
+	return nil
+}
+
+// the output's processors drop every row before it is written
+func getDroppedRowsConfig(plugin string) string {
+	return strings.TrimSpace(fmt.Sprintf(`
+input:
+  generate:
+    count: 2
+    interval: ""
+    mapping: 'root = { "name": "nick" }'
+output:
+  label: ""
+  neosync_plugin:
+    plugin: %s
+    schema: public
+    table: test
+    columns:
+      - name
+  processors:
+    - mapping: 'root = deleted()'
+`, plugin))
+}
+
+func Test_Sync_Run_RowCountDiscrepancy_Fail(t *testing.T) {
+	neosync_plugins.RegisterSink("test-row-count-fail", &discardSink{})
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	activity := New(nil, nil, &sync.Map{}, nil, nil, NewBenthosStreamManager(), nil, RowCountPolicyFail, nonfinite.PolicyPreserve, nil)
+	env.RegisterActivity(activity.Sync)
+
+	_, err := env.ExecuteActivity(activity.Sync, &SyncRequest{
+		BenthosConfig: getDroppedRowsConfig("test-row-count-fail"),
+	}, &SyncMetadata{Schema: "public", Table: "test"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "row count discrepancy for public.test: read 2 rows but neosync_plugin wrote 0 and skipped 0 rows")
+}
+
+func Test_Sync_Run_RowCountDiscrepancy_Warn(t *testing.T) {
+	neosync_plugins.RegisterSink("test-row-count-warn", &discardSink{})
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	activity := New(nil, nil, &sync.Map{}, nil, nil, NewBenthosStreamManager(), nil, RowCountPolicyWarn, nonfinite.PolicyPreserve, nil)
+	env.RegisterActivity(activity.Sync)
+
+	val, err := env.ExecuteActivity(activity.Sync, &SyncRequest{
+		BenthosConfig: getDroppedRowsConfig("test-row-count-warn"),
+	}, &SyncMetadata{Schema: "public", Table: "test"})
+	require.NoError(t, err)
+	res := &SyncResponse{}
+	require.NoError(t, val.Get(res))
+	require.NotNil(t, res.RowCounts)
+	require.Equal(t, int64(2), res.RowCounts.RowsRead)
+	require.Equal(t, []*OutputRowCounts{{Output: "neosync_plugin", RowsWritten: 0}}, res.RowCounts.Outputs)
+	require.True(t, res.RowCounts.HasDiscrepancy())
+}
+
 func Test_getEnvVarLookupFn(t *testing.T) {
 	fn := getEnvVarLookupFn(nil)
 	assert.NotNil(t, fn)
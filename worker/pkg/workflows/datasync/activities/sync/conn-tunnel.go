@@ -1,6 +1,7 @@
 package sync_activity
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/pkg/dbcredentials"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
 	neosync_benthos_sql "github.com/nucleuscloud/neosync/worker/internal/benthos/sql"
 	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
@@ -36,13 +38,36 @@ type sqlProvider interface {
 	DbOpen(driver, dsn string, maxConnectionLimits *int32) (neosync_benthos_sql.SqlDbtx, error)
 }
 
-func NewConnectionTunnelManager(sqlprovider sqlProvider) *ConnectionTunnelManager {
+type credentialsProvider interface {
+	GetCredentials(ctx context.Context, cfg *mgmtv1alpha1.TemporaryCredentialsConfig, host string, port int32) (*dbcredentials.Credentials, error)
+	Revoke(ctx context.Context, creds *dbcredentials.Credentials) error
+}
+
+const (
+	// how long minting or revoking temporary credentials may take
+	credentialsTimeout = 30 * time.Second
+	// expiring credentials are replaced this long before they expire so that new connections can still be opened
+	credentialsRefreshWindow = 5 * time.Minute
+)
+
+// Temporary credentials along with the database endpoint they were minted for
+type temporaryCredentials struct {
+	creds *dbcredentials.Credentials
+	host  string
+	port  int32
+}
+
+// credentialsprovider is optional and only required for connections that are configured to use temporary credentials
+func NewConnectionTunnelManager(sqlprovider sqlProvider, credentialsprovider credentialsProvider) *ConnectionTunnelManager {
 	return &ConnectionTunnelManager{
-		connMap:        map[string]neosync_benthos_sql.SqlDbtx{},
-		sessionMap:     map[string]map[string]struct{}{},
-		connDetailsMap: map[string]*sqlconnect.ConnectionDetails{},
-		shutdown:       make(chan any),
-		sqlprovider:    sqlprovider,
+		connMap:             map[string]neosync_benthos_sql.SqlDbtx{},
+		connCredentialsMap:  map[string]*dbcredentials.Credentials{},
+		sessionMap:          map[string]map[string]struct{}{},
+		connDetailsMap:      map[string]*sqlconnect.ConnectionDetails{},
+		credentialsMap:      map[string]*temporaryCredentials{},
+		shutdown:            make(chan any),
+		sqlprovider:         sqlprovider,
+		credentialsprovider: credentialsprovider,
 	}
 }
 
@@ -51,10 +76,15 @@ func NewConnectionTunnelManager(sqlprovider sqlProvider) *ConnectionTunnelManage
 // however the saving grace here is in prod this is scoped to a single Run, which is typically limited to
 // its number of connections anyways
 type ConnectionTunnelManager struct {
-	sqlprovider sqlProvider
+	sqlprovider         sqlProvider
+	credentialsprovider credentialsProvider
 	// connection id to connection details
 	connDetailsMap map[string]*sqlconnect.ConnectionDetails
-	connDetailsMu  sync.RWMutex
+	// connection id to the temporary credentials its connection details were built with
+	credentialsMap map[string]*temporaryCredentials
+	// replaced temporary credentials that may still be in use by open connections, revoked on shutdown
+	retiredCredentials []*dbcredentials.Credentials
+	connDetailsMu      sync.RWMutex
 
 	// session to set of connection ids that it is using
 	sessionMap map[string]map[string]struct{}
@@ -62,7 +92,11 @@ type ConnectionTunnelManager struct {
 
 	// connection id to sql connection
 	connMap map[string]neosync_benthos_sql.SqlDbtx
-	connMu  sync.RWMutex
+	// connection id to the temporary credentials its sql connection was opened with
+	connCredentialsMap map[string]*dbcredentials.Credentials
+	// sql connections that were replaced after their credentials were refreshed, closed on shutdown
+	retiredConns []neosync_benthos_sql.SqlDbtx
+	connMu       sync.RWMutex
 
 	shutdown chan any
 }
@@ -78,7 +112,7 @@ func (c *ConnectionTunnelManager) GetConnectionString(
 	c.connDetailsMu.RLock()
 	loadedDetails, ok := c.connDetailsMap[connection.Id]
 
-	if ok {
+	if ok && !c.isCredentialsRefreshDue(connection.Id) {
 		c.bindSession(session, connection.Id)
 		c.connDetailsMu.RUnlock()
 		return loadedDetails.GeneralDbConnectConfig.String(), nil
@@ -89,6 +123,14 @@ func (c *ConnectionTunnelManager) GetConnectionString(
 
 	loadedDetails, ok = c.connDetailsMap[connection.Id]
 	if ok {
+		if c.isCredentialsRefreshDue(connection.Id) {
+			current := c.credentialsMap[connection.Id]
+			logger.Debug("refreshing temporary credentials before they expire")
+			err := c.applyTemporaryCredentials(connection, loadedDetails, current.host, current.port)
+			if err != nil {
+				return "", err
+			}
+		}
 		c.bindSession(session, connection.Id)
 		return loadedDetails.GeneralDbConnectConfig.String(), nil
 	}
@@ -97,6 +139,11 @@ func (c *ConnectionTunnelManager) GetConnectionString(
 	if err != nil {
 		return "", err
 	}
+	// minted before the tunnel is started as the credentials may be bound to the database's own endpoint
+	err = c.applyTemporaryCredentials(connection, details, details.GeneralDbConnectConfig.Host, details.GeneralDbConnectConfig.Port)
+	if err != nil {
+		return "", err
+	}
 	if details.Tunnel == nil {
 		c.bindSession(session, connection.Id)
 		c.connDetailsMap[connection.Id] = details
@@ -127,7 +174,7 @@ func (c *ConnectionTunnelManager) GetConnection(
 ) (neosync_benthos_sql.SqlDbtx, error) {
 	c.connMu.RLock()
 	existingDb, ok := c.connMap[connection.Id]
-	if ok {
+	if ok && !c.isConnectionStale(connection.Id) {
 		c.bindSession(session, connection.Id)
 		c.connMu.RUnlock()
 		return existingDb, nil
@@ -137,7 +184,7 @@ func (c *ConnectionTunnelManager) GetConnection(
 	defer c.connMu.Unlock()
 
 	existingDb, ok = c.connMap[connection.Id]
-	if ok {
+	if ok && !c.isConnectionStale(connection.Id) {
 		c.bindSession(session, connection.Id)
 		return existingDb, nil
 	}
@@ -146,6 +193,9 @@ func (c *ConnectionTunnelManager) GetConnection(
 	if err != nil {
 		return nil, err
 	}
+	if ok && !c.isConnectionStale(connection.Id) {
+		return existingDb, nil
+	}
 	driver, err := getDriverFromConnection(connection)
 	if err != nil {
 		return nil, err
@@ -160,7 +210,12 @@ func (c *ConnectionTunnelManager) GetConnection(
 	if err != nil {
 		return nil, err
 	}
+	if ok {
+		// the existing connection may still be in use by a running sync so it is only closed on shutdown
+		c.retiredConns = append(c.retiredConns, existingDb)
+	}
 	c.connMap[connection.Id] = dbconn
+	c.connCredentialsMap[connection.Id] = c.getCurrentCredentials(connection.Id)
 	c.bindSession(session, connection.Id)
 	return dbconn, nil
 }
@@ -225,7 +280,12 @@ func (c *ConnectionTunnelManager) hardClose() {
 	for connId, dbConn := range c.connMap {
 		dbConn.Close()
 		delete(c.connMap, connId)
+		delete(c.connCredentialsMap, connId)
 	}
+	for _, dbConn := range c.retiredConns {
+		dbConn.Close()
+	}
+	c.retiredConns = nil
 
 	for connId, details := range c.connDetailsMap {
 		if details.Tunnel != nil {
@@ -233,6 +293,12 @@ func (c *ConnectionTunnelManager) hardClose() {
 		}
 		delete(c.connDetailsMap, connId)
 	}
+	toRevoke := c.retiredCredentials
+	c.retiredCredentials = nil
+	for connId, tc := range c.credentialsMap {
+		toRevoke = append(toRevoke, tc.creds)
+		delete(c.credentialsMap, connId)
+	}
 
 	for sessionId := range c.sessionMap {
 		delete(c.sessionMap, sessionId)
@@ -240,6 +306,7 @@ func (c *ConnectionTunnelManager) hardClose() {
 	c.connMu.Unlock()
 	c.connDetailsMu.Unlock()
 	c.sessionMu.Unlock()
+	c.revokeCredentials(toRevoke)
 }
 
 func (c *ConnectionTunnelManager) close() {
@@ -250,6 +317,7 @@ func (c *ConnectionTunnelManager) close() {
 		if _, ok := sessionConnections[connId]; !ok {
 			dbConn.Close()
 			delete(c.connMap, connId)
+			delete(c.connCredentialsMap, connId)
 		}
 	}
 	c.sessionMu.Unlock()
@@ -258,16 +326,91 @@ func (c *ConnectionTunnelManager) close() {
 	c.connDetailsMu.Lock()
 	c.sessionMu.Lock()
 	sessionConnections = getUniqueConnectionIdsFromSessions(c.sessionMap)
+	toRevoke := []*dbcredentials.Credentials{}
 	for connId, details := range c.connDetailsMap {
 		if _, ok := sessionConnections[connId]; !ok {
 			if details.Tunnel != nil {
 				details.Tunnel.Close()
 			}
 			delete(c.connDetailsMap, connId)
+			if tc, ok := c.credentialsMap[connId]; ok {
+				toRevoke = append(toRevoke, tc.creds)
+				delete(c.credentialsMap, connId)
+			}
 		}
 	}
 	c.sessionMu.Unlock()
 	c.connDetailsMu.Unlock()
+	c.revokeCredentials(toRevoke)
+}
+
+// Replaces the stored credentials in the connection details with freshly minted temporary credentials if the connection is configured to use them.
+// Must be called while holding the connection details write lock.
+func (c *ConnectionTunnelManager) applyTemporaryCredentials(
+	connection *mgmtv1alpha1.Connection,
+	details *sqlconnect.ConnectionDetails,
+	host string,
+	port int32,
+) error {
+	cfg := dbcredentials.GetTemporaryCredentialsConfig(connection)
+	if cfg == nil {
+		return nil
+	}
+	if c.credentialsprovider == nil {
+		return errors.New("connection is configured to use temporary credentials but the worker does not have a credentials provider")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), credentialsTimeout)
+	defer cancel()
+	creds, err := c.credentialsprovider.GetCredentials(ctx, cfg, host, port)
+	if err != nil {
+		return fmt.Errorf("unable to mint temporary credentials: %w", err)
+	}
+	if existing, ok := c.credentialsMap[connection.Id]; ok {
+		c.retiredCredentials = append(c.retiredCredentials, existing.creds)
+	}
+	c.credentialsMap[connection.Id] = &temporaryCredentials{creds: creds, host: host, port: port}
+	details.GeneralDbConnectConfig.User = creds.User
+	details.GeneralDbConnectConfig.Pass = creds.Pass
+	return nil
+}
+
+// Must be called while holding the connection details lock
+func (c *ConnectionTunnelManager) isCredentialsRefreshDue(connectionId string) bool {
+	tc, ok := c.credentialsMap[connectionId]
+	return ok && tc.creds.ExpiresAt != nil && time.Until(*tc.creds.ExpiresAt) < credentialsRefreshWindow
+}
+
+func (c *ConnectionTunnelManager) getCurrentCredentials(connectionId string) *dbcredentials.Credentials {
+	c.connDetailsMu.RLock()
+	defer c.connDetailsMu.RUnlock()
+	if tc, ok := c.credentialsMap[connectionId]; ok {
+		return tc.creds
+	}
+	return nil
+}
+
+// Returns true if the sql connection was opened with temporary credentials that have since been, or are due to be, replaced.
+// Must be called while holding the connection lock
+func (c *ConnectionTunnelManager) isConnectionStale(connectionId string) bool {
+	c.connDetailsMu.RLock()
+	defer c.connDetailsMu.RUnlock()
+	tc, ok := c.credentialsMap[connectionId]
+	if !ok {
+		return false
+	}
+	return c.connCredentialsMap[connectionId] != tc.creds || c.isCredentialsRefreshDue(connectionId)
+}
+
+func (c *ConnectionTunnelManager) revokeCredentials(creds []*dbcredentials.Credentials) {
+	if c.credentialsprovider == nil {
+		return
+	}
+	for _, cred := range creds {
+		ctx, cancel := context.WithTimeout(context.Background(), credentialsTimeout)
+		// credentials that fail to be revoked still expire at the end of their lease
+		_ = c.credentialsprovider.Revoke(ctx, cred)
+		cancel()
+	}
 }
 
 func getUniqueConnectionIdsFromSessions(sessionMap map[string]map[string]struct{}) map[string]struct{} {
@@ -0,0 +1,123 @@
+package sync_activity
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	neosync_benthos_rowcount "github.com/nucleuscloud/neosync/worker/internal/benthos/rowcount"
+)
+
+// Determines what a sync does when the rows an output wrote do not account for the rows that were read
+type RowCountPolicy string
+
+const (
+	// Fails the sync activity
+	RowCountPolicyFail RowCountPolicy = "fail"
+	// Logs the discrepancy and lets the sync complete. The discrepancy is returned with the job run's row counts
+	RowCountPolicyWarn RowCountPolicy = "warn"
+	// Rows are not counted
+	RowCountPolicyOff RowCountPolicy = "off"
+)
+
+func ParseRowCountPolicy(input string) (RowCountPolicy, error) {
+	switch policy := RowCountPolicy(strings.ToLower(input)); policy {
+	case RowCountPolicyFail, RowCountPolicyWarn, RowCountPolicyOff:
+		return policy, nil
+	case "":
+		return RowCountPolicyFail, nil
+	default:
+		return "", fmt.Errorf("unknown row count policy %q, must be one of fail, warn or off", input)
+	}
+}
+
+// Compares the rows that were read for a table with the rows that each of its outputs wrote
+type TableRowCounts struct {
+	Schema string
+	Table  string
+	// The rows that were handed to the outputs, after any table processors have run
+	RowsRead int64
+	Outputs  []*OutputRowCounts
+}
+
+type OutputRowCounts struct {
+	// The benthos output that wrote the rows, e.g. pooled_sql_insert
+	Output string
+	// Empty if the output does not write to a connection that is resolved by dsn, such as AWS S3
+	ConnectionId string
+	RowsWritten  int64
+	// Rows that were intentionally not written, such as conflicting rows when on conflict do nothing is set
+	RowsSkipped int64
+}
+
+// Returns true if the written and skipped rows do not add up to the rows that were read
+func (o *OutputRowCounts) HasDiscrepancy(rowsRead int64) bool {
+	return o.RowsWritten+o.RowsSkipped != rowsRead
+}
+
+// Returns true if any of the table's outputs did not account for every row that was read
+func (t *TableRowCounts) HasDiscrepancy() bool {
+	for _, output := range t.Outputs {
+		if output.HasDiscrepancy(t.RowsRead) {
+			return true
+		}
+	}
+	return false
+}
+
+func getTableRowCounts(
+	metadata *SyncMetadata,
+	tracker *neosync_benthos_rowcount.Tracker,
+	dsnToConnectionIdMap *sync.Map,
+) *TableRowCounts {
+	if tracker == nil {
+		return nil
+	}
+	counts := &TableRowCounts{
+		Schema:   metadata.Schema,
+		Table:    metadata.Table,
+		RowsRead: tracker.Read(),
+		Outputs:  []*OutputRowCounts{},
+	}
+	for _, output := range tracker.Outputs() {
+		// the dsn is never returned as it may contain credentials
+		var connectionId string
+		if output.Dsn != "" {
+			if connid, ok := dsnToConnectionIdMap.Load(output.Dsn); ok {
+				connectionId, _ = connid.(string)
+			}
+		}
+		counts.Outputs = append(counts.Outputs, &OutputRowCounts{
+			Output:       output.Output,
+			ConnectionId: connectionId,
+			RowsWritten:  output.Written,
+			RowsSkipped:  output.Skipped,
+		})
+	}
+	return counts
+}
+
+// Returns an error describing every output whose rows do not account for the rows that were read
+func getRowCountDiscrepancyError(counts *TableRowCounts) error {
+	if counts == nil || !counts.HasDiscrepancy() {
+		return nil
+	}
+	discrepancies := []string{}
+	for _, output := range counts.Outputs {
+		if !output.HasDiscrepancy(counts.RowsRead) {
+			continue
+		}
+		destination := output.Output
+		if output.ConnectionId != "" {
+			destination = fmt.Sprintf("%s (connection %s)", output.Output, output.ConnectionId)
+		}
+		discrepancies = append(discrepancies, fmt.Sprintf(
+			"%s wrote %d and skipped %d rows",
+			destination, output.RowsWritten, output.RowsSkipped,
+		))
+	}
+	return fmt.Errorf(
+		"row count discrepancy for %s.%s: read %d rows but %s",
+		counts.Schema, counts.Table, counts.RowsRead, strings.Join(discrepancies, ", "),
+	)
+}
@@ -7,7 +7,15 @@ import (
 	"sync"
 	"time"
 
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/worker/internal/openlineage"
+	analyzetables_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/analyze-tables"
+	computedataquality_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/compute-data-quality"
+	desttriggers_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/destination-triggers"
+	emitrunevent_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/emit-run-event"
 	genbenthosconfigs_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/gen-benthos-configs"
+	refreshmatviews_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/refresh-materialized-views"
+	restoresnapshot_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/restore-snapshot"
 	runsqlinittablestmts_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/run-sql-init-table-stmts"
 	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 	sync_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync"
@@ -24,9 +32,45 @@ type WorkflowRequest struct {
 	JobId string
 }
 
-type WorkflowResponse struct{}
+type WorkflowResponse struct {
+	// Nil if the data quality step did not run or was unable to complete
+	DataQuality *computedataquality_activity.ComputeDataQualityResponse
+	// The rows read and written by each sync. Empty if row counting is turned off on the worker
+	RowCounts []*sync_activity.TableRowCounts
+	// The instance restored for snapshot clone jobs. Nil for all other jobs
+	SnapshotClone *restoresnapshot_activity.RestoreSnapshotResponse
+}
+
+// Sent to batch runs to ask them to make room for an interactive run
+const PreemptSignalName = "preempt"
+
+// Query that returns the batches that were planned and completed for each table of an in place mask run
+const InPlaceMaskProgressQueryName = "in-place-mask-progress"
 
-func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse, error) {
+type PreemptSignal struct {
+	// How long the run holds off on starting new tables. Tables that are already syncing are left to finish
+	YieldFor time.Duration
+}
+
+// Steps that were added to the workflow after runs may already have been in flight are gated by a version
+// so that those runs still replay without them
+const (
+	runEventsChangeId                = "emit-run-events"
+	restoreSnapshotChangeId          = "restore-snapshot"
+	preemptionChangeId               = "preempt-signal"
+	destinationTriggersChangeId      = "destination-triggers"
+	analyzeTablesChangeId            = "analyze-tables"
+	refreshMaterializedViewsChangeId = "refresh-materialized-views"
+	dataQualityChangeId              = "compute-data-quality"
+)
+
+// Optional steps that are turned on by the worker's configuration rather than by the job
+type workerSteps struct {
+	EmitRunEvents      bool
+	ComputeDataQuality bool
+}
+
+func Workflow(wfctx workflow.Context, req *WorkflowRequest) (wfResp *WorkflowResponse, wfErr error) {
 	wfinfo := workflow.GetInfo(wfctx)
 
 	ctx := workflow.WithActivityOptions(wfctx, workflow.ActivityOptions{
@@ -46,6 +90,31 @@ func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse,
 		RunId:      wfinfo.WorkflowExecution.RunID,
 	}
 
+	isRunEventsVersion := isStepVersioned(wfctx, runEventsChangeId)
+	isDataQualityVersion := isStepVersioned(wfctx, dataQualityChangeId)
+	steps := &workerSteps{}
+	if isRunEventsVersion || isDataQualityVersion {
+		var err error
+		steps, err = getWorkerSteps(wfctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if isRunEventsVersion && steps.EmitRunEvents {
+		runEmitRunEventActivity(wfctx, logger, &emitrunevent_activity.EmitRunEventRequest{
+			JobId:     req.JobId,
+			EventType: openlineage.EventTypeStart,
+			EventTime: workflow.Now(wfctx),
+		})
+		defer func() {
+			// the outcome is reported on a disconnected context so that cancelled runs are still reported
+			disconnectedCtx, cancel := workflow.NewDisconnectedContext(wfctx)
+			defer cancel()
+			runEmitRunEventActivity(disconnectedCtx, logger, getRunOutcome(req.JobId, workflow.Now(disconnectedCtx), wfResp, wfErr))
+		}()
+	}
+
 	var bcResp *genbenthosconfigs_activity.GenerateBenthosConfigsResponse
 	logger.Info("scheduling GenerateBenthosConfigs for execution.")
 	var genbenthosactivity *genbenthosconfigs_activity.Activity
@@ -56,9 +125,59 @@ func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse,
 		return nil, err
 	}
 
+	var snapshotClone *restoresnapshot_activity.RestoreSnapshotResponse
+	if isStepVersioned(wfctx, restoreSnapshotChangeId) && bcResp.RequiresSnapshotRestore {
+		instanceId := restoresnapshot_activity.GetRestoredInstanceId(wfinfo.WorkflowExecution.ID)
+		defer func() {
+			if wfErr == nil || (snapshotClone != nil && snapshotClone.RetainOnFailure) {
+				return
+			}
+			// the restored instance is deleted on a disconnected context so that cancelled runs do not leave it running
+			disconnectedCtx, cancel := workflow.NewDisconnectedContext(wfctx)
+			defer cancel()
+			deleteReq := &restoresnapshot_activity.DeleteRestoredSnapshotRequest{JobId: req.JobId, InstanceId: instanceId}
+			if snapshotClone != nil {
+				deleteReq.ConnectionId = snapshotClone.ConnectionId
+			}
+			if err := runDeleteRestoredSnapshotActivity(disconnectedCtx, logger, deleteReq); err != nil {
+				logger.Error("unable to delete restored snapshot instance", "instanceId", instanceId, "err", err)
+			}
+		}()
+		snapshotClone, err = runRestoreSnapshotActivity(wfctx, logger, req.JobId, instanceId)
+		if err != nil {
+			return nil, err
+		}
+
+		logger.Info("scheduling GenerateBenthosConfigs for the restored snapshot.")
+		err = workflow.ExecuteActivity(ctx, genbenthosactivity.GenerateBenthosConfigs, &genbenthosconfigs_activity.GenerateBenthosConfigsRequest{
+			JobId:                req.JobId,
+			RestoredConnectionId: snapshotClone.ConnectionId,
+		}).Get(ctx, &bcResp)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if bcResp.InPlaceMask != nil {
+		err = workflow.SetQueryHandler(wfctx, InPlaceMaskProgressQueryName, func() (*genbenthosconfigs_activity.InPlaceMaskPlan, error) {
+			return bcResp.InPlaceMask, nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to register in place mask progress query handler: %w", err)
+		}
+		batchCh := workflow.GetSignalChannel(wfctx, sync_activity.InPlaceMaskBatchSignalName)
+		workflow.Go(wfctx, func(ctx workflow.Context) {
+			for {
+				var signal sync_activity.InPlaceMaskBatchSignal
+				batchCh.Receive(ctx, &signal)
+				bcResp.InPlaceMask.SetCompletedBatches(signal.Schema, signal.Table, signal.CompletedBatches)
+			}
+		})
+	}
+
 	if len(bcResp.BenthosConfigs) == 0 {
 		logger.Info("found 0 benthos configs, ending workflow.")
-		return &WorkflowResponse{}, nil
+		return &WorkflowResponse{SnapshotClone: snapshotClone}, nil
 	}
 
 	splitConfigs := splitBenthosConfigs(bcResp.BenthosConfigs)
@@ -80,10 +199,27 @@ func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse,
 	}
 	logger.Info("completed RetrieveActivityOptions.")
 
+	var yieldUntil time.Time
+	if isStepVersioned(wfctx, preemptionChangeId) && actOptResp.Priority != mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_INTERACTIVE {
+		preemptCh := workflow.GetSignalChannel(wfctx, PreemptSignalName)
+		workflow.Go(wfctx, func(ctx workflow.Context) {
+			for {
+				var signal PreemptSignal
+				preemptCh.Receive(ctx, &signal)
+				until := workflow.Now(ctx).Add(signal.YieldFor)
+				if until.After(yieldUntil) {
+					yieldUntil = until
+				}
+				logger.Info("run was preempted by an interactive run", "yieldUntil", yieldUntil)
+			}
+		})
+	}
+
 	ctx = workflow.WithActivityOptions(wfctx, *actOptResp.SyncActivityOptions)
 	logger.Info("scheduling RunSqlInitTableStatements for execution.")
 	var resp *runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse
-	err = workflow.ExecuteActivity(ctx, runsqlinittablestmts_activity.RunSqlInitTableStatements, &runsqlinittablestmts_activity.RunSqlInitTableStatementsRequest{
+	var initTableStmtsActivity *runsqlinittablestmts_activity.Activity
+	err = workflow.ExecuteActivity(ctx, initTableStmtsActivity.RunSqlInitTableStatements, &runsqlinittablestmts_activity.RunSqlInitTableStatementsRequest{
 		JobId:      req.JobId,
 		WorkflowId: wfinfo.WorkflowExecution.ID,
 	}).Get(ctx, &resp)
@@ -92,8 +228,34 @@ func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse,
 	}
 	logger.Info("completed RunSqlInitTableStatements.")
 
+	disabledTriggers := &desttriggers_activity.DisableDestinationTriggersResponse{}
+	if isStepVersioned(wfctx, destinationTriggersChangeId) && actOptResp.DisableDestinationTriggers {
+		logger.Info("scheduling DisableDestinationTriggers for execution.")
+		var triggersActivity *desttriggers_activity.Activity
+		err = workflow.ExecuteActivity(ctx, triggersActivity.DisableDestinationTriggers, &desttriggers_activity.DisableDestinationTriggersRequest{
+			JobId: req.JobId,
+		}).Get(ctx, &disabledTriggers)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info("completed DisableDestinationTriggers.")
+	}
+	triggersEnabled := len(disabledTriggers.Destinations) == 0
+	defer func() {
+		if triggersEnabled {
+			return
+		}
+		// the workflow is exiting early, so triggers are re-enabled on a disconnected context to ensure this runs even if the workflow was cancelled
+		disconnectedCtx, cancel := workflow.NewDisconnectedContext(wfctx)
+		defer cancel()
+		if err := runEnableDestinationTriggersActivity(disconnectedCtx, logger, req.JobId, disabledTriggers.Destinations); err != nil {
+			logger.Error("unable to re-enable destination triggers", "err", err)
+		}
+	}()
+
 	started := sync.Map{}
 	completed := sync.Map{}
+	var rowCounts []*sync_activity.TableRowCounts
 
 	redisDependsOn := map[string]map[string][]string{} // schema.table -> dependson
 	redisConfigs := map[string]*genbenthosconfigs_activity.BenthosRedisConfig{}
@@ -115,6 +277,9 @@ func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse,
 	for _, bc := range splitConfigs.Root {
 		bc := bc
 		logger := log.With(logger, withBenthosConfigResponseLoggerTags(bc)...)
+		if err := yieldIfPreempted(ctx, &yieldUntil, logger); err != nil {
+			return nil, err
+		}
 		future := invokeSync(bc, childctx, &started, &completed, logger)
 		workselector.AddFuture(future, func(f workflow.Future) {
 			logger.Info("config sync completed")
@@ -129,6 +294,11 @@ func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse,
 				logger.Error("sync activity did not complete", "err", err)
 				cancelHandler()
 				activityErr = err
+			} else {
+				bcResp.InPlaceMask.CompleteTable(bc.TableSchema, bc.TableName)
+				if result.RowCounts != nil {
+					rowCounts = append(rowCounts, result.RowCounts)
+				}
 			}
 			delete(redisDependsOn, fmt.Sprintf("%s.%s", bc.TableSchema, bc.TableName))
 			// clean up redis
@@ -164,6 +334,9 @@ func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse,
 				continue
 			}
 			logger := log.With(logger, withBenthosConfigResponseLoggerTags(bc)...)
+			if err := yieldIfPreempted(ctx, &yieldUntil, logger); err != nil {
+				return nil, err
+			}
 			future := invokeSync(bc, childctx, &started, &completed, logger)
 			workselector.AddFuture(future, func(f workflow.Future) {
 				var result sync_activity.SyncResponse
@@ -176,6 +349,11 @@ func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse,
 					}
 					cancelHandler()
 					activityErr = err
+				} else {
+					bcResp.InPlaceMask.CompleteTable(bc.TableSchema, bc.TableName)
+					if result.RowCounts != nil {
+						rowCounts = append(rowCounts, result.RowCounts)
+					}
 				}
 				logger.Info("config sync completed", "name", bc.Name)
 				delete(redisDependsOn, fmt.Sprintf("%s.%s", bc.TableSchema, bc.TableName))
@@ -187,8 +365,274 @@ func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse,
 			})
 		}
 	}
+
+	if !triggersEnabled {
+		err = runEnableDestinationTriggersActivity(wfctx, logger, req.JobId, disabledTriggers.Destinations)
+		if err != nil {
+			return nil, err
+		}
+		triggersEnabled = true
+	}
+
+	// statistics are refreshed first so that the materialized view queries are planned against the loaded data
+	if isStepVersioned(wfctx, analyzeTablesChangeId) && actOptResp.AnalyzeDestinationTables {
+		err = runAnalyzeTablesActivity(wfctx, logger, actOptResp, req.JobId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if isStepVersioned(wfctx, refreshMaterializedViewsChangeId) && actOptResp.RefreshMaterializedViews {
+		err = runRefreshMaterializedViewsActivity(wfctx, logger, actOptResp, req.JobId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var dataQuality *computedataquality_activity.ComputeDataQualityResponse
+	if isDataQualityVersion && steps.ComputeDataQuality {
+		dataQuality = runDataQualityActivity(wfctx, logger, req.JobId, bcResp.BenthosConfigs)
+	}
 	logger.Info("data sync workflow completed")
-	return &WorkflowResponse{}, nil
+	return &WorkflowResponse{DataQuality: dataQuality, RowCounts: rowCounts, SnapshotClone: snapshotClone}, nil
+}
+
+func isStepVersioned(ctx workflow.Context, changeId string) bool {
+	return workflow.GetVersion(ctx, changeId, workflow.DefaultVersion, 1) == 1
+}
+
+// The worker's configuration is read through a side effect so that replays see the steps the run started with,
+// even if the worker has since been reconfigured
+func getWorkerSteps(ctx workflow.Context) (*workerSteps, error) {
+	var steps *workerSteps
+	err := workflow.SideEffect(ctx, func(ctx workflow.Context) any {
+		return &workerSteps{
+			EmitRunEvents:      shared.IsRunEventReportingEnabled(),
+			ComputeDataQuality: shared.IsDataQualityScoringEnabled(),
+		}
+	}).Get(&steps)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read worker steps: %w", err)
+	}
+	return steps, nil
+}
+
+// Restoring a large snapshot can take hours, so the activity is given a long timeout and relies on its heartbeat to detect a lost worker
+func runRestoreSnapshotActivity(
+	wfctx workflow.Context,
+	logger log.Logger,
+	jobId string,
+	instanceId string,
+) (*restoresnapshot_activity.RestoreSnapshotResponse, error) {
+	ctx := workflow.WithActivityOptions(wfctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 6 * time.Hour,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 1,
+		},
+		HeartbeatTimeout: 1 * time.Minute,
+	})
+	logger.Info("scheduling RestoreSnapshot for execution.", "instanceId", instanceId)
+	var resp *restoresnapshot_activity.RestoreSnapshotResponse
+	var activity *restoresnapshot_activity.Activity
+	err := workflow.ExecuteActivity(ctx, activity.RestoreSnapshot, &restoresnapshot_activity.RestoreSnapshotRequest{
+		JobId:      jobId,
+		InstanceId: instanceId,
+	}).Get(ctx, &resp)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("completed RestoreSnapshot.", "instanceId", instanceId, "connectionId", resp.ConnectionId)
+	return resp, nil
+}
+
+func runDeleteRestoredSnapshotActivity(
+	wfctx workflow.Context,
+	logger log.Logger,
+	req *restoresnapshot_activity.DeleteRestoredSnapshotRequest,
+) error {
+	ctx := workflow.WithActivityOptions(wfctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 1 * time.Hour,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 3,
+		},
+		HeartbeatTimeout: 1 * time.Minute,
+	})
+	logger.Info("scheduling DeleteRestoredSnapshot for execution.", "instanceId", req.InstanceId)
+	var activity *restoresnapshot_activity.Activity
+	err := workflow.ExecuteActivity(ctx, activity.DeleteRestoredSnapshot, req).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+	logger.Info("completed DeleteRestoredSnapshot.", "instanceId", req.InstanceId)
+	return nil
+}
+
+// Reports the state of the run to the OpenLineage backend and the Datadog or Splunk forwarders. Failures are logged and do not fail the run.
+func runEmitRunEventActivity(
+	wfctx workflow.Context,
+	logger log.Logger,
+	req *emitrunevent_activity.EmitRunEventRequest,
+) {
+	ctx := workflow.WithActivityOptions(wfctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 1 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 3,
+		},
+	})
+	logger.Info("scheduling EmitRunEvent for execution.", "eventType", req.EventType)
+	var activity *emitrunevent_activity.Activity
+	err := workflow.ExecuteActivity(ctx, activity.EmitRunEvent, req).Get(ctx, nil)
+	if err != nil {
+		logger.Warn("unable to emit run event", "eventType", req.EventType, "err", err)
+		return
+	}
+	logger.Info("completed EmitRunEvent.", "eventType", req.EventType)
+}
+
+func getRunOutcome(
+	jobId string,
+	eventTime time.Time,
+	resp *WorkflowResponse,
+	err error,
+) *emitrunevent_activity.EmitRunEventRequest {
+	req := &emitrunevent_activity.EmitRunEventRequest{
+		JobId:     jobId,
+		EventType: openlineage.EventTypeComplete,
+		EventTime: eventTime,
+	}
+	switch {
+	case temporal.IsCanceledError(err):
+		req.EventType = openlineage.EventTypeAbort
+	case err != nil:
+		req.EventType = openlineage.EventTypeFail
+		req.ErrorMessage = err.Error()
+	case resp != nil:
+		req.RowCounts = resp.RowCounts
+	}
+	return req
+}
+
+// Table boundaries are the only points a run can be preempted at as a table's sync can not be paused part way through.
+// Further signals received while waiting extend the wait.
+func yieldIfPreempted(ctx workflow.Context, yieldUntil *time.Time, logger log.Logger) error {
+	for {
+		remaining := yieldUntil.Sub(workflow.Now(ctx))
+		if remaining <= 0 {
+			return nil
+		}
+		logger.Info("run is preempted, waiting to start the next table", "remaining", remaining)
+		if err := workflow.Sleep(ctx, remaining); err != nil {
+			return err
+		}
+	}
+}
+
+func runEnableDestinationTriggersActivity(
+	wfctx workflow.Context,
+	logger log.Logger,
+	jobId string,
+	destinations []*desttriggers_activity.DestinationTriggers,
+) error {
+	ctx := workflow.WithActivityOptions(wfctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 5 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 3,
+		},
+		HeartbeatTimeout: 1 * time.Minute,
+	})
+	logger.Info("scheduling EnableDestinationTriggers for execution.")
+	var activity *desttriggers_activity.Activity
+	err := workflow.ExecuteActivity(ctx, activity.EnableDestinationTriggers, &desttriggers_activity.EnableDestinationTriggersRequest{
+		JobId:        jobId,
+		Destinations: destinations,
+	}).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+	logger.Info("completed EnableDestinationTriggers.")
+	return nil
+}
+
+// Refreshes the table statistics of any sql destinations that have opted in
+func runAnalyzeTablesActivity(
+	wfctx workflow.Context,
+	logger log.Logger,
+	actOptResp *syncactivityopts_activity.RetrieveActivityOptionsResponse,
+	jobId string,
+) error {
+	ctx := workflow.WithActivityOptions(wfctx, *actOptResp.SyncActivityOptions)
+	logger.Info("scheduling AnalyzeTables for execution.")
+	var resp *analyzetables_activity.AnalyzeTablesResponse
+	var activity *analyzetables_activity.Activity
+	err := workflow.ExecuteActivity(ctx, activity.AnalyzeTables, &analyzetables_activity.AnalyzeTablesRequest{
+		JobId: jobId,
+	}).Get(ctx, &resp)
+	if err != nil {
+		return err
+	}
+	logger.Info("completed AnalyzeTables.", "tables", resp.AnalyzedTables)
+	return nil
+}
+
+// Refreshes the materialized views of any postgres destinations that have opted in so they reflect the newly synced data
+func runRefreshMaterializedViewsActivity(
+	wfctx workflow.Context,
+	logger log.Logger,
+	actOptResp *syncactivityopts_activity.RetrieveActivityOptionsResponse,
+	jobId string,
+) error {
+	ctx := workflow.WithActivityOptions(wfctx, *actOptResp.SyncActivityOptions)
+	logger.Info("scheduling RefreshMaterializedViews for execution.")
+	var resp *refreshmatviews_activity.RefreshMaterializedViewsResponse
+	var activity *refreshmatviews_activity.Activity
+	err := workflow.ExecuteActivity(ctx, activity.RefreshMaterializedViews, &refreshmatviews_activity.RefreshMaterializedViewsRequest{
+		JobId: jobId,
+	}).Get(ctx, &resp)
+	if err != nil {
+		return err
+	}
+	logger.Info("completed RefreshMaterializedViews.", "views", resp.RefreshedViews)
+	return nil
+}
+
+// Scores the synced data against the source. Failures are logged and do not fail the run.
+func runDataQualityActivity(
+	wfctx workflow.Context,
+	logger log.Logger,
+	jobId string,
+	configs []*genbenthosconfigs_activity.BenthosConfigResponse,
+) *computedataquality_activity.ComputeDataQualityResponse {
+	ctx := workflow.WithActivityOptions(wfctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 1,
+		},
+		HeartbeatTimeout: 1 * time.Minute,
+	})
+	logger.Info("scheduling ComputeDataQuality for execution.")
+	var resp *computedataquality_activity.ComputeDataQualityResponse
+	var activity *computedataquality_activity.Activity
+	err := workflow.ExecuteActivity(ctx, activity.ComputeDataQuality, &computedataquality_activity.ComputeDataQualityRequest{
+		JobId:  jobId,
+		Tables: getSyncedTables(configs),
+	}).Get(ctx, &resp)
+	if err != nil {
+		logger.Warn("unable to compute data quality", "err", err)
+		return nil
+	}
+	logger.Info("completed ComputeDataQuality.")
+	return resp
+}
+
+func getSyncedTables(configs []*genbenthosconfigs_activity.BenthosConfigResponse) []string {
+	tables := []string{}
+	for _, cfg := range configs {
+		table := fmt.Sprintf("%s.%s", cfg.TableSchema, cfg.TableName)
+		if !slices.Contains(tables, table) {
+			tables = append(tables, table)
+		}
+	}
+	return tables
 }
 
 func runRedisCleanUpActivity(
@@ -7,13 +7,23 @@ import (
 	"testing"
 	"time"
 
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
 	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
 	neosync_benthos "github.com/nucleuscloud/neosync/worker/internal/benthos"
+	"github.com/nucleuscloud/neosync/worker/internal/openlineage"
+	analyzetables_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/analyze-tables"
+	computedataquality_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/compute-data-quality"
+	desttriggers_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/destination-triggers"
+	emitrunevent_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/emit-run-event"
 	genbenthosconfigs_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/gen-benthos-configs"
+	refreshmatviews_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/refresh-materialized-views"
+	restoresnapshot_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/restore-snapshot"
 	runsqlinittablestmts_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/run-sql-init-table-stmts"
 	sync_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync"
 	syncactivityopts_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync-activity-opts"
 	syncrediscleanup_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync-redis-clean-up"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.temporal.io/sdk/temporal"
@@ -85,7 +95,8 @@ func Test_Workflow_Succeeds_SingleSync(t *testing.T) {
 				StartToCloseTimeout: time.Minute,
 			},
 		}, nil)
-	env.OnActivity(runsqlinittablestmts_activity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
+	var initTableStmtsActivity *runsqlinittablestmts_activity.Activity
+	env.OnActivity(initTableStmtsActivity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
 		Return(&runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil)
 	syncActivity := sync_activity.Activity{}
 	env.OnActivity(syncActivity.Sync, mock.Anything, mock.Anything, mock.Anything).Return(&sync_activity.SyncResponse{}, nil)
@@ -105,6 +116,493 @@ func Test_Workflow_Succeeds_SingleSync(t *testing.T) {
 	env.AssertExpectations(t)
 }
 
+func Test_Workflow_Succeeds_DataQuality(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	setWorkerConfig(t, "DATA_QUALITY_SCORING_ENABLED", true)
+
+	var genact *genbenthosconfigs_activity.Activity
+	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).
+		Return(&genbenthosconfigs_activity.GenerateBenthosConfigsResponse{BenthosConfigs: []*genbenthosconfigs_activity.BenthosConfigResponse{
+			{
+				Name:        "public.users",
+				DependsOn:   []*tabledependency.DependsOn{},
+				Config:      &neosync_benthos.BenthosConfig{},
+				TableSchema: "public",
+				TableName:   "users",
+			},
+		}}, nil)
+	env.OnActivity(syncactivityopts_activity.RetrieveActivityOptions, mock.Anything, mock.Anything, mock.Anything).
+		Return(&syncactivityopts_activity.RetrieveActivityOptionsResponse{
+			SyncActivityOptions: &workflow.ActivityOptions{
+				StartToCloseTimeout: time.Minute,
+			},
+		}, nil)
+	var initTableStmtsActivity *runsqlinittablestmts_activity.Activity
+	env.OnActivity(initTableStmtsActivity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
+		Return(&runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil)
+	syncActivity := sync_activity.Activity{}
+	env.OnActivity(syncActivity.Sync, mock.Anything, mock.Anything, mock.Anything).Return(&sync_activity.SyncResponse{}, nil)
+
+	dataQuality := &computedataquality_activity.ComputeDataQualityResponse{
+		Tables: []*computedataquality_activity.TableQuality{
+			{Schema: "public", Table: "users", DestinationConnectionId: "123", Score: 0.9},
+		},
+	}
+	var dqact *computedataquality_activity.Activity
+	env.OnActivity(dqact.ComputeDataQuality, mock.Anything, &computedataquality_activity.ComputeDataQualityRequest{
+		Tables: []string{"public.users"},
+	}).Return(dataQuality, nil)
+
+	env.ExecuteWorkflow(Workflow, &WorkflowRequest{})
+
+	assert.True(t, env.IsWorkflowCompleted())
+
+	err := env.GetWorkflowError()
+	assert.Nil(t, err)
+
+	result := &WorkflowResponse{}
+	err = env.GetWorkflowResult(result)
+	assert.Nil(t, err)
+	assert.Equal(t, &WorkflowResponse{DataQuality: dataQuality}, result)
+
+	env.AssertExpectations(t)
+}
+
+func Test_Workflow_Succeeds_RowCounts(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var genact *genbenthosconfigs_activity.Activity
+	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).
+		Return(&genbenthosconfigs_activity.GenerateBenthosConfigsResponse{BenthosConfigs: []*genbenthosconfigs_activity.BenthosConfigResponse{
+			{
+				Name:      "public.users",
+				DependsOn: []*tabledependency.DependsOn{},
+				Config:    &neosync_benthos.BenthosConfig{},
+			},
+		}}, nil)
+	env.OnActivity(syncactivityopts_activity.RetrieveActivityOptions, mock.Anything, mock.Anything, mock.Anything).
+		Return(&syncactivityopts_activity.RetrieveActivityOptionsResponse{
+			SyncActivityOptions: &workflow.ActivityOptions{
+				StartToCloseTimeout: time.Minute,
+			},
+		}, nil)
+	var initTableStmtsActivity *runsqlinittablestmts_activity.Activity
+	env.OnActivity(initTableStmtsActivity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
+		Return(&runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil)
+	rowCounts := &sync_activity.TableRowCounts{
+		Schema:   "public",
+		Table:    "users",
+		RowsRead: 10,
+		Outputs: []*sync_activity.OutputRowCounts{
+			{Output: "pooled_sql_insert", ConnectionId: "123", RowsWritten: 9},
+		},
+	}
+	syncActivity := sync_activity.Activity{}
+	env.OnActivity(syncActivity.Sync, mock.Anything, mock.Anything, mock.Anything).Return(&sync_activity.SyncResponse{RowCounts: rowCounts}, nil)
+
+	env.ExecuteWorkflow(Workflow, &WorkflowRequest{})
+
+	assert.True(t, env.IsWorkflowCompleted())
+
+	err := env.GetWorkflowError()
+	assert.Nil(t, err)
+
+	result := &WorkflowResponse{}
+	err = env.GetWorkflowResult(result)
+	assert.Nil(t, err)
+	assert.Equal(t, &WorkflowResponse{RowCounts: []*sync_activity.TableRowCounts{rowCounts}}, result)
+
+	env.AssertExpectations(t)
+}
+
+func Test_Workflow_EmitsRunEvents_Failure(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	setWorkerConfig(t, "OPENLINEAGE_URL", "http://localhost:5000")
+
+	var genact *genbenthosconfigs_activity.Activity
+	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).Return(nil, errors.New("TestFailure"))
+
+	var runeventact *emitrunevent_activity.Activity
+	eventTypes := []openlineage.EventType{}
+	errorMessages := []string{}
+	env.OnActivity(runeventact.EmitRunEvent, mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, req *emitrunevent_activity.EmitRunEventRequest) (*emitrunevent_activity.EmitRunEventResponse, error) {
+			eventTypes = append(eventTypes, req.EventType)
+			errorMessages = append(errorMessages, req.ErrorMessage)
+			return &emitrunevent_activity.EmitRunEventResponse{}, nil
+		})
+
+	env.ExecuteWorkflow(Workflow, &WorkflowRequest{JobId: "123"})
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.Error(t, env.GetWorkflowError())
+	assert.Equal(t, []openlineage.EventType{openlineage.EventTypeStart, openlineage.EventTypeFail}, eventTypes)
+	assert.Empty(t, errorMessages[0])
+	assert.Contains(t, errorMessages[1], "TestFailure")
+
+	env.AssertExpectations(t)
+}
+
+func Test_Workflow_SkipsUnversionedSteps(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	setWorkerConfig(t, "DATA_QUALITY_SCORING_ENABLED", true)
+	setWorkerConfig(t, "OPENLINEAGE_URL", "http://localhost:5000")
+
+	// runs that started before these steps existed replay without them, even though they are configured
+	env.OnGetVersion(runEventsChangeId, workflow.DefaultVersion, 1).Return(workflow.DefaultVersion)
+	env.OnGetVersion(dataQualityChangeId, workflow.DefaultVersion, 1).Return(workflow.DefaultVersion)
+	env.OnGetVersion(destinationTriggersChangeId, workflow.DefaultVersion, 1).Return(workflow.DefaultVersion)
+	env.OnGetVersion(analyzeTablesChangeId, workflow.DefaultVersion, 1).Return(workflow.DefaultVersion)
+	env.OnGetVersion(refreshMaterializedViewsChangeId, workflow.DefaultVersion, 1).Return(workflow.DefaultVersion)
+	env.OnGetVersion(mock.Anything, workflow.DefaultVersion, 1).Return(workflow.Version(1))
+
+	var genact *genbenthosconfigs_activity.Activity
+	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).
+		Return(&genbenthosconfigs_activity.GenerateBenthosConfigsResponse{BenthosConfigs: []*genbenthosconfigs_activity.BenthosConfigResponse{
+			{
+				Name:      "public.users",
+				DependsOn: []*tabledependency.DependsOn{},
+				Config:    &neosync_benthos.BenthosConfig{},
+			},
+		}}, nil)
+	env.OnActivity(syncactivityopts_activity.RetrieveActivityOptions, mock.Anything, mock.Anything, mock.Anything).
+		Return(&syncactivityopts_activity.RetrieveActivityOptionsResponse{
+			SyncActivityOptions: &workflow.ActivityOptions{
+				StartToCloseTimeout: time.Minute,
+			},
+			DisableDestinationTriggers: true,
+			AnalyzeDestinationTables:   true,
+			RefreshMaterializedViews:   true,
+		}, nil)
+	var initTableStmtsActivity *runsqlinittablestmts_activity.Activity
+	env.OnActivity(initTableStmtsActivity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
+		Return(&runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil)
+	syncActivity := sync_activity.Activity{}
+	env.OnActivity(syncActivity.Sync, mock.Anything, mock.Anything, mock.Anything).Return(&sync_activity.SyncResponse{}, nil)
+
+	env.ExecuteWorkflow(Workflow, &WorkflowRequest{})
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.Nil(t, env.GetWorkflowError())
+
+	result := &WorkflowResponse{}
+	assert.Nil(t, env.GetWorkflowResult(result))
+	assert.Equal(t, &WorkflowResponse{}, result)
+
+	env.AssertExpectations(t)
+}
+
+// Sets a worker config value for the duration of the test
+func setWorkerConfig(t *testing.T, key string, value any) {
+	t.Helper()
+	viper.Set(key, value)
+	t.Cleanup(func() {
+		viper.Set(key, nil)
+	})
+}
+
+func Test_getRunOutcome(t *testing.T) {
+	now := time.Now()
+	rowCounts := []*sync_activity.TableRowCounts{{Schema: "public", Table: "users", RowsRead: 10}}
+
+	assert.Equal(t, &emitrunevent_activity.EmitRunEventRequest{
+		JobId:     "123",
+		EventType: openlineage.EventTypeComplete,
+		EventTime: now,
+		RowCounts: rowCounts,
+	}, getRunOutcome("123", now, &WorkflowResponse{RowCounts: rowCounts}, nil))
+
+	assert.Equal(t, &emitrunevent_activity.EmitRunEventRequest{
+		JobId:        "123",
+		EventType:    openlineage.EventTypeFail,
+		EventTime:    now,
+		ErrorMessage: "TestFailure",
+	}, getRunOutcome("123", now, nil, errors.New("TestFailure")))
+
+	assert.Equal(t, openlineage.EventTypeAbort, getRunOutcome("123", now, nil, temporal.NewCanceledError()).EventType)
+}
+
+func Test_Workflow_Reenables_Destination_Triggers(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	mockSingleSyncWithDisabledTriggers(env)
+	syncActivity := sync_activity.Activity{}
+	env.OnActivity(syncActivity.Sync, mock.Anything, mock.Anything, mock.Anything).Return(&sync_activity.SyncResponse{}, nil)
+	var triggersact *desttriggers_activity.Activity
+	env.OnActivity(triggersact.EnableDestinationTriggers, mock.Anything, &desttriggers_activity.EnableDestinationTriggersRequest{
+		Destinations: mockDisabledTriggers(),
+	}).Return(&desttriggers_activity.EnableDestinationTriggersResponse{}, nil).Once()
+	var analyzeact *analyzetables_activity.Activity
+	env.OnActivity(analyzeact.AnalyzeTables, mock.Anything, mock.Anything).
+		Return(&analyzetables_activity.AnalyzeTablesResponse{}, nil)
+	var refreshact *refreshmatviews_activity.Activity
+	env.OnActivity(refreshact.RefreshMaterializedViews, mock.Anything, mock.Anything).
+		Return(&refreshmatviews_activity.RefreshMaterializedViewsResponse{}, nil)
+
+	env.ExecuteWorkflow(Workflow, &WorkflowRequest{})
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.Nil(t, env.GetWorkflowError())
+
+	env.AssertExpectations(t)
+}
+
+func Test_Workflow_Reenables_Destination_Triggers_OnError(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	mockSingleSyncWithDisabledTriggers(env)
+	syncActivity := sync_activity.Activity{}
+	env.OnActivity(syncActivity.Sync, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("TestFailure"))
+	var triggersact *desttriggers_activity.Activity
+	env.OnActivity(triggersact.EnableDestinationTriggers, mock.Anything, &desttriggers_activity.EnableDestinationTriggersRequest{
+		Destinations: mockDisabledTriggers(),
+	}).Return(&desttriggers_activity.EnableDestinationTriggersResponse{}, nil).Once()
+
+	env.ExecuteWorkflow(Workflow, &WorkflowRequest{})
+
+	assert.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	assert.Error(t, err)
+	var applicationErr *temporal.ApplicationError
+	assert.True(t, errors.As(err, &applicationErr))
+	assert.Equal(t, "TestFailure", applicationErr.Error())
+
+	env.AssertExpectations(t)
+}
+
+func Test_Workflow_SnapshotClone_MasksRestoredInstance(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	restored := &restoresnapshot_activity.RestoreSnapshotResponse{ConnectionId: "789", InstanceId: "neosync-clone-1"}
+	mockSnapshotCloneSync(env, restored)
+	syncActivity := sync_activity.Activity{}
+	env.OnActivity(syncActivity.Sync, mock.Anything, mock.Anything, mock.Anything).Return(&sync_activity.SyncResponse{}, nil)
+
+	env.ExecuteWorkflow(Workflow, &WorkflowRequest{})
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.Nil(t, env.GetWorkflowError())
+
+	result := &WorkflowResponse{}
+	err := env.GetWorkflowResult(result)
+	assert.Nil(t, err)
+	assert.Equal(t, restored, result.SnapshotClone)
+
+	env.AssertExpectations(t)
+}
+
+func Test_Workflow_SnapshotClone_DeletesRestoredInstance_OnError(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	restored := &restoresnapshot_activity.RestoreSnapshotResponse{ConnectionId: "789", InstanceId: "neosync-clone-1"}
+	mockSnapshotCloneSync(env, restored)
+	syncActivity := sync_activity.Activity{}
+	env.OnActivity(syncActivity.Sync, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("TestFailure"))
+	var restoreact *restoresnapshot_activity.Activity
+	env.OnActivity(restoreact.DeleteRestoredSnapshot, mock.Anything, mock.MatchedBy(func(req *restoresnapshot_activity.DeleteRestoredSnapshotRequest) bool {
+		return req.ConnectionId == "789"
+	})).Return(nil).Once()
+
+	env.ExecuteWorkflow(Workflow, &WorkflowRequest{})
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.Error(t, env.GetWorkflowError())
+
+	env.AssertExpectations(t)
+}
+
+func Test_Workflow_SnapshotClone_RetainsRestoredInstance_OnError(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	restored := &restoresnapshot_activity.RestoreSnapshotResponse{ConnectionId: "789", InstanceId: "neosync-clone-1", RetainOnFailure: true}
+	mockSnapshotCloneSync(env, restored)
+	syncActivity := sync_activity.Activity{}
+	env.OnActivity(syncActivity.Sync, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("TestFailure"))
+	var restoreact *restoresnapshot_activity.Activity
+	env.OnActivity(restoreact.DeleteRestoredSnapshot, mock.Anything, mock.Anything).Return(nil).Never()
+
+	env.ExecuteWorkflow(Workflow, &WorkflowRequest{})
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.Error(t, env.GetWorkflowError())
+
+	env.AssertExpectations(t)
+}
+
+func Test_Workflow_InPlaceMask_CompletesTables(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	plan := &genbenthosconfigs_activity.InPlaceMaskPlan{
+		Tables: []*genbenthosconfigs_activity.InPlaceMaskTable{{Schema: "public", Table: "users", Columns: []string{"email"}, TotalRows: 3, TotalBatches: 2}},
+	}
+	var genact *genbenthosconfigs_activity.Activity
+	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).
+		Return(&genbenthosconfigs_activity.GenerateBenthosConfigsResponse{
+			BenthosConfigs: []*genbenthosconfigs_activity.BenthosConfigResponse{
+				{
+					Name:        "public.users.update",
+					DependsOn:   []*tabledependency.DependsOn{},
+					Config:      &neosync_benthos.BenthosConfig{},
+					TableSchema: "public",
+					TableName:   "users",
+					Columns:     []string{"email"},
+				},
+			},
+			InPlaceMask: plan,
+		}, nil)
+	env.OnActivity(syncactivityopts_activity.RetrieveActivityOptions, mock.Anything, mock.Anything, mock.Anything).
+		Return(&syncactivityopts_activity.RetrieveActivityOptionsResponse{
+			SyncActivityOptions: &workflow.ActivityOptions{
+				StartToCloseTimeout: time.Minute,
+			},
+		}, nil)
+	var initTableStmtsActivity *runsqlinittablestmts_activity.Activity
+	env.OnActivity(initTableStmtsActivity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
+		Return(&runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil)
+
+	counter := atomic.NewInt32(0)
+	syncActivity := sync_activity.Activity{}
+	env.OnActivity(syncActivity.Sync, mock.Anything, mock.Anything, &sync_activity.SyncMetadata{Schema: "public", Table: "users"}).
+		Return(func(ctx context.Context, req *sync_activity.SyncRequest, metadata *sync_activity.SyncMetadata) (*sync_activity.SyncResponse, error) {
+			counter.Add(1)
+			return &sync_activity.SyncResponse{}, nil
+		})
+
+	env.ExecuteWorkflow(Workflow, &WorkflowRequest{})
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.Nil(t, env.GetWorkflowError())
+	assert.Equal(t, int32(1), counter.Load(), "the table is synced by a single activity that pages through its batches")
+
+	encoded, err := env.QueryWorkflow(InPlaceMaskProgressQueryName)
+	assert.NoError(t, err)
+	var progress *genbenthosconfigs_activity.InPlaceMaskPlan
+	assert.NoError(t, encoded.Get(&progress))
+	assert.Equal(t, uint32(2), progress.Tables[0].CompletedBatches)
+
+	env.AssertExpectations(t)
+}
+
+func Test_Workflow_InPlaceMask_ReportsCompletedBatches(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	plan := &genbenthosconfigs_activity.InPlaceMaskPlan{
+		Tables: []*genbenthosconfigs_activity.InPlaceMaskTable{{Schema: "public", Table: "users", Columns: []string{"email"}, TotalRows: 3, TotalBatches: 2}},
+	}
+	var genact *genbenthosconfigs_activity.Activity
+	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).
+		Return(&genbenthosconfigs_activity.GenerateBenthosConfigsResponse{
+			BenthosConfigs: []*genbenthosconfigs_activity.BenthosConfigResponse{
+				{
+					Name:        "public.users.update",
+					DependsOn:   []*tabledependency.DependsOn{},
+					Config:      &neosync_benthos.BenthosConfig{},
+					TableSchema: "public",
+					TableName:   "users",
+					Columns:     []string{"email"},
+				},
+			},
+			InPlaceMask: plan,
+		}, nil)
+	env.OnActivity(syncactivityopts_activity.RetrieveActivityOptions, mock.Anything, mock.Anything, mock.Anything).
+		Return(&syncactivityopts_activity.RetrieveActivityOptionsResponse{
+			SyncActivityOptions: &workflow.ActivityOptions{
+				StartToCloseTimeout: time.Minute,
+			},
+		}, nil)
+	var initTableStmtsActivity *runsqlinittablestmts_activity.Activity
+	env.OnActivity(initTableStmtsActivity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
+		Return(&runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil)
+	syncActivity := sync_activity.Activity{}
+	env.OnActivity(syncActivity.Sync, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("TestFailure"))
+
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(sync_activity.InPlaceMaskBatchSignalName, &sync_activity.InPlaceMaskBatchSignal{Schema: "public", Table: "users", CompletedBatches: 1})
+	}, 0)
+
+	env.ExecuteWorkflow(Workflow, &WorkflowRequest{})
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.Error(t, env.GetWorkflowError())
+
+	encoded, err := env.QueryWorkflow(InPlaceMaskProgressQueryName)
+	assert.NoError(t, err)
+	var progress *genbenthosconfigs_activity.InPlaceMaskPlan
+	assert.NoError(t, encoded.Get(&progress))
+	assert.Equal(t, uint32(1), progress.Tables[0].CompletedBatches, "the batch that was updated before the sync failed is kept")
+
+	env.AssertExpectations(t)
+}
+
+func mockSnapshotCloneSync(env *testsuite.TestWorkflowEnvironment, restored *restoresnapshot_activity.RestoreSnapshotResponse) {
+	var genact *genbenthosconfigs_activity.Activity
+	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, &genbenthosconfigs_activity.GenerateBenthosConfigsRequest{}).
+		Return(&genbenthosconfigs_activity.GenerateBenthosConfigsResponse{RequiresSnapshotRestore: true}, nil).Once()
+	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, &genbenthosconfigs_activity.GenerateBenthosConfigsRequest{RestoredConnectionId: restored.ConnectionId}).
+		Return(&genbenthosconfigs_activity.GenerateBenthosConfigsResponse{BenthosConfigs: []*genbenthosconfigs_activity.BenthosConfigResponse{
+			{
+				Name:      "public.users.update",
+				DependsOn: []*tabledependency.DependsOn{},
+				Config:    &neosync_benthos.BenthosConfig{},
+			},
+		}}, nil).Once()
+	var restoreact *restoresnapshot_activity.Activity
+	env.OnActivity(restoreact.RestoreSnapshot, mock.Anything, mock.Anything).Return(restored, nil).Once()
+	env.OnActivity(syncactivityopts_activity.RetrieveActivityOptions, mock.Anything, mock.Anything, mock.Anything).
+		Return(&syncactivityopts_activity.RetrieveActivityOptionsResponse{
+			SyncActivityOptions: &workflow.ActivityOptions{
+				StartToCloseTimeout: time.Minute,
+			},
+		}, nil)
+	var initTableStmtsActivity *runsqlinittablestmts_activity.Activity
+	env.OnActivity(initTableStmtsActivity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
+		Return(&runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil)
+}
+
+func mockDisabledTriggers() []*desttriggers_activity.DestinationTriggers {
+	return []*desttriggers_activity.DestinationTriggers{
+		{ConnectionId: "123", Triggers: []*sql_manager.TableTrigger{{Schema: "public", Table: "users", Name: "audit_users"}}},
+	}
+}
+
+func mockSingleSyncWithDisabledTriggers(env *testsuite.TestWorkflowEnvironment) {
+	var genact *genbenthosconfigs_activity.Activity
+	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).
+		Return(&genbenthosconfigs_activity.GenerateBenthosConfigsResponse{BenthosConfigs: []*genbenthosconfigs_activity.BenthosConfigResponse{
+			{
+				Name:      "public.users",
+				DependsOn: []*tabledependency.DependsOn{},
+				Config:    &neosync_benthos.BenthosConfig{},
+			},
+		}}, nil)
+	env.OnActivity(syncactivityopts_activity.RetrieveActivityOptions, mock.Anything, mock.Anything, mock.Anything).
+		Return(&syncactivityopts_activity.RetrieveActivityOptionsResponse{
+			SyncActivityOptions: &workflow.ActivityOptions{
+				StartToCloseTimeout: time.Minute,
+			},
+			DisableDestinationTriggers: true,
+			AnalyzeDestinationTables:   true,
+			RefreshMaterializedViews:   true,
+		}, nil)
+	var initTableStmtsActivity *runsqlinittablestmts_activity.Activity
+	env.OnActivity(initTableStmtsActivity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
+		Return(&runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil)
+	var triggersact *desttriggers_activity.Activity
+	env.OnActivity(triggersact.DisableDestinationTriggers, mock.Anything, mock.Anything).
+		Return(&desttriggers_activity.DisableDestinationTriggersResponse{Destinations: mockDisabledTriggers()}, nil)
+}
+
 func Test_Workflow_Follows_Synchronous_DependentFlow(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestWorkflowEnvironment()
@@ -155,7 +653,8 @@ func Test_Workflow_Follows_Synchronous_DependentFlow(t *testing.T) {
 				StartToCloseTimeout: time.Minute,
 			},
 		}, nil)
-	env.OnActivity(runsqlinittablestmts_activity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
+	var initTableStmtsActivity *runsqlinittablestmts_activity.Activity
+	env.OnActivity(initTableStmtsActivity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
 		Return(&runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil)
 	count := 0
 	syncActivity := sync_activity.Activity{}
@@ -258,7 +757,8 @@ func Test_Workflow_Follows_Multiple_Dependents(t *testing.T) {
 				StartToCloseTimeout: time.Minute,
 			},
 		}, nil)
-	env.OnActivity(runsqlinittablestmts_activity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
+	var initTableStmtsActivity *runsqlinittablestmts_activity.Activity
+	env.OnActivity(initTableStmtsActivity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
 		Return(&runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil)
 	counter := atomic.NewInt32(0)
 	syncActivity := sync_activity.Activity{}
@@ -380,7 +880,8 @@ func Test_Workflow_Follows_Multiple_Dependent_Redis_Cleanup(t *testing.T) {
 				StartToCloseTimeout: time.Minute,
 			},
 		}, nil)
-	env.OnActivity(runsqlinittablestmts_activity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
+	var initTableStmtsActivity *runsqlinittablestmts_activity.Activity
+	env.OnActivity(initTableStmtsActivity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
 		Return(&runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil)
 	counter := atomic.NewInt32(0)
 	syncActivities := &sync_activity.Activity{}
@@ -487,7 +988,8 @@ func Test_Workflow_Halts_Activities_OnError(t *testing.T) {
 				},
 			},
 		}}, nil)
-	env.OnActivity(runsqlinittablestmts_activity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
+	var initTableStmtsActivity *runsqlinittablestmts_activity.Activity
+	env.OnActivity(initTableStmtsActivity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
 		Return(&runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil)
 	env.OnActivity(syncactivityopts_activity.RetrieveActivityOptions, mock.Anything, mock.Anything, mock.Anything).
 		Return(&syncactivityopts_activity.RetrieveActivityOptionsResponse{
@@ -588,7 +1090,8 @@ func Test_Workflow_Cleans_Up_Redis_OnError(t *testing.T) {
 				},
 			},
 		}}, nil)
-	env.OnActivity(runsqlinittablestmts_activity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
+	var initTableStmtsActivity *runsqlinittablestmts_activity.Activity
+	env.OnActivity(initTableStmtsActivity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
 		Return(&runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil)
 	env.OnActivity(syncactivityopts_activity.RetrieveActivityOptions, mock.Anything, mock.Anything, mock.Anything).
 		Return(&syncactivityopts_activity.RetrieveActivityOptionsResponse{
@@ -621,6 +1124,74 @@ func Test_Workflow_Cleans_Up_Redis_OnError(t *testing.T) {
 
 	env.AssertExpectations(t)
 }
+func Test_Workflow_Preempted_YieldsAtTableBoundary(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	syncStartedAt := mockPreemptibleSync(env, mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_BATCH)
+	startedAt := env.Now()
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(PreemptSignalName, &PreemptSignal{YieldFor: time.Hour})
+	}, 0)
+
+	env.ExecuteWorkflow(Workflow, &WorkflowRequest{})
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.Nil(t, env.GetWorkflowError())
+	assert.False(t, syncStartedAt().Before(startedAt.Add(time.Hour)))
+
+	env.AssertExpectations(t)
+}
+
+func Test_Workflow_Interactive_IgnoresPreemption(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	syncStartedAt := mockPreemptibleSync(env, mgmtv1alpha1.JobRunPriority_JOB_RUN_PRIORITY_INTERACTIVE)
+	startedAt := env.Now()
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(PreemptSignalName, &PreemptSignal{YieldFor: time.Hour})
+	}, 0)
+
+	env.ExecuteWorkflow(Workflow, &WorkflowRequest{})
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.Nil(t, env.GetWorkflowError())
+	assert.True(t, syncStartedAt().Before(startedAt.Add(time.Hour)))
+
+	env.AssertExpectations(t)
+}
+
+func mockPreemptibleSync(env *testsuite.TestWorkflowEnvironment, priority mgmtv1alpha1.JobRunPriority) func() time.Time {
+	var genact *genbenthosconfigs_activity.Activity
+	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).
+		Return(&genbenthosconfigs_activity.GenerateBenthosConfigsResponse{BenthosConfigs: []*genbenthosconfigs_activity.BenthosConfigResponse{
+			{
+				Name:      "public.users",
+				DependsOn: []*tabledependency.DependsOn{},
+				Config:    &neosync_benthos.BenthosConfig{},
+			},
+		}}, nil)
+	env.OnActivity(syncactivityopts_activity.RetrieveActivityOptions, mock.Anything, mock.Anything, mock.Anything).
+		Return(&syncactivityopts_activity.RetrieveActivityOptionsResponse{
+			SyncActivityOptions: &workflow.ActivityOptions{
+				StartToCloseTimeout: time.Minute,
+			},
+			Priority: priority,
+		}, nil)
+	var initTableStmtsActivity *runsqlinittablestmts_activity.Activity
+	env.OnActivity(initTableStmtsActivity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
+		Return(&runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil)
+	var syncStartedAt time.Time
+	syncActivity := sync_activity.Activity{}
+	env.OnActivity(syncActivity.Sync, mock.Anything, mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, req *sync_activity.SyncRequest, metadata *sync_activity.SyncMetadata) (*sync_activity.SyncResponse, error) {
+			syncStartedAt = env.Now()
+			return &sync_activity.SyncResponse{}, nil
+		})
+	return func() time.Time { return syncStartedAt }
+}
+
 func Test_isConfigReady(t *testing.T) {
 	isReady, err := isConfigReady(nil, nil)
 	assert.NoError(t, err)